@@ -0,0 +1,273 @@
+package client
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// AdaptiveRetryer retries requests the same as DefaultRetryer, and in
+// addition rate limits the requests the client sends when the service
+// responds with throttling errors. Once throttled, every attempt of a
+// request, including its first, must acquire a token from the client's rate
+// limiter before being sent, and will be delayed until a token is available.
+//
+// The rate limiter's send rate is reduced when a request is throttled, and is
+// increased again as requests succeed, using a cubic function of the time
+// since the last throttling error. Errors other than throttling errors do not
+// change the send rate, and return the token the attempt acquired.
+//
+// An AdaptiveRetryer must be used by pointer, and should not be shared
+// between service clients, so that each client measures the throttling of
+// its own requests:
+//
+//    svc := dynamodb.New(sess, request.WithRetryer(aws.NewConfig(),
+//        &client.AdaptiveRetryer{
+//            DefaultRetryer: client.DefaultRetryer{NumMaxRetries: 3},
+//        },
+//    ))
+//
+// The aws.Config.RetryMode can also be set to aws.AdaptiveRetryMode to have
+// each service client create its own AdaptiveRetryer.
+type AdaptiveRetryer struct {
+	DefaultRetryer
+
+	rateLimit adaptiveRateLimit
+}
+
+// addHandlers adds the handlers acquiring send tokens, and updating the send
+// rate from the responses of requests sent with the retryer.
+func (d *AdaptiveRetryer) addHandlers(handlers *request.Handlers) {
+	handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "awssdk.client.AdaptiveRetryer.AcquireToken",
+		Fn:   d.acquireToken,
+	})
+	handlers.Retry.PushFrontNamed(request.NamedHandler{
+		Name: "awssdk.client.AdaptiveRetryer.UpdateOnError",
+		Fn:   d.updateOnError,
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "awssdk.client.AdaptiveRetryer.UpdateOnSuccess",
+		Fn:   d.updateOnSuccess,
+	})
+}
+
+// acquireToken waits until the rate limiter has a token for the request's
+// attempt to be sent with. Presigned requests are not sent by the SDK, and
+// do not acquire a token.
+func (d *AdaptiveRetryer) acquireToken(r *request.Request) {
+	if r.ExpireTime > 0 {
+		return
+	}
+
+	for {
+		ok, delay := d.rateLimit.acquireToken(1)
+		if ok {
+			return
+		}
+
+		if sleepFn := r.Config.SleepDelay; sleepFn != nil {
+			sleepFn(delay)
+		} else if err := aws.SleepWithContext(r.Context(), delay); err != nil {
+			r.Error = awserr.New(request.CanceledErrorCode,
+				"request context canceled", err)
+			return
+		}
+	}
+}
+
+// updateOnError reduces the send rate if the attempt was throttled.
+// Otherwise the attempt's token is returned to the rate limiter.
+func (d *AdaptiveRetryer) updateOnError(r *request.Request) {
+	if isThrottled(r) {
+		d.rateLimit.update(true)
+		return
+	}
+	d.rateLimit.refundToken(1)
+}
+
+// updateOnSuccess increases the send rate after a request's attempt
+// succeeded.
+func (d *AdaptiveRetryer) updateOnSuccess(r *request.Request) {
+	if r.Error != nil || r.ExpireTime > 0 {
+		return
+	}
+	d.rateLimit.update(false)
+}
+
+// isThrottled returns if the request's attempt was throttled by the service.
+func isThrottled(r *request.Request) bool {
+	if r.HTTPResponse != nil && r.HTTPResponse.StatusCode == 429 {
+		return true
+	}
+	return r.IsErrorThrottle()
+}
+
+const (
+	// adaptiveBeta is the proportion of the send rate kept when throttled.
+	adaptiveBeta = 0.7
+
+	// adaptiveScale is the cubic scaling constant the send rate recovers at.
+	adaptiveScale = 0.4
+
+	// adaptiveSmooth is the weight of the most recent measurement in the
+	// measured send rate.
+	adaptiveSmooth = 0.8
+
+	// Minimum tokens per second, and capacity, of the token bucket.
+	adaptiveMinFillRate = 0.5
+	adaptiveMinCapacity = 1.0
+)
+
+// adaptiveRateLimit is a token bucket rate limiter whose fill rate adapts to
+// the throttling responses of the requests sent. The rate limiter is only
+// enabled once a request has been throttled. The zero value is ready to use.
+type adaptiveRateLimit struct {
+	mu sync.Mutex
+
+	// now returns the current time, the clock is overridden by tests.
+	now func() time.Time
+
+	started bool
+	enabled bool
+
+	// token bucket
+	fillRate        float64
+	maxCapacity     float64
+	currentCapacity float64
+	lastRefill      float64
+
+	// send rate calculation
+	lastMaxRate      float64
+	lastThrottleTime float64
+	timeWindow       float64
+
+	// measured send rate
+	measuredTxRate   float64
+	lastTxRateBucket float64
+	requestCount     int64
+}
+
+// seconds returns the current time as seconds since the epoch. The first
+// call starts the rate limiter's clock.
+func (l *adaptiveRateLimit) seconds() float64 {
+	now := time.Now
+	if l.now != nil {
+		now = l.now
+	}
+	t := float64(now().UnixNano()) / float64(time.Second)
+
+	if !l.started {
+		l.started = true
+		l.lastRefill = t
+		l.lastThrottleTime = t
+		l.lastTxRateBucket = math.Floor(t)
+	}
+	return t
+}
+
+// acquireToken takes amount tokens from the bucket if the rate limiter is
+// not enabled, or enough tokens are available. Otherwise the delay until
+// the tokens will be available is returned.
+func (l *adaptiveRateLimit) acquireToken(amount float64) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.enabled {
+		return true, 0
+	}
+
+	l.refill(l.seconds())
+	if amount > l.currentCapacity {
+		wait := (amount - l.currentCapacity) / l.fillRate
+		return false, time.Duration(wait * float64(time.Second))
+	}
+
+	l.currentCapacity -= amount
+	return true, 0
+}
+
+// refundToken returns amount tokens to the bucket.
+func (l *adaptiveRateLimit) refundToken(amount float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.enabled {
+		return
+	}
+	l.currentCapacity = math.Min(l.currentCapacity+amount, l.maxCapacity)
+}
+
+// update measures the send rate with the attempt's response, and updates
+// the bucket's fill rate from it. A throttled response reduces the fill rate,
+// and enables the rate limiter.
+func (l *adaptiveRateLimit) update(throttled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	t := l.seconds()
+	l.updateMeasuredRate(t)
+
+	var rate float64
+	if throttled {
+		rateToUse := l.measuredTxRate
+		if l.enabled {
+			rateToUse = math.Min(l.measuredTxRate, l.fillRate)
+		}
+
+		l.lastMaxRate = rateToUse
+		l.calculateTimeWindow()
+		l.lastThrottleTime = t
+		rate = l.cubicThrottle(rateToUse)
+		l.enabled = true
+	} else {
+		l.calculateTimeWindow()
+		rate = l.cubicSuccess(t)
+	}
+
+	l.updateRate(t, math.Min(rate, 2*l.measuredTxRate))
+}
+
+func (l *adaptiveRateLimit) refill(t float64) {
+	fill := (t - l.lastRefill) * l.fillRate
+	l.currentCapacity = math.Min(l.maxCapacity, l.currentCapacity+fill)
+	l.lastRefill = t
+}
+
+func (l *adaptiveRateLimit) updateRate(t, rate float64) {
+	l.refill(t)
+	l.fillRate = math.Max(rate, adaptiveMinFillRate)
+	l.maxCapacity = math.Max(rate, adaptiveMinCapacity)
+	l.currentCapacity = math.Min(l.currentCapacity, l.maxCapacity)
+}
+
+// updateMeasuredRate counts the request sent, and updates the measured send
+// rate each half second.
+func (l *adaptiveRateLimit) updateMeasuredRate(t float64) {
+	bucket := math.Floor(t*2) / 2
+	l.requestCount++
+
+	if bucket > l.lastTxRateBucket {
+		rate := float64(l.requestCount) / (bucket - l.lastTxRateBucket)
+		l.measuredTxRate = rate*adaptiveSmooth + l.measuredTxRate*(1-adaptiveSmooth)
+		l.requestCount = 0
+		l.lastTxRateBucket = bucket
+	}
+}
+
+func (l *adaptiveRateLimit) calculateTimeWindow() {
+	l.timeWindow = math.Cbrt(l.lastMaxRate * (1 - adaptiveBeta) / adaptiveScale)
+}
+
+func (l *adaptiveRateLimit) cubicThrottle(rate float64) float64 {
+	return rate * adaptiveBeta
+}
+
+func (l *adaptiveRateLimit) cubicSuccess(t float64) float64 {
+	dt := t - l.lastThrottleTime
+	return adaptiveScale*math.Pow(dt-l.timeWindow, 3) + l.lastMaxRate
+}
@@ -0,0 +1,302 @@
+package client
+
+import (
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+type mockClock struct {
+	seconds float64
+}
+
+func (c *mockClock) now() time.Time {
+	return time.Unix(0, int64(c.seconds*float64(time.Second)))
+}
+
+func newMockRateLimit(clock *mockClock) *adaptiveRateLimit {
+	l := &adaptiveRateLimit{now: clock.now}
+	l.seconds() // start the clock
+	return l
+}
+
+func floatEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestAdaptiveRateLimit_Update(t *testing.T) {
+	cases := []struct {
+		Time             float64
+		Throttled        bool
+		ExpectMeasured   float64
+		ExpectFillRate   float64
+		ExpectRateEnable bool
+	}{
+		{0.2, false, 0, 0.5, false},
+		{0.4, false, 0, 0.5, false},
+		{0.6, false, 4.8, 0.5, false},
+		{0.8, false, 4.8, 0.5, false},
+		{1.0, false, 4.16, 0.5, false},
+		{1.2, false, 4.16, 0.6912, false},
+		{1.4, false, 4.16, 1.0976, false},
+		{1.6, false, 5.632, 1.6384, false},
+		{1.8, false, 5.632, 2.3328, false},
+		{2.0, true, 4.3264, 3.02848, true},
+		{2.2, false, 4.3264, 3.486639174, true},
+		{2.4, false, 4.3264, 3.821874416, true},
+		{2.6, false, 5.66528, 4.053385728, true},
+		{2.8, true, 5.66528, 2.837370009, true},
+		{3.0, false, 4.333056, 3.274687575, true},
+		{3.2, false, 4.333056, 3.592135937, true},
+		{3.4, false, 4.333056, 3.808915096, true},
+	}
+
+	clock := &mockClock{}
+	l := newMockRateLimit(clock)
+	for i, c := range cases {
+		clock.seconds = c.Time
+		l.update(c.Throttled)
+
+		if e, a := c.ExpectMeasured, l.measuredTxRate; !floatEqual(e, a) {
+			t.Errorf("%d, expect %v measured rate, got %v", i, e, a)
+		}
+		if e, a := c.ExpectFillRate, l.fillRate; !floatEqual(e, a) {
+			t.Errorf("%d, expect %v fill rate, got %v", i, e, a)
+		}
+		if e, a := c.ExpectRateEnable, l.enabled; e != a {
+			t.Errorf("%d, expect %v enabled, got %v", i, e, a)
+		}
+	}
+}
+
+func TestAdaptiveRateLimit_CubicSuccess(t *testing.T) {
+	l := adaptiveRateLimit{lastMaxRate: 10, lastThrottleTime: 5}
+	l.calculateTimeWindow()
+
+	cases := []struct {
+		Time   float64
+		Expect float64
+	}{
+		{5, 7},
+		{6, 9.64893600966},
+		{7, 10.000030849917364},
+		{8, 10.453284520772092},
+		{9, 13.408697022224185},
+		{10, 21.26626835427364},
+		{11, 36.425998516920465},
+	}
+
+	for _, c := range cases {
+		if e, a := c.Expect, l.cubicSuccess(c.Time); !floatEqual(e, a) {
+			t.Errorf("%v, expect %v rate, got %v", c.Time, e, a)
+		}
+	}
+
+	if e, a := 7.0, l.cubicThrottle(10); !floatEqual(e, a) {
+		t.Errorf("expect %v throttled rate, got %v", e, a)
+	}
+}
+
+func TestAdaptiveRateLimit_AcquireToken(t *testing.T) {
+	clock := &mockClock{}
+	l := newMockRateLimit(clock)
+
+	for i := 0; i < 10; i++ {
+		if ok, delay := l.acquireToken(1); !ok || delay != 0 {
+			t.Fatalf("%d, expect token before being throttled, got %v, %v", i, ok, delay)
+		}
+	}
+
+	clock.seconds = 0.2
+	l.update(true)
+	l.currentCapacity = 0
+
+	ok, delay := l.acquireToken(1)
+	if ok {
+		t.Fatalf("expect no token to be available")
+	}
+	if e, a := 2*time.Second, delay; e != a {
+		t.Errorf("expect %v delay, got %v", e, a)
+	}
+
+	clock.seconds = 2.2
+	if ok, _ := l.acquireToken(1); !ok {
+		t.Errorf("expect token after delay")
+	}
+	if ok, _ := l.acquireToken(1); ok {
+		t.Errorf("expect bucket to be empty")
+	}
+
+	l.refundToken(1)
+	if ok, _ := l.acquireToken(1); !ok {
+		t.Errorf("expect refunded token")
+	}
+}
+
+func newAdaptiveRequest(code string, status int) *request.Request {
+	r := &request.Request{
+		HTTPRequest:  &http.Request{Header: http.Header{}},
+		HTTPResponse: &http.Response{StatusCode: status},
+	}
+	if len(code) > 0 {
+		r.Error = awserr.New(code, "mock error", nil)
+	}
+	return r
+}
+
+func TestAdaptiveRetryer_NonThrottleError(t *testing.T) {
+	clock := &mockClock{}
+	retryer := &AdaptiveRetryer{}
+	retryer.rateLimit.now = clock.now
+
+	clock.seconds = 1
+	retryer.updateOnError(newAdaptiveRequest("ThrottlingException", 400))
+	if !retryer.rateLimit.enabled {
+		t.Fatalf("expect throttling error to enable rate limit")
+	}
+	retryer.rateLimit.currentCapacity = 1
+
+	fillRate := retryer.rateLimit.fillRate
+	for i := 0; i < 5; i++ {
+		r := newAdaptiveRequest("InternalError", 500)
+		retryer.acquireToken(r)
+		if r.Error.(awserr.Error).Code() != "InternalError" {
+			t.Fatalf("%d, expect token to be acquired, got %v", i, r.Error)
+		}
+		retryer.updateOnError(r)
+	}
+
+	if e, a := 1.0, retryer.rateLimit.currentCapacity; !floatEqual(e, a) {
+		t.Errorf("expect %v capacity, got %v", e, a)
+	}
+	if e, a := fillRate, retryer.rateLimit.fillRate; e != a {
+		t.Errorf("expect %v fill rate, got %v", e, a)
+	}
+
+	retryer.updateOnError(newAdaptiveRequest("", 429))
+	if a := retryer.rateLimit.fillRate; a > fillRate {
+		t.Errorf("expect 429 status to not increase fill rate from %v, got %v", fillRate, a)
+	}
+}
+
+type canceledContext struct {
+	aws.Context
+	done chan struct{}
+}
+
+func (c canceledContext) Done() <-chan struct{} { return c.done }
+func (c canceledContext) Err() error            { return errCanceled }
+
+var errCanceled = awserr.New("Canceled", "mock canceled", nil)
+
+func TestAdaptiveRetryer_AcquireTokenCanceled(t *testing.T) {
+	clock := &mockClock{}
+	retryer := &AdaptiveRetryer{}
+	retryer.rateLimit.now = clock.now
+
+	retryer.updateOnError(newAdaptiveRequest("ThrottlingException", 400))
+	retryer.rateLimit.currentCapacity = 0
+
+	ctx := canceledContext{Context: aws.BackgroundContext(), done: make(chan struct{})}
+	close(ctx.done)
+
+	r := newAdaptiveRequest("", 200)
+	r.SetContext(ctx)
+	retryer.acquireToken(r)
+
+	aerr, ok := r.Error.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T, %v", r.Error, r.Error)
+	}
+	if e, a := request.CanceledErrorCode, aerr.Code(); e != a {
+		t.Errorf("expect %q error code, got %q", e, a)
+	}
+}
+
+func TestAdaptiveRetryer_SleepDelay(t *testing.T) {
+	clock := &mockClock{}
+	retryer := &AdaptiveRetryer{}
+	retryer.rateLimit.now = clock.now
+
+	retryer.updateOnError(newAdaptiveRequest("ThrottlingException", 400))
+	retryer.rateLimit.currentCapacity = 0
+
+	var delays []time.Duration
+	r := newAdaptiveRequest("", 200)
+	r.Config.SleepDelay = func(d time.Duration) {
+		delays = append(delays, d)
+		clock.seconds += d.Seconds()
+	}
+	retryer.acquireToken(r)
+
+	if r.Error != nil {
+		t.Fatalf("expect no error, got %v", r.Error)
+	}
+	if e, a := 1, len(delays); e != a {
+		t.Fatalf("expect %d delays, got %d", e, a)
+	}
+	if e, a := 2*time.Second, delays[0]; e != a {
+		t.Errorf("expect %v delay, got %v", e, a)
+	}
+}
+
+func TestNewClient_RetryMode(t *testing.T) {
+	cases := []struct {
+		Config         aws.Config
+		ExpectAdaptive bool
+	}{
+		{Config: aws.Config{}},
+		{Config: aws.Config{RetryMode: aws.String(aws.LegacyRetryMode)}},
+		{Config: aws.Config{RetryMode: aws.String("unknown")}},
+		{
+			Config:         aws.Config{RetryMode: aws.String(aws.AdaptiveRetryMode)},
+			ExpectAdaptive: true,
+		},
+		{
+			Config: aws.Config{
+				RetryMode: aws.String(aws.AdaptiveRetryMode),
+				Retryer:   DefaultRetryer{NumMaxRetries: 1},
+			},
+		},
+		{
+			Config:         aws.Config{Retryer: &AdaptiveRetryer{}},
+			ExpectAdaptive: true,
+		},
+	}
+
+	for i, c := range cases {
+		c.Config.DisableRetryQuota = aws.Bool(true)
+		svc := New(c.Config, metadata.ClientInfo{}, request.Handlers{})
+
+		retryer, ok := svc.Retryer.(*AdaptiveRetryer)
+		if e, a := c.ExpectAdaptive, ok; e != a {
+			t.Errorf("%d, expect adaptive %v, got %T", i, e, svc.Retryer)
+		}
+
+		expectLen := 0
+		if c.ExpectAdaptive {
+			expectLen = 1
+		}
+		if e, a := expectLen, svc.Handlers.Sign.Len(); e != a {
+			t.Errorf("%d, expect %d sign handlers, got %d", i, e, a)
+		}
+		if e, a := expectLen, svc.Handlers.Retry.Len(); e != a {
+			t.Errorf("%d, expect %d retry handlers, got %d", i, e, a)
+		}
+		if e, a := expectLen, svc.Handlers.Complete.Len(); e != a {
+			t.Errorf("%d, expect %d complete handlers, got %d", i, e, a)
+		}
+
+		if ok && c.Config.Retryer == nil {
+			if e, a := 3, retryer.MaxRetries(); e != a {
+				t.Errorf("%d, expect %d max retries, got %d", i, e, a)
+			}
+		}
+	}
+}
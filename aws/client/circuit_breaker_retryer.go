@@ -0,0 +1,404 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// ErrCodeCircuitOpen is the error code of the error a request fails with,
+// without being sent, when the circuit of the request's endpoint host is
+// open.
+const ErrCodeCircuitOpen = "CircuitOpen"
+
+// Default values of the CircuitBreakerRetryer's options.
+const (
+	DefaultCircuitBreakerFailureThreshold = 5
+	DefaultCircuitBreakerFailureRate      = 0.5
+	DefaultCircuitBreakerWindow           = 10 * time.Second
+	DefaultCircuitBreakerOpenTimeout      = 30 * time.Second
+	DefaultCircuitBreakerHalfOpenProbes   = 1
+)
+
+// A CircuitState is the state of the circuit of an endpoint host.
+type CircuitState string
+
+// Circuit states of an endpoint host.
+const (
+	// CircuitClosed is the state of a healthy host, requests are sent.
+	CircuitClosed CircuitState = "closed"
+
+	// CircuitOpen is the state of a failing host, requests fail without
+	// being sent.
+	CircuitOpen CircuitState = "open"
+
+	// CircuitHalfOpen is the state of a host whose circuit was open for the
+	// open timeout. A limited number of probe requests are sent, the
+	// circuit is closed once a probe succeeds, and opened again if a probe
+	// fails.
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+// A CircuitBreakerOption sets an option of a CircuitBreakerRetryer.
+type CircuitBreakerOption func(*CircuitBreakerRetryer)
+
+// WithCircuitBreakerFailureThreshold sets the minimum number of failed
+// attempts, and the minimum rate of failed attempts, within the failure
+// window for a host's circuit to be opened.
+func WithCircuitBreakerFailureThreshold(failures int, rate float64) CircuitBreakerOption {
+	return func(c *CircuitBreakerRetryer) {
+		c.FailureThreshold = failures
+		c.FailureRate = rate
+	}
+}
+
+// WithCircuitBreakerWindow sets the duration failed attempts are counted
+// over.
+func WithCircuitBreakerWindow(window time.Duration) CircuitBreakerOption {
+	return func(c *CircuitBreakerRetryer) {
+		c.Window = window
+	}
+}
+
+// WithCircuitBreakerOpenTimeout sets the duration a host's circuit is open for
+// before probe requests are sent to the host.
+func WithCircuitBreakerOpenTimeout(timeout time.Duration) CircuitBreakerOption {
+	return func(c *CircuitBreakerRetryer) {
+		c.OpenTimeout = timeout
+	}
+}
+
+// WithCircuitBreakerHalfOpenProbes sets the number of probe requests sent
+// concurrently to a host whose circuit is half-open.
+func WithCircuitBreakerHalfOpenProbes(probes int) CircuitBreakerOption {
+	return func(c *CircuitBreakerRetryer) {
+		c.HalfOpenProbes = probes
+	}
+}
+
+// CircuitBreakerRetryer retries requests the same as the Retryer it wraps,
+// and in addition tracks the failed attempts of the requests sent to each
+// endpoint host. Once the attempts to a host fail at or above the failure
+// threshold within the failure window, the host's circuit is opened, and
+// requests to the host fail immediately with the ErrCodeCircuitOpen error
+// code, without being sent or retried. After the open timeout, a limited
+// number of probe requests are sent to the host, and the circuit is closed
+// once a probe succeeds.
+//
+// An attempt fails if no response was received, such as connection errors
+// and timeouts, or the service responded with a 5xx status code. Other error
+// responses, and canceled requests, are not failures of the host.
+//
+// A CircuitBreakerRetryer must be used by pointer, and should not be shared
+// between service clients:
+//
+//    svc := dynamodb.New(sess, request.WithRetryer(aws.NewConfig(),
+//        client.NewCircuitBreakerRetryer(
+//            client.DefaultRetryer{NumMaxRetries: 3},
+//            client.WithCircuitBreakerOpenTimeout(time.Minute),
+//        ),
+//    ))
+type CircuitBreakerRetryer struct {
+	request.Retryer
+
+	// FailureThreshold is the minimum number of failed attempts within the
+	// failure window for a host's circuit to be opened.
+	FailureThreshold int
+
+	// FailureRate is the minimum rate of the attempts within the failure
+	// window which failed for a host's circuit to be opened.
+	FailureRate float64
+
+	// Window is the duration failed attempts are counted over.
+	Window time.Duration
+
+	// OpenTimeout is the duration a host's circuit is open for before probe
+	// requests are sent to the host.
+	OpenTimeout time.Duration
+
+	// HalfOpenProbes is the number of probe requests sent concurrently to
+	// a host whose circuit is half-open.
+	HalfOpenProbes int
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+
+	// now returns the current time, the clock is overridden by tests.
+	now func() time.Time
+}
+
+// NewCircuitBreakerRetryer returns a CircuitBreakerRetryer wrapping the
+// retryer, with the default options modified by opts.
+func NewCircuitBreakerRetryer(retryer request.Retryer, opts ...CircuitBreakerOption) *CircuitBreakerRetryer {
+	c := &CircuitBreakerRetryer{
+		Retryer:          retryer,
+		FailureThreshold: DefaultCircuitBreakerFailureThreshold,
+		FailureRate:      DefaultCircuitBreakerFailureRate,
+		Window:           DefaultCircuitBreakerWindow,
+		OpenTimeout:      DefaultCircuitBreakerOpenTimeout,
+		HalfOpenProbes:   DefaultCircuitBreakerHalfOpenProbes,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// State returns the state of the circuit of the endpoint host, e.g.
+// "dynamodb.us-west-2.amazonaws.com".
+func (c *CircuitBreakerRetryer) State(host string) CircuitState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cb, ok := c.circuits[host]; ok {
+		return cb.stateAt(c.time(), c.OpenTimeout)
+	}
+	return CircuitClosed
+}
+
+// States returns the states of the circuits of the endpoint hosts requests
+// were sent to.
+func (c *CircuitBreakerRetryer) States() map[string]CircuitState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.time()
+	states := make(map[string]CircuitState, len(c.circuits))
+	for host, cb := range c.circuits {
+		states[host] = cb.stateAt(now, c.OpenTimeout)
+	}
+	return states
+}
+
+// addHandlers adds the handlers failing requests to hosts whose circuit is
+// open, and tracking the failed attempts of requests sent with the retryer.
+func (c *CircuitBreakerRetryer) addHandlers(handlers *request.Handlers) {
+	handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "awssdk.client.CircuitBreakerRetryer.Allow",
+		Fn:   c.allow,
+	})
+	handlers.Retry.PushFrontNamed(request.NamedHandler{
+		Name: "awssdk.client.CircuitBreakerRetryer.RecordFailure",
+		Fn:   c.recordFailure,
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "awssdk.client.CircuitBreakerRetryer.RecordSuccess",
+		Fn:   c.recordSuccess,
+	})
+}
+
+// allow fails the request's attempt if the circuit of the request's host is
+// open, or is half-open and the host's probes are in flight. Presigned
+// requests are not sent by the SDK, and are always allowed.
+func (c *CircuitBreakerRetryer) allow(r *request.Request) {
+	if r.ExpireTime > 0 || r.HTTPRequest == nil {
+		return
+	}
+	host := r.HTTPRequest.URL.Host
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cb := c.circuit(host)
+	if cb.allow(r, c.time(), c.OpenTimeout, c.HalfOpenProbes) {
+		return
+	}
+
+	r.Error = awserr.New(ErrCodeCircuitOpen,
+		"circuit open for endpoint host "+host+", request not sent", nil)
+}
+
+// recordFailure records the outcome of the request's failed attempt. The
+// probe of a canceled request is released without an outcome.
+func (c *CircuitBreakerRetryer) recordFailure(r *request.Request) {
+	if isCanceled(r) {
+		c.release(r)
+		return
+	}
+	c.record(r, isHostFailure(r))
+}
+
+// recordSuccess records the outcome of the request's last attempt if it
+// succeeded, and releases the request's probe otherwise.
+func (c *CircuitBreakerRetryer) recordSuccess(r *request.Request) {
+	if r.Error == nil {
+		c.record(r, false)
+		return
+	}
+	c.release(r)
+}
+
+// release releases the request's probe, if the request is a probe.
+func (c *CircuitBreakerRetryer) release(r *request.Request) {
+	if r.HTTPRequest == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cb, ok := c.circuits[r.HTTPRequest.URL.Host]; ok {
+		delete(cb.probes, r)
+	}
+}
+
+func (c *CircuitBreakerRetryer) record(r *request.Request, failed bool) {
+	if r.ExpireTime > 0 || r.HTTPRequest == nil || isCircuitOpenError(r) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cb := c.circuit(r.HTTPRequest.URL.Host)
+	cb.record(r, failed, c.time(), c.Window, c.FailureThreshold, c.FailureRate)
+}
+
+// circuit returns the circuit of the host, creating the circuit if needed.
+// Must be called with the retryer's lock held.
+func (c *CircuitBreakerRetryer) circuit(host string) *circuit {
+	if c.circuits == nil {
+		c.circuits = map[string]*circuit{}
+	}
+
+	cb, ok := c.circuits[host]
+	if !ok {
+		cb = &circuit{probes: map[*request.Request]struct{}{}}
+		c.circuits[host] = cb
+	}
+	return cb
+}
+
+func (c *CircuitBreakerRetryer) time() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+	return time.Now()
+}
+
+// A circuit tracks the failed attempts of the requests sent to a host.
+type circuit struct {
+	open     bool
+	openedAt time.Time
+
+	// Attempts within the failure window.
+	windowStart time.Time
+	attempts    int
+	failures    int
+
+	// Probe requests in flight while the circuit is half-open.
+	probes     map[*request.Request]struct{}
+	probeStart time.Time
+}
+
+func (cb *circuit) stateAt(now time.Time, openTimeout time.Duration) CircuitState {
+	switch {
+	case !cb.open:
+		return CircuitClosed
+	case now.Sub(cb.openedAt) < openTimeout:
+		return CircuitOpen
+	default:
+		return CircuitHalfOpen
+	}
+}
+
+// allow returns if the request's attempt may be sent. The request is
+// allowed as a probe if the circuit is half-open and fewer than
+// maxProbes probes are in flight. Probes which did not complete within the
+// open timeout, such as requests which were only signed, are abandoned.
+func (cb *circuit) allow(r *request.Request, now time.Time, openTimeout time.Duration, maxProbes int) bool {
+	switch cb.stateAt(now, openTimeout) {
+	case CircuitClosed:
+		return true
+	case CircuitOpen:
+		return false
+	}
+
+	if _, ok := cb.probes[r]; ok {
+		return true
+	}
+	if len(cb.probes) > 0 && now.Sub(cb.probeStart) >= openTimeout {
+		cb.probes = map[*request.Request]struct{}{}
+	}
+	if len(cb.probes) >= maxProbes {
+		return false
+	}
+
+	if len(cb.probes) == 0 {
+		cb.probeStart = now
+	}
+	cb.probes[r] = struct{}{}
+	return true
+}
+
+// record records the outcome of the request's attempt. A probe's outcome
+// closes, or opens the circuit again. Otherwise the circuit is opened if the
+// failed attempts within the window reach the threshold.
+func (cb *circuit) record(r *request.Request, failed bool, now time.Time, window time.Duration, threshold int, rate float64) {
+	if _, ok := cb.probes[r]; ok {
+		delete(cb.probes, r)
+		if failed {
+			cb.trip(now)
+		} else {
+			cb.reset(now)
+		}
+		return
+	}
+	if cb.open {
+		// Requests sent before the circuit was opened do not change the
+		// circuit's state.
+		return
+	}
+
+	if now.Sub(cb.windowStart) >= window {
+		cb.windowStart = now
+		cb.attempts, cb.failures = 0, 0
+	}
+	cb.attempts++
+	if !failed {
+		return
+	}
+	cb.failures++
+
+	if cb.failures >= threshold && float64(cb.failures) >= rate*float64(cb.attempts) {
+		cb.trip(now)
+	}
+}
+
+func (cb *circuit) trip(now time.Time) {
+	cb.open = true
+	cb.openedAt = now
+	cb.probes = map[*request.Request]struct{}{}
+}
+
+func (cb *circuit) reset(now time.Time) {
+	cb.open = false
+	cb.windowStart = now
+	cb.attempts, cb.failures = 0, 0
+	cb.probes = map[*request.Request]struct{}{}
+}
+
+// isHostFailure returns if the request's failed attempt is a failure of the
+// endpoint host, such as a connection error, timeout, or 5xx response.
+func isHostFailure(r *request.Request) bool {
+	if r.Error == nil || isCanceled(r) {
+		return false
+	}
+	if r.HTTPResponse == nil || r.HTTPResponse.StatusCode == 0 {
+		return true
+	}
+	return r.HTTPResponse.StatusCode >= 500
+}
+
+func isCircuitOpenError(r *request.Request) bool {
+	aerr, ok := r.Error.(awserr.Error)
+	return ok && aerr.Code() == ErrCodeCircuitOpen
+}
+
+func isCanceled(r *request.Request) bool {
+	aerr, ok := r.Error.(awserr.Error)
+	return ok && aerr.Code() == request.CanceledErrorCode
+}
@@ -0,0 +1,308 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func newCircuitRequest(host string) *request.Request {
+	return &request.Request{
+		HTTPRequest: &http.Request{
+			URL:    &url.URL{Scheme: "https", Host: host},
+			Header: http.Header{},
+		},
+	}
+}
+
+func failCircuitRequest(r *request.Request, status int) {
+	r.HTTPResponse = &http.Response{StatusCode: status}
+	r.Error = awserr.New("MockError", "mock error", nil)
+}
+
+func errCode(err error) string {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code()
+	}
+	return ""
+}
+
+func TestCircuitBreakerRetryer_States(t *testing.T) {
+	clock := &mockClock{}
+	c := NewCircuitBreakerRetryer(DefaultRetryer{},
+		WithCircuitBreakerFailureThreshold(3, 0.4),
+		WithCircuitBreakerWindow(10*time.Second),
+		WithCircuitBreakerOpenTimeout(30*time.Second),
+	)
+	c.now = clock.now
+
+	send := func(host string, status int) *request.Request {
+		r := newCircuitRequest(host)
+		c.allow(r)
+		if r.Error != nil {
+			c.recordSuccess(r)
+			return r
+		}
+		if status == 0 || status >= 300 {
+			failCircuitRequest(r, status)
+			c.recordFailure(r)
+		}
+		c.recordSuccess(r)
+		return r
+	}
+
+	// Client errors, and failures of other hosts, are not failures of the
+	// host.
+	for _, status := range []int{200, 400, 404, 403, 500, 503} {
+		send("a.example.com", status)
+	}
+	send("b.example.com", 500)
+	if e, a := CircuitClosed, c.State("a.example.com"); e != a {
+		t.Fatalf("expect %v state, got %v", e, a)
+	}
+
+	send("a.example.com", 0)
+	if e, a := CircuitOpen, c.State("a.example.com"); e != a {
+		t.Fatalf("expect %v state, got %v", e, a)
+	}
+	if e, a := CircuitClosed, c.State("b.example.com"); e != a {
+		t.Errorf("expect %v state for other host, got %v", e, a)
+	}
+	if e, a := ErrCodeCircuitOpen, errCode(send("a.example.com", 200).Error); e != a {
+		t.Errorf("expect %v error, got %v", e, a)
+	}
+
+	// Half-open, a single probe is sent at a time.
+	clock.seconds += 30
+	if e, a := CircuitHalfOpen, c.State("a.example.com"); e != a {
+		t.Fatalf("expect %v state, got %v", e, a)
+	}
+	probe := newCircuitRequest("a.example.com")
+	c.allow(probe)
+	if probe.Error != nil {
+		t.Fatalf("expect probe to be allowed, got %v", probe.Error)
+	}
+	if e, a := ErrCodeCircuitOpen, errCode(send("a.example.com", 200).Error); e != a {
+		t.Errorf("expect %v error while probe in flight, got %v", e, a)
+	}
+
+	// A failed probe opens the circuit again.
+	failCircuitRequest(probe, 502)
+	c.recordFailure(probe)
+	if e, a := CircuitOpen, c.State("a.example.com"); e != a {
+		t.Fatalf("expect %v state, got %v", e, a)
+	}
+
+	// A successful probe closes the circuit.
+	clock.seconds += 30
+	if r := send("a.example.com", 200); r.Error != nil {
+		t.Fatalf("expect probe to be allowed, got %v", r.Error)
+	}
+	if e, a := CircuitClosed, c.State("a.example.com"); e != a {
+		t.Fatalf("expect %v state, got %v", e, a)
+	}
+
+	expect := map[string]CircuitState{
+		"a.example.com": CircuitClosed,
+		"b.example.com": CircuitClosed,
+	}
+	if e, a := expect, c.States(); len(e) != len(a) || e["a.example.com"] != a["a.example.com"] {
+		t.Errorf("expect %v states, got %v", e, a)
+	}
+}
+
+func TestCircuitBreakerRetryer_FailureRate(t *testing.T) {
+	clock := &mockClock{}
+	c := NewCircuitBreakerRetryer(DefaultRetryer{},
+		WithCircuitBreakerFailureThreshold(2, 0.5),
+		WithCircuitBreakerWindow(10*time.Second),
+	)
+	c.now = clock.now
+
+	record := func(status int) {
+		r := newCircuitRequest("host")
+		c.allow(r)
+		if status >= 300 {
+			failCircuitRequest(r, status)
+			c.recordFailure(r)
+			return
+		}
+		c.recordSuccess(r)
+	}
+
+	// Two failures in five attempts is below the failure rate.
+	for _, status := range []int{200, 500, 200, 200, 500} {
+		record(status)
+	}
+	if e, a := CircuitClosed, c.State("host"); e != a {
+		t.Fatalf("expect %v state, got %v", e, a)
+	}
+
+	// Failures outside of the window are not counted.
+	clock.seconds += 10
+	record(500)
+	if e, a := CircuitClosed, c.State("host"); e != a {
+		t.Fatalf("expect %v state, got %v", e, a)
+	}
+	record(500)
+	if e, a := CircuitOpen, c.State("host"); e != a {
+		t.Fatalf("expect %v state, got %v", e, a)
+	}
+}
+
+func TestCircuitBreakerRetryer_CanceledProbe(t *testing.T) {
+	clock := &mockClock{}
+	c := NewCircuitBreakerRetryer(DefaultRetryer{},
+		WithCircuitBreakerFailureThreshold(1, 1),
+	)
+	c.now = clock.now
+
+	r := newCircuitRequest("host")
+	c.allow(r)
+	failCircuitRequest(r, 0)
+	c.recordFailure(r)
+
+	clock.seconds += DefaultCircuitBreakerOpenTimeout.Seconds()
+	probe := newCircuitRequest("host")
+	c.allow(probe)
+	probe.Error = awserr.New(request.CanceledErrorCode, "canceled", nil)
+	c.recordFailure(probe)
+	c.recordSuccess(probe)
+
+	// The canceled probe is released, without closing the circuit.
+	if e, a := CircuitHalfOpen, c.State("host"); e != a {
+		t.Fatalf("expect %v state, got %v", e, a)
+	}
+	next := newCircuitRequest("host")
+	c.allow(next)
+	if next.Error != nil {
+		t.Errorf("expect next probe to be allowed, got %v", next.Error)
+	}
+}
+
+func TestCircuitBreakerRetryer_FailureBurst(t *testing.T) {
+	var failing int32 = 1
+	var served int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&served, 1)
+		if atomic.LoadInt32(&failing) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+	}))
+	defer server.Close()
+
+	var clockMu sync.Mutex
+	clock := &mockClock{}
+	retryer := NewCircuitBreakerRetryer(DefaultRetryer{NumMaxRetries: 2},
+		WithCircuitBreakerFailureThreshold(5, 0.5),
+		WithCircuitBreakerWindow(time.Minute),
+		WithCircuitBreakerOpenTimeout(time.Minute),
+	)
+	retryer.now = func() time.Time {
+		clockMu.Lock()
+		defer clockMu.Unlock()
+		return clock.now()
+	}
+
+	handlers := request.Handlers{}
+	handlers.Send.PushBackNamed(corehandlers.SendHandler)
+	handlers.ValidateResponse.PushBackNamed(corehandlers.ValidateResponseHandler)
+	handlers.AfterRetry.PushBackNamed(corehandlers.AfterRetryHandler)
+	svc := New(aws.Config{
+		HTTPClient:        http.DefaultClient,
+		Retryer:           retryer,
+		SleepDelay:        func(time.Duration) {},
+		DisableRetryQuota: aws.Bool(true),
+	}, metadata.ClientInfo{Endpoint: server.URL}, handlers)
+
+	sendAll := func(n int) []error {
+		errs := make([]error, n)
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				r := svc.NewRequest(&request.Operation{
+					Name: "Operation", HTTPMethod: "GET", HTTPPath: "/",
+				}, nil, nil)
+				errs[i] = r.Send()
+			}(i)
+		}
+		wg.Wait()
+		return errs
+	}
+
+	host := server.Listener.Addr().String()
+	sendAll(20)
+	if e, a := CircuitOpen, retryer.State(host); e != a {
+		t.Fatalf("expect %v state, got %v", e, a)
+	}
+	if a := atomic.LoadInt32(&served); a >= 20*3 {
+		t.Errorf("expect open circuit to shed attempts, got %d attempts", a)
+	}
+
+	// Requests fail fast while the circuit is open.
+	before := atomic.LoadInt32(&served)
+	for i, err := range sendAll(20) {
+		if e, a := ErrCodeCircuitOpen, errCode(err); e != a {
+			t.Errorf("%d, expect %v error, got %v", i, e, err)
+		}
+	}
+	if e, a := before, atomic.LoadInt32(&served); e != a {
+		t.Errorf("expect no attempts sent while open, got %d", a-e)
+	}
+
+	// The host recovers, and the circuit is closed once the probe succeeds.
+	atomic.StoreInt32(&failing, 0)
+	clockMu.Lock()
+	clock.seconds += time.Minute.Seconds()
+	clockMu.Unlock()
+	if e, a := CircuitHalfOpen, retryer.State(host); e != a {
+		t.Fatalf("expect %v state, got %v", e, a)
+	}
+	if errs := sendAll(1); errs[0] != nil {
+		t.Fatalf("expect probe to succeed, got %v", errs[0])
+	}
+	if e, a := CircuitClosed, retryer.State(host); e != a {
+		t.Fatalf("expect %v state, got %v", e, a)
+	}
+	for i, err := range sendAll(20) {
+		if err != nil {
+			t.Errorf("%d, expect no error, got %v", i, err)
+		}
+	}
+}
+
+func TestNewClient_CircuitBreakerRetryer(t *testing.T) {
+	retryer := NewCircuitBreakerRetryer(&AdaptiveRetryer{})
+	svc := New(aws.Config{
+		Retryer:           retryer,
+		DisableRetryQuota: aws.Bool(true),
+	}, metadata.ClientInfo{}, request.Handlers{})
+
+	// The handlers of both the circuit breaker and the adaptive retryer it
+	// wraps are added.
+	if e, a := 2, svc.Handlers.Sign.Len(); e != a {
+		t.Errorf("expect %d sign handlers, got %d", e, a)
+	}
+	if e, a := 2, svc.Handlers.Retry.Len(); e != a {
+		t.Errorf("expect %d retry handlers, got %d", e, a)
+	}
+	if e, a := 2, svc.Handlers.Complete.Len(); e != a {
+		t.Errorf("expect %d complete handlers, got %d", e, a)
+	}
+	if svc.Retryer != request.Retryer(retryer) {
+		t.Errorf("expect circuit breaker retryer, got %T", svc.Retryer)
+	}
+}
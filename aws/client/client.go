@@ -15,6 +15,12 @@ type Config struct {
 	Endpoint      string
 	SigningRegion string
 	SigningName   string
+
+	// States that the signing name did not come from a modeled source but
+	// was derived based on other data. Used by service client constructors
+	// to determine if the signing name can be overridden based on metadata the
+	// service has.
+	SigningNameDerived bool
 }
 
 // ConfigProvider provides a generic way for a service client to receive
@@ -38,10 +44,27 @@ type Client struct {
 
 	Config   aws.Config
 	Handlers request.Handlers
+
+	// RetryQuota limits the retries made by the client's requests, and
+	// reports the capacity remaining for retries. Nil if the
+	// aws.Config.DisableRetryQuota option is set. May be replaced to change
+	// the quota's capacity.
+	RetryQuota *RetryQuota
 }
 
 // New will return a pointer to a new initialized service client.
+//
+// The log level and logger of the config's ServiceLogLevels and
+// ServiceLoggers for the client's service ID override the config's LogLevel
+// and Logger.
 func New(cfg aws.Config, info metadata.ClientInfo, handlers request.Handlers, options ...func(*Client)) *Client {
+	if level, ok := cfg.ServiceLogLevels[info.ServiceID]; ok && level != nil {
+		cfg.LogLevel = level
+	}
+	if logger, ok := cfg.ServiceLoggers[info.ServiceID]; ok && logger != nil {
+		cfg.Logger = logger
+	}
+
 	svc := &Client{
 		Config:     cfg,
 		ClientInfo: info,
@@ -59,8 +82,18 @@ func New(cfg aws.Config, info metadata.ClientInfo, handlers request.Handlers, op
 		maxRetries := aws.IntValue(cfg.MaxRetries)
 		if cfg.MaxRetries == nil || maxRetries == aws.UseServiceDefaultRetries {
 			maxRetries = 3
+			if aws.StringValue(cfg.RetryMode) == aws.StandardRetryMode {
+				maxRetries = 2
+			}
 		}
-		svc.Retryer = DefaultRetryer{NumMaxRetries: maxRetries}
+		svc.Retryer = newRetryer(cfg, maxRetries)
+	}
+
+	svc.addRetryerHandlers(svc.Retryer)
+
+	if !aws.BoolValue(cfg.DisableRetryQuota) {
+		svc.RetryQuota = NewRetryQuota(DefaultRetryQuotaCapacity)
+		svc.addRetryQuotaHandlers()
 	}
 
 	svc.AddDebugHandlers()
@@ -72,6 +105,42 @@ func New(cfg aws.Config, info metadata.ClientInfo, handlers request.Handlers, op
 	return svc
 }
 
+// addRetryerHandlers adds the handlers of the retryer, and of the retryer it
+// wraps, to the client's handlers.
+func (c *Client) addRetryerHandlers(retryer request.Retryer) {
+	switch r := retryer.(type) {
+	case *AdaptiveRetryer:
+		r.addHandlers(&c.Handlers)
+	case *CircuitBreakerRetryer:
+		r.addHandlers(&c.Handlers)
+		c.addRetryerHandlers(r.Retryer)
+	}
+}
+
+// newRetryer returns the retryer for the config's retry mode, with the
+// config's retry delay options.
+func newRetryer(cfg aws.Config, maxRetries int) request.Retryer {
+	retryer := DefaultRetryer{NumMaxRetries: maxRetries}
+	if opts := cfg.RetryDelayOptions; opts != nil {
+		retryer.MinRetryDelay = opts.MinRetryDelay
+		retryer.MaxRetryDelay = opts.MaxRetryDelay
+		retryer.MinThrottleDelay = opts.MinThrottleDelay
+		retryer.MaxThrottleDelay = opts.MaxThrottleDelay
+		retryer.Jitter = opts.Jitter
+	}
+
+	switch mode := aws.StringValue(cfg.RetryMode); mode {
+	case aws.AdaptiveRetryMode:
+		return &AdaptiveRetryer{DefaultRetryer: retryer}
+	case "", aws.LegacyRetryMode, aws.StandardRetryMode:
+	default:
+		if cfg.Logger != nil {
+			cfg.Logger.Log(fmt.Sprintf("WARNING: unknown retry mode %q; using DefaultRetryer instead", mode))
+		}
+	}
+	return retryer
+}
+
 // NewRequest returns a new Request pointer for the service API
 // operation and parameters.
 func (c *Client) NewRequest(operation *request.Operation, params interface{}, data interface{}) *request.Request {
@@ -76,3 +76,46 @@ func TestNewClient_CopyHandlers(t *testing.T) {
 	}
 
 }
+
+func TestNewClient_ServiceLogLevels(t *testing.T) {
+	var logged int
+	logger := aws.LoggerFunc(func(args ...interface{}) {
+		logged++
+	})
+
+	cfg := aws.NewConfig().
+		WithDisableRetryQuota(true).
+		WithLogLevel(aws.LogOff).
+		WithServiceLogLevel("S3", aws.LogDebugWithHTTPBody).
+		WithServiceLogger("S3", logger).
+		WithServiceLogLevel("Unknown", aws.LogDebug)
+
+	s3Client := New(*cfg, metadata.ClientInfo{ServiceID: "S3"}, request.Handlers{})
+	ddbClient := New(*cfg, metadata.ClientInfo{ServiceID: "DynamoDB"}, request.Handlers{})
+
+	if e, a := aws.LogDebugWithHTTPBody, s3Client.Config.LogLevel.Value(); e != a {
+		t.Errorf("expect S3 client log level %v, got %v", e, a)
+	}
+	if e, a := aws.LogOff, ddbClient.Config.LogLevel.Value(); e != a {
+		t.Errorf("expect DynamoDB client log level %v, got %v", e, a)
+	}
+	if e, a := 2, s3Client.Handlers.Send.Len(); e != a {
+		t.Errorf("expect S3 client debug handlers, got %d send handlers", a)
+	}
+	if e, a := 0, ddbClient.Handlers.Send.Len(); e != a {
+		t.Errorf("expect no DynamoDB client debug handlers, got %d send handlers", a)
+	}
+
+	s3Client.Config.Logger.Log("message")
+	if e, a := 1, logged; e != a {
+		t.Errorf("expect %d message logged by S3 logger, got %d", e, a)
+	}
+	if ddbClient.Config.Logger != nil {
+		t.Errorf("expect DynamoDB client to not have a logger")
+	}
+
+	// The shared config is not modified by the clients.
+	if e, a := aws.LogOff, cfg.LogLevel.Value(); e != a {
+		t.Errorf("expect config log level %v, got %v", e, a)
+	}
+}
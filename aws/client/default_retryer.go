@@ -1,7 +1,10 @@
 package client
 
 import (
+	"math"
 	"math/rand"
+	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -22,8 +25,46 @@ import (
 //    func (d retryer) MaxRetries() int { return 100 }
 type DefaultRetryer struct {
 	NumMaxRetries int
+
+	// The minimum and maximum delay before a request which failed with an
+	// error other than throttling is retried. Default to
+	// DefaultRetryerMinRetryDelay, and DefaultRetryerMaxRetryDelay if zero.
+	MinRetryDelay time.Duration
+	MaxRetryDelay time.Duration
+
+	// The minimum and maximum delay before a throttled request is retried.
+	// Default to DefaultRetryerMinThrottleDelay, and
+	// DefaultRetryerMaxThrottleDelay if zero.
+	MinThrottleDelay time.Duration
+	MaxThrottleDelay time.Duration
+
+	// Jitter returns the randomized delay of a retry from the retry's
+	// exponential backoff delay, such as FullJitter or EqualJitter. The
+	// jittered delay is limited to the minimum and maximum delays. If nil,
+	// a random number of whole milliseconds, up to the minimum delay, is
+	// added to the minimum delay before it is backed off, e.g. a first
+	// retry delay of 30-59ms, and a second of 60-118ms by default.
+	Jitter func(time.Duration) time.Duration
+
+	// MaxRetryAfterDelay is the maximum delay a response's Retry-After, or
+	// x-amz-retry-after-ms header will delay the request's retry by. Longer
+	// delays are capped at the maximum. Defaults to DefaultMaxRetryAfterDelay
+	// if zero.
+	MaxRetryAfterDelay time.Duration
 }
 
+// Default delays of the DefaultRetryer.
+const (
+	DefaultRetryerMinRetryDelay    = 30 * time.Millisecond
+	DefaultRetryerMaxRetryDelay    = 300 * time.Second
+	DefaultRetryerMinThrottleDelay = 500 * time.Millisecond
+	DefaultRetryerMaxThrottleDelay = 300 * time.Second
+)
+
+// DefaultMaxRetryAfterDelay is the default maximum delay of a retry which
+// was requested by the service with the response's Retry-After header.
+const DefaultMaxRetryAfterDelay = 20 * time.Second
+
 // MaxRetries returns the number of maximum returns the service will use to make
 // an individual API request.
 func (d DefaultRetryer) MaxRetries() int {
@@ -32,24 +73,89 @@ func (d DefaultRetryer) MaxRetries() int {
 
 var seededRand = rand.New(&lockedSource{src: rand.NewSource(time.Now().UnixNano())})
 
-// RetryRules returns the delay duration before retrying this request again
+// RetryRules returns the delay duration before retrying this request again.
+// If the service responded with a 429 or 503 status code, and the delay to
+// retry after in the response's x-amz-retry-after-ms, or Retry-After header,
+// that delay is used, up to the retryer's MaxRetryAfterDelay.
 func (d DefaultRetryer) RetryRules(r *request.Request) time.Duration {
-	// Set the upper limit of delay in retrying at ~five minutes
-	minTime := 30
-	throttle := d.shouldThrottle(r)
-	if throttle {
-		minTime = 500
+	if delay, ok := retryAfterDelay(r); ok {
+		max := d.MaxRetryAfterDelay
+		if max <= 0 {
+			max = DefaultMaxRetryAfterDelay
+		}
+		if delay > max {
+			delay = max
+		}
+		return delay
+	}
+
+	minDelay, maxDelay := d.MinRetryDelay, d.MaxRetryDelay
+	if minDelay <= 0 {
+		minDelay = DefaultRetryerMinRetryDelay
+	}
+	if maxDelay <= 0 {
+		maxDelay = DefaultRetryerMaxRetryDelay
+	}
+	if d.shouldThrottle(r) {
+		minDelay, maxDelay = d.MinThrottleDelay, d.MaxThrottleDelay
+		if minDelay <= 0 {
+			minDelay = DefaultRetryerMinThrottleDelay
+		}
+		if maxDelay <= 0 {
+			maxDelay = DefaultRetryerMaxThrottleDelay
+		}
+	}
+
+	delay := minDelay
+	if d.Jitter == nil {
+		delay = jitterMinDelay(minDelay)
+	}
+
+	// Exponential backoff from the minimum delay, stopping once the
+	// maximum is reached to not overflow.
+	for i := 0; i < r.RetryCount && delay < maxDelay && delay <= math.MaxInt64/2; i++ {
+		delay *= 2
 	}
 
-	retryCount := r.RetryCount
-	if retryCount > 13 {
-		retryCount = 13
-	} else if throttle && retryCount > 8 {
-		retryCount = 8
+	if d.Jitter != nil {
+		delay = d.Jitter(delay)
 	}
 
-	delay := (1 << uint(retryCount)) * (seededRand.Intn(minTime) + minTime)
-	return time.Duration(delay) * time.Millisecond
+	if delay < minDelay {
+		delay = minDelay
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// FullJitter returns a random delay between zero and the backoff delay.
+// Set as the DefaultRetryer's Jitter to spread out the retries of many
+// clients the most, at the cost of some retries being sent sooner.
+func FullJitter(delay time.Duration) time.Duration {
+	if delay <= 0 {
+		return 0
+	}
+	return time.Duration(seededRand.Int63n(int64(delay)))
+}
+
+// EqualJitter returns a random delay between half of the backoff delay, and
+// the backoff delay.
+func EqualJitter(delay time.Duration) time.Duration {
+	half := delay / 2
+	return half + FullJitter(delay-half)
+}
+
+// jitterMinDelay returns the minimum delay with a random number of whole
+// milliseconds, up to the minimum delay, added to it. The DefaultRetryer's
+// jitter if its Jitter is nil, applied before the delay is backed off.
+func jitterMinDelay(minDelay time.Duration) time.Duration {
+	ms := int64(minDelay / time.Millisecond)
+	if ms <= 0 {
+		return minDelay
+	}
+	return minDelay + time.Duration(seededRand.Int63n(ms))*time.Millisecond
 }
 
 // ShouldRetry returns true if the request should be retried.
@@ -76,6 +182,57 @@ func (d DefaultRetryer) shouldThrottle(r *request.Request) bool {
 	return r.IsErrorThrottle()
 }
 
+// retryAfterDelay returns the delay the service requested the request be
+// retried after, and if the delay was set. The x-amz-retry-after-ms header's
+// milliseconds are used before the Retry-After header's delay seconds or
+// HTTP date. Invalid values are ignored.
+func retryAfterDelay(r *request.Request) (time.Duration, bool) {
+	if r.HTTPResponse == nil {
+		return 0, false
+	}
+	switch r.HTTPResponse.StatusCode {
+	case 429, 503:
+	default:
+		return 0, false
+	}
+
+	header := r.HTTPResponse.Header
+	if v := header.Get("x-amz-retry-after-ms"); len(v) != 0 {
+		if ms, err := strconv.ParseInt(v, 10, 64); err == nil && ms >= 0 {
+			return durationOf(ms, time.Millisecond), true
+		}
+	}
+
+	v := header.Get("Retry-After")
+	if len(v) == 0 {
+		return 0, false
+	}
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return durationOf(secs, time.Second), true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		delay := t.Sub(time.Now())
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// durationOf returns n units as a duration, limited to the maximum duration
+// instead of overflowing.
+func durationOf(n int64, unit time.Duration) time.Duration {
+	if n > int64(math.MaxInt64/unit) {
+		return math.MaxInt64
+	}
+	return time.Duration(n) * unit
+}
+
 // lockedSource is a thread-safe implementation of rand.Source
 type lockedSource struct {
 	lk  sync.Mutex
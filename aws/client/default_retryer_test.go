@@ -0,0 +1,325 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestRetryRules_DelayBounds(t *testing.T) {
+	cases := map[string]struct {
+		Retryer    DefaultRetryer
+		StatusCode int
+		RetryCount int
+		ExpectMin  time.Duration
+		ExpectMax  time.Duration
+	}{
+		"default": {
+			StatusCode: 500,
+			ExpectMin:  30 * time.Millisecond,
+			ExpectMax:  59 * time.Millisecond,
+		},
+		"default retry count": {
+			StatusCode: 500,
+			RetryCount: 2,
+			ExpectMin:  120 * time.Millisecond,
+			ExpectMax:  236 * time.Millisecond,
+		},
+		"default throttle": {
+			StatusCode: 503,
+			RetryCount: 1,
+			ExpectMin:  1000 * time.Millisecond,
+			ExpectMax:  1998 * time.Millisecond,
+		},
+		"default max": {
+			StatusCode: 500,
+			RetryCount: 100,
+			ExpectMin:  DefaultRetryerMaxRetryDelay,
+			ExpectMax:  DefaultRetryerMaxRetryDelay,
+		},
+		"min delay": {
+			Retryer: DefaultRetryer{
+				MinRetryDelay: time.Second,
+				Jitter:        FullJitter,
+			},
+			StatusCode: 500,
+			ExpectMin:  time.Second,
+			ExpectMax:  time.Second,
+		},
+		"max delay": {
+			Retryer:    DefaultRetryer{MaxRetryDelay: 100 * time.Millisecond},
+			StatusCode: 500,
+			RetryCount: 5,
+			ExpectMin:  100 * time.Millisecond,
+			ExpectMax:  100 * time.Millisecond,
+		},
+		"full jitter": {
+			Retryer: DefaultRetryer{
+				MinRetryDelay: time.Millisecond,
+				Jitter:        FullJitter,
+			},
+			StatusCode: 500,
+			RetryCount: 4,
+			ExpectMin:  time.Millisecond,
+			ExpectMax:  16 * time.Millisecond,
+		},
+		"equal jitter": {
+			Retryer: DefaultRetryer{
+				MinRetryDelay: 10 * time.Millisecond,
+				Jitter:        EqualJitter,
+			},
+			StatusCode: 500,
+			RetryCount: 2,
+			ExpectMin:  20 * time.Millisecond,
+			ExpectMax:  40 * time.Millisecond,
+		},
+		"throttle delays": {
+			Retryer: DefaultRetryer{
+				MinRetryDelay:    time.Millisecond,
+				MaxRetryDelay:    2 * time.Millisecond,
+				MinThrottleDelay: time.Second,
+				MaxThrottleDelay: 3 * time.Second,
+				Jitter:           EqualJitter,
+			},
+			StatusCode: 502,
+			RetryCount: 1,
+			ExpectMin:  time.Second,
+			ExpectMax:  2 * time.Second,
+		},
+		"throttle max": {
+			Retryer: DefaultRetryer{
+				MinThrottleDelay: time.Second,
+				MaxThrottleDelay: 3 * time.Second,
+			},
+			StatusCode: 504,
+			RetryCount: 5,
+			ExpectMin:  3 * time.Second,
+			ExpectMax:  3 * time.Second,
+		},
+	}
+
+	for name, c := range cases {
+		r := &request.Request{
+			HTTPResponse: &http.Response{StatusCode: c.StatusCode, Header: http.Header{}},
+			RetryCount:   c.RetryCount,
+		}
+
+		for i := 0; i < 100; i++ {
+			delay := c.Retryer.RetryRules(r)
+			if delay < c.ExpectMin || delay > c.ExpectMax {
+				t.Fatalf("%s, expect delay between %v and %v, got %v",
+					name, c.ExpectMin, c.ExpectMax, delay)
+			}
+		}
+	}
+}
+
+func TestRetryRules_DefaultJitterGranularity(t *testing.T) {
+	for retryCount := 0; retryCount < 4; retryCount++ {
+		r := &request.Request{
+			HTTPResponse: &http.Response{StatusCode: 500, Header: http.Header{}},
+			RetryCount:   retryCount,
+		}
+
+		// The minimum delay is jittered by whole milliseconds before it is
+		// backed off.
+		step := time.Duration(1<<uint(retryCount)) * time.Millisecond
+		for i := 0; i < 100; i++ {
+			delay := DefaultRetryer{}.RetryRules(r)
+			if delay%step != 0 {
+				t.Fatalf("%d, expect delay to be a multiple of %v, got %v", retryCount, step, delay)
+			}
+		}
+	}
+}
+
+func TestNewClient_RetryDelayOptions(t *testing.T) {
+	cfg := aws.NewConfig().WithRetryDelayOptions(aws.RetryDelayOptions{
+		MinRetryDelay:    time.Second,
+		MaxRetryDelay:    2 * time.Second,
+		MinThrottleDelay: 3 * time.Second,
+		MaxThrottleDelay: 4 * time.Second,
+		Jitter:           FullJitter,
+	})
+
+	svc := New(*cfg, metadata.ClientInfo{}, request.Handlers{})
+	retryer, ok := svc.Retryer.(DefaultRetryer)
+	if !ok {
+		t.Fatalf("expect DefaultRetryer, got %T", svc.Retryer)
+	}
+	if e, a := time.Second, retryer.MinRetryDelay; e != a {
+		t.Errorf("expect %v min retry delay, got %v", e, a)
+	}
+	if e, a := 2*time.Second, retryer.MaxRetryDelay; e != a {
+		t.Errorf("expect %v max retry delay, got %v", e, a)
+	}
+	if e, a := 3*time.Second, retryer.MinThrottleDelay; e != a {
+		t.Errorf("expect %v min throttle delay, got %v", e, a)
+	}
+	if e, a := 4*time.Second, retryer.MaxThrottleDelay; e != a {
+		t.Errorf("expect %v max throttle delay, got %v", e, a)
+	}
+	if retryer.Jitter == nil {
+		t.Errorf("expect jitter to be set")
+	}
+
+	svc = New(*cfg.WithRetryMode(aws.AdaptiveRetryMode).WithDisableRetryQuota(true),
+		metadata.ClientInfo{}, request.Handlers{})
+	adaptive, ok := svc.Retryer.(*AdaptiveRetryer)
+	if !ok {
+		t.Fatalf("expect AdaptiveRetryer, got %T", svc.Retryer)
+	}
+	if e, a := time.Second, adaptive.MinRetryDelay; e != a {
+		t.Errorf("expect %v min retry delay, got %v", e, a)
+	}
+}
+
+func TestNewClient_StandardRetryModeMaxRetries(t *testing.T) {
+	cases := map[string]struct {
+		Config aws.Config
+		Expect int
+	}{
+		"legacy": {
+			Config: aws.Config{RetryMode: aws.String(aws.LegacyRetryMode)},
+			Expect: 3,
+		},
+		"standard": {
+			Config: aws.Config{RetryMode: aws.String(aws.StandardRetryMode)},
+			Expect: 2,
+		},
+		"standard service default": {
+			Config: aws.Config{
+				RetryMode:  aws.String(aws.StandardRetryMode),
+				MaxRetries: aws.Int(aws.UseServiceDefaultRetries),
+			},
+			Expect: 2,
+		},
+		"standard max retries": {
+			Config: aws.Config{
+				RetryMode:  aws.String(aws.StandardRetryMode),
+				MaxRetries: aws.Int(5),
+			},
+			Expect: 5,
+		},
+	}
+
+	for name, c := range cases {
+		c.Config.DisableRetryQuota = aws.Bool(true)
+		svc := New(c.Config, metadata.ClientInfo{}, request.Handlers{})
+
+		if _, ok := svc.Retryer.(DefaultRetryer); !ok {
+			t.Errorf("%s, expect DefaultRetryer, got %T", name, svc.Retryer)
+		}
+		if e, a := c.Expect, svc.MaxRetries(); e != a {
+			t.Errorf("%s, expect %d max retries, got %d", name, e, a)
+		}
+	}
+}
+
+func TestRetryRules_RetryAfter(t *testing.T) {
+	cases := map[string]struct {
+		StatusCode  int
+		Header      http.Header
+		MaxDelay    time.Duration
+		ExpectDelay time.Duration
+		Tolerance   time.Duration
+		ExpectRules bool
+	}{
+		"delta seconds": {
+			StatusCode:  503,
+			Header:      http.Header{"Retry-After": []string{"3"}},
+			ExpectDelay: 3 * time.Second,
+		},
+		"http date": {
+			StatusCode: 429,
+			Header: http.Header{"Retry-After": []string{
+				time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat),
+			}},
+			ExpectDelay: 10 * time.Second,
+			// The HTTP date only has second precision.
+			Tolerance: 2 * time.Second,
+		},
+		"http date in past": {
+			StatusCode: 429,
+			Header: http.Header{"Retry-After": []string{
+				time.Now().Add(-10 * time.Second).UTC().Format(http.TimeFormat),
+			}},
+			ExpectDelay: 0,
+		},
+		"milliseconds": {
+			StatusCode: 503,
+			Header: http.Header{
+				"Retry-After":          []string{"3"},
+				"X-Amz-Retry-After-Ms": []string{"1500"},
+			},
+			ExpectDelay: 1500 * time.Millisecond,
+		},
+		"capped": {
+			StatusCode:  503,
+			Header:      http.Header{"Retry-After": []string{"3600"}},
+			ExpectDelay: DefaultMaxRetryAfterDelay,
+		},
+		"capped overflow": {
+			StatusCode:  503,
+			Header:      http.Header{"Retry-After": []string{"9223372036854775807"}},
+			ExpectDelay: DefaultMaxRetryAfterDelay,
+		},
+		"custom max": {
+			StatusCode:  503,
+			Header:      http.Header{"Retry-After": []string{"10"}},
+			MaxDelay:    5 * time.Second,
+			ExpectDelay: 5 * time.Second,
+		},
+		"garbage": {
+			StatusCode:  503,
+			Header:      http.Header{"Retry-After": []string{"soon"}},
+			ExpectRules: true,
+		},
+		"negative": {
+			StatusCode:  503,
+			Header:      http.Header{"Retry-After": []string{"-1"}},
+			ExpectRules: true,
+		},
+		"absent": {
+			StatusCode:  503,
+			Header:      http.Header{},
+			ExpectRules: true,
+		},
+		"other status code": {
+			StatusCode:  500,
+			Header:      http.Header{"Retry-After": []string{"3"}},
+			ExpectRules: true,
+		},
+	}
+
+	for name, c := range cases {
+		d := DefaultRetryer{NumMaxRetries: 3, MaxRetryAfterDelay: c.MaxDelay}
+		r := &request.Request{
+			HTTPResponse: &http.Response{
+				StatusCode: c.StatusCode,
+				Header:     c.Header,
+			},
+		}
+
+		delay := d.RetryRules(r)
+		if c.ExpectRules {
+			// exponential backoff of the first attempt, with jitter.
+			if delay < 30*time.Millisecond || delay >= 1000*time.Millisecond {
+				t.Errorf("%s, expect default delay, got %v", name, delay)
+			}
+			continue
+		}
+
+		diff := c.ExpectDelay - delay
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff > c.Tolerance {
+			t.Errorf("%s, expect %v delay, got %v", name, c.ExpectDelay, delay)
+		}
+	}
+}
@@ -2,13 +2,19 @@ package client
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
-	"io/ioutil"
+	"mime"
+	"net/http"
 	"net/http/httputil"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol/redact"
 )
 
 const logReqMsg = `DEBUG: Request %s/%s Details:
@@ -21,15 +27,136 @@ const logReqErrMsg = `DEBUG ERROR: Request %s/%s:
 %s
 ------------------------------------------------------`
 
-type logWriter struct {
-	// Logger is what we will use to log the payload of a response.
-	Logger aws.Logger
-	// buf stores the contents of what has been read
-	buf *bytes.Buffer
+// DefaultLogBodyMaxBytes is the default maximum number of bytes of an HTTP
+// request or response body logged.
+const DefaultLogBodyMaxBytes int64 = 64 * 1024
+
+// binarySniffLen is the number of bytes of a body sniffed for null bytes to
+// detect binary content.
+const binarySniffLen = 512
+
+// A bodyLog collects the bytes of an HTTP body to be logged, up to the
+// maximum number of bytes logged, and the size and SHA256 hash of all the
+// bytes written.
+type bodyLog struct {
+	// max is the maximum number of bytes kept, no limit if less than or
+	// equal to zero.
+	max  int64
+	buf  bytes.Buffer
+	size int64
+	hash hash.Hash
+}
+
+func newBodyLog(cfg aws.Config) *bodyLog {
+	max := DefaultLogBodyMaxBytes
+	if cfg.LogBodyMaxBytes != nil {
+		max = *cfg.LogBodyMaxBytes
+	}
+
+	return &bodyLog{max: max, hash: sha256.New()}
+}
+
+func (l *bodyLog) Write(b []byte) (int, error) {
+	l.size += int64(len(b))
+	l.hash.Write(b)
+
+	keep := b
+	if l.max > 0 {
+		if remain := l.max - int64(l.buf.Len()); remain < int64(len(keep)) {
+			if remain < 0 {
+				remain = 0
+			}
+			keep = keep[:remain]
+		}
+	}
+	l.buf.Write(keep)
+
+	return len(b), nil
+}
+
+// truncated returns the number of bytes written which were not kept.
+func (l *bodyLog) truncated() int64 {
+	return l.size - int64(l.buf.Len())
 }
 
-func (logger *logWriter) Write(b []byte) (int, error) {
-	return logger.buf.Write(b)
+// String returns the body to be logged. Binary bodies are summarized by their
+// size and hash unless logBinary is set. Truncated bodies are marked with the
+// number of bytes truncated.
+func (l *bodyLog) String(header http.Header, logBinary bool) string {
+	if l.isSummarized(header, logBinary) {
+		return fmt.Sprintf("<binary body, %d bytes, sha256=%s>",
+			l.size, hex.EncodeToString(l.hash.Sum(nil)))
+	}
+
+	if n := l.truncated(); n > 0 {
+		return fmt.Sprintf("%s[truncated %d bytes]", l.buf.String(), n)
+	}
+	return l.buf.String()
+}
+
+// isSummarized returns if the body is logged as a summary of its size and
+// hash, rather than its content.
+func (l *bodyLog) isSummarized(header http.Header, logBinary bool) bool {
+	return !logBinary && isBinaryBody(header, l.buf.Bytes())
+}
+
+// isBinaryBody returns if the body is binary, based on the body's
+// Content-Type and Content-Encoding headers, or if the start of the body
+// contains null bytes.
+func isBinaryBody(header http.Header, body []byte) bool {
+	if enc := header.Get("Content-Encoding"); len(enc) != 0 && enc != "identity" {
+		return true
+	}
+
+	if contentType := header.Get("Content-Type"); len(contentType) != 0 {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err == nil {
+			if isTextMediaType(mediaType) {
+				return false
+			}
+			if isBinaryMediaType(mediaType) {
+				return true
+			}
+		}
+	}
+
+	if len(body) > binarySniffLen {
+		body = body[:binarySniffLen]
+	}
+	return bytes.IndexByte(body, 0) >= 0
+}
+
+func isTextMediaType(mediaType string) bool {
+	switch {
+	case strings.HasPrefix(mediaType, "text/"),
+		strings.HasSuffix(mediaType, "+json"),
+		strings.HasSuffix(mediaType, "+xml"),
+		strings.HasPrefix(mediaType, "application/x-amz-json"):
+		return true
+	}
+
+	switch mediaType {
+	case "application/json", "application/xml", "application/x-www-form-urlencoded":
+		return true
+	}
+	return false
+}
+
+func isBinaryMediaType(mediaType string) bool {
+	switch {
+	case strings.HasPrefix(mediaType, "image/"),
+		strings.HasPrefix(mediaType, "audio/"),
+		strings.HasPrefix(mediaType, "video/"):
+		return true
+	}
+
+	switch mediaType {
+	case "application/octet-stream", "binary/octet-stream",
+		"application/zip", "application/gzip", "application/x-gzip",
+		"application/pdf":
+		return true
+	}
+	return false
 }
 
 type teeReaderCloser struct {
@@ -46,22 +173,151 @@ func (reader *teeReaderCloser) Close() error {
 
 func logRequest(r *request.Request) {
 	logBody := r.Config.LogLevel.Matches(aws.LogDebugWithHTTPBody)
-	dumpedBody, err := httputil.DumpRequestOut(r.HTTPRequest, logBody)
+
+	// The request is dumped from a copy, so that the headers of the request
+	// sent are not redacted.
+	httpReq := *r.HTTPRequest
+	httpReq.Header = redactHeaders(httpReq.Header, r.SensitiveHeaders)
+	dumpedBody, err := httputil.DumpRequestOut(&httpReq, false)
 	if err != nil {
 		r.Config.Logger.Log(fmt.Sprintf(logReqErrMsg, r.ClientInfo.ServiceName, r.Operation.Name, err))
 		return
 	}
 
 	if logBody {
-		// Reset the request body because dumpRequest will re-wrap the r.HTTPRequest's
-		// Body as a NoOpCloser and will not be reset after read by the HTTP
-		// client reader.
-		r.ResetBody()
+		body, err := requestBodyLog(r)
+		if err != nil {
+			r.Config.Logger.Log(fmt.Sprintf(logReqErrMsg, r.ClientInfo.ServiceName, r.Operation.Name, err))
+			return
+		}
+		dumpedBody = append(dumpedBody, body...)
 	}
 
 	r.Config.Logger.Log(fmt.Sprintf(logReqMsg, r.ClientInfo.ServiceName, r.Operation.Name, string(dumpedBody)))
 }
 
+// requestBodyLog returns the request's body to be logged. The body is read
+// from the request's Body, which is reset to the start of the request's
+// payload once read. Bodies which cannot be rewound are not read.
+func requestBodyLog(r *request.Request) (string, error) {
+	if r.Body == nil {
+		return "", nil
+	}
+	if !aws.IsReaderSeekable(r.Body) {
+		return "<streaming body not logged, body is not seekable>", nil
+	}
+	if r.StreamingPayload {
+		// The body is signed as it is read, and cannot be rewound.
+		return "<streaming body not logged, body is signed in chunks>", nil
+	}
+
+	l := newBodyLog(r.Config)
+	logBinary := r.Config.LogLevel.Matches(aws.LogDebugWithBinaryBody)
+
+	if _, err := r.Body.Seek(r.BodyStart, 0); err != nil {
+		return "", err
+	}
+	// Read up to the maximum number of bytes logged. The rest of the body
+	// is only read to compute the hash of binary bodies.
+	var err error
+	if l.max > 0 {
+		_, err = io.CopyN(l, r.Body, l.max)
+		if err == nil {
+			if l.isSummarized(r.HTTPRequest.Header, logBinary) {
+				_, err = io.Copy(l, r.Body)
+			} else {
+				var end int64
+				end, err = r.Body.Seek(0, 2)
+				l.size = end - r.BodyStart
+			}
+		}
+	} else {
+		_, err = io.Copy(l, r.Body)
+	}
+	if err == io.EOF {
+		err = nil
+	}
+	r.ResetBody()
+	if err != nil {
+		return "", err
+	}
+
+	return l.redactedString(r.HTTPRequest.Header, logBinary, r.SensitiveBodyPaths), nil
+}
+
+// redactedString returns the body to be logged as String does, with the
+// values of the members at the sensitive paths redacted. Truncated bodies
+// cannot be parsed, and are redacted entirely if any path is sensitive.
+func (l *bodyLog) redactedString(header http.Header, logBinary bool, paths []string) string {
+	if len(paths) == 0 || l.isSummarized(header, logBinary) {
+		return l.String(header, logBinary)
+	}
+	if l.truncated() > 0 {
+		return redact.Value
+	}
+
+	return string(redactBody(header, l.buf.Bytes(), paths))
+}
+
+// redactBody replaces the values of sensitive members in the logged body.
+// The logged body is a copy of the request's or response's body, so the
+// body sent or read is not modified. Form encoded bodies are identified by
+// their Content-Type, and JSON and XML bodies by their first character. If
+// the body cannot be parsed the whole body is redacted.
+func redactBody(header http.Header, body []byte, paths []string) []byte {
+	if len(paths) == 0 {
+		return body
+	}
+
+	var redacted []byte
+	var err error
+	switch trimmed := bytes.TrimSpace(body); {
+	case len(trimmed) == 0:
+		return body
+	case isFormBody(header):
+		redacted, err = redact.Query(body, paths)
+	case trimmed[0] == '{' || trimmed[0] == '[':
+		redacted, err = redact.JSON(body, paths)
+	case trimmed[0] == '<':
+		redacted, err = redact.XML(body, paths)
+	default:
+		err = fmt.Errorf("unknown body format")
+	}
+	if err != nil {
+		return []byte(redact.Value)
+	}
+
+	return redacted
+}
+
+// isFormBody returns if the body is form encoded, as the bodies of query
+// protocol requests are.
+func isFormBody(header http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	return err == nil && mediaType == "application/x-www-form-urlencoded"
+}
+
+// redactHeaders returns a copy of the header with the values of the
+// sensitive headers redacted. The header is returned if no header is
+// sensitive.
+func redactHeaders(header http.Header, names []string) http.Header {
+	if len(names) == 0 {
+		return header
+	}
+
+	redacted := make(http.Header, len(header))
+	for k, v := range header {
+		redacted[k] = v
+	}
+	for _, k := range names {
+		if _, ok := redacted[http.CanonicalHeaderKey(k)]; ok {
+			redacted.Set(k, redact.Value)
+		}
+	}
+
+	return redacted
+}
+
 const logRespMsg = `DEBUG: Response %s/%s Details:
 ---[ RESPONSE ]--------------------------------------
 %s
@@ -73,36 +329,47 @@ const logRespErrMsg = `DEBUG ERROR: Response %s/%s:
 -----------------------------------------------------`
 
 func logResponse(r *request.Request) {
-	lw := &logWriter{r.Config.Logger, bytes.NewBuffer(nil)}
+	l := newBodyLog(r.Config)
 	r.HTTPResponse.Body = &teeReaderCloser{
-		Reader: io.TeeReader(r.HTTPResponse.Body, lw),
+		Reader: io.TeeReader(r.HTTPResponse.Body, l),
 		Source: r.HTTPResponse.Body,
 	}
 
-	handlerFn := func(req *request.Request) {
-		body, err := httputil.DumpResponse(req.HTTPResponse, false)
-		if err != nil {
-			lw.Logger.Log(fmt.Sprintf(logRespErrMsg, req.ClientInfo.ServiceName, req.Operation.Name, err))
-			return
-		}
+	// The members of the operation's output are only redacted from the body
+	// of successful responses. Error responses are logged unredacted, since
+	// they are not the operation's output.
+	handlerFn := func(sensitivePaths []string, sensitiveBody bool) func(*request.Request) {
+		return func(req *request.Request) {
+			logger := req.Config.Logger
 
-		b, err := ioutil.ReadAll(lw.buf)
-		if err != nil {
-			lw.Logger.Log(fmt.Sprintf(logRespErrMsg, req.ClientInfo.ServiceName, req.Operation.Name, err))
-			return
-		}
-		lw.Logger.Log(fmt.Sprintf(logRespMsg, req.ClientInfo.ServiceName, req.Operation.Name, string(body)))
-		if req.Config.LogLevel.Matches(aws.LogDebugWithHTTPBody) {
-			lw.Logger.Log(string(b))
+			// The response is dumped from a copy, so that the headers of
+			// the response read are not redacted.
+			httpResp := *req.HTTPResponse
+			httpResp.Header = redactHeaders(httpResp.Header, req.Operation.SensitiveOutputHeaders)
+			body, err := httputil.DumpResponse(&httpResp, false)
+			if err != nil {
+				logger.Log(fmt.Sprintf(logRespErrMsg, req.ClientInfo.ServiceName, req.Operation.Name, err))
+				return
+			}
+
+			logger.Log(fmt.Sprintf(logRespMsg, req.ClientInfo.ServiceName, req.Operation.Name, string(body)))
+			if req.Config.LogLevel.Matches(aws.LogDebugWithHTTPBody) {
+				logBinary := req.Config.LogLevel.Matches(aws.LogDebugWithBinaryBody)
+				if sensitiveBody && !l.isSummarized(req.HTTPResponse.Header, logBinary) {
+					logger.Log(redact.Value)
+					return
+				}
+				logger.Log(l.redactedString(req.HTTPResponse.Header, logBinary, sensitivePaths))
+			}
 		}
 	}
 
 	const handlerName = "awsdk.client.LogResponse.ResponseBody"
 
 	r.Handlers.Unmarshal.SetBackNamed(request.NamedHandler{
-		Name: handlerName, Fn: handlerFn,
+		Name: handlerName, Fn: handlerFn(r.Operation.SensitiveOutputPaths, r.Operation.SensitiveOutputBody),
 	})
 	r.Handlers.UnmarshalError.SetBackNamed(request.NamedHandler{
-		Name: handlerName, Fn: handlerFn,
+		Name: handlerName, Fn: handlerFn(nil, false),
 	})
 }
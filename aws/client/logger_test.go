@@ -2,8 +2,18 @@ package client
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
 )
 
 type mockCloser struct {
@@ -46,12 +56,396 @@ func TestTeeReaderCloser(t *testing.T) {
 	}
 }
 
-func TestLogWriter(t *testing.T) {
+func TestBodyLog(t *testing.T) {
 	expected := "FOO"
-	lw := &logWriter{nil, bytes.NewBuffer(nil)}
-	lw.Write([]byte(expected))
+	l := newBodyLog(aws.Config{})
+	l.Write([]byte(expected))
+
+	if expected != l.buf.String() {
+		t.Errorf("Expected %q, but received %q", expected, l.buf.String())
+	}
+	if e, a := expected, l.String(http.Header{}, false); e != a {
+		t.Errorf("expect %q, got %q", e, a)
+	}
+}
+
+func TestBodyLog_Truncated(t *testing.T) {
+	l := newBodyLog(*aws.NewConfig().WithLogBodyMaxBytes(4))
+	l.Write([]byte("abc"))
+	l.Write([]byte("defgh"))
+
+	if e, a := "abcd[truncated 4 bytes]", l.String(http.Header{}, false); e != a {
+		t.Errorf("expect %q, got %q", e, a)
+	}
+
+	l = newBodyLog(*aws.NewConfig().WithLogBodyMaxBytes(0))
+	l.Write(bytes.Repeat([]byte("a"), int(DefaultLogBodyMaxBytes)+1))
+	if e, a := int64(0), l.truncated(); e != a {
+		t.Errorf("expect %d bytes truncated with no limit, got %d", e, a)
+	}
+}
+
+func TestIsBinaryBody(t *testing.T) {
+	cases := map[string]struct {
+		Header http.Header
+		Body   string
+		Expect bool
+	}{
+		"json":          {Header: http.Header{"Content-Type": {"application/x-amz-json-1.1"}}, Body: `{}`},
+		"xml":           {Header: http.Header{"Content-Type": {"text/xml; charset=utf-8"}}, Body: "<a/>"},
+		"octet stream":  {Header: http.Header{"Content-Type": {"binary/octet-stream"}}, Body: "abc", Expect: true},
+		"encoded":       {Header: http.Header{"Content-Encoding": {"gzip"}}, Body: "abc", Expect: true},
+		"sniffed":       {Header: http.Header{}, Body: "a\x00b", Expect: true},
+		"sniffed text":  {Header: http.Header{}, Body: "abc"},
+		"unknown type":  {Header: http.Header{"Content-Type": {"application/x-custom"}}, Body: "a\x00b", Expect: true},
+		"text with nul": {Header: http.Header{"Content-Type": {"application/json"}}, Body: "a\x00b"},
+	}
+
+	for name, c := range cases {
+		if e, a := c.Expect, isBinaryBody(c.Header, []byte(c.Body)); e != a {
+			t.Errorf("%s, expect binary %t, got %t", name, e, a)
+		}
+	}
+}
+
+func TestLogRequestBody(t *testing.T) {
+	binary := bytes.Repeat([]byte{0, 1, 2, 3}, 1024)
+	binaryHash := sha256.Sum256(binary)
+	stream := strings.NewReader("hello world")
+
+	cases := map[string]struct {
+		Body        io.ReadSeeker
+		ContentType string
+		LogLevel    aws.LogLevelType
+		MaxBytes    *int64
+		Expect      string
+		ExpectNot   string
+	}{
+		"text": {
+			Body:     strings.NewReader("hello world"),
+			LogLevel: aws.LogDebugWithHTTPBody,
+			Expect:   "\r\n\r\nhello world",
+		},
+		"truncated": {
+			Body:      strings.NewReader("hello world"),
+			LogLevel:  aws.LogDebugWithHTTPBody,
+			MaxBytes:  aws.Int64(5),
+			Expect:    "\r\n\r\nhello[truncated 6 bytes]",
+			ExpectNot: "world",
+		},
+		"binary": {
+			Body:        bytes.NewReader(binary),
+			ContentType: "application/octet-stream",
+			LogLevel:    aws.LogDebugWithHTTPBody,
+			MaxBytes:    aws.Int64(16),
+			Expect: fmt.Sprintf("<binary body, %d bytes, sha256=%s>",
+				len(binary), hex.EncodeToString(binaryHash[:])),
+		},
+		"binary logged": {
+			Body:        bytes.NewReader(binary),
+			ContentType: "application/octet-stream",
+			LogLevel:    aws.LogDebugWithHTTPBody | aws.LogDebugWithBinaryBody,
+			MaxBytes:    aws.Int64(4),
+			Expect:      fmt.Sprintf("\x00\x01\x02\x03[truncated %d bytes]", len(binary)-4),
+		},
+		"not seekable": {
+			Body:      aws.ReadSeekCloser(struct{ io.Reader }{stream}),
+			LogLevel:  aws.LogDebugWithHTTPBody,
+			Expect:    "<streaming body not logged, body is not seekable>",
+			ExpectNot: "hello",
+		},
+		"no body logging": {
+			Body:      strings.NewReader("hello world"),
+			LogLevel:  aws.LogDebug,
+			ExpectNot: "hello",
+		},
+	}
+
+	for name, c := range cases {
+		var logged bytes.Buffer
+		cfg := aws.Config{
+			LogLevel:        aws.LogLevel(c.LogLevel),
+			LogBodyMaxBytes: c.MaxBytes,
+			Logger: aws.LoggerFunc(func(args ...interface{}) {
+				fmt.Fprint(&logged, args...)
+			}),
+		}
+
+		r := request.New(cfg, metadata.ClientInfo{Endpoint: "https://example.com"}, request.Handlers{}, nil,
+			&request.Operation{Name: "Operation", HTTPMethod: "PUT"}, nil, nil)
+		r.SetReaderBody(c.Body)
+		if len(c.ContentType) != 0 {
+			r.HTTPRequest.Header.Set("Content-Type", c.ContentType)
+		}
+
+		logRequest(r)
+
+		if e, a := c.Expect, logged.String(); !strings.Contains(a, e) {
+			t.Errorf("%s, expect log to contain %q, got %q", name, e, a)
+		}
+		if len(c.ExpectNot) != 0 && strings.Contains(logged.String(), c.ExpectNot) {
+			t.Errorf("%s, expect log not to contain %q, got %q", name, c.ExpectNot, logged.String())
+		}
+
+		// The body sent must not be consumed by logging.
+		if aws.IsReaderSeekable(c.Body) {
+			b, err := ioutil.ReadAll(r.HTTPRequest.Body)
+			if err != nil {
+				t.Fatalf("%s, expect no error, got %v", name, err)
+			}
+			c.Body.Seek(0, 0)
+			expect, _ := ioutil.ReadAll(c.Body)
+			if !bytes.Equal(expect, b) {
+				t.Errorf("%s, expect full body to be sent, got %d bytes", name, len(b))
+			}
+		} else if e, a := 11, stream.Len(); e != a {
+			t.Errorf("%s, expect %d bytes of body not to be read, got %d", name, e, a)
+		}
+	}
+}
+
+func TestLogResponseBody(t *testing.T) {
+	cases := map[string]struct {
+		Body        string
+		ContentType string
+		Expect      string
+	}{
+		"text": {
+			Body:        strings.Repeat("a", 10),
+			ContentType: "application/json",
+			Expect:      "aaaa[truncated 6 bytes]",
+		},
+		"binary": {
+			Body:   "ab\x00cd",
+			Expect: "<binary body, 5 bytes, sha256=",
+		},
+	}
+
+	for name, c := range cases {
+		var logged bytes.Buffer
+		cfg := aws.Config{
+			LogLevel:        aws.LogLevel(aws.LogDebugWithHTTPBody),
+			LogBodyMaxBytes: aws.Int64(4),
+			Logger: aws.LoggerFunc(func(args ...interface{}) {
+				fmt.Fprint(&logged, args...)
+			}),
+		}
+
+		r := request.New(cfg, metadata.ClientInfo{Endpoint: "https://example.com"}, request.Handlers{}, nil,
+			&request.Operation{Name: "Operation", HTTPMethod: "GET"}, nil, nil)
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader(c.Body)),
+		}
+		if len(c.ContentType) != 0 {
+			r.HTTPResponse.Header.Set("Content-Type", c.ContentType)
+		}
+
+		logResponse(r)
+		b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := c.Body, string(b); e != a {
+			t.Errorf("%s, expect full body to be read, got %q", name, a)
+		}
+		r.Handlers.Unmarshal.Run(r)
+
+		if e, a := c.Expect, logged.String(); !strings.Contains(a, e) {
+			t.Errorf("%s, expect log to contain %q, got %q", name, e, a)
+		}
+	}
+}
+
+func TestLogRequestRedactsSensitiveBody(t *testing.T) {
+	cases := []struct {
+		Body, Expect string
+		ContentType  string
+		Paths        []string
+	}{
+		{
+			Body:   `{"User":"abc","Password":"secret"}`,
+			Expect: `{"User":"abc","Password":"***"}`,
+			Paths:  []string{"Password"},
+		},
+		{
+			Body:        `Action=Operation&User=abc&Password=secret`,
+			Expect:      `Action=Operation&User=abc&Password=***`,
+			ContentType: "application/x-www-form-urlencoded; charset=utf-8",
+			Paths:       []string{"Password"},
+		},
+		{
+			Body:   `<Root><User>abc</User><Password>secret</Password></Root>`,
+			Expect: `<Root><User>abc</User><Password>***</Password></Root>`,
+			Paths:  []string{"Root/Password"},
+		},
+		{
+			Body:   `{"User":"abc","Password":"secret"`,
+			Expect: `***`,
+			Paths:  []string{"Password"},
+		},
+		{
+			Body:   `{"User":"abc","Password":"secret"}`,
+			Expect: `{"User":"abc","Password":"secret"}`,
+		},
+	}
+
+	for i, c := range cases {
+		var logged bytes.Buffer
+		cfg := aws.Config{
+			LogLevel: aws.LogLevel(aws.LogDebugWithHTTPBody),
+			Logger: aws.LoggerFunc(func(args ...interface{}) {
+				fmt.Fprint(&logged, args...)
+			}),
+		}
+
+		r := request.New(cfg, metadata.ClientInfo{Endpoint: "https://example.com"}, request.Handlers{}, nil,
+			&request.Operation{Name: "Operation", HTTPMethod: "POST"}, nil, nil)
+		r.SetStringBody(c.Body)
+		r.HTTPRequest.ContentLength = int64(len(c.Body))
+		if len(c.ContentType) != 0 {
+			r.HTTPRequest.Header.Set("Content-Type", c.ContentType)
+		}
+		r.SensitiveBodyPaths = c.Paths
+
+		logRequest(r)
+
+		if e, a := c.Expect, logged.String(); !strings.Contains(a, e) {
+			t.Errorf("%d, expect log to contain %s, got %s", i, e, a)
+		}
+		if len(c.Paths) != 0 && strings.Contains(logged.String(), "secret") {
+			t.Errorf("%d, expect sensitive value not to be logged, got %s", i, logged.String())
+		}
+
+		b, err := ioutil.ReadAll(r.HTTPRequest.Body)
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Body, string(b); e != a {
+			t.Errorf("%d, expect body sent to be %s, got %s", i, e, a)
+		}
+	}
+}
+
+func TestLogRequestRedactsSensitiveHeaders(t *testing.T) {
+	var logged bytes.Buffer
+	cfg := aws.Config{
+		LogLevel: aws.LogLevel(aws.LogDebug),
+		Logger: aws.LoggerFunc(func(args ...interface{}) {
+			fmt.Fprint(&logged, args...)
+		}),
+	}
+
+	r := request.New(cfg, metadata.ClientInfo{Endpoint: "https://example.com"}, request.Handlers{}, nil,
+		&request.Operation{Name: "Operation", HTTPMethod: "PUT"}, nil, nil)
+	r.HTTPRequest.Header.Set("X-Amz-Key", "secret")
+	r.HTTPRequest.Header.Set("X-Amz-Other", "value")
+	r.SensitiveHeaders = []string{"x-amz-key"}
+
+	logRequest(r)
+
+	if e, a := "X-Amz-Key: ***", logged.String(); !strings.Contains(a, e) {
+		t.Errorf("expect log to contain %q, got %q", e, a)
+	}
+	if e, a := "X-Amz-Other: value", logged.String(); !strings.Contains(a, e) {
+		t.Errorf("expect log to contain %q, got %q", e, a)
+	}
+	if e, a := "secret", r.HTTPRequest.Header.Get("X-Amz-Key"); e != a {
+		t.Errorf("expect header sent to be %q, got %q", e, a)
+	}
+}
+
+func TestLogResponseRedactsSensitiveBody(t *testing.T) {
+	cases := map[string]struct {
+		Body, Expect  string
+		StatusCode    int
+		Paths         []string
+		Headers       []string
+		SensitiveBody bool
+	}{
+		"json": {
+			Body:       `{"Items":[{"User":"abc","Password":"secret"}]}`,
+			Expect:     `{"Items":[{"User":"abc","Password":"***"}]}`,
+			StatusCode: 200,
+			Paths:      []string{"Items[*].Password"},
+		},
+		"xml": {
+			Body:       `<Response><Result><Password>secret</Password></Result></Response>`,
+			Expect:     `<Response><Result><Password>***</Password></Result></Response>`,
+			StatusCode: 200,
+			Paths:      []string{"*/Result/Password"},
+		},
+		"header": {
+			Body:       `{}`,
+			Expect:     "X-Amz-Key: ***",
+			StatusCode: 200,
+			Headers:    []string{"X-Amz-Key"},
+		},
+		"sensitive body": {
+			Body:          `{"secret":true}`,
+			Expect:        "-----***",
+			StatusCode:    200,
+			SensitiveBody: true,
+		},
+		"error response": {
+			Body:       `{"Code":"InvalidPassword","Password":"value"}`,
+			Expect:     `{"Code":"InvalidPassword","Password":"value"}`,
+			StatusCode: 400,
+			Paths:      []string{"Password"},
+		},
+	}
+
+	for name, c := range cases {
+		var logged bytes.Buffer
+		cfg := aws.Config{
+			LogLevel: aws.LogLevel(aws.LogDebugWithHTTPBody),
+			Logger: aws.LoggerFunc(func(args ...interface{}) {
+				fmt.Fprint(&logged, args...)
+			}),
+		}
+
+		r := request.New(cfg, metadata.ClientInfo{Endpoint: "https://example.com"}, request.Handlers{}, nil,
+			&request.Operation{
+				Name:                   "Operation",
+				HTTPMethod:             "GET",
+				SensitiveOutputPaths:   c.Paths,
+				SensitiveOutputHeaders: c.Headers,
+				SensitiveOutputBody:    c.SensitiveBody,
+			}, nil, nil)
+		r.HTTPResponse = &http.Response{
+			StatusCode: c.StatusCode,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader(c.Body)),
+		}
+		for _, h := range c.Headers {
+			r.HTTPResponse.Header.Set(h, "secret")
+		}
+
+		logResponse(r)
+		b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := c.Body, string(b); e != a {
+			t.Errorf("%s, expect body read to be %s, got %s", name, e, a)
+		}
+		if c.StatusCode < 300 {
+			r.Handlers.Unmarshal.Run(r)
+		} else {
+			r.Handlers.UnmarshalError.Run(r)
+		}
 
-	if expected != lw.buf.String() {
-		t.Errorf("Expected %q, but received %q", expected, lw.buf.String())
+		if e, a := c.Expect, logged.String(); !strings.Contains(a, e) {
+			t.Errorf("%s, expect log to contain %s, got %s", name, e, a)
+		}
+		if c.StatusCode < 300 && strings.Contains(logged.String(), "secret") {
+			t.Errorf("%s, expect sensitive value not to be logged, got %s", name, logged.String())
+		}
+		for _, h := range c.Headers {
+			if e, a := "secret", r.HTTPResponse.Header.Get(h); e != a {
+				t.Errorf("%s, expect header read to be %q, got %q", name, e, a)
+			}
+		}
 	}
 }
@@ -3,10 +3,32 @@ package metadata
 // ClientInfo wraps immutable data from the client.Client structure.
 type ClientInfo struct {
 	ServiceName   string
+	ServiceID     string
 	APIVersion    string
 	Endpoint      string
 	SigningName   string
 	SigningRegion string
 	JSONVersion   string
 	TargetPrefix  string
+
+	// SigningEscaping is how the URI path of the client's requests is
+	// escaped in the canonical request of their V4 signatures. If empty, the
+	// paths of the requests of clients with the "s3" signing name are
+	// signed raw, and the paths of other clients' requests are escaped.
+	SigningEscaping SigningEscaping
 }
+
+// SigningEscaping is how the URI path of a request is escaped in the
+// canonical request of the request's V4 signature.
+type SigningEscaping string
+
+const (
+	// EscapedPathSigning escapes each segment of the request's URI path
+	// again, after the path's empty, "." and ".." segments are removed.
+	// Used by most services.
+	EscapedPathSigning SigningEscaping = "escaped"
+
+	// RawPathSigning signs the request's URI path as it is sent, without
+	// escaping, or normalizing, the path. Used by S3.
+	RawPathSigning SigningEscaping = "raw"
+)
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"net"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// ErrCodeRetryQuotaExceeded is the error code of the error a request fails
+// with when it would have been retried, but the client's retry quota has been
+// exhausted. The error's OrigErr is the error of the request's last attempt.
+const ErrCodeRetryQuotaExceeded = "RetryQuotaExceeded"
+
+const (
+	// DefaultRetryQuotaCapacity is the capacity of a client's retry quota.
+	DefaultRetryQuotaCapacity = 500
+
+	// RetryQuotaCost is the capacity withdrawn from the quota by a retry.
+	RetryQuotaCost = 5
+
+	// RetryQuotaTimeoutCost is the capacity withdrawn from the quota by a
+	// retry of an attempt which timed out.
+	RetryQuotaTimeoutCost = 10
+
+	// RetryQuotaNoRetryIncrement is the capacity returned to the quota by a
+	// request which succeeded without being retried.
+	RetryQuotaNoRetryIncrement = 1
+)
+
+// A RetryQuota limits the number of retries a client's requests can make
+// while the service is failing. Each retry withdraws capacity from the quota,
+// and successful requests return capacity to it. Once the quota is exhausted,
+// failed requests are not retried until enough requests succeed to return
+// the capacity, so that an unavailable service is not sent a full set of
+// retries for every request.
+//
+// A RetryQuota is safe to use concurrently by all of a client's requests.
+type RetryQuota struct {
+	mu        sync.Mutex
+	capacity  int
+	available int
+}
+
+// NewRetryQuota returns a RetryQuota with the capacity provided available.
+func NewRetryQuota(capacity int) *RetryQuota {
+	return &RetryQuota{
+		capacity:  capacity,
+		available: capacity,
+	}
+}
+
+// Remaining returns the capacity available in the quota for retries.
+func (q *RetryQuota) Remaining() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.available
+}
+
+// withdraw takes cost from the quota, returning false if the quota does not
+// have the capacity available.
+func (q *RetryQuota) withdraw(cost int) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if cost > q.available {
+		return false
+	}
+	q.available -= cost
+	return true
+}
+
+// deposit returns amount to the quota, up to its capacity.
+func (q *RetryQuota) deposit(amount int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.available += amount
+	if q.available > q.capacity {
+		q.available = q.capacity
+	}
+}
+
+// addRetryQuotaHandlers adds the handlers withdrawing from the client's
+// retry quota when a request will be retried, and depositing to the quota
+// when a request succeeds. The handlers use the client's RetryQuota at the
+// time the request is retried or completed, and do nothing if it is nil.
+func (c *Client) addRetryQuotaHandlers() {
+	c.Handlers.AfterRetry.PushFrontNamed(request.NamedHandler{
+		Name: "awssdk.client.RetryQuota.Withdraw",
+		Fn: func(r *request.Request) {
+			if q := c.RetryQuota; q != nil {
+				q.withdrawRetry(r)
+			}
+		},
+	})
+	c.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "awssdk.client.RetryQuota.Deposit",
+		Fn: func(r *request.Request) {
+			if q := c.RetryQuota; q != nil {
+				q.depositSuccess(r)
+			}
+		},
+	})
+}
+
+// withdrawRetry withdraws the cost of retrying the request from the quota,
+// if the request will be retried. The request fails with the
+// ErrCodeRetryQuotaExceeded error code, and is not retried, if the quota
+// does not have the capacity available.
+func (q *RetryQuota) withdrawRetry(r *request.Request) {
+	// Determine if the request will be retried the same as the core
+	// AfterRetryHandler, before it delays the retry. A request whose body
+	// cannot be rewound fails without being retried, and withdraws nothing.
+	if r.Retryable == nil || aws.BoolValue(r.Config.EnforceShouldRetryCheck) {
+		r.Retryable = aws.Bool(r.ShouldRetry(r))
+	}
+	if !r.WillRetry() || !r.IsBodyRetryable() {
+		return
+	}
+
+	cost := RetryQuotaCost
+	if isErrorTimeout(r.Error) {
+		cost = RetryQuotaTimeoutCost
+	}
+	if !q.withdraw(cost) {
+		r.Error = awserr.New(ErrCodeRetryQuotaExceeded,
+			"retry quota exceeded, not retrying request", r.Error)
+		r.Retryable = aws.Bool(false)
+	}
+}
+
+// depositSuccess deposits to the quota when the request succeeded. A
+// request which succeeded without being retried deposits
+// RetryQuotaNoRetryIncrement, otherwise RetryQuotaCost is deposited for
+// the retry which succeeded.
+func (q *RetryQuota) depositSuccess(r *request.Request) {
+	if r.Error != nil {
+		return
+	}
+
+	if r.RetryCount == 0 {
+		q.deposit(RetryQuotaNoRetryIncrement)
+	} else {
+		q.deposit(RetryQuotaCost)
+	}
+}
+
+// isErrorTimeout returns if the error is the result of the attempt timing
+// out.
+func isErrorTimeout(err error) bool {
+	for err != nil {
+		if nerr, ok := err.(net.Error); ok && nerr.Timeout() {
+			return true
+		}
+
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			return false
+		}
+		switch aerr.Code() {
+		case request.ErrCodeResponseTimeout, "RequestTimeout", "RequestTimeoutException":
+			return true
+		}
+		err = aerr.OrigErr()
+	}
+	return false
+}
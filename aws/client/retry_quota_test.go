@@ -0,0 +1,207 @@
+package client
+
+import (
+	"bytes"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// newQuotaTestClient returns a client whose requests fail with the error
+// returned by fail, or succeed if it returns nil. The number of attempts
+// sent is counted by attempts.
+func newQuotaTestClient(cfg aws.Config, attempts *int, fail func() error) *Client {
+	var mu sync.Mutex
+
+	cfg.SleepDelay = func(time.Duration) {}
+	handlers := request.Handlers{}
+	handlers.Send.PushBack(func(r *request.Request) {
+		mu.Lock()
+		*attempts++
+		mu.Unlock()
+
+		r.HTTPResponse = &http.Response{StatusCode: 200}
+		if err := fail(); err != nil {
+			r.HTTPResponse.StatusCode = 500
+			r.Error = err
+		}
+	})
+	handlers.AfterRetry.PushBackNamed(corehandlers.AfterRetryHandler)
+
+	return New(cfg, metadata.ClientInfo{}, handlers)
+}
+
+func newQuotaTestRequest(c *Client) *request.Request {
+	r := c.NewRequest(&request.Operation{Name: "Operation"}, nil, nil)
+	r.HTTPRequest, _ = http.NewRequest("GET", "https://example.com", nil)
+	return r
+}
+
+func TestRetryQuota_Outage(t *testing.T) {
+	const numRequests, maxRetries = 200, 3
+
+	outage := func() error {
+		return awserr.New("InternalError", "service unavailable", nil)
+	}
+
+	cases := map[string]struct {
+		Config         aws.Config
+		ExpectAttempts int
+	}{
+		"quota": {
+			Config: aws.Config{MaxRetries: aws.Int(maxRetries)},
+			// Each request is sent once, plus the retries the quota's
+			// capacity allows.
+			ExpectAttempts: numRequests + DefaultRetryQuotaCapacity/RetryQuotaCost,
+		},
+		"disabled": {
+			Config: aws.Config{
+				MaxRetries:        aws.Int(maxRetries),
+				DisableRetryQuota: aws.Bool(true),
+			},
+			ExpectAttempts: numRequests * (maxRetries + 1),
+		},
+	}
+
+	for name, c := range cases {
+		var attempts int
+		svc := newQuotaTestClient(c.Config, &attempts, outage)
+
+		var quotaErrs int
+		for i := 0; i < numRequests; i++ {
+			err := newQuotaTestRequest(svc).Send()
+			if err == nil {
+				t.Fatalf("%s, %d, expect error", name, i)
+			}
+			if err.(awserr.Error).Code() == ErrCodeRetryQuotaExceeded {
+				quotaErrs++
+			}
+		}
+
+		if e, a := c.ExpectAttempts, attempts; e != a {
+			t.Errorf("%s, expect %d attempts, got %d", name, e, a)
+		}
+		if svc.RetryQuota != nil {
+			if e, a := 0, svc.RetryQuota.Remaining(); e != a {
+				t.Errorf("%s, expect %d remaining, got %d", name, e, a)
+			}
+			if quotaErrs == 0 {
+				t.Errorf("%s, expect retry quota exceeded errors", name)
+			}
+		} else if quotaErrs != 0 {
+			t.Errorf("%s, expect no retry quota exceeded errors, got %d", name, quotaErrs)
+		}
+	}
+}
+
+func TestRetryQuota_ExceededError(t *testing.T) {
+	var attempts int
+	origErr := awserr.New("InternalError", "service unavailable", nil)
+	svc := newQuotaTestClient(aws.Config{}, &attempts, func() error { return origErr })
+	svc.RetryQuota = NewRetryQuota(RetryQuotaCost)
+
+	err := newQuotaTestRequest(svc).Send()
+	if e, a := 2, attempts; e != a {
+		t.Errorf("expect %d attempts, got %d", e, a)
+	}
+
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T, %v", err, err)
+	}
+	if e, a := ErrCodeRetryQuotaExceeded, aerr.Code(); e != a {
+		t.Errorf("expect %q error code, got %q", e, a)
+	}
+	if e, a := origErr, aerr.OrigErr(); e != a {
+		t.Errorf("expect %v original error, got %v", e, a)
+	}
+}
+
+func TestRetryQuota_BodyNotRetryable(t *testing.T) {
+	var attempts int
+	origErr := awserr.New("InternalError", "service unavailable", nil)
+	svc := newQuotaTestClient(aws.Config{}, &attempts, func() error { return origErr })
+
+	r := newQuotaTestRequest(svc)
+	r.SetReaderBody(aws.ReadSeekCloser(bytes.NewBufferString("abc")))
+
+	err := r.Send()
+	if e, a := 1, attempts; e != a {
+		t.Errorf("expect %d attempts, got %d", e, a)
+	}
+	if e, a := request.ErrCodeBodyNotRetryable, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %q error code, got %q", e, a)
+	}
+
+	// The request was not retried, so no capacity is withdrawn.
+	if e, a := DefaultRetryQuotaCapacity, svc.RetryQuota.Remaining(); e != a {
+		t.Errorf("expect %d remaining, got %d", e, a)
+	}
+}
+
+func TestRetryQuota_Refill(t *testing.T) {
+	var attempts int
+	var fails int
+	svc := newQuotaTestClient(aws.Config{}, &attempts, func() error {
+		if fails > 0 {
+			fails--
+			return awserr.New(request.ErrCodeResponseTimeout, "timeout", nil)
+		}
+		return nil
+	})
+	q := svc.RetryQuota
+
+	// timeouts withdraw more capacity, and the retry which succeeded
+	// deposits its cost back.
+	fails = 2
+	if err := newQuotaTestRequest(svc).Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	withdrawn := 2*RetryQuotaTimeoutCost - RetryQuotaCost
+	if e, a := DefaultRetryQuotaCapacity-withdrawn, q.Remaining(); e != a {
+		t.Errorf("expect %d remaining, got %d", e, a)
+	}
+
+	// successful requests slowly refill the quota.
+	for i := 0; i < withdrawn+5; i++ {
+		if err := newQuotaTestRequest(svc).Send(); err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		expect := DefaultRetryQuotaCapacity - withdrawn + (i+1)*RetryQuotaNoRetryIncrement
+		if expect > DefaultRetryQuotaCapacity {
+			expect = DefaultRetryQuotaCapacity
+		}
+		if e, a := expect, q.Remaining(); e != a {
+			t.Errorf("%d, expect %d remaining, got %d", i, e, a)
+		}
+	}
+}
+
+func TestRetryQuota_Concurrent(t *testing.T) {
+	q := NewRetryQuota(100)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if q.withdraw(RetryQuotaCost) {
+					q.deposit(RetryQuotaCost)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if e, a := 100, q.Remaining(); e != a {
+		t.Errorf("expect %d remaining, got %d", e, a)
+	}
+}
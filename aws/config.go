@@ -13,6 +13,71 @@ import (
 // Config.MaxRetries is nil also.
 const UseServiceDefaultRetries = -1
 
+// Retry modes a service client's retryer can be selected with when
+// Config.Retryer is not set.
+const (
+	// LegacyRetryMode retries requests with the client.DefaultRetryer.
+	LegacyRetryMode = "legacy"
+
+	// StandardRetryMode retries requests with the client.DefaultRetryer,
+	// making at most 3 attempts, the first attempt and 2 retries, unless
+	// Config.MaxRetries is set.
+	StandardRetryMode = "standard"
+
+	// AdaptiveRetryMode retries requests with a client.AdaptiveRetryer,
+	// rate limiting the requests a client sends while being throttled.
+	AdaptiveRetryMode = "adaptive"
+)
+
+// RetryDelayOptions are the options of the delay the client.DefaultRetryer
+// waits before retrying a request. Zero values use the retryer's defaults.
+type RetryDelayOptions struct {
+	// The minimum and maximum delay before a request which failed with an
+	// error other than throttling is retried. The delay grows exponentially
+	// from the minimum with each retry, up to the maximum.
+	MinRetryDelay time.Duration
+	MaxRetryDelay time.Duration
+
+	// The minimum and maximum delay before a throttled request is retried.
+	MinThrottleDelay time.Duration
+	MaxThrottleDelay time.Duration
+
+	// Jitter returns the randomized delay of a retry, from the retry's
+	// exponential backoff delay. Such as client.FullJitter, or
+	// client.EqualJitter. The jittered delay is limited to the minimum and
+	// maximum delays.
+	Jitter func(time.Duration) time.Duration
+}
+
+// HTTPTransportOptions are the options of the HTTP transport of the HTTP
+// client a Session creates, see Config.HTTPTransportOptions. Zero values use
+// the values of Go's http.DefaultTransport.
+type HTTPTransportOptions struct {
+	// The maximum amount of time a dial waits for a connection to be made.
+	DialTimeout time.Duration
+
+	// The maximum amount of time to wait for a TLS handshake.
+	TLSHandshakeTimeout time.Duration
+
+	// The amount of time to wait for the response headers after the request
+	// has been written, not including the time to read the response body.
+	ResponseHeaderTimeout time.Duration
+
+	// The amount of time to wait for the server's first response headers
+	// after writing the request headers, if the request has an
+	// "Expect: 100-continue" header, such as large S3 uploads. The body is
+	// sent if the timeout elapses without a response. Requires Go 1.7 or
+	// later.
+	ExpectContinueTimeout time.Duration
+
+	// The maximum number of idle, keep-alive, connections kept per host.
+	MaxIdleConnsPerHost int
+
+	// The maximum amount of time an idle, keep-alive, connection remains
+	// idle before closing itself. Requires Go 1.7 or later.
+	IdleConnTimeout time.Duration
+}
+
 // RequestRetryer is an alias for a type that implements the request.Retryer
 // interface.
 type RequestRetryer interface{}
@@ -32,8 +97,10 @@ type RequestRetryer interface{}
 //     })
 type Config struct {
 	// Enables verbose error printing of all credential chain errors.
-	// Should be used when wanting to see all errors while attempting to
-	// retrieve credentials.
+	//
+	// Deprecated: the errors of all of the credential chain's providers are
+	// always included in the error returned when credentials cannot be
+	// retrieved. CredentialsChainVerboseErrors is ignored.
 	CredentialsChainVerboseErrors *bool
 
 	// The credentials object to use when signing requests. Defaults to a
@@ -51,6 +118,11 @@ type Config struct {
 
 	// The resolver to use for looking up endpoints for AWS service clients
 	// to use based on region.
+	//
+	// When a Session is created with the resolver set, endpoints the resolver
+	// returns an endpoints.EndpointNotFoundError error for are resolved by
+	// the SDK's default resolver. See endpoints.ResolverChain, and
+	// endpoints.URLResolverFunc to resolve only the URLs of endpoints.
 	EndpointResolver endpoints.Resolver
 
 	// EnforceShouldRetryCheck is used in the AfterRetryHandler to always call
@@ -77,6 +149,25 @@ type Config struct {
 	// `http.DefaultClient`.
 	HTTPClient *http.Client
 
+	// HTTPTransportOptions sets the timeouts and connection pooling options
+	// of the HTTP client's transport. When a Session is created with the
+	// options set, the session's HTTP client uses a copy of Go's
+	// http.DefaultTransport with the options applied, keeping the default
+	// transport's proxy, keep-alive, and HTTP/2 support. See
+	// defaults.HTTPClient.
+	//
+	// Creating a Session fails if both the options and an HTTPClient are set,
+	// set the options on the HTTP client's transport instead. The options are
+	// only used when a Session is created, and are ignored by the
+	// aws.Config of service clients.
+	//
+	//   sess, err := session.NewSession(aws.NewConfig().WithHTTPTransportOptions(
+	//       aws.HTTPTransportOptions{
+	//           DialTimeout:         5 * time.Second,
+	//           TLSHandshakeTimeout: 5 * time.Second,
+	//       }))
+	HTTPTransportOptions *HTTPTransportOptions
+
 	// An integer value representing the logging level. The default log level
 	// is zero (LogOff), which represents no logging. To enable logging set
 	// to a LogLevel Value.
@@ -86,9 +177,28 @@ type Config struct {
 	// standard out.
 	Logger Logger
 
+	// ServiceLogLevels are the log levels of the service clients, keyed by
+	// the ServiceID of the client's service package, such as s3.ServiceID.
+	// The log level of a service overrides LogLevel for the service's
+	// clients. Log levels of services without a client are ignored.
+	//
+	//   sess := session.Must(session.NewSession(aws.NewConfig().
+	//       WithServiceLogLevel(s3.ServiceID, aws.LogDebugWithHTTPBody)))
+	ServiceLogLevels map[string]*LogLevelType
+
+	// ServiceLoggers are the loggers of the service clients, keyed by the
+	// ServiceID of the client's service package. The logger of a service
+	// overrides Logger for the service's clients.
+	ServiceLoggers map[string]Logger
+
 	// The maximum number of times that a request will be retried for failures.
 	// Defaults to -1, which defers the max retry setting to the service
 	// specific configuration.
+	//
+	// Also set via the AWS_MAX_ATTEMPTS environment variable, or the
+	// max_attempts shared config key, when a Session is created. These are
+	// the maximum number of attempts, including the first attempt, so
+	// MaxRetries is set to one less than their value.
 	MaxRetries *int
 
 	// Retryer guides how HTTP requests should be retried in case of
@@ -107,10 +217,95 @@ type Config struct {
 	//
 	Retryer RequestRetryer
 
+	// RetryMode selects the retryer a service client will use when Retryer
+	// is not set. Set to AdaptiveRetryMode to have each service client use a
+	// client.AdaptiveRetryer, which rate limits the requests the client sends
+	// when the service responds with throttling errors, or StandardRetryMode
+	// to limit requests to 3 attempts by default. Defaults to
+	// LegacyRetryMode, the client.DefaultRetryer.
+	//
+	// Also set via the AWS_RETRY_MODE environment variable, or the retry_mode
+	// shared config key, when a Session is created.
+	//
+	//   svc := dynamodb.New(sess, aws.NewConfig().WithRetryMode(aws.AdaptiveRetryMode))
+	RetryMode *string
+
+	// RetryDelayOptions sets the delay options of the client.DefaultRetryer
+	// a service client uses when Retryer is not set, such as the minimum and
+	// maximum delays before a request is retried, and the jitter applied to
+	// the delays.
+	//
+	//   svc := s3.New(sess, aws.NewConfig().WithRetryDelayOptions(aws.RetryDelayOptions{
+	//       MinRetryDelay: time.Second,
+	//       Jitter:        client.FullJitter,
+	//   }))
+	RetryDelayOptions *RetryDelayOptions
+
+	// AttemptTimeout limits the time each attempt of a request may take,
+	// including reading the response, separate from the deadline of the
+	// request's Context. An attempt which times out fails with a retryable
+	// RequestError whose original error is a request.AttemptTimeoutError,
+	// and is retried if the request has retries remaining. Requests which
+	// cannot be retried, such as those with a non-seekable body, fail with
+	// the error instead.
+	//
+	// The request's Context being canceled, or its deadline expiring, still
+	// fails the request with the request.CanceledErrorCode error code.
+	//
+	// Defaults to nil, attempts are only limited by the request's Context,
+	// and the HTTPClient's timeouts.
+	AttemptTimeout *time.Duration
+
+	// RequestMetricsCollector receives the metrics of each attempt of the
+	// service client's API requests, such as the attempt's latency, status
+	// code and error, and if it will be retried. Once a request is completed
+	// its final metrics are also provided. Defaults to nil, no metrics are
+	// collected.
+	RequestMetricsCollector RequestMetricsCollector
+
+	// Disables the service client's retry quota. When enabled, a client's
+	// requests stop being retried once the client has made too many retries
+	// without requests succeeding, such as while a service is unavailable.
+	// Requests which would have been retried fail with the
+	// client.ErrCodeRetryQuotaExceeded error code. Defaults to false.
+	DisableRetryQuota *bool
+
+	// RequestCompressionMinSize enables gzip compression of request bodies
+	// of at least the size in bytes. Compressed requests are sent with the
+	// "Content-Encoding: gzip" header, so should only be enabled for service
+	// APIs which accept compressed request bodies, such as CloudWatch
+	// PutMetricData. Streaming payloads, and presigned requests are not
+	// compressed. Defaults to nil, request bodies are not compressed.
+	//
+	// Also enabled per request with the request.WithCompression request
+	// option.
+	RequestCompressionMinSize *int64
+
+	// MaxErrorResponseBodySize is the maximum number of bytes of an error
+	// response's body which will be read by the SDK when unmarshaling the
+	// error. Error messages of errors unmarshaled from a truncated body note
+	// the truncation. Set to a value less than or equal to zero to read the
+	// full body. Defaults to request.DefaultMaxErrorResponseBodySize.
+	MaxErrorResponseBodySize *int64
+
+	// LogBodyMaxBytes is the maximum number of bytes of an HTTP request or
+	// response body logged with the LogDebugWithHTTPBody log level. Logged
+	// bodies exceeding the limit are truncated. Set to a value less than or
+	// equal to zero to log the full body. Defaults to
+	// client.DefaultLogBodyMaxBytes.
+	LogBodyMaxBytes *int64
+
 	// Disables semantic parameter validation, which validates input for
 	// missing required fields and/or other semantic request input errors.
 	DisableParamValidation *bool
 
+	// Enables extended parameter validation, which also validates input for
+	// fields exceeding their maximum length or value, and string fields not
+	// matching their pattern, or not one of their enum values. Has no effect
+	// if DisableParamValidation is set. Defaults to false, since services may
+	// accept values the SDK's API models do not yet know about.
+	EnableExtendedValidation *bool
+
 	// Disables the computation of request and response checksums, e.g.,
 	// CRC32 checksums in Amazon DynamoDB.
 	DisableComputeChecksums *bool
@@ -133,14 +328,23 @@ type Config struct {
 	//
 	// http://docs.aws.amazon.com/AmazonS3/latest/API/RESTObjectPUT.html
 	//
-	// 100-Continue is only enabled for Go 1.6 and above. See `http.Transport`'s
-	// `ExpectContinueTimeout` for information on adjusting the continue wait
-	// timeout. https://golang.org/pkg/net/http/#Transport
+	// 100-Continue is only enabled for Go 1.6 and above. The HTTP client waits
+	// up to its transport's `ExpectContinueTimeout` for the `continue` status,
+	// and then sends the body anyway. Set the timeout of the session's HTTP
+	// client with HTTPTransportOptions' ExpectContinueTimeout.
+	// https://golang.org/pkg/net/http/#Transport
 	//
 	// You should use this flag to disble 100-Continue if you experience issues
 	// with proxies or third party S3 compatible services.
 	S3Disable100Continue *bool
 
+	// The minimum content length in bytes of the S3 PUT requests, such as
+	// PutObject, UploadPart, and CopyObject, which are sent with the
+	// `Expect: 100-Continue` header. Defaults to 2MB if nil. A threshold of
+	// zero adds the header to all PUT requests, including requests without a
+	// body, such as CopyObject. Has no effect if S3Disable100Continue is set.
+	S3ExpectContinueThreshold *int64
+
 	// Set this to `true` to enable S3 Accelerate feature. For all operations
 	// compatible with S3 Accelerate will use the accelerate endpoint for
 	// requests. Requests not compatible will fall back to normal S3 requests.
@@ -151,6 +355,23 @@ type Config struct {
 	// with accelerate.
 	S3UseAccelerate *bool
 
+	// Set this to `true` to allow the S3 client to make requests to the region
+	// of an access point ARN used as the Bucket of a request, when the region
+	// differs from the client's region. By default the region of the ARN must
+	// match the client's region, and cross-region requests fail with an error.
+	S3UseARNRegion *bool
+
+	// Set this to `true` to have the S3 client follow the redirects S3 returns
+	// for requests to buckets in regions other than the client's region. The
+	// request is signed again for the bucket's region and retried once with
+	// the region's endpoint. The region of each bucket redirected is cached
+	// by the client, so that later requests to the bucket are made to the
+	// bucket's region.
+	//
+	// Redirects are not followed for requests with a custom Endpoint, or
+	// S3UseAccelerate set.
+	S3FollowRegionRedirects *bool
+
 	// Set this to `true` to disable the EC2Metadata client from overriding the
 	// default http.Client's Timeout. This is helpful if you do not want the
 	// EC2Metadata client to create a new http.Client. This options is only
@@ -168,6 +389,14 @@ type Config struct {
 	//
 	EC2MetadataDisableTimeoutOverride *bool
 
+	// Set this to `true` to prevent the EC2Metadata client from falling back
+	// to the insecure IMDSv1 requests, without session token, when the IMDSv2
+	// session token fails to be fetched. Requests fail with an error instead.
+	//
+	// If not set, the AWS_EC2_METADATA_V1_DISABLED environment variable is
+	// used. Fallback to IMDSv1 is enabled by default.
+	EC2MetadataV1Disabled *bool
+
 	// Instructs the endpiont to be generated for a service client to
 	// be the dual stack endpoint. The dual stack endpoint will support
 	// both IPv4 and IPv6 addressing.
@@ -189,6 +418,55 @@ type Config struct {
 	//     })
 	UseDualStack *bool
 
+	// Set this to `true` to resolve the dualstack endpoints of service
+	// clients, supporting both IPv4 and IPv6, such as ec2.us-west-2.api.aws.
+	// Unlike UseDualStack, creating a service client fails with an
+	// endpoints.EndpointNotFoundError error if the service does not have a
+	// dualstack endpoint for the region, instead of using the IPv4 only
+	// endpoint.
+	//
+	// If the Endpoint config value is also provided the UseDualStackEndpoint
+	// flag will be ignored.
+	//
+	// Also set with the AWS_USE_DUALSTACK_ENDPOINT environment variable, or the
+	// use_dualstack_endpoint shared config key, when a Session is created.
+	UseDualStackEndpoint *bool
+
+	// Set this to `true` to resolve the FIPS endpoints of service clients,
+	// such as sts-fips.us-east-1.amazonaws.com. Creating a service client
+	// fails with an UnknownFIPSEndpointError error if the service does not
+	// have a FIPS endpoint for the region, instead of using the non-FIPS
+	// endpoint. UseDualStack is ignored if the FIPS endpoint is used.
+	//
+	// If the Endpoint config value is also provided the UseFIPSEndpoint flag
+	// will be ignored.
+	//
+	// Also set with the AWS_USE_FIPS_ENDPOINT environment variable, or the
+	// use_fips_endpoint shared config key, when a Session is created.
+	UseFIPSEndpoint *bool
+
+	// STSRegionalEndpoint selects the endpoint STS clients send requests to
+	// in regions whose STS requests are sent to the global endpoint,
+	// sts.amazonaws.com, by default. Set to endpoints.RegionalSTSEndpoint to
+	// send the requests to the STS endpoint of the region, such as
+	// sts.us-west-2.amazonaws.com.
+	//
+	// If not set, the AWS_STS_REGIONAL_ENDPOINTS environment variable, or the
+	// sts_regional_endpoints shared config key, is used by sessions. The
+	// global endpoint is used by default.
+	STSRegionalEndpoint endpoints.STSRegionalEndpoint
+
+	// S3UsEast1RegionalEndpoint selects the endpoint S3 clients send requests
+	// to in the us-east-1 region, which are sent to the global endpoint,
+	// s3.amazonaws.com, by default. Set to endpoints.RegionalS3UsEast1Endpoint
+	// to send the requests to the regional endpoint,
+	// s3.us-east-1.amazonaws.com.
+	//
+	// If not set, the AWS_S3_US_EAST_1_REGIONAL_ENDPOINT environment variable,
+	// or the s3_us_east_1_regional_endpoint shared config key, is used by
+	// sessions. The global endpoint is used by default.
+	S3UsEast1RegionalEndpoint endpoints.S3UsEast1RegionalEndpoint
+
 	// SleepDelay is an override for the func the SDK will call when sleeping
 	// during the lifecycle of a request. Specifically this will be used for
 	// request delays. This value should only be used for testing. To adjust
@@ -214,6 +492,54 @@ type Config struct {
 	//    	Key: aws.String("//foo//bar//moo"),
 	//    })
 	DisableRestProtocolURICleaning *bool
+
+	// Set this to `true` to have the SDK collect the JSON paths of response
+	// fields which are not defined in the API operation's output shape. The
+	// collected paths are available via the request.Request's
+	// UnknownResponseFields member after the request is sent. Collecting
+	// unknown fields does not modify the decoded output value.
+	//
+	// This is useful for integration tests that want to detect drift between
+	// the SDK's API models and the live service. Only supported by JSON based
+	// protocols. Defaults to `false`.
+	CollectUnknownFields *bool
+
+	// Set this to `true` to enable discovery of the endpoints of service API
+	// operations which support endpoint discovery. Discovered endpoints are
+	// cached by the service client until they expire. Operations which
+	// require endpoint discovery always discover their endpoints. Defaults
+	// to `false`.
+	//
+	// Also set with the AWS_ENABLE_ENDPOINT_DISCOVERY environment variable,
+	// or the endpoint_discovery_enabled shared config key, when a Session is
+	// created. Their value is true, false, or auto. Auto sets the option to
+	// `false`, only discovering the endpoints of operations which require
+	// endpoint discovery.
+	EnableEndpointDiscovery *bool
+
+	// AppID is an identifier of the application making requests, appended
+	// to the User-Agent header of requests as "app/<AppID>". Used to
+	// attribute requests to an application in service logs. The ID must be
+	// at most 50 characters, and not contain spaces or control characters.
+	// Invalid IDs are not added to the User-Agent, and a warning is logged.
+	//
+	// Also set via the AWS_SDK_UA_APP_ID environment variable, or the
+	// sdk_ua_app_id shared config key, when a Session is created.
+	AppID *string
+
+	// SigningIgnoredHeaders are the names of the headers of requests which
+	// are not signed. Used to exclude headers which are added, or modified,
+	// after the request is signed, such as the headers a proxy injects, or a
+	// load balancer rewrites.
+	//
+	// The Host, X-Amz-Date, X-Amz-Content-Sha256, and X-Amz-Security-Token
+	// headers cannot be ignored, requests fail to be signed with an
+	// InvalidIgnoredHeader error if they are included.
+	//
+	//    svc := s3.New(sess, aws.NewConfig().
+	//        WithSigningIgnoredHeaders("X-Forwarded-For", "Accept-Encoding"),
+	//    )
+	SigningIgnoredHeaders []string
 }
 
 // NewConfig returns a new Config pointer that can be chained with builder
@@ -282,6 +608,13 @@ func (c *Config) WithHTTPClient(client *http.Client) *Config {
 	return c
 }
 
+// WithHTTPTransportOptions sets a config HTTPTransportOptions value
+// returning a Config pointer for chaining.
+func (c *Config) WithHTTPTransportOptions(opts HTTPTransportOptions) *Config {
+	c.HTTPTransportOptions = &opts
+	return c
+}
+
 // WithMaxRetries sets a config MaxRetries value returning a Config pointer
 // for chaining.
 func (c *Config) WithMaxRetries(max int) *Config {
@@ -289,6 +622,69 @@ func (c *Config) WithMaxRetries(max int) *Config {
 	return c
 }
 
+// WithRetryMode sets a config RetryMode value returning a Config pointer
+// for chaining.
+func (c *Config) WithRetryMode(mode string) *Config {
+	c.RetryMode = &mode
+	return c
+}
+
+// WithRetryDelayOptions sets a config RetryDelayOptions value returning a
+// Config pointer for chaining.
+func (c *Config) WithRetryDelayOptions(opts RetryDelayOptions) *Config {
+	c.RetryDelayOptions = &opts
+	return c
+}
+
+// WithAttemptTimeout sets a config AttemptTimeout value returning a Config
+// pointer for chaining.
+func (c *Config) WithAttemptTimeout(timeout time.Duration) *Config {
+	c.AttemptTimeout = &timeout
+	return c
+}
+
+// WithRequestMetricsCollector sets a config RequestMetricsCollector value
+// returning a Config pointer for chaining.
+func (c *Config) WithRequestMetricsCollector(collector RequestMetricsCollector) *Config {
+	c.RequestMetricsCollector = collector
+	return c
+}
+
+// WithDisableRetryQuota sets a config DisableRetryQuota value returning a
+// Config pointer for chaining.
+func (c *Config) WithDisableRetryQuota(disable bool) *Config {
+	c.DisableRetryQuota = &disable
+	return c
+}
+
+// WithRequestCompressionMinSize sets a config RequestCompressionMinSize
+// value returning a Config pointer for chaining.
+func (c *Config) WithRequestCompressionMinSize(size int64) *Config {
+	c.RequestCompressionMinSize = &size
+	return c
+}
+
+// WithMaxErrorResponseBodySize sets a config MaxErrorResponseBodySize value
+// returning a Config pointer for chaining.
+func (c *Config) WithMaxErrorResponseBodySize(size int64) *Config {
+	c.MaxErrorResponseBodySize = &size
+	return c
+}
+
+// WithLogBodyMaxBytes sets a config LogBodyMaxBytes value returning a Config
+// pointer for chaining.
+func (c *Config) WithLogBodyMaxBytes(max int64) *Config {
+	c.LogBodyMaxBytes = &max
+	return c
+}
+
+// WithEnableExtendedValidation sets a config EnableExtendedValidation value
+// returning a Config pointer for chaining.
+func (c *Config) WithEnableExtendedValidation(enable bool) *Config {
+	c.EnableExtendedValidation = &enable
+	return c
+}
+
 // WithDisableParamValidation sets a config DisableParamValidation value
 // returning a Config pointer for chaining.
 func (c *Config) WithDisableParamValidation(disable bool) *Config {
@@ -317,6 +713,26 @@ func (c *Config) WithLogger(logger Logger) *Config {
 	return c
 }
 
+// WithServiceLogLevel sets the log level of the service's clients in the
+// config ServiceLogLevels value returning a Config pointer for chaining.
+func (c *Config) WithServiceLogLevel(serviceID string, level LogLevelType) *Config {
+	if c.ServiceLogLevels == nil {
+		c.ServiceLogLevels = map[string]*LogLevelType{}
+	}
+	c.ServiceLogLevels[serviceID] = &level
+	return c
+}
+
+// WithServiceLogger sets the logger of the service's clients in the config
+// ServiceLoggers value returning a Config pointer for chaining.
+func (c *Config) WithServiceLogger(serviceID string, logger Logger) *Config {
+	if c.ServiceLoggers == nil {
+		c.ServiceLoggers = map[string]Logger{}
+	}
+	c.ServiceLoggers[serviceID] = logger
+	return c
+}
+
 // WithS3ForcePathStyle sets a config S3ForcePathStyle value returning a Config
 // pointer for chaining.
 func (c *Config) WithS3ForcePathStyle(force bool) *Config {
@@ -331,6 +747,13 @@ func (c *Config) WithS3Disable100Continue(disable bool) *Config {
 	return c
 }
 
+// WithS3ExpectContinueThreshold sets a config S3ExpectContinueThreshold value
+// returning a Config pointer for chaining.
+func (c *Config) WithS3ExpectContinueThreshold(threshold int64) *Config {
+	c.S3ExpectContinueThreshold = &threshold
+	return c
+}
+
 // WithS3UseAccelerate sets a config S3UseAccelerate value returning a Config
 // pointer for chaining.
 func (c *Config) WithS3UseAccelerate(enable bool) *Config {
@@ -338,6 +761,20 @@ func (c *Config) WithS3UseAccelerate(enable bool) *Config {
 	return c
 }
 
+// WithS3UseARNRegion sets a config S3UseARNRegion value returning a Config
+// pointer for chaining.
+func (c *Config) WithS3UseARNRegion(enable bool) *Config {
+	c.S3UseARNRegion = &enable
+	return c
+}
+
+// WithS3FollowRegionRedirects sets a config S3FollowRegionRedirects value
+// returning a Config pointer for chaining.
+func (c *Config) WithS3FollowRegionRedirects(enable bool) *Config {
+	c.S3FollowRegionRedirects = &enable
+	return c
+}
+
 // WithUseDualStack sets a config UseDualStack value returning a Config
 // pointer for chaining.
 func (c *Config) WithUseDualStack(enable bool) *Config {
@@ -345,6 +782,20 @@ func (c *Config) WithUseDualStack(enable bool) *Config {
 	return c
 }
 
+// WithUseDualStackEndpoint sets a config UseDualStackEndpoint value returning
+// a Config pointer for chaining.
+func (c *Config) WithUseDualStackEndpoint(enable bool) *Config {
+	c.UseDualStackEndpoint = &enable
+	return c
+}
+
+// WithUseFIPSEndpoint sets a config UseFIPSEndpoint value returning a Config
+// pointer for chaining.
+func (c *Config) WithUseFIPSEndpoint(enable bool) *Config {
+	c.UseFIPSEndpoint = &enable
+	return c
+}
+
 // WithEC2MetadataDisableTimeoutOverride sets a config EC2MetadataDisableTimeoutOverride value
 // returning a Config pointer for chaining.
 func (c *Config) WithEC2MetadataDisableTimeoutOverride(enable bool) *Config {
@@ -352,6 +803,55 @@ func (c *Config) WithEC2MetadataDisableTimeoutOverride(enable bool) *Config {
 	return c
 }
 
+// WithSTSRegionalEndpoint sets a config STSRegionalEndpoint value returning
+// a Config pointer for chaining.
+func (c *Config) WithSTSRegionalEndpoint(e endpoints.STSRegionalEndpoint) *Config {
+	c.STSRegionalEndpoint = e
+	return c
+}
+
+// WithS3UsEast1RegionalEndpoint sets a config S3UsEast1RegionalEndpoint value
+// returning a Config pointer for chaining.
+func (c *Config) WithS3UsEast1RegionalEndpoint(e endpoints.S3UsEast1RegionalEndpoint) *Config {
+	c.S3UsEast1RegionalEndpoint = e
+	return c
+}
+
+// WithEC2MetadataV1Disabled sets a config EC2MetadataV1Disabled value
+// returning a Config pointer for chaining.
+func (c *Config) WithEC2MetadataV1Disabled(disable bool) *Config {
+	c.EC2MetadataV1Disabled = &disable
+	return c
+}
+
+// WithEndpointDiscovery sets a config EnableEndpointDiscovery value returning
+// a Config pointer for chaining.
+func (c *Config) WithEndpointDiscovery(enable bool) *Config {
+	c.EnableEndpointDiscovery = &enable
+	return c
+}
+
+// WithAppID sets a config AppID value returning a Config pointer for
+// chaining.
+func (c *Config) WithAppID(id string) *Config {
+	c.AppID = &id
+	return c
+}
+
+// WithCollectUnknownFields sets a config CollectUnknownFields value
+// returning a Config pointer for chaining.
+func (c *Config) WithCollectUnknownFields(enable bool) *Config {
+	c.CollectUnknownFields = &enable
+	return c
+}
+
+// WithSigningIgnoredHeaders sets a config SigningIgnoredHeaders value
+// returning a Config pointer for chaining.
+func (c *Config) WithSigningIgnoredHeaders(names ...string) *Config {
+	c.SigningIgnoredHeaders = names
+	return c
+}
+
 // WithSleepDelay overrides the function used to sleep while waiting for the
 // next retry. Defaults to time.Sleep.
 func (c *Config) WithSleepDelay(fn func(time.Duration)) *Config {
@@ -399,6 +899,10 @@ func mergeInConfig(dst *Config, other *Config) {
 		dst.HTTPClient = other.HTTPClient
 	}
 
+	if other.HTTPTransportOptions != nil {
+		dst.HTTPTransportOptions = other.HTTPTransportOptions
+	}
+
 	if other.LogLevel != nil {
 		dst.LogLevel = other.LogLevel
 	}
@@ -407,6 +911,30 @@ func mergeInConfig(dst *Config, other *Config) {
 		dst.Logger = other.Logger
 	}
 
+	// The service log levels and loggers are merged by service, into new
+	// maps so the maps of the merged configs are not modified.
+	if len(other.ServiceLogLevels) != 0 {
+		levels := make(map[string]*LogLevelType, len(dst.ServiceLogLevels)+len(other.ServiceLogLevels))
+		for k, v := range dst.ServiceLogLevels {
+			levels[k] = v
+		}
+		for k, v := range other.ServiceLogLevels {
+			levels[k] = v
+		}
+		dst.ServiceLogLevels = levels
+	}
+
+	if len(other.ServiceLoggers) != 0 {
+		loggers := make(map[string]Logger, len(dst.ServiceLoggers)+len(other.ServiceLoggers))
+		for k, v := range dst.ServiceLoggers {
+			loggers[k] = v
+		}
+		for k, v := range other.ServiceLoggers {
+			loggers[k] = v
+		}
+		dst.ServiceLoggers = loggers
+	}
+
 	if other.MaxRetries != nil {
 		dst.MaxRetries = other.MaxRetries
 	}
@@ -415,10 +943,46 @@ func mergeInConfig(dst *Config, other *Config) {
 		dst.Retryer = other.Retryer
 	}
 
+	if other.RetryMode != nil {
+		dst.RetryMode = other.RetryMode
+	}
+
+	if other.RetryDelayOptions != nil {
+		dst.RetryDelayOptions = other.RetryDelayOptions
+	}
+
+	if other.AttemptTimeout != nil {
+		dst.AttemptTimeout = other.AttemptTimeout
+	}
+
+	if other.RequestMetricsCollector != nil {
+		dst.RequestMetricsCollector = other.RequestMetricsCollector
+	}
+
+	if other.DisableRetryQuota != nil {
+		dst.DisableRetryQuota = other.DisableRetryQuota
+	}
+
+	if other.RequestCompressionMinSize != nil {
+		dst.RequestCompressionMinSize = other.RequestCompressionMinSize
+	}
+
+	if other.MaxErrorResponseBodySize != nil {
+		dst.MaxErrorResponseBodySize = other.MaxErrorResponseBodySize
+	}
+
+	if other.LogBodyMaxBytes != nil {
+		dst.LogBodyMaxBytes = other.LogBodyMaxBytes
+	}
+
 	if other.DisableParamValidation != nil {
 		dst.DisableParamValidation = other.DisableParamValidation
 	}
 
+	if other.EnableExtendedValidation != nil {
+		dst.EnableExtendedValidation = other.EnableExtendedValidation
+	}
+
 	if other.DisableComputeChecksums != nil {
 		dst.DisableComputeChecksums = other.DisableComputeChecksums
 	}
@@ -431,18 +995,50 @@ func mergeInConfig(dst *Config, other *Config) {
 		dst.S3Disable100Continue = other.S3Disable100Continue
 	}
 
+	if other.S3ExpectContinueThreshold != nil {
+		dst.S3ExpectContinueThreshold = other.S3ExpectContinueThreshold
+	}
+
 	if other.S3UseAccelerate != nil {
 		dst.S3UseAccelerate = other.S3UseAccelerate
 	}
 
+	if other.S3UseARNRegion != nil {
+		dst.S3UseARNRegion = other.S3UseARNRegion
+	}
+
+	if other.S3FollowRegionRedirects != nil {
+		dst.S3FollowRegionRedirects = other.S3FollowRegionRedirects
+	}
+
 	if other.UseDualStack != nil {
 		dst.UseDualStack = other.UseDualStack
 	}
 
+	if other.UseDualStackEndpoint != nil {
+		dst.UseDualStackEndpoint = other.UseDualStackEndpoint
+	}
+
+	if other.UseFIPSEndpoint != nil {
+		dst.UseFIPSEndpoint = other.UseFIPSEndpoint
+	}
+
+	if other.STSRegionalEndpoint != endpoints.UnsetSTSEndpoint {
+		dst.STSRegionalEndpoint = other.STSRegionalEndpoint
+	}
+
+	if other.S3UsEast1RegionalEndpoint != endpoints.UnsetS3UsEast1Endpoint {
+		dst.S3UsEast1RegionalEndpoint = other.S3UsEast1RegionalEndpoint
+	}
+
 	if other.EC2MetadataDisableTimeoutOverride != nil {
 		dst.EC2MetadataDisableTimeoutOverride = other.EC2MetadataDisableTimeoutOverride
 	}
 
+	if other.EC2MetadataV1Disabled != nil {
+		dst.EC2MetadataV1Disabled = other.EC2MetadataV1Disabled
+	}
+
 	if other.SleepDelay != nil {
 		dst.SleepDelay = other.SleepDelay
 	}
@@ -454,6 +1050,22 @@ func mergeInConfig(dst *Config, other *Config) {
 	if other.EnforceShouldRetryCheck != nil {
 		dst.EnforceShouldRetryCheck = other.EnforceShouldRetryCheck
 	}
+
+	if other.CollectUnknownFields != nil {
+		dst.CollectUnknownFields = other.CollectUnknownFields
+	}
+
+	if other.EnableEndpointDiscovery != nil {
+		dst.EnableEndpointDiscovery = other.EnableEndpointDiscovery
+	}
+
+	if other.AppID != nil {
+		dst.AppID = other.AppID
+	}
+
+	if other.SigningIgnoredHeaders != nil {
+		dst.SigningIgnoredHeaders = other.SigningIgnoredHeaders
+	}
 }
 
 // Copy will return a shallow copy of the Config object. If any additional
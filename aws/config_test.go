@@ -60,6 +60,7 @@ var mergeTestConfig = Config{
 	DisableParamValidation:  Bool(true),
 	DisableComputeChecksums: Bool(true),
 	S3ForcePathStyle:        Bool(true),
+	SigningIgnoredHeaders:   []string{"X-Forwarded-For"},
 }
 
 var mergeTests = []struct {
@@ -84,3 +85,32 @@ func TestMerge(t *testing.T) {
 		}
 	}
 }
+
+func TestMergeServiceLogLevels(t *testing.T) {
+	base := NewConfig().
+		WithServiceLogLevel("S3", LogDebug).
+		WithServiceLogger("S3", NewDefaultLogger())
+	other := NewConfig().
+		WithServiceLogLevel("S3", LogDebugWithHTTPBody).
+		WithServiceLogLevel("DynamoDB", LogOff)
+
+	got := base.Copy(other)
+
+	if e, a := LogDebugWithHTTPBody, got.ServiceLogLevels["S3"].Value(); e != a {
+		t.Errorf("expect S3 log level %v, got %v", e, a)
+	}
+	if e, a := LogOff, got.ServiceLogLevels["DynamoDB"].Value(); e != a {
+		t.Errorf("expect DynamoDB log level %v, got %v", e, a)
+	}
+	if got.ServiceLoggers["S3"] == nil {
+		t.Errorf("expect S3 logger to be merged")
+	}
+
+	// The maps of the merged configs are not modified.
+	if e, a := LogDebug, base.ServiceLogLevels["S3"].Value(); e != a {
+		t.Errorf("expect base S3 log level %v, got %v", e, a)
+	}
+	if _, ok := base.ServiceLogLevels["DynamoDB"]; ok {
+		t.Errorf("expect base to not have DynamoDB log level")
+	}
+}
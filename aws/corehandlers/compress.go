@@ -0,0 +1,96 @@
+package corehandlers
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// CompressRequestBodyHandler is a request handler to gzip compress the
+// request's body, if the request's Config.RequestCompressionMinSize is set,
+// and the body is at least that size.
+//
+// The handler is a Sign handler so that it runs after the protocol's Build
+// handlers marshaled the body, and before the body is signed. Since the
+// request's body is replaced with the compressed body, retries of the
+// request reuse the compressed body.
+var CompressRequestBodyHandler = request.NamedHandler{
+	Name: "core.CompressRequestBodyHandler",
+	Fn: func(r *request.Request) {
+		if r.Config.RequestCompressionMinSize == nil || r.Body == nil {
+			return
+		}
+		// Presigned requests are not sent by the SDK, and the body was
+		// already compressed, or encoded by the caller.
+		if r.ExpireTime > 0 || len(r.HTTPRequest.Header.Get("Content-Encoding")) != 0 {
+			return
+		}
+		if hasStreamingPayload(r.Params) {
+			return
+		}
+
+		start, err := r.Body.Seek(r.BodyStart, 0)
+		if err != nil {
+			return
+		}
+		end, err := r.Body.Seek(0, 2)
+		if err != nil {
+			return
+		}
+		if end-start < *r.Config.RequestCompressionMinSize {
+			r.Body.Seek(start, 0)
+			return
+		}
+		r.Body.Seek(start, 0)
+
+		buf := &bytes.Buffer{}
+		w := gzip.NewWriter(buf)
+		if _, err := io.Copy(w, r.Body); err == nil {
+			err = w.Close()
+		}
+		if err != nil {
+			r.Error = awserr.New(request.ErrCodeSerialization,
+				"failed to compress request body", err)
+			return
+		}
+
+		r.SetBufferBody(buf.Bytes())
+		r.HTTPRequest.Header.Set("Content-Encoding", "gzip")
+		r.HTTPRequest.Header.Set("Content-Length", fmt.Sprintf("%d", buf.Len()))
+		r.HTTPRequest.ContentLength = int64(buf.Len())
+		// Payload hashes computed before the body was compressed are not
+		// valid for the compressed body.
+		r.HTTPRequest.Header.Del("X-Amz-Content-Sha256")
+	},
+}
+
+var readSeekerType = reflect.TypeOf((*io.ReadSeeker)(nil)).Elem()
+
+// hasStreamingPayload returns if the input parameters have a streaming
+// payload, which will not be compressed.
+func hasStreamingPayload(params interface{}) bool {
+	v := reflect.Indirect(reflect.ValueOf(params))
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+
+	field, ok := v.Type().FieldByName("_")
+	if !ok {
+		return false
+	}
+	payloadName := field.Tag.Get("payload")
+	if len(payloadName) == 0 {
+		return false
+	}
+	payload, ok := v.Type().FieldByName(payloadName)
+	if !ok {
+		return false
+	}
+
+	return payload.Type == readSeekerType
+}
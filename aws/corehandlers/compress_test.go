@@ -0,0 +1,195 @@
+package corehandlers_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+type compressTestRequest struct {
+	ContentEncoding string
+	Body            []byte
+	DecodedBody     []byte
+}
+
+// newCompressTestServer returns a server recording the requests it
+// receives, responding with a 500 status code to the first fail requests.
+func newCompressTestServer(t *testing.T, fail int) (*httptest.Server, func() []compressTestRequest) {
+	var mu sync.Mutex
+	var reqs []compressTestRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("expect no error reading body, got %v", err)
+		}
+		if e, a := int64(len(body)), r.ContentLength; e != a {
+			t.Errorf("expect %d content length, got %d", e, a)
+		}
+
+		req := compressTestRequest{
+			ContentEncoding: r.Header.Get("Content-Encoding"),
+			Body:            body,
+			DecodedBody:     body,
+		}
+		if req.ContentEncoding == "gzip" {
+			gr, err := gzip.NewReader(bytes.NewReader(body))
+			if err != nil {
+				t.Fatalf("expect no error decompressing body, got %v", err)
+			}
+			if req.DecodedBody, err = ioutil.ReadAll(gr); err != nil {
+				t.Errorf("expect no error decompressing body, got %v", err)
+			}
+		}
+
+		mu.Lock()
+		reqs = append(reqs, req)
+		n := len(reqs)
+		mu.Unlock()
+
+		if n <= fail {
+			w.WriteHeader(500)
+			return
+		}
+		fmt.Fprint(w, `<PutMetricDataResponse><ResponseMetadata><RequestId>id</RequestId></ResponseMetadata></PutMetricDataResponse>`)
+	}))
+
+	return server, func() []compressTestRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]compressTestRequest{}, reqs...)
+	}
+}
+
+func newCompressTestInput() *cloudwatch.PutMetricDataInput {
+	input := &cloudwatch.PutMetricDataInput{Namespace: aws.String("namespace")}
+	for i := 0; i < 20; i++ {
+		input.MetricData = append(input.MetricData, &cloudwatch.MetricDatum{
+			MetricName: aws.String(fmt.Sprintf("metric%d", i)),
+			Value:      aws.Float64(float64(i)),
+		})
+	}
+	return input
+}
+
+func TestCompressRequestBodyHandler(t *testing.T) {
+	cases := map[string]struct {
+		Config         *aws.Config
+		Options        []request.Option
+		Fail           int
+		ExpectEncoding string
+	}{
+		"disabled": {
+			Config: &aws.Config{},
+		},
+		"client": {
+			Config:         aws.NewConfig().WithRequestCompressionMinSize(100),
+			ExpectEncoding: "gzip",
+		},
+		"request": {
+			Config:         &aws.Config{},
+			Options:        []request.Option{request.WithCompression(100)},
+			ExpectEncoding: "gzip",
+		},
+		"under min size": {
+			Config: aws.NewConfig().WithRequestCompressionMinSize(1 << 20),
+		},
+		"retried": {
+			Config:         aws.NewConfig().WithRequestCompressionMinSize(100),
+			Fail:           2,
+			ExpectEncoding: "gzip",
+		},
+	}
+
+	// The body of the request sent without compression.
+	var expectBody []byte
+	{
+		server, reqs := newCompressTestServer(t, 0)
+		svc := cloudwatch.New(unit.Session, &aws.Config{
+			Endpoint: aws.String(server.URL),
+		})
+		_, err := svc.PutMetricData(newCompressTestInput())
+		server.Close()
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		expectBody = reqs()[0].Body
+	}
+
+	for name, c := range cases {
+		server, reqs := newCompressTestServer(t, c.Fail)
+
+		svc := cloudwatch.New(unit.Session, c.Config.Copy().
+			WithEndpoint(server.URL).
+			WithSleepDelay(func(time.Duration) {}))
+		_, err := svc.PutMetricDataWithContext(aws.BackgroundContext(),
+			newCompressTestInput(), c.Options...)
+		server.Close()
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		received := reqs()
+		if e, a := c.Fail+1, len(received); e != a {
+			t.Fatalf("%s, expect %d requests, got %d", name, e, a)
+		}
+		for i, req := range received {
+			if e, a := c.ExpectEncoding, req.ContentEncoding; e != a {
+				t.Errorf("%s, %d, expect %q content encoding, got %q", name, i, e, a)
+			}
+			if e, a := expectBody, req.DecodedBody; !bytes.Equal(e, a) {
+				t.Errorf("%s, %d, expect %q body, got %q", name, i, e, a)
+			}
+			if len(c.ExpectEncoding) != 0 && len(req.Body) >= len(expectBody) {
+				t.Errorf("%s, %d, expect body to be compressed, got %d bytes", name, i, len(req.Body))
+			}
+			// Retries must send the same compressed body.
+			if e, a := received[0].Body, req.Body; !bytes.Equal(e, a) {
+				t.Errorf("%s, %d, expect retried body to match", name, i)
+			}
+		}
+	}
+}
+
+func TestCompressRequestBodyHandler_Presign(t *testing.T) {
+	svc := cloudwatch.New(unit.Session, aws.NewConfig().WithRequestCompressionMinSize(0))
+	req, _ := svc.PutMetricDataRequest(newCompressTestInput())
+
+	if _, err := req.Presign(time.Minute); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if v := req.HTTPRequest.Header.Get("Content-Encoding"); len(v) != 0 {
+		t.Errorf("expect presigned request not to be compressed, got %q", v)
+	}
+}
+
+func TestCompressRequestBodyHandler_StreamingPayload(t *testing.T) {
+	svc := s3.New(unit.Session, aws.NewConfig().WithRequestCompressionMinSize(0))
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader(bytes.Repeat([]byte("abc"), 100)),
+	})
+
+	if err := req.Sign(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if v := req.HTTPRequest.Header.Get("Content-Encoding"); len(v) != 0 {
+		t.Errorf("expect streaming payload not to be compressed, got %q", v)
+	}
+	if e, a := int64(300), req.HTTPRequest.ContentLength; e != a {
+		t.Errorf("expect %d content length, got %d", e, a)
+	}
+}
@@ -10,7 +10,9 @@ import (
 	"regexp"
 	"runtime"
 	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -67,6 +69,64 @@ var SDKVersionUserAgentHandler = request.NamedHandler{
 		runtime.Version(), runtime.GOOS, runtime.GOARCH),
 }
 
+// maxAppIDLength is the maximum length of the application ID added to the
+// User-Agent.
+const maxAppIDLength = 50
+
+// AppIDUserAgentHandler is a request handler for adding the application ID
+// set by aws.Config.AppID to the user agent, e.g. "app/my-application".
+// Invalid IDs are not added, and a warning is logged.
+var AppIDUserAgentHandler = request.NamedHandler{
+	Name: "core.AppIDUserAgentHandler",
+	Fn: func(r *request.Request) {
+		id := aws.StringValue(r.Config.AppID)
+		if len(id) == 0 {
+			return
+		}
+
+		if !validAppID(id) {
+			if r.Config.Logger != nil {
+				r.Config.Logger.Log(fmt.Sprintf("WARNING: invalid application ID %q not added to User-Agent,"+
+					" must be at most %d characters without spaces or control characters", id, maxAppIDLength))
+			}
+			return
+		}
+		request.AddToUserAgent(r, "app/"+id)
+	},
+}
+
+// validAppID returns if the application ID can be added to the User-Agent.
+func validAppID(id string) bool {
+	if len(id) > maxAppIDLength {
+		return false
+	}
+	for _, c := range id {
+		if unicode.IsSpace(c) || unicode.IsControl(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// ServiceIDUserAgentHandler is a request handler for adding the service ID
+// and API version of the request's service client to the user agent, e.g.
+// "api/API-Gateway#2015-07-09". Spaces in the service ID are replaced with
+// "-".
+var ServiceIDUserAgentHandler = request.NamedHandler{
+	Name: "core.ServiceIDUserAgentHandler",
+	Fn: func(r *request.Request) {
+		if len(r.ClientInfo.ServiceID) == 0 {
+			return
+		}
+
+		ua := "api/" + strings.Replace(r.ClientInfo.ServiceID, " ", "-", -1)
+		if len(r.ClientInfo.APIVersion) != 0 {
+			ua += "#" + r.ClientInfo.APIVersion
+		}
+		request.AddToUserAgent(r, ua)
+	},
+}
+
 var reStatusCode = regexp.MustCompile(`^(\d{3})`)
 
 // ValidateReqSigHandler is a request handler to ensure that the request's
@@ -205,6 +265,13 @@ var AfterRetryHandler = request.NamedHandler{Name: "core.AfterRetryHandler", Fn:
 		r.Retryable = aws.Bool(r.ShouldRetry(r))
 	}
 
+	if r.WillRetry() && !r.IsBodyRetryable() {
+		r.Error = awserr.New(request.ErrCodeBodyNotRetryable,
+			"request body not retryable", r.Error)
+		r.Retryable = aws.Bool(false)
+		return
+	}
+
 	if r.WillRetry() {
 		r.RetryDelay = r.RetryRules(r)
 
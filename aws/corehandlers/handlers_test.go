@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -150,6 +152,39 @@ func TestAfterRetryWithContext(t *testing.T) {
 	}
 }
 
+func TestAfterRetryWithNonSeekableBody(t *testing.T) {
+	c := awstesting.NewClient()
+
+	req := c.NewRequest(&request.Operation{Name: "Operation"}, nil, nil)
+	req.SetReaderBody(aws.ReadSeekCloser(bytes.NewBufferString("abc")))
+
+	origErr := fmt.Errorf("some error")
+	req.Error = origErr
+	req.Retryable = aws.Bool(true)
+	req.HTTPResponse = &http.Response{
+		StatusCode: 500,
+	}
+
+	corehandlers.AfterRetryHandler.Fn(req)
+
+	if req.Error == nil {
+		t.Fatalf("expect error but didn't receive one")
+	}
+	aerr := req.Error.(awserr.Error)
+	if e, a := request.ErrCodeBodyNotRetryable, aerr.Code(); e != a {
+		t.Errorf("expect %q, error code got %q", e, a)
+	}
+	if e, a := origErr, aerr.OrigErr(); e != a {
+		t.Errorf("expect %v orig error, got %v", e, a)
+	}
+	if req.WillRetry() {
+		t.Errorf("expect request not to be retried")
+	}
+	if e, a := 0, req.RetryCount; e != a {
+		t.Errorf("expect retry count to be %d, got %d", e, a)
+	}
+}
+
 func TestSendWithContextCanceled(t *testing.T) {
 	c := awstesting.NewClient(&aws.Config{
 		SleepDelay: func(dur time.Duration) {
@@ -353,3 +388,95 @@ func TestBuildContentLength_WithBody(t *testing.T) {
 
 	assert.NoError(t, err)
 }
+
+func TestServiceIDUserAgentHandler(t *testing.T) {
+	cases := map[string]struct {
+		ServiceID  string
+		APIVersion string
+		ExpectUA   string
+	}{
+		"service ID": {
+			ServiceID:  "API Gateway",
+			APIVersion: "2015-07-09",
+			ExpectUA:   "api/API-Gateway#2015-07-09",
+		},
+		"no API version": {
+			ServiceID: "SES",
+			ExpectUA:  "api/SES",
+		},
+		"no service ID": {
+			APIVersion: "2015-07-09",
+		},
+	}
+
+	for name, c := range cases {
+		r := &request.Request{
+			HTTPRequest: &http.Request{Header: http.Header{}},
+		}
+		r.ClientInfo.ServiceID = c.ServiceID
+		r.ClientInfo.APIVersion = c.APIVersion
+
+		corehandlers.ServiceIDUserAgentHandler.Fn(r)
+		if e, a := c.ExpectUA, r.HTTPRequest.Header.Get("User-Agent"); e != a {
+			t.Errorf("%s, expect %q user agent, got %q", name, e, a)
+		}
+	}
+}
+
+func TestAppIDUserAgentHandler(t *testing.T) {
+	cases := map[string]struct {
+		AppID     *string
+		ExpectUA  *regexp.Regexp
+		ExpectLog bool
+	}{
+		"no app ID": {
+			ExpectUA: regexp.MustCompile(`^aws-sdk-go/\S+ \(go\S+; \S+; \S+\) custom/1\.0$`),
+		},
+		"app ID": {
+			AppID:    aws.String("my-app_1.0"),
+			ExpectUA: regexp.MustCompile(`^aws-sdk-go/\S+ \(go\S+; \S+; \S+\) app/my-app_1\.0 custom/1\.0$`),
+		},
+		"space": {
+			AppID:     aws.String("my app"),
+			ExpectUA:  regexp.MustCompile(`^aws-sdk-go/\S+ \(go\S+; \S+; \S+\) custom/1\.0$`),
+			ExpectLog: true,
+		},
+		"control character": {
+			AppID:     aws.String("my-app\n"),
+			ExpectUA:  regexp.MustCompile(`^aws-sdk-go/\S+ \(go\S+; \S+; \S+\) custom/1\.0$`),
+			ExpectLog: true,
+		},
+		"too long": {
+			AppID:     aws.String(strings.Repeat("a", 51)),
+			ExpectUA:  regexp.MustCompile(`^aws-sdk-go/\S+ \(go\S+; \S+; \S+\) custom/1\.0$`),
+			ExpectLog: true,
+		},
+		"max length": {
+			AppID:    aws.String(strings.Repeat("a", 50)),
+			ExpectUA: regexp.MustCompile(`^aws-sdk-go/\S+ \(go\S+; \S+; \S+\) app/a{50} custom/1\.0$`),
+		},
+	}
+
+	for name, c := range cases {
+		var logged []string
+		svc := awstesting.NewClient(&aws.Config{
+			Region: aws.String("mock-region"),
+			AppID:  c.AppID,
+			Logger: aws.LoggerFunc(func(args ...interface{}) {
+				logged = append(logged, fmt.Sprint(args...))
+			}),
+		})
+		r := svc.NewRequest(&request.Operation{Name: "Operation"}, nil, nil)
+		r.ApplyOptions(request.WithAppendUserAgent("custom/1.0"))
+
+		if err := r.Build(); err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if ua := r.HTTPRequest.Header.Get("User-Agent"); !c.ExpectUA.MatchString(ua) {
+			t.Errorf("%s, expect user agent to match %v, got %q", name, c.ExpectUA, ua)
+		}
+		if e, a := c.ExpectLog, len(logged) != 0; e != a {
+			t.Errorf("%s, expect logged %t, got %v", name, e, logged)
+		}
+	}
+}
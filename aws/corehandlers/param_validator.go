@@ -1,6 +1,9 @@
 package corehandlers
 
-import "github.com/aws/aws-sdk-go/aws/request"
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
 
 // ValidateParametersHandler is a request handler to validate the input parameters.
 // Validating parameters only has meaning if done prior to the request being sent.
@@ -14,4 +17,32 @@ var ValidateParametersHandler = request.NamedHandler{Name: "core.ValidateParamet
 			r.Error = err
 		}
 	}
+
+	if !aws.BoolValue(r.Config.EnableExtendedValidation) {
+		return
+	}
+	if v, ok := r.Params.(request.ExtendedValidator); ok {
+		if err := v.ValidateExtended(); err != nil {
+			r.Error = mergeInvalidParams(r.Error, err)
+		}
+	}
 }}
+
+// mergeInvalidParams returns the errors of extended validation merged into
+// the errors of validation, so all invalid parameters are reported together.
+func mergeInvalidParams(err, extErr error) error {
+	if err == nil {
+		return extErr
+	}
+	invalidParams, ok := err.(request.ErrInvalidParams)
+	if !ok {
+		return err
+	}
+	extInvalidParams, ok := extErr.(request.ErrInvalidParams)
+	if !ok {
+		return err
+	}
+
+	invalidParams.Merge(extInvalidParams)
+	return invalidParams
+}
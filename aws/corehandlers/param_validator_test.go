@@ -230,6 +230,135 @@ func TestValidateFieldMinParameter(t *testing.T) {
 	}
 }
 
+type extendedInput struct {
+	Name   *string `required:"true" max:"5" pattern:"[a-z]+"`
+	Mode   *string `enum:"Mode"`
+	Count  *int64  `max:"10"`
+	Nested *extendedNestedInput
+}
+
+func (s *extendedInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "extendedInput"}
+	if s.Name == nil {
+		invalidParams.Add(request.NewErrParamRequired("Name"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+func (s *extendedInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "extendedInput"}
+	if s.Name != nil && len(*s.Name) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 5))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[a-z]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[a-z]+"))
+	}
+	if s.Mode != nil {
+		values := []string{"fast", "slow"}
+		if !request.IsParamEnumValue(*s.Mode, values) {
+			invalidParams.Add(request.NewErrParamEnum("Mode", *s.Mode, values))
+		}
+	}
+	if s.Count != nil && *s.Count > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("Count", 10))
+	}
+	if s.Nested != nil {
+		if err := s.Nested.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Nested", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+type extendedNestedInput struct {
+	List []*string `max:"2"`
+}
+
+func (s *extendedNestedInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "extendedNestedInput"}
+	if s.List != nil && len(s.List) > 2 {
+		invalidParams.Add(request.NewErrParamMaxLen("List", 2))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+func TestValidateExtendedParameters(t *testing.T) {
+	cases := map[string]struct {
+		Config    aws.Config
+		Input     *extendedInput
+		ExpectErr string
+	}{
+		"disabled": {
+			Input: &extendedInput{
+				Name: aws.String("ABCDEFG"),
+				Mode: aws.String("other"),
+			},
+		},
+		"valid": {
+			Config: aws.Config{EnableExtendedValidation: aws.Bool(true)},
+			Input: &extendedInput{
+				Name:   aws.String("abc"),
+				Mode:   aws.String("fast"),
+				Count:  aws.Int64(10),
+				Nested: &extendedNestedInput{List: []*string{aws.String("a")}},
+			},
+		},
+		"invalid": {
+			Config: aws.Config{EnableExtendedValidation: aws.Bool(true)},
+			Input: &extendedInput{
+				Name:   aws.String("ABCDEFG"),
+				Mode:   aws.String("other"),
+				Count:  aws.Int64(11),
+				Nested: &extendedNestedInput{List: []*string{aws.String("a"), aws.String("b"), aws.String("c")}},
+			},
+			ExpectErr: "InvalidParameter: 5 validation error(s) found.\n" +
+				"- maximum field size of 5, extendedInput.Name.\n" +
+				"- field value must match pattern [a-z]+, extendedInput.Name.\n" +
+				"- field value \"other\" must be one of fast, slow, extendedInput.Mode.\n" +
+				"- maximum field value of 10, extendedInput.Count.\n" +
+				"- maximum field size of 2, extendedInput.Nested.List.\n",
+		},
+		"merged with required": {
+			Config: aws.Config{EnableExtendedValidation: aws.Bool(true)},
+			Input:  &extendedInput{Mode: aws.String("other")},
+			ExpectErr: "InvalidParameter: 2 validation error(s) found.\n" +
+				"- missing required field, extendedInput.Name.\n" +
+				"- field value \"other\" must be one of fast, slow, extendedInput.Mode.\n",
+		},
+	}
+
+	for name, c := range cases {
+		svc := &client.Client{Config: c.Config}
+		req := svc.NewRequest(&request.Operation{}, c.Input, nil)
+		corehandlers.ValidateParametersHandler.Fn(req)
+
+		if len(c.ExpectErr) == 0 {
+			if req.Error != nil {
+				t.Errorf("%s, expect no error, got %v", name, req.Error)
+			}
+			continue
+		}
+		if req.Error == nil {
+			t.Fatalf("%s, expect error", name)
+		}
+		if e, a := c.ExpectErr, req.Error.Error(); e != a {
+			t.Errorf("%s, expect %q error, got %q", name, e, a)
+		}
+	}
+}
+
 func BenchmarkValidateAny(b *testing.B) {
 	input := &kinesis.PutRecordsInput{
 		StreamName: aws.String("stream"),
@@ -0,0 +1,16 @@
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package cache
+
+import "os"
+
+// lockFile does not lock the file on platforms without flock. Processes
+// retrieving the credentials at the same time may each retrieve them.
+func lockFile(f *os.File) error {
+	return nil
+}
+
+// unlockFile does nothing on platforms without flock.
+func unlockFile(f *os.File) error {
+	return nil
+}
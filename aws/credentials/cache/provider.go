@@ -0,0 +1,281 @@
+/*
+Package cache provides a credentials Provider caching the temporary
+credentials of another Provider in a directory shared between processes.
+
+Processes assuming the same role, such as short lived CLI invocations, read
+the role's credentials from the cache instead of each assuming the role
+again. The credentials are stored in a JSON file named after the cache key,
+and are only read from the cache while they are not expired.
+
+	p := &stscreds.AssumeRoleProvider{
+		Client:  sts.New(sess),
+		RoleARN: "myRoleArn",
+	}
+	creds := credentials.NewCredentials(cache.NewProvider(p, cache.Key("myRoleArn")))
+
+A lock file is held while the credentials are retrieved, so that processes
+retrieving the credentials at the same time retrieve them once. Locking is
+advisory, and is not supported on all platforms.
+
+The cache is best effort. Cache files which fail to be read, or which can be
+read by other users, are ignored and replaced once the credentials are
+retrieved. Errors writing the cache are ignored.
+*/
+package cache
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/internal/shareddefaults"
+)
+
+// ProviderName is the name of the credentials provider, used when the
+// credentials are read from the cache and the cached credentials do not
+// have a provider name.
+const ProviderName = "CacheProvider"
+
+// DefaultDir returns the directory the credentials are cached in by default,
+// ~/.aws/cli/cache.
+func DefaultDir() string {
+	return filepath.Join(shareddefaults.UserHomeDir(), ".aws", "cli", "cache")
+}
+
+// Key returns the cache key of the values identifying the credentials, such
+// as the ARN and session name of a role. The key is the hex encoded SHA-1
+// hash of the values.
+func Key(values ...string) string {
+	b, _ := json.Marshal(values)
+	hash := sha1.Sum(b)
+	return hex.EncodeToString(hash[:])
+}
+
+// Provider satisfies the credentials.Provider interface, and retrieves the
+// credentials of the wrapped Provider, caching them in the cache directory.
+//
+// Only credentials of wrapped Providers satisfying the credentials.Expirer
+// interface are cached. The cached credentials expire at the time reported
+// by the wrapped Provider, which includes the expiry window of the wrapped
+// Provider.
+type Provider struct {
+	credentials.Expiry
+
+	// Provider is the wrapped Provider the credentials are retrieved from
+	// when they are not cached.
+	Provider credentials.Provider
+
+	// Key is the name of the cache file of the credentials. See Key.
+	Key string
+
+	// Dir is the directory the credentials are cached in. Defaults to
+	// DefaultDir if empty.
+	Dir string
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring. Cached credentials expiring within
+	// the window are not read from the cache.
+	//
+	// So a ExpiryWindow of 10s would cause calls to IsExpired() to return true
+	// 10 seconds before the credentials are actually expired.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+}
+
+// NewProvider returns a Provider caching the credentials of the provider
+// with the key.
+func NewProvider(provider credentials.Provider, key string, options ...func(*Provider)) *Provider {
+	p := &Provider{
+		Provider: provider,
+		Key:      key,
+	}
+
+	for _, option := range options {
+		option(p)
+	}
+
+	return p
+}
+
+// cacheEntry is the JSON document of the cache file.
+type cacheEntry struct {
+	Credentials struct {
+		AccessKeyID     string `json:"AccessKeyId"`
+		SecretAccessKey string
+		SessionToken    string
+		Expiration      time.Time
+		AccountID       string `json:",omitempty"`
+	}
+	ProviderName string `json:",omitempty"`
+}
+
+// Retrieve returns the cached credentials if they are not expired, or
+// retrieves the credentials from the wrapped Provider and caches them.
+func (p *Provider) Retrieve() (credentials.Value, error) {
+	return p.retrieve(p.Provider.Retrieve)
+}
+
+// RetrieveWithContext is the same as Retrieve, and passes the context to the
+// wrapped Provider if it satisfies the credentials.ProviderWithContext
+// interface.
+func (p *Provider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	if pc, ok := p.Provider.(credentials.ProviderWithContext); ok {
+		return p.retrieve(func() (credentials.Value, error) {
+			return pc.RetrieveWithContext(ctx)
+		})
+	}
+	return p.Retrieve()
+}
+
+// retrieve returns the cached credentials, or retrieves the credentials with
+// the function and caches them.
+func (p *Provider) retrieve(fn func() (credentials.Value, error)) (credentials.Value, error) {
+	path := p.path()
+
+	if v, ok := p.read(path); ok {
+		return v, nil
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err == nil {
+		if f, err := os.OpenFile(path+".lock", os.O_RDWR|os.O_CREATE, 0600); err == nil {
+			defer f.Close()
+			if err := lockFile(f); err == nil {
+				defer unlockFile(f)
+			}
+
+			// The credentials may have been cached by another process while
+			// waiting for the lock.
+			if v, ok := p.read(path); ok {
+				return v, nil
+			}
+		}
+	}
+
+	v, err := fn()
+	if err != nil {
+		return v, err
+	}
+
+	expirer, ok := p.Provider.(credentials.Expirer)
+	if !ok || expirer.ExpiresAt().IsZero() {
+		p.SetExpiration(time.Time{}, 0)
+		return v, nil
+	}
+	expiration := expirer.ExpiresAt()
+	p.SetExpiration(expiration, p.ExpiryWindow)
+
+	p.write(path, v, expiration)
+	return v, nil
+}
+
+// IsExpired returns if the credentials are expired. Credentials of wrapped
+// Providers that do not report their expiration are expired if the wrapped
+// Provider reports them expired.
+func (p *Provider) IsExpired() bool {
+	if p.ExpiresAt().IsZero() {
+		return p.Provider.IsExpired()
+	}
+	return p.Expiry.IsExpired()
+}
+
+// path returns the path of the cache file.
+func (p *Provider) path() string {
+	dir := p.Dir
+	if len(dir) == 0 {
+		dir = DefaultDir()
+	}
+	return filepath.Join(dir, p.Key+".json")
+}
+
+// read returns the cached credentials, and if they were read. Credentials
+// that fail to be read, or are expired, are not read.
+func (p *Provider) read(path string) (credentials.Value, bool) {
+	f, err := os.Open(path)
+	if err != nil {
+		return credentials.Value{}, false
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil || !info.Mode().IsRegular() || insecureMode(info.Mode()) {
+		return credentials.Value{}, false
+	}
+
+	var entry cacheEntry
+	if err := json.NewDecoder(f).Decode(&entry); err != nil {
+		return credentials.Value{}, false
+	}
+	creds := entry.Credentials
+	if len(creds.AccessKeyID) == 0 || len(creds.SecretAccessKey) == 0 || creds.Expiration.IsZero() {
+		return credentials.Value{}, false
+	}
+
+	p.SetExpiration(creds.Expiration, p.ExpiryWindow)
+	if p.Expiry.IsExpired() {
+		return credentials.Value{}, false
+	}
+
+	providerName := entry.ProviderName
+	if len(providerName) == 0 {
+		providerName = ProviderName
+	}
+	return credentials.Value{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+		ProviderName:    providerName,
+		AccountID:       creds.AccountID,
+	}, true
+}
+
+// write caches the credentials. The cache file is replaced by renaming a
+// temporary file, so that other processes do not read partially written
+// credentials.
+func (p *Provider) write(path string, v credentials.Value, expiration time.Time) {
+	var entry cacheEntry
+	entry.Credentials.AccessKeyID = v.AccessKeyID
+	entry.Credentials.SecretAccessKey = v.SecretAccessKey
+	entry.Credentials.SessionToken = v.SessionToken
+	entry.Credentials.Expiration = expiration.UTC()
+	entry.Credentials.AccountID = v.AccountID
+	entry.ProviderName = v.ProviderName
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	// Temporary files are created with 0600 permissions.
+	f, err := ioutil.TempFile(filepath.Dir(path), p.Key+".tmp")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(b)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		if runtime.GOOS == "windows" {
+			// Rename fails on Windows if the file exists.
+			os.Remove(path)
+		}
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+}
+
+// insecureMode returns if the cache file can be accessed by other users.
+// Permissions are not checked on Windows.
+func insecureMode(mode os.FileMode) bool {
+	return runtime.GOOS != "windows" && mode.Perm()&0077 != 0
+}
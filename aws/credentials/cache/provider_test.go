@@ -0,0 +1,297 @@
+package cache
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+type stubProvider struct {
+	credentials.Expiry
+
+	mu         sync.Mutex
+	retrieved  int
+	expiration time.Time
+	err        error
+}
+
+func (s *stubProvider) Retrieve() (credentials.Value, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.retrieved++
+	if s.err != nil {
+		return credentials.Value{ProviderName: "stubProvider"}, s.err
+	}
+	s.SetExpiration(s.expiration, 0)
+	return credentials.Value{
+		AccessKeyID:     fmt.Sprintf("AKID_%d", s.retrieved),
+		SecretAccessKey: "SECRET",
+		SessionToken:    "TOKEN",
+		ProviderName:    "stubProvider",
+		AccountID:       "012345678901",
+	}, nil
+}
+
+type staticStubProvider struct {
+	retrieved int
+}
+
+func (s *staticStubProvider) Retrieve() (credentials.Value, error) {
+	s.retrieved++
+	return credentials.Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, nil
+}
+
+func (s *staticStubProvider) IsExpired() bool {
+	return false
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "aws-sdk-go-cache")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	return filepath.Join(dir, "cache")
+}
+
+func retrieve(t *testing.T, p *Provider) credentials.Value {
+	v, err := p.Retrieve()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	return v
+}
+
+func TestKey(t *testing.T) {
+	if e, a := Key("a", "b"), Key("a", "b"); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if a, b := Key("a", "b"), Key("a\", \"b"); a == b {
+		t.Errorf("expect keys of different values to differ, got %v", a)
+	}
+	if e, a := 40, len(Key("a")); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestProvider_SharedCache(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(filepath.Dir(dir))
+
+	expiration := time.Now().Add(time.Hour).Round(time.Second)
+	stub1 := &stubProvider{expiration: expiration}
+	p1 := NewProvider(stub1, Key("role"), func(p *Provider) { p.Dir = dir })
+
+	v := retrieve(t, p1)
+	if e, a := "AKID_1", v.AccessKeyID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := expiration, p1.ExpiresAt(); !e.Equal(a) {
+		t.Errorf("expect %v expiration, got %v", e, a)
+	}
+
+	info, err := os.Stat(filepath.Join(dir, Key("role")+".json"))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if runtime.GOOS != "windows" {
+		if e, a := os.FileMode(0600), info.Mode().Perm(); e != a {
+			t.Errorf("expect %v cache file mode, got %v", e, a)
+		}
+	}
+
+	// Another process retrieving the credentials reads them from the cache.
+	stub2 := &stubProvider{expiration: expiration}
+	p2 := NewProvider(stub2, Key("role"), func(p *Provider) { p.Dir = dir })
+
+	v = retrieve(t, p2)
+	expect := credentials.Value{
+		AccessKeyID:     "AKID_1",
+		SecretAccessKey: "SECRET",
+		SessionToken:    "TOKEN",
+		ProviderName:    "stubProvider",
+		AccountID:       "012345678901",
+	}
+	if e, a := expect, v; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := 0, stub2.retrieved; e != a {
+		t.Errorf("expect %v retrieves, got %v", e, a)
+	}
+	if e, a := expiration, p2.ExpiresAt(); !e.Equal(a) {
+		t.Errorf("expect %v expiration, got %v", e, a)
+	}
+	if p2.IsExpired() {
+		t.Errorf("expect cached credentials not to be expired")
+	}
+
+	// Credentials of other keys are not read.
+	stub3 := &stubProvider{expiration: expiration}
+	p3 := NewProvider(stub3, Key("other role"), func(p *Provider) { p.Dir = dir })
+	retrieve(t, p3)
+	if e, a := 1, stub3.retrieved; e != a {
+		t.Errorf("expect %v retrieves, got %v", e, a)
+	}
+}
+
+func TestProvider_ExpiredCache(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(filepath.Dir(dir))
+
+	stub := &stubProvider{expiration: time.Now().Add(5 * time.Minute)}
+	p := NewProvider(stub, Key("role"), func(p *Provider) { p.Dir = dir })
+	retrieve(t, p)
+
+	// The cached credentials expire within the expiry window.
+	stub2 := &stubProvider{expiration: time.Now().Add(time.Hour)}
+	p2 := NewProvider(stub2, Key("role"), func(p *Provider) {
+		p.Dir = dir
+		p.ExpiryWindow = 10 * time.Minute
+	})
+
+	v := retrieve(t, p2)
+	if e, a := "AKID_1", v.AccessKeyID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := 1, stub2.retrieved; e != a {
+		t.Errorf("expect %v retrieves, got %v", e, a)
+	}
+	if p2.IsExpired() {
+		t.Errorf("expect retrieved credentials not to be expired")
+	}
+}
+
+func TestProvider_InvalidCacheFile(t *testing.T) {
+	cases := map[string]struct {
+		Content string
+		Mode    os.FileMode
+	}{
+		"corrupt": {
+			Content: `{"Credentials": {`,
+			Mode:    0600,
+		},
+		"missing credentials": {
+			Content: fmt.Sprintf(`{"Credentials": {"Expiration": %q}}`,
+				time.Now().Add(time.Hour).UTC().Format(time.RFC3339)),
+			Mode: 0600,
+		},
+		"world readable": {
+			Content: fmt.Sprintf(`{"Credentials": {"AccessKeyId": "CACHED", "SecretAccessKey": "SECRET", "Expiration": %q}}`,
+				time.Now().Add(time.Hour).UTC().Format(time.RFC3339)),
+			Mode: 0644,
+		},
+	}
+
+	for name, c := range cases {
+		if runtime.GOOS == "windows" && c.Mode != 0600 {
+			continue
+		}
+
+		dir := tempDir(t)
+		path := filepath.Join(dir, Key("role")+".json")
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if err := ioutil.WriteFile(path, []byte(c.Content), c.Mode); err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		os.Chmod(path, c.Mode)
+
+		stub := &stubProvider{expiration: time.Now().Add(time.Hour)}
+		p := NewProvider(stub, Key("role"), func(p *Provider) { p.Dir = dir })
+
+		v := retrieve(t, p)
+		if e, a := "AKID_1", v.AccessKeyID; e != a {
+			t.Errorf("%s, expect %v, got %v", name, e, a)
+		}
+
+		// The cache file is replaced.
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if runtime.GOOS != "windows" {
+			if e, a := os.FileMode(0600), info.Mode().Perm(); e != a {
+				t.Errorf("%s, expect %v cache file mode, got %v", name, e, a)
+			}
+		}
+		p2 := NewProvider(&stubProvider{}, Key("role"), func(p *Provider) { p.Dir = dir })
+		if e, a := "AKID_1", retrieve(t, p2).AccessKeyID; e != a {
+			t.Errorf("%s, expect %v, got %v", name, e, a)
+		}
+
+		os.RemoveAll(filepath.Dir(dir))
+	}
+}
+
+func TestProvider_NotExpirer(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(filepath.Dir(dir))
+
+	stub := &staticStubProvider{}
+	p := NewProvider(stub, Key("static"), func(p *Provider) { p.Dir = dir })
+	retrieve(t, p)
+	retrieve(t, p)
+
+	if e, a := 2, stub.retrieved; e != a {
+		t.Errorf("expect %v retrieves, got %v", e, a)
+	}
+	if p.IsExpired() {
+		t.Errorf("expect credentials not to be expired")
+	}
+	if _, err := os.Stat(filepath.Join(dir, Key("static")+".json")); !os.IsNotExist(err) {
+		t.Errorf("expect credentials not to be cached, got %v", err)
+	}
+}
+
+func TestProvider_RetrieveError(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(filepath.Dir(dir))
+
+	stub := &stubProvider{err: fmt.Errorf("retrieve error")}
+	p := NewProvider(stub, Key("role"), func(p *Provider) { p.Dir = dir })
+
+	if _, err := p.Retrieve(); err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if _, err := os.Stat(filepath.Join(dir, Key("role")+".json")); !os.IsNotExist(err) {
+		t.Errorf("expect credentials not to be cached, got %v", err)
+	}
+}
+
+func TestProvider_ConcurrentRetrieve(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("cache files are not locked on windows")
+	}
+
+	dir := tempDir(t)
+	defer os.RemoveAll(filepath.Dir(dir))
+
+	stub := &stubProvider{expiration: time.Now().Add(time.Hour)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Each provider locks the cache file with its own file
+			// descriptor, as separate processes do.
+			p := NewProvider(stub, Key("role"), func(p *Provider) { p.Dir = dir })
+			if _, err := p.Retrieve(); err != nil {
+				t.Errorf("expect no error, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if e, a := 1, stub.retrieved; e != a {
+		t.Errorf("expect %v retrieves, got %v", e, a)
+	}
+}
@@ -1,15 +1,18 @@
 package credentials
 
 import (
+	"fmt"
+	"reflect"
+	"sync"
+
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
 
 var (
-	// ErrNoValidProvidersFoundInChain Is returned when there are no valid
-	// providers in the ChainProvider.
-	//
-	// This has been deprecated. For verbose error messaging set
-	// aws.Config.CredentialsChainVerboseErrors to true
+	// ErrNoValidProvidersFoundInChain Is returned when there are no
+	// providers in the ChainProvider. If the providers fail to retrieve
+	// credentials their errors are returned instead, with the same
+	// NoCredentialProviders error code.
 	//
 	// @readonly
 	ErrNoValidProvidersFoundInChain = awserr.New("NoCredentialProviders",
@@ -26,7 +29,10 @@ var (
 // in the list.
 //
 // If none of the Providers retrieve valid credentials Value, ChainProvider's
-// Retrieve() will return the error ErrNoValidProvidersFoundInChain.
+// Retrieve() will return an awserr.BatchedErrors with the NoCredentialProviders
+// error code, and the errors of the Providers in priority order. The errors
+// are also available from ProviderErrors. If there are no Providers
+// ErrNoValidProvidersFoundInChain is returned.
 //
 // If a Provider is found which returns valid credentials Value ChainProvider
 // will cache that Provider for all calls to IsExpired(), until Retrieve is
@@ -36,8 +42,8 @@ var (
 // In this example EnvProvider will first check if any credentials are available
 // via the environment variables. If there are none ChainProvider will check
 // the next Provider in the list, EC2RoleProvider in this case. If EC2RoleProvider
-// does not return any credentials ChainProvider will return the error
-// ErrNoValidProvidersFoundInChain
+// does not return any credentials ChainProvider will return the errors of
+// both Providers.
 //
 //     creds := credentials.NewChainCredentials(
 //         []credentials.Provider{
@@ -53,11 +59,41 @@ var (
 //     })))
 //
 type ChainProvider struct {
-	Providers     []Provider
-	curr          Provider
+	Providers []Provider
+	curr      Provider
+
+	// errs are the errors of the providers from the last Retrieve, guarded
+	// by m as ProviderErrors may be called while credentials are refreshed.
+	m    sync.Mutex
+	errs []error
+
+	// Deprecated: the errors of the Providers are always included in the
+	// error returned by Retrieve. VerboseErrors is ignored.
 	VerboseErrors bool
 }
 
+// A ProviderError is the error returned by a Provider of a ChainProvider
+// failing to retrieve credentials.
+type ProviderError struct {
+	// ProviderName is the name of the Provider, e.g. EnvProvider. The type
+	// name of the Provider is used if the Provider did not return a name with
+	// its error.
+	ProviderName string
+
+	// Err is the error returned by the Provider.
+	Err error
+}
+
+// Error returns the error of the Provider, prefixed with the Provider's name.
+func (e ProviderError) Error() string {
+	return fmt.Sprintf("%s: %v", e.ProviderName, e.Err)
+}
+
+// OrigErr returns the error returned by the Provider.
+func (e ProviderError) OrigErr() error {
+	return e.Err
+}
+
 // NewChainCredentials returns a pointer to a new Credentials object
 // wrapping a chain of providers.
 func NewChainCredentials(providers []Provider) *Credentials {
@@ -71,24 +107,82 @@ func NewChainCredentials(providers []Provider) *Credentials {
 //
 // If a provider is found it will be cached and any calls to IsExpired()
 // will return the expired state of the cached provider.
+//
+// If no provider is found the error is an awserr.BatchedErrors with the
+// NoCredentialProviders error code, and the errors of the providers as
+// ProviderError values in the order of the providers.
 func (c *ChainProvider) Retrieve() (Value, error) {
+	return c.RetrieveWithContext(backgroundContext())
+}
+
+// RetrieveWithContext is the same as Retrieve, and passes the context to the
+// providers satisfying the ProviderWithContext interface. The remaining
+// providers are not tried once the context is canceled.
+func (c *ChainProvider) RetrieveWithContext(ctx Context) (Value, error) {
 	var errs []error
 	for _, p := range c.Providers {
-		creds, err := p.Retrieve()
+		var creds Value
+		var err error
+		if pc, ok := p.(ProviderWithContext); ok {
+			creds, err = pc.RetrieveWithContext(ctx)
+		} else {
+			creds, err = p.Retrieve()
+		}
 		if err == nil {
 			c.curr = p
+			c.setErrs(nil)
 			return creds, nil
 		}
-		errs = append(errs, err)
+		errs = append(errs, ProviderError{
+			ProviderName: chainProviderName(p, creds),
+			Err:          err,
+		})
+
+		if ctx.Err() != nil {
+			c.curr = nil
+			c.setErrs(errs)
+			return Value{}, awserr.New("RequestCanceled",
+				"credentials retrieval canceled", ctx.Err())
+		}
 	}
 	c.curr = nil
+	c.setErrs(errs)
+
+	if len(errs) == 0 {
+		return Value{}, ErrNoValidProvidersFoundInChain
+	}
+	return Value{}, awserr.NewBatchError("NoCredentialProviders", "no valid providers in chain", errs)
+}
+
+// ProviderErrors returns the errors of the providers from the last call to
+// Retrieve, as ProviderError values in the order of the providers. Nil is
+// returned if the last Retrieve succeeded.
+func (c *ChainProvider) ProviderErrors() []error {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	return append([]error(nil), c.errs...)
+}
+
+func (c *ChainProvider) setErrs(errs []error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.errs = errs
+}
+
+// chainProviderName returns the name of the provider returned with its
+// error, or the provider's type name.
+func chainProviderName(p Provider, creds Value) string {
+	if len(creds.ProviderName) != 0 {
+		return creds.ProviderName
+	}
 
-	var err error
-	err = ErrNoValidProvidersFoundInChain
-	if c.VerboseErrors {
-		err = awserr.NewBatchError("NoCredentialProviders", "no valid providers in chain", errs)
+	t := reflect.TypeOf(p)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
 	}
-	return Value{}, err
+	return t.Name()
 }
 
 // IsExpired will returned the expired state of the currently cached provider
@@ -1,6 +1,10 @@
 package credentials
 
 import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -111,44 +115,109 @@ func TestChainProviderWithNoProvider(t *testing.T) {
 }
 
 func TestChainProviderWithNoValidProvider(t *testing.T) {
-	errs := []error{
-		awserr.New("FirstError", "first provider error", nil),
-		awserr.New("SecondError", "second provider error", nil),
-	}
+	os.Clearenv()
+
 	p := &ChainProvider{
 		Providers: []Provider{
-			&stubProvider{err: errs[0]},
-			&stubProvider{err: errs[1]},
+			&EnvProvider{},
+			ErrorProvider{
+				Err:          awserr.New("SecondError", "second provider error", nil),
+				ProviderName: "SharedCredentialsProvider",
+			},
+			&noNameProvider{err: fmt.Errorf("third provider error")},
 		},
 	}
 
 	assert.True(t, p.IsExpired(), "Expect expired with no providers")
 	_, err := p.Retrieve()
 
-	assert.Equal(t,
-		ErrNoValidProvidersFoundInChain,
-		err,
-		"Expect no providers error returned")
+	aerr, ok := err.(awserr.BatchedErrors)
+	if !ok {
+		t.Fatalf("expect awserr.BatchedErrors, got %T, %v", err, err)
+	}
+	if e, a := "NoCredentialProviders", aerr.Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+
+	expect := []struct {
+		Name, Message string
+	}{
+		{"EnvProvider", "EnvAccessKeyNotFound"},
+		{"SharedCredentialsProvider", "second provider error"},
+		{"noNameProvider", "third provider error"},
+	}
+	for _, errs := range [][]error{aerr.OrigErrs(), p.ProviderErrors()} {
+		if e, a := len(expect), len(errs); e != a {
+			t.Fatalf("expect %d provider errors, got %d, %v", e, a, errs)
+		}
+		for i, c := range expect {
+			perr, ok := errs[i].(ProviderError)
+			if !ok {
+				t.Fatalf("%d, expect ProviderError, got %T", i, errs[i])
+			}
+			if e, a := c.Name, perr.ProviderName; e != a {
+				t.Errorf("%d, expect %v provider name, got %v", i, e, a)
+			}
+			if !strings.Contains(perr.Error(), c.Message) {
+				t.Errorf("%d, expect %q in error, got %q", i, c.Message, perr.Error())
+			}
+		}
+	}
+
+	// All of the providers' messages are included in the error.
+	for _, c := range expect {
+		if !strings.Contains(err.Error(), c.Name+": ") || !strings.Contains(err.Error(), c.Message) {
+			t.Errorf("expect %v error in %q", c.Name, err.Error())
+		}
+	}
 }
 
-func TestChainProviderWithNoValidProviderWithVerboseEnabled(t *testing.T) {
-	errs := []error{
-		awserr.New("FirstError", "first provider error", nil),
-		awserr.New("SecondError", "second provider error", nil),
+func TestChainProviderErrorsReset(t *testing.T) {
+	failing := &stubProvider{err: awserr.New("FirstError", "first provider error", nil)}
+	p := &ChainProvider{
+		Providers: []Provider{failing},
 	}
+
+	_, err := p.Retrieve()
+	assert.Error(t, err)
+	assert.Len(t, p.ProviderErrors(), 1)
+
+	failing.err = nil
+	_, err = p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+	assert.Nil(t, p.ProviderErrors(), "Expect no provider errors after retrieve")
+}
+
+func TestChainProviderErrorsConcurrent(t *testing.T) {
 	p := &ChainProvider{
-		VerboseErrors: true,
 		Providers: []Provider{
-			&stubProvider{err: errs[0]},
-			&stubProvider{err: errs[1]},
+			&stubProvider{err: awserr.New("FirstError", "first provider error", nil)},
 		},
 	}
+	creds := NewCredentials(p)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			creds.Get()
+		}()
+		go func() {
+			defer wg.Done()
+			if errs := p.ProviderErrors(); len(errs) > 1 {
+				t.Errorf("expect at most 1 provider error, got %v", errs)
+			}
+		}()
+	}
+	wg.Wait()
 
-	assert.True(t, p.IsExpired(), "Expect expired with no providers")
-	_, err := p.Retrieve()
+	assert.Len(t, p.ProviderErrors(), 1)
+}
 
-	assert.Equal(t,
-		awserr.NewBatchError("NoCredentialProviders", "no valid providers in chain", errs),
-		err,
-		"Expect no providers error returned")
+type noNameProvider struct {
+	err error
 }
+
+func (p *noNameProvider) Retrieve() (Value, error) { return Value{}, p.err }
+func (p *noNameProvider) IsExpired() bool          { return true }
@@ -0,0 +1,27 @@
+package credentials
+
+import "time"
+
+// Context is a copy of the Go v1.7 stdlib's context.Context interface, and of
+// aws.Context. It is declared by the credentials package, since the aws
+// package imports the credentials package.
+//
+// See https://golang.org/pkg/context on how to use contexts.
+type Context interface {
+	// Deadline returns the time when work done on behalf of this context
+	// should be canceled. Deadline returns ok==false when no deadline is
+	// set.
+	Deadline() (deadline time.Time, ok bool)
+
+	// Done returns a channel that's closed when work done on behalf of this
+	// context should be canceled. Done may return nil if this context can
+	// never be canceled.
+	Done() <-chan struct{}
+
+	// Err returns a non-nil error value after Done is closed.
+	Err() error
+
+	// Value returns the value associated with this context for key, or nil
+	// if no value is associated with key.
+	Value(key interface{}) interface{}
+}
@@ -0,0 +1,37 @@
+// +build !go1.7
+
+package credentials
+
+import "time"
+
+// An emptyCtx is a copy of the Go 1.7 context.emptyCtx type, providing a Go
+// 1.6 and 1.5 safe background context.
+type emptyCtx int
+
+func (*emptyCtx) Deadline() (deadline time.Time, ok bool) {
+	return
+}
+
+func (*emptyCtx) Done() <-chan struct{} {
+	return nil
+}
+
+func (*emptyCtx) Err() error {
+	return nil
+}
+
+func (*emptyCtx) Value(key interface{}) interface{} {
+	return nil
+}
+
+func (e *emptyCtx) String() string {
+	return "credentials.BackgroundContext"
+}
+
+var backgroundCtx = new(emptyCtx)
+
+// backgroundContext returns a context that will never be canceled, has no
+// values, and no deadline.
+func backgroundContext() Context {
+	return backgroundCtx
+}
@@ -0,0 +1,11 @@
+// +build go1.7
+
+package credentials
+
+import "context"
+
+// backgroundContext returns a context that will never be canceled, has no
+// values, and no deadline.
+func backgroundContext() Context {
+	return context.Background()
+}
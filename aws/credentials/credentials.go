@@ -46,11 +46,27 @@
 //     creds := credentials.NewCredentials(&MyProvider{})
 //     credValue, err := creds.Get()
 //
+// Asynchronous Refresh
+//
+// By default expired credentials are retrieved by the Get() call finding them
+// expired, delaying the request being signed. With the WithAsyncRefresh
+// option the credentials of Providers satisfying the Expirer interface are
+// retrieved in the background once they are about to expire, while Get()
+// continues to return the cached credentials until they are expired.
+//
+//     creds := credentials.NewCredentialsWithOptions(
+//         stscreds.NewAssumeRoleProvider(...),
+//         credentials.WithAsyncRefresh(5*time.Minute),
+//     )
+//
 package credentials
 
 import (
+	"math/rand"
 	"sync"
 	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
 )
 
 // AnonymousCredentials is an empty Credential object that can be used as
@@ -81,6 +97,10 @@ type Value struct {
 
 	// Provider used to get credentials
 	ProviderName string
+
+	// AWS Account ID of the credentials. Empty if the account is not known
+	// by the Provider.
+	AccountID string
 }
 
 // A Provider is the interface for any component which will provide credentials
@@ -99,6 +119,19 @@ type Provider interface {
 	IsExpired() bool
 }
 
+// ProviderWithContext is a Provider that can retrieve credentials with a
+// Context. Canceling the context aborts the retrieval. Credentials uses
+// RetrieveWithContext for providers satisfying the interface, and Retrieve
+// otherwise.
+type ProviderWithContext interface {
+	Provider
+
+	// RetrieveWithContext returns nil if it successfully retrieved the
+	// value. Error is returned if the value were not obtainable, or empty,
+	// or the context was canceled.
+	RetrieveWithContext(Context) (Value, error)
+}
+
 // An ErrorProvider is a stub credentials provider that always returns an error
 // this is used by the SDK when construction a known provider is not possible
 // due to an error.
@@ -139,6 +172,12 @@ type Expiry struct {
 	// Defaults to time.Now if CurrentTime is not set.  Available for testing
 	// to be able to mock out the current time.
 	CurrentTime func() time.Time
+
+	// If set will be used by SetExpirationWithJitter to randomize the expiry
+	// window, and must return a value in [0.0,1.0). Defaults to rand.Float64
+	// if JitterRand is not set. Available for testing to be able to mock out
+	// the random source.
+	JitterRand func() float64
 }
 
 // SetExpiration sets the expiration IsExpired will check when called.
@@ -156,6 +195,31 @@ func (e *Expiry) SetExpiration(expiration time.Time, window time.Duration) {
 	}
 }
 
+// SetExpirationWithJitter sets the expiration IsExpired will check when
+// called, with the window randomized between window*(1-jitterFrac) and
+// window.
+//
+// Randomizing the window is helpful so that multiple processes sharing the
+// same credentials do not all refresh them at the same time. The randomized
+// window is chosen once, so IsExpired and ExpiresAt are consistent until the
+// expiration is set again.
+//
+// If jitterFrac is 0 or less the window is not randomized, and values larger
+// than 1 are treated as 1.
+func (e *Expiry) SetExpirationWithJitter(expiration time.Time, window time.Duration, jitterFrac float64) {
+	if window > 0 && jitterFrac > 0 {
+		if jitterFrac > 1 {
+			jitterFrac = 1
+		}
+		random := e.JitterRand
+		if random == nil {
+			random = rand.Float64
+		}
+		window -= time.Duration(float64(window) * jitterFrac * random())
+	}
+	e.SetExpiration(expiration, window)
+}
+
 // IsExpired returns if the credentials are expired.
 func (e *Expiry) IsExpired() bool {
 	if e.CurrentTime == nil {
@@ -164,6 +228,18 @@ func (e *Expiry) IsExpired() bool {
 	return e.expiration.Before(e.CurrentTime())
 }
 
+// ExpiresAt returns the time the credentials are considered expired at,
+// which is the expiration reduced by the expiry window.
+func (e *Expiry) ExpiresAt() time.Time {
+	return e.expiration
+}
+
+// An Expirer is a Provider which can report the time its credentials expire
+// at, such as Providers embedding Expiry.
+type Expirer interface {
+	ExpiresAt() time.Time
+}
+
 // A Credentials provides synchronous safe retrieval of AWS credentials Value.
 // Credentials will cache the credentials value until they expire. Once the value
 // expires the next Get will attempt to retrieve valid credentials.
@@ -181,8 +257,35 @@ type Credentials struct {
 	m            sync.Mutex
 
 	provider Provider
+
+	// asyncRefreshThreshold is the remaining lifetime of the credentials
+	// below which they are retrieved in the background. Disabled if 0.
+	asyncRefreshThreshold time.Duration
+
+	// refreshing is closed once the retrieval of the credentials, by a call
+	// to GetWithContext or in the background, is done, and is nil if the
+	// credentials are not being retrieved. The provider must not be used by
+	// other goroutines while it is set.
+	refreshing chan struct{}
+
+	// refreshExpiresAt is the time the cached credentials expire at, used
+	// instead of the provider while the credentials are being retrieved.
+	refreshExpiresAt time.Time
+
+	// refreshFailures is the number of background retrievals which failed
+	// since the credentials were last retrieved, and nextRefresh the time
+	// the retrieval is attempted again at.
+	refreshFailures uint
+	nextRefresh     time.Time
 }
 
+const (
+	// asyncRefreshMinBackoff and asyncRefreshMaxBackoff bound the delay
+	// before a failed background retrieval is attempted again.
+	asyncRefreshMinBackoff = time.Second
+	asyncRefreshMaxBackoff = time.Minute
+)
+
 // NewCredentials returns a pointer to a new Credentials with the provider set.
 func NewCredentials(provider Provider) *Credentials {
 	return &Credentials{
@@ -191,6 +294,33 @@ func NewCredentials(provider Provider) *Credentials {
 	}
 }
 
+// NewCredentialsWithOptions returns a pointer to a new Credentials with the
+// provider set, and configured by the options.
+func NewCredentialsWithOptions(provider Provider, options ...func(*Credentials)) *Credentials {
+	c := NewCredentials(provider)
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// WithAsyncRefresh returns an option retrieving the credentials in the
+// background once they expire within the threshold, instead of retrieving
+// them when Get() finds them expired. Get() returns the cached credentials
+// until the background retrieval is done, and only waits for the retrieval
+// if the credentials are expired.
+//
+// A single background retrieval is made at a time. If the retrieval fails it
+// is attempted again with an exponential backoff, and the cached credentials
+// are returned until they are expired.
+//
+// The option only applies to Providers satisfying the Expirer interface.
+func WithAsyncRefresh(threshold time.Duration) func(*Credentials) {
+	return func(c *Credentials) {
+		c.asyncRefreshThreshold = threshold
+	}
+}
+
 // Get returns the credentials value, or error if the credentials Value failed
 // to be retrieved.
 //
@@ -201,21 +331,123 @@ func NewCredentials(provider Provider) *Credentials {
 // If Credentials.Expire() was called the credentials Value will be force
 // expired, and the next call to Get() will cause them to be refreshed.
 func (c *Credentials) Get() (Value, error) {
+	return c.GetWithContext(backgroundContext())
+}
+
+// GetWithContext returns the credentials value, or error if the credentials
+// Value failed to be retrieved, or the context was canceled.
+//
+// GetWithContext behaves as Get, and passes the context to the Provider's
+// RetrieveWithContext if the Provider satisfies the ProviderWithContext
+// interface. Canceling the context stops waiting for the credentials being
+// retrieved by other callers, and aborts the retrieval made by this call.
+func (c *Credentials) GetWithContext(ctx Context) (Value, error) {
 	c.m.Lock()
 	defer c.m.Unlock()
 
+	// Wait for the credentials being retrieved by another call, or in the
+	// background, if the cached credentials are expired.
+	for c.refreshing != nil && c.isExpired() {
+		done := c.refreshing
+		c.m.Unlock()
+		select {
+		case <-done:
+		case <-ctx.Done():
+			c.m.Lock()
+			return Value{}, awserr.New("RequestCanceled",
+				"credentials retrieval canceled", ctx.Err())
+		}
+		c.m.Lock()
+	}
+
 	if c.isExpired() {
-		creds, err := c.provider.Retrieve()
+		// Retrieve the credentials without holding the lock, so that other
+		// callers waiting for them can be canceled. The cached credentials
+		// are expired while they are retrieved.
+		done := make(chan struct{})
+		c.refreshing = done
+		c.refreshExpiresAt = time.Time{}
+
+		c.m.Unlock()
+		creds, err := c.retrieve(ctx)
+		c.m.Lock()
+
+		c.refreshing = nil
+		close(done)
+
 		if err != nil {
 			return Value{}, err
 		}
 		c.creds = creds
 		c.forceRefresh = false
+		c.refreshFailures = 0
+		c.nextRefresh = time.Time{}
 	}
 
+	c.startAsyncRefresh()
+
 	return c.creds, nil
 }
 
+// retrieve retrieves the credentials from the provider with the context if
+// the provider supports it.
+func (c *Credentials) retrieve(ctx Context) (Value, error) {
+	if p, ok := c.provider.(ProviderWithContext); ok {
+		return p.RetrieveWithContext(ctx)
+	}
+	return c.provider.Retrieve()
+}
+
+// startAsyncRefresh starts retrieving the credentials in the background if
+// they expire within the async refresh threshold, and are not already being
+// retrieved. Must be called with the lock held.
+func (c *Credentials) startAsyncRefresh() {
+	if c.asyncRefreshThreshold <= 0 || c.refreshing != nil {
+		return
+	}
+	expirer, ok := c.provider.(Expirer)
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	expiresAt := expirer.ExpiresAt()
+	if expiresAt.IsZero() || expiresAt.Sub(now) >= c.asyncRefreshThreshold || now.Before(c.nextRefresh) {
+		// The credentials do not expire, are not about to expire, or the
+		// retrieval is backing off.
+		return
+	}
+
+	done := make(chan struct{})
+	c.refreshing = done
+	c.refreshExpiresAt = expiresAt
+
+	go func() {
+		creds, err := c.retrieve(backgroundContext())
+
+		c.m.Lock()
+		defer c.m.Unlock()
+
+		if err != nil {
+			// Keep the cached credentials, and retry with backoff.
+			backoff := asyncRefreshMaxBackoff
+			if c.refreshFailures < 6 {
+				backoff = asyncRefreshMinBackoff << c.refreshFailures
+			}
+			c.refreshFailures++
+			c.nextRefresh = time.Now().Add(backoff)
+		} else {
+			c.creds = creds
+			c.forceRefresh = false
+			c.refreshFailures = 0
+			c.nextRefresh = time.Time{}
+		}
+
+		c.refreshing = nil
+		close(done)
+	}()
+}
+
 // Expire expires the credentials and forces them to be retrieved on the
 // next call to Get().
 //
@@ -240,7 +472,48 @@ func (c *Credentials) IsExpired() bool {
 	return c.isExpired()
 }
 
+// ExpiresAt returns the time the credentials retrieved by the Provider are
+// considered expired at. An error is returned if the Provider does not
+// satisfy the Expirer interface.
+func (c *Credentials) ExpiresAt() (time.Time, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	expirer, ok := c.provider.(Expirer)
+	if !ok {
+		return time.Time{}, awserr.New("ProviderNotExpirer",
+			"provider does not support ExpiresAt()", nil)
+	}
+	if c.forceRefresh {
+		// The credentials were forced to be expired, or were not retrieved
+		// yet.
+		return time.Time{}, nil
+	}
+	if c.refreshing != nil {
+		return c.refreshExpiresAt, nil
+	}
+	return expirer.ExpiresAt(), nil
+}
+
+// AccountID returns the ID of the AWS account of the credentials, retrieving
+// the credentials if they are expired. An empty ID is returned if the account
+// is not known by the Provider.
+//
+// The credentials are retrieved as GetWithContext retrieves them, canceling
+// the context aborts the retrieval.
+func (c *Credentials) AccountID(ctx Context) (string, error) {
+	creds, err := c.GetWithContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	return creds.AccountID, nil
+}
+
 // isExpired helper method wrapping the definition of expired credentials.
 func (c *Credentials) isExpired() bool {
+	if c.refreshing != nil {
+		// The provider is being used by the background retrieval.
+		return c.forceRefresh || c.refreshExpiresAt.Before(time.Now())
+	}
 	return c.forceRefresh || c.provider.IsExpired()
 }
@@ -1,7 +1,11 @@
 package credentials
 
 import (
+	"fmt"
+	"math/rand"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/stretchr/testify/assert"
@@ -71,3 +75,413 @@ func TestCredentialsGetWithProviderName(t *testing.T) {
 	assert.Nil(t, err, "Expected no error")
 	assert.Equal(t, creds.ProviderName, "stubProvider", "Expected provider name to match")
 }
+
+func TestCredentialsAccountID(t *testing.T) {
+	stub := &stubProvider{
+		creds:   Value{AccessKeyID: "AKID", AccountID: "012345678901"},
+		expired: true,
+	}
+	c := NewCredentials(stub)
+
+	accountID, err := c.AccountID(backgroundContext())
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, "012345678901", accountID, "Expect account ID to match")
+	assert.False(t, c.IsExpired(), "Expect credentials to be retrieved")
+
+	c = NewCredentials(&stubProvider{err: awserr.New("provider error", "", nil), expired: true})
+	_, err = c.AccountID(backgroundContext())
+	assert.Equal(t, "provider error", err.(awserr.Error).Code(), "Expected provider error")
+}
+
+func TestExpiry_SetExpirationWithJitter(t *testing.T) {
+	expiration := time.Date(2017, 9, 1, 0, 0, 0, 0, time.UTC)
+	window := 10 * time.Minute
+	jitterFrac := 0.5
+	lower, upper := expiration.Add(-window), expiration.Add(-5*time.Minute)
+
+	const samples, buckets = 2000, 10
+	counts := make([]int, buckets)
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < samples; i++ {
+		e := Expiry{JitterRand: random.Float64}
+		e.SetExpirationWithJitter(expiration, window, jitterFrac)
+
+		expiresAt := e.ExpiresAt()
+		if expiresAt.Before(lower) || expiresAt.After(upper) {
+			t.Fatalf("expect expiration between %v and %v, got %v", lower, upper, expiresAt)
+		}
+
+		// IsExpired is consistent with the expiration chosen.
+		e.CurrentTime = func() time.Time { return expiresAt.Add(-time.Nanosecond) }
+		if e.IsExpired() {
+			t.Fatalf("expect not expired before %v", expiresAt)
+		}
+		e.CurrentTime = func() time.Time { return expiresAt.Add(time.Nanosecond) }
+		if !e.IsExpired() {
+			t.Fatalf("expect expired after %v", expiresAt)
+		}
+
+		bucket := int(expiresAt.Sub(lower) * buckets / upper.Sub(lower))
+		if bucket == buckets {
+			bucket--
+		}
+		counts[bucket]++
+	}
+
+	// The refresh times are spread across the range, each bucket expects
+	// samples/buckets refreshes.
+	for i, n := range counts {
+		if n < samples/buckets/2 {
+			t.Errorf("expect refreshes spread across range, bucket %d got %d of %d, %v", i, n, samples, counts)
+		}
+	}
+}
+
+func TestExpiry_SetExpirationWithJitterDisabled(t *testing.T) {
+	expiration := time.Date(2017, 9, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		Window     time.Duration
+		JitterFrac float64
+		Expect     time.Time
+	}{
+		{Window: time.Minute, JitterFrac: 0, Expect: expiration.Add(-time.Minute)},
+		{Window: 0, JitterFrac: 0.5, Expect: expiration},
+		{Window: time.Minute, JitterFrac: 2, Expect: expiration},
+	}
+
+	for i, c := range cases {
+		e := Expiry{JitterRand: func() float64 { return 0.9999999999 }}
+		e.SetExpirationWithJitter(expiration, c.Window, c.JitterFrac)
+		if a := e.ExpiresAt(); a.Sub(c.Expect) > time.Millisecond || c.Expect.Sub(a) > time.Millisecond {
+			t.Errorf("%d, expect %v expiration, got %v", i, c.Expect, a)
+		}
+	}
+}
+
+type stubExpirerProvider struct {
+	stubProvider
+	Expiry
+}
+
+func (s *stubExpirerProvider) IsExpired() bool {
+	return s.Expiry.IsExpired()
+}
+
+func TestCredentialsExpiresAt(t *testing.T) {
+	expiration := time.Now().Add(time.Hour)
+	p := &stubExpirerProvider{}
+	p.SetExpiration(expiration, time.Minute)
+	c := NewCredentials(p)
+
+	// Credentials not yet retrieved are expired.
+	if a, err := c.ExpiresAt(); err != nil || !a.IsZero() {
+		t.Errorf("expect zero time and no error, got %v, %v", a, err)
+	}
+
+	if _, err := c.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	a, err := c.ExpiresAt()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e := expiration.Add(-time.Minute); !e.Equal(a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	_, err = NewCredentials(&stubProvider{}).ExpiresAt()
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != "ProviderNotExpirer" {
+		t.Errorf("expect ProviderNotExpirer error, got %v", err)
+	}
+}
+
+// asyncStubProvider returns credentials with a new access key ID each time
+// they are retrieved. Retrieve waits for release to be closed if set.
+type asyncStubProvider struct {
+	Expiry
+
+	mu        sync.Mutex
+	retrieves int
+	errs      []error
+	release   chan struct{}
+}
+
+func (p *asyncStubProvider) Retrieve() (Value, error) {
+	p.mu.Lock()
+	p.retrieves++
+	n := p.retrieves
+	var err error
+	if len(p.errs) != 0 {
+		err, p.errs = p.errs[0], p.errs[1:]
+	}
+	release := p.release
+	p.mu.Unlock()
+
+	if release != nil {
+		<-release
+	}
+	if err != nil {
+		return Value{}, err
+	}
+	p.SetExpiration(time.Now().Add(time.Hour), 0)
+	return Value{AccessKeyID: fmt.Sprintf("AKID_%d", n)}, nil
+}
+
+func (p *asyncStubProvider) retrieveCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.retrieves
+}
+
+// waitAsyncRefresh waits for the background retrieval of the credentials to
+// be done.
+func waitAsyncRefresh(c *Credentials) {
+	c.m.Lock()
+	done := c.refreshing
+	c.m.Unlock()
+	if done != nil {
+		<-done
+	}
+}
+
+// newAsyncRefreshCredentials returns credentials which were retrieved, and
+// expire within the async refresh threshold.
+func newAsyncRefreshCredentials(t *testing.T, p *asyncStubProvider) *Credentials {
+	c := NewCredentialsWithOptions(p, WithAsyncRefresh(30*time.Minute))
+	if _, err := c.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	p.SetExpiration(time.Now().Add(10*time.Minute), 0)
+	return c
+}
+
+func TestCredentialsAsyncRefresh(t *testing.T) {
+	p := &asyncStubProvider{}
+	c := newAsyncRefreshCredentials(t, p)
+	release := make(chan struct{})
+	p.release = release
+
+	// The cached credentials are returned while being retrieved.
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				creds, err := c.Get()
+				if err != nil {
+					t.Errorf("expect no error, got %v", err)
+					return
+				}
+				if e, a := "AKID_1", creds.AccessKeyID; e != a {
+					t.Errorf("expect %v, got %v", e, a)
+					return
+				}
+				c.IsExpired()
+				c.ExpiresAt()
+			}
+		}()
+	}
+	wg.Wait()
+
+	close(release)
+	waitAsyncRefresh(c)
+
+	creds, err := c.Get()
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, "AKID_2", creds.AccessKeyID, "Expect refreshed credentials")
+	assert.Equal(t, 2, p.retrieveCount(), "Expect a single background retrieval")
+}
+
+func TestCredentialsAsyncRefreshExpired(t *testing.T) {
+	p := &asyncStubProvider{}
+	c := newAsyncRefreshCredentials(t, p)
+	release := make(chan struct{})
+	p.release = release
+
+	if _, err := c.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	c.Expire()
+
+	// Expired credentials wait for the background retrieval.
+	results := make(chan string)
+	for i := 0; i < 10; i++ {
+		go func() {
+			creds, err := c.Get()
+			if err != nil {
+				t.Errorf("expect no error, got %v", err)
+			}
+			results <- creds.AccessKeyID
+		}()
+	}
+
+	select {
+	case a := <-results:
+		t.Fatalf("expect Get to wait for the retrieval, got %v", a)
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, "AKID_2", <-results, "Expect refreshed credentials")
+	}
+	assert.Equal(t, 2, p.retrieveCount(), "Expect a single background retrieval")
+}
+
+func TestCredentialsAsyncRefreshFailure(t *testing.T) {
+	p := &asyncStubProvider{errs: []error{nil, awserr.New("RetrieveError", "", nil)}}
+	c := newAsyncRefreshCredentials(t, p)
+
+	c.Get()
+	waitAsyncRefresh(c)
+
+	// The failed retrieval is not attempted again until the backoff elapsed,
+	// and the cached credentials are returned.
+	creds, err := c.Get()
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, "AKID_1", creds.AccessKeyID, "Expect cached credentials")
+	waitAsyncRefresh(c)
+	assert.Equal(t, 2, p.retrieveCount(), "Expect retrieval to back off")
+	c.m.Lock()
+	assert.Equal(t, uint(1), c.refreshFailures)
+	assert.True(t, c.nextRefresh.After(time.Now()), "Expect retrieval to back off")
+	c.nextRefresh = time.Now()
+	c.m.Unlock()
+
+	c.Get()
+	waitAsyncRefresh(c)
+
+	creds, err = c.Get()
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, "AKID_3", creds.AccessKeyID, "Expect refreshed credentials")
+	assert.Equal(t, 3, p.retrieveCount())
+	assert.Equal(t, uint(0), c.refreshFailures)
+}
+
+func TestCredentialsAsyncRefreshNotExpirer(t *testing.T) {
+	c := NewCredentialsWithOptions(&stubProvider{}, WithAsyncRefresh(time.Hour))
+
+	creds, err := c.Get()
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, "stubProvider", creds.ProviderName)
+	assert.Nil(t, c.refreshing, "Expect no background retrieval")
+}
+
+// stubContext is a Context canceled by closing done.
+type stubContext struct {
+	done chan struct{}
+}
+
+func (c *stubContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (c *stubContext) Done() <-chan struct{}       { return c.done }
+func (c *stubContext) Value(interface{}) interface{} {
+	return nil
+}
+func (c *stubContext) Err() error {
+	select {
+	case <-c.done:
+		return fmt.Errorf("context canceled")
+	default:
+		return nil
+	}
+}
+
+type contextStubProvider struct {
+	stubProvider
+	ctx Context
+}
+
+func (p *contextStubProvider) RetrieveWithContext(ctx Context) (Value, error) {
+	p.ctx = ctx
+	return p.Retrieve()
+}
+
+func TestCredentialsGetWithContext(t *testing.T) {
+	p := &contextStubProvider{
+		stubProvider: stubProvider{creds: Value{AccessKeyID: "AKID"}, expired: true},
+	}
+	c := NewCredentials(p)
+
+	ctx := &stubContext{done: make(chan struct{})}
+	creds, err := c.GetWithContext(ctx)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := Context(ctx), p.ctx; e != a {
+		t.Errorf("expect provider to be passed the context, got %v", a)
+	}
+}
+
+// canceledStubProvider waits for the context it retrieves the credentials
+// with to be canceled.
+type canceledStubProvider struct {
+	stubProvider
+}
+
+func (p *canceledStubProvider) RetrieveWithContext(ctx Context) (Value, error) {
+	<-ctx.Done()
+	return Value{}, awserr.New("RequestCanceled", "retrieval canceled", ctx.Err())
+}
+
+func TestCredentialsAccountIDCanceled(t *testing.T) {
+	c := NewCredentials(&canceledStubProvider{
+		stubProvider: stubProvider{creds: Value{AccountID: "012345678901"}, expired: true},
+	})
+
+	ctx := &stubContext{done: make(chan struct{})}
+	close(ctx.done)
+	accountID, err := c.AccountID(ctx)
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := "RequestCanceled", err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if len(accountID) != 0 {
+		t.Errorf("expect no account ID, got %v", accountID)
+	}
+	if !c.IsExpired() {
+		t.Errorf("expect credentials to still be expired")
+	}
+}
+
+func TestCredentialsGetWithContextCanceledWait(t *testing.T) {
+	p := &asyncStubProvider{release: make(chan struct{})}
+	c := NewCredentials(p)
+
+	// The first call blocks retrieving the credentials.
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := c.Get()
+		errCh <- err
+	}()
+	for p.retrieveCount() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The second call waiting for the credentials is canceled.
+	ctx := &stubContext{done: make(chan struct{})}
+	close(ctx.done)
+	_, err := c.GetWithContext(ctx)
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := "RequestCanceled", err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+
+	close(p.release)
+	if err := <-errCh; err != nil {
+		t.Errorf("expect no error, got %v", err)
+	}
+	if e, a := 1, p.retrieveCount(); e != a {
+		t.Errorf("expect %v retrieves, got %v", e, a)
+	}
+	if c.IsExpired() {
+		t.Errorf("expect credentials not to be expired")
+	}
+}
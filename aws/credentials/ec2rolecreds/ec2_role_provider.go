@@ -4,10 +4,12 @@ import (
 	"bufio"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"path"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -50,6 +52,18 @@ type EC2RoleProvider struct {
 	//
 	// If ExpiryWindow is 0 or less it will be ignored.
 	ExpiryWindow time.Duration
+
+	// ExpiryWindowJitterFrac randomizes the ExpiryWindow, so that processes
+	// sharing the same credentials do not refresh them at the same time. The
+	// window is randomized between ExpiryWindow*(1-ExpiryWindowJitterFrac)
+	// and ExpiryWindow each time the credentials are retrieved.
+	//
+	// So a ExpiryWindowJitterFrac of 0.5 with a ExpiryWindow of 10s would
+	// cause calls to IsExpired() to return true between 5 and 10 seconds
+	// before the credentials are actually expired.
+	//
+	// If ExpiryWindowJitterFrac is 0 or less it will be ignored.
+	ExpiryWindowJitterFrac float64
 }
 
 // NewCredentials returns a pointer to a new Credentials object wrapping
@@ -67,6 +81,15 @@ func NewCredentials(c client.ConfigProvider, options ...func(*EC2RoleProvider))
 	return credentials.NewCredentials(p)
 }
 
+// WithHTTPClient returns an option setting the HTTP client the requests of
+// the provider's EC2Metadata client are sent with, such as a client not
+// sending the requests through the proxy of the service clients.
+func WithHTTPClient(httpClient *http.Client) func(*EC2RoleProvider) {
+	return func(p *EC2RoleProvider) {
+		p.Client.Config.HTTPClient = httpClient
+	}
+}
+
 // NewCredentialsWithClient returns a pointer to a new Credentials object wrapping
 // the EC2RoleProvider. Takes a EC2Metadata client to use when connecting to EC2
 // metadata service.
@@ -86,7 +109,15 @@ func NewCredentialsWithClient(client *ec2metadata.EC2Metadata, options ...func(*
 // Error will be returned if the request fails, or unable to extract
 // the desired credentials.
 func (m *EC2RoleProvider) Retrieve() (credentials.Value, error) {
-	credsList, err := requestCredList(m.Client)
+	return m.RetrieveWithContext(aws.BackgroundContext())
+}
+
+// RetrieveWithContext retrieves credentials from the EC2 service, canceling
+// the requests to the EC2 metadata service if the context is canceled.
+// Error will be returned if the request fails, or unable to extract
+// the desired credentials.
+func (m *EC2RoleProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	credsList, err := requestCredList(ctx, m.Client)
 	if err != nil {
 		return credentials.Value{ProviderName: ProviderName}, err
 	}
@@ -96,12 +127,12 @@ func (m *EC2RoleProvider) Retrieve() (credentials.Value, error) {
 	}
 	credsName := credsList[0]
 
-	roleCreds, err := requestCred(m.Client, credsName)
+	roleCreds, err := requestCred(ctx, m.Client, credsName)
 	if err != nil {
 		return credentials.Value{ProviderName: ProviderName}, err
 	}
 
-	m.SetExpiration(roleCreds.Expiration, m.ExpiryWindow)
+	m.SetExpirationWithJitter(roleCreds.Expiration, m.ExpiryWindow, m.ExpiryWindowJitterFrac)
 
 	return credentials.Value{
 		AccessKeyID:     roleCreds.AccessKeyID,
@@ -129,8 +160,8 @@ const iamSecurityCredsPath = "/iam/security-credentials"
 
 // requestCredList requests a list of credentials from the EC2 service.
 // If there are no credentials, or there is an error making or receiving the request
-func requestCredList(client *ec2metadata.EC2Metadata) ([]string, error) {
-	resp, err := client.GetMetadata(iamSecurityCredsPath)
+func requestCredList(ctx aws.Context, client *ec2metadata.EC2Metadata) ([]string, error) {
+	resp, err := client.GetMetadataWithContext(ctx, iamSecurityCredsPath)
 	if err != nil {
 		return nil, awserr.New("EC2RoleRequestError", "no EC2 instance role found", err)
 	}
@@ -152,8 +183,8 @@ func requestCredList(client *ec2metadata.EC2Metadata) ([]string, error) {
 //
 // If the credentials cannot be found, or there is an error reading the response
 // and error will be returned.
-func requestCred(client *ec2metadata.EC2Metadata, credsName string) (ec2RoleCredRespBody, error) {
-	resp, err := client.GetMetadata(path.Join(iamSecurityCredsPath, credsName))
+func requestCred(ctx aws.Context, client *ec2metadata.EC2Metadata, credsName string) (ec2RoleCredRespBody, error) {
+	resp, err := client.GetMetadataWithContext(ctx, path.Join(iamSecurityCredsPath, credsName))
 	if err != nil {
 		return ec2RoleCredRespBody{},
 			awserr.New("EC2RoleRequestError",
@@ -13,6 +13,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
 	"github.com/aws/aws-sdk-go/awstesting/unit"
 )
 
@@ -66,6 +68,69 @@ func TestEC2RoleProvider(t *testing.T) {
 	assert.Equal(t, "token", creds.SessionToken, "Expect session token to match")
 }
 
+type countingTransport struct {
+	requests int
+}
+
+func (c *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	c.requests++
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestEC2RoleProvider_WithHTTPClient(t *testing.T) {
+	server := initTestServer("2014-12-16T01:51:37Z", false)
+	defer server.Close()
+
+	transport := &countingTransport{}
+	creds := ec2rolecreds.NewCredentials(
+		unit.Session.Copy(&aws.Config{Endpoint: aws.String(server.URL + "/latest")}),
+		ec2rolecreds.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+
+	v, err := creds.Get()
+	assert.Nil(t, err, "Expect no error, %v", err)
+	assert.Equal(t, "accessKey", v.AccessKeyID, "Expect access key ID to match")
+	assert.True(t, transport.requests > 0, "Expect requests sent with the HTTP client")
+}
+
+func TestEC2RoleProvider_RetrieveWithContextCanceled(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The metadata service hangs until the test is done.
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	creds := ec2rolecreds.NewCredentials(
+		unit.Session.Copy(&aws.Config{Endpoint: aws.String(server.URL + "/latest")}),
+	)
+
+	ctx := &awstesting.FakeContext{DoneCh: make(chan struct{})}
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		ctx.Error = fmt.Errorf("context canceled")
+		close(ctx.DoneCh)
+	}()
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := creds.GetWithContext(ctx)
+		errCh <- err
+	}()
+
+	select {
+	case err := <-errCh:
+		assert.NotNil(t, err, "Expect error")
+		aerr, ok := err.(awserr.Error)
+		assert.True(t, ok, "Expect awserr.Error, got %T", err)
+		assert.Equal(t, "EC2RoleRequestError", aerr.Code(), "Expect error code to match")
+		assert.Equal(t, request.CanceledErrorCode, aerr.OrigErr().(awserr.Error).Code(), "Expect canceled request")
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expect canceled context to abort retrieving credentials")
+	}
+}
+
 func TestEC2RoleProviderFailAssume(t *testing.T) {
 	server := initTestServer("2014-12-16T01:51:37Z", true)
 	defer server.Close()
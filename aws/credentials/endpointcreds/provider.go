@@ -27,10 +27,20 @@
 //        "code": "ErrorCode",
 //        "message": "Helpful error message."
 //    }
+//
+// The credentials request is sent with the Authorization header if an
+// authorization token is configured. The token is returned by the
+// AuthorizationTokenProvider if set, or is the static AuthorizationToken. The
+// AuthorizationTokenFile helper provides a token which is read from a file
+// each time the credentials are retrieved, so that rotated tokens are used.
 package endpointcreds
 
 import (
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -65,6 +75,42 @@ type Provider struct {
 	//
 	// If ExpiryWindow is 0 or less it will be ignored.
 	ExpiryWindow time.Duration
+
+	// ExpiryWindowJitterFrac randomizes the ExpiryWindow, so that processes
+	// sharing the same credentials do not refresh them at the same time. The
+	// window is randomized between ExpiryWindow*(1-ExpiryWindowJitterFrac)
+	// and ExpiryWindow each time the credentials are retrieved.
+	//
+	// So a ExpiryWindowJitterFrac of 0.5 with a ExpiryWindow of 10s would
+	// cause calls to IsExpired() to return true between 5 and 10 seconds
+	// before the credentials are actually expired.
+	//
+	// If ExpiryWindowJitterFrac is 0 or less it will be ignored.
+	ExpiryWindowJitterFrac float64
+
+	// AuthorizationToken is the value of the Authorization header the
+	// credentials request is sent with. The header is not sent if empty.
+	AuthorizationToken string
+
+	// AuthorizationTokenProvider returns the value of the Authorization
+	// header the credentials request is sent with, and is called each time
+	// the credentials are retrieved. Takes precedence over AuthorizationToken
+	// if set.
+	AuthorizationTokenProvider func() (string, error)
+}
+
+// AuthorizationTokenFile returns an AuthorizationTokenProvider reading the
+// authorization token from the file at path. The file is read each time the
+// token is provided, and trailing newlines are trimmed from the token.
+func AuthorizationTokenFile(path string) func() (string, error) {
+	return func() (string, error) {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", awserr.New("CredentialsEndpointError",
+				fmt.Sprintf("failed to read authorization token file, %s", path), err)
+		}
+		return strings.TrimRight(string(b), "\r\n"), nil
+	}
 }
 
 // NewProviderClient returns a credentials Provider for retrieving AWS credentials
@@ -93,6 +139,15 @@ func NewProviderClient(cfg aws.Config, handlers request.Handlers, endpoint strin
 	return p
 }
 
+// WithHTTPClient returns an option setting the HTTP client the credentials
+// requests are sent with, such as a client not sending the requests through
+// the proxy of the service clients.
+func WithHTTPClient(httpClient *http.Client) func(*Provider) {
+	return func(p *Provider) {
+		p.Client.Config.HTTPClient = httpClient
+	}
+}
+
 // NewCredentialsClient returns a Credentials wrapper for retrieving credentials
 // from an arbitrary endpoint concurrently. The client will request the
 func NewCredentialsClient(cfg aws.Config, handlers request.Handlers, endpoint string, options ...func(*Provider)) *credentials.Credentials {
@@ -111,14 +166,21 @@ func (p *Provider) IsExpired() bool {
 // Retrieve will attempt to request the credentials from the endpoint the Provider
 // was configured for. And error will be returned if the retrieval fails.
 func (p *Provider) Retrieve() (credentials.Value, error) {
-	resp, err := p.getCredentials()
+	return p.RetrieveWithContext(aws.BackgroundContext())
+}
+
+// RetrieveWithContext will attempt to request the credentials from the endpoint
+// the Provider was configured for, canceling the request if the context is
+// canceled. And error will be returned if the retrieval fails.
+func (p *Provider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	resp, err := p.getCredentials(ctx)
 	if err != nil {
 		return credentials.Value{ProviderName: ProviderName},
 			awserr.New("CredentialsEndpointError", "failed to load credentials", err)
 	}
 
 	if resp.Expiration != nil {
-		p.SetExpiration(*resp.Expiration, p.ExpiryWindow)
+		p.SetExpirationWithJitter(*resp.Expiration, p.ExpiryWindow, p.ExpiryWindowJitterFrac)
 	} else {
 		p.staticCreds = true
 	}
@@ -143,19 +205,47 @@ type errorOutput struct {
 	Message string `json:"message"`
 }
 
-func (p *Provider) getCredentials() (*getCredentialsOutput, error) {
+func (p *Provider) getCredentials(ctx aws.Context) (*getCredentialsOutput, error) {
 	op := &request.Operation{
 		Name:       "GetCredentials",
 		HTTPMethod: "GET",
 	}
 
+	token, err := p.authorizationToken()
+	if err != nil {
+		return nil, err
+	}
+
 	out := &getCredentialsOutput{}
 	req := p.Client.NewRequest(op, nil, out)
+	req.SetContext(ctx)
 	req.HTTPRequest.Header.Set("Accept", "application/json")
+	if len(token) != 0 {
+		req.HTTPRequest.Header.Set("Authorization", token)
+	}
 
 	return out, req.Send()
 }
 
+// authorizationToken returns the authorization token the credentials request
+// is sent with, or an error if the token cannot be used as a header value.
+func (p *Provider) authorizationToken() (string, error) {
+	token := p.AuthorizationToken
+	if p.AuthorizationTokenProvider != nil {
+		var err error
+		if token, err = p.AuthorizationTokenProvider(); err != nil {
+			return "", err
+		}
+	}
+
+	if strings.ContainsAny(token, "\r\n") {
+		return "", awserr.New("CredentialsEndpointError",
+			"invalid authorization token, must not contain CR or LF characters", nil)
+	}
+
+	return token, nil
+}
+
 func validateEndpointHandler(r *request.Request) {
 	if len(r.ClientInfo.Endpoint) == 0 {
 		r.Error = aws.ErrMissingEndpoint
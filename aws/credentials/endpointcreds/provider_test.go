@@ -3,8 +3,11 @@ package endpointcreds_test
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -109,3 +112,106 @@ func TestFailedRetrieveCredentials(t *testing.T) {
 	assert.Empty(t, creds.SessionToken)
 	assert.True(t, client.IsExpired())
 }
+
+func TestRetrieveCredentialsAuthorizationToken(t *testing.T) {
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get("Authorization"))
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"AccessKeyID":     "AKID",
+			"SecretAccessKey": "SECRET",
+			"Expiration":      time.Now().Add(1 * time.Hour),
+		})
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "endpointcreds")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	tokenFile := filepath.Join(dir, "token")
+
+	client := endpointcreds.NewProviderClient(*unit.Session.Config, unit.Session.Handlers, server.URL,
+		func(p *endpointcreds.Provider) {
+			p.AuthorizationToken = "static_token"
+		},
+	)
+
+	_, err = client.Retrieve()
+	assert.NoError(t, err)
+
+	// The token file is read each time the credentials are retrieved, and
+	// takes precedence over the static token.
+	client.(*endpointcreds.Provider).AuthorizationTokenProvider = endpointcreds.AuthorizationTokenFile(tokenFile)
+	for _, token := range []string{"file_token_1\n", "file_token_2\r\n"} {
+		assert.NoError(t, ioutil.WriteFile(tokenFile, []byte(token), 0600))
+		_, err = client.Retrieve()
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"static_token", "file_token_1", "file_token_2"}, tokens)
+}
+
+func TestRetrieveCredentialsInvalidAuthorizationToken(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "endpointcreds")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	tokenFile := filepath.Join(dir, "token")
+	assert.NoError(t, ioutil.WriteFile(tokenFile, []byte("invalid\r\ntoken\n"), 0600))
+
+	cases := map[string]func(*endpointcreds.Provider){
+		"static token": func(p *endpointcreds.Provider) {
+			p.AuthorizationToken = "invalid\ntoken"
+		},
+		"token file": func(p *endpointcreds.Provider) {
+			p.AuthorizationTokenProvider = endpointcreds.AuthorizationTokenFile(tokenFile)
+		},
+		"missing token file": func(p *endpointcreds.Provider) {
+			p.AuthorizationTokenProvider = endpointcreds.AuthorizationTokenFile(filepath.Join(dir, "missing"))
+		},
+	}
+
+	for name, option := range cases {
+		client := endpointcreds.NewProviderClient(*unit.Session.Config, unit.Session.Handlers, server.URL, option)
+		_, err := client.Retrieve()
+
+		if assert.Error(t, err, name) {
+			assert.Equal(t, "CredentialsEndpointError", err.(awserr.Error).Code(), name)
+		}
+	}
+
+	assert.Equal(t, 0, requests)
+}
+
+type countingTransport struct {
+	requests int
+}
+
+func (c *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	c.requests++
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestRetrieveCredentialsWithHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"AccessKeyID":"AKID","SecretAccessKey":"SECRET"}`)
+	}))
+	defer server.Close()
+
+	transport := &countingTransport{}
+	client := endpointcreds.NewProviderClient(*unit.Session.Config,
+		unit.Session.Handlers,
+		server.URL,
+		endpointcreds.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+	creds, err := client.Retrieve()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID", creds.AccessKeyID)
+	assert.Equal(t, 1, transport.requests)
+}
@@ -0,0 +1,294 @@
+/*
+Package processcreds provides support for retrieving credentials from an
+external process, such as a helper binary issuing credentials.
+
+The process is configured with the credential_process key of a profile in the
+shared config files. Sessions use the process to retrieve credentials when the
+session's profile contains the key.
+
+    [profile external]
+    credential_process = /opt/bin/credential-helper --role admin
+
+The command is executed with the platform's shell, "sh -c" or "cmd.exe /C",
+and must write the credentials as JSON to stdout, and exit with a zero exit
+status:
+
+    {
+        "Version": 1,
+        "AccessKeyId": "AKID",
+        "SecretAccessKey": "SECRET",
+        "SessionToken": "TOKEN",
+        "Expiration": "2017-09-01T00:00:00Z",
+        "AccountId": "123456789012"
+    }
+
+SessionToken, Expiration, and AccountId are optional. Credentials without an
+Expiration never expire. Otherwise the process is executed again to refresh
+the credentials once they expire.
+
+The output of the process must not be larger than the provider's MaxBufSize,
+and the process must exit within the provider's Timeout, or it is killed. If
+the process exits with a non-zero exit status, the error returned includes
+what the process wrote to stderr.
+
+Warning: the command is executed with the permissions of the application,
+the shared config files must only be writable by trusted users.
+*/
+package processcreds
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+// ProviderName is the name of the credentials provider.
+const ProviderName = `ProcessProvider`
+
+// Error codes of the errors returned by the ProcessProvider.
+const (
+	// ErrCodeProcessProviderParse is the error code of the error returned
+	// when the process's output cannot be parsed.
+	ErrCodeProcessProviderParse = "ProcessProviderParseError"
+
+	// ErrCodeProcessProviderVersion is the error code of the error returned
+	// when the process's output has an unsupported Version.
+	ErrCodeProcessProviderVersion = "ProcessProviderVersionError"
+
+	// ErrCodeProcessProviderRequired is the error code of the error returned
+	// when the process's output does not include the access key ID, or
+	// secret access key.
+	ErrCodeProcessProviderRequired = "ProcessProviderRequiredError"
+
+	// ErrCodeProcessProviderExecution is the error code of the error
+	// returned when the process fails to execute, times out, or exits with
+	// a non-zero exit status.
+	ErrCodeProcessProviderExecution = "ProcessProviderExecutionError"
+)
+
+const (
+	// DefaultTimeout is the default time the process is allowed to run for
+	// before being killed.
+	DefaultTimeout = time.Minute
+
+	// DefaultMaxBufSize is the default maximum number of bytes of the
+	// process's output.
+	DefaultMaxBufSize = 8 * 1024
+)
+
+// ProcessProvider satisfies the credentials.Provider interface, and retrieves
+// credentials from the output of an external process.
+type ProcessProvider struct {
+	staticCreds bool
+	credentials.Expiry
+
+	command string
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring. This is beneficial so race conditions
+	// with expiring credentials do not cause request to fail unexpectedly
+	// due to ExpiredTokenException exceptions.
+	//
+	// So a ExpiryWindow of 10s would cause calls to IsExpired() to return true
+	// 10 seconds before the credentials are actually expired.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+
+	// Timeout is the time the process is allowed to run for before being
+	// killed. Defaults to DefaultTimeout if 0 or less.
+	Timeout time.Duration
+
+	// MaxBufSize is the maximum number of bytes of the process's output.
+	// Defaults to DefaultMaxBufSize if 0 or less.
+	MaxBufSize int
+}
+
+// NewCredentials returns a Credentials wrapper for retrieving credentials
+// from the output of the command, executed with the platform's shell.
+func NewCredentials(command string, options ...func(*ProcessProvider)) *credentials.Credentials {
+	p := &ProcessProvider{
+		command: command,
+	}
+
+	for _, option := range options {
+		option(p)
+	}
+
+	return credentials.NewCredentials(p)
+}
+
+// IsExpired returns true if the credentials retrieved are expired, or not yet
+// retrieved.
+func (p *ProcessProvider) IsExpired() bool {
+	if p.staticCreds {
+		return false
+	}
+	return p.Expiry.IsExpired()
+}
+
+type credentialProcessOutput struct {
+	Version         int
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      *time.Time
+
+	// AccountID is the optional ID of the account of the credentials.
+	AccountID string `json:"AccountId"`
+}
+
+// Retrieve executes the process, and returns the credentials parsed from the
+// process's output. An error is returned if the process fails, or the
+// output is not valid.
+func (p *ProcessProvider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(aws.BackgroundContext())
+}
+
+// RetrieveWithContext is the same as Retrieve, and kills the process if the
+// context is canceled before the process exits.
+func (p *ProcessProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	out, err := p.executeCredentialProcess(ctx)
+	if err != nil {
+		return credentials.Value{ProviderName: ProviderName}, err
+	}
+
+	resp := &credentialProcessOutput{}
+	if err := json.Unmarshal(out, resp); err != nil {
+		return credentials.Value{ProviderName: ProviderName}, awserr.New(
+			ErrCodeProcessProviderParse,
+			"failed to parse credential process output", err)
+	}
+
+	if resp.Version != 1 {
+		return credentials.Value{ProviderName: ProviderName}, awserr.New(
+			ErrCodeProcessProviderVersion,
+			fmt.Sprintf("credential process output version %d not supported, expect 1", resp.Version),
+			nil)
+	}
+	if len(resp.AccessKeyID) == 0 || len(resp.SecretAccessKey) == 0 {
+		return credentials.Value{ProviderName: ProviderName}, awserr.New(
+			ErrCodeProcessProviderRequired,
+			"credential process output missing AccessKeyId or SecretAccessKey",
+			nil)
+	}
+
+	if resp.Expiration != nil {
+		p.staticCreds = false
+		p.SetExpiration(*resp.Expiration, p.ExpiryWindow)
+	} else {
+		p.staticCreds = true
+	}
+
+	return credentials.Value{
+		AccessKeyID:     resp.AccessKeyID,
+		SecretAccessKey: resp.SecretAccessKey,
+		SessionToken:    resp.SessionToken,
+		ProviderName:    ProviderName,
+		AccountID:       resp.AccountID,
+	}, nil
+}
+
+// executeCredentialProcess executes the command, and returns its output. The
+// process is killed if the context is canceled.
+func (p *ProcessProvider) executeCredentialProcess(ctx aws.Context) ([]byte, error) {
+	if len(strings.TrimSpace(p.command)) == 0 {
+		return nil, awserr.New(ErrCodeProcessProviderExecution,
+			"credential process command is empty", nil)
+	}
+
+	maxBufSize := p.MaxBufSize
+	if maxBufSize <= 0 {
+		maxBufSize = DefaultMaxBufSize
+	}
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd.exe", "/C", p.command)
+	} else {
+		cmd = exec.Command("sh", "-c", p.command)
+	}
+	stdout := &limitedBuffer{max: maxBufSize}
+	stderr := &limitedBuffer{max: maxBufSize}
+	cmd.Env = os.Environ()
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	if err := cmd.Start(); err != nil {
+		return nil, awserr.New(ErrCodeProcessProviderExecution,
+			"failed to start credential process", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return nil, awserr.New(ErrCodeProcessProviderExecution,
+				fmt.Sprintf("credential process failed, stderr: %q",
+					strings.TrimSpace(stderr.String())),
+				err)
+		}
+	case <-time.After(timeout):
+		cmd.Process.Kill()
+		return nil, awserr.New(ErrCodeProcessProviderExecution,
+			fmt.Sprintf("credential process timed out after %v", timeout), nil)
+	case <-ctx.Done():
+		cmd.Process.Kill()
+		return nil, awserr.New(ErrCodeProcessProviderExecution,
+			"credential process canceled", ctx.Err())
+	}
+
+	if stdout.overflow {
+		return nil, awserr.New(ErrCodeProcessProviderExecution,
+			fmt.Sprintf("credential process output exceeds %d bytes", maxBufSize), nil)
+	}
+
+	return stdout.Bytes(), nil
+}
+
+// A limitedBuffer buffers up to max bytes written to it, discarding the rest.
+// Writes never fail, so the process is not blocked writing its output.
+//
+// The buffer is not embedded, so that bytes.Buffer's ReadFrom does not bypass
+// the limit when the output is copied with io.Copy.
+type limitedBuffer struct {
+	buf      bytes.Buffer
+	max      int
+	overflow bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	if remain := b.max - b.buf.Len(); n > remain {
+		p = p[:remain]
+		b.overflow = true
+	}
+	b.buf.Write(p)
+
+	return n, nil
+}
+
+func (b *limitedBuffer) Bytes() []byte {
+	return b.buf.Bytes()
+}
+
+func (b *limitedBuffer) String() string {
+	return b.buf.String()
+}
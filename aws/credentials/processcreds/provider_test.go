@@ -0,0 +1,163 @@
+package processcreds_test
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials/processcreds"
+)
+
+func skipWindows(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test commands require a POSIX shell")
+	}
+}
+
+// echoCommand returns a command writing the output to stdout.
+func echoCommand(output string) string {
+	return fmt.Sprintf("printf '%%s' '%s'", output)
+}
+
+func TestProcessProvider_Retrieve(t *testing.T) {
+	skipWindows(t)
+
+	expiration := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	creds := processcreds.NewCredentials(echoCommand(fmt.Sprintf(
+		`{"Version":1,"AccessKeyId":"AKID","SecretAccessKey":"SECRET","SessionToken":"TOKEN","Expiration":"%s","AccountId":"012345678901"}`,
+		expiration.Format(time.RFC3339),
+	)))
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID", v.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+	if e, a := "SECRET", v.SecretAccessKey; e != a {
+		t.Errorf("expect %v secret access key, got %v", e, a)
+	}
+	if e, a := "TOKEN", v.SessionToken; e != a {
+		t.Errorf("expect %v session token, got %v", e, a)
+	}
+	if e, a := processcreds.ProviderName, v.ProviderName; e != a {
+		t.Errorf("expect %v provider name, got %v", e, a)
+	}
+	if e, a := "012345678901", v.AccountID; e != a {
+		t.Errorf("expect %v account ID, got %v", e, a)
+	}
+	if creds.IsExpired() {
+		t.Errorf("expect credentials not to be expired")
+	}
+}
+
+func TestProcessProvider_Expiration(t *testing.T) {
+	skipWindows(t)
+
+	creds := processcreds.NewCredentials(echoCommand(
+		`{"Version":1,"AccessKeyId":"AKID","SecretAccessKey":"SECRET","Expiration":"2000-01-01T00:00:00Z"}`,
+	), func(o *processcreds.ProcessProvider) {
+		o.ExpiryWindow = time.Minute
+	})
+
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	// Expired credentials are retrieved again.
+	if !creds.IsExpired() {
+		t.Errorf("expect credentials to be expired")
+	}
+}
+
+func TestProcessProvider_Static(t *testing.T) {
+	skipWindows(t)
+
+	creds := processcreds.NewCredentials(echoCommand(
+		`{"Version":1,"AccessKeyId":"AKID","SecretAccessKey":"SECRET"}`,
+	))
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if creds.IsExpired() {
+		t.Errorf("expect credentials without expiration to never expire")
+	}
+}
+
+func TestProcessProvider_Errors(t *testing.T) {
+	skipWindows(t)
+
+	cases := map[string]struct {
+		Command       string
+		Options       func(*processcreds.ProcessProvider)
+		ExpectCode    string
+		ExpectMessage string
+	}{
+		"empty command": {
+			Command:    " ",
+			ExpectCode: processcreds.ErrCodeProcessProviderExecution,
+		},
+		"non-zero exit": {
+			Command:       "echo 'helper: not authorized' >&2; exit 3",
+			ExpectCode:    processcreds.ErrCodeProcessProviderExecution,
+			ExpectMessage: "helper: not authorized",
+		},
+		"invalid JSON": {
+			Command:    echoCommand(`{"Version":`),
+			ExpectCode: processcreds.ErrCodeProcessProviderParse,
+		},
+		"version": {
+			Command:    echoCommand(`{"Version":2,"AccessKeyId":"AKID","SecretAccessKey":"SECRET"}`),
+			ExpectCode: processcreds.ErrCodeProcessProviderVersion,
+		},
+		"missing secret": {
+			Command:    echoCommand(`{"Version":1,"AccessKeyId":"AKID"}`),
+			ExpectCode: processcreds.ErrCodeProcessProviderRequired,
+		},
+		"output too large": {
+			Command: echoCommand(`{"Version":1,"AccessKeyId":"AKID","SecretAccessKey":"SECRET"}`),
+			Options: func(p *processcreds.ProcessProvider) {
+				p.MaxBufSize = 10
+			},
+			ExpectCode:    processcreds.ErrCodeProcessProviderExecution,
+			ExpectMessage: "exceeds 10 bytes",
+		},
+		"timeout": {
+			Command: "sleep 10",
+			Options: func(p *processcreds.ProcessProvider) {
+				p.Timeout = 100 * time.Millisecond
+			},
+			ExpectCode:    processcreds.ErrCodeProcessProviderExecution,
+			ExpectMessage: "timed out",
+		},
+	}
+
+	for name, c := range cases {
+		var opts []func(*processcreds.ProcessProvider)
+		if c.Options != nil {
+			opts = append(opts, c.Options)
+		}
+		creds := processcreds.NewCredentials(c.Command, opts...)
+
+		start := time.Now()
+		_, err := creds.Get()
+		if d := time.Since(start); d > 5*time.Second {
+			t.Errorf("%s, expect credential process to be bounded, took %v", name, d)
+		}
+
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			t.Errorf("%s, expect awserr.Error, got %T, %v", name, err, err)
+			continue
+		}
+		if e, a := c.ExpectCode, aerr.Code(); e != a {
+			t.Errorf("%s, expect %v error code, got %v", name, e, a)
+		}
+		if !strings.Contains(aerr.Message(), c.ExpectMessage) {
+			t.Errorf("%s, expect message to contain %q, got %q", name, c.ExpectMessage, aerr.Message())
+		}
+	}
+}
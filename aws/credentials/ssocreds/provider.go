@@ -0,0 +1,281 @@
+/*
+Package ssocreds provides support for retrieving credentials for a role with
+the access token of an AWS Single Sign-On (SSO) session.
+
+The role is configured with the SSO keys of a profile in the shared config
+file. Sessions use the provider to retrieve credentials when the session's
+profile contains the keys.
+
+    [profile devsso]
+    sso_start_url = https://my-sso-portal.awsapps.com/start
+    sso_region = us-east-1
+    sso_account_id = 123456789012
+    sso_role_name = MyRole
+
+The provider does not sign in to SSO. The access token is read from the SSO
+token cache, created by signing in with the AWS CLI:
+
+    aws sso login --profile devsso
+
+The cached token is read from the file named after the SHA-1 hash of the
+start URL in the ~/.aws/sso/cache directory. The credentials of the role are
+retrieved with the token by calling the SSO GetRoleCredentials API in the
+SSO region, and are retrieved again once they expire.
+
+If the cached token is missing or expired, Retrieve returns an error with
+the ErrCodeSSOProviderInvalidToken error code, and the user must sign in
+again.
+*/
+package ssocreds
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/cache"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/internal/shareddefaults"
+	"github.com/aws/aws-sdk-go/private/protocol/restjson"
+)
+
+// ProviderName is the name of the credentials provider.
+const ProviderName = `SSOProvider`
+
+// ErrCodeSSOProviderInvalidToken is the error code of the error returned
+// when the cached SSO access token is missing, expired, or rejected by SSO.
+const ErrCodeSSOProviderInvalidToken = "SSOProviderInvalidToken"
+
+const invalidTokenMessage = "the SSO session has expired or is invalid, " +
+	"run aws sso login to refresh the cached SSO access token"
+
+// EndpointsID is the ID used to resolve the endpoint of the SSO portal,
+// e.g. portal.sso.us-east-1.amazonaws.com.
+const EndpointsID = "portal.sso"
+
+// Provider satisfies the credentials.Provider interface, and retrieves the
+// credentials of a role with the cached access token of an SSO session.
+type Provider struct {
+	credentials.Expiry
+
+	// Client is the client the SSO GetRoleCredentials API is called with.
+	Client *client.Client
+
+	// AccountID is the ID of the account of the role.
+	AccountID string
+
+	// RoleName is the name of the role credentials are retrieved for.
+	RoleName string
+
+	// StartURL is the start URL of the SSO session, used to find the cached
+	// access token.
+	StartURL string
+
+	// CachedTokenFilepath is the file the cached access token is read from.
+	// Defaults to the file of the start URL in the ~/.aws/sso/cache
+	// directory if empty.
+	CachedTokenFilepath string
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring. This is beneficial so race conditions
+	// with expiring credentials do not cause request to fail unexpectedly
+	// due to ExpiredTokenException exceptions.
+	//
+	// So a ExpiryWindow of 10s would cause calls to IsExpired() to return true
+	// 10 seconds before the credentials are actually expired.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+
+	// CacheDir is the directory the credentials of the role are cached in,
+	// shared with other processes. The credentials are read from the cache
+	// while they are not expired, instead of calling GetRoleCredentials
+	// again. See the cache package.
+	//
+	// The credentials are cached by the start URL, account ID, and role
+	// name. CacheDir is only used by the Credentials returned by
+	// NewCredentials.
+	//
+	// If CacheDir is empty the credentials are not cached.
+	CacheDir string
+}
+
+// NewCredentials returns a Credentials wrapper for retrieving the credentials
+// of the role in the account, with the cached access token of the SSO session
+// of the start URL. The SSO GetRoleCredentials API is called in the region
+// provided.
+func NewCredentials(c client.ConfigProvider, accountID, region, roleName, startURL string, options ...func(*Provider)) *credentials.Credentials {
+	p := &Provider{
+		Client:    newClient(c, region),
+		AccountID: accountID,
+		RoleName:  roleName,
+		StartURL:  startURL,
+	}
+
+	for _, option := range options {
+		option(p)
+	}
+
+	if len(p.CacheDir) == 0 {
+		return credentials.NewCredentials(p)
+	}
+
+	key := cache.Key(p.StartURL, p.AccountID, p.RoleName)
+	return credentials.NewCredentials(cache.NewProvider(p, key, func(c *cache.Provider) {
+		c.Dir = p.CacheDir
+	}))
+}
+
+// newClient returns a client for the SSO portal in the region. Requests are
+// authorized with the access token, and are not signed.
+func newClient(c client.ConfigProvider, region string) *client.Client {
+	cfg := c.ClientConfig(EndpointsID, &aws.Config{
+		Region:      aws.String(region),
+		Credentials: credentials.AnonymousCredentials,
+	})
+
+	svc := client.New(
+		*cfg.Config,
+		metadata.ClientInfo{
+			ServiceName:   "SSO",
+			SigningName:   "awsssoportal",
+			SigningRegion: cfg.SigningRegion,
+			Endpoint:      cfg.Endpoint,
+			APIVersion:    "2019-06-10",
+		},
+		cfg.Handlers,
+	)
+	svc.Handlers.Build.PushBackNamed(restjson.BuildHandler)
+	svc.Handlers.Unmarshal.PushBackNamed(restjson.UnmarshalHandler)
+	svc.Handlers.UnmarshalMeta.PushBackNamed(restjson.UnmarshalMetaHandler)
+	svc.Handlers.UnmarshalError.PushBackNamed(restjson.UnmarshalErrorHandler)
+
+	return svc
+}
+
+// StandardCachedTokenFilepath returns the file the cached access token of the
+// SSO session of the start URL is read from by default.
+func StandardCachedTokenFilepath(startURL string) string {
+	hash := sha1.Sum([]byte(startURL))
+	return filepath.Join(shareddefaults.UserHomeDir(), ".aws", "sso", "cache",
+		strings.ToLower(hex.EncodeToString(hash[:]))+".json")
+}
+
+// Retrieve reads the cached access token, and returns the credentials of the
+// role retrieved with the token. An error is returned if the token is missing
+// or expired, or the credentials fail to be retrieved.
+func (p *Provider) Retrieve() (credentials.Value, error) {
+	token, err := p.loadCachedToken()
+	if err != nil {
+		return credentials.Value{ProviderName: ProviderName}, err
+	}
+
+	input := &getRoleCredentialsInput{
+		AccessToken: aws.String(token),
+		AccountID:   aws.String(p.AccountID),
+		RoleName:    aws.String(p.RoleName),
+	}
+	output := &getRoleCredentialsOutput{}
+	req := p.Client.NewRequest(&request.Operation{
+		Name:       "GetRoleCredentials",
+		HTTPMethod: "GET",
+		HTTPPath:   "/federation/credentials",
+	}, input, output)
+	if err := req.Send(); err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "UnauthorizedException" {
+			err = awserr.New(ErrCodeSSOProviderInvalidToken, invalidTokenMessage, err)
+		}
+		return credentials.Value{ProviderName: ProviderName}, err
+	}
+
+	creds := output.RoleCredentials
+	if creds == nil {
+		return credentials.Value{ProviderName: ProviderName}, awserr.New(
+			request.ErrCodeSerialization,
+			"SSO GetRoleCredentials response missing role credentials", nil)
+	}
+
+	expiration := time.Unix(0, aws.Int64Value(creds.Expiration)*int64(time.Millisecond))
+	p.SetExpiration(expiration, p.ExpiryWindow)
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(creds.AccessKeyID),
+		SecretAccessKey: aws.StringValue(creds.SecretAccessKey),
+		SessionToken:    aws.StringValue(creds.SessionToken),
+		ProviderName:    ProviderName,
+		AccountID:       p.AccountID,
+	}, nil
+}
+
+type cachedToken struct {
+	AccessToken string    `json:"accessToken"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// loadCachedToken returns the cached access token, or an error if the token
+// is missing or expired.
+func (p *Provider) loadCachedToken() (string, error) {
+	filename := p.CachedTokenFilepath
+	if len(filename) == 0 {
+		filename = StandardCachedTokenFilepath(p.StartURL)
+	}
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", awserr.New(ErrCodeSSOProviderInvalidToken, invalidTokenMessage, err)
+	}
+
+	var token cachedToken
+	if err := json.Unmarshal(b, &token); err != nil {
+		return "", awserr.New(ErrCodeSSOProviderInvalidToken, invalidTokenMessage, err)
+	}
+
+	now := time.Now
+	if p.CurrentTime != nil {
+		now = p.CurrentTime
+	}
+	if len(token.AccessToken) == 0 || !token.ExpiresAt.After(now()) {
+		return "", awserr.New(ErrCodeSSOProviderInvalidToken, invalidTokenMessage, nil)
+	}
+
+	return token.AccessToken, nil
+}
+
+type getRoleCredentialsInput struct {
+	_ struct{} `type:"structure"`
+
+	AccessToken *string `location:"header" locationName:"x-amz-sso_bearer_token" type:"string" required:"true"`
+
+	AccountID *string `location:"querystring" locationName:"account_id" type:"string" required:"true"`
+
+	RoleName *string `location:"querystring" locationName:"role_name" type:"string" required:"true"`
+}
+
+type getRoleCredentialsOutput struct {
+	_ struct{} `type:"structure"`
+
+	RoleCredentials *roleCredentials `locationName:"roleCredentials" type:"structure"`
+}
+
+type roleCredentials struct {
+	_ struct{} `type:"structure"`
+
+	AccessKeyID *string `locationName:"accessKeyId" type:"string"`
+
+	// Expiration is the time the credentials expire, in milliseconds since
+	// the Unix epoch.
+	Expiration *int64 `locationName:"expiration" type:"long"`
+
+	SecretAccessKey *string `locationName:"secretAccessKey" type:"string"`
+
+	SessionToken *string `locationName:"sessionToken" type:"string"`
+}
@@ -0,0 +1,220 @@
+package ssocreds_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+)
+
+func writeCachedToken(t *testing.T, dir, name string, expiresAt time.Time) string {
+	filename := filepath.Join(dir, name+".json")
+	token := fmt.Sprintf(`{"accessToken":"cached_token","expiresAt":"%s"}`,
+		expiresAt.UTC().Format(time.RFC3339))
+	if err := ioutil.WriteFile(filename, []byte(token), 0600); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	return filename
+}
+
+func TestStandardCachedTokenFilepath(t *testing.T) {
+	home := os.Getenv("HOME")
+	defer os.Setenv("HOME", home)
+	profile := os.Getenv("USERPROFILE")
+	defer os.Setenv("USERPROFILE", profile)
+
+	os.Setenv("HOME", "home")
+	os.Setenv("USERPROFILE", "home")
+
+	expect := filepath.Join("home", ".aws", "sso", "cache",
+		"c7aaaf71fcc8777ae2475525ed049d39fe16c484.json")
+	if e, a := expect, ssocreds.StandardCachedTokenFilepath("https://my-sso-portal.awsapps.com/start"); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestNewCredentials_Endpoint(t *testing.T) {
+	var endpoint string
+	ssocreds.NewCredentials(unit.Session, "012345678901", "us-west-2", "TestRole",
+		"https://my-sso-portal.awsapps.com/start", func(p *ssocreds.Provider) {
+			endpoint = p.Client.Endpoint
+		})
+
+	if e, a := "https://portal.sso.us-west-2.amazonaws.com", endpoint; e != a {
+		t.Errorf("expect %v endpoint, got %v", e, a)
+	}
+}
+
+func TestProvider_Retrieve(t *testing.T) {
+	expiration := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if e, a := "cached_token", r.Header.Get("X-Amz-Sso_bearer_token"); e != a {
+			t.Errorf("expect %v access token, got %v", e, a)
+		}
+		if e, a := "", r.Header.Get("Authorization"); e != a {
+			t.Errorf("expect request not signed, got %v", a)
+		}
+		fmt.Fprintf(w, `{"roleCredentials":{"accessKeyId":"AKID","secretAccessKey":"SECRET","sessionToken":"TOKEN","expiration":%d}}`,
+			expiration.UnixNano()/int64(time.Millisecond))
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "ssocreds")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	sess := unit.Session.Copy(&aws.Config{Endpoint: aws.String(server.URL)})
+	creds := ssocreds.NewCredentials(sess, "012345678901", "us-west-2", "TestRole",
+		"https://my-sso-portal.awsapps.com/start", func(p *ssocreds.Provider) {
+			p.CachedTokenFilepath = writeCachedToken(t, dir, "token", now.Add(2*time.Hour))
+			p.ExpiryWindow = time.Minute
+			p.CurrentTime = func() time.Time { return now }
+		})
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID", v.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+	if e, a := "SECRET", v.SecretAccessKey; e != a {
+		t.Errorf("expect %v secret access key, got %v", e, a)
+	}
+	if e, a := "TOKEN", v.SessionToken; e != a {
+		t.Errorf("expect %v session token, got %v", e, a)
+	}
+	if e, a := ssocreds.ProviderName, v.ProviderName; e != a {
+		t.Errorf("expect %v provider name, got %v", e, a)
+	}
+	if e, a := "012345678901", v.AccountID; e != a {
+		t.Errorf("expect %v account ID, got %v", e, a)
+	}
+	if creds.IsExpired() {
+		t.Errorf("expect credentials not to be expired")
+	}
+
+	// The credentials are retrieved again within the expiry window.
+	now = expiration.Add(-30 * time.Second)
+	if !creds.IsExpired() {
+		t.Errorf("expect credentials to be expired")
+	}
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 2, calls; e != a {
+		t.Errorf("expect %d calls, got %d", e, a)
+	}
+}
+
+func TestNewCredentials_CacheDir(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprintf(w, `{"roleCredentials":{"accessKeyId":"AKID","secretAccessKey":"SECRET","sessionToken":"TOKEN","expiration":%d}}`,
+			time.Now().Add(time.Hour).UnixNano()/int64(time.Millisecond))
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "ssocreds")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+	tokenFile := writeCachedToken(t, dir, "token", time.Now().Add(2*time.Hour))
+
+	sess := unit.Session.Copy(&aws.Config{Endpoint: aws.String(server.URL)})
+	for i := 0; i < 2; i++ {
+		creds := ssocreds.NewCredentials(sess, "012345678901", "us-west-2", "TestRole",
+			"https://my-sso-portal.awsapps.com/start", func(p *ssocreds.Provider) {
+				p.CachedTokenFilepath = tokenFile
+				p.CacheDir = filepath.Join(dir, "cache")
+			})
+
+		v, err := creds.Get()
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := "AKID", v.AccessKeyID; e != a {
+			t.Errorf("expect %v access key ID, got %v", e, a)
+		}
+	}
+
+	// The second credentials are read from the cache.
+	if e, a := 1, calls; e != a {
+		t.Errorf("expect %d calls, got %d", e, a)
+	}
+}
+
+func TestProvider_InvalidToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Amzn-Errortype", "UnauthorizedException")
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"message":"Session token not found or invalid"}`)
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "ssocreds")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cases := map[string]struct {
+		CachedTokenFilepath string
+		ExpectOrigErr       bool
+	}{
+		"missing": {
+			CachedTokenFilepath: filepath.Join(dir, "missing.json"),
+			ExpectOrigErr:       true,
+		},
+		"expired": {
+			CachedTokenFilepath: writeCachedToken(t, dir, "expired", time.Now().Add(-time.Minute)),
+		},
+		"unauthorized": {
+			CachedTokenFilepath: writeCachedToken(t, dir, "valid", time.Now().Add(time.Hour)),
+			ExpectOrigErr:       true,
+		},
+	}
+
+	for name, c := range cases {
+		sess := unit.Session.Copy(&aws.Config{
+			Endpoint:   aws.String(server.URL),
+			MaxRetries: aws.Int(0),
+		})
+		creds := ssocreds.NewCredentials(sess, "012345678901", "us-west-2", "TestRole",
+			"https://my-sso-portal.awsapps.com/start", func(p *ssocreds.Provider) {
+				p.CachedTokenFilepath = c.CachedTokenFilepath
+			})
+
+		_, err := creds.Get()
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			t.Fatalf("%s, expect awserr.Error, got %T, %v", name, err, err)
+		}
+		if e, a := ssocreds.ErrCodeSSOProviderInvalidToken, aerr.Code(); e != a {
+			t.Errorf("%s, expect %v error code, got %v", name, e, a)
+		}
+		if !strings.Contains(aerr.Message(), "aws sso login") {
+			t.Errorf("%s, expect message to refer to sso login, got %v", name, aerr.Message())
+		}
+		if e, a := c.ExpectOrigErr, aerr.OrigErr() != nil; e != a {
+			t.Errorf("%s, expect original error %t, got %v", name, e, aerr.OrigErr())
+		}
+	}
+}
@@ -80,12 +80,16 @@ package stscreds
 
 import (
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/cache"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
 
@@ -116,6 +120,12 @@ type AssumeRoler interface {
 	AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error)
 }
 
+// assumeRolerWithContext is satisfied by AssumeRoler clients, such as the STS
+// client, that can assume the role with a context.
+type assumeRolerWithContext interface {
+	AssumeRoleWithContext(aws.Context, *sts.AssumeRoleInput, ...request.Option) (*sts.AssumeRoleOutput, error)
+}
+
 // DefaultDuration is the default amount of time in minutes that the credentials
 // will be valid for.
 var DefaultDuration = time.Duration(15) * time.Minute
@@ -148,6 +158,11 @@ type AssumeRoleProvider struct {
 	// Optional ExternalID to pass along, defaults to nil if not set.
 	ExternalID *string
 
+	// Optional source identity of the role session, recorded in CloudTrail
+	// logs and persisted across chained role sessions. Defaults to nil if
+	// not set.
+	SourceIdentity *string
+
 	// The policy plain text must be 2048 bytes or shorter. However, an internal
 	// conversion compresses it into a packed binary format with a separate limit.
 	// The PackedPolicySize response element indicates by percentage how close to
@@ -169,6 +184,10 @@ type AssumeRoleProvider struct {
 	//
 	// If SerialNumber is set and neither TokenCode nor TokenProvider are also
 	// set an error will be returned.
+	//
+	// MFA token codes cannot be reused, so the TokenCode is only used to
+	// assume the role once. Once the credentials need to be refreshed an error
+	// is returned, unless TokenProvider is also set.
 	TokenCode *string
 
 	// Async method of providing MFA token code for assuming an IAM role with MFA.
@@ -193,6 +212,38 @@ type AssumeRoleProvider struct {
 	//
 	// If ExpiryWindow is 0 or less it will be ignored.
 	ExpiryWindow time.Duration
+
+	// ExpiryWindowJitterFrac randomizes the ExpiryWindow, so that processes
+	// sharing the same credentials do not refresh them at the same time. The
+	// window is randomized between ExpiryWindow*(1-ExpiryWindowJitterFrac)
+	// and ExpiryWindow each time the credentials are retrieved.
+	//
+	// So a ExpiryWindowJitterFrac of 0.5 with a ExpiryWindow of 10s would
+	// cause calls to IsExpired() to return true between 5 and 10 seconds
+	// before the credentials are actually expired.
+	//
+	// If ExpiryWindowJitterFrac is 0 or less it will be ignored.
+	ExpiryWindowJitterFrac float64
+
+	// CacheDir is the directory the credentials are cached in, shared with
+	// other processes assuming the role. The credentials are read from the
+	// cache while they are not expired, instead of assuming the role again.
+	// See the cache package, and cache.DefaultDir for the directory used by
+	// the AWS CLI.
+	//
+	// The credentials are cached by the role ARN, session name, MFA serial
+	// number, external ID, and policy. CacheDir is only used by the
+	// Credentials returned by NewCredentials and NewCredentialsWithClient.
+	//
+	// If CacheDir is empty the credentials are not cached.
+	CacheDir string
+
+	// tokenCodeUsed is set once the role was assumed with the TokenCode.
+	tokenCodeUsed bool
+
+	// stsConfigs are the configs the STS client is created with by
+	// NewCredentials.
+	stsConfigs []*aws.Config
 }
 
 // NewCredentials returns a pointer to a new Credentials object wrapping the
@@ -205,9 +256,12 @@ type AssumeRoleProvider struct {
 // It is safe to share the returned Credentials with multiple Sessions and
 // service clients. All access to the credentials and refreshing them
 // will be synchronized.
+//
+// The STS client is created with the config of the ConfigProvider. The
+// WithSTSRegion, WithSTSEndpoint, and WithSTSHTTPClient options override the
+// config of the client, and are ignored if an option sets the Client.
 func NewCredentials(c client.ConfigProvider, roleARN string, options ...func(*AssumeRoleProvider)) *credentials.Credentials {
 	p := &AssumeRoleProvider{
-		Client:   sts.New(c),
 		RoleARN:  roleARN,
 		Duration: DefaultDuration,
 	}
@@ -216,7 +270,37 @@ func NewCredentials(c client.ConfigProvider, roleARN string, options ...func(*As
 		option(p)
 	}
 
-	return credentials.NewCredentials(p)
+	if p.Client == nil {
+		p.Client = sts.New(c, p.stsConfigs...)
+	}
+
+	return newCredentials(p)
+}
+
+// WithSTSRegion returns an option setting the region of the STS client
+// created by NewCredentials. The endpoint of the client is resolved for the
+// region.
+func WithSTSRegion(region string) func(*AssumeRoleProvider) {
+	return func(p *AssumeRoleProvider) {
+		p.stsConfigs = append(p.stsConfigs, aws.NewConfig().WithRegion(region))
+	}
+}
+
+// WithSTSEndpoint returns an option setting the endpoint of the STS client
+// created by NewCredentials, such as the endpoint of a VPC interface endpoint
+// of STS.
+func WithSTSEndpoint(endpoint string) func(*AssumeRoleProvider) {
+	return func(p *AssumeRoleProvider) {
+		p.stsConfigs = append(p.stsConfigs, aws.NewConfig().WithEndpoint(endpoint))
+	}
+}
+
+// WithSTSHTTPClient returns an option setting the HTTP client of the STS
+// client created by NewCredentials.
+func WithSTSHTTPClient(httpClient *http.Client) func(*AssumeRoleProvider) {
+	return func(p *AssumeRoleProvider) {
+		p.stsConfigs = append(p.stsConfigs, aws.NewConfig().WithHTTPClient(httpClient))
+	}
 }
 
 // NewCredentialsWithClient returns a pointer to a new Credentials object wrapping the
@@ -239,11 +323,33 @@ func NewCredentialsWithClient(svc AssumeRoler, roleARN string, options ...func(*
 		option(p)
 	}
 
-	return credentials.NewCredentials(p)
+	return newCredentials(p)
+}
+
+// newCredentials returns a Credentials wrapping the AssumeRoleProvider, with
+// the credentials cached in the CacheDir if set.
+func newCredentials(p *AssumeRoleProvider) *credentials.Credentials {
+	if len(p.CacheDir) == 0 {
+		return credentials.NewCredentials(p)
+	}
+
+	key := cache.Key(p.RoleARN, p.RoleSessionName, aws.StringValue(p.SerialNumber),
+		aws.StringValue(p.ExternalID), aws.StringValue(p.Policy),
+		aws.StringValue(p.SourceIdentity))
+	return credentials.NewCredentials(cache.NewProvider(p, key, func(c *cache.Provider) {
+		c.Dir = p.CacheDir
+	}))
 }
 
 // Retrieve generates a new set of temporary credentials using STS.
 func (p *AssumeRoleProvider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(aws.BackgroundContext())
+}
+
+// RetrieveWithContext generates a new set of temporary credentials using STS.
+// The role is assumed with the context if the Client supports contexts, such
+// as the STS client, so that canceling the context cancels the request.
+func (p *AssumeRoleProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
 
 	// Apply defaults where parameters are not set.
 	if p.RoleSessionName == "" {
@@ -259,40 +365,74 @@ func (p *AssumeRoleProvider) Retrieve() (credentials.Value, error) {
 		RoleArn:         aws.String(p.RoleARN),
 		RoleSessionName: aws.String(p.RoleSessionName),
 		ExternalId:      p.ExternalID,
+		SourceIdentity:  p.SourceIdentity,
 	}
 	if p.Policy != nil {
 		input.Policy = p.Policy
 	}
 	if p.SerialNumber != nil {
-		if p.TokenCode != nil {
-			input.SerialNumber = p.SerialNumber
-			input.TokenCode = p.TokenCode
-		} else if p.TokenProvider != nil {
-			input.SerialNumber = p.SerialNumber
-			code, err := p.TokenProvider()
-			if err != nil {
-				return credentials.Value{ProviderName: ProviderName}, err
-			}
-			input.TokenCode = aws.String(code)
-		} else {
-			return credentials.Value{ProviderName: ProviderName},
-				awserr.New("AssumeRoleTokenNotAvailable",
-					"assume role with MFA enabled, but neither TokenCode nor TokenProvider are set", nil)
+		code, err := p.tokenCode()
+		if err != nil {
+			return credentials.Value{ProviderName: ProviderName}, err
 		}
+		input.SerialNumber = p.SerialNumber
+		input.TokenCode = aws.String(code)
 	}
 
-	roleOutput, err := p.Client.AssumeRole(input)
+	var roleOutput *sts.AssumeRoleOutput
+	var err error
+	if c, ok := p.Client.(assumeRolerWithContext); ok {
+		roleOutput, err = c.AssumeRoleWithContext(ctx, input)
+	} else {
+		roleOutput, err = p.Client.AssumeRole(input)
+	}
 	if err != nil {
 		return credentials.Value{ProviderName: ProviderName}, err
 	}
+	if input.SerialNumber != nil && p.TokenProvider == nil {
+		p.tokenCodeUsed = true
+	}
 
 	// We will proactively generate new credentials before they expire.
-	p.SetExpiration(*roleOutput.Credentials.Expiration, p.ExpiryWindow)
+	p.SetExpirationWithJitter(*roleOutput.Credentials.Expiration, p.ExpiryWindow, p.ExpiryWindowJitterFrac)
 
 	return credentials.Value{
 		AccessKeyID:     *roleOutput.Credentials.AccessKeyId,
 		SecretAccessKey: *roleOutput.Credentials.SecretAccessKey,
 		SessionToken:    *roleOutput.Credentials.SessionToken,
 		ProviderName:    ProviderName,
+		AccountID:       assumedRoleAccountID(roleOutput.AssumedRoleUser),
 	}, nil
 }
+
+// assumedRoleAccountID returns the account ID of the ARN of the assumed role
+// user, or an empty string if the ARN is missing or not valid.
+func assumedRoleAccountID(user *sts.AssumedRoleUser) string {
+	if user == nil {
+		return ""
+	}
+	a, err := arn.Parse(aws.StringValue(user.Arn))
+	if err != nil {
+		return ""
+	}
+	return a.AccountID
+}
+
+// tokenCode returns the MFA token code to assume the role with. The
+// TokenProvider is called each time the role is assumed, and the TokenCode
+// is only used once.
+func (p *AssumeRoleProvider) tokenCode() (string, error) {
+	switch {
+	case p.TokenProvider != nil:
+		return p.TokenProvider()
+	case p.TokenCode != nil && !p.tokenCodeUsed:
+		return *p.TokenCode, nil
+	case p.TokenCode != nil:
+		return "", awserr.New("AssumeRoleTokenCodeUsed",
+			"assume role with MFA enabled, and the credentials need to be refreshed, "+
+				"but the TokenCode was already used, set TokenProvider to provide new token codes", nil)
+	default:
+		return "", awserr.New("AssumeRoleTokenNotAvailable",
+			"assume role with MFA enabled, but neither TokenCode nor TokenProvider are set", nil)
+	}
+}
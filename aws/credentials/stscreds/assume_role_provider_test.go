@@ -2,10 +2,15 @@ package stscreds
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/stretchr/testify/assert"
 )
@@ -27,6 +32,10 @@ func (s *stubSTS) AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput,
 			SessionToken:    aws.String("assumedSessionToken"),
 			Expiration:      &expiry,
 		},
+		AssumedRoleUser: &sts.AssumedRoleUser{
+			Arn:           aws.String("arn:aws:sts::012345678901:assumed-role/role/session"),
+			AssumedRoleId: aws.String("AROAID:session"),
+		},
 	}, nil
 }
 
@@ -43,6 +52,7 @@ func TestAssumeRoleProvider(t *testing.T) {
 	assert.Equal(t, "roleARN", creds.AccessKeyID, "Expect access key ID to be reflected role ARN")
 	assert.Equal(t, "assumedSecretAccessKey", creds.SecretAccessKey, "Expect secret access key to match")
 	assert.Equal(t, "assumedSessionToken", creds.SessionToken, "Expect session token to match")
+	assert.Equal(t, "012345678901", creds.AccountID, "Expect account ID of assumed role user ARN")
 }
 
 func TestAssumeRoleProvider_WithTokenCode(t *testing.T) {
@@ -67,6 +77,26 @@ func TestAssumeRoleProvider_WithTokenCode(t *testing.T) {
 	assert.Equal(t, "assumedSessionToken", creds.SessionToken, "Expect session token to match")
 }
 
+func TestAssumeRoleProvider_WithSourceIdentity(t *testing.T) {
+	stub := &stubSTS{
+		TestInput: func(in *sts.AssumeRoleInput) {
+			assert.Equal(t, "identity", aws.StringValue(in.SourceIdentity))
+			assert.Equal(t, "1234", aws.StringValue(in.ExternalId))
+			assert.Equal(t, int64(3600), aws.Int64Value(in.DurationSeconds))
+		},
+	}
+	p := &AssumeRoleProvider{
+		Client:         stub,
+		RoleARN:        "roleARN",
+		Duration:       time.Hour,
+		ExternalID:     aws.String("1234"),
+		SourceIdentity: aws.String("identity"),
+	}
+
+	_, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+}
+
 func TestAssumeRoleProvider_WithTokenProvider(t *testing.T) {
 	stub := &stubSTS{
 		TestInput: func(in *sts.AssumeRoleInput) {
@@ -134,6 +164,160 @@ func TestAssumeRoleProvider_MFAWithNoToken(t *testing.T) {
 	assert.Empty(t, creds.SessionToken)
 }
 
+func TestAssumeRoleProvider_WithTokenProviderRefresh(t *testing.T) {
+	var codes []string
+	stub := &stubSTS{
+		TestInput: func(in *sts.AssumeRoleInput) {
+			assert.Equal(t, "0123456789", *in.SerialNumber)
+			codes = append(codes, *in.TokenCode)
+		},
+	}
+	var calls int
+	p := &AssumeRoleProvider{
+		Client:       stub,
+		RoleARN:      "roleARN",
+		SerialNumber: aws.String("0123456789"),
+		TokenCode:    aws.String("static"),
+		TokenProvider: func() (string, error) {
+			calls++
+			return fmt.Sprintf("code%d", calls), nil
+		},
+	}
+
+	// The token provider is called each time the credentials are refreshed,
+	// and is used instead of the token code.
+	for i := 0; i < 2; i++ {
+		_, err := p.Retrieve()
+		assert.Nil(t, err, "Expect no error")
+	}
+	assert.Equal(t, []string{"code1", "code2"}, codes)
+}
+
+func TestAssumeRoleProvider_WithTokenCodeUsed(t *testing.T) {
+	var calls int
+	stub := &stubSTS{
+		TestInput: func(in *sts.AssumeRoleInput) {
+			calls++
+		},
+	}
+	p := &AssumeRoleProvider{
+		Client:       stub,
+		RoleARN:      "roleARN",
+		SerialNumber: aws.String("0123456789"),
+		TokenCode:    aws.String("code"),
+	}
+
+	_, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+
+	// The token code cannot be used again to refresh the credentials.
+	creds, err := p.Retrieve()
+	if aerr, ok := err.(awserr.Error); assert.True(t, ok, "Expect awserr.Error") {
+		assert.Equal(t, "AssumeRoleTokenCodeUsed", aerr.Code())
+		assert.Contains(t, aerr.Message(), "TokenProvider")
+	}
+	assert.Empty(t, creds.AccessKeyID)
+	assert.Equal(t, 1, calls, "Expect API request to be called once")
+}
+
+func TestAssumeRoleProvider_ExpiryWindowJitter(t *testing.T) {
+	p := &AssumeRoleProvider{
+		Client:                 &stubSTS{},
+		RoleARN:                "roleARN",
+		ExpiryWindow:           10 * time.Minute,
+		ExpiryWindowJitterFrac: 0.5,
+	}
+	p.JitterRand = func() float64 { return 0.5 }
+
+	before := time.Now()
+	_, err := p.Retrieve()
+	assert.Nil(t, err, "Expect no error")
+
+	// The stub's credentials expire in an hour, and the window is reduced to
+	// 7.5 minutes by the jitter.
+	expiresAt := p.ExpiresAt()
+	lower := before.Add(60*time.Minute - 7*time.Minute - 30*time.Second)
+	assert.False(t, expiresAt.Before(lower), "Expect %v not before %v", expiresAt, lower)
+	assert.True(t, expiresAt.Before(lower.Add(time.Second)), "Expect %v before %v", expiresAt, lower.Add(time.Second))
+}
+
+func TestNewCredentialsWithClient_CacheDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stscreds-cache")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var assumed []string
+	stub := &stubSTS{
+		TestInput: func(in *sts.AssumeRoleInput) {
+			assumed = append(assumed, *in.RoleArn+"/"+aws.StringValue(in.ExternalId))
+		},
+	}
+	withCache := func(externalID string) func(*AssumeRoleProvider) {
+		return func(p *AssumeRoleProvider) {
+			p.RoleSessionName = "session"
+			p.ExternalID = aws.String(externalID)
+			p.CacheDir = dir
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		creds := NewCredentialsWithClient(stub, "roleARN", withCache("external"))
+		v, err := creds.Get()
+		assert.Nil(t, err, "Expect no error")
+		assert.Equal(t, "roleARN", v.AccessKeyID)
+		assert.Equal(t, "012345678901", v.AccountID)
+	}
+
+	// Roles assumed with other parameters are not read from the cache.
+	creds := NewCredentialsWithClient(stub, "roleARN", withCache("other"))
+	_, err = creds.Get()
+	assert.Nil(t, err, "Expect no error")
+
+	assert.Equal(t, []string{"roleARN/external", "roleARN/other"}, assumed)
+}
+
+// configRecorder records the config of the clients created with it.
+type configRecorder struct {
+	configs int
+	config  *aws.Config
+}
+
+func (r *configRecorder) ClientConfig(serviceName string, cfgs ...*aws.Config) client.Config {
+	r.configs++
+	r.config = aws.NewConfig().WithRegion("us-east-1")
+	r.config.MergeIn(cfgs...)
+
+	return client.Config{
+		Config:        r.config,
+		Endpoint:      aws.StringValue(r.config.Endpoint),
+		SigningRegion: aws.StringValue(r.config.Region),
+	}
+}
+
+func TestNewCredentials_STSClientOptions(t *testing.T) {
+	httpClient := &http.Client{}
+	recorder := &configRecorder{}
+	NewCredentials(recorder, "roleARN",
+		WithSTSRegion("us-west-2"),
+		WithSTSEndpoint("https://sts.example.com"),
+		WithSTSHTTPClient(httpClient),
+	)
+
+	assert.Equal(t, 1, recorder.configs)
+	assert.Equal(t, "us-west-2", aws.StringValue(recorder.config.Region))
+	assert.Equal(t, "https://sts.example.com", aws.StringValue(recorder.config.Endpoint))
+	assert.True(t, httpClient == recorder.config.HTTPClient, "Expect HTTP client of option")
+
+	// The STS client is not created if an option sets the client.
+	recorder = &configRecorder{}
+	NewCredentials(recorder, "roleARN", WithSTSRegion("us-west-2"), func(p *AssumeRoleProvider) {
+		p.Client = &stubSTS{}
+	})
+	assert.Equal(t, 0, recorder.configs)
+}
+
 func BenchmarkAssumeRoleProvider(b *testing.B) {
 	stub := &stubSTS{}
 	p := &AssumeRoleProvider{
@@ -0,0 +1,169 @@
+package stscreds
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+const (
+	// ErrCodeWebIdentity is the error code of the error returned when the
+	// web identity token fails to be fetched.
+	ErrCodeWebIdentity = "WebIdentityErr"
+
+	// WebIdentityProviderName is the name of the web identity provider.
+	WebIdentityProviderName = "WebIdentityCredentials"
+)
+
+// now is used to return the current time, and may be replaced by tests.
+var now = time.Now
+
+// TokenFetcher fetches the web identity token used to assume the role. The
+// token is fetched each time the credentials are retrieved.
+type TokenFetcher interface {
+	FetchToken() ([]byte, error)
+}
+
+// FetchTokenPath is a TokenFetcher reading the token from the file at the
+// path. The file is read each time the token is fetched, so that a rotated
+// token is used once the credentials are refreshed.
+type FetchTokenPath string
+
+// FetchToken reads the token from the file.
+func (f FetchTokenPath) FetchToken() ([]byte, error) {
+	data, err := ioutil.ReadFile(string(f))
+	if err != nil {
+		return nil, awserr.New(ErrCodeWebIdentity,
+			fmt.Sprintf("unable to read web identity token file, %s", string(f)), err)
+	}
+	return data, nil
+}
+
+// WebIdentityRoleAssumer represents the minimal subset of the STS client API
+// used by the WebIdentityRoleProvider.
+type WebIdentityRoleAssumer interface {
+	AssumeRoleWithWebIdentityRequest(input *sts.AssumeRoleWithWebIdentityInput) (*request.Request, *sts.AssumeRoleWithWebIdentityOutput)
+}
+
+// WebIdentityRoleProvider retrieves temporary credentials from the STS
+// service by assuming a role with a web identity token, such as the token of
+// a Kubernetes service account, and keeps track of their expiration time.
+//
+// The provider is used by the SDK's default credential chain when the
+// AWS_ROLE_ARN and AWS_WEB_IDENTITY_TOKEN_FILE environment variables are set.
+// The AWS_ROLE_SESSION_NAME environment variable sets the role session name.
+type WebIdentityRoleProvider struct {
+	credentials.Expiry
+
+	// ExpiryWindow will allow the credentials to trigger refreshing prior to
+	// the credentials actually expiring. This is beneficial so race conditions
+	// with expiring credentials do not cause request to fail unexpectedly
+	// due to ExpiredTokenException exceptions.
+	//
+	// So a ExpiryWindow of 10s would cause calls to IsExpired() to return true
+	// 10 seconds before the credentials are actually expired.
+	//
+	// If ExpiryWindow is 0 or less it will be ignored.
+	ExpiryWindow time.Duration
+
+	// ExpiryWindowJitterFrac randomizes the ExpiryWindow, so that processes
+	// sharing the same credentials do not refresh them at the same time. The
+	// window is randomized between ExpiryWindow*(1-ExpiryWindowJitterFrac)
+	// and ExpiryWindow each time the credentials are retrieved.
+	//
+	// So a ExpiryWindowJitterFrac of 0.5 with a ExpiryWindow of 10s would
+	// cause calls to IsExpired() to return true between 5 and 10 seconds
+	// before the credentials are actually expired.
+	//
+	// If ExpiryWindowJitterFrac is 0 or less it will be ignored.
+	ExpiryWindowJitterFrac float64
+
+	client       WebIdentityRoleAssumer
+	tokenFetcher TokenFetcher
+
+	roleARN         string
+	roleSessionName string
+}
+
+// NewWebIdentityCredentials returns a Credentials wrapper for retrieving the
+// credentials of the role assumed with the web identity token read from the
+// file at path.
+func NewWebIdentityCredentials(c client.ConfigProvider, roleARN, roleSessionName, path string) *credentials.Credentials {
+	svc := sts.New(c)
+	p := NewWebIdentityRoleProvider(svc, roleARN, roleSessionName, FetchTokenPath(path))
+	return credentials.NewCredentials(p)
+}
+
+// NewWebIdentityRoleProvider returns a WebIdentityRoleProvider assuming the
+// role with the web identity token fetched by the tokenFetcher. If the
+// roleSessionName is empty, the current time is used as the session name.
+func NewWebIdentityRoleProvider(svc WebIdentityRoleAssumer, roleARN, roleSessionName string, tokenFetcher TokenFetcher) *WebIdentityRoleProvider {
+	return &WebIdentityRoleProvider{
+		client:          svc,
+		tokenFetcher:    tokenFetcher,
+		roleARN:         roleARN,
+		roleSessionName: roleSessionName,
+	}
+}
+
+// Retrieve fetches the web identity token, and returns the credentials of
+// the role assumed with the token. An error is returned if the token fails
+// to be fetched, or the role fails to be assumed.
+func (p *WebIdentityRoleProvider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(aws.BackgroundContext())
+}
+
+// RetrieveWithContext is the same as Retrieve, and cancels the request
+// assuming the role if the context is canceled.
+func (p *WebIdentityRoleProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	b, err := p.tokenFetcher.FetchToken()
+	if err != nil {
+		return credentials.Value{ProviderName: WebIdentityProviderName}, err
+	}
+
+	sessionName := p.roleSessionName
+	if len(sessionName) == 0 {
+		// session name is used to uniquely identify a session. This simply
+		// uses unix time in nanoseconds to uniquely identify sessions.
+		sessionName = strconv.FormatInt(now().UnixNano(), 10)
+	}
+
+	req, resp := p.client.AssumeRoleWithWebIdentityRequest(&sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.roleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(string(b)),
+	})
+	req.SetContext(ctx)
+	// The identity provider failing to be reached by STS is a temporary
+	// error, and the role is assumed again.
+	req.Handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: "stscreds.RetryIDPCommunicationError",
+		Fn: func(r *request.Request) {
+			if aerr, ok := r.Error.(awserr.Error); ok && aerr.Code() == sts.ErrCodeIDPCommunicationErrorException {
+				r.Retryable = aws.Bool(true)
+			}
+		},
+	})
+	if err := req.Send(); err != nil {
+		return credentials.Value{ProviderName: WebIdentityProviderName},
+			awserr.New(ErrCodeWebIdentity, "failed to retrieve credentials", err)
+	}
+
+	p.SetExpirationWithJitter(aws.TimeValue(resp.Credentials.Expiration), p.ExpiryWindow, p.ExpiryWindowJitterFrac)
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(resp.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(resp.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(resp.Credentials.SessionToken),
+		ProviderName:    WebIdentityProviderName,
+		AccountID:       assumedRoleAccountID(resp.AssumedRoleUser),
+	}, nil
+}
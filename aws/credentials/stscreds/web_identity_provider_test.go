@@ -0,0 +1,268 @@
+package stscreds
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+const webIdentityResponse = `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>AKID_%d</AccessKeyId>
+      <SecretAccessKey>SECRET</SecretAccessKey>
+      <SessionToken>TOKEN</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::012345678901:assumed-role/TestRole/session_name</Arn>
+      <AssumedRoleId>AROAID:session_name</AssumedRoleId>
+    </AssumedRoleUser>
+  </AssumeRoleWithWebIdentityResult>
+</AssumeRoleWithWebIdentityResponse>`
+
+const webIdentityErrorResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>%s</Code>
+    <Message>mock error</Message>
+  </Error>
+  <RequestId>request-id</RequestId>
+</ErrorResponse>`
+
+type webIdentityServer struct {
+	*httptest.Server
+
+	expiration time.Time
+	errCodes   []string
+	tokens     []string
+	sessions   []string
+}
+
+// newWebIdentityServer returns a fake STS server failing the first requests
+// with the error codes, and recording the token and session name of each
+// request.
+func newWebIdentityServer(expiration time.Time, errCodes ...string) *webIdentityServer {
+	s := &webIdentityServer{expiration: expiration, errCodes: errCodes}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if e, a := "AssumeRoleWithWebIdentity", r.Form.Get("Action"); e != a {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if len(r.Header.Get("Authorization")) != 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.tokens = append(s.tokens, r.Form.Get("WebIdentityToken"))
+		s.sessions = append(s.sessions, r.Form.Get("RoleSessionName"))
+
+		if n := len(s.tokens); n <= len(s.errCodes) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, webIdentityErrorResponse, s.errCodes[n-1])
+			return
+		}
+		fmt.Fprintf(w, webIdentityResponse, len(s.tokens), s.expiration.UTC().Format(time.RFC3339))
+	}))
+	return s
+}
+
+// stubConfigProvider provides the config of clients sending requests to the
+// endpoint.
+type stubConfigProvider struct {
+	endpoint string
+}
+
+func (p stubConfigProvider) ClientConfig(serviceName string, cfgs ...*aws.Config) client.Config {
+	var handlers request.Handlers
+	handlers.Send.PushBackNamed(corehandlers.SendHandler)
+	handlers.ValidateResponse.PushBackNamed(corehandlers.ValidateResponseHandler)
+	handlers.AfterRetry.PushBackNamed(corehandlers.AfterRetryHandler)
+
+	return client.Config{
+		Config: aws.NewConfig().
+			WithRegion("us-east-1").
+			WithHTTPClient(http.DefaultClient).
+			WithMaxRetries(2).
+			WithSleepDelay(func(time.Duration) {}).
+			WithDisableRetryQuota(true),
+		Handlers: handlers,
+		Endpoint: p.endpoint,
+	}
+}
+
+func newWebIdentitySTS(endpoint string) *sts.STS {
+	return sts.New(stubConfigProvider{endpoint: endpoint})
+}
+
+func writeWebIdentityToken(t *testing.T, filename, token string) {
+	if err := ioutil.WriteFile(filename, []byte(token), 0600); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+}
+
+func TestWebIdentityRoleProvider_Retrieve(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stscreds")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+	tokenFile := filepath.Join(dir, "token")
+	writeWebIdentityToken(t, tokenFile, "token_1")
+
+	expiration := time.Now().Add(time.Hour).Truncate(time.Second)
+	server := newWebIdentityServer(expiration)
+	defer server.Close()
+
+	p := NewWebIdentityRoleProvider(newWebIdentitySTS(server.URL),
+		"arn:aws:iam::012345678901:role/TestRole", "session_name", FetchTokenPath(tokenFile))
+	p.ExpiryWindow = time.Minute
+	clock := time.Now()
+	p.CurrentTime = func() time.Time { return clock }
+
+	v, err := p.Retrieve()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID_1", v.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+	if e, a := "SECRET", v.SecretAccessKey; e != a {
+		t.Errorf("expect %v secret access key, got %v", e, a)
+	}
+	if e, a := "TOKEN", v.SessionToken; e != a {
+		t.Errorf("expect %v session token, got %v", e, a)
+	}
+	if e, a := WebIdentityProviderName, v.ProviderName; e != a {
+		t.Errorf("expect %v provider name, got %v", e, a)
+	}
+	if e, a := "012345678901", v.AccountID; e != a {
+		t.Errorf("expect %v account ID, got %v", e, a)
+	}
+	if p.IsExpired() {
+		t.Errorf("expect credentials not to be expired")
+	}
+
+	// The rotated token is read once the credentials expire.
+	writeWebIdentityToken(t, tokenFile, "token_2")
+	clock = expiration.Add(-30 * time.Second)
+	if !p.IsExpired() {
+		t.Fatalf("expect credentials to be expired")
+	}
+	if v, err = p.Retrieve(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID_2", v.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+
+	if e, a := []string{"token_1", "token_2"}, server.tokens; fmt.Sprint(e) != fmt.Sprint(a) {
+		t.Errorf("expect %v tokens, got %v", e, a)
+	}
+	if e, a := []string{"session_name", "session_name"}, server.sessions; fmt.Sprint(e) != fmt.Sprint(a) {
+		t.Errorf("expect %v session names, got %v", e, a)
+	}
+}
+
+func TestWebIdentityRoleProvider_DefaultSessionName(t *testing.T) {
+	defer func(fn func() time.Time) { now = fn }(now)
+	now = func() time.Time { return time.Unix(0, 1234) }
+
+	dir, err := ioutil.TempDir("", "stscreds")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+	tokenFile := filepath.Join(dir, "token")
+	writeWebIdentityToken(t, tokenFile, "token")
+
+	server := newWebIdentityServer(time.Now().Add(time.Hour))
+	defer server.Close()
+
+	p := NewWebIdentityRoleProvider(newWebIdentitySTS(server.URL),
+		"arn:aws:iam::012345678901:role/TestRole", "", FetchTokenPath(tokenFile))
+	if _, err := p.Retrieve(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := []string{"1234"}, server.sessions; fmt.Sprint(e) != fmt.Sprint(a) {
+		t.Errorf("expect %v session names, got %v", e, a)
+	}
+}
+
+func TestWebIdentityRoleProvider_Errors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stscreds")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+	tokenFile := filepath.Join(dir, "token")
+	writeWebIdentityToken(t, tokenFile, "token")
+
+	cases := map[string]struct {
+		TokenFile     string
+		ErrCodes      []string
+		ExpectErr     bool
+		ExpectOrigErr string
+		ExpectSends   int
+	}{
+		"IDP communication error retried": {
+			TokenFile:   tokenFile,
+			ErrCodes:    []string{sts.ErrCodeIDPCommunicationErrorException},
+			ExpectSends: 2,
+		},
+		"invalid token not retried": {
+			TokenFile:     tokenFile,
+			ErrCodes:      []string{sts.ErrCodeInvalidIdentityTokenException},
+			ExpectErr:     true,
+			ExpectOrigErr: sts.ErrCodeInvalidIdentityTokenException,
+			ExpectSends:   1,
+		},
+		"missing token file": {
+			TokenFile: filepath.Join(dir, "missing"),
+			ExpectErr: true,
+		},
+	}
+
+	for name, c := range cases {
+		server := newWebIdentityServer(time.Now().Add(time.Hour), c.ErrCodes...)
+		p := NewWebIdentityRoleProvider(newWebIdentitySTS(server.URL),
+			"arn:aws:iam::012345678901:role/TestRole", "session_name", FetchTokenPath(c.TokenFile))
+		_, err := p.Retrieve()
+		server.Close()
+
+		if !c.ExpectErr {
+			if err != nil {
+				t.Errorf("%s, expect no error, got %v", name, err)
+			}
+		} else {
+			aerr, ok := err.(awserr.Error)
+			if !ok {
+				t.Fatalf("%s, expect awserr.Error, got %T, %v", name, err, err)
+			}
+			if e, a := ErrCodeWebIdentity, aerr.Code(); e != a {
+				t.Errorf("%s, expect %v error code, got %v", name, e, a)
+			}
+			if len(c.ExpectOrigErr) != 0 {
+				origErr, _ := aerr.OrigErr().(awserr.Error)
+				if origErr == nil || origErr.Code() != c.ExpectOrigErr {
+					t.Errorf("%s, expect %v original error, got %v", name, c.ExpectOrigErr, aerr.OrigErr())
+				}
+			}
+		}
+		if e, a := c.ExpectSends, len(server.tokens); e != a {
+			t.Errorf("%s, expect %d sends, got %d", name, e, a)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package csm
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+const (
+	apiCallMetricType        = "ApiCall"
+	apiCallAttemptMetricType = "ApiCallAttempt"
+
+	metricVersion = 1
+)
+
+// maxRecordSize is the maximum size in bytes of a record sent in a UDP
+// datagram. Records larger than the limit are truncated, or dropped if they
+// cannot be.
+const maxRecordSize = 8 * 1024
+
+// Maximum lengths of the metric's free-form string values.
+const (
+	maxExceptionLength        = 128
+	maxExceptionMessageLength = 512
+	maxUserAgentLength        = 256
+	maxFqdnLength             = 256
+)
+
+// metricTime is the time of a metric, encoded as milliseconds since the Unix
+// epoch.
+type metricTime time.Time
+
+func (t metricTime) MarshalJSON() ([]byte, error) {
+	ms := time.Time(t).UnixNano() / int64(time.Millisecond)
+	return []byte(strconv.FormatInt(ms, 10)), nil
+}
+
+// A metric is the record of an API call, or an API call attempt, sent to the
+// CSM agent. Only the values of the metric's type are set.
+type metric struct {
+	ClientID  string     `json:"ClientId"`
+	API       string     `json:"Api"`
+	Service   string     `json:"Service"`
+	Timestamp metricTime `json:"Timestamp"`
+	Type      string     `json:"Type"`
+	Version   int        `json:"Version"`
+	Region    string     `json:"Region,omitempty"`
+
+	// ApiCall values.
+	AttemptCount        int    `json:"AttemptCount,omitempty"`
+	Latency             *int64 `json:"Latency,omitempty"`
+	FinalHTTPStatusCode int    `json:"FinalHttpStatusCode,omitempty"`
+	FinalAWSException   string `json:"FinalAwsException,omitempty"`
+	FinalSDKException   string `json:"FinalSdkException,omitempty"`
+
+	// ApiCallAttempt values.
+	Fqdn                string `json:"Fqdn,omitempty"`
+	UserAgent           string `json:"UserAgent,omitempty"`
+	AccessKey           string `json:"AccessKey,omitempty"`
+	AttemptLatency      *int64 `json:"AttemptLatency,omitempty"`
+	HTTPStatusCode      int    `json:"HttpStatusCode,omitempty"`
+	XAmznRequestID      string `json:"XAmznRequestId,omitempty"`
+	XAmzRequestID       string `json:"XAmzRequestId,omitempty"`
+	XAmzID2             string `json:"XAmzId2,omitempty"`
+	AWSException        string `json:"AwsException,omitempty"`
+	AWSExceptionMessage string `json:"AwsExceptionMessage,omitempty"`
+	SDKException        string `json:"SdkException,omitempty"`
+	SDKExceptionMessage string `json:"SdkExceptionMessage,omitempty"`
+}
+
+// marshal encodes the metric as JSON, truncating its free-form values to fit
+// the record size limit. Returns false if the metric is too large to send.
+func (m metric) marshal() ([]byte, bool) {
+	m.FinalAWSException = truncate(m.FinalAWSException, maxExceptionLength)
+	m.FinalSDKException = truncate(m.FinalSDKException, maxExceptionLength)
+	m.AWSException = truncate(m.AWSException, maxExceptionLength)
+	m.SDKException = truncate(m.SDKException, maxExceptionLength)
+	m.AWSExceptionMessage = truncate(m.AWSExceptionMessage, maxExceptionMessageLength)
+	m.SDKExceptionMessage = truncate(m.SDKExceptionMessage, maxExceptionMessageLength)
+	m.UserAgent = truncate(m.UserAgent, maxUserAgentLength)
+	m.Fqdn = truncate(m.Fqdn, maxFqdnLength)
+
+	b, err := json.Marshal(m)
+	if err == nil && len(b) > maxRecordSize {
+		// Escaped characters may still exceed the limit, drop the messages
+		// as the least useful values.
+		m.AWSExceptionMessage, m.SDKExceptionMessage = "", ""
+		b, err = json.Marshal(m)
+	}
+	if err != nil || len(b) > maxRecordSize {
+		return nil, false
+	}
+
+	return b, true
+}
+
+// truncate returns the first n bytes of s.
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
+
+func millis(d time.Duration) *int64 {
+	ms := int64(d / time.Millisecond)
+	return &ms
+}
@@ -0,0 +1,104 @@
+package csm
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMetric_Marshal(t *testing.T) {
+	m := metric{
+		ClientID:            "client-id",
+		API:                 "Operation",
+		Service:             "Mock",
+		Timestamp:           metricTime(time.Unix(1, int64(500*time.Millisecond))),
+		Type:                apiCallAttemptMetricType,
+		Version:             metricVersion,
+		AttemptLatency:      millis(1500 * time.Millisecond),
+		AWSException:        strings.Repeat("e", 2*maxExceptionLength),
+		AWSExceptionMessage: strings.Repeat("m", 2*maxExceptionMessageLength),
+		UserAgent:           strings.Repeat("u", 2*maxUserAgentLength),
+	}
+
+	b, ok := m.marshal()
+	if !ok {
+		t.Fatalf("expect metric to be marshaled")
+	}
+
+	var record map[string]interface{}
+	if err := json.Unmarshal(b, &record); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := float64(1500), record["Timestamp"]; e != a {
+		t.Errorf("expect %v timestamp, got %v", e, a)
+	}
+	if e, a := float64(1500), record["AttemptLatency"]; e != a {
+		t.Errorf("expect %v attempt latency, got %v", e, a)
+	}
+	for k, l := range map[string]int{
+		"AwsException":        maxExceptionLength,
+		"AwsExceptionMessage": maxExceptionMessageLength,
+		"UserAgent":           maxUserAgentLength,
+	} {
+		if e, a := l, len(record[k].(string)); e != a {
+			t.Errorf("expect %s truncated to %d, got %d", k, e, a)
+		}
+	}
+	if _, ok := record["SdkException"]; ok {
+		t.Errorf("expect no SDK exception, got %v", record["SdkException"])
+	}
+}
+
+func TestMetric_MarshalRecordSizeLimit(t *testing.T) {
+	// Escaped control characters exceed the record size limit once
+	// encoded, even though the messages are truncated.
+	m := metric{
+		Type:                apiCallAttemptMetricType,
+		AWSExceptionMessage: strings.Repeat("\x00", maxExceptionMessageLength),
+		SDKExceptionMessage: strings.Repeat("\x00", maxExceptionMessageLength),
+		UserAgent:           strings.Repeat("\x00", maxUserAgentLength),
+		Fqdn:                strings.Repeat("\x00", maxFqdnLength),
+	}
+
+	b, ok := m.marshal()
+	if !ok {
+		t.Fatalf("expect metric to be marshaled")
+	}
+	if len(b) > maxRecordSize {
+		t.Errorf("expect record within %d bytes, got %d", maxRecordSize, len(b))
+	}
+	if strings.Contains(string(b), "ExceptionMessage") {
+		t.Errorf("expect exception messages to be dropped")
+	}
+
+	m.API = strings.Repeat("\x00", maxRecordSize)
+	if _, ok := m.marshal(); ok {
+		t.Errorf("expect metric exceeding the size limit not to be marshaled")
+	}
+}
+
+func TestReporter_PushFull(t *testing.T) {
+	rep := &Reporter{
+		clientID: "client-id",
+		metrics:  make(chan metric, 1),
+		done:     make(chan struct{}),
+	}
+
+	// Pushing to a full queue must not block.
+	rep.push(metric{API: "first"})
+	rep.push(metric{API: "dropped"})
+
+	if e, a := 1, len(rep.metrics); e != a {
+		t.Fatalf("expect %d queued records, got %d", e, a)
+	}
+	if m := <-rep.metrics; m.API != "first" || m.ClientID != "client-id" {
+		t.Errorf("expect first record with client ID, got %v", m)
+	}
+
+	close(rep.done)
+	rep.push(metric{API: "closed"})
+	if e, a := 0, len(rep.metrics); e != a {
+		t.Errorf("expect %d queued records after close, got %d", e, a)
+	}
+}
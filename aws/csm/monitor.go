@@ -0,0 +1,131 @@
+package csm
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Names of the handlers injected into each request's handlers.
+const (
+	attemptStartedHandlerName   = "awscsm.AttemptStarted"
+	attemptCompletedHandlerName = "awscsm.AttemptCompleted"
+	callCompletedHandlerName    = "awscsm.CallCompleted"
+)
+
+// A requestMonitor collects the records of a request, and its attempts.
+type requestMonitor struct {
+	rep   *Reporter
+	start time.Time
+
+	attempts       int
+	attemptStart   time.Time
+	attemptPending bool
+}
+
+// injectRequestHandlers injects the handlers collecting the request's records
+// into the request's handlers. Runs once, when the request is validated.
+func (rep *Reporter) injectRequestHandlers(r *request.Request) {
+	m := &requestMonitor{rep: rep, start: time.Now()}
+
+	r.Handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: attemptStartedHandlerName, Fn: m.attemptStarted,
+	})
+	// Failed attempts are completed by the request's retry handlers, which
+	// clear the attempt's error if the request will be retried.
+	r.Handlers.AfterRetry.PushFrontNamed(request.NamedHandler{
+		Name: attemptCompletedHandlerName, Fn: m.attemptCompleted,
+	})
+	r.Handlers.Complete.PushFrontNamed(request.NamedHandler{
+		Name: callCompletedHandlerName, Fn: m.callCompleted,
+	})
+}
+
+func (m *requestMonitor) attemptStarted(r *request.Request) {
+	m.attempts++
+	m.attemptStart = time.Now()
+	m.attemptPending = true
+}
+
+func (m *requestMonitor) attemptCompleted(r *request.Request) {
+	if !m.attemptPending {
+		return
+	}
+	m.attemptPending = false
+
+	rec := newMetric(r, apiCallAttemptMetricType, m.attemptStart)
+	rec.Fqdn = r.HTTPRequest.URL.Host
+	rec.UserAgent = r.HTTPRequest.Header.Get("User-Agent")
+	rec.AttemptLatency = millis(time.Since(m.attemptStart))
+	if r.Config.Credentials != nil {
+		if v, err := r.Config.Credentials.GetWithContext(r.Context()); err == nil {
+			rec.AccessKey = v.AccessKeyID
+		}
+	}
+	if resp := r.HTTPResponse; resp != nil {
+		rec.HTTPStatusCode = resp.StatusCode
+		rec.XAmznRequestID = resp.Header.Get("X-Amzn-Requestid")
+		rec.XAmzRequestID = resp.Header.Get("X-Amz-Request-Id")
+		rec.XAmzID2 = resp.Header.Get("X-Amz-Id-2")
+	}
+	if aerr, ok := r.Error.(awserr.Error); ok {
+		if isServiceError(aerr) {
+			rec.AWSException, rec.AWSExceptionMessage = aerr.Code(), aerr.Message()
+		} else {
+			rec.SDKException, rec.SDKExceptionMessage = aerr.Code(), aerr.Message()
+		}
+	} else if r.Error != nil {
+		rec.SDKException, rec.SDKExceptionMessage = "UnknownError", r.Error.Error()
+	}
+
+	m.rep.push(rec)
+}
+
+func (m *requestMonitor) callCompleted(r *request.Request) {
+	// The last attempt is completed with the request, unless it was
+	// completed by the request's retry handlers.
+	m.attemptCompleted(r)
+
+	rec := newMetric(r, apiCallMetricType, m.start)
+	rec.AttemptCount = m.attempts
+	rec.Latency = millis(time.Since(m.start))
+	if r.HTTPResponse != nil {
+		rec.FinalHTTPStatusCode = r.HTTPResponse.StatusCode
+	}
+	if aerr, ok := r.Error.(awserr.Error); ok {
+		if isServiceError(aerr) {
+			rec.FinalAWSException = aerr.Code()
+		} else {
+			rec.FinalSDKException = aerr.Code()
+		}
+	} else if r.Error != nil {
+		rec.FinalSDKException = "UnknownError"
+	}
+
+	m.rep.push(rec)
+}
+
+func newMetric(r *request.Request, typ string, t time.Time) metric {
+	service := r.ClientInfo.ServiceID
+	if len(service) == 0 {
+		service = r.ClientInfo.ServiceName
+	}
+
+	return metric{
+		API:       r.Operation.Name,
+		Service:   service,
+		Timestamp: metricTime(t),
+		Type:      typ,
+		Version:   metricVersion,
+		Region:    aws.StringValue(r.Config.Region),
+	}
+}
+
+// isServiceError returns if the error was returned by the service in an error
+// response, rather than by the SDK.
+func isServiceError(err awserr.Error) bool {
+	reqErr, ok := err.(awserr.RequestFailure)
+	return ok && reqErr.StatusCode() >= 300
+}
@@ -0,0 +1,191 @@
+// Package csm provides client side monitoring (CSM) of the API requests made
+// by service clients. A record of each API call, and of each of the call's
+// attempts, is sent as JSON in a UDP datagram to a CSM agent, such as one
+// running on the local host.
+//
+// CSM is enabled for the service clients of sessions created with the
+// AWS_CSM_ENABLED environment variable set to true. The agent's port on the
+// local host, and the client ID added to records, are set with the
+// AWS_CSM_PORT, and AWS_CSM_CLIENT_ID environment variables.
+//
+// CSM can also be enabled programmatically, by starting a Reporter and
+// injecting its handlers into a session's, or service client's handlers.
+//
+//     r, err := csm.Start("my-application", "127.0.0.1:31000")
+//     if err != nil {
+//         panic(fmt.Sprintf("failed to start CSM reporter, %v", err))
+//     }
+//     defer r.Close()
+//
+//     sess := session.Must(session.NewSession())
+//     r.InjectHandlers(&sess.Handlers)
+//
+// Records are sent in the background, and are dropped rather than delaying
+// requests if the records cannot be sent as fast as they are produced.
+package csm
+
+import (
+	"net"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// DefaultPort is the port of the CSM agent on the local host sessions send
+// records to if the AWS_CSM_PORT environment variable is not set.
+const DefaultPort = "31000"
+
+// ErrCodeStart is the error code of the error Start returns when a Reporter
+// cannot be started.
+const ErrCodeStart = "CSMStartError"
+
+// metricsChanSize is the number of records buffered to be sent. Records are
+// dropped once the buffer is full.
+const metricsChanSize = 100
+
+var (
+	reporterMu sync.Mutex
+	reporter   *Reporter
+)
+
+// A Reporter sends the records of the API requests of the service clients
+// its handlers are injected into to a CSM agent.
+//
+// Only one Reporter is running at a time. A Reporter is safe to use
+// concurrently.
+type Reporter struct {
+	clientID string
+	addr     string
+	conn     net.Conn
+
+	metrics   chan metric
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// Start starts a Reporter sending records to the CSM agent at the UDP address
+// addr, e.g. "127.0.0.1:31000", with the client ID.
+//
+// If a Reporter was already started with the same client ID and address,
+// and has not been closed, the running Reporter is returned. An error is
+// returned if the running Reporter's client ID or address differ.
+func Start(clientID, addr string) (*Reporter, error) {
+	reporterMu.Lock()
+	defer reporterMu.Unlock()
+
+	if reporter != nil {
+		if reporter.clientID != clientID || reporter.addr != addr {
+			return nil, awserr.New(ErrCodeStart,
+				"CSM reporter already started with a different client ID or address", nil)
+		}
+		return reporter, nil
+	}
+
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		return nil, awserr.New(ErrCodeStart,
+			"failed to connect to CSM agent, "+addr, err)
+	}
+
+	reporter = newReporter(clientID, addr, conn)
+	return reporter, nil
+}
+
+// Get returns the running Reporter, or nil if no Reporter is running.
+func Get() *Reporter {
+	reporterMu.Lock()
+	defer reporterMu.Unlock()
+
+	return reporter
+}
+
+func newReporter(clientID, addr string, conn net.Conn) *Reporter {
+	rep := &Reporter{
+		clientID: clientID,
+		addr:     addr,
+		conn:     conn,
+		metrics:  make(chan metric, metricsChanSize),
+		done:     make(chan struct{}),
+	}
+
+	rep.wg.Add(1)
+	go rep.run()
+
+	return rep
+}
+
+// Close stops the Reporter, and closes its connection to the CSM agent.
+// Records of requests made after the Reporter is closed are dropped. Once
+// closed, Start will start a new Reporter.
+func (rep *Reporter) Close() error {
+	var err error
+	rep.closeOnce.Do(func() {
+		reporterMu.Lock()
+		if reporter == rep {
+			reporter = nil
+		}
+		reporterMu.Unlock()
+
+		close(rep.done)
+		rep.wg.Wait()
+		err = rep.conn.Close()
+	})
+
+	return err
+}
+
+// run sends the records pushed to the Reporter until it is closed.
+func (rep *Reporter) run() {
+	defer rep.wg.Done()
+
+	for {
+		select {
+		case <-rep.done:
+			return
+		case m := <-rep.metrics:
+			b, ok := m.marshal()
+			if !ok {
+				continue
+			}
+			// Records which fail to be sent are dropped, there is no
+			// guarantee the agent is listening.
+			rep.conn.Write(b)
+		}
+	}
+}
+
+// push queues the record to be sent, dropping the record if the Reporter is
+// closed, or the queue is full.
+func (rep *Reporter) push(m metric) {
+	select {
+	case <-rep.done:
+		return
+	default:
+	}
+
+	m.ClientID = rep.clientID
+	select {
+	case rep.metrics <- m:
+	default:
+	}
+}
+
+// InjectHandlerName is the name of the request handler injected into a
+// request's handlers to collect the request's records.
+const InjectHandlerName = "awscsm.Inject"
+
+// InjectHandlers injects the Reporter's handlers into the handlers, so that
+// the records of requests made with the handlers are sent by the Reporter.
+// Does nothing if the Reporter is nil.
+func (rep *Reporter) InjectHandlers(handlers *request.Handlers) {
+	if rep == nil {
+		return
+	}
+
+	handlers.Validate.SetFrontNamed(request.NamedHandler{
+		Name: InjectHandlerName,
+		Fn:   rep.injectRequestHandlers,
+	})
+}
@@ -0,0 +1,215 @@
+package csm_test
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/csm"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol/jsonrpc"
+)
+
+func startUDPServer(t *testing.T) *net.UDPConn {
+	addr, _ := net.ResolveUDPAddr("udp", "127.0.0.1:0")
+	conn, err := net.ListenUDP("udp", addr)
+	if err != nil {
+		t.Fatalf("expect no error listening, got %v", err)
+	}
+	return conn
+}
+
+func readRecords(t *testing.T, conn *net.UDPConn, n int) []map[string]interface{} {
+	var records []map[string]interface{}
+	b := make([]byte, 64*1024)
+	for i := 0; i < n; i++ {
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		l, err := conn.Read(b)
+		if err != nil {
+			t.Fatalf("expect %d records, got %d, %v", n, i, err)
+		}
+		var record map[string]interface{}
+		if err := json.Unmarshal(b[:l], &record); err != nil {
+			t.Fatalf("expect JSON record, got %v, %s", err, b[:l])
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestReporter_RetriedThrottledCall(t *testing.T) {
+	var mu sync.Mutex
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		n := requests
+		mu.Unlock()
+
+		if n == 1 {
+			w.Header().Set("X-Amzn-Requestid", "throttled-request-id")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type":"ThrottlingException","message":"Rate exceeded"}`))
+			return
+		}
+		w.Header().Set("X-Amzn-Requestid", "request-id")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	udp := startUDPServer(t)
+	defer udp.Close()
+
+	rep, err := csm.Start("client-id", udp.LocalAddr().String())
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer rep.Close()
+
+	def := defaults.Get()
+	def.Config.MergeIn(&aws.Config{
+		Region:      aws.String("mock-region"),
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		SleepDelay:  func(time.Duration) {},
+	})
+	def.Handlers.Build.PushBackNamed(jsonrpc.BuildHandler)
+	def.Handlers.Unmarshal.PushBackNamed(jsonrpc.UnmarshalHandler)
+	def.Handlers.UnmarshalMeta.PushBackNamed(jsonrpc.UnmarshalMetaHandler)
+	def.Handlers.UnmarshalError.PushBackNamed(jsonrpc.UnmarshalErrorHandler)
+	rep.InjectHandlers(&def.Handlers)
+
+	svc := client.New(*def.Config, metadata.ClientInfo{
+		ServiceName:  "mock",
+		ServiceID:    "Mock",
+		Endpoint:     server.URL,
+		JSONVersion:  "1.1",
+		TargetPrefix: "Mock",
+	}, def.Handlers)
+
+	r := svc.NewRequest(&request.Operation{
+		Name:       "Operation",
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}, &struct{}{}, &struct{}{})
+	if err := r.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	records := readRecords(t, udp, 3)
+
+	common := map[string]interface{}{
+		"ClientId": "client-id",
+		"Api":      "Operation",
+		"Service":  "Mock",
+		"Region":   "mock-region",
+		"Version":  float64(1),
+	}
+	for i, record := range records {
+		for k, e := range common {
+			if a := record[k]; e != a {
+				t.Errorf("%d, expect %v %s, got %v", i, e, k, a)
+			}
+		}
+		if _, ok := record["Timestamp"].(float64); !ok {
+			t.Errorf("%d, expect timestamp, got %v", i, record["Timestamp"])
+		}
+	}
+
+	expects := []map[string]interface{}{
+		{
+			"Type":                "ApiCallAttempt",
+			"HttpStatusCode":      float64(400),
+			"XAmznRequestId":      "throttled-request-id",
+			"AwsException":        "ThrottlingException",
+			"AwsExceptionMessage": "Rate exceeded",
+			"AccessKey":           "AKID",
+			"SdkException":        nil,
+		},
+		{
+			"Type":           "ApiCallAttempt",
+			"HttpStatusCode": float64(200),
+			"XAmznRequestId": "request-id",
+			"AwsException":   nil,
+			"SdkException":   nil,
+		},
+		{
+			"Type":                "ApiCall",
+			"AttemptCount":        float64(2),
+			"FinalHttpStatusCode": float64(200),
+			"FinalAwsException":   nil,
+		},
+	}
+	for i, expect := range expects {
+		for k, e := range expect {
+			if a := records[i][k]; e != a {
+				t.Errorf("%d, expect %v %s, got %v", i, e, k, a)
+			}
+		}
+	}
+	for i, k := range []string{"AttemptLatency", "AttemptLatency", "Latency"} {
+		if _, ok := records[i][k].(float64); !ok {
+			t.Errorf("%d, expect %s, got %v", i, k, records[i][k])
+		}
+	}
+	if host := records[0]["Fqdn"]; host != server.Listener.Addr().String() {
+		t.Errorf("expect %v Fqdn, got %v", server.Listener.Addr(), host)
+	}
+}
+
+func TestStart(t *testing.T) {
+	udp := startUDPServer(t)
+	defer udp.Close()
+	addr := udp.LocalAddr().String()
+
+	rep, err := csm.Start("client-id", addr)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := rep, csm.Get(); e != a {
+		t.Errorf("expect running reporter %p, got %p", e, a)
+	}
+
+	same, err := csm.Start("client-id", addr)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := rep, same; e != a {
+		t.Errorf("expect running reporter %p, got %p", e, a)
+	}
+
+	if _, err := csm.Start("other-client-id", addr); err == nil {
+		t.Errorf("expect error starting reporter with other client ID")
+	}
+
+	if err := rep.Close(); err != nil {
+		t.Errorf("expect no error, got %v", err)
+	}
+	if a := csm.Get(); a != nil {
+		t.Errorf("expect no running reporter, got %p", a)
+	}
+
+	other, err := csm.Start("other-client-id", addr)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	other.Close()
+}
+
+func TestReporter_InjectHandlersNil(t *testing.T) {
+	var rep *csm.Reporter
+	handlers := request.Handlers{}
+	rep.InjectHandlers(&handlers)
+
+	if e, a := 0, handlers.Validate.Len(); e != a {
+		t.Errorf("expect %d handlers, got %d", e, a)
+	}
+}
@@ -9,6 +9,7 @@ package defaults
 
 import (
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
@@ -23,6 +24,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/internal/shareddefaults"
 )
 
 // A Defaults provides a collection of default values for SDK clients.
@@ -72,7 +74,10 @@ func Handlers() request.Handlers {
 	handlers.Validate.PushBackNamed(corehandlers.ValidateEndpointHandler)
 	handlers.Validate.AfterEachFn = request.HandlerListStopOnError
 	handlers.Build.PushBackNamed(corehandlers.SDKVersionUserAgentHandler)
+	handlers.Build.PushBackNamed(corehandlers.ServiceIDUserAgentHandler)
+	handlers.Build.PushBackNamed(corehandlers.AppIDUserAgentHandler)
 	handlers.Build.AfterEachFn = request.HandlerListStopOnError
+	handlers.Sign.PushBackNamed(corehandlers.CompressRequestBodyHandler)
 	handlers.Sign.PushBackNamed(corehandlers.BuildContentLengthHandler)
 	handlers.Send.PushBackNamed(corehandlers.ValidateReqSigHandler)
 	handlers.Send.PushBackNamed(corehandlers.SendHandler)
@@ -89,7 +94,6 @@ func Handlers() request.Handlers {
 // existing service client or session's Config.
 func CredChain(cfg *aws.Config, handlers request.Handlers) *credentials.Credentials {
 	return credentials.NewCredentials(&credentials.ChainProvider{
-		VerboseErrors: aws.BoolValue(cfg.CredentialsChainVerboseErrors),
 		Providers: []credentials.Provider{
 			&credentials.EnvProvider{},
 			&credentials.SharedCredentialsProvider{Filename: "", Profile: ""},
@@ -101,11 +105,34 @@ func CredChain(cfg *aws.Config, handlers request.Handlers) *credentials.Credenti
 const (
 	httpProviderEnvVar     = "AWS_CONTAINER_CREDENTIALS_FULL_URI"
 	ecsCredsProviderEnvVar = "AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"
+
+	httpProviderAuthTokenEnvVar     = "AWS_CONTAINER_AUTHORIZATION_TOKEN"
+	httpProviderAuthTokenFileEnvVar = "AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE"
 )
 
 // RemoteCredProvider returns a credentials provider for the default remote
 // endpoints such as EC2 or ECS Roles.
+//
+// The requests are sent with a dedicated HTTP client, not the HTTP client of
+// the config, so that the requests are not sent through the proxy of the
+// service clients. See RemoteCredProviderWithHTTPClient.
 func RemoteCredProvider(cfg aws.Config, handlers request.Handlers) credentials.Provider {
+	return RemoteCredProviderWithHTTPClient(cfg, handlers, nil)
+}
+
+// RemoteCredProviderWithHTTPClient returns a credentials provider for the
+// default remote endpoints such as EC2 or ECS Roles, sending the requests
+// with the HTTP client.
+//
+// If the HTTP client is nil, the requests are sent with an HTTP client with
+// short timeouts that does not send the requests through the proxy of the
+// HTTP_PROXY and HTTPS_PROXY environment variables.
+func RemoteCredProviderWithHTTPClient(cfg aws.Config, handlers request.Handlers, httpClient *http.Client) credentials.Provider {
+	if httpClient == nil {
+		httpClient = shareddefaults.MetadataHTTPClient()
+	}
+	cfg.HTTPClient = httpClient
+
 	if u := os.Getenv(httpProviderEnvVar); len(u) > 0 {
 		return localHTTPCredProvider(cfg, handlers, u)
 	}
@@ -124,8 +151,18 @@ func localHTTPCredProvider(cfg aws.Config, handlers request.Handlers, u string)
 	parsed, err := url.Parse(u)
 	if err != nil {
 		errMsg = fmt.Sprintf("invalid URL, %v", err)
-	} else if host := aws.URLHostname(parsed); !(host == "localhost" || host == "127.0.0.1") {
-		errMsg = fmt.Sprintf("invalid host address, %q, only localhost and 127.0.0.1 are valid.", host)
+	} else if host := aws.URLHostname(parsed); len(host) == 0 {
+		errMsg = fmt.Sprintf("invalid URL, %q, missing host", u)
+	} else {
+		switch parsed.Scheme {
+		case "https":
+		case "http":
+			if !isLoopbackHost(host) {
+				errMsg = fmt.Sprintf("invalid host address, %q, only loopback hosts, such as localhost and 127.0.0.1, are valid for http URLs, use https instead.", host)
+			}
+		default:
+			errMsg = fmt.Sprintf("invalid URL scheme, %q, only http and https are valid.", parsed.Scheme)
+		}
 	}
 
 	if len(errMsg) > 0 {
@@ -141,10 +178,27 @@ func localHTTPCredProvider(cfg aws.Config, handlers request.Handlers, u string)
 	return httpCredProvider(cfg, handlers, u)
 }
 
+// isLoopbackHost returns if the host is localhost, or a loopback IP address.
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// httpCredProvider returns the provider of the credentials of the endpoint.
+// The credentials request is sent with the authorization token read from the
+// AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE file each time the credentials are
+// retrieved if set, or the AWS_CONTAINER_AUTHORIZATION_TOKEN token otherwise.
 func httpCredProvider(cfg aws.Config, handlers request.Handlers, u string) credentials.Provider {
 	return endpointcreds.NewProviderClient(cfg, handlers, u,
 		func(p *endpointcreds.Provider) {
 			p.ExpiryWindow = 5 * time.Minute
+			p.AuthorizationToken = os.Getenv(httpProviderAuthTokenEnvVar)
+			if path := os.Getenv(httpProviderAuthTokenFileEnvVar); len(path) != 0 {
+				p.AuthorizationTokenProvider = endpointcreds.AuthorizationTokenFile(path)
+			}
 		},
 	)
 }
@@ -2,29 +2,39 @@ package defaults
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
 	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 )
 
 func TestHTTPCredProvider(t *testing.T) {
 	cases := []struct {
-		Host string
+		URL  string
 		Fail bool
 	}{
-		{"localhost", false}, {"127.0.0.1", false},
-		{"www.example.com", true}, {"169.254.170.2", true},
+		{"http://localhost/abc/123", false}, {"http://127.0.0.1/abc/123", false},
+		{"http://127.0.0.2:8080/abc/123", false}, {"http://[::1]/abc/123", false},
+		{"https://www.example.com/abc/123", false}, {"https://169.254.170.2/abc/123", false},
+		{"http://www.example.com/abc/123", true}, {"http://169.254.170.2/abc/123", true},
+		{"ftp://localhost/abc/123", true}, {"/abc/123", true},
 	}
 
 	defer os.Clearenv()
 
 	for i, c := range cases {
-		u := fmt.Sprintf("http://%s/abc/123", c.Host)
+		u := c.URL
 		os.Setenv(httpProviderEnvVar, u)
 
 		provider := RemoteCredProvider(aws.Config{}, request.Handlers{})
@@ -54,6 +64,55 @@ func TestHTTPCredProvider(t *testing.T) {
 	}
 }
 
+func TestHTTPCredProvider_AuthorizationToken(t *testing.T) {
+	dir, err := ioutil.TempDir("", "defaults")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+	tokenFile := filepath.Join(dir, "token")
+	if err := ioutil.WriteFile(tokenFile, []byte("file_token\n"), 0600); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	cases := map[string]struct {
+		Token       string
+		TokenFile   string
+		ExpectToken string
+	}{
+		"none":          {},
+		"env":           {Token: "env_token", ExpectToken: "env_token"},
+		"file":          {TokenFile: tokenFile, ExpectToken: "file_token"},
+		"file over env": {Token: "env_token", TokenFile: tokenFile, ExpectToken: "file_token"},
+	}
+
+	defer os.Clearenv()
+
+	for name, c := range cases {
+		var tokens []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokens = append(tokens, r.Header.Get("Authorization"))
+			fmt.Fprint(w, `{"AccessKeyId":"AKID","SecretAccessKey":"SECRET"}`)
+		}))
+
+		os.Clearenv()
+		os.Setenv(httpProviderEnvVar, server.URL)
+		os.Setenv(httpProviderAuthTokenEnvVar, c.Token)
+		os.Setenv(httpProviderAuthTokenFileEnvVar, c.TokenFile)
+
+		provider := RemoteCredProvider(*Config(), Handlers())
+		_, err := provider.Retrieve()
+		server.Close()
+
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := []string{c.ExpectToken}, tokens; fmt.Sprint(e) != fmt.Sprint(a) {
+			t.Errorf("%s, expect %v authorization tokens, got %v", name, e, a)
+		}
+	}
+}
+
 func TestECSCredProvider(t *testing.T) {
 	defer os.Clearenv()
 	os.Setenv(ecsCredsProviderEnvVar, "/abc/123")
@@ -86,3 +145,93 @@ func TestDefaultEC2RoleProvider(t *testing.T) {
 		t.Errorf("expect %q endpoint, got %q", e, a)
 	}
 }
+
+func TestRemoteCredProvider_BypassProxy(t *testing.T) {
+	defer os.Clearenv()
+
+	var proxied int
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied++
+		http.Error(w, "proxied", http.StatusBadGateway)
+	}))
+	defer proxy.Close()
+	proxyURL, _ := url.Parse(proxy.URL)
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/latest/api/token":
+			fmt.Fprint(w, "token")
+		case "/latest/meta-data/iam/security-credentials":
+			fmt.Fprint(w, "RoleName")
+		case "/latest/meta-data/iam/security-credentials/RoleName":
+			fmt.Fprintf(w, `{"Code":"Success","AccessKeyId":"AKID","SecretAccessKey":"SECRET","Expiration":"%s"}`,
+				time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+		default:
+			fmt.Fprint(w, `{"AccessKeyId":"AKID","SecretAccessKey":"SECRET"}`)
+		}
+	}))
+	defer server.Close()
+
+	// The service clients' requests are sent through the proxy.
+	cfg := Config().
+		WithHTTPClient(&http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}}).
+		WithEndpointResolver(endpoints.ResolverFunc(
+			func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+				return endpoints.ResolvedEndpoint{URL: server.URL + "/latest"}, nil
+			}))
+
+	cases := map[string]map[string]string{
+		"ec2 metadata":          {},
+		"container credentials": {httpProviderEnvVar: server.URL + "/creds"},
+	}
+
+	for name, envs := range cases {
+		os.Clearenv()
+		for k, v := range envs {
+			os.Setenv(k, v)
+		}
+		requests, proxied = 0, 0
+
+		v, err := RemoteCredProvider(*cfg, Handlers()).Retrieve()
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := "AKID", v.AccessKeyID; e != a {
+			t.Errorf("%s, expect %v access key, got %v", name, e, a)
+		}
+		if requests == 0 {
+			t.Errorf("%s, expect requests sent directly to the endpoint", name)
+		}
+		if e, a := 0, proxied; e != a {
+			t.Errorf("%s, expect %v proxied requests, got %v", name, e, a)
+		}
+	}
+
+	resp, err := cfg.HTTPClient.Get(server.URL)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	resp.Body.Close()
+	if e, a := 1, proxied; e != a {
+		t.Errorf("expect %v proxied requests, got %v", e, a)
+	}
+}
+
+func TestRemoteCredProviderWithHTTPClient(t *testing.T) {
+	defer os.Clearenv()
+	os.Setenv(ecsCredsProviderEnvVar, "/abc/123")
+
+	httpClient := &http.Client{Timeout: time.Second}
+	provider := RemoteCredProviderWithHTTPClient(aws.Config{}, request.Handlers{}, httpClient)
+	if e, a := httpClient, provider.(*endpointcreds.Provider).Client.Config.HTTPClient; e != a {
+		t.Errorf("expect %p HTTP client, got %p", e, a)
+	}
+
+	os.Clearenv()
+	provider = RemoteCredProviderWithHTTPClient(aws.Config{}, request.Handlers{}, httpClient)
+	if e, a := httpClient, provider.(*ec2rolecreds.EC2RoleProvider).Client.Config.HTTPClient; e != a {
+		t.Errorf("expect %p HTTP client, got %p", e, a)
+	}
+}
@@ -0,0 +1,33 @@
+package defaults
+
+import (
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// HTTPClient returns a new HTTP client whose transport is created by
+// HTTPTransport with the options.
+func HTTPClient(opts aws.HTTPTransportOptions) *http.Client {
+	return &http.Client{Transport: HTTPTransport(opts)}
+}
+
+// HTTPTransport returns a new HTTP transport with the same configuration as
+// Go's http.DefaultTransport, e.g. its proxy, keep-alive, and HTTP/2
+// support, and the options applied. Zero valued options keep the default
+// transport's values. http.DefaultTransport is not modified.
+func HTTPTransport(opts aws.HTTPTransportOptions) *http.Transport {
+	t := newHTTPTransport(opts)
+
+	if opts.TLSHandshakeTimeout != 0 {
+		t.TLSHandshakeTimeout = opts.TLSHandshakeTimeout
+	}
+	if opts.ResponseHeaderTimeout != 0 {
+		t.ResponseHeaderTimeout = opts.ResponseHeaderTimeout
+	}
+	if opts.MaxIdleConnsPerHost != 0 {
+		t.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+	}
+
+	return t
+}
@@ -0,0 +1,49 @@
+// +build go1.13
+
+package defaults
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// newHTTPTransport returns a clone of http.DefaultTransport, which keeps
+// HTTP/2 enabled when the transport's dialer is replaced, with the dial,
+// expect continue, and idle connection options applied.
+func newHTTPTransport(opts aws.HTTPTransportOptions) *http.Transport {
+	var t *http.Transport
+	if v, ok := http.DefaultTransport.(*http.Transport); ok {
+		t = v.Clone()
+	} else {
+		t = &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		}
+	}
+
+	if opts.DialTimeout != 0 {
+		t.DialContext = (&net.Dialer{
+			Timeout:   opts.DialTimeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext
+	}
+	if opts.ExpectContinueTimeout != 0 {
+		t.ExpectContinueTimeout = opts.ExpectContinueTimeout
+	}
+	if opts.IdleConnTimeout != 0 {
+		t.IdleConnTimeout = opts.IdleConnTimeout
+	}
+
+	return t
+}
@@ -0,0 +1,112 @@
+// +build go1.13
+
+package defaults
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func TestHTTPTransport(t *testing.T) {
+	def := http.DefaultTransport.(*http.Transport)
+
+	cases := map[string]struct {
+		Options                     aws.HTTPTransportOptions
+		ExpectTLSHandshakeTimeout   time.Duration
+		ExpectResponseHeaderTimeout time.Duration
+		ExpectExpectContinueTimeout time.Duration
+		ExpectMaxIdleConnsPerHost   int
+		ExpectIdleConnTimeout       time.Duration
+	}{
+		"defaults": {
+			ExpectTLSHandshakeTimeout:   def.TLSHandshakeTimeout,
+			ExpectResponseHeaderTimeout: def.ResponseHeaderTimeout,
+			ExpectExpectContinueTimeout: def.ExpectContinueTimeout,
+			ExpectMaxIdleConnsPerHost:   def.MaxIdleConnsPerHost,
+			ExpectIdleConnTimeout:       def.IdleConnTimeout,
+		},
+		"options": {
+			Options: aws.HTTPTransportOptions{
+				DialTimeout:           time.Second,
+				TLSHandshakeTimeout:   2 * time.Second,
+				ResponseHeaderTimeout: 3 * time.Second,
+				ExpectContinueTimeout: 4 * time.Second,
+				MaxIdleConnsPerHost:   5,
+				IdleConnTimeout:       6 * time.Second,
+			},
+			ExpectTLSHandshakeTimeout:   2 * time.Second,
+			ExpectResponseHeaderTimeout: 3 * time.Second,
+			ExpectExpectContinueTimeout: 4 * time.Second,
+			ExpectMaxIdleConnsPerHost:   5,
+			ExpectIdleConnTimeout:       6 * time.Second,
+		},
+	}
+
+	for name, c := range cases {
+		tr := HTTPTransport(c.Options)
+		if tr == def {
+			t.Fatalf("%s, expect new transport, got http.DefaultTransport", name)
+		}
+
+		if e, a := c.ExpectTLSHandshakeTimeout, tr.TLSHandshakeTimeout; e != a {
+			t.Errorf("%s, expect %v TLS handshake timeout, got %v", name, e, a)
+		}
+		if e, a := c.ExpectResponseHeaderTimeout, tr.ResponseHeaderTimeout; e != a {
+			t.Errorf("%s, expect %v response header timeout, got %v", name, e, a)
+		}
+		if e, a := c.ExpectExpectContinueTimeout, tr.ExpectContinueTimeout; e != a {
+			t.Errorf("%s, expect %v expect continue timeout, got %v", name, e, a)
+		}
+		if e, a := c.ExpectMaxIdleConnsPerHost, tr.MaxIdleConnsPerHost; e != a {
+			t.Errorf("%s, expect %v max idle conns per host, got %v", name, e, a)
+		}
+		if e, a := c.ExpectIdleConnTimeout, tr.IdleConnTimeout; e != a {
+			t.Errorf("%s, expect %v idle conn timeout, got %v", name, e, a)
+		}
+		if tr.Proxy == nil {
+			t.Errorf("%s, expect proxy to be set", name)
+		}
+		if tr.DialContext == nil {
+			t.Errorf("%s, expect dialer to be set", name)
+		}
+		if !tr.ForceAttemptHTTP2 {
+			t.Errorf("%s, expect HTTP/2 to be enabled", name)
+		}
+	}
+
+	if e, a := 10*time.Second, def.TLSHandshakeTimeout; e != a {
+		t.Errorf("expect http.DefaultTransport not to be modified, got %v TLS handshake timeout", a)
+	}
+}
+
+func TestHTTPClient_HTTP2(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	client := HTTPClient(aws.HTTPTransportOptions{
+		DialTimeout:         5 * time.Second,
+		TLSHandshakeTimeout: 5 * time.Second,
+	})
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	client.Transport.(*http.Transport).TLSClientConfig = &tls.Config{RootCAs: pool}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	if e, a := 2, resp.ProtoMajor; e != a {
+		t.Errorf("expect HTTP/%d, got %v", e, resp.Proto)
+	}
+}
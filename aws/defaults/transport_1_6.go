@@ -0,0 +1,30 @@
+// +build !go1.7
+
+package defaults
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// newHTTPTransport returns a new transport with the configuration of
+// http.DefaultTransport, and the dial option applied. The expect continue
+// and idle connection options are not supported before Go 1.7.
+func newHTTPTransport(opts aws.HTTPTransportOptions) *http.Transport {
+	dialTimeout := 30 * time.Second
+	if opts.DialTimeout != 0 {
+		dialTimeout = opts.DialTimeout
+	}
+
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		Dial: (&net.Dialer{
+			Timeout:   dialTimeout,
+			KeepAlive: 30 * time.Second,
+		}).Dial,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+}
@@ -0,0 +1,43 @@
+// +build go1.7,!go1.13
+
+package defaults
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// newHTTPTransport returns a new transport with the configuration of
+// http.DefaultTransport, and the dial, expect continue, and idle connection
+// options applied. Replacing the transport's DialContext does not disable
+// HTTP/2 before Go 1.13.
+func newHTTPTransport(opts aws.HTTPTransportOptions) *http.Transport {
+	dialTimeout := 30 * time.Second
+	if opts.DialTimeout != 0 {
+		dialTimeout = opts.DialTimeout
+	}
+
+	t := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   dialTimeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+
+	if opts.ExpectContinueTimeout != 0 {
+		t.ExpectContinueTimeout = opts.ExpectContinueTimeout
+	}
+	if opts.IdleConnTimeout != 0 {
+		t.IdleConnTimeout = opts.IdleConnTimeout
+	}
+
+	return t
+}
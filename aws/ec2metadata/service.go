@@ -7,13 +7,13 @@ import (
 	"errors"
 	"io"
 	"net/http"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/client/metadata"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/internal/shareddefaults"
 )
 
 // ServiceName is the name of the service.
@@ -44,18 +44,24 @@ func New(p client.ConfigProvider, cfgs ...*aws.Config) *EC2Metadata {
 // is preferred.
 //
 // If an unmodified HTTP client is provided from the stdlib default, or no client
-// the EC2RoleProvider's EC2Metadata HTTP client's timeout will be shortened.
-// To disable this set Config.EC2MetadataDisableTimeoutOverride to false. Enabled by default.
+// the EC2RoleProvider's EC2Metadata HTTP client's timeout will be shortened,
+// and the requests are not sent through the proxy of the HTTP_PROXY and
+// HTTPS_PROXY environment variables. To disable this set
+// Config.EC2MetadataDisableTimeoutOverride to true. Enabled by default.
+//
+// Requests are sent with an IMDSv2 session token, fetched from the metadata
+// service and cached until it expires. If the token cannot be fetched, such as
+// when IMDSv2 is disabled, or the token request is blocked by the hop limit of
+// containers, requests are sent without token (IMDSv1). Set
+// Config.EC2MetadataV1Disabled, or the AWS_EC2_METADATA_V1_DISABLED environment
+// variable, to true to return an error instead.
 func NewClient(cfg aws.Config, handlers request.Handlers, endpoint, signingRegion string, opts ...func(*client.Client)) *EC2Metadata {
 	if !aws.BoolValue(cfg.EC2MetadataDisableTimeoutOverride) && httpClientZero(cfg.HTTPClient) {
 		// If the http client is unmodified and this feature is not disabled
-		// set custom timeouts for EC2Metadata requests.
-		cfg.HTTPClient = &http.Client{
-			// use a shorter timeout than default because the metadata
-			// service is local if it is running, and to fail faster
-			// if not running on an ec2 instance.
-			Timeout: 5 * time.Second,
-		}
+		// set custom timeouts for EC2Metadata requests. Use a shorter timeout
+		// than default because the metadata service is local if it is
+		// running, and to fail faster if not running on an ec2 instance.
+		cfg.HTTPClient = shareddefaults.MetadataHTTPClient()
 	}
 
 	svc := &EC2Metadata{
@@ -70,6 +76,13 @@ func NewClient(cfg aws.Config, handlers request.Handlers, endpoint, signingRegio
 		),
 	}
 
+	tp := newTokenProvider(svc, cfg)
+	svc.Handlers.Sign.PushBackNamed(request.NamedHandler{
+		Name: "ec2metadata.FetchTokenHandler", Fn: tp.fetchTokenHandler,
+	})
+	svc.Handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: "ec2metadata.RetryUnauthorizedHandler", Fn: tp.retryUnauthorizedHandler,
+	})
 	svc.Handlers.Unmarshal.PushBack(unmarshalHandler)
 	svc.Handlers.UnmarshalError.PushBack(unmarshalError)
 	svc.Handlers.Validate.Clear()
@@ -20,6 +20,14 @@ func TestClientOverrideDefaultHTTPClientTimeout(t *testing.T) {
 	assert.Equal(t, 5*time.Second, svc.Config.HTTPClient.Timeout)
 }
 
+func TestClientOverrideDefaultHTTPClientProxy(t *testing.T) {
+	svc := ec2metadata.New(unit.Session)
+
+	tr, ok := svc.Config.HTTPClient.Transport.(*http.Transport)
+	assert.True(t, ok)
+	assert.Nil(t, tr.Proxy, "expect metadata requests not to be proxied")
+}
+
 func TestClientNotOverrideDefaultHTTPClientTimeout(t *testing.T) {
 	http.DefaultClient.Transport = &http.Transport{}
 	defer func() {
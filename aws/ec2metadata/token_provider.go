@@ -0,0 +1,182 @@
+package ec2metadata
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// tokenHeader is the header the IMDSv2 session token is sent with.
+	tokenHeader = "X-aws-ec2-metadata-token"
+
+	// ttlHeader is the header the TTL of the session token is requested
+	// with, and returned in.
+	ttlHeader = "X-aws-ec2-metadata-token-ttl-seconds"
+
+	// defaultTokenTTL is the TTL of the session tokens requested.
+	defaultTokenTTL = 21600 * time.Second
+
+	// tokenExpiryWindow is how long before the token expires a new token
+	// is fetched.
+	tokenExpiryWindow = 30 * time.Second
+
+	// v1DisabledEnvVar is the environment variable disabling the fallback to
+	// IMDSv1 if Config.EC2MetadataV1Disabled is not set.
+	v1DisabledEnvVar = "AWS_EC2_METADATA_V1_DISABLED"
+
+	getTokenOperationName = "GetToken"
+)
+
+// ErrCodeTokenFetch is the error code of the error returned when the IMDSv2
+// session token fails to be fetched, and falling back to IMDSv1 is disabled.
+const ErrCodeTokenFetch = "EC2MetadataTokenFetchError"
+
+// tokenProvider fetches and caches the IMDSv2 session token the requests of
+// the EC2Metadata client are sent with.
+//
+// If the metadata service does not support IMDSv2, or the token request
+// does not reach the service, the requests are sent without token (IMDSv1)
+// until a request is rejected as unauthorized.
+type tokenProvider struct {
+	client     *EC2Metadata
+	ttl        time.Duration
+	v1Disabled bool
+
+	mu       sync.Mutex
+	token    string
+	expiry   time.Time
+	fallback bool
+}
+
+func newTokenProvider(c *EC2Metadata, cfg aws.Config) *tokenProvider {
+	v1Disabled := aws.BoolValue(cfg.EC2MetadataV1Disabled)
+	if cfg.EC2MetadataV1Disabled == nil {
+		v1Disabled, _ = strconv.ParseBool(os.Getenv(v1DisabledEnvVar))
+	}
+
+	return &tokenProvider{
+		client:     c,
+		ttl:        defaultTokenTTL,
+		v1Disabled: v1Disabled,
+	}
+}
+
+// fetchTokenHandler sets the session token header of the request, fetching
+// a new token if the cached token is missing or about to expire.
+func (t *tokenProvider) fetchTokenHandler(r *request.Request) {
+	if r.Operation.Name == getTokenOperationName {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.fallback {
+		r.HTTPRequest.Header.Del(tokenHeader)
+		return
+	}
+
+	if len(t.token) == 0 || !time.Now().Before(t.expiry) {
+		token, ttl, err := t.client.getToken(r.Context(), t.ttl)
+		if err != nil {
+			t.token = ""
+			if ctxErr := r.Context().Err(); ctxErr != nil {
+				// The token request was canceled with the request, and does
+				// not tell if IMDSv2 is available.
+				r.Error = awserr.New(request.CanceledErrorCode,
+					"request context canceled", ctxErr)
+				return
+			}
+			if t.v1Disabled {
+				r.Error = awserr.New(ErrCodeTokenFetch,
+					"failed to fetch EC2 metadata session token, and IMDSv1 is disabled", err)
+				return
+			}
+
+			// IMDSv2 is disabled, or the token request was blocked, such as
+			// by the hop limit of containers. Use IMDSv1 until a request is
+			// rejected. Other errors only skip the token of this request.
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				switch reqErr.StatusCode() {
+				case 0, http.StatusForbidden, http.StatusNotFound, http.StatusMethodNotAllowed:
+					t.fallback = true
+				}
+			}
+			r.HTTPRequest.Header.Del(tokenHeader)
+			return
+		}
+
+		t.token = token
+		t.expiry = time.Now().Add(ttl - tokenExpiryWindow)
+	}
+
+	r.HTTPRequest.Header.Set(tokenHeader, t.token)
+}
+
+// retryUnauthorizedHandler retries requests rejected as unauthorized with a
+// new session token. The request is rejected if the token expired, or IMDSv1
+// was disabled after falling back.
+func (t *tokenProvider) retryUnauthorizedHandler(r *request.Request) {
+	if r.Operation.Name == getTokenOperationName ||
+		r.HTTPResponse == nil || r.HTTPResponse.StatusCode != http.StatusUnauthorized {
+		return
+	}
+
+	t.mu.Lock()
+	if t.token == r.HTTPRequest.Header.Get(tokenHeader) {
+		t.token = ""
+	}
+	t.fallback = false
+	t.mu.Unlock()
+
+	r.Retryable = aws.Bool(true)
+}
+
+// getToken requests a session token with the TTL, and returns the token and
+// the TTL returned by the service. The request is canceled if the context is
+// canceled.
+func (c *EC2Metadata) getToken(ctx aws.Context, ttl time.Duration) (string, time.Duration, error) {
+	op := &request.Operation{
+		Name:       getTokenOperationName,
+		HTTPMethod: "PUT",
+		HTTPPath:   "/api/token",
+	}
+
+	output := &metadataOutput{}
+	req := c.NewRequest(op, nil, output)
+	req.SetContext(ctx)
+	req.HTTPRequest.Header.Set(ttlHeader, strconv.FormatInt(int64(ttl/time.Second), 10))
+
+	// The token request is not retried, so that clients whose token requests
+	// are blocked fall back to IMDSv1 without waiting for each retry to time
+	// out.
+	req.Retryer = client.DefaultRetryer{NumMaxRetries: 0}
+
+	if err := req.Send(); err != nil {
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			aerr = awserr.New("EC2MetadataError", "failed to fetch EC2 metadata session token", err)
+		}
+		var statusCode int
+		if req.HTTPResponse != nil {
+			statusCode = req.HTTPResponse.StatusCode
+		}
+		return "", 0, awserr.NewRequestFailure(aerr, statusCode, req.RequestID)
+	}
+
+	if v := req.HTTPResponse.Header.Get(ttlHeader); len(v) != 0 {
+		if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+			ttl = time.Duration(secs) * time.Second
+		}
+	}
+
+	return output.Content, ttl, nil
+}
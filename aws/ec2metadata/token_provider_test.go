@@ -0,0 +1,279 @@
+package ec2metadata_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+)
+
+// imdsServer is a fake metadata service serving the meta-data path. The
+// token requests are answered with the status, or are not answered until
+// the server is closed if the status is 0.
+type imdsServer struct {
+	*httptest.Server
+
+	status      int
+	ttl         string
+	blockV1     bool
+	unblockPuts chan struct{}
+
+	mu         sync.Mutex
+	puts       int
+	ttls       []string
+	tokens     []string
+	validToken string
+}
+
+func newIMDSServer(status int) *imdsServer {
+	s := &imdsServer{
+		status:      status,
+		ttl:         "21600",
+		unblockPuts: make(chan struct{}),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *imdsServer) Close() {
+	close(s.unblockPuts)
+	s.Server.Close()
+}
+
+func (s *imdsServer) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "PUT" && r.URL.Path == "/latest/api/token" {
+		s.mu.Lock()
+		s.puts++
+		s.ttls = append(s.ttls, r.Header.Get("X-aws-ec2-metadata-token-ttl-seconds"))
+		s.validToken = fmt.Sprintf("token_%d", s.puts)
+		token := s.validToken
+		s.mu.Unlock()
+
+		switch s.status {
+		case 0:
+			// The response is dropped, as with a hop limit of 1.
+			<-s.unblockPuts
+		case http.StatusOK:
+			w.Header().Set("X-aws-ec2-metadata-token-ttl-seconds", s.ttl)
+			w.Write([]byte(token))
+		default:
+			http.Error(w, "token error", s.status)
+		}
+		return
+	}
+
+	if r.Method != "GET" || r.URL.Path != "/latest/meta-data/some/path" {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	s.mu.Lock()
+	token := r.Header.Get("X-aws-ec2-metadata-token")
+	s.tokens = append(s.tokens, token)
+	valid := token == s.validToken
+	s.mu.Unlock()
+
+	if (len(token) == 0 && s.blockV1) || (len(token) != 0 && !valid) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	w.Write([]byte("success"))
+}
+
+func (s *imdsServer) invalidateToken() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.validToken = ""
+}
+
+func newIMDSClient(s *imdsServer, cfgs ...*aws.Config) *ec2metadata.EC2Metadata {
+	cfg := &aws.Config{
+		Endpoint:   aws.String(s.URL + "/latest"),
+		HTTPClient: &http.Client{Timeout: 200 * time.Millisecond},
+	}
+	return ec2metadata.New(unit.Session, append([]*aws.Config{cfg}, cfgs...)...)
+}
+
+func getMetadata(t *testing.T, c *ec2metadata.EC2Metadata, n int) {
+	for i := 0; i < n; i++ {
+		resp, err := c.GetMetadata("some/path")
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := "success", resp; e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+	}
+}
+
+func TestGetMetadata_SessionToken(t *testing.T) {
+	server := newIMDSServer(http.StatusOK)
+	defer server.Close()
+	c := newIMDSClient(server)
+
+	getMetadata(t, c, 3)
+
+	if e, a := 1, server.puts; e != a {
+		t.Errorf("expect %v token requests, got %v", e, a)
+	}
+	if e, a := "[21600]", fmt.Sprint(server.ttls); e != a {
+		t.Errorf("expect %v token TTLs, got %v", e, a)
+	}
+	if e, a := "[token_1 token_1 token_1]", fmt.Sprint(server.tokens); e != a {
+		t.Errorf("expect %v tokens, got %v", e, a)
+	}
+}
+
+func TestGetMetadata_SessionTokenExpired(t *testing.T) {
+	server := newIMDSServer(http.StatusOK)
+	server.ttl = "1"
+	defer server.Close()
+	c := newIMDSClient(server)
+
+	getMetadata(t, c, 2)
+
+	if e, a := "[token_1 token_2]", fmt.Sprint(server.tokens); e != a {
+		t.Errorf("expect %v tokens, got %v", e, a)
+	}
+}
+
+func TestGetMetadata_SessionTokenUnauthorized(t *testing.T) {
+	server := newIMDSServer(http.StatusOK)
+	defer server.Close()
+	c := newIMDSClient(server)
+
+	getMetadata(t, c, 1)
+	server.invalidateToken()
+	getMetadata(t, c, 1)
+
+	if e, a := 2, server.puts; e != a {
+		t.Errorf("expect %v token requests, got %v", e, a)
+	}
+	if e, a := "[token_1 token_1 token_2]", fmt.Sprint(server.tokens); e != a {
+		t.Errorf("expect %v tokens, got %v", e, a)
+	}
+}
+
+func TestGetMetadata_FallbackToV1(t *testing.T) {
+	cases := map[string]int{
+		"forbidden":          http.StatusForbidden,
+		"not found":          http.StatusNotFound,
+		"method not allowed": http.StatusMethodNotAllowed,
+		"hop limit":          0,
+	}
+
+	for name, status := range cases {
+		server := newIMDSServer(status)
+		c := newIMDSClient(server)
+
+		getMetadata(t, c, 2)
+
+		if e, a := 1, server.puts; e != a {
+			t.Errorf("%s, expect %v token requests, got %v", name, e, a)
+		}
+		if e, a := "[ ]", fmt.Sprint(server.tokens); e != a {
+			t.Errorf("%s, expect %v tokens, got %v", name, e, a)
+		}
+		server.Close()
+	}
+}
+
+func TestGetMetadata_FallbackToV1Unauthorized(t *testing.T) {
+	server := newIMDSServer(http.StatusNotFound)
+	defer server.Close()
+	c := newIMDSClient(server)
+
+	getMetadata(t, c, 1)
+
+	// IMDSv2 is enabled, and IMDSv1 disabled, after falling back.
+	server.status = http.StatusOK
+	server.blockV1 = true
+	getMetadata(t, c, 1)
+
+	if e, a := 2, server.puts; e != a {
+		t.Errorf("expect %v token requests, got %v", e, a)
+	}
+	if e, a := "[  token_2]", fmt.Sprint(server.tokens); e != a {
+		t.Errorf("expect %v tokens, got %v", e, a)
+	}
+}
+
+func TestGetMetadataWithContext_Canceled(t *testing.T) {
+	server := newIMDSServer(0)
+	defer server.Close()
+	c := newIMDSClient(server, &aws.Config{HTTPClient: &http.Client{Timeout: 5 * time.Second}})
+
+	ctx := &awstesting.FakeContext{DoneCh: make(chan struct{})}
+	time.AfterFunc(100*time.Millisecond, func() {
+		ctx.Error = fmt.Errorf("context canceled")
+		close(ctx.DoneCh)
+	})
+
+	start := time.Now()
+	_, err := c.GetMetadataWithContext(ctx, "some/path")
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T, %v", err, err)
+	}
+	if e, a := request.CanceledErrorCode, aerr.Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if d := time.Since(start); d > 2*time.Second {
+		t.Errorf("expect canceled request to return promptly, took %v", d)
+	}
+
+	// The canceled token request does not fall back to IMDSv1.
+	server.status = http.StatusOK
+	getMetadata(t, c, 1)
+	if e, a := "[token_2]", fmt.Sprint(server.tokens); e != a {
+		t.Errorf("expect %v tokens, got %v", e, a)
+	}
+}
+
+func TestGetMetadata_V1Disabled(t *testing.T) {
+	env := os.Getenv("AWS_EC2_METADATA_V1_DISABLED")
+	defer os.Setenv("AWS_EC2_METADATA_V1_DISABLED", env)
+
+	cases := map[string]struct {
+		Env    string
+		Config *aws.Config
+	}{
+		"config": {
+			Config: aws.NewConfig().WithEC2MetadataV1Disabled(true),
+		},
+		"environment": {
+			Env:    "true",
+			Config: aws.NewConfig(),
+		},
+	}
+
+	for name, c := range cases {
+		os.Setenv("AWS_EC2_METADATA_V1_DISABLED", c.Env)
+		server := newIMDSServer(0)
+		client := newIMDSClient(server, c.Config)
+
+		_, err := client.GetMetadata("some/path")
+		server.Close()
+
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			t.Fatalf("%s, expect awserr.Error, got %T, %v", name, err, err)
+		}
+		if e, a := ec2metadata.ErrCodeTokenFetch, aerr.Code(); e != a {
+			t.Errorf("%s, expect %v error code, got %v", name, e, a)
+		}
+		if e, a := 0, len(server.tokens); e != a {
+			t.Errorf("%s, expect %v metadata requests, got %v", name, e, a)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package endpointdiscovery
+
+import (
+	"sync"
+	"time"
+)
+
+// An Endpoint is an endpoint discovered for an API operation, and the period
+// of time the endpoint may be cached for.
+type Endpoint struct {
+	// The endpoint's address. Either a URL, or a host name which will use
+	// the scheme of the client's endpoint.
+	Address string
+
+	// The period the endpoint may be cached for. The endpoint is discovered
+	// again once the period expires.
+	CachePeriod time.Duration
+}
+
+type cacheEntry struct {
+	endpoint Endpoint
+	expires  time.Time
+}
+
+// A Cache caches the endpoints discovered for a service client's API
+// operations. Endpoints are cached by a key identifying the credentials and
+// operation the endpoint was discovered for, until their cache period
+// expires.
+//
+// A Cache is safe to use concurrently.
+type Cache struct {
+	mu       sync.Mutex
+	entries  map[string]cacheEntry
+	inflight map[string]struct{}
+
+	// Returns the current time, for testing.
+	now func() time.Time
+}
+
+// NewCache returns an empty Cache.
+func NewCache() *Cache {
+	return &Cache{
+		entries:  map[string]cacheEntry{},
+		inflight: map[string]struct{}{},
+		now:      time.Now,
+	}
+}
+
+// Get returns the endpoint cached for the key, and if an endpoint was found
+// which has not expired. Expired endpoints are removed from the cache.
+func (c *Cache) Get(key string) (Endpoint, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return Endpoint{}, false
+	}
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		return Endpoint{}, false
+	}
+
+	return entry.endpoint, true
+}
+
+// Add caches the endpoint for the key, replacing any endpoint already
+// cached for the key.
+func (c *Cache) Add(key string, endpoint Endpoint) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = cacheEntry{
+		endpoint: endpoint,
+		expires:  c.now().Add(endpoint.CachePeriod),
+	}
+}
+
+// Delete evicts the endpoint cached for the key.
+func (c *Cache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// Len returns the number of endpoints cached, including expired endpoints
+// which have not yet been removed.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// startDiscovery marks a discovery for the key as in flight, returning false
+// if a discovery for the key is already in flight.
+func (c *Cache) startDiscovery(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.inflight[key]; ok {
+		return false
+	}
+	c.inflight[key] = struct{}{}
+
+	return true
+}
+
+// endDiscovery marks the discovery for the key as no longer in flight.
+func (c *Cache) endDiscovery(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.inflight, key)
+}
@@ -0,0 +1,58 @@
+package endpointdiscovery
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Now()
+	c := NewCache()
+	c.now = func() time.Time { return now }
+
+	if _, ok := c.Get("key"); ok {
+		t.Errorf("expect no endpoint cached")
+	}
+
+	c.Add("key", Endpoint{Address: "endpoint", CachePeriod: time.Minute})
+	endpoint, ok := c.Get("key")
+	if !ok {
+		t.Fatalf("expect endpoint cached")
+	}
+	if e, a := "endpoint", endpoint.Address; e != a {
+		t.Errorf("expect %q address, got %q", e, a)
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := c.Get("key"); ok {
+		t.Errorf("expect expired endpoint not to be returned")
+	}
+	if e, a := 0, c.Len(); e != a {
+		t.Errorf("expect expired endpoint to be removed, got %d cached", a)
+	}
+
+	c.Add("key", Endpoint{Address: "endpoint", CachePeriod: time.Minute})
+	c.Delete("key")
+	if _, ok := c.Get("key"); ok {
+		t.Errorf("expect deleted endpoint not to be returned")
+	}
+}
+
+func TestCache_Discovery(t *testing.T) {
+	c := NewCache()
+
+	if !c.startDiscovery("key") {
+		t.Fatalf("expect discovery to start")
+	}
+	if c.startDiscovery("key") {
+		t.Errorf("expect discovery not to start while in flight")
+	}
+	if !c.startDiscovery("other") {
+		t.Errorf("expect discovery of other key to start")
+	}
+
+	c.endDiscovery("key")
+	if !c.startDiscovery("key") {
+		t.Errorf("expect discovery to start after previous discovery ended")
+	}
+}
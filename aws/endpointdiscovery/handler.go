@@ -0,0 +1,255 @@
+// Package endpointdiscovery provides discovery, and caching of the endpoints
+// service APIs expect requests for their operations to be sent to.
+//
+// Services supporting endpoint discovery provide an operation, such as
+// DescribeEndpoints, returning the endpoint other operations should be sent
+// to, and how long the endpoint may be cached for. Discovery is optional for
+// most operations, and only performed if aws.Config.EnableEndpointDiscovery is
+// set. Operations which require discovery always discover their endpoint.
+package endpointdiscovery
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// ErrCodeDiscoverEndpoint is the error code of the error a request fails
+	// with when its operation requires endpoint discovery, and the endpoint
+	// could not be discovered.
+	ErrCodeDiscoverEndpoint = "DiscoverEndpointError"
+
+	// ErrCodeInvalidEndpoint is the error code services return when a
+	// request is sent to an endpoint which is no longer valid for the
+	// operation. The endpoint is evicted from the cache.
+	ErrCodeInvalidEndpoint = "InvalidEndpointException"
+)
+
+// statusMisdirectedRequest is the HTTP status code of responses to requests
+// sent to an endpoint which cannot respond to them.
+const statusMisdirectedRequest = 421
+
+// BuildHandlerName is the name of the request handler discovering the
+// endpoint of a request.
+const BuildHandlerName = "awssdk.endpointdiscovery.Build"
+
+// EvictHandlerName is the name of the request handler evicting a request's
+// discovered endpoint when the request fails because the endpoint is no
+// longer valid.
+const EvictHandlerName = "awssdk.endpointdiscovery.Evict"
+
+// A Handler discovers the endpoint of the requests of an API operation, and
+// caches the discovered endpoints.
+//
+// Endpoints are discovered lazily. If the operation requires endpoint
+// discovery, a request with no endpoint cached is blocked until the endpoint
+// is discovered. Otherwise the request is sent to the client's endpoint, and
+// the endpoint is discovered in the background for subsequent requests.
+//
+// If a request sent to a discovered endpoint fails with the 421 HTTP status
+// code, or the InvalidEndpointException error code, the endpoint is evicted
+// from the cache, and the request is retried.
+type Handler struct {
+	// The cache of discovered endpoints. Should be shared by all of a
+	// service client's requests.
+	Cache *Cache
+
+	// Discover discovers the endpoint of the request's operation with the
+	// context, e.g. by sending a DescribeEndpoints request with the
+	// request's Config.
+	//
+	// The request passed to Discover is a copy of the request's Config,
+	// ClientInfo, Operation, and Params. It must not be modified.
+	Discover func(ctx aws.Context, r *request.Request) (Endpoint, error)
+
+	// Set if the operation requires endpoint discovery. Requests will fail
+	// if their endpoint cannot be discovered.
+	Required bool
+
+	// Key returns the key of the request's discovered endpoint in the cache.
+	// Defaults to the Key function.
+	Key func(r *request.Request) string
+}
+
+// Key returns the cache key of the request's endpoint, identifying the
+// request's credentials and operation.
+func Key(r *request.Request) string {
+	var accessKeyID string
+	if r.Config.Credentials != nil {
+		if v, err := r.Config.Credentials.GetWithContext(r.Context()); err == nil {
+			accessKeyID = v.AccessKeyID
+		}
+	}
+
+	return accessKeyID + "." + r.Operation.Name
+}
+
+// AddToHandlers adds the handler to the request handlers.
+func (h *Handler) AddToHandlers(handlers *request.Handlers) {
+	handlers.Build.PushFrontNamed(request.NamedHandler{
+		Name: BuildHandlerName,
+		Fn:   h.Build,
+	})
+}
+
+// Build is a request handler which sets the request's endpoint to its
+// operation's discovered endpoint.
+func (h *Handler) Build(r *request.Request) {
+	if !h.Required {
+		if !aws.BoolValue(r.Config.EnableEndpointDiscovery) {
+			return
+		}
+		// Discovered endpoints are not used by clients with a custom
+		// endpoint.
+		if len(aws.StringValue(r.Config.Endpoint)) != 0 {
+			return
+		}
+	}
+
+	key := h.key(r)
+	endpoint, ok := h.Cache.Get(key)
+	if !ok {
+		if !h.Required {
+			h.discoverAsync(r, key)
+			return
+		}
+
+		var err error
+		if endpoint, err = h.discover(r.Context(), discoverRequest(r), key); err != nil {
+			r.Error = awserr.New(ErrCodeDiscoverEndpoint,
+				"failed to discover endpoint", err)
+			return
+		}
+	}
+
+	origEndpoint, origURL := r.ClientInfo.Endpoint, *r.HTTPRequest.URL
+	if err := setEndpoint(r, endpoint); err != nil {
+		r.Error = err
+		return
+	}
+
+	discovered := true
+	r.Handlers.Retry.PushFrontNamed(request.NamedHandler{
+		Name: EvictHandlerName,
+		Fn: func(r *request.Request) {
+			if discovered && isInvalidEndpoint(r) {
+				discovered = h.evict(r, key, origEndpoint, &origURL)
+			}
+		},
+	})
+}
+
+// evict removes the request's endpoint from the cache, and resets the
+// request's endpoint to the client's endpoint. Requests of operations which
+// require endpoint discovery are sent to a newly discovered endpoint. Returns
+// if the request's endpoint is a discovered endpoint.
+func (h *Handler) evict(r *request.Request, key, origEndpoint string, origURL *url.URL) bool {
+
+	h.Cache.Delete(key)
+	r.ClientInfo.Endpoint = origEndpoint
+	r.HTTPRequest.URL.Scheme = origURL.Scheme
+	r.HTTPRequest.URL.Host = origURL.Host
+
+	if h.Required {
+		endpoint, err := h.discover(r.Context(), discoverRequest(r), key)
+		if err == nil {
+			err = setEndpoint(r, endpoint)
+		}
+		if err != nil {
+			r.Error = awserr.New(ErrCodeDiscoverEndpoint,
+				"failed to discover endpoint", err)
+			r.Retryable = aws.Bool(false)
+			return false
+		}
+	}
+
+	r.Retryable = aws.Bool(true)
+	return h.Required
+}
+
+func (h *Handler) key(r *request.Request) string {
+	if h.Key != nil {
+		return h.Key(r)
+	}
+	return Key(r)
+}
+
+// discoverRequest returns the request passed to Discover for the request.
+func discoverRequest(r *request.Request) *request.Request {
+	return &request.Request{
+		Config:     r.Config,
+		ClientInfo: r.ClientInfo,
+		Operation:  r.Operation,
+		Params:     r.Params,
+	}
+}
+
+// discover discovers the endpoint, and adds it to the cache.
+func (h *Handler) discover(ctx aws.Context, r *request.Request, key string) (Endpoint, error) {
+	endpoint, err := h.Discover(ctx, r)
+	if err != nil {
+		return Endpoint{}, err
+	}
+	if len(endpoint.Address) == 0 {
+		return Endpoint{}, awserr.New(ErrCodeDiscoverEndpoint,
+			"no endpoint address discovered", nil)
+	}
+
+	h.Cache.Add(key, endpoint)
+	return endpoint, nil
+}
+
+// discoverAsync discovers the endpoint in the background, unless the
+// endpoint is already being discovered.
+func (h *Handler) discoverAsync(r *request.Request, key string) {
+	if !h.Cache.startDiscovery(key) {
+		return
+	}
+
+	dr := discoverRequest(r)
+	go func() {
+		defer h.Cache.endDiscovery(key)
+
+		if _, err := h.discover(aws.BackgroundContext(), dr, key); err != nil {
+			if dr.Config.LogLevel.Matches(aws.LogDebugWithRequestErrors) {
+				dr.Config.Logger.Log(
+					"DEBUG: failed to discover endpoint for operation",
+					dr.Operation.Name, err)
+			}
+		}
+	}()
+}
+
+// setEndpoint sets the request's endpoint to the discovered endpoint.
+func setEndpoint(r *request.Request, endpoint Endpoint) error {
+	address := endpoint.Address
+	if !strings.Contains(address, "://") {
+		address = r.HTTPRequest.URL.Scheme + "://" + address
+	}
+
+	u, err := url.Parse(address)
+	if err != nil || len(u.Host) == 0 {
+		return awserr.New(ErrCodeDiscoverEndpoint,
+			"invalid discovered endpoint address, "+endpoint.Address, err)
+	}
+
+	r.ClientInfo.Endpoint = u.Scheme + "://" + u.Host
+	r.HTTPRequest.URL.Scheme = u.Scheme
+	r.HTTPRequest.URL.Host = u.Host
+
+	return nil
+}
+
+func isInvalidEndpoint(r *request.Request) bool {
+	if r.HTTPResponse != nil && r.HTTPResponse.StatusCode == statusMisdirectedRequest {
+		return true
+	}
+	if aerr, ok := r.Error.(awserr.Error); ok {
+		return aerr.Code() == ErrCodeInvalidEndpoint
+	}
+	return false
+}
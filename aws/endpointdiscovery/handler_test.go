@@ -0,0 +1,331 @@
+package endpointdiscovery
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+type testServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests int
+	// Status codes responded with to the first requests.
+	statusCodes []int
+}
+
+func newTestServer(statusCodes ...int) *testServer {
+	s := &testServer{statusCodes: statusCodes}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		n := s.requests
+		s.requests++
+		s.mu.Unlock()
+
+		if n < len(s.statusCodes) {
+			w.WriteHeader(s.statusCodes[n])
+		}
+	}))
+
+	return s
+}
+
+func (s *testServer) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+// fakeDiscoverer fakes a DescribeEndpoints operation returning the address.
+type fakeDiscoverer struct {
+	mu        sync.Mutex
+	address   string
+	err       error
+	calls     int
+	operation string
+}
+
+func (d *fakeDiscoverer) Discover(ctx aws.Context, r *request.Request) (Endpoint, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.calls++
+	d.operation = r.Operation.Name
+	if d.err != nil {
+		return Endpoint{}, d.err
+	}
+	return Endpoint{Address: d.address, CachePeriod: time.Minute}, nil
+}
+
+func (d *fakeDiscoverer) Calls() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.calls
+}
+
+func newTestClient(endpoint string, cfg *aws.Config) *client.Client {
+	def := defaults.Get()
+	def.Config.MergeIn(&aws.Config{
+		Region:      aws.String("mock-region"),
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		SleepDelay:  func(time.Duration) {},
+	}, cfg)
+
+	return client.New(*def.Config, metadata.ClientInfo{
+		ServiceName: "mock",
+		Endpoint:    endpoint,
+	}, def.Handlers)
+}
+
+func sendTestRequest(svc *client.Client, h *Handler) error {
+	r := svc.NewRequest(&request.Operation{
+		Name:       "Operation",
+		HTTPMethod: "GET",
+		HTTPPath:   "/",
+	}, nil, nil)
+	h.AddToHandlers(&r.Handlers)
+
+	return r.Send()
+}
+
+func TestHandler_Required(t *testing.T) {
+	clientServer := newTestServer()
+	defer clientServer.Close()
+	discoveredServer := newTestServer()
+	defer discoveredServer.Close()
+
+	d := &fakeDiscoverer{address: discoveredServer.URL}
+	h := &Handler{Cache: NewCache(), Discover: d.Discover, Required: true}
+	svc := newTestClient(clientServer.URL, &aws.Config{})
+
+	for i := 0; i < 2; i++ {
+		if err := sendTestRequest(svc, h); err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+	}
+
+	if e, a := 1, d.Calls(); e != a {
+		t.Errorf("expect %d discovery, got %d", e, a)
+	}
+	if e, a := "Operation", d.operation; e != a {
+		t.Errorf("expect %q operation discovered, got %q", e, a)
+	}
+	if e, a := 0, clientServer.Requests(); e != a {
+		t.Errorf("expect %d client endpoint requests, got %d", e, a)
+	}
+	if e, a := 2, discoveredServer.Requests(); e != a {
+		t.Errorf("expect %d discovered endpoint requests, got %d", e, a)
+	}
+}
+
+func TestHandler_RequiredDiscoveryError(t *testing.T) {
+	clientServer := newTestServer()
+	defer clientServer.Close()
+
+	d := &fakeDiscoverer{err: fmt.Errorf("discovery failed")}
+	h := &Handler{Cache: NewCache(), Discover: d.Discover, Required: true}
+	svc := newTestClient(clientServer.URL, &aws.Config{})
+
+	err := sendTestRequest(svc, h)
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T, %v", err, err)
+	}
+	if e, a := ErrCodeDiscoverEndpoint, aerr.Code(); e != a {
+		t.Errorf("expect %q error code, got %q", e, a)
+	}
+	if e, a := d.err, aerr.OrigErr(); e != a {
+		t.Errorf("expect %v original error, got %v", e, a)
+	}
+	if e, a := 0, clientServer.Requests(); e != a {
+		t.Errorf("expect %d client endpoint requests, got %d", e, a)
+	}
+}
+
+func TestHandler_Optional(t *testing.T) {
+	cases := map[string]struct {
+		Config          *aws.Config
+		ExpectDiscovery bool
+	}{
+		"disabled": {
+			Config: &aws.Config{},
+		},
+		"enabled": {
+			Config:          aws.NewConfig().WithEndpointDiscovery(true),
+			ExpectDiscovery: true,
+		},
+		"custom endpoint": {
+			Config: aws.NewConfig().WithEndpointDiscovery(true).
+				WithEndpoint("http://custom.endpoint"),
+		},
+	}
+
+	for name, c := range cases {
+		clientServer := newTestServer()
+		discoveredServer := newTestServer()
+
+		d := &fakeDiscoverer{address: discoveredServer.URL}
+		h := &Handler{Cache: NewCache(), Discover: d.Discover}
+		svc := newTestClient(clientServer.URL, c.Config)
+
+		// The first request is sent to the client's endpoint while the
+		// endpoint is discovered in the background.
+		if err := sendTestRequest(svc, h); err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := 1, clientServer.Requests(); e != a {
+			t.Errorf("%s, expect %d client endpoint requests, got %d", name, e, a)
+		}
+
+		if c.ExpectDiscovery {
+			for i := 0; h.Cache.Len() == 0; i++ {
+				if i == 100 {
+					t.Fatalf("%s, expect endpoint to be discovered", name)
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+			if err := sendTestRequest(svc, h); err != nil {
+				t.Fatalf("%s, expect no error, got %v", name, err)
+			}
+			if e, a := 1, discoveredServer.Requests(); e != a {
+				t.Errorf("%s, expect %d discovered endpoint requests, got %d", name, e, a)
+			}
+		} else if e, a := 0, d.Calls(); e != a {
+			t.Errorf("%s, expect %d discoveries, got %d", name, e, a)
+		}
+
+		clientServer.Close()
+		discoveredServer.Close()
+	}
+}
+
+func TestHandler_Evict(t *testing.T) {
+	cases := map[string]struct {
+		Required              bool
+		StatusCode            int
+		ExpectEvicted         bool
+		ExpectDiscoveries     int
+		ExpectClientRequests  int
+		ExpectDiscoveredCalls int
+	}{
+		"required misdirected": {
+			Required:              true,
+			StatusCode:            421,
+			ExpectEvicted:         true,
+			ExpectDiscoveries:     2,
+			ExpectDiscoveredCalls: 2,
+		},
+		"optional misdirected": {
+			StatusCode:            421,
+			ExpectEvicted:         true,
+			ExpectDiscoveries:     1,
+			ExpectClientRequests:  1,
+			ExpectDiscoveredCalls: 1,
+		},
+		"required server error": {
+			Required:              true,
+			StatusCode:            500,
+			ExpectDiscoveries:     1,
+			ExpectDiscoveredCalls: 2,
+		},
+	}
+
+	for name, c := range cases {
+		clientServer := newTestServer()
+		discoveredServer := newTestServer(c.StatusCode)
+
+		d := &fakeDiscoverer{address: discoveredServer.URL}
+		h := &Handler{Cache: NewCache(), Discover: d.Discover, Required: c.Required}
+		h.Cache.Add(Key(&request.Request{
+			Config: aws.Config{
+				Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+			},
+			Operation: &request.Operation{Name: "Operation"},
+		}), Endpoint{Address: discoveredServer.URL, CachePeriod: time.Minute})
+		d.calls = 1
+
+		svc := newTestClient(clientServer.URL, aws.NewConfig().WithEndpointDiscovery(true))
+		if err := sendTestRequest(svc, h); err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if e, a := c.ExpectDiscoveries, d.Calls(); e != a {
+			t.Errorf("%s, expect %d discoveries, got %d", name, e, a)
+		}
+		if e, a := c.ExpectClientRequests, clientServer.Requests(); e != a {
+			t.Errorf("%s, expect %d client endpoint requests, got %d", name, e, a)
+		}
+		if e, a := c.ExpectDiscoveredCalls, discoveredServer.Requests(); e != a {
+			t.Errorf("%s, expect %d discovered endpoint requests, got %d", name, e, a)
+		}
+		_, cached := h.Cache.Get("AKID.Operation")
+		if e, a := !c.ExpectEvicted || c.Required, cached; e != a {
+			t.Errorf("%s, expect cached %t, got %t", name, e, a)
+		}
+
+		clientServer.Close()
+		discoveredServer.Close()
+	}
+}
+
+func TestSetEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		Address   string
+		ExpectURL string
+		ExpectErr bool
+	}{
+		"host": {
+			Address:   "discovered.amazonaws.com",
+			ExpectURL: "https://discovered.amazonaws.com/path?query=value",
+		},
+		"url": {
+			Address:   "http://discovered.amazonaws.com",
+			ExpectURL: "http://discovered.amazonaws.com/path?query=value",
+		},
+		"invalid": {
+			Address:   "://",
+			ExpectErr: true,
+		},
+	}
+
+	for name, c := range cases {
+		svc := newTestClient("https://service.amazonaws.com", &aws.Config{})
+		r := svc.NewRequest(&request.Operation{
+			Name:       "Operation",
+			HTTPMethod: "GET",
+			HTTPPath:   "/path?query=value",
+		}, nil, nil)
+
+		err := setEndpoint(r, Endpoint{Address: c.Address})
+		if c.ExpectErr {
+			if err == nil {
+				t.Errorf("%s, expect error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := c.ExpectURL, r.HTTPRequest.URL.String(); e != a {
+			t.Errorf("%s, expect %q URL, got %q", name, e, a)
+		}
+		if !strings.HasPrefix(c.ExpectURL, r.ClientInfo.Endpoint) {
+			t.Errorf("%s, expect client endpoint to be updated, got %q", name, r.ClientInfo.Endpoint)
+		}
+	}
+}
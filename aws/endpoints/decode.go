@@ -83,6 +83,7 @@ func decodeV3Endpoints(modelDef modelDefinition, opts DecodeModelOptions) (Resol
 		p := &ps[i]
 		custAddEC2Metadata(p)
 		custAddS3DualStack(p)
+		custAddAPIDualStack(p)
 		custRmIotDataService(p)
 	}
 
@@ -94,15 +95,29 @@ func custAddS3DualStack(p *partition) {
 		return
 	}
 
-	s, ok := p.Services["s3"]
+	custAddDualStack(p, "s3", "{service}.dualstack.{region}.{dnsSuffix}")
+}
+
+func custAddAPIDualStack(p *partition) {
+	if p.ID != "aws" {
+		return
+	}
+
+	for _, name := range []string{"ec2", "lambda", "sqs"} {
+		custAddDualStack(p, name, "{service}.{region}.api.aws")
+	}
+}
+
+func custAddDualStack(p *partition, svcName, hostname string) {
+	s, ok := p.Services[svcName]
 	if !ok {
 		return
 	}
 
 	s.Defaults.HasDualStack = boxedTrue
-	s.Defaults.DualStackHostname = "{service}.dualstack.{region}.{dnsSuffix}"
+	s.Defaults.DualStackHostname = hostname
 
-	p.Services["s3"] = s
+	p.Services[svcName] = s
 }
 
 func custAddEC2Metadata(p *partition) {
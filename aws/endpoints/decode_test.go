@@ -35,6 +35,11 @@ func TestDecodeEndpoints_V3(t *testing.T) {
              "ap-northeast-1": {}
     	  }
         },
+        "ec2": {
+          "endpoints": {
+             "ap-northeast-1": {}
+    	  }
+        },
         "s3": {
           "endpoints": {
              "ap-northeast-1": {}
@@ -69,6 +74,14 @@ func TestDecodeEndpoints_V3(t *testing.T) {
 		t.Errorf("expect s3 dualstack host pattern to be %q, got %q", e, a)
 	}
 
+	ec2Defaults := p.Services["ec2"].Defaults
+	if a, e := ec2Defaults.HasDualStack, boxedTrue; a != e {
+		t.Errorf("expect ec2 service to have dualstack enabled")
+	}
+	if a, e := ec2Defaults.DualStackHostname, "{service}.{region}.api.aws"; a != e {
+		t.Errorf("expect ec2 dualstack host pattern to be %q, got %q", e, a)
+	}
+
 	ec2metaEndpoint := p.Services["ec2metadata"].Endpoints["aws-global"]
 	if a, e := ec2metaEndpoint.Hostname, "169.254.169.254/latest"; a != e {
 		t.Errorf("expect ec2metadata host to be %q, got %q", e, a)
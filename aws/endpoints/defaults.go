@@ -744,6 +744,9 @@ var awsPartition = partition{
 		"ec2": service{
 			Defaults: endpoint{
 				Protocols: []string{"http", "https"},
+
+				HasDualStack:      boxedTrue,
+				DualStackHostname: "{service}.{region}.api.aws",
 			},
 			Endpoints: endpoints{
 				"ap-northeast-1": endpoint{},
@@ -1153,7 +1156,11 @@ var awsPartition = partition{
 			},
 		},
 		"lambda": service{
+			Defaults: endpoint{
 
+				HasDualStack:      boxedTrue,
+				DualStackHostname: "{service}.{region}.api.aws",
+			},
 			Endpoints: endpoints{
 				"ap-northeast-1": endpoint{},
 				"ap-northeast-2": endpoint{},
@@ -1586,6 +1593,9 @@ var awsPartition = partition{
 			Defaults: endpoint{
 				SSLCommonName: "{region}.queue.{dnsSuffix}",
 				Protocols:     []string{"http", "https"},
+
+				HasDualStack:      boxedTrue,
+				DualStackHostname: "{service}.{region}.api.aws",
 			},
 			Endpoints: endpoints{
 				"ap-northeast-1": endpoint{},
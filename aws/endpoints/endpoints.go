@@ -3,6 +3,8 @@ package endpoints
 import (
 	"fmt"
 	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
@@ -20,8 +22,30 @@ type Options struct {
 	// be returned. This endpoint may not be valid. If StrictMatching is
 	// enabled only services that are known to support dualstack will return
 	// dualstack endpoints.
+	//
+	// UseDualStackEndpoint is preferred. UseDualStack resolves the same
+	// dualstack endpoints, but falls back to the IPv4 only endpoint of
+	// services without a modeled dualstack endpoint.
 	UseDualStack bool
 
+	// Sets the resolver to resolve the dualstack endpoint of the service,
+	// supporting both IPv4 and IPv6, such as ec2.us-west-2.api.aws. If the
+	// service does not have a dualstack endpoint modeled for the region an
+	// EndpointNotFoundError error is returned, instead of resolving the IPv4
+	// only endpoint.
+	//
+	// If UseFIPSEndpoint is also set, the FIPS endpoint of the region must
+	// model its own dualstack endpoint.
+	UseDualStackEndpoint bool
+
+	// Sets the resolver to resolve the FIPS endpoint of the service. The
+	// FIPS endpoints are modeled as the endpoints of the service whose ID is
+	// the region with a "-fips" suffix, or "fips-" prefix, such as
+	// "us-east-1-fips". If the service has no FIPS endpoint for the region an
+	// UnknownFIPSEndpointError error is returned, instead of resolving the
+	// non-FIPS endpoint. UseDualStack is ignored when resolving FIPS endpoints.
+	UseFIPSEndpoint bool
+
 	// Enables strict matching of services and regions resolved endpoints.
 	// If the partition doesn't enumerate the exact service and region an
 	// error will be returned. This option will prevent returning endpoints
@@ -46,6 +70,120 @@ type Options struct {
 	//
 	// This option is ignored if StrictMatching is enabled.
 	ResolveUnknownService bool
+
+	// STSRegionalEndpoint selects the STS endpoint of regions whose STS
+	// requests are sent to the global endpoint, sts.amazonaws.com, by
+	// default. If set to RegionalSTSEndpoint the STS endpoint of the region
+	// is resolved instead, e.g. sts.us-west-2.amazonaws.com.
+	//
+	// The global endpoint is resolved if not set, or set to
+	// LegacySTSEndpoint.
+	STSRegionalEndpoint STSRegionalEndpoint
+
+	// S3UsEast1RegionalEndpoint selects the S3 endpoint of the us-east-1
+	// region, whose S3 requests are sent to the global endpoint,
+	// s3.amazonaws.com, by default. If set to RegionalS3UsEast1Endpoint the
+	// regional endpoint, s3.us-east-1.amazonaws.com, is resolved instead.
+	//
+	// The global endpoint is resolved if not set, or set to
+	// LegacyS3UsEast1Endpoint.
+	S3UsEast1RegionalEndpoint S3UsEast1RegionalEndpoint
+}
+
+// STSRegionalEndpoint is an enum of the STS endpoint resolution modes of
+// the STSRegionalEndpoint option.
+type STSRegionalEndpoint int
+
+func (e STSRegionalEndpoint) String() string {
+	switch e {
+	case LegacySTSEndpoint:
+		return "legacy"
+	case RegionalSTSEndpoint:
+		return "regional"
+	case UnsetSTSEndpoint:
+		return ""
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	// UnsetSTSEndpoint represents that the STS endpoint resolution mode is
+	// not set, and LegacySTSEndpoint is used.
+	UnsetSTSEndpoint STSRegionalEndpoint = iota
+
+	// LegacySTSEndpoint resolves the global STS endpoint for the regions
+	// whose STS endpoint is the global endpoint by default.
+	LegacySTSEndpoint
+
+	// RegionalSTSEndpoint resolves the STS endpoint of the region.
+	RegionalSTSEndpoint
+)
+
+// GetSTSRegionalEndpoint returns the STSRegionalEndpoint of the value of the
+// sts_regional_endpoints shared config key, or AWS_STS_REGIONAL_ENDPOINTS
+// environment variable, "legacy" or "regional". The value is not case
+// sensitive, and UnsetSTSEndpoint is returned for an empty value.
+func GetSTSRegionalEndpoint(s string) (STSRegionalEndpoint, error) {
+	switch strings.ToLower(s) {
+	case "":
+		return UnsetSTSEndpoint, nil
+	case "legacy":
+		return LegacySTSEndpoint, nil
+	case "regional":
+		return RegionalSTSEndpoint, nil
+	default:
+		return UnsetSTSEndpoint, fmt.Errorf("unable to resolve the value of STSRegionalEndpoint for %v, must be legacy or regional", s)
+	}
+}
+
+// S3UsEast1RegionalEndpoint is an enum of the S3 us-east-1 endpoint
+// resolution modes of the S3UsEast1RegionalEndpoint option.
+type S3UsEast1RegionalEndpoint int
+
+func (e S3UsEast1RegionalEndpoint) String() string {
+	switch e {
+	case LegacyS3UsEast1Endpoint:
+		return "legacy"
+	case RegionalS3UsEast1Endpoint:
+		return "regional"
+	case UnsetS3UsEast1Endpoint:
+		return ""
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	// UnsetS3UsEast1Endpoint represents that the S3 us-east-1 endpoint
+	// resolution mode is not set, and LegacyS3UsEast1Endpoint is used.
+	UnsetS3UsEast1Endpoint S3UsEast1RegionalEndpoint = iota
+
+	// LegacyS3UsEast1Endpoint resolves the global S3 endpoint for the
+	// us-east-1 region.
+	LegacyS3UsEast1Endpoint
+
+	// RegionalS3UsEast1Endpoint resolves the regional S3 endpoint of the
+	// us-east-1 region.
+	RegionalS3UsEast1Endpoint
+)
+
+// GetS3UsEast1RegionalEndpoint returns the S3UsEast1RegionalEndpoint of the
+// value of the s3_us_east_1_regional_endpoint shared config key, or
+// AWS_S3_US_EAST_1_REGIONAL_ENDPOINT environment variable, "legacy" or
+// "regional". The value is not case sensitive, and UnsetS3UsEast1Endpoint is
+// returned for an empty value.
+func GetS3UsEast1RegionalEndpoint(s string) (S3UsEast1RegionalEndpoint, error) {
+	switch strings.ToLower(s) {
+	case "":
+		return UnsetS3UsEast1Endpoint, nil
+	case "legacy":
+		return LegacyS3UsEast1Endpoint, nil
+	case "regional":
+		return RegionalS3UsEast1Endpoint, nil
+	default:
+		return UnsetS3UsEast1Endpoint, fmt.Errorf("unable to resolve the value of S3UsEast1RegionalEndpoint for %v, must be legacy or regional", s)
+	}
 }
 
 // Set combines all of the option functions together.
@@ -67,12 +205,40 @@ func UseDualStackOption(o *Options) {
 	o.UseDualStack = true
 }
 
+// UseDualStackEndpointOption sets the UseDualStackEndpoint option. Can be used
+// as a functional option when resolving endpoints.
+func UseDualStackEndpointOption(o *Options) {
+	o.UseDualStackEndpoint = true
+}
+
+// UseFIPSEndpointOption sets the UseFIPSEndpoint option. Can be used as a
+// functional option when resolving endpoints.
+func UseFIPSEndpointOption(o *Options) {
+	o.UseFIPSEndpoint = true
+}
+
 // StrictMatchingOption sets the StrictMatching option. Can be used as a functional
 // option when resolving endpoints.
 func StrictMatchingOption(o *Options) {
 	o.StrictMatching = true
 }
 
+// STSRegionalEndpointOption returns a functional option setting the
+// STSRegionalEndpoint option when resolving endpoints.
+func STSRegionalEndpointOption(e STSRegionalEndpoint) func(*Options) {
+	return func(o *Options) {
+		o.STSRegionalEndpoint = e
+	}
+}
+
+// S3UsEast1RegionalEndpointOption returns a functional option setting the
+// S3UsEast1RegionalEndpoint option when resolving endpoints.
+func S3UsEast1RegionalEndpointOption(e S3UsEast1RegionalEndpoint) func(*Options) {
+	return func(o *Options) {
+		o.S3UsEast1RegionalEndpoint = e
+	}
+}
+
 // ResolveUnknownServiceOption sets the ResolveUnknownService option. Can be used
 // as a functional option when resolving endpoints.
 func ResolveUnknownServiceOption(o *Options) {
@@ -126,40 +292,105 @@ type EnumPartitions interface {
 
 // RegionsForService returns a map of regions for the partition and service.
 // If either the partition or service does not exist false will be returned
-// as the second parameter.
+// as the second parameter. If the partitionID is empty the regions of the
+// service in all of the partitions are returned.
 //
 // This example shows how  to get the regions for DynamoDB in the AWS partition.
 //    rs, exists := endpoints.RegionsForService(endpoints.DefaultPartitions(), endpoints.AwsPartitionID, endpoints.DynamodbServiceID)
 //
 // This is equivalent to using the partition directly.
 //    rs := endpoints.AwsPartition().Services()[endpoints.DynamodbServiceID].Regions()
+//
+// Use SortRegions to enumerate the regions in a stable order.
 func RegionsForService(ps []Partition, partitionID, serviceID string) (map[string]Region, bool) {
+	rs := map[string]Region{}
+	var found bool
 	for _, p := range ps {
-		if p.ID() != partitionID {
+		if len(partitionID) != 0 && p.ID() != partitionID {
 			continue
 		}
 		if _, ok := p.p.Services[serviceID]; !ok {
-			break
+			continue
 		}
 
 		s := Service{
 			id: serviceID,
 			p:  p.p,
 		}
-		return s.Regions(), true
+		for id, r := range s.Regions() {
+			rs[id] = r
+		}
+		found = true
 	}
 
-	return map[string]Region{}, false
+	return rs, found
 }
 
+// ServicesForRegion returns the services known to be in the region, sorted
+// by their ID. If no partition includes the region false will be returned as
+// the second parameter. See PartitionForRegion for the partition the region
+// is looked up in.
+//
+// Regions which are not known by the partition, but match its region
+// pattern, have no services known to be in them.
+func ServicesForRegion(ps []Partition, regionID string) ([]Service, bool) {
+	p, ok := PartitionForRegion(ps, regionID)
+	if !ok {
+		return []Service{}, false
+	}
+
+	r := Region{
+		id: regionID,
+		p:  p.p,
+	}
+	ss := make([]Service, 0, len(p.p.Services))
+	for _, s := range r.Services() {
+		ss = append(ss, s)
+	}
+	sort.Sort(servicesByID(ss))
+
+	return ss, true
+}
+
+// SortRegions returns the regions of the map sorted by their ID.
+func SortRegions(rs map[string]Region) []Region {
+	sorted := make([]Region, 0, len(rs))
+	for _, r := range rs {
+		sorted = append(sorted, r)
+	}
+	sort.Sort(regionsByID(sorted))
+
+	return sorted
+}
+
+type regionsByID []Region
+
+func (rs regionsByID) Len() int           { return len(rs) }
+func (rs regionsByID) Less(i, j int) bool { return rs[i].id < rs[j].id }
+func (rs regionsByID) Swap(i, j int)      { rs[i], rs[j] = rs[j], rs[i] }
+
+type servicesByID []Service
+
+func (ss servicesByID) Len() int           { return len(ss) }
+func (ss servicesByID) Less(i, j int) bool { return ss[i].id < ss[j].id }
+func (ss servicesByID) Swap(i, j int)      { ss[i], ss[j] = ss[j], ss[i] }
+
 // PartitionForRegion returns the first partition which includes the region
 // passed in. This includes both known regions and regions which match
 // a pattern supported by the partition which may include regions that are
 // not explicitly known by the partition. Use the Regions method of the
 // returned Partition if explicit support is needed.
+//
+// Partitions which know of the region are preferred over partitions whose
+// region pattern matches the region.
 func PartitionForRegion(ps []Partition, regionID string) (Partition, bool) {
 	for _, p := range ps {
-		if _, ok := p.p.Regions[regionID]; ok || p.p.RegionRegex.MatchString(regionID) {
+		if _, ok := p.p.Regions[regionID]; ok {
+			return p, true
+		}
+	}
+	for _, p := range ps {
+		if p.p.RegionRegex.Regexp != nil && p.p.RegionRegex.MatchString(regionID) {
 			return p, true
 		}
 	}
@@ -177,6 +408,10 @@ type Partition struct {
 // ID returns the identifier of the partition.
 func (p Partition) ID() string { return p.id }
 
+// DNSSuffix returns the DNS suffix of the partition's endpoints, such as
+// "amazonaws.com".
+func (p Partition) DNSSuffix() string { return p.p.DNSSuffix }
+
 // EndpointFor attempts to resolve the endpoint based on service and region.
 // See Options for information on configuring how the endpoint is resolved.
 //
@@ -198,6 +433,8 @@ func (p Partition) ID() string { return p.id }
 // Errors that can be returned.
 //   * UnknownServiceError
 //   * UnknownEndpointError
+//   * UnknownFIPSEndpointError
+//   * EndpointNotFoundError
 func (p Partition) EndpointFor(service, region string, opts ...func(*Options)) (ResolvedEndpoint, error) {
 	return p.p.EndpointFor(service, region, opts...)
 }
@@ -206,10 +443,11 @@ func (p Partition) EndpointFor(service, region string, opts ...func(*Options)) (
 // enumerating over the regions in a partition.
 func (p Partition) Regions() map[string]Region {
 	rs := map[string]Region{}
-	for id := range p.p.Regions {
+	for id, r := range p.p.Regions {
 		rs[id] = Region{
-			id: id,
-			p:  p.p,
+			id:   id,
+			desc: r.Description,
+			p:    p.p,
 		}
 	}
 
@@ -240,6 +478,9 @@ type Region struct {
 // ID returns the region's identifier.
 func (r Region) ID() string { return r.id }
 
+// Description returns the region's description, such as "US East (N. Virginia)".
+func (r Region) Description() string { return r.desc }
+
 // ResolveEndpoint resolves an endpoint from the context of the region given
 // a service. See Partition.EndpointFor for usage and errors that can be returned.
 func (r Region) ResolveEndpoint(service string, opts ...func(*Options)) (ResolvedEndpoint, error) {
@@ -284,10 +525,11 @@ func (s Service) ResolveEndpoint(region string, opts ...func(*Options)) (Resolve
 func (s Service) Regions() map[string]Region {
 	rs := map[string]Region{}
 	for id := range s.p.Services[s.id].Endpoints {
-		if _, ok := s.p.Regions[id]; ok {
+		if r, ok := s.p.Regions[id]; ok {
 			rs[id] = Region{
-				id: id,
-				p:  s.p,
+				id:   id,
+				desc: r.Description,
+				p:    s.p,
 			}
 		}
 	}
@@ -347,6 +589,13 @@ type ResolvedEndpoint struct {
 	// The service name that should be used for signing requests.
 	SigningName string
 
+	// States that the signing name for this endpoint was derived from the
+	// endpoint's service ID, e.g. "email" for SES, and not modeled by the
+	// endpoint's credential scope. Service clients should use their modeled
+	// signing name instead, if the signing name differs from the endpoint
+	// prefix.
+	SigningNameDerived bool
+
 	// The signing method that should be used for signing requests.
 	SigningMethod string
 }
@@ -357,6 +606,8 @@ type awsError awserr.Error
 
 // A EndpointNotFoundError is returned when in StrictMatching mode, and the
 // endpoint for the service and region cannot be found in any of the partitions.
+// Also returned when the UseDualStackEndpoint option is enabled, and the
+// service does not have a dualstack endpoint for the region.
 type EndpointNotFoundError struct {
 	awsError
 	Partition string
@@ -364,6 +615,29 @@ type EndpointNotFoundError struct {
 	Region    string
 }
 
+// NewEndpointNotFoundError builds and returns EndpointNotFoundError.
+func NewEndpointNotFoundError(p, s, r string) EndpointNotFoundError {
+	return EndpointNotFoundError{
+		awsError: awserr.New("EndpointNotFoundError",
+			"could not find endpoint", nil),
+		Partition: p,
+		Service:   s,
+		Region:    r,
+	}
+}
+
+// String returns the string representation of the error.
+func (e EndpointNotFoundError) Error() string {
+	extra := fmt.Sprintf("partition: %q, service: %q, region: %q",
+		e.Partition, e.Service, e.Region)
+	return awserr.SprintError(e.Code(), e.Message(), extra, e.OrigErr())
+}
+
+// String returns the string representation of the error.
+func (e EndpointNotFoundError) String() string {
+	return e.Error()
+}
+
 // A UnknownServiceError is returned when the service does not resolve to an
 // endpoint. Includes a list of all known services for the partition. Returned
 // when a partition does not support the service.
@@ -437,3 +711,35 @@ func (e UnknownEndpointError) Error() string {
 func (e UnknownEndpointError) String() string {
 	return e.Error()
 }
+
+// A UnknownFIPSEndpointError is returned when the UseFIPSEndpoint option is
+// enabled, and the service does not have a FIPS endpoint for the region.
+type UnknownFIPSEndpointError struct {
+	awsError
+	Partition string
+	Service   string
+	Region    string
+}
+
+// NewUnknownFIPSEndpointError builds and returns UnknownFIPSEndpointError.
+func NewUnknownFIPSEndpointError(p, s, r string) UnknownFIPSEndpointError {
+	return UnknownFIPSEndpointError{
+		awsError: awserr.New("UnknownFIPSEndpointError",
+			"could not resolve FIPS endpoint", nil),
+		Partition: p,
+		Service:   s,
+		Region:    r,
+	}
+}
+
+// String returns the string representation of the error.
+func (e UnknownFIPSEndpointError) Error() string {
+	extra := fmt.Sprintf("partition: %q, service: %q, region: %q",
+		e.Partition, e.Service, e.Region)
+	return awserr.SprintError(e.Code(), e.Message(), extra, e.OrigErr())
+}
+
+// String returns the string representation of the error.
+func (e UnknownFIPSEndpointError) String() string {
+	return e.Error()
+}
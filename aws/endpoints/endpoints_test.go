@@ -1,6 +1,10 @@
 package endpoints
 
-import "testing"
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
 
 func TestEnumDefaultPartitions(t *testing.T) {
 	resolver := DefaultResolver()
@@ -333,3 +337,167 @@ func TestPartitionForRegion_NotFound(t *testing.T) {
 		t.Errorf("expect no partition to be found, got %v", actual)
 	}
 }
+
+var testQueryPartitions = partitions{
+	{
+		ID:          "aws",
+		RegionRegex: regionRegex{regexp.MustCompile(`^(us|eu)\-\w+\-\d+$`)},
+		Regions: regions{
+			"us-east-1": region{Description: "US East (N. Virginia)"},
+			"eu-west-1": region{Description: "EU (Ireland)"},
+		},
+		Services: services{
+			"ec2": service{
+				Endpoints: endpoints{
+					"us-east-1": endpoint{},
+					"eu-west-1": endpoint{},
+				},
+			},
+			"acm": service{
+				Endpoints: endpoints{
+					"us-east-1": endpoint{},
+				},
+			},
+		},
+	},
+	{
+		ID:          "aws-cn",
+		RegionRegex: regionRegex{regexp.MustCompile(`^cn\-\w+\-\d+$`)},
+		Regions: regions{
+			"cn-north-1": region{Description: "China (Beijing)"},
+		},
+		Services: services{
+			"ec2": service{
+				Endpoints: endpoints{
+					"cn-north-1": endpoint{},
+				},
+			},
+			"cnonly": service{
+				Endpoints: endpoints{
+					"cn-north-1": endpoint{},
+				},
+			},
+		},
+	},
+}
+
+func TestRegionsForService_AllPartitions(t *testing.T) {
+	ps := testQueryPartitions.Partitions()
+
+	cases := map[string]struct {
+		PartitionID, ServiceID string
+		Expect                 []string
+		ExpectFound            bool
+	}{
+		"all partitions": {
+			ServiceID:   "ec2",
+			Expect:      []string{"cn-north-1", "eu-west-1", "us-east-1"},
+			ExpectFound: true,
+		},
+		"partition": {
+			PartitionID: "aws",
+			ServiceID:   "ec2",
+			Expect:      []string{"eu-west-1", "us-east-1"},
+			ExpectFound: true,
+		},
+		"service only in aws-cn": {
+			ServiceID:   "cnonly",
+			Expect:      []string{"cn-north-1"},
+			ExpectFound: true,
+		},
+		"service not in aws": {
+			PartitionID: "aws",
+			ServiceID:   "cnonly",
+		},
+		"service not exists": {
+			ServiceID: "service-not-exists",
+		},
+	}
+
+	for name, c := range cases {
+		rs, ok := RegionsForService(ps, c.PartitionID, c.ServiceID)
+		if e, a := c.ExpectFound, ok; e != a {
+			t.Errorf("%s, expect found %t, got %t", name, e, a)
+		}
+
+		var actual []string
+		for _, r := range SortRegions(rs) {
+			actual = append(actual, r.ID())
+		}
+		if e, a := c.Expect, actual; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v regions, got %v", name, e, a)
+		}
+	}
+}
+
+func TestRegionsForService_Description(t *testing.T) {
+	rs, _ := RegionsForService(testQueryPartitions.Partitions(), "", "ec2")
+
+	if e, a := "China (Beijing)", rs["cn-north-1"].Description(); e != a {
+		t.Errorf("expect %q description, got %q", e, a)
+	}
+}
+
+func TestServicesForRegion(t *testing.T) {
+	ps := testQueryPartitions.Partitions()
+
+	cases := map[string]struct {
+		RegionID    string
+		Expect      []string
+		ExpectFound bool
+	}{
+		"aws": {
+			RegionID:    "us-east-1",
+			Expect:      []string{"acm", "ec2"},
+			ExpectFound: true,
+		},
+		"aws-cn": {
+			RegionID:    "cn-north-1",
+			Expect:      []string{"cnonly", "ec2"},
+			ExpectFound: true,
+		},
+		"region only matched by regex": {
+			RegionID:    "eu-north-9",
+			ExpectFound: true,
+		},
+		"unknown region": {
+			RegionID: "region-not-exists",
+		},
+	}
+
+	for name, c := range cases {
+		ss, ok := ServicesForRegion(ps, c.RegionID)
+		if e, a := c.ExpectFound, ok; e != a {
+			t.Errorf("%s, expect found %t, got %t", name, e, a)
+		}
+
+		var actual []string
+		for _, s := range ss {
+			actual = append(actual, s.ID())
+		}
+		if e, a := c.Expect, actual; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v services, got %v", name, e, a)
+		}
+	}
+}
+
+func TestPartitionForRegion_RegionRegex(t *testing.T) {
+	ps := testQueryPartitions.Partitions()
+
+	cases := map[string]string{
+		"us-east-1":  "aws",
+		"us-west-9":  "aws",
+		"cn-north-1": "aws-cn",
+		"cn-east-9":  "aws-cn",
+	}
+
+	for regionID, expect := range cases {
+		p, ok := PartitionForRegion(ps, regionID)
+		if !ok {
+			t.Fatalf("%s, expect partition to be found", regionID)
+		}
+		if e, a := expect, p.ID(); e != a {
+			t.Errorf("%s, expect %s partition, got %s", regionID, e, a)
+		}
+	}
+}
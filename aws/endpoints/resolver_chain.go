@@ -0,0 +1,91 @@
+package endpoints
+
+// ResolverChain is a Resolver which resolves endpoints with its resolvers, in
+// order. A resolver returns an EndpointNotFoundError error to have the
+// endpoint resolved by the next resolver in the chain. Any other error, or
+// the endpoint, resolved by a resolver is returned.
+//
+// The options the endpoint is resolved with, e.g. UseFIPSEndpoint, are passed
+// to each resolver of the chain.
+//
+//     resolver := endpoints.ResolverChain{
+//         myResolver,
+//         endpoints.DefaultResolver(),
+//     }
+type ResolverChain []Resolver
+
+// EndpointFor resolves the endpoint with the resolvers of the chain, in
+// order, until a resolver does not return an EndpointNotFoundError error. The
+// last EndpointNotFoundError error is returned if no resolver resolves the
+// endpoint.
+func (c ResolverChain) EndpointFor(service, region string, opts ...func(*Options)) (ResolvedEndpoint, error) {
+	var err error = NewEndpointNotFoundError("", service, region)
+
+	for _, r := range c {
+		if r == nil {
+			continue
+		}
+
+		var resolved ResolvedEndpoint
+		resolved, err = r.EndpointFor(service, region, opts...)
+		if _, ok := err.(EndpointNotFoundError); ok {
+			continue
+		}
+		return resolved, err
+	}
+
+	return ResolvedEndpoint{}, err
+}
+
+// ResolverWithFallback returns a Resolver which resolves endpoints with the
+// resolver, and with the fallback resolver if the resolver returns an
+// EndpointNotFoundError error. See ResolverChain.
+func ResolverWithFallback(resolver, fallback Resolver) Resolver {
+	return ResolverChain{resolver, fallback}
+}
+
+// URLResolverFunc is a helper utility that wraps a function which returns
+// only the URL of a service's endpoint, so it satisfies the Resolver
+// interface. The signing region and name of the endpoint are resolved from
+// the SDK's endpoint model, see DefaultResolver, with the same options,
+// or are derived from the region and service if the model cannot resolve
+// the endpoint.
+//
+// The function returns an EndpointNotFoundError error for services it does
+// not override, so the endpoint can be resolved by the next resolver of a
+// ResolverChain.
+//
+//     resolver := endpoints.ResolverWithFallback(
+//         endpoints.URLResolverFunc(func(service, region string) (string, error) {
+//             if service == endpoints.DynamodbServiceID {
+//                 return "http://localhost:8000", nil
+//             }
+//             return "", endpoints.NewEndpointNotFoundError("", service, region)
+//         }),
+//         endpoints.DefaultResolver(),
+//     )
+type URLResolverFunc func(service, region string) (string, error)
+
+// EndpointFor returns the endpoint with the URL of the wrapped function, and
+// the signing region and name of the SDK's endpoint model.
+func (fn URLResolverFunc) EndpointFor(service, region string, opts ...func(*Options)) (ResolvedEndpoint, error) {
+	u, err := fn(service, region)
+	if err != nil {
+		return ResolvedEndpoint{}, err
+	}
+
+	resolved, err := DefaultResolver().EndpointFor(service, region, opts...)
+	if err != nil {
+		resolved = ResolvedEndpoint{
+			SigningRegion:      region,
+			SigningName:        service,
+			SigningNameDerived: true,
+		}
+	}
+
+	var o Options
+	o.Set(opts...)
+	resolved.URL = AddScheme(u, o.DisableSSL)
+
+	return resolved, nil
+}
@@ -0,0 +1,134 @@
+package endpoints
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func dynamodbURLResolver(service, region string) (string, error) {
+	if service == DynamodbServiceID {
+		return "localhost:8000", nil
+	}
+	return "", NewEndpointNotFoundError("", service, region)
+}
+
+func TestResolverChain(t *testing.T) {
+	resolver := ResolverWithFallback(URLResolverFunc(dynamodbURLResolver), DefaultResolver())
+
+	cases := map[string]struct {
+		Service, Region string
+		Options         []func(*Options)
+	}{
+		"s3":             {Service: S3ServiceID, Region: "us-west-2"},
+		"sqs":            {Service: SqsServiceID, Region: "eu-west-1"},
+		"sts global":     {Service: StsServiceID, Region: "aws-global"},
+		"ec2 fips":       {Service: Ec2ServiceID, Region: "us-west-2", Options: []func(*Options){UseFIPSEndpointOption}},
+		"sqs dualstack":  {Service: SqsServiceID, Region: "us-west-2", Options: []func(*Options){UseDualStackEndpointOption}},
+		"unknown region": {Service: SqsServiceID, Region: "mars-west-1"},
+	}
+
+	for name, c := range cases {
+		expect, expectErr := DefaultResolver().EndpointFor(c.Service, c.Region, c.Options...)
+		actual, err := resolver.EndpointFor(c.Service, c.Region, c.Options...)
+		if e, a := expectErr, err; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v error, got %v", name, e, a)
+		}
+		if e, a := expect, actual; e != a {
+			t.Errorf("%s, expect %v endpoint, got %v", name, e, a)
+		}
+	}
+}
+
+func TestResolverChain_Override(t *testing.T) {
+	resolver := ResolverWithFallback(URLResolverFunc(dynamodbURLResolver), DefaultResolver())
+
+	cases := map[string]struct {
+		Region  string
+		Options []func(*Options)
+		Expect  ResolvedEndpoint
+	}{
+		"default": {
+			Region: "us-west-2",
+			Expect: ResolvedEndpoint{
+				URL:                "https://localhost:8000",
+				SigningRegion:      "us-west-2",
+				SigningName:        "dynamodb",
+				SigningNameDerived: true,
+				SigningMethod:      "v4",
+			},
+		},
+		"disable ssl": {
+			Region:  "us-west-2",
+			Options: []func(*Options){DisableSSLOption},
+			Expect: ResolvedEndpoint{
+				URL:                "http://localhost:8000",
+				SigningRegion:      "us-west-2",
+				SigningName:        "dynamodb",
+				SigningNameDerived: true,
+				SigningMethod:      "v4",
+			},
+		},
+		"modeled signing region": {
+			Region: "local",
+			Expect: ResolvedEndpoint{
+				URL:                "https://localhost:8000",
+				SigningRegion:      "us-east-1",
+				SigningName:        "dynamodb",
+				SigningNameDerived: true,
+				SigningMethod:      "v4",
+			},
+		},
+		"unresolved signing region": {
+			Region:  "us-west-2",
+			Options: []func(*Options){UseDualStackEndpointOption},
+			Expect: ResolvedEndpoint{
+				URL:                "https://localhost:8000",
+				SigningRegion:      "us-west-2",
+				SigningName:        "dynamodb",
+				SigningNameDerived: true,
+			},
+		},
+	}
+
+	for name, c := range cases {
+		actual, err := resolver.EndpointFor(DynamodbServiceID, c.Region, c.Options...)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := c.Expect, actual; e != a {
+			t.Errorf("%s, expect %v endpoint, got %v", name, e, a)
+		}
+	}
+}
+
+func TestResolverChain_Errors(t *testing.T) {
+	expectErr := fmt.Errorf("resolver error")
+	var called bool
+
+	resolver := ResolverChain{
+		nil,
+		ResolverFunc(func(service, region string, opts ...func(*Options)) (ResolvedEndpoint, error) {
+			return ResolvedEndpoint{}, NewEndpointNotFoundError("", service, region)
+		}),
+		ResolverFunc(func(service, region string, opts ...func(*Options)) (ResolvedEndpoint, error) {
+			return ResolvedEndpoint{}, expectErr
+		}),
+		ResolverFunc(func(service, region string, opts ...func(*Options)) (ResolvedEndpoint, error) {
+			called = true
+			return ResolvedEndpoint{}, nil
+		}),
+	}
+
+	if _, err := resolver.EndpointFor("service", "region"); err != expectErr {
+		t.Errorf("expect %v error, got %v", expectErr, err)
+	}
+	if called {
+		t.Errorf("expect resolvers after the error not to be called")
+	}
+
+	_, err := ResolverChain{}.EndpointFor("service", "region")
+	if _, ok := err.(EndpointNotFoundError); !ok {
+		t.Errorf("expect EndpointNotFoundError error, got %T, %v", err, err)
+	}
+}
@@ -0,0 +1,108 @@
+package endpoints
+
+import (
+	"net/url"
+	"strings"
+)
+
+// NewTemplatedResolver returns a Resolver which resolves the endpoints of
+// services to the hostname of the template, such as the hostnames of VPC
+// interface endpoints. The template's {service}, {region}, and {dnsSuffix}
+// tokens are replaced with the service's endpoint ID, the region, and the DNS
+// suffix of the region's partition, e.g. amazonaws.com.
+//
+// The signing region, signing name, and protocol of the endpoints are resolved
+// by the resolver, DefaultResolver if nil, with the same options. The
+// endpoints of the excluded services, by endpoint ID, are resolved by the
+// resolver without the template.
+//
+//     resolver := endpoints.NewTemplatedResolver(
+//         "vpce-0123456789abcdef0.{service}.{region}.vpce.{dnsSuffix}",
+//         endpoints.DefaultResolver(),
+//         endpoints.S3ServiceID,
+//     )
+//
+// The template is used as is for the UseDualStackEndpoint and
+// UseFIPSEndpoint options.
+func NewTemplatedResolver(hostnameTemplate string, resolver Resolver, excludedServices ...string) Resolver {
+	if resolver == nil {
+		resolver = DefaultResolver()
+	}
+
+	excluded := make(map[string]struct{}, len(excludedServices))
+	for _, id := range excludedServices {
+		excluded[id] = struct{}{}
+	}
+
+	return templatedResolver{
+		template: hostnameTemplate,
+		resolver: resolver,
+		excluded: excluded,
+	}
+}
+
+type templatedResolver struct {
+	template string
+	resolver Resolver
+	excluded map[string]struct{}
+}
+
+// EndpointFor returns the endpoint of the service with the templated
+// hostname, and the signing region, signing name, and protocol of the
+// endpoint resolved by the underlying resolver. The signing region and name
+// are derived from the region and service if the underlying resolver cannot
+// resolve the endpoint.
+func (r templatedResolver) EndpointFor(service, region string, opts ...func(*Options)) (ResolvedEndpoint, error) {
+	if _, ok := r.excluded[service]; ok {
+		return r.resolver.EndpointFor(service, region, opts...)
+	}
+
+	var o Options
+	o.Set(opts...)
+
+	scheme := "https"
+	if o.DisableSSL {
+		scheme = "http"
+	}
+
+	resolved, err := r.resolver.EndpointFor(service, region, opts...)
+	if err != nil {
+		resolved = ResolvedEndpoint{
+			SigningRegion:      region,
+			SigningName:        service,
+			SigningNameDerived: true,
+		}
+	} else if u, err := url.Parse(resolved.URL); err == nil && len(u.Scheme) != 0 {
+		scheme = u.Scheme
+	}
+
+	hostname := strings.Replace(r.template, "{service}", service, -1)
+	hostname = strings.Replace(hostname, "{region}", region, -1)
+	hostname = strings.Replace(hostname, "{dnsSuffix}", r.dnsSuffix(region), -1)
+
+	resolved.URL = scheme + "://" + hostname
+
+	return resolved, nil
+}
+
+// dnsSuffix returns the DNS suffix of the region's partition, of the
+// partitions of the underlying resolver if it enumerates its partitions, or
+// of the SDK's default partitions.
+func (r templatedResolver) dnsSuffix(region string) string {
+	ps := DefaultPartitions()
+	if enum, ok := r.resolver.(EnumPartitions); ok {
+		ps = enum.Partitions()
+	}
+
+	p, ok := PartitionForRegion(ps, region)
+	if !ok {
+		if len(ps) == 0 {
+			return ""
+		}
+		// Regions of unknown partitions use the first partition's DNS
+		// suffix, as the partitions resolver does.
+		p = ps[0]
+	}
+
+	return p.p.DNSSuffix
+}
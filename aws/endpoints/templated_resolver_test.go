@@ -0,0 +1,83 @@
+package endpoints
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTemplatedResolver(t *testing.T) {
+	resolver := NewTemplatedResolver("vpce-1.{service}.{region}.vpce.{dnsSuffix}", nil, Ec2ServiceID)
+
+	cases := map[string]struct {
+		Service, Region string
+		Options         []func(*Options)
+		URL             string
+	}{
+		"s3": {
+			Service: S3ServiceID, Region: "us-west-2",
+			URL: "https://vpce-1.s3.us-west-2.vpce.amazonaws.com",
+		},
+		"s3 global endpoint": {
+			Service: S3ServiceID, Region: "us-east-1",
+			URL: "https://vpce-1.s3.us-east-1.vpce.amazonaws.com",
+		},
+		"sts": {
+			Service: StsServiceID, Region: "us-west-2",
+			URL: "https://vpce-1.sts.us-west-2.vpce.amazonaws.com",
+		},
+		"sts regional": {
+			Service: StsServiceID, Region: "us-west-2",
+			Options: []func(*Options){STSRegionalEndpointOption(RegionalSTSEndpoint)},
+			URL:     "https://vpce-1.sts.us-west-2.vpce.amazonaws.com",
+		},
+		"china partition": {
+			Service: S3ServiceID, Region: "cn-north-1",
+			URL: "https://vpce-1.s3.cn-north-1.vpce.amazonaws.com.cn",
+		},
+		"disable ssl": {
+			Service: SqsServiceID, Region: "eu-west-1",
+			Options: []func(*Options){DisableSSLOption},
+			URL:     "http://vpce-1.sqs.eu-west-1.vpce.amazonaws.com",
+		},
+		"excluded": {
+			Service: Ec2ServiceID, Region: "us-west-2",
+		},
+	}
+
+	for name, c := range cases {
+		expect, err := DefaultResolver().EndpointFor(c.Service, c.Region, c.Options...)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if len(c.URL) != 0 {
+			expect.URL = c.URL
+		}
+
+		actual, err := resolver.EndpointFor(c.Service, c.Region, c.Options...)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := expect, actual; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v endpoint, got %v", name, e, a)
+		}
+	}
+}
+
+func TestTemplatedResolver_UnresolvedEndpoint(t *testing.T) {
+	resolver := NewTemplatedResolver("{service}.{region}.internal.example.com", nil)
+
+	actual, err := resolver.EndpointFor("unknownservice", "us-west-2")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := ResolvedEndpoint{
+		URL:                "https://unknownservice.us-west-2.internal.example.com",
+		SigningRegion:      "us-west-2",
+		SigningName:        "unknownservice",
+		SigningNameDerived: true,
+	}
+	if e, a := expect, actual; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v endpoint, got %v", e, a)
+	}
+}
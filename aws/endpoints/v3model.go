@@ -85,12 +85,39 @@ func (p partition) EndpointFor(service, region string, opts ...func(*Options)) (
 		return resolved, NewUnknownServiceError(p.ID, service, serviceList(p.Services))
 	}
 
+	if opt.UseFIPSEndpoint {
+		e, ok := s.fipsEndpointForRegion(region)
+		if !ok {
+			return resolved, NewUnknownFIPSEndpointError(p.ID, service, region)
+		}
+		// The service defaults describe the dualstack endpoints of the
+		// regions, not of the FIPS endpoints, which must model their own.
+		if opt.UseDualStackEndpoint && e.HasDualStack != boxedTrue {
+			return resolved, NewEndpointNotFoundError(p.ID, service, region)
+		}
+		opt.UseDualStack = false
+		return e.resolve(service, region, p.DNSSuffix, []endpoint{p.Defaults, s.Defaults}, opt), nil
+	}
+
 	e, hasEndpoint := s.endpointForRegion(region)
 	if !hasEndpoint && opt.StrictMatching {
 		return resolved, NewUnknownEndpointError(p.ID, service, region, endpointList(s.Endpoints))
 	}
 
 	defs := []endpoint{p.Defaults, s.Defaults}
+	if service == StsServiceID && opt.STSRegionalEndpoint == RegionalSTSEndpoint && region != s.PartitionEndpoint {
+		// The service defaults of STS are the global endpoint, which is the
+		// endpoint of the regions not modeling their own endpoint.
+		defs = []endpoint{p.Defaults}
+	}
+	if service == S3ServiceID && opt.S3UsEast1RegionalEndpoint == RegionalS3UsEast1Endpoint && region == "us-east-1" {
+		// The us-east-1 endpoint of S3 is modeled as the global endpoint,
+		// the regional endpoint is the partition's default hostname.
+		e.Hostname = ""
+	}
+	if opt.UseDualStackEndpoint && e.withDefaults(defs).HasDualStack != boxedTrue {
+		return resolved, NewEndpointNotFoundError(p.ID, service, region)
+	}
 	return e.resolve(service, region, p.DNSSuffix, defs, opt), nil
 }
 
@@ -156,6 +183,24 @@ func (s *service) endpointForRegion(region string) (endpoint, bool) {
 	return endpoint{}, false
 }
 
+// fipsEndpointForRegion returns the FIPS endpoint of the region, modeled as
+// the endpoint whose ID is the region with a "-fips" suffix or "fips-"
+// prefix. The region may also be the ID of the FIPS endpoint itself.
+func (s *service) fipsEndpointForRegion(region string) (endpoint, bool) {
+	ids := []string{region + "-fips", "fips-" + region}
+	if strings.HasSuffix(region, "-fips") || strings.HasPrefix(region, "fips-") {
+		ids = []string{region}
+	}
+
+	for _, id := range ids {
+		if e, ok := s.Endpoints[id]; ok {
+			return e, true
+		}
+	}
+
+	return endpoint{}, false
+}
+
 type endpoints map[string]endpoint
 
 type endpoint struct {
@@ -200,18 +245,23 @@ func getByPriority(s []string, p []string, def string) string {
 	return s[0]
 }
 
-func (e endpoint) resolve(service, region, dnsSuffix string, defs []endpoint, opts Options) ResolvedEndpoint {
+// withDefaults returns the endpoint merged on top of the defaults, in order.
+func (e endpoint) withDefaults(defs []endpoint) endpoint {
 	var merged endpoint
 	for _, def := range defs {
 		merged.mergeIn(def)
 	}
 	merged.mergeIn(e)
-	e = merged
+	return merged
+}
+
+func (e endpoint) resolve(service, region, dnsSuffix string, defs []endpoint, opts Options) ResolvedEndpoint {
+	e = e.withDefaults(defs)
 
 	hostname := e.Hostname
 
 	// Offset the hostname for dualstack if enabled
-	if opts.UseDualStack && e.HasDualStack == boxedTrue {
+	if (opts.UseDualStack || opts.UseDualStackEndpoint) && e.HasDualStack == boxedTrue {
 		hostname = e.DualStackHostname
 	}
 
@@ -227,15 +277,18 @@ func (e endpoint) resolve(service, region, dnsSuffix string, defs []endpoint, op
 		signingRegion = region
 	}
 	signingName := e.CredentialScope.Service
+	var signingNameDerived bool
 	if len(signingName) == 0 {
 		signingName = service
+		signingNameDerived = true
 	}
 
 	return ResolvedEndpoint{
-		URL:           u,
-		SigningRegion: signingRegion,
-		SigningName:   signingName,
-		SigningMethod: getByPriority(e.SignatureVersions, signerPriority, defaultSigner),
+		URL:                u,
+		SigningRegion:      signingRegion,
+		SigningName:        signingName,
+		SigningNameDerived: signingNameDerived,
+		SigningMethod:      getByPriority(e.SignatureVersions, signerPriority, defaultSigner),
 	}
 }
 
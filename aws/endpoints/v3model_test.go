@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -157,6 +158,7 @@ func TestEndpointResolve(t *testing.T) {
 
 	assert.Equal(t, "https://service.region.dnsSuffix", resolved.URL)
 	assert.Equal(t, "signing_service", resolved.SigningName)
+	assert.False(t, resolved.SigningNameDerived)
 	assert.Equal(t, "signing_region", resolved.SigningRegion)
 	assert.Equal(t, "v4", resolved.SigningMethod)
 }
@@ -250,6 +252,7 @@ func TestResolveEndpoint(t *testing.T) {
 	assert.Equal(t, "https://service2.us-west-2.amazonaws.com", resolved.URL)
 	assert.Equal(t, "us-west-2", resolved.SigningRegion)
 	assert.Equal(t, "service2", resolved.SigningName)
+	assert.True(t, resolved.SigningNameDerived)
 }
 
 func TestResolveEndpoint_DisableSSL(t *testing.T) {
@@ -352,3 +355,359 @@ func TestResolveEndpoint_AwsGlobal(t *testing.T) {
 	assert.Equal(t, "us-east-1", resolved.SigningRegion)
 	assert.Equal(t, "globalService", resolved.SigningName)
 }
+
+func TestResolveEndpoint_STSRegionalEndpoint(t *testing.T) {
+	cases := []struct {
+		Region        string
+		Mode          STSRegionalEndpoint
+		URL           string
+		SigningRegion string
+	}{
+		{"us-west-2", UnsetSTSEndpoint, "https://sts.amazonaws.com", "us-east-1"},
+		{"us-west-2", LegacySTSEndpoint, "https://sts.amazonaws.com", "us-east-1"},
+		{"us-west-2", RegionalSTSEndpoint, "https://sts.us-west-2.amazonaws.com", "us-west-2"},
+		{"us-east-1", RegionalSTSEndpoint, "https://sts.us-east-1.amazonaws.com", "us-east-1"},
+		{"aws-global", RegionalSTSEndpoint, "https://sts.amazonaws.com", "us-east-1"},
+		{"ap-northeast-2", LegacySTSEndpoint, "https://sts.ap-northeast-2.amazonaws.com", "ap-northeast-2"},
+		{"us-east-1-fips", RegionalSTSEndpoint, "https://sts-fips.us-east-1.amazonaws.com", "us-east-1"},
+		{"cn-north-1", LegacySTSEndpoint, "https://sts.cn-north-1.amazonaws.com.cn", "cn-north-1"},
+		{"cn-north-1", RegionalSTSEndpoint, "https://sts.cn-north-1.amazonaws.com.cn", "cn-north-1"},
+	}
+
+	for _, c := range cases {
+		resolved, err := DefaultResolver().EndpointFor(StsServiceID, c.Region, STSRegionalEndpointOption(c.Mode))
+
+		assert.NoError(t, err)
+		assert.Equal(t, c.URL, resolved.URL, "%s, %v", c.Region, c.Mode)
+		assert.Equal(t, c.SigningRegion, resolved.SigningRegion, "%s, %v", c.Region, c.Mode)
+	}
+}
+
+func TestResolveEndpoint_S3UsEast1RegionalEndpoint(t *testing.T) {
+	cases := []struct {
+		Region        string
+		Mode          S3UsEast1RegionalEndpoint
+		DualStack     bool
+		URL           string
+		SigningRegion string
+	}{
+		{"us-east-1", UnsetS3UsEast1Endpoint, false, "https://s3.amazonaws.com", "us-east-1"},
+		{"us-east-1", LegacyS3UsEast1Endpoint, false, "https://s3.amazonaws.com", "us-east-1"},
+		{"us-east-1", RegionalS3UsEast1Endpoint, false, "https://s3.us-east-1.amazonaws.com", "us-east-1"},
+		{"us-east-1", LegacyS3UsEast1Endpoint, true, "https://s3.dualstack.us-east-1.amazonaws.com", "us-east-1"},
+		{"us-east-1", RegionalS3UsEast1Endpoint, true, "https://s3.dualstack.us-east-1.amazonaws.com", "us-east-1"},
+		{"us-west-2", RegionalS3UsEast1Endpoint, false, "https://s3-us-west-2.amazonaws.com", "us-west-2"},
+		{"s3-external-1", RegionalS3UsEast1Endpoint, false, "https://s3-external-1.amazonaws.com", "us-east-1"},
+	}
+
+	for _, c := range cases {
+		opts := []func(*Options){S3UsEast1RegionalEndpointOption(c.Mode)}
+		if c.DualStack {
+			opts = append(opts, UseDualStackEndpointOption)
+		}
+		resolved, err := DefaultResolver().EndpointFor(S3ServiceID, c.Region, opts...)
+
+		assert.NoError(t, err)
+		assert.Equal(t, c.URL, resolved.URL, "%s, %v, %t", c.Region, c.Mode, c.DualStack)
+		assert.Equal(t, c.SigningRegion, resolved.SigningRegion, "%s, %v, %t", c.Region, c.Mode, c.DualStack)
+	}
+
+	// Other services are not affected by the option.
+	resolved, err := DefaultResolver().EndpointFor(StsServiceID, "us-east-1",
+		S3UsEast1RegionalEndpointOption(RegionalS3UsEast1Endpoint))
+	assert.NoError(t, err)
+	assert.Equal(t, "https://sts.amazonaws.com", resolved.URL)
+}
+
+func TestResolveEndpoint_UseFIPSEndpoint(t *testing.T) {
+	isoPartition := partition{
+		ID:        "aws-iso",
+		Name:      "AWS ISO (US)",
+		DNSSuffix: "c2s.ic.gov",
+		RegionRegex: regionRegex{
+			Regexp: regexp.MustCompile("^us\\-iso\\-\\w+\\-\\d+$"),
+		},
+		Defaults: endpoint{
+			Hostname:          "{service}.{region}.{dnsSuffix}",
+			Protocols:         []string{"https"},
+			SignatureVersions: []string{"v4"},
+		},
+		Regions: regions{
+			"us-iso-east-1": region{},
+		},
+		Services: services{
+			"kms": service{
+				Endpoints: endpoints{
+					"us-iso-east-1": endpoint{},
+					"us-iso-east-1-fips": endpoint{
+						Hostname: "kms-fips.us-iso-east-1.c2s.ic.gov",
+						CredentialScope: credentialScope{
+							Region: "us-iso-east-1",
+						},
+					},
+				},
+			},
+			"ec2": service{
+				Endpoints: endpoints{
+					"us-iso-east-1": endpoint{},
+				},
+			},
+		},
+	}
+	resolver := append(partitions{}, defaultPartitions...)
+	resolver = append(resolver, isoPartition)
+
+	cases := map[string]struct {
+		Service, Region string
+		Options         []func(*Options)
+		URL             string
+		SigningRegion   string
+		SigningName     string
+		Err             bool
+	}{
+		"standard": {
+			Service: StsServiceID, Region: "us-east-1",
+			URL:           "https://sts-fips.us-east-1.amazonaws.com",
+			SigningRegion: "us-east-1", SigningName: "sts",
+		},
+		"standard dualstack ignored": {
+			Service: StsServiceID, Region: "us-west-2",
+			Options:       []func(*Options){UseDualStackOption},
+			URL:           "https://sts-fips.us-west-2.amazonaws.com",
+			SigningRegion: "us-west-2", SigningName: "sts",
+		},
+		"standard no FIPS endpoint": {
+			Service: Ec2ServiceID, Region: "us-west-2",
+			Err: true,
+		},
+		"standard unknown region": {
+			Service: StsServiceID, Region: "us-region-1",
+			Err: true,
+		},
+		"govcloud": {
+			Service: S3ServiceID, Region: "us-gov-west-1",
+			URL:           "https://s3-fips-us-gov-west-1.amazonaws.com",
+			SigningRegion: "us-gov-west-1", SigningName: "s3",
+		},
+		"govcloud FIPS endpoint ID": {
+			Service: S3ServiceID, Region: "fips-us-gov-west-1",
+			URL:           "https://s3-fips-us-gov-west-1.amazonaws.com",
+			SigningRegion: "us-gov-west-1", SigningName: "s3",
+		},
+		"govcloud no FIPS endpoint": {
+			Service: Ec2ServiceID, Region: "us-gov-west-1",
+			Err: true,
+		},
+		"iso": {
+			Service: "kms", Region: "us-iso-east-1",
+			URL:           "https://kms-fips.us-iso-east-1.c2s.ic.gov",
+			SigningRegion: "us-iso-east-1", SigningName: "kms",
+		},
+		"iso no FIPS endpoint": {
+			Service: "ec2", Region: "us-iso-east-1",
+			Err: true,
+		},
+		"unknown service": {
+			Service: "unknown-service", Region: "us-west-2",
+			Options: []func(*Options){ResolveUnknownServiceOption},
+			Err:     true,
+		},
+	}
+
+	for name, c := range cases {
+		opts := append([]func(*Options){UseFIPSEndpointOption}, c.Options...)
+		resolved, err := resolver.EndpointFor(c.Service, c.Region, opts...)
+		if c.Err {
+			if _, ok := err.(UnknownFIPSEndpointError); !ok {
+				t.Errorf("%s, expect UnknownFIPSEndpointError, got %v", name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		assert.Equal(t, c.URL, resolved.URL, name)
+		assert.Equal(t, c.SigningRegion, resolved.SigningRegion, name)
+		assert.Equal(t, c.SigningName, resolved.SigningName, name)
+	}
+}
+
+func TestResolveEndpoint_UseDualStackEndpoint(t *testing.T) {
+	testPartition := partition{
+		ID:        "aws-test",
+		Name:      "AWS Test",
+		DNSSuffix: "amazonaws.test",
+		RegionRegex: regionRegex{
+			Regexp: regexp.MustCompile("^test\\-\\w+\\-\\d+$"),
+		},
+		Defaults: endpoint{
+			Hostname:          "{service}.{region}.{dnsSuffix}",
+			Protocols:         []string{"https"},
+			SignatureVersions: []string{"v4"},
+		},
+		Regions: regions{
+			"test-east-1": region{},
+		},
+		Services: services{
+			"kms": service{
+				Defaults: endpoint{
+					HasDualStack:      boxedTrue,
+					DualStackHostname: "{service}.{region}.api.test",
+				},
+				Endpoints: endpoints{
+					"test-east-1": endpoint{},
+					"test-east-1-fips": endpoint{
+						Hostname:          "kms-fips.test-east-1.amazonaws.test",
+						HasDualStack:      boxedTrue,
+						DualStackHostname: "kms-fips.test-east-1.api.test",
+						CredentialScope: credentialScope{
+							Region: "test-east-1",
+						},
+					},
+				},
+			},
+		},
+	}
+	resolver := append(partitions{}, defaultPartitions...)
+	resolver = append(resolver, testPartition)
+
+	cases := map[string]struct {
+		Service, Region string
+		Options         []func(*Options)
+		URL             string
+		SigningRegion   string
+		SigningName     string
+		Err             string
+	}{
+		"ec2": {
+			Service: Ec2ServiceID, Region: "us-west-2",
+			Options:       []func(*Options){UseDualStackEndpointOption},
+			URL:           "https://ec2.us-west-2.api.aws",
+			SigningRegion: "us-west-2", SigningName: "ec2",
+		},
+		"lambda": {
+			Service: LambdaServiceID, Region: "us-east-1",
+			Options:       []func(*Options){UseDualStackEndpointOption},
+			URL:           "https://lambda.us-east-1.api.aws",
+			SigningRegion: "us-east-1", SigningName: "lambda",
+		},
+		"sqs": {
+			Service: SqsServiceID, Region: "eu-west-1",
+			Options:       []func(*Options){UseDualStackEndpointOption},
+			URL:           "https://sqs.eu-west-1.api.aws",
+			SigningRegion: "eu-west-1", SigningName: "sqs",
+		},
+		"s3": {
+			Service: S3ServiceID, Region: "us-west-2",
+			Options:       []func(*Options){UseDualStackEndpointOption},
+			URL:           "https://s3.dualstack.us-west-2.amazonaws.com",
+			SigningRegion: "us-west-2", SigningName: "s3",
+		},
+		"s3 legacy option": {
+			Service: S3ServiceID, Region: "us-west-2",
+			Options:       []func(*Options){UseDualStackOption},
+			URL:           "https://s3.dualstack.us-west-2.amazonaws.com",
+			SigningRegion: "us-west-2", SigningName: "s3",
+		},
+		"legacy option no dualstack endpoint": {
+			Service: StsServiceID, Region: "us-west-2",
+			Options:       []func(*Options){UseDualStackOption},
+			URL:           "https://sts.amazonaws.com",
+			SigningRegion: "us-east-1", SigningName: "sts",
+		},
+		"no dualstack endpoint": {
+			Service: StsServiceID, Region: "us-west-2",
+			Options: []func(*Options){UseDualStackEndpointOption},
+			Err:     "EndpointNotFoundError",
+		},
+		"no dualstack endpoint in partition": {
+			Service: Ec2ServiceID, Region: "cn-north-1",
+			Options: []func(*Options){UseDualStackEndpointOption},
+			Err:     "EndpointNotFoundError",
+		},
+		"FIPS and dualstack": {
+			Service: "kms", Region: "test-east-1",
+			Options: []func(*Options){
+				UseDualStackEndpointOption, UseFIPSEndpointOption,
+			},
+			URL:           "https://kms-fips.test-east-1.api.test",
+			SigningRegion: "test-east-1", SigningName: "kms",
+		},
+		"FIPS and dualstack, only FIPS endpoint": {
+			Service: StsServiceID, Region: "us-east-1",
+			Options: []func(*Options){
+				UseDualStackEndpointOption, UseFIPSEndpointOption,
+			},
+			Err: "EndpointNotFoundError",
+		},
+		"FIPS and dualstack, only dualstack endpoint": {
+			Service: Ec2ServiceID, Region: "us-west-2",
+			Options: []func(*Options){
+				UseDualStackEndpointOption, UseFIPSEndpointOption,
+			},
+			Err: "UnknownFIPSEndpointError",
+		},
+	}
+
+	for name, c := range cases {
+		resolved, err := resolver.EndpointFor(c.Service, c.Region, c.Options...)
+		if len(c.Err) != 0 {
+			if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != c.Err {
+				t.Errorf("%s, expect %v error, got %v", name, c.Err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		assert.Equal(t, c.URL, resolved.URL, name)
+		assert.Equal(t, c.SigningRegion, resolved.SigningRegion, name)
+		assert.Equal(t, c.SigningName, resolved.SigningName, name)
+	}
+}
+
+func TestGetSTSRegionalEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		Expect STSRegionalEndpoint
+		Err    bool
+	}{
+		"":         {Expect: UnsetSTSEndpoint},
+		"legacy":   {Expect: LegacySTSEndpoint},
+		"Regional": {Expect: RegionalSTSEndpoint},
+		"global":   {Err: true},
+	}
+
+	for v, c := range cases {
+		e, err := GetSTSRegionalEndpoint(v)
+		if c.Err {
+			assert.Error(t, err, v)
+			continue
+		}
+		assert.NoError(t, err, v)
+		assert.Equal(t, c.Expect, e, v)
+	}
+}
+
+func TestGetS3UsEast1RegionalEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		Expect S3UsEast1RegionalEndpoint
+		Err    bool
+	}{
+		"":         {Expect: UnsetS3UsEast1Endpoint},
+		"Legacy":   {Expect: LegacyS3UsEast1Endpoint},
+		"regional": {Expect: RegionalS3UsEast1Endpoint},
+		"global":   {Err: true},
+	}
+
+	for v, c := range cases {
+		e, err := GetS3UsEast1RegionalEndpoint(v)
+		if c.Err {
+			assert.Error(t, err, v)
+			continue
+		}
+		assert.NoError(t, err, v)
+		assert.Equal(t, c.Expect, e, v)
+	}
+}
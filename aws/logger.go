@@ -54,7 +54,9 @@ const (
 const (
 	// LogDebugWithSigning states that the SDK should log request signing and
 	// presigning events. This should be used to log the signing details of
-	// requests for debugging. Will also enable LogDebug.
+	// requests for debugging, the canonical request, string to sign, and
+	// credential scope of the signature. The session token of the
+	// credentials is redacted. Will also enable LogDebug.
 	LogDebugWithSigning LogLevelType = LogDebug | (1 << iota)
 
 	// LogDebugWithHTTPBody states the SDK should log HTTP request and response
@@ -71,6 +73,12 @@ const (
 	// LogDebugWithRequestErrors states the SDK should log when service requests fail
 	// to build, send, validate, or unmarshal.
 	LogDebugWithRequestErrors
+
+	// LogDebugWithBinaryBody states the SDK should log the content of binary
+	// HTTP request and response bodies when bodies are logged with
+	// LogDebugWithHTTPBody. Otherwise only the size, and SHA256 hash of
+	// binary bodies are logged. Will also enable LogDebug.
+	LogDebugWithBinaryBody
 )
 
 // A Logger is a minimalistic interface for the SDK to log messages to. Should
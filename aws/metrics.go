@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"time"
+)
+
+// A RequestMetricsCollector receives the metrics of each attempt of the API
+// requests made by a service client, and of each request once it is
+// completed. Set with Config.RequestMetricsCollector.
+//
+// The collector's methods are called synchronously from the goroutine the
+// request is sent in, and must be safe to be called concurrently by
+// multiple requests. Methods should return quickly, as the request's attempt
+// or retry is delayed until the method returns.
+type RequestMetricsCollector interface {
+	// AttemptStarted is called before each attempt of a request is built
+	// and signed.
+	AttemptStarted(AttemptStartedMetrics)
+
+	// AttemptCompleted is called after each attempt of a request is
+	// completed, and the request's handlers determined if it will be
+	// retried.
+	AttemptCompleted(AttemptCompletedMetrics)
+
+	// RequestCompleted is called once the request is completed, after the
+	// request's Complete handlers have run.
+	RequestCompleted(RequestCompletedMetrics)
+}
+
+// RequestMetricsInfo identifies the API request metrics are for.
+type RequestMetricsInfo struct {
+	ServiceName string
+	ServiceID   string
+	Operation   string
+	Region      string
+
+	// The request ID of the service's response, if available.
+	RequestID string
+}
+
+// AttemptStartedMetrics are the metrics of an attempt of a request before
+// it is sent.
+type AttemptStartedMetrics struct {
+	RequestMetricsInfo
+
+	// The attempt's zero based index, the number of times the request was
+	// retried before the attempt.
+	Attempt int
+
+	// The time the attempt was started.
+	Time time.Time
+}
+
+// AttemptCompletedMetrics are the metrics of a completed attempt of a
+// request.
+type AttemptCompletedMetrics struct {
+	RequestMetricsInfo
+
+	// The attempt's zero based index, the number of times the request was
+	// retried before the attempt.
+	Attempt int
+
+	// The time from the attempt starting until its response was read, not
+	// including any delay before the attempt is retried.
+	Latency time.Duration
+
+	// The HTTP status code of the attempt's response, zero if no response
+	// was received.
+	StatusCode int
+
+	// The error the attempt failed with, nil if the attempt succeeded.
+	Err error
+
+	// If the request will be retried, and the delay before it is.
+	WillRetry  bool
+	RetryDelay time.Duration
+}
+
+// RequestCompletedMetrics are the metrics of a completed request.
+type RequestCompletedMetrics struct {
+	RequestMetricsInfo
+
+	// The number of attempts sent for the request.
+	Attempts int
+
+	// The time from the request being sent until it was completed,
+	// including any delays between attempts.
+	Latency time.Duration
+
+	// The HTTP status code of the request's last response, zero if no
+	// response was received.
+	StatusCode int
+
+	// The error the request failed with, nil if the request succeeded.
+	Err error
+}
@@ -0,0 +1,104 @@
+package request
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// WithAttemptTimeout is a request option that limits the time each attempt
+// of the request may take, separate from the deadline of the request's
+// Context. An attempt which times out fails with a retryable RequestError,
+// and will be retried if the request has retries remaining.
+//
+// See aws.Config.AttemptTimeout for more information.
+//
+//     svc.PutObjectWithContext(ctx, params, request.WithAttemptTimeout(5 * time.Second))
+func WithAttemptTimeout(timeout time.Duration) Option {
+	return func(r *Request) {
+		r.Config.AttemptTimeout = &timeout
+	}
+}
+
+// AttemptTimeoutError is the original error of the RequestError an attempt
+// fails with when it is not completed within the request's attempt timeout.
+// The error is a temporary net.Error, so that the attempt is retried.
+type AttemptTimeoutError struct {
+	// The attempt timeout which expired.
+	Duration time.Duration
+}
+
+func (e AttemptTimeoutError) Error() string {
+	return fmt.Sprintf("request attempt timed out after %v", e.Duration)
+}
+
+// Timeout returns true, the error is a timeout.
+func (e AttemptTimeoutError) Timeout() bool { return true }
+
+// Temporary returns true, the attempt can be retried.
+func (e AttemptTimeoutError) Temporary() bool { return true }
+
+// startAttempt sets the context the request's attempt will be sent with, if
+// the request has an attempt timeout. Returns the parent context, and the
+// attempt context, or nil if the request does not have an attempt timeout.
+func (r *Request) startAttempt() (aws.Context, *timeoutContext) {
+	if r.Config.AttemptTimeout == nil || *r.Config.AttemptTimeout <= 0 {
+		return nil, nil
+	}
+	timeout := *r.Config.AttemptTimeout
+
+	parent := r.Context()
+	ctx := newTimeoutContext(parent, timeout, AttemptTimeoutError{Duration: timeout})
+	setRequestContext(r, ctx)
+
+	return parent, ctx
+}
+
+// watchAttemptBody releases the attempt's context when the attempt's
+// response body is closed. Until then the body may still be read after the
+// attempt ends, and its reads are canceled if the parent context is done.
+func (r *Request) watchAttemptBody(ctx *timeoutContext) {
+	if ctx == nil || r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+		return
+	}
+
+	r.HTTPResponse.Body = &attemptBody{ReadCloser: r.HTTPResponse.Body, ctx: ctx}
+}
+
+// endAttempt stops the attempt's timeout, and restores the request's parent
+// context for the request's retry delay. If the attempt timed out its error
+// is replaced with a retryable RequestError. The attempt's context is
+// released unless the attempt succeeded with a response body which may
+// still be read.
+func (r *Request) endAttempt(parent aws.Context, ctx *timeoutContext) {
+	if ctx == nil {
+		return
+	}
+
+	ctx.stopTimeout()
+	r.context = parent
+	if r.Error != nil || r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+		ctx.releaseContext()
+	}
+
+	if r.Error != nil && ctx.timedOut() {
+		r.Error = awserr.New("RequestError", "request attempt timed out",
+			AttemptTimeoutError{Duration: ctx.timeout})
+		r.Retryable = aws.Bool(true)
+	}
+}
+
+// An attemptBody releases the context of the attempt its response was read
+// by when it is closed.
+type attemptBody struct {
+	io.ReadCloser
+	ctx *timeoutContext
+}
+
+func (b *attemptBody) Close() error {
+	b.ctx.releaseContext()
+	return b.ReadCloser.Close()
+}
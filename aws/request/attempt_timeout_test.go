@@ -0,0 +1,245 @@
+package request_test
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+)
+
+// newAttemptTimeoutServer returns a server which does not respond to the
+// first hung requests it receives until the test is done.
+func newAttemptTimeoutServer(hung int) (*httptest.Server, func() int) {
+	stop := make(chan struct{})
+	var mu sync.Mutex
+	var attempts int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts++
+		n := attempts
+		mu.Unlock()
+
+		if n <= hung {
+			<-stop
+			return
+		}
+		w.WriteHeader(200)
+	}))
+
+	return server, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		select {
+		case <-stop:
+		default:
+			close(stop)
+		}
+		return attempts
+	}
+}
+
+func newAttemptTimeoutRequest(endpoint string, cfg *aws.Config) *request.Request {
+	svc := awstesting.NewClient(cfg.WithEndpoint(endpoint).
+		WithRegion("mock-region").
+		WithDisableSSL(true).
+		WithSleepDelay(func(time.Duration) {}))
+
+	return svc.NewRequest(&request.Operation{
+		Name:       "Operation",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/",
+	}, nil, nil)
+}
+
+func TestAttemptTimeout_Retry(t *testing.T) {
+	server, done := newAttemptTimeoutServer(1)
+	defer server.Close()
+
+	r := newAttemptTimeoutRequest(server.URL, aws.NewConfig().
+		WithMaxRetries(2).
+		WithAttemptTimeout(50*time.Millisecond))
+
+	err := r.Send()
+	attempts := done()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 2, attempts; e != a {
+		t.Errorf("expect %d attempts, got %d", e, a)
+	}
+	if e, a := 1, r.RetryCount; e != a {
+		t.Errorf("expect %d retry count, got %d", e, a)
+	}
+}
+
+func TestAttemptTimeout_RetriesExhausted(t *testing.T) {
+	server, done := newAttemptTimeoutServer(10)
+	defer server.Close()
+
+	r := newAttemptTimeoutRequest(server.URL, aws.NewConfig().WithMaxRetries(2))
+	r.ApplyOptions(request.WithAttemptTimeout(50 * time.Millisecond))
+
+	err := r.Send()
+	attempts := done()
+	if err == nil {
+		t.Fatalf("expect error")
+	}
+	if e, a := 3, attempts; e != a {
+		t.Errorf("expect %d attempts, got %d", e, a)
+	}
+
+	aerr := err.(awserr.Error)
+	if e, a := "RequestError", aerr.Code(); e != a {
+		t.Errorf("expect %q error code, got %q", e, a)
+	}
+	origErr, ok := aerr.OrigErr().(net.Error)
+	if !ok {
+		t.Fatalf("expect net.Error, got %T", aerr.OrigErr())
+	}
+	if !origErr.Timeout() || !origErr.Temporary() {
+		t.Errorf("expect temporary timeout error, got %v", origErr)
+	}
+	if e, a := (request.AttemptTimeoutError{Duration: 50 * time.Millisecond}), origErr; e != a {
+		t.Errorf("expect %v original error, got %v", e, a)
+	}
+}
+
+func TestAttemptTimeout_NonSeekableBody(t *testing.T) {
+	server, done := newAttemptTimeoutServer(10)
+	defer server.Close()
+
+	r := newAttemptTimeoutRequest(server.URL, aws.NewConfig().
+		WithMaxRetries(2).
+		WithAttemptTimeout(50*time.Millisecond))
+	r.SetReaderBody(aws.ReadSeekCloser(bytes.NewBufferString("abc")))
+
+	err := r.Send()
+	attempts := done()
+	if err == nil {
+		t.Fatalf("expect error")
+	}
+	if e, a := 1, attempts; e != a {
+		t.Errorf("expect %d attempts, got %d", e, a)
+	}
+
+	aerr := err.(awserr.Error)
+	if e, a := request.ErrCodeBodyNotRetryable, aerr.Code(); e != a {
+		t.Errorf("expect %q error code, got %q", e, a)
+	}
+	origErr, ok := aerr.OrigErr().(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error original error, got %T", aerr.OrigErr())
+	}
+	if _, ok := origErr.OrigErr().(request.AttemptTimeoutError); !ok {
+		t.Errorf("expect attempt timeout error, got %v", origErr)
+	}
+}
+
+func TestAttemptTimeout_ParentContextDone(t *testing.T) {
+	server, done := newAttemptTimeoutServer(10)
+	defer server.Close()
+
+	r := newAttemptTimeoutRequest(server.URL, aws.NewConfig().
+		WithMaxRetries(2).
+		WithAttemptTimeout(10*time.Second))
+
+	ctx := &awstesting.FakeContext{DoneCh: make(chan struct{})}
+	r.SetContext(ctx)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		ctx.Error = fmt.Errorf("context canceled")
+		close(ctx.DoneCh)
+	}()
+
+	start := time.Now()
+	err := r.Send()
+	attempts := done()
+	if err == nil {
+		t.Fatalf("expect error")
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("expect parent context to cancel the attempt")
+	}
+	if e, a := 1, attempts; e != a {
+		t.Errorf("expect %d attempts, got %d", e, a)
+	}
+
+	aerr := err.(awserr.Error)
+	if e, a := request.CanceledErrorCode, aerr.Code(); e != a {
+		t.Errorf("expect %q error code, got %q", e, a)
+	}
+	if e, a := 0, r.RetryCount; e != a {
+		t.Errorf("expect %d retry count, got %d", e, a)
+	}
+}
+
+func TestAttemptTimeout_ResponseBodyAfterAttempt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("body"))
+	}))
+	defer server.Close()
+
+	r := newAttemptTimeoutRequest(server.URL, aws.NewConfig().
+		WithAttemptTimeout(50*time.Millisecond))
+	r.Handlers.Unmarshal.Clear()
+
+	if err := r.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	// The response body must be readable after the attempt timeout would
+	// have expired.
+	time.Sleep(100 * time.Millisecond)
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r.HTTPResponse.Body); err != nil {
+		t.Fatalf("expect no error reading body, got %v", err)
+	}
+	if e, a := "body", buf.String(); e != a {
+		t.Errorf("expect %q body, got %q", e, a)
+	}
+}
+
+func TestAttemptTimeout_ReleasesAttemptContexts(t *testing.T) {
+	server, _ := newAttemptTimeoutServer(0)
+	defer server.Close()
+
+	// The parent context is never done, so the attempts' contexts must be
+	// released when the attempts end, or their response bodies are closed.
+	ctx := &awstesting.FakeContext{DoneCh: make(chan struct{})}
+
+	send := func() {
+		r := newAttemptTimeoutRequest(server.URL, aws.NewConfig().
+			WithAttemptTimeout(5*time.Second))
+		r.SetContext(ctx)
+		if err := r.Send(); err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		r.HTTPResponse.Body.Close()
+	}
+
+	send()
+	start := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		send()
+	}
+
+	var n int
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); {
+		if n = runtime.NumGoroutine(); n-start < 10 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("expect attempt contexts to be released, goroutines grew from %d to %d", start, n)
+}
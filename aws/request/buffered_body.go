@@ -0,0 +1,132 @@
+package request
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// ErrCodeBufferedBodyTooLarge is the error code of the error returned when a
+// request's body being buffered by WithBufferedBody exceeds the maximum
+// number of bytes buffered.
+const ErrCodeBufferedBodyTooLarge = "BufferedBodyTooLarge"
+
+// DefaultBufferedBodyMemoryBytes is the default number of bytes of a request
+// body buffered in memory before the body is spooled to a temporary file.
+const DefaultBufferedBodyMemoryBytes = 1024 * 1024
+
+// A BufferedBody is the buffering of a request body which is not seekable,
+// so that the body can be rewound. See WithBufferedBody.
+type BufferedBody struct {
+	// MaxBytes is the maximum number of bytes of the body buffered. The
+	// request fails without being sent if the body is larger.
+	MaxBytes int64
+
+	// MemoryBytes is the number of bytes of the body buffered in memory.
+	// Larger bodies are spooled to a temporary file. Defaults to
+	// DefaultBufferedBodyMemoryBytes if zero.
+	MemoryBytes int64
+
+	// TempDir is the directory temporary files are created in. Defaults to
+	// the directory returned by os.TempDir if empty.
+	TempDir string
+}
+
+// WithBufferedBody is a request option that buffers the request's body, if
+// the body is not seekable, such as an aws.ReadSeekCloser wrapping a pipe.
+// Once buffered, the body's payload hash can be computed when the request is
+// signed, and the body rewound to retry the request.
+//
+// Bodies larger than DefaultBufferedBodyMemoryBytes are spooled to a
+// temporary file, which is removed when the request completes. If the body
+// is larger than maxBytes the request fails with the
+// ErrCodeBufferedBodyTooLarge error code, without being sent.
+//
+//     pr, pw := io.Pipe()
+//     go produce(pw)
+//     _, err := svc.PutObjectWithContext(ctx, &s3.PutObjectInput{
+//         Bucket: aws.String("bucket"),
+//         Key:    aws.String("key"),
+//         Body:   aws.ReadSeekCloser(pr),
+//     }, request.WithBufferedBody(64*1024*1024))
+func WithBufferedBody(maxBytes int64) Option {
+	return func(r *Request) {
+		r.BufferedBody = &BufferedBody{MaxBytes: maxBytes}
+	}
+}
+
+// bufferBody buffers the request's body if the body is not seekable, and
+// the request's BufferedBody is set. Presigned requests are not sent with
+// their body, and their body is not buffered.
+func (r *Request) bufferBody() {
+	b := r.BufferedBody
+	if b == nil || r.Body == nil || aws.IsReaderSeekable(r.Body) || r.ExpireTime > 0 {
+		return
+	}
+
+	memBytes := b.MemoryBytes
+	if memBytes == 0 {
+		memBytes = DefaultBufferedBodyMemoryBytes
+	}
+	if memBytes > b.MaxBytes {
+		memBytes = b.MaxBytes
+	}
+
+	// One byte more than the limits is read, to know if the limits were
+	// exceeded.
+	var buf bytes.Buffer
+	n, err := io.Copy(&buf, io.LimitReader(r.Body, memBytes+1))
+	if err != nil {
+		r.Error = awserr.New(ErrCodeSerialization, "failed to buffer request body", err)
+		return
+	}
+	if n <= memBytes {
+		r.SetReaderBody(bytes.NewReader(buf.Bytes()))
+		return
+	}
+	if n > b.MaxBytes {
+		r.Error = bufferedBodyTooLargeError(b.MaxBytes)
+		return
+	}
+
+	f, err := ioutil.TempFile(b.TempDir, "aws-sdk-go-request-body")
+	if err != nil {
+		r.Error = awserr.New(ErrCodeSerialization, "failed to create request body buffer file", err)
+		return
+	}
+	// The file is removed once the request completes, or if the body fails
+	// to be buffered.
+	r.Handlers.Complete.PushBackNamed(NamedHandler{
+		Name: "core.BufferedBodyRemoveFile",
+		Fn: func(r *Request) {
+			f.Close()
+			os.Remove(f.Name())
+		},
+	})
+
+	remain := b.MaxBytes - n
+	m, err := io.Copy(f, io.MultiReader(&buf, io.LimitReader(r.Body, remain+1)))
+	if err == nil && m-n > remain {
+		r.Error = bufferedBodyTooLargeError(b.MaxBytes)
+		return
+	}
+	if err == nil {
+		_, err = f.Seek(0, 0)
+	}
+	if err != nil {
+		r.Error = awserr.New(ErrCodeSerialization, "failed to buffer request body", err)
+		return
+	}
+
+	r.SetReaderBody(f)
+}
+
+func bufferedBodyTooLargeError(maxBytes int64) error {
+	return awserr.New(ErrCodeBufferedBodyTooLarge,
+		fmt.Sprintf("request body exceeds %d bytes buffered", maxBytes), nil)
+}
@@ -0,0 +1,219 @@
+package request_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// newPipeBody returns a body backed by a pipe, which is not seekable, with
+// the payload written to the pipe.
+func newPipeBody(payload []byte) io.ReadSeeker {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write(payload)
+		pw.Close()
+	}()
+	return aws.ReadSeekCloser(pr)
+}
+
+type bufferedBodyServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	payloads [][]byte
+	hashes   []string
+}
+
+// newBufferedBodyServer returns a server failing the first attempt with a 500
+// status code, and recording the payload of each attempt.
+func newBufferedBodyServer() *bufferedBodyServer {
+	s := &bufferedBodyServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+
+		s.mu.Lock()
+		s.payloads = append(s.payloads, b)
+		s.hashes = append(s.hashes, r.Header.Get("X-Amz-Content-Sha256"))
+		attempt := len(s.payloads)
+		s.mu.Unlock()
+
+		if attempt == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	return s
+}
+
+func newBufferedBodyClient(endpoint string) *s3.S3 {
+	return s3.New(unit.Session, &aws.Config{
+		Endpoint:         aws.String(endpoint),
+		S3ForcePathStyle: aws.Bool(true),
+		MaxRetries:       aws.Int(1),
+		SleepDelay:       func(d time.Duration) {},
+	})
+}
+
+func TestWithBufferedBody_Retry(t *testing.T) {
+	payload := bytes.Repeat([]byte("0123456789"), 100)
+	sum := sha256.Sum256(payload)
+	hash := hex.EncodeToString(sum[:])
+
+	cases := map[string]struct {
+		Options     []request.Option
+		ExpectCode  string
+		ExpectSends int
+	}{
+		"not buffered": {
+			ExpectCode:  request.ErrCodeBodyNotRetryable,
+			ExpectSends: 1,
+		},
+		"memory": {
+			Options:     []request.Option{request.WithBufferedBody(1024)},
+			ExpectSends: 2,
+		},
+		"file": {
+			Options: []request.Option{
+				request.WithBufferedBody(1024),
+				func(r *request.Request) { r.BufferedBody.MemoryBytes = 10 },
+			},
+			ExpectSends: 2,
+		},
+	}
+
+	for name, c := range cases {
+		server := newBufferedBodyServer()
+		svc := newBufferedBodyClient(server.URL)
+
+		dir, err := ioutil.TempDir("", "buffered-body")
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		opts := append(c.Options, func(r *request.Request) {
+			if r.BufferedBody != nil {
+				r.BufferedBody.TempDir = dir
+			}
+		})
+
+		_, err = svc.PutObjectWithContext(aws.BackgroundContext(), &s3.PutObjectInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+			Body:   newPipeBody(payload),
+		}, opts...)
+		server.Close()
+
+		if len(c.ExpectCode) != 0 {
+			aerr, ok := err.(awserr.Error)
+			if !ok || aerr.Code() != c.ExpectCode {
+				t.Errorf("%s, expect %v error, got %v", name, c.ExpectCode, err)
+			}
+		} else if err != nil {
+			t.Errorf("%s, expect no error, got %v", name, err)
+		}
+
+		if e, a := c.ExpectSends, len(server.payloads); e != a {
+			t.Fatalf("%s, expect %d attempts, got %d", name, e, a)
+		}
+		if len(c.ExpectCode) == 0 {
+			for i, b := range server.payloads {
+				if !bytes.Equal(payload, b) {
+					t.Errorf("%s, %d, expect payload sent, got %d bytes", name, i, len(b))
+				}
+				if e, a := hash, server.hashes[i]; e != a {
+					t.Errorf("%s, %d, expect %v payload hash, got %v", name, i, e, a)
+				}
+			}
+		}
+
+		assertDirEmpty(t, name, dir)
+	}
+}
+
+func TestWithBufferedBody_TooLarge(t *testing.T) {
+	for name, memBytes := range map[string]int64{"memory": 0, "file": 10} {
+		server := newBufferedBodyServer()
+		svc := newBufferedBodyClient(server.URL)
+
+		dir, err := ioutil.TempDir("", "buffered-body")
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		_, err = svc.PutObjectWithContext(aws.BackgroundContext(), &s3.PutObjectInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+			Body:   newPipeBody(bytes.Repeat([]byte("a"), 100)),
+		}, request.WithBufferedBody(99), func(r *request.Request) {
+			r.BufferedBody.MemoryBytes = memBytes
+			r.BufferedBody.TempDir = dir
+		})
+		server.Close()
+
+		aerr, ok := err.(awserr.Error)
+		if !ok || aerr.Code() != request.ErrCodeBufferedBodyTooLarge {
+			t.Errorf("%s, expect %v error, got %v", name, request.ErrCodeBufferedBodyTooLarge, err)
+		}
+		if e, a := 0, len(server.payloads); e != a {
+			t.Errorf("%s, expect %d attempts, got %d", name, e, a)
+		}
+		assertDirEmpty(t, name, dir)
+	}
+}
+
+func TestWithBufferedBody_Canceled(t *testing.T) {
+	server := newBufferedBodyServer()
+	defer server.Close()
+	svc := newBufferedBodyClient(server.URL)
+
+	dir, err := ioutil.TempDir("", "buffered-body")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	ctx := &awstesting.FakeContext{DoneCh: make(chan struct{})}
+	ctx.Error = fmt.Errorf("context canceled")
+	close(ctx.DoneCh)
+
+	_, err = svc.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   newPipeBody(bytes.Repeat([]byte("a"), 100)),
+	}, request.WithBufferedBody(1024), func(r *request.Request) {
+		r.BufferedBody.MemoryBytes = 10
+		r.BufferedBody.TempDir = dir
+	})
+
+	aerr, ok := err.(awserr.Error)
+	if !ok || aerr.Code() != request.CanceledErrorCode {
+		t.Errorf("expect %v error, got %v", request.CanceledErrorCode, err)
+	}
+	assertDirEmpty(t, "canceled", dir)
+}
+
+func assertDirEmpty(t *testing.T, name, dir string) {
+	defer os.RemoveAll(dir)
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("%s, expect no error, got %v", name, err)
+	}
+	if e, a := 0, len(files); e != a {
+		t.Errorf("%s, expect %d temporary files, got %d", name, e, a)
+	}
+}
@@ -0,0 +1,168 @@
+package request_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+)
+
+// newSigV4Server returns a server responding with 200 to requests signed with
+// the credentials, and 403 otherwise. The request's signature is verified by
+// signing the received request again. The payload of the last request
+// received is written to payload.
+func newSigV4Server(t *testing.T, creds *credentials.Credentials, service, region string, payload *[]byte) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		signTime, err := time.Parse("20060102T150405Z", r.Header.Get("X-Amz-Date"))
+		if err != nil {
+			t.Errorf("expect valid X-Amz-Date, got %v", err)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("expect no error reading body, got %v", err)
+		}
+		*payload = b
+
+		req, _ := http.NewRequest(r.Method, "http://"+r.Host+r.URL.RequestURI(), nil)
+		i := strings.Index(auth, "SignedHeaders=")
+		j := strings.Index(auth, ", Signature=")
+		if i < 0 || j < i {
+			t.Errorf("expect signed headers in Authorization, got %q", auth)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		for _, h := range strings.Split(auth[i+len("SignedHeaders="):j], ";") {
+			switch h {
+			case "host":
+			case "content-length":
+				req.Header.Set("Content-Length", strconv.FormatInt(r.ContentLength, 10))
+			default:
+				req.Header[http.CanonicalHeaderKey(h)] = r.Header[http.CanonicalHeaderKey(h)]
+			}
+		}
+
+		signer := v4.NewSigner(creds)
+		if _, err := signer.Sign(req, bytes.NewReader(b), service, region, signTime); err != nil {
+			t.Errorf("expect no error signing, got %v", err)
+		}
+		if e, a := req.Header.Get("Authorization"), auth; e != a {
+			t.Errorf("expect %q Authorization, got %q", e, a)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+	}))
+}
+
+func TestRequest_BuildAndSign(t *testing.T) {
+	creds := credentials.NewStaticCredentials("AKID", "SECRET", "SESSION")
+	var payload []byte
+	server := newSigV4Server(t, creds, "mock", "mock-region", &payload)
+	defer server.Close()
+
+	cases := map[string]struct {
+		Body       []byte
+		BodyStart  int64
+		ExpectBody []byte
+	}{
+		"no body": {},
+		"body": {
+			Body:       []byte(`{"Key":"Value"}`),
+			ExpectBody: []byte(`{"Key":"Value"}`),
+		},
+		"streaming body offset": {
+			Body:       []byte("skipped streaming payload"),
+			BodyStart:  8,
+			ExpectBody: []byte("streaming payload"),
+		},
+	}
+
+	for name, c := range cases {
+		def := defaults.Get()
+		def.Config.MergeIn(&aws.Config{
+			Region:      aws.String("mock-region"),
+			Credentials: creds,
+		})
+		def.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+		svc := client.New(*def.Config, metadata.ClientInfo{
+			ServiceName: "mock",
+			Endpoint:    server.URL,
+		}, def.Handlers)
+
+		r := svc.NewRequest(&request.Operation{
+			Name:       "Operation",
+			HTTPMethod: "PUT",
+			HTTPPath:   "/path",
+		}, nil, nil)
+		if c.Body != nil {
+			body := bytes.NewReader(c.Body)
+			body.Seek(c.BodyStart, 0)
+			r.SetReaderBody(body)
+			r.BodyStart = c.BodyStart
+			r.ResetBody()
+		}
+
+		httpReq, body, err := r.BuildAndSign()
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := c.Body != nil, body != nil; e != a {
+			t.Errorf("%s, expect body %t, got %t", name, e, a)
+		}
+
+		resp, err := http.DefaultClient.Do(httpReq)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		resp.Body.Close()
+		if e, a := http.StatusOK, resp.StatusCode; e != a {
+			t.Errorf("%s, expect %d status code, got %d", name, e, a)
+		}
+		if e, a := c.ExpectBody, payload; !bytes.Equal(e, a) {
+			t.Errorf("%s, expect %q body, got %q", name, e, a)
+		}
+
+		err = r.Send()
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			t.Fatalf("%s, expect awserr.Error, got %T, %v", name, err, err)
+		}
+		if e, a := request.ErrCodeRequestBuiltAndSigned, aerr.Code(); e != a {
+			t.Errorf("%s, expect %q error code, got %q", name, e, a)
+		}
+	}
+}
+
+func TestRequest_BuildAndSign_ValidateError(t *testing.T) {
+	svc := client.New(aws.Config{Region: aws.String("mock-region")},
+		metadata.ClientInfo{ServiceName: "mock", Endpoint: "http://endpoint"},
+		request.Handlers{})
+	r := svc.NewRequest(&request.Operation{Name: "Operation", HTTPPath: "/"}, nil, nil)
+	r.Handlers.Validate.PushBack(func(r *request.Request) {
+		r.Error = awserr.New("ValidateError", "invalid", nil)
+	})
+
+	httpReq, body, err := r.BuildAndSign()
+	if err == nil {
+		t.Fatalf("expect error")
+	}
+	if httpReq != nil || body != nil {
+		t.Errorf("expect no HTTP request or body, got %v, %v", httpReq, body)
+	}
+}
@@ -0,0 +1,120 @@
+package request
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// DefaultMaxErrorResponseBodySize is the default maximum number of bytes of
+// an error response's body read when unmarshaling the error.
+const DefaultMaxErrorResponseBodySize int64 = 256 * 1024
+
+// errorResponseBodyDrainSize is the maximum number of bytes of an error
+// response's body discarded after the body's size limit is reached, so that
+// the connection can be reused.
+const errorResponseBodyDrainSize int64 = 256 * 1024
+
+// errorResponseBody limits the number of bytes read from an error response's
+// body, and records if the body was truncated.
+type errorResponseBody struct {
+	body      io.ReadCloser
+	reader    *io.LimitedReader
+	limit     int64
+	truncated bool
+	closed    bool
+}
+
+func newErrorResponseBody(body io.ReadCloser, limit int64) *errorResponseBody {
+	return &errorResponseBody{
+		body:   body,
+		reader: &io.LimitedReader{R: body, N: limit},
+		limit:  limit,
+	}
+}
+
+func (b *errorResponseBody) Read(p []byte) (int, error) {
+	n, err := b.reader.Read(p)
+	if err == io.EOF && b.reader.N == 0 && !b.truncated {
+		// The limit was reached, the body is truncated if it has more bytes.
+		var one [1]byte
+		if m, _ := io.ReadFull(b.body, one[:]); m != 0 {
+			b.truncated = true
+		}
+	}
+
+	return n, err
+}
+
+// Close discards up to errorResponseBodyDrainSize bytes of the remaining
+// body before closing it.
+func (b *errorResponseBody) Close() error {
+	if b.closed {
+		return nil
+	}
+	b.closed = true
+
+	io.CopyN(ioutil.Discard, b.body, errorResponseBodyDrainSize)
+	return b.body.Close()
+}
+
+// maxErrorResponseBodySize returns the maximum number of bytes of the error
+// response's body to read, or zero if the body should not be limited.
+func (r *Request) maxErrorResponseBodySize() int64 {
+	if r.Config.MaxErrorResponseBodySize == nil {
+		return DefaultMaxErrorResponseBodySize
+	}
+	if size := aws.Int64Value(r.Config.MaxErrorResponseBodySize); size > 0 {
+		return size
+	}
+	return 0
+}
+
+// limitErrorResponseBody wraps the HTTP response's body so the UnmarshalError
+// handlers cannot read more than the maximum error response body size.
+func (r *Request) limitErrorResponseBody() *errorResponseBody {
+	limit := r.maxErrorResponseBodySize()
+	if limit == 0 || r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+		return nil
+	}
+
+	body := newErrorResponseBody(r.HTTPResponse.Body, limit)
+	r.HTTPResponse.Body = body
+
+	return body
+}
+
+// noteTruncatedErrorResponseBody replaces the request's error with a
+// RequestFailure whose message notes that the error response's body was
+// truncated.
+func (r *Request) noteTruncatedErrorResponseBody(body *errorResponseBody) {
+	if body == nil || !body.truncated || r.Error == nil {
+		return
+	}
+
+	note := fmt.Sprintf("error response body truncated to %d bytes", body.limit)
+	statusCode, reqID := r.HTTPResponse.StatusCode, r.RequestID
+	var code, msg string
+	var origErr error
+	switch err := r.Error.(type) {
+	case awserr.RequestFailure:
+		code, msg, origErr = err.Code(), err.Message(), err.OrigErr()
+		statusCode, reqID = err.StatusCode(), err.RequestID()
+	case awserr.Error:
+		code, msg, origErr = err.Code(), err.Message(), err.OrigErr()
+	default:
+		code, msg, origErr = ErrCodeSerialization, err.Error(), nil
+	}
+
+	if len(msg) != 0 {
+		msg += ", "
+	}
+	r.Error = awserr.NewRequestFailure(
+		awserr.New(code, msg+note, origErr),
+		statusCode,
+		reqID,
+	)
+}
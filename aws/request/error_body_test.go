@@ -0,0 +1,145 @@
+package request_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+)
+
+type countingBody struct {
+	*bytes.Reader
+	read   int
+	closed bool
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	b.read += n
+	return n, err
+}
+
+func (b *countingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestMaxErrorResponseBodySize(t *testing.T) {
+	cases := map[string]struct {
+		Config          *aws.Config
+		BodySize        int
+		ExpectRead      int
+		ExpectDrained   int
+		ExpectTruncated bool
+	}{
+		"default": {
+			Config:     &aws.Config{},
+			BodySize:   1024,
+			ExpectRead: 1024,
+		},
+		"default truncated": {
+			Config:          &aws.Config{},
+			BodySize:        1024 * 1024,
+			ExpectRead:      int(request.DefaultMaxErrorResponseBodySize),
+			ExpectDrained:   2*int(request.DefaultMaxErrorResponseBodySize) + 1,
+			ExpectTruncated: true,
+		},
+		"limit": {
+			Config:          aws.NewConfig().WithMaxErrorResponseBodySize(100),
+			BodySize:        1024,
+			ExpectRead:      100,
+			ExpectDrained:   1024,
+			ExpectTruncated: true,
+		},
+		"limit equal to body": {
+			Config:     aws.NewConfig().WithMaxErrorResponseBodySize(1024),
+			BodySize:   1024,
+			ExpectRead: 1024,
+		},
+		"disabled": {
+			Config:     aws.NewConfig().WithMaxErrorResponseBodySize(-1),
+			BodySize:   1024 * 1024,
+			ExpectRead: 1024 * 1024,
+		},
+	}
+
+	for name, c := range cases {
+		respBody := &countingBody{
+			Reader: bytes.NewReader([]byte(strings.Repeat("a", c.BodySize))),
+		}
+
+		var read int
+		svc := awstesting.NewClient(c.Config.Copy().
+			WithRegion("mock-region").
+			WithMaxRetries(0))
+		svc.Handlers.Send.Clear()
+		svc.Handlers.Send.PushBack(func(r *request.Request) {
+			r.HTTPResponse = &http.Response{
+				StatusCode: 500,
+				Status:     "500 Internal Server Error",
+				Header:     http.Header{},
+				Body:       respBody,
+			}
+			r.RequestID = "request-id"
+		})
+		svc.Handlers.UnmarshalError.PushBack(func(r *request.Request) {
+			defer r.HTTPResponse.Body.Close()
+			b, _ := ioutil.ReadAll(r.HTTPResponse.Body)
+			read = len(b)
+			r.Error = awserr.New("UnmarshalError", "failed decoding error", nil)
+		})
+
+		req := svc.NewRequest(&request.Operation{Name: "Operation"}, nil, &testData{})
+		err := req.Send()
+
+		if e, a := c.ExpectRead, read; e != a {
+			t.Errorf("%s, expect %d bytes read, got %d", name, e, a)
+		}
+		if c.ExpectDrained == 0 {
+			c.ExpectDrained = c.ExpectRead
+		}
+		// The byte read to check for truncation is also drained.
+		if c.ExpectTruncated {
+			c.ExpectRead++
+		}
+		if respBody.read < c.ExpectRead || respBody.read > c.ExpectDrained {
+			t.Errorf("%s, expect between %d and %d bytes drained, got %d",
+				name, c.ExpectRead, c.ExpectDrained, respBody.read)
+		}
+		if !respBody.closed {
+			t.Errorf("%s, expect body to be closed", name)
+		}
+
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			t.Fatalf("%s, expect awserr.Error, got %T", name, err)
+		}
+		if e, a := "UnmarshalError", aerr.Code(); e != a {
+			t.Errorf("%s, expect %q error code, got %q", name, e, a)
+		}
+		truncated := strings.Contains(aerr.Message(), "error response body truncated")
+		if e, a := c.ExpectTruncated, truncated; e != a {
+			t.Errorf("%s, expect truncated %t, got %t, %q", name, e, a, aerr.Message())
+		}
+		if !c.ExpectTruncated {
+			continue
+		}
+
+		reqErr, ok := err.(awserr.RequestFailure)
+		if !ok {
+			t.Fatalf("%s, expect awserr.RequestFailure, got %T", name, err)
+		}
+		if e, a := 500, reqErr.StatusCode(); e != a {
+			t.Errorf("%s, expect %d status code, got %d", name, e, a)
+		}
+		if e, a := "request-id", reqErr.RequestID(); e != a {
+			t.Errorf("%s, expect %q request id, got %q", name, e, a)
+		}
+	}
+}
@@ -7,6 +7,17 @@ import (
 
 // A Handlers provides a collection of request handlers for various
 // stages of handling requests.
+//
+// The handler lists are copied when a service client is created, and when
+// a request is created from the client. Modifying a client's handler lists
+// does not affect requests already created, and modifying a request's
+// handler lists does not affect the client's other requests. A client's
+// handler lists should not be modified while the client is being used to
+// create requests concurrently.
+//
+// Copies of a handler list share the list's handlers until the copy is
+// modified, so that copying the handlers of a client for each request does
+// not allocate.
 type Handlers struct {
 	Validate         HandlerList
 	Build            HandlerList
@@ -65,6 +76,13 @@ type HandlerListRunItem struct {
 type HandlerList struct {
 	list []NamedHandler
 
+	// Set if the list's backing array was allocated by the list, and
+	// handlers may be appended to it in place. The backing array may be
+	// shared with copies of the list, which only refer to the handlers up
+	// to the copy's length. Handlers in the backing array are never
+	// modified, a new array is allocated instead.
+	owned bool
+
 	// Called after each request handler in the list is called. If set
 	// and the func returns true the HandlerList will continue to iterate
 	// over the request handlers. If false is returned the HandlerList
@@ -83,22 +101,32 @@ type NamedHandler struct {
 	Fn   func(*Request)
 }
 
-// copy creates a copy of the handler list.
+// handlerListGrowth is the number of handlers a list's backing array has
+// room for when the array is allocated to push handlers to the list.
+const handlerListGrowth = 5
+
+// copy creates a copy of the handler list. The copy shares the list's
+// backing array until either list is modified.
 func (l *HandlerList) copy() HandlerList {
-	n := HandlerList{
+	return HandlerList{
+		list:        l.list,
 		AfterEachFn: l.AfterEachFn,
 	}
-	if len(l.list) == 0 {
-		return n
-	}
+}
 
-	n.list = append(make([]NamedHandler, 0, len(l.list)), l.list...)
-	return n
+// clone replaces the list's backing array with a new array owned by the
+// list, with room for extra more handlers.
+func (l *HandlerList) clone(extra int) {
+	list := make([]NamedHandler, len(l.list), len(l.list)+extra)
+	copy(list, l.list)
+	l.list = list
+	l.owned = true
 }
 
 // Clear clears the handler list.
 func (l *HandlerList) Clear() {
-	l.list = l.list[0:0]
+	l.list = nil
+	l.owned = false
 }
 
 // Len returns the number of handlers in the list.
@@ -113,8 +141,8 @@ func (l *HandlerList) PushBack(f func(*Request)) {
 
 // PushBackNamed pushes named handler f to the back of the handler list.
 func (l *HandlerList) PushBackNamed(n NamedHandler) {
-	if cap(l.list) == 0 {
-		l.list = make([]NamedHandler, 0, 5)
+	if !l.owned {
+		l.clone(handlerListGrowth)
 	}
 	l.list = append(l.list, n)
 }
@@ -126,15 +154,47 @@ func (l *HandlerList) PushFront(f func(*Request)) {
 
 // PushFrontNamed pushes named handler f to the front of the handler list.
 func (l *HandlerList) PushFrontNamed(n NamedHandler) {
-	if cap(l.list) == len(l.list) {
-		// Allocating new list required
-		l.list = append([]NamedHandler{n}, l.list...)
-	} else {
-		// Enough room to prepend into list.
-		l.list = append(l.list, NamedHandler{})
-		copy(l.list[1:], l.list)
-		l.list[0] = n
+	l.insert(0, n)
+}
+
+// InsertBeforeNamed inserts the named handler n before the first handler in
+// the list named name, returning true if a handler named name was found. The
+// list is not modified otherwise.
+func (l *HandlerList) InsertBeforeNamed(name string, n NamedHandler) bool {
+	for i := 0; i < len(l.list); i++ {
+		if l.list[i].Name == name {
+			l.insert(i, n)
+			return true
+		}
 	}
+
+	return false
+}
+
+// InsertAfterNamed inserts the named handler n after the first handler in
+// the list named name, returning true if a handler named name was found. The
+// list is not modified otherwise.
+func (l *HandlerList) InsertAfterNamed(name string, n NamedHandler) bool {
+	for i := 0; i < len(l.list); i++ {
+		if l.list[i].Name == name {
+			l.insert(i+1, n)
+			return true
+		}
+	}
+
+	return false
+}
+
+// insert inserts the named handler n at index i of the list. A new list is
+// always allocated, so that the list's previous handlers are not modified.
+func (l *HandlerList) insert(i int, n NamedHandler) {
+	list := make([]NamedHandler, 0, len(l.list)+1)
+	list = append(list, l.list[:i]...)
+	list = append(list, n)
+	list = append(list, l.list[i:]...)
+
+	l.list = list
+	l.owned = true
 }
 
 // Remove removes a NamedHandler n
@@ -145,16 +205,23 @@ func (l *HandlerList) Remove(n NamedHandler) {
 // RemoveByName removes a NamedHandler by name.
 func (l *HandlerList) RemoveByName(name string) {
 	for i := 0; i < len(l.list); i++ {
-		m := l.list[i]
-		if m.Name == name {
-			// Shift array preventing creating new arrays
-			copy(l.list[i:], l.list[i+1:])
-			l.list[len(l.list)-1] = NamedHandler{}
-			l.list = l.list[:len(l.list)-1]
-
-			// decrement list so next check to length is correct
-			i--
+		if l.list[i].Name != name {
+			continue
 		}
+
+		// A new list is allocated, so that the list's previous handlers,
+		// which may be shared with copies of the list, are not modified.
+		list := make([]NamedHandler, i, len(l.list))
+		copy(list, l.list[:i])
+		for _, m := range l.list[i+1:] {
+			if m.Name != name {
+				list = append(list, m)
+			}
+		}
+
+		l.list = list
+		l.owned = true
+		return
 	}
 }
 
@@ -164,6 +231,11 @@ func (l *HandlerList) RemoveByName(name string) {
 func (l *HandlerList) SwapNamed(n NamedHandler) (swapped bool) {
 	for i := 0; i < len(l.list); i++ {
 		if l.list[i].Name == n.Name {
+			if !swapped {
+				// The list's previous handlers may be shared with copies
+				// of the list, and are not modified.
+				l.clone(0)
+			}
 			l.list[i].Fn = n.Fn
 			swapped = true
 		}
@@ -223,11 +295,14 @@ func HandlerListStopOnError(item HandlerListRunItem) bool {
 }
 
 // WithAppendUserAgent will add a string to the user agent prefixed with a
-// single white space.
+// single white space. The string is added after the SDK's user agent, and
+// the aws.Config.AppID application ID.
+//
+//     svc.PutObjectWithContext(ctx, params, request.WithAppendUserAgent("my-feature/1.0"))
 func WithAppendUserAgent(s string) Option {
 	return func(r *Request) {
 		r.Handlers.Build.PushBack(func(r2 *Request) {
-			AddToUserAgent(r, s)
+			AddToUserAgent(r2, s)
 		})
 	}
 }
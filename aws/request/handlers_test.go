@@ -1,7 +1,9 @@
 package request_test
 
 import (
+	"fmt"
 	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -197,6 +199,202 @@ func TestStopHandlers(t *testing.T) {
 	}
 }
 
+func TestInsertNamed(t *testing.T) {
+	cases := map[string]struct {
+		Insert       func(l *request.HandlerList, n request.NamedHandler) bool
+		ExpectFound  bool
+		ExpectCalled []string
+	}{
+		"before": {
+			Insert: func(l *request.HandlerList, n request.NamedHandler) bool {
+				return l.InsertBeforeNamed("sign", n)
+			},
+			ExpectFound:  true,
+			ExpectCalled: []string{"build", "inserted", "sign", "send", "sign"},
+		},
+		"after": {
+			Insert: func(l *request.HandlerList, n request.NamedHandler) bool {
+				return l.InsertAfterNamed("sign", n)
+			},
+			ExpectFound:  true,
+			ExpectCalled: []string{"build", "sign", "inserted", "send", "sign"},
+		},
+		"before first": {
+			Insert: func(l *request.HandlerList, n request.NamedHandler) bool {
+				return l.InsertBeforeNamed("build", n)
+			},
+			ExpectFound:  true,
+			ExpectCalled: []string{"inserted", "build", "sign", "send", "sign"},
+		},
+		"after last": {
+			Insert: func(l *request.HandlerList, n request.NamedHandler) bool {
+				return l.InsertAfterNamed("send", n)
+			},
+			ExpectFound:  true,
+			ExpectCalled: []string{"build", "sign", "send", "inserted", "sign"},
+		},
+		"not found": {
+			Insert: func(l *request.HandlerList, n request.NamedHandler) bool {
+				return l.InsertAfterNamed("unknown", n)
+			},
+			ExpectCalled: []string{"build", "sign", "send", "sign"},
+		},
+	}
+
+	for name, c := range cases {
+		var called []string
+		record := func(name string) request.NamedHandler {
+			return request.NamedHandler{Name: name, Fn: func(r *request.Request) {
+				called = append(called, name)
+			}}
+		}
+
+		l := request.HandlerList{}
+		l.PushBackNamed(record("build"))
+		l.PushBackNamed(record("sign"))
+		l.PushBackNamed(record("send"))
+		// Handlers are inserted relative to the first handler with the name.
+		l.PushBackNamed(record("sign"))
+		orig := l.Len()
+
+		if e, a := c.ExpectFound, c.Insert(&l, record("inserted")); e != a {
+			t.Errorf("%s, expect found %t, got %t", name, e, a)
+		}
+
+		l.Run(&request.Request{})
+		if e, a := c.ExpectCalled, called; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v handlers called, got %v", name, e, a)
+		}
+		if e, a := len(c.ExpectCalled), l.Len(); e != a {
+			t.Errorf("%s, expect %d list length, got %d", name, e, a)
+		}
+		if c.ExpectFound && orig+1 != l.Len() {
+			t.Errorf("%s, expect list length to grow by one, got %d", name, l.Len())
+		}
+	}
+}
+
+func TestInsertNamed_Copy(t *testing.T) {
+	var called []string
+	record := func(name string) request.NamedHandler {
+		return request.NamedHandler{Name: name, Fn: func(r *request.Request) {
+			called = append(called, name)
+		}}
+	}
+
+	handlers := request.Handlers{}
+	handlers.Send.PushBackNamed(record("sign"))
+	handlers.Send.PushBackNamed(record("send"))
+	handlers.Send.InsertAfterNamed("sign", record("instrument"))
+
+	// Copies of the handlers, such as a client's copy, keep the inserted
+	// handler, and insertions into the copy do not modify the original.
+	cp := handlers.Copy()
+	if e, a := handlers.Send.Len(), cp.Send.Len(); e != a {
+		t.Fatalf("expect %d list length, got %d", e, a)
+	}
+	cp.Send.InsertBeforeNamed("send", record("copy"))
+
+	handlers.Send.Run(&request.Request{})
+	if e, a := []string{"sign", "instrument", "send"}, called; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v handlers called, got %v", e, a)
+	}
+
+	called = nil
+	cp.Send.Run(&request.Request{})
+	if e, a := []string{"sign", "instrument", "copy", "send"}, called; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v handlers called, got %v", e, a)
+	}
+}
+
+func TestHandlers_CopyOnWrite(t *testing.T) {
+	var mu sync.Mutex
+	called := map[string][]string{}
+	record := func(name string) request.NamedHandler {
+		return request.NamedHandler{Name: name, Fn: func(r *request.Request) {
+			id := r.Operation.Name
+			mu.Lock()
+			called[id] = append(called[id], name)
+			mu.Unlock()
+		}}
+	}
+
+	client := request.Handlers{}
+	client.Send.PushBackNamed(record("a"))
+	client.Send.PushBackNamed(record("b"))
+	client.Send.PushBackNamed(record("c"))
+
+	// Requests modifying their copies of the handlers concurrently do not
+	// modify the client's, or each other's handlers.
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			id := fmt.Sprintf("request%d", i)
+			r := &request.Request{
+				Operation: &request.Operation{Name: id},
+				Handlers:  client.Copy(),
+			}
+			switch i % 5 {
+			case 0:
+				r.Handlers.Send.PushBackNamed(record(id))
+			case 1:
+				r.Handlers.Send.PushFrontNamed(record(id))
+			case 2:
+				r.Handlers.Send.InsertAfterNamed("a", record(id))
+			case 3:
+				r.Handlers.Send.RemoveByName("b")
+				r.Handlers.Send.PushBackNamed(record(id))
+			case 4:
+				r.Handlers.Send.SwapNamed(record(id))
+				r.Handlers.Send.SetBackNamed(request.NamedHandler{Name: "c", Fn: record(id).Fn})
+			}
+			r.Handlers.Send.Run(r)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < 20; i++ {
+		id := fmt.Sprintf("request%d", i)
+		var expect []string
+		switch i % 5 {
+		case 0:
+			expect = []string{"a", "b", "c", id}
+		case 1:
+			expect = []string{id, "a", "b", "c"}
+		case 2:
+			expect = []string{"a", id, "b", "c"}
+		case 3:
+			expect = []string{"a", "c", id}
+		case 4:
+			expect = []string{"a", "b", id}
+		}
+		if e, a := expect, called[id]; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v handlers called, got %v", id, e, a)
+		}
+	}
+
+	called = map[string][]string{}
+	r := &request.Request{Operation: &request.Operation{Name: "client"}}
+	client.Send.Run(r)
+	if e, a := []string{"a", "b", "c"}, called["client"]; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v client handlers called, got %v", e, a)
+	}
+
+	// Modifying the client's handlers does not modify the handlers of
+	// requests already created.
+	cp := client.Copy()
+	client.Send.PushBackNamed(record("d"))
+	client.Send.RemoveByName("a")
+	called = map[string][]string{}
+	r.Handlers = cp
+	r.Handlers.Send.Run(r)
+	if e, a := []string{"a", "b", "c"}, called["client"]; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v copied handlers called, got %v", e, a)
+	}
+}
+
 func BenchmarkNewRequest(b *testing.B) {
 	svc := s3.New(unit.Session)
 
@@ -220,6 +418,7 @@ func BenchmarkHandlersCopy(b *testing.B) {
 	handlers.Unmarshal.PushBack(func(r *request.Request) {})
 	handlers.Unmarshal.PushBack(func(r *request.Request) {})
 
+	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
 		h := handlers.Copy()
 		if e, a := handlers.Validate.Len(), h.Validate.Len(); e != a {
@@ -228,6 +427,24 @@ func BenchmarkHandlersCopy(b *testing.B) {
 	}
 }
 
+// BenchmarkHandlersCopyPushBack benchmarks copying a client's handlers for a
+// request which adds a handler to one of its lists.
+func BenchmarkHandlersCopyPushBack(b *testing.B) {
+	handlers := request.Handlers{}
+	for i := 0; i < 4; i++ {
+		handlers.Validate.PushBack(func(r *request.Request) {})
+		handlers.Build.PushBack(func(r *request.Request) {})
+		handlers.Send.PushBack(func(r *request.Request) {})
+		handlers.Unmarshal.PushBack(func(r *request.Request) {})
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		h := handlers.Copy()
+		h.Build.PushBack(func(r *request.Request) {})
+	}
+}
+
 func BenchmarkHandlersPushBack(b *testing.B) {
 	handlers := request.Handlers{}
 
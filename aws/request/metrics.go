@@ -0,0 +1,96 @@
+package request
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// metricsInfo returns the information identifying the request's metrics.
+func (r *Request) metricsInfo() aws.RequestMetricsInfo {
+	info := aws.RequestMetricsInfo{
+		ServiceName: r.ClientInfo.ServiceName,
+		ServiceID:   r.ClientInfo.ServiceID,
+		Region:      aws.StringValue(r.Config.Region),
+		RequestID:   r.RequestID,
+	}
+	if r.Operation != nil {
+		info.Operation = r.Operation.Name
+	}
+	return info
+}
+
+// metricsTime returns the current time if the request's metrics are
+// collected, otherwise the zero time.
+func (r *Request) metricsTime() time.Time {
+	if r.Config.RequestMetricsCollector == nil {
+		return time.Time{}
+	}
+	return time.Now()
+}
+
+// attemptStarted reports the request's attempt started, returning the time
+// it started at.
+func (r *Request) attemptStarted() time.Time {
+	c := r.Config.RequestMetricsCollector
+	if c == nil {
+		return time.Time{}
+	}
+
+	m := aws.AttemptStartedMetrics{
+		RequestMetricsInfo: r.metricsInfo(),
+		Attempt:            r.RetryCount,
+		Time:               time.Now(),
+	}
+	// The request ID is of the previous attempt's response.
+	m.RequestID = ""
+	c.AttemptStarted(m)
+
+	return m.Time
+}
+
+// attemptCompleted reports the request's attempt completed with err. The
+// attempt will be retried if the attempt failed, and the request's error
+// was cleared by the request's retry handlers.
+func (r *Request) attemptCompleted(attempt int, start, end time.Time, err error) {
+	c := r.Config.RequestMetricsCollector
+	if c == nil {
+		return
+	}
+
+	m := aws.AttemptCompletedMetrics{
+		RequestMetricsInfo: r.metricsInfo(),
+		Attempt:            attempt,
+		Latency:            end.Sub(start),
+		StatusCode:         r.statusCode(),
+		Err:                err,
+		WillRetry:          err != nil && r.Error == nil,
+	}
+	if m.WillRetry {
+		m.RetryDelay = r.RetryDelay
+	}
+	c.AttemptCompleted(m)
+}
+
+// requestCompleted reports the request completed after attempts.
+func (r *Request) requestCompleted(attempts int, start time.Time) {
+	c := r.Config.RequestMetricsCollector
+	if c == nil {
+		return
+	}
+
+	c.RequestCompleted(aws.RequestCompletedMetrics{
+		RequestMetricsInfo: r.metricsInfo(),
+		Attempts:           attempts,
+		Latency:            time.Since(start),
+		StatusCode:         r.statusCode(),
+		Err:                r.Error,
+	})
+}
+
+func (r *Request) statusCode() int {
+	if r.HTTPResponse == nil {
+		return 0
+	}
+	return r.HTTPResponse.StatusCode
+}
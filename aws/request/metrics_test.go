@@ -0,0 +1,202 @@
+package request_test
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+)
+
+type mockMetricsCollector struct {
+	mu        sync.Mutex
+	started   []aws.AttemptStartedMetrics
+	completed []aws.AttemptCompletedMetrics
+	requests  []aws.RequestCompletedMetrics
+}
+
+func (c *mockMetricsCollector) AttemptStarted(m aws.AttemptStartedMetrics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.started = append(c.started, m)
+}
+
+func (c *mockMetricsCollector) AttemptCompleted(m aws.AttemptCompletedMetrics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.completed = append(c.completed, m)
+}
+
+func (c *mockMetricsCollector) RequestCompleted(m aws.RequestCompletedMetrics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requests = append(c.requests, m)
+}
+
+func newMetricsTestRequest(collector aws.RequestMetricsCollector, statusCodes []int) *request.Request {
+	s := awstesting.NewClient(aws.NewConfig().
+		WithRegion("mock-region").
+		WithMaxRetries(10).
+		WithSleepDelay(func(time.Duration) {}).
+		WithRequestMetricsCollector(collector))
+	s.ServiceName = "mockService"
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	reqNum := 0
+	s.Handlers.Send.PushBack(func(r *request.Request) {
+		code := statusCodes[reqNum]
+		reqNum++
+		r.HTTPResponse = &http.Response{
+			StatusCode: code,
+			Header:     http.Header{},
+			Body:       body(`{"__type":"UnknownError","message":"An error occurred."}`),
+		}
+		if code == 200 {
+			r.HTTPResponse.Body = body(`{"data":"valid"}`)
+		}
+	})
+	s.Handlers.UnmarshalMeta.PushBack(func(r *request.Request) {
+		r.RequestID = fmt.Sprintf("request-id-%d", reqNum)
+	})
+
+	return s.NewRequest(&request.Operation{Name: "Operation"}, nil, &testData{})
+}
+
+func TestRequestMetricsCollector(t *testing.T) {
+	collector := &mockMetricsCollector{}
+	r := newMetricsTestRequest(collector, []int{500, 503, 200})
+
+	if err := r.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 3, len(collector.started); e != a {
+		t.Fatalf("expect %d attempts started, got %d", e, a)
+	}
+	if e, a := 3, len(collector.completed); e != a {
+		t.Fatalf("expect %d attempts completed, got %d", e, a)
+	}
+	if e, a := 1, len(collector.requests); e != a {
+		t.Fatalf("expect %d requests completed, got %d", e, a)
+	}
+
+	expectInfo := aws.RequestMetricsInfo{
+		ServiceName: "mockService",
+		Operation:   "Operation",
+		Region:      "mock-region",
+	}
+	for i, m := range collector.started {
+		if e, a := expectInfo, m.RequestMetricsInfo; e != a {
+			t.Errorf("%d, expect %v info, got %v", i, e, a)
+		}
+		if e, a := i, m.Attempt; e != a {
+			t.Errorf("%d, expect %d attempt, got %d", i, e, a)
+		}
+		if m.Time.IsZero() {
+			t.Errorf("%d, expect start time", i)
+		}
+	}
+
+	for i, c := range []struct {
+		StatusCode int
+		ErrCode    string
+		WillRetry  bool
+	}{
+		{500, "UnknownError", true},
+		{503, "UnknownError", true},
+		{200, "", false},
+	} {
+		m := collector.completed[i]
+
+		expectInfo.RequestID = fmt.Sprintf("request-id-%d", i+1)
+		if e, a := expectInfo, m.RequestMetricsInfo; e != a {
+			t.Errorf("%d, expect %v info, got %v", i, e, a)
+		}
+		if e, a := i, m.Attempt; e != a {
+			t.Errorf("%d, expect %d attempt, got %d", i, e, a)
+		}
+		if e, a := c.StatusCode, m.StatusCode; e != a {
+			t.Errorf("%d, expect %d status code, got %d", i, e, a)
+		}
+		if len(c.ErrCode) == 0 {
+			if m.Err != nil {
+				t.Errorf("%d, expect no error, got %v", i, m.Err)
+			}
+		} else if e, a := c.ErrCode, m.Err.(awserr.Error).Code(); e != a {
+			t.Errorf("%d, expect %q error code, got %q", i, e, a)
+		}
+		if e, a := c.WillRetry, m.WillRetry; e != a {
+			t.Errorf("%d, expect %v will retry, got %v", i, e, a)
+		}
+		if c.WillRetry && m.RetryDelay <= 0 {
+			t.Errorf("%d, expect retry delay, got %v", i, m.RetryDelay)
+		}
+		if !c.WillRetry && m.RetryDelay != 0 {
+			t.Errorf("%d, expect no retry delay, got %v", i, m.RetryDelay)
+		}
+		if m.Latency < 0 {
+			t.Errorf("%d, expect latency, got %v", i, m.Latency)
+		}
+	}
+
+	m := collector.requests[0]
+	if e, a := expectInfo, m.RequestMetricsInfo; e != a {
+		t.Errorf("expect %v info, got %v", e, a)
+	}
+	if e, a := 3, m.Attempts; e != a {
+		t.Errorf("expect %d attempts, got %d", e, a)
+	}
+	if e, a := 200, m.StatusCode; e != a {
+		t.Errorf("expect %d status code, got %d", e, a)
+	}
+	if m.Err != nil {
+		t.Errorf("expect no error, got %v", m.Err)
+	}
+}
+
+func TestRequestMetricsCollector_MutatedError(t *testing.T) {
+	collector := &mockMetricsCollector{}
+	r := newMetricsTestRequest(collector, []int{500, 200})
+
+	// Handlers replacing the request's error must not change the error the
+	// attempt is reported with.
+	r.Handlers.Retry.PushBack(func(r *request.Request) {
+		r.Error = awserr.New("Replaced", "replaced error", nil)
+	})
+	finalErr := awserr.New("Final", "final error", nil)
+	r.Handlers.Complete.PushBack(func(r *request.Request) {
+		r.Error = finalErr
+	})
+
+	if err := r.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 2, len(collector.completed); e != a {
+		t.Fatalf("expect %d attempts completed, got %d", e, a)
+	}
+	m := collector.completed[0]
+	if e, a := "UnknownError", m.Err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %q error code, got %q", e, a)
+	}
+	if !m.WillRetry {
+		t.Errorf("expect attempt to be retried")
+	}
+
+	if e, a := 1, len(collector.requests); e != a {
+		t.Fatalf("expect %d requests completed, got %d", e, a)
+	}
+	if e, a := finalErr, collector.requests[0].Err; e != a {
+		t.Errorf("expect %v request error, got %v", e, a)
+	}
+	if e, a := 2, collector.requests[0].Attempts; e != a {
+		t.Errorf("expect %d attempts, got %d", e, a)
+	}
+}
@@ -28,6 +28,15 @@ const (
 	// during body reads.
 	ErrCodeResponseTimeout = "ResponseTimeout"
 
+	// ErrCodeBodyNotRetryable is the error code returned when a request
+	// failed and would be retried, but the request's body is backed by a
+	// reader that is not seekable, and cannot be rewound to be sent again.
+	ErrCodeBodyNotRetryable = "RequestBodyNotRetryable"
+
+	// ErrCodeRequestBuiltAndSigned is the error code returned when a request
+	// is sent after its HTTP request was returned by BuildAndSign.
+	ErrCodeRequestBuiltAndSigned = "RequestBuiltAndSigned"
+
 	// CanceledErrorCode is the error code that will be returned by an
 	// API request that was canceled. Requests given a aws.Context may
 	// return this error when canceled.
@@ -60,10 +69,66 @@ type Request struct {
 	LastSignedAt           time.Time
 	DisableFollowRedirects bool
 
+	// UnknownResponseFields are the paths of response fields which were
+	// present in the response, but not defined by the operation's output
+	// shape. Only populated if the Config.CollectUnknownFields is enabled,
+	// and the protocol supports collecting unknown fields.
+	UnknownResponseFields []string
+
+	// PresignSignedHeaders are the names of the headers of a presigned
+	// request which are signed as headers, rather than hoisted to the
+	// presigned URL's query string. See WithPresignSignedHeaders.
+	PresignSignedHeaders []string
+
+	// PresignUnsignedHeaders are the names of the headers of a presigned
+	// request which are hoisted to the presigned URL's query string, rather
+	// than signed as headers. See WithPresignUnsignedHeaders.
+	PresignUnsignedHeaders []string
+
+	// StreamingPayload signs the request's payload in chunks as the payload
+	// is sent, rather than hashing the payload before the request is sent.
+	// See WithStreamingPayload.
+	StreamingPayload bool
+
+	// UnsignedPayload signs the request with the UNSIGNED-PAYLOAD content
+	// SHA256, rather than the hash of the request's payload. See
+	// WithUnsignedPayload.
+	UnsignedPayload bool
+
+	// SensitiveBodyPaths are the paths of request body members which were
+	// marked as sensitive by the protocol's encoder. Values at these paths
+	// are redacted when the request body is logged.
+	SensitiveBodyPaths []string
+
+	// SensitiveHeaders are the names of the request headers which were
+	// marked as sensitive by the protocol's encoder. Their values are
+	// redacted when the request is logged.
+	SensitiveHeaders []string
+
+	// ResponseBodyWriter, if set, is the writer the operation's streaming
+	// output payload is copied to. See WithResponseBodyWriter.
+	ResponseBodyWriter *ResponseBodyWriter
+
+	// BufferedBody, if set, buffers the request's body if the body is not
+	// seekable, so the body can be rewound. See WithBufferedBody.
+	BufferedBody *BufferedBody
+
+	// ResponseTrailerCallbacks are called with the response's HTTP trailers
+	// once the response body is read to EOF. See WithResponseTrailersCallback.
+	ResponseTrailerCallbacks []func(trailer http.Header)
+
 	context aws.Context
 
 	built bool
 
+	// Set once the HTTP request is returned by BuildAndSign, so that the
+	// request is not also sent.
+	builtAndSigned bool
+
+	// Set once the response body of the last attempt is read to EOF, and
+	// its trailers are available.
+	responseTrailersRead bool
+
 	// Need to persist an intermediate body between the input Body and HTTP
 	// request body because the HTTP Client's transport can maintain a reference
 	// to the HTTP request's body after the client has returned. This value is
@@ -78,6 +143,26 @@ type Operation struct {
 	HTTPPath   string
 	*Paginator
 
+	// Idempotent marks the operation as safe to be sent more than once,
+	// regardless of its HTTP method. See Request.IsIdempotent.
+	Idempotent bool
+
+	// SensitiveOutputPaths are the paths of the operation's response body
+	// members which are marked as sensitive, in the form of the paths of
+	// SensitiveBodyPaths. A "[*]" index matches any list element, and a "*"
+	// element any map key or XML element name, e.g. "Users[*].Password".
+	// Values at these paths are redacted when the response body is logged.
+	SensitiveOutputPaths []string
+
+	// SensitiveOutputHeaders are the names of the operation's response
+	// headers which are marked as sensitive. Their values are redacted when
+	// the response is logged.
+	SensitiveOutputHeaders []string
+
+	// SensitiveOutputBody marks the operation's response payload as
+	// sensitive. The entire response body is redacted when logged.
+	SensitiveOutputBody bool
+
 	BeforePresignFn func(r *Request) error
 }
 
@@ -128,12 +213,44 @@ func New(cfg aws.Config, clientInfo metadata.ClientInfo, handlers Handlers,
 // using a WithContext API operation method.
 type Option func(*Request)
 
+// WithGetRequestID builds a request Option which will retrieve the request ID
+// of the request's last attempt once the request completes, for both success
+// and error responses. The passed in id pointer must be non-nil.
+//
+// The request ID is the one unmarshaled by the service's protocol, which may
+// be read from the response's body. If the protocol did not set the request
+// ID, the ID is read from the x-amzn-RequestId response header, falling back
+// to the x-amz-request-id header used by services such as Amazon S3. The ID is
+// empty if no response was received.
+//
+//    var id string
+//    _, err := svc.GetObjectWithContext(ctx, params, request.WithGetRequestID(&id))
+//    if err != nil {
+//        fmt.Println("request failed", id, err)
+//    }
+func WithGetRequestID(id *string) Option {
+	return func(r *Request) {
+		r.Handlers.Complete.PushBack(func(req *Request) {
+			*id = req.RequestID
+			if len(*id) != 0 || req.HTTPResponse == nil {
+				return
+			}
+			*id = req.HTTPResponse.Header.Get("X-Amzn-Requestid")
+			if len(*id) == 0 {
+				*id = req.HTTPResponse.Header.Get("X-Amz-Request-Id")
+			}
+		})
+	}
+}
+
 // WithGetResponseHeader builds a request Option which will retrieve a single
 // header value from the HTTP Response. If there are multiple values for the
 // header key use WithGetResponseHeaders instead to access the http.Header
 // map directly. The passed in val pointer must be non-nil.
 //
-// This Option can be used multiple times with a single API operation.
+// This Option can be used multiple times with a single API operation. The
+// value is retrieved for both success and error responses, and is empty if
+// no response was received.
 //
 //    var id2, versionID string
 //    svc.PutObjectWithContext(ctx, params,
@@ -143,21 +260,29 @@ type Option func(*Request)
 func WithGetResponseHeader(key string, val *string) Option {
 	return func(r *Request) {
 		r.Handlers.Complete.PushBack(func(req *Request) {
-			*val = req.HTTPResponse.Header.Get(key)
+			*val = ""
+			if req.HTTPResponse != nil {
+				*val = req.HTTPResponse.Header.Get(key)
+			}
 		})
 	}
 }
 
 // WithGetResponseHeaders builds a request Option which will retrieve the
 // headers from the HTTP response and assign them to the passed in headers
-// variable. The passed in headers pointer must be non-nil.
+// variable. The passed in headers pointer must be non-nil. The headers are
+// retrieved for both success and error responses, and are nil if no
+// response was received.
 //
 //    var headers http.Header
 //    svc.PutObjectWithContext(ctx, params, request.WithGetResponseHeaders(&headers))
 func WithGetResponseHeaders(headers *http.Header) Option {
 	return func(r *Request) {
 		r.Handlers.Complete.PushBack(func(req *Request) {
-			*headers = req.HTTPResponse.Header
+			*headers = nil
+			if req.HTTPResponse != nil {
+				*headers = req.HTTPResponse.Header
+			}
 		})
 	}
 }
@@ -172,6 +297,85 @@ func WithLogLevel(l aws.LogLevelType) Option {
 	}
 }
 
+// WithCollectUnknownFields is a request option that enables collecting the
+// paths of response fields not defined by the operation's output shape.
+// The collected paths will be set to the Request's UnknownResponseFields.
+//
+// See aws.Config.CollectUnknownFields for more information.
+func WithCollectUnknownFields() Option {
+	return func(r *Request) {
+		r.Config.CollectUnknownFields = aws.Bool(true)
+	}
+}
+
+// WithCompression is a request option that enables gzip compression of the
+// request's body, if the body is at least minSize bytes.
+//
+// See aws.Config.RequestCompressionMinSize for more information.
+//
+//     svc.PutMetricDataWithContext(ctx, params, request.WithCompression(10240))
+func WithCompression(minSize int64) Option {
+	return func(r *Request) {
+		r.Config.RequestCompressionMinSize = &minSize
+	}
+}
+
+// WithPresignSignedHeaders is a request option that signs the named headers
+// of a presigned request as headers, rather than hoisting them to the
+// presigned URL's query string. The headers, and their values, must be
+// included in the HTTP request made with the presigned URL. The headers are
+// returned by PresignRequest. Presigning fails if a header has no value.
+func WithPresignSignedHeaders(names ...string) Option {
+	return func(r *Request) {
+		r.PresignSignedHeaders = append(r.PresignSignedHeaders, names...)
+	}
+}
+
+// WithPresignUnsignedHeaders is a request option that hoists the named
+// headers of a presigned request to the presigned URL's query string, rather
+// than signing them as headers, so that the HTTP request made with the
+// presigned URL does not need to include them. The headers are hoisted even
+// if NotHoist is set.
+func WithPresignUnsignedHeaders(names ...string) Option {
+	return func(r *Request) {
+		r.PresignUnsignedHeaders = append(r.PresignUnsignedHeaders, names...)
+	}
+}
+
+// WithStreamingPayload is a request option that signs the request's payload
+// in chunks as the payload is sent, with the V4 signer's streaming payload
+// signing, rather than reading the whole payload to hash it before the
+// request is sent. Only supported by S3's PutObject and UploadPart
+// operations.
+//
+// The request's payload is not signed in chunks if the request is presigned.
+//
+//     svc.PutObjectWithContext(ctx, params, request.WithStreamingPayload())
+func WithStreamingPayload() Option {
+	return func(r *Request) {
+		r.StreamingPayload = true
+	}
+}
+
+// WithUnsignedPayload is a request option that signs the request with the
+// UNSIGNED-PAYLOAD content SHA256, set as the X-Amz-Content-Sha256 header,
+// rather than reading the request's payload to hash it. The payload is not
+// read when the request is signed, so payloads which are not seekable can be
+// sent without being buffered. Only supported by services which accept
+// unsigned payloads, such as S3.
+//
+// The payload is only protected by TLS, so requests with the option fail to
+// be signed, with a v4.ErrCodeInsecureUnsignedPayload error, if the
+// request's endpoint is not HTTPS. Presigned requests are presigned with the
+// UNSIGNED-PAYLOAD content SHA256 in the presigned URL's query string.
+//
+//     svc.PutObjectWithContext(ctx, params, request.WithUnsignedPayload())
+func WithUnsignedPayload() Option {
+	return func(r *Request) {
+		r.UnsignedPayload = true
+	}
+}
+
 // ApplyOptions will apply each option to the request calling them in the order
 // the were provided.
 func (r *Request) ApplyOptions(opts ...Option) {
@@ -269,6 +473,25 @@ func (r *Request) Presign(expireTime time.Duration) (string, error) {
 	return r.HTTPRequest.URL.String(), nil
 }
 
+// PresignWithContext is the same as Presign, with the addition of the
+// context, which is used by the request's handlers, e.g. to retrieve the
+// request's credentials. An error is returned if the context is canceled
+// before the request is signed.
+//
+// The context must not be nil, or this method will panic.
+func (r *Request) PresignWithContext(ctx aws.Context, expireTime time.Duration) (string, error) {
+	r.SetContext(ctx)
+
+	select {
+	case <-ctx.Done():
+		return "", awserr.New(CanceledErrorCode,
+			"request context canceled", ctx.Err())
+	default:
+	}
+
+	return r.Presign(expireTime)
+}
+
 // PresignRequest behaves just like presign, with the addition of returning a
 // set of headers that were signed.
 //
@@ -287,6 +510,43 @@ func (r *Request) PresignRequest(expireTime time.Duration) (string, http.Header,
 	return r.HTTPRequest.URL.String(), r.SignedHeaderVals, nil
 }
 
+// BuildAndSign validates, builds, and signs the request, returning the signed
+// HTTP request, and its body, without sending the request. The HTTP request
+// can be sent with any HTTP client, e.g. to send the request through a proxy,
+// or to queue it to be sent later.
+//
+// The returned body is the HTTP request's body, positioned at the start of
+// the request's payload, and is nil if the request has no body. The body is
+// closed once the HTTP request is sent.
+//
+// The request's signature is only valid for a limited time after it is
+// signed, e.g. 15 minutes for the V4 signer, and must be sent before the
+// signature expires.
+//
+// Once BuildAndSign returns the HTTP request, Send will fail with the
+// ErrCodeRequestBuiltAndSigned error code, so that the same request is not
+// sent twice.
+func (r *Request) BuildAndSign() (*http.Request, io.ReadSeeker, error) {
+	if err := r.Sign(); err != nil {
+		return nil, nil, err
+	}
+
+	// Signing may have read the body to compute its hash, so the HTTP
+	// request's body must be reset to the start of the payload.
+	r.ResetBody()
+	if r.Error != nil {
+		return nil, nil, r.Error
+	}
+
+	r.builtAndSigned = true
+
+	var body io.ReadSeeker
+	if r.HTTPRequest.Body != NoBody {
+		body = r.GetBody()
+	}
+	return r.HTTPRequest, body, nil
+}
+
 func debugLogReqError(r *Request, stage string, retrying bool, err error) {
 	if !r.Config.LogLevel.Matches(aws.LogDebugWithRequestErrors) {
 		return
@@ -319,6 +579,9 @@ func (r *Request) Build() error {
 			return r.Error
 		}
 		r.Handlers.Build.Run(r)
+		if r.Error == nil {
+			r.bufferBody()
+		}
 		if r.Error != nil {
 			debugLogReqError(r, "Build Request", false, r.Error)
 			return r.Error
@@ -399,16 +662,9 @@ func (r *Request) getNextRequestBody() (io.ReadCloser, error) {
 // a ReaderSeekerCloser without an unerlying Seeker -1 will be returned.
 // If no error occurs the length of the body will be returned.
 func computeBodyLength(r io.ReadSeeker) (int64, error) {
-	seekable := true
 	// Determine if the seeker is actually seekable. ReaderSeekerCloser
 	// hides the fact that a io.Readers might not actually be seekable.
-	switch v := r.(type) {
-	case aws.ReaderSeekerCloser:
-		seekable = v.IsSeeker()
-	case *aws.ReaderSeekerCloser:
-		seekable = v.IsSeeker()
-	}
-	if !seekable {
+	if !aws.IsReaderSeekable(r) {
 		return -1, nil
 	}
 
@@ -430,6 +686,13 @@ func computeBodyLength(r io.ReadSeeker) (int64, error) {
 	return endOffset - curOffset, nil
 }
 
+// IsBodyRetryable returns if the request's body can be rewound so that the
+// request can be retried. Bodies backed by readers that are not seekable
+// cannot be retried.
+func (r *Request) IsBodyRetryable() bool {
+	return r.Body == nil || aws.IsReaderSeekable(r.Body)
+}
+
 // GetBody will return an io.ReadSeeker of the Request's underlying
 // input body with a concurrency safe wrapper.
 func (r *Request) GetBody() io.ReadSeeker {
@@ -449,11 +712,22 @@ func (r *Request) GetBody() io.ReadSeeker {
 // https://github.com/golang/go/blob/master/src/net/http/transport.go
 //
 // Send will not close the request.Request's body.
+//
+// Send fails if the request's HTTP request was returned by BuildAndSign.
 func (r *Request) Send() error {
+	if r.builtAndSigned {
+		r.Error = awserr.New(ErrCodeRequestBuiltAndSigned,
+			"request cannot be sent, its HTTP request was returned by BuildAndSign", nil)
+		return r.Error
+	}
+
+	sendStart := r.metricsTime()
+	var attempts int
 	defer func() {
 		// Regardless of success or failure of the request trigger the Complete
 		// request handlers.
 		r.Handlers.Complete.Run(r)
+		r.requestCompleted(attempts, sendStart)
 	}()
 
 	for {
@@ -476,22 +750,32 @@ func (r *Request) Send() error {
 			}
 		}
 
+		attempt := r.RetryCount
+		attemptStart := r.attemptStarted()
+		attempts++
+
 		r.Sign()
 		if r.Error != nil {
+			r.attemptCompleted(attempt, attemptStart, r.metricsTime(), r.Error)
 			return r.Error
 		}
 
 		r.Retryable = nil
 
+		parentCtx, attemptCtx := r.startAttempt()
 		r.Handlers.Send.Run(r)
 		if r.Error != nil {
+			r.endAttempt(parentCtx, attemptCtx)
+			attemptEnd := r.metricsTime()
 			if !shouldRetryCancel(r) {
+				r.attemptCompleted(attempt, attemptStart, attemptEnd, r.Error)
 				return r.Error
 			}
 
 			err := r.Error
 			r.Handlers.Retry.Run(r)
 			r.Handlers.AfterRetry.Run(r)
+			r.attemptCompleted(attempt, attemptStart, attemptEnd, err)
 			if r.Error != nil {
 				debugLogReqError(r, "Send Request", false, err)
 				return r.Error
@@ -499,14 +783,21 @@ func (r *Request) Send() error {
 			debugLogReqError(r, "Send Request", true, err)
 			continue
 		}
+		r.watchAttemptBody(attemptCtx)
+		r.watchResponseTrailers()
 		r.Handlers.UnmarshalMeta.Run(r)
 		r.Handlers.ValidateResponse.Run(r)
 		if r.Error != nil {
+			errBody := r.limitErrorResponseBody()
 			r.Handlers.UnmarshalError.Run(r)
+			r.noteTruncatedErrorResponseBody(errBody)
+			r.endAttempt(parentCtx, attemptCtx)
+			attemptEnd := r.metricsTime()
 			err := r.Error
 
 			r.Handlers.Retry.Run(r)
 			r.Handlers.AfterRetry.Run(r)
+			r.attemptCompleted(attempt, attemptStart, attemptEnd, err)
 			if r.Error != nil {
 				debugLogReqError(r, "Validate Response", false, err)
 				return r.Error
@@ -516,10 +807,13 @@ func (r *Request) Send() error {
 		}
 
 		r.Handlers.Unmarshal.Run(r)
+		r.endAttempt(parentCtx, attemptCtx)
+		attemptEnd := r.metricsTime()
 		if r.Error != nil {
 			err := r.Error
 			r.Handlers.Retry.Run(r)
 			r.Handlers.AfterRetry.Run(r)
+			r.attemptCompleted(attempt, attemptStart, attemptEnd, err)
 			if r.Error != nil {
 				debugLogReqError(r, "Unmarshal Response", false, err)
 				return r.Error
@@ -528,6 +822,7 @@ func (r *Request) Send() error {
 			continue
 		}
 
+		r.attemptCompleted(attempt, attemptStart, attemptEnd, nil)
 		break
 	}
 
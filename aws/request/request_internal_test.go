@@ -2,6 +2,9 @@ package request
 
 import (
 	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
 )
 
 func TestCopy(t *testing.T) {
@@ -25,3 +28,19 @@ func TestCopy(t *testing.T) {
 		t.Errorf("expect %q http method, got %q", e, a)
 	}
 }
+
+func TestRequestMetrics_NoCollectorAllocs(t *testing.T) {
+	r := &Request{
+		Config:    aws.Config{Region: aws.String("mock-region")},
+		Operation: &Operation{Name: "Operation"},
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		start := r.attemptStarted()
+		r.attemptCompleted(0, start, r.metricsTime(), nil)
+		r.requestCompleted(1, time.Time{})
+	})
+	if allocs != 0 {
+		t.Errorf("expect no allocations without a collector, got %v", allocs)
+	}
+}
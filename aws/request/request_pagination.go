@@ -5,6 +5,7 @@ import (
 	"sync/atomic"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
@@ -38,6 +39,7 @@ type Pagination struct {
 
 	started    bool
 	nextTokens []interface{}
+	pageSize   *int64
 
 	err     error
 	curPage interface{}
@@ -64,6 +66,16 @@ func (p *Pagination) Page() interface{} {
 	return p.curPage
 }
 
+// SetPageSize sets the maximum number of items the pages retrieved by the
+// following calls to Next will contain, by setting the API operation's limit
+// member. Has no effect if the API operation does not have a limit member.
+//
+// Can be called between pages, such as to retrieve a small first page
+// quickly, and larger pages after it.
+func (p *Pagination) SetPageSize(size int64) {
+	p.pageSize = &size
+}
+
 // Next will attempt to retrieve the next page for the API operation. When a page
 // is retrieved true will be returned. If the page cannot be retrieved, or there
 // are no more pages false will be returned.
@@ -73,6 +85,19 @@ func (p *Pagination) Page() interface{} {
 //
 // Use the Err method to determine if an error occurred if Page returns false.
 func (p *Pagination) Next() bool {
+	return p.next(nil)
+}
+
+// NextWithContext is the same as Next, except the page's request is sent
+// with the Context provided instead of the Context set by NewRequest.
+//
+// If the Context is canceled, or its deadline expires, no further pages are
+// requested, and Err returns an error with the CanceledErrorCode error code.
+func (p *Pagination) NextWithContext(ctx aws.Context) bool {
+	return p.next(ctx)
+}
+
+func (p *Pagination) next(ctx aws.Context) bool {
 	if !p.HasNextPage() {
 		return false
 	}
@@ -83,11 +108,27 @@ func (p *Pagination) Next() bool {
 		return false
 	}
 
+	if ctx != nil {
+		req.SetContext(ctx)
+	}
+	// Stop requesting pages once the context is done, instead of relying on
+	// the request failing to be sent.
+	select {
+	case <-req.Context().Done():
+		p.err = awserr.New(CanceledErrorCode,
+			"request context canceled", req.Context().Err())
+		return false
+	default:
+	}
+
 	if p.started {
 		for i, intok := range req.Operation.InputTokens {
 			awsutil.SetValueAtPath(req.Params, intok, p.nextTokens[i])
 		}
 	}
+	if p.pageSize != nil && req.Operation.Paginator != nil && len(req.Operation.LimitToken) != 0 {
+		awsutil.SetValueAtPath(req.Params, req.Operation.LimitToken, p.pageSize)
+	}
 	p.started = true
 
 	err = req.Send()
@@ -1,11 +1,18 @@
 package request_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/awstesting"
 	"github.com/aws/aws-sdk-go/awstesting/unit"
@@ -529,6 +536,168 @@ func TestPagination_Standalone(t *testing.T) {
 	}
 }
 
+// newPaginationServer returns a server responding to DynamoDB ListTables
+// requests with pages which always have a next page. The Limit of each
+// request received is recorded.
+func newPaginationServer(t *testing.T) (*httptest.Server, func() []int64) {
+	var mu sync.Mutex
+	var limits []int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var in dynamodb.ListTablesInput
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			t.Errorf("expect no error decoding request, got %v", err)
+		}
+
+		mu.Lock()
+		limits = append(limits, aws.Int64Value(in.Limit))
+		n := len(limits)
+		mu.Unlock()
+
+		fmt.Fprintf(w, `{"TableNames":["table%d"],"LastEvaluatedTableName":"table%d"}`, n, n)
+	}))
+
+	return server, func() []int64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]int64{}, limits...)
+	}
+}
+
+func newPaginationServerClient(endpoint string) *dynamodb.DynamoDB {
+	return dynamodb.New(unit.Session, &aws.Config{
+		Endpoint:   aws.String(endpoint),
+		DisableSSL: aws.Bool(true),
+		MaxRetries: aws.Int(0),
+	})
+}
+
+func TestPagination_NextWithContextCanceled(t *testing.T) {
+	server, limits := newPaginationServer(t)
+	defer server.Close()
+
+	db := newPaginationServerClient(server.URL)
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			req, _ := db.ListTablesRequest(&dynamodb.ListTablesInput{})
+			return req, nil
+		},
+	}
+
+	ctx := &awstesting.FakeContext{DoneCh: make(chan struct{})}
+	pages := 0
+	for p.NextWithContext(ctx) {
+		pages++
+		if pages == 2 {
+			ctx.Error = fmt.Errorf("context canceled")
+			close(ctx.DoneCh)
+		}
+	}
+
+	if e, a := 2, pages; e != a {
+		t.Errorf("expect %d pages, got %d", e, a)
+	}
+	if e, a := 2, len(limits()); e != a {
+		t.Errorf("expect %d requests, got %d", e, a)
+	}
+	aerr, ok := p.Err().(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T, %v", p.Err(), p.Err())
+	}
+	if e, a := request.CanceledErrorCode, aerr.Code(); e != a {
+		t.Errorf("expect %q error code, got %q", e, a)
+	}
+	if e, a := ctx.Error, aerr.OrigErr(); e != a {
+		t.Errorf("expect %v original error, got %v", e, a)
+	}
+}
+
+func TestPagination_PagesWithContextCanceled(t *testing.T) {
+	server, limits := newPaginationServer(t)
+	defer server.Close()
+
+	db := newPaginationServerClient(server.URL)
+	ctx := &awstesting.FakeContext{DoneCh: make(chan struct{})}
+	pages := 0
+	err := db.ListTablesPagesWithContext(ctx, &dynamodb.ListTablesInput{},
+		func(page *dynamodb.ListTablesOutput, lastPage bool) bool {
+			pages++
+			if pages == 3 {
+				ctx.Error = fmt.Errorf("context canceled")
+				close(ctx.DoneCh)
+			}
+			return true
+		})
+
+	if e, a := 3, pages; e != a {
+		t.Errorf("expect %d pages, got %d", e, a)
+	}
+	if e, a := 3, len(limits()); e != a {
+		t.Errorf("expect %d requests, got %d", e, a)
+	}
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T, %v", err, err)
+	}
+	if e, a := request.CanceledErrorCode, aerr.Code(); e != a {
+		t.Errorf("expect %q error code, got %q", e, a)
+	}
+}
+
+func TestPagination_SetPageSize(t *testing.T) {
+	server, limits := newPaginationServer(t)
+	defer server.Close()
+
+	db := newPaginationServerClient(server.URL)
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			req, _ := db.ListTablesRequest(&dynamodb.ListTablesInput{
+				Limit: aws.Int64(1),
+			})
+			return req, nil
+		},
+	}
+
+	for i := 0; i < 3 && p.Next(); i++ {
+		if i == 0 {
+			p.SetPageSize(10)
+		}
+	}
+	if err := p.Err(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := []int64{1, 10, 10}, limits(); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v limits, got %v", e, a)
+	}
+}
+
+func TestPagination_SetPageSizeNoLimitToken(t *testing.T) {
+	c := awstesting.NewClient()
+	input := &testPageInput{}
+	p := request.Pagination{
+		NewRequest: func() (*request.Request, error) {
+			r := c.NewRequest(&request.Operation{
+				Name: "Operation",
+				Paginator: &request.Paginator{
+					InputTokens:  []string{"NextToken"},
+					OutputTokens: []string{"NextToken"},
+				},
+			}, input, &testPageOutput{})
+			r.Handlers.Clear()
+			return r, nil
+		},
+	}
+	p.SetPageSize(10)
+
+	if !p.Next() {
+		t.Fatalf("expect page, got %v", p.Err())
+	}
+	if e, a := (testPageInput{}), *input; e != a {
+		t.Errorf("expect %v input, got %v", e, a)
+	}
+}
+
 // Benchmarks
 var benchResps = []*dynamodb.ListTablesOutput{
 	{TableNames: []*string{aws.String("TABLE"), aws.String("NXT")}, LastEvaluatedTableName: aws.String("NXT")},
@@ -26,6 +26,7 @@ import (
 	"github.com/aws/aws-sdk-go/awstesting/unit"
 	"github.com/aws/aws-sdk-go/private/protocol/jsonrpc"
 	"github.com/aws/aws-sdk-go/private/protocol/rest"
+	"github.com/aws/aws-sdk-go/service/s3"
 )
 
 type testData struct {
@@ -516,6 +517,7 @@ func TestRequest_NoBody(t *testing.T) {
 func TestIsSerializationErrorRetryable(t *testing.T) {
 	testCases := []struct {
 		err      error
+		method   string
 		expected bool
 	}{
 		{
@@ -532,13 +534,20 @@ func TestIsSerializationErrorRetryable(t *testing.T) {
 		},
 		{
 			err:      awserr.New(request.ErrCodeSerialization, "foo error", stubConnectionResetError),
+			method:   "GET",
 			expected: true,
 		},
+		{
+			err:      awserr.New(request.ErrCodeSerialization, "foo error", stubConnectionResetError),
+			method:   "POST",
+			expected: false,
+		},
 	}
 
 	for i, c := range testCases {
 		r := &request.Request{
-			Error: c.err,
+			Operation: &request.Operation{Name: "Operation", HTTPMethod: c.method},
+			Error:     c.err,
 		}
 		if r.IsErrorRetryable() != c.expected {
 			t.Errorf("Case %d: Expected %v, but received %v", i+1, c.expected, !c.expected)
@@ -607,6 +616,120 @@ func TestWithGetResponseHeaders(t *testing.T) {
 	}
 }
 
+func TestWithGetRequestID(t *testing.T) {
+	cases := map[string]struct {
+		RequestID string
+		Header    map[string]string
+		NoResp    bool
+		Expect    string
+	}{
+		"unmarshaled": {
+			RequestID: "unmarshaled-id",
+			Header: map[string]string{
+				"X-Amzn-Requestid": "amzn-id",
+			},
+			Expect: "unmarshaled-id",
+		},
+		"amzn header": {
+			Header: map[string]string{
+				"X-Amzn-Requestid": "amzn-id",
+				"X-Amz-Request-Id": "amz-id",
+			},
+			Expect: "amzn-id",
+		},
+		"amz header": {
+			Header: map[string]string{
+				"X-Amz-Request-Id": "amz-id",
+			},
+			Expect: "amz-id",
+		},
+		"no response": {
+			NoResp: true,
+		},
+	}
+
+	for name, c := range cases {
+		r := &request.Request{RequestID: c.RequestID}
+
+		id := "previous"
+		var header string
+		var headers http.Header
+		r.ApplyOptions(
+			request.WithGetRequestID(&id),
+			request.WithGetResponseHeader("X-Amz-Request-Id", &header),
+			request.WithGetResponseHeaders(&headers),
+		)
+
+		if !c.NoResp {
+			r.HTTPResponse = &http.Response{Header: http.Header{}}
+			for k, v := range c.Header {
+				r.HTTPResponse.Header.Set(k, v)
+			}
+		}
+		r.Handlers.Complete.Run(r)
+
+		if e, a := c.Expect, id; e != a {
+			t.Errorf("%s, expect %q request ID, got %q", name, e, a)
+		}
+		if e, a := c.Header["X-Amz-Request-Id"], header; e != a {
+			t.Errorf("%s, expect %q header value, got %q", name, e, a)
+		}
+		if c.NoResp && headers != nil {
+			t.Errorf("%s, expect no headers, got %v", name, headers)
+		}
+	}
+}
+
+func TestWithGetRequestID_Send(t *testing.T) {
+	var status int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Amzn-Requestid", "amzn-request-id")
+		w.Header().Set("X-Amz-Request-Id", "amz-request-id")
+		w.Header().Set("X-Amz-Id-2", "amz-id-2")
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			w.Write([]byte(`<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`))
+		}
+	}))
+	defer server.Close()
+
+	svc := s3.New(unit.Session, &aws.Config{
+		Endpoint:         aws.String(server.URL),
+		S3ForcePathStyle: aws.Bool(true),
+		MaxRetries:       aws.Int(0),
+	})
+
+	for _, status = range []int{http.StatusOK, http.StatusForbidden} {
+		var id, id2 string
+		var headers http.Header
+		ctx := &awstesting.FakeContext{DoneCh: make(chan struct{})}
+		_, err := svc.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
+			Bucket: aws.String("bucket"),
+		},
+			request.WithGetRequestID(&id),
+			request.WithGetResponseHeader("X-Amz-Id-2", &id2),
+			request.WithGetResponseHeaders(&headers),
+		)
+		if status == http.StatusOK && err != nil {
+			t.Fatalf("%d, expect no error, got %v", status, err)
+		} else if status != http.StatusOK && err == nil {
+			t.Fatalf("%d, expect error", status)
+		}
+
+		// The protocol's request ID, read from the x-amzn-RequestId
+		// header, takes precedence.
+		if e, a := "amzn-request-id", id; e != a {
+			t.Errorf("%d, expect %q request ID, got %q", status, e, a)
+		}
+		if e, a := "amz-id-2", id2; e != a {
+			t.Errorf("%d, expect %q host ID, got %q", status, e, a)
+		}
+		if e, a := "amz-request-id", headers.Get("X-Amz-Request-Id"); e != a {
+			t.Errorf("%d, expect %q header, got %q", status, e, a)
+		}
+	}
+}
+
 type connResetCloser struct {
 }
 
@@ -665,7 +788,7 @@ func TestSerializationErrConnectionReset(t *testing.T) {
 	)
 
 	osErr := stubConnectionResetError
-	req.ApplyOptions(request.WithResponseReadTimeout(time.Second))
+	req.ApplyOptions(request.WithResponseReadTimeout(time.Second), request.WithIdempotent())
 	err := req.Send()
 	if err == nil {
 		t.Error("Expected rror 'SerializationError', but received nil")
@@ -0,0 +1,53 @@
+package request
+
+import "io"
+
+// DefaultProgressInterval is the default number of bytes written to a
+// ResponseBodyWriter between calls to its Progress callback.
+const DefaultProgressInterval = 1024 * 1024
+
+// A ResponseBodyWriter is the writer an operation's streaming output payload
+// is copied to by the protocol unmarshaler. The payload is copied instead of
+// being returned as the output's io.ReadCloser body member, which will be
+// set to an empty body. The output's other members, such as headers, are
+// unmarshaled as usual.
+type ResponseBodyWriter struct {
+	// Writer is the writer the payload is copied to. If the Writer is also
+	// an io.WriterAt, and the response has a Content-Range header, the
+	// payload is written at the offset of the range's first byte.
+	Writer io.Writer
+
+	// Progress is an optional callback which is called with the total number
+	// of bytes written every ProgressInterval bytes, and once the whole
+	// payload has been written.
+	Progress func(written int64)
+
+	// ProgressInterval is the number of bytes written between calls to
+	// Progress. Defaults to DefaultProgressInterval if zero.
+	ProgressInterval int64
+}
+
+// WithResponseBodyWriter is a request option that copies the operation's
+// streaming output payload to the writer w, instead of returning the payload
+// as the output's body member. The progress callback is optional, and is
+// called with the total number of bytes written every
+// DefaultProgressInterval bytes.
+//
+// An error is returned if the payload is shorter than the response's
+// Content-Length. A request whose payload was partially written to a writer
+// which is not an io.WriterAt will not be retried.
+//
+//     f, _ := os.Create("object")
+//     _, err := svc.GetObjectWithContext(ctx, params,
+//         request.WithResponseBodyWriter(f, func(n int64) {
+//             fmt.Printf("%d bytes downloaded\n", n)
+//         }),
+//     )
+func WithResponseBodyWriter(w io.Writer, progress func(written int64)) Option {
+	return func(r *Request) {
+		r.ResponseBodyWriter = &ResponseBodyWriter{
+			Writer:   w,
+			Progress: progress,
+		}
+	}
+}
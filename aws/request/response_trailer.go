@@ -0,0 +1,129 @@
+package request
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// responseTrailerDrainSize is the maximum number of unread bytes drained from
+// a response body when it is closed, so that trailers following a body which
+// was not read to EOF, such as whitespace after a JSON document, are read.
+const responseTrailerDrainSize = 4 * 1024
+
+// checksumTrailerPrefix is the canonical prefix of the x-amz-checksum-*
+// trailer family.
+const checksumTrailerPrefix = "X-Amz-Checksum-"
+
+// WithResponseTrailersCallback is a request option that calls fn with the
+// response's HTTP trailers once the response body is read to EOF. For
+// operations with streaming output payloads, fn is called when the caller
+// reads the output's body to EOF, after the request has completed.
+//
+//     _, err := svc.GetObjectWithContext(ctx, params,
+//         request.WithResponseTrailersCallback(func(t http.Header) {
+//             fmt.Println("checksum", t.Get("X-Amz-Checksum-Crc32"))
+//         }),
+//     )
+func WithResponseTrailersCallback(fn func(trailer http.Header)) Option {
+	return func(r *Request) {
+		r.ResponseTrailerCallbacks = append(r.ResponseTrailerCallbacks, fn)
+	}
+}
+
+// ResponseTrailers returns the HTTP trailers of the request's response. The
+// trailers are only available once the response body has been read to EOF,
+// nil is returned until then. Complete handlers of operations without
+// streaming output payloads can use ResponseTrailers, as the body has been
+// read by the protocol unmarshaler.
+func (r *Request) ResponseTrailers() http.Header {
+	if !r.responseTrailersRead || r.HTTPResponse == nil {
+		return nil
+	}
+	return r.HTTPResponse.Trailer
+}
+
+// ResponseChecksums returns the values of the response's x-amz-checksum-*
+// trailers, keyed by the upper case checksum algorithm, e.g. "CRC32" or
+// "SHA256". Returns nil if the trailers are not available, or the response
+// has no checksum trailers.
+func (r *Request) ResponseChecksums() map[string]string {
+	var checksums map[string]string
+	for k, v := range r.ResponseTrailers() {
+		k = http.CanonicalHeaderKey(k)
+		if !strings.HasPrefix(k, checksumTrailerPrefix) || len(v) == 0 {
+			continue
+		}
+		if checksums == nil {
+			checksums = map[string]string{}
+		}
+		checksums[strings.ToUpper(k[len(checksumTrailerPrefix):])] = v[0]
+	}
+	return checksums
+}
+
+// watchResponseTrailers wraps the response body of the attempt, so that the
+// response's trailers are made available once the body is read to EOF. The
+// body is only wrapped if the response declares trailers with its Trailer
+// header, or the request has trailer callbacks.
+func (r *Request) watchResponseTrailers() {
+	r.responseTrailersRead = false
+	if r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+		return
+	}
+	if len(r.HTTPResponse.Trailer) == 0 && len(r.ResponseTrailerCallbacks) == 0 {
+		return
+	}
+
+	r.HTTPResponse.Body = &responseTrailerReader{
+		ReadCloser: r.HTTPResponse.Body,
+		r:          r,
+		resp:       r.HTTPResponse,
+	}
+}
+
+// readResponseTrailers is called once the body of the response is read to
+// EOF, and the HTTP client has read the response's trailers.
+func (r *Request) readResponseTrailers(resp *http.Response) {
+	if r.HTTPResponse != resp {
+		// The body of a previous attempt's response.
+		return
+	}
+
+	r.responseTrailersRead = true
+	for _, fn := range r.ResponseTrailerCallbacks {
+		fn(resp.Trailer)
+	}
+}
+
+// A responseTrailerReader notifies its request when the response body is read
+// to EOF.
+type responseTrailerReader struct {
+	io.ReadCloser
+	r    *Request
+	resp *http.Response
+	eof  bool
+	err  error
+}
+
+func (t *responseTrailerReader) Read(p []byte) (int, error) {
+	n, err := t.ReadCloser.Read(p)
+	if err == io.EOF && !t.eof {
+		t.eof = true
+		t.r.readResponseTrailers(t.resp)
+	} else if err != nil && err != io.EOF {
+		t.err = err
+	}
+	return n, err
+}
+
+// Close drains up to responseTrailerDrainSize unread bytes of the body, so
+// that the trailers of a body which was not read to EOF are read, before
+// closing the body. A body which failed to be read is not drained.
+func (t *responseTrailerReader) Close() error {
+	if !t.eof && t.err == nil {
+		io.CopyN(ioutil.Discard, t, responseTrailerDrainSize)
+	}
+	return t.ReadCloser.Close()
+}
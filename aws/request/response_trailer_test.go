@@ -0,0 +1,143 @@
+package request_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func newTrailerServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Trailer", "X-Amz-Checksum-Crc32, X-Amz-Checksum-Sha256, X-Status")
+		w.Write([]byte(`{"Value":"abc"}` + "\n\n"))
+		w.(http.Flusher).Flush()
+		w.Header().Set("X-Amz-Checksum-Crc32", "NSRBwg==")
+		w.Header().Set("X-Amz-Checksum-Sha256", "ungWv48Bz+pBQUDeXa4iI7ADYaOWF3qctBD/YfIAFa0=")
+		w.Header().Set("X-Status", "complete")
+	}))
+}
+
+func newTrailerRequest(server *httptest.Server, unmarshal func(*request.Request), opts ...request.Option) *request.Request {
+	def := defaults.Get()
+	def.Config.MergeIn(&aws.Config{
+		Region:     aws.String("mock-region"),
+		DisableSSL: aws.Bool(true),
+		Endpoint:   aws.String(server.URL),
+	})
+	svc := client.New(*def.Config, metadata.ClientInfo{
+		ServiceName: "mock",
+		Endpoint:    server.URL,
+	}, def.Handlers)
+	svc.Handlers.Unmarshal.PushBack(unmarshal)
+
+	r := svc.NewRequest(&request.Operation{
+		Name:       "Operation",
+		HTTPMethod: "GET",
+		HTTPPath:   "/",
+	}, nil, nil)
+	r.ApplyOptions(opts...)
+	return r
+}
+
+func TestResponseTrailers(t *testing.T) {
+	server := newTrailerServer()
+	defer server.Close()
+
+	var value string
+	var callbackTrailer, completeTrailer http.Header
+	r := newTrailerRequest(server, func(r *request.Request) {
+		// Decoding stops at the end of the JSON document, the remaining body
+		// is drained when it is closed.
+		defer r.HTTPResponse.Body.Close()
+		var v struct{ Value string }
+		if err := json.NewDecoder(r.HTTPResponse.Body).Decode(&v); err != nil {
+			r.Error = err
+		}
+		value = v.Value
+	}, request.WithResponseTrailersCallback(func(t http.Header) {
+		callbackTrailer = t
+	}))
+	r.Handlers.Complete.PushBack(func(r *request.Request) {
+		completeTrailer = r.ResponseTrailers()
+	})
+
+	if a := r.ResponseTrailers(); a != nil {
+		t.Errorf("expect no trailers before send, got %v", a)
+	}
+	if err := r.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "abc", value; e != a {
+		t.Errorf("expect %v value, got %v", e, a)
+	}
+	if e, a := "complete", completeTrailer.Get("X-Status"); e != a {
+		t.Errorf("expect %v trailer in complete handler, got %v", e, a)
+	}
+	if e, a := "complete", callbackTrailer.Get("X-Status"); e != a {
+		t.Errorf("expect %v trailer in callback, got %v", e, a)
+	}
+	if e, a := "complete", r.HTTPResponse.Trailer.Get("X-Status"); e != a {
+		t.Errorf("expect %v HTTP response trailer, got %v", e, a)
+	}
+
+	expect := map[string]string{
+		"CRC32":  "NSRBwg==",
+		"SHA256": "ungWv48Bz+pBQUDeXa4iI7ADYaOWF3qctBD/YfIAFa0=",
+	}
+	if e, a := expect, r.ResponseChecksums(); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v checksums, got %v", e, a)
+	}
+}
+
+func TestResponseTrailers_StreamingPayload(t *testing.T) {
+	server := newTrailerServer()
+	defer server.Close()
+
+	var called int
+	var callbackTrailer http.Header
+	r := newTrailerRequest(server, func(r *request.Request) {
+		// Streaming payloads are left to the caller to read.
+	}, request.WithResponseTrailersCallback(func(t http.Header) {
+		called++
+		callbackTrailer = t
+	}))
+
+	if err := r.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if a := r.ResponseTrailers(); a != nil {
+		t.Errorf("expect no trailers before the body is read, got %v", a)
+	}
+	if e, a := 0, called; e != a {
+		t.Errorf("expect callback called %d times, got %d", e, a)
+	}
+
+	b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	r.HTTPResponse.Body.Close()
+	if e, a := `{"Value":"abc"}`+"\n\n", string(b); e != a {
+		t.Errorf("expect %q body, got %q", e, a)
+	}
+
+	if e, a := 1, called; e != a {
+		t.Errorf("expect callback called %d times, got %d", e, a)
+	}
+	if e, a := "NSRBwg==", callbackTrailer.Get("X-Amz-Checksum-Crc32"); e != a {
+		t.Errorf("expect %v checksum trailer, got %v", e, a)
+	}
+	if e, a := "NSRBwg==", r.ResponseChecksums()["CRC32"]; e != a {
+		t.Errorf("expect %v checksum, got %v", e, a)
+	}
+}
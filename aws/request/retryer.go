@@ -1,6 +1,8 @@
 package request
 
 import (
+	"io"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -103,6 +105,25 @@ func isNestedErrorRetryable(parentErr awserr.Error) bool {
 	return isErrConnectionReset(err)
 }
 
+// isNestedErrorTransport returns if the error is a serialization or read
+// error caused by the connection failing while the response body was read,
+// such as the connection being closed or reset before the whole body was
+// received. These errors are only safe to retry for idempotent operations.
+func isNestedErrorTransport(parentErr awserr.Error) bool {
+	if _, ok := validParentCodes[parentErr.Code()]; !ok {
+		return false
+	}
+
+	err := parentErr.OrigErr()
+	if err == nil {
+		return false
+	}
+
+	return err == io.ErrUnexpectedEOF ||
+		isErrConnectionReset(err) ||
+		strings.Contains(err.Error(), "http2: server sent GOAWAY")
+}
+
 // IsErrorRetryable returns whether the error is retryable, based on its Code.
 // Returns false if error is nil.
 func IsErrorRetryable(err error) bool {
@@ -139,9 +160,56 @@ func IsErrorExpiredCreds(err error) bool {
 // IsErrorRetryable returns whether the error is retryable, based on its Code.
 // Returns false if the request has no Error set.
 //
-// Alias for the utility function IsErrorRetryable
+// In addition to the errors the utility function IsErrorRetryable considers
+// retryable, errors caused by the connection failing while the response body
+// was read, such as an unexpected EOF, a connection reset, or the HTTP/2
+// server sending GOAWAY, are retryable if the request's operation is
+// idempotent.
 func (r *Request) IsErrorRetryable() bool {
-	return IsErrorRetryable(r.Error)
+	if IsErrorRetryable(r.Error) {
+		return true
+	}
+
+	if aerr, ok := r.Error.(awserr.Error); ok && r.IsIdempotent() {
+		return isNestedErrorTransport(aerr)
+	}
+	return false
+}
+
+// IsIdempotent returns whether the request's operation can be safely sent
+// more than once. Operations using the GET, HEAD, OPTIONS, PUT, or DELETE
+// HTTP methods are idempotent, and other operations if marked as idempotent
+// by their Operation.Idempotent field, or the WithIdempotent request option.
+func (r *Request) IsIdempotent() bool {
+	if r.Operation != nil && r.Operation.Idempotent {
+		return true
+	}
+
+	method := ""
+	if r.HTTPRequest != nil {
+		method = r.HTTPRequest.Method
+	} else if r.Operation != nil {
+		method = r.Operation.HTTPMethod
+	}
+
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// WithIdempotent is a request option that marks the request's operation as
+// idempotent, so that the request is retried if the connection fails while
+// the response body is read.
+//
+//     svc.PublishWithContext(ctx, params, request.WithIdempotent())
+func WithIdempotent() Option {
+	return func(r *Request) {
+		op := *r.Operation
+		op.Idempotent = true
+		r.Operation = &op
+	}
 }
 
 // IsErrorThrottle returns whether the error is to be throttled based on its code.
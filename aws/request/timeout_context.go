@@ -0,0 +1,120 @@
+package request
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// A timeoutContext is a context derived from a parent context which is done
+// when its timeout expires, or the parent context is done. Used for the
+// attempt timeout of requests, and the max wait time of waiters.
+type timeoutContext struct {
+	aws.Context
+
+	timeout    time.Duration
+	timeoutErr error
+	deadline   time.Time
+	done       chan struct{}
+	stop       chan struct{}
+	stopOnce   sync.Once
+	release    chan struct{}
+	relOnce    sync.Once
+
+	mu  sync.Mutex
+	err error
+}
+
+// newTimeoutContext returns a timeoutContext whose Err returns timeoutErr
+// once its timeout expires.
+func newTimeoutContext(parent aws.Context, timeout time.Duration, timeoutErr error) *timeoutContext {
+	ctx := &timeoutContext{
+		Context:    parent,
+		timeout:    timeout,
+		timeoutErr: timeoutErr,
+		deadline:   time.Now().Add(timeout),
+		done:       make(chan struct{}),
+		stop:       make(chan struct{}),
+		release:    make(chan struct{}),
+	}
+	go ctx.wait()
+
+	return ctx
+}
+
+func (c *timeoutContext) wait() {
+	t := time.NewTimer(c.timeout)
+	defer t.Stop()
+
+	var err error
+	select {
+	case <-t.C:
+		err = c.timeoutErr
+	case <-c.Context.Done():
+		err = c.Context.Err()
+	case <-c.release:
+		return
+	case <-c.stop:
+		// The timeout was stopped, but a response body may still be read,
+		// so continue to be done when the parent context is.
+		done := c.Context.Done()
+		if done == nil {
+			return
+		}
+		select {
+		case <-done:
+			err = c.Context.Err()
+		case <-c.release:
+			return
+		}
+	}
+
+	c.mu.Lock()
+	c.err = err
+	c.mu.Unlock()
+	close(c.done)
+}
+
+// Deadline returns the timeout's deadline, or the parent context's deadline
+// if it is earlier.
+func (c *timeoutContext) Deadline() (time.Time, bool) {
+	if deadline, ok := c.Context.Deadline(); ok && deadline.Before(c.deadline) {
+		return deadline, true
+	}
+	return c.deadline, true
+}
+
+// Done returns a channel closed when the timeout expires, or the parent
+// context is done.
+func (c *timeoutContext) Done() <-chan struct{} {
+	return c.done
+}
+
+// Err returns the timeout error if the timeout expired, or the parent
+// context's error if it is done.
+func (c *timeoutContext) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.err
+}
+
+// stopTimeout stops the context's timeout. The context will still be done
+// when the parent context is.
+func (c *timeoutContext) stopTimeout() {
+	c.stopOnce.Do(func() { close(c.stop) })
+}
+
+// releaseContext stops the context's timeout, and no longer watches the
+// parent context. The context will not be done after it is released.
+func (c *timeoutContext) releaseContext() {
+	c.relOnce.Do(func() { close(c.release) })
+}
+
+// timedOut returns if the context's timeout expired, and not because the
+// parent context is done.
+func (c *timeoutContext) timedOut() bool {
+	err := c.Err()
+	return err != nil && err == c.timeoutErr
+}
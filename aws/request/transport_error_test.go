@@ -0,0 +1,196 @@
+package request_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+)
+
+// newClosedBodyServer returns a server which closes the connection part way
+// through the response body of the first broken requests it receives.
+func newClosedBodyServer(t *testing.T, broken int) (*httptest.Server, func() int) {
+	var mu sync.Mutex
+	var attempts int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts++
+		n := attempts
+		mu.Unlock()
+
+		body := `{"data":"valid"}`
+		if n > broken {
+			w.Write([]byte(body))
+			return
+		}
+
+		w.Header().Set("Content-Length", "100")
+		w.WriteHeader(200)
+		w.Write([]byte(body[:5]))
+		w.(http.Flusher).Flush()
+
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("expect no error hijacking connection, got %v", err)
+			return
+		}
+		conn.Close()
+	}))
+
+	return server, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return attempts
+	}
+}
+
+func unmarshalSerialization(r *request.Request) {
+	defer r.HTTPResponse.Body.Close()
+	if err := json.NewDecoder(r.HTTPResponse.Body).Decode(r.Data); err != nil {
+		r.Error = awserr.New(request.ErrCodeSerialization, "failed decoding response", err)
+	}
+}
+
+func newClosedBodyRequest(endpoint, method string) *request.Request {
+	svc := awstesting.NewClient(aws.NewConfig().
+		WithEndpoint(endpoint).
+		WithRegion("mock-region").
+		WithDisableSSL(true).
+		WithMaxRetries(2).
+		WithSleepDelay(func(time.Duration) {}))
+	svc.Handlers.Unmarshal.PushBack(unmarshalSerialization)
+	svc.Handlers.UnmarshalError.PushBack(unmarshalError)
+
+	return svc.NewRequest(&request.Operation{
+		Name:       "Operation",
+		HTTPMethod: method,
+		HTTPPath:   "/",
+	}, nil, &testData{})
+}
+
+func TestTransportErrorRetry(t *testing.T) {
+	cases := map[string]struct {
+		Method         string
+		Options        []request.Option
+		ExpectAttempts int
+		ExpectErr      bool
+	}{
+		"GET": {
+			Method:         "GET",
+			ExpectAttempts: 2,
+		},
+		"POST": {
+			Method:         "POST",
+			ExpectAttempts: 1,
+			ExpectErr:      true,
+		},
+		"POST idempotent": {
+			Method:         "POST",
+			Options:        []request.Option{request.WithIdempotent()},
+			ExpectAttempts: 2,
+		},
+	}
+
+	for name, c := range cases {
+		server, attempts := newClosedBodyServer(t, 1)
+
+		r := newClosedBodyRequest(server.URL, c.Method)
+		r.ApplyOptions(c.Options...)
+		err := r.Send()
+		server.Close()
+
+		if e, a := c.ExpectAttempts, attempts(); e != a {
+			t.Errorf("%s, expect %d attempts, got %d", name, e, a)
+		}
+		if !c.ExpectErr {
+			if err != nil {
+				t.Errorf("%s, expect no error, got %v", name, err)
+			}
+			if e, a := "valid", r.Data.(*testData).Data; e != a {
+				t.Errorf("%s, expect %q data, got %q", name, e, a)
+			}
+			continue
+		}
+
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			t.Fatalf("%s, expect awserr.Error, got %T, %v", name, err, err)
+		}
+		if e, a := request.ErrCodeSerialization, aerr.Code(); e != a {
+			t.Errorf("%s, expect %q error code, got %q", name, e, a)
+		}
+		if e, a := io.ErrUnexpectedEOF, aerr.OrigErr(); e != a {
+			t.Errorf("%s, expect %v original error, got %v", name, e, a)
+		}
+	}
+}
+
+func TestWithIdempotent(t *testing.T) {
+	op := &request.Operation{Name: "Operation", HTTPMethod: "POST"}
+	r := &request.Request{Operation: op}
+	if r.IsIdempotent() {
+		t.Errorf("expect POST operation not to be idempotent")
+	}
+
+	r.ApplyOptions(request.WithIdempotent())
+	if !r.IsIdempotent() {
+		t.Errorf("expect request to be idempotent")
+	}
+	if op.Idempotent {
+		t.Errorf("expect operation not to be modified")
+	}
+}
+
+func TestTransportErrorRetry_ConnectionReset(t *testing.T) {
+	cases := map[string]struct {
+		Method         string
+		Options        []request.Option
+		ExpectAttempts int
+	}{
+		"GET": {
+			Method:         "GET",
+			ExpectAttempts: 3,
+		},
+		"POST": {
+			Method:         "POST",
+			ExpectAttempts: 1,
+		},
+		"POST idempotent": {
+			Method:         "POST",
+			Options:        []request.Option{request.WithIdempotent()},
+			ExpectAttempts: 3,
+		},
+	}
+
+	for name, c := range cases {
+		r := newClosedBodyRequest("http://localhost", c.Method)
+		var attempts int
+		r.Handlers.Send.Clear()
+		r.Handlers.Send.PushBack(func(r *request.Request) {
+			attempts++
+			r.HTTPResponse = &http.Response{StatusCode: 200, Body: &connResetCloser{}}
+		})
+		r.ApplyOptions(c.Options...)
+		err := r.Send()
+
+		if e, a := c.ExpectAttempts, attempts; e != a {
+			t.Errorf("%s, expect %d attempts, got %d", name, e, a)
+		}
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			t.Fatalf("%s, expect awserr.Error, got %T, %v", name, err, err)
+		}
+		if e, a := stubConnectionResetError, aerr.OrigErr(); e != a {
+			t.Errorf("%s, expect %v original error, got %v", name, e, a)
+		}
+	}
+}
@@ -3,6 +3,9 @@ package request
 import (
 	"bytes"
 	"fmt"
+	"regexp"
+	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
@@ -17,6 +20,20 @@ const (
 	ParamMinValueErrCode = "ParamMinValueError"
 	// ParamMinLenErrCode is the error code for fields without enough elements.
 	ParamMinLenErrCode = "ParamMinLenError"
+	// ParamFormatErrCode is the error code for fields with a value that is
+	// not in the field's required format.
+	ParamFormatErrCode = "ParamFormatInvalidError"
+	// ParamMaxValueErrCode is the error code for fields with too high of a
+	// number value.
+	ParamMaxValueErrCode = "ParamMaxValueError"
+	// ParamMaxLenErrCode is the error code for fields with too many elements.
+	ParamMaxLenErrCode = "ParamMaxLenError"
+	// ParamPatternErrCode is the error code for fields with a value that does
+	// not match the field's pattern.
+	ParamPatternErrCode = "ParamPatternError"
+	// ParamEnumErrCode is the error code for fields with a value that is not
+	// one of the field's enum values.
+	ParamEnumErrCode = "ParamEnumError"
 )
 
 // Validator provides a way for types to perform validation logic on their
@@ -26,6 +43,14 @@ type Validator interface {
 	Validate() error
 }
 
+// ExtendedValidator provides validation of the constraints of a type's
+// input values which are only validated when the client's
+// Config.EnableExtendedValidation is set, such as maximum lengths, patterns,
+// and enum values.
+type ExtendedValidator interface {
+	ValidateExtended() error
+}
+
 // An ErrInvalidParams provides wrapping of invalid parameter errors found when
 // validating API operation input parameters.
 type ErrInvalidParams struct {
@@ -55,6 +80,17 @@ func (e *ErrInvalidParams) AddNested(nestedCtx string, nested ErrInvalidParams)
 	}
 }
 
+// Merge adds the invalid parameter errors from another ErrInvalidParams
+// value of the same input into this collection.
+//
+// Use for merging the errors of Validate and ValidateExtended.
+func (e *ErrInvalidParams) Merge(other ErrInvalidParams) {
+	for _, err := range other.errs {
+		err.SetContext(e.Context)
+		e.errs = append(e.errs, err)
+	}
+}
+
 // Len returns the number of invalid parameter errors
 func (e ErrInvalidParams) Len() int {
 	return len(e.errs)
@@ -232,3 +268,163 @@ func NewErrParamMinLen(field string, min int) *ErrParamMinLen {
 func (e *ErrParamMinLen) MinLen() int {
 	return e.min
 }
+
+// An ErrParamFormat represents an invalid format parameter error.
+type ErrParamFormat struct {
+	errInvalidParam
+	format string
+}
+
+// NewErrParamFormat creates a new invalid format parameter error.
+func NewErrParamFormat(field string, format, value string) *ErrParamFormat {
+	return &ErrParamFormat{
+		errInvalidParam: errInvalidParam{
+			code:  ParamFormatErrCode,
+			field: field,
+			msg:   fmt.Sprintf("format %v, %v", format, value),
+		},
+		format: format,
+	}
+}
+
+// Format returns the field's required format.
+func (e *ErrParamFormat) Format() string {
+	return e.format
+}
+
+// An ErrParamMaxValue represents a maximum value parameter error.
+type ErrParamMaxValue struct {
+	errInvalidParam
+	max float64
+}
+
+// NewErrParamMaxValue creates a new maximum value parameter error.
+func NewErrParamMaxValue(field string, max float64) *ErrParamMaxValue {
+	return &ErrParamMaxValue{
+		errInvalidParam: errInvalidParam{
+			code:  ParamMaxValueErrCode,
+			field: field,
+			msg:   fmt.Sprintf("maximum field value of %v", max),
+		},
+		max: max,
+	}
+}
+
+// MaxValue returns the field's required maximum value.
+//
+// float64 is returned for both int and float max values.
+func (e *ErrParamMaxValue) MaxValue() float64 {
+	return e.max
+}
+
+// An ErrParamMaxLen represents a maximum length parameter error.
+type ErrParamMaxLen struct {
+	errInvalidParam
+	max int
+}
+
+// NewErrParamMaxLen creates a new maximum length parameter error.
+func NewErrParamMaxLen(field string, max int) *ErrParamMaxLen {
+	return &ErrParamMaxLen{
+		errInvalidParam: errInvalidParam{
+			code:  ParamMaxLenErrCode,
+			field: field,
+			msg:   fmt.Sprintf("maximum field size of %v", max),
+		},
+		max: max,
+	}
+}
+
+// MaxLen returns the field's required maximum length.
+func (e *ErrParamMaxLen) MaxLen() int {
+	return e.max
+}
+
+// An ErrParamPattern represents a parameter error for a value not matching
+// the field's pattern.
+type ErrParamPattern struct {
+	errInvalidParam
+	pattern string
+}
+
+// NewErrParamPattern creates a new pattern parameter error.
+func NewErrParamPattern(field string, pattern string) *ErrParamPattern {
+	return &ErrParamPattern{
+		errInvalidParam: errInvalidParam{
+			code:  ParamPatternErrCode,
+			field: field,
+			msg:   fmt.Sprintf("field value must match pattern %v", pattern),
+		},
+		pattern: pattern,
+	}
+}
+
+// Pattern returns the field's required pattern.
+func (e *ErrParamPattern) Pattern() string {
+	return e.pattern
+}
+
+// An ErrParamEnum represents a parameter error for a value which is not one
+// of the field's enum values.
+type ErrParamEnum struct {
+	errInvalidParam
+	value  string
+	values []string
+}
+
+// NewErrParamEnum creates a new enum parameter error.
+func NewErrParamEnum(field string, value string, values []string) *ErrParamEnum {
+	return &ErrParamEnum{
+		errInvalidParam: errInvalidParam{
+			code:  ParamEnumErrCode,
+			field: field,
+			msg: fmt.Sprintf("field value %q must be one of %s",
+				value, strings.Join(values, ", ")),
+		},
+		value:  value,
+		values: values,
+	}
+}
+
+// Value returns the field's invalid value.
+func (e *ErrParamEnum) Value() string {
+	return e.value
+}
+
+// Values returns the field's enum values.
+func (e *ErrParamEnum) Values() []string {
+	return e.values
+}
+
+// IsParamEnumValue returns if the value is one of the enum values.
+func IsParamEnumValue(value string, values []string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+var paramPatterns = struct {
+	sync.Mutex
+	m map[string]*regexp.Regexp
+}{m: map[string]*regexp.Regexp{}}
+
+// MatchParamPattern returns if the whole value matches the pattern. The
+// compiled patterns are cached. Patterns which cannot be compiled match all
+// values, since they cannot be validated client-side.
+func MatchParamPattern(pattern, value string) bool {
+	paramPatterns.Lock()
+	re, ok := paramPatterns.m[pattern]
+	if !ok {
+		re, _ = regexp.Compile("^(?:" + pattern + ")$")
+		paramPatterns.m[pattern] = re
+	}
+	paramPatterns.Unlock()
+
+	if re == nil {
+		return true
+	}
+	return re.MatchString(value)
+}
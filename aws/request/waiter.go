@@ -49,6 +49,33 @@ func WithWaiterDelay(delayer WaiterDelay) WaiterOption {
 	}
 }
 
+// WithWaiterMaxWaitTime returns a waiter option setting the maximum total
+// time the waiter will wait for the resource to reach the target state.
+func WithWaiterMaxWaitTime(max time.Duration) WaiterOption {
+	return func(w *Waiter) {
+		w.MaxWaitTime = max
+	}
+}
+
+// WithWaiterBeforeAttempt returns a waiter option setting the function the
+// waiter calls before each attempt to check the resource state, such as to
+// log the waiter's progress.
+func WithWaiterBeforeAttempt(fn func(attempt int)) WaiterOption {
+	return func(w *Waiter) {
+		w.BeforeAttempt = fn
+	}
+}
+
+// WithWaiterAfterAttempt returns a waiter option setting the function the
+// waiter calls after each attempt's request is sent, with the request and
+// its error, before the response is compared against the waiter's
+// acceptors.
+func WithWaiterAfterAttempt(fn func(attempt int, req *Request, err error)) WaiterOption {
+	return func(w *Waiter) {
+		w.AfterAttempt = fn
+	}
+}
+
 // WithWaiterLogger returns a waiter option to set the logger a waiter
 // should use to log warnings and errors to.
 func WithWaiterLogger(logger aws.Logger) WaiterOption {
@@ -79,6 +106,16 @@ type Waiter struct {
 	MaxAttempts int
 	Delay       WaiterDelay
 
+	// MaxWaitTime limits the total time the waiter waits for the resource,
+	// in addition to MaxAttempts. The waiter's requests and delays are
+	// canceled once the time has elapsed. No limit if zero.
+	MaxWaitTime time.Duration
+
+	// BeforeAttempt and AfterAttempt are called before and after each
+	// attempt to check the resource state, if set.
+	BeforeAttempt func(attempt int)
+	AfterAttempt  func(attempt int, req *Request, err error)
+
 	RequestOptions   []Option
 	NewRequest       func([]Option) (*Request, error)
 	SleepWithContext func(aws.Context, time.Duration) error
@@ -161,28 +198,57 @@ func (m WaiterMatchMode) String() string {
 // Use aws.BackgroundContext if no context is available.
 //
 // The waiter will continue until the target state defined by the Acceptors,
-// or the max attempts expires.
+// the max attempts expires, or the max wait time elapses.
 //
 // Will return the WaiterResourceNotReadyErrorCode error code if the waiter's
 // retryer ShouldRetry returns false. This normally will happen when the max
-// wait attempts expires.
+// wait attempts expires. The error code is also returned when the waiter's
+// MaxWaitTime elapses, wrapping the error of the waiter's last failed
+// attempt, if any.
 func (w Waiter) WaitWithContext(ctx aws.Context) error {
+	var waitCtx *timeoutContext
+	if w.MaxWaitTime > 0 {
+		waitCtx = newTimeoutContext(ctx, w.MaxWaitTime, errWaiterMaxWaitTime)
+		defer waitCtx.releaseContext()
+		ctx = waitCtx
+	}
 
+	var lastErr error
 	for attempt := 1; ; attempt++ {
+		if w.BeforeAttempt != nil {
+			w.BeforeAttempt(attempt)
+		}
+
 		req, err := w.NewRequest(w.RequestOptions)
 		if err != nil {
 			waiterLogf(w.Logger, "unable to create request %v", err)
 			return err
 		}
+		if waitCtx != nil {
+			req.SetContext(waitCtx)
+		}
 		req.Handlers.Build.PushBack(MakeAddToUserAgentFreeFormHandler("Waiter"))
 		err = req.Send()
 
+		if w.AfterAttempt != nil {
+			w.AfterAttempt(attempt, req, err)
+		}
+
+		// The request was interrupted by the max wait time elapsing, its
+		// error is not from the resource.
+		if err != nil && waitCtx != nil && waitCtx.timedOut() {
+			return maxWaitTimeError(lastErr)
+		}
+
 		// See if any of the acceptors match the request's response, or error
 		for _, a := range w.Acceptors {
 			if matched, matchErr := a.match(w.Name, w.Logger, req, err); matched {
 				return matchErr
 			}
 		}
+		if err != nil {
+			lastErr = err
+		}
 
 		// The Waiter should only check the resource state MaxAttempts times
 		// This is here instead of in the for loop above to prevent delaying
@@ -203,14 +269,29 @@ func (w Waiter) WaitWithContext(ctx aws.Context) error {
 			}
 
 			if err := sleepCtxFn(ctx, delay); err != nil {
+				if waitCtx != nil && waitCtx.timedOut() {
+					return maxWaitTimeError(lastErr)
+				}
 				return awserr.New(CanceledErrorCode, "waiter context canceled", err)
 			}
 		}
+
+		if waitCtx != nil && waitCtx.timedOut() {
+			return maxWaitTimeError(lastErr)
+		}
 	}
 
 	return awserr.New(WaiterResourceNotReadyErrorCode, "exceeded wait attempts", nil)
 }
 
+// errWaiterMaxWaitTime is the error of a waiter's context once the waiter's
+// MaxWaitTime has elapsed.
+var errWaiterMaxWaitTime = awserr.New(WaiterResourceNotReadyErrorCode, "exceeded max wait time", nil)
+
+func maxWaitTimeError(lastErr error) error {
+	return awserr.New(WaiterResourceNotReadyErrorCode, "exceeded max wait time", lastErr)
+}
+
 // A WaiterAcceptor provides the information needed to wait for an API operation
 // to complete.
 type WaiterAcceptor struct {
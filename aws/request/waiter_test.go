@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"testing"
 	"time"
 
@@ -652,3 +653,153 @@ func TestWaiterWithContextNilInput(t *testing.T) {
 		t.Fatalf("expect no error, but got %v", err)
 	}
 }
+
+func TestWaiter_MaxWaitTime(t *testing.T) {
+	c := awstesting.NewClient()
+
+	reqCount := 0
+	notReadyErr := awserr.New("NotReady", "resource not ready", nil)
+
+	w := request.Waiter{
+		Name:        "TestWaiter",
+		MaxAttempts: 1000,
+		Delay:       request.ConstantWaiterDelay(10 * time.Millisecond),
+		Acceptors: []request.WaiterAcceptor{
+			{
+				State:    request.SuccessWaiterState,
+				Matcher:  request.StatusWaiterMatch,
+				Expected: 200,
+			},
+		},
+		Logger: aws.NewDefaultLogger(),
+		NewRequest: func(opts []request.Option) (*request.Request, error) {
+			req := c.NewRequest(&request.Operation{Name: "Operation"}, nil, nil)
+			req.HTTPResponse = &http.Response{StatusCode: http.StatusNotFound}
+			req.Handlers.Clear()
+			req.Data = struct{}{}
+			req.Handlers.Send.PushBack(func(r *request.Request) {
+				reqCount++
+				r.Error = notReadyErr
+			})
+
+			return req, nil
+		},
+	}
+	w.ApplyOptions(request.WithWaiterMaxWaitTime(50 * time.Millisecond))
+
+	start := time.Now()
+	err := w.WaitWithContext(aws.BackgroundContext())
+	if err == nil {
+		t.Fatalf("expect error did not get one")
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("expect waiter to stop after max wait time")
+	}
+
+	aerr := err.(awserr.Error)
+	if e, a := request.WaiterResourceNotReadyErrorCode, aerr.Code(); e != a {
+		t.Errorf("expect %q error code, got %q", e, a)
+	}
+	if e, a := notReadyErr, aerr.OrigErr(); e != a {
+		t.Errorf("expect %v original error, got %v", e, a)
+	}
+	if reqCount == 0 || reqCount >= w.MaxAttempts {
+		t.Errorf("expect max wait time to limit requests, got %d", reqCount)
+	}
+}
+
+func TestWaiter_MaxWaitTimeParentCanceled(t *testing.T) {
+	c := awstesting.NewClient()
+
+	ctx := &awstesting.FakeContext{DoneCh: make(chan struct{})}
+	w := request.Waiter{
+		Name:        "TestWaiter",
+		MaxAttempts: 10,
+		MaxWaitTime: 10 * time.Second,
+		Delay:       request.ConstantWaiterDelay(10 * time.Second),
+		Acceptors: []request.WaiterAcceptor{
+			{
+				State:    request.SuccessWaiterState,
+				Matcher:  request.StatusWaiterMatch,
+				Expected: 200,
+			},
+		},
+		NewRequest: func(opts []request.Option) (*request.Request, error) {
+			req := c.NewRequest(&request.Operation{Name: "Operation"}, nil, nil)
+			req.HTTPResponse = &http.Response{StatusCode: http.StatusNotFound}
+			req.Handlers.Clear()
+			req.Data = struct{}{}
+			req.Handlers.Send.PushBack(func(r *request.Request) {
+				ctx.Error = fmt.Errorf("context canceled")
+				close(ctx.DoneCh)
+			})
+
+			return req, nil
+		},
+	}
+
+	err := w.WaitWithContext(ctx)
+	if err == nil {
+		t.Fatalf("expect error did not get one")
+	}
+	aerr := err.(awserr.Error)
+	if e, a := request.CanceledErrorCode, aerr.Code(); e != a {
+		t.Errorf("expect %q error code, got %q", e, a)
+	}
+	if e, a := ctx.Error, aerr.OrigErr(); e != a {
+		t.Errorf("expect %v original error, got %v", e, a)
+	}
+}
+
+func TestWaiter_AttemptCallbacks(t *testing.T) {
+	c := awstesting.NewClient()
+
+	statuses := []int{http.StatusNotFound, http.StatusNotFound, http.StatusOK}
+	reqCount := 0
+	var calls []string
+
+	w := request.Waiter{
+		Name:        "TestWaiter",
+		MaxAttempts: 10,
+		Delay:       request.ConstantWaiterDelay(0),
+		Acceptors: []request.WaiterAcceptor{
+			{
+				State:    request.SuccessWaiterState,
+				Matcher:  request.StatusWaiterMatch,
+				Expected: 200,
+			},
+		},
+		NewRequest: func(opts []request.Option) (*request.Request, error) {
+			req := c.NewRequest(&request.Operation{Name: "Operation"}, nil, nil)
+			req.HTTPResponse = &http.Response{StatusCode: statuses[reqCount]}
+			req.Handlers.Clear()
+			req.Data = struct{}{}
+			req.Handlers.Send.PushBack(func(r *request.Request) {
+				reqCount++
+			})
+
+			return req, nil
+		},
+	}
+	w.ApplyOptions(
+		request.WithWaiterBeforeAttempt(func(attempt int) {
+			calls = append(calls, fmt.Sprintf("before %d", attempt))
+		}),
+		request.WithWaiterAfterAttempt(func(attempt int, req *request.Request, err error) {
+			calls = append(calls, fmt.Sprintf("after %d %d", attempt, req.HTTPResponse.StatusCode))
+		}),
+	)
+
+	if err := w.WaitWithContext(aws.BackgroundContext()); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := []string{
+		"before 1", "after 1 404",
+		"before 2", "after 2 404",
+		"before 3", "after 3 200",
+	}
+	if e, a := expect, calls; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+}
@@ -0,0 +1,169 @@
+package session
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
+)
+
+// ConfigSourceKind is the kind of source a configuration value of a Session
+// was resolved from.
+type ConfigSourceKind string
+
+const (
+	// DefaultConfigSourceKind is the kind of the values which are not
+	// configured by any source, and are the SDK's defaults.
+	DefaultConfigSourceKind ConfigSourceKind = "Default"
+
+	// ProgrammaticConfigSourceKind is the kind of the values configured by
+	// the aws.Config, or Options, the Session was created with.
+	ProgrammaticConfigSourceKind ConfigSourceKind = "Programmatic"
+
+	// EnvConfigSourceKind is the kind of the values configured by an
+	// environment variable.
+	EnvConfigSourceKind ConfigSourceKind = "Environment"
+
+	// SharedConfigSourceKind is the kind of the values configured by a key
+	// of a shared config, or shared credentials, file profile.
+	SharedConfigSourceKind ConfigSourceKind = "SharedConfig"
+)
+
+// ConfigSource is the source a configuration value of a Session was resolved
+// from. The zero value is the source of the values not resolved by the
+// Session, such as the values of a Session created with New.
+type ConfigSource struct {
+	// Kind of the source.
+	Kind ConfigSourceKind
+
+	// Name of the environment variable, or of the shared config key, the
+	// value was resolved from. Empty for other kinds of sources.
+	Name string
+
+	// Profile and Filename of the shared config file the value was resolved
+	// from. Empty for other kinds of sources.
+	Profile  string
+	Filename string
+}
+
+// String returns the description of the source.
+func (s ConfigSource) String() string {
+	switch s.Kind {
+	case EnvConfigSourceKind:
+		return fmt.Sprintf("environment variable %s", s.Name)
+	case SharedConfigSourceKind:
+		return fmt.Sprintf("%s key of shared config profile %s, in %s", s.Name, s.Profile, s.Filename)
+	case ProgrammaticConfigSourceKind:
+		return "programmatic configuration"
+	case DefaultConfigSourceKind:
+		return "SDK default"
+	default:
+		return "unknown"
+	}
+}
+
+// ConfigSources are the sources the configuration of a Session was resolved
+// from, see Session.ConfigSources. Only the sources of the values are
+// reported, never the values themselves, so the report is safe to log.
+type ConfigSources struct {
+	// Region of the Session.
+	Region ConfigSource
+
+	// Credentials of the Session, and the type of their provider, such as
+	// StaticProvider, or AssumeRoleProvider.
+	//
+	// CredentialsProvider is empty for the credentials set by aws.Config, as
+	// the provider of those credentials is not known without retrieving
+	// them. For the SDK's default credential chain the provider is the
+	// remote provider the chain falls back to, EC2RoleProvider, or
+	// CredentialsEndpointProvider.
+	Credentials         ConfigSource
+	CredentialsProvider string
+
+	// Endpoint options of the Session.
+	UseFIPSEndpoint           ConfigSource
+	UseDualStackEndpoint      ConfigSource
+	STSRegionalEndpoint       ConfigSource
+	S3UsEast1RegionalEndpoint ConfigSource
+	EnableEndpointDiscovery   ConfigSource
+
+	// Retry options of the Session. MaxAttempts is the source of the
+	// Session's maximum number of retries.
+	RetryMode   ConfigSource
+	MaxAttempts ConfigSource
+}
+
+// ConfigSources returns the sources the Session's configuration was resolved
+// from when the Session was created. Only Sessions created with NewSession,
+// or NewSessionWithOptions, report the sources of their configuration.
+//
+// The Session's configuration values may be modified after the Session is
+// created, the sources report how the values were resolved at creation.
+func (s *Session) ConfigSources() ConfigSources {
+	return s.configSources
+}
+
+// setSharedConfigFilenames sets the file name of the sources resolved from
+// the shared config files.
+func (srcs *ConfigSources) setSharedConfigFilenames(files []sharedConfigFile) {
+	for _, src := range []*ConfigSource{
+		&srcs.Region, &srcs.Credentials,
+		&srcs.UseFIPSEndpoint, &srcs.UseDualStackEndpoint, &srcs.STSRegionalEndpoint,
+		&srcs.S3UsEast1RegionalEndpoint, &srcs.EnableEndpointDiscovery,
+		&srcs.RetryMode, &srcs.MaxAttempts,
+	} {
+		if src.Kind == SharedConfigSourceKind {
+			src.Filename = sharedConfigKeyFilename(src.Profile, src.Name, files)
+		}
+	}
+}
+
+// programmaticConfigSource returns the source of a value configured by the
+// aws.Config, or Options, of the Session.
+func programmaticConfigSource() ConfigSource {
+	return ConfigSource{Kind: ProgrammaticConfigSourceKind}
+}
+
+// defaultConfigSource returns the source of a value not configured by any
+// source.
+func defaultConfigSource() ConfigSource {
+	return ConfigSource{Kind: DefaultConfigSourceKind}
+}
+
+// envConfigSource returns the source of a value resolved from the first of
+// the environment variables which is set.
+func envConfigSource(keys []string) ConfigSource {
+	src := ConfigSource{Kind: EnvConfigSourceKind, Name: keys[0]}
+	for _, k := range keys {
+		if len(os.Getenv(k)) > 0 {
+			src.Name = k
+			break
+		}
+	}
+	return src
+}
+
+// sharedConfigSource returns the source of a value resolved from the key of
+// the shared config profile. The file name is set by
+// ConfigSources.setSharedConfigFilenames.
+func sharedConfigSource(key, profile string) ConfigSource {
+	if len(profile) == 0 {
+		profile = DefaultSharedConfigProfile
+	}
+	return ConfigSource{Kind: SharedConfigSourceKind, Name: key, Profile: profile}
+}
+
+// remoteCredProviderName returns the name of the remote credentials provider
+// of the SDK's default credential chain.
+func remoteCredProviderName(p credentials.Provider) string {
+	switch p.(type) {
+	case *ec2rolecreds.EC2RoleProvider:
+		return ec2rolecreds.ProviderName
+	case *endpointcreds.Provider:
+		return endpointcreds.ProviderName
+	default:
+		return ""
+	}
+}
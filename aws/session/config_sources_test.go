@@ -0,0 +1,222 @@
+package session
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/awstesting"
+)
+
+func TestSession_ConfigSources(t *testing.T) {
+	defaultSrc := ConfigSource{Kind: DefaultConfigSourceKind}
+	programmaticSrc := ConfigSource{Kind: ProgrammaticConfigSourceKind}
+
+	cases := map[string]struct {
+		Envs    map[string]string
+		Config  aws.Config
+		Profile string
+		Expect  ConfigSources
+	}{
+		"default": {
+			Expect: ConfigSources{
+				Region:                    defaultSrc,
+				Credentials:               defaultSrc,
+				CredentialsProvider:       ec2rolecreds.ProviderName,
+				UseFIPSEndpoint:           defaultSrc,
+				UseDualStackEndpoint:      defaultSrc,
+				STSRegionalEndpoint:       defaultSrc,
+				S3UsEast1RegionalEndpoint: defaultSrc,
+				EnableEndpointDiscovery:   defaultSrc,
+				RetryMode:                 defaultSrc,
+				MaxAttempts:               defaultSrc,
+			},
+		},
+		"programmatic": {
+			Config: aws.Config{
+				Region:               aws.String("us-west-2"),
+				Credentials:          credentials.NewStaticCredentials("AKID", "SECRET", ""),
+				UseFIPSEndpoint:      aws.Bool(true),
+				UseDualStackEndpoint: aws.Bool(true),
+				RetryMode:            aws.String(aws.StandardRetryMode),
+				MaxRetries:           aws.Int(2),
+			},
+			Envs: map[string]string{
+				"AWS_REGION":            "us-east-1",
+				"AWS_ACCESS_KEY_ID":     "env_akid",
+				"AWS_SECRET_ACCESS_KEY": "env_secret",
+			},
+			Expect: ConfigSources{
+				Region:                    programmaticSrc,
+				Credentials:               programmaticSrc,
+				UseFIPSEndpoint:           programmaticSrc,
+				UseDualStackEndpoint:      programmaticSrc,
+				STSRegionalEndpoint:       defaultSrc,
+				S3UsEast1RegionalEndpoint: defaultSrc,
+				EnableEndpointDiscovery:   defaultSrc,
+				RetryMode:                 programmaticSrc,
+				MaxAttempts:               programmaticSrc,
+			},
+		},
+		"env over shared config": {
+			Envs: map[string]string{
+				"AWS_SDK_LOAD_CONFIG":        "1",
+				"AWS_REGION":                 "us-east-1",
+				"AWS_USE_FIPS_ENDPOINT":      "true",
+				"AWS_STS_REGIONAL_ENDPOINTS": "regional",
+				"AWS_MAX_ATTEMPTS":           "3",
+			},
+			Profile: "full_profile",
+			Expect: ConfigSources{
+				Region: ConfigSource{Kind: EnvConfigSourceKind, Name: "AWS_REGION"},
+				Credentials: ConfigSource{
+					Kind: SharedConfigSourceKind, Name: "aws_access_key_id",
+					Profile: "full_profile", Filename: testConfigFilename,
+				},
+				CredentialsProvider:       credentials.StaticProviderName,
+				UseFIPSEndpoint:           ConfigSource{Kind: EnvConfigSourceKind, Name: "AWS_USE_FIPS_ENDPOINT"},
+				UseDualStackEndpoint:      defaultSrc,
+				STSRegionalEndpoint:       ConfigSource{Kind: EnvConfigSourceKind, Name: "AWS_STS_REGIONAL_ENDPOINTS"},
+				S3UsEast1RegionalEndpoint: defaultSrc,
+				EnableEndpointDiscovery:   defaultSrc,
+				RetryMode:                 defaultSrc,
+				MaxAttempts:               ConfigSource{Kind: EnvConfigSourceKind, Name: "AWS_MAX_ATTEMPTS"},
+			},
+		},
+		"shared config": {
+			Envs: map[string]string{
+				"AWS_SDK_LOAD_CONFIG":   "1",
+				"AWS_ACCESS_KEY_ID":     "env_akid",
+				"AWS_SECRET_ACCESS_KEY": "env_secret",
+			},
+			Profile: "retry_mode",
+			Expect: ConfigSources{
+				Region:                    defaultSrc,
+				Credentials:               ConfigSource{Kind: EnvConfigSourceKind, Name: "AWS_ACCESS_KEY_ID"},
+				CredentialsProvider:       credentials.StaticProviderName,
+				UseFIPSEndpoint:           defaultSrc,
+				UseDualStackEndpoint:      defaultSrc,
+				STSRegionalEndpoint:       defaultSrc,
+				S3UsEast1RegionalEndpoint: defaultSrc,
+				EnableEndpointDiscovery:   defaultSrc,
+				RetryMode: ConfigSource{
+					Kind: SharedConfigSourceKind, Name: "retry_mode",
+					Profile: "retry_mode", Filename: testConfigFilename,
+				},
+				MaxAttempts: ConfigSource{
+					Kind: SharedConfigSourceKind, Name: "max_attempts",
+					Profile: "retry_mode", Filename: testConfigFilename,
+				},
+			},
+		},
+		"shared config file precedence": {
+			Envs: map[string]string{
+				"AWS_SDK_LOAD_CONFIG":         "1",
+				"AWS_SHARED_CREDENTIALS_FILE": testConfigOtherFilename,
+			},
+			Profile: "config_file_load_order",
+			Expect: ConfigSources{
+				Region: ConfigSource{
+					Kind: SharedConfigSourceKind, Name: "region",
+					Profile: "config_file_load_order", Filename: testConfigOtherFilename,
+				},
+				Credentials: ConfigSource{
+					Kind: SharedConfigSourceKind, Name: "aws_access_key_id",
+					Profile: "config_file_load_order", Filename: testConfigOtherFilename,
+				},
+				CredentialsProvider:       credentials.StaticProviderName,
+				UseFIPSEndpoint:           defaultSrc,
+				UseDualStackEndpoint:      defaultSrc,
+				STSRegionalEndpoint:       defaultSrc,
+				S3UsEast1RegionalEndpoint: defaultSrc,
+				EnableEndpointDiscovery:   defaultSrc,
+				RetryMode:                 defaultSrc,
+				MaxAttempts:               defaultSrc,
+			},
+		},
+		"assume role": {
+			Envs:    map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile: "assume_role",
+			Expect: ConfigSources{
+				Region: defaultSrc,
+				Credentials: ConfigSource{
+					Kind: SharedConfigSourceKind, Name: "role_arn",
+					Profile: "assume_role", Filename: testConfigFilename,
+				},
+				CredentialsProvider:       stscreds.ProviderName,
+				UseFIPSEndpoint:           defaultSrc,
+				UseDualStackEndpoint:      defaultSrc,
+				STSRegionalEndpoint:       defaultSrc,
+				S3UsEast1RegionalEndpoint: defaultSrc,
+				EnableEndpointDiscovery:   defaultSrc,
+				RetryMode:                 defaultSrc,
+				MaxAttempts:               defaultSrc,
+			},
+		},
+	}
+
+	for name, c := range cases {
+		oldEnv := initSessionTestEnv()
+		os.Setenv("AWS_CONFIG_FILE", testConfigFilename)
+		for k, v := range c.Envs {
+			os.Setenv(k, v)
+		}
+
+		s, err := NewSessionWithOptions(Options{
+			Config:  c.Config,
+			Profile: c.Profile,
+		})
+		awstesting.PopEnv(oldEnv)
+		if err != nil {
+			t.Errorf("%s, expect no error, got %v", name, err)
+			continue
+		}
+
+		if e, a := c.Expect, s.ConfigSources(); !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect sources\n%#v\ngot\n%#v", name, e, a)
+		}
+		if e, a := c.Expect, s.Copy().ConfigSources(); !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect copied session sources\n%#v\ngot\n%#v", name, e, a)
+		}
+	}
+}
+
+func TestConfigSource_String(t *testing.T) {
+	cases := []struct {
+		Source ConfigSource
+		Expect string
+	}{
+		{
+			Source: ConfigSource{Kind: DefaultConfigSourceKind},
+			Expect: "SDK default",
+		},
+		{
+			Source: ConfigSource{Kind: ProgrammaticConfigSourceKind},
+			Expect: "programmatic configuration",
+		},
+		{
+			Source: ConfigSource{Kind: EnvConfigSourceKind, Name: "AWS_REGION"},
+			Expect: "environment variable AWS_REGION",
+		},
+		{
+			Source: ConfigSource{
+				Kind: SharedConfigSourceKind, Name: "region",
+				Profile: "default", Filename: "/home/user/.aws/config",
+			},
+			Expect: "region key of shared config profile default, in /home/user/.aws/config",
+		},
+		{
+			Expect: "unknown",
+		},
+	}
+
+	for i, c := range cases {
+		if e, a := c.Expect, c.Source.String(); e != a {
+			t.Errorf("%d, expect %q, got %q", i, e, a)
+		}
+	}
+}
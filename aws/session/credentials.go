@@ -0,0 +1,186 @@
+package session
+
+import (
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/cache"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/processcreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/internal/shareddefaults"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// credChain returns the default credential chain. If the environment
+// configures a web identity role, the role is assumed ahead of the remote
+// credential providers.
+func credChain(cfg *aws.Config, handlers request.Handlers, envCfg envConfig) *credentials.Credentials {
+	providers := []credentials.Provider{
+		&credentials.EnvProvider{},
+		&credentials.SharedCredentialsProvider{Filename: "", Profile: ""},
+	}
+	if len(envCfg.WebIdentityTokenFilePath) > 0 && len(envCfg.RoleARN) > 0 {
+		cfgCp := *cfg
+		svc := sts.New(&Session{
+			Config:   &cfgCp,
+			Handlers: handlers.Copy(),
+		})
+		providers = append(providers, stscreds.NewWebIdentityRoleProvider(svc,
+			envCfg.RoleARN, envCfg.RoleSessionName,
+			stscreds.FetchTokenPath(envCfg.WebIdentityTokenFilePath)))
+	}
+	providers = append(providers, defaults.RemoteCredProvider(*cfg, handlers))
+
+	return credentials.NewCredentials(&credentials.ChainProvider{
+		Providers: providers,
+	})
+}
+
+// assumeRoleCredentials returns the credentials of the role of the shared
+// config profile, assumed with the credentials of the profile's source. If
+// the source profile assumes a role itself, that role is assumed first,
+// chaining the roles. Each role is assumed with its own STS client, in the
+// region of the config.
+func assumeRoleCredentials(cfg *aws.Config, envCfg envConfig, sharedCfg sharedConfig, handlers request.Handlers, sessOpts Options) (*credentials.Credentials, error) {
+	var srcCreds *credentials.Credentials
+	var err error
+
+	switch src := sharedCfg.AssumeRoleSource; {
+	case len(sharedCfg.AssumeRole.CredentialSource) > 0:
+		srcCreds, err = credentialSourceCredentials(cfg, envCfg, sharedCfg.AssumeRole.CredentialSource, handlers, sessOpts)
+	case src == nil:
+		err = SharedConfigAssumeRoleError{RoleARN: sharedCfg.AssumeRole.RoleARN}
+	case len(src.AssumeRole.RoleARN) > 0:
+		srcCreds, err = assumeRoleCredentials(cfg, envCfg, *src, handlers, sessOpts)
+	case len(src.Creds.AccessKeyID) > 0:
+		srcCreds = credentials.NewStaticCredentialsFromCreds(src.Creds)
+	case len(src.SSO.StartURL) > 0:
+		cfgCp := *cfg
+		srcCreds = ssocreds.NewCredentials(
+			&Session{
+				Config:   &cfgCp,
+				Handlers: handlers.Copy(),
+			},
+			src.SSO.AccountID,
+			src.SSO.Region,
+			src.SSO.RoleName,
+			src.SSO.StartURL,
+			sessOpts.ssoCacheOption,
+		)
+	case len(src.CredentialProcess) > 0:
+		srcCreds = processcreds.NewCredentials(src.CredentialProcess)
+	default:
+		err = SharedConfigAssumeRoleError{RoleARN: sharedCfg.AssumeRole.RoleARN}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sharedCfg.AssumeRole.MFASerial) > 0 && sessOpts.AssumeRoleTokenProvider == nil {
+		// AssumeRole Token provider is required if doing Assume Role
+		// with MFA.
+		return nil, AssumeRoleTokenProviderNotSetError{}
+	}
+
+	cfgCp := *cfg
+	cfgCp.Credentials = srcCreds
+	return stscreds.NewCredentials(
+		&Session{
+			Config:   &cfgCp,
+			Handlers: handlers.Copy(),
+		},
+		sharedCfg.AssumeRole.RoleARN,
+		func(opt *stscreds.AssumeRoleProvider) {
+			opt.RoleSessionName = sharedCfg.AssumeRole.RoleSessionName
+
+			if sessOpts.AssumeRoleDuration > 0 {
+				opt.Duration = sessOpts.AssumeRoleDuration
+			} else if sharedCfg.AssumeRole.Duration > 0 {
+				opt.Duration = sharedCfg.AssumeRole.Duration
+			}
+
+			// Assume role with external ID
+			if len(sharedCfg.AssumeRole.ExternalID) > 0 {
+				opt.ExternalID = aws.String(sharedCfg.AssumeRole.ExternalID)
+			}
+
+			// Assume role with source identity
+			if len(sharedCfg.AssumeRole.SourceIdentity) > 0 {
+				opt.SourceIdentity = aws.String(sharedCfg.AssumeRole.SourceIdentity)
+			}
+
+			// Assume role with MFA
+			if len(sharedCfg.AssumeRole.MFASerial) > 0 {
+				opt.SerialNumber = aws.String(sharedCfg.AssumeRole.MFASerial)
+				opt.TokenProvider = sessOpts.AssumeRoleTokenProvider
+			}
+
+			if sessOpts.EnableSharedCredentialCache {
+				opt.CacheDir = cache.DefaultDir()
+			}
+		},
+	), nil
+}
+
+// ssoCacheOption caches the credentials of the SSO provider if the shared
+// credential cache is enabled.
+func (o Options) ssoCacheOption(p *ssocreds.Provider) {
+	if o.EnableSharedCredentialCache {
+		p.CacheDir = cache.DefaultDir()
+	}
+}
+
+// credentialSourceCredentials returns the credentials of the credential_source
+// of a shared config profile.
+func credentialSourceCredentials(cfg *aws.Config, envCfg envConfig, source string, handlers request.Handlers, sessOpts Options) (*credentials.Credentials, error) {
+	switch source {
+	case credSourceEnvironment:
+		if len(envCfg.Creds.AccessKeyID) == 0 {
+			return nil, awserr.New("EnvAccessKeyNotFound",
+				"failed to find credentials in the environment for credential_source Environment.", nil)
+		}
+		return credentials.NewStaticCredentialsFromCreds(envCfg.Creds), nil
+
+	case credSourceECSContainer:
+		if len(os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI")) == 0 &&
+			len(os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI")) == 0 {
+			return nil, awserr.New("CredentialsEndpointError",
+				"failed to find the container credentials endpoint for credential_source EcsContainer, "+
+					"AWS_CONTAINER_CREDENTIALS_RELATIVE_URI or AWS_CONTAINER_CREDENTIALS_FULL_URI must be set.", nil)
+		}
+		return credentials.NewCredentials(
+			defaults.RemoteCredProviderWithHTTPClient(*cfg, handlers, sessOpts.EC2IMDSHTTPClient),
+		), nil
+
+	case credSourceEc2Metadata:
+		resolver := cfg.EndpointResolver
+		if resolver == nil {
+			resolver = endpoints.DefaultResolver()
+		}
+		e, err := resolver.EndpointFor(endpoints.Ec2metadataServiceID, "")
+		if err != nil {
+			return nil, err
+		}
+		cfgCp := *cfg
+		cfgCp.HTTPClient = sessOpts.EC2IMDSHTTPClient
+		if cfgCp.HTTPClient == nil {
+			cfgCp.HTTPClient = shareddefaults.MetadataHTTPClient()
+		}
+		return credentials.NewCredentials(&ec2rolecreds.EC2RoleProvider{
+			Client:       ec2metadata.NewClient(cfgCp, handlers, e.URL, e.SigningRegion),
+			ExpiryWindow: 5 * time.Minute,
+		}), nil
+
+	default:
+		return nil, SharedConfigCredentialSourceError{CredentialSource: source}
+	}
+}
@@ -2,7 +2,10 @@ package session
 
 import (
 	"bytes"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"os"
@@ -241,3 +244,146 @@ func TestNewSession_WithCustomCABundle_TransportSet(t *testing.T) {
 		t.Errorf("expect %d status code, got %d", e, a)
 	}
 }
+
+func rootCAs(t *testing.T, s *Session) *x509.CertPool {
+	tr, ok := s.Config.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("expect *http.Transport, got %T", s.Config.HTTPClient.Transport)
+	}
+	if tr.TLSClientConfig == nil || tr.TLSClientConfig.RootCAs == nil {
+		t.Fatalf("expect root CAs to be set")
+	}
+	return tr.TLSClientConfig.RootCAs
+}
+
+func TestNewSession_WithCustomCABundle_MultipleCerts(t *testing.T) {
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	bundle := append(append([]byte{}, awstesting.TLSBundleCA...), awstesting.TLSBundleCert...)
+	s, err := NewSessionWithOptions(Options{
+		Config: aws.Config{
+			HTTPClient: &http.Client{},
+		},
+		CustomCABundle: bytes.NewReader(bundle),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 2, len(rootCAs(t, s).Subjects()); e != a {
+		t.Errorf("expect %d root CAs, got %d", e, a)
+	}
+}
+
+func TestNewSession_WithCustomCABundle_InvalidPEM(t *testing.T) {
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	s, err := NewSessionWithOptions(Options{
+		Config: aws.Config{
+			HTTPClient: &http.Client{},
+		},
+		CustomCABundle: strings.NewReader("not a PEM bundle"),
+	})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := "LoadCustomCABundleError", err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %s error code, got %s", e, a)
+	}
+	if s != nil {
+		t.Errorf("expect nil session, got %v", s)
+	}
+}
+
+func TestNewSession_WithCustomCABundle_AppendRootCAs(t *testing.T) {
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(awstesting.TLSBundleCert)
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: pool},
+		},
+	}
+
+	s, err := NewSessionWithOptions(Options{
+		Config: aws.Config{
+			HTTPClient: client,
+		},
+		CustomCABundle: bytes.NewReader(awstesting.TLSBundleCA),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	p := rootCAs(t, s)
+	if p != pool {
+		t.Errorf("expect the transport's root CAs to be appended to")
+	}
+	if e, a := 2, len(p.Subjects()); e != a {
+		t.Errorf("expect %d root CAs, got %d", e, a)
+	}
+}
+
+func TestNewSession_WithCustomCABundle_DefaultClient(t *testing.T) {
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	s, err := NewSessionWithOptions(Options{
+		CustomCABundle: bytes.NewReader(awstesting.TLSBundleCA),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 1, len(rootCAs(t, s).Subjects()); e != a {
+		t.Errorf("expect %d root CAs, got %d", e, a)
+	}
+	if http.DefaultClient.Transport != nil {
+		t.Errorf("expect the default HTTP client not to be modified")
+	}
+}
+
+func TestNewSession_WithCustomCABundle_SharedConfig(t *testing.T) {
+	cases := map[string]struct {
+		EnvBundle string
+		CfgBundle string
+	}{
+		"shared config": {
+			CfgBundle: TLSBundleCAFile,
+		},
+		"environment priority": {
+			EnvBundle: TLSBundleCAFile,
+			CfgBundle: "file-not-exists",
+		},
+	}
+
+	for name, c := range cases {
+		oldEnv := initSessionTestEnv()
+
+		f, err := ioutil.TempFile("", "aws-sdk-go-shared-config")
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		fmt.Fprintf(f, "[default]\nca_bundle = %s\n", c.CfgBundle)
+		f.Close()
+
+		os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+		os.Setenv("AWS_CONFIG_FILE", f.Name())
+		os.Setenv("AWS_CA_BUNDLE", c.EnvBundle)
+
+		s, err := NewSession(&aws.Config{HTTPClient: &http.Client{}})
+		os.Remove(f.Name())
+		awstesting.PopEnv(oldEnv)
+
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := 1, len(rootCAs(t, s).Subjects()); e != a {
+			t.Errorf("%s, expect %d root CAs, got %d", name, e, a)
+		}
+	}
+}
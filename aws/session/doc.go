@@ -103,6 +103,21 @@ handler logs every request and its payload made by a service client:
 			r.ClientInfo.ServiceName, r.Operation, r.Params)
 	})
 
+Configuration Sources
+
+The Session's ConfigSources method reports the source each of the Session's
+region, credentials, endpoint options, and retry options was resolved from,
+such as an environment variable, a shared config profile's key, the Session's
+aws.Config, or the SDK's defaults. Only the sources are reported, not the
+values, so the report is safe to log. Reporting the credentials' provider
+does not retrieve the credentials.
+
+	sess := session.Must(session.NewSession())
+
+	srcs := sess.ConfigSources()
+	logger.Printf("region from %s, credentials from %s (%s)",
+		srcs.Region, srcs.Credentials, srcs.CredentialsProvider)
+
 Deprecated "New" function
 
 The New session function has been deprecated because it does not provide good
@@ -142,19 +157,64 @@ Assume Role values allow you to configure the SDK to assume an IAM role using
 a set of credentials provided in a config file via the source_profile field.
 Both "role_arn" and "source_profile" are required. The SDK supports assuming
 a role with MFA token if the session option AssumeRoleTokenProvider
-is set.
+is set. The duration_seconds field sets how long the role's credentials are
+valid for, between 900 and 43200 seconds, and is overridden by the session
+option AssumeRoleDuration. The source_identity field sets the source identity
+of the role session.
 
 	role_arn = arn:aws:iam::<account_number>:role/<role_name>
 	source_profile = profile_with_creds
 	external_id = 1234
 	mfa_serial = <serial or mfa arn>
 	role_session_name = session_name
+	duration_seconds = 3600
+	source_identity = identity
+
+The source_profile may assume a role itself, in which case the roles are
+assumed in turn, chaining them. A profile may also name itself as its
+source_profile, to assume the role with its own credentials. An error is
+returned if the source profiles form a cycle.
+
+Instead of source_profile, the credential_source field can be set to assume
+the role with credentials that are not in the config files. The value must be
+one of Environment, Ec2InstanceMetadata, or EcsContainer. Only one of
+source_profile and credential_source may be set.
+
+	role_arn = arn:aws:iam::<account_number>:role/<role_name>
+	credential_source = Ec2InstanceMetadata
+
+The credentials of assumed roles can be cached in the ~/.aws/cli/cache
+directory, shared between processes, by setting the session option
+EnableSharedCredentialCache. Processes using the same profile then assume the
+role once until the credentials expire. See the credentials/cache package.
 
 Region is the region the SDK should use for looking up AWS service endpoints
 and signing requests.
 
 	region = us-east-1
 
+STS regional endpoints instructs the SDK to send STS requests, such as the
+requests assuming roles, to the STS endpoint of the region instead of the
+global endpoint, sts.amazonaws.com. The value is legacy, the default, or
+regional.
+
+	sts_regional_endpoints = regional
+
+S3 us-east-1 regional endpoint instructs the SDK to send S3 requests in the
+us-east-1 region to the regional endpoint, s3.us-east-1.amazonaws.com, instead
+of the global endpoint, s3.amazonaws.com. The value is legacy, the default, or
+regional.
+
+	s3_us_east_1_regional_endpoint = regional
+
+Endpoint discovery instructs service clients to discover the endpoints of the
+API operations which support endpoint discovery. The value is true, false, or
+auto, the default. With auto, or false, only the endpoints of operations which
+require endpoint discovery are discovered. Other values fail the creation of
+the Session.
+
+	endpoint_discovery_enabled = true
+
 Assume Role with MFA token
 
 To create a session with support for assuming an IAM role with MFA set the
@@ -209,6 +269,17 @@ not required.
 	# Session Token
 	AWS_SESSION_TOKEN=TOKEN
 
+Web identity role configuration values, such as the values set for pods of
+Kubernetes service accounts. If set both the Role ARN and Web Identity Token
+File must be provided. The role is assumed with the token read from the file,
+and the file is read again each time the credentials are refreshed.
+
+	AWS_ROLE_ARN=arn:aws:iam::012345678901:role/my-role
+	AWS_WEB_IDENTITY_TOKEN_FILE=/var/run/secrets/token
+
+	# Role Session Name, optional. Defaults to the current time.
+	AWS_ROLE_SESSION_NAME=my-session
+
 Region value will instruct the SDK where to make service API requests to. If is
 not provided in the environment the region must be provided before a service
 client request is made.
@@ -219,6 +290,69 @@ client request is made.
 	# and AWS_REGION is not also set.
 	AWS_DEFAULT_REGION=us-east-1
 
+Retry mode selects the retryer of service clients, one of legacy, standard,
+or adaptive. Takes precedence over the retry_mode shared config field. See
+aws.Config.RetryMode.
+
+	AWS_RETRY_MODE=standard
+
+Max attempts is the maximum number of attempts of requests, including the
+first attempt, so the aws.Config MaxRetries is set to one less than the value.
+Takes precedence over the max_attempts shared config field. Invalid retry mode
+and max attempts values fail the creation of the session. Both are ignored if
+the aws.Config sets their option.
+
+	AWS_MAX_ATTEMPTS=3
+
+STS regional endpoints instructs the SDK to send STS requests to the STS
+endpoint of the region instead of the global endpoint. Takes precedence over
+the sts_regional_endpoints shared config field.
+
+	AWS_STS_REGIONAL_ENDPOINTS=regional
+
+S3 us-east-1 regional endpoint instructs the SDK to send S3 requests in the
+us-east-1 region to the regional endpoint instead of the global endpoint.
+Takes precedence over the s3_us_east_1_regional_endpoint shared config field.
+
+	AWS_S3_US_EAST_1_REGIONAL_ENDPOINT=regional
+
+Endpoint discovery instructs service clients to discover the endpoints of the
+API operations which support endpoint discovery, true, false, or auto. Takes
+precedence over the endpoint_discovery_enabled shared config field.
+
+	AWS_ENABLE_ENDPOINT_DISCOVERY=true
+
+FIPS endpoints instructs the SDK to send requests to the FIPS endpoints of
+services. Requests fail if the service does not have a FIPS endpoint for the
+region. Takes precedence over the use_fips_endpoint shared config field.
+
+	AWS_USE_FIPS_ENDPOINT=true
+
+Dualstack endpoints instructs the SDK to send requests to the dualstack
+endpoints of services, supporting both IPv4 and IPv6. Requests fail if the
+service does not have a dualstack endpoint for the region. Takes precedence
+over the use_dualstack_endpoint shared config field.
+
+	AWS_USE_DUALSTACK_ENDPOINT=true
+
+Endpoint URLs instruct the SDK to send the requests of service clients to
+the endpoint URL instead of the service's resolved endpoint. The endpoint URL
+of a single service is set with the AWS_ENDPOINT_URL_<SERVICE> environment
+variable, where SERVICE is the service's ID, e.g. "Elastic Beanstalk", upper
+cased with spaces replaced by underscores. Service endpoint URLs take
+precedence over the AWS_ENDPOINT_URL environment variable, and both take
+precedence over the shared config's endpoint URLs. The aws.Config Endpoint
+value takes precedence over all configured endpoint URLs.
+
+	AWS_ENDPOINT_URL=http://localhost:4566
+	AWS_ENDPOINT_URL_ELASTIC_BEANSTALK=http://localhost:4567
+
+The endpoint URLs configured by the environment and shared config are ignored
+if AWS_IGNORE_CONFIGURED_ENDPOINT_URLS is true. Takes precedence over the
+ignore_configured_endpoint_urls shared config field.
+
+	AWS_IGNORE_CONFIGURED_ENDPOINT_URLS=true
+
 Profile name the SDK should load use when loading shared config from the
 configuration files. If not provided "default" will be used as the profile name.
 
@@ -258,9 +392,15 @@ if you want to replace the CA bundle the SDK uses for TLS requests.
 
 Enabling this option will attempt to merge the Transport into the SDK's HTTP
 client. If the client's Transport is not a http.Transport an error will be
-returned. If the Transport's TLS config is set this option will cause the SDK
-to overwrite the Transport's TLS config's  RootCAs value. If the CA bundle file
-contains multiple certificates all of them will be loaded.
+returned. If the Transport's TLS config has RootCAs set the certificates are
+appended to them. If the CA bundle file contains multiple certificates all of
+them will be loaded.
+
+The CA bundle can also be set with the shared config's ca_bundle field, which
+is used if the AWS_CA_BUNDLE environment variable is not set.
+
+	[default]
+	ca_bundle = /path/to/my_custom_ca_bundle
 
 The Session option CustomCABundle is also available when creating sessions
 to also enable this feature. CustomCABundle session option field has priority
@@ -269,5 +409,43 @@ over the AWS_CA_BUNDLE environment variable, and will be used if both are set.
 Setting a custom HTTPClient in the aws.Config options will override this setting.
 To use this option and custom HTTP client, the HTTP client needs to be provided
 when creating the session. Not the service client.
+
+The shared config's endpoint_url field sets the endpoint URL of all service
+clients. The endpoint URLs of individual services are set in the services
+section the profile's services field refers to, keyed by the service's ID,
+lower cased with spaces replaced by underscores. These are only used when
+AWS_SDK_LOAD_CONFIG is set.
+
+	[default]
+	endpoint_url = http://localhost:4566
+	services = local
+
+	[services local]
+	s3 =
+	  endpoint_url = http://localhost:4567
+	elastic_beanstalk =
+	  endpoint_url = http://localhost:4568
+
+The S3 client's accelerate, dualstack, addressing style, and access point
+ARN region options can be set in the shared config's nested s3 block. These
+are only used when AWS_SDK_LOAD_CONFIG is set, and are overridden by values
+set in the aws.Config. Unknown, or invalid, values in the block are ignored,
+and logged when the aws.Config LogLevel is at least aws.LogDebug.
+
+	[default]
+	s3 =
+	  use_accelerate_endpoint = true
+	  use_dualstack_endpoint = true
+	  addressing_style = path
+	  use_arn_region = true
+
+The same options can be set with the environment variables below, which have
+priority over both the aws.Config and shared config values. The addressing
+style may be path or virtual.
+
+	AWS_S3_USE_ACCELERATE_ENDPOINT=true
+	AWS_S3_USE_DUALSTACK_ENDPOINT=true
+	AWS_S3_ADDRESSING_STYLE=path
+	AWS_S3_USE_ARN_REGION=true
 */
 package session
@@ -0,0 +1,121 @@
+package session
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// configuredEndpointURLs are the endpoint URLs of service clients configured
+// by the environment, or by the shared config.
+type configuredEndpointURLs struct {
+	// Endpoint URL of the service clients without a service specific
+	// endpoint URL.
+	Global string
+
+	// Service specific endpoint URLs, keyed by serviceEndpointURLKey.
+	Services map[string]string
+}
+
+// urlFor returns the endpoint URL configured for the service with the
+// endpoints ID, if any.
+func (u configuredEndpointURLs) urlFor(endpointsID string) (string, bool) {
+	ids, ok := serviceIDs[endpointsID]
+	if !ok {
+		return "", false
+	}
+
+	for _, id := range ids {
+		if v, ok := u.Services[serviceEndpointURLKey(id)]; ok {
+			return v, true
+		}
+	}
+
+	return u.Global, len(u.Global) != 0
+}
+
+// serviceEndpointURLKey returns the key of the service ID, or of the suffix of
+// a service's AWS_ENDPOINT_URL_<SERVICE> environment variable, the service's
+// endpoint URL is looked up by.
+func serviceEndpointURLKey(id string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(id), " ", "_", -1))
+}
+
+// configuredEndpointResolver resolves the endpoint URLs configured by the
+// environment and shared config for service clients, in priority order. The
+// signing region and name of the endpoints are resolved by the resolver the
+// configured endpoint resolver is layered over, which also resolves the
+// endpoints of services without a configured endpoint URL.
+//
+// Only services with a client in the SDK, see serviceIDs, use the configured
+// endpoint URLs.
+type configuredEndpointResolver struct {
+	urls     []configuredEndpointURLs
+	resolver endpoints.Resolver
+}
+
+// newConfiguredEndpointResolver returns a resolver layered over the resolver
+// if the environment or shared config configure endpoint URLs, otherwise the
+// resolver is returned.
+func newConfiguredEndpointResolver(resolver endpoints.Resolver, envCfg envConfig, sharedCfg sharedConfig) endpoints.Resolver {
+	ignore := envCfg.IgnoreConfiguredEndpointURLs
+	if ignore == nil && envCfg.EnableSharedConfig {
+		ignore = sharedCfg.IgnoreConfiguredEndpointURLs
+	}
+	if ignore != nil && *ignore {
+		return resolver
+	}
+
+	urls := []configuredEndpointURLs{
+		{Global: envCfg.EndpointURL, Services: envCfg.ServiceEndpointURLs},
+	}
+	if envCfg.EnableSharedConfig {
+		urls = append(urls, configuredEndpointURLs{
+			Global: sharedCfg.EndpointURL, Services: sharedCfg.ServiceEndpointURLs,
+		})
+	}
+
+	for _, u := range urls {
+		if len(u.Global) != 0 || len(u.Services) != 0 {
+			if resolver == nil {
+				resolver = endpoints.DefaultResolver()
+			}
+			return configuredEndpointResolver{urls: urls, resolver: resolver}
+		}
+	}
+
+	return resolver
+}
+
+// EndpointFor returns the endpoint URL configured for the service, with the
+// signing region and name of the service's endpoint, or the endpoint
+// resolved by the underlying resolver if the service has no configured
+// endpoint URL.
+func (r configuredEndpointResolver) EndpointFor(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	resolved, err := r.resolver.EndpointFor(service, region, opts...)
+
+	for _, u := range r.urls {
+		v, ok := u.urlFor(service)
+		if !ok {
+			continue
+		}
+
+		if err != nil {
+			// The configured endpoint URL is used even if the service's
+			// endpoint cannot be resolved, such as for unknown regions.
+			resolved = endpoints.ResolvedEndpoint{
+				SigningRegion:      region,
+				SigningName:        service,
+				SigningNameDerived: true,
+			}
+		}
+
+		var o endpoints.Options
+		o.Set(opts...)
+		resolved.URL = endpoints.AddScheme(v, o.DisableSSL)
+
+		return resolved, nil
+	}
+
+	return resolved, err
+}
@@ -3,6 +3,7 @@ package session
 import (
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/credentials"
 )
@@ -31,6 +32,17 @@ type envConfig struct {
 	//	AWS_SESSION_TOKEN=TOKEN
 	Creds credentials.Value
 
+	// Web identity role assumed with the token read from the file. Both the
+	// role ARN and the token file must be provided. The role session name
+	// is optional.
+	//
+	//	AWS_ROLE_ARN=arn:aws:iam::012345678901:role/my-role
+	//	AWS_WEB_IDENTITY_TOKEN_FILE=/var/run/secrets/token
+	//	AWS_ROLE_SESSION_NAME=my-session
+	WebIdentityTokenFilePath string
+	RoleARN                  string
+	RoleSessionName          string
+
 	// Region value will instruct the SDK where to make service API requests to. If is
 	// not provided in the environment the region must be provided before a service
 	// client request is made.
@@ -86,8 +98,8 @@ type envConfig struct {
 	// Enabling this option will attempt to merge the Transport
 	// into the SDK's HTTP client. If the client's Transport is
 	// not a http.Transport an error will be returned. If the
-	// Transport's TLS config is set this option will cause the
-	// SDK to overwrite the Transport's TLS config's  RootCAs value.
+	// Transport's TLS config has RootCAs set the certificates are
+	// appended to them.
 	//
 	// Setting a custom HTTPClient in the aws.Config options will override this setting.
 	// To use this option and custom HTTP client, the HTTP client needs to be provided
@@ -95,6 +107,108 @@ type envConfig struct {
 	//
 	//  AWS_CA_BUNDLE=$HOME/my_custom_ca_bundle
 	CustomCABundle string
+
+	// Retry mode the service clients created from the session will use,
+	// e.g. "adaptive" to have each client rate limit its requests while
+	// being throttled. See aws.Config.RetryMode for the supported modes.
+	//
+	//	AWS_RETRY_MODE=adaptive
+	RetryMode string
+
+	// Maximum number of attempts of the requests of service clients created
+	// from the session, including the first attempt. See aws.Config.MaxRetries.
+	//
+	//	AWS_MAX_ATTEMPTS=5
+	MaxAttempts string
+
+	// STS endpoint resolution mode, "regional" to send STS requests to the
+	// STS endpoint of the region instead of the global endpoint. See
+	// aws.Config.STSRegionalEndpoint.
+	//
+	//	AWS_STS_REGIONAL_ENDPOINTS=regional
+	STSRegionalEndpoint string
+
+	// S3 us-east-1 endpoint resolution mode, "regional" to send S3 requests
+	// in us-east-1 to the regional endpoint instead of the global endpoint.
+	// See aws.Config.S3UsEast1RegionalEndpoint.
+	//
+	//	AWS_S3_US_EAST_1_REGIONAL_ENDPOINT=regional
+	S3UsEast1RegionalEndpoint string
+
+	// Enables discovery of the endpoints of service API operations which
+	// support endpoint discovery, "true", "false", or "auto". See
+	// aws.Config.EnableEndpointDiscovery.
+	//
+	//	AWS_ENABLE_ENDPOINT_DISCOVERY=true
+	EnableEndpointDiscovery string
+
+	// Enables resolving the FIPS endpoints of service clients. See
+	// aws.Config.UseFIPSEndpoint.
+	//
+	//	AWS_USE_FIPS_ENDPOINT=true
+	UseFIPSEndpoint *bool
+
+	// Enables resolving the dualstack endpoints of service clients. See
+	// aws.Config.UseDualStackEndpoint.
+	//
+	//	AWS_USE_DUALSTACK_ENDPOINT=true
+	UseDualStackEndpoint *bool
+
+	// Endpoint URL of the service clients without a service specific
+	// endpoint URL.
+	//
+	//	AWS_ENDPOINT_URL=http://localhost:4566
+	EndpointURL string
+
+	// Service specific endpoint URLs, keyed by the service's ID with spaces
+	// replaced by underscores, and lower cased, e.g. "elastic_beanstalk".
+	//
+	//	AWS_ENDPOINT_URL_ELASTIC_BEANSTALK=http://localhost:4566
+	ServiceEndpointURLs map[string]string
+
+	// Disables the endpoint URLs configured by the environment and shared
+	// config.
+	//
+	//	AWS_IGNORE_CONFIGURED_ENDPOINT_URLS=true
+	IgnoreConfiguredEndpointURLs *bool
+
+	// Identifier of the application added to the User-Agent of requests.
+	// See aws.Config.AppID.
+	//
+	//	AWS_SDK_UA_APP_ID=my-application
+	AppID string
+
+	// S3 client options, which have priority over the options of the
+	// aws.Config and the shared config. See aws.Config.S3UseAccelerate,
+	// aws.Config.UseDualStack, aws.Config.S3ForcePathStyle, and
+	// aws.Config.S3UseARNRegion. The addressing style is "path", "virtual",
+	// or "auto".
+	//
+	//	AWS_S3_USE_ACCELERATE_ENDPOINT=true
+	//	AWS_S3_USE_DUALSTACK_ENDPOINT=true
+	//	AWS_S3_ADDRESSING_STYLE=path
+	//	AWS_S3_USE_ARN_REGION=true
+	S3UseAccelerate  *bool
+	S3UseDualStack   *bool
+	S3ForcePathStyle *bool
+	S3UseARNRegion   *bool
+
+	// Enables client side monitoring (CSM) of the session's service clients'
+	// API requests. See the csm package.
+	//
+	//	AWS_CSM_ENABLED=true
+	CSMEnabled bool
+
+	// Port of the CSM agent on the local host records are sent to. Defaults
+	// to csm.DefaultPort.
+	//
+	//	AWS_CSM_PORT=31000
+	CSMPort string
+
+	// Client ID added to the records sent to the CSM agent.
+	//
+	//	AWS_CSM_CLIENT_ID=my-application
+	CSMClientID string
 }
 
 var (
@@ -110,6 +224,16 @@ var (
 		"AWS_SESSION_TOKEN",
 	}
 
+	webIdentityTokenFilePathEnvKey = []string{
+		"AWS_WEB_IDENTITY_TOKEN_FILE",
+	}
+	roleARNEnvKey = []string{
+		"AWS_ROLE_ARN",
+	}
+	roleSessionNameEnvKey = []string{
+		"AWS_ROLE_SESSION_NAME",
+	}
+
 	regionEnvKeys = []string{
 		"AWS_REGION",
 		"AWS_DEFAULT_REGION", // Only read if AWS_SDK_LOAD_CONFIG is also set
@@ -124,6 +248,57 @@ var (
 	sharedConfigFileEnvKey = []string{
 		"AWS_CONFIG_FILE",
 	}
+	retryModeEnvKey = []string{
+		"AWS_RETRY_MODE",
+	}
+	maxAttemptsEnvKey = []string{
+		"AWS_MAX_ATTEMPTS",
+	}
+	stsRegionalEndpointEnvKey = []string{
+		"AWS_STS_REGIONAL_ENDPOINTS",
+	}
+	s3UsEast1RegionalEndpointEnvKey = []string{
+		"AWS_S3_US_EAST_1_REGIONAL_ENDPOINT",
+	}
+	enableEndpointDiscoveryEnvKey = []string{
+		"AWS_ENABLE_ENDPOINT_DISCOVERY",
+	}
+	useFIPSEndpointEnvKey = []string{
+		"AWS_USE_FIPS_ENDPOINT",
+	}
+	useDualStackEndpointEnvKey = []string{
+		"AWS_USE_DUALSTACK_ENDPOINT",
+	}
+	endpointURLEnvKey = []string{
+		"AWS_ENDPOINT_URL",
+	}
+	ignoreConfiguredEndpointURLsEnvKey = []string{
+		"AWS_IGNORE_CONFIGURED_ENDPOINT_URLS",
+	}
+	appIDEnvKey = []string{
+		"AWS_SDK_UA_APP_ID",
+	}
+	s3UseAccelerateEnvKey = []string{
+		"AWS_S3_USE_ACCELERATE_ENDPOINT",
+	}
+	s3UseDualStackEnvKey = []string{
+		"AWS_S3_USE_DUALSTACK_ENDPOINT",
+	}
+	s3AddressingStyleEnvKey = []string{
+		"AWS_S3_ADDRESSING_STYLE",
+	}
+	s3UseARNRegionEnvKey = []string{
+		"AWS_S3_USE_ARN_REGION",
+	}
+	csmEnabledEnvKey = []string{
+		"AWS_CSM_ENABLED",
+	}
+	csmPortEnvKey = []string{
+		"AWS_CSM_PORT",
+	}
+	csmClientIDEnvKey = []string{
+		"AWS_CSM_CLIENT_ID",
+	}
 )
 
 // loadEnvConfig retrieves the SDK's environment configuration.
@@ -163,6 +338,10 @@ func envConfigLoad(enableSharedConfig bool) envConfig {
 		cfg.Creds.ProviderName = EnvProviderName
 	}
 
+	setFromEnvVal(&cfg.WebIdentityTokenFilePath, webIdentityTokenFilePathEnvKey)
+	setFromEnvVal(&cfg.RoleARN, roleARNEnvKey)
+	setFromEnvVal(&cfg.RoleSessionName, roleSessionNameEnvKey)
+
 	regionKeys := regionEnvKeys
 	profileKeys := profileEnvKeys
 	if !cfg.EnableSharedConfig {
@@ -178,6 +357,42 @@ func envConfigLoad(enableSharedConfig bool) envConfig {
 
 	cfg.CustomCABundle = os.Getenv("AWS_CA_BUNDLE")
 
+	setFromEnvVal(&cfg.RetryMode, retryModeEnvKey)
+	setFromEnvVal(&cfg.MaxAttempts, maxAttemptsEnvKey)
+	setFromEnvVal(&cfg.STSRegionalEndpoint, stsRegionalEndpointEnvKey)
+	setFromEnvVal(&cfg.S3UsEast1RegionalEndpoint, s3UsEast1RegionalEndpointEnvKey)
+
+	setFromEnvVal(&cfg.EnableEndpointDiscovery, enableEndpointDiscoveryEnvKey)
+
+	setBoolPtrFromEnvVal(&cfg.UseFIPSEndpoint, useFIPSEndpointEnvKey)
+	setBoolPtrFromEnvVal(&cfg.UseDualStackEndpoint, useDualStackEndpointEnvKey)
+
+	setFromEnvVal(&cfg.EndpointURL, endpointURLEnvKey)
+	cfg.ServiceEndpointURLs = serviceEndpointURLsFromEnv()
+	setBoolPtrFromEnvVal(&cfg.IgnoreConfiguredEndpointURLs, ignoreConfiguredEndpointURLsEnvKey)
+
+	setFromEnvVal(&cfg.AppID, appIDEnvKey)
+
+	setBoolPtrFromEnvVal(&cfg.S3UseAccelerate, s3UseAccelerateEnvKey)
+	setBoolPtrFromEnvVal(&cfg.S3UseDualStack, s3UseDualStackEnvKey)
+	setBoolPtrFromEnvVal(&cfg.S3UseARNRegion, s3UseARNRegionEnvKey)
+	var addressingStyle string
+	setFromEnvVal(&addressingStyle, s3AddressingStyleEnvKey)
+	switch strings.ToLower(addressingStyle) {
+	case s3AddressingStylePath:
+		forcePathStyle := true
+		cfg.S3ForcePathStyle = &forcePathStyle
+	case s3AddressingStyleVirtual:
+		forcePathStyle := false
+		cfg.S3ForcePathStyle = &forcePathStyle
+	}
+
+	var csmEnabled string
+	setFromEnvVal(&csmEnabled, csmEnabledEnvKey)
+	cfg.CSMEnabled, _ = strconv.ParseBool(csmEnabled)
+	setFromEnvVal(&cfg.CSMPort, csmPortEnvKey)
+	setFromEnvVal(&cfg.CSMClientID, csmClientIDEnvKey)
+
 	return cfg
 }
 
@@ -189,3 +404,33 @@ func setFromEnvVal(dst *string, keys []string) {
 		}
 	}
 }
+
+// serviceEndpointURLsFromEnv returns the service specific endpoint URLs of
+// the environment, keyed by service.
+func serviceEndpointURLsFromEnv() map[string]string {
+	prefix := endpointURLEnvKey[0] + "_"
+
+	var urls map[string]string
+	for _, kv := range os.Environ() {
+		i := strings.Index(kv, "=")
+		if i <= len(prefix) || !strings.HasPrefix(kv, prefix) || i+1 == len(kv) {
+			continue
+		}
+		if urls == nil {
+			urls = map[string]string{}
+		}
+		urls[serviceEndpointURLKey(kv[len(prefix):i])] = kv[i+1:]
+	}
+
+	return urls
+}
+
+// setBoolPtrFromEnvVal sets the value of the first of the keys set, if it
+// is a valid boolean.
+func setBoolPtrFromEnvVal(dst **bool, keys []string) {
+	var v string
+	setFromEnvVal(&v, keys)
+	if b, err := strconv.ParseBool(v); err == nil {
+		*dst = &b
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/awstesting"
 )
@@ -237,6 +238,113 @@ func TestLoadEnvConfig(t *testing.T) {
 				SharedConfigFile:      "/path/to/config/file",
 			},
 		},
+		{
+			Env: map[string]string{
+				"AWS_RETRY_MODE":   "adaptive",
+				"AWS_MAX_ATTEMPTS": "5",
+			},
+			Config: envConfig{
+				RetryMode:   "adaptive",
+				MaxAttempts: "5",
+			},
+		},
+		{
+			Env: map[string]string{
+				"AWS_STS_REGIONAL_ENDPOINTS": "regional",
+			},
+			Config: envConfig{
+				STSRegionalEndpoint: "regional",
+			},
+		},
+		{
+			Env: map[string]string{
+				"AWS_S3_US_EAST_1_REGIONAL_ENDPOINT": "regional",
+			},
+			Config: envConfig{
+				S3UsEast1RegionalEndpoint: "regional",
+			},
+		},
+		{
+			Env: map[string]string{
+				"AWS_ENABLE_ENDPOINT_DISCOVERY": "true",
+			},
+			Config: envConfig{
+				EnableEndpointDiscovery: "true",
+			},
+		},
+		{
+			Env: map[string]string{
+				"AWS_USE_FIPS_ENDPOINT": "true",
+			},
+			Config: envConfig{
+				UseFIPSEndpoint: aws.Bool(true),
+			},
+		},
+		{
+			Env: map[string]string{
+				"AWS_ENDPOINT_URL":                    "http://localhost:4566",
+				"AWS_ENDPOINT_URL_ELASTIC_BEANSTALK":  "http://localhost:4567",
+				"AWS_ENDPOINT_URL_":                   "http://localhost:4568",
+				"AWS_ENDPOINT_URL_SES":                "",
+				"AWS_IGNORE_CONFIGURED_ENDPOINT_URLS": "true",
+			},
+			Config: envConfig{
+				EndpointURL: "http://localhost:4566",
+				ServiceEndpointURLs: map[string]string{
+					"elastic_beanstalk": "http://localhost:4567",
+				},
+				IgnoreConfiguredEndpointURLs: aws.Bool(true),
+			},
+		},
+		{
+			Env: map[string]string{
+				"AWS_USE_DUALSTACK_ENDPOINT": "true",
+			},
+			Config: envConfig{
+				UseDualStackEndpoint: aws.Bool(true),
+			},
+		},
+		{
+			Env: map[string]string{
+				"AWS_SDK_UA_APP_ID": "my-app",
+			},
+			Config: envConfig{
+				AppID: "my-app",
+			},
+		},
+		{
+			Env: map[string]string{
+				"AWS_S3_USE_ACCELERATE_ENDPOINT": "true",
+				"AWS_S3_USE_DUALSTACK_ENDPOINT":  "false",
+				"AWS_S3_ADDRESSING_STYLE":        "path",
+				"AWS_S3_USE_ARN_REGION":          "true",
+			},
+			Config: envConfig{
+				S3UseAccelerate:  aws.Bool(true),
+				S3UseDualStack:   aws.Bool(false),
+				S3ForcePathStyle: aws.Bool(true),
+				S3UseARNRegion:   aws.Bool(true),
+			},
+		},
+		{
+			Env: map[string]string{
+				"AWS_S3_USE_ACCELERATE_ENDPOINT": "notabool",
+				"AWS_S3_ADDRESSING_STYLE":        "virtual",
+			},
+			Config: envConfig{
+				S3ForcePathStyle: aws.Bool(false),
+			},
+		},
+		{
+			Env: map[string]string{
+				"AWS_CSM_ENABLED":   "true",
+				"AWS_CSM_PORT":      "4321",
+				"AWS_CSM_CLIENT_ID": "my-client",
+			},
+			Config: envConfig{
+				CSMEnabled: true, CSMPort: "4321", CSMClientID: "my-client",
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -8,13 +8,19 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/corehandlers"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/processcreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/ssocreds"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/csm"
 	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -30,6 +36,8 @@ import (
 type Session struct {
 	Config   *aws.Config
 	Handlers request.Handlers
+
+	configSources ConfigSources
 }
 
 // New creates a new instance of the handlers merging in the provided configs
@@ -79,7 +87,14 @@ func New(cfgs ...*aws.Config) *Session {
 		return s
 	}
 
-	return deprecatedNewSession(cfgs...)
+	s := deprecatedNewSession(envCfg, cfgs...)
+	if envCfg.CSMEnabled {
+		if err := enableCSM(&s.Handlers, envCfg.CSMClientID, envCfg.CSMPort); err != nil {
+			s.Config.Logger.Log("ERROR:", "failed to enable client side monitoring,", err)
+		}
+	}
+
+	return s
 }
 
 // NewSession returns a new Session created from SDK defaults, config files,
@@ -179,20 +194,51 @@ type Options struct {
 	// the config enables assume role wit MFA via the mfa_serial field.
 	AssumeRoleTokenProvider func() (string, error)
 
+	// Duration of the credentials of roles assumed with the shared config,
+	// overriding the profiles' duration_seconds field. If not set, the
+	// duration_seconds of the profile is used, or the stscreds package's
+	// DefaultDuration if the profile does not set it either.
+	//
+	// This field is only used if the shared configuration is enabled.
+	AssumeRoleDuration time.Duration
+
 	// Reader for a custom Credentials Authority (CA) bundle in PEM format that
 	// the SDK will use instead of the default system's root CA bundle. Use this
 	// only if you want to replace the CA bundle the SDK uses for TLS requests.
 	//
 	// Enabling this option will attempt to merge the Transport into the SDK's HTTP
 	// client. If the client's Transport is not a http.Transport an error will be
-	// returned. If the Transport's TLS config is set this option will cause the SDK
-	// to overwrite the Transport's TLS config's  RootCAs value. If the CA
-	// bundle reader contains multiple certificates all of them will be loaded.
+	// returned. If the Transport's TLS config has RootCAs set the certificates are
+	// appended to them. If the CA bundle reader contains multiple certificates
+	// all of them will be loaded. An error is returned when the session is
+	// created if the bundle does not contain a valid certificate.
 	//
-	// The Session option CustomCABundle is also available when creating sessions
-	// to also enable this feature. CustomCABundle session option field has priority
-	// over the AWS_CA_BUNDLE environment variable, and will be used if both are set.
+	// The CA bundle can also be set with the AWS_CA_BUNDLE environment variable,
+	// or the shared config's ca_bundle field. CustomCABundle session option field
+	// has priority over the AWS_CA_BUNDLE environment variable, which has
+	// priority over the shared config.
 	CustomCABundle io.Reader
+
+	// Enables caching the credentials of roles assumed with the shared config,
+	// and of SSO profiles, in the credentials cache directory shared with other
+	// processes, ~/.aws/cli/cache. Processes using the same profile read the
+	// credentials from the cache while they are not expired, instead of each
+	// assuming the role again.
+	//
+	// This field is only used if the shared configuration is enabled.
+	EnableSharedCredentialCache bool
+
+	// HTTP client the requests of the session's instance metadata service
+	// and container credentials endpoint credential providers are sent with.
+	// If not set, the requests are sent with an HTTP client with short
+	// timeouts, that does not send the requests through the proxy of the
+	// HTTP_PROXY and HTTPS_PROXY environment variables.
+	//
+	// The HTTP client of the Config, used by the service clients, is not used
+	// for these requests, so that the service clients' requests can be sent
+	// through a proxy while the endpoints local to the host are reached
+	// directly.
+	EC2IMDSHTTPClient *http.Client
 }
 
 // NewSessionWithOptions returns a new Session created from SDK defaults, config files,
@@ -250,17 +296,6 @@ func NewSessionWithOptions(opts Options) (*Session, error) {
 		envCfg.SharedConfigFile = defaults.SharedConfigFilename()
 	}
 
-	// Only use AWS_CA_BUNDLE if session option is not provided.
-	if len(envCfg.CustomCABundle) != 0 && opts.CustomCABundle == nil {
-		f, err := os.Open(envCfg.CustomCABundle)
-		if err != nil {
-			return nil, awserr.New("LoadCustomCABundleError",
-				"failed to open custom CA bundle PEM file", err)
-		}
-		defer f.Close()
-		opts.CustomCABundle = f
-	}
-
 	return newSession(opts, envCfg, &opts.Config)
 }
 
@@ -279,7 +314,7 @@ func Must(sess *Session, err error) *Session {
 	return sess
 }
 
-func deprecatedNewSession(cfgs ...*aws.Config) *Session {
+func deprecatedNewSession(envCfg envConfig, cfgs ...*aws.Config) *Session {
 	cfg := defaults.Config()
 	handlers := defaults.Handlers()
 
@@ -291,7 +326,7 @@ func deprecatedNewSession(cfgs ...*aws.Config) *Session {
 		// endpoints for service client configurations.
 		cfg.EndpointResolver = endpoints.DefaultResolver()
 	}
-	cfg.Credentials = defaults.CredChain(cfg, handlers)
+	cfg.Credentials = credChain(cfg, handlers, envCfg)
 
 	// Reapply any passed in configs to override credentials if set
 	cfg.MergeIn(cfgs...)
@@ -315,6 +350,16 @@ func newSession(opts Options, envCfg envConfig, cfgs ...*aws.Config) (*Session,
 	userCfg := &aws.Config{}
 	userCfg.MergeIn(cfgs...)
 
+	// HTTP client with the transport options, if the user has not provided
+	// their own HTTP client.
+	if userCfg.HTTPTransportOptions != nil {
+		if userCfg.HTTPClient != nil {
+			return nil, awserr.New("HTTPTransportOptionsError",
+				"unable to apply HTTPTransportOptions, HTTPClient is also set, set the options on the HTTPClient's transport instead", nil)
+		}
+		cfg.HTTPClient = defaults.HTTPClient(*userCfg.HTTPTransportOptions)
+	}
+
 	// Ordered config files will be loaded in with later files overwriting
 	// previous config file values.
 	var cfgFiles []string
@@ -330,22 +375,53 @@ func newSession(opts Options, envCfg envConfig, cfgs ...*aws.Config) (*Session,
 	}
 
 	// Load additional config from file(s)
-	sharedCfg, err := loadSharedConfig(envCfg.Profile, cfgFiles)
+	files, err := loadSharedConfigIniFiles(cfgFiles)
+	if err != nil {
+		return nil, err
+	}
+	sharedCfg, err := loadSharedConfigFromFiles(envCfg.Profile, files)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := mergeConfigSrcs(cfg, userCfg, envCfg, sharedCfg, handlers, opts); err != nil {
+	var srcs ConfigSources
+	if err := mergeConfigSrcs(cfg, userCfg, envCfg, sharedCfg, handlers, opts, &srcs); err != nil {
 		return nil, err
 	}
+	srcs.setSharedConfigFilenames(files)
+
+	// Only use AWS_CA_BUNDLE, or the shared config's ca_bundle, if the
+	// session option is not provided.
+	if opts.CustomCABundle == nil {
+		caBundle := envCfg.CustomCABundle
+		if len(caBundle) == 0 {
+			caBundle = sharedCfg.CustomCABundle
+		}
+		if len(caBundle) != 0 {
+			f, err := os.Open(caBundle)
+			if err != nil {
+				return nil, awserr.New("LoadCustomCABundleError",
+					"failed to open custom CA bundle PEM file", err)
+			}
+			defer f.Close()
+			opts.CustomCABundle = f
+		}
+	}
 
 	s := &Session{
-		Config:   cfg,
-		Handlers: handlers,
+		Config:        cfg,
+		Handlers:      handlers,
+		configSources: srcs,
 	}
 
 	initHandlers(s)
 
+	if envCfg.CSMEnabled {
+		if err := enableCSM(&s.Handlers, envCfg.CSMClientID, envCfg.CSMPort); err != nil {
+			return nil, err
+		}
+	}
+
 	// Setup HTTP client with custom cert bundle if enabled
 	if opts.CustomCABundle != nil {
 		if err := loadCustomCABundle(s, opts.CustomCABundle); err != nil {
@@ -356,6 +432,26 @@ func newSession(opts Options, envCfg envConfig, cfgs ...*aws.Config) (*Session,
 	return s, nil
 }
 
+// enableCSM starts the client side monitoring reporter sending records to the
+// CSM agent on the local host's port, and injects its handlers into the
+// handlers.
+func enableCSM(handlers *request.Handlers, clientID, port string) error {
+	if len(port) == 0 {
+		port = csm.DefaultPort
+	}
+
+	r, err := csm.Start(clientID, "127.0.0.1:"+port)
+	if err != nil {
+		return err
+	}
+	r.InjectHandlers(handlers)
+
+	return nil
+}
+
+// loadCustomCABundle adds the certificates of the PEM bundle to the root CAs
+// of the session's HTTP client. The certificates are appended to the root CAs
+// of the client's transport if it has any.
 func loadCustomCABundle(s *Session, bundle io.Reader) error {
 	var t *http.Transport
 	switch v := s.Config.HTTPClient.Transport.(type) {
@@ -364,44 +460,97 @@ func loadCustomCABundle(s *Session, bundle io.Reader) error {
 	default:
 		if s.Config.HTTPClient.Transport != nil {
 			return awserr.New("LoadCustomCABundleError",
-				"unable to load custom CA bundle, HTTPClient's transport unsupported type", nil)
+				fmt.Sprintf("unable to load custom CA bundle, HTTPClient's transport unsupported type, %T, expect *http.Transport",
+					s.Config.HTTPClient.Transport), nil)
 		}
 	}
-	if t == nil {
-		t = &http.Transport{}
-	}
 
-	p, err := loadCertPool(bundle)
-	if err != nil {
+	var pool *x509.CertPool
+	if t != nil && t.TLSClientConfig != nil {
+		pool = t.TLSClientConfig.RootCAs
+	}
+	if pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if err := loadCertPool(pool, bundle); err != nil {
 		return err
 	}
+
+	if t == nil {
+		// The client uses the default transport, which must not be modified,
+		// nor the client, which may be shared, such as http.DefaultClient.
+		t = &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			TLSHandshakeTimeout: 10 * time.Second,
+		}
+		client := *s.Config.HTTPClient
+		client.Transport = t
+		s.Config.HTTPClient = &client
+	}
 	if t.TLSClientConfig == nil {
 		t.TLSClientConfig = &tls.Config{}
 	}
-	t.TLSClientConfig.RootCAs = p
-
-	s.Config.HTTPClient.Transport = t
+	t.TLSClientConfig.RootCAs = pool
 
 	return nil
 }
 
-func loadCertPool(r io.Reader) (*x509.CertPool, error) {
+// loadCertPool adds the certificates of the PEM bundle to the pool. An error
+// is returned if the bundle does not contain any certificate.
+func loadCertPool(pool *x509.CertPool, r io.Reader) error {
 	b, err := ioutil.ReadAll(r)
 	if err != nil {
-		return nil, awserr.New("LoadCustomCABundleError",
+		return awserr.New("LoadCustomCABundleError",
 			"failed to read custom CA bundle PEM file", err)
 	}
 
-	p := x509.NewCertPool()
-	if !p.AppendCertsFromPEM(b) {
-		return nil, awserr.New("LoadCustomCABundleError",
-			"failed to load custom CA bundle PEM file", err)
+	if !pool.AppendCertsFromPEM(b) {
+		return awserr.New("LoadCustomCABundleError",
+			"failed to load custom CA bundle PEM file, no valid certificates found", nil)
+	}
+
+	return nil
+}
+
+// mergeS3ConfigSrcs sets the S3 client options of the config. Options set by
+// the environment have priority over the options set by the user, which have
+// priority over the shared config's s3 block.
+func mergeS3ConfigSrcs(cfg *aws.Config, envCfg envConfig, sharedCfg sharedConfig) {
+	if envCfg.EnableSharedConfig {
+		if cfg.S3UseAccelerate == nil && sharedCfg.S3.UseAccelerate != nil {
+			cfg.WithS3UseAccelerate(*sharedCfg.S3.UseAccelerate)
+		}
+		if cfg.UseDualStack == nil && sharedCfg.S3.UseDualStack != nil {
+			cfg.WithUseDualStack(*sharedCfg.S3.UseDualStack)
+		}
+		if cfg.S3ForcePathStyle == nil && sharedCfg.S3.ForcePathStyle != nil {
+			cfg.WithS3ForcePathStyle(*sharedCfg.S3.ForcePathStyle)
+		}
+		if cfg.S3UseARNRegion == nil && sharedCfg.S3.UseARNRegion != nil {
+			cfg.WithS3UseARNRegion(*sharedCfg.S3.UseARNRegion)
+		}
+
+		if len(sharedCfg.S3.Ignored) > 0 && cfg.Logger != nil && cfg.LogLevel.AtLeast(aws.LogDebug) {
+			cfg.Logger.Log(fmt.Sprintf("DEBUG: ignoring unknown, or invalid, shared config s3 values, %s",
+				strings.Join(sharedCfg.S3.Ignored, ", ")))
+		}
 	}
 
-	return p, nil
+	if envCfg.S3UseAccelerate != nil {
+		cfg.WithS3UseAccelerate(*envCfg.S3UseAccelerate)
+	}
+	if envCfg.S3UseDualStack != nil {
+		cfg.WithUseDualStack(*envCfg.S3UseDualStack)
+	}
+	if envCfg.S3ForcePathStyle != nil {
+		cfg.WithS3ForcePathStyle(*envCfg.S3ForcePathStyle)
+	}
+	if envCfg.S3UseARNRegion != nil {
+		cfg.WithS3UseARNRegion(*envCfg.S3UseARNRegion)
+	}
 }
 
-func mergeConfigSrcs(cfg, userCfg *aws.Config, envCfg envConfig, sharedCfg sharedConfig, handlers request.Handlers, sessOpts Options) error {
+func mergeConfigSrcs(cfg, userCfg *aws.Config, envCfg envConfig, sharedCfg sharedConfig, handlers request.Handlers, sessOpts Options, srcs *ConfigSources) error {
 	// Merge in user provided configuration
 	cfg.MergeIn(userCfg)
 
@@ -414,65 +563,308 @@ func mergeConfigSrcs(cfg, userCfg *aws.Config, envCfg envConfig, sharedCfg share
 		}
 	}
 
+	// The default config's region is also read from AWS_REGION, the source
+	// of the region is the first source which sets it.
+	switch {
+	case len(aws.StringValue(userCfg.Region)) > 0:
+		srcs.Region = programmaticConfigSource()
+	case len(envCfg.Region) > 0:
+		srcs.Region = envConfigSource(regionEnvKeys)
+	case envCfg.EnableSharedConfig && len(sharedCfg.Region) > 0:
+		srcs.Region = sharedConfigSource(regionKey, envCfg.Profile)
+	default:
+		srcs.Region = defaultConfigSource()
+	}
+
+	// Retry mode if not already set by user
+	srcs.RetryMode = programmaticConfigSource()
+	if cfg.RetryMode == nil {
+		srcs.RetryMode = defaultConfigSource()
+		if len(envCfg.RetryMode) > 0 {
+			mode, err := loadRetryMode(envCfg.RetryMode, "the AWS_RETRY_MODE environment variable")
+			if err != nil {
+				return err
+			}
+			cfg.WithRetryMode(mode)
+			srcs.RetryMode = envConfigSource(retryModeEnvKey)
+		} else if envCfg.EnableSharedConfig && len(sharedCfg.RetryMode) > 0 {
+			mode, err := loadRetryMode(sharedCfg.RetryMode, sharedConfigKeySource(retryModeKey, envCfg.Profile))
+			if err != nil {
+				return err
+			}
+			cfg.WithRetryMode(mode)
+			srcs.RetryMode = sharedConfigSource(retryModeKey, envCfg.Profile)
+		}
+	}
+
+	// Max retries from the max attempts if not already set by user
+	srcs.MaxAttempts = programmaticConfigSource()
+	if cfg.MaxRetries == nil || *cfg.MaxRetries == aws.UseServiceDefaultRetries {
+		srcs.MaxAttempts = defaultConfigSource()
+		if len(envCfg.MaxAttempts) > 0 {
+			n, err := loadMaxAttempts(envCfg.MaxAttempts, "the AWS_MAX_ATTEMPTS environment variable")
+			if err != nil {
+				return err
+			}
+			cfg.WithMaxRetries(n - 1)
+			srcs.MaxAttempts = envConfigSource(maxAttemptsEnvKey)
+		} else if envCfg.EnableSharedConfig && len(sharedCfg.MaxAttempts) > 0 {
+			n, err := loadMaxAttempts(sharedCfg.MaxAttempts, sharedConfigKeySource(maxAttemptsKey, envCfg.Profile))
+			if err != nil {
+				return err
+			}
+			cfg.WithMaxRetries(n - 1)
+			srcs.MaxAttempts = sharedConfigSource(maxAttemptsKey, envCfg.Profile)
+		}
+	}
+
+	// STS endpoint resolution mode if not already set by user
+	srcs.STSRegionalEndpoint = programmaticConfigSource()
+	if cfg.STSRegionalEndpoint == endpoints.UnsetSTSEndpoint {
+		v := envCfg.STSRegionalEndpoint
+		srcs.STSRegionalEndpoint = envConfigSource(stsRegionalEndpointEnvKey)
+		if len(v) == 0 && envCfg.EnableSharedConfig {
+			v = sharedCfg.STSRegionalEndpoint
+			srcs.STSRegionalEndpoint = sharedConfigSource(stsRegionalEndpointKey, envCfg.Profile)
+		}
+		if len(v) == 0 {
+			srcs.STSRegionalEndpoint = defaultConfigSource()
+		}
+		e, err := endpoints.GetSTSRegionalEndpoint(v)
+		if err != nil {
+			return awserr.New("InvalidSTSRegionalEndpoint",
+				"failed to load the STS regional endpoints configuration", err)
+		}
+		cfg.STSRegionalEndpoint = e
+	}
+
+	// S3 us-east-1 endpoint resolution mode if not already set by user
+	srcs.S3UsEast1RegionalEndpoint = programmaticConfigSource()
+	if cfg.S3UsEast1RegionalEndpoint == endpoints.UnsetS3UsEast1Endpoint {
+		v := envCfg.S3UsEast1RegionalEndpoint
+		srcs.S3UsEast1RegionalEndpoint = envConfigSource(s3UsEast1RegionalEndpointEnvKey)
+		if len(v) == 0 && envCfg.EnableSharedConfig {
+			v = sharedCfg.S3UsEast1RegionalEndpoint
+			srcs.S3UsEast1RegionalEndpoint = sharedConfigSource(s3UsEast1RegionalEndpointKey, envCfg.Profile)
+		}
+		if len(v) == 0 {
+			srcs.S3UsEast1RegionalEndpoint = defaultConfigSource()
+		}
+		e, err := endpoints.GetS3UsEast1RegionalEndpoint(v)
+		if err != nil {
+			return awserr.New("InvalidS3UsEast1RegionalEndpoint",
+				"failed to load the S3 us-east-1 regional endpoint configuration", err)
+		}
+		cfg.S3UsEast1RegionalEndpoint = e
+	}
+
+	// Endpoint discovery if not already set by user
+	srcs.EnableEndpointDiscovery = programmaticConfigSource()
+	if cfg.EnableEndpointDiscovery == nil {
+		srcs.EnableEndpointDiscovery = defaultConfigSource()
+		if len(envCfg.EnableEndpointDiscovery) > 0 {
+			enable, err := loadEndpointDiscovery(envCfg.EnableEndpointDiscovery, "the AWS_ENABLE_ENDPOINT_DISCOVERY environment variable")
+			if err != nil {
+				return err
+			}
+			cfg.WithEndpointDiscovery(enable)
+			srcs.EnableEndpointDiscovery = envConfigSource(enableEndpointDiscoveryEnvKey)
+		} else if envCfg.EnableSharedConfig && len(sharedCfg.EnableEndpointDiscovery) > 0 {
+			enable, err := loadEndpointDiscovery(sharedCfg.EnableEndpointDiscovery, sharedConfigKeySource(endpointDiscoveryEnabledKey, envCfg.Profile))
+			if err != nil {
+				return err
+			}
+			cfg.WithEndpointDiscovery(enable)
+			srcs.EnableEndpointDiscovery = sharedConfigSource(endpointDiscoveryEnabledKey, envCfg.Profile)
+		}
+	}
+
+	// FIPS endpoint resolution if not already set by user
+	srcs.UseFIPSEndpoint = programmaticConfigSource()
+	if cfg.UseFIPSEndpoint == nil {
+		srcs.UseFIPSEndpoint = defaultConfigSource()
+		if envCfg.UseFIPSEndpoint != nil {
+			cfg.WithUseFIPSEndpoint(*envCfg.UseFIPSEndpoint)
+			srcs.UseFIPSEndpoint = envConfigSource(useFIPSEndpointEnvKey)
+		} else if envCfg.EnableSharedConfig && sharedCfg.UseFIPSEndpoint != nil {
+			cfg.WithUseFIPSEndpoint(*sharedCfg.UseFIPSEndpoint)
+			srcs.UseFIPSEndpoint = sharedConfigSource(useFIPSEndpointKey, envCfg.Profile)
+		}
+	}
+
+	// Dualstack endpoint resolution if not already set by user
+	srcs.UseDualStackEndpoint = programmaticConfigSource()
+	if cfg.UseDualStackEndpoint == nil {
+		srcs.UseDualStackEndpoint = defaultConfigSource()
+		if envCfg.UseDualStackEndpoint != nil {
+			cfg.WithUseDualStackEndpoint(*envCfg.UseDualStackEndpoint)
+			srcs.UseDualStackEndpoint = envConfigSource(useDualStackEndpointEnvKey)
+		} else if envCfg.EnableSharedConfig && sharedCfg.UseDualStackEndpoint != nil {
+			cfg.WithUseDualStackEndpoint(*sharedCfg.UseDualStackEndpoint)
+			srcs.UseDualStackEndpoint = sharedConfigSource(useDualStackEndpointKey, envCfg.Profile)
+		}
+	}
+
+	// User-Agent application ID if not already set by user
+	if cfg.AppID == nil {
+		if len(envCfg.AppID) > 0 {
+			cfg.WithAppID(envCfg.AppID)
+		} else if envCfg.EnableSharedConfig && len(sharedCfg.AppID) > 0 {
+			cfg.WithAppID(sharedCfg.AppID)
+		}
+	}
+
+	// User provided endpoint resolver falls back to the SDK's endpoint model
+	// for the endpoints it does not resolve.
+	if userCfg.EndpointResolver != nil {
+		cfg.EndpointResolver = endpoints.ResolverWithFallback(
+			userCfg.EndpointResolver, endpoints.DefaultResolver(),
+		)
+	}
+
+	// Endpoint URLs configured by the environment and shared config
+	cfg.EndpointResolver = newConfiguredEndpointResolver(cfg.EndpointResolver, envCfg, sharedCfg)
+
+	mergeS3ConfigSrcs(cfg, envCfg, sharedCfg)
+
 	// Configure credentials if not already set
+	srcs.Credentials = programmaticConfigSource()
 	if cfg.Credentials == credentials.AnonymousCredentials && userCfg.Credentials == nil {
-		if len(envCfg.Creds.AccessKeyID) > 0 {
+		// The environment credentials are the source of the profile's role
+		// if the profile's credential_source is Environment.
+		envCredSource := envCfg.EnableSharedConfig &&
+			sharedCfg.assumeRoleCredentialSource() == credSourceEnvironment
+
+		if len(envCfg.Creds.AccessKeyID) > 0 && !envCredSource {
 			cfg.Credentials = credentials.NewStaticCredentialsFromCreds(
 				envCfg.Creds,
 			)
-		} else if envCfg.EnableSharedConfig && len(sharedCfg.AssumeRole.RoleARN) > 0 && sharedCfg.AssumeRoleSource != nil {
+			srcs.Credentials = envConfigSource(credAccessEnvKey)
+			srcs.CredentialsProvider = credentials.StaticProviderName
+		} else if len(envCfg.WebIdentityTokenFilePath) > 0 && len(envCfg.RoleARN) > 0 {
 			cfgCp := *cfg
-			cfgCp.Credentials = credentials.NewStaticCredentialsFromCreds(
-				sharedCfg.AssumeRoleSource.Creds,
-			)
-			if len(sharedCfg.AssumeRole.MFASerial) > 0 && sessOpts.AssumeRoleTokenProvider == nil {
-				// AssumeRole Token provider is required if doing Assume Role
-				// with MFA.
-				return AssumeRoleTokenProviderNotSetError{}
-			}
-			cfg.Credentials = stscreds.NewCredentials(
+			cfg.Credentials = stscreds.NewWebIdentityCredentials(
 				&Session{
 					Config:   &cfgCp,
 					Handlers: handlers.Copy(),
 				},
-				sharedCfg.AssumeRole.RoleARN,
-				func(opt *stscreds.AssumeRoleProvider) {
-					opt.RoleSessionName = sharedCfg.AssumeRole.RoleSessionName
-
-					// Assume role with external ID
-					if len(sharedCfg.AssumeRole.ExternalID) > 0 {
-						opt.ExternalID = aws.String(sharedCfg.AssumeRole.ExternalID)
-					}
-
-					// Assume role with MFA
-					if len(sharedCfg.AssumeRole.MFASerial) > 0 {
-						opt.SerialNumber = aws.String(sharedCfg.AssumeRole.MFASerial)
-						opt.TokenProvider = sessOpts.AssumeRoleTokenProvider
-					}
-				},
+				envCfg.RoleARN,
+				envCfg.RoleSessionName,
+				envCfg.WebIdentityTokenFilePath,
 			)
+			srcs.Credentials = envConfigSource(webIdentityTokenFilePathEnvKey)
+			srcs.CredentialsProvider = stscreds.WebIdentityProviderName
+		} else if envCfg.EnableSharedConfig && len(sharedCfg.AssumeRole.RoleARN) > 0 {
+			creds, err := assumeRoleCredentials(cfg, envCfg, sharedCfg, handlers, sessOpts)
+			if err != nil {
+				return err
+			}
+			cfg.Credentials = creds
+			srcs.Credentials = sharedConfigSource(roleArnKey, envCfg.Profile)
+			srcs.CredentialsProvider = stscreds.ProviderName
 		} else if len(sharedCfg.Creds.AccessKeyID) > 0 {
 			cfg.Credentials = credentials.NewStaticCredentialsFromCreds(
 				sharedCfg.Creds,
 			)
+			srcs.Credentials = sharedConfigSource(accessKeyIDKey, envCfg.Profile)
+			srcs.CredentialsProvider = credentials.StaticProviderName
+		} else if len(sharedCfg.SSO.StartURL) > 0 {
+			cfgCp := *cfg
+			cfg.Credentials = ssocreds.NewCredentials(
+				&Session{
+					Config:   &cfgCp,
+					Handlers: handlers.Copy(),
+				},
+				sharedCfg.SSO.AccountID,
+				sharedCfg.SSO.Region,
+				sharedCfg.SSO.RoleName,
+				sharedCfg.SSO.StartURL,
+				sessOpts.ssoCacheOption,
+			)
+			srcs.Credentials = sharedConfigSource(ssoStartURLKey, envCfg.Profile)
+			srcs.CredentialsProvider = ssocreds.ProviderName
+		} else if len(sharedCfg.CredentialProcess) > 0 {
+			cfg.Credentials = processcreds.NewCredentials(
+				sharedCfg.CredentialProcess,
+			)
+			srcs.Credentials = sharedConfigSource(credentialProcessKey, envCfg.Profile)
+			srcs.CredentialsProvider = processcreds.ProviderName
 		} else {
 			// Fallback to default credentials provider, include mock errors
 			// for the credential chain so user can identify why credentials
 			// failed to be retrieved.
+			remoteProvider := defaults.RemoteCredProviderWithHTTPClient(*cfg, handlers, sessOpts.EC2IMDSHTTPClient)
 			cfg.Credentials = credentials.NewCredentials(&credentials.ChainProvider{
-				VerboseErrors: aws.BoolValue(cfg.CredentialsChainVerboseErrors),
 				Providers: []credentials.Provider{
-					&credProviderError{Err: awserr.New("EnvAccessKeyNotFound", "failed to find credentials in the environment.", nil)},
-					&credProviderError{Err: awserr.New("SharedCredsLoad", fmt.Sprintf("failed to load profile, %s.", envCfg.Profile), nil)},
-					defaults.RemoteCredProvider(*cfg, handlers),
+					&credProviderError{
+						Err:          awserr.New("EnvAccessKeyNotFound", "failed to find credentials in the environment.", nil),
+						ProviderName: credentials.EnvProviderName,
+					},
+					&credProviderError{
+						Err:          awserr.New("SharedCredsLoad", fmt.Sprintf("failed to load profile, %s.", envCfg.Profile), nil),
+						ProviderName: credentials.SharedCredsProviderName,
+					},
+					remoteProvider,
 				},
 			})
+			srcs.Credentials = defaultConfigSource()
+			srcs.CredentialsProvider = remoteCredProviderName(remoteProvider)
 		}
 	}
 
 	return nil
 }
 
+// loadRetryMode returns the retry mode of the value loaded from the source, or
+// an error if the value is not a supported retry mode.
+func loadRetryMode(v, source string) (string, error) {
+	switch mode := strings.ToLower(strings.TrimSpace(v)); mode {
+	case aws.LegacyRetryMode, aws.StandardRetryMode, aws.AdaptiveRetryMode:
+		return mode, nil
+	default:
+		return "", awserr.New("InvalidRetryMode",
+			fmt.Sprintf("invalid retry mode, %q, from %s, must be one of %s, %s, or %s", v, source,
+				aws.LegacyRetryMode, aws.StandardRetryMode, aws.AdaptiveRetryMode), nil)
+	}
+}
+
+// loadEndpointDiscovery returns if endpoint discovery is enabled by the value
+// loaded from the source, true, false, or auto, or an error if the value is
+// not one of them. Endpoint discovery is not enabled by auto, as the
+// operations which require endpoint discovery always discover their
+// endpoints.
+func loadEndpointDiscovery(v, source string) (bool, error) {
+	if strings.EqualFold(strings.TrimSpace(v), "auto") {
+		return false, nil
+	}
+	enable, err := strconv.ParseBool(strings.TrimSpace(v))
+	if err != nil {
+		return false, awserr.New("InvalidEndpointDiscovery",
+			fmt.Sprintf("invalid endpoint discovery setting, %q, from %s, must be true, false, or auto", v, source), nil)
+	}
+	return enable, nil
+}
+
+// loadMaxAttempts returns the maximum number of attempts of the value loaded
+// from the source, or an error if the value is not a positive integer.
+func loadMaxAttempts(v, source string) (int, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil || n < 1 {
+		return 0, awserr.New("InvalidMaxAttempts",
+			fmt.Sprintf("invalid max attempts, %q, from %s, must be a positive integer", v, source), err)
+	}
+	return n, nil
+}
+
+// sharedConfigKeySource returns the description of the shared config key of
+// the profile, for errors of the key's value.
+func sharedConfigKeySource(key, profile string) string {
+	if len(profile) == 0 {
+		profile = DefaultSharedConfigProfile
+	}
+	return fmt.Sprintf("the %s key of shared config profile %s", key, profile)
+}
+
 // AssumeRoleTokenProviderNotSetError is an error returned when creating a session when the
 // MFAToken option is not set when shared config is configured load assume a
 // role with an MFA token.
@@ -499,13 +891,14 @@ func (e AssumeRoleTokenProviderNotSetError) Error() string {
 }
 
 type credProviderError struct {
-	Err error
+	Err          error
+	ProviderName string
 }
 
 var emptyCreds = credentials.Value{}
 
 func (c credProviderError) Retrieve() (credentials.Value, error) {
-	return credentials.Value{}, c.Err
+	return credentials.Value{ProviderName: c.ProviderName}, c.Err
 }
 func (c credProviderError) IsExpired() bool {
 	return true
@@ -527,8 +920,9 @@ func initHandlers(s *Session) {
 //     sess.Copy(&aws.Config{Region: aws.String("us-west-2")})
 func (s *Session) Copy(cfgs ...*aws.Config) *Session {
 	newSession := &Session{
-		Config:   s.Config.Copy(cfgs...),
-		Handlers: s.Handlers.Copy(),
+		Config:        s.Config.Copy(cfgs...),
+		Handlers:      s.Handlers.Copy(),
+		configSources: s.configSources,
 	}
 
 	initHandlers(newSession)
@@ -542,7 +936,20 @@ func (s *Session) Copy(cfgs ...*aws.Config) *Session {
 func (s *Session) ClientConfig(serviceName string, cfgs ...*aws.Config) client.Config {
 	// Backwards compatibility, the error will be eaten if user calls ClientConfig
 	// directly. All SDK services will use ClientconfigWithError.
-	cfg, _ := s.clientConfigWithErr(serviceName, cfgs...)
+	cfg, err := s.clientConfigWithErr(serviceName, cfgs...)
+
+	// The client's requests fail with the FIPS or dualstack endpoint error,
+	// instead of a missing endpoint error, so the client is not used with
+	// the non-FIPS, or IPv4 only, endpoint.
+	switch err.(type) {
+	case endpoints.UnknownFIPSEndpointError, endpoints.EndpointNotFoundError:
+		cfg.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+			Name: "session.EndpointResolutionErrorHandler",
+			Fn: func(r *request.Request) {
+				r.Error = err
+			},
+		})
+	}
 
 	return cfg
 }
@@ -564,6 +971,10 @@ func (s *Session) clientConfigWithErr(serviceName string, cfgs ...*aws.Config) (
 			func(opt *endpoints.Options) {
 				opt.DisableSSL = aws.BoolValue(s.Config.DisableSSL)
 				opt.UseDualStack = aws.BoolValue(s.Config.UseDualStack)
+				opt.UseDualStackEndpoint = aws.BoolValue(s.Config.UseDualStackEndpoint)
+				opt.UseFIPSEndpoint = aws.BoolValue(s.Config.UseFIPSEndpoint)
+				opt.STSRegionalEndpoint = s.Config.STSRegionalEndpoint
+				opt.S3UsEast1RegionalEndpoint = s.Config.S3UsEast1RegionalEndpoint
 
 				// Support the condition where the service is modeled but its
 				// endpoint metadata is not available.
@@ -573,11 +984,12 @@ func (s *Session) clientConfigWithErr(serviceName string, cfgs ...*aws.Config) (
 	}
 
 	return client.Config{
-		Config:        s.Config,
-		Handlers:      s.Handlers,
-		Endpoint:      resolved.URL,
-		SigningRegion: resolved.SigningRegion,
-		SigningName:   resolved.SigningName,
+		Config:             s.Config,
+		Handlers:           s.Handlers,
+		Endpoint:           resolved.URL,
+		SigningRegion:      resolved.SigningRegion,
+		SigningNameDerived: resolved.SigningNameDerived,
+		SigningName:        resolved.SigningName,
 	}, err
 }
 
@@ -3,18 +3,31 @@ package session
 import (
 	"bytes"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/processcreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/csm"
 	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/awstesting"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/s3"
 )
 
@@ -110,6 +123,1354 @@ func TestNewSession_NoCredentials(t *testing.T) {
 	assert.NotEqual(t, credentials.AnonymousCredentials, s.Config.Credentials)
 }
 
+func TestNewSession_CSMEnabled(t *testing.T) {
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	os.Setenv("AWS_CSM_ENABLED", "true")
+	os.Setenv("AWS_CSM_PORT", "31001")
+	os.Setenv("AWS_CSM_CLIENT_ID", "my-client")
+
+	s, err := NewSession()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	r := csm.Get()
+	if r == nil {
+		t.Fatalf("expect CSM reporter to be started")
+	}
+	defer r.Close()
+
+	handlers := s.Handlers.Copy()
+	if !handlers.Validate.SwapNamed(request.NamedHandler{Name: csm.InjectHandlerName}) {
+		t.Errorf("expect CSM handlers to be injected")
+	}
+}
+
+func TestNewSession_CredentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential process test command requires a POSIX shell")
+	}
+
+	path := os.Getenv("PATH")
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	// The shell executing the process is found in the PATH.
+	os.Setenv("PATH", path)
+	os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", testConfigFilename)
+	os.Setenv("AWS_PROFILE", "credential_process")
+
+	s, err := NewSession()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	creds, err := s.Config.Credentials.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "process_akid", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+	if e, a := "process_secret", creds.SecretAccessKey; e != a {
+		t.Errorf("expect %v secret access key, got %v", e, a)
+	}
+	if e, a := processcreds.ProviderName, creds.ProviderName; e != a {
+		t.Errorf("expect %v provider, got %v", e, a)
+	}
+}
+
+func TestNewSession_SSOCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if e, a := "/federation/credentials", r.URL.Path; e != a {
+			t.Errorf("expect %v path, got %v", e, a)
+		}
+		if e, a := "cached_token", r.Header.Get("X-Amz-Sso_bearer_token"); e != a {
+			t.Errorf("expect %v access token, got %v", e, a)
+		}
+		if e, a := "TestRole", r.URL.Query().Get("role_name"); e != a {
+			t.Errorf("expect %v role name, got %v", e, a)
+		}
+		if e, a := "012345678901", r.URL.Query().Get("account_id"); e != a {
+			t.Errorf("expect %v account ID, got %v", e, a)
+		}
+		fmt.Fprintf(w, `{"roleCredentials":{"accessKeyId":"sso_akid","secretAccessKey":"sso_secret","sessionToken":"sso_token","expiration":%d}}`,
+			time.Now().Add(time.Hour).Unix()*1000)
+	}))
+	defer server.Close()
+
+	home, err := ioutil.TempDir("", "aws-sdk-go-sso")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(home)
+
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	os.Setenv("HOME", home)
+	os.Setenv("USERPROFILE", home)
+	os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", testConfigFilename)
+	os.Setenv("AWS_PROFILE", "sso_creds")
+
+	tokenFile := ssocreds.StandardCachedTokenFilepath("https://example.awsapps.com/start")
+	if err := os.MkdirAll(filepath.Dir(tokenFile), 0700); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	token := fmt.Sprintf(`{"accessToken":"cached_token","expiresAt":"%s"}`,
+		time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	if err := ioutil.WriteFile(tokenFile, []byte(token), 0600); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	s, err := NewSession(&aws.Config{Endpoint: aws.String(server.URL)})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	creds, err := s.Config.Credentials.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "sso_akid", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+	if e, a := "sso_secret", creds.SecretAccessKey; e != a {
+		t.Errorf("expect %v secret access key, got %v", e, a)
+	}
+	if e, a := "sso_token", creds.SessionToken; e != a {
+		t.Errorf("expect %v session token, got %v", e, a)
+	}
+	if e, a := ssocreds.ProviderName, creds.ProviderName; e != a {
+		t.Errorf("expect %v provider, got %v", e, a)
+	}
+}
+
+func TestNewSession_WebIdentityCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if e, a := "web_identity_token", r.Form.Get("WebIdentityToken"); e != a {
+			t.Errorf("expect %v token, got %v", e, a)
+		}
+		if e, a := "session_name", r.Form.Get("RoleSessionName"); e != a {
+			t.Errorf("expect %v session name, got %v", e, a)
+		}
+		fmt.Fprintf(w, `<AssumeRoleWithWebIdentityResponse><AssumeRoleWithWebIdentityResult><Credentials>
+<AccessKeyId>web_akid</AccessKeyId><SecretAccessKey>web_secret</SecretAccessKey><SessionToken>web_token</SessionToken>
+<Expiration>%s</Expiration></Credentials></AssumeRoleWithWebIdentityResult></AssumeRoleWithWebIdentityResponse>`,
+			time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	}))
+	defer server.Close()
+
+	f, err := ioutil.TempFile("", "web-identity-token")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("web_identity_token")
+	f.Close()
+
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	os.Setenv("AWS_REGION", "us-west-2")
+	os.Setenv("AWS_ROLE_ARN", "arn:aws:iam::012345678901:role/TestRole")
+	os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", f.Name())
+	os.Setenv("AWS_ROLE_SESSION_NAME", "session_name")
+
+	s, err := NewSession(&aws.Config{Endpoint: aws.String(server.URL)})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	creds, err := s.Config.Credentials.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "web_akid", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+	if e, a := stscreds.WebIdentityProviderName, creds.ProviderName; e != a {
+		t.Errorf("expect %v provider, got %v", e, a)
+	}
+}
+
+func TestNewSession_STSRegionalEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		Envs    map[string]string
+		Config  aws.Config
+		Profile string
+		Expect  string
+		Err     string
+	}{
+		"default": {
+			Expect: "https://sts.amazonaws.com",
+		},
+		"env legacy": {
+			Envs:   map[string]string{"AWS_STS_REGIONAL_ENDPOINTS": "legacy"},
+			Expect: "https://sts.amazonaws.com",
+		},
+		"env regional": {
+			Envs:   map[string]string{"AWS_STS_REGIONAL_ENDPOINTS": "regional"},
+			Expect: "https://sts.us-west-2.amazonaws.com",
+		},
+		"shared config regional": {
+			Envs:    map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile: "sts_regional_endpoints",
+			Expect:  "https://sts.us-west-2.amazonaws.com",
+		},
+		"shared config not enabled": {
+			Profile: "sts_regional_endpoints",
+			Expect:  "https://sts.amazonaws.com",
+		},
+		"env over shared config": {
+			Envs: map[string]string{
+				"AWS_SDK_LOAD_CONFIG":        "1",
+				"AWS_STS_REGIONAL_ENDPOINTS": "legacy",
+			},
+			Profile: "sts_regional_endpoints",
+			Expect:  "https://sts.amazonaws.com",
+		},
+		"config over env": {
+			Envs:   map[string]string{"AWS_STS_REGIONAL_ENDPOINTS": "legacy"},
+			Config: aws.Config{STSRegionalEndpoint: endpoints.RegionalSTSEndpoint},
+			Expect: "https://sts.us-west-2.amazonaws.com",
+		},
+		"invalid env": {
+			Envs: map[string]string{"AWS_STS_REGIONAL_ENDPOINTS": "global"},
+			Err:  "InvalidSTSRegionalEndpoint",
+		},
+	}
+
+	for name, c := range cases {
+		oldEnv := initSessionTestEnv()
+		os.Setenv("AWS_REGION", "us-west-2")
+		os.Setenv("AWS_CONFIG_FILE", testConfigFilename)
+		for k, v := range c.Envs {
+			os.Setenv(k, v)
+		}
+
+		s, err := NewSessionWithOptions(Options{
+			Config:  c.Config,
+			Profile: c.Profile,
+		})
+		awstesting.PopEnv(oldEnv)
+
+		if len(c.Err) != 0 {
+			if err == nil || !strings.Contains(err.Error(), c.Err) {
+				t.Errorf("%s, expect %v error, got %v", name, c.Err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if e, a := c.Expect, s.ClientConfig(endpoints.StsServiceID).Endpoint; e != a {
+			t.Errorf("%s, expect %v endpoint, got %v", name, e, a)
+		}
+	}
+}
+
+func TestNewSession_S3UsEast1RegionalEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		Envs    map[string]string
+		Config  aws.Config
+		Profile string
+		Expect  string
+		Err     string
+	}{
+		"default": {
+			Expect: "https://s3.amazonaws.com",
+		},
+		"env legacy": {
+			Envs:   map[string]string{"AWS_S3_US_EAST_1_REGIONAL_ENDPOINT": "legacy"},
+			Expect: "https://s3.amazonaws.com",
+		},
+		"env regional": {
+			Envs:   map[string]string{"AWS_S3_US_EAST_1_REGIONAL_ENDPOINT": "regional"},
+			Expect: "https://s3.us-east-1.amazonaws.com",
+		},
+		"shared config regional": {
+			Envs:    map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile: "s3_us_east_1_regional_endpoint",
+			Expect:  "https://s3.us-east-1.amazonaws.com",
+		},
+		"shared config not enabled": {
+			Profile: "s3_us_east_1_regional_endpoint",
+			Expect:  "https://s3.amazonaws.com",
+		},
+		"env over shared config": {
+			Envs: map[string]string{
+				"AWS_SDK_LOAD_CONFIG":                "1",
+				"AWS_S3_US_EAST_1_REGIONAL_ENDPOINT": "legacy",
+			},
+			Profile: "s3_us_east_1_regional_endpoint",
+			Expect:  "https://s3.amazonaws.com",
+		},
+		"config over env": {
+			Envs:   map[string]string{"AWS_S3_US_EAST_1_REGIONAL_ENDPOINT": "legacy"},
+			Config: aws.Config{S3UsEast1RegionalEndpoint: endpoints.RegionalS3UsEast1Endpoint},
+			Expect: "https://s3.us-east-1.amazonaws.com",
+		},
+		"invalid env": {
+			Envs: map[string]string{"AWS_S3_US_EAST_1_REGIONAL_ENDPOINT": "global"},
+			Err:  "InvalidS3UsEast1RegionalEndpoint",
+		},
+	}
+
+	for name, c := range cases {
+		oldEnv := initSessionTestEnv()
+		os.Setenv("AWS_REGION", "us-east-1")
+		os.Setenv("AWS_CONFIG_FILE", testConfigFilename)
+		for k, v := range c.Envs {
+			os.Setenv(k, v)
+		}
+
+		s, err := NewSessionWithOptions(Options{
+			Config:  c.Config,
+			Profile: c.Profile,
+		})
+		awstesting.PopEnv(oldEnv)
+
+		if len(c.Err) != 0 {
+			if err == nil || !strings.Contains(err.Error(), c.Err) {
+				t.Errorf("%s, expect %v error, got %v", name, c.Err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		cfg := s.ClientConfig(endpoints.S3ServiceID)
+		if e, a := c.Expect, cfg.Endpoint; e != a {
+			t.Errorf("%s, expect %v endpoint, got %v", name, e, a)
+		}
+		if e, a := "us-east-1", cfg.SigningRegion; e != a {
+			t.Errorf("%s, expect %v signing region, got %v", name, e, a)
+		}
+	}
+}
+
+func TestNewSession_EndpointDiscovery(t *testing.T) {
+	cases := map[string]struct {
+		Envs    map[string]string
+		Config  aws.Config
+		Profile string
+		Expect  *bool
+		Err     string
+	}{
+		"default": {},
+		"env enabled": {
+			Envs:   map[string]string{"AWS_ENABLE_ENDPOINT_DISCOVERY": "true"},
+			Expect: aws.Bool(true),
+		},
+		"env auto": {
+			Envs:   map[string]string{"AWS_ENABLE_ENDPOINT_DISCOVERY": "AUTO"},
+			Expect: aws.Bool(false),
+		},
+		"shared config enabled": {
+			Envs:    map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile: "endpoint_discovery",
+			Expect:  aws.Bool(true),
+		},
+		"shared config auto": {
+			Envs:    map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile: "endpoint_discovery_auto",
+			Expect:  aws.Bool(false),
+		},
+		"shared config not enabled": {
+			Profile: "endpoint_discovery",
+		},
+		"env over shared config": {
+			Envs: map[string]string{
+				"AWS_SDK_LOAD_CONFIG":           "1",
+				"AWS_ENABLE_ENDPOINT_DISCOVERY": "false",
+			},
+			Profile: "endpoint_discovery",
+			Expect:  aws.Bool(false),
+		},
+		"env over invalid shared config": {
+			Envs: map[string]string{
+				"AWS_SDK_LOAD_CONFIG":           "1",
+				"AWS_ENABLE_ENDPOINT_DISCOVERY": "true",
+			},
+			Profile: "endpoint_discovery_invalid",
+			Expect:  aws.Bool(true),
+		},
+		"config over env": {
+			Envs:   map[string]string{"AWS_ENABLE_ENDPOINT_DISCOVERY": "true"},
+			Config: aws.Config{EnableEndpointDiscovery: aws.Bool(false)},
+			Expect: aws.Bool(false),
+		},
+		"invalid env": {
+			Envs: map[string]string{"AWS_ENABLE_ENDPOINT_DISCOVERY": "yes"},
+			Err:  "from the AWS_ENABLE_ENDPOINT_DISCOVERY environment variable",
+		},
+		"invalid shared config": {
+			Envs:    map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile: "endpoint_discovery_invalid",
+			Err:     "from the endpoint_discovery_enabled key of shared config profile endpoint_discovery_invalid",
+		},
+	}
+
+	for name, c := range cases {
+		oldEnv := initSessionTestEnv()
+		os.Setenv("AWS_CONFIG_FILE", testConfigFilename)
+		for k, v := range c.Envs {
+			os.Setenv(k, v)
+		}
+
+		s, err := NewSessionWithOptions(Options{
+			Config:  c.Config,
+			Profile: c.Profile,
+		})
+		awstesting.PopEnv(oldEnv)
+
+		if len(c.Err) != 0 {
+			if err == nil || !strings.Contains(err.Error(), c.Err) {
+				t.Errorf("%s, expect %v error, got %v", name, c.Err, err)
+			}
+			if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != "InvalidEndpointDiscovery" {
+				t.Errorf("%s, expect InvalidEndpointDiscovery error, got %v", name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if e, a := c.Expect, s.Config.EnableEndpointDiscovery; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v endpoint discovery, got %v", name, aws.BoolValue(e), aws.BoolValue(a))
+		}
+	}
+}
+
+func TestNewSession_ServiceLogLevels(t *testing.T) {
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	s, err := NewSession(aws.NewConfig().
+		WithRegion("us-west-2").
+		WithLogLevel(aws.LogDebug).
+		WithServiceLogLevel(s3.ServiceID, aws.LogDebugWithHTTPBody))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	s3Client := s3.New(s)
+	ddbClient := dynamodb.New(s)
+
+	if e, a := aws.LogDebugWithHTTPBody, s3Client.Config.LogLevel.Value(); e != a {
+		t.Errorf("expect S3 client log level %v, got %v", e, a)
+	}
+	if e, a := aws.LogDebug, ddbClient.Config.LogLevel.Value(); e != a {
+		t.Errorf("expect DynamoDB client log level %v, got %v", e, a)
+	}
+
+	// Clients created with their own config keep the session's credentials,
+	// and service log levels.
+	s3Client = s3.New(s, aws.NewConfig().WithS3ForcePathStyle(true))
+	if e, a := aws.LogDebugWithHTTPBody, s3Client.Config.LogLevel.Value(); e != a {
+		t.Errorf("expect S3 client log level %v, got %v", e, a)
+	}
+	if e, a := s.Config.Credentials, s3Client.Config.Credentials; e != a {
+		t.Errorf("expect S3 client to use the session's credentials")
+	}
+}
+
+func TestNewSession_HTTPTransportOptions(t *testing.T) {
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	s, err := NewSession(aws.NewConfig().WithHTTPTransportOptions(aws.HTTPTransportOptions{
+		TLSHandshakeTimeout:   2 * time.Second,
+		ResponseHeaderTimeout: 3 * time.Second,
+		MaxIdleConnsPerHost:   5,
+	}))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if s.Config.HTTPClient == http.DefaultClient {
+		t.Fatalf("expect new HTTP client, got http.DefaultClient")
+	}
+	tr, ok := s.Config.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("expect *http.Transport, got %T", s.Config.HTTPClient.Transport)
+	}
+	if e, a := 2*time.Second, tr.TLSHandshakeTimeout; e != a {
+		t.Errorf("expect %v TLS handshake timeout, got %v", e, a)
+	}
+	if e, a := 3*time.Second, tr.ResponseHeaderTimeout; e != a {
+		t.Errorf("expect %v response header timeout, got %v", e, a)
+	}
+	if e, a := 5, tr.MaxIdleConnsPerHost; e != a {
+		t.Errorf("expect %v max idle conns per host, got %v", e, a)
+	}
+
+	if e, a := s.Config.HTTPClient, s.ClientConfig("s3").Config.HTTPClient; e != a {
+		t.Errorf("expect service clients to use the session's HTTP client")
+	}
+}
+
+func TestNewSession_HTTPTransportOptionsWithHTTPClient(t *testing.T) {
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	_, err := NewSession(&aws.Config{
+		HTTPClient:           &http.Client{},
+		HTTPTransportOptions: &aws.HTTPTransportOptions{DialTimeout: time.Second},
+	})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := "HTTPTransportOptionsError", err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+}
+
+func TestNewSession_RetryOptions(t *testing.T) {
+	cases := map[string]struct {
+		Envs             map[string]string
+		Config           aws.Config
+		Profile          string
+		ExpectMode       string
+		ExpectMaxRetries int
+		Err              string
+	}{
+		"default": {
+			ExpectMaxRetries: aws.UseServiceDefaultRetries,
+		},
+		"env": {
+			Envs: map[string]string{
+				"AWS_RETRY_MODE":   "standard",
+				"AWS_MAX_ATTEMPTS": "1",
+			},
+			ExpectMode:       aws.StandardRetryMode,
+			ExpectMaxRetries: 0,
+		},
+		"shared config": {
+			Envs:             map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile:          "retry_mode",
+			ExpectMode:       aws.AdaptiveRetryMode,
+			ExpectMaxRetries: 4,
+		},
+		"shared config not enabled": {
+			Profile:          "retry_mode",
+			ExpectMaxRetries: aws.UseServiceDefaultRetries,
+		},
+		"env over shared config": {
+			Envs: map[string]string{
+				"AWS_SDK_LOAD_CONFIG": "1",
+				"AWS_RETRY_MODE":      "Legacy",
+				"AWS_MAX_ATTEMPTS":    "10",
+			},
+			Profile:          "retry_mode",
+			ExpectMode:       aws.LegacyRetryMode,
+			ExpectMaxRetries: 9,
+		},
+		"config over env": {
+			Envs: map[string]string{
+				"AWS_RETRY_MODE":   "standard",
+				"AWS_MAX_ATTEMPTS": "10",
+			},
+			Config: aws.Config{
+				RetryMode:  aws.String(aws.AdaptiveRetryMode),
+				MaxRetries: aws.Int(1),
+			},
+			ExpectMode:       aws.AdaptiveRetryMode,
+			ExpectMaxRetries: 1,
+		},
+		"invalid env retry mode": {
+			Envs: map[string]string{"AWS_RETRY_MODE": "exponential"},
+			Err:  "from the AWS_RETRY_MODE environment variable",
+		},
+		"invalid env max attempts": {
+			Envs: map[string]string{"AWS_MAX_ATTEMPTS": "three"},
+			Err:  "from the AWS_MAX_ATTEMPTS environment variable",
+		},
+		"invalid shared config retry mode": {
+			Envs:    map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile: "invalid_retry_mode",
+			Err:     "from the retry_mode key of shared config profile invalid_retry_mode",
+		},
+		"invalid shared config max attempts": {
+			Envs:    map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile: "invalid_max_attempts",
+			Err:     "from the max_attempts key of shared config profile invalid_max_attempts",
+		},
+		"invalid shared config not used": {
+			Envs: map[string]string{
+				"AWS_SDK_LOAD_CONFIG": "1",
+				"AWS_MAX_ATTEMPTS":    "2",
+			},
+			Profile:          "invalid_max_attempts",
+			ExpectMaxRetries: 1,
+		},
+	}
+
+	for name, c := range cases {
+		oldEnv := initSessionTestEnv()
+		os.Setenv("AWS_CONFIG_FILE", testConfigFilename)
+		for k, v := range c.Envs {
+			os.Setenv(k, v)
+		}
+
+		s, err := NewSessionWithOptions(Options{
+			Config:  c.Config,
+			Profile: c.Profile,
+		})
+		awstesting.PopEnv(oldEnv)
+
+		if len(c.Err) != 0 {
+			if err == nil || !strings.Contains(err.Error(), c.Err) {
+				t.Errorf("%s, expect %v error, got %v", name, c.Err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if e, a := c.ExpectMode, aws.StringValue(s.Config.RetryMode); e != a {
+			t.Errorf("%s, expect %q retry mode, got %q", name, e, a)
+		}
+		if e, a := c.ExpectMaxRetries, aws.IntValue(s.Config.MaxRetries); e != a {
+			t.Errorf("%s, expect %d max retries, got %d", name, e, a)
+		}
+	}
+}
+
+func TestNewSession_UseFIPSEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		Envs    map[string]string
+		Region  string
+		Config  aws.Config
+		Profile string
+		Service string
+		Expect  string
+		Err     string
+	}{
+		"default": {
+			Region:  "us-east-1",
+			Service: "sts",
+			Expect:  "https://sts.amazonaws.com",
+		},
+		"env": {
+			Region:  "us-east-1",
+			Envs:    map[string]string{"AWS_USE_FIPS_ENDPOINT": "true"},
+			Service: "sts",
+			Expect:  "https://sts-fips.us-east-1.amazonaws.com",
+		},
+		"shared config": {
+			Envs:    map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile: "fips_endpoint",
+			Service: "s3",
+			Expect:  "https://s3-fips-us-gov-west-1.amazonaws.com",
+		},
+		"shared config not enabled": {
+			Region:  "us-east-1",
+			Profile: "fips_endpoint",
+			Service: "sts",
+			Expect:  "https://sts.amazonaws.com",
+		},
+		"env over shared config": {
+			Envs: map[string]string{
+				"AWS_SDK_LOAD_CONFIG":   "1",
+				"AWS_USE_FIPS_ENDPOINT": "false",
+			},
+			Profile: "fips_endpoint",
+			Service: "s3",
+			Expect:  "https://s3-us-gov-west-1.amazonaws.com",
+		},
+		"config over env": {
+			Region:  "us-east-1",
+			Envs:    map[string]string{"AWS_USE_FIPS_ENDPOINT": "true"},
+			Config:  aws.Config{UseFIPSEndpoint: aws.Bool(false)},
+			Service: "sts",
+			Expect:  "https://sts.amazonaws.com",
+		},
+		"no FIPS endpoint": {
+			Region:  "us-east-1",
+			Config:  aws.Config{UseFIPSEndpoint: aws.Bool(true)},
+			Service: "ec2",
+			Err:     "UnknownFIPSEndpointError",
+		},
+	}
+
+	for name, c := range cases {
+		oldEnv := initSessionTestEnv()
+		os.Setenv("AWS_REGION", c.Region)
+		os.Setenv("AWS_CONFIG_FILE", testConfigFilename)
+		for k, v := range c.Envs {
+			os.Setenv(k, v)
+		}
+
+		s, err := NewSessionWithOptions(Options{
+			Config:  c.Config,
+			Profile: c.Profile,
+		})
+		awstesting.PopEnv(oldEnv)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		cfg, err := s.clientConfigWithErr(c.Service)
+		if len(c.Err) != 0 {
+			if err == nil || !strings.Contains(err.Error(), c.Err) {
+				t.Errorf("%s, expect %v error, got %v", name, c.Err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if e, a := c.Expect, cfg.Endpoint; e != a {
+			t.Errorf("%s, expect %v endpoint, got %v", name, e, a)
+		}
+	}
+}
+
+func TestNewSession_UseFIPSEndpointRequestError(t *testing.T) {
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	s, err := NewSession(&aws.Config{
+		Region:          aws.String("us-west-2"),
+		UseFIPSEndpoint: aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	req, _ := s3.New(s).ListBucketsRequest(&s3.ListBucketsInput{})
+	err = req.Build()
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if _, ok := err.(endpoints.UnknownFIPSEndpointError); !ok {
+		t.Errorf("expect UnknownFIPSEndpointError error, got %T, %v", err, err)
+	}
+}
+
+func TestNewSession_UseDualStackEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		Envs    map[string]string
+		Region  string
+		Config  aws.Config
+		Profile string
+		Service string
+		Expect  string
+		Err     string
+	}{
+		"default": {
+			Region:  "us-west-2",
+			Service: "ec2",
+			Expect:  "https://ec2.us-west-2.amazonaws.com",
+		},
+		"env": {
+			Region:  "us-west-2",
+			Envs:    map[string]string{"AWS_USE_DUALSTACK_ENDPOINT": "true"},
+			Service: "ec2",
+			Expect:  "https://ec2.us-west-2.api.aws",
+		},
+		"shared config": {
+			Envs:    map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile: "dualstack_endpoint",
+			Service: "sqs",
+			Expect:  "https://sqs.us-west-2.api.aws",
+		},
+		"shared config not enabled": {
+			Region:  "us-west-2",
+			Profile: "dualstack_endpoint",
+			Service: "sqs",
+			Expect:  "https://sqs.us-west-2.amazonaws.com",
+		},
+		"env over shared config": {
+			Envs: map[string]string{
+				"AWS_SDK_LOAD_CONFIG":        "1",
+				"AWS_USE_DUALSTACK_ENDPOINT": "false",
+			},
+			Profile: "dualstack_endpoint",
+			Service: "sqs",
+			Expect:  "https://sqs.us-west-2.amazonaws.com",
+		},
+		"config over env": {
+			Region:  "us-west-2",
+			Envs:    map[string]string{"AWS_USE_DUALSTACK_ENDPOINT": "true"},
+			Config:  aws.Config{UseDualStackEndpoint: aws.Bool(false)},
+			Service: "ec2",
+			Expect:  "https://ec2.us-west-2.amazonaws.com",
+		},
+		"s3 legacy option": {
+			Region:  "us-west-2",
+			Envs:    map[string]string{"AWS_S3_USE_DUALSTACK_ENDPOINT": "true"},
+			Service: "s3",
+			Expect:  "https://s3.dualstack.us-west-2.amazonaws.com",
+		},
+		"no dualstack endpoint": {
+			Region:  "us-west-2",
+			Config:  aws.Config{UseDualStackEndpoint: aws.Bool(true)},
+			Service: "sts",
+			Err:     "EndpointNotFoundError",
+		},
+	}
+
+	for name, c := range cases {
+		oldEnv := initSessionTestEnv()
+		os.Setenv("AWS_REGION", c.Region)
+		os.Setenv("AWS_CONFIG_FILE", testConfigFilename)
+		for k, v := range c.Envs {
+			os.Setenv(k, v)
+		}
+
+		s, err := NewSessionWithOptions(Options{
+			Config:  c.Config,
+			Profile: c.Profile,
+		})
+		awstesting.PopEnv(oldEnv)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		cfg, err := s.clientConfigWithErr(c.Service)
+		if len(c.Err) != 0 {
+			if err == nil || !strings.Contains(err.Error(), c.Err) {
+				t.Errorf("%s, expect %v error, got %v", name, c.Err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if e, a := c.Expect, cfg.Endpoint; e != a {
+			t.Errorf("%s, expect %v endpoint, got %v", name, e, a)
+		}
+	}
+}
+
+func TestNewSession_UseDualStackEndpointRequestError(t *testing.T) {
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	s, err := NewSession(&aws.Config{
+		Region:               aws.String("cn-north-1"),
+		UseDualStackEndpoint: aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	req, _ := s3.New(s).ListBucketsRequest(&s3.ListBucketsInput{})
+	err = req.Build()
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if _, ok := err.(endpoints.EndpointNotFoundError); !ok {
+		t.Errorf("expect EndpointNotFoundError error, got %T, %v", err, err)
+	}
+}
+
+func TestNewSession_ConfiguredEndpointURLs(t *testing.T) {
+	cases := map[string]struct {
+		Envs          map[string]string
+		Config        aws.Config
+		Profile       string
+		Service       string
+		Expect        string
+		ExpectSigning string
+	}{
+		"default": {
+			Service:       "sqs",
+			Expect:        "https://sqs.us-west-2.amazonaws.com",
+			ExpectSigning: "sqs",
+		},
+		"env": {
+			Envs:          map[string]string{"AWS_ENDPOINT_URL": "http://localhost:4566"},
+			Service:       "sqs",
+			Expect:        "http://localhost:4566",
+			ExpectSigning: "sqs",
+		},
+		"env service": {
+			Envs: map[string]string{
+				"AWS_ENDPOINT_URL":     "http://localhost:4566",
+				"AWS_ENDPOINT_URL_SES": "http://localhost:4568",
+			},
+			Service:       "email",
+			Expect:        "http://localhost:4568",
+			ExpectSigning: "email",
+		},
+		"env service with spaces": {
+			Envs:          map[string]string{"AWS_ENDPOINT_URL_ELASTIC_BEANSTALK": "localhost:4569"},
+			Service:       "elasticbeanstalk",
+			Expect:        "https://localhost:4569",
+			ExpectSigning: "elasticbeanstalk",
+		},
+		"env other service": {
+			Envs:          map[string]string{"AWS_ENDPOINT_URL_SES": "http://localhost:4568"},
+			Service:       "sqs",
+			Expect:        "https://sqs.us-west-2.amazonaws.com",
+			ExpectSigning: "sqs",
+		},
+		"env ignored": {
+			Envs: map[string]string{
+				"AWS_ENDPOINT_URL":                    "http://localhost:4566",
+				"AWS_IGNORE_CONFIGURED_ENDPOINT_URLS": "true",
+			},
+			Service:       "sqs",
+			Expect:        "https://sqs.us-west-2.amazonaws.com",
+			ExpectSigning: "sqs",
+		},
+		"shared config": {
+			Envs:          map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile:       "endpoint_urls",
+			Service:       "sqs",
+			Expect:        "http://localhost:4566",
+			ExpectSigning: "sqs",
+		},
+		"shared config service": {
+			Envs:          map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile:       "endpoint_urls",
+			Service:       "email",
+			Expect:        "http://localhost:4568",
+			ExpectSigning: "email",
+		},
+		"shared config not enabled": {
+			Profile:       "endpoint_urls",
+			Service:       "sqs",
+			Expect:        "https://sqs.us-west-2.amazonaws.com",
+			ExpectSigning: "sqs",
+		},
+		"shared config ignored": {
+			Envs:          map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile:       "endpoint_urls_ignored",
+			Service:       "sqs",
+			Expect:        "https://sqs.us-west-2.amazonaws.com",
+			ExpectSigning: "sqs",
+		},
+		"env over shared config": {
+			Envs: map[string]string{
+				"AWS_SDK_LOAD_CONFIG": "1",
+				"AWS_ENDPOINT_URL":    "http://localhost:9000",
+			},
+			Profile:       "endpoint_urls",
+			Service:       "email",
+			Expect:        "http://localhost:9000",
+			ExpectSigning: "email",
+		},
+		"env ignore over shared config": {
+			Envs: map[string]string{
+				"AWS_SDK_LOAD_CONFIG":                 "1",
+				"AWS_IGNORE_CONFIGURED_ENDPOINT_URLS": "false",
+				"AWS_ENDPOINT_URL":                    "http://localhost:9000",
+			},
+			Profile:       "endpoint_urls_ignored",
+			Service:       "sqs",
+			Expect:        "http://localhost:9000",
+			ExpectSigning: "sqs",
+		},
+		"config over env": {
+			Envs:    map[string]string{"AWS_ENDPOINT_URL": "http://localhost:4566"},
+			Config:  aws.Config{Endpoint: aws.String("http://localhost:9000")},
+			Service: "sqs",
+			Expect:  "http://localhost:9000",
+		},
+		"not a service client": {
+			Envs:          map[string]string{"AWS_ENDPOINT_URL": "http://localhost:4566"},
+			Service:       "ec2metadata",
+			Expect:        "http://169.254.169.254/latest",
+			ExpectSigning: "ec2metadata",
+		},
+	}
+
+	for name, c := range cases {
+		oldEnv := initSessionTestEnv()
+		os.Setenv("AWS_REGION", "us-west-2")
+		os.Setenv("AWS_CONFIG_FILE", testConfigFilename)
+		for k, v := range c.Envs {
+			os.Setenv(k, v)
+		}
+
+		s, err := NewSessionWithOptions(Options{
+			Config:  c.Config,
+			Profile: c.Profile,
+		})
+		awstesting.PopEnv(oldEnv)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		cfg, err := s.clientConfigWithErr(c.Service)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if e, a := c.Expect, cfg.Endpoint; e != a {
+			t.Errorf("%s, expect %v endpoint, got %v", name, e, a)
+		}
+		if e, a := "us-west-2", cfg.SigningRegion; e != a {
+			t.Errorf("%s, expect %v signing region, got %v", name, e, a)
+		}
+		if e, a := c.ExpectSigning, cfg.SigningName; e != a {
+			t.Errorf("%s, expect %v signing name, got %v", name, e, a)
+		}
+	}
+}
+
+func TestNewSession_ConfiguredEndpointURLsS3(t *testing.T) {
+	cases := map[string]struct {
+		Envs   map[string]string
+		Expect string
+	}{
+		"path style": {
+			Expect: "http://localhost:4567/bucket/key",
+		},
+		"virtual hosted style": {
+			Envs:   map[string]string{"AWS_S3_ADDRESSING_STYLE": "virtual"},
+			Expect: "http://bucket.localhost:4567/key",
+		},
+		"env service over shared config": {
+			Envs:   map[string]string{"AWS_ENDPOINT_URL_S3": "http://localhost:9000"},
+			Expect: "http://localhost:9000/bucket/key",
+		},
+	}
+
+	for name, c := range cases {
+		oldEnv := initSessionTestEnv()
+		os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+		os.Setenv("AWS_CONFIG_FILE", testConfigFilename)
+		os.Setenv("AWS_PROFILE", "endpoint_urls")
+		for k, v := range c.Envs {
+			os.Setenv(k, v)
+		}
+
+		s, err := NewSession()
+		awstesting.PopEnv(oldEnv)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		req, _ := s3.New(s).GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+		})
+		if err := req.Build(); err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if e, a := c.Expect, req.HTTPRequest.URL.String(); e != a {
+			t.Errorf("%s, expect %v URL, got %v", name, e, a)
+		}
+	}
+}
+
+func TestNewSession_EndpointResolverFallback(t *testing.T) {
+	resolver := endpoints.URLResolverFunc(func(service, region string) (string, error) {
+		if service == endpoints.DynamodbServiceID {
+			return "http://localhost:8000", nil
+		}
+		return "", endpoints.NewEndpointNotFoundError("", service, region)
+	})
+
+	cases := map[string]struct {
+		Config        aws.Config
+		Service       string
+		Expect        string
+		ExpectSigning string
+	}{
+		"custom": {
+			Service:       "dynamodb",
+			Expect:        "http://localhost:8000",
+			ExpectSigning: "us-west-2",
+		},
+		"fallback": {
+			Service:       "sqs",
+			Expect:        "https://sqs.us-west-2.amazonaws.com",
+			ExpectSigning: "us-west-2",
+		},
+		"fallback global": {
+			Service:       "iam",
+			Expect:        "https://iam.amazonaws.com",
+			ExpectSigning: "us-east-1",
+		},
+		"fallback fips": {
+			Config:        aws.Config{UseFIPSEndpoint: aws.Bool(true)},
+			Service:       "sts",
+			Expect:        "https://sts-fips.us-west-2.amazonaws.com",
+			ExpectSigning: "us-west-2",
+		},
+		"fallback dualstack": {
+			Config:        aws.Config{UseDualStackEndpoint: aws.Bool(true)},
+			Service:       "sqs",
+			Expect:        "https://sqs.us-west-2.api.aws",
+			ExpectSigning: "us-west-2",
+		},
+	}
+
+	for name, c := range cases {
+		oldEnv := initSessionTestEnv()
+
+		c.Config.Region = aws.String("us-west-2")
+		c.Config.EndpointResolver = resolver
+		s, err := NewSession(&c.Config)
+		awstesting.PopEnv(oldEnv)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		cfg, err := s.clientConfigWithErr(c.Service)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := c.Expect, cfg.Endpoint; e != a {
+			t.Errorf("%s, expect %v endpoint, got %v", name, e, a)
+		}
+		if e, a := c.ExpectSigning, cfg.SigningRegion; e != a {
+			t.Errorf("%s, expect %v signing region, got %v", name, e, a)
+		}
+	}
+}
+
+func TestNewSession_SharedConfigS3(t *testing.T) {
+	cases := map[string]struct {
+		Envs                map[string]string
+		Config              aws.Config
+		Profile             string
+		ExpectAccelerate    *bool
+		ExpectDualStack     *bool
+		ExpectPathStyle     *bool
+		ExpectARNRegion     *bool
+		ExpectLogContains   string
+		ExpectLogNotContain string
+	}{
+		"shared config not enabled": {
+			Profile: "s3_path",
+		},
+		"shared config": {
+			Envs:             map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile:          "s3_path",
+			ExpectAccelerate: aws.Bool(true),
+			ExpectDualStack:  aws.Bool(true),
+			ExpectPathStyle:  aws.Bool(true),
+			ExpectARNRegion:  aws.Bool(true),
+		},
+		"config over shared config": {
+			Envs:    map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile: "s3_path",
+			Config: aws.Config{
+				S3UseAccelerate:  aws.Bool(false),
+				S3ForcePathStyle: aws.Bool(false),
+				S3UseARNRegion:   aws.Bool(false),
+			},
+			ExpectAccelerate: aws.Bool(false),
+			ExpectDualStack:  aws.Bool(true),
+			ExpectPathStyle:  aws.Bool(false),
+			ExpectARNRegion:  aws.Bool(false),
+		},
+		"env over config": {
+			Envs: map[string]string{
+				"AWS_SDK_LOAD_CONFIG":            "1",
+				"AWS_S3_USE_ACCELERATE_ENDPOINT": "true",
+				"AWS_S3_USE_DUALSTACK_ENDPOINT":  "false",
+				"AWS_S3_ADDRESSING_STYLE":        "virtual",
+				"AWS_S3_USE_ARN_REGION":          "false",
+			},
+			Profile: "s3_path",
+			Config: aws.Config{
+				S3UseAccelerate:  aws.Bool(false),
+				S3ForcePathStyle: aws.Bool(true),
+			},
+			ExpectAccelerate: aws.Bool(true),
+			ExpectDualStack:  aws.Bool(false),
+			ExpectPathStyle:  aws.Bool(false),
+			ExpectARNRegion:  aws.Bool(false),
+		},
+		"ignored values logged": {
+			Envs:    map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile: "s3_virtual",
+			Config: aws.Config{
+				LogLevel: aws.LogLevel(aws.LogDebug),
+			},
+			ExpectPathStyle:   aws.Bool(false),
+			ExpectLogContains: "unknown_key=value, use_dualstack_endpoint=notabool",
+		},
+		"ignored values not logged": {
+			Envs:                map[string]string{"AWS_SDK_LOAD_CONFIG": "1"},
+			Profile:             "s3_virtual",
+			ExpectPathStyle:     aws.Bool(false),
+			ExpectLogNotContain: "unknown_key",
+		},
+	}
+
+	for name, c := range cases {
+		oldEnv := initSessionTestEnv()
+		os.Setenv("AWS_CONFIG_FILE", testConfigS3Filename)
+		for k, v := range c.Envs {
+			os.Setenv(k, v)
+		}
+
+		logger := bytes.Buffer{}
+		c.Config.Logger = &mockLogger{&logger}
+
+		s, err := NewSessionWithOptions(Options{
+			Config:  c.Config,
+			Profile: c.Profile,
+		})
+		awstesting.PopEnv(oldEnv)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		cfg := s.Config
+		if e, a := c.ExpectAccelerate, cfg.S3UseAccelerate; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v accelerate, got %v", name, aws.BoolValue(e), aws.BoolValue(a))
+		}
+		if e, a := c.ExpectDualStack, cfg.UseDualStack; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v dualstack, got %v", name, aws.BoolValue(e), aws.BoolValue(a))
+		}
+		if e, a := c.ExpectPathStyle, cfg.S3ForcePathStyle; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v path style, got %v", name, aws.BoolValue(e), aws.BoolValue(a))
+		}
+		if e, a := c.ExpectARNRegion, cfg.S3UseARNRegion; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v ARN region, got %v", name, aws.BoolValue(e), aws.BoolValue(a))
+		}
+		if e, a := c.ExpectLogContains, logger.String(); !strings.Contains(a, e) {
+			t.Errorf("%s, expect log to contain %q, got %q", name, e, a)
+		}
+		if e, a := c.ExpectLogNotContain, logger.String(); len(e) != 0 && strings.Contains(a, e) {
+			t.Errorf("%s, expect log not to contain %q, got %q", name, e, a)
+		}
+	}
+}
+
+func TestNewSession_SharedConfigS3AccelerateDualStack(t *testing.T) {
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+	os.Setenv("AWS_CONFIG_FILE", testConfigS3Filename)
+	os.Setenv("AWS_PROFILE", "s3_path")
+
+	s, err := NewSessionWithOptions(Options{
+		Config: aws.Config{S3ForcePathStyle: aws.Bool(false)},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	req, _ := s3.New(s).GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	if err := req.Build(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "bucket.s3-accelerate.dualstack.amazonaws.com", req.HTTPRequest.URL.Host; e != a {
+		t.Errorf("expect %v host, got %v", e, a)
+	}
+}
+
+type countingTransport struct {
+	requests int
+}
+
+func (c *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	c.requests++
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestNewSession_EC2IMDSHTTPClient(t *testing.T) {
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"AccessKeyId":"container_akid","SecretAccessKey":"container_secret"}`))
+	}))
+	defer server.Close()
+	os.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", server.URL)
+
+	sessTransport := &countingTransport{}
+	imdsTransport := &countingTransport{}
+	s, err := NewSessionWithOptions(Options{
+		Config:            aws.Config{HTTPClient: &http.Client{Transport: sessTransport}},
+		EC2IMDSHTTPClient: &http.Client{Transport: imdsTransport},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	creds, err := s.Config.Credentials.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "container_akid", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v access key, got %v", e, a)
+	}
+	if e, a := 1, imdsTransport.requests; e != a {
+		t.Errorf("expect %v requests with the IMDS HTTP client, got %v", e, a)
+	}
+	if e, a := 0, sessTransport.requests; e != a {
+		t.Errorf("expect %v requests with the session HTTP client, got %v", e, a)
+	}
+}
+
+func TestNew_WebIdentityCredentials(t *testing.T) {
+	var sessionName, token string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		sessionName = r.Form.Get("RoleSessionName")
+		token = r.Form.Get("WebIdentityToken")
+		fmt.Fprintf(w, `<AssumeRoleWithWebIdentityResponse><AssumeRoleWithWebIdentityResult><Credentials>
+<AccessKeyId>web_akid</AccessKeyId><SecretAccessKey>web_secret</SecretAccessKey><SessionToken>web_token</SessionToken>
+<Expiration>%s</Expiration></Credentials></AssumeRoleWithWebIdentityResult></AssumeRoleWithWebIdentityResponse>`,
+			time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	}))
+	defer server.Close()
+
+	f, err := ioutil.TempFile("", "web-identity-token")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("web_identity_token")
+	f.Close()
+
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	os.Setenv("AWS_ROLE_ARN", "arn:aws:iam::012345678901:role/TestRole")
+	os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", f.Name())
+	os.Setenv("AWS_ROLE_SESSION_NAME", "session_name")
+
+	s := New(&aws.Config{Region: aws.String("us-west-2"), Endpoint: aws.String(server.URL)})
+
+	creds, err := s.Config.Credentials.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "web_akid", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+	if e, a := stscreds.WebIdentityProviderName, creds.ProviderName; e != a {
+		t.Errorf("expect %v provider, got %v", e, a)
+	}
+	if e, a := "session_name", sessionName; e != a {
+		t.Errorf("expect %v session name, got %v", e, a)
+	}
+	if e, a := "web_identity_token", token; e != a {
+		t.Errorf("expect %v token, got %v", e, a)
+	}
+}
+
 func TestNewSessionWithOptions_OverrideProfile(t *testing.T) {
 	oldEnv := initSessionTestEnv()
 	defer awstesting.PopEnv(oldEnv)
@@ -324,6 +1685,130 @@ func TestSesisonAssumeRole(t *testing.T) {
 	assert.Contains(t, creds.ProviderName, "AssumeRoleProvider")
 }
 
+func TestSessionAssumeRole_Options(t *testing.T) {
+	cases := map[string]struct {
+		Options        Options
+		ExpectDuration string
+	}{
+		"shared config": {
+			ExpectDuration: "3600",
+		},
+		"session option over shared config": {
+			Options:        Options{AssumeRoleDuration: 2 * time.Hour},
+			ExpectDuration: "7200",
+		},
+	}
+
+	for name, c := range cases {
+		oldEnv := initSessionTestEnv()
+		os.Setenv("AWS_REGION", "us-east-1")
+		os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+		os.Setenv("AWS_SHARED_CREDENTIALS_FILE", testConfigFilename)
+		os.Setenv("AWS_PROFILE", "assume_role_w_options")
+
+		var params map[string][]string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.ParseForm()
+			params = r.PostForm
+			w.Write([]byte(fmt.Sprintf(assumeRoleRespMsg, time.Now().Add(15*time.Minute).Format("2006-01-02T15:04:05Z"))))
+		}))
+
+		c.Options.Config = aws.Config{Endpoint: aws.String(server.URL), DisableSSL: aws.Bool(true)}
+		s, err := NewSessionWithOptions(c.Options)
+		awstesting.PopEnv(oldEnv)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		_, err = s.Config.Credentials.Get()
+		server.Close()
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		expect := map[string]string{
+			"RoleArn":         "assume_role_w_options_role_arn",
+			"DurationSeconds": c.ExpectDuration,
+			"ExternalId":      "1234",
+			"SourceIdentity":  "assume_role_w_options_identity",
+		}
+		for k, e := range expect {
+			if a := params[k]; len(a) != 1 || a[0] != e {
+				t.Errorf("%s, expect %v %s, got %v", name, e, k, a)
+			}
+		}
+	}
+}
+
+func TestSessionAssumeRole_InvalidDuration(t *testing.T) {
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	os.Setenv("AWS_REGION", "us-east-1")
+	os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", testConfigFilename)
+	os.Setenv("AWS_PROFILE", "assume_role_invalid_duration")
+
+	_, err := NewSession()
+	if _, ok := err.(SharedConfigAssumeRoleDurationError); !ok {
+		t.Fatalf("expect SharedConfigAssumeRoleDurationError error, got %T, %v", err, err)
+	}
+	if e, a := "profile assume_role_invalid_duration", err.Error(); !strings.Contains(a, e) {
+		t.Errorf("expect error to contain %q, got %q", e, a)
+	}
+}
+
+func TestSessionAssumeRole_SharedCredentialCache(t *testing.T) {
+	oldEnv := initSessionTestEnv()
+	defer awstesting.PopEnv(oldEnv)
+
+	home, err := ioutil.TempDir("", "session-cache")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(home)
+
+	os.Setenv("HOME", home)
+	os.Setenv("USERPROFILE", home)
+	os.Setenv("AWS_REGION", "us-east-1")
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", testConfigFilename)
+	os.Setenv("AWS_PROFILE", "assume_role_w_creds")
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(fmt.Sprintf(assumeRoleRespMsg, time.Now().Add(15*time.Minute).Format("2006-01-02T15:04:05Z"))))
+	}))
+	defer server.Close()
+
+	for i := 0; i < 2; i++ {
+		s, err := NewSessionWithOptions(Options{
+			Config:                      aws.Config{Endpoint: aws.String(server.URL), DisableSSL: aws.Bool(true)},
+			SharedConfigState:           SharedConfigEnable,
+			EnableSharedCredentialCache: true,
+		})
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+
+		creds, err := s.Config.Credentials.Get()
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := "AKID", creds.AccessKeyID; e != a {
+			t.Errorf("expect %v access key, got %v", e, a)
+		}
+	}
+
+	if e, a := 1, calls; e != a {
+		t.Errorf("expect %v assume role calls, got %v", e, a)
+	}
+	files, _ := filepath.Glob(filepath.Join(home, ".aws", "cli", "cache", "*.json"))
+	if e, a := 1, len(files); e != a {
+		t.Errorf("expect %v cache files, got %v", e, a)
+	}
+}
+
 func TestSessionAssumeRole_WithMFA(t *testing.T) {
 	oldEnv := initSessionTestEnv()
 	defer awstesting.PopEnv(oldEnv)
@@ -419,6 +1904,170 @@ func TestSessionAssumeRole_InvalidSourceProfile(t *testing.T) {
 	assert.Nil(t, s)
 }
 
+const assumeRoleChainRespMsg = `
+<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>%[1]s</Arn>
+      <AssumedRoleId>%[2]s_akid:session_name</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>%[2]s_akid</AccessKeyId>
+      <SecretAccessKey>%[2]s_secret</SecretAccessKey>
+      <SessionToken>%[2]s_token</SessionToken>
+      <Expiration>%[3]s</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>request-id</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>
+`
+
+func TestSessionAssumeRole_Chain(t *testing.T) {
+	type assumeRoleCall struct {
+		RoleARN, ExternalID, SessionName, Duration, AccessKeyID string
+	}
+
+	cases := []struct {
+		Profile   string
+		Envs      map[string]string
+		ExpectKey string
+		Calls     []assumeRoleCall
+		Err       string
+	}{
+		{
+			Profile:   "chain_hop_2",
+			ExpectKey: "hop_2_akid",
+			Calls: []assumeRoleCall{
+				{
+					RoleARN:     "arn:aws:iam::111111111111:role/hop_1",
+					ExternalID:  "hop_1_external_id",
+					SessionName: "hop_1_session",
+					Duration:    "1800",
+					AccessKeyID: "chain_source_akid",
+				},
+				{
+					RoleARN:     "arn:aws:iam::222222222222:role/hop_2",
+					SessionName: "hop_2_session",
+					Duration:    "3600",
+					AccessKeyID: "hop_1_akid",
+				},
+			},
+		},
+		{
+			Profile:   "chain_self_source_hop",
+			ExpectKey: "self_source_hop_akid",
+			Calls: []assumeRoleCall{
+				{
+					RoleARN:     "arn:aws:iam::333333333333:role/self_source",
+					Duration:    "900",
+					AccessKeyID: "chain_self_source_akid",
+				},
+				{
+					RoleARN:     "arn:aws:iam::333333333333:role/self_source_hop",
+					Duration:    "900",
+					AccessKeyID: "self_source_akid",
+				},
+			},
+		},
+		{
+			Profile: "chain_env_source_hop",
+			Envs: map[string]string{
+				"AWS_ACCESS_KEY_ID":     "env_akid",
+				"AWS_SECRET_ACCESS_KEY": "env_secret",
+			},
+			ExpectKey: "env_source_hop_akid",
+			Calls: []assumeRoleCall{
+				{
+					RoleARN:     "arn:aws:iam::444444444444:role/env_source",
+					Duration:    "900",
+					AccessKeyID: "env_akid",
+				},
+				{
+					RoleARN:     "arn:aws:iam::444444444444:role/env_source_hop",
+					Duration:    "900",
+					AccessKeyID: "env_source_akid",
+				},
+			},
+		},
+		{
+			Profile: "chain_env_source",
+			Err:     "EnvAccessKeyNotFound",
+		},
+		{
+			Profile: "chain_cycle_a",
+			Err:     "SharedConfigAssumeRoleCycleError",
+		},
+	}
+
+	for _, c := range cases {
+		oldEnv := initSessionTestEnv()
+		os.Setenv("AWS_REGION", "us-east-1")
+		os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+		os.Setenv("AWS_CONFIG_FILE", testConfigChainFilename)
+		os.Setenv("AWS_PROFILE", c.Profile)
+		for k, v := range c.Envs {
+			os.Setenv(k, v)
+		}
+
+		var calls []assumeRoleCall
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth := r.Header.Get("Authorization")
+			akid := auth[strings.Index(auth, "Credential=")+len("Credential="):]
+			akid = akid[:strings.Index(akid, "/")]
+
+			roleARN := r.FormValue("RoleArn")
+			calls = append(calls, assumeRoleCall{
+				RoleARN:     roleARN,
+				ExternalID:  r.FormValue("ExternalId"),
+				SessionName: r.FormValue("RoleSessionName"),
+				Duration:    r.FormValue("DurationSeconds"),
+				AccessKeyID: akid,
+			})
+
+			w.Write([]byte(fmt.Sprintf(assumeRoleChainRespMsg, roleARN,
+				roleARN[strings.LastIndex(roleARN, "/")+1:],
+				time.Now().Add(15*time.Minute).Format("2006-01-02T15:04:05Z"))))
+		}))
+
+		s, err := NewSession(&aws.Config{Endpoint: aws.String(server.URL), DisableSSL: aws.Bool(true)})
+		if len(c.Err) != 0 {
+			if err == nil {
+				t.Errorf("%s, expect %v error, got none", c.Profile, c.Err)
+			} else if !strings.Contains(err.Error(), c.Err) {
+				t.Errorf("%s, expect %v error, got %v", c.Profile, c.Err, err)
+			}
+			server.Close()
+			awstesting.PopEnv(oldEnv)
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", c.Profile, err)
+		}
+
+		creds, err := s.Config.Credentials.Get()
+		server.Close()
+		awstesting.PopEnv(oldEnv)
+
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", c.Profile, err)
+		}
+		if e, a := c.ExpectKey, creds.AccessKeyID; e != a {
+			t.Errorf("%s, expect %v access key, got %v", c.Profile, e, a)
+		}
+		// Session names not set by the profile are generated.
+		for i := range calls {
+			if i < len(c.Calls) && len(c.Calls[i].SessionName) == 0 {
+				calls[i].SessionName = ""
+			}
+		}
+		if e, a := c.Calls, calls; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v assume role calls, got %v", c.Profile, e, a)
+		}
+	}
+}
+
 func initSessionTestEnv() (oldEnv []string) {
 	oldEnv = awstesting.StashEnv()
 	os.Setenv("AWS_CONFIG_FILE", "file_not_exists")
@@ -3,6 +3,10 @@ package session
 import (
 	"fmt"
 	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -16,14 +20,53 @@ const (
 	sessionTokenKey = `aws_session_token`     // optional
 
 	// Assume Role Credentials group
-	roleArnKey         = `role_arn`          // group required
-	sourceProfileKey   = `source_profile`    // group required
-	externalIDKey      = `external_id`       // optional
-	mfaSerialKey       = `mfa_serial`        // optional
-	roleSessionNameKey = `role_session_name` // optional
+	roleArnKey          = `role_arn`          // group required
+	sourceProfileKey    = `source_profile`    // group required, or credential_source
+	credentialSourceKey = `credential_source` // group required, or source_profile
+	externalIDKey       = `external_id`       // optional
+	mfaSerialKey        = `mfa_serial`        // optional
+	roleSessionNameKey  = `role_session_name` // optional
+	durationSecondsKey  = `duration_seconds`  // optional
+	sourceIdentityKey   = `source_identity`   // optional
+
+	// Credential sources of credential_source
+	credSourceEc2Metadata  = `Ec2InstanceMetadata`
+	credSourceEnvironment  = `Environment`
+	credSourceECSContainer = `EcsContainer`
+
+	// Credential process, command executed to retrieve credentials
+	credentialProcessKey = `credential_process`
+
+	// SSO Credentials group
+	ssoStartURLKey  = `sso_start_url`  // group required
+	ssoRegionKey    = `sso_region`     // group required
+	ssoAccountIDKey = `sso_account_id` // group required
+	ssoRoleNameKey  = `sso_role_name`  // group required
 
 	// Additional Config fields
-	regionKey = `region`
+	regionKey                    = `region`
+	retryModeKey                 = `retry_mode`
+	maxAttemptsKey               = `max_attempts`
+	stsRegionalEndpointKey       = `sts_regional_endpoints`
+	s3UsEast1RegionalEndpointKey = `s3_us_east_1_regional_endpoint`
+	endpointDiscoveryEnabledKey  = `endpoint_discovery_enabled`
+	useFIPSEndpointKey           = `use_fips_endpoint`
+	useDualStackEndpointKey      = `use_dualstack_endpoint`
+	endpointURLKey               = `endpoint_url`
+	servicesKey                  = `services`
+	ignoreEndpointURLsKey        = `ignore_configured_endpoint_urls`
+	appIDKey                     = `sdk_ua_app_id`
+	caBundleKey                  = `ca_bundle`
+
+	// S3 nested configuration block
+	s3Key                    = `s3`
+	s3UseAccelerateKey       = `use_accelerate_endpoint`
+	s3UseDualStackKey        = `use_dualstack_endpoint`
+	s3AddressingStyleKey     = `addressing_style`
+	s3AddressingStylePath    = `path`
+	s3AddressingStyleVirtual = `virtual`
+	s3AddressingStyleAuto    = `auto`
+	s3UseARNRegionKey        = `use_arn_region`
 
 	// DefaultSharedConfigProfile is the default profile to be used when
 	// loading configuration from the config files if another profile name
@@ -32,11 +75,21 @@ const (
 )
 
 type assumeRoleConfig struct {
-	RoleARN         string
-	SourceProfile   string
-	ExternalID      string
-	MFASerial       string
-	RoleSessionName string
+	RoleARN          string
+	SourceProfile    string
+	CredentialSource string
+	ExternalID       string
+	MFASerial        string
+	RoleSessionName  string
+	Duration         time.Duration
+	SourceIdentity   string
+}
+
+type ssoConfig struct {
+	StartURL  string
+	Region    string
+	AccountID string
+	RoleName  string
 }
 
 // sharedConfig represents the configuration fields of the SDK config files.
@@ -52,19 +105,156 @@ type sharedConfig struct {
 	//	aws_session_token
 	Creds credentials.Value
 
-	AssumeRole       assumeRoleConfig
+	// AssumeRole is the role assumed with the credentials of the source
+	// profile, or of the credential source. Either source_profile or
+	// credential_source must be provided with role_arn to be considered
+	// valid.
+	//
+	//	role_arn
+	//	source_profile
+	//	credential_source
+	//	external_id
+	//	mfa_serial
+	//	role_session_name
+	//	duration_seconds
+	//	source_identity
+	AssumeRole assumeRoleConfig
+
+	// AssumeRoleSource is the config of the source profile. If the source
+	// profile assumes a role itself, its AssumeRoleSource is set, chaining
+	// the roles. Nil if the role is assumed with the credential source.
 	AssumeRoleSource *sharedConfig
 
+	// CredentialProcess is the command executed to retrieve credentials.
+	// Static credentials in the profile take precedence.
+	//
+	//	credential_process
+	CredentialProcess string
+
+	// SSO is the role credentials are retrieved for with the cached access
+	// token of an SSO session. All of the keys must be provided to be
+	// considered valid.
+	//
+	//	sso_start_url
+	//	sso_region
+	//	sso_account_id
+	//	sso_role_name
+	SSO ssoConfig
+
 	// Region is the region the SDK should use for looking up AWS service endpoints
 	// and signing requests.
 	//
 	//	region
 	Region string
+
+	// RetryMode is the retry mode service clients should use, e.g. adaptive.
+	//
+	//	retry_mode
+	RetryMode string
+
+	// MaxAttempts is the maximum number of attempts of requests, including
+	// the first attempt.
+	//
+	//	max_attempts
+	MaxAttempts string
+
+	// STSRegionalEndpoint is the STS endpoint resolution mode, legacy or
+	// regional.
+	//
+	//	sts_regional_endpoints
+	STSRegionalEndpoint string
+
+	// S3UsEast1RegionalEndpoint is the S3 us-east-1 endpoint resolution mode,
+	// legacy or regional.
+	//
+	//	s3_us_east_1_regional_endpoint
+	S3UsEast1RegionalEndpoint string
+
+	// CustomCABundle is the path of the PEM bundle of the CAs the SDK's HTTP
+	// client trusts. See the AWS_CA_BUNDLE environment variable.
+	//
+	//	ca_bundle
+	CustomCABundle string
+
+	// EnableEndpointDiscovery is if service clients should discover the
+	// endpoints of API operations supporting endpoint discovery, true,
+	// false, or auto.
+	//
+	//	endpoint_discovery_enabled
+	EnableEndpointDiscovery string
+
+	// UseFIPSEndpoint is if service clients should resolve the FIPS
+	// endpoints of services.
+	//
+	//	use_fips_endpoint
+	UseFIPSEndpoint *bool
+
+	// UseDualStackEndpoint is if service clients should resolve the
+	// dualstack endpoints of services.
+	//
+	//	use_dualstack_endpoint
+	UseDualStackEndpoint *bool
+
+	// EndpointURL is the endpoint URL of the service clients without a
+	// service specific endpoint URL.
+	//
+	//	endpoint_url
+	EndpointURL string
+
+	// ServiceEndpointURLs are the service specific endpoint URLs, set by the
+	// services section the profile refers to, keyed by the service's ID with
+	// spaces replaced by underscores, and lower cased.
+	//
+	//	[profile foo]
+	//	services = my-services
+	//
+	//	[services my-services]
+	//	elastic_beanstalk =
+	//	  endpoint_url = http://localhost:4566
+	ServiceEndpointURLs map[string]string
+
+	// IgnoreConfiguredEndpointURLs is if the endpoint URLs configured by the
+	// environment and shared config are disabled.
+	//
+	//	ignore_configured_endpoint_urls
+	IgnoreConfiguredEndpointURLs *bool
+
+	// AppID is the identifier of the application added to the User-Agent
+	// of requests.
+	//
+	//	sdk_ua_app_id
+	AppID string
+
+	// S3 is the configuration of the S3 client, set by the nested s3 block
+	// of the profile.
+	//
+	//	s3 =
+	//	  use_accelerate_endpoint = true
+	//	  use_dualstack_endpoint = true
+	//	  addressing_style = path
+	//	  use_arn_region = true
+	S3 s3Config
+}
+
+type s3Config struct {
+	UseAccelerate  *bool
+	UseDualStack   *bool
+	ForcePathStyle *bool
+	UseARNRegion   *bool
+
+	// Ignored are the keys of the s3 block which are unknown, or have
+	// invalid values, as key=value pairs.
+	Ignored []string
 }
 
 type sharedConfigFile struct {
 	Filename string
 	IniData  *ini.File
+
+	// Nested are the nested values of the file's sections, keyed by section
+	// name and the key of the nested block. The ini parser does not support
+	// nested values, which are removed before the file is parsed.
+	Nested map[string]map[string]map[string]string
 }
 
 // loadSharedConfig retrieves the configuration from the list of files
@@ -78,22 +268,28 @@ type sharedConfigFile struct {
 // See sharedConfig.setFromFile for information how the config files
 // will be loaded.
 func loadSharedConfig(profile string, filenames []string) (sharedConfig, error) {
-	if len(profile) == 0 {
-		profile = DefaultSharedConfigProfile
-	}
-
 	files, err := loadSharedConfigIniFiles(filenames)
 	if err != nil {
 		return sharedConfig{}, err
 	}
 
+	return loadSharedConfigFromFiles(profile, files)
+}
+
+// loadSharedConfigFromFiles retrieves the configuration of the profile from
+// the loaded shared config files, see loadSharedConfig.
+func loadSharedConfigFromFiles(profile string, files []sharedConfigFile) (sharedConfig, error) {
+	if len(profile) == 0 {
+		profile = DefaultSharedConfigProfile
+	}
+
 	cfg := sharedConfig{}
-	if err = cfg.setFromIniFiles(profile, files); err != nil {
+	if err := cfg.setFromIniFiles(profile, files); err != nil {
 		return sharedConfig{}, err
 	}
 
-	if len(cfg.AssumeRole.SourceProfile) > 0 {
-		if err := cfg.setAssumeRoleSource(profile, files); err != nil {
+	if len(cfg.AssumeRole.RoleARN) > 0 {
+		if err := cfg.setAssumeRoleSource(profile, files, nil); err != nil {
 			return sharedConfig{}, err
 		}
 	}
@@ -111,34 +307,81 @@ func loadSharedConfigIniFiles(filenames []string) ([]sharedConfigFile, error) {
 			continue
 		}
 
+		b, nested := splitNestedValues(b)
 		f, err := ini.Load(b)
 		if err != nil {
 			return nil, SharedConfigLoadError{Filename: filename, Err: err}
 		}
 
 		files = append(files, sharedConfigFile{
-			Filename: filename, IniData: f,
+			Filename: filename, IniData: f, Nested: nested,
 		})
 	}
 
 	return files, nil
 }
 
-func (cfg *sharedConfig) setAssumeRoleSource(origProfile string, files []sharedConfigFile) error {
-	var assumeRoleSrc sharedConfig
+// sharedConfigKeyFilename returns the name of the file the value of the key
+// of the profile is loaded from, the last of the files which sets the key.
+func sharedConfigKeyFilename(profile, key string, files []sharedConfigFile) string {
+	for i := len(files) - 1; i >= 0; i-- {
+		section, err := files[i].IniData.GetSection(profile)
+		if err != nil {
+			section, err = files[i].IniData.GetSection(fmt.Sprintf("profile %s", profile))
+			if err != nil {
+				continue
+			}
+		}
+		if len(section.Key(key).String()) > 0 {
+			return files[i].Filename
+		}
+	}
+
+	return ""
+}
+
+// setAssumeRoleSource loads the source of the credentials the role of the
+// profile is assumed with. Source profiles assuming a role themselves are
+// loaded recursively, chaining the roles, until a profile with credentials,
+// or with a credential_source, is found. The profiles already in the chain
+// are used to detect source_profile cycles.
+func (cfg *sharedConfig) setAssumeRoleSource(profile string, files []sharedConfigFile, chain []string) error {
+	if len(cfg.AssumeRole.CredentialSource) > 0 {
+		return cfg.validateCredentialSource(profile)
+	}
 
-	// Multiple level assume role chains are not support
-	if cfg.AssumeRole.SourceProfile == origProfile {
+	chain = append(chain, profile)
+
+	var assumeRoleSrc sharedConfig
+	if cfg.AssumeRole.SourceProfile == profile {
+		// The profile's own credentials are the source of the role.
 		assumeRoleSrc = *cfg
 		assumeRoleSrc.AssumeRole = assumeRoleConfig{}
 	} else {
+		for _, p := range chain {
+			if p == cfg.AssumeRole.SourceProfile {
+				return SharedConfigAssumeRoleCycleError{
+					Profiles: append(chain, cfg.AssumeRole.SourceProfile),
+				}
+			}
+		}
+
 		err := assumeRoleSrc.setFromIniFiles(cfg.AssumeRole.SourceProfile, files)
 		if err != nil {
 			return err
 		}
+
+		if len(assumeRoleSrc.AssumeRole.RoleARN) > 0 {
+			err := assumeRoleSrc.setAssumeRoleSource(cfg.AssumeRole.SourceProfile, files, chain)
+			if err != nil {
+				return err
+			}
+			cfg.AssumeRoleSource = &assumeRoleSrc
+			return nil
+		}
 	}
 
-	if len(assumeRoleSrc.Creds.AccessKeyID) == 0 {
+	if !assumeRoleSrc.hasCredentials() {
 		return SharedConfigAssumeRoleError{RoleARN: cfg.AssumeRole.RoleARN}
 	}
 
@@ -147,6 +390,44 @@ func (cfg *sharedConfig) setAssumeRoleSource(origProfile string, files []sharedC
 	return nil
 }
 
+// validateCredentialSource returns an error if the credential_source of the
+// profile is not valid, or is provided with a source_profile.
+func (cfg *sharedConfig) validateCredentialSource(profile string) error {
+	err := SharedConfigCredentialSourceError{
+		Profile:          profile,
+		CredentialSource: cfg.AssumeRole.CredentialSource,
+		SourceProfile:    cfg.AssumeRole.SourceProfile,
+	}
+	if len(cfg.AssumeRole.SourceProfile) > 0 {
+		return err
+	}
+
+	switch cfg.AssumeRole.CredentialSource {
+	case credSourceEc2Metadata, credSourceEnvironment, credSourceECSContainer:
+		return nil
+	default:
+		return err
+	}
+}
+
+// hasCredentials returns if the profile provides credentials, other than by
+// assuming a role.
+func (cfg *sharedConfig) hasCredentials() bool {
+	return len(cfg.Creds.AccessKeyID) > 0 || len(cfg.SSO.StartURL) > 0 || len(cfg.CredentialProcess) > 0
+}
+
+// assumeRoleCredentialSource returns the credential_source of the root of
+// the profile's assume role chain, or an empty string if the chain has no
+// credential source.
+func (cfg *sharedConfig) assumeRoleCredentialSource() string {
+	for c := cfg; c != nil && len(c.AssumeRole.RoleARN) > 0; c = c.AssumeRoleSource {
+		if len(c.AssumeRole.CredentialSource) > 0 {
+			return c.AssumeRole.CredentialSource
+		}
+	}
+	return ""
+}
+
 func (cfg *sharedConfig) setFromIniFiles(profile string, files []sharedConfigFile) error {
 	// Trim files from the list that don't exist.
 	for _, f := range files {
@@ -195,14 +476,41 @@ func (cfg *sharedConfig) setFromIniFile(profile string, file sharedConfigFile) e
 	// Assume Role
 	roleArn := section.Key(roleArnKey).String()
 	srcProfile := section.Key(sourceProfileKey).String()
-	if len(roleArn) > 0 && len(srcProfile) > 0 {
+	credSource := section.Key(credentialSourceKey).String()
+	if len(roleArn) > 0 && (len(srcProfile) > 0 || len(credSource) > 0) {
 		cfg.AssumeRole = assumeRoleConfig{
-			RoleARN:         roleArn,
-			SourceProfile:   srcProfile,
-			ExternalID:      section.Key(externalIDKey).String(),
-			MFASerial:       section.Key(mfaSerialKey).String(),
-			RoleSessionName: section.Key(roleSessionNameKey).String(),
+			RoleARN:          roleArn,
+			SourceProfile:    srcProfile,
+			CredentialSource: credSource,
+			ExternalID:       section.Key(externalIDKey).String(),
+			MFASerial:        section.Key(mfaSerialKey).String(),
+			RoleSessionName:  section.Key(roleSessionNameKey).String(),
+			SourceIdentity:   section.Key(sourceIdentityKey).String(),
 		}
+		if v := section.Key(durationSecondsKey).String(); len(v) > 0 {
+			n, err := strconv.ParseInt(v, 10, 64)
+			d := time.Duration(n) * time.Second
+			if err != nil || d < minAssumeRoleDuration || d > maxAssumeRoleDuration {
+				return SharedConfigAssumeRoleDurationError{Profile: profile, DurationSeconds: v}
+			}
+			cfg.AssumeRole.Duration = d
+		}
+	}
+
+	// SSO
+	sso := ssoConfig{
+		StartURL:  section.Key(ssoStartURLKey).String(),
+		Region:    section.Key(ssoRegionKey).String(),
+		AccountID: section.Key(ssoAccountIDKey).String(),
+		RoleName:  section.Key(ssoRoleNameKey).String(),
+	}
+	if len(sso.StartURL) > 0 && len(sso.Region) > 0 && len(sso.AccountID) > 0 && len(sso.RoleName) > 0 {
+		cfg.SSO = sso
+	}
+
+	// Credential process
+	if v := section.Key(credentialProcessKey).String(); len(v) > 0 {
+		cfg.CredentialProcess = v
 	}
 
 	// Region
@@ -210,9 +518,170 @@ func (cfg *sharedConfig) setFromIniFile(profile string, file sharedConfigFile) e
 		cfg.Region = v
 	}
 
+	// Retry mode
+	if v := section.Key(retryModeKey).String(); len(v) > 0 {
+		cfg.RetryMode = v
+	}
+	if v := section.Key(maxAttemptsKey).String(); len(v) > 0 {
+		cfg.MaxAttempts = v
+	}
+
+	// STS regional endpoints
+	if v := section.Key(stsRegionalEndpointKey).String(); len(v) > 0 {
+		cfg.STSRegionalEndpoint = v
+	}
+
+	// S3 us-east-1 regional endpoint
+	if v := section.Key(s3UsEast1RegionalEndpointKey).String(); len(v) > 0 {
+		cfg.S3UsEast1RegionalEndpoint = v
+	}
+
+	// Custom CA bundle
+	if v := section.Key(caBundleKey).String(); len(v) > 0 {
+		cfg.CustomCABundle = v
+	}
+
+	// Endpoint discovery
+	if v := section.Key(endpointDiscoveryEnabledKey).String(); len(v) > 0 {
+		cfg.EnableEndpointDiscovery = v
+	}
+
+	// FIPS endpoints
+	if v, err := section.Key(useFIPSEndpointKey).Bool(); err == nil {
+		cfg.UseFIPSEndpoint = &v
+	}
+
+	// Dualstack endpoints
+	if v, err := section.Key(useDualStackEndpointKey).Bool(); err == nil {
+		cfg.UseDualStackEndpoint = &v
+	}
+
+	// Endpoint URLs
+	if v := section.Key(endpointURLKey).String(); len(v) > 0 {
+		cfg.EndpointURL = v
+	}
+	if v := section.Key(servicesKey).String(); len(v) > 0 {
+		for svc, values := range file.Nested[servicesKey+" "+v] {
+			if u := values[endpointURLKey]; len(u) > 0 {
+				if cfg.ServiceEndpointURLs == nil {
+					cfg.ServiceEndpointURLs = map[string]string{}
+				}
+				cfg.ServiceEndpointURLs[serviceEndpointURLKey(svc)] = u
+			}
+		}
+	}
+	if v, err := section.Key(ignoreEndpointURLsKey).Bool(); err == nil {
+		cfg.IgnoreConfiguredEndpointURLs = &v
+	}
+
+	// User-Agent application ID
+	if v := section.Key(appIDKey).String(); len(v) > 0 {
+		cfg.AppID = v
+	}
+
+	// S3
+	if values, ok := file.Nested[section.Name()][s3Key]; ok {
+		cfg.S3.setFromNestedValues(values)
+	}
+
 	return nil
 }
 
+// setFromNestedValues sets the S3 configuration from the values of the s3
+// block. Unknown keys and invalid values are ignored.
+func (c *s3Config) setFromNestedValues(values map[string]string) {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := values[k]
+		switch k {
+		case s3UseAccelerateKey:
+			if b, err := strconv.ParseBool(v); err == nil {
+				c.UseAccelerate = &b
+				continue
+			}
+		case s3UseDualStackKey:
+			if b, err := strconv.ParseBool(v); err == nil {
+				c.UseDualStack = &b
+				continue
+			}
+		case s3UseARNRegionKey:
+			if b, err := strconv.ParseBool(v); err == nil {
+				c.UseARNRegion = &b
+				continue
+			}
+		case s3AddressingStyleKey:
+			switch strings.ToLower(v) {
+			case s3AddressingStylePath:
+				b := true
+				c.ForcePathStyle = &b
+				continue
+			case s3AddressingStyleVirtual:
+				b := false
+				c.ForcePathStyle = &b
+				continue
+			case s3AddressingStyleAuto:
+				c.ForcePathStyle = nil
+				continue
+			}
+		}
+		c.Ignored = append(c.Ignored, k+"="+v)
+	}
+}
+
+// splitNestedValues removes the nested values from the config file, and
+// returns them keyed by section name and the key of the nested block. A
+// nested block is a key without a value followed by indented key value
+// pairs, such as:
+//
+//	[profile foo]
+//	s3 =
+//	  addressing_style = path
+func splitNestedValues(b []byte) ([]byte, map[string]map[string]map[string]string) {
+	nested := map[string]map[string]map[string]string{}
+
+	var out []string
+	var section string
+	var block map[string]string
+	for _, line := range strings.Split(string(b), "\n") {
+		trimmed := strings.TrimSpace(line)
+		indented := len(trimmed) > 0 && len(line) > 0 && (line[0] == ' ' || line[0] == '\t')
+
+		if block != nil && indented {
+			if trimmed[0] != '#' && trimmed[0] != ';' {
+				if i := strings.IndexAny(trimmed, "=:"); i > 0 {
+					block[strings.TrimSpace(trimmed[:i])] = strings.TrimSpace(trimmed[i+1:])
+				}
+			}
+			continue
+		}
+		block = nil
+		out = append(out, line)
+
+		switch {
+		case len(trimmed) == 0 || indented:
+		case trimmed[0] == '[' && trimmed[len(trimmed)-1] == ']':
+			section = strings.TrimSpace(trimmed[1 : len(trimmed)-1])
+		default:
+			i := strings.IndexAny(trimmed, "=:")
+			if i <= 0 || len(strings.TrimSpace(trimmed[i+1:])) != 0 {
+				continue
+			}
+			if nested[section] == nil {
+				nested[section] = map[string]map[string]string{}
+			}
+			block = map[string]string{}
+			nested[section][strings.TrimSpace(trimmed[:i])] = block
+		}
+	}
+
+	return []byte(strings.Join(out, "\n")), nested
+}
+
 // SharedConfigLoadError is an error for the shared config file failed to load.
 type SharedConfigLoadError struct {
 	Filename string
@@ -293,3 +762,102 @@ func (e SharedConfigAssumeRoleError) OrigErr() error {
 func (e SharedConfigAssumeRoleError) Error() string {
 	return awserr.SprintError(e.Code(), e.Message(), "", nil)
 }
+
+// The bounds of the duration_seconds of a profile's assumed role.
+const (
+	minAssumeRoleDuration = 15 * time.Minute
+	maxAssumeRoleDuration = 12 * time.Hour
+)
+
+// SharedConfigAssumeRoleDurationError is an error for the shared config when
+// the duration_seconds of the profile's assumed role is not a number of
+// seconds between 900, 15 minutes, and 43200, 12 hours.
+type SharedConfigAssumeRoleDurationError struct {
+	Profile         string
+	DurationSeconds string
+}
+
+// Code is the short id of the error.
+func (e SharedConfigAssumeRoleDurationError) Code() string {
+	return "SharedConfigAssumeRoleDurationError"
+}
+
+// Message is the description of the error
+func (e SharedConfigAssumeRoleDurationError) Message() string {
+	return fmt.Sprintf("failed to load assume role of profile %s, duration_seconds %q must be between %d and %d",
+		e.Profile, e.DurationSeconds,
+		minAssumeRoleDuration/time.Second, maxAssumeRoleDuration/time.Second)
+}
+
+// OrigErr is the underlying error that caused the failure.
+func (e SharedConfigAssumeRoleDurationError) OrigErr() error {
+	return nil
+}
+
+// Error satisfies the error interface.
+func (e SharedConfigAssumeRoleDurationError) Error() string {
+	return awserr.SprintError(e.Code(), e.Message(), "", nil)
+}
+
+// SharedConfigAssumeRoleCycleError is an error for the shared config when the
+// source_profile links of the profile's assume role chain form a cycle.
+type SharedConfigAssumeRoleCycleError struct {
+	// Profiles are the profiles of the chain, ending with the profile
+	// closing the cycle.
+	Profiles []string
+}
+
+// Code is the short id of the error.
+func (e SharedConfigAssumeRoleCycleError) Code() string {
+	return "SharedConfigAssumeRoleCycleError"
+}
+
+// Message is the description of the error
+func (e SharedConfigAssumeRoleCycleError) Message() string {
+	return fmt.Sprintf("failed to load assume role chain, source_profile cycle, %s",
+		strings.Join(e.Profiles, " -> "))
+}
+
+// OrigErr is the underlying error that caused the failure.
+func (e SharedConfigAssumeRoleCycleError) OrigErr() error {
+	return nil
+}
+
+// Error satisfies the error interface.
+func (e SharedConfigAssumeRoleCycleError) Error() string {
+	return awserr.SprintError(e.Code(), e.Message(), "", nil)
+}
+
+// SharedConfigCredentialSourceError is an error for the shared config when
+// the credential_source of the profile is not valid, or the profile provides
+// both credential_source and source_profile.
+type SharedConfigCredentialSourceError struct {
+	Profile          string
+	CredentialSource string
+	SourceProfile    string
+}
+
+// Code is the short id of the error.
+func (e SharedConfigCredentialSourceError) Code() string {
+	return "SharedConfigCredentialSourceError"
+}
+
+// Message is the description of the error
+func (e SharedConfigCredentialSourceError) Message() string {
+	if len(e.SourceProfile) > 0 {
+		return fmt.Sprintf("failed to load assume role of profile %s, only one of source_profile and credential_source may be set",
+			e.Profile)
+	}
+	return fmt.Sprintf("failed to load assume role of profile %s, credential_source %q is not one of %s, %s, or %s",
+		e.Profile, e.CredentialSource, credSourceEc2Metadata, credSourceEnvironment, credSourceECSContainer)
+}
+
+// OrigErr is the underlying error that caused the failure.
+func (e SharedConfigCredentialSourceError) OrigErr() error {
+	return nil
+}
+
+// Error satisfies the error interface.
+func (e SharedConfigCredentialSourceError) Error() string {
+	return awserr.SprintError(e.Code(), e.Message(), "", nil)
+}
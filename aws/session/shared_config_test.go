@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/go-ini/ini"
 	"github.com/stretchr/testify/assert"
@@ -13,6 +15,8 @@ import (
 var (
 	testConfigFilename      = filepath.Join("testdata", "shared_config")
 	testConfigOtherFilename = filepath.Join("testdata", "shared_config_other")
+	testConfigChainFilename = filepath.Join("testdata", "shared_config_assume_role_chain")
+	testConfigS3Filename    = filepath.Join("testdata", "shared_config_s3")
 )
 
 func TestLoadSharedConfig(t *testing.T) {
@@ -30,6 +34,9 @@ func TestLoadSharedConfig(t *testing.T) {
 			Filenames: []string{testConfigFilename},
 			Expected: sharedConfig{
 				Region: "default_region",
+				S3: s3Config{
+					Ignored: []string{"other_unsupported=abc", "unsupported_key=123"},
+				},
 			},
 		},
 		{
@@ -108,6 +115,33 @@ func TestLoadSharedConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			Filenames: []string{testConfigFilename},
+			Profile:   "assume_role_w_options",
+			Expected: sharedConfig{
+				AssumeRole: assumeRoleConfig{
+					RoleARN:        "assume_role_w_options_role_arn",
+					SourceProfile:  "complete_creds",
+					ExternalID:     "1234",
+					Duration:       time.Hour,
+					SourceIdentity: "assume_role_w_options_identity",
+				},
+				AssumeRoleSource: &sharedConfig{
+					Creds: credentials.Value{
+						AccessKeyID:     "complete_creds_akid",
+						SecretAccessKey: "complete_creds_secret",
+						ProviderName:    fmt.Sprintf("SharedConfigCredentials: %s", testConfigFilename),
+					},
+				},
+			},
+		},
+		{
+			Filenames: []string{testConfigFilename},
+			Profile:   "assume_role_invalid_duration",
+			Err: SharedConfigAssumeRoleDurationError{
+				Profile: "assume_role_invalid_duration", DurationSeconds: "60",
+			},
+		},
 		{
 			Filenames: []string{testConfigOtherFilename, testConfigFilename},
 			Profile:   "assume_role_wo_creds",
@@ -119,6 +153,20 @@ func TestLoadSharedConfig(t *testing.T) {
 			},
 			Err: SharedConfigAssumeRoleError{RoleARN: "assume_role_wo_creds_role_arn"},
 		},
+		{
+			Filenames: []string{testConfigFilename},
+			Profile:   "endpoint_urls",
+			Expected: sharedConfig{
+				Region:      "us-west-2",
+				EndpointURL: "http://localhost:4566",
+				ServiceEndpointURLs: map[string]string{
+					"s3":                "http://localhost:4567",
+					"ses":               "http://localhost:4568",
+					"elastic_beanstalk": "http://localhost:4569",
+				},
+				S3: s3Config{ForcePathStyle: aws.Bool(true)},
+			},
+		},
 		{
 			Filenames: []string{filepath.Join("testdata", "shared_config_invalid_ini")},
 			Profile:   "profile_name",
@@ -222,6 +270,76 @@ func TestLoadSharedConfigFromFile(t *testing.T) {
 				},
 			},
 		},
+		{
+			Profile:  "retry_mode",
+			Expected: sharedConfig{RetryMode: "adaptive", MaxAttempts: "5"},
+		},
+		{
+			Profile:  "sts_regional_endpoints",
+			Expected: sharedConfig{Region: "us-west-2", STSRegionalEndpoint: "regional"},
+		},
+		{
+			Profile:  "s3_us_east_1_regional_endpoint",
+			Expected: sharedConfig{Region: "us-east-1", S3UsEast1RegionalEndpoint: "regional"},
+		},
+		{
+			Profile:  "endpoint_discovery",
+			Expected: sharedConfig{EnableEndpointDiscovery: "true"},
+		},
+		{
+			Profile: "fips_endpoint",
+			Expected: sharedConfig{
+				Region:          "us-gov-west-1",
+				UseFIPSEndpoint: aws.Bool(true),
+			},
+		},
+		{
+			Profile: "endpoint_urls",
+			Expected: sharedConfig{
+				Region:      "us-west-2",
+				EndpointURL: "http://localhost:4566",
+			},
+		},
+		{
+			Profile: "endpoint_urls_ignored",
+			Expected: sharedConfig{
+				Region:                       "us-west-2",
+				EndpointURL:                  "http://localhost:4566",
+				IgnoreConfiguredEndpointURLs: aws.Bool(true),
+			},
+		},
+		{
+			Profile: "dualstack_endpoint",
+			Expected: sharedConfig{
+				Region:               "us-west-2",
+				UseDualStackEndpoint: aws.Bool(true),
+			},
+		},
+		{
+			Profile:  "app_id",
+			Expected: sharedConfig{AppID: "my-app"},
+		},
+		{
+			Profile: "credential_process",
+			Expected: sharedConfig{
+				CredentialProcess: `printf '{"Version":1,"AccessKeyId":"process_akid","SecretAccessKey":"process_secret"}'`,
+			},
+		},
+		{
+			Profile: "sso_creds",
+			Expected: sharedConfig{
+				SSO: ssoConfig{
+					StartURL:  "https://example.awsapps.com/start",
+					Region:    "us-west-2",
+					AccountID: "012345678901",
+					RoleName:  "TestRole",
+				},
+			},
+		},
+		{
+			Profile:  "sso_incomplete",
+			Expected: sharedConfig{},
+		},
 		{
 			Profile: "does_not_exists",
 			Err:     SharedConfigProfileNotExistsError{Profile: "does_not_exists"},
@@ -242,6 +360,220 @@ func TestLoadSharedConfigFromFile(t *testing.T) {
 	}
 }
 
+func TestLoadSharedConfig_AssumeRoleChain(t *testing.T) {
+	sourceCreds := credentials.Value{
+		AccessKeyID:     "chain_source_akid",
+		SecretAccessKey: "chain_source_secret",
+		ProviderName:    fmt.Sprintf("SharedConfigCredentials: %s", testConfigChainFilename),
+	}
+	hop1 := assumeRoleConfig{
+		RoleARN:         "arn:aws:iam::111111111111:role/hop_1",
+		SourceProfile:   "chain_source",
+		ExternalID:      "hop_1_external_id",
+		RoleSessionName: "hop_1_session",
+		Duration:        30 * time.Minute,
+	}
+
+	cases := []struct {
+		Profile  string
+		Expected sharedConfig
+		Err      error
+	}{
+		{
+			Profile: "chain_hop_1",
+			Expected: sharedConfig{
+				AssumeRole:       hop1,
+				AssumeRoleSource: &sharedConfig{Creds: sourceCreds},
+			},
+		},
+		{
+			Profile: "chain_hop_2",
+			Expected: sharedConfig{
+				AssumeRole: assumeRoleConfig{
+					RoleARN:         "arn:aws:iam::222222222222:role/hop_2",
+					SourceProfile:   "chain_hop_1",
+					RoleSessionName: "hop_2_session",
+					Duration:        time.Hour,
+				},
+				AssumeRoleSource: &sharedConfig{
+					AssumeRole:       hop1,
+					AssumeRoleSource: &sharedConfig{Creds: sourceCreds},
+				},
+			},
+		},
+		{
+			Profile: "chain_self_source_hop",
+			Expected: sharedConfig{
+				AssumeRole: assumeRoleConfig{
+					RoleARN:       "arn:aws:iam::333333333333:role/self_source_hop",
+					SourceProfile: "chain_self_source",
+				},
+				AssumeRoleSource: &sharedConfig{
+					Creds: credentials.Value{
+						AccessKeyID:     "chain_self_source_akid",
+						SecretAccessKey: "chain_self_source_secret",
+						ProviderName:    fmt.Sprintf("SharedConfigCredentials: %s", testConfigChainFilename),
+					},
+					AssumeRole: assumeRoleConfig{
+						RoleARN:       "arn:aws:iam::333333333333:role/self_source",
+						SourceProfile: "chain_self_source",
+					},
+					AssumeRoleSource: &sharedConfig{
+						Creds: credentials.Value{
+							AccessKeyID:     "chain_self_source_akid",
+							SecretAccessKey: "chain_self_source_secret",
+							ProviderName:    fmt.Sprintf("SharedConfigCredentials: %s", testConfigChainFilename),
+						},
+					},
+				},
+			},
+		},
+		{
+			Profile: "chain_env_source_hop",
+			Expected: sharedConfig{
+				AssumeRole: assumeRoleConfig{
+					RoleARN:       "arn:aws:iam::444444444444:role/env_source_hop",
+					SourceProfile: "chain_env_source",
+				},
+				AssumeRoleSource: &sharedConfig{
+					AssumeRole: assumeRoleConfig{
+						RoleARN:          "arn:aws:iam::444444444444:role/env_source",
+						CredentialSource: credSourceEnvironment,
+					},
+				},
+			},
+		},
+		{
+			Profile: "chain_ec2_source",
+			Expected: sharedConfig{
+				AssumeRole: assumeRoleConfig{
+					RoleARN:          "arn:aws:iam::555555555555:role/ec2_source",
+					CredentialSource: credSourceEc2Metadata,
+				},
+			},
+		},
+		{
+			Profile: "chain_invalid_credential_source",
+			Err: SharedConfigCredentialSourceError{
+				Profile:          "chain_invalid_credential_source",
+				CredentialSource: "Unknown",
+			},
+		},
+		{
+			Profile: "chain_both_sources",
+			Err: SharedConfigCredentialSourceError{
+				Profile:          "chain_both_sources",
+				CredentialSource: credSourceEnvironment,
+				SourceProfile:    "chain_source",
+			},
+		},
+		{
+			Profile: "chain_cycle_a",
+			Err: SharedConfigAssumeRoleCycleError{
+				Profiles: []string{"chain_cycle_a", "chain_cycle_b", "chain_cycle_c", "chain_cycle_b"},
+			},
+		},
+		{
+			Profile: "chain_missing_source",
+			Err:     SharedConfigAssumeRoleError{RoleARN: "arn:aws:iam::888888888888:role/missing_source"},
+		},
+	}
+
+	for _, c := range cases {
+		cfg, err := loadSharedConfig(c.Profile, []string{testConfigChainFilename})
+		if c.Err != nil {
+			assert.Equal(t, c.Err, err, "expected error, %s", c.Profile)
+			continue
+		}
+
+		assert.NoError(t, err, "unexpected error, %s", c.Profile)
+		assert.Equal(t, c.Expected, cfg, "not equal, %s", c.Profile)
+	}
+}
+
+func TestLoadSharedConfig_S3(t *testing.T) {
+	cases := []struct {
+		Profile  string
+		Expected sharedConfig
+	}{
+		{
+			Profile: "default",
+			Expected: sharedConfig{
+				S3: s3Config{UseAccelerate: aws.Bool(true)},
+			},
+		},
+		{
+			Profile: "s3_path",
+			Expected: sharedConfig{
+				Region: "us-east-1",
+				S3: s3Config{
+					UseAccelerate:  aws.Bool(true),
+					UseDualStack:   aws.Bool(true),
+					ForcePathStyle: aws.Bool(true),
+					UseARNRegion:   aws.Bool(true),
+				},
+			},
+		},
+		{
+			Profile: "s3_virtual",
+			Expected: sharedConfig{
+				Region: "us-west-2",
+				S3: s3Config{
+					ForcePathStyle: aws.Bool(false),
+					Ignored:        []string{"unknown_key=value", "use_dualstack_endpoint=notabool"},
+				},
+			},
+		},
+		{
+			Profile:  "s3_empty",
+			Expected: sharedConfig{},
+		},
+		{
+			Profile:  "s3_top_level",
+			Expected: sharedConfig{},
+		},
+	}
+
+	for _, c := range cases {
+		cfg, err := loadSharedConfig(c.Profile, []string{testConfigS3Filename})
+		assert.NoError(t, err, "unexpected error, %s", c.Profile)
+		assert.Equal(t, c.Expected, cfg, "not equal, %s", c.Profile)
+	}
+}
+
+func TestSharedConfigErrorMessages(t *testing.T) {
+	cases := []struct {
+		Err    error
+		Expect string
+	}{
+		{
+			Err: SharedConfigAssumeRoleCycleError{
+				Profiles: []string{"chain_cycle_a", "chain_cycle_b", "chain_cycle_a"},
+			},
+			Expect: "SharedConfigAssumeRoleCycleError: failed to load assume role chain, source_profile cycle, chain_cycle_a -> chain_cycle_b -> chain_cycle_a",
+		},
+		{
+			Err: SharedConfigCredentialSourceError{
+				Profile:          "profile_name",
+				CredentialSource: "Unknown",
+			},
+			Expect: `SharedConfigCredentialSourceError: failed to load assume role of profile profile_name, credential_source "Unknown" is not one of Ec2InstanceMetadata, Environment, or EcsContainer`,
+		},
+		{
+			Err: SharedConfigCredentialSourceError{
+				Profile:          "profile_name",
+				CredentialSource: "Environment",
+				SourceProfile:    "source_profile_name",
+			},
+			Expect: "SharedConfigCredentialSourceError: failed to load assume role of profile profile_name, only one of source_profile and credential_source may be set",
+		},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.Expect, c.Err.Error())
+	}
+}
+
 func TestLoadSharedConfigIniFiles(t *testing.T) {
 	cases := []struct {
 		Filenames []string
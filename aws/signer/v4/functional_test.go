@@ -1,15 +1,24 @@
 package v4_test
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"net/http"
 	"net/url"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/awstesting"
 	"github.com/aws/aws-sdk-go/awstesting/unit"
 	"github.com/aws/aws-sdk-go/service/s3"
 )
@@ -164,3 +173,504 @@ func TestStandaloneSign_CustomURIEscape(t *testing.T) {
 		t.Errorf("expect %v, got %v", e, a)
 	}
 }
+
+// verifyPresignedURL verifies the V4 signature of the presigned URL of a
+// request made with the headers. The payload is expected to be unsigned.
+func verifyPresignedURL(creds *credentials.Credentials, method, urlstr string, header http.Header, service, region string) error {
+	u, err := url.Parse(urlstr)
+	if err != nil {
+		return err
+	}
+	query := u.Query()
+	signature := query.Get("X-Amz-Signature")
+	query.Del("X-Amz-Signature")
+	for k := range query {
+		sort.Strings(query[k])
+	}
+
+	signedHeaders := query.Get("X-Amz-SignedHeaders")
+	var canonicalHeaders []string
+	for _, k := range strings.Split(signedHeaders, ";") {
+		v := strings.Join(header[k], ",")
+		if k == "host" {
+			v = u.Host
+		} else if len(v) == 0 {
+			return fmt.Errorf("signed header %s not included", k)
+		}
+		canonicalHeaders = append(canonicalHeaders, k+":"+strings.TrimSpace(v)+"\n")
+	}
+
+	payloadHash := query.Get("X-Amz-Content-Sha256")
+	if len(payloadHash) == 0 {
+		payloadHash = "UNSIGNED-PAYLOAD"
+	}
+	canonicalRequest := strings.Join([]string{
+		method,
+		u.EscapedPath(),
+		strings.Replace(query.Encode(), "+", "%20", -1),
+		strings.Join(canonicalHeaders, ""),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	date := query.Get("X-Amz-Date")
+	if len(date) < 8 {
+		return fmt.Errorf("invalid X-Amz-Date %q", date)
+	}
+	scope := strings.Join([]string{date[:8], region, service, "aws4_request"}, "/")
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256", date, scope, hex.EncodeToString(hash[:]),
+	}, "\n")
+
+	v, err := creds.Get()
+	if err != nil {
+		return err
+	}
+	key := []byte("AWS4" + v.SecretAccessKey)
+	for _, part := range []string{date[:8], region, service, "aws4_request", stringToSign} {
+		h := hmac.New(sha256.New, key)
+		h.Write([]byte(part))
+		key = h.Sum(nil)
+	}
+
+	if e, a := hex.EncodeToString(key), signature; e != a {
+		return fmt.Errorf("expect %s signature, got %s", e, a)
+	}
+	return nil
+}
+
+func TestPresignHeaderHoisting(t *testing.T) {
+	cases := map[string]struct {
+		Options             []request.Option
+		ExpectSignedHeaders string
+		ExpectQuery         []string
+	}{
+		"default": {
+			ExpectSignedHeaders: "host;x-amz-acl",
+			ExpectQuery:         []string{"X-Amz-Tagging"},
+		},
+		"signed headers": {
+			Options: []request.Option{
+				request.WithPresignSignedHeaders("x-amz-tagging"),
+			},
+			ExpectSignedHeaders: "host;x-amz-acl;x-amz-tagging",
+		},
+		"unsigned headers": {
+			Options: []request.Option{
+				request.WithPresignUnsignedHeaders("X-Amz-Acl"),
+			},
+			ExpectSignedHeaders: "host",
+			ExpectQuery:         []string{"X-Amz-Acl", "X-Amz-Tagging"},
+		},
+		"unsigned headers not hoisted": {
+			Options: []request.Option{
+				func(r *request.Request) { r.NotHoist = true },
+				request.WithPresignUnsignedHeaders("X-Amz-Acl"),
+			},
+			ExpectSignedHeaders: "host;x-amz-content-sha256;x-amz-tagging",
+			ExpectQuery:         []string{"X-Amz-Acl"},
+		},
+	}
+
+	for name, c := range cases {
+		svc := s3.New(unit.Session)
+		req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+			Bucket:  aws.String("bucket"),
+			Key:     aws.String("key"),
+			ACL:     aws.String("public-read"),
+			Tagging: aws.String("k=v"),
+		})
+		req.ApplyOptions(c.Options...)
+		notHoist := req.NotHoist
+
+		urlstr, headers, err := req.PresignRequest(5 * time.Minute)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if notHoist != req.NotHoist {
+			t.Fatalf("%s, expect NotHoist not to be modified", name)
+		}
+
+		u, _ := url.Parse(urlstr)
+		query := u.Query()
+		if e, a := c.ExpectSignedHeaders, query.Get("X-Amz-SignedHeaders"); e != a {
+			t.Errorf("%s, expect %v signed headers, got %v", name, e, a)
+		}
+		for _, k := range c.ExpectQuery {
+			if len(query.Get(k)) == 0 {
+				t.Errorf("%s, expect %s to be hoisted to query", name, k)
+			}
+		}
+
+		if err := verifyPresignedURL(unit.Session.Config.Credentials, "PUT", urlstr, headers, "s3", "mock-region"); err != nil {
+			t.Errorf("%s, expect presigned URL to verify, %v", name, err)
+		}
+
+		if v := headers["x-amz-acl"]; len(v) != 0 {
+			headers["x-amz-acl"] = []string{"private"}
+			if err := verifyPresignedURL(unit.Session.Config.Credentials, "PUT", urlstr, headers, "s3", "mock-region"); err == nil {
+				t.Errorf("%s, expect presigned URL not to verify with modified header", name)
+			}
+		}
+	}
+}
+
+func TestPresignWithContext(t *testing.T) {
+	svc := s3.New(unit.Session)
+	req, _ := svc.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	ctx := &awstesting.FakeContext{DoneCh: make(chan struct{})}
+
+	urlstr, err := req.PresignWithContext(ctx, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := verifyPresignedURL(unit.Session.Config.Credentials, "GET", urlstr, nil, "s3", "mock-region"); err != nil {
+		t.Errorf("expect presigned URL to verify, %v", err)
+	}
+
+	req, _ = svc.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	ctx = &awstesting.FakeContext{DoneCh: make(chan struct{}), Error: fmt.Errorf("canceled")}
+	close(ctx.DoneCh)
+
+	_, err = req.PresignWithContext(ctx, 5*time.Minute)
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != request.CanceledErrorCode {
+		t.Errorf("expect %s error, got %v", request.CanceledErrorCode, err)
+	}
+}
+
+func TestPresignExpireTime(t *testing.T) {
+	cases := map[string]struct {
+		ExpireTime time.Duration
+		ExpectErr  bool
+	}{
+		"maximum":  {ExpireTime: v4.PresignMaxExpireTime},
+		"exceeded": {ExpireTime: v4.PresignMaxExpireTime + time.Second, ExpectErr: true},
+		"negative": {ExpireTime: -time.Minute, ExpectErr: true},
+	}
+
+	for name, c := range cases {
+		svc := s3.New(unit.Session)
+		req, _ := svc.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+		})
+
+		urlstr, err := req.Presign(c.ExpireTime)
+		if !c.ExpectErr {
+			if err != nil {
+				t.Errorf("%s, expect no error, got %v", name, err)
+			}
+			continue
+		}
+
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			t.Fatalf("%s, expect awserr.Error, got %T, %v", name, err, err)
+		}
+		if e, a := v4.ErrCodeInvalidPresignExpireTime, aerr.Code(); e != a {
+			t.Errorf("%s, expect %s error code, got %s", name, e, a)
+		}
+		if len(urlstr) != 0 {
+			t.Errorf("%s, expect no URL, got %s", name, urlstr)
+		}
+	}
+}
+
+// verifySignedRequest verifies the V4 signature of the Authorization header
+// of the request, with the payload hash of the X-Amz-Content-Sha256 header.
+func verifySignedRequest(creds *credentials.Credentials, r *http.Request, service, region string) error {
+	var signedHeaders, signature string
+	for _, part := range strings.Split(r.Header.Get("Authorization"), ", ") {
+		if strings.HasPrefix(part, "SignedHeaders=") {
+			signedHeaders = strings.TrimPrefix(part, "SignedHeaders=")
+		} else if strings.HasPrefix(part, "Signature=") {
+			signature = strings.TrimPrefix(part, "Signature=")
+		}
+	}
+
+	var canonicalHeaders []string
+	for _, k := range strings.Split(signedHeaders, ";") {
+		v := strings.Join(r.Header[http.CanonicalHeaderKey(k)], ",")
+		if k == "host" {
+			v = r.URL.Host
+		} else if len(v) == 0 {
+			return fmt.Errorf("signed header %s not included", k)
+		}
+		canonicalHeaders = append(canonicalHeaders, k+":"+strings.TrimSpace(v)+"\n")
+	}
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		r.URL.EscapedPath(),
+		r.URL.RawQuery,
+		strings.Join(canonicalHeaders, ""),
+		signedHeaders,
+		r.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+
+	date := r.Header.Get("X-Amz-Date")
+	if len(date) < 8 {
+		return fmt.Errorf("invalid X-Amz-Date %q", date)
+	}
+	scope := strings.Join([]string{date[:8], region, service, "aws4_request"}, "/")
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256", date, scope, hex.EncodeToString(hash[:]),
+	}, "\n")
+
+	v, err := creds.Get()
+	if err != nil {
+		return err
+	}
+	key := []byte("AWS4" + v.SecretAccessKey)
+	for _, part := range []string{date[:8], region, service, "aws4_request", stringToSign} {
+		h := hmac.New(sha256.New, key)
+		h.Write([]byte(part))
+		key = h.Sum(nil)
+	}
+
+	if e, a := hex.EncodeToString(key), signature; e != a {
+		return fmt.Errorf("expect %s signature, got %s", e, a)
+	}
+	return nil
+}
+
+type unreadableReader struct{}
+
+func (unreadableReader) Read([]byte) (int, error) {
+	return 0, fmt.Errorf("expect payload not to be read")
+}
+
+func TestSignRequest_UnsignedPayload(t *testing.T) {
+	svc := s3.New(unit.Session)
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket:        aws.String("bucket"),
+		Key:           aws.String("key"),
+		Body:          aws.ReadSeekCloser(unreadableReader{}),
+		ContentLength: aws.Int64(3),
+	})
+	req.ApplyOptions(request.WithUnsignedPayload())
+	req.Time = time.Unix(0, 0)
+
+	if err := req.Sign(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "UNSIGNED-PAYLOAD", req.HTTPRequest.Header.Get("X-Amz-Content-Sha256"); e != a {
+		t.Errorf("expect %v content SHA256, got %v", e, a)
+	}
+	expect := "AWS4-HMAC-SHA256 Credential=AKID/19700101/mock-region/s3/aws4_request, " +
+		"SignedHeaders=content-length;host;x-amz-content-sha256;x-amz-date;x-amz-security-token, " +
+		"Signature=e6bc37521ec57fe3f789a4de9bd6161f300eee558fd6decb01d87d42adbb1399"
+	if e, a := expect, req.HTTPRequest.Header.Get("Authorization"); e != a {
+		t.Errorf("expect %v authorization, got %v", e, a)
+	}
+	if err := verifySignedRequest(unit.Session.Config.Credentials, req.HTTPRequest, "s3", "mock-region"); err != nil {
+		t.Errorf("expect signed request to verify, %v", err)
+	}
+}
+
+func TestSignRequest_UnsignedPayloadInsecure(t *testing.T) {
+	svc := s3.New(unit.Session, &aws.Config{DisableSSL: aws.Bool(true)})
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	req.ApplyOptions(request.WithUnsignedPayload())
+
+	err := req.Sign()
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T, %v", err, err)
+	}
+	if e, a := v4.ErrCodeInsecureUnsignedPayload, aerr.Code(); e != a {
+		t.Errorf("expect %s error code, got %s", e, a)
+	}
+	if v := req.HTTPRequest.Header.Get("Authorization"); len(v) != 0 {
+		t.Errorf("expect request not signed, got %v", v)
+	}
+}
+
+func TestPresignRequest_UnsignedPayload(t *testing.T) {
+	svc := s3.New(unit.Session)
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   aws.ReadSeekCloser(unreadableReader{}),
+	})
+	req.ApplyOptions(request.WithUnsignedPayload())
+
+	urlstr, headers, err := req.PresignRequest(5 * time.Minute)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	u, _ := url.Parse(urlstr)
+	if e, a := "UNSIGNED-PAYLOAD", u.Query().Get("X-Amz-Content-Sha256"); e != a {
+		t.Errorf("expect %v content SHA256, got %v", e, a)
+	}
+	if err := verifyPresignedURL(unit.Session.Config.Credentials, "PUT", urlstr, headers, "s3", "mock-region"); err != nil {
+		t.Errorf("expect presigned URL to verify, %v", err)
+	}
+}
+
+func TestSignRequest_S3KeyEscaping(t *testing.T) {
+	svc := s3.New(unit.Session)
+	req, _ := svc.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("a//b+c%2Fd"),
+	})
+	req.Time = time.Unix(0, 0)
+
+	if err := req.Sign(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "/a//b%2Bc%252Fd", req.HTTPRequest.URL.EscapedPath(); e != a {
+		t.Errorf("expect %v path, got %v", e, a)
+	}
+	expect := "AWS4-HMAC-SHA256 Credential=AKID/19700101/mock-region/s3/aws4_request, " +
+		"SignedHeaders=host;x-amz-content-sha256;x-amz-date;x-amz-security-token, " +
+		"Signature=644182151616e4bfb2a00ac4467a1a6c0f4eab1618d8a0ff59803d04f53a95d8"
+	if e, a := expect, req.HTTPRequest.Header.Get("Authorization"); e != a {
+		t.Errorf("expect %v authorization, got %v", e, a)
+	}
+	if err := verifySignedRequest(unit.Session.Config.Credentials, req.HTTPRequest, "s3", "mock-region"); err != nil {
+		t.Errorf("expect signed request to verify, %v", err)
+	}
+}
+
+func TestPresign_WithSignedHeaders(t *testing.T) {
+	creds := unit.Session.Config.Credentials
+	signer := v4.NewSigner(creds, v4.WithSignedHeaders("Content-Type", "x-amz-meta-owner", "X-Amz-Tagging"))
+
+	req, _ := http.NewRequest("PUT", "https://bucket.s3.us-west-2.amazonaws.com/key", nil)
+	req.Header.Set("Content-Type", "image/png")
+	req.Header.Set("X-Amz-Meta-Owner", "owner")
+	req.Header.Set("X-Amz-Tagging", "k=v")
+	req.Header.Set("X-Amz-Server-Side-Encryption", "AES256")
+	req.Header.Set("X-Amz-Expected-Bucket-Owner", "012345678901")
+
+	header, err := signer.Presign(req, nil, "s3", "us-west-2", 5*time.Minute, time.Now())
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	query := req.URL.Query()
+	if e, a := "content-type;host;x-amz-meta-owner;x-amz-server-side-encryption;x-amz-tagging", query.Get("X-Amz-SignedHeaders"); e != a {
+		t.Errorf("expect %v signed headers, got %v", e, a)
+	}
+	if e, a := "012345678901", query.Get("X-Amz-Expected-Bucket-Owner"); e != a {
+		t.Errorf("expect %v hoisted header, got %v", e, a)
+	}
+
+	urlstr := req.URL.String()
+	if err := verifyPresignedURL(creds, "PUT", urlstr, header, "s3", "us-west-2"); err != nil {
+		t.Errorf("expect presigned URL to verify, %v", err)
+	}
+
+	for _, k := range []string{"content-type", "x-amz-meta-owner", "x-amz-tagging"} {
+		modified := http.Header{}
+		for hk, hv := range header {
+			modified[hk] = hv
+		}
+		modified[k] = []string{"modified"}
+		if err := verifyPresignedURL(creds, "PUT", urlstr, modified, "s3", "us-west-2"); err == nil {
+			t.Errorf("expect presigned URL not to verify with modified %s header", k)
+		}
+	}
+}
+
+func TestPresign_WithSignedHeadersMissing(t *testing.T) {
+	signer := v4.NewSigner(unit.Session.Config.Credentials, v4.WithSignedHeaders("Content-Type", "X-Amz-Meta-Owner"))
+
+	req, _ := http.NewRequest("PUT", "https://bucket.s3.us-west-2.amazonaws.com/key", nil)
+	req.Header.Set("Content-Type", "image/png")
+
+	_, err := signer.Presign(req, nil, "s3", "us-west-2", 5*time.Minute, time.Now())
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T, %v", err, err)
+	}
+	if e, a := v4.ErrCodeMissingSignedHeader, aerr.Code(); e != a {
+		t.Errorf("expect %s error code, got %s", e, a)
+	}
+	if v := req.URL.Query().Get("X-Amz-Signature"); len(v) != 0 {
+		t.Errorf("expect request not presigned, got %v signature", v)
+	}
+}
+
+func TestSignRequest_IgnoredHeaders(t *testing.T) {
+	cases := map[string]struct {
+		IgnoredHeaders []string
+		ExpectVerified bool
+	}{
+		"ignored": {
+			IgnoredHeaders: []string{"x-forwarded-for", "Accept-Encoding", "X-Corp-Trace"},
+			ExpectVerified: true,
+		},
+		"not ignored": {
+			ExpectVerified: false,
+		},
+	}
+
+	for name, c := range cases {
+		svc := s3.New(unit.Session, aws.NewConfig().WithSigningIgnoredHeaders(c.IgnoredHeaders...))
+		req, _ := svc.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+		})
+		req.HTTPRequest.Header.Set("X-Forwarded-For", "10.0.0.1")
+		req.HTTPRequest.Header.Set("Accept-Encoding", "gzip")
+		req.HTTPRequest.Header.Set("X-Corp-Trace", "abc")
+
+		if err := req.Sign(); err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		// The headers are mutated in transit, after the request is signed.
+		req.HTTPRequest.Header.Set("X-Forwarded-For", "10.0.0.1, 192.168.0.1")
+		req.HTTPRequest.Header.Set("Accept-Encoding", "identity")
+		req.HTTPRequest.Header.Set("X-Corp-Trace", "def")
+
+		err := verifySignedRequest(unit.Session.Config.Credentials, req.HTTPRequest, "s3", "mock-region")
+		if e, a := c.ExpectVerified, err == nil; e != a {
+			t.Errorf("%s, expect verified %v, got %v", name, e, err)
+		}
+	}
+}
+
+func TestSignRequest_IgnoredCriticalHeaders(t *testing.T) {
+	for _, name := range []string{"host", "X-Amz-Date", "x-amz-content-sha256", "X-Amz-Security-Token"} {
+		svc := s3.New(unit.Session, aws.NewConfig().WithSigningIgnoredHeaders(name))
+		req, _ := svc.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+		})
+
+		err := req.Sign()
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			t.Fatalf("%s, expect awserr.Error, got %T, %v", name, err, err)
+		}
+		if e, a := v4.ErrCodeInvalidIgnoredHeader, aerr.Code(); e != a {
+			t.Errorf("%s, expect %v error code, got %v", name, e, a)
+		}
+
+		signer := v4.NewSigner(unit.Session.Config.Credentials)
+		err = signer.AddIgnoredHeaders("X-Forwarded-For", name)
+		if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != v4.ErrCodeInvalidIgnoredHeader {
+			t.Errorf("%s, expect %v error, got %v", name, v4.ErrCodeInvalidIgnoredHeader, err)
+		}
+		if len(signer.IgnoredHeaders) != 0 {
+			t.Errorf("%s, expect no ignored headers added, got %v", name, signer.IgnoredHeaders)
+		}
+	}
+}
@@ -80,3 +80,33 @@ func (r inclusiveRules) IsValid(value string) bool {
 	}
 	return true
 }
+
+// newHeaderMapRule returns a map rule of the canonical header names.
+func newHeaderMapRule(names []string) mapRule {
+	m := mapRule{}
+	for _, name := range names {
+		m[http.CanonicalHeaderKey(name)] = struct{}{}
+	}
+	return m
+}
+
+// hoistingOverrides overrides the hoisting rule of the signed, and unsigned
+// headers. Signed headers are never hoisted, and unsigned headers are always
+// hoisted.
+type hoistingOverrides struct {
+	rule     rule
+	signed   mapRule
+	unsigned mapRule
+}
+
+// IsValid returns if the header is hoisted.
+func (h hoistingOverrides) IsValid(value string) bool {
+	key := http.CanonicalHeaderKey(value)
+	if h.signed.IsValid(key) {
+		return false
+	}
+	if h.unsigned.IsValid(key) {
+		return true
+	}
+	return h.rule != nil && h.rule.IsValid(key)
+}
@@ -5,3 +5,38 @@ package v4
 func WithUnsignedPayload(v4 *Signer) {
 	v4.UnsignedPayload = true
 }
+
+// WithStreamingPayload will enable and set the StreamingPayload field to
+// true of the signer.
+func WithStreamingPayload(v4 *Signer) {
+	v4.StreamingPayload = true
+}
+
+// WithSignedHeaders returns a signer option which signs the named headers of
+// presigned requests as headers, rather than hoisting them to the request's
+// query string, see Signer.PresignSignedHeaders. The request made with the
+// presigned URL must include the headers with the values they were presigned
+// with.
+//
+//     signer := v4.NewSigner(creds, v4.WithSignedHeaders("Content-Type", "X-Amz-Meta-Owner"))
+func WithSignedHeaders(names ...string) func(*Signer) {
+	return func(v4 *Signer) {
+		v4.PresignSignedHeaders = append(v4.PresignSignedHeaders, names...)
+	}
+}
+
+// WithDebugSigning returns a signer option which calls the function with
+// the canonical request, string to sign, and signed headers of each signed
+// request, see Signer.DebugSigning.
+//
+//     handler := v4.BuildNamedHandler(v4.SignRequestHandler.Name,
+//         v4.WithDebugSigning(func(canonicalRequest, stringToSign, signedHeaders string) {
+//             log.Printf("canonical request:\n%s", canonicalRequest)
+//         }),
+//     )
+//     svc.Handlers.Sign.SwapNamed(handler)
+func WithDebugSigning(fn func(canonicalRequest, stringToSign, signedHeaders string)) func(*Signer) {
+	return func(v4 *Signer) {
+		v4.DebugSigning = fn
+	}
+}
@@ -0,0 +1,216 @@
+package v4
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+const (
+	// streamingPayloadHash is the content SHA256 of requests which payloads
+	// are signed in chunks.
+	streamingPayloadHash = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+
+	// streamingChunkAlgorithm is the algorithm of the string to sign of each
+	// chunk of a streamed payload.
+	streamingChunkAlgorithm = "AWS4-HMAC-SHA256-PAYLOAD"
+
+	// streamingContentEncoding is the content encoding of streamed payloads.
+	streamingContentEncoding = "aws-chunked"
+
+	// decodedContentLengthHeader is the header of the length of the payload
+	// of requests which payloads are signed in chunks.
+	decodedContentLengthHeader = "X-Amz-Decoded-Content-Length"
+
+	// chunkSignatureLength is the length of the hex encoded signature of a
+	// chunk.
+	chunkSignatureLength = 64
+)
+
+// DefaultStreamingChunkSize is the size of the chunks of payloads signed with
+// Signer.StreamingPayload, if Signer.StreamingChunkSize is not set.
+const DefaultStreamingChunkSize = 64 * 1024
+
+// ErrCodeInvalidStreamingPayload is the error code of the error returned when
+// the length of the payload of a request signed with Signer.StreamingPayload
+// cannot be determined.
+const ErrCodeInvalidStreamingPayload = "InvalidStreamingPayload"
+
+// buildStreamingHeaders sets the headers, and content length, of the request
+// which payload is signed in chunks. The length of the payload is the
+// request's X-Amz-Decoded-Content-Length header, if set, or the length of the
+// request's body.
+func (ctx *signingCtx) buildStreamingHeaders() error {
+	var length int64
+	if v := ctx.Request.Header.Get(decodedContentLengthHeader); len(v) != 0 {
+		var err error
+		if length, err = strconv.ParseInt(v, 10, 64); err != nil || length < 0 {
+			return awserr.New(ErrCodeInvalidStreamingPayload,
+				fmt.Sprintf("invalid %s header, %q", decodedContentLengthHeader, v), err)
+		}
+	} else if ctx.Body != nil {
+		start, err := ctx.Body.Seek(0, 1)
+		if err == nil {
+			var end int64
+			if end, err = ctx.Body.Seek(0, 2); err == nil {
+				_, err = ctx.Body.Seek(start, 0)
+				length = end - start
+			}
+		}
+		if err != nil {
+			return awserr.New(ErrCodeInvalidStreamingPayload,
+				"failed to compute the length of the streamed payload", err)
+		}
+	}
+
+	contentLength := streamingContentLength(length, ctx.streamingChunkSize)
+
+	header := ctx.Request.Header
+	header.Set("X-Amz-Content-Sha256", streamingPayloadHash)
+	header.Set(decodedContentLengthHeader, strconv.FormatInt(length, 10))
+	header.Set("Content-Length", strconv.FormatInt(contentLength, 10))
+	ctx.Request.ContentLength = contentLength
+
+	// The payload may also be encoded with other encodings, such as gzip,
+	// which are applied before the payload is chunk encoded.
+	if enc := header.Get("Content-Encoding"); len(enc) == 0 {
+		header.Set("Content-Encoding", streamingContentEncoding)
+	} else if !strings.HasPrefix(enc, streamingContentEncoding) {
+		header.Set("Content-Encoding", streamingContentEncoding+","+enc)
+	}
+
+	return nil
+}
+
+// isChunkEncoded returns if the request's payload is chunk encoded with a
+// streaming content SHA256 other than the signer's, such as the payloads with
+// trailing checksums.
+func isChunkEncoded(header http.Header) bool {
+	v := header.Get("X-Amz-Content-Sha256")
+	return strings.HasPrefix(v, "STREAMING-") && v != streamingPayloadHash
+}
+
+// streamingContentLength returns the length of the chunk encoded payload of
+// the length, including the final empty chunk.
+func streamingContentLength(length int64, chunkSize int) int64 {
+	size := int64(chunkSize)
+
+	contentLength := (length / size) * encodedChunkLength(size)
+	if n := length % size; n > 0 {
+		contentLength += encodedChunkLength(n)
+	}
+
+	return contentLength + encodedChunkLength(0)
+}
+
+// encodedChunkLength returns the length of the encoded chunk of n bytes.
+//
+//     <hex(n)>;chunk-signature=<signature>\r\n<data>\r\n
+func encodedChunkLength(n int64) int64 {
+	return int64(len(strconv.FormatInt(n, 16))) +
+		int64(len(";chunk-signature=")) + chunkSignatureLength + 2 +
+		n + 2
+}
+
+// chunkSigningReader reads the payload of a request chunk encoded, signing
+// each chunk with the signature of the previous chunk. The signature of the
+// first chunk is seeded by the request's signature. The payload is ended by
+// a final empty chunk.
+//
+// A new reader must be created each time the request is signed, as the
+// signatures of the chunks depend on the request's signature.
+type chunkSigningReader struct {
+	body          io.Reader
+	key           []byte
+	formattedTime string
+	scope         string
+	prevSignature string
+
+	chunk []byte
+	buf   bytes.Buffer
+	done  bool
+}
+
+// newChunkSigningReader returns a reader which reads the body chunk encoded,
+// signing the chunks with the request's signing context.
+func newChunkSigningReader(body io.Reader, ctx *signingCtx) *chunkSigningReader {
+	if body == nil {
+		body = bytes.NewReader(nil)
+	}
+
+	return &chunkSigningReader{
+		body:          body,
+		key:           ctx.signingKey,
+		formattedTime: ctx.formattedTime,
+		scope:         ctx.credentialString,
+		prevSignature: ctx.signature,
+		chunk:         make([]byte, ctx.streamingChunkSize),
+	}
+}
+
+// Read reads the next bytes of the chunk encoded payload.
+func (r *chunkSigningReader) Read(p []byte) (int, error) {
+	for r.buf.Len() == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	return r.buf.Read(p)
+}
+
+// Close closes the underlying body, if the body is an io.Closer.
+func (r *chunkSigningReader) Close() error {
+	if c, ok := r.body.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// readChunk reads the next chunk of the body, and buffers the encoded chunk.
+// The final empty chunk is buffered after the last chunk of the body.
+func (r *chunkSigningReader) readChunk() error {
+	n, err := io.ReadFull(r.body, r.chunk)
+	switch err {
+	case nil:
+	case io.EOF, io.ErrUnexpectedEOF:
+		r.done = true
+	default:
+		return err
+	}
+
+	if n > 0 {
+		r.writeChunk(r.chunk[:n])
+	}
+	if r.done {
+		r.writeChunk(nil)
+	}
+
+	return nil
+}
+
+// writeChunk signs the chunk and buffers the encoded chunk.
+func (r *chunkSigningReader) writeChunk(data []byte) {
+	stringToSign := strings.Join([]string{
+		streamingChunkAlgorithm,
+		r.formattedTime,
+		r.scope,
+		r.prevSignature,
+		emptyStringSHA256,
+		hex.EncodeToString(makeSha256(data)),
+	}, "\n")
+	r.prevSignature = hex.EncodeToString(makeHmac(r.key, []byte(stringToSign)))
+
+	fmt.Fprintf(&r.buf, "%x;chunk-signature=%s\r\n", len(data), r.prevSignature)
+	r.buf.Write(data)
+	r.buf.WriteString("\r\n")
+}
@@ -22,3 +22,30 @@ func getURIPath(u *url.URL) string {
 
 	return uri
 }
+
+// normalizeURIPath removes the empty, "." and ".." segments of the URI path,
+// as the canonical requests of the signatures of services other than S3
+// require. The path's trailing slash is preserved.
+func normalizeURIPath(p string) string {
+	segments := strings.Split(p, "/")
+	normalized := make([]string, 0, len(segments))
+	for _, seg := range segments {
+		switch seg {
+		case "", ".":
+		case "..":
+			if len(normalized) > 0 {
+				normalized = normalized[:len(normalized)-1]
+			}
+		default:
+			normalized = append(normalized, seg)
+		}
+	}
+
+	path := "/" + strings.Join(normalized, "/")
+	if last := segments[len(segments)-1]; len(normalized) > 0 &&
+		(last == "" || last == "." || last == "..") {
+		path += "/"
+	}
+
+	return path
+}
@@ -69,6 +69,8 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/private/protocol/rest"
@@ -83,6 +85,29 @@ const (
 	emptyStringSHA256 = `e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855`
 )
 
+// PresignMaxExpireTime is the maximum duration a V4 presigned request is valid
+// for after it is signed.
+const PresignMaxExpireTime = 7 * 24 * time.Hour
+
+// ErrCodeInvalidPresignExpireTime is the error code of the error returned when
+// a request is presigned with an expire time which is negative, or exceeds
+// PresignMaxExpireTime.
+const ErrCodeInvalidPresignExpireTime = "InvalidPresignExpireTime"
+
+// ErrCodeMissingSignedHeader is the error code of the error returned when
+// a header of Signer.PresignSignedHeaders has no value when the request is
+// presigned.
+const ErrCodeMissingSignedHeader = "MissingSignedHeader"
+
+// ErrCodeInsecureUnsignedPayload is the error code of the error returned when
+// a request with the request.WithUnsignedPayload option is not made over
+// HTTPS.
+const ErrCodeInsecureUnsignedPayload = "InsecureUnsignedPayload"
+
+// ErrCodeInvalidIgnoredHeader is the error code of the error returned when
+// a header of Signer.IgnoredHeaders is required to be signed.
+const ErrCodeInvalidIgnoredHeader = "InvalidIgnoredHeader"
+
 var ignoredHeaders = rules{
 	blacklist{
 		mapRule{
@@ -93,6 +118,15 @@ var ignoredHeaders = rules{
 	},
 }
 
+// criticalHeaders are the headers which are always signed, and cannot be
+// ignored with Signer.IgnoredHeaders.
+var criticalHeaders = mapRule{
+	"Host":                 struct{}{},
+	"X-Amz-Date":           struct{}{},
+	"X-Amz-Content-Sha256": struct{}{},
+	"X-Amz-Security-Token": struct{}{},
+}
+
 // requiredSignedHeaders is a whitelist for build canonical headers.
 var requiredSignedHeaders = rules{
 	whitelist{
@@ -172,9 +206,14 @@ type Signer struct {
 
 	// Disables the automatic escaping of the URI path of the request for the
 	// siganture's canonical string's path. For services that do not need additional
-	// escaping then use this to disable the signer escaping the path.
+	// escaping then use this to disable the signer escaping the path. Escaped
+	// paths are also normalized, removing their empty, "." and ".." segments,
+	// paths which are not escaped are signed as is.
 	//
 	// S3 is an example of a service that does not need additional escaping.
+	// The signer of SDK requests sets the option from the SigningEscaping of
+	// the request's client metadata, options of the signer's handler
+	// override it.
 	//
 	// http://docs.aws.amazon.com/general/latest/gr/sigv4-create-canonical-request.html
 	DisableURIPathEscaping bool
@@ -197,6 +236,86 @@ type Signer struct {
 	// UnsignedPayload will prevent signing of the payload. This will only
 	// work for services that have support for this.
 	UnsignedPayload bool
+
+	// Names of the headers of presigned requests which are signed as
+	// headers, rather than hoisted to the request's query string. The
+	// headers, and their values, must be included in the HTTP request made
+	// with the presigned URL. Presigning fails with an
+	// ErrCodeMissingSignedHeader error if a header has no value.
+	PresignSignedHeaders []string
+
+	// Names of the headers of presigned requests which are hoisted to the
+	// request's query string, rather than signed as headers. Headers are
+	// hoisted even if DisableHeaderHoisting is set.
+	PresignUnsignedHeaders []string
+
+	// StreamingPayload signs the request's payload in chunks, with the
+	// STREAMING-AWS4-HMAC-SHA256-PAYLOAD content SHA256, rather than hashing
+	// the whole payload before the request is sent. Each chunk is signed with
+	// the signature of the previous chunk, the first with the request's
+	// signature. Supported by S3's PutObject and UploadPart operations.
+	//
+	// The request's Body is replaced with the chunk encoded payload, even if
+	// DisableRequestBodyOverwrite is set, and its ContentLength with the
+	// length of the encoded payload. The length of the payload is read from
+	// the X-Amz-Decoded-Content-Length header if set, otherwise the header is
+	// set to the length of the body. Presigned requests, and requests with
+	// UnsignedPayload set, are not streamed.
+	StreamingPayload bool
+
+	// StreamingChunkSize is the size of the chunks of streamed payloads. If
+	// zero DefaultStreamingChunkSize is used. S3 requires chunks of at least
+	// 8KB.
+	StreamingChunkSize int
+
+	// Names of the headers which are not signed, in addition to the
+	// headers the signer always ignores, such as User-Agent. Used to exclude
+	// headers which are added, or modified, after the request is signed,
+	// such as the headers of proxies. The Host, X-Amz-Date,
+	// X-Amz-Content-Sha256, and X-Amz-Security-Token headers cannot be
+	// ignored, signing fails with an ErrCodeInvalidIgnoredHeader error if
+	// they are included. See AddIgnoredHeaders.
+	IgnoredHeaders []string
+
+	// DebugSigning is called with the canonical request, string to sign,
+	// and signed headers of each signed, and presigned, request. Used to
+	// diagnose signature mismatches, such as with S3 compatible storage, by
+	// comparing the canonical request with the service's. The value of the
+	// X-Amz-Security-Token header, or query parameter, is redacted. The
+	// signing key, and secret access key, are never included.
+	//
+	// The signer of SDK requests can be configured with the hook with the
+	// options of BuildNamedHandler.
+	DebugSigning func(canonicalRequest, stringToSign, signedHeaders string)
+}
+
+// AddIgnoredHeaders adds the names of the headers to the signer's
+// IgnoredHeaders. Returns an ErrCodeInvalidIgnoredHeader error, and adds no
+// headers, if a header is required to be signed.
+//
+//     signer := v4.NewSigner(creds)
+//     if err := signer.AddIgnoredHeaders("X-Forwarded-For", "Accept-Encoding"); err != nil {
+//         return err
+//     }
+func (v4 *Signer) AddIgnoredHeaders(names ...string) error {
+	if err := validateIgnoredHeaders(names); err != nil {
+		return err
+	}
+
+	v4.IgnoredHeaders = append(v4.IgnoredHeaders, names...)
+	return nil
+}
+
+// validateIgnoredHeaders returns an error if a header is required to be
+// signed.
+func validateIgnoredHeaders(names []string) error {
+	for _, name := range names {
+		if criticalHeaders.IsValid(http.CanonicalHeaderKey(name)) {
+			return awserr.New(ErrCodeInvalidIgnoredHeader,
+				fmt.Sprintf("header %s must be signed, and cannot be ignored", name), nil)
+		}
+	}
+	return nil
 }
 
 // NewSigner returns a Signer pointer configured with the credentials and optional
@@ -231,6 +350,8 @@ type signingCtx struct {
 	formattedTime      string
 	formattedShortTime string
 	unsignedPayload    bool
+	streamingPayload   bool
+	streamingChunkSize int
 
 	bodyDigest       string
 	signedHeaders    string
@@ -239,6 +360,7 @@ type signingCtx struct {
 	credentialString string
 	stringToSign     string
 	signature        string
+	signingKey       []byte
 	authorization    string
 }
 
@@ -268,7 +390,7 @@ type signingCtx struct {
 // "X-Amz-Content-Sha256" header with a precomputed value. The signer will
 // only compute the hash if the request header value is empty.
 func (v4 Signer) Sign(r *http.Request, body io.ReadSeeker, service, region string, signTime time.Time) (http.Header, error) {
-	return v4.signWithBody(r, body, service, region, 0, signTime)
+	return v4.signWithBody(aws.BackgroundContext(), r, body, service, region, 0, signTime)
 }
 
 // Presign signs AWS v4 requests with the provided body, service name, region
@@ -302,10 +424,12 @@ func (v4 Signer) Sign(r *http.Request, body io.ReadSeeker, service, region strin
 // presigned request's signature you can set the "X-Amz-Content-Sha256"
 // HTTP header and that will be included in the request's signature.
 func (v4 Signer) Presign(r *http.Request, body io.ReadSeeker, service, region string, exp time.Duration, signTime time.Time) (http.Header, error) {
-	return v4.signWithBody(r, body, service, region, exp, signTime)
+	return v4.signWithBody(aws.BackgroundContext(), r, body, service, region, exp, signTime)
 }
 
-func (v4 Signer) signWithBody(r *http.Request, body io.ReadSeeker, service, region string, exp time.Duration, signTime time.Time) (http.Header, error) {
+// signWithBody signs the request, retrieving the credentials with the context
+// so that canceling the context aborts retrieving them.
+func (v4 Signer) signWithBody(credCtx credentials.Context, r *http.Request, body io.ReadSeeker, service, region string, exp time.Duration, signTime time.Time) (http.Header, error) {
 	currentTimeFn := v4.currentTimeFn
 	if currentTimeFn == nil {
 		currentTimeFn = time.Now
@@ -322,6 +446,33 @@ func (v4 Signer) signWithBody(r *http.Request, body io.ReadSeeker, service, regi
 		Region:                 region,
 		DisableURIPathEscaping: v4.DisableURIPathEscaping,
 		unsignedPayload:        v4.UnsignedPayload,
+		streamingPayload:       v4.StreamingPayload && !v4.UnsignedPayload && exp == 0,
+		streamingChunkSize:     v4.StreamingChunkSize,
+	}
+	if ctx.streamingChunkSize <= 0 {
+		ctx.streamingChunkSize = DefaultStreamingChunkSize
+	}
+
+	if ctx.isPresign && (exp < 0 || exp > PresignMaxExpireTime) {
+		return http.Header{}, awserr.New(ErrCodeInvalidPresignExpireTime,
+			fmt.Sprintf("presign expire time %v must be positive, and at most %v",
+				exp, PresignMaxExpireTime), nil)
+	}
+
+	if err := validateIgnoredHeaders(v4.IgnoredHeaders); err != nil {
+		return http.Header{}, err
+	}
+
+	if ctx.isPresign {
+		for _, name := range v4.PresignSignedHeaders {
+			if strings.EqualFold(name, "host") {
+				continue
+			}
+			if len(r.Header.Get(name)) == 0 {
+				return http.Header{}, awserr.New(ErrCodeMissingSignedHeader,
+					fmt.Sprintf("presigned signed header %s has no value", name), nil)
+			}
+		}
 	}
 
 	for key := range ctx.Query {
@@ -334,18 +485,32 @@ func (v4 Signer) signWithBody(r *http.Request, body io.ReadSeeker, service, regi
 	}
 
 	var err error
-	ctx.credValues, err = v4.Credentials.Get()
+	ctx.credValues, err = v4.Credentials.GetWithContext(credCtx)
 	if err != nil {
 		return http.Header{}, err
 	}
 
+	if ctx.streamingPayload && isChunkEncoded(r.Header) {
+		// The payload is already chunk encoded, such as payloads with
+		// trailing checksums, and is signed as is.
+		ctx.streamingPayload = false
+	}
+	if ctx.streamingPayload {
+		if err := ctx.buildStreamingHeaders(); err != nil {
+			return http.Header{}, err
+		}
+	}
+
 	ctx.assignAmzQueryValues()
-	ctx.build(v4.DisableHeaderHoisting)
+	ctx.build(v4.presignHoistingRule(), v4.ignoredHeadersRule())
 
 	// If the request is not presigned the body should be attached to it. This
 	// prevents the confusion of wanting to send a signed request without
-	// the body the request was signed for attached.
-	if !(v4.DisableRequestBodyOverwrite || ctx.isPresign) {
+	// the body the request was signed for attached. Streamed payloads are
+	// always attached, since the chunks are signed as the body is read.
+	if ctx.streamingPayload {
+		r.Body = newChunkSigningReader(body, ctx)
+	} else if !(v4.DisableRequestBodyOverwrite || ctx.isPresign) {
 		var reader io.ReadCloser
 		if body != nil {
 			var ok bool
@@ -356,6 +521,9 @@ func (v4 Signer) signWithBody(r *http.Request, body io.ReadSeeker, service, regi
 		r.Body = reader
 	}
 
+	if v4.DebugSigning != nil {
+		v4.DebugSigning(ctx.redact(ctx.canonicalString), ctx.stringToSign, ctx.signedHeaders)
+	}
 	if v4.Debug.Matches(aws.LogDebugWithSigning) {
 		v4.logSigningInfo(ctx)
 	}
@@ -442,15 +610,28 @@ func signSDKRequestWithCurrTime(req *request.Request, curTimeFn func() time.Time
 		name = req.ClientInfo.ServiceName
 	}
 
+	// Unsigned payloads are only protected from tampering by TLS.
+	if req.UnsignedPayload && req.HTTPRequest.URL.Scheme != "https" {
+		req.Error = awserr.New(ErrCodeInsecureUnsignedPayload,
+			fmt.Sprintf("unsigned payload requires an HTTPS endpoint, got %s scheme",
+				req.HTTPRequest.URL.Scheme), nil)
+		req.SignedHeaderVals = nil
+		return
+	}
+
 	v4 := NewSigner(req.Config.Credentials, func(v4 *Signer) {
 		v4.Debug = req.Config.LogLevel.Value()
 		v4.Logger = req.Config.Logger
 		v4.DisableHeaderHoisting = req.NotHoist
-		v4.currentTimeFn = curTimeFn
-		if name == "s3" {
-			// S3 service should not have any escaping applied
-			v4.DisableURIPathEscaping = true
+		v4.PresignSignedHeaders = req.PresignSignedHeaders
+		v4.PresignUnsignedHeaders = req.PresignUnsignedHeaders
+		v4.IgnoredHeaders = req.Config.SigningIgnoredHeaders
+		v4.StreamingPayload = req.StreamingPayload
+		if req.UnsignedPayload {
+			v4.UnsignedPayload = true
 		}
+		v4.currentTimeFn = curTimeFn
+		v4.DisableURIPathEscaping = signingEscaping(req.ClientInfo, name) == metadata.RawPathSigning
 		// Prevents setting the HTTPRequest's Body. Since the Body could be
 		// wrapped in a custom io.Closer that we do not want to be stompped
 		// on top of by the signer.
@@ -461,12 +642,21 @@ func signSDKRequestWithCurrTime(req *request.Request, curTimeFn func() time.Time
 		opt(v4)
 	}
 
+	// The length of the payload is the request's content length built from
+	// the request's body, since the body may not be seekable. Retries keep
+	// the header set when the request was first signed.
+	if v4.StreamingPayload && req.ExpireTime == 0 &&
+		len(req.HTTPRequest.Header.Get(decodedContentLengthHeader)) == 0 {
+		req.HTTPRequest.Header.Set(decodedContentLengthHeader,
+			strconv.FormatInt(req.HTTPRequest.ContentLength, 10))
+	}
+
 	signingTime := req.Time
 	if !req.LastSignedAt.IsZero() {
 		signingTime = req.LastSignedAt
 	}
 
-	signedHeaders, err := v4.signWithBody(req.HTTPRequest, req.GetBody(),
+	signedHeaders, err := v4.signWithBody(req.Context(), req.HTTPRequest, req.GetBody(),
 		name, region, req.ExpireTime, signingTime,
 	)
 	if err != nil {
@@ -479,10 +669,25 @@ func signSDKRequestWithCurrTime(req *request.Request, curTimeFn func() time.Time
 	req.LastSignedAt = curTimeFn()
 }
 
+// signingEscaping returns how the URI path of the client's requests is
+// escaped when signed, derived from the signing name if not set by the
+// client's metadata. S3 requests should not have any escaping applied.
+func signingEscaping(info metadata.ClientInfo, signingName string) metadata.SigningEscaping {
+	if len(info.SigningEscaping) != 0 {
+		return info.SigningEscaping
+	}
+	if signingName == "s3" {
+		return metadata.RawPathSigning
+	}
+	return metadata.EscapedPathSigning
+}
+
 const logSignInfoMsg = `DEBUG: Request Signature:
 ---[ CANONICAL STRING  ]-----------------------------
 %s
 ---[ STRING TO SIGN ]--------------------------------
+%s
+---[ CREDENTIAL SCOPE ]------------------------------
 %s%s
 -----------------------------------------------------`
 const logSignedURLMsg = `
@@ -492,13 +697,62 @@ const logSignedURLMsg = `
 func (v4 *Signer) logSigningInfo(ctx *signingCtx) {
 	signedURLMsg := ""
 	if ctx.isPresign {
-		signedURLMsg = fmt.Sprintf(logSignedURLMsg, ctx.Request.URL.String())
+		signedURLMsg = fmt.Sprintf(logSignedURLMsg, ctx.redact(ctx.Request.URL.String()))
 	}
-	msg := fmt.Sprintf(logSignInfoMsg, ctx.canonicalString, ctx.stringToSign, signedURLMsg)
+	msg := fmt.Sprintf(logSignInfoMsg, ctx.redact(ctx.canonicalString), ctx.stringToSign,
+		ctx.credentialString, signedURLMsg)
 	v4.Logger.Log(msg)
 }
 
-func (ctx *signingCtx) build(disableHeaderHoisting bool) {
+// redactedValue replaces the values redacted from logged signing information.
+const redactedValue = "<redacted>"
+
+// redact returns the string with the session token of the credentials, and
+// its escaped form, redacted.
+func (ctx *signingCtx) redact(s string) string {
+	token := ctx.credValues.SessionToken
+	if len(token) == 0 {
+		return s
+	}
+
+	if escaped := url.QueryEscape(token); escaped != token {
+		s = strings.Replace(s, escaped, redactedValue, -1)
+	}
+	return strings.Replace(s, token, redactedValue, -1)
+}
+
+// presignHoistingRule returns the rule of the headers hoisted to the query
+// string of presigned requests, or nil if no headers are hoisted.
+func (v4 *Signer) presignHoistingRule() rule {
+	var hoisting rule
+	if !v4.DisableHeaderHoisting {
+		hoisting = allowedQueryHoisting
+	}
+	if len(v4.PresignSignedHeaders) == 0 && len(v4.PresignUnsignedHeaders) == 0 {
+		return hoisting
+	}
+
+	return hoistingOverrides{
+		rule:     hoisting,
+		signed:   newHeaderMapRule(v4.PresignSignedHeaders),
+		unsigned: newHeaderMapRule(v4.PresignUnsignedHeaders),
+	}
+}
+
+// ignoredHeadersRule returns the rule of the headers which are signed,
+// excluding the signer's ignored headers.
+func (v4 *Signer) ignoredHeadersRule() rule {
+	if len(v4.IgnoredHeaders) == 0 {
+		return ignoredHeaders
+	}
+
+	return inclusiveRules{
+		ignoredHeaders,
+		blacklist{newHeaderMapRule(v4.IgnoredHeaders)},
+	}
+}
+
+func (ctx *signingCtx) build(hoisting, ignored rule) {
 	ctx.buildTime()             // no depends
 	ctx.buildCredentialString() // no depends
 
@@ -506,16 +760,16 @@ func (ctx *signingCtx) build(disableHeaderHoisting bool) {
 
 	unsignedHeaders := ctx.Request.Header
 	if ctx.isPresign {
-		if !disableHeaderHoisting {
+		if hoisting != nil {
 			urlValues := url.Values{}
-			urlValues, unsignedHeaders = buildQuery(allowedQueryHoisting, unsignedHeaders) // no depends
+			urlValues, unsignedHeaders = buildQuery(hoisting, unsignedHeaders) // no depends
 			for k := range urlValues {
 				ctx.Query[k] = urlValues[k]
 			}
 		}
 	}
 
-	ctx.buildCanonicalHeaders(ignoredHeaders, unsignedHeaders)
+	ctx.buildCanonicalHeaders(ignored, unsignedHeaders)
 	ctx.buildCanonicalString() // depends on canon headers / signed headers
 	ctx.buildStringToSign()    // depends on canon string
 	ctx.buildSignature()       // depends on string to sign
@@ -624,7 +878,7 @@ func (ctx *signingCtx) buildCanonicalString() {
 	uri := getURIPath(ctx.Request.URL)
 
 	if !ctx.DisableURIPathEscaping {
-		uri = rest.EscapePath(uri, false)
+		uri = rest.EscapePath(normalizeURIPath(uri), false)
 	}
 
 	ctx.canonicalString = strings.Join([]string{
@@ -647,15 +901,30 @@ func (ctx *signingCtx) buildStringToSign() {
 }
 
 func (ctx *signingCtx) buildSignature() {
-	secret := ctx.credValues.SecretAccessKey
-	date := makeHmac([]byte("AWS4"+secret), []byte(ctx.formattedShortTime))
-	region := makeHmac(date, []byte(ctx.Region))
-	service := makeHmac(region, []byte(ctx.ServiceName))
-	credentials := makeHmac(service, []byte("aws4_request"))
-	signature := makeHmac(credentials, []byte(ctx.stringToSign))
+	key := deriveSigningKey(ctx.credValues.SecretAccessKey, ctx.formattedShortTime, ctx.Region, ctx.ServiceName)
+	signature := makeHmac(key, []byte(ctx.stringToSign))
+	ctx.signingKey = key
 	ctx.signature = hex.EncodeToString(signature)
 }
 
+// DeriveSigningKey returns the V4 signing key derived from the secret access
+// key, for signing requests to the service in the region on the date of the
+// sign time. The signature of a string to sign is the hex encoded
+// HMAC-SHA256 of the string with the key.
+//
+// Used to sign documents which are not HTTP requests, such as the policies of
+// S3's browser-based POST uploads.
+func DeriveSigningKey(secretKey string, signTime time.Time, region, service string) []byte {
+	return deriveSigningKey(secretKey, signTime.UTC().Format(shortTimeFormat), region, service)
+}
+
+func deriveSigningKey(secretKey, shortTime, region, service string) []byte {
+	date := makeHmac([]byte("AWS4"+secretKey), []byte(shortTime))
+	regionKey := makeHmac(date, []byte(region))
+	serviceKey := makeHmac(regionKey, []byte(service))
+	return makeHmac(serviceKey, []byte("aws4_request"))
+}
+
 func (ctx *signingCtx) buildBodyDigest() {
 	hash := ctx.Request.Header.Get("X-Amz-Content-Sha256")
 	if hash == "" {
@@ -2,6 +2,7 @@ package v4
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/awstesting"
@@ -600,6 +602,48 @@ func TestRequestHost(t *testing.T) {
 	}
 }
 
+type contextCredProvider struct {
+	ctx credentials.Context
+}
+
+func (p *contextCredProvider) Retrieve() (credentials.Value, error) {
+	return credentials.Value{}, fmt.Errorf("expect credentials retrieved with context")
+}
+
+func (p *contextCredProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	p.ctx = ctx
+	return credentials.Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, nil
+}
+
+func (p *contextCredProvider) IsExpired() bool { return p.ctx == nil }
+
+func TestSignSDKRequest_CredentialsContext(t *testing.T) {
+	p := &contextCredProvider{}
+	svc := awstesting.NewClient(&aws.Config{
+		Credentials: credentials.NewCredentials(p),
+		Region:      aws.String("us-west-2"),
+	})
+	r := svc.NewRequest(
+		&request.Operation{
+			Name:       "BatchGetItem",
+			HTTPMethod: "POST",
+			HTTPPath:   "/",
+		},
+		nil,
+		nil,
+	)
+	ctx := &struct{ aws.Context }{aws.BackgroundContext()}
+	r.SetContext(ctx)
+
+	SignSDKRequest(r)
+	if r.Error != nil {
+		t.Fatalf("expect no error, got %v", r.Error)
+	}
+	if e, a := credentials.Context(ctx), p.ctx; e != a {
+		t.Errorf("expect credentials retrieved with the request's context, got %v", a)
+	}
+}
+
 func BenchmarkPresignRequest(b *testing.B) {
 	signer := buildSigner()
 	req, body := buildRequest("dynamodb", "us-east-1", "{}")
@@ -642,3 +686,207 @@ func BenchmarkStripExcessSpaces(b *testing.B) {
 		stripExcessSpaces(cases)
 	}
 }
+
+func TestNormalizeURIPath(t *testing.T) {
+	cases := map[string]string{
+		"":                "/",
+		"/":               "/",
+		"//":              "/",
+		"/a/b":            "/a/b",
+		"/a/b/":           "/a/b/",
+		"/a//b":           "/a/b",
+		"/a/./b":          "/a/b",
+		"/a/../b":         "/b",
+		"/a/b/..":         "/a/",
+		"/a/b/.":          "/a/b/",
+		"/../a":           "/a",
+		"/a%2F%2Fb/%2E/c": "/a%2F%2Fb/%2E/c",
+	}
+
+	for path, expect := range cases {
+		if e, a := expect, normalizeURIPath(path); e != a {
+			t.Errorf("%q, expect %q, got %q", path, e, a)
+		}
+	}
+}
+
+func TestSignSDKRequest_SigningEscaping(t *testing.T) {
+	cases := map[string]struct {
+		SigningName     string
+		SigningEscaping metadata.SigningEscaping
+		Options         []func(*Signer)
+		ExpectPath      string
+	}{
+		"escaped": {
+			SigningName: "es",
+			ExpectPath:  "/a/b%252Bc%252Fd",
+		},
+		"s3 signing name": {
+			SigningName: "s3",
+			ExpectPath:  "/a//b%2Bc%2Fd",
+		},
+		"raw": {
+			SigningName:     "custom",
+			SigningEscaping: metadata.RawPathSigning,
+			ExpectPath:      "/a//b%2Bc%2Fd",
+		},
+		"s3 escaped": {
+			SigningName:     "s3",
+			SigningEscaping: metadata.EscapedPathSigning,
+			ExpectPath:      "/a/b%252Bc%252Fd",
+		},
+		"signer option override": {
+			SigningName: "custom",
+			Options: []func(*Signer){
+				func(v4 *Signer) { v4.DisableURIPathEscaping = true },
+			},
+			ExpectPath: "/a//b%2Bc%2Fd",
+		},
+	}
+
+	for name, c := range cases {
+		svc := awstesting.NewClient(&aws.Config{
+			Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+			Region:      aws.String("us-west-2"),
+		})
+		r := svc.NewRequest(
+			&request.Operation{
+				Name:       "Operation",
+				HTTPMethod: "GET",
+				HTTPPath:   "/",
+			},
+			nil,
+			nil,
+		)
+		r.ClientInfo.SigningName = c.SigningName
+		r.ClientInfo.SigningEscaping = c.SigningEscaping
+		r.HTTPRequest.URL.Path = "/a//b+c/d"
+		r.HTTPRequest.URL.RawPath = "/a//b%2Bc%2Fd"
+
+		var logger bytes.Buffer
+		r.Config.Logger = aws.LoggerFunc(func(args ...interface{}) {
+			fmt.Fprint(&logger, args...)
+		})
+		r.Config.LogLevel = aws.LogLevel(aws.LogDebugWithSigning)
+
+		signSDKRequestWithCurrTime(r, time.Now, c.Options...)
+		if r.Error != nil {
+			t.Fatalf("%s, expect no error, got %v", name, r.Error)
+		}
+		if e, a := "GET\n"+c.ExpectPath+"\n", logger.String(); !strings.Contains(a, e) {
+			t.Errorf("%s, expect canonical path %q, got\n%s", name, c.ExpectPath, a)
+		}
+	}
+}
+
+// TestDebugSigning_GetVanilla verifies the get-vanilla request of the V4
+// signature test suite.
+func TestDebugSigning_GetVanilla(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://example.amazonaws.com/", nil)
+
+	var canonicalRequest, stringToSign, signedHeaders string
+	signer := NewSigner(
+		credentials.NewStaticCredentials("AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", ""),
+		WithDebugSigning(func(c, s, h string) {
+			canonicalRequest, stringToSign, signedHeaders = c, s, h
+		}),
+	)
+	_, err := signer.Sign(req, nil, "service", "us-east-1", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expectCanonical := strings.Join([]string{
+		"GET",
+		"/",
+		"",
+		"host:example.amazonaws.com",
+		"x-amz-date:20150830T123600Z",
+		"",
+		"host;x-amz-date",
+		"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	}, "\n")
+	if e, a := expectCanonical, canonicalRequest; e != a {
+		t.Errorf("expect canonical request\n%s\ngot\n%s", e, a)
+	}
+
+	expectStringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		"20150830T123600Z",
+		"20150830/us-east-1/service/aws4_request",
+		"bb579772317eb040ac9ed261061d46c1f17a8133879d6129b6e1c25292927e63",
+	}, "\n")
+	if e, a := expectStringToSign, stringToSign; e != a {
+		t.Errorf("expect string to sign\n%s\ngot\n%s", e, a)
+	}
+	if e, a := "host;x-amz-date", signedHeaders; e != a {
+		t.Errorf("expect %v signed headers, got %v", e, a)
+	}
+
+	expectAuth := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, " +
+		"SignedHeaders=host;x-amz-date, " +
+		"Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31"
+	if e, a := expectAuth, req.Header.Get("Authorization"); e != a {
+		t.Errorf("expect %v authorization, got %v", e, a)
+	}
+}
+
+func TestDebugSigning_RedactSessionToken(t *testing.T) {
+	const token = "FQoG/session+token=="
+
+	cases := map[string]struct {
+		Expire time.Duration
+	}{
+		"sign":    {},
+		"presign": {Expire: 5 * time.Minute},
+	}
+
+	for name, c := range cases {
+		svc := awstesting.NewClient(&aws.Config{
+			Credentials: credentials.NewStaticCredentials("AKID", "SECRET", token),
+			Region:      aws.String("us-west-2"),
+			LogLevel:    aws.LogLevel(aws.LogDebugWithSigning),
+		})
+		var logged string
+		svc.Config.Logger = aws.LoggerFunc(func(args ...interface{}) {
+			logged = fmt.Sprint(args...)
+		})
+		r := svc.NewRequest(&request.Operation{
+			Name:       "Operation",
+			HTTPMethod: "GET",
+			HTTPPath:   "/",
+		}, nil, nil)
+		r.ClientInfo.SigningName = "service"
+		r.ExpireTime = c.Expire
+
+		var calls int
+		var canonicalRequest string
+		handler := BuildNamedHandler("v4.CustomSignerHandler", WithDebugSigning(func(c, s, h string) {
+			calls++
+			canonicalRequest = c
+		}))
+		handler.Fn(r)
+		if r.Error != nil {
+			t.Fatalf("%s, expect no error, got %v", name, r.Error)
+		}
+
+		if e, a := 1, calls; e != a {
+			t.Errorf("%s, expect %v debug signing calls, got %v", name, e, a)
+		}
+		for _, v := range []string{canonicalRequest, logged} {
+			if strings.Contains(v, token) || strings.Contains(v, "FQoG%2Fsession%2Btoken%3D%3D") {
+				t.Errorf("%s, expect session token redacted, got\n%s", name, v)
+			}
+			if !strings.Contains(v, "<redacted>") {
+				t.Errorf("%s, expect redacted session token, got\n%s", name, v)
+			}
+			if strings.Contains(v, "SECRET") {
+				t.Errorf("%s, expect no secret key, got\n%s", name, v)
+			}
+		}
+		if e := "---[ CREDENTIAL SCOPE ]------------------------------\n" +
+			r.Time.UTC().Format("20060102") + "/us-west-2/service/aws4_request"; !strings.Contains(logged, e) {
+			t.Errorf("%s, expect credential scope logged, got\n%s", name, logged)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package v4a
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+// ErrCodeKeyDerivation is the error code of the error returned when the
+// ECDSA private key cannot be derived from the credentials.
+const ErrCodeKeyDerivation = "KeyDerivationError"
+
+var (
+	one = big.NewInt(1)
+
+	// nMinusTwoP256 is the order of the P-256 curve minus two, the upper
+	// bound of the derived private key candidates.
+	nMinusTwoP256 = new(big.Int).Sub(elliptic.P256().Params().N, big.NewInt(2))
+)
+
+// DeriveKey returns the ECDSA P-256 private key derived from the access key
+// pair of the credentials, as specified by the SigV4a signature. The key is
+// derived with the HMAC-SHA256 counter mode KDF of NIST SP 800-108, keyed
+// with "AWS4A" and the secret access key, and the label
+// AWS4-ECDSA-P256-SHA256. The context of the KDF is the access key ID and an
+// external counter, which is incremented until the candidate is less than
+// the curve's order minus two. The private key is the candidate plus one.
+func DeriveKey(accessKeyID, secretAccessKey string) (*ecdsa.PrivateKey, error) {
+	curve := elliptic.P256()
+	bitLen := curve.Params().BitSize
+	inputKey := []byte("AWS4A" + secretAccessKey)
+
+	d := new(big.Int)
+	for counter := 1; ; counter++ {
+		if counter > 0xFF {
+			return nil, awserr.New(ErrCodeKeyDerivation,
+				"exhausted the external counter deriving the private key", nil)
+		}
+
+		context := append([]byte(accessKeyID), byte(counter))
+		candidate := hmacKeyDerivation(bitLen, inputKey, []byte(authHeaderPrefix), context)
+		if d.SetBytes(candidate).Cmp(nMinusTwoP256) < 0 {
+			break
+		}
+	}
+	d.Add(d, one)
+
+	priv := &ecdsa.PrivateKey{D: d}
+	priv.PublicKey.Curve = curve
+	priv.PublicKey.X, priv.PublicKey.Y = curve.ScalarBaseMult(d.Bytes())
+
+	return priv, nil
+}
+
+// hmacKeyDerivation returns the key of bitLen bits derived with the
+// HMAC-SHA256 counter mode KDF of NIST SP 800-108.
+//
+//     K(i) = HMAC(key, [i]32 || label || 0x00 || context || [bitLen]32)
+func hmacKeyDerivation(bitLen int, key, label, context []byte) []byte {
+	var fixedInput bytes.Buffer
+	fixedInput.Write(label)
+	fixedInput.WriteByte(0x00)
+	fixedInput.Write(context)
+	binary.Write(&fixedInput, binary.BigEndian, uint32(bitLen))
+
+	n := (bitLen/8 + sha256.Size - 1) / sha256.Size
+
+	var output []byte
+	h := hmac.New(sha256.New, key)
+	for i := 1; i <= n; i++ {
+		h.Reset()
+		binary.Write(h, binary.BigEndian, uint32(i))
+		h.Write(fixedInput.Bytes())
+		output = h.Sum(output)
+	}
+
+	return output[:bitLen/8]
+}
+
+// keyCache caches the private key derived from the most recently used access
+// key pair, as the same credentials sign many requests.
+type keyCache struct {
+	m     sync.Mutex
+	creds credentials.Value
+	key   *ecdsa.PrivateKey
+}
+
+// Get returns the private key derived from the access key pair of the
+// credentials, deriving the key if the cached key is of other credentials.
+func (c *keyCache) Get(creds credentials.Value) (*ecdsa.PrivateKey, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.key != nil && c.creds.AccessKeyID == creds.AccessKeyID &&
+		c.creds.SecretAccessKey == creds.SecretAccessKey {
+		return c.key, nil
+	}
+
+	key, err := DeriveKey(creds.AccessKeyID, creds.SecretAccessKey)
+	if err != nil {
+		return nil, err
+	}
+	c.creds, c.key = creds, key
+
+	return key, nil
+}
+
+var derivedKeys = &keyCache{}
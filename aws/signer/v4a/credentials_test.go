@@ -0,0 +1,65 @@
+package v4a
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+func TestDeriveKey(t *testing.T) {
+	cases := map[string]struct {
+		AccessKeyID, SecretAccessKey string
+		D, X, Y                      string
+	}{
+		"random key pair": {
+			AccessKeyID:     "AKISORANDOMAASORANDOM",
+			SecretAccessKey: "q+jcrXGc+0zWN6uzclKVhvMmUsIfRPa4rlRandom",
+			D:               "7fd3bd010c0d9c292141c2b77bfbde1042c92e6836fff749d1269ec890fca1bd",
+			X:               "15d242ceebf8d8169fd6a8b5a746c41140414c3b07579038da06af89190fffcb",
+			Y:               "515242cedd82e94799482e4c0514b505afccf2c0c98d6a553bf539f424c5ec0",
+		},
+		"test suite key pair": {
+			AccessKeyID:     "AKIDEXAMPLE",
+			SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+			D:               "7efc8c0e65a324242818c5a50c891c6060b6a00717b7ba3cbe3c5d765be9259c",
+			X:               "b6618f6a65740a99e650b33b6b4b5bd0d43b176d721a3edfea7e7d2d56d936b1",
+			Y:               "865ed22a7eadc9c5cb9d2cbaca1b3699139fedc5043dc6661864218330c8e518",
+		},
+	}
+
+	for name, c := range cases {
+		key, err := DeriveKey(c.AccessKeyID, c.SecretAccessKey)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := c.D, fmt.Sprintf("%x", key.D); e != a {
+			t.Errorf("%s, expect %v private key, got %v", name, e, a)
+		}
+		if e, a := c.X, fmt.Sprintf("%x", key.X); e != a {
+			t.Errorf("%s, expect %v public key X, got %v", name, e, a)
+		}
+		if e, a := c.Y, fmt.Sprintf("%x", key.Y); e != a {
+			t.Errorf("%s, expect %v public key Y, got %v", name, e, a)
+		}
+	}
+}
+
+func TestKeyCache(t *testing.T) {
+	cache := &keyCache{}
+
+	creds := credentials.Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}
+	first, err := cache.Get(creds)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	second, _ := cache.Get(creds)
+	if first != second {
+		t.Errorf("expect cached key for the same credentials")
+	}
+
+	rotated, _ := cache.Get(credentials.Value{AccessKeyID: "AKID", SecretAccessKey: "ROTATED"})
+	if first == rotated || first.D.Cmp(rotated.D) == 0 {
+		t.Errorf("expect new key for rotated credentials")
+	}
+}
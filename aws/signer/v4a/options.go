@@ -0,0 +1,17 @@
+package v4a
+
+// WithUnsignedPayload will enable and set the UnsignedPayload field to
+// true of the signer.
+func WithUnsignedPayload(v4a *Signer) {
+	v4a.UnsignedPayload = true
+}
+
+// WithRegionSet returns a signer option which sets the regions the signature
+// is valid in, see Signer.RegionSet.
+//
+//     signer := v4a.NewSigner(creds, v4a.WithRegionSet("*"))
+func WithRegionSet(regions ...string) func(*Signer) {
+	return func(v4a *Signer) {
+		v4a.RegionSet = regions
+	}
+}
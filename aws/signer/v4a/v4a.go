@@ -0,0 +1,630 @@
+// Package v4a implements signing for AWS SigV4a signatures.
+//
+// SigV4a is the asymmetric variant of the AWS V4 signature, which signs
+// requests with an ECDSA P-256 key derived from the credentials' secret
+// access key. A SigV4a signature is valid in each region of the signature's
+// region set, rather than only in the region it was signed for. The region
+// set "*" is valid in all regions. Requests to S3 multi-region access points
+// must be signed with SigV4a.
+//
+// The canonical request of the signature is the same as the V4 signature's,
+// with the X-Amz-Region-Set header signed. The signature is the hex encoded
+// ASN.1 DER ECDSA signature of the SHA256 of the string to sign. ECDSA
+// signatures are randomized, signing the same request twice produces two
+// different, valid, signatures.
+//
+// Headers of presigned requests are not hoisted to the request's query
+// string. The signed headers of the presigned request, and their values, must
+// be included in the HTTP request made with the presigned URL.
+package v4a
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/private/protocol/rest"
+)
+
+const (
+	authHeaderPrefix = "AWS4-ECDSA-P256-SHA256"
+	timeFormat       = "20060102T150405Z"
+	shortTimeFormat  = "20060102"
+
+	// regionSetHeader is the header, and query parameter of presigned
+	// requests, of the regions the signature is valid in.
+	regionSetHeader = "X-Amz-Region-Set"
+
+	// emptyStringSHA256 is a SHA256 of an empty string
+	emptyStringSHA256 = `e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855`
+)
+
+// ErrCodeSignature is the error code of the error returned when the
+// request's string to sign cannot be signed with the derived private key.
+const ErrCodeSignature = "SignatureError"
+
+// ignoredHeaders are the headers which are not signed.
+var ignoredHeaders = map[string]struct{}{
+	"Authorization":   {},
+	"User-Agent":      {},
+	"X-Amzn-Trace-Id": {},
+}
+
+// Signer applies AWS SigV4a signing to given request. Use this to sign
+// requests that need to be signed with AWS SigV4a Signatures.
+type Signer struct {
+	// The authentication credentials the request will be signed against.
+	// This value must be set to sign requests.
+	Credentials *credentials.Credentials
+
+	// Sets the log level the signer should use when reporting information to
+	// the logger. If the logger is nil nothing will be logged. See
+	// aws.LogLevelType for more information on available logging levels
+	//
+	// By default nothing will be logged.
+	Debug aws.LogLevelType
+
+	// The logger loging information will be written to. If there the logger
+	// is nil, nothing will be logged.
+	Logger aws.Logger
+
+	// RegionSet is the regions the signature is valid in. If empty the
+	// signature is valid in the region the request is signed for. "*" is
+	// valid in all regions.
+	RegionSet []string
+
+	// Disables the automatic escaping of the URI path of the request for the
+	// siganture's canonical string's path, the same as the V4 signer's
+	// option. S3 is an example of a service that does not need additional
+	// escaping.
+	DisableURIPathEscaping bool
+
+	// Disales the automatical setting of the HTTP request's Body field with the
+	// io.ReadSeeker passed in to the signer.
+	DisableRequestBodyOverwrite bool
+
+	// UnsignedPayload will prevent signing of the payload. This will only
+	// work for services that have support for this.
+	UnsignedPayload bool
+
+	// currentTimeFn returns the time value which represents the current time.
+	// This value should only be used for testing. If it is nil the default
+	// time.Now will be used.
+	currentTimeFn func() time.Time
+}
+
+// NewSigner returns a Signer pointer configured with the credentials and optional
+// option values provided. If not options are provided the Signer will use its
+// default configuration.
+func NewSigner(credentials *credentials.Credentials, options ...func(*Signer)) *Signer {
+	v4a := &Signer{
+		Credentials: credentials,
+	}
+
+	for _, option := range options {
+		option(v4a)
+	}
+
+	return v4a
+}
+
+type signingCtx struct {
+	ServiceName      string
+	RegionSet        []string
+	Request          *http.Request
+	Body             io.ReadSeeker
+	Query            url.Values
+	Time             time.Time
+	ExpireTime       time.Duration
+	SignedHeaderVals http.Header
+
+	DisableURIPathEscaping bool
+
+	credValues         credentials.Value
+	privateKey         *ecdsa.PrivateKey
+	isPresign          bool
+	formattedTime      string
+	formattedShortTime string
+	unsignedPayload    bool
+
+	bodyDigest       string
+	signedHeaders    string
+	canonicalHeaders string
+	canonicalString  string
+	credentialString string
+	stringToSign     string
+	signature        string
+}
+
+// Sign signs AWS SigV4a requests with the provided body, service name,
+// region the request is made to, and time the request is signed at. The
+// signature is valid in the signer's RegionSet, or the region if the region
+// set is empty.
+//
+// Returns a list of HTTP headers that were included in the signature or an
+// error if signing the request failed.
+//
+// Sign will set the request's Body to be the `body` parameter passed in,
+// unless DisableRequestBodyOverwrite is set. To bypass the signer computing
+// the hash of the body you can set the "X-Amz-Content-Sha256" header with a
+// precomputed value.
+func (v4a Signer) Sign(r *http.Request, body io.ReadSeeker, service, region string, signTime time.Time) (http.Header, error) {
+	return v4a.signWithBody(aws.BackgroundContext(), r, body, service, region, 0, signTime)
+}
+
+// Presign signs AWS SigV4a requests with the query string, with the provided
+// body, service name, region the request is made to, and time the request
+// is signed at. The signature is valid for exp after the sign time, at most
+// v4.PresignMaxExpireTime.
+//
+// Returns a list of HTTP headers that were included in the signature or an
+// error if signing the request failed. For presigned requests these headers
+// and their values must be included on the HTTP request when it is made.
+//
+// Presigning a S3 request will not compute the body's SHA256 hash, unless
+// the "X-Amz-Content-Sha256" header is set.
+func (v4a Signer) Presign(r *http.Request, body io.ReadSeeker, service, region string, exp time.Duration, signTime time.Time) (http.Header, error) {
+	return v4a.signWithBody(aws.BackgroundContext(), r, body, service, region, exp, signTime)
+}
+
+// signWithBody signs the request, retrieving the credentials with the context
+// so that canceling the context aborts retrieving them.
+func (v4a Signer) signWithBody(credCtx credentials.Context, r *http.Request, body io.ReadSeeker, service, region string, exp time.Duration, signTime time.Time) (http.Header, error) {
+	currentTimeFn := v4a.currentTimeFn
+	if currentTimeFn == nil {
+		currentTimeFn = time.Now
+	}
+
+	regionSet := v4a.RegionSet
+	if len(regionSet) == 0 {
+		regionSet = []string{region}
+	}
+
+	ctx := &signingCtx{
+		Request:                r,
+		Body:                   body,
+		Query:                  r.URL.Query(),
+		Time:                   signTime,
+		ExpireTime:             exp,
+		isPresign:              exp != 0,
+		ServiceName:            service,
+		RegionSet:              regionSet,
+		DisableURIPathEscaping: v4a.DisableURIPathEscaping,
+		unsignedPayload:        v4a.UnsignedPayload,
+	}
+
+	if ctx.isPresign && (exp < 0 || exp > v4.PresignMaxExpireTime) {
+		return http.Header{}, awserr.New(v4.ErrCodeInvalidPresignExpireTime,
+			fmt.Sprintf("presign expire time %v must be positive, and at most %v",
+				exp, v4.PresignMaxExpireTime), nil)
+	}
+
+	for key := range ctx.Query {
+		sort.Strings(ctx.Query[key])
+	}
+
+	if ctx.isRequestSigned() {
+		ctx.Time = currentTimeFn()
+		ctx.handlePresignRemoval()
+	}
+
+	var err error
+	ctx.credValues, err = v4a.Credentials.GetWithContext(credCtx)
+	if err != nil {
+		return http.Header{}, err
+	}
+	if ctx.privateKey, err = derivedKeys.Get(ctx.credValues); err != nil {
+		return http.Header{}, err
+	}
+
+	ctx.assignAmzQueryValues()
+	if err := ctx.build(); err != nil {
+		return http.Header{}, err
+	}
+
+	// If the request is not presigned the body should be attached to it. This
+	// prevents the confusion of wanting to send a signed request without
+	// the body the request was signed for attached.
+	if !(v4a.DisableRequestBodyOverwrite || ctx.isPresign) {
+		var reader io.ReadCloser
+		if body != nil {
+			var ok bool
+			if reader, ok = body.(io.ReadCloser); !ok {
+				reader = ioutil.NopCloser(body)
+			}
+		}
+		r.Body = reader
+	}
+
+	if v4a.Debug.Matches(aws.LogDebugWithSigning) {
+		v4a.logSigningInfo(ctx)
+	}
+
+	return ctx.SignedHeaderVals, nil
+}
+
+func (ctx *signingCtx) handlePresignRemoval() {
+	if !ctx.isPresign {
+		return
+	}
+
+	// The credentials have expired for this request. The current signing
+	// is invalid, and needs to be request because the request will fail.
+	ctx.removePresign()
+
+	// Update the request's query string to ensure the values stays in
+	// sync in the case retrieving the new credentials fails.
+	ctx.Request.URL.RawQuery = ctx.Query.Encode()
+}
+
+func (ctx *signingCtx) assignAmzQueryValues() {
+	regionSet := strings.Join(ctx.RegionSet, ",")
+
+	if ctx.isPresign {
+		ctx.Query.Set("X-Amz-Algorithm", authHeaderPrefix)
+		ctx.Query.Set(regionSetHeader, regionSet)
+		if ctx.credValues.SessionToken != "" {
+			ctx.Query.Set("X-Amz-Security-Token", ctx.credValues.SessionToken)
+		} else {
+			ctx.Query.Del("X-Amz-Security-Token")
+		}
+
+		return
+	}
+
+	ctx.Request.Header.Set(regionSetHeader, regionSet)
+	if ctx.credValues.SessionToken != "" {
+		ctx.Request.Header.Set("X-Amz-Security-Token", ctx.credValues.SessionToken)
+	}
+}
+
+// SignRequestHandler is a named request handler the SDK will use to sign
+// service client request with using the SigV4a signature.
+var SignRequestHandler = request.NamedHandler{
+	Name: "v4a.SignRequestHandler", Fn: SignSDKRequest,
+}
+
+// SignSDKRequest signs an AWS request with the SigV4a signature, valid in the
+// request's signing region. This request handler should only be used with
+// the SDK's built in service client's API operation requests.
+//
+// If the credentials of the request's config are set to
+// credentials.AnonymousCredentials the request will not be signed.
+//
+// Requests with the request.WithStreamingPayload option are signed with the
+// SHA256 of the whole payload, rather than in chunks.
+func SignSDKRequest(req *request.Request) {
+	signSDKRequestWithCurrTime(req, time.Now)
+}
+
+// BuildNamedHandler will build a generic handler for signing.
+func BuildNamedHandler(name string, opts ...func(*Signer)) request.NamedHandler {
+	return request.NamedHandler{
+		Name: name,
+		Fn: func(req *request.Request) {
+			signSDKRequestWithCurrTime(req, time.Now, opts...)
+		},
+	}
+}
+
+func signSDKRequestWithCurrTime(req *request.Request, curTimeFn func() time.Time, opts ...func(*Signer)) {
+	// If the request does not need to be signed ignore the signing of the
+	// request if the AnonymousCredentials object is used.
+	if req.Config.Credentials == credentials.AnonymousCredentials {
+		return
+	}
+
+	region := req.ClientInfo.SigningRegion
+	if region == "" {
+		region = aws.StringValue(req.Config.Region)
+	}
+
+	name := req.ClientInfo.SigningName
+	if name == "" {
+		name = req.ClientInfo.ServiceName
+	}
+
+	// Unsigned payloads are only protected from tampering by TLS.
+	if req.UnsignedPayload && req.HTTPRequest.URL.Scheme != "https" {
+		req.Error = awserr.New(v4.ErrCodeInsecureUnsignedPayload,
+			fmt.Sprintf("unsigned payload requires an HTTPS endpoint, got %s scheme",
+				req.HTTPRequest.URL.Scheme), nil)
+		req.SignedHeaderVals = nil
+		return
+	}
+
+	v4a := NewSigner(req.Config.Credentials, func(v4a *Signer) {
+		v4a.Debug = req.Config.LogLevel.Value()
+		v4a.Logger = req.Config.Logger
+		v4a.UnsignedPayload = req.UnsignedPayload
+		v4a.currentTimeFn = curTimeFn
+		v4a.DisableURIPathEscaping = signingEscaping(req.ClientInfo, name) == metadata.RawPathSigning
+		// Prevents setting the HTTPRequest's Body. Since the Body could be
+		// wrapped in a custom io.Closer that we do not want to be stompped
+		// on top of by the signer.
+		v4a.DisableRequestBodyOverwrite = true
+	})
+
+	for _, opt := range opts {
+		opt(v4a)
+	}
+
+	signingTime := req.Time
+	if !req.LastSignedAt.IsZero() {
+		signingTime = req.LastSignedAt
+	}
+
+	signedHeaders, err := v4a.signWithBody(req.Context(), req.HTTPRequest, req.GetBody(),
+		name, region, req.ExpireTime, signingTime,
+	)
+	if err != nil {
+		req.Error = err
+		req.SignedHeaderVals = nil
+		return
+	}
+
+	req.SignedHeaderVals = signedHeaders
+	req.LastSignedAt = curTimeFn()
+}
+
+// signingEscaping returns how the URI path of the client's requests is
+// escaped when signed, the same as the V4 signer.
+func signingEscaping(info metadata.ClientInfo, signingName string) metadata.SigningEscaping {
+	if len(info.SigningEscaping) != 0 {
+		return info.SigningEscaping
+	}
+	if signingName == "s3" {
+		return metadata.RawPathSigning
+	}
+	return metadata.EscapedPathSigning
+}
+
+const logSignInfoMsg = `DEBUG: Request Signature:
+---[ CANONICAL STRING  ]-----------------------------
+%s
+---[ STRING TO SIGN ]--------------------------------
+%s%s
+-----------------------------------------------------`
+const logSignedURLMsg = `
+---[ SIGNED URL ]------------------------------------
+%s`
+
+func (v4a *Signer) logSigningInfo(ctx *signingCtx) {
+	signedURLMsg := ""
+	if ctx.isPresign {
+		signedURLMsg = fmt.Sprintf(logSignedURLMsg, ctx.Request.URL.String())
+	}
+	msg := fmt.Sprintf(logSignInfoMsg, ctx.canonicalString, ctx.stringToSign, signedURLMsg)
+	v4a.Logger.Log(msg)
+}
+
+func (ctx *signingCtx) build() error {
+	ctx.buildTime()             // no depends
+	ctx.buildCredentialString() // no depends
+
+	ctx.buildBodyDigest()
+
+	ctx.buildCanonicalHeaders(ctx.Request.Header)
+	ctx.buildCanonicalString() // depends on canon headers / signed headers
+	ctx.buildStringToSign()    // depends on canon string
+	if err := ctx.buildSignature(); err != nil {
+		return err
+	}
+
+	if ctx.isPresign {
+		ctx.Request.URL.RawQuery += "&X-Amz-Signature=" + ctx.signature
+	} else {
+		parts := []string{
+			authHeaderPrefix + " Credential=" + ctx.credValues.AccessKeyID + "/" + ctx.credentialString,
+			"SignedHeaders=" + ctx.signedHeaders,
+			"Signature=" + ctx.signature,
+		}
+		ctx.Request.Header.Set("Authorization", strings.Join(parts, ", "))
+	}
+
+	return nil
+}
+
+func (ctx *signingCtx) buildTime() {
+	ctx.formattedTime = ctx.Time.UTC().Format(timeFormat)
+	ctx.formattedShortTime = ctx.Time.UTC().Format(shortTimeFormat)
+
+	if ctx.isPresign {
+		duration := int64(ctx.ExpireTime / time.Second)
+		ctx.Query.Set("X-Amz-Date", ctx.formattedTime)
+		ctx.Query.Set("X-Amz-Expires", strconv.FormatInt(duration, 10))
+	} else {
+		ctx.Request.Header.Set("X-Amz-Date", ctx.formattedTime)
+	}
+}
+
+// buildCredentialString builds the credential scope of the signature, which
+// unlike the V4 signature's scope does not include the region.
+func (ctx *signingCtx) buildCredentialString() {
+	ctx.credentialString = strings.Join([]string{
+		ctx.formattedShortTime,
+		ctx.ServiceName,
+		"aws4_request",
+	}, "/")
+
+	if ctx.isPresign {
+		ctx.Query.Set("X-Amz-Credential", ctx.credValues.AccessKeyID+"/"+ctx.credentialString)
+	}
+}
+
+func (ctx *signingCtx) buildCanonicalHeaders(header http.Header) {
+	var headers []string
+	headers = append(headers, "host")
+	for k, v := range header {
+		if _, ok := ignoredHeaders[http.CanonicalHeaderKey(k)]; ok {
+			continue // ignored header
+		}
+		if ctx.SignedHeaderVals == nil {
+			ctx.SignedHeaderVals = make(http.Header)
+		}
+
+		lowerCaseKey := strings.ToLower(k)
+		if _, ok := ctx.SignedHeaderVals[lowerCaseKey]; ok {
+			// include additional values
+			ctx.SignedHeaderVals[lowerCaseKey] = append(ctx.SignedHeaderVals[lowerCaseKey], v...)
+			continue
+		}
+
+		headers = append(headers, lowerCaseKey)
+		ctx.SignedHeaderVals[lowerCaseKey] = v
+	}
+	sort.Strings(headers)
+
+	ctx.signedHeaders = strings.Join(headers, ";")
+
+	if ctx.isPresign {
+		ctx.Query.Set("X-Amz-SignedHeaders", ctx.signedHeaders)
+	}
+
+	headerValues := make([]string, len(headers))
+	for i, k := range headers {
+		if k == "host" {
+			if ctx.Request.Host != "" {
+				headerValues[i] = "host:" + ctx.Request.Host
+			} else {
+				headerValues[i] = "host:" + ctx.Request.URL.Host
+			}
+		} else {
+			headerValues[i] = k + ":" + stripExcessSpaces(strings.Join(ctx.SignedHeaderVals[k], ","))
+		}
+	}
+	ctx.canonicalHeaders = strings.Join(headerValues, "\n")
+}
+
+func (ctx *signingCtx) buildCanonicalString() {
+	ctx.Request.URL.RawQuery = strings.Replace(ctx.Query.Encode(), "+", "%20", -1)
+
+	uri := getURIPath(ctx.Request.URL)
+
+	if !ctx.DisableURIPathEscaping {
+		uri = rest.EscapePath(normalizeURIPath(uri), false)
+	}
+
+	ctx.canonicalString = strings.Join([]string{
+		ctx.Request.Method,
+		uri,
+		ctx.Request.URL.RawQuery,
+		ctx.canonicalHeaders + "\n",
+		ctx.signedHeaders,
+		ctx.bodyDigest,
+	}, "\n")
+}
+
+func (ctx *signingCtx) buildStringToSign() {
+	ctx.stringToSign = strings.Join([]string{
+		authHeaderPrefix,
+		ctx.formattedTime,
+		ctx.credentialString,
+		hex.EncodeToString(makeSha256([]byte(ctx.canonicalString))),
+	}, "\n")
+}
+
+// buildSignature signs the SHA256 of the string to sign with the private key
+// derived from the credentials. The signature is the hex encoded ASN.1 DER
+// encoding of the ECDSA signature.
+func (ctx *signingCtx) buildSignature() error {
+	r, s, err := ecdsa.Sign(rand.Reader, ctx.privateKey, makeSha256([]byte(ctx.stringToSign)))
+	if err != nil {
+		return awserr.New(ErrCodeSignature, "failed to sign the request", err)
+	}
+
+	signature, err := asn1.Marshal(ecdsaSignature{R: r, S: s})
+	if err != nil {
+		return awserr.New(ErrCodeSignature, "failed to encode the request's signature", err)
+	}
+	ctx.signature = hex.EncodeToString(signature)
+
+	return nil
+}
+
+// ecdsaSignature is the ASN.1 structure of ECDSA signatures.
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+func (ctx *signingCtx) buildBodyDigest() {
+	hash := ctx.Request.Header.Get("X-Amz-Content-Sha256")
+	if hash == "" {
+		if ctx.unsignedPayload || (ctx.isPresign && ctx.ServiceName == "s3") {
+			hash = "UNSIGNED-PAYLOAD"
+		} else if ctx.Body == nil {
+			hash = emptyStringSHA256
+		} else {
+			hash = hex.EncodeToString(makeSha256Reader(ctx.Body))
+		}
+		// The header of presigned requests would have to be included in the
+		// request made with the presigned URL.
+		if !ctx.isPresign && (ctx.unsignedPayload || ctx.ServiceName == "s3") {
+			ctx.Request.Header.Set("X-Amz-Content-Sha256", hash)
+		}
+	}
+	ctx.bodyDigest = hash
+}
+
+// isRequestSigned returns if the request is currently signed or presigned
+func (ctx *signingCtx) isRequestSigned() bool {
+	if ctx.isPresign && ctx.Query.Get("X-Amz-Signature") != "" {
+		return true
+	}
+	if ctx.Request.Header.Get("Authorization") != "" {
+		return true
+	}
+
+	return false
+}
+
+// unsign removes signing flags for both signed and presigned requests.
+func (ctx *signingCtx) removePresign() {
+	ctx.Query.Del("X-Amz-Algorithm")
+	ctx.Query.Del("X-Amz-Signature")
+	ctx.Query.Del("X-Amz-Security-Token")
+	ctx.Query.Del("X-Amz-Date")
+	ctx.Query.Del("X-Amz-Expires")
+	ctx.Query.Del("X-Amz-Credential")
+	ctx.Query.Del("X-Amz-SignedHeaders")
+	ctx.Query.Del(regionSetHeader)
+}
+
+func makeSha256(data []byte) []byte {
+	hash := sha256.New()
+	hash.Write(data)
+	return hash.Sum(nil)
+}
+
+func makeSha256Reader(reader io.ReadSeeker) []byte {
+	hash := sha256.New()
+	start, _ := reader.Seek(0, 1)
+	defer reader.Seek(start, 0)
+
+	io.Copy(hash, reader)
+	return hash.Sum(nil)
+}
+
+// stripExcessSpaces returns the header value with its leading and trailing
+// spaces trimmed, and its sequential spaces replaced by a single space.
+func stripExcessSpaces(v string) string {
+	return strings.Join(strings.Fields(v), " ")
+}
@@ -0,0 +1,318 @@
+package v4a
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+)
+
+// The credentials, and public key of the credentials, of the SigV4a test
+// suite.
+var (
+	testSuiteCreds = credentials.NewStaticCredentials(
+		"AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "")
+
+	testSuitePublicKey = func() *ecdsa.PublicKey {
+		x, _ := new(big.Int).SetString("b6618f6a65740a99e650b33b6b4b5bd0d43b176d721a3edfea7e7d2d56d936b1", 16)
+		y, _ := new(big.Int).SetString("865ed22a7eadc9c5cb9d2cbaca1b3699139fedc5043dc6661864218330c8e518", 16)
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+	}()
+)
+
+// verifySignature returns if the hex encoded signature is a valid signature
+// of the string to sign with the public key.
+func verifySignature(t *testing.T, pub *ecdsa.PublicKey, stringToSign, signature string) bool {
+	b, err := hex.DecodeString(signature)
+	if err != nil {
+		t.Fatalf("expect hex encoded signature, got %v", err)
+	}
+	var sig ecdsaSignature
+	if _, err := asn1.Unmarshal(b, &sig); err != nil {
+		t.Fatalf("expect ASN.1 encoded signature, got %v", err)
+	}
+
+	return ecdsa.Verify(pub, makeSha256([]byte(stringToSign)), sig.R, sig.S)
+}
+
+// TestSign_GetVanilla verifies the get-vanilla request of the SigV4a test
+// suite.
+func TestSign_GetVanilla(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://example.amazonaws.com/", nil)
+	signTime := time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+
+	var logged string
+	signer := NewSigner(testSuiteCreds, func(v4a *Signer) {
+		v4a.Debug = aws.LogDebugWithSigning
+		v4a.Logger = aws.LoggerFunc(func(args ...interface{}) {
+			logged = args[0].(string)
+		})
+	})
+	if _, err := signer.Sign(req, nil, "service", "us-east-1", signTime); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expectCanonical := strings.Join([]string{
+		"GET",
+		"/",
+		"",
+		"host:example.amazonaws.com",
+		"x-amz-date:20150830T123600Z",
+		"x-amz-region-set:us-east-1",
+		"",
+		"host;x-amz-date;x-amz-region-set",
+		"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	}, "\n")
+	if !strings.Contains(logged, expectCanonical) {
+		t.Errorf("expect canonical request\n%s\nlogged, got\n%s", expectCanonical, logged)
+	}
+
+	expectStringToSign := strings.Join([]string{
+		"AWS4-ECDSA-P256-SHA256",
+		"20150830T123600Z",
+		"20150830/service/aws4_request",
+		hex.EncodeToString(makeSha256([]byte(expectCanonical))),
+	}, "\n")
+	if !strings.Contains(logged, expectStringToSign) {
+		t.Errorf("expect string to sign\n%s\nlogged, got\n%s", expectStringToSign, logged)
+	}
+
+	auth := req.Header.Get("Authorization")
+	prefix := "AWS4-ECDSA-P256-SHA256 Credential=AKIDEXAMPLE/20150830/service/aws4_request, " +
+		"SignedHeaders=host;x-amz-date;x-amz-region-set, Signature="
+	if !strings.HasPrefix(auth, prefix) {
+		t.Fatalf("expect authorization %s..., got %s", prefix, auth)
+	}
+	if !verifySignature(t, testSuitePublicKey, expectStringToSign, strings.TrimPrefix(auth, prefix)) {
+		t.Errorf("expect signature verified by the test suite's public key")
+	}
+}
+
+func TestSign_RegionSet(t *testing.T) {
+	req, _ := http.NewRequest("PUT", "https://bucket.s3.amazonaws.com/key", nil)
+
+	signer := NewSigner(testSuiteCreds, WithRegionSet("us-west-2", "us-east-1"))
+	signer.DisableURIPathEscaping = true
+	signedHeaders, err := signer.Sign(req, bytes.NewReader([]byte("abc")), "s3", "us-west-2", time.Now())
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "us-west-2,us-east-1", req.Header.Get("X-Amz-Region-Set"); e != a {
+		t.Errorf("expect %v region set, got %v", e, a)
+	}
+	if e, a := "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", req.Header.Get("X-Amz-Content-Sha256"); e != a {
+		t.Errorf("expect %v content SHA256, got %v", e, a)
+	}
+	if _, ok := signedHeaders["x-amz-region-set"]; !ok {
+		t.Errorf("expect region set signed, got %v", signedHeaders)
+	}
+	if req.Body == nil {
+		t.Errorf("expect body attached to the request")
+	}
+}
+
+func TestPresign(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://bucket.s3.amazonaws.com/a%20b", nil)
+	signTime := time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+
+	signer := NewSigner(credentials.NewStaticCredentials("AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "SESSION"),
+		WithRegionSet("*"))
+	signer.DisableURIPathEscaping = true
+	if _, err := signer.Presign(req, nil, "s3", "us-east-1", 5*time.Minute, signTime); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	q := req.URL.Query()
+	expectQuery := map[string]string{
+		"X-Amz-Algorithm":      "AWS4-ECDSA-P256-SHA256",
+		"X-Amz-Credential":     "AKIDEXAMPLE/20150830/s3/aws4_request",
+		"X-Amz-Date":           "20150830T123600Z",
+		"X-Amz-Expires":        "300",
+		"X-Amz-Region-Set":     "*",
+		"X-Amz-Security-Token": "SESSION",
+		"X-Amz-SignedHeaders":  "host",
+	}
+	for k, e := range expectQuery {
+		if a := q.Get(k); e != a {
+			t.Errorf("expect %s query %q, got %q", k, e, a)
+		}
+	}
+	if v := req.Header.Get("X-Amz-Content-Sha256"); len(v) != 0 {
+		t.Errorf("expect no content SHA256 header, got %v", v)
+	}
+
+	signature := q.Get("X-Amz-Signature")
+	q.Del("X-Amz-Signature")
+	canonical := strings.Join([]string{
+		"GET",
+		"/a%20b",
+		strings.Replace(q.Encode(), "+", "%20", -1),
+		"host:bucket.s3.amazonaws.com",
+		"",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	stringToSign := strings.Join([]string{
+		"AWS4-ECDSA-P256-SHA256",
+		"20150830T123600Z",
+		"20150830/s3/aws4_request",
+		hex.EncodeToString(makeSha256([]byte(canonical))),
+	}, "\n")
+	if !verifySignature(t, testSuitePublicKey, stringToSign, signature) {
+		t.Errorf("expect presigned signature verified by the test suite's public key")
+	}
+}
+
+func TestPresign_InvalidExpireTime(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://example.amazonaws.com/", nil)
+
+	signer := NewSigner(testSuiteCreds)
+	_, err := signer.Presign(req, nil, "service", "us-east-1", v4.PresignMaxExpireTime+time.Second, time.Now())
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := v4.ErrCodeInvalidPresignExpireTime, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+}
+
+func TestSignSDKRequest(t *testing.T) {
+	svc := client.New(
+		aws.Config{
+			Credentials: credentials.NewStaticCredentials("AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "SESSION"),
+			Region:      aws.String("us-west-2"),
+		},
+		metadata.ClientInfo{
+			ServiceName: "service",
+			Endpoint:    "https://endpoint",
+		},
+		request.Handlers{},
+	)
+	r := svc.NewRequest(&request.Operation{
+		Name:       "Operation",
+		HTTPMethod: "POST",
+		HTTPPath:   "/a/./b",
+	}, nil, nil)
+	r.SetStringBody("{}")
+
+	signTime := time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+	r.Time = signTime
+	var stringToSign string
+	r.Config.LogLevel = aws.LogLevel(aws.LogDebugWithSigning)
+	r.Config.Logger = aws.LoggerFunc(func(args ...interface{}) {
+		msg := args[0].(string)
+		stringToSign = msg[strings.Index(msg, "AWS4-ECDSA-P256-SHA256"):strings.LastIndex(msg, "\n")]
+	})
+
+	signSDKRequestWithCurrTime(r, func() time.Time { return signTime })
+	if r.Error != nil {
+		t.Fatalf("expect no error, got %v", r.Error)
+	}
+
+	expectHeaders := map[string]string{
+		"X-Amz-Region-Set":     "us-west-2",
+		"X-Amz-Security-Token": "SESSION",
+		"X-Amz-Date":           "20150830T123600Z",
+	}
+	for k, e := range expectHeaders {
+		if a := r.HTTPRequest.Header.Get(k); e != a {
+			t.Errorf("expect %s header %q, got %q", k, e, a)
+		}
+	}
+
+	auth := r.HTTPRequest.Header.Get("Authorization")
+	signature := auth[strings.Index(auth, "Signature=")+len("Signature="):]
+	if !verifySignature(t, testSuitePublicKey, stringToSign, signature) {
+		t.Errorf("expect signature verified by the test suite's public key")
+	}
+	if r.LastSignedAt.IsZero() {
+		t.Errorf("expect request's last signed time set")
+	}
+}
+
+func TestSignSDKRequest_PathEscaping(t *testing.T) {
+	cases := map[string]struct {
+		SigningName string
+		Escaping    metadata.SigningEscaping
+		ExpectPath  string
+	}{
+		"default escaped": {
+			SigningName: "service", ExpectPath: "/a/b%253Dc",
+		},
+		"s3 raw": {
+			SigningName: "s3", ExpectPath: "/a/./b%3Dc",
+		},
+		"metadata raw": {
+			SigningName: "service", Escaping: metadata.RawPathSigning, ExpectPath: "/a/./b%3Dc",
+		},
+	}
+
+	for name, c := range cases {
+		svc := client.New(
+			aws.Config{
+				Credentials: testSuiteCreds,
+				Region:      aws.String("us-west-2"),
+			},
+			metadata.ClientInfo{
+				ServiceName:     "service",
+				SigningName:     c.SigningName,
+				SigningEscaping: c.Escaping,
+				Endpoint:        "https://endpoint",
+			},
+			request.Handlers{},
+		)
+		r := svc.NewRequest(&request.Operation{
+			Name:       "Operation",
+			HTTPMethod: "GET",
+			HTTPPath:   "/",
+		}, nil, nil)
+		r.HTTPRequest.URL = &url.URL{Scheme: "https", Host: "endpoint", Path: "/a/./b=c", RawPath: "/a/./b%3Dc"}
+
+		var logged string
+		r.Config.LogLevel = aws.LogLevel(aws.LogDebugWithSigning)
+		r.Config.Logger = aws.LoggerFunc(func(args ...interface{}) {
+			logged = args[0].(string)
+		})
+
+		SignSDKRequest(r)
+		if r.Error != nil {
+			t.Fatalf("%s, expect no error, got %v", name, r.Error)
+		}
+		if e := "\nGET\n" + c.ExpectPath + "\n"; !strings.Contains(logged, e) {
+			t.Errorf("%s, expect canonical path %v, got\n%s", name, c.ExpectPath, logged)
+		}
+	}
+}
+
+func TestSignSDKRequest_AnonymousCredentials(t *testing.T) {
+	svc := client.New(
+		aws.Config{Credentials: credentials.AnonymousCredentials, Region: aws.String("us-west-2")},
+		metadata.ClientInfo{ServiceName: "service", Endpoint: "https://endpoint"},
+		request.Handlers{},
+	)
+	r := svc.NewRequest(&request.Operation{Name: "Operation", HTTPMethod: "GET", HTTPPath: "/"}, nil, nil)
+
+	SignSDKRequest(r)
+	if r.Error != nil {
+		t.Fatalf("expect no error, got %v", r.Error)
+	}
+	if v := r.HTTPRequest.Header.Get("Authorization"); len(v) != 0 {
+		t.Errorf("expect request not signed, got %v", v)
+	}
+}
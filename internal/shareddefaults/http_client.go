@@ -0,0 +1,29 @@
+package shareddefaults
+
+import (
+	"net"
+	"net/http"
+	"time"
+)
+
+// MetadataHTTPClient returns a new HTTP client for the instance metadata
+// service, and the container credentials endpoint.
+//
+// The requests are not sent through the proxy of the HTTP_PROXY, and
+// HTTPS_PROXY environment variables, because the endpoints are local to the
+// host. The timeouts are short so that credential chains fail fast when not
+// running on EC2 or ECS.
+func MetadataHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: nil,
+			Dial: (&net.Dialer{
+				Timeout:   1 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).Dial,
+			TLSHandshakeTimeout: 2 * time.Second,
+			MaxIdleConnsPerHost: 2,
+		},
+		Timeout: 5 * time.Second,
+	}
+}
@@ -0,0 +1,28 @@
+package shareddefaults_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/internal/shareddefaults"
+)
+
+func TestMetadataHTTPClient(t *testing.T) {
+	c := shareddefaults.MetadataHTTPClient()
+
+	if e, a := 5*time.Second, c.Timeout; e != a {
+		t.Errorf("expect %v timeout, got %v", e, a)
+	}
+	tr, ok := c.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("expect *http.Transport, got %T", c.Transport)
+	}
+	if tr.Proxy != nil {
+		t.Errorf("expect requests not to be proxied")
+	}
+
+	if c == shareddefaults.MetadataHTTPClient() {
+		t.Errorf("expect new client each call")
+	}
+}
@@ -73,6 +73,7 @@ type Metadata struct {
 	Protocol            string
 	UID                 string
 	EndpointsID         string
+	ServiceID           string
 
 	NoResolveEndpoint bool
 }
@@ -118,11 +119,45 @@ func (a *API) StructName() string {
 	return a.name
 }
 
+var serviceIDRegex = regexp.MustCompile(`[^a-zA-Z0-9 ]+`)
+
+// ServiceID returns the human readable identifier of the service, e.g.
+// "API Gateway". If the API model does not define the service's ID, it is
+// derived from the service's abbreviation or full name, without an "Amazon"
+// or "AWS" prefix.
+func (a *API) ServiceID() string {
+	if len(a.Metadata.ServiceID) != 0 {
+		return a.Metadata.ServiceID
+	}
+
+	name := a.Metadata.ServiceAbbreviation
+	if name == "" {
+		name = a.Metadata.ServiceFullName
+	}
+
+	name = strings.Replace(name, "Amazon", "", -1)
+	name = strings.Replace(name, "AWS", "", -1)
+	name = serviceIDRegex.ReplaceAllString(name, "")
+
+	return strings.Join(strings.Fields(name), " ")
+}
+
 // UseInitMethods returns if the service's init method should be rendered.
 func (a *API) UseInitMethods() bool {
 	return !a.NoInitMethods
 }
 
+// UseRawPathSigning returns if the URI path of the API's requests is signed
+// raw by the V4 signer, without escaping or normalizing the path, as S3
+// requires.
+func (a *API) UseRawPathSigning() bool {
+	name := a.Metadata.SigningName
+	if len(name) == 0 {
+		name = a.Metadata.EndpointPrefix
+	}
+	return name == "s3"
+}
+
 // NiceName returns the human friendly API name.
 func (a *API) NiceName() string {
 	if a.Metadata.ServiceAbbreviation != "" {
@@ -174,6 +209,28 @@ func (a *API) OperationHasOutputPlaceholder() bool {
 	return false
 }
 
+// EndpointDiscoveryOp returns the API's operation discovering the endpoints
+// of its other operations, or nil if the API has none.
+func (a *API) EndpointDiscoveryOp() *Operation {
+	for _, op := range a.OperationList() {
+		if op.IsEndpointDiscoveryOp {
+			return op
+		}
+	}
+	return nil
+}
+
+// HasEndpointDiscovery returns if the endpoint of any of the API's operations
+// is discovered.
+func (a *API) HasEndpointDiscovery() bool {
+	for _, op := range a.Operations {
+		if op.HasEndpointDiscovery() {
+			return true
+		}
+	}
+	return false
+}
+
 // ShapeNames returns a slice of names for each shape used by the API.
 func (a *API) ShapeNames() []string {
 	i, names := 0, make([]string, len(a.Shapes))
@@ -256,6 +313,10 @@ var tplAPI = template.Must(template.New("api").Parse(`
 
 {{ end }}
 
+{{ if .HasEndpointDiscovery }}{{ .EndpointDiscoveryGoCode }}{{ end }}
+
+{{ if .HasEventStreams }}{{ .EventStreamHelpersGoCode }}{{ end }}
+
 {{ range $_, $s := .ShapeList }}
 {{ if and $s.IsInternal (eq $s.Type "structure") }}{{ $s.GoCode }}{{ end }}
 
@@ -286,6 +347,32 @@ func (a *API) APIGoCode() string {
 			break
 		}
 	}
+	for _, op := range a.Operations {
+		if op.HasHostPrefix() {
+			a.imports["github.com/aws/aws-sdk-go/aws"] = true
+			a.imports["github.com/aws/aws-sdk-go/private/protocol"] = true
+			break
+		}
+	}
+
+	if a.HasEndpointDiscovery() {
+		a.imports["time"] = true
+		a.imports["github.com/aws/aws-sdk-go/aws"] = true
+		a.imports["github.com/aws/aws-sdk-go/aws/endpointdiscovery"] = true
+	}
+
+	if a.HasEventStreams() {
+		a.imports["bytes"] = true
+		a.imports["encoding/xml"] = true
+		a.imports["fmt"] = true
+		a.imports["io"] = true
+		a.imports["io/ioutil"] = true
+		a.imports["sync"] = true
+		a.imports["github.com/aws/aws-sdk-go/aws/awserr"] = true
+		a.imports["github.com/aws/aws-sdk-go/private/protocol/eventstream"] = true
+		a.imports["github.com/aws/aws-sdk-go/private/protocol/xml/xmlutil"] = true
+		a.imports["github.com/aws/aws-sdk-go/private/protocol/"+a.ProtocolPackage()] = true
+	}
 
 	var buf bytes.Buffer
 	err := tplAPI.Execute(&buf, a)
@@ -297,6 +384,42 @@ func (a *API) APIGoCode() string {
 	return code
 }
 
+// tplEndpointDiscovery defines the template for the method of the client
+// discovering the endpoints of its operations.
+var tplEndpointDiscovery = template.Must(template.New("endpointDiscovery").Parse(`
+// discoverEndpoint discovers the endpoint of the request's operation with the
+// {{ .ExportedName }} operation, sent with the request's Config.
+func (c *{{ .API.StructName }}) discoverEndpoint(ctx aws.Context, r *request.Request) (endpointdiscovery.Endpoint, error) {
+	output, err := c.{{ .ExportedName }}WithContext(ctx, &{{ .InputRef.GoTypeElem }}{}, func(req *request.Request) {
+		req.Config = r.Config
+	})
+	if err != nil {
+		return endpointdiscovery.Endpoint{}, err
+	}
+
+	var endpoint endpointdiscovery.Endpoint
+	for _, e := range output.Endpoints {
+		if e != nil && e.Address != nil {
+			endpoint.Address = *e.Address
+			endpoint.CachePeriod = time.Duration(aws.Int64Value(e.CachePeriodInMinutes)) * time.Minute
+			break
+		}
+	}
+	return endpoint, nil
+}
+`))
+
+// EndpointDiscoveryGoCode renders the method of the client discovering the
+// endpoints of the API's operations.
+func (a *API) EndpointDiscoveryGoCode() string {
+	var buf bytes.Buffer
+	if err := tplEndpointDiscovery.Execute(&buf, a.EndpointDiscoveryOp()); err != nil {
+		panic(err)
+	}
+
+	return strings.TrimSpace(buf.String())
+}
+
 var noCrossLinkServices = map[string]struct{}{
 	"apigateway":        {},
 	"budgets":           {},
@@ -402,6 +525,13 @@ var tplService = template.Must(template.New("service").Funcs(template.FuncMap{
 		}
 		return fmt.Sprintf("%q", a.Metadata.EndpointsID)
 	},
+	"ServiceIDValue": func(a *API) string {
+		if a.NoConstServiceNames {
+			return fmt.Sprintf("%q", a.ServiceID())
+		}
+
+		return "ServiceID"
+	},
 	"EndpointsIDValue": func(a *API) string {
 		if a.NoConstServiceNames {
 			return fmt.Sprintf("%q", a.Metadata.EndpointPrefix)
@@ -418,6 +548,9 @@ var tplService = template.Must(template.New("service").Funcs(template.FuncMap{
 // modify mutate any of the struct's properties though.
 type {{ .StructName }} struct {
 	*client.Client
+	{{- if .HasEndpointDiscovery }}
+	endpointCache *endpointdiscovery.Cache
+	{{- end }}
 }
 
 {{ if .UseInitMethods }}// Used for custom client initialization logic
@@ -433,6 +566,7 @@ var initRequest func(*request.Request)
 const (
 	ServiceName = "{{ .Metadata.EndpointPrefix }}" // Service endpoint prefix API calls made to.
 	EndpointsID = {{ EndpointsIDConstValue . }} // Service ID for Regions and Endpoints metadata.
+	ServiceID = "{{ .ServiceID }}" // ServiceID is a unique identifier of a specific service.
 )
 {{- end }}
 
@@ -457,25 +591,29 @@ func New(p client.ConfigProvider, cfgs ...*aws.Config) *{{ .StructName }} {
 	{{- else -}}
 		c := p.ClientConfig({{ EndpointsIDValue . }}, cfgs...)
 	{{- end }}
+	{{- if .Metadata.SigningName }}
+		if c.SigningNameDerived || len(c.SigningName) == 0 {
+			c.SigningName = "{{ .Metadata.SigningName }}"
+		}
+	{{- end }}
 	return newClient(*c.Config, c.Handlers, c.Endpoint, c.SigningRegion, c.SigningName)
 }
 
 // newClient creates, initializes and returns a new service client instance.
 func newClient(cfg aws.Config, handlers request.Handlers, endpoint, signingRegion, signingName string) *{{ .StructName }} {
-	{{- if .Metadata.SigningName }}
-		if len(signingName) == 0 {
-			signingName = "{{ .Metadata.SigningName }}"
-		}
-	{{- end }}
     svc := &{{ .StructName }}{
     	Client: client.New(
     		cfg,
     		metadata.ClientInfo{
 			ServiceName: {{ ServiceNameValue . }},
+			ServiceID: {{ ServiceIDValue . }},
 			SigningName: signingName,
 			SigningRegion: signingRegion,
 			Endpoint:     endpoint,
 			APIVersion:   "{{ .Metadata.APIVersion }}",
+			{{- if .UseRawPathSigning }}
+				SigningEscaping: metadata.RawPathSigning,
+			{{- end }}
 			{{ if .Metadata.JSONVersion -}}
 				JSONVersion:  "{{ .Metadata.JSONVersion }}",
 			{{- end }}
@@ -485,6 +623,9 @@ func newClient(cfg aws.Config, handlers request.Handlers, endpoint, signingRegio
     		},
     		handlers,
     	),
+	{{- if .HasEndpointDiscovery }}
+		endpointCache: endpointdiscovery.NewCache(),
+	{{- end }}
     }
 
 	// Handlers
@@ -548,6 +689,9 @@ func (a *API) ServiceGoCode() string {
 		a.imports["github.com/aws/aws-sdk-go/aws/signer/v4"] = true
 	}
 	a.imports["github.com/aws/aws-sdk-go/private/protocol/"+a.ProtocolPackage()] = true
+	if a.HasEndpointDiscovery() {
+		a.imports["github.com/aws/aws-sdk-go/aws/endpointdiscovery"] = true
+	}
 
 	var buf bytes.Buffer
 	err := tplService.Execute(&buf, a)
@@ -559,6 +703,40 @@ func (a *API) ServiceGoCode() string {
 	return code
 }
 
+var tplSessionServiceIDs = template.Must(template.New("sessionServiceIDs").Parse(`
+// serviceIDs are the IDs of the services, e.g. "SES", keyed by the service's
+// ID in the endpoints model, e.g. "email". Services may share the same
+// endpoints ID.
+var serviceIDs = map[string][]string{
+{{- range $id, $svcIDs := . }}
+	{{ printf "%q" $id }}: { {{- range $i, $v := $svcIDs }}{{ if $i }}, {{ end }}{{ printf "%q" $v }}{{ end -}} },
+{{- end }}
+}
+`))
+
+// SessionServiceIDsGoCode renders the session package's table of the
+// service IDs of the APIs, keyed by the APIs' endpoints ID.
+func SessionServiceIDsGoCode(apis []*API) string {
+	ids := map[string][]string{}
+	for _, a := range apis {
+		id := a.Metadata.EndpointsID
+		if a.NoConstServiceNames {
+			id = a.Metadata.EndpointPrefix
+		}
+		ids[id] = append(ids[id], a.ServiceID())
+	}
+	for _, svcIDs := range ids {
+		sort.Strings(svcIDs)
+	}
+
+	var buf bytes.Buffer
+	if err := tplSessionServiceIDs.Execute(&buf, ids); err != nil {
+		panic(err)
+	}
+
+	return buf.String()
+}
+
 // ExampleGoCode renders service example code. Returning it as a string.
 func (a *API) ExampleGoCode() string {
 	exs := []string{}
@@ -679,6 +857,7 @@ func (a *API) NewAPIGoCodeWithPkgName(cfg string) string {
 func (a *API) addShapeValidations() {
 	for _, o := range a.Operations {
 		resolveShapeValidations(o.InputRef.Shape)
+		resolveShapeExtendedValidations(o.InputRef.Shape)
 	}
 }
 
@@ -745,6 +924,93 @@ func resolveShapeValidations(s *Shape, ancestry ...*Shape) {
 	ancestry = ancestry[:len(ancestry)-1]
 }
 
+// Updates the source shape and all nested shapes with the extended
+// validations that could possibly be needed, such as maximum, pattern, and
+// enum constraints.
+func resolveShapeExtendedValidations(s *Shape, ancestry ...*Shape) {
+	for _, a := range ancestry {
+		if a == s {
+			return
+		}
+	}
+
+	children := []string{}
+	for _, name := range s.MemberNames() {
+		ref := s.MemberRefs[name]
+		if ref.JSONValue || ref.Shape.Streaming {
+			continue
+		}
+
+		for _, typ := range extendedValidationTypes(ref.Shape) {
+			if !s.ExtendedValidations.Has(ref, typ) {
+				s.ExtendedValidations = append(s.ExtendedValidations, ShapeValidation{
+					Name: name, Ref: ref, Type: typ,
+				})
+			}
+		}
+
+		switch ref.Shape.Type {
+		case "map", "list", "structure":
+			children = append(children, name)
+		}
+	}
+
+	ancestry = append(ancestry, s)
+	for _, name := range children {
+		ref := s.MemberRefs[name]
+		nestedShape := ref.Shape.NestedShape()
+
+		if len(nestedShape.ExtendedValidations) == 0 {
+			resolveShapeExtendedValidations(nestedShape, ancestry...)
+		}
+		if len(nestedShape.ExtendedValidations) == 0 {
+			continue
+		}
+
+		if !s.ExtendedValidations.Has(ref, ShapeValidationNestedExtended) {
+			s.ExtendedValidations = append(s.ExtendedValidations, ShapeValidation{
+				Name: name, Ref: ref, Type: ShapeValidationNestedExtended,
+			})
+		}
+	}
+	ancestry = ancestry[:len(ancestry)-1]
+}
+
+// maxSafeInteger is the largest integer a float64 maximum can represent
+// exactly, larger maximums of lengths and integers are not validated.
+const maxSafeInteger = 1 << 53
+
+// extendedValidationTypes returns the extended validations the shape's
+// constraints need.
+func extendedValidationTypes(s *Shape) []ShapeValidationType {
+	var types []ShapeValidationType
+
+	switch s.Type {
+	case "list", "map", "blob", "string", "integer", "long":
+		if s.Max != 0 && s.Max < maxSafeInteger {
+			types = append(types, ShapeValidationMaxVal)
+		}
+	case "float", "double":
+		if s.Max != 0 {
+			types = append(types, ShapeValidationMaxVal)
+		}
+	}
+
+	if s.Type == "string" {
+		if len(s.Enum) != 0 {
+			types = append(types, ShapeValidationEnum)
+		} else if len(s.Pattern) != 0 {
+			// Patterns using syntax Go does not support cannot be
+			// validated client-side.
+			if _, err := regexp.Compile(s.Pattern); err == nil {
+				types = append(types, ShapeValidationPattern)
+			}
+		}
+	}
+
+	return types
+}
+
 // A tplAPIErrors is the top level template for the API
 var tplAPIErrors = template.Must(template.New("api").Parse(`
 const (
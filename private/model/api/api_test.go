@@ -52,3 +52,44 @@ func TestStructNameForExceptions(t *testing.T) {
 		t.Errorf("API struct name should have been %s, but received %s", "ConfigService", a.StructName())
 	}
 }
+
+func TestServiceID(t *testing.T) {
+	cases := map[string]struct {
+		Metadata Metadata
+		Expect   string
+	}{
+		"modeled": {
+			Metadata: Metadata{
+				ServiceID:           "Modeled ID",
+				ServiceAbbreviation: "Amazon SES",
+			},
+			Expect: "Modeled ID",
+		},
+		"abbreviation": {
+			Metadata: Metadata{
+				ServiceFullName:     "Amazon Simple Email Service",
+				ServiceAbbreviation: "Amazon SES",
+			},
+			Expect: "SES",
+		},
+		"full name": {
+			Metadata: Metadata{
+				ServiceFullName: "Amazon API Gateway",
+			},
+			Expect: "API Gateway",
+		},
+		"special characters": {
+			Metadata: Metadata{
+				ServiceFullName: "AWS Service (Name)-100",
+			},
+			Expect: "Service Name100",
+		},
+	}
+
+	for name, c := range cases {
+		a := API{Metadata: c.Metadata}
+		if e, a := c.Expect, a.ServiceID(); e != a {
+			t.Errorf("%s, expect %q service ID, got %q", name, e, a)
+		}
+	}
+}
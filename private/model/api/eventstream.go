@@ -0,0 +1,391 @@
+// +build codegen
+
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+// eventStreamMemberName is the name the event stream member of an
+// operation's output is renamed to.
+const eventStreamMemberName = "EventStream"
+
+// setupEventStreams updates the shapes of the operations whose response is an
+// event stream. The output's event stream member is renamed to EventStream,
+// and is read with the event stream's reader instead of being unmarshaled
+// from the response's body. Each event's payload is set to the event's
+// payload member.
+func (a *API) setupEventStreams() {
+	for _, op := range a.OperationList() {
+		output := op.OutputRef.Shape
+		for _, name := range output.MemberNames() {
+			ref := output.MemberRefs[name]
+			if !ref.Shape.IsEventStream {
+				continue
+			}
+
+			switch a.Metadata.Protocol {
+			case "rest-xml":
+			default:
+				panic(fmt.Sprintf("%s event stream %s, %s protocol event streams are not supported",
+					op.ExportedName, ref.ShapeName, a.Metadata.Protocol))
+			}
+
+			delete(output.MemberRefs, name)
+			output.MemberRefs[eventStreamMemberName] = ref
+			if output.Payload == name {
+				output.Payload = ""
+			}
+			if len(ref.Documentation) == 0 {
+				ref.Documentation = docstring(eventStreamMemberName + " reads the events of the response's event stream. The " +
+					eventStreamMemberName + " must be closed once the caller is done reading its events.")
+			}
+		}
+	}
+
+	for _, s := range a.Shapes {
+		if !s.IsEvent {
+			continue
+		}
+		for _, name := range s.MemberNames() {
+			if s.MemberRefs[name].IsEventPayload {
+				s.Payload = name
+			}
+		}
+	}
+}
+
+// HasEventStreams returns if the response of any of the API's operations is
+// an event stream.
+func (a *API) HasEventStreams() bool {
+	for _, op := range a.Operations {
+		if op.HasEventStream() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasEventStream returns if the Operation's response is an event stream.
+func (o *Operation) HasEventStream() bool {
+	return o.OutputRef.Shape.EventStreamRef() != nil
+}
+
+// EventStreamHandler returns the code to replace the request's unmarshal
+// handler with the handler reading the output's event stream.
+func (o *Operation) EventStreamHandler() string {
+	buf := bytes.NewBuffer(nil)
+
+	fmt.Fprintf(buf, "req.Handlers.Unmarshal.Remove(%s.UnmarshalHandler)\n", o.API.ProtocolPackage())
+	buf.WriteString("req.Handlers.Unmarshal.PushBackNamed(request.NamedHandler{\n")
+	fmt.Fprintf(buf, "Name: %q,\n", "awssdk."+o.API.PackageName()+"."+o.ExportedName+"EventStreamHandler")
+	buf.WriteString("Fn: output.unmarshalEventStream,\n")
+	buf.WriteString("})\n")
+
+	return buf.String()
+}
+
+// EventStreamRef returns the reference to the shape's event stream member, or
+// nil if the shape does not have one.
+func (s *Shape) EventStreamRef() *ShapeRef {
+	if ref, ok := s.MemberRefs[eventStreamMemberName]; ok && ref.Shape.IsEventStream {
+		return ref
+	}
+	return nil
+}
+
+// An eventStreamEvent is an event of an event stream, and the member of the
+// event's message payload.
+type eventStreamEvent struct {
+	// Type is the event type of the event's messages.
+	Type  string
+	Shape *Shape
+
+	PayloadName string
+	PayloadRef  *ShapeRef
+}
+
+// Events returns the events of the event stream shape, in the order of the
+// stream's members.
+func (s *Shape) Events() []eventStreamEvent {
+	events := make([]eventStreamEvent, 0, len(s.MemberRefs))
+	for _, name := range s.MemberNames() {
+		ref := s.MemberRefs[name]
+		event := eventStreamEvent{
+			Type:  refLocationName(name, ref),
+			Shape: ref.Shape,
+		}
+		if p := ref.Shape.Payload; len(p) != 0 {
+			event.PayloadName = p
+			event.PayloadRef = ref.Shape.MemberRefs[p]
+
+			switch t := event.PayloadRef.Shape.Type; t {
+			case "blob", "structure":
+			default:
+				panic(fmt.Sprintf("%s event %s, unsupported %s payload, %s",
+					s.ShapeName, ref.ShapeName, t, p))
+			}
+		}
+		events = append(events, event)
+	}
+
+	return events
+}
+
+// EndEvent returns the event ending the event stream, or nil if the stream
+// does not have an end event. A stream with an end event is truncated if it
+// ends without the event.
+func (s *Shape) EndEvent() *Shape {
+	for _, e := range s.Events() {
+		if e.Type == "End" {
+			return e.Shape
+		}
+	}
+	return nil
+}
+
+var eventStreamShapeTmpl = template.Must(template.New("eventStreamShapeTmpl").Parse(`
+{{ $name := $.ShapeName -}}
+{{ $events := $.Events -}}
+
+// {{ $name }}Event is an event of the
+// {{ $name }} event stream. The event is one of the
+// following types:
+//
+{{ range $_, $e := $events -}}
+//     * *{{ $e.Shape.ShapeName }}
+{{ end -}}
+type {{ $name }}Event interface {
+	event{{ $name }}()
+}
+
+{{ range $_, $e := $events -}}
+func (*{{ $e.Shape.ShapeName }}) event{{ $name }}() {}
+
+{{ end -}}
+
+// {{ $name }}Reader reads the events of the
+// {{ $name }} event stream of a response.
+//
+// The events are sent on the Events channel, which is closed once the stream
+// ends, or fails. Err returns the error of the stream, if any, once the Events
+// channel is closed. The reader must be closed once the caller is done reading
+// the events, to release the response's connection.
+type {{ $name }}Reader struct {
+	body   io.ReadCloser
+	events chan {{ $name }}Event
+
+	// done is closed when the reader is closed, and readDone when the
+	// reader's read loop returns.
+	done     chan struct{}
+	readDone chan struct{}
+
+	m   sync.Mutex
+	err error
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// new{{ $name }}Reader returns a reader of the event
+// stream of the body, reading the stream's events until the stream ends or
+// the reader is closed.
+func new{{ $name }}Reader(body io.ReadCloser) *{{ $name }}Reader {
+	r := &{{ $name }}Reader{
+		body:     body,
+		events:   make(chan {{ $name }}Event),
+		done:     make(chan struct{}),
+		readDone: make(chan struct{}),
+	}
+	go r.readLoop()
+
+	return r
+}
+
+// Events returns the channel the events of the stream are sent on. The
+// channel is closed once the stream ends, fails, or the reader is closed.
+func (r *{{ $name }}Reader) Events() <-chan {{ $name }}Event {
+	return r.events
+}
+
+// Err returns the error of the event stream, nil if the stream has not
+// failed. The stream fails with the error message sent by the service, or if
+// its messages could not be decoded.
+{{- if $.EndEvent }}
+// The stream also fails with an error with the code
+// ErrCodeEventStreamTruncated if it ends without an {{ $.EndEvent.ShapeName }}.
+{{- end }}
+func (r *{{ $name }}Reader) Err() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	return r.err
+}
+
+// Close stops reading the events of the stream, and drains and closes the
+// response's body so that its connection can be reused. The events not read
+// are discarded. Close is safe to call multiple times.
+func (r *{{ $name }}Reader) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.done)
+		<-r.readDone
+
+		_, err := io.Copy(ioutil.Discard, r.body)
+		if cerr := r.body.Close(); err == nil {
+			err = cerr
+		}
+		r.closeErr = err
+	})
+
+	return r.closeErr
+}
+
+func (r *{{ $name }}Reader) readLoop() {
+	defer close(r.readDone)
+	defer close(r.events)
+
+	decoder := eventstream.NewDecoder(r.body)
+	for {
+		msg, err := decoder.Decode(nil)
+		if err == io.EOF {
+			{{- if $.EndEvent }}
+			r.setErr(awserr.New(ErrCodeEventStreamTruncated,
+				"event stream ended without an {{ $.EndEvent.ShapeName }}, results may be truncated", nil))
+			{{- end }}
+			return
+		} else if err != nil {
+			r.setErr(awserr.New(request.ErrCodeSerialization,
+				"failed to decode event stream message", err))
+			return
+		}
+
+		event, err := unmarshal{{ $name }}Event(msg)
+		if err != nil {
+			r.setErr(err)
+			return
+		}
+		if event == nil {
+			// Events unknown to the SDK are skipped.
+			continue
+		}
+
+		select {
+		case r.events <- event:
+		case <-r.done:
+			return
+		}
+		{{- if $.EndEvent }}
+
+		if _, ok := event.(*{{ $.EndEvent.ShapeName }}); ok {
+			return
+		}
+		{{- end }}
+	}
+}
+
+func (r *{{ $name }}Reader) setErr(err error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.err = err
+}
+
+// unmarshal{{ $name }}Event returns the event of the event
+// stream message, or the error of the message if it is an error or exception
+// message. Returns nil if the message's event type is not known.
+func unmarshal{{ $name }}Event(msg eventstream.Message) ({{ $name }}Event, error) {
+	if err := eventStreamMessageError(msg); err != nil {
+		return nil, err
+	}
+
+	switch eventType := eventStreamHeader(msg, ":event-type"); eventType {
+	{{- range $_, $e := $events }}
+	case {{ printf "%q" $e.Type }}:
+		{{- if not $e.PayloadRef }}
+		return &{{ $e.Shape.ShapeName }}{}, nil
+		{{- else if eq $e.PayloadRef.Shape.Type "blob" }}
+		return {{ printf "&%s{%s: msg.Payload}" $e.Shape.ShapeName $e.PayloadName }}, nil
+		{{- else }}
+		event := {{ printf "&%s{%s: &%s{}}" $e.Shape.ShapeName $e.PayloadName $e.PayloadRef.Shape.ShapeName }}
+		if err := unmarshalEventPayload(event.{{ $e.PayloadName }}, eventType, msg.Payload); err != nil {
+			return nil, err
+		}
+		return event, nil
+		{{- end }}
+	{{- end }}
+	default:
+		return nil, nil
+	}
+}
+`))
+
+// EventStreamGoCode renders the event interface and reader of the event
+// stream shape.
+func (s *Shape) EventStreamGoCode() string {
+	var buf bytes.Buffer
+	if err := eventStreamShapeTmpl.Execute(&buf, s); err != nil {
+		panic(fmt.Sprintf("failed to generate event stream shape %s, %v", s.ShapeName, err))
+	}
+
+	return buf.String()
+}
+
+var tplEventStreamHelpers = template.Must(template.New("eventStreamHelpers").Parse(`
+const (
+	// ErrCodeEventStreamTruncated is the error code of the error returned when
+	// an event stream ends without its end event. The events read may not
+	// include all of the results.
+	ErrCodeEventStreamTruncated = "EventStreamTruncatedError"
+)
+
+// eventStreamMessageError returns the error of the event stream message if
+// it is an error or exception message, nil if it is an event message.
+func eventStreamMessageError(msg eventstream.Message) error {
+	switch msgType := eventStreamHeader(msg, ":message-type"); msgType {
+	case "event":
+		return nil
+	case "error":
+		return awserr.New(eventStreamHeader(msg, ":error-code"),
+			eventStreamHeader(msg, ":error-message"), nil)
+	case "exception":
+		return awserr.New(eventStreamHeader(msg, ":exception-type"),
+			string(msg.Payload), nil)
+	default:
+		return awserr.New(request.ErrCodeSerialization,
+			fmt.Sprintf("unknown event stream message type, %q", msgType), nil)
+	}
+}
+
+// unmarshalEventPayload unmarshals the XML payload of the event into v.
+func unmarshalEventPayload(v interface{}, eventType string, payload []byte) error {
+	err := xmlutil.UnmarshalXML(v, xml.NewDecoder(bytes.NewReader(payload)), "")
+	if err != nil {
+		return awserr.New(request.ErrCodeSerialization,
+			fmt.Sprintf("failed to decode %s event", eventType), err)
+	}
+
+	return nil
+}
+
+// eventStreamHeader returns the value of the message's string header, empty
+// if the message does not have the header.
+func eventStreamHeader(msg eventstream.Message, name string) string {
+	if v, ok := msg.Headers.Get(name).(eventstream.StringValue); ok {
+		return string(v)
+	}
+	return ""
+}
+`))
+
+// EventStreamHelpersGoCode renders the helpers shared by the API's event
+// stream readers.
+func (a *API) EventStreamHelpersGoCode() string {
+	var buf bytes.Buffer
+	if err := tplEventStreamHelpers.Execute(&buf, a); err != nil {
+		panic(err)
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,117 @@
+// +build 1.6,codegen
+
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+const eventStreamTestModel = `{
+  "metadata": {"protocol": "rest-xml", "serviceAbbreviation": "Event", "serviceId": "Event"},
+  "operations": {
+    "Select": {
+      "name": "Select",
+      "http": {"method": "POST", "requestUri": "/"},
+      "input": {"shape": "SelectRequest"},
+      "output": {"shape": "SelectOutput"}
+    }
+  },
+  "shapes": {
+    "SelectRequest": {"type": "structure", "members": {}},
+    "SelectOutput": {
+      "type": "structure",
+      "members": {"Payload": {"shape": "SelectEventStream"}},
+      "payload": "Payload"
+    },
+    "SelectEventStream": {
+      "type": "structure",
+      "members": {
+        "Records": {"shape": "RecordsEvent"},
+        "Stats": {"shape": "StatsEvent"},
+        "End": {"shape": "EndEvent"}
+      },
+      "eventstream": true
+    },
+    "RecordsEvent": {
+      "type": "structure",
+      "members": {"Payload": {"shape": "Body", "eventpayload": true}},
+      "event": true
+    },
+    "StatsEvent": {
+      "type": "structure",
+      "members": {"Details": {"shape": "Stats", "eventpayload": true}},
+      "event": true
+    },
+    "EndEvent": {"type": "structure", "members": {}, "event": true},
+    "Stats": {"type": "structure", "members": {"Bytes": {"shape": "Long"}}},
+    "Body": {"type": "blob"},
+    "Long": {"type": "long"}
+  }
+}`
+
+func TestSetupEventStreams(t *testing.T) {
+	a := &API{}
+	a.AttachString(eventStreamTestModel)
+
+	op := a.Operations["Select"]
+	if !op.HasEventStream() {
+		t.Fatalf("expect operation to have event stream")
+	}
+	if !a.HasEventStreams() {
+		t.Errorf("expect API to have event streams")
+	}
+
+	output := op.OutputRef.Shape
+	if _, ok := output.MemberRefs["Payload"]; ok {
+		t.Errorf("expect event stream member to be renamed")
+	}
+	if e, a := "", output.Payload; e != a {
+		t.Errorf("expect %q output payload, got %q", e, a)
+	}
+	if e, a := "*SelectEventStreamReader", output.GoStructType("EventStream", output.EventStreamRef()); e != a {
+		t.Errorf("expect %v event stream type, got %v", e, a)
+	}
+
+	if e, a := "Payload", a.Shapes["RecordsEvent"].Payload; e != a {
+		t.Errorf("expect %q records event payload, got %q", e, a)
+	}
+	if e, a := "Details", a.Shapes["StatsEvent"].Payload; e != a {
+		t.Errorf("expect %q stats event payload, got %q", e, a)
+	}
+
+	stream := a.Shapes["SelectEventStream"]
+	if e, a := "EndEvent", stream.EndEvent().ShapeName; e != a {
+		t.Errorf("expect %v end event, got %v", e, a)
+	}
+
+	code := stream.GoCode()
+	for _, expect := range []string{
+		"type SelectEventStreamEvent interface",
+		"func (*RecordsEvent) eventSelectEventStream() {}",
+		"type SelectEventStreamReader struct",
+		"return &RecordsEvent{Payload: msg.Payload}, nil",
+		"event := &StatsEvent{Details: &Stats{}}",
+		"r.setErr(awserr.New(ErrCodeEventStreamTruncated,",
+		"if _, ok := event.(*EndEvent); ok {",
+	} {
+		if !strings.Contains(code, expect) {
+			t.Errorf("expect event stream code to contain %q, got\n%s", expect, code)
+		}
+	}
+}
+
+func TestOperationEventStreamHandler(t *testing.T) {
+	a := &API{}
+	a.AttachString(eventStreamTestModel)
+
+	expect := `req.Handlers.Unmarshal.Remove(restxml.UnmarshalHandler)
+req.Handlers.Unmarshal.PushBackNamed(request.NamedHandler{
+Name: "awssdk.event.SelectEventStreamHandler",
+Fn: output.unmarshalEventStream,
+})
+`
+	if e, a := expect, a.Operations["Select"].EventStreamHandler(); e != a {
+		t.Errorf("expect %q, got %q", e, a)
+	}
+}
@@ -60,6 +60,7 @@ func (a *API) Setup() {
 	}
 	a.updateTopLevelShapeReferences()
 	a.createInputOutputShapes()
+	a.setupEventStreams()
 	a.customizationPasses()
 
 	if !a.NoRemoveUnusedShapes {
@@ -22,9 +22,35 @@ type Operation struct {
 	OutputRef     ShapeRef   `json:"output"`
 	ErrorRefs     []ShapeRef `json:"errors"`
 	Paginator     *Paginator
-	Deprecated    bool   `json:"deprecated"`
-	AuthType      string `json:"authtype"`
-	imports       map[string]bool
+	Deprecated    bool           `json:"deprecated"`
+	AuthType      string         `json:"authtype"`
+	Endpoint      *EndpointTrait `json:"endpoint"`
+
+	// EndpointDiscovery is set if the endpoint of the Operation's requests
+	// is discovered with the API's endpoint discovery operation.
+	EndpointDiscovery *EndpointDiscoveryTrait `json:"endpointdiscovery"`
+
+	// IsEndpointDiscoveryOp is set if the Operation discovers the endpoints
+	// of the API's other operations.
+	IsEndpointDiscoveryOp bool `json:"endpointoperation"`
+
+	imports map[string]bool
+}
+
+// An EndpointTrait defines the endpoint customizations of an Operation.
+type EndpointTrait struct {
+	// HostPrefix is the template prepended to the endpoint's host, e.g.
+	// "{AccountId}.". Labels are substituted with the input members marked
+	// as host labels.
+	HostPrefix string `json:"hostPrefix"`
+}
+
+// An EndpointDiscoveryTrait defines the endpoint discovery of an Operation.
+type EndpointDiscoveryTrait struct {
+	// Required is set if the Operation's requests must be sent to a
+	// discovered endpoint, even if the client's endpoint discovery is not
+	// enabled.
+	Required bool `json:"required"`
 }
 
 // A HTTPInfo defines the method of HTTP request for the Operation.
@@ -64,6 +90,65 @@ func (o *Operation) GetSigner() string {
 	return buf.String()
 }
 
+// HasHostPrefix returns if the Operation's endpoint host is prefixed.
+func (o *Operation) HasHostPrefix() bool {
+	return o.Endpoint != nil && len(o.Endpoint.HostPrefix) != 0
+}
+
+// HostPrefixHandler returns the code to add the build handler prepending the
+// Operation's host prefix to the request endpoint's host.
+func (o *Operation) HostPrefixHandler() string {
+	buf := bytes.NewBuffer(nil)
+
+	fmt.Fprintf(buf, "req.Handlers.Build.PushBackNamed(protocol.NewHostPrefixHandler(%q, ", o.Endpoint.HostPrefix)
+
+	var labels []string
+	if o.HasInput() {
+		for _, name := range o.InputRef.Shape.MemberNames() {
+			if o.InputRef.Shape.MemberRefs[name].HostLabel {
+				labels = append(labels, name)
+			}
+		}
+	}
+	if len(labels) == 0 {
+		buf.WriteString("nil))\n")
+		return buf.String()
+	}
+
+	buf.WriteString("func() map[string]string {\n")
+	buf.WriteString("return map[string]string{\n")
+	for _, name := range labels {
+		fmt.Fprintf(buf, "%q: aws.StringValue(input.%s),\n", name, name)
+	}
+	buf.WriteString("}\n")
+	buf.WriteString("}))\n")
+
+	return buf.String()
+}
+
+// HasEndpointDiscovery returns if the endpoint of the Operation's requests is
+// discovered. Operations are only discovered if the API has an endpoint
+// discovery operation.
+func (o *Operation) HasEndpointDiscovery() bool {
+	return o.EndpointDiscovery != nil && o.API.EndpointDiscoveryOp() != nil
+}
+
+// EndpointDiscoveryHandler returns the code to add the handler discovering
+// the endpoint of the request to the request's handlers.
+func (o *Operation) EndpointDiscoveryHandler() string {
+	buf := bytes.NewBuffer(nil)
+
+	buf.WriteString("(&endpointdiscovery.Handler{\n")
+	buf.WriteString("Cache: c.endpointCache,\n")
+	buf.WriteString("Discover: c.discoverEndpoint,\n")
+	if o.EndpointDiscovery.Required {
+		buf.WriteString("Required: true,\n")
+	}
+	buf.WriteString("}).AddToHandlers(&req.Handlers)\n")
+
+	return buf.String()
+}
+
 // tplOperation defines a template for rendering an API Operation
 var tplOperation = template.Must(template.New("operation").Funcs(template.FuncMap{
 	"GetCrosslinkURL": GetCrosslinkURL,
@@ -112,6 +197,9 @@ func (c *{{ .API.StructName }}) {{ .ExportedName }}Request(` +
 				LimitToken: "{{ .Paginator.LimitKey }}",
 				TruncationToken: "{{ .Paginator.MoreResults }}",
 		},
+		{{ end }}{{ with .SensitiveOutputPaths }}SensitiveOutputPaths: {{ printf "%#v" . }},
+		{{ end }}{{ with .SensitiveOutputHeaders }}SensitiveOutputHeaders: {{ printf "%#v" . }},
+		{{ end }}{{ if .SensitiveOutputBody }}SensitiveOutputBody: true,
 		{{ end }}
 	}
 
@@ -124,6 +212,9 @@ func (c *{{ .API.StructName }}) {{ .ExportedName }}Request(` +
 	req.Handlers.Unmarshal.Remove({{ .API.ProtocolPackage }}.UnmarshalHandler)
 	req.Handlers.Unmarshal.PushBackNamed(protocol.UnmarshalDiscardBodyHandler){{ end }}
 	{{ if ne .AuthType "" }}{{ .GetSigner }}{{ end -}}
+	{{ if .HasHostPrefix }}{{ .HostPrefixHandler }}{{ end -}}
+	{{ if .HasEndpointDiscovery }}{{ .EndpointDiscoveryHandler }}{{ end -}}
+	{{ if .HasEventStream }}{{ .EventStreamHandler }}{{ end -}}
 	return
 }
 
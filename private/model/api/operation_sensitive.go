@@ -0,0 +1,161 @@
+// +build codegen
+
+package api
+
+// SensitiveOutputPaths returns the paths of the operation's output body
+// members which are marked as sensitive, in the form the response body is
+// redacted by when logged. JSON paths are joined with ".", e.g.
+// "Users[*].Password", and XML element paths with "/", with the root element
+// matched by "*", e.g. "*/GetUserResult/User/Password".
+func (o *Operation) SensitiveOutputPaths() []string {
+	s := o.OutputRef.Shape
+	if s == nil || o.SensitiveOutputBody() {
+		return nil
+	}
+	if len(s.Payload) != 0 {
+		// Only structure payloads have members in the body. Other payloads
+		// are the body.
+		s = s.MemberRefs[s.Payload].Shape
+		if s.Type != "structure" {
+			return nil
+		}
+	}
+
+	w := sensitivePathWalker{visiting: map[*Shape]bool{}}
+	var root string
+	switch o.API.Metadata.Protocol {
+	case "json", "rest-json":
+	case "query":
+		w.xml = true
+		root = "*/" + o.Name + "Result"
+	case "ec2", "rest-xml":
+		w.xml = true
+		root = "*"
+	default:
+		return nil
+	}
+
+	w.walkStructure(s, root)
+	return w.paths
+}
+
+// SensitiveOutputHeaders returns the names of the operation's output headers
+// whose members are marked as sensitive.
+func (o *Operation) SensitiveOutputHeaders() []string {
+	s := o.OutputRef.Shape
+	if s == nil {
+		return nil
+	}
+
+	var names []string
+	for _, name := range s.MemberNames() {
+		ref := s.MemberRefs[name]
+		if ref.Location == "header" && isSensitiveRef(ref) {
+			names = append(names, refLocationName(name, ref))
+		}
+	}
+	return names
+}
+
+// SensitiveOutputBody returns if the operation's output payload member is
+// marked as sensitive, in which case the entire response body is sensitive.
+func (o *Operation) SensitiveOutputBody() bool {
+	s := o.OutputRef.Shape
+	if s == nil || len(s.Payload) == 0 {
+		return false
+	}
+
+	return isSensitiveRef(s.MemberRefs[s.Payload])
+}
+
+// sensitivePathWalker collects the paths of the sensitive members of a shape
+// and the shapes nested in it.
+type sensitivePathWalker struct {
+	xml   bool
+	paths []string
+
+	// visiting are the structures being walked, so that recursive shapes are
+	// only walked once.
+	visiting map[*Shape]bool
+}
+
+func (w *sensitivePathWalker) walkStructure(s *Shape, path string) {
+	if w.visiting[s] {
+		return
+	}
+	w.visiting[s] = true
+	defer delete(w.visiting, s)
+
+	for _, name := range s.MemberNames() {
+		ref := s.MemberRefs[name]
+		if len(ref.Location) != 0 || ref.XMLAttribute {
+			continue
+		}
+		w.walkRef(ref, w.join(path, refLocationName(name, ref)))
+	}
+}
+
+func (w *sensitivePathWalker) walkRef(ref *ShapeRef, path string) {
+	s := ref.Shape
+	if s.Sensitive {
+		w.paths = append(w.paths, path)
+		return
+	}
+
+	switch s.Type {
+	case "structure":
+		w.walkStructure(s, path)
+	case "list":
+		elem := path + "[*]"
+		if w.xml {
+			elem = path
+			if !(ref.Flattened || s.Flattened) {
+				elem += "/*"
+			}
+		}
+		w.walkRef(&s.MemberRef, elem)
+	case "map":
+		elem := path + ".*"
+		if w.xml {
+			value := "value"
+			if v := s.ValueRef.LocationName; len(v) != 0 {
+				value = v
+			}
+			elem = path
+			if !(ref.Flattened || s.Flattened) {
+				elem += "/entry"
+			}
+			elem += "/" + value
+		}
+		w.walkRef(&s.ValueRef, elem)
+	}
+}
+
+func (w *sensitivePathWalker) join(path, name string) string {
+	switch {
+	case len(path) == 0:
+		return name
+	case w.xml:
+		return path + "/" + name
+	default:
+		return path + "." + name
+	}
+}
+
+// isSensitiveRef returns if the shape is sensitive, or is a list or map of
+// sensitive values.
+func isSensitiveRef(ref *ShapeRef) bool {
+	return marshalShapeRef{Ref: ref}.IsSensitive()
+}
+
+// refLocationName returns the name of the member's element, key, or header
+// in the response, which is the member's name unless a location name is set.
+func refLocationName(name string, ref *ShapeRef) string {
+	if len(ref.LocationName) != 0 {
+		return ref.LocationName
+	}
+	if len(ref.Shape.LocationName) != 0 {
+		return ref.Shape.LocationName
+	}
+	return name
+}
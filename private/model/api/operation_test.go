@@ -0,0 +1,186 @@
+// +build 1.6,codegen
+
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOperationHostPrefixHandler(t *testing.T) {
+	input := &Shape{
+		ShapeName: "OperationInput",
+		Type:      "structure",
+		MemberRefs: map[string]*ShapeRef{
+			"AccountId": {ShapeName: "String", HostLabel: true},
+			"Name":      {ShapeName: "String"},
+		},
+	}
+	op := &Operation{
+		Name:     "Operation",
+		InputRef: ShapeRef{ShapeName: "OperationInput", Shape: input},
+		Endpoint: &EndpointTrait{HostPrefix: "{AccountId}."},
+	}
+
+	if !op.HasHostPrefix() {
+		t.Fatalf("expect operation to have host prefix")
+	}
+
+	expect := `req.Handlers.Build.PushBackNamed(protocol.NewHostPrefixHandler("{AccountId}.", func() map[string]string {
+return map[string]string{
+"AccountId": aws.StringValue(input.AccountId),
+}
+}))
+`
+	if e, a := expect, op.HostPrefixHandler(); e != a {
+		t.Errorf("expect %q, got %q", e, a)
+	}
+
+	op.Endpoint.HostPrefix = "data-"
+	input.MemberRefs["AccountId"].HostLabel = false
+	expect = "req.Handlers.Build.PushBackNamed(protocol.NewHostPrefixHandler(\"data-\", nil))\n"
+	if e, a := expect, op.HostPrefixHandler(); e != a {
+		t.Errorf("expect %q, got %q", e, a)
+	}
+
+	op.Endpoint = nil
+	if op.HasHostPrefix() {
+		t.Errorf("expect operation to not have host prefix")
+	}
+}
+
+func TestOperationSensitiveOutput(t *testing.T) {
+	secret := &Shape{ShapeName: "Secret", Type: "string", Sensitive: true}
+	str := &Shape{ShapeName: "String", Type: "string"}
+	user := &Shape{
+		ShapeName: "User",
+		Type:      "structure",
+		MemberRefs: map[string]*ShapeRef{
+			"Name":     {ShapeName: "String", Shape: str},
+			"Password": {ShapeName: "Secret", Shape: secret},
+		},
+	}
+	// Recursive shapes are only walked once.
+	user.MemberRefs["Manager"] = &ShapeRef{ShapeName: "User", Shape: user}
+	users := &Shape{ShapeName: "Users", Type: "list", MemberRef: ShapeRef{ShapeName: "User", Shape: user}}
+	tokens := &Shape{
+		ShapeName: "Tokens",
+		Type:      "map",
+		KeyRef:    ShapeRef{ShapeName: "String", Shape: str},
+		ValueRef:  ShapeRef{ShapeName: "Secret", Shape: secret},
+	}
+	output := &Shape{
+		ShapeName: "OperationOutput",
+		Type:      "structure",
+		MemberRefs: map[string]*ShapeRef{
+			"Key":    {ShapeName: "Secret", Shape: secret, Location: "header", LocationName: "x-amz-key"},
+			"Tokens": {ShapeName: "Tokens", Shape: tokens},
+			"Users":  {ShapeName: "Users", Shape: users, LocationName: "UserList"},
+		},
+	}
+
+	cases := map[string]struct {
+		Protocol string
+		Expect   []string
+	}{
+		"json": {
+			Protocol: "json",
+			Expect: []string{
+				"Tokens.*",
+				"UserList[*].Password",
+			},
+		},
+		"query": {
+			Protocol: "query",
+			Expect: []string{
+				"*/OperationResult/Tokens/entry/value",
+				"*/OperationResult/UserList/*/Password",
+			},
+		},
+		"rest-xml": {
+			Protocol: "rest-xml",
+			Expect: []string{
+				"*/Tokens/entry/value",
+				"*/UserList/*/Password",
+			},
+		},
+	}
+
+	for name, c := range cases {
+		op := &Operation{
+			API:       &API{Metadata: Metadata{Protocol: c.Protocol}},
+			Name:      "Operation",
+			OutputRef: ShapeRef{ShapeName: "OperationOutput", Shape: output},
+		}
+
+		if e, a := c.Expect, op.SensitiveOutputPaths(); !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v paths, got %v", name, e, a)
+		}
+		if e, a := []string{"x-amz-key"}, op.SensitiveOutputHeaders(); !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v headers, got %v", name, e, a)
+		}
+		if op.SensitiveOutputBody() {
+			t.Errorf("%s, expect body not to be sensitive", name)
+		}
+	}
+
+	output.Payload = "Data"
+	output.MemberRefs["Data"] = &ShapeRef{ShapeName: "Secret", Shape: secret}
+	op := &Operation{
+		API:       &API{Metadata: Metadata{Protocol: "rest-json"}},
+		Name:      "Operation",
+		OutputRef: ShapeRef{ShapeName: "OperationOutput", Shape: output},
+	}
+	if !op.SensitiveOutputBody() {
+		t.Errorf("expect sensitive payload body to be sensitive")
+	}
+	if a := op.SensitiveOutputPaths(); len(a) != 0 {
+		t.Errorf("expect no paths, got %v", a)
+	}
+}
+
+func TestOperationEndpointDiscoveryHandler(t *testing.T) {
+	a := &API{Operations: map[string]*Operation{}}
+	op := &Operation{
+		API:               a,
+		Name:              "Operation",
+		EndpointDiscovery: &EndpointDiscoveryTrait{},
+	}
+	a.Operations["Operation"] = op
+
+	if op.HasEndpointDiscovery() {
+		t.Errorf("expect no endpoint discovery without discovery operation")
+	}
+
+	a.Operations["DescribeEndpoints"] = &Operation{
+		API:                   a,
+		Name:                  "DescribeEndpoints",
+		IsEndpointDiscoveryOp: true,
+	}
+	if !op.HasEndpointDiscovery() {
+		t.Fatalf("expect operation to have endpoint discovery")
+	}
+	if !a.HasEndpointDiscovery() {
+		t.Errorf("expect API to have endpoint discovery")
+	}
+
+	expect := `(&endpointdiscovery.Handler{
+Cache: c.endpointCache,
+Discover: c.discoverEndpoint,
+}).AddToHandlers(&req.Handlers)
+`
+	if e, a := expect, op.EndpointDiscoveryHandler(); e != a {
+		t.Errorf("expect %q, got %q", e, a)
+	}
+
+	op.EndpointDiscovery.Required = true
+	expect = `(&endpointdiscovery.Handler{
+Cache: c.endpointCache,
+Discover: c.discoverEndpoint,
+Required: true,
+}).AddToHandlers(&req.Handlers)
+`
+	if e, a := expect, op.EndpointDiscoveryHandler(); e != a {
+		t.Errorf("expect %q, got %q", e, a)
+	}
+}
@@ -31,6 +31,12 @@ type ShapeRef struct {
 	IdempotencyToken bool `json:"idempotencyToken"`
 	JSONValue        bool `json:"jsonvalue"`
 	Deprecated       bool `json:"deprecated"`
+	HostLabel        bool `json:"hostLabel"`
+	SerializeEmpty   bool `json:"serializeEmpty"`
+
+	// IsEventPayload is set if the member is the payload of its event shape's
+	// event stream messages.
+	IsEventPayload bool `json:"eventpayload"`
 
 	OrigShapeName string `json:"-"`
 
@@ -72,6 +78,7 @@ type Shape struct {
 	XMLNamespace     XMLInfo
 	Min              float64 // optional Minimum length (string, list) or value (number)
 	Max              float64 // optional Maximum length (string, list) or value (number)
+	Pattern          string  // optional pattern strings must match
 
 	refs       []*ShapeRef // References to this shape
 	resolvePkg string      // use this package in the goType() if present
@@ -86,11 +93,26 @@ type Shape struct {
 
 	Deprecated bool `json:"deprecated"`
 
+	// Sensitive is set if the shape's value contains sensitive data which
+	// should not be logged.
+	Sensitive bool `json:"sensitive"`
+
 	Validations ShapeValidations
 
+	// ExtendedValidations are the validations of the shape's constraints
+	// only validated if a client's extended validation is enabled.
+	ExtendedValidations ShapeValidations
+
 	// Error information that is set if the shape is an error shape.
 	IsError   bool
 	ErrorInfo ErrorInfo `json:"error"`
+
+	// IsEventStream is set if the shape is the stream of events of an
+	// operation's response. The members of the shape are its events.
+	IsEventStream bool `json:"eventstream"`
+
+	// IsEvent is set if the shape is an event of an event stream.
+	IsEvent bool `json:"event"`
 }
 
 // ErrorCodeName will return the error shape's name formated for
@@ -199,6 +221,10 @@ func (s *Shape) IsRefPayloadReader(name string, ref *ShapeRef) bool {
 // GoStructType returns the type of a struct field based on the API
 // model definition.
 func (s *Shape) GoStructType(name string, ref *ShapeRef) string {
+	if ref.Shape.IsEventStream {
+		return "*" + ref.Shape.ShapeName + "Reader"
+	}
+
 	if s.IsRefPayloadReader(name, ref) {
 		rtype := "io.ReadSeeker"
 		if strings.HasSuffix(s.ShapeName, "Output") {
@@ -220,6 +246,11 @@ func (s *Shape) GoStructType(name string, ref *ShapeRef) string {
 			s.API.imports["fmt"] = true
 		}
 	}
+	for _, v := range s.ExtendedValidations {
+		if (v.Ref.Shape.Type == "map" || v.Ref.Shape.Type == "list") && v.Type == ShapeValidationNestedExtended {
+			s.API.imports["fmt"] = true
+		}
+	}
 
 	return ref.GoType()
 }
@@ -343,6 +374,11 @@ func (s ShapeTags) String() string {
 
 // GoTags returns the rendered tags string for the ShapeRef
 func (ref *ShapeRef) GoTags(toplevel bool, isRequired bool) string {
+	if ref.Shape.IsEventStream {
+		// Event streams are read by their reader, and not marshaled.
+		return ""
+	}
+
 	tags := ShapeTags{}
 
 	if ref.Location != "" {
@@ -408,6 +444,9 @@ func (ref *ShapeRef) GoTags(toplevel bool, isRequired bool) string {
 	if ref.Shape.IsEnum() {
 		tags = append(tags, ShapeTag{"enum", ref.ShapeName})
 	}
+	if isSensitiveRef(ref) {
+		tags = append(tags, ShapeTag{"sensitive", "true"})
+	}
 
 	if toplevel {
 		if ref.Shape.Payload != "" {
@@ -576,6 +615,9 @@ type {{ .ShapeName }} struct {
 	{{ if .Validations -}}
 		{{ .Validations.GoCode . }}
 	{{ end }}
+	{{ if .ExtendedValidations -}}
+		{{ .ExtendedValidations.ExtendedGoCode . }}
+	{{ end }}
 {{ end }}
 
 {{ if not .API.NoGenStructFieldAccessors }}
@@ -584,6 +626,7 @@ type {{ .ShapeName }} struct {
 
 {{ range $_, $name := $context.MemberNames -}}
 	{{ $elem := index $context.MemberRefs $name -}}
+	{{ if not $elem.Shape.IsEventStream -}}
 
 // Set{{ $name }} sets the {{ $name }} field's value.
 func (s *{{ $builderShapeName }}) Set{{ $name }}(v {{ $context.GoStructValueType $name $elem }}) *{{ $builderShapeName }} {
@@ -608,9 +651,18 @@ func (s *{{ $builderShapeName }}) get{{ $name }}() (v {{ $context.GoStructValueT
 }
 {{- end }}
 
+{{ end -}}
 {{ end }}
 {{ end }}
 
+{{ with $.EventStreamRef -}}
+// unmarshalEventStream starts reading the response's event stream with the
+// output's {{ $.EventStreamRef.Shape.ShapeName }}Reader.
+func (s *{{ $.ShapeName }}) unmarshalEventStream(r *request.Request) {
+	s.EventStream = new{{ $.EventStreamRef.Shape.ShapeName }}Reader(r.HTTPResponse.Body)
+}
+{{- end }}
+
 {{ if not $.API.NoGenMarshalers -}}
 {{ MarshalShapeGoCode $ }}
 {{- end }}
@@ -637,6 +689,8 @@ func (s *Shape) GoCode() string {
 	b := &bytes.Buffer{}
 
 	switch {
+	case s.IsEventStream:
+		b.WriteString(s.EventStreamGoCode())
 	case s.Type == "structure":
 		if err := structShapeTmpl.Execute(b, s); err != nil {
 			panic(fmt.Sprintf("Failed to generate struct shape %s, %v\n", s.ShapeName, err))
@@ -15,13 +15,41 @@ import (
 // Will panic if error.
 func MarshalShapeGoCode(s *Shape) string {
 	w := &bytes.Buffer{}
-	if err := marshalShapeTmpl.Execute(w, s); err != nil {
+	if err := marshalShapeTmpl.Execute(w, marshalShape{Shape: s}); err != nil {
 		panic(fmt.Sprintf("failed to render shape's fields marshaler, %v", err))
 	}
 
 	return w.String()
 }
 
+// marshalShape is the shape rendered by the shape's MarshalFields template.
+type marshalShape struct {
+	*Shape
+}
+
+// XMLRoot returns the name of the XML root element the shape's body members
+// are marshaled within, or empty if the shape does not have one. Only the
+// input shapes of REST-XML operations with a location name have a root
+// element.
+func (s marshalShape) XMLRoot() string {
+	if s.API.Metadata.Protocol != "rest-xml" || len(s.LocationName) == 0 || len(s.Payload) != 0 {
+		return ""
+	}
+
+	for _, op := range s.API.Operations {
+		if op.InputRef.Shape == s.Shape {
+			return s.LocationName
+		}
+	}
+	return ""
+}
+
+// IsBodyMember returns if the shape's member is marshaled to the body.
+func (s marshalShape) IsBodyMember(name string) bool {
+	r := marshalShapeRef{Name: name, Ref: s.MemberRefs[name], Context: s.Shape}
+	return r.Location() == "Body"
+}
+
 // MarshalShapeRefGoCode renders protocol encode for the shape ref's type.
 //
 // Will panic if error.
@@ -29,6 +57,9 @@ func MarshalShapeRefGoCode(refName string, ref *ShapeRef, context *Shape) string
 	if ref.XMLAttribute {
 		return "// Skipping " + refName + " XML Attribute."
 	}
+	if ref.Shape.IsEventStream {
+		return "// Skipping " + refName + " Output type's event stream."
+	}
 	if context.IsRefPayloadReader(refName, ref) {
 		if strings.HasSuffix(context.ShapeName, "Output") {
 			return "// Skipping " + refName + " Output type's body not valid."
@@ -63,10 +94,28 @@ var marshalShapeTmpl = template.Must(template.New("marshalShapeTmpl").Funcs(
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *{{ $shapeName }}) MarshalFields(e protocol.FieldEncoder) error {
+{{- if $.XMLRoot }}
 	{{ range $name, $ref := $.MemberRefs -}}
-		{{ MarshalShapeRefGoCode $name $ref $ }}
+		{{ if not ($.IsBodyMember $name) -}}
+			{{ MarshalShapeRefGoCode $name $ref $.Shape }}
+		{{ end -}}
+	{{ end }}
+	e.SetFields(protocol.BodyTarget, "{{ $.XMLRoot }}", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		{{ range $name, $ref := $.MemberRefs -}}
+			{{ if $.IsBodyMember $name -}}
+				{{ MarshalShapeRefGoCode $name $ref $.Shape }}
+			{{ end -}}
+		{{ end }}
+		return nil
+	}), protocol.Metadata{ {{- with $.XMLNamespace.URI }}XMLNamespaceURI: "{{ . }}"{{ end -}} })
+
+	return nil
+{{- else }}
+	{{ range $name, $ref := $.MemberRefs -}}
+		{{ MarshalShapeRefGoCode $name $ref $.Shape }}
 	{{ end }}
 	return nil
+{{- end }}
 }
 
 {{ if $.UsedInList -}}
@@ -153,6 +202,14 @@ var marshalShapeRefTmpl = template.Must(template.New("marshalShapeRefTmpl").Pars
 			Flatten: true,
 		{{- end -}}
 
+		{{- if $.IsSensitive -}}
+			Sensitive: true,
+		{{- end -}}
+
+		{{- if $.Ref.SerializeEmpty -}}
+			SerializeEmpty: true,
+		{{- end -}}
+
 		{{- if $.HasAttributes -}}
 			Attributes: attrs,
 		{{- end -}}
@@ -186,7 +243,7 @@ var marshalShapeRefTmpl = template.Must(template.New("marshalShapeRefTmpl").Pars
 {{ define "is ref set" -}}
 	{{ $isList := $.IsShapeType "list" -}}
 	{{ $isMap := $.IsShapeType "map" -}}
-	{{- if or $isList $isMap -}}
+	{{- if and (or $isList $isMap) (not $.Ref.SerializeEmpty) -}}
 		len(s.{{ $.Name }}) > 0
 	{{- else -}}
 		s.{{ $.Name }} != nil
@@ -371,6 +428,17 @@ func (r marshalShapeRef) LocationName() string {
 func (r marshalShapeRef) IsFlattened() bool {
 	return r.Ref.Flattened || r.Ref.Shape.Flattened
 }
+func (r marshalShapeRef) IsSensitive() bool {
+	switch s := r.Ref.Shape; {
+	case s.Sensitive:
+		return true
+	case s.Type == "list":
+		return s.MemberRef.Shape != nil && s.MemberRef.Shape.Sensitive
+	case s.Type == "map":
+		return s.ValueRef.Shape != nil && s.ValueRef.Shape.Sensitive
+	}
+	return false
+}
 func (r marshalShapeRef) XMLNamespacePrefix() string {
 	if v := r.Ref.XMLNamespace.Prefix; len(v) != 0 {
 		return v
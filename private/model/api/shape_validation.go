@@ -22,6 +22,21 @@ const (
 	// ShapeValidationNested states the shape has nested values that need
 	// to be validated
 	ShapeValidationNested
+
+	// ShapeValidationMaxVal states the shape must have at most a number of
+	// elements, or for numbers a maximum value
+	ShapeValidationMaxVal
+
+	// ShapeValidationPattern states the string shape must match a pattern
+	ShapeValidationPattern
+
+	// ShapeValidationEnum states the string shape must be one of its enum
+	// values
+	ShapeValidationEnum
+
+	// ShapeValidationNestedExtended states the shape has nested values that
+	// need extended validation
+	ShapeValidationNestedExtended
 )
 
 // A ShapeValidation contains information about a shape and the type of validation
@@ -56,6 +71,34 @@ var validationGoCodeTmpls = template.Must(template.New("validationGoCodeTmpls").
 		invalidParams.Add(request.NewErrParamMinValue("{{ .Name }}", {{ .Ref.Shape.Min }}))
 	}
 {{- end }}
+{{ define "maxLen" -}}
+	if s.{{ .Name }} != nil && len(s.{{ .Name }}) > {{ .Ref.Shape.Max }} {
+		invalidParams.Add(request.NewErrParamMaxLen("{{ .Name }}", {{ .Ref.Shape.Max }}))
+	}
+{{- end }}
+{{ define "maxLenString" -}}
+	if s.{{ .Name }} != nil && len(*s.{{ .Name }}) > {{ .Ref.Shape.Max }} {
+		invalidParams.Add(request.NewErrParamMaxLen("{{ .Name }}", {{ .Ref.Shape.Max }}))
+	}
+{{- end }}
+{{ define "maxVal" -}}
+	if s.{{ .Name }} != nil && *s.{{ .Name }} > {{ .Ref.Shape.Max }} {
+		invalidParams.Add(request.NewErrParamMaxValue("{{ .Name }}", {{ .Ref.Shape.Max }}))
+	}
+{{- end }}
+{{ define "pattern" -}}
+	if s.{{ .Name }} != nil && !request.MatchParamPattern({{ printf "%q" .Ref.Shape.Pattern }}, *s.{{ .Name }}) {
+		invalidParams.Add(request.NewErrParamPattern("{{ .Name }}", {{ printf "%q" .Ref.Shape.Pattern }}))
+	}
+{{- end }}
+{{ define "enum" -}}
+	if s.{{ .Name }} != nil {
+		values := []string{ {{- range $_, $v := .Ref.Shape.Enum }}{{ printf "%q" $v }},{{ end -}} }
+		if !request.IsParamEnumValue(*s.{{ .Name }}, values) {
+			invalidParams.Add(request.NewErrParamEnum("{{ .Name }}", *s.{{ .Name }}, values))
+		}
+	}
+{{- end }}
 {{ define "nestedMapList" -}}
     if s.{{ .Name }} != nil { 
 		for i, v := range s.{{ .Name }} {
@@ -73,6 +116,23 @@ var validationGoCodeTmpls = template.Must(template.New("validationGoCodeTmpls").
 		}
 	}
 {{- end }}
+{{ define "nestedMapListExtended" -}}
+	if s.{{ .Name }} != nil {
+		for i, v := range s.{{ .Name }} {
+			if v == nil { continue }
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "{{ .Name }}", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+{{- end }}
+{{ define "nestedStructExtended" -}}
+	if s.{{ .Name }} != nil {
+		if err := s.{{ .Name }}.ValidateExtended(); err != nil {
+			invalidParams.AddNested("{{ .Name }}", err.(request.ErrInvalidParams))
+		}
+	}
+{{- end }}
 `))
 
 // GoCode returns the generated Go code for the Shape with its validation type.
@@ -102,6 +162,29 @@ func (sv ShapeValidation) GoCode() string {
 		default:
 			err = validationGoCodeTmpls.ExecuteTemplate(w, "nestedStruct", sv)
 		}
+	case ShapeValidationMaxVal:
+		switch sv.Ref.Shape.Type {
+		case "list", "map", "blob":
+			err = validationGoCodeTmpls.ExecuteTemplate(w, "maxLen", sv)
+		case "string":
+			err = validationGoCodeTmpls.ExecuteTemplate(w, "maxLenString", sv)
+		case "integer", "long", "float", "double":
+			err = validationGoCodeTmpls.ExecuteTemplate(w, "maxVal", sv)
+		default:
+			panic(fmt.Sprintf("ShapeValidation.GoCode, %s's type %s, no max value handling",
+				sv.Name, sv.Ref.Shape.Type))
+		}
+	case ShapeValidationPattern:
+		err = validationGoCodeTmpls.ExecuteTemplate(w, "pattern", sv)
+	case ShapeValidationEnum:
+		err = validationGoCodeTmpls.ExecuteTemplate(w, "enum", sv)
+	case ShapeValidationNestedExtended:
+		switch sv.Ref.Shape.Type {
+		case "map", "list":
+			err = validationGoCodeTmpls.ExecuteTemplate(w, "nestedMapListExtended", sv)
+		default:
+			err = validationGoCodeTmpls.ExecuteTemplate(w, "nestedStructExtended", sv)
+		}
 	default:
 		panic(fmt.Sprintf("ShapeValidation.GoCode, %s's type %d, unknown validation type",
 			sv.Name, sv.Type))
@@ -132,6 +215,33 @@ func (s *{{ .Shape.ShapeName }}) Validate() error {
 }
 `))
 
+var validateExtendedShapeTmpl = template.Must(template.New("ValidateExtendedShape").Parse(`
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *{{ .Shape.ShapeName }}) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "{{ .Shape.ShapeName }}"}
+	{{ range $_, $v := .Validations -}}
+		{{ $v.GoCode }}
+	{{ end }}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+`))
+
+// ExtendedGoCode generates the Go code needed to perform the extended
+// validations for the shape and its nested fields.
+func (vs ShapeValidations) ExtendedGoCode(shape *Shape) string {
+	buf := &bytes.Buffer{}
+	validateExtendedShapeTmpl.Execute(buf, map[string]interface{}{
+		"Shape":       shape,
+		"Validations": vs,
+	})
+	return buf.String()
+}
+
 // GoCode generates the Go code needed to perform validations for the
 // shape and its nested fields.
 func (vs ShapeValidations) GoCode(shape *Shape) string {
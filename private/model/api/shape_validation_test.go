@@ -0,0 +1,55 @@
+// +build 1.6,codegen
+
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtendedValidationTypes(t *testing.T) {
+	cases := map[string]struct {
+		Shape  Shape
+		Expect []ShapeValidationType
+	}{
+		"string max": {
+			Shape:  Shape{Type: "string", Max: 10},
+			Expect: []ShapeValidationType{ShapeValidationMaxVal},
+		},
+		"string enum": {
+			Shape:  Shape{Type: "string", Enum: []string{"a", "b"}, Pattern: "[a-z]"},
+			Expect: []ShapeValidationType{ShapeValidationEnum},
+		},
+		"string pattern": {
+			Shape:  Shape{Type: "string", Max: 5, Pattern: "[a-z]+"},
+			Expect: []ShapeValidationType{ShapeValidationMaxVal, ShapeValidationPattern},
+		},
+		"unsupported pattern": {
+			Shape: Shape{Type: "string", Pattern: "(?!aws:)[a-z]+"},
+		},
+		"list max": {
+			Shape:  Shape{Type: "list", Max: 3},
+			Expect: []ShapeValidationType{ShapeValidationMaxVal},
+		},
+		"long max": {
+			Shape:  Shape{Type: "long", Max: 100},
+			Expect: []ShapeValidationType{ShapeValidationMaxVal},
+		},
+		"long unsafe max": {
+			Shape: Shape{Type: "long", Max: 9223372036854775807},
+		},
+		"double max": {
+			Shape:  Shape{Type: "double", Max: 1.5},
+			Expect: []ShapeValidationType{ShapeValidationMaxVal},
+		},
+		"no constraints": {
+			Shape: Shape{Type: "structure"},
+		},
+	}
+
+	for name, c := range cases {
+		if e, a := c.Expect, extendedValidationTypes(&c.Shape); !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v validations, got %v", name, e, a)
+		}
+	}
+}
@@ -114,7 +114,7 @@ func newGenerateInfo(modelFile, svcPath, svcImportPath string) *generateInfo {
 func main() {
 	var svcPath, sessionPath, svcImportPath string
 	flag.StringVar(&svcPath, "path", "service", "directory to generate service clients in")
-	flag.StringVar(&sessionPath, "sessionPath", filepath.Join("aws", "session"), "generate session service client factories")
+	flag.StringVar(&sessionPath, "sessionPath", "", "directory to generate the session package's service ID table in, if set")
 	flag.StringVar(&svcImportPath, "svc-import-path", "github.com/aws/aws-sdk-go/service", "namespace to generate service client Go code import path under")
 	flag.Parse()
 	api.Bootstrap()
@@ -152,6 +152,7 @@ func main() {
 		m[svc] = true
 	}
 
+	apis := []*api.API{}
 	wg := sync.WaitGroup{}
 	for i := range files {
 		filename := files[i]
@@ -167,6 +168,7 @@ func main() {
 			// Skip services not yet supported.
 			continue
 		}
+		apis = append(apis, genInfo.API)
 
 		wg.Add(1)
 		go func(g *generateInfo, filename string) {
@@ -176,6 +178,12 @@ func main() {
 	}
 
 	wg.Wait()
+
+	// The session's table of service IDs must include every service, and
+	// is only written when all services are generated.
+	if len(sessionPath) != 0 && len(os.Getenv("SERVICES")) == 0 {
+		Must(writeSessionServiceIDsFile(sessionPath, apis))
+	}
 }
 
 func writeServiceFiles(g *generateInfo, filename string) {
@@ -292,6 +300,17 @@ func writeAPIFile(g *generateInfo) error {
 	)
 }
 
+// writeSessionServiceIDsFile writes out the session package's table of
+// service IDs keyed by endpoints ID.
+func writeSessionServiceIDsFile(sessionPath string, apis []*api.API) error {
+	return writeGoFile(filepath.Join(sessionPath, "service_ids.go"),
+		codeLayout,
+		"",
+		"session",
+		api.SessionServiceIDsGoCode(apis),
+	)
+}
+
 // writeAPIErrorsFile writes out the service API errors file.
 func writeAPIErrorsFile(g *generateInfo) error {
 	return writeGoFile(filepath.Join(g.PackageDir, "errors.go"),
@@ -8,6 +8,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/query"
 	"github.com/aws/aws-sdk-go/private/protocol/query/queryutil"
 )
 
@@ -20,16 +22,38 @@ func Build(r *request.Request) {
 		"Action":  {r.Operation.Name},
 		"Version": {r.ClientInfo.APIVersion},
 	}
-	if err := queryutil.Parse(body, r.Params, true); err != nil {
+	sensitive, err := parse(body, r.Params)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == request.InvalidParameterErrCode {
+			r.Error = err
+			return
+		}
 		r.Error = awserr.New("SerializationError", "failed encoding EC2 Query request", err)
+		return
 	}
 
 	if r.ExpireTime == 0 {
 		r.HTTPRequest.Method = "POST"
 		r.HTTPRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
 		r.SetBufferBody([]byte(body.Encode()))
+		r.SensitiveBodyPaths = sensitive
 	} else { // This is a pre-signed request
 		r.HTTPRequest.Method = "GET"
 		r.HTTPRequest.URL.RawQuery = body.Encode()
 	}
 }
+
+// parse encodes the params into the body, using the params' generated
+// marshaler if it has one. The names of the sensitive parameters built by
+// the reflection based parser are returned.
+func parse(body url.Values, params interface{}) ([]string, error) {
+	if m, ok := params.(protocol.FieldMarshaler); ok {
+		e := query.NewEncoder(body)
+		e.EC2 = true
+		m.MarshalFields(e)
+		_, err := e.Encode()
+		return nil, err
+	}
+
+	return queryutil.ParseWithSensitivePaths(body, params, true)
+}
@@ -3,8 +3,11 @@ package ec2query
 //go:generate go run -tags codegen ../../../models/protocol_tests/generate.go ../../../models/protocol_tests/output/ec2.json unmarshal_test.go
 
 import (
+	"bytes"
 	"encoding/xml"
-	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -38,26 +41,92 @@ func UnmarshalMeta(r *request.Request) {
 	// TODO implement unmarshaling of request IDs
 }
 
+// xmlErrorResponse is the EC2 style error envelope,
+// <Response><Errors><Error>...</Error></Errors></Response>.
 type xmlErrorResponse struct {
-	XMLName   xml.Name `xml:"Response"`
-	Code      string   `xml:"Errors>Error>Code"`
-	Message   string   `xml:"Errors>Error>Message"`
-	RequestID string   `xml:"RequestID"`
+	XMLName      xml.Name `xml:"Response"`
+	Code         string   `xml:"Errors>Error>Code"`
+	Message      string   `xml:"Errors>Error>Message"`
+	RequestID    string   `xml:"RequestID"`
+	AltRequestID string   `xml:"RequestId"`
+}
+
+// xmlQueryErrorResponse is the query protocol style error envelope,
+// <ErrorResponse><Error>...</Error></ErrorResponse>, which is returned by
+// some EC2 endpoints and intermediate proxies.
+type xmlQueryErrorResponse struct {
+	XMLName      xml.Name `xml:"ErrorResponse"`
+	Code         string   `xml:"Error>Code"`
+	Message      string   `xml:"Error>Message"`
+	RequestID    string   `xml:"RequestID"`
+	AltRequestID string   `xml:"RequestId"`
 }
 
 // UnmarshalError unmarshals a response error for the EC2 protocol.
+//
+// Both the EC2 and query protocol error envelopes are supported. If the
+// response body is empty, or is not a known XML error envelope the error
+// code and message will be derived from the HTTP status.
 func UnmarshalError(r *request.Request) {
 	defer r.HTTPResponse.Body.Close()
 
-	resp := &xmlErrorResponse{}
-	err := xml.NewDecoder(r.HTTPResponse.Body).Decode(resp)
-	if err != nil && err != io.EOF {
-		r.Error = awserr.New("SerializationError", "failed decoding EC2 Query error response", err)
-	} else {
-		r.Error = awserr.NewRequestFailure(
-			awserr.New(resp.Code, resp.Message, nil),
-			r.HTTPResponse.StatusCode,
-			resp.RequestID,
-		)
+	bodyBytes, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	if err != nil {
+		r.Error = awserr.New("SerializationError", "failed reading EC2 Query error response", err)
+		return
+	}
+
+	var code, msg, reqID string
+	switch rootElementName(bodyBytes) {
+	case "Response":
+		resp := xmlErrorResponse{}
+		if err = xml.Unmarshal(bodyBytes, &resp); err == nil {
+			code, msg = resp.Code, resp.Message
+			reqID = firstNonEmpty(resp.RequestID, resp.AltRequestID)
+		}
+	case "ErrorResponse":
+		resp := xmlQueryErrorResponse{}
+		if err = xml.Unmarshal(bodyBytes, &resp); err == nil {
+			code, msg = resp.Code, resp.Message
+			reqID = firstNonEmpty(resp.RequestID, resp.AltRequestID)
+		}
+	}
+
+	// Fallback to status code converted to message if still no error code
+	if len(code) == 0 {
+		statusText := http.StatusText(r.HTTPResponse.StatusCode)
+		code = strings.Replace(statusText, " ", "", -1)
+		msg = statusText
+	}
+
+	r.Error = awserr.NewRequestFailure(
+		awserr.New(code, msg, err),
+		r.HTTPResponse.StatusCode,
+		firstNonEmpty(reqID, r.RequestID),
+	)
+}
+
+// rootElementName returns the local name of the first XML element in the
+// document. An empty string is returned if the body does not contain an
+// XML element.
+func rootElementName(b []byte) string {
+	decoder := xml.NewDecoder(bytes.NewReader(b))
+	for {
+		tok, err := decoder.Token()
+		if err != nil {
+			return ""
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			return start.Name.Local
+		}
+	}
+}
+
+func firstNonEmpty(vs ...string) string {
+	for _, v := range vs {
+		if len(v) != 0 {
+			return v
+		}
 	}
+	return ""
 }
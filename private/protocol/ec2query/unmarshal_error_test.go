@@ -0,0 +1,101 @@
+package ec2query_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol/ec2query"
+)
+
+func TestUnmarshalError(t *testing.T) {
+	cases := []struct {
+		Status        int
+		Body          string
+		Code, Msg     string
+		ReqID         string
+		ExpectOrigErr bool
+	}{
+		{
+			Status: 400,
+			Body: `<Response><Errors><Error><Code>InvalidInstanceID.Malformed</Code>` +
+				`<Message>Invalid id</Message></Error></Errors><RequestID>abc123</RequestID></Response>`,
+			Code: "InvalidInstanceID.Malformed", Msg: "Invalid id", ReqID: "abc123",
+		},
+		{
+			Status: 400,
+			Body: `<Response><Errors><Error><Code>InvalidParameterValue</Code>` +
+				`<Message>Bad value</Message></Error></Errors><RequestId>abc123</RequestId></Response>`,
+			Code: "InvalidParameterValue", Msg: "Bad value", ReqID: "abc123",
+		},
+		{
+			Status: 403,
+			Body: `<?xml version="1.0" encoding="UTF-8"?>` +
+				`<ErrorResponse><Error><Type>Sender</Type><Code>AuthFailure</Code>` +
+				`<Message>not authorized</Message></Error><RequestId>abc123</RequestId></ErrorResponse>`,
+			Code: "AuthFailure", Msg: "not authorized", ReqID: "abc123",
+		},
+		{
+			Status: 503,
+			Body:   "",
+			Code:   "ServiceUnavailable", Msg: "Service Unavailable", ReqID: "fallback",
+		},
+		{
+			Status: 500,
+			Body:   `<Response><Errors><Error><Code>InternalError</Code><Mess`,
+			Code:   "InternalServerError", Msg: "Internal Server Error", ReqID: "fallback",
+			ExpectOrigErr: true,
+		},
+		{
+			Status: 502,
+			Body: `<!DOCTYPE html><html><head><title>502 Bad Gateway</title></head>` +
+				`<body><h1>Bad Gateway</h1><hr><p>proxy</p></body></html>`,
+			Code: "BadGateway", Msg: "Bad Gateway", ReqID: "fallback",
+		},
+		{
+			Status: 400,
+			Body:   "not xml at all",
+			Code:   "BadRequest", Msg: "Bad Request", ReqID: "fallback",
+		},
+	}
+
+	for i, c := range cases {
+		r := &request.Request{
+			HTTPResponse: &http.Response{
+				StatusCode: c.Status,
+				Status:     http.StatusText(c.Status),
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(c.Body))),
+			},
+			RequestID: "fallback",
+		}
+
+		ec2query.UnmarshalError(r)
+		if r.Error == nil {
+			t.Fatalf("%d, expect error, got none", i)
+		}
+
+		aerr, ok := r.Error.(awserr.RequestFailure)
+		if !ok {
+			t.Fatalf("%d, expect RequestFailure, got %T, %v", i, r.Error, r.Error)
+		}
+		if e, a := c.Code, aerr.Code(); e != a {
+			t.Errorf("%d, expect %v code, got %v", i, e, a)
+		}
+		if e, a := c.Msg, aerr.Message(); e != a {
+			t.Errorf("%d, expect %v message, got %v", i, e, a)
+		}
+		if e, a := c.ReqID, aerr.RequestID(); e != a {
+			t.Errorf("%d, expect %v request id, got %v", i, e, a)
+		}
+		if e, a := c.Status, aerr.StatusCode(); e != a {
+			t.Errorf("%d, expect %v status code, got %v", i, e, a)
+		}
+		if e, a := c.ExpectOrigErr, aerr.OrigErr() != nil; e != a {
+			t.Errorf("%d, expect %v orig err, got %v", i, e, aerr.OrigErr())
+		}
+	}
+}
@@ -10,6 +10,15 @@ type FieldMarshaler interface {
 	MarshalFields(FieldEncoder) error
 }
 
+// FieldMarshalerFunc is a function which implements the FieldMarshaler
+// interface, marshaling the fields with the function.
+type FieldMarshalerFunc func(FieldEncoder) error
+
+// MarshalFields marshals the fields by calling the function with the encoder.
+func (fn FieldMarshalerFunc) MarshalFields(e FieldEncoder) error {
+	return fn(e)
+}
+
 // ValueMarshaler provides a generic type for all encoding field values to be
 // passed into a encoder's methods with.
 type ValueMarshaler interface {
@@ -22,6 +31,14 @@ type StreamMarshaler interface {
 	MarshalStream() (io.ReadSeeker, error)
 }
 
+// A ReaderStreamMarshaler interface is used to marshal a stream which may not
+// be seekable when encoding. Encoders which support non-seekable streams will
+// use MarshalReaderStream instead of MarshalStream.
+type ReaderStreamMarshaler interface {
+	StreamMarshaler
+	MarshalReaderStream() (io.Reader, error)
+}
+
 // A ListEncoder provides the interface for encoders that will encode List elements.
 type ListEncoder interface {
 	ListAddValue(v ValueMarshaler)
@@ -0,0 +1,127 @@
+package eventstream
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+)
+
+// Decoder provides decoding of an Event Stream messages.
+type Decoder struct {
+	r io.Reader
+}
+
+// NewDecoder initializes and returns a Decoder for decoding event
+// stream messages from the reader provided.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{
+		r: r,
+	}
+}
+
+// Decode attempts to decode a single message from the event stream reader.
+// Will return the event stream message, or error if Decode fails to read
+// the message from the stream.
+//
+// io.EOF is returned if the stream ended cleanly before a new message. If the
+// stream ends within a message io.ErrUnexpectedEOF is returned. A
+// ChecksumError is returned if either the prelude or message checksum does
+// not match, and a LengthError if the prelude's lengths are not valid.
+func (d *Decoder) Decode(payloadBuf []byte) (m Message, err error) {
+	crc := crc32.New(crc32IEEETable)
+	hashReader := io.TeeReader(d.r, crc)
+
+	prelude, err := decodePrelude(hashReader, crc)
+	if err != nil {
+		return Message{}, err
+	}
+
+	headersBuf := make([]byte, prelude.HeadersLen)
+	if _, err = io.ReadFull(hashReader, headersBuf); err != nil {
+		return Message{}, unexpectedEOF(err)
+	}
+
+	payloadLen := int(prelude.PayloadLen())
+	if cap(payloadBuf) < payloadLen {
+		payloadBuf = make([]byte, payloadLen)
+	}
+	payloadBuf = payloadBuf[0:payloadLen]
+	if _, err = io.ReadFull(hashReader, payloadBuf); err != nil {
+		return Message{}, unexpectedEOF(err)
+	}
+
+	if err = validateCRC(d.r, "message", crc.Sum32()); err != nil {
+		return Message{}, err
+	}
+
+	headers, err := decodeHeaders(bytes.NewReader(headersBuf))
+	if err != nil {
+		return Message{}, err
+	}
+
+	m.Headers = headers
+	m.Payload = payloadBuf
+
+	return m, nil
+}
+
+func decodePrelude(r io.Reader, crc crc32Hash) (messagePrelude, error) {
+	var p messagePrelude
+
+	var err error
+	p.Length, err = decodeUint32(r)
+	if err != nil {
+		// A stream ending before any part of the prelude is a clean end of
+		// the stream.
+		return messagePrelude{}, err
+	}
+
+	p.HeadersLen, err = decodeUint32(r)
+	if err != nil {
+		return messagePrelude{}, unexpectedEOF(err)
+	}
+
+	p.PreludeCRC = crc.Sum32()
+	if err := validateCRC(r, "prelude", p.PreludeCRC); err != nil {
+		return messagePrelude{}, err
+	}
+
+	if err := p.ValidateLens(); err != nil {
+		return messagePrelude{}, err
+	}
+
+	return p, nil
+}
+
+func decodeUint32(r io.Reader) (uint32, error) {
+	var b [4]byte
+	n, err := io.ReadFull(r, b[:])
+	if err == io.ErrUnexpectedEOF || (err == io.EOF && n != 0) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(b[:]), nil
+}
+
+func validateCRC(r io.Reader, part string, expect uint32) error {
+	msgCRC, err := decodeUint32(r)
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+
+	if msgCRC != expect {
+		return ChecksumError{Part: part, Want: expect, Have: msgCRC}
+	}
+
+	return nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
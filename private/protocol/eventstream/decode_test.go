@@ -0,0 +1,151 @@
+package eventstream
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	cases := readPositiveTests(t, "testdata")
+
+	for _, c := range cases {
+		decoder := NewDecoder(bytes.NewBuffer(c.Encoded))
+
+		msg, err := decoder.Decode(nil)
+		if err != nil {
+			t.Fatalf("%s, expect no decode error, got %v", c.Name, err)
+		}
+
+		assertMessageEqual(t, c.Name, c.Decoded, msg)
+
+		if _, err := decoder.Decode(nil); err != io.EOF {
+			t.Errorf("%s, expect io.EOF at end of stream, got %v", c.Name, err)
+		}
+	}
+}
+
+func TestDecode_NegativeTests(t *testing.T) {
+	cases := readNegativeTests(t, "testdata")
+
+	for _, c := range cases {
+		decoder := NewDecoder(bytes.NewBuffer(c.Encoded))
+
+		_, err := decoder.Decode(nil)
+		if err == nil {
+			t.Fatalf("%s, expect error, got none", c.Name)
+		}
+
+		cerr, ok := err.(ChecksumError)
+		if !ok {
+			t.Fatalf("%s, expect ChecksumError, got %T, %v", c.Name, err, err)
+		}
+		if e, a := strings.ToLower(strings.Fields(c.Err)[0]), cerr.Part; e != a {
+			t.Errorf("%s, expect %v checksum error, got %v", c.Name, e, a)
+		}
+	}
+}
+
+func TestDecode_TruncatedMessage(t *testing.T) {
+	cases := readPositiveTests(t, "testdata")
+
+	for _, c := range cases {
+		for n := 1; n < len(c.Encoded); n++ {
+			decoder := NewDecoder(bytes.NewBuffer(c.Encoded[:n]))
+
+			_, err := decoder.Decode(nil)
+			if err != io.ErrUnexpectedEOF {
+				t.Errorf("%s, expect io.ErrUnexpectedEOF for %d/%d bytes, got %v",
+					c.Name, n, len(c.Encoded), err)
+			}
+		}
+	}
+}
+
+func TestDecode_CorruptedMessage(t *testing.T) {
+	cases := readPositiveTests(t, "testdata")
+
+	for _, c := range cases {
+		for i := 0; i < len(c.Encoded); i++ {
+			b := make([]byte, len(c.Encoded))
+			copy(b, c.Encoded)
+			b[i] ^= 0x01
+
+			_, err := NewDecoder(bytes.NewBuffer(b)).Decode(nil)
+			if err == nil {
+				t.Errorf("%s, expect error for corrupted byte %d, got none", c.Name, i)
+				continue
+			}
+
+			switch err.(type) {
+			case ChecksumError, LengthError:
+			default:
+				if err != io.ErrUnexpectedEOF {
+					t.Errorf("%s, expect typed error for corrupted byte %d, got %T, %v",
+						c.Name, i, err, err)
+				}
+			}
+		}
+	}
+}
+
+func TestDecode_InvalidLengths(t *testing.T) {
+	cases := map[string]messagePrelude{
+		"headers longer than message":  {Length: minMsgLen, HeadersLen: 10},
+		"message too large":            {Length: maxMsgLen + 1},
+		"headers too large":            {Length: maxMsgLen, HeadersLen: maxHeadersLen + 1},
+		"message shorter than prelude": {Length: 4},
+	}
+
+	for name, p := range cases {
+		var buf bytes.Buffer
+		encodePrelude(&buf, preludeCRC(p), p)
+
+		_, err := NewDecoder(&buf).Decode(nil)
+		if _, ok := err.(LengthError); !ok {
+			t.Errorf("%s, expect LengthError, got %T, %v", name, err, err)
+		}
+	}
+}
+
+func TestDecode_ReusePayloadBuffer(t *testing.T) {
+	var buf bytes.Buffer
+	encoder := NewEncoder(&buf)
+	encoder.Encode(Message{Payload: []byte("abc123")})
+	encoder.Encode(Message{})
+
+	payloadBuf := make([]byte, 0, 10)
+	decoder := NewDecoder(&buf)
+
+	msg, err := decoder.Decode(payloadBuf)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "abc123", string(msg.Payload); e != a {
+		t.Errorf("expect %v payload, got %v", e, a)
+	}
+	if e, a := &payloadBuf[:1][0], &msg.Payload[0]; e != a {
+		t.Errorf("expect payload buffer to be reused")
+	}
+
+	msg, err = decoder.Decode(payloadBuf)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 0, len(msg.Payload); e != a {
+		t.Errorf("expect %v payload length, got %v", e, a)
+	}
+}
+
+type preludeCRC messagePrelude
+
+func (p preludeCRC) Sum32() uint32 {
+	var buf bytes.Buffer
+	encodePrelude(&buf, zeroCRC{}, messagePrelude(p))
+	return crc32Checksum(buf.Bytes()[:preludeLen])
+}
+
+type zeroCRC struct{}
+
+func (zeroCRC) Sum32() uint32 { return 0 }
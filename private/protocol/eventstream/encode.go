@@ -0,0 +1,75 @@
+package eventstream
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+)
+
+// Encoder provides EventStream message encoding.
+type Encoder struct {
+	w io.Writer
+
+	headersBuf *bytes.Buffer
+}
+
+// NewEncoder initializes and returns an Encoder to encode Event Stream
+// messages to an io.Writer.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{
+		w:          w,
+		headersBuf: bytes.NewBuffer(nil),
+	}
+}
+
+// Encode encodes a single EventStream message to the io.Writer the Encoder
+// was created with. An error is returned if writing the message fails, or
+// the message's headers or payload are larger than the message framing
+// allows.
+func (e *Encoder) Encode(msg Message) error {
+	e.headersBuf.Reset()
+
+	if err := encodeHeaders(e.headersBuf, msg.Headers); err != nil {
+		return err
+	}
+
+	prelude := messagePrelude{
+		HeadersLen: uint32(e.headersBuf.Len()),
+		Length:     uint32(minMsgLen + e.headersBuf.Len() + len(msg.Payload)),
+	}
+	if err := prelude.ValidateLens(); err != nil {
+		return err
+	}
+
+	crc := crc32.New(crc32IEEETable)
+	hashWriter := io.MultiWriter(e.w, crc)
+
+	if err := encodePrelude(hashWriter, crc, prelude); err != nil {
+		return err
+	}
+	if _, err := e.headersBuf.WriteTo(hashWriter); err != nil {
+		return err
+	}
+	if _, err := hashWriter.Write(msg.Payload); err != nil {
+		return err
+	}
+
+	return binary.Write(e.w, binary.BigEndian, crc.Sum32())
+}
+
+func encodePrelude(w io.Writer, crc crc32Hash, p messagePrelude) error {
+	if err := binary.Write(w, binary.BigEndian, p.Length); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.BigEndian, p.HeadersLen); err != nil {
+		return err
+	}
+
+	// The prelude's checksum is written into the message's checksum as well.
+	return binary.Write(w, binary.BigEndian, crc.Sum32())
+}
+
+type crc32Hash interface {
+	Sum32() uint32
+}
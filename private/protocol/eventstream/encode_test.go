@@ -0,0 +1,116 @@
+package eventstream
+
+import (
+	"bytes"
+	"hash/crc32"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEncode(t *testing.T) {
+	cases := readPositiveTests(t, "testdata")
+
+	var buf bytes.Buffer
+	encoder := NewEncoder(&buf)
+
+	for _, c := range cases {
+		buf.Reset()
+
+		err := encoder.Encode(Message{
+			Headers: c.Decoded.Headers,
+			Payload: c.Decoded.Payload,
+		})
+		if err != nil {
+			t.Fatalf("%s, expect no encode error, got %v", c.Name, err)
+		}
+
+		if e, a := c.Encoded, buf.Bytes(); !bytes.Equal(e, a) {
+			t.Errorf("%s, expect encoded message to match\n%v\n%v", c.Name, e, a)
+		}
+	}
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	msgs := []Message{
+		{},
+		{Payload: []byte{}},
+		{Headers: Headers{{Name: "empty string", Value: StringValue("")}}},
+		{
+			Headers: Headers{
+				{Name: ":event-type", Value: StringValue("Records")},
+				{Name: "bool", Value: BoolValue(false)},
+				{Name: "int8", Value: Int8Value(-8)},
+				{Name: "int16", Value: Int16Value(-16)},
+				{Name: "int32", Value: Int32Value(-32)},
+				{Name: "int64", Value: Int64Value(-64)},
+				{Name: "bytes", Value: BytesValue([]byte{0, 1, 2})},
+				{Name: "timestamp", Value: TimestampValue(time.Unix(1500000000, 123*int64(time.Millisecond)).UTC())},
+				{Name: "uuid", Value: UUIDValue{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}},
+			},
+			Payload: []byte(`{"abc":123}`),
+		},
+	}
+
+	var buf bytes.Buffer
+	encoder := NewEncoder(&buf)
+	for i, msg := range msgs {
+		if err := encoder.Encode(msg); err != nil {
+			t.Fatalf("%d, expect no encode error, got %v", i, err)
+		}
+	}
+
+	decoder := NewDecoder(&buf)
+	for i, msg := range msgs {
+		actual, err := decoder.Decode(nil)
+		if err != nil {
+			t.Fatalf("%d, expect no decode error, got %v", i, err)
+		}
+		assertMessageEqual(t, strconv.Itoa(i), decodedMessage{Headers: msg.Headers, Payload: msg.Payload}, actual)
+	}
+}
+
+func TestEncode_InvalidHeaders(t *testing.T) {
+	cases := map[string]Headers{
+		"empty name":     {{Name: "", Value: BoolValue(true)}},
+		"long name":      {{Name: strings.Repeat("a", maxHeaderNameLen+1), Value: BoolValue(true)}},
+		"long value":     {{Name: "a", Value: StringValue(strings.Repeat("a", maxHeaderValueLen+1))}},
+		"nil value":      {{Name: "a"}},
+		"headers length": largeHeaders(),
+	}
+
+	for name, hs := range cases {
+		var buf bytes.Buffer
+		err := NewEncoder(&buf).Encode(Message{Headers: hs})
+		if err == nil {
+			t.Errorf("%s, expect error, got none", name)
+		}
+		if e, a := 0, buf.Len(); e != a {
+			t.Errorf("%s, expect nothing written, got %d bytes", name, a)
+		}
+	}
+}
+
+func TestEncode_PayloadTooLarge(t *testing.T) {
+	var buf bytes.Buffer
+	err := NewEncoder(&buf).Encode(Message{Payload: make([]byte, maxPayloadLen+1)})
+	if _, ok := err.(LengthError); !ok {
+		t.Errorf("expect LengthError, got %T, %v", err, err)
+	}
+}
+
+func largeHeaders() Headers {
+	var hs Headers
+	for i := 0; i < 5; i++ {
+		hs = append(hs, Header{
+			Name:  strings.Repeat(string(rune('a'+i)), 10),
+			Value: StringValue(strings.Repeat("a", maxHeaderValueLen)),
+		})
+	}
+	return hs
+}
+
+func crc32Checksum(b []byte) uint32 {
+	return crc32.Checksum(b, crc32IEEETable)
+}
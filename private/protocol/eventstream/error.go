@@ -0,0 +1,38 @@
+package eventstream
+
+import "fmt"
+
+// LengthError provides the error for items being larger than a maximum length,
+// or a length inconsistent with the message's prelude.
+type LengthError struct {
+	Part string
+	Want int
+	Have int
+}
+
+func (e LengthError) Error() string {
+	return fmt.Sprintf("%s length invalid, %d/%d", e.Part, e.Want, e.Have)
+}
+
+// ChecksumError provides the error for message checksum mismatches. Part
+// will be either "prelude" or "message".
+type ChecksumError struct {
+	Part string
+	Want uint32
+	Have uint32
+}
+
+func (e ChecksumError) Error() string {
+	return fmt.Sprintf("%s checksum mismatch, %08x/%08x", e.Part, e.Want, e.Have)
+}
+
+// HeaderError provides the error for a message header which could not be
+// decoded, such as an unknown header value type.
+type HeaderError struct {
+	Name string
+	Err  error
+}
+
+func (e HeaderError) Error() string {
+	return fmt.Sprintf("invalid message header %q, %v", e.Name, e.Err)
+}
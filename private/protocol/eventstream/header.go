@@ -0,0 +1,111 @@
+package eventstream
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const maxHeaderNameLen = 255
+
+// Headers are a collection of EventStream header values.
+type Headers []Header
+
+// Header is a single EventStream Key Value header pair.
+type Header struct {
+	Name  string
+	Value Value
+}
+
+// Set associates the name with a value. If the header name already exists in
+// the Headers the value will be replaced with the new one.
+func (hs *Headers) Set(name string, value Value) {
+	var i int
+	for ; i < len(*hs); i++ {
+		if (*hs)[i].Name == name {
+			(*hs)[i].Value = value
+			return
+		}
+	}
+
+	*hs = append(*hs, Header{
+		Name: name, Value: value,
+	})
+}
+
+// Get returns the Value associated with the header. Nil is returned if the
+// value does not exist.
+func (hs Headers) Get(name string) Value {
+	for i := 0; i < len(hs); i++ {
+		if h := hs[i]; h.Name == name {
+			return h.Value
+		}
+	}
+	return nil
+}
+
+// Del deletes the value in the Headers if it exists.
+func (hs *Headers) Del(name string) {
+	for i := 0; i < len(*hs); i++ {
+		if (*hs)[i].Name == name {
+			copy((*hs)[i:], (*hs)[i+1:])
+			(*hs) = (*hs)[:len(*hs)-1]
+			i--
+		}
+	}
+}
+
+func encodeHeaders(w io.Writer, headers Headers) error {
+	for _, h := range headers {
+		if len(h.Name) == 0 || len(h.Name) > maxHeaderNameLen {
+			return LengthError{
+				Part: "header name",
+				Want: maxHeaderNameLen, Have: len(h.Name),
+			}
+		}
+		if h.Value == nil {
+			return HeaderError{Name: h.Name, Err: fmt.Errorf("nil header value")}
+		}
+
+		if err := binary.Write(w, binary.BigEndian, uint8(len(h.Name))); err != nil {
+			return err
+		}
+		if _, err := w.Write([]byte(h.Name)); err != nil {
+			return err
+		}
+		if err := h.Value.encode(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func decodeHeaders(r io.Reader) (Headers, error) {
+	hs := Headers{}
+
+	for {
+		var n uint8
+		if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+			if err == io.EOF {
+				return hs, nil
+			}
+			return nil, err
+		}
+		if n == 0 {
+			return nil, LengthError{Part: "header name", Want: maxHeaderNameLen, Have: 0}
+		}
+
+		name := make([]byte, n)
+		if _, err := io.ReadFull(r, name); err != nil {
+			return nil, HeaderError{Name: string(name), Err: err}
+		}
+
+		value, err := decodeHeaderValue(r)
+		if err != nil {
+			return nil, HeaderError{Name: string(name), Err: err}
+		}
+
+		hs = append(hs, Header{Name: string(name), Value: value})
+	}
+}
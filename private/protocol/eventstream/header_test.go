@@ -0,0 +1,54 @@
+package eventstream
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHeaders_Set(t *testing.T) {
+	var hs Headers
+	hs.Set("abc", StringValue("123"))
+	hs.Set("def", StringValue("456"))
+	hs.Set("abc", StringValue("789"))
+
+	expect := Headers{
+		{Name: "abc", Value: StringValue("789")},
+		{Name: "def", Value: StringValue("456")},
+	}
+	if e, a := expect, hs; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v headers, got %v", e, a)
+	}
+}
+
+func TestHeaders_Get(t *testing.T) {
+	hs := Headers{
+		{Name: "abc", Value: StringValue("123")},
+		{Name: "def", Value: Int32Value(456)},
+	}
+
+	if e, a := StringValue("123"), hs.Get("abc"); e != a {
+		t.Errorf("expect %v value, got %v", e, a)
+	}
+	if e, a := Int32Value(456), hs.Get("def"); e != a {
+		t.Errorf("expect %v value, got %v", e, a)
+	}
+	if v := hs.Get("ghi"); v != nil {
+		t.Errorf("expect no value, got %v", v)
+	}
+}
+
+func TestHeaders_Del(t *testing.T) {
+	hs := Headers{
+		{Name: "abc", Value: StringValue("123")},
+		{Name: "abc", Value: StringValue("456")},
+		{Name: "def", Value: StringValue("789")},
+	}
+	hs.Del("abc")
+
+	expect := Headers{
+		{Name: "def", Value: StringValue("789")},
+	}
+	if e, a := expect, hs; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v headers, got %v", e, a)
+	}
+}
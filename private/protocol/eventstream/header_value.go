@@ -0,0 +1,367 @@
+package eventstream
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+const maxHeaderValueLen = 1<<15 - 1 // 2^15-1 or 32KB - 1
+
+// valueType is the EventStream header value type.
+type valueType uint8
+
+// Header value types
+const (
+	trueValueType valueType = iota
+	falseValueType
+	int8ValueType  // Byte
+	int16ValueType // Short
+	int32ValueType // Integer
+	int64ValueType // Long
+	bytesValueType
+	stringValueType
+	timestampValueType
+	uuidValueType
+)
+
+func (t valueType) String() string {
+	switch t {
+	case trueValueType:
+		return "bool"
+	case falseValueType:
+		return "bool"
+	case int8ValueType:
+		return "int8"
+	case int16ValueType:
+		return "int16"
+	case int32ValueType:
+		return "int32"
+	case int64ValueType:
+		return "int64"
+	case bytesValueType:
+		return "byte_array"
+	case stringValueType:
+		return "string"
+	case timestampValueType:
+		return "timestamp"
+	case uuidValueType:
+		return "uuid"
+	default:
+		return fmt.Sprintf("unknown value type %d", uint8(t))
+	}
+}
+
+// Value represents the abstract header value.
+type Value interface {
+	// Get returns the Go value of the header value.
+	Get() interface{}
+	String() string
+
+	valueType() valueType
+	encode(io.Writer) error
+}
+
+// BoolValue provides eventstream encoding, and representation
+// of a Go bool value.
+type BoolValue bool
+
+// Get returns the underlying type
+func (v BoolValue) Get() interface{} {
+	return bool(v)
+}
+
+func (v BoolValue) valueType() valueType {
+	if v {
+		return trueValueType
+	}
+	return falseValueType
+}
+
+func (v BoolValue) String() string {
+	return strconv.FormatBool(bool(v))
+}
+
+// encode encodes the BoolValue into an eventstream binary value
+// representation. The value is encoded by the header value type alone.
+func (v BoolValue) encode(w io.Writer) error {
+	return binary.Write(w, binary.BigEndian, v.valueType())
+}
+
+// Int8Value provides eventstream encoding, and representation of a Go
+// int8 value.
+type Int8Value int8
+
+// Get returns the underlying value.
+func (v Int8Value) Get() interface{} {
+	return int8(v)
+}
+
+func (Int8Value) valueType() valueType {
+	return int8ValueType
+}
+
+func (v Int8Value) String() string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+func (v Int8Value) encode(w io.Writer) error {
+	return writeValue(w, v.valueType(), int8(v))
+}
+
+// Int16Value provides eventstream encoding, and representation of a Go
+// int16 value.
+type Int16Value int16
+
+// Get returns the underlying value.
+func (v Int16Value) Get() interface{} {
+	return int16(v)
+}
+
+func (Int16Value) valueType() valueType {
+	return int16ValueType
+}
+
+func (v Int16Value) String() string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+func (v Int16Value) encode(w io.Writer) error {
+	return writeValue(w, v.valueType(), int16(v))
+}
+
+// Int32Value provides eventstream encoding, and representation of a Go
+// int32 value.
+type Int32Value int32
+
+// Get returns the underlying value.
+func (v Int32Value) Get() interface{} {
+	return int32(v)
+}
+
+func (Int32Value) valueType() valueType {
+	return int32ValueType
+}
+
+func (v Int32Value) String() string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+func (v Int32Value) encode(w io.Writer) error {
+	return writeValue(w, v.valueType(), int32(v))
+}
+
+// Int64Value provides eventstream encoding, and representation of a Go
+// int64 value.
+type Int64Value int64
+
+// Get returns the underlying value.
+func (v Int64Value) Get() interface{} {
+	return int64(v)
+}
+
+func (Int64Value) valueType() valueType {
+	return int64ValueType
+}
+
+func (v Int64Value) String() string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+func (v Int64Value) encode(w io.Writer) error {
+	return writeValue(w, v.valueType(), int64(v))
+}
+
+// BytesValue provides eventstream encoding, and representation of a Go
+// byte slice.
+type BytesValue []byte
+
+// Get returns the underlying value.
+func (v BytesValue) Get() interface{} {
+	return []byte(v)
+}
+
+func (BytesValue) valueType() valueType {
+	return bytesValueType
+}
+
+func (v BytesValue) String() string {
+	return hex.EncodeToString([]byte(v))
+}
+
+func (v BytesValue) encode(w io.Writer) error {
+	if err := binary.Write(w, binary.BigEndian, v.valueType()); err != nil {
+		return err
+	}
+	return writeBytesValue(w, []byte(v))
+}
+
+// StringValue provides eventstream encoding, and representation of a Go
+// string.
+type StringValue string
+
+// Get returns the underlying value.
+func (v StringValue) Get() interface{} {
+	return string(v)
+}
+
+func (StringValue) valueType() valueType {
+	return stringValueType
+}
+
+func (v StringValue) String() string {
+	return string(v)
+}
+
+func (v StringValue) encode(w io.Writer) error {
+	if err := binary.Write(w, binary.BigEndian, v.valueType()); err != nil {
+		return err
+	}
+	return writeBytesValue(w, []byte(v))
+}
+
+// TimestampValue provides eventstream encoding, and representation of a Go
+// time value. Timestamps are encoded as milliseconds since the unix epoch.
+type TimestampValue time.Time
+
+// Get returns the underlying value.
+func (v TimestampValue) Get() interface{} {
+	return time.Time(v)
+}
+
+func (TimestampValue) valueType() valueType {
+	return timestampValueType
+}
+
+func (v TimestampValue) epochMilli() int64 {
+	nano := time.Time(v).UnixNano()
+	return nano / int64(time.Millisecond)
+}
+
+func (v TimestampValue) String() string {
+	return time.Time(v).UTC().Format(time.RFC3339Nano)
+}
+
+func (v TimestampValue) encode(w io.Writer) error {
+	return writeValue(w, v.valueType(), v.epochMilli())
+}
+
+func timeFromEpochMilli(t int64) time.Time {
+	secs := t / 1e3
+	msec := t % 1e3
+	return time.Unix(secs, msec*int64(time.Millisecond)).UTC()
+}
+
+// UUIDValue provides eventstream encoding, and representation of a UUID
+// value.
+type UUIDValue [16]byte
+
+// Get returns the underlying value.
+func (v UUIDValue) Get() interface{} {
+	return v[:]
+}
+
+func (UUIDValue) valueType() valueType {
+	return uuidValueType
+}
+
+func (v UUIDValue) String() string {
+	return fmt.Sprintf(`%X-%X-%X-%X-%X`, v[0:4], v[4:6], v[6:8], v[8:10], v[10:])
+}
+
+func (v UUIDValue) encode(w io.Writer) error {
+	if err := binary.Write(w, binary.BigEndian, v.valueType()); err != nil {
+		return err
+	}
+	_, err := w.Write(v[:])
+	return err
+}
+
+func writeValue(w io.Writer, t valueType, v interface{}) error {
+	if err := binary.Write(w, binary.BigEndian, t); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.BigEndian, v)
+}
+
+func writeBytesValue(w io.Writer, v []byte) error {
+	if len(v) > maxHeaderValueLen {
+		return LengthError{
+			Part: "header value",
+			Want: maxHeaderValueLen, Have: len(v),
+		}
+	}
+	if err := binary.Write(w, binary.BigEndian, uint16(len(v))); err != nil {
+		return err
+	}
+	_, err := w.Write(v)
+	return err
+}
+
+func decodeHeaderValue(r io.Reader) (Value, error) {
+	var raw valueType
+	if err := binary.Read(r, binary.BigEndian, &raw); err != nil {
+		return nil, err
+	}
+
+	switch raw {
+	case trueValueType:
+		return BoolValue(true), nil
+	case falseValueType:
+		return BoolValue(false), nil
+	case int8ValueType:
+		var v int8
+		err := binary.Read(r, binary.BigEndian, &v)
+		return Int8Value(v), err
+	case int16ValueType:
+		var v int16
+		err := binary.Read(r, binary.BigEndian, &v)
+		return Int16Value(v), err
+	case int32ValueType:
+		var v int32
+		err := binary.Read(r, binary.BigEndian, &v)
+		return Int32Value(v), err
+	case int64ValueType:
+		var v int64
+		err := binary.Read(r, binary.BigEndian, &v)
+		return Int64Value(v), err
+	case bytesValueType:
+		v, err := readBytesValue(r)
+		return BytesValue(v), err
+	case stringValueType:
+		v, err := readBytesValue(r)
+		return StringValue(v), err
+	case timestampValueType:
+		var v int64
+		err := binary.Read(r, binary.BigEndian, &v)
+		return TimestampValue(timeFromEpochMilli(v)), err
+	case uuidValueType:
+		var v UUIDValue
+		_, err := io.ReadFull(r, v[:])
+		return v, err
+	default:
+		return nil, fmt.Errorf("unknown header value type %d", uint8(raw))
+	}
+}
+
+func readBytesValue(r io.Reader) ([]byte, error) {
+	var n uint16
+	if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+		return nil, err
+	}
+	if n > maxHeaderValueLen {
+		return nil, LengthError{
+			Part: "header value",
+			Want: maxHeaderValueLen, Have: int(n),
+		}
+	}
+
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
@@ -0,0 +1,76 @@
+// Package eventstream provides encoding and decoding of the AWS event stream
+// binary message framing, "application/vnd.amazon.eventstream".
+//
+// Each message is framed by a prelude containing the total length of the
+// message and the length of the headers, followed by a CRC32 checksum of the
+// prelude. The prelude is followed by the message's headers, payload, and a
+// CRC32 checksum of the entire message.
+package eventstream
+
+import "hash/crc32"
+
+const (
+	totalLenLen   = 4
+	headersLenLen = 4
+	preludeCRCLen = 4
+	messageCRCLen = 4
+
+	preludeLen = totalLenLen + headersLenLen
+	minMsgLen  = preludeLen + preludeCRCLen + messageCRCLen
+
+	maxPayloadLen = 1024 * 1024 * 16 // 16MB
+	maxHeadersLen = 1024 * 128       // 128KB
+	maxMsgLen     = minMsgLen + maxHeadersLen + maxPayloadLen
+)
+
+var crc32IEEETable = crc32.MakeTable(crc32.IEEE)
+
+// A Message provides the eventstream message representation.
+type Message struct {
+	Headers Headers
+	Payload []byte
+}
+
+// messagePrelude is the length prefix of an eventstream message.
+type messagePrelude struct {
+	Length     uint32
+	HeadersLen uint32
+	PreludeCRC uint32
+}
+
+func (p messagePrelude) PayloadLen() uint32 {
+	return p.Length - p.HeadersLen - minMsgLen
+}
+
+func (p messagePrelude) ValidateLens() error {
+	if p.Length > maxMsgLen {
+		return LengthError{
+			Part: "message prelude",
+			Want: maxMsgLen,
+			Have: int(p.Length),
+		}
+	}
+	if p.HeadersLen > maxHeadersLen {
+		return LengthError{
+			Part: "message headers",
+			Want: maxHeadersLen,
+			Have: int(p.HeadersLen),
+		}
+	}
+	if p.Length < minMsgLen+p.HeadersLen {
+		return LengthError{
+			Part: "message headers",
+			Want: int(p.Length) - minMsgLen,
+			Have: int(p.HeadersLen),
+		}
+	}
+	if payloadLen := p.PayloadLen(); payloadLen > maxPayloadLen {
+		return LengthError{
+			Part: "message payload",
+			Want: maxPayloadLen,
+			Have: int(payloadLen),
+		}
+	}
+
+	return nil
+}
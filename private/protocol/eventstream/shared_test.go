@@ -0,0 +1,180 @@
+package eventstream
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type testCase struct {
+	Name    string
+	Encoded []byte
+	Decoded decodedMessage
+}
+
+type testErrorCase struct {
+	Name    string
+	Encoded []byte
+	Err     string
+}
+
+type decodedMessage struct {
+	Headers Headers
+	Payload []byte
+}
+
+func (d *decodedMessage) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Headers []struct {
+			Name  string          `json:"name"`
+			Type  valueType       `json:"type"`
+			Value json.RawMessage `json:"value"`
+		} `json:"headers"`
+		Payload []byte `json:"payload"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	d.Payload = raw.Payload
+	for _, h := range raw.Headers {
+		v, err := decodedHeaderValue(h.Type, h.Value)
+		if err != nil {
+			return fmt.Errorf("header %s, %v", h.Name, err)
+		}
+		d.Headers = append(d.Headers, Header{Name: h.Name, Value: v})
+	}
+
+	return nil
+}
+
+func decodedHeaderValue(t valueType, raw json.RawMessage) (Value, error) {
+	switch t {
+	case trueValueType, falseValueType:
+		var v bool
+		err := json.Unmarshal(raw, &v)
+		return BoolValue(v), err
+	case int8ValueType:
+		var v int8
+		err := json.Unmarshal(raw, &v)
+		return Int8Value(v), err
+	case int16ValueType:
+		var v int16
+		err := json.Unmarshal(raw, &v)
+		return Int16Value(v), err
+	case int32ValueType:
+		var v int32
+		err := json.Unmarshal(raw, &v)
+		return Int32Value(v), err
+	case int64ValueType:
+		var v int64
+		err := json.Unmarshal(raw, &v)
+		return Int64Value(v), err
+	case bytesValueType:
+		var v []byte
+		err := json.Unmarshal(raw, &v)
+		return BytesValue(v), err
+	case stringValueType:
+		var v []byte
+		err := json.Unmarshal(raw, &v)
+		return StringValue(v), err
+	case timestampValueType:
+		var v int64
+		err := json.Unmarshal(raw, &v)
+		return TimestampValue(timeFromEpochMilli(v)), err
+	case uuidValueType:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, err
+		}
+		var v UUIDValue
+		copy(v[:], b)
+		return v, nil
+	default:
+		return nil, fmt.Errorf("unknown value type %d", t)
+	}
+}
+
+func readPositiveTests(t *testing.T, root string) []testCase {
+	names := readTestNames(t, filepath.Join(root, "encoded", "positive"))
+
+	cases := make([]testCase, 0, len(names))
+	for _, name := range names {
+		c := testCase{Name: name}
+		c.Encoded = readTestFile(t, filepath.Join(root, "encoded", "positive", name))
+
+		b := readTestFile(t, filepath.Join(root, "decoded", "positive", name))
+		if err := json.Unmarshal(b, &c.Decoded); err != nil {
+			t.Fatalf("%s, failed to decode test case, %v", name, err)
+		}
+		cases = append(cases, c)
+	}
+
+	return cases
+}
+
+func readNegativeTests(t *testing.T, root string) []testErrorCase {
+	names := readTestNames(t, filepath.Join(root, "encoded", "negative"))
+
+	cases := make([]testErrorCase, 0, len(names))
+	for _, name := range names {
+		cases = append(cases, testErrorCase{
+			Name:    name,
+			Encoded: readTestFile(t, filepath.Join(root, "encoded", "negative", name)),
+			Err:     strings.TrimSpace(string(readTestFile(t, filepath.Join(root, "decoded", "negative", name)))),
+		})
+	}
+
+	return cases
+}
+
+func readTestNames(t *testing.T, dir string) []string {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to read test directory %s, %v", dir, err)
+	}
+
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		names = append(names, f.Name())
+	}
+	return names
+}
+
+func readTestFile(t *testing.T, filename string) []byte {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("failed to read test file %s, %v", filename, err)
+	}
+	return b
+}
+
+func assertMessageEqual(t *testing.T, name string, expect decodedMessage, actual Message) {
+	if e, a := len(expect.Headers), len(actual.Headers); e != a {
+		t.Fatalf("%s, expect %d headers, got %d", name, e, a)
+	}
+	for i, h := range expect.Headers {
+		ah := actual.Headers[i]
+		if e, a := h.Name, ah.Name; e != a {
+			t.Errorf("%s, expect %v header name, got %v", name, e, a)
+		}
+		if e, a := h.Value.String(), ah.Value.String(); e != a {
+			t.Errorf("%s, expect %v %s header value, got %v", name, e, h.Name, a)
+		}
+		if e, a := h.Value.valueType(), ah.Value.valueType(); e != a {
+			t.Errorf("%s, expect %v %s header type, got %v", name, e, h.Name, a)
+		}
+	}
+	if e, a := expect.Payload, actual.Payload; !bytes.Equal(e, a) {
+		t.Errorf("%s, expect %v payload, got %v", name, e, a)
+	}
+}
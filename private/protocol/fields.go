@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"io"
+	"math"
+	"math/big"
 	"strconv"
 	"strings"
 	"time"
@@ -49,6 +52,7 @@ func (v BytesValue) MarshalValueBuf(b []byte) ([]byte, error) {
 	if cap(b) < n {
 		b = make([]byte, n)
 	}
+	b = b[:n]
 	base64.StdEncoding.Encode(b, m)
 	return b, nil
 }
@@ -90,9 +94,10 @@ func (v Int64Value) MarshalValueBuf(b []byte) ([]byte, error) {
 // Float64Value provies encoding of float64 for AWS protocols.
 type Float64Value float64
 
-// MarshalValue formats the value into a string for encoding.
+// MarshalValue formats the value into a string for encoding. Non-finite
+// values are formatted as the NaN, Infinity, and -Infinity string tokens.
 func (v Float64Value) MarshalValue() (string, error) {
-	return strconv.FormatFloat(float64(v), 'f', -1, 64), nil
+	return FormatFloat64(float64(v)), nil
 }
 
 // MarshalValueBuf formats the value into a byte slice for encoding.
@@ -101,7 +106,207 @@ func (v Float64Value) MarshalValue() (string, error) {
 // Will reset the length of the passed in slice to 0.
 func (v Float64Value) MarshalValueBuf(b []byte) ([]byte, error) {
 	b = b[0:0]
-	return strconv.AppendFloat(b, float64(v), 'f', -1, 64), nil
+	return AppendFloat64(b, float64(v)), nil
+}
+
+// String tokens used by AWS protocols to represent non-finite float values.
+const (
+	FloatNaN              = "NaN"
+	FloatInfinity         = "Infinity"
+	FloatNegativeInfinity = "-Infinity"
+)
+
+// IsFiniteFloat64 returns if the value is neither NaN, nor positive or
+// negative infinity.
+func IsFiniteFloat64(v float64) bool {
+	return !(math.IsNaN(v) || math.IsInf(v, 0))
+}
+
+// FormatFloat64 formats the float value into its protocol string form.
+// Non-finite values are formatted as their string tokens.
+func FormatFloat64(v float64) string {
+	return string(AppendFloat64(nil, v))
+}
+
+// AppendFloat64 appends the protocol string form of the float value to b.
+// Non-finite values are appended as their string tokens.
+func AppendFloat64(b []byte, v float64) []byte {
+	switch {
+	case math.IsNaN(v):
+		return append(b, FloatNaN...)
+	case math.IsInf(v, 1):
+		return append(b, FloatInfinity...)
+	case math.IsInf(v, -1):
+		return append(b, FloatNegativeInfinity...)
+	}
+	return strconv.AppendFloat(b, v, 'f', -1, 64)
+}
+
+// ParseFloat64 parses the protocol string form of a float value, including
+// the NaN, Infinity, and -Infinity string tokens.
+func ParseFloat64(s string) (float64, error) {
+	switch s {
+	case FloatNaN:
+		return math.NaN(), nil
+	case FloatInfinity:
+		return math.Inf(1), nil
+	case FloatNegativeInfinity:
+		return math.Inf(-1), nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// QuotedInt64Value provides encoding of int64 for AWS protocols which
+// require the value to be sent as a string, e.g. a JSON string instead of a
+// JSON number. Header and query values are the same as Int64Value.
+type QuotedInt64Value int64
+
+// MarshalValue formats the value into a string for encoding.
+func (v QuotedInt64Value) MarshalValue() (string, error) {
+	return Int64Value(v).MarshalValue()
+}
+
+// MarshalValueBuf formats the value into a byte slice for encoding.
+// If there is enough room in the passed in slice v will be appended to it.
+//
+// Will reset the length of the passed in slice to 0.
+func (v QuotedInt64Value) MarshalValueBuf(b []byte) ([]byte, error) {
+	return Int64Value(v).MarshalValueBuf(b)
+}
+
+// QuotedFloat64Value provides encoding of float64 for AWS protocols which
+// require the value to be sent as a string, e.g. a JSON string instead of a
+// JSON number. Header and query values are the same as Float64Value.
+type QuotedFloat64Value float64
+
+// MarshalValue formats the value into a string for encoding.
+func (v QuotedFloat64Value) MarshalValue() (string, error) {
+	return Float64Value(v).MarshalValue()
+}
+
+// MarshalValueBuf formats the value into a byte slice for encoding.
+// If there is enough room in the passed in slice v will be appended to it.
+//
+// Will reset the length of the passed in slice to 0.
+func (v QuotedFloat64Value) MarshalValueBuf(b []byte) ([]byte, error) {
+	return Float64Value(v).MarshalValueBuf(b)
+}
+
+// QuotedBoolValue provides encoding of bool for AWS protocols which require
+// the value to be sent as a string, e.g. a JSON string instead of a JSON
+// boolean. Header and query values are the same as BoolValue.
+type QuotedBoolValue bool
+
+// MarshalValue formats the value into a string for encoding.
+func (v QuotedBoolValue) MarshalValue() (string, error) {
+	return BoolValue(v).MarshalValue()
+}
+
+// MarshalValueBuf formats the value into a byte slice for encoding.
+// If there is enough room in the passed in slice v will be appended to it.
+//
+// Will reset the length of the passed in slice to 0.
+func (v QuotedBoolValue) MarshalValueBuf(b []byte) ([]byte, error) {
+	return BoolValue(v).MarshalValueBuf(b)
+}
+
+// BigIntValue provides encoding of arbitrary precision integers for AWS
+// protocols.
+type BigIntValue struct {
+	V *big.Int
+}
+
+// MarshalValue formats the value into a string for encoding.
+func (v BigIntValue) MarshalValue() (string, error) {
+	if v.V == nil {
+		return "", fmt.Errorf("nil big.Int value")
+	}
+	return v.V.String(), nil
+}
+
+// MarshalValueBuf formats the value into a byte slice for encoding.
+// If there is enough room in the passed in slice v will be appended to it.
+//
+// Will reset the length of the passed in slice to 0.
+func (v BigIntValue) MarshalValueBuf(b []byte) ([]byte, error) {
+	b = b[0:0]
+	if v.V == nil {
+		return nil, fmt.Errorf("nil big.Int value")
+	}
+	return v.V.Append(b, 10), nil
+}
+
+// BigFloatValue provides encoding of arbitrary precision floats for AWS
+// protocols. The value is formatted as a decimal number, with the fewest
+// digits needed to represent the value exactly at its precision.
+type BigFloatValue struct {
+	V *big.Float
+}
+
+// MarshalValue formats the value into a string for encoding. Infinite values
+// are formatted as the Infinity, and -Infinity string tokens.
+func (v BigFloatValue) MarshalValue() (string, error) {
+	b, err := v.MarshalValueBuf(nil)
+	return string(b), err
+}
+
+// MarshalValueBuf formats the value into a byte slice for encoding.
+// If there is enough room in the passed in slice v will be appended to it.
+//
+// Will reset the length of the passed in slice to 0.
+func (v BigFloatValue) MarshalValueBuf(b []byte) ([]byte, error) {
+	b = b[0:0]
+	switch {
+	case v.V == nil:
+		return nil, fmt.Errorf("nil big.Float value")
+	case v.V.IsInf() && v.V.Sign() > 0:
+		return append(b, FloatInfinity...), nil
+	case v.V.IsInf():
+		return append(b, FloatNegativeInfinity...), nil
+	}
+	return v.V.Append(b, 'f', -1), nil
+}
+
+// ParseBigInt parses the decimal string form of an integer value. Values
+// with an exponent, e.g. 1e3, are accepted if they are integral.
+func ParseBigInt(s string) (*big.Int, error) {
+	if v, ok := new(big.Int).SetString(s, 10); ok {
+		return v, nil
+	}
+
+	f, err := ParseBigFloat(s)
+	if err != nil {
+		return nil, err
+	}
+	v, acc := f.Int(nil)
+	if f.IsInf() || acc != big.Exact {
+		return nil, fmt.Errorf("invalid integer value, %s", s)
+	}
+	return v, nil
+}
+
+// ParseBigFloat parses the decimal string form of a float value, including
+// the Infinity, and -Infinity string tokens. The precision of the returned
+// value is large enough to represent every digit of s.
+func ParseBigFloat(s string) (*big.Float, error) {
+	switch s {
+	case FloatInfinity:
+		return new(big.Float).SetInf(false), nil
+	case FloatNegativeInfinity:
+		return new(big.Float).SetInf(true), nil
+	}
+
+	// Four bits per decimal digit is more than enough to not lose any of
+	// the digits, log2(10) ~= 3.32.
+	prec := uint(len(s)) * 4
+	if prec < 64 {
+		prec = 64
+	}
+	v, _, err := big.ParseFloat(s, 10, prec, big.ToNearestEven)
+	if err != nil {
+		return nil, fmt.Errorf("invalid float value, %s, %v", s, err)
+	}
+	return v, nil
 }
 
 // JSONValue provies encoding of aws.JSONValues for AWS protocols.
@@ -145,9 +350,12 @@ func (v JSONValue) MarshalValueBuf(b []byte) ([]byte, error) {
 
 // Time formats for protocol time fields.
 const (
-	ISO8601TimeFormat = "2006-01-02T15:04:05Z"         // ISO 8601 formated time.
-	RFC822TimeFromat  = "Mon, 2 Jan 2006 15:04:05 GMT" // RFC822 formatted time.
-	UnixTimeFormat    = "unix time format"             // Special case for Unix time
+	ISO8601TimeFormat      = "2006-01-02T15:04:05Z"          // ISO 8601 formated time.
+	ISO8601MilliTimeFormat = "2006-01-02T15:04:05.000Z"      // ISO 8601 formated time with milliseconds.
+	RFC822TimeFromat       = "Mon, 2 Jan 2006 15:04:05 GMT"  // RFC822 formatted time.
+	RFC7231TimeFormat      = "Mon, 02 Jan 2006 15:04:05 GMT" // RFC 7231 IMF-fixdate formatted time.
+	UnixTimeFormat         = "unix time format"              // Special case for Unix time
+	UnixMilliTimeFormat    = "unix milli time format"        // Special case for Unix time in milliseconds
 )
 
 // TimeValue provies encoding of time.Time for AWS protocols.
@@ -160,12 +368,53 @@ type TimeValue struct {
 func (v TimeValue) MarshalValue() (string, error) {
 	t := time.Time(v.V)
 
-	if v.Format == UnixTimeFormat {
+	switch v.Format {
+	case UnixTimeFormat:
 		return strconv.FormatInt(t.UTC().Unix(), 10), nil
+	case UnixMilliTimeFormat:
+		return strconv.FormatInt(unixMilli(t), 10), nil
 	}
 	return t.UTC().Format(v.Format), nil
 }
 
+// unixMilli returns the time as milliseconds since the Unix epoch. Unlike
+// UnixNano, it does not overflow for times far from the epoch, such as the
+// zero value of time.Time.
+func unixMilli(t time.Time) int64 {
+	return t.Unix()*1000 + int64(t.Nanosecond())/int64(time.Millisecond)
+}
+
+// ParseTime parses the string form of a timestamp in the format, e.g.
+// ISO8601TimeFormat. The ISO 8601 formats accept values with or without
+// fractional seconds, and UnixTimeFormat accepts fractional seconds. The
+// time returned is in UTC.
+func ParseTime(format, v string) (time.Time, error) {
+	switch format {
+	case ISO8601TimeFormat, ISO8601MilliTimeFormat:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		return t.UTC(), err
+	case UnixTimeFormat:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return time.Time{}, fmt.Errorf("invalid unix timestamp, %s", v)
+		}
+		sec, frac := math.Modf(f)
+		return time.Unix(int64(sec), int64(frac*float64(time.Second))).UTC(), nil
+	case UnixMilliTimeFormat:
+		ms, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(ms/1000, (ms%1000)*int64(time.Millisecond)).UTC(), nil
+	default:
+		t, err := time.Parse(format, v)
+		return t.UTC(), err
+	}
+}
+
 // MarshalValueBuf formats the value into a byte slice for encoding.
 // If there is enough room in the passed in slice v will be appended to it.
 //
@@ -191,6 +440,26 @@ func (v ReadSeekerStream) MarshalStream() (io.ReadSeeker, error) {
 	return v.V, nil
 }
 
+// A ReaderStream wraps an io.Reader, which is not required to be seekable,
+// to be used as a ReaderStreamMarshaler.
+type ReaderStream struct {
+	V io.Reader
+}
+
+// MarshalStream returns the wrapped io.Reader for encoding if it is also an
+// io.ReadSeeker. An error is returned if the reader is not seekable.
+func (v ReaderStream) MarshalStream() (io.ReadSeeker, error) {
+	if rs, ok := v.V.(io.ReadSeeker); ok && aws.IsReaderSeekable(rs) {
+		return rs, nil
+	}
+	return nil, fmt.Errorf("stream reader is not seekable, %T", v.V)
+}
+
+// MarshalReaderStream returns the wrapped io.Reader for encoding.
+func (v ReaderStream) MarshalReaderStream() (io.Reader, error) {
+	return v.V, nil
+}
+
 // A BytesStream aliases a byte slice to be used as a StreamMarshaler.
 type BytesStream []byte
 
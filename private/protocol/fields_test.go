@@ -0,0 +1,322 @@
+package protocol
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestFloat64Value_NonFinite(t *testing.T) {
+	cases := []struct {
+		Value  float64
+		Expect string
+	}{
+		{Value: math.NaN(), Expect: "NaN"},
+		{Value: math.Inf(1), Expect: "Infinity"},
+		{Value: math.Inf(-1), Expect: "-Infinity"},
+		{Value: 1.5, Expect: "1.5"},
+		{Value: float64(float32(0.25)), Expect: "0.25"},
+	}
+
+	for i, c := range cases {
+		str, err := Float64Value(c.Value).MarshalValue()
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Expect, str; e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+
+		b, err := Float64Value(c.Value).MarshalValueBuf(make([]byte, 0, 4))
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Expect, string(b); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+
+		v, err := ParseFloat64(str)
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if math.IsNaN(c.Value) {
+			if !math.IsNaN(v) {
+				t.Errorf("%d, expect NaN, got %v", i, v)
+			}
+		} else if e, a := c.Value, v; e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+}
+
+func TestBigIntValue(t *testing.T) {
+	cases := []string{
+		"0",
+		"-42",
+		"9223372036854775807",
+		"123456789012345678901234567890",
+		"-123456789012345678901234567890",
+	}
+
+	for i, c := range cases {
+		n, ok := new(big.Int).SetString(c, 10)
+		if !ok {
+			t.Fatalf("%d, failed to parse test value %s", i, c)
+		}
+
+		str, err := BigIntValue{V: n}.MarshalValue()
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c, str; e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+
+		b, err := BigIntValue{V: n}.MarshalValueBuf(make([]byte, 0, 4))
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c, string(b); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+
+		v, err := ParseBigInt(str)
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if v.Cmp(n) != 0 {
+			t.Errorf("%d, expect %v, got %v", i, n, v)
+		}
+	}
+
+	if _, err := (BigIntValue{}).MarshalValue(); err == nil {
+		t.Errorf("expect error for nil value")
+	}
+}
+
+func TestParseBigInt(t *testing.T) {
+	v, err := ParseBigInt("1.5e3")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "1500", v.String(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	for _, s := range []string{"1.5", "abc", "Infinity"} {
+		if _, err := ParseBigInt(s); err == nil {
+			t.Errorf("%s, expect error", s)
+		}
+	}
+}
+
+func TestBigFloatValue(t *testing.T) {
+	cases := []string{
+		"0",
+		"1.5",
+		"-0.001",
+		"12345678901234567890.123456789",
+		"-98765432109876543210987654321.000000000000000001",
+		"Infinity",
+		"-Infinity",
+	}
+
+	for i, c := range cases {
+		f, err := ParseBigFloat(c)
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+
+		str, err := BigFloatValue{V: f}.MarshalValue()
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c, str; e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+
+		b, err := BigFloatValue{V: f}.MarshalValueBuf(make([]byte, 0, 4))
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c, string(b); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+
+	if _, err := (BigFloatValue{}).MarshalValue(); err == nil {
+		t.Errorf("expect error for nil value")
+	}
+}
+
+func TestBigFloatValue_Precision(t *testing.T) {
+	const v = "12345678901234567890.123456789"
+
+	f, err := ParseBigFloat(v)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	str, err := BigFloatValue{V: f}.MarshalValue()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := v, str; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	// The float64 path loses the value's precision.
+	f64, err := ParseFloat64(v)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if str := FormatFloat64(f64); str == v {
+		t.Errorf("expect float64 to lose precision, got %v", str)
+	}
+}
+
+func TestQuotedValues(t *testing.T) {
+	cases := []struct {
+		Value  ValueMarshaler
+		Expect string
+	}{
+		{Value: QuotedInt64Value(math.MaxInt64), Expect: "9223372036854775807"},
+		{Value: QuotedInt64Value(math.MinInt64), Expect: "-9223372036854775808"},
+		{Value: QuotedFloat64Value(0.30000000000000004), Expect: "0.30000000000000004"},
+		{Value: QuotedFloat64Value(math.Inf(1)), Expect: "Infinity"},
+		{Value: QuotedBoolValue(true), Expect: "true"},
+	}
+
+	for i, c := range cases {
+		str, err := c.Value.MarshalValue()
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Expect, str; e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+
+		b, err := c.Value.MarshalValueBuf(make([]byte, 0, 4))
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Expect, string(b); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+}
+
+func TestTimeValue_RoundTrip(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data not available, %v", err)
+	}
+
+	times := []time.Time{
+		{}, // year 1, zero value
+		time.Date(1, 1, 1, 0, 0, 1, 0, time.UTC),
+		time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC),
+		time.Date(2017, 3, 12, 1, 59, 59, 0, ny), // before DST starts
+		time.Date(2017, 3, 12, 3, 0, 0, 0, ny),   // after DST starts
+		time.Date(2017, 11, 5, 1, 30, 0, 0, ny),  // ambiguous hour when DST ends
+		time.Date(2017, 11, 5, 1, 30, 0, 0, ny).Add(time.Hour),
+		time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+	}
+	formats := []string{
+		ISO8601TimeFormat,
+		ISO8601MilliTimeFormat,
+		UnixTimeFormat,
+		UnixMilliTimeFormat,
+	}
+
+	for _, format := range formats {
+		for i, v := range times {
+			s, err := TimeValue{V: v, Format: format}.MarshalValue()
+			if err != nil {
+				t.Fatalf("%s, %d, expect no marshal error, got %v", format, i, err)
+			}
+
+			actual, err := ParseTime(format, s)
+			if err != nil {
+				t.Fatalf("%s, %d, expect no parse error, got %v", format, i, err)
+			}
+			if !v.Equal(actual) {
+				t.Errorf("%s, %d, expect %v time from %s, got %v", format, i, v, s, actual)
+			}
+		}
+	}
+}
+
+func TestTimeValue_Formats(t *testing.T) {
+	v := time.Date(2017, 11, 6, 8, 49, 37, int(123*time.Millisecond), time.UTC)
+
+	cases := map[string]string{
+		ISO8601TimeFormat:      "2017-11-06T08:49:37Z",
+		ISO8601MilliTimeFormat: "2017-11-06T08:49:37.123Z",
+		UnixTimeFormat:         "1509958177",
+		UnixMilliTimeFormat:    "1509958177123",
+	}
+
+	for format, expect := range cases {
+		actual, err := TimeValue{V: v, Format: format}.MarshalValue()
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", format, err)
+		}
+		if e, a := expect, actual; e != a {
+			t.Errorf("%s, expect %s, got %s", format, e, a)
+		}
+	}
+
+	if e, a := "-62135596800000", mustMarshal(t, TimeValue{Format: UnixMilliTimeFormat}); e != a {
+		t.Errorf("expect %s zero time, got %s", e, a)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	expect := time.Date(2017, 11, 6, 8, 49, 37, int(500*time.Millisecond), time.UTC)
+
+	cases := []struct {
+		Format, Value string
+	}{
+		{ISO8601TimeFormat, "2017-11-06T08:49:37.5Z"},
+		{ISO8601MilliTimeFormat, "2017-11-06T08:49:37.500Z"},
+		{ISO8601TimeFormat, "2017-11-06T09:49:37.5+01:00"},
+		{UnixTimeFormat, "1509958177.5"},
+		{UnixMilliTimeFormat, "1509958177500"},
+	}
+
+	for _, c := range cases {
+		actual, err := ParseTime(c.Format, c.Value)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", c.Value, err)
+		}
+		if !expect.Equal(actual) {
+			t.Errorf("%s, expect %v, got %v", c.Value, expect, actual)
+		}
+		if e, a := time.UTC, actual.Location(); e != a {
+			t.Errorf("%s, expect %v location, got %v", c.Value, e, a)
+		}
+	}
+
+	invalid := []struct {
+		Format, Value string
+	}{
+		{ISO8601TimeFormat, "1509958177"},
+		{UnixTimeFormat, "2017-11-06T08:49:37Z"},
+		{UnixTimeFormat, "Infinity"},
+		{UnixMilliTimeFormat, "1509958177.5"},
+	}
+	for _, c := range invalid {
+		if _, err := ParseTime(c.Format, c.Value); err == nil {
+			t.Errorf("%s, expect error", c.Value)
+		}
+	}
+}
+
+func mustMarshal(t *testing.T, v ValueMarshaler) string {
+	s, err := v.MarshalValue()
+	if err != nil {
+		t.Fatalf("expect no marshal error, got %v", err)
+	}
+	return s
+}
@@ -0,0 +1,92 @@
+package protocol
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// HeaderMapDecoder decodes a map of values from all headers sharing a common
+// prefix, e.g. "X-Amz-Meta-". The prefix is matched case-insensitively, and is
+// removed from the map's keys. The remainder of the header name is kept in the
+// case it was received in.
+//
+// If a header has multiple values the values are joined with a comma, in the
+// order they were received, as described by RFC 7230 section 3.2.2. Headers
+// with an empty value are decoded as an empty string.
+type HeaderMapDecoder struct {
+	Prefix string
+	Header http.Header
+}
+
+// Keys returns the sorted keys of the map, with the prefix removed.
+func (d *HeaderMapDecoder) Keys() []string {
+	var ks []string
+	for k := range d.Header {
+		if hasPrefixFold(k, d.Prefix) {
+			ks = append(ks, k[len(d.Prefix):])
+		}
+	}
+	sort.Strings(ks)
+	return ks
+}
+
+// Values returns the map of header values with the prefix removed from the
+// keys.
+func (d *HeaderMapDecoder) Values() map[string]string {
+	out := map[string]string{}
+	for k, vs := range d.Header {
+		if hasPrefixFold(k, d.Prefix) {
+			out[k[len(d.Prefix):]] = strings.Join(vs, ",")
+		}
+	}
+	return out
+}
+
+// MapGet calls fn with the string value of the header k, with the prefix
+// prepended.
+func (d *HeaderMapDecoder) MapGet(k string, fn func(v FieldValue)) {
+	vs, ok := d.Header[d.Prefix+k]
+	if !ok {
+		for hk, hvs := range d.Header {
+			if hasPrefixFold(hk, d.Prefix) && hk[len(d.Prefix):] == k {
+				vs, ok = hvs, true
+				break
+			}
+		}
+	}
+	if !ok {
+		return
+	}
+
+	fn(strings.Join(vs, ","))
+}
+
+// MapGetList is not supported, header map of lists is undefined.
+func (d *HeaderMapDecoder) MapGetList(k string, fn func(n int, ld ListDecoder)) {}
+
+// MapGetMap is not supported, header map of maps is undefined.
+func (d *HeaderMapDecoder) MapGetMap(k string, fn func(ks []string, fd FieldDecoder)) {}
+
+// MapGetFields is not supported, header map of FieldUnmarshalers is undefined.
+func (d *HeaderMapDecoder) MapGetFields(k string, fn func() FieldUnmarshaler) {}
+
+// DecodeStringMap returns a function that will decode the values of a map
+// decoder into a map of string pointers, updating the value pointed to by the
+// input.
+func DecodeStringMap(vp *map[string]*string) func([]string, MapDecoder) {
+	return func(ks []string, md MapDecoder) {
+		m := make(map[string]*string, len(ks))
+		for _, k := range ks {
+			md.MapGet(k, func(v FieldValue) {
+				s := v.(string)
+				m[k] = &s
+			})
+		}
+		*vp = m
+	}
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
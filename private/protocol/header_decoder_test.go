@@ -0,0 +1,60 @@
+package protocol
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestHeaderMapDecoder(t *testing.T) {
+	header := http.Header{
+		"X-Amz-Meta-Foo":   []string{"bar"},
+		"x-amz-meta-CaSe":  []string{"kept"},
+		"X-Amz-Meta-Multi": []string{"a", "b"},
+		"X-Amz-Meta-Empty": []string{""},
+		"X-Amz-Other":      []string{"ignored"},
+	}
+
+	d := HeaderMapDecoder{Prefix: "x-AMZ-meta-", Header: header}
+
+	expectKeys := []string{"CaSe", "Empty", "Foo", "Multi"}
+	if e, a := expectKeys, d.Keys(); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v keys, got %v", e, a)
+	}
+
+	expectValues := map[string]string{
+		"Foo":   "bar",
+		"CaSe":  "kept",
+		"Multi": "a,b",
+		"Empty": "",
+	}
+	if e, a := expectValues, d.Values(); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v values, got %v", e, a)
+	}
+
+	var m map[string]*string
+	DecodeStringMap(&m)(d.Keys(), &d)
+	if e, a := len(expectValues), len(m); e != a {
+		t.Fatalf("expect %v values, got %v", e, a)
+	}
+	for k, v := range expectValues {
+		if m[k] == nil {
+			t.Errorf("expect %v key to be set", k)
+			continue
+		}
+		if e, a := v, *m[k]; e != a {
+			t.Errorf("expect %v value for %v, got %v", e, k, a)
+		}
+	}
+}
+
+func TestHeaderMapDecoderNoMatch(t *testing.T) {
+	d := HeaderMapDecoder{Prefix: "X-Amz-Meta-", Header: http.Header{"X-Amz-Other": []string{"v"}}}
+
+	if v := d.Keys(); len(v) != 0 {
+		t.Errorf("expect no keys, got %v", v)
+	}
+	if v := d.Values(); len(v) != 0 {
+		t.Errorf("expect no values, got %v", v)
+	}
+}
@@ -2,14 +2,95 @@ package protocol
 
 import (
 	"fmt"
+	"mime"
 	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Encodings of header values containing non-ASCII characters, selected with
+// Metadata.HeaderValueEncoding.
+const (
+	// HeaderValueEncodingRFC2047 encodes the value as an RFC 2047 MIME
+	// encoded-word, e.g. "=?UTF-8?B?w6k=?=".
+	HeaderValueEncodingRFC2047 = "RFC2047"
+
+	// HeaderValueEncodingURL percent-encodes the value, e.g. "%C3%A9".
+	HeaderValueEncodingURL = "URL"
 )
 
+// EncodeHeaderValue validates the header's name and value, returning the
+// value to set for the header. An error is returned if the name is not a
+// valid HTTP header name, or the value contains control characters.
+//
+// Values containing non-ASCII characters are encoded with encoding, if set.
+// Values are returned unmodified otherwise.
+func EncodeHeaderValue(name, value, encoding string) (string, error) {
+	if !validHeaderName(name) {
+		return "", awserr.New(request.InvalidParameterErrCode,
+			fmt.Sprintf("invalid header name %q", name), nil)
+	}
+
+	var nonASCII bool
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; {
+		case c == '\t':
+		case c < ' ' || c == 0x7f:
+			return "", awserr.New(request.InvalidParameterErrCode,
+				fmt.Sprintf("invalid header value for %s, control character at %d", name, i), nil)
+		case c >= 0x80:
+			nonASCII = true
+		}
+	}
+	if !nonASCII {
+		return value, nil
+	}
+
+	switch encoding {
+	case "":
+		return value, nil
+	case HeaderValueEncodingRFC2047:
+		return mime.BEncoding.Encode("UTF-8", value), nil
+	case HeaderValueEncodingURL:
+		return escapePath(value, true), nil
+	default:
+		return "", fmt.Errorf("unknown header value encoding %s, %s", encoding, name)
+	}
+}
+
+// validHeaderName returns if the name is a valid HTTP header field name,
+// made up of only RFC 7230 token characters.
+func validHeaderName(name string) bool {
+	if len(name) == 0 {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c >= '0' && c <= '9':
+		case c >= 'A' && c <= 'Z':
+		case c >= 'a' && c <= 'z':
+		default:
+			switch c {
+			case '!', '#', '$', '%', '&', '\'', '*', '+', '-', '.', '^', '_', '`', '|', '~':
+			default:
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // HeaderMapEncoder builds a map valu
 type HeaderMapEncoder struct {
 	Prefix string
 	Header http.Header
 	Err    error
+
+	// ValueEncoding is the encoding of values containing non-ASCII
+	// characters. See EncodeHeaderValue.
+	ValueEncoding string
 }
 
 // MapSetValue adds a single value to the header.
@@ -28,6 +109,9 @@ func (e *HeaderMapEncoder) MapSetValue(k string, v ValueMarshaler) {
 		k = e.Prefix + k
 	}
 
+	if str, e.Err = EncodeHeaderValue(k, str, e.ValueEncoding); e.Err != nil {
+		return
+	}
 	e.Header.Set(k, str)
 }
 
@@ -42,7 +126,7 @@ func (e *HeaderMapEncoder) MapSetList(k string, fn func(le ListEncoder)) {
 		k = e.Prefix + k
 	}
 
-	nested := HeaderListEncoder{Key: k, Header: e.Header}
+	nested := HeaderListEncoder{Key: k, Header: e.Header, ValueEncoding: e.ValueEncoding}
 	fn(&nested)
 	e.Err = nested.Err
 }
@@ -58,7 +142,7 @@ func (e *HeaderMapEncoder) MapSetMap(k string, fn func(me MapEncoder)) {
 		k = e.Prefix + k
 	}
 
-	nested := HeaderMapEncoder{Prefix: k, Header: e.Header}
+	nested := HeaderMapEncoder{Prefix: k, Header: e.Header, ValueEncoding: e.ValueEncoding}
 	fn(&nested)
 	e.Err = nested.Err
 }
@@ -73,6 +157,10 @@ type HeaderListEncoder struct {
 	Key    string
 	Header http.Header
 	Err    error
+
+	// ValueEncoding is the encoding of values containing non-ASCII
+	// characters. See EncodeHeaderValue.
+	ValueEncoding string
 }
 
 // ListAddValue encodes an individual list value into the header.
@@ -87,6 +175,9 @@ func (e *HeaderListEncoder) ListAddValue(v ValueMarshaler) {
 		return
 	}
 
+	if str, e.Err = EncodeHeaderValue(e.Key, str, e.ValueEncoding); e.Err != nil {
+		return
+	}
 	e.Header.Add(e.Key, str)
 }
 
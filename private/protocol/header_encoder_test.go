@@ -0,0 +1,65 @@
+package protocol
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestEncodeHeaderValue(t *testing.T) {
+	cases := []struct {
+		Name, Value, Encoding string
+		Expect                string
+		ExpectErr             bool
+	}{
+		{Name: "X-Amz-Meta-Foo", Value: "bar", Expect: "bar"},
+		{Name: "X-Amz-Meta-Foo", Value: "tab\tvalue", Expect: "tab\tvalue"},
+		{Name: "X-Amz-Meta-Foo", Value: "café", Expect: "café"},
+		{Name: "X-Amz-Meta-Foo", Value: "café", Encoding: HeaderValueEncodingRFC2047, Expect: "=?UTF-8?b?Y2Fmw6k=?="},
+		{Name: "X-Amz-Meta-Foo", Value: "café au lait", Encoding: HeaderValueEncodingURL, Expect: "caf%C3%A9%20au%20lait"},
+		{Name: "X-Amz-Meta-Foo", Value: "plain value", Encoding: HeaderValueEncodingURL, Expect: "plain value"},
+		{Name: "X-Amz-Meta-Foo", Value: "café", Encoding: "unknown", ExpectErr: true},
+		{Name: "X-Amz-Meta-Foo", Value: "bar\r\nX-Injected: value", ExpectErr: true},
+		{Name: "X-Amz-Meta-Foo", Value: "bar\nbaz", Encoding: HeaderValueEncodingRFC2047, ExpectErr: true},
+		{Name: "X-Amz-Meta-Foo", Value: "null\x00", ExpectErr: true},
+		{Name: "X-Amz-Meta-Foo", Value: "del\x7f", ExpectErr: true},
+		{Name: "X-Amz-Meta Foo", Value: "bar", ExpectErr: true},
+		{Name: "X-Amz-Meta-Foo\r\n", Value: "bar", ExpectErr: true},
+		{Name: "", Value: "bar", ExpectErr: true},
+	}
+
+	for i, c := range cases {
+		v, err := EncodeHeaderValue(c.Name, c.Value, c.Encoding)
+		if c.ExpectErr {
+			if err == nil {
+				t.Errorf("%d, expect error, got none", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Expect, v; e != a {
+			t.Errorf("%d, expect %q, got %q", i, e, a)
+		}
+	}
+}
+
+func TestHeaderMapEncoder_InvalidValue(t *testing.T) {
+	header := http.Header{}
+	e := HeaderMapEncoder{Prefix: "X-Amz-Meta-", Header: header}
+	e.MapSetValue("Foo", StringValue("bar\r\nX-Injected: value"))
+
+	aerr, ok := e.Err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T, %v", e.Err, e.Err)
+	}
+	if e, a := request.InvalidParameterErrCode, aerr.Code(); e != a {
+		t.Errorf("expect %v code, got %v", e, a)
+	}
+	if len(header) != 0 {
+		t.Errorf("expect no headers set, got %v", header)
+	}
+}
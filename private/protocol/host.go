@@ -0,0 +1,144 @@
+package protocol
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// HostPrefixHandlerName is the name of the request handler which prepends
+// an operation's host prefix to the request endpoint's host.
+const HostPrefixHandlerName = "awssdk.protocol.HostPrefixHandler"
+
+// NewHostPrefixHandler returns a build handler which expands the host prefix
+// template with the host labels returned by labelsFn, and prepends it to the
+// request endpoint's host. labelsFn may be nil if the prefix has no labels.
+func NewHostPrefixHandler(prefix string, labelsFn func() map[string]string) request.NamedHandler {
+	h := HostPrefixHandler{
+		Prefix:   prefix,
+		LabelsFn: labelsFn,
+	}
+
+	return request.NamedHandler{
+		Name: HostPrefixHandlerName,
+		Fn:   h.Handler,
+	}
+}
+
+// A HostPrefixHandler provides the request handler to expand and prepend an
+// operation's host prefix to the request endpoint's host.
+//
+// The host prefix is not applied if a custom endpoint was provided with the
+// aws.Config.Endpoint value.
+type HostPrefixHandler struct {
+	// Prefix is the host prefix template, e.g. "{AccountId}.". Labels in
+	// the template are substituted with the values returned by LabelsFn.
+	Prefix string
+
+	// LabelsFn returns the host label values of the operation's input,
+	// keyed by label name.
+	LabelsFn func() map[string]string
+}
+
+// Handler updates the request's endpoint host with the host prefix expanded.
+// The request's error is set if a host label is invalid, or the resulting
+// host is not a valid DNS host name.
+func (h HostPrefixHandler) Handler(r *request.Request) {
+	if len(aws.StringValue(r.Config.Endpoint)) != 0 {
+		return
+	}
+
+	var labels map[string]string
+	if h.LabelsFn != nil {
+		labels = h.LabelsFn()
+	}
+
+	prefix := h.Prefix
+	invalidParams := request.ErrInvalidParams{Context: r.Operation.Name}
+	for name, value := range labels {
+		if !ValidHostLabel(value) {
+			invalidParams.Add(request.NewErrParamFormat(name, "host label", value))
+			continue
+		}
+		prefix = strings.Replace(prefix, "{"+name+"}", value, -1)
+	}
+	if invalidParams.Len() > 0 {
+		r.Error = invalidParams
+		return
+	}
+
+	host := prefix + r.HTTPRequest.URL.Host
+	if err := ValidateEndpointHost(r.Operation.Name, host); err != nil {
+		r.Error = err
+		return
+	}
+
+	r.HTTPRequest.URL.Host = host
+	if len(r.HTTPRequest.Host) > 0 {
+		r.HTTPRequest.Host = prefix + r.HTTPRequest.Host
+	}
+}
+
+// ValidateEndpointHost returns an error if the host is not a valid DNS host
+// name, made up of RFC 1123 labels separated by dots. An optional port
+// suffix is allowed.
+func ValidateEndpointHost(opName, host string) error {
+	hostname := host
+	if i := strings.LastIndex(host, ":"); i != -1 {
+		if !validPort(host[i+1:]) {
+			return endpointHostError(opName, host)
+		}
+		hostname = host[:i]
+	}
+
+	if len(hostname) == 0 || len(hostname) > 255 {
+		return endpointHostError(opName, host)
+	}
+	for _, label := range strings.Split(hostname, ".") {
+		if !ValidHostLabel(label) {
+			return endpointHostError(opName, host)
+		}
+	}
+
+	return nil
+}
+
+// ValidHostLabel returns if the label is a valid RFC 1123 host label. A host
+// label must be 1 to 63 characters long, made up of only letters, digits,
+// and hyphens.
+func ValidHostLabel(label string) bool {
+	if l := len(label); l == 0 || l > 63 {
+		return false
+	}
+	for _, r := range label {
+		switch {
+		case r >= '0' && r <= '9':
+		case r >= 'A' && r <= 'Z':
+		case r >= 'a' && r <= 'z':
+		case r == '-':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func validPort(port string) bool {
+	if len(port) == 0 {
+		return false
+	}
+	for _, r := range port {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func endpointHostError(opName, host string) error {
+	invalidParams := request.ErrInvalidParams{Context: opName}
+	invalidParams.Add(request.NewErrParamFormat("endpoint host", "RFC 1123 host name", host))
+	return invalidParams
+}
@@ -0,0 +1,124 @@
+package protocol
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestHostPrefixHandler(t *testing.T) {
+	cases := []struct {
+		Prefix      string
+		Labels      map[string]string
+		Endpoint    string
+		Host        string
+		ExpectHost  string
+		ExpectError bool
+	}{
+		{
+			Prefix:     "data-",
+			ExpectHost: "data-service.region.amazonaws.com",
+		},
+		{
+			Prefix:     "{AccountId}.",
+			Labels:     map[string]string{"AccountId": "123456789012"},
+			ExpectHost: "123456789012.service.region.amazonaws.com",
+		},
+		{
+			Prefix:     "data-",
+			Host:       "service.region.amazonaws.com",
+			ExpectHost: "data-service.region.amazonaws.com",
+		},
+		{
+			Prefix:     "data-",
+			Endpoint:   "https://custom.example.com",
+			ExpectHost: "service.region.amazonaws.com",
+		},
+		{
+			Prefix:      "{AccountId}.",
+			Labels:      map[string]string{"AccountId": ""},
+			ExpectError: true,
+		},
+		{
+			Prefix:      "{AccountId}.",
+			Labels:      map[string]string{"AccountId": "123.456"},
+			ExpectError: true,
+		},
+		{
+			Prefix:      "data_",
+			ExpectError: true,
+		},
+	}
+
+	for i, c := range cases {
+		u, _ := url.Parse("https://service.region.amazonaws.com/path")
+		r := &request.Request{
+			Config:      aws.Config{},
+			Operation:   &request.Operation{Name: "Operation"},
+			HTTPRequest: &http.Request{URL: u, Host: c.Host},
+		}
+		if len(c.Endpoint) != 0 {
+			r.Config.Endpoint = aws.String(c.Endpoint)
+		}
+
+		labels := c.Labels
+		NewHostPrefixHandler(c.Prefix, func() map[string]string { return labels }).Fn(r)
+
+		if c.ExpectError {
+			if r.Error == nil {
+				t.Fatalf("%d, expect error", i)
+			}
+			aerr, ok := r.Error.(awserr.Error)
+			if !ok {
+				t.Fatalf("%d, expect awserr.Error, got %T", i, r.Error)
+			}
+			if e, a := request.InvalidParameterErrCode, aerr.Code(); e != a {
+				t.Errorf("%d, expect %s error code, got %s", i, e, a)
+			}
+			if e, a := "service.region.amazonaws.com", r.HTTPRequest.URL.Host; e != a {
+				t.Errorf("%d, expect %s host unchanged, got %s", i, e, a)
+			}
+			continue
+		}
+		if r.Error != nil {
+			t.Fatalf("%d, expect no error, got %v", i, r.Error)
+		}
+		if e, a := c.ExpectHost, r.HTTPRequest.URL.Host; e != a {
+			t.Errorf("%d, expect %s URL host, got %s", i, e, a)
+		}
+		if len(c.Host) != 0 {
+			if e, a := c.ExpectHost, r.HTTPRequest.Host; e != a {
+				t.Errorf("%d, expect %s host, got %s", i, e, a)
+			}
+		}
+	}
+}
+
+func TestValidateEndpointHost(t *testing.T) {
+	cases := map[string]bool{
+		"service.region.amazonaws.com":       true,
+		"123456789012.service.amazonaws.com": true,
+		"localhost:8080":                     true,
+		"data-service.amazonaws.com":         true,
+		"":                                   false,
+		"service..amazonaws.com":             false,
+		"service_1.amazonaws.com":            false,
+		"localhost:":                         false,
+		"localhost:port":                     false,
+		"a0123456789012345678901234567890123456789012345678901234567890123.com": false,
+	}
+
+	for host, valid := range cases {
+		err := ValidateEndpointHost("Operation", host)
+		if valid && err != nil {
+			t.Errorf("%q, expect valid host, got %v", host, err)
+		}
+		if !valid && err == nil {
+			t.Errorf("%q, expect invalid host error", host)
+		}
+	}
+}
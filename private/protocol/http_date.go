@@ -0,0 +1,52 @@
+package protocol
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HTTP-date layouts accepted by ParseHTTPDate, in the order they are tried.
+// The day of month is not required to be zero padded.
+var httpDateLayouts = []string{
+	"Mon, 2 Jan 2006 15:04:05 GMT",    // RFC 7231 IMF-fixdate
+	"Monday, 2-Jan-06 15:04:05 GMT",   // RFC 850
+	"Mon Jan _2 15:04:05 2006",        // ANSI C asctime
+	"Mon, 2 Jan 2006 15:04:05 -0700",  // RFC 1123 with numeric zone
+	"Mon, 2 Jan 2006 15:04:05 -07:00", // RFC 1123 with numeric zone
+}
+
+// ParseHTTPDate parses an HTTP-date timestamp, such as a Last-Modified
+// header's value. The value may be in any of the formats HTTP/1.1 recipients
+// are required to accept, RFC 7231 IMF-fixdate, RFC 850, or asctime. If the
+// value is not in a date format it is parsed as epoch seconds.
+//
+// Parsing is lenient of common deviations from the formats seen in
+// responses, such as a lowercase weekday, or a "GMT+0000" zone.
+func ParseHTTPDate(v string) (time.Time, error) {
+	v = strings.TrimSpace(v)
+	for _, suffix := range []string{"+0000", "-0000", "+00:00", "-00:00"} {
+		if strings.HasSuffix(v, "GMT"+suffix) || strings.HasSuffix(v, "UTC"+suffix) {
+			v = v[:len(v)-len(suffix)]
+			break
+		}
+	}
+	if strings.HasSuffix(v, "UTC") {
+		v = v[:len(v)-len("UTC")] + "GMT"
+	}
+
+	for _, layout := range httpDateLayouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	if f, err := strconv.ParseFloat(v, 64); err == nil && !math.IsInf(f, 0) && !math.IsNaN(f) {
+		sec, frac := math.Modf(f)
+		return time.Unix(int64(sec), int64(frac*float64(time.Second))).UTC(), nil
+	}
+
+	return time.Time{}, fmt.Errorf("unable to parse HTTP date, %q", v)
+}
@@ -0,0 +1,65 @@
+package protocol
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseHTTPDate(t *testing.T) {
+	expect := time.Date(2017, 11, 6, 8, 49, 37, 0, time.UTC)
+
+	cases := []string{
+		"Mon, 06 Nov 2017 08:49:37 GMT",
+		"Mon, 6 Nov 2017 08:49:37 GMT",
+		"mon, 06 nov 2017 08:49:37 GMT",
+		"Mon, 06 Nov 2017 08:49:37 GMT+0000",
+		"Mon, 06 Nov 2017 08:49:37 UTC",
+		"Mon, 06 Nov 2017 09:49:37 +0100",
+		"Monday, 06-Nov-17 08:49:37 GMT",
+		"monday, 06-Nov-17 08:49:37 GMT+0000",
+		"Mon Nov  6 08:49:37 2017",
+		" Mon, 06 Nov 2017 08:49:37 GMT ",
+		"1509958177",
+		"1509958177.000",
+	}
+
+	for _, c := range cases {
+		actual, err := ParseHTTPDate(c)
+		if err != nil {
+			t.Errorf("%q, expect no error, got %v", c, err)
+			continue
+		}
+		if !expect.Equal(actual) {
+			t.Errorf("%q, expect %v time, got %v", c, expect, actual)
+		}
+		if e, a := time.UTC, actual.Location(); e != a {
+			t.Errorf("%q, expect %v location, got %v", c, e, a)
+		}
+	}
+}
+
+func TestParseHTTPDate_Fractional(t *testing.T) {
+	actual, err := ParseHTTPDate("1509958177.5")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := time.Date(2017, 11, 6, 8, 49, 37, int(500*time.Millisecond), time.UTC), actual; !e.Equal(a) {
+		t.Errorf("expect %v time, got %v", e, a)
+	}
+}
+
+func TestParseHTTPDate_Invalid(t *testing.T) {
+	cases := []string{
+		"",
+		"not a date",
+		"2017-11-06T08:49:37Z",
+		"Mon, 06 Nov 2017 08:49:37 GMT+0100",
+		"NaN",
+	}
+
+	for _, c := range cases {
+		if _, err := ParseHTTPDate(c); err == nil {
+			t.Errorf("%q, expect error", c)
+		}
+	}
+}
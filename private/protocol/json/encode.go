@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go/private/protocol"
 )
@@ -21,8 +22,9 @@ type Encoder struct {
 func NewEncoder() *Encoder {
 	e := &Encoder{
 		encoder: encoder{
-			buf:      bytes.NewBuffer([]byte{'{'}),
-			fieldBuf: &protocol.FieldBuffer{},
+			buf:       bytes.NewBuffer([]byte{'{'}),
+			fieldBuf:  &protocol.FieldBuffer{},
+			sensitive: &[]string{},
 		},
 		root: true,
 	}
@@ -46,8 +48,15 @@ func (e *Encoder) Encode() (io.ReadSeeker, error) {
 	return bytes.NewReader(b), nil
 }
 
+// SensitivePaths returns the JSON paths of the members encoded that were
+// marked as sensitive, e.g. "Nested.List[0].Password".
+func (e *Encoder) SensitivePaths() []string {
+	return *e.sensitive
+}
+
 // SetValue sets an individual value to the JSON body.
 func (e *Encoder) SetValue(t protocol.Target, k string, v protocol.ValueMarshaler, meta protocol.Metadata) {
+	e.markSensitive(k, meta)
 	e.writeSep()
 	e.writeKey(k)
 	e.writeValue(v)
@@ -62,25 +71,41 @@ func (e *Encoder) SetStream(t protocol.Target, k string, v protocol.StreamMarsha
 }
 
 // SetList creates an JSON list and calls the passed in fn callback with a list encoder.
+//
+// The list is omitted if fn does not add any elements, unless
+// meta.SerializeEmpty is set, in which case an empty list, "[]", is encoded.
 func (e *Encoder) SetList(t protocol.Target, k string, fn func(le protocol.ListEncoder), meta protocol.Metadata) {
+	mark := e.mark()
 	e.writeSep()
 	e.writeKey(k)
-	e.writeList(func(enc encoder) error {
+
+	var n int
+	e.writeList(joinPath(e.path, k), func(enc encoder) error {
 		nested := listEncoder{encoder: enc}
 		fn(&nested)
+		n = nested.n
 		return nested.err
 	})
+	e.omitEmpty(mark, n, k, meta)
 }
 
 // SetMap creates an JSON map and calls the passed in fn callback with a map encoder.
+//
+// The map is omitted if fn does not set any entries, unless
+// meta.SerializeEmpty is set, in which case an empty map, "{}", is encoded.
 func (e *Encoder) SetMap(t protocol.Target, k string, fn func(me protocol.MapEncoder), meta protocol.Metadata) {
+	mark := e.mark()
 	e.writeSep()
 	e.writeKey(k)
-	e.writeObject(func(enc encoder) error {
+
+	var n int
+	e.writeObject(joinPath(e.path, k), func(enc encoder) error {
 		nested := mapEncoder{encoder: enc}
 		fn(&nested)
+		n = nested.n
 		return nested.err
 	})
+	e.omitEmpty(mark, n, k, meta)
 }
 
 // SetFields sets the nested fields to the JSON body.
@@ -89,8 +114,10 @@ func (e *Encoder) SetFields(t protocol.Target, k string, m protocol.FieldMarshal
 		// Ignore payload key and only marshal body without wrapping in object first.
 		nested := Encoder{
 			encoder: encoder{
-				buf:      e.encoder.buf,
-				fieldBuf: e.encoder.fieldBuf,
+				buf:       e.encoder.buf,
+				fieldBuf:  e.encoder.fieldBuf,
+				sensitive: e.encoder.sensitive,
+				path:      e.encoder.path,
 			},
 		}
 		m.MarshalFields(&nested)
@@ -98,9 +125,10 @@ func (e *Encoder) SetFields(t protocol.Target, k string, m protocol.FieldMarshal
 		return
 	}
 
+	e.markSensitive(k, meta)
 	e.writeSep()
 	e.writeKey(k)
-	e.writeObject(func(enc encoder) error {
+	e.writeObject(joinPath(e.path, k), func(enc encoder) error {
 		nested := Encoder{encoder: enc}
 		m.MarshalFields(&nested)
 		return nested.err
@@ -110,10 +138,19 @@ func (e *Encoder) SetFields(t protocol.Target, k string, m protocol.FieldMarshal
 // A listEncoder encodes elements within a list for the JSON encoder.
 type listEncoder struct {
 	encoder
+	n int
+}
+
+// nextPath returns the JSON path of the next list element.
+func (e *listEncoder) nextPath() string {
+	p := e.path + "[" + strconv.Itoa(e.n) + "]"
+	e.n++
+	return p
 }
 
 // ListAddValue will add the value to the list.
 func (e *listEncoder) ListAddValue(v protocol.ValueMarshaler) {
+	e.n++
 	e.writeSep()
 	e.writeValue(v)
 }
@@ -121,7 +158,7 @@ func (e *listEncoder) ListAddValue(v protocol.ValueMarshaler) {
 // ListAddList adds a list nested within another list.
 func (e *listEncoder) ListAddList(fn func(le protocol.ListEncoder)) {
 	e.writeSep()
-	e.writeList(func(enc encoder) error {
+	e.writeList(e.nextPath(), func(enc encoder) error {
 		nested := listEncoder{encoder: enc}
 		fn(&nested)
 		return nested.err
@@ -131,7 +168,7 @@ func (e *listEncoder) ListAddList(fn func(le protocol.ListEncoder)) {
 // ListAddMap adds a map nested within a list.
 func (e *listEncoder) ListAddMap(fn func(me protocol.MapEncoder)) {
 	e.writeSep()
-	e.writeObject(func(enc encoder) error {
+	e.writeObject(e.nextPath(), func(enc encoder) error {
 		nested := mapEncoder{encoder: enc}
 		fn(&nested)
 		return nested.err
@@ -141,7 +178,7 @@ func (e *listEncoder) ListAddMap(fn func(me protocol.MapEncoder)) {
 // ListAddFields will set the nested type's fields to the list.
 func (e *listEncoder) ListAddFields(m protocol.FieldMarshaler) {
 	e.writeSep()
-	e.writeObject(func(enc encoder) error {
+	e.writeObject(e.nextPath(), func(enc encoder) error {
 		nested := Encoder{encoder: enc}
 		m.MarshalFields(&nested)
 		return nested.err
@@ -151,10 +188,12 @@ func (e *listEncoder) ListAddFields(m protocol.FieldMarshaler) {
 // A mapEncoder encodes key values pair map values for the JSON encoder.
 type mapEncoder struct {
 	encoder
+	n int
 }
 
 // MapSetValue sets a map value.
 func (e *mapEncoder) MapSetValue(k string, v protocol.ValueMarshaler) {
+	e.n++
 	e.writeSep()
 	e.writeKey(k)
 	e.writeValue(v)
@@ -162,9 +201,10 @@ func (e *mapEncoder) MapSetValue(k string, v protocol.ValueMarshaler) {
 
 // MapSetList encodes a list nested within the map.
 func (e *mapEncoder) MapSetList(k string, fn func(le protocol.ListEncoder)) {
+	e.n++
 	e.writeSep()
 	e.writeKey(k)
-	e.writeList(func(enc encoder) error {
+	e.writeList(joinPath(e.path, k), func(enc encoder) error {
 		nested := listEncoder{encoder: enc}
 		fn(&nested)
 		return nested.err
@@ -173,9 +213,10 @@ func (e *mapEncoder) MapSetList(k string, fn func(le protocol.ListEncoder)) {
 
 // MapSetMap encodes a map nested within another map.
 func (e *mapEncoder) MapSetMap(k string, fn func(me protocol.MapEncoder)) {
+	e.n++
 	e.writeSep()
 	e.writeKey(k)
-	e.writeObject(func(enc encoder) error {
+	e.writeObject(joinPath(e.path, k), func(enc encoder) error {
 		nested := mapEncoder{encoder: enc}
 		fn(&nested)
 		return nested.err
@@ -184,9 +225,10 @@ func (e *mapEncoder) MapSetMap(k string, fn func(me protocol.MapEncoder)) {
 
 // MapSetFields will set the nested type's fields under the map.
 func (e *mapEncoder) MapSetFields(k string, m protocol.FieldMarshaler) {
+	e.n++
 	e.writeSep()
 	e.writeKey(k)
-	e.writeObject(func(enc encoder) error {
+	e.writeObject(joinPath(e.path, k), func(enc encoder) error {
 		nested := Encoder{encoder: enc}
 		m.MarshalFields(&nested)
 		return nested.err
@@ -198,6 +240,11 @@ type encoder struct {
 	fieldBuf *protocol.FieldBuffer
 	started  bool
 	err      error
+
+	// path is the JSON path of the object or list being encoded, and
+	// sensitive collects the paths of members marked as sensitive.
+	path      string
+	sensitive *[]string
 }
 
 func (e encoder) encode() ([]byte, error) {
@@ -211,6 +258,39 @@ func (e encoder) encode() ([]byte, error) {
 	return e.buf.Bytes(), nil
 }
 
+func (e *encoder) markSensitive(k string, meta protocol.Metadata) {
+	if meta.Sensitive && e.sensitive != nil {
+		*e.sensitive = append(*e.sensitive, joinPath(e.path, k))
+	}
+}
+
+// A memberMark is the state of the encoder before a member was written, so
+// that the member can be removed.
+type memberMark struct {
+	len     int
+	started bool
+}
+
+func (e *encoder) mark() memberMark {
+	return memberMark{len: e.buf.Len(), started: e.started}
+}
+
+// omitEmpty removes the list or map member written since the mark if it has
+// no elements, n, and the member is not required to be serialized when empty.
+// Otherwise the member is marked as sensitive if needed.
+func (e *encoder) omitEmpty(m memberMark, n int, k string, meta protocol.Metadata) {
+	if e.err != nil {
+		return
+	}
+
+	if n == 0 && !meta.SerializeEmpty {
+		e.buf.Truncate(m.len)
+		e.started = m.started
+		return
+	}
+	e.markSensitive(k, meta)
+}
+
 func (e *encoder) writeSep() {
 	if e.started {
 		e.buf.WriteByte(',')
@@ -238,9 +318,15 @@ func (e *encoder) writeValue(v protocol.ValueMarshaler) {
 	}
 
 	var asStr bool
-	switch v.(type) {
-	case protocol.StringValue, protocol.BytesValue:
+	switch tv := v.(type) {
+	case protocol.StringValue, protocol.BytesValue,
+		protocol.QuotedInt64Value, protocol.QuotedFloat64Value, protocol.QuotedBoolValue:
 		asStr = true
+	case protocol.Float64Value:
+		// Non-finite values are encoded as string tokens.
+		asStr = !protocol.IsFiniteFloat64(float64(tv))
+	case protocol.BigFloatValue:
+		asStr = tv.V.IsInf()
 	}
 
 	if asStr {
@@ -250,22 +336,39 @@ func (e *encoder) writeValue(v protocol.ValueMarshaler) {
 	}
 }
 
-func (e *encoder) writeList(fn func(encoder) error) {
+func (e *encoder) writeList(path string, fn func(encoder) error) {
 	if e.err != nil {
 		return
 	}
 
 	e.buf.WriteByte('[')
-	e.err = fn(encoder{buf: e.buf, fieldBuf: e.fieldBuf})
+	e.err = fn(e.nested(path))
 	e.buf.WriteByte(']')
 }
 
-func (e *encoder) writeObject(fn func(encoder) error) {
+func (e *encoder) writeObject(path string, fn func(encoder) error) {
 	if e.err != nil {
 		return
 	}
 
 	e.buf.WriteByte('{')
-	e.err = fn(encoder{buf: e.buf, fieldBuf: e.fieldBuf})
+	e.err = fn(e.nested(path))
 	e.buf.WriteByte('}')
 }
+
+func (e *encoder) nested(path string) encoder {
+	return encoder{
+		buf:       e.buf,
+		fieldBuf:  e.fieldBuf,
+		path:      path,
+		sensitive: e.sensitive,
+	}
+}
+
+// joinPath returns the JSON path of the member k within the object at path.
+func joinPath(path, k string) string {
+	if len(path) == 0 {
+		return k
+	}
+	return path + "." + k
+}
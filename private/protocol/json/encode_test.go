@@ -3,6 +3,8 @@ package json
 import (
 	"io"
 	"io/ioutil"
+	"math"
+	"math/big"
 	"testing"
 	"time"
 
@@ -300,3 +302,159 @@ func encode(s baseShape) (io.ReadSeeker, error) {
 	s.MarshalFields(e)
 	return e.Encode()
 }
+
+func TestEncodeNonFiniteFloat(t *testing.T) {
+	e := NewEncoder()
+	e.SetValue(protocol.BodyTarget, "nan", protocol.Float64Value(math.NaN()), protocol.Metadata{})
+	e.SetValue(protocol.BodyTarget, "inf", protocol.Float64Value(math.Inf(1)), protocol.Metadata{})
+	e.SetValue(protocol.BodyTarget, "negInf", protocol.Float64Value(math.Inf(-1)), protocol.Metadata{})
+	e.SetValue(protocol.BodyTarget, "finite", protocol.Float64Value(1.5), protocol.Metadata{})
+
+	r, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no marshal error, %v", err)
+	}
+
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("expect no read error, %v", err)
+	}
+
+	expect := `{"nan":"NaN","inf":"Infinity","negInf":"-Infinity","finite":1.5}`
+	if e, a := expect, string(b); e != a {
+		t.Errorf("expect bodies to match, did not.\n,\tExpect:\n%s\n\tActual:\n%s\n", e, a)
+	}
+}
+
+func TestEncodeBigNumbers(t *testing.T) {
+	n, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	f, _ := protocol.ParseBigFloat("12345678901234567890.123456789")
+
+	e := NewEncoder()
+	e.SetValue(protocol.BodyTarget, "int", protocol.BigIntValue{V: n}, protocol.Metadata{})
+	e.SetValue(protocol.BodyTarget, "float", protocol.BigFloatValue{V: f}, protocol.Metadata{})
+	e.SetValue(protocol.BodyTarget, "inf", protocol.BigFloatValue{V: new(big.Float).SetInf(false)}, protocol.Metadata{})
+
+	r, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no marshal error, %v", err)
+	}
+
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("expect no read error, %v", err)
+	}
+
+	expect := `{"int":123456789012345678901234567890,"float":12345678901234567890.123456789,"inf":"Infinity"}`
+	if e, a := expect, string(b); e != a {
+		t.Errorf("expect bodies to match, did not.\n,\tExpect:\n%s\n\tActual:\n%s\n", e, a)
+	}
+}
+
+func TestEncodeQuotedValues(t *testing.T) {
+	e := NewEncoder()
+	e.SetValue(protocol.BodyTarget, "int", protocol.QuotedInt64Value(math.MaxInt64), protocol.Metadata{})
+	e.SetValue(protocol.BodyTarget, "float", protocol.QuotedFloat64Value(0.30000000000000004), protocol.Metadata{})
+	e.SetValue(protocol.BodyTarget, "bool", protocol.QuotedBoolValue(false), protocol.Metadata{})
+	e.SetList(protocol.BodyTarget, "list", func(le protocol.ListEncoder) {
+		le.ListAddValue(protocol.QuotedInt64Value(-1))
+		le.ListAddValue(protocol.Int64Value(-1))
+	}, protocol.Metadata{})
+
+	r, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no marshal error, %v", err)
+	}
+
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("expect no read error, %v", err)
+	}
+
+	expect := `{"int":"9223372036854775807","float":"0.30000000000000004","bool":"false","list":["-1",-1]}`
+	if e, a := expect, string(b); e != a {
+		t.Errorf("expect bodies to match, did not.\n,\tExpect:\n%s\n\tActual:\n%s\n", e, a)
+	}
+}
+
+func TestEncodeEmptyListMap(t *testing.T) {
+	emptyList := func(le protocol.ListEncoder) {}
+	emptyMap := func(me protocol.MapEncoder) {}
+	serializeEmpty := protocol.Metadata{SerializeEmpty: true}
+
+	cases := []struct {
+		Fields func(e *Encoder)
+		Expect string
+	}{
+		{
+			Fields: func(e *Encoder) {
+				e.SetList(protocol.BodyTarget, "list", emptyList, protocol.Metadata{})
+				e.SetMap(protocol.BodyTarget, "map", emptyMap, protocol.Metadata{})
+			},
+		},
+		{
+			Fields: func(e *Encoder) {
+				e.SetValue(protocol.BodyTarget, "first", protocol.StringValue("a"), protocol.Metadata{})
+				e.SetList(protocol.BodyTarget, "list", emptyList, protocol.Metadata{})
+				e.SetMap(protocol.BodyTarget, "map", emptyMap, protocol.Metadata{})
+				e.SetValue(protocol.BodyTarget, "last", protocol.StringValue("b"), protocol.Metadata{})
+			},
+			Expect: `{"first":"a","last":"b"}`,
+		},
+		{
+			Fields: func(e *Encoder) {
+				e.SetList(protocol.BodyTarget, "list", emptyList, serializeEmpty)
+				e.SetMap(protocol.BodyTarget, "map", emptyMap, serializeEmpty)
+			},
+			Expect: `{"list":[],"map":{}}`,
+		},
+		{
+			Fields: func(e *Encoder) {
+				e.SetFields(protocol.BodyTarget, "nested", fieldMarshaler(func(ne protocol.FieldEncoder) {
+					ne.SetList(protocol.BodyTarget, "list", emptyList, protocol.Metadata{})
+					ne.SetMap(protocol.BodyTarget, "map", emptyMap, serializeEmpty)
+				}), protocol.Metadata{})
+			},
+			Expect: `{"nested":{"map":{}}}`,
+		},
+		{
+			Fields: func(e *Encoder) {
+				e.SetList(protocol.BodyTarget, "list", func(le protocol.ListEncoder) {
+					le.ListAddList(emptyList)
+				}, protocol.Metadata{})
+			},
+			Expect: `{"list":[[]]}`,
+		},
+	}
+
+	for i, c := range cases {
+		e := NewEncoder()
+		c.Fields(e)
+
+		r, err := e.Encode()
+		if err != nil {
+			t.Fatalf("%d, expect no marshal error, %v", i, err)
+		}
+		if len(c.Expect) == 0 {
+			if r != nil {
+				t.Errorf("%d, expect no body", i)
+			}
+			continue
+		}
+
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatalf("%d, expect no read error, %v", i, err)
+		}
+		if e, a := c.Expect, string(b); e != a {
+			t.Errorf("%d, expect %s body, got %s", i, e, a)
+		}
+	}
+}
+
+type fieldMarshaler func(protocol.FieldEncoder)
+
+func (fn fieldMarshaler) MarshalFields(e protocol.FieldEncoder) error {
+	fn(e)
+	return nil
+}
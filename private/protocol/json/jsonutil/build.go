@@ -4,9 +4,8 @@ package jsonutil
 import (
 	"bytes"
 	"encoding/base64"
-	"encoding/json"
 	"fmt"
-	"math"
+	"math/big"
 	"reflect"
 	"sort"
 	"strconv"
@@ -17,16 +16,53 @@ import (
 
 var timeType = reflect.ValueOf(time.Time{}).Type()
 var byteSliceType = reflect.ValueOf([]byte{}).Type()
+var bigIntType = reflect.TypeOf(big.Int{})
+var bigFloatType = reflect.TypeOf(big.Float{})
 
 // BuildJSON builds a JSON string for a given object v.
 func BuildJSON(v interface{}) ([]byte, error) {
 	var buf bytes.Buffer
 
-	err := buildAny(reflect.ValueOf(v), &buf, "")
+	err := buildAny(reflect.ValueOf(v), &buf, "", jsonPath{})
 	return buf.Bytes(), err
 }
 
-func buildAny(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) error {
+// BuildJSONWithSensitivePaths builds a JSON string for a given object v, and
+// returns the JSON paths of the members built which are tagged as sensitive,
+// e.g. "Users[0].Password", so they can be redacted when the body is logged.
+func BuildJSONWithSensitivePaths(v interface{}) ([]byte, []string, error) {
+	var buf bytes.Buffer
+	var sensitive []string
+
+	err := buildAny(reflect.ValueOf(v), &buf, "", jsonPath{sensitive: &sensitive})
+	return buf.Bytes(), sensitive, err
+}
+
+// jsonPath is the JSON path of the value being built. If sensitive is set,
+// the paths of the members tagged as sensitive are collected in it.
+type jsonPath struct {
+	path      string
+	sensitive *[]string
+}
+
+func (p jsonPath) member(name string) jsonPath {
+	if len(p.path) != 0 {
+		name = p.path + "." + name
+	}
+	return jsonPath{path: name, sensitive: p.sensitive}
+}
+
+func (p jsonPath) index(i int) jsonPath {
+	return jsonPath{path: p.path + "[" + strconv.Itoa(i) + "]", sensitive: p.sensitive}
+}
+
+func (p jsonPath) markSensitive() {
+	if p.sensitive != nil {
+		*p.sensitive = append(*p.sensitive, p.path)
+	}
+}
+
+func buildAny(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag, path jsonPath) error {
 	origVal := value
 	value = reflect.Indirect(value)
 	if !value.IsValid() {
@@ -39,8 +75,10 @@ func buildAny(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) err
 	if t == "" {
 		switch vtype.Kind() {
 		case reflect.Struct:
-			// also it can't be a time object
-			if value.Type() != timeType {
+			// also it can't be a time, or arbitrary precision number object
+			switch value.Type() {
+			case timeType, bigIntType, bigFloatType:
+			default:
 				t = "structure"
 			}
 		case reflect.Slice:
@@ -58,17 +96,17 @@ func buildAny(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) err
 		if field, ok := vtype.FieldByName("_"); ok {
 			tag = field.Tag
 		}
-		return buildStruct(value, buf, tag)
+		return buildStruct(value, buf, tag, path)
 	case "list":
-		return buildList(value, buf, tag)
+		return buildList(value, buf, tag, path)
 	case "map":
-		return buildMap(value, buf, tag)
+		return buildMap(value, buf, tag, path)
 	default:
 		return buildScalar(origVal, buf, tag)
 	}
 }
 
-func buildStruct(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) error {
+func buildStruct(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag, path jsonPath) error {
 	if !value.IsValid() {
 		return nil
 	}
@@ -133,7 +171,12 @@ func buildStruct(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag)
 		writeString(name, buf)
 		buf.WriteString(`:`)
 
-		err := buildAny(member, buf, field.Tag)
+		memberPath := path.member(name)
+		if field.Tag.Get("sensitive") == "true" {
+			memberPath.markSensitive()
+		}
+
+		err := buildAny(member, buf, field.Tag, memberPath)
 		if err != nil {
 			return err
 		}
@@ -145,11 +188,11 @@ func buildStruct(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag)
 	return nil
 }
 
-func buildList(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) error {
+func buildList(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag, path jsonPath) error {
 	buf.WriteString("[")
 
 	for i := 0; i < value.Len(); i++ {
-		buildAny(value.Index(i), buf, "")
+		buildAny(value.Index(i), buf, "", path.index(i))
 
 		if i < value.Len()-1 {
 			buf.WriteString(",")
@@ -167,7 +210,7 @@ func (sv sortedValues) Len() int           { return len(sv) }
 func (sv sortedValues) Swap(i, j int)      { sv[i], sv[j] = sv[j], sv[i] }
 func (sv sortedValues) Less(i, j int) bool { return sv[i].String() < sv[j].String() }
 
-func buildMap(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) error {
+func buildMap(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag, path jsonPath) error {
 	buf.WriteString("{")
 
 	sv := sortedValues(value.MapKeys())
@@ -181,7 +224,7 @@ func buildMap(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) err
 		writeString(k.String(), buf)
 		buf.WriteString(`:`)
 
-		buildAny(value.MapIndex(k), buf, "")
+		buildAny(value.MapIndex(k), buf, "", path.member(k.String()))
 	}
 
 	buf.WriteString("}")
@@ -205,8 +248,10 @@ func buildScalar(v reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) erro
 		buf.Write(strconv.AppendInt(scratch[:0], value.Int(), 10))
 	case reflect.Float64:
 		f := value.Float()
-		if math.IsInf(f, 0) || math.IsNaN(f) {
-			return &json.UnsupportedValueError{Value: v, Str: strconv.FormatFloat(f, 'f', -1, 64)}
+		if !protocol.IsFiniteFloat64(f) {
+			// Non-finite values are encoded as string tokens.
+			writeString(protocol.FormatFloat64(f), buf)
+			break
 		}
 		buf.Write(strconv.AppendFloat(scratch[:0], f, 'f', -1, 64))
 	default:
@@ -215,6 +260,18 @@ func buildScalar(v reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) erro
 			converted := v.Interface().(*time.Time)
 
 			buf.Write(strconv.AppendInt(scratch[:0], converted.UTC().Unix(), 10))
+		case bigIntType:
+			converted := v.Interface().(*big.Int)
+			buf.Write(converted.Append(scratch[:0], 10))
+		case bigFloatType:
+			converted := v.Interface().(*big.Float)
+			b, _ := protocol.BigFloatValue{V: converted}.MarshalValueBuf(scratch[:0])
+			if converted.IsInf() {
+				// Infinite values are encoded as string tokens.
+				writeString(string(b), buf)
+				break
+			}
+			buf.Write(b)
 		case byteSliceType:
 			if !value.IsNil() {
 				converted := value.Interface().([]byte)
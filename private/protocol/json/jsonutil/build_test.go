@@ -74,8 +74,8 @@ var jsonTests = []struct {
 		J{
 			F: F(4.56 / zero),
 		},
-		"",
-		`json: unsupported value: +Inf`,
+		`{"F":"Infinity"}`,
+		``,
 	},
 }
 
@@ -1,33 +1,72 @@
 package jsonutil
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/big"
 	"reflect"
+	"sort"
+	"strconv"
 	"time"
+
+	"github.com/aws/aws-sdk-go/private/protocol"
 )
 
 // UnmarshalJSON reads a stream and unmarshals the results in object v.
 func UnmarshalJSON(v interface{}, stream io.Reader) error {
+	_, err := unmarshalJSON(v, stream, false)
+	return err
+}
+
+// UnmarshalJSONCollectUnknown reads a stream and unmarshals the results in
+// object v. In addition the JSON paths of all fields in the stream that are
+// not defined by v's shape are returned, e.g. "Items[0].NewField".
+//
+// The unknown fields are collected separately from decoding v, and do not
+// modify the value unmarshaled into v.
+func UnmarshalJSONCollectUnknown(v interface{}, stream io.Reader) ([]string, error) {
+	return unmarshalJSON(v, stream, true)
+}
+
+func unmarshalJSON(v interface{}, stream io.Reader, collectUnknown bool) ([]string, error) {
 	var out interface{}
 
 	b, err := ioutil.ReadAll(stream)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(b) == 0 {
-		return nil
+		return nil, nil
 	}
 
-	if err := json.Unmarshal(b, &out); err != nil {
-		return err
+	// Numbers are decoded as json.Number so that arbitrary precision values
+	// are not truncated by first being converted to float64.
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&out); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("invalid JSON, unexpected data after top-level value")
+	}
+
+	value := reflect.ValueOf(v)
+	if err := unmarshalAny(value, out, ""); err != nil {
+		return nil, err
 	}
 
-	return unmarshalAny(reflect.ValueOf(v), out, "")
+	if !collectUnknown {
+		return nil, nil
+	}
+
+	var unknown []string
+	collectUnknownFields(value.Type(), out, "", "", &unknown)
+	return unknown, nil
 }
 
 func unmarshalAny(value reflect.Value, data interface{}, tag reflect.StructTag) error {
@@ -40,8 +79,10 @@ func unmarshalAny(value reflect.Value, data interface{}, tag reflect.StructTag)
 	if t == "" {
 		switch vtype.Kind() {
 		case reflect.Struct:
-			// also it can't be a time object
-			if _, ok := value.Interface().(*time.Time); !ok {
+			// also it can't be a time, or arbitrary precision number object
+			switch value.Interface().(type) {
+			case *time.Time, *big.Int, *big.Float:
+			default:
 				t = "structure"
 			}
 		case reflect.Slice:
@@ -177,6 +218,33 @@ func unmarshalScalar(value reflect.Value, data interface{}, tag reflect.StructTa
 		switch value.Interface().(type) {
 		case *string:
 			value.Set(reflect.ValueOf(&d))
+		case *int64:
+			// Numbers may be sent as quoted strings.
+			di, err := strconv.ParseInt(d, 10, 64)
+			if err != nil {
+				return err
+			}
+			value.Set(reflect.ValueOf(&di))
+		case *bool:
+			b, err := strconv.ParseBool(d)
+			if err != nil {
+				return err
+			}
+			value.Set(reflect.ValueOf(&b))
+		case *float64:
+			// Non-finite float values are represented as string tokens.
+			f, err := protocol.ParseFloat64(d)
+			if err != nil {
+				return err
+			}
+			value.Set(reflect.ValueOf(&f))
+		case *big.Float:
+			// Infinite float values are represented as string tokens.
+			f, err := protocol.ParseBigFloat(d)
+			if err != nil {
+				return err
+			}
+			value.Set(reflect.ValueOf(f))
 		case []byte:
 			b, err := base64.StdEncoding.DecodeString(d)
 			if err != nil {
@@ -186,15 +254,44 @@ func unmarshalScalar(value reflect.Value, data interface{}, tag reflect.StructTa
 		default:
 			return errf()
 		}
-	case float64:
+	case json.Number:
 		switch value.Interface().(type) {
 		case *int64:
-			di := int64(d)
+			di, err := d.Int64()
+			if err != nil {
+				// Fall back to truncating values with a fraction, or
+				// exponent.
+				f, ferr := d.Float64()
+				if ferr != nil {
+					return err
+				}
+				di = int64(f)
+			}
 			value.Set(reflect.ValueOf(&di))
 		case *float64:
-			value.Set(reflect.ValueOf(&d))
+			f, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			value.Set(reflect.ValueOf(&f))
+		case *big.Int:
+			v, err := protocol.ParseBigInt(d.String())
+			if err != nil {
+				return err
+			}
+			value.Set(reflect.ValueOf(v))
+		case *big.Float:
+			v, err := protocol.ParseBigFloat(d.String())
+			if err != nil {
+				return err
+			}
+			value.Set(reflect.ValueOf(v))
 		case *time.Time:
-			t := time.Unix(int64(d), 0).UTC()
+			f, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			t := time.Unix(int64(f), 0).UTC()
 			value.Set(reflect.ValueOf(&t))
 		default:
 			return errf()
@@ -211,3 +308,100 @@ func unmarshalScalar(value reflect.Value, data interface{}, tag reflect.StructTa
 	}
 	return nil
 }
+
+// collectUnknownFields walks the decoded JSON data alongside the type it was
+// unmarshaled into, appending the path of each JSON object key that has no
+// matching member in the type.
+func collectUnknownFields(vtype reflect.Type, data interface{}, path string, tag reflect.StructTag, unknown *[]string) {
+	if data == nil {
+		return
+	}
+	if vtype.Kind() == reflect.Ptr {
+		vtype = vtype.Elem()
+	}
+
+	t := tag.Get("type")
+	if t == "" {
+		switch vtype.Kind() {
+		case reflect.Struct:
+			if vtype != reflect.TypeOf(time.Time{}) {
+				t = "structure"
+			}
+		case reflect.Slice:
+			if vtype.Elem().Kind() != reflect.Uint8 {
+				t = "list"
+			}
+		case reflect.Map:
+			t = "map"
+		}
+	}
+
+	switch t {
+	case "structure":
+		mapData, ok := data.(map[string]interface{})
+		if !ok {
+			return
+		}
+		if field, ok := vtype.FieldByName("_"); ok {
+			if payload := field.Tag.Get("payload"); payload != "" {
+				member, _ := vtype.FieldByName(payload)
+				collectUnknownFields(member.Type, data, path, member.Tag, unknown)
+				return
+			}
+		}
+
+		members := map[string]reflect.StructField{}
+		for i := 0; i < vtype.NumField(); i++ {
+			field := vtype.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			name := field.Name
+			if locName := field.Tag.Get("locationName"); locName != "" {
+				name = locName
+			}
+			members[name] = field
+		}
+
+		for _, k := range sortedKeys(mapData) {
+			field, ok := members[k]
+			if !ok {
+				*unknown = append(*unknown, joinPath(path, k))
+				continue
+			}
+			collectUnknownFields(field.Type, mapData[k], joinPath(path, k), field.Tag, unknown)
+		}
+	case "list":
+		listData, ok := data.([]interface{})
+		if !ok {
+			return
+		}
+		for i, v := range listData {
+			collectUnknownFields(vtype.Elem(), v, fmt.Sprintf("%s[%d]", path, i), "", unknown)
+		}
+	case "map":
+		mapData, ok := data.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for _, k := range sortedKeys(mapData) {
+			collectUnknownFields(vtype.Elem(), mapData[k], joinPath(path, k), "", unknown)
+		}
+	}
+}
+
+func joinPath(path, k string) string {
+	if len(path) == 0 {
+		return k
+	}
+	return path + "." + k
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
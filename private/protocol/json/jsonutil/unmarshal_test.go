@@ -0,0 +1,216 @@
+package jsonutil_test
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+)
+
+type unknownNested struct {
+	_ struct{} `type:"structure"`
+
+	Name *string `type:"string"`
+}
+
+type unknownOutput struct {
+	_ struct{} `type:"structure"`
+
+	Items  []*unknownNested          `type:"list"`
+	Nested *unknownNested            `type:"structure"`
+	Tags   map[string]*string        `type:"map"`
+	Value  *string                   `locationName:"value" type:"string"`
+	Maps   map[string]*unknownNested `type:"map"`
+}
+
+const unknownFieldsJSON = `{
+	"value": "abc",
+	"NewTopLevel": 123,
+	"Nested": {"Name": "nested", "NewObject": {"Foo": "bar"}},
+	"Items": [
+		{"Name": "first"},
+		{"Name": "second", "NewList": [{"A": 1}, "b"]}
+	],
+	"Tags": {"key": "value"},
+	"Maps": {"mk": {"Name": "mapped", "Other": true}}
+}`
+
+func TestUnmarshalJSONCollectUnknown(t *testing.T) {
+	var expect, actual unknownOutput
+
+	if err := jsonutil.UnmarshalJSON(&expect, strings.NewReader(unknownFieldsJSON)); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	unknown, err := jsonutil.UnmarshalJSONCollectUnknown(&actual, strings.NewReader(unknownFieldsJSON))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expectUnknown := []string{
+		"Items[1].NewList",
+		"Maps.mk.Other",
+		"Nested.NewObject",
+		"NewTopLevel",
+	}
+	if e, a := expectUnknown, unknown; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v unknown fields, got %v", e, a)
+	}
+
+	if !reflect.DeepEqual(expect, actual) {
+		t.Errorf("expect collecting unknown fields to not modify value, %v, %v", expect, actual)
+	}
+	if e, a := "second", aws.StringValue(actual.Items[1].Name); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestUnmarshalJSONCollectUnknown_None(t *testing.T) {
+	var actual unknownOutput
+	unknown, err := jsonutil.UnmarshalJSONCollectUnknown(&actual, strings.NewReader(`{"value":"abc"}`))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if len(unknown) != 0 {
+		t.Errorf("expect no unknown fields, got %v", unknown)
+	}
+}
+
+type floatShape struct {
+	_ struct{} `type:"structure"`
+
+	Float  *float64 `type:"float"`
+	Double *float64 `type:"double"`
+}
+
+func TestNonFiniteFloatRoundTrip(t *testing.T) {
+	cases := []struct {
+		Value  float64
+		Expect string
+	}{
+		{Value: math.NaN(), Expect: `{"Float":"NaN","Double":"NaN"}`},
+		{Value: math.Inf(1), Expect: `{"Float":"Infinity","Double":"Infinity"}`},
+		{Value: math.Inf(-1), Expect: `{"Float":"-Infinity","Double":"-Infinity"}`},
+		{Value: 1.25, Expect: `{"Float":1.25,"Double":1.25}`},
+	}
+
+	for i, c := range cases {
+		b, err := jsonutil.BuildJSON(&floatShape{Float: aws.Float64(c.Value), Double: aws.Float64(c.Value)})
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Expect, string(b); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+
+		var actual floatShape
+		if err := jsonutil.UnmarshalJSON(&actual, bytes.NewReader(b)); err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		for _, v := range []*float64{actual.Float, actual.Double} {
+			if v == nil {
+				t.Fatalf("%d, expect value, got nil", i)
+			}
+			if math.IsNaN(c.Value) {
+				if !math.IsNaN(*v) {
+					t.Errorf("%d, expect NaN, got %v", i, *v)
+				}
+			} else if e, a := c.Value, *v; e != a {
+				t.Errorf("%d, expect %v, got %v", i, e, a)
+			}
+		}
+	}
+}
+
+type bigNumberShape struct {
+	_ struct{} `type:"structure"`
+
+	Int   *big.Int   `type:"bigInteger"`
+	Float *big.Float `type:"bigDecimal"`
+	Long  *int64     `type:"long"`
+}
+
+func TestBigNumberRoundTrip(t *testing.T) {
+	const input = `{"Int":123456789012345678901234567890,"Float":12345678901234567890.123456789,"Long":9223372036854775807}`
+
+	var actual bigNumberShape
+	if err := jsonutil.UnmarshalJSON(&actual, strings.NewReader(input)); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "123456789012345678901234567890", actual.Int.String(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "12345678901234567890.123456789", actual.Float.Text('f', -1); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	// Decoding through float64 would round the value to 9223372036854775808.
+	if e, a := int64(math.MaxInt64), *actual.Long; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	b, err := jsonutil.BuildJSON(&actual)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := input, string(b); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	// Unmarshaling the same value into a float64 loses precision.
+	var lossy floatShape
+	if err := jsonutil.UnmarshalJSON(&lossy, strings.NewReader(`{"Double":12345678901234567890.123456789}`)); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "12345678901234567890.123456789", strconv.FormatFloat(*lossy.Double, 'f', -1, 64); e == a {
+		t.Errorf("expect float64 value to lose precision, got %v", a)
+	}
+}
+
+func TestUnmarshalJSON_TrailingData(t *testing.T) {
+	var actual floatShape
+	if err := jsonutil.UnmarshalJSON(&actual, strings.NewReader(`{"Double":1} {}`)); err == nil {
+		t.Errorf("expect error for trailing data")
+	}
+}
+
+type quotedShape struct {
+	_ struct{} `type:"structure"`
+
+	Long   *int64   `type:"long"`
+	Double *float64 `type:"double"`
+	Bool   *bool    `type:"boolean"`
+}
+
+func TestUnmarshalQuotedValues(t *testing.T) {
+	cases := []string{
+		`{"Long":"9223372036854775807","Double":"0.30000000000000004","Bool":"true"}`,
+		`{"Long":9223372036854775807,"Double":0.30000000000000004,"Bool":true}`,
+	}
+
+	for i, c := range cases {
+		var actual quotedShape
+		if err := jsonutil.UnmarshalJSON(&actual, strings.NewReader(c)); err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := int64(math.MaxInt64), *actual.Long; e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+		if e, a := 0.30000000000000004, *actual.Double; e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+		if e, a := true, *actual.Bool; e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+
+	var actual quotedShape
+	if err := jsonutil.UnmarshalJSON(&actual, strings.NewReader(`{"Long":"abc"}`)); err == nil {
+		t.Errorf("expect error for invalid quoted number")
+	}
+}
@@ -0,0 +1,132 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/json"
+)
+
+// An Encoder provides encoding of the AWS JSON RPC protocol. The encoder
+// writes all fields to the JSON body, and sets the X-Amz-Target and
+// Content-Type headers of the request when encoded.
+//
+// Only supports body and payload targets.
+type Encoder struct {
+	req         *http.Request
+	bodyEncoder *json.Encoder
+
+	// TargetPrefix is the prefix of the X-Amz-Target header, e.g.
+	// "DynamoDB_20120810". The header's value is the prefix and the
+	// Operation name joined with a ".".
+	TargetPrefix string
+
+	// Operation is the name of the API operation being encoded.
+	Operation string
+
+	// JSONVersion is the version of the JSON protocol, e.g. "1.0", used in
+	// the "application/x-amz-json-<JSONVersion>" Content-Type header.
+	JSONVersion string
+
+	err error
+}
+
+// NewEncoder creates a new encoder for encoding the AWS JSON RPC protocol.
+// The TargetPrefix, Operation, and JSONVersion must be set before the
+// request is encoded.
+func NewEncoder(req *http.Request) *Encoder {
+	return &Encoder{
+		req:         req,
+		bodyEncoder: json.NewEncoder(),
+	}
+}
+
+// Encode returns the encoded request and JSON body. An empty body is encoded
+// as an empty JSON object, "{}". An error is returned if the TargetPrefix,
+// Operation, or JSONVersion is not set, or if one occurred while encoding the
+// fields.
+func (e *Encoder) Encode() (*http.Request, io.ReadSeeker, error) {
+	if e.err != nil {
+		return nil, nil, e.err
+	}
+
+	switch {
+	case len(e.TargetPrefix) == 0:
+		return nil, nil, fmt.Errorf("jsonrpc encoder TargetPrefix not set")
+	case len(e.Operation) == 0:
+		return nil, nil, fmt.Errorf("jsonrpc encoder Operation not set")
+	case len(e.JSONVersion) == 0:
+		return nil, nil, fmt.Errorf("jsonrpc encoder JSONVersion not set")
+	}
+
+	body, err := e.bodyEncoder.Encode()
+	if err != nil {
+		return nil, nil, err
+	}
+	if body == nil {
+		// Several services fail requests without a body, so an empty
+		// object is always sent.
+		body = bytes.NewReader(emptyJSON)
+	}
+
+	e.req.Header.Set("X-Amz-Target", e.TargetPrefix+"."+e.Operation)
+	e.req.Header.Set("Content-Type", "application/x-amz-json-"+e.JSONVersion)
+
+	return e.req, body, nil
+}
+
+// SensitivePaths returns the paths of the JSON body members encoded that were
+// marked as sensitive.
+func (e *Encoder) SensitivePaths() []string {
+	return e.bodyEncoder.SensitivePaths()
+}
+
+// SetValue sets an individual value to the JSON body.
+func (e *Encoder) SetValue(t protocol.Target, k string, v protocol.ValueMarshaler, meta protocol.Metadata) {
+	if e.validTarget("SetValue", t, k) {
+		e.bodyEncoder.SetValue(t, k, v, meta)
+	}
+}
+
+// SetStream is not supported for JSON RPC protocol marshaling.
+func (e *Encoder) SetStream(t protocol.Target, k string, v protocol.StreamMarshaler, meta protocol.Metadata) {
+	e.err = fmt.Errorf("jsonrpc encoder SetStream not supported, %s, %s", t, k)
+}
+
+// SetList creates a JSON list and calls the passed in fn callback with a list encoder.
+func (e *Encoder) SetList(t protocol.Target, k string, fn func(le protocol.ListEncoder), meta protocol.Metadata) {
+	if e.validTarget("SetList", t, k) {
+		e.bodyEncoder.SetList(t, k, fn, meta)
+	}
+}
+
+// SetMap creates a JSON map and calls the passed in fn callback with a map encoder.
+func (e *Encoder) SetMap(t protocol.Target, k string, fn func(me protocol.MapEncoder), meta protocol.Metadata) {
+	if e.validTarget("SetMap", t, k) {
+		e.bodyEncoder.SetMap(t, k, fn, meta)
+	}
+}
+
+// SetFields sets the nested fields to the JSON body.
+func (e *Encoder) SetFields(t protocol.Target, k string, m protocol.FieldMarshaler, meta protocol.Metadata) {
+	if e.validTarget("SetFields", t, k) {
+		e.bodyEncoder.SetFields(t, k, m, meta)
+	}
+}
+
+func (e *Encoder) validTarget(method string, t protocol.Target, k string) bool {
+	if e.err != nil {
+		return false
+	}
+
+	switch t {
+	case protocol.BodyTarget, protocol.PayloadTarget:
+		return true
+	default:
+		e.err = fmt.Errorf("unknown %s jsonrpc encode target, %s, %s", method, t, k)
+		return false
+	}
+}
@@ -0,0 +1,180 @@
+package jsonrpc
+
+import (
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/redact"
+)
+
+type encodeShape struct {
+	Value *string
+}
+
+func (s *encodeShape) MarshalFields(e protocol.FieldEncoder) error {
+	if s.Value != nil {
+		e.SetValue(protocol.BodyTarget, "Value", protocol.StringValue(*s.Value), protocol.Metadata{})
+	}
+	return nil
+}
+
+func TestEncoderHeaders(t *testing.T) {
+	cases := []struct {
+		Shape      *encodeShape
+		ExpectBody string
+	}{
+		{Shape: &encodeShape{Value: aws.String("abc")}, ExpectBody: `{"Value":"abc"}`},
+		{Shape: &encodeShape{}, ExpectBody: `{}`},
+	}
+
+	for i, c := range cases {
+		origReq, _ := http.NewRequest("POST", "https://service.amazonaws.com", nil)
+
+		e := NewEncoder(origReq)
+		e.TargetPrefix = "Service_20170101"
+		e.Operation = "Operation"
+		e.JSONVersion = "1.1"
+		c.Shape.MarshalFields(e)
+
+		req, body, err := e.Encode()
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+
+		if e, a := "Service_20170101.Operation", req.Header.Get("X-Amz-Target"); e != a {
+			t.Errorf("%d, expect %v target, got %v", i, e, a)
+		}
+		if e, a := "application/x-amz-json-1.1", req.Header.Get("Content-Type"); e != a {
+			t.Errorf("%d, expect %v content type, got %v", i, e, a)
+		}
+
+		if body == nil {
+			t.Fatalf("%d, expect body, got none", i)
+		}
+		b, err := ioutil.ReadAll(body)
+		if err != nil {
+			t.Fatalf("%d, expect no read error, got %v", i, err)
+		}
+		if e, a := c.ExpectBody, string(b); e != a {
+			t.Errorf("%d, expect %v body, got %v", i, e, a)
+		}
+	}
+}
+
+func TestEncoderMissingMetadata(t *testing.T) {
+	cases := []struct {
+		TargetPrefix, Operation, JSONVersion string
+	}{
+		{Operation: "Operation", JSONVersion: "1.1"},
+		{TargetPrefix: "Service_20170101", JSONVersion: "1.1"},
+		{TargetPrefix: "Service_20170101", Operation: "Operation"},
+	}
+
+	for i, c := range cases {
+		origReq, _ := http.NewRequest("POST", "https://service.amazonaws.com", nil)
+
+		e := NewEncoder(origReq)
+		e.TargetPrefix = c.TargetPrefix
+		e.Operation = c.Operation
+		e.JSONVersion = c.JSONVersion
+
+		if _, _, err := e.Encode(); err == nil {
+			t.Errorf("%d, expect error, got none", i)
+		}
+	}
+}
+
+func TestEncoderInvalidTarget(t *testing.T) {
+	origReq, _ := http.NewRequest("POST", "https://service.amazonaws.com", nil)
+
+	e := NewEncoder(origReq)
+	e.TargetPrefix = "Service_20170101"
+	e.Operation = "Operation"
+	e.JSONVersion = "1.1"
+	e.SetValue(protocol.HeaderTarget, "x-amz-header", protocol.StringValue("abc"), protocol.Metadata{})
+
+	if _, _, err := e.Encode(); err == nil {
+		t.Errorf("expect error, got none")
+	}
+}
+
+func TestBuildWithEncoder(t *testing.T) {
+	req := request.New(aws.Config{}, metadata.ClientInfo{
+		Endpoint:     "https://service.amazonaws.com",
+		TargetPrefix: "Service_20170101",
+		JSONVersion:  "1.0",
+	}, request.Handlers{}, nil, &request.Operation{Name: "Operation", HTTPMethod: "POST"},
+		&encodeShape{}, nil)
+
+	Build(req)
+	if req.Error != nil {
+		t.Fatalf("expect no error, got %v", req.Error)
+	}
+
+	if e, a := "Service_20170101.Operation", req.HTTPRequest.Header.Get("X-Amz-Target"); e != a {
+		t.Errorf("expect %v target, got %v", e, a)
+	}
+	if e, a := "application/x-amz-json-1.0", req.HTTPRequest.Header.Get("Content-Type"); e != a {
+		t.Errorf("expect %v content type, got %v", e, a)
+	}
+
+	b, err := ioutil.ReadAll(req.GetBody())
+	if err != nil {
+		t.Fatalf("expect no read error, got %v", err)
+	}
+	if e, a := `{}`, string(b); e != a {
+		t.Errorf("expect %v body, got %v", e, a)
+	}
+}
+
+type sensitiveShape struct {
+	_ struct{} `type:"structure"`
+
+	User     *string           `type:"string"`
+	Password *string           `type:"string" sensitive:"true"`
+	Members  []*sensitiveShape `type:"list"`
+}
+
+func TestBuildRedactsSensitiveMembers(t *testing.T) {
+	req := request.New(aws.Config{}, metadata.ClientInfo{
+		Endpoint:     "https://service.amazonaws.com",
+		TargetPrefix: "Service_20170101",
+		JSONVersion:  "1.0",
+	}, request.Handlers{}, nil, &request.Operation{Name: "Operation", HTTPMethod: "POST"},
+		&sensitiveShape{
+			User:     aws.String("abc"),
+			Password: aws.String("secret"),
+			Members: []*sensitiveShape{
+				{User: aws.String("def")},
+				{User: aws.String("ghi"), Password: aws.String("secret")},
+			},
+		}, nil)
+
+	Build(req)
+	if req.Error != nil {
+		t.Fatalf("expect no error, got %v", req.Error)
+	}
+
+	if e, a := []string{"Password", "Members[1].Password"}, req.SensitiveBodyPaths; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v sensitive paths, got %v", e, a)
+	}
+
+	b, err := ioutil.ReadAll(req.GetBody())
+	if err != nil {
+		t.Fatalf("expect no read error, got %v", err)
+	}
+	redacted, err := redact.JSON(b, req.SensitiveBodyPaths)
+	if err != nil {
+		t.Fatalf("expect no redact error, got %v", err)
+	}
+	expect := `{"User":"abc","Password":"***","Members":[{"User":"def"},{"User":"ghi","Password":"***"}]}`
+	if e, a := expect, string(redacted); e != a {
+		t.Errorf("expect %v redacted body, got %v", e, a)
+	}
+}
@@ -7,11 +7,14 @@ package jsonrpc
 
 import (
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol"
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/private/protocol/rest"
 )
@@ -32,10 +35,33 @@ var UnmarshalErrorHandler = request.NamedHandler{Name: "awssdk.jsonrpc.Unmarshal
 
 // Build builds a JSON payload for a JSON RPC request.
 func Build(req *request.Request) {
+	if m, ok := req.Params.(protocol.FieldMarshaler); ok && req.ParamsFilled() &&
+		req.ClientInfo.TargetPrefix != "" && req.ClientInfo.JSONVersion != "" {
+		e := NewEncoder(req.HTTPRequest)
+		e.TargetPrefix = req.ClientInfo.TargetPrefix
+		e.Operation = req.Operation.Name
+		e.JSONVersion = req.ClientInfo.JSONVersion
+
+		m.MarshalFields(e)
+
+		var body io.ReadSeeker
+		var err error
+		req.HTTPRequest, body, err = e.Encode()
+		if err != nil {
+			req.Error = awserr.New(request.ErrCodeSerialization, "failed encoding JSON RPC request", err)
+			return
+		}
+		req.SetReaderBody(body)
+		req.SensitiveBodyPaths = e.SensitivePaths()
+		return
+	}
+
+	// Fall back to old reflection based marshaler
 	var buf []byte
+	var sensitive []string
 	var err error
 	if req.ParamsFilled() {
-		buf, err = jsonutil.BuildJSON(req.Params)
+		buf, sensitive, err = jsonutil.BuildJSONWithSensitivePaths(req.Params)
 		if err != nil {
 			req.Error = awserr.New("SerializationError", "failed encoding JSON RPC request", err)
 			return
@@ -46,6 +72,7 @@ func Build(req *request.Request) {
 
 	if req.ClientInfo.TargetPrefix != "" || string(buf) != "{}" {
 		req.SetBufferBody(buf)
+		req.SensitiveBodyPaths = sensitive
 	}
 
 	if req.ClientInfo.TargetPrefix != "" {
@@ -62,7 +89,12 @@ func Build(req *request.Request) {
 func Unmarshal(req *request.Request) {
 	defer req.HTTPResponse.Body.Close()
 	if req.DataFilled() {
-		err := jsonutil.UnmarshalJSON(req.Data, req.HTTPResponse.Body)
+		var err error
+		if aws.BoolValue(req.Config.CollectUnknownFields) {
+			req.UnknownResponseFields, err = jsonutil.UnmarshalJSONCollectUnknown(req.Data, req.HTTPResponse.Body)
+		} else {
+			err = jsonutil.UnmarshalJSON(req.Data, req.HTTPResponse.Body)
+		}
 		if err != nil {
 			req.Error = awserr.New("SerializationError", "failed decoding JSON RPC response", err)
 		}
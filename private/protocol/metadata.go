@@ -8,6 +8,12 @@ type Attribute struct {
 	Meta  Metadata
 }
 
+// Checksum algorithms supported for stream payload trailing checksums.
+const (
+	ChecksumAlgorithmCRC32C = "CRC32C"
+	ChecksumAlgorithmSHA256 = "SHA256"
+)
+
 // Metadata is a collection of configuration flags for encoders to render the
 // output.
 type Metadata struct {
@@ -21,4 +27,33 @@ type Metadata struct {
 
 	XMLNamespacePrefix string
 	XMLNamespaceURI    string
+
+	// ContentLength is the length of a stream payload, if known. Only used
+	// for streams which are not seekable. Zero if the length is unknown.
+	ContentLength int64
+
+	// ChecksumAlgorithm is the algorithm of the trailing checksum to compute
+	// for a stream payload. If set the payload will be sent with the
+	// aws-chunked content encoding. Empty if no checksum should be computed.
+	ChecksumAlgorithm string
+
+	// Sensitive marks the member's value as sensitive, e.g. a password or
+	// secret key. Sensitive values are redacted when request bodies are
+	// logged.
+	Sensitive bool
+
+	// HeaderValueEncoding is the encoding of header values containing
+	// non-ASCII characters, e.g. HeaderValueEncodingRFC2047. Empty if the
+	// values should be sent unmodified.
+	HeaderValueEncoding string
+
+	// SerializeEmpty forces a list or map member to be encoded in the JSON
+	// body even if it has no elements, e.g. "[]" or "{}". Otherwise list and
+	// map members without elements are omitted.
+	SerializeEmpty bool
+
+	// TimestampFormat is the format timestamp members are encoded in, e.g.
+	// UnixTimeFormat. Only used for query string members. Empty if the
+	// TimeValue's format should be used.
+	TimestampFormat string
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol"
 	"github.com/aws/aws-sdk-go/private/protocol/query/queryutil"
 )
 
@@ -20,7 +21,12 @@ func Build(r *request.Request) {
 		"Action":  {r.Operation.Name},
 		"Version": {r.ClientInfo.APIVersion},
 	}
-	if err := queryutil.Parse(body, r.Params, false); err != nil {
+	sensitive, err := parse(body, r.Params)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == request.InvalidParameterErrCode {
+			r.Error = err
+			return
+		}
 		r.Error = awserr.New("SerializationError", "failed encoding Query request", err)
 		return
 	}
@@ -29,8 +35,23 @@ func Build(r *request.Request) {
 		r.HTTPRequest.Method = "POST"
 		r.HTTPRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
 		r.SetBufferBody([]byte(body.Encode()))
+		r.SensitiveBodyPaths = sensitive
 	} else { // This is a pre-signed request
 		r.HTTPRequest.Method = "GET"
 		r.HTTPRequest.URL.RawQuery = body.Encode()
 	}
 }
+
+// parse encodes the params into the body, using the params' generated
+// marshaler if it has one. The names of the sensitive parameters built by
+// the reflection based parser are returned.
+func parse(body url.Values, params interface{}) ([]string, error) {
+	if m, ok := params.(protocol.FieldMarshaler); ok {
+		e := NewEncoder(body)
+		m.MarshalFields(e)
+		_, err := e.Encode()
+		return nil, err
+	}
+
+	return queryutil.ParseWithSensitivePaths(body, params, false)
+}
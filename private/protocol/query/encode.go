@@ -0,0 +1,274 @@
+package query
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
+// An Encoder provides encoding of the AWS Query protocol. Fields are encoded
+// as form values named by their location within the input shape, e.g.
+// "Nested.List.member.1". Blob values are base64 encoded.
+//
+// Only supports body targets.
+type Encoder struct {
+	values url.Values
+	prefix string
+	err    *error
+
+	// EC2 selects the EC2 Query protocol's naming of list and map members.
+	// EC2 Query lists and maps are always flattened.
+	EC2 bool
+}
+
+// NewEncoder creates a new encoder for encoding the AWS Query protocol into
+// the values passed in. The values should already include the request's
+// Action and Version.
+func NewEncoder(values url.Values) *Encoder {
+	var err error
+	return &Encoder{
+		values: values,
+		err:    &err,
+	}
+}
+
+// Encode returns the encoded form values. An error is returned if one
+// occurred while encoding the fields.
+func (e *Encoder) Encode() (url.Values, error) {
+	if *e.err != nil {
+		return nil, *e.err
+	}
+	return e.values, nil
+}
+
+// SetValue sets an individual value to the form values.
+func (e *Encoder) SetValue(t protocol.Target, k string, v protocol.ValueMarshaler, meta protocol.Metadata) {
+	if !e.validTarget("SetValue", t, k) {
+		return
+	}
+	e.setValue(e.name(k), v)
+}
+
+// SetStream is not supported for Query protocol marshaling.
+func (e *Encoder) SetStream(t protocol.Target, k string, v protocol.StreamMarshaler, meta protocol.Metadata) {
+	if *e.err != nil {
+		return
+	}
+	*e.err = fmt.Errorf("query encoder SetStream not supported, %s, %s", t, k)
+}
+
+// SetList encodes the list's elements as form values calling the passed in
+// fn callback with a list encoder. An empty list is encoded as an empty
+// value.
+func (e *Encoder) SetList(t protocol.Target, k string, fn func(le protocol.ListEncoder), meta protocol.Metadata) {
+	if !e.validTarget("SetList", t, k) {
+		return
+	}
+	e.setList(e.name(k), fn, meta)
+}
+
+// SetMap encodes the map's entries as form values calling the passed in fn
+// callback with a map encoder. Entries are encoded in key order.
+func (e *Encoder) SetMap(t protocol.Target, k string, fn func(me protocol.MapEncoder), meta protocol.Metadata) {
+	if !e.validTarget("SetMap", t, k) {
+		return
+	}
+	e.setMap(e.name(k), fn, meta)
+}
+
+// SetFields sets the nested fields to the form values, prefixed with the
+// field's name.
+func (e *Encoder) SetFields(t protocol.Target, k string, m protocol.FieldMarshaler, meta protocol.Metadata) {
+	if !e.validTarget("SetFields", t, k) {
+		return
+	}
+	e.setFields(e.name(k), m)
+}
+
+func (e *Encoder) name(k string) string {
+	if len(e.prefix) == 0 {
+		return k
+	}
+	return e.prefix + "." + k
+}
+
+func (e *Encoder) validTarget(method string, t protocol.Target, k string) bool {
+	if *e.err != nil {
+		return false
+	}
+
+	switch t {
+	case protocol.BodyTarget:
+		return true
+	default:
+		*e.err = fmt.Errorf("unknown %s query encode target, %s, %s", method, t, k)
+		return false
+	}
+}
+
+func (e *Encoder) setValue(name string, v protocol.ValueMarshaler) {
+	if *e.err != nil {
+		return
+	}
+
+	if f, ok := v.(protocol.Float64Value); ok && !protocol.IsFiniteFloat64(float64(f)) {
+		*e.err = awserr.New(request.InvalidParameterErrCode,
+			fmt.Sprintf("non-finite float value %s not supported for param %s",
+				protocol.FormatFloat64(float64(f)), name),
+			nil)
+		return
+	}
+
+	str, err := v.MarshalValue()
+	if err != nil {
+		*e.err = err
+		return
+	}
+	e.values.Set(name, str)
+}
+
+func (e *Encoder) setList(name string, fn func(le protocol.ListEncoder), meta protocol.Metadata) {
+	if *e.err != nil {
+		return
+	}
+
+	memberName := name
+	if !e.EC2 && !meta.Flatten {
+		listName := meta.ListLocationName
+		if len(listName) == 0 {
+			listName = "member"
+		}
+		memberName += "." + listName
+	}
+
+	le := listEncoder{Encoder: e, prefix: memberName}
+	fn(&le)
+	if le.n == 0 && *e.err == nil {
+		e.values.Set(name, "")
+	}
+}
+
+func (e *Encoder) setMap(name string, fn func(me protocol.MapEncoder), meta protocol.Metadata) {
+	if *e.err != nil {
+		return
+	}
+
+	entryName := name
+	if !e.EC2 && !meta.Flatten {
+		entryName += ".entry"
+	}
+
+	me := mapEncoder{
+		Encoder: e,
+		entries: map[string]func(string){},
+	}
+	fn(&me)
+	if *e.err != nil {
+		return
+	}
+	if len(me.entries) == 0 {
+		e.values.Set(name, "")
+		return
+	}
+
+	keyName, valueName := meta.MapLocationNameKey, meta.MapLocationNameValue
+	if len(keyName) == 0 {
+		keyName = "key"
+	}
+	if len(valueName) == 0 {
+		valueName = "value"
+	}
+
+	// Sort keys for consistent serialization.
+	keys := make([]string, 0, len(me.entries))
+	for k := range me.entries {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for i, k := range keys {
+		prefix := entryName + "." + strconv.Itoa(i+1)
+		e.values.Set(prefix+"."+keyName, k)
+		me.entries[k](prefix + "." + valueName)
+	}
+}
+
+func (e *Encoder) setFields(name string, m protocol.FieldMarshaler) {
+	if *e.err != nil {
+		return
+	}
+
+	nested := Encoder{
+		values: e.values,
+		prefix: name,
+		err:    e.err,
+		EC2:    e.EC2,
+	}
+	if err := m.MarshalFields(&nested); err != nil && *e.err == nil {
+		*e.err = err
+	}
+}
+
+// A listEncoder encodes elements within a list for the Query encoder.
+type listEncoder struct {
+	*Encoder
+	prefix string
+	n      int
+}
+
+func (e *listEncoder) nextName() string {
+	e.n++
+	return e.prefix + "." + strconv.Itoa(e.n)
+}
+
+// ListAddValue adds the value to the list.
+func (e *listEncoder) ListAddValue(v protocol.ValueMarshaler) {
+	e.setValue(e.nextName(), v)
+}
+
+// ListAddList adds a list nested within another list.
+func (e *listEncoder) ListAddList(fn func(le protocol.ListEncoder)) {
+	e.setList(e.nextName(), fn, protocol.Metadata{})
+}
+
+// ListAddMap adds a map nested within a list.
+func (e *listEncoder) ListAddMap(fn func(me protocol.MapEncoder)) {
+	e.setMap(e.nextName(), fn, protocol.Metadata{})
+}
+
+// ListAddFields adds the nested type's fields to the list.
+func (e *listEncoder) ListAddFields(m protocol.FieldMarshaler) {
+	e.setFields(e.nextName(), m)
+}
+
+// A mapEncoder collects the entries of a map for the Query encoder, so that
+// the entries can be encoded in key order.
+type mapEncoder struct {
+	*Encoder
+	entries map[string]func(name string)
+}
+
+// MapSetValue sets a map value.
+func (e *mapEncoder) MapSetValue(k string, v protocol.ValueMarshaler) {
+	e.entries[k] = func(name string) { e.setValue(name, v) }
+}
+
+// MapSetList sets a list nested within the map.
+func (e *mapEncoder) MapSetList(k string, fn func(le protocol.ListEncoder)) {
+	e.entries[k] = func(name string) { e.setList(name, fn, protocol.Metadata{}) }
+}
+
+// MapSetMap sets a map nested within another map.
+func (e *mapEncoder) MapSetMap(k string, fn func(me protocol.MapEncoder)) {
+	e.entries[k] = func(name string) { e.setMap(name, fn, protocol.Metadata{}) }
+}
+
+// MapSetFields sets the nested type's fields under the map.
+func (e *mapEncoder) MapSetFields(k string, m protocol.FieldMarshaler) {
+	e.entries[k] = func(name string) { e.setFields(name, m) }
+}
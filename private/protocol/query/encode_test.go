@@ -0,0 +1,254 @@
+package query
+
+import (
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/redact"
+)
+
+type encodeNested struct {
+	Name  string
+	Blobs [][]byte
+}
+
+func (s *encodeNested) MarshalFields(e protocol.FieldEncoder) error {
+	e.SetValue(protocol.BodyTarget, "Name", protocol.StringValue(s.Name), protocol.Metadata{})
+	if s.Blobs != nil {
+		e.SetList(protocol.BodyTarget, "Blobs", func(le protocol.ListEncoder) {
+			for _, v := range s.Blobs {
+				le.ListAddValue(protocol.BytesValue(v))
+			}
+		}, protocol.Metadata{})
+	}
+	return nil
+}
+
+type encodeShape struct {
+	Blob      []byte
+	Blobs     [][]byte
+	Flattened []string
+	Named     []string
+	Empty     []string
+	Map       map[string]string
+	Nested    *encodeNested
+	List      []*encodeNested
+}
+
+func (s *encodeShape) MarshalFields(e protocol.FieldEncoder) error {
+	if s.Blob != nil {
+		e.SetValue(protocol.BodyTarget, "Blob", protocol.BytesValue(s.Blob), protocol.Metadata{})
+	}
+	if s.Blobs != nil {
+		e.SetList(protocol.BodyTarget, "Blobs", func(le protocol.ListEncoder) {
+			for _, v := range s.Blobs {
+				le.ListAddValue(protocol.BytesValue(v))
+			}
+		}, protocol.Metadata{})
+	}
+	if s.Flattened != nil {
+		e.SetList(protocol.BodyTarget, "Flattened", encodeStrings(s.Flattened), protocol.Metadata{Flatten: true})
+	}
+	if s.Named != nil {
+		e.SetList(protocol.BodyTarget, "Named", encodeStrings(s.Named), protocol.Metadata{ListLocationName: "item"})
+	}
+	if s.Empty != nil {
+		e.SetList(protocol.BodyTarget, "Empty", encodeStrings(s.Empty), protocol.Metadata{})
+	}
+	if s.Map != nil {
+		e.SetMap(protocol.BodyTarget, "Map", func(me protocol.MapEncoder) {
+			for k, v := range s.Map {
+				me.MapSetValue(k, protocol.StringValue(v))
+			}
+		}, protocol.Metadata{MapLocationNameKey: "Name"})
+	}
+	if s.Nested != nil {
+		e.SetFields(protocol.BodyTarget, "Nested", s.Nested, protocol.Metadata{})
+	}
+	if s.List != nil {
+		e.SetList(protocol.BodyTarget, "List", func(le protocol.ListEncoder) {
+			for _, v := range s.List {
+				le.ListAddFields(v)
+			}
+		}, protocol.Metadata{})
+	}
+	return nil
+}
+
+func encodeStrings(vs []string) func(protocol.ListEncoder) {
+	return func(le protocol.ListEncoder) {
+		for _, v := range vs {
+			le.ListAddValue(protocol.StringValue(v))
+		}
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	input := &encodeShape{
+		Blob:      []byte("foo"),
+		Blobs:     [][]byte{[]byte("bar"), {}},
+		Flattened: []string{"a", "b"},
+		Named:     []string{"c"},
+		Empty:     []string{},
+		Map:       map[string]string{"b": "2", "a": "1"},
+		Nested:    &encodeNested{Name: "nested", Blobs: [][]byte{[]byte("baz")}},
+		List:      []*encodeNested{{Name: "first"}, {Name: "second"}},
+	}
+
+	e := NewEncoder(url.Values{"Action": {"Operation"}})
+	input.MarshalFields(e)
+	values, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := url.Values{
+		"Action":                {"Operation"},
+		"Blob":                  {"Zm9v"},
+		"Blobs.member.1":        {"YmFy"},
+		"Blobs.member.2":        {""},
+		"Flattened.1":           {"a"},
+		"Flattened.2":           {"b"},
+		"Named.item.1":          {"c"},
+		"Empty":                 {""},
+		"Map.entry.1.Name":      {"a"},
+		"Map.entry.1.value":     {"1"},
+		"Map.entry.2.Name":      {"b"},
+		"Map.entry.2.value":     {"2"},
+		"Nested.Name":           {"nested"},
+		"Nested.Blobs.member.1": {"YmF6"},
+		"List.member.1.Name":    {"first"},
+		"List.member.2.Name":    {"second"},
+	}
+	if e, a := expect.Encode(), values.Encode(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestEncoder_EC2(t *testing.T) {
+	input := &encodeShape{
+		Blobs: [][]byte{[]byte("bar")},
+		Map:   map[string]string{"a": "1"},
+	}
+
+	e := NewEncoder(url.Values{})
+	e.EC2 = true
+	input.MarshalFields(e)
+	values, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := url.Values{
+		"Blobs.1":     {"YmFy"},
+		"Map.1.Name":  {"a"},
+		"Map.1.value": {"1"},
+	}
+	if e, a := expect.Encode(), values.Encode(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestEncoder_Errors(t *testing.T) {
+	e := NewEncoder(url.Values{})
+	e.SetValue(protocol.HeaderTarget, "Header", protocol.StringValue("abc"), protocol.Metadata{})
+	if _, err := e.Encode(); err == nil {
+		t.Errorf("expect error for unsupported target")
+	}
+
+	e = NewEncoder(url.Values{})
+	e.SetValue(protocol.BodyTarget, "Float", protocol.Float64Value(math.Inf(1)), protocol.Metadata{})
+	_, err := e.Encode()
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T, %v", err, err)
+	}
+	if e, a := request.InvalidParameterErrCode, aerr.Code(); e != a {
+		t.Errorf("expect %v code, got %v", e, a)
+	}
+	if e, a := "Float", aerr.Message(); !strings.Contains(a, e) {
+		t.Errorf("expect %v in message, got %v", e, a)
+	}
+}
+
+func TestBuild_FieldMarshaler(t *testing.T) {
+	httpReq, _ := http.NewRequest("POST", "https://service.amazonaws.com", nil)
+	r := &request.Request{
+		HTTPRequest: httpReq,
+		Operation:   &request.Operation{Name: "Operation"},
+		ClientInfo:  metadata.ClientInfo{APIVersion: "2017-01-01"},
+		Params:      &encodeShape{Blobs: [][]byte{[]byte("bar"), {}}},
+	}
+
+	Build(r)
+	if r.Error != nil {
+		t.Fatalf("expect no error, got %v", r.Error)
+	}
+
+	b, err := ioutil.ReadAll(r.GetBody())
+	if err != nil {
+		t.Fatalf("expect no read error, got %v", err)
+	}
+	expect := "Action=Operation&Blobs.member.1=YmFy&Blobs.member.2=&Version=2017-01-01"
+	if e, a := expect, string(b); e != a {
+		t.Errorf("expect %v body, got %v", e, a)
+	}
+}
+
+type sensitiveShape struct {
+	_ struct{} `type:"structure"`
+
+	User     *string           `type:"string"`
+	Password *string           `type:"string" sensitive:"true"`
+	Members  []*sensitiveShape `type:"list"`
+}
+
+func TestBuild_RedactsSensitiveMembers(t *testing.T) {
+	httpReq, _ := http.NewRequest("POST", "https://service.amazonaws.com", nil)
+	r := &request.Request{
+		HTTPRequest: httpReq,
+		Operation:   &request.Operation{Name: "Operation"},
+		ClientInfo:  metadata.ClientInfo{APIVersion: "2017-01-01"},
+		Params: &sensitiveShape{
+			User:     aws.String("abc"),
+			Password: aws.String("secret"),
+			Members: []*sensitiveShape{
+				{User: aws.String("def")},
+				{User: aws.String("ghi"), Password: aws.String("secret")},
+			},
+		},
+	}
+
+	Build(r)
+	if r.Error != nil {
+		t.Fatalf("expect no error, got %v", r.Error)
+	}
+
+	if e, a := []string{"Password", "Members.member.2.Password"}, r.SensitiveBodyPaths; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v sensitive paths, got %v", e, a)
+	}
+
+	b, err := ioutil.ReadAll(r.GetBody())
+	if err != nil {
+		t.Fatalf("expect no read error, got %v", err)
+	}
+	redacted, err := redact.Query(b, r.SensitiveBodyPaths)
+	if err != nil {
+		t.Fatalf("expect no redact error, got %v", err)
+	}
+	expect := "Action=Operation&Members.member.1.User=def&Members.member.2.Password=***&" +
+		"Members.member.2.User=ghi&Password=***&User=abc&Version=2017-01-01"
+	if e, a := expect, string(redacted); e != a {
+		t.Errorf("expect %v redacted body, got %v", e, a)
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/private/protocol"
 )
 
@@ -20,6 +22,18 @@ func Parse(body url.Values, i interface{}, isEC2 bool) error {
 	return q.parseValue(body, reflect.ValueOf(i), "", "")
 }
 
+// ParseWithSensitivePaths parses an object i and fills a url.Values object as
+// Parse does, and returns the names of the parameters built from members
+// tagged as sensitive, e.g. "Users.member.1.Password", so they can be
+// redacted when the body is logged. A sensitive list, map, or structure's
+// name is returned once, and is the prefix of the parameters nested in it.
+func ParseWithSensitivePaths(body url.Values, i interface{}, isEC2 bool) ([]string, error) {
+	var sensitive []string
+	q := queryParser{isEC2: isEC2, sensitive: &sensitive}
+	err := q.parseValue(body, reflect.ValueOf(i), "", "")
+	return sensitive, err
+}
+
 func elemOf(value reflect.Value) reflect.Value {
 	for value.Kind() == reflect.Ptr {
 		value = value.Elem()
@@ -29,6 +43,10 @@ func elemOf(value reflect.Value) reflect.Value {
 
 type queryParser struct {
 	isEC2 bool
+
+	// sensitive collects the names of the parameters built from members
+	// tagged as sensitive, if set.
+	sensitive *[]string
 }
 
 func (q *queryParser) parseValue(v url.Values, value reflect.Value, prefix string, tag reflect.StructTag) error {
@@ -45,7 +63,10 @@ func (q *queryParser) parseValue(v url.Values, value reflect.Value, prefix strin
 		case reflect.Struct:
 			t = "structure"
 		case reflect.Slice:
-			t = "list"
+			// also it can't be a blob
+			if _, ok := value.Interface().([]byte); !ok {
+				t = "list"
+			}
 		case reflect.Map:
 			t = "map"
 		}
@@ -107,6 +128,10 @@ func (q *queryParser) parseStruct(v url.Values, value reflect.Value, prefix stri
 			name = prefix + "." + name
 		}
 
+		if q.sensitive != nil && field.Tag.Get("sensitive") == "true" && elemValue.IsValid() {
+			*q.sensitive = append(*q.sensitive, name)
+		}
+
 		if err := q.parseValue(v, elemValue, name, field.Tag); err != nil {
 			return err
 		}
@@ -224,8 +249,14 @@ func (q *queryParser) parseScalar(v url.Values, r reflect.Value, name string, ta
 	case int:
 		v.Set(name, strconv.Itoa(value))
 	case float64:
+		if !protocol.IsFiniteFloat64(value) {
+			return newNonFiniteFloatError(name, value)
+		}
 		v.Set(name, strconv.FormatFloat(value, 'f', -1, 64))
 	case float32:
+		if !protocol.IsFiniteFloat64(float64(value)) {
+			return newNonFiniteFloatError(name, float64(value))
+		}
 		v.Set(name, strconv.FormatFloat(float64(value), 'f', -1, 32))
 	case time.Time:
 		const ISO8601UTC = "2006-01-02T15:04:05Z"
@@ -235,3 +266,12 @@ func (q *queryParser) parseScalar(v url.Values, r reflect.Value, name string, ta
 	}
 	return nil
 }
+
+// newNonFiniteFloatError returns a client side validation error for float
+// values which cannot be represented by the query protocol.
+func newNonFiniteFloatError(name string, v float64) error {
+	return awserr.New(request.InvalidParameterErrCode,
+		fmt.Sprintf("non-finite float value %s not supported for param %s",
+			protocol.FormatFloat64(v), name),
+		nil)
+}
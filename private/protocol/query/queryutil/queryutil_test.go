@@ -0,0 +1,106 @@
+package queryutil_test
+
+import (
+	"math"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol/query/queryutil"
+)
+
+type floatInput struct {
+	_ struct{} `type:"structure"`
+
+	Float  *float64 `type:"float"`
+	Double *float64 `type:"double"`
+}
+
+func TestParseNonFiniteFloat(t *testing.T) {
+	cases := []struct {
+		Input  floatInput
+		Field  string
+		Value  string
+		Expect string
+	}{
+		{Input: floatInput{Float: aws.Float64(math.NaN())}, Field: "Float", Value: "NaN"},
+		{Input: floatInput{Double: aws.Float64(math.Inf(1))}, Field: "Double", Value: "Infinity"},
+		{Input: floatInput{Double: aws.Float64(math.Inf(-1))}, Field: "Double", Value: "-Infinity"},
+		{Input: floatInput{Double: aws.Float64(1.5)}, Expect: "Double=1.5"},
+	}
+
+	for i, c := range cases {
+		body := url.Values{}
+		err := queryutil.Parse(body, &c.Input, false)
+
+		if len(c.Field) == 0 {
+			if err != nil {
+				t.Fatalf("%d, expect no error, got %v", i, err)
+			}
+			if e, a := c.Expect, body.Encode(); e != a {
+				t.Errorf("%d, expect %v, got %v", i, e, a)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("%d, expect error, got none", i)
+		}
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			t.Fatalf("%d, expect awserr.Error, got %T", i, err)
+		}
+		if e, a := request.InvalidParameterErrCode, aerr.Code(); e != a {
+			t.Errorf("%d, expect %v code, got %v", i, e, a)
+		}
+		if e, a := c.Field, aerr.Message(); !strings.Contains(a, e) {
+			t.Errorf("%d, expect %v in message, got %v", i, e, a)
+		}
+		if e, a := c.Value, aerr.Message(); !strings.Contains(a, e) {
+			t.Errorf("%d, expect %v in message, got %v", i, e, a)
+		}
+	}
+}
+
+type blobInput struct {
+	_ struct{} `type:"structure"`
+
+	Blob  []byte   `type:"blob"`
+	Empty []byte   `type:"blob"`
+	Blobs [][]byte `type:"list"`
+}
+
+func TestParseBlobs(t *testing.T) {
+	input := blobInput{
+		Blob:  []byte("foo"),
+		Empty: []byte{},
+		Blobs: [][]byte{[]byte("bar"), {}, []byte("baz")},
+	}
+
+	body := url.Values{}
+	if err := queryutil.Parse(body, &input, false); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := url.Values{
+		"Blob":           {"Zm9v"},
+		"Empty":          {""},
+		"Blobs.member.1": {"YmFy"},
+		"Blobs.member.2": {""},
+		"Blobs.member.3": {"YmF6"},
+	}
+	if e, a := expect.Encode(), body.Encode(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	body = url.Values{}
+	if err := queryutil.Parse(body, &blobInput{}, false); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "", body.Encode(); e != a {
+		t.Errorf("expect unset blobs to not be encoded, got %v", a)
+	}
+}
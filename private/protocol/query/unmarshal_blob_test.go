@@ -0,0 +1,91 @@
+package query_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol/query"
+)
+
+type blobOutput struct {
+	_ struct{} `type:"structure"`
+
+	Blob      []byte   `type:"blob"`
+	Empty     []byte   `type:"blob"`
+	Blobs     [][]byte `type:"list"`
+	Flattened [][]byte `type:"list" flattened:"true"`
+}
+
+func TestUnmarshalBlobs(t *testing.T) {
+	body := `<OperationResponse><OperationResult>` +
+		`<Blob>Zm9v</Blob>` +
+		`<Empty></Empty>` +
+		`<Blobs><member>YmFy</member><member>YmF6</member></Blobs>` +
+		`<Flattened>cXV4</Flattened><Flattened>cXV1eA==</Flattened>` +
+		`</OperationResult></OperationResponse>`
+
+	out := &blobOutput{}
+	r := &request.Request{
+		Operation: &request.Operation{Name: "Operation"},
+		HTTPResponse: &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+		},
+		Data: out,
+	}
+
+	query.Unmarshal(r)
+	if r.Error != nil {
+		t.Fatalf("expect no error, got %v", r.Error)
+	}
+
+	if e, a := []byte("foo"), out.Blob; !bytes.Equal(e, a) {
+		t.Errorf("expect %q blob, got %q", e, a)
+	}
+	if e, a := 0, len(out.Empty); e != a {
+		t.Errorf("expect %d length empty blob, got %d", e, a)
+	}
+
+	for _, c := range []struct {
+		Expect []string
+		Actual [][]byte
+	}{
+		{Expect: []string{"bar", "baz"}, Actual: out.Blobs},
+		{Expect: []string{"qux", "quux"}, Actual: out.Flattened},
+	} {
+		if e, a := len(c.Expect), len(c.Actual); e != a {
+			t.Fatalf("expect %d blobs, got %d", e, a)
+		}
+		for i, v := range c.Expect {
+			if e, a := v, string(c.Actual[i]); e != a {
+				t.Errorf("%d, expect %q blob, got %q", i, e, a)
+			}
+		}
+	}
+}
+
+func TestUnmarshalBlobs_InvalidBase64(t *testing.T) {
+	body := `<OperationResponse><OperationResult>` +
+		`<Blob>not base64!</Blob>` +
+		`</OperationResult></OperationResponse>`
+
+	r := &request.Request{
+		Operation: &request.Operation{Name: "Operation"},
+		HTTPResponse: &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		},
+		Data: &blobOutput{},
+	}
+
+	query.Unmarshal(r)
+	if r.Error == nil {
+		t.Errorf("expect error for invalid base64 blob")
+	}
+}
@@ -0,0 +1,118 @@
+// Package redact provides helpers for replacing the values of sensitive
+// members in encoded request bodies, so that the bodies can be logged.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// Value is the value sensitive members are replaced with when a document is
+// redacted.
+const Value = "***"
+
+// JSON returns a copy of the JSON document b with the values of the members at
+// paths replaced with Value. Paths are in the form returned by the JSON
+// protocol encoder's SensitivePaths, e.g. "Nested.List[0].Password". A "[*]"
+// index matches any list index, and a "*" member any key of a map, e.g.
+// "Nested.List[*].Password". If a path refers to an object or list the entire
+// value is replaced. The document b is not modified.
+func JSON(b []byte, paths []string) ([]byte, error) {
+	if len(paths) == 0 {
+		return b, nil
+	}
+
+	sensitive := newPathSet(paths, splitJSONPath)
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	buf := bytes.NewBuffer(make([]byte, 0, len(b)))
+	if err := redactJSONValue(dec, buf, "", sensitive); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func redactJSONValue(dec *json.Decoder, buf *bytes.Buffer, path string, sensitive *pathSet) error {
+	if len(path) != 0 && sensitive.match(path) {
+		var v json.RawMessage
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+		buf.WriteString(strconv.Quote(Value))
+		return nil
+	}
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	switch tv := tok.(type) {
+	case json.Delim:
+		switch tv {
+		case '{':
+			buf.WriteByte('{')
+			for i := 0; dec.More(); i++ {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				keyTok, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				key, _ := keyTok.(string)
+				writeJSONToken(buf, key)
+				buf.WriteByte(':')
+				if err := redactJSONValue(dec, buf, joinJSONPath(path, key), sensitive); err != nil {
+					return err
+				}
+			}
+			buf.WriteByte('}')
+		case '[':
+			buf.WriteByte('[')
+			for i := 0; dec.More(); i++ {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				elemPath := path + "[" + strconv.Itoa(i) + "]"
+				if err := redactJSONValue(dec, buf, elemPath, sensitive); err != nil {
+					return err
+				}
+			}
+			buf.WriteByte(']')
+		}
+		// Consume the closing delimiter of the object or list.
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+	default:
+		writeJSONToken(buf, tok)
+	}
+
+	return nil
+}
+
+func writeJSONToken(buf *bytes.Buffer, tok json.Token) {
+	switch tv := tok.(type) {
+	case string:
+		b, _ := json.Marshal(tv)
+		buf.Write(b)
+	case json.Number:
+		buf.WriteString(string(tv))
+	case bool:
+		buf.WriteString(strconv.FormatBool(tv))
+	case nil:
+		buf.WriteString("null")
+	}
+}
+
+func joinJSONPath(path, k string) string {
+	if len(path) == 0 {
+		return k
+	}
+	return path + "." + k
+}
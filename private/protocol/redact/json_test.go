@@ -0,0 +1,160 @@
+package redact
+
+import (
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/private/protocol"
+	protojson "github.com/aws/aws-sdk-go/private/protocol/json"
+)
+
+type sensitiveShape struct {
+	Name     *string
+	Password *string
+	Key      []byte
+	Tokens   []*string
+	Flat     []*string
+	Creds    *sensitiveCreds
+	Items    []*sensitiveCreds
+}
+
+func (s *sensitiveShape) MarshalFields(e protocol.FieldEncoder) error {
+	if s.Name != nil {
+		e.SetValue(protocol.BodyTarget, "Name", protocol.StringValue(*s.Name), protocol.Metadata{})
+	}
+	if s.Password != nil {
+		e.SetValue(protocol.BodyTarget, "Password", protocol.StringValue(*s.Password), protocol.Metadata{Sensitive: true})
+	}
+	if s.Key != nil {
+		e.SetValue(protocol.BodyTarget, "Key", protocol.BytesValue(s.Key), protocol.Metadata{Sensitive: true})
+	}
+	if s.Tokens != nil {
+		e.SetList(protocol.BodyTarget, "Tokens", protocol.EncodeStringList(s.Tokens), protocol.Metadata{Sensitive: true})
+	}
+	if s.Flat != nil {
+		e.SetList(protocol.BodyTarget, "Flat", protocol.EncodeStringList(s.Flat), protocol.Metadata{Flatten: true, Sensitive: true})
+	}
+	if s.Creds != nil {
+		e.SetFields(protocol.BodyTarget, "Creds", s.Creds, protocol.Metadata{Sensitive: true})
+	}
+	if s.Items != nil {
+		e.SetList(protocol.BodyTarget, "Items", func(le protocol.ListEncoder) {
+			for _, v := range s.Items {
+				le.ListAddFields(v)
+			}
+		}, protocol.Metadata{})
+	}
+	return nil
+}
+
+type sensitiveCreds struct {
+	User   *string
+	Secret *string
+}
+
+func (s *sensitiveCreds) MarshalFields(e protocol.FieldEncoder) error {
+	if s.User != nil {
+		e.SetValue(protocol.BodyTarget, "User", protocol.StringValue(*s.User), protocol.Metadata{})
+	}
+	if s.Secret != nil {
+		e.SetValue(protocol.BodyTarget, "Secret", protocol.StringValue(*s.Secret), protocol.Metadata{Sensitive: true})
+	}
+	return nil
+}
+
+type sensitiveRoot struct {
+	Payload *sensitiveShape
+}
+
+func (s *sensitiveRoot) MarshalFields(e protocol.FieldEncoder) error {
+	e.SetFields(protocol.PayloadTarget, "Root", s.Payload, protocol.Metadata{})
+	return nil
+}
+
+func newSensitiveRoot() *sensitiveRoot {
+	s := "value"
+	return &sensitiveRoot{Payload: &sensitiveShape{
+		Name:     &s,
+		Password: &s,
+		Key:      []byte("key"),
+		Tokens:   []*string{&s, &s},
+		Flat:     []*string{&s, &s},
+		Creds:    &sensitiveCreds{User: &s, Secret: &s},
+		Items: []*sensitiveCreds{
+			{User: &s},
+			{User: &s, Secret: &s},
+		},
+	}}
+}
+
+func TestJSON(t *testing.T) {
+	shape := newSensitiveRoot()
+
+	e := protojson.NewEncoder()
+	shape.MarshalFields(e)
+	r, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no marshal error, %v", err)
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("expect no read error, %v", err)
+	}
+
+	expectPaths := []string{
+		"Password", "Key", "Tokens", "Flat", "Creds", "Creds.Secret", "Items[1].Secret",
+	}
+	if e, a := expectPaths, e.SensitivePaths(); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v paths, got %v", e, a)
+	}
+
+	orig := string(b)
+	redacted, err := JSON(b, e.SensitivePaths())
+	if err != nil {
+		t.Fatalf("expect no redact error, %v", err)
+	}
+
+	expect := `{"Name":"value","Password":"***","Key":"***","Tokens":"***","Flat":"***","Creds":"***",` +
+		`"Items":[{"User":"value"},{"User":"value","Secret":"***"}]}`
+	if e, a := expect, string(redacted); e != a {
+		t.Errorf("expect bodies to match, did not.\n,\tExpect:\n%s\n\tActual:\n%s\n", e, a)
+	}
+	if e, a := orig, string(b); e != a {
+		t.Errorf("expect original body not to be modified, got %s", a)
+	}
+}
+
+func TestJSONWildcardPaths(t *testing.T) {
+	b := []byte(`{"Items":[{"User":"a","Secret":"b"},{"User":"c","Secret":"d"}],` +
+		`"Vars":{"k1":"v1","k2":"v2"},"Nested":{"Vars":{"k":{"Secret":"e","Other":"f"}}},"Secret":"g"}`)
+
+	redacted, err := JSON(b, []string{"Items[*].Secret", "Vars.*", "Nested.Vars.*.Secret"})
+	if err != nil {
+		t.Fatalf("expect no redact error, %v", err)
+	}
+
+	expect := `{"Items":[{"User":"a","Secret":"***"},{"User":"c","Secret":"***"}],` +
+		`"Vars":{"k1":"***","k2":"***"},"Nested":{"Vars":{"k":{"Secret":"***","Other":"f"}}},"Secret":"g"}`
+	if e, a := expect, string(redacted); e != a {
+		t.Errorf("expect bodies to match, did not.\n,\tExpect:\n%s\n\tActual:\n%s\n", e, a)
+	}
+}
+
+func TestJSONNoPaths(t *testing.T) {
+	b := []byte(`{"a": 1}`)
+	redacted, err := JSON(b, nil)
+	if err != nil {
+		t.Fatalf("expect no error, %v", err)
+	}
+	if e, a := string(b), string(redacted); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestJSONInvalid(t *testing.T) {
+	_, err := JSON([]byte(`{"a":`), []string{"a.b"})
+	if err == nil {
+		t.Errorf("expect error for invalid JSON")
+	}
+}
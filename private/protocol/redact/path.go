@@ -0,0 +1,95 @@
+package redact
+
+import "strings"
+
+// A pathSet matches the paths of a document's members against the sensitive
+// paths. A sensitive path's "[*]" index matches any list index, and its "*"
+// element matches any map key or XML element name.
+type pathSet struct {
+	split    func(string) []string
+	exact    map[string]struct{}
+	patterns [][]string
+}
+
+func newPathSet(paths []string, split func(string) []string) *pathSet {
+	s := &pathSet{split: split, exact: make(map[string]struct{}, len(paths))}
+	for _, p := range paths {
+		if strings.Contains(p, "*") {
+			s.patterns = append(s.patterns, split(p))
+		} else {
+			s.exact[p] = struct{}{}
+		}
+	}
+
+	return s
+}
+
+// match returns if the path is sensitive.
+func (s *pathSet) match(path string) bool {
+	if _, ok := s.exact[path]; ok {
+		return true
+	}
+	if len(s.patterns) == 0 {
+		return false
+	}
+
+	elems := s.split(path)
+	for _, p := range s.patterns {
+		if matchElems(p, elems) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchElems(pattern, elems []string) bool {
+	if len(pattern) != len(elems) {
+		return false
+	}
+
+	for i, p := range pattern {
+		isIndex := strings.HasPrefix(elems[i], "[")
+		switch {
+		case p == "[*]" && isIndex:
+		case p == "*" && !isIndex:
+		case p == elems[i]:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// splitJSONPath splits the JSON path into its member names and list indexes,
+// e.g. "Nested.List[0].Password" into "Nested", "List", "[0]", "Password".
+func splitJSONPath(path string) []string {
+	var elems []string
+	for _, name := range strings.Split(path, ".") {
+		for {
+			i := strings.Index(name, "[")
+			if i < 0 {
+				break
+			}
+			if i > 0 {
+				elems = append(elems, name[:i])
+			}
+			j := strings.Index(name[i:], "]")
+			if j < 0 {
+				break
+			}
+			elems = append(elems, name[i:i+j+1])
+			name = name[i+j+1:]
+		}
+		if len(name) != 0 {
+			elems = append(elems, name)
+		}
+	}
+
+	return elems
+}
+
+// splitXMLPath splits the XML path into its element names, e.g.
+// "Root/Credentials/Password" into "Root", "Credentials", "Password".
+func splitXMLPath(path string) []string {
+	return strings.Split(path, "/")
+}
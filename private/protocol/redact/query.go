@@ -0,0 +1,56 @@
+package redact
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+)
+
+// Query returns a copy of the form encoded query body b with the values of
+// the parameters at paths replaced with Value. Paths are the names of the
+// parameters as built by the query protocol, e.g. "Users.member.1.Password".
+// A path also matches the parameters nested in it, e.g. "Credentials" matches
+// "Credentials.Password". The order of the parameters is kept, and the body b
+// is not modified.
+func Query(b []byte, paths []string) ([]byte, error) {
+	if len(paths) == 0 {
+		return b, nil
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, len(b)))
+	for i, param := range bytes.Split(b, []byte("&")) {
+		if i > 0 {
+			buf.WriteByte('&')
+		}
+
+		k := param
+		if j := bytes.IndexByte(param, '='); j >= 0 {
+			k = param[:j]
+		}
+		name, err := url.QueryUnescape(string(k))
+		if err != nil {
+			return nil, err
+		}
+
+		if !matchQueryName(paths, name) {
+			buf.Write(param)
+			continue
+		}
+		buf.Write(k)
+		buf.WriteByte('=')
+		buf.WriteString(Value)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// matchQueryName returns if the parameter name is one of the paths, or is
+// nested in one of them.
+func matchQueryName(paths []string, name string) bool {
+	for _, p := range paths {
+		if name == p || strings.HasPrefix(name, p+".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package redact
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+// XML returns a copy of the XML document b with the content of the elements at
+// paths replaced with Value. Paths are in the form returned by the XML
+// protocol encoder's SensitivePaths, e.g. "Root/Credentials/Password". A "*"
+// element matches any element name, e.g. "*/Credentials/Password". All
+// elements matching a path are redacted, including the repeated elements of
+// flattened lists. The document b is not modified.
+func XML(b []byte, paths []string) ([]byte, error) {
+	if len(paths) == 0 {
+		return b, nil
+	}
+
+	sensitive := newPathSet(paths, splitXMLPath)
+
+	type span struct{ start, end int64 }
+	var spans []span
+
+	dec := xml.NewDecoder(bytes.NewReader(b))
+
+	var path []string
+	redactDepth := -1
+	var start int64
+	for {
+		offset := dec.InputOffset()
+		tok, err := dec.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			path = append(path, t.Name.Local)
+			if redactDepth >= 0 {
+				continue
+			}
+			if sensitive.match(strings.Join(path, "/")) {
+				redactDepth = len(path)
+				start = dec.InputOffset()
+			}
+		case xml.EndElement:
+			if len(path) == 0 || path[len(path)-1] != t.Name.Local {
+				return nil, &xml.SyntaxError{Msg: "unexpected end element </" + t.Name.Local + ">"}
+			}
+			if redactDepth == len(path) {
+				// Empty and self closing elements have no content to redact.
+				if offset > start {
+					spans = append(spans, span{start: start, end: offset})
+				}
+				redactDepth = -1
+			}
+			path = path[:len(path)-1]
+		}
+	}
+
+	if len(path) != 0 {
+		return nil, &xml.SyntaxError{Msg: "unexpected EOF, unclosed element <" + path[len(path)-1] + ">"}
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, len(b)))
+	var last int64
+	for _, s := range spans {
+		buf.Write(b[last:s.start])
+		buf.WriteString(Value)
+		last = s.end
+	}
+	buf.Write(b[last:])
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,83 @@
+package redact
+
+import (
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	protoxml "github.com/aws/aws-sdk-go/private/protocol/xml"
+)
+
+func TestXML(t *testing.T) {
+	shape := newSensitiveRoot()
+
+	e := protoxml.NewEncoder()
+	shape.MarshalFields(e)
+	r, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no marshal error, %v", err)
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("expect no read error, %v", err)
+	}
+
+	expectPaths := []string{
+		"Root/Password", "Root/Key", "Root/Tokens", "Root/Flat",
+		"Root/Creds", "Root/Creds/Secret",
+		"Root/Items/member/Secret",
+	}
+	if e, a := expectPaths, e.SensitivePaths(); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v paths, got %v", e, a)
+	}
+
+	orig := string(b)
+	redacted, err := XML(b, e.SensitivePaths())
+	if err != nil {
+		t.Fatalf("expect no redact error, %v", err)
+	}
+
+	expect := `<Root><Name>value</Name><Password>***</Password><Key>***</Key>` +
+		`<Tokens>***</Tokens><Flat>***</Flat><Flat>***</Flat><Creds>***</Creds>` +
+		`<Items><member><User>value</User></member><member><User>value</User><Secret>***</Secret></member></Items></Root>`
+	if e, a := expect, string(redacted); e != a {
+		t.Errorf("expect bodies to match, did not.\n,\tExpect:\n%s\n\tActual:\n%s\n", e, a)
+	}
+	if e, a := orig, string(b); e != a {
+		t.Errorf("expect original body not to be modified, got %s", a)
+	}
+}
+
+func TestXMLWildcardPaths(t *testing.T) {
+	b := []byte(`<CreateResponse><CreateResult><Items><member><User>a</User><Secret>b</Secret></member>` +
+		`<member><User>c</User><Secret>d</Secret></member></Items></CreateResult></CreateResponse>`)
+
+	redacted, err := XML(b, []string{"*/CreateResult/Items/*/Secret"})
+	if err != nil {
+		t.Fatalf("expect no redact error, %v", err)
+	}
+
+	expect := `<CreateResponse><CreateResult><Items><member><User>a</User><Secret>***</Secret></member>` +
+		`<member><User>c</User><Secret>***</Secret></member></Items></CreateResult></CreateResponse>`
+	if e, a := expect, string(redacted); e != a {
+		t.Errorf("expect bodies to match, did not.\n,\tExpect:\n%s\n\tActual:\n%s\n", e, a)
+	}
+}
+
+func TestXMLEmptyElement(t *testing.T) {
+	b := []byte(`<Root><Secret/><Other></Other></Root>`)
+	redacted, err := XML(b, []string{"Root/Secret", "Root/Other"})
+	if err != nil {
+		t.Fatalf("expect no error, %v", err)
+	}
+	if e, a := string(b), string(redacted); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestXMLInvalid(t *testing.T) {
+	_, err := XML([]byte(`<Root><Secret>abc</Root>`), []string{"Root/Secret"})
+	if err == nil {
+		t.Errorf("expect error for invalid XML")
+	}
+}
@@ -0,0 +1,138 @@
+package rest
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// writeResponseBody copies the response body to the request's
+// ResponseBodyWriter. The body is drained and closed once copied, or if an
+// error occurs, so that the connection can be reused.
+func writeResponseBody(r *request.Request, bw *request.ResponseBodyWriter) error {
+	body := r.HTTPResponse.Body
+	defer body.Close()
+
+	w, seekable, err := responseBodyWriter(r, bw.Writer)
+	if err != nil {
+		io.Copy(ioutil.Discard, body)
+		return err
+	}
+
+	pw := &progressWriter{
+		Writer:   w,
+		progress: bw.Progress,
+		interval: bw.ProgressInterval,
+	}
+	if pw.interval <= 0 {
+		pw.interval = request.DefaultProgressInterval
+	}
+
+	n, err := io.Copy(pw, body)
+	if err == nil {
+		if l := r.HTTPResponse.ContentLength; l >= 0 && n != l {
+			err = fmt.Errorf("response body length mismatch, expected %d bytes, got %d", l, n)
+		}
+	}
+	if err != nil {
+		io.Copy(ioutil.Discard, body)
+		if n > 0 && !seekable {
+			// The payload already written cannot be overwritten by a retry.
+			r.Retryable = aws.Bool(false)
+		}
+		return err
+	}
+
+	pw.done()
+	return nil
+}
+
+// responseBodyWriter returns the writer the response body should be written
+// to, and if the writer is able to rewrite the payload written, e.g. on
+// retry.
+func responseBodyWriter(r *request.Request, w io.Writer) (io.Writer, bool, error) {
+	wa, ok := w.(io.WriterAt)
+	if !ok {
+		return w, false, nil
+	}
+
+	contentRange := r.HTTPResponse.Header.Get("Content-Range")
+	if len(contentRange) == 0 {
+		return w, false, nil
+	}
+
+	offset, err := contentRangeStart(contentRange)
+	if err != nil {
+		return nil, false, err
+	}
+	return &offsetWriter{w: wa, offset: offset}, true, nil
+}
+
+// contentRangeStart returns the offset of the first byte of a Content-Range
+// header value, e.g. "bytes 100-199/1000".
+func contentRangeStart(v string) (int64, error) {
+	const unit = "bytes "
+	if strings.HasPrefix(v, unit) {
+		if i := strings.Index(v, "-"); i > len(unit) {
+			if start, err := strconv.ParseInt(v[len(unit):i], 10, 64); err == nil && start >= 0 {
+				return start, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("invalid Content-Range, %q", v)
+}
+
+// An offsetWriter writes sequentially to an io.WriterAt from an offset.
+type offsetWriter struct {
+	w      io.WriterAt
+	offset int64
+}
+
+func (w *offsetWriter) Write(p []byte) (int, error) {
+	n, err := w.w.WriteAt(p, w.offset)
+	w.offset += int64(n)
+	return n, err
+}
+
+// A progressWriter calls the progress callback with the number of bytes
+// written each time interval bytes have been written.
+type progressWriter struct {
+	io.Writer
+	progress func(int64)
+	interval int64
+
+	written  int64
+	mark     int64 // multiple of interval progress was last reported at
+	reported bool
+	last     int64 // number of bytes written last reported
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	w.written += int64(n)
+
+	if w.progress != nil && w.written >= w.mark+w.interval {
+		w.mark = w.written - w.written%w.interval
+		w.report()
+	}
+	return n, err
+}
+
+// done reports the total written, if it was not reported already.
+func (w *progressWriter) done() {
+	if w.progress != nil && (!w.reported || w.last != w.written) {
+		w.report()
+	}
+}
+
+func (w *progressWriter) report() {
+	w.reported = true
+	w.last = w.written
+	w.progress(w.written)
+}
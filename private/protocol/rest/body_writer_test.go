@@ -0,0 +1,211 @@
+package rest_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol/rest"
+)
+
+type mockPayloadOutput struct {
+	_           struct{}      `type:"structure" payload:"Body"`
+	Body        io.ReadCloser `type:"blob"`
+	ContentType *string       `location:"header" locationName:"Content-Type" type:"string"`
+}
+
+type mockBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *mockBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func newPayloadRequest(body *mockBody, contentLength int64, header http.Header, bw *request.ResponseBodyWriter) *request.Request {
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("Content-Type", "text/plain")
+
+	return &request.Request{
+		Data: &mockPayloadOutput{},
+		HTTPResponse: &http.Response{
+			StatusCode:    200,
+			Body:          body,
+			ContentLength: contentLength,
+			Header:        header,
+		},
+		ResponseBodyWriter: bw,
+	}
+}
+
+func TestUnmarshalResponseBodyWriter(t *testing.T) {
+	payload := strings.Repeat("abcdefghij", 10)
+
+	var progress []int64
+	var buf bytes.Buffer
+	body := &mockBody{Reader: iotest.OneByteReader(strings.NewReader(payload))}
+	req := newPayloadRequest(body, int64(len(payload)), nil, &request.ResponseBodyWriter{
+		Writer:           &buf,
+		Progress:         func(n int64) { progress = append(progress, n) },
+		ProgressInterval: 30,
+	})
+
+	rest.UnmarshalMeta(req)
+	rest.Unmarshal(req)
+	if req.Error != nil {
+		t.Fatalf("expect no error, got %v", req.Error)
+	}
+
+	if e, a := payload, buf.String(); e != a {
+		t.Errorf("expect %q payload written, got %q", e, a)
+	}
+	if !body.closed {
+		t.Errorf("expect response body to be closed")
+	}
+	if e, a := []int64{30, 60, 90, 100}, progress; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v progress, got %v", e, a)
+	}
+
+	out := req.Data.(*mockPayloadOutput)
+	if e, a := "text/plain", aws.StringValue(out.ContentType); e != a {
+		t.Errorf("expect %q content type, got %q", e, a)
+	}
+	if out.Body == nil {
+		t.Fatalf("expect output body to be set")
+	}
+	b, err := ioutil.ReadAll(out.Body)
+	if err != nil {
+		t.Fatalf("expect no read error, got %v", err)
+	}
+	if len(b) != 0 {
+		t.Errorf("expect empty output body, got %q", b)
+	}
+}
+
+func TestUnmarshalResponseBodyWriter_Option(t *testing.T) {
+	var progress []int64
+	var buf bytes.Buffer
+	req := newPayloadRequest(&mockBody{Reader: strings.NewReader("abc")}, -1, nil, nil)
+	req.ApplyOptions(request.WithResponseBodyWriter(&buf, func(n int64) {
+		progress = append(progress, n)
+	}))
+
+	rest.Unmarshal(req)
+	if req.Error != nil {
+		t.Fatalf("expect no error, got %v", req.Error)
+	}
+	if e, a := "abc", buf.String(); e != a {
+		t.Errorf("expect %q payload written, got %q", e, a)
+	}
+	if e, a := []int64{3}, progress; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v progress, got %v", e, a)
+	}
+}
+
+func TestUnmarshalResponseBodyWriter_ShortRead(t *testing.T) {
+	var buf bytes.Buffer
+	body := &mockBody{Reader: strings.NewReader("abc")}
+	req := newPayloadRequest(body, 10, nil, &request.ResponseBodyWriter{Writer: &buf})
+
+	rest.Unmarshal(req)
+	if req.Error == nil {
+		t.Fatalf("expect error")
+	}
+	if !body.closed {
+		t.Errorf("expect response body to be closed")
+	}
+	if req.Retryable == nil || *req.Retryable {
+		t.Errorf("expect partially written request to not be retryable, got %v", req.Retryable)
+	}
+}
+
+type errWriter struct{}
+
+func (errWriter) Write(p []byte) (int, error) {
+	return 0, io.ErrClosedPipe
+}
+
+func TestUnmarshalResponseBodyWriter_WriteError(t *testing.T) {
+	body := &mockBody{Reader: strings.NewReader("abc")}
+	req := newPayloadRequest(body, 3, nil, &request.ResponseBodyWriter{Writer: errWriter{}})
+
+	rest.Unmarshal(req)
+	if req.Error == nil {
+		t.Fatalf("expect error")
+	}
+	if n, _ := body.Read(make([]byte, 1)); n != 0 {
+		t.Errorf("expect response body to be drained")
+	}
+	if !body.closed {
+		t.Errorf("expect response body to be closed")
+	}
+	if req.Retryable != nil {
+		t.Errorf("expect retryable to not be set, got %v", *req.Retryable)
+	}
+}
+
+func TestUnmarshalResponseBodyWriter_WriterAt(t *testing.T) {
+	f, err := ioutil.TempFile("", "aws-sdk-go-body-writer")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	for _, part := range []struct {
+		Payload, Range string
+	}{
+		{"defgh", "bytes 3-7/8"},
+		{"abc", "bytes 0-2/8"},
+	} {
+		header := http.Header{"Content-Range": []string{part.Range}}
+		req := newPayloadRequest(&mockBody{Reader: strings.NewReader(part.Payload)},
+			int64(len(part.Payload)), header, &request.ResponseBodyWriter{Writer: f})
+
+		rest.Unmarshal(req)
+		if req.Error != nil {
+			t.Fatalf("%s, expect no error, got %v", part.Range, req.Error)
+		}
+	}
+
+	b, err := ioutil.ReadFile(f.Name())
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "abcdefgh", string(b); e != a {
+		t.Errorf("expect %q file, got %q", e, a)
+	}
+}
+
+func TestUnmarshalResponseBodyWriter_InvalidRange(t *testing.T) {
+	f, err := ioutil.TempFile("", "aws-sdk-go-body-writer")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	body := &mockBody{Reader: strings.NewReader("abc")}
+	header := http.Header{"Content-Range": []string{"bytes */8"}}
+	req := newPayloadRequest(body, 3, header, &request.ResponseBodyWriter{Writer: f})
+
+	rest.Unmarshal(req)
+	if req.Error == nil {
+		t.Fatalf("expect error")
+	}
+	if !body.closed {
+		t.Errorf("expect response body to be closed")
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol"
 )
 
 // RFC822 returns an RFC822 formatted timestamp for AWS protocols
@@ -269,9 +270,9 @@ func convertType(v reflect.Value, tag reflect.StructTag) (string, error) {
 	case int64:
 		str = strconv.FormatInt(value, 10)
 	case float64:
-		str = strconv.FormatFloat(value, 'f', -1, 64)
+		str = protocol.FormatFloat64(value)
 	case time.Time:
-		str = value.UTC().Format(RFC822)
+		str = value.UTC().Format(protocol.RFC7231TimeFormat)
 	case aws.JSONValue:
 		b, err := json.Marshal(value)
 		if err != nil {
@@ -0,0 +1,248 @@
+package rest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
+// DefaultAWSChunkSize is the size of the chunks aws-chunked stream payloads
+// are split into.
+const DefaultAWSChunkSize = 64 * 1024
+
+// streamingUnsignedPayloadTrailer is the X-Amz-Content-Sha256 value for
+// aws-chunked payloads which are not signed, and include a trailing checksum.
+const streamingUnsignedPayloadTrailer = "STREAMING-UNSIGNED-PAYLOAD-TRAILER"
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// awsChunkedPayload wraps the payload with the aws-chunked content encoding,
+// computing the trailing checksum of the payload with the algorithm
+// provided. The payload's length must be known, either because the payload
+// is seekable, or passed in as contentLength.
+//
+// The returned payload can be rewound for retries if the payload is
+// seekable.
+func (e *Encoder) awsChunkedPayload(payload io.ReadSeeker, algorithm string, contentLength int64) (io.ReadSeeker, error) {
+	newHash, trailer, err := checksumAlgorithm(algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	seekable := aws.IsReaderSeekable(payload)
+	decodedLen := contentLength
+	if seekable {
+		start, err := payload.Seek(0, 1)
+		if err != nil {
+			return nil, err
+		}
+		end, err := payload.Seek(0, 2)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = payload.Seek(start, 0); err != nil {
+			return nil, err
+		}
+		decodedLen = end - start
+	} else if contentLength <= 0 {
+		return nil, fmt.Errorf("aws-chunked payload length unknown")
+	}
+
+	chunkSize := e.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultAWSChunkSize
+	}
+
+	r := &awsChunkedReader{
+		body:        payload,
+		chunk:       make([]byte, chunkSize),
+		newHash:     newHash,
+		hash:        newHash(),
+		trailerName: trailer,
+	}
+	length := awsChunkedLength(decodedLen, int64(chunkSize), trailer, newHash().Size())
+
+	if enc := e.header.Get("Content-Encoding"); len(enc) != 0 {
+		e.header.Set("Content-Encoding", "aws-chunked,"+enc)
+	} else {
+		e.header.Set("Content-Encoding", "aws-chunked")
+	}
+	e.header.Set("Content-Length", strconv.FormatInt(length, 10))
+	e.header.Set("X-Amz-Decoded-Content-Length", strconv.FormatInt(decodedLen, 10))
+	e.header.Set("X-Amz-Trailer", trailer)
+	e.header.Set("X-Amz-Content-Sha256", streamingUnsignedPayloadTrailer)
+
+	if !seekable {
+		return aws.ReadSeekCloser(r), nil
+	}
+
+	start, _ := payload.Seek(0, 1)
+	return &awsChunkedReadSeeker{
+		awsChunkedReader: r,
+		seeker:           payload,
+		bodyStart:        start,
+		length:           length,
+	}, nil
+}
+
+func checksumAlgorithm(algorithm string) (func() hash.Hash, string, error) {
+	switch algorithm {
+	case protocol.ChecksumAlgorithmCRC32C:
+		return func() hash.Hash { return crc32.New(crc32cTable) }, "x-amz-checksum-crc32c", nil
+	case protocol.ChecksumAlgorithmSHA256:
+		return sha256.New, "x-amz-checksum-sha256", nil
+	default:
+		return nil, "", fmt.Errorf("unsupported checksum algorithm, %s", algorithm)
+	}
+}
+
+// awsChunkedLength returns the length of an aws-chunked encoded payload of
+// decodedLen bytes.
+func awsChunkedLength(decodedLen, chunkSize int64, trailer string, hashSize int) int64 {
+	chunkLen := func(n int64) int64 {
+		return int64(len(strconv.FormatInt(n, 16))) + 2 + n + 2
+	}
+
+	full, rem := decodedLen/chunkSize, decodedLen%chunkSize
+	length := full * chunkLen(chunkSize)
+	if rem > 0 {
+		length += chunkLen(rem)
+	}
+
+	// Final zero length chunk, trailer, and the trailing empty line.
+	trailerLen := len(trailer) + 1 + base64.StdEncoding.EncodedLen(hashSize) + 2
+	return length + int64(len("0\r\n")) + int64(trailerLen) + 2
+}
+
+// awsChunkedReader encodes the body with the aws-chunked content encoding
+// as it is read, and writes the body's checksum as a trailer after the final
+// chunk.
+type awsChunkedReader struct {
+	body        io.Reader
+	chunk       []byte
+	newHash     func() hash.Hash
+	hash        hash.Hash
+	trailerName string
+
+	buf  bytes.Buffer
+	done bool
+}
+
+func (r *awsChunkedReader) Read(p []byte) (int, error) {
+	for r.buf.Len() == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.fill(); err != nil {
+			return 0, err
+		}
+	}
+
+	return r.buf.Read(p)
+}
+
+func (r *awsChunkedReader) fill() error {
+	n, err := io.ReadFull(r.body, r.chunk)
+	if n > 0 {
+		r.hash.Write(r.chunk[:n])
+		r.buf.WriteString(strconv.FormatInt(int64(n), 16))
+		r.buf.WriteString("\r\n")
+		r.buf.Write(r.chunk[:n])
+		r.buf.WriteString("\r\n")
+	}
+
+	switch err {
+	case nil:
+		return nil
+	case io.EOF, io.ErrUnexpectedEOF:
+		r.buf.WriteString("0\r\n")
+		r.buf.WriteString(r.trailerName)
+		r.buf.WriteString(":")
+		r.buf.WriteString(base64.StdEncoding.EncodeToString(r.hash.Sum(nil)))
+		r.buf.WriteString("\r\n\r\n")
+		r.done = true
+		return nil
+	default:
+		return err
+	}
+}
+
+func (r *awsChunkedReader) reset() {
+	r.hash = r.newHash()
+	r.buf.Reset()
+	r.done = false
+}
+
+// awsChunkedReadSeeker is an awsChunkedReader for a seekable body. Seeking
+// restarts encoding from the start of the body, so the payload can be
+// retried.
+type awsChunkedReadSeeker struct {
+	*awsChunkedReader
+	seeker    io.Seeker
+	bodyStart int64
+	length    int64
+
+	// pos is the position that will be read from next, and read is the
+	// position the encoded body has been read up to.
+	pos, read int64
+}
+
+func (r *awsChunkedReadSeeker) Read(p []byte) (int, error) {
+	if r.pos != r.read {
+		if err := r.rewind(r.pos); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.awsChunkedReader.Read(p)
+	r.pos += int64(n)
+	r.read = r.pos
+	return n, err
+}
+
+// Seek sets the position of the next Read. The encoded payload is only
+// repositioned when next read from.
+func (r *awsChunkedReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case 0:
+	case 1:
+		offset += r.pos
+	case 2:
+		offset += r.length
+	default:
+		return 0, fmt.Errorf("invalid whence, %d", whence)
+	}
+	if offset < 0 || offset > r.length {
+		return 0, fmt.Errorf("invalid seek offset, %d", offset)
+	}
+
+	r.pos = offset
+	return offset, nil
+}
+
+// rewind restarts encoding from the start of the body, skipping the encoded
+// payload up to pos.
+func (r *awsChunkedReadSeeker) rewind(pos int64) error {
+	if _, err := r.seeker.Seek(r.bodyStart, 0); err != nil {
+		return err
+	}
+	r.awsChunkedReader.reset()
+	r.read = 0
+
+	if pos > 0 {
+		if _, err := io.CopyN(ioutil.Discard, r.awsChunkedReader, pos); err != nil {
+			return err
+		}
+	}
+	r.read = pos
+	return nil
+}
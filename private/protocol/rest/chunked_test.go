@@ -0,0 +1,207 @@
+package rest
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
+func TestSetPayloadAWSChunked(t *testing.T) {
+	cases := []struct {
+		Payload   string
+		Algorithm string
+		ChunkSize int
+		Expect    string
+	}{
+		{
+			Payload:   "",
+			Algorithm: protocol.ChecksumAlgorithmCRC32C,
+			ChunkSize: 4,
+			Expect: "0\r\n" +
+				"x-amz-checksum-crc32c:AAAAAA==\r\n\r\n",
+		},
+		{
+			Payload:   "",
+			Algorithm: protocol.ChecksumAlgorithmSHA256,
+			ChunkSize: 4,
+			Expect: "0\r\n" +
+				"x-amz-checksum-sha256:47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=\r\n\r\n",
+		},
+		{
+			Payload:   "abcdefghij",
+			Algorithm: protocol.ChecksumAlgorithmCRC32C,
+			ChunkSize: 4,
+			Expect: "4\r\nabcd\r\n" +
+				"4\r\nefgh\r\n" +
+				"2\r\nij\r\n" +
+				"0\r\n" +
+				"x-amz-checksum-crc32c:5lmUNw==\r\n\r\n",
+		},
+		{
+			Payload:   "abcdefghij",
+			Algorithm: protocol.ChecksumAlgorithmSHA256,
+			ChunkSize: 5,
+			Expect: "5\r\nabcde\r\n" +
+				"5\r\nfghij\r\n" +
+				"0\r\n" +
+				"x-amz-checksum-sha256:cjmTYdpqd1T+yYbcpbfLrxyBCije1KuvVrIQbQbLeLA=\r\n\r\n",
+		},
+		{
+			Payload:   "Hello, World!",
+			Algorithm: protocol.ChecksumAlgorithmCRC32C,
+			Expect: "d\r\nHello, World!\r\n" +
+				"0\r\n" +
+				"x-amz-checksum-crc32c:TVUQaA==\r\n\r\n",
+		},
+	}
+
+	for i, c := range cases {
+		origReq, _ := http.NewRequest("PUT", "https://service.amazonaws.com/path", nil)
+
+		e := NewEncoder(origReq)
+		if c.ChunkSize != 0 {
+			e.ChunkSize = c.ChunkSize
+		}
+		e.SetStream(protocol.PayloadTarget, "payload",
+			protocol.ReadSeekerStream{V: strings.NewReader(c.Payload)},
+			protocol.Metadata{ChecksumAlgorithm: c.Algorithm})
+		req, body, err := e.Encode()
+		if err != nil {
+			t.Fatalf("%d, expect no encode error, got %v", i, err)
+		}
+
+		expectHeaders := map[string]string{
+			"Content-Encoding":             "aws-chunked",
+			"Content-Length":               strconv.Itoa(len(c.Expect)),
+			"X-Amz-Decoded-Content-Length": strconv.Itoa(len(c.Payload)),
+			"X-Amz-Trailer":                "x-amz-checksum-" + strings.ToLower(c.Algorithm),
+			"X-Amz-Content-Sha256":         "STREAMING-UNSIGNED-PAYLOAD-TRAILER",
+		}
+		for k, v := range expectHeaders {
+			if e, a := v, req.Header.Get(k); e != a {
+				t.Errorf("%d, expect %s %s header, got %s", i, e, k, a)
+			}
+		}
+
+		if !aws.IsReaderSeekable(body) {
+			t.Errorf("%d, expect body to be seekable", i)
+		}
+
+		// Read twice to ensure the payload can be rewound for retries.
+		for j := 0; j < 2; j++ {
+			body.Seek(0, 0)
+			b, err := ioutil.ReadAll(body)
+			if err != nil {
+				t.Fatalf("%d, expect no body read error, got %v", i, err)
+			}
+			if e, a := c.Expect, string(b); e != a {
+				t.Errorf("%d, expect %q body, got %q", i, e, a)
+			}
+		}
+	}
+}
+
+func TestSetPayloadAWSChunked_Seek(t *testing.T) {
+	origReq, _ := http.NewRequest("PUT", "https://service.amazonaws.com/path", nil)
+
+	e := NewEncoder(origReq)
+	e.ChunkSize = 4
+	e.SetStream(protocol.PayloadTarget, "payload",
+		protocol.ReadSeekerStream{V: strings.NewReader("abcdefghij")},
+		protocol.Metadata{ChecksumAlgorithm: protocol.ChecksumAlgorithmCRC32C})
+	_, body, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no encode error, got %v", err)
+	}
+
+	expect := "4\r\nabcd\r\n4\r\nefgh\r\n2\r\nij\r\n0\r\nx-amz-checksum-crc32c:5lmUNw==\r\n\r\n"
+
+	// Probe the length the same way the request does when resetting the body.
+	end, err := body.Seek(0, 2)
+	if err != nil {
+		t.Fatalf("expect no seek error, got %v", err)
+	}
+	if e, a := int64(len(expect)), end; e != a {
+		t.Errorf("expect %d length, got %d", e, a)
+	}
+
+	// Read part of the payload, and seek back to a mid point.
+	if _, err := body.Seek(0, 0); err != nil {
+		t.Fatalf("expect no seek error, got %v", err)
+	}
+	if _, err := io.CopyN(ioutil.Discard, body, 12); err != nil {
+		t.Fatalf("expect no read error, got %v", err)
+	}
+	if _, err := body.Seek(5, 0); err != nil {
+		t.Fatalf("expect no seek error, got %v", err)
+	}
+
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		t.Fatalf("expect no read error, got %v", err)
+	}
+	if e, a := expect[5:], string(b); e != a {
+		t.Errorf("expect %q body, got %q", e, a)
+	}
+}
+
+func TestSetPayloadAWSChunked_NonSeekable(t *testing.T) {
+	origReq, _ := http.NewRequest("PUT", "https://service.amazonaws.com/path", nil)
+	origReq.Header.Set("Content-Encoding", "gzip")
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("abcdefghij"))
+		pw.Close()
+	}()
+
+	e := NewEncoder(origReq)
+	e.ChunkSize = 4
+	e.SetStream(protocol.PayloadTarget, "payload", protocol.ReaderStream{V: pr},
+		protocol.Metadata{ContentLength: 10, ChecksumAlgorithm: protocol.ChecksumAlgorithmCRC32C})
+	req, body, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no encode error, got %v", err)
+	}
+
+	expect := "4\r\nabcd\r\n4\r\nefgh\r\n2\r\nij\r\n0\r\nx-amz-checksum-crc32c:5lmUNw==\r\n\r\n"
+
+	if aws.IsReaderSeekable(body) {
+		t.Errorf("expect body to not be seekable")
+	}
+	if e, a := "aws-chunked,gzip", req.Header.Get("Content-Encoding"); e != a {
+		t.Errorf("expect %s content encoding, got %s", e, a)
+	}
+	if e, a := strconv.Itoa(len(expect)), req.Header.Get("Content-Length"); e != a {
+		t.Errorf("expect %s content length, got %s", e, a)
+	}
+	if e, a := "STREAMING-UNSIGNED-PAYLOAD-TRAILER", req.Header.Get("X-Amz-Content-Sha256"); e != a {
+		t.Errorf("expect %s content sha256, got %s", e, a)
+	}
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, body); err != nil {
+		t.Fatalf("expect no read error, got %v", err)
+	}
+	if e, a := expect, buf.String(); e != a {
+		t.Errorf("expect %q body, got %q", e, a)
+	}
+}
+
+func TestSetPayloadAWSChunked_UnknownAlgorithm(t *testing.T) {
+	origReq, _ := http.NewRequest("PUT", "https://service.amazonaws.com/path", nil)
+
+	e := NewEncoder(origReq)
+	e.SetStream(protocol.PayloadTarget, "payload",
+		protocol.ReadSeekerStream{V: strings.NewReader("abc")},
+		protocol.Metadata{ChecksumAlgorithm: "MD4"})
+	if _, _, err := e.Encode(); err == nil {
+		t.Errorf("expect error for unknown checksum algorithm")
+	}
+}
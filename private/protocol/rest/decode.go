@@ -0,0 +1,74 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
+// A Decoder provides decoding of REST URI response locations, the response's
+// headers and status code. Body and payload targets are decoded by the
+// protocol's body decoder.
+type Decoder struct {
+	resp *http.Response
+	err  error
+}
+
+// NewDecoder creates a new decoder for decoding the REST locations of the
+// HTTP response.
+func NewDecoder(resp *http.Response) *Decoder {
+	return &Decoder{resp: resp}
+}
+
+// Err returns the first error encountered while decoding, if any.
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+// Get decodes a single header value as a string, or the response's status
+// code as an int64. The callback is not called if the header is not present.
+func (d *Decoder) Get(t protocol.Target, k string, fn func(v protocol.FieldValue), meta protocol.Metadata) {
+	if d.err != nil {
+		return
+	}
+
+	switch t {
+	case protocol.HeaderTarget:
+		vs, ok := d.resp.Header[http.CanonicalHeaderKey(k)]
+		if !ok || len(vs) == 0 {
+			return
+		}
+		fn(vs[0])
+	case protocol.StatusCodeTarget:
+		fn(int64(d.resp.StatusCode))
+	default:
+		d.err = fmt.Errorf("unknown Get rest decode target, %s, %s", t, k)
+	}
+}
+
+// GetList is not supported for REST decoder.
+func (d *Decoder) GetList(t protocol.Target, k string, fn func(n int, ld protocol.ListDecoder), meta protocol.Metadata) {
+	d.err = fmt.Errorf("rest decoder GetList not supported, %s, %s", t, k)
+}
+
+// GetMap decodes the map of all headers sharing the prefix k. See
+// protocol.HeaderMapDecoder for how header names and values are decoded.
+func (d *Decoder) GetMap(t protocol.Target, k string, fn func(ks []string, md protocol.MapDecoder), meta protocol.Metadata) {
+	if d.err != nil {
+		return
+	}
+
+	switch t {
+	case protocol.HeadersTarget:
+		md := &protocol.HeaderMapDecoder{Prefix: k, Header: d.resp.Header}
+		fn(md.Keys(), md)
+	default:
+		d.err = fmt.Errorf("unknown GetMap rest decode target, %s, %s", t, k)
+	}
+}
+
+// GetFields is not supported for REST decoder.
+func (d *Decoder) GetFields(t protocol.Target, k string, fn func() protocol.FieldUnmarshaler, meta protocol.Metadata) {
+	d.err = fmt.Errorf("rest decoder GetFields not supported, %s, %s", t, k)
+}
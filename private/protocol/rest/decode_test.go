@@ -0,0 +1,55 @@
+package rest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
+func TestDecoderGetHeadersMap(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: 200,
+		Header: http.Header{
+			"X-Amz-Meta-Foo":   []string{"bar"},
+			"X-Amz-Meta-Empty": []string{""},
+			"X-Amz-Request-Id": []string{"abc"},
+		},
+	}
+
+	var meta map[string]*string
+	var reqID *string
+	var status *int64
+
+	d := NewDecoder(resp)
+	d.GetMap(protocol.HeadersTarget, "x-amz-meta-", protocol.DecodeStringMap(&meta), protocol.Metadata{})
+	d.Get(protocol.HeaderTarget, "x-amz-request-id", protocol.DecodeString(&reqID), protocol.Metadata{})
+	d.Get(protocol.StatusCodeTarget, "", protocol.DecodeInt64(&status), protocol.Metadata{})
+	if err := d.Err(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 2, len(meta); e != a {
+		t.Fatalf("expect %v metadata, got %v", e, a)
+	}
+	if e, a := "bar", *meta["Foo"]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "", *meta["Empty"]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "abc", *reqID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := int64(200), *status; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestDecoderUnsupportedTarget(t *testing.T) {
+	d := NewDecoder(&http.Response{Header: http.Header{}})
+	d.GetMap(protocol.BodyTarget, "key", func([]string, protocol.MapDecoder) {}, protocol.Metadata{})
+	if d.Err() == nil {
+		t.Errorf("expect error for unsupported target")
+	}
+}
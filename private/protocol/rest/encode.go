@@ -23,6 +23,20 @@ type Encoder struct {
 
 	payload io.ReadSeeker
 
+	// sensitiveHeaders collects the names of the headers of members marked
+	// as sensitive.
+	sensitiveHeaders []string
+
+	// MaxStreamBufferSize is the maximum number of bytes of a non-seekable
+	// stream payload without a known length that will be buffered in memory
+	// before spooling the stream to a temporary file. Defaults to
+	// DefaultStreamBufferSize.
+	MaxStreamBufferSize int64
+
+	// ChunkSize is the size of the chunks stream payloads with a trailing
+	// checksum are split into. Defaults to DefaultAWSChunkSize.
+	ChunkSize int
+
 	err error
 }
 
@@ -36,6 +50,9 @@ func NewEncoder(req *http.Request) *Encoder {
 		path:   protocol.NewPathReplace(req.URL.Path),
 		query:  req.URL.Query(),
 		header: req.Header,
+
+		MaxStreamBufferSize: DefaultStreamBufferSize,
+		ChunkSize:           DefaultAWSChunkSize,
 	}
 
 	return e
@@ -57,15 +74,46 @@ func (e *Encoder) Encode() (*http.Request, io.ReadSeeker, error) {
 	return e.req, e.payload, nil
 }
 
+// SensitiveHeaders returns the names of the headers encoded of the members
+// that were marked as sensitive.
+func (e *Encoder) SensitiveHeaders() []string {
+	return e.sensitiveHeaders
+}
+
+func (e *Encoder) markSensitiveHeader(k string, meta protocol.Metadata) {
+	if meta.Sensitive {
+		e.sensitiveHeaders = append(e.sensitiveHeaders, k)
+	}
+}
+
 // SetValue will set a value to the header, path, query.
 //
 // If the request's method is GET all BodyTarget values will be written to
 // the query string.
+//
+// Header values are validated, and an error is returned by Encode if the
+// header's name or value is invalid, e.g. a value containing a newline.
+//
+// Header timestamps in the RFC822 format are written as RFC 7231
+// IMF-fixdate, e.g. "Mon, 06 Nov 2017 08:49:37 GMT". Query timestamps are
+// written in meta.TimestampFormat if set.
 func (e *Encoder) SetValue(t protocol.Target, k string, v protocol.ValueMarshaler, meta protocol.Metadata) {
 	if e.err != nil {
 		return
 	}
 
+	if tv, ok := v.(protocol.TimeValue); ok {
+		switch {
+		case t == protocol.HeaderTarget:
+			if tv.Format == protocol.RFC822TimeFromat || len(tv.Format) == 0 {
+				tv.Format = protocol.RFC7231TimeFormat
+			}
+		case t == protocol.QueryTarget && len(meta.TimestampFormat) != 0:
+			tv.Format = meta.TimestampFormat
+		}
+		v = tv
+	}
+
 	var str string
 	str, e.err = v.MarshalValue()
 	if e.err != nil {
@@ -74,7 +122,11 @@ func (e *Encoder) SetValue(t protocol.Target, k string, v protocol.ValueMarshale
 
 	switch t {
 	case protocol.HeaderTarget:
+		if str, e.err = protocol.EncodeHeaderValue(k, str, meta.HeaderValueEncoding); e.err != nil {
+			return
+		}
 		e.header.Set(k, str)
+		e.markSensitiveHeader(k, meta)
 	case protocol.PathTarget:
 		e.path.ReplaceElement(k, str)
 	case protocol.QueryTarget:
@@ -91,6 +143,14 @@ func (e *Encoder) SetValue(t protocol.Target, k string, v protocol.ValueMarshale
 }
 
 // SetStream will set the stream to the payload of the request.
+//
+// If v is a ReaderStreamMarshaler the stream is not required to be seekable.
+// Non-seekable streams with a known length, meta.ContentLength, are sent
+// unsigned and cannot be retried. Non-seekable streams without a known length
+// are buffered in memory, or spooled to a temporary file if larger than
+// MaxStreamBufferSize. The payload returned by Encode will be a *SpooledFile
+// if the stream was spooled to a file, and should be closed once the request
+// is complete.
 func (e *Encoder) SetStream(t protocol.Target, k string, v protocol.StreamMarshaler, meta protocol.Metadata) {
 	if e.err != nil {
 		return
@@ -98,13 +158,27 @@ func (e *Encoder) SetStream(t protocol.Target, k string, v protocol.StreamMarsha
 
 	switch t {
 	case protocol.PayloadTarget:
-		e.payload, e.err = v.MarshalStream()
+		if rs, ok := v.(protocol.ReaderStreamMarshaler); ok {
+			var r io.Reader
+			if r, e.err = rs.MarshalReaderStream(); e.err != nil {
+				return
+			}
+			e.payload, e.err = e.readerStreamPayload(r, meta.ContentLength)
+		} else {
+			e.payload, e.err = v.MarshalStream()
+		}
+		if e.err == nil && len(meta.ChecksumAlgorithm) != 0 && e.payload != nil {
+			e.payload, e.err = e.awsChunkedPayload(e.payload, meta.ChecksumAlgorithm, meta.ContentLength)
+		}
 	default:
 		e.err = fmt.Errorf("unknown SetStream rest encode target, %s, %s", t, k)
 	}
 }
 
 // SetList will set the nested list values to the header or query.
+//
+// If the request's method is GET BodyTarget lists will be written to the
+// query string. A list without elements adds no query parameters.
 func (e *Encoder) SetList(t protocol.Target, k string, fn func(le protocol.ListEncoder), meta protocol.Metadata) {
 	if e.err != nil {
 		return
@@ -115,16 +189,28 @@ func (e *Encoder) SetList(t protocol.Target, k string, fn func(le protocol.ListE
 		nested := protocol.QueryListEncoder{Key: k, Query: e.query}
 		fn(&nested)
 		e.err = nested.Err
+	case protocol.BodyTarget:
+		if e.req.Method != "GET" {
+			e.err = fmt.Errorf("body target not supported for rest non-GET methods %s, %s", t, k)
+			return
+		}
+		nested := protocol.QueryListEncoder{Key: k, Query: e.query}
+		fn(&nested)
+		e.err = nested.Err
 	case protocol.HeaderTarget:
-		nested := protocol.HeaderListEncoder{Key: k, Header: e.header}
+		nested := protocol.HeaderListEncoder{Key: k, Header: e.header, ValueEncoding: meta.HeaderValueEncoding}
 		fn(&nested)
 		e.err = nested.Err
+		e.markSensitiveHeader(k, meta)
 	default:
 		e.err = fmt.Errorf("unknown SetList rest encode target, %s, %s", t, k)
 	}
 }
 
 // SetMap will set the nested map values to the header or query.
+//
+// If the request's method is GET BodyTarget maps will be written to the
+// query string. A map without entries adds no query parameters.
 func (e *Encoder) SetMap(t protocol.Target, k string, fn func(fe protocol.MapEncoder), meta protocol.Metadata) {
 	if e.err != nil {
 		return
@@ -135,8 +221,16 @@ func (e *Encoder) SetMap(t protocol.Target, k string, fn func(fe protocol.MapEnc
 		nested := protocol.QueryMapEncoder{Query: e.query}
 		fn(&nested)
 		e.err = nested.Err
+	case protocol.BodyTarget:
+		if e.req.Method != "GET" {
+			e.err = fmt.Errorf("body target not supported for rest non-GET methods %s, %s", t, k)
+			return
+		}
+		nested := protocol.QueryMapEncoder{Query: e.query}
+		fn(&nested)
+		e.err = nested.Err
 	case protocol.HeadersTarget:
-		nested := protocol.HeaderMapEncoder{Prefix: k, Header: e.header}
+		nested := protocol.HeaderMapEncoder{Prefix: k, Header: e.header, ValueEncoding: meta.HeaderValueEncoding}
 		fn(&nested)
 		e.err = nested.Err
 	default:
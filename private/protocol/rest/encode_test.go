@@ -3,9 +3,14 @@ package rest
 import (
 	"io"
 	"io/ioutil"
+	"math"
+	"math/big"
 	"net/http"
+	"os"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/private/protocol"
@@ -98,6 +103,26 @@ func TestSetHeaderValue(t *testing.T) {
 	}
 }
 
+func TestSetHeaderValue_Sensitive(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://endpoint/path", nil)
+	e := NewEncoder(req)
+	e.SetValue(protocol.HeaderTarget, "x-amz-key", protocol.StringValue("secret"), protocol.Metadata{Sensitive: true})
+	e.SetValue(protocol.HeaderTarget, "x-amz-other", protocol.StringValue("value"), protocol.Metadata{})
+	e.SetList(protocol.HeaderTarget, "x-amz-keys", protocol.EncodeStringList([]*string{aws.String("secret")}),
+		protocol.Metadata{Sensitive: true})
+
+	req, _, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "secret", req.Header.Get("x-amz-key"); e != a {
+		t.Errorf("expect %s header value, got %s", e, a)
+	}
+	if e, a := []string{"x-amz-key", "x-amz-keys"}, e.SensitiveHeaders(); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v sensitive headers, got %v", e, a)
+	}
+}
+
 func TestSetPathValue(t *testing.T) {
 	req, body, err := encode("GET", "/{key}", shape{
 		PathValue: aws.String("value"),
@@ -355,3 +380,298 @@ func encode(method, path string, s shape) (*http.Request, io.ReadSeeker, error)
 	s.MarshalAWS(e)
 	return e.Encode()
 }
+
+func TestSetNonFiniteFloatValues(t *testing.T) {
+	origReq, _ := http.NewRequest("GET", "https://service.amazonaws.com/path", nil)
+
+	e := NewEncoder(origReq)
+	e.SetValue(protocol.HeaderTarget, "x-amz-float", protocol.Float64Value(math.Inf(-1)), protocol.Metadata{})
+	e.SetValue(protocol.QueryTarget, "double", protocol.Float64Value(math.NaN()), protocol.Metadata{})
+	e.SetValue(protocol.QueryTarget, "float", protocol.Float64Value(math.Inf(1)), protocol.Metadata{})
+
+	req, _, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "-Infinity", req.Header.Get("x-amz-float"); e != a {
+		t.Errorf("expect %s header value, got %s", e, a)
+	}
+	query := req.URL.Query()
+	if e, a := "NaN", query.Get("double"); e != a {
+		t.Errorf("expect %s query value, got %s", e, a)
+	}
+	if e, a := "Infinity", query.Get("float"); e != a {
+		t.Errorf("expect %s query value, got %s", e, a)
+	}
+}
+
+func TestSetBigNumberValues(t *testing.T) {
+	origReq, _ := http.NewRequest("GET", "https://service.amazonaws.com/path", nil)
+
+	n, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	f, _ := protocol.ParseBigFloat("12345678901234567890.123456789")
+
+	e := NewEncoder(origReq)
+	e.SetValue(protocol.HeaderTarget, "x-amz-int", protocol.BigIntValue{V: n}, protocol.Metadata{})
+	e.SetValue(protocol.QueryTarget, "float", protocol.BigFloatValue{V: f}, protocol.Metadata{})
+
+	req, _, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "-123456789012345678901234567890", req.Header.Get("x-amz-int"); e != a {
+		t.Errorf("expect %s header value, got %s", e, a)
+	}
+	if e, a := "12345678901234567890.123456789", req.URL.Query().Get("float"); e != a {
+		t.Errorf("expect %s query value, got %s", e, a)
+	}
+}
+
+func TestSetQuotedValues(t *testing.T) {
+	origReq, _ := http.NewRequest("GET", "https://service.amazonaws.com/path", nil)
+
+	e := NewEncoder(origReq)
+	e.SetValue(protocol.HeaderTarget, "x-amz-int", protocol.QuotedInt64Value(math.MaxInt64), protocol.Metadata{})
+	e.SetValue(protocol.QueryTarget, "float", protocol.QuotedFloat64Value(0.30000000000000004), protocol.Metadata{})
+	e.SetValue(protocol.QueryTarget, "bool", protocol.QuotedBoolValue(true), protocol.Metadata{})
+
+	req, _, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "9223372036854775807", req.Header.Get("x-amz-int"); e != a {
+		t.Errorf("expect %s header value, got %s", e, a)
+	}
+	if e, a := "bool=true&float=0.30000000000000004", req.URL.RawQuery; e != a {
+		t.Errorf("expect %s query, got %s", e, a)
+	}
+}
+
+func TestSetHeaderValue_Invalid(t *testing.T) {
+	cases := map[string]func(e *Encoder){
+		"value": func(e *Encoder) {
+			e.SetValue(protocol.HeaderTarget, "x-amz-foo", protocol.StringValue("bar\r\nX-Injected: value"), protocol.Metadata{})
+		},
+		"list": func(e *Encoder) {
+			e.SetList(protocol.HeaderTarget, "x-amz-list", protocol.EncodeStringList([]*string{
+				aws.String("a"), aws.String("b\r\nX-Injected: value"),
+			}), protocol.Metadata{})
+		},
+		"map": func(e *Encoder) {
+			e.SetMap(protocol.HeadersTarget, "x-amz-meta-", protocol.EncodeStringMap(map[string]*string{
+				"foo": aws.String("bar\r\nX-Injected: value"),
+			}), protocol.Metadata{})
+		},
+		"name": func(e *Encoder) {
+			e.SetValue(protocol.HeaderTarget, "x-amz-foo\r\nX-Injected", protocol.StringValue("bar"), protocol.Metadata{})
+		},
+	}
+
+	for name, fn := range cases {
+		origReq, _ := http.NewRequest("GET", "https://service.amazonaws.com/path", nil)
+		e := NewEncoder(origReq)
+		fn(e)
+
+		_, _, err := e.Encode()
+		if err == nil {
+			t.Fatalf("%s, expect error, got none", name)
+		}
+		if strings.Contains(err.Error(), "X-Injected: value") {
+			t.Errorf("%s, expect error to not include the value, got %v", name, err)
+		}
+		if v := origReq.Header.Get("X-Injected"); len(v) != 0 {
+			t.Errorf("%s, expect no injected header, got %v", name, v)
+		}
+	}
+}
+
+func TestSetHeaderValue_Encoding(t *testing.T) {
+	origReq, _ := http.NewRequest("GET", "https://service.amazonaws.com/path", nil)
+
+	e := NewEncoder(origReq)
+	e.SetValue(protocol.HeaderTarget, "x-amz-plain", protocol.StringValue("café"), protocol.Metadata{})
+	e.SetValue(protocol.HeaderTarget, "x-amz-url", protocol.StringValue("café"),
+		protocol.Metadata{HeaderValueEncoding: protocol.HeaderValueEncodingURL})
+	e.SetMap(protocol.HeadersTarget, "x-amz-meta-", protocol.EncodeStringMap(map[string]*string{
+		"foo": aws.String("café"),
+	}), protocol.Metadata{HeaderValueEncoding: protocol.HeaderValueEncodingRFC2047})
+
+	req, _, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := map[string]string{
+		"X-Amz-Plain":    "café",
+		"X-Amz-Url":      "caf%C3%A9",
+		"X-Amz-Meta-Foo": "=?UTF-8?b?Y2Fmw6k=?=",
+	}
+	for k, v := range expect {
+		if e, a := v, req.Header.Get(k); e != a {
+			t.Errorf("expect %s %s header, got %s", e, k, a)
+		}
+	}
+}
+
+func TestSetPayloadReaderStream_ContentLength(t *testing.T) {
+	origReq, _ := http.NewRequest("PUT", "https://service.amazonaws.com/path", nil)
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("a value"))
+		pw.Close()
+	}()
+
+	e := NewEncoder(origReq)
+	e.SetStream(protocol.PayloadTarget, "payload", protocol.ReaderStream{V: pr},
+		protocol.Metadata{ContentLength: 7})
+	req, body, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no encode error, got %v", err)
+	}
+
+	if aws.IsReaderSeekable(body) {
+		t.Errorf("expect body to not be seekable")
+	}
+	if e, a := "7", req.Header.Get("Content-Length"); e != a {
+		t.Errorf("expect %s content length, got %s", e, a)
+	}
+	if e, a := "UNSIGNED-PAYLOAD", req.Header.Get("X-Amz-Content-Sha256"); e != a {
+		t.Errorf("expect %s content sha256, got %s", e, a)
+	}
+
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		t.Fatalf("expect no body read error, got %v", err)
+	}
+	if e, a := "a value", string(b); e != a {
+		t.Errorf("expect %s body, got %s", e, a)
+	}
+}
+
+func TestSetPayloadReaderStream_Spool(t *testing.T) {
+	cases := []struct {
+		Payload    string
+		MaxBuffer  int64
+		ExpectFile bool
+	}{
+		{Payload: "a value", MaxBuffer: 1024},
+		{Payload: "a value", MaxBuffer: 7},
+		{Payload: "a larger value", MaxBuffer: 7, ExpectFile: true},
+	}
+
+	for i, c := range cases {
+		origReq, _ := http.NewRequest("PUT", "https://service.amazonaws.com/path", nil)
+
+		e := NewEncoder(origReq)
+		e.MaxStreamBufferSize = c.MaxBuffer
+		e.SetStream(protocol.PayloadTarget, "payload",
+			protocol.ReaderStream{V: ioutil.NopCloser(strings.NewReader(c.Payload))},
+			protocol.Metadata{})
+		req, body, err := e.Encode()
+		if err != nil {
+			t.Fatalf("%d, expect no encode error, got %v", i, err)
+		}
+
+		if !aws.IsReaderSeekable(body) {
+			t.Errorf("%d, expect body to be seekable", i)
+		}
+		if v := req.Header.Get("X-Amz-Content-Sha256"); len(v) != 0 {
+			t.Errorf("%d, expect no content sha256, got %s", i, v)
+		}
+
+		f, isFile := body.(*SpooledFile)
+		if e, a := c.ExpectFile, isFile; e != a {
+			t.Errorf("%d, expect %t spooled file, got %t", i, e, a)
+		}
+
+		// Read twice to ensure the spooled payload can be rewound for retries.
+		for j := 0; j < 2; j++ {
+			body.Seek(0, 0)
+			b, err := ioutil.ReadAll(body)
+			if err != nil {
+				t.Fatalf("%d, expect no body read error, got %v", i, err)
+			}
+			if e, a := c.Payload, string(b); e != a {
+				t.Errorf("%d, expect %s body, got %s", i, e, a)
+			}
+		}
+
+		if isFile {
+			name := f.Name()
+			if err := f.Close(); err != nil {
+				t.Errorf("%d, expect no close error, got %v", i, err)
+			}
+			if _, err := os.Stat(name); !os.IsNotExist(err) {
+				t.Errorf("%d, expect spooled file to be removed, got %v", i, err)
+			}
+		}
+	}
+}
+
+func TestSetHeaderTimeValue(t *testing.T) {
+	v := time.Date(2017, 11, 6, 8, 49, 37, 0, time.FixedZone("", 3600))
+
+	cases := []struct {
+		Format string
+		Expect string
+	}{
+		{Format: protocol.RFC822TimeFromat, Expect: "Mon, 06 Nov 2017 07:49:37 GMT"},
+		{Format: protocol.RFC7231TimeFormat, Expect: "Mon, 06 Nov 2017 07:49:37 GMT"},
+		{Expect: "Mon, 06 Nov 2017 07:49:37 GMT"},
+		{Format: protocol.ISO8601TimeFormat, Expect: "2017-11-06T07:49:37Z"},
+		{Format: protocol.UnixTimeFormat, Expect: "1509954577"},
+	}
+
+	for i, c := range cases {
+		origReq, _ := http.NewRequest("GET", "https://service.amazonaws.com/path", nil)
+
+		e := NewEncoder(origReq)
+		e.SetValue(protocol.HeaderTarget, "Expires", protocol.TimeValue{V: v, Format: c.Format}, protocol.Metadata{})
+		req, _, err := e.Encode()
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Expect, req.Header.Get("Expires"); e != a {
+			t.Errorf("%d, expect %q header, got %q", i, e, a)
+		}
+	}
+}
+
+func TestSetQueryTimeValue(t *testing.T) {
+	v := time.Date(2017, 11, 6, 8, 49, 37, int(123*time.Millisecond), time.UTC)
+
+	cases := []struct {
+		Format string
+		Expect string
+	}{
+		{Expect: "t=2017-11-06T08%3A49%3A37Z"},
+		{Format: protocol.ISO8601TimeFormat, Expect: "t=2017-11-06T08%3A49%3A37Z"},
+		{Format: protocol.ISO8601MilliTimeFormat, Expect: "t=2017-11-06T08%3A49%3A37.123Z"},
+		{Format: protocol.UnixTimeFormat, Expect: "t=1509958177"},
+		{Format: protocol.UnixMilliTimeFormat, Expect: "t=1509958177123"},
+	}
+
+	for i, c := range cases {
+		origReq, _ := http.NewRequest("GET", "https://service.amazonaws.com/path", nil)
+
+		e := NewEncoder(origReq)
+		e.SetValue(protocol.QueryTarget, "t",
+			protocol.TimeValue{V: v, Format: protocol.ISO8601TimeFormat},
+			protocol.Metadata{TimestampFormat: c.Format})
+
+		// Encoding multiple times, e.g. when a request is rebuilt, must not
+		// escape the query again.
+		for j := 0; j < 2; j++ {
+			req, _, err := e.Encode()
+			if err != nil {
+				t.Fatalf("%d, expect no error, got %v", i, err)
+			}
+			if e, a := c.Expect, req.URL.RawQuery; e != a {
+				t.Errorf("%d, %d, expect %q query, got %q", i, j, e, a)
+			}
+		}
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/client"
@@ -61,3 +63,101 @@ func TestUnsetHeaders(t *testing.T) {
 		t.Fatal(req.Error)
 	}
 }
+
+func TestUnmarshalHeadersMap(t *testing.T) {
+	req := &request.Request{
+		Data: &struct {
+			Metadata map[string]*string `location:"headers" locationName:"x-amz-meta-"`
+		}{},
+		HTTPResponse: &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(nil)),
+			Header: http.Header{
+				"X-Amz-Meta-Foo":   []string{"bar"},
+				"x-amz-meta-CaSe":  []string{"kept"},
+				"X-Amz-Meta-Multi": []string{"a", "b"},
+				"X-Amz-Meta-Empty": []string{""},
+			},
+		},
+	}
+
+	rest.UnmarshalMeta(req)
+	if req.Error != nil {
+		t.Fatalf("expect no error, got %v", req.Error)
+	}
+
+	expect := map[string]string{"Foo": "bar", "CaSe": "kept", "Multi": "a,b", "Empty": ""}
+	actual := req.Data.(*struct {
+		Metadata map[string]*string `location:"headers" locationName:"x-amz-meta-"`
+	}).Metadata
+	if e, a := len(expect), len(actual); e != a {
+		t.Fatalf("expect %v metadata, got %v", e, a)
+	}
+	for k, v := range expect {
+		if actual[k] == nil {
+			t.Errorf("expect %v key to be set", k)
+			continue
+		}
+		if e, a := v, *actual[k]; e != a {
+			t.Errorf("expect %v value for %v, got %v", e, k, a)
+		}
+	}
+}
+
+func TestUnmarshalHeaderTimestamp(t *testing.T) {
+	type output struct {
+		Expires *time.Time `location:"header" locationName:"Expires" type:"timestamp"`
+	}
+	expect := time.Date(2017, 11, 6, 8, 49, 37, 0, time.UTC)
+
+	cases := []string{
+		"Mon, 06 Nov 2017 08:49:37 GMT",
+		"Mon, 06 Nov 2017 08:49:37 GMT+0000",
+		"mon, 06 Nov 2017 08:49:37 GMT",
+		"Monday, 06-Nov-17 08:49:37 GMT",
+		"Mon Nov  6 08:49:37 2017",
+		"1509958177",
+	}
+
+	for _, c := range cases {
+		out := &output{}
+		req := &request.Request{
+			Data: out,
+			HTTPResponse: &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBuffer(nil)),
+				Header:     http.Header{"Expires": []string{c}},
+			},
+		}
+
+		rest.UnmarshalMeta(req)
+		if req.Error != nil {
+			t.Errorf("%q, expect no error, got %v", c, req.Error)
+			continue
+		}
+		if out.Expires == nil || !expect.Equal(*out.Expires) {
+			t.Errorf("%q, expect %v time, got %v", c, expect, out.Expires)
+		}
+	}
+}
+
+func TestUnmarshalHeaderTimestamp_Invalid(t *testing.T) {
+	req := &request.Request{
+		Data: &struct {
+			Expires *time.Time `location:"header" locationName:"Expires" type:"timestamp"`
+		}{},
+		HTTPResponse: &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(nil)),
+			Header:     http.Header{"Expires": []string{"not a date"}},
+		},
+	}
+
+	rest.UnmarshalMeta(req)
+	if req.Error == nil {
+		t.Fatalf("expect error")
+	}
+	if e, a := "Expires header", req.Error.Error(); !strings.Contains(a, e) {
+		t.Errorf("expect %q in error, got %v", e, a)
+	}
+}
@@ -0,0 +1,101 @@
+package rest
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// DefaultStreamBufferSize is the default maximum number of bytes of a
+// non-seekable stream payload, without a known length, that will be buffered
+// in memory before the stream is spooled to a temporary file.
+const DefaultStreamBufferSize = 5 * 1024 * 1024
+
+// unsignedPayload is the X-Amz-Content-Sha256 value instructing the signer
+// not to include a hash of the payload in the request's signature.
+const unsignedPayload = "UNSIGNED-PAYLOAD"
+
+// readerStreamPayload returns the payload for a stream which might not be
+// seekable.
+//
+// If the reader is seekable it will be used directly. If the length of the
+// stream is known the reader will be passed through without buffering, and
+// the payload will not be signed. Requests with a passed through payload
+// cannot be retried. Otherwise the stream will be buffered in memory up to
+// the encoder's MaxStreamBufferSize, then spooled to a temporary file.
+func (e *Encoder) readerStreamPayload(r io.Reader, contentLength int64) (io.ReadSeeker, error) {
+	if rs, ok := r.(io.ReadSeeker); ok && aws.IsReaderSeekable(rs) {
+		return rs, nil
+	}
+
+	if contentLength > 0 {
+		e.header.Set("Content-Length", strconv.FormatInt(contentLength, 10))
+		e.header.Set("X-Amz-Content-Sha256", unsignedPayload)
+		return aws.ReadSeekCloser(r), nil
+	}
+
+	return spoolStream(r, e.MaxStreamBufferSize)
+}
+
+// spoolStream reads the stream into memory up to maxBuf bytes. If the
+// stream is larger than maxBuf the stream will be copied to a temporary file
+// instead. The temporary file is removed when the returned SpooledFile is
+// closed.
+func spoolStream(r io.Reader, maxBuf int64) (io.ReadSeeker, error) {
+	var buf bytes.Buffer
+	n, err := io.Copy(&buf, io.LimitReader(r, maxBuf+1))
+	if err != nil {
+		return nil, err
+	}
+	if n <= maxBuf {
+		return bytes.NewReader(buf.Bytes()), nil
+	}
+
+	f, err := ioutil.TempFile("", "aws-sdk-go-stream")
+	if err != nil {
+		return nil, err
+	}
+	spooled := &SpooledFile{File: f}
+
+	if _, err = io.Copy(f, io.MultiReader(&buf, r)); err == nil {
+		_, err = f.Seek(0, 0)
+	}
+	if err != nil {
+		spooled.Close()
+		return nil, err
+	}
+
+	return spooled, nil
+}
+
+// A SpooledFile is a temporary file containing a non-seekable stream payload
+// spooled by the Encoder. The file is removed when closed.
+type SpooledFile struct {
+	*os.File
+}
+
+// Close closes and removes the temporary file.
+func (f *SpooledFile) Close() error {
+	err := f.File.Close()
+	if rmErr := os.Remove(f.Name()); err == nil {
+		err = rmErr
+	}
+	return err
+}
+
+// SpooledPayload returns the SpooledFile backing the payload returned by the
+// Encoder, or nil if the payload was not spooled to a temporary file.
+func SpooledPayload(payload io.Reader) *SpooledFile {
+	switch p := payload.(type) {
+	case *SpooledFile:
+		return p
+	case *awsChunkedReadSeeker:
+		return SpooledPayload(p.body)
+	default:
+		return nil
+	}
+}
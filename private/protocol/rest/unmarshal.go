@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol"
 )
 
 // UnmarshalHandler is a named request handler for unmarshaling rest protocol requests
@@ -73,7 +74,16 @@ func unmarshalBody(r *request.Request, v reflect.Value) {
 					default:
 						switch payload.Type().String() {
 						case "io.ReadCloser":
-							payload.Set(reflect.ValueOf(r.HTTPResponse.Body))
+							if bw := r.ResponseBodyWriter; bw != nil {
+								if err := writeResponseBody(r, bw); err != nil {
+									r.Error = awserr.New("SerializationError",
+										"failed to write response body", err)
+									return
+								}
+								payload.Set(reflect.ValueOf(ioutil.NopCloser(bytes.NewReader(nil))))
+							} else {
+								payload.Set(reflect.ValueOf(r.HTTPResponse.Body))
+							}
 						case "io.ReadSeeker":
 							b, err := ioutil.ReadAll(r.HTTPResponse.Body)
 							if err != nil {
@@ -115,7 +125,8 @@ func unmarshalLocationElements(r *request.Request, v reflect.Value) {
 			case "header":
 				err := unmarshalHeader(m, r.HTTPResponse.Header.Get(name), field.Tag)
 				if err != nil {
-					r.Error = awserr.New("SerializationError", "failed to decode REST response", err)
+					r.Error = awserr.New("SerializationError",
+						fmt.Sprintf("failed to decode REST response %s header", name), err)
 					break
 				}
 			case "headers":
@@ -148,12 +159,11 @@ func unmarshalStatusCode(v reflect.Value, statusCode int) {
 func unmarshalHeaderMap(r reflect.Value, headers http.Header, prefix string) error {
 	switch r.Interface().(type) {
 	case map[string]*string: // we only support string map value types
+		d := protocol.HeaderMapDecoder{Prefix: prefix, Header: headers}
 		out := map[string]*string{}
-		for k, v := range headers {
-			k = http.CanonicalHeaderKey(k)
-			if strings.HasPrefix(strings.ToLower(k), strings.ToLower(prefix)) {
-				out[k[len(prefix):]] = &v[0]
-			}
+		for k, v := range d.Values() {
+			v := v
+			out[k] = &v
 		}
 		r.Set(reflect.ValueOf(out))
 	}
@@ -192,13 +202,13 @@ func unmarshalHeader(v reflect.Value, header string, tag reflect.StructTag) erro
 		}
 		v.Set(reflect.ValueOf(&i))
 	case *float64:
-		f, err := strconv.ParseFloat(header, 64)
+		f, err := protocol.ParseFloat64(header)
 		if err != nil {
 			return err
 		}
 		v.Set(reflect.ValueOf(&f))
 	case *time.Time:
-		t, err := time.Parse(RFC822, header)
+		t, err := protocol.ParseHTTPDate(header)
 		if err != nil {
 			return err
 		}
@@ -2,10 +2,13 @@ package restjson
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/private/protocol"
 	"github.com/aws/aws-sdk-go/private/protocol/json"
 	"github.com/aws/aws-sdk-go/private/protocol/rest"
@@ -20,8 +23,8 @@ type Encoder struct {
 	reqEncoder  *rest.Encoder
 	bodyEncoder *json.Encoder
 
-	buf *bytes.Buffer
-	err error
+	buf  *bytes.Buffer
+	errs []error
 }
 
 // NewEncoder creates a new encoder for encoding the AWS RESTJSON protocol.
@@ -38,24 +41,30 @@ func NewEncoder(req *http.Request) *Encoder {
 }
 
 // Encode returns the encoded request, and body payload. If no payload body was
-// set nil will be returned.  If an error occurred while encoding the API an
-// error will be returned.
+// set nil will be returned.
+//
+// If an error occurred while encoding the API an *EncodeError will be
+// returned for the first error. All errors encountered are available from
+// Errors.
 func (e *Encoder) Encode() (*http.Request, io.ReadSeeker, error) {
 	req, payloadBody, err := e.reqEncoder.Encode()
 	if err != nil {
-		return nil, nil, err
+		e.addError("Encode", "", "", err)
 	}
 
 	jsonBody, err := e.bodyEncoder.Encode()
 	if err != nil {
-		return nil, nil, err
+		e.addError("Encode", "", "", err)
 	}
 
-	havePayload := payloadBody != nil
-	haveJSON := jsonBody != nil
+	if payloadBody != nil && jsonBody != nil {
+		e.addError("Encode", "", "", ErrBodyAndPayload)
+	}
 
-	if havePayload == haveJSON && haveJSON {
-		return nil, nil, fmt.Errorf("unexpected JSON body and request payload for AWSMarshaler")
+	if len(e.errs) != 0 {
+		first := *e.errs[0].(*EncodeError)
+		first.NumOthers = len(e.errs) - 1
+		return nil, nil, &first
 	}
 
 	body := payloadBody
@@ -63,7 +72,35 @@ func (e *Encoder) Encode() (*http.Request, io.ReadSeeker, error) {
 		body = jsonBody
 	}
 
-	return req, body, err
+	return req, body, nil
+}
+
+// Errors returns all errors encountered while encoding the API, in the order
+// they occurred. Errors from the rest and JSON encoders are only available
+// once Encode is called.
+func (e *Encoder) Errors() []error {
+	return e.errs
+}
+
+// SensitivePaths returns the paths of the JSON body members encoded that were
+// marked as sensitive.
+func (e *Encoder) SensitivePaths() []string {
+	return e.bodyEncoder.SensitivePaths()
+}
+
+// SensitiveHeaders returns the names of the headers encoded of the members
+// that were marked as sensitive.
+func (e *Encoder) SensitiveHeaders() []string {
+	return e.reqEncoder.SensitiveHeaders()
+}
+
+func (e *Encoder) addError(op, t, k string, err error) {
+	e.errs = append(e.errs, &EncodeError{
+		Op:     op,
+		Target: t,
+		Key:    k,
+		Err:    err,
+	})
 }
 
 // SetValue will set a value to the header, path, query, or body.
@@ -71,10 +108,6 @@ func (e *Encoder) Encode() (*http.Request, io.ReadSeeker, error) {
 // If the request's method is GET all BodyTarget values will be written to
 // the query string.
 func (e *Encoder) SetValue(t protocol.Target, k string, v protocol.ValueMarshaler, meta protocol.Metadata) {
-	if e.err != nil {
-		return
-	}
-
 	switch t {
 	case protocol.PathTarget:
 		fallthrough
@@ -91,72 +124,132 @@ func (e *Encoder) SetValue(t protocol.Target, k string, v protocol.ValueMarshale
 			e.bodyEncoder.SetValue(t, k, v, meta)
 		}
 	default:
-		e.err = fmt.Errorf("unknown SetValue restjson encode target, %s, %s", t, k)
+		e.addError("SetValue", fmt.Sprint(t), k, ErrInvalidTarget)
 	}
 }
 
 // SetStream will set the stream to the payload of the request.
 func (e *Encoder) SetStream(t protocol.Target, k string, v protocol.StreamMarshaler, meta protocol.Metadata) {
-	if e.err != nil {
-		return
-	}
-
 	switch t {
 	case protocol.PayloadTarget:
 		e.reqEncoder.SetStream(t, k, v, meta)
 	default:
-		e.err = fmt.Errorf("invalid target %s, for SetStream, must be PayloadTarget", t)
+		e.addError("SetStream", fmt.Sprint(t), k, ErrInvalidTarget)
 	}
 }
 
 // SetList will set the nested list values to the header, query, or body.
+//
+// Body lists without elements are omitted unless meta.SerializeEmpty is set.
+// If the request's method is GET BodyTarget lists will be written to the
+// query string instead, and a list without elements adds no query parameters,
+// regardless of meta.SerializeEmpty.
 func (e *Encoder) SetList(t protocol.Target, k string, fn func(le protocol.ListEncoder), meta protocol.Metadata) {
-	if e.err != nil {
-		return
-	}
-
 	switch t {
 	case protocol.HeaderTarget:
 		fallthrough
 	case protocol.QueryTarget:
 		e.reqEncoder.SetList(t, k, fn, meta)
 	case protocol.BodyTarget:
-		e.bodyEncoder.SetList(t, k, fn, meta)
+		if e.method == "GET" {
+			e.reqEncoder.SetList(t, k, fn, meta)
+		} else {
+			e.bodyEncoder.SetList(t, k, fn, meta)
+		}
 	default:
-		e.err = fmt.Errorf("unknown SetList restjson encode target, %s, %s", t, k)
+		e.addError("SetList", fmt.Sprint(t), k, ErrInvalidTarget)
 	}
 }
 
 // SetMap will set the nested map values to the header, query, or body.
+//
+// Body maps without entries are omitted unless meta.SerializeEmpty is set.
+// If the request's method is GET BodyTarget maps will be written to the query
+// string instead, and a map without entries adds no query parameters,
+// regardless of meta.SerializeEmpty.
 func (e *Encoder) SetMap(t protocol.Target, k string, fn func(me protocol.MapEncoder), meta protocol.Metadata) {
-	if e.err != nil {
-		return
-	}
-
 	switch t {
 	case protocol.QueryTarget:
 		fallthrough
 	case protocol.HeadersTarget:
 		e.reqEncoder.SetMap(t, k, fn, meta)
 	case protocol.BodyTarget:
-		e.bodyEncoder.SetMap(t, k, fn, meta)
+		if e.method == "GET" {
+			e.reqEncoder.SetMap(t, k, fn, meta)
+		} else {
+			e.bodyEncoder.SetMap(t, k, fn, meta)
+		}
 	default:
-		e.err = fmt.Errorf("unknown SetMap restjson encode target, %s, %s", t, k)
+		e.addError("SetMap", fmt.Sprint(t), k, ErrInvalidTarget)
 	}
 }
 
 // SetFields will set the nested type's fields to the body.
 func (e *Encoder) SetFields(t protocol.Target, k string, m protocol.FieldMarshaler, meta protocol.Metadata) {
-	if e.err != nil {
-		return
-	}
-
 	switch t {
 	case protocol.PayloadTarget:
 		fallthrough
 	case protocol.BodyTarget:
 		e.bodyEncoder.SetFields(t, k, m, meta)
 	default:
-		e.err = fmt.Errorf("unknown SetMarshaler restjson encode target, %s, %s", t, k)
+		e.addError("SetFields", fmt.Sprint(t), k, ErrInvalidTarget)
 	}
 }
+
+var (
+	// ErrInvalidTarget is the EncodeError's underlying error when a field is
+	// set to a target that is not supported for the field's type.
+	ErrInvalidTarget = errors.New("invalid encode target")
+
+	// ErrBodyAndPayload is the EncodeError's underlying error when both JSON
+	// body fields and a request payload were set.
+	ErrBodyAndPayload = errors.New("unexpected JSON body and request payload")
+)
+
+// An EncodeError is an error that occurred while encoding an API's fields
+// with the RESTJSON Encoder. Satisfies the awserr.Error interface, with the
+// request.ErrCodeSerialization error code.
+type EncodeError struct {
+	// Op is the Encoder method the error occurred in, e.g. "SetValue".
+	Op string
+
+	// Target and Key are the target and name of the field being encoded.
+	// Empty for errors that are not for a specific field, e.g. errors of
+	// the underlying rest and JSON encoders returned by Encode.
+	Target string
+	Key    string
+
+	// Err is the underlying error, e.g. ErrInvalidTarget.
+	Err error
+
+	// NumOthers is the number of errors that occurred after this one. Set
+	// on the error returned by Encode.
+	NumOthers int
+}
+
+// Code returns the error code, request.ErrCodeSerialization.
+func (e *EncodeError) Code() string {
+	return request.ErrCodeSerialization
+}
+
+// Message returns the error message describing where the error occurred.
+func (e *EncodeError) Message() string {
+	msg := "restjson encoder " + e.Op + " failed"
+	if len(e.Target) != 0 || len(e.Key) != 0 {
+		msg += fmt.Sprintf(", %s, %s", e.Target, e.Key)
+	}
+	if e.NumOthers > 0 {
+		msg += fmt.Sprintf(", and %d other errors", e.NumOthers)
+	}
+	return msg
+}
+
+// OrigErr returns the underlying error.
+func (e *EncodeError) OrigErr() error {
+	return e.Err
+}
+
+// Error satisfies the error interface.
+func (e *EncodeError) Error() string {
+	return awserr.SprintError(e.Code(), e.Message(), "", e.Err)
+}
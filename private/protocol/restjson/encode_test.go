@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/private/protocol"
 )
 
@@ -109,3 +111,120 @@ func encode(method, path string, s shape) (*http.Request, io.ReadSeeker, error)
 	s.MarshalFields(e)
 	return e.Encode()
 }
+
+func TestEncodeEmptyList(t *testing.T) {
+	cases := []struct {
+		Method      string
+		Meta        protocol.Metadata
+		ExpectBody  string
+		ExpectQuery string
+	}{
+		{Method: "PUT"},
+		{Method: "PUT", Meta: protocol.Metadata{SerializeEmpty: true}, ExpectBody: `{"list":[],"map":{}}`},
+		{Method: "GET"},
+		{Method: "GET", Meta: protocol.Metadata{SerializeEmpty: true}},
+	}
+
+	for i, c := range cases {
+		origReq, _ := http.NewRequest(c.Method, "https://service.amazonaws.com/path", nil)
+
+		e := NewEncoder(origReq)
+		e.SetList(protocol.BodyTarget, "list", func(le protocol.ListEncoder) {}, c.Meta)
+		e.SetMap(protocol.BodyTarget, "map", func(me protocol.MapEncoder) {}, c.Meta)
+		req, reader, err := e.Encode()
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+
+		if e, a := c.ExpectQuery, req.URL.RawQuery; e != a {
+			t.Errorf("%d, expect %q query, got %q", i, e, a)
+		}
+		if len(c.ExpectBody) == 0 {
+			if reader != nil {
+				t.Errorf("%d, expect no body", i)
+			}
+			continue
+		}
+
+		b, err := ioutil.ReadAll(reader)
+		if err != nil {
+			t.Fatalf("%d, expect no read error, %v", i, err)
+		}
+		if e, a := c.ExpectBody, string(b); e != a {
+			t.Errorf("%d, expect %s body, got %s", i, e, a)
+		}
+	}
+}
+
+func TestEncodeGETList(t *testing.T) {
+	origReq, _ := http.NewRequest("GET", "https://service.amazonaws.com/path", nil)
+
+	e := NewEncoder(origReq)
+	e.SetList(protocol.BodyTarget, "list", func(le protocol.ListEncoder) {
+		le.ListAddValue(protocol.StringValue("a"))
+		le.ListAddValue(protocol.StringValue("b"))
+	}, protocol.Metadata{})
+	req, reader, err := e.Encode()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if reader != nil {
+		t.Errorf("expect no body")
+	}
+	if e, a := "list=a&list=b", req.URL.RawQuery; e != a {
+		t.Errorf("expect %q query, got %q", e, a)
+	}
+}
+
+func TestEncodeError(t *testing.T) {
+	origReq, _ := http.NewRequest("PUT", "https://service.amazonaws.com/path", nil)
+
+	e := NewEncoder(origReq)
+	e.SetStream(protocol.BodyTarget, "stream", protocol.ReadSeekerStream{V: strings.NewReader("abc")}, protocol.Metadata{})
+	e.SetList(protocol.PathTarget, "list", func(le protocol.ListEncoder) {}, protocol.Metadata{})
+	e.SetValue(protocol.BodyTarget, "value", protocol.StringValue("abc"), protocol.Metadata{})
+	e.SetStream(protocol.PayloadTarget, "payload", protocol.ReadSeekerStream{V: strings.NewReader("abc")}, protocol.Metadata{})
+
+	_, _, err := e.Encode()
+	if err == nil {
+		t.Fatalf("expect error")
+	}
+
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T", err)
+	}
+	if e, a := request.ErrCodeSerialization, aerr.Code(); e != a {
+		t.Errorf("expect %s code, got %s", e, a)
+	}
+
+	encErr, ok := err.(*EncodeError)
+	if !ok {
+		t.Fatalf("expect *EncodeError, got %T", err)
+	}
+	expect := EncodeError{
+		Op: "SetStream", Target: "Body", Key: "stream", Err: ErrInvalidTarget, NumOthers: 2,
+	}
+	if e, a := expect, *encErr; e != a {
+		t.Errorf("expect %v error, got %v", e, a)
+	}
+	if e, a := "restjson encoder SetStream failed, Body, stream, and 2 other errors", encErr.Message(); e != a {
+		t.Errorf("expect %q message, got %q", e, a)
+	}
+
+	errs := e.Errors()
+	if e, a := 3, len(errs); e != a {
+		t.Fatalf("expect %d errors, got %d", e, a)
+	}
+	expectErrs := []EncodeError{
+		{Op: "SetStream", Target: "Body", Key: "stream", Err: ErrInvalidTarget},
+		{Op: "SetList", Target: "Path", Key: "list", Err: ErrInvalidTarget},
+		{Op: "Encode", Err: ErrBodyAndPayload},
+	}
+	for i, expect := range expectErrs {
+		if e, a := expect, *errs[i].(*EncodeError); e != a {
+			t.Errorf("%d, expect %v error, got %v", i, e, a)
+		}
+	}
+}
@@ -44,8 +44,17 @@ func Build(r *request.Request) {
 			r.Error = awserr.New(request.ErrCodeSerialization, "failed to encode rest JSON request", err)
 			return
 		}
+		r.SensitiveHeaders = e.SensitiveHeaders()
 		if body != nil {
 			r.SetReaderBody(body)
+			r.SensitiveBodyPaths = e.SensitivePaths()
+			if f := rest.SpooledPayload(body); f != nil {
+				// Payloads spooled by the encoder need to be cleaned up
+				// once the request is no longer in use.
+				r.Handlers.Complete.PushBack(func(*request.Request) {
+					f.Close()
+				})
+			}
 		}
 		return
 	}
@@ -73,41 +82,75 @@ func UnmarshalMeta(r *request.Request) {
 }
 
 // UnmarshalError unmarshals a response error for the REST JSON protocol.
+//
+// The error code is taken from the X-Amzn-Errortype header if set, falling
+// back to the body's "code", then "__type" members. The code is sanitized of
+// any namespace prefix ending with "#", and any suffix starting with ":".
 func UnmarshalError(r *request.Request) {
 	defer r.HTTPResponse.Body.Close()
-	code := r.HTTPResponse.Header.Get("X-Amzn-Errortype")
+
 	bodyBytes, err := ioutil.ReadAll(r.HTTPResponse.Body)
 	if err != nil {
-		r.Error = awserr.New("SerializationError", "failed reading REST JSON error response", err)
-		return
-	}
-	if len(bodyBytes) == 0 {
 		r.Error = awserr.NewRequestFailure(
-			awserr.New("SerializationError", r.HTTPResponse.Status, nil),
+			awserr.New("SerializationError", "failed reading REST JSON error response", err),
 			r.HTTPResponse.StatusCode,
-			"",
+			r.RequestID,
 		)
 		return
 	}
+
 	var jsonErr jsonErrorResponse
-	if err := json.Unmarshal(bodyBytes, &jsonErr); err != nil {
-		r.Error = awserr.New("SerializationError", "failed decoding REST JSON error response", err)
+	if len(bodyBytes) != 0 {
+		if err := json.Unmarshal(bodyBytes, &jsonErr); err != nil {
+			r.Error = awserr.NewRequestFailure(
+				awserr.New("SerializationError", "failed decoding REST JSON error response", err),
+				r.HTTPResponse.StatusCode,
+				r.RequestID,
+			)
+			return
+		}
+	}
+
+	code := sanitizeErrorCode(r.HTTPResponse.Header.Get("X-Amzn-Errortype"))
+	if len(code) == 0 {
+		code = sanitizeErrorCode(jsonErr.Code)
+	}
+	if len(code) == 0 {
+		code = sanitizeErrorCode(jsonErr.Type)
+	}
+	if len(code) == 0 {
+		r.Error = awserr.NewRequestFailure(
+			awserr.New("SerializationError", r.HTTPResponse.Status, nil),
+			r.HTTPResponse.StatusCode,
+			r.RequestID,
+		)
 		return
 	}
 
-	if code == "" {
-		code = jsonErr.Code
+	msg := jsonErr.Message
+	if len(msg) == 0 {
+		msg = jsonErr.MessageUpper
 	}
 
-	code = strings.SplitN(code, ":", 2)[0]
 	r.Error = awserr.NewRequestFailure(
-		awserr.New(code, jsonErr.Message, nil),
+		awserr.New(code, msg, nil),
 		r.HTTPResponse.StatusCode,
 		r.RequestID,
 	)
 }
 
+// sanitizeErrorCode strips the namespace prefix, and URI suffix from an
+// error code, e.g. "aws.service#NotFoundException:http://internal/" becomes
+// "NotFoundException".
+func sanitizeErrorCode(code string) string {
+	code = strings.SplitN(code, ":", 2)[0]
+	parts := strings.SplitN(code, "#", 2)
+	return strings.TrimSpace(parts[len(parts)-1])
+}
+
 type jsonErrorResponse struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+	Code         string `json:"code"`
+	Type         string `json:"__type"`
+	Message      string `json:"message"`
+	MessageUpper string `json:"Message"`
 }
@@ -0,0 +1,100 @@
+package restjson_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol/restjson"
+)
+
+func TestUnmarshalError(t *testing.T) {
+	cases := []struct {
+		Status        int
+		Header        http.Header
+		Body          string
+		Code, Msg     string
+		ExpectOrigErr bool
+	}{
+		{
+			Status: 400,
+			Header: http.Header{"X-Amzn-Errortype": []string{"aws.protocoltests#FooError:http://internal.amazon.com/coral/aws.protocoltests/"}},
+			Body:   `{"code":"BodyCode","__type":"BodyType","message":"foo message"}`,
+			Code:   "FooError", Msg: "foo message",
+		},
+		{
+			Status: 400,
+			Body:   `{"code":"BodyCode","__type":"BodyType","message":"foo message"}`,
+			Code:   "BodyCode", Msg: "foo message",
+		},
+		{
+			Status: 400,
+			Body:   `{"__type":"aws.protocoltests#BodyType","Message":"upper message"}`,
+			Code:   "BodyType", Msg: "upper message",
+		},
+		{
+			Status: 404,
+			Body:   `{"code":"ResourceNotFoundException:http://internal","message":"not found"}`,
+			Code:   "ResourceNotFoundException", Msg: "not found",
+		},
+		{
+			Status: 404,
+			Header: http.Header{"X-Amzn-Errortype": []string{"ResourceNotFoundException:"}},
+			Code:   "ResourceNotFoundException",
+		},
+		{
+			Status: 404,
+			Code:   "SerializationError", Msg: "Not Found",
+		},
+		{
+			Status: 500,
+			Body:   `{"code":"InternalFailure","mess`,
+			Code:   "SerializationError", Msg: "failed decoding REST JSON error response",
+			ExpectOrigErr: true,
+		},
+	}
+
+	for i, c := range cases {
+		header := c.Header
+		if header == nil {
+			header = http.Header{}
+		}
+		r := &request.Request{
+			HTTPResponse: &http.Response{
+				StatusCode: c.Status,
+				Status:     http.StatusText(c.Status),
+				Header:     header,
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(c.Body))),
+			},
+			RequestID: "abc123",
+		}
+
+		restjson.UnmarshalError(r)
+		if r.Error == nil {
+			t.Fatalf("%d, expect error, got none", i)
+		}
+
+		aerr, ok := r.Error.(awserr.RequestFailure)
+		if !ok {
+			t.Fatalf("%d, expect RequestFailure, got %T, %v", i, r.Error, r.Error)
+		}
+		if e, a := c.Code, aerr.Code(); e != a {
+			t.Errorf("%d, expect %v code, got %v", i, e, a)
+		}
+		if e, a := c.Msg, aerr.Message(); e != a {
+			t.Errorf("%d, expect %v message, got %v", i, e, a)
+		}
+		if e, a := "abc123", aerr.RequestID(); e != a {
+			t.Errorf("%d, expect %v request id, got %v", i, e, a)
+		}
+		if e, a := c.Status, aerr.StatusCode(); e != a {
+			t.Errorf("%d, expect %v status code, got %v", i, e, a)
+		}
+		if e, a := c.ExpectOrigErr, aerr.OrigErr() != nil; e != a {
+			t.Errorf("%d, expect %v orig err, got %v", i, e, aerr.OrigErr())
+		}
+	}
+}
@@ -66,6 +66,18 @@ func (e *Encoder) Encode() (*http.Request, io.ReadSeeker, error) {
 	return req, body, err
 }
 
+// SensitivePaths returns the paths of the XML body members encoded that were
+// marked as sensitive.
+func (e *Encoder) SensitivePaths() []string {
+	return e.bodyEncoder.SensitivePaths()
+}
+
+// SensitiveHeaders returns the names of the headers encoded of the members
+// that were marked as sensitive.
+func (e *Encoder) SensitiveHeaders() []string {
+	return e.reqEncoder.SensitiveHeaders()
+}
+
 // SetValue will set a value to the header, path, query, or body.
 //
 // If the request's method is GET all BodyTarget values will be written to
@@ -44,8 +44,17 @@ func Build(r *request.Request) {
 			r.Error = awserr.New(request.ErrCodeSerialization, "failed to encode rest XML request", err)
 			return
 		}
+		r.SensitiveHeaders = e.SensitiveHeaders()
 		if body != nil {
 			r.SetReaderBody(body)
+			r.SensitiveBodyPaths = e.SensitivePaths()
+			if f := rest.SpooledPayload(body); f != nil {
+				// Payloads spooled by the encoder need to be cleaned up
+				// once the request is no longer in use.
+				r.Handlers.Complete.PushBack(func(*request.Request) {
+					f.Close()
+				})
+			}
 		}
 		return
 	}
@@ -5,6 +5,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/private/protocol"
 )
@@ -16,6 +17,11 @@ type Encoder struct {
 	encodedBuf *bytes.Buffer
 	fieldBuf   protocol.FieldBuffer
 	err        error
+
+	// path is the stack of element names currently open, and sensitive
+	// collects the paths of members marked as sensitive.
+	path      []string
+	sensitive []string
 }
 
 // NewEncoder creates a new encoder for encoding AWS XML protocol. Only encodes
@@ -47,6 +53,12 @@ func (e *Encoder) Encode() (io.ReadSeeker, error) {
 	return bytes.NewReader(e.encodedBuf.Bytes()), e.err
 }
 
+// SensitivePaths returns the element paths of the members encoded that were
+// marked as sensitive, joined with "/", e.g. "Root/Credentials/Password".
+func (e *Encoder) SensitivePaths() []string {
+	return e.sensitive
+}
+
 // SetValue sets an individual value to the XML body.
 func (e *Encoder) SetValue(t protocol.Target, k string, v protocol.ValueMarshaler, meta protocol.Metadata) {
 	if e.err != nil {
@@ -56,6 +68,7 @@ func (e *Encoder) SetValue(t protocol.Target, k string, v protocol.ValueMarshale
 		e.err = fmt.Errorf(" invalid target %s for xml encoder SetValue, %s", t, k)
 		return
 	}
+	e.markSensitive(k, meta)
 
 	e.err = addValueToken(e.encoder, &e.fieldBuf, k, v, meta)
 }
@@ -74,6 +87,7 @@ func (e *Encoder) SetList(t protocol.Target, k string, fn func(le protocol.ListE
 		e.err = fmt.Errorf(" invalid target %s for xml encoder SetValue, %s", t, k)
 		return
 	}
+	e.markSensitive(k, meta)
 
 	le := ListEncoder{Base: e,
 		Flatten:  meta.Flatten,
@@ -93,13 +107,13 @@ func (e *Encoder) SetList(t protocol.Target, k string, fn func(le protocol.ListE
 		if e.err != nil {
 			return
 		}
-		e.encoder.EncodeToken(tok)
+		e.startElem(tok)
 	}
 
 	fn(&le)
 
 	if !le.Flatten {
-		e.encoder.EncodeToken(xml.EndElement{Name: tok.Name})
+		e.endElem(tok)
 	}
 }
 
@@ -112,6 +126,7 @@ func (e *Encoder) SetMap(t protocol.Target, k string, fn func(me protocol.MapEnc
 		e.err = fmt.Errorf(" invalid target %s for xml encoder SetValue, %s", t, k)
 		return
 	}
+	e.markSensitive(k, meta)
 
 	me := MapEncoder{Base: e,
 		Flatten:   meta.Flatten,
@@ -125,11 +140,11 @@ func (e *Encoder) SetMap(t protocol.Target, k string, fn func(me protocol.MapEnc
 		return
 	}
 
-	e.encoder.EncodeToken(tok)
+	e.startElem(tok)
 
 	fn(&me)
 
-	e.encoder.EncodeToken(xml.EndElement{Name: tok.Name})
+	e.endElem(tok)
 }
 
 // SetFields sets the nested fields to the XML body.
@@ -141,6 +156,7 @@ func (e *Encoder) SetFields(t protocol.Target, k string, m protocol.FieldMarshal
 		e.err = fmt.Errorf(" invalid target %s for xml encoder SetFields, %s", t, k)
 		return
 	}
+	e.markSensitive(k, meta)
 
 	tok, err := xmlStartElem(k, meta)
 	if err != nil {
@@ -148,9 +164,9 @@ func (e *Encoder) SetFields(t protocol.Target, k string, m protocol.FieldMarshal
 		return
 	}
 
-	e.encoder.EncodeToken(tok)
+	e.startElem(tok)
 	m.MarshalFields(e)
-	e.encoder.EncodeToken(xml.EndElement{Name: tok.Name})
+	e.endElem(tok)
 }
 
 // A ListEncoder encodes elements within a list for the XML encoder.
@@ -192,9 +208,9 @@ func (e *ListEncoder) ListAddFields(m protocol.FieldMarshaler) {
 		return
 	}
 
-	e.Base.encoder.EncodeToken(tok)
+	e.Base.startElem(tok)
 	m.MarshalFields(e.Base)
-	e.Base.encoder.EncodeToken(xml.EndElement{Name: tok.Name})
+	e.Base.endElem(tok)
 }
 
 // A MapEncoder encodes key values pair map values for the XML encoder.
@@ -218,7 +234,7 @@ func (e *MapEncoder) MapSetValue(k string, v protocol.ValueMarshaler) {
 		if e.err != nil {
 			return
 		}
-		e.Base.encoder.EncodeToken(tok)
+		e.Base.startElem(tok)
 	}
 
 	keyName, valueName := e.KeyName, e.ValueName
@@ -240,7 +256,7 @@ func (e *MapEncoder) MapSetValue(k string, v protocol.ValueMarshaler) {
 	}
 
 	if !e.Flatten {
-		e.Base.encoder.EncodeToken(xml.EndElement{Name: tok.Name})
+		e.Base.endElem(tok)
 	}
 }
 
@@ -266,7 +282,7 @@ func (e *MapEncoder) MapSetFields(k string, m protocol.FieldMarshaler) {
 		if e.err != nil {
 			return
 		}
-		e.Base.encoder.EncodeToken(tok)
+		e.Base.startElem(tok)
 	}
 
 	keyName, valueName := e.KeyName, e.ValueName
@@ -287,17 +303,39 @@ func (e *MapEncoder) MapSetFields(k string, m protocol.FieldMarshaler) {
 		e.err = err
 		return
 	}
-	e.Base.encoder.EncodeToken(valTok)
+	e.Base.startElem(valTok)
 
 	m.MarshalFields(e.Base)
 
-	e.Base.encoder.EncodeToken(xml.EndElement{Name: valTok.Name})
+	e.Base.endElem(valTok)
 
 	if !e.Flatten {
-		e.Base.encoder.EncodeToken(xml.EndElement{Name: tok.Name})
+		e.Base.endElem(tok)
+	}
+}
+
+func (e *Encoder) startElem(tok xml.StartElement) {
+	e.encoder.EncodeToken(tok)
+	e.path = append(e.path, tok.Name.Local)
+}
+
+func (e *Encoder) endElem(tok xml.StartElement) {
+	e.encoder.EncodeToken(xml.EndElement{Name: tok.Name})
+	e.path = e.path[:len(e.path)-1]
+}
+
+func (e *Encoder) markSensitive(k string, meta protocol.Metadata) {
+	if meta.Sensitive {
+		e.sensitive = append(e.sensitive, joinPath(e.path, k))
 	}
 }
 
+// joinPath returns the element path of the member k nested within the
+// elements of path.
+func joinPath(path []string, k string) string {
+	return strings.Join(append(path[:len(path):len(path)], k), "/")
+}
+
 func addValueToken(e *xml.Encoder, fieldBuf *protocol.FieldBuffer, k string, v protocol.ValueMarshaler, meta protocol.Metadata) error {
 	b, err := fieldBuf.GetValue(v)
 	if err != nil {
@@ -0,0 +1,342 @@
+package xmlutil
+
+import (
+	"encoding/xml"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// decodeXML deserializes the XML tokens read from the decoder directly into
+// the container v. Only the value being decoded is held in memory, instead of
+// the whole document, so very large responses are decoded with far fewer
+// allocations than building an XMLNode tree first.
+//
+// Maps, and structures with multiple fields sharing an element name, are read
+// into an XMLNode tree and parsed the same as unmarshalXMLTree, because their
+// values cannot be decoded until the whole element has been read. When a
+// wrapper is provided, the root element's children are also read into a tree
+// until the wrapper element is found.
+func decodeXML(v interface{}, d *xml.Decoder, wrapper string) error {
+	r := reflect.ValueOf(v)
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		if len(wrapper) == 0 {
+			err = decodeElement(r, d, start, "")
+		} else {
+			err = decodeWrapped(r, d, start, wrapper)
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// decodeWrapped decodes the root element's wrapper child element into r. If
+// the root element does not contain the wrapper element the root element
+// itself is decoded into r.
+func decodeWrapped(r reflect.Value, d *xml.Decoder, start xml.StartElement, wrapper string) error {
+	root := NewXMLElement(start.Name)
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch typed := tok.(type) {
+		case xml.StartElement:
+			if typed.Name.Local == wrapper {
+				if err := decodeElement(r, d, typed, ""); err != nil {
+					return err
+				}
+				// Only the first wrapper element is decoded.
+				return d.Skip()
+			}
+
+			node, err := readXMLNode(d, typed)
+			if err != nil {
+				return err
+			}
+			root.AddChild(node)
+		case xml.EndElement:
+			root.parent = attrXMLNode(start)
+			return parse(r, root, "")
+		}
+	}
+}
+
+// decodeElement decodes the element started by start into r. The type tag is
+// used to infer the type, or reflect will be used to determine the type from r.
+func decodeElement(r reflect.Value, d *xml.Decoder, start xml.StartElement, tag reflect.StructTag) error {
+	rtype, t := shapeType(r, tag)
+
+	switch t {
+	case "structure":
+		if field, ok := rtype.FieldByName("_"); ok {
+			tag = field.Tag
+		}
+		return decodeStruct(r, d, start, tag)
+	case "list":
+		return decodeList(r, d, start, tag)
+	case "map":
+		node, err := readXMLNode(d, start)
+		if err != nil {
+			return err
+		}
+		return parseMap(r, node, tag)
+	default:
+		return decodeScalar(r, d, tag)
+	}
+}
+
+// decodeStruct decodes a structure and its fields from the element's child
+// elements and attributes. Child elements which do not match a field are
+// skipped.
+func decodeStruct(r reflect.Value, d *xml.Decoder, start xml.StartElement, tag reflect.StructTag) error {
+	t := r.Type()
+	if r.Kind() == reflect.Ptr {
+		if r.IsNil() { // create the structure if it's nil
+			s := reflect.New(r.Type().Elem())
+			r.Set(s)
+			r = s
+		}
+
+		r = r.Elem()
+		t = t.Elem()
+	}
+
+	// unwrap any payloads
+	if payload := tag.Get("payload"); payload != "" {
+		field, _ := t.FieldByName(payload)
+		return decodeStruct(r.FieldByName(payload), d, start, field.Tag)
+	}
+
+	fields := cachedStructFields(t)
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch typed := tok.(type) {
+		case xml.StartElement:
+			idxs := fields.elems[typed.Name.Local]
+			switch len(idxs) {
+			case 0:
+				err = d.Skip()
+			case 1:
+				err = decodeElement(r.Field(idxs[0]), d, typed, t.Field(idxs[0]).Tag)
+			default:
+				err = parseFields(r, t, idxs, d, typed)
+			}
+			if err != nil {
+				return err
+			}
+		case xml.EndElement:
+			if len(fields.attrs) == 0 {
+				return nil
+			}
+
+			node := attrXMLNode(start)
+			for _, i := range fields.attrs {
+				if val, ok := node.findElem(fields.names[i]); ok {
+					if err := parse(r.Field(i), &XMLNode{Text: val}, t.Field(i).Tag); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}
+	}
+}
+
+// parseFields reads the element into an XMLNode, and parses it into each of
+// the fields which share the element's name.
+func parseFields(r reflect.Value, t reflect.Type, idxs []int, d *xml.Decoder, start xml.StartElement) error {
+	node, err := readXMLNode(d, start)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range idxs {
+		if err := parse(r.Field(i), node, t.Field(i).Tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeList decodes a list of values from the element. A flattened list's
+// element is a single entry of the list, otherwise each of the element's
+// member elements are an entry of the list.
+func decodeList(r reflect.Value, d *xml.Decoder, start xml.StartElement, tag reflect.StructTag) error {
+	t := r.Type()
+
+	if tag.Get("flattened") != "" { // flattened list means this is a single element
+		if r.IsNil() {
+			r.Set(reflect.MakeSlice(t, 0, 0))
+		}
+
+		r.Set(reflect.Append(r, reflect.Zero(t.Elem())))
+		return decodeElement(r.Index(r.Len()-1), d, start, "")
+	}
+
+	mname := "member"
+	if name := tag.Get("locationNameList"); name != "" {
+		mname = name
+	}
+
+	list := reflect.MakeSlice(t, 0, 0)
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch typed := tok.(type) {
+		case xml.StartElement:
+			if typed.Name.Local != mname {
+				if err := d.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
+
+			list = reflect.Append(list, reflect.Zero(t.Elem()))
+			if err := decodeElement(list.Index(list.Len()-1), d, typed, ""); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			// The list is only created if it has members.
+			if list.Len() > 0 && r.IsNil() {
+				r.Set(list)
+			}
+			return nil
+		}
+	}
+}
+
+// decodeScalar decodes the element's text into r. Any child elements are
+// skipped. An element without text, such as an element with the xsi:nil
+// attribute, is decoded as an empty value.
+func decodeScalar(r reflect.Value, d *xml.Decoder, tag reflect.StructTag) error {
+	var text []byte
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch typed := tok.(type) {
+		case xml.CharData:
+			text = append(text, typed...)
+		case xml.StartElement:
+			if err := d.Skip(); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return parseScalar(r, &XMLNode{Text: string(text)}, tag)
+		}
+	}
+}
+
+// readXMLNode reads the element started by start into an XMLNode, the same
+// as XMLToStruct would for the element.
+func readXMLNode(d *xml.Decoder, start xml.StartElement) (*XMLNode, error) {
+	node, err := XMLToStruct(d, &start)
+	if err != nil {
+		return nil, err
+	}
+
+	node.Name = start.Name
+	node.findNamespaces()
+	node.parent = attrXMLNode(start)
+
+	return node, nil
+}
+
+// attrXMLNode returns an XMLNode with the element's attributes, and the
+// namespaces the element declares, to look up attribute values with.
+func attrXMLNode(start xml.StartElement) *XMLNode {
+	node := &XMLNode{Attr: start.Copy().Attr}
+	node.findNamespaces()
+	return node
+}
+
+// xmlStructFields are the XML names of a structure's exported fields.
+type xmlStructFields struct {
+	// names of the fields by field index.
+	names []string
+
+	// field indexes by element name.
+	elems map[string][]int
+
+	// field indexes of namespace prefixed attribute fields.
+	attrs []int
+}
+
+var structFieldsCache = struct {
+	sync.RWMutex
+	m map[reflect.Type]*xmlStructFields
+}{m: map[reflect.Type]*xmlStructFields{}}
+
+// cachedStructFields returns the XML names of the structure's fields, named
+// the same as parseStruct would name them.
+func cachedStructFields(t reflect.Type) *xmlStructFields {
+	structFieldsCache.RLock()
+	fields, ok := structFieldsCache.m[t]
+	structFieldsCache.RUnlock()
+	if ok {
+		return fields
+	}
+
+	fields = &xmlStructFields{
+		names: make([]string, t.NumField()),
+		elems: map[string][]int{},
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if c := field.Name[0:1]; strings.ToLower(c) == c {
+			continue // ignore unexported fields
+		}
+
+		// figure out what this field is called
+		name := field.Name
+		if field.Tag.Get("flattened") != "" && field.Tag.Get("locationNameList") != "" {
+			name = field.Tag.Get("locationNameList")
+		} else if locName := field.Tag.Get("locationName"); locName != "" {
+			name = locName
+		}
+		fields.names[i] = name
+
+		// Element local names never contain a namespace prefix, so prefixed
+		// names can only be matched by attributes.
+		if strings.Contains(name, ":") {
+			fields.attrs = append(fields.attrs, i)
+		} else {
+			fields.elems[name] = append(fields.elems[name], i)
+		}
+	}
+
+	structFieldsCache.Lock()
+	structFieldsCache.m[t] = fields
+	structFieldsCache.Unlock()
+
+	return fields
+}
@@ -0,0 +1,251 @@
+package xmlutil
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+)
+
+type mockStreamOutput struct {
+	_         struct{}                     `type:"structure" xmlPrefix:"xsi" xmlURI:"http://www.w3.org/2001/XMLSchema-instance"`
+	String    *string                      `type:"string"`
+	Empty     *string                      `type:"string"`
+	Nil       *string                      `type:"string"`
+	Bool      *bool                        `type:"boolean"`
+	Float     *float64                     `type:"double"`
+	Time      *time.Time                   `type:"timestamp" timestampFormat:"iso8601"`
+	Blob      []byte                       `type:"blob"`
+	Renamed   *string                      `locationName:"OtherName" type:"string"`
+	Shared1   *string                      `locationName:"Shared" type:"string"`
+	Shared2   *string                      `locationName:"Shared" type:"string"`
+	Attr      *string                      `locationName:"xsi:attr" type:"string" xmlAttribute:"true"`
+	Wrapped   []*string                    `type:"list"`
+	Named     []*mockListElem              `locationNameList:"Elem" type:"list"`
+	Flattened []*mockNestedStruct          `locationName:"Flat" locationNameList:"Flat" type:"list" flattened:"true"`
+	Blobs     [][]byte                     `type:"list"`
+	Map       map[string]*string           `type:"map"`
+	FlatMap   map[string]*string           `locationName:"FlatMap" type:"map" flattened:"true"`
+	StructMap map[string]*mockNestedStruct `locationNameKey:"Name" locationNameValue:"Item" type:"map"`
+	Nested    *mockStreamOutput            `type:"structure"`
+	Closed    *mockClosedTags              `type:"structure"`
+	Payload   *mockPayload                 `type:"structure"`
+}
+
+type mockPayload struct {
+	_    struct{}          `type:"structure" payload:"Data"`
+	Data *mockNestedStruct `type:"structure"`
+}
+
+const mockStreamBody = `<?xml version="1.0" encoding="UTF-8"?>
+<Response xmlns="http://xmlns.example.com" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:attr="root attr">
+	<String>string &amp; value</String>
+	<Empty></Empty>
+	<Nil xsi:nil="true"/>
+	<Bool>true</Bool>
+	<Float>1.5</Float>
+	<Time>2017-01-02T03:04:05Z</Time>
+	<Blob>YmxvYg==</Blob>
+	<OtherName>renamed</OtherName>
+	<Shared>shared</Shared>
+	<Unknown><String>ignored</String></Unknown>
+	<Wrapped><member>a</member><other>skipped</other><member>b</member></Wrapped>
+	<Named>
+		<Elem>
+			<NestedElem xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="type">
+				<String>nested elem string value</String>
+			</NestedElem>
+			<String>elem string value</String>
+		</Elem>
+	</Named>
+	<Flat><NestedString>one</NestedString></Flat>
+	<Flat><NestedInt>2</NestedInt></Flat>
+	<Blobs><member>YQ==</member><member>Yg==</member></Blobs>
+	<Map>
+		<entry><key>k1</key><value>v1</value></entry>
+		<entry><value>v2</value><key>k2</key></entry>
+	</Map>
+	<FlatMap><key>f1</key><value>fv1</value></FlatMap>
+	<FlatMap><key>f2</key><value>fv2</value></FlatMap>
+	<StructMap>
+		<entry><Name>s1</Name><Item><NestedInt>1</NestedInt></Item></entry>
+	</StructMap>
+	<Nested xsi:attr="nested attr">
+		<ns:String xmlns:ns="http://other.example.com">prefixed</ns:String>
+		<Wrapped></Wrapped>
+	</Nested>
+	<Closed xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:attrval="attr value"/>
+	<Payload><NestedString>payload</NestedString></Payload>
+</Response>`
+
+func TestUnmarshalXML_StreamMatchesTree(t *testing.T) {
+	cases := map[string]struct {
+		Body    string
+		Wrapper string
+		New     func() interface{}
+	}{
+		"all shapes": {
+			Body: mockStreamBody,
+			New:  func() interface{} { return &mockStreamOutput{} },
+		},
+		"existing mock": {
+			Body: mockStreamBody,
+			New:  func() interface{} { return &mockOutput{} },
+		},
+		"wrapper": {
+			Body: `<OpResponse><ResponseMetadata><RequestId>abc</RequestId></ResponseMetadata>` +
+				`<OpResult><String>wrapped</String><Bool>true</Bool></OpResult>` +
+				`<OpResult><String>second</String></OpResult></OpResponse>`,
+			Wrapper: "OpResult",
+			New:     func() interface{} { return &mockStreamOutput{} },
+		},
+		"missing wrapper": {
+			Body:    `<OpResponse><String>unwrapped</String><Wrapped><member>a</member></Wrapped></OpResponse>`,
+			Wrapper: "OpResult",
+			New:     func() interface{} { return &mockStreamOutput{} },
+		},
+		"multiple roots": {
+			Body: `<First><String>first</String></First><Second><Bool>false</Bool></Second>`,
+			New:  func() interface{} { return &mockStreamOutput{} },
+		},
+		"empty": {
+			Body: ``,
+			New:  func() interface{} { return &mockStreamOutput{} },
+		},
+	}
+
+	for name, c := range cases {
+		expect := c.New()
+		err := unmarshalXMLTree(expect, xml.NewDecoder(strings.NewReader(c.Body)), c.Wrapper)
+		if err != nil {
+			t.Fatalf("%s, expect no tree error, got %v", name, err)
+		}
+
+		actual := c.New()
+		err = UnmarshalXML(actual, xml.NewDecoder(strings.NewReader(c.Body)), c.Wrapper)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if !reflect.DeepEqual(expect, actual) {
+			t.Errorf("%s, expect unmarshal to match\nExpect: %s\nActual: %s",
+				name, awsutil.Prettify(expect), awsutil.Prettify(actual))
+		}
+	}
+}
+
+func TestUnmarshalXML_Stream(t *testing.T) {
+	actual := mockStreamOutput{}
+	err := UnmarshalXML(&actual, xml.NewDecoder(strings.NewReader(mockStreamBody)), "")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "string & value", aws.StringValue(actual.String); e != a {
+		t.Errorf("expect %q string, got %q", e, a)
+	}
+	if actual.Nil == nil || len(*actual.Nil) != 0 {
+		t.Errorf("expect nil element to be empty string, got %v", actual.Nil)
+	}
+	if e, a := "root attr", aws.StringValue(actual.Attr); e != a {
+		t.Errorf("expect %q attribute, got %q", e, a)
+	}
+	if e, a := "shared", aws.StringValue(actual.Shared2); e != a {
+		t.Errorf("expect %q shared, got %q", e, a)
+	}
+	if e, a := []*string{aws.String("a"), aws.String("b")}, actual.Wrapped; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v list, got %v", e, a)
+	}
+	if e, a := 2, len(actual.Flattened); e != a {
+		t.Errorf("expect %d flattened members, got %d", e, a)
+	}
+	if e, a := "v2", aws.StringValue(actual.Map["k2"]); e != a {
+		t.Errorf("expect %q map value, got %q", e, a)
+	}
+	if e, a := "prefixed", aws.StringValue(actual.Nested.String); e != a {
+		t.Errorf("expect %q prefixed element, got %q", e, a)
+	}
+	if actual.Nested.Wrapped != nil {
+		t.Errorf("expect empty list to be nil, got %v", actual.Nested.Wrapped)
+	}
+	if e, a := "payload", aws.StringValue(actual.Payload.Data.NestedString); e != a {
+		t.Errorf("expect %q payload, got %q", e, a)
+	}
+}
+
+func TestUnmarshalXML_StreamError(t *testing.T) {
+	cases := []string{
+		`<Response><Bool>notbool</Bool></Response>`,
+		`<Response><Named><Elem><String>partial`,
+		`<Response><Map><entry><key>k`,
+	}
+
+	for i, c := range cases {
+		out := mockStreamOutput{}
+		err := UnmarshalXML(&out, xml.NewDecoder(strings.NewReader(c)), "")
+		if err == nil {
+			t.Errorf("%d, expect error", i)
+		}
+	}
+}
+
+type mockBenchOutput struct {
+	_        struct{}         `type:"structure"`
+	Name     *string          `type:"string"`
+	Contents []*mockBenchItem `type:"list" flattened:"true"`
+}
+
+type mockBenchItem struct {
+	_            struct{}            `type:"structure"`
+	Key          *string             `type:"string"`
+	LastModified *time.Time          `type:"timestamp" timestampFormat:"iso8601"`
+	ETag         *string             `type:"string"`
+	Size         *int64              `type:"integer"`
+	Owner        *mockNestedStruct   `type:"structure"`
+	Tags         []*mockNestedStruct `locationNameList:"Tag" type:"list"`
+}
+
+// mockBenchBody returns a ListObjects like response body of about n bytes.
+func mockBenchBody(n int) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` +
+		`<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Name>bucket</Name>`)
+	for i := 0; buf.Len() < n; i++ {
+		fmt.Fprintf(&buf, `<Contents><Key>path/to/object/key-%d</Key>`+
+			`<LastModified>2017-01-02T03:04:05Z</LastModified>`+
+			`<ETag>&quot;fba9dede5f27731c9771645a39863328&quot;</ETag>`+
+			`<Size>%d</Size><StorageClass>STANDARD</StorageClass>`+
+			`<Owner><NestedString>owner</NestedString><NestedInt>%d</NestedInt></Owner>`+
+			`<Tags><Tag><NestedString>tag</NestedString></Tag></Tags></Contents>`, i, i, i)
+	}
+	buf.WriteString(`</ListBucketResult>`)
+	return buf.Bytes()
+}
+
+func benchmarkUnmarshalXML(b *testing.B, fn func(interface{}, *xml.Decoder, string) error) {
+	body := mockBenchBody(20 * 1024 * 1024)
+
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		out := mockBenchOutput{}
+		if err := fn(&out, xml.NewDecoder(bytes.NewReader(body)), ""); err != nil {
+			b.Fatalf("expect no error, got %v", err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalXML_Stream(b *testing.B) {
+	benchmarkUnmarshalXML(b, UnmarshalXML)
+}
+
+func BenchmarkUnmarshalXML_Tree(b *testing.B) {
+	benchmarkUnmarshalXML(b, unmarshalXMLTree)
+}
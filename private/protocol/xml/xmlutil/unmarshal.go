@@ -14,7 +14,16 @@ import (
 // UnmarshalXML deserializes an xml.Decoder into the container v. V
 // needs to match the shape of the XML expected to be decoded.
 // If the shape doesn't match unmarshaling will fail.
+//
+// The XML tokens are decoded directly into v as they are read, without
+// building the whole document in memory first. See decodeXML.
 func UnmarshalXML(v interface{}, d *xml.Decoder, wrapper string) error {
+	return decodeXML(v, d, wrapper)
+}
+
+// unmarshalXMLTree deserializes an xml.Decoder into the container v by first
+// reading the whole document into an XMLNode tree.
+func unmarshalXMLTree(v interface{}, d *xml.Decoder, wrapper string) error {
 	n, err := XMLToStruct(d, nil)
 	if err != nil {
 		return err
@@ -43,6 +52,27 @@ func UnmarshalXML(v interface{}, d *xml.Decoder, wrapper string) error {
 // parse deserializes any value from the XMLNode. The type tag is used to infer the type, or reflect
 // will be used to determine the type from r.
 func parse(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
+	rtype, t := shapeType(r, tag)
+
+	switch t {
+	case "structure":
+		if field, ok := rtype.FieldByName("_"); ok {
+			tag = field.Tag
+		}
+		return parseStruct(r, node, tag)
+	case "list":
+		return parseList(r, node, tag)
+	case "map":
+		return parseMap(r, node, tag)
+	default:
+		return parseScalar(r, node, tag)
+	}
+}
+
+// shapeType returns the underlying type of r, and the shape type of the value
+// from the type tag. Reflect is used to determine the shape type if the tag
+// doesn't provide it.
+func shapeType(r reflect.Value, tag reflect.StructTag) (reflect.Type, string) {
 	rtype := r.Type()
 	if rtype.Kind() == reflect.Ptr {
 		rtype = rtype.Elem() // check kind of actual element type
@@ -54,25 +84,16 @@ func parse(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 		case reflect.Struct:
 			t = "structure"
 		case reflect.Slice:
-			t = "list"
+			// also it can't be a blob
+			if rtype.Elem().Kind() != reflect.Uint8 {
+				t = "list"
+			}
 		case reflect.Map:
 			t = "map"
 		}
 	}
 
-	switch t {
-	case "structure":
-		if field, ok := rtype.FieldByName("_"); ok {
-			tag = field.Tag
-		}
-		return parseStruct(r, node, tag)
-	case "list":
-		return parseList(r, node, tag)
-	case "map":
-		return parseMap(r, node, tag)
-	default:
-		return parseScalar(r, node, tag)
-	}
+	return rtype, t
 }
 
 // parseStruct deserializes a structure and its fields from an XMLNode. Any nested
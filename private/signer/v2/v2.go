@@ -35,6 +35,10 @@ type signer struct {
 	Debug       aws.LogLevelType
 	Logger      aws.Logger
 
+	// Context the credentials are retrieved with. Defaults to
+	// aws.BackgroundContext if nil.
+	Context aws.Context
+
 	Query        url.Values
 	stringToSign string
 	signature    string
@@ -70,6 +74,7 @@ func SignSDKRequest(req *request.Request) {
 		Credentials: req.Config.Credentials,
 		Debug:       req.Config.LogLevel.Value(),
 		Logger:      req.Config.Logger,
+		Context:     req.Context(),
 	}
 
 	req.Error = v2.Sign()
@@ -92,7 +97,11 @@ func SignSDKRequest(req *request.Request) {
 }
 
 func (v2 *signer) Sign() error {
-	credValue, err := v2.Credentials.Get()
+	ctx := v2.Context
+	if ctx == nil {
+		ctx = aws.BackgroundContext()
+	}
+	credValue, err := v2.Credentials.GetWithContext(ctx)
 	if err != nil {
 		return err
 	}
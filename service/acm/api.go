@@ -1081,6 +1081,37 @@ func (s *AddTagsToCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddTagsToCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddTagsToCertificateInput"}
+	if s.CertificateArn != nil && len(*s.CertificateArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateArn", 2048))
+	}
+	if s.CertificateArn != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*", *s.CertificateArn) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateArn", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*"))
+	}
+	if s.Tags != nil && len(s.Tags) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 50))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateArn sets the CertificateArn field's value.
 func (s *AddTagsToCertificateInput) SetCertificateArn(v string) *AddTagsToCertificateInput {
 	s.CertificateArn = &v
@@ -1424,6 +1455,24 @@ func (s *DeleteCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteCertificateInput"}
+	if s.CertificateArn != nil && len(*s.CertificateArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateArn", 2048))
+	}
+	if s.CertificateArn != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*", *s.CertificateArn) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateArn", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateArn sets the CertificateArn field's value.
 func (s *DeleteCertificateInput) SetCertificateArn(v string) *DeleteCertificateInput {
 	s.CertificateArn = &v
@@ -1487,6 +1536,24 @@ func (s *DescribeCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeCertificateInput"}
+	if s.CertificateArn != nil && len(*s.CertificateArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateArn", 2048))
+	}
+	if s.CertificateArn != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*", *s.CertificateArn) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateArn", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateArn sets the CertificateArn field's value.
 func (s *DescribeCertificateInput) SetCertificateArn(v string) *DescribeCertificateInput {
 	s.CertificateArn = &v
@@ -1636,6 +1703,24 @@ func (s *DomainValidationOption) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DomainValidationOption) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DomainValidationOption"}
+	if s.DomainName != nil && len(*s.DomainName) > 253 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 253))
+	}
+	if s.ValidationDomain != nil && len(*s.ValidationDomain) > 253 {
+		invalidParams.Add(request.NewErrParamMaxLen("ValidationDomain", 253))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DomainValidationOption) SetDomainName(v string) *DomainValidationOption {
 	s.DomainName = &v
@@ -1689,6 +1774,24 @@ func (s *GetCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetCertificateInput"}
+	if s.CertificateArn != nil && len(*s.CertificateArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateArn", 2048))
+	}
+	if s.CertificateArn != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*", *s.CertificateArn) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateArn", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateArn sets the CertificateArn field's value.
 func (s *GetCertificateInput) SetCertificateArn(v string) *GetCertificateInput {
 	s.CertificateArn = &v
@@ -1770,7 +1873,7 @@ type ImportCertificateInput struct {
 	// PrivateKey is automatically base64 encoded/decoded by the SDK.
 	//
 	// PrivateKey is a required field
-	PrivateKey []byte `min:"1" type:"blob" required:"true"`
+	PrivateKey []byte `min:"1" type:"blob" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -1811,6 +1914,33 @@ func (s *ImportCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ImportCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ImportCertificateInput"}
+	if s.Certificate != nil && len(s.Certificate) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Certificate", 32768))
+	}
+	if s.CertificateArn != nil && len(*s.CertificateArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateArn", 2048))
+	}
+	if s.CertificateArn != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*", *s.CertificateArn) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateArn", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*"))
+	}
+	if s.CertificateChain != nil && len(s.CertificateChain) > 2.097152e+06 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateChain", 2.097152e+06))
+	}
+	if s.PrivateKey != nil && len(s.PrivateKey) > 524288 {
+		invalidParams.Add(request.NewErrParamMaxLen("PrivateKey", 524288))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificate sets the Certificate field's value.
 func (s *ImportCertificateInput) SetCertificate(v []byte) *ImportCertificateInput {
 	s.Certificate = v
@@ -1905,6 +2035,24 @@ func (s *ListCertificatesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListCertificatesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListCertificatesInput"}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 320))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateStatuses sets the CertificateStatuses field's value.
 func (s *ListCertificatesInput) SetCertificateStatuses(v []*string) *ListCertificatesInput {
 	s.CertificateStatuses = v
@@ -1999,6 +2147,24 @@ func (s *ListTagsForCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsForCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForCertificateInput"}
+	if s.CertificateArn != nil && len(*s.CertificateArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateArn", 2048))
+	}
+	if s.CertificateArn != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*", *s.CertificateArn) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateArn", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateArn sets the CertificateArn field's value.
 func (s *ListTagsForCertificateInput) SetCertificateArn(v string) *ListTagsForCertificateInput {
 	s.CertificateArn = &v
@@ -2092,6 +2258,37 @@ func (s *RemoveTagsFromCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveTagsFromCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveTagsFromCertificateInput"}
+	if s.CertificateArn != nil && len(*s.CertificateArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateArn", 2048))
+	}
+	if s.CertificateArn != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*", *s.CertificateArn) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateArn", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*"))
+	}
+	if s.Tags != nil && len(s.Tags) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 50))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateArn sets the CertificateArn field's value.
 func (s *RemoveTagsFromCertificateInput) SetCertificateArn(v string) *RemoveTagsFromCertificateInput {
 	s.CertificateArn = &v
@@ -2257,6 +2454,43 @@ func (s *RequestCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RequestCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RequestCertificateInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 253 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 253))
+	}
+	if s.DomainValidationOptions != nil && len(s.DomainValidationOptions) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainValidationOptions", 100))
+	}
+	if s.IdempotencyToken != nil && len(*s.IdempotencyToken) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdempotencyToken", 32))
+	}
+	if s.IdempotencyToken != nil && !request.MatchParamPattern("\\w+", *s.IdempotencyToken) {
+		invalidParams.Add(request.NewErrParamPattern("IdempotencyToken", "\\w+"))
+	}
+	if s.SubjectAlternativeNames != nil && len(s.SubjectAlternativeNames) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("SubjectAlternativeNames", 100))
+	}
+	if s.DomainValidationOptions != nil {
+		for i, v := range s.DomainValidationOptions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "DomainValidationOptions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *RequestCertificateInput) SetDomainName(v string) *RequestCertificateInput {
 	s.DomainName = &v
@@ -2389,6 +2623,30 @@ func (s *ResendValidationEmailInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResendValidationEmailInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResendValidationEmailInput"}
+	if s.CertificateArn != nil && len(*s.CertificateArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateArn", 2048))
+	}
+	if s.CertificateArn != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*", *s.CertificateArn) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateArn", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:[\\w+=/,.@-]*:[0-9]+:[\\w+=,.@-]+(/[\\w+=/,.@-]+)*"))
+	}
+	if s.Domain != nil && len(*s.Domain) > 253 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 253))
+	}
+	if s.ValidationDomain != nil && len(*s.ValidationDomain) > 253 {
+		invalidParams.Add(request.NewErrParamMaxLen("ValidationDomain", 253))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateArn sets the CertificateArn field's value.
 func (s *ResendValidationEmailInput) SetCertificateArn(v string) *ResendValidationEmailInput {
 	s.CertificateArn = &v
@@ -2462,6 +2720,30 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Key != nil && !request.MatchParamPattern("[\\p{L}\\p{Z}\\p{N}_.:\\/=+\\-@]*", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "[\\p{L}\\p{Z}\\p{N}_.:\\/=+\\-@]*"))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+	if s.Value != nil && !request.MatchParamPattern("[\\p{L}\\p{Z}\\p{N}_.:\\/=+\\-@]*", *s.Value) {
+		invalidParams.Add(request.NewErrParamPattern("Value", "[\\p{L}\\p{Z}\\p{N}_.:\\/=+\\-@]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -3,6 +3,7 @@
 package apigateway
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11521,6 +11522,24 @@ func (s *CreateAuthorizerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAuthorizerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAuthorizerInput"}
+	if s.Type != nil {
+		values := []string{"TOKEN", "REQUEST", "COGNITO_USER_POOLS"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthType sets the AuthType field's value.
 func (s *CreateAuthorizerInput) SetAuthType(v string) *CreateAuthorizerInput {
 	s.AuthType = &v
@@ -11793,6 +11812,24 @@ func (s *CreateDeploymentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDeploymentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDeploymentInput"}
+	if s.CacheClusterSize != nil {
+		values := []string{"0.5", "1.6", "6.1", "13.5", "28.4", "58.2", "118", "237"}
+		if !request.IsParamEnumValue(*s.CacheClusterSize, values) {
+			invalidParams.Add(request.NewErrParamEnum("CacheClusterSize", *s.CacheClusterSize, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCacheClusterEnabled sets the CacheClusterEnabled field's value.
 func (s *CreateDeploymentInput) SetCacheClusterEnabled(v bool) *CreateDeploymentInput {
 	s.CacheClusterEnabled = &v
@@ -11933,6 +11970,23 @@ func (s *CreateDocumentationPartInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDocumentationPartInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDocumentationPartInput"}
+	if s.Location != nil {
+		if err := s.Location.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Location", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLocation sets the Location field's value.
 func (s *CreateDocumentationPartInput) SetLocation(v *DocumentationPartLocation) *CreateDocumentationPartInput {
 	s.Location = v
@@ -12668,6 +12722,24 @@ func (s *CreateStageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateStageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateStageInput"}
+	if s.CacheClusterSize != nil {
+		values := []string{"0.5", "1.6", "6.1", "13.5", "28.4", "58.2", "118", "237"}
+		if !request.IsParamEnumValue(*s.CacheClusterSize, values) {
+			invalidParams.Add(request.NewErrParamEnum("CacheClusterSize", *s.CacheClusterSize, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCacheClusterEnabled sets the CacheClusterEnabled field's value.
 func (s *CreateStageInput) SetCacheClusterEnabled(v bool) *CreateStageInput {
 	s.CacheClusterEnabled = &v
@@ -12809,6 +12881,23 @@ func (s *CreateUsagePlanInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateUsagePlanInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateUsagePlanInput"}
+	if s.Quota != nil {
+		if err := s.Quota.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Quota", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApiStages sets the ApiStages field's value.
 func (s *CreateUsagePlanInput) SetApiStages(v []*ApiStage) *CreateUsagePlanInput {
 	s.ApiStages = v
@@ -13677,6 +13766,24 @@ func (s *DeleteGatewayResponseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteGatewayResponseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteGatewayResponseInput"}
+	if s.ResponseType != nil {
+		values := []string{"DEFAULT_4XX", "DEFAULT_5XX", "RESOURCE_NOT_FOUND", "UNAUTHORIZED", "INVALID_API_KEY", "ACCESS_DENIED", "AUTHORIZER_FAILURE", "AUTHORIZER_CONFIGURATION_ERROR", "INVALID_SIGNATURE", "EXPIRED_TOKEN", "MISSING_AUTHENTICATION_TOKEN", "INTEGRATION_FAILURE", "INTEGRATION_TIMEOUT", "API_CONFIGURATION_ERROR", "UNSUPPORTED_MEDIA_TYPE", "BAD_REQUEST_PARAMETERS", "BAD_REQUEST_BODY", "REQUEST_TOO_LARGE", "THROTTLED", "QUOTA_EXCEEDED"}
+		if !request.IsParamEnumValue(*s.ResponseType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResponseType", *s.ResponseType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResponseType sets the ResponseType field's value.
 func (s *DeleteGatewayResponseInput) SetResponseType(v string) *DeleteGatewayResponseInput {
 	s.ResponseType = &v
@@ -13890,6 +13997,21 @@ func (s *DeleteIntegrationResponseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteIntegrationResponseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteIntegrationResponseInput"}
+	if s.StatusCode != nil && !request.MatchParamPattern("[1-5]\\d\\d", *s.StatusCode) {
+		invalidParams.Add(request.NewErrParamPattern("StatusCode", "[1-5]\\d\\d"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHttpMethod sets the HttpMethod field's value.
 func (s *DeleteIntegrationResponseInput) SetHttpMethod(v string) *DeleteIntegrationResponseInput {
 	s.HttpMethod = &v
@@ -14125,6 +14247,21 @@ func (s *DeleteMethodResponseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteMethodResponseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteMethodResponseInput"}
+	if s.StatusCode != nil && !request.MatchParamPattern("[1-5]\\d\\d", *s.StatusCode) {
+		invalidParams.Add(request.NewErrParamPattern("StatusCode", "[1-5]\\d\\d"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHttpMethod sets the HttpMethod field's value.
 func (s *DeleteMethodResponseInput) SetHttpMethod(v string) *DeleteMethodResponseInput {
 	s.HttpMethod = &v
@@ -15048,6 +15185,27 @@ func (s *DocumentationPartLocation) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DocumentationPartLocation) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DocumentationPartLocation"}
+	if s.StatusCode != nil && !request.MatchParamPattern("^([1-5]\\d\\d|\\*|\\s*)$", *s.StatusCode) {
+		invalidParams.Add(request.NewErrParamPattern("StatusCode", "^([1-5]\\d\\d|\\*|\\s*)$"))
+	}
+	if s.Type != nil {
+		values := []string{"API", "AUTHORIZER", "MODEL", "RESOURCE", "METHOD", "PATH_PARAMETER", "QUERY_PARAMETER", "REQUEST_HEADER", "REQUEST_BODY", "RESPONSE", "RESPONSE_HEADER", "RESPONSE_BODY"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMethod sets the Method field's value.
 func (s *DocumentationPartLocation) SetMethod(v string) *DocumentationPartLocation {
 	s.Method = &v
@@ -16642,6 +16800,24 @@ func (s *GetDocumentationPartsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDocumentationPartsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDocumentationPartsInput"}
+	if s.Type != nil {
+		values := []string{"API", "AUTHORIZER", "MODEL", "RESOURCE", "METHOD", "PATH_PARAMETER", "QUERY_PARAMETER", "REQUEST_HEADER", "REQUEST_BODY", "RESPONSE", "RESPONSE_HEADER", "RESPONSE_BODY"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *GetDocumentationPartsInput) SetLimit(v int64) *GetDocumentationPartsInput {
 	s.Limit = &v
@@ -17364,6 +17540,24 @@ func (s *GetGatewayResponseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetGatewayResponseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetGatewayResponseInput"}
+	if s.ResponseType != nil {
+		values := []string{"DEFAULT_4XX", "DEFAULT_5XX", "RESOURCE_NOT_FOUND", "UNAUTHORIZED", "INVALID_API_KEY", "ACCESS_DENIED", "AUTHORIZER_FAILURE", "AUTHORIZER_CONFIGURATION_ERROR", "INVALID_SIGNATURE", "EXPIRED_TOKEN", "MISSING_AUTHENTICATION_TOKEN", "INTEGRATION_FAILURE", "INTEGRATION_TIMEOUT", "API_CONFIGURATION_ERROR", "UNSUPPORTED_MEDIA_TYPE", "BAD_REQUEST_PARAMETERS", "BAD_REQUEST_BODY", "REQUEST_TOO_LARGE", "THROTTLED", "QUOTA_EXCEEDED"}
+		if !request.IsParamEnumValue(*s.ResponseType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResponseType", *s.ResponseType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResponseType sets the ResponseType field's value.
 func (s *GetGatewayResponseInput) SetResponseType(v string) *GetGatewayResponseInput {
 	s.ResponseType = &v
@@ -17827,6 +18021,21 @@ func (s *GetIntegrationResponseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetIntegrationResponseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetIntegrationResponseInput"}
+	if s.StatusCode != nil && !request.MatchParamPattern("[1-5]\\d\\d", *s.StatusCode) {
+		invalidParams.Add(request.NewErrParamPattern("StatusCode", "[1-5]\\d\\d"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHttpMethod sets the HttpMethod field's value.
 func (s *GetIntegrationResponseInput) SetHttpMethod(v string) *GetIntegrationResponseInput {
 	s.HttpMethod = &v
@@ -18022,6 +18231,21 @@ func (s *GetMethodResponseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetMethodResponseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetMethodResponseInput"}
+	if s.StatusCode != nil && !request.MatchParamPattern("[1-5]\\d\\d", *s.StatusCode) {
+		invalidParams.Add(request.NewErrParamPattern("StatusCode", "[1-5]\\d\\d"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHttpMethod sets the HttpMethod field's value.
 func (s *GetMethodResponseInput) SetHttpMethod(v string) *GetMethodResponseInput {
 	s.HttpMethod = &v
@@ -20026,6 +20250,24 @@ func (s *ImportApiKeysInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ImportApiKeysInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ImportApiKeysInput"}
+	if s.Format != nil {
+		values := []string{"csv"}
+		if !request.IsParamEnumValue(*s.Format, values) {
+			invalidParams.Add(request.NewErrParamEnum("Format", *s.Format, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBody sets the Body field's value.
 func (s *ImportApiKeysInput) SetBody(v []byte) *ImportApiKeysInput {
 	s.Body = v
@@ -20166,6 +20408,24 @@ func (s *ImportDocumentationPartsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ImportDocumentationPartsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ImportDocumentationPartsInput"}
+	if s.Mode != nil {
+		values := []string{"merge", "overwrite"}
+		if !request.IsParamEnumValue(*s.Mode, values) {
+			invalidParams.Add(request.NewErrParamEnum("Mode", *s.Mode, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBody sets the Body field's value.
 func (s *ImportDocumentationPartsInput) SetBody(v []byte) *ImportDocumentationPartsInput {
 	s.Body = v
@@ -21578,6 +21838,24 @@ func (s PatchOperation) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PatchOperation) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PatchOperation"}
+	if s.Op != nil {
+		values := []string{"add", "remove", "replace", "move", "copy", "test"}
+		if !request.IsParamEnumValue(*s.Op, values) {
+			invalidParams.Add(request.NewErrParamEnum("Op", *s.Op, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFrom sets the From field's value.
 func (s *PatchOperation) SetFrom(v string) *PatchOperation {
 	s.From = &v
@@ -21709,6 +21987,27 @@ func (s *PutGatewayResponseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutGatewayResponseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutGatewayResponseInput"}
+	if s.ResponseType != nil {
+		values := []string{"DEFAULT_4XX", "DEFAULT_5XX", "RESOURCE_NOT_FOUND", "UNAUTHORIZED", "INVALID_API_KEY", "ACCESS_DENIED", "AUTHORIZER_FAILURE", "AUTHORIZER_CONFIGURATION_ERROR", "INVALID_SIGNATURE", "EXPIRED_TOKEN", "MISSING_AUTHENTICATION_TOKEN", "INTEGRATION_FAILURE", "INTEGRATION_TIMEOUT", "API_CONFIGURATION_ERROR", "UNSUPPORTED_MEDIA_TYPE", "BAD_REQUEST_PARAMETERS", "BAD_REQUEST_BODY", "REQUEST_TOO_LARGE", "THROTTLED", "QUOTA_EXCEEDED"}
+		if !request.IsParamEnumValue(*s.ResponseType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResponseType", *s.ResponseType, values))
+		}
+	}
+	if s.StatusCode != nil && !request.MatchParamPattern("[1-5]\\d\\d", *s.StatusCode) {
+		invalidParams.Add(request.NewErrParamPattern("StatusCode", "[1-5]\\d\\d"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResponseParameters sets the ResponseParameters field's value.
 func (s *PutGatewayResponseInput) SetResponseParameters(v map[string]*string) *PutGatewayResponseInput {
 	s.ResponseParameters = v
@@ -21896,6 +22195,30 @@ func (s *PutIntegrationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutIntegrationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutIntegrationInput"}
+	if s.ContentHandling != nil {
+		values := []string{"CONVERT_TO_BINARY", "CONVERT_TO_TEXT"}
+		if !request.IsParamEnumValue(*s.ContentHandling, values) {
+			invalidParams.Add(request.NewErrParamEnum("ContentHandling", *s.ContentHandling, values))
+		}
+	}
+	if s.Type != nil {
+		values := []string{"HTTP", "AWS", "MOCK", "HTTP_PROXY", "AWS_PROXY"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCacheKeyParameters sets the CacheKeyParameters field's value.
 func (s *PutIntegrationInput) SetCacheKeyParameters(v []*string) *PutIntegrationInput {
 	s.CacheKeyParameters = v
@@ -22134,6 +22457,27 @@ func (s *PutIntegrationResponseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutIntegrationResponseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutIntegrationResponseInput"}
+	if s.ContentHandling != nil {
+		values := []string{"CONVERT_TO_BINARY", "CONVERT_TO_TEXT"}
+		if !request.IsParamEnumValue(*s.ContentHandling, values) {
+			invalidParams.Add(request.NewErrParamEnum("ContentHandling", *s.ContentHandling, values))
+		}
+	}
+	if s.StatusCode != nil && !request.MatchParamPattern("[1-5]\\d\\d", *s.StatusCode) {
+		invalidParams.Add(request.NewErrParamPattern("StatusCode", "[1-5]\\d\\d"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContentHandling sets the ContentHandling field's value.
 func (s *PutIntegrationResponseInput) SetContentHandling(v string) *PutIntegrationResponseInput {
 	s.ContentHandling = &v
@@ -22508,6 +22852,21 @@ func (s *PutMethodResponseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutMethodResponseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutMethodResponseInput"}
+	if s.StatusCode != nil && !request.MatchParamPattern("[1-5]\\d\\d", *s.StatusCode) {
+		invalidParams.Add(request.NewErrParamPattern("StatusCode", "[1-5]\\d\\d"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHttpMethod sets the HttpMethod field's value.
 func (s *PutMethodResponseInput) SetHttpMethod(v string) *PutMethodResponseInput {
 	s.HttpMethod = &v
@@ -22638,6 +22997,24 @@ func (s *PutRestApiInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutRestApiInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutRestApiInput"}
+	if s.Mode != nil {
+		values := []string{"merge", "overwrite"}
+		if !request.IsParamEnumValue(*s.Mode, values) {
+			invalidParams.Add(request.NewErrParamEnum("Mode", *s.Mode, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBody sets the Body field's value.
 func (s *PutRestApiInput) SetBody(v []byte) *PutRestApiInput {
 	s.Body = v
@@ -22725,6 +23102,24 @@ func (s QuotaSettings) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *QuotaSettings) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "QuotaSettings"}
+	if s.Period != nil {
+		values := []string{"DAY", "WEEK", "MONTH"}
+		if !request.IsParamEnumValue(*s.Period, values) {
+			invalidParams.Add(request.NewErrParamEnum("Period", *s.Period, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *QuotaSettings) SetLimit(v int64) *QuotaSettings {
 	s.Limit = &v
@@ -24096,6 +24491,28 @@ func (s UpdateAccountInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateAccountInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateAccountInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPatchOperations sets the PatchOperations field's value.
 func (s *UpdateAccountInput) SetPatchOperations(v []*PatchOperation) *UpdateAccountInput {
 	s.PatchOperations = v
@@ -24150,6 +24567,28 @@ func (s *UpdateApiKeyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateApiKeyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateApiKeyInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApiKey sets the ApiKey field's value.
 func (s *UpdateApiKeyInput) SetApiKey(v string) *UpdateApiKeyInput {
 	s.ApiKey = &v
@@ -24223,6 +24662,28 @@ func (s *UpdateAuthorizerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateAuthorizerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateAuthorizerInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthorizerId sets the AuthorizerId field's value.
 func (s *UpdateAuthorizerInput) SetAuthorizerId(v string) *UpdateAuthorizerInput {
 	s.AuthorizerId = &v
@@ -24307,6 +24768,28 @@ func (s *UpdateBasePathMappingInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateBasePathMappingInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateBasePathMappingInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBasePath sets the BasePath field's value.
 func (s *UpdateBasePathMappingInput) SetBasePath(v string) *UpdateBasePathMappingInput {
 	s.BasePath = &v
@@ -24383,6 +24866,28 @@ func (s *UpdateClientCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateClientCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateClientCertificateInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientCertificateId sets the ClientCertificateId field's value.
 func (s *UpdateClientCertificateInput) SetClientCertificateId(v string) *UpdateClientCertificateInput {
 	s.ClientCertificateId = &v
@@ -24457,6 +24962,28 @@ func (s *UpdateDeploymentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDeploymentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDeploymentInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeploymentId sets the DeploymentId field's value.
 func (s *UpdateDeploymentInput) SetDeploymentId(v string) *UpdateDeploymentInput {
 	s.DeploymentId = &v
@@ -24541,6 +25068,28 @@ func (s *UpdateDocumentationPartInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDocumentationPartInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDocumentationPartInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDocumentationPartId sets the DocumentationPartId field's value.
 func (s *UpdateDocumentationPartInput) SetDocumentationPartId(v string) *UpdateDocumentationPartInput {
 	s.DocumentationPartId = &v
@@ -24625,6 +25174,28 @@ func (s *UpdateDocumentationVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDocumentationVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDocumentationVersionInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDocumentationVersion sets the DocumentationVersion field's value.
 func (s *UpdateDocumentationVersionInput) SetDocumentationVersion(v string) *UpdateDocumentationVersionInput {
 	s.DocumentationVersion = &v
@@ -24701,6 +25272,28 @@ func (s *UpdateDomainNameInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDomainNameInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDomainNameInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *UpdateDomainNameInput) SetDomainName(v string) *UpdateDomainNameInput {
 	s.DomainName = &v
@@ -24794,6 +25387,34 @@ func (s *UpdateGatewayResponseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateGatewayResponseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateGatewayResponseInput"}
+	if s.ResponseType != nil {
+		values := []string{"DEFAULT_4XX", "DEFAULT_5XX", "RESOURCE_NOT_FOUND", "UNAUTHORIZED", "INVALID_API_KEY", "ACCESS_DENIED", "AUTHORIZER_FAILURE", "AUTHORIZER_CONFIGURATION_ERROR", "INVALID_SIGNATURE", "EXPIRED_TOKEN", "MISSING_AUTHENTICATION_TOKEN", "INTEGRATION_FAILURE", "INTEGRATION_TIMEOUT", "API_CONFIGURATION_ERROR", "UNSUPPORTED_MEDIA_TYPE", "BAD_REQUEST_PARAMETERS", "BAD_REQUEST_BODY", "REQUEST_TOO_LARGE", "THROTTLED", "QUOTA_EXCEEDED"}
+		if !request.IsParamEnumValue(*s.ResponseType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResponseType", *s.ResponseType, values))
+		}
+	}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPatchOperations sets the PatchOperations field's value.
 func (s *UpdateGatewayResponseInput) SetPatchOperations(v []*PatchOperation) *UpdateGatewayResponseInput {
 	s.PatchOperations = v
@@ -25049,6 +25670,28 @@ func (s *UpdateIntegrationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateIntegrationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateIntegrationInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHttpMethod sets the HttpMethod field's value.
 func (s *UpdateIntegrationInput) SetHttpMethod(v string) *UpdateIntegrationInput {
 	s.HttpMethod = &v
@@ -25160,6 +25803,31 @@ func (s *UpdateIntegrationResponseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateIntegrationResponseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateIntegrationResponseInput"}
+	if s.StatusCode != nil && !request.MatchParamPattern("[1-5]\\d\\d", *s.StatusCode) {
+		invalidParams.Add(request.NewErrParamPattern("StatusCode", "[1-5]\\d\\d"))
+	}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHttpMethod sets the HttpMethod field's value.
 func (s *UpdateIntegrationResponseInput) SetHttpMethod(v string) *UpdateIntegrationResponseInput {
 	s.HttpMethod = &v
@@ -25274,6 +25942,28 @@ func (s *UpdateMethodInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateMethodInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateMethodInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHttpMethod sets the HttpMethod field's value.
 func (s *UpdateMethodInput) SetHttpMethod(v string) *UpdateMethodInput {
 	s.HttpMethod = &v
@@ -25385,6 +26075,31 @@ func (s *UpdateMethodResponseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateMethodResponseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateMethodResponseInput"}
+	if s.StatusCode != nil && !request.MatchParamPattern("[1-5]\\d\\d", *s.StatusCode) {
+		invalidParams.Add(request.NewErrParamPattern("StatusCode", "[1-5]\\d\\d"))
+	}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHttpMethod sets the HttpMethod field's value.
 func (s *UpdateMethodResponseInput) SetHttpMethod(v string) *UpdateMethodResponseInput {
 	s.HttpMethod = &v
@@ -25491,6 +26206,28 @@ func (s *UpdateModelInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateModelInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateModelInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetModelName sets the ModelName field's value.
 func (s *UpdateModelInput) SetModelName(v string) *UpdateModelInput {
 	s.ModelName = &v
@@ -25575,6 +26312,28 @@ func (s *UpdateRequestValidatorInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateRequestValidatorInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateRequestValidatorInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPatchOperations sets the PatchOperations field's value.
 func (s *UpdateRequestValidatorInput) SetPatchOperations(v []*PatchOperation) *UpdateRequestValidatorInput {
 	s.PatchOperations = v
@@ -25754,6 +26513,28 @@ func (s *UpdateResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateResourceInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPatchOperations sets the PatchOperations field's value.
 func (s *UpdateResourceInput) SetPatchOperations(v []*PatchOperation) *UpdateResourceInput {
 	s.PatchOperations = v
@@ -25830,6 +26611,28 @@ func (s *UpdateRestApiInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateRestApiInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateRestApiInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPatchOperations sets the PatchOperations field's value.
 func (s *UpdateRestApiInput) SetPatchOperations(v []*PatchOperation) *UpdateRestApiInput {
 	s.PatchOperations = v
@@ -25903,6 +26706,28 @@ func (s *UpdateStageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateStageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateStageInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPatchOperations sets the PatchOperations field's value.
 func (s *UpdateStageInput) SetPatchOperations(v []*PatchOperation) *UpdateStageInput {
 	s.PatchOperations = v
@@ -25989,6 +26814,28 @@ func (s *UpdateUsageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateUsageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateUsageInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *UpdateUsageInput) SetKeyId(v string) *UpdateUsageInput {
 	s.KeyId = &v
@@ -26065,6 +26912,28 @@ func (s *UpdateUsagePlanInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateUsagePlanInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateUsagePlanInput"}
+	if s.PatchOperations != nil {
+		for i, v := range s.PatchOperations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchOperations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPatchOperations sets the PatchOperations field's value.
 func (s *UpdateUsagePlanInput) SetPatchOperations(v []*PatchOperation) *UpdateUsagePlanInput {
 	s.PatchOperations = v
@@ -1004,6 +1004,24 @@ func (s *CustomizedMetricSpecification) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CustomizedMetricSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CustomizedMetricSpecification"}
+	if s.Statistic != nil {
+		values := []string{"Average", "Minimum", "Maximum", "SampleCount", "Sum"}
+		if !request.IsParamEnumValue(*s.Statistic, values) {
+			invalidParams.Add(request.NewErrParamEnum("Statistic", *s.Statistic, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDimensions sets the Dimensions field's value.
 func (s *CustomizedMetricSpecification) SetDimensions(v []*MetricDimension) *CustomizedMetricSpecification {
 	s.Dimensions = v
@@ -1142,6 +1160,36 @@ func (s *DeleteScalingPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteScalingPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteScalingPolicyInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 1600))
+	}
+	if s.ResourceId != nil && len(*s.ResourceId) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 1600))
+	}
+	if s.ScalableDimension != nil {
+		values := []string{"ecs:service:DesiredCount", "ec2:spot-fleet-request:TargetCapacity", "elasticmapreduce:instancegroup:InstanceCount", "appstream:fleet:DesiredCapacity", "dynamodb:table:ReadCapacityUnits", "dynamodb:table:WriteCapacityUnits", "dynamodb:index:ReadCapacityUnits", "dynamodb:index:WriteCapacityUnits"}
+		if !request.IsParamEnumValue(*s.ScalableDimension, values) {
+			invalidParams.Add(request.NewErrParamEnum("ScalableDimension", *s.ScalableDimension, values))
+		}
+	}
+	if s.ServiceNamespace != nil {
+		values := []string{"ecs", "elasticmapreduce", "ec2", "appstream", "dynamodb"}
+		if !request.IsParamEnumValue(*s.ServiceNamespace, values) {
+			invalidParams.Add(request.NewErrParamEnum("ServiceNamespace", *s.ServiceNamespace, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyName sets the PolicyName field's value.
 func (s *DeleteScalingPolicyInput) SetPolicyName(v string) *DeleteScalingPolicyInput {
 	s.PolicyName = &v
@@ -1278,6 +1326,33 @@ func (s *DeregisterScalableTargetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeregisterScalableTargetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeregisterScalableTargetInput"}
+	if s.ResourceId != nil && len(*s.ResourceId) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 1600))
+	}
+	if s.ScalableDimension != nil {
+		values := []string{"ecs:service:DesiredCount", "ec2:spot-fleet-request:TargetCapacity", "elasticmapreduce:instancegroup:InstanceCount", "appstream:fleet:DesiredCapacity", "dynamodb:table:ReadCapacityUnits", "dynamodb:table:WriteCapacityUnits", "dynamodb:index:ReadCapacityUnits", "dynamodb:index:WriteCapacityUnits"}
+		if !request.IsParamEnumValue(*s.ScalableDimension, values) {
+			invalidParams.Add(request.NewErrParamEnum("ScalableDimension", *s.ScalableDimension, values))
+		}
+	}
+	if s.ServiceNamespace != nil {
+		values := []string{"ecs", "elasticmapreduce", "ec2", "appstream", "dynamodb"}
+		if !request.IsParamEnumValue(*s.ServiceNamespace, values) {
+			invalidParams.Add(request.NewErrParamEnum("ServiceNamespace", *s.ServiceNamespace, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *DeregisterScalableTargetInput) SetResourceId(v string) *DeregisterScalableTargetInput {
 	s.ResourceId = &v
@@ -1409,6 +1484,30 @@ func (s *DescribeScalableTargetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeScalableTargetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeScalableTargetsInput"}
+	if s.ScalableDimension != nil {
+		values := []string{"ecs:service:DesiredCount", "ec2:spot-fleet-request:TargetCapacity", "elasticmapreduce:instancegroup:InstanceCount", "appstream:fleet:DesiredCapacity", "dynamodb:table:ReadCapacityUnits", "dynamodb:table:WriteCapacityUnits", "dynamodb:index:ReadCapacityUnits", "dynamodb:index:WriteCapacityUnits"}
+		if !request.IsParamEnumValue(*s.ScalableDimension, values) {
+			invalidParams.Add(request.NewErrParamEnum("ScalableDimension", *s.ScalableDimension, values))
+		}
+	}
+	if s.ServiceNamespace != nil {
+		values := []string{"ecs", "elasticmapreduce", "ec2", "appstream", "dynamodb"}
+		if !request.IsParamEnumValue(*s.ServiceNamespace, values) {
+			invalidParams.Add(request.NewErrParamEnum("ServiceNamespace", *s.ServiceNamespace, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *DescribeScalableTargetsInput) SetMaxResults(v int64) *DescribeScalableTargetsInput {
 	s.MaxResults = &v
@@ -1574,6 +1673,33 @@ func (s *DescribeScalingActivitiesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeScalingActivitiesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeScalingActivitiesInput"}
+	if s.ResourceId != nil && len(*s.ResourceId) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 1600))
+	}
+	if s.ScalableDimension != nil {
+		values := []string{"ecs:service:DesiredCount", "ec2:spot-fleet-request:TargetCapacity", "elasticmapreduce:instancegroup:InstanceCount", "appstream:fleet:DesiredCapacity", "dynamodb:table:ReadCapacityUnits", "dynamodb:table:WriteCapacityUnits", "dynamodb:index:ReadCapacityUnits", "dynamodb:index:WriteCapacityUnits"}
+		if !request.IsParamEnumValue(*s.ScalableDimension, values) {
+			invalidParams.Add(request.NewErrParamEnum("ScalableDimension", *s.ScalableDimension, values))
+		}
+	}
+	if s.ServiceNamespace != nil {
+		values := []string{"ecs", "elasticmapreduce", "ec2", "appstream", "dynamodb"}
+		if !request.IsParamEnumValue(*s.ServiceNamespace, values) {
+			invalidParams.Add(request.NewErrParamEnum("ServiceNamespace", *s.ServiceNamespace, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *DescribeScalingActivitiesInput) SetMaxResults(v int64) *DescribeScalingActivitiesInput {
 	s.MaxResults = &v
@@ -1742,6 +1868,33 @@ func (s *DescribeScalingPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeScalingPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeScalingPoliciesInput"}
+	if s.ResourceId != nil && len(*s.ResourceId) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 1600))
+	}
+	if s.ScalableDimension != nil {
+		values := []string{"ecs:service:DesiredCount", "ec2:spot-fleet-request:TargetCapacity", "elasticmapreduce:instancegroup:InstanceCount", "appstream:fleet:DesiredCapacity", "dynamodb:table:ReadCapacityUnits", "dynamodb:table:WriteCapacityUnits", "dynamodb:index:ReadCapacityUnits", "dynamodb:index:WriteCapacityUnits"}
+		if !request.IsParamEnumValue(*s.ScalableDimension, values) {
+			invalidParams.Add(request.NewErrParamEnum("ScalableDimension", *s.ScalableDimension, values))
+		}
+	}
+	if s.ServiceNamespace != nil {
+		values := []string{"ecs", "elasticmapreduce", "ec2", "appstream", "dynamodb"}
+		if !request.IsParamEnumValue(*s.ServiceNamespace, values) {
+			invalidParams.Add(request.NewErrParamEnum("ServiceNamespace", *s.ServiceNamespace, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *DescribeScalingPoliciesInput) SetMaxResults(v int64) *DescribeScalingPoliciesInput {
 	s.MaxResults = &v
@@ -1906,6 +2059,27 @@ func (s *PredefinedMetricSpecification) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PredefinedMetricSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PredefinedMetricSpecification"}
+	if s.PredefinedMetricType != nil {
+		values := []string{"DynamoDBReadCapacityUtilization", "DynamoDBWriteCapacityUtilization"}
+		if !request.IsParamEnumValue(*s.PredefinedMetricType, values) {
+			invalidParams.Add(request.NewErrParamEnum("PredefinedMetricType", *s.PredefinedMetricType, values))
+		}
+	}
+	if s.ResourceLabel != nil && len(*s.ResourceLabel) > 1023 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceLabel", 1023))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPredefinedMetricType sets the PredefinedMetricType field's value.
 func (s *PredefinedMetricSpecification) SetPredefinedMetricType(v string) *PredefinedMetricSpecification {
 	s.PredefinedMetricType = &v
@@ -2055,6 +2229,52 @@ func (s *PutScalingPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutScalingPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutScalingPolicyInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 256))
+	}
+	if s.PolicyType != nil {
+		values := []string{"StepScaling", "TargetTrackingScaling"}
+		if !request.IsParamEnumValue(*s.PolicyType, values) {
+			invalidParams.Add(request.NewErrParamEnum("PolicyType", *s.PolicyType, values))
+		}
+	}
+	if s.ResourceId != nil && len(*s.ResourceId) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 1600))
+	}
+	if s.ScalableDimension != nil {
+		values := []string{"ecs:service:DesiredCount", "ec2:spot-fleet-request:TargetCapacity", "elasticmapreduce:instancegroup:InstanceCount", "appstream:fleet:DesiredCapacity", "dynamodb:table:ReadCapacityUnits", "dynamodb:table:WriteCapacityUnits", "dynamodb:index:ReadCapacityUnits", "dynamodb:index:WriteCapacityUnits"}
+		if !request.IsParamEnumValue(*s.ScalableDimension, values) {
+			invalidParams.Add(request.NewErrParamEnum("ScalableDimension", *s.ScalableDimension, values))
+		}
+	}
+	if s.ServiceNamespace != nil {
+		values := []string{"ecs", "elasticmapreduce", "ec2", "appstream", "dynamodb"}
+		if !request.IsParamEnumValue(*s.ServiceNamespace, values) {
+			invalidParams.Add(request.NewErrParamEnum("ServiceNamespace", *s.ServiceNamespace, values))
+		}
+	}
+	if s.StepScalingPolicyConfiguration != nil {
+		if err := s.StepScalingPolicyConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StepScalingPolicyConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TargetTrackingScalingPolicyConfiguration != nil {
+		if err := s.TargetTrackingScalingPolicyConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TargetTrackingScalingPolicyConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyName sets the PolicyName field's value.
 func (s *PutScalingPolicyInput) SetPolicyName(v string) *PutScalingPolicyInput {
 	s.PolicyName = &v
@@ -2247,6 +2467,36 @@ func (s *RegisterScalableTargetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterScalableTargetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterScalableTargetInput"}
+	if s.ResourceId != nil && len(*s.ResourceId) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 1600))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 1600))
+	}
+	if s.ScalableDimension != nil {
+		values := []string{"ecs:service:DesiredCount", "ec2:spot-fleet-request:TargetCapacity", "elasticmapreduce:instancegroup:InstanceCount", "appstream:fleet:DesiredCapacity", "dynamodb:table:ReadCapacityUnits", "dynamodb:table:WriteCapacityUnits", "dynamodb:index:ReadCapacityUnits", "dynamodb:index:WriteCapacityUnits"}
+		if !request.IsParamEnumValue(*s.ScalableDimension, values) {
+			invalidParams.Add(request.NewErrParamEnum("ScalableDimension", *s.ScalableDimension, values))
+		}
+	}
+	if s.ServiceNamespace != nil {
+		values := []string{"ecs", "elasticmapreduce", "ec2", "appstream", "dynamodb"}
+		if !request.IsParamEnumValue(*s.ServiceNamespace, values) {
+			invalidParams.Add(request.NewErrParamEnum("ServiceNamespace", *s.ServiceNamespace, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxCapacity sets the MaxCapacity field's value.
 func (s *RegisterScalableTargetInput) SetMaxCapacity(v int64) *RegisterScalableTargetInput {
 	s.MaxCapacity = &v
@@ -2952,6 +3202,30 @@ func (s *StepScalingPolicyConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StepScalingPolicyConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StepScalingPolicyConfiguration"}
+	if s.AdjustmentType != nil {
+		values := []string{"ChangeInCapacity", "PercentChangeInCapacity", "ExactCapacity"}
+		if !request.IsParamEnumValue(*s.AdjustmentType, values) {
+			invalidParams.Add(request.NewErrParamEnum("AdjustmentType", *s.AdjustmentType, values))
+		}
+	}
+	if s.MetricAggregationType != nil {
+		values := []string{"Average", "Minimum", "Maximum"}
+		if !request.IsParamEnumValue(*s.MetricAggregationType, values) {
+			invalidParams.Add(request.NewErrParamEnum("MetricAggregationType", *s.MetricAggregationType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdjustmentType sets the AdjustmentType field's value.
 func (s *StepScalingPolicyConfiguration) SetAdjustmentType(v string) *StepScalingPolicyConfiguration {
 	s.AdjustmentType = &v
@@ -3059,6 +3333,28 @@ func (s *TargetTrackingScalingPolicyConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TargetTrackingScalingPolicyConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TargetTrackingScalingPolicyConfiguration"}
+	if s.CustomizedMetricSpecification != nil {
+		if err := s.CustomizedMetricSpecification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CustomizedMetricSpecification", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.PredefinedMetricSpecification != nil {
+		if err := s.PredefinedMetricSpecification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("PredefinedMetricSpecification", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCustomizedMetricSpecification sets the CustomizedMetricSpecification field's value.
 func (s *TargetTrackingScalingPolicyConfiguration) SetCustomizedMetricSpecification(v *CustomizedMetricSpecification) *TargetTrackingScalingPolicyConfiguration {
 	s.CustomizedMetricSpecification = v
@@ -3581,6 +3581,34 @@ func (s *ListConfigurationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListConfigurationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListConfigurationsInput"}
+	if s.ConfigurationType != nil {
+		values := []string{"SERVER", "PROCESS", "CONNECTION", "APPLICATION"}
+		if !request.IsParamEnumValue(*s.ConfigurationType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConfigurationType", *s.ConfigurationType, values))
+		}
+	}
+	if s.OrderBy != nil {
+		for i, v := range s.OrderBy {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OrderBy", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigurationType sets the ConfigurationType field's value.
 func (s *ListConfigurationsInput) SetConfigurationType(v string) *ListConfigurationsInput {
 	s.ConfigurationType = &v
@@ -3879,6 +3907,24 @@ func (s *OrderByElement) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *OrderByElement) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "OrderByElement"}
+	if s.SortOrder != nil {
+		values := []string{"ASC", "DESC"}
+		if !request.IsParamEnumValue(*s.SortOrder, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortOrder", *s.SortOrder, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFieldName sets the FieldName field's value.
 func (s *OrderByElement) SetFieldName(v string) *OrderByElement {
 	s.FieldName = &v
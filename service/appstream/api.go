@@ -130,9 +130,10 @@ const opCreateDirectoryConfig = "CreateDirectoryConfig"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/appstream-2016-12-01/CreateDirectoryConfig
 func (c *AppStream) CreateDirectoryConfigRequest(input *CreateDirectoryConfigInput) (req *request.Request, output *CreateDirectoryConfigOutput) {
 	op := &request.Operation{
-		Name:       opCreateDirectoryConfig,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opCreateDirectoryConfig,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"DirectoryConfig.ServiceAccountCredentials.AccountName", "DirectoryConfig.ServiceAccountCredentials.AccountPassword"},
 	}
 
 	if input == nil {
@@ -748,9 +749,10 @@ const opDescribeDirectoryConfigs = "DescribeDirectoryConfigs"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/appstream-2016-12-01/DescribeDirectoryConfigs
 func (c *AppStream) DescribeDirectoryConfigsRequest(input *DescribeDirectoryConfigsInput) (req *request.Request, output *DescribeDirectoryConfigsOutput) {
 	op := &request.Operation{
-		Name:       opDescribeDirectoryConfigs,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeDirectoryConfigs,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"DirectoryConfigs[*].ServiceAccountCredentials.AccountName", "DirectoryConfigs[*].ServiceAccountCredentials.AccountPassword"},
 	}
 
 	if input == nil {
@@ -1629,9 +1631,10 @@ const opUpdateDirectoryConfig = "UpdateDirectoryConfig"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/appstream-2016-12-01/UpdateDirectoryConfig
 func (c *AppStream) UpdateDirectoryConfigRequest(input *UpdateDirectoryConfigInput) (req *request.Request, output *UpdateDirectoryConfigOutput) {
 	op := &request.Operation{
-		Name:       opUpdateDirectoryConfig,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opUpdateDirectoryConfig,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"DirectoryConfig.ServiceAccountCredentials.AccountName", "DirectoryConfig.ServiceAccountCredentials.AccountPassword"},
 	}
 
 	if input == nil {
@@ -2189,6 +2192,23 @@ func (s *CreateDirectoryConfigInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDirectoryConfigInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDirectoryConfigInput"}
+	if s.ServiceAccountCredentials != nil {
+		if err := s.ServiceAccountCredentials.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ServiceAccountCredentials", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryName sets the DirectoryName field's value.
 func (s *CreateDirectoryConfigInput) SetDirectoryName(v string) *CreateDirectoryConfigInput {
 	s.DirectoryName = &v
@@ -2369,6 +2389,43 @@ func (s *CreateFleetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateFleetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateFleetInput"}
+	if s.Description != nil && len(*s.Description) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 256))
+	}
+	if s.DisplayName != nil && len(*s.DisplayName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DisplayName", 100))
+	}
+	if s.FleetType != nil {
+		values := []string{"ALWAYS_ON", "ON_DEMAND"}
+		if !request.IsParamEnumValue(*s.FleetType, values) {
+			invalidParams.Add(request.NewErrParamEnum("FleetType", *s.FleetType, values))
+		}
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,100}$"))
+	}
+	if s.DomainJoinInfo != nil {
+		if err := s.DomainJoinInfo.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DomainJoinInfo", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.VpcConfig != nil {
+		if err := s.VpcConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("VpcConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComputeCapacity sets the ComputeCapacity field's value.
 func (s *CreateFleetInput) SetComputeCapacity(v *ComputeCapacity) *CreateFleetInput {
 	s.ComputeCapacity = v
@@ -2520,6 +2577,34 @@ func (s *CreateStackInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateStackInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateStackInput"}
+	if s.Description != nil && len(*s.Description) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 256))
+	}
+	if s.DisplayName != nil && len(*s.DisplayName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DisplayName", 100))
+	}
+	if s.StorageConnectors != nil {
+		for i, v := range s.StorageConnectors {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "StorageConnectors", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *CreateStackInput) SetDescription(v string) *CreateStackInput {
 	s.Description = &v
@@ -2642,6 +2727,24 @@ func (s *CreateStreamingURLInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateStreamingURLInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateStreamingURLInput"}
+	if s.UserId != nil && len(*s.UserId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserId", 32))
+	}
+	if s.UserId != nil && !request.MatchParamPattern("[\\w+=,.@-]*", *s.UserId) {
+		invalidParams.Add(request.NewErrParamPattern("UserId", "[\\w+=,.@-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationId sets the ApplicationId field's value.
 func (s *CreateStreamingURLInput) SetApplicationId(v string) *CreateStreamingURLInput {
 	s.ApplicationId = &v
@@ -3170,6 +3273,27 @@ func (s *DescribeSessionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeSessionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeSessionsInput"}
+	if s.AuthenticationType != nil {
+		values := []string{"API", "SAML", "USERPOOL"}
+		if !request.IsParamEnumValue(*s.AuthenticationType, values) {
+			invalidParams.Add(request.NewErrParamEnum("AuthenticationType", *s.AuthenticationType, values))
+		}
+	}
+	if s.UserId != nil && len(*s.UserId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserId", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationType sets the AuthenticationType field's value.
 func (s *DescribeSessionsInput) SetAuthenticationType(v string) *DescribeSessionsInput {
 	s.AuthenticationType = &v
@@ -3478,6 +3602,21 @@ func (s DomainJoinInfo) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DomainJoinInfo) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DomainJoinInfo"}
+	if s.OrganizationalUnitDistinguishedName != nil && len(*s.OrganizationalUnitDistinguishedName) > 2000 {
+		invalidParams.Add(request.NewErrParamMaxLen("OrganizationalUnitDistinguishedName", 2000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryName sets the DirectoryName field's value.
 func (s *DomainJoinInfo) SetDirectoryName(v string) *DomainJoinInfo {
 	s.DirectoryName = &v
@@ -4122,12 +4261,12 @@ type ServiceAccountCredentials struct {
 	// units specified.
 	//
 	// AccountName is a required field
-	AccountName *string `min:"1" type:"string" required:"true"`
+	AccountName *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The password for the user account for directory actions.
 	//
 	// AccountPassword is a required field
-	AccountPassword *string `min:"1" type:"string" required:"true"`
+	AccountPassword *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -4162,6 +4301,21 @@ func (s *ServiceAccountCredentials) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ServiceAccountCredentials) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ServiceAccountCredentials"}
+	if s.AccountPassword != nil && len(*s.AccountPassword) > 127 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountPassword", 127))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountName sets the AccountName field's value.
 func (s *ServiceAccountCredentials) SetAccountName(v string) *ServiceAccountCredentials {
 	s.AccountName = &v
@@ -4525,6 +4679,24 @@ func (s *StorageConnector) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StorageConnector) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StorageConnector"}
+	if s.ConnectorType != nil {
+		values := []string{"HOMEFOLDERS"}
+		if !request.IsParamEnumValue(*s.ConnectorType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConnectorType", *s.ConnectorType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConnectorType sets the ConnectorType field's value.
 func (s *StorageConnector) SetConnectorType(v string) *StorageConnector {
 	s.ConnectorType = &v
@@ -4583,6 +4755,23 @@ func (s *UpdateDirectoryConfigInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDirectoryConfigInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDirectoryConfigInput"}
+	if s.ServiceAccountCredentials != nil {
+		if err := s.ServiceAccountCredentials.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ServiceAccountCredentials", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryName sets the DirectoryName field's value.
 func (s *UpdateDirectoryConfigInput) SetDirectoryName(v string) *UpdateDirectoryConfigInput {
 	s.DirectoryName = &v
@@ -4754,6 +4943,34 @@ func (s *UpdateFleetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateFleetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateFleetInput"}
+	if s.Description != nil && len(*s.Description) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 256))
+	}
+	if s.DisplayName != nil && len(*s.DisplayName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DisplayName", 100))
+	}
+	if s.DomainJoinInfo != nil {
+		if err := s.DomainJoinInfo.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DomainJoinInfo", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.VpcConfig != nil {
+		if err := s.VpcConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("VpcConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributesToDelete sets the AttributesToDelete field's value.
 func (s *UpdateFleetInput) SetAttributesToDelete(v []*string) *UpdateFleetInput {
 	s.AttributesToDelete = v
@@ -4914,6 +5131,34 @@ func (s *UpdateStackInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateStackInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateStackInput"}
+	if s.Description != nil && len(*s.Description) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 256))
+	}
+	if s.DisplayName != nil && len(*s.DisplayName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DisplayName", 100))
+	}
+	if s.StorageConnectors != nil {
+		for i, v := range s.StorageConnectors {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "StorageConnectors", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeleteStorageConnectors sets the DeleteStorageConnectors field's value.
 func (s *UpdateStackInput) SetDeleteStorageConnectors(v bool) *UpdateStackInput {
 	s.DeleteStorageConnectors = &v
@@ -4991,6 +5236,21 @@ func (s VpcConfig) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *VpcConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "VpcConfig"}
+	if s.SecurityGroupIds != nil && len(s.SecurityGroupIds) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("SecurityGroupIds", 5))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSecurityGroupIds sets the SecurityGroupIds field's value.
 func (s *VpcConfig) SetSecurityGroupIds(v []*string) *VpcConfig {
 	s.SecurityGroupIds = v
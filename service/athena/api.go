@@ -1178,6 +1178,21 @@ func (s *BatchGetNamedQueryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchGetNamedQueryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchGetNamedQueryInput"}
+	if s.NamedQueryIds != nil && len(s.NamedQueryIds) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("NamedQueryIds", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNamedQueryIds sets the NamedQueryIds field's value.
 func (s *BatchGetNamedQueryInput) SetNamedQueryIds(v []*string) *BatchGetNamedQueryInput {
 	s.NamedQueryIds = v
@@ -1253,6 +1268,21 @@ func (s *BatchGetQueryExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchGetQueryExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchGetQueryExecutionInput"}
+	if s.QueryExecutionIds != nil && len(s.QueryExecutionIds) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("QueryExecutionIds", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetQueryExecutionIds sets the QueryExecutionIds field's value.
 func (s *BatchGetQueryExecutionInput) SetQueryExecutionIds(v []*string) *BatchGetQueryExecutionInput {
 	s.QueryExecutionIds = v
@@ -1481,6 +1511,33 @@ func (s *CreateNamedQueryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateNamedQueryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateNamedQueryInput"}
+	if s.ClientRequestToken != nil && len(*s.ClientRequestToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientRequestToken", 128))
+	}
+	if s.Database != nil && len(*s.Database) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Database", 32))
+	}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.QueryString != nil && len(*s.QueryString) > 262144 {
+		invalidParams.Add(request.NewErrParamMaxLen("QueryString", 262144))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientRequestToken sets the ClientRequestToken field's value.
 func (s *CreateNamedQueryInput) SetClientRequestToken(v string) *CreateNamedQueryInput {
 	s.ClientRequestToken = &v
@@ -1654,6 +1711,24 @@ func (s *EncryptionConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EncryptionConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EncryptionConfiguration"}
+	if s.EncryptionOption != nil {
+		values := []string{"SSE_S3", "SSE_KMS", "CSE_KMS"}
+		if !request.IsParamEnumValue(*s.EncryptionOption, values) {
+			invalidParams.Add(request.NewErrParamEnum("EncryptionOption", *s.EncryptionOption, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEncryptionOption sets the EncryptionOption field's value.
 func (s *EncryptionConfiguration) SetEncryptionOption(v string) *EncryptionConfiguration {
 	s.EncryptionOption = &v
@@ -1832,6 +1907,21 @@ func (s *GetQueryResultsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetQueryResultsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetQueryResultsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *GetQueryResultsInput) SetMaxResults(v int64) *GetQueryResultsInput {
 	s.MaxResults = &v
@@ -1905,6 +1995,21 @@ func (s ListNamedQueriesInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListNamedQueriesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListNamedQueriesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListNamedQueriesInput) SetMaxResults(v int64) *ListNamedQueriesInput {
 	s.MaxResults = &v
@@ -1972,6 +2077,21 @@ func (s ListQueryExecutionsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListQueryExecutionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListQueryExecutionsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListQueryExecutionsInput) SetMaxResults(v int64) *ListQueryExecutionsInput {
 	s.MaxResults = &v
@@ -2190,6 +2310,21 @@ func (s *QueryExecutionContext) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *QueryExecutionContext) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "QueryExecutionContext"}
+	if s.Database != nil && len(*s.Database) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Database", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDatabase sets the Database field's value.
 func (s *QueryExecutionContext) SetDatabase(v string) *QueryExecutionContext {
 	s.Database = &v
@@ -2332,6 +2467,23 @@ func (s *ResultConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResultConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResultConfiguration"}
+	if s.EncryptionConfiguration != nil {
+		if err := s.EncryptionConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("EncryptionConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEncryptionConfiguration sets the EncryptionConfiguration field's value.
 func (s *ResultConfiguration) SetEncryptionConfiguration(v *EncryptionConfiguration) *ResultConfiguration {
 	s.EncryptionConfiguration = v
@@ -2502,6 +2654,34 @@ func (s *StartQueryExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartQueryExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartQueryExecutionInput"}
+	if s.ClientRequestToken != nil && len(*s.ClientRequestToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientRequestToken", 128))
+	}
+	if s.QueryString != nil && len(*s.QueryString) > 262144 {
+		invalidParams.Add(request.NewErrParamMaxLen("QueryString", 262144))
+	}
+	if s.QueryExecutionContext != nil {
+		if err := s.QueryExecutionContext.ValidateExtended(); err != nil {
+			invalidParams.AddNested("QueryExecutionContext", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ResultConfiguration != nil {
+		if err := s.ResultConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ResultConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientRequestToken sets the ClientRequestToken field's value.
 func (s *StartQueryExecutionInput) SetClientRequestToken(v string) *StartQueryExecutionInput {
 	s.ClientRequestToken = &v
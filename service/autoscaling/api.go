@@ -5275,6 +5275,21 @@ func (s *AttachInstancesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttachInstancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttachInstancesInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *AttachInstancesInput) SetAutoScalingGroupName(v string) *AttachInstancesInput {
 	s.AutoScalingGroupName = &v
@@ -5346,6 +5361,21 @@ func (s *AttachLoadBalancerTargetGroupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttachLoadBalancerTargetGroupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttachLoadBalancerTargetGroupsInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *AttachLoadBalancerTargetGroupsInput) SetAutoScalingGroupName(v string) *AttachLoadBalancerTargetGroupsInput {
 	s.AutoScalingGroupName = &v
@@ -5417,6 +5447,21 @@ func (s *AttachLoadBalancersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttachLoadBalancersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttachLoadBalancersInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *AttachLoadBalancersInput) SetAutoScalingGroupName(v string) *AttachLoadBalancersInput {
 	s.AutoScalingGroupName = &v
@@ -5502,6 +5547,29 @@ func (s *BlockDeviceMapping) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BlockDeviceMapping) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BlockDeviceMapping"}
+	if s.DeviceName != nil && len(*s.DeviceName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeviceName", 255))
+	}
+	if s.VirtualName != nil && len(*s.VirtualName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("VirtualName", 255))
+	}
+	if s.Ebs != nil {
+		if err := s.Ebs.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Ebs", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeviceName sets the DeviceName field's value.
 func (s *BlockDeviceMapping) SetDeviceName(v string) *BlockDeviceMapping {
 	s.DeviceName = &v
@@ -5596,6 +5664,33 @@ func (s *CompleteLifecycleActionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CompleteLifecycleActionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CompleteLifecycleActionInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+	if s.InstanceId != nil && len(*s.InstanceId) > 19 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceId", 19))
+	}
+	if s.LifecycleActionToken != nil && len(*s.LifecycleActionToken) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("LifecycleActionToken", 36))
+	}
+	if s.LifecycleHookName != nil && len(*s.LifecycleHookName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("LifecycleHookName", 255))
+	}
+	if s.LifecycleHookName != nil && !request.MatchParamPattern("[A-Za-z0-9\\-_\\/]+", *s.LifecycleHookName) {
+		invalidParams.Add(request.NewErrParamPattern("LifecycleHookName", "[A-Za-z0-9\\-_\\/]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *CompleteLifecycleActionInput) SetAutoScalingGroupName(v string) *CompleteLifecycleActionInput {
 	s.AutoScalingGroupName = &v
@@ -5831,6 +5926,56 @@ func (s *CreateAutoScalingGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAutoScalingGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAutoScalingGroupInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 255))
+	}
+	if s.HealthCheckType != nil && len(*s.HealthCheckType) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HealthCheckType", 32))
+	}
+	if s.InstanceId != nil && len(*s.InstanceId) > 19 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceId", 19))
+	}
+	if s.LaunchConfigurationName != nil && len(*s.LaunchConfigurationName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("LaunchConfigurationName", 1600))
+	}
+	if s.PlacementGroup != nil && len(*s.PlacementGroup) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("PlacementGroup", 255))
+	}
+	if s.VPCZoneIdentifier != nil && len(*s.VPCZoneIdentifier) > 2047 {
+		invalidParams.Add(request.NewErrParamMaxLen("VPCZoneIdentifier", 2047))
+	}
+	if s.LifecycleHookSpecificationList != nil {
+		for i, v := range s.LifecycleHookSpecificationList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "LifecycleHookSpecificationList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *CreateAutoScalingGroupInput) SetAutoScalingGroupName(v string) *CreateAutoScalingGroupInput {
 	s.AutoScalingGroupName = &v
@@ -6168,6 +6313,64 @@ func (s *CreateLaunchConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateLaunchConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateLaunchConfigurationInput"}
+	if s.ClassicLinkVPCId != nil && len(*s.ClassicLinkVPCId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClassicLinkVPCId", 255))
+	}
+	if s.IamInstanceProfile != nil && len(*s.IamInstanceProfile) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("IamInstanceProfile", 1600))
+	}
+	if s.ImageId != nil && len(*s.ImageId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("ImageId", 255))
+	}
+	if s.InstanceId != nil && len(*s.InstanceId) > 19 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceId", 19))
+	}
+	if s.InstanceType != nil && len(*s.InstanceType) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceType", 255))
+	}
+	if s.KernelId != nil && len(*s.KernelId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("KernelId", 255))
+	}
+	if s.KeyName != nil && len(*s.KeyName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyName", 255))
+	}
+	if s.LaunchConfigurationName != nil && len(*s.LaunchConfigurationName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("LaunchConfigurationName", 255))
+	}
+	if s.PlacementTenancy != nil && len(*s.PlacementTenancy) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("PlacementTenancy", 64))
+	}
+	if s.RamdiskId != nil && len(*s.RamdiskId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("RamdiskId", 255))
+	}
+	if s.SpotPrice != nil && len(*s.SpotPrice) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("SpotPrice", 255))
+	}
+	if s.UserData != nil && len(*s.UserData) > 21847 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserData", 21847))
+	}
+	if s.BlockDeviceMappings != nil {
+		for i, v := range s.BlockDeviceMappings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "BlockDeviceMappings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssociatePublicIpAddress sets the AssociatePublicIpAddress field's value.
 func (s *CreateLaunchConfigurationInput) SetAssociatePublicIpAddress(v bool) *CreateLaunchConfigurationInput {
 	s.AssociatePublicIpAddress = &v
@@ -6334,6 +6537,28 @@ func (s *CreateOrUpdateTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateOrUpdateTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateOrUpdateTagsInput"}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTags sets the Tags field's value.
 func (s *CreateOrUpdateTagsInput) SetTags(v []*Tag) *CreateOrUpdateTagsInput {
 	s.Tags = v
@@ -6421,6 +6646,24 @@ func (s *CustomizedMetricSpecification) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CustomizedMetricSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CustomizedMetricSpecification"}
+	if s.Statistic != nil {
+		values := []string{"Average", "Minimum", "Maximum", "SampleCount", "Sum"}
+		if !request.IsParamEnumValue(*s.Statistic, values) {
+			invalidParams.Add(request.NewErrParamEnum("Statistic", *s.Statistic, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDimensions sets the Dimensions field's value.
 func (s *CustomizedMetricSpecification) SetDimensions(v []*MetricDimension) *CustomizedMetricSpecification {
 	s.Dimensions = v
@@ -6492,6 +6735,21 @@ func (s *DeleteAutoScalingGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteAutoScalingGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteAutoScalingGroupInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *DeleteAutoScalingGroupInput) SetAutoScalingGroupName(v string) *DeleteAutoScalingGroupInput {
 	s.AutoScalingGroupName = &v
@@ -6555,6 +6813,21 @@ func (s *DeleteLaunchConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteLaunchConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteLaunchConfigurationInput"}
+	if s.LaunchConfigurationName != nil && len(*s.LaunchConfigurationName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("LaunchConfigurationName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLaunchConfigurationName sets the LaunchConfigurationName field's value.
 func (s *DeleteLaunchConfigurationInput) SetLaunchConfigurationName(v string) *DeleteLaunchConfigurationInput {
 	s.LaunchConfigurationName = &v
@@ -6623,6 +6896,27 @@ func (s *DeleteLifecycleHookInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteLifecycleHookInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteLifecycleHookInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+	if s.LifecycleHookName != nil && len(*s.LifecycleHookName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("LifecycleHookName", 255))
+	}
+	if s.LifecycleHookName != nil && !request.MatchParamPattern("[A-Za-z0-9\\-_\\/]+", *s.LifecycleHookName) {
+		invalidParams.Add(request.NewErrParamPattern("LifecycleHookName", "[A-Za-z0-9\\-_\\/]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *DeleteLifecycleHookInput) SetAutoScalingGroupName(v string) *DeleteLifecycleHookInput {
 	s.AutoScalingGroupName = &v
@@ -6698,6 +6992,24 @@ func (s *DeleteNotificationConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteNotificationConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteNotificationConfigurationInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+	if s.TopicARN != nil && len(*s.TopicARN) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("TopicARN", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *DeleteNotificationConfigurationInput) SetAutoScalingGroupName(v string) *DeleteNotificationConfigurationInput {
 	s.AutoScalingGroupName = &v
@@ -6767,6 +7079,24 @@ func (s *DeletePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeletePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePolicyInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+	if s.PolicyName != nil && len(*s.PolicyName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *DeletePolicyInput) SetAutoScalingGroupName(v string) *DeletePolicyInput {
 	s.AutoScalingGroupName = &v
@@ -6841,6 +7171,24 @@ func (s *DeleteScheduledActionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteScheduledActionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteScheduledActionInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+	if s.ScheduledActionName != nil && len(*s.ScheduledActionName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("ScheduledActionName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *DeleteScheduledActionInput) SetAutoScalingGroupName(v string) *DeleteScheduledActionInput {
 	s.AutoScalingGroupName = &v
@@ -6911,6 +7259,28 @@ func (s *DeleteTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTagsInput"}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTags sets the Tags field's value.
 func (s *DeleteTagsInput) SetTags(v []*Tag) *DeleteTagsInput {
 	s.Tags = v
@@ -7399,6 +7769,24 @@ func (s *DescribeLifecycleHooksInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeLifecycleHooksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeLifecycleHooksInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+	if s.LifecycleHookNames != nil && len(s.LifecycleHookNames) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("LifecycleHookNames", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *DescribeLifecycleHooksInput) SetAutoScalingGroupName(v string) *DescribeLifecycleHooksInput {
 	s.AutoScalingGroupName = &v
@@ -7479,6 +7867,21 @@ func (s *DescribeLoadBalancerTargetGroupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeLoadBalancerTargetGroupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeLoadBalancerTargetGroupsInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *DescribeLoadBalancerTargetGroupsInput) SetAutoScalingGroupName(v string) *DescribeLoadBalancerTargetGroupsInput {
 	s.AutoScalingGroupName = &v
@@ -7575,6 +7978,21 @@ func (s *DescribeLoadBalancersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeLoadBalancersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeLoadBalancersInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *DescribeLoadBalancersInput) SetAutoScalingGroupName(v string) *DescribeLoadBalancersInput {
 	s.AutoScalingGroupName = &v
@@ -7803,6 +8221,21 @@ func (s *DescribePoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribePoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribePoliciesInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *DescribePoliciesInput) SetAutoScalingGroupName(v string) *DescribePoliciesInput {
 	s.AutoScalingGroupName = &v
@@ -7913,6 +8346,21 @@ func (s *DescribeScalingActivitiesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeScalingActivitiesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeScalingActivitiesInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActivityIds sets the ActivityIds field's value.
 func (s *DescribeScalingActivitiesInput) SetActivityIds(v []*string) *DescribeScalingActivitiesInput {
 	s.ActivityIds = v
@@ -8069,6 +8517,21 @@ func (s *DescribeScheduledActionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeScheduledActionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeScheduledActionsInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *DescribeScheduledActionsInput) SetAutoScalingGroupName(v string) *DescribeScheduledActionsInput {
 	s.AutoScalingGroupName = &v
@@ -8305,6 +8768,21 @@ func (s *DetachInstancesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetachInstancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetachInstancesInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *DetachInstancesInput) SetAutoScalingGroupName(v string) *DetachInstancesInput {
 	s.AutoScalingGroupName = &v
@@ -8391,6 +8869,21 @@ func (s *DetachLoadBalancerTargetGroupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetachLoadBalancerTargetGroupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetachLoadBalancerTargetGroupsInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *DetachLoadBalancerTargetGroupsInput) SetAutoScalingGroupName(v string) *DetachLoadBalancerTargetGroupsInput {
 	s.AutoScalingGroupName = &v
@@ -8462,6 +8955,21 @@ func (s *DetachLoadBalancersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetachLoadBalancersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetachLoadBalancersInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *DetachLoadBalancersInput) SetAutoScalingGroupName(v string) *DetachLoadBalancersInput {
 	s.AutoScalingGroupName = &v
@@ -8545,6 +9053,21 @@ func (s *DisableMetricsCollectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisableMetricsCollectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableMetricsCollectionInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *DisableMetricsCollectionInput) SetAutoScalingGroupName(v string) *DisableMetricsCollectionInput {
 	s.AutoScalingGroupName = &v
@@ -8649,6 +9172,30 @@ func (s *Ebs) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Ebs) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Ebs"}
+	if s.Iops != nil && *s.Iops > 20000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Iops", 20000))
+	}
+	if s.SnapshotId != nil && len(*s.SnapshotId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("SnapshotId", 255))
+	}
+	if s.VolumeSize != nil && *s.VolumeSize > 16384 {
+		invalidParams.Add(request.NewErrParamMaxValue("VolumeSize", 16384))
+	}
+	if s.VolumeType != nil && len(*s.VolumeType) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("VolumeType", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeleteOnTermination sets the DeleteOnTermination field's value.
 func (s *Ebs) SetDeleteOnTermination(v bool) *Ebs {
 	s.DeleteOnTermination = &v
@@ -8753,6 +9300,24 @@ func (s *EnableMetricsCollectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnableMetricsCollectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableMetricsCollectionInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+	if s.Granularity != nil && len(*s.Granularity) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Granularity", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *EnableMetricsCollectionInput) SetAutoScalingGroupName(v string) *EnableMetricsCollectionInput {
 	s.AutoScalingGroupName = &v
@@ -8887,6 +9452,21 @@ func (s *EnterStandbyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnterStandbyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnterStandbyInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *EnterStandbyInput) SetAutoScalingGroupName(v string) *EnterStandbyInput {
 	s.AutoScalingGroupName = &v
@@ -9000,6 +9580,24 @@ func (s *ExecutePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ExecutePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ExecutePolicyInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+	if s.PolicyName != nil && len(*s.PolicyName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *ExecutePolicyInput) SetAutoScalingGroupName(v string) *ExecutePolicyInput {
 	s.AutoScalingGroupName = &v
@@ -9084,6 +9682,21 @@ func (s *ExitStandbyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ExitStandbyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ExitStandbyInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *ExitStandbyInput) SetAutoScalingGroupName(v string) *ExitStandbyInput {
 	s.AutoScalingGroupName = &v
@@ -9994,6 +10607,33 @@ func (s *LifecycleHookSpecification) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LifecycleHookSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LifecycleHookSpecification"}
+	if s.LifecycleHookName != nil && len(*s.LifecycleHookName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("LifecycleHookName", 255))
+	}
+	if s.LifecycleHookName != nil && !request.MatchParamPattern("[A-Za-z0-9\\-_\\/]+", *s.LifecycleHookName) {
+		invalidParams.Add(request.NewErrParamPattern("LifecycleHookName", "[A-Za-z0-9\\-_\\/]+"))
+	}
+	if s.NotificationMetadata != nil && len(*s.NotificationMetadata) > 1023 {
+		invalidParams.Add(request.NewErrParamMaxLen("NotificationMetadata", 1023))
+	}
+	if s.NotificationTargetARN != nil && len(*s.NotificationTargetARN) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("NotificationTargetARN", 1600))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultResult sets the DefaultResult field's value.
 func (s *LifecycleHookSpecification) SetDefaultResult(v string) *LifecycleHookSpecification {
 	s.DefaultResult = &v
@@ -10388,6 +11028,27 @@ func (s *PredefinedMetricSpecification) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PredefinedMetricSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PredefinedMetricSpecification"}
+	if s.PredefinedMetricType != nil {
+		values := []string{"ASGAverageCPUUtilization", "ASGAverageNetworkIn", "ASGAverageNetworkOut", "ALBRequestCountPerTarget"}
+		if !request.IsParamEnumValue(*s.PredefinedMetricType, values) {
+			invalidParams.Add(request.NewErrParamEnum("PredefinedMetricType", *s.PredefinedMetricType, values))
+		}
+	}
+	if s.ResourceLabel != nil && len(*s.ResourceLabel) > 1023 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceLabel", 1023))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPredefinedMetricType sets the PredefinedMetricType field's value.
 func (s *PredefinedMetricSpecification) SetPredefinedMetricType(v string) *PredefinedMetricSpecification {
 	s.PredefinedMetricType = &v
@@ -10545,6 +11206,36 @@ func (s *PutLifecycleHookInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutLifecycleHookInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutLifecycleHookInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+	if s.LifecycleHookName != nil && len(*s.LifecycleHookName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("LifecycleHookName", 255))
+	}
+	if s.LifecycleHookName != nil && !request.MatchParamPattern("[A-Za-z0-9\\-_\\/]+", *s.LifecycleHookName) {
+		invalidParams.Add(request.NewErrParamPattern("LifecycleHookName", "[A-Za-z0-9\\-_\\/]+"))
+	}
+	if s.NotificationMetadata != nil && len(*s.NotificationMetadata) > 1023 {
+		invalidParams.Add(request.NewErrParamMaxLen("NotificationMetadata", 1023))
+	}
+	if s.NotificationTargetARN != nil && len(*s.NotificationTargetARN) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("NotificationTargetARN", 1600))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *PutLifecycleHookInput) SetAutoScalingGroupName(v string) *PutLifecycleHookInput {
 	s.AutoScalingGroupName = &v
@@ -10665,6 +11356,24 @@ func (s *PutNotificationConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutNotificationConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutNotificationConfigurationInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+	if s.TopicARN != nil && len(*s.TopicARN) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("TopicARN", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *PutNotificationConfigurationInput) SetAutoScalingGroupName(v string) *PutNotificationConfigurationInput {
 	s.AutoScalingGroupName = &v
@@ -10838,6 +11547,38 @@ func (s *PutScalingPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutScalingPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutScalingPolicyInput"}
+	if s.AdjustmentType != nil && len(*s.AdjustmentType) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("AdjustmentType", 255))
+	}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+	if s.MetricAggregationType != nil && len(*s.MetricAggregationType) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("MetricAggregationType", 32))
+	}
+	if s.PolicyName != nil && len(*s.PolicyName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 255))
+	}
+	if s.PolicyType != nil && len(*s.PolicyType) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyType", 64))
+	}
+	if s.TargetTrackingConfiguration != nil {
+		if err := s.TargetTrackingConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TargetTrackingConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdjustmentType sets the AdjustmentType field's value.
 func (s *PutScalingPolicyInput) SetAdjustmentType(v string) *PutScalingPolicyInput {
 	s.AdjustmentType = &v
@@ -11024,6 +11765,27 @@ func (s *PutScheduledUpdateGroupActionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutScheduledUpdateGroupActionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutScheduledUpdateGroupActionInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+	if s.Recurrence != nil && len(*s.Recurrence) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Recurrence", 255))
+	}
+	if s.ScheduledActionName != nil && len(*s.ScheduledActionName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("ScheduledActionName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *PutScheduledUpdateGroupActionInput) SetAutoScalingGroupName(v string) *PutScheduledUpdateGroupActionInput {
 	s.AutoScalingGroupName = &v
@@ -11154,6 +11916,33 @@ func (s *RecordLifecycleActionHeartbeatInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RecordLifecycleActionHeartbeatInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RecordLifecycleActionHeartbeatInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+	if s.InstanceId != nil && len(*s.InstanceId) > 19 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceId", 19))
+	}
+	if s.LifecycleActionToken != nil && len(*s.LifecycleActionToken) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("LifecycleActionToken", 36))
+	}
+	if s.LifecycleHookName != nil && len(*s.LifecycleHookName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("LifecycleHookName", 255))
+	}
+	if s.LifecycleHookName != nil && !request.MatchParamPattern("[A-Za-z0-9\\-_\\/]+", *s.LifecycleHookName) {
+		invalidParams.Add(request.NewErrParamPattern("LifecycleHookName", "[A-Za-z0-9\\-_\\/]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *RecordLifecycleActionHeartbeatInput) SetAutoScalingGroupName(v string) *RecordLifecycleActionHeartbeatInput {
 	s.AutoScalingGroupName = &v
@@ -11416,6 +12205,21 @@ func (s *ScalingProcessQuery) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ScalingProcessQuery) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ScalingProcessQuery"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *ScalingProcessQuery) SetAutoScalingGroupName(v string) *ScalingProcessQuery {
 	s.AutoScalingGroupName = &v
@@ -11589,6 +12393,21 @@ func (s *SetDesiredCapacityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetDesiredCapacityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetDesiredCapacityInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *SetDesiredCapacityInput) SetAutoScalingGroupName(v string) *SetDesiredCapacityInput {
 	s.AutoScalingGroupName = &v
@@ -11680,6 +12499,24 @@ func (s *SetInstanceHealthInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetInstanceHealthInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetInstanceHealthInput"}
+	if s.HealthStatus != nil && len(*s.HealthStatus) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HealthStatus", 32))
+	}
+	if s.InstanceId != nil && len(*s.InstanceId) > 19 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceId", 19))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHealthStatus sets the HealthStatus field's value.
 func (s *SetInstanceHealthInput) SetHealthStatus(v string) *SetInstanceHealthInput {
 	s.HealthStatus = &v
@@ -11766,6 +12603,21 @@ func (s *SetInstanceProtectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetInstanceProtectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetInstanceProtectionInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *SetInstanceProtectionInput) SetAutoScalingGroupName(v string) *SetInstanceProtectionInput {
 	s.AutoScalingGroupName = &v
@@ -11997,6 +12849,24 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -12147,6 +13017,28 @@ func (s *TargetTrackingConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TargetTrackingConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TargetTrackingConfiguration"}
+	if s.CustomizedMetricSpecification != nil {
+		if err := s.CustomizedMetricSpecification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CustomizedMetricSpecification", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.PredefinedMetricSpecification != nil {
+		if err := s.PredefinedMetricSpecification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("PredefinedMetricSpecification", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCustomizedMetricSpecification sets the CustomizedMetricSpecification field's value.
 func (s *TargetTrackingConfiguration) SetCustomizedMetricSpecification(v *CustomizedMetricSpecification) *TargetTrackingConfiguration {
 	s.CustomizedMetricSpecification = v
@@ -12216,6 +13108,21 @@ func (s *TerminateInstanceInAutoScalingGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TerminateInstanceInAutoScalingGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TerminateInstanceInAutoScalingGroupInput"}
+	if s.InstanceId != nil && len(*s.InstanceId) > 19 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceId", 19))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceId sets the InstanceId field's value.
 func (s *TerminateInstanceInAutoScalingGroupInput) SetInstanceId(v string) *TerminateInstanceInAutoScalingGroupInput {
 	s.InstanceId = &v
@@ -12366,6 +13273,33 @@ func (s *UpdateAutoScalingGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateAutoScalingGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateAutoScalingGroupInput"}
+	if s.AutoScalingGroupName != nil && len(*s.AutoScalingGroupName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroupName", 1600))
+	}
+	if s.HealthCheckType != nil && len(*s.HealthCheckType) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HealthCheckType", 32))
+	}
+	if s.LaunchConfigurationName != nil && len(*s.LaunchConfigurationName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("LaunchConfigurationName", 1600))
+	}
+	if s.PlacementGroup != nil && len(*s.PlacementGroup) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("PlacementGroup", 255))
+	}
+	if s.VPCZoneIdentifier != nil && len(*s.VPCZoneIdentifier) > 2047 {
+		invalidParams.Add(request.NewErrParamMaxLen("VPCZoneIdentifier", 2047))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroupName sets the AutoScalingGroupName field's value.
 func (s *UpdateAutoScalingGroupInput) SetAutoScalingGroupName(v string) *UpdateAutoScalingGroupInput {
 	s.AutoScalingGroupName = &v
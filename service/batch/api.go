@@ -2044,6 +2044,24 @@ func (s *ComputeResource) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ComputeResource) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ComputeResource"}
+	if s.Type != nil {
+		values := []string{"EC2", "SPOT"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBidPercentage sets the BidPercentage field's value.
 func (s *ComputeResource) SetBidPercentage(v int64) *ComputeResource {
 	s.BidPercentage = &v
@@ -2968,6 +2986,35 @@ func (s *CreateComputeEnvironmentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateComputeEnvironmentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateComputeEnvironmentInput"}
+	if s.State != nil {
+		values := []string{"ENABLED", "DISABLED"}
+		if !request.IsParamEnumValue(*s.State, values) {
+			invalidParams.Add(request.NewErrParamEnum("State", *s.State, values))
+		}
+	}
+	if s.Type != nil {
+		values := []string{"MANAGED", "UNMANAGED"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.ComputeResources != nil {
+		if err := s.ComputeResources.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ComputeResources", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComputeEnvironmentName sets the ComputeEnvironmentName field's value.
 func (s *CreateComputeEnvironmentInput) SetComputeEnvironmentName(v string) *CreateComputeEnvironmentInput {
 	s.ComputeEnvironmentName = &v
@@ -3149,6 +3196,24 @@ func (s *CreateJobQueueInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateJobQueueInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateJobQueueInput"}
+	if s.State != nil {
+		values := []string{"ENABLED", "DISABLED"}
+		if !request.IsParamEnumValue(*s.State, values) {
+			invalidParams.Add(request.NewErrParamEnum("State", *s.State, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComputeEnvironmentOrder sets the ComputeEnvironmentOrder field's value.
 func (s *CreateJobQueueInput) SetComputeEnvironmentOrder(v []*ComputeEnvironmentOrder) *CreateJobQueueInput {
 	s.ComputeEnvironmentOrder = v
@@ -4775,6 +4840,24 @@ func (s *ListJobsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListJobsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListJobsInput"}
+	if s.JobStatus != nil {
+		values := []string{"SUBMITTED", "PENDING", "RUNNABLE", "STARTING", "RUNNING", "SUCCEEDED", "FAILED"}
+		if !request.IsParamEnumValue(*s.JobStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("JobStatus", *s.JobStatus, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobQueue sets the JobQueue field's value.
 func (s *ListJobsInput) SetJobQueue(v string) *ListJobsInput {
 	s.JobQueue = &v
@@ -5014,6 +5097,24 @@ func (s *RegisterJobDefinitionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterJobDefinitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterJobDefinitionInput"}
+	if s.Type != nil {
+		values := []string{"container"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContainerProperties sets the ContainerProperties field's value.
 func (s *RegisterJobDefinitionInput) SetContainerProperties(v *ContainerProperties) *RegisterJobDefinitionInput {
 	s.ContainerProperties = v
@@ -5641,6 +5742,24 @@ func (s *UpdateComputeEnvironmentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateComputeEnvironmentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateComputeEnvironmentInput"}
+	if s.State != nil {
+		values := []string{"ENABLED", "DISABLED"}
+		if !request.IsParamEnumValue(*s.State, values) {
+			invalidParams.Add(request.NewErrParamEnum("State", *s.State, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComputeEnvironment sets the ComputeEnvironment field's value.
 func (s *UpdateComputeEnvironmentInput) SetComputeEnvironment(v string) *UpdateComputeEnvironmentInput {
 	s.ComputeEnvironment = &v
@@ -5798,6 +5917,24 @@ func (s *UpdateJobQueueInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateJobQueueInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateJobQueueInput"}
+	if s.State != nil {
+		values := []string{"ENABLED", "DISABLED"}
+		if !request.IsParamEnumValue(*s.State, values) {
+			invalidParams.Add(request.NewErrParamEnum("State", *s.State, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComputeEnvironmentOrder sets the ComputeEnvironmentOrder field's value.
 func (s *UpdateJobQueueInput) SetComputeEnvironmentOrder(v []*ComputeEnvironmentOrder) *UpdateJobQueueInput {
 	s.ComputeEnvironmentOrder = v
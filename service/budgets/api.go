@@ -1252,6 +1252,46 @@ func (s *Budget) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Budget) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Budget"}
+	if s.BudgetName != nil && len(*s.BudgetName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BudgetName", 100))
+	}
+	if s.BudgetName != nil && !request.MatchParamPattern("[^:\\\\]+", *s.BudgetName) {
+		invalidParams.Add(request.NewErrParamPattern("BudgetName", "[^:\\\\]+"))
+	}
+	if s.BudgetType != nil {
+		values := []string{"USAGE", "COST", "RI_UTILIZATION"}
+		if !request.IsParamEnumValue(*s.BudgetType, values) {
+			invalidParams.Add(request.NewErrParamEnum("BudgetType", *s.BudgetType, values))
+		}
+	}
+	if s.TimeUnit != nil {
+		values := []string{"DAILY", "MONTHLY", "QUARTERLY", "ANNUALLY"}
+		if !request.IsParamEnumValue(*s.TimeUnit, values) {
+			invalidParams.Add(request.NewErrParamEnum("TimeUnit", *s.TimeUnit, values))
+		}
+	}
+	if s.BudgetLimit != nil {
+		if err := s.BudgetLimit.ValidateExtended(); err != nil {
+			invalidParams.AddNested("BudgetLimit", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.CalculatedSpend != nil {
+		if err := s.CalculatedSpend.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CalculatedSpend", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBudgetLimit sets the BudgetLimit field's value.
 func (s *Budget) SetBudgetLimit(v *Spend) *Budget {
 	s.BudgetLimit = v
@@ -1348,6 +1388,28 @@ func (s *CalculatedSpend) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CalculatedSpend) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CalculatedSpend"}
+	if s.ActualSpend != nil {
+		if err := s.ActualSpend.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ActualSpend", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ForecastedSpend != nil {
+		if err := s.ForecastedSpend.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ForecastedSpend", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActualSpend sets the ActualSpend field's value.
 func (s *CalculatedSpend) SetActualSpend(v *Spend) *CalculatedSpend {
 	s.ActualSpend = v
@@ -1489,6 +1551,39 @@ func (s *CreateBudgetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateBudgetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateBudgetInput"}
+	if s.AccountId != nil && len(*s.AccountId) > 12 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountId", 12))
+	}
+	if s.NotificationsWithSubscribers != nil && len(s.NotificationsWithSubscribers) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("NotificationsWithSubscribers", 5))
+	}
+	if s.Budget != nil {
+		if err := s.Budget.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Budget", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.NotificationsWithSubscribers != nil {
+		for i, v := range s.NotificationsWithSubscribers {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "NotificationsWithSubscribers", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *CreateBudgetInput) SetAccountId(v string) *CreateBudgetInput {
 	s.AccountId = &v
@@ -1601,6 +1696,45 @@ func (s *CreateNotificationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateNotificationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateNotificationInput"}
+	if s.AccountId != nil && len(*s.AccountId) > 12 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountId", 12))
+	}
+	if s.BudgetName != nil && len(*s.BudgetName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BudgetName", 100))
+	}
+	if s.BudgetName != nil && !request.MatchParamPattern("[^:\\\\]+", *s.BudgetName) {
+		invalidParams.Add(request.NewErrParamPattern("BudgetName", "[^:\\\\]+"))
+	}
+	if s.Subscribers != nil && len(s.Subscribers) > 11 {
+		invalidParams.Add(request.NewErrParamMaxLen("Subscribers", 11))
+	}
+	if s.Notification != nil {
+		if err := s.Notification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notification", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Subscribers != nil {
+		for i, v := range s.Subscribers {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Subscribers", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *CreateNotificationInput) SetAccountId(v string) *CreateNotificationInput {
 	s.AccountId = &v
@@ -1712,6 +1846,37 @@ func (s *CreateSubscriberInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateSubscriberInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateSubscriberInput"}
+	if s.AccountId != nil && len(*s.AccountId) > 12 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountId", 12))
+	}
+	if s.BudgetName != nil && len(*s.BudgetName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BudgetName", 100))
+	}
+	if s.BudgetName != nil && !request.MatchParamPattern("[^:\\\\]+", *s.BudgetName) {
+		invalidParams.Add(request.NewErrParamPattern("BudgetName", "[^:\\\\]+"))
+	}
+	if s.Notification != nil {
+		if err := s.Notification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notification", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Subscriber != nil {
+		if err := s.Subscriber.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Subscriber", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *CreateSubscriberInput) SetAccountId(v string) *CreateSubscriberInput {
 	s.AccountId = &v
@@ -1795,6 +1960,27 @@ func (s *DeleteBudgetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteBudgetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteBudgetInput"}
+	if s.AccountId != nil && len(*s.AccountId) > 12 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountId", 12))
+	}
+	if s.BudgetName != nil && len(*s.BudgetName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BudgetName", 100))
+	}
+	if s.BudgetName != nil && !request.MatchParamPattern("[^:\\\\]+", *s.BudgetName) {
+		invalidParams.Add(request.NewErrParamPattern("BudgetName", "[^:\\\\]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *DeleteBudgetInput) SetAccountId(v string) *DeleteBudgetInput {
 	s.AccountId = &v
@@ -1880,6 +2066,32 @@ func (s *DeleteNotificationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteNotificationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteNotificationInput"}
+	if s.AccountId != nil && len(*s.AccountId) > 12 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountId", 12))
+	}
+	if s.BudgetName != nil && len(*s.BudgetName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BudgetName", 100))
+	}
+	if s.BudgetName != nil && !request.MatchParamPattern("[^:\\\\]+", *s.BudgetName) {
+		invalidParams.Add(request.NewErrParamPattern("BudgetName", "[^:\\\\]+"))
+	}
+	if s.Notification != nil {
+		if err := s.Notification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notification", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *DeleteNotificationInput) SetAccountId(v string) *DeleteNotificationInput {
 	s.AccountId = &v
@@ -1985,6 +2197,37 @@ func (s *DeleteSubscriberInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteSubscriberInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSubscriberInput"}
+	if s.AccountId != nil && len(*s.AccountId) > 12 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountId", 12))
+	}
+	if s.BudgetName != nil && len(*s.BudgetName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BudgetName", 100))
+	}
+	if s.BudgetName != nil && !request.MatchParamPattern("[^:\\\\]+", *s.BudgetName) {
+		invalidParams.Add(request.NewErrParamPattern("BudgetName", "[^:\\\\]+"))
+	}
+	if s.Notification != nil {
+		if err := s.Notification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notification", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Subscriber != nil {
+		if err := s.Subscriber.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Subscriber", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *DeleteSubscriberInput) SetAccountId(v string) *DeleteSubscriberInput {
 	s.AccountId = &v
@@ -2068,6 +2311,27 @@ func (s *DescribeBudgetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeBudgetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeBudgetInput"}
+	if s.AccountId != nil && len(*s.AccountId) > 12 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountId", 12))
+	}
+	if s.BudgetName != nil && len(*s.BudgetName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BudgetName", 100))
+	}
+	if s.BudgetName != nil && !request.MatchParamPattern("[^:\\\\]+", *s.BudgetName) {
+		invalidParams.Add(request.NewErrParamPattern("BudgetName", "[^:\\\\]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *DescribeBudgetInput) SetAccountId(v string) *DescribeBudgetInput {
 	s.AccountId = &v
@@ -2150,6 +2414,24 @@ func (s *DescribeBudgetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeBudgetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeBudgetsInput"}
+	if s.AccountId != nil && len(*s.AccountId) > 12 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountId", 12))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *DescribeBudgetsInput) SetAccountId(v string) *DescribeBudgetsInput {
 	s.AccountId = &v
@@ -2255,6 +2537,30 @@ func (s *DescribeNotificationsForBudgetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeNotificationsForBudgetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeNotificationsForBudgetInput"}
+	if s.AccountId != nil && len(*s.AccountId) > 12 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountId", 12))
+	}
+	if s.BudgetName != nil && len(*s.BudgetName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BudgetName", 100))
+	}
+	if s.BudgetName != nil && !request.MatchParamPattern("[^:\\\\]+", *s.BudgetName) {
+		invalidParams.Add(request.NewErrParamPattern("BudgetName", "[^:\\\\]+"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *DescribeNotificationsForBudgetInput) SetAccountId(v string) *DescribeNotificationsForBudgetInput {
 	s.AccountId = &v
@@ -2380,6 +2686,35 @@ func (s *DescribeSubscribersForNotificationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeSubscribersForNotificationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeSubscribersForNotificationInput"}
+	if s.AccountId != nil && len(*s.AccountId) > 12 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountId", 12))
+	}
+	if s.BudgetName != nil && len(*s.BudgetName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BudgetName", 100))
+	}
+	if s.BudgetName != nil && !request.MatchParamPattern("[^:\\\\]+", *s.BudgetName) {
+		invalidParams.Add(request.NewErrParamPattern("BudgetName", "[^:\\\\]+"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.Notification != nil {
+		if err := s.Notification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notification", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *DescribeSubscribersForNotificationInput) SetAccountId(v string) *DescribeSubscribersForNotificationInput {
 	s.AccountId = &v
@@ -2500,6 +2835,39 @@ func (s *Notification) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Notification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Notification"}
+	if s.ComparisonOperator != nil {
+		values := []string{"GREATER_THAN", "LESS_THAN", "EQUAL_TO"}
+		if !request.IsParamEnumValue(*s.ComparisonOperator, values) {
+			invalidParams.Add(request.NewErrParamEnum("ComparisonOperator", *s.ComparisonOperator, values))
+		}
+	}
+	if s.NotificationType != nil {
+		values := []string{"ACTUAL", "FORECASTED"}
+		if !request.IsParamEnumValue(*s.NotificationType, values) {
+			invalidParams.Add(request.NewErrParamEnum("NotificationType", *s.NotificationType, values))
+		}
+	}
+	if s.Threshold != nil && *s.Threshold > 1e+09 {
+		invalidParams.Add(request.NewErrParamMaxValue("Threshold", 1e+09))
+	}
+	if s.ThresholdType != nil {
+		values := []string{"PERCENTAGE", "ABSOLUTE_VALUE"}
+		if !request.IsParamEnumValue(*s.ThresholdType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ThresholdType", *s.ThresholdType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComparisonOperator sets the ComparisonOperator field's value.
 func (s *Notification) SetComparisonOperator(v string) *Notification {
 	s.ComparisonOperator = &v
@@ -2585,6 +2953,36 @@ func (s *NotificationWithSubscribers) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NotificationWithSubscribers) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NotificationWithSubscribers"}
+	if s.Subscribers != nil && len(s.Subscribers) > 11 {
+		invalidParams.Add(request.NewErrParamMaxLen("Subscribers", 11))
+	}
+	if s.Notification != nil {
+		if err := s.Notification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notification", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Subscribers != nil {
+		for i, v := range s.Subscribers {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Subscribers", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNotification sets the Notification field's value.
 func (s *NotificationWithSubscribers) SetNotification(v *Notification) *NotificationWithSubscribers {
 	s.Notification = v
@@ -2642,6 +3040,21 @@ func (s *Spend) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Spend) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Spend"}
+	if s.Amount != nil && !request.MatchParamPattern("[0-9]*(\\.)?[0-9]+", *s.Amount) {
+		invalidParams.Add(request.NewErrParamPattern("Amount", "[0-9]*(\\.)?[0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAmount sets the Amount field's value.
 func (s *Spend) SetAmount(v string) *Spend {
 	s.Amount = &v
@@ -2696,6 +3109,24 @@ func (s *Subscriber) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Subscriber) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Subscriber"}
+	if s.SubscriptionType != nil {
+		values := []string{"SNS", "EMAIL"}
+		if !request.IsParamEnumValue(*s.SubscriptionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("SubscriptionType", *s.SubscriptionType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddress sets the Address field's value.
 func (s *Subscriber) SetAddress(v string) *Subscriber {
 	s.Address = &v
@@ -2810,6 +3241,26 @@ func (s *UpdateBudgetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateBudgetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateBudgetInput"}
+	if s.AccountId != nil && len(*s.AccountId) > 12 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountId", 12))
+	}
+	if s.NewBudget != nil {
+		if err := s.NewBudget.ValidateExtended(); err != nil {
+			invalidParams.AddNested("NewBudget", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *UpdateBudgetInput) SetAccountId(v string) *UpdateBudgetInput {
 	s.AccountId = &v
@@ -2909,6 +3360,37 @@ func (s *UpdateNotificationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateNotificationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateNotificationInput"}
+	if s.AccountId != nil && len(*s.AccountId) > 12 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountId", 12))
+	}
+	if s.BudgetName != nil && len(*s.BudgetName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BudgetName", 100))
+	}
+	if s.BudgetName != nil && !request.MatchParamPattern("[^:\\\\]+", *s.BudgetName) {
+		invalidParams.Add(request.NewErrParamPattern("BudgetName", "[^:\\\\]+"))
+	}
+	if s.NewNotification != nil {
+		if err := s.NewNotification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("NewNotification", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.OldNotification != nil {
+		if err := s.OldNotification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("OldNotification", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *UpdateNotificationInput) SetAccountId(v string) *UpdateNotificationInput {
 	s.AccountId = &v
@@ -3034,6 +3516,42 @@ func (s *UpdateSubscriberInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateSubscriberInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateSubscriberInput"}
+	if s.AccountId != nil && len(*s.AccountId) > 12 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountId", 12))
+	}
+	if s.BudgetName != nil && len(*s.BudgetName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BudgetName", 100))
+	}
+	if s.BudgetName != nil && !request.MatchParamPattern("[^:\\\\]+", *s.BudgetName) {
+		invalidParams.Add(request.NewErrParamPattern("BudgetName", "[^:\\\\]+"))
+	}
+	if s.NewSubscriber != nil {
+		if err := s.NewSubscriber.ValidateExtended(); err != nil {
+			invalidParams.AddNested("NewSubscriber", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Notification != nil {
+		if err := s.Notification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notification", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.OldSubscriber != nil {
+		if err := s.OldSubscriber.ValidateExtended(); err != nil {
+			invalidParams.AddNested("OldSubscriber", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *UpdateSubscriberInput) SetAccountId(v string) *UpdateSubscriberInput {
 	s.AccountId = &v
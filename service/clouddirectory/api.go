@@ -7879,6 +7879,33 @@ func (s *AddFacetToObjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddFacetToObjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddFacetToObjectInput"}
+	if s.ObjectAttributeList != nil {
+		for i, v := range s.ObjectAttributeList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ObjectAttributeList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.SchemaFacet != nil {
+		if err := s.SchemaFacet.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SchemaFacet", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryArn sets the DirectoryArn field's value.
 func (s *AddFacetToObjectInput) SetDirectoryArn(v string) *AddFacetToObjectInput {
 	s.DirectoryArn = &v
@@ -8134,6 +8161,24 @@ func (s *AttachObjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttachObjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttachObjectInput"}
+	if s.LinkName != nil && len(*s.LinkName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("LinkName", 64))
+	}
+	if s.LinkName != nil && !request.MatchParamPattern("[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+", *s.LinkName) {
+		invalidParams.Add(request.NewErrParamPattern("LinkName", "[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChildReference sets the ChildReference field's value.
 func (s *AttachObjectInput) SetChildReference(v *ObjectReference) *AttachObjectInput {
 	s.ChildReference = v
@@ -8529,6 +8574,33 @@ func (s *AttachTypedLinkInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttachTypedLinkInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttachTypedLinkInput"}
+	if s.Attributes != nil {
+		for i, v := range s.Attributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Attributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.TypedLinkFacet != nil {
+		if err := s.TypedLinkFacet.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TypedLinkFacet", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *AttachTypedLinkInput) SetAttributes(v []*AttributeNameAndValue) *AttachTypedLinkInput {
 	s.Attributes = v
@@ -8682,6 +8754,30 @@ func (s *AttributeKey) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttributeKey) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttributeKey"}
+	if s.FacetName != nil && len(*s.FacetName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("FacetName", 64))
+	}
+	if s.FacetName != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.FacetName) {
+		invalidParams.Add(request.NewErrParamPattern("FacetName", "^[a-zA-Z0-9._-]*$"))
+	}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFacetName sets the FacetName field's value.
 func (s *AttributeKey) SetFacetName(v string) *AttributeKey {
 	s.FacetName = &v
@@ -8776,6 +8872,23 @@ func (s *AttributeKeyAndValue) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttributeKeyAndValue) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttributeKeyAndValue"}
+	if s.Key != nil {
+		if err := s.Key.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Key", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *AttributeKeyAndValue) SetKey(v *AttributeKey) *AttributeKeyAndValue {
 	s.Key = v
@@ -8857,6 +8970,24 @@ func (s *AttributeNameAndValue) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttributeNameAndValue) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttributeNameAndValue"}
+	if s.AttributeName != nil && len(*s.AttributeName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("AttributeName", 64))
+	}
+	if s.AttributeName != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.AttributeName) {
+		invalidParams.Add(request.NewErrParamPattern("AttributeName", "^[a-zA-Z0-9._-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeName sets the AttributeName field's value.
 func (s *AttributeNameAndValue) SetAttributeName(v string) *AttributeNameAndValue {
 	s.AttributeName = &v
@@ -8958,6 +9089,33 @@ func (s *BatchAddFacetToObject) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchAddFacetToObject) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchAddFacetToObject"}
+	if s.ObjectAttributeList != nil {
+		for i, v := range s.ObjectAttributeList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ObjectAttributeList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.SchemaFacet != nil {
+		if err := s.SchemaFacet.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SchemaFacet", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetObjectAttributeList sets the ObjectAttributeList field's value.
 func (s *BatchAddFacetToObject) SetObjectAttributeList(v []*AttributeKeyAndValue) *BatchAddFacetToObject {
 	s.ObjectAttributeList = v
@@ -9072,6 +9230,24 @@ func (s *BatchAttachObject) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchAttachObject) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchAttachObject"}
+	if s.LinkName != nil && len(*s.LinkName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("LinkName", 64))
+	}
+	if s.LinkName != nil && !request.MatchParamPattern("[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+", *s.LinkName) {
+		invalidParams.Add(request.NewErrParamPattern("LinkName", "[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChildReference sets the ChildReference field's value.
 func (s *BatchAttachObject) SetChildReference(v *ObjectReference) *BatchAttachObject {
 	s.ChildReference = v
@@ -9421,6 +9597,33 @@ func (s *BatchAttachTypedLink) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchAttachTypedLink) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchAttachTypedLink"}
+	if s.Attributes != nil {
+		for i, v := range s.Attributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Attributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.TypedLinkFacet != nil {
+		if err := s.TypedLinkFacet.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TypedLinkFacet", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *BatchAttachTypedLink) SetAttributes(v []*AttributeNameAndValue) *BatchAttachTypedLink {
 	s.Attributes = v
@@ -9575,6 +9778,34 @@ func (s *BatchCreateIndex) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchCreateIndex) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchCreateIndex"}
+	if s.LinkName != nil && len(*s.LinkName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("LinkName", 64))
+	}
+	if s.LinkName != nil && !request.MatchParamPattern("[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+", *s.LinkName) {
+		invalidParams.Add(request.NewErrParamPattern("LinkName", "[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+"))
+	}
+	if s.OrderedIndexedAttributeList != nil {
+		for i, v := range s.OrderedIndexedAttributeList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OrderedIndexedAttributeList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBatchReferenceName sets the BatchReferenceName field's value.
 func (s *BatchCreateIndex) SetBatchReferenceName(v string) *BatchCreateIndex {
 	s.BatchReferenceName = &v
@@ -9764,6 +9995,44 @@ func (s *BatchCreateObject) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchCreateObject) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchCreateObject"}
+	if s.LinkName != nil && len(*s.LinkName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("LinkName", 64))
+	}
+	if s.LinkName != nil && !request.MatchParamPattern("[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+", *s.LinkName) {
+		invalidParams.Add(request.NewErrParamPattern("LinkName", "[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+"))
+	}
+	if s.ObjectAttributeList != nil {
+		for i, v := range s.ObjectAttributeList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ObjectAttributeList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.SchemaFacet != nil {
+		for i, v := range s.SchemaFacet {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SchemaFacet", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBatchReferenceName sets the BatchReferenceName field's value.
 func (s *BatchCreateObject) SetBatchReferenceName(v string) *BatchCreateObject {
 	s.BatchReferenceName = &v
@@ -10095,6 +10364,24 @@ func (s *BatchDetachObject) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchDetachObject) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchDetachObject"}
+	if s.LinkName != nil && len(*s.LinkName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("LinkName", 64))
+	}
+	if s.LinkName != nil && !request.MatchParamPattern("[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+", *s.LinkName) {
+		invalidParams.Add(request.NewErrParamPattern("LinkName", "[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBatchReferenceName sets the BatchReferenceName field's value.
 func (s *BatchDetachObject) SetBatchReferenceName(v string) *BatchDetachObject {
 	s.BatchReferenceName = &v
@@ -10303,6 +10590,23 @@ func (s *BatchDetachTypedLink) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchDetachTypedLink) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchDetachTypedLink"}
+	if s.TypedLinkSpecifier != nil {
+		if err := s.TypedLinkSpecifier.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TypedLinkSpecifier", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTypedLinkSpecifier sets the TypedLinkSpecifier field's value.
 func (s *BatchDetachTypedLink) SetTypedLinkSpecifier(v *TypedLinkSpecifier) *BatchDetachTypedLink {
 	s.TypedLinkSpecifier = v
@@ -10647,6 +10951,33 @@ func (s *BatchListIncomingTypedLinks) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchListIncomingTypedLinks) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchListIncomingTypedLinks"}
+	if s.FilterAttributeRanges != nil {
+		for i, v := range s.FilterAttributeRanges {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "FilterAttributeRanges", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.FilterTypedLink != nil {
+		if err := s.FilterTypedLink.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FilterTypedLink", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilterAttributeRanges sets the FilterAttributeRanges field's value.
 func (s *BatchListIncomingTypedLinks) SetFilterAttributeRanges(v []*TypedLinkAttributeRange) *BatchListIncomingTypedLinks {
 	s.FilterAttributeRanges = v
@@ -10815,6 +11146,28 @@ func (s *BatchListIndex) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchListIndex) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchListIndex"}
+	if s.RangesOnIndexedValues != nil {
+		for i, v := range s.RangesOnIndexedValues {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "RangesOnIndexedValues", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIndexReference sets the IndexReference field's value.
 func (s *BatchListIndex) SetIndexReference(v *ObjectReference) *BatchListIndex {
 	s.IndexReference = v
@@ -10968,13 +11321,30 @@ func (s *BatchListObjectAttributes) Validate() error {
 	return nil
 }
 
-// SetFacetFilter sets the FacetFilter field's value.
-func (s *BatchListObjectAttributes) SetFacetFilter(v *SchemaFacet) *BatchListObjectAttributes {
-	s.FacetFilter = v
-	return s
-}
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchListObjectAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchListObjectAttributes"}
+	if s.FacetFilter != nil {
+		if err := s.FacetFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FacetFilter", err.(request.ErrInvalidParams))
+		}
+	}
 
-// SetMaxResults sets the MaxResults field's value.
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetFacetFilter sets the FacetFilter field's value.
+func (s *BatchListObjectAttributes) SetFacetFilter(v *SchemaFacet) *BatchListObjectAttributes {
+	s.FacetFilter = v
+	return s
+}
+
+// SetMaxResults sets the MaxResults field's value.
 func (s *BatchListObjectAttributes) SetMaxResults(v int64) *BatchListObjectAttributes {
 	s.MaxResults = &v
 	return s
@@ -11540,6 +11910,33 @@ func (s *BatchListOutgoingTypedLinks) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchListOutgoingTypedLinks) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchListOutgoingTypedLinks"}
+	if s.FilterAttributeRanges != nil {
+		for i, v := range s.FilterAttributeRanges {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "FilterAttributeRanges", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.FilterTypedLink != nil {
+		if err := s.FilterTypedLink.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FilterTypedLink", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilterAttributeRanges sets the FilterAttributeRanges field's value.
 func (s *BatchListOutgoingTypedLinks) SetFilterAttributeRanges(v []*TypedLinkAttributeRange) *BatchListOutgoingTypedLinks {
 	s.FilterAttributeRanges = v
@@ -12027,6 +12424,34 @@ func (s *BatchReadInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchReadInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchReadInput"}
+	if s.ConsistencyLevel != nil {
+		values := []string{"SERIALIZABLE", "EVENTUAL"}
+		if !request.IsParamEnumValue(*s.ConsistencyLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConsistencyLevel", *s.ConsistencyLevel, values))
+		}
+	}
+	if s.Operations != nil {
+		for i, v := range s.Operations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Operations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConsistencyLevel sets the ConsistencyLevel field's value.
 func (s *BatchReadInput) SetConsistencyLevel(v string) *BatchReadInput {
 	s.ConsistencyLevel = &v
@@ -12193,6 +12618,38 @@ func (s *BatchReadOperation) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchReadOperation) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchReadOperation"}
+	if s.ListIncomingTypedLinks != nil {
+		if err := s.ListIncomingTypedLinks.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ListIncomingTypedLinks", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ListIndex != nil {
+		if err := s.ListIndex.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ListIndex", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ListObjectAttributes != nil {
+		if err := s.ListObjectAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ListObjectAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ListOutgoingTypedLinks != nil {
+		if err := s.ListOutgoingTypedLinks.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ListOutgoingTypedLinks", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGetObjectInformation sets the GetObjectInformation field's value.
 func (s *BatchReadOperation) SetGetObjectInformation(v *BatchGetObjectInformation) *BatchReadOperation {
 	s.GetObjectInformation = v
@@ -12657,6 +13114,23 @@ func (s *BatchRemoveFacetFromObject) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchRemoveFacetFromObject) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchRemoveFacetFromObject"}
+	if s.SchemaFacet != nil {
+		if err := s.SchemaFacet.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SchemaFacet", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetObjectReference sets the ObjectReference field's value.
 func (s *BatchRemoveFacetFromObject) SetObjectReference(v *ObjectReference) *BatchRemoveFacetFromObject {
 	s.ObjectReference = v
@@ -12759,6 +13233,28 @@ func (s *BatchUpdateObjectAttributes) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchUpdateObjectAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchUpdateObjectAttributes"}
+	if s.AttributeUpdates != nil {
+		for i, v := range s.AttributeUpdates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "AttributeUpdates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeUpdates sets the AttributeUpdates field's value.
 func (s *BatchUpdateObjectAttributes) SetAttributeUpdates(v []*ObjectAttributeUpdate) *BatchUpdateObjectAttributes {
 	s.AttributeUpdates = v
@@ -12875,6 +13371,28 @@ func (s *BatchWriteInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchWriteInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchWriteInput"}
+	if s.Operations != nil {
+		for i, v := range s.Operations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Operations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryArn sets the DirectoryArn field's value.
 func (s *BatchWriteInput) SetDirectoryArn(v string) *BatchWriteInput {
 	s.DirectoryArn = &v
@@ -13045,6 +13563,63 @@ func (s *BatchWriteOperation) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchWriteOperation) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchWriteOperation"}
+	if s.AddFacetToObject != nil {
+		if err := s.AddFacetToObject.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AddFacetToObject", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.AttachObject != nil {
+		if err := s.AttachObject.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AttachObject", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.AttachTypedLink != nil {
+		if err := s.AttachTypedLink.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AttachTypedLink", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.CreateIndex != nil {
+		if err := s.CreateIndex.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CreateIndex", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.CreateObject != nil {
+		if err := s.CreateObject.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CreateObject", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DetachObject != nil {
+		if err := s.DetachObject.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DetachObject", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DetachTypedLink != nil {
+		if err := s.DetachTypedLink.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DetachTypedLink", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RemoveFacetFromObject != nil {
+		if err := s.RemoveFacetFromObject.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RemoveFacetFromObject", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.UpdateObjectAttributes != nil {
+		if err := s.UpdateObjectAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("UpdateObjectAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddFacetToObject sets the AddFacetToObject field's value.
 func (s *BatchWriteOperation) SetAddFacetToObject(v *BatchAddFacetToObject) *BatchWriteOperation {
 	s.AddFacetToObject = v
@@ -13523,6 +14098,24 @@ func (s *CreateDirectoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDirectoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDirectoryInput"}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *CreateDirectoryInput) SetName(v string) *CreateDirectoryInput {
 	s.Name = &v
@@ -13715,6 +14308,40 @@ func (s *CreateFacetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateFacetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateFacetInput"}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+	if s.ObjectType != nil {
+		values := []string{"NODE", "LEAF_NODE", "POLICY", "INDEX"}
+		if !request.IsParamEnumValue(*s.ObjectType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ObjectType", *s.ObjectType, values))
+		}
+	}
+	if s.Attributes != nil {
+		for i, v := range s.Attributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Attributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *CreateFacetInput) SetAttributes(v []*FacetAttribute) *CreateFacetInput {
 	s.Attributes = v
@@ -13856,6 +14483,34 @@ func (s *CreateIndexInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateIndexInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateIndexInput"}
+	if s.LinkName != nil && len(*s.LinkName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("LinkName", 64))
+	}
+	if s.LinkName != nil && !request.MatchParamPattern("[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+", *s.LinkName) {
+		invalidParams.Add(request.NewErrParamPattern("LinkName", "[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+"))
+	}
+	if s.OrderedIndexedAttributeList != nil {
+		for i, v := range s.OrderedIndexedAttributeList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OrderedIndexedAttributeList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryArn sets the DirectoryArn field's value.
 func (s *CreateIndexInput) SetDirectoryArn(v string) *CreateIndexInput {
 	s.DirectoryArn = &v
@@ -14028,6 +14683,44 @@ func (s *CreateObjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateObjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateObjectInput"}
+	if s.LinkName != nil && len(*s.LinkName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("LinkName", 64))
+	}
+	if s.LinkName != nil && !request.MatchParamPattern("[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+", *s.LinkName) {
+		invalidParams.Add(request.NewErrParamPattern("LinkName", "[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+"))
+	}
+	if s.ObjectAttributeList != nil {
+		for i, v := range s.ObjectAttributeList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ObjectAttributeList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.SchemaFacets != nil {
+		for i, v := range s.SchemaFacets {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SchemaFacets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryArn sets the DirectoryArn field's value.
 func (s *CreateObjectInput) SetDirectoryArn(v string) *CreateObjectInput {
 	s.DirectoryArn = &v
@@ -14161,13 +14854,31 @@ func (s *CreateSchemaInput) Validate() error {
 	return nil
 }
 
-// SetName sets the Name field's value.
-func (s *CreateSchemaInput) SetName(v string) *CreateSchemaInput {
-	s.Name = &v
-	return s
-}
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateSchemaInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateSchemaInput"}
+	if s.Name != nil && len(*s.Name) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 32))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
 
-// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *CreateSchemaInput) SetName(v string) *CreateSchemaInput {
+	s.Name = &v
+	return s
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *CreateSchemaInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.Name != nil {
 		v := *s.Name
@@ -14261,6 +14972,23 @@ func (s *CreateTypedLinkFacetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateTypedLinkFacetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTypedLinkFacetInput"}
+	if s.Facet != nil {
+		if err := s.Facet.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Facet", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFacet sets the Facet field's value.
 func (s *CreateTypedLinkFacetInput) SetFacet(v *TypedLinkFacet) *CreateTypedLinkFacetInput {
 	s.Facet = v
@@ -14442,6 +15170,24 @@ func (s *DeleteFacetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteFacetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteFacetInput"}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteFacetInput) SetName(v string) *DeleteFacetInput {
 	s.Name = &v
@@ -14711,6 +15457,21 @@ func (s *DeleteTypedLinkFacetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteTypedLinkFacetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTypedLinkFacetInput"}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteTypedLinkFacetInput) SetName(v string) *DeleteTypedLinkFacetInput {
 	s.Name = &v
@@ -14938,6 +15699,24 @@ func (s *DetachObjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetachObjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetachObjectInput"}
+	if s.LinkName != nil && len(*s.LinkName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("LinkName", 64))
+	}
+	if s.LinkName != nil && !request.MatchParamPattern("[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+", *s.LinkName) {
+		invalidParams.Add(request.NewErrParamPattern("LinkName", "[^\\/\\[\\]\\(\\):\\{\\}#@!?\\s\\\\;]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryArn sets the DirectoryArn field's value.
 func (s *DetachObjectInput) SetDirectoryArn(v string) *DetachObjectInput {
 	s.DirectoryArn = &v
@@ -15169,6 +15948,23 @@ func (s *DetachTypedLinkInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetachTypedLinkInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetachTypedLinkInput"}
+	if s.TypedLinkSpecifier != nil {
+		if err := s.TypedLinkSpecifier.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TypedLinkSpecifier", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryArn sets the DirectoryArn field's value.
 func (s *DetachTypedLinkInput) SetDirectoryArn(v string) *DetachTypedLinkInput {
 	s.DirectoryArn = &v
@@ -15590,6 +16386,40 @@ func (s *FacetAttribute) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *FacetAttribute) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "FacetAttribute"}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+	if s.RequiredBehavior != nil {
+		values := []string{"REQUIRED_ALWAYS", "NOT_REQUIRED"}
+		if !request.IsParamEnumValue(*s.RequiredBehavior, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequiredBehavior", *s.RequiredBehavior, values))
+		}
+	}
+	if s.AttributeDefinition != nil {
+		if err := s.AttributeDefinition.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AttributeDefinition", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.AttributeReference != nil {
+		if err := s.AttributeReference.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AttributeReference", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeDefinition sets the AttributeDefinition field's value.
 func (s *FacetAttribute) SetAttributeDefinition(v *FacetAttributeDefinition) *FacetAttribute {
 	s.AttributeDefinition = v
@@ -15692,6 +16522,34 @@ func (s *FacetAttributeDefinition) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *FacetAttributeDefinition) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "FacetAttributeDefinition"}
+	if s.Type != nil {
+		values := []string{"STRING", "BINARY", "BOOLEAN", "NUMBER", "DATETIME"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.Rules != nil {
+		for i, v := range s.Rules {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Rules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultValue sets the DefaultValue field's value.
 func (s *FacetAttributeDefinition) SetDefaultValue(v *TypedAttributeValue) *FacetAttributeDefinition {
 	s.DefaultValue = v
@@ -15795,6 +16653,30 @@ func (s *FacetAttributeReference) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *FacetAttributeReference) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "FacetAttributeReference"}
+	if s.TargetAttributeName != nil && len(*s.TargetAttributeName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("TargetAttributeName", 64))
+	}
+	if s.TargetAttributeName != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.TargetAttributeName) {
+		invalidParams.Add(request.NewErrParamPattern("TargetAttributeName", "^[a-zA-Z0-9._-]*$"))
+	}
+	if s.TargetFacetName != nil && len(*s.TargetFacetName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("TargetFacetName", 64))
+	}
+	if s.TargetFacetName != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.TargetFacetName) {
+		invalidParams.Add(request.NewErrParamPattern("TargetFacetName", "^[a-zA-Z0-9._-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTargetAttributeName sets the TargetAttributeName field's value.
 func (s *FacetAttributeReference) SetTargetAttributeName(v string) *FacetAttributeReference {
 	s.TargetAttributeName = &v
@@ -15860,6 +16742,29 @@ func (s *FacetAttributeUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *FacetAttributeUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "FacetAttributeUpdate"}
+	if s.Action != nil {
+		values := []string{"CREATE_OR_UPDATE", "DELETE"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+	if s.Attribute != nil {
+		if err := s.Attribute.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Attribute", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *FacetAttributeUpdate) SetAction(v string) *FacetAttributeUpdate {
 	s.Action = &v
@@ -16028,6 +16933,24 @@ func (s *GetFacetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetFacetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetFacetInput"}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *GetFacetInput) SetName(v string) *GetFacetInput {
 	s.Name = &v
@@ -16135,6 +17058,24 @@ func (s *GetObjectInformationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetObjectInformationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetObjectInformationInput"}
+	if s.ConsistencyLevel != nil {
+		values := []string{"SERIALIZABLE", "EVENTUAL"}
+		if !request.IsParamEnumValue(*s.ConsistencyLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConsistencyLevel", *s.ConsistencyLevel, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConsistencyLevel sets the ConsistencyLevel field's value.
 func (s *GetObjectInformationInput) SetConsistencyLevel(v string) *GetObjectInformationInput {
 	s.ConsistencyLevel = &v
@@ -16364,6 +17305,21 @@ func (s *GetTypedLinkFacetInformationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetTypedLinkFacetInformationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTypedLinkFacetInformationInput"}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *GetTypedLinkFacetInformationInput) SetName(v string) *GetTypedLinkFacetInformationInput {
 	s.Name = &v
@@ -16675,6 +17631,24 @@ func (s *ListAttachedIndicesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAttachedIndicesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAttachedIndicesInput"}
+	if s.ConsistencyLevel != nil {
+		values := []string{"SERIALIZABLE", "EVENTUAL"}
+		if !request.IsParamEnumValue(*s.ConsistencyLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConsistencyLevel", *s.ConsistencyLevel, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConsistencyLevel sets the ConsistencyLevel field's value.
 func (s *ListAttachedIndicesInput) SetConsistencyLevel(v string) *ListAttachedIndicesInput {
 	s.ConsistencyLevel = &v
@@ -16934,6 +17908,24 @@ func (s *ListDirectoriesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListDirectoriesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDirectoriesInput"}
+	if s.State != nil {
+		values := []string{"ENABLED", "DISABLED", "DELETED"}
+		if !request.IsParamEnumValue(*s.State, values) {
+			invalidParams.Add(request.NewErrParamEnum("State", *s.State, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListDirectoriesInput) SetMaxResults(v int64) *ListDirectoriesInput {
 	s.MaxResults = &v
@@ -17078,6 +18070,24 @@ func (s *ListFacetAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListFacetAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListFacetAttributesInput"}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListFacetAttributesInput) SetMaxResults(v int64) *ListFacetAttributesInput {
 	s.MaxResults = &v
@@ -17386,6 +18396,39 @@ func (s *ListIncomingTypedLinksInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListIncomingTypedLinksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListIncomingTypedLinksInput"}
+	if s.ConsistencyLevel != nil {
+		values := []string{"SERIALIZABLE", "EVENTUAL"}
+		if !request.IsParamEnumValue(*s.ConsistencyLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConsistencyLevel", *s.ConsistencyLevel, values))
+		}
+	}
+	if s.FilterAttributeRanges != nil {
+		for i, v := range s.FilterAttributeRanges {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "FilterAttributeRanges", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.FilterTypedLink != nil {
+		if err := s.FilterTypedLink.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FilterTypedLink", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConsistencyLevel sets the ConsistencyLevel field's value.
 func (s *ListIncomingTypedLinksInput) SetConsistencyLevel(v string) *ListIncomingTypedLinksInput {
 	s.ConsistencyLevel = &v
@@ -17584,6 +18627,34 @@ func (s *ListIndexInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListIndexInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListIndexInput"}
+	if s.ConsistencyLevel != nil {
+		values := []string{"SERIALIZABLE", "EVENTUAL"}
+		if !request.IsParamEnumValue(*s.ConsistencyLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConsistencyLevel", *s.ConsistencyLevel, values))
+		}
+	}
+	if s.RangesOnIndexedValues != nil {
+		for i, v := range s.RangesOnIndexedValues {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "RangesOnIndexedValues", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConsistencyLevel sets the ConsistencyLevel field's value.
 func (s *ListIndexInput) SetConsistencyLevel(v string) *ListIndexInput {
 	s.ConsistencyLevel = &v
@@ -17770,6 +18841,29 @@ func (s *ListObjectAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListObjectAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListObjectAttributesInput"}
+	if s.ConsistencyLevel != nil {
+		values := []string{"SERIALIZABLE", "EVENTUAL"}
+		if !request.IsParamEnumValue(*s.ConsistencyLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConsistencyLevel", *s.ConsistencyLevel, values))
+		}
+	}
+	if s.FacetFilter != nil {
+		if err := s.FacetFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FacetFilter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConsistencyLevel sets the ConsistencyLevel field's value.
 func (s *ListObjectAttributesInput) SetConsistencyLevel(v string) *ListObjectAttributesInput {
 	s.ConsistencyLevel = &v
@@ -17949,10 +19043,28 @@ func (s *ListObjectChildrenInput) Validate() error {
 	return nil
 }
 
-// SetConsistencyLevel sets the ConsistencyLevel field's value.
-func (s *ListObjectChildrenInput) SetConsistencyLevel(v string) *ListObjectChildrenInput {
-	s.ConsistencyLevel = &v
-	return s
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListObjectChildrenInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListObjectChildrenInput"}
+	if s.ConsistencyLevel != nil {
+		values := []string{"SERIALIZABLE", "EVENTUAL"}
+		if !request.IsParamEnumValue(*s.ConsistencyLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConsistencyLevel", *s.ConsistencyLevel, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetConsistencyLevel sets the ConsistencyLevel field's value.
+func (s *ListObjectChildrenInput) SetConsistencyLevel(v string) *ListObjectChildrenInput {
+	s.ConsistencyLevel = &v
+	return s
 }
 
 // SetDirectoryArn sets the DirectoryArn field's value.
@@ -18267,6 +19379,24 @@ func (s *ListObjectParentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListObjectParentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListObjectParentsInput"}
+	if s.ConsistencyLevel != nil {
+		values := []string{"SERIALIZABLE", "EVENTUAL"}
+		if !request.IsParamEnumValue(*s.ConsistencyLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConsistencyLevel", *s.ConsistencyLevel, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConsistencyLevel sets the ConsistencyLevel field's value.
 func (s *ListObjectParentsInput) SetConsistencyLevel(v string) *ListObjectParentsInput {
 	s.ConsistencyLevel = &v
@@ -18434,6 +19564,24 @@ func (s *ListObjectPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListObjectPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListObjectPoliciesInput"}
+	if s.ConsistencyLevel != nil {
+		values := []string{"SERIALIZABLE", "EVENTUAL"}
+		if !request.IsParamEnumValue(*s.ConsistencyLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConsistencyLevel", *s.ConsistencyLevel, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConsistencyLevel sets the ConsistencyLevel field's value.
 func (s *ListObjectPoliciesInput) SetConsistencyLevel(v string) *ListObjectPoliciesInput {
 	s.ConsistencyLevel = &v
@@ -18623,6 +19771,39 @@ func (s *ListOutgoingTypedLinksInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListOutgoingTypedLinksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListOutgoingTypedLinksInput"}
+	if s.ConsistencyLevel != nil {
+		values := []string{"SERIALIZABLE", "EVENTUAL"}
+		if !request.IsParamEnumValue(*s.ConsistencyLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConsistencyLevel", *s.ConsistencyLevel, values))
+		}
+	}
+	if s.FilterAttributeRanges != nil {
+		for i, v := range s.FilterAttributeRanges {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "FilterAttributeRanges", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.FilterTypedLink != nil {
+		if err := s.FilterTypedLink.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FilterTypedLink", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConsistencyLevel sets the ConsistencyLevel field's value.
 func (s *ListOutgoingTypedLinksInput) SetConsistencyLevel(v string) *ListOutgoingTypedLinksInput {
 	s.ConsistencyLevel = &v
@@ -18811,6 +19992,24 @@ func (s *ListPolicyAttachmentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPolicyAttachmentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPolicyAttachmentsInput"}
+	if s.ConsistencyLevel != nil {
+		values := []string{"SERIALIZABLE", "EVENTUAL"}
+		if !request.IsParamEnumValue(*s.ConsistencyLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConsistencyLevel", *s.ConsistencyLevel, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConsistencyLevel sets the ConsistencyLevel field's value.
 func (s *ListPolicyAttachmentsInput) SetConsistencyLevel(v string) *ListPolicyAttachmentsInput {
 	s.ConsistencyLevel = &v
@@ -19217,6 +20416,21 @@ func (s *ListTypedLinkFacetAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTypedLinkFacetAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTypedLinkFacetAttributesInput"}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListTypedLinkFacetAttributesInput) SetMaxResults(v int64) *ListTypedLinkFacetAttributesInput {
 	s.MaxResults = &v
@@ -19621,6 +20835,24 @@ func (s ObjectAttributeAction) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ObjectAttributeAction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ObjectAttributeAction"}
+	if s.ObjectAttributeActionType != nil {
+		values := []string{"CREATE_OR_UPDATE", "DELETE"}
+		if !request.IsParamEnumValue(*s.ObjectAttributeActionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ObjectAttributeActionType", *s.ObjectAttributeActionType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetObjectAttributeActionType sets the ObjectAttributeActionType field's value.
 func (s *ObjectAttributeAction) SetObjectAttributeActionType(v string) *ObjectAttributeAction {
 	s.ObjectAttributeActionType = &v
@@ -19691,6 +20923,28 @@ func (s *ObjectAttributeRange) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ObjectAttributeRange) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ObjectAttributeRange"}
+	if s.AttributeKey != nil {
+		if err := s.AttributeKey.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AttributeKey", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Range != nil {
+		if err := s.Range.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Range", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeKey sets the AttributeKey field's value.
 func (s *ObjectAttributeRange) SetAttributeKey(v *AttributeKey) *ObjectAttributeRange {
 	s.AttributeKey = v
@@ -19764,6 +21018,28 @@ func (s *ObjectAttributeUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ObjectAttributeUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ObjectAttributeUpdate"}
+	if s.ObjectAttributeAction != nil {
+		if err := s.ObjectAttributeAction.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ObjectAttributeAction", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ObjectAttributeKey != nil {
+		if err := s.ObjectAttributeKey.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ObjectAttributeKey", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetObjectAttributeAction sets the ObjectAttributeAction field's value.
 func (s *ObjectAttributeUpdate) SetObjectAttributeAction(v *ObjectAttributeAction) *ObjectAttributeUpdate {
 	s.ObjectAttributeAction = v
@@ -20094,6 +21370,30 @@ func (s *PublishSchemaInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PublishSchemaInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PublishSchemaInput"}
+	if s.Name != nil && len(*s.Name) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 32))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+	if s.Version != nil && len(*s.Version) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 10))
+	}
+	if s.Version != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Version) {
+		invalidParams.Add(request.NewErrParamPattern("Version", "^[a-zA-Z0-9._-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDevelopmentSchemaArn sets the DevelopmentSchemaArn field's value.
 func (s *PublishSchemaInput) SetDevelopmentSchemaArn(v string) *PublishSchemaInput {
 	s.DevelopmentSchemaArn = &v
@@ -20327,6 +21627,23 @@ func (s *RemoveFacetFromObjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveFacetFromObjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveFacetFromObjectInput"}
+	if s.SchemaFacet != nil {
+		if err := s.SchemaFacet.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SchemaFacet", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryArn sets the DirectoryArn field's value.
 func (s *RemoveFacetFromObjectInput) SetDirectoryArn(v string) *RemoveFacetFromObjectInput {
 	s.DirectoryArn = &v
@@ -20410,6 +21727,24 @@ func (s Rule) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Rule) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Rule"}
+	if s.Type != nil {
+		values := []string{"BINARY_LENGTH", "NUMBER_COMPARISON", "STRING_FROM_SET", "STRING_LENGTH"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetParameters sets the Parameters field's value.
 func (s *Rule) SetParameters(v map[string]*string) *Rule {
 	s.Parameters = v
@@ -20481,6 +21816,24 @@ func (s *SchemaFacet) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SchemaFacet) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SchemaFacet"}
+	if s.FacetName != nil && len(*s.FacetName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("FacetName", 64))
+	}
+	if s.FacetName != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.FacetName) {
+		invalidParams.Add(request.NewErrParamPattern("FacetName", "^[a-zA-Z0-9._-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFacetName sets the FacetName field's value.
 func (s *SchemaFacet) SetFacetName(v string) *SchemaFacet {
 	s.FacetName = &v
@@ -20810,6 +22163,30 @@ func (s *TypedAttributeValueRange) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TypedAttributeValueRange) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TypedAttributeValueRange"}
+	if s.EndMode != nil {
+		values := []string{"FIRST", "LAST", "LAST_BEFORE_MISSING_VALUES", "INCLUSIVE", "EXCLUSIVE"}
+		if !request.IsParamEnumValue(*s.EndMode, values) {
+			invalidParams.Add(request.NewErrParamEnum("EndMode", *s.EndMode, values))
+		}
+	}
+	if s.StartMode != nil {
+		values := []string{"FIRST", "LAST", "LAST_BEFORE_MISSING_VALUES", "INCLUSIVE", "EXCLUSIVE"}
+		if !request.IsParamEnumValue(*s.StartMode, values) {
+			invalidParams.Add(request.NewErrParamEnum("StartMode", *s.StartMode, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEndMode sets the EndMode field's value.
 func (s *TypedAttributeValueRange) SetEndMode(v string) *TypedAttributeValueRange {
 	s.EndMode = &v
@@ -20922,6 +22299,46 @@ func (s *TypedLinkAttributeDefinition) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TypedLinkAttributeDefinition) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TypedLinkAttributeDefinition"}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+	if s.RequiredBehavior != nil {
+		values := []string{"REQUIRED_ALWAYS", "NOT_REQUIRED"}
+		if !request.IsParamEnumValue(*s.RequiredBehavior, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequiredBehavior", *s.RequiredBehavior, values))
+		}
+	}
+	if s.Type != nil {
+		values := []string{"STRING", "BINARY", "BOOLEAN", "NUMBER", "DATETIME"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.Rules != nil {
+		for i, v := range s.Rules {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Rules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultValue sets the DefaultValue field's value.
 func (s *TypedLinkAttributeDefinition) SetDefaultValue(v *TypedAttributeValue) *TypedLinkAttributeDefinition {
 	s.DefaultValue = v
@@ -21047,6 +22464,29 @@ func (s *TypedLinkAttributeRange) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TypedLinkAttributeRange) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TypedLinkAttributeRange"}
+	if s.AttributeName != nil && len(*s.AttributeName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("AttributeName", 64))
+	}
+	if s.AttributeName != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.AttributeName) {
+		invalidParams.Add(request.NewErrParamPattern("AttributeName", "^[a-zA-Z0-9._-]*$"))
+	}
+	if s.Range != nil {
+		if err := s.Range.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Range", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeName sets the AttributeName field's value.
 func (s *TypedLinkAttributeRange) SetAttributeName(v string) *TypedLinkAttributeRange {
 	s.AttributeName = &v
@@ -21150,6 +22590,31 @@ func (s *TypedLinkFacet) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TypedLinkFacet) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TypedLinkFacet"}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+	if s.Attributes != nil {
+		for i, v := range s.Attributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Attributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *TypedLinkFacet) SetAttributes(v []*TypedLinkAttributeDefinition) *TypedLinkFacet {
 	s.Attributes = v
@@ -21236,6 +22701,29 @@ func (s *TypedLinkFacetAttributeUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TypedLinkFacetAttributeUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TypedLinkFacetAttributeUpdate"}
+	if s.Action != nil {
+		values := []string{"CREATE_OR_UPDATE", "DELETE"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+	if s.Attribute != nil {
+		if err := s.Attribute.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Attribute", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *TypedLinkFacetAttributeUpdate) SetAction(v string) *TypedLinkFacetAttributeUpdate {
 	s.Action = &v
@@ -21316,6 +22804,21 @@ func (s *TypedLinkSchemaAndFacetName) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TypedLinkSchemaAndFacetName) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TypedLinkSchemaAndFacetName"}
+	if s.TypedLinkName != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.TypedLinkName) {
+		invalidParams.Add(request.NewErrParamPattern("TypedLinkName", "^[a-zA-Z0-9._-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSchemaArn sets the SchemaArn field's value.
 func (s *TypedLinkSchemaAndFacetName) SetSchemaArn(v string) *TypedLinkSchemaAndFacetName {
 	s.SchemaArn = &v
@@ -21422,6 +22925,33 @@ func (s *TypedLinkSpecifier) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TypedLinkSpecifier) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TypedLinkSpecifier"}
+	if s.IdentityAttributeValues != nil {
+		for i, v := range s.IdentityAttributeValues {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "IdentityAttributeValues", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.TypedLinkFacet != nil {
+		if err := s.TypedLinkFacet.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TypedLinkFacet", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityAttributeValues sets the IdentityAttributeValues field's value.
 func (s *TypedLinkSpecifier) SetIdentityAttributeValues(v []*AttributeNameAndValue) *TypedLinkSpecifier {
 	s.IdentityAttributeValues = v
@@ -21635,6 +23165,40 @@ func (s *UpdateFacetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateFacetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateFacetInput"}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+	if s.ObjectType != nil {
+		values := []string{"NODE", "LEAF_NODE", "POLICY", "INDEX"}
+		if !request.IsParamEnumValue(*s.ObjectType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ObjectType", *s.ObjectType, values))
+		}
+	}
+	if s.AttributeUpdates != nil {
+		for i, v := range s.AttributeUpdates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "AttributeUpdates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeUpdates sets the AttributeUpdates field's value.
 func (s *UpdateFacetInput) SetAttributeUpdates(v []*FacetAttributeUpdate) *UpdateFacetInput {
 	s.AttributeUpdates = v
@@ -21766,6 +23330,28 @@ func (s *UpdateObjectAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateObjectAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateObjectAttributesInput"}
+	if s.AttributeUpdates != nil {
+		for i, v := range s.AttributeUpdates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "AttributeUpdates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeUpdates sets the AttributeUpdates field's value.
 func (s *UpdateObjectAttributesInput) SetAttributeUpdates(v []*ObjectAttributeUpdate) *UpdateObjectAttributesInput {
 	s.AttributeUpdates = v
@@ -21885,6 +23471,24 @@ func (s *UpdateSchemaInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateSchemaInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateSchemaInput"}
+	if s.Name != nil && len(*s.Name) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 32))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *UpdateSchemaInput) SetName(v string) *UpdateSchemaInput {
 	s.Name = &v
@@ -22024,6 +23628,31 @@ func (s *UpdateTypedLinkFacetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateTypedLinkFacetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateTypedLinkFacetInput"}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9._-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9._-]*$"))
+	}
+	if s.AttributeUpdates != nil {
+		for i, v := range s.AttributeUpdates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "AttributeUpdates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeUpdates sets the AttributeUpdates field's value.
 func (s *UpdateTypedLinkFacetInput) SetAttributeUpdates(v []*TypedLinkFacetAttributeUpdate) *UpdateTypedLinkFacetInput {
 	s.AttributeUpdates = v
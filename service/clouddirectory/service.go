@@ -31,6 +31,7 @@ var initRequest func(*request.Request)
 const (
 	ServiceName = "clouddirectory" // Service endpoint prefix API calls made to.
 	EndpointsID = ServiceName      // Service ID for Regions and Endpoints metadata.
+	ServiceID   = "CloudDirectory" // ServiceID is a unique identifier of a specific service.
 )
 
 // New creates a new instance of the CloudDirectory client with a session.
@@ -45,19 +46,20 @@ const (
 //     svc := clouddirectory.New(mySession, aws.NewConfig().WithRegion("us-west-2"))
 func New(p client.ConfigProvider, cfgs ...*aws.Config) *CloudDirectory {
 	c := p.ClientConfig(EndpointsID, cfgs...)
+	if c.SigningNameDerived || len(c.SigningName) == 0 {
+		c.SigningName = "clouddirectory"
+	}
 	return newClient(*c.Config, c.Handlers, c.Endpoint, c.SigningRegion, c.SigningName)
 }
 
 // newClient creates, initializes and returns a new service client instance.
 func newClient(cfg aws.Config, handlers request.Handlers, endpoint, signingRegion, signingName string) *CloudDirectory {
-	if len(signingName) == 0 {
-		signingName = "clouddirectory"
-	}
 	svc := &CloudDirectory{
 		Client: client.New(
 			cfg,
 			metadata.ClientInfo{
 				ServiceName:   ServiceName,
+				ServiceID:     ServiceID,
 				SigningName:   signingName,
 				SigningRegion: signingRegion,
 				Endpoint:      endpoint,
@@ -3786,6 +3786,24 @@ func (s *CancelUpdateStackInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CancelUpdateStackInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelUpdateStackInput"}
+	if s.ClientRequestToken != nil && len(*s.ClientRequestToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientRequestToken", 128))
+	}
+	if s.ClientRequestToken != nil && !request.MatchParamPattern("[a-zA-Z0-9][-a-zA-Z0-9]*", *s.ClientRequestToken) {
+		invalidParams.Add(request.NewErrParamPattern("ClientRequestToken", "[a-zA-Z0-9][-a-zA-Z0-9]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientRequestToken sets the ClientRequestToken field's value.
 func (s *CancelUpdateStackInput) SetClientRequestToken(v string) *CancelUpdateStackInput {
 	s.ClientRequestToken = &v
@@ -4057,6 +4075,30 @@ func (s *ContinueUpdateRollbackInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ContinueUpdateRollbackInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ContinueUpdateRollbackInput"}
+	if s.ClientRequestToken != nil && len(*s.ClientRequestToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientRequestToken", 128))
+	}
+	if s.ClientRequestToken != nil && !request.MatchParamPattern("[a-zA-Z0-9][-a-zA-Z0-9]*", *s.ClientRequestToken) {
+		invalidParams.Add(request.NewErrParamPattern("ClientRequestToken", "[a-zA-Z0-9][-a-zA-Z0-9]*"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 2048))
+	}
+	if s.StackName != nil && !request.MatchParamPattern("([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)", *s.StackName) {
+		invalidParams.Add(request.NewErrParamPattern("StackName", "([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientRequestToken sets the ClientRequestToken field's value.
 func (s *ContinueUpdateRollbackInput) SetClientRequestToken(v string) *ContinueUpdateRollbackInput {
 	s.ClientRequestToken = &v
@@ -4295,6 +4337,66 @@ func (s *CreateChangeSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateChangeSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateChangeSetInput"}
+	if s.ChangeSetName != nil && len(*s.ChangeSetName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ChangeSetName", 128))
+	}
+	if s.ChangeSetName != nil && !request.MatchParamPattern("[a-zA-Z][-a-zA-Z0-9]*", *s.ChangeSetName) {
+		invalidParams.Add(request.NewErrParamPattern("ChangeSetName", "[a-zA-Z][-a-zA-Z0-9]*"))
+	}
+	if s.ChangeSetType != nil {
+		values := []string{"CREATE", "UPDATE"}
+		if !request.IsParamEnumValue(*s.ChangeSetType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ChangeSetType", *s.ChangeSetType, values))
+		}
+	}
+	if s.ClientToken != nil && len(*s.ClientToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientToken", 128))
+	}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.NotificationARNs != nil && len(s.NotificationARNs) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("NotificationARNs", 5))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 2048))
+	}
+	if s.StackName != nil && !request.MatchParamPattern("([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)", *s.StackName) {
+		invalidParams.Add(request.NewErrParamPattern("StackName", "([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)"))
+	}
+	if s.Tags != nil && len(s.Tags) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 50))
+	}
+	if s.TemplateURL != nil && len(*s.TemplateURL) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateURL", 1024))
+	}
+	if s.RollbackConfiguration != nil {
+		if err := s.RollbackConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RollbackConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCapabilities sets the Capabilities field's value.
 func (s *CreateChangeSetInput) SetCapabilities(v []*string) *CreateChangeSetInput {
 	s.Capabilities = v
@@ -4633,6 +4735,63 @@ func (s *CreateStackInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateStackInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateStackInput"}
+	if s.ClientRequestToken != nil && len(*s.ClientRequestToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientRequestToken", 128))
+	}
+	if s.ClientRequestToken != nil && !request.MatchParamPattern("[a-zA-Z0-9][-a-zA-Z0-9]*", *s.ClientRequestToken) {
+		invalidParams.Add(request.NewErrParamPattern("ClientRequestToken", "[a-zA-Z0-9][-a-zA-Z0-9]*"))
+	}
+	if s.NotificationARNs != nil && len(s.NotificationARNs) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("NotificationARNs", 5))
+	}
+	if s.OnFailure != nil {
+		values := []string{"DO_NOTHING", "ROLLBACK", "DELETE"}
+		if !request.IsParamEnumValue(*s.OnFailure, values) {
+			invalidParams.Add(request.NewErrParamEnum("OnFailure", *s.OnFailure, values))
+		}
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 2048))
+	}
+	if s.StackPolicyBody != nil && len(*s.StackPolicyBody) > 16384 {
+		invalidParams.Add(request.NewErrParamMaxLen("StackPolicyBody", 16384))
+	}
+	if s.StackPolicyURL != nil && len(*s.StackPolicyURL) > 1350 {
+		invalidParams.Add(request.NewErrParamMaxLen("StackPolicyURL", 1350))
+	}
+	if s.Tags != nil && len(s.Tags) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 50))
+	}
+	if s.TemplateURL != nil && len(*s.TemplateURL) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateURL", 1024))
+	}
+	if s.RollbackConfiguration != nil {
+		if err := s.RollbackConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RollbackConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCapabilities sets the Capabilities field's value.
 func (s *CreateStackInput) SetCapabilities(v []*string) *CreateStackInput {
 	s.Capabilities = v
@@ -4805,6 +4964,29 @@ func (s *CreateStackInstancesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateStackInstancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateStackInstancesInput"}
+	if s.OperationId != nil && len(*s.OperationId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("OperationId", 128))
+	}
+	if s.OperationId != nil && !request.MatchParamPattern("[a-zA-Z0-9][-a-zA-Z0-9]*", *s.OperationId) {
+		invalidParams.Add(request.NewErrParamPattern("OperationId", "[a-zA-Z0-9][-a-zA-Z0-9]*"))
+	}
+	if s.OperationPreferences != nil {
+		if err := s.OperationPreferences.ValidateExtended(); err != nil {
+			invalidParams.AddNested("OperationPreferences", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccounts sets the Accounts field's value.
 func (s *CreateStackInstancesInput) SetAccounts(v []*string) *CreateStackInstancesInput {
 	s.Accounts = v
@@ -5024,6 +5206,43 @@ func (s *CreateStackSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateStackSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateStackSetInput"}
+	if s.ClientRequestToken != nil && len(*s.ClientRequestToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientRequestToken", 128))
+	}
+	if s.ClientRequestToken != nil && !request.MatchParamPattern("[a-zA-Z0-9][-a-zA-Z0-9]*", *s.ClientRequestToken) {
+		invalidParams.Add(request.NewErrParamPattern("ClientRequestToken", "[a-zA-Z0-9][-a-zA-Z0-9]*"))
+	}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.Tags != nil && len(s.Tags) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 50))
+	}
+	if s.TemplateURL != nil && len(*s.TemplateURL) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateURL", 1024))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCapabilities sets the Capabilities field's value.
 func (s *CreateStackSetInput) SetCapabilities(v []*string) *CreateStackSetInput {
 	s.Capabilities = v
@@ -5141,6 +5360,27 @@ func (s *DeleteChangeSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteChangeSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteChangeSetInput"}
+	if s.ChangeSetName != nil && len(*s.ChangeSetName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("ChangeSetName", 1600))
+	}
+	if s.ChangeSetName != nil && !request.MatchParamPattern("[a-zA-Z][-a-zA-Z0-9]*|arn:[-a-zA-Z0-9:/]*", *s.ChangeSetName) {
+		invalidParams.Add(request.NewErrParamPattern("ChangeSetName", "[a-zA-Z][-a-zA-Z0-9]*|arn:[-a-zA-Z0-9:/]*"))
+	}
+	if s.StackName != nil && !request.MatchParamPattern("([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)", *s.StackName) {
+		invalidParams.Add(request.NewErrParamPattern("StackName", "([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeSetName sets the ChangeSetName field's value.
 func (s *DeleteChangeSetInput) SetChangeSetName(v string) *DeleteChangeSetInput {
 	s.ChangeSetName = &v
@@ -5243,6 +5483,27 @@ func (s *DeleteStackInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteStackInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteStackInput"}
+	if s.ClientRequestToken != nil && len(*s.ClientRequestToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientRequestToken", 128))
+	}
+	if s.ClientRequestToken != nil && !request.MatchParamPattern("[a-zA-Z0-9][-a-zA-Z0-9]*", *s.ClientRequestToken) {
+		invalidParams.Add(request.NewErrParamPattern("ClientRequestToken", "[a-zA-Z0-9][-a-zA-Z0-9]*"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientRequestToken sets the ClientRequestToken field's value.
 func (s *DeleteStackInput) SetClientRequestToken(v string) *DeleteStackInput {
 	s.ClientRequestToken = &v
@@ -5353,6 +5614,29 @@ func (s *DeleteStackInstancesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteStackInstancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteStackInstancesInput"}
+	if s.OperationId != nil && len(*s.OperationId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("OperationId", 128))
+	}
+	if s.OperationId != nil && !request.MatchParamPattern("[a-zA-Z0-9][-a-zA-Z0-9]*", *s.OperationId) {
+		invalidParams.Add(request.NewErrParamPattern("OperationId", "[a-zA-Z0-9][-a-zA-Z0-9]*"))
+	}
+	if s.OperationPreferences != nil {
+		if err := s.OperationPreferences.ValidateExtended(); err != nil {
+			invalidParams.AddNested("OperationPreferences", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccounts sets the Accounts field's value.
 func (s *DeleteStackInstancesInput) SetAccounts(v []*string) *DeleteStackInstancesInput {
 	s.Accounts = v
@@ -5515,6 +5799,21 @@ func (s *DescribeAccountLimitsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAccountLimitsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAccountLimitsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *DescribeAccountLimitsInput) SetNextToken(v string) *DescribeAccountLimitsInput {
 	s.NextToken = &v
@@ -5609,6 +5908,30 @@ func (s *DescribeChangeSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeChangeSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeChangeSetInput"}
+	if s.ChangeSetName != nil && len(*s.ChangeSetName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("ChangeSetName", 1600))
+	}
+	if s.ChangeSetName != nil && !request.MatchParamPattern("[a-zA-Z][-a-zA-Z0-9]*|arn:[-a-zA-Z0-9:/]*", *s.ChangeSetName) {
+		invalidParams.Add(request.NewErrParamPattern("ChangeSetName", "[a-zA-Z][-a-zA-Z0-9]*|arn:[-a-zA-Z0-9:/]*"))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.StackName != nil && !request.MatchParamPattern("([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)", *s.StackName) {
+		invalidParams.Add(request.NewErrParamPattern("StackName", "([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeSetName sets the ChangeSetName field's value.
 func (s *DescribeChangeSetInput) SetChangeSetName(v string) *DescribeChangeSetInput {
 	s.ChangeSetName = &v
@@ -5845,6 +6168,21 @@ func (s *DescribeStackEventsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeStackEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeStackEventsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *DescribeStackEventsInput) SetNextToken(v string) *DescribeStackEventsInput {
 	s.NextToken = &v
@@ -5942,6 +6280,21 @@ func (s *DescribeStackInstanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeStackInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeStackInstanceInput"}
+	if s.StackInstanceAccount != nil && !request.MatchParamPattern("[0-9]{12}", *s.StackInstanceAccount) {
+		invalidParams.Add(request.NewErrParamPattern("StackInstanceAccount", "[0-9]{12}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStackInstanceAccount sets the StackInstanceAccount field's value.
 func (s *DescribeStackInstanceInput) SetStackInstanceAccount(v string) *DescribeStackInstanceInput {
 	s.StackInstanceAccount = &v
@@ -6249,6 +6602,24 @@ func (s *DescribeStackSetOperationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeStackSetOperationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeStackSetOperationInput"}
+	if s.OperationId != nil && len(*s.OperationId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("OperationId", 128))
+	}
+	if s.OperationId != nil && !request.MatchParamPattern("[a-zA-Z0-9][-a-zA-Z0-9]*", *s.OperationId) {
+		invalidParams.Add(request.NewErrParamPattern("OperationId", "[a-zA-Z0-9][-a-zA-Z0-9]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetOperationId sets the OperationId field's value.
 func (s *DescribeStackSetOperationInput) SetOperationId(v string) *DescribeStackSetOperationInput {
 	s.OperationId = &v
@@ -6352,6 +6723,21 @@ func (s *DescribeStacksInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeStacksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeStacksInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *DescribeStacksInput) SetNextToken(v string) *DescribeStacksInput {
 	s.NextToken = &v
@@ -6452,6 +6838,21 @@ func (s *EstimateTemplateCostInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EstimateTemplateCostInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EstimateTemplateCostInput"}
+	if s.TemplateURL != nil && len(*s.TemplateURL) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateURL", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetParameters sets the Parameters field's value.
 func (s *EstimateTemplateCostInput) SetParameters(v []*Parameter) *EstimateTemplateCostInput {
 	s.Parameters = v
@@ -6551,6 +6952,33 @@ func (s *ExecuteChangeSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ExecuteChangeSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ExecuteChangeSetInput"}
+	if s.ChangeSetName != nil && len(*s.ChangeSetName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("ChangeSetName", 1600))
+	}
+	if s.ChangeSetName != nil && !request.MatchParamPattern("[a-zA-Z][-a-zA-Z0-9]*|arn:[-a-zA-Z0-9:/]*", *s.ChangeSetName) {
+		invalidParams.Add(request.NewErrParamPattern("ChangeSetName", "[a-zA-Z][-a-zA-Z0-9]*|arn:[-a-zA-Z0-9:/]*"))
+	}
+	if s.ClientRequestToken != nil && len(*s.ClientRequestToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientRequestToken", 128))
+	}
+	if s.ClientRequestToken != nil && !request.MatchParamPattern("[a-zA-Z0-9][-a-zA-Z0-9]*", *s.ClientRequestToken) {
+		invalidParams.Add(request.NewErrParamPattern("ClientRequestToken", "[a-zA-Z0-9][-a-zA-Z0-9]*"))
+	}
+	if s.StackName != nil && !request.MatchParamPattern("([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)", *s.StackName) {
+		invalidParams.Add(request.NewErrParamPattern("StackName", "([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeSetName sets the ChangeSetName field's value.
 func (s *ExecuteChangeSetInput) SetChangeSetName(v string) *ExecuteChangeSetInput {
 	s.ChangeSetName = &v
@@ -6753,6 +7181,30 @@ func (s *GetTemplateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetTemplateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTemplateInput"}
+	if s.ChangeSetName != nil && len(*s.ChangeSetName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("ChangeSetName", 1600))
+	}
+	if s.ChangeSetName != nil && !request.MatchParamPattern("[a-zA-Z][-a-zA-Z0-9]*|arn:[-a-zA-Z0-9:/]*", *s.ChangeSetName) {
+		invalidParams.Add(request.NewErrParamPattern("ChangeSetName", "[a-zA-Z][-a-zA-Z0-9]*|arn:[-a-zA-Z0-9:/]*"))
+	}
+	if s.TemplateStage != nil {
+		values := []string{"Original", "Processed"}
+		if !request.IsParamEnumValue(*s.TemplateStage, values) {
+			invalidParams.Add(request.NewErrParamEnum("TemplateStage", *s.TemplateStage, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeSetName sets the ChangeSetName field's value.
 func (s *GetTemplateInput) SetChangeSetName(v string) *GetTemplateInput {
 	s.ChangeSetName = &v
@@ -6884,6 +7336,27 @@ func (s *GetTemplateSummaryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetTemplateSummaryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTemplateSummaryInput"}
+	if s.StackName != nil && !request.MatchParamPattern("([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)", *s.StackName) {
+		invalidParams.Add(request.NewErrParamPattern("StackName", "([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)"))
+	}
+	if s.StackSetName != nil && !request.MatchParamPattern("[a-zA-Z][-a-zA-Z0-9]*", *s.StackSetName) {
+		invalidParams.Add(request.NewErrParamPattern("StackSetName", "[a-zA-Z][-a-zA-Z0-9]*"))
+	}
+	if s.TemplateURL != nil && len(*s.TemplateURL) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateURL", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStackName sets the StackName field's value.
 func (s *GetTemplateSummaryInput) SetStackName(v string) *GetTemplateSummaryInput {
 	s.StackName = &v
@@ -7052,6 +7525,24 @@ func (s *ListChangeSetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListChangeSetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListChangeSetsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.StackName != nil && !request.MatchParamPattern("([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)", *s.StackName) {
+		invalidParams.Add(request.NewErrParamPattern("StackName", "([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListChangeSetsInput) SetNextToken(v string) *ListChangeSetsInput {
 	s.NextToken = &v
@@ -7132,6 +7623,21 @@ func (s *ListExportsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListExportsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListExportsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListExportsInput) SetNextToken(v string) *ListExportsInput {
 	s.NextToken = &v
@@ -7213,6 +7719,21 @@ func (s *ListImportsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListImportsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListImportsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExportName sets the ExportName field's value.
 func (s *ListImportsInput) SetExportName(v string) *ListImportsInput {
 	s.ExportName = &v
@@ -7318,6 +7839,27 @@ func (s *ListStackInstancesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListStackInstancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListStackInstancesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.StackInstanceAccount != nil && !request.MatchParamPattern("[0-9]{12}", *s.StackInstanceAccount) {
+		invalidParams.Add(request.NewErrParamPattern("StackInstanceAccount", "[0-9]{12}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListStackInstancesInput) SetMaxResults(v int64) *ListStackInstancesInput {
 	s.MaxResults = &v
@@ -7434,6 +7976,21 @@ func (s *ListStackResourcesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListStackResourcesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListStackResourcesInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListStackResourcesInput) SetNextToken(v string) *ListStackResourcesInput {
 	s.NextToken = &v
@@ -7545,6 +8102,30 @@ func (s *ListStackSetOperationResultsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListStackSetOperationResultsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListStackSetOperationResultsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.OperationId != nil && len(*s.OperationId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("OperationId", 128))
+	}
+	if s.OperationId != nil && !request.MatchParamPattern("[a-zA-Z0-9][-a-zA-Z0-9]*", *s.OperationId) {
+		invalidParams.Add(request.NewErrParamPattern("OperationId", "[a-zA-Z0-9][-a-zA-Z0-9]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListStackSetOperationResultsInput) SetMaxResults(v int64) *ListStackSetOperationResultsInput {
 	s.MaxResults = &v
@@ -7660,6 +8241,24 @@ func (s *ListStackSetOperationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListStackSetOperationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListStackSetOperationsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListStackSetOperationsInput) SetMaxResults(v int64) *ListStackSetOperationsInput {
 	s.MaxResults = &v
@@ -7762,6 +8361,30 @@ func (s *ListStackSetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListStackSetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListStackSetsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.Status != nil {
+		values := []string{"ACTIVE", "DELETED"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListStackSetsInput) SetMaxResults(v int64) *ListStackSetsInput {
 	s.MaxResults = &v
@@ -7854,6 +8477,21 @@ func (s *ListStacksInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListStacksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListStacksInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListStacksInput) SetNextToken(v string) *ListStacksInput {
 	s.NextToken = &v
@@ -8442,6 +9080,24 @@ func (s *RollbackConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RollbackConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RollbackConfiguration"}
+	if s.MonitoringTimeInMinutes != nil && *s.MonitoringTimeInMinutes > 180 {
+		invalidParams.Add(request.NewErrParamMaxValue("MonitoringTimeInMinutes", 180))
+	}
+	if s.RollbackTriggers != nil && len(s.RollbackTriggers) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("RollbackTriggers", 5))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMonitoringTimeInMinutes sets the MonitoringTimeInMinutes field's value.
 func (s *RollbackConfiguration) SetMonitoringTimeInMinutes(v int64) *RollbackConfiguration {
 	s.MonitoringTimeInMinutes = &v
@@ -8565,6 +9221,24 @@ func (s *SetStackPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetStackPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetStackPolicyInput"}
+	if s.StackPolicyBody != nil && len(*s.StackPolicyBody) > 16384 {
+		invalidParams.Add(request.NewErrParamMaxLen("StackPolicyBody", 16384))
+	}
+	if s.StackPolicyURL != nil && len(*s.StackPolicyURL) > 1350 {
+		invalidParams.Add(request.NewErrParamMaxLen("StackPolicyURL", 1350))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStackName sets the StackName field's value.
 func (s *SetStackPolicyInput) SetStackName(v string) *SetStackPolicyInput {
 	s.StackName = &v
@@ -8668,6 +9342,30 @@ func (s *SignalResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SignalResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SignalResourceInput"}
+	if s.StackName != nil && !request.MatchParamPattern("([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)", *s.StackName) {
+		invalidParams.Add(request.NewErrParamPattern("StackName", "([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)"))
+	}
+	if s.Status != nil {
+		values := []string{"SUCCESS", "FAILURE"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+	if s.UniqueId != nil && len(*s.UniqueId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UniqueId", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLogicalResourceId sets the LogicalResourceId field's value.
 func (s *SignalResourceInput) SetLogicalResourceId(v string) *SignalResourceInput {
 	s.LogicalResourceId = &v
@@ -9814,6 +10512,24 @@ func (s *StackSetOperationPreferences) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StackSetOperationPreferences) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StackSetOperationPreferences"}
+	if s.FailureTolerancePercentage != nil && *s.FailureTolerancePercentage > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("FailureTolerancePercentage", 100))
+	}
+	if s.MaxConcurrentPercentage != nil && *s.MaxConcurrentPercentage > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxConcurrentPercentage", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFailureToleranceCount sets the FailureToleranceCount field's value.
 func (s *StackSetOperationPreferences) SetFailureToleranceCount(v int64) *StackSetOperationPreferences {
 	s.FailureToleranceCount = &v
@@ -10209,6 +10925,24 @@ func (s *StopStackSetOperationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopStackSetOperationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopStackSetOperationInput"}
+	if s.OperationId != nil && len(*s.OperationId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("OperationId", 128))
+	}
+	if s.OperationId != nil && !request.MatchParamPattern("[a-zA-Z0-9][-a-zA-Z0-9]*", *s.OperationId) {
+		invalidParams.Add(request.NewErrParamPattern("OperationId", "[a-zA-Z0-9][-a-zA-Z0-9]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetOperationId sets the OperationId field's value.
 func (s *StopStackSetOperationInput) SetOperationId(v string) *StopStackSetOperationInput {
 	s.OperationId = &v
@@ -10288,6 +11022,24 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -10578,6 +11330,63 @@ func (s *UpdateStackInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateStackInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateStackInput"}
+	if s.ClientRequestToken != nil && len(*s.ClientRequestToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientRequestToken", 128))
+	}
+	if s.ClientRequestToken != nil && !request.MatchParamPattern("[a-zA-Z0-9][-a-zA-Z0-9]*", *s.ClientRequestToken) {
+		invalidParams.Add(request.NewErrParamPattern("ClientRequestToken", "[a-zA-Z0-9][-a-zA-Z0-9]*"))
+	}
+	if s.NotificationARNs != nil && len(s.NotificationARNs) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("NotificationARNs", 5))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 2048))
+	}
+	if s.StackPolicyBody != nil && len(*s.StackPolicyBody) > 16384 {
+		invalidParams.Add(request.NewErrParamMaxLen("StackPolicyBody", 16384))
+	}
+	if s.StackPolicyDuringUpdateBody != nil && len(*s.StackPolicyDuringUpdateBody) > 16384 {
+		invalidParams.Add(request.NewErrParamMaxLen("StackPolicyDuringUpdateBody", 16384))
+	}
+	if s.StackPolicyDuringUpdateURL != nil && len(*s.StackPolicyDuringUpdateURL) > 1350 {
+		invalidParams.Add(request.NewErrParamMaxLen("StackPolicyDuringUpdateURL", 1350))
+	}
+	if s.StackPolicyURL != nil && len(*s.StackPolicyURL) > 1350 {
+		invalidParams.Add(request.NewErrParamMaxLen("StackPolicyURL", 1350))
+	}
+	if s.Tags != nil && len(s.Tags) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 50))
+	}
+	if s.TemplateURL != nil && len(*s.TemplateURL) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateURL", 1024))
+	}
+	if s.RollbackConfiguration != nil {
+		if err := s.RollbackConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RollbackConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCapabilities sets the Capabilities field's value.
 func (s *UpdateStackInput) SetCapabilities(v []*string) *UpdateStackInput {
 	s.Capabilities = v
@@ -10871,6 +11680,48 @@ func (s *UpdateStackSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateStackSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateStackSetInput"}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.OperationId != nil && len(*s.OperationId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("OperationId", 128))
+	}
+	if s.OperationId != nil && !request.MatchParamPattern("[a-zA-Z0-9][-a-zA-Z0-9]*", *s.OperationId) {
+		invalidParams.Add(request.NewErrParamPattern("OperationId", "[a-zA-Z0-9][-a-zA-Z0-9]*"))
+	}
+	if s.Tags != nil && len(s.Tags) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 50))
+	}
+	if s.TemplateURL != nil && len(*s.TemplateURL) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateURL", 1024))
+	}
+	if s.OperationPreferences != nil {
+		if err := s.OperationPreferences.ValidateExtended(); err != nil {
+			invalidParams.AddNested("OperationPreferences", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCapabilities sets the Capabilities field's value.
 func (s *UpdateStackSetInput) SetCapabilities(v []*string) *UpdateStackSetInput {
 	s.Capabilities = v
@@ -11005,6 +11856,21 @@ func (s *ValidateTemplateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ValidateTemplateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ValidateTemplateInput"}
+	if s.TemplateURL != nil && len(*s.TemplateURL) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateURL", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTemplateBody sets the TemplateBody field's value.
 func (s *ValidateTemplateInput) SetTemplateBody(v string) *ValidateTemplateInput {
 	s.TemplateBody = &v
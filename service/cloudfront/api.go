@@ -3601,6 +3601,34 @@ func (s *CacheBehavior) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CacheBehavior) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CacheBehavior"}
+	if s.ViewerProtocolPolicy != nil {
+		values := []string{"allow-all", "https-only", "redirect-to-https"}
+		if !request.IsParamEnumValue(*s.ViewerProtocolPolicy, values) {
+			invalidParams.Add(request.NewErrParamEnum("ViewerProtocolPolicy", *s.ViewerProtocolPolicy, values))
+		}
+	}
+	if s.ForwardedValues != nil {
+		if err := s.ForwardedValues.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ForwardedValues", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.LambdaFunctionAssociations != nil {
+		if err := s.LambdaFunctionAssociations.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LambdaFunctionAssociations", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllowedMethods sets the AllowedMethods field's value.
 func (s *CacheBehavior) SetAllowedMethods(v *AllowedMethods) *CacheBehavior {
 	s.AllowedMethods = v
@@ -3795,6 +3823,28 @@ func (s *CacheBehaviors) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CacheBehaviors) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CacheBehaviors"}
+	if s.Items != nil {
+		for i, v := range s.Items {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Items", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetItems sets the Items field's value.
 func (s *CacheBehaviors) SetItems(v []*CacheBehavior) *CacheBehaviors {
 	s.Items = v
@@ -4039,6 +4089,24 @@ func (s *CookiePreference) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CookiePreference) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CookiePreference"}
+	if s.Forward != nil {
+		values := []string{"none", "whitelist", "all"}
+		if !request.IsParamEnumValue(*s.Forward, values) {
+			invalidParams.Add(request.NewErrParamEnum("Forward", *s.Forward, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetForward sets the Forward field's value.
 func (s *CookiePreference) SetForward(v string) *CookiePreference {
 	s.Forward = &v
@@ -4227,6 +4295,23 @@ func (s *CreateDistributionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDistributionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDistributionInput"}
+	if s.DistributionConfig != nil {
+		if err := s.DistributionConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DistributionConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDistributionConfig sets the DistributionConfig field's value.
 func (s *CreateDistributionInput) SetDistributionConfig(v *DistributionConfig) *CreateDistributionInput {
 	s.DistributionConfig = v
@@ -4348,6 +4433,23 @@ func (s *CreateDistributionWithTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDistributionWithTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDistributionWithTagsInput"}
+	if s.DistributionConfigWithTags != nil {
+		if err := s.DistributionConfigWithTags.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DistributionConfigWithTags", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDistributionConfigWithTags sets the DistributionConfigWithTags field's value.
 func (s *CreateDistributionWithTagsInput) SetDistributionConfigWithTags(v *DistributionConfigWithTags) *CreateDistributionWithTagsInput {
 	s.DistributionConfigWithTags = v
@@ -4595,6 +4697,23 @@ func (s *CreateStreamingDistributionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateStreamingDistributionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateStreamingDistributionInput"}
+	if s.StreamingDistributionConfig != nil {
+		if err := s.StreamingDistributionConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StreamingDistributionConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStreamingDistributionConfig sets the StreamingDistributionConfig field's value.
 func (s *CreateStreamingDistributionInput) SetStreamingDistributionConfig(v *StreamingDistributionConfig) *CreateStreamingDistributionInput {
 	s.StreamingDistributionConfig = v
@@ -4716,6 +4835,23 @@ func (s *CreateStreamingDistributionWithTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateStreamingDistributionWithTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateStreamingDistributionWithTagsInput"}
+	if s.StreamingDistributionConfigWithTags != nil {
+		if err := s.StreamingDistributionConfigWithTags.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StreamingDistributionConfigWithTags", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStreamingDistributionConfigWithTags sets the StreamingDistributionConfigWithTags field's value.
 func (s *CreateStreamingDistributionWithTagsInput) SetStreamingDistributionConfigWithTags(v *StreamingDistributionConfigWithTags) *CreateStreamingDistributionWithTagsInput {
 	s.StreamingDistributionConfigWithTags = v
@@ -5201,6 +5337,24 @@ func (s *CustomOriginConfig) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CustomOriginConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CustomOriginConfig"}
+	if s.OriginProtocolPolicy != nil {
+		values := []string{"http-only", "match-viewer", "https-only"}
+		if !request.IsParamEnumValue(*s.OriginProtocolPolicy, values) {
+			invalidParams.Add(request.NewErrParamEnum("OriginProtocolPolicy", *s.OriginProtocolPolicy, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHTTPPort sets the HTTPPort field's value.
 func (s *CustomOriginConfig) SetHTTPPort(v int64) *CustomOriginConfig {
 	s.HTTPPort = &v
@@ -5455,6 +5609,34 @@ func (s *DefaultCacheBehavior) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DefaultCacheBehavior) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DefaultCacheBehavior"}
+	if s.ViewerProtocolPolicy != nil {
+		values := []string{"allow-all", "https-only", "redirect-to-https"}
+		if !request.IsParamEnumValue(*s.ViewerProtocolPolicy, values) {
+			invalidParams.Add(request.NewErrParamEnum("ViewerProtocolPolicy", *s.ViewerProtocolPolicy, values))
+		}
+	}
+	if s.ForwardedValues != nil {
+		if err := s.ForwardedValues.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ForwardedValues", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.LambdaFunctionAssociations != nil {
+		if err := s.LambdaFunctionAssociations.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LambdaFunctionAssociations", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllowedMethods sets the AllowedMethods field's value.
 func (s *DefaultCacheBehavior) SetAllowedMethods(v *AllowedMethods) *DefaultCacheBehavior {
 	s.AllowedMethods = v
@@ -6309,6 +6491,55 @@ func (s *DistributionConfig) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DistributionConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DistributionConfig"}
+	if s.HttpVersion != nil {
+		values := []string{"http1.1", "http2"}
+		if !request.IsParamEnumValue(*s.HttpVersion, values) {
+			invalidParams.Add(request.NewErrParamEnum("HttpVersion", *s.HttpVersion, values))
+		}
+	}
+	if s.PriceClass != nil {
+		values := []string{"PriceClass_100", "PriceClass_200", "PriceClass_All"}
+		if !request.IsParamEnumValue(*s.PriceClass, values) {
+			invalidParams.Add(request.NewErrParamEnum("PriceClass", *s.PriceClass, values))
+		}
+	}
+	if s.CacheBehaviors != nil {
+		if err := s.CacheBehaviors.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CacheBehaviors", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DefaultCacheBehavior != nil {
+		if err := s.DefaultCacheBehavior.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DefaultCacheBehavior", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Origins != nil {
+		if err := s.Origins.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Origins", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Restrictions != nil {
+		if err := s.Restrictions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Restrictions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ViewerCertificate != nil {
+		if err := s.ViewerCertificate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ViewerCertificate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAliases sets the Aliases field's value.
 func (s *DistributionConfig) SetAliases(v *Aliases) *DistributionConfig {
 	s.Aliases = v
@@ -6544,6 +6775,28 @@ func (s *DistributionConfigWithTags) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DistributionConfigWithTags) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DistributionConfigWithTags"}
+	if s.DistributionConfig != nil {
+		if err := s.DistributionConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DistributionConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		if err := s.Tags.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Tags", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDistributionConfig sets the DistributionConfig field's value.
 func (s *DistributionConfigWithTags) SetDistributionConfig(v *DistributionConfig) *DistributionConfigWithTags {
 	s.DistributionConfig = v
@@ -7121,6 +7374,23 @@ func (s *ForwardedValues) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ForwardedValues) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ForwardedValues"}
+	if s.Cookies != nil {
+		if err := s.Cookies.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Cookies", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCookies sets the Cookies field's value.
 func (s *ForwardedValues) SetCookies(v *CookiePreference) *ForwardedValues {
 	s.Cookies = v
@@ -7241,6 +7511,24 @@ func (s *GeoRestriction) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GeoRestriction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GeoRestriction"}
+	if s.RestrictionType != nil {
+		values := []string{"blacklist", "whitelist", "none"}
+		if !request.IsParamEnumValue(*s.RestrictionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("RestrictionType", *s.RestrictionType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetItems sets the Items field's value.
 func (s *GeoRestriction) SetItems(v []*string) *GeoRestriction {
 	s.Items = v
@@ -8563,6 +8851,24 @@ func (s LambdaFunctionAssociation) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LambdaFunctionAssociation) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LambdaFunctionAssociation"}
+	if s.EventType != nil {
+		values := []string{"viewer-request", "viewer-response", "origin-request", "origin-response"}
+		if !request.IsParamEnumValue(*s.EventType, values) {
+			invalidParams.Add(request.NewErrParamEnum("EventType", *s.EventType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEventType sets the EventType field's value.
 func (s *LambdaFunctionAssociation) SetEventType(v string) *LambdaFunctionAssociation {
 	s.EventType = &v
@@ -8647,6 +8953,28 @@ func (s *LambdaFunctionAssociations) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LambdaFunctionAssociations) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LambdaFunctionAssociations"}
+	if s.Items != nil {
+		for i, v := range s.Items {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Items", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetItems sets the Items field's value.
 func (s *LambdaFunctionAssociations) SetItems(v []*LambdaFunctionAssociation) *LambdaFunctionAssociations {
 	s.Items = v
@@ -9221,6 +9549,21 @@ func (s *ListTagsForResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsForResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
+	if s.Resource != nil && !request.MatchParamPattern("arn:aws:cloudfront::[0-9]+:.*", *s.Resource) {
+		invalidParams.Add(request.NewErrParamPattern("Resource", "arn:aws:cloudfront::[0-9]+:.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResource sets the Resource field's value.
 func (s *ListTagsForResourceInput) SetResource(v string) *ListTagsForResourceInput {
 	s.Resource = &v
@@ -9525,6 +9868,23 @@ func (s *Origin) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Origin) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Origin"}
+	if s.CustomOriginConfig != nil {
+		if err := s.CustomOriginConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CustomOriginConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCustomHeaders sets the CustomHeaders field's value.
 func (s *Origin) SetCustomHeaders(v *CustomHeaders) *Origin {
 	s.CustomHeaders = v
@@ -10174,6 +10534,28 @@ func (s *Origins) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Origins) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Origins"}
+	if s.Items != nil {
+		for i, v := range s.Items {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Items", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetItems sets the Items field's value.
 func (s *Origins) SetItems(v []*Origin) *Origins {
 	s.Items = v
@@ -10377,6 +10759,23 @@ func (s *Restrictions) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Restrictions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Restrictions"}
+	if s.GeoRestriction != nil {
+		if err := s.GeoRestriction.ValidateExtended(); err != nil {
+			invalidParams.AddNested("GeoRestriction", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGeoRestriction sets the GeoRestriction field's value.
 func (s *Restrictions) SetGeoRestriction(v *GeoRestriction) *Restrictions {
 	s.GeoRestriction = v
@@ -10877,6 +11276,24 @@ func (s *StreamingDistributionConfig) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StreamingDistributionConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StreamingDistributionConfig"}
+	if s.PriceClass != nil {
+		values := []string{"PriceClass_100", "PriceClass_200", "PriceClass_All"}
+		if !request.IsParamEnumValue(*s.PriceClass, values) {
+			invalidParams.Add(request.NewErrParamEnum("PriceClass", *s.PriceClass, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAliases sets the Aliases field's value.
 func (s *StreamingDistributionConfig) SetAliases(v *Aliases) *StreamingDistributionConfig {
 	s.Aliases = v
@@ -11024,6 +11441,28 @@ func (s *StreamingDistributionConfigWithTags) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StreamingDistributionConfigWithTags) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StreamingDistributionConfigWithTags"}
+	if s.StreamingDistributionConfig != nil {
+		if err := s.StreamingDistributionConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StreamingDistributionConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		if err := s.Tags.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Tags", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStreamingDistributionConfig sets the StreamingDistributionConfig field's value.
 func (s *StreamingDistributionConfigWithTags) SetStreamingDistributionConfig(v *StreamingDistributionConfig) *StreamingDistributionConfigWithTags {
 	s.StreamingDistributionConfig = v
@@ -11536,6 +11975,30 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+	if s.Value != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Value) {
+		invalidParams.Add(request.NewErrParamPattern("Value", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -11655,6 +12118,26 @@ func (s *TagResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TagResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
+	if s.Resource != nil && !request.MatchParamPattern("arn:aws:cloudfront::[0-9]+:.*", *s.Resource) {
+		invalidParams.Add(request.NewErrParamPattern("Resource", "arn:aws:cloudfront::[0-9]+:.*"))
+	}
+	if s.Tags != nil {
+		if err := s.Tags.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Tags", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResource sets the Resource field's value.
 func (s *TagResourceInput) SetResource(v string) *TagResourceInput {
 	s.Resource = &v
@@ -11743,6 +12226,28 @@ func (s *Tags) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tags) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tags"}
+	if s.Items != nil {
+		for i, v := range s.Items {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Items", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetItems sets the Items field's value.
 func (s *Tags) SetItems(v []*Tag) *Tags {
 	s.Items = v
@@ -11905,6 +12410,21 @@ func (s *UntagResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UntagResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UntagResourceInput"}
+	if s.Resource != nil && !request.MatchParamPattern("arn:aws:cloudfront::[0-9]+:.*", *s.Resource) {
+		invalidParams.Add(request.NewErrParamPattern("Resource", "arn:aws:cloudfront::[0-9]+:.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResource sets the Resource field's value.
 func (s *UntagResourceInput) SetResource(v string) *UntagResourceInput {
 	s.Resource = &v
@@ -12145,6 +12665,23 @@ func (s *UpdateDistributionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDistributionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDistributionInput"}
+	if s.DistributionConfig != nil {
+		if err := s.DistributionConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DistributionConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDistributionConfig sets the DistributionConfig field's value.
 func (s *UpdateDistributionInput) SetDistributionConfig(v *DistributionConfig) *UpdateDistributionInput {
 	s.DistributionConfig = v
@@ -12285,6 +12822,23 @@ func (s *UpdateStreamingDistributionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateStreamingDistributionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateStreamingDistributionInput"}
+	if s.StreamingDistributionConfig != nil {
+		if err := s.StreamingDistributionConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StreamingDistributionConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *UpdateStreamingDistributionInput) SetId(v string) *UpdateStreamingDistributionInput {
 	s.Id = &v
@@ -12539,6 +13093,36 @@ func (s ViewerCertificate) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ViewerCertificate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ViewerCertificate"}
+	if s.CertificateSource != nil {
+		values := []string{"cloudfront", "iam", "acm"}
+		if !request.IsParamEnumValue(*s.CertificateSource, values) {
+			invalidParams.Add(request.NewErrParamEnum("CertificateSource", *s.CertificateSource, values))
+		}
+	}
+	if s.MinimumProtocolVersion != nil {
+		values := []string{"SSLv3", "TLSv1"}
+		if !request.IsParamEnumValue(*s.MinimumProtocolVersion, values) {
+			invalidParams.Add(request.NewErrParamEnum("MinimumProtocolVersion", *s.MinimumProtocolVersion, values))
+		}
+	}
+	if s.SSLSupportMethod != nil {
+		values := []string{"sni-only", "vip"}
+		if !request.IsParamEnumValue(*s.SSLSupportMethod, values) {
+			invalidParams.Add(request.NewErrParamEnum("SSLSupportMethod", *s.SSLSupportMethod, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetACMCertificateArn sets the ACMCertificateArn field's value.
 func (s *ViewerCertificate) SetACMCertificateArn(v string) *ViewerCertificate {
 	s.ACMCertificateArn = &v
@@ -1799,6 +1799,31 @@ func (s *AddTagsToResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddTagsToResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddTagsToResourceInput"}
+	if s.ResourceArn != nil && !request.MatchParamPattern("[\\w :+=./\\\\-]*", *s.ResourceArn) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceArn", "[\\w :+=./\\\\-]*"))
+	}
+	if s.TagList != nil {
+		for i, v := range s.TagList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TagList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceArn sets the ResourceArn field's value.
 func (s *AddTagsToResourceInput) SetResourceArn(v string) *AddTagsToResourceInput {
 	s.ResourceArn = &v
@@ -1871,6 +1896,21 @@ func (s *CreateHapgInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateHapgInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateHapgInput"}
+	if s.Label != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]{1,64}", *s.Label) {
+		invalidParams.Add(request.NewErrParamPattern("Label", "[a-zA-Z0-9_.-]{1,64}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLabel sets the Label field's value.
 func (s *CreateHapgInput) SetLabel(v string) *CreateHapgInput {
 	s.Label = &v
@@ -1981,6 +2021,45 @@ func (s *CreateHsmInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateHsmInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateHsmInput"}
+	if s.ClientToken != nil && !request.MatchParamPattern("[a-zA-Z0-9]{1,64}", *s.ClientToken) {
+		invalidParams.Add(request.NewErrParamPattern("ClientToken", "[a-zA-Z0-9]{1,64}"))
+	}
+	if s.EniIp != nil && !request.MatchParamPattern("\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}", *s.EniIp) {
+		invalidParams.Add(request.NewErrParamPattern("EniIp", "\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"))
+	}
+	if s.ExternalId != nil && !request.MatchParamPattern("[\\w :+=./-]*", *s.ExternalId) {
+		invalidParams.Add(request.NewErrParamPattern("ExternalId", "[\\w :+=./-]*"))
+	}
+	if s.IamRoleArn != nil && !request.MatchParamPattern("arn:aws(-iso)?:iam::[0-9]{12}:role/[a-zA-Z0-9_\\+=,\\.\\-@]{1,64}", *s.IamRoleArn) {
+		invalidParams.Add(request.NewErrParamPattern("IamRoleArn", "arn:aws(-iso)?:iam::[0-9]{12}:role/[a-zA-Z0-9_\\+=,\\.\\-@]{1,64}"))
+	}
+	if s.SshKey != nil && !request.MatchParamPattern("[a-zA-Z0-9+/= ._:\\\\@-]*", *s.SshKey) {
+		invalidParams.Add(request.NewErrParamPattern("SshKey", "[a-zA-Z0-9+/= ._:\\\\@-]*"))
+	}
+	if s.SubnetId != nil && !request.MatchParamPattern("subnet-[0-9a-f]{8}", *s.SubnetId) {
+		invalidParams.Add(request.NewErrParamPattern("SubnetId", "subnet-[0-9a-f]{8}"))
+	}
+	if s.SubscriptionType != nil {
+		values := []string{"PRODUCTION"}
+		if !request.IsParamEnumValue(*s.SubscriptionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("SubscriptionType", *s.SubscriptionType, values))
+		}
+	}
+	if s.SyslogIp != nil && !request.MatchParamPattern("\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}", *s.SyslogIp) {
+		invalidParams.Add(request.NewErrParamPattern("SyslogIp", "\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientToken sets the ClientToken field's value.
 func (s *CreateHsmInput) SetClientToken(v string) *CreateHsmInput {
 	s.ClientToken = &v
@@ -2095,6 +2174,27 @@ func (s *CreateLunaClientInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateLunaClientInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateLunaClientInput"}
+	if s.Certificate != nil && len(*s.Certificate) > 2400 {
+		invalidParams.Add(request.NewErrParamMaxLen("Certificate", 2400))
+	}
+	if s.Certificate != nil && !request.MatchParamPattern("[\\w :+=./\\n-]*", *s.Certificate) {
+		invalidParams.Add(request.NewErrParamPattern("Certificate", "[\\w :+=./\\n-]*"))
+	}
+	if s.Label != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]{2,64}", *s.Label) {
+		invalidParams.Add(request.NewErrParamPattern("Label", "[a-zA-Z0-9_.-]{2,64}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificate sets the Certificate field's value.
 func (s *CreateLunaClientInput) SetCertificate(v string) *CreateLunaClientInput {
 	s.Certificate = &v
@@ -2166,6 +2266,21 @@ func (s *DeleteHapgInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteHapgInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteHapgInput"}
+	if s.HapgArn != nil && !request.MatchParamPattern("arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}", *s.HapgArn) {
+		invalidParams.Add(request.NewErrParamPattern("HapgArn", "arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHapgArn sets the HapgArn field's value.
 func (s *DeleteHapgInput) SetHapgArn(v string) *DeleteHapgInput {
 	s.HapgArn = &v
@@ -2233,6 +2348,21 @@ func (s *DeleteHsmInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteHsmInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteHsmInput"}
+	if s.HsmArn != nil && !request.MatchParamPattern("arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}", *s.HsmArn) {
+		invalidParams.Add(request.NewErrParamPattern("HsmArn", "arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHsmArn sets the HsmArn field's value.
 func (s *DeleteHsmInput) SetHsmArn(v string) *DeleteHsmInput {
 	s.HsmArn = &v
@@ -2299,6 +2429,21 @@ func (s *DeleteLunaClientInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteLunaClientInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteLunaClientInput"}
+	if s.ClientArn != nil && !request.MatchParamPattern("arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}", *s.ClientArn) {
+		invalidParams.Add(request.NewErrParamPattern("ClientArn", "arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientArn sets the ClientArn field's value.
 func (s *DeleteLunaClientInput) SetClientArn(v string) *DeleteLunaClientInput {
 	s.ClientArn = &v
@@ -2365,6 +2510,21 @@ func (s *DescribeHapgInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeHapgInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeHapgInput"}
+	if s.HapgArn != nil && !request.MatchParamPattern("arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}", *s.HapgArn) {
+		invalidParams.Add(request.NewErrParamPattern("HapgArn", "arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHapgArn sets the HapgArn field's value.
 func (s *DescribeHapgInput) SetHapgArn(v string) *DescribeHapgInput {
 	s.HapgArn = &v
@@ -2493,6 +2653,24 @@ func (s DescribeHsmInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeHsmInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeHsmInput"}
+	if s.HsmArn != nil && !request.MatchParamPattern("arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}", *s.HsmArn) {
+		invalidParams.Add(request.NewErrParamPattern("HsmArn", "arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}"))
+	}
+	if s.HsmSerialNumber != nil && !request.MatchParamPattern("\\d{1,16}", *s.HsmSerialNumber) {
+		invalidParams.Add(request.NewErrParamPattern("HsmSerialNumber", "\\d{1,16}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHsmArn sets the HsmArn field's value.
 func (s *DescribeHsmInput) SetHsmArn(v string) *DescribeHsmInput {
 	s.HsmArn = &v
@@ -2734,6 +2912,24 @@ func (s DescribeLunaClientInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeLunaClientInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeLunaClientInput"}
+	if s.CertificateFingerprint != nil && !request.MatchParamPattern("([0-9a-fA-F][0-9a-fA-F]:){15}[0-9a-fA-F][0-9a-fA-F]", *s.CertificateFingerprint) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateFingerprint", "([0-9a-fA-F][0-9a-fA-F]:){15}[0-9a-fA-F][0-9a-fA-F]"))
+	}
+	if s.ClientArn != nil && !request.MatchParamPattern("arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}", *s.ClientArn) {
+		invalidParams.Add(request.NewErrParamPattern("ClientArn", "arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateFingerprint sets the CertificateFingerprint field's value.
 func (s *DescribeLunaClientInput) SetCertificateFingerprint(v string) *DescribeLunaClientInput {
 	s.CertificateFingerprint = &v
@@ -2856,6 +3052,27 @@ func (s *GetConfigInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetConfigInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetConfigInput"}
+	if s.ClientArn != nil && !request.MatchParamPattern("arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}", *s.ClientArn) {
+		invalidParams.Add(request.NewErrParamPattern("ClientArn", "arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"))
+	}
+	if s.ClientVersion != nil {
+		values := []string{"5.1", "5.3"}
+		if !request.IsParamEnumValue(*s.ClientVersion, values) {
+			invalidParams.Add(request.NewErrParamEnum("ClientVersion", *s.ClientVersion, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientArn sets the ClientArn field's value.
 func (s *GetConfigInput) SetClientArn(v string) *GetConfigInput {
 	s.ClientArn = &v
@@ -2975,6 +3192,21 @@ func (s ListHapgsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListHapgsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListHapgsInput"}
+	if s.NextToken != nil && !request.MatchParamPattern("[a-zA-Z0-9+/]*", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[a-zA-Z0-9+/]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListHapgsInput) SetNextToken(v string) *ListHapgsInput {
 	s.NextToken = &v
@@ -3036,6 +3268,21 @@ func (s ListHsmsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListHsmsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListHsmsInput"}
+	if s.NextToken != nil && !request.MatchParamPattern("[a-zA-Z0-9+/]*", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[a-zA-Z0-9+/]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListHsmsInput) SetNextToken(v string) *ListHsmsInput {
 	s.NextToken = &v
@@ -3096,6 +3343,21 @@ func (s ListLunaClientsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListLunaClientsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListLunaClientsInput"}
+	if s.NextToken != nil && !request.MatchParamPattern("[a-zA-Z0-9+/]*", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[a-zA-Z0-9+/]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListLunaClientsInput) SetNextToken(v string) *ListLunaClientsInput {
 	s.NextToken = &v
@@ -3171,6 +3433,21 @@ func (s *ListTagsForResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsForResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
+	if s.ResourceArn != nil && !request.MatchParamPattern("[\\w :+=./\\\\-]*", *s.ResourceArn) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceArn", "[\\w :+=./\\\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceArn sets the ResourceArn field's value.
 func (s *ListTagsForResourceInput) SetResourceArn(v string) *ListTagsForResourceInput {
 	s.ResourceArn = &v
@@ -3243,6 +3520,24 @@ func (s *ModifyHapgInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyHapgInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyHapgInput"}
+	if s.HapgArn != nil && !request.MatchParamPattern("arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}", *s.HapgArn) {
+		invalidParams.Add(request.NewErrParamPattern("HapgArn", "arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}"))
+	}
+	if s.Label != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]{1,64}", *s.Label) {
+		invalidParams.Add(request.NewErrParamPattern("Label", "[a-zA-Z0-9_.-]{1,64}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHapgArn sets the HapgArn field's value.
 func (s *ModifyHapgInput) SetHapgArn(v string) *ModifyHapgInput {
 	s.HapgArn = &v
@@ -3340,6 +3635,36 @@ func (s *ModifyHsmInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyHsmInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyHsmInput"}
+	if s.EniIp != nil && !request.MatchParamPattern("\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}", *s.EniIp) {
+		invalidParams.Add(request.NewErrParamPattern("EniIp", "\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"))
+	}
+	if s.ExternalId != nil && !request.MatchParamPattern("[\\w :+=./-]*", *s.ExternalId) {
+		invalidParams.Add(request.NewErrParamPattern("ExternalId", "[\\w :+=./-]*"))
+	}
+	if s.HsmArn != nil && !request.MatchParamPattern("arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}", *s.HsmArn) {
+		invalidParams.Add(request.NewErrParamPattern("HsmArn", "arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}"))
+	}
+	if s.IamRoleArn != nil && !request.MatchParamPattern("arn:aws(-iso)?:iam::[0-9]{12}:role/[a-zA-Z0-9_\\+=,\\.\\-@]{1,64}", *s.IamRoleArn) {
+		invalidParams.Add(request.NewErrParamPattern("IamRoleArn", "arn:aws(-iso)?:iam::[0-9]{12}:role/[a-zA-Z0-9_\\+=,\\.\\-@]{1,64}"))
+	}
+	if s.SubnetId != nil && !request.MatchParamPattern("subnet-[0-9a-f]{8}", *s.SubnetId) {
+		invalidParams.Add(request.NewErrParamPattern("SubnetId", "subnet-[0-9a-f]{8}"))
+	}
+	if s.SyslogIp != nil && !request.MatchParamPattern("\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}", *s.SyslogIp) {
+		invalidParams.Add(request.NewErrParamPattern("SyslogIp", "\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEniIp sets the EniIp field's value.
 func (s *ModifyHsmInput) SetEniIp(v string) *ModifyHsmInput {
 	s.EniIp = &v
@@ -3445,6 +3770,27 @@ func (s *ModifyLunaClientInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyLunaClientInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyLunaClientInput"}
+	if s.Certificate != nil && len(*s.Certificate) > 2400 {
+		invalidParams.Add(request.NewErrParamMaxLen("Certificate", 2400))
+	}
+	if s.Certificate != nil && !request.MatchParamPattern("[\\w :+=./\\n-]*", *s.Certificate) {
+		invalidParams.Add(request.NewErrParamPattern("Certificate", "[\\w :+=./\\n-]*"))
+	}
+	if s.ClientArn != nil && !request.MatchParamPattern("arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}", *s.ClientArn) {
+		invalidParams.Add(request.NewErrParamPattern("ClientArn", "arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificate sets the Certificate field's value.
 func (s *ModifyLunaClientInput) SetCertificate(v string) *ModifyLunaClientInput {
 	s.Certificate = &v
@@ -3525,6 +3871,21 @@ func (s *RemoveTagsFromResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveTagsFromResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveTagsFromResourceInput"}
+	if s.ResourceArn != nil && !request.MatchParamPattern("[\\w :+=./\\\\-]*", *s.ResourceArn) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceArn", "[\\w :+=./\\\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceArn sets the ResourceArn field's value.
 func (s *RemoveTagsFromResourceInput) SetResourceArn(v string) *RemoveTagsFromResourceInput {
 	s.ResourceArn = &v
@@ -3609,6 +3970,24 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -1443,6 +1443,27 @@ func (s *CreateClusterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateClusterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateClusterInput"}
+	if s.HsmType != nil && !request.MatchParamPattern("(hsm1\\.medium)", *s.HsmType) {
+		invalidParams.Add(request.NewErrParamPattern("HsmType", "(hsm1\\.medium)"))
+	}
+	if s.SourceBackupId != nil && !request.MatchParamPattern("backup-[2-7a-zA-Z]{11,16}", *s.SourceBackupId) {
+		invalidParams.Add(request.NewErrParamPattern("SourceBackupId", "backup-[2-7a-zA-Z]{11,16}"))
+	}
+	if s.SubnetIds != nil && len(s.SubnetIds) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("SubnetIds", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHsmType sets the HsmType field's value.
 func (s *CreateClusterInput) SetHsmType(v string) *CreateClusterInput {
 	s.HsmType = &v
@@ -1533,6 +1554,27 @@ func (s *CreateHsmInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateHsmInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateHsmInput"}
+	if s.AvailabilityZone != nil && !request.MatchParamPattern("[a-z]{2}(-(gov|isob|iso))?-(east|west|north|south|central){1,2}-\\d[a-z]", *s.AvailabilityZone) {
+		invalidParams.Add(request.NewErrParamPattern("AvailabilityZone", "[a-z]{2}(-(gov|isob|iso))?-(east|west|north|south|central){1,2}-\\d[a-z]"))
+	}
+	if s.ClusterId != nil && !request.MatchParamPattern("cluster-[2-7a-zA-Z]{11,16}", *s.ClusterId) {
+		invalidParams.Add(request.NewErrParamPattern("ClusterId", "cluster-[2-7a-zA-Z]{11,16}"))
+	}
+	if s.IpAddress != nil && !request.MatchParamPattern("\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}", *s.IpAddress) {
+		invalidParams.Add(request.NewErrParamPattern("IpAddress", "\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAvailabilityZone sets the AvailabilityZone field's value.
 func (s *CreateHsmInput) SetAvailabilityZone(v string) *CreateHsmInput {
 	s.AvailabilityZone = &v
@@ -1609,6 +1651,21 @@ func (s *DeleteClusterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteClusterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteClusterInput"}
+	if s.ClusterId != nil && !request.MatchParamPattern("cluster-[2-7a-zA-Z]{11,16}", *s.ClusterId) {
+		invalidParams.Add(request.NewErrParamPattern("ClusterId", "cluster-[2-7a-zA-Z]{11,16}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClusterId sets the ClusterId field's value.
 func (s *DeleteClusterInput) SetClusterId(v string) *DeleteClusterInput {
 	s.ClusterId = &v
@@ -1683,6 +1740,30 @@ func (s *DeleteHsmInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteHsmInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteHsmInput"}
+	if s.ClusterId != nil && !request.MatchParamPattern("cluster-[2-7a-zA-Z]{11,16}", *s.ClusterId) {
+		invalidParams.Add(request.NewErrParamPattern("ClusterId", "cluster-[2-7a-zA-Z]{11,16}"))
+	}
+	if s.EniId != nil && !request.MatchParamPattern("eni-[0-9a-fA-F]{8}", *s.EniId) {
+		invalidParams.Add(request.NewErrParamPattern("EniId", "eni-[0-9a-fA-F]{8}"))
+	}
+	if s.EniIp != nil && !request.MatchParamPattern("\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}", *s.EniIp) {
+		invalidParams.Add(request.NewErrParamPattern("EniIp", "\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"))
+	}
+	if s.HsmId != nil && !request.MatchParamPattern("hsm-[2-7a-zA-Z]{11,16}", *s.HsmId) {
+		invalidParams.Add(request.NewErrParamPattern("HsmId", "hsm-[2-7a-zA-Z]{11,16}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClusterId sets the ClusterId field's value.
 func (s *DeleteHsmInput) SetClusterId(v string) *DeleteHsmInput {
 	s.ClusterId = &v
@@ -1778,6 +1859,27 @@ func (s *DescribeBackupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeBackupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeBackupsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 256))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern(".*", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", ".*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribeBackupsInput) SetFilters(v map[string][]*string) *DescribeBackupsInput {
 	s.Filters = v
@@ -1879,6 +1981,27 @@ func (s *DescribeClustersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeClustersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeClustersInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 256))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern(".*", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", ".*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribeClustersInput) SetFilters(v map[string][]*string) *DescribeClustersInput {
 	s.Filters = v
@@ -2078,6 +2201,33 @@ func (s *InitializeClusterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InitializeClusterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InitializeClusterInput"}
+	if s.ClusterId != nil && !request.MatchParamPattern("cluster-[2-7a-zA-Z]{11,16}", *s.ClusterId) {
+		invalidParams.Add(request.NewErrParamPattern("ClusterId", "cluster-[2-7a-zA-Z]{11,16}"))
+	}
+	if s.SignedCert != nil && len(*s.SignedCert) > 5000 {
+		invalidParams.Add(request.NewErrParamMaxLen("SignedCert", 5000))
+	}
+	if s.SignedCert != nil && !request.MatchParamPattern("[a-zA-Z0-9+-/=\\s]*", *s.SignedCert) {
+		invalidParams.Add(request.NewErrParamPattern("SignedCert", "[a-zA-Z0-9+-/=\\s]*"))
+	}
+	if s.TrustAnchor != nil && len(*s.TrustAnchor) > 5000 {
+		invalidParams.Add(request.NewErrParamMaxLen("TrustAnchor", 5000))
+	}
+	if s.TrustAnchor != nil && !request.MatchParamPattern("[a-zA-Z0-9+-/=\\s]*", *s.TrustAnchor) {
+		invalidParams.Add(request.NewErrParamPattern("TrustAnchor", "[a-zA-Z0-9+-/=\\s]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClusterId sets the ClusterId field's value.
 func (s *InitializeClusterInput) SetClusterId(v string) *InitializeClusterInput {
 	s.ClusterId = &v
@@ -2174,6 +2324,30 @@ func (s *ListTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 256))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern(".*", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", ".*"))
+	}
+	if s.ResourceId != nil && !request.MatchParamPattern("cluster-[2-7a-zA-Z]{11,16}", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "cluster-[2-7a-zA-Z]{11,16}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListTagsInput) SetMaxResults(v int64) *ListTagsInput {
 	s.MaxResults = &v
@@ -2273,6 +2447,27 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -2340,6 +2535,34 @@ func (s *TagResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TagResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
+	if s.ResourceId != nil && !request.MatchParamPattern("cluster-[2-7a-zA-Z]{11,16}", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "cluster-[2-7a-zA-Z]{11,16}"))
+	}
+	if s.TagList != nil && len(s.TagList) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagList", 50))
+	}
+	if s.TagList != nil {
+		for i, v := range s.TagList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TagList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *TagResourceInput) SetResourceId(v string) *TagResourceInput {
 	s.ResourceId = &v
@@ -2413,6 +2636,24 @@ func (s *UntagResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UntagResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UntagResourceInput"}
+	if s.ResourceId != nil && !request.MatchParamPattern("cluster-[2-7a-zA-Z]{11,16}", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "cluster-[2-7a-zA-Z]{11,16}"))
+	}
+	if s.TagKeyList != nil && len(s.TagKeyList) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagKeyList", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *UntagResourceInput) SetResourceId(v string) *UntagResourceInput {
 	s.ResourceId = &v
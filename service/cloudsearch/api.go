@@ -2256,6 +2256,24 @@ func (s AnalysisOptions) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AnalysisOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AnalysisOptions"}
+	if s.AlgorithmicStemming != nil {
+		values := []string{"none", "minimal", "light", "full"}
+		if !request.IsParamEnumValue(*s.AlgorithmicStemming, values) {
+			invalidParams.Add(request.NewErrParamEnum("AlgorithmicStemming", *s.AlgorithmicStemming, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAlgorithmicStemming sets the AlgorithmicStemming field's value.
 func (s *AnalysisOptions) SetAlgorithmicStemming(v string) *AnalysisOptions {
 	s.AlgorithmicStemming = &v
@@ -2339,6 +2357,35 @@ func (s *AnalysisScheme) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AnalysisScheme) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AnalysisScheme"}
+	if s.AnalysisSchemeLanguage != nil {
+		values := []string{"ar", "bg", "ca", "cs", "da", "de", "el", "en", "es", "eu", "fa", "fi", "fr", "ga", "gl", "he", "hi", "hu", "hy", "id", "it", "ja", "ko", "lv", "mul", "nl", "no", "pt", "ro", "ru", "sv", "th", "tr", "zh-Hans", "zh-Hant"}
+		if !request.IsParamEnumValue(*s.AnalysisSchemeLanguage, values) {
+			invalidParams.Add(request.NewErrParamEnum("AnalysisSchemeLanguage", *s.AnalysisSchemeLanguage, values))
+		}
+	}
+	if s.AnalysisSchemeName != nil && len(*s.AnalysisSchemeName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("AnalysisSchemeName", 64))
+	}
+	if s.AnalysisSchemeName != nil && !request.MatchParamPattern("[a-z][a-z0-9_]*", *s.AnalysisSchemeName) {
+		invalidParams.Add(request.NewErrParamPattern("AnalysisSchemeName", "[a-z][a-z0-9_]*"))
+	}
+	if s.AnalysisOptions != nil {
+		if err := s.AnalysisOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AnalysisOptions", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAnalysisOptions sets the AnalysisOptions field's value.
 func (s *AnalysisScheme) SetAnalysisOptions(v *AnalysisOptions) *AnalysisScheme {
 	s.AnalysisOptions = v
@@ -2474,6 +2521,24 @@ func (s *BuildSuggestersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BuildSuggestersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BuildSuggestersInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *BuildSuggestersInput) SetDomainName(v string) *BuildSuggestersInput {
 	s.DomainName = &v
@@ -2544,6 +2609,24 @@ func (s *CreateDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDomainInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *CreateDomainInput) SetDomainName(v string) *CreateDomainInput {
 	s.DomainName = &v
@@ -2606,6 +2689,24 @@ func (s DateArrayOptions) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DateArrayOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DateArrayOptions"}
+	if s.DefaultValue != nil && len(*s.DefaultValue) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultValue", 1024))
+	}
+	if s.SourceFields != nil && !request.MatchParamPattern("\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*", *s.SourceFields) {
+		invalidParams.Add(request.NewErrParamPattern("SourceFields", "\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultValue sets the DefaultValue field's value.
 func (s *DateArrayOptions) SetDefaultValue(v string) *DateArrayOptions {
 	s.DefaultValue = &v
@@ -2698,6 +2799,27 @@ func (s *DateOptions) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DateOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DateOptions"}
+	if s.DefaultValue != nil && len(*s.DefaultValue) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultValue", 1024))
+	}
+	if s.SourceField != nil && len(*s.SourceField) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceField", 64))
+	}
+	if s.SourceField != nil && !request.MatchParamPattern("[a-z][a-z0-9_]*", *s.SourceField) {
+		invalidParams.Add(request.NewErrParamPattern("SourceField", "[a-z][a-z0-9_]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultValue sets the DefaultValue field's value.
 func (s *DateOptions) SetDefaultValue(v string) *DateOptions {
 	s.DefaultValue = &v
@@ -2790,6 +2912,29 @@ func (s *DefineAnalysisSchemeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DefineAnalysisSchemeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DefineAnalysisSchemeInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+	if s.AnalysisScheme != nil {
+		if err := s.AnalysisScheme.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AnalysisScheme", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAnalysisScheme sets the AnalysisScheme field's value.
 func (s *DefineAnalysisSchemeInput) SetAnalysisScheme(v *AnalysisScheme) *DefineAnalysisSchemeInput {
 	s.AnalysisScheme = v
@@ -2885,6 +3030,29 @@ func (s *DefineExpressionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DefineExpressionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DefineExpressionInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+	if s.Expression != nil {
+		if err := s.Expression.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Expression", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DefineExpressionInput) SetDomainName(v string) *DefineExpressionInput {
 	s.DomainName = &v
@@ -2977,6 +3145,29 @@ func (s *DefineIndexFieldInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DefineIndexFieldInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DefineIndexFieldInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+	if s.IndexField != nil {
+		if err := s.IndexField.ValidateExtended(); err != nil {
+			invalidParams.AddNested("IndexField", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DefineIndexFieldInput) SetDomainName(v string) *DefineIndexFieldInput {
 	s.DomainName = &v
@@ -3071,6 +3262,29 @@ func (s *DefineSuggesterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DefineSuggesterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DefineSuggesterInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+	if s.Suggester != nil {
+		if err := s.Suggester.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Suggester", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DefineSuggesterInput) SetDomainName(v string) *DefineSuggesterInput {
 	s.DomainName = &v
@@ -3162,6 +3376,30 @@ func (s *DeleteAnalysisSchemeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteAnalysisSchemeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteAnalysisSchemeInput"}
+	if s.AnalysisSchemeName != nil && len(*s.AnalysisSchemeName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("AnalysisSchemeName", 64))
+	}
+	if s.AnalysisSchemeName != nil && !request.MatchParamPattern("[a-z][a-z0-9_]*", *s.AnalysisSchemeName) {
+		invalidParams.Add(request.NewErrParamPattern("AnalysisSchemeName", "[a-z][a-z0-9_]*"))
+	}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAnalysisSchemeName sets the AnalysisSchemeName field's value.
 func (s *DeleteAnalysisSchemeInput) SetAnalysisSchemeName(v string) *DeleteAnalysisSchemeInput {
 	s.AnalysisSchemeName = &v
@@ -3238,6 +3476,24 @@ func (s *DeleteDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDomainInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DeleteDomainInput) SetDomainName(v string) *DeleteDomainInput {
 	s.DomainName = &v
@@ -3321,6 +3577,30 @@ func (s *DeleteExpressionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteExpressionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteExpressionInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+	if s.ExpressionName != nil && len(*s.ExpressionName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExpressionName", 64))
+	}
+	if s.ExpressionName != nil && !request.MatchParamPattern("[a-z][a-z0-9_]*", *s.ExpressionName) {
+		invalidParams.Add(request.NewErrParamPattern("ExpressionName", "[a-z][a-z0-9_]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DeleteExpressionInput) SetDomainName(v string) *DeleteExpressionInput {
 	s.DomainName = &v
@@ -3413,6 +3693,30 @@ func (s *DeleteIndexFieldInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteIndexFieldInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteIndexFieldInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+	if s.IndexFieldName != nil && len(*s.IndexFieldName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("IndexFieldName", 64))
+	}
+	if s.IndexFieldName != nil && !request.MatchParamPattern("([a-z][a-z0-9_]*\\*?|\\*[a-z0-9_]*)", *s.IndexFieldName) {
+		invalidParams.Add(request.NewErrParamPattern("IndexFieldName", "([a-z][a-z0-9_]*\\*?|\\*[a-z0-9_]*)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DeleteIndexFieldInput) SetDomainName(v string) *DeleteIndexFieldInput {
 	s.DomainName = &v
@@ -3503,6 +3807,30 @@ func (s *DeleteSuggesterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteSuggesterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSuggesterInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+	if s.SuggesterName != nil && len(*s.SuggesterName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("SuggesterName", 64))
+	}
+	if s.SuggesterName != nil && !request.MatchParamPattern("[a-z][a-z0-9_]*", *s.SuggesterName) {
+		invalidParams.Add(request.NewErrParamPattern("SuggesterName", "[a-z][a-z0-9_]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DeleteSuggesterInput) SetDomainName(v string) *DeleteSuggesterInput {
 	s.DomainName = &v
@@ -3589,6 +3917,24 @@ func (s *DescribeAnalysisSchemesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAnalysisSchemesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAnalysisSchemesInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAnalysisSchemeNames sets the AnalysisSchemeNames field's value.
 func (s *DescribeAnalysisSchemesInput) SetAnalysisSchemeNames(v []*string) *DescribeAnalysisSchemesInput {
 	s.AnalysisSchemeNames = v
@@ -3677,6 +4023,24 @@ func (s *DescribeAvailabilityOptionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAvailabilityOptionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAvailabilityOptionsInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeployed sets the Deployed field's value.
 func (s *DescribeAvailabilityOptionsInput) SetDeployed(v bool) *DescribeAvailabilityOptionsInput {
 	s.Deployed = &v
@@ -3816,6 +4180,24 @@ func (s *DescribeExpressionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeExpressionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeExpressionsInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeployed sets the Deployed field's value.
 func (s *DescribeExpressionsInput) SetDeployed(v bool) *DescribeExpressionsInput {
 	s.Deployed = &v
@@ -3909,6 +4291,24 @@ func (s *DescribeIndexFieldsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeIndexFieldsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeIndexFieldsInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeployed sets the Deployed field's value.
 func (s *DescribeIndexFieldsInput) SetDeployed(v bool) *DescribeIndexFieldsInput {
 	s.Deployed = &v
@@ -3994,6 +4394,24 @@ func (s *DescribeScalingParametersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeScalingParametersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeScalingParametersInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DescribeScalingParametersInput) SetDomainName(v string) *DescribeScalingParametersInput {
 	s.DomainName = &v
@@ -4070,6 +4488,24 @@ func (s *DescribeServiceAccessPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeServiceAccessPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeServiceAccessPoliciesInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeployed sets the Deployed field's value.
 func (s *DescribeServiceAccessPoliciesInput) SetDeployed(v bool) *DescribeServiceAccessPoliciesInput {
 	s.Deployed = &v
@@ -4155,6 +4591,24 @@ func (s *DescribeSuggestersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeSuggestersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeSuggestersInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeployed sets the Deployed field's value.
 func (s *DescribeSuggestersInput) SetDeployed(v bool) *DescribeSuggestersInput {
 	s.Deployed = &v
@@ -4251,6 +4705,30 @@ func (s *DocumentSuggesterOptions) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DocumentSuggesterOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DocumentSuggesterOptions"}
+	if s.FuzzyMatching != nil {
+		values := []string{"none", "low", "high"}
+		if !request.IsParamEnumValue(*s.FuzzyMatching, values) {
+			invalidParams.Add(request.NewErrParamEnum("FuzzyMatching", *s.FuzzyMatching, values))
+		}
+	}
+	if s.SourceField != nil && len(*s.SourceField) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceField", 64))
+	}
+	if s.SourceField != nil && !request.MatchParamPattern("[a-z][a-z0-9_]*", *s.SourceField) {
+		invalidParams.Add(request.NewErrParamPattern("SourceField", "[a-z][a-z0-9_]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFuzzyMatching sets the FuzzyMatching field's value.
 func (s *DocumentSuggesterOptions) SetFuzzyMatching(v string) *DocumentSuggesterOptions {
 	s.FuzzyMatching = &v
@@ -4450,6 +4928,21 @@ func (s DoubleArrayOptions) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DoubleArrayOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DoubleArrayOptions"}
+	if s.SourceFields != nil && !request.MatchParamPattern("\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*", *s.SourceFields) {
+		invalidParams.Add(request.NewErrParamPattern("SourceFields", "\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultValue sets the DefaultValue field's value.
 func (s *DoubleArrayOptions) SetDefaultValue(v float64) *DoubleArrayOptions {
 	s.DefaultValue = &v
@@ -4529,6 +5022,24 @@ func (s *DoubleOptions) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DoubleOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DoubleOptions"}
+	if s.SourceField != nil && len(*s.SourceField) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceField", 64))
+	}
+	if s.SourceField != nil && !request.MatchParamPattern("[a-z][a-z0-9_]*", *s.SourceField) {
+		invalidParams.Add(request.NewErrParamPattern("SourceField", "[a-z][a-z0-9_]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultValue sets the DefaultValue field's value.
 func (s *DoubleOptions) SetDefaultValue(v float64) *DoubleOptions {
 	s.DefaultValue = &v
@@ -4618,6 +5129,27 @@ func (s *Expression) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Expression) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Expression"}
+	if s.ExpressionName != nil && len(*s.ExpressionName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExpressionName", 64))
+	}
+	if s.ExpressionName != nil && !request.MatchParamPattern("[a-z][a-z0-9_]*", *s.ExpressionName) {
+		invalidParams.Add(request.NewErrParamPattern("ExpressionName", "[a-z][a-z0-9_]*"))
+	}
+	if s.ExpressionValue != nil && len(*s.ExpressionValue) > 10240 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExpressionValue", 10240))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExpressionName sets the ExpressionName field's value.
 func (s *Expression) SetExpressionName(v string) *Expression {
 	s.ExpressionName = &v
@@ -4707,6 +5239,24 @@ func (s *IndexDocumentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *IndexDocumentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "IndexDocumentsInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *IndexDocumentsInput) SetDomainName(v string) *IndexDocumentsInput {
 	s.DomainName = &v
@@ -4881,6 +5431,85 @@ func (s *IndexField) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *IndexField) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "IndexField"}
+	if s.IndexFieldName != nil && len(*s.IndexFieldName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("IndexFieldName", 64))
+	}
+	if s.IndexFieldName != nil && !request.MatchParamPattern("([a-z][a-z0-9_]*\\*?|\\*[a-z0-9_]*)", *s.IndexFieldName) {
+		invalidParams.Add(request.NewErrParamPattern("IndexFieldName", "([a-z][a-z0-9_]*\\*?|\\*[a-z0-9_]*)"))
+	}
+	if s.IndexFieldType != nil {
+		values := []string{"int", "double", "literal", "text", "date", "latlon", "int-array", "double-array", "literal-array", "text-array", "date-array"}
+		if !request.IsParamEnumValue(*s.IndexFieldType, values) {
+			invalidParams.Add(request.NewErrParamEnum("IndexFieldType", *s.IndexFieldType, values))
+		}
+	}
+	if s.DateArrayOptions != nil {
+		if err := s.DateArrayOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DateArrayOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DateOptions != nil {
+		if err := s.DateOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DateOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DoubleArrayOptions != nil {
+		if err := s.DoubleArrayOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DoubleArrayOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DoubleOptions != nil {
+		if err := s.DoubleOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DoubleOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.IntArrayOptions != nil {
+		if err := s.IntArrayOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("IntArrayOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.IntOptions != nil {
+		if err := s.IntOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("IntOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.LatLonOptions != nil {
+		if err := s.LatLonOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LatLonOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.LiteralArrayOptions != nil {
+		if err := s.LiteralArrayOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LiteralArrayOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.LiteralOptions != nil {
+		if err := s.LiteralOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LiteralOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TextArrayOptions != nil {
+		if err := s.TextArrayOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TextArrayOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TextOptions != nil {
+		if err := s.TextOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TextOptions", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDateArrayOptions sets the DateArrayOptions field's value.
 func (s *IndexField) SetDateArrayOptions(v *DateArrayOptions) *IndexField {
 	s.DateArrayOptions = v
@@ -5029,6 +5658,21 @@ func (s IntArrayOptions) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *IntArrayOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "IntArrayOptions"}
+	if s.SourceFields != nil && !request.MatchParamPattern("\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*", *s.SourceFields) {
+		invalidParams.Add(request.NewErrParamPattern("SourceFields", "\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultValue sets the DefaultValue field's value.
 func (s *IntArrayOptions) SetDefaultValue(v int64) *IntArrayOptions {
 	s.DefaultValue = &v
@@ -5108,6 +5752,24 @@ func (s *IntOptions) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *IntOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "IntOptions"}
+	if s.SourceField != nil && len(*s.SourceField) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceField", 64))
+	}
+	if s.SourceField != nil && !request.MatchParamPattern("[a-z][a-z0-9_]*", *s.SourceField) {
+		invalidParams.Add(request.NewErrParamPattern("SourceField", "[a-z][a-z0-9_]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultValue sets the DefaultValue field's value.
 func (s *IntOptions) SetDefaultValue(v int64) *IntOptions {
 	s.DefaultValue = &v
@@ -5205,6 +5867,27 @@ func (s *LatLonOptions) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LatLonOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LatLonOptions"}
+	if s.DefaultValue != nil && len(*s.DefaultValue) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultValue", 1024))
+	}
+	if s.SourceField != nil && len(*s.SourceField) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceField", 64))
+	}
+	if s.SourceField != nil && !request.MatchParamPattern("[a-z][a-z0-9_]*", *s.SourceField) {
+		invalidParams.Add(request.NewErrParamPattern("SourceField", "[a-z][a-z0-9_]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultValue sets the DefaultValue field's value.
 func (s *LatLonOptions) SetDefaultValue(v string) *LatLonOptions {
 	s.DefaultValue = &v
@@ -5344,6 +6027,24 @@ func (s LiteralArrayOptions) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LiteralArrayOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LiteralArrayOptions"}
+	if s.DefaultValue != nil && len(*s.DefaultValue) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultValue", 1024))
+	}
+	if s.SourceFields != nil && !request.MatchParamPattern("\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*", *s.SourceFields) {
+		invalidParams.Add(request.NewErrParamPattern("SourceFields", "\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultValue sets the DefaultValue field's value.
 func (s *LiteralArrayOptions) SetDefaultValue(v string) *LiteralArrayOptions {
 	s.DefaultValue = &v
@@ -5434,6 +6135,27 @@ func (s *LiteralOptions) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LiteralOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LiteralOptions"}
+	if s.DefaultValue != nil && len(*s.DefaultValue) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultValue", 1024))
+	}
+	if s.SourceField != nil && len(*s.SourceField) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceField", 64))
+	}
+	if s.SourceField != nil && !request.MatchParamPattern("[a-z][a-z0-9_]*", *s.SourceField) {
+		invalidParams.Add(request.NewErrParamPattern("SourceField", "[a-z][a-z0-9_]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultValue sets the DefaultValue field's value.
 func (s *LiteralOptions) SetDefaultValue(v string) *LiteralOptions {
 	s.DefaultValue = &v
@@ -5571,6 +6293,24 @@ func (s ScalingParameters) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ScalingParameters) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ScalingParameters"}
+	if s.DesiredInstanceType != nil {
+		values := []string{"search.m1.small", "search.m1.large", "search.m2.xlarge", "search.m2.2xlarge", "search.m3.medium", "search.m3.large", "search.m3.xlarge", "search.m3.2xlarge"}
+		if !request.IsParamEnumValue(*s.DesiredInstanceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DesiredInstanceType", *s.DesiredInstanceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDesiredInstanceType sets the DesiredInstanceType field's value.
 func (s *ScalingParameters) SetDesiredInstanceType(v string) *ScalingParameters {
 	s.DesiredInstanceType = &v
@@ -5703,6 +6443,29 @@ func (s *Suggester) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Suggester) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Suggester"}
+	if s.SuggesterName != nil && len(*s.SuggesterName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("SuggesterName", 64))
+	}
+	if s.SuggesterName != nil && !request.MatchParamPattern("[a-z][a-z0-9_]*", *s.SuggesterName) {
+		invalidParams.Add(request.NewErrParamPattern("SuggesterName", "[a-z][a-z0-9_]*"))
+	}
+	if s.DocumentSuggesterOptions != nil {
+		if err := s.DocumentSuggesterOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DocumentSuggesterOptions", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDocumentSuggesterOptions sets the DocumentSuggesterOptions field's value.
 func (s *Suggester) SetDocumentSuggesterOptions(v *DocumentSuggesterOptions) *Suggester {
 	s.DocumentSuggesterOptions = v
@@ -5786,6 +6549,27 @@ func (s TextArrayOptions) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TextArrayOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TextArrayOptions"}
+	if s.AnalysisScheme != nil && !request.MatchParamPattern("[\\S]+", *s.AnalysisScheme) {
+		invalidParams.Add(request.NewErrParamPattern("AnalysisScheme", "[\\S]+"))
+	}
+	if s.DefaultValue != nil && len(*s.DefaultValue) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultValue", 1024))
+	}
+	if s.SourceFields != nil && !request.MatchParamPattern("\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*", *s.SourceFields) {
+		invalidParams.Add(request.NewErrParamPattern("SourceFields", "\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAnalysisScheme sets the AnalysisScheme field's value.
 func (s *TextArrayOptions) SetAnalysisScheme(v string) *TextArrayOptions {
 	s.AnalysisScheme = &v
@@ -5877,6 +6661,30 @@ func (s *TextOptions) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TextOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TextOptions"}
+	if s.AnalysisScheme != nil && !request.MatchParamPattern("[\\S]+", *s.AnalysisScheme) {
+		invalidParams.Add(request.NewErrParamPattern("AnalysisScheme", "[\\S]+"))
+	}
+	if s.DefaultValue != nil && len(*s.DefaultValue) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultValue", 1024))
+	}
+	if s.SourceField != nil && len(*s.SourceField) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceField", 64))
+	}
+	if s.SourceField != nil && !request.MatchParamPattern("[a-z][a-z0-9_]*", *s.SourceField) {
+		invalidParams.Add(request.NewErrParamPattern("SourceField", "[a-z][a-z0-9_]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAnalysisScheme sets the AnalysisScheme field's value.
 func (s *TextOptions) SetAnalysisScheme(v string) *TextOptions {
 	s.AnalysisScheme = &v
@@ -5965,6 +6773,24 @@ func (s *UpdateAvailabilityOptionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateAvailabilityOptionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateAvailabilityOptionsInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *UpdateAvailabilityOptionsInput) SetDomainName(v string) *UpdateAvailabilityOptionsInput {
 	s.DomainName = &v
@@ -6052,6 +6878,29 @@ func (s *UpdateScalingParametersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateScalingParametersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateScalingParametersInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+	if s.ScalingParameters != nil {
+		if err := s.ScalingParameters.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ScalingParameters", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *UpdateScalingParametersInput) SetDomainName(v string) *UpdateScalingParametersInput {
 	s.DomainName = &v
@@ -6141,6 +6990,24 @@ func (s *UpdateServiceAccessPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateServiceAccessPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateServiceAccessPoliciesInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessPolicies sets the AccessPolicies field's value.
 func (s *UpdateServiceAccessPoliciesInput) SetAccessPolicies(v string) *UpdateServiceAccessPoliciesInput {
 	s.AccessPolicies = &v
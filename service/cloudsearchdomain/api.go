@@ -1098,6 +1098,24 @@ func (s *SearchInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SearchInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SearchInput"}
+	if s.QueryParser != nil {
+		values := []string{"simple", "structured", "lucene", "dismax"}
+		if !request.IsParamEnumValue(*s.QueryParser, values) {
+			invalidParams.Add(request.NewErrParamEnum("QueryParser", *s.QueryParser, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCursor sets the Cursor field's value.
 func (s *SearchInput) SetCursor(v string) *SearchInput {
 	s.Cursor = &v
@@ -1748,6 +1766,24 @@ func (s *UploadDocumentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UploadDocumentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UploadDocumentsInput"}
+	if s.ContentType != nil {
+		values := []string{"application/json", "application/xml"}
+		if !request.IsParamEnumValue(*s.ContentType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ContentType", *s.ContentType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContentType sets the ContentType field's value.
 func (s *UploadDocumentsInput) SetContentType(v string) *UploadDocumentsInput {
 	s.ContentType = &v
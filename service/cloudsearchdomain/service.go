@@ -29,8 +29,9 @@ var initRequest func(*request.Request)
 
 // Service information constants
 const (
-	ServiceName = "cloudsearchdomain" // Service endpoint prefix API calls made to.
-	EndpointsID = ServiceName         // Service ID for Regions and Endpoints metadata.
+	ServiceName = "cloudsearchdomain"  // Service endpoint prefix API calls made to.
+	EndpointsID = ServiceName          // Service ID for Regions and Endpoints metadata.
+	ServiceID   = "CloudSearch Domain" // ServiceID is a unique identifier of a specific service.
 )
 
 // New creates a new instance of the CloudSearchDomain client with a session.
@@ -50,19 +51,20 @@ func New(p client.ConfigProvider, cfgs ...*aws.Config) *CloudSearchDomain {
 	} else {
 		c = p.ClientConfig(EndpointsID, cfgs...)
 	}
+	if c.SigningNameDerived || len(c.SigningName) == 0 {
+		c.SigningName = "cloudsearch"
+	}
 	return newClient(*c.Config, c.Handlers, c.Endpoint, c.SigningRegion, c.SigningName)
 }
 
 // newClient creates, initializes and returns a new service client instance.
 func newClient(cfg aws.Config, handlers request.Handlers, endpoint, signingRegion, signingName string) *CloudSearchDomain {
-	if len(signingName) == 0 {
-		signingName = "cloudsearch"
-	}
 	svc := &CloudSearchDomain{
 		Client: client.New(
 			cfg,
 			metadata.ClientInfo{
 				ServiceName:   ServiceName,
+				ServiceID:     ServiceID,
 				SigningName:   signingName,
 				SigningRegion: signingRegion,
 				Endpoint:      endpoint,
@@ -2413,6 +2413,24 @@ func (s EventSelector) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EventSelector) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EventSelector"}
+	if s.ReadWriteType != nil {
+		values := []string{"ReadOnly", "WriteOnly", "All"}
+		if !request.IsParamEnumValue(*s.ReadWriteType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReadWriteType", *s.ReadWriteType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDataResources sets the DataResources field's value.
 func (s *EventSelector) SetDataResources(v []*DataResource) *EventSelector {
 	s.DataResources = v
@@ -2971,6 +2989,24 @@ func (s *LookupAttribute) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LookupAttribute) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LookupAttribute"}
+	if s.AttributeKey != nil {
+		values := []string{"EventId", "EventName", "Username", "ResourceType", "ResourceName", "EventSource"}
+		if !request.IsParamEnumValue(*s.AttributeKey, values) {
+			invalidParams.Add(request.NewErrParamEnum("AttributeKey", *s.AttributeKey, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeKey sets the AttributeKey field's value.
 func (s *LookupAttribute) SetAttributeKey(v string) *LookupAttribute {
 	s.AttributeKey = &v
@@ -3047,6 +3083,31 @@ func (s *LookupEventsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LookupEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LookupEventsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.LookupAttributes != nil {
+		for i, v := range s.LookupAttributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "LookupAttributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEndTime sets the EndTime field's value.
 func (s *LookupEventsInput) SetEndTime(v time.Time) *LookupEventsInput {
 	s.EndTime = &v
@@ -3230,6 +3291,28 @@ func (s *PutEventSelectorsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutEventSelectorsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutEventSelectorsInput"}
+	if s.EventSelectors != nil {
+		for i, v := range s.EventSelectors {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "EventSelectors", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEventSelectors sets the EventSelectors field's value.
 func (s *PutEventSelectorsInput) SetEventSelectors(v []*EventSelector) *PutEventSelectorsInput {
 	s.EventSelectors = v
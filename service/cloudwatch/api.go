@@ -1841,6 +1841,21 @@ func (s *DeleteAlarmsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteAlarmsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteAlarmsInput"}
+	if s.AlarmNames != nil && len(s.AlarmNames) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("AlarmNames", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAlarmNames sets the AlarmNames field's value.
 func (s *DeleteAlarmsInput) SetAlarmNames(v []*string) *DeleteAlarmsInput {
 	s.AlarmNames = v
@@ -1951,6 +1966,33 @@ func (s *DescribeAlarmHistoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAlarmHistoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAlarmHistoryInput"}
+	if s.AlarmName != nil && len(*s.AlarmName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("AlarmName", 255))
+	}
+	if s.HistoryItemType != nil {
+		values := []string{"ConfigurationUpdate", "StateUpdate", "Action"}
+		if !request.IsParamEnumValue(*s.HistoryItemType, values) {
+			invalidParams.Add(request.NewErrParamEnum("HistoryItemType", *s.HistoryItemType, values))
+		}
+	}
+	if s.MaxRecords != nil && *s.MaxRecords > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxRecords", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAlarmName sets the AlarmName field's value.
 func (s *DescribeAlarmHistoryInput) SetAlarmName(v string) *DescribeAlarmHistoryInput {
 	s.AlarmName = &v
@@ -2098,6 +2140,55 @@ func (s *DescribeAlarmsForMetricInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAlarmsForMetricInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAlarmsForMetricInput"}
+	if s.Dimensions != nil && len(s.Dimensions) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Dimensions", 10))
+	}
+	if s.ExtendedStatistic != nil && !request.MatchParamPattern("p(\\d{1,2}(\\.\\d{0,2})?|100)", *s.ExtendedStatistic) {
+		invalidParams.Add(request.NewErrParamPattern("ExtendedStatistic", "p(\\d{1,2}(\\.\\d{0,2})?|100)"))
+	}
+	if s.MetricName != nil && len(*s.MetricName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("MetricName", 255))
+	}
+	if s.Namespace != nil && len(*s.Namespace) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Namespace", 255))
+	}
+	if s.Namespace != nil && !request.MatchParamPattern("[^:].*", *s.Namespace) {
+		invalidParams.Add(request.NewErrParamPattern("Namespace", "[^:].*"))
+	}
+	if s.Statistic != nil {
+		values := []string{"SampleCount", "Average", "Sum", "Minimum", "Maximum"}
+		if !request.IsParamEnumValue(*s.Statistic, values) {
+			invalidParams.Add(request.NewErrParamEnum("Statistic", *s.Statistic, values))
+		}
+	}
+	if s.Unit != nil {
+		values := []string{"Seconds", "Microseconds", "Milliseconds", "Bytes", "Kilobytes", "Megabytes", "Gigabytes", "Terabytes", "Bits", "Kilobits", "Megabits", "Gigabits", "Terabits", "Percent", "Count", "Bytes/Second", "Kilobytes/Second", "Megabytes/Second", "Gigabytes/Second", "Terabytes/Second", "Bits/Second", "Kilobits/Second", "Megabits/Second", "Gigabits/Second", "Terabits/Second", "Count/Second", "None"}
+		if !request.IsParamEnumValue(*s.Unit, values) {
+			invalidParams.Add(request.NewErrParamEnum("Unit", *s.Unit, values))
+		}
+	}
+	if s.Dimensions != nil {
+		for i, v := range s.Dimensions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Dimensions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDimensions sets the Dimensions field's value.
 func (s *DescribeAlarmsForMetricInput) SetDimensions(v []*Dimension) *DescribeAlarmsForMetricInput {
 	s.Dimensions = v
@@ -2218,6 +2309,39 @@ func (s *DescribeAlarmsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAlarmsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAlarmsInput"}
+	if s.ActionPrefix != nil && len(*s.ActionPrefix) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("ActionPrefix", 1024))
+	}
+	if s.AlarmNamePrefix != nil && len(*s.AlarmNamePrefix) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("AlarmNamePrefix", 255))
+	}
+	if s.AlarmNames != nil && len(s.AlarmNames) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("AlarmNames", 100))
+	}
+	if s.MaxRecords != nil && *s.MaxRecords > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxRecords", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.StateValue != nil {
+		values := []string{"OK", "ALARM", "INSUFFICIENT_DATA"}
+		if !request.IsParamEnumValue(*s.StateValue, values) {
+			invalidParams.Add(request.NewErrParamEnum("StateValue", *s.StateValue, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActionPrefix sets the ActionPrefix field's value.
 func (s *DescribeAlarmsInput) SetActionPrefix(v string) *DescribeAlarmsInput {
 	s.ActionPrefix = &v
@@ -2335,6 +2459,24 @@ func (s *Dimension) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Dimension) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Dimension"}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.Value != nil && len(*s.Value) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *Dimension) SetName(v string) *Dimension {
 	s.Name = &v
@@ -2390,6 +2532,24 @@ func (s *DimensionFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DimensionFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DimensionFilter"}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.Value != nil && len(*s.Value) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DimensionFilter) SetName(v string) *DimensionFilter {
 	s.Name = &v
@@ -2435,6 +2595,21 @@ func (s *DisableAlarmActionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisableAlarmActionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableAlarmActionsInput"}
+	if s.AlarmNames != nil && len(s.AlarmNames) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("AlarmNames", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAlarmNames sets the AlarmNames field's value.
 func (s *DisableAlarmActionsInput) SetAlarmNames(v []*string) *DisableAlarmActionsInput {
 	s.AlarmNames = v
@@ -2489,6 +2664,21 @@ func (s *EnableAlarmActionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnableAlarmActionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableAlarmActionsInput"}
+	if s.AlarmNames != nil && len(s.AlarmNames) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("AlarmNames", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAlarmNames sets the AlarmNames field's value.
 func (s *EnableAlarmActionsInput) SetAlarmNames(v []*string) *EnableAlarmActionsInput {
 	s.AlarmNames = v
@@ -2738,6 +2928,52 @@ func (s *GetMetricStatisticsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetMetricStatisticsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetMetricStatisticsInput"}
+	if s.Dimensions != nil && len(s.Dimensions) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Dimensions", 10))
+	}
+	if s.ExtendedStatistics != nil && len(s.ExtendedStatistics) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExtendedStatistics", 10))
+	}
+	if s.MetricName != nil && len(*s.MetricName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("MetricName", 255))
+	}
+	if s.Namespace != nil && len(*s.Namespace) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Namespace", 255))
+	}
+	if s.Namespace != nil && !request.MatchParamPattern("[^:].*", *s.Namespace) {
+		invalidParams.Add(request.NewErrParamPattern("Namespace", "[^:].*"))
+	}
+	if s.Statistics != nil && len(s.Statistics) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Statistics", 5))
+	}
+	if s.Unit != nil {
+		values := []string{"Seconds", "Microseconds", "Milliseconds", "Bytes", "Kilobytes", "Megabytes", "Gigabytes", "Terabytes", "Bits", "Kilobits", "Megabits", "Gigabits", "Terabits", "Percent", "Count", "Bytes/Second", "Kilobytes/Second", "Megabytes/Second", "Gigabytes/Second", "Terabytes/Second", "Bits/Second", "Kilobits/Second", "Megabits/Second", "Gigabits/Second", "Terabits/Second", "Count/Second", "None"}
+		if !request.IsParamEnumValue(*s.Unit, values) {
+			invalidParams.Add(request.NewErrParamEnum("Unit", *s.Unit, values))
+		}
+	}
+	if s.Dimensions != nil {
+		for i, v := range s.Dimensions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Dimensions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDimensions sets the Dimensions field's value.
 func (s *GetMetricStatisticsInput) SetDimensions(v []*Dimension) *GetMetricStatisticsInput {
 	s.Dimensions = v
@@ -2849,6 +3085,21 @@ func (s ListDashboardsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListDashboardsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDashboardsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDashboardNamePrefix sets the DashboardNamePrefix field's value.
 func (s *ListDashboardsInput) SetDashboardNamePrefix(v string) *ListDashboardsInput {
 	s.DashboardNamePrefix = &v
@@ -2948,6 +3199,43 @@ func (s *ListMetricsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListMetricsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListMetricsInput"}
+	if s.Dimensions != nil && len(s.Dimensions) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Dimensions", 10))
+	}
+	if s.MetricName != nil && len(*s.MetricName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("MetricName", 255))
+	}
+	if s.Namespace != nil && len(*s.Namespace) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Namespace", 255))
+	}
+	if s.Namespace != nil && !request.MatchParamPattern("[^:].*", *s.Namespace) {
+		invalidParams.Add(request.NewErrParamPattern("Namespace", "[^:].*"))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.Dimensions != nil {
+		for i, v := range s.Dimensions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Dimensions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDimensions sets the Dimensions field's value.
 func (s *ListMetricsInput) SetDimensions(v []*DimensionFilter) *ListMetricsInput {
 	s.Dimensions = v
@@ -3381,6 +3669,40 @@ func (s *MetricDatum) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MetricDatum) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MetricDatum"}
+	if s.Dimensions != nil && len(s.Dimensions) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Dimensions", 10))
+	}
+	if s.MetricName != nil && len(*s.MetricName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("MetricName", 255))
+	}
+	if s.Unit != nil {
+		values := []string{"Seconds", "Microseconds", "Milliseconds", "Bytes", "Kilobytes", "Megabytes", "Gigabytes", "Terabytes", "Bits", "Kilobits", "Megabits", "Gigabits", "Terabits", "Percent", "Count", "Bytes/Second", "Kilobytes/Second", "Megabytes/Second", "Gigabytes/Second", "Terabytes/Second", "Bits/Second", "Kilobits/Second", "Megabits/Second", "Gigabits/Second", "Terabits/Second", "Count/Second", "None"}
+		if !request.IsParamEnumValue(*s.Unit, values) {
+			invalidParams.Add(request.NewErrParamEnum("Unit", *s.Unit, values))
+		}
+	}
+	if s.Dimensions != nil {
+		for i, v := range s.Dimensions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Dimensions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDimensions sets the Dimensions field's value.
 func (s *MetricDatum) SetDimensions(v []*Dimension) *MetricDatum {
 	s.Dimensions = v
@@ -3703,6 +4025,82 @@ func (s *PutMetricAlarmInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutMetricAlarmInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutMetricAlarmInput"}
+	if s.AlarmActions != nil && len(s.AlarmActions) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("AlarmActions", 5))
+	}
+	if s.AlarmDescription != nil && len(*s.AlarmDescription) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("AlarmDescription", 1024))
+	}
+	if s.AlarmName != nil && len(*s.AlarmName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("AlarmName", 255))
+	}
+	if s.ComparisonOperator != nil {
+		values := []string{"GreaterThanOrEqualToThreshold", "GreaterThanThreshold", "LessThanThreshold", "LessThanOrEqualToThreshold"}
+		if !request.IsParamEnumValue(*s.ComparisonOperator, values) {
+			invalidParams.Add(request.NewErrParamEnum("ComparisonOperator", *s.ComparisonOperator, values))
+		}
+	}
+	if s.Dimensions != nil && len(s.Dimensions) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Dimensions", 10))
+	}
+	if s.EvaluateLowSampleCountPercentile != nil && len(*s.EvaluateLowSampleCountPercentile) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("EvaluateLowSampleCountPercentile", 255))
+	}
+	if s.ExtendedStatistic != nil && !request.MatchParamPattern("p(\\d{1,2}(\\.\\d{0,2})?|100)", *s.ExtendedStatistic) {
+		invalidParams.Add(request.NewErrParamPattern("ExtendedStatistic", "p(\\d{1,2}(\\.\\d{0,2})?|100)"))
+	}
+	if s.InsufficientDataActions != nil && len(s.InsufficientDataActions) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("InsufficientDataActions", 5))
+	}
+	if s.MetricName != nil && len(*s.MetricName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("MetricName", 255))
+	}
+	if s.Namespace != nil && len(*s.Namespace) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Namespace", 255))
+	}
+	if s.Namespace != nil && !request.MatchParamPattern("[^:].*", *s.Namespace) {
+		invalidParams.Add(request.NewErrParamPattern("Namespace", "[^:].*"))
+	}
+	if s.OKActions != nil && len(s.OKActions) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("OKActions", 5))
+	}
+	if s.Statistic != nil {
+		values := []string{"SampleCount", "Average", "Sum", "Minimum", "Maximum"}
+		if !request.IsParamEnumValue(*s.Statistic, values) {
+			invalidParams.Add(request.NewErrParamEnum("Statistic", *s.Statistic, values))
+		}
+	}
+	if s.TreatMissingData != nil && len(*s.TreatMissingData) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TreatMissingData", 255))
+	}
+	if s.Unit != nil {
+		values := []string{"Seconds", "Microseconds", "Milliseconds", "Bytes", "Kilobytes", "Megabytes", "Gigabytes", "Terabytes", "Bits", "Kilobits", "Megabits", "Gigabits", "Terabits", "Percent", "Count", "Bytes/Second", "Kilobytes/Second", "Megabytes/Second", "Gigabytes/Second", "Terabytes/Second", "Bits/Second", "Kilobits/Second", "Megabits/Second", "Gigabits/Second", "Terabits/Second", "Count/Second", "None"}
+		if !request.IsParamEnumValue(*s.Unit, values) {
+			invalidParams.Add(request.NewErrParamEnum("Unit", *s.Unit, values))
+		}
+	}
+	if s.Dimensions != nil {
+		for i, v := range s.Dimensions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Dimensions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActionsEnabled sets the ActionsEnabled field's value.
 func (s *PutMetricAlarmInput) SetActionsEnabled(v bool) *PutMetricAlarmInput {
 	s.ActionsEnabled = &v
@@ -3883,6 +4281,34 @@ func (s *PutMetricDataInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutMetricDataInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutMetricDataInput"}
+	if s.Namespace != nil && len(*s.Namespace) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Namespace", 255))
+	}
+	if s.Namespace != nil && !request.MatchParamPattern("[^:].*", *s.Namespace) {
+		invalidParams.Add(request.NewErrParamPattern("Namespace", "[^:].*"))
+	}
+	if s.MetricData != nil {
+		for i, v := range s.MetricData {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "MetricData", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMetricData sets the MetricData field's value.
 func (s *PutMetricDataInput) SetMetricData(v []*MetricDatum) *PutMetricDataInput {
 	s.MetricData = v
@@ -3966,6 +4392,33 @@ func (s *SetAlarmStateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetAlarmStateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetAlarmStateInput"}
+	if s.AlarmName != nil && len(*s.AlarmName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("AlarmName", 255))
+	}
+	if s.StateReason != nil && len(*s.StateReason) > 1023 {
+		invalidParams.Add(request.NewErrParamMaxLen("StateReason", 1023))
+	}
+	if s.StateReasonData != nil && len(*s.StateReasonData) > 4000 {
+		invalidParams.Add(request.NewErrParamMaxLen("StateReasonData", 4000))
+	}
+	if s.StateValue != nil {
+		values := []string{"OK", "ALARM", "INSUFFICIENT_DATA"}
+		if !request.IsParamEnumValue(*s.StateValue, values) {
+			invalidParams.Add(request.NewErrParamEnum("StateValue", *s.StateValue, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAlarmName sets the AlarmName field's value.
 func (s *SetAlarmStateInput) SetAlarmName(v string) *SetAlarmStateInput {
 	s.AlarmName = &v
@@ -1461,6 +1461,24 @@ func (s *DeleteRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteRuleInput"}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[\\.\\-_A-Za-z0-9]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[\\.\\-_A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteRuleInput) SetName(v string) *DeleteRuleInput {
 	s.Name = &v
@@ -1576,6 +1594,24 @@ func (s *DescribeRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeRuleInput"}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[\\.\\-_A-Za-z0-9]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[\\.\\-_A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DescribeRuleInput) SetName(v string) *DescribeRuleInput {
 	s.Name = &v
@@ -1697,6 +1733,24 @@ func (s *DisableRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisableRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableRuleInput"}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[\\.\\-_A-Za-z0-9]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[\\.\\-_A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DisableRuleInput) SetName(v string) *DisableRuleInput {
 	s.Name = &v
@@ -1763,6 +1817,21 @@ func (s *EcsParameters) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EcsParameters) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EcsParameters"}
+	if s.TaskDefinitionArn != nil && len(*s.TaskDefinitionArn) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskDefinitionArn", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTaskCount sets the TaskCount field's value.
 func (s *EcsParameters) SetTaskCount(v int64) *EcsParameters {
 	s.TaskCount = &v
@@ -1811,6 +1880,24 @@ func (s *EnableRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnableRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableRuleInput"}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[\\.\\-_A-Za-z0-9]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[\\.\\-_A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *EnableRuleInput) SetName(v string) *EnableRuleInput {
 	s.Name = &v
@@ -1876,6 +1963,24 @@ func (s *InputTransformer) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InputTransformer) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputTransformer"}
+	if s.InputPathsMap != nil && len(s.InputPathsMap) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("InputPathsMap", 10))
+	}
+	if s.InputTemplate != nil && len(*s.InputTemplate) > 8192 {
+		invalidParams.Add(request.NewErrParamMaxLen("InputTemplate", 8192))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInputPathsMap sets the InputPathsMap field's value.
 func (s *InputTransformer) SetInputPathsMap(v map[string]*string) *InputTransformer {
 	s.InputPathsMap = v
@@ -1927,6 +2032,21 @@ func (s *KinesisParameters) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *KinesisParameters) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "KinesisParameters"}
+	if s.PartitionKeyPath != nil && len(*s.PartitionKeyPath) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("PartitionKeyPath", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPartitionKeyPath sets the PartitionKeyPath field's value.
 func (s *KinesisParameters) SetPartitionKeyPath(v string) *KinesisParameters {
 	s.PartitionKeyPath = &v
@@ -1981,6 +2101,27 @@ func (s *ListRuleNamesByTargetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListRuleNamesByTargetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRuleNamesByTargetInput"}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 2048))
+	}
+	if s.TargetArn != nil && len(*s.TargetArn) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("TargetArn", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListRuleNamesByTargetInput) SetLimit(v int64) *ListRuleNamesByTargetInput {
 	s.Limit = &v
@@ -2076,6 +2217,30 @@ func (s *ListRulesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListRulesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRulesInput"}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+	if s.NamePrefix != nil && len(*s.NamePrefix) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("NamePrefix", 64))
+	}
+	if s.NamePrefix != nil && !request.MatchParamPattern("[\\.\\-_A-Za-z0-9]+", *s.NamePrefix) {
+		invalidParams.Add(request.NewErrParamPattern("NamePrefix", "[\\.\\-_A-Za-z0-9]+"))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListRulesInput) SetLimit(v int64) *ListRulesInput {
 	s.Limit = &v
@@ -2176,6 +2341,30 @@ func (s *ListTargetsByRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTargetsByRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTargetsByRuleInput"}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 2048))
+	}
+	if s.Rule != nil && len(*s.Rule) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Rule", 64))
+	}
+	if s.Rule != nil && !request.MatchParamPattern("[\\.\\-_A-Za-z0-9]+", *s.Rule) {
+		invalidParams.Add(request.NewErrParamPattern("Rule", "[\\.\\-_A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListTargetsByRuleInput) SetLimit(v int64) *ListTargetsByRuleInput {
 	s.Limit = &v
@@ -2266,6 +2455,21 @@ func (s *PutEventsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutEventsInput"}
+	if s.Entries != nil && len(s.Entries) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Entries", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEntries sets the Entries field's value.
 func (s *PutEventsInput) SetEntries(v []*PutEventsRequestEntry) *PutEventsInput {
 	s.Entries = v
@@ -2483,6 +2687,36 @@ func (s *PutPermissionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutPermissionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutPermissionInput"}
+	if s.Action != nil && len(*s.Action) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Action", 64))
+	}
+	if s.Action != nil && !request.MatchParamPattern("events:[a-zA-Z]+", *s.Action) {
+		invalidParams.Add(request.NewErrParamPattern("Action", "events:[a-zA-Z]+"))
+	}
+	if s.Principal != nil && len(*s.Principal) > 12 {
+		invalidParams.Add(request.NewErrParamMaxLen("Principal", 12))
+	}
+	if s.Principal != nil && !request.MatchParamPattern("(\\d{12}|\\*)", *s.Principal) {
+		invalidParams.Add(request.NewErrParamPattern("Principal", "(\\d{12}|\\*)"))
+	}
+	if s.StatementId != nil && len(*s.StatementId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("StatementId", 64))
+	}
+	if s.StatementId != nil && !request.MatchParamPattern("[a-zA-Z0-9-_]+", *s.StatementId) {
+		invalidParams.Add(request.NewErrParamPattern("StatementId", "[a-zA-Z0-9-_]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *PutPermissionInput) SetAction(v string) *PutPermissionInput {
 	s.Action = &v
@@ -2571,6 +2805,42 @@ func (s *PutRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutRuleInput"}
+	if s.Description != nil && len(*s.Description) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 512))
+	}
+	if s.EventPattern != nil && len(*s.EventPattern) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("EventPattern", 2048))
+	}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[\\.\\-_A-Za-z0-9]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[\\.\\-_A-Za-z0-9]+"))
+	}
+	if s.RoleArn != nil && len(*s.RoleArn) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleArn", 1600))
+	}
+	if s.ScheduleExpression != nil && len(*s.ScheduleExpression) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ScheduleExpression", 256))
+	}
+	if s.State != nil {
+		values := []string{"ENABLED", "DISABLED"}
+		if !request.IsParamEnumValue(*s.State, values) {
+			invalidParams.Add(request.NewErrParamEnum("State", *s.State, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *PutRuleInput) SetDescription(v string) *PutRuleInput {
 	s.Description = &v
@@ -2688,6 +2958,37 @@ func (s *PutTargetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutTargetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutTargetsInput"}
+	if s.Rule != nil && len(*s.Rule) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Rule", 64))
+	}
+	if s.Rule != nil && !request.MatchParamPattern("[\\.\\-_A-Za-z0-9]+", *s.Rule) {
+		invalidParams.Add(request.NewErrParamPattern("Rule", "[\\.\\-_A-Za-z0-9]+"))
+	}
+	if s.Targets != nil && len(s.Targets) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Targets", 100))
+	}
+	if s.Targets != nil {
+		for i, v := range s.Targets {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Targets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRule sets the Rule field's value.
 func (s *PutTargetsInput) SetRule(v string) *PutTargetsInput {
 	s.Rule = &v
@@ -2815,6 +3116,24 @@ func (s *RemovePermissionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemovePermissionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemovePermissionInput"}
+	if s.StatementId != nil && len(*s.StatementId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("StatementId", 64))
+	}
+	if s.StatementId != nil && !request.MatchParamPattern("[a-zA-Z0-9-_]+", *s.StatementId) {
+		invalidParams.Add(request.NewErrParamPattern("StatementId", "[a-zA-Z0-9-_]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStatementId sets the StatementId field's value.
 func (s *RemovePermissionInput) SetStatementId(v string) *RemovePermissionInput {
 	s.StatementId = &v
@@ -2883,6 +3202,27 @@ func (s *RemoveTargetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveTargetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveTargetsInput"}
+	if s.Ids != nil && len(s.Ids) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Ids", 100))
+	}
+	if s.Rule != nil && len(*s.Rule) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Rule", 64))
+	}
+	if s.Rule != nil && !request.MatchParamPattern("[\\.\\-_A-Za-z0-9]+", *s.Rule) {
+		invalidParams.Add(request.NewErrParamPattern("Rule", "[\\.\\-_A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIds sets the Ids field's value.
 func (s *RemoveTargetsInput) SetIds(v []*string) *RemoveTargetsInput {
 	s.Ids = v
@@ -3103,6 +3443,31 @@ func (s *RunCommandParameters) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RunCommandParameters) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RunCommandParameters"}
+	if s.RunCommandTargets != nil && len(s.RunCommandTargets) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("RunCommandTargets", 5))
+	}
+	if s.RunCommandTargets != nil {
+		for i, v := range s.RunCommandTargets {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "RunCommandTargets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRunCommandTargets sets the RunCommandTargets field's value.
 func (s *RunCommandParameters) SetRunCommandTargets(v []*RunCommandTarget) *RunCommandParameters {
 	s.RunCommandTargets = v
@@ -3160,6 +3525,27 @@ func (s *RunCommandTarget) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RunCommandTarget) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RunCommandTarget"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^[\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^[\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*$"))
+	}
+	if s.Values != nil && len(s.Values) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Values", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *RunCommandTarget) SetKey(v string) *RunCommandTarget {
 	s.Key = &v
@@ -3281,6 +3667,56 @@ func (s *Target) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Target) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Target"}
+	if s.Arn != nil && len(*s.Arn) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("Arn", 1600))
+	}
+	if s.Id != nil && len(*s.Id) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 64))
+	}
+	if s.Id != nil && !request.MatchParamPattern("[\\.\\-_A-Za-z0-9]+", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "[\\.\\-_A-Za-z0-9]+"))
+	}
+	if s.Input != nil && len(*s.Input) > 8192 {
+		invalidParams.Add(request.NewErrParamMaxLen("Input", 8192))
+	}
+	if s.InputPath != nil && len(*s.InputPath) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("InputPath", 256))
+	}
+	if s.RoleArn != nil && len(*s.RoleArn) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleArn", 1600))
+	}
+	if s.EcsParameters != nil {
+		if err := s.EcsParameters.ValidateExtended(); err != nil {
+			invalidParams.AddNested("EcsParameters", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.InputTransformer != nil {
+		if err := s.InputTransformer.ValidateExtended(); err != nil {
+			invalidParams.AddNested("InputTransformer", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.KinesisParameters != nil {
+		if err := s.KinesisParameters.ValidateExtended(); err != nil {
+			invalidParams.AddNested("KinesisParameters", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RunCommandParameters != nil {
+		if err := s.RunCommandParameters.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RunCommandParameters", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *Target) SetArn(v string) *Target {
 	s.Arn = &v
@@ -3377,6 +3813,21 @@ func (s *TestEventPatternInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TestEventPatternInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TestEventPatternInput"}
+	if s.EventPattern != nil && len(*s.EventPattern) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("EventPattern", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEvent sets the Event field's value.
 func (s *TestEventPatternInput) SetEvent(v string) *TestEventPatternInput {
 	s.Event = &v
@@ -3510,6 +3510,27 @@ func (s *AssociateKmsKeyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssociateKmsKeyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociateKmsKeyInput"}
+	if s.KmsKeyId != nil && len(*s.KmsKeyId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("KmsKeyId", 256))
+	}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKmsKeyId sets the KmsKeyId field's value.
 func (s *AssociateKmsKeyInput) SetKmsKeyId(v string) *AssociateKmsKeyInput {
 	s.KmsKeyId = &v
@@ -3573,6 +3594,21 @@ func (s *CancelExportTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CancelExportTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelExportTaskInput"}
+	if s.TaskId != nil && len(*s.TaskId) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskId", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTaskId sets the TaskId field's value.
 func (s *CancelExportTaskInput) SetTaskId(v string) *CancelExportTaskInput {
 	s.TaskId = &v
@@ -3679,6 +3715,36 @@ func (s *CreateExportTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateExportTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateExportTaskInput"}
+	if s.Destination != nil && len(*s.Destination) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("Destination", 512))
+	}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+	if s.LogStreamNamePrefix != nil && len(*s.LogStreamNamePrefix) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogStreamNamePrefix", 512))
+	}
+	if s.LogStreamNamePrefix != nil && !request.MatchParamPattern("[^:*]*", *s.LogStreamNamePrefix) {
+		invalidParams.Add(request.NewErrParamPattern("LogStreamNamePrefix", "[^:*]*"))
+	}
+	if s.TaskName != nil && len(*s.TaskName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskName", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestination sets the Destination field's value.
 func (s *CreateExportTaskInput) SetDestination(v string) *CreateExportTaskInput {
 	s.Destination = &v
@@ -3792,6 +3858,30 @@ func (s *CreateLogGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateLogGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateLogGroupInput"}
+	if s.KmsKeyId != nil && len(*s.KmsKeyId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("KmsKeyId", 256))
+	}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+	if s.Tags != nil && len(s.Tags) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKmsKeyId sets the KmsKeyId field's value.
 func (s *CreateLogGroupInput) SetKmsKeyId(v string) *CreateLogGroupInput {
 	s.KmsKeyId = &v
@@ -3872,6 +3962,30 @@ func (s *CreateLogStreamInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateLogStreamInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateLogStreamInput"}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+	if s.LogStreamName != nil && len(*s.LogStreamName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogStreamName", 512))
+	}
+	if s.LogStreamName != nil && !request.MatchParamPattern("[^:*]*", *s.LogStreamName) {
+		invalidParams.Add(request.NewErrParamPattern("LogStreamName", "[^:*]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLogGroupName sets the LogGroupName field's value.
 func (s *CreateLogStreamInput) SetLogGroupName(v string) *CreateLogStreamInput {
 	s.LogGroupName = &v
@@ -3935,6 +4049,24 @@ func (s *DeleteDestinationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteDestinationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDestinationInput"}
+	if s.DestinationName != nil && len(*s.DestinationName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("DestinationName", 512))
+	}
+	if s.DestinationName != nil && !request.MatchParamPattern("[^:*]*", *s.DestinationName) {
+		invalidParams.Add(request.NewErrParamPattern("DestinationName", "[^:*]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestinationName sets the DestinationName field's value.
 func (s *DeleteDestinationInput) SetDestinationName(v string) *DeleteDestinationInput {
 	s.DestinationName = &v
@@ -3992,6 +4124,24 @@ func (s *DeleteLogGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteLogGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteLogGroupInput"}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLogGroupName sets the LogGroupName field's value.
 func (s *DeleteLogGroupInput) SetLogGroupName(v string) *DeleteLogGroupInput {
 	s.LogGroupName = &v
@@ -4060,6 +4210,30 @@ func (s *DeleteLogStreamInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteLogStreamInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteLogStreamInput"}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+	if s.LogStreamName != nil && len(*s.LogStreamName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogStreamName", 512))
+	}
+	if s.LogStreamName != nil && !request.MatchParamPattern("[^:*]*", *s.LogStreamName) {
+		invalidParams.Add(request.NewErrParamPattern("LogStreamName", "[^:*]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLogGroupName sets the LogGroupName field's value.
 func (s *DeleteLogStreamInput) SetLogGroupName(v string) *DeleteLogStreamInput {
 	s.LogGroupName = &v
@@ -4134,6 +4308,30 @@ func (s *DeleteMetricFilterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteMetricFilterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteMetricFilterInput"}
+	if s.FilterName != nil && len(*s.FilterName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("FilterName", 512))
+	}
+	if s.FilterName != nil && !request.MatchParamPattern("[^:*]*", *s.FilterName) {
+		invalidParams.Add(request.NewErrParamPattern("FilterName", "[^:*]*"))
+	}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilterName sets the FilterName field's value.
 func (s *DeleteMetricFilterInput) SetFilterName(v string) *DeleteMetricFilterInput {
 	s.FilterName = &v
@@ -4236,6 +4434,24 @@ func (s *DeleteRetentionPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteRetentionPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteRetentionPolicyInput"}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLogGroupName sets the LogGroupName field's value.
 func (s *DeleteRetentionPolicyInput) SetLogGroupName(v string) *DeleteRetentionPolicyInput {
 	s.LogGroupName = &v
@@ -4304,6 +4520,30 @@ func (s *DeleteSubscriptionFilterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteSubscriptionFilterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSubscriptionFilterInput"}
+	if s.FilterName != nil && len(*s.FilterName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("FilterName", 512))
+	}
+	if s.FilterName != nil && !request.MatchParamPattern("[^:*]*", *s.FilterName) {
+		invalidParams.Add(request.NewErrParamPattern("FilterName", "[^:*]*"))
+	}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilterName sets the FilterName field's value.
 func (s *DeleteSubscriptionFilterInput) SetFilterName(v string) *DeleteSubscriptionFilterInput {
 	s.FilterName = &v
@@ -4376,6 +4616,27 @@ func (s *DescribeDestinationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeDestinationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeDestinationsInput"}
+	if s.DestinationNamePrefix != nil && len(*s.DestinationNamePrefix) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("DestinationNamePrefix", 512))
+	}
+	if s.DestinationNamePrefix != nil && !request.MatchParamPattern("[^:*]*", *s.DestinationNamePrefix) {
+		invalidParams.Add(request.NewErrParamPattern("DestinationNamePrefix", "[^:*]*"))
+	}
+	if s.Limit != nil && *s.Limit > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestinationNamePrefix sets the DestinationNamePrefix field's value.
 func (s *DescribeDestinationsInput) SetDestinationNamePrefix(v string) *DescribeDestinationsInput {
 	s.DestinationNamePrefix = &v
@@ -4478,6 +4739,30 @@ func (s *DescribeExportTasksInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeExportTasksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeExportTasksInput"}
+	if s.Limit != nil && *s.Limit > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 50))
+	}
+	if s.StatusCode != nil {
+		values := []string{"CANCELLED", "COMPLETED", "FAILED", "PENDING", "PENDING_CANCEL", "RUNNING"}
+		if !request.IsParamEnumValue(*s.StatusCode, values) {
+			invalidParams.Add(request.NewErrParamEnum("StatusCode", *s.StatusCode, values))
+		}
+	}
+	if s.TaskId != nil && len(*s.TaskId) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskId", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *DescribeExportTasksInput) SetLimit(v int64) *DescribeExportTasksInput {
 	s.Limit = &v
@@ -4581,6 +4866,27 @@ func (s *DescribeLogGroupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeLogGroupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeLogGroupsInput"}
+	if s.Limit != nil && *s.Limit > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 50))
+	}
+	if s.LogGroupNamePrefix != nil && len(*s.LogGroupNamePrefix) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupNamePrefix", 512))
+	}
+	if s.LogGroupNamePrefix != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupNamePrefix) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupNamePrefix", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *DescribeLogGroupsInput) SetLimit(v int64) *DescribeLogGroupsInput {
 	s.Limit = &v
@@ -4710,6 +5016,39 @@ func (s *DescribeLogStreamsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeLogStreamsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeLogStreamsInput"}
+	if s.Limit != nil && *s.Limit > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 50))
+	}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+	if s.LogStreamNamePrefix != nil && len(*s.LogStreamNamePrefix) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogStreamNamePrefix", 512))
+	}
+	if s.LogStreamNamePrefix != nil && !request.MatchParamPattern("[^:*]*", *s.LogStreamNamePrefix) {
+		invalidParams.Add(request.NewErrParamPattern("LogStreamNamePrefix", "[^:*]*"))
+	}
+	if s.OrderBy != nil {
+		values := []string{"LogStreamName", "LastEventTime"}
+		if !request.IsParamEnumValue(*s.OrderBy, values) {
+			invalidParams.Add(request.NewErrParamEnum("OrderBy", *s.OrderBy, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescending sets the Descending field's value.
 func (s *DescribeLogStreamsInput) SetDescending(v bool) *DescribeLogStreamsInput {
 	s.Descending = &v
@@ -4838,6 +5177,45 @@ func (s *DescribeMetricFiltersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeMetricFiltersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeMetricFiltersInput"}
+	if s.FilterNamePrefix != nil && len(*s.FilterNamePrefix) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("FilterNamePrefix", 512))
+	}
+	if s.FilterNamePrefix != nil && !request.MatchParamPattern("[^:*]*", *s.FilterNamePrefix) {
+		invalidParams.Add(request.NewErrParamPattern("FilterNamePrefix", "[^:*]*"))
+	}
+	if s.Limit != nil && *s.Limit > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 50))
+	}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+	if s.MetricName != nil && len(*s.MetricName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("MetricName", 255))
+	}
+	if s.MetricName != nil && !request.MatchParamPattern("[^:*$]*", *s.MetricName) {
+		invalidParams.Add(request.NewErrParamPattern("MetricName", "[^:*$]*"))
+	}
+	if s.MetricNamespace != nil && len(*s.MetricNamespace) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("MetricNamespace", 255))
+	}
+	if s.MetricNamespace != nil && !request.MatchParamPattern("[^:*$]*", *s.MetricNamespace) {
+		invalidParams.Add(request.NewErrParamPattern("MetricNamespace", "[^:*$]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilterNamePrefix sets the FilterNamePrefix field's value.
 func (s *DescribeMetricFiltersInput) SetFilterNamePrefix(v string) *DescribeMetricFiltersInput {
 	s.FilterNamePrefix = &v
@@ -4947,6 +5325,21 @@ func (s *DescribeResourcePoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeResourcePoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeResourcePoliciesInput"}
+	if s.Limit != nil && *s.Limit > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *DescribeResourcePoliciesInput) SetLimit(v int64) *DescribeResourcePoliciesInput {
 	s.Limit = &v
@@ -5049,6 +5442,33 @@ func (s *DescribeSubscriptionFiltersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeSubscriptionFiltersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeSubscriptionFiltersInput"}
+	if s.FilterNamePrefix != nil && len(*s.FilterNamePrefix) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("FilterNamePrefix", 512))
+	}
+	if s.FilterNamePrefix != nil && !request.MatchParamPattern("[^:*]*", *s.FilterNamePrefix) {
+		invalidParams.Add(request.NewErrParamPattern("FilterNamePrefix", "[^:*]*"))
+	}
+	if s.Limit != nil && *s.Limit > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 50))
+	}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilterNamePrefix sets the FilterNamePrefix field's value.
 func (s *DescribeSubscriptionFiltersInput) SetFilterNamePrefix(v string) *DescribeSubscriptionFiltersInput {
 	s.FilterNamePrefix = &v
@@ -5216,6 +5636,24 @@ func (s *DisassociateKmsKeyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisassociateKmsKeyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisassociateKmsKeyInput"}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLogGroupName sets the LogGroupName field's value.
 func (s *DisassociateKmsKeyInput) SetLogGroupName(v string) *DisassociateKmsKeyInput {
 	s.LogGroupName = &v
@@ -5481,6 +5919,33 @@ func (s *FilterLogEventsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *FilterLogEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "FilterLogEventsInput"}
+	if s.FilterPattern != nil && len(*s.FilterPattern) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("FilterPattern", 1024))
+	}
+	if s.Limit != nil && *s.Limit > 10000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 10000))
+	}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+	if s.LogStreamNames != nil && len(s.LogStreamNames) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogStreamNames", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEndTime sets the EndTime field's value.
 func (s *FilterLogEventsInput) SetEndTime(v int64) *FilterLogEventsInput {
 	s.EndTime = &v
@@ -5713,6 +6178,33 @@ func (s *GetLogEventsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetLogEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetLogEventsInput"}
+	if s.Limit != nil && *s.Limit > 10000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 10000))
+	}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+	if s.LogStreamName != nil && len(*s.LogStreamName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogStreamName", 512))
+	}
+	if s.LogStreamName != nil && !request.MatchParamPattern("[^:*]*", *s.LogStreamName) {
+		invalidParams.Add(request.NewErrParamPattern("LogStreamName", "[^:*]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEndTime sets the EndTime field's value.
 func (s *GetLogEventsInput) SetEndTime(v int64) *GetLogEventsInput {
 	s.EndTime = &v
@@ -5894,6 +6386,24 @@ func (s *ListTagsLogGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsLogGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsLogGroupInput"}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLogGroupName sets the LogGroupName field's value.
 func (s *ListTagsLogGroupInput) SetLogGroupName(v string) *ListTagsLogGroupInput {
 	s.LogGroupName = &v
@@ -6268,6 +6778,33 @@ func (s *MetricTransformation) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MetricTransformation) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MetricTransformation"}
+	if s.MetricName != nil && len(*s.MetricName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("MetricName", 255))
+	}
+	if s.MetricName != nil && !request.MatchParamPattern("[^:*$]*", *s.MetricName) {
+		invalidParams.Add(request.NewErrParamPattern("MetricName", "[^:*$]*"))
+	}
+	if s.MetricNamespace != nil && len(*s.MetricNamespace) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("MetricNamespace", 255))
+	}
+	if s.MetricNamespace != nil && !request.MatchParamPattern("[^:*$]*", *s.MetricNamespace) {
+		invalidParams.Add(request.NewErrParamPattern("MetricNamespace", "[^:*$]*"))
+	}
+	if s.MetricValue != nil && len(*s.MetricValue) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("MetricValue", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultValue sets the DefaultValue field's value.
 func (s *MetricTransformation) SetDefaultValue(v float64) *MetricTransformation {
 	s.DefaultValue = &v
@@ -6396,6 +6933,24 @@ func (s *PutDestinationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutDestinationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutDestinationInput"}
+	if s.DestinationName != nil && len(*s.DestinationName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("DestinationName", 512))
+	}
+	if s.DestinationName != nil && !request.MatchParamPattern("[^:*]*", *s.DestinationName) {
+		invalidParams.Add(request.NewErrParamPattern("DestinationName", "[^:*]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestinationName sets the DestinationName field's value.
 func (s *PutDestinationInput) SetDestinationName(v string) *PutDestinationInput {
 	s.DestinationName = &v
@@ -6486,6 +7041,24 @@ func (s *PutDestinationPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutDestinationPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutDestinationPolicyInput"}
+	if s.DestinationName != nil && len(*s.DestinationName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("DestinationName", 512))
+	}
+	if s.DestinationName != nil && !request.MatchParamPattern("[^:*]*", *s.DestinationName) {
+		invalidParams.Add(request.NewErrParamPattern("DestinationName", "[^:*]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessPolicy sets the AccessPolicy field's value.
 func (s *PutDestinationPolicyInput) SetAccessPolicy(v string) *PutDestinationPolicyInput {
 	s.AccessPolicy = &v
@@ -6591,6 +7164,33 @@ func (s *PutLogEventsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutLogEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutLogEventsInput"}
+	if s.LogEvents != nil && len(s.LogEvents) > 10000 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogEvents", 10000))
+	}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+	if s.LogStreamName != nil && len(*s.LogStreamName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogStreamName", 512))
+	}
+	if s.LogStreamName != nil && !request.MatchParamPattern("[^:*]*", *s.LogStreamName) {
+		invalidParams.Add(request.NewErrParamPattern("LogStreamName", "[^:*]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLogEvents sets the LogEvents field's value.
 func (s *PutLogEventsInput) SetLogEvents(v []*InputLogEvent) *PutLogEventsInput {
 	s.LogEvents = v
@@ -6724,6 +7324,46 @@ func (s *PutMetricFilterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutMetricFilterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutMetricFilterInput"}
+	if s.FilterName != nil && len(*s.FilterName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("FilterName", 512))
+	}
+	if s.FilterName != nil && !request.MatchParamPattern("[^:*]*", *s.FilterName) {
+		invalidParams.Add(request.NewErrParamPattern("FilterName", "[^:*]*"))
+	}
+	if s.FilterPattern != nil && len(*s.FilterPattern) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("FilterPattern", 1024))
+	}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+	if s.MetricTransformations != nil && len(s.MetricTransformations) > 1 {
+		invalidParams.Add(request.NewErrParamMaxLen("MetricTransformations", 1))
+	}
+	if s.MetricTransformations != nil {
+		for i, v := range s.MetricTransformations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "MetricTransformations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilterName sets the FilterName field's value.
 func (s *PutMetricFilterInput) SetFilterName(v string) *PutMetricFilterInput {
 	s.FilterName = &v
@@ -6806,6 +7446,21 @@ func (s *PutResourcePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutResourcePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutResourcePolicyInput"}
+	if s.PolicyDocument != nil && len(*s.PolicyDocument) > 5120 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyDocument", 5120))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyDocument sets the PolicyDocument field's value.
 func (s *PutResourcePolicyInput) SetPolicyDocument(v string) *PutResourcePolicyInput {
 	s.PolicyDocument = &v
@@ -6888,6 +7543,24 @@ func (s *PutRetentionPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutRetentionPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutRetentionPolicyInput"}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLogGroupName sets the LogGroupName field's value.
 func (s *PutRetentionPolicyInput) SetLogGroupName(v string) *PutRetentionPolicyInput {
 	s.LogGroupName = &v
@@ -7012,6 +7685,39 @@ func (s *PutSubscriptionFilterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutSubscriptionFilterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutSubscriptionFilterInput"}
+	if s.Distribution != nil {
+		values := []string{"Random", "ByLogStream"}
+		if !request.IsParamEnumValue(*s.Distribution, values) {
+			invalidParams.Add(request.NewErrParamEnum("Distribution", *s.Distribution, values))
+		}
+	}
+	if s.FilterName != nil && len(*s.FilterName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("FilterName", 512))
+	}
+	if s.FilterName != nil && !request.MatchParamPattern("[^:*]*", *s.FilterName) {
+		invalidParams.Add(request.NewErrParamPattern("FilterName", "[^:*]*"))
+	}
+	if s.FilterPattern != nil && len(*s.FilterPattern) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("FilterPattern", 1024))
+	}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestinationArn sets the DestinationArn field's value.
 func (s *PutSubscriptionFilterInput) SetDestinationArn(v string) *PutSubscriptionFilterInput {
 	s.DestinationArn = &v
@@ -7315,6 +8021,27 @@ func (s *TagLogGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TagLogGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagLogGroupInput"}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+	if s.Tags != nil && len(s.Tags) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLogGroupName sets the LogGroupName field's value.
 func (s *TagLogGroupInput) SetLogGroupName(v string) *TagLogGroupInput {
 	s.LogGroupName = &v
@@ -7389,6 +8116,24 @@ func (s *TestMetricFilterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TestMetricFilterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TestMetricFilterInput"}
+	if s.FilterPattern != nil && len(*s.FilterPattern) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("FilterPattern", 1024))
+	}
+	if s.LogEventMessages != nil && len(s.LogEventMessages) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogEventMessages", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilterPattern sets the FilterPattern field's value.
 func (s *TestMetricFilterInput) SetFilterPattern(v string) *TestMetricFilterInput {
 	s.FilterPattern = &v
@@ -7472,6 +8217,24 @@ func (s *UntagLogGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UntagLogGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UntagLogGroupInput"}
+	if s.LogGroupName != nil && len(*s.LogGroupName) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogGroupName", 512))
+	}
+	if s.LogGroupName != nil && !request.MatchParamPattern("[\\.\\-_/#A-Za-z0-9]+", *s.LogGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("LogGroupName", "[\\.\\-_/#A-Za-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLogGroupName sets the LogGroupName field's value.
 func (s *UntagLogGroupInput) SetLogGroupName(v string) *UntagLogGroupInput {
 	s.LogGroupName = &v
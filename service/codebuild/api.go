@@ -1014,6 +1014,21 @@ func (s *BatchDeleteBuildsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchDeleteBuildsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchDeleteBuildsInput"}
+	if s.Ids != nil && len(s.Ids) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Ids", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIds sets the Ids field's value.
 func (s *BatchDeleteBuildsInput) SetIds(v []*string) *BatchDeleteBuildsInput {
 	s.Ids = v
@@ -1089,6 +1104,21 @@ func (s *BatchGetBuildsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchGetBuildsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchGetBuildsInput"}
+	if s.Ids != nil && len(s.Ids) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Ids", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIds sets the Ids field's value.
 func (s *BatchGetBuildsInput) SetIds(v []*string) *BatchGetBuildsInput {
 	s.Ids = v
@@ -1164,6 +1194,21 @@ func (s *BatchGetProjectsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchGetProjectsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchGetProjectsInput"}
+	if s.Names != nil && len(s.Names) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Names", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNames sets the Names field's value.
 func (s *BatchGetProjectsInput) SetNames(v []*string) *BatchGetProjectsInput {
 	s.Names = v
@@ -1700,6 +1745,58 @@ func (s *CreateProjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateProjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateProjectInput"}
+	if s.Description != nil && len(*s.Description) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 255))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[A-Za-z0-9][A-Za-z0-9\\-_]{1,254}", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[A-Za-z0-9][A-Za-z0-9\\-_]{1,254}"))
+	}
+	if s.Tags != nil && len(s.Tags) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 50))
+	}
+	if s.TimeoutInMinutes != nil && *s.TimeoutInMinutes > 480 {
+		invalidParams.Add(request.NewErrParamMaxValue("TimeoutInMinutes", 480))
+	}
+	if s.Artifacts != nil {
+		if err := s.Artifacts.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Artifacts", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Environment != nil {
+		if err := s.Environment.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Environment", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Source != nil {
+		if err := s.Source.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Source", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArtifacts sets the Artifacts field's value.
 func (s *CreateProjectInput) SetArtifacts(v *ProjectArtifacts) *CreateProjectInput {
 	s.Artifacts = v
@@ -1997,6 +2094,24 @@ func (s *EnvironmentVariable) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnvironmentVariable) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnvironmentVariable"}
+	if s.Type != nil {
+		values := []string{"PLAINTEXT", "PARAMETER_STORE"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *EnvironmentVariable) SetName(v string) *EnvironmentVariable {
 	s.Name = &v
@@ -2066,6 +2181,24 @@ func (s *ListBuildsForProjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListBuildsForProjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListBuildsForProjectInput"}
+	if s.SortOrder != nil {
+		values := []string{"ASCENDING", "DESCENDING"}
+		if !request.IsParamEnumValue(*s.SortOrder, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortOrder", *s.SortOrder, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListBuildsForProjectInput) SetNextToken(v string) *ListBuildsForProjectInput {
 	s.NextToken = &v
@@ -2151,6 +2284,24 @@ func (s ListBuildsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListBuildsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListBuildsInput"}
+	if s.SortOrder != nil {
+		values := []string{"ASCENDING", "DESCENDING"}
+		if !request.IsParamEnumValue(*s.SortOrder, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortOrder", *s.SortOrder, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListBuildsInput) SetNextToken(v string) *ListBuildsInput {
 	s.NextToken = &v
@@ -2298,6 +2449,30 @@ func (s *ListProjectsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListProjectsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListProjectsInput"}
+	if s.SortBy != nil {
+		values := []string{"NAME", "CREATED_TIME", "LAST_MODIFIED_TIME"}
+		if !request.IsParamEnumValue(*s.SortBy, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortBy", *s.SortBy, values))
+		}
+	}
+	if s.SortOrder != nil {
+		values := []string{"ASCENDING", "DESCENDING"}
+		if !request.IsParamEnumValue(*s.SortOrder, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortOrder", *s.SortOrder, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListProjectsInput) SetNextToken(v string) *ListProjectsInput {
 	s.NextToken = &v
@@ -2697,6 +2872,36 @@ func (s *ProjectArtifacts) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ProjectArtifacts) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ProjectArtifacts"}
+	if s.NamespaceType != nil {
+		values := []string{"NONE", "BUILD_ID"}
+		if !request.IsParamEnumValue(*s.NamespaceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("NamespaceType", *s.NamespaceType, values))
+		}
+	}
+	if s.Packaging != nil {
+		values := []string{"NONE", "ZIP"}
+		if !request.IsParamEnumValue(*s.Packaging, values) {
+			invalidParams.Add(request.NewErrParamEnum("Packaging", *s.Packaging, values))
+		}
+	}
+	if s.Type != nil {
+		values := []string{"CODEPIPELINE", "S3", "NO_ARTIFACTS"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLocation sets the Location field's value.
 func (s *ProjectArtifacts) SetLocation(v string) *ProjectArtifacts {
 	s.Location = &v
@@ -2824,6 +3029,40 @@ func (s *ProjectEnvironment) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ProjectEnvironment) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ProjectEnvironment"}
+	if s.ComputeType != nil {
+		values := []string{"BUILD_GENERAL1_SMALL", "BUILD_GENERAL1_MEDIUM", "BUILD_GENERAL1_LARGE"}
+		if !request.IsParamEnumValue(*s.ComputeType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ComputeType", *s.ComputeType, values))
+		}
+	}
+	if s.Type != nil {
+		values := []string{"LINUX_CONTAINER"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.EnvironmentVariables != nil {
+		for i, v := range s.EnvironmentVariables {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "EnvironmentVariables", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComputeType sets the ComputeType field's value.
 func (s *ProjectEnvironment) SetComputeType(v string) *ProjectEnvironment {
 	s.ComputeType = &v
@@ -2961,6 +3200,29 @@ func (s *ProjectSource) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ProjectSource) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ProjectSource"}
+	if s.Type != nil {
+		values := []string{"CODECOMMIT", "CODEPIPELINE", "GITHUB", "S3", "BITBUCKET"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.Auth != nil {
+		if err := s.Auth.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Auth", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuth sets the Auth field's value.
 func (s *ProjectSource) SetAuth(v *SourceAuth) *ProjectSource {
 	s.Auth = v
@@ -3028,6 +3290,24 @@ func (s *SourceAuth) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SourceAuth) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SourceAuth"}
+	if s.Type != nil {
+		values := []string{"OAUTH"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResource sets the Resource field's value.
 func (s *SourceAuth) SetResource(v string) *SourceAuth {
 	s.Resource = &v
@@ -3131,6 +3411,36 @@ func (s *StartBuildInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartBuildInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartBuildInput"}
+	if s.TimeoutInMinutesOverride != nil && *s.TimeoutInMinutesOverride > 480 {
+		invalidParams.Add(request.NewErrParamMaxValue("TimeoutInMinutesOverride", 480))
+	}
+	if s.ArtifactsOverride != nil {
+		if err := s.ArtifactsOverride.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ArtifactsOverride", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.EnvironmentVariablesOverride != nil {
+		for i, v := range s.EnvironmentVariablesOverride {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "EnvironmentVariablesOverride", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArtifactsOverride sets the ArtifactsOverride field's value.
 func (s *StartBuildInput) SetArtifactsOverride(v *ProjectArtifacts) *StartBuildInput {
 	s.ArtifactsOverride = v
@@ -3297,6 +3607,30 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 127 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 127))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^([\\\\p{L}\\\\p{Z}\\\\p{N}_.:/=@+\\\\-]*)$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^([\\\\p{L}\\\\p{Z}\\\\p{N}_.:/=@+\\\\-]*)$"))
+	}
+	if s.Value != nil && len(*s.Value) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 255))
+	}
+	if s.Value != nil && !request.MatchParamPattern("^([\\\\p{L}\\\\p{Z}\\\\p{N}_.:/=@+\\\\-]*)$", *s.Value) {
+		invalidParams.Add(request.NewErrParamPattern("Value", "^([\\\\p{L}\\\\p{Z}\\\\p{N}_.:/=@+\\\\-]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -3417,6 +3751,52 @@ func (s *UpdateProjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateProjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateProjectInput"}
+	if s.Description != nil && len(*s.Description) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 255))
+	}
+	if s.Tags != nil && len(s.Tags) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 50))
+	}
+	if s.TimeoutInMinutes != nil && *s.TimeoutInMinutes > 480 {
+		invalidParams.Add(request.NewErrParamMaxValue("TimeoutInMinutes", 480))
+	}
+	if s.Artifacts != nil {
+		if err := s.Artifacts.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Artifacts", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Environment != nil {
+		if err := s.Environment.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Environment", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Source != nil {
+		if err := s.Source.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Source", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArtifacts sets the Artifacts field's value.
 func (s *UpdateProjectInput) SetArtifacts(v *ProjectArtifacts) *UpdateProjectInput {
 	s.Artifacts = v
@@ -2448,6 +2448,27 @@ func (s *CreateBranchInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateBranchInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateBranchInput"}
+	if s.BranchName != nil && len(*s.BranchName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BranchName", 100))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 100))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "[\\w\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBranchName sets the BranchName field's value.
 func (s *CreateBranchInput) SetBranchName(v string) *CreateBranchInput {
 	s.BranchName = &v
@@ -2533,6 +2554,27 @@ func (s *CreateRepositoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateRepositoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateRepositoryInput"}
+	if s.RepositoryDescription != nil && len(*s.RepositoryDescription) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryDescription", 1000))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 100))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "[\\w\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRepositoryDescription sets the RepositoryDescription field's value.
 func (s *CreateRepositoryInput) SetRepositoryDescription(v string) *CreateRepositoryInput {
 	s.RepositoryDescription = &v
@@ -2607,6 +2649,24 @@ func (s *DeleteRepositoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteRepositoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteRepositoryInput"}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 100))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "[\\w\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRepositoryName sets the RepositoryName field's value.
 func (s *DeleteRepositoryInput) SetRepositoryName(v string) *DeleteRepositoryInput {
 	s.RepositoryName = &v
@@ -2729,6 +2789,24 @@ func (s *GetBlobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetBlobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetBlobInput"}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 100))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "[\\w\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBlobId sets the BlobId field's value.
 func (s *GetBlobInput) SetBlobId(v string) *GetBlobInput {
 	s.BlobId = &v
@@ -2809,6 +2887,27 @@ func (s *GetBranchInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetBranchInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetBranchInput"}
+	if s.BranchName != nil && len(*s.BranchName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BranchName", 100))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 100))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "[\\w\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBranchName sets the BranchName field's value.
 func (s *GetBranchInput) SetBranchName(v string) *GetBranchInput {
 	s.BranchName = &v
@@ -2891,6 +2990,24 @@ func (s *GetCommitInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetCommitInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetCommitInput"}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 100))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "[\\w\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCommitId sets the CommitId field's value.
 func (s *GetCommitInput) SetCommitId(v string) *GetCommitInput {
 	s.CommitId = &v
@@ -3000,6 +3117,24 @@ func (s *GetDifferencesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDifferencesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDifferencesInput"}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 100))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "[\\w\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAfterCommitSpecifier sets the AfterCommitSpecifier field's value.
 func (s *GetDifferencesInput) SetAfterCommitSpecifier(v string) *GetDifferencesInput {
 	s.AfterCommitSpecifier = &v
@@ -3114,6 +3249,24 @@ func (s *GetRepositoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetRepositoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetRepositoryInput"}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 100))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "[\\w\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRepositoryName sets the RepositoryName field's value.
 func (s *GetRepositoryInput) SetRepositoryName(v string) *GetRepositoryInput {
 	s.RepositoryName = &v
@@ -3182,6 +3335,24 @@ func (s *GetRepositoryTriggersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetRepositoryTriggersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetRepositoryTriggersInput"}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 100))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "[\\w\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRepositoryName sets the RepositoryName field's value.
 func (s *GetRepositoryTriggersInput) SetRepositoryName(v string) *GetRepositoryTriggersInput {
 	s.RepositoryName = &v
@@ -3262,6 +3433,24 @@ func (s *ListBranchesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListBranchesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListBranchesInput"}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 100))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "[\\w\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListBranchesInput) SetNextToken(v string) *ListBranchesInput {
 	s.NextToken = &v
@@ -3336,6 +3525,30 @@ func (s ListRepositoriesInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListRepositoriesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRepositoriesInput"}
+	if s.Order != nil {
+		values := []string{"ascending", "descending"}
+		if !request.IsParamEnumValue(*s.Order, values) {
+			invalidParams.Add(request.NewErrParamEnum("Order", *s.Order, values))
+		}
+	}
+	if s.SortBy != nil {
+		values := []string{"repositoryName", "lastModifiedDate"}
+		if !request.IsParamEnumValue(*s.SortBy, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortBy", *s.SortBy, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListRepositoriesInput) SetNextToken(v string) *ListRepositoriesInput {
 	s.NextToken = &v
@@ -3446,6 +3659,24 @@ func (s *PutRepositoryTriggersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutRepositoryTriggersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutRepositoryTriggersInput"}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 100))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "[\\w\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRepositoryName sets the RepositoryName field's value.
 func (s *PutRepositoryTriggersInput) SetRepositoryName(v string) *PutRepositoryTriggersInput {
 	s.RepositoryName = &v
@@ -3805,6 +4036,24 @@ func (s *TestRepositoryTriggersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TestRepositoryTriggersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TestRepositoryTriggersInput"}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 100))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "[\\w\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRepositoryName sets the RepositoryName field's value.
 func (s *TestRepositoryTriggersInput) SetRepositoryName(v string) *TestRepositoryTriggersInput {
 	s.RepositoryName = &v
@@ -3901,6 +4150,27 @@ func (s *UpdateDefaultBranchInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDefaultBranchInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDefaultBranchInput"}
+	if s.DefaultBranchName != nil && len(*s.DefaultBranchName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultBranchName", 100))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 100))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "[\\w\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultBranchName sets the DefaultBranchName field's value.
 func (s *UpdateDefaultBranchInput) SetDefaultBranchName(v string) *UpdateDefaultBranchInput {
 	s.DefaultBranchName = &v
@@ -3969,6 +4239,27 @@ func (s *UpdateRepositoryDescriptionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateRepositoryDescriptionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateRepositoryDescriptionInput"}
+	if s.RepositoryDescription != nil && len(*s.RepositoryDescription) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryDescription", 1000))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 100))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "[\\w\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRepositoryDescription sets the RepositoryDescription field's value.
 func (s *UpdateRepositoryDescriptionInput) SetRepositoryDescription(v string) *UpdateRepositoryDescriptionInput {
 	s.RepositoryDescription = &v
@@ -4044,6 +4335,30 @@ func (s *UpdateRepositoryNameInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateRepositoryNameInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateRepositoryNameInput"}
+	if s.NewName != nil && len(*s.NewName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("NewName", 100))
+	}
+	if s.NewName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.NewName) {
+		invalidParams.Add(request.NewErrParamPattern("NewName", "[\\w\\.-]+"))
+	}
+	if s.OldName != nil && len(*s.OldName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("OldName", 100))
+	}
+	if s.OldName != nil && !request.MatchParamPattern("[\\w\\.-]+", *s.OldName) {
+		invalidParams.Add(request.NewErrParamPattern("OldName", "[\\w\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNewName sets the NewName field's value.
 func (s *UpdateRepositoryNameInput) SetNewName(v string) *UpdateRepositoryNameInput {
 	s.NewName = &v
@@ -3,6 +3,7 @@
 package codedeploy
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -4385,6 +4386,31 @@ func (s *BatchGetApplicationRevisionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchGetApplicationRevisionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchGetApplicationRevisionsInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.Revisions != nil {
+		for i, v := range s.Revisions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Revisions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *BatchGetApplicationRevisionsInput) SetApplicationName(v string) *BatchGetApplicationRevisionsInput {
 	s.ApplicationName = &v
@@ -4536,6 +4562,21 @@ func (s *BatchGetDeploymentGroupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchGetDeploymentGroupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchGetDeploymentGroupsInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *BatchGetDeploymentGroupsInput) SetApplicationName(v string) *BatchGetDeploymentGroupsInput {
 	s.ApplicationName = &v
@@ -4798,6 +4839,33 @@ func (s BlueGreenDeploymentConfiguration) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BlueGreenDeploymentConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BlueGreenDeploymentConfiguration"}
+	if s.DeploymentReadyOption != nil {
+		if err := s.DeploymentReadyOption.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DeploymentReadyOption", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.GreenFleetProvisioningOption != nil {
+		if err := s.GreenFleetProvisioningOption.ValidateExtended(); err != nil {
+			invalidParams.AddNested("GreenFleetProvisioningOption", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TerminateBlueInstancesOnDeploymentSuccess != nil {
+		if err := s.TerminateBlueInstancesOnDeploymentSuccess.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TerminateBlueInstancesOnDeploymentSuccess", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeploymentReadyOption sets the DeploymentReadyOption field's value.
 func (s *BlueGreenDeploymentConfiguration) SetDeploymentReadyOption(v *DeploymentReadyOption) *BlueGreenDeploymentConfiguration {
 	s.DeploymentReadyOption = v
@@ -4846,6 +4914,24 @@ func (s BlueInstanceTerminationOption) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BlueInstanceTerminationOption) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BlueInstanceTerminationOption"}
+	if s.Action != nil {
+		values := []string{"TERMINATE", "KEEP_ALIVE"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *BlueInstanceTerminationOption) SetAction(v string) *BlueInstanceTerminationOption {
 	s.Action = &v
@@ -4936,6 +5022,21 @@ func (s *CreateApplicationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateApplicationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateApplicationInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *CreateApplicationInput) SetApplicationName(v string) *CreateApplicationInput {
 	s.ApplicationName = &v
@@ -5030,6 +5131,26 @@ func (s *CreateDeploymentConfigInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDeploymentConfigInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDeploymentConfigInput"}
+	if s.DeploymentConfigName != nil && len(*s.DeploymentConfigName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeploymentConfigName", 100))
+	}
+	if s.MinimumHealthyHosts != nil {
+		if err := s.MinimumHealthyHosts.ValidateExtended(); err != nil {
+			invalidParams.AddNested("MinimumHealthyHosts", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeploymentConfigName sets the DeploymentConfigName field's value.
 func (s *CreateDeploymentConfigInput) SetDeploymentConfigName(v string) *CreateDeploymentConfigInput {
 	s.DeploymentConfigName = &v
@@ -5188,6 +5309,57 @@ func (s *CreateDeploymentGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDeploymentGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDeploymentGroupInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.DeploymentConfigName != nil && len(*s.DeploymentConfigName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeploymentConfigName", 100))
+	}
+	if s.DeploymentGroupName != nil && len(*s.DeploymentGroupName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeploymentGroupName", 100))
+	}
+	if s.BlueGreenDeploymentConfiguration != nil {
+		if err := s.BlueGreenDeploymentConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("BlueGreenDeploymentConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DeploymentStyle != nil {
+		if err := s.DeploymentStyle.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DeploymentStyle", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Ec2TagFilters != nil {
+		for i, v := range s.Ec2TagFilters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Ec2TagFilters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.OnPremisesInstanceTagFilters != nil {
+		for i, v := range s.OnPremisesInstanceTagFilters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OnPremisesInstanceTagFilters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAlarmConfiguration sets the AlarmConfiguration field's value.
 func (s *CreateDeploymentGroupInput) SetAlarmConfiguration(v *AlarmConfiguration) *CreateDeploymentGroupInput {
 	s.AlarmConfiguration = v
@@ -5403,6 +5575,43 @@ func (s *CreateDeploymentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDeploymentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDeploymentInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.DeploymentConfigName != nil && len(*s.DeploymentConfigName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeploymentConfigName", 100))
+	}
+	if s.DeploymentGroupName != nil && len(*s.DeploymentGroupName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeploymentGroupName", 100))
+	}
+	if s.FileExistsBehavior != nil {
+		values := []string{"DISALLOW", "OVERWRITE", "RETAIN"}
+		if !request.IsParamEnumValue(*s.FileExistsBehavior, values) {
+			invalidParams.Add(request.NewErrParamEnum("FileExistsBehavior", *s.FileExistsBehavior, values))
+		}
+	}
+	if s.Revision != nil {
+		if err := s.Revision.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Revision", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TargetInstances != nil {
+		if err := s.TargetInstances.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TargetInstances", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *CreateDeploymentInput) SetApplicationName(v string) *CreateDeploymentInput {
 	s.ApplicationName = &v
@@ -5526,6 +5735,21 @@ func (s *DeleteApplicationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteApplicationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteApplicationInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *DeleteApplicationInput) SetApplicationName(v string) *DeleteApplicationInput {
 	s.ApplicationName = &v
@@ -5585,6 +5809,21 @@ func (s *DeleteDeploymentConfigInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteDeploymentConfigInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDeploymentConfigInput"}
+	if s.DeploymentConfigName != nil && len(*s.DeploymentConfigName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeploymentConfigName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeploymentConfigName sets the DeploymentConfigName field's value.
 func (s *DeleteDeploymentConfigInput) SetDeploymentConfigName(v string) *DeleteDeploymentConfigInput {
 	s.DeploymentConfigName = &v
@@ -5655,6 +5894,24 @@ func (s *DeleteDeploymentGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteDeploymentGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDeploymentGroupInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.DeploymentGroupName != nil && len(*s.DeploymentGroupName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeploymentGroupName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *DeleteDeploymentGroupInput) SetApplicationName(v string) *DeleteDeploymentGroupInput {
 	s.ApplicationName = &v
@@ -6335,6 +6592,24 @@ func (s DeploymentReadyOption) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeploymentReadyOption) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeploymentReadyOption"}
+	if s.ActionOnTimeout != nil {
+		values := []string{"CONTINUE_DEPLOYMENT", "STOP_DEPLOYMENT"}
+		if !request.IsParamEnumValue(*s.ActionOnTimeout, values) {
+			invalidParams.Add(request.NewErrParamEnum("ActionOnTimeout", *s.ActionOnTimeout, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActionOnTimeout sets the ActionOnTimeout field's value.
 func (s *DeploymentReadyOption) SetActionOnTimeout(v string) *DeploymentReadyOption {
 	s.ActionOnTimeout = &v
@@ -6370,6 +6645,30 @@ func (s DeploymentStyle) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeploymentStyle) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeploymentStyle"}
+	if s.DeploymentOption != nil {
+		values := []string{"WITH_TRAFFIC_CONTROL", "WITHOUT_TRAFFIC_CONTROL"}
+		if !request.IsParamEnumValue(*s.DeploymentOption, values) {
+			invalidParams.Add(request.NewErrParamEnum("DeploymentOption", *s.DeploymentOption, values))
+		}
+	}
+	if s.DeploymentType != nil {
+		values := []string{"IN_PLACE", "BLUE_GREEN"}
+		if !request.IsParamEnumValue(*s.DeploymentType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DeploymentType", *s.DeploymentType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeploymentOption sets the DeploymentOption field's value.
 func (s *DeploymentStyle) SetDeploymentOption(v string) *DeploymentStyle {
 	s.DeploymentOption = &v
@@ -6537,6 +6836,24 @@ func (s EC2TagFilter) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EC2TagFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EC2TagFilter"}
+	if s.Type != nil {
+		values := []string{"KEY_ONLY", "VALUE_ONLY", "KEY_AND_VALUE"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *EC2TagFilter) SetKey(v string) *EC2TagFilter {
 	s.Key = &v
@@ -6786,6 +7103,21 @@ func (s *GetApplicationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetApplicationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetApplicationInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *GetApplicationInput) SetApplicationName(v string) *GetApplicationInput {
 	s.ApplicationName = &v
@@ -6862,6 +7194,26 @@ func (s *GetApplicationRevisionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetApplicationRevisionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetApplicationRevisionInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.Revision != nil {
+		if err := s.Revision.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Revision", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *GetApplicationRevisionInput) SetApplicationName(v string) *GetApplicationRevisionInput {
 	s.ApplicationName = &v
@@ -6955,6 +7307,21 @@ func (s *GetDeploymentConfigInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDeploymentConfigInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDeploymentConfigInput"}
+	if s.DeploymentConfigName != nil && len(*s.DeploymentConfigName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeploymentConfigName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeploymentConfigName sets the DeploymentConfigName field's value.
 func (s *GetDeploymentConfigInput) SetDeploymentConfigName(v string) *GetDeploymentConfigInput {
 	s.DeploymentConfigName = &v
@@ -7035,6 +7402,24 @@ func (s *GetDeploymentGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDeploymentGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDeploymentGroupInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.DeploymentGroupName != nil && len(*s.DeploymentGroupName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeploymentGroupName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *GetDeploymentGroupInput) SetApplicationName(v string) *GetDeploymentGroupInput {
 	s.ApplicationName = &v
@@ -7345,6 +7730,24 @@ func (s GreenFleetProvisioningOption) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GreenFleetProvisioningOption) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GreenFleetProvisioningOption"}
+	if s.Action != nil {
+		values := []string{"DISCOVER_EXISTING", "COPY_AUTO_SCALING_GROUP"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *GreenFleetProvisioningOption) SetAction(v string) *GreenFleetProvisioningOption {
 	s.Action = &v
@@ -7732,6 +8135,39 @@ func (s *ListApplicationRevisionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListApplicationRevisionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListApplicationRevisionsInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.Deployed != nil {
+		values := []string{"include", "exclude", "ignore"}
+		if !request.IsParamEnumValue(*s.Deployed, values) {
+			invalidParams.Add(request.NewErrParamEnum("Deployed", *s.Deployed, values))
+		}
+	}
+	if s.SortBy != nil {
+		values := []string{"registerTime", "firstUsedTime", "lastUsedTime"}
+		if !request.IsParamEnumValue(*s.SortBy, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortBy", *s.SortBy, values))
+		}
+	}
+	if s.SortOrder != nil {
+		values := []string{"ascending", "descending"}
+		if !request.IsParamEnumValue(*s.SortOrder, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortOrder", *s.SortOrder, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *ListApplicationRevisionsInput) SetApplicationName(v string) *ListApplicationRevisionsInput {
 	s.ApplicationName = &v
@@ -7978,6 +8414,21 @@ func (s *ListDeploymentGroupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListDeploymentGroupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDeploymentGroupsInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *ListDeploymentGroupsInput) SetApplicationName(v string) *ListDeploymentGroupsInput {
 	s.ApplicationName = &v
@@ -8214,6 +8665,24 @@ func (s *ListDeploymentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListDeploymentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDeploymentsInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.DeploymentGroupName != nil && len(*s.DeploymentGroupName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeploymentGroupName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *ListDeploymentsInput) SetApplicationName(v string) *ListDeploymentsInput {
 	s.ApplicationName = &v
@@ -8375,6 +8844,34 @@ func (s ListOnPremisesInstancesInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListOnPremisesInstancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListOnPremisesInstancesInput"}
+	if s.RegistrationStatus != nil {
+		values := []string{"Registered", "Deregistered"}
+		if !request.IsParamEnumValue(*s.RegistrationStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("RegistrationStatus", *s.RegistrationStatus, values))
+		}
+	}
+	if s.TagFilters != nil {
+		for i, v := range s.TagFilters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TagFilters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListOnPremisesInstancesInput) SetNextToken(v string) *ListOnPremisesInstancesInput {
 	s.NextToken = &v
@@ -8515,6 +9012,24 @@ func (s MinimumHealthyHosts) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MinimumHealthyHosts) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MinimumHealthyHosts"}
+	if s.Type != nil {
+		values := []string{"HOST_COUNT", "FLEET_PERCENT"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetType sets the Type field's value.
 func (s *MinimumHealthyHosts) SetType(v string) *MinimumHealthyHosts {
 	s.Type = &v
@@ -8604,6 +9119,26 @@ func (s *RegisterApplicationRevisionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterApplicationRevisionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterApplicationRevisionInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.Revision != nil {
+		if err := s.Revision.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Revision", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *RegisterApplicationRevisionInput) SetApplicationName(v string) *RegisterApplicationRevisionInput {
 	s.ApplicationName = &v
@@ -8844,6 +9379,29 @@ func (s RevisionLocation) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RevisionLocation) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RevisionLocation"}
+	if s.RevisionType != nil {
+		values := []string{"S3", "GitHub"}
+		if !request.IsParamEnumValue(*s.RevisionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("RevisionType", *s.RevisionType, values))
+		}
+	}
+	if s.S3Location != nil {
+		if err := s.S3Location.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3Location", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGitHubLocation sets the GitHubLocation field's value.
 func (s *RevisionLocation) SetGitHubLocation(v *GitHubLocation) *RevisionLocation {
 	s.GitHubLocation = v
@@ -8954,6 +9512,24 @@ func (s S3Location) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *S3Location) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "S3Location"}
+	if s.BundleType != nil {
+		values := []string{"tar", "tgz", "zip"}
+		if !request.IsParamEnumValue(*s.BundleType, values) {
+			invalidParams.Add(request.NewErrParamEnum("BundleType", *s.BundleType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *S3Location) SetBucket(v string) *S3Location {
 	s.Bucket = &v
@@ -9178,6 +9754,24 @@ func (s TagFilter) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TagFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagFilter"}
+	if s.Type != nil {
+		values := []string{"KEY_ONLY", "VALUE_ONLY", "KEY_AND_VALUE"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *TagFilter) SetKey(v string) *TagFilter {
 	s.Key = &v
@@ -9258,6 +9852,28 @@ func (s TargetInstances) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TargetInstances) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TargetInstances"}
+	if s.TagFilters != nil {
+		for i, v := range s.TagFilters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TagFilters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingGroups sets the AutoScalingGroups field's value.
 func (s *TargetInstances) SetAutoScalingGroups(v []*string) *TargetInstances {
 	s.AutoScalingGroups = v
@@ -9396,6 +10012,24 @@ func (s *UpdateApplicationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateApplicationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateApplicationInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.NewApplicationName != nil && len(*s.NewApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("NewApplicationName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *UpdateApplicationInput) SetApplicationName(v string) *UpdateApplicationInput {
 	s.ApplicationName = &v
@@ -9534,6 +10168,60 @@ func (s *UpdateDeploymentGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDeploymentGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDeploymentGroupInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.CurrentDeploymentGroupName != nil && len(*s.CurrentDeploymentGroupName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("CurrentDeploymentGroupName", 100))
+	}
+	if s.DeploymentConfigName != nil && len(*s.DeploymentConfigName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeploymentConfigName", 100))
+	}
+	if s.NewDeploymentGroupName != nil && len(*s.NewDeploymentGroupName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("NewDeploymentGroupName", 100))
+	}
+	if s.BlueGreenDeploymentConfiguration != nil {
+		if err := s.BlueGreenDeploymentConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("BlueGreenDeploymentConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DeploymentStyle != nil {
+		if err := s.DeploymentStyle.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DeploymentStyle", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Ec2TagFilters != nil {
+		for i, v := range s.Ec2TagFilters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Ec2TagFilters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.OnPremisesInstanceTagFilters != nil {
+		for i, v := range s.OnPremisesInstanceTagFilters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OnPremisesInstanceTagFilters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAlarmConfiguration sets the AlarmConfiguration field's value.
 func (s *UpdateDeploymentGroupInput) SetAlarmConfiguration(v *AlarmConfiguration) *UpdateDeploymentGroupInput {
 	s.AlarmConfiguration = v
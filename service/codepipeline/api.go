@@ -739,9 +739,10 @@ const opGetJobDetails = "GetJobDetails"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/GetJobDetails
 func (c *CodePipeline) GetJobDetailsRequest(input *GetJobDetailsInput) (req *request.Request, output *GetJobDetailsOutput) {
 	op := &request.Operation{
-		Name:       opGetJobDetails,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opGetJobDetails,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"jobDetails.data.artifactCredentials"},
 	}
 
 	if input == nil {
@@ -1085,9 +1086,10 @@ const opGetThirdPartyJobDetails = "GetThirdPartyJobDetails"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/GetThirdPartyJobDetails
 func (c *CodePipeline) GetThirdPartyJobDetailsRequest(input *GetThirdPartyJobDetailsInput) (req *request.Request, output *GetThirdPartyJobDetailsOutput) {
 	op := &request.Operation{
-		Name:       opGetThirdPartyJobDetails,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opGetThirdPartyJobDetails,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"jobDetails.data.artifactCredentials"},
 	}
 
 	if input == nil {
@@ -1429,9 +1431,10 @@ const opPollForJobs = "PollForJobs"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/PollForJobs
 func (c *CodePipeline) PollForJobsRequest(input *PollForJobsInput) (req *request.Request, output *PollForJobsOutput) {
 	op := &request.Operation{
-		Name:       opPollForJobs,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opPollForJobs,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"jobs[*].data.artifactCredentials"},
 	}
 
 	if input == nil {
@@ -2394,7 +2397,7 @@ func (c *CodePipeline) UpdatePipelineWithContext(ctx aws.Context, input *UpdateP
 // store artifact for the pipeline in AWS CodePipeline.
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/codepipeline-2015-07-09/AWSSessionCredentials
 type AWSSessionCredentials struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" sensitive:"true"`
 
 	// The access key for the session.
 	//
@@ -2484,6 +2487,21 @@ func (s *AcknowledgeJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AcknowledgeJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AcknowledgeJobInput"}
+	if s.JobId != nil && !request.MatchParamPattern("[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.JobId) {
+		invalidParams.Add(request.NewErrParamPattern("JobId", "[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobId sets the JobId field's value.
 func (s *AcknowledgeJobInput) SetJobId(v string) *AcknowledgeJobInput {
 	s.JobId = &v
@@ -2580,6 +2598,24 @@ func (s *AcknowledgeThirdPartyJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AcknowledgeThirdPartyJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AcknowledgeThirdPartyJobInput"}
+	if s.ClientToken != nil && len(*s.ClientToken) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientToken", 256))
+	}
+	if s.JobId != nil && len(*s.JobId) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobId", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientToken sets the ClientToken field's value.
 func (s *AcknowledgeThirdPartyJobInput) SetClientToken(v string) *AcknowledgeThirdPartyJobInput {
 	s.ClientToken = &v
@@ -2735,6 +2771,30 @@ func (s *ActionConfigurationProperty) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ActionConfigurationProperty) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActionConfigurationProperty"}
+	if s.Description != nil && len(*s.Description) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2048))
+	}
+	if s.Name != nil && len(*s.Name) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 50))
+	}
+	if s.Type != nil {
+		values := []string{"String", "Number", "Boolean"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *ActionConfigurationProperty) SetDescription(v string) *ActionConfigurationProperty {
 	s.Description = &v
@@ -2894,6 +2954,58 @@ func (s *ActionDeclaration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ActionDeclaration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActionDeclaration"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[A-Za-z0-9.@\\-_]+"))
+	}
+	if s.RoleArn != nil && len(*s.RoleArn) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleArn", 1024))
+	}
+	if s.RoleArn != nil && !request.MatchParamPattern("arn:aws(-[\\w]+)*:iam::[0-9]{12}:role/.*", *s.RoleArn) {
+		invalidParams.Add(request.NewErrParamPattern("RoleArn", "arn:aws(-[\\w]+)*:iam::[0-9]{12}:role/.*"))
+	}
+	if s.RunOrder != nil && *s.RunOrder > 999 {
+		invalidParams.Add(request.NewErrParamMaxValue("RunOrder", 999))
+	}
+	if s.ActionTypeId != nil {
+		if err := s.ActionTypeId.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ActionTypeId", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.InputArtifacts != nil {
+		for i, v := range s.InputArtifacts {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "InputArtifacts", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.OutputArtifacts != nil {
+		for i, v := range s.OutputArtifacts {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OutputArtifacts", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActionTypeId sets the ActionTypeId field's value.
 func (s *ActionDeclaration) SetActionTypeId(v *ActionTypeId) *ActionDeclaration {
 	s.ActionTypeId = v
@@ -3097,6 +3209,24 @@ func (s *ActionRevision) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ActionRevision) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActionRevision"}
+	if s.RevisionChangeId != nil && len(*s.RevisionChangeId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RevisionChangeId", 100))
+	}
+	if s.RevisionId != nil && len(*s.RevisionId) > 1500 {
+		invalidParams.Add(request.NewErrParamMaxLen("RevisionId", 1500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCreated sets the Created field's value.
 func (s *ActionRevision) SetCreated(v time.Time) *ActionRevision {
 	s.Created = &v
@@ -3314,6 +3444,42 @@ func (s *ActionTypeId) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ActionTypeId) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActionTypeId"}
+	if s.Category != nil {
+		values := []string{"Source", "Build", "Deploy", "Test", "Invoke", "Approval"}
+		if !request.IsParamEnumValue(*s.Category, values) {
+			invalidParams.Add(request.NewErrParamEnum("Category", *s.Category, values))
+		}
+	}
+	if s.Owner != nil {
+		values := []string{"AWS", "ThirdParty", "Custom"}
+		if !request.IsParamEnumValue(*s.Owner, values) {
+			invalidParams.Add(request.NewErrParamEnum("Owner", *s.Owner, values))
+		}
+	}
+	if s.Provider != nil && len(*s.Provider) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("Provider", 25))
+	}
+	if s.Provider != nil && !request.MatchParamPattern("[0-9A-Za-z_-]+", *s.Provider) {
+		invalidParams.Add(request.NewErrParamPattern("Provider", "[0-9A-Za-z_-]+"))
+	}
+	if s.Version != nil && len(*s.Version) > 9 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 9))
+	}
+	if s.Version != nil && !request.MatchParamPattern("[0-9A-Za-z_-]+", *s.Version) {
+		invalidParams.Add(request.NewErrParamPattern("Version", "[0-9A-Za-z_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCategory sets the Category field's value.
 func (s *ActionTypeId) SetCategory(v string) *ActionTypeId {
 	s.Category = &v
@@ -3398,6 +3564,30 @@ func (s *ActionTypeSettings) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ActionTypeSettings) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActionTypeSettings"}
+	if s.EntityUrlTemplate != nil && len(*s.EntityUrlTemplate) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("EntityUrlTemplate", 2048))
+	}
+	if s.ExecutionUrlTemplate != nil && len(*s.ExecutionUrlTemplate) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExecutionUrlTemplate", 2048))
+	}
+	if s.RevisionUrlTemplate != nil && len(*s.RevisionUrlTemplate) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RevisionUrlTemplate", 2048))
+	}
+	if s.ThirdPartyConfigurationUrl != nil && len(*s.ThirdPartyConfigurationUrl) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThirdPartyConfigurationUrl", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEntityUrlTemplate sets the EntityUrlTemplate field's value.
 func (s *ActionTypeSettings) SetEntityUrlTemplate(v string) *ActionTypeSettings {
 	s.EntityUrlTemplate = &v
@@ -3464,6 +3654,27 @@ func (s *ApprovalResult) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ApprovalResult) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ApprovalResult"}
+	if s.Status != nil {
+		values := []string{"Approved", "Rejected"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+	if s.Summary != nil && len(*s.Summary) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("Summary", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStatus sets the Status field's value.
 func (s *ApprovalResult) SetStatus(v string) *ApprovalResult {
 	s.Status = &v
@@ -3563,6 +3774,24 @@ func (s *ArtifactDetails) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ArtifactDetails) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ArtifactDetails"}
+	if s.MaximumCount != nil && *s.MaximumCount > 5 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaximumCount", 5))
+	}
+	if s.MinimumCount != nil && *s.MinimumCount > 5 {
+		invalidParams.Add(request.NewErrParamMaxValue("MinimumCount", 5))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaximumCount sets the MaximumCount field's value.
 func (s *ArtifactDetails) SetMaximumCount(v int64) *ArtifactDetails {
 	s.MaximumCount = &v
@@ -3746,6 +3975,35 @@ func (s *ArtifactStore) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ArtifactStore) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ArtifactStore"}
+	if s.Location != nil && len(*s.Location) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("Location", 63))
+	}
+	if s.Location != nil && !request.MatchParamPattern("[a-zA-Z0-9\\-\\.]+", *s.Location) {
+		invalidParams.Add(request.NewErrParamPattern("Location", "[a-zA-Z0-9\\-\\.]+"))
+	}
+	if s.Type != nil {
+		values := []string{"S3"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.EncryptionKey != nil {
+		if err := s.EncryptionKey.ValidateExtended(); err != nil {
+			invalidParams.AddNested("EncryptionKey", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEncryptionKey sets the EncryptionKey field's value.
 func (s *ArtifactStore) SetEncryptionKey(v *EncryptionKey) *ArtifactStore {
 	s.EncryptionKey = v
@@ -3809,6 +4067,27 @@ func (s *BlockerDeclaration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BlockerDeclaration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BlockerDeclaration"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Type != nil {
+		values := []string{"Schedule"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *BlockerDeclaration) SetName(v string) *BlockerDeclaration {
 	s.Name = &v
@@ -3932,6 +4211,64 @@ func (s *CreateCustomActionTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateCustomActionTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateCustomActionTypeInput"}
+	if s.Category != nil {
+		values := []string{"Source", "Build", "Deploy", "Test", "Invoke", "Approval"}
+		if !request.IsParamEnumValue(*s.Category, values) {
+			invalidParams.Add(request.NewErrParamEnum("Category", *s.Category, values))
+		}
+	}
+	if s.ConfigurationProperties != nil && len(s.ConfigurationProperties) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConfigurationProperties", 10))
+	}
+	if s.Provider != nil && len(*s.Provider) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("Provider", 25))
+	}
+	if s.Provider != nil && !request.MatchParamPattern("[0-9A-Za-z_-]+", *s.Provider) {
+		invalidParams.Add(request.NewErrParamPattern("Provider", "[0-9A-Za-z_-]+"))
+	}
+	if s.Version != nil && len(*s.Version) > 9 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 9))
+	}
+	if s.Version != nil && !request.MatchParamPattern("[0-9A-Za-z_-]+", *s.Version) {
+		invalidParams.Add(request.NewErrParamPattern("Version", "[0-9A-Za-z_-]+"))
+	}
+	if s.ConfigurationProperties != nil {
+		for i, v := range s.ConfigurationProperties {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ConfigurationProperties", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.InputArtifactDetails != nil {
+		if err := s.InputArtifactDetails.ValidateExtended(); err != nil {
+			invalidParams.AddNested("InputArtifactDetails", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.OutputArtifactDetails != nil {
+		if err := s.OutputArtifactDetails.ValidateExtended(); err != nil {
+			invalidParams.AddNested("OutputArtifactDetails", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Settings != nil {
+		if err := s.Settings.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Settings", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCategory sets the Category field's value.
 func (s *CreateCustomActionTypeInput) SetCategory(v string) *CreateCustomActionTypeInput {
 	s.Category = &v
@@ -4040,6 +4377,23 @@ func (s *CreatePipelineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePipelineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePipelineInput"}
+	if s.Pipeline != nil {
+		if err := s.Pipeline.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Pipeline", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPipeline sets the Pipeline field's value.
 func (s *CreatePipelineInput) SetPipeline(v *PipelineDeclaration) *CreatePipelineInput {
 	s.Pipeline = v
@@ -4129,6 +4483,27 @@ func (s *CurrentRevision) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CurrentRevision) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CurrentRevision"}
+	if s.ChangeIdentifier != nil && len(*s.ChangeIdentifier) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ChangeIdentifier", 100))
+	}
+	if s.Revision != nil && len(*s.Revision) > 1500 {
+		invalidParams.Add(request.NewErrParamMaxLen("Revision", 1500))
+	}
+	if s.RevisionSummary != nil && len(*s.RevisionSummary) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RevisionSummary", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeIdentifier sets the ChangeIdentifier field's value.
 func (s *CurrentRevision) SetChangeIdentifier(v string) *CurrentRevision {
 	s.ChangeIdentifier = &v
@@ -4211,6 +4586,36 @@ func (s *DeleteCustomActionTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteCustomActionTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteCustomActionTypeInput"}
+	if s.Category != nil {
+		values := []string{"Source", "Build", "Deploy", "Test", "Invoke", "Approval"}
+		if !request.IsParamEnumValue(*s.Category, values) {
+			invalidParams.Add(request.NewErrParamEnum("Category", *s.Category, values))
+		}
+	}
+	if s.Provider != nil && len(*s.Provider) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("Provider", 25))
+	}
+	if s.Provider != nil && !request.MatchParamPattern("[0-9A-Za-z_-]+", *s.Provider) {
+		invalidParams.Add(request.NewErrParamPattern("Provider", "[0-9A-Za-z_-]+"))
+	}
+	if s.Version != nil && len(*s.Version) > 9 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 9))
+	}
+	if s.Version != nil && !request.MatchParamPattern("[0-9A-Za-z_-]+", *s.Version) {
+		invalidParams.Add(request.NewErrParamPattern("Version", "[0-9A-Za-z_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCategory sets the Category field's value.
 func (s *DeleteCustomActionTypeInput) SetCategory(v string) *DeleteCustomActionTypeInput {
 	s.Category = &v
@@ -4281,6 +4686,24 @@ func (s *DeletePipelineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeletePipelineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePipelineInput"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[A-Za-z0-9.@\\-_]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeletePipelineInput) SetName(v string) *DeletePipelineInput {
 	s.Name = &v
@@ -4376,11 +4799,47 @@ func (s *DisableStageTransitionInput) Validate() error {
 	return nil
 }
 
-// SetPipelineName sets the PipelineName field's value.
-func (s *DisableStageTransitionInput) SetPipelineName(v string) *DisableStageTransitionInput {
-	s.PipelineName = &v
-	return s
-}
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisableStageTransitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableStageTransitionInput"}
+	if s.PipelineName != nil && len(*s.PipelineName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineName", 100))
+	}
+	if s.PipelineName != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.PipelineName) {
+		invalidParams.Add(request.NewErrParamPattern("PipelineName", "[A-Za-z0-9.@\\-_]+"))
+	}
+	if s.Reason != nil && len(*s.Reason) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("Reason", 300))
+	}
+	if s.Reason != nil && !request.MatchParamPattern("[a-zA-Z0-9!@ \\(\\)\\.\\*\\?\\-]+", *s.Reason) {
+		invalidParams.Add(request.NewErrParamPattern("Reason", "[a-zA-Z0-9!@ \\(\\)\\.\\*\\?\\-]+"))
+	}
+	if s.StageName != nil && len(*s.StageName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("StageName", 100))
+	}
+	if s.StageName != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.StageName) {
+		invalidParams.Add(request.NewErrParamPattern("StageName", "[A-Za-z0-9.@\\-_]+"))
+	}
+	if s.TransitionType != nil {
+		values := []string{"Inbound", "Outbound"}
+		if !request.IsParamEnumValue(*s.TransitionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("TransitionType", *s.TransitionType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPipelineName sets the PipelineName field's value.
+func (s *DisableStageTransitionInput) SetPipelineName(v string) *DisableStageTransitionInput {
+	s.PipelineName = &v
+	return s
+}
 
 // SetReason sets the Reason field's value.
 func (s *DisableStageTransitionInput) SetReason(v string) *DisableStageTransitionInput {
@@ -4475,6 +4934,36 @@ func (s *EnableStageTransitionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnableStageTransitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableStageTransitionInput"}
+	if s.PipelineName != nil && len(*s.PipelineName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineName", 100))
+	}
+	if s.PipelineName != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.PipelineName) {
+		invalidParams.Add(request.NewErrParamPattern("PipelineName", "[A-Za-z0-9.@\\-_]+"))
+	}
+	if s.StageName != nil && len(*s.StageName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("StageName", 100))
+	}
+	if s.StageName != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.StageName) {
+		invalidParams.Add(request.NewErrParamPattern("StageName", "[A-Za-z0-9.@\\-_]+"))
+	}
+	if s.TransitionType != nil {
+		values := []string{"Inbound", "Outbound"}
+		if !request.IsParamEnumValue(*s.TransitionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("TransitionType", *s.TransitionType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPipelineName sets the PipelineName field's value.
 func (s *EnableStageTransitionInput) SetPipelineName(v string) *EnableStageTransitionInput {
 	s.PipelineName = &v
@@ -4556,6 +5045,27 @@ func (s *EncryptionKey) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EncryptionKey) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EncryptionKey"}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+	if s.Type != nil {
+		values := []string{"KMS"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *EncryptionKey) SetId(v string) *EncryptionKey {
 	s.Id = &v
@@ -4643,6 +5153,24 @@ func (s *ExecutionDetails) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ExecutionDetails) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ExecutionDetails"}
+	if s.ExternalExecutionId != nil && len(*s.ExternalExecutionId) > 1500 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExternalExecutionId", 1500))
+	}
+	if s.PercentComplete != nil && *s.PercentComplete > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("PercentComplete", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExternalExecutionId sets the ExternalExecutionId field's value.
 func (s *ExecutionDetails) SetExternalExecutionId(v string) *ExecutionDetails {
 	s.ExternalExecutionId = &v
@@ -4709,6 +5237,27 @@ func (s *FailureDetails) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *FailureDetails) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "FailureDetails"}
+	if s.ExternalExecutionId != nil && len(*s.ExternalExecutionId) > 1500 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExternalExecutionId", 1500))
+	}
+	if s.Type != nil {
+		values := []string{"JobFailed", "ConfigurationError", "PermissionError", "RevisionOutOfSync", "RevisionUnavailable", "SystemUnavailable"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExternalExecutionId sets the ExternalExecutionId field's value.
 func (s *FailureDetails) SetExternalExecutionId(v string) *FailureDetails {
 	s.ExternalExecutionId = &v
@@ -4761,6 +5310,21 @@ func (s *GetJobDetailsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetJobDetailsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetJobDetailsInput"}
+	if s.JobId != nil && !request.MatchParamPattern("[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.JobId) {
+		invalidParams.Add(request.NewErrParamPattern("JobId", "[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobId sets the JobId field's value.
 func (s *GetJobDetailsInput) SetJobId(v string) *GetJobDetailsInput {
 	s.JobId = &v
@@ -4840,6 +5404,27 @@ func (s *GetPipelineExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetPipelineExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPipelineExecutionInput"}
+	if s.PipelineExecutionId != nil && !request.MatchParamPattern("[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.PipelineExecutionId) {
+		invalidParams.Add(request.NewErrParamPattern("PipelineExecutionId", "[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.PipelineName != nil && len(*s.PipelineName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineName", 100))
+	}
+	if s.PipelineName != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.PipelineName) {
+		invalidParams.Add(request.NewErrParamPattern("PipelineName", "[A-Za-z0-9.@\\-_]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPipelineExecutionId sets the PipelineExecutionId field's value.
 func (s *GetPipelineExecutionInput) SetPipelineExecutionId(v string) *GetPipelineExecutionInput {
 	s.PipelineExecutionId = &v
@@ -4922,6 +5507,24 @@ func (s *GetPipelineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetPipelineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPipelineInput"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[A-Za-z0-9.@\\-_]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *GetPipelineInput) SetName(v string) *GetPipelineInput {
 	s.Name = &v
@@ -5006,6 +5609,24 @@ func (s *GetPipelineStateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetPipelineStateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPipelineStateInput"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[A-Za-z0-9.@\\-_]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *GetPipelineStateInput) SetName(v string) *GetPipelineStateInput {
 	s.Name = &v
@@ -5125,6 +5746,24 @@ func (s *GetThirdPartyJobDetailsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetThirdPartyJobDetailsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetThirdPartyJobDetailsInput"}
+	if s.ClientToken != nil && len(*s.ClientToken) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientToken", 256))
+	}
+	if s.JobId != nil && len(*s.JobId) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobId", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientToken sets the ClientToken field's value.
 func (s *GetThirdPartyJobDetailsInput) SetClientToken(v string) *GetThirdPartyJobDetailsInput {
 	s.ClientToken = &v
@@ -5206,6 +5845,24 @@ func (s *InputArtifact) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InputArtifact) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputArtifact"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[a-zA-Z0-9_\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *InputArtifact) SetName(v string) *InputArtifact {
 	s.Name = &v
@@ -5282,7 +5939,7 @@ type JobData struct {
 	// credentials that are issued by AWS Secure Token Service (STS). They can be
 	// used to access input and output artifacts in the Amazon S3 bucket used to
 	// store artifact for the pipeline in AWS CodePipeline.
-	ArtifactCredentials *AWSSessionCredentials `locationName:"artifactCredentials" type:"structure"`
+	ArtifactCredentials *AWSSessionCredentials `locationName:"artifactCredentials" type:"structure" sensitive:"true"`
 
 	// A system-generated token, such as a AWS CodeDeploy deployment ID, that a
 	// job requires in order to continue the job asynchronously.
@@ -5440,6 +6097,27 @@ func (s *ListActionTypesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListActionTypesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListActionTypesInput"}
+	if s.ActionOwnerFilter != nil {
+		values := []string{"AWS", "ThirdParty", "Custom"}
+		if !request.IsParamEnumValue(*s.ActionOwnerFilter, values) {
+			invalidParams.Add(request.NewErrParamEnum("ActionOwnerFilter", *s.ActionOwnerFilter, values))
+		}
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActionOwnerFilter sets the ActionOwnerFilter field's value.
 func (s *ListActionTypesInput) SetActionOwnerFilter(v string) *ListActionTypesInput {
 	s.ActionOwnerFilter = &v
@@ -5543,6 +6221,30 @@ func (s *ListPipelineExecutionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPipelineExecutionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPipelineExecutionsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 2048))
+	}
+	if s.PipelineName != nil && len(*s.PipelineName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineName", 100))
+	}
+	if s.PipelineName != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.PipelineName) {
+		invalidParams.Add(request.NewErrParamPattern("PipelineName", "[A-Za-z0-9.@\\-_]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListPipelineExecutionsInput) SetMaxResults(v int64) *ListPipelineExecutionsInput {
 	s.MaxResults = &v
@@ -5630,6 +6332,21 @@ func (s *ListPipelinesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPipelinesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPipelinesInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListPipelinesInput) SetNextToken(v string) *ListPipelinesInput {
 	s.NextToken = &v
@@ -5717,6 +6434,24 @@ func (s *OutputArtifact) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *OutputArtifact) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "OutputArtifact"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[a-zA-Z0-9_\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *OutputArtifact) SetName(v string) *OutputArtifact {
 	s.Name = &v
@@ -5853,6 +6588,45 @@ func (s *PipelineDeclaration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PipelineDeclaration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PipelineDeclaration"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[A-Za-z0-9.@\\-_]+"))
+	}
+	if s.RoleArn != nil && len(*s.RoleArn) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleArn", 1024))
+	}
+	if s.RoleArn != nil && !request.MatchParamPattern("arn:aws(-[\\w]+)*:iam::[0-9]{12}:role/.*", *s.RoleArn) {
+		invalidParams.Add(request.NewErrParamPattern("RoleArn", "arn:aws(-[\\w]+)*:iam::[0-9]{12}:role/.*"))
+	}
+	if s.ArtifactStore != nil {
+		if err := s.ArtifactStore.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ArtifactStore", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Stages != nil {
+		for i, v := range s.Stages {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Stages", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArtifactStore sets the ArtifactStore field's value.
 func (s *PipelineDeclaration) SetArtifactStore(v *ArtifactStore) *PipelineDeclaration {
 	s.ArtifactStore = v
@@ -6163,6 +6937,26 @@ func (s *PollForJobsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PollForJobsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PollForJobsInput"}
+	if s.QueryParam != nil && len(s.QueryParam) > 1 {
+		invalidParams.Add(request.NewErrParamMaxLen("QueryParam", 1))
+	}
+	if s.ActionTypeId != nil {
+		if err := s.ActionTypeId.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ActionTypeId", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActionTypeId sets the ActionTypeId field's value.
 func (s *PollForJobsInput) SetActionTypeId(v *ActionTypeId) *PollForJobsInput {
 	s.ActionTypeId = v
@@ -6251,6 +7045,23 @@ func (s *PollForThirdPartyJobsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PollForThirdPartyJobsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PollForThirdPartyJobsInput"}
+	if s.ActionTypeId != nil {
+		if err := s.ActionTypeId.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ActionTypeId", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActionTypeId sets the ActionTypeId field's value.
 func (s *PollForThirdPartyJobsInput) SetActionTypeId(v *ActionTypeId) *PollForThirdPartyJobsInput {
 	s.ActionTypeId = v
@@ -6360,6 +7171,41 @@ func (s *PutActionRevisionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutActionRevisionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutActionRevisionInput"}
+	if s.ActionName != nil && len(*s.ActionName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ActionName", 100))
+	}
+	if s.ActionName != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.ActionName) {
+		invalidParams.Add(request.NewErrParamPattern("ActionName", "[A-Za-z0-9.@\\-_]+"))
+	}
+	if s.PipelineName != nil && len(*s.PipelineName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineName", 100))
+	}
+	if s.PipelineName != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.PipelineName) {
+		invalidParams.Add(request.NewErrParamPattern("PipelineName", "[A-Za-z0-9.@\\-_]+"))
+	}
+	if s.StageName != nil && len(*s.StageName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("StageName", 100))
+	}
+	if s.StageName != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.StageName) {
+		invalidParams.Add(request.NewErrParamPattern("StageName", "[A-Za-z0-9.@\\-_]+"))
+	}
+	if s.ActionRevision != nil {
+		if err := s.ActionRevision.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ActionRevision", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActionName sets the ActionName field's value.
 func (s *PutActionRevisionInput) SetActionName(v string) *PutActionRevisionInput {
 	s.ActionName = &v
@@ -6502,6 +7348,44 @@ func (s *PutApprovalResultInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutApprovalResultInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutApprovalResultInput"}
+	if s.ActionName != nil && len(*s.ActionName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ActionName", 100))
+	}
+	if s.ActionName != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.ActionName) {
+		invalidParams.Add(request.NewErrParamPattern("ActionName", "[A-Za-z0-9.@\\-_]+"))
+	}
+	if s.PipelineName != nil && len(*s.PipelineName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineName", 100))
+	}
+	if s.PipelineName != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.PipelineName) {
+		invalidParams.Add(request.NewErrParamPattern("PipelineName", "[A-Za-z0-9.@\\-_]+"))
+	}
+	if s.StageName != nil && len(*s.StageName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("StageName", 100))
+	}
+	if s.StageName != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.StageName) {
+		invalidParams.Add(request.NewErrParamPattern("StageName", "[A-Za-z0-9.@\\-_]+"))
+	}
+	if s.Token != nil && !request.MatchParamPattern("[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.Token) {
+		invalidParams.Add(request.NewErrParamPattern("Token", "[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.Result != nil {
+		if err := s.Result.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Result", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActionName sets the ActionName field's value.
 func (s *PutApprovalResultInput) SetActionName(v string) *PutApprovalResultInput {
 	s.ActionName = &v
@@ -6605,6 +7489,26 @@ func (s *PutJobFailureResultInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutJobFailureResultInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutJobFailureResultInput"}
+	if s.JobId != nil && !request.MatchParamPattern("[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.JobId) {
+		invalidParams.Add(request.NewErrParamPattern("JobId", "[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.FailureDetails != nil {
+		if err := s.FailureDetails.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FailureDetails", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFailureDetails sets the FailureDetails field's value.
 func (s *PutJobFailureResultInput) SetFailureDetails(v *FailureDetails) *PutJobFailureResultInput {
 	s.FailureDetails = v
@@ -6693,6 +7597,31 @@ func (s *PutJobSuccessResultInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutJobSuccessResultInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutJobSuccessResultInput"}
+	if s.JobId != nil && !request.MatchParamPattern("[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.JobId) {
+		invalidParams.Add(request.NewErrParamPattern("JobId", "[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.CurrentRevision != nil {
+		if err := s.CurrentRevision.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CurrentRevision", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ExecutionDetails != nil {
+		if err := s.ExecutionDetails.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ExecutionDetails", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContinuationToken sets the ContinuationToken field's value.
 func (s *PutJobSuccessResultInput) SetContinuationToken(v string) *PutJobSuccessResultInput {
 	s.ContinuationToken = &v
@@ -6794,6 +7723,29 @@ func (s *PutThirdPartyJobFailureResultInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutThirdPartyJobFailureResultInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutThirdPartyJobFailureResultInput"}
+	if s.ClientToken != nil && len(*s.ClientToken) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientToken", 256))
+	}
+	if s.JobId != nil && len(*s.JobId) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobId", 512))
+	}
+	if s.FailureDetails != nil {
+		if err := s.FailureDetails.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FailureDetails", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientToken sets the ClientToken field's value.
 func (s *PutThirdPartyJobFailureResultInput) SetClientToken(v string) *PutThirdPartyJobFailureResultInput {
 	s.ClientToken = &v
@@ -6902,6 +7854,34 @@ func (s *PutThirdPartyJobSuccessResultInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutThirdPartyJobSuccessResultInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutThirdPartyJobSuccessResultInput"}
+	if s.ClientToken != nil && len(*s.ClientToken) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientToken", 256))
+	}
+	if s.JobId != nil && len(*s.JobId) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobId", 512))
+	}
+	if s.CurrentRevision != nil {
+		if err := s.CurrentRevision.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CurrentRevision", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ExecutionDetails != nil {
+		if err := s.ExecutionDetails.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ExecutionDetails", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientToken sets the ClientToken field's value.
 func (s *PutThirdPartyJobSuccessResultInput) SetClientToken(v string) *PutThirdPartyJobSuccessResultInput {
 	s.ClientToken = &v
@@ -7013,6 +7993,39 @@ func (s *RetryStageExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RetryStageExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RetryStageExecutionInput"}
+	if s.PipelineExecutionId != nil && !request.MatchParamPattern("[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.PipelineExecutionId) {
+		invalidParams.Add(request.NewErrParamPattern("PipelineExecutionId", "[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.PipelineName != nil && len(*s.PipelineName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineName", 100))
+	}
+	if s.PipelineName != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.PipelineName) {
+		invalidParams.Add(request.NewErrParamPattern("PipelineName", "[A-Za-z0-9.@\\-_]+"))
+	}
+	if s.RetryMode != nil {
+		values := []string{"FAILED_ACTIONS"}
+		if !request.IsParamEnumValue(*s.RetryMode, values) {
+			invalidParams.Add(request.NewErrParamEnum("RetryMode", *s.RetryMode, values))
+		}
+	}
+	if s.StageName != nil && len(*s.StageName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("StageName", 100))
+	}
+	if s.StageName != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.StageName) {
+		invalidParams.Add(request.NewErrParamPattern("StageName", "[A-Za-z0-9.@\\-_]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPipelineExecutionId sets the PipelineExecutionId field's value.
 func (s *RetryStageExecutionInput) SetPipelineExecutionId(v string) *RetryStageExecutionInput {
 	s.PipelineExecutionId = &v
@@ -7194,6 +8207,44 @@ func (s *StageDeclaration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StageDeclaration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StageDeclaration"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[A-Za-z0-9.@\\-_]+"))
+	}
+	if s.Actions != nil {
+		for i, v := range s.Actions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Actions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Blockers != nil {
+		for i, v := range s.Blockers {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Blockers", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActions sets the Actions field's value.
 func (s *StageDeclaration) SetActions(v []*ActionDeclaration) *StageDeclaration {
 	s.Actions = v
@@ -7341,6 +8392,24 @@ func (s *StartPipelineExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartPipelineExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartPipelineExecutionInput"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[A-Za-z0-9.@\\-_]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[A-Za-z0-9.@\\-_]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *StartPipelineExecutionInput) SetName(v string) *StartPipelineExecutionInput {
 	s.Name = &v
@@ -7423,7 +8492,7 @@ type ThirdPartyJobData struct {
 	// credentials that are issued by AWS Secure Token Service (STS). They can be
 	// used to access input and output artifacts in the Amazon S3 bucket used to
 	// store artifact for the pipeline in AWS CodePipeline.
-	ArtifactCredentials *AWSSessionCredentials `locationName:"artifactCredentials" type:"structure"`
+	ArtifactCredentials *AWSSessionCredentials `locationName:"artifactCredentials" type:"structure" sensitive:"true"`
 
 	// A system-generated token, such as a AWS CodeDeploy deployment ID, that a
 	// job requires in order to continue the job asynchronously.
@@ -7646,6 +8715,23 @@ func (s *UpdatePipelineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdatePipelineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdatePipelineInput"}
+	if s.Pipeline != nil {
+		if err := s.Pipeline.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Pipeline", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPipeline sets the Pipeline field's value.
 func (s *UpdatePipelineInput) SetPipeline(v *PipelineDeclaration) *UpdatePipelineInput {
 	s.Pipeline = v
@@ -238,9 +238,10 @@ const opCreateUserProfile = "CreateUserProfile"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/codestar-2017-04-19/CreateUserProfile
 func (c *CodeStar) CreateUserProfileRequest(input *CreateUserProfileInput) (req *request.Request, output *CreateUserProfileOutput) {
 	op := &request.Operation{
-		Name:       opCreateUserProfile,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opCreateUserProfile,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"emailAddress"},
 	}
 
 	if input == nil {
@@ -496,9 +497,10 @@ const opDescribeProject = "DescribeProject"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/codestar-2017-04-19/DescribeProject
 func (c *CodeStar) DescribeProjectRequest(input *DescribeProjectInput) (req *request.Request, output *DescribeProjectOutput) {
 	op := &request.Operation{
-		Name:       opDescribeProject,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeProject,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"description", "name"},
 	}
 
 	if input == nil {
@@ -588,9 +590,10 @@ const opDescribeUserProfile = "DescribeUserProfile"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/codestar-2017-04-19/DescribeUserProfile
 func (c *CodeStar) DescribeUserProfileRequest(input *DescribeUserProfileInput) (req *request.Request, output *DescribeUserProfileOutput) {
 	op := &request.Operation{
-		Name:       opDescribeUserProfile,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeUserProfile,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"emailAddress"},
 	}
 
 	if input == nil {
@@ -1099,9 +1102,10 @@ const opListUserProfiles = "ListUserProfiles"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/codestar-2017-04-19/ListUserProfiles
 func (c *CodeStar) ListUserProfilesRequest(input *ListUserProfilesInput) (req *request.Request, output *ListUserProfilesOutput) {
 	op := &request.Operation{
-		Name:       opListUserProfiles,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opListUserProfiles,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"userProfiles[*].emailAddress"},
 	}
 
 	if input == nil {
@@ -1541,9 +1545,10 @@ const opUpdateUserProfile = "UpdateUserProfile"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/codestar-2017-04-19/UpdateUserProfile
 func (c *CodeStar) UpdateUserProfileRequest(input *UpdateUserProfileInput) (req *request.Request, output *UpdateUserProfileOutput) {
 	op := &request.Operation{
-		Name:       opUpdateUserProfile,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opUpdateUserProfile,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"emailAddress"},
 	}
 
 	if input == nil {
@@ -1666,6 +1671,36 @@ func (s *AssociateTeamMemberInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssociateTeamMemberInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociateTeamMemberInput"}
+	if s.ClientRequestToken != nil && len(*s.ClientRequestToken) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientRequestToken", 256))
+	}
+	if s.ClientRequestToken != nil && !request.MatchParamPattern("^[\\w:/-]+$", *s.ClientRequestToken) {
+		invalidParams.Add(request.NewErrParamPattern("ClientRequestToken", "^[\\w:/-]+$"))
+	}
+	if s.ProjectId != nil && len(*s.ProjectId) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProjectId", 15))
+	}
+	if s.ProjectId != nil && !request.MatchParamPattern("^[a-z][a-z0-9-]+$", *s.ProjectId) {
+		invalidParams.Add(request.NewErrParamPattern("ProjectId", "^[a-z][a-z0-9-]+$"))
+	}
+	if s.ProjectRole != nil && !request.MatchParamPattern("^(Owner|Viewer|Contributor)$", *s.ProjectRole) {
+		invalidParams.Add(request.NewErrParamPattern("ProjectRole", "^(Owner|Viewer|Contributor)$"))
+	}
+	if s.UserArn != nil && len(*s.UserArn) > 95 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserArn", 95))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientRequestToken sets the ClientRequestToken field's value.
 func (s *AssociateTeamMemberInput) SetClientRequestToken(v string) *AssociateTeamMemberInput {
 	s.ClientRequestToken = &v
@@ -1729,7 +1764,7 @@ type CreateProjectInput struct {
 	ClientRequestToken *string `locationName:"clientRequestToken" min:"1" type:"string"`
 
 	// Reserved for future use.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" sensitive:"true"`
 
 	// Reserved for future use.
 	//
@@ -1739,7 +1774,7 @@ type CreateProjectInput struct {
 	// Reserved for future use.
 	//
 	// Name is a required field
-	Name *string `locationName:"name" min:"1" type:"string" required:"true"`
+	Name *string `locationName:"name" min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -1777,6 +1812,42 @@ func (s *CreateProjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateProjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateProjectInput"}
+	if s.ClientRequestToken != nil && len(*s.ClientRequestToken) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientRequestToken", 256))
+	}
+	if s.ClientRequestToken != nil && !request.MatchParamPattern("^[\\w:/-]+$", *s.ClientRequestToken) {
+		invalidParams.Add(request.NewErrParamPattern("ClientRequestToken", "^[\\w:/-]+$"))
+	}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.Description != nil && !request.MatchParamPattern("^$|^\\S(.*\\S)?$", *s.Description) {
+		invalidParams.Add(request.NewErrParamPattern("Description", "^$|^\\S(.*\\S)?$"))
+	}
+	if s.Id != nil && len(*s.Id) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 15))
+	}
+	if s.Id != nil && !request.MatchParamPattern("^[a-z][a-z0-9-]+$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^[a-z][a-z0-9-]+$"))
+	}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^\\S(.*\\S)?$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^\\S(.*\\S)?$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientRequestToken sets the ClientRequestToken field's value.
 func (s *CreateProjectInput) SetClientRequestToken(v string) *CreateProjectInput {
 	s.ClientRequestToken = &v
@@ -1870,7 +1941,7 @@ type CreateUserProfileInput struct {
 	// AWS CodeStar.
 	//
 	// EmailAddress is a required field
-	EmailAddress *string `locationName:"emailAddress" min:"3" type:"string" required:"true"`
+	EmailAddress *string `locationName:"emailAddress" min:"3" type:"string" required:"true" sensitive:"true"`
 
 	// The SSH public key associated with the user in AWS CodeStar. If a project
 	// owner allows the user remote access to project resources, this public key
@@ -1921,6 +1992,36 @@ func (s *CreateUserProfileInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateUserProfileInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateUserProfileInput"}
+	if s.DisplayName != nil && len(*s.DisplayName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DisplayName", 64))
+	}
+	if s.DisplayName != nil && !request.MatchParamPattern("^\\S(.*\\S)?$", *s.DisplayName) {
+		invalidParams.Add(request.NewErrParamPattern("DisplayName", "^\\S(.*\\S)?$"))
+	}
+	if s.EmailAddress != nil && len(*s.EmailAddress) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("EmailAddress", 128))
+	}
+	if s.EmailAddress != nil && !request.MatchParamPattern("^[\\w-.+]+@[\\w-.+]+$", *s.EmailAddress) {
+		invalidParams.Add(request.NewErrParamPattern("EmailAddress", "^[\\w-.+]+@[\\w-.+]+$"))
+	}
+	if s.SshPublicKey != nil && len(*s.SshPublicKey) > 16384 {
+		invalidParams.Add(request.NewErrParamMaxLen("SshPublicKey", 16384))
+	}
+	if s.UserArn != nil && len(*s.UserArn) > 95 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserArn", 95))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDisplayName sets the DisplayName field's value.
 func (s *CreateUserProfileInput) SetDisplayName(v string) *CreateUserProfileInput {
 	s.DisplayName = &v
@@ -1957,7 +2058,7 @@ type CreateUserProfileOutput struct {
 
 	// The email address that is displayed as part of the user's profile in AWS
 	// CodeStar.
-	EmailAddress *string `locationName:"emailAddress" min:"3" type:"string"`
+	EmailAddress *string `locationName:"emailAddress" min:"3" type:"string" sensitive:"true"`
 
 	// The date the user profile was last modified, in timestamp format.
 	LastModifiedTimestamp *time.Time `locationName:"lastModifiedTimestamp" type:"timestamp" timestampFormat:"unix"`
@@ -2068,6 +2169,30 @@ func (s *DeleteProjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteProjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteProjectInput"}
+	if s.ClientRequestToken != nil && len(*s.ClientRequestToken) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientRequestToken", 256))
+	}
+	if s.ClientRequestToken != nil && !request.MatchParamPattern("^[\\w:/-]+$", *s.ClientRequestToken) {
+		invalidParams.Add(request.NewErrParamPattern("ClientRequestToken", "^[\\w:/-]+$"))
+	}
+	if s.Id != nil && len(*s.Id) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 15))
+	}
+	if s.Id != nil && !request.MatchParamPattern("^[a-z][a-z0-9-]+$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^[a-z][a-z0-9-]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientRequestToken sets the ClientRequestToken field's value.
 func (s *DeleteProjectInput) SetClientRequestToken(v string) *DeleteProjectInput {
 	s.ClientRequestToken = &v
@@ -2156,6 +2281,21 @@ func (s *DeleteUserProfileInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteUserProfileInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteUserProfileInput"}
+	if s.UserArn != nil && len(*s.UserArn) > 95 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserArn", 95))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserArn sets the UserArn field's value.
 func (s *DeleteUserProfileInput) SetUserArn(v string) *DeleteUserProfileInput {
 	s.UserArn = &v
@@ -2224,6 +2364,24 @@ func (s *DescribeProjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeProjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeProjectInput"}
+	if s.Id != nil && len(*s.Id) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 15))
+	}
+	if s.Id != nil && !request.MatchParamPattern("^[a-z][a-z0-9-]+$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^[a-z][a-z0-9-]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *DescribeProjectInput) SetId(v string) *DescribeProjectInput {
 	s.Id = &v
@@ -2245,13 +2403,13 @@ type DescribeProjectOutput struct {
 	CreatedTimeStamp *time.Time `locationName:"createdTimeStamp" type:"timestamp" timestampFormat:"unix"`
 
 	// The description of the project, if any.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" sensitive:"true"`
 
 	// The ID of the project.
 	Id *string `locationName:"id" min:"2" type:"string"`
 
 	// The display name for the project.
-	Name *string `locationName:"name" min:"1" type:"string"`
+	Name *string `locationName:"name" min:"1" type:"string" sensitive:"true"`
 
 	// The ID for the AWS CodeStar project template used to create the project.
 	ProjectTemplateId *string `locationName:"projectTemplateId" min:"1" type:"string"`
@@ -2355,6 +2513,21 @@ func (s *DescribeUserProfileInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeUserProfileInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeUserProfileInput"}
+	if s.UserArn != nil && len(*s.UserArn) > 95 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserArn", 95))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserArn sets the UserArn field's value.
 func (s *DescribeUserProfileInput) SetUserArn(v string) *DescribeUserProfileInput {
 	s.UserArn = &v
@@ -2383,7 +2556,7 @@ type DescribeUserProfileOutput struct {
 	DisplayName *string `locationName:"displayName" min:"1" type:"string"`
 
 	// The email address for the user. Optional.
-	EmailAddress *string `locationName:"emailAddress" min:"3" type:"string"`
+	EmailAddress *string `locationName:"emailAddress" min:"3" type:"string" sensitive:"true"`
 
 	// The date and time when the user profile was last modified, in timestamp format.
 	//
@@ -2496,6 +2669,27 @@ func (s *DisassociateTeamMemberInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisassociateTeamMemberInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisassociateTeamMemberInput"}
+	if s.ProjectId != nil && len(*s.ProjectId) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProjectId", 15))
+	}
+	if s.ProjectId != nil && !request.MatchParamPattern("^[a-z][a-z0-9-]+$", *s.ProjectId) {
+		invalidParams.Add(request.NewErrParamPattern("ProjectId", "^[a-z][a-z0-9-]+$"))
+	}
+	if s.UserArn != nil && len(*s.UserArn) > 95 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserArn", 95))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetProjectId sets the ProjectId field's value.
 func (s *DisassociateTeamMemberInput) SetProjectId(v string) *DisassociateTeamMemberInput {
 	s.ProjectId = &v
@@ -2561,6 +2755,27 @@ func (s *ListProjectsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListProjectsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListProjectsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 512))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("^[\\w/+=]+$", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "^[\\w/+=]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListProjectsInput) SetMaxResults(v int64) *ListProjectsInput {
 	s.MaxResults = &v
@@ -2658,6 +2873,33 @@ func (s *ListResourcesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListResourcesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListResourcesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 512))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("^[\\w/+=]+$", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "^[\\w/+=]+$"))
+	}
+	if s.ProjectId != nil && len(*s.ProjectId) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProjectId", 15))
+	}
+	if s.ProjectId != nil && !request.MatchParamPattern("^[a-z][a-z0-9-]+$", *s.ProjectId) {
+		invalidParams.Add(request.NewErrParamPattern("ProjectId", "^[a-z][a-z0-9-]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListResourcesInput) SetMaxResults(v int64) *ListResourcesInput {
 	s.MaxResults = &v
@@ -2758,6 +3000,33 @@ func (s *ListTagsForProjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsForProjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForProjectInput"}
+	if s.Id != nil && len(*s.Id) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 15))
+	}
+	if s.Id != nil && !request.MatchParamPattern("^[a-z][a-z0-9-]+$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^[a-z][a-z0-9-]+$"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 512))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("^[\\w/+=]+$", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "^[\\w/+=]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *ListTagsForProjectInput) SetId(v string) *ListTagsForProjectInput {
 	s.Id = &v
@@ -2858,6 +3127,33 @@ func (s *ListTeamMembersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTeamMembersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTeamMembersInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 512))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("^[\\w/+=]+$", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "^[\\w/+=]+$"))
+	}
+	if s.ProjectId != nil && len(*s.ProjectId) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProjectId", 15))
+	}
+	if s.ProjectId != nil && !request.MatchParamPattern("^[a-z][a-z0-9-]+$", *s.ProjectId) {
+		invalidParams.Add(request.NewErrParamPattern("ProjectId", "^[a-z][a-z0-9-]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListTeamMembersInput) SetMaxResults(v int64) *ListTeamMembersInput {
 	s.MaxResults = &v
@@ -2950,6 +3246,27 @@ func (s *ListUserProfilesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListUserProfilesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListUserProfilesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 512))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("^[\\w/+=]+$", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "^[\\w/+=]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListUserProfilesInput) SetMaxResults(v int64) *ListUserProfilesInput {
 	s.MaxResults = &v
@@ -3103,6 +3420,24 @@ func (s *TagProjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TagProjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagProjectInput"}
+	if s.Id != nil && len(*s.Id) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 15))
+	}
+	if s.Id != nil && !request.MatchParamPattern("^[a-z][a-z0-9-]+$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^[a-z][a-z0-9-]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *TagProjectInput) SetId(v string) *TagProjectInput {
 	s.Id = &v
@@ -3233,6 +3568,24 @@ func (s *UntagProjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UntagProjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UntagProjectInput"}
+	if s.Id != nil && len(*s.Id) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 15))
+	}
+	if s.Id != nil && !request.MatchParamPattern("^[a-z][a-z0-9-]+$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^[a-z][a-z0-9-]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *UntagProjectInput) SetId(v string) *UntagProjectInput {
 	s.Id = &v
@@ -3265,7 +3618,7 @@ type UpdateProjectInput struct {
 	_ struct{} `type:"structure"`
 
 	// The description of the project, if any.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" sensitive:"true"`
 
 	// The ID of the project you want to update.
 	//
@@ -3273,7 +3626,7 @@ type UpdateProjectInput struct {
 	Id *string `locationName:"id" min:"2" type:"string" required:"true"`
 
 	// The name of the project you want to update.
-	Name *string `locationName:"name" min:"1" type:"string"`
+	Name *string `locationName:"name" min:"1" type:"string" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -3305,6 +3658,36 @@ func (s *UpdateProjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateProjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateProjectInput"}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.Description != nil && !request.MatchParamPattern("^$|^\\S(.*\\S)?$", *s.Description) {
+		invalidParams.Add(request.NewErrParamPattern("Description", "^$|^\\S(.*\\S)?$"))
+	}
+	if s.Id != nil && len(*s.Id) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 15))
+	}
+	if s.Id != nil && !request.MatchParamPattern("^[a-z][a-z0-9-]+$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^[a-z][a-z0-9-]+$"))
+	}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^\\S(.*\\S)?$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^\\S(.*\\S)?$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *UpdateProjectInput) SetDescription(v string) *UpdateProjectInput {
 	s.Description = &v
@@ -3397,6 +3780,30 @@ func (s *UpdateTeamMemberInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateTeamMemberInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateTeamMemberInput"}
+	if s.ProjectId != nil && len(*s.ProjectId) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProjectId", 15))
+	}
+	if s.ProjectId != nil && !request.MatchParamPattern("^[a-z][a-z0-9-]+$", *s.ProjectId) {
+		invalidParams.Add(request.NewErrParamPattern("ProjectId", "^[a-z][a-z0-9-]+$"))
+	}
+	if s.ProjectRole != nil && !request.MatchParamPattern("^(Owner|Viewer|Contributor)$", *s.ProjectRole) {
+		invalidParams.Add(request.NewErrParamPattern("ProjectRole", "^(Owner|Viewer|Contributor)$"))
+	}
+	if s.UserArn != nil && len(*s.UserArn) > 95 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserArn", 95))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetProjectId sets the ProjectId field's value.
 func (s *UpdateTeamMemberInput) SetProjectId(v string) *UpdateTeamMemberInput {
 	s.ProjectId = &v
@@ -3474,7 +3881,7 @@ type UpdateUserProfileInput struct {
 
 	// The email address that is displayed as part of the user's profile in AWS
 	// CodeStar.
-	EmailAddress *string `locationName:"emailAddress" min:"3" type:"string"`
+	EmailAddress *string `locationName:"emailAddress" min:"3" type:"string" sensitive:"true"`
 
 	// The SSH public key associated with the user in AWS CodeStar. If a project
 	// owner allows the user remote access to project resources, this public key
@@ -3520,6 +3927,36 @@ func (s *UpdateUserProfileInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateUserProfileInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateUserProfileInput"}
+	if s.DisplayName != nil && len(*s.DisplayName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DisplayName", 64))
+	}
+	if s.DisplayName != nil && !request.MatchParamPattern("^\\S(.*\\S)?$", *s.DisplayName) {
+		invalidParams.Add(request.NewErrParamPattern("DisplayName", "^\\S(.*\\S)?$"))
+	}
+	if s.EmailAddress != nil && len(*s.EmailAddress) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("EmailAddress", 128))
+	}
+	if s.EmailAddress != nil && !request.MatchParamPattern("^[\\w-.+]+@[\\w-.+]+$", *s.EmailAddress) {
+		invalidParams.Add(request.NewErrParamPattern("EmailAddress", "^[\\w-.+]+@[\\w-.+]+$"))
+	}
+	if s.SshPublicKey != nil && len(*s.SshPublicKey) > 16384 {
+		invalidParams.Add(request.NewErrParamMaxLen("SshPublicKey", 16384))
+	}
+	if s.UserArn != nil && len(*s.UserArn) > 95 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserArn", 95))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDisplayName sets the DisplayName field's value.
 func (s *UpdateUserProfileInput) SetDisplayName(v string) *UpdateUserProfileInput {
 	s.DisplayName = &v
@@ -3556,7 +3993,7 @@ type UpdateUserProfileOutput struct {
 
 	// The email address that is displayed as part of the user's profile in AWS
 	// CodeStar.
-	EmailAddress *string `locationName:"emailAddress" min:"3" type:"string"`
+	EmailAddress *string `locationName:"emailAddress" min:"3" type:"string" sensitive:"true"`
 
 	// The date the user profile was last modified, in timestamp format.
 	LastModifiedTimestamp *time.Time `locationName:"lastModifiedTimestamp" type:"timestamp" timestampFormat:"unix"`
@@ -3635,7 +4072,7 @@ type UserProfileSummary struct {
 	DisplayName *string `locationName:"displayName" min:"1" type:"string"`
 
 	// The email address associated with the user.
-	EmailAddress *string `locationName:"emailAddress" min:"3" type:"string"`
+	EmailAddress *string `locationName:"emailAddress" min:"3" type:"string" sensitive:"true"`
 
 	// The SSH public key associated with the user in AWS CodeStar. If a project
 	// owner allows the user remote access to project resources, this public key
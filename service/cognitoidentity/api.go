@@ -1921,6 +1921,43 @@ func (s *CreateIdentityPoolInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateIdentityPoolInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateIdentityPoolInput"}
+	if s.DeveloperProviderName != nil && len(*s.DeveloperProviderName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeveloperProviderName", 128))
+	}
+	if s.DeveloperProviderName != nil && !request.MatchParamPattern("[\\w._-]+", *s.DeveloperProviderName) {
+		invalidParams.Add(request.NewErrParamPattern("DeveloperProviderName", "[\\w._-]+"))
+	}
+	if s.IdentityPoolName != nil && len(*s.IdentityPoolName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolName", 128))
+	}
+	if s.IdentityPoolName != nil && !request.MatchParamPattern("[\\w ]+", *s.IdentityPoolName) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolName", "[\\w ]+"))
+	}
+	if s.SupportedLoginProviders != nil && len(s.SupportedLoginProviders) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("SupportedLoginProviders", 10))
+	}
+	if s.CognitoIdentityProviders != nil {
+		for i, v := range s.CognitoIdentityProviders {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "CognitoIdentityProviders", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllowUnauthenticatedIdentities sets the AllowUnauthenticatedIdentities field's value.
 func (s *CreateIdentityPoolInput) SetAllowUnauthenticatedIdentities(v bool) *CreateIdentityPoolInput {
 	s.AllowUnauthenticatedIdentities = &v
@@ -2052,6 +2089,21 @@ func (s *DeleteIdentitiesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteIdentitiesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteIdentitiesInput"}
+	if s.IdentityIdsToDelete != nil && len(s.IdentityIdsToDelete) > 60 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityIdsToDelete", 60))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityIdsToDelete sets the IdentityIdsToDelete field's value.
 func (s *DeleteIdentitiesInput) SetIdentityIdsToDelete(v []*string) *DeleteIdentitiesInput {
 	s.IdentityIdsToDelete = v
@@ -2121,6 +2173,24 @@ func (s *DeleteIdentityPoolInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteIdentityPoolInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteIdentityPoolInput"}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityPoolId sets the IdentityPoolId field's value.
 func (s *DeleteIdentityPoolInput) SetIdentityPoolId(v string) *DeleteIdentityPoolInput {
 	s.IdentityPoolId = &v
@@ -2179,6 +2249,24 @@ func (s *DescribeIdentityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeIdentityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeIdentityInput"}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityId sets the IdentityId field's value.
 func (s *DescribeIdentityInput) SetIdentityId(v string) *DescribeIdentityInput {
 	s.IdentityId = &v
@@ -2222,6 +2310,24 @@ func (s *DescribeIdentityPoolInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeIdentityPoolInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeIdentityPoolInput"}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityPoolId sets the IdentityPoolId field's value.
 func (s *DescribeIdentityPoolInput) SetIdentityPoolId(v string) *DescribeIdentityPoolInput {
 	s.IdentityPoolId = &v
@@ -2277,6 +2383,30 @@ func (s *GetCredentialsForIdentityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetCredentialsForIdentityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetCredentialsForIdentityInput"}
+	if s.CustomRoleArn != nil && len(*s.CustomRoleArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("CustomRoleArn", 2048))
+	}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.Logins != nil && len(s.Logins) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Logins", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCustomRoleArn sets the CustomRoleArn field's value.
 func (s *GetCredentialsForIdentityInput) SetCustomRoleArn(v string) *GetCredentialsForIdentityInput {
 	s.CustomRoleArn = &v
@@ -2388,6 +2518,33 @@ func (s *GetIdInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetIdInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetIdInput"}
+	if s.AccountId != nil && len(*s.AccountId) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountId", 15))
+	}
+	if s.AccountId != nil && !request.MatchParamPattern("\\d+", *s.AccountId) {
+		invalidParams.Add(request.NewErrParamPattern("AccountId", "\\d+"))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.Logins != nil && len(s.Logins) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Logins", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *GetIdInput) SetAccountId(v string) *GetIdInput {
 	s.AccountId = &v
@@ -2468,6 +2625,24 @@ func (s *GetIdentityPoolRolesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetIdentityPoolRolesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetIdentityPoolRolesInput"}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityPoolId sets the IdentityPoolId field's value.
 func (s *GetIdentityPoolRolesInput) SetIdentityPoolId(v string) *GetIdentityPoolRolesInput {
 	s.IdentityPoolId = &v
@@ -2592,6 +2767,36 @@ func (s *GetOpenIdTokenForDeveloperIdentityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetOpenIdTokenForDeveloperIdentityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetOpenIdTokenForDeveloperIdentityInput"}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.Logins != nil && len(s.Logins) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Logins", 10))
+	}
+	if s.TokenDuration != nil && *s.TokenDuration > 86400 {
+		invalidParams.Add(request.NewErrParamMaxValue("TokenDuration", 86400))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityId sets the IdentityId field's value.
 func (s *GetOpenIdTokenForDeveloperIdentityInput) SetIdentityId(v string) *GetOpenIdTokenForDeveloperIdentityInput {
 	s.IdentityId = &v
@@ -2694,6 +2899,27 @@ func (s *GetOpenIdTokenInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetOpenIdTokenInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetOpenIdTokenInput"}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.Logins != nil && len(s.Logins) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Logins", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityId sets the IdentityId field's value.
 func (s *GetOpenIdTokenInput) SetIdentityId(v string) *GetOpenIdTokenInput {
 	s.IdentityId = &v
@@ -2878,6 +3104,49 @@ func (s *IdentityPool) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *IdentityPool) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "IdentityPool"}
+	if s.DeveloperProviderName != nil && len(*s.DeveloperProviderName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeveloperProviderName", 128))
+	}
+	if s.DeveloperProviderName != nil && !request.MatchParamPattern("[\\w._-]+", *s.DeveloperProviderName) {
+		invalidParams.Add(request.NewErrParamPattern("DeveloperProviderName", "[\\w._-]+"))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.IdentityPoolName != nil && len(*s.IdentityPoolName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolName", 128))
+	}
+	if s.IdentityPoolName != nil && !request.MatchParamPattern("[\\w ]+", *s.IdentityPoolName) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolName", "[\\w ]+"))
+	}
+	if s.SupportedLoginProviders != nil && len(s.SupportedLoginProviders) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("SupportedLoginProviders", 10))
+	}
+	if s.CognitoIdentityProviders != nil {
+		for i, v := range s.CognitoIdentityProviders {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "CognitoIdentityProviders", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllowUnauthenticatedIdentities sets the AllowUnauthenticatedIdentities field's value.
 func (s *IdentityPool) SetAllowUnauthenticatedIdentities(v bool) *IdentityPool {
 	s.AllowUnauthenticatedIdentities = &v
@@ -3019,6 +3288,30 @@ func (s *ListIdentitiesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListIdentitiesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListIdentitiesInput"}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 60))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("[\\S]+", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[\\S]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHideDisabled sets the HideDisabled field's value.
 func (s *ListIdentitiesInput) SetHideDisabled(v bool) *ListIdentitiesInput {
 	s.HideDisabled = &v
@@ -3129,6 +3422,24 @@ func (s *ListIdentityPoolsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListIdentityPoolsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListIdentityPoolsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 60))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("[\\S]+", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[\\S]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListIdentityPoolsInput) SetMaxResults(v int64) *ListIdentityPoolsInput {
 	s.MaxResults = &v
@@ -3243,6 +3554,39 @@ func (s *LookupDeveloperIdentityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LookupDeveloperIdentityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LookupDeveloperIdentityInput"}
+	if s.DeveloperUserIdentifier != nil && len(*s.DeveloperUserIdentifier) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeveloperUserIdentifier", 1024))
+	}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 60))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("[\\S]+", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[\\S]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeveloperUserIdentifier sets the DeveloperUserIdentifier field's value.
 func (s *LookupDeveloperIdentityInput) SetDeveloperUserIdentifier(v string) *LookupDeveloperIdentityInput {
 	s.DeveloperUserIdentifier = &v
@@ -3393,6 +3737,36 @@ func (s *MappingRule) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MappingRule) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MappingRule"}
+	if s.Claim != nil && len(*s.Claim) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Claim", 64))
+	}
+	if s.Claim != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Claim) {
+		invalidParams.Add(request.NewErrParamPattern("Claim", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.MatchType != nil {
+		values := []string{"Equals", "Contains", "StartsWith", "NotEqual"}
+		if !request.IsParamEnumValue(*s.MatchType, values) {
+			invalidParams.Add(request.NewErrParamEnum("MatchType", *s.MatchType, values))
+		}
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 2048))
+	}
+	if s.Value != nil && len(*s.Value) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClaim sets the Claim field's value.
 func (s *MappingRule) SetClaim(v string) *MappingRule {
 	s.Claim = &v
@@ -3491,6 +3865,36 @@ func (s *MergeDeveloperIdentitiesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MergeDeveloperIdentitiesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MergeDeveloperIdentitiesInput"}
+	if s.DestinationUserIdentifier != nil && len(*s.DestinationUserIdentifier) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DestinationUserIdentifier", 1024))
+	}
+	if s.DeveloperProviderName != nil && len(*s.DeveloperProviderName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeveloperProviderName", 128))
+	}
+	if s.DeveloperProviderName != nil && !request.MatchParamPattern("[\\w._-]+", *s.DeveloperProviderName) {
+		invalidParams.Add(request.NewErrParamPattern("DeveloperProviderName", "[\\w._-]+"))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.SourceUserIdentifier != nil && len(*s.SourceUserIdentifier) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceUserIdentifier", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestinationUserIdentifier sets the DestinationUserIdentifier field's value.
 func (s *MergeDeveloperIdentitiesInput) SetDestinationUserIdentifier(v string) *MergeDeveloperIdentitiesInput {
 	s.DestinationUserIdentifier = &v
@@ -3584,6 +3988,30 @@ func (s *Provider) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Provider) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Provider"}
+	if s.ClientId != nil && len(*s.ClientId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 128))
+	}
+	if s.ClientId != nil && !request.MatchParamPattern("[\\w_]+", *s.ClientId) {
+		invalidParams.Add(request.NewErrParamPattern("ClientId", "[\\w_]+"))
+	}
+	if s.ProviderName != nil && len(*s.ProviderName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProviderName", 128))
+	}
+	if s.ProviderName != nil && !request.MatchParamPattern("[\\w._:/-]+", *s.ProviderName) {
+		invalidParams.Add(request.NewErrParamPattern("ProviderName", "[\\w._:/-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientId sets the ClientId field's value.
 func (s *Provider) SetClientId(v string) *Provider {
 	s.ClientId = &v
@@ -3655,6 +4083,35 @@ func (s *RoleMapping) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RoleMapping) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RoleMapping"}
+	if s.AmbiguousRoleResolution != nil {
+		values := []string{"AuthenticatedRole", "Deny"}
+		if !request.IsParamEnumValue(*s.AmbiguousRoleResolution, values) {
+			invalidParams.Add(request.NewErrParamEnum("AmbiguousRoleResolution", *s.AmbiguousRoleResolution, values))
+		}
+	}
+	if s.Type != nil {
+		values := []string{"Token", "Rules"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.RulesConfiguration != nil {
+		if err := s.RulesConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RulesConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAmbiguousRoleResolution sets the AmbiguousRoleResolution field's value.
 func (s *RoleMapping) SetAmbiguousRoleResolution(v string) *RoleMapping {
 	s.AmbiguousRoleResolution = &v
@@ -3722,6 +4179,31 @@ func (s *RulesConfigurationType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RulesConfigurationType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RulesConfigurationType"}
+	if s.Rules != nil && len(s.Rules) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("Rules", 25))
+	}
+	if s.Rules != nil {
+		for i, v := range s.Rules {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Rules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRules sets the Rules field's value.
 func (s *RulesConfigurationType) SetRules(v []*MappingRule) *RulesConfigurationType {
 	s.Rules = v
@@ -3792,6 +4274,40 @@ func (s *SetIdentityPoolRolesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetIdentityPoolRolesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetIdentityPoolRolesInput"}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.RoleMappings != nil && len(s.RoleMappings) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleMappings", 10))
+	}
+	if s.Roles != nil && len(s.Roles) > 2 {
+		invalidParams.Add(request.NewErrParamMaxLen("Roles", 2))
+	}
+	if s.RoleMappings != nil {
+		for i, v := range s.RoleMappings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "RoleMappings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityPoolId sets the IdentityPoolId field's value.
 func (s *SetIdentityPoolRolesInput) SetIdentityPoolId(v string) *SetIdentityPoolRolesInput {
 	s.IdentityPoolId = &v
@@ -3895,6 +4411,39 @@ func (s *UnlinkDeveloperIdentityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UnlinkDeveloperIdentityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UnlinkDeveloperIdentityInput"}
+	if s.DeveloperProviderName != nil && len(*s.DeveloperProviderName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeveloperProviderName", 128))
+	}
+	if s.DeveloperProviderName != nil && !request.MatchParamPattern("[\\w._-]+", *s.DeveloperProviderName) {
+		invalidParams.Add(request.NewErrParamPattern("DeveloperProviderName", "[\\w._-]+"))
+	}
+	if s.DeveloperUserIdentifier != nil && len(*s.DeveloperUserIdentifier) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeveloperUserIdentifier", 1024))
+	}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeveloperProviderName sets the DeveloperProviderName field's value.
 func (s *UnlinkDeveloperIdentityInput) SetDeveloperProviderName(v string) *UnlinkDeveloperIdentityInput {
 	s.DeveloperProviderName = &v
@@ -3987,6 +4536,27 @@ func (s *UnlinkIdentityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UnlinkIdentityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UnlinkIdentityInput"}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.Logins != nil && len(s.Logins) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Logins", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityId sets the IdentityId field's value.
 func (s *UnlinkIdentityInput) SetIdentityId(v string) *UnlinkIdentityInput {
 	s.IdentityId = &v
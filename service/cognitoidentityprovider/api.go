@@ -361,9 +361,10 @@ const opAdminCreateUser = "AdminCreateUser"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/AdminCreateUser
 func (c *CognitoIdentityProvider) AdminCreateUserRequest(input *AdminCreateUserInput) (req *request.Request, output *AdminCreateUserOutput) {
 	op := &request.Operation{
-		Name:       opAdminCreateUser,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opAdminCreateUser,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"User.Attributes[*].Value", "User.Username"},
 	}
 
 	if input == nil {
@@ -1138,9 +1139,10 @@ const opAdminGetDevice = "AdminGetDevice"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/AdminGetDevice
 func (c *CognitoIdentityProvider) AdminGetDeviceRequest(input *AdminGetDeviceInput) (req *request.Request, output *AdminGetDeviceOutput) {
 	op := &request.Operation{
-		Name:       opAdminGetDevice,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opAdminGetDevice,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Device.DeviceAttributes[*].Value"},
 	}
 
 	if input == nil {
@@ -1237,9 +1239,10 @@ const opAdminGetUser = "AdminGetUser"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/AdminGetUser
 func (c *CognitoIdentityProvider) AdminGetUserRequest(input *AdminGetUserInput) (req *request.Request, output *AdminGetUserOutput) {
 	op := &request.Operation{
-		Name:       opAdminGetUser,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opAdminGetUser,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"UserAttributes[*].Value", "Username"},
 	}
 
 	if input == nil {
@@ -1337,9 +1340,10 @@ const opAdminInitiateAuth = "AdminInitiateAuth"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/AdminInitiateAuth
 func (c *CognitoIdentityProvider) AdminInitiateAuthRequest(input *AdminInitiateAuthInput) (req *request.Request, output *AdminInitiateAuthOutput) {
 	op := &request.Operation{
-		Name:       opAdminInitiateAuth,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opAdminInitiateAuth,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"AuthenticationResult.AccessToken", "AuthenticationResult.IdToken", "AuthenticationResult.RefreshToken"},
 	}
 
 	if input == nil {
@@ -1592,9 +1596,10 @@ const opAdminListDevices = "AdminListDevices"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/AdminListDevices
 func (c *CognitoIdentityProvider) AdminListDevicesRequest(input *AdminListDevicesInput) (req *request.Request, output *AdminListDevicesOutput) {
 	op := &request.Operation{
-		Name:       opAdminListDevices,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opAdminListDevices,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Devices[*].DeviceAttributes[*].Value"},
 	}
 
 	if input == nil {
@@ -2031,9 +2036,10 @@ const opAdminRespondToAuthChallenge = "AdminRespondToAuthChallenge"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/AdminRespondToAuthChallenge
 func (c *CognitoIdentityProvider) AdminRespondToAuthChallengeRequest(input *AdminRespondToAuthChallengeInput) (req *request.Request, output *AdminRespondToAuthChallengeOutput) {
 	op := &request.Operation{
-		Name:       opAdminRespondToAuthChallenge,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opAdminRespondToAuthChallenge,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"AuthenticationResult.AccessToken", "AuthenticationResult.IdToken", "AuthenticationResult.RefreshToken"},
 	}
 
 	if input == nil {
@@ -3614,9 +3620,10 @@ const opCreateUserPoolClient = "CreateUserPoolClient"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/CreateUserPoolClient
 func (c *CognitoIdentityProvider) CreateUserPoolClientRequest(input *CreateUserPoolClientInput) (req *request.Request, output *CreateUserPoolClientOutput) {
 	op := &request.Operation{
-		Name:       opCreateUserPoolClient,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opCreateUserPoolClient,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"UserPoolClient.ClientId", "UserPoolClient.ClientSecret"},
 	}
 
 	if input == nil {
@@ -4977,9 +4984,10 @@ const opDescribeUserPoolClient = "DescribeUserPoolClient"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/DescribeUserPoolClient
 func (c *CognitoIdentityProvider) DescribeUserPoolClientRequest(input *DescribeUserPoolClientInput) (req *request.Request, output *DescribeUserPoolClientOutput) {
 	op := &request.Operation{
-		Name:       opDescribeUserPoolClient,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeUserPoolClient,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"UserPoolClient.ClientId", "UserPoolClient.ClientSecret"},
 	}
 
 	if input == nil {
@@ -5506,9 +5514,10 @@ const opGetDevice = "GetDevice"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/GetDevice
 func (c *CognitoIdentityProvider) GetDeviceRequest(input *GetDeviceInput) (req *request.Request, output *GetDeviceOutput) {
 	op := &request.Operation{
-		Name:       opGetDevice,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opGetDevice,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Device.DeviceAttributes[*].Value"},
 	}
 
 	if input == nil {
@@ -5802,9 +5811,10 @@ const opGetUICustomization = "GetUICustomization"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/GetUICustomization
 func (c *CognitoIdentityProvider) GetUICustomizationRequest(input *GetUICustomizationInput) (req *request.Request, output *GetUICustomizationOutput) {
 	op := &request.Operation{
-		Name:       opGetUICustomization,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opGetUICustomization,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"UICustomization.ClientId"},
 	}
 
 	if input == nil {
@@ -5899,9 +5909,10 @@ const opGetUser = "GetUser"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/GetUser
 func (c *CognitoIdentityProvider) GetUserRequest(input *GetUserInput) (req *request.Request, output *GetUserOutput) {
 	op := &request.Operation{
-		Name:       opGetUser,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opGetUser,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"UserAttributes[*].Value", "Username"},
 	}
 
 	if input == nil {
@@ -6240,9 +6251,10 @@ const opInitiateAuth = "InitiateAuth"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/InitiateAuth
 func (c *CognitoIdentityProvider) InitiateAuthRequest(input *InitiateAuthInput) (req *request.Request, output *InitiateAuthOutput) {
 	op := &request.Operation{
-		Name:       opInitiateAuth,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opInitiateAuth,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"AuthenticationResult.AccessToken", "AuthenticationResult.IdToken", "AuthenticationResult.RefreshToken"},
 	}
 
 	if input == nil {
@@ -6358,9 +6370,10 @@ const opListDevices = "ListDevices"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/ListDevices
 func (c *CognitoIdentityProvider) ListDevicesRequest(input *ListDevicesInput) (req *request.Request, output *ListDevicesOutput) {
 	op := &request.Operation{
-		Name:       opListDevices,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opListDevices,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Devices[*].DeviceAttributes[*].Value"},
 	}
 
 	if input == nil {
@@ -6842,9 +6855,10 @@ const opListUserPoolClients = "ListUserPoolClients"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/ListUserPoolClients
 func (c *CognitoIdentityProvider) ListUserPoolClientsRequest(input *ListUserPoolClientsInput) (req *request.Request, output *ListUserPoolClientsOutput) {
 	op := &request.Operation{
-		Name:       opListUserPoolClients,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opListUserPoolClients,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"UserPoolClients[*].ClientId"},
 	}
 
 	if input == nil {
@@ -7026,9 +7040,10 @@ const opListUsers = "ListUsers"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/ListUsers
 func (c *CognitoIdentityProvider) ListUsersRequest(input *ListUsersInput) (req *request.Request, output *ListUsersOutput) {
 	op := &request.Operation{
-		Name:       opListUsers,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opListUsers,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Users[*].Attributes[*].Value", "Users[*].Username"},
 	}
 
 	if input == nil {
@@ -7120,9 +7135,10 @@ const opListUsersInGroup = "ListUsersInGroup"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/ListUsersInGroup
 func (c *CognitoIdentityProvider) ListUsersInGroupRequest(input *ListUsersInGroupInput) (req *request.Request, output *ListUsersInGroupOutput) {
 	op := &request.Operation{
-		Name:       opListUsersInGroup,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opListUsersInGroup,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Users[*].Attributes[*].Value", "Users[*].Username"},
 	}
 
 	if input == nil {
@@ -7348,9 +7364,10 @@ const opRespondToAuthChallenge = "RespondToAuthChallenge"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/RespondToAuthChallenge
 func (c *CognitoIdentityProvider) RespondToAuthChallengeRequest(input *RespondToAuthChallengeInput) (req *request.Request, output *RespondToAuthChallengeOutput) {
 	op := &request.Operation{
-		Name:       opRespondToAuthChallenge,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opRespondToAuthChallenge,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"AuthenticationResult.AccessToken", "AuthenticationResult.IdToken", "AuthenticationResult.RefreshToken"},
 	}
 
 	if input == nil {
@@ -7497,9 +7514,10 @@ const opSetUICustomization = "SetUICustomization"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/SetUICustomization
 func (c *CognitoIdentityProvider) SetUICustomizationRequest(input *SetUICustomizationInput) (req *request.Request, output *SetUICustomizationOutput) {
 	op := &request.Operation{
-		Name:       opSetUICustomization,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opSetUICustomization,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"UICustomization.ClientId"},
 	}
 
 	if input == nil {
@@ -8688,9 +8706,10 @@ const opUpdateUserPoolClient = "UpdateUserPoolClient"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/cognito-idp-2016-04-18/UpdateUserPoolClient
 func (c *CognitoIdentityProvider) UpdateUserPoolClientRequest(input *UpdateUserPoolClientInput) (req *request.Request, output *UpdateUserPoolClientOutput) {
 	op := &request.Operation{
-		Name:       opUpdateUserPoolClient,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opUpdateUserPoolClient,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"UserPoolClient.ClientId", "UserPoolClient.ClientSecret"},
 	}
 
 	if input == nil {
@@ -8934,6 +8953,37 @@ func (s *AddCustomAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddCustomAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddCustomAttributesInput"}
+	if s.CustomAttributes != nil && len(s.CustomAttributes) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("CustomAttributes", 25))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.CustomAttributes != nil {
+		for i, v := range s.CustomAttributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "CustomAttributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCustomAttributes sets the CustomAttributes field's value.
 func (s *AddCustomAttributesInput) SetCustomAttributes(v []*SchemaAttributeType) *AddCustomAttributesInput {
 	s.CustomAttributes = v
@@ -8979,7 +9029,7 @@ type AdminAddUserToGroupInput struct {
 	// The username for the user.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -9020,6 +9070,36 @@ func (s *AdminAddUserToGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminAddUserToGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminAddUserToGroupInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *AdminAddUserToGroupInput) SetGroupName(v string) *AdminAddUserToGroupInput {
 	s.GroupName = &v
@@ -9066,7 +9146,7 @@ type AdminConfirmSignUpInput struct {
 	// The user name for which you want to confirm user registration.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -9101,6 +9181,30 @@ func (s *AdminConfirmSignUpInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminConfirmSignUpInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminConfirmSignUpInput"}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserPoolId sets the UserPoolId field's value.
 func (s *AdminConfirmSignUpInput) SetUserPoolId(v string) *AdminConfirmSignUpInput {
 	s.UserPoolId = &v
@@ -9173,6 +9277,26 @@ func (s *AdminCreateUserConfigType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminCreateUserConfigType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminCreateUserConfigType"}
+	if s.UnusedAccountValidityDays != nil && *s.UnusedAccountValidityDays > 90 {
+		invalidParams.Add(request.NewErrParamMaxValue("UnusedAccountValidityDays", 90))
+	}
+	if s.InviteMessageTemplate != nil {
+		if err := s.InviteMessageTemplate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("InviteMessageTemplate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllowAdminCreateUserOnly sets the AllowAdminCreateUserOnly field's value.
 func (s *AdminCreateUserConfigType) SetAllowAdminCreateUserOnly(v bool) *AdminCreateUserConfigType {
 	s.AllowAdminCreateUserOnly = &v
@@ -9233,7 +9357,7 @@ type AdminCreateUserInput struct {
 	// limit that you specified when you created the user pool. To reset the account
 	// after that time limit, you must call AdminCreateUser again, specifying "RESEND"
 	// for the MessageAction parameter.
-	TemporaryPassword *string `min:"6" type:"string"`
+	TemporaryPassword *string `min:"6" type:"string" sensitive:"true"`
 
 	// An array of name-value pairs that contain user attributes and attribute values
 	// to be set for the user to be created. You can create a user without specifying
@@ -9275,7 +9399,7 @@ type AdminCreateUserInput struct {
 	// username cannot be changed.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The user's validation data. This is an array of name-value pairs that contain
 	// user attributes and attribute values that you can use for custom validation,
@@ -9347,6 +9471,62 @@ func (s *AdminCreateUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminCreateUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminCreateUserInput"}
+	if s.MessageAction != nil {
+		values := []string{"RESEND", "SUPPRESS"}
+		if !request.IsParamEnumValue(*s.MessageAction, values) {
+			invalidParams.Add(request.NewErrParamEnum("MessageAction", *s.MessageAction, values))
+		}
+	}
+	if s.TemporaryPassword != nil && len(*s.TemporaryPassword) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemporaryPassword", 256))
+	}
+	if s.TemporaryPassword != nil && !request.MatchParamPattern("[\\S]+", *s.TemporaryPassword) {
+		invalidParams.Add(request.NewErrParamPattern("TemporaryPassword", "[\\S]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.UserAttributes != nil {
+		for i, v := range s.UserAttributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "UserAttributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.ValidationData != nil {
+		for i, v := range s.ValidationData {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ValidationData", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDesiredDeliveryMediums sets the DesiredDeliveryMediums field's value.
 func (s *AdminCreateUserInput) SetDesiredDeliveryMediums(v []*string) *AdminCreateUserInput {
 	s.DesiredDeliveryMediums = v
@@ -9441,7 +9621,7 @@ type AdminDeleteUserAttributesInput struct {
 	// The user name of the user from which you would like to delete attributes.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -9479,6 +9659,30 @@ func (s *AdminDeleteUserAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminDeleteUserAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminDeleteUserAttributesInput"}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserAttributeNames sets the UserAttributeNames field's value.
 func (s *AdminDeleteUserAttributesInput) SetUserAttributeNames(v []*string) *AdminDeleteUserAttributesInput {
 	s.UserAttributeNames = v
@@ -9527,7 +9731,7 @@ type AdminDeleteUserInput struct {
 	// The user name of the user you wish to delete.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -9562,6 +9766,30 @@ func (s *AdminDeleteUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminDeleteUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminDeleteUserInput"}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserPoolId sets the UserPoolId field's value.
 func (s *AdminDeleteUserInput) SetUserPoolId(v string) *AdminDeleteUserInput {
 	s.UserPoolId = &v
@@ -9635,6 +9863,23 @@ func (s *AdminDisableProviderForUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminDisableProviderForUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminDisableProviderForUserInput"}
+	if s.User != nil {
+		if err := s.User.ValidateExtended(); err != nil {
+			invalidParams.AddNested("User", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUser sets the User field's value.
 func (s *AdminDisableProviderForUserInput) SetUser(v *ProviderUserIdentifierType) *AdminDisableProviderForUserInput {
 	s.User = v
@@ -9675,7 +9920,7 @@ type AdminDisableUserInput struct {
 	// The user name of the user you wish to disable.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -9710,6 +9955,30 @@ func (s *AdminDisableUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminDisableUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminDisableUserInput"}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserPoolId sets the UserPoolId field's value.
 func (s *AdminDisableUserInput) SetUserPoolId(v string) *AdminDisableUserInput {
 	s.UserPoolId = &v
@@ -9752,7 +10021,7 @@ type AdminEnableUserInput struct {
 	// The user name of the user you wish to enable.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -9787,6 +10056,30 @@ func (s *AdminEnableUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminEnableUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminEnableUserInput"}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserPoolId sets the UserPoolId field's value.
 func (s *AdminEnableUserInput) SetUserPoolId(v string) *AdminEnableUserInput {
 	s.UserPoolId = &v
@@ -9834,7 +10127,7 @@ type AdminForgetDeviceInput struct {
 	// The user name.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -9875,6 +10168,36 @@ func (s *AdminForgetDeviceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminForgetDeviceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminForgetDeviceInput"}
+	if s.DeviceKey != nil && len(*s.DeviceKey) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeviceKey", 55))
+	}
+	if s.DeviceKey != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-f-]+", *s.DeviceKey) {
+		invalidParams.Add(request.NewErrParamPattern("DeviceKey", "[\\w-]+_[0-9a-f-]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeviceKey sets the DeviceKey field's value.
 func (s *AdminForgetDeviceInput) SetDeviceKey(v string) *AdminForgetDeviceInput {
 	s.DeviceKey = &v
@@ -9926,7 +10249,7 @@ type AdminGetDeviceInput struct {
 	// The user name.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -9967,6 +10290,36 @@ func (s *AdminGetDeviceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminGetDeviceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminGetDeviceInput"}
+	if s.DeviceKey != nil && len(*s.DeviceKey) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeviceKey", 55))
+	}
+	if s.DeviceKey != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-f-]+", *s.DeviceKey) {
+		invalidParams.Add(request.NewErrParamPattern("DeviceKey", "[\\w-]+_[0-9a-f-]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeviceKey sets the DeviceKey field's value.
 func (s *AdminGetDeviceInput) SetDeviceKey(v string) *AdminGetDeviceInput {
 	s.DeviceKey = &v
@@ -10026,7 +10379,7 @@ type AdminGetUserInput struct {
 	// The user name of the user you wish to retrieve.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -10061,6 +10414,30 @@ func (s *AdminGetUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminGetUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminGetUserInput"}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserPoolId sets the UserPoolId field's value.
 func (s *AdminGetUserInput) SetUserPoolId(v string) *AdminGetUserInput {
 	s.UserPoolId = &v
@@ -10110,7 +10487,7 @@ type AdminGetUserOutput struct {
 	// The user name of the user about whom you are receiving information.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -10216,7 +10593,7 @@ type AdminInitiateAuthInput struct {
 	// The app client ID.
 	//
 	// ClientId is a required field
-	ClientId *string `min:"1" type:"string" required:"true"`
+	ClientId *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// This is a random key-value pair map which can contain any key and will be
 	// passed to your PreAuthentication Lambda trigger as-is. It can be used to
@@ -10264,6 +10641,36 @@ func (s *AdminInitiateAuthInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminInitiateAuthInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminInitiateAuthInput"}
+	if s.AuthFlow != nil {
+		values := []string{"USER_SRP_AUTH", "REFRESH_TOKEN_AUTH", "REFRESH_TOKEN", "CUSTOM_AUTH", "ADMIN_NO_SRP_AUTH"}
+		if !request.IsParamEnumValue(*s.AuthFlow, values) {
+			invalidParams.Add(request.NewErrParamEnum("AuthFlow", *s.AuthFlow, values))
+		}
+	}
+	if s.ClientId != nil && len(*s.ClientId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 128))
+	}
+	if s.ClientId != nil && !request.MatchParamPattern("[\\w+]+", *s.ClientId) {
+		invalidParams.Add(request.NewErrParamPattern("ClientId", "[\\w+]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthFlow sets the AuthFlow field's value.
 func (s *AdminInitiateAuthInput) SetAuthFlow(v string) *AdminInitiateAuthInput {
 	s.AuthFlow = &v
@@ -10471,17 +10878,39 @@ func (s *AdminLinkProviderForUserInput) Validate() error {
 	return nil
 }
 
-// SetDestinationUser sets the DestinationUser field's value.
-func (s *AdminLinkProviderForUserInput) SetDestinationUser(v *ProviderUserIdentifierType) *AdminLinkProviderForUserInput {
-	s.DestinationUser = v
-	return s
-}
-
-// SetSourceUser sets the SourceUser field's value.
-func (s *AdminLinkProviderForUserInput) SetSourceUser(v *ProviderUserIdentifierType) *AdminLinkProviderForUserInput {
-	s.SourceUser = v
-	return s
-}
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminLinkProviderForUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminLinkProviderForUserInput"}
+	if s.DestinationUser != nil {
+		if err := s.DestinationUser.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DestinationUser", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.SourceUser != nil {
+		if err := s.SourceUser.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SourceUser", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDestinationUser sets the DestinationUser field's value.
+func (s *AdminLinkProviderForUserInput) SetDestinationUser(v *ProviderUserIdentifierType) *AdminLinkProviderForUserInput {
+	s.DestinationUser = v
+	return s
+}
+
+// SetSourceUser sets the SourceUser field's value.
+func (s *AdminLinkProviderForUserInput) SetSourceUser(v *ProviderUserIdentifierType) *AdminLinkProviderForUserInput {
+	s.SourceUser = v
+	return s
+}
 
 // SetUserPoolId sets the UserPoolId field's value.
 func (s *AdminLinkProviderForUserInput) SetUserPoolId(v string) *AdminLinkProviderForUserInput {
@@ -10523,7 +10952,7 @@ type AdminListDevicesInput struct {
 	// The user name.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -10561,6 +10990,36 @@ func (s *AdminListDevicesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminListDevicesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminListDevicesInput"}
+	if s.Limit != nil && *s.Limit > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 60))
+	}
+	if s.PaginationToken != nil && !request.MatchParamPattern("[\\S]+", *s.PaginationToken) {
+		invalidParams.Add(request.NewErrParamPattern("PaginationToken", "[\\S]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *AdminListDevicesInput) SetLimit(v int64) *AdminListDevicesInput {
 	s.Limit = &v
@@ -10638,7 +11097,7 @@ type AdminListGroupsForUserInput struct {
 	// The username for the user.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -10676,6 +11135,36 @@ func (s *AdminListGroupsForUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminListGroupsForUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminListGroupsForUserInput"}
+	if s.Limit != nil && *s.Limit > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 60))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("[\\S]+", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[\\S]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *AdminListGroupsForUserInput) SetLimit(v int64) *AdminListGroupsForUserInput {
 	s.Limit = &v
@@ -10751,7 +11240,7 @@ type AdminRemoveUserFromGroupInput struct {
 	// The username for the user.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -10792,6 +11281,36 @@ func (s *AdminRemoveUserFromGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminRemoveUserFromGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminRemoveUserFromGroupInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *AdminRemoveUserFromGroupInput) SetGroupName(v string) *AdminRemoveUserFromGroupInput {
 	s.GroupName = &v
@@ -10838,7 +11357,7 @@ type AdminResetUserPasswordInput struct {
 	// The user name of the user whose password you wish to reset.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -10873,6 +11392,30 @@ func (s *AdminResetUserPasswordInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminResetUserPasswordInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminResetUserPasswordInput"}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserPoolId sets the UserPoolId field's value.
 func (s *AdminResetUserPasswordInput) SetUserPoolId(v string) *AdminResetUserPasswordInput {
 	s.UserPoolId = &v
@@ -10937,7 +11480,7 @@ type AdminRespondToAuthChallengeInput struct {
 	// The app client ID.
 	//
 	// ClientId is a required field
-	ClientId *string `min:"1" type:"string" required:"true"`
+	ClientId *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The session which should be passed both ways in challenge-response calls
 	// to the service. If InitiateAuth or RespondToAuthChallenge API call determines
@@ -10990,6 +11533,39 @@ func (s *AdminRespondToAuthChallengeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminRespondToAuthChallengeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminRespondToAuthChallengeInput"}
+	if s.ChallengeName != nil {
+		values := []string{"SMS_MFA", "PASSWORD_VERIFIER", "CUSTOM_CHALLENGE", "DEVICE_SRP_AUTH", "DEVICE_PASSWORD_VERIFIER", "ADMIN_NO_SRP_AUTH", "NEW_PASSWORD_REQUIRED"}
+		if !request.IsParamEnumValue(*s.ChallengeName, values) {
+			invalidParams.Add(request.NewErrParamEnum("ChallengeName", *s.ChallengeName, values))
+		}
+	}
+	if s.ClientId != nil && len(*s.ClientId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 128))
+	}
+	if s.ClientId != nil && !request.MatchParamPattern("[\\w+]+", *s.ClientId) {
+		invalidParams.Add(request.NewErrParamPattern("ClientId", "[\\w+]+"))
+	}
+	if s.Session != nil && len(*s.Session) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Session", 2048))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChallengeName sets the ChallengeName field's value.
 func (s *AdminRespondToAuthChallengeInput) SetChallengeName(v string) *AdminRespondToAuthChallengeInput {
 	s.ChallengeName = &v
@@ -11096,7 +11672,7 @@ type AdminSetUserSettingsInput struct {
 	// The user name of the user for whom you wish to set user settings.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -11144,6 +11720,40 @@ func (s *AdminSetUserSettingsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminSetUserSettingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminSetUserSettingsInput"}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.MFAOptions != nil {
+		for i, v := range s.MFAOptions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "MFAOptions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMFAOptions sets the MFAOptions field's value.
 func (s *AdminSetUserSettingsInput) SetMFAOptions(v []*MFAOptionType) *AdminSetUserSettingsInput {
 	s.MFAOptions = v
@@ -11199,7 +11809,7 @@ type AdminUpdateDeviceStatusInput struct {
 	// The user name.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -11240,6 +11850,42 @@ func (s *AdminUpdateDeviceStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminUpdateDeviceStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminUpdateDeviceStatusInput"}
+	if s.DeviceKey != nil && len(*s.DeviceKey) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeviceKey", 55))
+	}
+	if s.DeviceKey != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-f-]+", *s.DeviceKey) {
+		invalidParams.Add(request.NewErrParamPattern("DeviceKey", "[\\w-]+_[0-9a-f-]+"))
+	}
+	if s.DeviceRememberedStatus != nil {
+		values := []string{"remembered", "not_remembered"}
+		if !request.IsParamEnumValue(*s.DeviceRememberedStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("DeviceRememberedStatus", *s.DeviceRememberedStatus, values))
+		}
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeviceKey sets the DeviceKey field's value.
 func (s *AdminUpdateDeviceStatusInput) SetDeviceKey(v string) *AdminUpdateDeviceStatusInput {
 	s.DeviceKey = &v
@@ -11301,7 +11947,7 @@ type AdminUpdateUserAttributesInput struct {
 	// The user name of the user for whom you want to update user attributes.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -11349,6 +11995,40 @@ func (s *AdminUpdateUserAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminUpdateUserAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminUpdateUserAttributesInput"}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.UserAttributes != nil {
+		for i, v := range s.UserAttributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "UserAttributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserAttributes sets the UserAttributes field's value.
 func (s *AdminUpdateUserAttributesInput) SetUserAttributes(v []*AttributeType) *AdminUpdateUserAttributesInput {
 	s.UserAttributes = v
@@ -11397,7 +12077,7 @@ type AdminUserGlobalSignOutInput struct {
 	// The user name.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -11432,6 +12112,30 @@ func (s *AdminUserGlobalSignOutInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdminUserGlobalSignOutInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdminUserGlobalSignOutInput"}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserPoolId sets the UserPoolId field's value.
 func (s *AdminUserGlobalSignOutInput) SetUserPoolId(v string) *AdminUserGlobalSignOutInput {
 	s.UserPoolId = &v
@@ -11471,7 +12175,7 @@ type AttributeType struct {
 	Name *string `min:"1" type:"string" required:"true"`
 
 	// The value of the attribute.
-	Value *string `type:"string"`
+	Value *string `type:"string" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -11500,6 +12204,27 @@ func (s *AttributeType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttributeType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttributeType"}
+	if s.Name != nil && len(*s.Name) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 32))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.Value != nil && len(*s.Value) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *AttributeType) SetName(v string) *AttributeType {
 	s.Name = &v
@@ -11518,19 +12243,19 @@ type AuthenticationResultType struct {
 	_ struct{} `type:"structure"`
 
 	// The access token of the authentication result.
-	AccessToken *string `type:"string"`
+	AccessToken *string `type:"string" sensitive:"true"`
 
 	// The expiration period of the authentication result.
 	ExpiresIn *int64 `type:"integer"`
 
 	// The ID token of the authentication result.
-	IdToken *string `type:"string"`
+	IdToken *string `type:"string" sensitive:"true"`
 
 	// The new device metadata from an authentication result.
 	NewDeviceMetadata *NewDeviceMetadataType `type:"structure"`
 
 	// The refresh token of the authentication result.
-	RefreshToken *string `type:"string"`
+	RefreshToken *string `type:"string" sensitive:"true"`
 
 	// The token type of the authentication result.
 	TokenType *string `type:"string"`
@@ -11590,17 +12315,17 @@ type ChangePasswordInput struct {
 	// The access token in the change password request.
 	//
 	// AccessToken is a required field
-	AccessToken *string `type:"string" required:"true"`
+	AccessToken *string `type:"string" required:"true" sensitive:"true"`
 
 	// The old password in the change password request.
 	//
 	// PreviousPassword is a required field
-	PreviousPassword *string `min:"6" type:"string" required:"true"`
+	PreviousPassword *string `min:"6" type:"string" required:"true" sensitive:"true"`
 
 	// The new password in the change password request.
 	//
 	// ProposedPassword is a required field
-	ProposedPassword *string `min:"6" type:"string" required:"true"`
+	ProposedPassword *string `min:"6" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -11638,6 +12363,33 @@ func (s *ChangePasswordInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ChangePasswordInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ChangePasswordInput"}
+	if s.AccessToken != nil && !request.MatchParamPattern("[A-Za-z0-9-_=.]+", *s.AccessToken) {
+		invalidParams.Add(request.NewErrParamPattern("AccessToken", "[A-Za-z0-9-_=.]+"))
+	}
+	if s.PreviousPassword != nil && len(*s.PreviousPassword) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("PreviousPassword", 256))
+	}
+	if s.PreviousPassword != nil && !request.MatchParamPattern("[\\S]+", *s.PreviousPassword) {
+		invalidParams.Add(request.NewErrParamPattern("PreviousPassword", "[\\S]+"))
+	}
+	if s.ProposedPassword != nil && len(*s.ProposedPassword) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProposedPassword", 256))
+	}
+	if s.ProposedPassword != nil && !request.MatchParamPattern("[\\S]+", *s.ProposedPassword) {
+		invalidParams.Add(request.NewErrParamPattern("ProposedPassword", "[\\S]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessToken sets the AccessToken field's value.
 func (s *ChangePasswordInput) SetAccessToken(v string) *ChangePasswordInput {
 	s.AccessToken = &v
@@ -11723,7 +12475,7 @@ type ConfirmDeviceInput struct {
 	// The access token.
 	//
 	// AccessToken is a required field
-	AccessToken *string `type:"string" required:"true"`
+	AccessToken *string `type:"string" required:"true" sensitive:"true"`
 
 	// The device key.
 	//
@@ -11769,6 +12521,30 @@ func (s *ConfirmDeviceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ConfirmDeviceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConfirmDeviceInput"}
+	if s.AccessToken != nil && !request.MatchParamPattern("[A-Za-z0-9-_=.]+", *s.AccessToken) {
+		invalidParams.Add(request.NewErrParamPattern("AccessToken", "[A-Za-z0-9-_=.]+"))
+	}
+	if s.DeviceKey != nil && len(*s.DeviceKey) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeviceKey", 55))
+	}
+	if s.DeviceKey != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-f-]+", *s.DeviceKey) {
+		invalidParams.Add(request.NewErrParamPattern("DeviceKey", "[\\w-]+_[0-9a-f-]+"))
+	}
+	if s.DeviceName != nil && len(*s.DeviceName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeviceName", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessToken sets the AccessToken field's value.
 func (s *ConfirmDeviceInput) SetAccessToken(v string) *ConfirmDeviceInput {
 	s.AccessToken = &v
@@ -11827,7 +12603,7 @@ type ConfirmForgotPasswordInput struct {
 	// The app client ID of the app associated with the user pool.
 	//
 	// ClientId is a required field
-	ClientId *string `min:"1" type:"string" required:"true"`
+	ClientId *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The confirmation code sent by a user's request to retrieve a forgotten password.
 	// For more information, see ForgotPassword (API_ForgotPassword.html)
@@ -11838,17 +12614,17 @@ type ConfirmForgotPasswordInput struct {
 	// The password sent by a user's request to retrieve a forgotten password.
 	//
 	// Password is a required field
-	Password *string `min:"6" type:"string" required:"true"`
+	Password *string `min:"6" type:"string" required:"true" sensitive:"true"`
 
 	// A keyed-hash message authentication code (HMAC) calculated using the secret
 	// key of a user pool client and username plus the client ID in the message.
-	SecretHash *string `min:"1" type:"string"`
+	SecretHash *string `min:"1" type:"string" sensitive:"true"`
 
 	// The user name of the user for whom you want to enter a code to retrieve a
 	// forgotten password.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -11898,6 +12674,48 @@ func (s *ConfirmForgotPasswordInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ConfirmForgotPasswordInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConfirmForgotPasswordInput"}
+	if s.ClientId != nil && len(*s.ClientId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 128))
+	}
+	if s.ClientId != nil && !request.MatchParamPattern("[\\w+]+", *s.ClientId) {
+		invalidParams.Add(request.NewErrParamPattern("ClientId", "[\\w+]+"))
+	}
+	if s.ConfirmationCode != nil && len(*s.ConfirmationCode) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConfirmationCode", 2048))
+	}
+	if s.ConfirmationCode != nil && !request.MatchParamPattern("[\\S]+", *s.ConfirmationCode) {
+		invalidParams.Add(request.NewErrParamPattern("ConfirmationCode", "[\\S]+"))
+	}
+	if s.Password != nil && len(*s.Password) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Password", 256))
+	}
+	if s.Password != nil && !request.MatchParamPattern("[\\S]+", *s.Password) {
+		invalidParams.Add(request.NewErrParamPattern("Password", "[\\S]+"))
+	}
+	if s.SecretHash != nil && len(*s.SecretHash) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("SecretHash", 128))
+	}
+	if s.SecretHash != nil && !request.MatchParamPattern("[\\w+=/]+", *s.SecretHash) {
+		invalidParams.Add(request.NewErrParamPattern("SecretHash", "[\\w+=/]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientId sets the ClientId field's value.
 func (s *ConfirmForgotPasswordInput) SetClientId(v string) *ConfirmForgotPasswordInput {
 	s.ClientId = &v
@@ -11953,7 +12771,7 @@ type ConfirmSignUpInput struct {
 	// The ID of the app client associated with the user pool.
 	//
 	// ClientId is a required field
-	ClientId *string `min:"1" type:"string" required:"true"`
+	ClientId *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The confirmation code sent by a user's request to confirm registration.
 	//
@@ -11970,12 +12788,12 @@ type ConfirmSignUpInput struct {
 
 	// A keyed-hash message authentication code (HMAC) calculated using the secret
 	// key of a user pool client and username plus the client ID in the message.
-	SecretHash *string `min:"1" type:"string"`
+	SecretHash *string `min:"1" type:"string" sensitive:"true"`
 
 	// The user name of the user whose registration you wish to confirm.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -12019,6 +12837,42 @@ func (s *ConfirmSignUpInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ConfirmSignUpInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConfirmSignUpInput"}
+	if s.ClientId != nil && len(*s.ClientId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 128))
+	}
+	if s.ClientId != nil && !request.MatchParamPattern("[\\w+]+", *s.ClientId) {
+		invalidParams.Add(request.NewErrParamPattern("ClientId", "[\\w+]+"))
+	}
+	if s.ConfirmationCode != nil && len(*s.ConfirmationCode) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConfirmationCode", 2048))
+	}
+	if s.ConfirmationCode != nil && !request.MatchParamPattern("[\\S]+", *s.ConfirmationCode) {
+		invalidParams.Add(request.NewErrParamPattern("ConfirmationCode", "[\\S]+"))
+	}
+	if s.SecretHash != nil && len(*s.SecretHash) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("SecretHash", 128))
+	}
+	if s.SecretHash != nil && !request.MatchParamPattern("[\\w+=/]+", *s.SecretHash) {
+		invalidParams.Add(request.NewErrParamPattern("SecretHash", "[\\w+=/]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientId sets the ClientId field's value.
 func (s *ConfirmSignUpInput) SetClientId(v string) *ConfirmSignUpInput {
 	s.ClientId = &v
@@ -12138,6 +12992,39 @@ func (s *CreateGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateGroupInput"}
+	if s.Description != nil && len(*s.Description) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2048))
+	}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.RoleArn != nil && len(*s.RoleArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleArn", 2048))
+	}
+	if s.RoleArn != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?", *s.RoleArn) {
+		invalidParams.Add(request.NewErrParamPattern("RoleArn", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *CreateGroupInput) SetDescription(v string) *CreateGroupInput {
 	s.Description = &v
@@ -12262,6 +13149,39 @@ func (s *CreateIdentityProviderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateIdentityProviderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateIdentityProviderInput"}
+	if s.IdpIdentifiers != nil && len(s.IdpIdentifiers) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdpIdentifiers", 50))
+	}
+	if s.ProviderName != nil && len(*s.ProviderName) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProviderName", 32))
+	}
+	if s.ProviderName != nil && !request.MatchParamPattern("[^_][\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}][^_]+", *s.ProviderName) {
+		invalidParams.Add(request.NewErrParamPattern("ProviderName", "[^_][\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}][^_]+"))
+	}
+	if s.ProviderType != nil {
+		values := []string{"SAML", "Facebook", "Google", "LoginWithAmazon"}
+		if !request.IsParamEnumValue(*s.ProviderType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ProviderType", *s.ProviderType, values))
+		}
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeMapping sets the AttributeMapping field's value.
 func (s *CreateIdentityProviderInput) SetAttributeMapping(v map[string]*string) *CreateIdentityProviderInput {
 	s.AttributeMapping = v
@@ -12396,6 +13316,49 @@ func (s *CreateResourceServerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateResourceServerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateResourceServerInput"}
+	if s.Identifier != nil && len(*s.Identifier) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Identifier", 256))
+	}
+	if s.Identifier != nil && !request.MatchParamPattern("[\\x21\\x23-\\x5B\\x5D-\\x7E]+", *s.Identifier) {
+		invalidParams.Add(request.NewErrParamPattern("Identifier", "[\\x21\\x23-\\x5B\\x5D-\\x7E]+"))
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[\\w\\s+=,.@-]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[\\w\\s+=,.@-]+"))
+	}
+	if s.Scopes != nil && len(s.Scopes) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("Scopes", 25))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Scopes != nil {
+		for i, v := range s.Scopes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Scopes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentifier sets the Identifier field's value.
 func (s *CreateResourceServerInput) SetIdentifier(v string) *CreateResourceServerInput {
 	s.Identifier = &v
@@ -12505,6 +13468,36 @@ func (s *CreateUserImportJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateUserImportJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateUserImportJobInput"}
+	if s.CloudWatchLogsRoleArn != nil && len(*s.CloudWatchLogsRoleArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("CloudWatchLogsRoleArn", 2048))
+	}
+	if s.CloudWatchLogsRoleArn != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?", *s.CloudWatchLogsRoleArn) {
+		invalidParams.Add(request.NewErrParamPattern("CloudWatchLogsRoleArn", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?"))
+	}
+	if s.JobName != nil && len(*s.JobName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobName", 128))
+	}
+	if s.JobName != nil && !request.MatchParamPattern("[\\w\\s+=,.@-]+", *s.JobName) {
+		invalidParams.Add(request.NewErrParamPattern("JobName", "[\\w\\s+=,.@-]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCloudWatchLogsRoleArn sets the CloudWatchLogsRoleArn field's value.
 func (s *CreateUserImportJobInput) SetCloudWatchLogsRoleArn(v string) *CreateUserImportJobInput {
 	s.CloudWatchLogsRoleArn = &v
@@ -12646,6 +13639,51 @@ func (s *CreateUserPoolClientInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateUserPoolClientInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateUserPoolClientInput"}
+	if s.AllowedOAuthFlows != nil && len(s.AllowedOAuthFlows) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("AllowedOAuthFlows", 3))
+	}
+	if s.AllowedOAuthScopes != nil && len(s.AllowedOAuthScopes) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("AllowedOAuthScopes", 25))
+	}
+	if s.CallbackURLs != nil && len(s.CallbackURLs) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("CallbackURLs", 100))
+	}
+	if s.ClientName != nil && len(*s.ClientName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientName", 128))
+	}
+	if s.ClientName != nil && !request.MatchParamPattern("[\\w\\s+=,.@-]+", *s.ClientName) {
+		invalidParams.Add(request.NewErrParamPattern("ClientName", "[\\w\\s+=,.@-]+"))
+	}
+	if s.DefaultRedirectURI != nil && len(*s.DefaultRedirectURI) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultRedirectURI", 1024))
+	}
+	if s.DefaultRedirectURI != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.DefaultRedirectURI) {
+		invalidParams.Add(request.NewErrParamPattern("DefaultRedirectURI", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.LogoutURLs != nil && len(s.LogoutURLs) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogoutURLs", 100))
+	}
+	if s.RefreshTokenValidity != nil && *s.RefreshTokenValidity > 3650 {
+		invalidParams.Add(request.NewErrParamMaxValue("RefreshTokenValidity", 3650))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllowedOAuthFlows sets the AllowedOAuthFlows field's value.
 func (s *CreateUserPoolClientInput) SetAllowedOAuthFlows(v []*string) *CreateUserPoolClientInput {
 	s.AllowedOAuthFlows = v
@@ -12802,6 +13840,30 @@ func (s *CreateUserPoolDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateUserPoolDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateUserPoolDomainInput"}
+	if s.Domain != nil && len(*s.Domain) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 63))
+	}
+	if s.Domain != nil && !request.MatchParamPattern("^[a-z0-9](?:[a-z0-9\\-]{0,61}[a-z0-9])?$", *s.Domain) {
+		invalidParams.Add(request.NewErrParamPattern("Domain", "^[a-z0-9](?:[a-z0-9\\-]{0,61}[a-z0-9])?$"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *CreateUserPoolDomainInput) SetDomain(v string) *CreateUserPoolDomainInput {
 	s.Domain = &v
@@ -12977,6 +14039,97 @@ func (s *CreateUserPoolInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateUserPoolInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateUserPoolInput"}
+	if s.EmailVerificationMessage != nil && len(*s.EmailVerificationMessage) > 20000 {
+		invalidParams.Add(request.NewErrParamMaxLen("EmailVerificationMessage", 20000))
+	}
+	if s.EmailVerificationMessage != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*\\{####\\}[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*", *s.EmailVerificationMessage) {
+		invalidParams.Add(request.NewErrParamPattern("EmailVerificationMessage", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*\\{####\\}[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*"))
+	}
+	if s.EmailVerificationSubject != nil && len(*s.EmailVerificationSubject) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("EmailVerificationSubject", 140))
+	}
+	if s.EmailVerificationSubject != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s]+", *s.EmailVerificationSubject) {
+		invalidParams.Add(request.NewErrParamPattern("EmailVerificationSubject", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s]+"))
+	}
+	if s.MfaConfiguration != nil {
+		values := []string{"OFF", "ON", "OPTIONAL"}
+		if !request.IsParamEnumValue(*s.MfaConfiguration, values) {
+			invalidParams.Add(request.NewErrParamEnum("MfaConfiguration", *s.MfaConfiguration, values))
+		}
+	}
+	if s.PoolName != nil && len(*s.PoolName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PoolName", 128))
+	}
+	if s.PoolName != nil && !request.MatchParamPattern("[\\w\\s+=,.@-]+", *s.PoolName) {
+		invalidParams.Add(request.NewErrParamPattern("PoolName", "[\\w\\s+=,.@-]+"))
+	}
+	if s.Schema != nil && len(s.Schema) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Schema", 50))
+	}
+	if s.SmsAuthenticationMessage != nil && len(*s.SmsAuthenticationMessage) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("SmsAuthenticationMessage", 140))
+	}
+	if s.SmsAuthenticationMessage != nil && !request.MatchParamPattern(".*\\{####\\}.*", *s.SmsAuthenticationMessage) {
+		invalidParams.Add(request.NewErrParamPattern("SmsAuthenticationMessage", ".*\\{####\\}.*"))
+	}
+	if s.SmsVerificationMessage != nil && len(*s.SmsVerificationMessage) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("SmsVerificationMessage", 140))
+	}
+	if s.SmsVerificationMessage != nil && !request.MatchParamPattern(".*\\{####\\}.*", *s.SmsVerificationMessage) {
+		invalidParams.Add(request.NewErrParamPattern("SmsVerificationMessage", ".*\\{####\\}.*"))
+	}
+	if s.AdminCreateUserConfig != nil {
+		if err := s.AdminCreateUserConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AdminCreateUserConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.EmailConfiguration != nil {
+		if err := s.EmailConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("EmailConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.LambdaConfig != nil {
+		if err := s.LambdaConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LambdaConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Policies != nil {
+		if err := s.Policies.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Policies", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Schema != nil {
+		for i, v := range s.Schema {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Schema", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.SmsConfiguration != nil {
+		if err := s.SmsConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SmsConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.VerificationMessageTemplate != nil {
+		if err := s.VerificationMessageTemplate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("VerificationMessageTemplate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdminCreateUserConfig sets the AdminCreateUserConfig field's value.
 func (s *CreateUserPoolInput) SetAdminCreateUserConfig(v *AdminCreateUserConfigType) *CreateUserPoolInput {
 	s.AdminCreateUserConfig = v
@@ -13158,6 +14311,30 @@ func (s *DeleteGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteGroupInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *DeleteGroupInput) SetGroupName(v string) *DeleteGroupInput {
 	s.GroupName = &v
@@ -13232,6 +14409,30 @@ func (s *DeleteIdentityProviderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteIdentityProviderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteIdentityProviderInput"}
+	if s.ProviderName != nil && len(*s.ProviderName) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProviderName", 32))
+	}
+	if s.ProviderName != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.ProviderName) {
+		invalidParams.Add(request.NewErrParamPattern("ProviderName", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetProviderName sets the ProviderName field's value.
 func (s *DeleteIdentityProviderInput) SetProviderName(v string) *DeleteIdentityProviderInput {
 	s.ProviderName = &v
@@ -13306,6 +14507,30 @@ func (s *DeleteResourceServerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteResourceServerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteResourceServerInput"}
+	if s.Identifier != nil && len(*s.Identifier) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Identifier", 256))
+	}
+	if s.Identifier != nil && !request.MatchParamPattern("[\\x21\\x23-\\x5B\\x5D-\\x7E]+", *s.Identifier) {
+		invalidParams.Add(request.NewErrParamPattern("Identifier", "[\\x21\\x23-\\x5B\\x5D-\\x7E]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentifier sets the Identifier field's value.
 func (s *DeleteResourceServerInput) SetIdentifier(v string) *DeleteResourceServerInput {
 	s.Identifier = &v
@@ -13341,7 +14566,7 @@ type DeleteUserAttributesInput struct {
 	// The access token used in the request to delete user attributes.
 	//
 	// AccessToken is a required field
-	AccessToken *string `type:"string" required:"true"`
+	AccessToken *string `type:"string" required:"true" sensitive:"true"`
 
 	// An array of strings representing the user attribute names you wish to delete.
 	//
@@ -13378,6 +14603,21 @@ func (s *DeleteUserAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteUserAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteUserAttributesInput"}
+	if s.AccessToken != nil && !request.MatchParamPattern("[A-Za-z0-9-_=.]+", *s.AccessToken) {
+		invalidParams.Add(request.NewErrParamPattern("AccessToken", "[A-Za-z0-9-_=.]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessToken sets the AccessToken field's value.
 func (s *DeleteUserAttributesInput) SetAccessToken(v string) *DeleteUserAttributesInput {
 	s.AccessToken = &v
@@ -13414,7 +14654,7 @@ type DeleteUserInput struct {
 	// The access token from a request to delete a user.
 	//
 	// AccessToken is a required field
-	AccessToken *string `type:"string" required:"true"`
+	AccessToken *string `type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -13440,6 +14680,21 @@ func (s *DeleteUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteUserInput"}
+	if s.AccessToken != nil && !request.MatchParamPattern("[A-Za-z0-9-_=.]+", *s.AccessToken) {
+		invalidParams.Add(request.NewErrParamPattern("AccessToken", "[A-Za-z0-9-_=.]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessToken sets the AccessToken field's value.
 func (s *DeleteUserInput) SetAccessToken(v string) *DeleteUserInput {
 	s.AccessToken = &v
@@ -13469,7 +14724,7 @@ type DeleteUserPoolClientInput struct {
 	// The app client ID of the app associated with the user pool.
 	//
 	// ClientId is a required field
-	ClientId *string `min:"1" type:"string" required:"true"`
+	ClientId *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The user pool ID for the user pool where you want to delete the client.
 	//
@@ -13509,6 +14764,30 @@ func (s *DeleteUserPoolClientInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteUserPoolClientInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteUserPoolClientInput"}
+	if s.ClientId != nil && len(*s.ClientId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 128))
+	}
+	if s.ClientId != nil && !request.MatchParamPattern("[\\w+]+", *s.ClientId) {
+		invalidParams.Add(request.NewErrParamPattern("ClientId", "[\\w+]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientId sets the ClientId field's value.
 func (s *DeleteUserPoolClientInput) SetClientId(v string) *DeleteUserPoolClientInput {
 	s.ClientId = &v
@@ -13583,6 +14862,30 @@ func (s *DeleteUserPoolDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteUserPoolDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteUserPoolDomainInput"}
+	if s.Domain != nil && len(*s.Domain) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 63))
+	}
+	if s.Domain != nil && !request.MatchParamPattern("^[a-z0-9](?:[a-z0-9\\-]{0,61}[a-z0-9])?$", *s.Domain) {
+		invalidParams.Add(request.NewErrParamPattern("Domain", "^[a-z0-9](?:[a-z0-9\\-]{0,61}[a-z0-9])?$"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *DeleteUserPoolDomainInput) SetDomain(v string) *DeleteUserPoolDomainInput {
 	s.Domain = &v
@@ -13647,6 +14950,24 @@ func (s *DeleteUserPoolInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteUserPoolInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteUserPoolInput"}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserPoolId sets the UserPoolId field's value.
 func (s *DeleteUserPoolInput) SetUserPoolId(v string) *DeleteUserPoolInput {
 	s.UserPoolId = &v
@@ -13715,6 +15036,30 @@ func (s *DescribeIdentityProviderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeIdentityProviderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeIdentityProviderInput"}
+	if s.ProviderName != nil && len(*s.ProviderName) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProviderName", 32))
+	}
+	if s.ProviderName != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.ProviderName) {
+		invalidParams.Add(request.NewErrParamPattern("ProviderName", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetProviderName sets the ProviderName field's value.
 func (s *DescribeIdentityProviderInput) SetProviderName(v string) *DescribeIdentityProviderInput {
 	s.ProviderName = &v
@@ -13778,20 +15123,44 @@ func (s DescribeResourceServerInput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeResourceServerInput) Validate() error {
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeResourceServerInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeResourceServerInput"}
+	if s.Identifier == nil {
+		invalidParams.Add(request.NewErrParamRequired("Identifier"))
+	}
+	if s.Identifier != nil && len(*s.Identifier) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Identifier", 1))
+	}
+	if s.UserPoolId == nil {
+		invalidParams.Add(request.NewErrParamRequired("UserPoolId"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("UserPoolId", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeResourceServerInput) ValidateExtended() error {
 	invalidParams := request.ErrInvalidParams{Context: "DescribeResourceServerInput"}
-	if s.Identifier == nil {
-		invalidParams.Add(request.NewErrParamRequired("Identifier"))
+	if s.Identifier != nil && len(*s.Identifier) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Identifier", 256))
 	}
-	if s.Identifier != nil && len(*s.Identifier) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Identifier", 1))
+	if s.Identifier != nil && !request.MatchParamPattern("[\\x21\\x23-\\x5B\\x5D-\\x7E]+", *s.Identifier) {
+		invalidParams.Add(request.NewErrParamPattern("Identifier", "[\\x21\\x23-\\x5B\\x5D-\\x7E]+"))
 	}
-	if s.UserPoolId == nil {
-		invalidParams.Add(request.NewErrParamRequired("UserPoolId"))
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
 	}
-	if s.UserPoolId != nil && len(*s.UserPoolId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("UserPoolId", 1))
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -13886,6 +15255,30 @@ func (s *DescribeUserImportJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeUserImportJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeUserImportJobInput"}
+	if s.JobId != nil && len(*s.JobId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobId", 55))
+	}
+	if s.JobId != nil && !request.MatchParamPattern("import-[0-9a-zA-Z-]+", *s.JobId) {
+		invalidParams.Add(request.NewErrParamPattern("JobId", "import-[0-9a-zA-Z-]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobId sets the JobId field's value.
 func (s *DescribeUserImportJobInput) SetJobId(v string) *DescribeUserImportJobInput {
 	s.JobId = &v
@@ -13932,7 +15325,7 @@ type DescribeUserPoolClientInput struct {
 	// The app client ID of the app associated with the user pool.
 	//
 	// ClientId is a required field
-	ClientId *string `min:"1" type:"string" required:"true"`
+	ClientId *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The user pool ID for the user pool you want to describe.
 	//
@@ -13972,6 +15365,30 @@ func (s *DescribeUserPoolClientInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeUserPoolClientInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeUserPoolClientInput"}
+	if s.ClientId != nil && len(*s.ClientId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 128))
+	}
+	if s.ClientId != nil && !request.MatchParamPattern("[\\w+]+", *s.ClientId) {
+		invalidParams.Add(request.NewErrParamPattern("ClientId", "[\\w+]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientId sets the ClientId field's value.
 func (s *DescribeUserPoolClientInput) SetClientId(v string) *DescribeUserPoolClientInput {
 	s.ClientId = &v
@@ -14046,6 +15463,24 @@ func (s *DescribeUserPoolDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeUserPoolDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeUserPoolDomainInput"}
+	if s.Domain != nil && len(*s.Domain) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 63))
+	}
+	if s.Domain != nil && !request.MatchParamPattern("^[a-z0-9](?:[a-z0-9\\-]{0,61}[a-z0-9])?$", *s.Domain) {
+		invalidParams.Add(request.NewErrParamPattern("Domain", "^[a-z0-9](?:[a-z0-9\\-]{0,61}[a-z0-9])?$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *DescribeUserPoolDomainInput) SetDomain(v string) *DescribeUserPoolDomainInput {
 	s.Domain = &v
@@ -14113,6 +15548,24 @@ func (s *DescribeUserPoolInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeUserPoolInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeUserPoolInput"}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserPoolId sets the UserPoolId field's value.
 func (s *DescribeUserPoolInput) SetUserPoolId(v string) *DescribeUserPoolInput {
 	s.UserPoolId = &v
@@ -14388,6 +15841,27 @@ func (s *EmailConfigurationType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EmailConfigurationType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EmailConfigurationType"}
+	if s.ReplyToEmailAddress != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+@[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.ReplyToEmailAddress) {
+		invalidParams.Add(request.NewErrParamPattern("ReplyToEmailAddress", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+@[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.SourceArn != nil && len(*s.SourceArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceArn", 2048))
+	}
+	if s.SourceArn != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?", *s.SourceArn) {
+		invalidParams.Add(request.NewErrParamPattern("SourceArn", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetReplyToEmailAddress sets the ReplyToEmailAddress field's value.
 func (s *EmailConfigurationType) SetReplyToEmailAddress(v string) *EmailConfigurationType {
 	s.ReplyToEmailAddress = &v
@@ -14406,7 +15880,7 @@ type ForgetDeviceInput struct {
 	_ struct{} `type:"structure"`
 
 	// The access token for the forgotten device request.
-	AccessToken *string `type:"string"`
+	AccessToken *string `type:"string" sensitive:"true"`
 
 	// The device key.
 	//
@@ -14440,6 +15914,27 @@ func (s *ForgetDeviceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ForgetDeviceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ForgetDeviceInput"}
+	if s.AccessToken != nil && !request.MatchParamPattern("[A-Za-z0-9-_=.]+", *s.AccessToken) {
+		invalidParams.Add(request.NewErrParamPattern("AccessToken", "[A-Za-z0-9-_=.]+"))
+	}
+	if s.DeviceKey != nil && len(*s.DeviceKey) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeviceKey", 55))
+	}
+	if s.DeviceKey != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-f-]+", *s.DeviceKey) {
+		invalidParams.Add(request.NewErrParamPattern("DeviceKey", "[\\w-]+_[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessToken sets the AccessToken field's value.
 func (s *ForgetDeviceInput) SetAccessToken(v string) *ForgetDeviceInput {
 	s.AccessToken = &v
@@ -14475,17 +15970,17 @@ type ForgotPasswordInput struct {
 	// The ID of the client associated with the user pool.
 	//
 	// ClientId is a required field
-	ClientId *string `min:"1" type:"string" required:"true"`
+	ClientId *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// A keyed-hash message authentication code (HMAC) calculated using the secret
 	// key of a user pool client and username plus the client ID in the message.
-	SecretHash *string `min:"1" type:"string"`
+	SecretHash *string `min:"1" type:"string" sensitive:"true"`
 
 	// The user name of the user for whom you want to enter a code to reset a forgotten
 	// password.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -14523,6 +16018,36 @@ func (s *ForgotPasswordInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ForgotPasswordInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ForgotPasswordInput"}
+	if s.ClientId != nil && len(*s.ClientId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 128))
+	}
+	if s.ClientId != nil && !request.MatchParamPattern("[\\w+]+", *s.ClientId) {
+		invalidParams.Add(request.NewErrParamPattern("ClientId", "[\\w+]+"))
+	}
+	if s.SecretHash != nil && len(*s.SecretHash) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("SecretHash", 128))
+	}
+	if s.SecretHash != nil && !request.MatchParamPattern("[\\w+=/]+", *s.SecretHash) {
+		invalidParams.Add(request.NewErrParamPattern("SecretHash", "[\\w+=/]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientId sets the ClientId field's value.
 func (s *ForgotPasswordInput) SetClientId(v string) *ForgotPasswordInput {
 	s.ClientId = &v
@@ -14606,6 +16131,24 @@ func (s *GetCSVHeaderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetCSVHeaderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetCSVHeaderInput"}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserPoolId sets the UserPoolId field's value.
 func (s *GetCSVHeaderInput) SetUserPoolId(v string) *GetCSVHeaderInput {
 	s.UserPoolId = &v
@@ -14653,7 +16196,7 @@ type GetDeviceInput struct {
 	_ struct{} `type:"structure"`
 
 	// The access token.
-	AccessToken *string `type:"string"`
+	AccessToken *string `type:"string" sensitive:"true"`
 
 	// The device key.
 	//
@@ -14687,6 +16230,27 @@ func (s *GetDeviceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDeviceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDeviceInput"}
+	if s.AccessToken != nil && !request.MatchParamPattern("[A-Za-z0-9-_=.]+", *s.AccessToken) {
+		invalidParams.Add(request.NewErrParamPattern("AccessToken", "[A-Za-z0-9-_=.]+"))
+	}
+	if s.DeviceKey != nil && len(*s.DeviceKey) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeviceKey", 55))
+	}
+	if s.DeviceKey != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-f-]+", *s.DeviceKey) {
+		invalidParams.Add(request.NewErrParamPattern("DeviceKey", "[\\w-]+_[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessToken sets the AccessToken field's value.
 func (s *GetDeviceInput) SetAccessToken(v string) *GetDeviceInput {
 	s.AccessToken = &v
@@ -14773,6 +16337,30 @@ func (s *GetGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetGroupInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *GetGroupInput) SetGroupName(v string) *GetGroupInput {
 	s.GroupName = &v
@@ -14856,6 +16444,30 @@ func (s *GetIdentityProviderByIdentifierInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetIdentityProviderByIdentifierInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetIdentityProviderByIdentifierInput"}
+	if s.IdpIdentifier != nil && len(*s.IdpIdentifier) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdpIdentifier", 40))
+	}
+	if s.IdpIdentifier != nil && !request.MatchParamPattern("[\\w\\s+=.@-]+", *s.IdpIdentifier) {
+		invalidParams.Add(request.NewErrParamPattern("IdpIdentifier", "[\\w\\s+=.@-]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdpIdentifier sets the IdpIdentifier field's value.
 func (s *GetIdentityProviderByIdentifierInput) SetIdpIdentifier(v string) *GetIdentityProviderByIdentifierInput {
 	s.IdpIdentifier = &v
@@ -14899,7 +16511,7 @@ type GetUICustomizationInput struct {
 	_ struct{} `type:"structure"`
 
 	// The client ID for the client app.
-	ClientId *string `min:"1" type:"string"`
+	ClientId *string `min:"1" type:"string" sensitive:"true"`
 
 	// The user pool ID for the user pool.
 	//
@@ -14936,6 +16548,30 @@ func (s *GetUICustomizationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetUICustomizationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetUICustomizationInput"}
+	if s.ClientId != nil && len(*s.ClientId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 128))
+	}
+	if s.ClientId != nil && !request.MatchParamPattern("[\\w+]+", *s.ClientId) {
+		invalidParams.Add(request.NewErrParamPattern("ClientId", "[\\w+]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientId sets the ClientId field's value.
 func (s *GetUICustomizationInput) SetClientId(v string) *GetUICustomizationInput {
 	s.ClientId = &v
@@ -14983,7 +16619,7 @@ type GetUserAttributeVerificationCodeInput struct {
 	// verification code.
 	//
 	// AccessToken is a required field
-	AccessToken *string `type:"string" required:"true"`
+	AccessToken *string `type:"string" required:"true" sensitive:"true"`
 
 	// The attribute name returned by the server response to get the user attribute
 	// verification code.
@@ -15021,6 +16657,27 @@ func (s *GetUserAttributeVerificationCodeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetUserAttributeVerificationCodeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetUserAttributeVerificationCodeInput"}
+	if s.AccessToken != nil && !request.MatchParamPattern("[A-Za-z0-9-_=.]+", *s.AccessToken) {
+		invalidParams.Add(request.NewErrParamPattern("AccessToken", "[A-Za-z0-9-_=.]+"))
+	}
+	if s.AttributeName != nil && len(*s.AttributeName) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("AttributeName", 32))
+	}
+	if s.AttributeName != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.AttributeName) {
+		invalidParams.Add(request.NewErrParamPattern("AttributeName", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessToken sets the AccessToken field's value.
 func (s *GetUserAttributeVerificationCodeInput) SetAccessToken(v string) *GetUserAttributeVerificationCodeInput {
 	s.AccessToken = &v
@@ -15069,7 +16726,7 @@ type GetUserInput struct {
 	// the user.
 	//
 	// AccessToken is a required field
-	AccessToken *string `type:"string" required:"true"`
+	AccessToken *string `type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -15095,6 +16752,21 @@ func (s *GetUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetUserInput"}
+	if s.AccessToken != nil && !request.MatchParamPattern("[A-Za-z0-9-_=.]+", *s.AccessToken) {
+		invalidParams.Add(request.NewErrParamPattern("AccessToken", "[A-Za-z0-9-_=.]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessToken sets the AccessToken field's value.
 func (s *GetUserInput) SetAccessToken(v string) *GetUserInput {
 	s.AccessToken = &v
@@ -15121,7 +16793,7 @@ type GetUserOutput struct {
 	// The user name of the user you wish to retrieve from the get user request.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -15160,7 +16832,7 @@ type GlobalSignOutInput struct {
 	// The access token.
 	//
 	// AccessToken is a required field
-	AccessToken *string `type:"string" required:"true"`
+	AccessToken *string `type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -15186,6 +16858,21 @@ func (s *GlobalSignOutInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GlobalSignOutInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GlobalSignOutInput"}
+	if s.AccessToken != nil && !request.MatchParamPattern("[A-Za-z0-9-_=.]+", *s.AccessToken) {
+		invalidParams.Add(request.NewErrParamPattern("AccessToken", "[A-Za-z0-9-_=.]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessToken sets the AccessToken field's value.
 func (s *GlobalSignOutInput) SetAccessToken(v string) *GlobalSignOutInput {
 	s.AccessToken = &v
@@ -15435,7 +17122,7 @@ type InitiateAuthInput struct {
 	// The app client ID.
 	//
 	// ClientId is a required field
-	ClientId *string `min:"1" type:"string" required:"true"`
+	ClientId *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// This is a random key-value pair map which can contain any key and will be
 	// passed to your PreAuthentication Lambda trigger as-is. It can be used to
@@ -15472,6 +17159,30 @@ func (s *InitiateAuthInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InitiateAuthInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InitiateAuthInput"}
+	if s.AuthFlow != nil {
+		values := []string{"USER_SRP_AUTH", "REFRESH_TOKEN_AUTH", "REFRESH_TOKEN", "CUSTOM_AUTH", "ADMIN_NO_SRP_AUTH"}
+		if !request.IsParamEnumValue(*s.AuthFlow, values) {
+			invalidParams.Add(request.NewErrParamEnum("AuthFlow", *s.AuthFlow, values))
+		}
+	}
+	if s.ClientId != nil && len(*s.ClientId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 128))
+	}
+	if s.ClientId != nil && !request.MatchParamPattern("[\\w+]+", *s.ClientId) {
+		invalidParams.Add(request.NewErrParamPattern("ClientId", "[\\w+]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthFlow sets the AuthFlow field's value.
 func (s *InitiateAuthInput) SetAuthFlow(v string) *InitiateAuthInput {
 	s.AuthFlow = &v
@@ -15660,6 +17371,66 @@ func (s *LambdaConfigType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LambdaConfigType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LambdaConfigType"}
+	if s.CreateAuthChallenge != nil && len(*s.CreateAuthChallenge) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("CreateAuthChallenge", 2048))
+	}
+	if s.CreateAuthChallenge != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?", *s.CreateAuthChallenge) {
+		invalidParams.Add(request.NewErrParamPattern("CreateAuthChallenge", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?"))
+	}
+	if s.CustomMessage != nil && len(*s.CustomMessage) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("CustomMessage", 2048))
+	}
+	if s.CustomMessage != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?", *s.CustomMessage) {
+		invalidParams.Add(request.NewErrParamPattern("CustomMessage", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?"))
+	}
+	if s.DefineAuthChallenge != nil && len(*s.DefineAuthChallenge) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefineAuthChallenge", 2048))
+	}
+	if s.DefineAuthChallenge != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?", *s.DefineAuthChallenge) {
+		invalidParams.Add(request.NewErrParamPattern("DefineAuthChallenge", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?"))
+	}
+	if s.PostAuthentication != nil && len(*s.PostAuthentication) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PostAuthentication", 2048))
+	}
+	if s.PostAuthentication != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?", *s.PostAuthentication) {
+		invalidParams.Add(request.NewErrParamPattern("PostAuthentication", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?"))
+	}
+	if s.PostConfirmation != nil && len(*s.PostConfirmation) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PostConfirmation", 2048))
+	}
+	if s.PostConfirmation != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?", *s.PostConfirmation) {
+		invalidParams.Add(request.NewErrParamPattern("PostConfirmation", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?"))
+	}
+	if s.PreAuthentication != nil && len(*s.PreAuthentication) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PreAuthentication", 2048))
+	}
+	if s.PreAuthentication != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?", *s.PreAuthentication) {
+		invalidParams.Add(request.NewErrParamPattern("PreAuthentication", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?"))
+	}
+	if s.PreSignUp != nil && len(*s.PreSignUp) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PreSignUp", 2048))
+	}
+	if s.PreSignUp != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?", *s.PreSignUp) {
+		invalidParams.Add(request.NewErrParamPattern("PreSignUp", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?"))
+	}
+	if s.VerifyAuthChallengeResponse != nil && len(*s.VerifyAuthChallengeResponse) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("VerifyAuthChallengeResponse", 2048))
+	}
+	if s.VerifyAuthChallengeResponse != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?", *s.VerifyAuthChallengeResponse) {
+		invalidParams.Add(request.NewErrParamPattern("VerifyAuthChallengeResponse", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCreateAuthChallenge sets the CreateAuthChallenge field's value.
 func (s *LambdaConfigType) SetCreateAuthChallenge(v string) *LambdaConfigType {
 	s.CreateAuthChallenge = &v
@@ -15716,7 +17487,7 @@ type ListDevicesInput struct {
 	// The access tokens for the request to list devices.
 	//
 	// AccessToken is a required field
-	AccessToken *string `type:"string" required:"true"`
+	AccessToken *string `type:"string" required:"true" sensitive:"true"`
 
 	// The limit of the device request.
 	Limit *int64 `type:"integer"`
@@ -15751,6 +17522,27 @@ func (s *ListDevicesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListDevicesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDevicesInput"}
+	if s.AccessToken != nil && !request.MatchParamPattern("[A-Za-z0-9-_=.]+", *s.AccessToken) {
+		invalidParams.Add(request.NewErrParamPattern("AccessToken", "[A-Za-z0-9-_=.]+"))
+	}
+	if s.Limit != nil && *s.Limit > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 60))
+	}
+	if s.PaginationToken != nil && !request.MatchParamPattern("[\\S]+", *s.PaginationToken) {
+		invalidParams.Add(request.NewErrParamPattern("PaginationToken", "[\\S]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessToken sets the AccessToken field's value.
 func (s *ListDevicesInput) SetAccessToken(v string) *ListDevicesInput {
 	s.AccessToken = &v
@@ -15849,6 +17641,30 @@ func (s *ListGroupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListGroupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListGroupsInput"}
+	if s.Limit != nil && *s.Limit > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 60))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("[\\S]+", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[\\S]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListGroupsInput) SetLimit(v int64) *ListGroupsInput {
 	s.Limit = &v
@@ -15927,20 +17743,44 @@ func (s ListIdentityProvidersInput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *ListIdentityProvidersInput) Validate() error {
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListIdentityProvidersInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListIdentityProvidersInput"}
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+	if s.NextToken != nil && len(*s.NextToken) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	}
+	if s.UserPoolId == nil {
+		invalidParams.Add(request.NewErrParamRequired("UserPoolId"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("UserPoolId", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListIdentityProvidersInput) ValidateExtended() error {
 	invalidParams := request.ErrInvalidParams{Context: "ListIdentityProvidersInput"}
-	if s.MaxResults != nil && *s.MaxResults < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	if s.MaxResults != nil && *s.MaxResults > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 60))
 	}
-	if s.NextToken != nil && len(*s.NextToken) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("NextToken", 1))
+	if s.NextToken != nil && !request.MatchParamPattern("[\\S]+", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[\\S]+"))
 	}
-	if s.UserPoolId == nil {
-		invalidParams.Add(request.NewErrParamRequired("UserPoolId"))
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
 	}
-	if s.UserPoolId != nil && len(*s.UserPoolId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("UserPoolId", 1))
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -16050,6 +17890,30 @@ func (s *ListResourceServersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListResourceServersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListResourceServersInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("[\\S]+", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[\\S]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListResourceServersInput) SetMaxResults(v int64) *ListResourceServersInput {
 	s.MaxResults = &v
@@ -16158,6 +18022,30 @@ func (s *ListUserImportJobsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListUserImportJobsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListUserImportJobsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 60))
+	}
+	if s.PaginationToken != nil && !request.MatchParamPattern("[\\S]+", *s.PaginationToken) {
+		invalidParams.Add(request.NewErrParamPattern("PaginationToken", "[\\S]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListUserImportJobsInput) SetMaxResults(v int64) *ListUserImportJobsInput {
 	s.MaxResults = &v
@@ -16263,6 +18151,30 @@ func (s *ListUserPoolClientsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListUserPoolClientsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListUserPoolClientsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 60))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("[\\S]+", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[\\S]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListUserPoolClientsInput) SetMaxResults(v int64) *ListUserPoolClientsInput {
 	s.MaxResults = &v
@@ -16361,6 +18273,24 @@ func (s *ListUserPoolsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListUserPoolsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListUserPoolsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 60))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("[\\S]+", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[\\S]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListUserPoolsInput) SetMaxResults(v int64) *ListUserPoolsInput {
 	s.MaxResults = &v
@@ -16465,6 +18395,36 @@ func (s *ListUsersInGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListUsersInGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListUsersInGroupInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.Limit != nil && *s.Limit > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 60))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("[\\S]+", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[\\S]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *ListUsersInGroupInput) SetGroupName(v string) *ListUsersInGroupInput {
 	s.GroupName = &v
@@ -16617,6 +18577,33 @@ func (s *ListUsersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListUsersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListUsersInput"}
+	if s.Filter != nil && len(*s.Filter) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filter", 256))
+	}
+	if s.Limit != nil && *s.Limit > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 60))
+	}
+	if s.PaginationToken != nil && !request.MatchParamPattern("[\\S]+", *s.PaginationToken) {
+		invalidParams.Add(request.NewErrParamPattern("PaginationToken", "[\\S]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributesToGet sets the AttributesToGet field's value.
 func (s *ListUsersInput) SetAttributesToGet(v []*string) *ListUsersInput {
 	s.AttributesToGet = v
@@ -16717,6 +18704,30 @@ func (s *MFAOptionType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MFAOptionType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MFAOptionType"}
+	if s.AttributeName != nil && len(*s.AttributeName) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("AttributeName", 32))
+	}
+	if s.AttributeName != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.AttributeName) {
+		invalidParams.Add(request.NewErrParamPattern("AttributeName", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.DeliveryMedium != nil {
+		values := []string{"SMS", "EMAIL"}
+		if !request.IsParamEnumValue(*s.DeliveryMedium, values) {
+			invalidParams.Add(request.NewErrParamEnum("DeliveryMedium", *s.DeliveryMedium, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeName sets the AttributeName field's value.
 func (s *MFAOptionType) SetAttributeName(v string) *MFAOptionType {
 	s.AttributeName = &v
@@ -16773,6 +18784,36 @@ func (s *MessageTemplateType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MessageTemplateType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MessageTemplateType"}
+	if s.EmailMessage != nil && len(*s.EmailMessage) > 20000 {
+		invalidParams.Add(request.NewErrParamMaxLen("EmailMessage", 20000))
+	}
+	if s.EmailMessage != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*\\{####\\}[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*", *s.EmailMessage) {
+		invalidParams.Add(request.NewErrParamPattern("EmailMessage", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*\\{####\\}[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*"))
+	}
+	if s.EmailSubject != nil && len(*s.EmailSubject) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("EmailSubject", 140))
+	}
+	if s.EmailSubject != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s]+", *s.EmailSubject) {
+		invalidParams.Add(request.NewErrParamPattern("EmailSubject", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s]+"))
+	}
+	if s.SMSMessage != nil && len(*s.SMSMessage) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("SMSMessage", 140))
+	}
+	if s.SMSMessage != nil && !request.MatchParamPattern(".*\\{####\\}.*", *s.SMSMessage) {
+		invalidParams.Add(request.NewErrParamPattern("SMSMessage", ".*\\{####\\}.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEmailMessage sets the EmailMessage field's value.
 func (s *MessageTemplateType) SetEmailMessage(v string) *MessageTemplateType {
 	s.EmailMessage = &v
@@ -16909,6 +18950,21 @@ func (s *PasswordPolicyType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PasswordPolicyType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PasswordPolicyType"}
+	if s.MinimumLength != nil && *s.MinimumLength > 99 {
+		invalidParams.Add(request.NewErrParamMaxValue("MinimumLength", 99))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMinimumLength sets the MinimumLength field's value.
 func (s *PasswordPolicyType) SetMinimumLength(v int64) *PasswordPolicyType {
 	s.MinimumLength = &v
@@ -17029,6 +19085,24 @@ func (s *ProviderUserIdentifierType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ProviderUserIdentifierType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ProviderUserIdentifierType"}
+	if s.ProviderName != nil && len(*s.ProviderName) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProviderName", 32))
+	}
+	if s.ProviderName != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.ProviderName) {
+		invalidParams.Add(request.NewErrParamPattern("ProviderName", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetProviderAttributeName sets the ProviderAttributeName field's value.
 func (s *ProviderUserIdentifierType) SetProviderAttributeName(v string) *ProviderUserIdentifierType {
 	s.ProviderAttributeName = &v
@@ -17055,16 +19129,16 @@ type ResendConfirmationCodeInput struct {
 	// The ID of the client associated with the user pool.
 	//
 	// ClientId is a required field
-	ClientId *string `min:"1" type:"string" required:"true"`
+	ClientId *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// A keyed-hash message authentication code (HMAC) calculated using the secret
 	// key of a user pool client and username plus the client ID in the message.
-	SecretHash *string `min:"1" type:"string"`
+	SecretHash *string `min:"1" type:"string" sensitive:"true"`
 
 	// The user name of the user to whom you wish to resend a confirmation code.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -17102,6 +19176,36 @@ func (s *ResendConfirmationCodeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResendConfirmationCodeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResendConfirmationCodeInput"}
+	if s.ClientId != nil && len(*s.ClientId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 128))
+	}
+	if s.ClientId != nil && !request.MatchParamPattern("[\\w+]+", *s.ClientId) {
+		invalidParams.Add(request.NewErrParamPattern("ClientId", "[\\w+]+"))
+	}
+	if s.SecretHash != nil && len(*s.SecretHash) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("SecretHash", 128))
+	}
+	if s.SecretHash != nil && !request.MatchParamPattern("[\\w+=/]+", *s.SecretHash) {
+		invalidParams.Add(request.NewErrParamPattern("SecretHash", "[\\w+=/]+"))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientId sets the ClientId field's value.
 func (s *ResendConfirmationCodeInput) SetClientId(v string) *ResendConfirmationCodeInput {
 	s.ClientId = &v
@@ -17195,6 +19299,27 @@ func (s *ResourceServerScopeType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResourceServerScopeType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResourceServerScopeType"}
+	if s.ScopeDescription != nil && len(*s.ScopeDescription) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ScopeDescription", 256))
+	}
+	if s.ScopeName != nil && len(*s.ScopeName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ScopeName", 256))
+	}
+	if s.ScopeName != nil && !request.MatchParamPattern("[\\x21\\x23-\\x2E\\x30-\\x5B\\x5D-\\x7E]+", *s.ScopeName) {
+		invalidParams.Add(request.NewErrParamPattern("ScopeName", "[\\x21\\x23-\\x2E\\x30-\\x5B\\x5D-\\x7E]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetScopeDescription sets the ScopeDescription field's value.
 func (s *ResourceServerScopeType) SetScopeDescription(v string) *ResourceServerScopeType {
 	s.ScopeDescription = &v
@@ -17288,7 +19413,7 @@ type RespondToAuthChallengeInput struct {
 	// The app client ID.
 	//
 	// ClientId is a required field
-	ClientId *string `min:"1" type:"string" required:"true"`
+	ClientId *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The session which should be passed both ways in challenge-response calls
 	// to the service. If InitiateAuth or RespondToAuthChallenge API call determines
@@ -17330,6 +19455,33 @@ func (s *RespondToAuthChallengeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RespondToAuthChallengeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RespondToAuthChallengeInput"}
+	if s.ChallengeName != nil {
+		values := []string{"SMS_MFA", "PASSWORD_VERIFIER", "CUSTOM_CHALLENGE", "DEVICE_SRP_AUTH", "DEVICE_PASSWORD_VERIFIER", "ADMIN_NO_SRP_AUTH", "NEW_PASSWORD_REQUIRED"}
+		if !request.IsParamEnumValue(*s.ChallengeName, values) {
+			invalidParams.Add(request.NewErrParamEnum("ChallengeName", *s.ChallengeName, values))
+		}
+	}
+	if s.ClientId != nil && len(*s.ClientId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 128))
+	}
+	if s.ClientId != nil && !request.MatchParamPattern("[\\w+]+", *s.ClientId) {
+		invalidParams.Add(request.NewErrParamPattern("ClientId", "[\\w+]+"))
+	}
+	if s.Session != nil && len(*s.Session) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Session", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChallengeName sets the ChallengeName field's value.
 func (s *RespondToAuthChallengeInput) SetChallengeName(v string) *RespondToAuthChallengeInput {
 	s.ChallengeName = &v
@@ -17464,6 +19616,30 @@ func (s *SchemaAttributeType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SchemaAttributeType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SchemaAttributeType"}
+	if s.AttributeDataType != nil {
+		values := []string{"String", "Number", "DateTime", "Boolean"}
+		if !request.IsParamEnumValue(*s.AttributeDataType, values) {
+			invalidParams.Add(request.NewErrParamEnum("AttributeDataType", *s.AttributeDataType, values))
+		}
+	}
+	if s.Name != nil && len(*s.Name) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 20))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeDataType sets the AttributeDataType field's value.
 func (s *SchemaAttributeType) SetAttributeDataType(v string) *SchemaAttributeType {
 	s.AttributeDataType = &v
@@ -17514,7 +19690,7 @@ type SetUICustomizationInput struct {
 	CSS *string `type:"string"`
 
 	// The client ID for the client app.
-	ClientId *string `min:"1" type:"string"`
+	ClientId *string `min:"1" type:"string" sensitive:"true"`
 
 	// The uploaded logo image for the UI customization.
 	//
@@ -17556,6 +19732,30 @@ func (s *SetUICustomizationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetUICustomizationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetUICustomizationInput"}
+	if s.ClientId != nil && len(*s.ClientId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 128))
+	}
+	if s.ClientId != nil && !request.MatchParamPattern("[\\w+]+", *s.ClientId) {
+		invalidParams.Add(request.NewErrParamPattern("ClientId", "[\\w+]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCSS sets the CSS field's value.
 func (s *SetUICustomizationInput) SetCSS(v string) *SetUICustomizationInput {
 	s.CSS = &v
@@ -17614,7 +19814,7 @@ type SetUserSettingsInput struct {
 	// The access token for the set user settings request.
 	//
 	// AccessToken is a required field
-	AccessToken *string `type:"string" required:"true"`
+	AccessToken *string `type:"string" required:"true" sensitive:"true"`
 
 	// Specifies the options for MFA (e.g., email or phone number).
 	//
@@ -17658,6 +19858,31 @@ func (s *SetUserSettingsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetUserSettingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetUserSettingsInput"}
+	if s.AccessToken != nil && !request.MatchParamPattern("[A-Za-z0-9-_=.]+", *s.AccessToken) {
+		invalidParams.Add(request.NewErrParamPattern("AccessToken", "[A-Za-z0-9-_=.]+"))
+	}
+	if s.MFAOptions != nil {
+		for i, v := range s.MFAOptions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "MFAOptions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessToken sets the AccessToken field's value.
 func (s *SetUserSettingsInput) SetAccessToken(v string) *SetUserSettingsInput {
 	s.AccessToken = &v
@@ -17694,16 +19919,16 @@ type SignUpInput struct {
 	// The ID of the client associated with the user pool.
 	//
 	// ClientId is a required field
-	ClientId *string `min:"1" type:"string" required:"true"`
+	ClientId *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The password of the user you wish to register.
 	//
 	// Password is a required field
-	Password *string `min:"6" type:"string" required:"true"`
+	Password *string `min:"6" type:"string" required:"true" sensitive:"true"`
 
 	// A keyed-hash message authentication code (HMAC) calculated using the secret
 	// key of a user pool client and username plus the client ID in the message.
-	SecretHash *string `min:"1" type:"string"`
+	SecretHash *string `min:"1" type:"string" sensitive:"true"`
 
 	// An array of name-value pairs representing user attributes.
 	//
@@ -17714,7 +19939,7 @@ type SignUpInput struct {
 	// The user name of the user you wish to register.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The validation data in the request to register a user.
 	ValidationData []*AttributeType `type:"list"`
@@ -17725,41 +19950,97 @@ func (s SignUpInput) String() string {
 	return awsutil.Prettify(s)
 }
 
-// GoString returns the string representation
-func (s SignUpInput) GoString() string {
-	return s.String()
+// GoString returns the string representation
+func (s SignUpInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *SignUpInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "SignUpInput"}
+	if s.ClientId == nil {
+		invalidParams.Add(request.NewErrParamRequired("ClientId"))
+	}
+	if s.ClientId != nil && len(*s.ClientId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ClientId", 1))
+	}
+	if s.Password == nil {
+		invalidParams.Add(request.NewErrParamRequired("Password"))
+	}
+	if s.Password != nil && len(*s.Password) < 6 {
+		invalidParams.Add(request.NewErrParamMinLen("Password", 6))
+	}
+	if s.SecretHash != nil && len(*s.SecretHash) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("SecretHash", 1))
+	}
+	if s.Username == nil {
+		invalidParams.Add(request.NewErrParamRequired("Username"))
+	}
+	if s.Username != nil && len(*s.Username) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Username", 1))
+	}
+	if s.UserAttributes != nil {
+		for i, v := range s.UserAttributes {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "UserAttributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.ValidationData != nil {
+		for i, v := range s.ValidationData {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ValidationData", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *SignUpInput) Validate() error {
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SignUpInput) ValidateExtended() error {
 	invalidParams := request.ErrInvalidParams{Context: "SignUpInput"}
-	if s.ClientId == nil {
-		invalidParams.Add(request.NewErrParamRequired("ClientId"))
+	if s.ClientId != nil && len(*s.ClientId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 128))
 	}
-	if s.ClientId != nil && len(*s.ClientId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ClientId", 1))
+	if s.ClientId != nil && !request.MatchParamPattern("[\\w+]+", *s.ClientId) {
+		invalidParams.Add(request.NewErrParamPattern("ClientId", "[\\w+]+"))
 	}
-	if s.Password == nil {
-		invalidParams.Add(request.NewErrParamRequired("Password"))
+	if s.Password != nil && len(*s.Password) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Password", 256))
 	}
-	if s.Password != nil && len(*s.Password) < 6 {
-		invalidParams.Add(request.NewErrParamMinLen("Password", 6))
+	if s.Password != nil && !request.MatchParamPattern("[\\S]+", *s.Password) {
+		invalidParams.Add(request.NewErrParamPattern("Password", "[\\S]+"))
 	}
-	if s.SecretHash != nil && len(*s.SecretHash) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("SecretHash", 1))
+	if s.SecretHash != nil && len(*s.SecretHash) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("SecretHash", 128))
 	}
-	if s.Username == nil {
-		invalidParams.Add(request.NewErrParamRequired("Username"))
+	if s.SecretHash != nil && !request.MatchParamPattern("[\\w+=/]+", *s.SecretHash) {
+		invalidParams.Add(request.NewErrParamPattern("SecretHash", "[\\w+=/]+"))
 	}
-	if s.Username != nil && len(*s.Username) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Username", 1))
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
 	}
 	if s.UserAttributes != nil {
 		for i, v := range s.UserAttributes {
 			if v == nil {
 				continue
 			}
-			if err := v.Validate(); err != nil {
+			if err := v.ValidateExtended(); err != nil {
 				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "UserAttributes", i), err.(request.ErrInvalidParams))
 			}
 		}
@@ -17769,7 +20050,7 @@ func (s *SignUpInput) Validate() error {
 			if v == nil {
 				continue
 			}
-			if err := v.Validate(); err != nil {
+			if err := v.ValidateExtended(); err != nil {
 				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ValidationData", i), err.(request.ErrInvalidParams))
 			}
 		}
@@ -17906,6 +20187,24 @@ func (s *SmsConfigurationType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SmsConfigurationType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SmsConfigurationType"}
+	if s.SnsCallerArn != nil && len(*s.SnsCallerArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("SnsCallerArn", 2048))
+	}
+	if s.SnsCallerArn != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?", *s.SnsCallerArn) {
+		invalidParams.Add(request.NewErrParamPattern("SnsCallerArn", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExternalId sets the ExternalId field's value.
 func (s *SmsConfigurationType) SetExternalId(v string) *SmsConfigurationType {
 	s.ExternalId = &v
@@ -17966,6 +20265,30 @@ func (s *StartUserImportJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartUserImportJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartUserImportJobInput"}
+	if s.JobId != nil && len(*s.JobId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobId", 55))
+	}
+	if s.JobId != nil && !request.MatchParamPattern("import-[0-9a-zA-Z-]+", *s.JobId) {
+		invalidParams.Add(request.NewErrParamPattern("JobId", "import-[0-9a-zA-Z-]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobId sets the JobId field's value.
 func (s *StartUserImportJobInput) SetJobId(v string) *StartUserImportJobInput {
 	s.JobId = &v
@@ -18052,6 +20375,30 @@ func (s *StopUserImportJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopUserImportJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopUserImportJobInput"}
+	if s.JobId != nil && len(*s.JobId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobId", 55))
+	}
+	if s.JobId != nil && !request.MatchParamPattern("import-[0-9a-zA-Z-]+", *s.JobId) {
+		invalidParams.Add(request.NewErrParamPattern("JobId", "import-[0-9a-zA-Z-]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobId sets the JobId field's value.
 func (s *StopUserImportJobInput) SetJobId(v string) *StopUserImportJobInput {
 	s.JobId = &v
@@ -18137,7 +20484,7 @@ type UICustomizationType struct {
 	CSSVersion *string `type:"string"`
 
 	// The client ID for the client app.
-	ClientId *string `min:"1" type:"string"`
+	ClientId *string `min:"1" type:"string" sensitive:"true"`
 
 	// The creation date for the UI customization.
 	CreationDate *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -18212,7 +20559,7 @@ type UpdateDeviceStatusInput struct {
 	// The access token.
 	//
 	// AccessToken is a required field
-	AccessToken *string `type:"string" required:"true"`
+	AccessToken *string `type:"string" required:"true" sensitive:"true"`
 
 	// The device key.
 	//
@@ -18252,6 +20599,33 @@ func (s *UpdateDeviceStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDeviceStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDeviceStatusInput"}
+	if s.AccessToken != nil && !request.MatchParamPattern("[A-Za-z0-9-_=.]+", *s.AccessToken) {
+		invalidParams.Add(request.NewErrParamPattern("AccessToken", "[A-Za-z0-9-_=.]+"))
+	}
+	if s.DeviceKey != nil && len(*s.DeviceKey) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeviceKey", 55))
+	}
+	if s.DeviceKey != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-f-]+", *s.DeviceKey) {
+		invalidParams.Add(request.NewErrParamPattern("DeviceKey", "[\\w-]+_[0-9a-f-]+"))
+	}
+	if s.DeviceRememberedStatus != nil {
+		values := []string{"remembered", "not_remembered"}
+		if !request.IsParamEnumValue(*s.DeviceRememberedStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("DeviceRememberedStatus", *s.DeviceRememberedStatus, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessToken sets the AccessToken field's value.
 func (s *UpdateDeviceStatusInput) SetAccessToken(v string) *UpdateDeviceStatusInput {
 	s.AccessToken = &v
@@ -18347,6 +20721,39 @@ func (s *UpdateGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateGroupInput"}
+	if s.Description != nil && len(*s.Description) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2048))
+	}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.RoleArn != nil && len(*s.RoleArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleArn", 2048))
+	}
+	if s.RoleArn != nil && !request.MatchParamPattern("arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?", *s.RoleArn) {
+		invalidParams.Add(request.NewErrParamPattern("RoleArn", "arn:[\\w+=/,.@-]+:[\\w+=/,.@-]+:([\\w+=/,.@-]*)?:[0-9]+:[\\w+=/,.@-]+(:[\\w+=/,.@-]+)?(:[\\w+=/,.@-]+)?"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *UpdateGroupInput) SetDescription(v string) *UpdateGroupInput {
 	s.Description = &v
@@ -18457,6 +20864,33 @@ func (s *UpdateIdentityProviderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateIdentityProviderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateIdentityProviderInput"}
+	if s.IdpIdentifiers != nil && len(s.IdpIdentifiers) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdpIdentifiers", 50))
+	}
+	if s.ProviderName != nil && len(*s.ProviderName) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProviderName", 32))
+	}
+	if s.ProviderName != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.ProviderName) {
+		invalidParams.Add(request.NewErrParamPattern("ProviderName", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeMapping sets the AttributeMapping field's value.
 func (s *UpdateIdentityProviderInput) SetAttributeMapping(v map[string]*string) *UpdateIdentityProviderInput {
 	s.AttributeMapping = v
@@ -18584,6 +21018,49 @@ func (s *UpdateResourceServerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateResourceServerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateResourceServerInput"}
+	if s.Identifier != nil && len(*s.Identifier) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Identifier", 256))
+	}
+	if s.Identifier != nil && !request.MatchParamPattern("[\\x21\\x23-\\x5B\\x5D-\\x7E]+", *s.Identifier) {
+		invalidParams.Add(request.NewErrParamPattern("Identifier", "[\\x21\\x23-\\x5B\\x5D-\\x7E]+"))
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[\\w\\s+=,.@-]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[\\w\\s+=,.@-]+"))
+	}
+	if s.Scopes != nil && len(s.Scopes) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("Scopes", 25))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.Scopes != nil {
+		for i, v := range s.Scopes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Scopes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentifier sets the Identifier field's value.
 func (s *UpdateResourceServerInput) SetIdentifier(v string) *UpdateResourceServerInput {
 	s.Identifier = &v
@@ -18642,7 +21119,7 @@ type UpdateUserAttributesInput struct {
 	// The access token for the request to update user attributes.
 	//
 	// AccessToken is a required field
-	AccessToken *string `type:"string" required:"true"`
+	AccessToken *string `type:"string" required:"true" sensitive:"true"`
 
 	// An array of name-value pairs representing user attributes.
 	//
@@ -18689,6 +21166,31 @@ func (s *UpdateUserAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateUserAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateUserAttributesInput"}
+	if s.AccessToken != nil && !request.MatchParamPattern("[A-Za-z0-9-_=.]+", *s.AccessToken) {
+		invalidParams.Add(request.NewErrParamPattern("AccessToken", "[A-Za-z0-9-_=.]+"))
+	}
+	if s.UserAttributes != nil {
+		for i, v := range s.UserAttributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "UserAttributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessToken sets the AccessToken field's value.
 func (s *UpdateUserAttributesInput) SetAccessToken(v string) *UpdateUserAttributesInput {
 	s.AccessToken = &v
@@ -18754,7 +21256,7 @@ type UpdateUserPoolClientInput struct {
 	// The ID of the client associated with the user pool.
 	//
 	// ClientId is a required field
-	ClientId *string `min:"1" type:"string" required:"true"`
+	ClientId *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The client name from the update user pool client request.
 	ClientName *string `min:"1" type:"string"`
@@ -18827,6 +21329,57 @@ func (s *UpdateUserPoolClientInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateUserPoolClientInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateUserPoolClientInput"}
+	if s.AllowedOAuthFlows != nil && len(s.AllowedOAuthFlows) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("AllowedOAuthFlows", 3))
+	}
+	if s.AllowedOAuthScopes != nil && len(s.AllowedOAuthScopes) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("AllowedOAuthScopes", 25))
+	}
+	if s.CallbackURLs != nil && len(s.CallbackURLs) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("CallbackURLs", 100))
+	}
+	if s.ClientId != nil && len(*s.ClientId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 128))
+	}
+	if s.ClientId != nil && !request.MatchParamPattern("[\\w+]+", *s.ClientId) {
+		invalidParams.Add(request.NewErrParamPattern("ClientId", "[\\w+]+"))
+	}
+	if s.ClientName != nil && len(*s.ClientName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientName", 128))
+	}
+	if s.ClientName != nil && !request.MatchParamPattern("[\\w\\s+=,.@-]+", *s.ClientName) {
+		invalidParams.Add(request.NewErrParamPattern("ClientName", "[\\w\\s+=,.@-]+"))
+	}
+	if s.DefaultRedirectURI != nil && len(*s.DefaultRedirectURI) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultRedirectURI", 1024))
+	}
+	if s.DefaultRedirectURI != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.DefaultRedirectURI) {
+		invalidParams.Add(request.NewErrParamPattern("DefaultRedirectURI", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.LogoutURLs != nil && len(s.LogoutURLs) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogoutURLs", 100))
+	}
+	if s.RefreshTokenValidity != nil && *s.RefreshTokenValidity > 3650 {
+		invalidParams.Add(request.NewErrParamMaxValue("RefreshTokenValidity", 3650))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllowedOAuthFlows sets the AllowedOAuthFlows field's value.
 func (s *UpdateUserPoolClientInput) SetAllowedOAuthFlows(v []*string) *UpdateUserPoolClientInput {
 	s.AllowedOAuthFlows = v
@@ -19070,6 +21623,84 @@ func (s *UpdateUserPoolInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateUserPoolInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateUserPoolInput"}
+	if s.EmailVerificationMessage != nil && len(*s.EmailVerificationMessage) > 20000 {
+		invalidParams.Add(request.NewErrParamMaxLen("EmailVerificationMessage", 20000))
+	}
+	if s.EmailVerificationMessage != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*\\{####\\}[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*", *s.EmailVerificationMessage) {
+		invalidParams.Add(request.NewErrParamPattern("EmailVerificationMessage", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*\\{####\\}[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*"))
+	}
+	if s.EmailVerificationSubject != nil && len(*s.EmailVerificationSubject) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("EmailVerificationSubject", 140))
+	}
+	if s.EmailVerificationSubject != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s]+", *s.EmailVerificationSubject) {
+		invalidParams.Add(request.NewErrParamPattern("EmailVerificationSubject", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s]+"))
+	}
+	if s.MfaConfiguration != nil {
+		values := []string{"OFF", "ON", "OPTIONAL"}
+		if !request.IsParamEnumValue(*s.MfaConfiguration, values) {
+			invalidParams.Add(request.NewErrParamEnum("MfaConfiguration", *s.MfaConfiguration, values))
+		}
+	}
+	if s.SmsAuthenticationMessage != nil && len(*s.SmsAuthenticationMessage) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("SmsAuthenticationMessage", 140))
+	}
+	if s.SmsAuthenticationMessage != nil && !request.MatchParamPattern(".*\\{####\\}.*", *s.SmsAuthenticationMessage) {
+		invalidParams.Add(request.NewErrParamPattern("SmsAuthenticationMessage", ".*\\{####\\}.*"))
+	}
+	if s.SmsVerificationMessage != nil && len(*s.SmsVerificationMessage) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("SmsVerificationMessage", 140))
+	}
+	if s.SmsVerificationMessage != nil && !request.MatchParamPattern(".*\\{####\\}.*", *s.SmsVerificationMessage) {
+		invalidParams.Add(request.NewErrParamPattern("SmsVerificationMessage", ".*\\{####\\}.*"))
+	}
+	if s.UserPoolId != nil && len(*s.UserPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserPoolId", 55))
+	}
+	if s.UserPoolId != nil && !request.MatchParamPattern("[\\w-]+_[0-9a-zA-Z]+", *s.UserPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("UserPoolId", "[\\w-]+_[0-9a-zA-Z]+"))
+	}
+	if s.AdminCreateUserConfig != nil {
+		if err := s.AdminCreateUserConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AdminCreateUserConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.EmailConfiguration != nil {
+		if err := s.EmailConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("EmailConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.LambdaConfig != nil {
+		if err := s.LambdaConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LambdaConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Policies != nil {
+		if err := s.Policies.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Policies", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.SmsConfiguration != nil {
+		if err := s.SmsConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SmsConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.VerificationMessageTemplate != nil {
+		if err := s.VerificationMessageTemplate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("VerificationMessageTemplate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdminCreateUserConfig sets the AdminCreateUserConfig field's value.
 func (s *UpdateUserPoolInput) SetAdminCreateUserConfig(v *AdminCreateUserConfigType) *UpdateUserPoolInput {
 	s.AdminCreateUserConfig = v
@@ -19339,7 +21970,7 @@ type UserPoolClientDescription struct {
 	_ struct{} `type:"structure"`
 
 	// The ID of the client associated with the user pool.
-	ClientId *string `min:"1" type:"string"`
+	ClientId *string `min:"1" type:"string" sensitive:"true"`
 
 	// The client name from the user pool client description.
 	ClientName *string `min:"1" type:"string"`
@@ -19402,13 +22033,13 @@ type UserPoolClientType struct {
 	CallbackURLs []*string `type:"list"`
 
 	// The ID of the client associated with the user pool.
-	ClientId *string `min:"1" type:"string"`
+	ClientId *string `min:"1" type:"string" sensitive:"true"`
 
 	// The client name from the user pool request of the client type.
 	ClientName *string `min:"1" type:"string"`
 
 	// The client secret from the user pool request of the client type.
-	ClientSecret *string `min:"1" type:"string"`
+	ClientSecret *string `min:"1" type:"string" sensitive:"true"`
 
 	// The date the user pool client was created.
 	CreationDate *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -19659,6 +22290,23 @@ func (s *UserPoolPolicyType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UserPoolPolicyType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UserPoolPolicyType"}
+	if s.PasswordPolicy != nil {
+		if err := s.PasswordPolicy.ValidateExtended(); err != nil {
+			invalidParams.AddNested("PasswordPolicy", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPasswordPolicy sets the PasswordPolicy field's value.
 func (s *UserPoolPolicyType) SetPasswordPolicy(v *PasswordPolicyType) *UserPoolPolicyType {
 	s.PasswordPolicy = v
@@ -19950,7 +22598,7 @@ type UserType struct {
 	UserStatus *string `type:"string" enum:"UserStatusType"`
 
 	// The user name of the user you wish to describe.
-	Username *string `min:"1" type:"string"`
+	Username *string `min:"1" type:"string" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -20065,6 +22713,54 @@ func (s *VerificationMessageTemplateType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *VerificationMessageTemplateType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "VerificationMessageTemplateType"}
+	if s.DefaultEmailOption != nil {
+		values := []string{"CONFIRM_WITH_LINK", "CONFIRM_WITH_CODE"}
+		if !request.IsParamEnumValue(*s.DefaultEmailOption, values) {
+			invalidParams.Add(request.NewErrParamEnum("DefaultEmailOption", *s.DefaultEmailOption, values))
+		}
+	}
+	if s.EmailMessage != nil && len(*s.EmailMessage) > 20000 {
+		invalidParams.Add(request.NewErrParamMaxLen("EmailMessage", 20000))
+	}
+	if s.EmailMessage != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*\\{####\\}[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*", *s.EmailMessage) {
+		invalidParams.Add(request.NewErrParamPattern("EmailMessage", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*\\{####\\}[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*"))
+	}
+	if s.EmailMessageByLink != nil && len(*s.EmailMessageByLink) > 20000 {
+		invalidParams.Add(request.NewErrParamMaxLen("EmailMessageByLink", 20000))
+	}
+	if s.EmailMessageByLink != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*\\{##[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*##\\}[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*", *s.EmailMessageByLink) {
+		invalidParams.Add(request.NewErrParamPattern("EmailMessageByLink", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*\\{##[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*##\\}[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s*]*"))
+	}
+	if s.EmailSubject != nil && len(*s.EmailSubject) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("EmailSubject", 140))
+	}
+	if s.EmailSubject != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s]+", *s.EmailSubject) {
+		invalidParams.Add(request.NewErrParamPattern("EmailSubject", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s]+"))
+	}
+	if s.EmailSubjectByLink != nil && len(*s.EmailSubjectByLink) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("EmailSubjectByLink", 140))
+	}
+	if s.EmailSubjectByLink != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s]+", *s.EmailSubjectByLink) {
+		invalidParams.Add(request.NewErrParamPattern("EmailSubjectByLink", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}\\s]+"))
+	}
+	if s.SmsMessage != nil && len(*s.SmsMessage) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("SmsMessage", 140))
+	}
+	if s.SmsMessage != nil && !request.MatchParamPattern(".*\\{####\\}.*", *s.SmsMessage) {
+		invalidParams.Add(request.NewErrParamPattern("SmsMessage", ".*\\{####\\}.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultEmailOption sets the DefaultEmailOption field's value.
 func (s *VerificationMessageTemplateType) SetDefaultEmailOption(v string) *VerificationMessageTemplateType {
 	s.DefaultEmailOption = &v
@@ -20109,7 +22805,7 @@ type VerifyUserAttributeInput struct {
 	// Represents the access token of the request to verify user attributes.
 	//
 	// AccessToken is a required field
-	AccessToken *string `type:"string" required:"true"`
+	AccessToken *string `type:"string" required:"true" sensitive:"true"`
 
 	// The attribute name in the request to verify user attributes.
 	//
@@ -20157,6 +22853,33 @@ func (s *VerifyUserAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *VerifyUserAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "VerifyUserAttributeInput"}
+	if s.AccessToken != nil && !request.MatchParamPattern("[A-Za-z0-9-_=.]+", *s.AccessToken) {
+		invalidParams.Add(request.NewErrParamPattern("AccessToken", "[A-Za-z0-9-_=.]+"))
+	}
+	if s.AttributeName != nil && len(*s.AttributeName) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("AttributeName", 32))
+	}
+	if s.AttributeName != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+", *s.AttributeName) {
+		invalidParams.Add(request.NewErrParamPattern("AttributeName", "[\\p{L}\\p{M}\\p{S}\\p{N}\\p{P}]+"))
+	}
+	if s.Code != nil && len(*s.Code) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Code", 2048))
+	}
+	if s.Code != nil && !request.MatchParamPattern("[\\S]+", *s.Code) {
+		invalidParams.Add(request.NewErrParamPattern("Code", "[\\S]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessToken sets the AccessToken field's value.
 func (s *VerifyUserAttributeInput) SetAccessToken(v string) *VerifyUserAttributeInput {
 	s.AccessToken = &v
@@ -1707,6 +1707,24 @@ func (s *BulkPublishInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BulkPublishInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BulkPublishInput"}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityPoolId sets the IdentityPoolId field's value.
 func (s *BulkPublishInput) SetIdentityPoolId(v string) *BulkPublishInput {
 	s.IdentityPoolId = &v
@@ -1809,6 +1827,33 @@ func (s *CognitoStreams) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CognitoStreams) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CognitoStreams"}
+	if s.RoleArn != nil && len(*s.RoleArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleArn", 2048))
+	}
+	if s.RoleArn != nil && !request.MatchParamPattern("arn:aws:iam::\\d+:role/.*", *s.RoleArn) {
+		invalidParams.Add(request.NewErrParamPattern("RoleArn", "arn:aws:iam::\\d+:role/.*"))
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamingStatus != nil {
+		values := []string{"ENABLED", "DISABLED"}
+		if !request.IsParamEnumValue(*s.StreamingStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("StreamingStatus", *s.StreamingStatus, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRoleArn sets the RoleArn field's value.
 func (s *CognitoStreams) SetRoleArn(v string) *CognitoStreams {
 	s.RoleArn = &v
@@ -2044,6 +2089,36 @@ func (s *DeleteDatasetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteDatasetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDatasetInput"}
+	if s.DatasetName != nil && len(*s.DatasetName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatasetName", 128))
+	}
+	if s.DatasetName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.:-]+", *s.DatasetName) {
+		invalidParams.Add(request.NewErrParamPattern("DatasetName", "[a-zA-Z0-9_.:-]+"))
+	}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDatasetName sets the DatasetName field's value.
 func (s *DeleteDatasetInput) SetDatasetName(v string) *DeleteDatasetInput {
 	s.DatasetName = &v
@@ -2186,6 +2261,36 @@ func (s *DescribeDatasetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeDatasetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeDatasetInput"}
+	if s.DatasetName != nil && len(*s.DatasetName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatasetName", 128))
+	}
+	if s.DatasetName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.:-]+", *s.DatasetName) {
+		invalidParams.Add(request.NewErrParamPattern("DatasetName", "[a-zA-Z0-9_.:-]+"))
+	}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDatasetName sets the DatasetName field's value.
 func (s *DescribeDatasetInput) SetDatasetName(v string) *DescribeDatasetInput {
 	s.DatasetName = &v
@@ -2303,6 +2408,24 @@ func (s *DescribeIdentityPoolUsageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeIdentityPoolUsageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeIdentityPoolUsageInput"}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityPoolId sets the IdentityPoolId field's value.
 func (s *DescribeIdentityPoolUsageInput) SetIdentityPoolId(v string) *DescribeIdentityPoolUsageInput {
 	s.IdentityPoolId = &v
@@ -2406,6 +2529,30 @@ func (s *DescribeIdentityUsageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeIdentityUsageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeIdentityUsageInput"}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityId sets the IdentityId field's value.
 func (s *DescribeIdentityUsageInput) SetIdentityId(v string) *DescribeIdentityUsageInput {
 	s.IdentityId = &v
@@ -2508,6 +2655,24 @@ func (s *GetBulkPublishDetailsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetBulkPublishDetailsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetBulkPublishDetailsInput"}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityPoolId sets the IdentityPoolId field's value.
 func (s *GetBulkPublishDetailsInput) SetIdentityPoolId(v string) *GetBulkPublishDetailsInput {
 	s.IdentityPoolId = &v
@@ -2666,6 +2831,24 @@ func (s *GetCognitoEventsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetCognitoEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetCognitoEventsInput"}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityPoolId sets the IdentityPoolId field's value.
 func (s *GetCognitoEventsInput) SetIdentityPoolId(v string) *GetCognitoEventsInput {
 	s.IdentityPoolId = &v
@@ -2758,6 +2941,24 @@ func (s *GetIdentityPoolConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetIdentityPoolConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetIdentityPoolConfigurationInput"}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityPoolId sets the IdentityPoolId field's value.
 func (s *GetIdentityPoolConfigurationInput) SetIdentityPoolId(v string) *GetIdentityPoolConfigurationInput {
 	s.IdentityPoolId = &v
@@ -3077,6 +3278,30 @@ func (s *ListDatasetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListDatasetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDatasetsInput"}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityId sets the IdentityId field's value.
 func (s *ListDatasetsInput) SetIdentityId(v string) *ListDatasetsInput {
 	s.IdentityId = &v
@@ -3393,6 +3618,36 @@ func (s *ListRecordsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListRecordsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRecordsInput"}
+	if s.DatasetName != nil && len(*s.DatasetName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatasetName", 128))
+	}
+	if s.DatasetName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.:-]+", *s.DatasetName) {
+		invalidParams.Add(request.NewErrParamPattern("DatasetName", "[a-zA-Z0-9_.:-]+"))
+	}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDatasetName sets the DatasetName field's value.
 func (s *ListRecordsInput) SetDatasetName(v string) *ListRecordsInput {
 	s.DatasetName = &v
@@ -3659,6 +3914,24 @@ func (s *PushSync) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PushSync) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PushSync"}
+	if s.RoleArn != nil && len(*s.RoleArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleArn", 2048))
+	}
+	if s.RoleArn != nil && !request.MatchParamPattern("arn:aws:iam::\\d+:role/.*", *s.RoleArn) {
+		invalidParams.Add(request.NewErrParamPattern("RoleArn", "arn:aws:iam::\\d+:role/.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationArns sets the ApplicationArns field's value.
 func (s *PushSync) SetApplicationArns(v []*string) *PushSync {
 	s.ApplicationArns = v
@@ -3860,6 +4133,30 @@ func (s *RecordPatch) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RecordPatch) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RecordPatch"}
+	if s.Key != nil && len(*s.Key) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 1024))
+	}
+	if s.Op != nil {
+		values := []string{"replace", "remove"}
+		if !request.IsParamEnumValue(*s.Op, values) {
+			invalidParams.Add(request.NewErrParamEnum("Op", *s.Op, values))
+		}
+	}
+	if s.Value != nil && len(*s.Value) > 1.048575e+06 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 1.048575e+06))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeviceLastModifiedDate sets the DeviceLastModifiedDate field's value.
 func (s *RecordPatch) SetDeviceLastModifiedDate(v time.Time) *RecordPatch {
 	s.DeviceLastModifiedDate = &v
@@ -3995,6 +4292,36 @@ func (s *RegisterDeviceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterDeviceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterDeviceInput"}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.Platform != nil {
+		values := []string{"APNS", "APNS_SANDBOX", "GCM", "ADM"}
+		if !request.IsParamEnumValue(*s.Platform, values) {
+			invalidParams.Add(request.NewErrParamEnum("Platform", *s.Platform, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityId sets the IdentityId field's value.
 func (s *RegisterDeviceInput) SetIdentityId(v string) *RegisterDeviceInput {
 	s.IdentityId = &v
@@ -4126,6 +4453,27 @@ func (s *SetCognitoEventsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetCognitoEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetCognitoEventsInput"}
+	if s.Events != nil && len(s.Events) > 1 {
+		invalidParams.Add(request.NewErrParamMaxLen("Events", 1))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEvents sets the Events field's value.
 func (s *SetCognitoEventsInput) SetEvents(v map[string]*string) *SetCognitoEventsInput {
 	s.Events = v
@@ -4229,6 +4577,34 @@ func (s *SetIdentityPoolConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetIdentityPoolConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetIdentityPoolConfigurationInput"}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.CognitoStreams != nil {
+		if err := s.CognitoStreams.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CognitoStreams", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.PushSync != nil {
+		if err := s.PushSync.ValidateExtended(); err != nil {
+			invalidParams.AddNested("PushSync", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCognitoStreams sets the CognitoStreams field's value.
 func (s *SetIdentityPoolConfigurationInput) SetCognitoStreams(v *CognitoStreams) *SetIdentityPoolConfigurationInput {
 	s.CognitoStreams = v
@@ -4404,6 +4780,39 @@ func (s *SubscribeToDatasetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SubscribeToDatasetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SubscribeToDatasetInput"}
+	if s.DatasetName != nil && len(*s.DatasetName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatasetName", 128))
+	}
+	if s.DatasetName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.:-]+", *s.DatasetName) {
+		invalidParams.Add(request.NewErrParamPattern("DatasetName", "[a-zA-Z0-9_.:-]+"))
+	}
+	if s.DeviceId != nil && len(*s.DeviceId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeviceId", 256))
+	}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDatasetName sets the DatasetName field's value.
 func (s *SubscribeToDatasetInput) SetDatasetName(v string) *SubscribeToDatasetInput {
 	s.DatasetName = &v
@@ -4547,6 +4956,39 @@ func (s *UnsubscribeFromDatasetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UnsubscribeFromDatasetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UnsubscribeFromDatasetInput"}
+	if s.DatasetName != nil && len(*s.DatasetName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatasetName", 128))
+	}
+	if s.DatasetName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.:-]+", *s.DatasetName) {
+		invalidParams.Add(request.NewErrParamPattern("DatasetName", "[a-zA-Z0-9_.:-]+"))
+	}
+	if s.DeviceId != nil && len(*s.DeviceId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeviceId", 256))
+	}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDatasetName sets the DatasetName field's value.
 func (s *UnsubscribeFromDatasetInput) SetDatasetName(v string) *UnsubscribeFromDatasetInput {
 	s.DatasetName = &v
@@ -4714,6 +5156,49 @@ func (s *UpdateRecordsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateRecordsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateRecordsInput"}
+	if s.DatasetName != nil && len(*s.DatasetName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatasetName", 128))
+	}
+	if s.DatasetName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.:-]+", *s.DatasetName) {
+		invalidParams.Add(request.NewErrParamPattern("DatasetName", "[a-zA-Z0-9_.:-]+"))
+	}
+	if s.DeviceId != nil && len(*s.DeviceId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeviceId", 256))
+	}
+	if s.IdentityId != nil && len(*s.IdentityId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityId", 55))
+	}
+	if s.IdentityId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.IdentityPoolId != nil && len(*s.IdentityPoolId) > 55 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdentityPoolId", 55))
+	}
+	if s.IdentityPoolId != nil && !request.MatchParamPattern("[\\w-]+:[0-9a-f-]+", *s.IdentityPoolId) {
+		invalidParams.Add(request.NewErrParamPattern("IdentityPoolId", "[\\w-]+:[0-9a-f-]+"))
+	}
+	if s.RecordPatches != nil {
+		for i, v := range s.RecordPatches {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "RecordPatches", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientContext sets the ClientContext field's value.
 func (s *UpdateRecordsInput) SetClientContext(v string) *UpdateRecordsInput {
 	s.ClientContext = &v
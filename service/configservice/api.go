@@ -3080,6 +3080,49 @@ func (s *ConfigRule) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ConfigRule) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConfigRule"}
+	if s.ConfigRuleName != nil && len(*s.ConfigRuleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConfigRuleName", 64))
+	}
+	if s.ConfigRuleState != nil {
+		values := []string{"ACTIVE", "DELETING", "DELETING_RESULTS", "EVALUATING"}
+		if !request.IsParamEnumValue(*s.ConfigRuleState, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConfigRuleState", *s.ConfigRuleState, values))
+		}
+	}
+	if s.Description != nil && len(*s.Description) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 256))
+	}
+	if s.InputParameters != nil && len(*s.InputParameters) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("InputParameters", 1024))
+	}
+	if s.MaximumExecutionFrequency != nil {
+		values := []string{"One_Hour", "Three_Hours", "Six_Hours", "Twelve_Hours", "TwentyFour_Hours"}
+		if !request.IsParamEnumValue(*s.MaximumExecutionFrequency, values) {
+			invalidParams.Add(request.NewErrParamEnum("MaximumExecutionFrequency", *s.MaximumExecutionFrequency, values))
+		}
+	}
+	if s.Scope != nil {
+		if err := s.Scope.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Scope", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Source != nil {
+		if err := s.Source.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Source", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigRuleArn sets the ConfigRuleArn field's value.
 func (s *ConfigRule) SetConfigRuleArn(v string) *ConfigRule {
 	s.ConfigRuleArn = &v
@@ -3321,6 +3364,24 @@ func (s ConfigSnapshotDeliveryProperties) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ConfigSnapshotDeliveryProperties) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConfigSnapshotDeliveryProperties"}
+	if s.DeliveryFrequency != nil {
+		values := []string{"One_Hour", "Three_Hours", "Six_Hours", "Twelve_Hours", "TwentyFour_Hours"}
+		if !request.IsParamEnumValue(*s.DeliveryFrequency, values) {
+			invalidParams.Add(request.NewErrParamEnum("DeliveryFrequency", *s.DeliveryFrequency, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeliveryFrequency sets the DeliveryFrequency field's value.
 func (s *ConfigSnapshotDeliveryProperties) SetDeliveryFrequency(v string) *ConfigSnapshotDeliveryProperties {
 	s.DeliveryFrequency = &v
@@ -3617,6 +3678,21 @@ func (s *ConfigurationRecorder) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ConfigurationRecorder) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConfigurationRecorder"}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *ConfigurationRecorder) SetName(v string) *ConfigurationRecorder {
 	s.Name = &v
@@ -3759,6 +3835,21 @@ func (s *DeleteConfigRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteConfigRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteConfigRuleInput"}
+	if s.ConfigRuleName != nil && len(*s.ConfigRuleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConfigRuleName", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigRuleName sets the ConfigRuleName field's value.
 func (s *DeleteConfigRuleInput) SetConfigRuleName(v string) *DeleteConfigRuleInput {
 	s.ConfigRuleName = &v
@@ -3819,6 +3910,21 @@ func (s *DeleteConfigurationRecorderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteConfigurationRecorderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteConfigurationRecorderInput"}
+	if s.ConfigurationRecorderName != nil && len(*s.ConfigurationRecorderName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConfigurationRecorderName", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigurationRecorderName sets the ConfigurationRecorderName field's value.
 func (s *DeleteConfigurationRecorderInput) SetConfigurationRecorderName(v string) *DeleteConfigurationRecorderInput {
 	s.ConfigurationRecorderName = &v
@@ -3878,6 +3984,21 @@ func (s *DeleteDeliveryChannelInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteDeliveryChannelInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDeliveryChannelInput"}
+	if s.DeliveryChannelName != nil && len(*s.DeliveryChannelName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeliveryChannelName", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeliveryChannelName sets the DeliveryChannelName field's value.
 func (s *DeleteDeliveryChannelInput) SetDeliveryChannelName(v string) *DeleteDeliveryChannelInput {
 	s.DeliveryChannelName = &v
@@ -3935,6 +4056,21 @@ func (s *DeleteEvaluationResultsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteEvaluationResultsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteEvaluationResultsInput"}
+	if s.ConfigRuleName != nil && len(*s.ConfigRuleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConfigRuleName", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigRuleName sets the ConfigRuleName field's value.
 func (s *DeleteEvaluationResultsInput) SetConfigRuleName(v string) *DeleteEvaluationResultsInput {
 	s.ConfigRuleName = &v
@@ -3995,6 +4131,21 @@ func (s *DeliverConfigSnapshotInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeliverConfigSnapshotInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeliverConfigSnapshotInput"}
+	if s.DeliveryChannelName != nil && len(*s.DeliveryChannelName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeliveryChannelName", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeliveryChannelName sets the DeliveryChannelName field's value.
 func (s *DeliverConfigSnapshotInput) SetDeliveryChannelName(v string) *DeliverConfigSnapshotInput {
 	s.DeliveryChannelName = &v
@@ -4088,6 +4239,26 @@ func (s *DeliveryChannel) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeliveryChannel) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeliveryChannel"}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.ConfigSnapshotDeliveryProperties != nil {
+		if err := s.ConfigSnapshotDeliveryProperties.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ConfigSnapshotDeliveryProperties", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigSnapshotDeliveryProperties sets the ConfigSnapshotDeliveryProperties field's value.
 func (s *DeliveryChannel) SetConfigSnapshotDeliveryProperties(v *ConfigSnapshotDeliveryProperties) *DeliveryChannel {
 	s.ConfigSnapshotDeliveryProperties = v
@@ -4202,6 +4373,24 @@ func (s DescribeComplianceByConfigRuleInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeComplianceByConfigRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeComplianceByConfigRuleInput"}
+	if s.ComplianceTypes != nil && len(s.ComplianceTypes) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("ComplianceTypes", 3))
+	}
+	if s.ConfigRuleNames != nil && len(s.ConfigRuleNames) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConfigRuleNames", 25))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComplianceTypes sets the ComplianceTypes field's value.
 func (s *DescribeComplianceByConfigRuleInput) SetComplianceTypes(v []*string) *DescribeComplianceByConfigRuleInput {
 	s.ComplianceTypes = v
@@ -4309,6 +4498,30 @@ func (s *DescribeComplianceByResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeComplianceByResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeComplianceByResourceInput"}
+	if s.ComplianceTypes != nil && len(s.ComplianceTypes) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("ComplianceTypes", 3))
+	}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+	if s.ResourceId != nil && len(*s.ResourceId) > 768 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 768))
+	}
+	if s.ResourceType != nil && len(*s.ResourceType) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceType", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComplianceTypes sets the ComplianceTypes field's value.
 func (s *DescribeComplianceByResourceInput) SetComplianceTypes(v []*string) *DescribeComplianceByResourceInput {
 	s.ComplianceTypes = v
@@ -4408,6 +4621,24 @@ func (s DescribeConfigRuleEvaluationStatusInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeConfigRuleEvaluationStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeConfigRuleEvaluationStatusInput"}
+	if s.ConfigRuleNames != nil && len(s.ConfigRuleNames) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConfigRuleNames", 25))
+	}
+	if s.Limit != nil && *s.Limit > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigRuleNames sets the ConfigRuleNames field's value.
 func (s *DescribeConfigRuleEvaluationStatusInput) SetConfigRuleNames(v []*string) *DescribeConfigRuleEvaluationStatusInput {
 	s.ConfigRuleNames = v
@@ -4483,6 +4714,21 @@ func (s DescribeConfigRulesInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeConfigRulesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeConfigRulesInput"}
+	if s.ConfigRuleNames != nil && len(s.ConfigRuleNames) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConfigRuleNames", 25))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigRuleNames sets the ConfigRuleNames field's value.
 func (s *DescribeConfigRulesInput) SetConfigRuleNames(v []*string) *DescribeConfigRulesInput {
 	s.ConfigRuleNames = v
@@ -4816,6 +5062,33 @@ func (s *Evaluation) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Evaluation) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Evaluation"}
+	if s.Annotation != nil && len(*s.Annotation) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Annotation", 256))
+	}
+	if s.ComplianceResourceId != nil && len(*s.ComplianceResourceId) > 768 {
+		invalidParams.Add(request.NewErrParamMaxLen("ComplianceResourceId", 768))
+	}
+	if s.ComplianceResourceType != nil && len(*s.ComplianceResourceType) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ComplianceResourceType", 256))
+	}
+	if s.ComplianceType != nil {
+		values := []string{"COMPLIANT", "NON_COMPLIANT", "NOT_APPLICABLE", "INSUFFICIENT_DATA"}
+		if !request.IsParamEnumValue(*s.ComplianceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ComplianceType", *s.ComplianceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAnnotation sets the Annotation field's value.
 func (s *Evaluation) SetAnnotation(v string) *Evaluation {
 	s.Annotation = &v
@@ -5057,6 +5330,27 @@ func (s *GetComplianceDetailsByConfigRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetComplianceDetailsByConfigRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetComplianceDetailsByConfigRuleInput"}
+	if s.ComplianceTypes != nil && len(s.ComplianceTypes) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("ComplianceTypes", 3))
+	}
+	if s.ConfigRuleName != nil && len(*s.ConfigRuleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConfigRuleName", 64))
+	}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComplianceTypes sets the ComplianceTypes field's value.
 func (s *GetComplianceDetailsByConfigRuleInput) SetComplianceTypes(v []*string) *GetComplianceDetailsByConfigRuleInput {
 	s.ComplianceTypes = v
@@ -5172,6 +5466,27 @@ func (s *GetComplianceDetailsByResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetComplianceDetailsByResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetComplianceDetailsByResourceInput"}
+	if s.ComplianceTypes != nil && len(s.ComplianceTypes) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("ComplianceTypes", 3))
+	}
+	if s.ResourceId != nil && len(*s.ResourceId) > 768 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 768))
+	}
+	if s.ResourceType != nil && len(*s.ResourceType) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceType", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComplianceTypes sets the ComplianceTypes field's value.
 func (s *GetComplianceDetailsByResourceInput) SetComplianceTypes(v []*string) *GetComplianceDetailsByResourceInput {
 	s.ComplianceTypes = v
@@ -5293,6 +5608,21 @@ func (s GetComplianceSummaryByResourceTypeInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetComplianceSummaryByResourceTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetComplianceSummaryByResourceTypeInput"}
+	if s.ResourceTypes != nil && len(s.ResourceTypes) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceTypes", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceTypes sets the ResourceTypes field's value.
 func (s *GetComplianceSummaryByResourceTypeInput) SetResourceTypes(v []*string) *GetComplianceSummaryByResourceTypeInput {
 	s.ResourceTypes = v
@@ -5361,6 +5691,24 @@ func (s GetDiscoveredResourceCountsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDiscoveredResourceCountsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDiscoveredResourceCountsInput"}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+	if s.ResourceTypes != nil && len(s.ResourceTypes) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceTypes", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *GetDiscoveredResourceCountsInput) SetLimit(v int64) *GetDiscoveredResourceCountsInput {
 	s.Limit = &v
@@ -5500,6 +5848,33 @@ func (s *GetResourceConfigHistoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetResourceConfigHistoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetResourceConfigHistoryInput"}
+	if s.ChronologicalOrder != nil {
+		values := []string{"Reverse", "Forward"}
+		if !request.IsParamEnumValue(*s.ChronologicalOrder, values) {
+			invalidParams.Add(request.NewErrParamEnum("ChronologicalOrder", *s.ChronologicalOrder, values))
+		}
+	}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+	if s.ResourceType != nil {
+		values := []string{"AWS::EC2::CustomerGateway", "AWS::EC2::EIP", "AWS::EC2::Host", "AWS::EC2::Instance", "AWS::EC2::InternetGateway", "AWS::EC2::NetworkAcl", "AWS::EC2::NetworkInterface", "AWS::EC2::RouteTable", "AWS::EC2::SecurityGroup", "AWS::EC2::Subnet", "AWS::CloudTrail::Trail", "AWS::EC2::Volume", "AWS::EC2::VPC", "AWS::EC2::VPNConnection", "AWS::EC2::VPNGateway", "AWS::IAM::Group", "AWS::IAM::Policy", "AWS::IAM::Role", "AWS::IAM::User", "AWS::ACM::Certificate", "AWS::RDS::DBInstance", "AWS::RDS::DBSubnetGroup", "AWS::RDS::DBSecurityGroup", "AWS::RDS::DBSnapshot", "AWS::RDS::EventSubscription", "AWS::ElasticLoadBalancingV2::LoadBalancer", "AWS::S3::Bucket", "AWS::SSM::ManagedInstanceInventory", "AWS::Redshift::Cluster", "AWS::Redshift::ClusterSnapshot", "AWS::Redshift::ClusterParameterGroup", "AWS::Redshift::ClusterSecurityGroup", "AWS::Redshift::ClusterSubnetGroup", "AWS::Redshift::EventSubscription", "AWS::CloudWatch::Alarm", "AWS::CloudFormation::Stack", "AWS::DynamoDB::Table", "AWS::AutoScaling::AutoScalingGroup", "AWS::AutoScaling::LaunchConfiguration", "AWS::AutoScaling::ScalingPolicy", "AWS::AutoScaling::ScheduledAction"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChronologicalOrder sets the ChronologicalOrder field's value.
 func (s *GetResourceConfigHistoryInput) SetChronologicalOrder(v string) *GetResourceConfigHistoryInput {
 	s.ChronologicalOrder = &v
@@ -5633,6 +6008,27 @@ func (s *ListDiscoveredResourcesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListDiscoveredResourcesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDiscoveredResourcesInput"}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+	if s.ResourceType != nil {
+		values := []string{"AWS::EC2::CustomerGateway", "AWS::EC2::EIP", "AWS::EC2::Host", "AWS::EC2::Instance", "AWS::EC2::InternetGateway", "AWS::EC2::NetworkAcl", "AWS::EC2::NetworkInterface", "AWS::EC2::RouteTable", "AWS::EC2::SecurityGroup", "AWS::EC2::Subnet", "AWS::CloudTrail::Trail", "AWS::EC2::Volume", "AWS::EC2::VPC", "AWS::EC2::VPNConnection", "AWS::EC2::VPNGateway", "AWS::IAM::Group", "AWS::IAM::Policy", "AWS::IAM::Role", "AWS::IAM::User", "AWS::ACM::Certificate", "AWS::RDS::DBInstance", "AWS::RDS::DBSubnetGroup", "AWS::RDS::DBSecurityGroup", "AWS::RDS::DBSnapshot", "AWS::RDS::EventSubscription", "AWS::ElasticLoadBalancingV2::LoadBalancer", "AWS::S3::Bucket", "AWS::SSM::ManagedInstanceInventory", "AWS::Redshift::Cluster", "AWS::Redshift::ClusterSnapshot", "AWS::Redshift::ClusterParameterGroup", "AWS::Redshift::ClusterSecurityGroup", "AWS::Redshift::ClusterSubnetGroup", "AWS::Redshift::EventSubscription", "AWS::CloudWatch::Alarm", "AWS::CloudFormation::Stack", "AWS::DynamoDB::Table", "AWS::AutoScaling::AutoScalingGroup", "AWS::AutoScaling::LaunchConfiguration", "AWS::AutoScaling::ScalingPolicy", "AWS::AutoScaling::ScheduledAction"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIncludeDeletedResources sets the IncludeDeletedResources field's value.
 func (s *ListDiscoveredResourcesInput) SetIncludeDeletedResources(v bool) *ListDiscoveredResourcesInput {
 	s.IncludeDeletedResources = &v
@@ -5742,6 +6138,23 @@ func (s *PutConfigRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutConfigRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutConfigRuleInput"}
+	if s.ConfigRule != nil {
+		if err := s.ConfigRule.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ConfigRule", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigRule sets the ConfigRule field's value.
 func (s *PutConfigRuleInput) SetConfigRule(v *ConfigRule) *PutConfigRuleInput {
 	s.ConfigRule = v
@@ -5803,6 +6216,23 @@ func (s *PutConfigurationRecorderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutConfigurationRecorderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutConfigurationRecorderInput"}
+	if s.ConfigurationRecorder != nil {
+		if err := s.ConfigurationRecorder.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ConfigurationRecorder", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigurationRecorder sets the ConfigurationRecorder field's value.
 func (s *PutConfigurationRecorderInput) SetConfigurationRecorder(v *ConfigurationRecorder) *PutConfigurationRecorderInput {
 	s.ConfigurationRecorder = v
@@ -5864,6 +6294,23 @@ func (s *PutDeliveryChannelInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutDeliveryChannelInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutDeliveryChannelInput"}
+	if s.DeliveryChannel != nil {
+		if err := s.DeliveryChannel.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DeliveryChannel", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeliveryChannel sets the DeliveryChannel field's value.
 func (s *PutDeliveryChannelInput) SetDeliveryChannel(v *DeliveryChannel) *PutDeliveryChannelInput {
 	s.DeliveryChannel = v
@@ -5943,6 +6390,31 @@ func (s *PutEvaluationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutEvaluationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutEvaluationsInput"}
+	if s.Evaluations != nil && len(s.Evaluations) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Evaluations", 100))
+	}
+	if s.Evaluations != nil {
+		for i, v := range s.Evaluations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Evaluations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEvaluations sets the Evaluations field's value.
 func (s *PutEvaluationsInput) SetEvaluations(v []*Evaluation) *PutEvaluationsInput {
 	s.Evaluations = v
@@ -6285,6 +6757,30 @@ func (s *Scope) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Scope) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Scope"}
+	if s.ComplianceResourceId != nil && len(*s.ComplianceResourceId) > 768 {
+		invalidParams.Add(request.NewErrParamMaxLen("ComplianceResourceId", 768))
+	}
+	if s.ComplianceResourceTypes != nil && len(s.ComplianceResourceTypes) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ComplianceResourceTypes", 100))
+	}
+	if s.TagKey != nil && len(*s.TagKey) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagKey", 128))
+	}
+	if s.TagValue != nil && len(*s.TagValue) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagValue", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComplianceResourceId sets the ComplianceResourceId field's value.
 func (s *Scope) SetComplianceResourceId(v string) *Scope {
 	s.ComplianceResourceId = &v
@@ -6364,6 +6860,40 @@ func (s *Source) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Source) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Source"}
+	if s.Owner != nil {
+		values := []string{"CUSTOM_LAMBDA", "AWS"}
+		if !request.IsParamEnumValue(*s.Owner, values) {
+			invalidParams.Add(request.NewErrParamEnum("Owner", *s.Owner, values))
+		}
+	}
+	if s.SourceDetails != nil && len(s.SourceDetails) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceDetails", 25))
+	}
+	if s.SourceIdentifier != nil && len(*s.SourceIdentifier) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceIdentifier", 256))
+	}
+	if s.SourceDetails != nil {
+		for i, v := range s.SourceDetails {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SourceDetails", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetOwner sets the Owner field's value.
 func (s *Source) SetOwner(v string) *Source {
 	s.Owner = &v
@@ -6436,6 +6966,36 @@ func (s SourceDetail) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SourceDetail) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SourceDetail"}
+	if s.EventSource != nil {
+		values := []string{"aws.config"}
+		if !request.IsParamEnumValue(*s.EventSource, values) {
+			invalidParams.Add(request.NewErrParamEnum("EventSource", *s.EventSource, values))
+		}
+	}
+	if s.MaximumExecutionFrequency != nil {
+		values := []string{"One_Hour", "Three_Hours", "Six_Hours", "Twelve_Hours", "TwentyFour_Hours"}
+		if !request.IsParamEnumValue(*s.MaximumExecutionFrequency, values) {
+			invalidParams.Add(request.NewErrParamEnum("MaximumExecutionFrequency", *s.MaximumExecutionFrequency, values))
+		}
+	}
+	if s.MessageType != nil {
+		values := []string{"ConfigurationItemChangeNotification", "ConfigurationSnapshotDeliveryCompleted", "ScheduledNotification", "OversizedConfigurationItemChangeNotification"}
+		if !request.IsParamEnumValue(*s.MessageType, values) {
+			invalidParams.Add(request.NewErrParamEnum("MessageType", *s.MessageType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEventSource sets the EventSource field's value.
 func (s *SourceDetail) SetEventSource(v string) *SourceDetail {
 	s.EventSource = &v
@@ -6485,6 +7045,21 @@ func (s *StartConfigRulesEvaluationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartConfigRulesEvaluationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartConfigRulesEvaluationInput"}
+	if s.ConfigRuleNames != nil && len(s.ConfigRuleNames) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConfigRuleNames", 25))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigRuleNames sets the ConfigRuleNames field's value.
 func (s *StartConfigRulesEvaluationInput) SetConfigRuleNames(v []*string) *StartConfigRulesEvaluationInput {
 	s.ConfigRuleNames = v
@@ -6545,6 +7120,21 @@ func (s *StartConfigurationRecorderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartConfigurationRecorderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartConfigurationRecorderInput"}
+	if s.ConfigurationRecorderName != nil && len(*s.ConfigurationRecorderName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConfigurationRecorderName", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigurationRecorderName sets the ConfigurationRecorderName field's value.
 func (s *StartConfigurationRecorderInput) SetConfigurationRecorderName(v string) *StartConfigurationRecorderInput {
 	s.ConfigurationRecorderName = &v
@@ -6604,6 +7194,21 @@ func (s *StopConfigurationRecorderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopConfigurationRecorderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopConfigurationRecorderInput"}
+	if s.ConfigurationRecorderName != nil && len(*s.ConfigurationRecorderName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConfigurationRecorderName", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigurationRecorderName sets the ConfigurationRecorderName field's value.
 func (s *StopConfigurationRecorderInput) SetConfigurationRecorderName(v string) *StopConfigurationRecorderInput {
 	s.ConfigurationRecorderName = &v
@@ -339,6 +339,24 @@ func (s DeleteReportDefinitionInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteReportDefinitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteReportDefinitionInput"}
+	if s.ReportName != nil && len(*s.ReportName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ReportName", 256))
+	}
+	if s.ReportName != nil && !request.MatchParamPattern("[0-9A-Za-z!\\-_.*\\'()]+", *s.ReportName) {
+		invalidParams.Add(request.NewErrParamPattern("ReportName", "[0-9A-Za-z!\\-_.*\\'()]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetReportName sets the ReportName field's value.
 func (s *DeleteReportDefinitionInput) SetReportName(v string) *DeleteReportDefinitionInput {
 	s.ReportName = &v
@@ -405,6 +423,21 @@ func (s *DescribeReportDefinitionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeReportDefinitionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeReportDefinitionsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 5 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 5))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *DescribeReportDefinitionsInput) SetMaxResults(v int64) *DescribeReportDefinitionsInput {
 	s.MaxResults = &v
@@ -492,6 +525,23 @@ func (s *PutReportDefinitionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutReportDefinitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutReportDefinitionInput"}
+	if s.ReportDefinition != nil {
+		if err := s.ReportDefinition.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ReportDefinition", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetReportDefinition sets the ReportDefinition field's value.
 func (s *PutReportDefinitionInput) SetReportDefinition(v *ReportDefinition) *PutReportDefinitionInput {
 	s.ReportDefinition = v
@@ -610,6 +660,57 @@ func (s *ReportDefinition) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReportDefinition) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReportDefinition"}
+	if s.Compression != nil {
+		values := []string{"ZIP", "GZIP"}
+		if !request.IsParamEnumValue(*s.Compression, values) {
+			invalidParams.Add(request.NewErrParamEnum("Compression", *s.Compression, values))
+		}
+	}
+	if s.Format != nil {
+		values := []string{"textORcsv"}
+		if !request.IsParamEnumValue(*s.Format, values) {
+			invalidParams.Add(request.NewErrParamEnum("Format", *s.Format, values))
+		}
+	}
+	if s.ReportName != nil && len(*s.ReportName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ReportName", 256))
+	}
+	if s.ReportName != nil && !request.MatchParamPattern("[0-9A-Za-z!\\-_.*\\'()]+", *s.ReportName) {
+		invalidParams.Add(request.NewErrParamPattern("ReportName", "[0-9A-Za-z!\\-_.*\\'()]+"))
+	}
+	if s.S3Bucket != nil && len(*s.S3Bucket) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3Bucket", 256))
+	}
+	if s.S3Prefix != nil && len(*s.S3Prefix) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3Prefix", 256))
+	}
+	if s.S3Prefix != nil && !request.MatchParamPattern("[0-9A-Za-z!\\-_.*\\'()/]*", *s.S3Prefix) {
+		invalidParams.Add(request.NewErrParamPattern("S3Prefix", "[0-9A-Za-z!\\-_.*\\'()/]*"))
+	}
+	if s.S3Region != nil {
+		values := []string{"us-east-1", "us-west-1", "us-west-2", "eu-central-1", "eu-west-1", "ap-southeast-1", "ap-southeast-2", "ap-northeast-1"}
+		if !request.IsParamEnumValue(*s.S3Region, values) {
+			invalidParams.Add(request.NewErrParamEnum("S3Region", *s.S3Region, values))
+		}
+	}
+	if s.TimeUnit != nil {
+		values := []string{"HOURLY", "DAILY"}
+		if !request.IsParamEnumValue(*s.TimeUnit, values) {
+			invalidParams.Add(request.NewErrParamEnum("TimeUnit", *s.TimeUnit, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdditionalArtifacts sets the AdditionalArtifacts field's value.
 func (s *ReportDefinition) SetAdditionalArtifacts(v []*string) *ReportDefinition {
 	s.AdditionalArtifacts = v
@@ -121,9 +121,10 @@ const opCreateEndpoint = "CreateEndpoint"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/dms-2016-01-01/CreateEndpoint
 func (c *DatabaseMigrationService) CreateEndpointRequest(input *CreateEndpointInput) (req *request.Request, output *CreateEndpointOutput) {
 	op := &request.Operation{
-		Name:       opCreateEndpoint,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opCreateEndpoint,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Endpoint.MongoDbSettings.Password"},
 	}
 
 	if input == nil {
@@ -704,9 +705,10 @@ const opDeleteEndpoint = "DeleteEndpoint"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/dms-2016-01-01/DeleteEndpoint
 func (c *DatabaseMigrationService) DeleteEndpointRequest(input *DeleteEndpointInput) (req *request.Request, output *DeleteEndpointOutput) {
 	op := &request.Operation{
-		Name:       opDeleteEndpoint,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDeleteEndpoint,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Endpoint.MongoDbSettings.Password"},
 	}
 
 	if input == nil {
@@ -1614,6 +1616,7 @@ func (c *DatabaseMigrationService) DescribeEndpointsRequest(input *DescribeEndpo
 			LimitToken:      "MaxRecords",
 			TruncationToken: "",
 		},
+		SensitiveOutputPaths: []string{"Endpoints[*].MongoDbSettings.Password"},
 	}
 
 	if input == nil {
@@ -3150,9 +3153,10 @@ const opModifyEndpoint = "ModifyEndpoint"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/dms-2016-01-01/ModifyEndpoint
 func (c *DatabaseMigrationService) ModifyEndpointRequest(input *ModifyEndpointInput) (req *request.Request, output *ModifyEndpointOutput) {
 	op := &request.Operation{
-		Name:       opModifyEndpoint,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opModifyEndpoint,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Endpoint.MongoDbSettings.Password"},
 	}
 
 	if input == nil {
@@ -4474,7 +4478,7 @@ type CreateEndpointInput struct {
 	MongoDbSettings *MongoDbSettings `type:"structure"`
 
 	// The password to be used to login to the endpoint database.
-	Password *string `type:"string"`
+	Password *string `type:"string" sensitive:"true"`
 
 	// The port used by the endpoint database.
 	Port *int64 `type:"integer"`
@@ -4535,6 +4539,40 @@ func (s *CreateEndpointInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateEndpointInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateEndpointInput"}
+	if s.EndpointType != nil {
+		values := []string{"source", "target"}
+		if !request.IsParamEnumValue(*s.EndpointType, values) {
+			invalidParams.Add(request.NewErrParamEnum("EndpointType", *s.EndpointType, values))
+		}
+	}
+	if s.SslMode != nil {
+		values := []string{"none", "require", "verify-ca", "verify-full"}
+		if !request.IsParamEnumValue(*s.SslMode, values) {
+			invalidParams.Add(request.NewErrParamEnum("SslMode", *s.SslMode, values))
+		}
+	}
+	if s.MongoDbSettings != nil {
+		if err := s.MongoDbSettings.ValidateExtended(); err != nil {
+			invalidParams.AddNested("MongoDbSettings", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3Settings != nil {
+		if err := s.S3Settings.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3Settings", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateArn sets the CertificateArn field's value.
 func (s *CreateEndpointInput) SetCertificateArn(v string) *CreateEndpointInput {
 	s.CertificateArn = &v
@@ -5214,6 +5252,24 @@ func (s *CreateReplicationTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateReplicationTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateReplicationTaskInput"}
+	if s.MigrationType != nil {
+		values := []string{"full-load", "cdc", "full-load-and-cdc"}
+		if !request.IsParamEnumValue(*s.MigrationType, values) {
+			invalidParams.Add(request.NewErrParamEnum("MigrationType", *s.MigrationType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCdcStartTime sets the CdcStartTime field's value.
 func (s *CreateReplicationTaskInput) SetCdcStartTime(v time.Time) *CreateReplicationTaskInput {
 	s.CdcStartTime = &v
@@ -6390,6 +6446,24 @@ func (s *DescribeEventsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEventsInput"}
+	if s.SourceType != nil {
+		values := []string{"replication-instance"}
+		if !request.IsParamEnumValue(*s.SourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("SourceType", *s.SourceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDuration sets the Duration field's value.
 func (s *DescribeEventsInput) SetDuration(v int64) *DescribeEventsInput {
 	s.Duration = &v
@@ -7836,7 +7910,7 @@ type ModifyEndpointInput struct {
 	MongoDbSettings *MongoDbSettings `type:"structure"`
 
 	// The password to be used to login to the endpoint database.
-	Password *string `type:"string"`
+	Password *string `type:"string" sensitive:"true"`
 
 	// The port used by the endpoint database.
 	Port *int64 `type:"integer"`
@@ -7888,6 +7962,40 @@ func (s *ModifyEndpointInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyEndpointInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyEndpointInput"}
+	if s.EndpointType != nil {
+		values := []string{"source", "target"}
+		if !request.IsParamEnumValue(*s.EndpointType, values) {
+			invalidParams.Add(request.NewErrParamEnum("EndpointType", *s.EndpointType, values))
+		}
+	}
+	if s.SslMode != nil {
+		values := []string{"none", "require", "verify-ca", "verify-full"}
+		if !request.IsParamEnumValue(*s.SslMode, values) {
+			invalidParams.Add(request.NewErrParamEnum("SslMode", *s.SslMode, values))
+		}
+	}
+	if s.MongoDbSettings != nil {
+		if err := s.MongoDbSettings.ValidateExtended(); err != nil {
+			invalidParams.AddNested("MongoDbSettings", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3Settings != nil {
+		if err := s.S3Settings.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3Settings", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateArn sets the CertificateArn field's value.
 func (s *ModifyEndpointInput) SetCertificateArn(v string) *ModifyEndpointInput {
 	s.CertificateArn = &v
@@ -8442,6 +8550,24 @@ func (s *ModifyReplicationTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyReplicationTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyReplicationTaskInput"}
+	if s.MigrationType != nil {
+		values := []string{"full-load", "cdc", "full-load-and-cdc"}
+		if !request.IsParamEnumValue(*s.MigrationType, values) {
+			invalidParams.Add(request.NewErrParamEnum("MigrationType", *s.MigrationType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCdcStartTime sets the CdcStartTime field's value.
 func (s *ModifyReplicationTaskInput) SetCdcStartTime(v time.Time) *ModifyReplicationTaskInput {
 	s.CdcStartTime = &v
@@ -8551,7 +8677,7 @@ type MongoDbSettings struct {
 	NestingLevel *string `type:"string" enum:"NestingLevelValue"`
 
 	// The password for the user account you use to access the MongoDB source endpoint.
-	Password *string `type:"string"`
+	Password *string `type:"string" sensitive:"true"`
 
 	// The port value for the MongoDB source endpoint.
 	Port *int64 `type:"integer"`
@@ -8573,6 +8699,36 @@ func (s MongoDbSettings) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MongoDbSettings) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MongoDbSettings"}
+	if s.AuthMechanism != nil {
+		values := []string{"default", "mongodb_cr", "scram_sha_1"}
+		if !request.IsParamEnumValue(*s.AuthMechanism, values) {
+			invalidParams.Add(request.NewErrParamEnum("AuthMechanism", *s.AuthMechanism, values))
+		}
+	}
+	if s.AuthType != nil {
+		values := []string{"no", "password"}
+		if !request.IsParamEnumValue(*s.AuthType, values) {
+			invalidParams.Add(request.NewErrParamEnum("AuthType", *s.AuthType, values))
+		}
+	}
+	if s.NestingLevel != nil {
+		values := []string{"none", "one"}
+		if !request.IsParamEnumValue(*s.NestingLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("NestingLevel", *s.NestingLevel, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthMechanism sets the AuthMechanism field's value.
 func (s *MongoDbSettings) SetAuthMechanism(v string) *MongoDbSettings {
 	s.AuthMechanism = &v
@@ -9610,6 +9766,24 @@ func (s S3Settings) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *S3Settings) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "S3Settings"}
+	if s.CompressionType != nil {
+		values := []string{"none", "gzip"}
+		if !request.IsParamEnumValue(*s.CompressionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("CompressionType", *s.CompressionType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucketFolder sets the BucketFolder field's value.
 func (s *S3Settings) SetBucketFolder(v string) *S3Settings {
 	s.BucketFolder = &v
@@ -9696,6 +9870,24 @@ func (s *StartReplicationTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartReplicationTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartReplicationTaskInput"}
+	if s.StartReplicationTaskType != nil {
+		values := []string{"start-replication", "resume-processing", "reload-target"}
+		if !request.IsParamEnumValue(*s.StartReplicationTaskType, values) {
+			invalidParams.Add(request.NewErrParamEnum("StartReplicationTaskType", *s.StartReplicationTaskType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCdcStartTime sets the CdcStartTime field's value.
 func (s *StartReplicationTaskInput) SetCdcStartTime(v time.Time) *StartReplicationTaskInput {
 	s.CdcStartTime = &v
@@ -2034,6 +2034,31 @@ func (s *ActivatePipelineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ActivatePipelineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActivatePipelineInput"}
+	if s.PipelineId != nil && len(*s.PipelineId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineId", 1024))
+	}
+	if s.ParameterValues != nil {
+		for i, v := range s.ParameterValues {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ParameterValues", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetParameterValues sets the ParameterValues field's value.
 func (s *ActivatePipelineInput) SetParameterValues(v []*ParameterValue) *ActivatePipelineInput {
 	s.ParameterValues = v
@@ -2123,6 +2148,34 @@ func (s *AddTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddTagsInput"}
+	if s.PipelineId != nil && len(*s.PipelineId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineId", 1024))
+	}
+	if s.Tags != nil && len(s.Tags) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 10))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPipelineId sets the PipelineId field's value.
 func (s *AddTagsInput) SetPipelineId(v string) *AddTagsInput {
 	s.PipelineId = &v
@@ -2230,6 +2283,40 @@ func (s *CreatePipelineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePipelineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePipelineInput"}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+	if s.Tags != nil && len(s.Tags) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 10))
+	}
+	if s.UniqueId != nil && len(*s.UniqueId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("UniqueId", 1024))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *CreatePipelineInput) SetDescription(v string) *CreatePipelineInput {
 	s.Description = &v
@@ -2324,6 +2411,21 @@ func (s *DeactivatePipelineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeactivatePipelineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeactivatePipelineInput"}
+	if s.PipelineId != nil && len(*s.PipelineId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineId", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCancelActive sets the CancelActive field's value.
 func (s *DeactivatePipelineInput) SetCancelActive(v bool) *DeactivatePipelineInput {
 	s.CancelActive = &v
@@ -2389,6 +2491,21 @@ func (s *DeletePipelineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeletePipelineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePipelineInput"}
+	if s.PipelineId != nil && len(*s.PipelineId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineId", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPipelineId sets the PipelineId field's value.
 func (s *DeletePipelineInput) SetPipelineId(v string) *DeletePipelineInput {
 	s.PipelineId = &v
@@ -2466,6 +2583,24 @@ func (s *DescribeObjectsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeObjectsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeObjectsInput"}
+	if s.Marker != nil && len(*s.Marker) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 1024))
+	}
+	if s.PipelineId != nil && len(*s.PipelineId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineId", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEvaluateExpressions sets the EvaluateExpressions field's value.
 func (s *DescribeObjectsInput) SetEvaluateExpressions(v bool) *DescribeObjectsInput {
 	s.EvaluateExpressions = &v
@@ -2661,6 +2796,27 @@ func (s *EvaluateExpressionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EvaluateExpressionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EvaluateExpressionInput"}
+	if s.Expression != nil && len(*s.Expression) > 2.097152e+07 {
+		invalidParams.Add(request.NewErrParamMaxLen("Expression", 2.097152e+07))
+	}
+	if s.ObjectId != nil && len(*s.ObjectId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("ObjectId", 1024))
+	}
+	if s.PipelineId != nil && len(*s.PipelineId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineId", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExpression sets the Expression field's value.
 func (s *EvaluateExpressionInput) SetExpression(v string) *EvaluateExpressionInput {
 	s.Expression = &v
@@ -2754,6 +2910,27 @@ func (s *Field) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Field) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Field"}
+	if s.Key != nil && len(*s.Key) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 256))
+	}
+	if s.RefValue != nil && len(*s.RefValue) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RefValue", 256))
+	}
+	if s.StringValue != nil && len(*s.StringValue) > 10240 {
+		invalidParams.Add(request.NewErrParamMaxLen("StringValue", 10240))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Field) SetKey(v string) *Field {
 	s.Key = &v
@@ -2814,6 +2991,24 @@ func (s *GetPipelineDefinitionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetPipelineDefinitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPipelineDefinitionInput"}
+	if s.PipelineId != nil && len(*s.PipelineId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineId", 1024))
+	}
+	if s.Version != nil && len(*s.Version) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPipelineId sets the PipelineId field's value.
 func (s *GetPipelineDefinitionInput) SetPipelineId(v string) *GetPipelineDefinitionInput {
 	s.PipelineId = &v
@@ -2899,6 +3094,24 @@ func (s InstanceIdentity) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InstanceIdentity) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InstanceIdentity"}
+	if s.Document != nil && len(*s.Document) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Document", 1024))
+	}
+	if s.Signature != nil && len(*s.Signature) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Signature", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDocument sets the Document field's value.
 func (s *InstanceIdentity) SetDocument(v string) *InstanceIdentity {
 	s.Document = &v
@@ -2933,6 +3146,21 @@ func (s ListPipelinesInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPipelinesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPipelinesInput"}
+	if s.Marker != nil && len(*s.Marker) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListPipelinesInput) SetMarker(v string) *ListPipelinesInput {
 	s.Marker = &v
@@ -3039,6 +3267,24 @@ func (s Operator) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Operator) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Operator"}
+	if s.Type != nil {
+		values := []string{"EQ", "REF_EQ", "LE", "GE", "BETWEEN"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetType sets the Type field's value.
 func (s *Operator) SetType(v string) *Operator {
 	s.Type = &v
@@ -3096,6 +3342,24 @@ func (s *ParameterAttribute) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ParameterAttribute) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ParameterAttribute"}
+	if s.Key != nil && len(*s.Key) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 256))
+	}
+	if s.StringValue != nil && len(*s.StringValue) > 10240 {
+		invalidParams.Add(request.NewErrParamMaxLen("StringValue", 10240))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *ParameterAttribute) SetKey(v string) *ParameterAttribute {
 	s.Key = &v
@@ -3163,6 +3427,31 @@ func (s *ParameterObject) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ParameterObject) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ParameterObject"}
+	if s.Id != nil && len(*s.Id) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 256))
+	}
+	if s.Attributes != nil {
+		for i, v := range s.Attributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Attributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *ParameterObject) SetAttributes(v []*ParameterAttribute) *ParameterObject {
 	s.Attributes = v
@@ -3220,6 +3509,24 @@ func (s *ParameterValue) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ParameterValue) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ParameterValue"}
+	if s.Id != nil && len(*s.Id) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 256))
+	}
+	if s.StringValue != nil && len(*s.StringValue) > 10240 {
+		invalidParams.Add(request.NewErrParamMaxLen("StringValue", 10240))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *ParameterValue) SetId(v string) *ParameterValue {
 	s.Id = &v
@@ -3407,6 +3714,34 @@ func (s *PipelineObject) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PipelineObject) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PipelineObject"}
+	if s.Id != nil && len(*s.Id) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 1024))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+	if s.Fields != nil {
+		for i, v := range s.Fields {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Fields", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFields sets the Fields field's value.
 func (s *PipelineObject) SetFields(v []*Field) *PipelineObject {
 	s.Fields = v
@@ -3477,6 +3812,29 @@ func (s *PollForTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PollForTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PollForTaskInput"}
+	if s.Hostname != nil && len(*s.Hostname) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Hostname", 1024))
+	}
+	if s.WorkerGroup != nil && len(*s.WorkerGroup) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkerGroup", 1024))
+	}
+	if s.InstanceIdentity != nil {
+		if err := s.InstanceIdentity.ValidateExtended(); err != nil {
+			invalidParams.AddNested("InstanceIdentity", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHostname sets the Hostname field's value.
 func (s *PollForTaskInput) SetHostname(v string) *PollForTaskInput {
 	s.Hostname = &v
@@ -3605,6 +3963,51 @@ func (s *PutPipelineDefinitionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutPipelineDefinitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutPipelineDefinitionInput"}
+	if s.PipelineId != nil && len(*s.PipelineId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineId", 1024))
+	}
+	if s.ParameterObjects != nil {
+		for i, v := range s.ParameterObjects {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ParameterObjects", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.ParameterValues != nil {
+		for i, v := range s.ParameterValues {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ParameterValues", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.PipelineObjects != nil {
+		for i, v := range s.PipelineObjects {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PipelineObjects", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetParameterObjects sets the ParameterObjects field's value.
 func (s *PutPipelineDefinitionInput) SetParameterObjects(v []*ParameterObject) *PutPipelineDefinitionInput {
 	s.ParameterObjects = v
@@ -3696,6 +4099,28 @@ func (s Query) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Query) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Query"}
+	if s.Selectors != nil {
+		for i, v := range s.Selectors {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Selectors", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSelectors sets the Selectors field's value.
 func (s *Query) SetSelectors(v []*Selector) *Query {
 	s.Selectors = v
@@ -3764,6 +4189,32 @@ func (s *QueryObjectsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *QueryObjectsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "QueryObjectsInput"}
+	if s.Marker != nil && len(*s.Marker) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 1024))
+	}
+	if s.PipelineId != nil && len(*s.PipelineId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineId", 1024))
+	}
+	if s.Sphere != nil && len(*s.Sphere) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Sphere", 1024))
+	}
+	if s.Query != nil {
+		if err := s.Query.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Query", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *QueryObjectsInput) SetLimit(v int64) *QueryObjectsInput {
 	s.Limit = &v
@@ -3885,6 +4336,21 @@ func (s *RemoveTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveTagsInput"}
+	if s.PipelineId != nil && len(*s.PipelineId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineId", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPipelineId sets the PipelineId field's value.
 func (s *RemoveTagsInput) SetPipelineId(v string) *RemoveTagsInput {
 	s.PipelineId = &v
@@ -3965,6 +4431,31 @@ func (s *ReportTaskProgressInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReportTaskProgressInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReportTaskProgressInput"}
+	if s.TaskId != nil && len(*s.TaskId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskId", 2048))
+	}
+	if s.Fields != nil {
+		for i, v := range s.Fields {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Fields", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFields sets the Fields field's value.
 func (s *ReportTaskProgressInput) SetFields(v []*Field) *ReportTaskProgressInput {
 	s.Fields = v
@@ -4059,6 +4550,27 @@ func (s *ReportTaskRunnerHeartbeatInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReportTaskRunnerHeartbeatInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReportTaskRunnerHeartbeatInput"}
+	if s.Hostname != nil && len(*s.Hostname) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Hostname", 1024))
+	}
+	if s.TaskrunnerId != nil && len(*s.TaskrunnerId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskrunnerId", 1024))
+	}
+	if s.WorkerGroup != nil && len(*s.WorkerGroup) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkerGroup", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHostname sets the Hostname field's value.
 func (s *ReportTaskRunnerHeartbeatInput) SetHostname(v string) *ReportTaskRunnerHeartbeatInput {
 	s.Hostname = &v
@@ -4131,6 +4643,26 @@ func (s Selector) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Selector) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Selector"}
+	if s.FieldName != nil && len(*s.FieldName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("FieldName", 1024))
+	}
+	if s.Operator != nil {
+		if err := s.Operator.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Operator", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFieldName sets the FieldName field's value.
 func (s *Selector) SetFieldName(v string) *Selector {
 	s.FieldName = &v
@@ -4198,6 +4730,24 @@ func (s *SetStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetStatusInput"}
+	if s.PipelineId != nil && len(*s.PipelineId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineId", 1024))
+	}
+	if s.Status != nil && len(*s.Status) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Status", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetObjectIds sets the ObjectIds field's value.
 func (s *SetStatusInput) SetObjectIds(v []*string) *SetStatusInput {
 	s.ObjectIds = v
@@ -4296,6 +4846,33 @@ func (s *SetTaskStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetTaskStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetTaskStatusInput"}
+	if s.ErrorId != nil && len(*s.ErrorId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("ErrorId", 1024))
+	}
+	if s.ErrorStackTrace != nil && len(*s.ErrorStackTrace) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("ErrorStackTrace", 1024))
+	}
+	if s.TaskId != nil && len(*s.TaskId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskId", 2048))
+	}
+	if s.TaskStatus != nil {
+		values := []string{"FINISHED", "FAILED", "FALSE"}
+		if !request.IsParamEnumValue(*s.TaskStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("TaskStatus", *s.TaskStatus, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetErrorId sets the ErrorId field's value.
 func (s *SetTaskStatusInput) SetErrorId(v string) *SetTaskStatusInput {
 	s.ErrorId = &v
@@ -4395,6 +4972,24 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -4543,6 +5138,51 @@ func (s *ValidatePipelineDefinitionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ValidatePipelineDefinitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ValidatePipelineDefinitionInput"}
+	if s.PipelineId != nil && len(*s.PipelineId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PipelineId", 1024))
+	}
+	if s.ParameterObjects != nil {
+		for i, v := range s.ParameterObjects {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ParameterObjects", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.ParameterValues != nil {
+		for i, v := range s.ParameterValues {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ParameterValues", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.PipelineObjects != nil {
+		for i, v := range s.PipelineObjects {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PipelineObjects", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetParameterObjects sets the ParameterObjects field's value.
 func (s *ValidatePipelineDefinitionInput) SetParameterObjects(v []*ParameterObject) *ValidatePipelineDefinitionInput {
 	s.ParameterObjects = v
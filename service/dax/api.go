@@ -2982,6 +2982,24 @@ func (s DescribeEventsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEventsInput"}
+	if s.SourceType != nil {
+		values := []string{"CLUSTER", "PARAMETER_GROUP", "SUBNET_GROUP"}
+		if !request.IsParamEnumValue(*s.SourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("SourceType", *s.SourceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDuration sets the Duration field's value.
 func (s *DescribeEventsInput) SetDuration(v int64) *DescribeEventsInput {
 	s.Duration = &v
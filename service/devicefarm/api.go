@@ -3,6 +3,7 @@
 package devicefarm
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -5551,6 +5552,34 @@ func (s *CreateDevicePoolInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDevicePoolInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDevicePoolInput"}
+	if s.Description != nil && len(*s.Description) > 16384 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 16384))
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.Rules != nil {
+		for i, v := range s.Rules {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Rules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *CreateDevicePoolInput) SetDescription(v string) *CreateDevicePoolInput {
 	s.Description = &v
@@ -5679,6 +5708,36 @@ func (s *CreateNetworkProfileInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateNetworkProfileInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateNetworkProfileInput"}
+	if s.Description != nil && len(*s.Description) > 16384 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 16384))
+	}
+	if s.DownlinkLossPercent != nil && *s.DownlinkLossPercent > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("DownlinkLossPercent", 100))
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.Type != nil {
+		values := []string{"CURATED", "PRIVATE"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.UplinkLossPercent != nil && *s.UplinkLossPercent > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("UplinkLossPercent", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *CreateNetworkProfileInput) SetDescription(v string) *CreateNetworkProfileInput {
 	s.Description = &v
@@ -5814,6 +5873,21 @@ func (s *CreateProjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateProjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateProjectInput"}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultJobTimeoutMinutes sets the DefaultJobTimeoutMinutes field's value.
 func (s *CreateProjectInput) SetDefaultJobTimeoutMinutes(v int64) *CreateProjectInput {
 	s.DefaultJobTimeoutMinutes = &v
@@ -5871,6 +5945,24 @@ func (s CreateRemoteAccessSessionConfiguration) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateRemoteAccessSessionConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateRemoteAccessSessionConfiguration"}
+	if s.BillingMethod != nil {
+		values := []string{"METERED", "UNMETERED"}
+		if !request.IsParamEnumValue(*s.BillingMethod, values) {
+			invalidParams.Add(request.NewErrParamEnum("BillingMethod", *s.BillingMethod, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBillingMethod sets the BillingMethod field's value.
 func (s *CreateRemoteAccessSessionConfiguration) SetBillingMethod(v string) *CreateRemoteAccessSessionConfiguration {
 	s.BillingMethod = &v
@@ -5948,6 +6040,32 @@ func (s *CreateRemoteAccessSessionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateRemoteAccessSessionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateRemoteAccessSessionInput"}
+	if s.ClientId != nil && len(*s.ClientId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientId", 64))
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.SshPublicKey != nil && len(*s.SshPublicKey) > 8192 {
+		invalidParams.Add(request.NewErrParamMaxLen("SshPublicKey", 8192))
+	}
+	if s.Configuration != nil {
+		if err := s.Configuration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Configuration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientId sets the ClientId field's value.
 func (s *CreateRemoteAccessSessionInput) SetClientId(v string) *CreateRemoteAccessSessionInput {
 	s.ClientId = &v
@@ -6115,6 +6233,30 @@ func (s *CreateUploadInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateUploadInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateUploadInput"}
+	if s.ContentType != nil && len(*s.ContentType) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ContentType", 64))
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.Type != nil {
+		values := []string{"ANDROID_APP", "IOS_APP", "WEB_APP", "EXTERNAL_DATA", "APPIUM_JAVA_JUNIT_TEST_PACKAGE", "APPIUM_JAVA_TESTNG_TEST_PACKAGE", "APPIUM_PYTHON_TEST_PACKAGE", "APPIUM_WEB_JAVA_JUNIT_TEST_PACKAGE", "APPIUM_WEB_JAVA_TESTNG_TEST_PACKAGE", "APPIUM_WEB_PYTHON_TEST_PACKAGE", "CALABASH_TEST_PACKAGE", "INSTRUMENTATION_TEST_PACKAGE", "UIAUTOMATION_TEST_PACKAGE", "UIAUTOMATOR_TEST_PACKAGE", "XCTEST_TEST_PACKAGE", "XCTEST_UI_TEST_PACKAGE"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContentType sets the ContentType field's value.
 func (s *CreateUploadInput) SetContentType(v string) *CreateUploadInput {
 	s.ContentType = &v
@@ -7163,6 +7305,29 @@ func (s *GetDevicePoolCompatibilityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDevicePoolCompatibilityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDevicePoolCompatibilityInput"}
+	if s.TestType != nil {
+		values := []string{"BUILTIN_FUZZ", "BUILTIN_EXPLORER", "APPIUM_JAVA_JUNIT", "APPIUM_JAVA_TESTNG", "APPIUM_PYTHON", "APPIUM_WEB_JAVA_JUNIT", "APPIUM_WEB_JAVA_TESTNG", "APPIUM_WEB_PYTHON", "CALABASH", "INSTRUMENTATION", "UIAUTOMATION", "UIAUTOMATOR", "XCTEST", "XCTEST_UI"}
+		if !request.IsParamEnumValue(*s.TestType, values) {
+			invalidParams.Add(request.NewErrParamEnum("TestType", *s.TestType, values))
+		}
+	}
+	if s.Test != nil {
+		if err := s.Test.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Test", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAppArn sets the AppArn field's value.
 func (s *GetDevicePoolCompatibilityInput) SetAppArn(v string) *GetDevicePoolCompatibilityInput {
 	s.AppArn = &v
@@ -7458,6 +7623,21 @@ func (s *GetOfferingStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetOfferingStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetOfferingStatusInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *GetOfferingStatusInput) SetNextToken(v string) *GetOfferingStatusInput {
 	s.NextToken = &v
@@ -8308,6 +8488,27 @@ func (s *ListArtifactsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListArtifactsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListArtifactsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.Type != nil {
+		values := []string{"SCREENSHOT", "FILE", "LOG"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *ListArtifactsInput) SetArn(v string) *ListArtifactsInput {
 	s.Arn = &v
@@ -8416,6 +8617,27 @@ func (s *ListDevicePoolsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListDevicePoolsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDevicePoolsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.Type != nil {
+		values := []string{"CURATED", "PRIVATE"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *ListDevicePoolsInput) SetArn(v string) *ListDevicePoolsInput {
 	s.Arn = &v
@@ -8509,6 +8731,21 @@ func (s *ListDevicesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListDevicesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDevicesInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *ListDevicesInput) SetArn(v string) *ListDevicesInput {
 	s.Arn = &v
@@ -8601,6 +8838,21 @@ func (s *ListJobsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListJobsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListJobsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *ListJobsInput) SetArn(v string) *ListJobsInput {
 	s.Arn = &v
@@ -8697,6 +8949,27 @@ func (s *ListNetworkProfilesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListNetworkProfilesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListNetworkProfilesInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.Type != nil {
+		values := []string{"CURATED", "PRIVATE"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *ListNetworkProfilesInput) SetArn(v string) *ListNetworkProfilesInput {
 	s.Arn = &v
@@ -8781,6 +9054,21 @@ func (s *ListOfferingPromotionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListOfferingPromotionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListOfferingPromotionsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListOfferingPromotionsInput) SetNextToken(v string) *ListOfferingPromotionsInput {
 	s.NextToken = &v
@@ -8854,6 +9142,21 @@ func (s *ListOfferingTransactionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListOfferingTransactionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListOfferingTransactionsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListOfferingTransactionsInput) SetNextToken(v string) *ListOfferingTransactionsInput {
 	s.NextToken = &v
@@ -8929,6 +9232,21 @@ func (s *ListOfferingsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListOfferingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListOfferingsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListOfferingsInput) SetNextToken(v string) *ListOfferingsInput {
 	s.NextToken = &v
@@ -9011,6 +9329,21 @@ func (s *ListProjectsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListProjectsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListProjectsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *ListProjectsInput) SetArn(v string) *ListProjectsInput {
 	s.Arn = &v
@@ -9104,6 +9437,21 @@ func (s *ListRemoteAccessSessionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListRemoteAccessSessionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRemoteAccessSessionsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *ListRemoteAccessSessionsInput) SetArn(v string) *ListRemoteAccessSessionsInput {
 	s.Arn = &v
@@ -9198,6 +9546,21 @@ func (s *ListRunsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListRunsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRunsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *ListRunsInput) SetArn(v string) *ListRunsInput {
 	s.Arn = &v
@@ -9291,6 +9654,21 @@ func (s *ListSamplesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListSamplesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListSamplesInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *ListSamplesInput) SetArn(v string) *ListSamplesInput {
 	s.Arn = &v
@@ -9383,6 +9761,21 @@ func (s *ListSuitesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListSuitesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListSuitesInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *ListSuitesInput) SetArn(v string) *ListSuitesInput {
 	s.Arn = &v
@@ -9475,6 +9868,21 @@ func (s *ListTestsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTestsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTestsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *ListTestsInput) SetArn(v string) *ListTestsInput {
 	s.Arn = &v
@@ -9567,6 +9975,21 @@ func (s *ListUniqueProblemsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListUniqueProblemsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListUniqueProblemsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *ListUniqueProblemsInput) SetArn(v string) *ListUniqueProblemsInput {
 	s.Arn = &v
@@ -9676,6 +10099,21 @@ func (s *ListUploadsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListUploadsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListUploadsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *ListUploadsInput) SetArn(v string) *ListUploadsInput {
 	s.Arn = &v
@@ -10862,6 +11300,30 @@ func (s Rule) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Rule) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Rule"}
+	if s.Attribute != nil {
+		values := []string{"ARN", "PLATFORM", "FORM_FACTOR", "MANUFACTURER", "REMOTE_ACCESS_ENABLED", "REMOTE_DEBUG_ENABLED", "APPIUM_VERSION"}
+		if !request.IsParamEnumValue(*s.Attribute, values) {
+			invalidParams.Add(request.NewErrParamEnum("Attribute", *s.Attribute, values))
+		}
+	}
+	if s.Operator != nil {
+		values := []string{"EQUALS", "LESS_THAN", "GREATER_THAN", "IN", "NOT_IN", "CONTAINS"}
+		if !request.IsParamEnumValue(*s.Operator, values) {
+			invalidParams.Add(request.NewErrParamEnum("Operator", *s.Operator, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *Rule) SetAttribute(v string) *Rule {
 	s.Attribute = &v
@@ -11296,6 +11758,24 @@ func (s *ScheduleRunConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ScheduleRunConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ScheduleRunConfiguration"}
+	if s.BillingMethod != nil {
+		values := []string{"METERED", "UNMETERED"}
+		if !request.IsParamEnumValue(*s.BillingMethod, values) {
+			invalidParams.Add(request.NewErrParamEnum("BillingMethod", *s.BillingMethod, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuxiliaryApps sets the AuxiliaryApps field's value.
 func (s *ScheduleRunConfiguration) SetAuxiliaryApps(v []*string) *ScheduleRunConfiguration {
 	s.AuxiliaryApps = v
@@ -11426,6 +11906,31 @@ func (s *ScheduleRunInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ScheduleRunInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ScheduleRunInput"}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.Configuration != nil {
+		if err := s.Configuration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Configuration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Test != nil {
+		if err := s.Test.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Test", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAppArn sets the AppArn field's value.
 func (s *ScheduleRunInput) SetAppArn(v string) *ScheduleRunInput {
 	s.AppArn = &v
@@ -11643,6 +12148,27 @@ func (s *ScheduleRunTest) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ScheduleRunTest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ScheduleRunTest"}
+	if s.Filter != nil && len(*s.Filter) > 8192 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filter", 8192))
+	}
+	if s.Type != nil {
+		values := []string{"BUILTIN_FUZZ", "BUILTIN_EXPLORER", "APPIUM_JAVA_JUNIT", "APPIUM_JAVA_TESTNG", "APPIUM_PYTHON", "APPIUM_WEB_JAVA_JUNIT", "APPIUM_WEB_JAVA_TESTNG", "APPIUM_WEB_PYTHON", "CALABASH", "INSTRUMENTATION", "UIAUTOMATION", "UIAUTOMATOR", "XCTEST", "XCTEST_UI"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilter sets the Filter field's value.
 func (s *ScheduleRunTest) SetFilter(v string) *ScheduleRunTest {
 	s.Filter = &v
@@ -12289,6 +12815,34 @@ func (s *UpdateDevicePoolInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDevicePoolInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDevicePoolInput"}
+	if s.Description != nil && len(*s.Description) > 16384 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 16384))
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.Rules != nil {
+		for i, v := range s.Rules {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Rules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *UpdateDevicePoolInput) SetArn(v string) *UpdateDevicePoolInput {
 	s.Arn = &v
@@ -12413,6 +12967,36 @@ func (s *UpdateNetworkProfileInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateNetworkProfileInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateNetworkProfileInput"}
+	if s.Description != nil && len(*s.Description) > 16384 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 16384))
+	}
+	if s.DownlinkLossPercent != nil && *s.DownlinkLossPercent > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("DownlinkLossPercent", 100))
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.Type != nil {
+		values := []string{"CURATED", "PRIVATE"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.UplinkLossPercent != nil && *s.UplinkLossPercent > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("UplinkLossPercent", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *UpdateNetworkProfileInput) SetArn(v string) *UpdateNetworkProfileInput {
 	s.Arn = &v
@@ -12553,6 +13137,21 @@ func (s *UpdateProjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateProjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateProjectInput"}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArn sets the Arn field's value.
 func (s *UpdateProjectInput) SetArn(v string) *UpdateProjectInput {
 	s.Arn = &v
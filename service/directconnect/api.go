@@ -3683,6 +3683,23 @@ func (s *AllocatePrivateVirtualInterfaceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AllocatePrivateVirtualInterfaceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AllocatePrivateVirtualInterfaceInput"}
+	if s.NewPrivateVirtualInterfaceAllocation != nil {
+		if err := s.NewPrivateVirtualInterfaceAllocation.ValidateExtended(); err != nil {
+			invalidParams.AddNested("NewPrivateVirtualInterfaceAllocation", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConnectionId sets the ConnectionId field's value.
 func (s *AllocatePrivateVirtualInterfaceInput) SetConnectionId(v string) *AllocatePrivateVirtualInterfaceInput {
 	s.ConnectionId = &v
@@ -3762,6 +3779,23 @@ func (s *AllocatePublicVirtualInterfaceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AllocatePublicVirtualInterfaceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AllocatePublicVirtualInterfaceInput"}
+	if s.NewPublicVirtualInterfaceAllocation != nil {
+		if err := s.NewPublicVirtualInterfaceAllocation.ValidateExtended(); err != nil {
+			invalidParams.AddNested("NewPublicVirtualInterfaceAllocation", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConnectionId sets the ConnectionId field's value.
 func (s *AllocatePublicVirtualInterfaceInput) SetConnectionId(v string) *AllocatePublicVirtualInterfaceInput {
 	s.ConnectionId = &v
@@ -4601,6 +4635,23 @@ func (s CreateBGPPeerInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateBGPPeerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateBGPPeerInput"}
+	if s.NewBGPPeer != nil {
+		if err := s.NewBGPPeer.ValidateExtended(); err != nil {
+			invalidParams.AddNested("NewBGPPeer", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNewBGPPeer sets the NewBGPPeer field's value.
 func (s *CreateBGPPeerInput) SetNewBGPPeer(v *NewBGPPeer) *CreateBGPPeerInput {
 	s.NewBGPPeer = v
@@ -4985,6 +5036,23 @@ func (s *CreatePrivateVirtualInterfaceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePrivateVirtualInterfaceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePrivateVirtualInterfaceInput"}
+	if s.NewPrivateVirtualInterface != nil {
+		if err := s.NewPrivateVirtualInterface.ValidateExtended(); err != nil {
+			invalidParams.AddNested("NewPrivateVirtualInterface", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConnectionId sets the ConnectionId field's value.
 func (s *CreatePrivateVirtualInterfaceInput) SetConnectionId(v string) *CreatePrivateVirtualInterfaceInput {
 	s.ConnectionId = &v
@@ -5051,6 +5119,23 @@ func (s *CreatePublicVirtualInterfaceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePublicVirtualInterfaceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePublicVirtualInterfaceInput"}
+	if s.NewPublicVirtualInterface != nil {
+		if err := s.NewPublicVirtualInterface.ValidateExtended(); err != nil {
+			invalidParams.AddNested("NewPublicVirtualInterface", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConnectionId sets the ConnectionId field's value.
 func (s *CreatePublicVirtualInterfaceInput) SetConnectionId(v string) *CreatePublicVirtualInterfaceInput {
 	s.ConnectionId = &v
@@ -5459,6 +5544,24 @@ func (s *DescribeConnectionLoaInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeConnectionLoaInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeConnectionLoaInput"}
+	if s.LoaContentType != nil {
+		values := []string{"application/pdf"}
+		if !request.IsParamEnumValue(*s.LoaContentType, values) {
+			invalidParams.Add(request.NewErrParamEnum("LoaContentType", *s.LoaContentType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConnectionId sets the ConnectionId field's value.
 func (s *DescribeConnectionLoaInput) SetConnectionId(v string) *DescribeConnectionLoaInput {
 	s.ConnectionId = &v
@@ -5670,6 +5773,24 @@ func (s *DescribeInterconnectLoaInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeInterconnectLoaInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeInterconnectLoaInput"}
+	if s.LoaContentType != nil {
+		values := []string{"application/pdf"}
+		if !request.IsParamEnumValue(*s.LoaContentType, values) {
+			invalidParams.Add(request.NewErrParamEnum("LoaContentType", *s.LoaContentType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInterconnectId sets the InterconnectId field's value.
 func (s *DescribeInterconnectLoaInput) SetInterconnectId(v string) *DescribeInterconnectLoaInput {
 	s.InterconnectId = &v
@@ -5872,6 +5993,24 @@ func (s *DescribeLoaInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeLoaInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeLoaInput"}
+	if s.LoaContentType != nil {
+		values := []string{"application/pdf"}
+		if !request.IsParamEnumValue(*s.LoaContentType, values) {
+			invalidParams.Add(request.NewErrParamEnum("LoaContentType", *s.LoaContentType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConnectionId sets the ConnectionId field's value.
 func (s *DescribeLoaInput) SetConnectionId(v string) *DescribeLoaInput {
 	s.ConnectionId = &v
@@ -6590,6 +6729,24 @@ func (s NewBGPPeer) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NewBGPPeer) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NewBGPPeer"}
+	if s.AddressFamily != nil {
+		values := []string{"ipv4", "ipv6"}
+		if !request.IsParamEnumValue(*s.AddressFamily, values) {
+			invalidParams.Add(request.NewErrParamEnum("AddressFamily", *s.AddressFamily, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddressFamily sets the AddressFamily field's value.
 func (s *NewBGPPeer) SetAddressFamily(v string) *NewBGPPeer {
 	s.AddressFamily = &v
@@ -6709,6 +6866,24 @@ func (s *NewPrivateVirtualInterface) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NewPrivateVirtualInterface) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NewPrivateVirtualInterface"}
+	if s.AddressFamily != nil {
+		values := []string{"ipv4", "ipv6"}
+		if !request.IsParamEnumValue(*s.AddressFamily, values) {
+			invalidParams.Add(request.NewErrParamEnum("AddressFamily", *s.AddressFamily, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddressFamily sets the AddressFamily field's value.
 func (s *NewPrivateVirtualInterface) SetAddressFamily(v string) *NewPrivateVirtualInterface {
 	s.AddressFamily = &v
@@ -6836,6 +7011,24 @@ func (s *NewPrivateVirtualInterfaceAllocation) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NewPrivateVirtualInterfaceAllocation) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NewPrivateVirtualInterfaceAllocation"}
+	if s.AddressFamily != nil {
+		values := []string{"ipv4", "ipv6"}
+		if !request.IsParamEnumValue(*s.AddressFamily, values) {
+			invalidParams.Add(request.NewErrParamEnum("AddressFamily", *s.AddressFamily, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddressFamily sets the AddressFamily field's value.
 func (s *NewPrivateVirtualInterfaceAllocation) SetAddressFamily(v string) *NewPrivateVirtualInterfaceAllocation {
 	s.AddressFamily = &v
@@ -6960,6 +7153,24 @@ func (s *NewPublicVirtualInterface) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NewPublicVirtualInterface) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NewPublicVirtualInterface"}
+	if s.AddressFamily != nil {
+		values := []string{"ipv4", "ipv6"}
+		if !request.IsParamEnumValue(*s.AddressFamily, values) {
+			invalidParams.Add(request.NewErrParamEnum("AddressFamily", *s.AddressFamily, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddressFamily sets the AddressFamily field's value.
 func (s *NewPublicVirtualInterface) SetAddressFamily(v string) *NewPublicVirtualInterface {
 	s.AddressFamily = &v
@@ -7091,6 +7302,24 @@ func (s *NewPublicVirtualInterfaceAllocation) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NewPublicVirtualInterfaceAllocation) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NewPublicVirtualInterfaceAllocation"}
+	if s.AddressFamily != nil {
+		values := []string{"ipv4", "ipv6"}
+		if !request.IsParamEnumValue(*s.AddressFamily, values) {
+			invalidParams.Add(request.NewErrParamEnum("AddressFamily", *s.AddressFamily, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddressFamily sets the AddressFamily field's value.
 func (s *NewPublicVirtualInterfaceAllocation) SetAddressFamily(v string) *NewPublicVirtualInterfaceAllocation {
 	s.AddressFamily = &v
@@ -7244,6 +7473,30 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+	if s.Value != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Value) {
+		invalidParams.Add(request.NewErrParamPattern("Value", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -7313,6 +7566,28 @@ func (s *TagResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TagResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceArn sets the ResourceArn field's value.
 func (s *TagResourceInput) SetResourceArn(v string) *TagResourceInput {
 	s.ResourceArn = &v
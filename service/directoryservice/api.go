@@ -1656,9 +1656,10 @@ const opDescribeDirectories = "DescribeDirectories"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/ds-2015-04-16/DescribeDirectories
 func (c *DirectoryService) DescribeDirectoriesRequest(input *DescribeDirectoriesInput) (req *request.Request, output *DescribeDirectoriesOutput) {
 	op := &request.Operation{
-		Name:       opDescribeDirectories,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeDirectories,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"DirectoryDescriptions[*].RadiusSettings.SharedSecret"},
 	}
 
 	if input == nil {
@@ -3899,6 +3900,31 @@ func (s *AddIpRoutesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddIpRoutesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddIpRoutesInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+	if s.IpRoutes != nil {
+		for i, v := range s.IpRoutes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "IpRoutes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *AddIpRoutesInput) SetDirectoryId(v string) *AddIpRoutesInput {
 	s.DirectoryId = &v
@@ -3983,6 +4009,31 @@ func (s *AddTagsToResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddTagsToResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddTagsToResourceInput"}
+	if s.ResourceId != nil && !request.MatchParamPattern("^[d]-[0-9a-f]{10}$", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "^[d]-[0-9a-f]{10}$"))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *AddTagsToResourceInput) SetResourceId(v string) *AddTagsToResourceInput {
 	s.ResourceId = &v
@@ -4098,6 +4149,24 @@ func (s *CancelSchemaExtensionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CancelSchemaExtensionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelSchemaExtensionInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+	if s.SchemaExtensionId != nil && !request.MatchParamPattern("^e-[0-9a-f]{10}$", *s.SchemaExtensionId) {
+		invalidParams.Add(request.NewErrParamPattern("SchemaExtensionId", "^e-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *CancelSchemaExtensionInput) SetDirectoryId(v string) *CancelSchemaExtensionInput {
 	s.DirectoryId = &v
@@ -4241,7 +4310,7 @@ type ConnectDirectoryInput struct {
 	// The password for the on-premises user account.
 	//
 	// Password is a required field
-	Password *string `min:"1" type:"string" required:"true"`
+	Password *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The NetBIOS name of the on-premises directory, such as CORP.
 	ShortName *string `type:"string"`
@@ -4292,6 +4361,44 @@ func (s *ConnectDirectoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ConnectDirectoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConnectDirectoryInput"}
+	if s.Description != nil && len(*s.Description) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 128))
+	}
+	if s.Description != nil && !request.MatchParamPattern("^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$", *s.Description) {
+		invalidParams.Add(request.NewErrParamPattern("Description", "^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$"))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+$"))
+	}
+	if s.Password != nil && len(*s.Password) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Password", 128))
+	}
+	if s.ShortName != nil && !request.MatchParamPattern("^[^\\\\/:*?\\\"\\<\\>|.]+[^\\\\/:*?\\\"<>|]*$", *s.ShortName) {
+		invalidParams.Add(request.NewErrParamPattern("ShortName", "^[^\\\\/:*?\\\"\\<\\>|.]+[^\\\\/:*?\\\"<>|]*$"))
+	}
+	if s.Size != nil {
+		values := []string{"Small", "Large"}
+		if !request.IsParamEnumValue(*s.Size, values) {
+			invalidParams.Add(request.NewErrParamEnum("Size", *s.Size, values))
+		}
+	}
+	if s.ConnectSettings != nil {
+		if err := s.ConnectSettings.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ConnectSettings", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConnectSettings sets the ConnectSettings field's value.
 func (s *ConnectDirectoryInput) SetConnectSettings(v *DirectoryConnectSettings) *ConnectDirectoryInput {
 	s.ConnectSettings = v
@@ -4401,6 +4508,24 @@ func (s *CreateAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAliasInput"}
+	if s.Alias != nil && len(*s.Alias) > 62 {
+		invalidParams.Add(request.NewErrParamMaxLen("Alias", 62))
+	}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAlias sets the Alias field's value.
 func (s *CreateAliasInput) SetAlias(v string) *CreateAliasInput {
 	s.Alias = &v
@@ -4474,7 +4599,7 @@ type CreateComputerInput struct {
 	// should generate a random, strong password to use for this parameter.
 	//
 	// Password is a required field
-	Password *string `min:"8" type:"string" required:"true"`
+	Password *string `min:"8" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -4525,6 +4650,30 @@ func (s *CreateComputerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateComputerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateComputerInput"}
+	if s.ComputerName != nil && len(*s.ComputerName) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("ComputerName", 15))
+	}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+	if s.OrganizationalUnitDistinguishedName != nil && len(*s.OrganizationalUnitDistinguishedName) > 2000 {
+		invalidParams.Add(request.NewErrParamMaxLen("OrganizationalUnitDistinguishedName", 2000))
+	}
+	if s.Password != nil && len(*s.Password) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Password", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComputerAttributes sets the ComputerAttributes field's value.
 func (s *CreateComputerInput) SetComputerAttributes(v []*Attribute) *CreateComputerInput {
 	s.ComputerAttributes = v
@@ -4634,6 +4783,24 @@ func (s *CreateConditionalForwarderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateConditionalForwarderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateConditionalForwarderInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+	if s.RemoteDomainName != nil && !request.MatchParamPattern("^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+[.]?$", *s.RemoteDomainName) {
+		invalidParams.Add(request.NewErrParamPattern("RemoteDomainName", "^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+[.]?$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *CreateConditionalForwarderInput) SetDirectoryId(v string) *CreateConditionalForwarderInput {
 	s.DirectoryId = &v
@@ -4686,7 +4853,7 @@ type CreateDirectoryInput struct {
 	// and this password.
 	//
 	// Password is a required field
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" required:"true" sensitive:"true"`
 
 	// The short name of the directory, such as CORP.
 	ShortName *string `type:"string"`
@@ -4735,6 +4902,41 @@ func (s *CreateDirectoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDirectoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDirectoryInput"}
+	if s.Description != nil && len(*s.Description) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 128))
+	}
+	if s.Description != nil && !request.MatchParamPattern("^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$", *s.Description) {
+		invalidParams.Add(request.NewErrParamPattern("Description", "^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$"))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+$"))
+	}
+	if s.ShortName != nil && !request.MatchParamPattern("^[^\\\\/:*?\\\"\\<\\>|.]+[^\\\\/:*?\\\"<>|]*$", *s.ShortName) {
+		invalidParams.Add(request.NewErrParamPattern("ShortName", "^[^\\\\/:*?\\\"\\<\\>|.]+[^\\\\/:*?\\\"<>|]*$"))
+	}
+	if s.Size != nil {
+		values := []string{"Small", "Large"}
+		if !request.IsParamEnumValue(*s.Size, values) {
+			invalidParams.Add(request.NewErrParamEnum("Size", *s.Size, values))
+		}
+	}
+	if s.VpcSettings != nil {
+		if err := s.VpcSettings.ValidateExtended(); err != nil {
+			invalidParams.AddNested("VpcSettings", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *CreateDirectoryInput) SetDescription(v string) *CreateDirectoryInput {
 	s.Description = &v
@@ -4815,7 +5017,7 @@ type CreateMicrosoftADInput struct {
 	// The password for the default administrative user named Admin.
 	//
 	// Password is a required field
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" required:"true" sensitive:"true"`
 
 	// The NetBIOS name for your domain. A short identifier for your domain, such
 	// as CORP. If you don't specify a NetBIOS name, it will default to the first
@@ -4862,6 +5064,35 @@ func (s *CreateMicrosoftADInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateMicrosoftADInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateMicrosoftADInput"}
+	if s.Description != nil && len(*s.Description) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 128))
+	}
+	if s.Description != nil && !request.MatchParamPattern("^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$", *s.Description) {
+		invalidParams.Add(request.NewErrParamPattern("Description", "^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$"))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+$"))
+	}
+	if s.ShortName != nil && !request.MatchParamPattern("^[^\\\\/:*?\\\"\\<\\>|.]+[^\\\\/:*?\\\"<>|]*$", *s.ShortName) {
+		invalidParams.Add(request.NewErrParamPattern("ShortName", "^[^\\\\/:*?\\\"\\<\\>|.]+[^\\\\/:*?\\\"<>|]*$"))
+	}
+	if s.VpcSettings != nil {
+		if err := s.VpcSettings.ValidateExtended(); err != nil {
+			invalidParams.AddNested("VpcSettings", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *CreateMicrosoftADInput) SetDescription(v string) *CreateMicrosoftADInput {
 	s.Description = &v
@@ -4954,6 +5185,27 @@ func (s *CreateSnapshotInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateSnapshotInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateSnapshotInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *CreateSnapshotInput) SetDirectoryId(v string) *CreateSnapshotInput {
 	s.DirectoryId = &v
@@ -5027,7 +5279,7 @@ type CreateTrustInput struct {
 	// the trust relationship on the external domain.
 	//
 	// TrustPassword is a required field
-	TrustPassword *string `min:"1" type:"string" required:"true"`
+	TrustPassword *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The trust relationship type.
 	TrustType *string `type:"string" enum:"TrustType"`
@@ -5068,6 +5320,39 @@ func (s *CreateTrustInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateTrustInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTrustInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+	if s.RemoteDomainName != nil && !request.MatchParamPattern("^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+[.]?$", *s.RemoteDomainName) {
+		invalidParams.Add(request.NewErrParamPattern("RemoteDomainName", "^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+[.]?$"))
+	}
+	if s.TrustDirection != nil {
+		values := []string{"One-Way: Outgoing", "One-Way: Incoming", "Two-Way"}
+		if !request.IsParamEnumValue(*s.TrustDirection, values) {
+			invalidParams.Add(request.NewErrParamEnum("TrustDirection", *s.TrustDirection, values))
+		}
+	}
+	if s.TrustPassword != nil && len(*s.TrustPassword) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("TrustPassword", 128))
+	}
+	if s.TrustType != nil {
+		values := []string{"Forest"}
+		if !request.IsParamEnumValue(*s.TrustType, values) {
+			invalidParams.Add(request.NewErrParamEnum("TrustType", *s.TrustType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConditionalForwarderIpAddrs sets the ConditionalForwarderIpAddrs field's value.
 func (s *CreateTrustInput) SetConditionalForwarderIpAddrs(v []*string) *CreateTrustInput {
 	s.ConditionalForwarderIpAddrs = v
@@ -5172,6 +5457,24 @@ func (s *DeleteConditionalForwarderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteConditionalForwarderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteConditionalForwarderInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+	if s.RemoteDomainName != nil && !request.MatchParamPattern("^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+[.]?$", *s.RemoteDomainName) {
+		invalidParams.Add(request.NewErrParamPattern("RemoteDomainName", "^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+[.]?$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *DeleteConditionalForwarderInput) SetDirectoryId(v string) *DeleteConditionalForwarderInput {
 	s.DirectoryId = &v
@@ -5234,6 +5537,21 @@ func (s *DeleteDirectoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteDirectoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDirectoryInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *DeleteDirectoryInput) SetDirectoryId(v string) *DeleteDirectoryInput {
 	s.DirectoryId = &v
@@ -5299,6 +5617,21 @@ func (s *DeleteSnapshotInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteSnapshotInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSnapshotInput"}
+	if s.SnapshotId != nil && !request.MatchParamPattern("^s-[0-9a-f]{10}$", *s.SnapshotId) {
+		invalidParams.Add(request.NewErrParamPattern("SnapshotId", "^s-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSnapshotId sets the SnapshotId field's value.
 func (s *DeleteSnapshotInput) SetSnapshotId(v string) *DeleteSnapshotInput {
 	s.SnapshotId = &v
@@ -5368,6 +5701,21 @@ func (s *DeleteTrustInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteTrustInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTrustInput"}
+	if s.TrustId != nil && !request.MatchParamPattern("^t-[0-9a-f]{10}$", *s.TrustId) {
+		invalidParams.Add(request.NewErrParamPattern("TrustId", "^t-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeleteAssociatedConditionalForwarder sets the DeleteAssociatedConditionalForwarder field's value.
 func (s *DeleteTrustInput) SetDeleteAssociatedConditionalForwarder(v bool) *DeleteTrustInput {
 	s.DeleteAssociatedConditionalForwarder = &v
@@ -5451,6 +5799,27 @@ func (s *DeregisterEventTopicInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeregisterEventTopicInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeregisterEventTopicInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+	if s.TopicName != nil && len(*s.TopicName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("TopicName", 256))
+	}
+	if s.TopicName != nil && !request.MatchParamPattern("[a-zA-Z0-9_-]+", *s.TopicName) {
+		invalidParams.Add(request.NewErrParamPattern("TopicName", "[a-zA-Z0-9_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *DeregisterEventTopicInput) SetDirectoryId(v string) *DeregisterEventTopicInput {
 	s.DirectoryId = &v
@@ -5518,6 +5887,21 @@ func (s *DescribeConditionalForwardersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeConditionalForwardersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeConditionalForwardersInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *DescribeConditionalForwardersInput) SetDirectoryId(v string) *DescribeConditionalForwardersInput {
 	s.DirectoryId = &v
@@ -5689,6 +6073,21 @@ func (s *DescribeDomainControllersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeDomainControllersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeDomainControllersInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *DescribeDomainControllersInput) SetDirectoryId(v string) *DescribeDomainControllersInput {
 	s.DirectoryId = &v
@@ -5774,6 +6173,21 @@ func (s DescribeEventTopicsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEventTopicsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEventTopicsInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *DescribeEventTopicsInput) SetDirectoryId(v string) *DescribeEventTopicsInput {
 	s.DirectoryId = &v
@@ -5843,6 +6257,21 @@ func (s DescribeSnapshotsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeSnapshotsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeSnapshotsInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *DescribeSnapshotsInput) SetDirectoryId(v string) *DescribeSnapshotsInput {
 	s.DirectoryId = &v
@@ -5943,6 +6372,21 @@ func (s DescribeTrustsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeTrustsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeTrustsInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *DescribeTrustsInput) SetDirectoryId(v string) *DescribeTrustsInput {
 	s.DirectoryId = &v
@@ -6078,6 +6522,24 @@ func (s *DirectoryConnectSettings) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DirectoryConnectSettings) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DirectoryConnectSettings"}
+	if s.CustomerUserName != nil && !request.MatchParamPattern("[a-zA-Z0-9._-]+", *s.CustomerUserName) {
+		invalidParams.Add(request.NewErrParamPattern("CustomerUserName", "[a-zA-Z0-9._-]+"))
+	}
+	if s.VpcId != nil && !request.MatchParamPattern("^(vpc-[0-9a-f]{8})$", *s.VpcId) {
+		invalidParams.Add(request.NewErrParamPattern("VpcId", "^(vpc-[0-9a-f]{8})$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCustomerDnsIps sets the CustomerDnsIps field's value.
 func (s *DirectoryConnectSettings) SetCustomerDnsIps(v []*string) *DirectoryConnectSettings {
 	s.CustomerDnsIps = v
@@ -6514,6 +6976,21 @@ func (s *DirectoryVpcSettings) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DirectoryVpcSettings) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DirectoryVpcSettings"}
+	if s.VpcId != nil && !request.MatchParamPattern("^(vpc-[0-9a-f]{8})$", *s.VpcId) {
+		invalidParams.Add(request.NewErrParamPattern("VpcId", "^(vpc-[0-9a-f]{8})$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSubnetIds sets the SubnetIds field's value.
 func (s *DirectoryVpcSettings) SetSubnetIds(v []*string) *DirectoryVpcSettings {
 	s.SubnetIds = v
@@ -6615,6 +7092,21 @@ func (s *DisableRadiusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisableRadiusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableRadiusInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *DisableRadiusInput) SetDirectoryId(v string) *DisableRadiusInput {
 	s.DirectoryId = &v
@@ -6650,7 +7142,7 @@ type DisableSsoInput struct {
 	// The password of an alternate account to use to disable single-sign on. This
 	// is only used for AD Connector directories. For more information, see the
 	// UserName parameter.
-	Password *string `min:"1" type:"string"`
+	Password *string `min:"1" type:"string" sensitive:"true"`
 
 	// The username of an alternate account to use to disable single-sign on. This
 	// is only used for AD Connector directories. This account must have privileges
@@ -6693,6 +7185,27 @@ func (s *DisableSsoInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisableSsoInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableSsoInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+	if s.Password != nil && len(*s.Password) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Password", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[a-zA-Z0-9._-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[a-zA-Z0-9._-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *DisableSsoInput) SetDirectoryId(v string) *DisableSsoInput {
 	s.DirectoryId = &v
@@ -6880,6 +7393,26 @@ func (s *EnableRadiusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnableRadiusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableRadiusInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+	if s.RadiusSettings != nil {
+		if err := s.RadiusSettings.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RadiusSettings", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *EnableRadiusInput) SetDirectoryId(v string) *EnableRadiusInput {
 	s.DirectoryId = &v
@@ -6921,7 +7454,7 @@ type EnableSsoInput struct {
 	// The password of an alternate account to use to enable single-sign on. This
 	// is only used for AD Connector directories. For more information, see the
 	// UserName parameter.
-	Password *string `min:"1" type:"string"`
+	Password *string `min:"1" type:"string" sensitive:"true"`
 
 	// The username of an alternate account to use to enable single-sign on. This
 	// is only used for AD Connector directories. This account must have privileges
@@ -6964,6 +7497,27 @@ func (s *EnableSsoInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnableSsoInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableSsoInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+	if s.Password != nil && len(*s.Password) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Password", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[a-zA-Z0-9._-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[a-zA-Z0-9._-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *EnableSsoInput) SetDirectoryId(v string) *EnableSsoInput {
 	s.DirectoryId = &v
@@ -7136,6 +7690,21 @@ func (s *GetSnapshotLimitsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetSnapshotLimitsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetSnapshotLimitsInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *GetSnapshotLimitsInput) SetDirectoryId(v string) *GetSnapshotLimitsInput {
 	s.DirectoryId = &v
@@ -7193,6 +7762,27 @@ func (s IpRoute) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *IpRoute) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "IpRoute"}
+	if s.CidrIp != nil && !request.MatchParamPattern("^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])(\\/([1-9]|[1-2][0-9]|3[0-2]))$", *s.CidrIp) {
+		invalidParams.Add(request.NewErrParamPattern("CidrIp", "^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])(\\/([1-9]|[1-2][0-9]|3[0-2]))$"))
+	}
+	if s.Description != nil && len(*s.Description) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 128))
+	}
+	if s.Description != nil && !request.MatchParamPattern("^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$", *s.Description) {
+		invalidParams.Add(request.NewErrParamPattern("Description", "^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCidrIp sets the CidrIp field's value.
 func (s *IpRoute) SetCidrIp(v string) *IpRoute {
 	s.CidrIp = &v
@@ -7316,6 +7906,21 @@ func (s *ListIpRoutesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListIpRoutesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListIpRoutesInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *ListIpRoutesInput) SetDirectoryId(v string) *ListIpRoutesInput {
 	s.DirectoryId = &v
@@ -7410,6 +8015,21 @@ func (s *ListSchemaExtensionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListSchemaExtensionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListSchemaExtensionsInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *ListSchemaExtensionsInput) SetDirectoryId(v string) *ListSchemaExtensionsInput {
 	s.DirectoryId = &v
@@ -7502,6 +8122,21 @@ func (s *ListTagsForResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsForResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
+	if s.ResourceId != nil && !request.MatchParamPattern("^[d]-[0-9a-f]{10}$", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "^[d]-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListTagsForResourceInput) SetLimit(v int64) *ListTagsForResourceInput {
 	s.Limit = &v
@@ -7582,7 +8217,7 @@ type RadiusSettings struct {
 	RadiusTimeout *int64 `min:"1" type:"integer"`
 
 	// Not currently used.
-	SharedSecret *string `min:"8" type:"string"`
+	SharedSecret *string `min:"8" type:"string" sensitive:"true"`
 
 	// Not currently used.
 	UseSameUsername *bool `type:"boolean"`
@@ -7620,6 +8255,39 @@ func (s *RadiusSettings) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RadiusSettings) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RadiusSettings"}
+	if s.AuthenticationProtocol != nil {
+		values := []string{"PAP", "CHAP", "MS-CHAPv1", "MS-CHAPv2"}
+		if !request.IsParamEnumValue(*s.AuthenticationProtocol, values) {
+			invalidParams.Add(request.NewErrParamEnum("AuthenticationProtocol", *s.AuthenticationProtocol, values))
+		}
+	}
+	if s.DisplayLabel != nil && len(*s.DisplayLabel) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DisplayLabel", 64))
+	}
+	if s.RadiusPort != nil && *s.RadiusPort > 65535 {
+		invalidParams.Add(request.NewErrParamMaxValue("RadiusPort", 65535))
+	}
+	if s.RadiusRetries != nil && *s.RadiusRetries > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("RadiusRetries", 10))
+	}
+	if s.RadiusTimeout != nil && *s.RadiusTimeout > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("RadiusTimeout", 20))
+	}
+	if s.SharedSecret != nil && len(*s.SharedSecret) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("SharedSecret", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationProtocol sets the AuthenticationProtocol field's value.
 func (s *RadiusSettings) SetAuthenticationProtocol(v string) *RadiusSettings {
 	s.AuthenticationProtocol = &v
@@ -7714,6 +8382,27 @@ func (s *RegisterEventTopicInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterEventTopicInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterEventTopicInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+	if s.TopicName != nil && len(*s.TopicName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("TopicName", 256))
+	}
+	if s.TopicName != nil && !request.MatchParamPattern("[a-zA-Z0-9_-]+", *s.TopicName) {
+		invalidParams.Add(request.NewErrParamPattern("TopicName", "[a-zA-Z0-9_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *RegisterEventTopicInput) SetDirectoryId(v string) *RegisterEventTopicInput {
 	s.DirectoryId = &v
@@ -7783,6 +8472,21 @@ func (s *RemoveIpRoutesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveIpRoutesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveIpRoutesInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCidrIps sets the CidrIps field's value.
 func (s *RemoveIpRoutesInput) SetCidrIps(v []*string) *RemoveIpRoutesInput {
 	s.CidrIps = v
@@ -7851,6 +8555,21 @@ func (s *RemoveTagsFromResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveTagsFromResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveTagsFromResourceInput"}
+	if s.ResourceId != nil && !request.MatchParamPattern("^[d]-[0-9a-f]{10}$", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "^[d]-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *RemoveTagsFromResourceInput) SetResourceId(v string) *RemoveTagsFromResourceInput {
 	s.ResourceId = &v
@@ -7912,6 +8631,21 @@ func (s *RestoreFromSnapshotInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RestoreFromSnapshotInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RestoreFromSnapshotInput"}
+	if s.SnapshotId != nil && !request.MatchParamPattern("^s-[0-9a-f]{10}$", *s.SnapshotId) {
+		invalidParams.Add(request.NewErrParamPattern("SnapshotId", "^s-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSnapshotId sets the SnapshotId field's value.
 func (s *RestoreFromSnapshotInput) SetSnapshotId(v string) *RestoreFromSnapshotInput {
 	s.SnapshotId = &v
@@ -8191,6 +8925,30 @@ func (s *StartSchemaExtensionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartSchemaExtensionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartSchemaExtensionInput"}
+	if s.Description != nil && len(*s.Description) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 128))
+	}
+	if s.Description != nil && !request.MatchParamPattern("^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$", *s.Description) {
+		invalidParams.Add(request.NewErrParamPattern("Description", "^([a-zA-Z0-9_])[\\\\a-zA-Z0-9_@#%*+=:?./!\\s-]*$"))
+	}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+	if s.LdifContent != nil && len(*s.LdifContent) > 500000 {
+		invalidParams.Add(request.NewErrParamMaxLen("LdifContent", 500000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCreateSnapshotBeforeSchemaExtension sets the CreateSnapshotBeforeSchemaExtension field's value.
 func (s *StartSchemaExtensionInput) SetCreateSnapshotBeforeSchemaExtension(v bool) *StartSchemaExtensionInput {
 	s.CreateSnapshotBeforeSchemaExtension = &v
@@ -8288,6 +9046,30 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+	if s.Value != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Value) {
+		invalidParams.Add(request.NewErrParamPattern("Value", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -8461,6 +9243,24 @@ func (s *UpdateConditionalForwarderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateConditionalForwarderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateConditionalForwarderInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+	if s.RemoteDomainName != nil && !request.MatchParamPattern("^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+[.]?$", *s.RemoteDomainName) {
+		invalidParams.Add(request.NewErrParamPattern("RemoteDomainName", "^([a-zA-Z0-9]+[\\\\.-])+([a-zA-Z0-9])+[.]?$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *UpdateConditionalForwarderInput) SetDirectoryId(v string) *UpdateConditionalForwarderInput {
 	s.DirectoryId = &v
@@ -8540,6 +9340,21 @@ func (s *UpdateNumberOfDomainControllersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateNumberOfDomainControllersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateNumberOfDomainControllersInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDesiredNumber sets the DesiredNumber field's value.
 func (s *UpdateNumberOfDomainControllersInput) SetDesiredNumber(v int64) *UpdateNumberOfDomainControllersInput {
 	s.DesiredNumber = &v
@@ -8614,6 +9429,26 @@ func (s *UpdateRadiusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateRadiusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateRadiusInput"}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{10}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{10}$"))
+	}
+	if s.RadiusSettings != nil {
+		if err := s.RadiusSettings.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RadiusSettings", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryId sets the DirectoryId field's value.
 func (s *UpdateRadiusInput) SetDirectoryId(v string) *UpdateRadiusInput {
 	s.DirectoryId = &v
@@ -8677,6 +9512,21 @@ func (s *VerifyTrustInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *VerifyTrustInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "VerifyTrustInput"}
+	if s.TrustId != nil && !request.MatchParamPattern("^t-[0-9a-f]{10}$", *s.TrustId) {
+		invalidParams.Add(request.NewErrParamPattern("TrustId", "^t-[0-9a-f]{10}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTrustId sets the TrustId field's value.
 func (s *VerifyTrustInput) SetTrustId(v string) *VerifyTrustInput {
 	s.TrustId = &v
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/endpointdiscovery"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/private/protocol"
 	"github.com/aws/aws-sdk-go/private/protocol/jsonrpc"
@@ -58,6 +59,10 @@ func (c *DynamoDB) BatchGetItemRequest(input *BatchGetItemInput) (req *request.R
 
 	output = &BatchGetItemOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -247,6 +252,10 @@ func (c *DynamoDB) BatchWriteItemRequest(input *BatchWriteItemInput) (req *reque
 
 	output = &BatchWriteItemOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -410,6 +419,10 @@ func (c *DynamoDB) CreateTableRequest(input *CreateTableInput) (req *request.Req
 
 	output = &CreateTableOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -518,6 +531,10 @@ func (c *DynamoDB) DeleteItemRequest(input *DeleteItemInput) (req *request.Reque
 
 	output = &DeleteItemOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -629,6 +646,10 @@ func (c *DynamoDB) DeleteTableRequest(input *DeleteTableInput) (req *request.Req
 
 	output = &DeleteTableOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -705,6 +726,80 @@ func (c *DynamoDB) DeleteTableWithContext(ctx aws.Context, input *DeleteTableInp
 	return out, req.Send()
 }
 
+const opDescribeEndpoints = "DescribeEndpoints"
+
+// DescribeEndpointsRequest generates a "aws/request.Request" representing the
+// client's request for the DescribeEndpoints operation. The "output" return
+// value will be populated with the request's response once the request complets
+// successfuly.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See DescribeEndpoints for more information on using the DescribeEndpoints
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//
+//    // Example sending a request using the DescribeEndpointsRequest method.
+//    req, resp := client.DescribeEndpointsRequest(params)
+//
+//    err := req.Send()
+//    if err == nil { // resp is now filled
+//        fmt.Println(resp)
+//    }
+//
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/dynamodb-2012-08-10/DescribeEndpoints
+func (c *DynamoDB) DescribeEndpointsRequest(input *DescribeEndpointsInput) (req *request.Request, output *DescribeEndpointsOutput) {
+	op := &request.Operation{
+		Name:       opDescribeEndpoints,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+
+	if input == nil {
+		input = &DescribeEndpointsInput{}
+	}
+
+	output = &DescribeEndpointsOutput{}
+	req = c.newRequest(op, input, output)
+	return
+}
+
+// DescribeEndpoints API operation for Amazon DynamoDB.
+//
+// Returns the regional endpoint information.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon DynamoDB's
+// API operation DescribeEndpoints for usage and error information.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/dynamodb-2012-08-10/DescribeEndpoints
+func (c *DynamoDB) DescribeEndpoints(input *DescribeEndpointsInput) (*DescribeEndpointsOutput, error) {
+	req, out := c.DescribeEndpointsRequest(input)
+	return out, req.Send()
+}
+
+// DescribeEndpointsWithContext is the same as DescribeEndpoints with the addition of
+// the ability to pass a context and additional request options.
+//
+// See DescribeEndpoints for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *DynamoDB) DescribeEndpointsWithContext(ctx aws.Context, input *DescribeEndpointsInput, opts ...request.Option) (*DescribeEndpointsOutput, error) {
+	req, out := c.DescribeEndpointsRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opDescribeLimits = "DescribeLimits"
 
 // DescribeLimitsRequest generates a "aws/request.Request" representing the
@@ -744,6 +839,10 @@ func (c *DynamoDB) DescribeLimitsRequest(input *DescribeLimitsInput) (req *reque
 
 	output = &DescribeLimitsOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -879,6 +978,10 @@ func (c *DynamoDB) DescribeTableRequest(input *DescribeTableInput) (req *request
 
 	output = &DescribeTableOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -970,6 +1073,10 @@ func (c *DynamoDB) DescribeTimeToLiveRequest(input *DescribeTimeToLiveInput) (re
 
 	output = &DescribeTimeToLiveOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -1053,6 +1160,10 @@ func (c *DynamoDB) GetItemRequest(input *GetItemInput) (req *request.Request, ou
 
 	output = &GetItemOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -1157,6 +1268,10 @@ func (c *DynamoDB) ListTablesRequest(input *ListTablesInput) (req *request.Reque
 
 	output = &ListTablesOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -1288,6 +1403,10 @@ func (c *DynamoDB) ListTagsOfResourceRequest(input *ListTagsOfResourceInput) (re
 
 	output = &ListTagsOfResourceOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -1375,6 +1494,10 @@ func (c *DynamoDB) PutItemRequest(input *PutItemInput) (req *request.Request, ou
 
 	output = &PutItemOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -1522,6 +1645,10 @@ func (c *DynamoDB) QueryRequest(input *QueryInput) (req *request.Request, output
 
 	output = &QueryOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -1716,6 +1843,10 @@ func (c *DynamoDB) ScanRequest(input *ScanInput) (req *request.Request, output *
 
 	output = &ScanOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -1886,6 +2017,10 @@ func (c *DynamoDB) TagResourceRequest(input *TagResourceInput) (req *request.Req
 	req = c.newRequest(op, input, output)
 	req.Handlers.Unmarshal.Remove(jsonrpc.UnmarshalHandler)
 	req.Handlers.Unmarshal.PushBackNamed(protocol.UnmarshalDiscardBodyHandler)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -1992,6 +2127,10 @@ func (c *DynamoDB) UntagResourceRequest(input *UntagResourceInput) (req *request
 	req = c.newRequest(op, input, output)
 	req.Handlers.Unmarshal.Remove(jsonrpc.UnmarshalHandler)
 	req.Handlers.Unmarshal.PushBackNamed(protocol.UnmarshalDiscardBodyHandler)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -2094,6 +2233,10 @@ func (c *DynamoDB) UpdateItemRequest(input *UpdateItemInput) (req *request.Reque
 
 	output = &UpdateItemOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -2199,6 +2342,10 @@ func (c *DynamoDB) UpdateTableRequest(input *UpdateTableInput) (req *request.Req
 
 	output = &UpdateTableOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -2314,6 +2461,10 @@ func (c *DynamoDB) UpdateTimeToLiveRequest(input *UpdateTimeToLiveInput) (req *r
 
 	output = &UpdateTimeToLiveOutput{}
 	req = c.newRequest(op, input, output)
+	(&endpointdiscovery.Handler{
+		Cache:    c.endpointCache,
+		Discover: c.discoverEndpoint,
+	}).AddToHandlers(&req.Handlers)
 	return
 }
 
@@ -2400,6 +2551,27 @@ func (c *DynamoDB) UpdateTimeToLiveWithContext(ctx aws.Context, input *UpdateTim
 	return out, req.Send()
 }
 
+// discoverEndpoint discovers the endpoint of the request's operation with the
+// DescribeEndpoints operation, sent with the request's Config.
+func (c *DynamoDB) discoverEndpoint(ctx aws.Context, r *request.Request) (endpointdiscovery.Endpoint, error) {
+	output, err := c.DescribeEndpointsWithContext(ctx, &DescribeEndpointsInput{}, func(req *request.Request) {
+		req.Config = r.Config
+	})
+	if err != nil {
+		return endpointdiscovery.Endpoint{}, err
+	}
+
+	var endpoint endpointdiscovery.Endpoint
+	for _, e := range output.Endpoints {
+		if e != nil && e.Address != nil {
+			endpoint.Address = *e.Address
+			endpoint.CachePeriod = time.Duration(aws.Int64Value(e.CachePeriodInMinutes)) * time.Minute
+			break
+		}
+	}
+	return endpoint, nil
+}
+
 // Represents an attribute for describing the key schema for the table and indexes.
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/dynamodb-2012-08-10/AttributeDefinition
 type AttributeDefinition struct {
@@ -2451,6 +2623,27 @@ func (s *AttributeDefinition) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttributeDefinition) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttributeDefinition"}
+	if s.AttributeName != nil && len(*s.AttributeName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("AttributeName", 255))
+	}
+	if s.AttributeType != nil {
+		values := []string{"S", "N", "B"}
+		if !request.IsParamEnumValue(*s.AttributeType, values) {
+			invalidParams.Add(request.NewErrParamEnum("AttributeType", *s.AttributeType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeName sets the AttributeName field's value.
 func (s *AttributeDefinition) SetAttributeName(v string) *AttributeDefinition {
 	s.AttributeName = &v
@@ -2704,6 +2897,24 @@ func (s AttributeValueUpdate) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttributeValueUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttributeValueUpdate"}
+	if s.Action != nil {
+		values := []string{"ADD", "PUT", "DELETE"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *AttributeValueUpdate) SetAction(v string) *AttributeValueUpdate {
 	s.Action = &v
@@ -2845,6 +3056,37 @@ func (s *BatchGetItemInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchGetItemInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchGetItemInput"}
+	if s.RequestItems != nil && len(s.RequestItems) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RequestItems", 100))
+	}
+	if s.ReturnConsumedCapacity != nil {
+		values := []string{"INDEXES", "TOTAL", "NONE"}
+		if !request.IsParamEnumValue(*s.ReturnConsumedCapacity, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReturnConsumedCapacity", *s.ReturnConsumedCapacity, values))
+		}
+	}
+	if s.RequestItems != nil {
+		for i, v := range s.RequestItems {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "RequestItems", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRequestItems sets the RequestItems field's value.
 func (s *BatchGetItemInput) SetRequestItems(v map[string]*KeysAndAttributes) *BatchGetItemInput {
 	s.RequestItems = v
@@ -3012,6 +3254,33 @@ func (s *BatchWriteItemInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchWriteItemInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchWriteItemInput"}
+	if s.RequestItems != nil && len(s.RequestItems) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("RequestItems", 25))
+	}
+	if s.ReturnConsumedCapacity != nil {
+		values := []string{"INDEXES", "TOTAL", "NONE"}
+		if !request.IsParamEnumValue(*s.ReturnConsumedCapacity, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReturnConsumedCapacity", *s.ReturnConsumedCapacity, values))
+		}
+	}
+	if s.ReturnItemCollectionMetrics != nil {
+		values := []string{"SIZE", "NONE"}
+		if !request.IsParamEnumValue(*s.ReturnItemCollectionMetrics, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReturnItemCollectionMetrics", *s.ReturnItemCollectionMetrics, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRequestItems sets the RequestItems field's value.
 func (s *BatchWriteItemInput) SetRequestItems(v map[string][]*WriteRequest) *BatchWriteItemInput {
 	s.RequestItems = v
@@ -3251,6 +3520,24 @@ func (s *Condition) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Condition) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Condition"}
+	if s.ComparisonOperator != nil {
+		values := []string{"EQ", "NE", "IN", "LE", "LT", "GE", "GT", "BETWEEN", "NOT_NULL", "NULL", "CONTAINS", "NOT_CONTAINS", "BEGINS_WITH"}
+		if !request.IsParamEnumValue(*s.ComparisonOperator, values) {
+			invalidParams.Add(request.NewErrParamEnum("ComparisonOperator", *s.ComparisonOperator, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeValueList sets the AttributeValueList field's value.
 func (s *Condition) SetAttributeValueList(v []*AttributeValue) *Condition {
 	s.AttributeValueList = v
@@ -3420,6 +3707,42 @@ func (s *CreateGlobalSecondaryIndexAction) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateGlobalSecondaryIndexAction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateGlobalSecondaryIndexAction"}
+	if s.IndexName != nil && len(*s.IndexName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("IndexName", 255))
+	}
+	if s.IndexName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.IndexName) {
+		invalidParams.Add(request.NewErrParamPattern("IndexName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.KeySchema != nil && len(s.KeySchema) > 2 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeySchema", 2))
+	}
+	if s.KeySchema != nil {
+		for i, v := range s.KeySchema {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "KeySchema", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Projection != nil {
+		if err := s.Projection.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Projection", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIndexName sets the IndexName field's value.
 func (s *CreateGlobalSecondaryIndexAction) SetIndexName(v string) *CreateGlobalSecondaryIndexAction {
 	s.IndexName = &v
@@ -3678,6 +4001,72 @@ func (s *CreateTableInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateTableInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTableInput"}
+	if s.KeySchema != nil && len(s.KeySchema) > 2 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeySchema", 2))
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.TableName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.TableName) {
+		invalidParams.Add(request.NewErrParamPattern("TableName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.AttributeDefinitions != nil {
+		for i, v := range s.AttributeDefinitions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "AttributeDefinitions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.GlobalSecondaryIndexes != nil {
+		for i, v := range s.GlobalSecondaryIndexes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "GlobalSecondaryIndexes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.KeySchema != nil {
+		for i, v := range s.KeySchema {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "KeySchema", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.LocalSecondaryIndexes != nil {
+		for i, v := range s.LocalSecondaryIndexes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "LocalSecondaryIndexes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.StreamSpecification != nil {
+		if err := s.StreamSpecification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StreamSpecification", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeDefinitions sets the AttributeDefinitions field's value.
 func (s *CreateTableInput) SetAttributeDefinitions(v []*AttributeDefinition) *CreateTableInput {
 	s.AttributeDefinitions = v
@@ -3782,6 +4171,24 @@ func (s *DeleteGlobalSecondaryIndexAction) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteGlobalSecondaryIndexAction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteGlobalSecondaryIndexAction"}
+	if s.IndexName != nil && len(*s.IndexName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("IndexName", 255))
+	}
+	if s.IndexName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.IndexName) {
+		invalidParams.Add(request.NewErrParamPattern("IndexName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIndexName sets the IndexName field's value.
 func (s *DeleteGlobalSecondaryIndexAction) SetIndexName(v string) *DeleteGlobalSecondaryIndexAction {
 	s.IndexName = &v
@@ -3962,6 +4369,58 @@ func (s *DeleteItemInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteItemInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteItemInput"}
+	if s.ConditionalOperator != nil {
+		values := []string{"AND", "OR"}
+		if !request.IsParamEnumValue(*s.ConditionalOperator, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConditionalOperator", *s.ConditionalOperator, values))
+		}
+	}
+	if s.ReturnConsumedCapacity != nil {
+		values := []string{"INDEXES", "TOTAL", "NONE"}
+		if !request.IsParamEnumValue(*s.ReturnConsumedCapacity, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReturnConsumedCapacity", *s.ReturnConsumedCapacity, values))
+		}
+	}
+	if s.ReturnItemCollectionMetrics != nil {
+		values := []string{"SIZE", "NONE"}
+		if !request.IsParamEnumValue(*s.ReturnItemCollectionMetrics, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReturnItemCollectionMetrics", *s.ReturnItemCollectionMetrics, values))
+		}
+	}
+	if s.ReturnValues != nil {
+		values := []string{"NONE", "ALL_OLD", "UPDATED_OLD", "ALL_NEW", "UPDATED_NEW"}
+		if !request.IsParamEnumValue(*s.ReturnValues, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReturnValues", *s.ReturnValues, values))
+		}
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.TableName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.TableName) {
+		invalidParams.Add(request.NewErrParamPattern("TableName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.Expected != nil {
+		for i, v := range s.Expected {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Expected", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConditionExpression sets the ConditionExpression field's value.
 func (s *DeleteItemInput) SetConditionExpression(v string) *DeleteItemInput {
 	s.ConditionExpression = &v
@@ -4156,6 +4615,24 @@ func (s *DeleteTableInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteTableInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTableInput"}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.TableName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.TableName) {
+		invalidParams.Add(request.NewErrParamPattern("TableName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTableName sets the TableName field's value.
 func (s *DeleteTableInput) SetTableName(v string) *DeleteTableInput {
 	s.TableName = &v
@@ -4187,34 +4664,75 @@ func (s *DeleteTableOutput) SetTableDescription(v *TableDescription) *DeleteTabl
 	return s
 }
 
-// Represents the input of a DescribeLimits operation. Has no content.
-// Please also see https://docs.aws.amazon.com/goto/WebAPI/dynamodb-2012-08-10/DescribeLimitsInput
-type DescribeLimitsInput struct {
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/dynamodb-2012-08-10/DescribeEndpointsRequest
+type DescribeEndpointsInput struct {
 	_ struct{} `type:"structure"`
 }
 
 // String returns the string representation
-func (s DescribeLimitsInput) String() string {
+func (s DescribeEndpointsInput) String() string {
 	return awsutil.Prettify(s)
 }
 
 // GoString returns the string representation
-func (s DescribeLimitsInput) GoString() string {
+func (s DescribeEndpointsInput) GoString() string {
 	return s.String()
 }
 
-// Represents the output of a DescribeLimits operation.
-// Please also see https://docs.aws.amazon.com/goto/WebAPI/dynamodb-2012-08-10/DescribeLimitsOutput
-type DescribeLimitsOutput struct {
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/dynamodb-2012-08-10/DescribeEndpointsResponse
+type DescribeEndpointsOutput struct {
 	_ struct{} `type:"structure"`
 
-	// The maximum total read capacity units that your account allows you to provision
-	// across all of your tables in this region.
-	AccountMaxReadCapacityUnits *int64 `min:"1" type:"long"`
-
-	// The maximum total write capacity units that your account allows you to provision
-	// across all of your tables in this region.
-	AccountMaxWriteCapacityUnits *int64 `min:"1" type:"long"`
+	// List of endpoints.
+	//
+	// Endpoints is a required field
+	Endpoints []*Endpoint `type:"list" required:"true"`
+}
+
+// String returns the string representation
+func (s DescribeEndpointsOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s DescribeEndpointsOutput) GoString() string {
+	return s.String()
+}
+
+// SetEndpoints sets the Endpoints field's value.
+func (s *DescribeEndpointsOutput) SetEndpoints(v []*Endpoint) *DescribeEndpointsOutput {
+	s.Endpoints = v
+	return s
+}
+
+// Represents the input of a DescribeLimits operation. Has no content.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/dynamodb-2012-08-10/DescribeLimitsInput
+type DescribeLimitsInput struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation
+func (s DescribeLimitsInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s DescribeLimitsInput) GoString() string {
+	return s.String()
+}
+
+// Represents the output of a DescribeLimits operation.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/dynamodb-2012-08-10/DescribeLimitsOutput
+type DescribeLimitsOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The maximum total read capacity units that your account allows you to provision
+	// across all of your tables in this region.
+	AccountMaxReadCapacityUnits *int64 `min:"1" type:"long"`
+
+	// The maximum total write capacity units that your account allows you to provision
+	// across all of your tables in this region.
+	AccountMaxWriteCapacityUnits *int64 `min:"1" type:"long"`
 
 	// The maximum read capacity units that your account allows you to provision
 	// for a new table that you are creating in this region, including the read
@@ -4298,6 +4816,24 @@ func (s *DescribeTableInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeTableInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeTableInput"}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.TableName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.TableName) {
+		invalidParams.Add(request.NewErrParamPattern("TableName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTableName sets the TableName field's value.
 func (s *DescribeTableInput) SetTableName(v string) *DescribeTableInput {
 	s.TableName = &v
@@ -4365,6 +4901,24 @@ func (s *DescribeTimeToLiveInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeTimeToLiveInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeTimeToLiveInput"}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.TableName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.TableName) {
+		invalidParams.Add(request.NewErrParamPattern("TableName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTableName sets the TableName field's value.
 func (s *DescribeTimeToLiveInput) SetTableName(v string) *DescribeTimeToLiveInput {
 	s.TableName = &v
@@ -4395,6 +4949,44 @@ func (s *DescribeTimeToLiveOutput) SetTimeToLiveDescription(v *TimeToLiveDescrip
 	return s
 }
 
+// An endpoint information details.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/dynamodb-2012-08-10/Endpoint
+type Endpoint struct {
+	_ struct{} `type:"structure"`
+
+	// IP address of the endpoint.
+	//
+	// Address is a required field
+	Address *string `type:"string" required:"true"`
+
+	// Endpoint cache time to live (TTL) value.
+	//
+	// CachePeriodInMinutes is a required field
+	CachePeriodInMinutes *int64 `type:"long" required:"true"`
+}
+
+// String returns the string representation
+func (s Endpoint) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s Endpoint) GoString() string {
+	return s.String()
+}
+
+// SetAddress sets the Address field's value.
+func (s *Endpoint) SetAddress(v string) *Endpoint {
+	s.Address = &v
+	return s
+}
+
+// SetCachePeriodInMinutes sets the CachePeriodInMinutes field's value.
+func (s *Endpoint) SetCachePeriodInMinutes(v int64) *Endpoint {
+	s.CachePeriodInMinutes = &v
+	return s
+}
+
 // Represents a condition to be compared with an attribute value. This condition
 // can be used with DeleteItem, PutItem or UpdateItem operations; if the comparison
 // evaluates to true, the operation succeeds; if not, the operation fails. You
@@ -4523,6 +5115,24 @@ func (s ExpectedAttributeValue) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ExpectedAttributeValue) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ExpectedAttributeValue"}
+	if s.ComparisonOperator != nil {
+		values := []string{"EQ", "NE", "IN", "LE", "LT", "GE", "GT", "BETWEEN", "NOT_NULL", "NULL", "CONTAINS", "NOT_CONTAINS", "BEGINS_WITH"}
+		if !request.IsParamEnumValue(*s.ComparisonOperator, values) {
+			invalidParams.Add(request.NewErrParamEnum("ComparisonOperator", *s.ComparisonOperator, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeValueList sets the AttributeValueList field's value.
 func (s *ExpectedAttributeValue) SetAttributeValueList(v []*AttributeValue) *ExpectedAttributeValue {
 	s.AttributeValueList = v
@@ -4677,6 +5287,30 @@ func (s *GetItemInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetItemInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetItemInput"}
+	if s.ReturnConsumedCapacity != nil {
+		values := []string{"INDEXES", "TOTAL", "NONE"}
+		if !request.IsParamEnumValue(*s.ReturnConsumedCapacity, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReturnConsumedCapacity", *s.ReturnConsumedCapacity, values))
+		}
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.TableName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.TableName) {
+		invalidParams.Add(request.NewErrParamPattern("TableName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributesToGet sets the AttributesToGet field's value.
 func (s *GetItemInput) SetAttributesToGet(v []*string) *GetItemInput {
 	s.AttributesToGet = v
@@ -4864,6 +5498,42 @@ func (s *GlobalSecondaryIndex) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GlobalSecondaryIndex) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GlobalSecondaryIndex"}
+	if s.IndexName != nil && len(*s.IndexName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("IndexName", 255))
+	}
+	if s.IndexName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.IndexName) {
+		invalidParams.Add(request.NewErrParamPattern("IndexName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.KeySchema != nil && len(s.KeySchema) > 2 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeySchema", 2))
+	}
+	if s.KeySchema != nil {
+		for i, v := range s.KeySchema {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "KeySchema", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Projection != nil {
+		if err := s.Projection.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Projection", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIndexName sets the IndexName field's value.
 func (s *GlobalSecondaryIndex) SetIndexName(v string) *GlobalSecondaryIndex {
 	s.IndexName = &v
@@ -5094,6 +5764,33 @@ func (s *GlobalSecondaryIndexUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GlobalSecondaryIndexUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GlobalSecondaryIndexUpdate"}
+	if s.Create != nil {
+		if err := s.Create.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Create", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Delete != nil {
+		if err := s.Delete.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Delete", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Update != nil {
+		if err := s.Update.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Update", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCreate sets the Create field's value.
 func (s *GlobalSecondaryIndexUpdate) SetCreate(v *CreateGlobalSecondaryIndexAction) *GlobalSecondaryIndexUpdate {
 	s.Create = v
@@ -5226,6 +5923,27 @@ func (s *KeySchemaElement) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *KeySchemaElement) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "KeySchemaElement"}
+	if s.AttributeName != nil && len(*s.AttributeName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("AttributeName", 255))
+	}
+	if s.KeyType != nil {
+		values := []string{"HASH", "RANGE"}
+		if !request.IsParamEnumValue(*s.KeyType, values) {
+			invalidParams.Add(request.NewErrParamEnum("KeyType", *s.KeyType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeName sets the AttributeName field's value.
 func (s *KeySchemaElement) SetAttributeName(v string) *KeySchemaElement {
 	s.AttributeName = &v
@@ -5343,6 +6061,21 @@ func (s *KeysAndAttributes) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *KeysAndAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "KeysAndAttributes"}
+	if s.Keys != nil && len(s.Keys) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Keys", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributesToGet sets the AttributesToGet field's value.
 func (s *KeysAndAttributes) SetAttributesToGet(v []*string) *KeysAndAttributes {
 	s.AttributesToGet = v
@@ -5414,6 +6147,27 @@ func (s *ListTablesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTablesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTablesInput"}
+	if s.ExclusiveStartTableName != nil && len(*s.ExclusiveStartTableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExclusiveStartTableName", 255))
+	}
+	if s.ExclusiveStartTableName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ExclusiveStartTableName) {
+		invalidParams.Add(request.NewErrParamPattern("ExclusiveStartTableName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExclusiveStartTableName sets the ExclusiveStartTableName field's value.
 func (s *ListTablesInput) SetExclusiveStartTableName(v string) *ListTablesInput {
 	s.ExclusiveStartTableName = &v
@@ -5512,6 +6266,21 @@ func (s *ListTagsOfResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsOfResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsOfResourceInput"}
+	if s.ResourceArn != nil && len(*s.ResourceArn) > 1283 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceArn", 1283))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *ListTagsOfResourceInput) SetNextToken(v string) *ListTagsOfResourceInput {
 	s.NextToken = &v
@@ -5647,6 +6416,42 @@ func (s *LocalSecondaryIndex) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LocalSecondaryIndex) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LocalSecondaryIndex"}
+	if s.IndexName != nil && len(*s.IndexName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("IndexName", 255))
+	}
+	if s.IndexName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.IndexName) {
+		invalidParams.Add(request.NewErrParamPattern("IndexName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.KeySchema != nil && len(s.KeySchema) > 2 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeySchema", 2))
+	}
+	if s.KeySchema != nil {
+		for i, v := range s.KeySchema {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "KeySchema", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Projection != nil {
+		if err := s.Projection.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Projection", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIndexName sets the IndexName field's value.
 func (s *LocalSecondaryIndex) SetIndexName(v string) *LocalSecondaryIndex {
 	s.IndexName = &v
@@ -5803,6 +6608,27 @@ func (s *Projection) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Projection) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Projection"}
+	if s.NonKeyAttributes != nil && len(s.NonKeyAttributes) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("NonKeyAttributes", 20))
+	}
+	if s.ProjectionType != nil {
+		values := []string{"ALL", "KEYS_ONLY", "INCLUDE"}
+		if !request.IsParamEnumValue(*s.ProjectionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ProjectionType", *s.ProjectionType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNonKeyAttributes sets the NonKeyAttributes field's value.
 func (s *Projection) SetNonKeyAttributes(v []*string) *Projection {
 	s.NonKeyAttributes = v
@@ -6141,6 +6967,58 @@ func (s *PutItemInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutItemInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutItemInput"}
+	if s.ConditionalOperator != nil {
+		values := []string{"AND", "OR"}
+		if !request.IsParamEnumValue(*s.ConditionalOperator, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConditionalOperator", *s.ConditionalOperator, values))
+		}
+	}
+	if s.ReturnConsumedCapacity != nil {
+		values := []string{"INDEXES", "TOTAL", "NONE"}
+		if !request.IsParamEnumValue(*s.ReturnConsumedCapacity, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReturnConsumedCapacity", *s.ReturnConsumedCapacity, values))
+		}
+	}
+	if s.ReturnItemCollectionMetrics != nil {
+		values := []string{"SIZE", "NONE"}
+		if !request.IsParamEnumValue(*s.ReturnItemCollectionMetrics, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReturnItemCollectionMetrics", *s.ReturnItemCollectionMetrics, values))
+		}
+	}
+	if s.ReturnValues != nil {
+		values := []string{"NONE", "ALL_OLD", "UPDATED_OLD", "ALL_NEW", "UPDATED_NEW"}
+		if !request.IsParamEnumValue(*s.ReturnValues, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReturnValues", *s.ReturnValues, values))
+		}
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.TableName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.TableName) {
+		invalidParams.Add(request.NewErrParamPattern("TableName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.Expected != nil {
+		for i, v := range s.Expected {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Expected", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConditionExpression sets the ConditionExpression field's value.
 func (s *PutItemInput) SetConditionExpression(v string) *PutItemInput {
 	s.ConditionExpression = &v
@@ -6651,6 +7529,68 @@ func (s *QueryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *QueryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "QueryInput"}
+	if s.ConditionalOperator != nil {
+		values := []string{"AND", "OR"}
+		if !request.IsParamEnumValue(*s.ConditionalOperator, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConditionalOperator", *s.ConditionalOperator, values))
+		}
+	}
+	if s.IndexName != nil && len(*s.IndexName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("IndexName", 255))
+	}
+	if s.IndexName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.IndexName) {
+		invalidParams.Add(request.NewErrParamPattern("IndexName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.ReturnConsumedCapacity != nil {
+		values := []string{"INDEXES", "TOTAL", "NONE"}
+		if !request.IsParamEnumValue(*s.ReturnConsumedCapacity, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReturnConsumedCapacity", *s.ReturnConsumedCapacity, values))
+		}
+	}
+	if s.Select != nil {
+		values := []string{"ALL_ATTRIBUTES", "ALL_PROJECTED_ATTRIBUTES", "SPECIFIC_ATTRIBUTES", "COUNT"}
+		if !request.IsParamEnumValue(*s.Select, values) {
+			invalidParams.Add(request.NewErrParamEnum("Select", *s.Select, values))
+		}
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.TableName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.TableName) {
+		invalidParams.Add(request.NewErrParamPattern("TableName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.KeyConditions != nil {
+		for i, v := range s.KeyConditions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "KeyConditions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.QueryFilter != nil {
+		for i, v := range s.QueryFilter {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "QueryFilter", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributesToGet sets the AttributesToGet field's value.
 func (s *QueryInput) SetAttributesToGet(v []*string) *QueryInput {
 	s.AttributesToGet = v
@@ -7137,6 +8077,64 @@ func (s *ScanInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ScanInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ScanInput"}
+	if s.ConditionalOperator != nil {
+		values := []string{"AND", "OR"}
+		if !request.IsParamEnumValue(*s.ConditionalOperator, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConditionalOperator", *s.ConditionalOperator, values))
+		}
+	}
+	if s.IndexName != nil && len(*s.IndexName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("IndexName", 255))
+	}
+	if s.IndexName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.IndexName) {
+		invalidParams.Add(request.NewErrParamPattern("IndexName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.ReturnConsumedCapacity != nil {
+		values := []string{"INDEXES", "TOTAL", "NONE"}
+		if !request.IsParamEnumValue(*s.ReturnConsumedCapacity, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReturnConsumedCapacity", *s.ReturnConsumedCapacity, values))
+		}
+	}
+	if s.Segment != nil && *s.Segment > 999999 {
+		invalidParams.Add(request.NewErrParamMaxValue("Segment", 999999))
+	}
+	if s.Select != nil {
+		values := []string{"ALL_ATTRIBUTES", "ALL_PROJECTED_ATTRIBUTES", "SPECIFIC_ATTRIBUTES", "COUNT"}
+		if !request.IsParamEnumValue(*s.Select, values) {
+			invalidParams.Add(request.NewErrParamEnum("Select", *s.Select, values))
+		}
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.TableName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.TableName) {
+		invalidParams.Add(request.NewErrParamPattern("TableName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.TotalSegments != nil && *s.TotalSegments > 1e+06 {
+		invalidParams.Add(request.NewErrParamMaxValue("TotalSegments", 1e+06))
+	}
+	if s.ScanFilter != nil {
+		for i, v := range s.ScanFilter {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ScanFilter", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributesToGet sets the AttributesToGet field's value.
 func (s *ScanInput) SetAttributesToGet(v []*string) *ScanInput {
 	s.AttributesToGet = v
@@ -7358,6 +8356,24 @@ func (s StreamSpecification) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StreamSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StreamSpecification"}
+	if s.StreamViewType != nil {
+		values := []string{"NEW_IMAGE", "OLD_IMAGE", "NEW_AND_OLD_IMAGES", "KEYS_ONLY"}
+		if !request.IsParamEnumValue(*s.StreamViewType, values) {
+			invalidParams.Add(request.NewErrParamEnum("StreamViewType", *s.StreamViewType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStreamEnabled sets the StreamEnabled field's value.
 func (s *StreamSpecification) SetStreamEnabled(v bool) *StreamSpecification {
 	s.StreamEnabled = &v
@@ -7719,6 +8735,24 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -7786,6 +8820,31 @@ func (s *TagResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TagResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
+	if s.ResourceArn != nil && len(*s.ResourceArn) > 1283 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceArn", 1283))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceArn sets the ResourceArn field's value.
 func (s *TagResourceInput) SetResourceArn(v string) *TagResourceInput {
 	s.ResourceArn = &v
@@ -7895,6 +8954,21 @@ func (s *TimeToLiveSpecification) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TimeToLiveSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TimeToLiveSpecification"}
+	if s.AttributeName != nil && len(*s.AttributeName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("AttributeName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeName sets the AttributeName field's value.
 func (s *TimeToLiveSpecification) SetAttributeName(v string) *TimeToLiveSpecification {
 	s.AttributeName = &v
@@ -7953,6 +9027,21 @@ func (s *UntagResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UntagResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UntagResourceInput"}
+	if s.ResourceArn != nil && len(*s.ResourceArn) > 1283 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceArn", 1283))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceArn sets the ResourceArn field's value.
 func (s *UntagResourceInput) SetResourceArn(v string) *UntagResourceInput {
 	s.ResourceArn = &v
@@ -8036,6 +9125,24 @@ func (s *UpdateGlobalSecondaryIndexAction) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateGlobalSecondaryIndexAction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateGlobalSecondaryIndexAction"}
+	if s.IndexName != nil && len(*s.IndexName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("IndexName", 255))
+	}
+	if s.IndexName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.IndexName) {
+		invalidParams.Add(request.NewErrParamPattern("IndexName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIndexName sets the IndexName field's value.
 func (s *UpdateGlobalSecondaryIndexAction) SetIndexName(v string) *UpdateGlobalSecondaryIndexAction {
 	s.IndexName = &v
@@ -8314,6 +9421,68 @@ func (s *UpdateItemInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateItemInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateItemInput"}
+	if s.ConditionalOperator != nil {
+		values := []string{"AND", "OR"}
+		if !request.IsParamEnumValue(*s.ConditionalOperator, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConditionalOperator", *s.ConditionalOperator, values))
+		}
+	}
+	if s.ReturnConsumedCapacity != nil {
+		values := []string{"INDEXES", "TOTAL", "NONE"}
+		if !request.IsParamEnumValue(*s.ReturnConsumedCapacity, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReturnConsumedCapacity", *s.ReturnConsumedCapacity, values))
+		}
+	}
+	if s.ReturnItemCollectionMetrics != nil {
+		values := []string{"SIZE", "NONE"}
+		if !request.IsParamEnumValue(*s.ReturnItemCollectionMetrics, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReturnItemCollectionMetrics", *s.ReturnItemCollectionMetrics, values))
+		}
+	}
+	if s.ReturnValues != nil {
+		values := []string{"NONE", "ALL_OLD", "UPDATED_OLD", "ALL_NEW", "UPDATED_NEW"}
+		if !request.IsParamEnumValue(*s.ReturnValues, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReturnValues", *s.ReturnValues, values))
+		}
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.TableName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.TableName) {
+		invalidParams.Add(request.NewErrParamPattern("TableName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.AttributeUpdates != nil {
+		for i, v := range s.AttributeUpdates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "AttributeUpdates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Expected != nil {
+		for i, v := range s.Expected {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Expected", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeUpdates sets the AttributeUpdates field's value.
 func (s *UpdateItemInput) SetAttributeUpdates(v map[string]*AttributeValueUpdate) *UpdateItemInput {
 	s.AttributeUpdates = v
@@ -8547,6 +9716,49 @@ func (s *UpdateTableInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateTableInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateTableInput"}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.TableName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.TableName) {
+		invalidParams.Add(request.NewErrParamPattern("TableName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.AttributeDefinitions != nil {
+		for i, v := range s.AttributeDefinitions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "AttributeDefinitions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.GlobalSecondaryIndexUpdates != nil {
+		for i, v := range s.GlobalSecondaryIndexUpdates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "GlobalSecondaryIndexUpdates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.StreamSpecification != nil {
+		if err := s.StreamSpecification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StreamSpecification", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeDefinitions sets the AttributeDefinitions field's value.
 func (s *UpdateTableInput) SetAttributeDefinitions(v []*AttributeDefinition) *UpdateTableInput {
 	s.AttributeDefinitions = v
@@ -8653,6 +9865,29 @@ func (s *UpdateTimeToLiveInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateTimeToLiveInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateTimeToLiveInput"}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.TableName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.TableName) {
+		invalidParams.Add(request.NewErrParamPattern("TableName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.TimeToLiveSpecification != nil {
+		if err := s.TimeToLiveSpecification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TimeToLiveSpecification", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTableName sets the TableName field's value.
 func (s *UpdateTimeToLiveInput) SetTableName(v string) *UpdateTimeToLiveInput {
 	s.TableName = &v
@@ -102,6 +102,10 @@ type Marshaler interface {
 //		// only valid for slices, and maps.
 //		Field []string `dynamodbav:",omitemptyelem"`
 //
+//		// Field's empty elems will be marshaled as NULL, even if the
+//		// Encoder's OmitEmpty option is set. Only valid for slices, and maps.
+//		Field []string `dynamodbav:",nullemptyelem"`
+//
 //		// Field will be marshaled as a AttributeValue string
 //		// only value for number types, (int,uint,float)
 //		Field int `dynamodbav:",string"`
@@ -127,12 +131,15 @@ type Marshaler interface {
 // AttributeValue NULL will be added to AttributeValue Maps during struct
 // marshal. The omitemptyelem tag works the same as omitempty except it
 // applies to maps and slices instead of struct fields, and will not be
-// included in the marshaled AttributeValue Map, List, or Set.
+// included in the marshaled AttributeValue Map, List, or Set. The
+// nullemptyelem tag keeps the empty elements of the maps and slices as
+// NULL AttributeValues when the Encoder's OmitEmpty option is set.
 //
 // For convenience and backwards compatibility with ConvertTo functions
 // json struct tags are supported by the Marshal and Unmarshal. If
 // both json and dynamodbav struct tags are provided the json tag will
-// be ignored in favor of dynamodbav.
+// be ignored in favor of dynamodbav. The MarshalOptions' TagKey adds the
+// struct tag key, such as "yaml", read if a field has no dynamodbav tag.
 //
 // All struct fields and with anonymous fields, are marshaled unless the
 // any of the following conditions are meet.
@@ -189,6 +196,21 @@ type MarshalOptions struct {
 	//
 	// Enabled by default.
 	SupportJSONTags bool
+
+	// The key of the struct tags read if a struct field has no `dynamodbav`
+	// struct tag, such as "yaml". The tag's value has the same format as the
+	// `dynamodbav` tag. If the field has neither tag the encoding/json tag is
+	// read if SupportJSONTags is enabled. No other tags are read if empty.
+	TagKey string
+
+	// Omits the empty struct fields, and empty elements of maps, lists, and
+	// sets, as if all struct fields were tagged with `omitempty` and
+	// `omitemptyelem`. Fields tagged with `nullemptyelem` keep their empty
+	// elements as NULL AttributeValues. Zero time.Time values are not empty.
+	// Only used when marshaling.
+	//
+	// Disabled by default.
+	OmitEmpty bool
 }
 
 // An Encoder provides marshaling Go value types to AttributeValues.
@@ -220,6 +242,23 @@ func NewEncoder(opts ...func(*Encoder)) *Encoder {
 	return e
 }
 
+// NewEncoderWithOptions creates a new Encoder with the marshal options, and
+// the default configuration of the Encoder's other options. Use the `opts`
+// functional options to override the default configuration.
+//
+// The options replace the default MarshalOptions, so encoding/json struct
+// tags are only supported if the options' SupportJSONTags is enabled.
+func NewEncoderWithOptions(options MarshalOptions, opts ...func(*Encoder)) *Encoder {
+	e := NewEncoder(func(e *Encoder) {
+		e.MarshalOptions = options
+	})
+	for _, o := range opts {
+		o(e)
+	}
+
+	return e
+}
+
 // Encode will marshal a Go value type to an AttributeValue. Returning
 // the AttributeValue constructed or error.
 func (e *Encoder) Encode(in interface{}) (*dynamodb.AttributeValue, error) {
@@ -311,12 +350,14 @@ func (e *Encoder) encodeStruct(av *dynamodb.AttributeValue, v reflect.Value, fie
 		if !found {
 			continue
 		}
+		fieldTag := f.tag
+		fieldTag.OmitEmpty = fieldTag.OmitEmpty || e.OmitEmpty
 		elem := &dynamodb.AttributeValue{}
-		err := e.encode(elem, fv, f.tag)
+		err := e.encode(elem, fv, fieldTag)
 		if err != nil {
 			return err
 		}
-		skip, err := keepOrOmitEmpty(f.OmitEmpty, elem, err)
+		skip, err := keepOrOmitEmpty(fieldTag.OmitEmpty, elem, err)
 		if err != nil {
 			return err
 		} else if skip {
@@ -343,7 +384,7 @@ func (e *Encoder) encodeMap(av *dynamodb.AttributeValue, v reflect.Value, fieldT
 		elemVal := v.MapIndex(key)
 		elem := &dynamodb.AttributeValue{}
 		err := e.encode(elem, elemVal, tag{})
-		skip, err := keepOrOmitEmpty(fieldTag.OmitEmptyElem, elem, err)
+		skip, err := keepOrOmitEmpty(e.omitEmptyElem(fieldTag), elem, err)
 		if err != nil {
 			return err
 		} else if skip {
@@ -417,11 +458,12 @@ func (e *Encoder) encodeSlice(av *dynamodb.AttributeValue, v reflect.Value, fiel
 }
 
 func (e *Encoder) encodeList(v reflect.Value, fieldTag tag, elemFn func(dynamodb.AttributeValue) error) (int, error) {
+	omitEmptyElem := e.omitEmptyElem(fieldTag)
 	count := 0
 	for i := 0; i < v.Len(); i++ {
 		elem := dynamodb.AttributeValue{}
-		err := e.encode(&elem, v.Index(i), tag{OmitEmpty: fieldTag.OmitEmptyElem})
-		skip, err := keepOrOmitEmpty(fieldTag.OmitEmptyElem, &elem, err)
+		err := e.encode(&elem, v.Index(i), tag{OmitEmpty: omitEmptyElem})
+		skip, err := keepOrOmitEmpty(omitEmptyElem, &elem, err)
 		if err != nil {
 			return 0, err
 		} else if skip {
@@ -437,6 +479,12 @@ func (e *Encoder) encodeList(v reflect.Value, fieldTag tag, elemFn func(dynamodb
 	return count, nil
 }
 
+// omitEmptyElem returns if the empty elements of the map or slice with the
+// field tag are omitted.
+func (e *Encoder) omitEmptyElem(fieldTag tag) bool {
+	return fieldTag.OmitEmptyElem || (e.OmitEmpty && !fieldTag.NullEmptyElem)
+}
+
 func (e *Encoder) encodeScalar(av *dynamodb.AttributeValue, v reflect.Value, fieldTag tag) error {
 	if v.Type() == numberType {
 		s := v.String()
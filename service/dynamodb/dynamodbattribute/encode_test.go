@@ -235,3 +235,154 @@ func TestEncodeAliasedUnixTime(t *testing.T) {
 	}
 	assert.Equal(t, expect, actual)
 }
+
+func TestEncoderOptionsOmitEmpty(t *testing.T) {
+	type B struct {
+		ID      string
+		Name    string
+		Count   int
+		Ptr     *string
+		Created time.Time
+		Tags    []string
+		Notes   []string `dynamodbav:",nullemptyelem"`
+		Labels  []string `dynamodbav:",omitemptyelem"`
+		Empty   []string
+		Attrs   map[string]string
+	}
+	in := B{
+		ID:     "id",
+		Tags:   []string{"a", ""},
+		Notes:  []string{"b", ""},
+		Labels: []string{"c", ""},
+		Empty:  []string{},
+		Attrs:  map[string]string{"k": "v", "e": ""},
+	}
+	zeroTime := "0001-01-01T00:00:00Z"
+
+	cases := map[string]struct {
+		Options       MarshalOptions
+		Expect        map[string]*dynamodb.AttributeValue
+		ExpectDecoded B
+	}{
+		"default": {
+			Options: MarshalOptions{SupportJSONTags: true},
+			Expect: map[string]*dynamodb.AttributeValue{
+				"ID":      {S: aws.String("id")},
+				"Name":    {NULL: aws.Bool(true)},
+				"Count":   {N: aws.String("0")},
+				"Ptr":     {NULL: aws.Bool(true)},
+				"Created": {S: aws.String(zeroTime)},
+				"Tags":    {L: []*dynamodb.AttributeValue{{S: aws.String("a")}, {NULL: aws.Bool(true)}}},
+				"Notes":   {L: []*dynamodb.AttributeValue{{S: aws.String("b")}, {NULL: aws.Bool(true)}}},
+				"Labels":  {L: []*dynamodb.AttributeValue{{S: aws.String("c")}}},
+				"Empty":   {NULL: aws.Bool(true)},
+				"Attrs": {M: map[string]*dynamodb.AttributeValue{
+					"k": {S: aws.String("v")},
+					"e": {NULL: aws.Bool(true)},
+				}},
+			},
+			ExpectDecoded: B{
+				ID:     "id",
+				Tags:   []string{"a", ""},
+				Notes:  []string{"b", ""},
+				Labels: []string{"c"},
+				Attrs:  map[string]string{"k": "v", "e": ""},
+			},
+		},
+		"omit empty": {
+			Options: MarshalOptions{SupportJSONTags: true, OmitEmpty: true},
+			Expect: map[string]*dynamodb.AttributeValue{
+				"ID":      {S: aws.String("id")},
+				"Created": {S: aws.String(zeroTime)},
+				"Tags":    {L: []*dynamodb.AttributeValue{{S: aws.String("a")}}},
+				"Notes":   {L: []*dynamodb.AttributeValue{{S: aws.String("b")}, {NULL: aws.Bool(true)}}},
+				"Labels":  {L: []*dynamodb.AttributeValue{{S: aws.String("c")}}},
+				"Attrs": {M: map[string]*dynamodb.AttributeValue{
+					"k": {S: aws.String("v")},
+				}},
+			},
+			ExpectDecoded: B{
+				ID:     "id",
+				Tags:   []string{"a"},
+				Notes:  []string{"b", ""},
+				Labels: []string{"c"},
+				Attrs:  map[string]string{"k": "v"},
+			},
+		},
+	}
+
+	for name, c := range cases {
+		actual, err := NewEncoderWithOptions(c.Options).Encode(in)
+		assert.NoError(t, err, name)
+		assert.Equal(t, &dynamodb.AttributeValue{M: c.Expect}, actual, name)
+
+		var out B
+		err = NewDecoder(func(d *Decoder) { d.MarshalOptions = c.Options }).Decode(actual, &out)
+		assert.NoError(t, err, name)
+		assert.Equal(t, c.ExpectDecoded, out, name)
+	}
+}
+
+func TestEncoderOptionsTagKey(t *testing.T) {
+	type B struct {
+		A string `yaml:"a_yaml" json:"a_json"`
+		B string `dynamodbav:"b_av" yaml:"b_yaml"`
+		C string `json:"c_json"`
+		D string `yaml:"-"`
+		E string `yaml:",omitempty"`
+	}
+	in := B{A: "a", B: "b", C: "c", D: "d"}
+
+	cases := map[string]struct {
+		Encoder *Encoder
+		Options MarshalOptions
+		Expect  map[string]*dynamodb.AttributeValue
+	}{
+		"default": {
+			Encoder: NewEncoder(),
+			Options: MarshalOptions{SupportJSONTags: true},
+			Expect: map[string]*dynamodb.AttributeValue{
+				"a_json": {S: aws.String("a")},
+				"b_av":   {S: aws.String("b")},
+				"c_json": {S: aws.String("c")},
+				"D":      {S: aws.String("d")},
+				"E":      {NULL: aws.Bool(true)},
+			},
+		},
+		"tag key with json": {
+			Options: MarshalOptions{SupportJSONTags: true, TagKey: "yaml"},
+			Expect: map[string]*dynamodb.AttributeValue{
+				"a_yaml": {S: aws.String("a")},
+				"b_av":   {S: aws.String("b")},
+				"c_json": {S: aws.String("c")},
+			},
+		},
+		"tag key without json": {
+			Options: MarshalOptions{TagKey: "yaml"},
+			Expect: map[string]*dynamodb.AttributeValue{
+				"a_yaml": {S: aws.String("a")},
+				"b_av":   {S: aws.String("b")},
+				"C":      {S: aws.String("c")},
+			},
+		},
+	}
+
+	for name, c := range cases {
+		e := c.Encoder
+		if e == nil {
+			e = NewEncoderWithOptions(c.Options)
+		}
+		actual, err := e.Encode(in)
+		assert.NoError(t, err, name)
+		assert.Equal(t, &dynamodb.AttributeValue{M: c.Expect}, actual, name)
+
+		expectDecoded := in
+		if _, ok := c.Expect["D"]; !ok {
+			expectDecoded.D = ""
+		}
+		var out B
+		err = NewDecoder(func(d *Decoder) { d.MarshalOptions = c.Options }).Decode(actual, &out)
+		assert.NoError(t, err, name)
+		assert.Equal(t, expectDecoded, out, name)
+	}
+}
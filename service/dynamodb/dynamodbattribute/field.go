@@ -99,6 +99,9 @@ func enumFields(t reflect.Type, opts MarshalOptions) []field {
 
 				fieldTag := tag{}
 				fieldTag.parseAVTag(sf.Tag)
+				if len(opts.TagKey) != 0 && fieldTag == (tag{}) {
+					fieldTag.parseTag(sf.Tag, opts.TagKey)
+				}
 				if opts.SupportJSONTags && fieldTag == (tag{}) {
 					fieldTag.parseJSONTag(sf.Tag)
 				}
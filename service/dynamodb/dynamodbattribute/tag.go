@@ -10,22 +10,22 @@ type tag struct {
 	Ignore                       bool
 	OmitEmpty                    bool
 	OmitEmptyElem                bool
+	NullEmptyElem                bool
 	AsString                     bool
 	AsBinSet, AsNumSet, AsStrSet bool
 	AsUnixTime                   bool
 }
 
 func (t *tag) parseAVTag(structTag reflect.StructTag) {
-	tagStr := structTag.Get("dynamodbav")
-	if len(tagStr) == 0 {
-		return
-	}
-
-	t.parseTagStr(tagStr)
+	t.parseTag(structTag, "dynamodbav")
 }
 
 func (t *tag) parseJSONTag(structTag reflect.StructTag) {
-	tagStr := structTag.Get("json")
+	t.parseTag(structTag, "json")
+}
+
+func (t *tag) parseTag(structTag reflect.StructTag, key string) {
+	tagStr := structTag.Get(key)
 	if len(tagStr) == 0 {
 		return
 	}
@@ -53,6 +53,8 @@ func (t *tag) parseTagStr(tagStr string) {
 			t.OmitEmpty = true
 		case "omitemptyelem":
 			t.OmitEmptyElem = true
+		case "nullemptyelem":
+			t.NullEmptyElem = true
 		case "string":
 			t.AsString = true
 		case "binaryset":
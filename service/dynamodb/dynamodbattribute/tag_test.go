@@ -26,6 +26,7 @@ func TestTagParse(t *testing.T) {
 		{`dynamodbav:"-"`, false, true, tag{Ignore: true}},
 		{`dynamodbav:",omitempty"`, false, true, tag{OmitEmpty: true}},
 		{`dynamodbav:",omitemptyelem"`, false, true, tag{OmitEmptyElem: true}},
+		{`dynamodbav:",nullemptyelem"`, false, true, tag{NullEmptyElem: true}},
 		{`dynamodbav:",string"`, false, true, tag{AsString: true}},
 		{`dynamodbav:",binaryset"`, false, true, tag{AsBinSet: true}},
 		{`dynamodbav:",numberset"`, false, true, tag{AsNumSet: true}},
@@ -0,0 +1,99 @@
+package dynamodb_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/awstesting"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+func TestEndpointDiscovery_SessionEnv(t *testing.T) {
+	cases := map[string]struct {
+		Env            string
+		ExpectDiscover bool
+	}{
+		"enabled": {
+			Env:            "true",
+			ExpectDiscover: true,
+		},
+		"disabled": {},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			env := awstesting.StashEnv()
+			defer awstesting.PopEnv(env)
+			if len(c.Env) != 0 {
+				os.Setenv("AWS_ENABLE_ENDPOINT_DISCOVERY", c.Env)
+			}
+
+			var discovered, sentToDiscovered int32
+			discoveredServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&sentToDiscovered, 1)
+				w.Write([]byte(`{"TableNames":[]}`))
+			}))
+			defer discoveredServer.Close()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("X-Amz-Target") == "DynamoDB_20120810.DescribeEndpoints" {
+					atomic.AddInt32(&discovered, 1)
+					w.Write([]byte(`{"Endpoints":[{"Address":"` + discoveredServer.URL + `","CachePeriodInMinutes":1}]}`))
+					return
+				}
+				w.Write([]byte(`{"TableNames":[]}`))
+			}))
+			defer server.Close()
+
+			sess, err := session.NewSession(&aws.Config{
+				Region:      aws.String("us-west-2"),
+				Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+				EndpointResolver: endpoints.ResolverFunc(
+					func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+						return endpoints.ResolvedEndpoint{URL: server.URL}, nil
+					}),
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectDiscover, aws.BoolValue(sess.Config.EnableEndpointDiscovery); e != a {
+				t.Fatalf("expect %v session endpoint discovery, got %v", e, a)
+			}
+			svc := dynamodb.New(sess)
+
+			// The endpoint is discovered in the background, and used once
+			// it has been discovered.
+			deadline := time.Now().Add(5 * time.Second)
+			for {
+				if _, err := svc.ListTables(&dynamodb.ListTablesInput{}); err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+				if !c.ExpectDiscover || atomic.LoadInt32(&sentToDiscovered) != 0 || time.Now().After(deadline) {
+					break
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			if c.ExpectDiscover {
+				if e, a := int32(1), atomic.LoadInt32(&discovered); e != a {
+					t.Errorf("expect %v endpoint discovered, got %v", e, a)
+				}
+				if atomic.LoadInt32(&sentToDiscovered) == 0 {
+					t.Errorf("expect request sent to discovered endpoint")
+				}
+			} else {
+				if e, a := int32(0), atomic.LoadInt32(&discovered)+atomic.LoadInt32(&sentToDiscovered); e != a {
+					t.Errorf("expect no endpoint discovered, got %v requests", a)
+				}
+			}
+		})
+	}
+}
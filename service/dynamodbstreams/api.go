@@ -458,6 +458,24 @@ func (s *DescribeStreamInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeStreamInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeStreamInput"}
+	if s.ExclusiveStartShardId != nil && len(*s.ExclusiveStartShardId) > 65 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExclusiveStartShardId", 65))
+	}
+	if s.StreamArn != nil && len(*s.StreamArn) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamArn", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExclusiveStartShardId sets the ExclusiveStartShardId field's value.
 func (s *DescribeStreamInput) SetExclusiveStartShardId(v string) *DescribeStreamInput {
 	s.ExclusiveStartShardId = &v
@@ -549,6 +567,21 @@ func (s *GetRecordsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetRecordsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetRecordsInput"}
+	if s.ShardIterator != nil && len(*s.ShardIterator) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ShardIterator", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *GetRecordsInput) SetLimit(v int64) *GetRecordsInput {
 	s.Limit = &v
@@ -675,6 +708,33 @@ func (s *GetShardIteratorInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetShardIteratorInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetShardIteratorInput"}
+	if s.SequenceNumber != nil && len(*s.SequenceNumber) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("SequenceNumber", 40))
+	}
+	if s.ShardId != nil && len(*s.ShardId) > 65 {
+		invalidParams.Add(request.NewErrParamMaxLen("ShardId", 65))
+	}
+	if s.ShardIteratorType != nil {
+		values := []string{"TRIM_HORIZON", "LATEST", "AT_SEQUENCE_NUMBER", "AFTER_SEQUENCE_NUMBER"}
+		if !request.IsParamEnumValue(*s.ShardIteratorType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ShardIteratorType", *s.ShardIteratorType, values))
+		}
+	}
+	if s.StreamArn != nil && len(*s.StreamArn) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamArn", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSequenceNumber sets the SequenceNumber field's value.
 func (s *GetShardIteratorInput) SetSequenceNumber(v string) *GetShardIteratorInput {
 	s.SequenceNumber = &v
@@ -808,6 +868,27 @@ func (s *ListStreamsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListStreamsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListStreamsInput"}
+	if s.ExclusiveStartStreamArn != nil && len(*s.ExclusiveStartStreamArn) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExclusiveStartStreamArn", 1024))
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.TableName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.TableName) {
+		invalidParams.Add(request.NewErrParamPattern("TableName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExclusiveStartStreamArn sets the ExclusiveStartStreamArn field's value.
 func (s *ListStreamsInput) SetExclusiveStartStreamArn(v string) *ListStreamsInput {
 	s.ExclusiveStartStreamArn = &v
@@ -20693,6 +20693,24 @@ func (s AllocateAddressInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AllocateAddressInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AllocateAddressInput"}
+	if s.Domain != nil {
+		values := []string{"vpc", "standard"}
+		if !request.IsParamEnumValue(*s.Domain, values) {
+			invalidParams.Add(request.NewErrParamEnum("Domain", *s.Domain, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddress sets the Address field's value.
 func (s *AllocateAddressInput) SetAddress(v string) *AllocateAddressInput {
 	s.Address = &v
@@ -20821,6 +20839,24 @@ func (s *AllocateHostsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AllocateHostsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AllocateHostsInput"}
+	if s.AutoPlacement != nil {
+		values := []string{"on", "off"}
+		if !request.IsParamEnumValue(*s.AutoPlacement, values) {
+			invalidParams.Add(request.NewErrParamEnum("AutoPlacement", *s.AutoPlacement, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoPlacement sets the AutoPlacement field's value.
 func (s *AllocateHostsInput) SetAutoPlacement(v string) *AllocateHostsInput {
 	s.AutoPlacement = &v
@@ -22591,6 +22627,23 @@ func (s BlockDeviceMapping) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BlockDeviceMapping) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BlockDeviceMapping"}
+	if s.Ebs != nil {
+		if err := s.Ebs.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Ebs", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeviceName sets the DeviceName field's value.
 func (s *BlockDeviceMapping) SetDeviceName(v string) *BlockDeviceMapping {
 	s.DeviceName = &v
@@ -24383,6 +24436,24 @@ func (s *CreateCustomerGatewayInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateCustomerGatewayInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateCustomerGatewayInput"}
+	if s.Type != nil {
+		values := []string{"ipsec.1"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBgpAsn sets the BgpAsn field's value.
 func (s *CreateCustomerGatewayInput) SetBgpAsn(v int64) *CreateCustomerGatewayInput {
 	s.BgpAsn = &v
@@ -24730,6 +24801,30 @@ func (s *CreateFlowLogsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateFlowLogsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateFlowLogsInput"}
+	if s.ResourceType != nil {
+		values := []string{"VPC", "Subnet", "NetworkInterface"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+	if s.TrafficType != nil {
+		values := []string{"ACCEPT", "REJECT", "ALL"}
+		if !request.IsParamEnumValue(*s.TrafficType, values) {
+			invalidParams.Add(request.NewErrParamEnum("TrafficType", *s.TrafficType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientToken sets the ClientToken field's value.
 func (s *CreateFlowLogsInput) SetClientToken(v string) *CreateFlowLogsInput {
 	s.ClientToken = &v
@@ -24996,6 +25091,28 @@ func (s *CreateImageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateImageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateImageInput"}
+	if s.BlockDeviceMappings != nil {
+		for i, v := range s.BlockDeviceMappings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "BlockDeviceMappings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBlockDeviceMappings sets the BlockDeviceMappings field's value.
 func (s *CreateImageInput) SetBlockDeviceMappings(v []*BlockDeviceMapping) *CreateImageInput {
 	s.BlockDeviceMappings = v
@@ -25101,6 +25218,29 @@ func (s *CreateInstanceExportTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateInstanceExportTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateInstanceExportTaskInput"}
+	if s.TargetEnvironment != nil {
+		values := []string{"citrix", "vmware", "microsoft"}
+		if !request.IsParamEnumValue(*s.TargetEnvironment, values) {
+			invalidParams.Add(request.NewErrParamEnum("TargetEnvironment", *s.TargetEnvironment, values))
+		}
+	}
+	if s.ExportToS3Task != nil {
+		if err := s.ExportToS3Task.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ExportToS3Task", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *CreateInstanceExportTaskInput) SetDescription(v string) *CreateInstanceExportTaskInput {
 	s.Description = &v
@@ -25499,6 +25639,24 @@ func (s *CreateNetworkAclEntryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateNetworkAclEntryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateNetworkAclEntryInput"}
+	if s.RuleAction != nil {
+		values := []string{"allow", "deny"}
+		if !request.IsParamEnumValue(*s.RuleAction, values) {
+			invalidParams.Add(request.NewErrParamEnum("RuleAction", *s.RuleAction, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCidrBlock sets the CidrBlock field's value.
 func (s *CreateNetworkAclEntryInput) SetCidrBlock(v string) *CreateNetworkAclEntryInput {
 	s.CidrBlock = &v
@@ -25872,6 +26030,24 @@ func (s *CreateNetworkInterfacePermissionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateNetworkInterfacePermissionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateNetworkInterfacePermissionInput"}
+	if s.Permission != nil {
+		values := []string{"INSTANCE-ATTACH", "EIP-ASSOCIATE"}
+		if !request.IsParamEnumValue(*s.Permission, values) {
+			invalidParams.Add(request.NewErrParamEnum("Permission", *s.Permission, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAwsAccountId sets the AwsAccountId field's value.
 func (s *CreateNetworkInterfacePermissionInput) SetAwsAccountId(v string) *CreateNetworkInterfacePermissionInput {
 	s.AwsAccountId = &v
@@ -25977,6 +26153,24 @@ func (s *CreatePlacementGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePlacementGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePlacementGroupInput"}
+	if s.Strategy != nil {
+		values := []string{"cluster"}
+		if !request.IsParamEnumValue(*s.Strategy, values) {
+			invalidParams.Add(request.NewErrParamEnum("Strategy", *s.Strategy, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDryRun sets the DryRun field's value.
 func (s *CreatePlacementGroupInput) SetDryRun(v bool) *CreatePlacementGroupInput {
 	s.DryRun = &v
@@ -26074,6 +26268,28 @@ func (s *CreateReservedInstancesListingInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateReservedInstancesListingInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateReservedInstancesListingInput"}
+	if s.PriceSchedules != nil {
+		for i, v := range s.PriceSchedules {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PriceSchedules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientToken sets the ClientToken field's value.
 func (s *CreateReservedInstancesListingInput) SetClientToken(v string) *CreateReservedInstancesListingInput {
 	s.ClientToken = &v
@@ -26897,6 +27113,34 @@ func (s *CreateVolumeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateVolumeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateVolumeInput"}
+	if s.VolumeType != nil {
+		values := []string{"standard", "io1", "gp2", "sc1", "st1"}
+		if !request.IsParamEnumValue(*s.VolumeType, values) {
+			invalidParams.Add(request.NewErrParamEnum("VolumeType", *s.VolumeType, values))
+		}
+	}
+	if s.TagSpecifications != nil {
+		for i, v := range s.TagSpecifications {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TagSpecifications", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAvailabilityZone sets the AvailabilityZone field's value.
 func (s *CreateVolumeInput) SetAvailabilityZone(v string) *CreateVolumeInput {
 	s.AvailabilityZone = &v
@@ -26976,6 +27220,24 @@ func (s CreateVolumePermission) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateVolumePermission) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateVolumePermission"}
+	if s.Group != nil {
+		values := []string{"all"}
+		if !request.IsParamEnumValue(*s.Group, values) {
+			invalidParams.Add(request.NewErrParamEnum("Group", *s.Group, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroup sets the Group field's value.
 func (s *CreateVolumePermission) SetGroup(v string) *CreateVolumePermission {
 	s.Group = &v
@@ -27012,6 +27274,38 @@ func (s CreateVolumePermissionModifications) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateVolumePermissionModifications) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateVolumePermissionModifications"}
+	if s.Add != nil {
+		for i, v := range s.Add {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Add", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Remove != nil {
+		for i, v := range s.Remove {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Remove", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdd sets the Add field's value.
 func (s *CreateVolumePermissionModifications) SetAdd(v []*CreateVolumePermission) *CreateVolumePermissionModifications {
 	s.Add = v
@@ -27213,6 +27507,24 @@ func (s *CreateVpcInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateVpcInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateVpcInput"}
+	if s.InstanceTenancy != nil {
+		values := []string{"default", "dedicated", "host"}
+		if !request.IsParamEnumValue(*s.InstanceTenancy, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceTenancy", *s.InstanceTenancy, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAmazonProvidedIpv6CidrBlock sets the AmazonProvidedIpv6CidrBlock field's value.
 func (s *CreateVpcInput) SetAmazonProvidedIpv6CidrBlock(v bool) *CreateVpcInput {
 	s.AmazonProvidedIpv6CidrBlock = &v
@@ -27574,6 +27886,24 @@ func (s *CreateVpnGatewayInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateVpnGatewayInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateVpnGatewayInput"}
+	if s.Type != nil {
+		values := []string{"ipsec.1"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAvailabilityZone sets the AvailabilityZone field's value.
 func (s *CreateVpnGatewayInput) SetAvailabilityZone(v string) *CreateVpnGatewayInput {
 	s.AvailabilityZone = &v
@@ -30788,6 +31118,24 @@ func (s *DescribeFpgaImageAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeFpgaImageAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeFpgaImageAttributeInput"}
+	if s.Attribute != nil {
+		values := []string{"description", "name", "loadPermission", "productCodes"}
+		if !request.IsParamEnumValue(*s.Attribute, values) {
+			invalidParams.Add(request.NewErrParamEnum("Attribute", *s.Attribute, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *DescribeFpgaImageAttributeInput) SetAttribute(v string) *DescribeFpgaImageAttributeInput {
 	s.Attribute = &v
@@ -30918,6 +31266,24 @@ func (s *DescribeFpgaImagesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeFpgaImagesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeFpgaImagesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 255 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 255))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDryRun sets the DryRun field's value.
 func (s *DescribeFpgaImagesInput) SetDryRun(v bool) *DescribeFpgaImagesInput {
 	s.DryRun = &v
@@ -31357,6 +31723,24 @@ func (s *DescribeIamInstanceProfileAssociationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeIamInstanceProfileAssociationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeIamInstanceProfileAssociationsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 255 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 255))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssociationIds sets the AssociationIds field's value.
 func (s *DescribeIamInstanceProfileAssociationsInput) SetAssociationIds(v []*string) *DescribeIamInstanceProfileAssociationsInput {
 	s.AssociationIds = v
@@ -31592,6 +31976,24 @@ func (s *DescribeImageAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeImageAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeImageAttributeInput"}
+	if s.Attribute != nil {
+		values := []string{"description", "kernel", "ramdisk", "launchPermission", "productCodes", "blockDeviceMapping", "sriovNetSupport"}
+		if !request.IsParamEnumValue(*s.Attribute, values) {
+			invalidParams.Add(request.NewErrParamEnum("Attribute", *s.Attribute, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *DescribeImageAttributeInput) SetAttribute(v string) *DescribeImageAttributeInput {
 	s.Attribute = &v
@@ -32126,6 +32528,24 @@ func (s *DescribeInstanceAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeInstanceAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeInstanceAttributeInput"}
+	if s.Attribute != nil {
+		values := []string{"instanceType", "kernel", "ramdisk", "userData", "disableApiTermination", "instanceInitiatedShutdownBehavior", "rootDeviceName", "blockDeviceMapping", "productCodes", "sourceDestCheck", "groupSet", "ebsOptimized", "sriovNetSupport", "enaSupport"}
+		if !request.IsParamEnumValue(*s.Attribute, values) {
+			invalidParams.Add(request.NewErrParamEnum("Attribute", *s.Attribute, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *DescribeInstanceAttributeInput) SetAttribute(v string) *DescribeInstanceAttributeInput {
 	s.Attribute = &v
@@ -33362,6 +33782,24 @@ func (s *DescribeNetworkInterfaceAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeNetworkInterfaceAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeNetworkInterfaceAttributeInput"}
+	if s.Attribute != nil {
+		values := []string{"description", "groupSet", "sourceDestCheck", "attachment"}
+		if !request.IsParamEnumValue(*s.Attribute, values) {
+			invalidParams.Add(request.NewErrParamEnum("Attribute", *s.Attribute, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *DescribeNetworkInterfaceAttributeInput) SetAttribute(v string) *DescribeNetworkInterfaceAttributeInput {
 	s.Attribute = &v
@@ -34079,6 +34517,30 @@ func (s DescribeReservedInstancesInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeReservedInstancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeReservedInstancesInput"}
+	if s.OfferingClass != nil {
+		values := []string{"standard", "convertible"}
+		if !request.IsParamEnumValue(*s.OfferingClass, values) {
+			invalidParams.Add(request.NewErrParamEnum("OfferingClass", *s.OfferingClass, values))
+		}
+	}
+	if s.OfferingType != nil {
+		values := []string{"Heavy Utilization", "Medium Utilization", "Light Utilization", "No Upfront", "Partial Upfront", "All Upfront"}
+		if !request.IsParamEnumValue(*s.OfferingType, values) {
+			invalidParams.Add(request.NewErrParamEnum("OfferingType", *s.OfferingType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDryRun sets the DryRun field's value.
 func (s *DescribeReservedInstancesInput) SetDryRun(v bool) *DescribeReservedInstancesInput {
 	s.DryRun = &v
@@ -34417,6 +34879,48 @@ func (s DescribeReservedInstancesOfferingsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeReservedInstancesOfferingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeReservedInstancesOfferingsInput"}
+	if s.InstanceTenancy != nil {
+		values := []string{"default", "dedicated", "host"}
+		if !request.IsParamEnumValue(*s.InstanceTenancy, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceTenancy", *s.InstanceTenancy, values))
+		}
+	}
+	if s.InstanceType != nil {
+		values := []string{"t1.micro", "t2.nano", "t2.micro", "t2.small", "t2.medium", "t2.large", "t2.xlarge", "t2.2xlarge", "m1.small", "m1.medium", "m1.large", "m1.xlarge", "m3.medium", "m3.large", "m3.xlarge", "m3.2xlarge", "m4.large", "m4.xlarge", "m4.2xlarge", "m4.4xlarge", "m4.10xlarge", "m4.16xlarge", "m2.xlarge", "m2.2xlarge", "m2.4xlarge", "cr1.8xlarge", "r3.large", "r3.xlarge", "r3.2xlarge", "r3.4xlarge", "r3.8xlarge", "r4.large", "r4.xlarge", "r4.2xlarge", "r4.4xlarge", "r4.8xlarge", "r4.16xlarge", "x1.16xlarge", "x1.32xlarge", "x1e.32xlarge", "i2.xlarge", "i2.2xlarge", "i2.4xlarge", "i2.8xlarge", "i3.large", "i3.xlarge", "i3.2xlarge", "i3.4xlarge", "i3.8xlarge", "i3.16xlarge", "hi1.4xlarge", "hs1.8xlarge", "c1.medium", "c1.xlarge", "c3.large", "c3.xlarge", "c3.2xlarge", "c3.4xlarge", "c3.8xlarge", "c4.large", "c4.xlarge", "c4.2xlarge", "c4.4xlarge", "c4.8xlarge", "cc1.4xlarge", "cc2.8xlarge", "g2.2xlarge", "g2.8xlarge", "g3.4xlarge", "g3.8xlarge", "g3.16xlarge", "cg1.4xlarge", "p2.xlarge", "p2.8xlarge", "p2.16xlarge", "d2.xlarge", "d2.2xlarge", "d2.4xlarge", "d2.8xlarge", "f1.2xlarge", "f1.16xlarge"}
+		if !request.IsParamEnumValue(*s.InstanceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceType", *s.InstanceType, values))
+		}
+	}
+	if s.OfferingClass != nil {
+		values := []string{"standard", "convertible"}
+		if !request.IsParamEnumValue(*s.OfferingClass, values) {
+			invalidParams.Add(request.NewErrParamEnum("OfferingClass", *s.OfferingClass, values))
+		}
+	}
+	if s.OfferingType != nil {
+		values := []string{"Heavy Utilization", "Medium Utilization", "Light Utilization", "No Upfront", "Partial Upfront", "All Upfront"}
+		if !request.IsParamEnumValue(*s.OfferingType, values) {
+			invalidParams.Add(request.NewErrParamEnum("OfferingType", *s.OfferingType, values))
+		}
+	}
+	if s.ProductDescription != nil {
+		values := []string{"Linux/UNIX", "Linux/UNIX (Amazon VPC)", "Windows", "Windows (Amazon VPC)"}
+		if !request.IsParamEnumValue(*s.ProductDescription, values) {
+			invalidParams.Add(request.NewErrParamEnum("ProductDescription", *s.ProductDescription, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAvailabilityZone sets the AvailabilityZone field's value.
 func (s *DescribeReservedInstancesOfferingsInput) SetAvailabilityZone(v string) *DescribeReservedInstancesOfferingsInput {
 	s.AvailabilityZone = &v
@@ -35242,6 +35746,24 @@ func (s *DescribeSnapshotAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeSnapshotAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeSnapshotAttributeInput"}
+	if s.Attribute != nil {
+		values := []string{"productCodes", "createVolumePermission"}
+		if !request.IsParamEnumValue(*s.Attribute, values) {
+			invalidParams.Add(request.NewErrParamEnum("Attribute", *s.Attribute, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *DescribeSnapshotAttributeInput) SetAttribute(v string) *DescribeSnapshotAttributeInput {
 	s.Attribute = &v
@@ -35707,6 +36229,24 @@ func (s *DescribeSpotFleetRequestHistoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeSpotFleetRequestHistoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeSpotFleetRequestHistoryInput"}
+	if s.EventType != nil {
+		values := []string{"instanceChange", "fleetRequestChange", "error"}
+		if !request.IsParamEnumValue(*s.EventType, values) {
+			invalidParams.Add(request.NewErrParamEnum("EventType", *s.EventType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDryRun sets the DryRun field's value.
 func (s *DescribeSpotFleetRequestHistoryInput) SetDryRun(v bool) *DescribeSpotFleetRequestHistoryInput {
 	s.DryRun = &v
@@ -36303,6 +36843,24 @@ func (s *DescribeStaleSecurityGroupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeStaleSecurityGroupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeStaleSecurityGroupsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 255 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 255))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDryRun sets the DryRun field's value.
 func (s *DescribeStaleSecurityGroupsInput) SetDryRun(v bool) *DescribeStaleSecurityGroupsInput {
 	s.DryRun = &v
@@ -36624,6 +37182,24 @@ func (s *DescribeVolumeAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeVolumeAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeVolumeAttributeInput"}
+	if s.Attribute != nil {
+		values := []string{"autoEnableIO", "productCodes"}
+		if !request.IsParamEnumValue(*s.Attribute, values) {
+			invalidParams.Add(request.NewErrParamEnum("Attribute", *s.Attribute, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *DescribeVolumeAttributeInput) SetAttribute(v string) *DescribeVolumeAttributeInput {
 	s.Attribute = &v
@@ -37134,6 +37710,24 @@ func (s *DescribeVpcAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeVpcAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeVpcAttributeInput"}
+	if s.Attribute != nil {
+		values := []string{"enableDnsSupport", "enableDnsHostnames"}
+		if !request.IsParamEnumValue(*s.Attribute, values) {
+			invalidParams.Add(request.NewErrParamEnum("Attribute", *s.Attribute, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *DescribeVpcAttributeInput) SetAttribute(v string) *DescribeVpcAttributeInput {
 	s.Attribute = &v
@@ -37243,6 +37837,24 @@ func (s *DescribeVpcClassicLinkDnsSupportInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeVpcClassicLinkDnsSupportInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeVpcClassicLinkDnsSupportInput"}
+	if s.MaxResults != nil && *s.MaxResults > 255 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 255))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *DescribeVpcClassicLinkDnsSupportInput) SetMaxResults(v int64) *DescribeVpcClassicLinkDnsSupportInput {
 	s.MaxResults = &v
@@ -39116,6 +39728,23 @@ func (s *DiskImage) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DiskImage) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DiskImage"}
+	if s.Image != nil {
+		if err := s.Image.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Image", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *DiskImage) SetDescription(v string) *DiskImage {
 	s.Description = &v
@@ -39256,6 +39885,24 @@ func (s *DiskImageDetail) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DiskImageDetail) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DiskImageDetail"}
+	if s.Format != nil {
+		values := []string{"VMDK", "RAW", "VHD"}
+		if !request.IsParamEnumValue(*s.Format, values) {
+			invalidParams.Add(request.NewErrParamEnum("Format", *s.Format, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBytes sets the Bytes field's value.
 func (s *DiskImageDetail) SetBytes(v int64) *DiskImageDetail {
 	s.Bytes = &v
@@ -39368,6 +40015,24 @@ func (s EbsBlockDevice) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EbsBlockDevice) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EbsBlockDevice"}
+	if s.VolumeType != nil {
+		values := []string{"standard", "io1", "gp2", "sc1", "st1"}
+		if !request.IsParamEnumValue(*s.VolumeType, values) {
+			invalidParams.Add(request.NewErrParamEnum("VolumeType", *s.VolumeType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeleteOnTermination sets the DeleteOnTermination field's value.
 func (s *EbsBlockDevice) SetDeleteOnTermination(v bool) *EbsBlockDevice {
 	s.DeleteOnTermination = &v
@@ -40225,6 +40890,30 @@ func (s ExportToS3TaskSpecification) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ExportToS3TaskSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ExportToS3TaskSpecification"}
+	if s.ContainerFormat != nil {
+		values := []string{"ova"}
+		if !request.IsParamEnumValue(*s.ContainerFormat, values) {
+			invalidParams.Add(request.NewErrParamEnum("ContainerFormat", *s.ContainerFormat, values))
+		}
+	}
+	if s.DiskImageFormat != nil {
+		values := []string{"VMDK", "RAW", "VHD"}
+		if !request.IsParamEnumValue(*s.DiskImageFormat, values) {
+			invalidParams.Add(request.NewErrParamEnum("DiskImageFormat", *s.DiskImageFormat, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContainerFormat sets the ContainerFormat field's value.
 func (s *ExportToS3TaskSpecification) SetContainerFormat(v string) *ExportToS3TaskSpecification {
 	s.ContainerFormat = &v
@@ -42626,6 +43315,39 @@ func (s *ImportInstanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ImportInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ImportInstanceInput"}
+	if s.Platform != nil {
+		values := []string{"Windows"}
+		if !request.IsParamEnumValue(*s.Platform, values) {
+			invalidParams.Add(request.NewErrParamEnum("Platform", *s.Platform, values))
+		}
+	}
+	if s.DiskImages != nil {
+		for i, v := range s.DiskImages {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "DiskImages", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.LaunchSpecification != nil {
+		if err := s.LaunchSpecification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LaunchSpecification", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *ImportInstanceInput) SetDescription(v string) *ImportInstanceInput {
 	s.Description = &v
@@ -42710,6 +43432,41 @@ func (s ImportInstanceLaunchSpecification) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ImportInstanceLaunchSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ImportInstanceLaunchSpecification"}
+	if s.Architecture != nil {
+		values := []string{"i386", "x86_64"}
+		if !request.IsParamEnumValue(*s.Architecture, values) {
+			invalidParams.Add(request.NewErrParamEnum("Architecture", *s.Architecture, values))
+		}
+	}
+	if s.InstanceInitiatedShutdownBehavior != nil {
+		values := []string{"stop", "terminate"}
+		if !request.IsParamEnumValue(*s.InstanceInitiatedShutdownBehavior, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceInitiatedShutdownBehavior", *s.InstanceInitiatedShutdownBehavior, values))
+		}
+	}
+	if s.InstanceType != nil {
+		values := []string{"t1.micro", "t2.nano", "t2.micro", "t2.small", "t2.medium", "t2.large", "t2.xlarge", "t2.2xlarge", "m1.small", "m1.medium", "m1.large", "m1.xlarge", "m3.medium", "m3.large", "m3.xlarge", "m3.2xlarge", "m4.large", "m4.xlarge", "m4.2xlarge", "m4.4xlarge", "m4.10xlarge", "m4.16xlarge", "m2.xlarge", "m2.2xlarge", "m2.4xlarge", "cr1.8xlarge", "r3.large", "r3.xlarge", "r3.2xlarge", "r3.4xlarge", "r3.8xlarge", "r4.large", "r4.xlarge", "r4.2xlarge", "r4.4xlarge", "r4.8xlarge", "r4.16xlarge", "x1.16xlarge", "x1.32xlarge", "x1e.32xlarge", "i2.xlarge", "i2.2xlarge", "i2.4xlarge", "i2.8xlarge", "i3.large", "i3.xlarge", "i3.2xlarge", "i3.4xlarge", "i3.8xlarge", "i3.16xlarge", "hi1.4xlarge", "hs1.8xlarge", "c1.medium", "c1.xlarge", "c3.large", "c3.xlarge", "c3.2xlarge", "c3.4xlarge", "c3.8xlarge", "c4.large", "c4.xlarge", "c4.2xlarge", "c4.4xlarge", "c4.8xlarge", "cc1.4xlarge", "cc2.8xlarge", "g2.2xlarge", "g2.8xlarge", "g3.4xlarge", "g3.8xlarge", "g3.16xlarge", "cg1.4xlarge", "p2.xlarge", "p2.8xlarge", "p2.16xlarge", "d2.xlarge", "d2.2xlarge", "d2.4xlarge", "d2.8xlarge", "f1.2xlarge", "f1.16xlarge"}
+		if !request.IsParamEnumValue(*s.InstanceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceType", *s.InstanceType, values))
+		}
+	}
+	if s.Placement != nil {
+		if err := s.Placement.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Placement", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdditionalInfo sets the AdditionalInfo field's value.
 func (s *ImportInstanceLaunchSpecification) SetAdditionalInfo(v string) *ImportInstanceLaunchSpecification {
 	s.AdditionalInfo = &v
@@ -43275,6 +44032,23 @@ func (s *ImportVolumeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ImportVolumeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ImportVolumeInput"}
+	if s.Image != nil {
+		if err := s.Image.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Image", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAvailabilityZone sets the AvailabilityZone field's value.
 func (s *ImportVolumeInput) SetAvailabilityZone(v string) *ImportVolumeInput {
 	s.AvailabilityZone = &v
@@ -45156,6 +45930,24 @@ func (s LaunchPermission) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LaunchPermission) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LaunchPermission"}
+	if s.Group != nil {
+		values := []string{"all"}
+		if !request.IsParamEnumValue(*s.Group, values) {
+			invalidParams.Add(request.NewErrParamEnum("Group", *s.Group, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroup sets the Group field's value.
 func (s *LaunchPermission) SetGroup(v string) *LaunchPermission {
 	s.Group = &v
@@ -45191,6 +45983,38 @@ func (s LaunchPermissionModifications) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LaunchPermissionModifications) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LaunchPermissionModifications"}
+	if s.Add != nil {
+		for i, v := range s.Add {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Add", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Remove != nil {
+		for i, v := range s.Remove {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Remove", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdd sets the Add field's value.
 func (s *LaunchPermissionModifications) SetAdd(v []*LaunchPermission) *LaunchPermissionModifications {
 	s.Add = v
@@ -45424,6 +46248,38 @@ func (s LoadPermissionModifications) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LoadPermissionModifications) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LoadPermissionModifications"}
+	if s.Add != nil {
+		for i, v := range s.Add {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Add", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Remove != nil {
+		for i, v := range s.Remove {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Remove", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdd sets the Add field's value.
 func (s *LoadPermissionModifications) SetAdd(v []*LoadPermissionRequest) *LoadPermissionModifications {
 	s.Add = v
@@ -45458,6 +46314,24 @@ func (s LoadPermissionRequest) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LoadPermissionRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LoadPermissionRequest"}
+	if s.Group != nil {
+		values := []string{"all"}
+		if !request.IsParamEnumValue(*s.Group, values) {
+			invalidParams.Add(request.NewErrParamEnum("Group", *s.Group, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroup sets the Group field's value.
 func (s *LoadPermissionRequest) SetGroup(v string) *LoadPermissionRequest {
 	s.Group = &v
@@ -45537,6 +46411,35 @@ func (s *ModifyFpgaImageAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyFpgaImageAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyFpgaImageAttributeInput"}
+	if s.Attribute != nil {
+		values := []string{"description", "name", "loadPermission", "productCodes"}
+		if !request.IsParamEnumValue(*s.Attribute, values) {
+			invalidParams.Add(request.NewErrParamEnum("Attribute", *s.Attribute, values))
+		}
+	}
+	if s.OperationType != nil {
+		values := []string{"add", "remove"}
+		if !request.IsParamEnumValue(*s.OperationType, values) {
+			invalidParams.Add(request.NewErrParamEnum("OperationType", *s.OperationType, values))
+		}
+	}
+	if s.LoadPermission != nil {
+		if err := s.LoadPermission.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LoadPermission", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *ModifyFpgaImageAttributeInput) SetAttribute(v string) *ModifyFpgaImageAttributeInput {
 	s.Attribute = &v
@@ -45663,6 +46566,24 @@ func (s *ModifyHostsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyHostsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyHostsInput"}
+	if s.AutoPlacement != nil {
+		values := []string{"on", "off"}
+		if !request.IsParamEnumValue(*s.AutoPlacement, values) {
+			invalidParams.Add(request.NewErrParamEnum("AutoPlacement", *s.AutoPlacement, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoPlacement sets the AutoPlacement field's value.
 func (s *ModifyHostsInput) SetAutoPlacement(v string) *ModifyHostsInput {
 	s.AutoPlacement = &v
@@ -45932,6 +46853,29 @@ func (s *ModifyImageAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyImageAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyImageAttributeInput"}
+	if s.OperationType != nil {
+		values := []string{"add", "remove"}
+		if !request.IsParamEnumValue(*s.OperationType, values) {
+			invalidParams.Add(request.NewErrParamEnum("OperationType", *s.OperationType, values))
+		}
+	}
+	if s.LaunchPermission != nil {
+		if err := s.LaunchPermission.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LaunchPermission", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *ModifyImageAttributeInput) SetAttribute(v string) *ModifyImageAttributeInput {
 	s.Attribute = &v
@@ -46128,6 +47072,24 @@ func (s *ModifyInstanceAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyInstanceAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyInstanceAttributeInput"}
+	if s.Attribute != nil {
+		values := []string{"instanceType", "kernel", "ramdisk", "userData", "disableApiTermination", "instanceInitiatedShutdownBehavior", "rootDeviceName", "blockDeviceMapping", "productCodes", "sourceDestCheck", "groupSet", "ebsOptimized", "sriovNetSupport", "enaSupport"}
+		if !request.IsParamEnumValue(*s.Attribute, values) {
+			invalidParams.Add(request.NewErrParamEnum("Attribute", *s.Attribute, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *ModifyInstanceAttributeInput) SetAttribute(v string) *ModifyInstanceAttributeInput {
 	s.Attribute = &v
@@ -46282,6 +47244,30 @@ func (s *ModifyInstancePlacementInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyInstancePlacementInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyInstancePlacementInput"}
+	if s.Affinity != nil {
+		values := []string{"default", "host"}
+		if !request.IsParamEnumValue(*s.Affinity, values) {
+			invalidParams.Add(request.NewErrParamEnum("Affinity", *s.Affinity, values))
+		}
+	}
+	if s.Tenancy != nil {
+		values := []string{"dedicated", "host"}
+		if !request.IsParamEnumValue(*s.Tenancy, values) {
+			invalidParams.Add(request.NewErrParamEnum("Tenancy", *s.Tenancy, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAffinity sets the Affinity field's value.
 func (s *ModifyInstancePlacementInput) SetAffinity(v string) *ModifyInstancePlacementInput {
 	s.Affinity = &v
@@ -46488,6 +47474,28 @@ func (s *ModifyReservedInstancesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyReservedInstancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyReservedInstancesInput"}
+	if s.TargetConfigurations != nil {
+		for i, v := range s.TargetConfigurations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TargetConfigurations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientToken sets the ClientToken field's value.
 func (s *ModifyReservedInstancesInput) SetClientToken(v string) *ModifyReservedInstancesInput {
 	s.ClientToken = &v
@@ -46588,6 +47596,35 @@ func (s *ModifySnapshotAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifySnapshotAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifySnapshotAttributeInput"}
+	if s.Attribute != nil {
+		values := []string{"productCodes", "createVolumePermission"}
+		if !request.IsParamEnumValue(*s.Attribute, values) {
+			invalidParams.Add(request.NewErrParamEnum("Attribute", *s.Attribute, values))
+		}
+	}
+	if s.OperationType != nil {
+		values := []string{"add", "remove"}
+		if !request.IsParamEnumValue(*s.OperationType, values) {
+			invalidParams.Add(request.NewErrParamEnum("OperationType", *s.OperationType, values))
+		}
+	}
+	if s.CreateVolumePermission != nil {
+		if err := s.CreateVolumePermission.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CreateVolumePermission", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *ModifySnapshotAttributeInput) SetAttribute(v string) *ModifySnapshotAttributeInput {
 	s.Attribute = &v
@@ -46687,6 +47724,24 @@ func (s *ModifySpotFleetRequestInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifySpotFleetRequestInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifySpotFleetRequestInput"}
+	if s.ExcessCapacityTerminationPolicy != nil {
+		values := []string{"noTermination", "default"}
+		if !request.IsParamEnumValue(*s.ExcessCapacityTerminationPolicy, values) {
+			invalidParams.Add(request.NewErrParamEnum("ExcessCapacityTerminationPolicy", *s.ExcessCapacityTerminationPolicy, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExcessCapacityTerminationPolicy sets the ExcessCapacityTerminationPolicy field's value.
 func (s *ModifySpotFleetRequestInput) SetExcessCapacityTerminationPolicy(v string) *ModifySpotFleetRequestInput {
 	s.ExcessCapacityTerminationPolicy = &v
@@ -46951,6 +48006,24 @@ func (s *ModifyVolumeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyVolumeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyVolumeInput"}
+	if s.VolumeType != nil {
+		values := []string{"standard", "io1", "gp2", "sc1", "st1"}
+		if !request.IsParamEnumValue(*s.VolumeType, values) {
+			invalidParams.Add(request.NewErrParamEnum("VolumeType", *s.VolumeType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDryRun sets the DryRun field's value.
 func (s *ModifyVolumeInput) SetDryRun(v bool) *ModifyVolumeInput {
 	s.DryRun = &v
@@ -48711,6 +49784,24 @@ func (s Placement) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Placement) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Placement"}
+	if s.Tenancy != nil {
+		values := []string{"default", "dedicated", "host"}
+		if !request.IsParamEnumValue(*s.Tenancy, values) {
+			invalidParams.Add(request.NewErrParamEnum("Tenancy", *s.Tenancy, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAffinity sets the Affinity field's value.
 func (s *Placement) SetAffinity(v string) *Placement {
 	s.Affinity = &v
@@ -48995,6 +50086,24 @@ func (s PriceScheduleSpecification) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PriceScheduleSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PriceScheduleSpecification"}
+	if s.CurrencyCode != nil {
+		values := []string{"USD"}
+		if !request.IsParamEnumValue(*s.CurrencyCode, values) {
+			invalidParams.Add(request.NewErrParamEnum("CurrencyCode", *s.CurrencyCode, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCurrencyCode sets the CurrencyCode field's value.
 func (s *PriceScheduleSpecification) SetCurrencyCode(v string) *PriceScheduleSpecification {
 	s.CurrencyCode = &v
@@ -49379,6 +50488,24 @@ func (s *PurchaseHostReservationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PurchaseHostReservationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PurchaseHostReservationInput"}
+	if s.CurrencyCode != nil {
+		values := []string{"USD"}
+		if !request.IsParamEnumValue(*s.CurrencyCode, values) {
+			invalidParams.Add(request.NewErrParamEnum("CurrencyCode", *s.CurrencyCode, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientToken sets the ClientToken field's value.
 func (s *PurchaseHostReservationInput) SetClientToken(v string) *PurchaseHostReservationInput {
 	s.ClientToken = &v
@@ -49580,6 +50707,23 @@ func (s *PurchaseReservedInstancesOfferingInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PurchaseReservedInstancesOfferingInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PurchaseReservedInstancesOfferingInput"}
+	if s.LimitPrice != nil {
+		if err := s.LimitPrice.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LimitPrice", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDryRun sets the DryRun field's value.
 func (s *PurchaseReservedInstancesOfferingInput) SetDryRun(v bool) *PurchaseReservedInstancesOfferingInput {
 	s.DryRun = &v
@@ -49959,6 +51103,34 @@ func (s *RegisterImageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterImageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterImageInput"}
+	if s.Architecture != nil {
+		values := []string{"i386", "x86_64"}
+		if !request.IsParamEnumValue(*s.Architecture, values) {
+			invalidParams.Add(request.NewErrParamEnum("Architecture", *s.Architecture, values))
+		}
+	}
+	if s.BlockDeviceMappings != nil {
+		for i, v := range s.BlockDeviceMappings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "BlockDeviceMappings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArchitecture sets the Architecture field's value.
 func (s *RegisterImageInput) SetArchitecture(v string) *RegisterImageInput {
 	s.Architecture = &v
@@ -50538,6 +51710,24 @@ func (s *ReplaceNetworkAclEntryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReplaceNetworkAclEntryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReplaceNetworkAclEntryInput"}
+	if s.RuleAction != nil {
+		values := []string{"allow", "deny"}
+		if !request.IsParamEnumValue(*s.RuleAction, values) {
+			invalidParams.Add(request.NewErrParamEnum("RuleAction", *s.RuleAction, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCidrBlock sets the CidrBlock field's value.
 func (s *ReplaceNetworkAclEntryInput) SetCidrBlock(v string) *ReplaceNetworkAclEntryInput {
 	s.CidrBlock = &v
@@ -50931,6 +52121,24 @@ func (s *ReportInstanceStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReportInstanceStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReportInstanceStatusInput"}
+	if s.Status != nil {
+		values := []string{"ok", "impaired"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *ReportInstanceStatusInput) SetDescription(v string) *ReportInstanceStatusInput {
 	s.Description = &v
@@ -51033,6 +52241,23 @@ func (s *RequestSpotFleetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RequestSpotFleetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RequestSpotFleetInput"}
+	if s.SpotFleetRequestConfig != nil {
+		if err := s.SpotFleetRequestConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SpotFleetRequestConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDryRun sets the DryRun field's value.
 func (s *RequestSpotFleetInput) SetDryRun(v bool) *RequestSpotFleetInput {
 	s.DryRun = &v
@@ -51196,6 +52421,35 @@ func (s *RequestSpotInstancesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RequestSpotInstancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RequestSpotInstancesInput"}
+	if s.InstanceInterruptionBehavior != nil {
+		values := []string{"stop", "terminate"}
+		if !request.IsParamEnumValue(*s.InstanceInterruptionBehavior, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceInterruptionBehavior", *s.InstanceInterruptionBehavior, values))
+		}
+	}
+	if s.Type != nil {
+		values := []string{"one-time", "persistent"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.LaunchSpecification != nil {
+		if err := s.LaunchSpecification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LaunchSpecification", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAvailabilityZoneGroup sets the AvailabilityZoneGroup field's value.
 func (s *RequestSpotInstancesInput) SetAvailabilityZoneGroup(v string) *RequestSpotInstancesInput {
 	s.AvailabilityZoneGroup = &v
@@ -51398,6 +52652,39 @@ func (s *RequestSpotLaunchSpecification) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RequestSpotLaunchSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RequestSpotLaunchSpecification"}
+	if s.InstanceType != nil {
+		values := []string{"t1.micro", "t2.nano", "t2.micro", "t2.small", "t2.medium", "t2.large", "t2.xlarge", "t2.2xlarge", "m1.small", "m1.medium", "m1.large", "m1.xlarge", "m3.medium", "m3.large", "m3.xlarge", "m3.2xlarge", "m4.large", "m4.xlarge", "m4.2xlarge", "m4.4xlarge", "m4.10xlarge", "m4.16xlarge", "m2.xlarge", "m2.2xlarge", "m2.4xlarge", "cr1.8xlarge", "r3.large", "r3.xlarge", "r3.2xlarge", "r3.4xlarge", "r3.8xlarge", "r4.large", "r4.xlarge", "r4.2xlarge", "r4.4xlarge", "r4.8xlarge", "r4.16xlarge", "x1.16xlarge", "x1.32xlarge", "x1e.32xlarge", "i2.xlarge", "i2.2xlarge", "i2.4xlarge", "i2.8xlarge", "i3.large", "i3.xlarge", "i3.2xlarge", "i3.4xlarge", "i3.8xlarge", "i3.16xlarge", "hi1.4xlarge", "hs1.8xlarge", "c1.medium", "c1.xlarge", "c3.large", "c3.xlarge", "c3.2xlarge", "c3.4xlarge", "c3.8xlarge", "c4.large", "c4.xlarge", "c4.2xlarge", "c4.4xlarge", "c4.8xlarge", "cc1.4xlarge", "cc2.8xlarge", "g2.2xlarge", "g2.8xlarge", "g3.4xlarge", "g3.8xlarge", "g3.16xlarge", "cg1.4xlarge", "p2.xlarge", "p2.8xlarge", "p2.16xlarge", "d2.xlarge", "d2.2xlarge", "d2.4xlarge", "d2.8xlarge", "f1.2xlarge", "f1.16xlarge"}
+		if !request.IsParamEnumValue(*s.InstanceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceType", *s.InstanceType, values))
+		}
+	}
+	if s.BlockDeviceMappings != nil {
+		for i, v := range s.BlockDeviceMappings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "BlockDeviceMappings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Placement != nil {
+		if err := s.Placement.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Placement", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddressingType sets the AddressingType field's value.
 func (s *RequestSpotLaunchSpecification) SetAddressingType(v string) *RequestSpotLaunchSpecification {
 	s.AddressingType = &v
@@ -51624,6 +52911,24 @@ func (s ReservedInstanceLimitPrice) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReservedInstanceLimitPrice) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReservedInstanceLimitPrice"}
+	if s.CurrencyCode != nil {
+		values := []string{"USD"}
+		if !request.IsParamEnumValue(*s.CurrencyCode, values) {
+			invalidParams.Add(request.NewErrParamEnum("CurrencyCode", *s.CurrencyCode, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAmount sets the Amount field's value.
 func (s *ReservedInstanceLimitPrice) SetAmount(v float64) *ReservedInstanceLimitPrice {
 	s.Amount = &v
@@ -51882,6 +53187,30 @@ func (s ReservedInstancesConfiguration) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReservedInstancesConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReservedInstancesConfiguration"}
+	if s.InstanceType != nil {
+		values := []string{"t1.micro", "t2.nano", "t2.micro", "t2.small", "t2.medium", "t2.large", "t2.xlarge", "t2.2xlarge", "m1.small", "m1.medium", "m1.large", "m1.xlarge", "m3.medium", "m3.large", "m3.xlarge", "m3.2xlarge", "m4.large", "m4.xlarge", "m4.2xlarge", "m4.4xlarge", "m4.10xlarge", "m4.16xlarge", "m2.xlarge", "m2.2xlarge", "m2.4xlarge", "cr1.8xlarge", "r3.large", "r3.xlarge", "r3.2xlarge", "r3.4xlarge", "r3.8xlarge", "r4.large", "r4.xlarge", "r4.2xlarge", "r4.4xlarge", "r4.8xlarge", "r4.16xlarge", "x1.16xlarge", "x1.32xlarge", "x1e.32xlarge", "i2.xlarge", "i2.2xlarge", "i2.4xlarge", "i2.8xlarge", "i3.large", "i3.xlarge", "i3.2xlarge", "i3.4xlarge", "i3.8xlarge", "i3.16xlarge", "hi1.4xlarge", "hs1.8xlarge", "c1.medium", "c1.xlarge", "c3.large", "c3.xlarge", "c3.2xlarge", "c3.4xlarge", "c3.8xlarge", "c4.large", "c4.xlarge", "c4.2xlarge", "c4.4xlarge", "c4.8xlarge", "cc1.4xlarge", "cc2.8xlarge", "g2.2xlarge", "g2.8xlarge", "g3.4xlarge", "g3.8xlarge", "g3.16xlarge", "cg1.4xlarge", "p2.xlarge", "p2.8xlarge", "p2.16xlarge", "d2.xlarge", "d2.2xlarge", "d2.4xlarge", "d2.8xlarge", "f1.2xlarge", "f1.16xlarge"}
+		if !request.IsParamEnumValue(*s.InstanceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceType", *s.InstanceType, values))
+		}
+	}
+	if s.Scope != nil {
+		values := []string{"Availability Zone", "Region"}
+		if !request.IsParamEnumValue(*s.Scope, values) {
+			invalidParams.Add(request.NewErrParamEnum("Scope", *s.Scope, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAvailabilityZone sets the AvailabilityZone field's value.
 func (s *ReservedInstancesConfiguration) SetAvailabilityZone(v string) *ReservedInstancesConfiguration {
 	s.AvailabilityZone = &v
@@ -52381,6 +53710,24 @@ func (s *ResetFpgaImageAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResetFpgaImageAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResetFpgaImageAttributeInput"}
+	if s.Attribute != nil {
+		values := []string{"loadPermission"}
+		if !request.IsParamEnumValue(*s.Attribute, values) {
+			invalidParams.Add(request.NewErrParamEnum("Attribute", *s.Attribute, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *ResetFpgaImageAttributeInput) SetAttribute(v string) *ResetFpgaImageAttributeInput {
 	s.Attribute = &v
@@ -52472,6 +53819,24 @@ func (s *ResetImageAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResetImageAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResetImageAttributeInput"}
+	if s.Attribute != nil {
+		values := []string{"launchPermission"}
+		if !request.IsParamEnumValue(*s.Attribute, values) {
+			invalidParams.Add(request.NewErrParamEnum("Attribute", *s.Attribute, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *ResetImageAttributeInput) SetAttribute(v string) *ResetImageAttributeInput {
 	s.Attribute = &v
@@ -52556,6 +53921,24 @@ func (s *ResetInstanceAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResetInstanceAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResetInstanceAttributeInput"}
+	if s.Attribute != nil {
+		values := []string{"instanceType", "kernel", "ramdisk", "userData", "disableApiTermination", "instanceInitiatedShutdownBehavior", "rootDeviceName", "blockDeviceMapping", "productCodes", "sourceDestCheck", "groupSet", "ebsOptimized", "sriovNetSupport", "enaSupport"}
+		if !request.IsParamEnumValue(*s.Attribute, values) {
+			invalidParams.Add(request.NewErrParamEnum("Attribute", *s.Attribute, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *ResetInstanceAttributeInput) SetAttribute(v string) *ResetInstanceAttributeInput {
 	s.Attribute = &v
@@ -52714,6 +54097,24 @@ func (s *ResetSnapshotAttributeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResetSnapshotAttributeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResetSnapshotAttributeInput"}
+	if s.Attribute != nil {
+		values := []string{"productCodes", "createVolumePermission"}
+		if !request.IsParamEnumValue(*s.Attribute, values) {
+			invalidParams.Add(request.NewErrParamEnum("Attribute", *s.Attribute, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttribute sets the Attribute field's value.
 func (s *ResetSnapshotAttributeInput) SetAttribute(v string) *ResetSnapshotAttributeInput {
 	s.Attribute = &v
@@ -53596,6 +54997,55 @@ func (s *RunInstancesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RunInstancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RunInstancesInput"}
+	if s.InstanceInitiatedShutdownBehavior != nil {
+		values := []string{"stop", "terminate"}
+		if !request.IsParamEnumValue(*s.InstanceInitiatedShutdownBehavior, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceInitiatedShutdownBehavior", *s.InstanceInitiatedShutdownBehavior, values))
+		}
+	}
+	if s.InstanceType != nil {
+		values := []string{"t1.micro", "t2.nano", "t2.micro", "t2.small", "t2.medium", "t2.large", "t2.xlarge", "t2.2xlarge", "m1.small", "m1.medium", "m1.large", "m1.xlarge", "m3.medium", "m3.large", "m3.xlarge", "m3.2xlarge", "m4.large", "m4.xlarge", "m4.2xlarge", "m4.4xlarge", "m4.10xlarge", "m4.16xlarge", "m2.xlarge", "m2.2xlarge", "m2.4xlarge", "cr1.8xlarge", "r3.large", "r3.xlarge", "r3.2xlarge", "r3.4xlarge", "r3.8xlarge", "r4.large", "r4.xlarge", "r4.2xlarge", "r4.4xlarge", "r4.8xlarge", "r4.16xlarge", "x1.16xlarge", "x1.32xlarge", "x1e.32xlarge", "i2.xlarge", "i2.2xlarge", "i2.4xlarge", "i2.8xlarge", "i3.large", "i3.xlarge", "i3.2xlarge", "i3.4xlarge", "i3.8xlarge", "i3.16xlarge", "hi1.4xlarge", "hs1.8xlarge", "c1.medium", "c1.xlarge", "c3.large", "c3.xlarge", "c3.2xlarge", "c3.4xlarge", "c3.8xlarge", "c4.large", "c4.xlarge", "c4.2xlarge", "c4.4xlarge", "c4.8xlarge", "cc1.4xlarge", "cc2.8xlarge", "g2.2xlarge", "g2.8xlarge", "g3.4xlarge", "g3.8xlarge", "g3.16xlarge", "cg1.4xlarge", "p2.xlarge", "p2.8xlarge", "p2.16xlarge", "d2.xlarge", "d2.2xlarge", "d2.4xlarge", "d2.8xlarge", "f1.2xlarge", "f1.16xlarge"}
+		if !request.IsParamEnumValue(*s.InstanceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceType", *s.InstanceType, values))
+		}
+	}
+	if s.BlockDeviceMappings != nil {
+		for i, v := range s.BlockDeviceMappings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "BlockDeviceMappings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Placement != nil {
+		if err := s.Placement.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Placement", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TagSpecifications != nil {
+		for i, v := range s.TagSpecifications {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TagSpecifications", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdditionalInfo sets the AdditionalInfo field's value.
 func (s *RunInstancesInput) SetAdditionalInfo(v string) *RunInstancesInput {
 	s.AdditionalInfo = &v
@@ -55819,6 +57269,49 @@ func (s *SpotFleetLaunchSpecification) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SpotFleetLaunchSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SpotFleetLaunchSpecification"}
+	if s.InstanceType != nil {
+		values := []string{"t1.micro", "t2.nano", "t2.micro", "t2.small", "t2.medium", "t2.large", "t2.xlarge", "t2.2xlarge", "m1.small", "m1.medium", "m1.large", "m1.xlarge", "m3.medium", "m3.large", "m3.xlarge", "m3.2xlarge", "m4.large", "m4.xlarge", "m4.2xlarge", "m4.4xlarge", "m4.10xlarge", "m4.16xlarge", "m2.xlarge", "m2.2xlarge", "m2.4xlarge", "cr1.8xlarge", "r3.large", "r3.xlarge", "r3.2xlarge", "r3.4xlarge", "r3.8xlarge", "r4.large", "r4.xlarge", "r4.2xlarge", "r4.4xlarge", "r4.8xlarge", "r4.16xlarge", "x1.16xlarge", "x1.32xlarge", "x1e.32xlarge", "i2.xlarge", "i2.2xlarge", "i2.4xlarge", "i2.8xlarge", "i3.large", "i3.xlarge", "i3.2xlarge", "i3.4xlarge", "i3.8xlarge", "i3.16xlarge", "hi1.4xlarge", "hs1.8xlarge", "c1.medium", "c1.xlarge", "c3.large", "c3.xlarge", "c3.2xlarge", "c3.4xlarge", "c3.8xlarge", "c4.large", "c4.xlarge", "c4.2xlarge", "c4.4xlarge", "c4.8xlarge", "cc1.4xlarge", "cc2.8xlarge", "g2.2xlarge", "g2.8xlarge", "g3.4xlarge", "g3.8xlarge", "g3.16xlarge", "cg1.4xlarge", "p2.xlarge", "p2.8xlarge", "p2.16xlarge", "d2.xlarge", "d2.2xlarge", "d2.4xlarge", "d2.8xlarge", "f1.2xlarge", "f1.16xlarge"}
+		if !request.IsParamEnumValue(*s.InstanceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceType", *s.InstanceType, values))
+		}
+	}
+	if s.BlockDeviceMappings != nil {
+		for i, v := range s.BlockDeviceMappings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "BlockDeviceMappings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Placement != nil {
+		if err := s.Placement.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Placement", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TagSpecifications != nil {
+		for i, v := range s.TagSpecifications {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TagSpecifications", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddressingType sets the AddressingType field's value.
 func (s *SpotFleetLaunchSpecification) SetAddressingType(v string) *SpotFleetLaunchSpecification {
 	s.AddressingType = &v
@@ -56149,6 +57642,52 @@ func (s *SpotFleetRequestConfigData) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SpotFleetRequestConfigData) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SpotFleetRequestConfigData"}
+	if s.AllocationStrategy != nil {
+		values := []string{"lowestPrice", "diversified"}
+		if !request.IsParamEnumValue(*s.AllocationStrategy, values) {
+			invalidParams.Add(request.NewErrParamEnum("AllocationStrategy", *s.AllocationStrategy, values))
+		}
+	}
+	if s.ExcessCapacityTerminationPolicy != nil {
+		values := []string{"noTermination", "default"}
+		if !request.IsParamEnumValue(*s.ExcessCapacityTerminationPolicy, values) {
+			invalidParams.Add(request.NewErrParamEnum("ExcessCapacityTerminationPolicy", *s.ExcessCapacityTerminationPolicy, values))
+		}
+	}
+	if s.InstanceInterruptionBehavior != nil {
+		values := []string{"stop", "terminate"}
+		if !request.IsParamEnumValue(*s.InstanceInterruptionBehavior, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceInterruptionBehavior", *s.InstanceInterruptionBehavior, values))
+		}
+	}
+	if s.Type != nil {
+		values := []string{"request", "maintain"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.LaunchSpecifications != nil {
+		for i, v := range s.LaunchSpecifications {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "LaunchSpecifications", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllocationStrategy sets the AllocationStrategy field's value.
 func (s *SpotFleetRequestConfigData) SetAllocationStrategy(v string) *SpotFleetRequestConfigData {
 	s.AllocationStrategy = &v
@@ -56256,6 +57795,24 @@ func (s SpotFleetTagSpecification) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SpotFleetTagSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SpotFleetTagSpecification"}
+	if s.ResourceType != nil {
+		values := []string{"customer-gateway", "dhcp-options", "image", "instance", "internet-gateway", "network-acl", "network-interface", "reserved-instances", "route-table", "snapshot", "spot-instances-request", "subnet", "security-group", "volume", "vpc", "vpn-connection", "vpn-gateway"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceType sets the ResourceType field's value.
 func (s *SpotFleetTagSpecification) SetResourceType(v string) *SpotFleetTagSpecification {
 	s.ResourceType = &v
@@ -56578,6 +58135,24 @@ func (s SpotPlacement) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SpotPlacement) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SpotPlacement"}
+	if s.Tenancy != nil {
+		values := []string{"default", "dedicated", "host"}
+		if !request.IsParamEnumValue(*s.Tenancy, values) {
+			invalidParams.Add(request.NewErrParamEnum("Tenancy", *s.Tenancy, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAvailabilityZone sets the AvailabilityZone field's value.
 func (s *SpotPlacement) SetAvailabilityZone(v string) *SpotPlacement {
 	s.AvailabilityZone = &v
@@ -57414,6 +58989,24 @@ func (s TagSpecification) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TagSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagSpecification"}
+	if s.ResourceType != nil {
+		values := []string{"customer-gateway", "dhcp-options", "image", "instance", "internet-gateway", "network-acl", "network-interface", "reserved-instances", "route-table", "snapshot", "spot-instances-request", "subnet", "security-group", "volume", "vpc", "vpn-connection", "vpn-gateway"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceType sets the ResourceType field's value.
 func (s *TagSpecification) SetResourceType(v string) *TagSpecification {
 	s.ResourceType = &v
@@ -44,6 +44,8 @@ func fillPresignedURL(r *request.Request) {
 		func(opt *endpoints.Options) {
 			opt.DisableSSL = aws.BoolValue(cfg.DisableSSL)
 			opt.UseDualStack = aws.BoolValue(cfg.UseDualStack)
+			opt.UseDualStackEndpoint = aws.BoolValue(cfg.UseDualStackEndpoint)
+			opt.UseFIPSEndpoint = aws.BoolValue(cfg.UseFIPSEndpoint)
 		},
 	)
 	if err != nil {
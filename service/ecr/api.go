@@ -1882,6 +1882,30 @@ func (s *BatchCheckLayerAvailabilityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchCheckLayerAvailabilityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchCheckLayerAvailabilityInput"}
+	if s.LayerDigests != nil && len(s.LayerDigests) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("LayerDigests", 100))
+	}
+	if s.RegistryId != nil && !request.MatchParamPattern("[0-9]{12}", *s.RegistryId) {
+		invalidParams.Add(request.NewErrParamPattern("RegistryId", "[0-9]{12}"))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 256))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLayerDigests sets the LayerDigests field's value.
 func (s *BatchCheckLayerAvailabilityInput) SetLayerDigests(v []*string) *BatchCheckLayerAvailabilityInput {
 	s.LayerDigests = v
@@ -1988,6 +2012,30 @@ func (s *BatchDeleteImageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchDeleteImageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchDeleteImageInput"}
+	if s.ImageIds != nil && len(s.ImageIds) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ImageIds", 100))
+	}
+	if s.RegistryId != nil && !request.MatchParamPattern("[0-9]{12}", *s.RegistryId) {
+		invalidParams.Add(request.NewErrParamPattern("RegistryId", "[0-9]{12}"))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 256))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetImageIds sets the ImageIds field's value.
 func (s *BatchDeleteImageInput) SetImageIds(v []*ImageIdentifier) *BatchDeleteImageInput {
 	s.ImageIds = v
@@ -2100,6 +2148,33 @@ func (s *BatchGetImageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchGetImageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchGetImageInput"}
+	if s.AcceptedMediaTypes != nil && len(s.AcceptedMediaTypes) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("AcceptedMediaTypes", 100))
+	}
+	if s.ImageIds != nil && len(s.ImageIds) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ImageIds", 100))
+	}
+	if s.RegistryId != nil && !request.MatchParamPattern("[0-9]{12}", *s.RegistryId) {
+		invalidParams.Add(request.NewErrParamPattern("RegistryId", "[0-9]{12}"))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 256))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptedMediaTypes sets the AcceptedMediaTypes field's value.
 func (s *BatchGetImageInput) SetAcceptedMediaTypes(v []*string) *BatchGetImageInput {
 	s.AcceptedMediaTypes = v
@@ -2217,6 +2292,33 @@ func (s *CompleteLayerUploadInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CompleteLayerUploadInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CompleteLayerUploadInput"}
+	if s.LayerDigests != nil && len(s.LayerDigests) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("LayerDigests", 100))
+	}
+	if s.RegistryId != nil && !request.MatchParamPattern("[0-9]{12}", *s.RegistryId) {
+		invalidParams.Add(request.NewErrParamPattern("RegistryId", "[0-9]{12}"))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 256))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"))
+	}
+	if s.UploadId != nil && !request.MatchParamPattern("[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}", *s.UploadId) {
+		invalidParams.Add(request.NewErrParamPattern("UploadId", "[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLayerDigests sets the LayerDigests field's value.
 func (s *CompleteLayerUploadInput) SetLayerDigests(v []*string) *CompleteLayerUploadInput {
 	s.LayerDigests = v
@@ -2330,6 +2432,24 @@ func (s *CreateRepositoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateRepositoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateRepositoryInput"}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 256))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRepositoryName sets the RepositoryName field's value.
 func (s *CreateRepositoryInput) SetRepositoryName(v string) *CreateRepositoryInput {
 	s.RepositoryName = &v
@@ -2403,6 +2523,27 @@ func (s *DeleteRepositoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteRepositoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteRepositoryInput"}
+	if s.RegistryId != nil && !request.MatchParamPattern("[0-9]{12}", *s.RegistryId) {
+		invalidParams.Add(request.NewErrParamPattern("RegistryId", "[0-9]{12}"))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 256))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetForce sets the Force field's value.
 func (s *DeleteRepositoryInput) SetForce(v bool) *DeleteRepositoryInput {
 	s.Force = &v
@@ -2487,6 +2628,27 @@ func (s *DeleteRepositoryPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteRepositoryPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteRepositoryPolicyInput"}
+	if s.RegistryId != nil && !request.MatchParamPattern("[0-9]{12}", *s.RegistryId) {
+		invalidParams.Add(request.NewErrParamPattern("RegistryId", "[0-9]{12}"))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 256))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRegistryId sets the RegistryId field's value.
 func (s *DeleteRepositoryPolicyInput) SetRegistryId(v string) *DeleteRepositoryPolicyInput {
 	s.RegistryId = &v
@@ -2561,6 +2723,24 @@ func (s DescribeImagesFilter) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeImagesFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeImagesFilter"}
+	if s.TagStatus != nil {
+		values := []string{"TAGGED", "UNTAGGED"}
+		if !request.IsParamEnumValue(*s.TagStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("TagStatus", *s.TagStatus, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTagStatus sets the TagStatus field's value.
 func (s *DescribeImagesFilter) SetTagStatus(v string) *DescribeImagesFilter {
 	s.TagStatus = &v
@@ -2636,6 +2816,38 @@ func (s *DescribeImagesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeImagesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeImagesInput"}
+	if s.ImageIds != nil && len(s.ImageIds) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ImageIds", 100))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.RegistryId != nil && !request.MatchParamPattern("[0-9]{12}", *s.RegistryId) {
+		invalidParams.Add(request.NewErrParamPattern("RegistryId", "[0-9]{12}"))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 256))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"))
+	}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilter sets the Filter field's value.
 func (s *DescribeImagesInput) SetFilter(v *DescribeImagesFilter) *DescribeImagesInput {
 	s.Filter = v
@@ -2767,6 +2979,27 @@ func (s *DescribeRepositoriesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeRepositoriesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeRepositoriesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.RegistryId != nil && !request.MatchParamPattern("[0-9]{12}", *s.RegistryId) {
+		invalidParams.Add(request.NewErrParamPattern("RegistryId", "[0-9]{12}"))
+	}
+	if s.RepositoryNames != nil && len(s.RepositoryNames) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryNames", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *DescribeRepositoriesInput) SetMaxResults(v int64) *DescribeRepositoriesInput {
 	s.MaxResults = &v
@@ -2860,6 +3093,21 @@ func (s *GetAuthorizationTokenInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetAuthorizationTokenInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetAuthorizationTokenInput"}
+	if s.RegistryIds != nil && len(s.RegistryIds) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("RegistryIds", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRegistryIds sets the RegistryIds field's value.
 func (s *GetAuthorizationTokenInput) SetRegistryIds(v []*string) *GetAuthorizationTokenInput {
 	s.RegistryIds = v
@@ -2939,6 +3187,30 @@ func (s *GetDownloadUrlForLayerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDownloadUrlForLayerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDownloadUrlForLayerInput"}
+	if s.LayerDigest != nil && !request.MatchParamPattern("[a-zA-Z0-9-_+.]+:[a-fA-F0-9]+", *s.LayerDigest) {
+		invalidParams.Add(request.NewErrParamPattern("LayerDigest", "[a-zA-Z0-9-_+.]+:[a-fA-F0-9]+"))
+	}
+	if s.RegistryId != nil && !request.MatchParamPattern("[0-9]{12}", *s.RegistryId) {
+		invalidParams.Add(request.NewErrParamPattern("RegistryId", "[0-9]{12}"))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 256))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLayerDigest sets the LayerDigest field's value.
 func (s *GetDownloadUrlForLayerInput) SetLayerDigest(v string) *GetDownloadUrlForLayerInput {
 	s.LayerDigest = &v
@@ -3030,6 +3302,27 @@ func (s *GetRepositoryPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetRepositoryPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetRepositoryPolicyInput"}
+	if s.RegistryId != nil && !request.MatchParamPattern("[0-9]{12}", *s.RegistryId) {
+		invalidParams.Add(request.NewErrParamPattern("RegistryId", "[0-9]{12}"))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 256))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRegistryId sets the RegistryId field's value.
 func (s *GetRepositoryPolicyInput) SetRegistryId(v string) *GetRepositoryPolicyInput {
 	s.RegistryId = &v
@@ -3329,6 +3622,27 @@ func (s *InitiateLayerUploadInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InitiateLayerUploadInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InitiateLayerUploadInput"}
+	if s.RegistryId != nil && !request.MatchParamPattern("[0-9]{12}", *s.RegistryId) {
+		invalidParams.Add(request.NewErrParamPattern("RegistryId", "[0-9]{12}"))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 256))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRegistryId sets the RegistryId field's value.
 func (s *InitiateLayerUploadInput) SetRegistryId(v string) *InitiateLayerUploadInput {
 	s.RegistryId = &v
@@ -3492,6 +3806,24 @@ func (s ListImagesFilter) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListImagesFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListImagesFilter"}
+	if s.TagStatus != nil {
+		values := []string{"TAGGED", "UNTAGGED"}
+		if !request.IsParamEnumValue(*s.TagStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("TagStatus", *s.TagStatus, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTagStatus sets the TagStatus field's value.
 func (s *ListImagesFilter) SetTagStatus(v string) *ListImagesFilter {
 	s.TagStatus = &v
@@ -3563,6 +3895,35 @@ func (s *ListImagesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListImagesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListImagesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.RegistryId != nil && !request.MatchParamPattern("[0-9]{12}", *s.RegistryId) {
+		invalidParams.Add(request.NewErrParamPattern("RegistryId", "[0-9]{12}"))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 256))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"))
+	}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilter sets the Filter field's value.
 func (s *ListImagesInput) SetFilter(v *ListImagesFilter) *ListImagesInput {
 	s.Filter = v
@@ -3682,6 +4043,27 @@ func (s *PutImageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutImageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutImageInput"}
+	if s.RegistryId != nil && !request.MatchParamPattern("[0-9]{12}", *s.RegistryId) {
+		invalidParams.Add(request.NewErrParamPattern("RegistryId", "[0-9]{12}"))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 256))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetImageManifest sets the ImageManifest field's value.
 func (s *PutImageInput) SetImageManifest(v string) *PutImageInput {
 	s.ImageManifest = &v
@@ -3849,6 +4231,30 @@ func (s *SetRepositoryPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetRepositoryPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetRepositoryPolicyInput"}
+	if s.PolicyText != nil && len(*s.PolicyText) > 10240 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyText", 10240))
+	}
+	if s.RegistryId != nil && !request.MatchParamPattern("[0-9]{12}", *s.RegistryId) {
+		invalidParams.Add(request.NewErrParamPattern("RegistryId", "[0-9]{12}"))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 256))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetForce sets the Force field's value.
 func (s *SetRepositoryPolicyInput) SetForce(v bool) *SetRepositoryPolicyInput {
 	s.Force = &v
@@ -3990,6 +4396,30 @@ func (s *UploadLayerPartInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UploadLayerPartInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UploadLayerPartInput"}
+	if s.RegistryId != nil && !request.MatchParamPattern("[0-9]{12}", *s.RegistryId) {
+		invalidParams.Add(request.NewErrParamPattern("RegistryId", "[0-9]{12}"))
+	}
+	if s.RepositoryName != nil && len(*s.RepositoryName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RepositoryName", 256))
+	}
+	if s.RepositoryName != nil && !request.MatchParamPattern("(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*", *s.RepositoryName) {
+		invalidParams.Add(request.NewErrParamPattern("RepositoryName", "(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"))
+	}
+	if s.UploadId != nil && !request.MatchParamPattern("[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}", *s.UploadId) {
+		invalidParams.Add(request.NewErrParamPattern("UploadId", "[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLayerPartBlob sets the LayerPartBlob field's value.
 func (s *UploadLayerPartInput) SetLayerPartBlob(v []byte) *UploadLayerPartInput {
 	s.LayerPartBlob = v
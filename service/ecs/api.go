@@ -3532,6 +3532,24 @@ func (s *Attribute) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Attribute) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Attribute"}
+	if s.TargetType != nil {
+		values := []string{"container-instance"}
+		if !request.IsParamEnumValue(*s.TargetType, values) {
+			invalidParams.Add(request.NewErrParamEnum("TargetType", *s.TargetType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *Attribute) SetName(v string) *Attribute {
 	s.Name = &v
@@ -4105,6 +4123,43 @@ func (s *ContainerDefinition) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ContainerDefinition) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ContainerDefinition"}
+	if s.LogConfiguration != nil {
+		if err := s.LogConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LogConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.PortMappings != nil {
+		for i, v := range s.PortMappings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PortMappings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Ulimits != nil {
+		for i, v := range s.Ulimits {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Ulimits", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCommand sets the Command field's value.
 func (s *ContainerDefinition) SetCommand(v []*string) *ContainerDefinition {
 	s.Command = v
@@ -4671,6 +4726,38 @@ func (s *CreateServiceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateServiceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateServiceInput"}
+	if s.PlacementConstraints != nil {
+		for i, v := range s.PlacementConstraints {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PlacementConstraints", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.PlacementStrategy != nil {
+		for i, v := range s.PlacementStrategy {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PlacementStrategy", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientToken sets the ClientToken field's value.
 func (s *CreateServiceInput) SetClientToken(v string) *CreateServiceInput {
 	s.ClientToken = &v
@@ -4806,6 +4893,28 @@ func (s *DeleteAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteAttributesInput"}
+	if s.Attributes != nil {
+		for i, v := range s.Attributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Attributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *DeleteAttributesInput) SetAttributes(v []*Attribute) *DeleteAttributesInput {
 	s.Attributes = v
@@ -6011,6 +6120,24 @@ func (s *ListAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAttributesInput"}
+	if s.TargetType != nil {
+		values := []string{"container-instance"}
+		if !request.IsParamEnumValue(*s.TargetType, values) {
+			invalidParams.Add(request.NewErrParamEnum("TargetType", *s.TargetType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeName sets the AttributeName field's value.
 func (s *ListAttributesInput) SetAttributeName(v string) *ListAttributesInput {
 	s.AttributeName = &v
@@ -6218,6 +6345,24 @@ func (s ListContainerInstancesInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListContainerInstancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListContainerInstancesInput"}
+	if s.Status != nil {
+		values := []string{"ACTIVE", "DRAINING"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCluster sets the Cluster field's value.
 func (s *ListContainerInstancesInput) SetCluster(v string) *ListContainerInstancesInput {
 	s.Cluster = &v
@@ -6427,6 +6572,24 @@ func (s ListTaskDefinitionFamiliesInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTaskDefinitionFamiliesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTaskDefinitionFamiliesInput"}
+	if s.Status != nil {
+		values := []string{"ACTIVE", "INACTIVE", "ALL"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFamilyPrefix sets the FamilyPrefix field's value.
 func (s *ListTaskDefinitionFamiliesInput) SetFamilyPrefix(v string) *ListTaskDefinitionFamiliesInput {
 	s.FamilyPrefix = &v
@@ -6542,6 +6705,30 @@ func (s ListTaskDefinitionsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTaskDefinitionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTaskDefinitionsInput"}
+	if s.Sort != nil {
+		values := []string{"ASC", "DESC"}
+		if !request.IsParamEnumValue(*s.Sort, values) {
+			invalidParams.Add(request.NewErrParamEnum("Sort", *s.Sort, values))
+		}
+	}
+	if s.Status != nil {
+		values := []string{"ACTIVE", "INACTIVE"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFamilyPrefix sets the FamilyPrefix field's value.
 func (s *ListTaskDefinitionsInput) SetFamilyPrefix(v string) *ListTaskDefinitionsInput {
 	s.FamilyPrefix = &v
@@ -6675,6 +6862,24 @@ func (s ListTasksInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTasksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTasksInput"}
+	if s.DesiredStatus != nil {
+		values := []string{"RUNNING", "PENDING", "STOPPED"}
+		if !request.IsParamEnumValue(*s.DesiredStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("DesiredStatus", *s.DesiredStatus, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCluster sets the Cluster field's value.
 func (s *ListTasksInput) SetCluster(v string) *ListTasksInput {
 	s.Cluster = &v
@@ -6872,6 +7077,24 @@ func (s *LogConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LogConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LogConfiguration"}
+	if s.LogDriver != nil {
+		values := []string{"json-file", "syslog", "journald", "gelf", "fluentd", "awslogs", "splunk"}
+		if !request.IsParamEnumValue(*s.LogDriver, values) {
+			invalidParams.Add(request.NewErrParamEnum("LogDriver", *s.LogDriver, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLogDriver sets the LogDriver field's value.
 func (s *LogConfiguration) SetLogDriver(v string) *LogConfiguration {
 	s.LogDriver = &v
@@ -6960,6 +7183,24 @@ func (s NetworkBinding) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NetworkBinding) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NetworkBinding"}
+	if s.Protocol != nil {
+		values := []string{"tcp", "udp"}
+		if !request.IsParamEnumValue(*s.Protocol, values) {
+			invalidParams.Add(request.NewErrParamEnum("Protocol", *s.Protocol, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBindIP sets the BindIP field's value.
 func (s *NetworkBinding) SetBindIP(v string) *NetworkBinding {
 	s.BindIP = &v
@@ -7014,6 +7255,24 @@ func (s PlacementConstraint) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PlacementConstraint) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PlacementConstraint"}
+	if s.Type != nil {
+		values := []string{"distinctInstance", "memberOf"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExpression sets the Expression field's value.
 func (s *PlacementConstraint) SetExpression(v string) *PlacementConstraint {
 	s.Expression = &v
@@ -7061,6 +7320,24 @@ func (s PlacementStrategy) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PlacementStrategy) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PlacementStrategy"}
+	if s.Type != nil {
+		values := []string{"random", "spread", "binpack"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetField sets the Field field's value.
 func (s *PlacementStrategy) SetField(v string) *PlacementStrategy {
 	s.Field = &v
@@ -7131,6 +7408,24 @@ func (s PortMapping) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PortMapping) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PortMapping"}
+	if s.Protocol != nil {
+		values := []string{"tcp", "udp"}
+		if !request.IsParamEnumValue(*s.Protocol, values) {
+			invalidParams.Add(request.NewErrParamEnum("Protocol", *s.Protocol, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContainerPort sets the ContainerPort field's value.
 func (s *PortMapping) SetContainerPort(v int64) *PortMapping {
 	s.ContainerPort = &v
@@ -7199,6 +7494,28 @@ func (s *PutAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutAttributesInput"}
+	if s.Attributes != nil {
+		for i, v := range s.Attributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Attributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *PutAttributesInput) SetAttributes(v []*Attribute) *PutAttributesInput {
 	s.Attributes = v
@@ -7298,6 +7615,28 @@ func (s *RegisterContainerInstanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterContainerInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterContainerInstanceInput"}
+	if s.Attributes != nil {
+		for i, v := range s.Attributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Attributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *RegisterContainerInstanceInput) SetAttributes(v []*Attribute) *RegisterContainerInstanceInput {
 	s.Attributes = v
@@ -7452,6 +7791,44 @@ func (s *RegisterTaskDefinitionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterTaskDefinitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterTaskDefinitionInput"}
+	if s.NetworkMode != nil {
+		values := []string{"bridge", "host", "none"}
+		if !request.IsParamEnumValue(*s.NetworkMode, values) {
+			invalidParams.Add(request.NewErrParamEnum("NetworkMode", *s.NetworkMode, values))
+		}
+	}
+	if s.ContainerDefinitions != nil {
+		for i, v := range s.ContainerDefinitions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ContainerDefinitions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.PlacementConstraints != nil {
+		for i, v := range s.PlacementConstraints {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PlacementConstraints", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContainerDefinitions sets the ContainerDefinitions field's value.
 func (s *RegisterTaskDefinitionInput) SetContainerDefinitions(v []*ContainerDefinition) *RegisterTaskDefinitionInput {
 	s.ContainerDefinitions = v
@@ -7665,6 +8042,38 @@ func (s *RunTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RunTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RunTaskInput"}
+	if s.PlacementConstraints != nil {
+		for i, v := range s.PlacementConstraints {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PlacementConstraints", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.PlacementStrategy != nil {
+		for i, v := range s.PlacementStrategy {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PlacementStrategy", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCluster sets the Cluster field's value.
 func (s *RunTaskInput) SetCluster(v string) *RunTaskInput {
 	s.Cluster = &v
@@ -8237,6 +8646,28 @@ func (s SubmitContainerStateChangeInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SubmitContainerStateChangeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SubmitContainerStateChangeInput"}
+	if s.NetworkBindings != nil {
+		for i, v := range s.NetworkBindings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "NetworkBindings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCluster sets the Cluster field's value.
 func (s *SubmitContainerStateChangeInput) SetCluster(v string) *SubmitContainerStateChangeInput {
 	s.Cluster = &v
@@ -8695,6 +9126,24 @@ func (s TaskDefinitionPlacementConstraint) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TaskDefinitionPlacementConstraint) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TaskDefinitionPlacementConstraint"}
+	if s.Type != nil {
+		values := []string{"memberOf"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExpression sets the Expression field's value.
 func (s *TaskDefinitionPlacementConstraint) SetExpression(v string) *TaskDefinitionPlacementConstraint {
 	s.Expression = &v
@@ -8793,6 +9242,24 @@ func (s *Ulimit) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Ulimit) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Ulimit"}
+	if s.Name != nil {
+		values := []string{"core", "cpu", "data", "fsize", "locks", "memlock", "msgqueue", "nice", "nofile", "nproc", "rss", "rtprio", "rttime", "sigpending", "stack"}
+		if !request.IsParamEnumValue(*s.Name, values) {
+			invalidParams.Add(request.NewErrParamEnum("Name", *s.Name, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHardLimit sets the HardLimit field's value.
 func (s *Ulimit) SetHardLimit(v int64) *Ulimit {
 	s.HardLimit = &v
@@ -8933,6 +9400,24 @@ func (s *UpdateContainerInstancesStateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateContainerInstancesStateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateContainerInstancesStateInput"}
+	if s.Status != nil {
+		values := []string{"ACTIVE", "DRAINING"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCluster sets the Cluster field's value.
 func (s *UpdateContainerInstancesStateInput) SetCluster(v string) *UpdateContainerInstancesStateInput {
 	s.Cluster = &v
@@ -1369,6 +1369,30 @@ func (s *CreateFileSystemInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateFileSystemInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateFileSystemInput"}
+	if s.CreationToken != nil && len(*s.CreationToken) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("CreationToken", 64))
+	}
+	if s.KmsKeyId != nil && len(*s.KmsKeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KmsKeyId", 2048))
+	}
+	if s.PerformanceMode != nil {
+		values := []string{"generalPurpose", "maxIO"}
+		if !request.IsParamEnumValue(*s.PerformanceMode, values) {
+			invalidParams.Add(request.NewErrParamEnum("PerformanceMode", *s.PerformanceMode, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCreationToken sets the CreationToken field's value.
 func (s *CreateFileSystemInput) SetCreationToken(v string) *CreateFileSystemInput {
 	s.CreationToken = &v
@@ -1467,6 +1491,21 @@ func (s *CreateMountTargetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateMountTargetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateMountTargetInput"}
+	if s.SecurityGroups != nil && len(s.SecurityGroups) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("SecurityGroups", 5))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFileSystemId sets the FileSystemId field's value.
 func (s *CreateMountTargetInput) SetFileSystemId(v string) *CreateMountTargetInput {
 	s.FileSystemId = &v
@@ -1569,6 +1608,28 @@ func (s *CreateTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTagsInput"}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFileSystemId sets the FileSystemId field's value.
 func (s *CreateTagsInput) SetFileSystemId(v string) *CreateTagsInput {
 	s.FileSystemId = &v
@@ -1901,6 +1962,21 @@ func (s *DescribeFileSystemsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeFileSystemsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeFileSystemsInput"}
+	if s.CreationToken != nil && len(*s.CreationToken) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("CreationToken", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCreationToken sets the CreationToken field's value.
 func (s *DescribeFileSystemsInput) SetCreationToken(v string) *DescribeFileSystemsInput {
 	s.CreationToken = &v
@@ -2725,6 +2801,21 @@ func (s *ModifyMountTargetSecurityGroupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyMountTargetSecurityGroupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyMountTargetSecurityGroupsInput"}
+	if s.SecurityGroups != nil && len(s.SecurityGroups) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("SecurityGroups", 5))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMountTargetId sets the MountTargetId field's value.
 func (s *ModifyMountTargetSecurityGroupsInput) SetMountTargetId(v string) *ModifyMountTargetSecurityGroupsInput {
 	s.MountTargetId = &v
@@ -2957,6 +3048,24 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
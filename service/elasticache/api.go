@@ -6058,6 +6058,24 @@ func (s *CreateCacheClusterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateCacheClusterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateCacheClusterInput"}
+	if s.AZMode != nil {
+		values := []string{"single-az", "cross-az"}
+		if !request.IsParamEnumValue(*s.AZMode, values) {
+			invalidParams.Add(request.NewErrParamEnum("AZMode", *s.AZMode, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAZMode sets the AZMode field's value.
 func (s *CreateCacheClusterInput) SetAZMode(v string) *CreateCacheClusterInput {
 	s.AZMode = &v
@@ -8240,6 +8258,24 @@ func (s DescribeEventsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEventsInput"}
+	if s.SourceType != nil {
+		values := []string{"cache-cluster", "cache-parameter-group", "cache-security-group", "cache-subnet-group", "replication-group"}
+		if !request.IsParamEnumValue(*s.SourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("SourceType", *s.SourceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDuration sets the Duration field's value.
 func (s *DescribeEventsInput) SetDuration(v int64) *DescribeEventsInput {
 	s.Duration = &v
@@ -9464,6 +9500,24 @@ func (s *ModifyCacheClusterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyCacheClusterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyCacheClusterInput"}
+	if s.AZMode != nil {
+		values := []string{"single-az", "cross-az"}
+		if !request.IsParamEnumValue(*s.AZMode, values) {
+			invalidParams.Add(request.NewErrParamEnum("AZMode", *s.AZMode, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAZMode sets the AZMode field's value.
 func (s *ModifyCacheClusterInput) SetAZMode(v string) *ModifyCacheClusterInput {
 	s.AZMode = &v
@@ -3543,6 +3543,21 @@ func (s *AbortEnvironmentUpdateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AbortEnvironmentUpdateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AbortEnvironmentUpdateInput"}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEnvironmentId sets the EnvironmentId field's value.
 func (s *AbortEnvironmentUpdateInput) SetEnvironmentId(v string) *AbortEnvironmentUpdateInput {
 	s.EnvironmentId = &v
@@ -4169,6 +4184,30 @@ func (s *BuildConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BuildConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BuildConfiguration"}
+	if s.CodeBuildServiceRole != nil && !request.MatchParamPattern(".*\\S.*", *s.CodeBuildServiceRole) {
+		invalidParams.Add(request.NewErrParamPattern("CodeBuildServiceRole", ".*\\S.*"))
+	}
+	if s.ComputeType != nil {
+		values := []string{"BUILD_GENERAL1_SMALL", "BUILD_GENERAL1_MEDIUM", "BUILD_GENERAL1_LARGE"}
+		if !request.IsParamEnumValue(*s.ComputeType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ComputeType", *s.ComputeType, values))
+		}
+	}
+	if s.Image != nil && !request.MatchParamPattern(".*\\S.*", *s.Image) {
+		invalidParams.Add(request.NewErrParamPattern("Image", ".*\\S.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArtifactName sets the ArtifactName field's value.
 func (s *BuildConfiguration) SetArtifactName(v string) *BuildConfiguration {
 	s.ArtifactName = &v
@@ -4347,6 +4386,21 @@ func (s *CheckDNSAvailabilityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CheckDNSAvailabilityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CheckDNSAvailabilityInput"}
+	if s.CNAMEPrefix != nil && len(*s.CNAMEPrefix) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("CNAMEPrefix", 63))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCNAMEPrefix sets the CNAMEPrefix field's value.
 func (s *CheckDNSAvailabilityInput) SetCNAMEPrefix(v string) *CheckDNSAvailabilityInput {
 	s.CNAMEPrefix = &v
@@ -4441,6 +4495,24 @@ func (s *ComposeEnvironmentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ComposeEnvironmentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ComposeEnvironmentsInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.GroupName != nil && len(*s.GroupName) > 19 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 19))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *ComposeEnvironmentsInput) SetApplicationName(v string) *ComposeEnvironmentsInput {
 	s.ApplicationName = &v
@@ -4657,6 +4729,21 @@ func (s *ConfigurationOptionSetting) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ConfigurationOptionSetting) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConfigurationOptionSetting"}
+	if s.ResourceName != nil && len(*s.ResourceName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceName", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNamespace sets the Namespace field's value.
 func (s *ConfigurationOptionSetting) SetNamespace(v string) *ConfigurationOptionSetting {
 	s.Namespace = &v
@@ -4852,6 +4939,24 @@ func (s *CreateApplicationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateApplicationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateApplicationInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.Description != nil && len(*s.Description) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 200))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *CreateApplicationInput) SetApplicationName(v string) *CreateApplicationInput {
 	s.ApplicationName = &v
@@ -4961,6 +5066,42 @@ func (s *CreateApplicationVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateApplicationVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateApplicationVersionInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.Description != nil && len(*s.Description) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 200))
+	}
+	if s.VersionLabel != nil && len(*s.VersionLabel) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("VersionLabel", 100))
+	}
+	if s.BuildConfiguration != nil {
+		if err := s.BuildConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("BuildConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.SourceBuildInformation != nil {
+		if err := s.SourceBuildInformation.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SourceBuildInformation", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.SourceBundle != nil {
+		if err := s.SourceBundle.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SourceBundle", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *CreateApplicationVersionInput) SetApplicationName(v string) *CreateApplicationVersionInput {
 	s.ApplicationName = &v
@@ -5122,6 +5263,42 @@ func (s *CreateConfigurationTemplateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateConfigurationTemplateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateConfigurationTemplateInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.Description != nil && len(*s.Description) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 200))
+	}
+	if s.TemplateName != nil && len(*s.TemplateName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateName", 100))
+	}
+	if s.OptionSettings != nil {
+		for i, v := range s.OptionSettings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OptionSettings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.SourceConfiguration != nil {
+		if err := s.SourceConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SourceConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *CreateConfigurationTemplateInput) SetApplicationName(v string) *CreateConfigurationTemplateInput {
 	s.ApplicationName = &v
@@ -5319,6 +5496,69 @@ func (s *CreateEnvironmentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateEnvironmentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateEnvironmentInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.CNAMEPrefix != nil && len(*s.CNAMEPrefix) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("CNAMEPrefix", 63))
+	}
+	if s.Description != nil && len(*s.Description) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 200))
+	}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+	if s.GroupName != nil && len(*s.GroupName) > 19 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 19))
+	}
+	if s.TemplateName != nil && len(*s.TemplateName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateName", 100))
+	}
+	if s.VersionLabel != nil && len(*s.VersionLabel) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("VersionLabel", 100))
+	}
+	if s.OptionSettings != nil {
+		for i, v := range s.OptionSettings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OptionSettings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.OptionsToRemove != nil {
+		for i, v := range s.OptionsToRemove {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OptionsToRemove", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *CreateEnvironmentInput) SetApplicationName(v string) *CreateEnvironmentInput {
 	s.ApplicationName = &v
@@ -5466,6 +5706,36 @@ func (s *CreatePlatformVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePlatformVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePlatformVersionInput"}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+	if s.OptionSettings != nil {
+		for i, v := range s.OptionSettings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OptionSettings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.PlatformDefinitionBundle != nil {
+		if err := s.PlatformDefinitionBundle.ValidateExtended(); err != nil {
+			invalidParams.AddNested("PlatformDefinitionBundle", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEnvironmentName sets the EnvironmentName field's value.
 func (s *CreatePlatformVersionInput) SetEnvironmentName(v string) *CreatePlatformVersionInput {
 	s.EnvironmentName = &v
@@ -5644,6 +5914,21 @@ func (s *DeleteApplicationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteApplicationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteApplicationInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *DeleteApplicationInput) SetApplicationName(v string) *DeleteApplicationInput {
 	s.ApplicationName = &v
@@ -5724,6 +6009,24 @@ func (s *DeleteApplicationVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteApplicationVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteApplicationVersionInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.VersionLabel != nil && len(*s.VersionLabel) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("VersionLabel", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *DeleteApplicationVersionInput) SetApplicationName(v string) *DeleteApplicationVersionInput {
 	s.ApplicationName = &v
@@ -5805,6 +6108,24 @@ func (s *DeleteConfigurationTemplateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteConfigurationTemplateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteConfigurationTemplateInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.TemplateName != nil && len(*s.TemplateName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *DeleteConfigurationTemplateInput) SetApplicationName(v string) *DeleteConfigurationTemplateInput {
 	s.ApplicationName = &v
@@ -5880,6 +6201,24 @@ func (s *DeleteEnvironmentConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteEnvironmentConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteEnvironmentConfigurationInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *DeleteEnvironmentConfigurationInput) SetApplicationName(v string) *DeleteEnvironmentConfigurationInput {
 	s.ApplicationName = &v
@@ -6068,6 +6407,24 @@ func (s *DescribeApplicationVersionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeApplicationVersionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeApplicationVersionsInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.MaxRecords != nil && *s.MaxRecords > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxRecords", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *DescribeApplicationVersionsInput) SetApplicationName(v string) *DescribeApplicationVersionsInput {
 	s.ApplicationName = &v
@@ -6244,6 +6601,37 @@ func (s *DescribeConfigurationOptionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeConfigurationOptionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeConfigurationOptionsInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+	if s.TemplateName != nil && len(*s.TemplateName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateName", 100))
+	}
+	if s.Options != nil {
+		for i, v := range s.Options {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Options", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *DescribeConfigurationOptionsInput) SetApplicationName(v string) *DescribeConfigurationOptionsInput {
 	s.ApplicationName = &v
@@ -6383,6 +6771,27 @@ func (s *DescribeConfigurationSettingsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeConfigurationSettingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeConfigurationSettingsInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+	if s.TemplateName != nil && len(*s.TemplateName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *DescribeConfigurationSettingsInput) SetApplicationName(v string) *DescribeConfigurationSettingsInput {
 	s.ApplicationName = &v
@@ -6469,6 +6878,21 @@ func (s *DescribeEnvironmentHealthInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEnvironmentHealthInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEnvironmentHealthInput"}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeNames sets the AttributeNames field's value.
 func (s *DescribeEnvironmentHealthInput) SetAttributeNames(v []*string) *DescribeEnvironmentHealthInput {
 	s.AttributeNames = v
@@ -6620,6 +7044,21 @@ func (s *DescribeEnvironmentManagedActionHistoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEnvironmentManagedActionHistoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEnvironmentManagedActionHistoryInput"}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEnvironmentId sets the EnvironmentId field's value.
 func (s *DescribeEnvironmentManagedActionHistoryInput) SetEnvironmentId(v string) *DescribeEnvironmentManagedActionHistoryInput {
 	s.EnvironmentId = &v
@@ -6704,6 +7143,24 @@ func (s DescribeEnvironmentManagedActionsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEnvironmentManagedActionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEnvironmentManagedActionsInput"}
+	if s.Status != nil {
+		values := []string{"Scheduled", "Pending", "Running", "Unknown"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEnvironmentId sets the EnvironmentId field's value.
 func (s *DescribeEnvironmentManagedActionsInput) SetEnvironmentId(v string) *DescribeEnvironmentManagedActionsInput {
 	s.EnvironmentId = &v
@@ -6790,6 +7247,21 @@ func (s *DescribeEnvironmentResourcesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEnvironmentResourcesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEnvironmentResourcesInput"}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEnvironmentId sets the EnvironmentId field's value.
 func (s *DescribeEnvironmentResourcesInput) SetEnvironmentId(v string) *DescribeEnvironmentResourcesInput {
 	s.EnvironmentId = &v
@@ -6885,17 +7357,38 @@ func (s DescribeEnvironmentsInput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *DescribeEnvironmentsInput) Validate() error {
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeEnvironmentsInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEnvironmentsInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("ApplicationName", 1))
+	}
+	if s.MaxRecords != nil && *s.MaxRecords < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxRecords", 1))
+	}
+	if s.VersionLabel != nil && len(*s.VersionLabel) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("VersionLabel", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEnvironmentsInput) ValidateExtended() error {
 	invalidParams := request.ErrInvalidParams{Context: "DescribeEnvironmentsInput"}
-	if s.ApplicationName != nil && len(*s.ApplicationName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("ApplicationName", 1))
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
 	}
-	if s.MaxRecords != nil && *s.MaxRecords < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("MaxRecords", 1))
+	if s.MaxRecords != nil && *s.MaxRecords > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxRecords", 1000))
 	}
-	if s.VersionLabel != nil && len(*s.VersionLabel) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("VersionLabel", 1))
+	if s.VersionLabel != nil && len(*s.VersionLabel) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("VersionLabel", 100))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7039,6 +7532,39 @@ func (s *DescribeEventsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEventsInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+	if s.MaxRecords != nil && *s.MaxRecords > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxRecords", 1000))
+	}
+	if s.Severity != nil {
+		values := []string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL"}
+		if !request.IsParamEnumValue(*s.Severity, values) {
+			invalidParams.Add(request.NewErrParamEnum("Severity", *s.Severity, values))
+		}
+	}
+	if s.TemplateName != nil && len(*s.TemplateName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateName", 100))
+	}
+	if s.VersionLabel != nil && len(*s.VersionLabel) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("VersionLabel", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *DescribeEventsInput) SetApplicationName(v string) *DescribeEventsInput {
 	s.ApplicationName = &v
@@ -7191,6 +7717,24 @@ func (s *DescribeInstancesHealthInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeInstancesHealthInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeInstancesHealthInput"}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeNames sets the AttributeNames field's value.
 func (s *DescribeInstancesHealthInput) SetAttributeNames(v []*string) *DescribeInstancesHealthInput {
 	s.AttributeNames = v
@@ -8737,6 +9281,21 @@ func (s *OptionSpecification) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *OptionSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "OptionSpecification"}
+	if s.ResourceName != nil && len(*s.ResourceName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceName", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNamespace sets the Namespace field's value.
 func (s *OptionSpecification) SetNamespace(v string) *OptionSpecification {
 	s.Namespace = &v
@@ -9219,6 +9778,21 @@ func (s *RebuildEnvironmentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RebuildEnvironmentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RebuildEnvironmentInput"}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEnvironmentId sets the EnvironmentId field's value.
 func (s *RebuildEnvironmentInput) SetEnvironmentId(v string) *RebuildEnvironmentInput {
 	s.EnvironmentId = &v
@@ -9304,6 +9878,27 @@ func (s *RequestEnvironmentInfoInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RequestEnvironmentInfoInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RequestEnvironmentInfoInput"}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+	if s.InfoType != nil {
+		values := []string{"tail", "bundle"}
+		if !request.IsParamEnumValue(*s.InfoType, values) {
+			invalidParams.Add(request.NewErrParamEnum("InfoType", *s.InfoType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEnvironmentId sets the EnvironmentId field's value.
 func (s *RequestEnvironmentInfoInput) SetEnvironmentId(v string) *RequestEnvironmentInfoInput {
 	s.EnvironmentId = &v
@@ -9379,6 +9974,21 @@ func (s *RestartAppServerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RestartAppServerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RestartAppServerInput"}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEnvironmentId sets the EnvironmentId field's value.
 func (s *RestartAppServerInput) SetEnvironmentId(v string) *RestartAppServerInput {
 	s.EnvironmentId = &v
@@ -9461,6 +10071,27 @@ func (s *RetrieveEnvironmentInfoInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RetrieveEnvironmentInfoInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RetrieveEnvironmentInfoInput"}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+	if s.InfoType != nil {
+		values := []string{"tail", "bundle"}
+		if !request.IsParamEnumValue(*s.InfoType, values) {
+			invalidParams.Add(request.NewErrParamEnum("InfoType", *s.InfoType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEnvironmentId sets the EnvironmentId field's value.
 func (s *RetrieveEnvironmentInfoInput) SetEnvironmentId(v string) *RetrieveEnvironmentInfoInput {
 	s.EnvironmentId = &v
@@ -9526,6 +10157,24 @@ func (s S3Location) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *S3Location) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "S3Location"}
+	if s.S3Bucket != nil && len(*s.S3Bucket) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3Bucket", 255))
+	}
+	if s.S3Key != nil && len(*s.S3Key) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3Key", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetS3Bucket sets the S3Bucket field's value.
 func (s *S3Location) SetS3Bucket(v string) *S3Location {
 	s.S3Bucket = &v
@@ -9751,6 +10400,36 @@ func (s *SourceBuildInformation) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SourceBuildInformation) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SourceBuildInformation"}
+	if s.SourceLocation != nil && len(*s.SourceLocation) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceLocation", 255))
+	}
+	if s.SourceLocation != nil && !request.MatchParamPattern(".+/.+", *s.SourceLocation) {
+		invalidParams.Add(request.NewErrParamPattern("SourceLocation", ".+/.+"))
+	}
+	if s.SourceRepository != nil {
+		values := []string{"CodeCommit", "S3"}
+		if !request.IsParamEnumValue(*s.SourceRepository, values) {
+			invalidParams.Add(request.NewErrParamEnum("SourceRepository", *s.SourceRepository, values))
+		}
+	}
+	if s.SourceType != nil {
+		values := []string{"Git", "Zip"}
+		if !request.IsParamEnumValue(*s.SourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("SourceType", *s.SourceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSourceLocation sets the SourceLocation field's value.
 func (s *SourceBuildInformation) SetSourceLocation(v string) *SourceBuildInformation {
 	s.SourceLocation = &v
@@ -9807,6 +10486,24 @@ func (s *SourceConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SourceConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SourceConfiguration"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.TemplateName != nil && len(*s.TemplateName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *SourceConfiguration) SetApplicationName(v string) *SourceConfiguration {
 	s.ApplicationName = &v
@@ -9937,6 +10634,24 @@ func (s *SwapEnvironmentCNAMEsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SwapEnvironmentCNAMEsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SwapEnvironmentCNAMEsInput"}
+	if s.DestinationEnvironmentName != nil && len(*s.DestinationEnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("DestinationEnvironmentName", 40))
+	}
+	if s.SourceEnvironmentName != nil && len(*s.SourceEnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceEnvironmentName", 40))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestinationEnvironmentId sets the DestinationEnvironmentId field's value.
 func (s *SwapEnvironmentCNAMEsInput) SetDestinationEnvironmentId(v string) *SwapEnvironmentCNAMEsInput {
 	s.DestinationEnvironmentId = &v
@@ -10049,6 +10764,24 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -10124,6 +10857,21 @@ func (s *TerminateEnvironmentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TerminateEnvironmentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TerminateEnvironmentInput"}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEnvironmentId sets the EnvironmentId field's value.
 func (s *TerminateEnvironmentInput) SetEnvironmentId(v string) *TerminateEnvironmentInput {
 	s.EnvironmentId = &v
@@ -10216,6 +10964,24 @@ func (s *UpdateApplicationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateApplicationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateApplicationInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.Description != nil && len(*s.Description) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 200))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *UpdateApplicationInput) SetApplicationName(v string) *UpdateApplicationInput {
 	s.ApplicationName = &v
@@ -10277,6 +11043,21 @@ func (s *UpdateApplicationResourceLifecycleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateApplicationResourceLifecycleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateApplicationResourceLifecycleInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *UpdateApplicationResourceLifecycleInput) SetApplicationName(v string) *UpdateApplicationResourceLifecycleInput {
 	s.ApplicationName = &v
@@ -10378,6 +11159,27 @@ func (s *UpdateApplicationVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateApplicationVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateApplicationVersionInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.Description != nil && len(*s.Description) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 200))
+	}
+	if s.VersionLabel != nil && len(*s.VersionLabel) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("VersionLabel", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *UpdateApplicationVersionInput) SetApplicationName(v string) *UpdateApplicationVersionInput {
 	s.ApplicationName = &v
@@ -10483,6 +11285,47 @@ func (s *UpdateConfigurationTemplateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateConfigurationTemplateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateConfigurationTemplateInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.Description != nil && len(*s.Description) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 200))
+	}
+	if s.TemplateName != nil && len(*s.TemplateName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateName", 100))
+	}
+	if s.OptionSettings != nil {
+		for i, v := range s.OptionSettings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OptionSettings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.OptionsToRemove != nil {
+		for i, v := range s.OptionsToRemove {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OptionsToRemove", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *UpdateConfigurationTemplateInput) SetApplicationName(v string) *UpdateConfigurationTemplateInput {
 	s.ApplicationName = &v
@@ -10638,6 +11481,56 @@ func (s *UpdateEnvironmentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateEnvironmentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateEnvironmentInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.Description != nil && len(*s.Description) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 200))
+	}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+	if s.GroupName != nil && len(*s.GroupName) > 19 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 19))
+	}
+	if s.TemplateName != nil && len(*s.TemplateName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateName", 100))
+	}
+	if s.VersionLabel != nil && len(*s.VersionLabel) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("VersionLabel", 100))
+	}
+	if s.OptionSettings != nil {
+		for i, v := range s.OptionSettings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OptionSettings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.OptionsToRemove != nil {
+		for i, v := range s.OptionsToRemove {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OptionsToRemove", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *UpdateEnvironmentInput) SetApplicationName(v string) *UpdateEnvironmentInput {
 	s.ApplicationName = &v
@@ -10782,6 +11675,37 @@ func (s *ValidateConfigurationSettingsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ValidateConfigurationSettingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ValidateConfigurationSettingsInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 100))
+	}
+	if s.EnvironmentName != nil && len(*s.EnvironmentName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnvironmentName", 40))
+	}
+	if s.TemplateName != nil && len(*s.TemplateName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TemplateName", 100))
+	}
+	if s.OptionSettings != nil {
+		for i, v := range s.OptionSettings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OptionSettings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *ValidateConfigurationSettingsInput) SetApplicationName(v string) *ValidateConfigurationSettingsInput {
 	s.ApplicationName = &v
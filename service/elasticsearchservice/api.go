@@ -1420,6 +1420,28 @@ func (s *AddTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddTagsInput"}
+	if s.TagList != nil {
+		for i, v := range s.TagList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TagList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetARN sets the ARN field's value.
 func (s *AddTagsInput) SetARN(v string) *AddTagsInput {
 	s.ARN = &v
@@ -1659,6 +1681,34 @@ func (s *CreateElasticsearchDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateElasticsearchDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateElasticsearchDomainInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+	if s.EBSOptions != nil {
+		if err := s.EBSOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("EBSOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ElasticsearchClusterConfig != nil {
+		if err := s.ElasticsearchClusterConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ElasticsearchClusterConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessPolicies sets the AccessPolicies field's value.
 func (s *CreateElasticsearchDomainInput) SetAccessPolicies(v string) *CreateElasticsearchDomainInput {
 	s.AccessPolicies = &v
@@ -1815,6 +1865,24 @@ func (s *DeleteElasticsearchDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteElasticsearchDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteElasticsearchDomainInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DeleteElasticsearchDomainInput) SetDomainName(v string) *DeleteElasticsearchDomainInput {
 	s.DomainName = &v
@@ -1906,6 +1974,24 @@ func (s *DescribeElasticsearchDomainConfigInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeElasticsearchDomainConfigInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeElasticsearchDomainConfigInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DescribeElasticsearchDomainConfigInput) SetDomainName(v string) *DescribeElasticsearchDomainConfigInput {
 	s.DomainName = &v
@@ -1998,6 +2084,24 @@ func (s *DescribeElasticsearchDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeElasticsearchDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeElasticsearchDomainInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DescribeElasticsearchDomainInput) SetDomainName(v string) *DescribeElasticsearchDomainInput {
 	s.DomainName = &v
@@ -2192,6 +2296,30 @@ func (s *DescribeElasticsearchInstanceTypeLimitsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeElasticsearchInstanceTypeLimitsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeElasticsearchInstanceTypeLimitsInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+	if s.InstanceType != nil {
+		values := []string{"m3.medium.elasticsearch", "m3.large.elasticsearch", "m3.xlarge.elasticsearch", "m3.2xlarge.elasticsearch", "m4.large.elasticsearch", "m4.xlarge.elasticsearch", "m4.2xlarge.elasticsearch", "m4.4xlarge.elasticsearch", "m4.10xlarge.elasticsearch", "t2.micro.elasticsearch", "t2.small.elasticsearch", "t2.medium.elasticsearch", "r3.large.elasticsearch", "r3.xlarge.elasticsearch", "r3.2xlarge.elasticsearch", "r3.4xlarge.elasticsearch", "r3.8xlarge.elasticsearch", "i2.xlarge.elasticsearch", "i2.2xlarge.elasticsearch", "d2.xlarge.elasticsearch", "d2.2xlarge.elasticsearch", "d2.4xlarge.elasticsearch", "d2.8xlarge.elasticsearch", "c4.large.elasticsearch", "c4.xlarge.elasticsearch", "c4.2xlarge.elasticsearch", "c4.4xlarge.elasticsearch", "c4.8xlarge.elasticsearch", "r4.large.elasticsearch", "r4.xlarge.elasticsearch", "r4.2xlarge.elasticsearch", "r4.4xlarge.elasticsearch", "r4.8xlarge.elasticsearch", "r4.16xlarge.elasticsearch"}
+		if !request.IsParamEnumValue(*s.InstanceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceType", *s.InstanceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DescribeElasticsearchInstanceTypeLimitsInput) SetDomainName(v string) *DescribeElasticsearchInstanceTypeLimitsInput {
 	s.DomainName = &v
@@ -2340,6 +2468,24 @@ func (s EBSOptions) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EBSOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EBSOptions"}
+	if s.VolumeType != nil {
+		values := []string{"standard", "gp2", "io1"}
+		if !request.IsParamEnumValue(*s.VolumeType, values) {
+			invalidParams.Add(request.NewErrParamEnum("VolumeType", *s.VolumeType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEBSEnabled sets the EBSEnabled field's value.
 func (s *EBSOptions) SetEBSEnabled(v bool) *EBSOptions {
 	s.EBSEnabled = &v
@@ -2481,6 +2627,30 @@ func (s ElasticsearchClusterConfig) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ElasticsearchClusterConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ElasticsearchClusterConfig"}
+	if s.DedicatedMasterType != nil {
+		values := []string{"m3.medium.elasticsearch", "m3.large.elasticsearch", "m3.xlarge.elasticsearch", "m3.2xlarge.elasticsearch", "m4.large.elasticsearch", "m4.xlarge.elasticsearch", "m4.2xlarge.elasticsearch", "m4.4xlarge.elasticsearch", "m4.10xlarge.elasticsearch", "t2.micro.elasticsearch", "t2.small.elasticsearch", "t2.medium.elasticsearch", "r3.large.elasticsearch", "r3.xlarge.elasticsearch", "r3.2xlarge.elasticsearch", "r3.4xlarge.elasticsearch", "r3.8xlarge.elasticsearch", "i2.xlarge.elasticsearch", "i2.2xlarge.elasticsearch", "d2.xlarge.elasticsearch", "d2.2xlarge.elasticsearch", "d2.4xlarge.elasticsearch", "d2.8xlarge.elasticsearch", "c4.large.elasticsearch", "c4.xlarge.elasticsearch", "c4.2xlarge.elasticsearch", "c4.4xlarge.elasticsearch", "c4.8xlarge.elasticsearch", "r4.large.elasticsearch", "r4.xlarge.elasticsearch", "r4.2xlarge.elasticsearch", "r4.4xlarge.elasticsearch", "r4.8xlarge.elasticsearch", "r4.16xlarge.elasticsearch"}
+		if !request.IsParamEnumValue(*s.DedicatedMasterType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DedicatedMasterType", *s.DedicatedMasterType, values))
+		}
+	}
+	if s.InstanceType != nil {
+		values := []string{"m3.medium.elasticsearch", "m3.large.elasticsearch", "m3.xlarge.elasticsearch", "m3.2xlarge.elasticsearch", "m4.large.elasticsearch", "m4.xlarge.elasticsearch", "m4.2xlarge.elasticsearch", "m4.4xlarge.elasticsearch", "m4.10xlarge.elasticsearch", "t2.micro.elasticsearch", "t2.small.elasticsearch", "t2.medium.elasticsearch", "r3.large.elasticsearch", "r3.xlarge.elasticsearch", "r3.2xlarge.elasticsearch", "r3.4xlarge.elasticsearch", "r3.8xlarge.elasticsearch", "i2.xlarge.elasticsearch", "i2.2xlarge.elasticsearch", "d2.xlarge.elasticsearch", "d2.2xlarge.elasticsearch", "d2.4xlarge.elasticsearch", "d2.8xlarge.elasticsearch", "c4.large.elasticsearch", "c4.xlarge.elasticsearch", "c4.2xlarge.elasticsearch", "c4.4xlarge.elasticsearch", "c4.8xlarge.elasticsearch", "r4.large.elasticsearch", "r4.xlarge.elasticsearch", "r4.2xlarge.elasticsearch", "r4.4xlarge.elasticsearch", "r4.8xlarge.elasticsearch", "r4.16xlarge.elasticsearch"}
+		if !request.IsParamEnumValue(*s.InstanceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceType", *s.InstanceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDedicatedMasterCount sets the DedicatedMasterCount field's value.
 func (s *ElasticsearchClusterConfig) SetDedicatedMasterCount(v int64) *ElasticsearchClusterConfig {
 	s.DedicatedMasterCount = &v
@@ -3268,6 +3438,27 @@ func (s *ListElasticsearchInstanceTypesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListElasticsearchInstanceTypesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListElasticsearchInstanceTypesInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *ListElasticsearchInstanceTypesInput) SetDomainName(v string) *ListElasticsearchInstanceTypesInput {
 	s.DomainName = &v
@@ -3399,6 +3590,21 @@ func (s ListElasticsearchVersionsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListElasticsearchVersionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListElasticsearchVersionsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListElasticsearchVersionsInput) SetMaxResults(v int64) *ListElasticsearchVersionsInput {
 	s.MaxResults = &v
@@ -4041,6 +4247,24 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -4133,6 +4357,34 @@ func (s *UpdateElasticsearchDomainConfigInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateElasticsearchDomainConfigInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateElasticsearchDomainConfigInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 28 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 28))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-z][a-z0-9\\-]+", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-z][a-z0-9\\-]+"))
+	}
+	if s.EBSOptions != nil {
+		if err := s.EBSOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("EBSOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ElasticsearchClusterConfig != nil {
+		if err := s.ElasticsearchClusterConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ElasticsearchClusterConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessPolicies sets the AccessPolicies field's value.
 func (s *UpdateElasticsearchDomainConfigInput) SetAccessPolicies(v string) *UpdateElasticsearchDomainConfigInput {
 	s.AccessPolicies = &v
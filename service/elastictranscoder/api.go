@@ -1945,6 +1945,41 @@ func (s *Artwork) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Artwork) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Artwork"}
+	if s.AlbumArtFormat != nil && !request.MatchParamPattern("(^jpg$)|(^png$)", *s.AlbumArtFormat) {
+		invalidParams.Add(request.NewErrParamPattern("AlbumArtFormat", "(^jpg$)|(^png$)"))
+	}
+	if s.InputKey != nil && len(*s.InputKey) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("InputKey", 1024))
+	}
+	if s.MaxHeight != nil && !request.MatchParamPattern("(^auto$)|(^\\d{2,4}$)", *s.MaxHeight) {
+		invalidParams.Add(request.NewErrParamPattern("MaxHeight", "(^auto$)|(^\\d{2,4}$)"))
+	}
+	if s.MaxWidth != nil && !request.MatchParamPattern("(^auto$)|(^\\d{2,4}$)", *s.MaxWidth) {
+		invalidParams.Add(request.NewErrParamPattern("MaxWidth", "(^auto$)|(^\\d{2,4}$)"))
+	}
+	if s.PaddingPolicy != nil && !request.MatchParamPattern("(^Pad$)|(^NoPad$)", *s.PaddingPolicy) {
+		invalidParams.Add(request.NewErrParamPattern("PaddingPolicy", "(^Pad$)|(^NoPad$)"))
+	}
+	if s.SizingPolicy != nil && !request.MatchParamPattern("(^Fit$)|(^Fill$)|(^Stretch$)|(^Keep$)|(^ShrinkToFit$)|(^ShrinkToFill$)", *s.SizingPolicy) {
+		invalidParams.Add(request.NewErrParamPattern("SizingPolicy", "(^Fit$)|(^Fill$)|(^Stretch$)|(^Keep$)|(^ShrinkToFit$)|(^ShrinkToFill$)"))
+	}
+	if s.Encryption != nil {
+		if err := s.Encryption.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Encryption", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAlbumArtFormat sets the AlbumArtFormat field's value.
 func (s *Artwork) SetAlbumArtFormat(v string) *Artwork {
 	s.AlbumArtFormat = &v
@@ -2105,6 +2140,30 @@ func (s AudioCodecOptions) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AudioCodecOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AudioCodecOptions"}
+	if s.BitDepth != nil && !request.MatchParamPattern("(^8$)|(^16$)|(^24$)|(^32$)", *s.BitDepth) {
+		invalidParams.Add(request.NewErrParamPattern("BitDepth", "(^8$)|(^16$)|(^24$)|(^32$)"))
+	}
+	if s.BitOrder != nil && !request.MatchParamPattern("(^LittleEndian$)", *s.BitOrder) {
+		invalidParams.Add(request.NewErrParamPattern("BitOrder", "(^LittleEndian$)"))
+	}
+	if s.Profile != nil && !request.MatchParamPattern("(^auto$)|(^AAC-LC$)|(^HE-AAC$)|(^HE-AACv2$)", *s.Profile) {
+		invalidParams.Add(request.NewErrParamPattern("Profile", "(^auto$)|(^AAC-LC$)|(^HE-AAC$)|(^HE-AACv2$)"))
+	}
+	if s.Signed != nil && !request.MatchParamPattern("(^Unsigned$)|(^Signed$)", *s.Signed) {
+		invalidParams.Add(request.NewErrParamPattern("Signed", "(^Unsigned$)|(^Signed$)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBitDepth sets the BitDepth field's value.
 func (s *AudioCodecOptions) SetBitDepth(v string) *AudioCodecOptions {
 	s.BitDepth = &v
@@ -2329,6 +2388,38 @@ func (s AudioParameters) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AudioParameters) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AudioParameters"}
+	if s.AudioPackingMode != nil && !request.MatchParamPattern("(^SingleTrack$)|(^OneChannelPerTrack$)|(^OneChannelPerTrackWithMosTo8Tracks$)", *s.AudioPackingMode) {
+		invalidParams.Add(request.NewErrParamPattern("AudioPackingMode", "(^SingleTrack$)|(^OneChannelPerTrack$)|(^OneChannelPerTrackWithMosTo8Tracks$)"))
+	}
+	if s.BitRate != nil && !request.MatchParamPattern("^\\d{1,3}$", *s.BitRate) {
+		invalidParams.Add(request.NewErrParamPattern("BitRate", "^\\d{1,3}$"))
+	}
+	if s.Channels != nil && !request.MatchParamPattern("(^auto$)|(^0$)|(^1$)|(^2$)", *s.Channels) {
+		invalidParams.Add(request.NewErrParamPattern("Channels", "(^auto$)|(^0$)|(^1$)|(^2$)"))
+	}
+	if s.Codec != nil && !request.MatchParamPattern("(^AAC$)|(^vorbis$)|(^mp3$)|(^mp2$)|(^pcm$)|(^flac$)", *s.Codec) {
+		invalidParams.Add(request.NewErrParamPattern("Codec", "(^AAC$)|(^vorbis$)|(^mp3$)|(^mp2$)|(^pcm$)|(^flac$)"))
+	}
+	if s.SampleRate != nil && !request.MatchParamPattern("(^auto$)|(^22050$)|(^32000$)|(^44100$)|(^48000$)|(^96000$)|(^192000$)", *s.SampleRate) {
+		invalidParams.Add(request.NewErrParamPattern("SampleRate", "(^auto$)|(^22050$)|(^32000$)|(^44100$)|(^48000$)|(^96000$)|(^192000$)"))
+	}
+	if s.CodecOptions != nil {
+		if err := s.CodecOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CodecOptions", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAudioPackingMode sets the AudioPackingMode field's value.
 func (s *AudioParameters) SetAudioPackingMode(v string) *AudioParameters {
 	s.AudioPackingMode = &v
@@ -2437,6 +2528,21 @@ func (s *CancelJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CancelJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelJobInput"}
+	if s.Id != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^\\d{13}-\\w{6}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *CancelJobInput) SetId(v string) *CancelJobInput {
 	s.Id = &v
@@ -2542,6 +2648,29 @@ func (s CaptionFormat) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CaptionFormat) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CaptionFormat"}
+	if s.Format != nil && !request.MatchParamPattern("(^mov-text$)|(^srt$)|(^scc$)|(^webvtt$)|(^dfxp$)|(^cea-708$)", *s.Format) {
+		invalidParams.Add(request.NewErrParamPattern("Format", "(^mov-text$)|(^srt$)|(^scc$)|(^webvtt$)|(^dfxp$)|(^cea-708$)"))
+	}
+	if s.Pattern != nil && !request.MatchParamPattern("(^$)|(^.*\\{language\\}.*$)", *s.Pattern) {
+		invalidParams.Add(request.NewErrParamPattern("Pattern", "(^$)|(^.*\\{language\\}.*$)"))
+	}
+	if s.Encryption != nil {
+		if err := s.Encryption.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Encryption", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEncryption sets the Encryption field's value.
 func (s *CaptionFormat) SetEncryption(v *Encryption) *CaptionFormat {
 	s.Encryption = v
@@ -2657,6 +2786,35 @@ func (s *CaptionSource) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CaptionSource) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CaptionSource"}
+	if s.Key != nil && len(*s.Key) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 1024))
+	}
+	if s.Label != nil && len(*s.Label) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("Label", 40))
+	}
+	if s.Language != nil && len(*s.Language) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Language", 255))
+	}
+	if s.TimeOffset != nil && !request.MatchParamPattern("(^[+-]?\\d{1,5}(\\.\\d{0,3})?$)|(^[+-]?([0-1]?[0-9]:|2[0-3]:)?([0-5]?[0-9]:)?[0-5]?[0-9](\\.\\d{0,3})?$)", *s.TimeOffset) {
+		invalidParams.Add(request.NewErrParamPattern("TimeOffset", "(^[+-]?\\d{1,5}(\\.\\d{0,3})?$)|(^[+-]?([0-1]?[0-9]:|2[0-3]:)?([0-5]?[0-9]:)?[0-5]?[0-9](\\.\\d{0,3})?$)"))
+	}
+	if s.Encryption != nil {
+		if err := s.Encryption.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Encryption", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEncryption sets the Encryption field's value.
 func (s *CaptionSource) SetEncryption(v *Encryption) *CaptionSource {
 	s.Encryption = v
@@ -2790,6 +2948,47 @@ func (s *Captions) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Captions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Captions"}
+	if s.CaptionFormats != nil && len(s.CaptionFormats) > 4 {
+		invalidParams.Add(request.NewErrParamMaxLen("CaptionFormats", 4))
+	}
+	if s.CaptionSources != nil && len(s.CaptionSources) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("CaptionSources", 20))
+	}
+	if s.MergePolicy != nil && !request.MatchParamPattern("(^MergeOverride$)|(^MergeRetain$)|(^Override$)", *s.MergePolicy) {
+		invalidParams.Add(request.NewErrParamPattern("MergePolicy", "(^MergeOverride$)|(^MergeRetain$)|(^Override$)"))
+	}
+	if s.CaptionFormats != nil {
+		for i, v := range s.CaptionFormats {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "CaptionFormats", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.CaptionSources != nil {
+		for i, v := range s.CaptionSources {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "CaptionSources", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCaptionFormats sets the CaptionFormats field's value.
 func (s *Captions) SetCaptionFormats(v []*CaptionFormat) *Captions {
 	s.CaptionFormats = v
@@ -2848,6 +3047,23 @@ func (s Clip) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Clip) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Clip"}
+	if s.TimeSpan != nil {
+		if err := s.TimeSpan.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TimeSpan", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTimeSpan sets the TimeSpan field's value.
 func (s *Clip) SetTimeSpan(v *TimeSpan) *Clip {
 	s.TimeSpan = v
@@ -2988,6 +3204,73 @@ func (s *CreateJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateJobInput"}
+	if s.Inputs != nil && len(s.Inputs) > 10000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Inputs", 10000))
+	}
+	if s.OutputKeyPrefix != nil && len(*s.OutputKeyPrefix) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("OutputKeyPrefix", 255))
+	}
+	if s.Outputs != nil && len(s.Outputs) > 30 {
+		invalidParams.Add(request.NewErrParamMaxLen("Outputs", 30))
+	}
+	if s.PipelineId != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.PipelineId) {
+		invalidParams.Add(request.NewErrParamPattern("PipelineId", "^\\d{13}-\\w{6}$"))
+	}
+	if s.Playlists != nil && len(s.Playlists) > 30 {
+		invalidParams.Add(request.NewErrParamMaxLen("Playlists", 30))
+	}
+	if s.Input != nil {
+		if err := s.Input.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Input", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Inputs != nil {
+		for i, v := range s.Inputs {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Inputs", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Output != nil {
+		if err := s.Output.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Output", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Outputs != nil {
+		for i, v := range s.Outputs {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Outputs", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Playlists != nil {
+		for i, v := range s.Playlists {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Playlists", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInput sets the Input field's value.
 func (s *CreateJobInput) SetInput(v *JobInput) *CreateJobInput {
 	s.Input = v
@@ -3271,6 +3554,73 @@ func (s *CreateJobOutput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateJobOutput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateJobOutput"}
+	if s.Key != nil && len(*s.Key) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 255))
+	}
+	if s.PresetId != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.PresetId) {
+		invalidParams.Add(request.NewErrParamPattern("PresetId", "^\\d{13}-\\w{6}$"))
+	}
+	if s.Rotate != nil && !request.MatchParamPattern("(^auto$)|(^0$)|(^90$)|(^180$)|(^270$)", *s.Rotate) {
+		invalidParams.Add(request.NewErrParamPattern("Rotate", "(^auto$)|(^0$)|(^90$)|(^180$)|(^270$)"))
+	}
+	if s.SegmentDuration != nil && !request.MatchParamPattern("^\\d{1,5}(\\.\\d{0,5})?$", *s.SegmentDuration) {
+		invalidParams.Add(request.NewErrParamPattern("SegmentDuration", "^\\d{1,5}(\\.\\d{0,5})?$"))
+	}
+	if s.ThumbnailPattern != nil && !request.MatchParamPattern("(^$)|(^.*\\{count\\}.*$)", *s.ThumbnailPattern) {
+		invalidParams.Add(request.NewErrParamPattern("ThumbnailPattern", "(^$)|(^.*\\{count\\}.*$)"))
+	}
+	if s.AlbumArt != nil {
+		if err := s.AlbumArt.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AlbumArt", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Captions != nil {
+		if err := s.Captions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Captions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Composition != nil {
+		for i, v := range s.Composition {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Composition", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Encryption != nil {
+		if err := s.Encryption.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Encryption", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ThumbnailEncryption != nil {
+		if err := s.ThumbnailEncryption.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ThumbnailEncryption", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Watermarks != nil {
+		for i, v := range s.Watermarks {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Watermarks", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAlbumArt sets the AlbumArt field's value.
 func (s *CreateJobOutput) SetAlbumArt(v *JobAlbumArt) *CreateJobOutput {
 	s.AlbumArt = v
@@ -3501,6 +3851,37 @@ func (s *CreateJobPlaylist) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateJobPlaylist) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateJobPlaylist"}
+	if s.Format != nil && !request.MatchParamPattern("(^HLSv3$)|(^HLSv4$)|(^Smooth$)|(^MPEG-DASH$)", *s.Format) {
+		invalidParams.Add(request.NewErrParamPattern("Format", "(^HLSv3$)|(^HLSv4$)|(^Smooth$)|(^MPEG-DASH$)"))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.OutputKeys != nil && len(s.OutputKeys) > 30 {
+		invalidParams.Add(request.NewErrParamMaxLen("OutputKeys", 30))
+	}
+	if s.HlsContentProtection != nil {
+		if err := s.HlsContentProtection.ValidateExtended(); err != nil {
+			invalidParams.AddNested("HlsContentProtection", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.PlayReadyDrm != nil {
+		if err := s.PlayReadyDrm.ValidateExtended(); err != nil {
+			invalidParams.AddNested("PlayReadyDrm", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFormat sets the Format field's value.
 func (s *CreateJobPlaylist) SetFormat(v string) *CreateJobPlaylist {
 	s.Format = &v
@@ -3865,6 +4246,48 @@ func (s *CreatePipelineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePipelineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePipelineInput"}
+	if s.AwsKmsKeyArn != nil && len(*s.AwsKmsKeyArn) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("AwsKmsKeyArn", 255))
+	}
+	if s.InputBucket != nil && !request.MatchParamPattern("^(\\w|\\.|-){1,255}$", *s.InputBucket) {
+		invalidParams.Add(request.NewErrParamPattern("InputBucket", "^(\\w|\\.|-){1,255}$"))
+	}
+	if s.Name != nil && len(*s.Name) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 40))
+	}
+	if s.OutputBucket != nil && !request.MatchParamPattern("^(\\w|\\.|-){1,255}$", *s.OutputBucket) {
+		invalidParams.Add(request.NewErrParamPattern("OutputBucket", "^(\\w|\\.|-){1,255}$"))
+	}
+	if s.Role != nil && !request.MatchParamPattern("^arn:aws:iam::\\w{12}:role/.+$", *s.Role) {
+		invalidParams.Add(request.NewErrParamPattern("Role", "^arn:aws:iam::\\w{12}:role/.+$"))
+	}
+	if s.ContentConfig != nil {
+		if err := s.ContentConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ContentConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Notifications != nil {
+		if err := s.Notifications.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notifications", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ThumbnailConfig != nil {
+		if err := s.ThumbnailConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ThumbnailConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAwsKmsKeyArn sets the AwsKmsKeyArn field's value.
 func (s *CreatePipelineInput) SetAwsKmsKeyArn(v string) *CreatePipelineInput {
 	s.AwsKmsKeyArn = &v
@@ -4079,6 +4502,42 @@ func (s *CreatePresetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePresetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePresetInput"}
+	if s.Container != nil && !request.MatchParamPattern("(^mp4$)|(^ts$)|(^webm$)|(^mp3$)|(^flac$)|(^oga$)|(^ogg$)|(^fmp4$)|(^mpg$)|(^flv$)|(^gif$)|(^mxf$)|(^wav$)", *s.Container) {
+		invalidParams.Add(request.NewErrParamPattern("Container", "(^mp4$)|(^ts$)|(^webm$)|(^mp3$)|(^flac$)|(^oga$)|(^ogg$)|(^fmp4$)|(^mpg$)|(^flv$)|(^gif$)|(^mxf$)|(^wav$)"))
+	}
+	if s.Description != nil && len(*s.Description) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 255))
+	}
+	if s.Name != nil && len(*s.Name) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 40))
+	}
+	if s.Audio != nil {
+		if err := s.Audio.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Audio", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Thumbnails != nil {
+		if err := s.Thumbnails.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Thumbnails", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Video != nil {
+		if err := s.Video.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Video", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAudio sets the Audio field's value.
 func (s *CreatePresetInput) SetAudio(v *AudioParameters) *CreatePresetInput {
 	s.Audio = v
@@ -4237,6 +4696,21 @@ func (s *DeletePipelineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeletePipelineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePipelineInput"}
+	if s.Id != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^\\d{13}-\\w{6}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *DeletePipelineInput) SetId(v string) *DeletePipelineInput {
 	s.Id = &v
@@ -4308,6 +4782,21 @@ func (s *DeletePresetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeletePresetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePresetInput"}
+	if s.Id != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^\\d{13}-\\w{6}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *DeletePresetInput) SetId(v string) *DeletePresetInput {
 	s.Id = &v
@@ -4377,6 +4866,21 @@ func (s DetectedProperties) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetectedProperties) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetectedProperties"}
+	if s.FrameRate != nil && !request.MatchParamPattern("^\\d{1,5}(\\.\\d{0,5})?$", *s.FrameRate) {
+		invalidParams.Add(request.NewErrParamPattern("FrameRate", "^\\d{1,5}(\\.\\d{0,5})?$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDurationMillis sets the DurationMillis field's value.
 func (s *DetectedProperties) SetDurationMillis(v int64) *DetectedProperties {
 	s.DurationMillis = &v
@@ -4517,6 +5021,30 @@ func (s Encryption) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Encryption) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Encryption"}
+	if s.InitializationVector != nil && len(*s.InitializationVector) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("InitializationVector", 255))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"))
+	}
+	if s.KeyMd5 != nil && !request.MatchParamPattern("^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)", *s.KeyMd5) {
+		invalidParams.Add(request.NewErrParamPattern("KeyMd5", "^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"))
+	}
+	if s.Mode != nil && !request.MatchParamPattern("(^s3$)|(^s3-aws-kms$)|(^aes-cbc-pkcs7$)|(^aes-ctr$)|(^aes-gcm$)", *s.Mode) {
+		invalidParams.Add(request.NewErrParamPattern("Mode", "(^s3$)|(^s3-aws-kms$)|(^aes-cbc-pkcs7$)|(^aes-ctr$)|(^aes-gcm$)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInitializationVector sets the InitializationVector field's value.
 func (s *Encryption) SetInitializationVector(v string) *Encryption {
 	s.InitializationVector = &v
@@ -4628,6 +5156,36 @@ func (s HlsContentProtection) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *HlsContentProtection) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "HlsContentProtection"}
+	if s.InitializationVector != nil && len(*s.InitializationVector) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("InitializationVector", 255))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"))
+	}
+	if s.KeyMd5 != nil && !request.MatchParamPattern("^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)", *s.KeyMd5) {
+		invalidParams.Add(request.NewErrParamPattern("KeyMd5", "^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"))
+	}
+	if s.KeyStoragePolicy != nil && !request.MatchParamPattern("(^NoStore$)|(^WithVariantPlaylists$)", *s.KeyStoragePolicy) {
+		invalidParams.Add(request.NewErrParamPattern("KeyStoragePolicy", "(^NoStore$)|(^WithVariantPlaylists$)"))
+	}
+	if s.LicenseAcquisitionUrl != nil && len(*s.LicenseAcquisitionUrl) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LicenseAcquisitionUrl", 512))
+	}
+	if s.Method != nil && !request.MatchParamPattern("(^aes-128$)", *s.Method) {
+		invalidParams.Add(request.NewErrParamPattern("Method", "(^aes-128$)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInitializationVector sets the InitializationVector field's value.
 func (s *HlsContentProtection) SetInitializationVector(v string) *HlsContentProtection {
 	s.InitializationVector = &v
@@ -4760,6 +5318,34 @@ func (s *InputCaptions) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InputCaptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputCaptions"}
+	if s.CaptionSources != nil && len(s.CaptionSources) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("CaptionSources", 20))
+	}
+	if s.MergePolicy != nil && !request.MatchParamPattern("(^MergeOverride$)|(^MergeRetain$)|(^Override$)", *s.MergePolicy) {
+		invalidParams.Add(request.NewErrParamPattern("MergePolicy", "(^MergeOverride$)|(^MergeRetain$)|(^Override$)"))
+	}
+	if s.CaptionSources != nil {
+		for i, v := range s.CaptionSources {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "CaptionSources", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCaptionSources sets the CaptionSources field's value.
 func (s *InputCaptions) SetCaptionSources(v []*CaptionSource) *InputCaptions {
 	s.CaptionSources = v
@@ -5083,6 +5669,31 @@ func (s *JobAlbumArt) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *JobAlbumArt) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "JobAlbumArt"}
+	if s.MergePolicy != nil && !request.MatchParamPattern("(^Replace$)|(^Prepend$)|(^Append$)|(^Fallback$)", *s.MergePolicy) {
+		invalidParams.Add(request.NewErrParamPattern("MergePolicy", "(^Replace$)|(^Prepend$)|(^Append$)|(^Fallback$)"))
+	}
+	if s.Artwork != nil {
+		for i, v := range s.Artwork {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Artwork", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArtwork sets the Artwork field's value.
 func (s *JobAlbumArt) SetArtwork(v []*Artwork) *JobAlbumArt {
 	s.Artwork = v
@@ -5253,6 +5864,56 @@ func (s *JobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *JobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "JobInput"}
+	if s.AspectRatio != nil && !request.MatchParamPattern("(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)", *s.AspectRatio) {
+		invalidParams.Add(request.NewErrParamPattern("AspectRatio", "(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"))
+	}
+	if s.Container != nil && !request.MatchParamPattern("(^auto$)|(^3gp$)|(^asf$)|(^avi$)|(^divx$)|(^flv$)|(^mkv$)|(^mov$)|(^mp4$)|(^mpeg$)|(^mpeg-ps$)|(^mpeg-ts$)|(^mxf$)|(^ogg$)|(^ts$)|(^vob$)|(^wav$)|(^webm$)|(^mp3$)|(^m4a$)|(^aac$)", *s.Container) {
+		invalidParams.Add(request.NewErrParamPattern("Container", "(^auto$)|(^3gp$)|(^asf$)|(^avi$)|(^divx$)|(^flv$)|(^mkv$)|(^mov$)|(^mp4$)|(^mpeg$)|(^mpeg-ps$)|(^mpeg-ts$)|(^mxf$)|(^ogg$)|(^ts$)|(^vob$)|(^wav$)|(^webm$)|(^mp3$)|(^m4a$)|(^aac$)"))
+	}
+	if s.FrameRate != nil && !request.MatchParamPattern("(^auto$)|(^10$)|(^15$)|(^23.97$)|(^24$)|(^25$)|(^29.97$)|(^30$)|(^50$)|(^60$)", *s.FrameRate) {
+		invalidParams.Add(request.NewErrParamPattern("FrameRate", "(^auto$)|(^10$)|(^15$)|(^23.97$)|(^24$)|(^25$)|(^29.97$)|(^30$)|(^50$)|(^60$)"))
+	}
+	if s.Interlaced != nil && !request.MatchParamPattern("(^auto$)|(^true$)|(^false$)", *s.Interlaced) {
+		invalidParams.Add(request.NewErrParamPattern("Interlaced", "(^auto$)|(^true$)|(^false$)"))
+	}
+	if s.Key != nil && len(*s.Key) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 1024))
+	}
+	if s.Resolution != nil && !request.MatchParamPattern("(^auto$)|(^\\d{1,5}x\\d{1,5}$)", *s.Resolution) {
+		invalidParams.Add(request.NewErrParamPattern("Resolution", "(^auto$)|(^\\d{1,5}x\\d{1,5}$)"))
+	}
+	if s.DetectedProperties != nil {
+		if err := s.DetectedProperties.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DetectedProperties", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Encryption != nil {
+		if err := s.Encryption.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Encryption", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.InputCaptions != nil {
+		if err := s.InputCaptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("InputCaptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TimeSpan != nil {
+		if err := s.TimeSpan.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TimeSpan", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAspectRatio sets the AspectRatio field's value.
 func (s *JobInput) SetAspectRatio(v string) *JobInput {
 	s.AspectRatio = &v
@@ -5902,6 +6563,29 @@ func (s *JobWatermark) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *JobWatermark) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "JobWatermark"}
+	if s.InputKey != nil && len(*s.InputKey) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("InputKey", 1024))
+	}
+	if s.PresetWatermarkId != nil && len(*s.PresetWatermarkId) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("PresetWatermarkId", 40))
+	}
+	if s.Encryption != nil {
+		if err := s.Encryption.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Encryption", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEncryption sets the Encryption field's value.
 func (s *JobWatermark) SetEncryption(v *Encryption) *JobWatermark {
 	s.Encryption = v
@@ -5990,6 +6674,27 @@ func (s *ListJobsByPipelineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListJobsByPipelineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListJobsByPipelineInput"}
+	if s.Ascending != nil && !request.MatchParamPattern("(^true$)|(^false$)", *s.Ascending) {
+		invalidParams.Add(request.NewErrParamPattern("Ascending", "(^true$)|(^false$)"))
+	}
+	if s.PageToken != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.PageToken) {
+		invalidParams.Add(request.NewErrParamPattern("PageToken", "^\\d{13}-\\w{6}$"))
+	}
+	if s.PipelineId != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.PipelineId) {
+		invalidParams.Add(request.NewErrParamPattern("PipelineId", "^\\d{13}-\\w{6}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAscending sets the Ascending field's value.
 func (s *ListJobsByPipelineInput) SetAscending(v string) *ListJobsByPipelineInput {
 	s.Ascending = &v
@@ -6123,6 +6828,27 @@ func (s *ListJobsByStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListJobsByStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListJobsByStatusInput"}
+	if s.Ascending != nil && !request.MatchParamPattern("(^true$)|(^false$)", *s.Ascending) {
+		invalidParams.Add(request.NewErrParamPattern("Ascending", "(^true$)|(^false$)"))
+	}
+	if s.PageToken != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.PageToken) {
+		invalidParams.Add(request.NewErrParamPattern("PageToken", "^\\d{13}-\\w{6}$"))
+	}
+	if s.Status != nil && !request.MatchParamPattern("(^Submitted$)|(^Progressing$)|(^Complete$)|(^Canceled$)|(^Error$)", *s.Status) {
+		invalidParams.Add(request.NewErrParamPattern("Status", "(^Submitted$)|(^Progressing$)|(^Complete$)|(^Canceled$)|(^Error$)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAscending sets the Ascending field's value.
 func (s *ListJobsByStatusInput) SetAscending(v string) *ListJobsByStatusInput {
 	s.Ascending = &v
@@ -6237,6 +6963,24 @@ func (s ListPipelinesInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPipelinesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPipelinesInput"}
+	if s.Ascending != nil && !request.MatchParamPattern("(^true$)|(^false$)", *s.Ascending) {
+		invalidParams.Add(request.NewErrParamPattern("Ascending", "(^true$)|(^false$)"))
+	}
+	if s.PageToken != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.PageToken) {
+		invalidParams.Add(request.NewErrParamPattern("PageToken", "^\\d{13}-\\w{6}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAscending sets the Ascending field's value.
 func (s *ListPipelinesInput) SetAscending(v string) *ListPipelinesInput {
 	s.Ascending = &v
@@ -6340,6 +7084,24 @@ func (s ListPresetsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPresetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPresetsInput"}
+	if s.Ascending != nil && !request.MatchParamPattern("(^true$)|(^false$)", *s.Ascending) {
+		invalidParams.Add(request.NewErrParamPattern("Ascending", "(^true$)|(^false$)"))
+	}
+	if s.PageToken != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.PageToken) {
+		invalidParams.Add(request.NewErrParamPattern("PageToken", "^\\d{13}-\\w{6}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAscending sets the Ascending field's value.
 func (s *ListPresetsInput) SetAscending(v string) *ListPresetsInput {
 	s.Ascending = &v
@@ -6454,6 +7216,30 @@ func (s Notifications) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Notifications) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Notifications"}
+	if s.Completed != nil && !request.MatchParamPattern("(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)", *s.Completed) {
+		invalidParams.Add(request.NewErrParamPattern("Completed", "(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"))
+	}
+	if s.Error != nil && !request.MatchParamPattern("(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)", *s.Error) {
+		invalidParams.Add(request.NewErrParamPattern("Error", "(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"))
+	}
+	if s.Progressing != nil && !request.MatchParamPattern("(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)", *s.Progressing) {
+		invalidParams.Add(request.NewErrParamPattern("Progressing", "(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"))
+	}
+	if s.Warning != nil && !request.MatchParamPattern("(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)", *s.Warning) {
+		invalidParams.Add(request.NewErrParamPattern("Warning", "(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCompleted sets the Completed field's value.
 func (s *Notifications) SetCompleted(v string) *Notifications {
 	s.Completed = &v
@@ -6568,6 +7354,27 @@ func (s *Permission) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Permission) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Permission"}
+	if s.Access != nil && len(s.Access) > 30 {
+		invalidParams.Add(request.NewErrParamMaxLen("Access", 30))
+	}
+	if s.Grantee != nil && len(*s.Grantee) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Grantee", 255))
+	}
+	if s.GranteeType != nil && !request.MatchParamPattern("(^Canonical$)|(^Email$)|(^Group$)", *s.GranteeType) {
+		invalidParams.Add(request.NewErrParamPattern("GranteeType", "(^Canonical$)|(^Email$)|(^Group$)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccess sets the Access field's value.
 func (s *Permission) SetAccess(v []*string) *Permission {
 	s.Access = v
@@ -6995,6 +7802,37 @@ func (s *PipelineOutputConfig) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PipelineOutputConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PipelineOutputConfig"}
+	if s.Bucket != nil && !request.MatchParamPattern("^(\\w|\\.|-){1,255}$", *s.Bucket) {
+		invalidParams.Add(request.NewErrParamPattern("Bucket", "^(\\w|\\.|-){1,255}$"))
+	}
+	if s.Permissions != nil && len(s.Permissions) > 30 {
+		invalidParams.Add(request.NewErrParamMaxLen("Permissions", 30))
+	}
+	if s.StorageClass != nil && !request.MatchParamPattern("(^ReducedRedundancy$)|(^Standard$)", *s.StorageClass) {
+		invalidParams.Add(request.NewErrParamPattern("StorageClass", "(^ReducedRedundancy$)|(^Standard$)"))
+	}
+	if s.Permissions != nil {
+		for i, v := range s.Permissions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Permissions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *PipelineOutputConfig) SetBucket(v string) *PipelineOutputConfig {
 	s.Bucket = &v
@@ -7109,6 +7947,36 @@ func (s *PlayReadyDrm) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PlayReadyDrm) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PlayReadyDrm"}
+	if s.Format != nil && !request.MatchParamPattern("(^microsoft$)|(^discretix-3.0$)", *s.Format) {
+		invalidParams.Add(request.NewErrParamPattern("Format", "(^microsoft$)|(^discretix-3.0$)"))
+	}
+	if s.InitializationVector != nil && len(*s.InitializationVector) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("InitializationVector", 255))
+	}
+	if s.Key != nil && !request.MatchParamPattern("(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"))
+	}
+	if s.KeyId != nil && !request.MatchParamPattern("(^[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$)|(^[0-9A-Fa-f]{32}$)", *s.KeyId) {
+		invalidParams.Add(request.NewErrParamPattern("KeyId", "(^[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$)|(^[0-9A-Fa-f]{32}$)"))
+	}
+	if s.KeyMd5 != nil && !request.MatchParamPattern("(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)", *s.KeyMd5) {
+		invalidParams.Add(request.NewErrParamPattern("KeyMd5", "(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"))
+	}
+	if s.LicenseAcquisitionUrl != nil && len(*s.LicenseAcquisitionUrl) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LicenseAcquisitionUrl", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFormat sets the Format field's value.
 func (s *PlayReadyDrm) SetFormat(v string) *PlayReadyDrm {
 	s.Format = &v
@@ -7713,6 +8581,48 @@ func (s *PresetWatermark) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PresetWatermark) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PresetWatermark"}
+	if s.HorizontalAlign != nil && !request.MatchParamPattern("(^Left$)|(^Right$)|(^Center$)", *s.HorizontalAlign) {
+		invalidParams.Add(request.NewErrParamPattern("HorizontalAlign", "(^Left$)|(^Right$)|(^Center$)"))
+	}
+	if s.HorizontalOffset != nil && !request.MatchParamPattern("(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)", *s.HorizontalOffset) {
+		invalidParams.Add(request.NewErrParamPattern("HorizontalOffset", "(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"))
+	}
+	if s.Id != nil && len(*s.Id) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 40))
+	}
+	if s.MaxHeight != nil && !request.MatchParamPattern("(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)", *s.MaxHeight) {
+		invalidParams.Add(request.NewErrParamPattern("MaxHeight", "(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"))
+	}
+	if s.MaxWidth != nil && !request.MatchParamPattern("(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)", *s.MaxWidth) {
+		invalidParams.Add(request.NewErrParamPattern("MaxWidth", "(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"))
+	}
+	if s.Opacity != nil && !request.MatchParamPattern("^\\d{1,3}(\\.\\d{0,20})?$", *s.Opacity) {
+		invalidParams.Add(request.NewErrParamPattern("Opacity", "^\\d{1,3}(\\.\\d{0,20})?$"))
+	}
+	if s.SizingPolicy != nil && !request.MatchParamPattern("(^Fit$)|(^Stretch$)|(^ShrinkToFit$)", *s.SizingPolicy) {
+		invalidParams.Add(request.NewErrParamPattern("SizingPolicy", "(^Fit$)|(^Stretch$)|(^ShrinkToFit$)"))
+	}
+	if s.Target != nil && !request.MatchParamPattern("(^Content$)|(^Frame$)", *s.Target) {
+		invalidParams.Add(request.NewErrParamPattern("Target", "(^Content$)|(^Frame$)"))
+	}
+	if s.VerticalAlign != nil && !request.MatchParamPattern("(^Top$)|(^Bottom$)|(^Center$)", *s.VerticalAlign) {
+		invalidParams.Add(request.NewErrParamPattern("VerticalAlign", "(^Top$)|(^Bottom$)|(^Center$)"))
+	}
+	if s.VerticalOffset != nil && !request.MatchParamPattern("(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)", *s.VerticalOffset) {
+		invalidParams.Add(request.NewErrParamPattern("VerticalOffset", "(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHorizontalAlign sets the HorizontalAlign field's value.
 func (s *PresetWatermark) SetHorizontalAlign(v string) *PresetWatermark {
 	s.HorizontalAlign = &v
@@ -7870,6 +8780,21 @@ func (s *ReadJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReadJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReadJobInput"}
+	if s.Id != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^\\d{13}-\\w{6}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *ReadJobInput) SetId(v string) *ReadJobInput {
 	s.Id = &v
@@ -7955,6 +8880,21 @@ func (s *ReadPipelineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReadPipelineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReadPipelineInput"}
+	if s.Id != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^\\d{13}-\\w{6}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *ReadPipelineInput) SetId(v string) *ReadPipelineInput {
 	s.Id = &v
@@ -8059,6 +8999,21 @@ func (s *ReadPresetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReadPresetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReadPresetInput"}
+	if s.Id != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^\\d{13}-\\w{6}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *ReadPresetInput) SetId(v string) *ReadPresetInput {
 	s.Id = &v
@@ -8172,6 +9127,30 @@ func (s *TestRoleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TestRoleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TestRoleInput"}
+	if s.InputBucket != nil && !request.MatchParamPattern("^(\\w|\\.|-){1,255}$", *s.InputBucket) {
+		invalidParams.Add(request.NewErrParamPattern("InputBucket", "^(\\w|\\.|-){1,255}$"))
+	}
+	if s.OutputBucket != nil && !request.MatchParamPattern("^(\\w|\\.|-){1,255}$", *s.OutputBucket) {
+		invalidParams.Add(request.NewErrParamPattern("OutputBucket", "^(\\w|\\.|-){1,255}$"))
+	}
+	if s.Role != nil && !request.MatchParamPattern("^arn:aws:iam::\\w{12}:role/.+$", *s.Role) {
+		invalidParams.Add(request.NewErrParamPattern("Role", "^arn:aws:iam::\\w{12}:role/.+$"))
+	}
+	if s.Topics != nil && len(s.Topics) > 30 {
+		invalidParams.Add(request.NewErrParamMaxLen("Topics", 30))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInputBucket sets the InputBucket field's value.
 func (s *TestRoleInput) SetInputBucket(v string) *TestRoleInput {
 	s.InputBucket = &v
@@ -8368,6 +9347,42 @@ func (s Thumbnails) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Thumbnails) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Thumbnails"}
+	if s.AspectRatio != nil && !request.MatchParamPattern("(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)", *s.AspectRatio) {
+		invalidParams.Add(request.NewErrParamPattern("AspectRatio", "(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"))
+	}
+	if s.Format != nil && !request.MatchParamPattern("(^jpg$)|(^png$)", *s.Format) {
+		invalidParams.Add(request.NewErrParamPattern("Format", "(^jpg$)|(^png$)"))
+	}
+	if s.Interval != nil && !request.MatchParamPattern("^\\d{1,5}$", *s.Interval) {
+		invalidParams.Add(request.NewErrParamPattern("Interval", "^\\d{1,5}$"))
+	}
+	if s.MaxHeight != nil && !request.MatchParamPattern("(^auto$)|(^\\d{2,4}$)", *s.MaxHeight) {
+		invalidParams.Add(request.NewErrParamPattern("MaxHeight", "(^auto$)|(^\\d{2,4}$)"))
+	}
+	if s.MaxWidth != nil && !request.MatchParamPattern("(^auto$)|(^\\d{2,4}$)", *s.MaxWidth) {
+		invalidParams.Add(request.NewErrParamPattern("MaxWidth", "(^auto$)|(^\\d{2,4}$)"))
+	}
+	if s.PaddingPolicy != nil && !request.MatchParamPattern("(^Pad$)|(^NoPad$)", *s.PaddingPolicy) {
+		invalidParams.Add(request.NewErrParamPattern("PaddingPolicy", "(^Pad$)|(^NoPad$)"))
+	}
+	if s.Resolution != nil && !request.MatchParamPattern("^\\d{1,5}x\\d{1,5}$", *s.Resolution) {
+		invalidParams.Add(request.NewErrParamPattern("Resolution", "^\\d{1,5}x\\d{1,5}$"))
+	}
+	if s.SizingPolicy != nil && !request.MatchParamPattern("(^Fit$)|(^Fill$)|(^Stretch$)|(^Keep$)|(^ShrinkToFit$)|(^ShrinkToFill$)", *s.SizingPolicy) {
+		invalidParams.Add(request.NewErrParamPattern("SizingPolicy", "(^Fit$)|(^Fill$)|(^Stretch$)|(^Keep$)|(^ShrinkToFit$)|(^ShrinkToFill$)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAspectRatio sets the AspectRatio field's value.
 func (s *Thumbnails) SetAspectRatio(v string) *Thumbnails {
 	s.AspectRatio = &v
@@ -8492,6 +9507,24 @@ func (s TimeSpan) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TimeSpan) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TimeSpan"}
+	if s.Duration != nil && !request.MatchParamPattern("(^\\d{1,5}(\\.\\d{0,3})?$)|(^([0-1]?[0-9]:|2[0-3]:)?([0-5]?[0-9]:)?[0-5]?[0-9](\\.\\d{0,3})?$)", *s.Duration) {
+		invalidParams.Add(request.NewErrParamPattern("Duration", "(^\\d{1,5}(\\.\\d{0,3})?$)|(^([0-1]?[0-9]:|2[0-3]:)?([0-5]?[0-9]:)?[0-5]?[0-9](\\.\\d{0,3})?$)"))
+	}
+	if s.StartTime != nil && !request.MatchParamPattern("(^\\d{1,5}(\\.\\d{0,3})?$)|(^([0-1]?[0-9]:|2[0-3]:)?([0-5]?[0-9]:)?[0-5]?[0-9](\\.\\d{0,3})?$)", *s.StartTime) {
+		invalidParams.Add(request.NewErrParamPattern("StartTime", "(^\\d{1,5}(\\.\\d{0,3})?$)|(^([0-1]?[0-9]:|2[0-3]:)?([0-5]?[0-9]:)?[0-5]?[0-9](\\.\\d{0,3})?$)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDuration sets the Duration field's value.
 func (s *TimeSpan) SetDuration(v string) *TimeSpan {
 	s.Duration = &v
@@ -8804,6 +9837,48 @@ func (s *UpdatePipelineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdatePipelineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdatePipelineInput"}
+	if s.AwsKmsKeyArn != nil && len(*s.AwsKmsKeyArn) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("AwsKmsKeyArn", 255))
+	}
+	if s.Id != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^\\d{13}-\\w{6}$"))
+	}
+	if s.InputBucket != nil && !request.MatchParamPattern("^(\\w|\\.|-){1,255}$", *s.InputBucket) {
+		invalidParams.Add(request.NewErrParamPattern("InputBucket", "^(\\w|\\.|-){1,255}$"))
+	}
+	if s.Name != nil && len(*s.Name) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 40))
+	}
+	if s.Role != nil && !request.MatchParamPattern("^arn:aws:iam::\\w{12}:role/.+$", *s.Role) {
+		invalidParams.Add(request.NewErrParamPattern("Role", "^arn:aws:iam::\\w{12}:role/.+$"))
+	}
+	if s.ContentConfig != nil {
+		if err := s.ContentConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ContentConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Notifications != nil {
+		if err := s.Notifications.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notifications", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ThumbnailConfig != nil {
+		if err := s.ThumbnailConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ThumbnailConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAwsKmsKeyArn sets the AwsKmsKeyArn field's value.
 func (s *UpdatePipelineInput) SetAwsKmsKeyArn(v string) *UpdatePipelineInput {
 	s.AwsKmsKeyArn = &v
@@ -8961,6 +10036,26 @@ func (s *UpdatePipelineNotificationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdatePipelineNotificationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdatePipelineNotificationsInput"}
+	if s.Id != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^\\d{13}-\\w{6}$"))
+	}
+	if s.Notifications != nil {
+		if err := s.Notifications.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notifications", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *UpdatePipelineNotificationsInput) SetId(v string) *UpdatePipelineNotificationsInput {
 	s.Id = &v
@@ -9125,6 +10220,24 @@ func (s *UpdatePipelineStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdatePipelineStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdatePipelineStatusInput"}
+	if s.Id != nil && !request.MatchParamPattern("^\\d{13}-\\w{6}$", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "^\\d{13}-\\w{6}$"))
+	}
+	if s.Status != nil && !request.MatchParamPattern("(^Active$)|(^Paused$)", *s.Status) {
+		invalidParams.Add(request.NewErrParamPattern("Status", "(^Active$)|(^Paused$)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *UpdatePipelineStatusInput) SetId(v string) *UpdatePipelineStatusInput {
 	s.Id = &v
@@ -9636,6 +10749,70 @@ func (s *VideoParameters) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *VideoParameters) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "VideoParameters"}
+	if s.AspectRatio != nil && !request.MatchParamPattern("(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)", *s.AspectRatio) {
+		invalidParams.Add(request.NewErrParamPattern("AspectRatio", "(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"))
+	}
+	if s.BitRate != nil && !request.MatchParamPattern("(^\\d{2,5}$)|(^auto$)", *s.BitRate) {
+		invalidParams.Add(request.NewErrParamPattern("BitRate", "(^\\d{2,5}$)|(^auto$)"))
+	}
+	if s.Codec != nil && !request.MatchParamPattern("(^H\\.264$)|(^vp8$)|(^vp9$)|(^mpeg2$)|(^gif$)", *s.Codec) {
+		invalidParams.Add(request.NewErrParamPattern("Codec", "(^H\\.264$)|(^vp8$)|(^vp9$)|(^mpeg2$)|(^gif$)"))
+	}
+	if s.CodecOptions != nil && len(s.CodecOptions) > 30 {
+		invalidParams.Add(request.NewErrParamMaxLen("CodecOptions", 30))
+	}
+	if s.DisplayAspectRatio != nil && !request.MatchParamPattern("(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)", *s.DisplayAspectRatio) {
+		invalidParams.Add(request.NewErrParamPattern("DisplayAspectRatio", "(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"))
+	}
+	if s.FixedGOP != nil && !request.MatchParamPattern("(^true$)|(^false$)", *s.FixedGOP) {
+		invalidParams.Add(request.NewErrParamPattern("FixedGOP", "(^true$)|(^false$)"))
+	}
+	if s.FrameRate != nil && !request.MatchParamPattern("(^auto$)|(^10$)|(^15$)|(^23.97$)|(^24$)|(^25$)|(^29.97$)|(^30$)|(^50$)|(^60$)", *s.FrameRate) {
+		invalidParams.Add(request.NewErrParamPattern("FrameRate", "(^auto$)|(^10$)|(^15$)|(^23.97$)|(^24$)|(^25$)|(^29.97$)|(^30$)|(^50$)|(^60$)"))
+	}
+	if s.KeyframesMaxDist != nil && !request.MatchParamPattern("^\\d{1,6}$", *s.KeyframesMaxDist) {
+		invalidParams.Add(request.NewErrParamPattern("KeyframesMaxDist", "^\\d{1,6}$"))
+	}
+	if s.MaxFrameRate != nil && !request.MatchParamPattern("(^10$)|(^15$)|(^23.97$)|(^24$)|(^25$)|(^29.97$)|(^30$)|(^50$)|(^60$)", *s.MaxFrameRate) {
+		invalidParams.Add(request.NewErrParamPattern("MaxFrameRate", "(^10$)|(^15$)|(^23.97$)|(^24$)|(^25$)|(^29.97$)|(^30$)|(^50$)|(^60$)"))
+	}
+	if s.MaxHeight != nil && !request.MatchParamPattern("(^auto$)|(^\\d{2,4}$)", *s.MaxHeight) {
+		invalidParams.Add(request.NewErrParamPattern("MaxHeight", "(^auto$)|(^\\d{2,4}$)"))
+	}
+	if s.MaxWidth != nil && !request.MatchParamPattern("(^auto$)|(^\\d{2,4}$)", *s.MaxWidth) {
+		invalidParams.Add(request.NewErrParamPattern("MaxWidth", "(^auto$)|(^\\d{2,4}$)"))
+	}
+	if s.PaddingPolicy != nil && !request.MatchParamPattern("(^Pad$)|(^NoPad$)", *s.PaddingPolicy) {
+		invalidParams.Add(request.NewErrParamPattern("PaddingPolicy", "(^Pad$)|(^NoPad$)"))
+	}
+	if s.Resolution != nil && !request.MatchParamPattern("(^auto$)|(^\\d{1,5}x\\d{1,5}$)", *s.Resolution) {
+		invalidParams.Add(request.NewErrParamPattern("Resolution", "(^auto$)|(^\\d{1,5}x\\d{1,5}$)"))
+	}
+	if s.SizingPolicy != nil && !request.MatchParamPattern("(^Fit$)|(^Fill$)|(^Stretch$)|(^Keep$)|(^ShrinkToFit$)|(^ShrinkToFill$)", *s.SizingPolicy) {
+		invalidParams.Add(request.NewErrParamPattern("SizingPolicy", "(^Fit$)|(^Fill$)|(^Stretch$)|(^Keep$)|(^ShrinkToFit$)|(^ShrinkToFill$)"))
+	}
+	if s.Watermarks != nil {
+		for i, v := range s.Watermarks {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Watermarks", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAspectRatio sets the AspectRatio field's value.
 func (s *VideoParameters) SetAspectRatio(v string) *VideoParameters {
 	s.AspectRatio = &v
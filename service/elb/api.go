@@ -2850,6 +2850,28 @@ func (s *AddTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddTagsInput"}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLoadBalancerNames sets the LoadBalancerNames field's value.
 func (s *AddTagsInput) SetLoadBalancerNames(v []*string) *AddTagsInput {
 	s.LoadBalancerNames = v
@@ -2900,6 +2922,30 @@ func (s AdditionalAttribute) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AdditionalAttribute) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AdditionalAttribute"}
+	if s.Key != nil && len(*s.Key) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 256))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^[a-zA-Z0-9.]+$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^[a-zA-Z0-9.]+$"))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+	if s.Value != nil && !request.MatchParamPattern("^[a-zA-Z0-9.]+$", *s.Value) {
+		invalidParams.Add(request.NewErrParamPattern("Value", "^[a-zA-Z0-9.]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *AdditionalAttribute) SetKey(v string) *AdditionalAttribute {
 	s.Key = &v
@@ -3188,6 +3234,23 @@ func (s *ConfigureHealthCheckInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ConfigureHealthCheckInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConfigureHealthCheckInput"}
+	if s.HealthCheck != nil {
+		if err := s.HealthCheck.ValidateExtended(); err != nil {
+			invalidParams.AddNested("HealthCheck", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHealthCheck sets the HealthCheck field's value.
 func (s *ConfigureHealthCheckInput) SetHealthCheck(v *HealthCheck) *ConfigureHealthCheckInput {
 	s.HealthCheck = v
@@ -3313,6 +3376,21 @@ func (s *ConnectionSettings) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ConnectionSettings) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConnectionSettings"}
+	if s.IdleTimeout != nil && *s.IdleTimeout > 3600 {
+		invalidParams.Add(request.NewErrParamMaxValue("IdleTimeout", 3600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdleTimeout sets the IdleTimeout field's value.
 func (s *ConnectionSettings) SetIdleTimeout(v int64) *ConnectionSettings {
 	s.IdleTimeout = &v
@@ -3595,6 +3673,38 @@ func (s *CreateLoadBalancerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateLoadBalancerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateLoadBalancerInput"}
+	if s.Listeners != nil {
+		for i, v := range s.Listeners {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Listeners", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAvailabilityZones sets the AvailabilityZones field's value.
 func (s *CreateLoadBalancerInput) SetAvailabilityZones(v []*string) *CreateLoadBalancerInput {
 	s.AvailabilityZones = v
@@ -3689,6 +3799,28 @@ func (s *CreateLoadBalancerListenersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateLoadBalancerListenersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateLoadBalancerListenersInput"}
+	if s.Listeners != nil {
+		for i, v := range s.Listeners {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Listeners", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetListeners sets the Listeners field's value.
 func (s *CreateLoadBalancerListenersInput) SetListeners(v []*Listener) *CreateLoadBalancerListenersInput {
 	s.Listeners = v
@@ -4186,6 +4318,21 @@ func (s *DescribeAccountLimitsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAccountLimitsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAccountLimitsInput"}
+	if s.PageSize != nil && *s.PageSize > 400 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 400))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *DescribeAccountLimitsInput) SetMarker(v string) *DescribeAccountLimitsInput {
 	s.Marker = &v
@@ -4521,6 +4668,21 @@ func (s *DescribeLoadBalancersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeLoadBalancersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeLoadBalancersInput"}
+	if s.PageSize != nil && *s.PageSize > 400 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 400))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLoadBalancerNames sets the LoadBalancerNames field's value.
 func (s *DescribeLoadBalancersInput) SetLoadBalancerNames(v []*string) *DescribeLoadBalancersInput {
 	s.LoadBalancerNames = v
@@ -4611,6 +4773,21 @@ func (s *DescribeTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeTagsInput"}
+	if s.LoadBalancerNames != nil && len(s.LoadBalancerNames) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("LoadBalancerNames", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLoadBalancerNames sets the LoadBalancerNames field's value.
 func (s *DescribeTagsInput) SetLoadBalancerNames(v []*string) *DescribeTagsInput {
 	s.LoadBalancerNames = v
@@ -4980,6 +5157,30 @@ func (s *HealthCheck) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *HealthCheck) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "HealthCheck"}
+	if s.HealthyThreshold != nil && *s.HealthyThreshold > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("HealthyThreshold", 10))
+	}
+	if s.Interval != nil && *s.Interval > 300 {
+		invalidParams.Add(request.NewErrParamMaxValue("Interval", 300))
+	}
+	if s.Timeout != nil && *s.Timeout > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("Timeout", 60))
+	}
+	if s.UnhealthyThreshold != nil && *s.UnhealthyThreshold > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("UnhealthyThreshold", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHealthyThreshold sets the HealthyThreshold field's value.
 func (s *HealthCheck) SetHealthyThreshold(v int64) *HealthCheck {
 	s.HealthyThreshold = &v
@@ -5271,6 +5472,21 @@ func (s *Listener) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Listener) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Listener"}
+	if s.InstancePort != nil && *s.InstancePort > 65535 {
+		invalidParams.Add(request.NewErrParamMaxValue("InstancePort", 65535))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstancePort sets the InstancePort field's value.
 func (s *Listener) SetInstancePort(v int64) *Listener {
 	s.InstancePort = &v
@@ -5414,6 +5630,36 @@ func (s *LoadBalancerAttributes) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LoadBalancerAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LoadBalancerAttributes"}
+	if s.AdditionalAttributes != nil && len(s.AdditionalAttributes) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("AdditionalAttributes", 10))
+	}
+	if s.AdditionalAttributes != nil {
+		for i, v := range s.AdditionalAttributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "AdditionalAttributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.ConnectionSettings != nil {
+		if err := s.ConnectionSettings.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ConnectionSettings", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessLog sets the AccessLog field's value.
 func (s *LoadBalancerAttributes) SetAccessLog(v *AccessLog) *LoadBalancerAttributes {
 	s.AccessLog = v
@@ -5664,6 +5910,23 @@ func (s *ModifyLoadBalancerAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyLoadBalancerAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyLoadBalancerAttributesInput"}
+	if s.LoadBalancerAttributes != nil {
+		if err := s.LoadBalancerAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LoadBalancerAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLoadBalancerAttributes sets the LoadBalancerAttributes field's value.
 func (s *ModifyLoadBalancerAttributesInput) SetLoadBalancerAttributes(v *LoadBalancerAttributes) *ModifyLoadBalancerAttributesInput {
 	s.LoadBalancerAttributes = v
@@ -6114,6 +6377,28 @@ func (s *RemoveTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveTagsInput"}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLoadBalancerNames sets the LoadBalancerNames field's value.
 func (s *RemoveTagsInput) SetLoadBalancerNames(v []*string) *RemoveTagsInput {
 	s.LoadBalancerNames = v
@@ -6471,6 +6756,30 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+	if s.Value != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Value) {
+		invalidParams.Add(request.NewErrParamPattern("Value", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -6549,6 +6858,24 @@ func (s *TagKeyOnly) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TagKeyOnly) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagKeyOnly"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *TagKeyOnly) SetKey(v string) *TagKeyOnly {
 	s.Key = &v
@@ -3088,6 +3088,24 @@ func (s *Action) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Action) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Action"}
+	if s.Type != nil {
+		values := []string{"forward"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTargetGroupArn sets the TargetGroupArn field's value.
 func (s *Action) SetTargetGroupArn(v string) *Action {
 	s.TargetGroupArn = &v
@@ -3154,6 +3172,28 @@ func (s *AddTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddTagsInput"}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceArns sets the ResourceArns field's value.
 func (s *AddTagsInput) SetResourceArns(v []*string) *AddTagsInput {
 	s.ResourceArns = v
@@ -3365,6 +3405,37 @@ func (s *CreateListenerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateListenerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateListenerInput"}
+	if s.Port != nil && *s.Port > 65535 {
+		invalidParams.Add(request.NewErrParamMaxValue("Port", 65535))
+	}
+	if s.Protocol != nil {
+		values := []string{"HTTP", "HTTPS", "TCP"}
+		if !request.IsParamEnumValue(*s.Protocol, values) {
+			invalidParams.Add(request.NewErrParamEnum("Protocol", *s.Protocol, values))
+		}
+	}
+	if s.DefaultActions != nil {
+		for i, v := range s.DefaultActions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "DefaultActions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificates sets the Certificates field's value.
 func (s *CreateListenerInput) SetCertificates(v []*Certificate) *CreateListenerInput {
 	s.Certificates = v
@@ -3522,6 +3593,46 @@ func (s *CreateLoadBalancerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateLoadBalancerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateLoadBalancerInput"}
+	if s.IpAddressType != nil {
+		values := []string{"ipv4", "dualstack"}
+		if !request.IsParamEnumValue(*s.IpAddressType, values) {
+			invalidParams.Add(request.NewErrParamEnum("IpAddressType", *s.IpAddressType, values))
+		}
+	}
+	if s.Scheme != nil {
+		values := []string{"internet-facing", "internal"}
+		if !request.IsParamEnumValue(*s.Scheme, values) {
+			invalidParams.Add(request.NewErrParamEnum("Scheme", *s.Scheme, values))
+		}
+	}
+	if s.Type != nil {
+		values := []string{"application", "network"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIpAddressType sets the IpAddressType field's value.
 func (s *CreateLoadBalancerInput) SetIpAddressType(v string) *CreateLoadBalancerInput {
 	s.IpAddressType = &v
@@ -3693,6 +3804,41 @@ func (s *CreateRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateRuleInput"}
+	if s.Priority != nil && *s.Priority > 50000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Priority", 50000))
+	}
+	if s.Actions != nil {
+		for i, v := range s.Actions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Actions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Conditions != nil {
+		for i, v := range s.Conditions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Conditions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActions sets the Actions field's value.
 func (s *CreateRuleInput) SetActions(v []*Action) *CreateRuleInput {
 	s.Actions = v
@@ -3883,6 +4029,54 @@ func (s *CreateTargetGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateTargetGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTargetGroupInput"}
+	if s.HealthCheckIntervalSeconds != nil && *s.HealthCheckIntervalSeconds > 300 {
+		invalidParams.Add(request.NewErrParamMaxValue("HealthCheckIntervalSeconds", 300))
+	}
+	if s.HealthCheckPath != nil && len(*s.HealthCheckPath) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("HealthCheckPath", 1024))
+	}
+	if s.HealthCheckProtocol != nil {
+		values := []string{"HTTP", "HTTPS", "TCP"}
+		if !request.IsParamEnumValue(*s.HealthCheckProtocol, values) {
+			invalidParams.Add(request.NewErrParamEnum("HealthCheckProtocol", *s.HealthCheckProtocol, values))
+		}
+	}
+	if s.HealthCheckTimeoutSeconds != nil && *s.HealthCheckTimeoutSeconds > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("HealthCheckTimeoutSeconds", 60))
+	}
+	if s.HealthyThresholdCount != nil && *s.HealthyThresholdCount > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("HealthyThresholdCount", 10))
+	}
+	if s.Port != nil && *s.Port > 65535 {
+		invalidParams.Add(request.NewErrParamMaxValue("Port", 65535))
+	}
+	if s.Protocol != nil {
+		values := []string{"HTTP", "HTTPS", "TCP"}
+		if !request.IsParamEnumValue(*s.Protocol, values) {
+			invalidParams.Add(request.NewErrParamEnum("Protocol", *s.Protocol, values))
+		}
+	}
+	if s.TargetType != nil {
+		values := []string{"instance", "ip"}
+		if !request.IsParamEnumValue(*s.TargetType, values) {
+			invalidParams.Add(request.NewErrParamEnum("TargetType", *s.TargetType, values))
+		}
+	}
+	if s.UnhealthyThresholdCount != nil && *s.UnhealthyThresholdCount > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("UnhealthyThresholdCount", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHealthCheckIntervalSeconds sets the HealthCheckIntervalSeconds field's value.
 func (s *CreateTargetGroupInput) SetHealthCheckIntervalSeconds(v int64) *CreateTargetGroupInput {
 	s.HealthCheckIntervalSeconds = &v
@@ -4253,6 +4447,28 @@ func (s *DeregisterTargetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeregisterTargetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeregisterTargetsInput"}
+	if s.Targets != nil {
+		for i, v := range s.Targets {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Targets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTargetGroupArn sets the TargetGroupArn field's value.
 func (s *DeregisterTargetsInput) SetTargetGroupArn(v string) *DeregisterTargetsInput {
 	s.TargetGroupArn = &v
@@ -4315,6 +4531,21 @@ func (s *DescribeAccountLimitsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAccountLimitsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAccountLimitsInput"}
+	if s.PageSize != nil && *s.PageSize > 400 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 400))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *DescribeAccountLimitsInput) SetMarker(v string) *DescribeAccountLimitsInput {
 	s.Marker = &v
@@ -4402,6 +4633,21 @@ func (s *DescribeListenersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeListenersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeListenersInput"}
+	if s.PageSize != nil && *s.PageSize > 400 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 400))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetListenerArns sets the ListenerArns field's value.
 func (s *DescribeListenersInput) SetListenerArns(v []*string) *DescribeListenersInput {
 	s.ListenerArns = v
@@ -4565,6 +4811,21 @@ func (s *DescribeLoadBalancersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeLoadBalancersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeLoadBalancersInput"}
+	if s.PageSize != nil && *s.PageSize > 400 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 400))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLoadBalancerArns sets the LoadBalancerArns field's value.
 func (s *DescribeLoadBalancersInput) SetLoadBalancerArns(v []*string) *DescribeLoadBalancersInput {
 	s.LoadBalancerArns = v
@@ -4664,6 +4925,21 @@ func (s *DescribeRulesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeRulesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeRulesInput"}
+	if s.PageSize != nil && *s.PageSize > 400 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 400))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetListenerArn sets the ListenerArn field's value.
 func (s *DescribeRulesInput) SetListenerArn(v string) *DescribeRulesInput {
 	s.ListenerArn = &v
@@ -4760,6 +5036,21 @@ func (s *DescribeSSLPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeSSLPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeSSLPoliciesInput"}
+	if s.PageSize != nil && *s.PageSize > 400 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 400))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *DescribeSSLPoliciesInput) SetMarker(v string) *DescribeSSLPoliciesInput {
 	s.Marker = &v
@@ -4982,6 +5273,21 @@ func (s *DescribeTargetGroupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeTargetGroupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeTargetGroupsInput"}
+	if s.PageSize != nil && *s.PageSize > 400 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 400))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLoadBalancerArn sets the LoadBalancerArn field's value.
 func (s *DescribeTargetGroupsInput) SetLoadBalancerArn(v string) *DescribeTargetGroupsInput {
 	s.LoadBalancerArn = &v
@@ -5092,6 +5398,28 @@ func (s *DescribeTargetHealthInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeTargetHealthInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeTargetHealthInput"}
+	if s.Targets != nil {
+		for i, v := range s.Targets {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Targets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTargetGroupArn sets the TargetGroupArn field's value.
 func (s *DescribeTargetHealthInput) SetTargetGroupArn(v string) *DescribeTargetHealthInput {
 	s.TargetGroupArn = &v
@@ -5467,6 +5795,27 @@ func (s LoadBalancerAttribute) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LoadBalancerAttribute) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LoadBalancerAttribute"}
+	if s.Key != nil && len(*s.Key) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 256))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^[a-zA-Z0-9._]+$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^[a-zA-Z0-9._]+$"))
+	}
+	if s.Value != nil && len(*s.Value) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *LoadBalancerAttribute) SetKey(v string) *LoadBalancerAttribute {
 	s.Key = &v
@@ -5628,6 +5977,37 @@ func (s *ModifyListenerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyListenerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyListenerInput"}
+	if s.Port != nil && *s.Port > 65535 {
+		invalidParams.Add(request.NewErrParamMaxValue("Port", 65535))
+	}
+	if s.Protocol != nil {
+		values := []string{"HTTP", "HTTPS", "TCP"}
+		if !request.IsParamEnumValue(*s.Protocol, values) {
+			invalidParams.Add(request.NewErrParamEnum("Protocol", *s.Protocol, values))
+		}
+	}
+	if s.DefaultActions != nil {
+		for i, v := range s.DefaultActions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "DefaultActions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificates sets the Certificates field's value.
 func (s *ModifyListenerInput) SetCertificates(v []*Certificate) *ModifyListenerInput {
 	s.Certificates = v
@@ -5729,6 +6109,31 @@ func (s *ModifyLoadBalancerAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyLoadBalancerAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyLoadBalancerAttributesInput"}
+	if s.Attributes != nil && len(s.Attributes) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("Attributes", 20))
+	}
+	if s.Attributes != nil {
+		for i, v := range s.Attributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Attributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *ModifyLoadBalancerAttributesInput) SetAttributes(v []*LoadBalancerAttribute) *ModifyLoadBalancerAttributesInput {
 	s.Attributes = v
@@ -5814,6 +6219,38 @@ func (s *ModifyRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyRuleInput"}
+	if s.Actions != nil {
+		for i, v := range s.Actions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Actions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Conditions != nil {
+		for i, v := range s.Conditions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Conditions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActions sets the Actions field's value.
 func (s *ModifyRuleInput) SetActions(v []*Action) *ModifyRuleInput {
 	s.Actions = v
@@ -5897,6 +6334,28 @@ func (s *ModifyTargetGroupAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyTargetGroupAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyTargetGroupAttributesInput"}
+	if s.Attributes != nil {
+		for i, v := range s.Attributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Attributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *ModifyTargetGroupAttributesInput) SetAttributes(v []*TargetGroupAttribute) *ModifyTargetGroupAttributesInput {
 	s.Attributes = v
@@ -6020,6 +6479,39 @@ func (s *ModifyTargetGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyTargetGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyTargetGroupInput"}
+	if s.HealthCheckIntervalSeconds != nil && *s.HealthCheckIntervalSeconds > 300 {
+		invalidParams.Add(request.NewErrParamMaxValue("HealthCheckIntervalSeconds", 300))
+	}
+	if s.HealthCheckPath != nil && len(*s.HealthCheckPath) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("HealthCheckPath", 1024))
+	}
+	if s.HealthCheckProtocol != nil {
+		values := []string{"HTTP", "HTTPS", "TCP"}
+		if !request.IsParamEnumValue(*s.HealthCheckProtocol, values) {
+			invalidParams.Add(request.NewErrParamEnum("HealthCheckProtocol", *s.HealthCheckProtocol, values))
+		}
+	}
+	if s.HealthCheckTimeoutSeconds != nil && *s.HealthCheckTimeoutSeconds > 60 {
+		invalidParams.Add(request.NewErrParamMaxValue("HealthCheckTimeoutSeconds", 60))
+	}
+	if s.HealthyThresholdCount != nil && *s.HealthyThresholdCount > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("HealthyThresholdCount", 10))
+	}
+	if s.UnhealthyThresholdCount != nil && *s.UnhealthyThresholdCount > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("UnhealthyThresholdCount", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHealthCheckIntervalSeconds sets the HealthCheckIntervalSeconds field's value.
 func (s *ModifyTargetGroupInput) SetHealthCheckIntervalSeconds(v int64) *ModifyTargetGroupInput {
 	s.HealthCheckIntervalSeconds = &v
@@ -6151,6 +6643,28 @@ func (s *RegisterTargetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterTargetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterTargetsInput"}
+	if s.Targets != nil {
+		for i, v := range s.Targets {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Targets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTargetGroupArn sets the TargetGroupArn field's value.
 func (s *RegisterTargetsInput) SetTargetGroupArn(v string) *RegisterTargetsInput {
 	s.TargetGroupArn = &v
@@ -6357,6 +6871,21 @@ func (s RuleCondition) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RuleCondition) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RuleCondition"}
+	if s.Field != nil && len(*s.Field) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Field", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetField sets the Field field's value.
 func (s *RuleCondition) SetField(v string) *RuleCondition {
 	s.Field = &v
@@ -6404,6 +6933,21 @@ func (s *RulePriorityPair) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RulePriorityPair) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RulePriorityPair"}
+	if s.Priority != nil && *s.Priority > 50000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Priority", 50000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPriority sets the Priority field's value.
 func (s *RulePriorityPair) SetPriority(v int64) *RulePriorityPair {
 	s.Priority = &v
@@ -6459,6 +7003,24 @@ func (s *SetIpAddressTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetIpAddressTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetIpAddressTypeInput"}
+	if s.IpAddressType != nil {
+		values := []string{"ipv4", "dualstack"}
+		if !request.IsParamEnumValue(*s.IpAddressType, values) {
+			invalidParams.Add(request.NewErrParamEnum("IpAddressType", *s.IpAddressType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIpAddressType sets the IpAddressType field's value.
 func (s *SetIpAddressTypeInput) SetIpAddressType(v string) *SetIpAddressTypeInput {
 	s.IpAddressType = &v
@@ -6538,6 +7100,28 @@ func (s *SetRulePrioritiesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetRulePrioritiesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetRulePrioritiesInput"}
+	if s.RulePriorities != nil {
+		for i, v := range s.RulePriorities {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "RulePriorities", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRulePriorities sets the RulePriorities field's value.
 func (s *SetRulePrioritiesInput) SetRulePriorities(v []*RulePriorityPair) *SetRulePrioritiesInput {
 	s.RulePriorities = v
@@ -6855,6 +7439,30 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+	if s.Value != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Value) {
+		invalidParams.Add(request.NewErrParamPattern("Value", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -6953,6 +7561,21 @@ func (s *TargetDescription) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TargetDescription) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TargetDescription"}
+	if s.Port != nil && *s.Port > 65535 {
+		invalidParams.Add(request.NewErrParamMaxValue("Port", 65535))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAvailabilityZone sets the AvailabilityZone field's value.
 func (s *TargetDescription) SetAvailabilityZone(v string) *TargetDescription {
 	s.AvailabilityZone = &v
@@ -7168,6 +7791,24 @@ func (s TargetGroupAttribute) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TargetGroupAttribute) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TargetGroupAttribute"}
+	if s.Key != nil && len(*s.Key) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 256))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^[a-zA-Z0-9._]+$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^[a-zA-Z0-9._]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *TargetGroupAttribute) SetKey(v string) *TargetGroupAttribute {
 	s.Key = &v
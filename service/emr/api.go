@@ -2732,6 +2732,26 @@ func (s *AddInstanceFleetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddInstanceFleetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddInstanceFleetInput"}
+	if s.ClusterId != nil && len(*s.ClusterId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClusterId", 256))
+	}
+	if s.InstanceFleet != nil {
+		if err := s.InstanceFleet.ValidateExtended(); err != nil {
+			invalidParams.AddNested("InstanceFleet", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClusterId sets the ClusterId field's value.
 func (s *AddInstanceFleetInput) SetClusterId(v string) *AddInstanceFleetInput {
 	s.ClusterId = &v
@@ -2829,6 +2849,31 @@ func (s *AddInstanceGroupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddInstanceGroupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddInstanceGroupsInput"}
+	if s.JobFlowId != nil && len(*s.JobFlowId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobFlowId", 256))
+	}
+	if s.InstanceGroups != nil {
+		for i, v := range s.InstanceGroups {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "InstanceGroups", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceGroups sets the InstanceGroups field's value.
 func (s *AddInstanceGroupsInput) SetInstanceGroups(v []*InstanceGroupConfig) *AddInstanceGroupsInput {
 	s.InstanceGroups = v
@@ -2928,6 +2973,31 @@ func (s *AddJobFlowStepsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddJobFlowStepsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddJobFlowStepsInput"}
+	if s.JobFlowId != nil && len(*s.JobFlowId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobFlowId", 256))
+	}
+	if s.Steps != nil {
+		for i, v := range s.Steps {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Steps", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobFlowId sets the JobFlowId field's value.
 func (s *AddJobFlowStepsInput) SetJobFlowId(v string) *AddJobFlowStepsInput {
 	s.JobFlowId = &v
@@ -3171,6 +3241,28 @@ func (s *AutoScalingPolicy) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AutoScalingPolicy) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AutoScalingPolicy"}
+	if s.Rules != nil {
+		for i, v := range s.Rules {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Rules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConstraints sets the Constraints field's value.
 func (s *AutoScalingPolicy) SetConstraints(v *ScalingConstraints) *AutoScalingPolicy {
 	s.Constraints = v
@@ -3350,6 +3442,26 @@ func (s *BootstrapActionConfig) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BootstrapActionConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BootstrapActionConfig"}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.ScriptBootstrapAction != nil {
+		if err := s.ScriptBootstrapAction.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ScriptBootstrapAction", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *BootstrapActionConfig) SetName(v string) *BootstrapActionConfig {
 	s.Name = &v
@@ -3455,6 +3567,21 @@ func (s CancelStepsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CancelStepsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelStepsInput"}
+	if s.ClusterId != nil && len(*s.ClusterId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClusterId", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClusterId sets the ClusterId field's value.
 func (s *CancelStepsInput) SetClusterId(v string) *CancelStepsInput {
 	s.ClusterId = &v
@@ -3576,6 +3703,36 @@ func (s *CloudWatchAlarmDefinition) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CloudWatchAlarmDefinition) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CloudWatchAlarmDefinition"}
+	if s.ComparisonOperator != nil {
+		values := []string{"GREATER_THAN_OR_EQUAL", "GREATER_THAN", "LESS_THAN", "LESS_THAN_OR_EQUAL"}
+		if !request.IsParamEnumValue(*s.ComparisonOperator, values) {
+			invalidParams.Add(request.NewErrParamEnum("ComparisonOperator", *s.ComparisonOperator, values))
+		}
+	}
+	if s.Statistic != nil {
+		values := []string{"SAMPLE_COUNT", "AVERAGE", "SUM", "MINIMUM", "MAXIMUM"}
+		if !request.IsParamEnumValue(*s.Statistic, values) {
+			invalidParams.Add(request.NewErrParamEnum("Statistic", *s.Statistic, values))
+		}
+	}
+	if s.Unit != nil {
+		values := []string{"NONE", "SECONDS", "MICRO_SECONDS", "MILLI_SECONDS", "BYTES", "KILO_BYTES", "MEGA_BYTES", "GIGA_BYTES", "TERA_BYTES", "BITS", "KILO_BITS", "MEGA_BITS", "GIGA_BITS", "TERA_BITS", "PERCENT", "COUNT", "BYTES_PER_SECOND", "KILO_BYTES_PER_SECOND", "MEGA_BYTES_PER_SECOND", "GIGA_BYTES_PER_SECOND", "TERA_BYTES_PER_SECOND", "BITS_PER_SECOND", "KILO_BITS_PER_SECOND", "MEGA_BITS_PER_SECOND", "GIGA_BITS_PER_SECOND", "TERA_BITS_PER_SECOND", "COUNT_PER_SECOND"}
+		if !request.IsParamEnumValue(*s.Unit, values) {
+			invalidParams.Add(request.NewErrParamEnum("Unit", *s.Unit, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComparisonOperator sets the ComparisonOperator field's value.
 func (s *CloudWatchAlarmDefinition) SetComparisonOperator(v string) *CloudWatchAlarmDefinition {
 	s.ComparisonOperator = &v
@@ -4211,6 +4368,21 @@ func (s *CreateSecurityConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateSecurityConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateSecurityConfigurationInput"}
+	if s.Name != nil && len(*s.Name) > 10280 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 10280))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *CreateSecurityConfigurationInput) SetName(v string) *CreateSecurityConfigurationInput {
 	s.Name = &v
@@ -4293,6 +4465,21 @@ func (s *DeleteSecurityConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteSecurityConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSecurityConfigurationInput"}
+	if s.Name != nil && len(*s.Name) > 10280 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 10280))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteSecurityConfigurationInput) SetName(v string) *DeleteSecurityConfigurationInput {
 	s.Name = &v
@@ -4489,6 +4676,21 @@ func (s *DescribeSecurityConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeSecurityConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeSecurityConfigurationInput"}
+	if s.Name != nil && len(*s.Name) > 10280 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 10280))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DescribeSecurityConfigurationInput) SetName(v string) *DescribeSecurityConfigurationInput {
 	s.Name = &v
@@ -5036,6 +5238,34 @@ func (s *HadoopJarStepConfig) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *HadoopJarStepConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "HadoopJarStepConfig"}
+	if s.Jar != nil && len(*s.Jar) > 10280 {
+		invalidParams.Add(request.NewErrParamMaxLen("Jar", 10280))
+	}
+	if s.MainClass != nil && len(*s.MainClass) > 10280 {
+		invalidParams.Add(request.NewErrParamMaxLen("MainClass", 10280))
+	}
+	if s.Properties != nil {
+		for i, v := range s.Properties {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Properties", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArgs sets the Args field's value.
 func (s *HadoopJarStepConfig) SetArgs(v []*string) *HadoopJarStepConfig {
 	s.Args = v
@@ -5491,6 +5721,42 @@ func (s *InstanceFleetConfig) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InstanceFleetConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InstanceFleetConfig"}
+	if s.InstanceFleetType != nil {
+		values := []string{"MASTER", "CORE", "TASK"}
+		if !request.IsParamEnumValue(*s.InstanceFleetType, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceFleetType", *s.InstanceFleetType, values))
+		}
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.InstanceTypeConfigs != nil {
+		for i, v := range s.InstanceTypeConfigs {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "InstanceTypeConfigs", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.LaunchSpecifications != nil {
+		if err := s.LaunchSpecifications.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LaunchSpecifications", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceFleetType sets the InstanceFleetType field's value.
 func (s *InstanceFleetConfig) SetInstanceFleetType(v string) *InstanceFleetConfig {
 	s.InstanceFleetType = &v
@@ -5634,6 +5900,23 @@ func (s *InstanceFleetProvisioningSpecifications) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InstanceFleetProvisioningSpecifications) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InstanceFleetProvisioningSpecifications"}
+	if s.SpotSpecification != nil {
+		if err := s.SpotSpecification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SpotSpecification", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSpotSpecification sets the SpotSpecification field's value.
 func (s *InstanceFleetProvisioningSpecifications) SetSpotSpecification(v *SpotProvisioningSpecification) *InstanceFleetProvisioningSpecifications {
 	s.SpotSpecification = v
@@ -6015,6 +6298,44 @@ func (s *InstanceGroupConfig) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InstanceGroupConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InstanceGroupConfig"}
+	if s.BidPrice != nil && len(*s.BidPrice) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("BidPrice", 256))
+	}
+	if s.InstanceRole != nil {
+		values := []string{"MASTER", "CORE", "TASK"}
+		if !request.IsParamEnumValue(*s.InstanceRole, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceRole", *s.InstanceRole, values))
+		}
+	}
+	if s.InstanceType != nil && len(*s.InstanceType) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceType", 256))
+	}
+	if s.Market != nil {
+		values := []string{"ON_DEMAND", "SPOT"}
+		if !request.IsParamEnumValue(*s.Market, values) {
+			invalidParams.Add(request.NewErrParamEnum("Market", *s.Market, values))
+		}
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.AutoScalingPolicy != nil {
+		if err := s.AutoScalingPolicy.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AutoScalingPolicy", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingPolicy sets the AutoScalingPolicy field's value.
 func (s *InstanceGroupConfig) SetAutoScalingPolicy(v *AutoScalingPolicy) *InstanceGroupConfig {
 	s.AutoScalingPolicy = v
@@ -6271,6 +6592,21 @@ func (s *InstanceGroupModifyConfig) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InstanceGroupModifyConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InstanceGroupModifyConfig"}
+	if s.InstanceGroupId != nil && len(*s.InstanceGroupId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceGroupId", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEC2InstanceIdsToTerminate sets the EC2InstanceIdsToTerminate field's value.
 func (s *InstanceGroupModifyConfig) SetEC2InstanceIdsToTerminate(v []*string) *InstanceGroupModifyConfig {
 	s.EC2InstanceIdsToTerminate = v
@@ -6654,6 +6990,24 @@ func (s *InstanceTypeConfig) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InstanceTypeConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InstanceTypeConfig"}
+	if s.BidPrice != nil && len(*s.BidPrice) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("BidPrice", 256))
+	}
+	if s.InstanceType != nil && len(*s.InstanceType) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceType", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBidPrice sets the BidPrice field's value.
 func (s *InstanceTypeConfig) SetBidPrice(v string) *InstanceTypeConfig {
 	s.BidPrice = &v
@@ -7167,6 +7521,67 @@ func (s *JobFlowInstancesConfig) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *JobFlowInstancesConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "JobFlowInstancesConfig"}
+	if s.Ec2KeyName != nil && len(*s.Ec2KeyName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Ec2KeyName", 256))
+	}
+	if s.Ec2SubnetId != nil && len(*s.Ec2SubnetId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Ec2SubnetId", 256))
+	}
+	if s.EmrManagedMasterSecurityGroup != nil && len(*s.EmrManagedMasterSecurityGroup) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("EmrManagedMasterSecurityGroup", 256))
+	}
+	if s.EmrManagedSlaveSecurityGroup != nil && len(*s.EmrManagedSlaveSecurityGroup) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("EmrManagedSlaveSecurityGroup", 256))
+	}
+	if s.HadoopVersion != nil && len(*s.HadoopVersion) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("HadoopVersion", 256))
+	}
+	if s.MasterInstanceType != nil && len(*s.MasterInstanceType) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("MasterInstanceType", 256))
+	}
+	if s.ServiceAccessSecurityGroup != nil && len(*s.ServiceAccessSecurityGroup) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServiceAccessSecurityGroup", 256))
+	}
+	if s.SlaveInstanceType != nil && len(*s.SlaveInstanceType) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("SlaveInstanceType", 256))
+	}
+	if s.InstanceFleets != nil {
+		for i, v := range s.InstanceFleets {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "InstanceFleets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.InstanceGroups != nil {
+		for i, v := range s.InstanceGroups {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "InstanceGroups", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Placement != nil {
+		if err := s.Placement.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Placement", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdditionalMasterSecurityGroups sets the AdditionalMasterSecurityGroups field's value.
 func (s *JobFlowInstancesConfig) SetAdditionalMasterSecurityGroups(v []*string) *JobFlowInstancesConfig {
 	s.AdditionalMasterSecurityGroups = v
@@ -7443,6 +7858,24 @@ func (s KeyValue) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *KeyValue) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "KeyValue"}
+	if s.Key != nil && len(*s.Key) > 10280 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 10280))
+	}
+	if s.Value != nil && len(*s.Value) > 10280 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 10280))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *KeyValue) SetKey(v string) *KeyValue {
 	s.Key = &v
@@ -7843,6 +8276,24 @@ func (s *ListInstancesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListInstancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListInstancesInput"}
+	if s.InstanceFleetType != nil {
+		values := []string{"MASTER", "CORE", "TASK"}
+		if !request.IsParamEnumValue(*s.InstanceFleetType, values) {
+			invalidParams.Add(request.NewErrParamEnum("InstanceFleetType", *s.InstanceFleetType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClusterId sets the ClusterId field's value.
 func (s *ListInstancesInput) SetClusterId(v string) *ListInstancesInput {
 	s.ClusterId = &v
@@ -8233,6 +8684,28 @@ func (s *ModifyInstanceGroupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyInstanceGroupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyInstanceGroupsInput"}
+	if s.InstanceGroups != nil {
+		for i, v := range s.InstanceGroups {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "InstanceGroups", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClusterId sets the ClusterId field's value.
 func (s *ModifyInstanceGroupsInput) SetClusterId(v string) *ModifyInstanceGroupsInput {
 	s.ClusterId = &v
@@ -8290,6 +8763,21 @@ func (s PlacementType) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PlacementType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PlacementType"}
+	if s.AvailabilityZone != nil && len(*s.AvailabilityZone) > 10280 {
+		invalidParams.Add(request.NewErrParamMaxLen("AvailabilityZone", 10280))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAvailabilityZone sets the AvailabilityZone field's value.
 func (s *PlacementType) SetAvailabilityZone(v string) *PlacementType {
 	s.AvailabilityZone = &v
@@ -8358,6 +8846,23 @@ func (s *PutAutoScalingPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutAutoScalingPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutAutoScalingPolicyInput"}
+	if s.AutoScalingPolicy != nil {
+		if err := s.AutoScalingPolicy.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AutoScalingPolicy", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutoScalingPolicy sets the AutoScalingPolicy field's value.
 func (s *PutAutoScalingPolicyInput) SetAutoScalingPolicy(v *AutoScalingPolicy) *PutAutoScalingPolicyInput {
 	s.AutoScalingPolicy = v
@@ -8777,6 +9282,95 @@ func (s *RunJobFlowInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RunJobFlowInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RunJobFlowInput"}
+	if s.AdditionalInfo != nil && len(*s.AdditionalInfo) > 10280 {
+		invalidParams.Add(request.NewErrParamMaxLen("AdditionalInfo", 10280))
+	}
+	if s.AmiVersion != nil && len(*s.AmiVersion) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("AmiVersion", 256))
+	}
+	if s.AutoScalingRole != nil && len(*s.AutoScalingRole) > 10280 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingRole", 10280))
+	}
+	if s.CustomAmiId != nil && len(*s.CustomAmiId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("CustomAmiId", 256))
+	}
+	if s.JobFlowRole != nil && len(*s.JobFlowRole) > 10280 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobFlowRole", 10280))
+	}
+	if s.LogUri != nil && len(*s.LogUri) > 10280 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogUri", 10280))
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.ReleaseLabel != nil && len(*s.ReleaseLabel) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ReleaseLabel", 256))
+	}
+	if s.RepoUpgradeOnBoot != nil {
+		values := []string{"SECURITY", "NONE"}
+		if !request.IsParamEnumValue(*s.RepoUpgradeOnBoot, values) {
+			invalidParams.Add(request.NewErrParamEnum("RepoUpgradeOnBoot", *s.RepoUpgradeOnBoot, values))
+		}
+	}
+	if s.ScaleDownBehavior != nil {
+		values := []string{"TERMINATE_AT_INSTANCE_HOUR", "TERMINATE_AT_TASK_COMPLETION"}
+		if !request.IsParamEnumValue(*s.ScaleDownBehavior, values) {
+			invalidParams.Add(request.NewErrParamEnum("ScaleDownBehavior", *s.ScaleDownBehavior, values))
+		}
+	}
+	if s.SecurityConfiguration != nil && len(*s.SecurityConfiguration) > 10280 {
+		invalidParams.Add(request.NewErrParamMaxLen("SecurityConfiguration", 10280))
+	}
+	if s.ServiceRole != nil && len(*s.ServiceRole) > 10280 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServiceRole", 10280))
+	}
+	if s.BootstrapActions != nil {
+		for i, v := range s.BootstrapActions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "BootstrapActions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Instances != nil {
+		if err := s.Instances.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Instances", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.NewSupportedProducts != nil {
+		for i, v := range s.NewSupportedProducts {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "NewSupportedProducts", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Steps != nil {
+		for i, v := range s.Steps {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Steps", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdditionalInfo sets the AdditionalInfo field's value.
 func (s *RunJobFlowInput) SetAdditionalInfo(v string) *RunJobFlowInput {
 	s.AdditionalInfo = &v
@@ -8979,6 +9573,29 @@ func (s *ScalingAction) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ScalingAction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ScalingAction"}
+	if s.Market != nil {
+		values := []string{"ON_DEMAND", "SPOT"}
+		if !request.IsParamEnumValue(*s.Market, values) {
+			invalidParams.Add(request.NewErrParamEnum("Market", *s.Market, values))
+		}
+	}
+	if s.SimpleScalingPolicyConfiguration != nil {
+		if err := s.SimpleScalingPolicyConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SimpleScalingPolicyConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarket sets the Market field's value.
 func (s *ScalingAction) SetMarket(v string) *ScalingAction {
 	s.Market = &v
@@ -9119,6 +9736,28 @@ func (s *ScalingRule) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ScalingRule) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ScalingRule"}
+	if s.Action != nil {
+		if err := s.Action.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Action", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Trigger != nil {
+		if err := s.Trigger.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Trigger", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *ScalingRule) SetAction(v *ScalingAction) *ScalingRule {
 	s.Action = v
@@ -9183,6 +9822,23 @@ func (s *ScalingTrigger) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ScalingTrigger) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ScalingTrigger"}
+	if s.CloudWatchAlarmDefinition != nil {
+		if err := s.CloudWatchAlarmDefinition.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CloudWatchAlarmDefinition", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCloudWatchAlarmDefinition sets the CloudWatchAlarmDefinition field's value.
 func (s *ScalingTrigger) SetCloudWatchAlarmDefinition(v *CloudWatchAlarmDefinition) *ScalingTrigger {
 	s.CloudWatchAlarmDefinition = v
@@ -9227,6 +9883,21 @@ func (s *ScriptBootstrapActionConfig) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ScriptBootstrapActionConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ScriptBootstrapActionConfig"}
+	if s.Path != nil && len(*s.Path) > 10280 {
+		invalidParams.Add(request.NewErrParamMaxLen("Path", 10280))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArgs sets the Args field's value.
 func (s *ScriptBootstrapActionConfig) SetArgs(v []*string) *ScriptBootstrapActionConfig {
 	s.Args = v
@@ -9516,6 +10187,24 @@ func (s *SimpleScalingPolicyConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SimpleScalingPolicyConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SimpleScalingPolicyConfiguration"}
+	if s.AdjustmentType != nil {
+		values := []string{"CHANGE_IN_CAPACITY", "PERCENT_CHANGE_IN_CAPACITY", "EXACT_CAPACITY"}
+		if !request.IsParamEnumValue(*s.AdjustmentType, values) {
+			invalidParams.Add(request.NewErrParamEnum("AdjustmentType", *s.AdjustmentType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdjustmentType sets the AdjustmentType field's value.
 func (s *SimpleScalingPolicyConfiguration) SetAdjustmentType(v string) *SimpleScalingPolicyConfiguration {
 	s.AdjustmentType = &v
@@ -9597,6 +10286,24 @@ func (s *SpotProvisioningSpecification) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SpotProvisioningSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SpotProvisioningSpecification"}
+	if s.TimeoutAction != nil {
+		values := []string{"SWITCH_TO_ON_DEMAND", "TERMINATE_CLUSTER"}
+		if !request.IsParamEnumValue(*s.TimeoutAction, values) {
+			invalidParams.Add(request.NewErrParamEnum("TimeoutAction", *s.TimeoutAction, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBlockDurationMinutes sets the BlockDurationMinutes field's value.
 func (s *SpotProvisioningSpecification) SetBlockDurationMinutes(v int64) *SpotProvisioningSpecification {
 	s.BlockDurationMinutes = &v
@@ -9727,6 +10434,32 @@ func (s *StepConfig) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StepConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StepConfig"}
+	if s.ActionOnFailure != nil {
+		values := []string{"TERMINATE_JOB_FLOW", "TERMINATE_CLUSTER", "CANCEL_AND_WAIT", "CONTINUE"}
+		if !request.IsParamEnumValue(*s.ActionOnFailure, values) {
+			invalidParams.Add(request.NewErrParamEnum("ActionOnFailure", *s.ActionOnFailure, values))
+		}
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.HadoopJarStep != nil {
+		if err := s.HadoopJarStep.ValidateExtended(); err != nil {
+			invalidParams.AddNested("HadoopJarStep", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActionOnFailure sets the ActionOnFailure field's value.
 func (s *StepConfig) SetActionOnFailure(v string) *StepConfig {
 	s.ActionOnFailure = &v
@@ -10065,6 +10798,21 @@ func (s SupportedProductConfig) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SupportedProductConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SupportedProductConfig"}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArgs sets the Args field's value.
 func (s *SupportedProductConfig) SetArgs(v []*string) *SupportedProductConfig {
 	s.Args = v
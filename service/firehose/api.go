@@ -268,9 +268,10 @@ const opDescribeDeliveryStream = "DescribeDeliveryStream"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/firehose-2015-08-04/DescribeDeliveryStream
 func (c *Firehose) DescribeDeliveryStreamRequest(input *DescribeDeliveryStreamInput) (req *request.Request, output *DescribeDeliveryStreamOutput) {
 	op := &request.Operation{
-		Name:       opDescribeDeliveryStream,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeDeliveryStream,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"DeliveryStreamDescription.Destinations[*].RedshiftDestinationDescription.Username"},
 	}
 
 	if input == nil {
@@ -915,6 +916,24 @@ func (s *BufferingHints) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BufferingHints) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BufferingHints"}
+	if s.IntervalInSeconds != nil && *s.IntervalInSeconds > 900 {
+		invalidParams.Add(request.NewErrParamMaxValue("IntervalInSeconds", 900))
+	}
+	if s.SizeInMBs != nil && *s.SizeInMBs > 128 {
+		invalidParams.Add(request.NewErrParamMaxValue("SizeInMBs", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIntervalInSeconds sets the IntervalInSeconds field's value.
 func (s *BufferingHints) SetIntervalInSeconds(v int64) *BufferingHints {
 	s.IntervalInSeconds = &v
@@ -1139,6 +1158,55 @@ func (s *CreateDeliveryStreamInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDeliveryStreamInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDeliveryStreamInput"}
+	if s.DeliveryStreamName != nil && len(*s.DeliveryStreamName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeliveryStreamName", 64))
+	}
+	if s.DeliveryStreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.DeliveryStreamName) {
+		invalidParams.Add(request.NewErrParamPattern("DeliveryStreamName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.DeliveryStreamType != nil {
+		values := []string{"DirectPut", "KinesisStreamAsSource"}
+		if !request.IsParamEnumValue(*s.DeliveryStreamType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DeliveryStreamType", *s.DeliveryStreamType, values))
+		}
+	}
+	if s.ElasticsearchDestinationConfiguration != nil {
+		if err := s.ElasticsearchDestinationConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ElasticsearchDestinationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ExtendedS3DestinationConfiguration != nil {
+		if err := s.ExtendedS3DestinationConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ExtendedS3DestinationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.KinesisStreamSourceConfiguration != nil {
+		if err := s.KinesisStreamSourceConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("KinesisStreamSourceConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RedshiftDestinationConfiguration != nil {
+		if err := s.RedshiftDestinationConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RedshiftDestinationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3DestinationConfiguration != nil {
+		if err := s.S3DestinationConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3DestinationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeliveryStreamName sets the DeliveryStreamName field's value.
 func (s *CreateDeliveryStreamInput) SetDeliveryStreamName(v string) *CreateDeliveryStreamInput {
 	s.DeliveryStreamName = &v
@@ -1241,6 +1309,24 @@ func (s *DeleteDeliveryStreamInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteDeliveryStreamInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDeliveryStreamInput"}
+	if s.DeliveryStreamName != nil && len(*s.DeliveryStreamName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeliveryStreamName", 64))
+	}
+	if s.DeliveryStreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.DeliveryStreamName) {
+		invalidParams.Add(request.NewErrParamPattern("DeliveryStreamName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeliveryStreamName sets the DeliveryStreamName field's value.
 func (s *DeleteDeliveryStreamInput) SetDeliveryStreamName(v string) *DeleteDeliveryStreamInput {
 	s.DeliveryStreamName = &v
@@ -1441,6 +1527,30 @@ func (s *DescribeDeliveryStreamInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeDeliveryStreamInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeDeliveryStreamInput"}
+	if s.DeliveryStreamName != nil && len(*s.DeliveryStreamName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeliveryStreamName", 64))
+	}
+	if s.DeliveryStreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.DeliveryStreamName) {
+		invalidParams.Add(request.NewErrParamPattern("DeliveryStreamName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.ExclusiveStartDestinationId != nil && len(*s.ExclusiveStartDestinationId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExclusiveStartDestinationId", 100))
+	}
+	if s.Limit != nil && *s.Limit > 10000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 10000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeliveryStreamName sets the DeliveryStreamName field's value.
 func (s *DescribeDeliveryStreamInput) SetDeliveryStreamName(v string) *DescribeDeliveryStreamInput {
 	s.DeliveryStreamName = &v
@@ -1593,6 +1703,24 @@ func (s *ElasticsearchBufferingHints) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ElasticsearchBufferingHints) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ElasticsearchBufferingHints"}
+	if s.IntervalInSeconds != nil && *s.IntervalInSeconds > 900 {
+		invalidParams.Add(request.NewErrParamMaxValue("IntervalInSeconds", 900))
+	}
+	if s.SizeInMBs != nil && *s.SizeInMBs > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("SizeInMBs", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIntervalInSeconds sets the IntervalInSeconds field's value.
 func (s *ElasticsearchBufferingHints) SetIntervalInSeconds(v int64) *ElasticsearchBufferingHints {
 	s.IntervalInSeconds = &v
@@ -1732,6 +1860,68 @@ func (s *ElasticsearchDestinationConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ElasticsearchDestinationConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ElasticsearchDestinationConfiguration"}
+	if s.DomainARN != nil && len(*s.DomainARN) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainARN", 512))
+	}
+	if s.DomainARN != nil && !request.MatchParamPattern("arn:.*", *s.DomainARN) {
+		invalidParams.Add(request.NewErrParamPattern("DomainARN", "arn:.*"))
+	}
+	if s.IndexName != nil && len(*s.IndexName) > 80 {
+		invalidParams.Add(request.NewErrParamMaxLen("IndexName", 80))
+	}
+	if s.IndexRotationPeriod != nil {
+		values := []string{"NoRotation", "OneHour", "OneDay", "OneWeek", "OneMonth"}
+		if !request.IsParamEnumValue(*s.IndexRotationPeriod, values) {
+			invalidParams.Add(request.NewErrParamEnum("IndexRotationPeriod", *s.IndexRotationPeriod, values))
+		}
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 512))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:.*", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:.*"))
+	}
+	if s.S3BackupMode != nil {
+		values := []string{"FailedDocumentsOnly", "AllDocuments"}
+		if !request.IsParamEnumValue(*s.S3BackupMode, values) {
+			invalidParams.Add(request.NewErrParamEnum("S3BackupMode", *s.S3BackupMode, values))
+		}
+	}
+	if s.TypeName != nil && len(*s.TypeName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TypeName", 100))
+	}
+	if s.BufferingHints != nil {
+		if err := s.BufferingHints.ValidateExtended(); err != nil {
+			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ProcessingConfiguration != nil {
+		if err := s.ProcessingConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RetryOptions != nil {
+		if err := s.RetryOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RetryOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3Configuration != nil {
+		if err := s.S3Configuration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3Configuration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBufferingHints sets the BufferingHints field's value.
 func (s *ElasticsearchDestinationConfiguration) SetBufferingHints(v *ElasticsearchBufferingHints) *ElasticsearchDestinationConfiguration {
 	s.BufferingHints = v
@@ -2005,6 +2195,62 @@ func (s *ElasticsearchDestinationUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ElasticsearchDestinationUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ElasticsearchDestinationUpdate"}
+	if s.DomainARN != nil && len(*s.DomainARN) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainARN", 512))
+	}
+	if s.DomainARN != nil && !request.MatchParamPattern("arn:.*", *s.DomainARN) {
+		invalidParams.Add(request.NewErrParamPattern("DomainARN", "arn:.*"))
+	}
+	if s.IndexName != nil && len(*s.IndexName) > 80 {
+		invalidParams.Add(request.NewErrParamMaxLen("IndexName", 80))
+	}
+	if s.IndexRotationPeriod != nil {
+		values := []string{"NoRotation", "OneHour", "OneDay", "OneWeek", "OneMonth"}
+		if !request.IsParamEnumValue(*s.IndexRotationPeriod, values) {
+			invalidParams.Add(request.NewErrParamEnum("IndexRotationPeriod", *s.IndexRotationPeriod, values))
+		}
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 512))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:.*", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:.*"))
+	}
+	if s.TypeName != nil && len(*s.TypeName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TypeName", 100))
+	}
+	if s.BufferingHints != nil {
+		if err := s.BufferingHints.ValidateExtended(); err != nil {
+			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ProcessingConfiguration != nil {
+		if err := s.ProcessingConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RetryOptions != nil {
+		if err := s.RetryOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RetryOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3Update != nil {
+		if err := s.S3Update.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3Update", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBufferingHints sets the BufferingHints field's value.
 func (s *ElasticsearchDestinationUpdate) SetBufferingHints(v *ElasticsearchBufferingHints) *ElasticsearchDestinationUpdate {
 	s.BufferingHints = v
@@ -2089,6 +2335,21 @@ func (s ElasticsearchRetryOptions) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ElasticsearchRetryOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ElasticsearchRetryOptions"}
+	if s.DurationInSeconds != nil && *s.DurationInSeconds > 7200 {
+		invalidParams.Add(request.NewErrParamMaxValue("DurationInSeconds", 7200))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDurationInSeconds sets the DurationInSeconds field's value.
 func (s *ElasticsearchRetryOptions) SetDurationInSeconds(v int64) *ElasticsearchRetryOptions {
 	s.DurationInSeconds = &v
@@ -2133,6 +2394,29 @@ func (s *EncryptionConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EncryptionConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EncryptionConfiguration"}
+	if s.NoEncryptionConfig != nil {
+		values := []string{"NoEncryption"}
+		if !request.IsParamEnumValue(*s.NoEncryptionConfig, values) {
+			invalidParams.Add(request.NewErrParamEnum("NoEncryptionConfig", *s.NoEncryptionConfig, values))
+		}
+	}
+	if s.KMSEncryptionConfig != nil {
+		if err := s.KMSEncryptionConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("KMSEncryptionConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKMSEncryptionConfig sets the KMSEncryptionConfig field's value.
 func (s *EncryptionConfiguration) SetKMSEncryptionConfig(v *KMSEncryptionConfig) *EncryptionConfiguration {
 	s.KMSEncryptionConfig = v
@@ -2242,6 +2526,62 @@ func (s *ExtendedS3DestinationConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ExtendedS3DestinationConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ExtendedS3DestinationConfiguration"}
+	if s.BucketARN != nil && len(*s.BucketARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("BucketARN", 2048))
+	}
+	if s.BucketARN != nil && !request.MatchParamPattern("arn:.*", *s.BucketARN) {
+		invalidParams.Add(request.NewErrParamPattern("BucketARN", "arn:.*"))
+	}
+	if s.CompressionFormat != nil {
+		values := []string{"UNCOMPRESSED", "GZIP", "ZIP", "Snappy"}
+		if !request.IsParamEnumValue(*s.CompressionFormat, values) {
+			invalidParams.Add(request.NewErrParamEnum("CompressionFormat", *s.CompressionFormat, values))
+		}
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 512))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:.*", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:.*"))
+	}
+	if s.S3BackupMode != nil {
+		values := []string{"Disabled", "Enabled"}
+		if !request.IsParamEnumValue(*s.S3BackupMode, values) {
+			invalidParams.Add(request.NewErrParamEnum("S3BackupMode", *s.S3BackupMode, values))
+		}
+	}
+	if s.BufferingHints != nil {
+		if err := s.BufferingHints.ValidateExtended(); err != nil {
+			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.EncryptionConfiguration != nil {
+		if err := s.EncryptionConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("EncryptionConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ProcessingConfiguration != nil {
+		if err := s.ProcessingConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3BackupConfiguration != nil {
+		if err := s.S3BackupConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3BackupConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucketARN sets the BucketARN field's value.
 func (s *ExtendedS3DestinationConfiguration) SetBucketARN(v string) *ExtendedS3DestinationConfiguration {
 	s.BucketARN = &v
@@ -2510,6 +2850,62 @@ func (s *ExtendedS3DestinationUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ExtendedS3DestinationUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ExtendedS3DestinationUpdate"}
+	if s.BucketARN != nil && len(*s.BucketARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("BucketARN", 2048))
+	}
+	if s.BucketARN != nil && !request.MatchParamPattern("arn:.*", *s.BucketARN) {
+		invalidParams.Add(request.NewErrParamPattern("BucketARN", "arn:.*"))
+	}
+	if s.CompressionFormat != nil {
+		values := []string{"UNCOMPRESSED", "GZIP", "ZIP", "Snappy"}
+		if !request.IsParamEnumValue(*s.CompressionFormat, values) {
+			invalidParams.Add(request.NewErrParamEnum("CompressionFormat", *s.CompressionFormat, values))
+		}
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 512))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:.*", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:.*"))
+	}
+	if s.S3BackupMode != nil {
+		values := []string{"Disabled", "Enabled"}
+		if !request.IsParamEnumValue(*s.S3BackupMode, values) {
+			invalidParams.Add(request.NewErrParamEnum("S3BackupMode", *s.S3BackupMode, values))
+		}
+	}
+	if s.BufferingHints != nil {
+		if err := s.BufferingHints.ValidateExtended(); err != nil {
+			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.EncryptionConfiguration != nil {
+		if err := s.EncryptionConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("EncryptionConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ProcessingConfiguration != nil {
+		if err := s.ProcessingConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3BackupUpdate != nil {
+		if err := s.S3BackupUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3BackupUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucketARN sets the BucketARN field's value.
 func (s *ExtendedS3DestinationUpdate) SetBucketARN(v string) *ExtendedS3DestinationUpdate {
 	s.BucketARN = &v
@@ -2604,6 +3000,24 @@ func (s *GetKinesisStreamInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetKinesisStreamInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetKinesisStreamInput"}
+	if s.DeliveryStreamARN != nil && len(*s.DeliveryStreamARN) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeliveryStreamARN", 512))
+	}
+	if s.DeliveryStreamARN != nil && !request.MatchParamPattern("arn:.*", *s.DeliveryStreamARN) {
+		invalidParams.Add(request.NewErrParamPattern("DeliveryStreamARN", "arn:.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeliveryStreamARN sets the DeliveryStreamARN field's value.
 func (s *GetKinesisStreamInput) SetDeliveryStreamARN(v string) *GetKinesisStreamInput {
 	s.DeliveryStreamARN = &v
@@ -2679,6 +3093,24 @@ func (s *KMSEncryptionConfig) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *KMSEncryptionConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "KMSEncryptionConfig"}
+	if s.AWSKMSKeyARN != nil && len(*s.AWSKMSKeyARN) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("AWSKMSKeyARN", 512))
+	}
+	if s.AWSKMSKeyARN != nil && !request.MatchParamPattern("arn:.*", *s.AWSKMSKeyARN) {
+		invalidParams.Add(request.NewErrParamPattern("AWSKMSKeyARN", "arn:.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAWSKMSKeyARN sets the AWSKMSKeyARN field's value.
 func (s *KMSEncryptionConfig) SetAWSKMSKeyARN(v string) *KMSEncryptionConfig {
 	s.AWSKMSKeyARN = &v
@@ -2734,6 +3166,30 @@ func (s *KinesisStreamSourceConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *KinesisStreamSourceConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "KinesisStreamSourceConfiguration"}
+	if s.KinesisStreamARN != nil && len(*s.KinesisStreamARN) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("KinesisStreamARN", 512))
+	}
+	if s.KinesisStreamARN != nil && !request.MatchParamPattern("arn:.*", *s.KinesisStreamARN) {
+		invalidParams.Add(request.NewErrParamPattern("KinesisStreamARN", "arn:.*"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 512))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:.*", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKinesisStreamARN sets the KinesisStreamARN field's value.
 func (s *KinesisStreamSourceConfiguration) SetKinesisStreamARN(v string) *KinesisStreamSourceConfiguration {
 	s.KinesisStreamARN = &v
@@ -2839,6 +3295,33 @@ func (s *ListDeliveryStreamsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListDeliveryStreamsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDeliveryStreamsInput"}
+	if s.DeliveryStreamType != nil {
+		values := []string{"DirectPut", "KinesisStreamAsSource"}
+		if !request.IsParamEnumValue(*s.DeliveryStreamType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DeliveryStreamType", *s.DeliveryStreamType, values))
+		}
+	}
+	if s.ExclusiveStartDeliveryStreamName != nil && len(*s.ExclusiveStartDeliveryStreamName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExclusiveStartDeliveryStreamName", 64))
+	}
+	if s.ExclusiveStartDeliveryStreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ExclusiveStartDeliveryStreamName) {
+		invalidParams.Add(request.NewErrParamPattern("ExclusiveStartDeliveryStreamName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.Limit != nil && *s.Limit > 10000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 10000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeliveryStreamType sets the DeliveryStreamType field's value.
 func (s *ListDeliveryStreamsInput) SetDeliveryStreamType(v string) *ListDeliveryStreamsInput {
 	s.DeliveryStreamType = &v
@@ -2936,6 +3419,28 @@ func (s *ProcessingConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ProcessingConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ProcessingConfiguration"}
+	if s.Processors != nil {
+		for i, v := range s.Processors {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Processors", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEnabled sets the Enabled field's value.
 func (s *ProcessingConfiguration) SetEnabled(v bool) *ProcessingConfiguration {
 	s.Enabled = &v
@@ -2995,6 +3500,34 @@ func (s *Processor) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Processor) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Processor"}
+	if s.Type != nil {
+		values := []string{"Lambda"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.Parameters != nil {
+		for i, v := range s.Parameters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Parameters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetParameters sets the Parameters field's value.
 func (s *Processor) SetParameters(v []*ProcessorParameter) *Processor {
 	s.Parameters = v
@@ -3052,6 +3585,27 @@ func (s *ProcessorParameter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ProcessorParameter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ProcessorParameter"}
+	if s.ParameterName != nil {
+		values := []string{"LambdaArn", "NumberOfRetries"}
+		if !request.IsParamEnumValue(*s.ParameterName, values) {
+			invalidParams.Add(request.NewErrParamEnum("ParameterName", *s.ParameterName, values))
+		}
+	}
+	if s.ParameterValue != nil && len(*s.ParameterValue) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("ParameterValue", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetParameterName sets the ParameterName field's value.
 func (s *ProcessorParameter) SetParameterName(v string) *ProcessorParameter {
 	s.ParameterName = &v
@@ -3121,6 +3675,37 @@ func (s *PutRecordBatchInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutRecordBatchInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutRecordBatchInput"}
+	if s.DeliveryStreamName != nil && len(*s.DeliveryStreamName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeliveryStreamName", 64))
+	}
+	if s.DeliveryStreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.DeliveryStreamName) {
+		invalidParams.Add(request.NewErrParamPattern("DeliveryStreamName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.Records != nil && len(s.Records) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("Records", 500))
+	}
+	if s.Records != nil {
+		for i, v := range s.Records {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Records", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeliveryStreamName sets the DeliveryStreamName field's value.
 func (s *PutRecordBatchInput) SetDeliveryStreamName(v string) *PutRecordBatchInput {
 	s.DeliveryStreamName = &v
@@ -3266,6 +3851,29 @@ func (s *PutRecordInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutRecordInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutRecordInput"}
+	if s.DeliveryStreamName != nil && len(*s.DeliveryStreamName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeliveryStreamName", 64))
+	}
+	if s.DeliveryStreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.DeliveryStreamName) {
+		invalidParams.Add(request.NewErrParamPattern("DeliveryStreamName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.Record != nil {
+		if err := s.Record.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Record", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeliveryStreamName sets the DeliveryStreamName field's value.
 func (s *PutRecordInput) SetDeliveryStreamName(v string) *PutRecordInput {
 	s.DeliveryStreamName = &v
@@ -3341,6 +3949,21 @@ func (s *Record) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Record) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Record"}
+	if s.Data != nil && len(s.Data) > 1.024e+06 {
+		invalidParams.Add(request.NewErrParamMaxLen("Data", 1.024e+06))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetData sets the Data field's value.
 func (s *Record) SetData(v []byte) *Record {
 	s.Data = v
@@ -3368,7 +3991,7 @@ type RedshiftDestinationConfiguration struct {
 	// The user password.
 	//
 	// Password is a required field
-	Password *string `min:"6" type:"string" required:"true"`
+	Password *string `min:"6" type:"string" required:"true" sensitive:"true"`
 
 	// The data processing configuration.
 	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
@@ -3401,7 +4024,7 @@ type RedshiftDestinationConfiguration struct {
 	// The name of the user.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -3474,6 +4097,50 @@ func (s *RedshiftDestinationConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RedshiftDestinationConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RedshiftDestinationConfiguration"}
+	if s.RoleARN != nil && len(*s.RoleARN) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 512))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:.*", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:.*"))
+	}
+	if s.S3BackupMode != nil {
+		values := []string{"Disabled", "Enabled"}
+		if !request.IsParamEnumValue(*s.S3BackupMode, values) {
+			invalidParams.Add(request.NewErrParamEnum("S3BackupMode", *s.S3BackupMode, values))
+		}
+	}
+	if s.ProcessingConfiguration != nil {
+		if err := s.ProcessingConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RetryOptions != nil {
+		if err := s.RetryOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RetryOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3BackupConfiguration != nil {
+		if err := s.S3BackupConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3BackupConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3Configuration != nil {
+		if err := s.S3Configuration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3Configuration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
 func (s *RedshiftDestinationConfiguration) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *RedshiftDestinationConfiguration {
 	s.CloudWatchLoggingOptions = v
@@ -3584,7 +4251,7 @@ type RedshiftDestinationDescription struct {
 	// The name of the user.
 	//
 	// Username is a required field
-	Username *string `min:"1" type:"string" required:"true"`
+	Username *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -3672,7 +4339,7 @@ type RedshiftDestinationUpdate struct {
 	CopyCommand *CopyCommand `type:"structure"`
 
 	// The user password.
-	Password *string `min:"6" type:"string"`
+	Password *string `min:"6" type:"string" sensitive:"true"`
 
 	// The data processing configuration.
 	ProcessingConfiguration *ProcessingConfiguration `type:"structure"`
@@ -3698,7 +4365,7 @@ type RedshiftDestinationUpdate struct {
 	S3Update *S3DestinationUpdate `type:"structure"`
 
 	// The name of the user.
-	Username *string `min:"1" type:"string"`
+	Username *string `min:"1" type:"string" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -3753,6 +4420,50 @@ func (s *RedshiftDestinationUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RedshiftDestinationUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RedshiftDestinationUpdate"}
+	if s.RoleARN != nil && len(*s.RoleARN) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 512))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:.*", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:.*"))
+	}
+	if s.S3BackupMode != nil {
+		values := []string{"Disabled", "Enabled"}
+		if !request.IsParamEnumValue(*s.S3BackupMode, values) {
+			invalidParams.Add(request.NewErrParamEnum("S3BackupMode", *s.S3BackupMode, values))
+		}
+	}
+	if s.ProcessingConfiguration != nil {
+		if err := s.ProcessingConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ProcessingConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RetryOptions != nil {
+		if err := s.RetryOptions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RetryOptions", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3BackupUpdate != nil {
+		if err := s.S3BackupUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3BackupUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3Update != nil {
+		if err := s.S3Update.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3Update", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCloudWatchLoggingOptions sets the CloudWatchLoggingOptions field's value.
 func (s *RedshiftDestinationUpdate) SetCloudWatchLoggingOptions(v *CloudWatchLoggingOptions) *RedshiftDestinationUpdate {
 	s.CloudWatchLoggingOptions = v
@@ -3843,6 +4554,21 @@ func (s RedshiftRetryOptions) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RedshiftRetryOptions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RedshiftRetryOptions"}
+	if s.DurationInSeconds != nil && *s.DurationInSeconds > 7200 {
+		invalidParams.Add(request.NewErrParamMaxValue("DurationInSeconds", 7200))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDurationInSeconds sets the DurationInSeconds field's value.
 func (s *RedshiftRetryOptions) SetDurationInSeconds(v int64) *RedshiftRetryOptions {
 	s.DurationInSeconds = &v
@@ -3932,6 +4658,46 @@ func (s *S3DestinationConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *S3DestinationConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "S3DestinationConfiguration"}
+	if s.BucketARN != nil && len(*s.BucketARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("BucketARN", 2048))
+	}
+	if s.BucketARN != nil && !request.MatchParamPattern("arn:.*", *s.BucketARN) {
+		invalidParams.Add(request.NewErrParamPattern("BucketARN", "arn:.*"))
+	}
+	if s.CompressionFormat != nil {
+		values := []string{"UNCOMPRESSED", "GZIP", "ZIP", "Snappy"}
+		if !request.IsParamEnumValue(*s.CompressionFormat, values) {
+			invalidParams.Add(request.NewErrParamEnum("CompressionFormat", *s.CompressionFormat, values))
+		}
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 512))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:.*", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:.*"))
+	}
+	if s.BufferingHints != nil {
+		if err := s.BufferingHints.ValidateExtended(); err != nil {
+			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.EncryptionConfiguration != nil {
+		if err := s.EncryptionConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("EncryptionConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucketARN sets the BucketARN field's value.
 func (s *S3DestinationConfiguration) SetBucketARN(v string) *S3DestinationConfiguration {
 	s.BucketARN = &v
@@ -4142,6 +4908,46 @@ func (s *S3DestinationUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *S3DestinationUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "S3DestinationUpdate"}
+	if s.BucketARN != nil && len(*s.BucketARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("BucketARN", 2048))
+	}
+	if s.BucketARN != nil && !request.MatchParamPattern("arn:.*", *s.BucketARN) {
+		invalidParams.Add(request.NewErrParamPattern("BucketARN", "arn:.*"))
+	}
+	if s.CompressionFormat != nil {
+		values := []string{"UNCOMPRESSED", "GZIP", "ZIP", "Snappy"}
+		if !request.IsParamEnumValue(*s.CompressionFormat, values) {
+			invalidParams.Add(request.NewErrParamEnum("CompressionFormat", *s.CompressionFormat, values))
+		}
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 512))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:.*", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:.*"))
+	}
+	if s.BufferingHints != nil {
+		if err := s.BufferingHints.ValidateExtended(); err != nil {
+			invalidParams.AddNested("BufferingHints", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.EncryptionConfiguration != nil {
+		if err := s.EncryptionConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("EncryptionConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucketARN sets the BucketARN field's value.
 func (s *S3DestinationUpdate) SetBucketARN(v string) *S3DestinationUpdate {
 	s.BucketARN = &v
@@ -4356,6 +5162,53 @@ func (s *UpdateDestinationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDestinationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDestinationInput"}
+	if s.CurrentDeliveryStreamVersionId != nil && len(*s.CurrentDeliveryStreamVersionId) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("CurrentDeliveryStreamVersionId", 50))
+	}
+	if s.CurrentDeliveryStreamVersionId != nil && !request.MatchParamPattern("[0-9]+", *s.CurrentDeliveryStreamVersionId) {
+		invalidParams.Add(request.NewErrParamPattern("CurrentDeliveryStreamVersionId", "[0-9]+"))
+	}
+	if s.DeliveryStreamName != nil && len(*s.DeliveryStreamName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeliveryStreamName", 64))
+	}
+	if s.DeliveryStreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.DeliveryStreamName) {
+		invalidParams.Add(request.NewErrParamPattern("DeliveryStreamName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.DestinationId != nil && len(*s.DestinationId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DestinationId", 100))
+	}
+	if s.ElasticsearchDestinationUpdate != nil {
+		if err := s.ElasticsearchDestinationUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ElasticsearchDestinationUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ExtendedS3DestinationUpdate != nil {
+		if err := s.ExtendedS3DestinationUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ExtendedS3DestinationUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RedshiftDestinationUpdate != nil {
+		if err := s.RedshiftDestinationUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RedshiftDestinationUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3DestinationUpdate != nil {
+		if err := s.S3DestinationUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3DestinationUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCurrentDeliveryStreamVersionId sets the CurrentDeliveryStreamVersionId field's value.
 func (s *UpdateDestinationInput) SetCurrentDeliveryStreamVersionId(v string) *UpdateDestinationInput {
 	s.CurrentDeliveryStreamVersionId = &v
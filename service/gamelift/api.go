@@ -293,9 +293,10 @@ const opCreateBuild = "CreateBuild"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/gamelift-2015-10-01/CreateBuild
 func (c *GameLift) CreateBuildRequest(input *CreateBuildInput) (req *request.Request, output *CreateBuildOutput) {
 	op := &request.Operation{
-		Name:       opCreateBuild,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opCreateBuild,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"UploadCredentials"},
 	}
 
 	if input == nil {
@@ -5374,9 +5375,10 @@ const opGetInstanceAccess = "GetInstanceAccess"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/gamelift-2015-10-01/GetInstanceAccess
 func (c *GameLift) GetInstanceAccessRequest(input *GetInstanceAccessInput) (req *request.Request, output *GetInstanceAccessOutput) {
 	op := &request.Operation{
-		Name:       opGetInstanceAccess,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opGetInstanceAccess,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"InstanceAccess.Credentials"},
 	}
 
 	if input == nil {
@@ -5989,9 +5991,10 @@ const opRequestUploadCredentials = "RequestUploadCredentials"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/gamelift-2015-10-01/RequestUploadCredentials
 func (c *GameLift) RequestUploadCredentialsRequest(input *RequestUploadCredentialsInput) (req *request.Request, output *RequestUploadCredentialsOutput) {
 	op := &request.Operation{
-		Name:       opRequestUploadCredentials,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opRequestUploadCredentials,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"UploadCredentials"},
 	}
 
 	if input == nil {
@@ -8215,6 +8218,30 @@ func (s *AcceptMatchInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AcceptMatchInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AcceptMatchInput"}
+	if s.AcceptanceType != nil {
+		values := []string{"ACCEPT", "REJECT"}
+		if !request.IsParamEnumValue(*s.AcceptanceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("AcceptanceType", *s.AcceptanceType, values))
+		}
+	}
+	if s.TicketId != nil && len(*s.TicketId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("TicketId", 128))
+	}
+	if s.TicketId != nil && !request.MatchParamPattern("[a-zA-Z0-9-\\.]+", *s.TicketId) {
+		invalidParams.Add(request.NewErrParamPattern("TicketId", "[a-zA-Z0-9-\\.]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptanceType sets the AcceptanceType field's value.
 func (s *AcceptMatchInput) SetAcceptanceType(v string) *AcceptMatchInput {
 	s.AcceptanceType = &v
@@ -8391,6 +8418,21 @@ func (s *AttributeValue) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttributeValue) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttributeValue"}
+	if s.S != nil && len(*s.S) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("S", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetN sets the N field's value.
 func (s *AttributeValue) SetN(v float64) *AttributeValue {
 	s.N = &v
@@ -8420,7 +8462,7 @@ func (s *AttributeValue) SetSL(v []*string) *AttributeValue {
 // your game build, get a new set by calling RequestUploadCredentials.
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/gamelift-2015-10-01/AwsCredentials
 type AwsCredentials struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" sensitive:"true"`
 
 	// Temporary key allowing access to the Amazon GameLift S3 account.
 	AccessKeyId *string `min:"1" type:"string"`
@@ -8622,6 +8664,32 @@ func (s *CreateAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAliasInput"}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+	if s.Name != nil && !request.MatchParamPattern(".*\\S.*", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", ".*\\S.*"))
+	}
+	if s.RoutingStrategy != nil {
+		if err := s.RoutingStrategy.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RoutingStrategy", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *CreateAliasInput) SetDescription(v string) *CreateAliasInput {
 	s.Description = &v
@@ -8724,6 +8792,30 @@ func (s *CreateBuildInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateBuildInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateBuildInput"}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+	if s.OperatingSystem != nil {
+		values := []string{"WINDOWS_2012", "AMAZON_LINUX"}
+		if !request.IsParamEnumValue(*s.OperatingSystem, values) {
+			invalidParams.Add(request.NewErrParamEnum("OperatingSystem", *s.OperatingSystem, values))
+		}
+	}
+	if s.Version != nil && len(*s.Version) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *CreateBuildInput) SetName(v string) *CreateBuildInput {
 	s.Name = &v
@@ -8760,7 +8852,7 @@ type CreateBuildOutput struct {
 	StorageLocation *S3Location `type:"structure"`
 
 	// This element is not currently in use.
-	UploadCredentials *AwsCredentials `type:"structure"`
+	UploadCredentials *AwsCredentials `type:"structure" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -8955,6 +9047,72 @@ func (s *CreateFleetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateFleetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateFleetInput"}
+	if s.BuildId != nil && !request.MatchParamPattern("^build-\\S+", *s.BuildId) {
+		invalidParams.Add(request.NewErrParamPattern("BuildId", "^build-\\S+"))
+	}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.EC2InboundPermissions != nil && len(s.EC2InboundPermissions) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("EC2InboundPermissions", 50))
+	}
+	if s.EC2InstanceType != nil {
+		values := []string{"t2.micro", "t2.small", "t2.medium", "t2.large", "c3.large", "c3.xlarge", "c3.2xlarge", "c3.4xlarge", "c3.8xlarge", "c4.large", "c4.xlarge", "c4.2xlarge", "c4.4xlarge", "c4.8xlarge", "r3.large", "r3.xlarge", "r3.2xlarge", "r3.4xlarge", "r3.8xlarge", "r4.large", "r4.xlarge", "r4.2xlarge", "r4.4xlarge", "r4.8xlarge", "r4.16xlarge", "m3.medium", "m3.large", "m3.xlarge", "m3.2xlarge", "m4.large", "m4.xlarge", "m4.2xlarge", "m4.4xlarge", "m4.10xlarge"}
+		if !request.IsParamEnumValue(*s.EC2InstanceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("EC2InstanceType", *s.EC2InstanceType, values))
+		}
+	}
+	if s.MetricGroups != nil && len(s.MetricGroups) > 1 {
+		invalidParams.Add(request.NewErrParamMaxLen("MetricGroups", 1))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+	if s.NewGameSessionProtectionPolicy != nil {
+		values := []string{"NoProtection", "FullProtection"}
+		if !request.IsParamEnumValue(*s.NewGameSessionProtectionPolicy, values) {
+			invalidParams.Add(request.NewErrParamEnum("NewGameSessionProtectionPolicy", *s.NewGameSessionProtectionPolicy, values))
+		}
+	}
+	if s.PeerVpcAwsAccountId != nil && len(*s.PeerVpcAwsAccountId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PeerVpcAwsAccountId", 1024))
+	}
+	if s.PeerVpcId != nil && len(*s.PeerVpcId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PeerVpcId", 1024))
+	}
+	if s.ServerLaunchParameters != nil && len(*s.ServerLaunchParameters) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerLaunchParameters", 1024))
+	}
+	if s.ServerLaunchPath != nil && len(*s.ServerLaunchPath) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerLaunchPath", 1024))
+	}
+	if s.EC2InboundPermissions != nil {
+		for i, v := range s.EC2InboundPermissions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "EC2InboundPermissions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.RuntimeConfiguration != nil {
+		if err := s.RuntimeConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RuntimeConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBuildId sets the BuildId field's value.
 func (s *CreateFleetInput) SetBuildId(v string) *CreateFleetInput {
 	s.BuildId = &v
@@ -9171,6 +9329,58 @@ func (s *CreateGameSessionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateGameSessionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateGameSessionInput"}
+	if s.AliasId != nil && !request.MatchParamPattern("^alias-\\S+", *s.AliasId) {
+		invalidParams.Add(request.NewErrParamPattern("AliasId", "^alias-\\S+"))
+	}
+	if s.CreatorId != nil && len(*s.CreatorId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("CreatorId", 1024))
+	}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.GameProperties != nil && len(s.GameProperties) > 16 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameProperties", 16))
+	}
+	if s.GameSessionData != nil && len(*s.GameSessionData) > 4096 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameSessionData", 4096))
+	}
+	if s.GameSessionId != nil && len(*s.GameSessionId) > 48 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameSessionId", 48))
+	}
+	if s.GameSessionId != nil && !request.MatchParamPattern("[a-zA-Z0-9-]+", *s.GameSessionId) {
+		invalidParams.Add(request.NewErrParamPattern("GameSessionId", "[a-zA-Z0-9-]+"))
+	}
+	if s.IdempotencyToken != nil && len(*s.IdempotencyToken) > 48 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdempotencyToken", 48))
+	}
+	if s.IdempotencyToken != nil && !request.MatchParamPattern("[a-zA-Z0-9-]+", *s.IdempotencyToken) {
+		invalidParams.Add(request.NewErrParamPattern("IdempotencyToken", "[a-zA-Z0-9-]+"))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+	if s.GameProperties != nil {
+		for i, v := range s.GameProperties {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "GameProperties", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAliasId sets the AliasId field's value.
 func (s *CreateGameSessionInput) SetAliasId(v string) *CreateGameSessionInput {
 	s.AliasId = &v
@@ -9320,6 +9530,34 @@ func (s *CreateGameSessionQueueInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateGameSessionQueueInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateGameSessionQueueInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[a-zA-Z0-9-]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[a-zA-Z0-9-]+"))
+	}
+	if s.Destinations != nil {
+		for i, v := range s.Destinations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Destinations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestinations sets the Destinations field's value.
 func (s *CreateGameSessionQueueInput) SetDestinations(v []*GameSessionQueueDestination) *CreateGameSessionQueueInput {
 	s.Destinations = v
@@ -9508,6 +9746,64 @@ func (s *CreateMatchmakingConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateMatchmakingConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateMatchmakingConfigurationInput"}
+	if s.AcceptanceTimeoutSeconds != nil && *s.AcceptanceTimeoutSeconds > 600 {
+		invalidParams.Add(request.NewErrParamMaxValue("AcceptanceTimeoutSeconds", 600))
+	}
+	if s.CustomEventData != nil && len(*s.CustomEventData) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("CustomEventData", 256))
+	}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.GameProperties != nil && len(s.GameProperties) > 16 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameProperties", 16))
+	}
+	if s.GameSessionData != nil && len(*s.GameSessionData) > 4096 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameSessionData", 4096))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[a-zA-Z0-9-\\.]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[a-zA-Z0-9-\\.]+"))
+	}
+	if s.NotificationTarget != nil && len(*s.NotificationTarget) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("NotificationTarget", 300))
+	}
+	if s.NotificationTarget != nil && !request.MatchParamPattern("[a-zA-Z0-9:_/-]*", *s.NotificationTarget) {
+		invalidParams.Add(request.NewErrParamPattern("NotificationTarget", "[a-zA-Z0-9:_/-]*"))
+	}
+	if s.RequestTimeoutSeconds != nil && *s.RequestTimeoutSeconds > 43200 {
+		invalidParams.Add(request.NewErrParamMaxValue("RequestTimeoutSeconds", 43200))
+	}
+	if s.RuleSetName != nil && len(*s.RuleSetName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleSetName", 128))
+	}
+	if s.RuleSetName != nil && !request.MatchParamPattern("[a-zA-Z0-9-\\.]+", *s.RuleSetName) {
+		invalidParams.Add(request.NewErrParamPattern("RuleSetName", "[a-zA-Z0-9-\\.]+"))
+	}
+	if s.GameProperties != nil {
+		for i, v := range s.GameProperties {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "GameProperties", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptanceRequired sets the AcceptanceRequired field's value.
 func (s *CreateMatchmakingConfigurationInput) SetAcceptanceRequired(v bool) *CreateMatchmakingConfigurationInput {
 	s.AcceptanceRequired = &v
@@ -9655,6 +9951,27 @@ func (s *CreateMatchmakingRuleSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateMatchmakingRuleSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateMatchmakingRuleSetInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[a-zA-Z0-9-\\.]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[a-zA-Z0-9-\\.]+"))
+	}
+	if s.RuleSetBody != nil && len(*s.RuleSetBody) > 65535 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleSetBody", 65535))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *CreateMatchmakingRuleSetInput) SetName(v string) *CreateMatchmakingRuleSetInput {
 	s.Name = &v
@@ -9749,6 +10066,30 @@ func (s *CreatePlayerSessionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePlayerSessionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePlayerSessionInput"}
+	if s.GameSessionId != nil && len(*s.GameSessionId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameSessionId", 256))
+	}
+	if s.GameSessionId != nil && !request.MatchParamPattern("[a-zA-Z0-9:/-]+", *s.GameSessionId) {
+		invalidParams.Add(request.NewErrParamPattern("GameSessionId", "[a-zA-Z0-9:/-]+"))
+	}
+	if s.PlayerData != nil && len(*s.PlayerData) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PlayerData", 2048))
+	}
+	if s.PlayerId != nil && len(*s.PlayerId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PlayerId", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGameSessionId sets the GameSessionId field's value.
 func (s *CreatePlayerSessionInput) SetGameSessionId(v string) *CreatePlayerSessionInput {
 	s.GameSessionId = &v
@@ -9846,6 +10187,27 @@ func (s *CreatePlayerSessionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePlayerSessionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePlayerSessionsInput"}
+	if s.GameSessionId != nil && len(*s.GameSessionId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameSessionId", 256))
+	}
+	if s.GameSessionId != nil && !request.MatchParamPattern("[a-zA-Z0-9:/-]+", *s.GameSessionId) {
+		invalidParams.Add(request.NewErrParamPattern("GameSessionId", "[a-zA-Z0-9:/-]+"))
+	}
+	if s.PlayerIds != nil && len(s.PlayerIds) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("PlayerIds", 25))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGameSessionId sets the GameSessionId field's value.
 func (s *CreatePlayerSessionsInput) SetGameSessionId(v string) *CreatePlayerSessionsInput {
 	s.GameSessionId = &v
@@ -9942,6 +10304,24 @@ func (s *CreateVpcPeeringAuthorizationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateVpcPeeringAuthorizationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateVpcPeeringAuthorizationInput"}
+	if s.GameLiftAwsAccountId != nil && len(*s.GameLiftAwsAccountId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameLiftAwsAccountId", 1024))
+	}
+	if s.PeerVpcId != nil && len(*s.PeerVpcId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PeerVpcId", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGameLiftAwsAccountId sets the GameLiftAwsAccountId field's value.
 func (s *CreateVpcPeeringAuthorizationInput) SetGameLiftAwsAccountId(v string) *CreateVpcPeeringAuthorizationInput {
 	s.GameLiftAwsAccountId = &v
@@ -10041,6 +10421,27 @@ func (s *CreateVpcPeeringConnectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateVpcPeeringConnectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateVpcPeeringConnectionInput"}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.PeerVpcAwsAccountId != nil && len(*s.PeerVpcAwsAccountId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PeerVpcAwsAccountId", 1024))
+	}
+	if s.PeerVpcId != nil && len(*s.PeerVpcId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PeerVpcId", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetId sets the FleetId field's value.
 func (s *CreateVpcPeeringConnectionInput) SetFleetId(v string) *CreateVpcPeeringConnectionInput {
 	s.FleetId = &v
@@ -10108,6 +10509,21 @@ func (s *DeleteAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteAliasInput"}
+	if s.AliasId != nil && !request.MatchParamPattern("^alias-\\S+", *s.AliasId) {
+		invalidParams.Add(request.NewErrParamPattern("AliasId", "^alias-\\S+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAliasId sets the AliasId field's value.
 func (s *DeleteAliasInput) SetAliasId(v string) *DeleteAliasInput {
 	s.AliasId = &v
@@ -10163,6 +10579,21 @@ func (s *DeleteBuildInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteBuildInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteBuildInput"}
+	if s.BuildId != nil && !request.MatchParamPattern("^build-\\S+", *s.BuildId) {
+		invalidParams.Add(request.NewErrParamPattern("BuildId", "^build-\\S+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBuildId sets the BuildId field's value.
 func (s *DeleteBuildInput) SetBuildId(v string) *DeleteBuildInput {
 	s.BuildId = &v
@@ -10218,6 +10649,21 @@ func (s *DeleteFleetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteFleetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteFleetInput"}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetId sets the FleetId field's value.
 func (s *DeleteFleetInput) SetFleetId(v string) *DeleteFleetInput {
 	s.FleetId = &v
@@ -10277,6 +10723,24 @@ func (s *DeleteGameSessionQueueInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteGameSessionQueueInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteGameSessionQueueInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[a-zA-Z0-9-]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[a-zA-Z0-9-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteGameSessionQueueInput) SetName(v string) *DeleteGameSessionQueueInput {
 	s.Name = &v
@@ -10335,8 +10799,26 @@ func (s *DeleteMatchmakingConfigurationInput) Validate() error {
 	return nil
 }
 
-// SetName sets the Name field's value.
-func (s *DeleteMatchmakingConfigurationInput) SetName(v string) *DeleteMatchmakingConfigurationInput {
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteMatchmakingConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteMatchmakingConfigurationInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[a-zA-Z0-9-\\.]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[a-zA-Z0-9-\\.]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetName sets the Name field's value.
+func (s *DeleteMatchmakingConfigurationInput) SetName(v string) *DeleteMatchmakingConfigurationInput {
 	s.Name = &v
 	return s
 }
@@ -10402,6 +10884,24 @@ func (s *DeleteScalingPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteScalingPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteScalingPolicyInput"}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetId sets the FleetId field's value.
 func (s *DeleteScalingPolicyInput) SetFleetId(v string) *DeleteScalingPolicyInput {
 	s.FleetId = &v
@@ -10482,6 +10982,24 @@ func (s *DeleteVpcPeeringAuthorizationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteVpcPeeringAuthorizationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteVpcPeeringAuthorizationInput"}
+	if s.GameLiftAwsAccountId != nil && len(*s.GameLiftAwsAccountId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameLiftAwsAccountId", 1024))
+	}
+	if s.PeerVpcId != nil && len(*s.PeerVpcId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PeerVpcId", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGameLiftAwsAccountId sets the GameLiftAwsAccountId field's value.
 func (s *DeleteVpcPeeringAuthorizationInput) SetGameLiftAwsAccountId(v string) *DeleteVpcPeeringAuthorizationInput {
 	s.GameLiftAwsAccountId = &v
@@ -10556,6 +11074,24 @@ func (s *DeleteVpcPeeringConnectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteVpcPeeringConnectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteVpcPeeringConnectionInput"}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.VpcPeeringConnectionId != nil && len(*s.VpcPeeringConnectionId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("VpcPeeringConnectionId", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetId sets the FleetId field's value.
 func (s *DeleteVpcPeeringConnectionInput) SetFleetId(v string) *DeleteVpcPeeringConnectionInput {
 	s.FleetId = &v
@@ -10617,6 +11153,21 @@ func (s *DescribeAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAliasInput"}
+	if s.AliasId != nil && !request.MatchParamPattern("^alias-\\S+", *s.AliasId) {
+		invalidParams.Add(request.NewErrParamPattern("AliasId", "^alias-\\S+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAliasId sets the AliasId field's value.
 func (s *DescribeAliasInput) SetAliasId(v string) *DescribeAliasInput {
 	s.AliasId = &v
@@ -10682,6 +11233,21 @@ func (s *DescribeBuildInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeBuildInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeBuildInput"}
+	if s.BuildId != nil && !request.MatchParamPattern("^build-\\S+", *s.BuildId) {
+		invalidParams.Add(request.NewErrParamPattern("BuildId", "^build-\\S+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBuildId sets the BuildId field's value.
 func (s *DescribeBuildInput) SetBuildId(v string) *DescribeBuildInput {
 	s.BuildId = &v
@@ -10737,6 +11303,24 @@ func (s DescribeEC2InstanceLimitsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEC2InstanceLimitsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEC2InstanceLimitsInput"}
+	if s.EC2InstanceType != nil {
+		values := []string{"t2.micro", "t2.small", "t2.medium", "t2.large", "c3.large", "c3.xlarge", "c3.2xlarge", "c3.4xlarge", "c3.8xlarge", "c4.large", "c4.xlarge", "c4.2xlarge", "c4.4xlarge", "c4.8xlarge", "r3.large", "r3.xlarge", "r3.2xlarge", "r3.4xlarge", "r3.8xlarge", "r4.large", "r4.xlarge", "r4.2xlarge", "r4.4xlarge", "r4.8xlarge", "r4.16xlarge", "m3.medium", "m3.large", "m3.xlarge", "m3.2xlarge", "m4.large", "m4.xlarge", "m4.2xlarge", "m4.4xlarge", "m4.10xlarge"}
+		if !request.IsParamEnumValue(*s.EC2InstanceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("EC2InstanceType", *s.EC2InstanceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEC2InstanceType sets the EC2InstanceType field's value.
 func (s *DescribeEC2InstanceLimitsInput) SetEC2InstanceType(v string) *DescribeEC2InstanceLimitsInput {
 	s.EC2InstanceType = &v
@@ -10819,6 +11403,21 @@ func (s *DescribeFleetAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeFleetAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeFleetAttributesInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetIds sets the FleetIds field's value.
 func (s *DescribeFleetAttributesInput) SetFleetIds(v []*string) *DescribeFleetAttributesInput {
 	s.FleetIds = v
@@ -10924,6 +11523,21 @@ func (s *DescribeFleetCapacityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeFleetCapacityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeFleetCapacityInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetIds sets the FleetIds field's value.
 func (s *DescribeFleetCapacityInput) SetFleetIds(v []*string) *DescribeFleetCapacityInput {
 	s.FleetIds = v
@@ -11040,6 +11654,24 @@ func (s *DescribeFleetEventsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeFleetEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeFleetEventsInput"}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEndTime sets the EndTime field's value.
 func (s *DescribeFleetEventsInput) SetEndTime(v time.Time) *DescribeFleetEventsInput {
 	s.EndTime = &v
@@ -11140,6 +11772,21 @@ func (s *DescribeFleetPortSettingsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeFleetPortSettingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeFleetPortSettingsInput"}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetId sets the FleetId field's value.
 func (s *DescribeFleetPortSettingsInput) SetFleetId(v string) *DescribeFleetPortSettingsInput {
 	s.FleetId = &v
@@ -11221,6 +11868,21 @@ func (s *DescribeFleetUtilizationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeFleetUtilizationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeFleetUtilizationInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetIds sets the FleetIds field's value.
 func (s *DescribeFleetUtilizationInput) SetFleetIds(v []*string) *DescribeFleetUtilizationInput {
 	s.FleetIds = v
@@ -11339,6 +12001,36 @@ func (s *DescribeGameSessionDetailsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeGameSessionDetailsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeGameSessionDetailsInput"}
+	if s.AliasId != nil && !request.MatchParamPattern("^alias-\\S+", *s.AliasId) {
+		invalidParams.Add(request.NewErrParamPattern("AliasId", "^alias-\\S+"))
+	}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.GameSessionId != nil && len(*s.GameSessionId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameSessionId", 256))
+	}
+	if s.GameSessionId != nil && !request.MatchParamPattern("[a-zA-Z0-9:/-]+", *s.GameSessionId) {
+		invalidParams.Add(request.NewErrParamPattern("GameSessionId", "[a-zA-Z0-9:/-]+"))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.StatusFilter != nil && len(*s.StatusFilter) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("StatusFilter", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAliasId sets the AliasId field's value.
 func (s *DescribeGameSessionDetailsInput) SetAliasId(v string) *DescribeGameSessionDetailsInput {
 	s.AliasId = &v
@@ -11449,6 +12141,24 @@ func (s *DescribeGameSessionPlacementInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeGameSessionPlacementInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeGameSessionPlacementInput"}
+	if s.PlacementId != nil && len(*s.PlacementId) > 48 {
+		invalidParams.Add(request.NewErrParamMaxLen("PlacementId", 48))
+	}
+	if s.PlacementId != nil && !request.MatchParamPattern("[a-zA-Z0-9-]+", *s.PlacementId) {
+		invalidParams.Add(request.NewErrParamPattern("PlacementId", "[a-zA-Z0-9-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPlacementId sets the PlacementId field's value.
 func (s *DescribeGameSessionPlacementInput) SetPlacementId(v string) *DescribeGameSessionPlacementInput {
 	s.PlacementId = &v
@@ -11525,6 +12235,21 @@ func (s *DescribeGameSessionQueuesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeGameSessionQueuesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeGameSessionQueuesInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *DescribeGameSessionQueuesInput) SetLimit(v int64) *DescribeGameSessionQueuesInput {
 	s.Limit = &v
@@ -11642,6 +12367,36 @@ func (s *DescribeGameSessionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeGameSessionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeGameSessionsInput"}
+	if s.AliasId != nil && !request.MatchParamPattern("^alias-\\S+", *s.AliasId) {
+		invalidParams.Add(request.NewErrParamPattern("AliasId", "^alias-\\S+"))
+	}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.GameSessionId != nil && len(*s.GameSessionId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameSessionId", 256))
+	}
+	if s.GameSessionId != nil && !request.MatchParamPattern("[a-zA-Z0-9:/-]+", *s.GameSessionId) {
+		invalidParams.Add(request.NewErrParamPattern("GameSessionId", "[a-zA-Z0-9:/-]+"))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.StatusFilter != nil && len(*s.StatusFilter) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("StatusFilter", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAliasId sets the AliasId field's value.
 func (s *DescribeGameSessionsInput) SetAliasId(v string) *DescribeGameSessionsInput {
 	s.AliasId = &v
@@ -11768,6 +12523,27 @@ func (s *DescribeInstancesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeInstancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeInstancesInput"}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.InstanceId != nil && !request.MatchParamPattern("[a-zA-Z0-9\\.-]+", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "[a-zA-Z0-9\\.-]+"))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetId sets the FleetId field's value.
 func (s *DescribeInstancesInput) SetFleetId(v string) *DescribeInstancesInput {
 	s.FleetId = &v
@@ -11880,6 +12656,27 @@ func (s *DescribeMatchmakingConfigurationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeMatchmakingConfigurationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeMatchmakingConfigurationsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.RuleSetName != nil && len(*s.RuleSetName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleSetName", 128))
+	}
+	if s.RuleSetName != nil && !request.MatchParamPattern("[a-zA-Z0-9-\\.]+", *s.RuleSetName) {
+		invalidParams.Add(request.NewErrParamPattern("RuleSetName", "[a-zA-Z0-9-\\.]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *DescribeMatchmakingConfigurationsInput) SetLimit(v int64) *DescribeMatchmakingConfigurationsInput {
 	s.Limit = &v
@@ -12054,6 +12851,27 @@ func (s *DescribeMatchmakingRuleSetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeMatchmakingRuleSetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeMatchmakingRuleSetsInput"}
+	if s.Limit != nil && *s.Limit > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 10))
+	}
+	if s.Names != nil && len(s.Names) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Names", 10))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *DescribeMatchmakingRuleSetsInput) SetLimit(v int64) *DescribeMatchmakingRuleSetsInput {
 	s.Limit = &v
@@ -12187,6 +13005,36 @@ func (s *DescribePlayerSessionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribePlayerSessionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribePlayerSessionsInput"}
+	if s.GameSessionId != nil && len(*s.GameSessionId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameSessionId", 256))
+	}
+	if s.GameSessionId != nil && !request.MatchParamPattern("[a-zA-Z0-9:/-]+", *s.GameSessionId) {
+		invalidParams.Add(request.NewErrParamPattern("GameSessionId", "[a-zA-Z0-9:/-]+"))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.PlayerId != nil && len(*s.PlayerId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PlayerId", 1024))
+	}
+	if s.PlayerSessionId != nil && !request.MatchParamPattern("^psess-\\S+", *s.PlayerSessionId) {
+		invalidParams.Add(request.NewErrParamPattern("PlayerSessionId", "^psess-\\S+"))
+	}
+	if s.PlayerSessionStatusFilter != nil && len(*s.PlayerSessionStatusFilter) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PlayerSessionStatusFilter", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGameSessionId sets the GameSessionId field's value.
 func (s *DescribePlayerSessionsInput) SetGameSessionId(v string) *DescribePlayerSessionsInput {
 	s.GameSessionId = &v
@@ -12294,6 +13142,21 @@ func (s *DescribeRuntimeConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeRuntimeConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeRuntimeConfigurationInput"}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetId sets the FleetId field's value.
 func (s *DescribeRuntimeConfigurationInput) SetFleetId(v string) *DescribeRuntimeConfigurationInput {
 	s.FleetId = &v
@@ -12394,6 +13257,30 @@ func (s *DescribeScalingPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeScalingPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeScalingPoliciesInput"}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.StatusFilter != nil {
+		values := []string{"ACTIVE", "UPDATE_REQUESTED", "UPDATING", "DELETE_REQUESTED", "DELETING", "DELETED", "ERROR"}
+		if !request.IsParamEnumValue(*s.StatusFilter, values) {
+			invalidParams.Add(request.NewErrParamEnum("StatusFilter", *s.StatusFilter, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetId sets the FleetId field's value.
 func (s *DescribeScalingPoliciesInput) SetFleetId(v string) *DescribeScalingPoliciesInput {
 	s.FleetId = &v
@@ -12513,6 +13400,21 @@ func (s DescribeVpcPeeringConnectionsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeVpcPeeringConnectionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeVpcPeeringConnectionsInput"}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetId sets the FleetId field's value.
 func (s *DescribeVpcPeeringConnectionsInput) SetFleetId(v string) *DescribeVpcPeeringConnectionsInput {
 	s.FleetId = &v
@@ -12584,6 +13486,24 @@ func (s *DesiredPlayerSession) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DesiredPlayerSession) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DesiredPlayerSession"}
+	if s.PlayerData != nil && len(*s.PlayerData) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PlayerData", 2048))
+	}
+	if s.PlayerId != nil && len(*s.PlayerId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PlayerId", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPlayerData sets the PlayerData field's value.
 func (s *DesiredPlayerSession) SetPlayerData(v string) *DesiredPlayerSession {
 	s.PlayerData = &v
@@ -13432,11 +14352,29 @@ func (s *GameProperty) Validate() error {
 	return nil
 }
 
-// SetKey sets the Key field's value.
-func (s *GameProperty) SetKey(v string) *GameProperty {
-	s.Key = &v
-	return s
-}
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GameProperty) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GameProperty"}
+	if s.Key != nil && len(*s.Key) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 32))
+	}
+	if s.Value != nil && len(*s.Value) > 96 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 96))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetKey sets the Key field's value.
+func (s *GameProperty) SetKey(v string) *GameProperty {
+	s.Key = &v
+	return s
+}
 
 // SetValue sets the Value field's value.
 func (s *GameProperty) SetValue(v string) *GameProperty {
@@ -14088,6 +15026,24 @@ func (s *GameSessionQueueDestination) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GameSessionQueueDestination) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GameSessionQueueDestination"}
+	if s.DestinationArn != nil && len(*s.DestinationArn) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("DestinationArn", 256))
+	}
+	if s.DestinationArn != nil && !request.MatchParamPattern("[a-zA-Z0-9:/-]+", *s.DestinationArn) {
+		invalidParams.Add(request.NewErrParamPattern("DestinationArn", "[a-zA-Z0-9:/-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestinationArn sets the DestinationArn field's value.
 func (s *GameSessionQueueDestination) SetDestinationArn(v string) *GameSessionQueueDestination {
 	s.DestinationArn = &v
@@ -14131,6 +15087,24 @@ func (s *GetGameSessionLogUrlInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetGameSessionLogUrlInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetGameSessionLogUrlInput"}
+	if s.GameSessionId != nil && len(*s.GameSessionId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameSessionId", 256))
+	}
+	if s.GameSessionId != nil && !request.MatchParamPattern("[a-zA-Z0-9:/-]+", *s.GameSessionId) {
+		invalidParams.Add(request.NewErrParamPattern("GameSessionId", "[a-zA-Z0-9:/-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGameSessionId sets the GameSessionId field's value.
 func (s *GetGameSessionLogUrlInput) SetGameSessionId(v string) *GetGameSessionLogUrlInput {
 	s.GameSessionId = &v
@@ -14208,6 +15182,24 @@ func (s *GetInstanceAccessInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetInstanceAccessInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetInstanceAccessInput"}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.InstanceId != nil && !request.MatchParamPattern("[a-zA-Z0-9\\.-]+", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "[a-zA-Z0-9\\.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetId sets the FleetId field's value.
 func (s *GetInstanceAccessInput) SetFleetId(v string) *GetInstanceAccessInput {
 	s.FleetId = &v
@@ -14346,7 +15338,7 @@ type InstanceAccess struct {
 	_ struct{} `type:"structure"`
 
 	// Credentials required to access the instance.
-	Credentials *InstanceCredentials `type:"structure"`
+	Credentials *InstanceCredentials `type:"structure" sensitive:"true"`
 
 	// Unique identifier for a fleet containing the instance being accessed.
 	FleetId *string `type:"string"`
@@ -14406,7 +15398,7 @@ func (s *InstanceAccess) SetOperatingSystem(v string) *InstanceAccess {
 // object.
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/gamelift-2015-10-01/InstanceCredentials
 type InstanceCredentials struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" sensitive:"true"`
 
 	// Secret string. For Windows instances, the secret is a password for use with
 	// Windows Remote Desktop. For Linux instances, it is a private key (which must
@@ -14510,6 +15502,33 @@ func (s *IpPermission) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *IpPermission) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "IpPermission"}
+	if s.FromPort != nil && *s.FromPort > 60000 {
+		invalidParams.Add(request.NewErrParamMaxValue("FromPort", 60000))
+	}
+	if s.IpRange != nil && !request.MatchParamPattern("[^\\s]+", *s.IpRange) {
+		invalidParams.Add(request.NewErrParamPattern("IpRange", "[^\\s]+"))
+	}
+	if s.Protocol != nil {
+		values := []string{"TCP", "UDP"}
+		if !request.IsParamEnumValue(*s.Protocol, values) {
+			invalidParams.Add(request.NewErrParamEnum("Protocol", *s.Protocol, values))
+		}
+	}
+	if s.ToPort != nil && *s.ToPort > 60000 {
+		invalidParams.Add(request.NewErrParamMaxValue("ToPort", 60000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFromPort sets the FromPort field's value.
 func (s *IpPermission) SetFromPort(v int64) *IpPermission {
 	s.FromPort = &v
@@ -14596,6 +15615,24 @@ func (s *ListAliasesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAliasesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAliasesInput"}
+	if s.RoutingStrategyType != nil {
+		values := []string{"SIMPLE", "TERMINAL"}
+		if !request.IsParamEnumValue(*s.RoutingStrategyType, values) {
+			invalidParams.Add(request.NewErrParamEnum("RoutingStrategyType", *s.RoutingStrategyType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListAliasesInput) SetLimit(v int64) *ListAliasesInput {
 	s.Limit = &v
@@ -14714,6 +15751,24 @@ func (s *ListBuildsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListBuildsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListBuildsInput"}
+	if s.Status != nil {
+		values := []string{"INITIALIZED", "READY", "FAILED"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListBuildsInput) SetLimit(v int64) *ListBuildsInput {
 	s.Limit = &v
@@ -14814,6 +15869,24 @@ func (s *ListFleetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListFleetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListFleetsInput"}
+	if s.BuildId != nil && !request.MatchParamPattern("^build-\\S+", *s.BuildId) {
+		invalidParams.Add(request.NewErrParamPattern("BuildId", "^build-\\S+"))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBuildId sets the BuildId field's value.
 func (s *ListFleetsInput) SetBuildId(v string) *ListFleetsInput {
 	s.BuildId = &v
@@ -15415,6 +16488,37 @@ func (s *Player) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Player) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Player"}
+	if s.PlayerId != nil && len(*s.PlayerId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PlayerId", 128))
+	}
+	if s.PlayerId != nil && !request.MatchParamPattern("[a-zA-Z0-9-\\.]+", *s.PlayerId) {
+		invalidParams.Add(request.NewErrParamPattern("PlayerId", "[a-zA-Z0-9-\\.]+"))
+	}
+	if s.Team != nil && len(*s.Team) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Team", 1024))
+	}
+	if s.PlayerAttributes != nil {
+		for i, v := range s.PlayerAttributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PlayerAttributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLatencyInMs sets the LatencyInMs field's value.
 func (s *Player) SetLatencyInMs(v map[string]*int64) *Player {
 	s.LatencyInMs = v
@@ -15486,6 +16590,24 @@ func (s *PlayerLatency) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PlayerLatency) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PlayerLatency"}
+	if s.PlayerId != nil && len(*s.PlayerId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("PlayerId", 1024))
+	}
+	if s.RegionIdentifier != nil && len(*s.RegionIdentifier) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("RegionIdentifier", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLatencyInMilliseconds sets the LatencyInMilliseconds field's value.
 func (s *PlayerLatency) SetLatencyInMilliseconds(v float64) *PlayerLatency {
 	s.LatencyInMilliseconds = &v
@@ -15836,6 +16958,42 @@ func (s *PutScalingPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutScalingPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutScalingPolicyInput"}
+	if s.ComparisonOperator != nil {
+		values := []string{"GreaterThanOrEqualToThreshold", "GreaterThanThreshold", "LessThanThreshold", "LessThanOrEqualToThreshold"}
+		if !request.IsParamEnumValue(*s.ComparisonOperator, values) {
+			invalidParams.Add(request.NewErrParamEnum("ComparisonOperator", *s.ComparisonOperator, values))
+		}
+	}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.MetricName != nil {
+		values := []string{"ActivatingGameSessions", "ActiveGameSessions", "ActiveInstances", "AvailableGameSessions", "AvailablePlayerSessions", "CurrentPlayerSessions", "IdleInstances", "PercentAvailableGameSessions", "PercentIdleInstances", "QueueDepth", "WaitTime"}
+		if !request.IsParamEnumValue(*s.MetricName, values) {
+			invalidParams.Add(request.NewErrParamEnum("MetricName", *s.MetricName, values))
+		}
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+	if s.ScalingAdjustmentType != nil {
+		values := []string{"ChangeInCapacity", "ExactCapacity", "PercentChangeInCapacity"}
+		if !request.IsParamEnumValue(*s.ScalingAdjustmentType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ScalingAdjustmentType", *s.ScalingAdjustmentType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComparisonOperator sets the ComparisonOperator field's value.
 func (s *PutScalingPolicyInput) SetComparisonOperator(v string) *PutScalingPolicyInput {
 	s.ComparisonOperator = &v
@@ -15944,6 +17102,21 @@ func (s *RequestUploadCredentialsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RequestUploadCredentialsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RequestUploadCredentialsInput"}
+	if s.BuildId != nil && !request.MatchParamPattern("^build-\\S+", *s.BuildId) {
+		invalidParams.Add(request.NewErrParamPattern("BuildId", "^build-\\S+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBuildId sets the BuildId field's value.
 func (s *RequestUploadCredentialsInput) SetBuildId(v string) *RequestUploadCredentialsInput {
 	s.BuildId = &v
@@ -15961,7 +17134,7 @@ type RequestUploadCredentialsOutput struct {
 	// AWS credentials required when uploading a game build to the storage location.
 	// These credentials have a limited lifespan and are valid only for the build
 	// they were issued for.
-	UploadCredentials *AwsCredentials `type:"structure"`
+	UploadCredentials *AwsCredentials `type:"structure" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -16020,6 +17193,21 @@ func (s *ResolveAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResolveAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResolveAliasInput"}
+	if s.AliasId != nil && !request.MatchParamPattern("^alias-\\S+", *s.AliasId) {
+		invalidParams.Add(request.NewErrParamPattern("AliasId", "^alias-\\S+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAliasId sets the AliasId field's value.
 func (s *ResolveAliasInput) SetAliasId(v string) *ResolveAliasInput {
 	s.AliasId = &v
@@ -16174,6 +17362,27 @@ func (s RoutingStrategy) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RoutingStrategy) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RoutingStrategy"}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.Type != nil {
+		values := []string{"SIMPLE", "TERMINAL"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetId sets the FleetId field's value.
 func (s *RoutingStrategy) SetFleetId(v string) *RoutingStrategy {
 	s.FleetId = &v
@@ -16315,6 +17524,37 @@ func (s *RuntimeConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RuntimeConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RuntimeConfiguration"}
+	if s.GameSessionActivationTimeoutSeconds != nil && *s.GameSessionActivationTimeoutSeconds > 600 {
+		invalidParams.Add(request.NewErrParamMaxValue("GameSessionActivationTimeoutSeconds", 600))
+	}
+	if s.MaxConcurrentGameSessionActivations != nil && *s.MaxConcurrentGameSessionActivations > 2.147483647e+09 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxConcurrentGameSessionActivations", 2.147483647e+09))
+	}
+	if s.ServerProcesses != nil && len(s.ServerProcesses) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerProcesses", 50))
+	}
+	if s.ServerProcesses != nil {
+		for i, v := range s.ServerProcesses {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ServerProcesses", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGameSessionActivationTimeoutSeconds sets the GameSessionActivationTimeoutSeconds field's value.
 func (s *RuntimeConfiguration) SetGameSessionActivationTimeoutSeconds(v int64) *RuntimeConfiguration {
 	s.GameSessionActivationTimeoutSeconds = &v
@@ -16704,6 +17944,33 @@ func (s *SearchGameSessionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SearchGameSessionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SearchGameSessionsInput"}
+	if s.AliasId != nil && !request.MatchParamPattern("^alias-\\S+", *s.AliasId) {
+		invalidParams.Add(request.NewErrParamPattern("AliasId", "^alias-\\S+"))
+	}
+	if s.FilterExpression != nil && len(*s.FilterExpression) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("FilterExpression", 1024))
+	}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.SortExpression != nil && len(*s.SortExpression) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("SortExpression", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAliasId sets the AliasId field's value.
 func (s *SearchGameSessionsInput) SetAliasId(v string) *SearchGameSessionsInput {
 	s.AliasId = &v
@@ -16841,6 +18108,24 @@ func (s *ServerProcess) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ServerProcess) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ServerProcess"}
+	if s.LaunchPath != nil && len(*s.LaunchPath) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("LaunchPath", 1024))
+	}
+	if s.Parameters != nil && len(*s.Parameters) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Parameters", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConcurrentExecutions sets the ConcurrentExecutions field's value.
 func (s *ServerProcess) SetConcurrentExecutions(v int64) *ServerProcess {
 	s.ConcurrentExecutions = &v
@@ -16979,6 +18264,69 @@ func (s *StartGameSessionPlacementInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartGameSessionPlacementInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartGameSessionPlacementInput"}
+	if s.GameProperties != nil && len(s.GameProperties) > 16 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameProperties", 16))
+	}
+	if s.GameSessionData != nil && len(*s.GameSessionData) > 4096 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameSessionData", 4096))
+	}
+	if s.GameSessionName != nil && len(*s.GameSessionName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameSessionName", 1024))
+	}
+	if s.GameSessionQueueName != nil && len(*s.GameSessionQueueName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameSessionQueueName", 128))
+	}
+	if s.GameSessionQueueName != nil && !request.MatchParamPattern("[a-zA-Z0-9-]+", *s.GameSessionQueueName) {
+		invalidParams.Add(request.NewErrParamPattern("GameSessionQueueName", "[a-zA-Z0-9-]+"))
+	}
+	if s.PlacementId != nil && len(*s.PlacementId) > 48 {
+		invalidParams.Add(request.NewErrParamMaxLen("PlacementId", 48))
+	}
+	if s.PlacementId != nil && !request.MatchParamPattern("[a-zA-Z0-9-]+", *s.PlacementId) {
+		invalidParams.Add(request.NewErrParamPattern("PlacementId", "[a-zA-Z0-9-]+"))
+	}
+	if s.DesiredPlayerSessions != nil {
+		for i, v := range s.DesiredPlayerSessions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "DesiredPlayerSessions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.GameProperties != nil {
+		for i, v := range s.GameProperties {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "GameProperties", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.PlayerLatencies != nil {
+		for i, v := range s.PlayerLatencies {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PlayerLatencies", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDesiredPlayerSessions sets the DesiredPlayerSessions field's value.
 func (s *StartGameSessionPlacementInput) SetDesiredPlayerSessions(v []*DesiredPlayerSession) *StartGameSessionPlacementInput {
 	s.DesiredPlayerSessions = v
@@ -17120,6 +18468,40 @@ func (s *StartMatchmakingInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartMatchmakingInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartMatchmakingInput"}
+	if s.ConfigurationName != nil && len(*s.ConfigurationName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConfigurationName", 128))
+	}
+	if s.ConfigurationName != nil && !request.MatchParamPattern("[a-zA-Z0-9-\\.]+", *s.ConfigurationName) {
+		invalidParams.Add(request.NewErrParamPattern("ConfigurationName", "[a-zA-Z0-9-\\.]+"))
+	}
+	if s.TicketId != nil && len(*s.TicketId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("TicketId", 128))
+	}
+	if s.TicketId != nil && !request.MatchParamPattern("[a-zA-Z0-9-\\.]+", *s.TicketId) {
+		invalidParams.Add(request.NewErrParamPattern("TicketId", "[a-zA-Z0-9-\\.]+"))
+	}
+	if s.Players != nil {
+		for i, v := range s.Players {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Players", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigurationName sets the ConfigurationName field's value.
 func (s *StartMatchmakingInput) SetConfigurationName(v string) *StartMatchmakingInput {
 	s.ConfigurationName = &v
@@ -17202,6 +18584,24 @@ func (s *StopGameSessionPlacementInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopGameSessionPlacementInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopGameSessionPlacementInput"}
+	if s.PlacementId != nil && len(*s.PlacementId) > 48 {
+		invalidParams.Add(request.NewErrParamMaxLen("PlacementId", 48))
+	}
+	if s.PlacementId != nil && !request.MatchParamPattern("[a-zA-Z0-9-]+", *s.PlacementId) {
+		invalidParams.Add(request.NewErrParamPattern("PlacementId", "[a-zA-Z0-9-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPlacementId sets the PlacementId field's value.
 func (s *StopGameSessionPlacementInput) SetPlacementId(v string) *StopGameSessionPlacementInput {
 	s.PlacementId = &v
@@ -17271,6 +18671,24 @@ func (s *StopMatchmakingInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopMatchmakingInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopMatchmakingInput"}
+	if s.TicketId != nil && len(*s.TicketId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("TicketId", 128))
+	}
+	if s.TicketId != nil && !request.MatchParamPattern("[a-zA-Z0-9-\\.]+", *s.TicketId) {
+		invalidParams.Add(request.NewErrParamPattern("TicketId", "[a-zA-Z0-9-\\.]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTicketId sets the TicketId field's value.
 func (s *StopMatchmakingInput) SetTicketId(v string) *StopMatchmakingInput {
 	s.TicketId = &v
@@ -17342,6 +18760,35 @@ func (s *UpdateAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateAliasInput"}
+	if s.AliasId != nil && !request.MatchParamPattern("^alias-\\S+", *s.AliasId) {
+		invalidParams.Add(request.NewErrParamPattern("AliasId", "^alias-\\S+"))
+	}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+	if s.Name != nil && !request.MatchParamPattern(".*\\S.*", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", ".*\\S.*"))
+	}
+	if s.RoutingStrategy != nil {
+		if err := s.RoutingStrategy.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RoutingStrategy", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAliasId sets the AliasId field's value.
 func (s *UpdateAliasInput) SetAliasId(v string) *UpdateAliasInput {
 	s.AliasId = &v
@@ -17439,6 +18886,27 @@ func (s *UpdateBuildInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateBuildInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateBuildInput"}
+	if s.BuildId != nil && !request.MatchParamPattern("^build-\\S+", *s.BuildId) {
+		invalidParams.Add(request.NewErrParamPattern("BuildId", "^build-\\S+"))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+	if s.Version != nil && len(*s.Version) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBuildId sets the BuildId field's value.
 func (s *UpdateBuildInput) SetBuildId(v string) *UpdateBuildInput {
 	s.BuildId = &v
@@ -17551,6 +19019,36 @@ func (s *UpdateFleetAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateFleetAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateFleetAttributesInput"}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.MetricGroups != nil && len(s.MetricGroups) > 1 {
+		invalidParams.Add(request.NewErrParamMaxLen("MetricGroups", 1))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+	if s.NewGameSessionProtectionPolicy != nil {
+		values := []string{"NoProtection", "FullProtection"}
+		if !request.IsParamEnumValue(*s.NewGameSessionProtectionPolicy, values) {
+			invalidParams.Add(request.NewErrParamEnum("NewGameSessionProtectionPolicy", *s.NewGameSessionProtectionPolicy, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *UpdateFleetAttributesInput) SetDescription(v string) *UpdateFleetAttributesInput {
 	s.Description = &v
@@ -17657,6 +19155,21 @@ func (s *UpdateFleetCapacityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateFleetCapacityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateFleetCapacityInput"}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDesiredInstances sets the DesiredInstances field's value.
 func (s *UpdateFleetCapacityInput) SetDesiredInstances(v int64) *UpdateFleetCapacityInput {
 	s.DesiredInstances = &v
@@ -17766,6 +19279,47 @@ func (s *UpdateFleetPortSettingsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateFleetPortSettingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateFleetPortSettingsInput"}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.InboundPermissionAuthorizations != nil && len(s.InboundPermissionAuthorizations) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("InboundPermissionAuthorizations", 50))
+	}
+	if s.InboundPermissionRevocations != nil && len(s.InboundPermissionRevocations) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("InboundPermissionRevocations", 50))
+	}
+	if s.InboundPermissionAuthorizations != nil {
+		for i, v := range s.InboundPermissionAuthorizations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "InboundPermissionAuthorizations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.InboundPermissionRevocations != nil {
+		for i, v := range s.InboundPermissionRevocations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "InboundPermissionRevocations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetId sets the FleetId field's value.
 func (s *UpdateFleetPortSettingsInput) SetFleetId(v string) *UpdateFleetPortSettingsInput {
 	s.FleetId = &v
@@ -17869,6 +19423,39 @@ func (s *UpdateGameSessionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateGameSessionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateGameSessionInput"}
+	if s.GameSessionId != nil && len(*s.GameSessionId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameSessionId", 256))
+	}
+	if s.GameSessionId != nil && !request.MatchParamPattern("[a-zA-Z0-9:/-]+", *s.GameSessionId) {
+		invalidParams.Add(request.NewErrParamPattern("GameSessionId", "[a-zA-Z0-9:/-]+"))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+	if s.PlayerSessionCreationPolicy != nil {
+		values := []string{"ACCEPT_ALL", "DENY_ALL"}
+		if !request.IsParamEnumValue(*s.PlayerSessionCreationPolicy, values) {
+			invalidParams.Add(request.NewErrParamEnum("PlayerSessionCreationPolicy", *s.PlayerSessionCreationPolicy, values))
+		}
+	}
+	if s.ProtectionPolicy != nil {
+		values := []string{"NoProtection", "FullProtection"}
+		if !request.IsParamEnumValue(*s.ProtectionPolicy, values) {
+			invalidParams.Add(request.NewErrParamEnum("ProtectionPolicy", *s.ProtectionPolicy, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGameSessionId sets the GameSessionId field's value.
 func (s *UpdateGameSessionInput) SetGameSessionId(v string) *UpdateGameSessionInput {
 	s.GameSessionId = &v
@@ -17994,6 +19581,34 @@ func (s *UpdateGameSessionQueueInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateGameSessionQueueInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateGameSessionQueueInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[a-zA-Z0-9-]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[a-zA-Z0-9-]+"))
+	}
+	if s.Destinations != nil {
+		for i, v := range s.Destinations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Destinations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestinations sets the Destinations field's value.
 func (s *UpdateGameSessionQueueInput) SetDestinations(v []*GameSessionQueueDestination) *UpdateGameSessionQueueInput {
 	s.Destinations = v
@@ -18163,6 +19778,64 @@ func (s *UpdateMatchmakingConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateMatchmakingConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateMatchmakingConfigurationInput"}
+	if s.AcceptanceTimeoutSeconds != nil && *s.AcceptanceTimeoutSeconds > 600 {
+		invalidParams.Add(request.NewErrParamMaxValue("AcceptanceTimeoutSeconds", 600))
+	}
+	if s.CustomEventData != nil && len(*s.CustomEventData) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("CustomEventData", 256))
+	}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.GameProperties != nil && len(s.GameProperties) > 16 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameProperties", 16))
+	}
+	if s.GameSessionData != nil && len(*s.GameSessionData) > 4096 {
+		invalidParams.Add(request.NewErrParamMaxLen("GameSessionData", 4096))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[a-zA-Z0-9-\\.]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[a-zA-Z0-9-\\.]+"))
+	}
+	if s.NotificationTarget != nil && len(*s.NotificationTarget) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("NotificationTarget", 300))
+	}
+	if s.NotificationTarget != nil && !request.MatchParamPattern("[a-zA-Z0-9:_/-]*", *s.NotificationTarget) {
+		invalidParams.Add(request.NewErrParamPattern("NotificationTarget", "[a-zA-Z0-9:_/-]*"))
+	}
+	if s.RequestTimeoutSeconds != nil && *s.RequestTimeoutSeconds > 43200 {
+		invalidParams.Add(request.NewErrParamMaxValue("RequestTimeoutSeconds", 43200))
+	}
+	if s.RuleSetName != nil && len(*s.RuleSetName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleSetName", 128))
+	}
+	if s.RuleSetName != nil && !request.MatchParamPattern("[a-zA-Z0-9-\\.]+", *s.RuleSetName) {
+		invalidParams.Add(request.NewErrParamPattern("RuleSetName", "[a-zA-Z0-9-\\.]+"))
+	}
+	if s.GameProperties != nil {
+		for i, v := range s.GameProperties {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "GameProperties", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptanceRequired sets the AcceptanceRequired field's value.
 func (s *UpdateMatchmakingConfigurationInput) SetAcceptanceRequired(v bool) *UpdateMatchmakingConfigurationInput {
 	s.AcceptanceRequired = &v
@@ -18312,6 +19985,26 @@ func (s *UpdateRuntimeConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateRuntimeConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateRuntimeConfigurationInput"}
+	if s.FleetId != nil && !request.MatchParamPattern("^fleet-\\S+", *s.FleetId) {
+		invalidParams.Add(request.NewErrParamPattern("FleetId", "^fleet-\\S+"))
+	}
+	if s.RuntimeConfiguration != nil {
+		if err := s.RuntimeConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RuntimeConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFleetId sets the FleetId field's value.
 func (s *UpdateRuntimeConfigurationInput) SetFleetId(v string) *UpdateRuntimeConfigurationInput {
 	s.FleetId = &v
@@ -18387,6 +20080,21 @@ func (s *ValidateMatchmakingRuleSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ValidateMatchmakingRuleSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ValidateMatchmakingRuleSetInput"}
+	if s.RuleSetBody != nil && len(*s.RuleSetBody) > 65535 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleSetBody", 65535))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRuleSetBody sets the RuleSetBody field's value.
 func (s *ValidateMatchmakingRuleSetInput) SetRuleSetBody(v string) *ValidateMatchmakingRuleSetInput {
 	s.RuleSetBody = &v
@@ -7249,6 +7249,21 @@ func (s *Action) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Action) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Action"}
+	if s.JobName != nil && len(*s.JobName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArguments sets the Arguments field's value.
 func (s *Action) SetArguments(v map[string]*string) *Action {
 	s.Arguments = v
@@ -7333,6 +7348,40 @@ func (s *BatchCreatePartitionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchCreatePartitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchCreatePartitionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.PartitionInputList != nil && len(s.PartitionInputList) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PartitionInputList", 100))
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.PartitionInputList != nil {
+		for i, v := range s.PartitionInputList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PartitionInputList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *BatchCreatePartitionInput) SetCatalogId(v string) *BatchCreatePartitionInput {
 	s.CatalogId = &v
@@ -7421,6 +7470,24 @@ func (s *BatchDeleteConnectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchDeleteConnectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchDeleteConnectionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.ConnectionNameList != nil && len(s.ConnectionNameList) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConnectionNameList", 25))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *BatchDeleteConnectionInput) SetCatalogId(v string) *BatchDeleteConnectionInput {
 	s.CatalogId = &v
@@ -7539,6 +7606,30 @@ func (s *BatchDeletePartitionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchDeletePartitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchDeletePartitionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.PartitionsToDelete != nil && len(s.PartitionsToDelete) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("PartitionsToDelete", 25))
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *BatchDeletePartitionInput) SetCatalogId(v string) *BatchDeletePartitionInput {
 	s.CatalogId = &v
@@ -7638,6 +7729,27 @@ func (s *BatchDeleteTableInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchDeleteTableInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchDeleteTableInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.TablesToDelete != nil && len(s.TablesToDelete) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TablesToDelete", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *BatchDeleteTableInput) SetCatalogId(v string) *BatchDeleteTableInput {
 	s.CatalogId = &v
@@ -7752,6 +7864,30 @@ func (s *BatchGetPartitionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchGetPartitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchGetPartitionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.PartitionsToGet != nil && len(s.PartitionsToGet) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("PartitionsToGet", 1000))
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *BatchGetPartitionInput) SetCatalogId(v string) *BatchGetPartitionInput {
 	s.CatalogId = &v
@@ -7858,6 +7994,24 @@ func (s *CatalogEntry) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CatalogEntry) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CatalogEntry"}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDatabaseName sets the DatabaseName field's value.
 func (s *CatalogEntry) SetDatabaseName(v string) *CatalogEntry {
 	s.DatabaseName = &v
@@ -7993,6 +8147,30 @@ func (s *CodeGenEdge) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CodeGenEdge) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CodeGenEdge"}
+	if s.Source != nil && len(*s.Source) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Source", 255))
+	}
+	if s.Source != nil && !request.MatchParamPattern("[A-Za-z_][A-Za-z0-9_]*", *s.Source) {
+		invalidParams.Add(request.NewErrParamPattern("Source", "[A-Za-z_][A-Za-z0-9_]*"))
+	}
+	if s.Target != nil && len(*s.Target) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Target", 255))
+	}
+	if s.Target != nil && !request.MatchParamPattern("[A-Za-z_][A-Za-z0-9_]*", *s.Target) {
+		invalidParams.Add(request.NewErrParamPattern("Target", "[A-Za-z_][A-Za-z0-9_]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSource sets the Source field's value.
 func (s *CodeGenEdge) SetSource(v string) *CodeGenEdge {
 	s.Source = &v
@@ -8077,6 +8255,27 @@ func (s *CodeGenNode) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CodeGenNode) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CodeGenNode"}
+	if s.Args != nil && len(s.Args) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Args", 50))
+	}
+	if s.Id != nil && len(*s.Id) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 255))
+	}
+	if s.Id != nil && !request.MatchParamPattern("[A-Za-z_][A-Za-z0-9_]*", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "[A-Za-z_][A-Za-z0-9_]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArgs sets the Args field's value.
 func (s *CodeGenNode) SetArgs(v []*CodeGenNodeArg) *CodeGenNode {
 	s.Args = v
@@ -8207,6 +8406,27 @@ func (s *Column) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Column) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Column"}
+	if s.Comment != nil && len(*s.Comment) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Comment", 255))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.Type != nil && len(*s.Type) > 131072 {
+		invalidParams.Add(request.NewErrParamMaxLen("Type", 131072))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComment sets the Comment field's value.
 func (s *Column) SetComment(v string) *Column {
 	s.Comment = &v
@@ -8259,6 +8479,33 @@ func (s *Condition) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Condition) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Condition"}
+	if s.JobName != nil && len(*s.JobName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobName", 255))
+	}
+	if s.LogicalOperator != nil {
+		values := []string{"EQUALS"}
+		if !request.IsParamEnumValue(*s.LogicalOperator, values) {
+			invalidParams.Add(request.NewErrParamEnum("LogicalOperator", *s.LogicalOperator, values))
+		}
+	}
+	if s.State != nil {
+		values := []string{"STARTING", "RUNNING", "STOPPING", "STOPPED", "SUCCEEDED", "FAILED"}
+		if !request.IsParamEnumValue(*s.State, values) {
+			invalidParams.Add(request.NewErrParamEnum("State", *s.State, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobName sets the JobName field's value.
 func (s *Condition) SetJobName(v string) *Condition {
 	s.JobName = &v
@@ -8428,6 +8675,41 @@ func (s *ConnectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ConnectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ConnectionInput"}
+	if s.ConnectionProperties != nil && len(s.ConnectionProperties) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConnectionProperties", 100))
+	}
+	if s.ConnectionType != nil {
+		values := []string{"JDBC", "SFTP"}
+		if !request.IsParamEnumValue(*s.ConnectionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConnectionType", *s.ConnectionType, values))
+		}
+	}
+	if s.Description != nil && len(*s.Description) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2048))
+	}
+	if s.MatchCriteria != nil && len(s.MatchCriteria) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("MatchCriteria", 10))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.PhysicalConnectionRequirements != nil {
+		if err := s.PhysicalConnectionRequirements.ValidateExtended(); err != nil {
+			invalidParams.AddNested("PhysicalConnectionRequirements", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConnectionProperties sets the ConnectionProperties field's value.
 func (s *ConnectionInput) SetConnectionProperties(v map[string]*string) *ConnectionInput {
 	s.ConnectionProperties = v
@@ -8801,6 +9083,23 @@ func (s *CreateClassifierInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateClassifierInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateClassifierInput"}
+	if s.GrokClassifier != nil {
+		if err := s.GrokClassifier.ValidateExtended(); err != nil {
+			invalidParams.AddNested("GrokClassifier", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGrokClassifier sets the GrokClassifier field's value.
 func (s *CreateClassifierInput) SetGrokClassifier(v *CreateGrokClassifierRequest) *CreateClassifierInput {
 	s.GrokClassifier = v
@@ -8867,6 +9166,26 @@ func (s *CreateConnectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateConnectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateConnectionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.ConnectionInput != nil {
+		if err := s.ConnectionInput.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ConnectionInput", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *CreateConnectionInput) SetCatalogId(v string) *CreateConnectionInput {
 	s.CatalogId = &v
@@ -8973,6 +9292,35 @@ func (s *CreateCrawlerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateCrawlerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateCrawlerInput"}
+	if s.Description != nil && len(*s.Description) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2048))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.Role != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/.*", *s.Role) {
+		invalidParams.Add(request.NewErrParamPattern("Role", "arn:aws:iam::\\d{12}:role/.*"))
+	}
+	if s.TablePrefix != nil && len(*s.TablePrefix) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("TablePrefix", 128))
+	}
+	if s.SchemaChangePolicy != nil {
+		if err := s.SchemaChangePolicy.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SchemaChangePolicy", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClassifiers sets the Classifiers field's value.
 func (s *CreateCrawlerInput) SetClassifiers(v []*string) *CreateCrawlerInput {
 	s.Classifiers = v
@@ -9087,6 +9435,26 @@ func (s *CreateDatabaseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDatabaseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDatabaseInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseInput != nil {
+		if err := s.DatabaseInput.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DatabaseInput", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *CreateDatabaseInput) SetCatalogId(v string) *CreateDatabaseInput {
 	s.CatalogId = &v
@@ -9185,6 +9553,21 @@ func (s *CreateDevEndpointInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDevEndpointInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDevEndpointInput"}
+	if s.RoleArn != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/.*", *s.RoleArn) {
+		invalidParams.Add(request.NewErrParamPattern("RoleArn", "arn:aws:iam::\\d{12}:role/.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEndpointName sets the EndpointName field's value.
 func (s *CreateDevEndpointInput) SetEndpointName(v string) *CreateDevEndpointInput {
 	s.EndpointName = &v
@@ -9427,6 +9810,27 @@ func (s *CreateGrokClassifierRequest) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateGrokClassifierRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateGrokClassifierRequest"}
+	if s.CustomPatterns != nil && len(*s.CustomPatterns) > 16000 {
+		invalidParams.Add(request.NewErrParamMaxLen("CustomPatterns", 16000))
+	}
+	if s.GrokPattern != nil && len(*s.GrokPattern) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("GrokPattern", 2048))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClassification sets the Classification field's value.
 func (s *CreateGrokClassifierRequest) SetClassification(v string) *CreateGrokClassifierRequest {
 	s.Classification = &v
@@ -9525,6 +9929,24 @@ func (s *CreateJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateJobInput"}
+	if s.Description != nil && len(*s.Description) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2048))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllocatedCapacity sets the AllocatedCapacity field's value.
 func (s *CreateJobInput) SetAllocatedCapacity(v int64) *CreateJobInput {
 	s.AllocatedCapacity = &v
@@ -9676,6 +10098,32 @@ func (s *CreatePartitionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePartitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePartitionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.PartitionInput != nil {
+		if err := s.PartitionInput.ValidateExtended(); err != nil {
+			invalidParams.AddNested("PartitionInput", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *CreatePartitionInput) SetCatalogId(v string) *CreatePartitionInput {
 	s.CatalogId = &v
@@ -9766,15 +10214,47 @@ func (s *CreateScriptInput) Validate() error {
 	return nil
 }
 
-// SetDagEdges sets the DagEdges field's value.
-func (s *CreateScriptInput) SetDagEdges(v []*CodeGenEdge) *CreateScriptInput {
-	s.DagEdges = v
-	return s
-}
-
-// SetDagNodes sets the DagNodes field's value.
-func (s *CreateScriptInput) SetDagNodes(v []*CodeGenNode) *CreateScriptInput {
-	s.DagNodes = v
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateScriptInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateScriptInput"}
+	if s.DagEdges != nil {
+		for i, v := range s.DagEdges {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "DagEdges", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.DagNodes != nil {
+		for i, v := range s.DagNodes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "DagNodes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetDagEdges sets the DagEdges field's value.
+func (s *CreateScriptInput) SetDagEdges(v []*CodeGenEdge) *CreateScriptInput {
+	s.DagEdges = v
+	return s
+}
+
+// SetDagNodes sets the DagNodes field's value.
+func (s *CreateScriptInput) SetDagNodes(v []*CodeGenNode) *CreateScriptInput {
+	s.DagNodes = v
 	return s
 }
 
@@ -9858,6 +10338,29 @@ func (s *CreateTableInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateTableInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTableInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.TableInput != nil {
+		if err := s.TableInput.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TableInput", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *CreateTableInput) SetCatalogId(v string) *CreateTableInput {
 	s.CatalogId = &v
@@ -9967,6 +10470,45 @@ func (s *CreateTriggerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateTriggerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTriggerInput"}
+	if s.Description != nil && len(*s.Description) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2048))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.Type != nil {
+		values := []string{"SCHEDULED", "CONDITIONAL", "ON_DEMAND"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.Actions != nil {
+		for i, v := range s.Actions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Actions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Predicate != nil {
+		if err := s.Predicate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Predicate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActions sets the Actions field's value.
 func (s *CreateTriggerInput) SetActions(v []*Action) *CreateTriggerInput {
 	s.Actions = v
@@ -10083,6 +10625,29 @@ func (s *CreateUserDefinedFunctionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateUserDefinedFunctionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateUserDefinedFunctionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.FunctionInput != nil {
+		if err := s.FunctionInput.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FunctionInput", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *CreateUserDefinedFunctionInput) SetCatalogId(v string) *CreateUserDefinedFunctionInput {
 	s.CatalogId = &v
@@ -10229,6 +10794,27 @@ func (s *DatabaseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DatabaseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DatabaseInput"}
+	if s.Description != nil && len(*s.Description) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2048))
+	}
+	if s.LocationUri != nil && len(*s.LocationUri) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("LocationUri", 1024))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *DatabaseInput) SetDescription(v string) *DatabaseInput {
 	s.Description = &v
@@ -10289,6 +10875,21 @@ func (s *DeleteClassifierInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteClassifierInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteClassifierInput"}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteClassifierInput) SetName(v string) *DeleteClassifierInput {
 	s.Name = &v
@@ -10353,6 +10954,24 @@ func (s *DeleteConnectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteConnectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteConnectionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.ConnectionName != nil && len(*s.ConnectionName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("ConnectionName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *DeleteConnectionInput) SetCatalogId(v string) *DeleteConnectionInput {
 	s.CatalogId = &v
@@ -10416,6 +11035,21 @@ func (s *DeleteCrawlerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteCrawlerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteCrawlerInput"}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteCrawlerInput) SetName(v string) *DeleteCrawlerInput {
 	s.Name = &v
@@ -10480,6 +11114,24 @@ func (s *DeleteDatabaseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteDatabaseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDatabaseInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *DeleteDatabaseInput) SetCatalogId(v string) *DeleteDatabaseInput {
 	s.CatalogId = &v
@@ -10597,6 +11249,21 @@ func (s *DeleteJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteJobInput"}
+	if s.JobName != nil && len(*s.JobName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobName sets the JobName field's value.
 func (s *DeleteJobInput) SetJobName(v string) *DeleteJobInput {
 	s.JobName = &v
@@ -10689,6 +11356,27 @@ func (s *DeletePartitionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeletePartitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePartitionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *DeletePartitionInput) SetCatalogId(v string) *DeletePartitionInput {
 	s.CatalogId = &v
@@ -10782,6 +11470,27 @@ func (s *DeleteTableInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteTableInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTableInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *DeleteTableInput) SetCatalogId(v string) *DeleteTableInput {
 	s.CatalogId = &v
@@ -10851,6 +11560,21 @@ func (s *DeleteTriggerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteTriggerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTriggerInput"}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteTriggerInput) SetName(v string) *DeleteTriggerInput {
 	s.Name = &v
@@ -10935,6 +11659,27 @@ func (s *DeleteUserDefinedFunctionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteUserDefinedFunctionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteUserDefinedFunctionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.FunctionName != nil && len(*s.FunctionName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *DeleteUserDefinedFunctionInput) SetCatalogId(v string) *DeleteUserDefinedFunctionInput {
 	s.CatalogId = &v
@@ -11266,6 +12011,21 @@ func (s *GetCatalogImportStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetCatalogImportStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetCatalogImportStatusInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *GetCatalogImportStatusInput) SetCatalogId(v string) *GetCatalogImportStatusInput {
 	s.CatalogId = &v
@@ -11332,6 +12092,21 @@ func (s *GetClassifierInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetClassifierInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetClassifierInput"}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *GetClassifierInput) SetName(v string) *GetClassifierInput {
 	s.Name = &v
@@ -11396,6 +12171,21 @@ func (s *GetClassifiersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetClassifiersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetClassifiersInput"}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *GetClassifiersInput) SetMaxResults(v int64) *GetClassifiersInput {
 	s.MaxResults = &v
@@ -11484,6 +12274,24 @@ func (s *GetConnectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetConnectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetConnectionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *GetConnectionInput) SetCatalogId(v string) *GetConnectionInput {
 	s.CatalogId = &v
@@ -11543,6 +12351,27 @@ func (s GetConnectionsFilter) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetConnectionsFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetConnectionsFilter"}
+	if s.ConnectionType != nil {
+		values := []string{"JDBC", "SFTP"}
+		if !request.IsParamEnumValue(*s.ConnectionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ConnectionType", *s.ConnectionType, values))
+		}
+	}
+	if s.MatchCriteria != nil && len(s.MatchCriteria) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("MatchCriteria", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConnectionType sets the ConnectionType field's value.
 func (s *GetConnectionsFilter) SetConnectionType(v string) *GetConnectionsFilter {
 	s.ConnectionType = &v
@@ -11599,6 +12428,29 @@ func (s *GetConnectionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetConnectionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetConnectionsInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *GetConnectionsInput) SetCatalogId(v string) *GetConnectionsInput {
 	s.CatalogId = &v
@@ -11693,6 +12545,21 @@ func (s *GetCrawlerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetCrawlerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetCrawlerInput"}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *GetCrawlerInput) SetName(v string) *GetCrawlerInput {
 	s.Name = &v
@@ -11736,6 +12603,24 @@ func (s *GetCrawlerMetricsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetCrawlerMetricsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetCrawlerMetricsInput"}
+	if s.CrawlerNameList != nil && len(s.CrawlerNameList) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("CrawlerNameList", 100))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCrawlerNameList sets the CrawlerNameList field's value.
 func (s *GetCrawlerMetricsInput) SetCrawlerNameList(v []*string) *GetCrawlerMetricsInput {
 	s.CrawlerNameList = v
@@ -11846,6 +12731,21 @@ func (s *GetCrawlersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetCrawlersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetCrawlersInput"}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *GetCrawlersInput) SetMaxResults(v int64) *GetCrawlersInput {
 	s.MaxResults = &v
@@ -11935,6 +12835,24 @@ func (s *GetDatabaseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDatabaseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDatabaseInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *GetDatabaseInput) SetCatalogId(v string) *GetDatabaseInput {
 	s.CatalogId = &v
@@ -12012,6 +12930,24 @@ func (s *GetDatabasesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDatabasesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDatabasesInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *GetDatabasesInput) SetCatalogId(v string) *GetDatabasesInput {
 	s.CatalogId = &v
@@ -12220,6 +13156,21 @@ func (s *GetDevEndpointsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDevEndpointsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDevEndpointsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *GetDevEndpointsInput) SetMaxResults(v int64) *GetDevEndpointsInput {
 	s.MaxResults = &v
@@ -12301,6 +13252,21 @@ func (s *GetJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetJobInput"}
+	if s.JobName != nil && len(*s.JobName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobName sets the JobName field's value.
 func (s *GetJobInput) SetJobName(v string) *GetJobInput {
 	s.JobName = &v
@@ -12381,6 +13347,24 @@ func (s *GetJobRunInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetJobRunInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetJobRunInput"}
+	if s.JobName != nil && len(*s.JobName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobName", 255))
+	}
+	if s.RunId != nil && len(*s.RunId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("RunId", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobName sets the JobName field's value.
 func (s *GetJobRunInput) SetJobName(v string) *GetJobRunInput {
 	s.JobName = &v
@@ -12468,6 +13452,24 @@ func (s *GetJobRunsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetJobRunsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetJobRunsInput"}
+	if s.JobName != nil && len(*s.JobName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobName", 255))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobName sets the JobName field's value.
 func (s *GetJobRunsInput) SetJobName(v string) *GetJobRunsInput {
 	s.JobName = &v
@@ -12553,6 +13555,21 @@ func (s *GetJobsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetJobsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetJobsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *GetJobsInput) SetMaxResults(v int64) *GetJobsInput {
 	s.MaxResults = &v
@@ -12657,6 +13674,38 @@ func (s *GetMappingInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetMappingInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetMappingInput"}
+	if s.Location != nil {
+		if err := s.Location.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Location", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Sinks != nil {
+		for i, v := range s.Sinks {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Sinks", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Source != nil {
+		if err := s.Source.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Source", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLocation sets the Location field's value.
 func (s *GetMappingInput) SetLocation(v *Location) *GetMappingInput {
 	s.Location = v
@@ -12763,6 +13812,27 @@ func (s *GetPartitionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetPartitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPartitionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *GetPartitionInput) SetCatalogId(v string) *GetPartitionInput {
 	s.CatalogId = &v
@@ -12885,6 +13955,38 @@ func (s *GetPartitionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetPartitionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPartitionsInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.Expression != nil && len(*s.Expression) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Expression", 2048))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.Segment != nil {
+		if err := s.Segment.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Segment", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *GetPartitionsInput) SetCatalogId(v string) *GetPartitionsInput {
 	s.CatalogId = &v
@@ -13028,6 +14130,38 @@ func (s *GetPlanInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetPlanInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPlanInput"}
+	if s.Location != nil {
+		if err := s.Location.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Location", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Sinks != nil {
+		for i, v := range s.Sinks {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Sinks", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Source != nil {
+		if err := s.Source.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Source", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLocation sets the Location field's value.
 func (s *GetPlanInput) SetLocation(v *Location) *GetPlanInput {
 	s.Location = v
@@ -13130,6 +14264,27 @@ func (s *GetTableInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetTableInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTableInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *GetTableInput) SetCatalogId(v string) *GetTableInput {
 	s.CatalogId = &v
@@ -13235,6 +14390,30 @@ func (s *GetTableVersionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetTableVersionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTableVersionsInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *GetTableVersionsInput) SetCatalogId(v string) *GetTableVersionsInput {
 	s.CatalogId = &v
@@ -13355,6 +14534,30 @@ func (s *GetTablesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetTablesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTablesInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.Expression != nil && len(*s.Expression) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Expression", 2048))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *GetTablesInput) SetCatalogId(v string) *GetTablesInput {
 	s.CatalogId = &v
@@ -13454,6 +14657,21 @@ func (s *GetTriggerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetTriggerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTriggerInput"}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *GetTriggerInput) SetName(v string) *GetTriggerInput {
 	s.Name = &v
@@ -13524,6 +14742,24 @@ func (s *GetTriggersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetTriggersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTriggersInput"}
+	if s.DependentJobName != nil && len(*s.DependentJobName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DependentJobName", 255))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDependentJobName sets the DependentJobName field's value.
 func (s *GetTriggersInput) SetDependentJobName(v string) *GetTriggersInput {
 	s.DependentJobName = &v
@@ -13629,6 +14865,27 @@ func (s *GetUserDefinedFunctionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetUserDefinedFunctionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetUserDefinedFunctionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.FunctionName != nil && len(*s.FunctionName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *GetUserDefinedFunctionInput) SetCatalogId(v string) *GetUserDefinedFunctionInput {
 	s.CatalogId = &v
@@ -13735,6 +14992,30 @@ func (s *GetUserDefinedFunctionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetUserDefinedFunctionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetUserDefinedFunctionsInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+	if s.Pattern != nil && len(*s.Pattern) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Pattern", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *GetUserDefinedFunctionsInput) SetCatalogId(v string) *GetUserDefinedFunctionsInput {
 	s.CatalogId = &v
@@ -13917,6 +15198,21 @@ func (s *ImportCatalogToGlueInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ImportCatalogToGlueInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ImportCatalogToGlueInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *ImportCatalogToGlueInput) SetCatalogId(v string) *ImportCatalogToGlueInput {
 	s.CatalogId = &v
@@ -14378,11 +15674,26 @@ func (s JobUpdate) GoString() string {
 	return s.String()
 }
 
-// SetAllocatedCapacity sets the AllocatedCapacity field's value.
-func (s *JobUpdate) SetAllocatedCapacity(v int64) *JobUpdate {
-	s.AllocatedCapacity = &v
-	return s
-}
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *JobUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "JobUpdate"}
+	if s.Description != nil && len(*s.Description) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAllocatedCapacity sets the AllocatedCapacity field's value.
+func (s *JobUpdate) SetAllocatedCapacity(v int64) *JobUpdate {
+	s.AllocatedCapacity = &v
+	return s
+}
 
 // SetCommand sets the Command field's value.
 func (s *JobUpdate) SetCommand(v *JobCommand) *JobUpdate {
@@ -14554,6 +15865,24 @@ func (s *Location) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Location) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Location"}
+	if s.Jdbc != nil && len(s.Jdbc) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Jdbc", 50))
+	}
+	if s.S3 != nil && len(s.S3) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJdbc sets the Jdbc field's value.
 func (s *Location) SetJdbc(v []*CodeGenNodeArg) *Location {
 	s.Jdbc = v
@@ -14682,6 +16011,24 @@ func (s *Order) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Order) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Order"}
+	if s.Column != nil && len(*s.Column) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Column", 255))
+	}
+	if s.SortOrder != nil && *s.SortOrder > 1 {
+		invalidParams.Add(request.NewErrParamMaxValue("SortOrder", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetColumn sets the Column field's value.
 func (s *Order) SetColumn(v string) *Order {
 	s.Column = &v
@@ -14862,6 +16209,23 @@ func (s *PartitionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PartitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PartitionInput"}
+	if s.StorageDescriptor != nil {
+		if err := s.StorageDescriptor.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StorageDescriptor", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLastAccessTime sets the LastAccessTime field's value.
 func (s *PartitionInput) SetLastAccessTime(v time.Time) *PartitionInput {
 	s.LastAccessTime = &v
@@ -14970,6 +16334,27 @@ func (s *PhysicalConnectionRequirements) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PhysicalConnectionRequirements) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PhysicalConnectionRequirements"}
+	if s.AvailabilityZone != nil && len(*s.AvailabilityZone) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("AvailabilityZone", 255))
+	}
+	if s.SecurityGroupIdList != nil && len(s.SecurityGroupIdList) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("SecurityGroupIdList", 50))
+	}
+	if s.SubnetId != nil && len(*s.SubnetId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("SubnetId", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAvailabilityZone sets the AvailabilityZone field's value.
 func (s *PhysicalConnectionRequirements) SetAvailabilityZone(v string) *PhysicalConnectionRequirements {
 	s.AvailabilityZone = &v
@@ -15064,6 +16449,34 @@ func (s *Predicate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Predicate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Predicate"}
+	if s.Logical != nil {
+		values := []string{"AND"}
+		if !request.IsParamEnumValue(*s.Logical, values) {
+			invalidParams.Add(request.NewErrParamEnum("Logical", *s.Logical, values))
+		}
+	}
+	if s.Conditions != nil {
+		for i, v := range s.Conditions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Conditions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConditions sets the Conditions field's value.
 func (s *Predicate) SetConditions(v []*Condition) *Predicate {
 	s.Conditions = v
@@ -15174,6 +16587,27 @@ func (s *ResourceUri) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResourceUri) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResourceUri"}
+	if s.ResourceType != nil {
+		values := []string{"JAR", "FILE", "ARCHIVE"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+	if s.Uri != nil && len(*s.Uri) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Uri", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceType sets the ResourceType field's value.
 func (s *ResourceUri) SetResourceType(v string) *ResourceUri {
 	s.ResourceType = &v
@@ -15279,6 +16713,30 @@ func (s SchemaChangePolicy) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SchemaChangePolicy) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SchemaChangePolicy"}
+	if s.DeleteBehavior != nil {
+		values := []string{"LOG", "DELETE_FROM_DATABASE", "DEPRECATE_IN_DATABASE"}
+		if !request.IsParamEnumValue(*s.DeleteBehavior, values) {
+			invalidParams.Add(request.NewErrParamEnum("DeleteBehavior", *s.DeleteBehavior, values))
+		}
+	}
+	if s.UpdateBehavior != nil {
+		values := []string{"LOG", "UPDATE_IN_DATABASE"}
+		if !request.IsParamEnumValue(*s.UpdateBehavior, values) {
+			invalidParams.Add(request.NewErrParamEnum("UpdateBehavior", *s.UpdateBehavior, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeleteBehavior sets the DeleteBehavior field's value.
 func (s *SchemaChangePolicy) SetDeleteBehavior(v string) *SchemaChangePolicy {
 	s.DeleteBehavior = &v
@@ -15339,6 +16797,21 @@ func (s *Segment) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Segment) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Segment"}
+	if s.TotalSegments != nil && *s.TotalSegments > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("TotalSegments", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSegmentNumber sets the SegmentNumber field's value.
 func (s *Segment) SetSegmentNumber(v int64) *Segment {
 	s.SegmentNumber = &v
@@ -15393,6 +16866,24 @@ func (s *SerDeInfo) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SerDeInfo) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SerDeInfo"}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.SerializationLibrary != nil && len(*s.SerializationLibrary) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("SerializationLibrary", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *SerDeInfo) SetName(v string) *SerDeInfo {
 	s.Name = &v
@@ -15491,6 +16982,21 @@ func (s *StartCrawlerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartCrawlerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartCrawlerInput"}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *StartCrawlerInput) SetName(v string) *StartCrawlerInput {
 	s.Name = &v
@@ -15548,6 +17054,21 @@ func (s *StartCrawlerScheduleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartCrawlerScheduleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartCrawlerScheduleInput"}
+	if s.CrawlerName != nil && len(*s.CrawlerName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CrawlerName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCrawlerName sets the CrawlerName field's value.
 func (s *StartCrawlerScheduleInput) SetCrawlerName(v string) *StartCrawlerScheduleInput {
 	s.CrawlerName = &v
@@ -15617,6 +17138,24 @@ func (s *StartJobRunInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartJobRunInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartJobRunInput"}
+	if s.JobName != nil && len(*s.JobName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobName", 255))
+	}
+	if s.JobRunId != nil && len(*s.JobRunId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobRunId", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllocatedCapacity sets the AllocatedCapacity field's value.
 func (s *StartJobRunInput) SetAllocatedCapacity(v int64) *StartJobRunInput {
 	s.AllocatedCapacity = &v
@@ -15701,6 +17240,21 @@ func (s *StartTriggerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartTriggerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartTriggerInput"}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *StartTriggerInput) SetName(v string) *StartTriggerInput {
 	s.Name = &v
@@ -15767,6 +17321,21 @@ func (s *StopCrawlerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopCrawlerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopCrawlerInput"}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *StopCrawlerInput) SetName(v string) *StopCrawlerInput {
 	s.Name = &v
@@ -15824,6 +17393,21 @@ func (s *StopCrawlerScheduleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopCrawlerScheduleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopCrawlerScheduleInput"}
+	if s.CrawlerName != nil && len(*s.CrawlerName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CrawlerName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCrawlerName sets the CrawlerName field's value.
 func (s *StopCrawlerScheduleInput) SetCrawlerName(v string) *StopCrawlerScheduleInput {
 	s.CrawlerName = &v
@@ -15881,6 +17465,21 @@ func (s *StopTriggerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopTriggerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopTriggerInput"}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *StopTriggerInput) SetName(v string) *StopTriggerInput {
 	s.Name = &v
@@ -16004,6 +17603,52 @@ func (s *StorageDescriptor) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StorageDescriptor) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StorageDescriptor"}
+	if s.InputFormat != nil && len(*s.InputFormat) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("InputFormat", 128))
+	}
+	if s.Location != nil && len(*s.Location) > 2056 {
+		invalidParams.Add(request.NewErrParamMaxLen("Location", 2056))
+	}
+	if s.OutputFormat != nil && len(*s.OutputFormat) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("OutputFormat", 128))
+	}
+	if s.Columns != nil {
+		for i, v := range s.Columns {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Columns", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.SerdeInfo != nil {
+		if err := s.SerdeInfo.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SerdeInfo", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.SortColumns != nil {
+		for i, v := range s.SortColumns {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "SortColumns", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucketColumns sets the BucketColumns field's value.
 func (s *StorageDescriptor) SetBucketColumns(v []*string) *StorageDescriptor {
 	s.BucketColumns = v
@@ -16365,6 +18010,51 @@ func (s *TableInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TableInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TableInput"}
+	if s.Description != nil && len(*s.Description) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2048))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.Owner != nil && len(*s.Owner) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Owner", 255))
+	}
+	if s.TableType != nil && len(*s.TableType) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableType", 255))
+	}
+	if s.ViewExpandedText != nil && len(*s.ViewExpandedText) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ViewExpandedText", 2048))
+	}
+	if s.ViewOriginalText != nil && len(*s.ViewOriginalText) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ViewOriginalText", 2048))
+	}
+	if s.PartitionKeys != nil {
+		for i, v := range s.PartitionKeys {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PartitionKeys", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.StorageDescriptor != nil {
+		if err := s.StorageDescriptor.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StorageDescriptor", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *TableInput) SetDescription(v string) *TableInput {
 	s.Description = &v
@@ -16616,6 +18306,39 @@ func (s *TriggerUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TriggerUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TriggerUpdate"}
+	if s.Description != nil && len(*s.Description) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2048))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.Actions != nil {
+		for i, v := range s.Actions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Actions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Predicate != nil {
+		if err := s.Predicate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Predicate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActions sets the Actions field's value.
 func (s *TriggerUpdate) SetActions(v []*Action) *TriggerUpdate {
 	s.Actions = v
@@ -16679,6 +18402,23 @@ func (s *UpdateClassifierInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateClassifierInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateClassifierInput"}
+	if s.GrokClassifier != nil {
+		if err := s.GrokClassifier.ValidateExtended(); err != nil {
+			invalidParams.AddNested("GrokClassifier", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGrokClassifier sets the GrokClassifier field's value.
 func (s *UpdateClassifierInput) SetGrokClassifier(v *UpdateGrokClassifierRequest) *UpdateClassifierInput {
 	s.GrokClassifier = v
@@ -16756,6 +18496,29 @@ func (s *UpdateConnectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateConnectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateConnectionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.ConnectionInput != nil {
+		if err := s.ConnectionInput.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ConnectionInput", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *UpdateConnectionInput) SetCatalogId(v string) *UpdateConnectionInput {
 	s.CatalogId = &v
@@ -16853,6 +18616,35 @@ func (s *UpdateCrawlerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateCrawlerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateCrawlerInput"}
+	if s.Description != nil && len(*s.Description) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2048))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.Role != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/.*", *s.Role) {
+		invalidParams.Add(request.NewErrParamPattern("Role", "arn:aws:iam::\\d{12}:role/.*"))
+	}
+	if s.TablePrefix != nil && len(*s.TablePrefix) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("TablePrefix", 128))
+	}
+	if s.SchemaChangePolicy != nil {
+		if err := s.SchemaChangePolicy.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SchemaChangePolicy", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClassifiers sets the Classifiers field's value.
 func (s *UpdateCrawlerInput) SetClassifiers(v []*string) *UpdateCrawlerInput {
 	s.Classifiers = v
@@ -16961,6 +18753,21 @@ func (s *UpdateCrawlerScheduleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateCrawlerScheduleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateCrawlerScheduleInput"}
+	if s.CrawlerName != nil && len(*s.CrawlerName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CrawlerName", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCrawlerName sets the CrawlerName field's value.
 func (s *UpdateCrawlerScheduleInput) SetCrawlerName(v string) *UpdateCrawlerScheduleInput {
 	s.CrawlerName = &v
@@ -17045,6 +18852,29 @@ func (s *UpdateDatabaseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDatabaseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDatabaseInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.DatabaseInput != nil {
+		if err := s.DatabaseInput.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DatabaseInput", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *UpdateDatabaseInput) SetCatalogId(v string) *UpdateDatabaseInput {
 	s.CatalogId = &v
@@ -17200,6 +19030,27 @@ func (s *UpdateGrokClassifierRequest) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateGrokClassifierRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateGrokClassifierRequest"}
+	if s.CustomPatterns != nil && len(*s.CustomPatterns) > 16000 {
+		invalidParams.Add(request.NewErrParamMaxLen("CustomPatterns", 16000))
+	}
+	if s.GrokPattern != nil && len(*s.GrokPattern) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("GrokPattern", 2048))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClassification sets the Classification field's value.
 func (s *UpdateGrokClassifierRequest) SetClassification(v string) *UpdateGrokClassifierRequest {
 	s.Classification = &v
@@ -17268,6 +19119,26 @@ func (s *UpdateJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateJobInput"}
+	if s.JobName != nil && len(*s.JobName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobName", 255))
+	}
+	if s.JobUpdate != nil {
+		if err := s.JobUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("JobUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobName sets the JobName field's value.
 func (s *UpdateJobInput) SetJobName(v string) *UpdateJobInput {
 	s.JobName = &v
@@ -17379,6 +19250,35 @@ func (s *UpdatePartitionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdatePartitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdatePartitionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.PartitionValueList != nil && len(s.PartitionValueList) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PartitionValueList", 100))
+	}
+	if s.TableName != nil && len(*s.TableName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 255))
+	}
+	if s.PartitionInput != nil {
+		if err := s.PartitionInput.ValidateExtended(); err != nil {
+			invalidParams.AddNested("PartitionInput", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *UpdatePartitionInput) SetCatalogId(v string) *UpdatePartitionInput {
 	s.CatalogId = &v
@@ -17480,6 +19380,29 @@ func (s *UpdateTableInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateTableInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateTableInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.TableInput != nil {
+		if err := s.TableInput.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TableInput", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *UpdateTableInput) SetCatalogId(v string) *UpdateTableInput {
 	s.CatalogId = &v
@@ -17562,6 +19485,26 @@ func (s *UpdateTriggerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateTriggerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateTriggerInput"}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.TriggerUpdate != nil {
+		if err := s.TriggerUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TriggerUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *UpdateTriggerInput) SetName(v string) *UpdateTriggerInput {
 	s.Name = &v
@@ -17665,6 +19608,32 @@ func (s *UpdateUserDefinedFunctionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateUserDefinedFunctionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateUserDefinedFunctionInput"}
+	if s.CatalogId != nil && len(*s.CatalogId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CatalogId", 255))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 255))
+	}
+	if s.FunctionName != nil && len(*s.FunctionName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 255))
+	}
+	if s.FunctionInput != nil {
+		if err := s.FunctionInput.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FunctionInput", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCatalogId sets the CatalogId field's value.
 func (s *UpdateUserDefinedFunctionInput) SetCatalogId(v string) *UpdateUserDefinedFunctionInput {
 	s.CatalogId = &v
@@ -17834,6 +19803,46 @@ func (s *UserDefinedFunctionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UserDefinedFunctionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UserDefinedFunctionInput"}
+	if s.ClassName != nil && len(*s.ClassName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClassName", 255))
+	}
+	if s.FunctionName != nil && len(*s.FunctionName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 255))
+	}
+	if s.OwnerName != nil && len(*s.OwnerName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("OwnerName", 255))
+	}
+	if s.OwnerType != nil {
+		values := []string{"USER", "ROLE", "GROUP"}
+		if !request.IsParamEnumValue(*s.OwnerType, values) {
+			invalidParams.Add(request.NewErrParamEnum("OwnerType", *s.OwnerType, values))
+		}
+	}
+	if s.ResourceUris != nil && len(s.ResourceUris) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceUris", 1000))
+	}
+	if s.ResourceUris != nil {
+		for i, v := range s.ResourceUris {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ResourceUris", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClassName sets the ClassName field's value.
 func (s *UserDefinedFunctionInput) SetClassName(v string) *UserDefinedFunctionInput {
 	s.ClassName = &v
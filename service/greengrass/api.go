@@ -3,6 +3,8 @@
 package greengrass
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -5916,6 +5918,24 @@ func (s *CreateDeploymentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDeploymentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDeploymentInput"}
+	if s.DeploymentType != nil {
+		values := []string{"NewDeployment", "Redeployment", "ResetDeployment", "ForceResetDeployment"}
+		if !request.IsParamEnumValue(*s.DeploymentType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DeploymentType", *s.DeploymentType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAmznClientToken sets the AmznClientToken field's value.
 func (s *CreateDeploymentInput) SetAmznClientToken(v string) *CreateDeploymentInput {
 	s.AmznClientToken = &v
@@ -7156,6 +7176,23 @@ func (s CreateLoggerDefinitionInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateLoggerDefinitionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateLoggerDefinitionInput"}
+	if s.InitialVersion != nil {
+		if err := s.InitialVersion.ValidateExtended(); err != nil {
+			invalidParams.AddNested("InitialVersion", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAmznClientToken sets the AmznClientToken field's value.
 func (s *CreateLoggerDefinitionInput) SetAmznClientToken(v string) *CreateLoggerDefinitionInput {
 	s.AmznClientToken = &v
@@ -7342,6 +7379,28 @@ func (s *CreateLoggerDefinitionVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateLoggerDefinitionVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateLoggerDefinitionVersionInput"}
+	if s.Loggers != nil {
+		for i, v := range s.Loggers {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Loggers", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAmznClientToken sets the AmznClientToken field's value.
 func (s *CreateLoggerDefinitionVersionInput) SetAmznClientToken(v string) *CreateLoggerDefinitionVersionInput {
 	s.AmznClientToken = &v
@@ -13355,6 +13414,36 @@ func (s Logger) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Logger) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Logger"}
+	if s.Component != nil {
+		values := []string{"GreengrassSystem", "Lambda"}
+		if !request.IsParamEnumValue(*s.Component, values) {
+			invalidParams.Add(request.NewErrParamEnum("Component", *s.Component, values))
+		}
+	}
+	if s.Level != nil {
+		values := []string{"DEBUG", "INFO", "WARN", "ERROR", "FATAL"}
+		if !request.IsParamEnumValue(*s.Level, values) {
+			invalidParams.Add(request.NewErrParamEnum("Level", *s.Level, values))
+		}
+	}
+	if s.Type != nil {
+		values := []string{"FileSystem", "AWSCloudWatch"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComponent sets the Component field's value.
 func (s *Logger) SetComponent(v string) *Logger {
 	s.Component = &v
@@ -13443,6 +13532,28 @@ func (s LoggerDefinitionVersion) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LoggerDefinitionVersion) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LoggerDefinitionVersion"}
+	if s.Loggers != nil {
+		for i, v := range s.Loggers {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Loggers", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLoggers sets the Loggers field's value.
 func (s *LoggerDefinitionVersion) SetLoggers(v []*Logger) *LoggerDefinitionVersion {
 	s.Loggers = v
@@ -918,6 +918,32 @@ func (s *DescribeAffectedEntitiesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAffectedEntitiesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAffectedEntitiesInput"}
+	if s.Locale != nil && len(*s.Locale) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Locale", 256))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("[a-zA-Z0-9=/+_.-]{4,512}", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[a-zA-Z0-9=/+_.-]{4,512}"))
+	}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilter sets the Filter field's value.
 func (s *DescribeAffectedEntitiesInput) SetFilter(v *EntityFilter) *DescribeAffectedEntitiesInput {
 	s.Filter = v
@@ -1011,6 +1037,21 @@ func (s *DescribeEntityAggregatesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEntityAggregatesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEntityAggregatesInput"}
+	if s.EventArns != nil && len(s.EventArns) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("EventArns", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEventArns sets the EventArns field's value.
 func (s *DescribeEntityAggregatesInput) SetEventArns(v []*string) *DescribeEntityAggregatesInput {
 	s.EventArns = v
@@ -1095,6 +1136,35 @@ func (s *DescribeEventAggregatesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEventAggregatesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEventAggregatesInput"}
+	if s.AggregateField != nil {
+		values := []string{"eventTypeCategory"}
+		if !request.IsParamEnumValue(*s.AggregateField, values) {
+			invalidParams.Add(request.NewErrParamEnum("AggregateField", *s.AggregateField, values))
+		}
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("[a-zA-Z0-9=/+_.-]{4,512}", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[a-zA-Z0-9=/+_.-]{4,512}"))
+	}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAggregateField sets the AggregateField field's value.
 func (s *DescribeEventAggregatesInput) SetAggregateField(v string) *DescribeEventAggregatesInput {
 	s.AggregateField = &v
@@ -1200,6 +1270,24 @@ func (s *DescribeEventDetailsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEventDetailsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEventDetailsInput"}
+	if s.EventArns != nil && len(s.EventArns) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("EventArns", 10))
+	}
+	if s.Locale != nil && len(*s.Locale) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Locale", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEventArns sets the EventArns field's value.
 func (s *DescribeEventDetailsInput) SetEventArns(v []*string) *DescribeEventDetailsInput {
 	s.EventArns = v
@@ -1298,6 +1386,32 @@ func (s *DescribeEventTypesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEventTypesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEventTypesInput"}
+	if s.Locale != nil && len(*s.Locale) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Locale", 256))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("[a-zA-Z0-9=/+_.-]{4,512}", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[a-zA-Z0-9=/+_.-]{4,512}"))
+	}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilter sets the Filter field's value.
 func (s *DescribeEventTypesInput) SetFilter(v *EventTypeFilter) *DescribeEventTypesInput {
 	s.Filter = v
@@ -1415,6 +1529,32 @@ func (s *DescribeEventsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEventsInput"}
+	if s.Locale != nil && len(*s.Locale) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Locale", 256))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && !request.MatchParamPattern("[a-zA-Z0-9=/+_.-]{4,512}", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "[a-zA-Z0-9=/+_.-]{4,512}"))
+	}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilter sets the Filter field's value.
 func (s *DescribeEventsInput) SetFilter(v *EventFilter) *DescribeEventsInput {
 	s.Filter = v
@@ -1577,6 +1717,36 @@ func (s *EntityFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EntityFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EntityFilter"}
+	if s.EntityArns != nil && len(s.EntityArns) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("EntityArns", 100))
+	}
+	if s.EntityValues != nil && len(s.EntityValues) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("EntityValues", 100))
+	}
+	if s.EventArns != nil && len(s.EventArns) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("EventArns", 10))
+	}
+	if s.LastUpdatedTimes != nil && len(s.LastUpdatedTimes) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("LastUpdatedTimes", 10))
+	}
+	if s.StatusCodes != nil && len(s.StatusCodes) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("StatusCodes", 3))
+	}
+	if s.Tags != nil && len(s.Tags) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEntityArns sets the EntityArns field's value.
 func (s *EntityFilter) SetEntityArns(v []*string) *EntityFilter {
 	s.EntityArns = v
@@ -1976,6 +2146,54 @@ func (s *EventFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EventFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EventFilter"}
+	if s.EndTimes != nil && len(s.EndTimes) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("EndTimes", 10))
+	}
+	if s.EntityArns != nil && len(s.EntityArns) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("EntityArns", 100))
+	}
+	if s.EntityValues != nil && len(s.EntityValues) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("EntityValues", 100))
+	}
+	if s.EventArns != nil && len(s.EventArns) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("EventArns", 10))
+	}
+	if s.EventStatusCodes != nil && len(s.EventStatusCodes) > 6 {
+		invalidParams.Add(request.NewErrParamMaxLen("EventStatusCodes", 6))
+	}
+	if s.EventTypeCategories != nil && len(s.EventTypeCategories) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("EventTypeCategories", 10))
+	}
+	if s.EventTypeCodes != nil && len(s.EventTypeCodes) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("EventTypeCodes", 10))
+	}
+	if s.LastUpdatedTimes != nil && len(s.LastUpdatedTimes) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("LastUpdatedTimes", 10))
+	}
+	if s.Regions != nil && len(s.Regions) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Regions", 10))
+	}
+	if s.Services != nil && len(s.Services) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Services", 10))
+	}
+	if s.StartTimes != nil && len(s.StartTimes) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("StartTimes", 10))
+	}
+	if s.Tags != nil && len(s.Tags) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAvailabilityZones sets the AvailabilityZones field's value.
 func (s *EventFilter) SetAvailabilityZones(v []*string) *EventFilter {
 	s.AvailabilityZones = v
@@ -2144,6 +2362,27 @@ func (s *EventTypeFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EventTypeFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EventTypeFilter"}
+	if s.EventTypeCategories != nil && len(s.EventTypeCategories) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("EventTypeCategories", 10))
+	}
+	if s.EventTypeCodes != nil && len(s.EventTypeCodes) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("EventTypeCodes", 10))
+	}
+	if s.Services != nil && len(s.Services) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Services", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEventTypeCategories sets the EventTypeCategories field's value.
 func (s *EventTypeFilter) SetEventTypeCategories(v []*string) *EventTypeFilter {
 	s.EventTypeCategories = v
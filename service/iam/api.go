@@ -771,9 +771,10 @@ const opCreateAccessKey = "CreateAccessKey"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/iam-2010-05-08/CreateAccessKey
 func (c *IAM) CreateAccessKeyRequest(input *CreateAccessKeyInput) (req *request.Request, output *CreateAccessKeyOutput) {
 	op := &request.Operation{
-		Name:       opCreateAccessKey,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opCreateAccessKey,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"*/CreateAccessKeyResult/AccessKey/SecretAccessKey"},
 	}
 
 	if input == nil {
@@ -1893,9 +1894,10 @@ const opCreateServiceSpecificCredential = "CreateServiceSpecificCredential"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/iam-2010-05-08/CreateServiceSpecificCredential
 func (c *IAM) CreateServiceSpecificCredentialRequest(input *CreateServiceSpecificCredentialInput) (req *request.Request, output *CreateServiceSpecificCredentialOutput) {
 	op := &request.Operation{
-		Name:       opCreateServiceSpecificCredential,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opCreateServiceSpecificCredential,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"*/CreateServiceSpecificCredentialResult/ServiceSpecificCredential/ServicePassword"},
 	}
 
 	if input == nil {
@@ -2089,9 +2091,10 @@ const opCreateVirtualMFADevice = "CreateVirtualMFADevice"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/iam-2010-05-08/CreateVirtualMFADevice
 func (c *IAM) CreateVirtualMFADeviceRequest(input *CreateVirtualMFADeviceInput) (req *request.Request, output *CreateVirtualMFADeviceOutput) {
 	op := &request.Operation{
-		Name:       opCreateVirtualMFADevice,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opCreateVirtualMFADevice,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"*/CreateVirtualMFADeviceResult/VirtualMFADevice/Base32StringSeed", "*/CreateVirtualMFADeviceResult/VirtualMFADevice/QRCodePNG"},
 	}
 
 	if input == nil {
@@ -10341,6 +10344,7 @@ func (c *IAM) ListVirtualMFADevicesRequest(input *ListVirtualMFADevicesInput) (r
 			LimitToken:      "MaxItems",
 			TruncationToken: "IsTruncated",
 		},
+		SensitiveOutputPaths: []string{"*/ListVirtualMFADevicesResult/VirtualMFADevices/*/Base32StringSeed", "*/ListVirtualMFADevicesResult/VirtualMFADevices/*/QRCodePNG"},
 	}
 
 	if input == nil {
@@ -11099,9 +11103,10 @@ const opResetServiceSpecificCredential = "ResetServiceSpecificCredential"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/iam-2010-05-08/ResetServiceSpecificCredential
 func (c *IAM) ResetServiceSpecificCredentialRequest(input *ResetServiceSpecificCredentialInput) (req *request.Request, output *ResetServiceSpecificCredentialOutput) {
 	op := &request.Operation{
-		Name:       opResetServiceSpecificCredential,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opResetServiceSpecificCredential,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"*/ResetServiceSpecificCredentialResult/ServiceSpecificCredential/ServicePassword"},
 	}
 
 	if input == nil {
@@ -13346,7 +13351,7 @@ type AccessKey struct {
 	// The secret key used to sign requests.
 	//
 	// SecretAccessKey is a required field
-	SecretAccessKey *string `type:"string" required:"true"`
+	SecretAccessKey *string `type:"string" required:"true" sensitive:"true"`
 
 	// The status of the access key. Active means the key is valid for API calls,
 	// while Inactive means it is not.
@@ -13584,6 +13589,24 @@ func (s *AddClientIDToOpenIDConnectProviderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddClientIDToOpenIDConnectProviderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddClientIDToOpenIDConnectProviderInput"}
+	if s.ClientID != nil && len(*s.ClientID) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientID", 255))
+	}
+	if s.OpenIDConnectProviderArn != nil && len(*s.OpenIDConnectProviderArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("OpenIDConnectProviderArn", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientID sets the ClientID field's value.
 func (s *AddClientIDToOpenIDConnectProviderInput) SetClientID(v string) *AddClientIDToOpenIDConnectProviderInput {
 	s.ClientID = &v
@@ -13666,6 +13689,30 @@ func (s *AddRoleToInstanceProfileInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddRoleToInstanceProfileInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddRoleToInstanceProfileInput"}
+	if s.InstanceProfileName != nil && len(*s.InstanceProfileName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceProfileName", 128))
+	}
+	if s.InstanceProfileName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.InstanceProfileName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceProfileName", "[\\w+=,.@-]+"))
+	}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceProfileName sets the InstanceProfileName field's value.
 func (s *AddRoleToInstanceProfileInput) SetInstanceProfileName(v string) *AddRoleToInstanceProfileInput {
 	s.InstanceProfileName = &v
@@ -13748,6 +13795,30 @@ func (s *AddUserToGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddUserToGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddUserToGroupInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\w+=,.@-]+"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *AddUserToGroupInput) SetGroupName(v string) *AddUserToGroupInput {
 	s.GroupName = &v
@@ -13830,6 +13901,27 @@ func (s *AttachGroupPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttachGroupPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttachGroupPolicyInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\w+=,.@-]+"))
+	}
+	if s.PolicyArn != nil && len(*s.PolicyArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyArn", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *AttachGroupPolicyInput) SetGroupName(v string) *AttachGroupPolicyInput {
 	s.GroupName = &v
@@ -13912,6 +14004,27 @@ func (s *AttachRolePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttachRolePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttachRolePolicyInput"}
+	if s.PolicyArn != nil && len(*s.PolicyArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyArn", 2048))
+	}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyArn sets the PolicyArn field's value.
 func (s *AttachRolePolicyInput) SetPolicyArn(v string) *AttachRolePolicyInput {
 	s.PolicyArn = &v
@@ -13994,6 +14107,27 @@ func (s *AttachUserPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttachUserPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttachUserPolicyInput"}
+	if s.PolicyArn != nil && len(*s.PolicyArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyArn", 2048))
+	}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyArn sets the PolicyArn field's value.
 func (s *AttachUserPolicyInput) SetPolicyArn(v string) *AttachUserPolicyInput {
 	s.PolicyArn = &v
@@ -14085,12 +14219,12 @@ type ChangePasswordInput struct {
 	// have special meaning within that tool.
 	//
 	// NewPassword is a required field
-	NewPassword *string `min:"1" type:"string" required:"true"`
+	NewPassword *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The IAM user's current password.
 	//
 	// OldPassword is a required field
-	OldPassword *string `min:"1" type:"string" required:"true"`
+	OldPassword *string `min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -14125,6 +14259,24 @@ func (s *ChangePasswordInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ChangePasswordInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ChangePasswordInput"}
+	if s.NewPassword != nil && len(*s.NewPassword) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("NewPassword", 128))
+	}
+	if s.OldPassword != nil && len(*s.OldPassword) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("OldPassword", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNewPassword sets the NewPassword field's value.
 func (s *ChangePasswordInput) SetNewPassword(v string) *ChangePasswordInput {
 	s.NewPassword = &v
@@ -14200,6 +14352,27 @@ func (s *ContextEntry) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ContextEntry) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ContextEntry"}
+	if s.ContextKeyName != nil && len(*s.ContextKeyName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ContextKeyName", 256))
+	}
+	if s.ContextKeyType != nil {
+		values := []string{"string", "stringList", "numeric", "numericList", "boolean", "booleanList", "ip", "ipList", "binary", "binaryList", "date", "dateList"}
+		if !request.IsParamEnumValue(*s.ContextKeyType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ContextKeyType", *s.ContextKeyType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContextKeyName sets the ContextKeyName field's value.
 func (s *ContextEntry) SetContextKeyName(v string) *ContextEntry {
 	s.ContextKeyName = &v
@@ -14253,6 +14426,24 @@ func (s *CreateAccessKeyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAccessKeyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAccessKeyInput"}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserName sets the UserName field's value.
 func (s *CreateAccessKeyInput) SetUserName(v string) *CreateAccessKeyInput {
 	s.UserName = &v
@@ -14327,6 +14518,21 @@ func (s *CreateAccountAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAccountAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAccountAliasInput"}
+	if s.AccountAlias != nil && len(*s.AccountAlias) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountAlias", 63))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountAlias sets the AccountAlias field's value.
 func (s *CreateAccountAliasInput) SetAccountAlias(v string) *CreateAccountAliasInput {
 	s.AccountAlias = &v
@@ -14407,6 +14613,27 @@ func (s *CreateGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateGroupInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\w+=,.@-]+"))
+	}
+	if s.Path != nil && len(*s.Path) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("Path", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *CreateGroupInput) SetGroupName(v string) *CreateGroupInput {
 	s.GroupName = &v
@@ -14503,6 +14730,27 @@ func (s *CreateInstanceProfileInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateInstanceProfileInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateInstanceProfileInput"}
+	if s.InstanceProfileName != nil && len(*s.InstanceProfileName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceProfileName", 128))
+	}
+	if s.InstanceProfileName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.InstanceProfileName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceProfileName", "[\\w+=,.@-]+"))
+	}
+	if s.Path != nil && len(*s.Path) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("Path", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceProfileName sets the InstanceProfileName field's value.
 func (s *CreateInstanceProfileInput) SetInstanceProfileName(v string) *CreateInstanceProfileInput {
 	s.InstanceProfileName = &v
@@ -14558,7 +14806,7 @@ type CreateLoginProfileInput struct {
 	// have special meaning within that tool.
 	//
 	// Password is a required field
-	Password *string `min:"1" type:"string" required:"true"`
+	Password *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// Specifies whether the user is required to set a new password on next sign-in.
 	PasswordResetRequired *bool `type:"boolean"`
@@ -14606,6 +14854,27 @@ func (s *CreateLoginProfileInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateLoginProfileInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateLoginProfileInput"}
+	if s.Password != nil && len(*s.Password) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Password", 128))
+	}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPassword sets the Password field's value.
 func (s *CreateLoginProfileInput) SetPassword(v string) *CreateLoginProfileInput {
 	s.Password = &v
@@ -14734,6 +15003,21 @@ func (s *CreateOpenIDConnectProviderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateOpenIDConnectProviderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateOpenIDConnectProviderInput"}
+	if s.Url != nil && len(*s.Url) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Url", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientIDList sets the ClientIDList field's value.
 func (s *CreateOpenIDConnectProviderInput) SetClientIDList(v []*string) *CreateOpenIDConnectProviderInput {
 	s.ClientIDList = v
@@ -14861,6 +15145,33 @@ func (s *CreatePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePolicyInput"}
+	if s.Description != nil && len(*s.Description) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1000))
+	}
+	if s.Path != nil && !request.MatchParamPattern("((/[A-Za-z0-9\\.,\\+@=_-]+)*)/", *s.Path) {
+		invalidParams.Add(request.NewErrParamPattern("Path", "((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"))
+	}
+	if s.PolicyDocument != nil && len(*s.PolicyDocument) > 131072 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyDocument", 131072))
+	}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *CreatePolicyInput) SetDescription(v string) *CreatePolicyInput {
 	s.Description = &v
@@ -14981,6 +15292,24 @@ func (s *CreatePolicyVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePolicyVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePolicyVersionInput"}
+	if s.PolicyArn != nil && len(*s.PolicyArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyArn", 2048))
+	}
+	if s.PolicyDocument != nil && len(*s.PolicyDocument) > 131072 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyDocument", 131072))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyArn sets the PolicyArn field's value.
 func (s *CreatePolicyVersionInput) SetPolicyArn(v string) *CreatePolicyVersionInput {
 	s.PolicyArn = &v
@@ -15106,6 +15435,36 @@ func (s *CreateRoleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateRoleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateRoleInput"}
+	if s.AssumeRolePolicyDocument != nil && len(*s.AssumeRolePolicyDocument) > 131072 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssumeRolePolicyDocument", 131072))
+	}
+	if s.Description != nil && len(*s.Description) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1000))
+	}
+	if s.Description != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{Z}\\p{S}\\p{N}\\p{P}]*", *s.Description) {
+		invalidParams.Add(request.NewErrParamPattern("Description", "[\\p{L}\\p{M}\\p{Z}\\p{S}\\p{N}\\p{P}]*"))
+	}
+	if s.Path != nil && len(*s.Path) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("Path", 512))
+	}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssumeRolePolicyDocument sets the AssumeRolePolicyDocument field's value.
 func (s *CreateRoleInput) SetAssumeRolePolicyDocument(v string) *CreateRoleInput {
 	s.AssumeRolePolicyDocument = &v
@@ -15215,6 +15574,27 @@ func (s *CreateSAMLProviderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateSAMLProviderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateSAMLProviderInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[\\w._-]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[\\w._-]+"))
+	}
+	if s.SAMLMetadataDocument != nil && len(*s.SAMLMetadataDocument) > 1e+07 {
+		invalidParams.Add(request.NewErrParamMaxLen("SAMLMetadataDocument", 1e+07))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *CreateSAMLProviderInput) SetName(v string) *CreateSAMLProviderInput {
 	s.Name = &v
@@ -15302,6 +15682,36 @@ func (s *CreateServiceLinkedRoleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateServiceLinkedRoleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateServiceLinkedRoleInput"}
+	if s.AWSServiceName != nil && len(*s.AWSServiceName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("AWSServiceName", 128))
+	}
+	if s.AWSServiceName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.AWSServiceName) {
+		invalidParams.Add(request.NewErrParamPattern("AWSServiceName", "[\\w+=,.@-]+"))
+	}
+	if s.CustomSuffix != nil && len(*s.CustomSuffix) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("CustomSuffix", 64))
+	}
+	if s.CustomSuffix != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.CustomSuffix) {
+		invalidParams.Add(request.NewErrParamPattern("CustomSuffix", "[\\w+=,.@-]+"))
+	}
+	if s.Description != nil && len(*s.Description) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1000))
+	}
+	if s.Description != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{Z}\\p{S}\\p{N}\\p{P}]*", *s.Description) {
+		invalidParams.Add(request.NewErrParamPattern("Description", "[\\p{L}\\p{M}\\p{Z}\\p{S}\\p{N}\\p{P}]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAWSServiceName sets the AWSServiceName field's value.
 func (s *CreateServiceLinkedRoleInput) SetAWSServiceName(v string) *CreateServiceLinkedRoleInput {
 	s.AWSServiceName = &v
@@ -15396,6 +15806,24 @@ func (s *CreateServiceSpecificCredentialInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateServiceSpecificCredentialInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateServiceSpecificCredentialInput"}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetServiceName sets the ServiceName field's value.
 func (s *CreateServiceSpecificCredentialInput) SetServiceName(v string) *CreateServiceSpecificCredentialInput {
 	s.ServiceName = &v
@@ -15496,6 +15924,27 @@ func (s *CreateUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateUserInput"}
+	if s.Path != nil && len(*s.Path) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("Path", 512))
+	}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPath sets the Path field's value.
 func (s *CreateUserInput) SetPath(v string) *CreateUserInput {
 	s.Path = &v
@@ -15591,6 +16040,24 @@ func (s *CreateVirtualMFADeviceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateVirtualMFADeviceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateVirtualMFADeviceInput"}
+	if s.Path != nil && len(*s.Path) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("Path", 512))
+	}
+	if s.VirtualMFADeviceName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.VirtualMFADeviceName) {
+		invalidParams.Add(request.NewErrParamPattern("VirtualMFADeviceName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPath sets the Path field's value.
 func (s *CreateVirtualMFADeviceInput) SetPath(v string) *CreateVirtualMFADeviceInput {
 	s.Path = &v
@@ -15686,12 +16153,36 @@ func (s *DeactivateMFADeviceInput) Validate() error {
 	return nil
 }
 
-// SetSerialNumber sets the SerialNumber field's value.
-func (s *DeactivateMFADeviceInput) SetSerialNumber(v string) *DeactivateMFADeviceInput {
-	s.SerialNumber = &v
-	return s
-}
-
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeactivateMFADeviceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeactivateMFADeviceInput"}
+	if s.SerialNumber != nil && len(*s.SerialNumber) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("SerialNumber", 256))
+	}
+	if s.SerialNumber != nil && !request.MatchParamPattern("[\\w+=/:,.@-]+", *s.SerialNumber) {
+		invalidParams.Add(request.NewErrParamPattern("SerialNumber", "[\\w+=/:,.@-]+"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetSerialNumber sets the SerialNumber field's value.
+func (s *DeactivateMFADeviceInput) SetSerialNumber(v string) *DeactivateMFADeviceInput {
+	s.SerialNumber = &v
+	return s
+}
+
 // SetUserName sets the UserName field's value.
 func (s *DeactivateMFADeviceInput) SetUserName(v string) *DeactivateMFADeviceInput {
 	s.UserName = &v
@@ -15764,6 +16255,30 @@ func (s *DeleteAccessKeyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteAccessKeyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteAccessKeyInput"}
+	if s.AccessKeyId != nil && len(*s.AccessKeyId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccessKeyId", 128))
+	}
+	if s.AccessKeyId != nil && !request.MatchParamPattern("[\\w]+", *s.AccessKeyId) {
+		invalidParams.Add(request.NewErrParamPattern("AccessKeyId", "[\\w]+"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessKeyId sets the AccessKeyId field's value.
 func (s *DeleteAccessKeyInput) SetAccessKeyId(v string) *DeleteAccessKeyInput {
 	s.AccessKeyId = &v
@@ -15832,6 +16347,21 @@ func (s *DeleteAccountAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteAccountAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteAccountAliasInput"}
+	if s.AccountAlias != nil && len(*s.AccountAlias) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountAlias", 63))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountAlias sets the AccountAlias field's value.
 func (s *DeleteAccountAliasInput) SetAccountAlias(v string) *DeleteAccountAliasInput {
 	s.AccountAlias = &v
@@ -15923,6 +16453,24 @@ func (s *DeleteGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteGroupInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *DeleteGroupInput) SetGroupName(v string) *DeleteGroupInput {
 	s.GroupName = &v
@@ -16000,6 +16548,30 @@ func (s *DeleteGroupPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteGroupPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteGroupPolicyInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\w+=,.@-]+"))
+	}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *DeleteGroupPolicyInput) SetGroupName(v string) *DeleteGroupPolicyInput {
 	s.GroupName = &v
@@ -16067,6 +16639,24 @@ func (s *DeleteInstanceProfileInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteInstanceProfileInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteInstanceProfileInput"}
+	if s.InstanceProfileName != nil && len(*s.InstanceProfileName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceProfileName", 128))
+	}
+	if s.InstanceProfileName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.InstanceProfileName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceProfileName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceProfileName sets the InstanceProfileName field's value.
 func (s *DeleteInstanceProfileInput) SetInstanceProfileName(v string) *DeleteInstanceProfileInput {
 	s.InstanceProfileName = &v
@@ -16128,6 +16718,24 @@ func (s *DeleteLoginProfileInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteLoginProfileInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteLoginProfileInput"}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserName sets the UserName field's value.
 func (s *DeleteLoginProfileInput) SetUserName(v string) *DeleteLoginProfileInput {
 	s.UserName = &v
@@ -16187,6 +16795,21 @@ func (s *DeleteOpenIDConnectProviderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteOpenIDConnectProviderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteOpenIDConnectProviderInput"}
+	if s.OpenIDConnectProviderArn != nil && len(*s.OpenIDConnectProviderArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("OpenIDConnectProviderArn", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetOpenIDConnectProviderArn sets the OpenIDConnectProviderArn field's value.
 func (s *DeleteOpenIDConnectProviderInput) SetOpenIDConnectProviderArn(v string) *DeleteOpenIDConnectProviderInput {
 	s.OpenIDConnectProviderArn = &v
@@ -16248,6 +16871,21 @@ func (s *DeletePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeletePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePolicyInput"}
+	if s.PolicyArn != nil && len(*s.PolicyArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyArn", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyArn sets the PolicyArn field's value.
 func (s *DeletePolicyInput) SetPolicyArn(v string) *DeletePolicyInput {
 	s.PolicyArn = &v
@@ -16327,6 +16965,24 @@ func (s *DeletePolicyVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeletePolicyVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePolicyVersionInput"}
+	if s.PolicyArn != nil && len(*s.PolicyArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyArn", 2048))
+	}
+	if s.VersionId != nil && !request.MatchParamPattern("v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?", *s.VersionId) {
+		invalidParams.Add(request.NewErrParamPattern("VersionId", "v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyArn sets the PolicyArn field's value.
 func (s *DeletePolicyVersionInput) SetPolicyArn(v string) *DeletePolicyVersionInput {
 	s.PolicyArn = &v
@@ -16394,6 +17050,24 @@ func (s *DeleteRoleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteRoleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteRoleInput"}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRoleName sets the RoleName field's value.
 func (s *DeleteRoleInput) SetRoleName(v string) *DeleteRoleInput {
 	s.RoleName = &v
@@ -16471,6 +17145,30 @@ func (s *DeleteRolePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteRolePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteRolePolicyInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyName sets the PolicyName field's value.
 func (s *DeleteRolePolicyInput) SetPolicyName(v string) *DeleteRolePolicyInput {
 	s.PolicyName = &v
@@ -16534,6 +17232,21 @@ func (s *DeleteSAMLProviderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteSAMLProviderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSAMLProviderInput"}
+	if s.SAMLProviderArn != nil && len(*s.SAMLProviderArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("SAMLProviderArn", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSAMLProviderArn sets the SAMLProviderArn field's value.
 func (s *DeleteSAMLProviderInput) SetSAMLProviderArn(v string) *DeleteSAMLProviderInput {
 	s.SAMLProviderArn = &v
@@ -16610,6 +17323,30 @@ func (s *DeleteSSHPublicKeyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteSSHPublicKeyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSSHPublicKeyInput"}
+	if s.SSHPublicKeyId != nil && len(*s.SSHPublicKeyId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("SSHPublicKeyId", 128))
+	}
+	if s.SSHPublicKeyId != nil && !request.MatchParamPattern("[\\w]+", *s.SSHPublicKeyId) {
+		invalidParams.Add(request.NewErrParamPattern("SSHPublicKeyId", "[\\w]+"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSSHPublicKeyId sets the SSHPublicKeyId field's value.
 func (s *DeleteSSHPublicKeyInput) SetSSHPublicKeyId(v string) *DeleteSSHPublicKeyInput {
 	s.SSHPublicKeyId = &v
@@ -16677,6 +17414,24 @@ func (s *DeleteServerCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteServerCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteServerCertificateInput"}
+	if s.ServerCertificateName != nil && len(*s.ServerCertificateName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerCertificateName", 128))
+	}
+	if s.ServerCertificateName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.ServerCertificateName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerCertificateName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetServerCertificateName sets the ServerCertificateName field's value.
 func (s *DeleteServerCertificateInput) SetServerCertificateName(v string) *DeleteServerCertificateInput {
 	s.ServerCertificateName = &v
@@ -16734,6 +17489,24 @@ func (s *DeleteServiceLinkedRoleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteServiceLinkedRoleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteServiceLinkedRoleInput"}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRoleName sets the RoleName field's value.
 func (s *DeleteServiceLinkedRoleInput) SetRoleName(v string) *DeleteServiceLinkedRoleInput {
 	s.RoleName = &v
@@ -16820,6 +17593,30 @@ func (s *DeleteServiceSpecificCredentialInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteServiceSpecificCredentialInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteServiceSpecificCredentialInput"}
+	if s.ServiceSpecificCredentialId != nil && len(*s.ServiceSpecificCredentialId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServiceSpecificCredentialId", 128))
+	}
+	if s.ServiceSpecificCredentialId != nil && !request.MatchParamPattern("[\\w]+", *s.ServiceSpecificCredentialId) {
+		invalidParams.Add(request.NewErrParamPattern("ServiceSpecificCredentialId", "[\\w]+"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetServiceSpecificCredentialId sets the ServiceSpecificCredentialId field's value.
 func (s *DeleteServiceSpecificCredentialInput) SetServiceSpecificCredentialId(v string) *DeleteServiceSpecificCredentialInput {
 	s.ServiceSpecificCredentialId = &v
@@ -16897,6 +17694,30 @@ func (s *DeleteSigningCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteSigningCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSigningCertificateInput"}
+	if s.CertificateId != nil && len(*s.CertificateId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateId", 128))
+	}
+	if s.CertificateId != nil && !request.MatchParamPattern("[\\w]+", *s.CertificateId) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateId", "[\\w]+"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateId sets the CertificateId field's value.
 func (s *DeleteSigningCertificateInput) SetCertificateId(v string) *DeleteSigningCertificateInput {
 	s.CertificateId = &v
@@ -16964,6 +17785,24 @@ func (s *DeleteUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteUserInput"}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserName sets the UserName field's value.
 func (s *DeleteUserInput) SetUserName(v string) *DeleteUserInput {
 	s.UserName = &v
@@ -17041,6 +17880,30 @@ func (s *DeleteUserPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteUserPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteUserPolicyInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyName sets the PolicyName field's value.
 func (s *DeleteUserPolicyInput) SetPolicyName(v string) *DeleteUserPolicyInput {
 	s.PolicyName = &v
@@ -17109,6 +17972,24 @@ func (s *DeleteVirtualMFADeviceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteVirtualMFADeviceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteVirtualMFADeviceInput"}
+	if s.SerialNumber != nil && len(*s.SerialNumber) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("SerialNumber", 256))
+	}
+	if s.SerialNumber != nil && !request.MatchParamPattern("[\\w+=/:,.@-]+", *s.SerialNumber) {
+		invalidParams.Add(request.NewErrParamPattern("SerialNumber", "[\\w+=/:,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSerialNumber sets the SerialNumber field's value.
 func (s *DeleteVirtualMFADeviceInput) SetSerialNumber(v string) *DeleteVirtualMFADeviceInput {
 	s.SerialNumber = &v
@@ -17227,6 +18108,27 @@ func (s *DetachGroupPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetachGroupPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetachGroupPolicyInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\w+=,.@-]+"))
+	}
+	if s.PolicyArn != nil && len(*s.PolicyArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyArn", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *DetachGroupPolicyInput) SetGroupName(v string) *DetachGroupPolicyInput {
 	s.GroupName = &v
@@ -17309,6 +18211,27 @@ func (s *DetachRolePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetachRolePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetachRolePolicyInput"}
+	if s.PolicyArn != nil && len(*s.PolicyArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyArn", 2048))
+	}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyArn sets the PolicyArn field's value.
 func (s *DetachRolePolicyInput) SetPolicyArn(v string) *DetachRolePolicyInput {
 	s.PolicyArn = &v
@@ -17391,6 +18314,27 @@ func (s *DetachUserPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetachUserPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetachUserPolicyInput"}
+	if s.PolicyArn != nil && len(*s.PolicyArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyArn", 2048))
+	}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyArn sets the PolicyArn field's value.
 func (s *DetachUserPolicyInput) SetPolicyArn(v string) *DetachUserPolicyInput {
 	s.PolicyArn = &v
@@ -17514,6 +18458,42 @@ func (s *EnableMFADeviceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnableMFADeviceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableMFADeviceInput"}
+	if s.AuthenticationCode1 != nil && len(*s.AuthenticationCode1) > 6 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationCode1", 6))
+	}
+	if s.AuthenticationCode1 != nil && !request.MatchParamPattern("[\\d]+", *s.AuthenticationCode1) {
+		invalidParams.Add(request.NewErrParamPattern("AuthenticationCode1", "[\\d]+"))
+	}
+	if s.AuthenticationCode2 != nil && len(*s.AuthenticationCode2) > 6 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationCode2", 6))
+	}
+	if s.AuthenticationCode2 != nil && !request.MatchParamPattern("[\\d]+", *s.AuthenticationCode2) {
+		invalidParams.Add(request.NewErrParamPattern("AuthenticationCode2", "[\\d]+"))
+	}
+	if s.SerialNumber != nil && len(*s.SerialNumber) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("SerialNumber", 256))
+	}
+	if s.SerialNumber != nil && !request.MatchParamPattern("[\\w+=/:,.@-]+", *s.SerialNumber) {
+		invalidParams.Add(request.NewErrParamPattern("SerialNumber", "[\\w+=/:,.@-]+"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationCode1 sets the AuthenticationCode1 field's value.
 func (s *EnableMFADeviceInput) SetAuthenticationCode1(v string) *EnableMFADeviceInput {
 	s.AuthenticationCode1 = &v
@@ -17755,6 +18735,24 @@ func (s *GetAccessKeyLastUsedInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetAccessKeyLastUsedInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetAccessKeyLastUsedInput"}
+	if s.AccessKeyId != nil && len(*s.AccessKeyId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccessKeyId", 128))
+	}
+	if s.AccessKeyId != nil && !request.MatchParamPattern("[\\w]+", *s.AccessKeyId) {
+		invalidParams.Add(request.NewErrParamPattern("AccessKeyId", "[\\w]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessKeyId sets the AccessKeyId field's value.
 func (s *GetAccessKeyLastUsedInput) SetAccessKeyId(v string) *GetAccessKeyLastUsedInput {
 	s.AccessKeyId = &v
@@ -17854,6 +18852,24 @@ func (s *GetAccountAuthorizationDetailsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetAccountAuthorizationDetailsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetAccountAuthorizationDetailsInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilter sets the Filter field's value.
 func (s *GetAccountAuthorizationDetailsInput) SetFilter(v []*string) *GetAccountAuthorizationDetailsInput {
 	s.Filter = v
@@ -18162,6 +19178,21 @@ func (s *GetContextKeysForPrincipalPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetContextKeysForPrincipalPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetContextKeysForPrincipalPolicyInput"}
+	if s.PolicySourceArn != nil && len(*s.PolicySourceArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicySourceArn", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyInputList sets the PolicyInputList field's value.
 func (s *GetContextKeysForPrincipalPolicyInput) SetPolicyInputList(v []*string) *GetContextKeysForPrincipalPolicyInput {
 	s.PolicyInputList = v
@@ -18298,6 +19329,30 @@ func (s *GetGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetGroupInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\w+=,.@-]+"))
+	}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *GetGroupInput) SetGroupName(v string) *GetGroupInput {
 	s.GroupName = &v
@@ -18433,6 +19488,30 @@ func (s *GetGroupPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetGroupPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetGroupPolicyInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\w+=,.@-]+"))
+	}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *GetGroupPolicyInput) SetGroupName(v string) *GetGroupPolicyInput {
 	s.GroupName = &v
@@ -18534,6 +19613,24 @@ func (s *GetInstanceProfileInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetInstanceProfileInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetInstanceProfileInput"}
+	if s.InstanceProfileName != nil && len(*s.InstanceProfileName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceProfileName", 128))
+	}
+	if s.InstanceProfileName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.InstanceProfileName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceProfileName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceProfileName sets the InstanceProfileName field's value.
 func (s *GetInstanceProfileInput) SetInstanceProfileName(v string) *GetInstanceProfileInput {
 	s.InstanceProfileName = &v
@@ -18607,6 +19704,24 @@ func (s *GetLoginProfileInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetLoginProfileInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetLoginProfileInput"}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserName sets the UserName field's value.
 func (s *GetLoginProfileInput) SetUserName(v string) *GetLoginProfileInput {
 	s.UserName = &v
@@ -18682,6 +19797,21 @@ func (s *GetOpenIDConnectProviderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetOpenIDConnectProviderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetOpenIDConnectProviderInput"}
+	if s.OpenIDConnectProviderArn != nil && len(*s.OpenIDConnectProviderArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("OpenIDConnectProviderArn", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetOpenIDConnectProviderArn sets the OpenIDConnectProviderArn field's value.
 func (s *GetOpenIDConnectProviderInput) SetOpenIDConnectProviderArn(v string) *GetOpenIDConnectProviderInput {
 	s.OpenIDConnectProviderArn = &v
@@ -18785,6 +19915,21 @@ func (s *GetPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPolicyInput"}
+	if s.PolicyArn != nil && len(*s.PolicyArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyArn", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyArn sets the PolicyArn field's value.
 func (s *GetPolicyInput) SetPolicyArn(v string) *GetPolicyInput {
 	s.PolicyArn = &v
@@ -18870,6 +20015,24 @@ func (s *GetPolicyVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetPolicyVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPolicyVersionInput"}
+	if s.PolicyArn != nil && len(*s.PolicyArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyArn", 2048))
+	}
+	if s.VersionId != nil && !request.MatchParamPattern("v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?", *s.VersionId) {
+		invalidParams.Add(request.NewErrParamPattern("VersionId", "v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyArn sets the PolicyArn field's value.
 func (s *GetPolicyVersionInput) SetPolicyArn(v string) *GetPolicyVersionInput {
 	s.PolicyArn = &v
@@ -18947,6 +20110,24 @@ func (s *GetRoleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetRoleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetRoleInput"}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRoleName sets the RoleName field's value.
 func (s *GetRoleInput) SetRoleName(v string) *GetRoleInput {
 	s.RoleName = &v
@@ -19035,6 +20216,30 @@ func (s *GetRolePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetRolePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetRolePolicyInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyName sets the PolicyName field's value.
 func (s *GetRolePolicyInput) SetPolicyName(v string) *GetRolePolicyInput {
 	s.PolicyName = &v
@@ -19137,6 +20342,21 @@ func (s *GetSAMLProviderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetSAMLProviderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetSAMLProviderInput"}
+	if s.SAMLProviderArn != nil && len(*s.SAMLProviderArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("SAMLProviderArn", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSAMLProviderArn sets the SAMLProviderArn field's value.
 func (s *GetSAMLProviderInput) SetSAMLProviderArn(v string) *GetSAMLProviderInput {
 	s.SAMLProviderArn = &v
@@ -19251,6 +20471,36 @@ func (s *GetSSHPublicKeyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetSSHPublicKeyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetSSHPublicKeyInput"}
+	if s.Encoding != nil {
+		values := []string{"SSH", "PEM"}
+		if !request.IsParamEnumValue(*s.Encoding, values) {
+			invalidParams.Add(request.NewErrParamEnum("Encoding", *s.Encoding, values))
+		}
+	}
+	if s.SSHPublicKeyId != nil && len(*s.SSHPublicKeyId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("SSHPublicKeyId", 128))
+	}
+	if s.SSHPublicKeyId != nil && !request.MatchParamPattern("[\\w]+", *s.SSHPublicKeyId) {
+		invalidParams.Add(request.NewErrParamPattern("SSHPublicKeyId", "[\\w]+"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEncoding sets the Encoding field's value.
 func (s *GetSSHPublicKeyInput) SetEncoding(v string) *GetSSHPublicKeyInput {
 	s.Encoding = &v
@@ -19334,6 +20584,24 @@ func (s *GetServerCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetServerCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetServerCertificateInput"}
+	if s.ServerCertificateName != nil && len(*s.ServerCertificateName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerCertificateName", 128))
+	}
+	if s.ServerCertificateName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.ServerCertificateName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerCertificateName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetServerCertificateName sets the ServerCertificateName field's value.
 func (s *GetServerCertificateInput) SetServerCertificateName(v string) *GetServerCertificateInput {
 	s.ServerCertificateName = &v
@@ -19404,6 +20672,21 @@ func (s *GetServiceLinkedRoleDeletionStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetServiceLinkedRoleDeletionStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetServiceLinkedRoleDeletionStatusInput"}
+	if s.DeletionTaskId != nil && len(*s.DeletionTaskId) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeletionTaskId", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeletionTaskId sets the DeletionTaskId field's value.
 func (s *GetServiceLinkedRoleDeletionStatusInput) SetDeletionTaskId(v string) *GetServiceLinkedRoleDeletionStatusInput {
 	s.DeletionTaskId = &v
@@ -19481,6 +20764,24 @@ func (s *GetUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetUserInput"}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetUserName sets the UserName field's value.
 func (s *GetUserInput) SetUserName(v string) *GetUserInput {
 	s.UserName = &v
@@ -19569,6 +20870,30 @@ func (s *GetUserPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetUserPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetUserPolicyInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyName sets the PolicyName field's value.
 func (s *GetUserPolicyInput) SetPolicyName(v string) *GetUserPolicyInput {
 	s.PolicyName = &v
@@ -19964,6 +21289,30 @@ func (s *ListAccessKeysInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAccessKeysInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAccessKeysInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListAccessKeysInput) SetMarker(v string) *ListAccessKeysInput {
 	s.Marker = &v
@@ -20081,6 +21430,24 @@ func (s *ListAccountAliasesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAccountAliasesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAccountAliasesInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListAccountAliasesInput) SetMarker(v string) *ListAccountAliasesInput {
 	s.Marker = &v
@@ -20219,6 +21586,33 @@ func (s *ListAttachedGroupPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAttachedGroupPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAttachedGroupPoliciesInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\w+=,.@-]+"))
+	}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.PathPrefix != nil && !request.MatchParamPattern("((/[A-Za-z0-9\\.,\\+@=_-]+)*)/", *s.PathPrefix) {
+		invalidParams.Add(request.NewErrParamPattern("PathPrefix", "((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *ListAttachedGroupPoliciesInput) SetGroupName(v string) *ListAttachedGroupPoliciesInput {
 	s.GroupName = &v
@@ -20365,6 +21759,33 @@ func (s *ListAttachedRolePoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAttachedRolePoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAttachedRolePoliciesInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.PathPrefix != nil && !request.MatchParamPattern("((/[A-Za-z0-9\\.,\\+@=_-]+)*)/", *s.PathPrefix) {
+		invalidParams.Add(request.NewErrParamPattern("PathPrefix", "((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"))
+	}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListAttachedRolePoliciesInput) SetMarker(v string) *ListAttachedRolePoliciesInput {
 	s.Marker = &v
@@ -20511,6 +21932,33 @@ func (s *ListAttachedUserPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAttachedUserPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAttachedUserPoliciesInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.PathPrefix != nil && !request.MatchParamPattern("((/[A-Za-z0-9\\.,\\+@=_-]+)*)/", *s.PathPrefix) {
+		invalidParams.Add(request.NewErrParamPattern("PathPrefix", "((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListAttachedUserPoliciesInput) SetMarker(v string) *ListAttachedUserPoliciesInput {
 	s.Marker = &v
@@ -20668,6 +22116,36 @@ func (s *ListEntitiesForPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListEntitiesForPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListEntitiesForPolicyInput"}
+	if s.EntityFilter != nil {
+		values := []string{"User", "Role", "Group", "LocalManagedPolicy", "AWSManagedPolicy"}
+		if !request.IsParamEnumValue(*s.EntityFilter, values) {
+			invalidParams.Add(request.NewErrParamEnum("EntityFilter", *s.EntityFilter, values))
+		}
+	}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.PathPrefix != nil && len(*s.PathPrefix) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("PathPrefix", 512))
+	}
+	if s.PolicyArn != nil && len(*s.PolicyArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyArn", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEntityFilter sets the EntityFilter field's value.
 func (s *ListEntitiesForPolicyInput) SetEntityFilter(v string) *ListEntitiesForPolicyInput {
 	s.EntityFilter = &v
@@ -20828,6 +22306,30 @@ func (s *ListGroupPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListGroupPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListGroupPoliciesInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\w+=,.@-]+"))
+	}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *ListGroupPoliciesInput) SetGroupName(v string) *ListGroupPoliciesInput {
 	s.GroupName = &v
@@ -20964,6 +22466,30 @@ func (s *ListGroupsForUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListGroupsForUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListGroupsForUserInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListGroupsForUserInput) SetMarker(v string) *ListGroupsForUserInput {
 	s.Marker = &v
@@ -21095,6 +22621,27 @@ func (s *ListGroupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListGroupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListGroupsInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.PathPrefix != nil && len(*s.PathPrefix) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("PathPrefix", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListGroupsInput) SetMarker(v string) *ListGroupsInput {
 	s.Marker = &v
@@ -21227,6 +22774,30 @@ func (s *ListInstanceProfilesForRoleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListInstanceProfilesForRoleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListInstanceProfilesForRoleInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListInstanceProfilesForRoleInput) SetMarker(v string) *ListInstanceProfilesForRoleInput {
 	s.Marker = &v
@@ -21359,6 +22930,27 @@ func (s *ListInstanceProfilesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListInstanceProfilesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListInstanceProfilesInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.PathPrefix != nil && len(*s.PathPrefix) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("PathPrefix", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListInstanceProfilesInput) SetMarker(v string) *ListInstanceProfilesInput {
 	s.Marker = &v
@@ -21486,6 +23078,30 @@ func (s *ListMFADevicesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListMFADevicesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListMFADevicesInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListMFADevicesInput) SetMarker(v string) *ListMFADevicesInput {
 	s.Marker = &v
@@ -21668,6 +23284,33 @@ func (s *ListPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPoliciesInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.PathPrefix != nil && !request.MatchParamPattern("((/[A-Za-z0-9\\.,\\+@=_-]+)*)/", *s.PathPrefix) {
+		invalidParams.Add(request.NewErrParamPattern("PathPrefix", "((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"))
+	}
+	if s.Scope != nil {
+		values := []string{"All", "AWS", "Local"}
+		if !request.IsParamEnumValue(*s.Scope, values) {
+			invalidParams.Add(request.NewErrParamEnum("Scope", *s.Scope, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListPoliciesInput) SetMarker(v string) *ListPoliciesInput {
 	s.Marker = &v
@@ -21810,6 +23453,27 @@ func (s *ListPolicyVersionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPolicyVersionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPolicyVersionsInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.PolicyArn != nil && len(*s.PolicyArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyArn", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListPolicyVersionsInput) SetMarker(v string) *ListPolicyVersionsInput {
 	s.Marker = &v
@@ -21944,6 +23608,30 @@ func (s *ListRolePoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListRolePoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRolePoliciesInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListRolePoliciesInput) SetMarker(v string) *ListRolePoliciesInput {
 	s.Marker = &v
@@ -22075,6 +23763,27 @@ func (s *ListRolesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListRolesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRolesInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.PathPrefix != nil && len(*s.PathPrefix) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("PathPrefix", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListRolesInput) SetMarker(v string) *ListRolesInput {
 	s.Marker = &v
@@ -22244,6 +23953,30 @@ func (s *ListSSHPublicKeysInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListSSHPublicKeysInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListSSHPublicKeysInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListSSHPublicKeysInput) SetMarker(v string) *ListSSHPublicKeysInput {
 	s.Marker = &v
@@ -22374,6 +24107,27 @@ func (s *ListServerCertificatesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListServerCertificatesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListServerCertificatesInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.PathPrefix != nil && len(*s.PathPrefix) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("PathPrefix", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListServerCertificatesInput) SetMarker(v string) *ListServerCertificatesInput {
 	s.Marker = &v
@@ -22484,6 +24238,24 @@ func (s *ListServiceSpecificCredentialsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListServiceSpecificCredentialsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListServiceSpecificCredentialsInput"}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetServiceName sets the ServiceName field's value.
 func (s *ListServiceSpecificCredentialsInput) SetServiceName(v string) *ListServiceSpecificCredentialsInput {
 	s.ServiceName = &v
@@ -22578,6 +24350,30 @@ func (s *ListSigningCertificatesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListSigningCertificatesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListSigningCertificatesInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListSigningCertificatesInput) SetMarker(v string) *ListSigningCertificatesInput {
 	s.Marker = &v
@@ -22710,6 +24506,30 @@ func (s *ListUserPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListUserPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListUserPoliciesInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListUserPoliciesInput) SetMarker(v string) *ListUserPoliciesInput {
 	s.Marker = &v
@@ -22842,6 +24662,27 @@ func (s *ListUsersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListUsersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListUsersInput"}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.PathPrefix != nil && len(*s.PathPrefix) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("PathPrefix", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListUsersInput) SetMarker(v string) *ListUsersInput {
 	s.Marker = &v
@@ -22964,6 +24805,30 @@ func (s *ListVirtualMFADevicesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListVirtualMFADevicesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListVirtualMFADevicesInput"}
+	if s.AssignmentStatus != nil {
+		values := []string{"Assigned", "Unassigned", "Any"}
+		if !request.IsParamEnumValue(*s.AssignmentStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("AssignmentStatus", *s.AssignmentStatus, values))
+		}
+	}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssignmentStatus sets the AssignmentStatus field's value.
 func (s *ListVirtualMFADevicesInput) SetAssignmentStatus(v string) *ListVirtualMFADevicesInput {
 	s.AssignmentStatus = &v
@@ -23930,6 +25795,33 @@ func (s *PutGroupPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutGroupPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutGroupPolicyInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\w+=,.@-]+"))
+	}
+	if s.PolicyDocument != nil && len(*s.PolicyDocument) > 131072 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyDocument", 131072))
+	}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *PutGroupPolicyInput) SetGroupName(v string) *PutGroupPolicyInput {
 	s.GroupName = &v
@@ -24036,6 +25928,33 @@ func (s *PutRolePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutRolePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutRolePolicyInput"}
+	if s.PolicyDocument != nil && len(*s.PolicyDocument) > 131072 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyDocument", 131072))
+	}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyDocument sets the PolicyDocument field's value.
 func (s *PutRolePolicyInput) SetPolicyDocument(v string) *PutRolePolicyInput {
 	s.PolicyDocument = &v
@@ -24142,6 +26061,33 @@ func (s *PutUserPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutUserPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutUserPolicyInput"}
+	if s.PolicyDocument != nil && len(*s.PolicyDocument) > 131072 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyDocument", 131072))
+	}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyDocument sets the PolicyDocument field's value.
 func (s *PutUserPolicyInput) SetPolicyDocument(v string) *PutUserPolicyInput {
 	s.PolicyDocument = &v
@@ -24229,6 +26175,24 @@ func (s *RemoveClientIDFromOpenIDConnectProviderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveClientIDFromOpenIDConnectProviderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveClientIDFromOpenIDConnectProviderInput"}
+	if s.ClientID != nil && len(*s.ClientID) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientID", 255))
+	}
+	if s.OpenIDConnectProviderArn != nil && len(*s.OpenIDConnectProviderArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("OpenIDConnectProviderArn", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientID sets the ClientID field's value.
 func (s *RemoveClientIDFromOpenIDConnectProviderInput) SetClientID(v string) *RemoveClientIDFromOpenIDConnectProviderInput {
 	s.ClientID = &v
@@ -24311,6 +26275,30 @@ func (s *RemoveRoleFromInstanceProfileInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveRoleFromInstanceProfileInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveRoleFromInstanceProfileInput"}
+	if s.InstanceProfileName != nil && len(*s.InstanceProfileName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceProfileName", 128))
+	}
+	if s.InstanceProfileName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.InstanceProfileName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceProfileName", "[\\w+=,.@-]+"))
+	}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceProfileName sets the InstanceProfileName field's value.
 func (s *RemoveRoleFromInstanceProfileInput) SetInstanceProfileName(v string) *RemoveRoleFromInstanceProfileInput {
 	s.InstanceProfileName = &v
@@ -24393,6 +26381,30 @@ func (s *RemoveUserFromGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveUserFromGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveUserFromGroupInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\w+=,.@-]+"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *RemoveUserFromGroupInput) SetGroupName(v string) *RemoveUserFromGroupInput {
 	s.GroupName = &v
@@ -24472,6 +26484,30 @@ func (s *ResetServiceSpecificCredentialInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResetServiceSpecificCredentialInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResetServiceSpecificCredentialInput"}
+	if s.ServiceSpecificCredentialId != nil && len(*s.ServiceSpecificCredentialId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServiceSpecificCredentialId", 128))
+	}
+	if s.ServiceSpecificCredentialId != nil && !request.MatchParamPattern("[\\w]+", *s.ServiceSpecificCredentialId) {
+		invalidParams.Add(request.NewErrParamPattern("ServiceSpecificCredentialId", "[\\w]+"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetServiceSpecificCredentialId sets the ServiceSpecificCredentialId field's value.
 func (s *ResetServiceSpecificCredentialInput) SetServiceSpecificCredentialId(v string) *ResetServiceSpecificCredentialInput {
 	s.ServiceSpecificCredentialId = &v
@@ -24664,11 +26700,47 @@ func (s *ResyncMFADeviceInput) Validate() error {
 	if s.SerialNumber != nil && len(*s.SerialNumber) < 9 {
 		invalidParams.Add(request.NewErrParamMinLen("SerialNumber", 9))
 	}
-	if s.UserName == nil {
-		invalidParams.Add(request.NewErrParamRequired("UserName"))
+	if s.UserName == nil {
+		invalidParams.Add(request.NewErrParamRequired("UserName"))
+	}
+	if s.UserName != nil && len(*s.UserName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("UserName", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResyncMFADeviceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResyncMFADeviceInput"}
+	if s.AuthenticationCode1 != nil && len(*s.AuthenticationCode1) > 6 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationCode1", 6))
+	}
+	if s.AuthenticationCode1 != nil && !request.MatchParamPattern("[\\d]+", *s.AuthenticationCode1) {
+		invalidParams.Add(request.NewErrParamPattern("AuthenticationCode1", "[\\d]+"))
+	}
+	if s.AuthenticationCode2 != nil && len(*s.AuthenticationCode2) > 6 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationCode2", 6))
+	}
+	if s.AuthenticationCode2 != nil && !request.MatchParamPattern("[\\d]+", *s.AuthenticationCode2) {
+		invalidParams.Add(request.NewErrParamPattern("AuthenticationCode2", "[\\d]+"))
+	}
+	if s.SerialNumber != nil && len(*s.SerialNumber) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("SerialNumber", 256))
+	}
+	if s.SerialNumber != nil && !request.MatchParamPattern("[\\w+=/:,.@-]+", *s.SerialNumber) {
+		invalidParams.Add(request.NewErrParamPattern("SerialNumber", "[\\w+=/:,.@-]+"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
 	}
-	if s.UserName != nil && len(*s.UserName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("UserName", 1))
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -25312,7 +27384,7 @@ type ServiceSpecificCredential struct {
 	// The generated password for the service-specific credential.
 	//
 	// ServicePassword is a required field
-	ServicePassword *string `type:"string" required:"true"`
+	ServicePassword *string `type:"string" required:"true" sensitive:"true"`
 
 	// The unique identifier for the service-specific credential.
 	//
@@ -25528,6 +27600,24 @@ func (s *SetDefaultPolicyVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetDefaultPolicyVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetDefaultPolicyVersionInput"}
+	if s.PolicyArn != nil && len(*s.PolicyArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyArn", 2048))
+	}
+	if s.VersionId != nil && !request.MatchParamPattern("v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?", *s.VersionId) {
+		invalidParams.Add(request.NewErrParamPattern("VersionId", "v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyArn sets the PolicyArn field's value.
 func (s *SetDefaultPolicyVersionInput) SetPolicyArn(v string) *SetDefaultPolicyVersionInput {
 	s.PolicyArn = &v
@@ -25825,6 +27915,46 @@ func (s *SimulateCustomPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SimulateCustomPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SimulateCustomPolicyInput"}
+	if s.CallerArn != nil && len(*s.CallerArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("CallerArn", 2048))
+	}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.ResourceHandlingOption != nil && len(*s.ResourceHandlingOption) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceHandlingOption", 64))
+	}
+	if s.ResourceOwner != nil && len(*s.ResourceOwner) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceOwner", 2048))
+	}
+	if s.ResourcePolicy != nil && len(*s.ResourcePolicy) > 131072 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourcePolicy", 131072))
+	}
+	if s.ContextEntries != nil {
+		for i, v := range s.ContextEntries {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ContextEntries", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActionNames sets the ActionNames field's value.
 func (s *SimulateCustomPolicyInput) SetActionNames(v []*string) *SimulateCustomPolicyInput {
 	s.ActionNames = v
@@ -26149,6 +28279,49 @@ func (s *SimulatePrincipalPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SimulatePrincipalPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SimulatePrincipalPolicyInput"}
+	if s.CallerArn != nil && len(*s.CallerArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("CallerArn", 2048))
+	}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 1000))
+	}
+	if s.PolicySourceArn != nil && len(*s.PolicySourceArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicySourceArn", 2048))
+	}
+	if s.ResourceHandlingOption != nil && len(*s.ResourceHandlingOption) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceHandlingOption", 64))
+	}
+	if s.ResourceOwner != nil && len(*s.ResourceOwner) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceOwner", 2048))
+	}
+	if s.ResourcePolicy != nil && len(*s.ResourcePolicy) > 131072 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourcePolicy", 131072))
+	}
+	if s.ContextEntries != nil {
+		for i, v := range s.ContextEntries {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ContextEntries", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActionNames sets the ActionNames field's value.
 func (s *SimulatePrincipalPolicyInput) SetActionNames(v []*string) *SimulatePrincipalPolicyInput {
 	s.ActionNames = v
@@ -26331,6 +28504,36 @@ func (s *UpdateAccessKeyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateAccessKeyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateAccessKeyInput"}
+	if s.AccessKeyId != nil && len(*s.AccessKeyId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccessKeyId", 128))
+	}
+	if s.AccessKeyId != nil && !request.MatchParamPattern("[\\w]+", *s.AccessKeyId) {
+		invalidParams.Add(request.NewErrParamPattern("AccessKeyId", "[\\w]+"))
+	}
+	if s.Status != nil {
+		values := []string{"Active", "Inactive"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccessKeyId sets the AccessKeyId field's value.
 func (s *UpdateAccessKeyInput) SetAccessKeyId(v string) *UpdateAccessKeyInput {
 	s.AccessKeyId = &v
@@ -26455,6 +28658,27 @@ func (s *UpdateAccountPasswordPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateAccountPasswordPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateAccountPasswordPolicyInput"}
+	if s.MaxPasswordAge != nil && *s.MaxPasswordAge > 1095 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxPasswordAge", 1095))
+	}
+	if s.MinimumPasswordLength != nil && *s.MinimumPasswordLength > 128 {
+		invalidParams.Add(request.NewErrParamMaxValue("MinimumPasswordLength", 128))
+	}
+	if s.PasswordReusePrevention != nil && *s.PasswordReusePrevention > 24 {
+		invalidParams.Add(request.NewErrParamMaxValue("PasswordReusePrevention", 24))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllowUsersToChangePassword sets the AllowUsersToChangePassword field's value.
 func (s *UpdateAccountPasswordPolicyInput) SetAllowUsersToChangePassword(v bool) *UpdateAccountPasswordPolicyInput {
 	s.AllowUsersToChangePassword = &v
@@ -26582,6 +28806,27 @@ func (s *UpdateAssumeRolePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateAssumeRolePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateAssumeRolePolicyInput"}
+	if s.PolicyDocument != nil && len(*s.PolicyDocument) > 131072 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyDocument", 131072))
+	}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyDocument sets the PolicyDocument field's value.
 func (s *UpdateAssumeRolePolicyInput) SetPolicyDocument(v string) *UpdateAssumeRolePolicyInput {
 	s.PolicyDocument = &v
@@ -26672,6 +28917,33 @@ func (s *UpdateGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateGroupInput"}
+	if s.GroupName != nil && len(*s.GroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GroupName", 128))
+	}
+	if s.GroupName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.GroupName) {
+		invalidParams.Add(request.NewErrParamPattern("GroupName", "[\\w+=,.@-]+"))
+	}
+	if s.NewGroupName != nil && len(*s.NewGroupName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("NewGroupName", 128))
+	}
+	if s.NewGroupName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.NewGroupName) {
+		invalidParams.Add(request.NewErrParamPattern("NewGroupName", "[\\w+=,.@-]+"))
+	}
+	if s.NewPath != nil && len(*s.NewPath) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("NewPath", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGroupName sets the GroupName field's value.
 func (s *UpdateGroupInput) SetGroupName(v string) *UpdateGroupInput {
 	s.GroupName = &v
@@ -26719,7 +28991,7 @@ type UpdateLoginProfileInput struct {
 	// tab (\u0009), line feed (\u000A), and carriage return (\u000D). However,
 	// the format can be further restricted by the account administrator by setting
 	// a password policy on the AWS account. For more information, see UpdateAccountPasswordPolicy.
-	Password *string `min:"1" type:"string"`
+	Password *string `min:"1" type:"string" sensitive:"true"`
 
 	// Allows this new password to be used only once by requiring the specified
 	// IAM user to set a new password on next sign-in.
@@ -26764,6 +29036,27 @@ func (s *UpdateLoginProfileInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateLoginProfileInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateLoginProfileInput"}
+	if s.Password != nil && len(*s.Password) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Password", 128))
+	}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPassword sets the Password field's value.
 func (s *UpdateLoginProfileInput) SetPassword(v string) *UpdateLoginProfileInput {
 	s.Password = &v
@@ -26848,6 +29141,21 @@ func (s *UpdateOpenIDConnectProviderThumbprintInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateOpenIDConnectProviderThumbprintInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateOpenIDConnectProviderThumbprintInput"}
+	if s.OpenIDConnectProviderArn != nil && len(*s.OpenIDConnectProviderArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("OpenIDConnectProviderArn", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetOpenIDConnectProviderArn sets the OpenIDConnectProviderArn field's value.
 func (s *UpdateOpenIDConnectProviderThumbprintInput) SetOpenIDConnectProviderArn(v string) *UpdateOpenIDConnectProviderThumbprintInput {
 	s.OpenIDConnectProviderArn = &v
@@ -26919,6 +29227,30 @@ func (s *UpdateRoleDescriptionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateRoleDescriptionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateRoleDescriptionInput"}
+	if s.Description != nil && len(*s.Description) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1000))
+	}
+	if s.Description != nil && !request.MatchParamPattern("[\\p{L}\\p{M}\\p{Z}\\p{S}\\p{N}\\p{P}]*", *s.Description) {
+		invalidParams.Add(request.NewErrParamPattern("Description", "[\\p{L}\\p{M}\\p{Z}\\p{S}\\p{N}\\p{P}]*"))
+	}
+	if s.RoleName != nil && len(*s.RoleName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleName", 64))
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *UpdateRoleDescriptionInput) SetDescription(v string) *UpdateRoleDescriptionInput {
 	s.Description = &v
@@ -27010,6 +29342,24 @@ func (s *UpdateSAMLProviderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateSAMLProviderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateSAMLProviderInput"}
+	if s.SAMLMetadataDocument != nil && len(*s.SAMLMetadataDocument) > 1e+07 {
+		invalidParams.Add(request.NewErrParamMaxLen("SAMLMetadataDocument", 1e+07))
+	}
+	if s.SAMLProviderArn != nil && len(*s.SAMLProviderArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("SAMLProviderArn", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSAMLMetadataDocument sets the SAMLMetadataDocument field's value.
 func (s *UpdateSAMLProviderInput) SetSAMLMetadataDocument(v string) *UpdateSAMLProviderInput {
 	s.SAMLMetadataDocument = &v
@@ -27112,6 +29462,36 @@ func (s *UpdateSSHPublicKeyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateSSHPublicKeyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateSSHPublicKeyInput"}
+	if s.SSHPublicKeyId != nil && len(*s.SSHPublicKeyId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("SSHPublicKeyId", 128))
+	}
+	if s.SSHPublicKeyId != nil && !request.MatchParamPattern("[\\w]+", *s.SSHPublicKeyId) {
+		invalidParams.Add(request.NewErrParamPattern("SSHPublicKeyId", "[\\w]+"))
+	}
+	if s.Status != nil {
+		values := []string{"Active", "Inactive"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSSHPublicKeyId sets the SSHPublicKeyId field's value.
 func (s *UpdateSSHPublicKeyInput) SetSSHPublicKeyId(v string) *UpdateSSHPublicKeyInput {
 	s.SSHPublicKeyId = &v
@@ -27210,6 +29590,33 @@ func (s *UpdateServerCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateServerCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateServerCertificateInput"}
+	if s.NewPath != nil && len(*s.NewPath) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("NewPath", 512))
+	}
+	if s.NewServerCertificateName != nil && len(*s.NewServerCertificateName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("NewServerCertificateName", 128))
+	}
+	if s.NewServerCertificateName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.NewServerCertificateName) {
+		invalidParams.Add(request.NewErrParamPattern("NewServerCertificateName", "[\\w+=,.@-]+"))
+	}
+	if s.ServerCertificateName != nil && len(*s.ServerCertificateName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerCertificateName", 128))
+	}
+	if s.ServerCertificateName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.ServerCertificateName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerCertificateName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNewPath sets the NewPath field's value.
 func (s *UpdateServerCertificateInput) SetNewPath(v string) *UpdateServerCertificateInput {
 	s.NewPath = &v
@@ -27303,6 +29710,36 @@ func (s *UpdateServiceSpecificCredentialInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateServiceSpecificCredentialInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateServiceSpecificCredentialInput"}
+	if s.ServiceSpecificCredentialId != nil && len(*s.ServiceSpecificCredentialId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServiceSpecificCredentialId", 128))
+	}
+	if s.ServiceSpecificCredentialId != nil && !request.MatchParamPattern("[\\w]+", *s.ServiceSpecificCredentialId) {
+		invalidParams.Add(request.NewErrParamPattern("ServiceSpecificCredentialId", "[\\w]+"))
+	}
+	if s.Status != nil {
+		values := []string{"Active", "Inactive"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetServiceSpecificCredentialId sets the ServiceSpecificCredentialId field's value.
 func (s *UpdateServiceSpecificCredentialInput) SetServiceSpecificCredentialId(v string) *UpdateServiceSpecificCredentialInput {
 	s.ServiceSpecificCredentialId = &v
@@ -27396,6 +29833,36 @@ func (s *UpdateSigningCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateSigningCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateSigningCertificateInput"}
+	if s.CertificateId != nil && len(*s.CertificateId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateId", 128))
+	}
+	if s.CertificateId != nil && !request.MatchParamPattern("[\\w]+", *s.CertificateId) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateId", "[\\w]+"))
+	}
+	if s.Status != nil {
+		values := []string{"Active", "Inactive"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateId sets the CertificateId field's value.
 func (s *UpdateSigningCertificateInput) SetCertificateId(v string) *UpdateSigningCertificateInput {
 	s.CertificateId = &v
@@ -27494,6 +29961,33 @@ func (s *UpdateUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateUserInput"}
+	if s.NewPath != nil && len(*s.NewPath) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("NewPath", 512))
+	}
+	if s.NewUserName != nil && len(*s.NewUserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("NewUserName", 64))
+	}
+	if s.NewUserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.NewUserName) {
+		invalidParams.Add(request.NewErrParamPattern("NewUserName", "[\\w+=,.@-]+"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNewPath sets the NewPath field's value.
 func (s *UpdateUserInput) SetNewPath(v string) *UpdateUserInput {
 	s.NewPath = &v
@@ -27586,6 +30080,27 @@ func (s *UploadSSHPublicKeyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UploadSSHPublicKeyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UploadSSHPublicKeyInput"}
+	if s.SSHPublicKeyBody != nil && len(*s.SSHPublicKeyBody) > 16384 {
+		invalidParams.Add(request.NewErrParamMaxLen("SSHPublicKeyBody", 16384))
+	}
+	if s.UserName != nil && len(*s.UserName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 64))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSSHPublicKeyBody sets the SSHPublicKeyBody field's value.
 func (s *UploadSSHPublicKeyInput) SetSSHPublicKeyBody(v string) *UploadSSHPublicKeyInput {
 	s.SSHPublicKeyBody = &v
@@ -27677,7 +30192,7 @@ type UploadServerCertificateInput struct {
 	// tab (\u0009), line feed (\u000A), and carriage return (\u000D).
 	//
 	// PrivateKey is a required field
-	PrivateKey *string `min:"1" type:"string" required:"true"`
+	PrivateKey *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The name for the server certificate. Do not include the path in this value.
 	// The name of the certificate cannot contain any spaces.
@@ -27734,6 +30249,36 @@ func (s *UploadServerCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UploadServerCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UploadServerCertificateInput"}
+	if s.CertificateBody != nil && len(*s.CertificateBody) > 16384 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateBody", 16384))
+	}
+	if s.CertificateChain != nil && len(*s.CertificateChain) > 2.097152e+06 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateChain", 2.097152e+06))
+	}
+	if s.Path != nil && len(*s.Path) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("Path", 512))
+	}
+	if s.PrivateKey != nil && len(*s.PrivateKey) > 16384 {
+		invalidParams.Add(request.NewErrParamMaxLen("PrivateKey", 16384))
+	}
+	if s.ServerCertificateName != nil && len(*s.ServerCertificateName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerCertificateName", 128))
+	}
+	if s.ServerCertificateName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.ServerCertificateName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerCertificateName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateBody sets the CertificateBody field's value.
 func (s *UploadServerCertificateInput) SetCertificateBody(v string) *UploadServerCertificateInput {
 	s.CertificateBody = &v
@@ -27843,6 +30388,27 @@ func (s *UploadSigningCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UploadSigningCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UploadSigningCertificateInput"}
+	if s.CertificateBody != nil && len(*s.CertificateBody) > 16384 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateBody", 16384))
+	}
+	if s.UserName != nil && len(*s.UserName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 128))
+	}
+	if s.UserName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.UserName) {
+		invalidParams.Add(request.NewErrParamPattern("UserName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateBody sets the CertificateBody field's value.
 func (s *UploadSigningCertificateInput) SetCertificateBody(v string) *UploadSigningCertificateInput {
 	s.CertificateBody = &v
@@ -28105,7 +30671,7 @@ type VirtualMFADevice struct {
 	// The Base32StringSeed is Base64-encoded.
 	//
 	// Base32StringSeed is automatically base64 encoded/decoded by the SDK.
-	Base32StringSeed []byte `type:"blob"`
+	Base32StringSeed []byte `type:"blob" sensitive:"true"`
 
 	// The date and time on which the virtual MFA device was enabled.
 	EnableDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -28116,7 +30682,7 @@ type VirtualMFADevice struct {
 	// is the seed in Base32 format. The Base32String value is Base64-encoded.
 	//
 	// QRCodePNG is automatically base64 encoded/decoded by the SDK.
-	QRCodePNG []byte `type:"blob"`
+	QRCodePNG []byte `type:"blob" sensitive:"true"`
 
 	// The serial number associated with VirtualMFADevice.
 	//
@@ -3529,6 +3529,34 @@ func (s *AddAttributesToFindingsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddAttributesToFindingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddAttributesToFindingsInput"}
+	if s.Attributes != nil && len(s.Attributes) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Attributes", 10))
+	}
+	if s.FindingArns != nil && len(s.FindingArns) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("FindingArns", 10))
+	}
+	if s.Attributes != nil {
+		for i, v := range s.Attributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Attributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *AddAttributesToFindingsInput) SetAttributes(v []*Attribute) *AddAttributesToFindingsInput {
 	s.Attributes = v
@@ -3653,6 +3681,24 @@ func (s *AgentFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AgentFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AgentFilter"}
+	if s.AgentHealthCodes != nil && len(s.AgentHealthCodes) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("AgentHealthCodes", 10))
+	}
+	if s.AgentHealths != nil && len(s.AgentHealths) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("AgentHealths", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAgentHealthCodes sets the AgentHealthCodes field's value.
 func (s *AgentFilter) SetAgentHealthCodes(v []*string) *AgentFilter {
 	s.AgentHealthCodes = v
@@ -4047,6 +4093,32 @@ func (s *AssessmentRunFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssessmentRunFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssessmentRunFilter"}
+	if s.NamePattern != nil && len(*s.NamePattern) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("NamePattern", 140))
+	}
+	if s.RulesPackageArns != nil && len(s.RulesPackageArns) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("RulesPackageArns", 50))
+	}
+	if s.States != nil && len(s.States) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("States", 50))
+	}
+	if s.DurationRange != nil {
+		if err := s.DurationRange.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DurationRange", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCompletionTimeRange sets the CompletionTimeRange field's value.
 func (s *AssessmentRunFilter) SetCompletionTimeRange(v *TimestampRange) *AssessmentRunFilter {
 	s.CompletionTimeRange = v
@@ -4310,6 +4382,21 @@ func (s *AssessmentTargetFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssessmentTargetFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssessmentTargetFilter"}
+	if s.AssessmentTargetNamePattern != nil && len(*s.AssessmentTargetNamePattern) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentTargetNamePattern", 140))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentTargetNamePattern sets the AssessmentTargetNamePattern field's value.
 func (s *AssessmentTargetFilter) SetAssessmentTargetNamePattern(v string) *AssessmentTargetFilter {
 	s.AssessmentTargetNamePattern = &v
@@ -4463,6 +4550,29 @@ func (s *AssessmentTemplateFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssessmentTemplateFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssessmentTemplateFilter"}
+	if s.NamePattern != nil && len(*s.NamePattern) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("NamePattern", 140))
+	}
+	if s.RulesPackageArns != nil && len(s.RulesPackageArns) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("RulesPackageArns", 50))
+	}
+	if s.DurationRange != nil {
+		if err := s.DurationRange.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DurationRange", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDurationRange sets the DurationRange field's value.
 func (s *AssessmentTemplateFilter) SetDurationRange(v *DurationRange) *AssessmentTemplateFilter {
 	s.DurationRange = v
@@ -4599,6 +4709,24 @@ func (s *Attribute) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Attribute) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Attribute"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Attribute) SetKey(v string) *Attribute {
 	s.Key = &v
@@ -4660,6 +4788,24 @@ func (s *CreateAssessmentTargetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAssessmentTargetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAssessmentTargetInput"}
+	if s.AssessmentTargetName != nil && len(*s.AssessmentTargetName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentTargetName", 140))
+	}
+	if s.ResourceGroupArn != nil && len(*s.ResourceGroupArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceGroupArn", 300))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentTargetName sets the AssessmentTargetName field's value.
 func (s *CreateAssessmentTargetInput) SetAssessmentTargetName(v string) *CreateAssessmentTargetInput {
 	s.AssessmentTargetName = &v
@@ -4786,6 +4932,43 @@ func (s *CreateAssessmentTemplateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAssessmentTemplateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAssessmentTemplateInput"}
+	if s.AssessmentTargetArn != nil && len(*s.AssessmentTargetArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentTargetArn", 300))
+	}
+	if s.AssessmentTemplateName != nil && len(*s.AssessmentTemplateName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentTemplateName", 140))
+	}
+	if s.DurationInSeconds != nil && *s.DurationInSeconds > 86400 {
+		invalidParams.Add(request.NewErrParamMaxValue("DurationInSeconds", 86400))
+	}
+	if s.RulesPackageArns != nil && len(s.RulesPackageArns) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("RulesPackageArns", 50))
+	}
+	if s.UserAttributesForFindings != nil && len(s.UserAttributesForFindings) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserAttributesForFindings", 10))
+	}
+	if s.UserAttributesForFindings != nil {
+		for i, v := range s.UserAttributesForFindings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "UserAttributesForFindings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentTargetArn sets the AssessmentTargetArn field's value.
 func (s *CreateAssessmentTemplateInput) SetAssessmentTargetArn(v string) *CreateAssessmentTemplateInput {
 	s.AssessmentTargetArn = &v
@@ -4890,6 +5073,31 @@ func (s *CreateResourceGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateResourceGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateResourceGroupInput"}
+	if s.ResourceGroupTags != nil && len(s.ResourceGroupTags) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceGroupTags", 10))
+	}
+	if s.ResourceGroupTags != nil {
+		for i, v := range s.ResourceGroupTags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ResourceGroupTags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceGroupTags sets the ResourceGroupTags field's value.
 func (s *CreateResourceGroupInput) SetResourceGroupTags(v []*ResourceGroupTag) *CreateResourceGroupInput {
 	s.ResourceGroupTags = v
@@ -4958,6 +5166,21 @@ func (s *DeleteAssessmentRunInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteAssessmentRunInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteAssessmentRunInput"}
+	if s.AssessmentRunArn != nil && len(*s.AssessmentRunArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentRunArn", 300))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentRunArn sets the AssessmentRunArn field's value.
 func (s *DeleteAssessmentRunInput) SetAssessmentRunArn(v string) *DeleteAssessmentRunInput {
 	s.AssessmentRunArn = &v
@@ -5015,6 +5238,21 @@ func (s *DeleteAssessmentTargetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteAssessmentTargetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteAssessmentTargetInput"}
+	if s.AssessmentTargetArn != nil && len(*s.AssessmentTargetArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentTargetArn", 300))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentTargetArn sets the AssessmentTargetArn field's value.
 func (s *DeleteAssessmentTargetInput) SetAssessmentTargetArn(v string) *DeleteAssessmentTargetInput {
 	s.AssessmentTargetArn = &v
@@ -5072,6 +5310,21 @@ func (s *DeleteAssessmentTemplateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteAssessmentTemplateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteAssessmentTemplateInput"}
+	if s.AssessmentTemplateArn != nil && len(*s.AssessmentTemplateArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentTemplateArn", 300))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentTemplateArn sets the AssessmentTemplateArn field's value.
 func (s *DeleteAssessmentTemplateInput) SetAssessmentTemplateArn(v string) *DeleteAssessmentTemplateInput {
 	s.AssessmentTemplateArn = &v
@@ -5129,6 +5382,21 @@ func (s *DescribeAssessmentRunsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAssessmentRunsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAssessmentRunsInput"}
+	if s.AssessmentRunArns != nil && len(s.AssessmentRunArns) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentRunArns", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentRunArns sets the AssessmentRunArns field's value.
 func (s *DescribeAssessmentRunsInput) SetAssessmentRunArns(v []*string) *DescribeAssessmentRunsInput {
 	s.AssessmentRunArns = v
@@ -5209,6 +5477,21 @@ func (s *DescribeAssessmentTargetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAssessmentTargetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAssessmentTargetsInput"}
+	if s.AssessmentTargetArns != nil && len(s.AssessmentTargetArns) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentTargetArns", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentTargetArns sets the AssessmentTargetArns field's value.
 func (s *DescribeAssessmentTargetsInput) SetAssessmentTargetArns(v []*string) *DescribeAssessmentTargetsInput {
 	s.AssessmentTargetArns = v
@@ -5287,6 +5570,21 @@ func (s *DescribeAssessmentTemplatesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAssessmentTemplatesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAssessmentTemplatesInput"}
+	if s.AssessmentTemplateArns != nil && len(s.AssessmentTemplateArns) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentTemplateArns", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentTemplateArns sets the AssessmentTemplateArns field's value.
 func (s *DescribeAssessmentTemplatesInput) SetAssessmentTemplateArns(v []*string) *DescribeAssessmentTemplatesInput {
 	s.AssessmentTemplateArns = v
@@ -5436,6 +5734,27 @@ func (s *DescribeFindingsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeFindingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeFindingsInput"}
+	if s.FindingArns != nil && len(s.FindingArns) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("FindingArns", 10))
+	}
+	if s.Locale != nil {
+		values := []string{"EN_US"}
+		if !request.IsParamEnumValue(*s.Locale, values) {
+			invalidParams.Add(request.NewErrParamEnum("Locale", *s.Locale, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFindingArns sets the FindingArns field's value.
 func (s *DescribeFindingsInput) SetFindingArns(v []*string) *DescribeFindingsInput {
 	s.FindingArns = v
@@ -5522,6 +5841,21 @@ func (s *DescribeResourceGroupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeResourceGroupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeResourceGroupsInput"}
+	if s.ResourceGroupArns != nil && len(s.ResourceGroupArns) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceGroupArns", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceGroupArns sets the ResourceGroupArns field's value.
 func (s *DescribeResourceGroupsInput) SetResourceGroupArns(v []*string) *DescribeResourceGroupsInput {
 	s.ResourceGroupArns = v
@@ -5605,6 +5939,27 @@ func (s *DescribeRulesPackagesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeRulesPackagesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeRulesPackagesInput"}
+	if s.Locale != nil {
+		values := []string{"EN_US"}
+		if !request.IsParamEnumValue(*s.Locale, values) {
+			invalidParams.Add(request.NewErrParamEnum("Locale", *s.Locale, values))
+		}
+	}
+	if s.RulesPackageArns != nil && len(s.RulesPackageArns) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("RulesPackageArns", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLocale sets the Locale field's value.
 func (s *DescribeRulesPackagesInput) SetLocale(v string) *DescribeRulesPackagesInput {
 	s.Locale = &v
@@ -5694,6 +6049,24 @@ func (s *DurationRange) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DurationRange) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DurationRange"}
+	if s.MaxSeconds != nil && *s.MaxSeconds > 86400 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxSeconds", 86400))
+	}
+	if s.MinSeconds != nil && *s.MinSeconds > 86400 {
+		invalidParams.Add(request.NewErrParamMaxValue("MinSeconds", 86400))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxSeconds sets the MaxSeconds field's value.
 func (s *DurationRange) SetMaxSeconds(v int64) *DurationRange {
 	s.MaxSeconds = &v
@@ -6057,6 +6430,59 @@ func (s *FindingFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *FindingFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "FindingFilter"}
+	if s.AgentIds != nil && len(s.AgentIds) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("AgentIds", 500))
+	}
+	if s.Attributes != nil && len(s.Attributes) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Attributes", 50))
+	}
+	if s.AutoScalingGroups != nil && len(s.AutoScalingGroups) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutoScalingGroups", 20))
+	}
+	if s.RuleNames != nil && len(s.RuleNames) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleNames", 50))
+	}
+	if s.RulesPackageArns != nil && len(s.RulesPackageArns) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("RulesPackageArns", 50))
+	}
+	if s.Severities != nil && len(s.Severities) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Severities", 50))
+	}
+	if s.UserAttributes != nil && len(s.UserAttributes) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserAttributes", 50))
+	}
+	if s.Attributes != nil {
+		for i, v := range s.Attributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Attributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.UserAttributes != nil {
+		for i, v := range s.UserAttributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "UserAttributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAgentIds sets the AgentIds field's value.
 func (s *FindingFilter) SetAgentIds(v []*string) *FindingFilter {
 	s.AgentIds = v
@@ -6161,6 +6587,33 @@ func (s *GetAssessmentReportInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetAssessmentReportInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetAssessmentReportInput"}
+	if s.AssessmentRunArn != nil && len(*s.AssessmentRunArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentRunArn", 300))
+	}
+	if s.ReportFileFormat != nil {
+		values := []string{"HTML", "PDF"}
+		if !request.IsParamEnumValue(*s.ReportFileFormat, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReportFileFormat", *s.ReportFileFormat, values))
+		}
+	}
+	if s.ReportType != nil {
+		values := []string{"FINDING", "FULL"}
+		if !request.IsParamEnumValue(*s.ReportType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ReportType", *s.ReportType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentRunArn sets the AssessmentRunArn field's value.
 func (s *GetAssessmentReportInput) SetAssessmentRunArn(v string) *GetAssessmentReportInput {
 	s.AssessmentRunArn = &v
@@ -6252,6 +6705,21 @@ func (s *GetTelemetryMetadataInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetTelemetryMetadataInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTelemetryMetadataInput"}
+	if s.AssessmentRunArn != nil && len(*s.AssessmentRunArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentRunArn", 300))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentRunArn sets the AssessmentRunArn field's value.
 func (s *GetTelemetryMetadataInput) SetAssessmentRunArn(v string) *GetTelemetryMetadataInput {
 	s.AssessmentRunArn = &v
@@ -6346,6 +6814,29 @@ func (s *ListAssessmentRunAgentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAssessmentRunAgentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAssessmentRunAgentsInput"}
+	if s.AssessmentRunArn != nil && len(*s.AssessmentRunArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentRunArn", 300))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 300))
+	}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentRunArn sets the AssessmentRunArn field's value.
 func (s *ListAssessmentRunAgentsInput) SetAssessmentRunArn(v string) *ListAssessmentRunAgentsInput {
 	s.AssessmentRunArn = &v
@@ -6463,6 +6954,29 @@ func (s *ListAssessmentRunsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAssessmentRunsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAssessmentRunsInput"}
+	if s.AssessmentTemplateArns != nil && len(s.AssessmentTemplateArns) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentTemplateArns", 50))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 300))
+	}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentTemplateArns sets the AssessmentTemplateArns field's value.
 func (s *ListAssessmentRunsInput) SetAssessmentTemplateArns(v []*string) *ListAssessmentRunsInput {
 	s.AssessmentTemplateArns = v
@@ -6577,6 +7091,26 @@ func (s *ListAssessmentTargetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAssessmentTargetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAssessmentTargetsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 300))
+	}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilter sets the Filter field's value.
 func (s *ListAssessmentTargetsInput) SetFilter(v *AssessmentTargetFilter) *ListAssessmentTargetsInput {
 	s.Filter = v
@@ -6689,6 +7223,29 @@ func (s *ListAssessmentTemplatesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAssessmentTemplatesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAssessmentTemplatesInput"}
+	if s.AssessmentTargetArns != nil && len(s.AssessmentTargetArns) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentTargetArns", 50))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 300))
+	}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentTargetArns sets the AssessmentTargetArns field's value.
 func (s *ListAssessmentTemplatesInput) SetAssessmentTargetArns(v []*string) *ListAssessmentTemplatesInput {
 	s.AssessmentTargetArns = v
@@ -6796,6 +7353,24 @@ func (s *ListEventSubscriptionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListEventSubscriptionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListEventSubscriptionsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 300))
+	}
+	if s.ResourceArn != nil && len(*s.ResourceArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceArn", 300))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListEventSubscriptionsInput) SetMaxResults(v int64) *ListEventSubscriptionsInput {
 	s.MaxResults = &v
@@ -6907,6 +7482,29 @@ func (s *ListFindingsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListFindingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListFindingsInput"}
+	if s.AssessmentRunArns != nil && len(s.AssessmentRunArns) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentRunArns", 50))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 300))
+	}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentRunArns sets the AssessmentRunArns field's value.
 func (s *ListFindingsInput) SetAssessmentRunArns(v []*string) *ListFindingsInput {
 	s.AssessmentRunArns = v
@@ -7007,6 +7605,21 @@ func (s *ListRulesPackagesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListRulesPackagesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRulesPackagesInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 300))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListRulesPackagesInput) SetMaxResults(v int64) *ListRulesPackagesInput {
 	s.MaxResults = &v
@@ -7093,6 +7706,21 @@ func (s *ListTagsForResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsForResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
+	if s.ResourceArn != nil && len(*s.ResourceArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceArn", 300))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceArn sets the ResourceArn field's value.
 func (s *ListTagsForResourceInput) SetResourceArn(v string) *ListTagsForResourceInput {
 	s.ResourceArn = &v
@@ -7174,6 +7802,24 @@ func (s *PreviewAgentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PreviewAgentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PreviewAgentsInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 300))
+	}
+	if s.PreviewAgentsArn != nil && len(*s.PreviewAgentsArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("PreviewAgentsArn", 300))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *PreviewAgentsInput) SetMaxResults(v int64) *PreviewAgentsInput {
 	s.MaxResults = &v
@@ -7267,6 +7913,21 @@ func (s *RegisterCrossAccountAccessRoleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterCrossAccountAccessRoleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterCrossAccountAccessRoleInput"}
+	if s.RoleArn != nil && len(*s.RoleArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleArn", 300))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRoleArn sets the RoleArn field's value.
 func (s *RegisterCrossAccountAccessRoleInput) SetRoleArn(v string) *RegisterCrossAccountAccessRoleInput {
 	s.RoleArn = &v
@@ -7332,6 +7993,24 @@ func (s *RemoveAttributesFromFindingsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveAttributesFromFindingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveAttributesFromFindingsInput"}
+	if s.AttributeKeys != nil && len(s.AttributeKeys) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("AttributeKeys", 10))
+	}
+	if s.FindingArns != nil && len(s.FindingArns) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("FindingArns", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeKeys sets the AttributeKeys field's value.
 func (s *RemoveAttributesFromFindingsInput) SetAttributeKeys(v []*string) *RemoveAttributesFromFindingsInput {
 	s.AttributeKeys = v
@@ -7467,6 +8146,24 @@ func (s *ResourceGroupTag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResourceGroupTag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResourceGroupTag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *ResourceGroupTag) SetKey(v string) *ResourceGroupTag {
 	s.Key = &v
@@ -7644,6 +8341,34 @@ func (s *SetTagsForResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetTagsForResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetTagsForResourceInput"}
+	if s.ResourceArn != nil && len(*s.ResourceArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceArn", 300))
+	}
+	if s.Tags != nil && len(s.Tags) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 10))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceArn sets the ResourceArn field's value.
 func (s *SetTagsForResourceInput) SetResourceArn(v string) *SetTagsForResourceInput {
 	s.ResourceArn = &v
@@ -7715,6 +8440,24 @@ func (s *StartAssessmentRunInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartAssessmentRunInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartAssessmentRunInput"}
+	if s.AssessmentRunName != nil && len(*s.AssessmentRunName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentRunName", 140))
+	}
+	if s.AssessmentTemplateArn != nil && len(*s.AssessmentTemplateArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentTemplateArn", 300))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentRunName sets the AssessmentRunName field's value.
 func (s *StartAssessmentRunInput) SetAssessmentRunName(v string) *StartAssessmentRunInput {
 	s.AssessmentRunName = &v
@@ -7796,6 +8539,27 @@ func (s *StopAssessmentRunInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopAssessmentRunInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopAssessmentRunInput"}
+	if s.AssessmentRunArn != nil && len(*s.AssessmentRunArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentRunArn", 300))
+	}
+	if s.StopAction != nil {
+		values := []string{"START_EVALUATION", "SKIP_EVALUATION"}
+		if !request.IsParamEnumValue(*s.StopAction, values) {
+			invalidParams.Add(request.NewErrParamEnum("StopAction", *s.StopAction, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentRunArn sets the AssessmentRunArn field's value.
 func (s *StopAssessmentRunInput) SetAssessmentRunArn(v string) *StopAssessmentRunInput {
 	s.AssessmentRunArn = &v
@@ -7879,6 +8643,30 @@ func (s *SubscribeToEventInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SubscribeToEventInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SubscribeToEventInput"}
+	if s.Event != nil {
+		values := []string{"ASSESSMENT_RUN_STARTED", "ASSESSMENT_RUN_COMPLETED", "ASSESSMENT_RUN_STATE_CHANGED", "FINDING_REPORTED", "OTHER"}
+		if !request.IsParamEnumValue(*s.Event, values) {
+			invalidParams.Add(request.NewErrParamEnum("Event", *s.Event, values))
+		}
+	}
+	if s.ResourceArn != nil && len(*s.ResourceArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceArn", 300))
+	}
+	if s.TopicArn != nil && len(*s.TopicArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("TopicArn", 300))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEvent sets the Event field's value.
 func (s *SubscribeToEventInput) SetEvent(v string) *SubscribeToEventInput {
 	s.Event = &v
@@ -8009,6 +8797,24 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -8160,6 +8966,30 @@ func (s *UnsubscribeFromEventInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UnsubscribeFromEventInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UnsubscribeFromEventInput"}
+	if s.Event != nil {
+		values := []string{"ASSESSMENT_RUN_STARTED", "ASSESSMENT_RUN_COMPLETED", "ASSESSMENT_RUN_STATE_CHANGED", "FINDING_REPORTED", "OTHER"}
+		if !request.IsParamEnumValue(*s.Event, values) {
+			invalidParams.Add(request.NewErrParamEnum("Event", *s.Event, values))
+		}
+	}
+	if s.ResourceArn != nil && len(*s.ResourceArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceArn", 300))
+	}
+	if s.TopicArn != nil && len(*s.TopicArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("TopicArn", 300))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEvent sets the Event field's value.
 func (s *UnsubscribeFromEventInput) SetEvent(v string) *UnsubscribeFromEventInput {
 	s.Event = &v
@@ -8252,6 +9082,27 @@ func (s *UpdateAssessmentTargetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateAssessmentTargetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateAssessmentTargetInput"}
+	if s.AssessmentTargetArn != nil && len(*s.AssessmentTargetArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentTargetArn", 300))
+	}
+	if s.AssessmentTargetName != nil && len(*s.AssessmentTargetName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssessmentTargetName", 140))
+	}
+	if s.ResourceGroupArn != nil && len(*s.ResourceGroupArn) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceGroupArn", 300))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssessmentTargetArn sets the AssessmentTargetArn field's value.
 func (s *UpdateAssessmentTargetInput) SetAssessmentTargetArn(v string) *UpdateAssessmentTargetInput {
 	s.AssessmentTargetArn = &v
@@ -563,9 +563,10 @@ const opCreateKeysAndCertificate = "CreateKeysAndCertificate"
 //    }
 func (c *IoT) CreateKeysAndCertificateRequest(input *CreateKeysAndCertificateInput) (req *request.Request, output *CreateKeysAndCertificateOutput) {
 	op := &request.Operation{
-		Name:       opCreateKeysAndCertificate,
-		HTTPMethod: "POST",
-		HTTPPath:   "/keys-and-certificate",
+		Name:                 opCreateKeysAndCertificate,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/keys-and-certificate",
+		SensitiveOutputPaths: []string{"keyPair.PrivateKey"},
 	}
 
 	if input == nil {
@@ -5398,6 +5399,24 @@ func (s *AcceptCertificateTransferInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AcceptCertificateTransferInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AcceptCertificateTransferInput"}
+	if s.CertificateId != nil && len(*s.CertificateId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateId", 64))
+	}
+	if s.CertificateId != nil && !request.MatchParamPattern("(0x)?[a-fA-F0-9]+", *s.CertificateId) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateId", "(0x)?[a-fA-F0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateId sets the CertificateId field's value.
 func (s *AcceptCertificateTransferInput) SetCertificateId(v string) *AcceptCertificateTransferInput {
 	s.CertificateId = &v
@@ -5577,6 +5596,48 @@ func (s *Action) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Action) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Action"}
+	if s.DynamoDB != nil {
+		if err := s.DynamoDB.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DynamoDB", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Elasticsearch != nil {
+		if err := s.Elasticsearch.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Elasticsearch", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Firehose != nil {
+		if err := s.Firehose.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Firehose", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3 != nil {
+		if err := s.S3.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Salesforce != nil {
+		if err := s.Salesforce.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Salesforce", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Sns != nil {
+		if err := s.Sns.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Sns", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCloudwatchAlarm sets the CloudwatchAlarm field's value.
 func (s *Action) SetCloudwatchAlarm(v *CloudwatchAlarmAction) *Action {
 	s.CloudwatchAlarm = v
@@ -5779,6 +5840,24 @@ func (s *AttachPrincipalPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttachPrincipalPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttachPrincipalPolicyInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyName sets the PolicyName field's value.
 func (s *AttachPrincipalPolicyInput) SetPolicyName(v string) *AttachPrincipalPolicyInput {
 	s.PolicyName = &v
@@ -5871,6 +5950,24 @@ func (s *AttachThingPrincipalInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttachThingPrincipalInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttachThingPrincipalInput"}
+	if s.ThingName != nil && len(*s.ThingName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingName", 128))
+	}
+	if s.ThingName != nil && !request.MatchParamPattern("[a-zA-Z0-9:_-]+", *s.ThingName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingName", "[a-zA-Z0-9:_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPrincipal sets the Principal field's value.
 func (s *AttachThingPrincipalInput) SetPrincipal(v string) *AttachThingPrincipalInput {
 	s.Principal = &v
@@ -6220,6 +6317,24 @@ func (s *CancelCertificateTransferInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CancelCertificateTransferInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelCertificateTransferInput"}
+	if s.CertificateId != nil && len(*s.CertificateId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateId", 64))
+	}
+	if s.CertificateId != nil && !request.MatchParamPattern("(0x)?[a-fA-F0-9]+", *s.CertificateId) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateId", "(0x)?[a-fA-F0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateId sets the CertificateId field's value.
 func (s *CancelCertificateTransferInput) SetCertificateId(v string) *CancelCertificateTransferInput {
 	s.CertificateId = &v
@@ -7043,6 +7158,24 @@ func (s *CreatePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePolicyInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyDocument sets the PolicyDocument field's value.
 func (s *CreatePolicyInput) SetPolicyDocument(v string) *CreatePolicyInput {
 	s.PolicyDocument = &v
@@ -7198,6 +7331,24 @@ func (s *CreatePolicyVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePolicyVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePolicyVersionInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyDocument sets the PolicyDocument field's value.
 func (s *CreatePolicyVersionInput) SetPolicyDocument(v string) *CreatePolicyVersionInput {
 	s.PolicyDocument = &v
@@ -7362,6 +7513,30 @@ func (s *CreateThingInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateThingInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateThingInput"}
+	if s.ThingName != nil && len(*s.ThingName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingName", 128))
+	}
+	if s.ThingName != nil && !request.MatchParamPattern("[a-zA-Z0-9:_-]+", *s.ThingName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingName", "[a-zA-Z0-9:_-]+"))
+	}
+	if s.ThingTypeName != nil && len(*s.ThingTypeName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingTypeName", 128))
+	}
+	if s.ThingTypeName != nil && !request.MatchParamPattern("[a-zA-Z0-9:_-]+", *s.ThingTypeName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingTypeName", "[a-zA-Z0-9:_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributePayload sets the AttributePayload field's value.
 func (s *CreateThingInput) SetAttributePayload(v *AttributePayload) *CreateThingInput {
 	s.AttributePayload = v
@@ -7491,6 +7666,29 @@ func (s *CreateThingTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateThingTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateThingTypeInput"}
+	if s.ThingTypeName != nil && len(*s.ThingTypeName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingTypeName", 128))
+	}
+	if s.ThingTypeName != nil && !request.MatchParamPattern("[a-zA-Z0-9:_-]+", *s.ThingTypeName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingTypeName", "[a-zA-Z0-9:_-]+"))
+	}
+	if s.ThingTypeProperties != nil {
+		if err := s.ThingTypeProperties.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ThingTypeProperties", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetThingTypeName sets the ThingTypeName field's value.
 func (s *CreateThingTypeInput) SetThingTypeName(v string) *CreateThingTypeInput {
 	s.ThingTypeName = &v
@@ -7617,6 +7815,29 @@ func (s *CreateTopicRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateTopicRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTopicRuleInput"}
+	if s.RuleName != nil && len(*s.RuleName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleName", 128))
+	}
+	if s.RuleName != nil && !request.MatchParamPattern("^[a-zA-Z0-9_]+$", *s.RuleName) {
+		invalidParams.Add(request.NewErrParamPattern("RuleName", "^[a-zA-Z0-9_]+$"))
+	}
+	if s.TopicRulePayload != nil {
+		if err := s.TopicRulePayload.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TopicRulePayload", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRuleName sets the RuleName field's value.
 func (s *CreateTopicRuleInput) SetRuleName(v string) *CreateTopicRuleInput {
 	s.RuleName = &v
@@ -7701,6 +7922,24 @@ func (s *DeleteCACertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteCACertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteCACertificateInput"}
+	if s.CertificateId != nil && len(*s.CertificateId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateId", 64))
+	}
+	if s.CertificateId != nil && !request.MatchParamPattern("(0x)?[a-fA-F0-9]+", *s.CertificateId) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateId", "(0x)?[a-fA-F0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateId sets the CertificateId field's value.
 func (s *DeleteCACertificateInput) SetCertificateId(v string) *DeleteCACertificateInput {
 	s.CertificateId = &v
@@ -7775,6 +8014,24 @@ func (s *DeleteCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteCertificateInput"}
+	if s.CertificateId != nil && len(*s.CertificateId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateId", 64))
+	}
+	if s.CertificateId != nil && !request.MatchParamPattern("(0x)?[a-fA-F0-9]+", *s.CertificateId) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateId", "(0x)?[a-fA-F0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateId sets the CertificateId field's value.
 func (s *DeleteCertificateInput) SetCertificateId(v string) *DeleteCertificateInput {
 	s.CertificateId = &v
@@ -7848,6 +8105,24 @@ func (s *DeletePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeletePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePolicyInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyName sets the PolicyName field's value.
 func (s *DeletePolicyInput) SetPolicyName(v string) *DeletePolicyInput {
 	s.PolicyName = &v
@@ -7929,6 +8204,27 @@ func (s *DeletePolicyVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeletePolicyVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePolicyVersionInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+	if s.PolicyVersionId != nil && !request.MatchParamPattern("[0-9]+", *s.PolicyVersionId) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyVersionId", "[0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyName sets the PolicyName field's value.
 func (s *DeletePolicyVersionInput) SetPolicyName(v string) *DeletePolicyVersionInput {
 	s.PolicyName = &v
@@ -8060,6 +8356,24 @@ func (s *DeleteThingInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteThingInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteThingInput"}
+	if s.ThingName != nil && len(*s.ThingName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingName", 128))
+	}
+	if s.ThingName != nil && !request.MatchParamPattern("[a-zA-Z0-9:_-]+", *s.ThingName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingName", "[a-zA-Z0-9:_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExpectedVersion sets the ExpectedVersion field's value.
 func (s *DeleteThingInput) SetExpectedVersion(v int64) *DeleteThingInput {
 	s.ExpectedVersion = &v
@@ -8145,6 +8459,24 @@ func (s *DeleteThingTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteThingTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteThingTypeInput"}
+	if s.ThingTypeName != nil && len(*s.ThingTypeName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingTypeName", 128))
+	}
+	if s.ThingTypeName != nil && !request.MatchParamPattern("[a-zA-Z0-9:_-]+", *s.ThingTypeName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingTypeName", "[a-zA-Z0-9:_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetThingTypeName sets the ThingTypeName field's value.
 func (s *DeleteThingTypeInput) SetThingTypeName(v string) *DeleteThingTypeInput {
 	s.ThingTypeName = &v
@@ -8219,6 +8551,24 @@ func (s *DeleteTopicRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteTopicRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTopicRuleInput"}
+	if s.RuleName != nil && len(*s.RuleName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleName", 128))
+	}
+	if s.RuleName != nil && !request.MatchParamPattern("^[a-zA-Z0-9_]+$", *s.RuleName) {
+		invalidParams.Add(request.NewErrParamPattern("RuleName", "^[a-zA-Z0-9_]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRuleName sets the RuleName field's value.
 func (s *DeleteTopicRuleInput) SetRuleName(v string) *DeleteTopicRuleInput {
 	s.RuleName = &v
@@ -8296,6 +8646,24 @@ func (s *DeprecateThingTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeprecateThingTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeprecateThingTypeInput"}
+	if s.ThingTypeName != nil && len(*s.ThingTypeName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingTypeName", 128))
+	}
+	if s.ThingTypeName != nil && !request.MatchParamPattern("[a-zA-Z0-9:_-]+", *s.ThingTypeName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingTypeName", "[a-zA-Z0-9:_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetThingTypeName sets the ThingTypeName field's value.
 func (s *DeprecateThingTypeInput) SetThingTypeName(v string) *DeprecateThingTypeInput {
 	s.ThingTypeName = &v
@@ -8381,6 +8749,24 @@ func (s *DescribeCACertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeCACertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeCACertificateInput"}
+	if s.CertificateId != nil && len(*s.CertificateId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateId", 64))
+	}
+	if s.CertificateId != nil && !request.MatchParamPattern("(0x)?[a-fA-F0-9]+", *s.CertificateId) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateId", "(0x)?[a-fA-F0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateId sets the CertificateId field's value.
 func (s *DescribeCACertificateInput) SetCertificateId(v string) *DescribeCACertificateInput {
 	s.CertificateId = &v
@@ -8469,6 +8855,24 @@ func (s *DescribeCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeCertificateInput"}
+	if s.CertificateId != nil && len(*s.CertificateId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateId", 64))
+	}
+	if s.CertificateId != nil && !request.MatchParamPattern("(0x)?[a-fA-F0-9]+", *s.CertificateId) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateId", "(0x)?[a-fA-F0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateId sets the CertificateId field's value.
 func (s *DescribeCertificateInput) SetCertificateId(v string) *DescribeCertificateInput {
 	s.CertificateId = &v
@@ -8613,6 +9017,24 @@ func (s *DescribeThingInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeThingInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeThingInput"}
+	if s.ThingName != nil && len(*s.ThingName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingName", 128))
+	}
+	if s.ThingName != nil && !request.MatchParamPattern("[a-zA-Z0-9:_-]+", *s.ThingName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingName", "[a-zA-Z0-9:_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetThingName sets the ThingName field's value.
 func (s *DescribeThingInput) SetThingName(v string) *DescribeThingInput {
 	s.ThingName = &v
@@ -8761,6 +9183,24 @@ func (s *DescribeThingTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeThingTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeThingTypeInput"}
+	if s.ThingTypeName != nil && len(*s.ThingTypeName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingTypeName", 128))
+	}
+	if s.ThingTypeName != nil && !request.MatchParamPattern("[a-zA-Z0-9:_-]+", *s.ThingTypeName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingTypeName", "[a-zA-Z0-9:_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetThingTypeName sets the ThingTypeName field's value.
 func (s *DescribeThingTypeInput) SetThingTypeName(v string) *DescribeThingTypeInput {
 	s.ThingTypeName = &v
@@ -8891,9 +9331,27 @@ func (s *DetachPrincipalPolicyInput) Validate() error {
 	return nil
 }
 
-// SetPolicyName sets the PolicyName field's value.
-func (s *DetachPrincipalPolicyInput) SetPolicyName(v string) *DetachPrincipalPolicyInput {
-	s.PolicyName = &v
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetachPrincipalPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetachPrincipalPolicyInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetPolicyName sets the PolicyName field's value.
+func (s *DetachPrincipalPolicyInput) SetPolicyName(v string) *DetachPrincipalPolicyInput {
+	s.PolicyName = &v
 	return s
 }
 
@@ -8985,6 +9443,24 @@ func (s *DetachThingPrincipalInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetachThingPrincipalInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetachThingPrincipalInput"}
+	if s.ThingName != nil && len(*s.ThingName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingName", 128))
+	}
+	if s.ThingName != nil && !request.MatchParamPattern("[a-zA-Z0-9:_-]+", *s.ThingName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingName", "[a-zA-Z0-9:_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPrincipal sets the Principal field's value.
 func (s *DetachThingPrincipalInput) SetPrincipal(v string) *DetachThingPrincipalInput {
 	s.Principal = &v
@@ -9070,6 +9546,24 @@ func (s *DisableTopicRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisableTopicRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableTopicRuleInput"}
+	if s.RuleName != nil && len(*s.RuleName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleName", 128))
+	}
+	if s.RuleName != nil && !request.MatchParamPattern("^[a-zA-Z0-9_]+$", *s.RuleName) {
+		invalidParams.Add(request.NewErrParamPattern("RuleName", "^[a-zA-Z0-9_]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRuleName sets the RuleName field's value.
 func (s *DisableTopicRuleInput) SetRuleName(v string) *DisableTopicRuleInput {
 	s.RuleName = &v
@@ -9200,6 +9694,30 @@ func (s *DynamoDBAction) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DynamoDBAction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DynamoDBAction"}
+	if s.HashKeyType != nil {
+		values := []string{"STRING", "NUMBER"}
+		if !request.IsParamEnumValue(*s.HashKeyType, values) {
+			invalidParams.Add(request.NewErrParamEnum("HashKeyType", *s.HashKeyType, values))
+		}
+	}
+	if s.RangeKeyType != nil {
+		values := []string{"STRING", "NUMBER"}
+		if !request.IsParamEnumValue(*s.RangeKeyType, values) {
+			invalidParams.Add(request.NewErrParamEnum("RangeKeyType", *s.RangeKeyType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHashKeyField sets the HashKeyField field's value.
 func (s *DynamoDBAction) SetHashKeyField(v string) *DynamoDBAction {
 	s.HashKeyField = &v
@@ -9455,6 +9973,21 @@ func (s *ElasticsearchAction) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ElasticsearchAction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ElasticsearchAction"}
+	if s.Endpoint != nil && !request.MatchParamPattern("https?://.*", *s.Endpoint) {
+		invalidParams.Add(request.NewErrParamPattern("Endpoint", "https?://.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEndpoint sets the Endpoint field's value.
 func (s *ElasticsearchAction) SetEndpoint(v string) *ElasticsearchAction {
 	s.Endpoint = &v
@@ -9552,6 +10085,24 @@ func (s *EnableTopicRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnableTopicRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableTopicRuleInput"}
+	if s.RuleName != nil && len(*s.RuleName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleName", 128))
+	}
+	if s.RuleName != nil && !request.MatchParamPattern("^[a-zA-Z0-9_]+$", *s.RuleName) {
+		invalidParams.Add(request.NewErrParamPattern("RuleName", "^[a-zA-Z0-9_]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRuleName sets the RuleName field's value.
 func (s *EnableTopicRuleInput) SetRuleName(v string) *EnableTopicRuleInput {
 	s.RuleName = &v
@@ -9635,6 +10186,21 @@ func (s *FirehoseAction) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *FirehoseAction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "FirehoseAction"}
+	if s.Separator != nil && !request.MatchParamPattern("([\\n\\t])|(\\r\\n)|(,)", *s.Separator) {
+		invalidParams.Add(request.NewErrParamPattern("Separator", "([\\n\\t])|(\\r\\n)|(,)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeliveryStreamName sets the DeliveryStreamName field's value.
 func (s *FirehoseAction) SetDeliveryStreamName(v string) *FirehoseAction {
 	s.DeliveryStreamName = &v
@@ -9780,6 +10346,24 @@ func (s *GetPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPolicyInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyName sets the PolicyName field's value.
 func (s *GetPolicyInput) SetPolicyName(v string) *GetPolicyInput {
 	s.PolicyName = &v
@@ -9918,6 +10502,27 @@ func (s *GetPolicyVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetPolicyVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPolicyVersionInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+	if s.PolicyVersionId != nil && !request.MatchParamPattern("[0-9]+", *s.PolicyVersionId) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyVersionId", "[0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyName sets the PolicyName field's value.
 func (s *GetPolicyVersionInput) SetPolicyName(v string) *GetPolicyVersionInput {
 	s.PolicyName = &v
@@ -10129,6 +10734,24 @@ func (s *GetTopicRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetTopicRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTopicRuleInput"}
+	if s.RuleName != nil && len(*s.RuleName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleName", 128))
+	}
+	if s.RuleName != nil && !request.MatchParamPattern("^[a-zA-Z0-9_]+$", *s.RuleName) {
+		invalidParams.Add(request.NewErrParamPattern("RuleName", "^[a-zA-Z0-9_]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRuleName sets the RuleName field's value.
 func (s *GetTopicRuleInput) SetRuleName(v string) *GetTopicRuleInput {
 	s.RuleName = &v
@@ -10200,7 +10823,7 @@ type KeyPair struct {
 	_ struct{} `type:"structure"`
 
 	// The private key.
-	PrivateKey *string `min:"1" type:"string"`
+	PrivateKey *string `min:"1" type:"string" sensitive:"true"`
 
 	// The public key.
 	PublicKey *string `min:"1" type:"string"`
@@ -10233,7 +10856,7 @@ func (s *KeyPair) MarshalFields(e protocol.FieldEncoder) error {
 	if s.PrivateKey != nil {
 		v := *s.PrivateKey
 
-		e.SetValue(protocol.BodyTarget, "PrivateKey", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "PrivateKey", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.PublicKey != nil {
 		v := *s.PublicKey
@@ -10414,6 +11037,21 @@ func (s *ListCACertificatesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListCACertificatesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListCACertificatesInput"}
+	if s.PageSize != nil && *s.PageSize > 250 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 250))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAscendingOrder sets the AscendingOrder field's value.
 func (s *ListCACertificatesInput) SetAscendingOrder(v bool) *ListCACertificatesInput {
 	s.AscendingOrder = &v
@@ -10552,6 +11190,27 @@ func (s *ListCertificatesByCAInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListCertificatesByCAInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListCertificatesByCAInput"}
+	if s.CaCertificateId != nil && len(*s.CaCertificateId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("CaCertificateId", 64))
+	}
+	if s.CaCertificateId != nil && !request.MatchParamPattern("(0x)?[a-fA-F0-9]+", *s.CaCertificateId) {
+		invalidParams.Add(request.NewErrParamPattern("CaCertificateId", "(0x)?[a-fA-F0-9]+"))
+	}
+	if s.PageSize != nil && *s.PageSize > 250 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 250))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAscendingOrder sets the AscendingOrder field's value.
 func (s *ListCertificatesByCAInput) SetAscendingOrder(v bool) *ListCertificatesByCAInput {
 	s.AscendingOrder = &v
@@ -10690,6 +11349,21 @@ func (s *ListCertificatesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListCertificatesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListCertificatesInput"}
+	if s.PageSize != nil && *s.PageSize > 250 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 250))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAscendingOrder sets the AscendingOrder field's value.
 func (s *ListCertificatesInput) SetAscendingOrder(v bool) *ListCertificatesInput {
 	s.AscendingOrder = &v
@@ -10817,6 +11491,21 @@ func (s *ListOutgoingCertificatesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListOutgoingCertificatesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListOutgoingCertificatesInput"}
+	if s.PageSize != nil && *s.PageSize > 250 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 250))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAscendingOrder sets the AscendingOrder field's value.
 func (s *ListOutgoingCertificatesInput) SetAscendingOrder(v bool) *ListOutgoingCertificatesInput {
 	s.AscendingOrder = &v
@@ -10943,6 +11632,21 @@ func (s *ListPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPoliciesInput"}
+	if s.PageSize != nil && *s.PageSize > 250 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 250))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAscendingOrder sets the AscendingOrder field's value.
 func (s *ListPoliciesInput) SetAscendingOrder(v bool) *ListPoliciesInput {
 	s.AscendingOrder = &v
@@ -11081,6 +11785,27 @@ func (s *ListPolicyPrincipalsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPolicyPrincipalsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPolicyPrincipalsInput"}
+	if s.PageSize != nil && *s.PageSize > 250 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 250))
+	}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAscendingOrder sets the AscendingOrder field's value.
 func (s *ListPolicyPrincipalsInput) SetAscendingOrder(v bool) *ListPolicyPrincipalsInput {
 	s.AscendingOrder = &v
@@ -11217,6 +11942,24 @@ func (s *ListPolicyVersionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPolicyVersionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPolicyVersionsInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyName sets the PolicyName field's value.
 func (s *ListPolicyVersionsInput) SetPolicyName(v string) *ListPolicyVersionsInput {
 	s.PolicyName = &v
@@ -11315,6 +12058,21 @@ func (s *ListPrincipalPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPrincipalPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPrincipalPoliciesInput"}
+	if s.PageSize != nil && *s.PageSize > 250 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 250))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAscendingOrder sets the AscendingOrder field's value.
 func (s *ListPrincipalPoliciesInput) SetAscendingOrder(v bool) *ListPrincipalPoliciesInput {
 	s.AscendingOrder = &v
@@ -11458,6 +12216,21 @@ func (s *ListPrincipalThingsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPrincipalThingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPrincipalThingsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 250 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 250))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListPrincipalThingsInput) SetMaxResults(v int64) *ListPrincipalThingsInput {
 	s.MaxResults = &v
@@ -11583,6 +12356,24 @@ func (s *ListThingPrincipalsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListThingPrincipalsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListThingPrincipalsInput"}
+	if s.ThingName != nil && len(*s.ThingName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingName", 128))
+	}
+	if s.ThingName != nil && !request.MatchParamPattern("[a-zA-Z0-9:_-]+", *s.ThingName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingName", "[a-zA-Z0-9:_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetThingName sets the ThingName field's value.
 func (s *ListThingPrincipalsInput) SetThingName(v string) *ListThingPrincipalsInput {
 	s.ThingName = &v
@@ -11676,6 +12467,27 @@ func (s *ListThingTypesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListThingTypesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListThingTypesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 250 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 250))
+	}
+	if s.ThingTypeName != nil && len(*s.ThingTypeName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingTypeName", 128))
+	}
+	if s.ThingTypeName != nil && !request.MatchParamPattern("[a-zA-Z0-9:_-]+", *s.ThingTypeName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingTypeName", "[a-zA-Z0-9:_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListThingTypesInput) SetMaxResults(v int64) *ListThingTypesInput {
 	s.MaxResults = &v
@@ -11812,6 +12624,39 @@ func (s *ListThingsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListThingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListThingsInput"}
+	if s.AttributeName != nil && len(*s.AttributeName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("AttributeName", 128))
+	}
+	if s.AttributeName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.,@/:#-]+", *s.AttributeName) {
+		invalidParams.Add(request.NewErrParamPattern("AttributeName", "[a-zA-Z0-9_.,@/:#-]+"))
+	}
+	if s.AttributeValue != nil && len(*s.AttributeValue) > 800 {
+		invalidParams.Add(request.NewErrParamMaxLen("AttributeValue", 800))
+	}
+	if s.AttributeValue != nil && !request.MatchParamPattern("[a-zA-Z0-9_.,@/:#-]*", *s.AttributeValue) {
+		invalidParams.Add(request.NewErrParamPattern("AttributeValue", "[a-zA-Z0-9_.,@/:#-]*"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 250 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 250))
+	}
+	if s.ThingTypeName != nil && len(*s.ThingTypeName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingTypeName", 128))
+	}
+	if s.ThingTypeName != nil && !request.MatchParamPattern("[a-zA-Z0-9:_-]+", *s.ThingTypeName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingTypeName", "[a-zA-Z0-9:_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeName sets the AttributeName field's value.
 func (s *ListThingsInput) SetAttributeName(v string) *ListThingsInput {
 	s.AttributeName = &v
@@ -11963,6 +12808,21 @@ func (s *ListTopicRulesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTopicRulesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTopicRulesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 10000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 10000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListTopicRulesInput) SetMaxResults(v int64) *ListTopicRulesInput {
 	s.MaxResults = &v
@@ -12098,6 +12958,24 @@ func (s *LoggingOptionsPayload) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LoggingOptionsPayload) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LoggingOptionsPayload"}
+	if s.LogLevel != nil {
+		values := []string{"DEBUG", "INFO", "ERROR", "WARN", "DISABLED"}
+		if !request.IsParamEnumValue(*s.LogLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("LogLevel", *s.LogLevel, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLogLevel sets the LogLevel field's value.
 func (s *LoggingOptionsPayload) SetLogLevel(v string) *LoggingOptionsPayload {
 	s.LogLevel = &v
@@ -12471,6 +13349,24 @@ func (s *RegisterCACertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterCACertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterCACertificateInput"}
+	if s.CaCertificate != nil && len(*s.CaCertificate) > 65536 {
+		invalidParams.Add(request.NewErrParamMaxLen("CaCertificate", 65536))
+	}
+	if s.VerificationCertificate != nil && len(*s.VerificationCertificate) > 65536 {
+		invalidParams.Add(request.NewErrParamMaxLen("VerificationCertificate", 65536))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllowAutoRegistration sets the AllowAutoRegistration field's value.
 func (s *RegisterCACertificateInput) SetAllowAutoRegistration(v bool) *RegisterCACertificateInput {
 	s.AllowAutoRegistration = &v
@@ -12618,6 +13514,30 @@ func (s *RegisterCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterCertificateInput"}
+	if s.CaCertificatePem != nil && len(*s.CaCertificatePem) > 65536 {
+		invalidParams.Add(request.NewErrParamMaxLen("CaCertificatePem", 65536))
+	}
+	if s.CertificatePem != nil && len(*s.CertificatePem) > 65536 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificatePem", 65536))
+	}
+	if s.Status != nil {
+		values := []string{"ACTIVE", "INACTIVE", "REVOKED", "PENDING_TRANSFER", "REGISTER_INACTIVE", "PENDING_ACTIVATION"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCaCertificatePem sets the CaCertificatePem field's value.
 func (s *RegisterCertificateInput) SetCaCertificatePem(v string) *RegisterCertificateInput {
 	s.CaCertificatePem = &v
@@ -12756,6 +13676,27 @@ func (s *RejectCertificateTransferInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RejectCertificateTransferInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RejectCertificateTransferInput"}
+	if s.CertificateId != nil && len(*s.CertificateId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateId", 64))
+	}
+	if s.CertificateId != nil && !request.MatchParamPattern("(0x)?[a-fA-F0-9]+", *s.CertificateId) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateId", "(0x)?[a-fA-F0-9]+"))
+	}
+	if s.RejectReason != nil && len(*s.RejectReason) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RejectReason", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateId sets the CertificateId field's value.
 func (s *RejectCertificateTransferInput) SetCertificateId(v string) *RejectCertificateTransferInput {
 	s.CertificateId = &v
@@ -12853,6 +13794,29 @@ func (s *ReplaceTopicRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReplaceTopicRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReplaceTopicRuleInput"}
+	if s.RuleName != nil && len(*s.RuleName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleName", 128))
+	}
+	if s.RuleName != nil && !request.MatchParamPattern("^[a-zA-Z0-9_]+$", *s.RuleName) {
+		invalidParams.Add(request.NewErrParamPattern("RuleName", "^[a-zA-Z0-9_]+$"))
+	}
+	if s.TopicRulePayload != nil {
+		if err := s.TopicRulePayload.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TopicRulePayload", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRuleName sets the RuleName field's value.
 func (s *ReplaceTopicRuleInput) SetRuleName(v string) *ReplaceTopicRuleInput {
 	s.RuleName = &v
@@ -13023,6 +13987,24 @@ func (s *S3Action) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *S3Action) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "S3Action"}
+	if s.CannedAcl != nil {
+		values := []string{"private", "public-read", "public-read-write", "aws-exec-read", "authenticated-read", "bucket-owner-read", "bucket-owner-full-control", "log-delivery-write"}
+		if !request.IsParamEnumValue(*s.CannedAcl, values) {
+			invalidParams.Add(request.NewErrParamEnum("CannedAcl", *s.CannedAcl, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucketName sets the BucketName field's value.
 func (s *S3Action) SetBucketName(v string) *S3Action {
 	s.BucketName = &v
@@ -13120,6 +14102,24 @@ func (s *SalesforceAction) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SalesforceAction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SalesforceAction"}
+	if s.Url != nil && len(*s.Url) > 2000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Url", 2000))
+	}
+	if s.Url != nil && !request.MatchParamPattern("https://ingestion-[a-zA-Z0-9]{1,12}\\.[a-zA-Z0-9]+\\.((sfdc-matrix\\.net)|(sfdcnow\\.com))/streams/\\w{1,20}/\\w{1,20}/event", *s.Url) {
+		invalidParams.Add(request.NewErrParamPattern("Url", "https://ingestion-[a-zA-Z0-9]{1,12}\\.[a-zA-Z0-9]+\\.((sfdc-matrix\\.net)|(sfdcnow\\.com))/streams/\\w{1,20}/\\w{1,20}/event"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetToken sets the Token field's value.
 func (s *SalesforceAction) SetToken(v string) *SalesforceAction {
 	s.Token = &v
@@ -13192,6 +14192,27 @@ func (s *SetDefaultPolicyVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetDefaultPolicyVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetDefaultPolicyVersionInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w+=,.@-]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w+=,.@-]+"))
+	}
+	if s.PolicyVersionId != nil && !request.MatchParamPattern("[0-9]+", *s.PolicyVersionId) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyVersionId", "[0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyName sets the PolicyName field's value.
 func (s *SetDefaultPolicyVersionInput) SetPolicyName(v string) *SetDefaultPolicyVersionInput {
 	s.PolicyName = &v
@@ -13278,6 +14299,23 @@ func (s *SetLoggingOptionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetLoggingOptionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetLoggingOptionsInput"}
+	if s.LoggingOptionsPayload != nil {
+		if err := s.LoggingOptionsPayload.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LoggingOptionsPayload", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLoggingOptionsPayload sets the LoggingOptionsPayload field's value.
 func (s *SetLoggingOptionsInput) SetLoggingOptionsPayload(v *LoggingOptionsPayload) *SetLoggingOptionsInput {
 	s.LoggingOptionsPayload = v
@@ -13364,6 +14402,24 @@ func (s *SnsAction) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SnsAction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SnsAction"}
+	if s.MessageFormat != nil {
+		values := []string{"RAW", "JSON"}
+		if !request.IsParamEnumValue(*s.MessageFormat, values) {
+			invalidParams.Add(request.NewErrParamEnum("MessageFormat", *s.MessageFormat, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMessageFormat sets the MessageFormat field's value.
 func (s *SnsAction) SetMessageFormat(v string) *SnsAction {
 	s.MessageFormat = &v
@@ -13733,6 +14789,21 @@ func (s ThingTypeProperties) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ThingTypeProperties) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ThingTypeProperties"}
+	if s.ThingTypeDescription != nil && len(*s.ThingTypeDescription) > 2028 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingTypeDescription", 2028))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSearchableAttributes sets the SearchableAttributes field's value.
 func (s *ThingTypeProperties) SetSearchableAttributes(v []*string) *ThingTypeProperties {
 	s.SearchableAttributes = v
@@ -14042,6 +15113,31 @@ func (s *TopicRulePayload) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TopicRulePayload) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TopicRulePayload"}
+	if s.Actions != nil && len(s.Actions) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Actions", 10))
+	}
+	if s.Actions != nil {
+		for i, v := range s.Actions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Actions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActions sets the Actions field's value.
 func (s *TopicRulePayload) SetActions(v []*Action) *TopicRulePayload {
 	s.Actions = v
@@ -14150,6 +15246,30 @@ func (s *TransferCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TransferCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TransferCertificateInput"}
+	if s.CertificateId != nil && len(*s.CertificateId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateId", 64))
+	}
+	if s.CertificateId != nil && !request.MatchParamPattern("(0x)?[a-fA-F0-9]+", *s.CertificateId) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateId", "(0x)?[a-fA-F0-9]+"))
+	}
+	if s.TargetAwsAccount != nil && !request.MatchParamPattern("[0-9]{12}", *s.TargetAwsAccount) {
+		invalidParams.Add(request.NewErrParamPattern("TargetAwsAccount", "[0-9]{12}"))
+	}
+	if s.TransferMessage != nil && len(*s.TransferMessage) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("TransferMessage", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateId sets the CertificateId field's value.
 func (s *TransferCertificateInput) SetCertificateId(v string) *TransferCertificateInput {
 	s.CertificateId = &v
@@ -14361,6 +15481,36 @@ func (s *UpdateCACertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateCACertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateCACertificateInput"}
+	if s.CertificateId != nil && len(*s.CertificateId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateId", 64))
+	}
+	if s.CertificateId != nil && !request.MatchParamPattern("(0x)?[a-fA-F0-9]+", *s.CertificateId) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateId", "(0x)?[a-fA-F0-9]+"))
+	}
+	if s.NewAutoRegistrationStatus != nil {
+		values := []string{"ENABLE", "DISABLE"}
+		if !request.IsParamEnumValue(*s.NewAutoRegistrationStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("NewAutoRegistrationStatus", *s.NewAutoRegistrationStatus, values))
+		}
+	}
+	if s.NewStatus != nil {
+		values := []string{"ACTIVE", "INACTIVE"}
+		if !request.IsParamEnumValue(*s.NewStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("NewStatus", *s.NewStatus, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateId sets the CertificateId field's value.
 func (s *UpdateCACertificateInput) SetCertificateId(v string) *UpdateCACertificateInput {
 	s.CertificateId = &v
@@ -14471,6 +15621,30 @@ func (s *UpdateCertificateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateCertificateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateCertificateInput"}
+	if s.CertificateId != nil && len(*s.CertificateId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("CertificateId", 64))
+	}
+	if s.CertificateId != nil && !request.MatchParamPattern("(0x)?[a-fA-F0-9]+", *s.CertificateId) {
+		invalidParams.Add(request.NewErrParamPattern("CertificateId", "(0x)?[a-fA-F0-9]+"))
+	}
+	if s.NewStatus != nil {
+		values := []string{"ACTIVE", "INACTIVE", "REVOKED", "PENDING_TRANSFER", "REGISTER_INACTIVE", "PENDING_ACTIVATION"}
+		if !request.IsParamEnumValue(*s.NewStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("NewStatus", *s.NewStatus, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCertificateId sets the CertificateId field's value.
 func (s *UpdateCertificateInput) SetCertificateId(v string) *UpdateCertificateInput {
 	s.CertificateId = &v
@@ -14577,6 +15751,30 @@ func (s *UpdateThingInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateThingInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateThingInput"}
+	if s.ThingName != nil && len(*s.ThingName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingName", 128))
+	}
+	if s.ThingName != nil && !request.MatchParamPattern("[a-zA-Z0-9:_-]+", *s.ThingName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingName", "[a-zA-Z0-9:_-]+"))
+	}
+	if s.ThingTypeName != nil && len(*s.ThingTypeName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingTypeName", 128))
+	}
+	if s.ThingTypeName != nil && !request.MatchParamPattern("[a-zA-Z0-9:_-]+", *s.ThingTypeName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingTypeName", "[a-zA-Z0-9:_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributePayload sets the AttributePayload field's value.
 func (s *UpdateThingInput) SetAttributePayload(v *AttributePayload) *UpdateThingInput {
 	s.AttributePayload = v
@@ -439,6 +439,24 @@ func (s *DeleteThingShadowInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteThingShadowInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteThingShadowInput"}
+	if s.ThingName != nil && len(*s.ThingName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingName", 128))
+	}
+	if s.ThingName != nil && !request.MatchParamPattern("[a-zA-Z0-9_-]+", *s.ThingName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingName", "[a-zA-Z0-9_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetThingName sets the ThingName field's value.
 func (s *DeleteThingShadowInput) SetThingName(v string) *DeleteThingShadowInput {
 	s.ThingName = &v
@@ -529,6 +547,24 @@ func (s *GetThingShadowInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetThingShadowInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetThingShadowInput"}
+	if s.ThingName != nil && len(*s.ThingName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingName", 128))
+	}
+	if s.ThingName != nil && !request.MatchParamPattern("[a-zA-Z0-9_-]+", *s.ThingName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingName", "[a-zA-Z0-9_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetThingName sets the ThingName field's value.
 func (s *GetThingShadowInput) SetThingName(v string) *GetThingShadowInput {
 	s.ThingName = &v
@@ -620,6 +656,21 @@ func (s *PublishInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PublishInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PublishInput"}
+	if s.Qos != nil && *s.Qos > 1 {
+		invalidParams.Add(request.NewErrParamMaxValue("Qos", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPayload sets the Payload field's value.
 func (s *PublishInput) SetPayload(v []byte) *PublishInput {
 	s.Payload = v
@@ -723,6 +774,24 @@ func (s *UpdateThingShadowInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateThingShadowInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateThingShadowInput"}
+	if s.ThingName != nil && len(*s.ThingName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThingName", 128))
+	}
+	if s.ThingName != nil && !request.MatchParamPattern("[a-zA-Z0-9_-]+", *s.ThingName) {
+		invalidParams.Add(request.NewErrParamPattern("ThingName", "[a-zA-Z0-9_-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPayload sets the Payload field's value.
 func (s *UpdateThingShadowInput) SetPayload(v []byte) *UpdateThingShadowInput {
 	s.Payload = v
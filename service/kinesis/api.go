@@ -2620,6 +2620,27 @@ func (s *AddTagsToStreamInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddTagsToStreamInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddTagsToStreamInput"}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.Tags != nil && len(s.Tags) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStreamName sets the StreamName field's value.
 func (s *AddTagsToStreamInput) SetStreamName(v string) *AddTagsToStreamInput {
 	s.StreamName = &v
@@ -2703,6 +2724,27 @@ func (s *CreateStreamInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateStreamInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateStreamInput"}
+	if s.ShardCount != nil && *s.ShardCount > 100000 {
+		invalidParams.Add(request.NewErrParamMaxValue("ShardCount", 100000))
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetShardCount sets the ShardCount field's value.
 func (s *CreateStreamInput) SetShardCount(v int64) *CreateStreamInput {
 	s.ShardCount = &v
@@ -2779,6 +2821,27 @@ func (s *DecreaseStreamRetentionPeriodInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DecreaseStreamRetentionPeriodInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DecreaseStreamRetentionPeriodInput"}
+	if s.RetentionPeriodHours != nil && *s.RetentionPeriodHours > 100000 {
+		invalidParams.Add(request.NewErrParamMaxValue("RetentionPeriodHours", 100000))
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRetentionPeriodHours sets the RetentionPeriodHours field's value.
 func (s *DecreaseStreamRetentionPeriodInput) SetRetentionPeriodHours(v int64) *DecreaseStreamRetentionPeriodInput {
 	s.RetentionPeriodHours = &v
@@ -2843,6 +2906,24 @@ func (s *DeleteStreamInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteStreamInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteStreamInput"}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStreamName sets the StreamName field's value.
 func (s *DeleteStreamInput) SetStreamName(v string) *DeleteStreamInput {
 	s.StreamName = &v
@@ -2966,6 +3047,33 @@ func (s *DescribeStreamInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeStreamInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeStreamInput"}
+	if s.ExclusiveStartShardId != nil && len(*s.ExclusiveStartShardId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExclusiveStartShardId", 128))
+	}
+	if s.ExclusiveStartShardId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ExclusiveStartShardId) {
+		invalidParams.Add(request.NewErrParamPattern("ExclusiveStartShardId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.Limit != nil && *s.Limit > 10000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 10000))
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExclusiveStartShardId sets the ExclusiveStartShardId field's value.
 func (s *DescribeStreamInput) SetExclusiveStartShardId(v string) *DescribeStreamInput {
 	s.ExclusiveStartShardId = &v
@@ -3083,6 +3191,27 @@ func (s *DisableEnhancedMonitoringInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisableEnhancedMonitoringInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableEnhancedMonitoringInput"}
+	if s.ShardLevelMetrics != nil && len(s.ShardLevelMetrics) > 7 {
+		invalidParams.Add(request.NewErrParamMaxLen("ShardLevelMetrics", 7))
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetShardLevelMetrics sets the ShardLevelMetrics field's value.
 func (s *DisableEnhancedMonitoringInput) SetShardLevelMetrics(v []*string) *DisableEnhancedMonitoringInput {
 	s.ShardLevelMetrics = v
@@ -3166,6 +3295,27 @@ func (s *EnableEnhancedMonitoringInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnableEnhancedMonitoringInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableEnhancedMonitoringInput"}
+	if s.ShardLevelMetrics != nil && len(s.ShardLevelMetrics) > 7 {
+		invalidParams.Add(request.NewErrParamMaxLen("ShardLevelMetrics", 7))
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetShardLevelMetrics sets the ShardLevelMetrics field's value.
 func (s *EnableEnhancedMonitoringInput) SetShardLevelMetrics(v []*string) *EnableEnhancedMonitoringInput {
 	s.ShardLevelMetrics = v
@@ -3317,6 +3467,24 @@ func (s *GetRecordsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetRecordsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetRecordsInput"}
+	if s.Limit != nil && *s.Limit > 10000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 10000))
+	}
+	if s.ShardIterator != nil && len(*s.ShardIterator) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("ShardIterator", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *GetRecordsInput) SetLimit(v int64) *GetRecordsInput {
 	s.Limit = &v
@@ -3466,6 +3634,39 @@ func (s *GetShardIteratorInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetShardIteratorInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetShardIteratorInput"}
+	if s.ShardId != nil && len(*s.ShardId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ShardId", 128))
+	}
+	if s.ShardId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ShardId) {
+		invalidParams.Add(request.NewErrParamPattern("ShardId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.ShardIteratorType != nil {
+		values := []string{"AT_SEQUENCE_NUMBER", "AFTER_SEQUENCE_NUMBER", "TRIM_HORIZON", "LATEST", "AT_TIMESTAMP"}
+		if !request.IsParamEnumValue(*s.ShardIteratorType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ShardIteratorType", *s.ShardIteratorType, values))
+		}
+	}
+	if s.StartingSequenceNumber != nil && !request.MatchParamPattern("0|([1-9]\\d{0,128})", *s.StartingSequenceNumber) {
+		invalidParams.Add(request.NewErrParamPattern("StartingSequenceNumber", "0|([1-9]\\d{0,128})"))
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetShardId sets the ShardId field's value.
 func (s *GetShardIteratorInput) SetShardId(v string) *GetShardIteratorInput {
 	s.ShardId = &v
@@ -3611,6 +3812,27 @@ func (s *IncreaseStreamRetentionPeriodInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *IncreaseStreamRetentionPeriodInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "IncreaseStreamRetentionPeriodInput"}
+	if s.RetentionPeriodHours != nil && *s.RetentionPeriodHours > 100000 {
+		invalidParams.Add(request.NewErrParamMaxValue("RetentionPeriodHours", 100000))
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRetentionPeriodHours sets the RetentionPeriodHours field's value.
 func (s *IncreaseStreamRetentionPeriodInput) SetRetentionPeriodHours(v int64) *IncreaseStreamRetentionPeriodInput {
 	s.RetentionPeriodHours = &v
@@ -3676,6 +3898,27 @@ func (s *ListStreamsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListStreamsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListStreamsInput"}
+	if s.ExclusiveStartStreamName != nil && len(*s.ExclusiveStartStreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExclusiveStartStreamName", 128))
+	}
+	if s.ExclusiveStartStreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ExclusiveStartStreamName) {
+		invalidParams.Add(request.NewErrParamPattern("ExclusiveStartStreamName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.Limit != nil && *s.Limit > 10000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 10000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExclusiveStartStreamName sets the ExclusiveStartStreamName field's value.
 func (s *ListStreamsInput) SetExclusiveStartStreamName(v string) *ListStreamsInput {
 	s.ExclusiveStartStreamName = &v
@@ -3779,6 +4022,30 @@ func (s *ListTagsForStreamInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsForStreamInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForStreamInput"}
+	if s.ExclusiveStartTagKey != nil && len(*s.ExclusiveStartTagKey) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExclusiveStartTagKey", 128))
+	}
+	if s.Limit != nil && *s.Limit > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 10))
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExclusiveStartTagKey sets the ExclusiveStartTagKey field's value.
 func (s *ListTagsForStreamInput) SetExclusiveStartTagKey(v string) *ListTagsForStreamInput {
 	s.ExclusiveStartTagKey = &v
@@ -3896,6 +4163,36 @@ func (s *MergeShardsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MergeShardsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MergeShardsInput"}
+	if s.AdjacentShardToMerge != nil && len(*s.AdjacentShardToMerge) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("AdjacentShardToMerge", 128))
+	}
+	if s.AdjacentShardToMerge != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.AdjacentShardToMerge) {
+		invalidParams.Add(request.NewErrParamPattern("AdjacentShardToMerge", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.ShardToMerge != nil && len(*s.ShardToMerge) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ShardToMerge", 128))
+	}
+	if s.ShardToMerge != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ShardToMerge) {
+		invalidParams.Add(request.NewErrParamPattern("ShardToMerge", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdjacentShardToMerge sets the AdjacentShardToMerge field's value.
 func (s *MergeShardsInput) SetAdjacentShardToMerge(v string) *MergeShardsInput {
 	s.AdjacentShardToMerge = &v
@@ -4008,6 +4305,36 @@ func (s *PutRecordInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutRecordInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutRecordInput"}
+	if s.Data != nil && len(s.Data) > 1.048576e+06 {
+		invalidParams.Add(request.NewErrParamMaxLen("Data", 1.048576e+06))
+	}
+	if s.ExplicitHashKey != nil && !request.MatchParamPattern("0|([1-9]\\d{0,38})", *s.ExplicitHashKey) {
+		invalidParams.Add(request.NewErrParamPattern("ExplicitHashKey", "0|([1-9]\\d{0,38})"))
+	}
+	if s.PartitionKey != nil && len(*s.PartitionKey) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("PartitionKey", 256))
+	}
+	if s.SequenceNumberForOrdering != nil && !request.MatchParamPattern("0|([1-9]\\d{0,128})", *s.SequenceNumberForOrdering) {
+		invalidParams.Add(request.NewErrParamPattern("SequenceNumberForOrdering", "0|([1-9]\\d{0,128})"))
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetData sets the Data field's value.
 func (s *PutRecordInput) SetData(v []byte) *PutRecordInput {
 	s.Data = v
@@ -4152,6 +4479,37 @@ func (s *PutRecordsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutRecordsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutRecordsInput"}
+	if s.Records != nil && len(s.Records) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("Records", 500))
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.Records != nil {
+		for i, v := range s.Records {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Records", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRecords sets the Records field's value.
 func (s *PutRecordsInput) SetRecords(v []*PutRecordsRequestEntry) *PutRecordsInput {
 	s.Records = v
@@ -4280,6 +4638,27 @@ func (s *PutRecordsRequestEntry) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutRecordsRequestEntry) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutRecordsRequestEntry"}
+	if s.Data != nil && len(s.Data) > 1.048576e+06 {
+		invalidParams.Add(request.NewErrParamMaxLen("Data", 1.048576e+06))
+	}
+	if s.ExplicitHashKey != nil && !request.MatchParamPattern("0|([1-9]\\d{0,38})", *s.ExplicitHashKey) {
+		invalidParams.Add(request.NewErrParamPattern("ExplicitHashKey", "0|([1-9]\\d{0,38})"))
+	}
+	if s.PartitionKey != nil && len(*s.PartitionKey) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("PartitionKey", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetData sets the Data field's value.
 func (s *PutRecordsRequestEntry) SetData(v []byte) *PutRecordsRequestEntry {
 	s.Data = v
@@ -4485,6 +4864,27 @@ func (s *RemoveTagsFromStreamInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveTagsFromStreamInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveTagsFromStreamInput"}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.TagKeys != nil && len(s.TagKeys) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagKeys", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStreamName sets the StreamName field's value.
 func (s *RemoveTagsFromStreamInput) SetStreamName(v string) *RemoveTagsFromStreamInput {
 	s.StreamName = &v
@@ -4679,6 +5079,33 @@ func (s *SplitShardInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SplitShardInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SplitShardInput"}
+	if s.NewStartingHashKey != nil && !request.MatchParamPattern("0|([1-9]\\d{0,38})", *s.NewStartingHashKey) {
+		invalidParams.Add(request.NewErrParamPattern("NewStartingHashKey", "0|([1-9]\\d{0,38})"))
+	}
+	if s.ShardToSplit != nil && len(*s.ShardToSplit) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ShardToSplit", 128))
+	}
+	if s.ShardToSplit != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ShardToSplit) {
+		invalidParams.Add(request.NewErrParamPattern("ShardToSplit", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNewStartingHashKey sets the NewStartingHashKey field's value.
 func (s *SplitShardInput) SetNewStartingHashKey(v string) *SplitShardInput {
 	s.NewStartingHashKey = &v
@@ -4774,6 +5201,33 @@ func (s *StartStreamEncryptionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartStreamEncryptionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartStreamEncryptionInput"}
+	if s.EncryptionType != nil {
+		values := []string{"NONE", "KMS"}
+		if !request.IsParamEnumValue(*s.EncryptionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("EncryptionType", *s.EncryptionType, values))
+		}
+	}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEncryptionType sets the EncryptionType field's value.
 func (s *StartStreamEncryptionInput) SetEncryptionType(v string) *StartStreamEncryptionInput {
 	s.EncryptionType = &v
@@ -4868,6 +5322,33 @@ func (s *StopStreamEncryptionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopStreamEncryptionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopStreamEncryptionInput"}
+	if s.EncryptionType != nil {
+		values := []string{"NONE", "KMS"}
+		if !request.IsParamEnumValue(*s.EncryptionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("EncryptionType", *s.EncryptionType, values))
+		}
+	}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEncryptionType sets the EncryptionType field's value.
 func (s *StopStreamEncryptionInput) SetEncryptionType(v string) *StopStreamEncryptionInput {
 	s.EncryptionType = &v
@@ -5138,6 +5619,33 @@ func (s *UpdateShardCountInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateShardCountInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateShardCountInput"}
+	if s.ScalingType != nil {
+		values := []string{"UNIFORM_SCALING"}
+		if !request.IsParamEnumValue(*s.ScalingType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ScalingType", *s.ScalingType, values))
+		}
+	}
+	if s.StreamName != nil && len(*s.StreamName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StreamName", 128))
+	}
+	if s.StreamName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.StreamName) {
+		invalidParams.Add(request.NewErrParamPattern("StreamName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.TargetShardCount != nil && *s.TargetShardCount > 100000 {
+		invalidParams.Add(request.NewErrParamMaxValue("TargetShardCount", 100000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetScalingType sets the ScalingType field's value.
 func (s *UpdateShardCountInput) SetScalingType(v string) *UpdateShardCountInput {
 	s.ScalingType = &v
@@ -1545,6 +1545,32 @@ func (s *AddApplicationCloudWatchLoggingOptionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddApplicationCloudWatchLoggingOptionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddApplicationCloudWatchLoggingOptionInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 128))
+	}
+	if s.ApplicationName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ApplicationName) {
+		invalidParams.Add(request.NewErrParamPattern("ApplicationName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.CurrentApplicationVersionId != nil && *s.CurrentApplicationVersionId > 9.99999999e+08 {
+		invalidParams.Add(request.NewErrParamMaxValue("CurrentApplicationVersionId", 9.99999999e+08))
+	}
+	if s.CloudWatchLoggingOption != nil {
+		if err := s.CloudWatchLoggingOption.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CloudWatchLoggingOption", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *AddApplicationCloudWatchLoggingOptionInput) SetApplicationName(v string) *AddApplicationCloudWatchLoggingOptionInput {
 	s.ApplicationName = &v
@@ -1642,6 +1668,32 @@ func (s *AddApplicationInputInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddApplicationInputInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddApplicationInputInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 128))
+	}
+	if s.ApplicationName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ApplicationName) {
+		invalidParams.Add(request.NewErrParamPattern("ApplicationName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.CurrentApplicationVersionId != nil && *s.CurrentApplicationVersionId > 9.99999999e+08 {
+		invalidParams.Add(request.NewErrParamMaxValue("CurrentApplicationVersionId", 9.99999999e+08))
+	}
+	if s.Input != nil {
+		if err := s.Input.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Input", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *AddApplicationInputInput) SetApplicationName(v string) *AddApplicationInputInput {
 	s.ApplicationName = &v
@@ -1741,6 +1793,32 @@ func (s *AddApplicationOutputInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddApplicationOutputInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddApplicationOutputInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 128))
+	}
+	if s.ApplicationName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ApplicationName) {
+		invalidParams.Add(request.NewErrParamPattern("ApplicationName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.CurrentApplicationVersionId != nil && *s.CurrentApplicationVersionId > 9.99999999e+08 {
+		invalidParams.Add(request.NewErrParamMaxValue("CurrentApplicationVersionId", 9.99999999e+08))
+	}
+	if s.Output != nil {
+		if err := s.Output.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Output", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *AddApplicationOutputInput) SetApplicationName(v string) *AddApplicationOutputInput {
 	s.ApplicationName = &v
@@ -1842,6 +1920,32 @@ func (s *AddApplicationReferenceDataSourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddApplicationReferenceDataSourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddApplicationReferenceDataSourceInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 128))
+	}
+	if s.ApplicationName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ApplicationName) {
+		invalidParams.Add(request.NewErrParamPattern("ApplicationName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.CurrentApplicationVersionId != nil && *s.CurrentApplicationVersionId > 9.99999999e+08 {
+		invalidParams.Add(request.NewErrParamMaxValue("CurrentApplicationVersionId", 9.99999999e+08))
+	}
+	if s.ReferenceDataSource != nil {
+		if err := s.ReferenceDataSource.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ReferenceDataSource", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *AddApplicationReferenceDataSourceInput) SetApplicationName(v string) *AddApplicationReferenceDataSourceInput {
 	s.ApplicationName = &v
@@ -2145,6 +2249,61 @@ func (s *ApplicationUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ApplicationUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ApplicationUpdate"}
+	if s.ApplicationCodeUpdate != nil && len(*s.ApplicationCodeUpdate) > 51200 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationCodeUpdate", 51200))
+	}
+	if s.CloudWatchLoggingOptionUpdates != nil {
+		for i, v := range s.CloudWatchLoggingOptionUpdates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "CloudWatchLoggingOptionUpdates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.InputUpdates != nil {
+		for i, v := range s.InputUpdates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "InputUpdates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.OutputUpdates != nil {
+		for i, v := range s.OutputUpdates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "OutputUpdates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.ReferenceDataSourceUpdates != nil {
+		for i, v := range s.ReferenceDataSourceUpdates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ReferenceDataSourceUpdates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationCodeUpdate sets the ApplicationCodeUpdate field's value.
 func (s *ApplicationUpdate) SetApplicationCodeUpdate(v string) *ApplicationUpdate {
 	s.ApplicationCodeUpdate = &v
@@ -2294,6 +2453,30 @@ func (s *CloudWatchLoggingOption) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CloudWatchLoggingOption) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CloudWatchLoggingOption"}
+	if s.LogStreamARN != nil && len(*s.LogStreamARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogStreamARN", 2048))
+	}
+	if s.LogStreamARN != nil && !request.MatchParamPattern("arn:.*", *s.LogStreamARN) {
+		invalidParams.Add(request.NewErrParamPattern("LogStreamARN", "arn:.*"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 2048))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLogStreamARN sets the LogStreamARN field's value.
 func (s *CloudWatchLoggingOption) SetLogStreamARN(v string) *CloudWatchLoggingOption {
 	s.LogStreamARN = &v
@@ -2406,6 +2589,36 @@ func (s *CloudWatchLoggingOptionUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CloudWatchLoggingOptionUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CloudWatchLoggingOptionUpdate"}
+	if s.CloudWatchLoggingOptionId != nil && len(*s.CloudWatchLoggingOptionId) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("CloudWatchLoggingOptionId", 50))
+	}
+	if s.CloudWatchLoggingOptionId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.CloudWatchLoggingOptionId) {
+		invalidParams.Add(request.NewErrParamPattern("CloudWatchLoggingOptionId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.LogStreamARNUpdate != nil && len(*s.LogStreamARNUpdate) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("LogStreamARNUpdate", 2048))
+	}
+	if s.LogStreamARNUpdate != nil && !request.MatchParamPattern("arn:.*", *s.LogStreamARNUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("LogStreamARNUpdate", "arn:.*"))
+	}
+	if s.RoleARNUpdate != nil && len(*s.RoleARNUpdate) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARNUpdate", 2048))
+	}
+	if s.RoleARNUpdate != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+", *s.RoleARNUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARNUpdate", "arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCloudWatchLoggingOptionId sets the CloudWatchLoggingOptionId field's value.
 func (s *CloudWatchLoggingOptionUpdate) SetCloudWatchLoggingOptionId(v string) *CloudWatchLoggingOptionUpdate {
 	s.CloudWatchLoggingOptionId = &v
@@ -2551,6 +2764,60 @@ func (s *CreateApplicationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateApplicationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateApplicationInput"}
+	if s.ApplicationCode != nil && len(*s.ApplicationCode) > 51200 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationCode", 51200))
+	}
+	if s.ApplicationDescription != nil && len(*s.ApplicationDescription) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationDescription", 1024))
+	}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 128))
+	}
+	if s.ApplicationName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ApplicationName) {
+		invalidParams.Add(request.NewErrParamPattern("ApplicationName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.CloudWatchLoggingOptions != nil {
+		for i, v := range s.CloudWatchLoggingOptions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "CloudWatchLoggingOptions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Inputs != nil {
+		for i, v := range s.Inputs {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Inputs", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Outputs != nil {
+		for i, v := range s.Outputs {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Outputs", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationCode sets the ApplicationCode field's value.
 func (s *CreateApplicationInput) SetApplicationCode(v string) *CreateApplicationInput {
 	s.ApplicationCode = &v
@@ -2675,6 +2942,33 @@ func (s *DeleteApplicationCloudWatchLoggingOptionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteApplicationCloudWatchLoggingOptionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteApplicationCloudWatchLoggingOptionInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 128))
+	}
+	if s.ApplicationName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ApplicationName) {
+		invalidParams.Add(request.NewErrParamPattern("ApplicationName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.CloudWatchLoggingOptionId != nil && len(*s.CloudWatchLoggingOptionId) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("CloudWatchLoggingOptionId", 50))
+	}
+	if s.CloudWatchLoggingOptionId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.CloudWatchLoggingOptionId) {
+		invalidParams.Add(request.NewErrParamPattern("CloudWatchLoggingOptionId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.CurrentApplicationVersionId != nil && *s.CurrentApplicationVersionId > 9.99999999e+08 {
+		invalidParams.Add(request.NewErrParamMaxValue("CurrentApplicationVersionId", 9.99999999e+08))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *DeleteApplicationCloudWatchLoggingOptionInput) SetApplicationName(v string) *DeleteApplicationCloudWatchLoggingOptionInput {
 	s.ApplicationName = &v
@@ -2752,6 +3046,24 @@ func (s *DeleteApplicationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteApplicationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteApplicationInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 128))
+	}
+	if s.ApplicationName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ApplicationName) {
+		invalidParams.Add(request.NewErrParamPattern("ApplicationName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *DeleteApplicationInput) SetApplicationName(v string) *DeleteApplicationInput {
 	s.ApplicationName = &v
@@ -2844,6 +3156,33 @@ func (s *DeleteApplicationOutputInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteApplicationOutputInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteApplicationOutputInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 128))
+	}
+	if s.ApplicationName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ApplicationName) {
+		invalidParams.Add(request.NewErrParamPattern("ApplicationName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.CurrentApplicationVersionId != nil && *s.CurrentApplicationVersionId > 9.99999999e+08 {
+		invalidParams.Add(request.NewErrParamMaxValue("CurrentApplicationVersionId", 9.99999999e+08))
+	}
+	if s.OutputId != nil && len(*s.OutputId) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("OutputId", 50))
+	}
+	if s.OutputId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.OutputId) {
+		invalidParams.Add(request.NewErrParamPattern("OutputId", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *DeleteApplicationOutputInput) SetApplicationName(v string) *DeleteApplicationOutputInput {
 	s.ApplicationName = &v
@@ -2940,6 +3279,33 @@ func (s *DeleteApplicationReferenceDataSourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteApplicationReferenceDataSourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteApplicationReferenceDataSourceInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 128))
+	}
+	if s.ApplicationName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ApplicationName) {
+		invalidParams.Add(request.NewErrParamPattern("ApplicationName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.CurrentApplicationVersionId != nil && *s.CurrentApplicationVersionId > 9.99999999e+08 {
+		invalidParams.Add(request.NewErrParamMaxValue("CurrentApplicationVersionId", 9.99999999e+08))
+	}
+	if s.ReferenceId != nil && len(*s.ReferenceId) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ReferenceId", 50))
+	}
+	if s.ReferenceId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ReferenceId) {
+		invalidParams.Add(request.NewErrParamPattern("ReferenceId", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *DeleteApplicationReferenceDataSourceInput) SetApplicationName(v string) *DeleteApplicationReferenceDataSourceInput {
 	s.ApplicationName = &v
@@ -3009,6 +3375,24 @@ func (s *DescribeApplicationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeApplicationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeApplicationInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 128))
+	}
+	if s.ApplicationName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ApplicationName) {
+		invalidParams.Add(request.NewErrParamPattern("ApplicationName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *DescribeApplicationInput) SetApplicationName(v string) *DescribeApplicationInput {
 	s.ApplicationName = &v
@@ -3063,6 +3447,24 @@ func (s DestinationSchema) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DestinationSchema) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DestinationSchema"}
+	if s.RecordFormatType != nil {
+		values := []string{"JSON", "CSV"}
+		if !request.IsParamEnumValue(*s.RecordFormatType, values) {
+			invalidParams.Add(request.NewErrParamEnum("RecordFormatType", *s.RecordFormatType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRecordFormatType sets the RecordFormatType field's value.
 func (s *DestinationSchema) SetRecordFormatType(v string) *DestinationSchema {
 	s.RecordFormatType = &v
@@ -3126,6 +3528,35 @@ func (s *DiscoverInputSchemaInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DiscoverInputSchemaInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DiscoverInputSchemaInput"}
+	if s.ResourceARN != nil && len(*s.ResourceARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceARN", 2048))
+	}
+	if s.ResourceARN != nil && !request.MatchParamPattern("arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+", *s.ResourceARN) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceARN", "arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 2048))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"))
+	}
+	if s.InputStartingPositionConfiguration != nil {
+		if err := s.InputStartingPositionConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("InputStartingPositionConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInputStartingPositionConfiguration sets the InputStartingPositionConfiguration field's value.
 func (s *DiscoverInputSchemaInput) SetInputStartingPositionConfiguration(v *InputStartingPositionConfiguration) *DiscoverInputSchemaInput {
 	s.InputStartingPositionConfiguration = v
@@ -3285,10 +3716,48 @@ func (s *Input) Validate() error {
 	return nil
 }
 
-// SetInputParallelism sets the InputParallelism field's value.
-func (s *Input) SetInputParallelism(v *InputParallelism) *Input {
-	s.InputParallelism = v
-	return s
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Input) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Input"}
+	if s.NamePrefix != nil && len(*s.NamePrefix) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("NamePrefix", 32))
+	}
+	if s.NamePrefix != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9_]+", *s.NamePrefix) {
+		invalidParams.Add(request.NewErrParamPattern("NamePrefix", "[a-zA-Z][a-zA-Z0-9_]+"))
+	}
+	if s.InputParallelism != nil {
+		if err := s.InputParallelism.ValidateExtended(); err != nil {
+			invalidParams.AddNested("InputParallelism", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.InputSchema != nil {
+		if err := s.InputSchema.ValidateExtended(); err != nil {
+			invalidParams.AddNested("InputSchema", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.KinesisFirehoseInput != nil {
+		if err := s.KinesisFirehoseInput.ValidateExtended(); err != nil {
+			invalidParams.AddNested("KinesisFirehoseInput", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.KinesisStreamsInput != nil {
+		if err := s.KinesisStreamsInput.ValidateExtended(); err != nil {
+			invalidParams.AddNested("KinesisStreamsInput", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetInputParallelism sets the InputParallelism field's value.
+func (s *Input) SetInputParallelism(v *InputParallelism) *Input {
+	s.InputParallelism = v
+	return s
 }
 
 // SetInputSchema sets the InputSchema field's value.
@@ -3363,6 +3832,29 @@ func (s *InputConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InputConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputConfiguration"}
+	if s.Id != nil && len(*s.Id) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 50))
+	}
+	if s.Id != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.InputStartingPositionConfiguration != nil {
+		if err := s.InputStartingPositionConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("InputStartingPositionConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *InputConfiguration) SetId(v string) *InputConfiguration {
 	s.Id = &v
@@ -3507,6 +3999,21 @@ func (s *InputParallelism) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InputParallelism) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputParallelism"}
+	if s.Count != nil && *s.Count > 64 {
+		invalidParams.Add(request.NewErrParamMaxValue("Count", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCount sets the Count field's value.
 func (s *InputParallelism) SetCount(v int64) *InputParallelism {
 	s.Count = &v
@@ -3545,6 +4052,21 @@ func (s *InputParallelismUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InputParallelismUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputParallelismUpdate"}
+	if s.CountUpdate != nil && *s.CountUpdate > 64 {
+		invalidParams.Add(request.NewErrParamMaxValue("CountUpdate", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCountUpdate sets the CountUpdate field's value.
 func (s *InputParallelismUpdate) SetCountUpdate(v int64) *InputParallelismUpdate {
 	s.CountUpdate = &v
@@ -3607,6 +4129,39 @@ func (s *InputSchemaUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InputSchemaUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputSchemaUpdate"}
+	if s.RecordColumnUpdates != nil && len(s.RecordColumnUpdates) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("RecordColumnUpdates", 1000))
+	}
+	if s.RecordEncodingUpdate != nil && !request.MatchParamPattern("UTF-8", *s.RecordEncodingUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("RecordEncodingUpdate", "UTF-8"))
+	}
+	if s.RecordColumnUpdates != nil {
+		for i, v := range s.RecordColumnUpdates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "RecordColumnUpdates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.RecordFormatUpdate != nil {
+		if err := s.RecordFormatUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RecordFormatUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRecordColumnUpdates sets the RecordColumnUpdates field's value.
 func (s *InputSchemaUpdate) SetRecordColumnUpdates(v []*RecordColumn) *InputSchemaUpdate {
 	s.RecordColumnUpdates = v
@@ -3654,6 +4209,24 @@ func (s InputStartingPositionConfiguration) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InputStartingPositionConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputStartingPositionConfiguration"}
+	if s.InputStartingPosition != nil {
+		values := []string{"NOW", "TRIM_HORIZON", "LAST_STOPPED_POINT"}
+		if !request.IsParamEnumValue(*s.InputStartingPosition, values) {
+			invalidParams.Add(request.NewErrParamEnum("InputStartingPosition", *s.InputStartingPosition, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInputStartingPosition sets the InputStartingPosition field's value.
 func (s *InputStartingPositionConfiguration) SetInputStartingPosition(v string) *InputStartingPositionConfiguration {
 	s.InputStartingPosition = &v
@@ -3743,6 +4316,50 @@ func (s *InputUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InputUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputUpdate"}
+	if s.InputId != nil && len(*s.InputId) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("InputId", 50))
+	}
+	if s.InputId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.InputId) {
+		invalidParams.Add(request.NewErrParamPattern("InputId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.NamePrefixUpdate != nil && len(*s.NamePrefixUpdate) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("NamePrefixUpdate", 32))
+	}
+	if s.NamePrefixUpdate != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9_]+", *s.NamePrefixUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("NamePrefixUpdate", "[a-zA-Z][a-zA-Z0-9_]+"))
+	}
+	if s.InputParallelismUpdate != nil {
+		if err := s.InputParallelismUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("InputParallelismUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.InputSchemaUpdate != nil {
+		if err := s.InputSchemaUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("InputSchemaUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.KinesisFirehoseInputUpdate != nil {
+		if err := s.KinesisFirehoseInputUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("KinesisFirehoseInputUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.KinesisStreamsInputUpdate != nil {
+		if err := s.KinesisStreamsInputUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("KinesisStreamsInputUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInputId sets the InputId field's value.
 func (s *InputUpdate) SetInputId(v string) *InputUpdate {
 	s.InputId = &v
@@ -3873,6 +4490,30 @@ func (s *KinesisFirehoseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *KinesisFirehoseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "KinesisFirehoseInput"}
+	if s.ResourceARN != nil && len(*s.ResourceARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceARN", 2048))
+	}
+	if s.ResourceARN != nil && !request.MatchParamPattern("arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+", *s.ResourceARN) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceARN", "arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 2048))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceARN sets the ResourceARN field's value.
 func (s *KinesisFirehoseInput) SetResourceARN(v string) *KinesisFirehoseInput {
 	s.ResourceARN = &v
@@ -3961,6 +4602,30 @@ func (s *KinesisFirehoseInputUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *KinesisFirehoseInputUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "KinesisFirehoseInputUpdate"}
+	if s.ResourceARNUpdate != nil && len(*s.ResourceARNUpdate) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceARNUpdate", 2048))
+	}
+	if s.ResourceARNUpdate != nil && !request.MatchParamPattern("arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+", *s.ResourceARNUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceARNUpdate", "arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+"))
+	}
+	if s.RoleARNUpdate != nil && len(*s.RoleARNUpdate) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARNUpdate", 2048))
+	}
+	if s.RoleARNUpdate != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+", *s.RoleARNUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARNUpdate", "arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceARNUpdate sets the ResourceARNUpdate field's value.
 func (s *KinesisFirehoseInputUpdate) SetResourceARNUpdate(v string) *KinesisFirehoseInputUpdate {
 	s.ResourceARNUpdate = &v
@@ -4026,6 +4691,30 @@ func (s *KinesisFirehoseOutput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *KinesisFirehoseOutput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "KinesisFirehoseOutput"}
+	if s.ResourceARN != nil && len(*s.ResourceARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceARN", 2048))
+	}
+	if s.ResourceARN != nil && !request.MatchParamPattern("arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+", *s.ResourceARN) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceARN", "arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 2048))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceARN sets the ResourceARN field's value.
 func (s *KinesisFirehoseOutput) SetResourceARN(v string) *KinesisFirehoseOutput {
 	s.ResourceARN = &v
@@ -4116,6 +4805,30 @@ func (s *KinesisFirehoseOutputUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *KinesisFirehoseOutputUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "KinesisFirehoseOutputUpdate"}
+	if s.ResourceARNUpdate != nil && len(*s.ResourceARNUpdate) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceARNUpdate", 2048))
+	}
+	if s.ResourceARNUpdate != nil && !request.MatchParamPattern("arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+", *s.ResourceARNUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceARNUpdate", "arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+"))
+	}
+	if s.RoleARNUpdate != nil && len(*s.RoleARNUpdate) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARNUpdate", 2048))
+	}
+	if s.RoleARNUpdate != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+", *s.RoleARNUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARNUpdate", "arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceARNUpdate sets the ResourceARNUpdate field's value.
 func (s *KinesisFirehoseOutputUpdate) SetResourceARNUpdate(v string) *KinesisFirehoseOutputUpdate {
 	s.ResourceARNUpdate = &v
@@ -4180,6 +4893,30 @@ func (s *KinesisStreamsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *KinesisStreamsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "KinesisStreamsInput"}
+	if s.ResourceARN != nil && len(*s.ResourceARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceARN", 2048))
+	}
+	if s.ResourceARN != nil && !request.MatchParamPattern("arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+", *s.ResourceARN) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceARN", "arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 2048))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceARN sets the ResourceARN field's value.
 func (s *KinesisStreamsInput) SetResourceARN(v string) *KinesisStreamsInput {
 	s.ResourceARN = &v
@@ -4269,6 +5006,30 @@ func (s *KinesisStreamsInputUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *KinesisStreamsInputUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "KinesisStreamsInputUpdate"}
+	if s.ResourceARNUpdate != nil && len(*s.ResourceARNUpdate) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceARNUpdate", 2048))
+	}
+	if s.ResourceARNUpdate != nil && !request.MatchParamPattern("arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+", *s.ResourceARNUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceARNUpdate", "arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+"))
+	}
+	if s.RoleARNUpdate != nil && len(*s.RoleARNUpdate) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARNUpdate", 2048))
+	}
+	if s.RoleARNUpdate != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+", *s.RoleARNUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARNUpdate", "arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceARNUpdate sets the ResourceARNUpdate field's value.
 func (s *KinesisStreamsInputUpdate) SetResourceARNUpdate(v string) *KinesisStreamsInputUpdate {
 	s.ResourceARNUpdate = &v
@@ -4334,6 +5095,30 @@ func (s *KinesisStreamsOutput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *KinesisStreamsOutput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "KinesisStreamsOutput"}
+	if s.ResourceARN != nil && len(*s.ResourceARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceARN", 2048))
+	}
+	if s.ResourceARN != nil && !request.MatchParamPattern("arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+", *s.ResourceARN) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceARN", "arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 2048))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceARN sets the ResourceARN field's value.
 func (s *KinesisStreamsOutput) SetResourceARN(v string) *KinesisStreamsOutput {
 	s.ResourceARN = &v
@@ -4424,6 +5209,30 @@ func (s *KinesisStreamsOutputUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *KinesisStreamsOutputUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "KinesisStreamsOutputUpdate"}
+	if s.ResourceARNUpdate != nil && len(*s.ResourceARNUpdate) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceARNUpdate", 2048))
+	}
+	if s.ResourceARNUpdate != nil && !request.MatchParamPattern("arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+", *s.ResourceARNUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceARNUpdate", "arn:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]+:[a-zA-Z0-9\\-]*:\\d{12}:[a-zA-Z_0-9+=,.@\\-_/:]+"))
+	}
+	if s.RoleARNUpdate != nil && len(*s.RoleARNUpdate) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARNUpdate", 2048))
+	}
+	if s.RoleARNUpdate != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+", *s.RoleARNUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARNUpdate", "arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceARNUpdate sets the ResourceARNUpdate field's value.
 func (s *KinesisStreamsOutputUpdate) SetResourceARNUpdate(v string) *KinesisStreamsOutputUpdate {
 	s.ResourceARNUpdate = &v
@@ -4476,6 +5285,27 @@ func (s *ListApplicationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListApplicationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListApplicationsInput"}
+	if s.ExclusiveStartApplicationName != nil && len(*s.ExclusiveStartApplicationName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExclusiveStartApplicationName", 128))
+	}
+	if s.ExclusiveStartApplicationName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ExclusiveStartApplicationName) {
+		invalidParams.Add(request.NewErrParamPattern("ExclusiveStartApplicationName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.Limit != nil && *s.Limit > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExclusiveStartApplicationName sets the ExclusiveStartApplicationName field's value.
 func (s *ListApplicationsInput) SetExclusiveStartApplicationName(v string) *ListApplicationsInput {
 	s.ExclusiveStartApplicationName = &v
@@ -4652,6 +5482,39 @@ func (s *Output) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Output) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Output"}
+	if s.Name != nil && len(*s.Name) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 32))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9_]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[a-zA-Z][a-zA-Z0-9_]+"))
+	}
+	if s.DestinationSchema != nil {
+		if err := s.DestinationSchema.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DestinationSchema", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.KinesisFirehoseOutput != nil {
+		if err := s.KinesisFirehoseOutput.ValidateExtended(); err != nil {
+			invalidParams.AddNested("KinesisFirehoseOutput", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.KinesisStreamsOutput != nil {
+		if err := s.KinesisStreamsOutput.ValidateExtended(); err != nil {
+			invalidParams.AddNested("KinesisStreamsOutput", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestinationSchema sets the DestinationSchema field's value.
 func (s *Output) SetDestinationSchema(v *DestinationSchema) *Output {
 	s.DestinationSchema = v
@@ -4806,6 +5669,45 @@ func (s *OutputUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *OutputUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "OutputUpdate"}
+	if s.NameUpdate != nil && len(*s.NameUpdate) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("NameUpdate", 32))
+	}
+	if s.NameUpdate != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9_]+", *s.NameUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("NameUpdate", "[a-zA-Z][a-zA-Z0-9_]+"))
+	}
+	if s.OutputId != nil && len(*s.OutputId) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("OutputId", 50))
+	}
+	if s.OutputId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.OutputId) {
+		invalidParams.Add(request.NewErrParamPattern("OutputId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.DestinationSchemaUpdate != nil {
+		if err := s.DestinationSchemaUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DestinationSchemaUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.KinesisFirehoseOutputUpdate != nil {
+		if err := s.KinesisFirehoseOutputUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("KinesisFirehoseOutputUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.KinesisStreamsOutputUpdate != nil {
+		if err := s.KinesisStreamsOutputUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("KinesisStreamsOutputUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestinationSchemaUpdate sets the DestinationSchemaUpdate field's value.
 func (s *OutputUpdate) SetDestinationSchemaUpdate(v *DestinationSchema) *OutputUpdate {
 	s.DestinationSchemaUpdate = v
@@ -4886,6 +5788,21 @@ func (s *RecordColumn) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RecordColumn) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RecordColumn"}
+	if s.Name != nil && !request.MatchParamPattern("[a-zA-Z_][a-zA-Z0-9_]*", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[a-zA-Z_][a-zA-Z0-9_]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMapping sets the Mapping field's value.
 func (s *RecordColumn) SetMapping(v string) *RecordColumn {
 	s.Mapping = &v
@@ -4950,6 +5867,24 @@ func (s *RecordFormat) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RecordFormat) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RecordFormat"}
+	if s.RecordFormatType != nil {
+		values := []string{"JSON", "CSV"}
+		if !request.IsParamEnumValue(*s.RecordFormatType, values) {
+			invalidParams.Add(request.NewErrParamEnum("RecordFormatType", *s.RecordFormatType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMappingParameters sets the MappingParameters field's value.
 func (s *RecordFormat) SetMappingParameters(v *MappingParameters) *RecordFormat {
 	s.MappingParameters = v
@@ -5030,6 +5965,34 @@ func (s *ReferenceDataSource) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReferenceDataSource) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReferenceDataSource"}
+	if s.TableName != nil && len(*s.TableName) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableName", 32))
+	}
+	if s.TableName != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9_]+", *s.TableName) {
+		invalidParams.Add(request.NewErrParamPattern("TableName", "[a-zA-Z][a-zA-Z0-9_]+"))
+	}
+	if s.ReferenceSchema != nil {
+		if err := s.ReferenceSchema.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ReferenceSchema", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3ReferenceDataSource != nil {
+		if err := s.S3ReferenceDataSource.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3ReferenceDataSource", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetReferenceSchema sets the ReferenceSchema field's value.
 func (s *ReferenceDataSource) SetReferenceSchema(v *SourceSchema) *ReferenceDataSource {
 	s.ReferenceSchema = v
@@ -5180,6 +6143,40 @@ func (s *ReferenceDataSourceUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReferenceDataSourceUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReferenceDataSourceUpdate"}
+	if s.ReferenceId != nil && len(*s.ReferenceId) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ReferenceId", 50))
+	}
+	if s.ReferenceId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ReferenceId) {
+		invalidParams.Add(request.NewErrParamPattern("ReferenceId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.TableNameUpdate != nil && len(*s.TableNameUpdate) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("TableNameUpdate", 32))
+	}
+	if s.TableNameUpdate != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9_]+", *s.TableNameUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("TableNameUpdate", "[a-zA-Z][a-zA-Z0-9_]+"))
+	}
+	if s.ReferenceSchemaUpdate != nil {
+		if err := s.ReferenceSchemaUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ReferenceSchemaUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.S3ReferenceDataSourceUpdate != nil {
+		if err := s.S3ReferenceDataSourceUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3ReferenceDataSourceUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetReferenceId sets the ReferenceId field's value.
 func (s *ReferenceDataSourceUpdate) SetReferenceId(v string) *ReferenceDataSourceUpdate {
 	s.ReferenceId = &v
@@ -5269,6 +6266,30 @@ func (s *S3ReferenceDataSource) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *S3ReferenceDataSource) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "S3ReferenceDataSource"}
+	if s.BucketARN != nil && len(*s.BucketARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("BucketARN", 2048))
+	}
+	if s.BucketARN != nil && !request.MatchParamPattern("arn:.*", *s.BucketARN) {
+		invalidParams.Add(request.NewErrParamPattern("BucketARN", "arn:.*"))
+	}
+	if s.ReferenceRoleARN != nil && len(*s.ReferenceRoleARN) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ReferenceRoleARN", 2048))
+	}
+	if s.ReferenceRoleARN != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+", *s.ReferenceRoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("ReferenceRoleARN", "arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucketARN sets the BucketARN field's value.
 func (s *S3ReferenceDataSource) SetBucketARN(v string) *S3ReferenceDataSource {
 	s.BucketARN = &v
@@ -5382,6 +6403,30 @@ func (s *S3ReferenceDataSourceUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *S3ReferenceDataSourceUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "S3ReferenceDataSourceUpdate"}
+	if s.BucketARNUpdate != nil && len(*s.BucketARNUpdate) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("BucketARNUpdate", 2048))
+	}
+	if s.BucketARNUpdate != nil && !request.MatchParamPattern("arn:.*", *s.BucketARNUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("BucketARNUpdate", "arn:.*"))
+	}
+	if s.ReferenceRoleARNUpdate != nil && len(*s.ReferenceRoleARNUpdate) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ReferenceRoleARNUpdate", 2048))
+	}
+	if s.ReferenceRoleARNUpdate != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+", *s.ReferenceRoleARNUpdate) {
+		invalidParams.Add(request.NewErrParamPattern("ReferenceRoleARNUpdate", "arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucketARNUpdate sets the BucketARNUpdate field's value.
 func (s *S3ReferenceDataSourceUpdate) SetBucketARNUpdate(v string) *S3ReferenceDataSourceUpdate {
 	s.BucketARNUpdate = &v
@@ -5465,6 +6510,39 @@ func (s *SourceSchema) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SourceSchema) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SourceSchema"}
+	if s.RecordColumns != nil && len(s.RecordColumns) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("RecordColumns", 1000))
+	}
+	if s.RecordEncoding != nil && !request.MatchParamPattern("UTF-8", *s.RecordEncoding) {
+		invalidParams.Add(request.NewErrParamPattern("RecordEncoding", "UTF-8"))
+	}
+	if s.RecordColumns != nil {
+		for i, v := range s.RecordColumns {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "RecordColumns", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.RecordFormat != nil {
+		if err := s.RecordFormat.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RecordFormat", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRecordColumns sets the RecordColumns field's value.
 func (s *SourceSchema) SetRecordColumns(v []*RecordColumn) *SourceSchema {
 	s.RecordColumns = v
@@ -5540,6 +6618,34 @@ func (s *StartApplicationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartApplicationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartApplicationInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 128))
+	}
+	if s.ApplicationName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ApplicationName) {
+		invalidParams.Add(request.NewErrParamPattern("ApplicationName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.InputConfigurations != nil {
+		for i, v := range s.InputConfigurations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "InputConfigurations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *StartApplicationInput) SetApplicationName(v string) *StartApplicationInput {
 	s.ApplicationName = &v
@@ -5603,6 +6709,24 @@ func (s *StopApplicationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopApplicationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopApplicationInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 128))
+	}
+	if s.ApplicationName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ApplicationName) {
+		invalidParams.Add(request.NewErrParamPattern("ApplicationName", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *StopApplicationInput) SetApplicationName(v string) *StopApplicationInput {
 	s.ApplicationName = &v
@@ -5685,6 +6809,32 @@ func (s *UpdateApplicationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateApplicationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateApplicationInput"}
+	if s.ApplicationName != nil && len(*s.ApplicationName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationName", 128))
+	}
+	if s.ApplicationName != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ApplicationName) {
+		invalidParams.Add(request.NewErrParamPattern("ApplicationName", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.CurrentApplicationVersionId != nil && *s.CurrentApplicationVersionId > 9.99999999e+08 {
+		invalidParams.Add(request.NewErrParamMaxValue("CurrentApplicationVersionId", 9.99999999e+08))
+	}
+	if s.ApplicationUpdate != nil {
+		if err := s.ApplicationUpdate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ApplicationUpdate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationName sets the ApplicationName field's value.
 func (s *UpdateApplicationInput) SetApplicationName(v string) *UpdateApplicationInput {
 	s.ApplicationName = &v
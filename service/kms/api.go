@@ -494,9 +494,10 @@ const opDecrypt = "Decrypt"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/kms-2014-11-01/Decrypt
 func (c *KMS) DecryptRequest(input *DecryptInput) (req *request.Request, output *DecryptOutput) {
 	op := &request.Operation{
-		Name:       opDecrypt,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDecrypt,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Plaintext"},
 	}
 
 	if input == nil {
@@ -1478,9 +1479,10 @@ const opGenerateDataKey = "GenerateDataKey"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/kms-2014-11-01/GenerateDataKey
 func (c *KMS) GenerateDataKeyRequest(input *GenerateDataKeyInput) (req *request.Request, output *GenerateDataKeyOutput) {
 	op := &request.Operation{
-		Name:       opGenerateDataKey,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opGenerateDataKey,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Plaintext"},
 	}
 
 	if input == nil {
@@ -1753,9 +1755,10 @@ const opGenerateRandom = "GenerateRandom"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/kms-2014-11-01/GenerateRandom
 func (c *KMS) GenerateRandomRequest(input *GenerateRandomInput) (req *request.Request, output *GenerateRandomOutput) {
 	op := &request.Operation{
-		Name:       opGenerateRandom,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opGenerateRandom,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Plaintext"},
 	}
 
 	if input == nil {
@@ -2044,9 +2047,10 @@ const opGetParametersForImport = "GetParametersForImport"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/kms-2014-11-01/GetParametersForImport
 func (c *KMS) GetParametersForImportRequest(input *GetParametersForImportInput) (req *request.Request, output *GetParametersForImportOutput) {
 	op := &request.Operation{
-		Name:       opGetParametersForImport,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opGetParametersForImport,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"PublicKey"},
 	}
 
 	if input == nil {
@@ -4168,6 +4172,21 @@ func (s *CancelKeyDeletionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CancelKeyDeletionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelKeyDeletionInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *CancelKeyDeletionInput) SetKeyId(v string) *CancelKeyDeletionInput {
 	s.KeyId = &v
@@ -4253,6 +4272,27 @@ func (s *CreateAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAliasInput"}
+	if s.AliasName != nil && len(*s.AliasName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("AliasName", 256))
+	}
+	if s.AliasName != nil && !request.MatchParamPattern("^[a-zA-Z0-9:/_-]+$", *s.AliasName) {
+		invalidParams.Add(request.NewErrParamPattern("AliasName", "^[a-zA-Z0-9:/_-]+$"))
+	}
+	if s.TargetKeyId != nil && len(*s.TargetKeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("TargetKeyId", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAliasName sets the AliasName field's value.
 func (s *CreateAliasInput) SetAliasName(v string) *CreateAliasInput {
 	s.AliasName = &v
@@ -4389,6 +4429,36 @@ func (s *CreateGrantInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateGrantInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateGrantInput"}
+	if s.GrantTokens != nil && len(s.GrantTokens) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("GrantTokens", 10))
+	}
+	if s.GranteePrincipal != nil && len(*s.GranteePrincipal) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("GranteePrincipal", 256))
+	}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9:/_-]+$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9:/_-]+$"))
+	}
+	if s.RetiringPrincipal != nil && len(*s.RetiringPrincipal) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RetiringPrincipal", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConstraints sets the Constraints field's value.
 func (s *CreateGrantInput) SetConstraints(v *GrantConstraints) *CreateGrantInput {
 	s.Constraints = v
@@ -4578,6 +4648,46 @@ func (s *CreateKeyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateKeyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateKeyInput"}
+	if s.Description != nil && len(*s.Description) > 8192 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 8192))
+	}
+	if s.KeyUsage != nil {
+		values := []string{"ENCRYPT_DECRYPT"}
+		if !request.IsParamEnumValue(*s.KeyUsage, values) {
+			invalidParams.Add(request.NewErrParamEnum("KeyUsage", *s.KeyUsage, values))
+		}
+	}
+	if s.Origin != nil {
+		values := []string{"AWS_KMS", "EXTERNAL"}
+		if !request.IsParamEnumValue(*s.Origin, values) {
+			invalidParams.Add(request.NewErrParamEnum("Origin", *s.Origin, values))
+		}
+	}
+	if s.Policy != nil && len(*s.Policy) > 131072 {
+		invalidParams.Add(request.NewErrParamMaxLen("Policy", 131072))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBypassPolicyLockoutSafetyCheck sets the BypassPolicyLockoutSafetyCheck field's value.
 func (s *CreateKeyInput) SetBypassPolicyLockoutSafetyCheck(v bool) *CreateKeyInput {
 	s.BypassPolicyLockoutSafetyCheck = &v
@@ -4687,6 +4797,24 @@ func (s *DecryptInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DecryptInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DecryptInput"}
+	if s.CiphertextBlob != nil && len(s.CiphertextBlob) > 6144 {
+		invalidParams.Add(request.NewErrParamMaxLen("CiphertextBlob", 6144))
+	}
+	if s.GrantTokens != nil && len(s.GrantTokens) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("GrantTokens", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCiphertextBlob sets the CiphertextBlob field's value.
 func (s *DecryptInput) SetCiphertextBlob(v []byte) *DecryptInput {
 	s.CiphertextBlob = v
@@ -4717,7 +4845,7 @@ type DecryptOutput struct {
 	// master key is not available or if you didn't have permission to use it.
 	//
 	// Plaintext is automatically base64 encoded/decoded by the SDK.
-	Plaintext []byte `min:"1" type:"blob"`
+	Plaintext []byte `min:"1" type:"blob" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -4779,6 +4907,24 @@ func (s *DeleteAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteAliasInput"}
+	if s.AliasName != nil && len(*s.AliasName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("AliasName", 256))
+	}
+	if s.AliasName != nil && !request.MatchParamPattern("^[a-zA-Z0-9:/_-]+$", *s.AliasName) {
+		invalidParams.Add(request.NewErrParamPattern("AliasName", "^[a-zA-Z0-9:/_-]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAliasName sets the AliasName field's value.
 func (s *DeleteAliasInput) SetAliasName(v string) *DeleteAliasInput {
 	s.AliasName = &v
@@ -4844,6 +4990,21 @@ func (s *DeleteImportedKeyMaterialInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteImportedKeyMaterialInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteImportedKeyMaterialInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *DeleteImportedKeyMaterialInput) SetKeyId(v string) *DeleteImportedKeyMaterialInput {
 	s.KeyId = &v
@@ -4917,6 +5078,24 @@ func (s *DescribeKeyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeKeyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeKeyInput"}
+	if s.GrantTokens != nil && len(s.GrantTokens) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("GrantTokens", 10))
+	}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGrantTokens sets the GrantTokens field's value.
 func (s *DescribeKeyInput) SetGrantTokens(v []*string) *DescribeKeyInput {
 	s.GrantTokens = v
@@ -4995,6 +5174,21 @@ func (s *DisableKeyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisableKeyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableKeyInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *DisableKeyInput) SetKeyId(v string) *DisableKeyInput {
 	s.KeyId = &v
@@ -5057,6 +5251,21 @@ func (s *DisableKeyRotationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisableKeyRotationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableKeyRotationInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *DisableKeyRotationInput) SetKeyId(v string) *DisableKeyRotationInput {
 	s.KeyId = &v
@@ -5119,6 +5328,21 @@ func (s *EnableKeyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnableKeyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableKeyInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *EnableKeyInput) SetKeyId(v string) *EnableKeyInput {
 	s.KeyId = &v
@@ -5181,6 +5405,21 @@ func (s *EnableKeyRotationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnableKeyRotationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableKeyRotationInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *EnableKeyRotationInput) SetKeyId(v string) *EnableKeyRotationInput {
 	s.KeyId = &v
@@ -5238,7 +5477,7 @@ type EncryptInput struct {
 	// Plaintext is automatically base64 encoded/decoded by the SDK.
 	//
 	// Plaintext is a required field
-	Plaintext []byte `min:"1" type:"blob" required:"true"`
+	Plaintext []byte `min:"1" type:"blob" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -5273,6 +5512,27 @@ func (s *EncryptInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EncryptInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EncryptInput"}
+	if s.GrantTokens != nil && len(s.GrantTokens) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("GrantTokens", 10))
+	}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+	if s.Plaintext != nil && len(s.Plaintext) > 4096 {
+		invalidParams.Add(request.NewErrParamMaxLen("Plaintext", 4096))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEncryptionContext sets the EncryptionContext field's value.
 func (s *EncryptInput) SetEncryptionContext(v map[string]*string) *EncryptInput {
 	s.EncryptionContext = v
@@ -5407,6 +5667,33 @@ func (s *GenerateDataKeyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GenerateDataKeyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GenerateDataKeyInput"}
+	if s.GrantTokens != nil && len(s.GrantTokens) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("GrantTokens", 10))
+	}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+	if s.KeySpec != nil {
+		values := []string{"AES_256", "AES_128"}
+		if !request.IsParamEnumValue(*s.KeySpec, values) {
+			invalidParams.Add(request.NewErrParamEnum("KeySpec", *s.KeySpec, values))
+		}
+	}
+	if s.NumberOfBytes != nil && *s.NumberOfBytes > 1024 {
+		invalidParams.Add(request.NewErrParamMaxValue("NumberOfBytes", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEncryptionContext sets the EncryptionContext field's value.
 func (s *GenerateDataKeyInput) SetEncryptionContext(v map[string]*string) *GenerateDataKeyInput {
 	s.EncryptionContext = v
@@ -5454,7 +5741,7 @@ type GenerateDataKeyOutput struct {
 	// then remove it from memory as soon as possible.
 	//
 	// Plaintext is automatically base64 encoded/decoded by the SDK.
-	Plaintext []byte `min:"1" type:"blob"`
+	Plaintext []byte `min:"1" type:"blob" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -5559,6 +5846,33 @@ func (s *GenerateDataKeyWithoutPlaintextInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GenerateDataKeyWithoutPlaintextInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GenerateDataKeyWithoutPlaintextInput"}
+	if s.GrantTokens != nil && len(s.GrantTokens) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("GrantTokens", 10))
+	}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+	if s.KeySpec != nil {
+		values := []string{"AES_256", "AES_128"}
+		if !request.IsParamEnumValue(*s.KeySpec, values) {
+			invalidParams.Add(request.NewErrParamEnum("KeySpec", *s.KeySpec, values))
+		}
+	}
+	if s.NumberOfBytes != nil && *s.NumberOfBytes > 1024 {
+		invalidParams.Add(request.NewErrParamMaxValue("NumberOfBytes", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEncryptionContext sets the EncryptionContext field's value.
 func (s *GenerateDataKeyWithoutPlaintextInput) SetEncryptionContext(v map[string]*string) *GenerateDataKeyWithoutPlaintextInput {
 	s.EncryptionContext = v
@@ -5656,6 +5970,21 @@ func (s *GenerateRandomInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GenerateRandomInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GenerateRandomInput"}
+	if s.NumberOfBytes != nil && *s.NumberOfBytes > 1024 {
+		invalidParams.Add(request.NewErrParamMaxValue("NumberOfBytes", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNumberOfBytes sets the NumberOfBytes field's value.
 func (s *GenerateRandomInput) SetNumberOfBytes(v int64) *GenerateRandomInput {
 	s.NumberOfBytes = &v
@@ -5669,7 +5998,7 @@ type GenerateRandomOutput struct {
 	// The random byte string.
 	//
 	// Plaintext is automatically base64 encoded/decoded by the SDK.
-	Plaintext []byte `min:"1" type:"blob"`
+	Plaintext []byte `min:"1" type:"blob" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -5741,6 +6070,27 @@ func (s *GetKeyPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetKeyPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetKeyPolicyInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *GetKeyPolicyInput) SetKeyId(v string) *GetKeyPolicyInput {
 	s.KeyId = &v
@@ -5818,6 +6168,21 @@ func (s *GetKeyRotationStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetKeyRotationStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetKeyRotationStatusInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *GetKeyRotationStatusInput) SetKeyId(v string) *GetKeyRotationStatusInput {
 	s.KeyId = &v
@@ -5912,6 +6277,33 @@ func (s *GetParametersForImportInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetParametersForImportInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetParametersForImportInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+	if s.WrappingAlgorithm != nil {
+		values := []string{"RSAES_PKCS1_V1_5", "RSAES_OAEP_SHA_1", "RSAES_OAEP_SHA_256"}
+		if !request.IsParamEnumValue(*s.WrappingAlgorithm, values) {
+			invalidParams.Add(request.NewErrParamEnum("WrappingAlgorithm", *s.WrappingAlgorithm, values))
+		}
+	}
+	if s.WrappingKeySpec != nil {
+		values := []string{"RSA_2048"}
+		if !request.IsParamEnumValue(*s.WrappingKeySpec, values) {
+			invalidParams.Add(request.NewErrParamEnum("WrappingKeySpec", *s.WrappingKeySpec, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *GetParametersForImportInput) SetKeyId(v string) *GetParametersForImportInput {
 	s.KeyId = &v
@@ -5952,7 +6344,7 @@ type GetParametersForImportOutput struct {
 	// ImportKeyMaterial.
 	//
 	// PublicKey is automatically base64 encoded/decoded by the SDK.
-	PublicKey []byte `min:"1" type:"blob"`
+	PublicKey []byte `min:"1" type:"blob" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -6227,6 +6619,33 @@ func (s *ImportKeyMaterialInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ImportKeyMaterialInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ImportKeyMaterialInput"}
+	if s.EncryptedKeyMaterial != nil && len(s.EncryptedKeyMaterial) > 6144 {
+		invalidParams.Add(request.NewErrParamMaxLen("EncryptedKeyMaterial", 6144))
+	}
+	if s.ExpirationModel != nil {
+		values := []string{"KEY_MATERIAL_EXPIRES", "KEY_MATERIAL_DOES_NOT_EXPIRE"}
+		if !request.IsParamEnumValue(*s.ExpirationModel, values) {
+			invalidParams.Add(request.NewErrParamEnum("ExpirationModel", *s.ExpirationModel, values))
+		}
+	}
+	if s.ImportToken != nil && len(s.ImportToken) > 6144 {
+		invalidParams.Add(request.NewErrParamMaxLen("ImportToken", 6144))
+	}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEncryptedKeyMaterial sets the EncryptedKeyMaterial field's value.
 func (s *ImportKeyMaterialInput) SetEncryptedKeyMaterial(v []byte) *ImportKeyMaterialInput {
 	s.EncryptedKeyMaterial = v
@@ -6507,6 +6926,24 @@ func (s *ListAliasesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAliasesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAliasesInput"}
+	if s.Limit != nil && *s.Limit > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 1000))
+	}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListAliasesInput) SetLimit(v int64) *ListAliasesInput {
 	s.Limit = &v
@@ -6625,6 +7062,27 @@ func (s *ListGrantsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListGrantsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListGrantsInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+	if s.Limit != nil && *s.Limit > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 1000))
+	}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *ListGrantsInput) SetKeyId(v string) *ListGrantsInput {
 	s.KeyId = &v
@@ -6751,6 +7209,27 @@ func (s *ListKeyPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListKeyPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListKeyPoliciesInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+	if s.Limit != nil && *s.Limit > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 1000))
+	}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *ListKeyPoliciesInput) SetKeyId(v string) *ListKeyPoliciesInput {
 	s.KeyId = &v
@@ -6860,6 +7339,24 @@ func (s *ListKeysInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListKeysInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListKeysInput"}
+	if s.Limit != nil && *s.Limit > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 1000))
+	}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListKeysInput) SetLimit(v int64) *ListKeysInput {
 	s.Limit = &v
@@ -6981,6 +7478,27 @@ func (s *ListResourceTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListResourceTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListResourceTagsInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+	if s.Limit != nil && *s.Limit > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 1000))
+	}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *ListResourceTagsInput) SetKeyId(v string) *ListResourceTagsInput {
 	s.KeyId = &v
@@ -7109,6 +7627,27 @@ func (s *ListRetirableGrantsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListRetirableGrantsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRetirableGrantsInput"}
+	if s.Limit != nil && *s.Limit > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 1000))
+	}
+	if s.Marker != nil && len(*s.Marker) > 320 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 320))
+	}
+	if s.RetiringPrincipal != nil && len(*s.RetiringPrincipal) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RetiringPrincipal", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListRetirableGrantsInput) SetLimit(v int64) *ListRetirableGrantsInput {
 	s.Limit = &v
@@ -7226,6 +7765,30 @@ func (s *PutKeyPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutKeyPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutKeyPolicyInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+	if s.Policy != nil && len(*s.Policy) > 131072 {
+		invalidParams.Add(request.NewErrParamMaxLen("Policy", 131072))
+	}
+	if s.PolicyName != nil && len(*s.PolicyName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 128))
+	}
+	if s.PolicyName != nil && !request.MatchParamPattern("[\\w]+", *s.PolicyName) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyName", "[\\w]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBypassPolicyLockoutSafetyCheck sets the BypassPolicyLockoutSafetyCheck field's value.
 func (s *PutKeyPolicyInput) SetBypassPolicyLockoutSafetyCheck(v bool) *PutKeyPolicyInput {
 	s.BypassPolicyLockoutSafetyCheck = &v
@@ -7337,6 +7900,27 @@ func (s *ReEncryptInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReEncryptInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReEncryptInput"}
+	if s.CiphertextBlob != nil && len(s.CiphertextBlob) > 6144 {
+		invalidParams.Add(request.NewErrParamMaxLen("CiphertextBlob", 6144))
+	}
+	if s.DestinationKeyId != nil && len(*s.DestinationKeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("DestinationKeyId", 2048))
+	}
+	if s.GrantTokens != nil && len(s.GrantTokens) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("GrantTokens", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCiphertextBlob sets the CiphertextBlob field's value.
 func (s *ReEncryptInput) SetCiphertextBlob(v []byte) *ReEncryptInput {
 	s.CiphertextBlob = v
@@ -7459,6 +8043,27 @@ func (s *RetireGrantInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RetireGrantInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RetireGrantInput"}
+	if s.GrantId != nil && len(*s.GrantId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GrantId", 128))
+	}
+	if s.GrantToken != nil && len(*s.GrantToken) > 8192 {
+		invalidParams.Add(request.NewErrParamMaxLen("GrantToken", 8192))
+	}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGrantId sets the GrantId field's value.
 func (s *RetireGrantInput) SetGrantId(v string) *RetireGrantInput {
 	s.GrantId = &v
@@ -7545,6 +8150,24 @@ func (s *RevokeGrantInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RevokeGrantInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RevokeGrantInput"}
+	if s.GrantId != nil && len(*s.GrantId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("GrantId", 128))
+	}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGrantId sets the GrantId field's value.
 func (s *RevokeGrantInput) SetGrantId(v string) *RevokeGrantInput {
 	s.GrantId = &v
@@ -7628,6 +8251,24 @@ func (s *ScheduleKeyDeletionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ScheduleKeyDeletionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ScheduleKeyDeletionInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+	if s.PendingWindowInDays != nil && *s.PendingWindowInDays > 365 {
+		invalidParams.Add(request.NewErrParamMaxValue("PendingWindowInDays", 365))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *ScheduleKeyDeletionInput) SetKeyId(v string) *ScheduleKeyDeletionInput {
 	s.KeyId = &v
@@ -7720,6 +8361,24 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.TagKey != nil && len(*s.TagKey) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagKey", 128))
+	}
+	if s.TagValue != nil && len(*s.TagValue) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagValue", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTagKey sets the TagKey field's value.
 func (s *Tag) SetTagKey(v string) *Tag {
 	s.TagKey = &v
@@ -7791,6 +8450,31 @@ func (s *TagResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TagResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *TagResourceInput) SetKeyId(v string) *TagResourceInput {
 	s.KeyId = &v
@@ -7867,6 +8551,21 @@ func (s *UntagResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UntagResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UntagResourceInput"}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyId sets the KeyId field's value.
 func (s *UntagResourceInput) SetKeyId(v string) *UntagResourceInput {
 	s.KeyId = &v
@@ -7952,6 +8651,27 @@ func (s *UpdateAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateAliasInput"}
+	if s.AliasName != nil && len(*s.AliasName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("AliasName", 256))
+	}
+	if s.AliasName != nil && !request.MatchParamPattern("^[a-zA-Z0-9:/_-]+$", *s.AliasName) {
+		invalidParams.Add(request.NewErrParamPattern("AliasName", "^[a-zA-Z0-9:/_-]+$"))
+	}
+	if s.TargetKeyId != nil && len(*s.TargetKeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("TargetKeyId", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAliasName sets the AliasName field's value.
 func (s *UpdateAliasInput) SetAliasName(v string) *UpdateAliasInput {
 	s.AliasName = &v
@@ -8028,6 +8748,24 @@ func (s *UpdateKeyDescriptionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateKeyDescriptionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateKeyDescriptionInput"}
+	if s.Description != nil && len(*s.Description) > 8192 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 8192))
+	}
+	if s.KeyId != nil && len(*s.KeyId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *UpdateKeyDescriptionInput) SetDescription(v string) *UpdateKeyDescriptionInput {
 	s.Description = &v
@@ -371,9 +371,10 @@ const opCreateFunction = "CreateFunction"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/lambda-2015-03-31/CreateFunction
 func (c *Lambda) CreateFunctionRequest(input *CreateFunctionInput) (req *request.Request, output *FunctionConfiguration) {
 	op := &request.Operation{
-		Name:       opCreateFunction,
-		HTTPMethod: "POST",
-		HTTPPath:   "/2015-03-31/functions",
+		Name:                 opCreateFunction,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/2015-03-31/functions",
+		SensitiveOutputPaths: []string{"Environment.Error.Message", "Environment.Variables"},
 	}
 
 	if input == nil {
@@ -1054,9 +1055,10 @@ const opGetFunction = "GetFunction"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/lambda-2015-03-31/GetFunction
 func (c *Lambda) GetFunctionRequest(input *GetFunctionInput) (req *request.Request, output *GetFunctionOutput) {
 	op := &request.Operation{
-		Name:       opGetFunction,
-		HTTPMethod: "GET",
-		HTTPPath:   "/2015-03-31/functions/{FunctionName}",
+		Name:                 opGetFunction,
+		HTTPMethod:           "GET",
+		HTTPPath:             "/2015-03-31/functions/{FunctionName}",
+		SensitiveOutputPaths: []string{"Configuration.Environment.Error.Message", "Configuration.Environment.Variables"},
 	}
 
 	if input == nil {
@@ -1158,9 +1160,10 @@ const opGetFunctionConfiguration = "GetFunctionConfiguration"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/lambda-2015-03-31/GetFunctionConfiguration
 func (c *Lambda) GetFunctionConfigurationRequest(input *GetFunctionConfigurationInput) (req *request.Request, output *FunctionConfiguration) {
 	op := &request.Operation{
-		Name:       opGetFunctionConfiguration,
-		HTTPMethod: "GET",
-		HTTPPath:   "/2015-03-31/functions/{FunctionName}/configuration",
+		Name:                 opGetFunctionConfiguration,
+		HTTPMethod:           "GET",
+		HTTPPath:             "/2015-03-31/functions/{FunctionName}/configuration",
+		SensitiveOutputPaths: []string{"Environment.Error.Message", "Environment.Variables"},
 	}
 
 	if input == nil {
@@ -1361,9 +1364,10 @@ const opInvoke = "Invoke"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/lambda-2015-03-31/Invoke
 func (c *Lambda) InvokeRequest(input *InvokeInput) (req *request.Request, output *InvokeOutput) {
 	op := &request.Operation{
-		Name:       opInvoke,
-		HTTPMethod: "POST",
-		HTTPPath:   "/2015-03-31/functions/{FunctionName}/invocations",
+		Name:                opInvoke,
+		HTTPMethod:          "POST",
+		HTTPPath:            "/2015-03-31/functions/{FunctionName}/invocations",
+		SensitiveOutputBody: true,
 	}
 
 	if input == nil {
@@ -1886,6 +1890,7 @@ func (c *Lambda) ListFunctionsRequest(input *ListFunctionsInput) (req *request.R
 			LimitToken:      "MaxItems",
 			TruncationToken: "",
 		},
+		SensitiveOutputPaths: []string{"Functions[*].Environment.Error.Message", "Functions[*].Environment.Variables"},
 	}
 
 	if input == nil {
@@ -2122,9 +2127,10 @@ const opListVersionsByFunction = "ListVersionsByFunction"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/lambda-2015-03-31/ListVersionsByFunction
 func (c *Lambda) ListVersionsByFunctionRequest(input *ListVersionsByFunctionInput) (req *request.Request, output *ListVersionsByFunctionOutput) {
 	op := &request.Operation{
-		Name:       opListVersionsByFunction,
-		HTTPMethod: "GET",
-		HTTPPath:   "/2015-03-31/functions/{FunctionName}/versions",
+		Name:                 opListVersionsByFunction,
+		HTTPMethod:           "GET",
+		HTTPPath:             "/2015-03-31/functions/{FunctionName}/versions",
+		SensitiveOutputPaths: []string{"Versions[*].Environment.Error.Message", "Versions[*].Environment.Variables"},
 	}
 
 	if input == nil {
@@ -2215,9 +2221,10 @@ const opPublishVersion = "PublishVersion"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/lambda-2015-03-31/PublishVersion
 func (c *Lambda) PublishVersionRequest(input *PublishVersionInput) (req *request.Request, output *FunctionConfiguration) {
 	op := &request.Operation{
-		Name:       opPublishVersion,
-		HTTPMethod: "POST",
-		HTTPPath:   "/2015-03-31/functions/{FunctionName}/versions",
+		Name:                 opPublishVersion,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/2015-03-31/functions/{FunctionName}/versions",
+		SensitiveOutputPaths: []string{"Environment.Error.Message", "Environment.Variables"},
 	}
 
 	if input == nil {
@@ -2818,9 +2825,10 @@ const opUpdateFunctionCode = "UpdateFunctionCode"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/lambda-2015-03-31/UpdateFunctionCode
 func (c *Lambda) UpdateFunctionCodeRequest(input *UpdateFunctionCodeInput) (req *request.Request, output *FunctionConfiguration) {
 	op := &request.Operation{
-		Name:       opUpdateFunctionCode,
-		HTTPMethod: "PUT",
-		HTTPPath:   "/2015-03-31/functions/{FunctionName}/code",
+		Name:                 opUpdateFunctionCode,
+		HTTPMethod:           "PUT",
+		HTTPPath:             "/2015-03-31/functions/{FunctionName}/code",
+		SensitiveOutputPaths: []string{"Environment.Error.Message", "Environment.Variables"},
 	}
 
 	if input == nil {
@@ -2921,9 +2929,10 @@ const opUpdateFunctionConfiguration = "UpdateFunctionConfiguration"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/lambda-2015-03-31/UpdateFunctionConfiguration
 func (c *Lambda) UpdateFunctionConfigurationRequest(input *UpdateFunctionConfigurationInput) (req *request.Request, output *FunctionConfiguration) {
 	op := &request.Operation{
-		Name:       opUpdateFunctionConfiguration,
-		HTTPMethod: "PUT",
-		HTTPPath:   "/2015-03-31/functions/{FunctionName}/configuration",
+		Name:                 opUpdateFunctionConfiguration,
+		HTTPMethod:           "PUT",
+		HTTPPath:             "/2015-03-31/functions/{FunctionName}/configuration",
+		SensitiveOutputPaths: []string{"Environment.Error.Message", "Environment.Variables"},
 	}
 
 	if input == nil {
@@ -3258,6 +3267,54 @@ func (s *AddPermissionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddPermissionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddPermissionInput"}
+	if s.Action != nil && !request.MatchParamPattern("(lambda:[*]|lambda:[a-zA-Z]+|[*])", *s.Action) {
+		invalidParams.Add(request.NewErrParamPattern("Action", "(lambda:[*]|lambda:[a-zA-Z]+|[*])"))
+	}
+	if s.EventSourceToken != nil && len(*s.EventSourceToken) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("EventSourceToken", 256))
+	}
+	if s.EventSourceToken != nil && !request.MatchParamPattern("[a-zA-Z0-9._\\-]+", *s.EventSourceToken) {
+		invalidParams.Add(request.NewErrParamPattern("EventSourceToken", "[a-zA-Z0-9._\\-]+"))
+	}
+	if s.FunctionName != nil && len(*s.FunctionName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 140))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.Principal != nil && !request.MatchParamPattern(".*", *s.Principal) {
+		invalidParams.Add(request.NewErrParamPattern("Principal", ".*"))
+	}
+	if s.Qualifier != nil && len(*s.Qualifier) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Qualifier", 128))
+	}
+	if s.Qualifier != nil && !request.MatchParamPattern("(|[a-zA-Z0-9$_-]+)", *s.Qualifier) {
+		invalidParams.Add(request.NewErrParamPattern("Qualifier", "(|[a-zA-Z0-9$_-]+)"))
+	}
+	if s.SourceAccount != nil && !request.MatchParamPattern("\\d{12}", *s.SourceAccount) {
+		invalidParams.Add(request.NewErrParamPattern("SourceAccount", "\\d{12}"))
+	}
+	if s.SourceArn != nil && !request.MatchParamPattern("arn:aws:([a-zA-Z0-9\\-])+:([a-z]{2}-[a-z]+-\\d{1})?:(\\d{12})?:(.*)", *s.SourceArn) {
+		invalidParams.Add(request.NewErrParamPattern("SourceArn", "arn:aws:([a-zA-Z0-9\\-])+:([a-z]{2}-[a-z]+-\\d{1})?:(\\d{12})?:(.*)"))
+	}
+	if s.StatementId != nil && len(*s.StatementId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("StatementId", 100))
+	}
+	if s.StatementId != nil && !request.MatchParamPattern("([a-zA-Z0-9-_]+)", *s.StatementId) {
+		invalidParams.Add(request.NewErrParamPattern("StatementId", "([a-zA-Z0-9-_]+)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *AddPermissionInput) SetAction(v string) *AddPermissionInput {
 	s.Action = &v
@@ -3540,6 +3597,36 @@ func (s *CreateAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAliasInput"}
+	if s.Description != nil && len(*s.Description) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 256))
+	}
+	if s.FunctionName != nil && len(*s.FunctionName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 140))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.FunctionVersion != nil && len(*s.FunctionVersion) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionVersion", 1024))
+	}
+	if s.FunctionVersion != nil && !request.MatchParamPattern("(\\$LATEST|[0-9]+)", *s.FunctionVersion) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionVersion", "(\\$LATEST|[0-9]+)"))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *CreateAliasInput) SetDescription(v string) *CreateAliasInput {
 	s.Description = &v
@@ -3682,6 +3769,36 @@ func (s *CreateEventSourceMappingInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateEventSourceMappingInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateEventSourceMappingInput"}
+	if s.BatchSize != nil && *s.BatchSize > 10000 {
+		invalidParams.Add(request.NewErrParamMaxValue("BatchSize", 10000))
+	}
+	if s.EventSourceArn != nil && !request.MatchParamPattern("arn:aws:([a-zA-Z0-9\\-])+:([a-z]{2}-[a-z]+-\\d{1})?:(\\d{12})?:(.*)", *s.EventSourceArn) {
+		invalidParams.Add(request.NewErrParamPattern("EventSourceArn", "arn:aws:([a-zA-Z0-9\\-])+:([a-z]{2}-[a-z]+-\\d{1})?:(\\d{12})?:(.*)"))
+	}
+	if s.FunctionName != nil && len(*s.FunctionName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 140))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.StartingPosition != nil {
+		values := []string{"TRIM_HORIZON", "LATEST", "AT_TIMESTAMP"}
+		if !request.IsParamEnumValue(*s.StartingPosition, values) {
+			invalidParams.Add(request.NewErrParamEnum("StartingPosition", *s.StartingPosition, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBatchSize sets the BatchSize field's value.
 func (s *CreateEventSourceMappingInput) SetBatchSize(v int64) *CreateEventSourceMappingInput {
 	s.BatchSize = &v
@@ -3900,6 +4017,68 @@ func (s *CreateFunctionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateFunctionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateFunctionInput"}
+	if s.Description != nil && len(*s.Description) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 256))
+	}
+	if s.FunctionName != nil && len(*s.FunctionName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 140))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.Handler != nil && len(*s.Handler) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Handler", 128))
+	}
+	if s.Handler != nil && !request.MatchParamPattern("[^\\s]+", *s.Handler) {
+		invalidParams.Add(request.NewErrParamPattern("Handler", "[^\\s]+"))
+	}
+	if s.KMSKeyArn != nil && !request.MatchParamPattern("(arn:aws:[a-z0-9-.]+:.*)|()", *s.KMSKeyArn) {
+		invalidParams.Add(request.NewErrParamPattern("KMSKeyArn", "(arn:aws:[a-z0-9-.]+:.*)|()"))
+	}
+	if s.MemorySize != nil && *s.MemorySize > 1536 {
+		invalidParams.Add(request.NewErrParamMaxValue("MemorySize", 1536))
+	}
+	if s.Role != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+", *s.Role) {
+		invalidParams.Add(request.NewErrParamPattern("Role", "arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"))
+	}
+	if s.Runtime != nil {
+		values := []string{"nodejs", "nodejs4.3", "nodejs6.10", "java8", "python2.7", "python3.6", "dotnetcore1.0", "nodejs4.3-edge"}
+		if !request.IsParamEnumValue(*s.Runtime, values) {
+			invalidParams.Add(request.NewErrParamEnum("Runtime", *s.Runtime, values))
+		}
+	}
+	if s.Code != nil {
+		if err := s.Code.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Code", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DeadLetterConfig != nil {
+		if err := s.DeadLetterConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DeadLetterConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TracingConfig != nil {
+		if err := s.TracingConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TracingConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.VpcConfig != nil {
+		if err := s.VpcConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("VpcConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCode sets the Code field's value.
 func (s *CreateFunctionInput) SetCode(v *FunctionCode) *CreateFunctionInput {
 	s.Code = v
@@ -4092,6 +4271,21 @@ func (s DeadLetterConfig) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeadLetterConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeadLetterConfig"}
+	if s.TargetArn != nil && !request.MatchParamPattern("(arn:aws:[a-z0-9-.]+:.*)|()", *s.TargetArn) {
+		invalidParams.Add(request.NewErrParamPattern("TargetArn", "(arn:aws:[a-z0-9-.]+:.*)|()"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTargetArn sets the TargetArn field's value.
 func (s *DeadLetterConfig) SetTargetArn(v string) *DeadLetterConfig {
 	s.TargetArn = &v
@@ -4159,6 +4353,27 @@ func (s *DeleteAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteAliasInput"}
+	if s.FunctionName != nil && len(*s.FunctionName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 140))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFunctionName sets the FunctionName field's value.
 func (s *DeleteAliasInput) SetFunctionName(v string) *DeleteAliasInput {
 	s.FunctionName = &v
@@ -4321,6 +4536,30 @@ func (s *DeleteFunctionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteFunctionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteFunctionInput"}
+	if s.FunctionName != nil && len(*s.FunctionName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 140))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.Qualifier != nil && len(*s.Qualifier) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Qualifier", 128))
+	}
+	if s.Qualifier != nil && !request.MatchParamPattern("(|[a-zA-Z0-9$_-]+)", *s.Qualifier) {
+		invalidParams.Add(request.NewErrParamPattern("Qualifier", "(|[a-zA-Z0-9$_-]+)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFunctionName sets the FunctionName field's value.
 func (s *DeleteFunctionInput) SetFunctionName(v string) *DeleteFunctionInput {
 	s.FunctionName = &v
@@ -4376,7 +4615,7 @@ type Environment struct {
 	_ struct{} `type:"structure"`
 
 	// The key-value pairs that represent your environment's configuration settings.
-	Variables map[string]*string `type:"map"`
+	Variables map[string]*string `type:"map" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -4400,7 +4639,7 @@ func (s *Environment) MarshalFields(e protocol.FieldEncoder) error {
 	if len(s.Variables) > 0 {
 		v := s.Variables
 
-		e.SetMap(protocol.BodyTarget, "Variables", protocol.EncodeStringMap(v), protocol.Metadata{})
+		e.SetMap(protocol.BodyTarget, "Variables", protocol.EncodeStringMap(v), protocol.Metadata{Sensitive: true})
 	}
 
 	return nil
@@ -4416,7 +4655,7 @@ type EnvironmentError struct {
 	ErrorCode *string `type:"string"`
 
 	// The message returned by the environment error object.
-	Message *string `type:"string"`
+	Message *string `type:"string" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -4451,7 +4690,7 @@ func (s *EnvironmentError) MarshalFields(e protocol.FieldEncoder) error {
 	if s.Message != nil {
 		v := *s.Message
 
-		e.SetValue(protocol.BodyTarget, "Message", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "Message", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 
 	return nil
@@ -4469,7 +4708,7 @@ type EnvironmentResponse struct {
 
 	// The key-value pairs returned that represent your environment's configuration
 	// settings or error information.
-	Variables map[string]*string `type:"map"`
+	Variables map[string]*string `type:"map" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -4504,7 +4743,7 @@ func (s *EnvironmentResponse) MarshalFields(e protocol.FieldEncoder) error {
 	if len(s.Variables) > 0 {
 		v := s.Variables
 
-		e.SetMap(protocol.BodyTarget, "Variables", protocol.EncodeStringMap(v), protocol.Metadata{})
+		e.SetMap(protocol.BodyTarget, "Variables", protocol.EncodeStringMap(v), protocol.Metadata{Sensitive: true})
 	}
 
 	return nil
@@ -4681,7 +4920,7 @@ type FunctionCode struct {
 	// in the AWS Lambda Developer Guide.
 	//
 	// ZipFile is automatically base64 encoded/decoded by the SDK.
-	ZipFile []byte `type:"blob"`
+	ZipFile []byte `type:"blob" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -4713,6 +4952,27 @@ func (s *FunctionCode) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *FunctionCode) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "FunctionCode"}
+	if s.S3Bucket != nil && len(*s.S3Bucket) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3Bucket", 63))
+	}
+	if s.S3Key != nil && len(*s.S3Key) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3Key", 1024))
+	}
+	if s.S3ObjectVersion != nil && len(*s.S3ObjectVersion) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3ObjectVersion", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetS3Bucket sets the S3Bucket field's value.
 func (s *FunctionCode) SetS3Bucket(v string) *FunctionCode {
 	s.S3Bucket = &v
@@ -4757,7 +5017,7 @@ func (s *FunctionCode) MarshalFields(e protocol.FieldEncoder) error {
 	if s.ZipFile != nil {
 		v := s.ZipFile
 
-		e.SetValue(protocol.BodyTarget, "ZipFile", protocol.BytesValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "ZipFile", protocol.BytesValue(v), protocol.Metadata{Sensitive: true})
 	}
 
 	return nil
@@ -5231,6 +5491,27 @@ func (s *GetAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetAliasInput"}
+	if s.FunctionName != nil && len(*s.FunctionName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 140))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFunctionName sets the FunctionName field's value.
 func (s *GetAliasInput) SetFunctionName(v string) *GetAliasInput {
 	s.FunctionName = &v
@@ -5365,6 +5646,30 @@ func (s *GetFunctionConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetFunctionConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetFunctionConfigurationInput"}
+	if s.FunctionName != nil && len(*s.FunctionName) > 170 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 170))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_\\.]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_\\.]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.Qualifier != nil && len(*s.Qualifier) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Qualifier", 128))
+	}
+	if s.Qualifier != nil && !request.MatchParamPattern("(|[a-zA-Z0-9$_-]+)", *s.Qualifier) {
+		invalidParams.Add(request.NewErrParamPattern("Qualifier", "(|[a-zA-Z0-9$_-]+)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFunctionName sets the FunctionName field's value.
 func (s *GetFunctionConfigurationInput) SetFunctionName(v string) *GetFunctionConfigurationInput {
 	s.FunctionName = &v
@@ -5447,6 +5752,30 @@ func (s *GetFunctionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetFunctionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetFunctionInput"}
+	if s.FunctionName != nil && len(*s.FunctionName) > 170 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 170))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_\\.]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_\\.]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.Qualifier != nil && len(*s.Qualifier) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Qualifier", 128))
+	}
+	if s.Qualifier != nil && !request.MatchParamPattern("(|[a-zA-Z0-9$_-]+)", *s.Qualifier) {
+		invalidParams.Add(request.NewErrParamPattern("Qualifier", "(|[a-zA-Z0-9$_-]+)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFunctionName sets the FunctionName field's value.
 func (s *GetFunctionInput) SetFunctionName(v string) *GetFunctionInput {
 	s.FunctionName = &v
@@ -5593,6 +5922,30 @@ func (s *GetPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPolicyInput"}
+	if s.FunctionName != nil && len(*s.FunctionName) > 170 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 170))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_\\.]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_\\.]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.Qualifier != nil && len(*s.Qualifier) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Qualifier", 128))
+	}
+	if s.Qualifier != nil && !request.MatchParamPattern("(|[a-zA-Z0-9$_-]+)", *s.Qualifier) {
+		invalidParams.Add(request.NewErrParamPattern("Qualifier", "(|[a-zA-Z0-9$_-]+)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFunctionName sets the FunctionName field's value.
 func (s *GetPolicyInput) SetFunctionName(v string) *GetPolicyInput {
 	s.FunctionName = &v
@@ -5704,6 +6057,24 @@ func (s *InvokeAsyncInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InvokeAsyncInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InvokeAsyncInput"}
+	if s.FunctionName != nil && len(*s.FunctionName) > 170 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 170))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_\\.]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_\\.]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFunctionName sets the FunctionName field's value.
 func (s *InvokeAsyncInput) SetFunctionName(v string) *InvokeAsyncInput {
 	s.FunctionName = &v
@@ -5804,7 +6175,7 @@ type InvokeInput struct {
 	LogType *string `location:"header" locationName:"X-Amz-Log-Type" type:"string" enum:"LogType"`
 
 	// JSON that you want to provide to your Lambda function as input.
-	Payload []byte `type:"blob"`
+	Payload []byte `type:"blob" sensitive:"true"`
 
 	// You can use this optional parameter to specify a Lambda function version
 	// or alias name. If you specify a function version, the API uses the qualified
@@ -5846,6 +6217,42 @@ func (s *InvokeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InvokeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InvokeInput"}
+	if s.FunctionName != nil && len(*s.FunctionName) > 170 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 170))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_\\.]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_\\.]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.InvocationType != nil {
+		values := []string{"Event", "RequestResponse", "DryRun"}
+		if !request.IsParamEnumValue(*s.InvocationType, values) {
+			invalidParams.Add(request.NewErrParamEnum("InvocationType", *s.InvocationType, values))
+		}
+	}
+	if s.LogType != nil {
+		values := []string{"None", "Tail"}
+		if !request.IsParamEnumValue(*s.LogType, values) {
+			invalidParams.Add(request.NewErrParamEnum("LogType", *s.LogType, values))
+		}
+	}
+	if s.Qualifier != nil && len(*s.Qualifier) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Qualifier", 128))
+	}
+	if s.Qualifier != nil && !request.MatchParamPattern("(|[a-zA-Z0-9$_-]+)", *s.Qualifier) {
+		invalidParams.Add(request.NewErrParamPattern("Qualifier", "(|[a-zA-Z0-9$_-]+)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientContext sets the ClientContext field's value.
 func (s *InvokeInput) SetClientContext(v string) *InvokeInput {
 	s.ClientContext = &v
@@ -5907,7 +6314,7 @@ func (s *InvokeInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.Payload != nil {
 		v := s.Payload
 
-		e.SetStream(protocol.PayloadTarget, "Payload", protocol.BytesStream(v), protocol.Metadata{})
+		e.SetStream(protocol.PayloadTarget, "Payload", protocol.BytesStream(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.Qualifier != nil {
 		v := *s.Qualifier
@@ -5942,7 +6349,7 @@ type InvokeOutput struct {
 	// In the event of a function error this field contains a message describing
 	// the error. For the Handled errors the Lambda function will report this message.
 	// For Unhandled errors AWS Lambda reports the message.
-	Payload []byte `type:"blob"`
+	Payload []byte `type:"blob" sensitive:"true"`
 
 	// The HTTP status code will be in the 200 range for successful request. For
 	// the RequestResponse invocation type this status code will be 200. For the
@@ -6000,7 +6407,7 @@ func (s *InvokeOutput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.Payload != nil {
 		v := s.Payload
 
-		e.SetStream(protocol.PayloadTarget, "Payload", protocol.BytesStream(v), protocol.Metadata{})
+		e.SetStream(protocol.PayloadTarget, "Payload", protocol.BytesStream(v), protocol.Metadata{Sensitive: true})
 	}
 	// ignoring invalid encode state, StatusCode. StatusCode
 
@@ -6064,6 +6471,33 @@ func (s *ListAliasesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAliasesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAliasesInput"}
+	if s.FunctionName != nil && len(*s.FunctionName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 140))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.FunctionVersion != nil && len(*s.FunctionVersion) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionVersion", 1024))
+	}
+	if s.FunctionVersion != nil && !request.MatchParamPattern("(\\$LATEST|[0-9]+)", *s.FunctionVersion) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionVersion", "(\\$LATEST|[0-9]+)"))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 10000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 10000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFunctionName sets the FunctionName field's value.
 func (s *ListAliasesInput) SetFunctionName(v string) *ListAliasesInput {
 	s.FunctionName = &v
@@ -6219,6 +6653,30 @@ func (s *ListEventSourceMappingsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListEventSourceMappingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListEventSourceMappingsInput"}
+	if s.EventSourceArn != nil && !request.MatchParamPattern("arn:aws:([a-zA-Z0-9\\-])+:([a-z]{2}-[a-z]+-\\d{1})?:(\\d{12})?:(.*)", *s.EventSourceArn) {
+		invalidParams.Add(request.NewErrParamPattern("EventSourceArn", "arn:aws:([a-zA-Z0-9\\-])+:([a-z]{2}-[a-z]+-\\d{1})?:(\\d{12})?:(.*)"))
+	}
+	if s.FunctionName != nil && len(*s.FunctionName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 140))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 10000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 10000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEventSourceArn sets the EventSourceArn field's value.
 func (s *ListEventSourceMappingsInput) SetEventSourceArn(v string) *ListEventSourceMappingsInput {
 	s.EventSourceArn = &v
@@ -6376,6 +6834,30 @@ func (s *ListFunctionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListFunctionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListFunctionsInput"}
+	if s.FunctionVersion != nil {
+		values := []string{"ALL"}
+		if !request.IsParamEnumValue(*s.FunctionVersion, values) {
+			invalidParams.Add(request.NewErrParamEnum("FunctionVersion", *s.FunctionVersion, values))
+		}
+	}
+	if s.MasterRegion != nil && !request.MatchParamPattern("ALL|[a-z]{2}(-gov)?-[a-z]+-\\d{1}", *s.MasterRegion) {
+		invalidParams.Add(request.NewErrParamPattern("MasterRegion", "ALL|[a-z]{2}(-gov)?-[a-z]+-\\d{1}"))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 10000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 10000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFunctionVersion sets the FunctionVersion field's value.
 func (s *ListFunctionsInput) SetFunctionVersion(v string) *ListFunctionsInput {
 	s.FunctionVersion = &v
@@ -6509,6 +6991,21 @@ func (s *ListTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsInput"}
+	if s.Resource != nil && !request.MatchParamPattern("arn:aws:lambda:[a-z]{2}-[a-z]+-\\d{1}:\\d{12}:function:[a-zA-Z0-9-_]+(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.Resource) {
+		invalidParams.Add(request.NewErrParamPattern("Resource", "arn:aws:lambda:[a-z]{2}-[a-z]+-\\d{1}:\\d{12}:function:[a-zA-Z0-9-_]+(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResource sets the Resource field's value.
 func (s *ListTagsInput) SetResource(v string) *ListTagsInput {
 	s.Resource = &v
@@ -6613,6 +7110,27 @@ func (s *ListVersionsByFunctionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListVersionsByFunctionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListVersionsByFunctionInput"}
+	if s.FunctionName != nil && len(*s.FunctionName) > 170 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 170))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_\\.]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_\\.]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 10000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 10000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFunctionName sets the FunctionName field's value.
 func (s *ListVersionsByFunctionInput) SetFunctionName(v string) *ListVersionsByFunctionInput {
 	s.FunctionName = &v
@@ -6752,6 +7270,27 @@ func (s *PublishVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PublishVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PublishVersionInput"}
+	if s.Description != nil && len(*s.Description) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 256))
+	}
+	if s.FunctionName != nil && len(*s.FunctionName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 140))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCodeSha256 sets the CodeSha256 field's value.
 func (s *PublishVersionInput) SetCodeSha256(v string) *PublishVersionInput {
 	s.CodeSha256 = &v
@@ -6853,6 +7392,36 @@ func (s *RemovePermissionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemovePermissionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemovePermissionInput"}
+	if s.FunctionName != nil && len(*s.FunctionName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 140))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.Qualifier != nil && len(*s.Qualifier) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Qualifier", 128))
+	}
+	if s.Qualifier != nil && !request.MatchParamPattern("(|[a-zA-Z0-9$_-]+)", *s.Qualifier) {
+		invalidParams.Add(request.NewErrParamPattern("Qualifier", "(|[a-zA-Z0-9$_-]+)"))
+	}
+	if s.StatementId != nil && len(*s.StatementId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("StatementId", 100))
+	}
+	if s.StatementId != nil && !request.MatchParamPattern("([a-zA-Z0-9-_.]+)", *s.StatementId) {
+		invalidParams.Add(request.NewErrParamPattern("StatementId", "([a-zA-Z0-9-_.]+)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFunctionName sets the FunctionName field's value.
 func (s *RemovePermissionInput) SetFunctionName(v string) *RemovePermissionInput {
 	s.FunctionName = &v
@@ -6954,6 +7523,21 @@ func (s *TagResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TagResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagResourceInput"}
+	if s.Resource != nil && !request.MatchParamPattern("arn:aws:lambda:[a-z]{2}-[a-z]+-\\d{1}:\\d{12}:function:[a-zA-Z0-9-_]+(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.Resource) {
+		invalidParams.Add(request.NewErrParamPattern("Resource", "arn:aws:lambda:[a-z]{2}-[a-z]+-\\d{1}:\\d{12}:function:[a-zA-Z0-9-_]+(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResource sets the Resource field's value.
 func (s *TagResourceInput) SetResource(v string) *TagResourceInput {
 	s.Resource = &v
@@ -7026,6 +7610,24 @@ func (s TracingConfig) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TracingConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TracingConfig"}
+	if s.Mode != nil {
+		values := []string{"Active", "PassThrough"}
+		if !request.IsParamEnumValue(*s.Mode, values) {
+			invalidParams.Add(request.NewErrParamEnum("Mode", *s.Mode, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMode sets the Mode field's value.
 func (s *TracingConfig) SetMode(v string) *TracingConfig {
 	s.Mode = &v
@@ -7120,6 +7722,21 @@ func (s *UntagResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UntagResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UntagResourceInput"}
+	if s.Resource != nil && !request.MatchParamPattern("arn:aws:lambda:[a-z]{2}-[a-z]+-\\d{1}:\\d{12}:function:[a-zA-Z0-9-_]+(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.Resource) {
+		invalidParams.Add(request.NewErrParamPattern("Resource", "arn:aws:lambda:[a-z]{2}-[a-z]+-\\d{1}:\\d{12}:function:[a-zA-Z0-9-_]+(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResource sets the Resource field's value.
 func (s *UntagResourceInput) SetResource(v string) *UntagResourceInput {
 	s.Resource = &v
@@ -7228,6 +7845,36 @@ func (s *UpdateAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateAliasInput"}
+	if s.Description != nil && len(*s.Description) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 256))
+	}
+	if s.FunctionName != nil && len(*s.FunctionName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 140))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.FunctionVersion != nil && len(*s.FunctionVersion) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionVersion", 1024))
+	}
+	if s.FunctionVersion != nil && !request.MatchParamPattern("(\\$LATEST|[0-9]+)", *s.FunctionVersion) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionVersion", "(\\$LATEST|[0-9]+)"))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *UpdateAliasInput) SetDescription(v string) *UpdateAliasInput {
 	s.Description = &v
@@ -7342,6 +7989,27 @@ func (s *UpdateEventSourceMappingInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateEventSourceMappingInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateEventSourceMappingInput"}
+	if s.BatchSize != nil && *s.BatchSize > 10000 {
+		invalidParams.Add(request.NewErrParamMaxValue("BatchSize", 10000))
+	}
+	if s.FunctionName != nil && len(*s.FunctionName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 140))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBatchSize sets the BatchSize field's value.
 func (s *UpdateEventSourceMappingInput) SetBatchSize(v int64) *UpdateEventSourceMappingInput {
 	s.BatchSize = &v
@@ -7438,7 +8106,7 @@ type UpdateFunctionCodeInput struct {
 	// in the AWS Lambda Developer Guide.
 	//
 	// ZipFile is automatically base64 encoded/decoded by the SDK.
-	ZipFile []byte `type:"blob"`
+	ZipFile []byte `type:"blob" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -7476,6 +8144,33 @@ func (s *UpdateFunctionCodeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateFunctionCodeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateFunctionCodeInput"}
+	if s.FunctionName != nil && len(*s.FunctionName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 140))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.S3Bucket != nil && len(*s.S3Bucket) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3Bucket", 63))
+	}
+	if s.S3Key != nil && len(*s.S3Key) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3Key", 1024))
+	}
+	if s.S3ObjectVersion != nil && len(*s.S3ObjectVersion) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3ObjectVersion", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDryRun sets the DryRun field's value.
 func (s *UpdateFunctionCodeInput) SetDryRun(v bool) *UpdateFunctionCodeInput {
 	s.DryRun = &v
@@ -7553,7 +8248,7 @@ func (s *UpdateFunctionCodeInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.ZipFile != nil {
 		v := s.ZipFile
 
-		e.SetValue(protocol.BodyTarget, "ZipFile", protocol.BytesValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "ZipFile", protocol.BytesValue(v), protocol.Metadata{Sensitive: true})
 	}
 
 	return nil
@@ -7670,6 +8365,63 @@ func (s *UpdateFunctionConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateFunctionConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateFunctionConfigurationInput"}
+	if s.Description != nil && len(*s.Description) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 256))
+	}
+	if s.FunctionName != nil && len(*s.FunctionName) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("FunctionName", 140))
+	}
+	if s.FunctionName != nil && !request.MatchParamPattern("(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?", *s.FunctionName) {
+		invalidParams.Add(request.NewErrParamPattern("FunctionName", "(arn:aws:lambda:)?([a-z]{2}-[a-z]+-\\d{1}:)?(\\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\\$LATEST|[a-zA-Z0-9-_]+))?"))
+	}
+	if s.Handler != nil && len(*s.Handler) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Handler", 128))
+	}
+	if s.Handler != nil && !request.MatchParamPattern("[^\\s]+", *s.Handler) {
+		invalidParams.Add(request.NewErrParamPattern("Handler", "[^\\s]+"))
+	}
+	if s.KMSKeyArn != nil && !request.MatchParamPattern("(arn:aws:[a-z0-9-.]+:.*)|()", *s.KMSKeyArn) {
+		invalidParams.Add(request.NewErrParamPattern("KMSKeyArn", "(arn:aws:[a-z0-9-.]+:.*)|()"))
+	}
+	if s.MemorySize != nil && *s.MemorySize > 1536 {
+		invalidParams.Add(request.NewErrParamMaxValue("MemorySize", 1536))
+	}
+	if s.Role != nil && !request.MatchParamPattern("arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+", *s.Role) {
+		invalidParams.Add(request.NewErrParamPattern("Role", "arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"))
+	}
+	if s.Runtime != nil {
+		values := []string{"nodejs", "nodejs4.3", "nodejs6.10", "java8", "python2.7", "python3.6", "dotnetcore1.0", "nodejs4.3-edge"}
+		if !request.IsParamEnumValue(*s.Runtime, values) {
+			invalidParams.Add(request.NewErrParamEnum("Runtime", *s.Runtime, values))
+		}
+	}
+	if s.DeadLetterConfig != nil {
+		if err := s.DeadLetterConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DeadLetterConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TracingConfig != nil {
+		if err := s.TracingConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TracingConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.VpcConfig != nil {
+		if err := s.VpcConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("VpcConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeadLetterConfig sets the DeadLetterConfig field's value.
 func (s *UpdateFunctionConfigurationInput) SetDeadLetterConfig(v *DeadLetterConfig) *UpdateFunctionConfigurationInput {
 	s.DeadLetterConfig = v
@@ -7833,6 +8585,24 @@ func (s VpcConfig) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *VpcConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "VpcConfig"}
+	if s.SecurityGroupIds != nil && len(s.SecurityGroupIds) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("SecurityGroupIds", 5))
+	}
+	if s.SubnetIds != nil && len(s.SubnetIds) > 16 {
+		invalidParams.Add(request.NewErrParamMaxLen("SubnetIds", 16))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSecurityGroupIds sets the SecurityGroupIds field's value.
 func (s *VpcConfig) SetSecurityGroupIds(v []*string) *VpcConfig {
 	s.SecurityGroupIds = v
@@ -1705,9 +1705,10 @@ const opGetBotChannelAssociation = "GetBotChannelAssociation"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/lex-models-2017-04-19/GetBotChannelAssociation
 func (c *LexModelBuildingService) GetBotChannelAssociationRequest(input *GetBotChannelAssociationInput) (req *request.Request, output *GetBotChannelAssociationOutput) {
 	op := &request.Operation{
-		Name:       opGetBotChannelAssociation,
-		HTTPMethod: "GET",
-		HTTPPath:   "/bots/{botName}/aliases/{aliasName}/channels/{name}",
+		Name:                 opGetBotChannelAssociation,
+		HTTPMethod:           "GET",
+		HTTPPath:             "/bots/{botName}/aliases/{aliasName}/channels/{name}",
+		SensitiveOutputPaths: []string{"botConfiguration"},
 	}
 
 	if input == nil {
@@ -1808,6 +1809,7 @@ func (c *LexModelBuildingService) GetBotChannelAssociationsRequest(input *GetBot
 			LimitToken:      "maxResults",
 			TruncationToken: "",
 		},
+		SensitiveOutputPaths: []string{"botChannelAssociations[*].botConfiguration"},
 	}
 
 	if input == nil {
@@ -4197,7 +4199,7 @@ type BotChannelAssociation struct {
 	BotAlias *string `locationName:"botAlias" min:"1" type:"string"`
 
 	// Provides information necessary to communicate with the messaging platform.
-	BotConfiguration map[string]*string `locationName:"botConfiguration" min:"1" type:"map"`
+	BotConfiguration map[string]*string `locationName:"botConfiguration" min:"1" type:"map" sensitive:"true"`
 
 	// The name of the Amazon Lex bot to which this association is being made.
 	//
@@ -4282,7 +4284,7 @@ func (s *BotChannelAssociation) MarshalFields(e protocol.FieldEncoder) error {
 	if len(s.BotConfiguration) > 0 {
 		v := s.BotConfiguration
 
-		e.SetMap(protocol.BodyTarget, "botConfiguration", protocol.EncodeStringMap(v), protocol.Metadata{})
+		e.SetMap(protocol.BodyTarget, "botConfiguration", protocol.EncodeStringMap(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.BotName != nil {
 		v := *s.BotName
@@ -4650,6 +4652,27 @@ func (s *CodeHook) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CodeHook) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CodeHook"}
+	if s.MessageVersion != nil && len(*s.MessageVersion) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("MessageVersion", 5))
+	}
+	if s.Uri != nil && len(*s.Uri) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Uri", 2048))
+	}
+	if s.Uri != nil && !request.MatchParamPattern("arn:aws:lambda:[a-z]+-[a-z]+-[0-9]:[0-9]{12}:function:[a-zA-Z0-9-_]+(/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})?(:[a-zA-Z0-9-_]+)?", *s.Uri) {
+		invalidParams.Add(request.NewErrParamPattern("Uri", "arn:aws:lambda:[a-z]+-[a-z]+-[0-9]:[0-9]{12}:function:[a-zA-Z0-9-_]+(/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})?(:[a-zA-Z0-9-_]+)?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMessageVersion sets the MessageVersion field's value.
 func (s *CodeHook) SetMessageVersion(v string) *CodeHook {
 	s.MessageVersion = &v
@@ -4722,6 +4745,24 @@ func (s *CreateBotVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateBotVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateBotVersionInput"}
+	if s.Name != nil && len(*s.Name) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 50))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChecksum sets the Checksum field's value.
 func (s *CreateBotVersionInput) SetChecksum(v string) *CreateBotVersionInput {
 	s.Checksum = &v
@@ -5055,6 +5096,24 @@ func (s *CreateIntentVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateIntentVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateIntentVersionInput"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChecksum sets the Checksum field's value.
 func (s *CreateIntentVersionInput) SetChecksum(v string) *CreateIntentVersionInput {
 	s.Checksum = &v
@@ -5363,6 +5422,24 @@ func (s *CreateSlotTypeVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateSlotTypeVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateSlotTypeVersionInput"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChecksum sets the Checksum field's value.
 func (s *CreateSlotTypeVersionInput) SetChecksum(v string) *CreateSlotTypeVersionInput {
 	s.Checksum = &v
@@ -5574,6 +5651,30 @@ func (s *DeleteBotAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteBotAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteBotAliasInput"}
+	if s.BotName != nil && len(*s.BotName) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("BotName", 50))
+	}
+	if s.BotName != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.BotName) {
+		invalidParams.Add(request.NewErrParamPattern("BotName", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBotName sets the BotName field's value.
 func (s *DeleteBotAliasInput) SetBotName(v string) *DeleteBotAliasInput {
 	s.BotName = &v
@@ -5682,6 +5783,36 @@ func (s *DeleteBotChannelAssociationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteBotChannelAssociationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteBotChannelAssociationInput"}
+	if s.BotAlias != nil && len(*s.BotAlias) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BotAlias", 100))
+	}
+	if s.BotAlias != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.BotAlias) {
+		invalidParams.Add(request.NewErrParamPattern("BotAlias", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.BotName != nil && len(*s.BotName) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("BotName", 50))
+	}
+	if s.BotName != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.BotName) {
+		invalidParams.Add(request.NewErrParamPattern("BotName", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBotAlias sets the BotAlias field's value.
 func (s *DeleteBotChannelAssociationInput) SetBotAlias(v string) *DeleteBotChannelAssociationInput {
 	s.BotAlias = &v
@@ -5778,6 +5909,24 @@ func (s *DeleteBotInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteBotInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteBotInput"}
+	if s.Name != nil && len(*s.Name) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 50))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteBotInput) SetName(v string) *DeleteBotInput {
 	s.Name = &v
@@ -5864,6 +6013,30 @@ func (s *DeleteBotVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteBotVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteBotVersionInput"}
+	if s.Name != nil && len(*s.Name) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 50))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.Version != nil && len(*s.Version) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 64))
+	}
+	if s.Version != nil && !request.MatchParamPattern("[0-9]+", *s.Version) {
+		invalidParams.Add(request.NewErrParamPattern("Version", "[0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteBotVersionInput) SetName(v string) *DeleteBotVersionInput {
 	s.Name = &v
@@ -5949,6 +6122,24 @@ func (s *DeleteIntentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteIntentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteIntentInput"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteIntentInput) SetName(v string) *DeleteIntentInput {
 	s.Name = &v
@@ -6035,6 +6226,30 @@ func (s *DeleteIntentVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteIntentVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteIntentVersionInput"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.Version != nil && len(*s.Version) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 64))
+	}
+	if s.Version != nil && !request.MatchParamPattern("[0-9]+", *s.Version) {
+		invalidParams.Add(request.NewErrParamPattern("Version", "[0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteIntentVersionInput) SetName(v string) *DeleteIntentVersionInput {
 	s.Name = &v
@@ -6120,6 +6335,24 @@ func (s *DeleteSlotTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteSlotTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSlotTypeInput"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteSlotTypeInput) SetName(v string) *DeleteSlotTypeInput {
 	s.Name = &v
@@ -6206,6 +6439,30 @@ func (s *DeleteSlotTypeVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteSlotTypeVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSlotTypeVersionInput"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.Version != nil && len(*s.Version) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 64))
+	}
+	if s.Version != nil && !request.MatchParamPattern("[0-9]+", *s.Version) {
+		invalidParams.Add(request.NewErrParamPattern("Version", "[0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteSlotTypeVersionInput) SetName(v string) *DeleteSlotTypeVersionInput {
 	s.Name = &v
@@ -6305,6 +6562,27 @@ func (s *DeleteUtterancesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteUtterancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteUtterancesInput"}
+	if s.BotName != nil && len(*s.BotName) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("BotName", 50))
+	}
+	if s.BotName != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.BotName) {
+		invalidParams.Add(request.NewErrParamPattern("BotName", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.UserId != nil && len(*s.UserId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBotName sets the BotName field's value.
 func (s *DeleteUtterancesInput) SetBotName(v string) *DeleteUtterancesInput {
 	s.BotName = &v
@@ -6405,6 +6683,21 @@ func (s *EnumerationValue) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnumerationValue) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnumerationValue"}
+	if s.Value != nil && len(*s.Value) > 140 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 140))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSynonyms sets the Synonyms field's value.
 func (s *EnumerationValue) SetSynonyms(v []*string) *EnumerationValue {
 	s.Synonyms = v
@@ -6496,6 +6789,28 @@ func (s *FollowUpPrompt) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *FollowUpPrompt) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "FollowUpPrompt"}
+	if s.Prompt != nil {
+		if err := s.Prompt.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Prompt", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RejectionStatement != nil {
+		if err := s.RejectionStatement.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RejectionStatement", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPrompt sets the Prompt field's value.
 func (s *FollowUpPrompt) SetPrompt(v *Prompt) *FollowUpPrompt {
 	s.Prompt = v
@@ -6584,6 +6899,29 @@ func (s *FulfillmentActivity) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *FulfillmentActivity) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "FulfillmentActivity"}
+	if s.Type != nil {
+		values := []string{"ReturnIntent", "CodeHook"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.CodeHook != nil {
+		if err := s.CodeHook.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CodeHook", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCodeHook sets the CodeHook field's value.
 func (s *FulfillmentActivity) SetCodeHook(v *CodeHook) *FulfillmentActivity {
 	s.CodeHook = v
@@ -6659,6 +6997,30 @@ func (s *GetBotAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetBotAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetBotAliasInput"}
+	if s.BotName != nil && len(*s.BotName) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("BotName", 50))
+	}
+	if s.BotName != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.BotName) {
+		invalidParams.Add(request.NewErrParamPattern("BotName", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBotName sets the BotName field's value.
 func (s *GetBotAliasInput) SetBotName(v string) *GetBotAliasInput {
 	s.BotName = &v
@@ -6864,6 +7226,33 @@ func (s *GetBotAliasesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetBotAliasesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetBotAliasesInput"}
+	if s.BotName != nil && len(*s.BotName) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("BotName", 50))
+	}
+	if s.BotName != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.BotName) {
+		invalidParams.Add(request.NewErrParamPattern("BotName", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.NameContains != nil && len(*s.NameContains) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("NameContains", 100))
+	}
+	if s.NameContains != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.NameContains) {
+		invalidParams.Add(request.NewErrParamPattern("NameContains", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBotName sets the BotName field's value.
 func (s *GetBotAliasesInput) SetBotName(v string) *GetBotAliasesInput {
 	s.BotName = &v
@@ -7026,6 +7415,36 @@ func (s *GetBotChannelAssociationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetBotChannelAssociationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetBotChannelAssociationInput"}
+	if s.BotAlias != nil && len(*s.BotAlias) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BotAlias", 100))
+	}
+	if s.BotAlias != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.BotAlias) {
+		invalidParams.Add(request.NewErrParamPattern("BotAlias", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.BotName != nil && len(*s.BotName) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("BotName", 50))
+	}
+	if s.BotName != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.BotName) {
+		invalidParams.Add(request.NewErrParamPattern("BotName", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBotAlias sets the BotAlias field's value.
 func (s *GetBotChannelAssociationInput) SetBotAlias(v string) *GetBotChannelAssociationInput {
 	s.BotAlias = &v
@@ -7075,7 +7494,7 @@ type GetBotChannelAssociationOutput struct {
 
 	// Provides information that the messaging platform needs to communicate with
 	// the Amazon Lex bot.
-	BotConfiguration map[string]*string `locationName:"botConfiguration" min:"1" type:"map"`
+	BotConfiguration map[string]*string `locationName:"botConfiguration" min:"1" type:"map" sensitive:"true"`
 
 	// The name of the Amazon Lex bot.
 	BotName *string `locationName:"botName" min:"2" type:"string"`
@@ -7155,7 +7574,7 @@ func (s *GetBotChannelAssociationOutput) MarshalFields(e protocol.FieldEncoder)
 	if len(s.BotConfiguration) > 0 {
 		v := s.BotConfiguration
 
-		e.SetMap(protocol.BodyTarget, "botConfiguration", protocol.EncodeStringMap(v), protocol.Metadata{})
+		e.SetMap(protocol.BodyTarget, "botConfiguration", protocol.EncodeStringMap(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.BotName != nil {
 		v := *s.BotName
@@ -7256,6 +7675,39 @@ func (s *GetBotChannelAssociationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetBotChannelAssociationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetBotChannelAssociationsInput"}
+	if s.BotAlias != nil && len(*s.BotAlias) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("BotAlias", 100))
+	}
+	if s.BotAlias != nil && !request.MatchParamPattern("^(-|^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*))$", *s.BotAlias) {
+		invalidParams.Add(request.NewErrParamPattern("BotAlias", "^(-|^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*))$"))
+	}
+	if s.BotName != nil && len(*s.BotName) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("BotName", 50))
+	}
+	if s.BotName != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.BotName) {
+		invalidParams.Add(request.NewErrParamPattern("BotName", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.NameContains != nil && len(*s.NameContains) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("NameContains", 100))
+	}
+	if s.NameContains != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.NameContains) {
+		invalidParams.Add(request.NewErrParamPattern("NameContains", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBotAlias sets the BotAlias field's value.
 func (s *GetBotChannelAssociationsInput) SetBotAlias(v string) *GetBotChannelAssociationsInput {
 	s.BotAlias = &v
@@ -7414,6 +7866,24 @@ func (s *GetBotInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetBotInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetBotInput"}
+	if s.Name != nil && len(*s.Name) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 50))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *GetBotInput) SetName(v string) *GetBotInput {
 	s.Name = &v
@@ -7753,12 +8223,33 @@ func (s *GetBotVersionsInput) Validate() error {
 	return nil
 }
 
-// SetMaxResults sets the MaxResults field's value.
-func (s *GetBotVersionsInput) SetMaxResults(v int64) *GetBotVersionsInput {
-	s.MaxResults = &v
-	return s
-}
-
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetBotVersionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetBotVersionsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.Name != nil && len(*s.Name) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 50))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaxResults sets the MaxResults field's value.
+func (s *GetBotVersionsInput) SetMaxResults(v int64) *GetBotVersionsInput {
+	s.MaxResults = &v
+	return s
+}
+
 // SetName sets the Name field's value.
 func (s *GetBotVersionsInput) SetName(v string) *GetBotVersionsInput {
 	s.Name = &v
@@ -7891,6 +8382,27 @@ func (s *GetBotsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetBotsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetBotsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.NameContains != nil && len(*s.NameContains) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("NameContains", 50))
+	}
+	if s.NameContains != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.NameContains) {
+		invalidParams.Add(request.NewErrParamPattern("NameContains", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *GetBotsInput) SetMaxResults(v int64) *GetBotsInput {
 	s.MaxResults = &v
@@ -8142,6 +8654,27 @@ func (s *GetBuiltinIntentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetBuiltinIntentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetBuiltinIntentsInput"}
+	if s.Locale != nil {
+		values := []string{"en-US"}
+		if !request.IsParamEnumValue(*s.Locale, values) {
+			invalidParams.Add(request.NewErrParamEnum("Locale", *s.Locale, values))
+		}
+	}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLocale sets the Locale field's value.
 func (s *GetBuiltinIntentsInput) SetLocale(v string) *GetBuiltinIntentsInput {
 	s.Locale = &v
@@ -8290,6 +8823,27 @@ func (s *GetBuiltinSlotTypesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetBuiltinSlotTypesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetBuiltinSlotTypesInput"}
+	if s.Locale != nil {
+		values := []string{"en-US"}
+		if !request.IsParamEnumValue(*s.Locale, values) {
+			invalidParams.Add(request.NewErrParamEnum("Locale", *s.Locale, values))
+		}
+	}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLocale sets the Locale field's value.
 func (s *GetBuiltinSlotTypesInput) SetLocale(v string) *GetBuiltinSlotTypesInput {
 	s.Locale = &v
@@ -8454,6 +9008,42 @@ func (s *GetExportInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetExportInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetExportInput"}
+	if s.ExportType != nil {
+		values := []string{"ALEXA_SKILLS_KIT"}
+		if !request.IsParamEnumValue(*s.ExportType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ExportType", *s.ExportType, values))
+		}
+	}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[a-zA-Z_]+", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[a-zA-Z_]+"))
+	}
+	if s.ResourceType != nil {
+		values := []string{"BOT"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+	if s.Version != nil && len(*s.Version) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 64))
+	}
+	if s.Version != nil && !request.MatchParamPattern("[0-9]+", *s.Version) {
+		invalidParams.Add(request.NewErrParamPattern("Version", "[0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExportType sets the ExportType field's value.
 func (s *GetExportInput) SetExportType(v string) *GetExportInput {
 	s.ExportType = &v
@@ -8680,6 +9270,30 @@ func (s *GetIntentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetIntentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetIntentInput"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.Version != nil && len(*s.Version) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 64))
+	}
+	if s.Version != nil && !request.MatchParamPattern("\\$LATEST|[0-9]+", *s.Version) {
+		invalidParams.Add(request.NewErrParamPattern("Version", "\\$LATEST|[0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *GetIntentInput) SetName(v string) *GetIntentInput {
 	s.Name = &v
@@ -8994,6 +9608,27 @@ func (s *GetIntentVersionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetIntentVersionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetIntentVersionsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *GetIntentVersionsInput) SetMaxResults(v int64) *GetIntentVersionsInput {
 	s.MaxResults = &v
@@ -9131,6 +9766,27 @@ func (s *GetIntentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetIntentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetIntentsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.NameContains != nil && len(*s.NameContains) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("NameContains", 100))
+	}
+	if s.NameContains != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.NameContains) {
+		invalidParams.Add(request.NewErrParamPattern("NameContains", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *GetIntentsInput) SetMaxResults(v int64) *GetIntentsInput {
 	s.MaxResults = &v
@@ -9267,6 +9923,30 @@ func (s *GetSlotTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetSlotTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetSlotTypeInput"}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.Version != nil && len(*s.Version) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 64))
+	}
+	if s.Version != nil && !request.MatchParamPattern("\\$LATEST|[0-9]+", *s.Version) {
+		invalidParams.Add(request.NewErrParamPattern("Version", "\\$LATEST|[0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *GetSlotTypeInput) SetName(v string) *GetSlotTypeInput {
 	s.Name = &v
@@ -9480,6 +10160,27 @@ func (s *GetSlotTypeVersionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetSlotTypeVersionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetSlotTypeVersionsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *GetSlotTypeVersionsInput) SetMaxResults(v int64) *GetSlotTypeVersionsInput {
 	s.MaxResults = &v
@@ -9618,6 +10319,27 @@ func (s *GetSlotTypesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetSlotTypesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetSlotTypesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.NameContains != nil && len(*s.NameContains) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("NameContains", 100))
+	}
+	if s.NameContains != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.NameContains) {
+		invalidParams.Add(request.NewErrParamPattern("NameContains", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *GetSlotTypesInput) SetMaxResults(v int64) *GetSlotTypesInput {
 	s.MaxResults = &v
@@ -9765,6 +10487,33 @@ func (s *GetUtterancesViewInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetUtterancesViewInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetUtterancesViewInput"}
+	if s.BotName != nil && len(*s.BotName) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("BotName", 50))
+	}
+	if s.BotName != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.BotName) {
+		invalidParams.Add(request.NewErrParamPattern("BotName", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.BotVersions != nil && len(s.BotVersions) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("BotVersions", 5))
+	}
+	if s.StatusType != nil {
+		values := []string{"Detected", "Missed"}
+		if !request.IsParamEnumValue(*s.StatusType, values) {
+			invalidParams.Add(request.NewErrParamEnum("StatusType", *s.StatusType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBotName sets the BotName field's value.
 func (s *GetUtterancesViewInput) SetBotName(v string) *GetUtterancesViewInput {
 	s.BotName = &v
@@ -9903,6 +10652,30 @@ func (s *Intent) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Intent) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Intent"}
+	if s.IntentName != nil && len(*s.IntentName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("IntentName", 100))
+	}
+	if s.IntentName != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.IntentName) {
+		invalidParams.Add(request.NewErrParamPattern("IntentName", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.IntentVersion != nil && len(*s.IntentVersion) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("IntentVersion", 64))
+	}
+	if s.IntentVersion != nil && !request.MatchParamPattern("\\$LATEST|[0-9]+", *s.IntentVersion) {
+		invalidParams.Add(request.NewErrParamPattern("IntentVersion", "\\$LATEST|[0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIntentName sets the IntentName field's value.
 func (s *Intent) SetIntentName(v string) *Intent {
 	s.IntentName = &v
@@ -10085,6 +10858,27 @@ func (s *Message) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Message) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Message"}
+	if s.Content != nil && len(*s.Content) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Content", 1000))
+	}
+	if s.ContentType != nil {
+		values := []string{"PlainText", "SSML"}
+		if !request.IsParamEnumValue(*s.ContentType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ContentType", *s.ContentType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContent sets the Content field's value.
 func (s *Message) SetContent(v string) *Message {
 	s.Content = &v
@@ -10192,6 +10986,37 @@ func (s *Prompt) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Prompt) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Prompt"}
+	if s.MaxAttempts != nil && *s.MaxAttempts > 5 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxAttempts", 5))
+	}
+	if s.Messages != nil && len(s.Messages) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Messages", 5))
+	}
+	if s.ResponseCard != nil && len(*s.ResponseCard) > 50000 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResponseCard", 50000))
+	}
+	if s.Messages != nil {
+		for i, v := range s.Messages {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Messages", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxAttempts sets the MaxAttempts field's value.
 func (s *Prompt) SetMaxAttempts(v int64) *Prompt {
 	s.MaxAttempts = &v
@@ -10303,6 +11128,39 @@ func (s *PutBotAliasInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutBotAliasInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutBotAliasInput"}
+	if s.BotName != nil && len(*s.BotName) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("BotName", 50))
+	}
+	if s.BotName != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.BotName) {
+		invalidParams.Add(request.NewErrParamPattern("BotName", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.BotVersion != nil && len(*s.BotVersion) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("BotVersion", 64))
+	}
+	if s.BotVersion != nil && !request.MatchParamPattern("\\$LATEST|[0-9]+", *s.BotVersion) {
+		invalidParams.Add(request.NewErrParamPattern("BotVersion", "\\$LATEST|[0-9]+"))
+	}
+	if s.Description != nil && len(*s.Description) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 200))
+	}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBotName sets the BotName field's value.
 func (s *PutBotAliasInput) SetBotName(v string) *PutBotAliasInput {
 	s.BotName = &v
@@ -10662,6 +11520,62 @@ func (s *PutBotInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutBotInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutBotInput"}
+	if s.Description != nil && len(*s.Description) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 200))
+	}
+	if s.IdleSessionTTLInSeconds != nil && *s.IdleSessionTTLInSeconds > 86400 {
+		invalidParams.Add(request.NewErrParamMaxValue("IdleSessionTTLInSeconds", 86400))
+	}
+	if s.Locale != nil {
+		values := []string{"en-US"}
+		if !request.IsParamEnumValue(*s.Locale, values) {
+			invalidParams.Add(request.NewErrParamEnum("Locale", *s.Locale, values))
+		}
+	}
+	if s.Name != nil && len(*s.Name) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 50))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.ProcessBehavior != nil {
+		values := []string{"SAVE", "BUILD"}
+		if !request.IsParamEnumValue(*s.ProcessBehavior, values) {
+			invalidParams.Add(request.NewErrParamEnum("ProcessBehavior", *s.ProcessBehavior, values))
+		}
+	}
+	if s.AbortStatement != nil {
+		if err := s.AbortStatement.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AbortStatement", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ClarificationPrompt != nil {
+		if err := s.ClarificationPrompt.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ClarificationPrompt", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Intents != nil {
+		for i, v := range s.Intents {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Intents", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAbortStatement sets the AbortStatement field's value.
 func (s *PutBotInput) SetAbortStatement(v *Statement) *PutBotInput {
 	s.AbortStatement = v
@@ -11241,6 +12155,73 @@ func (s *PutIntentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutIntentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutIntentInput"}
+	if s.Description != nil && len(*s.Description) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 200))
+	}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.SampleUtterances != nil && len(s.SampleUtterances) > 1500 {
+		invalidParams.Add(request.NewErrParamMaxLen("SampleUtterances", 1500))
+	}
+	if s.Slots != nil && len(s.Slots) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Slots", 100))
+	}
+	if s.ConclusionStatement != nil {
+		if err := s.ConclusionStatement.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ConclusionStatement", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ConfirmationPrompt != nil {
+		if err := s.ConfirmationPrompt.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ConfirmationPrompt", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DialogCodeHook != nil {
+		if err := s.DialogCodeHook.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DialogCodeHook", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.FollowUpPrompt != nil {
+		if err := s.FollowUpPrompt.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FollowUpPrompt", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.FulfillmentActivity != nil {
+		if err := s.FulfillmentActivity.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FulfillmentActivity", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RejectionStatement != nil {
+		if err := s.RejectionStatement.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RejectionStatement", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Slots != nil {
+		for i, v := range s.Slots {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Slots", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChecksum sets the Checksum field's value.
 func (s *PutIntentInput) SetChecksum(v string) *PutIntentInput {
 	s.Checksum = &v
@@ -11714,6 +12695,46 @@ func (s *PutSlotTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutSlotTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutSlotTypeInput"}
+	if s.Description != nil && len(*s.Description) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 200))
+	}
+	if s.EnumerationValues != nil && len(s.EnumerationValues) > 10000 {
+		invalidParams.Add(request.NewErrParamMaxLen("EnumerationValues", 10000))
+	}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.ValueSelectionStrategy != nil {
+		values := []string{"ORIGINAL_VALUE", "TOP_RESOLUTION"}
+		if !request.IsParamEnumValue(*s.ValueSelectionStrategy, values) {
+			invalidParams.Add(request.NewErrParamEnum("ValueSelectionStrategy", *s.ValueSelectionStrategy, values))
+		}
+	}
+	if s.EnumerationValues != nil {
+		for i, v := range s.EnumerationValues {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "EnumerationValues", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChecksum sets the Checksum field's value.
 func (s *PutSlotTypeInput) SetChecksum(v string) *PutSlotTypeInput {
 	s.Checksum = &v
@@ -12057,6 +13078,59 @@ func (s *Slot) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Slot) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Slot"}
+	if s.Description != nil && len(*s.Description) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 200))
+	}
+	if s.Name != nil && len(*s.Name) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 100))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z]+(((_|.)[a-zA-Z]+)*|([a-zA-Z]+(_|.))*|(_|.))", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z]+(((_|.)[a-zA-Z]+)*|([a-zA-Z]+(_|.))*|(_|.))"))
+	}
+	if s.Priority != nil && *s.Priority > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Priority", 100))
+	}
+	if s.ResponseCard != nil && len(*s.ResponseCard) > 50000 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResponseCard", 50000))
+	}
+	if s.SampleUtterances != nil && len(s.SampleUtterances) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("SampleUtterances", 10))
+	}
+	if s.SlotConstraint != nil {
+		values := []string{"Required", "Optional"}
+		if !request.IsParamEnumValue(*s.SlotConstraint, values) {
+			invalidParams.Add(request.NewErrParamEnum("SlotConstraint", *s.SlotConstraint, values))
+		}
+	}
+	if s.SlotType != nil && len(*s.SlotType) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("SlotType", 100))
+	}
+	if s.SlotType != nil && !request.MatchParamPattern("^([a-zA-Z]|AMAZON.)+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)", *s.SlotType) {
+		invalidParams.Add(request.NewErrParamPattern("SlotType", "^([a-zA-Z]|AMAZON.)+((_[a-zA-Z]+)*|([a-zA-Z]+_)*|_)"))
+	}
+	if s.SlotTypeVersion != nil && len(*s.SlotTypeVersion) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("SlotTypeVersion", 64))
+	}
+	if s.SlotTypeVersion != nil && !request.MatchParamPattern("\\$LATEST|[0-9]+", *s.SlotTypeVersion) {
+		invalidParams.Add(request.NewErrParamPattern("SlotTypeVersion", "\\$LATEST|[0-9]+"))
+	}
+	if s.ValueElicitationPrompt != nil {
+		if err := s.ValueElicitationPrompt.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ValueElicitationPrompt", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *Slot) SetDescription(v string) *Slot {
 	s.Description = &v
@@ -12328,6 +13402,34 @@ func (s *Statement) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Statement) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Statement"}
+	if s.Messages != nil && len(s.Messages) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Messages", 5))
+	}
+	if s.ResponseCard != nil && len(*s.ResponseCard) > 50000 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResponseCard", 50000))
+	}
+	if s.Messages != nil {
+		for i, v := range s.Messages {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Messages", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMessages sets the Messages field's value.
 func (s *Statement) SetMessages(v []*Message) *Statement {
 	s.Messages = v
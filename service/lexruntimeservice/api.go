@@ -40,9 +40,10 @@ const opPostContent = "PostContent"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/runtime.lex-2016-11-28/PostContent
 func (c *LexRuntimeService) PostContentRequest(input *PostContentInput) (req *request.Request, output *PostContentOutput) {
 	op := &request.Operation{
-		Name:       opPostContent,
-		HTTPMethod: "POST",
-		HTTPPath:   "/bot/{botName}/alias/{botAlias}/user/{userId}/content",
+		Name:                   opPostContent,
+		HTTPMethod:             "POST",
+		HTTPPath:               "/bot/{botName}/alias/{botAlias}/user/{userId}/content",
+		SensitiveOutputHeaders: []string{"x-amz-lex-message"},
 	}
 
 	if input == nil {
@@ -218,9 +219,10 @@ const opPostText = "PostText"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/runtime.lex-2016-11-28/PostText
 func (c *LexRuntimeService) PostTextRequest(input *PostTextInput) (req *request.Request, output *PostTextOutput) {
 	op := &request.Operation{
-		Name:       opPostText,
-		HTTPMethod: "POST",
-		HTTPPath:   "/bot/{botName}/alias/{botAlias}/user/{userId}/text",
+		Name:                 opPostText,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/bot/{botName}/alias/{botAlias}/user/{userId}/text",
+		SensitiveOutputPaths: []string{"message", "sessionAttributes", "slots"},
 	}
 
 	if input == nil {
@@ -680,6 +682,24 @@ func (s *PostContentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PostContentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PostContentInput"}
+	if s.UserId != nil && len(*s.UserId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserId", 100))
+	}
+	if s.UserId != nil && !request.MatchParamPattern("[0-9a-zA-Z._:-]+", *s.UserId) {
+		invalidParams.Add(request.NewErrParamPattern("UserId", "[0-9a-zA-Z._:-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccept sets the Accept field's value.
 func (s *PostContentInput) SetAccept(v string) *PostContentInput {
 	s.Accept = &v
@@ -856,7 +876,7 @@ type PostContentOutput struct {
 	// the confirmation prompt message in the intent configuration. If the code
 	// hook returns a message, Amazon Lex passes it as-is in its response to the
 	// client.
-	Message *string `location:"header" locationName:"x-amz-lex-message" min:"1" type:"string"`
+	Message *string `location:"header" locationName:"x-amz-lex-message" min:"1" type:"string" sensitive:"true"`
 
 	// Map of key/value pairs representing the session-specific context information.
 	SessionAttributes aws.JSONValue `location:"header" locationName:"x-amz-lex-session-attributes" type:"jsonvalue"`
@@ -969,7 +989,7 @@ func (s *PostContentOutput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.Message != nil {
 		v := *s.Message
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-lex-message", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-lex-message", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.SessionAttributes != nil {
 		v := s.SessionAttributes
@@ -1007,7 +1027,7 @@ type PostTextInput struct {
 	// The text that the user entered (Amazon Lex interprets this text).
 	//
 	// InputText is a required field
-	InputText *string `locationName:"inputText" min:"1" type:"string" required:"true"`
+	InputText *string `locationName:"inputText" min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// Request-specific information passed between Amazon Lex and a client application.
 	//
@@ -1015,12 +1035,12 @@ type PostTextInput struct {
 	// any request attributes with the prefix x-amz-lex:.
 	//
 	// For more information, see Setting Request Attributes (http://docs.aws.amazon.com/lex/latest/dg/context-mgmt.html#context-mgmt-request-attribs).
-	RequestAttributes map[string]*string `locationName:"requestAttributes" type:"map"`
+	RequestAttributes map[string]*string `locationName:"requestAttributes" type:"map" sensitive:"true"`
 
 	// Application-specific information passed between Amazon Lex and a client application.
 	//
 	// For more information, see Setting Session Attributes (http://docs.aws.amazon.com/lex/latest/dg/context-mgmt.html#context-mgmt-session-attribs).
-	SessionAttributes map[string]*string `locationName:"sessionAttributes" type:"map"`
+	SessionAttributes map[string]*string `locationName:"sessionAttributes" type:"map" sensitive:"true"`
 
 	// The ID of the client application user. Amazon Lex uses this to identify a
 	// user's conversation with your bot. At runtime, each request must contain
@@ -1088,6 +1108,27 @@ func (s *PostTextInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PostTextInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PostTextInput"}
+	if s.InputText != nil && len(*s.InputText) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("InputText", 1024))
+	}
+	if s.UserId != nil && len(*s.UserId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserId", 100))
+	}
+	if s.UserId != nil && !request.MatchParamPattern("[0-9a-zA-Z._:-]+", *s.UserId) {
+		invalidParams.Add(request.NewErrParamPattern("UserId", "[0-9a-zA-Z._:-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBotAlias sets the BotAlias field's value.
 func (s *PostTextInput) SetBotAlias(v string) *PostTextInput {
 	s.BotAlias = &v
@@ -1139,17 +1180,17 @@ func (s *PostTextInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.InputText != nil {
 		v := *s.InputText
 
-		e.SetValue(protocol.BodyTarget, "inputText", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "inputText", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if len(s.RequestAttributes) > 0 {
 		v := s.RequestAttributes
 
-		e.SetMap(protocol.BodyTarget, "requestAttributes", protocol.EncodeStringMap(v), protocol.Metadata{})
+		e.SetMap(protocol.BodyTarget, "requestAttributes", protocol.EncodeStringMap(v), protocol.Metadata{Sensitive: true})
 	}
 	if len(s.SessionAttributes) > 0 {
 		v := s.SessionAttributes
 
-		e.SetMap(protocol.BodyTarget, "sessionAttributes", protocol.EncodeStringMap(v), protocol.Metadata{})
+		e.SetMap(protocol.BodyTarget, "sessionAttributes", protocol.EncodeStringMap(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.UserId != nil {
 		v := *s.UserId
@@ -1223,7 +1264,7 @@ type PostTextOutput struct {
 	// the confirmation prompt message in the intent configuration. If the code
 	// hook returns a message, Amazon Lex passes it as-is in its response to the
 	// client.
-	Message *string `locationName:"message" min:"1" type:"string"`
+	Message *string `locationName:"message" min:"1" type:"string" sensitive:"true"`
 
 	// Represents the options that the user has to respond to the current prompt.
 	// Response Card can come from the bot configuration (in the Amazon Lex console,
@@ -1231,7 +1272,7 @@ type PostTextOutput struct {
 	ResponseCard *ResponseCard `locationName:"responseCard" type:"structure"`
 
 	// A map of key-value pairs representing the session-specific context information.
-	SessionAttributes map[string]*string `locationName:"sessionAttributes" type:"map"`
+	SessionAttributes map[string]*string `locationName:"sessionAttributes" type:"map" sensitive:"true"`
 
 	// If the dialogState value is ElicitSlot, returns the name of the slot for
 	// which Amazon Lex is eliciting a value.
@@ -1247,7 +1288,7 @@ type PostTextOutput struct {
 	// TOP_RESOLUTION Amazon Lex returns the first value in the resolution list
 	// or, if there is no resolution list, null. If you don't specify a valueSelectionStrategy,
 	// the default is ORIGINAL_VALUE.
-	Slots map[string]*string `locationName:"slots" type:"map"`
+	Slots map[string]*string `locationName:"slots" type:"map" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -1317,7 +1358,7 @@ func (s *PostTextOutput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.Message != nil {
 		v := *s.Message
 
-		e.SetValue(protocol.BodyTarget, "message", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "message", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ResponseCard != nil {
 		v := s.ResponseCard
@@ -1327,7 +1368,7 @@ func (s *PostTextOutput) MarshalFields(e protocol.FieldEncoder) error {
 	if len(s.SessionAttributes) > 0 {
 		v := s.SessionAttributes
 
-		e.SetMap(protocol.BodyTarget, "sessionAttributes", protocol.EncodeStringMap(v), protocol.Metadata{})
+		e.SetMap(protocol.BodyTarget, "sessionAttributes", protocol.EncodeStringMap(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.SlotToElicit != nil {
 		v := *s.SlotToElicit
@@ -1337,7 +1378,7 @@ func (s *PostTextOutput) MarshalFields(e protocol.FieldEncoder) error {
 	if len(s.Slots) > 0 {
 		v := s.Slots
 
-		e.SetMap(protocol.BodyTarget, "slots", protocol.EncodeStringMap(v), protocol.Metadata{})
+		e.SetMap(protocol.BodyTarget, "slots", protocol.EncodeStringMap(v), protocol.Metadata{Sensitive: true})
 	}
 
 	return nil
@@ -3,6 +3,7 @@
 package lightsail
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -5058,6 +5059,21 @@ func (s *AllocateStaticIpInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AllocateStaticIpInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AllocateStaticIpInput"}
+	if s.StaticIpName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.StaticIpName) {
+		invalidParams.Add(request.NewErrParamPattern("StaticIpName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStaticIpName sets the StaticIpName field's value.
 func (s *AllocateStaticIpInput) SetStaticIpName(v string) *AllocateStaticIpInput {
 	s.StaticIpName = &v
@@ -5130,6 +5146,24 @@ func (s *AttachStaticIpInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttachStaticIpInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttachStaticIpInput"}
+	if s.InstanceName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceName", "\\w[\\w\\-]*\\w"))
+	}
+	if s.StaticIpName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.StaticIpName) {
+		invalidParams.Add(request.NewErrParamPattern("StaticIpName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceName sets the InstanceName field's value.
 func (s *AttachStaticIpInput) SetInstanceName(v string) *AttachStaticIpInput {
 	s.InstanceName = &v
@@ -5467,6 +5501,26 @@ func (s *CloseInstancePublicPortsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CloseInstancePublicPortsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CloseInstancePublicPortsInput"}
+	if s.InstanceName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceName", "\\w[\\w\\-]*\\w"))
+	}
+	if s.PortInfo != nil {
+		if err := s.PortInfo.ValidateExtended(); err != nil {
+			invalidParams.AddNested("PortInfo", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceName sets the InstanceName field's value.
 func (s *CloseInstancePublicPortsInput) SetInstanceName(v string) *CloseInstancePublicPortsInput {
 	s.InstanceName = &v
@@ -5546,6 +5600,23 @@ func (s *CreateDomainEntryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDomainEntryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDomainEntryInput"}
+	if s.DomainEntry != nil {
+		if err := s.DomainEntry.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DomainEntry", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainEntry sets the DomainEntry field's value.
 func (s *CreateDomainEntryInput) SetDomainEntry(v *DomainEntry) *CreateDomainEntryInput {
 	s.DomainEntry = v
@@ -5692,6 +5763,24 @@ func (s *CreateInstanceSnapshotInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateInstanceSnapshotInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateInstanceSnapshotInput"}
+	if s.InstanceName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceName", "\\w[\\w\\-]*\\w"))
+	}
+	if s.InstanceSnapshotName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceSnapshotName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceSnapshotName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceName sets the InstanceName field's value.
 func (s *CreateInstanceSnapshotInput) SetInstanceName(v string) *CreateInstanceSnapshotInput {
 	s.InstanceName = &v
@@ -5805,6 +5894,27 @@ func (s *CreateInstancesFromSnapshotInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateInstancesFromSnapshotInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateInstancesFromSnapshotInput"}
+	if s.BundleId != nil && !request.MatchParamPattern(".*\\S.*", *s.BundleId) {
+		invalidParams.Add(request.NewErrParamPattern("BundleId", ".*\\S.*"))
+	}
+	if s.InstanceSnapshotName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceSnapshotName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceSnapshotName", "\\w[\\w\\-]*\\w"))
+	}
+	if s.KeyPairName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.KeyPairName) {
+		invalidParams.Add(request.NewErrParamPattern("KeyPairName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAvailabilityZone sets the AvailabilityZone field's value.
 func (s *CreateInstancesFromSnapshotInput) SetAvailabilityZone(v string) *CreateInstancesFromSnapshotInput {
 	s.AvailabilityZone = &v
@@ -5949,6 +6059,30 @@ func (s *CreateInstancesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateInstancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateInstancesInput"}
+	if s.BlueprintId != nil && !request.MatchParamPattern(".*\\S.*", *s.BlueprintId) {
+		invalidParams.Add(request.NewErrParamPattern("BlueprintId", ".*\\S.*"))
+	}
+	if s.BundleId != nil && !request.MatchParamPattern(".*\\S.*", *s.BundleId) {
+		invalidParams.Add(request.NewErrParamPattern("BundleId", ".*\\S.*"))
+	}
+	if s.CustomImageName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.CustomImageName) {
+		invalidParams.Add(request.NewErrParamPattern("CustomImageName", "\\w[\\w\\-]*\\w"))
+	}
+	if s.KeyPairName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.KeyPairName) {
+		invalidParams.Add(request.NewErrParamPattern("KeyPairName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAvailabilityZone sets the AvailabilityZone field's value.
 func (s *CreateInstancesInput) SetAvailabilityZone(v string) *CreateInstancesInput {
 	s.AvailabilityZone = &v
@@ -6049,6 +6183,21 @@ func (s *CreateKeyPairInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateKeyPairInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateKeyPairInput"}
+	if s.KeyPairName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.KeyPairName) {
+		invalidParams.Add(request.NewErrParamPattern("KeyPairName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyPairName sets the KeyPairName field's value.
 func (s *CreateKeyPairInput) SetKeyPairName(v string) *CreateKeyPairInput {
 	s.KeyPairName = &v
@@ -6149,6 +6298,23 @@ func (s *DeleteDomainEntryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteDomainEntryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDomainEntryInput"}
+	if s.DomainEntry != nil {
+		if err := s.DomainEntry.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DomainEntry", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainEntry sets the DomainEntry field's value.
 func (s *DeleteDomainEntryInput) SetDomainEntry(v *DomainEntry) *DeleteDomainEntryInput {
 	s.DomainEntry = v
@@ -6283,6 +6449,21 @@ func (s *DeleteInstanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteInstanceInput"}
+	if s.InstanceName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceName sets the InstanceName field's value.
 func (s *DeleteInstanceInput) SetInstanceName(v string) *DeleteInstanceInput {
 	s.InstanceName = &v
@@ -6347,6 +6528,21 @@ func (s *DeleteInstanceSnapshotInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteInstanceSnapshotInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteInstanceSnapshotInput"}
+	if s.InstanceSnapshotName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceSnapshotName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceSnapshotName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceSnapshotName sets the InstanceSnapshotName field's value.
 func (s *DeleteInstanceSnapshotInput) SetInstanceSnapshotName(v string) *DeleteInstanceSnapshotInput {
 	s.InstanceSnapshotName = &v
@@ -6411,6 +6607,21 @@ func (s *DeleteKeyPairInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteKeyPairInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteKeyPairInput"}
+	if s.KeyPairName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.KeyPairName) {
+		invalidParams.Add(request.NewErrParamPattern("KeyPairName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyPairName sets the KeyPairName field's value.
 func (s *DeleteKeyPairInput) SetKeyPairName(v string) *DeleteKeyPairInput {
 	s.KeyPairName = &v
@@ -6475,6 +6686,21 @@ func (s *DetachStaticIpInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetachStaticIpInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetachStaticIpInput"}
+	if s.StaticIpName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.StaticIpName) {
+		invalidParams.Add(request.NewErrParamPattern("StaticIpName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStaticIpName sets the StaticIpName field's value.
 func (s *DetachStaticIpInput) SetStaticIpName(v string) *DetachStaticIpInput {
 	s.StaticIpName = &v
@@ -6763,6 +6989,21 @@ func (s DomainEntry) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DomainEntry) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DomainEntry"}
+	if s.Id != nil && !request.MatchParamPattern(".*\\S.*", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", ".*\\S.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *DomainEntry) SetId(v string) *DomainEntry {
 	s.Id = &v
@@ -7198,6 +7439,27 @@ func (s *GetInstanceAccessDetailsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetInstanceAccessDetailsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetInstanceAccessDetailsInput"}
+	if s.InstanceName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceName", "\\w[\\w\\-]*\\w"))
+	}
+	if s.Protocol != nil {
+		values := []string{"ssh", "rdp"}
+		if !request.IsParamEnumValue(*s.Protocol, values) {
+			invalidParams.Add(request.NewErrParamEnum("Protocol", *s.Protocol, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceName sets the InstanceName field's value.
 func (s *GetInstanceAccessDetailsInput) SetInstanceName(v string) *GetInstanceAccessDetailsInput {
 	s.InstanceName = &v
@@ -7268,6 +7530,21 @@ func (s *GetInstanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetInstanceInput"}
+	if s.InstanceName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceName sets the InstanceName field's value.
 func (s *GetInstanceInput) SetInstanceName(v string) *GetInstanceInput {
 	s.InstanceName = &v
@@ -7358,6 +7635,36 @@ func (s *GetInstanceMetricDataInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetInstanceMetricDataInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetInstanceMetricDataInput"}
+	if s.InstanceName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceName", "\\w[\\w\\-]*\\w"))
+	}
+	if s.MetricName != nil {
+		values := []string{"CPUUtilization", "NetworkIn", "NetworkOut", "StatusCheckFailed", "StatusCheckFailed_Instance", "StatusCheckFailed_System"}
+		if !request.IsParamEnumValue(*s.MetricName, values) {
+			invalidParams.Add(request.NewErrParamEnum("MetricName", *s.MetricName, values))
+		}
+	}
+	if s.Period != nil && *s.Period > 86400 {
+		invalidParams.Add(request.NewErrParamMaxValue("Period", 86400))
+	}
+	if s.Unit != nil {
+		values := []string{"Seconds", "Microseconds", "Milliseconds", "Bytes", "Kilobytes", "Megabytes", "Gigabytes", "Terabytes", "Bits", "Kilobits", "Megabits", "Gigabits", "Terabits", "Percent", "Count", "Bytes/Second", "Kilobytes/Second", "Megabytes/Second", "Gigabytes/Second", "Terabytes/Second", "Bits/Second", "Kilobits/Second", "Megabits/Second", "Gigabits/Second", "Terabits/Second", "Count/Second", "None"}
+		if !request.IsParamEnumValue(*s.Unit, values) {
+			invalidParams.Add(request.NewErrParamEnum("Unit", *s.Unit, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEndTime sets the EndTime field's value.
 func (s *GetInstanceMetricDataInput) SetEndTime(v time.Time) *GetInstanceMetricDataInput {
 	s.EndTime = &v
@@ -7491,6 +7798,21 @@ func (s *GetInstancePortStatesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetInstancePortStatesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetInstancePortStatesInput"}
+	if s.InstanceName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceName sets the InstanceName field's value.
 func (s *GetInstancePortStatesInput) SetInstanceName(v string) *GetInstancePortStatesInput {
 	s.InstanceName = &v
@@ -7554,6 +7876,21 @@ func (s *GetInstanceSnapshotInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetInstanceSnapshotInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetInstanceSnapshotInput"}
+	if s.InstanceSnapshotName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceSnapshotName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceSnapshotName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceSnapshotName sets the InstanceSnapshotName field's value.
 func (s *GetInstanceSnapshotInput) SetInstanceSnapshotName(v string) *GetInstanceSnapshotInput {
 	s.InstanceSnapshotName = &v
@@ -7678,6 +8015,21 @@ func (s *GetInstanceStateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetInstanceStateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetInstanceStateInput"}
+	if s.InstanceName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceName sets the InstanceName field's value.
 func (s *GetInstanceStateInput) SetInstanceName(v string) *GetInstanceStateInput {
 	s.InstanceName = &v
@@ -7800,6 +8152,21 @@ func (s *GetKeyPairInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetKeyPairInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetKeyPairInput"}
+	if s.KeyPairName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.KeyPairName) {
+		invalidParams.Add(request.NewErrParamPattern("KeyPairName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyPairName sets the KeyPairName field's value.
 func (s *GetKeyPairInput) SetKeyPairName(v string) *GetKeyPairInput {
 	s.KeyPairName = &v
@@ -7922,6 +8289,21 @@ func (s *GetOperationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetOperationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetOperationInput"}
+	if s.OperationId != nil && !request.MatchParamPattern(".*\\S.*", *s.OperationId) {
+		invalidParams.Add(request.NewErrParamPattern("OperationId", ".*\\S.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetOperationId sets the OperationId field's value.
 func (s *GetOperationInput) SetOperationId(v string) *GetOperationInput {
 	s.OperationId = &v
@@ -7990,6 +8372,21 @@ func (s *GetOperationsForResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetOperationsForResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetOperationsForResourceInput"}
+	if s.ResourceName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.ResourceName) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPageToken sets the PageToken field's value.
 func (s *GetOperationsForResourceInput) SetPageToken(v string) *GetOperationsForResourceInput {
 	s.PageToken = &v
@@ -8193,6 +8590,21 @@ func (s *GetStaticIpInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetStaticIpInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetStaticIpInput"}
+	if s.StaticIpName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.StaticIpName) {
+		invalidParams.Add(request.NewErrParamPattern("StaticIpName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStaticIpName sets the StaticIpName field's value.
 func (s *GetStaticIpInput) SetStaticIpName(v string) *GetStaticIpInput {
 	s.StaticIpName = &v
@@ -8325,6 +8737,21 @@ func (s *ImportKeyPairInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ImportKeyPairInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ImportKeyPairInput"}
+	if s.KeyPairName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.KeyPairName) {
+		invalidParams.Add(request.NewErrParamPattern("KeyPairName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKeyPairName sets the KeyPairName field's value.
 func (s *ImportKeyPairInput) SetKeyPairName(v string) *ImportKeyPairInput {
 	s.KeyPairName = &v
@@ -9315,6 +9742,26 @@ func (s *OpenInstancePublicPortsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *OpenInstancePublicPortsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "OpenInstancePublicPortsInput"}
+	if s.InstanceName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceName", "\\w[\\w\\-]*\\w"))
+	}
+	if s.PortInfo != nil {
+		if err := s.PortInfo.ValidateExtended(); err != nil {
+			invalidParams.AddNested("PortInfo", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceName sets the InstanceName field's value.
 func (s *OpenInstancePublicPortsInput) SetInstanceName(v string) *OpenInstancePublicPortsInput {
 	s.InstanceName = &v
@@ -9540,6 +9987,30 @@ func (s PortInfo) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PortInfo) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PortInfo"}
+	if s.FromPort != nil && *s.FromPort > 65535 {
+		invalidParams.Add(request.NewErrParamMaxValue("FromPort", 65535))
+	}
+	if s.Protocol != nil {
+		values := []string{"tcp", "all", "udp"}
+		if !request.IsParamEnumValue(*s.Protocol, values) {
+			invalidParams.Add(request.NewErrParamEnum("Protocol", *s.Protocol, values))
+		}
+	}
+	if s.ToPort != nil && *s.ToPort > 65535 {
+		invalidParams.Add(request.NewErrParamMaxValue("ToPort", 65535))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFromPort sets the FromPort field's value.
 func (s *PortInfo) SetFromPort(v int64) *PortInfo {
 	s.FromPort = &v
@@ -9599,6 +10070,31 @@ func (s *PutInstancePublicPortsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutInstancePublicPortsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutInstancePublicPortsInput"}
+	if s.InstanceName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceName", "\\w[\\w\\-]*\\w"))
+	}
+	if s.PortInfos != nil {
+		for i, v := range s.PortInfos {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PortInfos", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceName sets the InstanceName field's value.
 func (s *PutInstancePublicPortsInput) SetInstanceName(v string) *PutInstancePublicPortsInput {
 	s.InstanceName = &v
@@ -9668,6 +10164,21 @@ func (s *RebootInstanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RebootInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RebootInstanceInput"}
+	if s.InstanceName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceName sets the InstanceName field's value.
 func (s *RebootInstanceInput) SetInstanceName(v string) *RebootInstanceInput {
 	s.InstanceName = &v
@@ -9793,6 +10304,21 @@ func (s *ReleaseStaticIpInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReleaseStaticIpInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReleaseStaticIpInput"}
+	if s.StaticIpName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.StaticIpName) {
+		invalidParams.Add(request.NewErrParamPattern("StaticIpName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStaticIpName sets the StaticIpName field's value.
 func (s *ReleaseStaticIpInput) SetStaticIpName(v string) *ReleaseStaticIpInput {
 	s.StaticIpName = &v
@@ -9890,6 +10416,21 @@ func (s *StartInstanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartInstanceInput"}
+	if s.InstanceName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceName sets the InstanceName field's value.
 func (s *StartInstanceInput) SetInstanceName(v string) *StartInstanceInput {
 	s.InstanceName = &v
@@ -10052,6 +10593,21 @@ func (s *StopInstanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopInstanceInput"}
+	if s.InstanceName != nil && !request.MatchParamPattern("\\w[\\w\\-]*\\w", *s.InstanceName) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceName", "\\w[\\w\\-]*\\w"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceName sets the InstanceName field's value.
 func (s *StopInstanceInput) SetInstanceName(v string) *StopInstanceInput {
 	s.InstanceName = &v
@@ -10162,6 +10718,23 @@ func (s *UpdateDomainEntryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDomainEntryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDomainEntryInput"}
+	if s.DomainEntry != nil {
+		if err := s.DomainEntry.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DomainEntry", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainEntry sets the DomainEntry field's value.
 func (s *UpdateDomainEntryInput) SetDomainEntry(v *DomainEntry) *UpdateDomainEntryInput {
 	s.DomainEntry = v
@@ -2804,6 +2804,43 @@ func (s *AddTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddTagsInput"}
+	if s.ResourceId != nil && len(*s.ResourceId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 64))
+	}
+	if s.ResourceId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.ResourceType != nil {
+		values := []string{"BatchPrediction", "DataSource", "Evaluation", "MLModel"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+	if s.Tags != nil && len(s.Tags) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 100))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *AddTagsInput) SetResourceId(v string) *AddTagsInput {
 	s.ResourceId = &v
@@ -3111,6 +3148,48 @@ func (s *CreateBatchPredictionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateBatchPredictionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateBatchPredictionInput"}
+	if s.BatchPredictionDataSourceId != nil && len(*s.BatchPredictionDataSourceId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("BatchPredictionDataSourceId", 64))
+	}
+	if s.BatchPredictionDataSourceId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.BatchPredictionDataSourceId) {
+		invalidParams.Add(request.NewErrParamPattern("BatchPredictionDataSourceId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.BatchPredictionId != nil && len(*s.BatchPredictionId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("BatchPredictionId", 64))
+	}
+	if s.BatchPredictionId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.BatchPredictionId) {
+		invalidParams.Add(request.NewErrParamPattern("BatchPredictionId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.BatchPredictionName != nil && len(*s.BatchPredictionName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("BatchPredictionName", 1024))
+	}
+	if s.BatchPredictionName != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.BatchPredictionName) {
+		invalidParams.Add(request.NewErrParamPattern("BatchPredictionName", ".*\\S.*|^$"))
+	}
+	if s.MLModelId != nil && len(*s.MLModelId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("MLModelId", 64))
+	}
+	if s.MLModelId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.MLModelId) {
+		invalidParams.Add(request.NewErrParamPattern("MLModelId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.OutputUri != nil && len(*s.OutputUri) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("OutputUri", 2048))
+	}
+	if s.OutputUri != nil && !request.MatchParamPattern("s3://([^/]+)(/.*)?", *s.OutputUri) {
+		invalidParams.Add(request.NewErrParamPattern("OutputUri", "s3://([^/]+)(/.*)?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBatchPredictionDataSourceId sets the BatchPredictionDataSourceId field's value.
 func (s *CreateBatchPredictionInput) SetBatchPredictionDataSourceId(v string) *CreateBatchPredictionInput {
 	s.BatchPredictionDataSourceId = &v
@@ -3242,6 +3321,38 @@ func (s *CreateDataSourceFromRDSInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDataSourceFromRDSInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDataSourceFromRDSInput"}
+	if s.DataSourceId != nil && len(*s.DataSourceId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSourceId", 64))
+	}
+	if s.DataSourceId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.DataSourceId) {
+		invalidParams.Add(request.NewErrParamPattern("DataSourceId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.DataSourceName != nil && len(*s.DataSourceName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSourceName", 1024))
+	}
+	if s.DataSourceName != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.DataSourceName) {
+		invalidParams.Add(request.NewErrParamPattern("DataSourceName", ".*\\S.*|^$"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 110 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 110))
+	}
+	if s.RDSData != nil {
+		if err := s.RDSData.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RDSData", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComputeStatistics sets the ComputeStatistics field's value.
 func (s *CreateDataSourceFromRDSInput) SetComputeStatistics(v bool) *CreateDataSourceFromRDSInput {
 	s.ComputeStatistics = &v
@@ -3404,6 +3515,38 @@ func (s *CreateDataSourceFromRedshiftInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDataSourceFromRedshiftInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDataSourceFromRedshiftInput"}
+	if s.DataSourceId != nil && len(*s.DataSourceId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSourceId", 64))
+	}
+	if s.DataSourceId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.DataSourceId) {
+		invalidParams.Add(request.NewErrParamPattern("DataSourceId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.DataSourceName != nil && len(*s.DataSourceName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSourceName", 1024))
+	}
+	if s.DataSourceName != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.DataSourceName) {
+		invalidParams.Add(request.NewErrParamPattern("DataSourceName", ".*\\S.*|^$"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 110 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 110))
+	}
+	if s.DataSpec != nil {
+		if err := s.DataSpec.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DataSpec", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComputeStatistics sets the ComputeStatistics field's value.
 func (s *CreateDataSourceFromRedshiftInput) SetComputeStatistics(v bool) *CreateDataSourceFromRedshiftInput {
 	s.ComputeStatistics = &v
@@ -3533,6 +3676,35 @@ func (s *CreateDataSourceFromS3Input) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDataSourceFromS3Input) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDataSourceFromS3Input"}
+	if s.DataSourceId != nil && len(*s.DataSourceId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSourceId", 64))
+	}
+	if s.DataSourceId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.DataSourceId) {
+		invalidParams.Add(request.NewErrParamPattern("DataSourceId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.DataSourceName != nil && len(*s.DataSourceName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSourceName", 1024))
+	}
+	if s.DataSourceName != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.DataSourceName) {
+		invalidParams.Add(request.NewErrParamPattern("DataSourceName", ".*\\S.*|^$"))
+	}
+	if s.DataSpec != nil {
+		if err := s.DataSpec.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DataSpec", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComputeStatistics sets the ComputeStatistics field's value.
 func (s *CreateDataSourceFromS3Input) SetComputeStatistics(v bool) *CreateDataSourceFromS3Input {
 	s.ComputeStatistics = &v
@@ -3650,6 +3822,42 @@ func (s *CreateEvaluationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateEvaluationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateEvaluationInput"}
+	if s.EvaluationDataSourceId != nil && len(*s.EvaluationDataSourceId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("EvaluationDataSourceId", 64))
+	}
+	if s.EvaluationDataSourceId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.EvaluationDataSourceId) {
+		invalidParams.Add(request.NewErrParamPattern("EvaluationDataSourceId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.EvaluationId != nil && len(*s.EvaluationId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("EvaluationId", 64))
+	}
+	if s.EvaluationId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.EvaluationId) {
+		invalidParams.Add(request.NewErrParamPattern("EvaluationId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.EvaluationName != nil && len(*s.EvaluationName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("EvaluationName", 1024))
+	}
+	if s.EvaluationName != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.EvaluationName) {
+		invalidParams.Add(request.NewErrParamPattern("EvaluationName", ".*\\S.*|^$"))
+	}
+	if s.MLModelId != nil && len(*s.MLModelId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("MLModelId", 64))
+	}
+	if s.MLModelId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.MLModelId) {
+		invalidParams.Add(request.NewErrParamPattern("MLModelId", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEvaluationDataSourceId sets the EvaluationDataSourceId field's value.
 func (s *CreateEvaluationInput) SetEvaluationDataSourceId(v string) *CreateEvaluationInput {
 	s.EvaluationDataSourceId = &v
@@ -3818,6 +4026,51 @@ func (s *CreateMLModelInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateMLModelInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateMLModelInput"}
+	if s.MLModelId != nil && len(*s.MLModelId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("MLModelId", 64))
+	}
+	if s.MLModelId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.MLModelId) {
+		invalidParams.Add(request.NewErrParamPattern("MLModelId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.MLModelName != nil && len(*s.MLModelName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("MLModelName", 1024))
+	}
+	if s.MLModelName != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.MLModelName) {
+		invalidParams.Add(request.NewErrParamPattern("MLModelName", ".*\\S.*|^$"))
+	}
+	if s.MLModelType != nil {
+		values := []string{"REGRESSION", "BINARY", "MULTICLASS"}
+		if !request.IsParamEnumValue(*s.MLModelType, values) {
+			invalidParams.Add(request.NewErrParamEnum("MLModelType", *s.MLModelType, values))
+		}
+	}
+	if s.Recipe != nil && len(*s.Recipe) > 131071 {
+		invalidParams.Add(request.NewErrParamMaxLen("Recipe", 131071))
+	}
+	if s.RecipeUri != nil && len(*s.RecipeUri) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RecipeUri", 2048))
+	}
+	if s.RecipeUri != nil && !request.MatchParamPattern("s3://([^/]+)(/.*)?", *s.RecipeUri) {
+		invalidParams.Add(request.NewErrParamPattern("RecipeUri", "s3://([^/]+)(/.*)?"))
+	}
+	if s.TrainingDataSourceId != nil && len(*s.TrainingDataSourceId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("TrainingDataSourceId", 64))
+	}
+	if s.TrainingDataSourceId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.TrainingDataSourceId) {
+		invalidParams.Add(request.NewErrParamPattern("TrainingDataSourceId", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMLModelId sets the MLModelId field's value.
 func (s *CreateMLModelInput) SetMLModelId(v string) *CreateMLModelInput {
 	s.MLModelId = &v
@@ -3924,6 +4177,24 @@ func (s *CreateRealtimeEndpointInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateRealtimeEndpointInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateRealtimeEndpointInput"}
+	if s.MLModelId != nil && len(*s.MLModelId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("MLModelId", 64))
+	}
+	if s.MLModelId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.MLModelId) {
+		invalidParams.Add(request.NewErrParamPattern("MLModelId", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMLModelId sets the MLModelId field's value.
 func (s *CreateRealtimeEndpointInput) SetMLModelId(v string) *CreateRealtimeEndpointInput {
 	s.MLModelId = &v
@@ -4202,6 +4473,24 @@ func (s *DeleteBatchPredictionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteBatchPredictionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteBatchPredictionInput"}
+	if s.BatchPredictionId != nil && len(*s.BatchPredictionId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("BatchPredictionId", 64))
+	}
+	if s.BatchPredictionId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.BatchPredictionId) {
+		invalidParams.Add(request.NewErrParamPattern("BatchPredictionId", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBatchPredictionId sets the BatchPredictionId field's value.
 func (s *DeleteBatchPredictionInput) SetBatchPredictionId(v string) *DeleteBatchPredictionInput {
 	s.BatchPredictionId = &v
@@ -4271,6 +4560,24 @@ func (s *DeleteDataSourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteDataSourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDataSourceInput"}
+	if s.DataSourceId != nil && len(*s.DataSourceId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSourceId", 64))
+	}
+	if s.DataSourceId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.DataSourceId) {
+		invalidParams.Add(request.NewErrParamPattern("DataSourceId", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDataSourceId sets the DataSourceId field's value.
 func (s *DeleteDataSourceInput) SetDataSourceId(v string) *DeleteDataSourceInput {
 	s.DataSourceId = &v
@@ -4337,6 +4644,24 @@ func (s *DeleteEvaluationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteEvaluationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteEvaluationInput"}
+	if s.EvaluationId != nil && len(*s.EvaluationId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("EvaluationId", 64))
+	}
+	if s.EvaluationId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.EvaluationId) {
+		invalidParams.Add(request.NewErrParamPattern("EvaluationId", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEvaluationId sets the EvaluationId field's value.
 func (s *DeleteEvaluationInput) SetEvaluationId(v string) *DeleteEvaluationInput {
 	s.EvaluationId = &v
@@ -4407,6 +4732,24 @@ func (s *DeleteMLModelInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteMLModelInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteMLModelInput"}
+	if s.MLModelId != nil && len(*s.MLModelId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("MLModelId", 64))
+	}
+	if s.MLModelId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.MLModelId) {
+		invalidParams.Add(request.NewErrParamPattern("MLModelId", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMLModelId sets the MLModelId field's value.
 func (s *DeleteMLModelInput) SetMLModelId(v string) *DeleteMLModelInput {
 	s.MLModelId = &v
@@ -4476,6 +4819,24 @@ func (s *DeleteRealtimeEndpointInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteRealtimeEndpointInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteRealtimeEndpointInput"}
+	if s.MLModelId != nil && len(*s.MLModelId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("MLModelId", 64))
+	}
+	if s.MLModelId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.MLModelId) {
+		invalidParams.Add(request.NewErrParamPattern("MLModelId", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMLModelId sets the MLModelId field's value.
 func (s *DeleteRealtimeEndpointInput) SetMLModelId(v string) *DeleteRealtimeEndpointInput {
 	s.MLModelId = &v
@@ -4569,6 +4930,33 @@ func (s *DeleteTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTagsInput"}
+	if s.ResourceId != nil && len(*s.ResourceId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 64))
+	}
+	if s.ResourceId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.ResourceType != nil {
+		values := []string{"BatchPrediction", "DataSource", "Evaluation", "MLModel"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+	if s.TagKeys != nil && len(s.TagKeys) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagKeys", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *DeleteTagsInput) SetResourceId(v string) *DeleteTagsInput {
 	s.ResourceId = &v
@@ -4719,6 +5107,75 @@ func (s *DescribeBatchPredictionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeBatchPredictionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeBatchPredictionsInput"}
+	if s.EQ != nil && len(*s.EQ) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("EQ", 1024))
+	}
+	if s.EQ != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.EQ) {
+		invalidParams.Add(request.NewErrParamPattern("EQ", ".*\\S.*|^$"))
+	}
+	if s.FilterVariable != nil {
+		values := []string{"CreatedAt", "LastUpdatedAt", "Status", "Name", "IAMUser", "MLModelId", "DataSourceId", "DataURI"}
+		if !request.IsParamEnumValue(*s.FilterVariable, values) {
+			invalidParams.Add(request.NewErrParamEnum("FilterVariable", *s.FilterVariable, values))
+		}
+	}
+	if s.GE != nil && len(*s.GE) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("GE", 1024))
+	}
+	if s.GE != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.GE) {
+		invalidParams.Add(request.NewErrParamPattern("GE", ".*\\S.*|^$"))
+	}
+	if s.GT != nil && len(*s.GT) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("GT", 1024))
+	}
+	if s.GT != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.GT) {
+		invalidParams.Add(request.NewErrParamPattern("GT", ".*\\S.*|^$"))
+	}
+	if s.LE != nil && len(*s.LE) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("LE", 1024))
+	}
+	if s.LE != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.LE) {
+		invalidParams.Add(request.NewErrParamPattern("LE", ".*\\S.*|^$"))
+	}
+	if s.LT != nil && len(*s.LT) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("LT", 1024))
+	}
+	if s.LT != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.LT) {
+		invalidParams.Add(request.NewErrParamPattern("LT", ".*\\S.*|^$"))
+	}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+	if s.NE != nil && len(*s.NE) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NE", 1024))
+	}
+	if s.NE != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.NE) {
+		invalidParams.Add(request.NewErrParamPattern("NE", ".*\\S.*|^$"))
+	}
+	if s.Prefix != nil && len(*s.Prefix) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Prefix", 1024))
+	}
+	if s.Prefix != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.Prefix) {
+		invalidParams.Add(request.NewErrParamPattern("Prefix", ".*\\S.*|^$"))
+	}
+	if s.SortOrder != nil {
+		values := []string{"asc", "dsc"}
+		if !request.IsParamEnumValue(*s.SortOrder, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortOrder", *s.SortOrder, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEQ sets the EQ field's value.
 func (s *DescribeBatchPredictionsInput) SetEQ(v string) *DescribeBatchPredictionsInput {
 	s.EQ = &v
@@ -4910,6 +5367,75 @@ func (s *DescribeDataSourcesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeDataSourcesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeDataSourcesInput"}
+	if s.EQ != nil && len(*s.EQ) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("EQ", 1024))
+	}
+	if s.EQ != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.EQ) {
+		invalidParams.Add(request.NewErrParamPattern("EQ", ".*\\S.*|^$"))
+	}
+	if s.FilterVariable != nil {
+		values := []string{"CreatedAt", "LastUpdatedAt", "Status", "Name", "DataLocationS3", "IAMUser"}
+		if !request.IsParamEnumValue(*s.FilterVariable, values) {
+			invalidParams.Add(request.NewErrParamEnum("FilterVariable", *s.FilterVariable, values))
+		}
+	}
+	if s.GE != nil && len(*s.GE) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("GE", 1024))
+	}
+	if s.GE != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.GE) {
+		invalidParams.Add(request.NewErrParamPattern("GE", ".*\\S.*|^$"))
+	}
+	if s.GT != nil && len(*s.GT) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("GT", 1024))
+	}
+	if s.GT != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.GT) {
+		invalidParams.Add(request.NewErrParamPattern("GT", ".*\\S.*|^$"))
+	}
+	if s.LE != nil && len(*s.LE) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("LE", 1024))
+	}
+	if s.LE != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.LE) {
+		invalidParams.Add(request.NewErrParamPattern("LE", ".*\\S.*|^$"))
+	}
+	if s.LT != nil && len(*s.LT) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("LT", 1024))
+	}
+	if s.LT != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.LT) {
+		invalidParams.Add(request.NewErrParamPattern("LT", ".*\\S.*|^$"))
+	}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+	if s.NE != nil && len(*s.NE) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NE", 1024))
+	}
+	if s.NE != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.NE) {
+		invalidParams.Add(request.NewErrParamPattern("NE", ".*\\S.*|^$"))
+	}
+	if s.Prefix != nil && len(*s.Prefix) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Prefix", 1024))
+	}
+	if s.Prefix != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.Prefix) {
+		invalidParams.Add(request.NewErrParamPattern("Prefix", ".*\\S.*|^$"))
+	}
+	if s.SortOrder != nil {
+		values := []string{"asc", "dsc"}
+		if !request.IsParamEnumValue(*s.SortOrder, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortOrder", *s.SortOrder, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEQ sets the EQ field's value.
 func (s *DescribeDataSourcesInput) SetEQ(v string) *DescribeDataSourcesInput {
 	s.EQ = &v
@@ -5106,6 +5632,75 @@ func (s *DescribeEvaluationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEvaluationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEvaluationsInput"}
+	if s.EQ != nil && len(*s.EQ) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("EQ", 1024))
+	}
+	if s.EQ != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.EQ) {
+		invalidParams.Add(request.NewErrParamPattern("EQ", ".*\\S.*|^$"))
+	}
+	if s.FilterVariable != nil {
+		values := []string{"CreatedAt", "LastUpdatedAt", "Status", "Name", "IAMUser", "MLModelId", "DataSourceId", "DataURI"}
+		if !request.IsParamEnumValue(*s.FilterVariable, values) {
+			invalidParams.Add(request.NewErrParamEnum("FilterVariable", *s.FilterVariable, values))
+		}
+	}
+	if s.GE != nil && len(*s.GE) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("GE", 1024))
+	}
+	if s.GE != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.GE) {
+		invalidParams.Add(request.NewErrParamPattern("GE", ".*\\S.*|^$"))
+	}
+	if s.GT != nil && len(*s.GT) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("GT", 1024))
+	}
+	if s.GT != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.GT) {
+		invalidParams.Add(request.NewErrParamPattern("GT", ".*\\S.*|^$"))
+	}
+	if s.LE != nil && len(*s.LE) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("LE", 1024))
+	}
+	if s.LE != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.LE) {
+		invalidParams.Add(request.NewErrParamPattern("LE", ".*\\S.*|^$"))
+	}
+	if s.LT != nil && len(*s.LT) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("LT", 1024))
+	}
+	if s.LT != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.LT) {
+		invalidParams.Add(request.NewErrParamPattern("LT", ".*\\S.*|^$"))
+	}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+	if s.NE != nil && len(*s.NE) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NE", 1024))
+	}
+	if s.NE != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.NE) {
+		invalidParams.Add(request.NewErrParamPattern("NE", ".*\\S.*|^$"))
+	}
+	if s.Prefix != nil && len(*s.Prefix) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Prefix", 1024))
+	}
+	if s.Prefix != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.Prefix) {
+		invalidParams.Add(request.NewErrParamPattern("Prefix", ".*\\S.*|^$"))
+	}
+	if s.SortOrder != nil {
+		values := []string{"asc", "dsc"}
+		if !request.IsParamEnumValue(*s.SortOrder, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortOrder", *s.SortOrder, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEQ sets the EQ field's value.
 func (s *DescribeEvaluationsInput) SetEQ(v string) *DescribeEvaluationsInput {
 	s.EQ = &v
@@ -5306,6 +5901,75 @@ func (s *DescribeMLModelsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeMLModelsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeMLModelsInput"}
+	if s.EQ != nil && len(*s.EQ) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("EQ", 1024))
+	}
+	if s.EQ != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.EQ) {
+		invalidParams.Add(request.NewErrParamPattern("EQ", ".*\\S.*|^$"))
+	}
+	if s.FilterVariable != nil {
+		values := []string{"CreatedAt", "LastUpdatedAt", "Status", "Name", "IAMUser", "TrainingDataSourceId", "RealtimeEndpointStatus", "MLModelType", "Algorithm", "TrainingDataURI"}
+		if !request.IsParamEnumValue(*s.FilterVariable, values) {
+			invalidParams.Add(request.NewErrParamEnum("FilterVariable", *s.FilterVariable, values))
+		}
+	}
+	if s.GE != nil && len(*s.GE) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("GE", 1024))
+	}
+	if s.GE != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.GE) {
+		invalidParams.Add(request.NewErrParamPattern("GE", ".*\\S.*|^$"))
+	}
+	if s.GT != nil && len(*s.GT) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("GT", 1024))
+	}
+	if s.GT != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.GT) {
+		invalidParams.Add(request.NewErrParamPattern("GT", ".*\\S.*|^$"))
+	}
+	if s.LE != nil && len(*s.LE) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("LE", 1024))
+	}
+	if s.LE != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.LE) {
+		invalidParams.Add(request.NewErrParamPattern("LE", ".*\\S.*|^$"))
+	}
+	if s.LT != nil && len(*s.LT) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("LT", 1024))
+	}
+	if s.LT != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.LT) {
+		invalidParams.Add(request.NewErrParamPattern("LT", ".*\\S.*|^$"))
+	}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+	if s.NE != nil && len(*s.NE) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NE", 1024))
+	}
+	if s.NE != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.NE) {
+		invalidParams.Add(request.NewErrParamPattern("NE", ".*\\S.*|^$"))
+	}
+	if s.Prefix != nil && len(*s.Prefix) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Prefix", 1024))
+	}
+	if s.Prefix != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.Prefix) {
+		invalidParams.Add(request.NewErrParamPattern("Prefix", ".*\\S.*|^$"))
+	}
+	if s.SortOrder != nil {
+		values := []string{"asc", "dsc"}
+		if !request.IsParamEnumValue(*s.SortOrder, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortOrder", *s.SortOrder, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEQ sets the EQ field's value.
 func (s *DescribeMLModelsInput) SetEQ(v string) *DescribeMLModelsInput {
 	s.EQ = &v
@@ -5450,6 +6114,30 @@ func (s *DescribeTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeTagsInput"}
+	if s.ResourceId != nil && len(*s.ResourceId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 64))
+	}
+	if s.ResourceId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.ResourceType != nil {
+		values := []string{"BatchPrediction", "DataSource", "Evaluation", "MLModel"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *DescribeTagsInput) SetResourceId(v string) *DescribeTagsInput {
 	s.ResourceId = &v
@@ -5712,6 +6400,24 @@ func (s *GetBatchPredictionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetBatchPredictionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetBatchPredictionInput"}
+	if s.BatchPredictionId != nil && len(*s.BatchPredictionId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("BatchPredictionId", 64))
+	}
+	if s.BatchPredictionId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.BatchPredictionId) {
+		invalidParams.Add(request.NewErrParamPattern("BatchPredictionId", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBatchPredictionId sets the BatchPredictionId field's value.
 func (s *GetBatchPredictionInput) SetBatchPredictionId(v string) *GetBatchPredictionInput {
 	s.BatchPredictionId = &v
@@ -5954,6 +6660,24 @@ func (s *GetDataSourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDataSourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDataSourceInput"}
+	if s.DataSourceId != nil && len(*s.DataSourceId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSourceId", 64))
+	}
+	if s.DataSourceId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.DataSourceId) {
+		invalidParams.Add(request.NewErrParamPattern("DataSourceId", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDataSourceId sets the DataSourceId field's value.
 func (s *GetDataSourceInput) SetDataSourceId(v string) *GetDataSourceInput {
 	s.DataSourceId = &v
@@ -6223,6 +6947,24 @@ func (s *GetEvaluationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetEvaluationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetEvaluationInput"}
+	if s.EvaluationId != nil && len(*s.EvaluationId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("EvaluationId", 64))
+	}
+	if s.EvaluationId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.EvaluationId) {
+		invalidParams.Add(request.NewErrParamPattern("EvaluationId", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEvaluationId sets the EvaluationId field's value.
 func (s *GetEvaluationInput) SetEvaluationId(v string) *GetEvaluationInput {
 	s.EvaluationId = &v
@@ -6455,6 +7197,24 @@ func (s *GetMLModelInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetMLModelInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetMLModelInput"}
+	if s.MLModelId != nil && len(*s.MLModelId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("MLModelId", 64))
+	}
+	if s.MLModelId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.MLModelId) {
+		invalidParams.Add(request.NewErrParamPattern("MLModelId", "[a-zA-Z0-9_.-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMLModelId sets the MLModelId field's value.
 func (s *GetMLModelInput) SetMLModelId(v string) *GetMLModelInput {
 	s.MLModelId = &v
@@ -7081,6 +7841,30 @@ func (s *PredictInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PredictInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PredictInput"}
+	if s.MLModelId != nil && len(*s.MLModelId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("MLModelId", 64))
+	}
+	if s.MLModelId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.MLModelId) {
+		invalidParams.Add(request.NewErrParamPattern("MLModelId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.PredictEndpoint != nil && len(*s.PredictEndpoint) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PredictEndpoint", 2048))
+	}
+	if s.PredictEndpoint != nil && !request.MatchParamPattern("https://[a-zA-Z0-9-.]*\\.amazon(aws)?\\.com[/]?", *s.PredictEndpoint) {
+		invalidParams.Add(request.NewErrParamPattern("PredictEndpoint", "https://[a-zA-Z0-9-.]*\\.amazon(aws)?\\.com[/]?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMLModelId sets the MLModelId field's value.
 func (s *PredictInput) SetMLModelId(v string) *PredictInput {
 	s.MLModelId = &v
@@ -7438,6 +8222,55 @@ func (s *RDSDataSpec) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RDSDataSpec) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RDSDataSpec"}
+	if s.DataSchema != nil && len(*s.DataSchema) > 131071 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSchema", 131071))
+	}
+	if s.DataSchemaUri != nil && len(*s.DataSchemaUri) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSchemaUri", 2048))
+	}
+	if s.DataSchemaUri != nil && !request.MatchParamPattern("s3://([^/]+)(/.*)?", *s.DataSchemaUri) {
+		invalidParams.Add(request.NewErrParamPattern("DataSchemaUri", "s3://([^/]+)(/.*)?"))
+	}
+	if s.ResourceRole != nil && len(*s.ResourceRole) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceRole", 64))
+	}
+	if s.S3StagingLocation != nil && len(*s.S3StagingLocation) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3StagingLocation", 2048))
+	}
+	if s.S3StagingLocation != nil && !request.MatchParamPattern("s3://([^/]+)(/.*)?", *s.S3StagingLocation) {
+		invalidParams.Add(request.NewErrParamPattern("S3StagingLocation", "s3://([^/]+)(/.*)?"))
+	}
+	if s.SelectSqlQuery != nil && len(*s.SelectSqlQuery) > 1.6777216e+07 {
+		invalidParams.Add(request.NewErrParamMaxLen("SelectSqlQuery", 1.6777216e+07))
+	}
+	if s.ServiceRole != nil && len(*s.ServiceRole) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServiceRole", 64))
+	}
+	if s.SubnetId != nil && len(*s.SubnetId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("SubnetId", 255))
+	}
+	if s.DatabaseCredentials != nil {
+		if err := s.DatabaseCredentials.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DatabaseCredentials", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DatabaseInformation != nil {
+		if err := s.DatabaseInformation.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DatabaseInformation", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDataRearrangement sets the DataRearrangement field's value.
 func (s *RDSDataSpec) SetDataRearrangement(v string) *RDSDataSpec {
 	s.DataRearrangement = &v
@@ -7551,6 +8384,27 @@ func (s *RDSDatabase) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RDSDatabase) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RDSDatabase"}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 64))
+	}
+	if s.InstanceIdentifier != nil && len(*s.InstanceIdentifier) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceIdentifier", 63))
+	}
+	if s.InstanceIdentifier != nil && !request.MatchParamPattern("[a-z0-9-]+", *s.InstanceIdentifier) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceIdentifier", "[a-z0-9-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDatabaseName sets the DatabaseName field's value.
 func (s *RDSDatabase) SetDatabaseName(v string) *RDSDatabase {
 	s.DatabaseName = &v
@@ -7614,6 +8468,24 @@ func (s *RDSDatabaseCredentials) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RDSDatabaseCredentials) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RDSDatabaseCredentials"}
+	if s.Password != nil && len(*s.Password) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Password", 128))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPassword sets the Password field's value.
 func (s *RDSDatabaseCredentials) SetPassword(v string) *RDSDatabaseCredentials {
 	s.Password = &v
@@ -7959,6 +8831,46 @@ func (s *RedshiftDataSpec) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RedshiftDataSpec) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RedshiftDataSpec"}
+	if s.DataSchema != nil && len(*s.DataSchema) > 131071 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSchema", 131071))
+	}
+	if s.DataSchemaUri != nil && len(*s.DataSchemaUri) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSchemaUri", 2048))
+	}
+	if s.DataSchemaUri != nil && !request.MatchParamPattern("s3://([^/]+)(/.*)?", *s.DataSchemaUri) {
+		invalidParams.Add(request.NewErrParamPattern("DataSchemaUri", "s3://([^/]+)(/.*)?"))
+	}
+	if s.S3StagingLocation != nil && len(*s.S3StagingLocation) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3StagingLocation", 2048))
+	}
+	if s.S3StagingLocation != nil && !request.MatchParamPattern("s3://([^/]+)(/.*)?", *s.S3StagingLocation) {
+		invalidParams.Add(request.NewErrParamPattern("S3StagingLocation", "s3://([^/]+)(/.*)?"))
+	}
+	if s.SelectSqlQuery != nil && len(*s.SelectSqlQuery) > 1.6777216e+07 {
+		invalidParams.Add(request.NewErrParamMaxLen("SelectSqlQuery", 1.6777216e+07))
+	}
+	if s.DatabaseCredentials != nil {
+		if err := s.DatabaseCredentials.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DatabaseCredentials", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DatabaseInformation != nil {
+		if err := s.DatabaseInformation.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DatabaseInformation", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDataRearrangement sets the DataRearrangement field's value.
 func (s *RedshiftDataSpec) SetDataRearrangement(v string) *RedshiftDataSpec {
 	s.DataRearrangement = &v
@@ -8049,6 +8961,30 @@ func (s *RedshiftDatabase) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RedshiftDatabase) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RedshiftDatabase"}
+	if s.ClusterIdentifier != nil && len(*s.ClusterIdentifier) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClusterIdentifier", 63))
+	}
+	if s.ClusterIdentifier != nil && !request.MatchParamPattern("[a-z0-9-]+", *s.ClusterIdentifier) {
+		invalidParams.Add(request.NewErrParamPattern("ClusterIdentifier", "[a-z0-9-]+"))
+	}
+	if s.DatabaseName != nil && len(*s.DatabaseName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DatabaseName", 64))
+	}
+	if s.DatabaseName != nil && !request.MatchParamPattern("[a-z0-9]+", *s.DatabaseName) {
+		invalidParams.Add(request.NewErrParamPattern("DatabaseName", "[a-z0-9]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClusterIdentifier sets the ClusterIdentifier field's value.
 func (s *RedshiftDatabase) SetClusterIdentifier(v string) *RedshiftDatabase {
 	s.ClusterIdentifier = &v
@@ -8115,6 +9051,24 @@ func (s *RedshiftDatabaseCredentials) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RedshiftDatabaseCredentials) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RedshiftDatabaseCredentials"}
+	if s.Password != nil && len(*s.Password) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Password", 64))
+	}
+	if s.Username != nil && len(*s.Username) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPassword sets the Password field's value.
 func (s *RedshiftDatabaseCredentials) SetPassword(v string) *RedshiftDatabaseCredentials {
 	s.Password = &v
@@ -8328,6 +9282,33 @@ func (s *S3DataSpec) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *S3DataSpec) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "S3DataSpec"}
+	if s.DataLocationS3 != nil && len(*s.DataLocationS3) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataLocationS3", 2048))
+	}
+	if s.DataLocationS3 != nil && !request.MatchParamPattern("s3://([^/]+)(/.*)?", *s.DataLocationS3) {
+		invalidParams.Add(request.NewErrParamPattern("DataLocationS3", "s3://([^/]+)(/.*)?"))
+	}
+	if s.DataSchema != nil && len(*s.DataSchema) > 131071 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSchema", 131071))
+	}
+	if s.DataSchemaLocationS3 != nil && len(*s.DataSchemaLocationS3) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSchemaLocationS3", 2048))
+	}
+	if s.DataSchemaLocationS3 != nil && !request.MatchParamPattern("s3://([^/]+)(/.*)?", *s.DataSchemaLocationS3) {
+		invalidParams.Add(request.NewErrParamPattern("DataSchemaLocationS3", "s3://([^/]+)(/.*)?"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDataLocationS3 sets the DataLocationS3 field's value.
 func (s *S3DataSpec) SetDataLocationS3(v string) *S3DataSpec {
 	s.DataLocationS3 = &v
@@ -8388,6 +9369,30 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+	if s.Value != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Value) {
+		invalidParams.Add(request.NewErrParamPattern("Value", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -8443,6 +9448,30 @@ func (s *UpdateBatchPredictionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateBatchPredictionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateBatchPredictionInput"}
+	if s.BatchPredictionId != nil && len(*s.BatchPredictionId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("BatchPredictionId", 64))
+	}
+	if s.BatchPredictionId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.BatchPredictionId) {
+		invalidParams.Add(request.NewErrParamPattern("BatchPredictionId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.BatchPredictionName != nil && len(*s.BatchPredictionName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("BatchPredictionName", 1024))
+	}
+	if s.BatchPredictionName != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.BatchPredictionName) {
+		invalidParams.Add(request.NewErrParamPattern("BatchPredictionName", ".*\\S.*|^$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBatchPredictionId sets the BatchPredictionId field's value.
 func (s *UpdateBatchPredictionInput) SetBatchPredictionId(v string) *UpdateBatchPredictionInput {
 	s.BatchPredictionId = &v
@@ -8526,6 +9555,30 @@ func (s *UpdateDataSourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDataSourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDataSourceInput"}
+	if s.DataSourceId != nil && len(*s.DataSourceId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSourceId", 64))
+	}
+	if s.DataSourceId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.DataSourceId) {
+		invalidParams.Add(request.NewErrParamPattern("DataSourceId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.DataSourceName != nil && len(*s.DataSourceName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSourceName", 1024))
+	}
+	if s.DataSourceName != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.DataSourceName) {
+		invalidParams.Add(request.NewErrParamPattern("DataSourceName", ".*\\S.*|^$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDataSourceId sets the DataSourceId field's value.
 func (s *UpdateDataSourceInput) SetDataSourceId(v string) *UpdateDataSourceInput {
 	s.DataSourceId = &v
@@ -8609,6 +9662,30 @@ func (s *UpdateEvaluationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateEvaluationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateEvaluationInput"}
+	if s.EvaluationId != nil && len(*s.EvaluationId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("EvaluationId", 64))
+	}
+	if s.EvaluationId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.EvaluationId) {
+		invalidParams.Add(request.NewErrParamPattern("EvaluationId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.EvaluationName != nil && len(*s.EvaluationName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("EvaluationName", 1024))
+	}
+	if s.EvaluationName != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.EvaluationName) {
+		invalidParams.Add(request.NewErrParamPattern("EvaluationName", ".*\\S.*|^$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEvaluationId sets the EvaluationId field's value.
 func (s *UpdateEvaluationInput) SetEvaluationId(v string) *UpdateEvaluationInput {
 	s.EvaluationId = &v
@@ -8694,6 +9771,30 @@ func (s *UpdateMLModelInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateMLModelInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateMLModelInput"}
+	if s.MLModelId != nil && len(*s.MLModelId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("MLModelId", 64))
+	}
+	if s.MLModelId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.-]+", *s.MLModelId) {
+		invalidParams.Add(request.NewErrParamPattern("MLModelId", "[a-zA-Z0-9_.-]+"))
+	}
+	if s.MLModelName != nil && len(*s.MLModelName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("MLModelName", 1024))
+	}
+	if s.MLModelName != nil && !request.MatchParamPattern(".*\\S.*|^$", *s.MLModelName) {
+		invalidParams.Add(request.NewErrParamPattern("MLModelName", ".*\\S.*|^$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMLModelId sets the MLModelId field's value.
 func (s *UpdateMLModelInput) SetMLModelId(v string) *UpdateMLModelInput {
 	s.MLModelId = &v
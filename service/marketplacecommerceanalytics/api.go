@@ -378,6 +378,30 @@ func (s *GenerateDataSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GenerateDataSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GenerateDataSetInput"}
+	if s.CustomerDefinedValues != nil && len(s.CustomerDefinedValues) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("CustomerDefinedValues", 5))
+	}
+	if s.DataSetType != nil && len(*s.DataSetType) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSetType", 255))
+	}
+	if s.DataSetType != nil {
+		values := []string{"customer_subscriber_hourly_monthly_subscriptions", "customer_subscriber_annual_subscriptions", "daily_business_usage_by_instance_type", "daily_business_fees", "daily_business_free_trial_conversions", "daily_business_new_instances", "daily_business_new_product_subscribers", "daily_business_canceled_product_subscribers", "monthly_revenue_billing_and_revenue_data", "monthly_revenue_annual_subscriptions", "disbursed_amount_by_product", "disbursed_amount_by_product_with_uncollected_funds", "disbursed_amount_by_instance_hours", "disbursed_amount_by_customer_geo", "disbursed_amount_by_age_of_uncollected_funds", "disbursed_amount_by_age_of_disbursed_funds", "customer_profile_by_industry", "customer_profile_by_revenue", "customer_profile_by_geography", "sales_compensation_billed_revenue", "us_sales_and_use_tax_records"}
+		if !request.IsParamEnumValue(*s.DataSetType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DataSetType", *s.DataSetType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCustomerDefinedValues sets the CustomerDefinedValues field's value.
 func (s *GenerateDataSetInput) SetCustomerDefinedValues(v map[string]*string) *GenerateDataSetInput {
 	s.CustomerDefinedValues = v
@@ -554,6 +578,30 @@ func (s *StartSupportDataExportInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartSupportDataExportInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartSupportDataExportInput"}
+	if s.CustomerDefinedValues != nil && len(s.CustomerDefinedValues) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("CustomerDefinedValues", 5))
+	}
+	if s.DataSetType != nil && len(*s.DataSetType) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataSetType", 255))
+	}
+	if s.DataSetType != nil {
+		values := []string{"customer_support_contacts_data", "test_customer_support_contacts_data"}
+		if !request.IsParamEnumValue(*s.DataSetType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DataSetType", *s.DataSetType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCustomerDefinedValues sets the CustomerDefinedValues field's value.
 func (s *StartSupportDataExportInput) SetCustomerDefinedValues(v map[string]*string) *StartSupportDataExportInput {
 	s.CustomerDefinedValues = v
@@ -29,8 +29,9 @@ var initRequest func(*request.Request)
 
 // Service information constants
 const (
-	ServiceName = "marketplacecommerceanalytics" // Service endpoint prefix API calls made to.
-	EndpointsID = ServiceName                    // Service ID for Regions and Endpoints metadata.
+	ServiceName = "marketplacecommerceanalytics"   // Service endpoint prefix API calls made to.
+	EndpointsID = ServiceName                      // Service ID for Regions and Endpoints metadata.
+	ServiceID   = "Marketplace Commerce Analytics" // ServiceID is a unique identifier of a specific service.
 )
 
 // New creates a new instance of the MarketplaceCommerceAnalytics client with a session.
@@ -45,19 +46,20 @@ const (
 //     svc := marketplacecommerceanalytics.New(mySession, aws.NewConfig().WithRegion("us-west-2"))
 func New(p client.ConfigProvider, cfgs ...*aws.Config) *MarketplaceCommerceAnalytics {
 	c := p.ClientConfig(EndpointsID, cfgs...)
+	if c.SigningNameDerived || len(c.SigningName) == 0 {
+		c.SigningName = "marketplacecommerceanalytics"
+	}
 	return newClient(*c.Config, c.Handlers, c.Endpoint, c.SigningRegion, c.SigningName)
 }
 
 // newClient creates, initializes and returns a new service client instance.
 func newClient(cfg aws.Config, handlers request.Handlers, endpoint, signingRegion, signingName string) *MarketplaceCommerceAnalytics {
-	if len(signingName) == 0 {
-		signingName = "marketplacecommerceanalytics"
-	}
 	svc := &MarketplaceCommerceAnalytics{
 		Client: client.New(
 			cfg,
 			metadata.ClientInfo{
 				ServiceName:   ServiceName,
+				ServiceID:     ServiceID,
 				SigningName:   signingName,
 				SigningRegion: signingRegion,
 				Endpoint:      endpoint,
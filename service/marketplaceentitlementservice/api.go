@@ -280,6 +280,24 @@ func (s *GetEntitlementsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetEntitlementsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetEntitlementsInput"}
+	if s.NextToken != nil && !request.MatchParamPattern("\\S+", *s.NextToken) {
+		invalidParams.Add(request.NewErrParamPattern("NextToken", "\\S+"))
+	}
+	if s.ProductCode != nil && len(*s.ProductCode) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductCode", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilter sets the Filter field's value.
 func (s *GetEntitlementsInput) SetFilter(v map[string][]*string) *GetEntitlementsInput {
 	s.Filter = v
@@ -29,8 +29,9 @@ var initRequest func(*request.Request)
 
 // Service information constants
 const (
-	ServiceName = "entitlement.marketplace" // Service endpoint prefix API calls made to.
-	EndpointsID = ServiceName               // Service ID for Regions and Endpoints metadata.
+	ServiceName = "entitlement.marketplace"         // Service endpoint prefix API calls made to.
+	EndpointsID = ServiceName                       // Service ID for Regions and Endpoints metadata.
+	ServiceID   = "Marketplace Entitlement Service" // ServiceID is a unique identifier of a specific service.
 )
 
 // New creates a new instance of the MarketplaceEntitlementService client with a session.
@@ -45,19 +46,20 @@ const (
 //     svc := marketplaceentitlementservice.New(mySession, aws.NewConfig().WithRegion("us-west-2"))
 func New(p client.ConfigProvider, cfgs ...*aws.Config) *MarketplaceEntitlementService {
 	c := p.ClientConfig(EndpointsID, cfgs...)
+	if c.SigningNameDerived || len(c.SigningName) == 0 {
+		c.SigningName = "aws-marketplace"
+	}
 	return newClient(*c.Config, c.Handlers, c.Endpoint, c.SigningRegion, c.SigningName)
 }
 
 // newClient creates, initializes and returns a new service client instance.
 func newClient(cfg aws.Config, handlers request.Handlers, endpoint, signingRegion, signingName string) *MarketplaceEntitlementService {
-	if len(signingName) == 0 {
-		signingName = "aws-marketplace"
-	}
 	svc := &MarketplaceEntitlementService{
 		Client: client.New(
 			cfg,
 			metadata.ClientInfo{
 				ServiceName:   ServiceName,
+				ServiceID:     ServiceID,
 				SigningName:   signingName,
 				SigningRegion: signingRegion,
 				Endpoint:      endpoint,
@@ -378,6 +378,34 @@ func (s *BatchMeterUsageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BatchMeterUsageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BatchMeterUsageInput"}
+	if s.ProductCode != nil && len(*s.ProductCode) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductCode", 255))
+	}
+	if s.UsageRecords != nil && len(s.UsageRecords) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("UsageRecords", 25))
+	}
+	if s.UsageRecords != nil {
+		for i, v := range s.UsageRecords {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "UsageRecords", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetProductCode sets the ProductCode field's value.
 func (s *BatchMeterUsageInput) SetProductCode(v string) *BatchMeterUsageInput {
 	s.ProductCode = &v
@@ -505,6 +533,27 @@ func (s *MeterUsageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MeterUsageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MeterUsageInput"}
+	if s.ProductCode != nil && len(*s.ProductCode) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductCode", 255))
+	}
+	if s.UsageDimension != nil && len(*s.UsageDimension) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("UsageDimension", 255))
+	}
+	if s.UsageQuantity != nil && *s.UsageQuantity > 1e+06 {
+		invalidParams.Add(request.NewErrParamMaxValue("UsageQuantity", 1e+06))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDryRun sets the DryRun field's value.
 func (s *MeterUsageInput) SetDryRun(v bool) *MeterUsageInput {
 	s.DryRun = &v
@@ -594,6 +643,21 @@ func (s *ResolveCustomerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResolveCustomerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResolveCustomerInput"}
+	if s.RegistrationToken != nil && !request.MatchParamPattern("\\S+", *s.RegistrationToken) {
+		invalidParams.Add(request.NewErrParamPattern("RegistrationToken", "\\S+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRegistrationToken sets the RegistrationToken field's value.
 func (s *ResolveCustomerInput) SetRegistrationToken(v string) *ResolveCustomerInput {
 	s.RegistrationToken = &v
@@ -714,6 +778,27 @@ func (s *UsageRecord) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UsageRecord) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UsageRecord"}
+	if s.CustomerIdentifier != nil && len(*s.CustomerIdentifier) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CustomerIdentifier", 255))
+	}
+	if s.Dimension != nil && len(*s.Dimension) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Dimension", 255))
+	}
+	if s.Quantity != nil && *s.Quantity > 1e+06 {
+		invalidParams.Add(request.NewErrParamMaxValue("Quantity", 1e+06))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCustomerIdentifier sets the CustomerIdentifier field's value.
 func (s *UsageRecord) SetCustomerIdentifier(v string) *UsageRecord {
 	s.CustomerIdentifier = &v
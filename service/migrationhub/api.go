@@ -1815,6 +1815,35 @@ func (s *AssociateCreatedArtifactInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssociateCreatedArtifactInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociateCreatedArtifactInput"}
+	if s.MigrationTaskName != nil && len(*s.MigrationTaskName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("MigrationTaskName", 256))
+	}
+	if s.MigrationTaskName != nil && !request.MatchParamPattern("[^:|]+", *s.MigrationTaskName) {
+		invalidParams.Add(request.NewErrParamPattern("MigrationTaskName", "[^:|]+"))
+	}
+	if s.ProgressUpdateStream != nil && len(*s.ProgressUpdateStream) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProgressUpdateStream", 50))
+	}
+	if s.ProgressUpdateStream != nil && !request.MatchParamPattern("[^/:|\\000-\\037]+", *s.ProgressUpdateStream) {
+		invalidParams.Add(request.NewErrParamPattern("ProgressUpdateStream", "[^/:|\\000-\\037]+"))
+	}
+	if s.CreatedArtifact != nil {
+		if err := s.CreatedArtifact.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CreatedArtifact", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCreatedArtifact sets the CreatedArtifact field's value.
 func (s *AssociateCreatedArtifactInput) SetCreatedArtifact(v *CreatedArtifact) *AssociateCreatedArtifactInput {
 	s.CreatedArtifact = v
@@ -1918,6 +1947,35 @@ func (s *AssociateDiscoveredResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssociateDiscoveredResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociateDiscoveredResourceInput"}
+	if s.MigrationTaskName != nil && len(*s.MigrationTaskName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("MigrationTaskName", 256))
+	}
+	if s.MigrationTaskName != nil && !request.MatchParamPattern("[^:|]+", *s.MigrationTaskName) {
+		invalidParams.Add(request.NewErrParamPattern("MigrationTaskName", "[^:|]+"))
+	}
+	if s.ProgressUpdateStream != nil && len(*s.ProgressUpdateStream) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProgressUpdateStream", 50))
+	}
+	if s.ProgressUpdateStream != nil && !request.MatchParamPattern("[^/:|\\000-\\037]+", *s.ProgressUpdateStream) {
+		invalidParams.Add(request.NewErrParamPattern("ProgressUpdateStream", "[^/:|\\000-\\037]+"))
+	}
+	if s.DiscoveredResource != nil {
+		if err := s.DiscoveredResource.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DiscoveredResource", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDiscoveredResource sets the DiscoveredResource field's value.
 func (s *AssociateDiscoveredResourceInput) SetDiscoveredResource(v *DiscoveredResource) *AssociateDiscoveredResourceInput {
 	s.DiscoveredResource = v
@@ -1997,6 +2055,24 @@ func (s *CreateProgressUpdateStreamInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateProgressUpdateStreamInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateProgressUpdateStreamInput"}
+	if s.ProgressUpdateStreamName != nil && len(*s.ProgressUpdateStreamName) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProgressUpdateStreamName", 50))
+	}
+	if s.ProgressUpdateStreamName != nil && !request.MatchParamPattern("[^/:|\\000-\\037]+", *s.ProgressUpdateStreamName) {
+		invalidParams.Add(request.NewErrParamPattern("ProgressUpdateStreamName", "[^/:|\\000-\\037]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDryRun sets the DryRun field's value.
 func (s *CreateProgressUpdateStreamInput) SetDryRun(v bool) *CreateProgressUpdateStreamInput {
 	s.DryRun = &v
@@ -2066,6 +2142,27 @@ func (s *CreatedArtifact) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatedArtifact) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatedArtifact"}
+	if s.Description != nil && len(*s.Description) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 500))
+	}
+	if s.Name != nil && len(*s.Name) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1600))
+	}
+	if s.Name != nil && !request.MatchParamPattern("arn:[a-z-]+:[a-z0-9-]+:(?:[a-z0-9-]+|):(?:[0-9]{12}|):.*", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "arn:[a-z-]+:[a-z0-9-]+:(?:[a-z0-9-]+|):(?:[0-9]{12}|):.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *CreatedArtifact) SetDescription(v string) *CreatedArtifact {
 	s.Description = &v
@@ -2118,6 +2215,24 @@ func (s *DeleteProgressUpdateStreamInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteProgressUpdateStreamInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteProgressUpdateStreamInput"}
+	if s.ProgressUpdateStreamName != nil && len(*s.ProgressUpdateStreamName) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProgressUpdateStreamName", 50))
+	}
+	if s.ProgressUpdateStreamName != nil && !request.MatchParamPattern("[^/:|\\000-\\037]+", *s.ProgressUpdateStreamName) {
+		invalidParams.Add(request.NewErrParamPattern("ProgressUpdateStreamName", "[^/:|\\000-\\037]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDryRun sets the DryRun field's value.
 func (s *DeleteProgressUpdateStreamInput) SetDryRun(v bool) *DeleteProgressUpdateStreamInput {
 	s.DryRun = &v
@@ -2181,6 +2296,21 @@ func (s *DescribeApplicationStateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeApplicationStateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeApplicationStateInput"}
+	if s.ApplicationId != nil && len(*s.ApplicationId) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationId", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationId sets the ApplicationId field's value.
 func (s *DescribeApplicationStateInput) SetApplicationId(v string) *DescribeApplicationStateInput {
 	s.ApplicationId = &v
@@ -2267,6 +2397,30 @@ func (s *DescribeMigrationTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeMigrationTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeMigrationTaskInput"}
+	if s.MigrationTaskName != nil && len(*s.MigrationTaskName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("MigrationTaskName", 256))
+	}
+	if s.MigrationTaskName != nil && !request.MatchParamPattern("[^:|]+", *s.MigrationTaskName) {
+		invalidParams.Add(request.NewErrParamPattern("MigrationTaskName", "[^:|]+"))
+	}
+	if s.ProgressUpdateStream != nil && len(*s.ProgressUpdateStream) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProgressUpdateStream", 50))
+	}
+	if s.ProgressUpdateStream != nil && !request.MatchParamPattern("[^/:|\\000-\\037]+", *s.ProgressUpdateStream) {
+		invalidParams.Add(request.NewErrParamPattern("ProgressUpdateStream", "[^/:|\\000-\\037]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMigrationTaskName sets the MigrationTaskName field's value.
 func (s *DescribeMigrationTaskInput) SetMigrationTaskName(v string) *DescribeMigrationTaskInput {
 	s.MigrationTaskName = &v
@@ -2367,6 +2521,36 @@ func (s *DisassociateCreatedArtifactInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisassociateCreatedArtifactInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisassociateCreatedArtifactInput"}
+	if s.CreatedArtifactName != nil && len(*s.CreatedArtifactName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("CreatedArtifactName", 1600))
+	}
+	if s.CreatedArtifactName != nil && !request.MatchParamPattern("arn:[a-z-]+:[a-z0-9-]+:(?:[a-z0-9-]+|):(?:[0-9]{12}|):.*", *s.CreatedArtifactName) {
+		invalidParams.Add(request.NewErrParamPattern("CreatedArtifactName", "arn:[a-z-]+:[a-z0-9-]+:(?:[a-z0-9-]+|):(?:[0-9]{12}|):.*"))
+	}
+	if s.MigrationTaskName != nil && len(*s.MigrationTaskName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("MigrationTaskName", 256))
+	}
+	if s.MigrationTaskName != nil && !request.MatchParamPattern("[^:|]+", *s.MigrationTaskName) {
+		invalidParams.Add(request.NewErrParamPattern("MigrationTaskName", "[^:|]+"))
+	}
+	if s.ProgressUpdateStream != nil && len(*s.ProgressUpdateStream) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProgressUpdateStream", 50))
+	}
+	if s.ProgressUpdateStream != nil && !request.MatchParamPattern("[^/:|\\000-\\037]+", *s.ProgressUpdateStream) {
+		invalidParams.Add(request.NewErrParamPattern("ProgressUpdateStream", "[^/:|\\000-\\037]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCreatedArtifactName sets the CreatedArtifactName field's value.
 func (s *DisassociateCreatedArtifactInput) SetCreatedArtifactName(v string) *DisassociateCreatedArtifactInput {
 	s.CreatedArtifactName = &v
@@ -2468,6 +2652,30 @@ func (s *DisassociateDiscoveredResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisassociateDiscoveredResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisassociateDiscoveredResourceInput"}
+	if s.MigrationTaskName != nil && len(*s.MigrationTaskName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("MigrationTaskName", 256))
+	}
+	if s.MigrationTaskName != nil && !request.MatchParamPattern("[^:|]+", *s.MigrationTaskName) {
+		invalidParams.Add(request.NewErrParamPattern("MigrationTaskName", "[^:|]+"))
+	}
+	if s.ProgressUpdateStream != nil && len(*s.ProgressUpdateStream) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProgressUpdateStream", 50))
+	}
+	if s.ProgressUpdateStream != nil && !request.MatchParamPattern("[^/:|\\000-\\037]+", *s.ProgressUpdateStream) {
+		invalidParams.Add(request.NewErrParamPattern("ProgressUpdateStream", "[^/:|\\000-\\037]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigurationId sets the ConfigurationId field's value.
 func (s *DisassociateDiscoveredResourceInput) SetConfigurationId(v string) *DisassociateDiscoveredResourceInput {
 	s.ConfigurationId = &v
@@ -2548,6 +2756,21 @@ func (s *DiscoveredResource) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DiscoveredResource) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DiscoveredResource"}
+	if s.Description != nil && len(*s.Description) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigurationId sets the ConfigurationId field's value.
 func (s *DiscoveredResource) SetConfigurationId(v string) *DiscoveredResource {
 	s.ConfigurationId = &v
@@ -2611,6 +2834,30 @@ func (s *ImportMigrationTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ImportMigrationTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ImportMigrationTaskInput"}
+	if s.MigrationTaskName != nil && len(*s.MigrationTaskName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("MigrationTaskName", 256))
+	}
+	if s.MigrationTaskName != nil && !request.MatchParamPattern("[^:|]+", *s.MigrationTaskName) {
+		invalidParams.Add(request.NewErrParamPattern("MigrationTaskName", "[^:|]+"))
+	}
+	if s.ProgressUpdateStream != nil && len(*s.ProgressUpdateStream) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProgressUpdateStream", 50))
+	}
+	if s.ProgressUpdateStream != nil && !request.MatchParamPattern("[^/:|\\000-\\037]+", *s.ProgressUpdateStream) {
+		invalidParams.Add(request.NewErrParamPattern("ProgressUpdateStream", "[^/:|\\000-\\037]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDryRun sets the DryRun field's value.
 func (s *ImportMigrationTaskInput) SetDryRun(v bool) *ImportMigrationTaskInput {
 	s.DryRun = &v
@@ -2702,6 +2949,33 @@ func (s *ListCreatedArtifactsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListCreatedArtifactsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListCreatedArtifactsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 10))
+	}
+	if s.MigrationTaskName != nil && len(*s.MigrationTaskName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("MigrationTaskName", 256))
+	}
+	if s.MigrationTaskName != nil && !request.MatchParamPattern("[^:|]+", *s.MigrationTaskName) {
+		invalidParams.Add(request.NewErrParamPattern("MigrationTaskName", "[^:|]+"))
+	}
+	if s.ProgressUpdateStream != nil && len(*s.ProgressUpdateStream) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProgressUpdateStream", 50))
+	}
+	if s.ProgressUpdateStream != nil && !request.MatchParamPattern("[^/:|\\000-\\037]+", *s.ProgressUpdateStream) {
+		invalidParams.Add(request.NewErrParamPattern("ProgressUpdateStream", "[^/:|\\000-\\037]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListCreatedArtifactsInput) SetMaxResults(v int64) *ListCreatedArtifactsInput {
 	s.MaxResults = &v
@@ -2819,6 +3093,33 @@ func (s *ListDiscoveredResourcesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListDiscoveredResourcesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDiscoveredResourcesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 10))
+	}
+	if s.MigrationTaskName != nil && len(*s.MigrationTaskName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("MigrationTaskName", 256))
+	}
+	if s.MigrationTaskName != nil && !request.MatchParamPattern("[^:|]+", *s.MigrationTaskName) {
+		invalidParams.Add(request.NewErrParamPattern("MigrationTaskName", "[^:|]+"))
+	}
+	if s.ProgressUpdateStream != nil && len(*s.ProgressUpdateStream) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProgressUpdateStream", 50))
+	}
+	if s.ProgressUpdateStream != nil && !request.MatchParamPattern("[^/:|\\000-\\037]+", *s.ProgressUpdateStream) {
+		invalidParams.Add(request.NewErrParamPattern("ProgressUpdateStream", "[^/:|\\000-\\037]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListDiscoveredResourcesInput) SetMaxResults(v int64) *ListDiscoveredResourcesInput {
 	s.MaxResults = &v
@@ -2919,6 +3220,24 @@ func (s *ListMigrationTasksInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListMigrationTasksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListMigrationTasksInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.ResourceName != nil && len(*s.ResourceName) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceName", 1600))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListMigrationTasksInput) SetMaxResults(v int64) *ListMigrationTasksInput {
 	s.MaxResults = &v
@@ -3008,6 +3327,21 @@ func (s *ListProgressUpdateStreamsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListProgressUpdateStreamsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListProgressUpdateStreamsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListProgressUpdateStreamsInput) SetMaxResults(v int64) *ListProgressUpdateStreamsInput {
 	s.MaxResults = &v
@@ -3234,6 +3568,27 @@ func (s *NotifyApplicationStateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NotifyApplicationStateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NotifyApplicationStateInput"}
+	if s.ApplicationId != nil && len(*s.ApplicationId) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApplicationId", 1600))
+	}
+	if s.Status != nil {
+		values := []string{"NOT_STARTED", "IN_PROGRESS", "COMPLETED"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationId sets the ApplicationId field's value.
 func (s *NotifyApplicationStateInput) SetApplicationId(v string) *NotifyApplicationStateInput {
 	s.ApplicationId = &v
@@ -3349,6 +3704,35 @@ func (s *NotifyMigrationTaskStateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NotifyMigrationTaskStateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NotifyMigrationTaskStateInput"}
+	if s.MigrationTaskName != nil && len(*s.MigrationTaskName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("MigrationTaskName", 256))
+	}
+	if s.MigrationTaskName != nil && !request.MatchParamPattern("[^:|]+", *s.MigrationTaskName) {
+		invalidParams.Add(request.NewErrParamPattern("MigrationTaskName", "[^:|]+"))
+	}
+	if s.ProgressUpdateStream != nil && len(*s.ProgressUpdateStream) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProgressUpdateStream", 50))
+	}
+	if s.ProgressUpdateStream != nil && !request.MatchParamPattern("[^/:|\\000-\\037]+", *s.ProgressUpdateStream) {
+		invalidParams.Add(request.NewErrParamPattern("ProgressUpdateStream", "[^/:|\\000-\\037]+"))
+	}
+	if s.Task != nil {
+		if err := s.Task.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Task", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDryRun sets the DryRun field's value.
 func (s *NotifyMigrationTaskStateInput) SetDryRun(v bool) *NotifyMigrationTaskStateInput {
 	s.DryRun = &v
@@ -3500,6 +3884,43 @@ func (s *PutResourceAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutResourceAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutResourceAttributesInput"}
+	if s.MigrationTaskName != nil && len(*s.MigrationTaskName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("MigrationTaskName", 256))
+	}
+	if s.MigrationTaskName != nil && !request.MatchParamPattern("[^:|]+", *s.MigrationTaskName) {
+		invalidParams.Add(request.NewErrParamPattern("MigrationTaskName", "[^:|]+"))
+	}
+	if s.ProgressUpdateStream != nil && len(*s.ProgressUpdateStream) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProgressUpdateStream", 50))
+	}
+	if s.ProgressUpdateStream != nil && !request.MatchParamPattern("[^/:|\\000-\\037]+", *s.ProgressUpdateStream) {
+		invalidParams.Add(request.NewErrParamPattern("ProgressUpdateStream", "[^/:|\\000-\\037]+"))
+	}
+	if s.ResourceAttributeList != nil && len(s.ResourceAttributeList) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceAttributeList", 100))
+	}
+	if s.ResourceAttributeList != nil {
+		for i, v := range s.ResourceAttributeList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ResourceAttributeList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDryRun sets the DryRun field's value.
 func (s *PutResourceAttributesInput) SetDryRun(v bool) *PutResourceAttributesInput {
 	s.DryRun = &v
@@ -3584,6 +4005,27 @@ func (s *ResourceAttribute) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResourceAttribute) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResourceAttribute"}
+	if s.Type != nil {
+		values := []string{"IPV4_ADDRESS", "IPV6_ADDRESS", "MAC_ADDRESS", "FQDN", "VM_MANAGER_ID", "VM_MANAGED_OBJECT_REFERENCE", "VM_NAME", "VM_PATH", "BIOS_ID", "MOTHERBOARD_SERIAL_NUMBER", "LABEL"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetType sets the Type field's value.
 func (s *ResourceAttribute) SetType(v string) *ResourceAttribute {
 	s.Type = &v
@@ -3638,6 +4080,30 @@ func (s *Task) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Task) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Task"}
+	if s.ProgressPercent != nil && *s.ProgressPercent > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("ProgressPercent", 100))
+	}
+	if s.Status != nil {
+		values := []string{"NOT_STARTED", "IN_PROGRESS", "FAILED", "COMPLETED"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+	if s.StatusDetail != nil && len(*s.StatusDetail) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("StatusDetail", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetProgressPercent sets the ProgressPercent field's value.
 func (s *Task) SetProgressPercent(v int64) *Task {
 	s.ProgressPercent = &v
@@ -1577,6 +1577,24 @@ func (s *ExportBundleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ExportBundleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ExportBundleInput"}
+	if s.Platform != nil {
+		values := []string{"OSX", "WINDOWS", "LINUX", "OBJC", "SWIFT", "ANDROID", "JAVASCRIPT"}
+		if !request.IsParamEnumValue(*s.Platform, values) {
+			invalidParams.Add(request.NewErrParamEnum("Platform", *s.Platform, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBundleId sets the BundleId field's value.
 func (s *ExportBundleInput) SetBundleId(v string) *ExportBundleInput {
 	s.BundleId = &v
@@ -164,6 +164,35 @@ func (s *Event) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Event) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Event"}
+	if s.Attributes != nil && len(s.Attributes) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Attributes", 50))
+	}
+	if s.EventType != nil && len(*s.EventType) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("EventType", 50))
+	}
+	if s.Metrics != nil && len(s.Metrics) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Metrics", 50))
+	}
+	if s.Version != nil && len(*s.Version) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 10))
+	}
+	if s.Session != nil {
+		if err := s.Session.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Session", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *Event) SetAttributes(v map[string]*string) *Event {
 	s.Attributes = v
@@ -299,6 +328,28 @@ func (s *PutEventsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutEventsInput"}
+	if s.Events != nil {
+		for i, v := range s.Events {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Events", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientContext sets the ClientContext field's value.
 func (s *PutEventsInput) SetClientContext(v string) *PutEventsInput {
 	s.ClientContext = &v
@@ -400,6 +451,21 @@ func (s *Session) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Session) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Session"}
+	if s.Id != nil && len(*s.Id) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDuration sets the Duration field's value.
 func (s *Session) SetDuration(v int64) *Session {
 	s.Duration = &v
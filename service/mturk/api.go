@@ -4177,6 +4177,24 @@ func (s *ApproveAssignmentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ApproveAssignmentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ApproveAssignmentInput"}
+	if s.AssignmentId != nil && len(*s.AssignmentId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssignmentId", 64))
+	}
+	if s.AssignmentId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.AssignmentId) {
+		invalidParams.Add(request.NewErrParamPattern("AssignmentId", "^[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssignmentId sets the AssignmentId field's value.
 func (s *ApproveAssignmentInput) SetAssignmentId(v string) *ApproveAssignmentInput {
 	s.AssignmentId = &v
@@ -4410,6 +4428,30 @@ func (s *AssociateQualificationWithWorkerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssociateQualificationWithWorkerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociateQualificationWithWorkerInput"}
+	if s.QualificationTypeId != nil && len(*s.QualificationTypeId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("QualificationTypeId", 64))
+	}
+	if s.QualificationTypeId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.QualificationTypeId) {
+		invalidParams.Add(request.NewErrParamPattern("QualificationTypeId", "^[A-Z0-9]+$"))
+	}
+	if s.WorkerId != nil && len(*s.WorkerId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkerId", 64))
+	}
+	if s.WorkerId != nil && !request.MatchParamPattern("^A[A-Z0-9]+$", *s.WorkerId) {
+		invalidParams.Add(request.NewErrParamPattern("WorkerId", "^A[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIntegerValue sets the IntegerValue field's value.
 func (s *AssociateQualificationWithWorkerInput) SetIntegerValue(v int64) *AssociateQualificationWithWorkerInput {
 	s.IntegerValue = &v
@@ -4560,6 +4602,27 @@ func (s *CreateAdditionalAssignmentsForHITInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAdditionalAssignmentsForHITInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAdditionalAssignmentsForHITInput"}
+	if s.HITId != nil && len(*s.HITId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HITId", 64))
+	}
+	if s.HITId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.HITId) {
+		invalidParams.Add(request.NewErrParamPattern("HITId", "^[A-Z0-9]+$"))
+	}
+	if s.UniqueRequestToken != nil && len(*s.UniqueRequestToken) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UniqueRequestToken", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHITId sets the HITId field's value.
 func (s *CreateAdditionalAssignmentsForHITInput) SetHITId(v string) *CreateAdditionalAssignmentsForHITInput {
 	s.HITId = &v
@@ -4759,6 +4822,40 @@ func (s *CreateHITInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateHITInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateHITInput"}
+	if s.HITLayoutId != nil && len(*s.HITLayoutId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HITLayoutId", 64))
+	}
+	if s.HITLayoutId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.HITLayoutId) {
+		invalidParams.Add(request.NewErrParamPattern("HITLayoutId", "^[A-Z0-9]+$"))
+	}
+	if s.Reward != nil && !request.MatchParamPattern("^[0-9]+(\\.)?[0-9]*$", *s.Reward) {
+		invalidParams.Add(request.NewErrParamPattern("Reward", "^[0-9]+(\\.)?[0-9]*$"))
+	}
+	if s.UniqueRequestToken != nil && len(*s.UniqueRequestToken) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UniqueRequestToken", 64))
+	}
+	if s.QualificationRequirements != nil {
+		for i, v := range s.QualificationRequirements {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "QualificationRequirements", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssignmentDurationInSeconds sets the AssignmentDurationInSeconds field's value.
 func (s *CreateHITInput) SetAssignmentDurationInSeconds(v int64) *CreateHITInput {
 	s.AssignmentDurationInSeconds = &v
@@ -4971,6 +5068,31 @@ func (s *CreateHITTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateHITTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateHITTypeInput"}
+	if s.Reward != nil && !request.MatchParamPattern("^[0-9]+(\\.)?[0-9]*$", *s.Reward) {
+		invalidParams.Add(request.NewErrParamPattern("Reward", "^[0-9]+(\\.)?[0-9]*$"))
+	}
+	if s.QualificationRequirements != nil {
+		for i, v := range s.QualificationRequirements {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "QualificationRequirements", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssignmentDurationInSeconds sets the AssignmentDurationInSeconds field's value.
 func (s *CreateHITTypeInput) SetAssignmentDurationInSeconds(v int64) *CreateHITTypeInput {
 	s.AssignmentDurationInSeconds = &v
@@ -5148,6 +5270,33 @@ func (s *CreateHITWithHITTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateHITWithHITTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateHITWithHITTypeInput"}
+	if s.HITLayoutId != nil && len(*s.HITLayoutId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HITLayoutId", 64))
+	}
+	if s.HITLayoutId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.HITLayoutId) {
+		invalidParams.Add(request.NewErrParamPattern("HITLayoutId", "^[A-Z0-9]+$"))
+	}
+	if s.HITTypeId != nil && len(*s.HITTypeId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HITTypeId", 64))
+	}
+	if s.HITTypeId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.HITTypeId) {
+		invalidParams.Add(request.NewErrParamPattern("HITTypeId", "^[A-Z0-9]+$"))
+	}
+	if s.UniqueRequestToken != nil && len(*s.UniqueRequestToken) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UniqueRequestToken", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssignmentReviewPolicy sets the AssignmentReviewPolicy field's value.
 func (s *CreateHITWithHITTypeInput) SetAssignmentReviewPolicy(v *ReviewPolicy) *CreateHITWithHITTypeInput {
 	s.AssignmentReviewPolicy = v
@@ -5338,6 +5487,24 @@ func (s *CreateQualificationTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateQualificationTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateQualificationTypeInput"}
+	if s.QualificationTypeStatus != nil {
+		values := []string{"Active", "Inactive"}
+		if !request.IsParamEnumValue(*s.QualificationTypeStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("QualificationTypeStatus", *s.QualificationTypeStatus, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAnswerKey sets the AnswerKey field's value.
 func (s *CreateQualificationTypeInput) SetAnswerKey(v string) *CreateQualificationTypeInput {
 	s.AnswerKey = &v
@@ -5467,6 +5634,24 @@ func (s *CreateWorkerBlockInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateWorkerBlockInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateWorkerBlockInput"}
+	if s.WorkerId != nil && len(*s.WorkerId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkerId", 64))
+	}
+	if s.WorkerId != nil && !request.MatchParamPattern("^A[A-Z0-9]+$", *s.WorkerId) {
+		invalidParams.Add(request.NewErrParamPattern("WorkerId", "^A[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetReason sets the Reason field's value.
 func (s *CreateWorkerBlockInput) SetReason(v string) *CreateWorkerBlockInput {
 	s.Reason = &v
@@ -5530,6 +5715,24 @@ func (s *DeleteHITInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteHITInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteHITInput"}
+	if s.HITId != nil && len(*s.HITId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HITId", 64))
+	}
+	if s.HITId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.HITId) {
+		invalidParams.Add(request.NewErrParamPattern("HITId", "^[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHITId sets the HITId field's value.
 func (s *DeleteHITInput) SetHITId(v string) *DeleteHITInput {
 	s.HITId = &v
@@ -5587,6 +5790,24 @@ func (s *DeleteQualificationTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteQualificationTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteQualificationTypeInput"}
+	if s.QualificationTypeId != nil && len(*s.QualificationTypeId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("QualificationTypeId", 64))
+	}
+	if s.QualificationTypeId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.QualificationTypeId) {
+		invalidParams.Add(request.NewErrParamPattern("QualificationTypeId", "^[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetQualificationTypeId sets the QualificationTypeId field's value.
 func (s *DeleteQualificationTypeInput) SetQualificationTypeId(v string) *DeleteQualificationTypeInput {
 	s.QualificationTypeId = &v
@@ -5648,6 +5869,24 @@ func (s *DeleteWorkerBlockInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteWorkerBlockInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteWorkerBlockInput"}
+	if s.WorkerId != nil && len(*s.WorkerId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkerId", 64))
+	}
+	if s.WorkerId != nil && !request.MatchParamPattern("^A[A-Z0-9]+$", *s.WorkerId) {
+		invalidParams.Add(request.NewErrParamPattern("WorkerId", "^A[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetReason sets the Reason field's value.
 func (s *DeleteWorkerBlockInput) SetReason(v string) *DeleteWorkerBlockInput {
 	s.Reason = &v
@@ -5726,6 +5965,30 @@ func (s *DisassociateQualificationFromWorkerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisassociateQualificationFromWorkerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisassociateQualificationFromWorkerInput"}
+	if s.QualificationTypeId != nil && len(*s.QualificationTypeId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("QualificationTypeId", 64))
+	}
+	if s.QualificationTypeId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.QualificationTypeId) {
+		invalidParams.Add(request.NewErrParamPattern("QualificationTypeId", "^[A-Z0-9]+$"))
+	}
+	if s.WorkerId != nil && len(*s.WorkerId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkerId", 64))
+	}
+	if s.WorkerId != nil && !request.MatchParamPattern("^A[A-Z0-9]+$", *s.WorkerId) {
+		invalidParams.Add(request.NewErrParamPattern("WorkerId", "^A[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetQualificationTypeId sets the QualificationTypeId field's value.
 func (s *DisassociateQualificationFromWorkerInput) SetQualificationTypeId(v string) *DisassociateQualificationFromWorkerInput {
 	s.QualificationTypeId = &v
@@ -5843,6 +6106,24 @@ func (s *GetAssignmentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetAssignmentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetAssignmentInput"}
+	if s.AssignmentId != nil && len(*s.AssignmentId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssignmentId", 64))
+	}
+	if s.AssignmentId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.AssignmentId) {
+		invalidParams.Add(request.NewErrParamPattern("AssignmentId", "^[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssignmentId sets the AssignmentId field's value.
 func (s *GetAssignmentInput) SetAssignmentId(v string) *GetAssignmentInput {
 	s.AssignmentId = &v
@@ -5927,6 +6208,24 @@ func (s *GetFileUploadURLInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetFileUploadURLInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetFileUploadURLInput"}
+	if s.AssignmentId != nil && len(*s.AssignmentId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssignmentId", 64))
+	}
+	if s.AssignmentId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.AssignmentId) {
+		invalidParams.Add(request.NewErrParamPattern("AssignmentId", "^[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssignmentId sets the AssignmentId field's value.
 func (s *GetFileUploadURLInput) SetAssignmentId(v string) *GetFileUploadURLInput {
 	s.AssignmentId = &v
@@ -5999,6 +6298,24 @@ func (s *GetHITInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetHITInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetHITInput"}
+	if s.HITId != nil && len(*s.HITId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HITId", 64))
+	}
+	if s.HITId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.HITId) {
+		invalidParams.Add(request.NewErrParamPattern("HITId", "^[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHITId sets the HITId field's value.
 func (s *GetHITInput) SetHITId(v string) *GetHITInput {
 	s.HITId = &v
@@ -6076,6 +6393,30 @@ func (s *GetQualificationScoreInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetQualificationScoreInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetQualificationScoreInput"}
+	if s.QualificationTypeId != nil && len(*s.QualificationTypeId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("QualificationTypeId", 64))
+	}
+	if s.QualificationTypeId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.QualificationTypeId) {
+		invalidParams.Add(request.NewErrParamPattern("QualificationTypeId", "^[A-Z0-9]+$"))
+	}
+	if s.WorkerId != nil && len(*s.WorkerId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkerId", 64))
+	}
+	if s.WorkerId != nil && !request.MatchParamPattern("^A[A-Z0-9]+$", *s.WorkerId) {
+		invalidParams.Add(request.NewErrParamPattern("WorkerId", "^A[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetQualificationTypeId sets the QualificationTypeId field's value.
 func (s *GetQualificationScoreInput) SetQualificationTypeId(v string) *GetQualificationScoreInput {
 	s.QualificationTypeId = &v
@@ -6149,6 +6490,24 @@ func (s *GetQualificationTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetQualificationTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetQualificationTypeInput"}
+	if s.QualificationTypeId != nil && len(*s.QualificationTypeId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("QualificationTypeId", 64))
+	}
+	if s.QualificationTypeId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.QualificationTypeId) {
+		invalidParams.Add(request.NewErrParamPattern("QualificationTypeId", "^[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetQualificationTypeId sets the QualificationTypeId field's value.
 func (s *GetQualificationTypeInput) SetQualificationTypeId(v string) *GetQualificationTypeInput {
 	s.QualificationTypeId = &v
@@ -6488,6 +6847,30 @@ func (s *ListAssignmentsForHITInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAssignmentsForHITInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAssignmentsForHITInput"}
+	if s.HITId != nil && len(*s.HITId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HITId", 64))
+	}
+	if s.HITId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.HITId) {
+		invalidParams.Add(request.NewErrParamPattern("HITId", "^[A-Z0-9]+$"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssignmentStatuses sets the AssignmentStatuses field's value.
 func (s *ListAssignmentsForHITInput) SetAssignmentStatuses(v []*string) *ListAssignmentsForHITInput {
 	s.AssignmentStatuses = v
@@ -6609,6 +6992,36 @@ func (s *ListBonusPaymentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListBonusPaymentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListBonusPaymentsInput"}
+	if s.AssignmentId != nil && len(*s.AssignmentId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssignmentId", 64))
+	}
+	if s.AssignmentId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.AssignmentId) {
+		invalidParams.Add(request.NewErrParamPattern("AssignmentId", "^[A-Z0-9]+$"))
+	}
+	if s.HITId != nil && len(*s.HITId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HITId", 64))
+	}
+	if s.HITId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.HITId) {
+		invalidParams.Add(request.NewErrParamPattern("HITId", "^[A-Z0-9]+$"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssignmentId sets the AssignmentId field's value.
 func (s *ListBonusPaymentsInput) SetAssignmentId(v string) *ListBonusPaymentsInput {
 	s.AssignmentId = &v
@@ -6727,6 +7140,30 @@ func (s *ListHITsForQualificationTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListHITsForQualificationTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListHITsForQualificationTypeInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 255))
+	}
+	if s.QualificationTypeId != nil && len(*s.QualificationTypeId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("QualificationTypeId", 64))
+	}
+	if s.QualificationTypeId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.QualificationTypeId) {
+		invalidParams.Add(request.NewErrParamPattern("QualificationTypeId", "^[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListHITsForQualificationTypeInput) SetMaxResults(v int64) *ListHITsForQualificationTypeInput {
 	s.MaxResults = &v
@@ -6826,6 +7263,24 @@ func (s *ListHITsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListHITsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListHITsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListHITsInput) SetMaxResults(v int64) *ListHITsInput {
 	s.MaxResults = &v
@@ -6928,6 +7383,30 @@ func (s *ListQualificationRequestsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListQualificationRequestsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListQualificationRequestsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 255))
+	}
+	if s.QualificationTypeId != nil && len(*s.QualificationTypeId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("QualificationTypeId", 64))
+	}
+	if s.QualificationTypeId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.QualificationTypeId) {
+		invalidParams.Add(request.NewErrParamPattern("QualificationTypeId", "^[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListQualificationRequestsInput) SetMaxResults(v int64) *ListQualificationRequestsInput {
 	s.MaxResults = &v
@@ -7052,6 +7531,24 @@ func (s *ListQualificationTypesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListQualificationTypesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListQualificationTypesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListQualificationTypesInput) SetMaxResults(v int64) *ListQualificationTypesInput {
 	s.MaxResults = &v
@@ -7188,6 +7685,30 @@ func (s *ListReviewPolicyResultsForHITInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListReviewPolicyResultsForHITInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListReviewPolicyResultsForHITInput"}
+	if s.HITId != nil && len(*s.HITId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HITId", 64))
+	}
+	if s.HITId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.HITId) {
+		invalidParams.Add(request.NewErrParamPattern("HITId", "^[A-Z0-9]+$"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHITId sets the HITId field's value.
 func (s *ListReviewPolicyResultsForHITInput) SetHITId(v string) *ListReviewPolicyResultsForHITInput {
 	s.HITId = &v
@@ -7344,6 +7865,36 @@ func (s *ListReviewableHITsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListReviewableHITsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListReviewableHITsInput"}
+	if s.HITTypeId != nil && len(*s.HITTypeId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HITTypeId", 64))
+	}
+	if s.HITTypeId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.HITTypeId) {
+		invalidParams.Add(request.NewErrParamPattern("HITTypeId", "^[A-Z0-9]+$"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 255))
+	}
+	if s.Status != nil {
+		values := []string{"Reviewable", "Reviewing"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHITTypeId sets the HITTypeId field's value.
 func (s *ListReviewableHITsInput) SetHITTypeId(v string) *ListReviewableHITsInput {
 	s.HITTypeId = &v
@@ -7449,6 +8000,24 @@ func (s *ListWorkerBlocksInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListWorkerBlocksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListWorkerBlocksInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListWorkerBlocksInput) SetMaxResults(v int64) *ListWorkerBlocksInput {
 	s.MaxResults = &v
@@ -7558,6 +8127,36 @@ func (s *ListWorkersWithQualificationTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListWorkersWithQualificationTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListWorkersWithQualificationTypeInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 255))
+	}
+	if s.QualificationTypeId != nil && len(*s.QualificationTypeId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("QualificationTypeId", 64))
+	}
+	if s.QualificationTypeId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.QualificationTypeId) {
+		invalidParams.Add(request.NewErrParamPattern("QualificationTypeId", "^[A-Z0-9]+$"))
+	}
+	if s.Status != nil {
+		values := []string{"Granted", "Revoked"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListWorkersWithQualificationTypeInput) SetMaxResults(v int64) *ListWorkersWithQualificationTypeInput {
 	s.MaxResults = &v
@@ -7672,6 +8271,24 @@ func (s *Locale) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Locale) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Locale"}
+	if s.Country != nil && len(*s.Country) > 2 {
+		invalidParams.Add(request.NewErrParamMaxLen("Country", 2))
+	}
+	if s.Subdivision != nil && len(*s.Subdivision) > 2 {
+		invalidParams.Add(request.NewErrParamMaxLen("Subdivision", 2))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCountry sets the Country field's value.
 func (s *Locale) SetCountry(v string) *Locale {
 	s.Country = &v
@@ -7741,6 +8358,24 @@ func (s *NotificationSpecification) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NotificationSpecification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NotificationSpecification"}
+	if s.Transport != nil {
+		values := []string{"Email", "SQS"}
+		if !request.IsParamEnumValue(*s.Transport, values) {
+			invalidParams.Add(request.NewErrParamEnum("Transport", *s.Transport, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestination sets the Destination field's value.
 func (s *NotificationSpecification) SetDestination(v string) *NotificationSpecification {
 	s.Destination = &v
@@ -8229,6 +8864,34 @@ func (s *QualificationRequirement) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *QualificationRequirement) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "QualificationRequirement"}
+	if s.Comparator != nil {
+		values := []string{"LessThan", "LessThanOrEqualTo", "GreaterThan", "GreaterThanOrEqualTo", "EqualTo", "NotEqualTo", "Exists", "DoesNotExist", "In", "NotIn"}
+		if !request.IsParamEnumValue(*s.Comparator, values) {
+			invalidParams.Add(request.NewErrParamEnum("Comparator", *s.Comparator, values))
+		}
+	}
+	if s.LocaleValues != nil {
+		for i, v := range s.LocaleValues {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "LocaleValues", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComparator sets the Comparator field's value.
 func (s *QualificationRequirement) SetComparator(v string) *QualificationRequirement {
 	s.Comparator = &v
@@ -8458,6 +9121,24 @@ func (s *RejectAssignmentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RejectAssignmentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RejectAssignmentInput"}
+	if s.AssignmentId != nil && len(*s.AssignmentId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssignmentId", 64))
+	}
+	if s.AssignmentId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.AssignmentId) {
+		invalidParams.Add(request.NewErrParamPattern("AssignmentId", "^[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssignmentId sets the AssignmentId field's value.
 func (s *RejectAssignmentInput) SetAssignmentId(v string) *RejectAssignmentInput {
 	s.AssignmentId = &v
@@ -8862,6 +9543,36 @@ func (s *SendBonusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SendBonusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SendBonusInput"}
+	if s.AssignmentId != nil && len(*s.AssignmentId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("AssignmentId", 64))
+	}
+	if s.AssignmentId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.AssignmentId) {
+		invalidParams.Add(request.NewErrParamPattern("AssignmentId", "^[A-Z0-9]+$"))
+	}
+	if s.BonusAmount != nil && !request.MatchParamPattern("^[0-9]+(\\.)?[0-9]*$", *s.BonusAmount) {
+		invalidParams.Add(request.NewErrParamPattern("BonusAmount", "^[0-9]+(\\.)?[0-9]*$"))
+	}
+	if s.UniqueRequestToken != nil && len(*s.UniqueRequestToken) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("UniqueRequestToken", 64))
+	}
+	if s.WorkerId != nil && len(*s.WorkerId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkerId", 64))
+	}
+	if s.WorkerId != nil && !request.MatchParamPattern("^A[A-Z0-9]+$", *s.WorkerId) {
+		invalidParams.Add(request.NewErrParamPattern("WorkerId", "^A[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssignmentId sets the AssignmentId field's value.
 func (s *SendBonusInput) SetAssignmentId(v string) *SendBonusInput {
 	s.AssignmentId = &v
@@ -8958,6 +9669,29 @@ func (s *SendTestEventNotificationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SendTestEventNotificationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SendTestEventNotificationInput"}
+	if s.TestEventType != nil {
+		values := []string{"AssignmentAccepted", "AssignmentAbandoned", "AssignmentReturned", "AssignmentSubmitted", "AssignmentRejected", "AssignmentApproved", "HITCreated", "HITExpired", "HITReviewable", "HITExtended", "HITDisposed", "Ping"}
+		if !request.IsParamEnumValue(*s.TestEventType, values) {
+			invalidParams.Add(request.NewErrParamEnum("TestEventType", *s.TestEventType, values))
+		}
+	}
+	if s.Notification != nil {
+		if err := s.Notification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notification", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNotification sets the Notification field's value.
 func (s *SendTestEventNotificationInput) SetNotification(v *NotificationSpecification) *SendTestEventNotificationInput {
 	s.Notification = v
@@ -9024,6 +9758,24 @@ func (s *UpdateExpirationForHITInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateExpirationForHITInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateExpirationForHITInput"}
+	if s.HITId != nil && len(*s.HITId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HITId", 64))
+	}
+	if s.HITId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.HITId) {
+		invalidParams.Add(request.NewErrParamPattern("HITId", "^[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExpireAt sets the ExpireAt field's value.
 func (s *UpdateExpirationForHITInput) SetExpireAt(v time.Time) *UpdateExpirationForHITInput {
 	s.ExpireAt = &v
@@ -9095,6 +9847,24 @@ func (s *UpdateHITReviewStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateHITReviewStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateHITReviewStatusInput"}
+	if s.HITId != nil && len(*s.HITId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HITId", 64))
+	}
+	if s.HITId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.HITId) {
+		invalidParams.Add(request.NewErrParamPattern("HITId", "^[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHITId sets the HITId field's value.
 func (s *UpdateHITReviewStatusInput) SetHITId(v string) *UpdateHITReviewStatusInput {
 	s.HITId = &v
@@ -9169,6 +9939,30 @@ func (s *UpdateHITTypeOfHITInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateHITTypeOfHITInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateHITTypeOfHITInput"}
+	if s.HITId != nil && len(*s.HITId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HITId", 64))
+	}
+	if s.HITId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.HITId) {
+		invalidParams.Add(request.NewErrParamPattern("HITId", "^[A-Z0-9]+$"))
+	}
+	if s.HITTypeId != nil && len(*s.HITTypeId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HITTypeId", 64))
+	}
+	if s.HITTypeId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.HITTypeId) {
+		invalidParams.Add(request.NewErrParamPattern("HITTypeId", "^[A-Z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHITId sets the HITId field's value.
 func (s *UpdateHITTypeOfHITInput) SetHITId(v string) *UpdateHITTypeOfHITInput {
 	s.HITId = &v
@@ -9246,6 +10040,29 @@ func (s *UpdateNotificationSettingsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateNotificationSettingsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateNotificationSettingsInput"}
+	if s.HITTypeId != nil && len(*s.HITTypeId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HITTypeId", 64))
+	}
+	if s.HITTypeId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.HITTypeId) {
+		invalidParams.Add(request.NewErrParamPattern("HITTypeId", "^[A-Z0-9]+$"))
+	}
+	if s.Notification != nil {
+		if err := s.Notification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notification", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActive sets the Active field's value.
 func (s *UpdateNotificationSettingsInput) SetActive(v bool) *UpdateNotificationSettingsInput {
 	s.Active = &v
@@ -9360,6 +10177,30 @@ func (s *UpdateQualificationTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateQualificationTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateQualificationTypeInput"}
+	if s.QualificationTypeId != nil && len(*s.QualificationTypeId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("QualificationTypeId", 64))
+	}
+	if s.QualificationTypeId != nil && !request.MatchParamPattern("^[A-Z0-9]+$", *s.QualificationTypeId) {
+		invalidParams.Add(request.NewErrParamPattern("QualificationTypeId", "^[A-Z0-9]+$"))
+	}
+	if s.QualificationTypeStatus != nil {
+		values := []string{"Active", "Inactive"}
+		if !request.IsParamEnumValue(*s.QualificationTypeStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("QualificationTypeStatus", *s.QualificationTypeStatus, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAnswerKey sets the AnswerKey field's value.
 func (s *UpdateQualificationTypeInput) SetAnswerKey(v string) *UpdateQualificationTypeInput {
 	s.AnswerKey = &v
@@ -7094,6 +7094,24 @@ func (s *AutoScalingThresholds) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AutoScalingThresholds) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AutoScalingThresholds"}
+	if s.IgnoreMetricsTime != nil && *s.IgnoreMetricsTime > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("IgnoreMetricsTime", 100))
+	}
+	if s.ThresholdsWaitTime != nil && *s.ThresholdsWaitTime > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("ThresholdsWaitTime", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAlarms sets the Alarms field's value.
 func (s *AutoScalingThresholds) SetAlarms(v []*string) *AutoScalingThresholds {
 	s.Alarms = v
@@ -7169,6 +7187,23 @@ func (s BlockDeviceMapping) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BlockDeviceMapping) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BlockDeviceMapping"}
+	if s.Ebs != nil {
+		if err := s.Ebs.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Ebs", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeviceName sets the DeviceName field's value.
 func (s *BlockDeviceMapping) SetDeviceName(v string) *BlockDeviceMapping {
 	s.DeviceName = &v
@@ -7488,6 +7523,29 @@ func (s *CloneStackInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CloneStackInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CloneStackInput"}
+	if s.DefaultRootDeviceType != nil {
+		values := []string{"ebs", "instance-store"}
+		if !request.IsParamEnumValue(*s.DefaultRootDeviceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DefaultRootDeviceType", *s.DefaultRootDeviceType, values))
+		}
+	}
+	if s.CustomCookbooksSource != nil {
+		if err := s.CustomCookbooksSource.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CustomCookbooksSource", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAgentVersion sets the AgentVersion field's value.
 func (s *CloneStackInput) SetAgentVersion(v string) *CloneStackInput {
 	s.AgentVersion = &v
@@ -7667,6 +7725,28 @@ func (s CloudWatchLogsConfiguration) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CloudWatchLogsConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CloudWatchLogsConfiguration"}
+	if s.LogStreams != nil {
+		for i, v := range s.LogStreams {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "LogStreams", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEnabled sets the Enabled field's value.
 func (s *CloudWatchLogsConfiguration) SetEnabled(v bool) *CloudWatchLogsConfiguration {
 	s.Enabled = &v
@@ -7758,6 +7838,36 @@ func (s CloudWatchLogsLogStream) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CloudWatchLogsLogStream) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CloudWatchLogsLogStream"}
+	if s.Encoding != nil {
+		values := []string{"ascii", "big5", "big5hkscs", "cp037", "cp424", "cp437", "cp500", "cp720", "cp737", "cp775", "cp850", "cp852", "cp855", "cp856", "cp857", "cp858", "cp860", "cp861", "cp862", "cp863", "cp864", "cp865", "cp866", "cp869", "cp874", "cp875", "cp932", "cp949", "cp950", "cp1006", "cp1026", "cp1140", "cp1250", "cp1251", "cp1252", "cp1253", "cp1254", "cp1255", "cp1256", "cp1257", "cp1258", "euc_jp", "euc_jis_2004", "euc_jisx0213", "euc_kr", "gb2312", "gbk", "gb18030", "hz", "iso2022_jp", "iso2022_jp_1", "iso2022_jp_2", "iso2022_jp_2004", "iso2022_jp_3", "iso2022_jp_ext", "iso2022_kr", "latin_1", "iso8859_2", "iso8859_3", "iso8859_4", "iso8859_5", "iso8859_6", "iso8859_7", "iso8859_8", "iso8859_9", "iso8859_10", "iso8859_13", "iso8859_14", "iso8859_15", "iso8859_16", "johab", "koi8_r", "koi8_u", "mac_cyrillic", "mac_greek", "mac_iceland", "mac_latin2", "mac_roman", "mac_turkish", "ptcp154", "shift_jis", "shift_jis_2004", "shift_jisx0213", "utf_32", "utf_32_be", "utf_32_le", "utf_16", "utf_16_be", "utf_16_le", "utf_7", "utf_8", "utf_8_sig"}
+		if !request.IsParamEnumValue(*s.Encoding, values) {
+			invalidParams.Add(request.NewErrParamEnum("Encoding", *s.Encoding, values))
+		}
+	}
+	if s.InitialPosition != nil {
+		values := []string{"start_of_file", "end_of_file"}
+		if !request.IsParamEnumValue(*s.InitialPosition, values) {
+			invalidParams.Add(request.NewErrParamEnum("InitialPosition", *s.InitialPosition, values))
+		}
+	}
+	if s.TimeZone != nil {
+		values := []string{"LOCAL", "UTC"}
+		if !request.IsParamEnumValue(*s.TimeZone, values) {
+			invalidParams.Add(request.NewErrParamEnum("TimeZone", *s.TimeZone, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBatchCount sets the BatchCount field's value.
 func (s *CloudWatchLogsLogStream) SetBatchCount(v int64) *CloudWatchLogsLogStream {
 	s.BatchCount = &v
@@ -8071,6 +8181,29 @@ func (s *CreateAppInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAppInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAppInput"}
+	if s.Type != nil {
+		values := []string{"aws-flow-ruby", "java", "rails", "php", "nodejs", "static", "other"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.AppSource != nil {
+		if err := s.AppSource.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AppSource", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAppSource sets the AppSource field's value.
 func (s *CreateAppInput) SetAppSource(v *Source) *CreateAppInput {
 	s.AppSource = v
@@ -8238,6 +8371,23 @@ func (s *CreateDeploymentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDeploymentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDeploymentInput"}
+	if s.Command != nil {
+		if err := s.Command.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Command", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAppId sets the AppId field's value.
 func (s *CreateDeploymentInput) SetAppId(v string) *CreateDeploymentInput {
 	s.AppId = &v
@@ -8476,6 +8626,46 @@ func (s *CreateInstanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateInstanceInput"}
+	if s.Architecture != nil {
+		values := []string{"x86_64", "i386"}
+		if !request.IsParamEnumValue(*s.Architecture, values) {
+			invalidParams.Add(request.NewErrParamEnum("Architecture", *s.Architecture, values))
+		}
+	}
+	if s.AutoScalingType != nil {
+		values := []string{"load", "timer"}
+		if !request.IsParamEnumValue(*s.AutoScalingType, values) {
+			invalidParams.Add(request.NewErrParamEnum("AutoScalingType", *s.AutoScalingType, values))
+		}
+	}
+	if s.RootDeviceType != nil {
+		values := []string{"ebs", "instance-store"}
+		if !request.IsParamEnumValue(*s.RootDeviceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("RootDeviceType", *s.RootDeviceType, values))
+		}
+	}
+	if s.BlockDeviceMappings != nil {
+		for i, v := range s.BlockDeviceMappings {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "BlockDeviceMappings", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAgentVersion sets the AgentVersion field's value.
 func (s *CreateInstanceInput) SetAgentVersion(v string) *CreateInstanceInput {
 	s.AgentVersion = &v
@@ -8747,6 +8937,29 @@ func (s *CreateLayerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateLayerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateLayerInput"}
+	if s.Type != nil {
+		values := []string{"aws-flow-ruby", "ecs-cluster", "java-app", "lb", "web", "php-app", "rails-app", "nodejs-app", "memcached", "db-master", "monitoring-master", "custom"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.CloudWatchLogsConfiguration != nil {
+		if err := s.CloudWatchLogsConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CloudWatchLogsConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *CreateLayerInput) SetAttributes(v map[string]*string) *CreateLayerInput {
 	s.Attributes = v
@@ -9134,6 +9347,29 @@ func (s *CreateStackInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateStackInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateStackInput"}
+	if s.DefaultRootDeviceType != nil {
+		values := []string{"ebs", "instance-store"}
+		if !request.IsParamEnumValue(*s.DefaultRootDeviceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DefaultRootDeviceType", *s.DefaultRootDeviceType, values))
+		}
+	}
+	if s.CustomCookbooksSource != nil {
+		if err := s.CustomCookbooksSource.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CustomCookbooksSource", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAgentVersion sets the AgentVersion field's value.
 func (s *CreateStackInput) SetAgentVersion(v string) *CreateStackInput {
 	s.AgentVersion = &v
@@ -9930,6 +10166,24 @@ func (s *DeploymentCommand) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeploymentCommand) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeploymentCommand"}
+	if s.Name != nil {
+		values := []string{"install_dependencies", "update_dependencies", "update_custom_cookbooks", "execute_recipes", "configure", "setup", "deploy", "rollback", "start", "stop", "restart", "undeploy"}
+		if !request.IsParamEnumValue(*s.Name, values) {
+			invalidParams.Add(request.NewErrParamEnum("Name", *s.Name, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetArgs sets the Args field's value.
 func (s *DeploymentCommand) SetArgs(v map[string][]*string) *DeploymentCommand {
 	s.Args = v
@@ -11764,6 +12018,24 @@ func (s EbsBlockDevice) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EbsBlockDevice) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EbsBlockDevice"}
+	if s.VolumeType != nil {
+		values := []string{"gp2", "io1", "standard"}
+		if !request.IsParamEnumValue(*s.VolumeType, values) {
+			invalidParams.Add(request.NewErrParamEnum("VolumeType", *s.VolumeType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeleteOnTermination sets the DeleteOnTermination field's value.
 func (s *EbsBlockDevice) SetDeleteOnTermination(v bool) *EbsBlockDevice {
 	s.DeleteOnTermination = &v
@@ -12191,6 +12463,21 @@ func (s *GrantAccessInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GrantAccessInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GrantAccessInput"}
+	if s.ValidForInMinutes != nil && *s.ValidForInMinutes > 1440 {
+		invalidParams.Add(request.NewErrParamMaxValue("ValidForInMinutes", 1440))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceId sets the InstanceId field's value.
 func (s *GrantAccessInput) SetInstanceId(v string) *GrantAccessInput {
 	s.InstanceId = &v
@@ -14405,6 +14692,28 @@ func (s *SetLoadBasedAutoScalingInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetLoadBasedAutoScalingInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetLoadBasedAutoScalingInput"}
+	if s.DownScaling != nil {
+		if err := s.DownScaling.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DownScaling", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.UpScaling != nil {
+		if err := s.UpScaling.ValidateExtended(); err != nil {
+			invalidParams.AddNested("UpScaling", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDownScaling sets the DownScaling field's value.
 func (s *SetLoadBasedAutoScalingInput) SetDownScaling(v *AutoScalingThresholds) *SetLoadBasedAutoScalingInput {
 	s.DownScaling = v
@@ -14711,6 +15020,24 @@ func (s Source) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Source) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Source"}
+	if s.Type != nil {
+		values := []string{"git", "svn", "archive", "s3"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPassword sets the Password field's value.
 func (s *Source) SetPassword(v string) *Source {
 	s.Password = &v
@@ -15809,6 +16136,29 @@ func (s *UpdateAppInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateAppInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateAppInput"}
+	if s.Type != nil {
+		values := []string{"aws-flow-ruby", "java", "rails", "php", "nodejs", "static", "other"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.AppSource != nil {
+		if err := s.AppSource.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AppSource", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAppId sets the AppId field's value.
 func (s *UpdateAppInput) SetAppId(v string) *UpdateAppInput {
 	s.AppId = &v
@@ -16080,6 +16430,30 @@ func (s *UpdateInstanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateInstanceInput"}
+	if s.Architecture != nil {
+		values := []string{"x86_64", "i386"}
+		if !request.IsParamEnumValue(*s.Architecture, values) {
+			invalidParams.Add(request.NewErrParamEnum("Architecture", *s.Architecture, values))
+		}
+	}
+	if s.AutoScalingType != nil {
+		values := []string{"load", "timer"}
+		if !request.IsParamEnumValue(*s.AutoScalingType, values) {
+			invalidParams.Add(request.NewErrParamEnum("AutoScalingType", *s.AutoScalingType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAgentVersion sets the AgentVersion field's value.
 func (s *UpdateInstanceInput) SetAgentVersion(v string) *UpdateInstanceInput {
 	s.AgentVersion = &v
@@ -16279,6 +16653,23 @@ func (s *UpdateLayerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateLayerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateLayerInput"}
+	if s.CloudWatchLogsConfiguration != nil {
+		if err := s.CloudWatchLogsConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CloudWatchLogsConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *UpdateLayerInput) SetAttributes(v map[string]*string) *UpdateLayerInput {
 	s.Attributes = v
@@ -16711,6 +17102,29 @@ func (s *UpdateStackInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateStackInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateStackInput"}
+	if s.DefaultRootDeviceType != nil {
+		values := []string{"ebs", "instance-store"}
+		if !request.IsParamEnumValue(*s.DefaultRootDeviceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DefaultRootDeviceType", *s.DefaultRootDeviceType, values))
+		}
+	}
+	if s.CustomCookbooksSource != nil {
+		if err := s.CustomCookbooksSource.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CustomCookbooksSource", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAgentVersion sets the AgentVersion field's value.
 func (s *UpdateStackInput) SetAgentVersion(v string) *UpdateStackInput {
 	s.AgentVersion = &v
@@ -238,9 +238,10 @@ const opCreateServer = "CreateServer"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/opsworkscm-2016-11-01/CreateServer
 func (c *OpsWorksCM) CreateServerRequest(input *CreateServerInput) (req *request.Request, output *CreateServerOutput) {
 	op := &request.Operation{
-		Name:       opCreateServer,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opCreateServer,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Server.EngineAttributes[*].Value"},
 	}
 
 	if input == nil {
@@ -878,9 +879,10 @@ const opDescribeServers = "DescribeServers"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/opsworkscm-2016-11-01/DescribeServers
 func (c *OpsWorksCM) DescribeServersRequest(input *DescribeServersInput) (req *request.Request, output *DescribeServersOutput) {
 	op := &request.Operation{
-		Name:       opDescribeServers,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeServers,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Servers[*].EngineAttributes[*].Value"},
 	}
 
 	if input == nil {
@@ -1160,9 +1162,10 @@ const opStartMaintenance = "StartMaintenance"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/opsworkscm-2016-11-01/StartMaintenance
 func (c *OpsWorksCM) StartMaintenanceRequest(input *StartMaintenanceInput) (req *request.Request, output *StartMaintenanceOutput) {
 	op := &request.Operation{
-		Name:       opStartMaintenance,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opStartMaintenance,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Server.EngineAttributes[*].Value"},
 	}
 
 	if input == nil {
@@ -1254,9 +1257,10 @@ const opUpdateServer = "UpdateServer"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/opsworkscm-2016-11-01/UpdateServer
 func (c *OpsWorksCM) UpdateServerRequest(input *UpdateServerInput) (req *request.Request, output *UpdateServerOutput) {
 	op := &request.Operation{
-		Name:       opUpdateServer,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opUpdateServer,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Server.EngineAttributes[*].Value"},
 	}
 
 	if input == nil {
@@ -1342,9 +1346,10 @@ const opUpdateServerEngineAttributes = "UpdateServerEngineAttributes"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/opsworkscm-2016-11-01/UpdateServerEngineAttributes
 func (c *OpsWorksCM) UpdateServerEngineAttributesRequest(input *UpdateServerEngineAttributesInput) (req *request.Request, output *UpdateServerEngineAttributesOutput) {
 	op := &request.Operation{
-		Name:       opUpdateServerEngineAttributes,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opUpdateServerEngineAttributes,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Server.EngineAttributes[*].Value"},
 	}
 
 	if input == nil {
@@ -1521,6 +1526,24 @@ func (s *AssociateNodeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssociateNodeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociateNodeInput"}
+	if s.ServerName != nil && len(*s.ServerName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerName", 40))
+	}
+	if s.ServerName != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9\\-]*", *s.ServerName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerName", "[a-zA-Z][a-zA-Z0-9\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEngineAttributes sets the EngineAttributes field's value.
 func (s *AssociateNodeInput) SetEngineAttributes(v []*EngineAttribute) *AssociateNodeInput {
 	s.EngineAttributes = v
@@ -1844,6 +1867,24 @@ func (s *CreateBackupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateBackupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateBackupInput"}
+	if s.ServerName != nil && len(*s.ServerName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerName", 40))
+	}
+	if s.ServerName != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9\\-]*", *s.ServerName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerName", "[a-zA-Z][a-zA-Z0-9\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *CreateBackupInput) SetDescription(v string) *CreateBackupInput {
 	s.Description = &v
@@ -2059,6 +2100,39 @@ func (s *CreateServerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateServerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateServerInput"}
+	if s.BackupId != nil && len(*s.BackupId) > 79 {
+		invalidParams.Add(request.NewErrParamMaxLen("BackupId", 79))
+	}
+	if s.InstanceProfileArn != nil && !request.MatchParamPattern("arn:aws:iam::[0-9]{12}:instance-profile/.*", *s.InstanceProfileArn) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceProfileArn", "arn:aws:iam::[0-9]{12}:instance-profile/.*"))
+	}
+	if s.PreferredBackupWindow != nil && !request.MatchParamPattern("^((Mon|Tue|Wed|Thu|Fri|Sat|Sun):)?([0-1][0-9]|2[0-3]):[0-5][0-9]$", *s.PreferredBackupWindow) {
+		invalidParams.Add(request.NewErrParamPattern("PreferredBackupWindow", "^((Mon|Tue|Wed|Thu|Fri|Sat|Sun):)?([0-1][0-9]|2[0-3]):[0-5][0-9]$"))
+	}
+	if s.PreferredMaintenanceWindow != nil && !request.MatchParamPattern("^((Mon|Tue|Wed|Thu|Fri|Sat|Sun):)?([0-1][0-9]|2[0-3]):[0-5][0-9]$", *s.PreferredMaintenanceWindow) {
+		invalidParams.Add(request.NewErrParamPattern("PreferredMaintenanceWindow", "^((Mon|Tue|Wed|Thu|Fri|Sat|Sun):)?([0-1][0-9]|2[0-3]):[0-5][0-9]$"))
+	}
+	if s.ServerName != nil && len(*s.ServerName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerName", 40))
+	}
+	if s.ServerName != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9\\-]*", *s.ServerName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerName", "[a-zA-Z][a-zA-Z0-9\\-]*"))
+	}
+	if s.ServiceRoleArn != nil && !request.MatchParamPattern("arn:aws:iam::[0-9]{12}:role/.*", *s.ServiceRoleArn) {
+		invalidParams.Add(request.NewErrParamPattern("ServiceRoleArn", "arn:aws:iam::[0-9]{12}:role/.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssociatePublicIpAddress sets the AssociatePublicIpAddress field's value.
 func (s *CreateServerInput) SetAssociatePublicIpAddress(v bool) *CreateServerInput {
 	s.AssociatePublicIpAddress = &v
@@ -2219,6 +2293,21 @@ func (s *DeleteBackupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteBackupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteBackupInput"}
+	if s.BackupId != nil && len(*s.BackupId) > 79 {
+		invalidParams.Add(request.NewErrParamMaxLen("BackupId", 79))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBackupId sets the BackupId field's value.
 func (s *DeleteBackupInput) SetBackupId(v string) *DeleteBackupInput {
 	s.BackupId = &v
@@ -2276,6 +2365,24 @@ func (s *DeleteServerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteServerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteServerInput"}
+	if s.ServerName != nil && len(*s.ServerName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerName", 40))
+	}
+	if s.ServerName != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9\\-]*", *s.ServerName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerName", "[a-zA-Z][a-zA-Z0-9\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetServerName sets the ServerName field's value.
 func (s *DeleteServerInput) SetServerName(v string) *DeleteServerInput {
 	s.ServerName = &v
@@ -2390,6 +2497,27 @@ func (s *DescribeBackupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeBackupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeBackupsInput"}
+	if s.BackupId != nil && len(*s.BackupId) > 79 {
+		invalidParams.Add(request.NewErrParamMaxLen("BackupId", 79))
+	}
+	if s.ServerName != nil && len(*s.ServerName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerName", 40))
+	}
+	if s.ServerName != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9\\-]*", *s.ServerName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerName", "[a-zA-Z][a-zA-Z0-9\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBackupId sets the BackupId field's value.
 func (s *DescribeBackupsInput) SetBackupId(v string) *DescribeBackupsInput {
 	s.BackupId = &v
@@ -2510,6 +2638,24 @@ func (s *DescribeEventsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEventsInput"}
+	if s.ServerName != nil && len(*s.ServerName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerName", 40))
+	}
+	if s.ServerName != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9\\-]*", *s.ServerName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerName", "[a-zA-Z][a-zA-Z0-9\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *DescribeEventsInput) SetMaxResults(v int64) *DescribeEventsInput {
 	s.MaxResults = &v
@@ -2610,6 +2756,24 @@ func (s *DescribeNodeAssociationStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeNodeAssociationStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeNodeAssociationStatusInput"}
+	if s.ServerName != nil && len(*s.ServerName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerName", 40))
+	}
+	if s.ServerName != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9\\-]*", *s.ServerName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerName", "[a-zA-Z][a-zA-Z0-9\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNodeAssociationStatusToken sets the NodeAssociationStatusToken field's value.
 func (s *DescribeNodeAssociationStatusInput) SetNodeAssociationStatusToken(v string) *DescribeNodeAssociationStatusInput {
 	s.NodeAssociationStatusToken = &v
@@ -2705,6 +2869,24 @@ func (s *DescribeServersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeServersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeServersInput"}
+	if s.ServerName != nil && len(*s.ServerName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerName", 40))
+	}
+	if s.ServerName != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9\\-]*", *s.ServerName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerName", "[a-zA-Z][a-zA-Z0-9\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *DescribeServersInput) SetMaxResults(v int64) *DescribeServersInput {
 	s.MaxResults = &v
@@ -2815,6 +2997,24 @@ func (s *DisassociateNodeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisassociateNodeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisassociateNodeInput"}
+	if s.ServerName != nil && len(*s.ServerName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerName", 40))
+	}
+	if s.ServerName != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9\\-]*", *s.ServerName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerName", "[a-zA-Z][a-zA-Z0-9\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEngineAttributes sets the EngineAttributes field's value.
 func (s *DisassociateNodeInput) SetEngineAttributes(v []*EngineAttribute) *DisassociateNodeInput {
 	s.EngineAttributes = v
@@ -2867,7 +3067,7 @@ type EngineAttribute struct {
 	Name *string `type:"string"`
 
 	// The value of the engine attribute.
-	Value *string `type:"string"`
+	Value *string `type:"string" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -2946,6 +3146,27 @@ func (s *RestoreServerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RestoreServerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RestoreServerInput"}
+	if s.BackupId != nil && len(*s.BackupId) > 79 {
+		invalidParams.Add(request.NewErrParamMaxLen("BackupId", 79))
+	}
+	if s.ServerName != nil && len(*s.ServerName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerName", 40))
+	}
+	if s.ServerName != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9\\-]*", *s.ServerName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerName", "[a-zA-Z][a-zA-Z0-9\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBackupId sets the BackupId field's value.
 func (s *RestoreServerInput) SetBackupId(v string) *RestoreServerInput {
 	s.BackupId = &v
@@ -3321,6 +3542,24 @@ func (s *StartMaintenanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartMaintenanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartMaintenanceInput"}
+	if s.ServerName != nil && len(*s.ServerName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerName", 40))
+	}
+	if s.ServerName != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9\\-]*", *s.ServerName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerName", "[a-zA-Z][a-zA-Z0-9\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetServerName sets the ServerName field's value.
 func (s *StartMaintenanceInput) SetServerName(v string) *StartMaintenanceInput {
 	s.ServerName = &v
@@ -3401,6 +3640,30 @@ func (s *UpdateServerEngineAttributesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateServerEngineAttributesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateServerEngineAttributesInput"}
+	if s.AttributeName != nil && len(*s.AttributeName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("AttributeName", 64))
+	}
+	if s.AttributeName != nil && !request.MatchParamPattern("[A-Z][A-Z0-9_]*", *s.AttributeName) {
+		invalidParams.Add(request.NewErrParamPattern("AttributeName", "[A-Z][A-Z0-9_]*"))
+	}
+	if s.ServerName != nil && len(*s.ServerName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerName", 40))
+	}
+	if s.ServerName != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9\\-]*", *s.ServerName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerName", "[a-zA-Z][a-zA-Z0-9\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeName sets the AttributeName field's value.
 func (s *UpdateServerEngineAttributesInput) SetAttributeName(v string) *UpdateServerEngineAttributesInput {
 	s.AttributeName = &v
@@ -3498,6 +3761,30 @@ func (s *UpdateServerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateServerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateServerInput"}
+	if s.PreferredBackupWindow != nil && !request.MatchParamPattern("^((Mon|Tue|Wed|Thu|Fri|Sat|Sun):)?([0-1][0-9]|2[0-3]):[0-5][0-9]$", *s.PreferredBackupWindow) {
+		invalidParams.Add(request.NewErrParamPattern("PreferredBackupWindow", "^((Mon|Tue|Wed|Thu|Fri|Sat|Sun):)?([0-1][0-9]|2[0-3]):[0-5][0-9]$"))
+	}
+	if s.PreferredMaintenanceWindow != nil && !request.MatchParamPattern("^((Mon|Tue|Wed|Thu|Fri|Sat|Sun):)?([0-1][0-9]|2[0-3]):[0-5][0-9]$", *s.PreferredMaintenanceWindow) {
+		invalidParams.Add(request.NewErrParamPattern("PreferredMaintenanceWindow", "^((Mon|Tue|Wed|Thu|Fri|Sat|Sun):)?([0-1][0-9]|2[0-3]):[0-5][0-9]$"))
+	}
+	if s.ServerName != nil && len(*s.ServerName) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServerName", 40))
+	}
+	if s.ServerName != nil && !request.MatchParamPattern("[a-zA-Z][a-zA-Z0-9\\-]*", *s.ServerName) {
+		invalidParams.Add(request.NewErrParamPattern("ServerName", "[a-zA-Z][a-zA-Z0-9\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBackupRetentionCount sets the BackupRetentionCount field's value.
 func (s *UpdateServerInput) SetBackupRetentionCount(v int64) *UpdateServerInput {
 	s.BackupRetentionCount = &v
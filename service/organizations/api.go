@@ -40,9 +40,10 @@ const opAcceptHandshake = "AcceptHandshake"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/organizations-2016-11-28/AcceptHandshake
 func (c *Organizations) AcceptHandshakeRequest(input *AcceptHandshakeInput) (req *request.Request, output *AcceptHandshakeOutput) {
 	op := &request.Operation{
-		Name:       opAcceptHandshake,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opAcceptHandshake,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Handshake.Parties[*].Id", "Handshake.Resources[*].Value"},
 	}
 
 	if input == nil {
@@ -553,9 +554,10 @@ const opCancelHandshake = "CancelHandshake"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/organizations-2016-11-28/CancelHandshake
 func (c *Organizations) CancelHandshakeRequest(input *CancelHandshakeInput) (req *request.Request, output *CancelHandshakeOutput) {
 	op := &request.Operation{
-		Name:       opCancelHandshake,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opCancelHandshake,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Handshake.Parties[*].Id", "Handshake.Resources[*].Value"},
 	}
 
 	if input == nil {
@@ -721,9 +723,10 @@ const opCreateAccount = "CreateAccount"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/organizations-2016-11-28/CreateAccount
 func (c *Organizations) CreateAccountRequest(input *CreateAccountInput) (req *request.Request, output *CreateAccountOutput) {
 	op := &request.Operation{
-		Name:       opCreateAccount,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opCreateAccount,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"CreateAccountStatus.AccountName"},
 	}
 
 	if input == nil {
@@ -996,9 +999,10 @@ const opCreateOrganization = "CreateOrganization"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/organizations-2016-11-28/CreateOrganization
 func (c *Organizations) CreateOrganizationRequest(input *CreateOrganizationInput) (req *request.Request, output *CreateOrganizationOutput) {
 	op := &request.Operation{
-		Name:       opCreateOrganization,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opCreateOrganization,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Organization.MasterAccountEmail"},
 	}
 
 	if input == nil {
@@ -1749,9 +1753,10 @@ const opDeclineHandshake = "DeclineHandshake"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/organizations-2016-11-28/DeclineHandshake
 func (c *Organizations) DeclineHandshakeRequest(input *DeclineHandshakeInput) (req *request.Request, output *DeclineHandshakeOutput) {
 	op := &request.Operation{
-		Name:       opDeclineHandshake,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDeclineHandshake,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Handshake.Parties[*].Id", "Handshake.Resources[*].Value"},
 	}
 
 	if input == nil {
@@ -2409,9 +2414,10 @@ const opDescribeAccount = "DescribeAccount"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/organizations-2016-11-28/DescribeAccount
 func (c *Organizations) DescribeAccountRequest(input *DescribeAccountInput) (req *request.Request, output *DescribeAccountOutput) {
 	op := &request.Operation{
-		Name:       opDescribeAccount,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeAccount,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Account.Email", "Account.Name"},
 	}
 
 	if input == nil {
@@ -2564,9 +2570,10 @@ const opDescribeCreateAccountStatus = "DescribeCreateAccountStatus"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/organizations-2016-11-28/DescribeCreateAccountStatus
 func (c *Organizations) DescribeCreateAccountStatusRequest(input *DescribeCreateAccountStatusInput) (req *request.Request, output *DescribeCreateAccountStatusOutput) {
 	op := &request.Operation{
-		Name:       opDescribeCreateAccountStatus,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeCreateAccountStatus,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"CreateAccountStatus.AccountName"},
 	}
 
 	if input == nil {
@@ -2718,9 +2725,10 @@ const opDescribeHandshake = "DescribeHandshake"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/organizations-2016-11-28/DescribeHandshake
 func (c *Organizations) DescribeHandshakeRequest(input *DescribeHandshakeInput) (req *request.Request, output *DescribeHandshakeOutput) {
 	op := &request.Operation{
-		Name:       opDescribeHandshake,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeHandshake,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Handshake.Parties[*].Id", "Handshake.Resources[*].Value"},
 	}
 
 	if input == nil {
@@ -2877,9 +2885,10 @@ const opDescribeOrganization = "DescribeOrganization"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/organizations-2016-11-28/DescribeOrganization
 func (c *Organizations) DescribeOrganizationRequest(input *DescribeOrganizationInput) (req *request.Request, output *DescribeOrganizationOutput) {
 	op := &request.Operation{
-		Name:       opDescribeOrganization,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeOrganization,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Organization.MasterAccountEmail"},
 	}
 
 	if input == nil {
@@ -3795,9 +3804,10 @@ const opEnableAllFeatures = "EnableAllFeatures"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/organizations-2016-11-28/EnableAllFeatures
 func (c *Organizations) EnableAllFeaturesRequest(input *EnableAllFeaturesInput) (req *request.Request, output *EnableAllFeaturesOutput) {
 	op := &request.Operation{
-		Name:       opEnableAllFeatures,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opEnableAllFeatures,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Handshake.Parties[*].Id", "Handshake.Resources[*].Value"},
 	}
 
 	if input == nil {
@@ -4263,9 +4273,10 @@ const opInviteAccountToOrganization = "InviteAccountToOrganization"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/organizations-2016-11-28/InviteAccountToOrganization
 func (c *Organizations) InviteAccountToOrganizationRequest(input *InviteAccountToOrganizationInput) (req *request.Request, output *InviteAccountToOrganizationOutput) {
 	op := &request.Operation{
-		Name:       opInviteAccountToOrganization,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opInviteAccountToOrganization,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Handshake.Parties[*].Id", "Handshake.Resources[*].Value"},
 	}
 
 	if input == nil {
@@ -4764,6 +4775,7 @@ func (c *Organizations) ListAccountsRequest(input *ListAccountsInput) (req *requ
 			LimitToken:      "MaxResults",
 			TruncationToken: "",
 		},
+		SensitiveOutputPaths: []string{"Accounts[*].Email", "Accounts[*].Name"},
 	}
 
 	if input == nil {
@@ -4971,6 +4983,7 @@ func (c *Organizations) ListAccountsForParentRequest(input *ListAccountsForParen
 			LimitToken:      "MaxResults",
 			TruncationToken: "",
 		},
+		SensitiveOutputPaths: []string{"Accounts[*].Email", "Accounts[*].Name"},
 	}
 
 	if input == nil {
@@ -5394,6 +5407,7 @@ func (c *Organizations) ListCreateAccountStatusRequest(input *ListCreateAccountS
 			LimitToken:      "MaxResults",
 			TruncationToken: "",
 		},
+		SensitiveOutputPaths: []string{"CreateAccountStatuses[*].AccountName"},
 	}
 
 	if input == nil {
@@ -5601,6 +5615,7 @@ func (c *Organizations) ListHandshakesForAccountRequest(input *ListHandshakesFor
 			LimitToken:      "MaxResults",
 			TruncationToken: "",
 		},
+		SensitiveOutputPaths: []string{"Handshakes[*].Parties[*].Id", "Handshakes[*].Resources[*].Value"},
 	}
 
 	if input == nil {
@@ -5812,6 +5827,7 @@ func (c *Organizations) ListHandshakesForOrganizationRequest(input *ListHandshak
 			LimitToken:      "MaxResults",
 			TruncationToken: "",
 		},
+		SensitiveOutputPaths: []string{"Handshakes[*].Parties[*].Id", "Handshakes[*].Resources[*].Value"},
 	}
 
 	if input == nil {
@@ -8144,6 +8160,21 @@ func (s *AcceptHandshakeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AcceptHandshakeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AcceptHandshakeInput"}
+	if s.HandshakeId != nil && !request.MatchParamPattern("^h-[0-9a-z]{8,32}$", *s.HandshakeId) {
+		invalidParams.Add(request.NewErrParamPattern("HandshakeId", "^h-[0-9a-z]{8,32}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHandshakeId sets the HandshakeId field's value.
 func (s *AcceptHandshakeInput) SetHandshakeId(v string) *AcceptHandshakeInput {
 	s.HandshakeId = &v
@@ -8190,7 +8221,7 @@ type Account struct {
 	//
 	// The regex pattern (http://wikipedia.org/wiki/regex) for this parameter is
 	// a string of characters that represents a standard Internet email address.
-	Email *string `min:"6" type:"string"`
+	Email *string `min:"6" type:"string" sensitive:"true"`
 
 	// The unique identifier (ID) of the account.
 	//
@@ -8209,7 +8240,7 @@ type Account struct {
 	// The regex pattern (http://wikipedia.org/wiki/regex) that is used to validate
 	// this parameter is a string of any of the characters in the ASCII character
 	// range.
-	Name *string `min:"1" type:"string"`
+	Name *string `min:"1" type:"string" sensitive:"true"`
 
 	// The status of the account in the organization.
 	Status *string `type:"string" enum:"AccountStatus"`
@@ -8327,6 +8358,24 @@ func (s *AttachPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttachPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttachPolicyInput"}
+	if s.PolicyId != nil && !request.MatchParamPattern("^p-[0-9a-zA-Z_]{8,128}$", *s.PolicyId) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyId", "^p-[0-9a-zA-Z_]{8,128}$"))
+	}
+	if s.TargetId != nil && !request.MatchParamPattern("^(r-[0-9a-z]{4,32})|(\\d{12})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$", *s.TargetId) {
+		invalidParams.Add(request.NewErrParamPattern("TargetId", "^(r-[0-9a-z]{4,32})|(\\d{12})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyId sets the PolicyId field's value.
 func (s *AttachPolicyInput) SetPolicyId(v string) *AttachPolicyInput {
 	s.PolicyId = &v
@@ -8391,6 +8440,21 @@ func (s *CancelHandshakeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CancelHandshakeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelHandshakeInput"}
+	if s.HandshakeId != nil && !request.MatchParamPattern("^h-[0-9a-z]{8,32}$", *s.HandshakeId) {
+		invalidParams.Add(request.NewErrParamPattern("HandshakeId", "^h-[0-9a-z]{8,32}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHandshakeId sets the HandshakeId field's value.
 func (s *CancelHandshakeInput) SetHandshakeId(v string) *CancelHandshakeInput {
 	s.HandshakeId = &v
@@ -8472,7 +8536,7 @@ type CreateAccountInput struct {
 	// The friendly name of the member account.
 	//
 	// AccountName is a required field
-	AccountName *string `min:"1" type:"string" required:"true"`
+	AccountName *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The email address of the owner to assign to the new member account. This
 	// email address must not already be associated with another AWS account. You
@@ -8481,7 +8545,7 @@ type CreateAccountInput struct {
 	// invalid email address.
 	//
 	// Email is a required field
-	Email *string `min:"6" type:"string" required:"true"`
+	Email *string `min:"6" type:"string" required:"true" sensitive:"true"`
 
 	// If set to ALLOW, the new account enables IAM users to access account billing
 	// information if they have the required permissions. If set to DENY, then only
@@ -8550,6 +8614,36 @@ func (s *CreateAccountInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAccountInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAccountInput"}
+	if s.AccountName != nil && len(*s.AccountName) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountName", 50))
+	}
+	if s.Email != nil && len(*s.Email) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Email", 64))
+	}
+	if s.Email != nil && !request.MatchParamPattern("[^\\s@]+@[^\\s@]+\\.[^\\s@]+", *s.Email) {
+		invalidParams.Add(request.NewErrParamPattern("Email", "[^\\s@]+@[^\\s@]+\\.[^\\s@]+"))
+	}
+	if s.IamUserAccessToBilling != nil {
+		values := []string{"ALLOW", "DENY"}
+		if !request.IsParamEnumValue(*s.IamUserAccessToBilling, values) {
+			invalidParams.Add(request.NewErrParamEnum("IamUserAccessToBilling", *s.IamUserAccessToBilling, values))
+		}
+	}
+	if s.RoleName != nil && !request.MatchParamPattern("[\\w+=,.@-]{1,64}", *s.RoleName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleName", "[\\w+=,.@-]{1,64}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountName sets the AccountName field's value.
 func (s *CreateAccountInput) SetAccountName(v string) *CreateAccountInput {
 	s.AccountName = &v
@@ -8616,7 +8710,7 @@ type CreateAccountStatus struct {
 	AccountId *string `type:"string"`
 
 	// The account name given to the account when it was created.
-	AccountName *string `min:"1" type:"string"`
+	AccountName *string `min:"1" type:"string" sensitive:"true"`
 
 	// The date and time that the account was created and the request completed.
 	CompletedTimestamp *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -8736,6 +8830,24 @@ func (s CreateOrganizationInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateOrganizationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateOrganizationInput"}
+	if s.FeatureSet != nil {
+		values := []string{"ALL", "CONSOLIDATED_BILLING"}
+		if !request.IsParamEnumValue(*s.FeatureSet, values) {
+			invalidParams.Add(request.NewErrParamEnum("FeatureSet", *s.FeatureSet, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFeatureSet sets the FeatureSet field's value.
 func (s *CreateOrganizationInput) SetFeatureSet(v string) *CreateOrganizationInput {
 	s.FeatureSet = &v
@@ -8822,6 +8934,24 @@ func (s *CreateOrganizationalUnitInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateOrganizationalUnitInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateOrganizationalUnitInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.ParentId != nil && !request.MatchParamPattern("^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$", *s.ParentId) {
+		invalidParams.Add(request.NewErrParamPattern("ParentId", "^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *CreateOrganizationalUnitInput) SetName(v string) *CreateOrganizationalUnitInput {
 	s.Name = &v
@@ -8934,6 +9064,33 @@ func (s *CreatePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePolicyInput"}
+	if s.Content != nil && len(*s.Content) > 1e+06 {
+		invalidParams.Add(request.NewErrParamMaxLen("Content", 1e+06))
+	}
+	if s.Description != nil && len(*s.Description) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 512))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Type != nil {
+		values := []string{"SERVICE_CONTROL_POLICY"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContent sets the Content field's value.
 func (s *CreatePolicyInput) SetContent(v string) *CreatePolicyInput {
 	s.Content = &v
@@ -9019,6 +9176,21 @@ func (s *DeclineHandshakeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeclineHandshakeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeclineHandshakeInput"}
+	if s.HandshakeId != nil && !request.MatchParamPattern("^h-[0-9a-z]{8,32}$", *s.HandshakeId) {
+		invalidParams.Add(request.NewErrParamPattern("HandshakeId", "^h-[0-9a-z]{8,32}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHandshakeId sets the HandshakeId field's value.
 func (s *DeclineHandshakeInput) SetHandshakeId(v string) *DeclineHandshakeInput {
 	s.HandshakeId = &v
@@ -9119,6 +9291,21 @@ func (s *DeleteOrganizationalUnitInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteOrganizationalUnitInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteOrganizationalUnitInput"}
+	if s.OrganizationalUnitId != nil && !request.MatchParamPattern("^ou-[0-9a-z]{4,32}-[a-z0-9]{8,32}$", *s.OrganizationalUnitId) {
+		invalidParams.Add(request.NewErrParamPattern("OrganizationalUnitId", "^ou-[0-9a-z]{4,32}-[a-z0-9]{8,32}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetOrganizationalUnitId sets the OrganizationalUnitId field's value.
 func (s *DeleteOrganizationalUnitInput) SetOrganizationalUnitId(v string) *DeleteOrganizationalUnitInput {
 	s.OrganizationalUnitId = &v
@@ -9177,6 +9364,21 @@ func (s *DeletePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeletePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePolicyInput"}
+	if s.PolicyId != nil && !request.MatchParamPattern("^p-[0-9a-zA-Z_]{8,128}$", *s.PolicyId) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyId", "^p-[0-9a-zA-Z_]{8,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyId sets the PolicyId field's value.
 func (s *DeletePolicyInput) SetPolicyId(v string) *DeletePolicyInput {
 	s.PolicyId = &v
@@ -9235,6 +9437,21 @@ func (s *DescribeAccountInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAccountInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAccountInput"}
+	if s.AccountId != nil && !request.MatchParamPattern("^\\d{12}$", *s.AccountId) {
+		invalidParams.Add(request.NewErrParamPattern("AccountId", "^\\d{12}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *DescribeAccountInput) SetAccountId(v string) *DescribeAccountInput {
 	s.AccountId = &v
@@ -9304,6 +9521,21 @@ func (s *DescribeCreateAccountStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeCreateAccountStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeCreateAccountStatusInput"}
+	if s.CreateAccountRequestId != nil && !request.MatchParamPattern("^car-[a-z0-9]{8,32}$", *s.CreateAccountRequestId) {
+		invalidParams.Add(request.NewErrParamPattern("CreateAccountRequestId", "^car-[a-z0-9]{8,32}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCreateAccountRequestId sets the CreateAccountRequestId field's value.
 func (s *DescribeCreateAccountStatusInput) SetCreateAccountRequestId(v string) *DescribeCreateAccountStatusInput {
 	s.CreateAccountRequestId = &v
@@ -9372,6 +9604,21 @@ func (s *DescribeHandshakeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeHandshakeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeHandshakeInput"}
+	if s.HandshakeId != nil && !request.MatchParamPattern("^h-[0-9a-z]{8,32}$", *s.HandshakeId) {
+		invalidParams.Add(request.NewErrParamPattern("HandshakeId", "^h-[0-9a-z]{8,32}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHandshakeId sets the HandshakeId field's value.
 func (s *DescribeHandshakeInput) SetHandshakeId(v string) *DescribeHandshakeInput {
 	s.HandshakeId = &v
@@ -9480,6 +9727,21 @@ func (s *DescribeOrganizationalUnitInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeOrganizationalUnitInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeOrganizationalUnitInput"}
+	if s.OrganizationalUnitId != nil && !request.MatchParamPattern("^ou-[0-9a-z]{4,32}-[a-z0-9]{8,32}$", *s.OrganizationalUnitId) {
+		invalidParams.Add(request.NewErrParamPattern("OrganizationalUnitId", "^ou-[0-9a-z]{4,32}-[a-z0-9]{8,32}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetOrganizationalUnitId sets the OrganizationalUnitId field's value.
 func (s *DescribeOrganizationalUnitInput) SetOrganizationalUnitId(v string) *DescribeOrganizationalUnitInput {
 	s.OrganizationalUnitId = &v
@@ -9547,6 +9809,21 @@ func (s *DescribePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribePolicyInput"}
+	if s.PolicyId != nil && !request.MatchParamPattern("^p-[0-9a-zA-Z_]{8,128}$", *s.PolicyId) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyId", "^p-[0-9a-zA-Z_]{8,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyId sets the PolicyId field's value.
 func (s *DescribePolicyInput) SetPolicyId(v string) *DescribePolicyInput {
 	s.PolicyId = &v
@@ -9637,6 +9914,24 @@ func (s *DetachPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetachPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetachPolicyInput"}
+	if s.PolicyId != nil && !request.MatchParamPattern("^p-[0-9a-zA-Z_]{8,128}$", *s.PolicyId) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyId", "^p-[0-9a-zA-Z_]{8,128}$"))
+	}
+	if s.TargetId != nil && !request.MatchParamPattern("^(r-[0-9a-z]{4,32})|(\\d{12})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$", *s.TargetId) {
+		invalidParams.Add(request.NewErrParamPattern("TargetId", "^(r-[0-9a-z]{4,32})|(\\d{12})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyId sets the PolicyId field's value.
 func (s *DetachPolicyInput) SetPolicyId(v string) *DetachPolicyInput {
 	s.PolicyId = &v
@@ -9709,6 +10004,27 @@ func (s *DisablePolicyTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisablePolicyTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisablePolicyTypeInput"}
+	if s.PolicyType != nil {
+		values := []string{"SERVICE_CONTROL_POLICY"}
+		if !request.IsParamEnumValue(*s.PolicyType, values) {
+			invalidParams.Add(request.NewErrParamEnum("PolicyType", *s.PolicyType, values))
+		}
+	}
+	if s.RootId != nil && !request.MatchParamPattern("^r-[0-9a-z]{4,32}$", *s.RootId) {
+		invalidParams.Add(request.NewErrParamPattern("RootId", "^r-[0-9a-z]{4,32}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyType sets the PolicyType field's value.
 func (s *DisablePolicyTypeInput) SetPolicyType(v string) *DisablePolicyTypeInput {
 	s.PolicyType = &v
@@ -9830,6 +10146,27 @@ func (s *EnablePolicyTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnablePolicyTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnablePolicyTypeInput"}
+	if s.PolicyType != nil {
+		values := []string{"SERVICE_CONTROL_POLICY"}
+		if !request.IsParamEnumValue(*s.PolicyType, values) {
+			invalidParams.Add(request.NewErrParamEnum("PolicyType", *s.PolicyType, values))
+		}
+	}
+	if s.RootId != nil && !request.MatchParamPattern("^r-[0-9a-z]{4,32}$", *s.RootId) {
+		invalidParams.Add(request.NewErrParamPattern("RootId", "^r-[0-9a-z]{4,32}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPolicyType sets the PolicyType field's value.
 func (s *EnablePolicyTypeInput) SetPolicyType(v string) *EnablePolicyTypeInput {
 	s.PolicyType = &v
@@ -10025,6 +10362,27 @@ func (s HandshakeFilter) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *HandshakeFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "HandshakeFilter"}
+	if s.ActionType != nil {
+		values := []string{"INVITE", "ENABLE_ALL_FEATURES", "APPROVE_ALL_FEATURES"}
+		if !request.IsParamEnumValue(*s.ActionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ActionType", *s.ActionType, values))
+		}
+	}
+	if s.ParentHandshakeId != nil && !request.MatchParamPattern("^h-[0-9a-z]{8,32}$", *s.ParentHandshakeId) {
+		invalidParams.Add(request.NewErrParamPattern("ParentHandshakeId", "^h-[0-9a-z]{8,32}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActionType sets the ActionType field's value.
 func (s *HandshakeFilter) SetActionType(v string) *HandshakeFilter {
 	s.ActionType = &v
@@ -10046,7 +10404,7 @@ type HandshakeParty struct {
 	//
 	// The regex pattern (http://wikipedia.org/wiki/regex) for handshake ID string
 	// requires "h-" followed by from 8 to 32 lower-case letters or digits.
-	Id *string `min:"1" type:"string"`
+	Id *string `min:"1" type:"string" sensitive:"true"`
 
 	// The type of party.
 	Type *string `type:"string" enum:"HandshakePartyType"`
@@ -10075,6 +10433,27 @@ func (s *HandshakeParty) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *HandshakeParty) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "HandshakeParty"}
+	if s.Id != nil && len(*s.Id) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 64))
+	}
+	if s.Type != nil {
+		values := []string{"ACCOUNT", "ORGANIZATION", "EMAIL"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *HandshakeParty) SetId(v string) *HandshakeParty {
 	s.Id = &v
@@ -10117,7 +10496,7 @@ type HandshakeResource struct {
 
 	// The information that is passed to the other party in the handshake. The format
 	// of the value string must match the requirements of the specified type.
-	Value *string `type:"string"`
+	Value *string `type:"string" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -10154,7 +10533,7 @@ type InviteAccountToOrganizationInput struct {
 
 	// Additional information that you want to include in the generated email to
 	// the recipient account owner.
-	Notes *string `type:"string"`
+	Notes *string `type:"string" sensitive:"true"`
 
 	// The identifier (ID) of the AWS account that you want to invite to join your
 	// organization. This is a JSON object that contains the following elements:
@@ -10204,6 +10583,26 @@ func (s *InviteAccountToOrganizationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InviteAccountToOrganizationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InviteAccountToOrganizationInput"}
+	if s.Notes != nil && len(*s.Notes) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Notes", 1024))
+	}
+	if s.Target != nil {
+		if err := s.Target.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Target", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNotes sets the Notes field's value.
 func (s *InviteAccountToOrganizationInput) SetNotes(v string) *InviteAccountToOrganizationInput {
 	s.Notes = &v
@@ -10325,6 +10724,24 @@ func (s *ListAccountsForParentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAccountsForParentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAccountsForParentInput"}
+	if s.MaxResults != nil && *s.MaxResults > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 20))
+	}
+	if s.ParentId != nil && !request.MatchParamPattern("^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$", *s.ParentId) {
+		invalidParams.Add(request.NewErrParamPattern("ParentId", "^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListAccountsForParentInput) SetMaxResults(v int64) *ListAccountsForParentInput {
 	s.MaxResults = &v
@@ -10425,6 +10842,21 @@ func (s *ListAccountsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAccountsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAccountsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListAccountsInput) SetMaxResults(v int64) *ListAccountsInput {
 	s.MaxResults = &v
@@ -10547,6 +10979,30 @@ func (s *ListChildrenInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListChildrenInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListChildrenInput"}
+	if s.ChildType != nil {
+		values := []string{"ACCOUNT", "ORGANIZATIONAL_UNIT"}
+		if !request.IsParamEnumValue(*s.ChildType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ChildType", *s.ChildType, values))
+		}
+	}
+	if s.MaxResults != nil && *s.MaxResults > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 20))
+	}
+	if s.ParentId != nil && !request.MatchParamPattern("^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$", *s.ParentId) {
+		invalidParams.Add(request.NewErrParamPattern("ParentId", "^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChildType sets the ChildType field's value.
 func (s *ListChildrenInput) SetChildType(v string) *ListChildrenInput {
 	s.ChildType = &v
@@ -10657,6 +11113,21 @@ func (s *ListCreateAccountStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListCreateAccountStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListCreateAccountStatusInput"}
+	if s.MaxResults != nil && *s.MaxResults > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListCreateAccountStatusInput) SetMaxResults(v int64) *ListCreateAccountStatusInput {
 	s.MaxResults = &v
@@ -10767,6 +11238,26 @@ func (s *ListHandshakesForAccountInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListHandshakesForAccountInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListHandshakesForAccountInput"}
+	if s.MaxResults != nil && *s.MaxResults > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 20))
+	}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilter sets the Filter field's value.
 func (s *ListHandshakesForAccountInput) SetFilter(v *HandshakeFilter) *ListHandshakesForAccountInput {
 	s.Filter = v
@@ -10876,6 +11367,26 @@ func (s *ListHandshakesForOrganizationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListHandshakesForOrganizationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListHandshakesForOrganizationInput"}
+	if s.MaxResults != nil && *s.MaxResults > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 20))
+	}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilter sets the Filter field's value.
 func (s *ListHandshakesForOrganizationInput) SetFilter(v *HandshakeFilter) *ListHandshakesForOrganizationInput {
 	s.Filter = v
@@ -10997,6 +11508,24 @@ func (s *ListOrganizationalUnitsForParentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListOrganizationalUnitsForParentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListOrganizationalUnitsForParentInput"}
+	if s.MaxResults != nil && *s.MaxResults > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 20))
+	}
+	if s.ParentId != nil && !request.MatchParamPattern("^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$", *s.ParentId) {
+		invalidParams.Add(request.NewErrParamPattern("ParentId", "^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListOrganizationalUnitsForParentInput) SetMaxResults(v int64) *ListOrganizationalUnitsForParentInput {
 	s.MaxResults = &v
@@ -11116,6 +11645,24 @@ func (s *ListParentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListParentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListParentsInput"}
+	if s.ChildId != nil && !request.MatchParamPattern("^(\\d{12})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$", *s.ChildId) {
+		invalidParams.Add(request.NewErrParamPattern("ChildId", "^(\\d{12})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChildId sets the ChildId field's value.
 func (s *ListParentsInput) SetChildId(v string) *ListParentsInput {
 	s.ChildId = &v
@@ -11246,6 +11793,30 @@ func (s *ListPoliciesForTargetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPoliciesForTargetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPoliciesForTargetInput"}
+	if s.Filter != nil {
+		values := []string{"SERVICE_CONTROL_POLICY"}
+		if !request.IsParamEnumValue(*s.Filter, values) {
+			invalidParams.Add(request.NewErrParamEnum("Filter", *s.Filter, values))
+		}
+	}
+	if s.MaxResults != nil && *s.MaxResults > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 20))
+	}
+	if s.TargetId != nil && !request.MatchParamPattern("^(r-[0-9a-z]{4,32})|(\\d{12})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$", *s.TargetId) {
+		invalidParams.Add(request.NewErrParamPattern("TargetId", "^(r-[0-9a-z]{4,32})|(\\d{12})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilter sets the Filter field's value.
 func (s *ListPoliciesForTargetInput) SetFilter(v string) *ListPoliciesForTargetInput {
 	s.Filter = &v
@@ -11360,6 +11931,27 @@ func (s *ListPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPoliciesInput"}
+	if s.Filter != nil {
+		values := []string{"SERVICE_CONTROL_POLICY"}
+		if !request.IsParamEnumValue(*s.Filter, values) {
+			invalidParams.Add(request.NewErrParamEnum("Filter", *s.Filter, values))
+		}
+	}
+	if s.MaxResults != nil && *s.MaxResults > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilter sets the Filter field's value.
 func (s *ListPoliciesInput) SetFilter(v string) *ListPoliciesInput {
 	s.Filter = &v
@@ -11462,6 +12054,21 @@ func (s *ListRootsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListRootsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRootsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListRootsInput) SetMaxResults(v int64) *ListRootsInput {
 	s.MaxResults = &v
@@ -11567,6 +12174,24 @@ func (s *ListTargetsForPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTargetsForPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTargetsForPolicyInput"}
+	if s.MaxResults != nil && *s.MaxResults > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 20))
+	}
+	if s.PolicyId != nil && !request.MatchParamPattern("^p-[0-9a-zA-Z_]{8,128}$", *s.PolicyId) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyId", "^p-[0-9a-zA-Z_]{8,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListTargetsForPolicyInput) SetMaxResults(v int64) *ListTargetsForPolicyInput {
 	s.MaxResults = &v
@@ -11699,6 +12324,27 @@ func (s *MoveAccountInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MoveAccountInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MoveAccountInput"}
+	if s.AccountId != nil && !request.MatchParamPattern("^\\d{12}$", *s.AccountId) {
+		invalidParams.Add(request.NewErrParamPattern("AccountId", "^\\d{12}$"))
+	}
+	if s.DestinationParentId != nil && !request.MatchParamPattern("^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$", *s.DestinationParentId) {
+		invalidParams.Add(request.NewErrParamPattern("DestinationParentId", "^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$"))
+	}
+	if s.SourceParentId != nil && !request.MatchParamPattern("^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$", *s.SourceParentId) {
+		invalidParams.Add(request.NewErrParamPattern("SourceParentId", "^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *MoveAccountInput) SetAccountId(v string) *MoveAccountInput {
 	s.AccountId = &v
@@ -11776,7 +12422,7 @@ type Organization struct {
 
 	// The email address that is associated with the AWS account that is designated
 	// as the master account for the organization.
-	MasterAccountEmail *string `min:"6" type:"string"`
+	MasterAccountEmail *string `min:"6" type:"string" sensitive:"true"`
 
 	// The unique identifier (ID) of the master account of an organization.
 	//
@@ -12209,6 +12855,21 @@ func (s *RemoveAccountFromOrganizationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveAccountFromOrganizationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveAccountFromOrganizationInput"}
+	if s.AccountId != nil && !request.MatchParamPattern("^\\d{12}$", *s.AccountId) {
+		invalidParams.Add(request.NewErrParamPattern("AccountId", "^\\d{12}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *RemoveAccountFromOrganizationInput) SetAccountId(v string) *RemoveAccountFromOrganizationInput {
 	s.AccountId = &v
@@ -12347,6 +13008,24 @@ func (s *UpdateOrganizationalUnitInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateOrganizationalUnitInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateOrganizationalUnitInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.OrganizationalUnitId != nil && !request.MatchParamPattern("^ou-[0-9a-z]{4,32}-[a-z0-9]{8,32}$", *s.OrganizationalUnitId) {
+		invalidParams.Add(request.NewErrParamPattern("OrganizationalUnitId", "^ou-[0-9a-z]{4,32}-[a-z0-9]{8,32}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *UpdateOrganizationalUnitInput) SetName(v string) *UpdateOrganizationalUnitInput {
 	s.Name = &v
@@ -12442,6 +13121,30 @@ func (s *UpdatePolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdatePolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdatePolicyInput"}
+	if s.Content != nil && len(*s.Content) > 1e+06 {
+		invalidParams.Add(request.NewErrParamMaxLen("Content", 1e+06))
+	}
+	if s.Description != nil && len(*s.Description) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 512))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.PolicyId != nil && !request.MatchParamPattern("^p-[0-9a-zA-Z_]{8,128}$", *s.PolicyId) {
+		invalidParams.Add(request.NewErrParamPattern("PolicyId", "^p-[0-9a-zA-Z_]{8,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContent sets the Content field's value.
 func (s *UpdatePolicyInput) SetContent(v string) *UpdatePolicyInput {
 	s.Content = &v
@@ -3,6 +3,8 @@
 package pinpoint
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -4284,6 +4286,24 @@ func (s APNSMessage) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *APNSMessage) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "APNSMessage"}
+	if s.Action != nil {
+		values := []string{"OPEN_APP", "DEEP_LINK", "URL"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *APNSMessage) SetAction(v string) *APNSMessage {
 	s.Action = &v
@@ -4929,6 +4949,24 @@ func (s AddressConfiguration) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddressConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddressConfiguration"}
+	if s.ChannelType != nil {
+		values := []string{"GCM", "APNS", "APNS_SANDBOX", "ADM", "SMS", "EMAIL"}
+		if !request.IsParamEnumValue(*s.ChannelType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ChannelType", *s.ChannelType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBodyOverride sets the BodyOverride field's value.
 func (s *AddressConfiguration) SetBodyOverride(v string) *AddressConfiguration {
 	s.BodyOverride = &v
@@ -5224,6 +5262,24 @@ func (s AttributeDimension) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AttributeDimension) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AttributeDimension"}
+	if s.AttributeType != nil {
+		values := []string{"INCLUSIVE", "EXCLUSIVE"}
+		if !request.IsParamEnumValue(*s.AttributeType, values) {
+			invalidParams.Add(request.NewErrParamEnum("AttributeType", *s.AttributeType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributeType sets the AttributeType field's value.
 func (s *AttributeDimension) SetAttributeType(v string) *AttributeDimension {
 	s.AttributeType = &v
@@ -5711,6 +5767,24 @@ func (s CampaignSmsMessage) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CampaignSmsMessage) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CampaignSmsMessage"}
+	if s.MessageType != nil {
+		values := []string{"TRANSACTIONAL", "PROMOTIONAL"}
+		if !request.IsParamEnumValue(*s.MessageType, values) {
+			invalidParams.Add(request.NewErrParamEnum("MessageType", *s.MessageType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBody sets the Body field's value.
 func (s *CampaignSmsMessage) SetBody(v string) *CampaignSmsMessage {
 	s.Body = &v
@@ -5995,6 +6069,23 @@ func (s *CreateCampaignInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateCampaignInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateCampaignInput"}
+	if s.WriteCampaignRequest != nil {
+		if err := s.WriteCampaignRequest.ValidateExtended(); err != nil {
+			invalidParams.AddNested("WriteCampaignRequest", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationId sets the ApplicationId field's value.
 func (s *CreateCampaignInput) SetApplicationId(v string) *CreateCampaignInput {
 	s.ApplicationId = &v
@@ -6095,6 +6186,23 @@ func (s *CreateImportJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateImportJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateImportJobInput"}
+	if s.ImportJobRequest != nil {
+		if err := s.ImportJobRequest.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ImportJobRequest", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationId sets the ApplicationId field's value.
 func (s *CreateImportJobInput) SetApplicationId(v string) *CreateImportJobInput {
 	s.ApplicationId = &v
@@ -6195,6 +6303,23 @@ func (s *CreateSegmentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateSegmentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateSegmentInput"}
+	if s.WriteSegmentRequest != nil {
+		if err := s.WriteSegmentRequest.ValidateExtended(); err != nil {
+			invalidParams.AddNested("WriteSegmentRequest", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationId sets the ApplicationId field's value.
 func (s *CreateSegmentInput) SetApplicationId(v string) *CreateSegmentInput {
 	s.ApplicationId = &v
@@ -6354,6 +6479,24 @@ func (s DefaultPushNotificationMessage) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DefaultPushNotificationMessage) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DefaultPushNotificationMessage"}
+	if s.Action != nil {
+		values := []string{"OPEN_APP", "DEEP_LINK", "URL"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *DefaultPushNotificationMessage) SetAction(v string) *DefaultPushNotificationMessage {
 	s.Action = &v
@@ -7255,6 +7398,38 @@ func (s DirectMessageConfiguration) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DirectMessageConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DirectMessageConfiguration"}
+	if s.APNSMessage != nil {
+		if err := s.APNSMessage.ValidateExtended(); err != nil {
+			invalidParams.AddNested("APNSMessage", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DefaultPushNotificationMessage != nil {
+		if err := s.DefaultPushNotificationMessage.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DefaultPushNotificationMessage", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.GCMMessage != nil {
+		if err := s.GCMMessage.ValidateExtended(); err != nil {
+			invalidParams.AddNested("GCMMessage", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.SMSMessage != nil {
+		if err := s.SMSMessage.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SMSMessage", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAPNSMessage sets the APNSMessage field's value.
 func (s *DirectMessageConfiguration) SetAPNSMessage(v *APNSMessage) *DirectMessageConfiguration {
 	s.APNSMessage = v
@@ -7637,6 +7812,24 @@ func (s EndpointBatchItem) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EndpointBatchItem) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EndpointBatchItem"}
+	if s.ChannelType != nil {
+		values := []string{"GCM", "APNS", "APNS_SANDBOX", "ADM", "SMS", "EMAIL"}
+		if !request.IsParamEnumValue(*s.ChannelType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ChannelType", *s.ChannelType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddress sets the Address field's value.
 func (s *EndpointBatchItem) SetAddress(v string) *EndpointBatchItem {
 	s.Address = &v
@@ -7806,6 +7999,28 @@ func (s EndpointBatchRequest) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EndpointBatchRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EndpointBatchRequest"}
+	if s.Item != nil {
+		for i, v := range s.Item {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Item", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetItem sets the Item field's value.
 func (s *EndpointBatchRequest) SetItem(v []*EndpointBatchItem) *EndpointBatchRequest {
 	s.Item = v
@@ -8115,6 +8330,24 @@ func (s EndpointRequest) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EndpointRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EndpointRequest"}
+	if s.ChannelType != nil {
+		values := []string{"GCM", "APNS", "APNS_SANDBOX", "ADM", "SMS", "EMAIL"}
+		if !request.IsParamEnumValue(*s.ChannelType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ChannelType", *s.ChannelType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddress sets the Address field's value.
 func (s *EndpointRequest) SetAddress(v string) *EndpointRequest {
 	s.Address = &v
@@ -8951,6 +9184,24 @@ func (s GCMMessage) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GCMMessage) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GCMMessage"}
+	if s.Action != nil {
+		values := []string{"OPEN_APP", "DEEP_LINK", "URL"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *GCMMessage) SetAction(v string) *GCMMessage {
 	s.Action = &v
@@ -11385,6 +11636,24 @@ func (s ImportJobRequest) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ImportJobRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ImportJobRequest"}
+	if s.Format != nil {
+		values := []string{"CSV", "JSON"}
+		if !request.IsParamEnumValue(*s.Format, values) {
+			invalidParams.Add(request.NewErrParamEnum("Format", *s.Format, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefineSegment sets the DefineSegment field's value.
 func (s *ImportJobRequest) SetDefineSegment(v bool) *ImportJobRequest {
 	s.DefineSegment = &v
@@ -11941,6 +12210,24 @@ func (s Message) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Message) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Message"}
+	if s.Action != nil {
+		values := []string{"OPEN_APP", "DEEP_LINK", "URL"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *Message) SetAction(v string) *Message {
 	s.Action = &v
@@ -12149,6 +12436,38 @@ func (s MessageConfiguration) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MessageConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MessageConfiguration"}
+	if s.APNSMessage != nil {
+		if err := s.APNSMessage.ValidateExtended(); err != nil {
+			invalidParams.AddNested("APNSMessage", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DefaultMessage != nil {
+		if err := s.DefaultMessage.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DefaultMessage", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.GCMMessage != nil {
+		if err := s.GCMMessage.ValidateExtended(); err != nil {
+			invalidParams.AddNested("GCMMessage", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.SMSMessage != nil {
+		if err := s.SMSMessage.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SMSMessage", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAPNSMessage sets the APNSMessage field's value.
 func (s *MessageConfiguration) SetAPNSMessage(v *Message) *MessageConfiguration {
 	s.APNSMessage = v
@@ -12234,6 +12553,33 @@ func (s MessageRequest) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MessageRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MessageRequest"}
+	if s.Addresses != nil {
+		for i, v := range s.Addresses {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Addresses", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.MessageConfiguration != nil {
+		if err := s.MessageConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("MessageConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddresses sets the Addresses field's value.
 func (s *MessageRequest) SetAddresses(v map[string]*AddressConfiguration) *MessageRequest {
 	s.Addresses = v
@@ -12600,6 +12946,30 @@ func (s RecencyDimension) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RecencyDimension) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RecencyDimension"}
+	if s.Duration != nil {
+		values := []string{"HR_24", "DAY_7", "DAY_14", "DAY_30"}
+		if !request.IsParamEnumValue(*s.Duration, values) {
+			invalidParams.Add(request.NewErrParamEnum("Duration", *s.Duration, values))
+		}
+	}
+	if s.RecencyType != nil {
+		values := []string{"ACTIVE", "INACTIVE"}
+		if !request.IsParamEnumValue(*s.RecencyType, values) {
+			invalidParams.Add(request.NewErrParamEnum("RecencyType", *s.RecencyType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDuration sets the Duration field's value.
 func (s *RecencyDimension) SetDuration(v string) *RecencyDimension {
 	s.Duration = &v
@@ -12878,6 +13248,24 @@ func (s SMSMessage) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SMSMessage) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SMSMessage"}
+	if s.MessageType != nil {
+		values := []string{"TRANSACTIONAL", "PROMOTIONAL"}
+		if !request.IsParamEnumValue(*s.MessageType, values) {
+			invalidParams.Add(request.NewErrParamEnum("MessageType", *s.MessageType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBody sets the Body field's value.
 func (s *SMSMessage) SetBody(v string) *SMSMessage {
 	s.Body = &v
@@ -12969,6 +13357,24 @@ func (s Schedule) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Schedule) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Schedule"}
+	if s.Frequency != nil {
+		values := []string{"ONCE", "HOURLY", "DAILY", "WEEKLY", "MONTHLY"}
+		if !request.IsParamEnumValue(*s.Frequency, values) {
+			invalidParams.Add(request.NewErrParamEnum("Frequency", *s.Frequency, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEndTime sets the EndTime field's value.
 func (s *Schedule) SetEndTime(v string) *Schedule {
 	s.EndTime = &v
@@ -13059,6 +13465,23 @@ func (s SegmentBehaviors) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SegmentBehaviors) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SegmentBehaviors"}
+	if s.Recency != nil {
+		if err := s.Recency.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Recency", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRecency sets the Recency field's value.
 func (s *SegmentBehaviors) SetRecency(v *RecencyDimension) *SegmentBehaviors {
 	s.Recency = v
@@ -13109,6 +13532,48 @@ func (s SegmentDemographics) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SegmentDemographics) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SegmentDemographics"}
+	if s.AppVersion != nil {
+		if err := s.AppVersion.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AppVersion", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Channel != nil {
+		if err := s.Channel.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Channel", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.DeviceType != nil {
+		if err := s.DeviceType.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DeviceType", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Make != nil {
+		if err := s.Make.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Make", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Model != nil {
+		if err := s.Model.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Model", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Platform != nil {
+		if err := s.Platform.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Platform", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAppVersion sets the AppVersion field's value.
 func (s *SegmentDemographics) SetAppVersion(v *SetDimension) *SegmentDemographics {
 	s.AppVersion = v
@@ -13211,6 +13676,53 @@ func (s SegmentDimensions) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SegmentDimensions) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SegmentDimensions"}
+	if s.Attributes != nil {
+		for i, v := range s.Attributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Attributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Behavior != nil {
+		if err := s.Behavior.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Behavior", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Demographic != nil {
+		if err := s.Demographic.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Demographic", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Location != nil {
+		if err := s.Location.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Location", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.UserAttributes != nil {
+		for i, v := range s.UserAttributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "UserAttributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *SegmentDimensions) SetAttributes(v map[string]*AttributeDimension) *SegmentDimensions {
 	s.Attributes = v
@@ -13398,6 +13910,23 @@ func (s SegmentLocation) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SegmentLocation) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SegmentLocation"}
+	if s.Country != nil {
+		if err := s.Country.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Country", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCountry sets the Country field's value.
 func (s *SegmentLocation) SetCountry(v *SetDimension) *SegmentLocation {
 	s.Country = v
@@ -13664,6 +14193,23 @@ func (s *SendMessagesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SendMessagesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SendMessagesInput"}
+	if s.MessageRequest != nil {
+		if err := s.MessageRequest.ValidateExtended(); err != nil {
+			invalidParams.AddNested("MessageRequest", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationId sets the ApplicationId field's value.
 func (s *SendMessagesInput) SetApplicationId(v string) *SendMessagesInput {
 	s.ApplicationId = &v
@@ -13750,6 +14296,24 @@ func (s SetDimension) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetDimension) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetDimension"}
+	if s.DimensionType != nil {
+		values := []string{"INCLUSIVE", "EXCLUSIVE"}
+		if !request.IsParamEnumValue(*s.DimensionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DimensionType", *s.DimensionType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDimensionType sets the DimensionType field's value.
 func (s *SetDimension) SetDimensionType(v string) *SetDimension {
 	s.DimensionType = &v
@@ -14255,6 +14819,23 @@ func (s *UpdateCampaignInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateCampaignInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateCampaignInput"}
+	if s.WriteCampaignRequest != nil {
+		if err := s.WriteCampaignRequest.ValidateExtended(); err != nil {
+			invalidParams.AddNested("WriteCampaignRequest", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationId sets the ApplicationId field's value.
 func (s *UpdateCampaignInput) SetApplicationId(v string) *UpdateCampaignInput {
 	s.ApplicationId = &v
@@ -14476,6 +15057,23 @@ func (s *UpdateEndpointInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateEndpointInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateEndpointInput"}
+	if s.EndpointRequest != nil {
+		if err := s.EndpointRequest.ValidateExtended(); err != nil {
+			invalidParams.AddNested("EndpointRequest", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationId sets the ApplicationId field's value.
 func (s *UpdateEndpointInput) SetApplicationId(v string) *UpdateEndpointInput {
 	s.ApplicationId = &v
@@ -14589,6 +15187,23 @@ func (s *UpdateEndpointsBatchInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateEndpointsBatchInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateEndpointsBatchInput"}
+	if s.EndpointBatchRequest != nil {
+		if err := s.EndpointBatchRequest.ValidateExtended(); err != nil {
+			invalidParams.AddNested("EndpointBatchRequest", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationId sets the ApplicationId field's value.
 func (s *UpdateEndpointsBatchInput) SetApplicationId(v string) *UpdateEndpointsBatchInput {
 	s.ApplicationId = &v
@@ -14799,6 +15414,23 @@ func (s *UpdateSegmentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateSegmentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateSegmentInput"}
+	if s.WriteSegmentRequest != nil {
+		if err := s.WriteSegmentRequest.ValidateExtended(); err != nil {
+			invalidParams.AddNested("WriteSegmentRequest", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApplicationId sets the ApplicationId field's value.
 func (s *UpdateSegmentInput) SetApplicationId(v string) *UpdateSegmentInput {
 	s.ApplicationId = &v
@@ -15082,6 +15714,38 @@ func (s WriteCampaignRequest) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *WriteCampaignRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "WriteCampaignRequest"}
+	if s.AdditionalTreatments != nil {
+		for i, v := range s.AdditionalTreatments {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "AdditionalTreatments", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.MessageConfiguration != nil {
+		if err := s.MessageConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("MessageConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Schedule != nil {
+		if err := s.Schedule.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Schedule", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdditionalTreatments sets the AdditionalTreatments field's value.
 func (s *WriteCampaignRequest) SetAdditionalTreatments(v []*WriteTreatmentResource) *WriteCampaignRequest {
 	s.AdditionalTreatments = v
@@ -15293,6 +15957,23 @@ func (s WriteSegmentRequest) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *WriteSegmentRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "WriteSegmentRequest"}
+	if s.Dimensions != nil {
+		if err := s.Dimensions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Dimensions", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDimensions sets the Dimensions field's value.
 func (s *WriteSegmentRequest) SetDimensions(v *SegmentDimensions) *WriteSegmentRequest {
 	s.Dimensions = v
@@ -15351,6 +16032,28 @@ func (s WriteTreatmentResource) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *WriteTreatmentResource) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "WriteTreatmentResource"}
+	if s.MessageConfiguration != nil {
+		if err := s.MessageConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("MessageConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Schedule != nil {
+		if err := s.Schedule.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Schedule", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMessageConfiguration sets the MessageConfiguration field's value.
 func (s *WriteTreatmentResource) SetMessageConfiguration(v *MessageConfiguration) *WriteTreatmentResource {
 	s.MessageConfiguration = v
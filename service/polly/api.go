@@ -230,9 +230,10 @@ const opGetLexicon = "GetLexicon"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/polly-2016-06-10/GetLexicon
 func (c *Polly) GetLexiconRequest(input *GetLexiconInput) (req *request.Request, output *GetLexiconOutput) {
 	op := &request.Operation{
-		Name:       opGetLexicon,
-		HTTPMethod: "GET",
-		HTTPPath:   "/v1/lexicons/{LexiconName}",
+		Name:                 opGetLexicon,
+		HTTPMethod:           "GET",
+		HTTPPath:             "/v1/lexicons/{LexiconName}",
+		SensitiveOutputPaths: []string{"Lexicon.Name"},
 	}
 
 	if input == nil {
@@ -318,9 +319,10 @@ const opListLexicons = "ListLexicons"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/polly-2016-06-10/ListLexicons
 func (c *Polly) ListLexiconsRequest(input *ListLexiconsInput) (req *request.Request, output *ListLexiconsOutput) {
 	op := &request.Operation{
-		Name:       opListLexicons,
-		HTTPMethod: "GET",
-		HTTPPath:   "/v1/lexicons",
+		Name:                 opListLexicons,
+		HTTPMethod:           "GET",
+		HTTPPath:             "/v1/lexicons",
+		SensitiveOutputPaths: []string{"Lexicons[*].Name"},
 	}
 
 	if input == nil {
@@ -596,7 +598,7 @@ type DeleteLexiconInput struct {
 	// The name of the lexicon to delete. Must be an existing lexicon in the region.
 	//
 	// Name is a required field
-	Name *string `location:"uri" locationName:"LexiconName" type:"string" required:"true"`
+	Name *string `location:"uri" locationName:"LexiconName" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -622,6 +624,21 @@ func (s *DeleteLexiconInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteLexiconInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteLexiconInput"}
+	if s.Name != nil && !request.MatchParamPattern("[0-9A-Za-z]{1,20}", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[0-9A-Za-z]{1,20}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteLexiconInput) SetName(v string) *DeleteLexiconInput {
 	s.Name = &v
@@ -633,7 +650,7 @@ func (s *DeleteLexiconInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.Name != nil {
 		v := *s.Name
 
-		e.SetValue(protocol.PathTarget, "LexiconName", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.PathTarget, "LexiconName", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 
 	return nil
@@ -684,6 +701,24 @@ func (s DescribeVoicesInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeVoicesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeVoicesInput"}
+	if s.LanguageCode != nil {
+		values := []string{"cy-GB", "da-DK", "de-DE", "en-AU", "en-GB", "en-GB-WLS", "en-IN", "en-US", "es-ES", "es-US", "fr-CA", "fr-FR", "is-IS", "it-IT", "ja-JP", "nb-NO", "nl-NL", "pl-PL", "pt-BR", "pt-PT", "ro-RO", "ru-RU", "sv-SE", "tr-TR"}
+		if !request.IsParamEnumValue(*s.LanguageCode, values) {
+			invalidParams.Add(request.NewErrParamEnum("LanguageCode", *s.LanguageCode, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLanguageCode sets the LanguageCode field's value.
 func (s *DescribeVoicesInput) SetLanguageCode(v string) *DescribeVoicesInput {
 	s.LanguageCode = &v
@@ -769,7 +804,7 @@ type GetLexiconInput struct {
 	// Name of the lexicon.
 	//
 	// Name is a required field
-	Name *string `location:"uri" locationName:"LexiconName" type:"string" required:"true"`
+	Name *string `location:"uri" locationName:"LexiconName" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -795,6 +830,21 @@ func (s *GetLexiconInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetLexiconInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetLexiconInput"}
+	if s.Name != nil && !request.MatchParamPattern("[0-9A-Za-z]{1,20}", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[0-9A-Za-z]{1,20}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *GetLexiconInput) SetName(v string) *GetLexiconInput {
 	s.Name = &v
@@ -806,7 +856,7 @@ func (s *GetLexiconInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.Name != nil {
 		v := *s.Name
 
-		e.SetValue(protocol.PathTarget, "LexiconName", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.PathTarget, "LexiconName", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 
 	return nil
@@ -874,7 +924,7 @@ type Lexicon struct {
 	Content *string `type:"string"`
 
 	// Name of the lexicon.
-	Name *string `type:"string"`
+	Name *string `type:"string" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -909,7 +959,7 @@ func (s *Lexicon) MarshalFields(e protocol.FieldEncoder) error {
 	if s.Name != nil {
 		v := *s.Name
 
-		e.SetValue(protocol.BodyTarget, "Name", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "Name", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 
 	return nil
@@ -1033,7 +1083,7 @@ type LexiconDescription struct {
 	Attributes *LexiconAttributes `type:"structure"`
 
 	// Name of the lexicon.
-	Name *string `type:"string"`
+	Name *string `type:"string" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -1068,7 +1118,7 @@ func (s *LexiconDescription) MarshalFields(e protocol.FieldEncoder) error {
 	if s.Name != nil {
 		v := *s.Name
 
-		e.SetValue(protocol.BodyTarget, "Name", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "Name", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 
 	return nil
@@ -1182,7 +1232,7 @@ type PutLexiconInput struct {
 	// long.
 	//
 	// Name is a required field
-	Name *string `location:"uri" locationName:"LexiconName" type:"string" required:"true"`
+	Name *string `location:"uri" locationName:"LexiconName" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -1211,6 +1261,21 @@ func (s *PutLexiconInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutLexiconInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutLexiconInput"}
+	if s.Name != nil && !request.MatchParamPattern("[0-9A-Za-z]{1,20}", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[0-9A-Za-z]{1,20}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContent sets the Content field's value.
 func (s *PutLexiconInput) SetContent(v string) *PutLexiconInput {
 	s.Content = &v
@@ -1233,7 +1298,7 @@ func (s *PutLexiconInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.Name != nil {
 		v := *s.Name
 
-		e.SetValue(protocol.PathTarget, "LexiconName", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.PathTarget, "LexiconName", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 
 	return nil
@@ -1268,7 +1333,7 @@ type SynthesizeSpeechInput struct {
 	// during synthesis. Lexicons are applied only if the language of the lexicon
 	// is the same as the language of the voice. For information about storing lexicons,
 	// see PutLexicon (http://docs.aws.amazon.com/polly/latest/dg/API_PutLexicon.html).
-	LexiconNames []*string `type:"list"`
+	LexiconNames []*string `type:"list" sensitive:"true"`
 
 	// The format in which the returned output will be encoded. For audio stream,
 	// this will be mp3, ogg_vorbis, or pcm. For speech marks, this will be json.
@@ -1334,6 +1399,42 @@ func (s *SynthesizeSpeechInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SynthesizeSpeechInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SynthesizeSpeechInput"}
+	if s.LexiconNames != nil && len(s.LexiconNames) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("LexiconNames", 5))
+	}
+	if s.OutputFormat != nil {
+		values := []string{"json", "mp3", "ogg_vorbis", "pcm"}
+		if !request.IsParamEnumValue(*s.OutputFormat, values) {
+			invalidParams.Add(request.NewErrParamEnum("OutputFormat", *s.OutputFormat, values))
+		}
+	}
+	if s.SpeechMarkTypes != nil && len(s.SpeechMarkTypes) > 4 {
+		invalidParams.Add(request.NewErrParamMaxLen("SpeechMarkTypes", 4))
+	}
+	if s.TextType != nil {
+		values := []string{"ssml", "text"}
+		if !request.IsParamEnumValue(*s.TextType, values) {
+			invalidParams.Add(request.NewErrParamEnum("TextType", *s.TextType, values))
+		}
+	}
+	if s.VoiceId != nil {
+		values := []string{"Geraint", "Gwyneth", "Mads", "Naja", "Hans", "Marlene", "Nicole", "Russell", "Amy", "Brian", "Emma", "Raveena", "Ivy", "Joanna", "Joey", "Justin", "Kendra", "Kimberly", "Salli", "Conchita", "Enrique", "Miguel", "Penelope", "Chantal", "Celine", "Mathieu", "Dora", "Karl", "Carla", "Giorgio", "Mizuki", "Liv", "Lotte", "Ruben", "Ewa", "Jacek", "Jan", "Maja", "Ricardo", "Vitoria", "Cristiano", "Ines", "Carmen", "Maxim", "Tatyana", "Astrid", "Filiz", "Vicki"}
+		if !request.IsParamEnumValue(*s.VoiceId, values) {
+			invalidParams.Add(request.NewErrParamEnum("VoiceId", *s.VoiceId, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLexiconNames sets the LexiconNames field's value.
 func (s *SynthesizeSpeechInput) SetLexiconNames(v []*string) *SynthesizeSpeechInput {
 	s.LexiconNames = v
@@ -1381,7 +1482,7 @@ func (s *SynthesizeSpeechInput) MarshalFields(e protocol.FieldEncoder) error {
 	if len(s.LexiconNames) > 0 {
 		v := s.LexiconNames
 
-		e.SetList(protocol.BodyTarget, "LexiconNames", protocol.EncodeStringList(v), protocol.Metadata{})
+		e.SetList(protocol.BodyTarget, "LexiconNames", protocol.EncodeStringList(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.OutputFormat != nil {
 		v := *s.OutputFormat
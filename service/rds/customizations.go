@@ -101,6 +101,8 @@ func presignURL(r *request.Request, sourceRegion *string, newParams interface{})
 		func(opt *endpoints.Options) {
 			opt.DisableSSL = aws.BoolValue(cfg.DisableSSL)
 			opt.UseDualStack = aws.BoolValue(cfg.UseDualStack)
+			opt.UseDualStackEndpoint = aws.BoolValue(cfg.UseDualStackEndpoint)
+			opt.UseFIPSEndpoint = aws.BoolValue(cfg.UseFIPSEndpoint)
 		},
 	)
 	if err != nil {
@@ -3,6 +3,7 @@
 package redshift
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -5418,9 +5419,10 @@ const opGetClusterCredentials = "GetClusterCredentials"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/redshift-2012-12-01/GetClusterCredentials
 func (c *Redshift) GetClusterCredentialsRequest(input *GetClusterCredentialsInput) (req *request.Request, output *GetClusterCredentialsOutput) {
 	op := &request.Operation{
-		Name:       opGetClusterCredentials,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opGetClusterCredentials,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"*/GetClusterCredentialsResult/DbPassword"},
 	}
 
 	if input == nil {
@@ -11607,6 +11609,24 @@ func (s DescribeEventsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEventsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEventsInput"}
+	if s.SourceType != nil {
+		values := []string{"cluster", "cluster-parameter-group", "cluster-security-group", "cluster-snapshot"}
+		if !request.IsParamEnumValue(*s.SourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("SourceType", *s.SourceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDuration sets the Duration field's value.
 func (s *DescribeEventsInput) SetDuration(v int64) *DescribeEventsInput {
 	s.Duration = &v
@@ -13687,7 +13707,7 @@ type GetClusterCredentialsOutput struct {
 
 	// A temporary password that authorizes the user name returned by DbUser to
 	// log on to the database DbName.
-	DbPassword *string `type:"string"`
+	DbPassword *string `type:"string" sensitive:"true"`
 
 	// A database user name that is authorized to log on to the database DbName
 	// using the password DbPassword. If the DbGroups parameter is specifed, DbUser
@@ -14489,6 +14509,28 @@ func (s *ModifyClusterParameterGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyClusterParameterGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyClusterParameterGroupInput"}
+	if s.Parameters != nil {
+		for i, v := range s.Parameters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Parameters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetParameterGroupName sets the ParameterGroupName field's value.
 func (s *ModifyClusterParameterGroupInput) SetParameterGroupName(v string) *ModifyClusterParameterGroupInput {
 	s.ParameterGroupName = &v
@@ -14918,6 +14960,24 @@ func (s Parameter) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Parameter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Parameter"}
+	if s.ApplyType != nil {
+		values := []string{"static", "dynamic"}
+		if !request.IsParamEnumValue(*s.ApplyType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ApplyType", *s.ApplyType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllowedValues sets the AllowedValues field's value.
 func (s *Parameter) SetAllowedValues(v string) *Parameter {
 	s.AllowedValues = &v
@@ -15527,6 +15587,28 @@ func (s *ResetClusterParameterGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResetClusterParameterGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResetClusterParameterGroupInput"}
+	if s.Parameters != nil {
+		for i, v := range s.Parameters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Parameters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetParameterGroupName sets the ParameterGroupName field's value.
 func (s *ResetClusterParameterGroupInput) SetParameterGroupName(v string) *ResetClusterParameterGroupInput {
 	s.ParameterGroupName = &v
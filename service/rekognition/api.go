@@ -1992,6 +1992,31 @@ func (s *CompareFacesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CompareFacesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CompareFacesInput"}
+	if s.SimilarityThreshold != nil && *s.SimilarityThreshold > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("SimilarityThreshold", 100))
+	}
+	if s.SourceImage != nil {
+		if err := s.SourceImage.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SourceImage", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TargetImage != nil {
+		if err := s.TargetImage.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TargetImage", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSimilarityThreshold sets the SimilarityThreshold field's value.
 func (s *CompareFacesInput) SetSimilarityThreshold(v float64) *CompareFacesInput {
 	s.SimilarityThreshold = &v
@@ -2263,6 +2288,24 @@ func (s *CreateCollectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateCollectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateCollectionInput"}
+	if s.CollectionId != nil && len(*s.CollectionId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CollectionId", 255))
+	}
+	if s.CollectionId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.\\-]+", *s.CollectionId) {
+		invalidParams.Add(request.NewErrParamPattern("CollectionId", "[a-zA-Z0-9_.\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCollectionId sets the CollectionId field's value.
 func (s *CreateCollectionInput) SetCollectionId(v string) *CreateCollectionInput {
 	s.CollectionId = &v
@@ -2337,6 +2380,24 @@ func (s *DeleteCollectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteCollectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteCollectionInput"}
+	if s.CollectionId != nil && len(*s.CollectionId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CollectionId", 255))
+	}
+	if s.CollectionId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.\\-]+", *s.CollectionId) {
+		invalidParams.Add(request.NewErrParamPattern("CollectionId", "[a-zA-Z0-9_.\\-]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCollectionId sets the CollectionId field's value.
 func (s *DeleteCollectionInput) SetCollectionId(v string) *DeleteCollectionInput {
 	s.CollectionId = &v
@@ -2412,6 +2473,27 @@ func (s *DeleteFacesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteFacesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteFacesInput"}
+	if s.CollectionId != nil && len(*s.CollectionId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CollectionId", 255))
+	}
+	if s.CollectionId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.\\-]+", *s.CollectionId) {
+		invalidParams.Add(request.NewErrParamPattern("CollectionId", "[a-zA-Z0-9_.\\-]+"))
+	}
+	if s.FaceIds != nil && len(s.FaceIds) > 4096 {
+		invalidParams.Add(request.NewErrParamMaxLen("FaceIds", 4096))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCollectionId sets the CollectionId field's value.
 func (s *DeleteFacesInput) SetCollectionId(v string) *DeleteFacesInput {
 	s.CollectionId = &v
@@ -2496,6 +2578,23 @@ func (s *DetectFacesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetectFacesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetectFacesInput"}
+	if s.Image != nil {
+		if err := s.Image.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Image", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttributes sets the Attributes field's value.
 func (s *DetectFacesInput) SetAttributes(v []*string) *DetectFacesInput {
 	s.Attributes = v
@@ -2598,6 +2697,26 @@ func (s *DetectLabelsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetectLabelsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetectLabelsInput"}
+	if s.MinConfidence != nil && *s.MinConfidence > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MinConfidence", 100))
+	}
+	if s.Image != nil {
+		if err := s.Image.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Image", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetImage sets the Image field's value.
 func (s *DetectLabelsInput) SetImage(v *Image) *DetectLabelsInput {
 	s.Image = v
@@ -2700,6 +2819,26 @@ func (s *DetectModerationLabelsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DetectModerationLabelsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DetectModerationLabelsInput"}
+	if s.MinConfidence != nil && *s.MinConfidence > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MinConfidence", 100))
+	}
+	if s.Image != nil {
+		if err := s.Image.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Image", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetImage sets the Image field's value.
 func (s *DetectModerationLabelsInput) SetImage(v *Image) *DetectModerationLabelsInput {
 	s.Image = v
@@ -3197,6 +3336,21 @@ func (s *GetCelebrityInfoInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetCelebrityInfoInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetCelebrityInfoInput"}
+	if s.Id != nil && !request.MatchParamPattern("[0-9A-Za-z]*", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "[0-9A-Za-z]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *GetCelebrityInfoInput) SetId(v string) *GetCelebrityInfoInput {
 	s.Id = &v
@@ -3298,6 +3452,26 @@ func (s *Image) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Image) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Image"}
+	if s.Bytes != nil && len(s.Bytes) > 5.24288e+06 {
+		invalidParams.Add(request.NewErrParamMaxLen("Bytes", 5.24288e+06))
+	}
+	if s.S3Object != nil {
+		if err := s.S3Object.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3Object", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBytes sets the Bytes field's value.
 func (s *Image) SetBytes(v []byte) *Image {
 	s.Bytes = v
@@ -3411,6 +3585,35 @@ func (s *IndexFacesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *IndexFacesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "IndexFacesInput"}
+	if s.CollectionId != nil && len(*s.CollectionId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CollectionId", 255))
+	}
+	if s.CollectionId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.\\-]+", *s.CollectionId) {
+		invalidParams.Add(request.NewErrParamPattern("CollectionId", "[a-zA-Z0-9_.\\-]+"))
+	}
+	if s.ExternalImageId != nil && len(*s.ExternalImageId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExternalImageId", 255))
+	}
+	if s.ExternalImageId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.\\-:]+", *s.ExternalImageId) {
+		invalidParams.Add(request.NewErrParamPattern("ExternalImageId", "[a-zA-Z0-9_.\\-:]+"))
+	}
+	if s.Image != nil {
+		if err := s.Image.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Image", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCollectionId sets the CollectionId field's value.
 func (s *IndexFacesInput) SetCollectionId(v string) *IndexFacesInput {
 	s.CollectionId = &v
@@ -3577,6 +3780,24 @@ func (s ListCollectionsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListCollectionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListCollectionsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 4096 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 4096))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListCollectionsInput) SetMaxResults(v int64) *ListCollectionsInput {
 	s.MaxResults = &v
@@ -3665,6 +3886,30 @@ func (s *ListFacesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListFacesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListFacesInput"}
+	if s.CollectionId != nil && len(*s.CollectionId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CollectionId", 255))
+	}
+	if s.CollectionId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.\\-]+", *s.CollectionId) {
+		invalidParams.Add(request.NewErrParamPattern("CollectionId", "[a-zA-Z0-9_.\\-]+"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 4096 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 4096))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCollectionId sets the CollectionId field's value.
 func (s *ListFacesInput) SetCollectionId(v string) *ListFacesInput {
 	s.CollectionId = &v
@@ -3913,6 +4158,23 @@ func (s *RecognizeCelebritiesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RecognizeCelebritiesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RecognizeCelebritiesInput"}
+	if s.Image != nil {
+		if err := s.Image.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Image", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetImage sets the Image field's value.
 func (s *RecognizeCelebritiesInput) SetImage(v *Image) *RecognizeCelebritiesInput {
 	s.Image = v
@@ -4021,6 +4283,30 @@ func (s *S3Object) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *S3Object) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "S3Object"}
+	if s.Bucket != nil && len(*s.Bucket) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Bucket", 255))
+	}
+	if s.Bucket != nil && !request.MatchParamPattern("[0-9A-Za-z\\.\\-_]*", *s.Bucket) {
+		invalidParams.Add(request.NewErrParamPattern("Bucket", "[0-9A-Za-z\\.\\-_]*"))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+	if s.Version != nil && len(*s.Version) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *S3Object) SetBucket(v string) *S3Object {
 	s.Bucket = &v
@@ -4099,6 +4385,35 @@ func (s *SearchFacesByImageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SearchFacesByImageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SearchFacesByImageInput"}
+	if s.CollectionId != nil && len(*s.CollectionId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CollectionId", 255))
+	}
+	if s.CollectionId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.\\-]+", *s.CollectionId) {
+		invalidParams.Add(request.NewErrParamPattern("CollectionId", "[a-zA-Z0-9_.\\-]+"))
+	}
+	if s.FaceMatchThreshold != nil && *s.FaceMatchThreshold > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("FaceMatchThreshold", 100))
+	}
+	if s.MaxFaces != nil && *s.MaxFaces > 4096 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxFaces", 4096))
+	}
+	if s.Image != nil {
+		if err := s.Image.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Image", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCollectionId sets the CollectionId field's value.
 func (s *SearchFacesByImageInput) SetCollectionId(v string) *SearchFacesByImageInput {
 	s.CollectionId = &v
@@ -4221,6 +4536,33 @@ func (s *SearchFacesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SearchFacesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SearchFacesInput"}
+	if s.CollectionId != nil && len(*s.CollectionId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("CollectionId", 255))
+	}
+	if s.CollectionId != nil && !request.MatchParamPattern("[a-zA-Z0-9_.\\-]+", *s.CollectionId) {
+		invalidParams.Add(request.NewErrParamPattern("CollectionId", "[a-zA-Z0-9_.\\-]+"))
+	}
+	if s.FaceId != nil && !request.MatchParamPattern("[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.FaceId) {
+		invalidParams.Add(request.NewErrParamPattern("FaceId", "[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.FaceMatchThreshold != nil && *s.FaceMatchThreshold > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("FaceMatchThreshold", 100))
+	}
+	if s.MaxFaces != nil && *s.MaxFaces > 4096 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxFaces", 4096))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCollectionId sets the CollectionId field's value.
 func (s *SearchFacesInput) SetCollectionId(v string) *SearchFacesInput {
 	s.CollectionId = &v
@@ -796,6 +796,34 @@ func (s *GetResourcesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetResourcesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetResourcesInput"}
+	if s.PaginationToken != nil && len(*s.PaginationToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PaginationToken", 2048))
+	}
+	if s.TagFilters != nil && len(s.TagFilters) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagFilters", 50))
+	}
+	if s.TagFilters != nil {
+		for i, v := range s.TagFilters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TagFilters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPaginationToken sets the PaginationToken field's value.
 func (s *GetResourcesInput) SetPaginationToken(v string) *GetResourcesInput {
 	s.PaginationToken = &v
@@ -881,6 +909,21 @@ func (s GetTagKeysInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetTagKeysInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTagKeysInput"}
+	if s.PaginationToken != nil && len(*s.PaginationToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PaginationToken", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPaginationToken sets the PaginationToken field's value.
 func (s *GetTagKeysInput) SetPaginationToken(v string) *GetTagKeysInput {
 	s.PaginationToken = &v
@@ -964,6 +1007,24 @@ func (s *GetTagValuesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetTagValuesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTagValuesInput"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.PaginationToken != nil && len(*s.PaginationToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PaginationToken", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *GetTagValuesInput) SetKey(v string) *GetTagValuesInput {
 	s.Key = &v
@@ -1127,6 +1188,24 @@ func (s *TagFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TagFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagFilter"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Values != nil && len(s.Values) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("Values", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *TagFilter) SetKey(v string) *TagFilter {
 	s.Key = &v
@@ -1191,6 +1270,24 @@ func (s *TagResourcesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TagResourcesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagResourcesInput"}
+	if s.ResourceARNList != nil && len(s.ResourceARNList) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceARNList", 20))
+	}
+	if s.Tags != nil && len(s.Tags) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceARNList sets the ResourceARNList field's value.
 func (s *TagResourcesInput) SetResourceARNList(v []*string) *TagResourcesInput {
 	s.ResourceARNList = v
@@ -1279,6 +1376,24 @@ func (s *UntagResourcesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UntagResourcesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UntagResourcesInput"}
+	if s.ResourceARNList != nil && len(s.ResourceARNList) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceARNList", 20))
+	}
+	if s.TagKeys != nil && len(s.TagKeys) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagKeys", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceARNList sets the ResourceARNList field's value.
 func (s *UntagResourcesInput) SetResourceARNList(v []*string) *UntagResourcesInput {
 	s.ResourceARNList = v
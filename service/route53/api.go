@@ -5469,6 +5469,30 @@ func (s *AlarmIdentifier) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AlarmIdentifier) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AlarmIdentifier"}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.Region != nil && len(*s.Region) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Region", 64))
+	}
+	if s.Region != nil {
+		values := []string{"us-east-1", "us-east-2", "us-west-1", "us-west-2", "ca-central-1", "eu-central-1", "eu-west-1", "eu-west-2", "ap-south-1", "ap-southeast-1", "ap-southeast-2", "ap-northeast-1", "ap-northeast-2", "sa-east-1"}
+		if !request.IsParamEnumValue(*s.Region, values) {
+			invalidParams.Add(request.NewErrParamEnum("Region", *s.Region, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *AlarmIdentifier) SetName(v string) *AlarmIdentifier {
 	s.Name = &v
@@ -5712,6 +5736,24 @@ func (s *AliasTarget) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AliasTarget) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AliasTarget"}
+	if s.DNSName != nil && len(*s.DNSName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DNSName", 1024))
+	}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneId", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDNSName sets the DNSName field's value.
 func (s *AliasTarget) SetDNSName(v string) *AliasTarget {
 	s.DNSName = &v
@@ -5807,6 +5849,26 @@ func (s *AssociateVPCWithHostedZoneInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssociateVPCWithHostedZoneInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociateVPCWithHostedZoneInput"}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneId", 32))
+	}
+	if s.VPC != nil {
+		if err := s.VPC.ValidateExtended(); err != nil {
+			invalidParams.AddNested("VPC", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComment sets the Comment field's value.
 func (s *AssociateVPCWithHostedZoneInput) SetComment(v string) *AssociateVPCWithHostedZoneInput {
 	s.Comment = &v
@@ -5827,21 +5889,26 @@ func (s *AssociateVPCWithHostedZoneInput) SetVPC(v *VPC) *AssociateVPCWithHosted
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *AssociateVPCWithHostedZoneInput) MarshalFields(e protocol.FieldEncoder) error {
-	if s.Comment != nil {
-		v := *s.Comment
-
-		e.SetValue(protocol.BodyTarget, "Comment", protocol.StringValue(v), protocol.Metadata{})
-	}
 	if s.HostedZoneId != nil {
 		v := *s.HostedZoneId
 
 		e.SetValue(protocol.PathTarget, "Id", protocol.StringValue(v), protocol.Metadata{})
 	}
-	if s.VPC != nil {
-		v := s.VPC
 
-		e.SetFields(protocol.BodyTarget, "VPC", v, protocol.Metadata{})
-	}
+	e.SetFields(protocol.BodyTarget, "AssociateVPCWithHostedZoneRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.Comment != nil {
+			v := *s.Comment
+
+			e.SetValue(protocol.BodyTarget, "Comment", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.VPC != nil {
+			v := s.VPC
+
+			e.SetFields(protocol.BodyTarget, "VPC", v, protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
 
 	return nil
 }
@@ -5982,6 +6049,29 @@ func (s *Change) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Change) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Change"}
+	if s.Action != nil {
+		values := []string{"CREATE", "DELETE", "UPSERT"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+	if s.ResourceRecordSet != nil {
+		if err := s.ResourceRecordSet.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ResourceRecordSet", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *Change) SetAction(v string) *Change {
 	s.Action = &v
@@ -6068,6 +6158,31 @@ func (s *ChangeBatch) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ChangeBatch) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ChangeBatch"}
+	if s.Comment != nil && len(*s.Comment) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Comment", 256))
+	}
+	if s.Changes != nil {
+		for i, v := range s.Changes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Changes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChanges sets the Changes field's value.
 func (s *ChangeBatch) SetChanges(v []*Change) *ChangeBatch {
 	s.Changes = v
@@ -6237,6 +6352,26 @@ func (s *ChangeResourceRecordSetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ChangeResourceRecordSetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ChangeResourceRecordSetsInput"}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneId", 32))
+	}
+	if s.ChangeBatch != nil {
+		if err := s.ChangeBatch.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ChangeBatch", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeBatch sets the ChangeBatch field's value.
 func (s *ChangeResourceRecordSetsInput) SetChangeBatch(v *ChangeBatch) *ChangeResourceRecordSetsInput {
 	s.ChangeBatch = v
@@ -6251,17 +6386,22 @@ func (s *ChangeResourceRecordSetsInput) SetHostedZoneId(v string) *ChangeResourc
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *ChangeResourceRecordSetsInput) MarshalFields(e protocol.FieldEncoder) error {
-	if s.ChangeBatch != nil {
-		v := s.ChangeBatch
-
-		e.SetFields(protocol.BodyTarget, "ChangeBatch", v, protocol.Metadata{})
-	}
 	if s.HostedZoneId != nil {
 		v := *s.HostedZoneId
 
 		e.SetValue(protocol.PathTarget, "Id", protocol.StringValue(v), protocol.Metadata{})
 	}
 
+	e.SetFields(protocol.BodyTarget, "ChangeResourceRecordSetsRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.ChangeBatch != nil {
+			v := s.ChangeBatch
+
+			e.SetFields(protocol.BodyTarget, "ChangeBatch", v, protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
+
 	return nil
 }
 
@@ -6371,6 +6511,43 @@ func (s *ChangeTagsForResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ChangeTagsForResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ChangeTagsForResourceInput"}
+	if s.AddTags != nil && len(s.AddTags) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("AddTags", 10))
+	}
+	if s.RemoveTagKeys != nil && len(s.RemoveTagKeys) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("RemoveTagKeys", 10))
+	}
+	if s.ResourceId != nil && len(*s.ResourceId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 64))
+	}
+	if s.ResourceType != nil {
+		values := []string{"healthcheck", "hostedzone"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+	if s.AddTags != nil {
+		for i, v := range s.AddTags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "AddTags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddTags sets the AddTags field's value.
 func (s *ChangeTagsForResourceInput) SetAddTags(v []*Tag) *ChangeTagsForResourceInput {
 	s.AddTags = v
@@ -6397,16 +6574,6 @@ func (s *ChangeTagsForResourceInput) SetResourceType(v string) *ChangeTagsForRes
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *ChangeTagsForResourceInput) MarshalFields(e protocol.FieldEncoder) error {
-	if len(s.AddTags) > 0 {
-		v := s.AddTags
-
-		e.SetList(protocol.BodyTarget, "AddTags", encodeTagList(v), protocol.Metadata{ListLocationName: "Tag"})
-	}
-	if len(s.RemoveTagKeys) > 0 {
-		v := s.RemoveTagKeys
-
-		e.SetList(protocol.BodyTarget, "RemoveTagKeys", protocol.EncodeStringList(v), protocol.Metadata{ListLocationName: "Key"})
-	}
 	if s.ResourceId != nil {
 		v := *s.ResourceId
 
@@ -6418,6 +6585,21 @@ func (s *ChangeTagsForResourceInput) MarshalFields(e protocol.FieldEncoder) erro
 		e.SetValue(protocol.PathTarget, "ResourceType", protocol.StringValue(v), protocol.Metadata{})
 	}
 
+	e.SetFields(protocol.BodyTarget, "ChangeTagsForResourceRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if len(s.AddTags) > 0 {
+			v := s.AddTags
+
+			e.SetList(protocol.BodyTarget, "AddTags", encodeTagList(v), protocol.Metadata{ListLocationName: "Tag"})
+		}
+		if len(s.RemoveTagKeys) > 0 {
+			v := s.RemoveTagKeys
+
+			e.SetList(protocol.BodyTarget, "RemoveTagKeys", protocol.EncodeStringList(v), protocol.Metadata{ListLocationName: "Key"})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
+
 	return nil
 }
 
@@ -6671,6 +6853,26 @@ func (s *CreateHealthCheckInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateHealthCheckInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateHealthCheckInput"}
+	if s.CallerReference != nil && len(*s.CallerReference) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("CallerReference", 64))
+	}
+	if s.HealthCheckConfig != nil {
+		if err := s.HealthCheckConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("HealthCheckConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCallerReference sets the CallerReference field's value.
 func (s *CreateHealthCheckInput) SetCallerReference(v string) *CreateHealthCheckInput {
 	s.CallerReference = &v
@@ -6685,16 +6887,21 @@ func (s *CreateHealthCheckInput) SetHealthCheckConfig(v *HealthCheckConfig) *Cre
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *CreateHealthCheckInput) MarshalFields(e protocol.FieldEncoder) error {
-	if s.CallerReference != nil {
-		v := *s.CallerReference
 
-		e.SetValue(protocol.BodyTarget, "CallerReference", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.HealthCheckConfig != nil {
-		v := s.HealthCheckConfig
+	e.SetFields(protocol.BodyTarget, "CreateHealthCheckRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.CallerReference != nil {
+			v := *s.CallerReference
 
-		e.SetFields(protocol.BodyTarget, "HealthCheckConfig", v, protocol.Metadata{})
-	}
+			e.SetValue(protocol.BodyTarget, "CallerReference", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.HealthCheckConfig != nil {
+			v := s.HealthCheckConfig
+
+			e.SetFields(protocol.BodyTarget, "HealthCheckConfig", v, protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
 
 	return nil
 }
@@ -6841,6 +7048,37 @@ func (s *CreateHostedZoneInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateHostedZoneInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateHostedZoneInput"}
+	if s.CallerReference != nil && len(*s.CallerReference) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("CallerReference", 128))
+	}
+	if s.DelegationSetId != nil && len(*s.DelegationSetId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("DelegationSetId", 32))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+	if s.HostedZoneConfig != nil {
+		if err := s.HostedZoneConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("HostedZoneConfig", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.VPC != nil {
+		if err := s.VPC.ValidateExtended(); err != nil {
+			invalidParams.AddNested("VPC", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCallerReference sets the CallerReference field's value.
 func (s *CreateHostedZoneInput) SetCallerReference(v string) *CreateHostedZoneInput {
 	s.CallerReference = &v
@@ -6873,31 +7111,36 @@ func (s *CreateHostedZoneInput) SetVPC(v *VPC) *CreateHostedZoneInput {
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *CreateHostedZoneInput) MarshalFields(e protocol.FieldEncoder) error {
-	if s.CallerReference != nil {
-		v := *s.CallerReference
 
-		e.SetValue(protocol.BodyTarget, "CallerReference", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.DelegationSetId != nil {
-		v := *s.DelegationSetId
+	e.SetFields(protocol.BodyTarget, "CreateHostedZoneRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.CallerReference != nil {
+			v := *s.CallerReference
 
-		e.SetValue(protocol.BodyTarget, "DelegationSetId", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.HostedZoneConfig != nil {
-		v := s.HostedZoneConfig
+			e.SetValue(protocol.BodyTarget, "CallerReference", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.DelegationSetId != nil {
+			v := *s.DelegationSetId
 
-		e.SetFields(protocol.BodyTarget, "HostedZoneConfig", v, protocol.Metadata{})
-	}
-	if s.Name != nil {
-		v := *s.Name
+			e.SetValue(protocol.BodyTarget, "DelegationSetId", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.HostedZoneConfig != nil {
+			v := s.HostedZoneConfig
 
-		e.SetValue(protocol.BodyTarget, "Name", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.VPC != nil {
-		v := s.VPC
+			e.SetFields(protocol.BodyTarget, "HostedZoneConfig", v, protocol.Metadata{})
+		}
+		if s.Name != nil {
+			v := *s.Name
 
-		e.SetFields(protocol.BodyTarget, "VPC", v, protocol.Metadata{})
-	}
+			e.SetValue(protocol.BodyTarget, "Name", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.VPC != nil {
+			v := s.VPC
+
+			e.SetFields(protocol.BodyTarget, "VPC", v, protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
 
 	return nil
 }
@@ -7053,6 +7296,21 @@ func (s *CreateQueryLoggingConfigInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateQueryLoggingConfigInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateQueryLoggingConfigInput"}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneId", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCloudWatchLogsLogGroupArn sets the CloudWatchLogsLogGroupArn field's value.
 func (s *CreateQueryLoggingConfigInput) SetCloudWatchLogsLogGroupArn(v string) *CreateQueryLoggingConfigInput {
 	s.CloudWatchLogsLogGroupArn = &v
@@ -7067,16 +7325,21 @@ func (s *CreateQueryLoggingConfigInput) SetHostedZoneId(v string) *CreateQueryLo
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *CreateQueryLoggingConfigInput) MarshalFields(e protocol.FieldEncoder) error {
-	if s.CloudWatchLogsLogGroupArn != nil {
-		v := *s.CloudWatchLogsLogGroupArn
 
-		e.SetValue(protocol.BodyTarget, "CloudWatchLogsLogGroupArn", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.HostedZoneId != nil {
-		v := *s.HostedZoneId
+	e.SetFields(protocol.BodyTarget, "CreateQueryLoggingConfigRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.CloudWatchLogsLogGroupArn != nil {
+			v := *s.CloudWatchLogsLogGroupArn
 
-		e.SetValue(protocol.BodyTarget, "HostedZoneId", protocol.StringValue(v), protocol.Metadata{})
-	}
+			e.SetValue(protocol.BodyTarget, "CloudWatchLogsLogGroupArn", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.HostedZoneId != nil {
+			v := *s.HostedZoneId
+
+			e.SetValue(protocol.BodyTarget, "HostedZoneId", protocol.StringValue(v), protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
 
 	return nil
 }
@@ -7180,6 +7443,24 @@ func (s *CreateReusableDelegationSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateReusableDelegationSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateReusableDelegationSetInput"}
+	if s.CallerReference != nil && len(*s.CallerReference) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("CallerReference", 128))
+	}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneId", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCallerReference sets the CallerReference field's value.
 func (s *CreateReusableDelegationSetInput) SetCallerReference(v string) *CreateReusableDelegationSetInput {
 	s.CallerReference = &v
@@ -7194,16 +7475,21 @@ func (s *CreateReusableDelegationSetInput) SetHostedZoneId(v string) *CreateReus
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *CreateReusableDelegationSetInput) MarshalFields(e protocol.FieldEncoder) error {
-	if s.CallerReference != nil {
-		v := *s.CallerReference
 
-		e.SetValue(protocol.BodyTarget, "CallerReference", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.HostedZoneId != nil {
-		v := *s.HostedZoneId
+	e.SetFields(protocol.BodyTarget, "CreateReusableDelegationSetRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.CallerReference != nil {
+			v := *s.CallerReference
 
-		e.SetValue(protocol.BodyTarget, "HostedZoneId", protocol.StringValue(v), protocol.Metadata{})
-	}
+			e.SetValue(protocol.BodyTarget, "CallerReference", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.HostedZoneId != nil {
+			v := *s.HostedZoneId
+
+			e.SetValue(protocol.BodyTarget, "HostedZoneId", protocol.StringValue(v), protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
 
 	return nil
 }
@@ -7308,6 +7594,27 @@ func (s *CreateTrafficPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateTrafficPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTrafficPolicyInput"}
+	if s.Comment != nil && len(*s.Comment) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Comment", 1024))
+	}
+	if s.Document != nil && len(*s.Document) > 102400 {
+		invalidParams.Add(request.NewErrParamMaxLen("Document", 102400))
+	}
+	if s.Name != nil && len(*s.Name) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 512))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComment sets the Comment field's value.
 func (s *CreateTrafficPolicyInput) SetComment(v string) *CreateTrafficPolicyInput {
 	s.Comment = &v
@@ -7328,21 +7635,26 @@ func (s *CreateTrafficPolicyInput) SetName(v string) *CreateTrafficPolicyInput {
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *CreateTrafficPolicyInput) MarshalFields(e protocol.FieldEncoder) error {
-	if s.Comment != nil {
-		v := *s.Comment
 
-		e.SetValue(protocol.BodyTarget, "Comment", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.Document != nil {
-		v := *s.Document
+	e.SetFields(protocol.BodyTarget, "CreateTrafficPolicyRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.Comment != nil {
+			v := *s.Comment
 
-		e.SetValue(protocol.BodyTarget, "Document", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.Name != nil {
-		v := *s.Name
+			e.SetValue(protocol.BodyTarget, "Comment", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.Document != nil {
+			v := *s.Document
 
-		e.SetValue(protocol.BodyTarget, "Name", protocol.StringValue(v), protocol.Metadata{})
-	}
+			e.SetValue(protocol.BodyTarget, "Document", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.Name != nil {
+			v := *s.Name
+
+			e.SetValue(protocol.BodyTarget, "Name", protocol.StringValue(v), protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
 
 	return nil
 }
@@ -7407,17 +7719,44 @@ func (s *CreateTrafficPolicyInstanceInput) Validate() error {
 	if s.TTL == nil {
 		invalidParams.Add(request.NewErrParamRequired("TTL"))
 	}
-	if s.TrafficPolicyId == nil {
-		invalidParams.Add(request.NewErrParamRequired("TrafficPolicyId"))
+	if s.TrafficPolicyId == nil {
+		invalidParams.Add(request.NewErrParamRequired("TrafficPolicyId"))
+	}
+	if s.TrafficPolicyId != nil && len(*s.TrafficPolicyId) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TrafficPolicyId", 1))
+	}
+	if s.TrafficPolicyVersion == nil {
+		invalidParams.Add(request.NewErrParamRequired("TrafficPolicyVersion"))
+	}
+	if s.TrafficPolicyVersion != nil && *s.TrafficPolicyVersion < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("TrafficPolicyVersion", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateTrafficPolicyInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTrafficPolicyInstanceInput"}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneId", 32))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
 	}
-	if s.TrafficPolicyId != nil && len(*s.TrafficPolicyId) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("TrafficPolicyId", 1))
+	if s.TTL != nil && *s.TTL > 2.147483647e+09 {
+		invalidParams.Add(request.NewErrParamMaxValue("TTL", 2.147483647e+09))
 	}
-	if s.TrafficPolicyVersion == nil {
-		invalidParams.Add(request.NewErrParamRequired("TrafficPolicyVersion"))
+	if s.TrafficPolicyId != nil && len(*s.TrafficPolicyId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("TrafficPolicyId", 36))
 	}
-	if s.TrafficPolicyVersion != nil && *s.TrafficPolicyVersion < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("TrafficPolicyVersion", 1))
+	if s.TrafficPolicyVersion != nil && *s.TrafficPolicyVersion > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("TrafficPolicyVersion", 1000))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -7458,31 +7797,36 @@ func (s *CreateTrafficPolicyInstanceInput) SetTrafficPolicyVersion(v int64) *Cre
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *CreateTrafficPolicyInstanceInput) MarshalFields(e protocol.FieldEncoder) error {
-	if s.HostedZoneId != nil {
-		v := *s.HostedZoneId
 
-		e.SetValue(protocol.BodyTarget, "HostedZoneId", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.Name != nil {
-		v := *s.Name
+	e.SetFields(protocol.BodyTarget, "CreateTrafficPolicyInstanceRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.HostedZoneId != nil {
+			v := *s.HostedZoneId
 
-		e.SetValue(protocol.BodyTarget, "Name", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.TTL != nil {
-		v := *s.TTL
+			e.SetValue(protocol.BodyTarget, "HostedZoneId", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.Name != nil {
+			v := *s.Name
 
-		e.SetValue(protocol.BodyTarget, "TTL", protocol.Int64Value(v), protocol.Metadata{})
-	}
-	if s.TrafficPolicyId != nil {
-		v := *s.TrafficPolicyId
+			e.SetValue(protocol.BodyTarget, "Name", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.TTL != nil {
+			v := *s.TTL
 
-		e.SetValue(protocol.BodyTarget, "TrafficPolicyId", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.TrafficPolicyVersion != nil {
-		v := *s.TrafficPolicyVersion
+			e.SetValue(protocol.BodyTarget, "TTL", protocol.Int64Value(v), protocol.Metadata{})
+		}
+		if s.TrafficPolicyId != nil {
+			v := *s.TrafficPolicyId
 
-		e.SetValue(protocol.BodyTarget, "TrafficPolicyVersion", protocol.Int64Value(v), protocol.Metadata{})
-	}
+			e.SetValue(protocol.BodyTarget, "TrafficPolicyId", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.TrafficPolicyVersion != nil {
+			v := *s.TrafficPolicyVersion
+
+			e.SetValue(protocol.BodyTarget, "TrafficPolicyVersion", protocol.Int64Value(v), protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
 
 	return nil
 }
@@ -7649,6 +7993,27 @@ func (s *CreateTrafficPolicyVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateTrafficPolicyVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTrafficPolicyVersionInput"}
+	if s.Comment != nil && len(*s.Comment) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Comment", 1024))
+	}
+	if s.Document != nil && len(*s.Document) > 102400 {
+		invalidParams.Add(request.NewErrParamMaxLen("Document", 102400))
+	}
+	if s.Id != nil && len(*s.Id) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 36))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComment sets the Comment field's value.
 func (s *CreateTrafficPolicyVersionInput) SetComment(v string) *CreateTrafficPolicyVersionInput {
 	s.Comment = &v
@@ -7669,22 +8034,27 @@ func (s *CreateTrafficPolicyVersionInput) SetId(v string) *CreateTrafficPolicyVe
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *CreateTrafficPolicyVersionInput) MarshalFields(e protocol.FieldEncoder) error {
-	if s.Comment != nil {
-		v := *s.Comment
-
-		e.SetValue(protocol.BodyTarget, "Comment", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.Document != nil {
-		v := *s.Document
-
-		e.SetValue(protocol.BodyTarget, "Document", protocol.StringValue(v), protocol.Metadata{})
-	}
 	if s.Id != nil {
 		v := *s.Id
 
 		e.SetValue(protocol.PathTarget, "Id", protocol.StringValue(v), protocol.Metadata{})
 	}
 
+	e.SetFields(protocol.BodyTarget, "CreateTrafficPolicyVersionRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.Comment != nil {
+			v := *s.Comment
+
+			e.SetValue(protocol.BodyTarget, "Comment", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.Document != nil {
+			v := *s.Document
+
+			e.SetValue(protocol.BodyTarget, "Document", protocol.StringValue(v), protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
+
 	return nil
 }
 
@@ -7795,6 +8165,26 @@ func (s *CreateVPCAssociationAuthorizationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateVPCAssociationAuthorizationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateVPCAssociationAuthorizationInput"}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneId", 32))
+	}
+	if s.VPC != nil {
+		if err := s.VPC.ValidateExtended(); err != nil {
+			invalidParams.AddNested("VPC", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHostedZoneId sets the HostedZoneId field's value.
 func (s *CreateVPCAssociationAuthorizationInput) SetHostedZoneId(v string) *CreateVPCAssociationAuthorizationInput {
 	s.HostedZoneId = &v
@@ -7814,11 +8204,16 @@ func (s *CreateVPCAssociationAuthorizationInput) MarshalFields(e protocol.FieldE
 
 		e.SetValue(protocol.PathTarget, "Id", protocol.StringValue(v), protocol.Metadata{})
 	}
-	if s.VPC != nil {
-		v := s.VPC
 
-		e.SetFields(protocol.BodyTarget, "VPC", v, protocol.Metadata{})
-	}
+	e.SetFields(protocol.BodyTarget, "CreateVPCAssociationAuthorizationRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.VPC != nil {
+			v := s.VPC
+
+			e.SetFields(protocol.BodyTarget, "VPC", v, protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
 
 	return nil
 }
@@ -7989,6 +8384,21 @@ func (s *DeleteHealthCheckInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteHealthCheckInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteHealthCheckInput"}
+	if s.HealthCheckId != nil && len(*s.HealthCheckId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HealthCheckId", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHealthCheckId sets the HealthCheckId field's value.
 func (s *DeleteHealthCheckInput) SetHealthCheckId(v string) *DeleteHealthCheckInput {
 	s.HealthCheckId = &v
@@ -8062,6 +8472,21 @@ func (s *DeleteHostedZoneInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteHostedZoneInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteHostedZoneInput"}
+	if s.Id != nil && len(*s.Id) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *DeleteHostedZoneInput) SetId(v string) *DeleteHostedZoneInput {
 	s.Id = &v
@@ -8154,6 +8579,21 @@ func (s *DeleteQueryLoggingConfigInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteQueryLoggingConfigInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteQueryLoggingConfigInput"}
+	if s.Id != nil && len(*s.Id) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 36))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *DeleteQueryLoggingConfigInput) SetId(v string) *DeleteQueryLoggingConfigInput {
 	s.Id = &v
@@ -8226,6 +8666,21 @@ func (s *DeleteReusableDelegationSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteReusableDelegationSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteReusableDelegationSetInput"}
+	if s.Id != nil && len(*s.Id) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *DeleteReusableDelegationSetInput) SetId(v string) *DeleteReusableDelegationSetInput {
 	s.Id = &v
@@ -8313,6 +8768,24 @@ func (s *DeleteTrafficPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteTrafficPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTrafficPolicyInput"}
+	if s.Id != nil && len(*s.Id) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 36))
+	}
+	if s.Version != nil && *s.Version > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Version", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *DeleteTrafficPolicyInput) SetId(v string) *DeleteTrafficPolicyInput {
 	s.Id = &v
@@ -8382,6 +8855,21 @@ func (s *DeleteTrafficPolicyInstanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteTrafficPolicyInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTrafficPolicyInstanceInput"}
+	if s.Id != nil && len(*s.Id) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 36))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *DeleteTrafficPolicyInstanceInput) SetId(v string) *DeleteTrafficPolicyInstanceInput {
 	s.Id = &v
@@ -8496,6 +8984,26 @@ func (s *DeleteVPCAssociationAuthorizationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteVPCAssociationAuthorizationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteVPCAssociationAuthorizationInput"}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneId", 32))
+	}
+	if s.VPC != nil {
+		if err := s.VPC.ValidateExtended(); err != nil {
+			invalidParams.AddNested("VPC", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHostedZoneId sets the HostedZoneId field's value.
 func (s *DeleteVPCAssociationAuthorizationInput) SetHostedZoneId(v string) *DeleteVPCAssociationAuthorizationInput {
 	s.HostedZoneId = &v
@@ -8515,11 +9023,16 @@ func (s *DeleteVPCAssociationAuthorizationInput) MarshalFields(e protocol.FieldE
 
 		e.SetValue(protocol.PathTarget, "Id", protocol.StringValue(v), protocol.Metadata{})
 	}
-	if s.VPC != nil {
-		v := s.VPC
 
-		e.SetFields(protocol.BodyTarget, "VPC", v, protocol.Metadata{})
-	}
+	e.SetFields(protocol.BodyTarget, "DeleteVPCAssociationAuthorizationRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.VPC != nil {
+			v := s.VPC
+
+			e.SetFields(protocol.BodyTarget, "VPC", v, protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
 
 	return nil
 }
@@ -8663,6 +9176,26 @@ func (s *DisassociateVPCFromHostedZoneInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisassociateVPCFromHostedZoneInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisassociateVPCFromHostedZoneInput"}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneId", 32))
+	}
+	if s.VPC != nil {
+		if err := s.VPC.ValidateExtended(); err != nil {
+			invalidParams.AddNested("VPC", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComment sets the Comment field's value.
 func (s *DisassociateVPCFromHostedZoneInput) SetComment(v string) *DisassociateVPCFromHostedZoneInput {
 	s.Comment = &v
@@ -8683,21 +9216,26 @@ func (s *DisassociateVPCFromHostedZoneInput) SetVPC(v *VPC) *DisassociateVPCFrom
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *DisassociateVPCFromHostedZoneInput) MarshalFields(e protocol.FieldEncoder) error {
-	if s.Comment != nil {
-		v := *s.Comment
-
-		e.SetValue(protocol.BodyTarget, "Comment", protocol.StringValue(v), protocol.Metadata{})
-	}
 	if s.HostedZoneId != nil {
 		v := *s.HostedZoneId
 
 		e.SetValue(protocol.PathTarget, "Id", protocol.StringValue(v), protocol.Metadata{})
 	}
-	if s.VPC != nil {
-		v := s.VPC
 
-		e.SetFields(protocol.BodyTarget, "VPC", v, protocol.Metadata{})
-	}
+	e.SetFields(protocol.BodyTarget, "DisassociateVPCFromHostedZoneRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.Comment != nil {
+			v := *s.Comment
+
+			e.SetValue(protocol.BodyTarget, "Comment", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.VPC != nil {
+			v := s.VPC
+
+			e.SetFields(protocol.BodyTarget, "VPC", v, protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
 
 	return nil
 }
@@ -8792,6 +9330,27 @@ func (s *GeoLocation) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GeoLocation) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GeoLocation"}
+	if s.ContinentCode != nil && len(*s.ContinentCode) > 2 {
+		invalidParams.Add(request.NewErrParamMaxLen("ContinentCode", 2))
+	}
+	if s.CountryCode != nil && len(*s.CountryCode) > 2 {
+		invalidParams.Add(request.NewErrParamMaxLen("CountryCode", 2))
+	}
+	if s.SubdivisionCode != nil && len(*s.SubdivisionCode) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("SubdivisionCode", 3))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContinentCode sets the ContinentCode field's value.
 func (s *GeoLocation) SetContinentCode(v string) *GeoLocation {
 	s.ContinentCode = &v
@@ -8984,6 +9543,21 @@ func (s *GetChangeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetChangeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetChangeInput"}
+	if s.Id != nil && len(*s.Id) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *GetChangeInput) SetId(v string) *GetChangeInput {
 	s.Id = &v
@@ -9158,6 +9732,27 @@ func (s *GetGeoLocationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetGeoLocationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetGeoLocationInput"}
+	if s.ContinentCode != nil && len(*s.ContinentCode) > 2 {
+		invalidParams.Add(request.NewErrParamMaxLen("ContinentCode", 2))
+	}
+	if s.CountryCode != nil && len(*s.CountryCode) > 2 {
+		invalidParams.Add(request.NewErrParamMaxLen("CountryCode", 2))
+	}
+	if s.SubdivisionCode != nil && len(*s.SubdivisionCode) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("SubdivisionCode", 3))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContinentCode sets the ContinentCode field's value.
 func (s *GetGeoLocationInput) SetContinentCode(v string) *GetGeoLocationInput {
 	s.ContinentCode = &v
@@ -9335,6 +9930,21 @@ func (s *GetHealthCheckInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetHealthCheckInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetHealthCheckInput"}
+	if s.HealthCheckId != nil && len(*s.HealthCheckId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HealthCheckId", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHealthCheckId sets the HealthCheckId field's value.
 func (s *GetHealthCheckInput) SetHealthCheckId(v string) *GetHealthCheckInput {
 	s.HealthCheckId = &v
@@ -9388,6 +9998,21 @@ func (s *GetHealthCheckLastFailureReasonInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetHealthCheckLastFailureReasonInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetHealthCheckLastFailureReasonInput"}
+	if s.HealthCheckId != nil && len(*s.HealthCheckId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HealthCheckId", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHealthCheckId sets the HealthCheckId field's value.
 func (s *GetHealthCheckLastFailureReasonInput) SetHealthCheckId(v string) *GetHealthCheckLastFailureReasonInput {
 	s.HealthCheckId = &v
@@ -9524,6 +10149,21 @@ func (s *GetHealthCheckStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetHealthCheckStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetHealthCheckStatusInput"}
+	if s.HealthCheckId != nil && len(*s.HealthCheckId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HealthCheckId", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHealthCheckId sets the HealthCheckId field's value.
 func (s *GetHealthCheckStatusInput) SetHealthCheckId(v string) *GetHealthCheckStatusInput {
 	s.HealthCheckId = &v
@@ -9663,11 +10303,26 @@ func (s GetHostedZoneInput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *GetHostedZoneInput) Validate() error {
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *GetHostedZoneInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetHostedZoneInput"}
+	if s.Id == nil {
+		invalidParams.Add(request.NewErrParamRequired("Id"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetHostedZoneInput) ValidateExtended() error {
 	invalidParams := request.ErrInvalidParams{Context: "GetHostedZoneInput"}
-	if s.Id == nil {
-		invalidParams.Add(request.NewErrParamRequired("Id"))
+	if s.Id != nil && len(*s.Id) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 32))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -9799,6 +10454,21 @@ func (s *GetQueryLoggingConfigInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetQueryLoggingConfigInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetQueryLoggingConfigInput"}
+	if s.Id != nil && len(*s.Id) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 36))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *GetQueryLoggingConfigInput) SetId(v string) *GetQueryLoggingConfigInput {
 	s.Id = &v
@@ -9889,6 +10559,21 @@ func (s *GetReusableDelegationSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetReusableDelegationSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetReusableDelegationSetInput"}
+	if s.Id != nil && len(*s.Id) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *GetReusableDelegationSetInput) SetId(v string) *GetReusableDelegationSetInput {
 	s.Id = &v
@@ -9994,6 +10679,24 @@ func (s *GetTrafficPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetTrafficPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTrafficPolicyInput"}
+	if s.Id != nil && len(*s.Id) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 36))
+	}
+	if s.Version != nil && *s.Version > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Version", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *GetTrafficPolicyInput) SetId(v string) *GetTrafficPolicyInput {
 	s.Id = &v
@@ -10122,6 +10825,21 @@ func (s *GetTrafficPolicyInstanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetTrafficPolicyInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTrafficPolicyInstanceInput"}
+	if s.Id != nil && len(*s.Id) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 36))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *GetTrafficPolicyInstanceInput) SetId(v string) *GetTrafficPolicyInstanceInput {
 	s.Id = &v
@@ -10646,6 +11364,68 @@ func (s *HealthCheckConfig) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *HealthCheckConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "HealthCheckConfig"}
+	if s.ChildHealthChecks != nil && len(s.ChildHealthChecks) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ChildHealthChecks", 256))
+	}
+	if s.FailureThreshold != nil && *s.FailureThreshold > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("FailureThreshold", 10))
+	}
+	if s.FullyQualifiedDomainName != nil && len(*s.FullyQualifiedDomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("FullyQualifiedDomainName", 255))
+	}
+	if s.HealthThreshold != nil && *s.HealthThreshold > 256 {
+		invalidParams.Add(request.NewErrParamMaxValue("HealthThreshold", 256))
+	}
+	if s.IPAddress != nil && len(*s.IPAddress) > 45 {
+		invalidParams.Add(request.NewErrParamMaxLen("IPAddress", 45))
+	}
+	if s.IPAddress != nil && !request.MatchParamPattern("(^((([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]))$|^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$)", *s.IPAddress) {
+		invalidParams.Add(request.NewErrParamPattern("IPAddress", "(^((([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]))$|^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$)"))
+	}
+	if s.InsufficientDataHealthStatus != nil {
+		values := []string{"Healthy", "Unhealthy", "LastKnownStatus"}
+		if !request.IsParamEnumValue(*s.InsufficientDataHealthStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("InsufficientDataHealthStatus", *s.InsufficientDataHealthStatus, values))
+		}
+	}
+	if s.Port != nil && *s.Port > 65535 {
+		invalidParams.Add(request.NewErrParamMaxValue("Port", 65535))
+	}
+	if s.Regions != nil && len(s.Regions) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Regions", 64))
+	}
+	if s.RequestInterval != nil && *s.RequestInterval > 30 {
+		invalidParams.Add(request.NewErrParamMaxValue("RequestInterval", 30))
+	}
+	if s.ResourcePath != nil && len(*s.ResourcePath) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourcePath", 255))
+	}
+	if s.SearchString != nil && len(*s.SearchString) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("SearchString", 255))
+	}
+	if s.Type != nil {
+		values := []string{"HTTP", "HTTPS", "HTTP_STR_MATCH", "HTTPS_STR_MATCH", "TCP", "CALCULATED", "CLOUDWATCH_METRIC"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.AlarmIdentifier != nil {
+		if err := s.AlarmIdentifier.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AlarmIdentifier", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAlarmIdentifier sets the AlarmIdentifier field's value.
 func (s *HealthCheckConfig) SetAlarmIdentifier(v *AlarmIdentifier) *HealthCheckConfig {
 	s.AlarmIdentifier = v
@@ -11042,6 +11822,21 @@ func (s HostedZoneConfig) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *HostedZoneConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "HostedZoneConfig"}
+	if s.Comment != nil && len(*s.Comment) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Comment", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComment sets the Comment field's value.
 func (s *HostedZoneConfig) SetComment(v string) *HostedZoneConfig {
 	s.Comment = &v
@@ -11142,6 +11937,27 @@ func (s *ListGeoLocationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListGeoLocationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListGeoLocationsInput"}
+	if s.StartContinentCode != nil && len(*s.StartContinentCode) > 2 {
+		invalidParams.Add(request.NewErrParamMaxLen("StartContinentCode", 2))
+	}
+	if s.StartCountryCode != nil && len(*s.StartCountryCode) > 2 {
+		invalidParams.Add(request.NewErrParamMaxLen("StartCountryCode", 2))
+	}
+	if s.StartSubdivisionCode != nil && len(*s.StartSubdivisionCode) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("StartSubdivisionCode", 3))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxItems sets the MaxItems field's value.
 func (s *ListGeoLocationsInput) SetMaxItems(v string) *ListGeoLocationsInput {
 	s.MaxItems = &v
@@ -11349,6 +12165,21 @@ func (s ListHealthChecksInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListHealthChecksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListHealthChecksInput"}
+	if s.Marker != nil && len(*s.Marker) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListHealthChecksInput) SetMarker(v string) *ListHealthChecksInput {
 	s.Marker = &v
@@ -11528,6 +12359,24 @@ func (s ListHostedZonesByNameInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListHostedZonesByNameInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListHostedZonesByNameInput"}
+	if s.DNSName != nil && len(*s.DNSName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("DNSName", 1024))
+	}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneId", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDNSName sets the DNSName field's value.
 func (s *ListHostedZonesByNameInput) SetDNSName(v string) *ListHostedZonesByNameInput {
 	s.DNSName = &v
@@ -11751,6 +12600,24 @@ func (s ListHostedZonesInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListHostedZonesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListHostedZonesInput"}
+	if s.DelegationSetId != nil && len(*s.DelegationSetId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("DelegationSetId", 32))
+	}
+	if s.Marker != nil && len(*s.Marker) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDelegationSetId sets the DelegationSetId field's value.
 func (s *ListHostedZonesInput) SetDelegationSetId(v string) *ListHostedZonesInput {
 	s.DelegationSetId = &v
@@ -11939,6 +12806,24 @@ func (s ListQueryLoggingConfigsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListQueryLoggingConfigsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListQueryLoggingConfigsInput"}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneId", 32))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHostedZoneId sets the HostedZoneId field's value.
 func (s *ListQueryLoggingConfigsInput) SetHostedZoneId(v string) *ListQueryLoggingConfigsInput {
 	s.HostedZoneId = &v
@@ -12119,6 +13004,33 @@ func (s *ListResourceRecordSetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListResourceRecordSetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListResourceRecordSetsInput"}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneId", 32))
+	}
+	if s.StartRecordIdentifier != nil && len(*s.StartRecordIdentifier) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("StartRecordIdentifier", 128))
+	}
+	if s.StartRecordName != nil && len(*s.StartRecordName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("StartRecordName", 1024))
+	}
+	if s.StartRecordType != nil {
+		values := []string{"SOA", "A", "TXT", "NS", "CNAME", "MX", "NAPTR", "PTR", "SRV", "SPF", "AAAA", "CAA"}
+		if !request.IsParamEnumValue(*s.StartRecordType, values) {
+			invalidParams.Add(request.NewErrParamEnum("StartRecordType", *s.StartRecordType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHostedZoneId sets the HostedZoneId field's value.
 func (s *ListResourceRecordSetsInput) SetHostedZoneId(v string) *ListResourceRecordSetsInput {
 	s.HostedZoneId = &v
@@ -12334,6 +13246,21 @@ func (s ListReusableDelegationSetsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListReusableDelegationSetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListReusableDelegationSetsInput"}
+	if s.Marker != nil && len(*s.Marker) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListReusableDelegationSetsInput) SetMarker(v string) *ListReusableDelegationSetsInput {
 	s.Marker = &v
@@ -12517,6 +13444,27 @@ func (s *ListTagsForResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsForResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
+	if s.ResourceId != nil && len(*s.ResourceId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 64))
+	}
+	if s.ResourceType != nil {
+		values := []string{"healthcheck", "hostedzone"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *ListTagsForResourceInput) SetResourceId(v string) *ListTagsForResourceInput {
 	s.ResourceId = &v
@@ -12635,6 +13583,27 @@ func (s *ListTagsForResourcesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsForResourcesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourcesInput"}
+	if s.ResourceIds != nil && len(s.ResourceIds) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceIds", 10))
+	}
+	if s.ResourceType != nil {
+		values := []string{"healthcheck", "hostedzone"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceIds sets the ResourceIds field's value.
 func (s *ListTagsForResourcesInput) SetResourceIds(v []*string) *ListTagsForResourcesInput {
 	s.ResourceIds = v
@@ -12649,17 +13618,22 @@ func (s *ListTagsForResourcesInput) SetResourceType(v string) *ListTagsForResour
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *ListTagsForResourcesInput) MarshalFields(e protocol.FieldEncoder) error {
-	if len(s.ResourceIds) > 0 {
-		v := s.ResourceIds
-
-		e.SetList(protocol.BodyTarget, "ResourceIds", protocol.EncodeStringList(v), protocol.Metadata{ListLocationName: "ResourceId"})
-	}
 	if s.ResourceType != nil {
 		v := *s.ResourceType
 
 		e.SetValue(protocol.PathTarget, "ResourceType", protocol.StringValue(v), protocol.Metadata{})
 	}
 
+	e.SetFields(protocol.BodyTarget, "ListTagsForResourcesRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if len(s.ResourceIds) > 0 {
+			v := s.ResourceIds
+
+			e.SetList(protocol.BodyTarget, "ResourceIds", protocol.EncodeStringList(v), protocol.Metadata{ListLocationName: "ResourceId"})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
+
 	return nil
 }
 
@@ -12748,6 +13722,21 @@ func (s *ListTrafficPoliciesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTrafficPoliciesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTrafficPoliciesInput"}
+	if s.TrafficPolicyIdMarker != nil && len(*s.TrafficPolicyIdMarker) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("TrafficPolicyIdMarker", 36))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxItems sets the MaxItems field's value.
 func (s *ListTrafficPoliciesInput) SetMaxItems(v string) *ListTrafficPoliciesInput {
 	s.MaxItems = &v
@@ -12934,6 +13923,30 @@ func (s *ListTrafficPolicyInstancesByHostedZoneInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTrafficPolicyInstancesByHostedZoneInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTrafficPolicyInstancesByHostedZoneInput"}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneId", 32))
+	}
+	if s.TrafficPolicyInstanceNameMarker != nil && len(*s.TrafficPolicyInstanceNameMarker) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TrafficPolicyInstanceNameMarker", 1024))
+	}
+	if s.TrafficPolicyInstanceTypeMarker != nil {
+		values := []string{"SOA", "A", "TXT", "NS", "CNAME", "MX", "NAPTR", "PTR", "SRV", "SPF", "AAAA", "CAA"}
+		if !request.IsParamEnumValue(*s.TrafficPolicyInstanceTypeMarker, values) {
+			invalidParams.Add(request.NewErrParamEnum("TrafficPolicyInstanceTypeMarker", *s.TrafficPolicyInstanceTypeMarker, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHostedZoneId sets the HostedZoneId field's value.
 func (s *ListTrafficPolicyInstancesByHostedZoneInput) SetHostedZoneId(v string) *ListTrafficPolicyInstancesByHostedZoneInput {
 	s.HostedZoneId = &v
@@ -13186,6 +14199,36 @@ func (s *ListTrafficPolicyInstancesByPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTrafficPolicyInstancesByPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTrafficPolicyInstancesByPolicyInput"}
+	if s.HostedZoneIdMarker != nil && len(*s.HostedZoneIdMarker) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneIdMarker", 32))
+	}
+	if s.TrafficPolicyId != nil && len(*s.TrafficPolicyId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("TrafficPolicyId", 36))
+	}
+	if s.TrafficPolicyInstanceNameMarker != nil && len(*s.TrafficPolicyInstanceNameMarker) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TrafficPolicyInstanceNameMarker", 1024))
+	}
+	if s.TrafficPolicyInstanceTypeMarker != nil {
+		values := []string{"SOA", "A", "TXT", "NS", "CNAME", "MX", "NAPTR", "PTR", "SRV", "SPF", "AAAA", "CAA"}
+		if !request.IsParamEnumValue(*s.TrafficPolicyInstanceTypeMarker, values) {
+			invalidParams.Add(request.NewErrParamEnum("TrafficPolicyInstanceTypeMarker", *s.TrafficPolicyInstanceTypeMarker, values))
+		}
+	}
+	if s.TrafficPolicyVersion != nil && *s.TrafficPolicyVersion > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("TrafficPolicyVersion", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHostedZoneIdMarker sets the HostedZoneIdMarker field's value.
 func (s *ListTrafficPolicyInstancesByPolicyInput) SetHostedZoneIdMarker(v string) *ListTrafficPolicyInstancesByPolicyInput {
 	s.HostedZoneIdMarker = &v
@@ -13440,6 +14483,30 @@ func (s ListTrafficPolicyInstancesInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTrafficPolicyInstancesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTrafficPolicyInstancesInput"}
+	if s.HostedZoneIdMarker != nil && len(*s.HostedZoneIdMarker) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneIdMarker", 32))
+	}
+	if s.TrafficPolicyInstanceNameMarker != nil && len(*s.TrafficPolicyInstanceNameMarker) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TrafficPolicyInstanceNameMarker", 1024))
+	}
+	if s.TrafficPolicyInstanceTypeMarker != nil {
+		values := []string{"SOA", "A", "TXT", "NS", "CNAME", "MX", "NAPTR", "PTR", "SRV", "SPF", "AAAA", "CAA"}
+		if !request.IsParamEnumValue(*s.TrafficPolicyInstanceTypeMarker, values) {
+			invalidParams.Add(request.NewErrParamEnum("TrafficPolicyInstanceTypeMarker", *s.TrafficPolicyInstanceTypeMarker, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHostedZoneIdMarker sets the HostedZoneIdMarker field's value.
 func (s *ListTrafficPolicyInstancesInput) SetHostedZoneIdMarker(v string) *ListTrafficPolicyInstancesInput {
 	s.HostedZoneIdMarker = &v
@@ -13672,6 +14739,24 @@ func (s *ListTrafficPolicyVersionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTrafficPolicyVersionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTrafficPolicyVersionsInput"}
+	if s.Id != nil && len(*s.Id) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 36))
+	}
+	if s.TrafficPolicyVersionMarker != nil && len(*s.TrafficPolicyVersionMarker) > 4 {
+		invalidParams.Add(request.NewErrParamMaxLen("TrafficPolicyVersionMarker", 4))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *ListTrafficPolicyVersionsInput) SetId(v string) *ListTrafficPolicyVersionsInput {
 	s.Id = &v
@@ -13855,6 +14940,24 @@ func (s *ListVPCAssociationAuthorizationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListVPCAssociationAuthorizationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListVPCAssociationAuthorizationsInput"}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneId", 32))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHostedZoneId sets the HostedZoneId field's value.
 func (s *ListVPCAssociationAuthorizationsInput) SetHostedZoneId(v string) *ListVPCAssociationAuthorizationsInput {
 	s.HostedZoneId = &v
@@ -14082,8 +15185,23 @@ func (s ResourceRecord) GoString() string {
 // Validate inspects the fields of the type to determine if they are valid.
 func (s *ResourceRecord) Validate() error {
 	invalidParams := request.ErrInvalidParams{Context: "ResourceRecord"}
-	if s.Value == nil {
-		invalidParams.Add(request.NewErrParamRequired("Value"))
+	if s.Value == nil {
+		invalidParams.Add(request.NewErrParamRequired("Value"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResourceRecord) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResourceRecord"}
+	if s.Value != nil && len(*s.Value) > 4000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 4000))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -14571,6 +15689,77 @@ func (s *ResourceRecordSet) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResourceRecordSet) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResourceRecordSet"}
+	if s.Failover != nil {
+		values := []string{"PRIMARY", "SECONDARY"}
+		if !request.IsParamEnumValue(*s.Failover, values) {
+			invalidParams.Add(request.NewErrParamEnum("Failover", *s.Failover, values))
+		}
+	}
+	if s.HealthCheckId != nil && len(*s.HealthCheckId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HealthCheckId", 64))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+	if s.Region != nil && len(*s.Region) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Region", 64))
+	}
+	if s.Region != nil {
+		values := []string{"us-east-1", "us-east-2", "us-west-1", "us-west-2", "ca-central-1", "eu-west-1", "eu-west-2", "eu-central-1", "ap-southeast-1", "ap-southeast-2", "ap-northeast-1", "ap-northeast-2", "sa-east-1", "cn-north-1", "ap-south-1"}
+		if !request.IsParamEnumValue(*s.Region, values) {
+			invalidParams.Add(request.NewErrParamEnum("Region", *s.Region, values))
+		}
+	}
+	if s.SetIdentifier != nil && len(*s.SetIdentifier) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("SetIdentifier", 128))
+	}
+	if s.TTL != nil && *s.TTL > 2.147483647e+09 {
+		invalidParams.Add(request.NewErrParamMaxValue("TTL", 2.147483647e+09))
+	}
+	if s.TrafficPolicyInstanceId != nil && len(*s.TrafficPolicyInstanceId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("TrafficPolicyInstanceId", 36))
+	}
+	if s.Type != nil {
+		values := []string{"SOA", "A", "TXT", "NS", "CNAME", "MX", "NAPTR", "PTR", "SRV", "SPF", "AAAA", "CAA"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.Weight != nil && *s.Weight > 255 {
+		invalidParams.Add(request.NewErrParamMaxValue("Weight", 255))
+	}
+	if s.AliasTarget != nil {
+		if err := s.AliasTarget.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AliasTarget", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.GeoLocation != nil {
+		if err := s.GeoLocation.ValidateExtended(); err != nil {
+			invalidParams.AddNested("GeoLocation", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ResourceRecords != nil {
+		for i, v := range s.ResourceRecords {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ResourceRecords", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAliasTarget sets the AliasTarget field's value.
 func (s *ResourceRecordSet) SetAliasTarget(v *AliasTarget) *ResourceRecordSet {
 	s.AliasTarget = v
@@ -14899,6 +16088,24 @@ func (s Tag) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -15008,6 +16215,45 @@ func (s *TestDNSAnswerInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TestDNSAnswerInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TestDNSAnswerInput"}
+	if s.EDNS0ClientSubnetIP != nil && len(*s.EDNS0ClientSubnetIP) > 45 {
+		invalidParams.Add(request.NewErrParamMaxLen("EDNS0ClientSubnetIP", 45))
+	}
+	if s.EDNS0ClientSubnetIP != nil && !request.MatchParamPattern("(^((([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]))$|^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$)", *s.EDNS0ClientSubnetIP) {
+		invalidParams.Add(request.NewErrParamPattern("EDNS0ClientSubnetIP", "(^((([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]))$|^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$)"))
+	}
+	if s.EDNS0ClientSubnetMask != nil && len(*s.EDNS0ClientSubnetMask) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("EDNS0ClientSubnetMask", 3))
+	}
+	if s.HostedZoneId != nil && len(*s.HostedZoneId) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("HostedZoneId", 32))
+	}
+	if s.RecordName != nil && len(*s.RecordName) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("RecordName", 1024))
+	}
+	if s.RecordType != nil {
+		values := []string{"SOA", "A", "TXT", "NS", "CNAME", "MX", "NAPTR", "PTR", "SRV", "SPF", "AAAA", "CAA"}
+		if !request.IsParamEnumValue(*s.RecordType, values) {
+			invalidParams.Add(request.NewErrParamEnum("RecordType", *s.RecordType, values))
+		}
+	}
+	if s.ResolverIP != nil && len(*s.ResolverIP) > 45 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResolverIP", 45))
+	}
+	if s.ResolverIP != nil && !request.MatchParamPattern("(^((([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]))$|^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$)", *s.ResolverIP) {
+		invalidParams.Add(request.NewErrParamPattern("ResolverIP", "(^((([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]))$|^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEDNS0ClientSubnetIP sets the EDNS0ClientSubnetIP field's value.
 func (s *TestDNSAnswerInput) SetEDNS0ClientSubnetIP(v string) *TestDNSAnswerInput {
 	s.EDNS0ClientSubnetIP = &v
@@ -15916,6 +17162,62 @@ func (s *UpdateHealthCheckInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateHealthCheckInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateHealthCheckInput"}
+	if s.ChildHealthChecks != nil && len(s.ChildHealthChecks) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ChildHealthChecks", 256))
+	}
+	if s.FailureThreshold != nil && *s.FailureThreshold > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("FailureThreshold", 10))
+	}
+	if s.FullyQualifiedDomainName != nil && len(*s.FullyQualifiedDomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("FullyQualifiedDomainName", 255))
+	}
+	if s.HealthCheckId != nil && len(*s.HealthCheckId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("HealthCheckId", 64))
+	}
+	if s.HealthThreshold != nil && *s.HealthThreshold > 256 {
+		invalidParams.Add(request.NewErrParamMaxValue("HealthThreshold", 256))
+	}
+	if s.IPAddress != nil && len(*s.IPAddress) > 45 {
+		invalidParams.Add(request.NewErrParamMaxLen("IPAddress", 45))
+	}
+	if s.IPAddress != nil && !request.MatchParamPattern("(^((([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]))$|^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$)", *s.IPAddress) {
+		invalidParams.Add(request.NewErrParamPattern("IPAddress", "(^((([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]))$|^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))$)"))
+	}
+	if s.InsufficientDataHealthStatus != nil {
+		values := []string{"Healthy", "Unhealthy", "LastKnownStatus"}
+		if !request.IsParamEnumValue(*s.InsufficientDataHealthStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("InsufficientDataHealthStatus", *s.InsufficientDataHealthStatus, values))
+		}
+	}
+	if s.Port != nil && *s.Port > 65535 {
+		invalidParams.Add(request.NewErrParamMaxValue("Port", 65535))
+	}
+	if s.Regions != nil && len(s.Regions) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Regions", 64))
+	}
+	if s.ResourcePath != nil && len(*s.ResourcePath) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourcePath", 255))
+	}
+	if s.SearchString != nil && len(*s.SearchString) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("SearchString", 255))
+	}
+	if s.AlarmIdentifier != nil {
+		if err := s.AlarmIdentifier.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AlarmIdentifier", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAlarmIdentifier sets the AlarmIdentifier field's value.
 func (s *UpdateHealthCheckInput) SetAlarmIdentifier(v *AlarmIdentifier) *UpdateHealthCheckInput {
 	s.AlarmIdentifier = v
@@ -16008,81 +17310,86 @@ func (s *UpdateHealthCheckInput) SetSearchString(v string) *UpdateHealthCheckInp
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *UpdateHealthCheckInput) MarshalFields(e protocol.FieldEncoder) error {
-	if s.AlarmIdentifier != nil {
-		v := s.AlarmIdentifier
+	if s.HealthCheckId != nil {
+		v := *s.HealthCheckId
 
-		e.SetFields(protocol.BodyTarget, "AlarmIdentifier", v, protocol.Metadata{})
+		e.SetValue(protocol.PathTarget, "HealthCheckId", protocol.StringValue(v), protocol.Metadata{})
 	}
-	if len(s.ChildHealthChecks) > 0 {
-		v := s.ChildHealthChecks
 
-		e.SetList(protocol.BodyTarget, "ChildHealthChecks", protocol.EncodeStringList(v), protocol.Metadata{ListLocationName: "ChildHealthCheck"})
-	}
-	if s.EnableSNI != nil {
-		v := *s.EnableSNI
+	e.SetFields(protocol.BodyTarget, "UpdateHealthCheckRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.AlarmIdentifier != nil {
+			v := s.AlarmIdentifier
 
-		e.SetValue(protocol.BodyTarget, "EnableSNI", protocol.BoolValue(v), protocol.Metadata{})
-	}
-	if s.FailureThreshold != nil {
-		v := *s.FailureThreshold
+			e.SetFields(protocol.BodyTarget, "AlarmIdentifier", v, protocol.Metadata{})
+		}
+		if len(s.ChildHealthChecks) > 0 {
+			v := s.ChildHealthChecks
 
-		e.SetValue(protocol.BodyTarget, "FailureThreshold", protocol.Int64Value(v), protocol.Metadata{})
-	}
-	if s.FullyQualifiedDomainName != nil {
-		v := *s.FullyQualifiedDomainName
+			e.SetList(protocol.BodyTarget, "ChildHealthChecks", protocol.EncodeStringList(v), protocol.Metadata{ListLocationName: "ChildHealthCheck"})
+		}
+		if s.EnableSNI != nil {
+			v := *s.EnableSNI
 
-		e.SetValue(protocol.BodyTarget, "FullyQualifiedDomainName", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.HealthCheckId != nil {
-		v := *s.HealthCheckId
+			e.SetValue(protocol.BodyTarget, "EnableSNI", protocol.BoolValue(v), protocol.Metadata{})
+		}
+		if s.FailureThreshold != nil {
+			v := *s.FailureThreshold
 
-		e.SetValue(protocol.PathTarget, "HealthCheckId", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.HealthCheckVersion != nil {
-		v := *s.HealthCheckVersion
+			e.SetValue(protocol.BodyTarget, "FailureThreshold", protocol.Int64Value(v), protocol.Metadata{})
+		}
+		if s.FullyQualifiedDomainName != nil {
+			v := *s.FullyQualifiedDomainName
 
-		e.SetValue(protocol.BodyTarget, "HealthCheckVersion", protocol.Int64Value(v), protocol.Metadata{})
-	}
-	if s.HealthThreshold != nil {
-		v := *s.HealthThreshold
+			e.SetValue(protocol.BodyTarget, "FullyQualifiedDomainName", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.HealthCheckVersion != nil {
+			v := *s.HealthCheckVersion
 
-		e.SetValue(protocol.BodyTarget, "HealthThreshold", protocol.Int64Value(v), protocol.Metadata{})
-	}
-	if s.IPAddress != nil {
-		v := *s.IPAddress
+			e.SetValue(protocol.BodyTarget, "HealthCheckVersion", protocol.Int64Value(v), protocol.Metadata{})
+		}
+		if s.HealthThreshold != nil {
+			v := *s.HealthThreshold
 
-		e.SetValue(protocol.BodyTarget, "IPAddress", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.InsufficientDataHealthStatus != nil {
-		v := *s.InsufficientDataHealthStatus
+			e.SetValue(protocol.BodyTarget, "HealthThreshold", protocol.Int64Value(v), protocol.Metadata{})
+		}
+		if s.IPAddress != nil {
+			v := *s.IPAddress
 
-		e.SetValue(protocol.BodyTarget, "InsufficientDataHealthStatus", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.Inverted != nil {
-		v := *s.Inverted
+			e.SetValue(protocol.BodyTarget, "IPAddress", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.InsufficientDataHealthStatus != nil {
+			v := *s.InsufficientDataHealthStatus
 
-		e.SetValue(protocol.BodyTarget, "Inverted", protocol.BoolValue(v), protocol.Metadata{})
-	}
-	if s.Port != nil {
-		v := *s.Port
+			e.SetValue(protocol.BodyTarget, "InsufficientDataHealthStatus", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.Inverted != nil {
+			v := *s.Inverted
 
-		e.SetValue(protocol.BodyTarget, "Port", protocol.Int64Value(v), protocol.Metadata{})
-	}
-	if len(s.Regions) > 0 {
-		v := s.Regions
+			e.SetValue(protocol.BodyTarget, "Inverted", protocol.BoolValue(v), protocol.Metadata{})
+		}
+		if s.Port != nil {
+			v := *s.Port
 
-		e.SetList(protocol.BodyTarget, "Regions", protocol.EncodeStringList(v), protocol.Metadata{ListLocationName: "Region"})
-	}
-	if s.ResourcePath != nil {
-		v := *s.ResourcePath
+			e.SetValue(protocol.BodyTarget, "Port", protocol.Int64Value(v), protocol.Metadata{})
+		}
+		if len(s.Regions) > 0 {
+			v := s.Regions
 
-		e.SetValue(protocol.BodyTarget, "ResourcePath", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.SearchString != nil {
-		v := *s.SearchString
+			e.SetList(protocol.BodyTarget, "Regions", protocol.EncodeStringList(v), protocol.Metadata{ListLocationName: "Region"})
+		}
+		if s.ResourcePath != nil {
+			v := *s.ResourcePath
 
-		e.SetValue(protocol.BodyTarget, "SearchString", protocol.StringValue(v), protocol.Metadata{})
-	}
+			e.SetValue(protocol.BodyTarget, "ResourcePath", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.SearchString != nil {
+			v := *s.SearchString
+
+			e.SetValue(protocol.BodyTarget, "SearchString", protocol.StringValue(v), protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
 
 	return nil
 }
@@ -16163,6 +17470,24 @@ func (s *UpdateHostedZoneCommentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateHostedZoneCommentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateHostedZoneCommentInput"}
+	if s.Comment != nil && len(*s.Comment) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Comment", 256))
+	}
+	if s.Id != nil && len(*s.Id) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 32))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComment sets the Comment field's value.
 func (s *UpdateHostedZoneCommentInput) SetComment(v string) *UpdateHostedZoneCommentInput {
 	s.Comment = &v
@@ -16177,17 +17502,22 @@ func (s *UpdateHostedZoneCommentInput) SetId(v string) *UpdateHostedZoneCommentI
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *UpdateHostedZoneCommentInput) MarshalFields(e protocol.FieldEncoder) error {
-	if s.Comment != nil {
-		v := *s.Comment
-
-		e.SetValue(protocol.BodyTarget, "Comment", protocol.StringValue(v), protocol.Metadata{})
-	}
 	if s.Id != nil {
 		v := *s.Id
 
 		e.SetValue(protocol.PathTarget, "Id", protocol.StringValue(v), protocol.Metadata{})
 	}
 
+	e.SetFields(protocol.BodyTarget, "UpdateHostedZoneCommentRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.Comment != nil {
+			v := *s.Comment
+
+			e.SetValue(protocol.BodyTarget, "Comment", protocol.StringValue(v), protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
+
 	return nil
 }
 
@@ -16289,6 +17619,27 @@ func (s *UpdateTrafficPolicyCommentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateTrafficPolicyCommentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateTrafficPolicyCommentInput"}
+	if s.Comment != nil && len(*s.Comment) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Comment", 1024))
+	}
+	if s.Id != nil && len(*s.Id) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 36))
+	}
+	if s.Version != nil && *s.Version > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("Version", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComment sets the Comment field's value.
 func (s *UpdateTrafficPolicyCommentInput) SetComment(v string) *UpdateTrafficPolicyCommentInput {
 	s.Comment = &v
@@ -16309,11 +17660,6 @@ func (s *UpdateTrafficPolicyCommentInput) SetVersion(v int64) *UpdateTrafficPoli
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *UpdateTrafficPolicyCommentInput) MarshalFields(e protocol.FieldEncoder) error {
-	if s.Comment != nil {
-		v := *s.Comment
-
-		e.SetValue(protocol.BodyTarget, "Comment", protocol.StringValue(v), protocol.Metadata{})
-	}
 	if s.Id != nil {
 		v := *s.Id
 
@@ -16325,6 +17671,16 @@ func (s *UpdateTrafficPolicyCommentInput) MarshalFields(e protocol.FieldEncoder)
 		e.SetValue(protocol.PathTarget, "Version", protocol.Int64Value(v), protocol.Metadata{})
 	}
 
+	e.SetFields(protocol.BodyTarget, "UpdateTrafficPolicyCommentRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.Comment != nil {
+			v := *s.Comment
+
+			e.SetValue(protocol.BodyTarget, "Comment", protocol.StringValue(v), protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
+
 	return nil
 }
 
@@ -16437,6 +17793,30 @@ func (s *UpdateTrafficPolicyInstanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateTrafficPolicyInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateTrafficPolicyInstanceInput"}
+	if s.Id != nil && len(*s.Id) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 36))
+	}
+	if s.TTL != nil && *s.TTL > 2.147483647e+09 {
+		invalidParams.Add(request.NewErrParamMaxValue("TTL", 2.147483647e+09))
+	}
+	if s.TrafficPolicyId != nil && len(*s.TrafficPolicyId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("TrafficPolicyId", 36))
+	}
+	if s.TrafficPolicyVersion != nil && *s.TrafficPolicyVersion > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("TrafficPolicyVersion", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *UpdateTrafficPolicyInstanceInput) SetId(v string) *UpdateTrafficPolicyInstanceInput {
 	s.Id = &v
@@ -16468,21 +17848,26 @@ func (s *UpdateTrafficPolicyInstanceInput) MarshalFields(e protocol.FieldEncoder
 
 		e.SetValue(protocol.PathTarget, "Id", protocol.StringValue(v), protocol.Metadata{})
 	}
-	if s.TTL != nil {
-		v := *s.TTL
 
-		e.SetValue(protocol.BodyTarget, "TTL", protocol.Int64Value(v), protocol.Metadata{})
-	}
-	if s.TrafficPolicyId != nil {
-		v := *s.TrafficPolicyId
+	e.SetFields(protocol.BodyTarget, "UpdateTrafficPolicyInstanceRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.TTL != nil {
+			v := *s.TTL
 
-		e.SetValue(protocol.BodyTarget, "TrafficPolicyId", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.TrafficPolicyVersion != nil {
-		v := *s.TrafficPolicyVersion
+			e.SetValue(protocol.BodyTarget, "TTL", protocol.Int64Value(v), protocol.Metadata{})
+		}
+		if s.TrafficPolicyId != nil {
+			v := *s.TrafficPolicyId
 
-		e.SetValue(protocol.BodyTarget, "TrafficPolicyVersion", protocol.Int64Value(v), protocol.Metadata{})
-	}
+			e.SetValue(protocol.BodyTarget, "TrafficPolicyId", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.TrafficPolicyVersion != nil {
+			v := *s.TrafficPolicyVersion
+
+			e.SetValue(protocol.BodyTarget, "TrafficPolicyVersion", protocol.Int64Value(v), protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "https://route53.amazonaws.com/doc/2013-04-01/"})
 
 	return nil
 }
@@ -16562,6 +17947,30 @@ func (s *VPC) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *VPC) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "VPC"}
+	if s.VPCId != nil && len(*s.VPCId) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("VPCId", 1024))
+	}
+	if s.VPCRegion != nil && len(*s.VPCRegion) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("VPCRegion", 64))
+	}
+	if s.VPCRegion != nil {
+		values := []string{"us-east-1", "us-east-2", "us-west-1", "us-west-2", "eu-west-1", "eu-west-2", "eu-central-1", "ap-southeast-1", "ap-southeast-2", "ap-south-1", "ap-northeast-1", "ap-northeast-2", "sa-east-1", "ca-central-1", "cn-north-1"}
+		if !request.IsParamEnumValue(*s.VPCRegion, values) {
+			invalidParams.Add(request.NewErrParamEnum("VPCRegion", *s.VPCRegion, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetVPCId sets the VPCId field's value.
 func (s *VPC) SetVPCId(v string) *VPC {
 	s.VPCId = &v
@@ -1,6 +1,7 @@
 package route53_test
 
 import (
+	"io/ioutil"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -33,3 +34,24 @@ func TestBuildCorrectURI(t *testing.T) {
 		t.Errorf("expect query to be %q, got %q", e, a)
 	}
 }
+
+func TestBuildXMLRootElement(t *testing.T) {
+	svc := route53.New(unit.Session)
+	req, _ := svc.UpdateHostedZoneCommentRequest(&route53.UpdateHostedZoneCommentInput{
+		Id:      aws.String("ABCDEFG"),
+		Comment: aws.String("comment"),
+	})
+	if err := req.Build(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	b, err := ioutil.ReadAll(req.HTTPRequest.Body)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	expect := `<UpdateHostedZoneCommentRequest xmlns="https://route53.amazonaws.com/doc/2013-04-01/">` +
+		`<Comment>comment</Comment></UpdateHostedZoneCommentRequest>`
+	if e, a := expect, string(b); e != a {
+		t.Errorf("expect %v body, got %v", e, a)
+	}
+}
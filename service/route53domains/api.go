@@ -688,9 +688,10 @@ const opGetDomainDetail = "GetDomainDetail"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/route53domains-2014-05-15/GetDomainDetail
 func (c *Route53Domains) GetDomainDetailRequest(input *GetDomainDetailInput) (req *request.Request, output *GetDomainDetailOutput) {
 	op := &request.Operation{
-		Name:       opGetDomainDetail,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opGetDomainDetail,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"AdminContact", "RegistrantContact", "TechContact"},
 	}
 
 	if input == nil {
@@ -1620,9 +1621,10 @@ const opRetrieveDomainAuthCode = "RetrieveDomainAuthCode"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/route53domains-2014-05-15/RetrieveDomainAuthCode
 func (c *Route53Domains) RetrieveDomainAuthCodeRequest(input *RetrieveDomainAuthCodeInput) (req *request.Request, output *RetrieveDomainAuthCodeOutput) {
 	op := &request.Operation{
-		Name:       opRetrieveDomainAuthCode,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opRetrieveDomainAuthCode,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"AuthCode"},
 	}
 
 	if input == nil {
@@ -2385,6 +2387,27 @@ func (s *CheckDomainAvailabilityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CheckDomainAvailabilityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CheckDomainAvailabilityInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+	if s.IdnLangCode != nil && len(*s.IdnLangCode) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdnLangCode", 3))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *CheckDomainAvailabilityInput) SetDomainName(v string) *CheckDomainAvailabilityInput {
 	s.DomainName = &v
@@ -2454,7 +2477,7 @@ func (s *CheckDomainAvailabilityOutput) SetAvailability(v string) *CheckDomainAv
 // ContactDetail includes the following elements.
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/route53domains-2014-05-15/ContactDetail
 type ContactDetail struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" sensitive:"true"`
 
 	// First line of the contact's address.
 	AddressLine1 *string `type:"string"`
@@ -2539,6 +2562,73 @@ func (s *ContactDetail) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ContactDetail) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ContactDetail"}
+	if s.AddressLine1 != nil && len(*s.AddressLine1) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("AddressLine1", 255))
+	}
+	if s.AddressLine2 != nil && len(*s.AddressLine2) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("AddressLine2", 255))
+	}
+	if s.City != nil && len(*s.City) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("City", 255))
+	}
+	if s.ContactType != nil {
+		values := []string{"PERSON", "COMPANY", "ASSOCIATION", "PUBLIC_BODY", "RESELLER"}
+		if !request.IsParamEnumValue(*s.ContactType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ContactType", *s.ContactType, values))
+		}
+	}
+	if s.CountryCode != nil {
+		values := []string{"AD", "AE", "AF", "AG", "AI", "AL", "AM", "AN", "AO", "AQ", "AR", "AS", "AT", "AU", "AW", "AZ", "BA", "BB", "BD", "BE", "BF", "BG", "BH", "BI", "BJ", "BL", "BM", "BN", "BO", "BR", "BS", "BT", "BW", "BY", "BZ", "CA", "CC", "CD", "CF", "CG", "CH", "CI", "CK", "CL", "CM", "CN", "CO", "CR", "CU", "CV", "CX", "CY", "CZ", "DE", "DJ", "DK", "DM", "DO", "DZ", "EC", "EE", "EG", "ER", "ES", "ET", "FI", "FJ", "FK", "FM", "FO", "FR", "GA", "GB", "GD", "GE", "GH", "GI", "GL", "GM", "GN", "GQ", "GR", "GT", "GU", "GW", "GY", "HK", "HN", "HR", "HT", "HU", "ID", "IE", "IL", "IM", "IN", "IQ", "IR", "IS", "IT", "JM", "JO", "JP", "KE", "KG", "KH", "KI", "KM", "KN", "KP", "KR", "KW", "KY", "KZ", "LA", "LB", "LC", "LI", "LK", "LR", "LS", "LT", "LU", "LV", "LY", "MA", "MC", "MD", "ME", "MF", "MG", "MH", "MK", "ML", "MM", "MN", "MO", "MP", "MR", "MS", "MT", "MU", "MV", "MW", "MX", "MY", "MZ", "NA", "NC", "NE", "NG", "NI", "NL", "NO", "NP", "NR", "NU", "NZ", "OM", "PA", "PE", "PF", "PG", "PH", "PK", "PL", "PM", "PN", "PR", "PT", "PW", "PY", "QA", "RO", "RS", "RU", "RW", "SA", "SB", "SC", "SD", "SE", "SG", "SH", "SI", "SK", "SL", "SM", "SN", "SO", "SR", "ST", "SV", "SY", "SZ", "TC", "TD", "TG", "TH", "TJ", "TK", "TL", "TM", "TN", "TO", "TR", "TT", "TV", "TW", "TZ", "UA", "UG", "US", "UY", "UZ", "VA", "VC", "VE", "VG", "VI", "VN", "VU", "WF", "WS", "YE", "YT", "ZA", "ZM", "ZW"}
+		if !request.IsParamEnumValue(*s.CountryCode, values) {
+			invalidParams.Add(request.NewErrParamEnum("CountryCode", *s.CountryCode, values))
+		}
+	}
+	if s.Email != nil && len(*s.Email) > 254 {
+		invalidParams.Add(request.NewErrParamMaxLen("Email", 254))
+	}
+	if s.Fax != nil && len(*s.Fax) > 30 {
+		invalidParams.Add(request.NewErrParamMaxLen("Fax", 30))
+	}
+	if s.FirstName != nil && len(*s.FirstName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("FirstName", 255))
+	}
+	if s.LastName != nil && len(*s.LastName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("LastName", 255))
+	}
+	if s.OrganizationName != nil && len(*s.OrganizationName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("OrganizationName", 255))
+	}
+	if s.PhoneNumber != nil && len(*s.PhoneNumber) > 30 {
+		invalidParams.Add(request.NewErrParamMaxLen("PhoneNumber", 30))
+	}
+	if s.State != nil && len(*s.State) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("State", 255))
+	}
+	if s.ZipCode != nil && len(*s.ZipCode) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("ZipCode", 255))
+	}
+	if s.ExtraParams != nil {
+		for i, v := range s.ExtraParams {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ExtraParams", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddressLine1 sets the AddressLine1 field's value.
 func (s *ContactDetail) SetAddressLine1(v string) *ContactDetail {
 	s.AddressLine1 = &v
@@ -2665,6 +2755,24 @@ func (s *DeleteTagsForDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteTagsForDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTagsForDomainInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DeleteTagsForDomainInput) SetDomainName(v string) *DeleteTagsForDomainInput {
 	s.DomainName = &v
@@ -2725,6 +2833,24 @@ func (s *DisableDomainAutoRenewInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisableDomainAutoRenewInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableDomainAutoRenewInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DisableDomainAutoRenewInput) SetDomainName(v string) *DisableDomainAutoRenewInput {
 	s.DomainName = &v
@@ -2780,6 +2906,24 @@ func (s *DisableDomainTransferLockInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisableDomainTransferLockInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableDomainTransferLockInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *DisableDomainTransferLockInput) SetDomainName(v string) *DisableDomainTransferLockInput {
 	s.DomainName = &v
@@ -2963,6 +3107,24 @@ func (s *EnableDomainAutoRenewInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnableDomainAutoRenewInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableDomainAutoRenewInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *EnableDomainAutoRenewInput) SetDomainName(v string) *EnableDomainAutoRenewInput {
 	s.DomainName = &v
@@ -3018,6 +3180,24 @@ func (s *EnableDomainTransferLockInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EnableDomainTransferLockInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EnableDomainTransferLockInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *EnableDomainTransferLockInput) SetDomainName(v string) *EnableDomainTransferLockInput {
 	s.DomainName = &v
@@ -3095,6 +3275,27 @@ func (s *ExtraParam) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ExtraParam) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ExtraParam"}
+	if s.Name != nil {
+		values := []string{"DUNS_NUMBER", "BRAND_NUMBER", "BIRTH_DEPARTMENT", "BIRTH_DATE_IN_YYYY_MM_DD", "BIRTH_COUNTRY", "BIRTH_CITY", "DOCUMENT_NUMBER", "AU_ID_NUMBER", "AU_ID_TYPE", "CA_LEGAL_TYPE", "CA_BUSINESS_ENTITY_TYPE", "ES_IDENTIFICATION", "ES_IDENTIFICATION_TYPE", "ES_LEGAL_FORM", "FI_BUSINESS_NUMBER", "FI_ID_NUMBER", "IT_PIN", "RU_PASSPORT_DATA", "SE_ID_NUMBER", "SG_ID_NUMBER", "VAT_NUMBER"}
+		if !request.IsParamEnumValue(*s.Name, values) {
+			invalidParams.Add(request.NewErrParamEnum("Name", *s.Name, values))
+		}
+	}
+	if s.Value != nil && len(*s.Value) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *ExtraParam) SetName(v string) *ExtraParam {
 	s.Name = &v
@@ -3126,6 +3327,24 @@ func (s GetContactReachabilityStatusInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetContactReachabilityStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetContactReachabilityStatusInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *GetContactReachabilityStatusInput) SetDomainName(v string) *GetContactReachabilityStatusInput {
 	s.DomainName = &v
@@ -3205,6 +3424,24 @@ func (s *GetDomainDetailInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDomainDetailInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDomainDetailInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *GetDomainDetailInput) SetDomainName(v string) *GetDomainDetailInput {
 	s.DomainName = &v
@@ -3227,7 +3464,7 @@ type GetDomainDetailOutput struct {
 	// Provides details about the domain administrative contact.
 	//
 	// AdminContact is a required field
-	AdminContact *ContactDetail `type:"structure" required:"true"`
+	AdminContact *ContactDetail `type:"structure" required:"true" sensitive:"true"`
 
 	// Specifies whether contact information for the admin contact is concealed
 	// from WHOIS queries. If the value is true, WHOIS ("who is") queries will return
@@ -3262,7 +3499,7 @@ type GetDomainDetailOutput struct {
 	// Provides details about the domain registrant.
 	//
 	// RegistrantContact is a required field
-	RegistrantContact *ContactDetail `type:"structure" required:"true"`
+	RegistrantContact *ContactDetail `type:"structure" required:"true" sensitive:"true"`
 
 	// Specifies whether contact information for the registrant contact is concealed
 	// from WHOIS queries. If the value is true, WHOIS ("who is") queries will return
@@ -3303,7 +3540,7 @@ type GetDomainDetailOutput struct {
 	// Provides details about the domain technical contact.
 	//
 	// TechContact is a required field
-	TechContact *ContactDetail `type:"structure" required:"true"`
+	TechContact *ContactDetail `type:"structure" required:"true" sensitive:"true"`
 
 	// Specifies whether contact information for the tech contact is concealed from
 	// WHOIS queries. If the value is true, WHOIS ("who is") queries will return
@@ -3513,6 +3750,24 @@ func (s *GetDomainSuggestionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDomainSuggestionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDomainSuggestionsInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *GetDomainSuggestionsInput) SetDomainName(v string) *GetDomainSuggestionsInput {
 	s.DomainName = &v
@@ -3591,6 +3846,21 @@ func (s *GetOperationDetailInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetOperationDetailInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetOperationDetailInput"}
+	if s.OperationId != nil && len(*s.OperationId) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("OperationId", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetOperationId sets the OperationId field's value.
 func (s *GetOperationDetailInput) SetOperationId(v string) *GetOperationDetailInput {
 	s.OperationId = &v
@@ -3698,6 +3968,24 @@ func (s ListDomainsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListDomainsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDomainsInput"}
+	if s.Marker != nil && len(*s.Marker) > 4096 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 4096))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListDomainsInput) SetMarker(v string) *ListDomainsInput {
 	s.Marker = &v
@@ -3777,6 +4065,24 @@ func (s ListOperationsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListOperationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListOperationsInput"}
+	if s.Marker != nil && len(*s.Marker) > 4096 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 4096))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMarker sets the Marker field's value.
 func (s *ListOperationsInput) SetMarker(v string) *ListOperationsInput {
 	s.Marker = &v
@@ -3861,6 +4167,24 @@ func (s *ListTagsForDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsForDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForDomainInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *ListTagsForDomainInput) SetDomainName(v string) *ListTagsForDomainInput {
 	s.DomainName = &v
@@ -3938,6 +4262,24 @@ func (s *Nameserver) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Nameserver) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Nameserver"}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[a-zA-Z0-9_\\-.]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGlueIps sets the GlueIps field's value.
 func (s *Nameserver) SetGlueIps(v []*string) *Nameserver {
 	s.GlueIps = v
@@ -4018,7 +4360,7 @@ type RegisterDomainInput struct {
 	// Provides detailed contact information.
 	//
 	// AdminContact is a required field
-	AdminContact *ContactDetail `type:"structure" required:"true"`
+	AdminContact *ContactDetail `type:"structure" required:"true" sensitive:"true"`
 
 	// Indicates whether the domain will be automatically renewed (true) or not
 	// (false). Autorenewal only takes effect after the account is charged.
@@ -4076,12 +4418,12 @@ type RegisterDomainInput struct {
 	// Provides detailed contact information.
 	//
 	// RegistrantContact is a required field
-	RegistrantContact *ContactDetail `type:"structure" required:"true"`
+	RegistrantContact *ContactDetail `type:"structure" required:"true" sensitive:"true"`
 
 	// Provides detailed contact information.
 	//
 	// TechContact is a required field
-	TechContact *ContactDetail `type:"structure" required:"true"`
+	TechContact *ContactDetail `type:"structure" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -4137,6 +4479,45 @@ func (s *RegisterDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterDomainInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+	if s.DurationInYears != nil && *s.DurationInYears > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("DurationInYears", 10))
+	}
+	if s.IdnLangCode != nil && len(*s.IdnLangCode) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdnLangCode", 3))
+	}
+	if s.AdminContact != nil {
+		if err := s.AdminContact.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AdminContact", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RegistrantContact != nil {
+		if err := s.RegistrantContact.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RegistrantContact", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TechContact != nil {
+		if err := s.TechContact.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TechContact", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdminContact sets the AdminContact field's value.
 func (s *RegisterDomainInput) SetAdminContact(v *ContactDetail) *RegisterDomainInput {
 	s.AdminContact = v
@@ -4280,6 +4661,27 @@ func (s *RenewDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RenewDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RenewDomainInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+	if s.DurationInYears != nil && *s.DurationInYears > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("DurationInYears", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCurrentExpiryYear sets the CurrentExpiryYear field's value.
 func (s *RenewDomainInput) SetCurrentExpiryYear(v int64) *RenewDomainInput {
 	s.CurrentExpiryYear = &v
@@ -4344,6 +4746,24 @@ func (s ResendContactReachabilityEmailInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResendContactReachabilityEmailInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResendContactReachabilityEmailInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *ResendContactReachabilityEmailInput) SetDomainName(v string) *ResendContactReachabilityEmailInput {
 	s.DomainName = &v
@@ -4430,6 +4850,24 @@ func (s *RetrieveDomainAuthCodeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RetrieveDomainAuthCodeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RetrieveDomainAuthCodeInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *RetrieveDomainAuthCodeInput) SetDomainName(v string) *RetrieveDomainAuthCodeInput {
 	s.DomainName = &v
@@ -4444,7 +4882,7 @@ type RetrieveDomainAuthCodeOutput struct {
 	// The authorization code for the domain.
 	//
 	// AuthCode is a required field
-	AuthCode *string `type:"string" required:"true"`
+	AuthCode *string `type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -4513,11 +4951,11 @@ type TransferDomainInput struct {
 	// Provides detailed contact information.
 	//
 	// AdminContact is a required field
-	AdminContact *ContactDetail `type:"structure" required:"true"`
+	AdminContact *ContactDetail `type:"structure" required:"true" sensitive:"true"`
 
 	// The authorization code for the domain. You get this value from the current
 	// registrar.
-	AuthCode *string `type:"string"`
+	AuthCode *string `type:"string" sensitive:"true"`
 
 	// Indicates whether the domain will be automatically renewed (true) or not
 	// (false). Autorenewal only takes effect after the account is charged.
@@ -4576,12 +5014,12 @@ type TransferDomainInput struct {
 	// Provides detailed contact information.
 	//
 	// RegistrantContact is a required field
-	RegistrantContact *ContactDetail `type:"structure" required:"true"`
+	RegistrantContact *ContactDetail `type:"structure" required:"true" sensitive:"true"`
 
 	// Provides detailed contact information.
 	//
 	// TechContact is a required field
-	TechContact *ContactDetail `type:"structure" required:"true"`
+	TechContact *ContactDetail `type:"structure" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -4647,6 +5085,58 @@ func (s *TransferDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TransferDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TransferDomainInput"}
+	if s.AuthCode != nil && len(*s.AuthCode) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthCode", 1024))
+	}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+	if s.DurationInYears != nil && *s.DurationInYears > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("DurationInYears", 10))
+	}
+	if s.IdnLangCode != nil && len(*s.IdnLangCode) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdnLangCode", 3))
+	}
+	if s.AdminContact != nil {
+		if err := s.AdminContact.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AdminContact", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Nameservers != nil {
+		for i, v := range s.Nameservers {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Nameservers", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.RegistrantContact != nil {
+		if err := s.RegistrantContact.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RegistrantContact", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TechContact != nil {
+		if err := s.TechContact.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TechContact", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdminContact sets the AdminContact field's value.
 func (s *TransferDomainInput) SetAdminContact(v *ContactDetail) *TransferDomainInput {
 	s.AdminContact = v
@@ -4753,7 +5243,7 @@ type UpdateDomainContactInput struct {
 	_ struct{} `type:"structure"`
 
 	// Provides detailed contact information.
-	AdminContact *ContactDetail `type:"structure"`
+	AdminContact *ContactDetail `type:"structure" sensitive:"true"`
 
 	// The name of the domain that you want to update contact information for.
 	//
@@ -4761,10 +5251,10 @@ type UpdateDomainContactInput struct {
 	DomainName *string `type:"string" required:"true"`
 
 	// Provides detailed contact information.
-	RegistrantContact *ContactDetail `type:"structure"`
+	RegistrantContact *ContactDetail `type:"structure" sensitive:"true"`
 
 	// Provides detailed contact information.
-	TechContact *ContactDetail `type:"structure"`
+	TechContact *ContactDetail `type:"structure" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -4805,6 +5295,39 @@ func (s *UpdateDomainContactInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDomainContactInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDomainContactInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+	if s.AdminContact != nil {
+		if err := s.AdminContact.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AdminContact", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RegistrantContact != nil {
+		if err := s.RegistrantContact.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RegistrantContact", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TechContact != nil {
+		if err := s.TechContact.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TechContact", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdminContact sets the AdminContact field's value.
 func (s *UpdateDomainContactInput) SetAdminContact(v *ContactDetail) *UpdateDomainContactInput {
 	s.AdminContact = v
@@ -4909,6 +5432,24 @@ func (s *UpdateDomainContactPrivacyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDomainContactPrivacyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDomainContactPrivacyInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdminPrivacy sets the AdminPrivacy field's value.
 func (s *UpdateDomainContactPrivacyInput) SetAdminPrivacy(v bool) *UpdateDomainContactPrivacyInput {
 	s.AdminPrivacy = &v
@@ -5022,6 +5563,34 @@ func (s *UpdateDomainNameserversInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDomainNameserversInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDomainNameserversInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+	if s.Nameservers != nil {
+		for i, v := range s.Nameservers {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Nameservers", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *UpdateDomainNameserversInput) SetDomainName(v string) *UpdateDomainNameserversInput {
 	s.DomainName = &v
@@ -5106,6 +5675,24 @@ func (s *UpdateTagsForDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateTagsForDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateTagsForDomainInput"}
+	if s.DomainName != nil && len(*s.DomainName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("DomainName", 255))
+	}
+	if s.DomainName != nil && !request.MatchParamPattern("[a-zA-Z0-9_\\-.]*", *s.DomainName) {
+		invalidParams.Add(request.NewErrParamPattern("DomainName", "[a-zA-Z0-9_\\-.]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomainName sets the DomainName field's value.
 func (s *UpdateTagsForDomainInput) SetDomainName(v string) *UpdateTagsForDomainInput {
 	s.DomainName = &v
@@ -5173,6 +5760,24 @@ func (s ViewBillingInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ViewBillingInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ViewBillingInput"}
+	if s.Marker != nil && len(*s.Marker) > 4096 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 4096))
+	}
+	if s.MaxItems != nil && *s.MaxItems > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEnd sets the End field's value.
 func (s *ViewBillingInput) SetEnd(v time.Time) *ViewBillingInput {
 	s.End = &v
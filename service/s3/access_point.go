@@ -0,0 +1,215 @@
+package s3
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// ErrCodeInvalidARN is the error code of requests whose Bucket is an ARN
+	// which is not a valid access point, or outposts access point, ARN.
+	ErrCodeInvalidARN = "InvalidARNError"
+
+	// ErrCodeARNRegionMismatch is the error code of requests whose Bucket is
+	// an access point ARN of a region other than the client's region, and
+	// the client's S3UseARNRegion option is not set.
+	ErrCodeARNRegionMismatch = "ARNRegionMismatchError"
+
+	// ErrCodeARNPartitionMismatch is the error code of requests whose Bucket
+	// is an access point ARN of a partition other than the partition of the
+	// client's region.
+	ErrCodeARNPartitionMismatch = "ARNPartitionMismatchError"
+
+	// ErrCodeARNWithAccelerate is the error code of requests whose Bucket is
+	// an access point ARN, made by a client with S3UseAccelerate set.
+	ErrCodeARNWithAccelerate = "ARNWithAccelerateError"
+
+	// ErrCodeARNWithPathStyle is the error code of requests whose Bucket is
+	// an access point ARN, made by a client with S3ForcePathStyle set.
+	ErrCodeARNWithPathStyle = "ARNWithPathStyleError"
+
+	// ErrCodeARNWithDualStack is the error code of requests whose Bucket is an
+	// outposts access point ARN, made by a client with dualstack endpoints
+	// enabled.
+	ErrCodeARNWithDualStack = "ARNWithDualStackError"
+)
+
+const outpostsSigningName = "s3-outposts"
+
+var (
+	reAccessPointName = regexp.MustCompile(`^[a-z0-9]([a-z0-9\-]{0,48}[a-z0-9])?$`)
+	reOutpostID       = regexp.MustCompile(`^[a-zA-Z0-9\-]{1,63}$`)
+	reAccountID       = regexp.MustCompile(`^[0-9]{12}$`)
+	reARNRegion       = regexp.MustCompile(`^[a-z0-9\-]{1,63}$`)
+)
+
+// accessPointARN is a parsed access point, or outposts access point, ARN.
+type accessPointARN struct {
+	arn.ARN
+
+	// The name of the access point.
+	Name string
+
+	// The ID of the outpost of an outposts access point, empty for access
+	// point ARNs.
+	OutpostID string
+}
+
+// parseAccessPointARN parses the access point, or outposts access point, ARN.
+// The resource of the ARN may be delimited by "/" or ":".
+//
+//     arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint
+//     arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01234567890123456/accesspoint/myendpoint
+func parseAccessPointARN(bucket string) (accessPointARN, error) {
+	a, err := arn.Parse(bucket)
+	if err != nil {
+		return accessPointARN{}, err
+	}
+
+	parts := strings.FieldsFunc(a.Resource, func(r rune) bool {
+		return r == '/' || r == ':'
+	})
+
+	ap := accessPointARN{ARN: a}
+	switch {
+	case a.Service == "s3" && len(parts) == 2 && parts[0] == "accesspoint":
+		ap.Name = parts[1]
+	case a.Service == outpostsSigningName && len(parts) == 4 &&
+		parts[0] == "outpost" && parts[2] == "accesspoint":
+		ap.OutpostID, ap.Name = parts[1], parts[3]
+		if !reOutpostID.MatchString(ap.OutpostID) {
+			return accessPointARN{}, fmt.Errorf("invalid outpost ID %s", ap.OutpostID)
+		}
+	default:
+		return accessPointARN{}, fmt.Errorf("resource %s is not an access point", a.Resource)
+	}
+
+	if !reARNRegion.MatchString(a.Region) {
+		return accessPointARN{}, fmt.Errorf("invalid region %q", a.Region)
+	}
+	if !reAccountID.MatchString(a.AccountID) {
+		return accessPointARN{}, fmt.Errorf("invalid account ID %q", a.AccountID)
+	}
+	if !reAccessPointName.MatchString(ap.Name) {
+		return accessPointARN{}, fmt.Errorf("invalid access point name %s", ap.Name)
+	}
+
+	return ap, nil
+}
+
+// updateEndpointForAccessPoint updates the request's endpoint to the endpoint
+// of the access point, and the request's signing region to the access point's
+// region, if the request's bucket is an access point, or outposts access
+// point, ARN. Requests to outposts access points are signed with the
+// s3-outposts signing name. Returns false if the bucket is not an ARN.
+func updateEndpointForAccessPoint(r *request.Request) bool {
+	bucket, ok := bucketNameFromReqParams(r.Params)
+	if !ok || !strings.HasPrefix(bucket, "arn:") {
+		return false
+	}
+
+	ap, err := parseAccessPointARN(bucket)
+	if err != nil {
+		r.Error = awserr.New(ErrCodeInvalidARN,
+			fmt.Sprintf("invalid access point ARN %s", bucket), err)
+		return true
+	}
+
+	if aws.BoolValue(r.Config.S3UseAccelerate) {
+		r.Error = awserr.New(ErrCodeARNWithAccelerate,
+			"S3 Accelerate is not supported by access points", nil)
+		return true
+	}
+	if aws.BoolValue(r.Config.S3ForcePathStyle) {
+		r.Error = awserr.New(ErrCodeARNWithPathStyle,
+			"access points are not compatible with aws.Config.S3ForcePathStyle", nil)
+		return true
+	}
+
+	dualStack := aws.BoolValue(r.Config.UseDualStack) || aws.BoolValue(r.Config.UseDualStackEndpoint)
+	if dualStack && len(ap.OutpostID) != 0 {
+		r.Error = awserr.New(ErrCodeARNWithDualStack,
+			"dualstack endpoints are not supported by outposts access points", nil)
+		return true
+	}
+
+	dnsSuffix, err := accessPointDNSSuffix(r, ap)
+	if err != nil {
+		r.Error = err
+		return true
+	}
+
+	u := r.HTTPRequest.URL
+	host := ap.Name + "-" + ap.AccountID
+	if len(ap.OutpostID) != 0 {
+		host += "." + ap.OutpostID
+	}
+	switch {
+	case len(aws.StringValue(r.Config.Endpoint)) != 0:
+		// Custom endpoints are prefixed with the access point's host labels.
+		host += "." + u.Host
+	case len(ap.OutpostID) != 0:
+		host += ".s3-outposts." + ap.Region + "." + dnsSuffix
+	default:
+		host += ".s3-accesspoint"
+		if dualStack {
+			host += ".dualstack"
+		}
+		host += "." + ap.Region + "." + dnsSuffix
+	}
+	u.Host = host
+	u.Path = strings.Replace(u.Path, "/{Bucket}", "", -1)
+	if u.Path == "" {
+		u.Path = "/"
+	}
+
+	r.ClientInfo.SigningRegion = ap.Region
+	if len(ap.OutpostID) != 0 {
+		r.ClientInfo.SigningName = outpostsSigningName
+	}
+
+	return true
+}
+
+// accessPointDNSSuffix returns the DNS suffix of the access point ARN's
+// partition, validating the ARN's partition and region with the client's
+// region.
+func accessPointDNSSuffix(r *request.Request, ap accessPointARN) (string, error) {
+	partitions := endpoints.DefaultPartitions()
+
+	var dnsSuffix string
+	for _, p := range partitions {
+		if p.ID() == ap.Partition {
+			dnsSuffix = p.DNSSuffix()
+		}
+	}
+	if len(dnsSuffix) == 0 {
+		return "", awserr.New(ErrCodeInvalidARN,
+			fmt.Sprintf("unknown partition %s of access point ARN", ap.Partition), nil)
+	}
+	if p, ok := endpoints.PartitionForRegion(partitions, ap.Region); ok && p.ID() != ap.Partition {
+		return "", awserr.New(ErrCodeInvalidARN,
+			fmt.Sprintf("region %s of access point ARN is not in partition %s", ap.Region, ap.Partition), nil)
+	}
+
+	region := aws.StringValue(r.Config.Region)
+	if p, ok := endpoints.PartitionForRegion(partitions, region); ok && p.ID() != ap.Partition {
+		return "", awserr.New(ErrCodeARNPartitionMismatch,
+			fmt.Sprintf("access point ARN partition %s does not match the client's partition %s",
+				ap.Partition, p.ID()), nil)
+	}
+	if ap.Region != region && !aws.BoolValue(r.Config.S3UseARNRegion) {
+		return "", awserr.New(ErrCodeARNRegionMismatch,
+			fmt.Sprintf("access point ARN region %s does not match the client's region %s, "+
+				"set aws.Config.S3UseARNRegion to make cross-region requests", ap.Region, region), nil)
+	}
+
+	return dnsSuffix, nil
+}
@@ -0,0 +1,182 @@
+package s3_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestAccessPoint(t *testing.T) {
+	cases := map[string]struct {
+		Config           *aws.Config
+		Bucket           string
+		ExpectURL        string
+		ExpectCredential string
+		ErrCode          string
+	}{
+		"access point": {
+			Bucket:           "arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint",
+			ExpectURL:        "https://myendpoint-123456789012.s3-accesspoint.us-west-2.amazonaws.com/key",
+			ExpectCredential: "/us-west-2/s3/aws4_request",
+		},
+		"access point colon delimited": {
+			Bucket:           "arn:aws:s3:us-west-2:123456789012:accesspoint:myendpoint",
+			ExpectURL:        "https://myendpoint-123456789012.s3-accesspoint.us-west-2.amazonaws.com/key",
+			ExpectCredential: "/us-west-2/s3/aws4_request",
+		},
+		"china partition": {
+			Config:           &aws.Config{Region: aws.String("cn-north-1")},
+			Bucket:           "arn:aws-cn:s3:cn-north-1:123456789012:accesspoint/myendpoint",
+			ExpectURL:        "https://myendpoint-123456789012.s3-accesspoint.cn-north-1.amazonaws.com.cn/key",
+			ExpectCredential: "/cn-north-1/s3/aws4_request",
+		},
+		"dualstack": {
+			Config:           &aws.Config{UseDualStack: aws.Bool(true)},
+			Bucket:           "arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint",
+			ExpectURL:        "https://myendpoint-123456789012.s3-accesspoint.dualstack.us-west-2.amazonaws.com/key",
+			ExpectCredential: "/us-west-2/s3/aws4_request",
+		},
+		"custom endpoint": {
+			Config:           &aws.Config{Endpoint: aws.String("https://beta.example.com")},
+			Bucket:           "arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint",
+			ExpectURL:        "https://myendpoint-123456789012.beta.example.com/key",
+			ExpectCredential: "/us-west-2/s3/aws4_request",
+		},
+		"cross region": {
+			Config:           &aws.Config{Region: aws.String("us-east-1"), S3UseARNRegion: aws.Bool(true)},
+			Bucket:           "arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint",
+			ExpectURL:        "https://myendpoint-123456789012.s3-accesspoint.us-west-2.amazonaws.com/key",
+			ExpectCredential: "/us-west-2/s3/aws4_request",
+		},
+		"cross region not enabled": {
+			Config:  &aws.Config{Region: aws.String("us-east-1")},
+			Bucket:  "arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint",
+			ErrCode: s3.ErrCodeARNRegionMismatch,
+		},
+		"cross partition": {
+			Config:  &aws.Config{Region: aws.String("cn-north-1"), S3UseARNRegion: aws.Bool(true)},
+			Bucket:  "arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint",
+			ErrCode: s3.ErrCodeARNPartitionMismatch,
+		},
+		"region not in partition": {
+			Bucket:  "arn:aws:s3:cn-north-1:123456789012:accesspoint/myendpoint",
+			ErrCode: s3.ErrCodeInvalidARN,
+		},
+		"accelerate": {
+			Config:  &aws.Config{S3UseAccelerate: aws.Bool(true)},
+			Bucket:  "arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint",
+			ErrCode: s3.ErrCodeARNWithAccelerate,
+		},
+		"force path style": {
+			Config:  &aws.Config{S3ForcePathStyle: aws.Bool(true)},
+			Bucket:  "arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint",
+			ErrCode: s3.ErrCodeARNWithPathStyle,
+		},
+		"invalid resource": {
+			Bucket:  "arn:aws:s3:us-west-2:123456789012:bucket_name:mybucket",
+			ErrCode: s3.ErrCodeInvalidARN,
+		},
+		"invalid access point name": {
+			Bucket:  "arn:aws:s3:us-west-2:123456789012:accesspoint/my_endpoint",
+			ErrCode: s3.ErrCodeInvalidARN,
+		},
+		"invalid account": {
+			Bucket:  "arn:aws:s3:us-west-2:1234:accesspoint/myendpoint",
+			ErrCode: s3.ErrCodeInvalidARN,
+		},
+		"outposts access point": {
+			Bucket:           "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01234567890123456/accesspoint/myaccesspoint",
+			ExpectURL:        "https://myaccesspoint-123456789012.op-01234567890123456.s3-outposts.us-west-2.amazonaws.com/key",
+			ExpectCredential: "/us-west-2/s3-outposts/aws4_request",
+		},
+		"outposts access point colon delimited": {
+			Bucket:           "arn:aws:s3-outposts:us-west-2:123456789012:outpost:op-01234567890123456:accesspoint:myaccesspoint",
+			ExpectURL:        "https://myaccesspoint-123456789012.op-01234567890123456.s3-outposts.us-west-2.amazonaws.com/key",
+			ExpectCredential: "/us-west-2/s3-outposts/aws4_request",
+		},
+		"outposts cross region": {
+			Config:           &aws.Config{Region: aws.String("us-east-1"), S3UseARNRegion: aws.Bool(true)},
+			Bucket:           "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01234567890123456/accesspoint/myaccesspoint",
+			ExpectURL:        "https://myaccesspoint-123456789012.op-01234567890123456.s3-outposts.us-west-2.amazonaws.com/key",
+			ExpectCredential: "/us-west-2/s3-outposts/aws4_request",
+		},
+		"outposts dualstack": {
+			Config:  &aws.Config{UseDualStack: aws.Bool(true)},
+			Bucket:  "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01234567890123456/accesspoint/myaccesspoint",
+			ErrCode: s3.ErrCodeARNWithDualStack,
+		},
+		"outposts missing access point": {
+			Bucket:  "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01234567890123456",
+			ErrCode: s3.ErrCodeInvalidARN,
+		},
+	}
+
+	for name, c := range cases {
+		cfg := &aws.Config{Region: aws.String("us-west-2")}
+		cfg.MergeIn(c.Config)
+
+		svc := s3.New(unit.Session, cfg)
+		req, _ := svc.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String(c.Bucket),
+			Key:    aws.String("key"),
+		})
+
+		err := req.Sign()
+		if len(c.ErrCode) != 0 {
+			aerr, ok := err.(awserr.Error)
+			if !ok {
+				t.Fatalf("%s, expect awserr.Error, got %T, %v", name, err, err)
+			}
+			if e, a := c.ErrCode, aerr.Code(); e != a {
+				t.Errorf("%s, expect %v error code, got %v", name, e, a)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if e, a := c.ExpectURL, req.HTTPRequest.URL.String(); e != a {
+			t.Errorf("%s, expect %v URL, got %v", name, e, a)
+		}
+		if e, a := c.ExpectCredential, req.HTTPRequest.Header.Get("Authorization"); !strings.Contains(a, e) {
+			t.Errorf("%s, expect %v credential scope, got %v", name, e, a)
+		}
+	}
+}
+
+func TestAccessPoint_Presign(t *testing.T) {
+	svc := s3.New(unit.Session, &aws.Config{
+		Region:         aws.String("us-east-1"),
+		S3UseARNRegion: aws.Bool(true),
+	})
+	req, _ := svc.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String("arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint"),
+		Key:    aws.String("key"),
+	})
+
+	urlstr, err := req.Presign(15 * time.Minute)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	u, err := url.Parse(urlstr)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "myendpoint-123456789012.s3-accesspoint.us-west-2.amazonaws.com", u.Host; e != a {
+		t.Errorf("expect %v host, got %v", e, a)
+	}
+	if e, a := "/key", u.Path; e != a {
+		t.Errorf("expect %v path, got %v", e, a)
+	}
+	if e, a := "/us-west-2/s3/aws4_request", u.Query().Get("X-Amz-Credential"); !strings.HasSuffix(a, e) {
+		t.Errorf("expect %v credential scope, got %v", e, a)
+	}
+}
@@ -3,15 +3,22 @@
 package s3
 
 import (
+	"bytes"
+	"encoding/xml"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/eventstream"
 	"github.com/aws/aws-sdk-go/private/protocol/restxml"
+	"github.com/aws/aws-sdk-go/private/protocol/xml/xmlutil"
 )
 
 const opAbortMultipartUpload = "AbortMultipartUpload"
@@ -125,9 +132,10 @@ const opCompleteMultipartUpload = "CompleteMultipartUpload"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/CompleteMultipartUpload
 func (c *S3) CompleteMultipartUploadRequest(input *CompleteMultipartUploadInput) (req *request.Request, output *CompleteMultipartUploadOutput) {
 	op := &request.Operation{
-		Name:       opCompleteMultipartUpload,
-		HTTPMethod: "POST",
-		HTTPPath:   "/{Bucket}/{Key+}",
+		Name:                   opCompleteMultipartUpload,
+		HTTPMethod:             "POST",
+		HTTPPath:               "/{Bucket}/{Key+}",
+		SensitiveOutputHeaders: []string{"x-amz-server-side-encryption-aws-kms-key-id"},
 	}
 
 	if input == nil {
@@ -199,9 +207,10 @@ const opCopyObject = "CopyObject"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/CopyObject
 func (c *S3) CopyObjectRequest(input *CopyObjectInput) (req *request.Request, output *CopyObjectOutput) {
 	op := &request.Operation{
-		Name:       opCopyObject,
-		HTTPMethod: "PUT",
-		HTTPPath:   "/{Bucket}/{Key+}",
+		Name:                   opCopyObject,
+		HTTPMethod:             "PUT",
+		HTTPPath:               "/{Bucket}/{Key+}",
+		SensitiveOutputHeaders: []string{"x-amz-server-side-encryption-aws-kms-key-id"},
 	}
 
 	if input == nil {
@@ -361,9 +370,10 @@ const opCreateMultipartUpload = "CreateMultipartUpload"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/CreateMultipartUpload
 func (c *S3) CreateMultipartUploadRequest(input *CreateMultipartUploadInput) (req *request.Request, output *CreateMultipartUploadOutput) {
 	op := &request.Operation{
-		Name:       opCreateMultipartUpload,
-		HTTPMethod: "POST",
-		HTTPPath:   "/{Bucket}/{Key+}?uploads",
+		Name:                   opCreateMultipartUpload,
+		HTTPMethod:             "POST",
+		HTTPPath:               "/{Bucket}/{Key+}?uploads",
+		SensitiveOutputHeaders: []string{"x-amz-server-side-encryption-aws-kms-key-id"},
 	}
 
 	if input == nil {
@@ -2772,9 +2782,10 @@ const opGetObject = "GetObject"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/GetObject
 func (c *S3) GetObjectRequest(input *GetObjectInput) (req *request.Request, output *GetObjectOutput) {
 	op := &request.Operation{
-		Name:       opGetObject,
-		HTTPMethod: "GET",
-		HTTPPath:   "/{Bucket}/{Key+}",
+		Name:                   opGetObject,
+		HTTPMethod:             "GET",
+		HTTPPath:               "/{Bucket}/{Key+}",
+		SensitiveOutputHeaders: []string{"x-amz-server-side-encryption-aws-kms-key-id"},
 	}
 
 	if input == nil {
@@ -3160,9 +3171,10 @@ const opHeadObject = "HeadObject"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/HeadObject
 func (c *S3) HeadObjectRequest(input *HeadObjectInput) (req *request.Request, output *HeadObjectOutput) {
 	op := &request.Operation{
-		Name:       opHeadObject,
-		HTTPMethod: "HEAD",
-		HTTPPath:   "/{Bucket}/{Key+}",
+		Name:                   opHeadObject,
+		HTTPMethod:             "HEAD",
+		HTTPPath:               "/{Bucket}/{Key+}",
+		SensitiveOutputHeaders: []string{"x-amz-server-side-encryption-aws-kms-key-id"},
 	}
 
 	if input == nil {
@@ -5511,9 +5523,10 @@ const opPutObject = "PutObject"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/PutObject
 func (c *S3) PutObjectRequest(input *PutObjectInput) (req *request.Request, output *PutObjectOutput) {
 	op := &request.Operation{
-		Name:       opPutObject,
-		HTTPMethod: "PUT",
-		HTTPPath:   "/{Bucket}/{Key+}",
+		Name:                   opPutObject,
+		HTTPMethod:             "PUT",
+		HTTPPath:               "/{Bucket}/{Key+}",
+		SensitiveOutputHeaders: []string{"x-amz-server-side-encryption-aws-kms-key-id"},
 	}
 
 	if input == nil {
@@ -5790,6 +5803,90 @@ func (c *S3) RestoreObjectWithContext(ctx aws.Context, input *RestoreObjectInput
 	return out, req.Send()
 }
 
+const opSelectObjectContent = "SelectObjectContent"
+
+// SelectObjectContentRequest generates a "aws/request.Request" representing the
+// client's request for the SelectObjectContent operation. The "output" return
+// value will be populated with the request's response once the request complets
+// successfuly.
+//
+// Use "Send" method on the returned Request to send the API call to the service.
+// the "output" return value is not valid until after Send returns without error.
+//
+// See SelectObjectContent for more information on using the SelectObjectContent
+// API call, and error handling.
+//
+// This method is useful when you want to inject custom logic or configuration
+// into the SDK's request lifecycle. Such as custom headers, or retry logic.
+//
+//
+//    // Example sending a request using the SelectObjectContentRequest method.
+//    req, resp := client.SelectObjectContentRequest(params)
+//
+//    err := req.Send()
+//    if err == nil { // resp is now filled
+//        fmt.Println(resp)
+//    }
+//
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/SelectObjectContent
+func (c *S3) SelectObjectContentRequest(input *SelectObjectContentInput) (req *request.Request, output *SelectObjectContentOutput) {
+	op := &request.Operation{
+		Name:       opSelectObjectContent,
+		HTTPMethod: "POST",
+		HTTPPath:   "/{Bucket}/{Key+}?select&select-type=2",
+	}
+
+	if input == nil {
+		input = &SelectObjectContentInput{}
+	}
+
+	output = &SelectObjectContentOutput{}
+	req = c.newRequest(op, input, output)
+	req.Handlers.Unmarshal.Remove(restxml.UnmarshalHandler)
+	req.Handlers.Unmarshal.PushBackNamed(request.NamedHandler{
+		Name: "awssdk.s3.SelectObjectContentEventStreamHandler",
+		Fn:   output.unmarshalEventStream,
+	})
+	return
+}
+
+// SelectObjectContent API operation for Amazon Simple Storage Service.
+//
+// This operation filters the contents of an Amazon S3 object based on a simple
+// Structured Query Language (SQL) statement. In the request, along with the
+// SQL expression, you must also specify a data serialization format (JSON or
+// CSV) of the object. Amazon S3 uses this to parse object data into records,
+// and returns only records that match the specified SQL expression. You must
+// also specify the data serialization format for the response.
+//
+// Returns awserr.Error for service API and SDK errors. Use runtime type assertions
+// with awserr.Error's Code and Message methods to get detailed information about
+// the error.
+//
+// See the AWS API reference guide for Amazon Simple Storage Service's
+// API operation SelectObjectContent for usage and error information.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/SelectObjectContent
+func (c *S3) SelectObjectContent(input *SelectObjectContentInput) (*SelectObjectContentOutput, error) {
+	req, out := c.SelectObjectContentRequest(input)
+	return out, req.Send()
+}
+
+// SelectObjectContentWithContext is the same as SelectObjectContent with the addition of
+// the ability to pass a context and additional request options.
+//
+// See SelectObjectContent for details on how to use this API operation.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (c *S3) SelectObjectContentWithContext(ctx aws.Context, input *SelectObjectContentInput, opts ...request.Option) (*SelectObjectContentOutput, error) {
+	req, out := c.SelectObjectContentRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return out, req.Send()
+}
+
 const opUploadPart = "UploadPart"
 
 // UploadPartRequest generates a "aws/request.Request" representing the
@@ -5818,9 +5915,10 @@ const opUploadPart = "UploadPart"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/UploadPart
 func (c *S3) UploadPartRequest(input *UploadPartInput) (req *request.Request, output *UploadPartOutput) {
 	op := &request.Operation{
-		Name:       opUploadPart,
-		HTTPMethod: "PUT",
-		HTTPPath:   "/{Bucket}/{Key+}",
+		Name:                   opUploadPart,
+		HTTPMethod:             "PUT",
+		HTTPPath:               "/{Bucket}/{Key+}",
+		SensitiveOutputHeaders: []string{"x-amz-server-side-encryption-aws-kms-key-id"},
 	}
 
 	if input == nil {
@@ -5898,9 +5996,10 @@ const opUploadPartCopy = "UploadPartCopy"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/UploadPartCopy
 func (c *S3) UploadPartCopyRequest(input *UploadPartCopyInput) (req *request.Request, output *UploadPartCopyOutput) {
 	op := &request.Operation{
-		Name:       opUploadPartCopy,
-		HTTPMethod: "PUT",
-		HTTPPath:   "/{Bucket}/{Key+}",
+		Name:                   opUploadPartCopy,
+		HTTPMethod:             "PUT",
+		HTTPPath:               "/{Bucket}/{Key+}",
+		SensitiveOutputHeaders: []string{"x-amz-server-side-encryption-aws-kms-key-id"},
 	}
 
 	if input == nil {
@@ -5944,6 +6043,51 @@ func (c *S3) UploadPartCopyWithContext(ctx aws.Context, input *UploadPartCopyInp
 	return out, req.Send()
 }
 
+const (
+	// ErrCodeEventStreamTruncated is the error code of the error returned when
+	// an event stream ends without its end event. The events read may not
+	// include all of the results.
+	ErrCodeEventStreamTruncated = "EventStreamTruncatedError"
+)
+
+// eventStreamMessageError returns the error of the event stream message if
+// it is an error or exception message, nil if it is an event message.
+func eventStreamMessageError(msg eventstream.Message) error {
+	switch msgType := eventStreamHeader(msg, ":message-type"); msgType {
+	case "event":
+		return nil
+	case "error":
+		return awserr.New(eventStreamHeader(msg, ":error-code"),
+			eventStreamHeader(msg, ":error-message"), nil)
+	case "exception":
+		return awserr.New(eventStreamHeader(msg, ":exception-type"),
+			string(msg.Payload), nil)
+	default:
+		return awserr.New(request.ErrCodeSerialization,
+			fmt.Sprintf("unknown event stream message type, %q", msgType), nil)
+	}
+}
+
+// unmarshalEventPayload unmarshals the XML payload of the event into v.
+func unmarshalEventPayload(v interface{}, eventType string, payload []byte) error {
+	err := xmlutil.UnmarshalXML(v, xml.NewDecoder(bytes.NewReader(payload)), "")
+	if err != nil {
+		return awserr.New(request.ErrCodeSerialization,
+			fmt.Sprintf("failed to decode %s event", eventType), err)
+	}
+
+	return nil
+}
+
+// eventStreamHeader returns the value of the message's string header, empty
+// if the message does not have the header.
+func eventStreamHeader(msg eventstream.Message, name string) string {
+	if v, ok := msg.Headers.Get(name).(eventstream.StringValue); ok {
+		return string(v)
+	}
+	return ""
+}
+
 // Specifies the days since the initiation of an Incomplete Multipart Upload
 // that Lifecycle will wait before permanently removing all parts of the upload.
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/AbortIncompleteMultipartUpload
@@ -6034,6 +6178,24 @@ func (s *AbortMultipartUploadInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AbortMultipartUploadInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AbortMultipartUploadInput"}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *AbortMultipartUploadInput) SetBucket(v string) *AbortMultipartUploadInput {
 	s.Bucket = &v
@@ -6145,6 +6307,24 @@ func (s AccelerateConfiguration) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AccelerateConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AccelerateConfiguration"}
+	if s.Status != nil {
+		values := []string{"Enabled", "Suspended"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStatus sets the Status field's value.
 func (s *AccelerateConfiguration) SetStatus(v string) *AccelerateConfiguration {
 	s.Status = &v
@@ -6202,6 +6382,28 @@ func (s *AccessControlPolicy) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AccessControlPolicy) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AccessControlPolicy"}
+	if s.Grants != nil {
+		for i, v := range s.Grants {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Grants", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGrants sets the Grants field's value.
 func (s *AccessControlPolicy) SetGrants(v []*Grant) *AccessControlPolicy {
 	s.Grants = v
@@ -6356,6 +6558,23 @@ func (s *AnalyticsConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AnalyticsConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AnalyticsConfiguration"}
+	if s.StorageClassAnalysis != nil {
+		if err := s.StorageClassAnalysis.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StorageClassAnalysis", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilter sets the Filter field's value.
 func (s *AnalyticsConfiguration) SetFilter(v *AnalyticsFilter) *AnalyticsConfiguration {
 	s.Filter = v
@@ -6441,6 +6660,23 @@ func (s *AnalyticsExportDestination) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AnalyticsExportDestination) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AnalyticsExportDestination"}
+	if s.S3BucketDestination != nil {
+		if err := s.S3BucketDestination.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3BucketDestination", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetS3BucketDestination sets the S3BucketDestination field's value.
 func (s *AnalyticsExportDestination) SetS3BucketDestination(v *AnalyticsS3BucketDestination) *AnalyticsExportDestination {
 	s.S3BucketDestination = v
@@ -6591,6 +6827,24 @@ func (s *AnalyticsS3BucketDestination) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AnalyticsS3BucketDestination) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AnalyticsS3BucketDestination"}
+	if s.Format != nil {
+		values := []string{"CSV"}
+		if !request.IsParamEnumValue(*s.Format, values) {
+			invalidParams.Add(request.NewErrParamEnum("Format", *s.Format, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *AnalyticsS3BucketDestination) SetBucket(v string) *AnalyticsS3BucketDestination {
 	s.Bucket = &v
@@ -6746,6 +7000,28 @@ func (s *BucketLifecycleConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BucketLifecycleConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BucketLifecycleConfiguration"}
+	if s.Rules != nil {
+		for i, v := range s.Rules {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Rules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRules sets the Rules field's value.
 func (s *BucketLifecycleConfiguration) SetRules(v []*LifecycleRule) *BucketLifecycleConfiguration {
 	s.Rules = v
@@ -6795,6 +7071,23 @@ func (s *BucketLoggingStatus) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BucketLoggingStatus) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BucketLoggingStatus"}
+	if s.LoggingEnabled != nil {
+		if err := s.LoggingEnabled.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LoggingEnabled", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLoggingEnabled sets the LoggingEnabled field's value.
 func (s *BucketLoggingStatus) SetLoggingEnabled(v *LoggingEnabled) *BucketLoggingStatus {
 	s.LoggingEnabled = v
@@ -6993,104 +7286,375 @@ func encodeCORSRuleList(vs []*CORSRule) func(protocol.ListEncoder) {
 	}
 }
 
-// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/CloudFunctionConfiguration
-type CloudFunctionConfiguration struct {
+// Describes how a CSV-formatted input object is formatted.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/CSVInput
+type CSVInput struct {
 	_ struct{} `type:"structure"`
 
-	CloudFunction *string `type:"string"`
+	// Specifies that CSV field values may contain quoted record delimiters and
+	// such records should be allowed. Default value is FALSE. Setting this value
+	// to TRUE may lower performance.
+	AllowQuotedRecordDelimiter *bool `type:"boolean"`
 
-	// Bucket event for which to send notifications.
-	Event *string `deprecated:"true" type:"string" enum:"Event"`
+	// The single character used to indicate a row should be ignored when present
+	// at the start of a row.
+	Comments *string `type:"string"`
 
-	Events []*string `locationName:"Event" type:"list" flattened:"true"`
+	// The value used to separate individual fields in a record.
+	FieldDelimiter *string `type:"string"`
 
-	// Optional unique identifier for configurations in a notification configuration.
-	// If you don't provide one, Amazon S3 will assign an ID.
-	Id *string `type:"string"`
+	// Describes the first line of input. Valid values: None, Ignore, Use.
+	FileHeaderInfo *string `type:"string" enum:"FileHeaderInfo"`
 
-	InvocationRole *string `type:"string"`
+	// Value used for escaping where the field delimiter is part of the value.
+	QuoteCharacter *string `type:"string"`
+
+	// The single character used for escaping the quote character inside an already
+	// escaped value.
+	QuoteEscapeCharacter *string `type:"string"`
+
+	// The value used to separate individual records.
+	RecordDelimiter *string `type:"string"`
 }
 
 // String returns the string representation
-func (s CloudFunctionConfiguration) String() string {
+func (s CSVInput) String() string {
 	return awsutil.Prettify(s)
 }
 
 // GoString returns the string representation
-func (s CloudFunctionConfiguration) GoString() string {
+func (s CSVInput) GoString() string {
 	return s.String()
 }
 
-// SetCloudFunction sets the CloudFunction field's value.
-func (s *CloudFunctionConfiguration) SetCloudFunction(v string) *CloudFunctionConfiguration {
-	s.CloudFunction = &v
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CSVInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CSVInput"}
+	if s.FileHeaderInfo != nil {
+		values := []string{"USE", "IGNORE", "NONE"}
+		if !request.IsParamEnumValue(*s.FileHeaderInfo, values) {
+			invalidParams.Add(request.NewErrParamEnum("FileHeaderInfo", *s.FileHeaderInfo, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAllowQuotedRecordDelimiter sets the AllowQuotedRecordDelimiter field's value.
+func (s *CSVInput) SetAllowQuotedRecordDelimiter(v bool) *CSVInput {
+	s.AllowQuotedRecordDelimiter = &v
 	return s
 }
 
-// SetEvent sets the Event field's value.
-func (s *CloudFunctionConfiguration) SetEvent(v string) *CloudFunctionConfiguration {
-	s.Event = &v
+// SetComments sets the Comments field's value.
+func (s *CSVInput) SetComments(v string) *CSVInput {
+	s.Comments = &v
 	return s
 }
 
-// SetEvents sets the Events field's value.
-func (s *CloudFunctionConfiguration) SetEvents(v []*string) *CloudFunctionConfiguration {
-	s.Events = v
+// SetFieldDelimiter sets the FieldDelimiter field's value.
+func (s *CSVInput) SetFieldDelimiter(v string) *CSVInput {
+	s.FieldDelimiter = &v
 	return s
 }
 
-// SetId sets the Id field's value.
-func (s *CloudFunctionConfiguration) SetId(v string) *CloudFunctionConfiguration {
-	s.Id = &v
+// SetFileHeaderInfo sets the FileHeaderInfo field's value.
+func (s *CSVInput) SetFileHeaderInfo(v string) *CSVInput {
+	s.FileHeaderInfo = &v
 	return s
 }
 
-// SetInvocationRole sets the InvocationRole field's value.
-func (s *CloudFunctionConfiguration) SetInvocationRole(v string) *CloudFunctionConfiguration {
-	s.InvocationRole = &v
+// SetQuoteCharacter sets the QuoteCharacter field's value.
+func (s *CSVInput) SetQuoteCharacter(v string) *CSVInput {
+	s.QuoteCharacter = &v
+	return s
+}
+
+// SetQuoteEscapeCharacter sets the QuoteEscapeCharacter field's value.
+func (s *CSVInput) SetQuoteEscapeCharacter(v string) *CSVInput {
+	s.QuoteEscapeCharacter = &v
+	return s
+}
+
+// SetRecordDelimiter sets the RecordDelimiter field's value.
+func (s *CSVInput) SetRecordDelimiter(v string) *CSVInput {
+	s.RecordDelimiter = &v
 	return s
 }
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
-func (s *CloudFunctionConfiguration) MarshalFields(e protocol.FieldEncoder) error {
-	if s.CloudFunction != nil {
-		v := *s.CloudFunction
+func (s *CSVInput) MarshalFields(e protocol.FieldEncoder) error {
+	if s.AllowQuotedRecordDelimiter != nil {
+		v := *s.AllowQuotedRecordDelimiter
 
-		e.SetValue(protocol.BodyTarget, "CloudFunction", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "AllowQuotedRecordDelimiter", protocol.BoolValue(v), protocol.Metadata{})
 	}
-	if s.Event != nil {
-		v := *s.Event
+	if s.Comments != nil {
+		v := *s.Comments
 
-		e.SetValue(protocol.BodyTarget, "Event", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "Comments", protocol.StringValue(v), protocol.Metadata{})
 	}
-	if len(s.Events) > 0 {
-		v := s.Events
+	if s.FieldDelimiter != nil {
+		v := *s.FieldDelimiter
 
-		e.SetList(protocol.BodyTarget, "Event", protocol.EncodeStringList(v), protocol.Metadata{Flatten: true})
+		e.SetValue(protocol.BodyTarget, "FieldDelimiter", protocol.StringValue(v), protocol.Metadata{})
 	}
-	if s.Id != nil {
-		v := *s.Id
+	if s.FileHeaderInfo != nil {
+		v := *s.FileHeaderInfo
 
-		e.SetValue(protocol.BodyTarget, "Id", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "FileHeaderInfo", protocol.StringValue(v), protocol.Metadata{})
 	}
-	if s.InvocationRole != nil {
-		v := *s.InvocationRole
+	if s.QuoteCharacter != nil {
+		v := *s.QuoteCharacter
 
-		e.SetValue(protocol.BodyTarget, "InvocationRole", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "QuoteCharacter", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.QuoteEscapeCharacter != nil {
+		v := *s.QuoteEscapeCharacter
+
+		e.SetValue(protocol.BodyTarget, "QuoteEscapeCharacter", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.RecordDelimiter != nil {
+		v := *s.RecordDelimiter
+
+		e.SetValue(protocol.BodyTarget, "RecordDelimiter", protocol.StringValue(v), protocol.Metadata{})
 	}
 
 	return nil
 }
 
-// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/CommonPrefix
-type CommonPrefix struct {
+// Describes how CSV-formatted results are formatted.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/CSVOutput
+type CSVOutput struct {
 	_ struct{} `type:"structure"`
 
-	Prefix *string `type:"string"`
+	// The value used to separate individual fields in a record.
+	FieldDelimiter *string `type:"string"`
+
+	// The value used for escaping where the field delimiter is part of the value.
+	QuoteCharacter *string `type:"string"`
+
+	// The single character used for escaping the quote character inside an already
+	// escaped value.
+	QuoteEscapeCharacter *string `type:"string"`
+
+	// Indicates whether or not all output fields should be quoted.
+	QuoteFields *string `type:"string" enum:"QuoteFields"`
+
+	// The value used to separate individual records.
+	RecordDelimiter *string `type:"string"`
 }
 
 // String returns the string representation
-func (s CommonPrefix) String() string {
+func (s CSVOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s CSVOutput) GoString() string {
+	return s.String()
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CSVOutput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CSVOutput"}
+	if s.QuoteFields != nil {
+		values := []string{"ALWAYS", "ASNEEDED"}
+		if !request.IsParamEnumValue(*s.QuoteFields, values) {
+			invalidParams.Add(request.NewErrParamEnum("QuoteFields", *s.QuoteFields, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetFieldDelimiter sets the FieldDelimiter field's value.
+func (s *CSVOutput) SetFieldDelimiter(v string) *CSVOutput {
+	s.FieldDelimiter = &v
+	return s
+}
+
+// SetQuoteCharacter sets the QuoteCharacter field's value.
+func (s *CSVOutput) SetQuoteCharacter(v string) *CSVOutput {
+	s.QuoteCharacter = &v
+	return s
+}
+
+// SetQuoteEscapeCharacter sets the QuoteEscapeCharacter field's value.
+func (s *CSVOutput) SetQuoteEscapeCharacter(v string) *CSVOutput {
+	s.QuoteEscapeCharacter = &v
+	return s
+}
+
+// SetQuoteFields sets the QuoteFields field's value.
+func (s *CSVOutput) SetQuoteFields(v string) *CSVOutput {
+	s.QuoteFields = &v
+	return s
+}
+
+// SetRecordDelimiter sets the RecordDelimiter field's value.
+func (s *CSVOutput) SetRecordDelimiter(v string) *CSVOutput {
+	s.RecordDelimiter = &v
+	return s
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *CSVOutput) MarshalFields(e protocol.FieldEncoder) error {
+	if s.FieldDelimiter != nil {
+		v := *s.FieldDelimiter
+
+		e.SetValue(protocol.BodyTarget, "FieldDelimiter", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.QuoteCharacter != nil {
+		v := *s.QuoteCharacter
+
+		e.SetValue(protocol.BodyTarget, "QuoteCharacter", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.QuoteEscapeCharacter != nil {
+		v := *s.QuoteEscapeCharacter
+
+		e.SetValue(protocol.BodyTarget, "QuoteEscapeCharacter", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.QuoteFields != nil {
+		v := *s.QuoteFields
+
+		e.SetValue(protocol.BodyTarget, "QuoteFields", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.RecordDelimiter != nil {
+		v := *s.RecordDelimiter
+
+		e.SetValue(protocol.BodyTarget, "RecordDelimiter", protocol.StringValue(v), protocol.Metadata{})
+	}
+
+	return nil
+}
+
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/CloudFunctionConfiguration
+type CloudFunctionConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	CloudFunction *string `type:"string"`
+
+	// Bucket event for which to send notifications.
+	Event *string `deprecated:"true" type:"string" enum:"Event"`
+
+	Events []*string `locationName:"Event" type:"list" flattened:"true"`
+
+	// Optional unique identifier for configurations in a notification configuration.
+	// If you don't provide one, Amazon S3 will assign an ID.
+	Id *string `type:"string"`
+
+	InvocationRole *string `type:"string"`
+}
+
+// String returns the string representation
+func (s CloudFunctionConfiguration) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s CloudFunctionConfiguration) GoString() string {
+	return s.String()
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CloudFunctionConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CloudFunctionConfiguration"}
+	if s.Event != nil {
+		values := []string{"s3:ReducedRedundancyLostObject", "s3:ObjectCreated:*", "s3:ObjectCreated:Put", "s3:ObjectCreated:Post", "s3:ObjectCreated:Copy", "s3:ObjectCreated:CompleteMultipartUpload", "s3:ObjectRemoved:*", "s3:ObjectRemoved:Delete", "s3:ObjectRemoved:DeleteMarkerCreated"}
+		if !request.IsParamEnumValue(*s.Event, values) {
+			invalidParams.Add(request.NewErrParamEnum("Event", *s.Event, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCloudFunction sets the CloudFunction field's value.
+func (s *CloudFunctionConfiguration) SetCloudFunction(v string) *CloudFunctionConfiguration {
+	s.CloudFunction = &v
+	return s
+}
+
+// SetEvent sets the Event field's value.
+func (s *CloudFunctionConfiguration) SetEvent(v string) *CloudFunctionConfiguration {
+	s.Event = &v
+	return s
+}
+
+// SetEvents sets the Events field's value.
+func (s *CloudFunctionConfiguration) SetEvents(v []*string) *CloudFunctionConfiguration {
+	s.Events = v
+	return s
+}
+
+// SetId sets the Id field's value.
+func (s *CloudFunctionConfiguration) SetId(v string) *CloudFunctionConfiguration {
+	s.Id = &v
+	return s
+}
+
+// SetInvocationRole sets the InvocationRole field's value.
+func (s *CloudFunctionConfiguration) SetInvocationRole(v string) *CloudFunctionConfiguration {
+	s.InvocationRole = &v
+	return s
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *CloudFunctionConfiguration) MarshalFields(e protocol.FieldEncoder) error {
+	if s.CloudFunction != nil {
+		v := *s.CloudFunction
+
+		e.SetValue(protocol.BodyTarget, "CloudFunction", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.Event != nil {
+		v := *s.Event
+
+		e.SetValue(protocol.BodyTarget, "Event", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if len(s.Events) > 0 {
+		v := s.Events
+
+		e.SetList(protocol.BodyTarget, "Event", protocol.EncodeStringList(v), protocol.Metadata{Flatten: true})
+	}
+	if s.Id != nil {
+		v := *s.Id
+
+		e.SetValue(protocol.BodyTarget, "Id", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.InvocationRole != nil {
+		v := *s.InvocationRole
+
+		e.SetValue(protocol.BodyTarget, "InvocationRole", protocol.StringValue(v), protocol.Metadata{})
+	}
+
+	return nil
+}
+
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/CommonPrefix
+type CommonPrefix struct {
+	_ struct{} `type:"structure"`
+
+	Prefix *string `type:"string"`
+}
+
+// String returns the string representation
+func (s CommonPrefix) String() string {
 	return awsutil.Prettify(s)
 }
 
@@ -7178,6 +7742,24 @@ func (s *CompleteMultipartUploadInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CompleteMultipartUploadInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CompleteMultipartUploadInput"}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *CompleteMultipartUploadInput) SetBucket(v string) *CompleteMultipartUploadInput {
 	s.Bucket = &v
@@ -7269,7 +7851,7 @@ type CompleteMultipartUploadOutput struct {
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
@@ -7385,7 +7967,7 @@ func (s *CompleteMultipartUploadOutput) MarshalFields(e protocol.FieldEncoder) e
 	if s.SSEKMSKeyId != nil {
 		v := *s.SSEKMSKeyId
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ServerSideEncryption != nil {
 		v := *s.ServerSideEncryption
@@ -7439,6 +8021,18 @@ func (s *CompletedMultipartUpload) MarshalFields(e protocol.FieldEncoder) error
 type CompletedPart struct {
 	_ struct{} `type:"structure"`
 
+	// The base64-encoded CRC32 checksum of the part.
+	ChecksumCRC32 *string `type:"string"`
+
+	// The base64-encoded CRC32C checksum of the part.
+	ChecksumCRC32C *string `type:"string"`
+
+	// The base64-encoded SHA1 checksum of the part.
+	ChecksumSHA1 *string `type:"string"`
+
+	// The base64-encoded SHA256 checksum of the part.
+	ChecksumSHA256 *string `type:"string"`
+
 	// Entity tag returned when the part was uploaded.
 	ETag *string `type:"string"`
 
@@ -7457,6 +8051,30 @@ func (s CompletedPart) GoString() string {
 	return s.String()
 }
 
+// SetChecksumCRC32 sets the ChecksumCRC32 field's value.
+func (s *CompletedPart) SetChecksumCRC32(v string) *CompletedPart {
+	s.ChecksumCRC32 = &v
+	return s
+}
+
+// SetChecksumCRC32C sets the ChecksumCRC32C field's value.
+func (s *CompletedPart) SetChecksumCRC32C(v string) *CompletedPart {
+	s.ChecksumCRC32C = &v
+	return s
+}
+
+// SetChecksumSHA1 sets the ChecksumSHA1 field's value.
+func (s *CompletedPart) SetChecksumSHA1(v string) *CompletedPart {
+	s.ChecksumSHA1 = &v
+	return s
+}
+
+// SetChecksumSHA256 sets the ChecksumSHA256 field's value.
+func (s *CompletedPart) SetChecksumSHA256(v string) *CompletedPart {
+	s.ChecksumSHA256 = &v
+	return s
+}
+
 // SetETag sets the ETag field's value.
 func (s *CompletedPart) SetETag(v string) *CompletedPart {
 	s.ETag = &v
@@ -7471,6 +8089,26 @@ func (s *CompletedPart) SetPartNumber(v int64) *CompletedPart {
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
 func (s *CompletedPart) MarshalFields(e protocol.FieldEncoder) error {
+	if s.ChecksumCRC32 != nil {
+		v := *s.ChecksumCRC32
+
+		e.SetValue(protocol.BodyTarget, "ChecksumCRC32", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.ChecksumCRC32C != nil {
+		v := *s.ChecksumCRC32C
+
+		e.SetValue(protocol.BodyTarget, "ChecksumCRC32C", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.ChecksumSHA1 != nil {
+		v := *s.ChecksumSHA1
+
+		e.SetValue(protocol.BodyTarget, "ChecksumSHA1", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.ChecksumSHA256 != nil {
+		v := *s.ChecksumSHA256
+
+		e.SetValue(protocol.BodyTarget, "ChecksumSHA256", protocol.StringValue(v), protocol.Metadata{})
+	}
 	if s.ETag != nil {
 		v := *s.ETag
 
@@ -7552,6 +8190,29 @@ func (s *Condition) MarshalFields(e protocol.FieldEncoder) error {
 	return nil
 }
 
+// An event sent periodically to keep the connection alive while no records
+// are selected.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/ContinuationEvent
+type ContinuationEvent struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation
+func (s ContinuationEvent) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s ContinuationEvent) GoString() string {
+	return s.String()
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *ContinuationEvent) MarshalFields(e protocol.FieldEncoder) error {
+
+	return nil
+}
+
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/CopyObjectRequest
 type CopyObjectInput struct {
 	_ struct{} `type:"structure"`
@@ -7604,7 +8265,7 @@ type CopyObjectInput struct {
 	// Specifies the customer-provided encryption key for Amazon S3 to use to decrypt
 	// the source object. The encryption key provided in this header must be one
 	// that was used when the source object was created.
-	CopySourceSSECustomerKey *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key" type:"string"`
+	CopySourceSSECustomerKey *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -7650,7 +8311,7 @@ type CopyObjectInput struct {
 	// does not store the encryption key. The key must be appropriate for use with
 	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
 	// header.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -7661,7 +8322,7 @@ type CopyObjectInput struct {
 	// requests for an object protected by AWS KMS will fail if not made via SSL
 	// or using SigV4. Documentation on configuring any of the officially supported
 	// AWS SDKs and CLI can be found at http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingAWSSDK.html#specify-signature-version
-	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
@@ -7717,6 +8378,57 @@ func (s *CopyObjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CopyObjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CopyObjectInput"}
+	if s.ACL != nil {
+		values := []string{"private", "public-read", "public-read-write", "authenticated-read", "aws-exec-read", "bucket-owner-read", "bucket-owner-full-control"}
+		if !request.IsParamEnumValue(*s.ACL, values) {
+			invalidParams.Add(request.NewErrParamEnum("ACL", *s.ACL, values))
+		}
+	}
+	if s.CopySource != nil && !request.MatchParamPattern("\\/.+\\/.+", *s.CopySource) {
+		invalidParams.Add(request.NewErrParamPattern("CopySource", "\\/.+\\/.+"))
+	}
+	if s.MetadataDirective != nil {
+		values := []string{"COPY", "REPLACE"}
+		if !request.IsParamEnumValue(*s.MetadataDirective, values) {
+			invalidParams.Add(request.NewErrParamEnum("MetadataDirective", *s.MetadataDirective, values))
+		}
+	}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+	if s.ServerSideEncryption != nil {
+		values := []string{"AES256", "aws:kms"}
+		if !request.IsParamEnumValue(*s.ServerSideEncryption, values) {
+			invalidParams.Add(request.NewErrParamEnum("ServerSideEncryption", *s.ServerSideEncryption, values))
+		}
+	}
+	if s.StorageClass != nil {
+		values := []string{"STANDARD", "REDUCED_REDUNDANCY", "STANDARD_IA"}
+		if !request.IsParamEnumValue(*s.StorageClass, values) {
+			invalidParams.Add(request.NewErrParamEnum("StorageClass", *s.StorageClass, values))
+		}
+	}
+	if s.TaggingDirective != nil {
+		values := []string{"COPY", "REPLACE"}
+		if !request.IsParamEnumValue(*s.TaggingDirective, values) {
+			invalidParams.Add(request.NewErrParamEnum("TaggingDirective", *s.TaggingDirective, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetACL sets the ACL field's value.
 func (s *CopyObjectInput) SetACL(v string) *CopyObjectInput {
 	s.ACL = &v
@@ -8006,7 +8718,7 @@ func (s *CopyObjectInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.CopySourceSSECustomerKey != nil {
 		v := *s.CopySourceSSECustomerKey
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-copy-source-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-copy-source-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.CopySourceSSECustomerKeyMD5 != nil {
 		v := *s.CopySourceSSECustomerKeyMD5
@@ -8066,7 +8778,7 @@ func (s *CopyObjectInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.SSECustomerKey != nil {
 		v := *s.SSECustomerKey
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.SSECustomerKeyMD5 != nil {
 		v := *s.SSECustomerKeyMD5
@@ -8076,7 +8788,7 @@ func (s *CopyObjectInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.SSEKMSKeyId != nil {
 		v := *s.SSEKMSKeyId
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ServerSideEncryption != nil {
 		v := *s.ServerSideEncryption
@@ -8134,7 +8846,7 @@ type CopyObjectOutput struct {
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
@@ -8243,7 +8955,7 @@ func (s *CopyObjectOutput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.SSEKMSKeyId != nil {
 		v := *s.SSEKMSKeyId
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ServerSideEncryption != nil {
 		v := *s.ServerSideEncryption
@@ -8374,6 +9086,24 @@ func (s CreateBucketConfiguration) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateBucketConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateBucketConfiguration"}
+	if s.LocationConstraint != nil {
+		values := []string{"EU", "eu-west-1", "us-west-1", "us-west-2", "ap-south-1", "ap-southeast-1", "ap-southeast-2", "ap-northeast-1", "sa-east-1", "cn-north-1", "eu-central-1"}
+		if !request.IsParamEnumValue(*s.LocationConstraint, values) {
+			invalidParams.Add(request.NewErrParamEnum("LocationConstraint", *s.LocationConstraint, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLocationConstraint sets the LocationConstraint field's value.
 func (s *CreateBucketConfiguration) SetLocationConstraint(v string) *CreateBucketConfiguration {
 	s.LocationConstraint = &v
@@ -8443,6 +9173,29 @@ func (s *CreateBucketInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateBucketInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateBucketInput"}
+	if s.ACL != nil {
+		values := []string{"private", "public-read", "public-read-write", "authenticated-read"}
+		if !request.IsParamEnumValue(*s.ACL, values) {
+			invalidParams.Add(request.NewErrParamEnum("ACL", *s.ACL, values))
+		}
+	}
+	if s.CreateBucketConfiguration != nil {
+		if err := s.CreateBucketConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CreateBucketConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetACL sets the ACL field's value.
 func (s *CreateBucketInput) SetACL(v string) *CreateBucketInput {
 	s.ACL = &v
@@ -8591,6 +9344,9 @@ type CreateMultipartUploadInput struct {
 	// Specifies caching behavior along the request/reply chain.
 	CacheControl *string `location:"header" locationName:"Cache-Control" type:"string"`
 
+	// The algorithm of the checksums of the parts of the multipart upload.
+	ChecksumAlgorithm *string `location:"header" locationName:"x-amz-checksum-algorithm" type:"string" enum:"ChecksumAlgorithm"`
+
 	// Specifies presentational information for the object.
 	ContentDisposition *string `location:"header" locationName:"Content-Disposition" type:"string"`
 
@@ -8640,7 +9396,7 @@ type CreateMultipartUploadInput struct {
 	// does not store the encryption key. The key must be appropriate for use with
 	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
 	// header.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -8651,7 +9407,7 @@ type CreateMultipartUploadInput struct {
 	// requests for an object protected by AWS KMS will fail if not made via SSL
 	// or using SigV4. Documentation on configuring any of the officially supported
 	// AWS SDKs and CLI can be found at http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingAWSSDK.html#specify-signature-version
-	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
@@ -8698,6 +9454,48 @@ func (s *CreateMultipartUploadInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateMultipartUploadInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateMultipartUploadInput"}
+	if s.ACL != nil {
+		values := []string{"private", "public-read", "public-read-write", "authenticated-read", "aws-exec-read", "bucket-owner-read", "bucket-owner-full-control"}
+		if !request.IsParamEnumValue(*s.ACL, values) {
+			invalidParams.Add(request.NewErrParamEnum("ACL", *s.ACL, values))
+		}
+	}
+	if s.ChecksumAlgorithm != nil {
+		values := []string{"CRC32", "CRC32C", "SHA1", "SHA256"}
+		if !request.IsParamEnumValue(*s.ChecksumAlgorithm, values) {
+			invalidParams.Add(request.NewErrParamEnum("ChecksumAlgorithm", *s.ChecksumAlgorithm, values))
+		}
+	}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+	if s.ServerSideEncryption != nil {
+		values := []string{"AES256", "aws:kms"}
+		if !request.IsParamEnumValue(*s.ServerSideEncryption, values) {
+			invalidParams.Add(request.NewErrParamEnum("ServerSideEncryption", *s.ServerSideEncryption, values))
+		}
+	}
+	if s.StorageClass != nil {
+		values := []string{"STANDARD", "REDUCED_REDUNDANCY", "STANDARD_IA"}
+		if !request.IsParamEnumValue(*s.StorageClass, values) {
+			invalidParams.Add(request.NewErrParamEnum("StorageClass", *s.StorageClass, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetACL sets the ACL field's value.
 func (s *CreateMultipartUploadInput) SetACL(v string) *CreateMultipartUploadInput {
 	s.ACL = &v
@@ -8723,6 +9521,12 @@ func (s *CreateMultipartUploadInput) SetCacheControl(v string) *CreateMultipartU
 	return s
 }
 
+// SetChecksumAlgorithm sets the ChecksumAlgorithm field's value.
+func (s *CreateMultipartUploadInput) SetChecksumAlgorithm(v string) *CreateMultipartUploadInput {
+	s.ChecksumAlgorithm = &v
+	return s
+}
+
 // SetContentDisposition sets the ContentDisposition field's value.
 func (s *CreateMultipartUploadInput) SetContentDisposition(v string) *CreateMultipartUploadInput {
 	s.ContentDisposition = &v
@@ -8867,6 +9671,11 @@ func (s *CreateMultipartUploadInput) MarshalFields(e protocol.FieldEncoder) erro
 
 		e.SetValue(protocol.HeaderTarget, "Cache-Control", protocol.StringValue(v), protocol.Metadata{})
 	}
+	if s.ChecksumAlgorithm != nil {
+		v := *s.ChecksumAlgorithm
+
+		e.SetValue(protocol.HeaderTarget, "x-amz-checksum-algorithm", protocol.StringValue(v), protocol.Metadata{})
+	}
 	if s.ContentDisposition != nil {
 		v := *s.ContentDisposition
 
@@ -8935,7 +9744,7 @@ func (s *CreateMultipartUploadInput) MarshalFields(e protocol.FieldEncoder) erro
 	if s.SSECustomerKey != nil {
 		v := *s.SSECustomerKey
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.SSECustomerKeyMD5 != nil {
 		v := *s.SSECustomerKeyMD5
@@ -8945,7 +9754,7 @@ func (s *CreateMultipartUploadInput) MarshalFields(e protocol.FieldEncoder) erro
 	if s.SSEKMSKeyId != nil {
 		v := *s.SSEKMSKeyId
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ServerSideEncryption != nil {
 		v := *s.ServerSideEncryption
@@ -9004,7 +9813,7 @@ type CreateMultipartUploadOutput struct {
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
@@ -9131,7 +9940,7 @@ func (s *CreateMultipartUploadOutput) MarshalFields(e protocol.FieldEncoder) err
 	if s.SSEKMSKeyId != nil {
 		v := *s.SSEKMSKeyId
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ServerSideEncryption != nil {
 		v := *s.ServerSideEncryption
@@ -10195,6 +11004,24 @@ func (s *DeleteObjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteObjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteObjectInput"}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *DeleteObjectInput) SetBucket(v string) *DeleteObjectInput {
 	s.Bucket = &v
@@ -10505,6 +11332,24 @@ func (s *DeleteObjectsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteObjectsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteObjectsInput"}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *DeleteObjectsInput) SetBucket(v string) *DeleteObjectsInput {
 	s.Bucket = &v
@@ -10742,6 +11587,24 @@ func (s *Destination) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Destination) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Destination"}
+	if s.StorageClass != nil {
+		values := []string{"STANDARD", "REDUCED_REDUNDANCY", "STANDARD_IA"}
+		if !request.IsParamEnumValue(*s.StorageClass, values) {
+			invalidParams.Add(request.NewErrParamEnum("StorageClass", *s.StorageClass, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *Destination) SetBucket(v string) *Destination {
 	s.Bucket = &v
@@ -10777,6 +11640,29 @@ func (s *Destination) MarshalFields(e protocol.FieldEncoder) error {
 	return nil
 }
 
+// The last event of the event stream, sent once all of the records have been
+// selected.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/EndEvent
+type EndEvent struct {
+	_ struct{} `type:"structure"`
+}
+
+// String returns the string representation
+func (s EndEvent) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s EndEvent) GoString() string {
+	return s.String()
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *EndEvent) MarshalFields(e protocol.FieldEncoder) error {
+
+	return nil
+}
+
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/Error
 type Error struct {
 	_ struct{} `type:"structure"`
@@ -10935,6 +11821,24 @@ func (s FilterRule) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *FilterRule) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "FilterRule"}
+	if s.Name != nil {
+		values := []string{"prefix", "suffix"}
+		if !request.IsParamEnumValue(*s.Name, values) {
+			invalidParams.Add(request.NewErrParamEnum("Name", *s.Name, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *FilterRule) SetName(v string) *FilterRule {
 	s.Name = &v
@@ -12645,6 +13549,24 @@ func (s *GetObjectAclInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetObjectAclInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetObjectAclInput"}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *GetObjectAclInput) SetBucket(v string) *GetObjectAclInput {
 	s.Bucket = &v
@@ -12832,7 +13754,7 @@ type GetObjectInput struct {
 	// does not store the encryption key. The key must be appropriate for use with
 	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
 	// header.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -12872,6 +13794,24 @@ func (s *GetObjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetObjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetObjectInput"}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *GetObjectInput) SetBucket(v string) *GetObjectInput {
 	s.Bucket = &v
@@ -13085,7 +14025,7 @@ func (s *GetObjectInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.SSECustomerKey != nil {
 		v := *s.SSECustomerKey
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.SSECustomerKeyMD5 != nil {
 		v := *s.SSECustomerKeyMD5
@@ -13187,7 +14127,7 @@ type GetObjectOutput struct {
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
@@ -13496,7 +14436,7 @@ func (s *GetObjectOutput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.SSEKMSKeyId != nil {
 		v := *s.SSEKMSKeyId
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ServerSideEncryption != nil {
 		v := *s.ServerSideEncryption
@@ -13709,6 +14649,24 @@ func (s *GetObjectTorrentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetObjectTorrentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetObjectTorrentInput"}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *GetObjectTorrentInput) SetBucket(v string) *GetObjectTorrentInput {
 	s.Bucket = &v
@@ -13833,6 +14791,24 @@ func (s *GlacierJobParameters) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GlacierJobParameters) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GlacierJobParameters"}
+	if s.Tier != nil {
+		values := []string{"Standard", "Bulk", "Expedited"}
+		if !request.IsParamEnumValue(*s.Tier, values) {
+			invalidParams.Add(request.NewErrParamEnum("Tier", *s.Tier, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTier sets the Tier field's value.
 func (s *GlacierJobParameters) SetTier(v string) *GlacierJobParameters {
 	s.Tier = &v
@@ -13885,6 +14861,29 @@ func (s *Grant) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Grant) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Grant"}
+	if s.Permission != nil {
+		values := []string{"FULL_CONTROL", "WRITE", "WRITE_ACP", "READ", "READ_ACP"}
+		if !request.IsParamEnumValue(*s.Permission, values) {
+			invalidParams.Add(request.NewErrParamEnum("Permission", *s.Permission, values))
+		}
+	}
+	if s.Grantee != nil {
+		if err := s.Grantee.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Grantee", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGrantee sets the Grantee field's value.
 func (s *Grant) SetGrantee(v *Grantee) *Grant {
 	s.Grantee = v
@@ -13971,6 +14970,24 @@ func (s *Grantee) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Grantee) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Grantee"}
+	if s.Type != nil {
+		values := []string{"CanonicalUser", "AmazonCustomerByEmail", "Group"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDisplayName sets the DisplayName field's value.
 func (s *Grantee) SetDisplayName(v string) *Grantee {
 	s.DisplayName = &v
@@ -14154,7 +15171,7 @@ type HeadObjectInput struct {
 	// does not store the encryption key. The key must be appropriate for use with
 	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
 	// header.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -14194,6 +15211,24 @@ func (s *HeadObjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *HeadObjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "HeadObjectInput"}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *HeadObjectInput) SetBucket(v string) *HeadObjectInput {
 	s.Bucket = &v
@@ -14341,7 +15376,7 @@ func (s *HeadObjectInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.SSECustomerKey != nil {
 		v := *s.SSECustomerKey
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.SSECustomerKeyMD5 != nil {
 		v := *s.SSECustomerKeyMD5
@@ -14437,7 +15472,7 @@ type HeadObjectOutput struct {
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
@@ -14719,7 +15754,7 @@ func (s *HeadObjectOutput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.SSEKMSKeyId != nil {
 		v := *s.SSEKMSKeyId
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ServerSideEncryption != nil {
 		v := *s.ServerSideEncryption
@@ -14848,6 +15883,99 @@ func (s *Initiator) MarshalFields(e protocol.FieldEncoder) error {
 	return nil
 }
 
+// Describes the serialization format of the object.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/InputSerialization
+type InputSerialization struct {
+	_ struct{} `type:"structure"`
+
+	// Describes the serialization of a CSV-encoded object.
+	CSV *CSVInput `type:"structure"`
+
+	// Specifies object's compression format. Valid values: NONE, GZIP, BZIP2. Default
+	// Value: NONE.
+	CompressionType *string `type:"string" enum:"CompressionType"`
+
+	// Specifies JSON as object's input serialization format.
+	JSON *JSONInput `type:"structure"`
+}
+
+// String returns the string representation
+func (s InputSerialization) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s InputSerialization) GoString() string {
+	return s.String()
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InputSerialization) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InputSerialization"}
+	if s.CompressionType != nil {
+		values := []string{"NONE", "GZIP", "BZIP2"}
+		if !request.IsParamEnumValue(*s.CompressionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("CompressionType", *s.CompressionType, values))
+		}
+	}
+	if s.CSV != nil {
+		if err := s.CSV.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CSV", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.JSON != nil {
+		if err := s.JSON.ValidateExtended(); err != nil {
+			invalidParams.AddNested("JSON", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCSV sets the CSV field's value.
+func (s *InputSerialization) SetCSV(v *CSVInput) *InputSerialization {
+	s.CSV = v
+	return s
+}
+
+// SetCompressionType sets the CompressionType field's value.
+func (s *InputSerialization) SetCompressionType(v string) *InputSerialization {
+	s.CompressionType = &v
+	return s
+}
+
+// SetJSON sets the JSON field's value.
+func (s *InputSerialization) SetJSON(v *JSONInput) *InputSerialization {
+	s.JSON = v
+	return s
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *InputSerialization) MarshalFields(e protocol.FieldEncoder) error {
+	if s.CSV != nil {
+		v := s.CSV
+
+		e.SetFields(protocol.BodyTarget, "CSV", v, protocol.Metadata{})
+	}
+	if s.CompressionType != nil {
+		v := *s.CompressionType
+
+		e.SetValue(protocol.BodyTarget, "CompressionType", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.JSON != nil {
+		v := s.JSON
+
+		e.SetFields(protocol.BodyTarget, "JSON", v, protocol.Metadata{})
+	}
+
+	return nil
+}
+
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/InventoryConfiguration
 type InventoryConfiguration struct {
 	_ struct{} `type:"structure"`
@@ -14935,6 +16063,34 @@ func (s *InventoryConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InventoryConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InventoryConfiguration"}
+	if s.IncludedObjectVersions != nil {
+		values := []string{"All", "Current"}
+		if !request.IsParamEnumValue(*s.IncludedObjectVersions, values) {
+			invalidParams.Add(request.NewErrParamEnum("IncludedObjectVersions", *s.IncludedObjectVersions, values))
+		}
+	}
+	if s.Destination != nil {
+		if err := s.Destination.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Destination", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Schedule != nil {
+		if err := s.Schedule.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Schedule", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestination sets the Destination field's value.
 func (s *InventoryConfiguration) SetDestination(v *InventoryDestination) *InventoryConfiguration {
 	s.Destination = v
@@ -15065,6 +16221,23 @@ func (s *InventoryDestination) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InventoryDestination) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InventoryDestination"}
+	if s.S3BucketDestination != nil {
+		if err := s.S3BucketDestination.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3BucketDestination", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetS3BucketDestination sets the S3BucketDestination field's value.
 func (s *InventoryDestination) SetS3BucketDestination(v *InventoryS3BucketDestination) *InventoryDestination {
 	s.S3BucketDestination = v
@@ -15180,6 +16353,24 @@ func (s *InventoryS3BucketDestination) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InventoryS3BucketDestination) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InventoryS3BucketDestination"}
+	if s.Format != nil {
+		values := []string{"CSV"}
+		if !request.IsParamEnumValue(*s.Format, values) {
+			invalidParams.Add(request.NewErrParamEnum("Format", *s.Format, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountId sets the AccountId field's value.
 func (s *InventoryS3BucketDestination) SetAccountId(v string) *InventoryS3BucketDestination {
 	s.AccountId = &v
@@ -15270,6 +16461,24 @@ func (s *InventorySchedule) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InventorySchedule) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InventorySchedule"}
+	if s.Frequency != nil {
+		values := []string{"Daily", "Weekly"}
+		if !request.IsParamEnumValue(*s.Frequency, values) {
+			invalidParams.Add(request.NewErrParamEnum("Frequency", *s.Frequency, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFrequency sets the Frequency field's value.
 func (s *InventorySchedule) SetFrequency(v string) *InventorySchedule {
 	s.Frequency = &v
@@ -15287,6 +16496,94 @@ func (s *InventorySchedule) MarshalFields(e protocol.FieldEncoder) error {
 	return nil
 }
 
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/JSONInput
+type JSONInput struct {
+	_ struct{} `type:"structure"`
+
+	// The type of JSON. Valid values: Document, Lines.
+	Type *string `type:"string" enum:"JSONType"`
+}
+
+// String returns the string representation
+func (s JSONInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s JSONInput) GoString() string {
+	return s.String()
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *JSONInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "JSONInput"}
+	if s.Type != nil {
+		values := []string{"DOCUMENT", "LINES"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetType sets the Type field's value.
+func (s *JSONInput) SetType(v string) *JSONInput {
+	s.Type = &v
+	return s
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *JSONInput) MarshalFields(e protocol.FieldEncoder) error {
+	if s.Type != nil {
+		v := *s.Type
+
+		e.SetValue(protocol.BodyTarget, "Type", protocol.StringValue(v), protocol.Metadata{})
+	}
+
+	return nil
+}
+
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/JSONOutput
+type JSONOutput struct {
+	_ struct{} `type:"structure"`
+
+	// The value used to separate individual records in the output.
+	RecordDelimiter *string `type:"string"`
+}
+
+// String returns the string representation
+func (s JSONOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s JSONOutput) GoString() string {
+	return s.String()
+}
+
+// SetRecordDelimiter sets the RecordDelimiter field's value.
+func (s *JSONOutput) SetRecordDelimiter(v string) *JSONOutput {
+	s.RecordDelimiter = &v
+	return s
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *JSONOutput) MarshalFields(e protocol.FieldEncoder) error {
+	if s.RecordDelimiter != nil {
+		v := *s.RecordDelimiter
+
+		e.SetValue(protocol.BodyTarget, "RecordDelimiter", protocol.StringValue(v), protocol.Metadata{})
+	}
+
+	return nil
+}
+
 // Container for object key name prefix and suffix filtering rules.
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/S3KeyFilter
 type KeyFilter struct {
@@ -15307,6 +16604,28 @@ func (s KeyFilter) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *KeyFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "KeyFilter"}
+	if s.FilterRules != nil {
+		for i, v := range s.FilterRules {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "FilterRules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilterRules sets the FilterRules field's value.
 func (s *KeyFilter) SetFilterRules(v []*FilterRule) *KeyFilter {
 	s.FilterRules = v
@@ -15373,6 +16692,23 @@ func (s *LambdaFunctionConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LambdaFunctionConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LambdaFunctionConfiguration"}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEvents sets the Events field's value.
 func (s *LambdaFunctionConfiguration) SetEvents(v []*string) *LambdaFunctionConfiguration {
 	s.Events = v
@@ -15472,6 +16808,28 @@ func (s *LifecycleConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LifecycleConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LifecycleConfiguration"}
+	if s.Rules != nil {
+		for i, v := range s.Rules {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Rules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRules sets the Rules field's value.
 func (s *LifecycleConfiguration) SetRules(v []*Rule) *LifecycleConfiguration {
 	s.Rules = v
@@ -15624,6 +16982,44 @@ func (s *LifecycleRule) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LifecycleRule) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LifecycleRule"}
+	if s.Status != nil {
+		values := []string{"Enabled", "Disabled"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+	if s.NoncurrentVersionTransitions != nil {
+		for i, v := range s.NoncurrentVersionTransitions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "NoncurrentVersionTransitions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Transitions != nil {
+		for i, v := range s.Transitions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Transitions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAbortIncompleteMultipartUpload sets the AbortIncompleteMultipartUpload field's value.
 func (s *LifecycleRule) SetAbortIncompleteMultipartUpload(v *AbortIncompleteMultipartUpload) *LifecycleRule {
 	s.AbortIncompleteMultipartUpload = v
@@ -16489,6 +17885,24 @@ func (s *ListMultipartUploadsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListMultipartUploadsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListMultipartUploadsInput"}
+	if s.EncodingType != nil {
+		values := []string{"url"}
+		if !request.IsParamEnumValue(*s.EncodingType, values) {
+			invalidParams.Add(request.NewErrParamEnum("EncodingType", *s.EncodingType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *ListMultipartUploadsInput) SetBucket(v string) *ListMultipartUploadsInput {
 	s.Bucket = &v
@@ -16834,6 +18248,24 @@ func (s *ListObjectVersionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListObjectVersionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListObjectVersionsInput"}
+	if s.EncodingType != nil {
+		values := []string{"url"}
+		if !request.IsParamEnumValue(*s.EncodingType, values) {
+			invalidParams.Add(request.NewErrParamEnum("EncodingType", *s.EncodingType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *ListObjectVersionsInput) SetBucket(v string) *ListObjectVersionsInput {
 	s.Bucket = &v
@@ -17180,6 +18612,30 @@ func (s *ListObjectsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListObjectsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListObjectsInput"}
+	if s.EncodingType != nil {
+		values := []string{"url"}
+		if !request.IsParamEnumValue(*s.EncodingType, values) {
+			invalidParams.Add(request.NewErrParamEnum("EncodingType", *s.EncodingType, values))
+		}
+	}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *ListObjectsInput) SetBucket(v string) *ListObjectsInput {
 	s.Bucket = &v
@@ -17496,6 +18952,30 @@ func (s *ListObjectsV2Input) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListObjectsV2Input) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListObjectsV2Input"}
+	if s.EncodingType != nil {
+		values := []string{"url"}
+		if !request.IsParamEnumValue(*s.EncodingType, values) {
+			invalidParams.Add(request.NewErrParamEnum("EncodingType", *s.EncodingType, values))
+		}
+	}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *ListObjectsV2Input) SetBucket(v string) *ListObjectsV2Input {
 	s.Bucket = &v
@@ -17869,6 +19349,24 @@ func (s *ListPartsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPartsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPartsInput"}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *ListPartsInput) SetBucket(v string) *ListPartsInput {
 	s.Bucket = &v
@@ -18223,6 +19721,28 @@ func (s *LoggingEnabled) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LoggingEnabled) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LoggingEnabled"}
+	if s.TargetGrants != nil {
+		for i, v := range s.TargetGrants {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TargetGrants", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTargetBucket sets the TargetBucket field's value.
 func (s *LoggingEnabled) SetTargetBucket(v string) *LoggingEnabled {
 	s.TargetBucket = &v
@@ -18679,6 +20199,24 @@ func (s NoncurrentVersionTransition) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NoncurrentVersionTransition) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NoncurrentVersionTransition"}
+	if s.StorageClass != nil {
+		values := []string{"GLACIER", "STANDARD_IA"}
+		if !request.IsParamEnumValue(*s.StorageClass, values) {
+			invalidParams.Add(request.NewErrParamEnum("StorageClass", *s.StorageClass, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNoncurrentDays sets the NoncurrentDays field's value.
 func (s *NoncurrentVersionTransition) SetNoncurrentDays(v int64) *NoncurrentVersionTransition {
 	s.NoncurrentDays = &v
@@ -18778,6 +20316,48 @@ func (s *NotificationConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NotificationConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NotificationConfiguration"}
+	if s.LambdaFunctionConfigurations != nil {
+		for i, v := range s.LambdaFunctionConfigurations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "LambdaFunctionConfigurations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.QueueConfigurations != nil {
+		for i, v := range s.QueueConfigurations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "QueueConfigurations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.TopicConfigurations != nil {
+		for i, v := range s.TopicConfigurations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TopicConfigurations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLambdaFunctionConfigurations sets the LambdaFunctionConfigurations field's value.
 func (s *NotificationConfiguration) SetLambdaFunctionConfigurations(v []*LambdaFunctionConfiguration) *NotificationConfiguration {
 	s.LambdaFunctionConfigurations = v
@@ -18838,8 +20418,35 @@ func (s NotificationConfigurationDeprecated) GoString() string {
 	return s.String()
 }
 
-// SetCloudFunctionConfiguration sets the CloudFunctionConfiguration field's value.
-func (s *NotificationConfigurationDeprecated) SetCloudFunctionConfiguration(v *CloudFunctionConfiguration) *NotificationConfigurationDeprecated {
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NotificationConfigurationDeprecated) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NotificationConfigurationDeprecated"}
+	if s.CloudFunctionConfiguration != nil {
+		if err := s.CloudFunctionConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CloudFunctionConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.QueueConfiguration != nil {
+		if err := s.QueueConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("QueueConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TopicConfiguration != nil {
+		if err := s.TopicConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TopicConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCloudFunctionConfiguration sets the CloudFunctionConfiguration field's value.
+func (s *NotificationConfigurationDeprecated) SetCloudFunctionConfiguration(v *CloudFunctionConfiguration) *NotificationConfigurationDeprecated {
 	s.CloudFunctionConfiguration = v
 	return s
 }
@@ -18897,6 +20504,23 @@ func (s NotificationConfigurationFilter) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NotificationConfigurationFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NotificationConfigurationFilter"}
+	if s.Key != nil {
+		if err := s.Key.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Key", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *NotificationConfigurationFilter) SetKey(v *KeyFilter) *NotificationConfigurationFilter {
 	s.Key = v
@@ -19237,6 +20861,73 @@ func encodeObjectVersionList(vs []*ObjectVersion) func(protocol.ListEncoder) {
 	}
 }
 
+// Describes how results of the Select job are serialized.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/OutputSerialization
+type OutputSerialization struct {
+	_ struct{} `type:"structure"`
+
+	// Describes the serialization of CSV-encoded Select results.
+	CSV *CSVOutput `type:"structure"`
+
+	// Specifies JSON as request's output serialization format.
+	JSON *JSONOutput `type:"structure"`
+}
+
+// String returns the string representation
+func (s OutputSerialization) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s OutputSerialization) GoString() string {
+	return s.String()
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *OutputSerialization) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "OutputSerialization"}
+	if s.CSV != nil {
+		if err := s.CSV.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CSV", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCSV sets the CSV field's value.
+func (s *OutputSerialization) SetCSV(v *CSVOutput) *OutputSerialization {
+	s.CSV = v
+	return s
+}
+
+// SetJSON sets the JSON field's value.
+func (s *OutputSerialization) SetJSON(v *JSONOutput) *OutputSerialization {
+	s.JSON = v
+	return s
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *OutputSerialization) MarshalFields(e protocol.FieldEncoder) error {
+	if s.CSV != nil {
+		v := s.CSV
+
+		e.SetFields(protocol.BodyTarget, "CSV", v, protocol.Metadata{})
+	}
+	if s.JSON != nil {
+		v := s.JSON
+
+		e.SetFields(protocol.BodyTarget, "JSON", v, protocol.Metadata{})
+	}
+
+	return nil
+}
+
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/Owner
 type Owner struct {
 	_ struct{} `type:"structure"`
@@ -19370,6 +21061,106 @@ func encodePartList(vs []*Part) func(protocol.ListEncoder) {
 	}
 }
 
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/Progress
+type Progress struct {
+	_ struct{} `type:"structure"`
+
+	// Current number of uncompressed object bytes processed.
+	BytesProcessed *int64 `type:"long"`
+
+	// Current number of bytes of records payload data returned.
+	BytesReturned *int64 `type:"long"`
+
+	// Current number of object bytes scanned.
+	BytesScanned *int64 `type:"long"`
+}
+
+// String returns the string representation
+func (s Progress) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s Progress) GoString() string {
+	return s.String()
+}
+
+// SetBytesProcessed sets the BytesProcessed field's value.
+func (s *Progress) SetBytesProcessed(v int64) *Progress {
+	s.BytesProcessed = &v
+	return s
+}
+
+// SetBytesReturned sets the BytesReturned field's value.
+func (s *Progress) SetBytesReturned(v int64) *Progress {
+	s.BytesReturned = &v
+	return s
+}
+
+// SetBytesScanned sets the BytesScanned field's value.
+func (s *Progress) SetBytesScanned(v int64) *Progress {
+	s.BytesScanned = &v
+	return s
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *Progress) MarshalFields(e protocol.FieldEncoder) error {
+	if s.BytesProcessed != nil {
+		v := *s.BytesProcessed
+
+		e.SetValue(protocol.BodyTarget, "BytesProcessed", protocol.Int64Value(v), protocol.Metadata{})
+	}
+	if s.BytesReturned != nil {
+		v := *s.BytesReturned
+
+		e.SetValue(protocol.BodyTarget, "BytesReturned", protocol.Int64Value(v), protocol.Metadata{})
+	}
+	if s.BytesScanned != nil {
+		v := *s.BytesScanned
+
+		e.SetValue(protocol.BodyTarget, "BytesScanned", protocol.Int64Value(v), protocol.Metadata{})
+	}
+
+	return nil
+}
+
+// An event of the progress of the request, sent periodically if the request's
+// RequestProgress is enabled.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/ProgressEvent
+type ProgressEvent struct {
+	_ struct{} `type:"structure" payload:"Details"`
+
+	// The Progress event details.
+	Details *Progress `type:"structure"`
+}
+
+// String returns the string representation
+func (s ProgressEvent) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s ProgressEvent) GoString() string {
+	return s.String()
+}
+
+// SetDetails sets the Details field's value.
+func (s *ProgressEvent) SetDetails(v *Progress) *ProgressEvent {
+	s.Details = v
+	return s
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *ProgressEvent) MarshalFields(e protocol.FieldEncoder) error {
+	if s.Details != nil {
+		v := s.Details
+
+		e.SetFields(protocol.PayloadTarget, "Details", v, protocol.Metadata{})
+	}
+
+	return nil
+}
+
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/PutBucketAccelerateConfigurationRequest
 type PutBucketAccelerateConfigurationInput struct {
 	_ struct{} `type:"structure" payload:"AccelerateConfiguration"`
@@ -19411,6 +21202,23 @@ func (s *PutBucketAccelerateConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutBucketAccelerateConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutBucketAccelerateConfigurationInput"}
+	if s.AccelerateConfiguration != nil {
+		if err := s.AccelerateConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AccelerateConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccelerateConfiguration sets the AccelerateConfiguration field's value.
 func (s *PutBucketAccelerateConfigurationInput) SetAccelerateConfiguration(v *AccelerateConfiguration) *PutBucketAccelerateConfigurationInput {
 	s.AccelerateConfiguration = v
@@ -19524,6 +21332,29 @@ func (s *PutBucketAclInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutBucketAclInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutBucketAclInput"}
+	if s.ACL != nil {
+		values := []string{"private", "public-read", "public-read-write", "authenticated-read"}
+		if !request.IsParamEnumValue(*s.ACL, values) {
+			invalidParams.Add(request.NewErrParamEnum("ACL", *s.ACL, values))
+		}
+	}
+	if s.AccessControlPolicy != nil {
+		if err := s.AccessControlPolicy.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AccessControlPolicy", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetACL sets the ACL field's value.
 func (s *PutBucketAclInput) SetACL(v string) *PutBucketAclInput {
 	s.ACL = &v
@@ -19700,6 +21531,23 @@ func (s *PutBucketAnalyticsConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutBucketAnalyticsConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutBucketAnalyticsConfigurationInput"}
+	if s.AnalyticsConfiguration != nil {
+		if err := s.AnalyticsConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AnalyticsConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAnalyticsConfiguration sets the AnalyticsConfiguration field's value.
 func (s *PutBucketAnalyticsConfigurationInput) SetAnalyticsConfiguration(v *AnalyticsConfiguration) *PutBucketAnalyticsConfigurationInput {
 	s.AnalyticsConfiguration = v
@@ -19919,6 +21767,23 @@ func (s *PutBucketInventoryConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutBucketInventoryConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutBucketInventoryConfigurationInput"}
+	if s.InventoryConfiguration != nil {
+		if err := s.InventoryConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("InventoryConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *PutBucketInventoryConfigurationInput) SetBucket(v string) *PutBucketInventoryConfigurationInput {
 	s.Bucket = &v
@@ -20024,6 +21889,23 @@ func (s *PutBucketLifecycleConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutBucketLifecycleConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutBucketLifecycleConfigurationInput"}
+	if s.LifecycleConfiguration != nil {
+		if err := s.LifecycleConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LifecycleConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *PutBucketLifecycleConfigurationInput) SetBucket(v string) *PutBucketLifecycleConfigurationInput {
 	s.Bucket = &v
@@ -20118,6 +22000,23 @@ func (s *PutBucketLifecycleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutBucketLifecycleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutBucketLifecycleInput"}
+	if s.LifecycleConfiguration != nil {
+		if err := s.LifecycleConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LifecycleConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *PutBucketLifecycleInput) SetBucket(v string) *PutBucketLifecycleInput {
 	s.Bucket = &v
@@ -20216,6 +22115,23 @@ func (s *PutBucketLoggingInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutBucketLoggingInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutBucketLoggingInput"}
+	if s.BucketLoggingStatus != nil {
+		if err := s.BucketLoggingStatus.ValidateExtended(); err != nil {
+			invalidParams.AddNested("BucketLoggingStatus", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *PutBucketLoggingInput) SetBucket(v string) *PutBucketLoggingInput {
 	s.Bucket = &v
@@ -20438,6 +22354,23 @@ func (s *PutBucketNotificationConfigurationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutBucketNotificationConfigurationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutBucketNotificationConfigurationInput"}
+	if s.NotificationConfiguration != nil {
+		if err := s.NotificationConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("NotificationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *PutBucketNotificationConfigurationInput) SetBucket(v string) *PutBucketNotificationConfigurationInput {
 	s.Bucket = &v
@@ -20531,6 +22464,23 @@ func (s *PutBucketNotificationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutBucketNotificationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutBucketNotificationInput"}
+	if s.NotificationConfiguration != nil {
+		if err := s.NotificationConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("NotificationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *PutBucketNotificationInput) SetBucket(v string) *PutBucketNotificationInput {
 	s.Bucket = &v
@@ -20727,6 +22677,23 @@ func (s *PutBucketReplicationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutBucketReplicationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutBucketReplicationInput"}
+	if s.ReplicationConfiguration != nil {
+		if err := s.ReplicationConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ReplicationConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *PutBucketReplicationInput) SetBucket(v string) *PutBucketReplicationInput {
 	s.Bucket = &v
@@ -20825,6 +22792,23 @@ func (s *PutBucketRequestPaymentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutBucketRequestPaymentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutBucketRequestPaymentInput"}
+	if s.RequestPaymentConfiguration != nil {
+		if err := s.RequestPaymentConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RequestPaymentConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *PutBucketRequestPaymentInput) SetBucket(v string) *PutBucketRequestPaymentInput {
 	s.Bucket = &v
@@ -21020,8 +23004,25 @@ func (s *PutBucketVersioningInput) Validate() error {
 	return nil
 }
 
-// SetBucket sets the Bucket field's value.
-func (s *PutBucketVersioningInput) SetBucket(v string) *PutBucketVersioningInput {
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutBucketVersioningInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutBucketVersioningInput"}
+	if s.VersioningConfiguration != nil {
+		if err := s.VersioningConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("VersioningConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBucket sets the Bucket field's value.
+func (s *PutBucketVersioningInput) SetBucket(v string) *PutBucketVersioningInput {
 	s.Bucket = &v
 	return s
 }
@@ -21129,6 +23130,23 @@ func (s *PutBucketWebsiteInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutBucketWebsiteInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutBucketWebsiteInput"}
+	if s.WebsiteConfiguration != nil {
+		if err := s.WebsiteConfiguration.ValidateExtended(); err != nil {
+			invalidParams.AddNested("WebsiteConfiguration", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *PutBucketWebsiteInput) SetBucket(v string) *PutBucketWebsiteInput {
 	s.Bucket = &v
@@ -21260,6 +23278,35 @@ func (s *PutObjectAclInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutObjectAclInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutObjectAclInput"}
+	if s.ACL != nil {
+		values := []string{"private", "public-read", "public-read-write", "authenticated-read", "aws-exec-read", "bucket-owner-read", "bucket-owner-full-control"}
+		if !request.IsParamEnumValue(*s.ACL, values) {
+			invalidParams.Add(request.NewErrParamEnum("ACL", *s.ACL, values))
+		}
+	}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+	if s.AccessControlPolicy != nil {
+		if err := s.AccessControlPolicy.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AccessControlPolicy", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetACL sets the ACL field's value.
 func (s *PutObjectAclInput) SetACL(v string) *PutObjectAclInput {
 	s.ACL = &v
@@ -21448,6 +23495,26 @@ type PutObjectInput struct {
 	// Specifies caching behavior along the request/reply chain.
 	CacheControl *string `location:"header" locationName:"Cache-Control" type:"string"`
 
+	// The algorithm of the checksum of the object, which is sent in the object's
+	// x-amz-checksum-* header.
+	ChecksumAlgorithm *string `location:"header" locationName:"x-amz-sdk-checksum-algorithm" type:"string" enum:"ChecksumAlgorithm"`
+
+	// The base64-encoded CRC32 checksum of the object, used by S3 to verify the
+	// integrity of the object.
+	ChecksumCRC32 *string `location:"header" locationName:"x-amz-checksum-crc32" type:"string"`
+
+	// The base64-encoded CRC32C checksum of the object, used by S3 to verify the
+	// integrity of the object.
+	ChecksumCRC32C *string `location:"header" locationName:"x-amz-checksum-crc32c" type:"string"`
+
+	// The base64-encoded SHA1 checksum of the object, used by S3 to verify the
+	// integrity of the object.
+	ChecksumSHA1 *string `location:"header" locationName:"x-amz-checksum-sha1" type:"string"`
+
+	// The base64-encoded SHA256 checksum of the object, used by S3 to verify the
+	// integrity of the object.
+	ChecksumSHA256 *string `location:"header" locationName:"x-amz-checksum-sha256" type:"string"`
+
 	// Specifies presentational information for the object.
 	ContentDisposition *string `location:"header" locationName:"Content-Disposition" type:"string"`
 
@@ -21503,7 +23570,7 @@ type PutObjectInput struct {
 	// does not store the encryption key. The key must be appropriate for use with
 	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
 	// header.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -21514,7 +23581,7 @@ type PutObjectInput struct {
 	// requests for an object protected by AWS KMS will fail if not made via SSL
 	// or using SigV4. Documentation on configuring any of the officially supported
 	// AWS SDKs and CLI can be found at http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingAWSSDK.html#specify-signature-version
-	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
@@ -21561,6 +23628,48 @@ func (s *PutObjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutObjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutObjectInput"}
+	if s.ACL != nil {
+		values := []string{"private", "public-read", "public-read-write", "authenticated-read", "aws-exec-read", "bucket-owner-read", "bucket-owner-full-control"}
+		if !request.IsParamEnumValue(*s.ACL, values) {
+			invalidParams.Add(request.NewErrParamEnum("ACL", *s.ACL, values))
+		}
+	}
+	if s.ChecksumAlgorithm != nil {
+		values := []string{"CRC32", "CRC32C", "SHA1", "SHA256"}
+		if !request.IsParamEnumValue(*s.ChecksumAlgorithm, values) {
+			invalidParams.Add(request.NewErrParamEnum("ChecksumAlgorithm", *s.ChecksumAlgorithm, values))
+		}
+	}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+	if s.ServerSideEncryption != nil {
+		values := []string{"AES256", "aws:kms"}
+		if !request.IsParamEnumValue(*s.ServerSideEncryption, values) {
+			invalidParams.Add(request.NewErrParamEnum("ServerSideEncryption", *s.ServerSideEncryption, values))
+		}
+	}
+	if s.StorageClass != nil {
+		values := []string{"STANDARD", "REDUCED_REDUNDANCY", "STANDARD_IA"}
+		if !request.IsParamEnumValue(*s.StorageClass, values) {
+			invalidParams.Add(request.NewErrParamEnum("StorageClass", *s.StorageClass, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetACL sets the ACL field's value.
 func (s *PutObjectInput) SetACL(v string) *PutObjectInput {
 	s.ACL = &v
@@ -21592,6 +23701,36 @@ func (s *PutObjectInput) SetCacheControl(v string) *PutObjectInput {
 	return s
 }
 
+// SetChecksumAlgorithm sets the ChecksumAlgorithm field's value.
+func (s *PutObjectInput) SetChecksumAlgorithm(v string) *PutObjectInput {
+	s.ChecksumAlgorithm = &v
+	return s
+}
+
+// SetChecksumCRC32 sets the ChecksumCRC32 field's value.
+func (s *PutObjectInput) SetChecksumCRC32(v string) *PutObjectInput {
+	s.ChecksumCRC32 = &v
+	return s
+}
+
+// SetChecksumCRC32C sets the ChecksumCRC32C field's value.
+func (s *PutObjectInput) SetChecksumCRC32C(v string) *PutObjectInput {
+	s.ChecksumCRC32C = &v
+	return s
+}
+
+// SetChecksumSHA1 sets the ChecksumSHA1 field's value.
+func (s *PutObjectInput) SetChecksumSHA1(v string) *PutObjectInput {
+	s.ChecksumSHA1 = &v
+	return s
+}
+
+// SetChecksumSHA256 sets the ChecksumSHA256 field's value.
+func (s *PutObjectInput) SetChecksumSHA256(v string) *PutObjectInput {
+	s.ChecksumSHA256 = &v
+	return s
+}
+
 // SetContentDisposition sets the ContentDisposition field's value.
 func (s *PutObjectInput) SetContentDisposition(v string) *PutObjectInput {
 	s.ContentDisposition = &v
@@ -21747,6 +23886,31 @@ func (s *PutObjectInput) MarshalFields(e protocol.FieldEncoder) error {
 
 		e.SetValue(protocol.HeaderTarget, "Cache-Control", protocol.StringValue(v), protocol.Metadata{})
 	}
+	if s.ChecksumAlgorithm != nil {
+		v := *s.ChecksumAlgorithm
+
+		e.SetValue(protocol.HeaderTarget, "x-amz-sdk-checksum-algorithm", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.ChecksumCRC32 != nil {
+		v := *s.ChecksumCRC32
+
+		e.SetValue(protocol.HeaderTarget, "x-amz-checksum-crc32", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.ChecksumCRC32C != nil {
+		v := *s.ChecksumCRC32C
+
+		e.SetValue(protocol.HeaderTarget, "x-amz-checksum-crc32c", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.ChecksumSHA1 != nil {
+		v := *s.ChecksumSHA1
+
+		e.SetValue(protocol.HeaderTarget, "x-amz-checksum-sha1", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.ChecksumSHA256 != nil {
+		v := *s.ChecksumSHA256
+
+		e.SetValue(protocol.HeaderTarget, "x-amz-checksum-sha256", protocol.StringValue(v), protocol.Metadata{})
+	}
 	if s.ContentDisposition != nil {
 		v := *s.ContentDisposition
 
@@ -21820,7 +23984,7 @@ func (s *PutObjectInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.SSECustomerKey != nil {
 		v := *s.SSECustomerKey
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.SSECustomerKeyMD5 != nil {
 		v := *s.SSECustomerKeyMD5
@@ -21830,7 +23994,7 @@ func (s *PutObjectInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.SSEKMSKeyId != nil {
 		v := *s.SSEKMSKeyId
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ServerSideEncryption != nil {
 		v := *s.ServerSideEncryption
@@ -21883,7 +24047,7 @@ type PutObjectOutput struct {
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
@@ -21981,7 +24145,7 @@ func (s *PutObjectOutput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.SSEKMSKeyId != nil {
 		v := *s.SSEKMSKeyId
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ServerSideEncryption != nil {
 		v := *s.ServerSideEncryption
@@ -22191,6 +24355,23 @@ func (s *QueueConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *QueueConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "QueueConfiguration"}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEvents sets the Events field's value.
 func (s *QueueConfiguration) SetEvents(v []*string) *QueueConfiguration {
 	s.Events = v
@@ -22275,6 +24456,24 @@ func (s QueueConfigurationDeprecated) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *QueueConfigurationDeprecated) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "QueueConfigurationDeprecated"}
+	if s.Event != nil {
+		values := []string{"s3:ReducedRedundancyLostObject", "s3:ObjectCreated:*", "s3:ObjectCreated:Put", "s3:ObjectCreated:Post", "s3:ObjectCreated:Copy", "s3:ObjectCreated:CompleteMultipartUpload", "s3:ObjectRemoved:*", "s3:ObjectRemoved:Delete", "s3:ObjectRemoved:DeleteMarkerCreated"}
+		if !request.IsParamEnumValue(*s.Event, values) {
+			invalidParams.Add(request.NewErrParamEnum("Event", *s.Event, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEvent sets the Event field's value.
 func (s *QueueConfigurationDeprecated) SetEvent(v string) *QueueConfigurationDeprecated {
 	s.Event = &v
@@ -22325,6 +24524,43 @@ func (s *QueueConfigurationDeprecated) MarshalFields(e protocol.FieldEncoder) er
 	return nil
 }
 
+// An event of the records selected, serialized with the request's OutputSerialization.
+// The records of an event may end within a record, continued by the next RecordsEvent.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/RecordsEvent
+type RecordsEvent struct {
+	_ struct{} `type:"structure" payload:"Payload"`
+
+	// The byte array of partial, one or more result records.
+	Payload []byte `type:"blob"`
+}
+
+// String returns the string representation
+func (s RecordsEvent) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s RecordsEvent) GoString() string {
+	return s.String()
+}
+
+// SetPayload sets the Payload field's value.
+func (s *RecordsEvent) SetPayload(v []byte) *RecordsEvent {
+	s.Payload = v
+	return s
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *RecordsEvent) MarshalFields(e protocol.FieldEncoder) error {
+	if s.Payload != nil {
+		v := s.Payload
+
+		e.SetStream(protocol.PayloadTarget, "Payload", protocol.BytesStream(v), protocol.Metadata{})
+	}
+
+	return nil
+}
+
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/Redirect
 type Redirect struct {
 	_ struct{} `type:"structure"`
@@ -22364,6 +24600,24 @@ func (s Redirect) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Redirect) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Redirect"}
+	if s.Protocol != nil {
+		values := []string{"http", "https"}
+		if !request.IsParamEnumValue(*s.Protocol, values) {
+			invalidParams.Add(request.NewErrParamEnum("Protocol", *s.Protocol, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHostName sets the HostName field's value.
 func (s *Redirect) SetHostName(v string) *Redirect {
 	s.HostName = &v
@@ -22462,6 +24716,24 @@ func (s *RedirectAllRequestsTo) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RedirectAllRequestsTo) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RedirectAllRequestsTo"}
+	if s.Protocol != nil {
+		values := []string{"http", "https"}
+		if !request.IsParamEnumValue(*s.Protocol, values) {
+			invalidParams.Add(request.NewErrParamEnum("Protocol", *s.Protocol, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetHostName sets the HostName field's value.
 func (s *RedirectAllRequestsTo) SetHostName(v string) *RedirectAllRequestsTo {
 	s.HostName = &v
@@ -22545,6 +24817,28 @@ func (s *ReplicationConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReplicationConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReplicationConfiguration"}
+	if s.Rules != nil {
+		for i, v := range s.Rules {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Rules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRole sets the Role field's value.
 func (s *ReplicationConfiguration) SetRole(v string) *ReplicationConfiguration {
 	s.Role = &v
@@ -22630,6 +24924,29 @@ func (s *ReplicationRule) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReplicationRule) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReplicationRule"}
+	if s.Status != nil {
+		values := []string{"Enabled", "Disabled"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+	if s.Destination != nil {
+		if err := s.Destination.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Destination", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestination sets the Destination field's value.
 func (s *ReplicationRule) SetDestination(v *Destination) *ReplicationRule {
 	s.Destination = v
@@ -22721,6 +25038,24 @@ func (s *RequestPaymentConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RequestPaymentConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RequestPaymentConfiguration"}
+	if s.Payer != nil {
+		values := []string{"Requester", "BucketOwner"}
+		if !request.IsParamEnumValue(*s.Payer, values) {
+			invalidParams.Add(request.NewErrParamEnum("Payer", *s.Payer, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPayer sets the Payer field's value.
 func (s *RequestPaymentConfiguration) SetPayer(v string) *RequestPaymentConfiguration {
 	s.Payer = &v
@@ -22738,6 +25073,42 @@ func (s *RequestPaymentConfiguration) MarshalFields(e protocol.FieldEncoder) err
 	return nil
 }
 
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/RequestProgress
+type RequestProgress struct {
+	_ struct{} `type:"structure"`
+
+	// Specifies whether periodic QueryProgress frames should be sent. Valid values:
+	// TRUE, FALSE. Default value: FALSE.
+	Enabled *bool `type:"boolean"`
+}
+
+// String returns the string representation
+func (s RequestProgress) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s RequestProgress) GoString() string {
+	return s.String()
+}
+
+// SetEnabled sets the Enabled field's value.
+func (s *RequestProgress) SetEnabled(v bool) *RequestProgress {
+	s.Enabled = &v
+	return s
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *RequestProgress) MarshalFields(e protocol.FieldEncoder) error {
+	if s.Enabled != nil {
+		v := *s.Enabled
+
+		e.SetValue(protocol.BodyTarget, "Enabled", protocol.BoolValue(v), protocol.Metadata{})
+	}
+
+	return nil
+}
+
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/RestoreObjectRequest
 type RestoreObjectInput struct {
 	_ struct{} `type:"structure" payload:"RestoreRequest"`
@@ -22793,6 +25164,29 @@ func (s *RestoreObjectInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RestoreObjectInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RestoreObjectInput"}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+	if s.RestoreRequest != nil {
+		if err := s.RestoreRequest.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RestoreRequest", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *RestoreObjectInput) SetBucket(v string) *RestoreObjectInput {
 	s.Bucket = &v
@@ -22938,6 +25332,23 @@ func (s *RestoreRequest) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RestoreRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RestoreRequest"}
+	if s.GlacierJobParameters != nil {
+		if err := s.GlacierJobParameters.ValidateExtended(); err != nil {
+			invalidParams.AddNested("GlacierJobParameters", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDays sets the Days field's value.
 func (s *RestoreRequest) SetDays(v int64) *RestoreRequest {
 	s.Days = &v
@@ -23007,11 +25418,28 @@ func (s *RoutingRule) Validate() error {
 	return nil
 }
 
-// SetCondition sets the Condition field's value.
-func (s *RoutingRule) SetCondition(v *Condition) *RoutingRule {
-	s.Condition = v
-	return s
-}
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RoutingRule) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RoutingRule"}
+	if s.Redirect != nil {
+		if err := s.Redirect.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Redirect", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetCondition sets the Condition field's value.
+func (s *RoutingRule) SetCondition(v *Condition) *RoutingRule {
+	s.Condition = v
+	return s
+}
 
 // SetRedirect sets the Redirect field's value.
 func (s *RoutingRule) SetRedirect(v *Redirect) *RoutingRule {
@@ -23110,6 +25538,34 @@ func (s *Rule) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Rule) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Rule"}
+	if s.Status != nil {
+		values := []string{"Enabled", "Disabled"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+	if s.NoncurrentVersionTransition != nil {
+		if err := s.NoncurrentVersionTransition.ValidateExtended(); err != nil {
+			invalidParams.AddNested("NoncurrentVersionTransition", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Transition != nil {
+		if err := s.Transition.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Transition", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAbortIncompleteMultipartUpload sets the AbortIncompleteMultipartUpload field's value.
 func (s *Rule) SetAbortIncompleteMultipartUpload(v *AbortIncompleteMultipartUpload) *Rule {
 	s.AbortIncompleteMultipartUpload = v
@@ -23134,82 +25590,646 @@ func (s *Rule) SetNoncurrentVersionExpiration(v *NoncurrentVersionExpiration) *R
 	return s
 }
 
-// SetNoncurrentVersionTransition sets the NoncurrentVersionTransition field's value.
-func (s *Rule) SetNoncurrentVersionTransition(v *NoncurrentVersionTransition) *Rule {
-	s.NoncurrentVersionTransition = v
-	return s
+// SetNoncurrentVersionTransition sets the NoncurrentVersionTransition field's value.
+func (s *Rule) SetNoncurrentVersionTransition(v *NoncurrentVersionTransition) *Rule {
+	s.NoncurrentVersionTransition = v
+	return s
+}
+
+// SetPrefix sets the Prefix field's value.
+func (s *Rule) SetPrefix(v string) *Rule {
+	s.Prefix = &v
+	return s
+}
+
+// SetStatus sets the Status field's value.
+func (s *Rule) SetStatus(v string) *Rule {
+	s.Status = &v
+	return s
+}
+
+// SetTransition sets the Transition field's value.
+func (s *Rule) SetTransition(v *Transition) *Rule {
+	s.Transition = v
+	return s
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *Rule) MarshalFields(e protocol.FieldEncoder) error {
+	if s.AbortIncompleteMultipartUpload != nil {
+		v := s.AbortIncompleteMultipartUpload
+
+		e.SetFields(protocol.BodyTarget, "AbortIncompleteMultipartUpload", v, protocol.Metadata{})
+	}
+	if s.Expiration != nil {
+		v := s.Expiration
+
+		e.SetFields(protocol.BodyTarget, "Expiration", v, protocol.Metadata{})
+	}
+	if s.ID != nil {
+		v := *s.ID
+
+		e.SetValue(protocol.BodyTarget, "ID", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.NoncurrentVersionExpiration != nil {
+		v := s.NoncurrentVersionExpiration
+
+		e.SetFields(protocol.BodyTarget, "NoncurrentVersionExpiration", v, protocol.Metadata{})
+	}
+	if s.NoncurrentVersionTransition != nil {
+		v := s.NoncurrentVersionTransition
+
+		e.SetFields(protocol.BodyTarget, "NoncurrentVersionTransition", v, protocol.Metadata{})
+	}
+	if s.Prefix != nil {
+		v := *s.Prefix
+
+		e.SetValue(protocol.BodyTarget, "Prefix", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.Status != nil {
+		v := *s.Status
+
+		e.SetValue(protocol.BodyTarget, "Status", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.Transition != nil {
+		v := s.Transition
+
+		e.SetFields(protocol.BodyTarget, "Transition", v, protocol.Metadata{})
+	}
+
+	return nil
+}
+
+func encodeRuleList(vs []*Rule) func(protocol.ListEncoder) {
+	return func(le protocol.ListEncoder) {
+		for _, v := range vs {
+			le.ListAddFields(v)
+		}
+	}
+}
+
+// SelectObjectContentEventStreamEvent is an event of the
+// SelectObjectContentEventStream event stream. The event is one of the
+// following types:
+//
+//     * *ContinuationEvent
+//     * *EndEvent
+//     * *ProgressEvent
+//     * *RecordsEvent
+//     * *StatsEvent
+type SelectObjectContentEventStreamEvent interface {
+	eventSelectObjectContentEventStream()
+}
+
+func (*ContinuationEvent) eventSelectObjectContentEventStream() {}
+
+func (*EndEvent) eventSelectObjectContentEventStream() {}
+
+func (*ProgressEvent) eventSelectObjectContentEventStream() {}
+
+func (*RecordsEvent) eventSelectObjectContentEventStream() {}
+
+func (*StatsEvent) eventSelectObjectContentEventStream() {}
+
+// SelectObjectContentEventStreamReader reads the events of the
+// SelectObjectContentEventStream event stream of a response.
+//
+// The events are sent on the Events channel, which is closed once the stream
+// ends, or fails. Err returns the error of the stream, if any, once the Events
+// channel is closed. The reader must be closed once the caller is done reading
+// the events, to release the response's connection.
+type SelectObjectContentEventStreamReader struct {
+	body   io.ReadCloser
+	events chan SelectObjectContentEventStreamEvent
+
+	// done is closed when the reader is closed, and readDone when the
+	// reader's read loop returns.
+	done     chan struct{}
+	readDone chan struct{}
+
+	m   sync.Mutex
+	err error
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// newSelectObjectContentEventStreamReader returns a reader of the event
+// stream of the body, reading the stream's events until the stream ends or
+// the reader is closed.
+func newSelectObjectContentEventStreamReader(body io.ReadCloser) *SelectObjectContentEventStreamReader {
+	r := &SelectObjectContentEventStreamReader{
+		body:     body,
+		events:   make(chan SelectObjectContentEventStreamEvent),
+		done:     make(chan struct{}),
+		readDone: make(chan struct{}),
+	}
+	go r.readLoop()
+
+	return r
+}
+
+// Events returns the channel the events of the stream are sent on. The
+// channel is closed once the stream ends, fails, or the reader is closed.
+func (r *SelectObjectContentEventStreamReader) Events() <-chan SelectObjectContentEventStreamEvent {
+	return r.events
+}
+
+// Err returns the error of the event stream, nil if the stream has not
+// failed. The stream fails with the error message sent by the service, or if
+// its messages could not be decoded.
+// The stream also fails with an error with the code
+// ErrCodeEventStreamTruncated if it ends without an EndEvent.
+func (r *SelectObjectContentEventStreamReader) Err() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	return r.err
+}
+
+// Close stops reading the events of the stream, and drains and closes the
+// response's body so that its connection can be reused. The events not read
+// are discarded. Close is safe to call multiple times.
+func (r *SelectObjectContentEventStreamReader) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.done)
+		<-r.readDone
+
+		_, err := io.Copy(ioutil.Discard, r.body)
+		if cerr := r.body.Close(); err == nil {
+			err = cerr
+		}
+		r.closeErr = err
+	})
+
+	return r.closeErr
+}
+
+func (r *SelectObjectContentEventStreamReader) readLoop() {
+	defer close(r.readDone)
+	defer close(r.events)
+
+	decoder := eventstream.NewDecoder(r.body)
+	for {
+		msg, err := decoder.Decode(nil)
+		if err == io.EOF {
+			r.setErr(awserr.New(ErrCodeEventStreamTruncated,
+				"event stream ended without an EndEvent, results may be truncated", nil))
+			return
+		} else if err != nil {
+			r.setErr(awserr.New(request.ErrCodeSerialization,
+				"failed to decode event stream message", err))
+			return
+		}
+
+		event, err := unmarshalSelectObjectContentEventStreamEvent(msg)
+		if err != nil {
+			r.setErr(err)
+			return
+		}
+		if event == nil {
+			// Events unknown to the SDK are skipped.
+			continue
+		}
+
+		select {
+		case r.events <- event:
+		case <-r.done:
+			return
+		}
+
+		if _, ok := event.(*EndEvent); ok {
+			return
+		}
+	}
+}
+
+func (r *SelectObjectContentEventStreamReader) setErr(err error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.err = err
+}
+
+// unmarshalSelectObjectContentEventStreamEvent returns the event of the event
+// stream message, or the error of the message if it is an error or exception
+// message. Returns nil if the message's event type is not known.
+func unmarshalSelectObjectContentEventStreamEvent(msg eventstream.Message) (SelectObjectContentEventStreamEvent, error) {
+	if err := eventStreamMessageError(msg); err != nil {
+		return nil, err
+	}
+
+	switch eventType := eventStreamHeader(msg, ":event-type"); eventType {
+	case "Cont":
+		return &ContinuationEvent{}, nil
+	case "End":
+		return &EndEvent{}, nil
+	case "Progress":
+		event := &ProgressEvent{Details: &Progress{}}
+		if err := unmarshalEventPayload(event.Details, eventType, msg.Payload); err != nil {
+			return nil, err
+		}
+		return event, nil
+	case "Records":
+		return &RecordsEvent{Payload: msg.Payload}, nil
+	case "Stats":
+		event := &StatsEvent{Details: &Stats{}}
+		if err := unmarshalEventPayload(event.Details, eventType, msg.Payload); err != nil {
+			return nil, err
+		}
+		return event, nil
+	default:
+		return nil, nil
+	}
+}
+
+// Request to filter the contents of an Amazon S3 object based on a simple Structured
+// Query Language (SQL) statement. In the request, along with the SQL expression,
+// you must also specify a data serialization format (JSON or CSV) of the object.
+// Amazon S3 uses this to parse object data into records, and returns only records
+// that match the specified SQL expression. You must also specify the data serialization
+// format for the response.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/SelectObjectContentRequest
+type SelectObjectContentInput struct {
+	_ struct{} `locationName:"SelectObjectContentRequest" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+
+	// The S3 Bucket.
+	//
+	// Bucket is a required field
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	// The expression that is used to query the object.
+	//
+	// Expression is a required field
+	Expression *string `type:"string" required:"true"`
+
+	// The type of the provided expression (e.g., SQL).
+	//
+	// ExpressionType is a required field
+	ExpressionType *string `type:"string" required:"true" enum:"ExpressionType"`
+
+	// Describes the format of the data in the object that is being queried.
+	//
+	// InputSerialization is a required field
+	InputSerialization *InputSerialization `type:"structure" required:"true"`
+
+	// The Object Key.
+	//
+	// Key is a required field
+	Key *string `location:"uri" locationName:"Key" min:"1" type:"string" required:"true"`
+
+	// Describes the format of the data that you want Amazon S3 to return in response.
+	//
+	// OutputSerialization is a required field
+	OutputSerialization *OutputSerialization `type:"structure" required:"true"`
+
+	// Specifies if periodic request progress information should be enabled.
+	RequestProgress *RequestProgress `type:"structure"`
+
+	// The SSE Algorithm used to encrypt the object.
+	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+
+	// The SSE Customer Key.
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string" sensitive:"true"`
+
+	// The SSE Customer Key MD5.
+	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+}
+
+// String returns the string representation
+func (s SelectObjectContentInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s SelectObjectContentInput) GoString() string {
+	return s.String()
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *SelectObjectContentInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "SelectObjectContentInput"}
+	if s.Bucket == nil {
+		invalidParams.Add(request.NewErrParamRequired("Bucket"))
+	}
+	if s.Expression == nil {
+		invalidParams.Add(request.NewErrParamRequired("Expression"))
+	}
+	if s.ExpressionType == nil {
+		invalidParams.Add(request.NewErrParamRequired("ExpressionType"))
+	}
+	if s.InputSerialization == nil {
+		invalidParams.Add(request.NewErrParamRequired("InputSerialization"))
+	}
+	if s.Key == nil {
+		invalidParams.Add(request.NewErrParamRequired("Key"))
+	}
+	if s.Key != nil && len(*s.Key) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+	}
+	if s.OutputSerialization == nil {
+		invalidParams.Add(request.NewErrParamRequired("OutputSerialization"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SelectObjectContentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SelectObjectContentInput"}
+	if s.ExpressionType != nil {
+		values := []string{"SQL"}
+		if !request.IsParamEnumValue(*s.ExpressionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ExpressionType", *s.ExpressionType, values))
+		}
+	}
+	if s.InputSerialization != nil {
+		if err := s.InputSerialization.ValidateExtended(); err != nil {
+			invalidParams.AddNested("InputSerialization", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.OutputSerialization != nil {
+		if err := s.OutputSerialization.ValidateExtended(); err != nil {
+			invalidParams.AddNested("OutputSerialization", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetBucket sets the Bucket field's value.
+func (s *SelectObjectContentInput) SetBucket(v string) *SelectObjectContentInput {
+	s.Bucket = &v
+	return s
+}
+
+func (s *SelectObjectContentInput) getBucket() (v string) {
+	if s.Bucket == nil {
+		return v
+	}
+	return *s.Bucket
+}
+
+// SetExpression sets the Expression field's value.
+func (s *SelectObjectContentInput) SetExpression(v string) *SelectObjectContentInput {
+	s.Expression = &v
+	return s
+}
+
+// SetExpressionType sets the ExpressionType field's value.
+func (s *SelectObjectContentInput) SetExpressionType(v string) *SelectObjectContentInput {
+	s.ExpressionType = &v
+	return s
+}
+
+// SetInputSerialization sets the InputSerialization field's value.
+func (s *SelectObjectContentInput) SetInputSerialization(v *InputSerialization) *SelectObjectContentInput {
+	s.InputSerialization = v
+	return s
+}
+
+// SetKey sets the Key field's value.
+func (s *SelectObjectContentInput) SetKey(v string) *SelectObjectContentInput {
+	s.Key = &v
+	return s
+}
+
+// SetOutputSerialization sets the OutputSerialization field's value.
+func (s *SelectObjectContentInput) SetOutputSerialization(v *OutputSerialization) *SelectObjectContentInput {
+	s.OutputSerialization = v
+	return s
+}
+
+// SetRequestProgress sets the RequestProgress field's value.
+func (s *SelectObjectContentInput) SetRequestProgress(v *RequestProgress) *SelectObjectContentInput {
+	s.RequestProgress = v
+	return s
+}
+
+// SetSSECustomerAlgorithm sets the SSECustomerAlgorithm field's value.
+func (s *SelectObjectContentInput) SetSSECustomerAlgorithm(v string) *SelectObjectContentInput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
+
+// SetSSECustomerKey sets the SSECustomerKey field's value.
+func (s *SelectObjectContentInput) SetSSECustomerKey(v string) *SelectObjectContentInput {
+	s.SSECustomerKey = &v
+	return s
+}
+
+func (s *SelectObjectContentInput) getSSECustomerKey() (v string) {
+	if s.SSECustomerKey == nil {
+		return v
+	}
+	return *s.SSECustomerKey
+}
+
+// SetSSECustomerKeyMD5 sets the SSECustomerKeyMD5 field's value.
+func (s *SelectObjectContentInput) SetSSECustomerKeyMD5(v string) *SelectObjectContentInput {
+	s.SSECustomerKeyMD5 = &v
+	return s
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *SelectObjectContentInput) MarshalFields(e protocol.FieldEncoder) error {
+	if s.Bucket != nil {
+		v := *s.Bucket
+
+		e.SetValue(protocol.PathTarget, "Bucket", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.Key != nil {
+		v := *s.Key
+
+		e.SetValue(protocol.PathTarget, "Key", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.SSECustomerAlgorithm != nil {
+		v := *s.SSECustomerAlgorithm
+
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-algorithm", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.SSECustomerKey != nil {
+		v := *s.SSECustomerKey
+
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
+	}
+	if s.SSECustomerKeyMD5 != nil {
+		v := *s.SSECustomerKeyMD5
+
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key-MD5", protocol.StringValue(v), protocol.Metadata{})
+	}
+
+	e.SetFields(protocol.BodyTarget, "SelectObjectContentRequest", protocol.FieldMarshalerFunc(func(e protocol.FieldEncoder) error {
+		if s.Expression != nil {
+			v := *s.Expression
+
+			e.SetValue(protocol.BodyTarget, "Expression", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.ExpressionType != nil {
+			v := *s.ExpressionType
+
+			e.SetValue(protocol.BodyTarget, "ExpressionType", protocol.StringValue(v), protocol.Metadata{})
+		}
+		if s.InputSerialization != nil {
+			v := s.InputSerialization
+
+			e.SetFields(protocol.BodyTarget, "InputSerialization", v, protocol.Metadata{})
+		}
+		if s.OutputSerialization != nil {
+			v := s.OutputSerialization
+
+			e.SetFields(protocol.BodyTarget, "OutputSerialization", v, protocol.Metadata{})
+		}
+		if s.RequestProgress != nil {
+			v := s.RequestProgress
+
+			e.SetFields(protocol.BodyTarget, "RequestProgress", v, protocol.Metadata{})
+		}
+
+		return nil
+	}), protocol.Metadata{XMLNamespaceURI: "http://s3.amazonaws.com/doc/2006-03-01/"})
+
+	return nil
+}
+
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/SelectObjectContentOutput
+type SelectObjectContentOutput struct {
+	_ struct{} `type:"structure"`
+
+	// EventStream reads the events of the response's event stream. The EventStream
+	// must be closed once the caller is done reading its events.
+	EventStream *SelectObjectContentEventStreamReader
+}
+
+// String returns the string representation
+func (s SelectObjectContentOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s SelectObjectContentOutput) GoString() string {
+	return s.String()
+}
+
+// unmarshalEventStream starts reading the response's event stream with the
+// output's SelectObjectContentEventStreamReader.
+func (s *SelectObjectContentOutput) unmarshalEventStream(r *request.Request) {
+	s.EventStream = newSelectObjectContentEventStreamReader(r.HTTPResponse.Body)
+}
+
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *SelectObjectContentOutput) MarshalFields(e protocol.FieldEncoder) error {
+	// Skipping EventStream Output type's event stream.
+
+	return nil
+}
+
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/Stats
+type Stats struct {
+	_ struct{} `type:"structure"`
+
+	// Total number of uncompressed object bytes processed.
+	BytesProcessed *int64 `type:"long"`
+
+	// Total number of bytes of records payload data returned.
+	BytesReturned *int64 `type:"long"`
+
+	// Total number of object bytes scanned.
+	BytesScanned *int64 `type:"long"`
+}
+
+// String returns the string representation
+func (s Stats) String() string {
+	return awsutil.Prettify(s)
+}
+
+// GoString returns the string representation
+func (s Stats) GoString() string {
+	return s.String()
 }
 
-// SetPrefix sets the Prefix field's value.
-func (s *Rule) SetPrefix(v string) *Rule {
-	s.Prefix = &v
+// SetBytesProcessed sets the BytesProcessed field's value.
+func (s *Stats) SetBytesProcessed(v int64) *Stats {
+	s.BytesProcessed = &v
 	return s
 }
 
-// SetStatus sets the Status field's value.
-func (s *Rule) SetStatus(v string) *Rule {
-	s.Status = &v
+// SetBytesReturned sets the BytesReturned field's value.
+func (s *Stats) SetBytesReturned(v int64) *Stats {
+	s.BytesReturned = &v
 	return s
 }
 
-// SetTransition sets the Transition field's value.
-func (s *Rule) SetTransition(v *Transition) *Rule {
-	s.Transition = v
+// SetBytesScanned sets the BytesScanned field's value.
+func (s *Stats) SetBytesScanned(v int64) *Stats {
+	s.BytesScanned = &v
 	return s
 }
 
 // MarshalFields encodes the AWS API shape using the passed in protocol encoder.
-func (s *Rule) MarshalFields(e protocol.FieldEncoder) error {
-	if s.AbortIncompleteMultipartUpload != nil {
-		v := s.AbortIncompleteMultipartUpload
+func (s *Stats) MarshalFields(e protocol.FieldEncoder) error {
+	if s.BytesProcessed != nil {
+		v := *s.BytesProcessed
 
-		e.SetFields(protocol.BodyTarget, "AbortIncompleteMultipartUpload", v, protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "BytesProcessed", protocol.Int64Value(v), protocol.Metadata{})
 	}
-	if s.Expiration != nil {
-		v := s.Expiration
+	if s.BytesReturned != nil {
+		v := *s.BytesReturned
 
-		e.SetFields(protocol.BodyTarget, "Expiration", v, protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "BytesReturned", protocol.Int64Value(v), protocol.Metadata{})
 	}
-	if s.ID != nil {
-		v := *s.ID
+	if s.BytesScanned != nil {
+		v := *s.BytesScanned
 
-		e.SetValue(protocol.BodyTarget, "ID", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "BytesScanned", protocol.Int64Value(v), protocol.Metadata{})
 	}
-	if s.NoncurrentVersionExpiration != nil {
-		v := s.NoncurrentVersionExpiration
 
-		e.SetFields(protocol.BodyTarget, "NoncurrentVersionExpiration", v, protocol.Metadata{})
-	}
-	if s.NoncurrentVersionTransition != nil {
-		v := s.NoncurrentVersionTransition
+	return nil
+}
 
-		e.SetFields(protocol.BodyTarget, "NoncurrentVersionTransition", v, protocol.Metadata{})
-	}
-	if s.Prefix != nil {
-		v := *s.Prefix
+// The event of the statistics of the request, sent once the records have been
+// selected.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/StatsEvent
+type StatsEvent struct {
+	_ struct{} `type:"structure" payload:"Details"`
 
-		e.SetValue(protocol.BodyTarget, "Prefix", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.Status != nil {
-		v := *s.Status
+	// The Stats event details.
+	Details *Stats `type:"structure"`
+}
 
-		e.SetValue(protocol.BodyTarget, "Status", protocol.StringValue(v), protocol.Metadata{})
-	}
-	if s.Transition != nil {
-		v := s.Transition
+// String returns the string representation
+func (s StatsEvent) String() string {
+	return awsutil.Prettify(s)
+}
 
-		e.SetFields(protocol.BodyTarget, "Transition", v, protocol.Metadata{})
-	}
+// GoString returns the string representation
+func (s StatsEvent) GoString() string {
+	return s.String()
+}
 
-	return nil
+// SetDetails sets the Details field's value.
+func (s *StatsEvent) SetDetails(v *Stats) *StatsEvent {
+	s.Details = v
+	return s
 }
 
-func encodeRuleList(vs []*Rule) func(protocol.ListEncoder) {
-	return func(le protocol.ListEncoder) {
-		for _, v := range vs {
-			le.ListAddFields(v)
-		}
+// MarshalFields encodes the AWS API shape using the passed in protocol encoder.
+func (s *StatsEvent) MarshalFields(e protocol.FieldEncoder) error {
+	if s.Details != nil {
+		v := s.Details
+
+		e.SetFields(protocol.PayloadTarget, "Details", v, protocol.Metadata{})
 	}
+
+	return nil
 }
 
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/s3-2006-03-01/StorageClassAnalysis
@@ -23246,6 +26266,23 @@ func (s *StorageClassAnalysis) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StorageClassAnalysis) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StorageClassAnalysis"}
+	if s.DataExport != nil {
+		if err := s.DataExport.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DataExport", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDataExport sets the DataExport field's value.
 func (s *StorageClassAnalysis) SetDataExport(v *StorageClassAnalysisDataExport) *StorageClassAnalysis {
 	s.DataExport = v
@@ -23309,6 +26346,29 @@ func (s *StorageClassAnalysisDataExport) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StorageClassAnalysisDataExport) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StorageClassAnalysisDataExport"}
+	if s.OutputSchemaVersion != nil {
+		values := []string{"V_1"}
+		if !request.IsParamEnumValue(*s.OutputSchemaVersion, values) {
+			invalidParams.Add(request.NewErrParamEnum("OutputSchemaVersion", *s.OutputSchemaVersion, values))
+		}
+	}
+	if s.Destination != nil {
+		if err := s.Destination.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Destination", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDestination sets the Destination field's value.
 func (s *StorageClassAnalysisDataExport) SetDestination(v *AnalyticsExportDestination) *StorageClassAnalysisDataExport {
 	s.Destination = v
@@ -23510,6 +26570,29 @@ func (s *TargetGrant) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TargetGrant) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TargetGrant"}
+	if s.Permission != nil {
+		values := []string{"FULL_CONTROL", "READ", "WRITE"}
+		if !request.IsParamEnumValue(*s.Permission, values) {
+			invalidParams.Add(request.NewErrParamEnum("Permission", *s.Permission, values))
+		}
+	}
+	if s.Grantee != nil {
+		if err := s.Grantee.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Grantee", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGrantee sets the Grantee field's value.
 func (s *TargetGrant) SetGrantee(v *Grantee) *TargetGrant {
 	s.Grantee = v
@@ -23601,6 +26684,23 @@ func (s *TopicConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TopicConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TopicConfiguration"}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEvents sets the Events field's value.
 func (s *TopicConfiguration) SetEvents(v []*string) *TopicConfiguration {
 	s.Events = v
@@ -23687,6 +26787,24 @@ func (s TopicConfigurationDeprecated) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TopicConfigurationDeprecated) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TopicConfigurationDeprecated"}
+	if s.Event != nil {
+		values := []string{"s3:ReducedRedundancyLostObject", "s3:ObjectCreated:*", "s3:ObjectCreated:Put", "s3:ObjectCreated:Post", "s3:ObjectCreated:Copy", "s3:ObjectCreated:CompleteMultipartUpload", "s3:ObjectRemoved:*", "s3:ObjectRemoved:Delete", "s3:ObjectRemoved:DeleteMarkerCreated"}
+		if !request.IsParamEnumValue(*s.Event, values) {
+			invalidParams.Add(request.NewErrParamEnum("Event", *s.Event, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEvent sets the Event field's value.
 func (s *TopicConfigurationDeprecated) SetEvent(v string) *TopicConfigurationDeprecated {
 	s.Event = &v
@@ -23763,6 +26881,24 @@ func (s Transition) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Transition) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Transition"}
+	if s.StorageClass != nil {
+		values := []string{"GLACIER", "STANDARD_IA"}
+		if !request.IsParamEnumValue(*s.StorageClass, values) {
+			invalidParams.Add(request.NewErrParamEnum("StorageClass", *s.StorageClass, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDate sets the Date field's value.
 func (s *Transition) SetDate(v time.Time) *Transition {
 	s.Date = &v
@@ -23849,7 +26985,7 @@ type UploadPartCopyInput struct {
 	// Specifies the customer-provided encryption key for Amazon S3 to use to decrypt
 	// the source object. The encryption key provided in this header must be one
 	// that was used when the source object was created.
-	CopySourceSSECustomerKey *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key" type:"string"`
+	CopySourceSSECustomerKey *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -23880,7 +27016,7 @@ type UploadPartCopyInput struct {
 	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
 	// header. This must be the same encryption key specified in the initiate multipart
 	// upload request.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -23931,6 +27067,27 @@ func (s *UploadPartCopyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UploadPartCopyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UploadPartCopyInput"}
+	if s.CopySource != nil && !request.MatchParamPattern("\\/.+\\/.+", *s.CopySource) {
+		invalidParams.Add(request.NewErrParamPattern("CopySource", "\\/.+\\/.+"))
+	}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucket sets the Bucket field's value.
 func (s *UploadPartCopyInput) SetBucket(v string) *UploadPartCopyInput {
 	s.Bucket = &v
@@ -24099,7 +27256,7 @@ func (s *UploadPartCopyInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.CopySourceSSECustomerKey != nil {
 		v := *s.CopySourceSSECustomerKey
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-copy-source-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-copy-source-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.CopySourceSSECustomerKeyMD5 != nil {
 		v := *s.CopySourceSSECustomerKeyMD5
@@ -24129,7 +27286,7 @@ func (s *UploadPartCopyInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.SSECustomerKey != nil {
 		v := *s.SSECustomerKey
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.SSECustomerKeyMD5 != nil {
 		v := *s.SSECustomerKeyMD5
@@ -24171,7 +27328,7 @@ type UploadPartCopyOutput struct {
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
@@ -24260,7 +27417,7 @@ func (s *UploadPartCopyOutput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.SSEKMSKeyId != nil {
 		v := *s.SSEKMSKeyId
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ServerSideEncryption != nil {
 		v := *s.ServerSideEncryption
@@ -24283,6 +27440,26 @@ type UploadPartInput struct {
 	// Bucket is a required field
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
+	// The algorithm of the checksum of the part, which is sent in the part's x-amz-checksum-*
+	// header. Must be the algorithm of the multipart upload.
+	ChecksumAlgorithm *string `location:"header" locationName:"x-amz-sdk-checksum-algorithm" type:"string" enum:"ChecksumAlgorithm"`
+
+	// The base64-encoded CRC32 checksum of the part, used by S3 to verify the integrity
+	// of the part.
+	ChecksumCRC32 *string `location:"header" locationName:"x-amz-checksum-crc32" type:"string"`
+
+	// The base64-encoded CRC32C checksum of the part, used by S3 to verify the
+	// integrity of the part.
+	ChecksumCRC32C *string `location:"header" locationName:"x-amz-checksum-crc32c" type:"string"`
+
+	// The base64-encoded SHA1 checksum of the part, used by S3 to verify the integrity
+	// of the part.
+	ChecksumSHA1 *string `location:"header" locationName:"x-amz-checksum-sha1" type:"string"`
+
+	// The base64-encoded SHA256 checksum of the part, used by S3 to verify the
+	// integrity of the part.
+	ChecksumSHA256 *string `location:"header" locationName:"x-amz-checksum-sha256" type:"string"`
+
 	// Size of the body in bytes. This parameter is useful when the size of the
 	// body cannot be determined automatically.
 	ContentLength *int64 `location:"header" locationName:"Content-Length" type:"long"`
@@ -24313,7 +27490,7 @@ type UploadPartInput struct {
 	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
 	// header. This must be the same encryption key specified in the initiate multipart
 	// upload request.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -24361,6 +27538,30 @@ func (s *UploadPartInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UploadPartInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UploadPartInput"}
+	if s.ChecksumAlgorithm != nil {
+		values := []string{"CRC32", "CRC32C", "SHA1", "SHA256"}
+		if !request.IsParamEnumValue(*s.ChecksumAlgorithm, values) {
+			invalidParams.Add(request.NewErrParamEnum("ChecksumAlgorithm", *s.ChecksumAlgorithm, values))
+		}
+	}
+	if s.RequestPayer != nil {
+		values := []string{"requester"}
+		if !request.IsParamEnumValue(*s.RequestPayer, values) {
+			invalidParams.Add(request.NewErrParamEnum("RequestPayer", *s.RequestPayer, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBody sets the Body field's value.
 func (s *UploadPartInput) SetBody(v io.ReadSeeker) *UploadPartInput {
 	s.Body = v
@@ -24380,6 +27581,36 @@ func (s *UploadPartInput) getBucket() (v string) {
 	return *s.Bucket
 }
 
+// SetChecksumAlgorithm sets the ChecksumAlgorithm field's value.
+func (s *UploadPartInput) SetChecksumAlgorithm(v string) *UploadPartInput {
+	s.ChecksumAlgorithm = &v
+	return s
+}
+
+// SetChecksumCRC32 sets the ChecksumCRC32 field's value.
+func (s *UploadPartInput) SetChecksumCRC32(v string) *UploadPartInput {
+	s.ChecksumCRC32 = &v
+	return s
+}
+
+// SetChecksumCRC32C sets the ChecksumCRC32C field's value.
+func (s *UploadPartInput) SetChecksumCRC32C(v string) *UploadPartInput {
+	s.ChecksumCRC32C = &v
+	return s
+}
+
+// SetChecksumSHA1 sets the ChecksumSHA1 field's value.
+func (s *UploadPartInput) SetChecksumSHA1(v string) *UploadPartInput {
+	s.ChecksumSHA1 = &v
+	return s
+}
+
+// SetChecksumSHA256 sets the ChecksumSHA256 field's value.
+func (s *UploadPartInput) SetChecksumSHA256(v string) *UploadPartInput {
+	s.ChecksumSHA256 = &v
+	return s
+}
+
 // SetContentLength sets the ContentLength field's value.
 func (s *UploadPartInput) SetContentLength(v int64) *UploadPartInput {
 	s.ContentLength = &v
@@ -24447,6 +27678,31 @@ func (s *UploadPartInput) MarshalFields(e protocol.FieldEncoder) error {
 
 		e.SetValue(protocol.PathTarget, "Bucket", protocol.StringValue(v), protocol.Metadata{})
 	}
+	if s.ChecksumAlgorithm != nil {
+		v := *s.ChecksumAlgorithm
+
+		e.SetValue(protocol.HeaderTarget, "x-amz-sdk-checksum-algorithm", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.ChecksumCRC32 != nil {
+		v := *s.ChecksumCRC32
+
+		e.SetValue(protocol.HeaderTarget, "x-amz-checksum-crc32", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.ChecksumCRC32C != nil {
+		v := *s.ChecksumCRC32C
+
+		e.SetValue(protocol.HeaderTarget, "x-amz-checksum-crc32c", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.ChecksumSHA1 != nil {
+		v := *s.ChecksumSHA1
+
+		e.SetValue(protocol.HeaderTarget, "x-amz-checksum-sha1", protocol.StringValue(v), protocol.Metadata{})
+	}
+	if s.ChecksumSHA256 != nil {
+		v := *s.ChecksumSHA256
+
+		e.SetValue(protocol.HeaderTarget, "x-amz-checksum-sha256", protocol.StringValue(v), protocol.Metadata{})
+	}
 	if s.ContentLength != nil {
 		v := *s.ContentLength
 
@@ -24475,7 +27731,7 @@ func (s *UploadPartInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.SSECustomerKey != nil {
 		v := *s.SSECustomerKey
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-customer-key", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.SSECustomerKeyMD5 != nil {
 		v := *s.SSECustomerKeyMD5
@@ -24514,7 +27770,7 @@ type UploadPartOutput struct {
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyId *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
@@ -24592,7 +27848,7 @@ func (s *UploadPartOutput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.SSEKMSKeyId != nil {
 		v := *s.SSEKMSKeyId
 
-		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "x-amz-server-side-encryption-aws-kms-key-id", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ServerSideEncryption != nil {
 		v := *s.ServerSideEncryption
@@ -24626,6 +27882,30 @@ func (s VersioningConfiguration) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *VersioningConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "VersioningConfiguration"}
+	if s.MFADelete != nil {
+		values := []string{"Enabled", "Disabled"}
+		if !request.IsParamEnumValue(*s.MFADelete, values) {
+			invalidParams.Add(request.NewErrParamEnum("MFADelete", *s.MFADelete, values))
+		}
+	}
+	if s.Status != nil {
+		values := []string{"Enabled", "Suspended"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMFADelete sets the MFADelete field's value.
 func (s *VersioningConfiguration) SetMFADelete(v string) *VersioningConfiguration {
 	s.MFADelete = &v
@@ -24712,6 +27992,33 @@ func (s *WebsiteConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *WebsiteConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "WebsiteConfiguration"}
+	if s.RedirectAllRequestsTo != nil {
+		if err := s.RedirectAllRequestsTo.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RedirectAllRequestsTo", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RoutingRules != nil {
+		for i, v := range s.RoutingRules {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "RoutingRules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetErrorDocument sets the ErrorDocument field's value.
 func (s *WebsiteConfiguration) SetErrorDocument(v *ErrorDocument) *WebsiteConfiguration {
 	s.ErrorDocument = v
@@ -24843,6 +28150,31 @@ const (
 	BucketVersioningStatusSuspended = "Suspended"
 )
 
+const (
+	// ChecksumAlgorithmCrc32 is a ChecksumAlgorithm enum value
+	ChecksumAlgorithmCrc32 = "CRC32"
+
+	// ChecksumAlgorithmCrc32c is a ChecksumAlgorithm enum value
+	ChecksumAlgorithmCrc32c = "CRC32C"
+
+	// ChecksumAlgorithmSha1 is a ChecksumAlgorithm enum value
+	ChecksumAlgorithmSha1 = "SHA1"
+
+	// ChecksumAlgorithmSha256 is a ChecksumAlgorithm enum value
+	ChecksumAlgorithmSha256 = "SHA256"
+)
+
+const (
+	// CompressionTypeNone is a CompressionType enum value
+	CompressionTypeNone = "NONE"
+
+	// CompressionTypeGzip is a CompressionType enum value
+	CompressionTypeGzip = "GZIP"
+
+	// CompressionTypeBzip2 is a CompressionType enum value
+	CompressionTypeBzip2 = "BZIP2"
+)
+
 // Requests Amazon S3 to encode the object keys in the response and specifies
 // the encoding method to use. An object key may contain any Unicode character;
 // however, XML 1.0 parser cannot parse some characters, such as characters
@@ -24892,6 +28224,22 @@ const (
 	ExpirationStatusDisabled = "Disabled"
 )
 
+const (
+	// ExpressionTypeSql is a ExpressionType enum value
+	ExpressionTypeSql = "SQL"
+)
+
+const (
+	// FileHeaderInfoUse is a FileHeaderInfo enum value
+	FileHeaderInfoUse = "USE"
+
+	// FileHeaderInfoIgnore is a FileHeaderInfo enum value
+	FileHeaderInfoIgnore = "IGNORE"
+
+	// FileHeaderInfoNone is a FileHeaderInfo enum value
+	FileHeaderInfoNone = "NONE"
+)
+
 const (
 	// FilterRuleNamePrefix is a FilterRuleName enum value
 	FilterRuleNamePrefix = "prefix"
@@ -24941,6 +28289,14 @@ const (
 	InventoryOptionalFieldReplicationStatus = "ReplicationStatus"
 )
 
+const (
+	// JSONTypeDocument is a JSONType enum value
+	JSONTypeDocument = "DOCUMENT"
+
+	// JSONTypeLines is a JSONType enum value
+	JSONTypeLines = "LINES"
+)
+
 const (
 	// MFADeleteEnabled is a MFADelete enum value
 	MFADeleteEnabled = "Enabled"
@@ -25037,6 +28393,14 @@ const (
 	ProtocolHttps = "https"
 )
 
+const (
+	// QuoteFieldsAlways is a QuoteFields enum value
+	QuoteFieldsAlways = "ALWAYS"
+
+	// QuoteFieldsAsneeded is a QuoteFields enum value
+	QuoteFieldsAsneeded = "ASNEEDED"
+)
+
 const (
 	// ReplicationRuleStatusEnabled is a ReplicationRuleStatus enum value
 	ReplicationRuleStatusEnabled = "Enabled"
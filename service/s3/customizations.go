@@ -1,6 +1,7 @@
 package s3
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/request"
 )
@@ -14,6 +15,11 @@ func defaultInitClientFn(c *client.Client) {
 	// Support building custom endpoints based on config
 	c.Handlers.Build.PushFront(updateEndpointForS3Config)
 
+	// Follow the region redirects of buckets in other regions, if enabled,
+	// before the endpoint is built for the bucket.
+	c.Handlers.Build.PushFrontNamed(newRegionRedirectHandler(
+		newBucketRegionCache(bucketRegionCacheSize)))
+
 	// Require SSL when using SSE keys
 	c.Handlers.Validate.PushBack(validateSSERequiresSSL)
 	c.Handlers.Build.PushBack(computeSSEKeys)
@@ -62,3 +68,10 @@ type sseCustomerKeyGetter interface {
 type copySourceSSECustomerKeyGetter interface {
 	getCopySourceSSECustomerKey() string
 }
+
+// logMessage logs the message if the request's log level is at least debug.
+func logMessage(r *request.Request, msg string) {
+	if r.Config.Logger != nil && r.Config.LogLevel.AtLeast(aws.LogDebug) {
+		r.Config.Logger.Log(msg)
+	}
+}
@@ -84,6 +84,26 @@
 // See the s3manager package's GetBucketRegion function documentation for more information
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3/s3manager/#GetBucketRegion
 //
+// Server-Side Encryption with Customer Keys
+//
+// The SSECustomerKey and CopySourceSSECustomerKey input parameters may be set
+// to either the raw 32-byte key, or the base64 encoding of the key. The client
+// base64-encodes raw keys, and computes the MD5 digest of the key if the key's
+// MD5 parameter is not set. Keys which are not 32 bytes fail the request
+// before it is sent.
+//
+//   key := make([]byte, 32)
+//   if _, err := rand.Read(key); err != nil {
+//       return err
+//   }
+//
+//   result, err := svc.GetObject(&s3.GetObjectInput{
+//       Bucket:               aws.String(myBucket),
+//       Key:                  aws.String(myKey),
+//       SSECustomerAlgorithm: aws.String("AES256"),
+//       SSECustomerKey:       aws.String(string(key)),
+//   })
+//
 // S3 Crypto Client
 //
 // The s3crypto package provides the tools to upload and download encrypted
@@ -0,0 +1,227 @@
+// +build go1.7
+
+package s3_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// countingConn counts the bytes written to the connection.
+type countingConn struct {
+	net.Conn
+	written *int64
+}
+
+func (c countingConn) Write(p []byte) (int, error) {
+	n, err := c.Conn.Write(p)
+	atomic.AddInt64(c.written, int64(n))
+	return n, err
+}
+
+// newExpectContinueClient returns a client of the server, whose transport
+// waits up to the timeout for a 100 Continue response, and counts the bytes
+// it writes to its connections.
+func newExpectContinueClient(server *httptest.Server, timeout time.Duration, cfgs ...*aws.Config) (*s3.S3, *int64) {
+	var written int64
+	transport := defaults.HTTPTransport(aws.HTTPTransportOptions{ExpectContinueTimeout: timeout})
+	dial := transport.DialContext
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := dial(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		return countingConn{Conn: conn, written: &written}, nil
+	}
+
+	cfg := &aws.Config{
+		Endpoint:         aws.String(server.URL),
+		DisableSSL:       aws.Bool(true),
+		S3ForcePathStyle: aws.Bool(true),
+		HTTPClient:       &http.Client{Transport: transport},
+	}
+	svc := s3.New(unit.Session, append([]*aws.Config{cfg}, cfgs...)...)
+
+	return svc, &written
+}
+
+func TestExpectContinue_RejectedBeforeBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Reject the request without reading its body.
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`))
+	}))
+	defer server.Close()
+
+	svc, written := newExpectContinueClient(server, 10*time.Second, &aws.Config{MaxRetries: aws.Int(0)})
+
+	bodySize := 8 * 1024 * 1024
+	start := time.Now()
+	_, err := svc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader(make([]byte, bodySize)),
+	})
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T, %v", err, err)
+	}
+	if e, a := "AccessDenied", aerr.Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if a := atomic.LoadInt64(written); a > 64*1024 {
+		t.Errorf("expect only the request headers written, got %v bytes", a)
+	}
+	if a := time.Since(start); a > 5*time.Second {
+		t.Errorf("expect the rejection not to wait for the continue timeout, took %v", a)
+	}
+}
+
+func TestExpectContinue_BodySentAfterTimeout(t *testing.T) {
+	bodySize := 64 * 1024
+
+	var writtenBeforeRead int64
+	var written *int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The 100 Continue response is only sent once the body is read.
+		time.Sleep(200 * time.Millisecond)
+		atomic.StoreInt64(&writtenBeforeRead, atomic.LoadInt64(written))
+
+		b, _ := ioutil.ReadAll(r.Body)
+		if len(b) != bodySize {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	svc, n := newExpectContinueClient(server, 10*time.Millisecond,
+		&aws.Config{MaxRetries: aws.Int(0)}, aws.NewConfig().WithS3ExpectContinueThreshold(1024))
+	written = n
+
+	_, err := svc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader(make([]byte, bodySize)),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := int64(bodySize), atomic.LoadInt64(&writtenBeforeRead); a < e {
+		t.Errorf("expect body sent after the continue timeout, got %v bytes written", a)
+	}
+}
+
+func TestExpectContinue_Retried(t *testing.T) {
+	var m sync.Mutex
+	var expects []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.Lock()
+		expects = append(expects, r.Header.Get("Expect"))
+		attempt := len(expects)
+		m.Unlock()
+
+		if attempt == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	svc, _ := newExpectContinueClient(server, time.Second, &aws.Config{MaxRetries: aws.Int(1)})
+
+	_, err := svc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader(make([]byte, 3*1024*1024)),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 2, len(expects); e != a {
+		t.Fatalf("expect %v attempts, got %v", e, a)
+	}
+	for i, a := range expects {
+		if e := "100-Continue"; e != a {
+			t.Errorf("attempt %d, expect %v Expect header, got %v", i+1, e, a)
+		}
+	}
+}
+
+func TestExpectContinue_Threshold(t *testing.T) {
+	cases := map[string]struct {
+		Threshold *int64
+		Body      []byte
+		Copy      bool
+		Expect    string
+	}{
+		"default over threshold": {
+			Body:   make([]byte, 3*1024*1024),
+			Expect: "100-Continue",
+		},
+		"default under threshold": {
+			Body: make([]byte, 1024*1024),
+		},
+		"lowered threshold": {
+			Threshold: aws.Int64(512 * 1024),
+			Body:      make([]byte, 1024*1024),
+			Expect:    "100-Continue",
+		},
+		"raised threshold": {
+			Threshold: aws.Int64(8 * 1024 * 1024),
+			Body:      make([]byte, 3*1024*1024),
+		},
+		"zero threshold copy": {
+			Threshold: aws.Int64(0),
+			Copy:      true,
+			Expect:    "100-Continue",
+		},
+		"default copy": {
+			Copy: true,
+		},
+	}
+
+	for name, c := range cases {
+		svc := s3.New(unit.Session, &aws.Config{S3ExpectContinueThreshold: c.Threshold})
+
+		var req *request.Request
+		if c.Copy {
+			req, _ = svc.CopyObjectRequest(&s3.CopyObjectInput{
+				Bucket:     aws.String("bucket"),
+				Key:        aws.String("key"),
+				CopySource: aws.String("bucket/source"),
+			})
+		} else {
+			req, _ = svc.PutObjectRequest(&s3.PutObjectInput{
+				Bucket: aws.String("bucket"),
+				Key:    aws.String("key"),
+				Body:   bytes.NewReader(c.Body),
+			})
+		}
+
+		if err := req.Sign(); err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := c.Expect, req.HTTPRequest.Header.Get("Expect"); e != a {
+			t.Errorf("%s, expect %q Expect header, got %q", name, e, a)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package s3_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestExtendedValidation_Enum(t *testing.T) {
+	input := &s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		ACL:    aws.String("public"),
+	}
+
+	svc := s3.New(unit.Session)
+	req, _ := svc.PutObjectRequest(input)
+	if err := req.Build(); err != nil {
+		t.Fatalf("expect no error without extended validation, got %v", err)
+	}
+
+	svc = s3.New(unit.Session, aws.NewConfig().WithEnableExtendedValidation(true))
+	req, _ = svc.PutObjectRequest(input)
+	err := req.Build()
+	if err == nil {
+		t.Fatalf("expect error")
+	}
+	if e, a := request.InvalidParameterErrCode, err.(request.ErrInvalidParams).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+
+	errs := err.(request.ErrInvalidParams).OrigErrs()
+	if e, a := 1, len(errs); e != a {
+		t.Fatalf("expect %v errors, got %v, %v", e, a, errs)
+	}
+	perr := errs[0].(request.ErrInvalidParam)
+	if e, a := request.ParamEnumErrCode, perr.Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if e, a := "PutObjectInput.ACL", perr.Field(); e != a {
+		t.Errorf("expect %v field, got %v", e, a)
+	}
+
+	input.ACL = aws.String(s3.ObjectCannedACLPublicRead)
+	req, _ = svc.PutObjectRequest(input)
+	if err := req.Build(); err != nil {
+		t.Errorf("expect no error for valid enum value, got %v", err)
+	}
+}
@@ -32,8 +32,14 @@ var accelerateOpBlacklist = operationBlacklist{
 
 // Request handler to automatically add the bucket name to the endpoint domain
 // if possible. This style of bucket is valid for all bucket names which are
-// DNS compatible and do not contain "."
+// DNS compatible and do not contain ".". Requests to multi-region access
+// points are made to the access point's global endpoint, and requests to
+// access points to the access point's regional endpoint.
 func updateEndpointForS3Config(r *request.Request) {
+	if updateEndpointForMultiRegionAccessPoint(r) || updateEndpointForAccessPoint(r) {
+		return
+	}
+
 	forceHostStyle := aws.BoolValue(r.Config.S3ForcePathStyle)
 	accelerate := aws.BoolValue(r.Config.S3UseAccelerate)
 
@@ -60,6 +66,10 @@ func updateEndpointForHostStyle(r *request.Request) {
 
 	if !hostCompatibleBucketName(r.HTTPRequest.URL, bucket) {
 		// bucket name must be valid to put into the host
+		if dnsCompatibleBucketName(bucket) {
+			logMessage(r, fmt.Sprintf("DEBUG: bucket %s contains dots, which are not compatible with "+
+				"the TLS certificate of the virtual-hosted-style endpoint, using path-style addressing.", bucket))
+		}
 		return
 	}
 
@@ -126,14 +136,37 @@ func bucketNameFromReqParams(params interface{}) (string, bool) {
 func hostCompatibleBucketName(u *url.URL, bucket string) bool {
 	// Bucket might be DNS compatible but dots in the hostname will fail
 	// certificate validation, so do not use host-style.
-	if u.Scheme == "https" && strings.Contains(bucket, ".") {
-		return false
+	if u.Scheme == "https" {
+		return ValidateBucketNameForVirtualHost(bucket) == nil
 	}
 
 	// if the bucket is DNS compatible
 	return dnsCompatibleBucketName(bucket)
 }
 
+// ValidateBucketNameForVirtualHost returns an error if the bucket name cannot
+// be used in the host of virtual-hosted-style requests made over HTTPS. The
+// bucket name must be DNS compatible, and must not contain dots, as the TLS
+// certificates of the S3 endpoints do not match hosts with more than one
+// label before the endpoint.
+//
+// Requests to buckets whose name is not valid for virtual-hosted-style
+// addressing are made with path-style addressing, except with S3 Accelerate,
+// which fails the request.
+func ValidateBucketNameForVirtualHost(bucket string) error {
+	if !dnsCompatibleBucketName(bucket) {
+		return awserr.New("InvalidBucketName",
+			fmt.Sprintf("bucket name %s is not DNS compatible", bucket), nil)
+	}
+	if strings.Contains(bucket, ".") {
+		return awserr.New("InvalidBucketName",
+			fmt.Sprintf("bucket name %s contains dots, which are not compatible with the TLS "+
+				"certificates of virtual-hosted-style endpoints", bucket), nil)
+	}
+
+	return nil
+}
+
 var reDomain = regexp.MustCompile(`^[a-z0-9][a-z0-9\.\-]{1,61}[a-z0-9]$`)
 var reIPAddress = regexp.MustCompile(`^(\d+\.){3}\d+$`)
 
@@ -1,12 +1,15 @@
 package s3_test
 
 import (
+	"fmt"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/awstesting/unit"
 	"github.com/aws/aws-sdk-go/service/s3"
 )
@@ -125,3 +128,166 @@ func TestHostStyleBucketGetBucketLocation(t *testing.T) {
 		t.Errorf("expect %s to be in %s", e, a)
 	}
 }
+
+func TestS3UsEast1RegionalEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		Config aws.Config
+		Host   string
+	}{
+		"legacy": {
+			Config: aws.Config{S3UsEast1RegionalEndpoint: endpoints.LegacyS3UsEast1Endpoint},
+			Host:   "bucket.s3.amazonaws.com",
+		},
+		"regional": {
+			Config: aws.Config{S3UsEast1RegionalEndpoint: endpoints.RegionalS3UsEast1Endpoint},
+			Host:   "bucket.s3.us-east-1.amazonaws.com",
+		},
+		"regional dualstack": {
+			Config: aws.Config{
+				S3UsEast1RegionalEndpoint: endpoints.RegionalS3UsEast1Endpoint,
+				UseDualStack:              aws.Bool(true),
+			},
+			Host: "bucket.s3.dualstack.us-east-1.amazonaws.com",
+		},
+		"regional accelerate": {
+			Config: aws.Config{
+				S3UsEast1RegionalEndpoint: endpoints.RegionalS3UsEast1Endpoint,
+				S3UseAccelerate:           aws.Bool(true),
+			},
+			Host: "bucket.s3-accelerate.amazonaws.com",
+		},
+		"regional accelerate dualstack": {
+			Config: aws.Config{
+				S3UsEast1RegionalEndpoint: endpoints.RegionalS3UsEast1Endpoint,
+				S3UseAccelerate:           aws.Bool(true),
+				UseDualStack:              aws.Bool(true),
+			},
+			Host: "bucket.s3-accelerate.dualstack.amazonaws.com",
+		},
+	}
+
+	for name, c := range cases {
+		c.Config.Region = aws.String("us-east-1")
+		svc := s3.New(unit.Session, &c.Config)
+
+		req, _ := svc.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+		})
+		u, err := req.Presign(15 * time.Minute)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		presigned, err := url.Parse(u)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := c.Host, presigned.Host; e != a {
+			t.Errorf("%s, expect %v host, got %v", name, e, a)
+		}
+		if e, a := "/us-east-1/s3/", presigned.Query().Get("X-Amz-Credential"); !strings.Contains(a, e) {
+			t.Errorf("%s, expect %v signing scope, got %v", name, e, a)
+		}
+	}
+}
+
+func TestHostStyleBucketBuild_TemplatedEndpoint(t *testing.T) {
+	s := s3.New(unit.Session, &aws.Config{
+		Region: aws.String("us-west-2"),
+		EndpointResolver: endpoints.NewTemplatedResolver(
+			"vpce-1.{service}.{region}.vpce.{dnsSuffix}", endpoints.DefaultResolver()),
+	})
+
+	runTests(t, s, []s3BucketTest{
+		{"abc", "https://abc.vpce-1.s3.us-west-2.vpce.amazonaws.com/", ""},
+		{"a.b.c", "https://vpce-1.s3.us-west-2.vpce.amazonaws.com/a.b.c", ""},
+	})
+}
+
+func TestHostStyleBucketBuild_DottedBucketLogged(t *testing.T) {
+	cases := map[string]struct {
+		Config    *aws.Config
+		Bucket    string
+		ExpectURL string
+		ExpectLog bool
+	}{
+		"dotted bucket https": {
+			Bucket:    "my.backups.example",
+			ExpectURL: "https://s3.mock-region.amazonaws.com/my.backups.example",
+			ExpectLog: true,
+		},
+		"dotted bucket http": {
+			Config:    &aws.Config{DisableSSL: aws.Bool(true)},
+			Bucket:    "my.backups.example",
+			ExpectURL: "http://my.backups.example.s3.mock-region.amazonaws.com/",
+		},
+		"bucket": {
+			Bucket:    "mybackups",
+			ExpectURL: "https://mybackups.s3.mock-region.amazonaws.com/",
+		},
+		"invalid bucket": {
+			Bucket:    "a..bc",
+			ExpectURL: "https://s3.mock-region.amazonaws.com/a..bc",
+		},
+	}
+
+	for name, c := range cases {
+		var logs []string
+		cfg := &aws.Config{
+			LogLevel: aws.LogLevel(aws.LogDebug),
+			Logger: aws.LoggerFunc(func(args ...interface{}) {
+				logs = append(logs, fmt.Sprint(args...))
+			}),
+		}
+		s := s3.New(unit.Session, cfg, c.Config)
+
+		req, _ := s.ListObjectsRequest(&s3.ListObjectsInput{Bucket: aws.String(c.Bucket)})
+		if err := req.Build(); err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := c.ExpectURL, req.HTTPRequest.URL.String(); e != a {
+			t.Errorf("%s, expect %v URL, got %v", name, e, a)
+		}
+
+		var logged bool
+		for _, l := range logs {
+			if strings.Contains(l, "using path-style addressing") {
+				logged = true
+			}
+		}
+		if e, a := c.ExpectLog, logged; e != a {
+			t.Errorf("%s, expect %t path-style log, got %t, %v", name, e, a, logs)
+		}
+	}
+}
+
+func TestValidateBucketNameForVirtualHost(t *testing.T) {
+	cases := map[string]struct {
+		Bucket    string
+		ExpectErr bool
+	}{
+		"valid":              {Bucket: "mybucket-1"},
+		"dots":               {Bucket: "my.backups.example", ExpectErr: true},
+		"uppercase":          {Bucket: "MyBucket", ExpectErr: true},
+		"consecutive dots":   {Bucket: "a..bc", ExpectErr: true},
+		"too short":          {Bucket: "ab", ExpectErr: true},
+		"ip address":         {Bucket: "192.168.5.4", ExpectErr: true},
+		"invalid characters": {Bucket: "a$b$c", ExpectErr: true},
+	}
+
+	for name, c := range cases {
+		err := s3.ValidateBucketNameForVirtualHost(c.Bucket)
+		if c.ExpectErr {
+			aerr, ok := err.(awserr.Error)
+			if !ok {
+				t.Fatalf("%s, expect awserr.Error, got %T, %v", name, err, err)
+			}
+			if e, a := "InvalidBucketName", aerr.Code(); e != a {
+				t.Errorf("%s, expect %v error code, got %v", name, e, a)
+			}
+		} else if err != nil {
+			t.Errorf("%s, expect no error, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package s3
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/aws/signer/v4a"
+)
+
+// multiRegionAccessPointSigner is the signer of the requests to multi-region
+// access points, which are signed with SigV4a valid in all regions.
+var multiRegionAccessPointSigner = v4a.BuildNamedHandler(v4.SignRequestHandler.Name,
+	v4a.WithRegionSet("*"))
+
+var reAccessPointAlias = regexp.MustCompile(`^[a-z0-9][a-z0-9\-]*\.mrap$`)
+
+// multiRegionAccessPoint returns the alias and partition of the multi-region
+// access point ARN, and if the bucket is a multi-region access point ARN.
+// Multi-region access point ARNs have no region, and their resource is the
+// access point's alias.
+//
+//     arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap
+func multiRegionAccessPoint(bucket string) (alias, partition string, ok bool) {
+	a, err := arn.Parse(bucket)
+	if err != nil || a.Service != "s3" || len(a.Region) != 0 {
+		return "", "", false
+	}
+
+	parts := strings.FieldsFunc(a.Resource, func(r rune) bool {
+		return r == '/' || r == ':'
+	})
+	if len(parts) != 2 || parts[0] != "accesspoint" {
+		return "", "", false
+	}
+
+	return parts[1], a.Partition, true
+}
+
+// updateEndpointForMultiRegionAccessPoint updates the request's endpoint to
+// the global endpoint of the multi-region access point, and the request's
+// signer to SigV4a, if the request's bucket is a multi-region access point
+// ARN. Returns false if the bucket is not a multi-region access point ARN.
+func updateEndpointForMultiRegionAccessPoint(r *request.Request) bool {
+	bucket, ok := bucketNameFromReqParams(r.Params)
+	if !ok {
+		return false
+	}
+	alias, partition, ok := multiRegionAccessPoint(bucket)
+	if !ok {
+		return false
+	}
+
+	if !reAccessPointAlias.MatchString(alias) {
+		r.Error = awserr.New("InvalidParameterException",
+			fmt.Sprintf("invalid multi-region access point alias %s", alias), nil)
+		return true
+	}
+	if aws.BoolValue(r.Config.S3UseAccelerate) {
+		r.Error = awserr.New("InvalidParameterException",
+			"S3 Accelerate is not supported by multi-region access points", nil)
+		return true
+	}
+	if aws.BoolValue(r.Config.S3ForcePathStyle) && r.Config.Logger != nil {
+		r.Config.Logger.Log("ERROR: multi-region access points are not compatible with aws.Config.S3ForcePathStyle, ignoring S3ForcePathStyle.")
+	}
+
+	var dnsSuffix string
+	for _, p := range endpoints.DefaultPartitions() {
+		if p.ID() == partition {
+			dnsSuffix = p.DNSSuffix()
+		}
+	}
+	if len(dnsSuffix) == 0 {
+		r.Error = awserr.New("InvalidParameterException",
+			fmt.Sprintf("unknown partition %s of multi-region access point ARN", partition), nil)
+		return true
+	}
+
+	u := r.HTTPRequest.URL
+	u.Host = alias + ".accesspoint.s3-global." + dnsSuffix
+	u.Path = strings.Replace(u.Path, "/{Bucket}", "", -1)
+	if u.Path == "" {
+		u.Path = "/"
+	}
+
+	r.Handlers.Sign.SwapNamed(multiRegionAccessPointSigner)
+
+	return true
+}
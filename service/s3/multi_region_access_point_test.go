@@ -0,0 +1,141 @@
+package s3_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestMultiRegionAccessPoint(t *testing.T) {
+	cases := map[string]struct {
+		Config     *aws.Config
+		Bucket     string
+		ExpectURL  string
+		ExpectAuth string
+		ErrCode    string
+	}{
+		"access point": {
+			Bucket:     "arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap",
+			ExpectURL:  "https://mfzwi23gnjvgw.mrap.accesspoint.s3-global.amazonaws.com/key",
+			ExpectAuth: "AWS4-ECDSA-P256-SHA256 Credential=AKID/",
+		},
+		"access point colon delimited": {
+			Bucket:     "arn:aws:s3::123456789012:accesspoint:mfzwi23gnjvgw.mrap",
+			ExpectURL:  "https://mfzwi23gnjvgw.mrap.accesspoint.s3-global.amazonaws.com/key",
+			ExpectAuth: "AWS4-ECDSA-P256-SHA256 Credential=AKID/",
+		},
+		"china partition": {
+			Bucket:     "arn:aws-cn:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap",
+			ExpectURL:  "https://mfzwi23gnjvgw.mrap.accesspoint.s3-global.amazonaws.com.cn/key",
+			ExpectAuth: "AWS4-ECDSA-P256-SHA256 Credential=AKID/",
+		},
+		"force path style": {
+			Config:     &aws.Config{S3ForcePathStyle: aws.Bool(true)},
+			Bucket:     "arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap",
+			ExpectURL:  "https://mfzwi23gnjvgw.mrap.accesspoint.s3-global.amazonaws.com/key",
+			ExpectAuth: "AWS4-ECDSA-P256-SHA256 Credential=AKID/",
+		},
+		"bucket": {
+			Bucket:     "bucket",
+			ExpectURL:  "https://bucket.s3.mock-region.amazonaws.com/key",
+			ExpectAuth: "AWS4-HMAC-SHA256 Credential=AKID/",
+		},
+		"regional ARN": {
+			Config:     &aws.Config{S3UseARNRegion: aws.Bool(true)},
+			Bucket:     "arn:aws:s3:us-west-2:123456789012:accesspoint/myendpoint",
+			ExpectURL:  "https://myendpoint-123456789012.s3-accesspoint.us-west-2.amazonaws.com/key",
+			ExpectAuth: "AWS4-HMAC-SHA256 Credential=AKID/",
+		},
+		"invalid alias": {
+			Bucket:  "arn:aws:s3::123456789012:accesspoint/my_endpoint",
+			ErrCode: "InvalidParameterException",
+		},
+		"unknown partition": {
+			Bucket:  "arn:aws-unknown:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap",
+			ErrCode: "InvalidParameterException",
+		},
+		"accelerate": {
+			Config:  &aws.Config{S3UseAccelerate: aws.Bool(true)},
+			Bucket:  "arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap",
+			ErrCode: "InvalidParameterException",
+		},
+	}
+
+	for name, c := range cases {
+		svc := s3.New(unit.Session, c.Config)
+		req, _ := svc.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String(c.Bucket),
+			Key:    aws.String("key"),
+		})
+
+		err := req.Sign()
+		if len(c.ErrCode) != 0 {
+			aerr, ok := err.(awserr.Error)
+			if !ok {
+				t.Fatalf("%s, expect awserr.Error, got %T, %v", name, err, err)
+			}
+			if e, a := c.ErrCode, aerr.Code(); e != a {
+				t.Errorf("%s, expect %v error code, got %v", name, e, a)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if e, a := c.ExpectURL, req.HTTPRequest.URL.String(); e != a {
+			t.Errorf("%s, expect %v URL, got %v", name, e, a)
+		}
+		if e, a := c.ExpectAuth, req.HTTPRequest.Header.Get("Authorization"); !strings.HasPrefix(a, e) {
+			t.Errorf("%s, expect %v... authorization, got %v", name, e, a)
+		}
+		if strings.HasPrefix(c.ExpectAuth, "AWS4-ECDSA-P256-SHA256") {
+			if e, a := "*", req.HTTPRequest.Header.Get("X-Amz-Region-Set"); e != a {
+				t.Errorf("%s, expect %v region set, got %v", name, e, a)
+			}
+		}
+	}
+}
+
+func TestMultiRegionAccessPoint_Presign(t *testing.T) {
+	svc := s3.New(unit.Session)
+	req, _ := svc.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String("arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap"),
+		Key:    aws.String("key"),
+	})
+
+	urlstr, err := req.Presign(15 * time.Minute)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	u, err := url.Parse(urlstr)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "mfzwi23gnjvgw.mrap.accesspoint.s3-global.amazonaws.com", u.Host; e != a {
+		t.Errorf("expect %v host, got %v", e, a)
+	}
+
+	q := u.Query()
+	expectQuery := map[string]string{
+		"X-Amz-Algorithm":     "AWS4-ECDSA-P256-SHA256",
+		"X-Amz-Region-Set":    "*",
+		"X-Amz-Expires":       "900",
+		"X-Amz-SignedHeaders": "host",
+	}
+	for k, e := range expectQuery {
+		if a := q.Get(k); e != a {
+			t.Errorf("expect %s query %q, got %q", k, e, a)
+		}
+	}
+	if v := q.Get("X-Amz-Signature"); len(v) == 0 {
+		t.Errorf("expect signature, got none")
+	}
+}
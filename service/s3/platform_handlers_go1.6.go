@@ -7,9 +7,16 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 )
 
+// default100ContinueThreshold is the minimum content length of the PUT
+// requests sent with the 100-Continue header, if the client's config does
+// not set S3ExpectContinueThreshold.
+const default100ContinueThreshold = 1024 * 1024 * 2
+
 func platformRequestHandlers(r *request.Request) {
 	if r.Operation.HTTPMethod == "PUT" {
-		// 100-Continue should only be used on put requests.
+		// 100-Continue should only be used on put requests. The header is
+		// added when each attempt of the request is signed, so that retries
+		// are also sent with the header.
 		r.Handlers.Sign.PushBack(add100Continue)
 	}
 }
@@ -18,9 +25,14 @@ func add100Continue(r *request.Request) {
 	if aws.BoolValue(r.Config.S3Disable100Continue) {
 		return
 	}
-	if r.HTTPRequest.ContentLength < 1024*1024*2 {
-		// Ignore requests smaller than 2MB. This helps prevent delaying
-		// requests unnecessarily.
+
+	threshold := int64(default100ContinueThreshold)
+	if r.Config.S3ExpectContinueThreshold != nil {
+		threshold = *r.Config.S3ExpectContinueThreshold
+	}
+	if r.HTTPRequest.ContentLength < threshold {
+		// Ignore requests smaller than the threshold. This helps prevent
+		// delaying requests unnecessarily.
 		return
 	}
 
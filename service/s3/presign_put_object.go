@@ -0,0 +1,89 @@
+package s3
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// PresignPutObjectOptions are the options of a presigned PutObject URL,
+// overriding how the headers of the PutObjectInput are included in the
+// presigned request.
+type PresignPutObjectOptions struct {
+	// Names of the headers which must be signed as headers. Presigning fails
+	// with a v4.ErrCodeMissingSignedHeader error if the input does not set a
+	// header, e.g. to ensure the Content-Type of the upload is always bound
+	// by the signature.
+	SignedHeaders []string
+
+	// Names of the headers which are hoisted to the presigned URL's query
+	// string, rather than signed as headers. The uploader does not need to
+	// send the headers, their values are still bound by the signature.
+	UnsignedHeaders []string
+
+	// Names of the headers which are omitted from the presigned request, and
+	// not signed. The uploader may send the headers with any value, except
+	// for X-Amz-* headers, which S3 rejects if they are not signed.
+	OmittedHeaders []string
+}
+
+// PresignPutObject returns a presigned URL of the PutObject request, and the
+// exact headers the upload made with the URL must send for the signature to
+// be valid. The URL is valid for the expire duration.
+//
+// Unlike PutObjectRequest's Presign, which hoists the X-Amz-* headers which
+// S3 does not require to be signed, such as X-Amz-Tagging, to the URL's query
+// string, every header set by the input is signed as a header, so that the
+// uploader cannot upload the object with different attributes. The options
+// override how individual headers are presigned.
+//
+// Example:
+//
+//     url, header, err := svc.PresignPutObject(&s3.PutObjectInput{
+//         Bucket:      aws.String("bucket"),
+//         Key:         aws.String("key"),
+//         ContentType: aws.String("image/png"),
+//         Tagging:     aws.String("partner=example"),
+//         Metadata:    map[string]*string{"source": aws.String("partner")},
+//     }, 15*time.Minute)
+//
+//     // The upload must be made with the returned headers.
+//     req, _ := http.NewRequest("PUT", url, body)
+//     for k, v := range header {
+//         req.Header[k] = v
+//     }
+func (c *S3) PresignPutObject(input *PutObjectInput, expire time.Duration, opts ...func(*PresignPutObjectOptions)) (string, http.Header, error) {
+	var options PresignPutObjectOptions
+	for _, fn := range opts {
+		fn(&options)
+	}
+
+	req, _ := c.PutObjectRequest(input)
+	req.NotHoist = true
+	req.ApplyOptions(
+		request.WithPresignSignedHeaders(options.SignedHeaders...),
+		request.WithPresignUnsignedHeaders(options.UnsignedHeaders...),
+	)
+	if len(options.OmittedHeaders) != 0 {
+		req.Handlers.Sign.PushFront(func(r *request.Request) {
+			for _, name := range options.OmittedHeaders {
+				r.HTTPRequest.Header.Del(name)
+			}
+		})
+	}
+
+	u, signed, err := req.PresignRequest(expire)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// The signed headers are keyed by their lower case names, canonicalized
+	// so that the headers can be read with http.Header's methods.
+	header := http.Header{}
+	for k, v := range signed {
+		header[http.CanonicalHeaderKey(k)] = v
+	}
+
+	return u, header, nil
+}
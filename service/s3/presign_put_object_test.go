@@ -0,0 +1,235 @@
+package s3_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const presignTestSecretKey = "SECRET"
+
+// validatePresignedRequest validates the V4 signature of the presigned
+// request as S3 would, returning an error if the signature is not valid, or
+// an X-Amz-* header of the request is not signed.
+func validatePresignedRequest(r *http.Request) error {
+	query := r.URL.Query()
+	signature := query.Get("X-Amz-Signature")
+	query.Del("X-Amz-Signature")
+
+	signed := strings.Split(query.Get("X-Amz-SignedHeaders"), ";")
+	signedSet := map[string]bool{}
+	for _, name := range signed {
+		signedSet[name] = true
+	}
+	var names []string
+	for name := range r.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		lower := strings.ToLower(name)
+		if strings.HasPrefix(lower, "x-amz-") && !signedSet[lower] {
+			return fmt.Errorf("header %s is not signed", name)
+		}
+	}
+
+	headers := make([]string, len(signed))
+	for i, name := range signed {
+		value := strings.Join(r.Header[http.CanonicalHeaderKey(name)], ",")
+		if name == "host" {
+			value = r.Host
+		}
+		headers[i] = name + ":" + strings.TrimSpace(value)
+	}
+
+	payloadHash := r.Header.Get("X-Amz-Content-Sha256")
+	if len(payloadHash) == 0 {
+		payloadHash = "UNSIGNED-PAYLOAD"
+	}
+
+	canonical := strings.Join([]string{
+		r.Method,
+		r.URL.EscapedPath(),
+		strings.Replace(query.Encode(), "+", "%20", -1),
+		strings.Join(headers, "\n") + "\n",
+		strings.Join(signed, ";"),
+		payloadHash,
+	}, "\n")
+	canonicalHash := sha256.Sum256([]byte(canonical))
+
+	// The credential is <access key>/<date>/<region>/<service>/aws4_request.
+	scope := strings.SplitN(query.Get("X-Amz-Credential"), "/", 2)[1]
+	parts := strings.Split(scope, "/")
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		query.Get("X-Amz-Date"),
+		scope,
+		hex.EncodeToString(canonicalHash[:]),
+	}, "\n")
+
+	signTime, err := time.Parse("20060102", parts[0])
+	if err != nil {
+		return err
+	}
+	mac := hmac.New(sha256.New, v4.DeriveSigningKey(presignTestSecretKey, signTime, parts[1], parts[2]))
+	mac.Write([]byte(stringToSign))
+	if e, a := hex.EncodeToString(mac.Sum(nil)), signature; e != a {
+		return fmt.Errorf("signature mismatch, canonical request:\n%s", canonical)
+	}
+
+	return nil
+}
+
+func newPresignValidatorServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := validatePresignedRequest(r); err != nil {
+			t.Logf("presigned request rejected, %v", err)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func presignPutObjectInput() *s3.PutObjectInput {
+	return &s3.PutObjectInput{
+		Bucket:      aws.String("bucket"),
+		Key:         aws.String("key"),
+		ContentType: aws.String("image/png"),
+		Tagging:     aws.String("partner=example"),
+		Metadata: map[string]*string{
+			"Source": aws.String("partner"),
+			"Batch":  aws.String("42"),
+		},
+	}
+}
+
+func TestPresignPutObject(t *testing.T) {
+	server := newPresignValidatorServer(t)
+	defer server.Close()
+
+	svc := s3.New(unit.Session, &aws.Config{
+		Credentials:      credentials.NewStaticCredentials("AKID", presignTestSecretKey, ""),
+		Region:           aws.String("us-west-2"),
+		Endpoint:         aws.String(server.URL),
+		DisableSSL:       aws.Bool(true),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+
+	cases := map[string]struct {
+		Options      func(*s3.PresignPutObjectOptions)
+		ExpectHeader map[string]string
+		ModifyHeader func(http.Header)
+		ExpectStatus int
+	}{
+		"signed headers": {
+			ExpectHeader: map[string]string{
+				"Content-Type":      "image/png",
+				"X-Amz-Tagging":     "partner=example",
+				"X-Amz-Meta-Source": "partner",
+				"X-Amz-Meta-Batch":  "42",
+			},
+			ExpectStatus: http.StatusOK,
+		},
+		"different content type": {
+			ModifyHeader: func(h http.Header) { h.Set("Content-Type", "text/html") },
+			ExpectStatus: http.StatusForbidden,
+		},
+		"missing tagging": {
+			ModifyHeader: func(h http.Header) { h.Del("X-Amz-Tagging") },
+			ExpectStatus: http.StatusForbidden,
+		},
+		"different metadata": {
+			ModifyHeader: func(h http.Header) { h.Set("X-Amz-Meta-Batch", "43") },
+			ExpectStatus: http.StatusForbidden,
+		},
+		"additional metadata": {
+			ModifyHeader: func(h http.Header) { h.Set("X-Amz-Meta-Other", "value") },
+			ExpectStatus: http.StatusForbidden,
+		},
+		"unsigned tagging": {
+			Options: func(o *s3.PresignPutObjectOptions) {
+				o.UnsignedHeaders = []string{"X-Amz-Tagging"}
+			},
+			ExpectHeader: map[string]string{
+				"Content-Type":  "image/png",
+				"X-Amz-Tagging": "",
+			},
+			ExpectStatus: http.StatusOK,
+		},
+		"omitted content type": {
+			Options: func(o *s3.PresignPutObjectOptions) {
+				o.OmittedHeaders = []string{"Content-Type"}
+			},
+			ExpectHeader: map[string]string{
+				"Content-Type":  "",
+				"X-Amz-Tagging": "partner=example",
+			},
+			ModifyHeader: func(h http.Header) { h.Set("Content-Type", "text/plain") },
+			ExpectStatus: http.StatusOK,
+		},
+	}
+
+	for name, c := range cases {
+		var opts []func(*s3.PresignPutObjectOptions)
+		if c.Options != nil {
+			opts = append(opts, c.Options)
+		}
+		u, header, err := svc.PresignPutObject(presignPutObjectInput(), 15*time.Minute, opts...)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		for k, e := range c.ExpectHeader {
+			if a := header.Get(k); e != a {
+				t.Errorf("%s, expect %v header %q, got %q", name, k, e, a)
+			}
+		}
+
+		req, _ := http.NewRequest("PUT", u, strings.NewReader("content"))
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		if c.ModifyHeader != nil {
+			c.ModifyHeader(req.Header)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		resp.Body.Close()
+		if e, a := c.ExpectStatus, resp.StatusCode; e != a {
+			t.Errorf("%s, expect %v status, got %v", name, e, a)
+		}
+	}
+}
+
+func TestPresignPutObject_MissingSignedHeader(t *testing.T) {
+	svc := s3.New(unit.Session, &aws.Config{Region: aws.String("us-west-2")})
+
+	input := presignPutObjectInput()
+	input.ContentType = nil
+	_, _, err := svc.PresignPutObject(input, 15*time.Minute, func(o *s3.PresignPutObjectOptions) {
+		o.SignedHeaders = []string{"Content-Type"}
+	})
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T, %v", err, err)
+	}
+	if e, a := v4.ErrCodeMissingSignedHeader, aerr.Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+}
@@ -0,0 +1,257 @@
+package s3
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+)
+
+// DefaultPresignedPostExpires is the duration a presigned POST is valid for
+// if PresignedPostInput.Expires is not set.
+const DefaultPresignedPostExpires = time.Hour
+
+const (
+	presignedPostAlgorithm   = "AWS4-HMAC-SHA256"
+	presignedPostTimeFormat  = "20060102T150405Z"
+	presignedPostExpFormat   = "2006-01-02T15:04:05.000Z"
+	presignedPostFilenameVar = "${filename}"
+)
+
+// PostPolicyCondition is a condition of the policy of a presigned POST,
+// which the form fields of the upload must satisfy. See
+// http://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-HTTPPOSTConstructPolicy.html
+type PostPolicyCondition struct {
+	value interface{}
+}
+
+// MarshalJSON returns the JSON encoding of the condition in the policy
+// document.
+func (c PostPolicyCondition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.value)
+}
+
+// PostPolicyEquals returns a condition that the form field must equal the
+// value.
+func PostPolicyEquals(field, value string) PostPolicyCondition {
+	return PostPolicyCondition{value: map[string]string{field: value}}
+}
+
+// PostPolicyStartsWith returns a condition that the form field must start
+// with the prefix. An empty prefix allows any value of the field.
+func PostPolicyStartsWith(field, prefix string) PostPolicyCondition {
+	return PostPolicyCondition{value: []string{"starts-with", "$" + field, prefix}}
+}
+
+// PostPolicyContentLengthRange returns a condition that the size of the
+// uploaded object must be between min and max bytes, inclusive.
+func PostPolicyContentLengthRange(min, max int64) PostPolicyCondition {
+	return PostPolicyCondition{value: []interface{}{"content-length-range", min, max}}
+}
+
+// PresignedPostInput is the input of a presigned POST, a browser-based
+// upload of an object with an HTML form.
+type PresignedPostInput struct {
+	// The bucket the object is uploaded to.
+	//
+	// Bucket is a required field
+	Bucket *string
+
+	// The key of the uploaded object. If the key ends with ${filename} the
+	// form's key field must start with the key before ${filename}, and S3
+	// replaces ${filename} with the name of the uploaded file.
+	//
+	// Key is a required field
+	Key *string
+
+	// Additional form fields of the upload, such as acl, Content-Type, or
+	// x-amz-meta-* fields. Each field is included in the returned form
+	// fields, and the policy requires the field to equal its value.
+	Fields map[string]string
+
+	// Additional conditions of the policy, such as the fields which must
+	// start with a prefix, or the range of the object's size.
+	Conditions []PostPolicyCondition
+
+	// The duration the presigned POST is valid for after SignTime. At most
+	// v4.PresignMaxExpireTime, and DefaultPresignedPostExpires if zero.
+	Expires time.Duration
+
+	// The time the policy is signed at. If zero, the current time is used.
+	SignTime time.Time
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *PresignedPostInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PresignedPostInput"}
+	if s.Bucket == nil {
+		invalidParams.Add(request.NewErrParamRequired("Bucket"))
+	}
+	if s.Bucket != nil && len(*s.Bucket) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Bucket", 1))
+	}
+	if s.Key == nil {
+		invalidParams.Add(request.NewErrParamRequired("Key"))
+	}
+	if s.Key != nil && len(*s.Key) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// PresignedPostOutput is the URL and form fields of a presigned POST.
+type PresignedPostOutput struct {
+	// The URL the form is posted to.
+	URL string
+
+	// The form fields of the upload, including the key, the policy, and its
+	// signature. The fields must be included in the form before the file
+	// field.
+	Fields map[string]string
+}
+
+// PresignedPost returns the URL and form fields of a browser-based upload of
+// an object with an HTML form, a POST request which is authorized by a
+// policy document signed with the client's credentials and region.
+//
+// The policy requires the upload's bucket, key, and additional fields to
+// equal the input's values, and the upload to satisfy the input's
+// conditions. The session token of the credentials, if any, is included in
+// the fields.
+//
+//     out, err := svc.PresignedPost(&s3.PresignedPostInput{
+//         Bucket: aws.String("bucket"),
+//         Key:    aws.String("uploads/${filename}"),
+//         Fields: map[string]string{"acl": "public-read"},
+//         Conditions: []s3.PostPolicyCondition{
+//             s3.PostPolicyContentLengthRange(0, 10*1024*1024),
+//         },
+//         Expires: 15 * time.Minute,
+//     })
+//
+// See http://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-UsingHTTPPOST.html
+func (c *S3) PresignedPost(input *PresignedPostInput) (*PresignedPostOutput, error) {
+	return c.PresignedPostWithContext(aws.BackgroundContext(), input)
+}
+
+// PresignedPostWithContext is the same as PresignedPost with the addition of
+// the ability to pass a context, which is used to retrieve the client's
+// credentials. The context must be non-nil.
+func (c *S3) PresignedPostWithContext(ctx aws.Context, input *PresignedPostInput) (*PresignedPostOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	expires := input.Expires
+	if expires == 0 {
+		expires = DefaultPresignedPostExpires
+	}
+	if expires < 0 || expires > v4.PresignMaxExpireTime {
+		return nil, awserr.New(v4.ErrCodeInvalidPresignExpireTime,
+			fmt.Sprintf("presigned POST expire time %v must be positive, and at most %v",
+				expires, v4.PresignMaxExpireTime), nil)
+	}
+
+	signTime := input.SignTime
+	if signTime.IsZero() {
+		signTime = time.Now()
+	}
+	signTime = signTime.UTC()
+
+	// The URL of the bucket is built by the client's handlers so that the
+	// bucket's endpoint respects the client's addressing options.
+	req, _ := c.HeadBucketRequest(&HeadBucketInput{Bucket: input.Bucket})
+	req.SetContext(ctx)
+	if err := req.Build(); err != nil {
+		return nil, err
+	}
+	u := *req.HTTPRequest.URL
+	u.RawQuery = ""
+
+	creds, err := c.Config.Credentials.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	region := c.SigningRegion
+	if len(region) == 0 {
+		region = aws.StringValue(c.Config.Region)
+	}
+	scope := strings.Join([]string{
+		signTime.Format("20060102"), region, "s3", "aws4_request",
+	}, "/")
+
+	key := aws.StringValue(input.Key)
+	fields := map[string]string{"key": key}
+	conditions := []PostPolicyCondition{
+		PostPolicyEquals("bucket", aws.StringValue(input.Bucket)),
+	}
+	if strings.HasSuffix(key, presignedPostFilenameVar) {
+		conditions = append(conditions,
+			PostPolicyStartsWith("key", strings.TrimSuffix(key, presignedPostFilenameVar)))
+	} else {
+		conditions = append(conditions, PostPolicyEquals("key", key))
+	}
+
+	names := make([]string, 0, len(input.Fields))
+	for name := range input.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fields[name] = input.Fields[name]
+		conditions = append(conditions, PostPolicyEquals(name, input.Fields[name]))
+	}
+	conditions = append(conditions, input.Conditions...)
+
+	fields["x-amz-algorithm"] = presignedPostAlgorithm
+	fields["x-amz-credential"] = creds.AccessKeyID + "/" + scope
+	fields["x-amz-date"] = signTime.Format(presignedPostTimeFormat)
+	if len(creds.SessionToken) != 0 {
+		fields["x-amz-security-token"] = creds.SessionToken
+	}
+	for _, name := range []string{
+		"x-amz-algorithm", "x-amz-credential", "x-amz-date", "x-amz-security-token",
+	} {
+		if v, ok := fields[name]; ok {
+			conditions = append(conditions, PostPolicyEquals(name, v))
+		}
+	}
+
+	policy, err := json.Marshal(struct {
+		Expiration string                `json:"expiration"`
+		Conditions []PostPolicyCondition `json:"conditions"`
+	}{
+		Expiration: signTime.Add(expires).Format(presignedPostExpFormat),
+		Conditions: conditions,
+	})
+	if err != nil {
+		return nil, awserr.New(request.ErrCodeSerialization,
+			"failed to encode presigned POST policy", err)
+	}
+	fields["policy"] = base64.StdEncoding.EncodeToString(policy)
+
+	signingKey := v4.DeriveSigningKey(creds.SecretAccessKey, signTime, region, "s3")
+	h := hmac.New(sha256.New, signingKey)
+	h.Write([]byte(fields["policy"]))
+	fields["x-amz-signature"] = hex.EncodeToString(h.Sum(nil))
+
+	return &PresignedPostOutput{
+		URL:    u.String(),
+		Fields: fields,
+	}, nil
+}
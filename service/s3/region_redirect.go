@@ -0,0 +1,204 @@
+package s3
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// bucketRegionCacheSize is the maximum number of buckets whose region is
+// cached by a client following region redirects.
+const bucketRegionCacheSize = 1000
+
+// bucketRegionCache is a size bounded cache of the regions of buckets, which
+// evicts the oldest bucket added when full.
+type bucketRegionCache struct {
+	m       sync.Mutex
+	size    int
+	regions map[string]string
+	buckets []string
+}
+
+func newBucketRegionCache(size int) *bucketRegionCache {
+	return &bucketRegionCache{
+		size:    size,
+		regions: map[string]string{},
+	}
+}
+
+// get returns the cached region of the bucket.
+func (c *bucketRegionCache) get(bucket string) (string, bool) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	region, ok := c.regions[bucket]
+	return region, ok
+}
+
+// set caches the region of the bucket, evicting the oldest bucket if the
+// cache is full.
+func (c *bucketRegionCache) set(bucket, region string) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if _, ok := c.regions[bucket]; !ok {
+		if len(c.buckets) >= c.size {
+			delete(c.regions, c.buckets[0])
+			c.buckets = c.buckets[1:]
+		}
+		c.buckets = append(c.buckets, bucket)
+	}
+	c.regions[bucket] = region
+}
+
+// newRegionRedirectHandler returns the build handler following the region
+// redirects of the client's requests, caching the regions of the buckets
+// redirected in the cache.
+func newRegionRedirectHandler(cache *bucketRegionCache) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "awssdk.s3.RegionRedirectHandler",
+		Fn: func(r *request.Request) {
+			if !aws.BoolValue(r.Config.S3FollowRegionRedirects) ||
+				aws.BoolValue(r.Config.S3UseAccelerate) ||
+				len(aws.StringValue(r.Config.Endpoint)) != 0 {
+				return
+			}
+			bucket, ok := bucketNameFromReqParams(r.Params)
+			if !ok || strings.HasPrefix(bucket, "arn:") {
+				return
+			}
+
+			rr := &regionRedirect{
+				cache:  cache,
+				bucket: bucket,
+				host:   r.HTTPRequest.URL.Host,
+			}
+			if region, ok := cache.get(bucket); ok && region != aws.StringValue(r.Config.Region) {
+				if err := rr.setRegion(r, region); err != nil {
+					r.Error = err
+					return
+				}
+			}
+
+			r.Handlers.AfterRetry.PushBackNamed(request.NamedHandler{
+				Name: "awssdk.s3.RegionRedirectRetryHandler",
+				Fn:   rr.retry,
+			})
+		},
+	}
+}
+
+// regionRedirect follows the region redirect of a request to a bucket.
+type regionRedirect struct {
+	cache  *bucketRegionCache
+	bucket string
+
+	// The host of the endpoint of the request's region.
+	host string
+
+	// The error of the request redirected, nil if the request was not
+	// redirected.
+	err error
+}
+
+// retry retries the request with the endpoint of the bucket's region if the
+// request's error is a region redirect. The request is only redirected once,
+// a second redirect fails the request with the error of the first redirect.
+func (rr *regionRedirect) retry(r *request.Request) {
+	if r.Error == nil {
+		return
+	}
+	region := redirectRegion(r)
+	if len(region) == 0 {
+		return
+	}
+	if rr.err != nil || region == aws.StringValue(r.Config.Region) {
+		if rr.err != nil {
+			r.Error = rr.err
+		}
+		return
+	}
+
+	if err := rr.setRegion(r, region); err != nil {
+		logMessage(r, fmt.Sprintf("DEBUG: unable to follow the region redirect of bucket %s, %v", rr.bucket, err))
+		return
+	}
+	rr.cache.set(rr.bucket, region)
+
+	rr.err = r.Error
+	r.Error = nil
+	r.Retryable = aws.Bool(true)
+}
+
+// setRegion updates the request's endpoint and signing region to the
+// bucket's region.
+func (rr *regionRedirect) setRegion(r *request.Request, region string) error {
+	resolver := r.Config.EndpointResolver
+	if resolver == nil {
+		resolver = endpoints.DefaultResolver()
+	}
+	resolved, err := resolver.EndpointFor(EndpointsID, region, func(opt *endpoints.Options) {
+		opt.DisableSSL = aws.BoolValue(r.Config.DisableSSL)
+		opt.UseDualStack = aws.BoolValue(r.Config.UseDualStack)
+		opt.UseDualStackEndpoint = aws.BoolValue(r.Config.UseDualStackEndpoint)
+		opt.UseFIPSEndpoint = aws.BoolValue(r.Config.UseFIPSEndpoint)
+		opt.S3UsEast1RegionalEndpoint = r.Config.S3UsEast1RegionalEndpoint
+	})
+	if err != nil {
+		return err
+	}
+	u, err := url.Parse(resolved.URL)
+	if err != nil {
+		return err
+	}
+
+	host := r.HTTPRequest.URL.Host
+	if !strings.HasSuffix(host, rr.host) {
+		return awserr.New("BucketRegionError",
+			fmt.Sprintf("request host %s is not the endpoint %s", host, rr.host), nil)
+	}
+	r.HTTPRequest.URL.Host = strings.TrimSuffix(host, rr.host) + u.Host
+	rr.host = u.Host
+
+	r.Config.Region = aws.String(region)
+	r.ClientInfo.SigningRegion = resolved.SigningRegion
+	if len(r.ClientInfo.SigningRegion) == 0 {
+		r.ClientInfo.SigningRegion = region
+	}
+
+	return nil
+}
+
+// redirectRegion returns the region of the bucket of the request if the
+// request's error is a region redirect, from the x-amz-bucket-region header
+// or the error's body.
+func redirectRegion(r *request.Request) string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+
+	switch r.HTTPResponse.StatusCode {
+	case http.StatusMovedPermanently:
+	case http.StatusBadRequest:
+		if aerr, ok := r.Error.(awserr.Error); !ok || aerr.Code() != "AuthorizationHeaderMalformed" {
+			return ""
+		}
+	default:
+		return ""
+	}
+
+	if region := r.HTTPResponse.Header.Get("X-Amz-Bucket-Region"); len(region) != 0 {
+		return region
+	}
+	if rf, ok := r.Error.(requestFailure); ok {
+		return rf.region
+	}
+	return ""
+}
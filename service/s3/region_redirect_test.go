@@ -0,0 +1,247 @@
+package s3_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+type redirectAttempt struct {
+	URL, Auth string
+}
+
+// newRedirectSvc returns a client whose requests are sent to the stub,
+// recording the URL and Authorization header of each attempt.
+func newRedirectSvc(cfg *aws.Config, attempts *[]redirectAttempt, respFn func(r *request.Request) *http.Response) *s3.S3 {
+	svc := s3.New(unit.Session, &aws.Config{
+		Region:                  aws.String("us-east-1"),
+		S3FollowRegionRedirects: aws.Bool(true),
+	}, cfg)
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		*attempts = append(*attempts, redirectAttempt{
+			URL:  r.HTTPRequest.URL.String(),
+			Auth: r.HTTPRequest.Header.Get("Authorization"),
+		})
+		r.HTTPResponse = respFn(r)
+	})
+
+	return svc
+}
+
+func redirectResponse(status int, region, body string) *http.Response {
+	header := http.Header{}
+	if len(region) != 0 {
+		header.Set("X-Amz-Bucket-Region", region)
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func okResponse() *http.Response {
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+	}
+}
+
+func TestRegionRedirect(t *testing.T) {
+	const authMalformed = `<Error><Code>AuthorizationHeaderMalformed</Code><Message>the region 'us-east-1' is wrong; expecting 'us-west-2'</Message><Region>us-west-2</Region></Error>`
+
+	cases := map[string]struct {
+		Config       *aws.Config
+		Redirect     *http.Response
+		ExpectURLs   []string
+		ExpectRegion string
+		ErrCode      string
+	}{
+		"moved permanently": {
+			Redirect: redirectResponse(301, "us-west-2", ""),
+			ExpectURLs: []string{
+				"https://bucket.s3.amazonaws.com/key",
+				"https://bucket.s3-us-west-2.amazonaws.com/key",
+			},
+			ExpectRegion: "us-west-2",
+		},
+		"moved permanently region in body": {
+			Redirect: redirectResponse(301, "", `<Error><Code>PermanentRedirect</Code><Region>eu-west-1</Region></Error>`),
+			ExpectURLs: []string{
+				"https://bucket.s3.amazonaws.com/key",
+				"https://bucket.s3-eu-west-1.amazonaws.com/key",
+			},
+			ExpectRegion: "eu-west-1",
+		},
+		"authorization header malformed": {
+			Redirect: redirectResponse(400, "", authMalformed),
+			ExpectURLs: []string{
+				"https://bucket.s3.amazonaws.com/key",
+				"https://bucket.s3-us-west-2.amazonaws.com/key",
+			},
+			ExpectRegion: "us-west-2",
+		},
+		"path style": {
+			Config:   &aws.Config{S3ForcePathStyle: aws.Bool(true)},
+			Redirect: redirectResponse(301, "us-west-2", ""),
+			ExpectURLs: []string{
+				"https://s3.amazonaws.com/bucket/key",
+				"https://s3-us-west-2.amazonaws.com/bucket/key",
+			},
+			ExpectRegion: "us-west-2",
+		},
+		"not enabled": {
+			Config:     &aws.Config{S3FollowRegionRedirects: aws.Bool(false)},
+			Redirect:   redirectResponse(301, "us-west-2", ""),
+			ExpectURLs: []string{"https://bucket.s3.amazonaws.com/key"},
+			ErrCode:    "BucketRegionError",
+		},
+		"custom endpoint": {
+			Config:     &aws.Config{Endpoint: aws.String("https://s3.example.com")},
+			Redirect:   redirectResponse(301, "us-west-2", ""),
+			ExpectURLs: []string{"https://bucket.s3.example.com/key"},
+			ErrCode:    "BucketRegionError",
+		},
+		"no region": {
+			Redirect:   redirectResponse(301, "", ""),
+			ExpectURLs: []string{"https://bucket.s3.amazonaws.com/key"},
+			ErrCode:    "BucketRegionError",
+		},
+		"other bad request": {
+			Redirect:   redirectResponse(400, "us-west-2", `<Error><Code>InvalidArgument</Code></Error>`),
+			ExpectURLs: []string{"https://bucket.s3.amazonaws.com/key"},
+			ErrCode:    "InvalidArgument",
+		},
+	}
+
+	for name, c := range cases {
+		var attempts []redirectAttempt
+		svc := newRedirectSvc(c.Config, &attempts, func(r *request.Request) *http.Response {
+			if len(attempts) == 1 {
+				return c.Redirect
+			}
+			return okResponse()
+		})
+
+		_, err := svc.GetObject(&s3.GetObjectInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+		})
+		if len(c.ErrCode) != 0 {
+			aerr, ok := err.(awserr.Error)
+			if !ok {
+				t.Fatalf("%s, expect awserr.Error, got %T, %v", name, err, err)
+			}
+			if e, a := c.ErrCode, aerr.Code(); e != a {
+				t.Errorf("%s, expect %v error code, got %v", name, e, a)
+			}
+		} else if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if e, a := len(c.ExpectURLs), len(attempts); e != a {
+			t.Fatalf("%s, expect %v attempts, got %v", name, e, a)
+		}
+		for i, e := range c.ExpectURLs {
+			if a := attempts[i].URL; e != a {
+				t.Errorf("%s, expect attempt %d URL %v, got %v", name, i, e, a)
+			}
+		}
+		if len(c.ExpectRegion) != 0 {
+			e := "/" + c.ExpectRegion + "/s3/aws4_request"
+			if a := attempts[len(attempts)-1].Auth; !strings.Contains(a, e) {
+				t.Errorf("%s, expect %v credential scope, got %v", name, e, a)
+			}
+		}
+	}
+}
+
+func TestRegionRedirect_Cached(t *testing.T) {
+	var attempts []redirectAttempt
+	svc := newRedirectSvc(nil, &attempts, func(r *request.Request) *http.Response {
+		if len(attempts) == 1 {
+			return redirectResponse(301, "us-west-2", "")
+		}
+		return okResponse()
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := svc.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+		})
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+	}
+
+	expect := []string{
+		"https://bucket.s3.amazonaws.com/key",
+		"https://bucket.s3-us-west-2.amazonaws.com/key",
+		"https://bucket.s3-us-west-2.amazonaws.com/key",
+	}
+	if e, a := len(expect), len(attempts); e != a {
+		t.Fatalf("expect %v attempts, got %v", e, a)
+	}
+	for i, e := range expect {
+		if a := attempts[i].URL; e != a {
+			t.Errorf("expect attempt %d URL %v, got %v", i, e, a)
+		}
+	}
+	if e, a := "/us-west-2/s3/aws4_request", attempts[2].Auth; !strings.Contains(a, e) {
+		t.Errorf("expect %v credential scope, got %v", e, a)
+	}
+
+	// The region is cached per client.
+	attempts = nil
+	other := newRedirectSvc(nil, &attempts, func(r *request.Request) *http.Response {
+		return okResponse()
+	})
+	if _, err := other.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "https://bucket.s3.amazonaws.com/key", attempts[0].URL; e != a {
+		t.Errorf("expect %v URL, got %v", e, a)
+	}
+}
+
+func TestRegionRedirect_Loop(t *testing.T) {
+	var attempts []redirectAttempt
+	svc := newRedirectSvc(nil, &attempts, func(r *request.Request) *http.Response {
+		if len(attempts) == 1 {
+			return redirectResponse(301, "us-west-2", "")
+		}
+		return redirectResponse(400, "", `<Error><Code>AuthorizationHeaderMalformed</Code><Region>us-east-1</Region></Error>`)
+	})
+
+	_, err := svc.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	aerr, ok := err.(awserr.RequestFailure)
+	if !ok {
+		t.Fatalf("expect awserr.RequestFailure, got %T, %v", err, err)
+	}
+	if e, a := "BucketRegionError", aerr.Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if e, a := 301, aerr.StatusCode(); e != a {
+		t.Errorf("expect %v status code, got %v", e, a)
+	}
+	if e, a := 2, len(attempts); e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+}
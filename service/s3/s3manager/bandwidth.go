@@ -0,0 +1,149 @@
+package s3manager
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// maxBandwidthWaitN is the maximum number of bytes the BandwidthLimiter is
+// waited for at once.
+const maxBandwidthWaitN = 32 * 1024
+
+// A BandwidthLimiter limits the throughput of the uploads and downloads it is
+// used with. The limiter can be shared by Uploaders and Downloaders to limit
+// their combined throughput.
+//
+// The *rate.Limiter of the golang.org/x/time/rate package satisfies this
+// interface, with a burst of at least 32 KiB.
+type BandwidthLimiter interface {
+	// WaitN blocks until n bytes can be transferred, or returns an error if
+	// the context is canceled before. WaitN is called with at most 32 KiB.
+	WaitN(ctx aws.Context, n int) error
+}
+
+// NewBandwidthLimiter returns a BandwidthLimiter limiting the throughput to
+// bytesPerSec bytes per second, with bursts of up to burst bytes, so that
+// transfers smaller than the burst are not delayed. The burst should be at
+// least 32 KiB.
+//
+// Example:
+//
+//	// Limit uploads to 1 MiB/s, with bursts of up to 256 KiB.
+//	uploader := s3manager.NewUploader(sess, func(u *s3manager.Uploader) {
+//	    u.BandwidthLimiter = s3manager.NewBandwidthLimiter(1024*1024, 256*1024)
+//	})
+func NewBandwidthLimiter(bytesPerSec, burst int64) BandwidthLimiter {
+	return newBandwidthLimiter(bytesPerSec, burst, time.Now, func(ctx aws.Context, t time.Time) error {
+		return aws.SleepWithContext(ctx, t.Sub(time.Now()))
+	})
+}
+
+// bandwidthLimiter is a token bucket of the bytes which can be transferred,
+// refilled at the limiter's rate. The bytes waited for are reserved from the
+// bucket, and waiters sleep until the bucket would have been refilled with the
+// bytes reserved.
+type bandwidthLimiter struct {
+	m      sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	now        func() time.Time
+	sleepUntil func(ctx aws.Context, t time.Time) error
+}
+
+func newBandwidthLimiter(bytesPerSec, burst int64, now func() time.Time,
+	sleepUntil func(aws.Context, time.Time) error) *bandwidthLimiter {
+	return &bandwidthLimiter{
+		rate:       float64(bytesPerSec),
+		burst:      float64(burst),
+		tokens:     float64(burst),
+		last:       now(),
+		now:        now,
+		sleepUntil: sleepUntil,
+	}
+}
+
+// WaitN blocks until the n bytes reserved can be transferred.
+func (l *bandwidthLimiter) WaitN(ctx aws.Context, n int) error {
+	l.m.Lock()
+	now := l.now()
+	if now.After(l.last) {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+	}
+	l.tokens -= float64(n)
+	tokens, last := l.tokens, l.last
+	l.m.Unlock()
+
+	if tokens >= 0 {
+		return nil
+	}
+
+	until := last.Add(time.Duration(-tokens / l.rate * float64(time.Second)))
+	if err := l.sleepUntil(ctx, until); err != nil {
+		// Return the bytes which were not transferred.
+		l.m.Lock()
+		l.tokens += float64(n)
+		l.m.Unlock()
+		return err
+	}
+	return nil
+}
+
+// throttledReader is a reader whose reads wait for the bandwidth limiter.
+type throttledReader struct {
+	io.ReadCloser
+	ctx     aws.Context
+	limiter BandwidthLimiter
+}
+
+func (r *throttledReader) Read(p []byte) (int, error) {
+	if len(p) > maxBandwidthWaitN {
+		p = p[:maxBandwidthWaitN]
+	}
+
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		if werr := r.limiter.WaitN(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
+
+// withRequestBodyLimit returns the request options with an option limiting
+// the throughput of the request's body with the limiter. The body of each
+// attempt is limited, so that the bytes of retried attempts are counted.
+func withRequestBodyLimit(opts []request.Option, limiter BandwidthLimiter) []request.Option {
+	return append(opts[:len(opts):len(opts)], func(r *request.Request) {
+		r.Handlers.Send.PushFront(func(r *request.Request) {
+			body := r.HTTPRequest.Body
+			if body == nil || body == request.NoBody {
+				return
+			}
+			r.HTTPRequest.Body = &throttledReader{ReadCloser: body, ctx: r.Context(), limiter: limiter}
+		})
+	})
+}
+
+// withResponseBodyLimit returns the request options with an option limiting
+// the throughput of the request's response body with the limiter.
+func withResponseBodyLimit(opts []request.Option, limiter BandwidthLimiter) []request.Option {
+	return append(opts[:len(opts):len(opts)], func(r *request.Request) {
+		r.Handlers.Send.PushBack(func(r *request.Request) {
+			if r.Error != nil || r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+				return
+			}
+			r.HTTPResponse.Body = &throttledReader{ReadCloser: r.HTTPResponse.Body, ctx: r.Context(), limiter: limiter}
+		})
+	})
+}
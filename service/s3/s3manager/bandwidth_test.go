@@ -0,0 +1,247 @@
+package s3manager
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// fakeClock is a clock whose sleeps advance the clock instead of sleeping.
+type fakeClock struct {
+	m   sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) SleepUntil(ctx aws.Context, t time.Time) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if t.After(c.now) {
+		c.now = t
+	}
+	return nil
+}
+
+func newFakeClockLimiter(bytesPerSec, burst int64) (*bandwidthLimiter, *fakeClock) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	return newBandwidthLimiter(bytesPerSec, burst, clock.Now, clock.SleepUntil), clock
+}
+
+func TestBandwidthLimiter(t *testing.T) {
+	cases := map[string]struct {
+		Rate, Burst int64
+		Waits       []int
+		Expect      time.Duration
+	}{
+		"within burst": {
+			Rate: 1000, Burst: 500,
+			Waits:  []int{200, 300},
+			Expect: 0,
+		},
+		"over burst": {
+			Rate: 1000, Burst: 500,
+			Waits:  []int{500, 1000},
+			Expect: time.Second,
+		},
+		"larger than burst": {
+			Rate: 1000, Burst: 100,
+			Waits:  []int{2100},
+			Expect: 2 * time.Second,
+		},
+	}
+
+	for name, c := range cases {
+		l, clock := newFakeClockLimiter(c.Rate, c.Burst)
+		for _, n := range c.Waits {
+			if err := l.WaitN(aws.BackgroundContext(), n); err != nil {
+				t.Fatalf("%s, expect no error, got %v", name, err)
+			}
+		}
+		if e, a := c.Expect, clock.Now().Sub(time.Unix(0, 0)); e != a {
+			t.Errorf("%s, expect %v elapsed, got %v", name, e, a)
+		}
+	}
+}
+
+func TestBandwidthLimiter_Canceled(t *testing.T) {
+	l := NewBandwidthLimiter(1, 1)
+
+	ctx := aws.BackgroundContext()
+	if err := l.WaitN(ctx, 1); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	cctx := &cancelContext{Context: ctx, done: make(chan struct{})}
+	close(cctx.done)
+
+	start := time.Now()
+	if err := l.WaitN(cctx, 100); err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("expect canceled wait to return immediately, took %v", d)
+	}
+}
+
+type cancelContext struct {
+	aws.Context
+	done chan struct{}
+}
+
+func (c *cancelContext) Done() <-chan struct{} { return c.done }
+func (c *cancelContext) Err() error {
+	select {
+	case <-c.done:
+		return fmt.Errorf("context canceled")
+	default:
+		return nil
+	}
+}
+
+func TestUploadBandwidthLimit(t *testing.T) {
+	const size = 10 * 1024 * 1024
+
+	var m sync.Mutex
+	var sent int
+	svc := s3.New(unit.Session)
+	svc.Handlers.Unmarshal.Clear()
+	svc.Handlers.UnmarshalMeta.Clear()
+	svc.Handlers.UnmarshalError.Clear()
+	svc.Handlers.ValidateResponse.Clear()
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		var n int64
+		if r.HTTPRequest.Body != nil {
+			n, _ = io.Copy(ioutil.Discard, r.HTTPRequest.Body)
+		}
+
+		m.Lock()
+		defer m.Unlock()
+		if r.Operation.Name == "UploadPart" {
+			sent += int(n)
+		}
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		}
+		switch data := r.Data.(type) {
+		case *s3.CreateMultipartUploadOutput:
+			data.UploadId = aws.String("UPLOAD-ID")
+		case *s3.UploadPartOutput:
+			data.ETag = aws.String("ETAG")
+		}
+	})
+
+	limiter, clock := newFakeClockLimiter(1024*1024, 64*1024)
+	u := NewUploaderWithClient(svc, func(u *Uploader) {
+		u.Concurrency = 4
+		u.BandwidthLimiter = limiter
+	})
+	_, err := u.Upload(&UploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader(make([]byte, size)),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := size, sent; e != a {
+		t.Errorf("expect %v bytes sent, got %v", e, a)
+	}
+	elapsed := clock.Now().Sub(time.Unix(0, 0))
+	if elapsed < 9750*time.Millisecond || elapsed > 10250*time.Millisecond {
+		t.Errorf("expect ~10s elapsed, got %v", elapsed)
+	}
+}
+
+func TestDownloadBandwidthLimit(t *testing.T) {
+	const size = 10 * 1024 * 1024
+	data := make([]byte, size)
+	reRange := regexp.MustCompile(`bytes=(\d+)-(\d+)`)
+
+	var m sync.Mutex
+	var active, maxActive int
+	svc := s3.New(unit.Session)
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		rng := reRange.FindStringSubmatch(r.HTTPRequest.Header.Get("Range"))
+		start, _ := strconv.ParseInt(rng[1], 10, 64)
+		fin, _ := strconv.ParseInt(rng[2], 10, 64)
+		if fin >= size {
+			fin = size - 1
+		}
+
+		m.Lock()
+		active++
+		if active > maxActive {
+			maxActive = active
+		}
+		m.Unlock()
+
+		r.HTTPResponse = &http.Response{
+			StatusCode: 206,
+			Header:     http.Header{},
+			Body: &activeReader{Reader: bytes.NewReader(data[start : fin+1]), done: func() {
+				m.Lock()
+				active--
+				m.Unlock()
+			}},
+		}
+		r.HTTPResponse.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, fin, size))
+		r.HTTPResponse.Header.Set("Content-Length", strconv.FormatInt(fin+1-start, 10))
+	})
+
+	limiter, clock := newFakeClockLimiter(1024*1024, 64*1024)
+	d := NewDownloaderWithClient(svc, func(d *Downloader) {
+		d.Concurrency = 4
+		d.PartSize = size / 4
+		d.BandwidthLimiter = limiter
+	})
+	n, err := d.Download(&aws.WriteAtBuffer{}, &s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := int64(size), n; e != a {
+		t.Errorf("expect %v bytes downloaded, got %v", e, a)
+	}
+	elapsed := clock.Now().Sub(time.Unix(0, 0))
+	if elapsed < 9750*time.Millisecond || elapsed > 10250*time.Millisecond {
+		t.Errorf("expect ~10s elapsed, got %v", elapsed)
+	}
+	if maxActive < 2 {
+		t.Errorf("expect concurrent parts, got %v at most", maxActive)
+	}
+}
+
+// activeReader calls done when the reader is closed.
+type activeReader struct {
+	io.Reader
+	done func()
+}
+
+func (r *activeReader) Close() error {
+	r.done()
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -18,6 +19,15 @@ const (
 	// This value is used when calling DeleteObjects. This represents how many objects to delete
 	// per DeleteObjects call.
 	DefaultBatchSize = 100
+
+	// MaxBatchSize is the maximum number of objects S3 deletes per
+	// DeleteObjects call. Batch sizes greater than MaxBatchSize are reduced to
+	// MaxBatchSize.
+	MaxBatchSize = 1000
+
+	// DefaultBatchDeleteConcurrency is the default number of DeleteObjects
+	// calls a batch delete client makes in parallel.
+	DefaultBatchDeleteConcurrency = 1
 )
 
 // BatchError will contain the key and bucket of the object that failed to
@@ -60,7 +70,8 @@ func newError(err error, bucket, key *string) Error {
 }
 
 func (err *Error) Error() string {
-	return fmt.Sprintf("failed to upload %q to %q:\n%s", err.Key, err.Bucket, err.OrigErr.Error())
+	return fmt.Sprintf("failed to perform batch operation on %q in %q:\n%s",
+		aws.StringValue(err.Key), aws.StringValue(err.Bucket), err.OrigErr.Error())
 }
 
 // NewBatchError will return a BatchError that satisfies the awserr.Error interface.
@@ -156,14 +167,54 @@ func NewDeleteListIterator(svc s3iface.S3API, input *s3.ListObjectsInput, opts .
 	return iter
 }
 
+// NewDeleteListV2Iterator will return a new DeleteListIterator iterating
+// through the objects listed with ListObjectsV2.
+//
+// Example:
+//	iter := s3manager.NewDeleteListV2Iterator(svc, &s3.ListObjectsV2Input{
+//		Bucket: aws.String("bucket"),
+//		Prefix: aws.String("prefix/"),
+//	})
+//
+//	batcher := s3manager.NewBatchDeleteWithClient(svc)
+//	if err := batcher.Delete(aws.BackgroundContext(), iter); err != nil {
+//		return err
+//	}
+func NewDeleteListV2Iterator(svc s3iface.S3API, input *s3.ListObjectsV2Input, opts ...func(*DeleteListIterator)) BatchDeleteIterator {
+	iter := &DeleteListIterator{
+		Bucket: input.Bucket,
+		Paginator: request.Pagination{
+			NewRequest: func() (*request.Request, error) {
+				var inCpy *s3.ListObjectsV2Input
+				if input != nil {
+					tmp := *input
+					inCpy = &tmp
+				}
+				req, _ := svc.ListObjectsV2Request(inCpy)
+				return req, nil
+			},
+		},
+	}
+
+	for _, opt := range opts {
+		opt(iter)
+	}
+	return iter
+}
+
 // Next will use the S3API client to iterate through a list of objects.
 func (iter *DeleteListIterator) Next() bool {
 	if len(iter.objects) > 0 {
 		iter.objects = iter.objects[1:]
 	}
 
-	if len(iter.objects) == 0 && iter.Paginator.Next() {
-		iter.objects = iter.Paginator.Page().(*s3.ListObjectsOutput).Contents
+	for len(iter.objects) == 0 && iter.Paginator.Next() {
+		switch page := iter.Paginator.Page().(type) {
+		case *s3.ListObjectsOutput:
+			iter.objects = page.Contents
+		case *s3.ListObjectsV2Output:
+			iter.objects = page.Contents
+		}
 	}
 
 	return len(iter.objects) > 0
@@ -187,8 +238,30 @@ func (iter *DeleteListIterator) DeleteObject() BatchDeleteObject {
 // BatchDelete will use the s3 package's service client to perform a batch
 // delete.
 type BatchDelete struct {
-	Client    s3iface.S3API
+	Client s3iface.S3API
+
+	// The number of objects to delete per DeleteObjects call. If this value
+	// is zero, the DefaultBatchSize value will be used, and values greater
+	// than MaxBatchSize are reduced to MaxBatchSize.
 	BatchSize int
+
+	// The number of DeleteObjects calls to make in parallel. If this value is
+	// zero, the DefaultBatchDeleteConcurrency value will be used. The After
+	// functions of the objects are called from the goroutines making the
+	// calls, and must be safe to call concurrently if this value is greater
+	// than one.
+	Concurrency int
+
+	// Setting this value to true will make the DeleteObjects calls in quiet
+	// mode, where S3 only returns the objects it failed to delete.
+	Quiet bool
+
+	// Setting this value to true will cause Delete to stop deleting objects
+	// after the first DeleteObjects call which fails to delete any of its
+	// objects. Calls already in progress are completed. By default Delete
+	// continues deleting the remaining objects, and returns the failures of
+	// all calls.
+	AbortOnError bool
 }
 
 // NewBatchDeleteWithClient will return a new delete client that can delete a batched amount of
@@ -213,8 +286,9 @@ type BatchDelete struct {
 //	}
 func NewBatchDeleteWithClient(client s3iface.S3API, options ...func(*BatchDelete)) *BatchDelete {
 	svc := &BatchDelete{
-		Client:    client,
-		BatchSize: DefaultBatchSize,
+		Client:      client,
+		BatchSize:   DefaultBatchSize,
+		Concurrency: DefaultBatchDeleteConcurrency,
 	}
 
 	for _, opt := range options {
@@ -290,16 +364,59 @@ func (iter *DeleteObjectsIterator) DeleteObject() BatchDeleteObject {
 
 // Delete will use the iterator to queue up objects that need to be deleted.
 // Once the batch size is met, this will call the deleteBatch function.
+//
+// The objects S3 fails to delete are returned in a BatchError, with an Error
+// for each object with the error code and message returned by S3.
 func (d *BatchDelete) Delete(ctx aws.Context, iter BatchDeleteIterator) error {
+	batchSize := d.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	} else if batchSize > MaxBatchSize {
+		batchSize = MaxBatchSize
+	}
+	concurrency := d.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBatchDeleteConcurrency
+	}
+
+	var wg sync.WaitGroup
+	var m sync.Mutex
 	var errs []Error
+	failed := false
+
+	aborted := func() bool {
+		m.Lock()
+		defer m.Unlock()
+		return d.AbortOnError && failed
+	}
+
+	ch := make(chan deleteBatchInput, concurrency)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := range ch {
+				if aborted() {
+					// Drain the channel without deleting the queued batches.
+					continue
+				}
+				batchErrs := deleteBatch(ctx, d, b.input, b.objects)
+
+				m.Lock()
+				errs = append(errs, batchErrs...)
+				failed = failed || len(batchErrs) > 0
+				m.Unlock()
+			}
+		}()
+	}
 	objects := []BatchDeleteObject{}
 	var input *s3.DeleteObjectsInput
 
-	for iter.Next() {
+	for !aborted() && iter.Next() {
 		o := iter.DeleteObject()
 
 		if input == nil {
-			input = initDeleteObjectsInput(o.Object)
+			input = d.initDeleteObjectsInput(o.Object)
 		}
 
 		parity := hasParity(input, o)
@@ -311,17 +428,15 @@ func (d *BatchDelete) Delete(ctx aws.Context, iter BatchDeleteIterator) error {
 			objects = append(objects, o)
 		}
 
-		if len(input.Delete.Objects) == d.BatchSize || !parity {
-			if err := deleteBatch(d, input, objects); err != nil {
-				errs = append(errs, err...)
-			}
+		if len(input.Delete.Objects) == batchSize || !parity {
+			ch <- deleteBatchInput{input: input, objects: objects}
 
-			objects = objects[:0]
+			objects = []BatchDeleteObject{}
 			input = nil
 
 			if !parity {
 				objects = append(objects, o)
-				input = initDeleteObjectsInput(o.Object)
+				input = d.initDeleteObjectsInput(o.Object)
 				input.Delete.Objects = append(input.Delete.Objects, &s3.ObjectIdentifier{
 					Key:       o.Object.Key,
 					VersionId: o.Object.VersionId,
@@ -330,10 +445,15 @@ func (d *BatchDelete) Delete(ctx aws.Context, iter BatchDeleteIterator) error {
 		}
 	}
 
-	if input != nil && len(input.Delete.Objects) > 0 {
-		if err := deleteBatch(d, input, objects); err != nil {
-			errs = append(errs, err...)
-		}
+	if !aborted() && input != nil && len(input.Delete.Objects) > 0 {
+		ch <- deleteBatchInput{input: input, objects: objects}
+	}
+
+	close(ch)
+	wg.Wait()
+
+	if err := iter.Err(); err != nil {
+		errs = append(errs, newError(err, nil, nil))
 	}
 
 	if len(errs) > 0 {
@@ -342,26 +462,39 @@ func (d *BatchDelete) Delete(ctx aws.Context, iter BatchDeleteIterator) error {
 	return nil
 }
 
-func initDeleteObjectsInput(o *s3.DeleteObjectInput) *s3.DeleteObjectsInput {
-	return &s3.DeleteObjectsInput{
+// deleteBatchInput is a batch of objects to delete with a DeleteObjects call.
+type deleteBatchInput struct {
+	input   *s3.DeleteObjectsInput
+	objects []BatchDeleteObject
+}
+
+func (d *BatchDelete) initDeleteObjectsInput(o *s3.DeleteObjectInput) *s3.DeleteObjectsInput {
+	input := &s3.DeleteObjectsInput{
 		Bucket:       o.Bucket,
 		MFA:          o.MFA,
 		RequestPayer: o.RequestPayer,
 		Delete:       &s3.Delete{},
 	}
+	if d.Quiet {
+		input.Delete.Quiet = aws.Bool(true)
+	}
+
+	return input
 }
 
 // deleteBatch will delete a batch of items in the objects parameters.
-func deleteBatch(d *BatchDelete, input *s3.DeleteObjectsInput, objects []BatchDeleteObject) []Error {
+func deleteBatch(ctx aws.Context, d *BatchDelete, input *s3.DeleteObjectsInput, objects []BatchDeleteObject) []Error {
 	errs := []Error{}
 
-	if result, err := d.Client.DeleteObjects(input); err != nil {
+	if result, err := d.Client.DeleteObjectsWithContext(ctx, input); err != nil {
 		for i := 0; i < len(input.Delete.Objects); i++ {
 			errs = append(errs, newError(err, input.Bucket, input.Delete.Objects[i].Key))
 		}
 	} else if len(result.Errors) > 0 {
 		for i := 0; i < len(result.Errors); i++ {
-			errs = append(errs, newError(err, input.Bucket, result.Errors[i].Key))
+			e := result.Errors[i]
+			err := awserr.New(aws.StringValue(e.Code), aws.StringValue(e.Message), nil)
+			errs = append(errs, newError(err, input.Bucket, e.Key))
 		}
 	}
 	for _, object := range objects {
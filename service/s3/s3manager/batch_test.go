@@ -6,10 +6,15 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/awstesting/unit"
@@ -973,3 +978,193 @@ func TestAfter(t *testing.T) {
 		t.Error("Expected 'afterUpload' to be true, but received false")
 	}
 }
+
+type mockDeleteClient struct {
+	s3iface.S3API
+	m       sync.Mutex
+	inputs  []*s3.DeleteObjectsInput
+	failKey map[string]string
+}
+
+func (client *mockDeleteClient) DeleteObjectsWithContext(ctx aws.Context, input *s3.DeleteObjectsInput, opts ...request.Option) (*s3.DeleteObjectsOutput, error) {
+	client.m.Lock()
+	defer client.m.Unlock()
+
+	client.inputs = append(client.inputs, input)
+
+	out := &s3.DeleteObjectsOutput{}
+	for _, o := range input.Delete.Objects {
+		if code, ok := client.failKey[*o.Key]; ok {
+			out.Errors = append(out.Errors, &s3.Error{
+				Key:     o.Key,
+				Code:    aws.String(code),
+				Message: aws.String("Access Denied"),
+			})
+		} else if !aws.BoolValue(input.Delete.Quiet) {
+			out.Deleted = append(out.Deleted, &s3.DeletedObject{Key: o.Key})
+		}
+	}
+
+	return out, nil
+}
+
+func testDeleteObjects(n int) []BatchDeleteObject {
+	objects := make([]BatchDeleteObject, 0, n)
+	for i := 0; i < n; i++ {
+		objects = append(objects, BatchDeleteObject{
+			Object: &s3.DeleteObjectInput{
+				Bucket: aws.String("bucket"),
+				Key:    aws.String(strconv.Itoa(i)),
+			},
+		})
+	}
+	return objects
+}
+
+func TestBatchDelete_Errors(t *testing.T) {
+	cases := map[string]struct {
+		BatchSize    int
+		Concurrency  int
+		AbortOnError bool
+		Objects      int
+		ExpectCalls  int
+		ExpectErrs   []string
+	}{
+		"continue on error": {
+			BatchSize:   2,
+			Objects:     5,
+			ExpectCalls: 3,
+			ExpectErrs:  []string{"1", "4"},
+		},
+		"abort on error": {
+			BatchSize:    2,
+			AbortOnError: true,
+			Objects:      5,
+			ExpectCalls:  1,
+			ExpectErrs:   []string{"1"},
+		},
+		"concurrent": {
+			BatchSize:   2,
+			Concurrency: 3,
+			Objects:     20,
+			ExpectCalls: 10,
+			ExpectErrs:  []string{"1", "4"},
+		},
+		"max batch size": {
+			BatchSize:   5000,
+			Objects:     2500,
+			ExpectCalls: 3,
+			ExpectErrs:  []string{"1", "4"},
+		},
+	}
+
+	for name, c := range cases {
+		svc := &mockDeleteClient{failKey: map[string]string{"1": "AccessDenied", "4": "AccessDenied"}}
+		batcher := NewBatchDeleteWithClient(svc, func(d *BatchDelete) {
+			d.BatchSize = c.BatchSize
+			d.Concurrency = c.Concurrency
+			d.AbortOnError = c.AbortOnError
+			d.Quiet = true
+		})
+
+		err := batcher.Delete(aws.BackgroundContext(), &DeleteObjectsIterator{Objects: testDeleteObjects(c.Objects)})
+		bErr, ok := err.(*BatchError)
+		if !ok {
+			t.Fatalf("%s, expect BatchError, got %T, %v", name, err, err)
+		}
+
+		var keys []string
+		for _, e := range bErr.Errors {
+			keys = append(keys, aws.StringValue(e.Key))
+			if e, a := "bucket", aws.StringValue(e.Bucket); e != a {
+				t.Errorf("%s, expect %v bucket, got %v", name, e, a)
+			}
+			aerr, ok := e.OrigErr.(awserr.Error)
+			if !ok {
+				t.Fatalf("%s, expect awserr.Error, got %T", name, e.OrigErr)
+			}
+			if e, a := "AccessDenied", aerr.Code(); e != a {
+				t.Errorf("%s, expect %v error code, got %v", name, e, a)
+			}
+		}
+		sort.Strings(keys)
+		if e, a := c.ExpectErrs, keys; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v failed keys, got %v", name, e, a)
+		}
+
+		if e, a := c.ExpectCalls, len(svc.inputs); e != a {
+			t.Errorf("%s, expect %d calls, got %d", name, e, a)
+		}
+		deleted := 0
+		for _, input := range svc.inputs {
+			if n := len(input.Delete.Objects); n > MaxBatchSize {
+				t.Errorf("%s, expect at most %d objects per call, got %d", name, MaxBatchSize, n)
+			}
+			if !aws.BoolValue(input.Delete.Quiet) {
+				t.Errorf("%s, expect quiet mode", name)
+			}
+			deleted += len(input.Delete.Objects)
+		}
+		if !c.AbortOnError {
+			if e, a := c.Objects, deleted; e != a {
+				t.Errorf("%s, expect %d objects deleted, got %d", name, e, a)
+			}
+		}
+	}
+}
+
+type mockListV2Client struct {
+	mockDeleteClient
+	pages []*s3.ListObjectsV2Output
+	index int
+}
+
+func (client *mockListV2Client) ListObjectsV2Request(input *s3.ListObjectsV2Input) (*request.Request, *s3.ListObjectsV2Output) {
+	req, _ := buildS3SvcClient("http://localhost").ListObjectsV2Request(input)
+	req.Handlers.Clear()
+	req.Data = client.pages[client.index]
+	client.index++
+	return req, req.Data.(*s3.ListObjectsV2Output)
+}
+
+func TestBatchDeleteListV2(t *testing.T) {
+	svc := &mockListV2Client{
+		pages: []*s3.ListObjectsV2Output{
+			{
+				Contents:              []*s3.Object{{Key: aws.String("1")}, {Key: aws.String("2")}},
+				NextContinuationToken: aws.String("token"),
+				IsTruncated:           aws.Bool(true),
+			},
+			{
+				NextContinuationToken: aws.String("token2"),
+				IsTruncated:           aws.Bool(true),
+			},
+			{
+				Contents:    []*s3.Object{{Key: aws.String("3")}},
+				IsTruncated: aws.Bool(false),
+			},
+		},
+	}
+	batcher := NewBatchDeleteWithClient(svc)
+
+	iter := NewDeleteListV2Iterator(svc, &s3.ListObjectsV2Input{
+		Bucket: aws.String("bucket"),
+	})
+	if err := batcher.Delete(aws.BackgroundContext(), iter); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 1, len(svc.inputs); e != a {
+		t.Fatalf("expect %d calls, got %d", e, a)
+	}
+	var keys []string
+	for _, o := range svc.inputs[0].Delete.Objects {
+		keys = append(keys, aws.StringValue(o.Key))
+	}
+	if e, a := []string{"1", "2", "3"}, keys; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v keys, got %v", e, a)
+	}
+	if e, a := "bucket", aws.StringValue(svc.inputs[0].Bucket); e != a {
+		t.Errorf("expect %v bucket, got %v", e, a)
+	}
+}
@@ -1,7 +1,11 @@
 package s3manager
 
 import (
+	"fmt"
+	"net/http"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -13,9 +17,12 @@ import (
 // regionHint to determine which AWS partition to perform the query on.
 //
 // The request will not be signed, and will not use your AWS credentials.
+// The region is read from the response's X-Amz-Bucket-Region header, also
+// if the response is a 301 redirect or a 403 access denied error. Redirects
+// are never followed.
 //
-// A "NotFound" error code will be returned if the bucket does not exist in
-// the AWS partition the regionHint belongs to.
+// A BucketNotFound error, with the "NotFound" error code, will be returned if
+// the bucket does not exist in the AWS partition the regionHint belongs to.
 //
 // For example to get the region of a bucket which exists in "eu-central-1"
 // you could provide a region hint of "us-west-2".
@@ -25,7 +32,7 @@ import (
 //    bucket := "my-bucket"
 //    region, err := s3manager.GetBucketRegion(ctx, sess, bucket, "us-west-2")
 //    if err != nil {
-//        if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "NotFound" {
+//        if _, ok := err.(*s3manager.BucketNotFound); ok {
 //             fmt.Fprintf(os.Stderr, "unable to find bucket %s's region not found\n", bucket)
 //        }
 //        return err
@@ -74,6 +81,9 @@ func GetBucketRegionWithClient(ctx aws.Context, svc s3iface.S3API, bucket string
 	req.ApplyOptions(opts...)
 
 	if err := req.Send(); err != nil {
+		if rerr, ok := err.(awserr.RequestFailure); ok && rerr.StatusCode() == http.StatusNotFound {
+			return "", &BucketNotFound{RequestFailure: rerr, Bucket: bucket}
+		}
 		return "", err
 	}
 
@@ -81,3 +91,20 @@ func GetBucketRegionWithClient(ctx aws.Context, svc s3iface.S3API, bucket string
 
 	return bucketRegion, nil
 }
+
+// A BucketNotFound is the error returned by GetBucketRegion if the bucket
+// does not exist in the AWS partition of the region hint. The error satisfies
+// the awserr.RequestFailure interface, with the "NotFound" error code.
+type BucketNotFound struct {
+	awserr.RequestFailure
+
+	// The name of the bucket which was not found.
+	Bucket string
+}
+
+// Error returns the string representation of the error.
+func (e *BucketNotFound) Error() string {
+	extra := fmt.Sprintf("bucket: %s, status code: %d, request id: %s",
+		e.Bucket, e.StatusCode(), e.RequestID())
+	return awserr.SprintError(e.Code(), e.Message(), extra, e.OrigErr())
+}
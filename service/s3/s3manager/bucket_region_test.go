@@ -13,6 +13,14 @@ import (
 
 func testSetupGetBucketRegionServer(region string, statusCode int, incHeader bool) *httptest.Server {
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get("Authorization"); len(v) != 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if statusCode == http.StatusMovedPermanently {
+			// The redirect must not be followed.
+			w.Header().Set("Location", "http://invalid.localhost/bucket")
+		}
 		if incHeader {
 			w.Header().Set(bucketRegionHeader, region)
 		}
@@ -64,6 +72,16 @@ func TestGetBucketRegion_NotExists(t *testing.T) {
 	if e, a := "NotFound", aerr.Code(); e != a {
 		t.Errorf("expect %s error code, got %s", e, a)
 	}
+	nerr, ok := err.(*BucketNotFound)
+	if !ok {
+		t.Fatalf("expect BucketNotFound error, got %T", err)
+	}
+	if e, a := "bucket", nerr.Bucket; e != a {
+		t.Errorf("expect %s bucket, got %s", e, a)
+	}
+	if e, a := 404, nerr.StatusCode(); e != a {
+		t.Errorf("expect %d status code, got %d", e, a)
+	}
 	if len(region) != 0 {
 		t.Errorf("expect region not to be set, got %q", region)
 	}
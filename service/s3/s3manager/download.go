@@ -48,6 +48,22 @@ type Downloader struct {
 	// List of request options that will be passed down to individual API
 	// operation requests made by the downloader.
 	RequestOptions []request.Option
+
+	// The listener of the progress of each download, reporting the parts of
+	// the download as they are received. No progress is reported if this
+	// value is nil.
+	ProgressListener ProgressListener
+
+	// The limiter of the throughput of the data downloaded, shared by the
+	// concurrent parts of each download. The bytes of retried requests are
+	// counted. The throughput is not limited if this value is nil.
+	BandwidthLimiter BandwidthLimiter
+
+	// The retryer of the parts of each download which failed after the
+	// retries of the S3 client's retryer were exhausted. Each part is retried
+	// up to the PartRetryer's attempts, instead of failing the whole
+	// download. Failed parts are not retried if this value is nil.
+	PartRetryer PartRetryer
 }
 
 // WithDownloaderRequestOptions appends to the Downloader's API request options.
@@ -166,7 +182,62 @@ func (d Downloader) Download(w io.WriterAt, input *s3.GetObjectInput, options ..
 // to perform a single GetObjectInput request for that object's range. This will
 // caused the part size, and concurrency configurations to be ignored.
 func (d Downloader) DownloadWithContext(ctx aws.Context, w io.WriterAt, input *s3.GetObjectInput, options ...func(*Downloader)) (n int64, err error) {
-	impl := downloader{w: w, in: input, cfg: d, ctx: ctx}
+	impl := d.newDownloader(ctx, w, input, options...)
+
+	return impl.download()
+}
+
+// DownloadTo downloads an object in S3 and writes the payload into w in
+// order, so that w can be a sequential io.Writer, such as a pipe, hash,
+// or HTTP response writer.
+//
+// Parts of the object are downloaded with concurrent GET requests, and
+// buffered until all parts before them have been written to w. The
+// download is limited to Concurrency buffered parts, so that it uses
+// roughly Concurrency times PartSize bytes of memory. If any part fails to
+// download the download is aborted with the part's error, and no later parts
+// are written to w.
+//
+// If Concurrency is 1, or the GetObjectInput's Range value is provided, the
+// object is downloaded with a single GetObject request, and its body is
+// streamed into w without buffering. The body of the single request is not
+// retried if reading it fails, as it may be partially written to w.
+//
+// Returns the number of bytes written to w.
+//
+// It is safe to call this method concurrently across goroutines.
+func (d Downloader) DownloadTo(w io.Writer, input *s3.GetObjectInput, options ...func(*Downloader)) (n int64, err error) {
+	return d.DownloadToWithContext(aws.BackgroundContext(), w, input, options...)
+}
+
+// DownloadToWithContext downloads an object in S3 and writes the payload
+// into w in order.
+//
+// DownloadToWithContext is the same as DownloadTo with the additional support
+// for Context input parameters. The Context must not be nil. A nil Context
+// will cause a panic. Use the Context to add deadlining, timeouts, ect. The
+// DownloadToWithContext may create sub-contexts for individual underlying
+// requests.
+//
+// It is safe to call this method concurrently across goroutines.
+func (d Downloader) DownloadToWithContext(ctx aws.Context, w io.Writer, input *s3.GetObjectInput, options ...func(*Downloader)) (n int64, err error) {
+	impl := d.newDownloader(ctx, nil, input, options...)
+
+	if impl.cfg.Concurrency == 1 || len(aws.StringValue(input.Range)) != 0 {
+		return impl.downloadStream(w)
+	}
+
+	impl.seq = newSequentialWriter(w, impl.cfg.PartSize, impl.cfg.Concurrency)
+	impl.w = impl.seq
+
+	_, err = impl.download()
+	return impl.seq.Written(), err
+}
+
+// newDownloader returns the downloader of the object to w, configured by the
+// Downloader and options.
+func (d Downloader) newDownloader(ctx aws.Context, w io.WriterAt, input *s3.GetObjectInput, options ...func(*Downloader)) *downloader {
+	impl := &downloader{w: w, in: input, cfg: d, ctx: ctx}
 
 	for _, option := range options {
 		option(&impl.cfg)
@@ -185,8 +256,12 @@ func (d Downloader) DownloadWithContext(ctx aws.Context, w io.WriterAt, input *s
 	if impl.cfg.PartSize == 0 {
 		impl.cfg.PartSize = DefaultDownloadPartSize
 	}
+	impl.progress = &progress{listener: impl.cfg.ProgressListener}
+	if impl.cfg.BandwidthLimiter != nil {
+		impl.cfg.RequestOptions = withResponseBodyLimit(impl.cfg.RequestOptions, impl.cfg.BandwidthLimiter)
+	}
 
-	return impl.download()
+	return impl
 }
 
 // DownloadWithIterator will download a batched amount of objects in S3 and writes them
@@ -257,6 +332,12 @@ type downloader struct {
 	in *s3.GetObjectInput
 	w  io.WriterAt
 
+	// seq is the writer of the parts to the sequential io.Writer, if the
+	// object is downloaded with DownloadTo.
+	seq *sequentialWriter
+
+	progress *progress
+
 	wg sync.WaitGroup
 	m  sync.Mutex
 
@@ -265,6 +346,17 @@ type downloader struct {
 	written    int64
 	err        error
 
+	// etag is the ETag of the object downloaded, and ifMatch if the parts
+	// are downloaded with an If-Match condition of the ETag.
+	etag    string
+	ifMatch bool
+
+	// resumed are the byte ranges written before the download was resumed,
+	// and completed the byte ranges written by the download.
+	resuming  bool
+	resumed   []DownloadResumeRange
+	completed []DownloadResumeRange
+
 	partBodyMaxRetries int
 }
 
@@ -276,11 +368,17 @@ func (d *downloader) download() (n int64, err error) {
 	// at the cost of no multipart downloads.
 	if rng := aws.StringValue(d.in.Range); len(rng) > 0 {
 		d.downloadRange(rng)
+		if d.err == nil {
+			d.progress.transferCompleted()
+		}
 		return d.written, d.err
 	}
 
-	// Spin off first worker to check additional header information
-	d.getChunk()
+	// Spin off first worker to check additional header information, unless
+	// the download is resumed with the object's total bytes known.
+	if !d.resuming {
+		d.getChunk()
+	}
 
 	if total := d.getTotalBytes(); total >= 0 {
 		// Spin up workers
@@ -297,6 +395,14 @@ func (d *downloader) download() (n int64, err error) {
 				break // We're finished queuing chunks
 			}
 
+			if d.resuming && d.skipResumed(total) {
+				continue // The range was written before the download resumed.
+			}
+
+			if d.seq != nil && !d.seq.Acquire() {
+				break // The download failed while waiting for a buffer.
+			}
+
 			// Queue the next range of bytes to read.
 			ch <- dlchunk{w: d.w, start: d.pos, size: d.cfg.PartSize}
 			d.pos += d.cfg.PartSize
@@ -322,8 +428,13 @@ func (d *downloader) download() (n int64, err error) {
 		}
 	}
 
-	// Return error
-	return d.written, d.err
+	if d.err == nil {
+		d.progress.transferCompleted()
+		return d.written, nil
+	}
+
+	// Return error, resumable with the byte ranges written.
+	return d.written, d.resumableError(d.err)
 }
 
 // downloadPart is an individual goroutine worker reading from the ch channel
@@ -356,6 +467,9 @@ func (d *downloader) getChunk() {
 	if d.getErr() != nil {
 		return
 	}
+	if d.seq != nil && !d.seq.Acquire() {
+		return
+	}
 
 	chunk := dlchunk{w: d.w, start: d.pos, size: d.cfg.PartSize}
 	d.pos += d.cfg.PartSize
@@ -384,13 +498,63 @@ func (d *downloader) downloadRange(rng string) {
 	d.pos = d.written
 }
 
-// downloadChunk downloads the chunk from s3
+// downloadChunk downloads the chunk from s3, retrying the chunk with the
+// PartRetryer if it fails.
 func (d *downloader) downloadChunk(chunk dlchunk) error {
+	maxAttempts := 1
+	if d.cfg.PartRetryer != nil {
+		maxAttempts = d.cfg.PartRetryer.MaxAttempts()
+	}
+
+	var n int64
+	var err error
+	for attempt := 1; ; attempt++ {
+		chunk.cur = 0
+		n, err = d.tryDownloadChunk(chunk)
+		if err == nil || attempt >= maxAttempts || !d.cfg.PartRetryer.ShouldRetry(err) {
+			break
+		}
+
+		logMessage(d.cfg.S3, aws.LogDebugWithRequestRetries,
+			fmt.Sprintf("DEBUG: object part download failed %s, range %s, err, %v, retrying attempt %d",
+				aws.StringValue(d.in.Key), chunk.ByteRange(), err, attempt))
+
+		delay := d.cfg.PartRetryer.RetryDelay(attempt, err)
+		if sleepErr := aws.SleepWithContext(d.ctx, delay); sleepErr != nil {
+			return awserr.New(request.CanceledErrorCode, "download part retry canceled", sleepErr)
+		}
+	}
+
+	d.incrWritten(n)
+	if err != nil {
+		return err
+	}
+	d.completeChunk(chunk, n)
+
+	partNumber := int64(1)
+	if len(chunk.withRange) == 0 {
+		partNumber = chunk.start/d.cfg.PartSize + 1
+	}
+	d.progress.partCompleted(partNumber, n, d.getTotalBytes())
+
+	if d.seq != nil {
+		return d.seq.Done(chunk.start)
+	}
+
+	return nil
+}
+
+// tryDownloadChunk makes an attempt to download the chunk from s3, returning
+// the number of bytes of the chunk written.
+func (d *downloader) tryDownloadChunk(chunk dlchunk) (int64, error) {
 	in := &s3.GetObjectInput{}
 	awsutil.Copy(in, d.in)
 
 	// Get the next byte range of data
 	in.Range = aws.String(chunk.ByteRange())
+	if d.ifMatch {
+		in.IfMatch = aws.String(d.etag)
+	}
 
 	var n int64
 	var err error
@@ -398,7 +562,15 @@ func (d *downloader) downloadChunk(chunk dlchunk) error {
 		var resp *s3.GetObjectOutput
 		resp, err = d.cfg.S3.GetObjectWithContext(d.ctx, in, d.cfg.RequestOptions...)
 		if err != nil {
-			return err
+			if rerr, ok := err.(awserr.RequestFailure); ok && d.ifMatch &&
+				rerr.StatusCode() == http.StatusPreconditionFailed {
+				return 0, &ErrObjectChanged{ETag: d.etag}
+			}
+			return 0, err
+		}
+		if err = d.checkETag(resp.ETag); err != nil {
+			resp.Body.Close()
+			return 0, err
 		}
 		d.setTotalBytes(resp) // Set total if not yet set.
 
@@ -414,9 +586,34 @@ func (d *downloader) downloadChunk(chunk dlchunk) error {
 				aws.StringValue(in.Key), err, retry))
 	}
 
-	d.incrWritten(n)
+	return n, err
+}
+
+// downloadStream downloads the object with a single GetObject request,
+// streaming its body into w.
+func (d *downloader) downloadStream(w io.Writer) (n int64, err error) {
+	in := &s3.GetObjectInput{}
+	awsutil.Copy(in, d.in)
 
-	return err
+	resp, err := d.cfg.S3.GetObjectWithContext(d.ctx, in, d.cfg.RequestOptions...)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	n, err = io.Copy(w, resp.Body)
+	if err != nil {
+		return n, err
+	}
+
+	totalSize := int64(-1)
+	if resp.ContentLength != nil && len(aws.StringValue(in.Range)) == 0 {
+		totalSize = *resp.ContentLength
+	}
+	d.progress.partCompleted(1, n, totalSize)
+	d.progress.transferCompleted()
+
+	return n, nil
 }
 
 func logMessage(svc s3iface.S3API, level aws.LogLevelType, msg string) {
@@ -504,6 +701,9 @@ func (d *downloader) setErr(e error) {
 	defer d.m.Unlock()
 
 	d.err = e
+	if e != nil && d.seq != nil {
+		d.seq.Abort()
+	}
 }
 
 // dlchunk represents a single chunk of data to write by the worker routine.
@@ -545,3 +745,115 @@ func (c *dlchunk) ByteRange() string {
 
 	return fmt.Sprintf("bytes=%d-%d", c.start, c.start+c.size-1)
 }
+
+// sequentialWriter is the io.WriterAt of the parts of a download, writing the
+// parts to a sequential io.Writer in order. The parts are buffered until all
+// parts before them are written. Acquire limits the number of parts buffered
+// at once.
+type sequentialWriter struct {
+	w        io.Writer
+	partSize int64
+
+	m        sync.Mutex
+	cond     *sync.Cond
+	parts    map[int64]*aws.WriteAtBuffer
+	done     map[int64]bool
+	pos      int64
+	written  int64
+	buffered int
+	max      int
+	aborted  bool
+}
+
+// newSequentialWriter returns a sequentialWriter of parts of the part size to
+// w, buffering up to max parts.
+func newSequentialWriter(w io.Writer, partSize int64, max int) *sequentialWriter {
+	s := &sequentialWriter{
+		w:        w,
+		partSize: partSize,
+		parts:    map[int64]*aws.WriteAtBuffer{},
+		done:     map[int64]bool{},
+		max:      max,
+	}
+	s.cond = sync.NewCond(&s.m)
+
+	return s
+}
+
+// Acquire blocks until a part can be buffered, and reserves its buffer.
+// Returns false if the download was aborted.
+func (s *sequentialWriter) Acquire() bool {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	for s.buffered >= s.max && !s.aborted {
+		s.cond.Wait()
+	}
+	if s.aborted {
+		return false
+	}
+	s.buffered++
+
+	return true
+}
+
+// WriteAt writes p into the buffer of the part containing the offset.
+func (s *sequentialWriter) WriteAt(p []byte, off int64) (n int, err error) {
+	start := off - off%s.partSize
+
+	s.m.Lock()
+	buf, ok := s.parts[start]
+	if !ok {
+		buf = aws.NewWriteAtBuffer(make([]byte, 0, s.partSize))
+		s.parts[start] = buf
+	}
+	s.m.Unlock()
+
+	return buf.WriteAt(p, off-start)
+}
+
+// Done marks the part starting at the offset as downloaded, and writes the
+// downloaded parts which follow the parts already written to the io.Writer,
+// releasing their buffers.
+func (s *sequentialWriter) Done(start int64) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.done[start] = true
+	for !s.aborted && s.done[s.pos] {
+		if buf, ok := s.parts[s.pos]; ok {
+			n, err := s.w.Write(buf.Bytes())
+			s.written += int64(n)
+			if err != nil {
+				return err
+			}
+		}
+
+		delete(s.parts, s.pos)
+		delete(s.done, s.pos)
+		s.pos += s.partSize
+		s.buffered--
+		s.cond.Broadcast()
+	}
+
+	return nil
+}
+
+// Abort releases the buffered parts, and unblocks Acquire, as the download
+// failed.
+func (s *sequentialWriter) Abort() {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.aborted = true
+	s.parts = map[int64]*aws.WriteAtBuffer{}
+	s.cond.Broadcast()
+}
+
+// Written returns the number of bytes written to the io.Writer.
+func (s *sequentialWriter) Written() int64 {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.written
+}
@@ -0,0 +1,424 @@
+package s3manager
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// DefaultPartMaxAttempts is the maximum number of attempts to download each
+// part of the DefaultPartRetryer, if its NumMaxAttempts is zero.
+const DefaultPartMaxAttempts = 3
+
+// Default delays of the DefaultPartRetryer.
+const (
+	DefaultPartMinRetryDelay = time.Second
+	DefaultPartMaxRetryDelay = 30 * time.Second
+)
+
+// A PartRetryer retries the parts of a download which failed after the
+// retries of the S3 client's retryer were exhausted, so that a part failing
+// does not fail the whole download. Each part has its own attempt budget.
+type PartRetryer interface {
+	// MaxAttempts returns the maximum number of attempts to download each
+	// part, including the first attempt.
+	MaxAttempts() int
+
+	// ShouldRetry returns if the part which failed with the error is
+	// retried.
+	ShouldRetry(err error) bool
+
+	// RetryDelay returns the delay before the part which failed the attempt
+	// is attempted again. The first attempt is 1.
+	RetryDelay(attempt int, err error) time.Duration
+}
+
+// DefaultPartRetryer retries the parts of a download which failed with an
+// exponential backoff, doubling the delay of each retry. Parts which failed
+// because the request was canceled, the object changed, or with a client
+// error other than a timeout or throttling, are not retried.
+type DefaultPartRetryer struct {
+	// The maximum number of attempts to download each part, including the
+	// first attempt. Defaults to DefaultPartMaxAttempts if zero.
+	NumMaxAttempts int
+
+	// The minimum and maximum delay before a part is retried. Default to
+	// DefaultPartMinRetryDelay, and DefaultPartMaxRetryDelay if zero.
+	MinRetryDelay time.Duration
+	MaxRetryDelay time.Duration
+}
+
+// MaxAttempts returns the maximum number of attempts to download each part.
+func (r DefaultPartRetryer) MaxAttempts() int {
+	if r.NumMaxAttempts == 0 {
+		return DefaultPartMaxAttempts
+	}
+	return r.NumMaxAttempts
+}
+
+// ShouldRetry returns if the part which failed with the error is retried.
+func (r DefaultPartRetryer) ShouldRetry(err error) bool {
+	if _, ok := err.(*ErrObjectChanged); ok {
+		return false
+	}
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == request.CanceledErrorCode {
+		return false
+	}
+	if rerr, ok := err.(awserr.RequestFailure); ok {
+		switch code := rerr.StatusCode(); {
+		case code == http.StatusRequestTimeout, code == http.StatusTooManyRequests:
+			return true
+		case code >= 400 && code < 500:
+			return false
+		}
+	}
+
+	return true
+}
+
+// RetryDelay returns the delay before the part which failed the attempt is
+// attempted again.
+func (r DefaultPartRetryer) RetryDelay(attempt int, err error) time.Duration {
+	min, max := r.MinRetryDelay, r.MaxRetryDelay
+	if min == 0 {
+		min = DefaultPartMinRetryDelay
+	}
+	if max == 0 {
+		max = DefaultPartMaxRetryDelay
+	}
+
+	delay := min
+	for i := 1; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	return delay
+}
+
+// ErrObjectChanged is the error returned when the object downloaded changed
+// during the download, or since the download resumed was started. The parts
+// already written are of the previous object, and the download must be
+// started again.
+type ErrObjectChanged struct {
+	// The ETag of the object the download was started with.
+	ETag string
+
+	// The ETag of the object's current version, empty if S3 only reported
+	// that the object no longer matches ETag.
+	CurrentETag string
+}
+
+// Code returns the error code of the error.
+func (e *ErrObjectChanged) Code() string {
+	return "ObjectChanged"
+}
+
+// Message returns the message of the error.
+func (e *ErrObjectChanged) Message() string {
+	if len(e.CurrentETag) == 0 {
+		return fmt.Sprintf("object changed during the download, no longer matches ETag %s", e.ETag)
+	}
+	return fmt.Sprintf("object changed during the download, ETag %s is now %s", e.ETag, e.CurrentETag)
+}
+
+// OrigErr returns nil, the error does not wrap an error.
+func (e *ErrObjectChanged) OrigErr() error {
+	return nil
+}
+
+// Error returns the string representation of the error.
+func (e *ErrObjectChanged) Error() string {
+	return awserr.SprintError(e.Code(), e.Message(), "", nil)
+}
+
+// A ResumableDownloadFailure wraps a failed download of an object with
+// Download, which can be resumed with the download's resume token. An error
+// returned by Download satisfies this interface if the ETag and size of the
+// object were known before the download failed, or was canceled with its
+// context. Downloads with DownloadTo, or of the GetObjectInput's Range, cannot
+// be resumed.
+//
+// Example:
+//
+//     n, err := downloader.Download(file, input)
+//     if rerr, ok := err.(s3manager.ResumableDownloadFailure); ok {
+//         // Resume the download, writing to the same file.
+//         n, err = downloader.DownloadWithResume(file, input, rerr.ResumeToken())
+//     }
+type ResumableDownloadFailure interface {
+	awserr.Error
+
+	// Returns the token to resume the download which failed.
+	ResumeToken() *DownloadResumeToken
+}
+
+// DownloadResumeToken is the state of a download needed to resume the
+// download with DownloadWithResume, downloading only the byte ranges of the
+// object which were not written. The token can be serialized to JSON to
+// resume the download in another process.
+type DownloadResumeToken struct {
+	// The bucket and key of the object downloaded.
+	Bucket string `json:"bucket"`
+	Key    string `json:"key"`
+
+	// The ETag of the object downloaded. The resumed download fails with
+	// ErrObjectChanged if the object no longer matches the ETag.
+	ETag string `json:"etag"`
+
+	// The size of the object in bytes.
+	Size int64 `json:"size"`
+
+	// The byte ranges of the object which were written.
+	Ranges []DownloadResumeRange `json:"ranges"`
+}
+
+// DownloadResumeRange is a byte range of an object written by a download,
+// from the Start offset, up to but not including the End offset.
+type DownloadResumeRange struct {
+	Start int64 `json:"start"`
+	End   int64 `json:"end"`
+}
+
+// downloadResumeRanges sorts the byte ranges by their start offset.
+type downloadResumeRanges []DownloadResumeRange
+
+func (a downloadResumeRanges) Len() int           { return len(a) }
+func (a downloadResumeRanges) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a downloadResumeRanges) Less(i, j int) bool { return a[i].Start < a[j].Start }
+
+// mergeResumeRanges returns the byte ranges sorted, with the overlapping and
+// adjacent ranges merged.
+func mergeResumeRanges(ranges []DownloadResumeRange) []DownloadResumeRange {
+	sorted := make(downloadResumeRanges, 0, len(ranges))
+	for _, r := range ranges {
+		if r.End > r.Start {
+			sorted = append(sorted, r)
+		}
+	}
+	sort.Sort(sorted)
+
+	var merged []DownloadResumeRange
+	for _, r := range sorted {
+		if last := len(merged) - 1; last >= 0 && r.Start <= merged[last].End {
+			if r.End > merged[last].End {
+				merged[last].End = r.End
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	return merged
+}
+
+// resumeRangesCover returns if the merged byte ranges include all bytes
+// from the start offset up to the end offset.
+func resumeRangesCover(ranges []DownloadResumeRange, start, end int64) bool {
+	for _, r := range ranges {
+		if r.Start <= start && end <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
+// DownloadWithResume resumes the download of an object from the resume token
+// of the failed download, downloading only the byte ranges of the object
+// which were not written. The io.WriterAt must be the writer of the failed
+// download, such as the same os.File, as the ranges written are skipped.
+//
+// The parts of the object are downloaded with an If-Match condition of the
+// token's ETag, and the download fails with ErrObjectChanged if the object
+// no longer matches the ETag. The parts of the object are the Downloader's
+// PartSize, parts only partially written are downloaded again.
+//
+// Returns the number of bytes of the object written, including the byte
+// ranges written before the download was resumed. If the resumed download
+// fails it can be resumed again with the ResumeToken of the
+// ResumableDownloadFailure error.
+//
+// It is safe to call this method concurrently across goroutines.
+func (d Downloader) DownloadWithResume(w io.WriterAt, input *s3.GetObjectInput, token *DownloadResumeToken, options ...func(*Downloader)) (n int64, err error) {
+	return d.DownloadWithResumeWithContext(aws.BackgroundContext(), w, input, token, options...)
+}
+
+// DownloadWithResumeWithContext resumes the download of an object from the
+// resume token of the failed download.
+//
+// DownloadWithResumeWithContext is the same as DownloadWithResume with the
+// additional support for Context input parameters. The Context must not be
+// nil. A nil Context will cause a panic. Use the Context to add deadlining,
+// timeouts, ect. Canceling the context pauses the download, returning a
+// ResumableDownloadFailure error with the token to resume it again.
+//
+// It is safe to call this method concurrently across goroutines.
+func (d Downloader) DownloadWithResumeWithContext(ctx aws.Context, w io.WriterAt, input *s3.GetObjectInput, token *DownloadResumeToken, options ...func(*Downloader)) (n int64, err error) {
+	impl := d.newDownloader(ctx, w, input, options...)
+	if err := impl.resume(token); err != nil {
+		return 0, err
+	}
+
+	return impl.download()
+}
+
+// resume configures the download to skip the byte ranges of the resume token
+// which were written, and to download the other ranges of the token's ETag.
+func (d *downloader) resume(token *DownloadResumeToken) error {
+	if token == nil || len(token.ETag) == 0 {
+		return awserr.New("InvalidResumeToken", "resume token has no ETag", nil)
+	}
+	if token.Bucket != aws.StringValue(d.in.Bucket) || token.Key != aws.StringValue(d.in.Key) {
+		msg := fmt.Sprintf("resume token is for %s/%s, not %s/%s", token.Bucket, token.Key,
+			aws.StringValue(d.in.Bucket), aws.StringValue(d.in.Key))
+		return awserr.New("InvalidResumeToken", msg, nil)
+	}
+	if token.Size < 0 {
+		return awserr.New("InvalidResumeToken", "resume token has no object size", nil)
+	}
+	if len(aws.StringValue(d.in.Range)) != 0 {
+		return awserr.New("ConfigError", "resuming a download of a range is not supported", nil)
+	}
+
+	d.resuming = true
+	d.resumed = mergeResumeRanges(token.Ranges)
+	d.totalBytes = token.Size
+	d.etag = token.ETag
+	d.ifMatch = d.in.IfMatch == nil
+
+	return nil
+}
+
+// skipResumed skips the part at the download's position if it was written
+// before the download was resumed. Only used by the goroutine queuing the
+// parts.
+func (d *downloader) skipResumed(total int64) bool {
+	start, end := d.pos, d.pos+d.cfg.PartSize
+	if end > total {
+		end = total
+	}
+	if !resumeRangesCover(d.resumed, start, end) {
+		return false
+	}
+
+	d.m.Lock()
+	d.completed = append(d.completed, DownloadResumeRange{Start: start, End: end})
+	d.written += end - start
+	d.m.Unlock()
+
+	d.progress.skipped(end - start)
+	d.pos += d.cfg.PartSize
+
+	return true
+}
+
+// checkETag returns ErrObjectChanged if the ETag of the part downloaded is
+// not the ETag of the object's previous parts.
+func (d *downloader) checkETag(etag *string) error {
+	if etag == nil {
+		return nil
+	}
+
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	if len(d.etag) == 0 {
+		d.etag = *etag
+	} else if d.etag != *etag {
+		return &ErrObjectChanged{ETag: d.etag, CurrentETag: *etag}
+	}
+	return nil
+}
+
+// completeChunk records the byte range of the chunk as written.
+func (d *downloader) completeChunk(chunk dlchunk, n int64) {
+	if len(chunk.withRange) != 0 || d.seq != nil || n == 0 {
+		return
+	}
+
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	d.completed = append(d.completed, DownloadResumeRange{Start: chunk.start, End: chunk.start + n})
+}
+
+// resumeToken returns the token to resume the download with the byte ranges
+// written, or nil if the download cannot be resumed.
+func (d *downloader) resumeToken() *DownloadResumeToken {
+	if d.seq != nil || len(aws.StringValue(d.in.Range)) != 0 {
+		return nil
+	}
+
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	if len(d.etag) == 0 || d.totalBytes < 0 {
+		return nil
+	}
+
+	return &DownloadResumeToken{
+		Bucket: aws.StringValue(d.in.Bucket),
+		Key:    aws.StringValue(d.in.Key),
+		ETag:   d.etag,
+		Size:   d.totalBytes,
+		Ranges: mergeResumeRanges(d.completed),
+	}
+}
+
+// resumableError returns the error of the download wrapped with the token to
+// resume the download, if the download can be resumed.
+func (d *downloader) resumableError(err error) error {
+	if _, ok := err.(*ErrObjectChanged); ok {
+		return err
+	}
+	token := d.resumeToken()
+	if token == nil {
+		return err
+	}
+
+	switch e := err.(type) {
+	case awserr.RequestFailure:
+		return resumableDownloadRequestFailure{RequestFailure: e, token: token}
+	case awserr.Error:
+		return resumableDownloadError{awsError: e, token: token}
+	default:
+		return resumableDownloadError{
+			awsError: awserr.New("DownloadFailed", "failed to download object", err),
+			token:    token,
+		}
+	}
+}
+
+// resumableDownloadError wraps the error of a download which can be resumed
+// with the token.
+type resumableDownloadError struct {
+	awsError
+
+	token *DownloadResumeToken
+}
+
+// ResumeToken returns the token to resume the download which failed.
+func (e resumableDownloadError) ResumeToken() *DownloadResumeToken {
+	return e.token
+}
+
+// resumableDownloadRequestFailure wraps the request failure of a download
+// which can be resumed with the token.
+type resumableDownloadRequestFailure struct {
+	awserr.RequestFailure
+
+	token *DownloadResumeToken
+}
+
+// ResumeToken returns the token to resume the download which failed.
+func (e resumableDownloadRequestFailure) ResumeToken() *DownloadResumeToken {
+	return e.token
+}
@@ -0,0 +1,311 @@
+package s3manager_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+var rangeValueRegex = regexp.MustCompile(`bytes=(\d+)-(\d+)`)
+
+// resumeDownloadS3 is a fake S3 serving the byte ranges of an object with
+// the ETag, which fails the GetObject calls of the ranges starting at the
+// offsets in failRanges, until their count of failures is exhausted.
+type resumeDownloadS3 struct {
+	m          sync.Mutex
+	data       []byte
+	etag       string
+	ranges     []string
+	ifMatch    []string
+	failRanges map[int64]int
+}
+
+func (f *resumeDownloadS3) client() *s3.S3 {
+	svc := s3.New(unit.Session, &aws.Config{MaxRetries: aws.Int(0)})
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		f.m.Lock()
+		defer f.m.Unlock()
+
+		in := r.Params.(*s3.GetObjectInput)
+		f.ranges = append(f.ranges, aws.StringValue(in.Range))
+		f.ifMatch = append(f.ifMatch, aws.StringValue(in.IfMatch))
+
+		match := rangeValueRegex.FindStringSubmatch(aws.StringValue(in.Range))
+		start, _ := strconv.ParseInt(match[1], 10, 64)
+		fin, _ := strconv.ParseInt(match[2], 10, 64)
+		fin++
+		if fin > int64(len(f.data)) {
+			fin = int64(len(f.data))
+		}
+
+		if in.IfMatch != nil && *in.IfMatch != f.etag {
+			r.Error = awserr.NewRequestFailure(
+				awserr.New("PreconditionFailed", "precondition failed", nil), http.StatusPreconditionFailed, "")
+			r.Retryable = aws.Bool(false)
+			return
+		}
+		if f.failRanges[start] > 0 {
+			f.failRanges[start]--
+			r.Error = awserr.NewRequestFailure(
+				awserr.New("InternalError", "internal error", nil), http.StatusInternalServerError, "")
+			r.Retryable = aws.Bool(false)
+			return
+		}
+
+		r.HTTPResponse = &http.Response{
+			StatusCode: http.StatusPartialContent,
+			Body:       ioutil.NopCloser(bytes.NewReader(f.data[start:fin])),
+			Header:     http.Header{},
+		}
+		r.HTTPResponse.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, fin-1, len(f.data)))
+		r.HTTPResponse.Header.Set("Content-Length", strconv.Itoa(int(fin-start)))
+		r.HTTPResponse.Header.Set("ETag", f.etag)
+	})
+
+	return svc
+}
+
+func resumeDownloadData() []byte {
+	data := make([]byte, 10*1024+100)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	return data
+}
+
+func resumeDownloadInput() *s3.GetObjectInput {
+	return &s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")}
+}
+
+func TestDownloadWithResume(t *testing.T) {
+	data := resumeDownloadData()
+	// Part 7 fails every attempt of the part retryer.
+	f := &resumeDownloadS3{data: data, etag: `"etag"`, failRanges: map[int64]int{6 * 1024: 3}}
+	d := s3manager.NewDownloaderWithClient(f.client(), func(d *s3manager.Downloader) {
+		d.PartSize = 1024
+		d.Concurrency = 3
+		d.PartRetryer = s3manager.DefaultPartRetryer{
+			NumMaxAttempts: 3,
+			MinRetryDelay:  time.Millisecond,
+			MaxRetryDelay:  time.Millisecond,
+		}
+	})
+
+	file, err := ioutil.TempFile("", "download-resume")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	n, err := d.Download(file, resumeDownloadInput())
+	rerr, ok := err.(s3manager.ResumableDownloadFailure)
+	if !ok {
+		t.Fatalf("expect ResumableDownloadFailure, got %T, %v", err, err)
+	}
+	if e, a := "InternalError", rerr.Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if e, a := int64(len(data)-1024), n; e != a {
+		t.Errorf("expect %v bytes written, got %v", e, a)
+	}
+	if e, a := 3, countRanges(f.ranges, "bytes=6144-7167"); e != a {
+		t.Errorf("expect part 7 attempted %v times, got %v", e, a)
+	}
+
+	b, err := json.Marshal(rerr.ResumeToken())
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	var token s3manager.DownloadResumeToken
+	if err := json.Unmarshal(b, &token); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	expectRanges := []s3manager.DownloadResumeRange{{Start: 0, End: 6 * 1024}, {Start: 7 * 1024, End: int64(len(data))}}
+	if e, a := fmt.Sprint(expectRanges), fmt.Sprint(token.Ranges); e != a {
+		t.Errorf("expect %v ranges, got %v", e, a)
+	}
+	if e, a := `"etag"`, token.ETag; e != a {
+		t.Errorf("expect %v etag, got %v", e, a)
+	}
+
+	f.ranges, f.ifMatch = nil, nil
+	n, err = d.DownloadWithResume(file, resumeDownloadInput(), &token)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := int64(len(data)), n; e != a {
+		t.Errorf("expect %v bytes written, got %v", e, a)
+	}
+	if e, a := []string{"bytes=6144-7167"}, f.ranges; fmt.Sprint(e) != fmt.Sprint(a) {
+		t.Errorf("expect %v ranges downloaded, got %v", e, a)
+	}
+	if e, a := []string{`"etag"`}, f.ifMatch; fmt.Sprint(e) != fmt.Sprint(a) {
+		t.Errorf("expect %v If-Match, got %v", e, a)
+	}
+
+	written, err := ioutil.ReadFile(file.Name())
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if !bytes.Equal(data, written) {
+		t.Errorf("expect file to be the object's data")
+	}
+}
+
+func TestDownloadWithResume_ObjectChanged(t *testing.T) {
+	data := resumeDownloadData()
+	f := &resumeDownloadS3{data: data, etag: `"changed"`}
+	d := s3manager.NewDownloaderWithClient(f.client(), func(d *s3manager.Downloader) {
+		d.PartSize = 1024
+		d.PartRetryer = s3manager.DefaultPartRetryer{MinRetryDelay: time.Millisecond}
+	})
+
+	token := &s3manager.DownloadResumeToken{
+		Bucket: "bucket",
+		Key:    "key",
+		ETag:   `"etag"`,
+		Size:   int64(len(data)),
+		Ranges: []s3manager.DownloadResumeRange{{Start: 0, End: 4096}},
+	}
+	_, err := d.DownloadWithResume(aws.NewWriteAtBuffer(make([]byte, len(data))), resumeDownloadInput(), token)
+	cerr, ok := err.(*s3manager.ErrObjectChanged)
+	if !ok {
+		t.Fatalf("expect ErrObjectChanged, got %T, %v", err, err)
+	}
+	if e, a := `"etag"`, cerr.ETag; e != a {
+		t.Errorf("expect %v etag, got %v", e, a)
+	}
+	for _, rng := range f.ranges {
+		if e, a := 1, countRanges(f.ranges, rng); e != a {
+			t.Errorf("expect range %v attempted %v times, got %v", rng, e, a)
+		}
+	}
+}
+
+func TestDownload_ObjectChanged(t *testing.T) {
+	data := resumeDownloadData()
+	f := &resumeDownloadS3{data: data, etag: `"etag"`}
+	svc := f.client()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		// The object is replaced after its first part is downloaded.
+		f.m.Lock()
+		f.etag = `"changed"`
+		f.m.Unlock()
+	})
+	d := s3manager.NewDownloaderWithClient(svc, func(d *s3manager.Downloader) {
+		d.PartSize = 1024
+		d.Concurrency = 1
+	})
+
+	_, err := d.Download(aws.NewWriteAtBuffer(nil), resumeDownloadInput())
+	cerr, ok := err.(*s3manager.ErrObjectChanged)
+	if !ok {
+		t.Fatalf("expect ErrObjectChanged, got %T, %v", err, err)
+	}
+	if e, a := `"etag"`, cerr.ETag; e != a {
+		t.Errorf("expect %v etag, got %v", e, a)
+	}
+	if e, a := `"changed"`, cerr.CurrentETag; e != a {
+		t.Errorf("expect %v current etag, got %v", e, a)
+	}
+}
+
+func TestDownload_PartRetryerRecovers(t *testing.T) {
+	data := resumeDownloadData()
+	f := &resumeDownloadS3{data: data, etag: `"etag"`, failRanges: map[int64]int{2048: 2, 6144: 1}}
+	d := s3manager.NewDownloaderWithClient(f.client(), func(d *s3manager.Downloader) {
+		d.PartSize = 1024
+		d.PartRetryer = s3manager.DefaultPartRetryer{
+			MinRetryDelay: time.Millisecond,
+			MaxRetryDelay: time.Millisecond,
+		}
+	})
+
+	w := aws.NewWriteAtBuffer(make([]byte, len(data)))
+	n, err := d.Download(w, resumeDownloadInput())
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := int64(len(data)), n; e != a {
+		t.Errorf("expect %v bytes written, got %v", e, a)
+	}
+	if !bytes.Equal(data, w.Bytes()) {
+		t.Errorf("expect downloaded data to be the object's data")
+	}
+	if e, a := 3, countRanges(f.ranges, "bytes=2048-3071"); e != a {
+		t.Errorf("expect part 3 attempted %v times, got %v", e, a)
+	}
+}
+
+func TestDefaultPartRetryer(t *testing.T) {
+	r := s3manager.DefaultPartRetryer{MinRetryDelay: time.Second, MaxRetryDelay: 5 * time.Second}
+
+	cases := map[string]struct {
+		Err         error
+		ShouldRetry bool
+	}{
+		"server error": {
+			Err:         awserr.NewRequestFailure(awserr.New("InternalError", "", nil), 500, ""),
+			ShouldRetry: true,
+		},
+		"throttled": {
+			Err:         awserr.NewRequestFailure(awserr.New("SlowDown", "", nil), 429, ""),
+			ShouldRetry: true,
+		},
+		"client error": {
+			Err: awserr.NewRequestFailure(awserr.New("AccessDenied", "", nil), 403, ""),
+		},
+		"canceled": {
+			Err: awserr.New(request.CanceledErrorCode, "", nil),
+		},
+		"object changed": {
+			Err: &s3manager.ErrObjectChanged{ETag: "etag"},
+		},
+		"connection error": {
+			Err:         fmt.Errorf("connection reset"),
+			ShouldRetry: true,
+		},
+	}
+
+	for name, c := range cases {
+		if e, a := c.ShouldRetry, r.ShouldRetry(c.Err); e != a {
+			t.Errorf("%s, expect %v should retry, got %v", name, e, a)
+		}
+	}
+
+	for attempt, e := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		if a := r.RetryDelay(attempt+1, nil); e != a {
+			t.Errorf("attempt %d, expect %v delay, got %v", attempt+1, e, a)
+		}
+	}
+	if e, a := s3manager.DefaultPartMaxAttempts, r.MaxAttempts(); e != a {
+		t.Errorf("expect %v max attempts, got %v", e, a)
+	}
+}
+
+func countRanges(ranges []string, rng string) int {
+	var n int
+	for _, r := range ranges {
+		if r == rng {
+			n++
+		}
+	}
+	return n
+}
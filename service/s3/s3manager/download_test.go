@@ -2,6 +2,7 @@ package s3manager_test
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -656,3 +657,177 @@ func (r *testErrReader) Read(p []byte) (int, error) {
 
 	return n, nil
 }
+
+func TestDownloadTo(t *testing.T) {
+	data := make([]byte, 1024*1024*25)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	expectSum := sha256.Sum256(data)
+
+	cases := map[string]struct {
+		Concurrency int
+		Svc         func([]byte) (*s3.S3, *[]string)
+		ExpectCalls int
+	}{
+		"concurrent": {
+			Concurrency: 5,
+			Svc: func(data []byte) (*s3.S3, *[]string) {
+				s, names, _ := dlLoggingSvc(data)
+				return s, names
+			},
+			ExpectCalls: 5,
+		},
+		"buffer bound": {
+			Concurrency: 2,
+			Svc: func(data []byte) (*s3.S3, *[]string) {
+				s, names, _ := dlLoggingSvc(data)
+				return s, names
+			},
+			ExpectCalls: 5,
+		},
+		"single stream": {
+			Concurrency: 1,
+			Svc:         dlLoggingSvcNoChunk,
+			ExpectCalls: 1,
+		},
+	}
+
+	for name, c := range cases {
+		s, names := c.Svc(data)
+		// Delay the first part so later parts complete out of order.
+		s.Handlers.Send.PushFront(func(r *request.Request) {
+			if strings.HasPrefix(aws.StringValue(r.Params.(*s3.GetObjectInput).Range), "bytes=0-") {
+				time.Sleep(50 * time.Millisecond)
+			}
+		})
+
+		d := s3manager.NewDownloaderWithClient(s, func(d *s3manager.Downloader) {
+			d.Concurrency = c.Concurrency
+		})
+
+		h := sha256.New()
+		n, err := d.DownloadTo(h, &s3.GetObjectInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+		})
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := int64(len(data)), n; e != a {
+			t.Errorf("%s, expect %d bytes written, got %d", name, e, a)
+		}
+		if e, a := expectSum[:], h.Sum(nil); !bytes.Equal(e, a) {
+			t.Errorf("%s, expect %x digest, got %x", name, e, a)
+		}
+		if e, a := c.ExpectCalls, len(*names); e != a {
+			t.Errorf("%s, expect %d API calls, got %d", name, e, a)
+		}
+	}
+}
+
+func TestDownloadTo_WithRange(t *testing.T) {
+	s, names, ranges := dlLoggingSvc([]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	d := s3manager.NewDownloaderWithClient(s, func(d *s3manager.Downloader) {
+		d.Concurrency = 10
+		d.PartSize = 1
+	})
+
+	var w bytes.Buffer
+	n, err := d.DownloadTo(&w, &s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Range:  aws.String("bytes=2-6"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := int64(5), n; e != a {
+		t.Errorf("expect %d bytes written, got %d", e, a)
+	}
+	expectCalls := []string{"GetObject"}
+	if e, a := expectCalls, *names; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v API calls, got %v", e, a)
+	}
+	expectRngs := []string{"bytes=2-6"}
+	if e, a := expectRngs, *ranges; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v ranges, got %v", e, a)
+	}
+	if e, a := []byte{2, 3, 4, 5, 6}, w.Bytes(); !bytes.Equal(e, a) {
+		t.Errorf("expect %v bytes, got %v", e, a)
+	}
+}
+
+func TestDownloadTo_PartFailure(t *testing.T) {
+	data := make([]byte, 1024*1024*25)
+	failRange := fmt.Sprintf("bytes=%d-", s3manager.DefaultDownloadPartSize*2)
+
+	s, _, _ := dlLoggingSvc(data)
+	s.Handlers.Send.PushBack(func(r *request.Request) {
+		if strings.HasPrefix(aws.StringValue(r.Params.(*s3.GetObjectInput).Range), failRange) {
+			r.HTTPResponse.Body = ioutil.NopCloser(&bytes.Buffer{})
+			r.Error = awserr.New("PartError", "middle part failed", nil)
+			r.Retryable = aws.Bool(false)
+		}
+	})
+
+	d := s3manager.NewDownloaderWithClient(s, func(d *s3manager.Downloader) {
+		d.Concurrency = 5
+	})
+
+	var w bytes.Buffer
+	n, err := d.DownloadTo(&w, &s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T, %v", err, err)
+	}
+	if e, a := "PartError", aerr.Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if n > int64(s3manager.DefaultDownloadPartSize*2) {
+		t.Errorf("expect no bytes after the failed part written, got %d", n)
+	}
+	if e, a := n, int64(w.Len()); e != a {
+		t.Errorf("expect %d bytes in writer, got %d", e, a)
+	}
+}
+
+func TestDownloadSSECustomerKey(t *testing.T) {
+	s, names, _ := dlLoggingSvc(buf12MB)
+
+	var m sync.Mutex
+	var keys, sums []string
+	s.Handlers.Send.PushFront(func(r *request.Request) {
+		m.Lock()
+		defer m.Unlock()
+		keys = append(keys, r.HTTPRequest.Header.Get("X-Amz-Server-Side-Encryption-Customer-Key"))
+		sums = append(sums, r.HTTPRequest.Header.Get("X-Amz-Server-Side-Encryption-Customer-Key-Md5"))
+	})
+
+	d := s3manager.NewDownloaderWithClient(s)
+	_, err := d.Download(&aws.WriteAtBuffer{}, &s3.GetObjectInput{
+		Bucket:               aws.String("bucket"),
+		Key:                  aws.String("key"),
+		SSECustomerAlgorithm: aws.String("AES256"),
+		SSECustomerKey:       aws.String("01234567890123456789012345678901"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := 3, len(*names); e != a {
+		t.Fatalf("expect %d API calls, got %d", e, a)
+	}
+	for i := range keys {
+		if e, a := "MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTIzNDU2Nzg5MDE=", keys[i]; e != a {
+			t.Errorf("%d, expect %v key, got %v", i, e, a)
+		}
+		if e, a := "KYvwGXoFFJ42a2u2GDWhwQ==", sums[i]; e != a {
+			t.Errorf("%d, expect %v key MD5, got %v", i, e, a)
+		}
+	}
+}
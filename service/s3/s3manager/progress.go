@@ -0,0 +1,71 @@
+package s3manager
+
+import "sync"
+
+// A ProgressListener receives the progress of an upload or download. The
+// progress of a part is only reported after the part's request succeeds, so
+// that the bytes of failed and retried requests are not counted, and the
+// total number of bytes transferred never decreases.
+//
+// The listener's methods are not called concurrently for the same transfer,
+// but are called concurrently for transfers made in parallel with the same
+// Uploader or Downloader.
+type ProgressListener interface {
+	// PartCompleted is called after the request of a part of the transfer
+	// succeeds, with the part's number and size in bytes, and the total
+	// number of bytes transferred. The totalSize is the size of the object,
+	// or -1 if the size is not known, such as when uploading a body which
+	// is not an io.Seeker.
+	PartCompleted(partNumber, bytes, totalTransferred, totalSize int64)
+
+	// TransferCompleted is called after the transfer succeeds, with the
+	// total number of bytes transferred.
+	TransferCompleted(totalTransferred int64)
+}
+
+// progress reports the progress of a transfer to the listener, serializing
+// the listener's calls.
+type progress struct {
+	m           sync.Mutex
+	listener    ProgressListener
+	transferred int64
+}
+
+// partCompleted adds the bytes of the part to the bytes transferred, and
+// reports the part's completion.
+func (p *progress) partCompleted(partNumber, bytes, totalSize int64) {
+	if p == nil || p.listener == nil {
+		return
+	}
+
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	p.transferred += bytes
+	p.listener.PartCompleted(partNumber, bytes, p.transferred, totalSize)
+}
+
+// skipped adds the bytes of a part transferred before the transfer was
+// resumed to the bytes transferred, without reporting the part.
+func (p *progress) skipped(bytes int64) {
+	if p == nil || p.listener == nil {
+		return
+	}
+
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	p.transferred += bytes
+}
+
+// transferCompleted reports the completion of the transfer.
+func (p *progress) transferCompleted() {
+	if p == nil || p.listener == nil {
+		return
+	}
+
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	p.listener.TransferCompleted(p.transferred)
+}
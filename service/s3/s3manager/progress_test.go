@@ -0,0 +1,252 @@
+package s3manager_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+type progressEvent struct {
+	PartNumber, Bytes, TotalTransferred, TotalSize int64
+}
+
+// recordingListener records the progress reported, and fails the test if
+// its methods are called concurrently.
+type recordingListener struct {
+	t        *testing.T
+	calling  int32
+	parts    []progressEvent
+	complete []int64
+}
+
+func (l *recordingListener) enter() {
+	if !atomic.CompareAndSwapInt32(&l.calling, 0, 1) {
+		l.t.Errorf("expect listener not to be called concurrently")
+	}
+	// Widen the window concurrent calls would overlap in.
+	time.Sleep(time.Millisecond)
+}
+
+func (l *recordingListener) exit() {
+	atomic.StoreInt32(&l.calling, 0)
+}
+
+func (l *recordingListener) PartCompleted(partNumber, bytes, totalTransferred, totalSize int64) {
+	l.enter()
+	defer l.exit()
+	l.parts = append(l.parts, progressEvent{partNumber, bytes, totalTransferred, totalSize})
+}
+
+func (l *recordingListener) TransferCompleted(totalTransferred int64) {
+	l.enter()
+	defer l.exit()
+	l.complete = append(l.complete, totalTransferred)
+}
+
+func (l *recordingListener) assert(name string, expectParts int, size, totalSize int64) {
+	t := l.t
+
+	if e, a := expectParts, len(l.parts); e != a {
+		t.Errorf("%s, expect %d parts reported, got %d", name, e, a)
+	}
+	seen := map[int64]bool{}
+	var last, sum int64
+	for _, p := range l.parts {
+		if seen[p.PartNumber] {
+			t.Errorf("%s, expect part %d reported once", name, p.PartNumber)
+		}
+		seen[p.PartNumber] = true
+
+		if p.TotalTransferred < last {
+			t.Errorf("%s, expect non-decreasing total, got %d after %d", name, p.TotalTransferred, last)
+		}
+		last = p.TotalTransferred
+		sum += p.Bytes
+
+		if e, a := sum, p.TotalTransferred; e != a {
+			t.Errorf("%s, expect %d total transferred, got %d", name, e, a)
+		}
+		if e, a := totalSize, p.TotalSize; e != a {
+			t.Errorf("%s, expect %d total size, got %d", name, e, a)
+		}
+	}
+
+	if e, a := []int64{size}, l.complete; len(a) != 1 || e[0] != a[0] {
+		t.Errorf("%s, expect transfer completed with %v, got %v", name, e, a)
+	}
+}
+
+// retrySvc returns a client failing the first attempt of each request with a
+// retryable error.
+func retrySvc() *s3.S3 {
+	var m sync.Mutex
+	attempts := map[string]int{}
+
+	svc := s3.New(unit.Session, &aws.Config{MaxRetries: aws.Int(2)})
+	svc.Handlers.Unmarshal.Clear()
+	svc.Handlers.UnmarshalMeta.Clear()
+	svc.Handlers.UnmarshalError.Clear()
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		m.Lock()
+		defer m.Unlock()
+
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte{})),
+		}
+
+		id := r.Operation.Name
+		if in, ok := r.Params.(*s3.UploadPartInput); ok {
+			id = fmt.Sprintf("%s-%d", id, *in.PartNumber)
+		}
+		attempts[id]++
+		if attempts[id] == 1 {
+			r.Error = awserr.New("RequestError", "connection reset", nil)
+			r.Retryable = aws.Bool(true)
+			return
+		}
+
+		switch data := r.Data.(type) {
+		case *s3.CreateMultipartUploadOutput:
+			data.UploadId = aws.String("UPLOAD-ID")
+		case *s3.UploadPartOutput:
+			data.ETag = aws.String(id)
+		case *s3.CompleteMultipartUploadOutput:
+			data.Location = aws.String("https://location")
+		}
+	})
+
+	return svc
+}
+
+func TestUploadProgress(t *testing.T) {
+	size := int64(1024*1024*12 + 5)
+
+	cases := map[string]struct {
+		Body        func() io.Reader
+		ExpectParts int
+		TotalSize   int64
+	}{
+		"seekable multipart": {
+			Body:        func() io.Reader { return bytes.NewReader(make([]byte, size)) },
+			ExpectParts: 3,
+			TotalSize:   size,
+		},
+		"non-seekable multipart": {
+			Body:        func() io.Reader { return bytes.NewBuffer(make([]byte, size)) },
+			ExpectParts: 3,
+			TotalSize:   -1,
+		},
+	}
+
+	for name, c := range cases {
+		l := &recordingListener{t: t}
+		mgr := s3manager.NewUploaderWithClient(retrySvc(), func(u *s3manager.Uploader) {
+			u.ProgressListener = l
+		})
+
+		_, err := mgr.Upload(&s3manager.UploadInput{
+			Bucket: aws.String("Bucket"),
+			Key:    aws.String("Key"),
+			Body:   c.Body(),
+		})
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		l.assert(name, c.ExpectParts, size, c.TotalSize)
+	}
+}
+
+func TestUploadProgress_SinglePart(t *testing.T) {
+	l := &recordingListener{t: t}
+	mgr := s3manager.NewUploaderWithClient(retrySvc(), func(u *s3manager.Uploader) {
+		u.ProgressListener = l
+	})
+
+	_, err := mgr.Upload(&s3manager.UploadInput{
+		Bucket: aws.String("Bucket"),
+		Key:    aws.String("Key"),
+		Body:   bytes.NewReader([]byte("hello world")),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	l.assert("single part", 1, 11, 11)
+}
+
+func TestDownloadProgress(t *testing.T) {
+	data := make([]byte, 1024*1024*12+5)
+
+	s, _, _ := dlLoggingSvc(data)
+	attempts := map[string]int{}
+	var m sync.Mutex
+	// Fail the first attempt of each part with a retryable error.
+	s.Handlers.Send.PushBack(func(r *request.Request) {
+		m.Lock()
+		defer m.Unlock()
+
+		rng := aws.StringValue(r.Params.(*s3.GetObjectInput).Range)
+		attempts[rng]++
+		if attempts[rng] == 1 {
+			r.Error = awserr.New("RequestError", "connection reset", nil)
+			r.Retryable = aws.Bool(true)
+		}
+	})
+
+	l := &recordingListener{t: t}
+	d := s3manager.NewDownloaderWithClient(s, func(d *s3manager.Downloader) {
+		d.ProgressListener = l
+	})
+
+	n, err := d.Download(&aws.WriteAtBuffer{}, &s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := int64(len(data)), n; e != a {
+		t.Errorf("expect %d bytes downloaded, got %d", e, a)
+	}
+
+	l.assert("download", 3, int64(len(data)), int64(len(data)))
+}
+
+func TestDownloadProgress_PartBodyRetry(t *testing.T) {
+	s, _ := dlLoggingSvcWithErrReader([]testErrReader{
+		{Buf: []byte("ab"), Len: 3, Err: io.ErrUnexpectedEOF},
+		{Buf: []byte("123"), Len: 3, Err: io.EOF},
+	})
+
+	l := &recordingListener{t: t}
+	d := s3manager.NewDownloaderWithClient(s, func(d *s3manager.Downloader) {
+		d.Concurrency = 1
+		d.ProgressListener = l
+	})
+
+	_, err := d.Download(&aws.WriteAtBuffer{}, &s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	l.assert("part body retry", 1, 3, 3)
+}
@@ -2,6 +2,7 @@ package s3manager
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"sort"
@@ -73,6 +74,9 @@ type multiUploadError struct {
 
 	// ID for multipart upload which failed.
 	uploadID string
+
+	// The token to resume the upload, nil if the upload cannot be resumed.
+	token *UploadResumeToken
 }
 
 // Error returns the string representation of the error.
@@ -96,6 +100,12 @@ func (m multiUploadError) UploadID() string {
 	return m.uploadID
 }
 
+// ResumeToken returns the token to resume the S3 upload which failed, nil if
+// the upload's parts were not left on S3.
+func (m multiUploadError) ResumeToken() *UploadResumeToken {
+	return m.token
+}
+
 // UploadInput contains all input for upload requests to Amazon S3.
 type UploadInput struct {
 	// The canned ACL to apply to the object.
@@ -106,6 +116,26 @@ type UploadInput struct {
 	// Specifies caching behavior along the request/reply chain.
 	CacheControl *string `location:"header" locationName:"Cache-Control" type:"string"`
 
+	// The algorithm of the checksum the uploader computes of the object's
+	// data. Overrides the Uploader's ChecksumAlgorithm if set.
+	ChecksumAlgorithm *string `location:"header" locationName:"x-amz-sdk-checksum-algorithm" type:"string" enum:"ChecksumAlgorithm"`
+
+	// The base64-encoded CRC32 checksum of the object. Only used if the object
+	// is uploaded in a single part, in place of the computed checksum.
+	ChecksumCRC32 *string `location:"header" locationName:"x-amz-checksum-crc32" type:"string"`
+
+	// The base64-encoded CRC32C checksum of the object. Only used if the object
+	// is uploaded in a single part, in place of the computed checksum.
+	ChecksumCRC32C *string `location:"header" locationName:"x-amz-checksum-crc32c" type:"string"`
+
+	// The base64-encoded SHA1 checksum of the object. Only used if the object
+	// is uploaded in a single part, in place of the computed checksum.
+	ChecksumSHA1 *string `location:"header" locationName:"x-amz-checksum-sha1" type:"string"`
+
+	// The base64-encoded SHA256 checksum of the object. Only used if the object
+	// is uploaded in a single part, in place of the computed checksum.
+	ChecksumSHA256 *string `location:"header" locationName:"x-amz-checksum-sha256" type:"string"`
+
 	// Specifies presentational information for the object.
 	ContentDisposition *string `location:"header" locationName:"Content-Disposition" type:"string"`
 
@@ -200,6 +230,13 @@ type UploadOutput struct {
 	// The ID for a multipart upload to S3. In the case of an error the error
 	// can be cast to the MultiUploadFailure interface to extract the upload ID.
 	UploadID string
+
+	// The base64-encoded checksum of the object computed by the uploader with
+	// the upload's checksum algorithm. Will only be populated if a checksum
+	// algorithm was set. For multipart uploads the checksum is the composite
+	// checksum of the parts, the checksum of the concatenated checksums of the
+	// parts followed by "-" and the number of parts, as computed by S3.
+	Checksum *string
 }
 
 // WithUploaderRequestOptions appends to the Uploader's API request options.
@@ -240,12 +277,37 @@ type Uploader struct {
 	// With a limited of s3.MaxUploadParts (10,000 parts).
 	MaxUploadParts int
 
+	// The algorithm of the checksum to compute of the data of each upload,
+	// such as s3.ChecksumAlgorithmCrc32c, or ContentMD5ChecksumAlgorithm. The
+	// checksum is computed while the data is read, and sent with the data for
+	// S3 to verify it. Multipart uploads send the checksum of each part, and
+	// S3 verifies the composite checksum of the parts. No checksum is computed
+	// if this value is empty.
+	ChecksumAlgorithm string
+
 	// The client to use when uploading to S3.
 	S3 s3iface.S3API
 
 	// List of request options that will be passed down to individual API
 	// operation requests made by the uploader.
 	RequestOptions []request.Option
+
+	// The listener of the progress of each upload, reporting the parts of
+	// the upload as S3 acknowledges them. No progress is reported if this
+	// value is nil.
+	ProgressListener ProgressListener
+
+	// The limiter of the throughput of the data uploaded, shared by the
+	// concurrent parts of each upload. The bytes of retried requests are
+	// counted. The throughput is not limited if this value is nil.
+	BandwidthLimiter BandwidthLimiter
+
+	// The minimum size in bytes of the PutObject and UploadPart requests of
+	// each upload which are sent with the "Expect: 100-continue" header, so
+	// that S3 rejects the request, e.g. if access is denied, before its body
+	// is sent. Overrides the S3 client's S3ExpectContinueThreshold config if
+	// not zero. Has no effect if the client's S3Disable100Continue is set.
+	ExpectContinueThreshold int64
 }
 
 // NewUploader creates a new Uploader instance to upload objects to S3. Pass In
@@ -440,6 +502,10 @@ type uploader struct {
 
 	readerPos int64 // current reader position
 	totalSize int64 // set to -1 if the size is not known
+
+	checksumAlgorithm string // empty if no checksum is computed
+
+	progress *progress
 }
 
 // internal logic for deciding whether to upload a single part or use a
@@ -451,17 +517,22 @@ func (u *uploader) upload() (*UploadOutput, error) {
 		msg := fmt.Sprintf("part size must be at least %d bytes", MinUploadPartSize)
 		return nil, awserr.New("ConfigError", msg, nil)
 	}
+	if len(u.checksumAlgorithm) != 0 && newChecksumHash(u.checksumAlgorithm) == nil {
+		msg := fmt.Sprintf("unsupported checksum algorithm %s", u.checksumAlgorithm)
+		return nil, awserr.New("ConfigError", msg, nil)
+	}
 
 	// Do one read to determine if we have more than one part
-	reader, _, err := u.nextReader()
+	reader, n, sum, err := u.nextReader()
+	first := chunk{buf: reader, num: 1, size: int64(n), sum: sum}
 	if err == io.EOF { // single part
-		return u.singlePart(reader)
+		return u.singlePart(first)
 	} else if err != nil {
 		return nil, awserr.New("ReadRequestBody", "read upload data failed", err)
 	}
 
-	mu := multiuploader{uploader: u}
-	return mu.upload(reader)
+	mu := multiuploader{uploader: u, sums: map[int64][]byte{}}
+	return mu.upload(&first)
 }
 
 // init will initialize all default options.
@@ -472,11 +543,30 @@ func (u *uploader) init() {
 	if u.cfg.PartSize == 0 {
 		u.cfg.PartSize = DefaultUploadPartSize
 	}
+	u.checksumAlgorithm = u.cfg.ChecksumAlgorithm
+	if u.in.ChecksumAlgorithm != nil {
+		u.checksumAlgorithm = *u.in.ChecksumAlgorithm
+	}
+	u.progress = &progress{listener: u.cfg.ProgressListener}
+	if u.cfg.BandwidthLimiter != nil {
+		u.cfg.RequestOptions = withRequestBodyLimit(u.cfg.RequestOptions, u.cfg.BandwidthLimiter)
+	}
+	if u.cfg.ExpectContinueThreshold != 0 {
+		u.cfg.RequestOptions = withExpectContinueThreshold(u.cfg.RequestOptions, u.cfg.ExpectContinueThreshold)
+	}
 
 	// Try to get the total size for some optimizations
 	u.initSize()
 }
 
+// withExpectContinueThreshold returns the request options with an option
+// overriding the S3 client's S3ExpectContinueThreshold with the threshold.
+func withExpectContinueThreshold(opts []request.Option, threshold int64) []request.Option {
+	return append(opts[:len(opts):len(opts)], func(r *request.Request) {
+		r.Config.S3ExpectContinueThreshold = aws.Int64(threshold)
+	})
+}
+
 // initSize tries to detect the total stream size, setting u.totalSize. If
 // the size is not known, totalSize is set to -1.
 func (u *uploader) initSize() {
@@ -507,11 +597,11 @@ func (u *uploader) initSize() {
 // This operation increases the shared u.readerPos counter, but note that it
 // does not need to be wrapped in a mutex because nextReader is only called
 // from the main thread.
-func (u *uploader) nextReader() (io.ReadSeeker, int, error) {
-	type readerAtSeeker interface {
-		io.ReaderAt
-		io.ReadSeeker
-	}
+//
+// If the upload computes checksums, and the packet is buffered from the
+// body, the checksum of the packet is computed while it is read and returned.
+// Otherwise the returned checksum is nil.
+func (u *uploader) nextReader() (io.ReadSeeker, int, []byte, error) {
 	switch r := u.in.Body.(type) {
 	case readerAtSeeker:
 		var err error
@@ -529,17 +619,33 @@ func (u *uploader) nextReader() (io.ReadSeeker, int, error) {
 		reader := io.NewSectionReader(r, u.readerPos, n)
 		u.readerPos += n
 
-		return reader, int(n), err
+		return reader, int(n), nil, err
 
 	default:
+		var sum []byte
+		h := newChecksumHash(u.checksumAlgorithm)
+		if h != nil {
+			r = io.TeeReader(r, h)
+		}
+
 		part := make([]byte, u.cfg.PartSize)
 		n, err := readFillBuf(r, part)
 		u.readerPos += int64(n)
 
-		return bytes.NewReader(part[0:n]), n, err
+		if h != nil {
+			sum = h.Sum(nil)
+		}
+
+		return bytes.NewReader(part[0:n]), n, sum, err
 	}
 }
 
+// readerAtSeeker is a body which parts can be read from without buffering.
+type readerAtSeeker interface {
+	io.ReaderAt
+	io.ReadSeeker
+}
+
 func readFillBuf(r io.Reader, b []byte) (offset int, err error) {
 	for offset < len(b) && err == nil {
 		var n int
@@ -553,24 +659,49 @@ func readFillBuf(r io.Reader, b []byte) (offset int, err error) {
 // singlePart contains upload logic for uploading a single chunk via
 // a regular PutObject request. Multipart requests require at least two
 // parts, or at least 5MB of data.
-func (u *uploader) singlePart(buf io.ReadSeeker) (*UploadOutput, error) {
+func (u *uploader) singlePart(c chunk) (*UploadOutput, error) {
+	buf, sum := c.buf, c.sum
+
 	params := &s3.PutObjectInput{}
 	awsutil.Copy(params, u.in)
 	params.Body = buf
 
+	opts := u.cfg.RequestOptions
+	var checksum *string
+	if len(u.checksumAlgorithm) != 0 && !hasChecksumMembers(params) {
+		if sum == nil {
+			var err error
+			if sum, err = computeChecksum(u.checksumAlgorithm, buf); err != nil {
+				return nil, awserr.New("ReadRequestBody", "read upload data failed", err)
+			}
+		}
+		checksum = aws.String(base64.StdEncoding.EncodeToString(sum))
+
+		if u.checksumAlgorithm == ContentMD5ChecksumAlgorithm {
+			opts = withContentMD5(opts, *checksum)
+		} else {
+			params.ChecksumAlgorithm = aws.String(u.checksumAlgorithm)
+			params.ChecksumCRC32, params.ChecksumCRC32C, params.ChecksumSHA1, params.ChecksumSHA256 =
+				checksumMembers(u.checksumAlgorithm, checksum)
+		}
+	}
+
 	// Need to use request form because URL generated in request is
 	// used in return.
 	req, out := u.cfg.S3.PutObjectRequest(params)
 	req.SetContext(u.ctx)
-	req.ApplyOptions(u.cfg.RequestOptions...)
+	req.ApplyOptions(opts...)
 	if err := req.Send(); err != nil {
 		return nil, err
 	}
+	u.progress.partCompleted(c.num, c.size, u.totalSize)
+	u.progress.transferCompleted()
 
 	url := req.HTTPRequest.URL.String()
 	return &UploadOutput{
 		Location:  url,
 		VersionID: out.VersionId,
+		Checksum:  checksum,
 	}, nil
 }
 
@@ -582,12 +713,19 @@ type multiuploader struct {
 	err      error
 	uploadID string
 	parts    completedParts
+	sums     map[int64][]byte
+
+	// resumed are the numbers of the parts completed before the upload was
+	// resumed, which are not uploaded again.
+	resumed map[int64]bool
 }
 
 // keeps track of a single chunk of data being sent to S3.
 type chunk struct {
-	buf io.ReadSeeker
-	num int64
+	buf  io.ReadSeeker
+	num  int64
+	size int64
+	sum  []byte
 }
 
 // completedParts is a wrapper to make parts sortable by their part number,
@@ -598,18 +736,26 @@ func (a completedParts) Len() int           { return len(a) }
 func (a completedParts) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a completedParts) Less(i, j int) bool { return *a[i].PartNumber < *a[j].PartNumber }
 
-// upload will perform a multipart upload using the firstBuf buffer containing
-// the first chunk of data.
-func (u *multiuploader) upload(firstBuf io.ReadSeeker) (*UploadOutput, error) {
-	params := &s3.CreateMultipartUploadInput{}
-	awsutil.Copy(params, u.in)
+// upload will perform a multipart upload using the first chunk of data. If
+// the upload is resumed the multipart upload is not created, and first is nil
+// if the first part was completed before the upload was resumed.
+func (u *multiuploader) upload(first *chunk) (*UploadOutput, error) {
+	var err error
+	if len(u.uploadID) == 0 {
+		params := &s3.CreateMultipartUploadInput{}
+		awsutil.Copy(params, u.in)
+		if len(u.checksumAlgorithm) != 0 && u.checksumAlgorithm != ContentMD5ChecksumAlgorithm {
+			params.ChecksumAlgorithm = aws.String(u.checksumAlgorithm)
+		}
 
-	// Create the multipart
-	resp, err := u.cfg.S3.CreateMultipartUploadWithContext(u.ctx, params, u.cfg.RequestOptions...)
-	if err != nil {
-		return nil, err
+		// Create the multipart
+		var resp *s3.CreateMultipartUploadOutput
+		resp, err = u.cfg.S3.CreateMultipartUploadWithContext(u.ctx, params, u.cfg.RequestOptions...)
+		if err != nil {
+			return nil, err
+		}
+		u.uploadID = *resp.UploadId
 	}
-	u.uploadID = *resp.UploadId
 
 	// Create the workers
 	ch := make(chan chunk, u.cfg.Concurrency)
@@ -620,7 +766,9 @@ func (u *multiuploader) upload(firstBuf io.ReadSeeker) (*UploadOutput, error) {
 
 	// Send part 1 to the workers
 	var num int64 = 1
-	ch <- chunk{buf: firstBuf, num: num}
+	if first != nil {
+		ch <- *first
+	}
 
 	// Read and queue the rest of the parts
 	for u.geterr() == nil && err == nil {
@@ -639,9 +787,20 @@ func (u *multiuploader) upload(firstBuf io.ReadSeeker) (*UploadOutput, error) {
 			break
 		}
 
+		if u.resumed[num] {
+			if err = u.skipPart(); err != nil && err != io.EOF {
+				u.seterr(awserr.New(
+					"ReadRequestBody",
+					"read multipart upload data failed",
+					err))
+			}
+			continue
+		}
+
 		var reader io.ReadSeeker
 		var nextChunkLen int
-		reader, nextChunkLen, err = u.nextReader()
+		var sum []byte
+		reader, nextChunkLen, sum, err = u.nextReader()
 
 		if err != nil && err != io.EOF {
 			u.seterr(awserr.New(
@@ -658,7 +817,7 @@ func (u *multiuploader) upload(firstBuf io.ReadSeeker) (*UploadOutput, error) {
 			break
 		}
 
-		ch <- chunk{buf: reader, num: num}
+		ch <- chunk{buf: reader, num: num, size: int64(nextChunkLen), sum: sum}
 	}
 
 	// Close the channel, wait for workers, and complete upload
@@ -673,12 +832,26 @@ func (u *multiuploader) upload(firstBuf io.ReadSeeker) (*UploadOutput, error) {
 				"upload multipart failed",
 				err),
 			uploadID: u.uploadID,
+			token:    u.resumeToken(),
+		}
+	}
+
+	var checksum *string
+	if len(u.checksumAlgorithm) != 0 {
+		sums := make([][]byte, 0, len(u.parts))
+		for _, p := range u.parts {
+			sums = append(sums, u.sums[*p.PartNumber])
 		}
+		checksum = aws.String(compositeChecksum(u.checksumAlgorithm, sums))
 	}
+
+	u.progress.transferCompleted()
+
 	return &UploadOutput{
 		Location:  aws.StringValue(complete.Location),
 		VersionID: complete.VersionId,
 		UploadID:  u.uploadID,
+		Checksum:  checksum,
 	}, nil
 }
 
@@ -711,20 +884,49 @@ func (u *multiuploader) send(c chunk) error {
 		UploadId:             &u.uploadID,
 		SSECustomerAlgorithm: u.in.SSECustomerAlgorithm,
 		SSECustomerKey:       u.in.SSECustomerKey,
+		SSECustomerKeyMD5:    u.in.SSECustomerKeyMD5,
 		PartNumber:           &c.num,
 	}
-	resp, err := u.cfg.S3.UploadPartWithContext(u.ctx, params, u.cfg.RequestOptions...)
+
+	opts := u.cfg.RequestOptions
+	var checksum *string
+	if len(u.checksumAlgorithm) != 0 {
+		if c.sum == nil {
+			var err error
+			if c.sum, err = computeChecksum(u.checksumAlgorithm, c.buf); err != nil {
+				return awserr.New("ReadRequestBody", "read multipart upload data failed", err)
+			}
+		}
+		checksum = aws.String(base64.StdEncoding.EncodeToString(c.sum))
+
+		if u.checksumAlgorithm == ContentMD5ChecksumAlgorithm {
+			opts = withContentMD5(opts, *checksum)
+		} else {
+			params.ChecksumAlgorithm = aws.String(u.checksumAlgorithm)
+			params.ChecksumCRC32, params.ChecksumCRC32C, params.ChecksumSHA1, params.ChecksumSHA256 =
+				checksumMembers(u.checksumAlgorithm, checksum)
+		}
+	}
+
+	resp, err := u.cfg.S3.UploadPartWithContext(u.ctx, params, opts...)
 	if err != nil {
 		return err
 	}
 
 	n := c.num
 	completed := &s3.CompletedPart{ETag: resp.ETag, PartNumber: &n}
+	if u.checksumAlgorithm != ContentMD5ChecksumAlgorithm {
+		completed.ChecksumCRC32, completed.ChecksumCRC32C, completed.ChecksumSHA1, completed.ChecksumSHA256 =
+			checksumMembers(u.checksumAlgorithm, checksum)
+	}
 
 	u.m.Lock()
 	u.parts = append(u.parts, completed)
+	u.sums[n] = c.sum
 	u.m.Unlock()
 
+	u.progress.partCompleted(c.num, c.size, u.totalSize)
+
 	return nil
 }
 
@@ -0,0 +1,103 @@
+package s3manager
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// ContentMD5ChecksumAlgorithm is the checksum algorithm of the Uploader which
+// sends the MD5 digest of the data in the Content-MD5 header of each upload
+// request, instead of a x-amz-checksum-* header.
+const ContentMD5ChecksumAlgorithm = "MD5"
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// newChecksumHash returns the hash of the checksum algorithm, or nil if the
+// algorithm is not supported.
+func newChecksumHash(algorithm string) hash.Hash {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return crc32.NewIEEE()
+	case s3.ChecksumAlgorithmCrc32c:
+		return crc32.New(crc32cTable)
+	case s3.ChecksumAlgorithmSha1:
+		return sha1.New()
+	case s3.ChecksumAlgorithmSha256:
+		return sha256.New()
+	case ContentMD5ChecksumAlgorithm:
+		return md5.New()
+	default:
+		return nil
+	}
+}
+
+// computeChecksum returns the checksum of the reader's data, seeking the
+// reader back to the start of its data.
+func computeChecksum(algorithm string, r io.ReadSeeker) ([]byte, error) {
+	h := newChecksumHash(algorithm)
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+	if _, err := r.Seek(0, 0); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
+
+// compositeChecksum returns the checksum of the concatenated checksums of
+// the parts followed by the number of parts, as computed by S3 for multipart
+// uploads.
+func compositeChecksum(algorithm string, sums [][]byte) string {
+	h := newChecksumHash(algorithm)
+	for _, sum := range sums {
+		h.Write(sum)
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(h.Sum(nil)), len(sums))
+}
+
+// checksumMembers returns the checksum value as the member of the API
+// operation input matching the checksum algorithm, in the order CRC32,
+// CRC32C, SHA1, and SHA256.
+func checksumMembers(algorithm string, v *string) (crc32, crc32c, sha1, sha256 *string) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		crc32 = v
+	case s3.ChecksumAlgorithmCrc32c:
+		crc32c = v
+	case s3.ChecksumAlgorithmSha1:
+		sha1 = v
+	case s3.ChecksumAlgorithmSha256:
+		sha256 = v
+	}
+
+	return crc32, crc32c, sha1, sha256
+}
+
+// withContentMD5 returns the request options with an option setting the
+// request's Content-MD5 header to the value. The options are copied so the
+// option is not shared across requests.
+func withContentMD5(opts []request.Option, v string) []request.Option {
+	opts = append(opts[:len(opts):len(opts)], func(r *request.Request) {
+		r.HTTPRequest.Header.Set("Content-MD5", v)
+	})
+
+	return opts
+}
+
+// hasChecksumMembers returns if the PutObject input has a checksum of the
+// object set by the caller.
+func hasChecksumMembers(params *s3.PutObjectInput) bool {
+	return params.ChecksumCRC32 != nil || params.ChecksumCRC32C != nil ||
+		params.ChecksumSHA1 != nil || params.ChecksumSHA256 != nil
+}
@@ -0,0 +1,279 @@
+package s3manager
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// A ResumableUploadFailure wraps a failed S3 multipart upload which parts were
+// left on S3, and can be resumed with the upload's resume token. An error
+// returned will satisfy this interface when a multipart upload failed, or
+// was canceled with its context, and the Uploader's LeavePartsOnError is set.
+//
+// Example:
+//
+//     output, err := uploader.Upload(input, func(u *s3manager.Uploader) {
+//         u.LeavePartsOnError = true
+//     })
+//     if err != nil {
+//         if rerr, ok := err.(s3manager.ResumableUploadFailure); ok && rerr.ResumeToken() != nil {
+//             // Save the token to resume the upload later.
+//             b, err := json.Marshal(rerr.ResumeToken())
+//             ...
+//         }
+//     }
+//
+type ResumableUploadFailure interface {
+	MultiUploadFailure
+
+	// Returns the token to resume the S3 multipart upload that failed, or
+	// nil if the upload's parts were not left on S3.
+	ResumeToken() *UploadResumeToken
+}
+
+// UploadResumeToken is the state of a multipart upload needed to resume the
+// upload with UploadWithResume, uploading only its parts which were not
+// completed. The token can be serialized to JSON to resume the upload in
+// another process.
+type UploadResumeToken struct {
+	// The bucket and key of the object uploaded.
+	Bucket string `json:"bucket"`
+	Key    string `json:"key"`
+
+	// The ID of the multipart upload.
+	UploadID string `json:"uploadId"`
+
+	// The part size of the upload, which the resumed upload must use to read
+	// the same parts from the body.
+	PartSize int64 `json:"partSize"`
+
+	// The checksum algorithm of the upload's parts, empty if the upload does
+	// not compute checksums.
+	ChecksumAlgorithm string `json:"checksumAlgorithm,omitempty"`
+
+	// The parts of the upload which were completed.
+	Parts []UploadResumePart `json:"parts"`
+}
+
+// UploadResumePart is a completed part of a multipart upload.
+type UploadResumePart struct {
+	PartNumber int64  `json:"partNumber"`
+	ETag       string `json:"etag"`
+
+	// The base64-encoded checksum of the part, empty if the upload does not
+	// compute checksums.
+	Checksum string `json:"checksum,omitempty"`
+}
+
+// UploadWithResume resumes the multipart upload of an object to S3 from the
+// resume token of the failed upload, uploading only the parts of the object
+// which were not completed. The input must be the input of the failed upload,
+// with a Body which is an io.ReadSeeker reading the same data, as the
+// completed parts are skipped by seeking past them.
+//
+// The parts of the token are validated with the parts S3 lists for the
+// upload, and the parts S3 does not have, or with a different ETag, are
+// uploaded again.
+//
+// The resumed upload leaves its parts on S3 if it fails, as if the Uploader's
+// LeavePartsOnError was set, so that it can be resumed again with the
+// ResumeToken of the ResumableUploadFailure error. Set LeavePartsOnError to
+// false in the options to abort the upload on failure.
+//
+// It is safe to call this method concurrently across goroutines.
+func (u Uploader) UploadWithResume(input *UploadInput, token *UploadResumeToken, options ...func(*Uploader)) (*UploadOutput, error) {
+	return u.UploadWithResumeWithContext(aws.BackgroundContext(), input, token, options...)
+}
+
+// UploadWithResumeWithContext resumes the multipart upload of an object to S3
+// from the resume token of the failed upload.
+//
+// UploadWithResumeWithContext is the same as UploadWithResume with the
+// additional support for Context input parameters. The Context must not be
+// nil. A nil Context will cause a panic. Use the context to add deadlining,
+// timeouts, ect. Canceling the context pauses the upload, returning a
+// ResumableUploadFailure error with the token to resume it again.
+//
+// It is safe to call this method concurrently across goroutines.
+func (u Uploader) UploadWithResumeWithContext(ctx aws.Context, input *UploadInput, token *UploadResumeToken, opts ...func(*Uploader)) (*UploadOutput, error) {
+	i := uploader{in: input, cfg: u, ctx: ctx}
+	i.cfg.LeavePartsOnError = true
+
+	for _, opt := range opts {
+		opt(&i.cfg)
+	}
+	i.cfg.RequestOptions = append(i.cfg.RequestOptions, request.WithAppendUserAgent("S3Manager"))
+
+	return i.resume(token)
+}
+
+// resume continues the multipart upload of the resume token, uploading the
+// parts which were not completed.
+func (u *uploader) resume(token *UploadResumeToken) (*UploadOutput, error) {
+	if token == nil || len(token.UploadID) == 0 {
+		return nil, awserr.New("InvalidResumeToken", "resume token has no upload ID", nil)
+	}
+	if token.Bucket != aws.StringValue(u.in.Bucket) || token.Key != aws.StringValue(u.in.Key) {
+		msg := fmt.Sprintf("resume token is for %s/%s, not %s/%s", token.Bucket, token.Key,
+			aws.StringValue(u.in.Bucket), aws.StringValue(u.in.Key))
+		return nil, awserr.New("InvalidResumeToken", msg, nil)
+	}
+	if token.PartSize < MinUploadPartSize {
+		msg := fmt.Sprintf("resume token part size must be at least %d bytes", MinUploadPartSize)
+		return nil, awserr.New("InvalidResumeToken", msg, nil)
+	}
+	if _, ok := u.in.Body.(io.ReadSeeker); !ok {
+		return nil, awserr.New("ConfigError",
+			"resuming an upload requires a Body which is an io.ReadSeeker", nil)
+	}
+
+	u.init()
+	// The parts must be read with the part size they were uploaded with.
+	u.cfg.PartSize = token.PartSize
+	u.checksumAlgorithm = token.ChecksumAlgorithm
+
+	mu := multiuploader{
+		uploader: u,
+		uploadID: token.UploadID,
+		sums:     map[int64][]byte{},
+		resumed:  map[int64]bool{},
+	}
+	if err := mu.resumeParts(token); err != nil {
+		return nil, err
+	}
+
+	var first *chunk
+	var err error
+	if mu.resumed[1] {
+		err = u.skipPart()
+	} else {
+		first = &chunk{num: 1}
+		var n int
+		first.buf, n, first.sum, err = u.nextReader()
+		first.size = int64(n)
+	}
+	if err != nil && err != io.EOF {
+		return nil, awserr.New("ReadRequestBody", "read upload data failed", err)
+	}
+
+	return mu.upload(first)
+}
+
+// resumeParts adds the parts of the resume token which S3 lists for the
+// upload to the completed parts, so that they are not uploaded again.
+func (u *multiuploader) resumeParts(token *UploadResumeToken) error {
+	etags := map[int64]string{}
+	params := &s3.ListPartsInput{
+		Bucket:       u.in.Bucket,
+		Key:          u.in.Key,
+		UploadId:     &u.uploadID,
+		RequestPayer: u.in.RequestPayer,
+	}
+	err := u.cfg.S3.ListPartsPagesWithContext(u.ctx, params,
+		func(page *s3.ListPartsOutput, lastPage bool) bool {
+			for _, p := range page.Parts {
+				etags[aws.Int64Value(p.PartNumber)] = aws.StringValue(p.ETag)
+			}
+			return true
+		}, u.cfg.RequestOptions...)
+	if err != nil {
+		return awserr.New("InvalidResumeToken", "failed to list the parts of the upload", err)
+	}
+
+	for _, p := range token.Parts {
+		if etag, ok := etags[p.PartNumber]; !ok || etag != p.ETag {
+			logMessage(u.cfg.S3, aws.LogDebug,
+				fmt.Sprintf("DEBUG: part %d of upload %s not found, uploading it again", p.PartNumber, u.uploadID))
+			continue
+		}
+
+		completed := &s3.CompletedPart{
+			ETag:       aws.String(p.ETag),
+			PartNumber: aws.Int64(p.PartNumber),
+		}
+		if len(u.checksumAlgorithm) != 0 {
+			sum, err := base64.StdEncoding.DecodeString(p.Checksum)
+			if err != nil || len(sum) == 0 {
+				msg := fmt.Sprintf("resume token part %d has an invalid checksum", p.PartNumber)
+				return awserr.New("InvalidResumeToken", msg, err)
+			}
+			u.sums[p.PartNumber] = sum
+
+			if u.checksumAlgorithm != ContentMD5ChecksumAlgorithm {
+				completed.ChecksumCRC32, completed.ChecksumCRC32C, completed.ChecksumSHA1, completed.ChecksumSHA256 =
+					checksumMembers(u.checksumAlgorithm, aws.String(p.Checksum))
+			}
+		}
+
+		u.parts = append(u.parts, completed)
+		u.resumed[p.PartNumber] = true
+	}
+
+	return nil
+}
+
+// resumeToken returns the token to resume the upload with its completed
+// parts, or nil if the upload's parts were not left on S3.
+func (u *multiuploader) resumeToken() *UploadResumeToken {
+	if !u.cfg.LeavePartsOnError || len(u.uploadID) == 0 {
+		return nil
+	}
+
+	u.m.Lock()
+	defer u.m.Unlock()
+
+	parts := make(completedParts, len(u.parts))
+	copy(parts, u.parts)
+	sort.Sort(parts)
+
+	token := &UploadResumeToken{
+		Bucket:            aws.StringValue(u.in.Bucket),
+		Key:               aws.StringValue(u.in.Key),
+		UploadID:          u.uploadID,
+		PartSize:          u.cfg.PartSize,
+		ChecksumAlgorithm: u.checksumAlgorithm,
+		Parts:             make([]UploadResumePart, 0, len(parts)),
+	}
+	for _, p := range parts {
+		part := UploadResumePart{
+			PartNumber: aws.Int64Value(p.PartNumber),
+			ETag:       aws.StringValue(p.ETag),
+		}
+		if sum, ok := u.sums[part.PartNumber]; ok {
+			part.Checksum = base64.StdEncoding.EncodeToString(sum)
+		}
+		token.Parts = append(token.Parts, part)
+	}
+
+	return token
+}
+
+// skipPart skips the next part of the body, which was completed before the
+// upload was resumed. Returns io.EOF if the part is the last part of the
+// body.
+func (u *uploader) skipPart() error {
+	n := u.cfg.PartSize
+	if u.totalSize >= 0 && u.totalSize-u.readerPos < n {
+		n = u.totalSize - u.readerPos
+	}
+
+	if _, ok := u.in.Body.(readerAtSeeker); !ok {
+		if _, err := u.in.Body.(io.Seeker).Seek(n, 1); err != nil {
+			return err
+		}
+	}
+	u.readerPos += n
+	u.progress.skipped(n)
+
+	if u.totalSize >= 0 && u.readerPos >= u.totalSize {
+		return io.EOF
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+package s3manager_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// resumeS3 is a fake S3 keeping the parts of a multipart upload, which fails
+// the UploadPart calls of the parts in failParts.
+type resumeS3 struct {
+	m         sync.Mutex
+	parts     map[int64][]byte
+	ops       []string
+	failParts map[int64]bool
+	complete  *s3.CompleteMultipartUploadInput
+}
+
+func (f *resumeS3) client() *s3.S3 {
+	svc := s3.New(unit.Session)
+	svc.Handlers.Unmarshal.Clear()
+	svc.Handlers.UnmarshalMeta.Clear()
+	svc.Handlers.UnmarshalError.Clear()
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		f.m.Lock()
+		defer f.m.Unlock()
+
+		f.ops = append(f.ops, r.Operation.Name)
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte{})),
+		}
+
+		switch data := r.Data.(type) {
+		case *s3.CreateMultipartUploadOutput:
+			data.UploadId = aws.String("UPLOAD-ID")
+		case *s3.UploadPartOutput:
+			in := r.Params.(*s3.UploadPartInput)
+			if f.failParts[*in.PartNumber] {
+				r.Error = awserr.New("ConnectionError", "connection lost", nil)
+				r.Retryable = aws.Bool(false)
+				return
+			}
+			b, _ := ioutil.ReadAll(in.Body)
+			f.parts[*in.PartNumber] = b
+			data.ETag = aws.String(fmt.Sprintf("ETAG%d", *in.PartNumber))
+		case *s3.ListPartsOutput:
+			for num := range f.parts {
+				data.Parts = append(data.Parts, &s3.Part{
+					PartNumber: aws.Int64(num),
+					ETag:       aws.String(fmt.Sprintf("ETAG%d", num)),
+				})
+			}
+		case *s3.CompleteMultipartUploadOutput:
+			f.complete = r.Params.(*s3.CompleteMultipartUploadInput)
+			data.Location = aws.String("https://location")
+		}
+	})
+
+	return svc
+}
+
+// readSeekerOnly hides the io.ReaderAt of the body.
+type readSeekerOnly struct {
+	io.ReadSeeker
+}
+
+func TestUploadWithResume(t *testing.T) {
+	partSize := s3manager.MinUploadPartSize
+	data := make([]byte, partSize*4+1024)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+
+	bodies := map[string]func() io.ReadSeeker{
+		"reader at": func() io.ReadSeeker { return bytes.NewReader(data) },
+		"seeker":    func() io.ReadSeeker { return readSeekerOnly{bytes.NewReader(data)} },
+	}
+
+	for name, body := range bodies {
+		f := &resumeS3{parts: map[int64][]byte{}, failParts: map[int64]bool{3: true}}
+		mgr := s3manager.NewUploaderWithClient(f.client(), func(u *s3manager.Uploader) {
+			u.Concurrency = 1
+			u.LeavePartsOnError = true
+		})
+
+		_, err := mgr.Upload(&s3manager.UploadInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+			Body:   body(),
+		})
+		rerr, ok := err.(s3manager.ResumableUploadFailure)
+		if !ok {
+			t.Fatalf("%s, expect ResumableUploadFailure, got %T, %v", name, err, err)
+		}
+		if rerr.ResumeToken() == nil {
+			t.Fatalf("%s, expect resume token, got none", name)
+		}
+
+		b, err := json.Marshal(rerr.ResumeToken())
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		var token s3manager.UploadResumeToken
+		if err := json.Unmarshal(b, &token); err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		expectToken := s3manager.UploadResumeToken{
+			Bucket:   "bucket",
+			Key:      "key",
+			UploadID: "UPLOAD-ID",
+			PartSize: partSize,
+			Parts: []s3manager.UploadResumePart{
+				{PartNumber: 1, ETag: "ETAG1"},
+				{PartNumber: 2, ETag: "ETAG2"},
+			},
+		}
+		if e, a := expectToken, token; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v token, got %v", name, e, a)
+		}
+
+		// Resume the upload in a new uploader, as in a restarted process.
+		f.failParts = nil
+		f.ops = nil
+		mgr = s3manager.NewUploaderWithClient(f.client())
+		resp, err := mgr.UploadWithResume(&s3manager.UploadInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+			Body:   body(),
+		}, &token)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := "UPLOAD-ID", resp.UploadID; e != a {
+			t.Errorf("%s, expect %v upload ID, got %v", name, e, a)
+		}
+
+		expectOps := []string{"ListParts", "UploadPart", "UploadPart", "UploadPart", "CompleteMultipartUpload"}
+		if e, a := expectOps, f.ops; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v ops, got %v", name, e, a)
+		}
+
+		var uploaded []byte
+		for i := int64(1); i <= 5; i++ {
+			uploaded = append(uploaded, f.parts[i]...)
+		}
+		if !bytes.Equal(data, uploaded) {
+			t.Errorf("%s, expect uploaded parts to match data", name)
+		}
+
+		if f.complete == nil {
+			t.Fatalf("%s, expect CompleteMultipartUpload, got none", name)
+		}
+		for i, p := range f.complete.MultipartUpload.Parts {
+			if e, a := int64(i+1), *p.PartNumber; e != a {
+				t.Errorf("%s, expect part %d, got %d", name, e, a)
+			}
+			if e, a := fmt.Sprintf("ETAG%d", i+1), *p.ETag; e != a {
+				t.Errorf("%s, expect %v ETag, got %v", name, e, a)
+			}
+		}
+	}
+}
+
+func TestUploadWithResume_MissingPart(t *testing.T) {
+	partSize := s3manager.MinUploadPartSize
+	data := make([]byte, partSize*2+1024)
+
+	// Part 2 of the token is not listed by S3, and is uploaded again.
+	f := &resumeS3{parts: map[int64][]byte{1: data[:partSize]}}
+	mgr := s3manager.NewUploaderWithClient(f.client())
+	_, err := mgr.UploadWithResume(&s3manager.UploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader(data),
+	}, &s3manager.UploadResumeToken{
+		Bucket:   "bucket",
+		Key:      "key",
+		UploadID: "UPLOAD-ID",
+		PartSize: partSize,
+		Parts: []s3manager.UploadResumePart{
+			{PartNumber: 1, ETag: "ETAG1"},
+			{PartNumber: 2, ETag: "ETAG2"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expectOps := []string{"ListParts", "UploadPart", "UploadPart", "CompleteMultipartUpload"}
+	if e, a := expectOps, f.ops; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v ops, got %v", e, a)
+	}
+	if e, a := 3, len(f.complete.MultipartUpload.Parts); e != a {
+		t.Errorf("expect %d completed parts, got %d", e, a)
+	}
+}
+
+func TestUploadWithResume_Invalid(t *testing.T) {
+	token := &s3manager.UploadResumeToken{
+		Bucket:   "bucket",
+		Key:      "key",
+		UploadID: "UPLOAD-ID",
+		PartSize: s3manager.MinUploadPartSize,
+	}
+
+	cases := map[string]struct {
+		Body    io.Reader
+		Key     string
+		Token   *s3manager.UploadResumeToken
+		ErrCode string
+	}{
+		"non-seekable body": {
+			Body:    bytes.NewBuffer([]byte("abc")),
+			Key:     "key",
+			Token:   token,
+			ErrCode: "ConfigError",
+		},
+		"other key": {
+			Body:    bytes.NewReader([]byte("abc")),
+			Key:     "other",
+			Token:   token,
+			ErrCode: "InvalidResumeToken",
+		},
+		"no token": {
+			Body:    bytes.NewReader([]byte("abc")),
+			Key:     "key",
+			ErrCode: "InvalidResumeToken",
+		},
+	}
+
+	for name, c := range cases {
+		f := &resumeS3{parts: map[int64][]byte{}}
+		mgr := s3manager.NewUploaderWithClient(f.client())
+		_, err := mgr.UploadWithResume(&s3manager.UploadInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String(c.Key),
+			Body:   c.Body,
+		}, c.Token)
+
+		aerr, ok := err.(awserr.Error)
+		if !ok {
+			t.Fatalf("%s, expect awserr.Error, got %T, %v", name, err, err)
+		}
+		if e, a := c.ErrCode, aerr.Code(); e != a {
+			t.Errorf("%s, expect %v error code, got %v", name, e, a)
+		}
+		if e, a := 0, len(f.ops); e != a {
+			t.Errorf("%s, expect no API calls, got %v", name, f.ops)
+		}
+	}
+}
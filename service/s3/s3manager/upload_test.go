@@ -2,7 +2,12 @@ package s3manager_test
 
 import (
 	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -937,6 +942,11 @@ func TestSSE(t *testing.T) {
 			StatusCode: 200,
 			Body:       ioutil.NopCloser(bytes.NewReader([]byte{})),
 		}
+		if r.Operation.Name != "CompleteMultipartUpload" {
+			if e, a := "KYvwGXoFFJ42a2u2GDWhwQ==", r.HTTPRequest.Header.Get("X-Amz-Server-Side-Encryption-Customer-Key-Md5"); e != a {
+				t.Errorf("%s, expect %v key MD5, got %v", r.Operation.Name, e, a)
+			}
+		}
 		switch data := r.Data.(type) {
 		case *s3.CreateMultipartUploadOutput:
 			data.UploadId = aws.String("UPLOAD-ID")
@@ -967,7 +977,7 @@ func TestSSE(t *testing.T) {
 		Bucket:               aws.String("Bucket"),
 		Key:                  aws.String("Key"),
 		SSECustomerAlgorithm: aws.String("AES256"),
-		SSECustomerKey:       aws.String("foo"),
+		SSECustomerKey:       aws.String("01234567890123456789012345678901"),
 		Body:                 bytes.NewBuffer(make([]byte, 1024*1024*10)),
 	})
 
@@ -1001,3 +1011,269 @@ func TestUploadWithContextCanceled(t *testing.T) {
 		t.Errorf("expected error message to contain %q, but did not %q", e, a)
 	}
 }
+
+func checksumSvc() (*s3.S3, *[]*request.Request) {
+	var m sync.Mutex
+	reqs := []*request.Request{}
+	svc := s3.New(unit.Session)
+	svc.Handlers.Unmarshal.Clear()
+	svc.Handlers.UnmarshalMeta.Clear()
+	svc.Handlers.UnmarshalError.Clear()
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *request.Request) {
+		m.Lock()
+		defer m.Unlock()
+
+		reqs = append(reqs, r)
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte{})),
+		}
+
+		switch data := r.Data.(type) {
+		case *s3.CreateMultipartUploadOutput:
+			data.UploadId = aws.String("UPLOAD-ID")
+		case *s3.UploadPartOutput:
+			data.ETag = aws.String(fmt.Sprintf("ETAG%d", *r.Params.(*s3.UploadPartInput).PartNumber))
+		}
+	})
+
+	return svc, &reqs
+}
+
+func TestUploadChecksumMultipart(t *testing.T) {
+	data := make([]byte, 1024*1024*12)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	partData := [][]byte{data[:1024*1024*5], data[1024*1024*5 : 1024*1024*10], data[1024*1024*10:]}
+
+	cases := map[string]struct {
+		Algorithm    string
+		Hash         func() hash.Hash
+		Header       string
+		CreateHeader string
+	}{
+		"CRC32C": {
+			Algorithm:    s3.ChecksumAlgorithmCrc32c,
+			Hash:         func() hash.Hash { return crc32.New(crc32.MakeTable(crc32.Castagnoli)) },
+			Header:       "X-Amz-Checksum-Crc32c",
+			CreateHeader: s3.ChecksumAlgorithmCrc32c,
+		},
+		"SHA256": {
+			Algorithm:    s3.ChecksumAlgorithmSha256,
+			Hash:         sha256.New,
+			Header:       "X-Amz-Checksum-Sha256",
+			CreateHeader: s3.ChecksumAlgorithmSha256,
+		},
+		"MD5": {
+			Algorithm: s3manager.ContentMD5ChecksumAlgorithm,
+			Hash:      md5.New,
+			Header:    "Content-Md5",
+		},
+	}
+
+	bodies := map[string]func() io.Reader{
+		"buffered": func() io.Reader { return bytes.NewBuffer(data) },
+		"seekable": func() io.Reader { return bytes.NewReader(data) },
+	}
+
+	for name, c := range cases {
+		for bodyName, body := range bodies {
+			name := name + " " + bodyName
+
+			var expectSums []string
+			composite := c.Hash()
+			for _, p := range partData {
+				h := c.Hash()
+				h.Write(p)
+				sum := h.Sum(nil)
+				composite.Write(sum)
+				expectSums = append(expectSums, base64.StdEncoding.EncodeToString(sum))
+			}
+			expectChecksum := base64.StdEncoding.EncodeToString(composite.Sum(nil)) + "-3"
+
+			s, reqs := checksumSvc()
+			mgr := s3manager.NewUploaderWithClient(s, func(u *s3manager.Uploader) {
+				u.ChecksumAlgorithm = c.Algorithm
+			})
+			resp, err := mgr.Upload(&s3manager.UploadInput{
+				Bucket: aws.String("Bucket"),
+				Key:    aws.String("Key"),
+				Body:   body(),
+			})
+			if err != nil {
+				t.Fatalf("%s, expect no error, got %v", name, err)
+			}
+			if e, a := expectChecksum, aws.StringValue(resp.Checksum); e != a {
+				t.Errorf("%s, expect %v checksum, got %v", name, e, a)
+			}
+
+			var complete *s3.CompleteMultipartUploadInput
+			parts := map[int64]*request.Request{}
+			for _, r := range *reqs {
+				switch params := r.Params.(type) {
+				case *s3.CreateMultipartUploadInput:
+					if e, a := c.CreateHeader, r.HTTPRequest.Header.Get("X-Amz-Checksum-Algorithm"); e != a {
+						t.Errorf("%s, expect %q checksum algorithm, got %q", name, e, a)
+					}
+				case *s3.UploadPartInput:
+					parts[*params.PartNumber] = r
+				case *s3.CompleteMultipartUploadInput:
+					complete = params
+				}
+			}
+
+			if e, a := 3, len(parts); e != a {
+				t.Fatalf("%s, expect %d parts, got %d", name, e, a)
+			}
+			for i, e := range expectSums {
+				r := parts[int64(i+1)]
+				if a := r.HTTPRequest.Header.Get(c.Header); e != a {
+					t.Errorf("%s, expect part %d %s %v, got %v", name, i+1, c.Header, e, a)
+				}
+			}
+
+			if complete == nil {
+				t.Fatalf("%s, expect CompleteMultipartUpload, got none", name)
+			}
+			for i, p := range complete.MultipartUpload.Parts {
+				var e string
+				if c.Algorithm != s3manager.ContentMD5ChecksumAlgorithm {
+					e = expectSums[i]
+				}
+				a := aws.StringValue(p.ChecksumCRC32C) + aws.StringValue(p.ChecksumSHA256)
+				if e != a {
+					t.Errorf("%s, expect completed part %d checksum %q, got %q", name, i+1, e, a)
+				}
+			}
+		}
+	}
+}
+
+func TestUploadChecksumSinglePart(t *testing.T) {
+	data := []byte("hello world")
+
+	cases := map[string]struct {
+		Input    *s3manager.UploadInput
+		Uploader string
+		Header   string
+		Expect   string
+	}{
+		"uploader algorithm": {
+			Input:    &s3manager.UploadInput{},
+			Uploader: s3.ChecksumAlgorithmCrc32,
+			Header:   "X-Amz-Checksum-Crc32",
+			Expect:   "DUoRhQ==",
+		},
+		"input algorithm": {
+			Input:    &s3manager.UploadInput{ChecksumAlgorithm: aws.String(s3.ChecksumAlgorithmSha1)},
+			Uploader: s3.ChecksumAlgorithmCrc32,
+			Header:   "X-Amz-Checksum-Sha1",
+			Expect:   "Kq5sNclPz7QV2+lfQIuc6R7oRu0=",
+		},
+		"content md5": {
+			Input:    &s3manager.UploadInput{},
+			Uploader: s3manager.ContentMD5ChecksumAlgorithm,
+			Header:   "Content-Md5",
+			Expect:   "XrY7u+Ae7tCTyyK7j1rNww==",
+		},
+		"precomputed": {
+			Input:    &s3manager.UploadInput{ChecksumSHA256: aws.String("precomputed")},
+			Uploader: s3.ChecksumAlgorithmSha256,
+			Header:   "X-Amz-Checksum-Sha256",
+			Expect:   "precomputed",
+		},
+	}
+
+	for name, c := range cases {
+		s, reqs := checksumSvc()
+		mgr := s3manager.NewUploaderWithClient(s, func(u *s3manager.Uploader) {
+			u.ChecksumAlgorithm = c.Uploader
+		})
+
+		c.Input.Bucket = aws.String("Bucket")
+		c.Input.Key = aws.String("Key")
+		c.Input.Body = bytes.NewBuffer(data)
+		resp, err := mgr.Upload(c.Input)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if e, a := 1, len(*reqs); e != a {
+			t.Fatalf("%s, expect %d requests, got %d", name, e, a)
+		}
+		if e, a := c.Expect, (*reqs)[0].HTTPRequest.Header.Get(c.Header); e != a {
+			t.Errorf("%s, expect %s %v, got %v", name, c.Header, e, a)
+		}
+		if c.Input.ChecksumSHA256 == nil {
+			if e, a := c.Expect, aws.StringValue(resp.Checksum); e != a {
+				t.Errorf("%s, expect %v checksum, got %v", name, e, a)
+			}
+		}
+	}
+}
+
+func TestUploadChecksumUnsupportedAlgorithm(t *testing.T) {
+	s, reqs := checksumSvc()
+	mgr := s3manager.NewUploaderWithClient(s, func(u *s3manager.Uploader) {
+		u.ChecksumAlgorithm = "CRC64"
+	})
+
+	_, err := mgr.Upload(&s3manager.UploadInput{
+		Bucket: aws.String("Bucket"),
+		Key:    aws.String("Key"),
+		Body:   bytes.NewBuffer([]byte("hello world")),
+	})
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T, %v", err, err)
+	}
+	if e, a := "ConfigError", aerr.Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if e, a := 0, len(*reqs); e != a {
+		t.Errorf("expect %d requests, got %d", e, a)
+	}
+}
+
+func TestUploadExpectContinueThreshold(t *testing.T) {
+	cases := map[string]struct {
+		Threshold    int64
+		ExpectHeader []string
+	}{
+		"client default": {
+			ExpectHeader: []string{"100-Continue", "100-Continue", "100-Continue"},
+		},
+		"uploader threshold": {
+			Threshold:    1024 * 1024 * 3,
+			ExpectHeader: []string{"100-Continue", "100-Continue", ""},
+		},
+	}
+
+	for name, c := range cases {
+		s, _, _ := loggingSvc(emptyList)
+		var headers []string
+		s.Handlers.Send.PushFront(func(r *request.Request) {
+			if r.Operation.Name == "UploadPart" {
+				headers = append(headers, r.HTTPRequest.Header.Get("Expect"))
+			}
+		})
+		mgr := s3manager.NewUploaderWithClient(s, func(u *s3manager.Uploader) {
+			u.Concurrency = 1
+			u.ExpectContinueThreshold = c.Threshold
+		})
+
+		_, err := mgr.Upload(&s3manager.UploadInput{
+			Bucket: aws.String("Bucket"),
+			Key:    aws.String("Key"),
+			Body:   bytes.NewReader(buf12MB),
+		})
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := c.ExpectHeader, headers; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v Expect headers, got %v", name, e, a)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package s3_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/private/protocol/eventstream"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func selectEventMessage(eventType string, payload string) eventstream.Message {
+	msg := eventstream.Message{Payload: []byte(payload)}
+	msg.Headers.Set(":message-type", eventstream.StringValue("event"))
+	msg.Headers.Set(":event-type", eventstream.StringValue(eventType))
+	return msg
+}
+
+func selectErrorMessage(code, message string) eventstream.Message {
+	var msg eventstream.Message
+	msg.Headers.Set(":message-type", eventstream.StringValue("error"))
+	msg.Headers.Set(":error-code", eventstream.StringValue(code))
+	msg.Headers.Set(":error-message", eventstream.StringValue(message))
+	return msg
+}
+
+func encodeSelectEventStream(t *testing.T, msgs ...eventstream.Message) []byte {
+	var buf bytes.Buffer
+	encoder := eventstream.NewEncoder(&buf)
+	for _, msg := range msgs {
+		if err := encoder.Encode(msg); err != nil {
+			t.Fatalf("expect no error encoding message, got %v", err)
+		}
+	}
+	return buf.Bytes()
+}
+
+// newSelectTestSvc returns a client whose requests are served the event
+// stream by a test server, and the channel the requests' bodies are sent on.
+func newSelectTestSvc(stream []byte) (*s3.S3, <-chan *http.Request, func()) {
+	reqs := make(chan *http.Request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		reqs <- r
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write(stream)
+	}))
+
+	svc := s3.New(unit.Session, &aws.Config{
+		Endpoint:         aws.String(server.URL),
+		DisableSSL:       aws.Bool(true),
+		MaxRetries:       aws.Int(0),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+	return svc, reqs, server.Close
+}
+
+func selectObjectContentInput() *s3.SelectObjectContentInput {
+	return &s3.SelectObjectContentInput{
+		Bucket:         aws.String("bucket"),
+		Key:            aws.String("key.csv"),
+		Expression:     aws.String("SELECT * FROM S3Object"),
+		ExpressionType: aws.String(s3.ExpressionTypeSql),
+		InputSerialization: &s3.InputSerialization{
+			CSV: &s3.CSVInput{FileHeaderInfo: aws.String(s3.FileHeaderInfoUse)},
+		},
+		OutputSerialization: &s3.OutputSerialization{
+			JSON: &s3.JSONOutput{},
+		},
+	}
+}
+
+func TestSelectObjectContent(t *testing.T) {
+	stream := encodeSelectEventStream(t,
+		selectEventMessage("Records", `{"a":1}`+"\n"),
+		selectEventMessage("Cont", ""),
+		selectEventMessage("Records", `{"a":2}`+"\n"),
+		selectEventMessage("Progress", `<Progress><BytesScanned>10</BytesScanned><BytesProcessed>10</BytesProcessed><BytesReturned>16</BytesReturned></Progress>`),
+		selectEventMessage("Stats", `<Stats><BytesScanned>20</BytesScanned><BytesProcessed>20</BytesProcessed><BytesReturned>16</BytesReturned></Stats>`),
+		selectEventMessage("End", ""),
+	)
+	svc, reqs, closeServer := newSelectTestSvc(stream)
+	defer closeServer()
+
+	resp, err := svc.SelectObjectContent(selectObjectContentInput())
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer resp.EventStream.Close()
+
+	var records bytes.Buffer
+	var stats *s3.Stats
+	var types []string
+	for event := range resp.EventStream.Events() {
+		types = append(types, reflect.TypeOf(event).Elem().Name())
+		switch e := event.(type) {
+		case *s3.RecordsEvent:
+			records.Write(e.Payload)
+		case *s3.StatsEvent:
+			stats = e.Details
+		}
+	}
+	if err := resp.EventStream.Err(); err != nil {
+		t.Fatalf("expect no stream error, got %v", err)
+	}
+	if err := resp.EventStream.Close(); err != nil {
+		t.Errorf("expect no close error, got %v", err)
+	}
+
+	expectTypes := []string{"RecordsEvent", "ContinuationEvent", "RecordsEvent", "ProgressEvent", "StatsEvent", "EndEvent"}
+	if e, a := expectTypes, types; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v events, got %v", e, a)
+	}
+	if e, a := "{\"a\":1}\n{\"a\":2}\n", records.String(); e != a {
+		t.Errorf("expect %q records, got %q", e, a)
+	}
+	if stats == nil {
+		t.Fatalf("expect stats event")
+	}
+	if e, a := int64(20), aws.Int64Value(stats.BytesScanned); e != a {
+		t.Errorf("expect %v bytes scanned, got %v", e, a)
+	}
+	if e, a := int64(16), aws.Int64Value(stats.BytesReturned); e != a {
+		t.Errorf("expect %v bytes returned, got %v", e, a)
+	}
+
+	r := <-reqs
+	if e, a := "POST", r.Method; e != a {
+		t.Errorf("expect %v method, got %v", e, a)
+	}
+	if e, a := "/bucket/key.csv", r.URL.Path; e != a {
+		t.Errorf("expect %v path, got %v", e, a)
+	}
+	if _, ok := r.URL.Query()["select"]; !ok {
+		t.Errorf("expect select query, got %v", r.URL.RawQuery)
+	}
+	if e, a := "2", r.URL.Query().Get("select-type"); e != a {
+		t.Errorf("expect %v select-type, got %v", e, a)
+	}
+	body, _ := ioutil.ReadAll(r.Body)
+	for _, e := range []string{
+		`<SelectObjectContentRequest xmlns="http://s3.amazonaws.com/doc/2006-03-01/">`,
+		`<Expression>SELECT * FROM S3Object</Expression>`,
+		`<ExpressionType>SQL</ExpressionType>`,
+		`<InputSerialization><CSV><FileHeaderInfo>USE</FileHeaderInfo></CSV></InputSerialization>`,
+		`<OutputSerialization><JSON></JSON></OutputSerialization>`,
+	} {
+		if a := string(body); !strings.Contains(a, e) {
+			t.Errorf("expect body to contain %v, got %v", e, a)
+		}
+	}
+}
+
+func TestSelectObjectContent_StreamErrors(t *testing.T) {
+	records := selectEventMessage("Records", "a,b\n")
+	exception := selectEventMessage("", "the exception")
+	exception.Headers.Set(":message-type", eventstream.StringValue("exception"))
+	exception.Headers.Set(":exception-type", eventstream.StringValue("SomeException"))
+	recordsLen := len(encodeSelectEventStream(t, records))
+
+	cases := map[string]struct {
+		Stream        []byte
+		ExpectRecords int
+		ErrCode       string
+		ErrMessage    string
+	}{
+		"error message": {
+			Stream:        encodeSelectEventStream(t, records, selectErrorMessage("InternalError", "the error")),
+			ExpectRecords: 1,
+			ErrCode:       "InternalError",
+			ErrMessage:    "the error",
+		},
+		"exception message": {
+			Stream:        encodeSelectEventStream(t, records, exception),
+			ExpectRecords: 1,
+			ErrCode:       "SomeException",
+			ErrMessage:    "the exception",
+		},
+		"missing end": {
+			Stream:        encodeSelectEventStream(t, records, records),
+			ExpectRecords: 2,
+			ErrCode:       s3.ErrCodeEventStreamTruncated,
+		},
+		"truncated message": {
+			Stream:        encodeSelectEventStream(t, records, records)[:recordsLen+10],
+			ExpectRecords: 1,
+			ErrCode:       "SerializationError",
+		},
+	}
+
+	for name, c := range cases {
+		svc, _, closeServer := newSelectTestSvc(c.Stream)
+
+		resp, err := svc.SelectObjectContent(selectObjectContentInput())
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		var n int
+		for event := range resp.EventStream.Events() {
+			if _, ok := event.(*s3.RecordsEvent); ok {
+				n++
+			}
+		}
+		if e, a := c.ExpectRecords, n; e != a {
+			t.Errorf("%s, expect %v records events, got %v", name, e, a)
+		}
+
+		aerr, ok := resp.EventStream.Err().(awserr.Error)
+		if !ok {
+			t.Fatalf("%s, expect awserr.Error, got %T, %v", name, resp.EventStream.Err(), resp.EventStream.Err())
+		}
+		if e, a := c.ErrCode, aerr.Code(); e != a {
+			t.Errorf("%s, expect %v error code, got %v", name, e, a)
+		}
+		if len(c.ErrMessage) != 0 {
+			if e, a := c.ErrMessage, aerr.Message(); e != a {
+				t.Errorf("%s, expect %v error message, got %v", name, e, a)
+			}
+		}
+
+		resp.EventStream.Close()
+		closeServer()
+	}
+}
+
+func TestSelectObjectContent_Close(t *testing.T) {
+	var msgs []eventstream.Message
+	for i := 0; i < 100; i++ {
+		msgs = append(msgs, selectEventMessage("Records", strings.Repeat("a,b\n", 1024)))
+	}
+	msgs = append(msgs, selectEventMessage("End", ""))
+	svc, _, closeServer := newSelectTestSvc(encodeSelectEventStream(t, msgs...))
+	defer closeServer()
+
+	resp, err := svc.SelectObjectContent(selectObjectContentInput())
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if _, ok := (<-resp.EventStream.Events()).(*s3.RecordsEvent); !ok {
+		t.Fatalf("expect records event")
+	}
+	if err := resp.EventStream.Close(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := resp.EventStream.Close(); err != nil {
+		t.Errorf("expect no error closing again, got %v", err)
+	}
+
+	if _, ok := <-resp.EventStream.Events(); ok {
+		t.Errorf("expect events channel closed")
+	}
+	if err := resp.EventStream.Err(); err != nil {
+		t.Errorf("expect no stream error, got %v", err)
+	}
+}
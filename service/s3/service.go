@@ -31,6 +31,7 @@ var initRequest func(*request.Request)
 const (
 	ServiceName = "s3"        // Service endpoint prefix API calls made to.
 	EndpointsID = ServiceName // Service ID for Regions and Endpoints metadata.
+	ServiceID   = "S3"        // ServiceID is a unique identifier of a specific service.
 )
 
 // New creates a new instance of the S3 client with a session.
@@ -54,11 +55,13 @@ func newClient(cfg aws.Config, handlers request.Handlers, endpoint, signingRegio
 		Client: client.New(
 			cfg,
 			metadata.ClientInfo{
-				ServiceName:   ServiceName,
-				SigningName:   signingName,
-				SigningRegion: signingRegion,
-				Endpoint:      endpoint,
-				APIVersion:    "2006-03-01",
+				ServiceName:     ServiceName,
+				ServiceID:       ServiceID,
+				SigningName:     signingName,
+				SigningRegion:   signingRegion,
+				Endpoint:        endpoint,
+				APIVersion:      "2006-03-01",
+				SigningEscaping: metadata.RawPathSigning,
 			},
 			handlers,
 		),
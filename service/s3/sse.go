@@ -3,11 +3,16 @@ package s3
 import (
 	"crypto/md5"
 	"encoding/base64"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 )
 
+// sseCustomerKeyLen is the length in bytes of SSE-C customer keys, which are
+// 256-bit AES keys.
+const sseCustomerKeyLen = 32
+
 var errSSERequiresSSL = awserr.New("ConfigError", "cannot send SSE keys over HTTP.", nil)
 
 func validateSSERequiresSSL(r *request.Request) {
@@ -30,6 +35,10 @@ func validateSSERequiresSSL(r *request.Request) {
 	}
 }
 
+// computeSSEKeys base64-encodes the SSE-C customer keys of the request, and
+// adds the MD5 digests of the keys if they were not set. The keys may be the
+// raw 32-byte keys, or their base64 encoding. Keys of any other length fail
+// the request before it is sent.
 func computeSSEKeys(r *request.Request) {
 	headers := []string{
 		"x-amz-server-side-encryption-customer-key",
@@ -39,16 +48,37 @@ func computeSSEKeys(r *request.Request) {
 	for _, h := range headers {
 		md5h := h + "-md5"
 		if key := r.HTTPRequest.Header.Get(h); key != "" {
+			raw, ok := sseCustomerKey(key)
+			if !ok {
+				r.Error = awserr.New(request.InvalidParameterErrCode,
+					fmt.Sprintf("%s must be a %d-byte key, or its base64 encoding", h, sseCustomerKeyLen), nil)
+				return
+			}
+
 			// Base64-encode the value
-			b64v := base64.StdEncoding.EncodeToString([]byte(key))
+			b64v := base64.StdEncoding.EncodeToString(raw)
 			r.HTTPRequest.Header.Set(h, b64v)
 
 			// Add MD5 if it wasn't computed
 			if r.HTTPRequest.Header.Get(md5h) == "" {
-				sum := md5.Sum([]byte(key))
+				sum := md5.Sum(raw)
 				b64sum := base64.StdEncoding.EncodeToString(sum[:])
 				r.HTTPRequest.Header.Set(md5h, b64sum)
 			}
 		}
 	}
 }
+
+// sseCustomerKey returns the raw bytes of the SSE-C customer key, which is
+// either the raw key, or the base64 encoding of the key. Returns false if the
+// key is not a 32-byte key.
+func sseCustomerKey(key string) ([]byte, bool) {
+	if len(key) == sseCustomerKeyLen {
+		return []byte(key), true
+	}
+	if b, err := base64.StdEncoding.DecodeString(key); err == nil && len(b) == sseCustomerKeyLen {
+		return b, true
+	}
+
+	return nil, false
+}
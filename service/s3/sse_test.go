@@ -1,10 +1,18 @@
 package s3_test
 
 import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/awstesting/unit"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/stretchr/testify/assert"
@@ -28,9 +36,9 @@ func TestSSECustomerKeyOverHTTPError(t *testing.T) {
 func TestCopySourceSSECustomerKeyOverHTTPError(t *testing.T) {
 	s := s3.New(unit.Session, &aws.Config{DisableSSL: aws.Bool(true)})
 	req, _ := s.CopyObjectRequest(&s3.CopyObjectInput{
-		Bucket:     aws.String("bucket"),
-		CopySource: aws.String("bucket/source"),
-		Key:        aws.String("dest"),
+		Bucket:                   aws.String("bucket"),
+		CopySource:               aws.String("bucket/source"),
+		Key:                      aws.String("dest"),
 		CopySourceSSECustomerKey: aws.String("key"),
 	})
 	err := req.Build()
@@ -40,22 +48,30 @@ func TestCopySourceSSECustomerKeyOverHTTPError(t *testing.T) {
 	assert.Contains(t, err.(awserr.Error).Message(), "cannot send SSE keys over HTTP")
 }
 
+const (
+	testSSEKey        = "01234567890123456789012345678901"
+	testSSEKeyB64     = "MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTIzNDU2Nzg5MDE="
+	testSSEKeyMD5     = "KYvwGXoFFJ42a2u2GDWhwQ=="
+	testCopySSEKeyB64 = "YWJjZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXowMTIzNDU="
+	testCopySSEKeyMD5 = "NX6C25NPxF9KJbS4Pci9GQ=="
+)
+
 func TestComputeSSEKeys(t *testing.T) {
 	s := s3.New(unit.Session)
 	req, _ := s.CopyObjectRequest(&s3.CopyObjectInput{
 		Bucket:                   aws.String("bucket"),
 		CopySource:               aws.String("bucket/source"),
 		Key:                      aws.String("dest"),
-		SSECustomerKey:           aws.String("key"),
-		CopySourceSSECustomerKey: aws.String("key"),
+		SSECustomerKey:           aws.String(testSSEKey),
+		CopySourceSSECustomerKey: aws.String(testCopySSEKeyB64),
 	})
 	err := req.Build()
 
 	assert.NoError(t, err)
-	assert.Equal(t, "a2V5", req.HTTPRequest.Header.Get("x-amz-server-side-encryption-customer-key"))
-	assert.Equal(t, "a2V5", req.HTTPRequest.Header.Get("x-amz-copy-source-server-side-encryption-customer-key"))
-	assert.Equal(t, "PG4LipwVIkqCKLmpjKFTHQ==", req.HTTPRequest.Header.Get("x-amz-server-side-encryption-customer-key-md5"))
-	assert.Equal(t, "PG4LipwVIkqCKLmpjKFTHQ==", req.HTTPRequest.Header.Get("x-amz-copy-source-server-side-encryption-customer-key-md5"))
+	assert.Equal(t, testSSEKeyB64, req.HTTPRequest.Header.Get("x-amz-server-side-encryption-customer-key"))
+	assert.Equal(t, testCopySSEKeyB64, req.HTTPRequest.Header.Get("x-amz-copy-source-server-side-encryption-customer-key"))
+	assert.Equal(t, testSSEKeyMD5, req.HTTPRequest.Header.Get("x-amz-server-side-encryption-customer-key-md5"))
+	assert.Equal(t, testCopySSEKeyMD5, req.HTTPRequest.Header.Get("x-amz-copy-source-server-side-encryption-customer-key-md5"))
 }
 
 func TestComputeSSEKeysShortcircuit(t *testing.T) {
@@ -64,16 +80,136 @@ func TestComputeSSEKeysShortcircuit(t *testing.T) {
 		Bucket:                      aws.String("bucket"),
 		CopySource:                  aws.String("bucket/source"),
 		Key:                         aws.String("dest"),
-		SSECustomerKey:              aws.String("key"),
-		CopySourceSSECustomerKey:    aws.String("key"),
+		SSECustomerKey:              aws.String(testSSEKey),
+		CopySourceSSECustomerKey:    aws.String(testSSEKey),
 		SSECustomerKeyMD5:           aws.String("MD5"),
 		CopySourceSSECustomerKeyMD5: aws.String("MD5"),
 	})
 	err := req.Build()
 
 	assert.NoError(t, err)
-	assert.Equal(t, "a2V5", req.HTTPRequest.Header.Get("x-amz-server-side-encryption-customer-key"))
-	assert.Equal(t, "a2V5", req.HTTPRequest.Header.Get("x-amz-copy-source-server-side-encryption-customer-key"))
+	assert.Equal(t, testSSEKeyB64, req.HTTPRequest.Header.Get("x-amz-server-side-encryption-customer-key"))
+	assert.Equal(t, testSSEKeyB64, req.HTTPRequest.Header.Get("x-amz-copy-source-server-side-encryption-customer-key"))
 	assert.Equal(t, "MD5", req.HTTPRequest.Header.Get("x-amz-server-side-encryption-customer-key-md5"))
 	assert.Equal(t, "MD5", req.HTTPRequest.Header.Get("x-amz-copy-source-server-side-encryption-customer-key-md5"))
 }
+
+func TestComputeSSEKeysOperations(t *testing.T) {
+	s := s3.New(unit.Session)
+	cases := map[string]*request.Request{}
+	cases["GetObject"], _ = s.GetObjectRequest(&s3.GetObjectInput{
+		Bucket:         aws.String("bucket"),
+		Key:            aws.String("key"),
+		SSECustomerKey: aws.String(testSSEKey),
+	})
+	cases["HeadObject"], _ = s.HeadObjectRequest(&s3.HeadObjectInput{
+		Bucket:         aws.String("bucket"),
+		Key:            aws.String("key"),
+		SSECustomerKey: aws.String(testSSEKeyB64),
+	})
+	cases["PutObject"], _ = s.PutObjectRequest(&s3.PutObjectInput{
+		Bucket:         aws.String("bucket"),
+		Key:            aws.String("key"),
+		SSECustomerKey: aws.String(testSSEKey),
+	})
+	cases["UploadPart"], _ = s.UploadPartRequest(&s3.UploadPartInput{
+		Bucket:         aws.String("bucket"),
+		Key:            aws.String("key"),
+		PartNumber:     aws.Int64(1),
+		UploadId:       aws.String("upload"),
+		SSECustomerKey: aws.String(testSSEKeyB64),
+	})
+
+	for name, req := range cases {
+		assert.NoError(t, req.Build(), name)
+		assert.Equal(t, testSSEKeyB64, req.HTTPRequest.Header.Get("x-amz-server-side-encryption-customer-key"), name)
+		assert.Equal(t, testSSEKeyMD5, req.HTTPRequest.Header.Get("x-amz-server-side-encryption-customer-key-md5"), name)
+	}
+}
+
+func TestComputeSSEKeysInvalidKeyLength(t *testing.T) {
+	cases := map[string]*s3.CopyObjectInput{
+		"short key": {
+			SSECustomerKey: aws.String("key"),
+		},
+		"long key": {
+			SSECustomerKey: aws.String(testSSEKey + "0"),
+		},
+		"short base64 key": {
+			CopySourceSSECustomerKey: aws.String("a2V5"),
+		},
+	}
+
+	for name, in := range cases {
+		var sent bool
+		s := s3.New(unit.Session)
+		s.Handlers.Send.Clear()
+		s.Handlers.Send.PushBack(func(r *request.Request) {
+			sent = true
+		})
+
+		in.Bucket = aws.String("bucket")
+		in.CopySource = aws.String("bucket/source")
+		in.Key = aws.String("dest")
+		req, _ := s.CopyObjectRequest(in)
+		err := req.Send()
+
+		assert.Error(t, err, name)
+		assert.Equal(t, request.InvalidParameterErrCode, err.(awserr.Error).Code(), name)
+		assert.False(t, sent, name)
+	}
+}
+
+func TestComputeSSEKeysPresign(t *testing.T) {
+	s := s3.New(unit.Session)
+	req, _ := s.GetObjectRequest(&s3.GetObjectInput{
+		Bucket:         aws.String("bucket"),
+		Key:            aws.String("key"),
+		SSECustomerKey: aws.String(testSSEKey),
+	})
+	u, headers, err := req.PresignRequest(15 * time.Minute)
+
+	assert.NoError(t, err)
+	assert.Contains(t, u, "X-Amz-SignedHeaders=host%3Bx-amz-server-side-encryption-customer-key%3Bx-amz-server-side-encryption-customer-key-md5")
+	assert.Equal(t, []string{testSSEKeyB64}, headers["x-amz-server-side-encryption-customer-key"])
+	assert.Equal(t, []string{testSSEKeyMD5}, headers["x-amz-server-side-encryption-customer-key-md5"])
+}
+
+func TestSSEKeysRedactedFromLog(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-amz-server-side-encryption-aws-kms-key-id", "kms-key-id")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var logged bytes.Buffer
+	s := s3.New(unit.Session, &aws.Config{
+		Endpoint:         aws.String(server.URL),
+		S3ForcePathStyle: aws.Bool(true),
+		HTTPClient: &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}},
+		LogLevel: aws.LogLevel(aws.LogDebugWithHTTPBody),
+		Logger: aws.LoggerFunc(func(args ...interface{}) {
+			fmt.Fprint(&logged, args...)
+		}),
+	})
+
+	resp, err := s.GetObject(&s3.GetObjectInput{
+		Bucket:         aws.String("bucket"),
+		Key:            aws.String("key"),
+		SSECustomerKey: aws.String(testSSEKey),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "kms-key-id", aws.StringValue(resp.SSEKMSKeyId); e != a {
+		t.Errorf("expect %v key id, got %v", e, a)
+	}
+
+	for _, v := range []string{testSSEKeyB64, "kms-key-id"} {
+		if strings.Contains(logged.String(), v) {
+			t.Errorf("expect %v not to be logged, got %v", v, logged.String())
+		}
+	}
+}
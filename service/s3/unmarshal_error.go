@@ -17,6 +17,10 @@ type xmlErrorResponse struct {
 	XMLName xml.Name `xml:"Error"`
 	Code    string   `xml:"Code"`
 	Message string   `xml:"Message"`
+
+	// The region of the bucket, returned by redirect and malformed
+	// authorization errors.
+	Region string `xml:"Region"`
 }
 
 func unmarshalError(r *request.Request) {
@@ -25,6 +29,10 @@ func unmarshalError(r *request.Request) {
 
 	hostID := r.HTTPResponse.Header.Get("X-Amz-Id-2")
 
+	// Attempt to parse error from body if it is known
+	resp := &xmlErrorResponse{}
+	err := xml.NewDecoder(r.HTTPResponse.Body).Decode(resp)
+
 	// Bucket exists in a different region, and request needs
 	// to be made to the correct region.
 	if r.HTTPResponse.StatusCode == http.StatusMovedPermanently {
@@ -38,15 +46,13 @@ func unmarshalError(r *request.Request) {
 				r.RequestID,
 			),
 			hostID: hostID,
+			region: resp.Region,
 		}
 		return
 	}
 
 	var errCode, errMsg string
 
-	// Attempt to parse error from body if it is known
-	resp := &xmlErrorResponse{}
-	err := xml.NewDecoder(r.HTTPResponse.Body).Decode(resp)
 	if err != nil && err != io.EOF {
 		errCode = "SerializationError"
 		errMsg = "failed to decode S3 XML error response"
@@ -70,6 +76,7 @@ func unmarshalError(r *request.Request) {
 			r.RequestID,
 		),
 		hostID: hostID,
+		region: resp.Region,
 	}
 }
 
@@ -88,6 +95,9 @@ type requestFailure struct {
 	awserr.RequestFailure
 
 	hostID string
+
+	// The region of the bucket returned by the error, if any.
+	region string
 }
 
 func (r requestFailure) Error() string {
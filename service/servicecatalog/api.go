@@ -5113,6 +5113,21 @@ func (s *AcceptPortfolioShareInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AcceptPortfolioShareInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AcceptPortfolioShareInput"}
+	if s.PortfolioId != nil && len(*s.PortfolioId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PortfolioId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *AcceptPortfolioShareInput) SetAcceptLanguage(v string) *AcceptPortfolioShareInput {
 	s.AcceptLanguage = &v
@@ -5169,6 +5184,24 @@ func (s AccessLevelFilter) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AccessLevelFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AccessLevelFilter"}
+	if s.Key != nil {
+		values := []string{"Account", "Role", "User"}
+		if !request.IsParamEnumValue(*s.Key, values) {
+			invalidParams.Add(request.NewErrParamEnum("Key", *s.Key, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *AccessLevelFilter) SetKey(v string) *AccessLevelFilter {
 	s.Key = &v
@@ -5245,6 +5278,30 @@ func (s *AssociatePrincipalWithPortfolioInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssociatePrincipalWithPortfolioInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociatePrincipalWithPortfolioInput"}
+	if s.PortfolioId != nil && len(*s.PortfolioId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PortfolioId", 100))
+	}
+	if s.PrincipalARN != nil && len(*s.PrincipalARN) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("PrincipalARN", 1000))
+	}
+	if s.PrincipalType != nil {
+		values := []string{"IAM"}
+		if !request.IsParamEnumValue(*s.PrincipalType, values) {
+			invalidParams.Add(request.NewErrParamEnum("PrincipalType", *s.PrincipalType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *AssociatePrincipalWithPortfolioInput) SetAcceptLanguage(v string) *AssociatePrincipalWithPortfolioInput {
 	s.AcceptLanguage = &v
@@ -5346,6 +5403,27 @@ func (s *AssociateProductWithPortfolioInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssociateProductWithPortfolioInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociateProductWithPortfolioInput"}
+	if s.PortfolioId != nil && len(*s.PortfolioId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PortfolioId", 100))
+	}
+	if s.ProductId != nil && len(*s.ProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductId", 100))
+	}
+	if s.SourcePortfolioId != nil && len(*s.SourcePortfolioId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourcePortfolioId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *AssociateProductWithPortfolioInput) SetAcceptLanguage(v string) *AssociateProductWithPortfolioInput {
 	s.AcceptLanguage = &v
@@ -5429,6 +5507,21 @@ func (s *AssociateTagOptionWithResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssociateTagOptionWithResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociateTagOptionWithResourceInput"}
+	if s.TagOptionId != nil && len(*s.TagOptionId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagOptionId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *AssociateTagOptionWithResourceInput) SetResourceId(v string) *AssociateTagOptionWithResourceInput {
 	s.ResourceId = &v
@@ -5617,6 +5710,30 @@ func (s *CopyProductInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CopyProductInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CopyProductInput"}
+	if s.IdempotencyToken != nil && len(*s.IdempotencyToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdempotencyToken", 128))
+	}
+	if s.IdempotencyToken != nil && !request.MatchParamPattern("[a-zA-Z0-9][a-zA-Z0-9_-]*", *s.IdempotencyToken) {
+		invalidParams.Add(request.NewErrParamPattern("IdempotencyToken", "[a-zA-Z0-9][a-zA-Z0-9_-]*"))
+	}
+	if s.SourceProductArn != nil && len(*s.SourceProductArn) > 1224 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceProductArn", 1224))
+	}
+	if s.TargetProductId != nil && len(*s.TargetProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TargetProductId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *CopyProductInput) SetAcceptLanguage(v string) *CopyProductInput {
 	s.AcceptLanguage = &v
@@ -5783,6 +5900,36 @@ func (s *CreateConstraintInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateConstraintInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateConstraintInput"}
+	if s.Description != nil && len(*s.Description) > 2000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2000))
+	}
+	if s.IdempotencyToken != nil && len(*s.IdempotencyToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdempotencyToken", 128))
+	}
+	if s.IdempotencyToken != nil && !request.MatchParamPattern("[a-zA-Z0-9][a-zA-Z0-9_-]*", *s.IdempotencyToken) {
+		invalidParams.Add(request.NewErrParamPattern("IdempotencyToken", "[a-zA-Z0-9][a-zA-Z0-9_-]*"))
+	}
+	if s.PortfolioId != nil && len(*s.PortfolioId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PortfolioId", 100))
+	}
+	if s.ProductId != nil && len(*s.ProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductId", 100))
+	}
+	if s.Type != nil && len(*s.Type) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Type", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *CreateConstraintInput) SetAcceptLanguage(v string) *CreateConstraintInput {
 	s.AcceptLanguage = &v
@@ -5952,6 +6099,46 @@ func (s *CreatePortfolioInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePortfolioInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePortfolioInput"}
+	if s.Description != nil && len(*s.Description) > 2000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2000))
+	}
+	if s.DisplayName != nil && len(*s.DisplayName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DisplayName", 100))
+	}
+	if s.IdempotencyToken != nil && len(*s.IdempotencyToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdempotencyToken", 128))
+	}
+	if s.IdempotencyToken != nil && !request.MatchParamPattern("[a-zA-Z0-9][a-zA-Z0-9_-]*", *s.IdempotencyToken) {
+		invalidParams.Add(request.NewErrParamPattern("IdempotencyToken", "[a-zA-Z0-9][a-zA-Z0-9_-]*"))
+	}
+	if s.ProviderName != nil && len(*s.ProviderName) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProviderName", 20))
+	}
+	if s.Tags != nil && len(s.Tags) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 20))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *CreatePortfolioInput) SetAcceptLanguage(v string) *CreatePortfolioInput {
 	s.AcceptLanguage = &v
@@ -6074,6 +6261,24 @@ func (s *CreatePortfolioShareInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePortfolioShareInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePortfolioShareInput"}
+	if s.AccountId != nil && !request.MatchParamPattern("^[0-9]{12}$", *s.AccountId) {
+		invalidParams.Add(request.NewErrParamPattern("AccountId", "^[0-9]{12}$"))
+	}
+	if s.PortfolioId != nil && len(*s.PortfolioId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PortfolioId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *CreatePortfolioShareInput) SetAcceptLanguage(v string) *CreatePortfolioShareInput {
 	s.AcceptLanguage = &v
@@ -6219,6 +6424,48 @@ func (s *CreateProductInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateProductInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateProductInput"}
+	if s.IdempotencyToken != nil && len(*s.IdempotencyToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdempotencyToken", 128))
+	}
+	if s.IdempotencyToken != nil && !request.MatchParamPattern("[a-zA-Z0-9][a-zA-Z0-9_-]*", *s.IdempotencyToken) {
+		invalidParams.Add(request.NewErrParamPattern("IdempotencyToken", "[a-zA-Z0-9][a-zA-Z0-9_-]*"))
+	}
+	if s.ProductType != nil {
+		values := []string{"CLOUD_FORMATION_TEMPLATE", "MARKETPLACE"}
+		if !request.IsParamEnumValue(*s.ProductType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ProductType", *s.ProductType, values))
+		}
+	}
+	if s.Tags != nil && len(s.Tags) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 20))
+	}
+	if s.ProvisioningArtifactParameters != nil {
+		if err := s.ProvisioningArtifactParameters.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ProvisioningArtifactParameters", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *CreateProductInput) SetAcceptLanguage(v string) *CreateProductInput {
 	s.AcceptLanguage = &v
@@ -6404,6 +6651,32 @@ func (s *CreateProvisioningArtifactInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateProvisioningArtifactInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateProvisioningArtifactInput"}
+	if s.IdempotencyToken != nil && len(*s.IdempotencyToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("IdempotencyToken", 128))
+	}
+	if s.IdempotencyToken != nil && !request.MatchParamPattern("[a-zA-Z0-9][a-zA-Z0-9_-]*", *s.IdempotencyToken) {
+		invalidParams.Add(request.NewErrParamPattern("IdempotencyToken", "[a-zA-Z0-9][a-zA-Z0-9_-]*"))
+	}
+	if s.ProductId != nil && len(*s.ProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductId", 100))
+	}
+	if s.Parameters != nil {
+		if err := s.Parameters.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Parameters", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *CreateProvisioningArtifactInput) SetAcceptLanguage(v string) *CreateProvisioningArtifactInput {
 	s.AcceptLanguage = &v
@@ -6517,6 +6790,30 @@ func (s *CreateTagOptionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateTagOptionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTagOptionInput"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+	if s.Value != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Value) {
+		invalidParams.Add(request.NewErrParamPattern("Value", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *CreateTagOptionInput) SetKey(v string) *CreateTagOptionInput {
 	s.Key = &v
@@ -6598,6 +6895,21 @@ func (s *DeleteConstraintInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteConstraintInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteConstraintInput"}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DeleteConstraintInput) SetAcceptLanguage(v string) *DeleteConstraintInput {
 	s.AcceptLanguage = &v
@@ -6670,6 +6982,21 @@ func (s *DeletePortfolioInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeletePortfolioInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePortfolioInput"}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DeletePortfolioInput) SetAcceptLanguage(v string) *DeletePortfolioInput {
 	s.AcceptLanguage = &v
@@ -6750,6 +7077,24 @@ func (s *DeletePortfolioShareInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeletePortfolioShareInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePortfolioShareInput"}
+	if s.AccountId != nil && !request.MatchParamPattern("^[0-9]{12}$", *s.AccountId) {
+		invalidParams.Add(request.NewErrParamPattern("AccountId", "^[0-9]{12}$"))
+	}
+	if s.PortfolioId != nil && len(*s.PortfolioId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PortfolioId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DeletePortfolioShareInput) SetAcceptLanguage(v string) *DeletePortfolioShareInput {
 	s.AcceptLanguage = &v
@@ -6828,6 +7173,21 @@ func (s *DeleteProductInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteProductInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteProductInput"}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DeleteProductInput) SetAcceptLanguage(v string) *DeleteProductInput {
 	s.AcceptLanguage = &v
@@ -6912,6 +7272,24 @@ func (s *DeleteProvisioningArtifactInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteProvisioningArtifactInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteProvisioningArtifactInput"}
+	if s.ProductId != nil && len(*s.ProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductId", 100))
+	}
+	if s.ProvisioningArtifactId != nil && len(*s.ProvisioningArtifactId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProvisioningArtifactId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DeleteProvisioningArtifactInput) SetAcceptLanguage(v string) *DeleteProvisioningArtifactInput {
 	s.AcceptLanguage = &v
@@ -6990,6 +7368,21 @@ func (s *DescribeConstraintInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeConstraintInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeConstraintInput"}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DescribeConstraintInput) SetAcceptLanguage(v string) *DescribeConstraintInput {
 	s.AcceptLanguage = &v
@@ -7089,6 +7482,21 @@ func (s *DescribeCopyProductStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeCopyProductStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeCopyProductStatusInput"}
+	if s.CopyProductToken != nil && len(*s.CopyProductToken) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("CopyProductToken", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DescribeCopyProductStatusInput) SetAcceptLanguage(v string) *DescribeCopyProductStatusInput {
 	s.AcceptLanguage = &v
@@ -7188,6 +7596,21 @@ func (s *DescribePortfolioInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribePortfolioInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribePortfolioInput"}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DescribePortfolioInput) SetAcceptLanguage(v string) *DescribePortfolioInput {
 	s.AcceptLanguage = &v
@@ -7287,6 +7710,21 @@ func (s *DescribeProductAsAdminInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeProductAsAdminInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeProductAsAdminInput"}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DescribeProductAsAdminInput) SetAcceptLanguage(v string) *DescribeProductAsAdminInput {
 	s.AcceptLanguage = &v
@@ -7395,6 +7833,21 @@ func (s *DescribeProductInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeProductInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeProductInput"}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DescribeProductInput) SetAcceptLanguage(v string) *DescribeProductInput {
 	s.AcceptLanguage = &v
@@ -7486,6 +7939,21 @@ func (s *DescribeProductViewInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeProductViewInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeProductViewInput"}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DescribeProductViewInput) SetAcceptLanguage(v string) *DescribeProductViewInput {
 	s.AcceptLanguage = &v
@@ -7577,6 +8045,21 @@ func (s *DescribeProvisionedProductInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeProvisionedProductInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeProvisionedProductInput"}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DescribeProvisionedProductInput) SetAcceptLanguage(v string) *DescribeProvisionedProductInput {
 	s.AcceptLanguage = &v
@@ -7673,6 +8156,24 @@ func (s *DescribeProvisioningArtifactInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeProvisioningArtifactInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeProvisioningArtifactInput"}
+	if s.ProductId != nil && len(*s.ProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductId", 100))
+	}
+	if s.ProvisioningArtifactId != nil && len(*s.ProvisioningArtifactId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProvisioningArtifactId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DescribeProvisioningArtifactInput) SetAcceptLanguage(v string) *DescribeProvisioningArtifactInput {
 	s.AcceptLanguage = &v
@@ -7804,6 +8305,27 @@ func (s *DescribeProvisioningParametersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeProvisioningParametersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeProvisioningParametersInput"}
+	if s.PathId != nil && len(*s.PathId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PathId", 100))
+	}
+	if s.ProductId != nil && len(*s.ProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductId", 100))
+	}
+	if s.ProvisioningArtifactId != nil && len(*s.ProvisioningArtifactId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProvisioningArtifactId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DescribeProvisioningParametersInput) SetAcceptLanguage(v string) *DescribeProvisioningParametersInput {
 	s.AcceptLanguage = &v
@@ -7937,6 +8459,24 @@ func (s *DescribeRecordInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeRecordInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeRecordInput"}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+	if s.PageSize != nil && *s.PageSize > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DescribeRecordInput) SetAcceptLanguage(v string) *DescribeRecordInput {
 	s.AcceptLanguage = &v
@@ -8042,6 +8582,21 @@ func (s *DescribeTagOptionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeTagOptionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeTagOptionInput"}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *DescribeTagOptionInput) SetId(v string) *DescribeTagOptionInput {
 	s.Id = &v
@@ -8128,6 +8683,24 @@ func (s *DisassociatePrincipalFromPortfolioInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisassociatePrincipalFromPortfolioInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisassociatePrincipalFromPortfolioInput"}
+	if s.PortfolioId != nil && len(*s.PortfolioId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PortfolioId", 100))
+	}
+	if s.PrincipalARN != nil && len(*s.PrincipalARN) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("PrincipalARN", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DisassociatePrincipalFromPortfolioInput) SetAcceptLanguage(v string) *DisassociatePrincipalFromPortfolioInput {
 	s.AcceptLanguage = &v
@@ -8217,6 +8790,24 @@ func (s *DisassociateProductFromPortfolioInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisassociateProductFromPortfolioInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisassociateProductFromPortfolioInput"}
+	if s.PortfolioId != nil && len(*s.PortfolioId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PortfolioId", 100))
+	}
+	if s.ProductId != nil && len(*s.ProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *DisassociateProductFromPortfolioInput) SetAcceptLanguage(v string) *DisassociateProductFromPortfolioInput {
 	s.AcceptLanguage = &v
@@ -8294,6 +8885,21 @@ func (s *DisassociateTagOptionFromResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisassociateTagOptionFromResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisassociateTagOptionFromResourceInput"}
+	if s.TagOptionId != nil && len(*s.TagOptionId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagOptionId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *DisassociateTagOptionFromResourceInput) SetResourceId(v string) *DisassociateTagOptionFromResourceInput {
 	s.ResourceId = &v
@@ -8407,6 +9013,21 @@ func (s ListAcceptedPortfolioSharesInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAcceptedPortfolioSharesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAcceptedPortfolioSharesInput"}
+	if s.PageSize != nil && *s.PageSize > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *ListAcceptedPortfolioSharesInput) SetAcceptLanguage(v string) *ListAcceptedPortfolioSharesInput {
 	s.AcceptLanguage = &v
@@ -8519,6 +9140,27 @@ func (s *ListConstraintsForPortfolioInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListConstraintsForPortfolioInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListConstraintsForPortfolioInput"}
+	if s.PageSize != nil && *s.PageSize > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 20))
+	}
+	if s.PortfolioId != nil && len(*s.PortfolioId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PortfolioId", 100))
+	}
+	if s.ProductId != nil && len(*s.ProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *ListConstraintsForPortfolioInput) SetAcceptLanguage(v string) *ListConstraintsForPortfolioInput {
 	s.AcceptLanguage = &v
@@ -8638,6 +9280,24 @@ func (s *ListLaunchPathsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListLaunchPathsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListLaunchPathsInput"}
+	if s.PageSize != nil && *s.PageSize > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 20))
+	}
+	if s.ProductId != nil && len(*s.ProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *ListLaunchPathsInput) SetAcceptLanguage(v string) *ListLaunchPathsInput {
 	s.AcceptLanguage = &v
@@ -8741,6 +9401,21 @@ func (s *ListPortfolioAccessInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPortfolioAccessInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPortfolioAccessInput"}
+	if s.PortfolioId != nil && len(*s.PortfolioId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PortfolioId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *ListPortfolioAccessInput) SetAcceptLanguage(v string) *ListPortfolioAccessInput {
 	s.AcceptLanguage = &v
@@ -8841,6 +9516,24 @@ func (s *ListPortfoliosForProductInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPortfoliosForProductInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPortfoliosForProductInput"}
+	if s.PageSize != nil && *s.PageSize > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 20))
+	}
+	if s.ProductId != nil && len(*s.ProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *ListPortfoliosForProductInput) SetAcceptLanguage(v string) *ListPortfoliosForProductInput {
 	s.AcceptLanguage = &v
@@ -8932,6 +9625,21 @@ func (s ListPortfoliosInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPortfoliosInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPortfoliosInput"}
+	if s.PageSize != nil && *s.PageSize > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *ListPortfoliosInput) SetAcceptLanguage(v string) *ListPortfoliosInput {
 	s.AcceptLanguage = &v
@@ -9038,6 +9746,24 @@ func (s *ListPrincipalsForPortfolioInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListPrincipalsForPortfolioInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListPrincipalsForPortfolioInput"}
+	if s.PageSize != nil && *s.PageSize > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 20))
+	}
+	if s.PortfolioId != nil && len(*s.PortfolioId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PortfolioId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *ListPrincipalsForPortfolioInput) SetAcceptLanguage(v string) *ListPrincipalsForPortfolioInput {
 	s.AcceptLanguage = &v
@@ -9141,6 +9867,21 @@ func (s *ListProvisioningArtifactsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListProvisioningArtifactsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListProvisioningArtifactsInput"}
+	if s.ProductId != nil && len(*s.ProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *ListProvisioningArtifactsInput) SetAcceptLanguage(v string) *ListProvisioningArtifactsInput {
 	s.AcceptLanguage = &v
@@ -9227,6 +9968,26 @@ func (s ListRecordHistoryInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListRecordHistoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRecordHistoryInput"}
+	if s.PageSize != nil && *s.PageSize > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 20))
+	}
+	if s.AccessLevelFilter != nil {
+		if err := s.AccessLevelFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AccessLevelFilter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *ListRecordHistoryInput) SetAcceptLanguage(v string) *ListRecordHistoryInput {
 	s.AcceptLanguage = &v
@@ -9373,6 +10134,24 @@ func (s *ListResourcesForTagOptionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListResourcesForTagOptionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListResourcesForTagOptionInput"}
+	if s.PageSize != nil && *s.PageSize > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 20))
+	}
+	if s.TagOptionId != nil && len(*s.TagOptionId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagOptionId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPageSize sets the PageSize field's value.
 func (s *ListResourcesForTagOptionInput) SetPageSize(v int64) *ListResourcesForTagOptionInput {
 	s.PageSize = &v
@@ -9456,14 +10235,38 @@ func (s ListTagOptionsFilters) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *ListTagOptionsFilters) Validate() error {
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ListTagOptionsFilters) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagOptionsFilters"}
+	if s.Key != nil && len(*s.Key) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+	}
+	if s.Value != nil && len(*s.Value) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("Value", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagOptionsFilters) ValidateExtended() error {
 	invalidParams := request.ErrInvalidParams{Context: "ListTagOptionsFilters"}
-	if s.Key != nil && len(*s.Key) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Key", 1))
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
 	}
-	if s.Value != nil && len(*s.Value) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("Value", 1))
+	if s.Key != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+	if s.Value != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Value) {
+		invalidParams.Add(request.NewErrParamPattern("Value", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
 	}
 
 	if invalidParams.Len() > 0 {
@@ -9533,6 +10336,26 @@ func (s *ListTagOptionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagOptionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagOptionsInput"}
+	if s.PageSize != nil && *s.PageSize > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 20))
+	}
+	if s.Filters != nil {
+		if err := s.Filters.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filters", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *ListTagOptionsInput) SetFilters(v *ListTagOptionsFilters) *ListTagOptionsInput {
 	s.Filters = v
@@ -10050,6 +10873,65 @@ func (s *ProvisionProductInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ProvisionProductInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ProvisionProductInput"}
+	if s.NotificationArns != nil && len(s.NotificationArns) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("NotificationArns", 5))
+	}
+	if s.PathId != nil && len(*s.PathId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PathId", 100))
+	}
+	if s.ProductId != nil && len(*s.ProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductId", 100))
+	}
+	if s.ProvisionToken != nil && len(*s.ProvisionToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProvisionToken", 128))
+	}
+	if s.ProvisionToken != nil && !request.MatchParamPattern("[a-zA-Z0-9][a-zA-Z0-9_-]*", *s.ProvisionToken) {
+		invalidParams.Add(request.NewErrParamPattern("ProvisionToken", "[a-zA-Z0-9][a-zA-Z0-9_-]*"))
+	}
+	if s.ProvisionedProductName != nil && len(*s.ProvisionedProductName) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProvisionedProductName", 128))
+	}
+	if s.ProvisionedProductName != nil && !request.MatchParamPattern("[a-zA-Z0-9][a-zA-Z0-9._-]*", *s.ProvisionedProductName) {
+		invalidParams.Add(request.NewErrParamPattern("ProvisionedProductName", "[a-zA-Z0-9][a-zA-Z0-9._-]*"))
+	}
+	if s.ProvisioningArtifactId != nil && len(*s.ProvisioningArtifactId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProvisioningArtifactId", 100))
+	}
+	if s.Tags != nil && len(s.Tags) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tags", 50))
+	}
+	if s.ProvisioningParameters != nil {
+		for i, v := range s.ProvisioningParameters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ProvisioningParameters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *ProvisionProductInput) SetAcceptLanguage(v string) *ProvisionProductInput {
 	s.AcceptLanguage = &v
@@ -10492,6 +11374,27 @@ func (s *ProvisioningArtifactProperties) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ProvisioningArtifactProperties) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ProvisioningArtifactProperties"}
+	if s.Info != nil && len(s.Info) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Info", 100))
+	}
+	if s.Type != nil {
+		values := []string{"CLOUD_FORMATION_TEMPLATE", "MARKETPLACE_AMI", "MARKETPLACE_CAR"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *ProvisioningArtifactProperties) SetDescription(v string) *ProvisioningArtifactProperties {
 	s.Description = &v
@@ -10614,6 +11517,24 @@ func (s *ProvisioningParameter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ProvisioningParameter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ProvisioningParameter"}
+	if s.Key != nil && len(*s.Key) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 1000))
+	}
+	if s.Value != nil && len(*s.Value) > 4096 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 4096))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *ProvisioningParameter) SetKey(v string) *ProvisioningParameter {
 	s.Key = &v
@@ -10930,6 +11851,21 @@ func (s *RejectPortfolioShareInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RejectPortfolioShareInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RejectPortfolioShareInput"}
+	if s.PortfolioId != nil && len(*s.PortfolioId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PortfolioId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *RejectPortfolioShareInput) SetAcceptLanguage(v string) *RejectPortfolioShareInput {
 	s.AcceptLanguage = &v
@@ -11055,6 +11991,26 @@ func (s ScanProvisionedProductsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ScanProvisionedProductsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ScanProvisionedProductsInput"}
+	if s.PageSize != nil && *s.PageSize > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 20))
+	}
+	if s.AccessLevelFilter != nil {
+		if err := s.AccessLevelFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AccessLevelFilter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *ScanProvisionedProductsInput) SetAcceptLanguage(v string) *ScanProvisionedProductsInput {
 	s.AcceptLanguage = &v
@@ -11176,6 +12132,42 @@ func (s *SearchProductsAsAdminInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SearchProductsAsAdminInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SearchProductsAsAdminInput"}
+	if s.PageSize != nil && *s.PageSize > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 20))
+	}
+	if s.PortfolioId != nil && len(*s.PortfolioId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PortfolioId", 100))
+	}
+	if s.ProductSource != nil {
+		values := []string{"ACCOUNT"}
+		if !request.IsParamEnumValue(*s.ProductSource, values) {
+			invalidParams.Add(request.NewErrParamEnum("ProductSource", *s.ProductSource, values))
+		}
+	}
+	if s.SortBy != nil {
+		values := []string{"Title", "VersionCount", "CreationDate"}
+		if !request.IsParamEnumValue(*s.SortBy, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortBy", *s.SortBy, values))
+		}
+	}
+	if s.SortOrder != nil {
+		values := []string{"ASCENDING", "DESCENDING"}
+		if !request.IsParamEnumValue(*s.SortOrder, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortOrder", *s.SortOrder, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *SearchProductsAsAdminInput) SetAcceptLanguage(v string) *SearchProductsAsAdminInput {
 	s.AcceptLanguage = &v
@@ -11302,6 +12294,33 @@ func (s SearchProductsInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SearchProductsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SearchProductsInput"}
+	if s.PageSize != nil && *s.PageSize > 20 {
+		invalidParams.Add(request.NewErrParamMaxValue("PageSize", 20))
+	}
+	if s.SortBy != nil {
+		values := []string{"Title", "VersionCount", "CreationDate"}
+		if !request.IsParamEnumValue(*s.SortBy, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortBy", *s.SortBy, values))
+		}
+	}
+	if s.SortOrder != nil {
+		values := []string{"ASCENDING", "DESCENDING"}
+		if !request.IsParamEnumValue(*s.SortOrder, values) {
+			invalidParams.Add(request.NewErrParamEnum("SortOrder", *s.SortOrder, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *SearchProductsInput) SetAcceptLanguage(v string) *SearchProductsInput {
 	s.AcceptLanguage = &v
@@ -11430,6 +12449,30 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+	if s.Value != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Value) {
+		invalidParams.Add(request.NewErrParamPattern("Value", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -11594,6 +12637,30 @@ func (s *TerminateProvisionedProductInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TerminateProvisionedProductInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TerminateProvisionedProductInput"}
+	if s.ProvisionedProductId != nil && len(*s.ProvisionedProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProvisionedProductId", 100))
+	}
+	if s.ProvisionedProductName != nil && len(*s.ProvisionedProductName) > 1224 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProvisionedProductName", 1224))
+	}
+	if s.TerminateToken != nil && len(*s.TerminateToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("TerminateToken", 128))
+	}
+	if s.TerminateToken != nil && !request.MatchParamPattern("[a-zA-Z0-9][a-zA-Z0-9_-]*", *s.TerminateToken) {
+		invalidParams.Add(request.NewErrParamPattern("TerminateToken", "[a-zA-Z0-9][a-zA-Z0-9_-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *TerminateProvisionedProductInput) SetAcceptLanguage(v string) *TerminateProvisionedProductInput {
 	s.AcceptLanguage = &v
@@ -11699,6 +12766,24 @@ func (s *UpdateConstraintInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateConstraintInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateConstraintInput"}
+	if s.Description != nil && len(*s.Description) > 2000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2000))
+	}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *UpdateConstraintInput) SetAcceptLanguage(v string) *UpdateConstraintInput {
 	s.AcceptLanguage = &v
@@ -11835,6 +12920,43 @@ func (s *UpdatePortfolioInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdatePortfolioInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdatePortfolioInput"}
+	if s.AddTags != nil && len(s.AddTags) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("AddTags", 20))
+	}
+	if s.Description != nil && len(*s.Description) > 2000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 2000))
+	}
+	if s.DisplayName != nil && len(*s.DisplayName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("DisplayName", 100))
+	}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+	if s.ProviderName != nil && len(*s.ProviderName) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProviderName", 20))
+	}
+	if s.AddTags != nil {
+		for i, v := range s.AddTags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "AddTags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *UpdatePortfolioInput) SetAcceptLanguage(v string) *UpdatePortfolioInput {
 	s.AcceptLanguage = &v
@@ -11992,6 +13114,34 @@ func (s *UpdateProductInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateProductInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateProductInput"}
+	if s.AddTags != nil && len(s.AddTags) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("AddTags", 20))
+	}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+	if s.AddTags != nil {
+		for i, v := range s.AddTags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "AddTags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *UpdateProductInput) SetAcceptLanguage(v string) *UpdateProductInput {
 	s.AcceptLanguage = &v
@@ -12185,6 +13335,49 @@ func (s *UpdateProvisionedProductInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateProvisionedProductInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateProvisionedProductInput"}
+	if s.PathId != nil && len(*s.PathId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("PathId", 100))
+	}
+	if s.ProductId != nil && len(*s.ProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductId", 100))
+	}
+	if s.ProvisionedProductId != nil && len(*s.ProvisionedProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProvisionedProductId", 100))
+	}
+	if s.ProvisionedProductName != nil && len(*s.ProvisionedProductName) > 1224 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProvisionedProductName", 1224))
+	}
+	if s.ProvisioningArtifactId != nil && len(*s.ProvisioningArtifactId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProvisioningArtifactId", 100))
+	}
+	if s.UpdateToken != nil && len(*s.UpdateToken) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("UpdateToken", 128))
+	}
+	if s.UpdateToken != nil && !request.MatchParamPattern("[a-zA-Z0-9][a-zA-Z0-9_-]*", *s.UpdateToken) {
+		invalidParams.Add(request.NewErrParamPattern("UpdateToken", "[a-zA-Z0-9][a-zA-Z0-9_-]*"))
+	}
+	if s.ProvisioningParameters != nil {
+		for i, v := range s.ProvisioningParameters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ProvisioningParameters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *UpdateProvisionedProductInput) SetAcceptLanguage(v string) *UpdateProvisionedProductInput {
 	s.AcceptLanguage = &v
@@ -12323,6 +13516,24 @@ func (s *UpdateProvisioningArtifactInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateProvisioningArtifactInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateProvisioningArtifactInput"}
+	if s.ProductId != nil && len(*s.ProductId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProductId", 100))
+	}
+	if s.ProvisioningArtifactId != nil && len(*s.ProvisioningArtifactId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProvisioningArtifactId", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAcceptLanguage sets the AcceptLanguage field's value.
 func (s *UpdateProvisioningArtifactInput) SetAcceptLanguage(v string) *UpdateProvisioningArtifactInput {
 	s.AcceptLanguage = &v
@@ -12437,6 +13648,24 @@ func (s *UpdateProvisioningParameter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateProvisioningParameter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateProvisioningParameter"}
+	if s.Key != nil && len(*s.Key) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 1000))
+	}
+	if s.Value != nil && len(*s.Value) > 4096 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 4096))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *UpdateProvisioningParameter) SetKey(v string) *UpdateProvisioningParameter {
 	s.Key = &v
@@ -12500,6 +13729,27 @@ func (s *UpdateTagOptionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateTagOptionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateTagOptionInput"}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+	if s.Value != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Value) {
+		invalidParams.Add(request.NewErrParamPattern("Value", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActive sets the Active field's value.
 func (s *UpdateTagOptionInput) SetActive(v bool) *UpdateTagOptionInput {
 	s.Active = &v
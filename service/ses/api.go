@@ -4960,6 +4960,29 @@ func (s *BouncedRecipientInfo) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *BouncedRecipientInfo) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "BouncedRecipientInfo"}
+	if s.BounceType != nil {
+		values := []string{"DoesNotExist", "MessageTooLarge", "ExceededQuota", "ContentRejected", "Undefined", "TemporaryFailure"}
+		if !request.IsParamEnumValue(*s.BounceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("BounceType", *s.BounceType, values))
+		}
+	}
+	if s.RecipientDsnFields != nil {
+		if err := s.RecipientDsnFields.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RecipientDsnFields", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBounceType sets the BounceType field's value.
 func (s *BouncedRecipientInfo) SetBounceType(v string) *BouncedRecipientInfo {
 	s.BounceType = &v
@@ -5113,6 +5136,28 @@ func (s *CloudWatchDestination) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CloudWatchDestination) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CloudWatchDestination"}
+	if s.DimensionConfigurations != nil {
+		for i, v := range s.DimensionConfigurations {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "DimensionConfigurations", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDimensionConfigurations sets the DimensionConfigurations field's value.
 func (s *CloudWatchDestination) SetDimensionConfigurations(v []*CloudWatchDimensionConfiguration) *CloudWatchDestination {
 	s.DimensionConfigurations = v
@@ -5190,6 +5235,24 @@ func (s *CloudWatchDimensionConfiguration) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CloudWatchDimensionConfiguration) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CloudWatchDimensionConfiguration"}
+	if s.DimensionValueSource != nil {
+		values := []string{"messageTag", "emailHeader", "linkTag"}
+		if !request.IsParamEnumValue(*s.DimensionValueSource, values) {
+			invalidParams.Add(request.NewErrParamEnum("DimensionValueSource", *s.DimensionValueSource, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultDimensionValue sets the DefaultDimensionValue field's value.
 func (s *CloudWatchDimensionConfiguration) SetDefaultDimensionValue(v string) *CloudWatchDimensionConfiguration {
 	s.DefaultDimensionValue = &v
@@ -5364,6 +5427,23 @@ func (s *CreateConfigurationSetEventDestinationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateConfigurationSetEventDestinationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateConfigurationSetEventDestinationInput"}
+	if s.EventDestination != nil {
+		if err := s.EventDestination.ValidateExtended(); err != nil {
+			invalidParams.AddNested("EventDestination", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigurationSetName sets the ConfigurationSetName field's value.
 func (s *CreateConfigurationSetEventDestinationInput) SetConfigurationSetName(v string) *CreateConfigurationSetEventDestinationInput {
 	s.ConfigurationSetName = &v
@@ -5575,6 +5655,23 @@ func (s *CreateReceiptFilterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateReceiptFilterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateReceiptFilterInput"}
+	if s.Filter != nil {
+		if err := s.Filter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Filter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilter sets the Filter field's value.
 func (s *CreateReceiptFilterInput) SetFilter(v *ReceiptFilter) *CreateReceiptFilterInput {
 	s.Filter = v
@@ -5652,6 +5749,23 @@ func (s *CreateReceiptRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateReceiptRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateReceiptRuleInput"}
+	if s.Rule != nil {
+		if err := s.Rule.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Rule", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAfter sets the After field's value.
 func (s *CreateReceiptRuleInput) SetAfter(v string) *CreateReceiptRuleInput {
 	s.After = &v
@@ -6049,6 +6163,21 @@ func (s *DeleteIdentityPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteIdentityPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteIdentityPolicyInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentity sets the Identity field's value.
 func (s *DeleteIdentityPolicyInput) SetIdentity(v string) *DeleteIdentityPolicyInput {
 	s.Identity = &v
@@ -6773,6 +6902,23 @@ func (s *EventDestination) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EventDestination) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EventDestination"}
+	if s.CloudWatchDestination != nil {
+		if err := s.CloudWatchDestination.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CloudWatchDestination", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCloudWatchDestination sets the CloudWatchDestination field's value.
 func (s *EventDestination) SetCloudWatchDestination(v *CloudWatchDestination) *EventDestination {
 	s.CloudWatchDestination = v
@@ -7740,6 +7886,24 @@ func (s *LambdaAction) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LambdaAction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LambdaAction"}
+	if s.InvocationType != nil {
+		values := []string{"Event", "RequestResponse"}
+		if !request.IsParamEnumValue(*s.InvocationType, values) {
+			invalidParams.Add(request.NewErrParamEnum("InvocationType", *s.InvocationType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFunctionArn sets the FunctionArn field's value.
 func (s *LambdaAction) SetFunctionArn(v string) *LambdaAction {
 	s.FunctionArn = &v
@@ -7861,6 +8025,24 @@ func (s ListIdentitiesInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListIdentitiesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListIdentitiesInput"}
+	if s.IdentityType != nil {
+		values := []string{"EmailAddress", "Domain"}
+		if !request.IsParamEnumValue(*s.IdentityType, values) {
+			invalidParams.Add(request.NewErrParamEnum("IdentityType", *s.IdentityType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentityType sets the IdentityType field's value.
 func (s *ListIdentitiesInput) SetIdentityType(v string) *ListIdentitiesInput {
 	s.IdentityType = &v
@@ -8418,6 +8600,21 @@ func (s *PutIdentityPolicyInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutIdentityPolicyInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutIdentityPolicyInput"}
+	if s.PolicyName != nil && len(*s.PolicyName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("PolicyName", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentity sets the Identity field's value.
 func (s *PutIdentityPolicyInput) SetIdentity(v string) *PutIdentityPolicyInput {
 	s.Identity = &v
@@ -8603,6 +8800,33 @@ func (s *ReceiptAction) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReceiptAction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReceiptAction"}
+	if s.LambdaAction != nil {
+		if err := s.LambdaAction.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LambdaAction", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.SNSAction != nil {
+		if err := s.SNSAction.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SNSAction", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.StopAction != nil {
+		if err := s.StopAction.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StopAction", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddHeaderAction sets the AddHeaderAction field's value.
 func (s *ReceiptAction) SetAddHeaderAction(v *AddHeaderAction) *ReceiptAction {
 	s.AddHeaderAction = v
@@ -8704,6 +8928,23 @@ func (s *ReceiptFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReceiptFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReceiptFilter"}
+	if s.IpFilter != nil {
+		if err := s.IpFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("IpFilter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIpFilter sets the IpFilter field's value.
 func (s *ReceiptFilter) SetIpFilter(v *ReceiptIpFilter) *ReceiptFilter {
 	s.IpFilter = v
@@ -8765,6 +9006,24 @@ func (s *ReceiptIpFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReceiptIpFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReceiptIpFilter"}
+	if s.Policy != nil {
+		values := []string{"Block", "Allow"}
+		if !request.IsParamEnumValue(*s.Policy, values) {
+			invalidParams.Add(request.NewErrParamEnum("Policy", *s.Policy, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCidr sets the Cidr field's value.
 func (s *ReceiptIpFilter) SetCidr(v string) *ReceiptIpFilter {
 	s.Cidr = &v
@@ -8860,6 +9119,34 @@ func (s *ReceiptRule) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ReceiptRule) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ReceiptRule"}
+	if s.TlsPolicy != nil {
+		values := []string{"Require", "Optional"}
+		if !request.IsParamEnumValue(*s.TlsPolicy, values) {
+			invalidParams.Add(request.NewErrParamEnum("TlsPolicy", *s.TlsPolicy, values))
+		}
+	}
+	if s.Actions != nil {
+		for i, v := range s.Actions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Actions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActions sets the Actions field's value.
 func (s *ReceiptRule) SetActions(v []*ReceiptAction) *ReceiptRule {
 	s.Actions = v
@@ -9030,6 +9317,24 @@ func (s *RecipientDsnFields) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RecipientDsnFields) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RecipientDsnFields"}
+	if s.Action != nil {
+		values := []string{"failed", "delayed", "delivered", "relayed", "expanded"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *RecipientDsnFields) SetAction(v string) *RecipientDsnFields {
 	s.Action = &v
@@ -9318,6 +9623,24 @@ func (s *SNSAction) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SNSAction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SNSAction"}
+	if s.Encoding != nil {
+		values := []string{"UTF-8", "Base64"}
+		if !request.IsParamEnumValue(*s.Encoding, values) {
+			invalidParams.Add(request.NewErrParamEnum("Encoding", *s.Encoding, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEncoding sets the Encoding field's value.
 func (s *SNSAction) SetEncoding(v string) *SNSAction {
 	s.Encoding = &v
@@ -9462,6 +9785,28 @@ func (s *SendBounceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SendBounceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SendBounceInput"}
+	if s.BouncedRecipientInfoList != nil {
+		for i, v := range s.BouncedRecipientInfoList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "BouncedRecipientInfoList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBounceSender sets the BounceSender field's value.
 func (s *SendBounceInput) SetBounceSender(v string) *SendBounceInput {
 	s.BounceSender = &v
@@ -10262,6 +10607,24 @@ func (s *SetIdentityHeadersInNotificationsEnabledInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetIdentityHeadersInNotificationsEnabledInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetIdentityHeadersInNotificationsEnabledInput"}
+	if s.NotificationType != nil {
+		values := []string{"Bounce", "Complaint", "Delivery"}
+		if !request.IsParamEnumValue(*s.NotificationType, values) {
+			invalidParams.Add(request.NewErrParamEnum("NotificationType", *s.NotificationType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEnabled sets the Enabled field's value.
 func (s *SetIdentityHeadersInNotificationsEnabledInput) SetEnabled(v bool) *SetIdentityHeadersInNotificationsEnabledInput {
 	s.Enabled = &v
@@ -10352,6 +10715,24 @@ func (s *SetIdentityMailFromDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetIdentityMailFromDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetIdentityMailFromDomainInput"}
+	if s.BehaviorOnMXFailure != nil {
+		values := []string{"UseDefaultValue", "RejectMessage"}
+		if !request.IsParamEnumValue(*s.BehaviorOnMXFailure, values) {
+			invalidParams.Add(request.NewErrParamEnum("BehaviorOnMXFailure", *s.BehaviorOnMXFailure, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBehaviorOnMXFailure sets the BehaviorOnMXFailure field's value.
 func (s *SetIdentityMailFromDomainInput) SetBehaviorOnMXFailure(v string) *SetIdentityMailFromDomainInput {
 	s.BehaviorOnMXFailure = &v
@@ -10439,6 +10820,24 @@ func (s *SetIdentityNotificationTopicInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetIdentityNotificationTopicInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetIdentityNotificationTopicInput"}
+	if s.NotificationType != nil {
+		values := []string{"Bounce", "Complaint", "Delivery"}
+		if !request.IsParamEnumValue(*s.NotificationType, values) {
+			invalidParams.Add(request.NewErrParamEnum("NotificationType", *s.NotificationType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIdentity sets the Identity field's value.
 func (s *SetIdentityNotificationTopicInput) SetIdentity(v string) *SetIdentityNotificationTopicInput {
 	s.Identity = &v
@@ -10599,6 +10998,24 @@ func (s *StopAction) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopAction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopAction"}
+	if s.Scope != nil {
+		values := []string{"RuleSet"}
+		if !request.IsParamEnumValue(*s.Scope, values) {
+			invalidParams.Add(request.NewErrParamEnum("Scope", *s.Scope, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetScope sets the Scope field's value.
 func (s *StopAction) SetScope(v string) *StopAction {
 	s.Scope = &v
@@ -10694,6 +11111,23 @@ func (s *UpdateConfigurationSetEventDestinationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateConfigurationSetEventDestinationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateConfigurationSetEventDestinationInput"}
+	if s.EventDestination != nil {
+		if err := s.EventDestination.ValidateExtended(); err != nil {
+			invalidParams.AddNested("EventDestination", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetConfigurationSetName sets the ConfigurationSetName field's value.
 func (s *UpdateConfigurationSetEventDestinationInput) SetConfigurationSetName(v string) *UpdateConfigurationSetEventDestinationInput {
 	s.ConfigurationSetName = &v
@@ -10848,6 +11282,23 @@ func (s *UpdateReceiptRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateReceiptRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateReceiptRuleInput"}
+	if s.Rule != nil {
+		if err := s.Rule.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Rule", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRule sets the Rule field's value.
 func (s *UpdateReceiptRuleInput) SetRule(v *ReceiptRule) *UpdateReceiptRuleInput {
 	s.Rule = v
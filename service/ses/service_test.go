@@ -0,0 +1,46 @@
+package ses_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/service/ses"
+)
+
+// SES's endpoint prefix, signing name, and service ID all differ.
+func TestNew_ServiceMetadata(t *testing.T) {
+	cases := map[string]*aws.Config{
+		"resolved endpoint": aws.NewConfig().WithRegion("us-west-2"),
+		"custom endpoint":   aws.NewConfig().WithEndpoint("https://email.example.com"),
+	}
+
+	for name, cfg := range cases {
+		svc := ses.New(unit.Session, cfg)
+
+		if e, a := "email", svc.ServiceName; e != a {
+			t.Errorf("%s, expect %q service name, got %q", name, e, a)
+		}
+		if e, a := "ses", svc.SigningName; e != a {
+			t.Errorf("%s, expect %q signing name, got %q", name, e, a)
+		}
+		if e, a := "SES", svc.ServiceID; e != a {
+			t.Errorf("%s, expect %q service ID, got %q", name, e, a)
+		}
+
+		req, _ := svc.ListIdentitiesRequest(&ses.ListIdentitiesInput{})
+		if err := req.Sign(); err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := "SES", req.ClientInfo.ServiceID; e != a {
+			t.Errorf("%s, expect %q request service ID, got %q", name, e, a)
+		}
+		if e, a := "/ses/aws4_request", req.HTTPRequest.Header.Get("Authorization"); !strings.Contains(a, e) {
+			t.Errorf("%s, expect %q in authorization header, got %q", name, e, a)
+		}
+		if e, a := "api/SES#2010-12-01", req.HTTPRequest.Header.Get("User-Agent"); !strings.Contains(a, e) {
+			t.Errorf("%s, expect %q in user agent, got %q", name, e, a)
+		}
+	}
+}
@@ -1982,6 +1982,21 @@ func (s *CreateActivityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateActivityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateActivityInput"}
+	if s.Name != nil && len(*s.Name) > 80 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 80))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *CreateActivityInput) SetName(v string) *CreateActivityInput {
 	s.Name = &v
@@ -2084,6 +2099,27 @@ func (s *CreateStateMachineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateStateMachineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateStateMachineInput"}
+	if s.Definition != nil && len(*s.Definition) > 1.048576e+06 {
+		invalidParams.Add(request.NewErrParamMaxLen("Definition", 1.048576e+06))
+	}
+	if s.Name != nil && len(*s.Name) > 80 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 80))
+	}
+	if s.RoleArn != nil && len(*s.RoleArn) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleArn", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefinition sets the Definition field's value.
 func (s *CreateStateMachineInput) SetDefinition(v string) *CreateStateMachineInput {
 	s.Definition = &v
@@ -2175,6 +2211,21 @@ func (s *DeleteActivityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteActivityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteActivityInput"}
+	if s.ActivityArn != nil && len(*s.ActivityArn) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ActivityArn", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActivityArn sets the ActivityArn field's value.
 func (s *DeleteActivityInput) SetActivityArn(v string) *DeleteActivityInput {
 	s.ActivityArn = &v
@@ -2232,6 +2283,21 @@ func (s *DeleteStateMachineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteStateMachineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteStateMachineInput"}
+	if s.StateMachineArn != nil && len(*s.StateMachineArn) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("StateMachineArn", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStateMachineArn sets the StateMachineArn field's value.
 func (s *DeleteStateMachineInput) SetStateMachineArn(v string) *DeleteStateMachineInput {
 	s.StateMachineArn = &v
@@ -2289,6 +2355,21 @@ func (s *DescribeActivityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeActivityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeActivityInput"}
+	if s.ActivityArn != nil && len(*s.ActivityArn) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ActivityArn", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActivityArn sets the ActivityArn field's value.
 func (s *DescribeActivityInput) SetActivityArn(v string) *DescribeActivityInput {
 	s.ActivityArn = &v
@@ -2379,6 +2460,21 @@ func (s *DescribeExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeExecutionInput"}
+	if s.ExecutionArn != nil && len(*s.ExecutionArn) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExecutionArn", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExecutionArn sets the ExecutionArn field's value.
 func (s *DescribeExecutionInput) SetExecutionArn(v string) *DescribeExecutionInput {
 	s.ExecutionArn = &v
@@ -2518,6 +2614,21 @@ func (s *DescribeStateMachineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeStateMachineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeStateMachineInput"}
+	if s.StateMachineArn != nil && len(*s.StateMachineArn) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("StateMachineArn", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStateMachineArn sets the StateMachineArn field's value.
 func (s *DescribeStateMachineInput) SetStateMachineArn(v string) *DescribeStateMachineInput {
 	s.StateMachineArn = &v
@@ -2884,6 +2995,24 @@ func (s *GetActivityTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetActivityTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetActivityTaskInput"}
+	if s.ActivityArn != nil && len(*s.ActivityArn) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ActivityArn", 256))
+	}
+	if s.WorkerName != nil && len(*s.WorkerName) > 80 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkerName", 80))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActivityArn sets the ActivityArn field's value.
 func (s *GetActivityTaskInput) SetActivityArn(v string) *GetActivityTaskInput {
 	s.ActivityArn = &v
@@ -2989,6 +3118,27 @@ func (s *GetExecutionHistoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetExecutionHistoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetExecutionHistoryInput"}
+	if s.ExecutionArn != nil && len(*s.ExecutionArn) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExecutionArn", 256))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExecutionArn sets the ExecutionArn field's value.
 func (s *GetExecutionHistoryInput) SetExecutionArn(v string) *GetExecutionHistoryInput {
 	s.ExecutionArn = &v
@@ -3506,6 +3656,24 @@ func (s *ListActivitiesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListActivitiesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListActivitiesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListActivitiesInput) SetMaxResults(v int64) *ListActivitiesInput {
 	s.MaxResults = &v
@@ -3618,6 +3786,33 @@ func (s *ListExecutionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListExecutionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListExecutionsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+	if s.StateMachineArn != nil && len(*s.StateMachineArn) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("StateMachineArn", 256))
+	}
+	if s.StatusFilter != nil {
+		values := []string{"RUNNING", "SUCCEEDED", "FAILED", "TIMED_OUT", "ABORTED"}
+		if !request.IsParamEnumValue(*s.StatusFilter, values) {
+			invalidParams.Add(request.NewErrParamEnum("StatusFilter", *s.StatusFilter, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListExecutionsInput) SetMaxResults(v int64) *ListExecutionsInput {
 	s.MaxResults = &v
@@ -3726,6 +3921,24 @@ func (s *ListStateMachinesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListStateMachinesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListStateMachinesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 1000))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListStateMachinesInput) SetMaxResults(v int64) *ListStateMachinesInput {
 	s.MaxResults = &v
@@ -3819,6 +4032,27 @@ func (s *SendTaskFailureInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SendTaskFailureInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SendTaskFailureInput"}
+	if s.Cause != nil && len(*s.Cause) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Cause", 32768))
+	}
+	if s.Error != nil && len(*s.Error) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Error", 256))
+	}
+	if s.TaskToken != nil && len(*s.TaskToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCause sets the Cause field's value.
 func (s *SendTaskFailureInput) SetCause(v string) *SendTaskFailureInput {
 	s.Cause = &v
@@ -3889,6 +4123,21 @@ func (s *SendTaskHeartbeatInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SendTaskHeartbeatInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SendTaskHeartbeatInput"}
+	if s.TaskToken != nil && len(*s.TaskToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTaskToken sets the TaskToken field's value.
 func (s *SendTaskHeartbeatInput) SetTaskToken(v string) *SendTaskHeartbeatInput {
 	s.TaskToken = &v
@@ -3955,6 +4204,24 @@ func (s *SendTaskSuccessInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SendTaskSuccessInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SendTaskSuccessInput"}
+	if s.Output != nil && len(*s.Output) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Output", 32768))
+	}
+	if s.TaskToken != nil && len(*s.TaskToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetOutput sets the Output field's value.
 func (s *SendTaskSuccessInput) SetOutput(v string) *SendTaskSuccessInput {
 	s.Output = &v
@@ -4028,6 +4295,27 @@ func (s *StartExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartExecutionInput"}
+	if s.Input != nil && len(*s.Input) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Input", 32768))
+	}
+	if s.Name != nil && len(*s.Name) > 80 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 80))
+	}
+	if s.StateMachineArn != nil && len(*s.StateMachineArn) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("StateMachineArn", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInput sets the Input field's value.
 func (s *StartExecutionInput) SetInput(v string) *StartExecutionInput {
 	s.Input = &v
@@ -4243,6 +4531,27 @@ func (s *StopExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopExecutionInput"}
+	if s.Cause != nil && len(*s.Cause) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Cause", 32768))
+	}
+	if s.Error != nil && len(*s.Error) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Error", 256))
+	}
+	if s.ExecutionArn != nil && len(*s.ExecutionArn) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExecutionArn", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCause sets the Cause field's value.
 func (s *StopExecutionInput) SetCause(v string) *StopExecutionInput {
 	s.Cause = &v
@@ -1005,6 +1005,24 @@ func (s *CreateProtectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateProtectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateProtectionInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[ a-zA-Z0-9_\\\\.\\\\-]*", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[ a-zA-Z0-9_\\\\.\\\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *CreateProtectionInput) SetName(v string) *CreateProtectionInput {
 	s.Name = &v
@@ -1107,6 +1125,24 @@ func (s *DeleteProtectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteProtectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteProtectionInput"}
+	if s.ProtectionId != nil && len(*s.ProtectionId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProtectionId", 36))
+	}
+	if s.ProtectionId != nil && !request.MatchParamPattern("[a-zA-Z0-9\\\\-]*", *s.ProtectionId) {
+		invalidParams.Add(request.NewErrParamPattern("ProtectionId", "[a-zA-Z0-9\\\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetProtectionId sets the ProtectionId field's value.
 func (s *DeleteProtectionInput) SetProtectionId(v string) *DeleteProtectionInput {
 	s.ProtectionId = &v
@@ -1194,6 +1230,24 @@ func (s *DescribeAttackInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAttackInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAttackInput"}
+	if s.AttackId != nil && len(*s.AttackId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("AttackId", 128))
+	}
+	if s.AttackId != nil && !request.MatchParamPattern("[a-zA-Z0-9\\\\-]*", *s.AttackId) {
+		invalidParams.Add(request.NewErrParamPattern("AttackId", "[a-zA-Z0-9\\\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttackId sets the AttackId field's value.
 func (s *DescribeAttackInput) SetAttackId(v string) *DescribeAttackInput {
 	s.AttackId = &v
@@ -1260,6 +1314,24 @@ func (s *DescribeProtectionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeProtectionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeProtectionInput"}
+	if s.ProtectionId != nil && len(*s.ProtectionId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProtectionId", 36))
+	}
+	if s.ProtectionId != nil && !request.MatchParamPattern("[a-zA-Z0-9\\\\-]*", *s.ProtectionId) {
+		invalidParams.Add(request.NewErrParamPattern("ProtectionId", "[a-zA-Z0-9\\\\-]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetProtectionId sets the ProtectionId field's value.
 func (s *DescribeProtectionInput) SetProtectionId(v string) *DescribeProtectionInput {
 	s.ProtectionId = &v
@@ -1375,6 +1447,21 @@ func (s *ListAttacksInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAttacksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAttacksInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEndTime sets the EndTime field's value.
 func (s *ListAttacksInput) SetEndTime(v *TimeRange) *ListAttacksInput {
 	s.EndTime = v
@@ -1477,6 +1564,21 @@ func (s *ListProtectionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListProtectionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListProtectionsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListProtectionsInput) SetMaxResults(v int64) *ListProtectionsInput {
 	s.MaxResults = &v
@@ -32,6 +32,7 @@ var initRequest func(*request.Request)
 const (
 	ServiceName = "sdb"       // Service endpoint prefix API calls made to.
 	EndpointsID = ServiceName // Service ID for Regions and Endpoints metadata.
+	ServiceID   = "SimpleDB"  // ServiceID is a unique identifier of a specific service.
 )
 
 // New creates a new instance of the SimpleDB client with a session.
@@ -56,6 +57,7 @@ func newClient(cfg aws.Config, handlers request.Handlers, endpoint, signingRegio
 			cfg,
 			metadata.ClientInfo{
 				ServiceName:   ServiceName,
+				ServiceID:     ServiceID,
 				SigningName:   signingName,
 				SigningRegion: signingRegion,
 				Endpoint:      endpoint,
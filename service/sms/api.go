@@ -1432,6 +1432,24 @@ func (s *CreateReplicationJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateReplicationJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateReplicationJobInput"}
+	if s.LicenseType != nil {
+		values := []string{"AWS", "BYOL"}
+		if !request.IsParamEnumValue(*s.LicenseType, values) {
+			invalidParams.Add(request.NewErrParamEnum("LicenseType", *s.LicenseType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *CreateReplicationJobInput) SetDescription(v string) *CreateReplicationJobInput {
 	s.Description = &v
@@ -2403,6 +2421,24 @@ func (s *UpdateReplicationJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateReplicationJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateReplicationJobInput"}
+	if s.LicenseType != nil {
+		values := []string{"AWS", "BYOL"}
+		if !request.IsParamEnumValue(*s.LicenseType, values) {
+			invalidParams.Add(request.NewErrParamEnum("LicenseType", *s.LicenseType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *UpdateReplicationJobInput) SetDescription(v string) *UpdateReplicationJobInput {
 	s.Description = &v
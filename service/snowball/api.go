@@ -1748,6 +1748,24 @@ func (s *Address) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Address) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Address"}
+	if s.AddressId != nil && len(*s.AddressId) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("AddressId", 40))
+	}
+	if s.AddressId != nil && !request.MatchParamPattern("ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.AddressId) {
+		invalidParams.Add(request.NewErrParamPattern("AddressId", "ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddressId sets the AddressId field's value.
 func (s *Address) SetAddressId(v string) *Address {
 	s.AddressId = &v
@@ -1869,6 +1887,24 @@ func (s *CancelClusterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CancelClusterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelClusterInput"}
+	if s.ClusterId != nil && len(*s.ClusterId) > 39 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClusterId", 39))
+	}
+	if s.ClusterId != nil && !request.MatchParamPattern("CID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.ClusterId) {
+		invalidParams.Add(request.NewErrParamPattern("ClusterId", "CID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClusterId sets the ClusterId field's value.
 func (s *CancelClusterInput) SetClusterId(v string) *CancelClusterInput {
 	s.ClusterId = &v
@@ -1927,6 +1963,24 @@ func (s *CancelJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CancelJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelJobInput"}
+	if s.JobId != nil && len(*s.JobId) > 39 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobId", 39))
+	}
+	if s.JobId != nil && !request.MatchParamPattern("(M|J)ID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.JobId) {
+		invalidParams.Add(request.NewErrParamPattern("JobId", "(M|J)ID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobId sets the JobId field's value.
 func (s *CancelJobInput) SetJobId(v string) *CancelJobInput {
 	s.JobId = &v
@@ -2197,6 +2251,23 @@ func (s *CreateAddressInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAddressInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAddressInput"}
+	if s.Address != nil {
+		if err := s.Address.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Address", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddress sets the Address field's value.
 func (s *CreateAddressInput) SetAddress(v *Address) *CreateAddressInput {
 	s.Address = v
@@ -2348,6 +2419,70 @@ func (s *CreateClusterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateClusterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateClusterInput"}
+	if s.AddressId != nil && len(*s.AddressId) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("AddressId", 40))
+	}
+	if s.AddressId != nil && !request.MatchParamPattern("ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.AddressId) {
+		invalidParams.Add(request.NewErrParamPattern("AddressId", "ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.ForwardingAddressId != nil && len(*s.ForwardingAddressId) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ForwardingAddressId", 40))
+	}
+	if s.ForwardingAddressId != nil && !request.MatchParamPattern("ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.ForwardingAddressId) {
+		invalidParams.Add(request.NewErrParamPattern("ForwardingAddressId", "ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.JobType != nil {
+		values := []string{"IMPORT", "EXPORT", "LOCAL_USE"}
+		if !request.IsParamEnumValue(*s.JobType, values) {
+			invalidParams.Add(request.NewErrParamEnum("JobType", *s.JobType, values))
+		}
+	}
+	if s.KmsKeyARN != nil && len(*s.KmsKeyARN) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("KmsKeyARN", 255))
+	}
+	if s.KmsKeyARN != nil && !request.MatchParamPattern("arn:aws.*:kms:.*:[0-9]{12}:key/.*", *s.KmsKeyARN) {
+		invalidParams.Add(request.NewErrParamPattern("KmsKeyARN", "arn:aws.*:kms:.*:[0-9]{12}:key/.*"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 255))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:aws.*:iam::[0-9]{12}:role/.*", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:aws.*:iam::[0-9]{12}:role/.*"))
+	}
+	if s.ShippingOption != nil {
+		values := []string{"SECOND_DAY", "NEXT_DAY", "EXPRESS", "STANDARD"}
+		if !request.IsParamEnumValue(*s.ShippingOption, values) {
+			invalidParams.Add(request.NewErrParamEnum("ShippingOption", *s.ShippingOption, values))
+		}
+	}
+	if s.SnowballType != nil {
+		values := []string{"STANDARD", "EDGE"}
+		if !request.IsParamEnumValue(*s.SnowballType, values) {
+			invalidParams.Add(request.NewErrParamEnum("SnowballType", *s.SnowballType, values))
+		}
+	}
+	if s.Notification != nil {
+		if err := s.Notification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notification", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Resources != nil {
+		if err := s.Resources.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Resources", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddressId sets the AddressId field's value.
 func (s *CreateClusterInput) SetAddressId(v string) *CreateClusterInput {
 	s.AddressId = &v
@@ -2545,6 +2680,82 @@ func (s *CreateJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateJobInput"}
+	if s.AddressId != nil && len(*s.AddressId) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("AddressId", 40))
+	}
+	if s.AddressId != nil && !request.MatchParamPattern("ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.AddressId) {
+		invalidParams.Add(request.NewErrParamPattern("AddressId", "ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.ClusterId != nil && len(*s.ClusterId) > 39 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClusterId", 39))
+	}
+	if s.ClusterId != nil && !request.MatchParamPattern("CID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.ClusterId) {
+		invalidParams.Add(request.NewErrParamPattern("ClusterId", "CID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.ForwardingAddressId != nil && len(*s.ForwardingAddressId) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ForwardingAddressId", 40))
+	}
+	if s.ForwardingAddressId != nil && !request.MatchParamPattern("ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.ForwardingAddressId) {
+		invalidParams.Add(request.NewErrParamPattern("ForwardingAddressId", "ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.JobType != nil {
+		values := []string{"IMPORT", "EXPORT", "LOCAL_USE"}
+		if !request.IsParamEnumValue(*s.JobType, values) {
+			invalidParams.Add(request.NewErrParamEnum("JobType", *s.JobType, values))
+		}
+	}
+	if s.KmsKeyARN != nil && len(*s.KmsKeyARN) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("KmsKeyARN", 255))
+	}
+	if s.KmsKeyARN != nil && !request.MatchParamPattern("arn:aws.*:kms:.*:[0-9]{12}:key/.*", *s.KmsKeyARN) {
+		invalidParams.Add(request.NewErrParamPattern("KmsKeyARN", "arn:aws.*:kms:.*:[0-9]{12}:key/.*"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 255))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:aws.*:iam::[0-9]{12}:role/.*", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:aws.*:iam::[0-9]{12}:role/.*"))
+	}
+	if s.ShippingOption != nil {
+		values := []string{"SECOND_DAY", "NEXT_DAY", "EXPRESS", "STANDARD"}
+		if !request.IsParamEnumValue(*s.ShippingOption, values) {
+			invalidParams.Add(request.NewErrParamEnum("ShippingOption", *s.ShippingOption, values))
+		}
+	}
+	if s.SnowballCapacityPreference != nil {
+		values := []string{"T50", "T80", "T100", "NoPreference"}
+		if !request.IsParamEnumValue(*s.SnowballCapacityPreference, values) {
+			invalidParams.Add(request.NewErrParamEnum("SnowballCapacityPreference", *s.SnowballCapacityPreference, values))
+		}
+	}
+	if s.SnowballType != nil {
+		values := []string{"STANDARD", "EDGE"}
+		if !request.IsParamEnumValue(*s.SnowballType, values) {
+			invalidParams.Add(request.NewErrParamEnum("SnowballType", *s.SnowballType, values))
+		}
+	}
+	if s.Notification != nil {
+		if err := s.Notification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notification", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Resources != nil {
+		if err := s.Resources.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Resources", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddressId sets the AddressId field's value.
 func (s *CreateJobInput) SetAddressId(v string) *CreateJobInput {
 	s.AddressId = &v
@@ -2735,6 +2946,24 @@ func (s *DescribeAddressInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAddressInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAddressInput"}
+	if s.AddressId != nil && len(*s.AddressId) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("AddressId", 40))
+	}
+	if s.AddressId != nil && !request.MatchParamPattern("ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.AddressId) {
+		invalidParams.Add(request.NewErrParamPattern("AddressId", "ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddressId sets the AddressId field's value.
 func (s *DescribeAddressInput) SetAddressId(v string) *DescribeAddressInput {
 	s.AddressId = &v
@@ -2802,6 +3031,21 @@ func (s *DescribeAddressesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAddressesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAddressesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *DescribeAddressesInput) SetMaxResults(v int64) *DescribeAddressesInput {
 	s.MaxResults = &v
@@ -2885,6 +3129,24 @@ func (s *DescribeClusterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeClusterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeClusterInput"}
+	if s.ClusterId != nil && len(*s.ClusterId) > 39 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClusterId", 39))
+	}
+	if s.ClusterId != nil && !request.MatchParamPattern("CID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.ClusterId) {
+		invalidParams.Add(request.NewErrParamPattern("ClusterId", "CID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClusterId sets the ClusterId field's value.
 func (s *DescribeClusterInput) SetClusterId(v string) *DescribeClusterInput {
 	s.ClusterId = &v
@@ -2952,6 +3214,24 @@ func (s *DescribeJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeJobInput"}
+	if s.JobId != nil && len(*s.JobId) > 39 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobId", 39))
+	}
+	if s.JobId != nil && !request.MatchParamPattern("(M|J)ID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.JobId) {
+		invalidParams.Add(request.NewErrParamPattern("JobId", "(M|J)ID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobId sets the JobId field's value.
 func (s *DescribeJobInput) SetJobId(v string) *DescribeJobInput {
 	s.JobId = &v
@@ -3013,6 +3293,21 @@ func (s EventTriggerDefinition) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *EventTriggerDefinition) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "EventTriggerDefinition"}
+	if s.EventResourceARN != nil && len(*s.EventResourceARN) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("EventResourceARN", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEventResourceARN sets the EventResourceARN field's value.
 func (s *EventTriggerDefinition) SetEventResourceARN(v string) *EventTriggerDefinition {
 	s.EventResourceARN = &v
@@ -3056,6 +3351,24 @@ func (s *GetJobManifestInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetJobManifestInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetJobManifestInput"}
+	if s.JobId != nil && len(*s.JobId) > 39 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobId", 39))
+	}
+	if s.JobId != nil && !request.MatchParamPattern("(M|J)ID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.JobId) {
+		invalidParams.Add(request.NewErrParamPattern("JobId", "(M|J)ID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobId sets the JobId field's value.
 func (s *GetJobManifestInput) SetJobId(v string) *GetJobManifestInput {
 	s.JobId = &v
@@ -3124,6 +3437,24 @@ func (s *GetJobUnlockCodeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetJobUnlockCodeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetJobUnlockCodeInput"}
+	if s.JobId != nil && len(*s.JobId) > 39 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobId", 39))
+	}
+	if s.JobId != nil && !request.MatchParamPattern("(M|J)ID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.JobId) {
+		invalidParams.Add(request.NewErrParamPattern("JobId", "(M|J)ID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobId sets the JobId field's value.
 func (s *GetJobUnlockCodeInput) SetJobId(v string) *GetJobUnlockCodeInput {
 	s.JobId = &v
@@ -3584,6 +3915,38 @@ func (s *JobResource) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *JobResource) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "JobResource"}
+	if s.LambdaResources != nil {
+		for i, v := range s.LambdaResources {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "LambdaResources", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.S3Resources != nil {
+		for i, v := range s.S3Resources {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "S3Resources", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLambdaResources sets the LambdaResources field's value.
 func (s *JobResource) SetLambdaResources(v []*LambdaResource) *JobResource {
 	s.LambdaResources = v
@@ -3675,6 +4038,31 @@ func (s LambdaResource) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LambdaResource) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LambdaResource"}
+	if s.LambdaArn != nil && len(*s.LambdaArn) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("LambdaArn", 255))
+	}
+	if s.EventTriggers != nil {
+		for i, v := range s.EventTriggers {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "EventTriggers", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEventTriggers sets the EventTriggers field's value.
 func (s *LambdaResource) SetEventTriggers(v []*EventTriggerDefinition) *LambdaResource {
 	s.EventTriggers = v
@@ -3734,6 +4122,27 @@ func (s *ListClusterJobsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListClusterJobsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListClusterJobsInput"}
+	if s.ClusterId != nil && len(*s.ClusterId) > 39 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClusterId", 39))
+	}
+	if s.ClusterId != nil && !request.MatchParamPattern("CID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.ClusterId) {
+		invalidParams.Add(request.NewErrParamPattern("ClusterId", "CID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClusterId sets the ClusterId field's value.
 func (s *ListClusterJobsInput) SetClusterId(v string) *ListClusterJobsInput {
 	s.ClusterId = &v
@@ -3824,6 +4233,21 @@ func (s *ListClustersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListClustersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListClustersInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListClustersInput) SetMaxResults(v int64) *ListClustersInput {
 	s.MaxResults = &v
@@ -3908,6 +4332,21 @@ func (s *ListJobsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListJobsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListJobsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListJobsInput) SetMaxResults(v int64) *ListJobsInput {
 	s.MaxResults = &v
@@ -3995,6 +4434,24 @@ func (s Notification) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Notification) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Notification"}
+	if s.SnsTopicARN != nil && len(*s.SnsTopicARN) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("SnsTopicARN", 255))
+	}
+	if s.SnsTopicARN != nil && !request.MatchParamPattern("arn:aws.*:sns:.*:[0-9]{12}:.*", *s.SnsTopicARN) {
+		invalidParams.Add(request.NewErrParamPattern("SnsTopicARN", "arn:aws.*:sns:.*:[0-9]{12}:.*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetJobStatesToNotify sets the JobStatesToNotify field's value.
 func (s *Notification) SetJobStatesToNotify(v []*string) *Notification {
 	s.JobStatesToNotify = v
@@ -4057,6 +4514,21 @@ func (s *S3Resource) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *S3Resource) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "S3Resource"}
+	if s.BucketArn != nil && len(*s.BucketArn) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("BucketArn", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBucketArn sets the BucketArn field's value.
 func (s *S3Resource) SetBucketArn(v string) *S3Resource {
 	s.BucketArn = &v
@@ -4244,6 +4716,58 @@ func (s *UpdateClusterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateClusterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateClusterInput"}
+	if s.AddressId != nil && len(*s.AddressId) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("AddressId", 40))
+	}
+	if s.AddressId != nil && !request.MatchParamPattern("ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.AddressId) {
+		invalidParams.Add(request.NewErrParamPattern("AddressId", "ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.ClusterId != nil && len(*s.ClusterId) > 39 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClusterId", 39))
+	}
+	if s.ClusterId != nil && !request.MatchParamPattern("CID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.ClusterId) {
+		invalidParams.Add(request.NewErrParamPattern("ClusterId", "CID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.ForwardingAddressId != nil && len(*s.ForwardingAddressId) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ForwardingAddressId", 40))
+	}
+	if s.ForwardingAddressId != nil && !request.MatchParamPattern("ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.ForwardingAddressId) {
+		invalidParams.Add(request.NewErrParamPattern("ForwardingAddressId", "ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 255))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:aws.*:iam::[0-9]{12}:role/.*", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:aws.*:iam::[0-9]{12}:role/.*"))
+	}
+	if s.ShippingOption != nil {
+		values := []string{"SECOND_DAY", "NEXT_DAY", "EXPRESS", "STANDARD"}
+		if !request.IsParamEnumValue(*s.ShippingOption, values) {
+			invalidParams.Add(request.NewErrParamEnum("ShippingOption", *s.ShippingOption, values))
+		}
+	}
+	if s.Notification != nil {
+		if err := s.Notification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notification", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Resources != nil {
+		if err := s.Resources.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Resources", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddressId sets the AddressId field's value.
 func (s *UpdateClusterInput) SetAddressId(v string) *UpdateClusterInput {
 	s.AddressId = &v
@@ -4386,6 +4910,64 @@ func (s *UpdateJobInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateJobInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateJobInput"}
+	if s.AddressId != nil && len(*s.AddressId) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("AddressId", 40))
+	}
+	if s.AddressId != nil && !request.MatchParamPattern("ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.AddressId) {
+		invalidParams.Add(request.NewErrParamPattern("AddressId", "ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.ForwardingAddressId != nil && len(*s.ForwardingAddressId) > 40 {
+		invalidParams.Add(request.NewErrParamMaxLen("ForwardingAddressId", 40))
+	}
+	if s.ForwardingAddressId != nil && !request.MatchParamPattern("ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.ForwardingAddressId) {
+		invalidParams.Add(request.NewErrParamPattern("ForwardingAddressId", "ADID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.JobId != nil && len(*s.JobId) > 39 {
+		invalidParams.Add(request.NewErrParamMaxLen("JobId", 39))
+	}
+	if s.JobId != nil && !request.MatchParamPattern("(M|J)ID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}", *s.JobId) {
+		invalidParams.Add(request.NewErrParamPattern("JobId", "(M|J)ID[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"))
+	}
+	if s.RoleARN != nil && len(*s.RoleARN) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleARN", 255))
+	}
+	if s.RoleARN != nil && !request.MatchParamPattern("arn:aws.*:iam::[0-9]{12}:role/.*", *s.RoleARN) {
+		invalidParams.Add(request.NewErrParamPattern("RoleARN", "arn:aws.*:iam::[0-9]{12}:role/.*"))
+	}
+	if s.ShippingOption != nil {
+		values := []string{"SECOND_DAY", "NEXT_DAY", "EXPRESS", "STANDARD"}
+		if !request.IsParamEnumValue(*s.ShippingOption, values) {
+			invalidParams.Add(request.NewErrParamEnum("ShippingOption", *s.ShippingOption, values))
+		}
+	}
+	if s.SnowballCapacityPreference != nil {
+		values := []string{"T50", "T80", "T100", "NoPreference"}
+		if !request.IsParamEnumValue(*s.SnowballCapacityPreference, values) {
+			invalidParams.Add(request.NewErrParamEnum("SnowballCapacityPreference", *s.SnowballCapacityPreference, values))
+		}
+	}
+	if s.Notification != nil {
+		if err := s.Notification.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Notification", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Resources != nil {
+		if err := s.Resources.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Resources", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAddressId sets the AddressId field's value.
 func (s *UpdateJobInput) SetAddressId(v string) *UpdateJobInput {
 	s.AddressId = &v
@@ -3053,9 +3053,10 @@ const opDescribeInstancePatchStates = "DescribeInstancePatchStates"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/ssm-2014-11-06/DescribeInstancePatchStates
 func (c *SSM) DescribeInstancePatchStatesRequest(input *DescribeInstancePatchStatesInput) (req *request.Request, output *DescribeInstancePatchStatesOutput) {
 	op := &request.Operation{
-		Name:       opDescribeInstancePatchStates,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeInstancePatchStates,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"InstancePatchStates[*].OwnerInformation"},
 	}
 
 	if input == nil {
@@ -3135,9 +3136,10 @@ const opDescribeInstancePatchStatesForPatchGroup = "DescribeInstancePatchStatesF
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/ssm-2014-11-06/DescribeInstancePatchStatesForPatchGroup
 func (c *SSM) DescribeInstancePatchStatesForPatchGroupRequest(input *DescribeInstancePatchStatesForPatchGroupInput) (req *request.Request, output *DescribeInstancePatchStatesForPatchGroupOutput) {
 	op := &request.Operation{
-		Name:       opDescribeInstancePatchStatesForPatchGroup,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeInstancePatchStatesForPatchGroup,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"InstancePatchStates[*].OwnerInformation"},
 	}
 
 	if input == nil {
@@ -3324,9 +3326,10 @@ const opDescribeMaintenanceWindowExecutionTaskInvocations = "DescribeMaintenance
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/ssm-2014-11-06/DescribeMaintenanceWindowExecutionTaskInvocations
 func (c *SSM) DescribeMaintenanceWindowExecutionTaskInvocationsRequest(input *DescribeMaintenanceWindowExecutionTaskInvocationsInput) (req *request.Request, output *DescribeMaintenanceWindowExecutionTaskInvocationsOutput) {
 	op := &request.Operation{
-		Name:       opDescribeMaintenanceWindowExecutionTaskInvocations,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeMaintenanceWindowExecutionTaskInvocations,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"WindowExecutionTaskInvocationIdentities[*].OwnerInformation", "WindowExecutionTaskInvocationIdentities[*].Parameters"},
 	}
 
 	if input == nil {
@@ -3572,9 +3575,10 @@ const opDescribeMaintenanceWindowTargets = "DescribeMaintenanceWindowTargets"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/ssm-2014-11-06/DescribeMaintenanceWindowTargets
 func (c *SSM) DescribeMaintenanceWindowTargetsRequest(input *DescribeMaintenanceWindowTargetsInput) (req *request.Request, output *DescribeMaintenanceWindowTargetsOutput) {
 	op := &request.Operation{
-		Name:       opDescribeMaintenanceWindowTargets,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeMaintenanceWindowTargets,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Targets[*].Description", "Targets[*].OwnerInformation"},
 	}
 
 	if input == nil {
@@ -3655,9 +3659,10 @@ const opDescribeMaintenanceWindowTasks = "DescribeMaintenanceWindowTasks"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/ssm-2014-11-06/DescribeMaintenanceWindowTasks
 func (c *SSM) DescribeMaintenanceWindowTasksRequest(input *DescribeMaintenanceWindowTasksInput) (req *request.Request, output *DescribeMaintenanceWindowTasksOutput) {
 	op := &request.Operation{
-		Name:       opDescribeMaintenanceWindowTasks,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeMaintenanceWindowTasks,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Tasks[*].Description", "Tasks[*].TaskParameters"},
 	}
 
 	if input == nil {
@@ -3738,9 +3743,10 @@ const opDescribeMaintenanceWindows = "DescribeMaintenanceWindows"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/ssm-2014-11-06/DescribeMaintenanceWindows
 func (c *SSM) DescribeMaintenanceWindowsRequest(input *DescribeMaintenanceWindowsInput) (req *request.Request, output *DescribeMaintenanceWindowsOutput) {
 	op := &request.Operation{
-		Name:       opDescribeMaintenanceWindows,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opDescribeMaintenanceWindows,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"WindowIdentities[*].Description"},
 	}
 
 	if input == nil {
@@ -4829,9 +4835,10 @@ const opGetMaintenanceWindow = "GetMaintenanceWindow"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/ssm-2014-11-06/GetMaintenanceWindow
 func (c *SSM) GetMaintenanceWindowRequest(input *GetMaintenanceWindowInput) (req *request.Request, output *GetMaintenanceWindowOutput) {
 	op := &request.Operation{
-		Name:       opGetMaintenanceWindow,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opGetMaintenanceWindow,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Description"},
 	}
 
 	if input == nil {
@@ -4996,9 +5003,10 @@ const opGetMaintenanceWindowExecutionTask = "GetMaintenanceWindowExecutionTask"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/ssm-2014-11-06/GetMaintenanceWindowExecutionTask
 func (c *SSM) GetMaintenanceWindowExecutionTaskRequest(input *GetMaintenanceWindowExecutionTaskInput) (req *request.Request, output *GetMaintenanceWindowExecutionTaskOutput) {
 	op := &request.Operation{
-		Name:       opGetMaintenanceWindowExecutionTask,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opGetMaintenanceWindowExecutionTask,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"TaskParameters"},
 	}
 
 	if input == nil {
@@ -5080,9 +5088,10 @@ const opGetMaintenanceWindowExecutionTaskInvocation = "GetMaintenanceWindowExecu
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/ssm-2014-11-06/GetMaintenanceWindowExecutionTaskInvocation
 func (c *SSM) GetMaintenanceWindowExecutionTaskInvocationRequest(input *GetMaintenanceWindowExecutionTaskInvocationInput) (req *request.Request, output *GetMaintenanceWindowExecutionTaskInvocationOutput) {
 	op := &request.Operation{
-		Name:       opGetMaintenanceWindowExecutionTaskInvocation,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opGetMaintenanceWindowExecutionTaskInvocation,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"OwnerInformation", "Parameters"},
 	}
 
 	if input == nil {
@@ -5164,9 +5173,10 @@ const opGetMaintenanceWindowTask = "GetMaintenanceWindowTask"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/ssm-2014-11-06/GetMaintenanceWindowTask
 func (c *SSM) GetMaintenanceWindowTaskRequest(input *GetMaintenanceWindowTaskInput) (req *request.Request, output *GetMaintenanceWindowTaskOutput) {
 	op := &request.Operation{
-		Name:       opGetMaintenanceWindowTask,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opGetMaintenanceWindowTask,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Description", "TaskInvocationParameters.Lambda.Payload", "TaskInvocationParameters.StepFunctions.Input", "TaskParameters"},
 	}
 
 	if input == nil {
@@ -8921,9 +8931,10 @@ const opUpdateMaintenanceWindow = "UpdateMaintenanceWindow"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/ssm-2014-11-06/UpdateMaintenanceWindow
 func (c *SSM) UpdateMaintenanceWindowRequest(input *UpdateMaintenanceWindowInput) (req *request.Request, output *UpdateMaintenanceWindowOutput) {
 	op := &request.Operation{
-		Name:       opUpdateMaintenanceWindow,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opUpdateMaintenanceWindow,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Description"},
 	}
 
 	if input == nil {
@@ -9004,9 +9015,10 @@ const opUpdateMaintenanceWindowTarget = "UpdateMaintenanceWindowTarget"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/ssm-2014-11-06/UpdateMaintenanceWindowTarget
 func (c *SSM) UpdateMaintenanceWindowTargetRequest(input *UpdateMaintenanceWindowTargetInput) (req *request.Request, output *UpdateMaintenanceWindowTargetOutput) {
 	op := &request.Operation{
-		Name:       opUpdateMaintenanceWindowTarget,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opUpdateMaintenanceWindowTarget,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Description", "OwnerInformation"},
 	}
 
 	if input == nil {
@@ -9103,9 +9115,10 @@ const opUpdateMaintenanceWindowTask = "UpdateMaintenanceWindowTask"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/ssm-2014-11-06/UpdateMaintenanceWindowTask
 func (c *SSM) UpdateMaintenanceWindowTaskRequest(input *UpdateMaintenanceWindowTaskInput) (req *request.Request, output *UpdateMaintenanceWindowTaskOutput) {
 	op := &request.Operation{
-		Name:       opUpdateMaintenanceWindowTask,
-		HTTPMethod: "POST",
-		HTTPPath:   "/",
+		Name:                 opUpdateMaintenanceWindowTask,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/",
+		SensitiveOutputPaths: []string{"Description", "TaskInvocationParameters.Lambda.Payload", "TaskInvocationParameters.StepFunctions.Input", "TaskParameters"},
 	}
 
 	if input == nil {
@@ -9518,6 +9531,34 @@ func (s *AddTagsToResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddTagsToResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddTagsToResourceInput"}
+	if s.ResourceType != nil {
+		values := []string{"ManagedInstance", "MaintenanceWindow", "Parameter"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *AddTagsToResourceInput) SetResourceId(v string) *AddTagsToResourceInput {
 	s.ResourceId = &v
@@ -9863,6 +9904,24 @@ func (s *AssociationFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssociationFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociationFilter"}
+	if s.Key != nil {
+		values := []string{"InstanceId", "Name", "AssociationId", "AssociationStatusName", "LastExecutedBefore", "LastExecutedAfter", "AssociationName"}
+		if !request.IsParamEnumValue(*s.Key, values) {
+			invalidParams.Add(request.NewErrParamEnum("Key", *s.Key, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *AssociationFilter) SetKey(v string) *AssociationFilter {
 	s.Key = &v
@@ -9976,6 +10035,30 @@ func (s *AssociationStatus) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssociationStatus) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociationStatus"}
+	if s.AdditionalInfo != nil && len(*s.AdditionalInfo) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("AdditionalInfo", 1024))
+	}
+	if s.Message != nil && len(*s.Message) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Message", 1024))
+	}
+	if s.Name != nil {
+		values := []string{"Pending", "Success", "Failed"}
+		if !request.IsParamEnumValue(*s.Name, values) {
+			invalidParams.Add(request.NewErrParamEnum("Name", *s.Name, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAdditionalInfo sets the AdditionalInfo field's value.
 func (s *AssociationStatus) SetAdditionalInfo(v string) *AssociationStatus {
 	s.AdditionalInfo = &v
@@ -10267,6 +10350,27 @@ func (s *AutomationExecutionFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AutomationExecutionFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AutomationExecutionFilter"}
+	if s.Key != nil {
+		values := []string{"DocumentNamePrefix", "ExecutionStatus"}
+		if !request.IsParamEnumValue(*s.Key, values) {
+			invalidParams.Add(request.NewErrParamEnum("Key", *s.Key, values))
+		}
+	}
+	if s.Values != nil && len(s.Values) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Values", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *AutomationExecutionFilter) SetKey(v string) *AutomationExecutionFilter {
 	s.Key = &v
@@ -10419,6 +10523,24 @@ func (s *CancelCommandInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CancelCommandInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelCommandInput"}
+	if s.CommandId != nil && len(*s.CommandId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("CommandId", 36))
+	}
+	if s.InstanceIds != nil && len(s.InstanceIds) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceIds", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCommandId sets the CommandId field's value.
 func (s *CancelCommandInput) SetCommandId(v string) *CancelCommandInput {
 	s.CommandId = &v
@@ -10741,6 +10863,24 @@ func (s *CommandFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CommandFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CommandFilter"}
+	if s.Key != nil {
+		values := []string{"InvokedAfter", "InvokedBefore", "Status"}
+		if !request.IsParamEnumValue(*s.Key, values) {
+			invalidParams.Add(request.NewErrParamEnum("Key", *s.Key, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *CommandFilter) SetKey(v string) *CommandFilter {
 	s.Key = &v
@@ -11190,6 +11330,24 @@ func (s *ComplianceExecutionSummary) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ComplianceExecutionSummary) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ComplianceExecutionSummary"}
+	if s.ExecutionId != nil && len(*s.ExecutionId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExecutionId", 100))
+	}
+	if s.ExecutionType != nil && len(*s.ExecutionType) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExecutionType", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetExecutionId sets the ExecutionId field's value.
 func (s *ComplianceExecutionSummary) SetExecutionId(v string) *ComplianceExecutionSummary {
 	s.ExecutionId = &v
@@ -11372,6 +11530,36 @@ func (s *ComplianceItemEntry) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ComplianceItemEntry) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ComplianceItemEntry"}
+	if s.Id != nil && len(*s.Id) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 100))
+	}
+	if s.Severity != nil {
+		values := []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "INFORMATIONAL", "UNSPECIFIED"}
+		if !request.IsParamEnumValue(*s.Severity, values) {
+			invalidParams.Add(request.NewErrParamEnum("Severity", *s.Severity, values))
+		}
+	}
+	if s.Status != nil {
+		values := []string{"COMPLIANT", "NON_COMPLIANT"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+	if s.Title != nil && len(*s.Title) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("Title", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDetails sets the Details field's value.
 func (s *ComplianceItemEntry) SetDetails(v map[string]*string) *ComplianceItemEntry {
 	s.Details = v
@@ -11444,6 +11632,30 @@ func (s *ComplianceStringFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ComplianceStringFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ComplianceStringFilter"}
+	if s.Key != nil && len(*s.Key) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 200))
+	}
+	if s.Type != nil {
+		values := []string{"EQUAL", "NOT_EQUAL", "BEGIN_WITH", "LESS_THAN", "GREATER_THAN"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.Values != nil && len(s.Values) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("Values", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *ComplianceStringFilter) SetKey(v string) *ComplianceStringFilter {
 	s.Key = &v
@@ -11594,6 +11806,33 @@ func (s *CreateActivationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateActivationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateActivationInput"}
+	if s.DefaultInstanceName != nil && len(*s.DefaultInstanceName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultInstanceName", 256))
+	}
+	if s.DefaultInstanceName != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.DefaultInstanceName) {
+		invalidParams.Add(request.NewErrParamPattern("DefaultInstanceName", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+	if s.Description != nil && len(*s.Description) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 256))
+	}
+	if s.IamRole != nil && len(*s.IamRole) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("IamRole", 64))
+	}
+	if s.RegistrationLimit != nil && *s.RegistrationLimit > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("RegistrationLimit", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultInstanceName sets the DefaultInstanceName field's value.
 func (s *CreateActivationInput) SetDefaultInstanceName(v string) *CreateActivationInput {
 	s.DefaultInstanceName = &v
@@ -11705,6 +11944,28 @@ func (s *CreateAssociationBatchInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAssociationBatchInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAssociationBatchInput"}
+	if s.Entries != nil {
+		for i, v := range s.Entries {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Entries", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEntries sets the Entries field's value.
 func (s *CreateAssociationBatchInput) SetEntries(v []*CreateAssociationBatchRequestEntry) *CreateAssociationBatchInput {
 	s.Entries = v
@@ -11817,6 +12078,51 @@ func (s *CreateAssociationBatchRequestEntry) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAssociationBatchRequestEntry) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAssociationBatchRequestEntry"}
+	if s.AssociationName != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.AssociationName) {
+		invalidParams.Add(request.NewErrParamPattern("AssociationName", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.DocumentVersion != nil && !request.MatchParamPattern("([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)", *s.DocumentVersion) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentVersion", "([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)"))
+	}
+	if s.InstanceId != nil && !request.MatchParamPattern("(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)"))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.ScheduleExpression != nil && len(*s.ScheduleExpression) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ScheduleExpression", 256))
+	}
+	if s.Targets != nil && len(s.Targets) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Targets", 5))
+	}
+	if s.OutputLocation != nil {
+		if err := s.OutputLocation.ValidateExtended(); err != nil {
+			invalidParams.AddNested("OutputLocation", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Targets != nil {
+		for i, v := range s.Targets {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Targets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssociationName sets the AssociationName field's value.
 func (s *CreateAssociationBatchRequestEntry) SetAssociationName(v string) *CreateAssociationBatchRequestEntry {
 	s.AssociationName = &v
@@ -11938,6 +12244,51 @@ func (s *CreateAssociationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateAssociationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateAssociationInput"}
+	if s.AssociationName != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.AssociationName) {
+		invalidParams.Add(request.NewErrParamPattern("AssociationName", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.DocumentVersion != nil && !request.MatchParamPattern("([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)", *s.DocumentVersion) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentVersion", "([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)"))
+	}
+	if s.InstanceId != nil && !request.MatchParamPattern("(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)"))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.ScheduleExpression != nil && len(*s.ScheduleExpression) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ScheduleExpression", 256))
+	}
+	if s.Targets != nil && len(s.Targets) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Targets", 5))
+	}
+	if s.OutputLocation != nil {
+		if err := s.OutputLocation.ValidateExtended(); err != nil {
+			invalidParams.AddNested("OutputLocation", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Targets != nil {
+		for i, v := range s.Targets {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Targets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssociationName sets the AssociationName field's value.
 func (s *CreateAssociationInput) SetAssociationName(v string) *CreateAssociationInput {
 	s.AssociationName = &v
@@ -12058,6 +12409,27 @@ func (s *CreateDocumentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateDocumentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateDocumentInput"}
+	if s.DocumentType != nil {
+		values := []string{"Command", "Policy", "Automation"}
+		if !request.IsParamEnumValue(*s.DocumentType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DocumentType", *s.DocumentType, values))
+		}
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContent sets the Content field's value.
 func (s *CreateDocumentInput) SetContent(v string) *CreateDocumentInput {
 	s.Content = &v
@@ -12126,7 +12498,7 @@ type CreateMaintenanceWindowInput struct {
 
 	// An optional description for the Maintenance Window. We recommend specifying
 	// a description to help you organize your Maintenance Windows.
-	Description *string `min:"1" type:"string"`
+	Description *string `min:"1" type:"string" sensitive:"true"`
 
 	// The duration of the Maintenance Window in hours.
 	//
@@ -12194,6 +12566,39 @@ func (s *CreateMaintenanceWindowInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateMaintenanceWindowInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateMaintenanceWindowInput"}
+	if s.ClientToken != nil && len(*s.ClientToken) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientToken", 64))
+	}
+	if s.Cutoff != nil && *s.Cutoff > 23 {
+		invalidParams.Add(request.NewErrParamMaxValue("Cutoff", 23))
+	}
+	if s.Description != nil && len(*s.Description) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 128))
+	}
+	if s.Duration != nil && *s.Duration > 24 {
+		invalidParams.Add(request.NewErrParamMaxValue("Duration", 24))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.Schedule != nil && len(*s.Schedule) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Schedule", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllowUnassociatedTargets sets the AllowUnassociatedTargets field's value.
 func (s *CreateMaintenanceWindowInput) SetAllowUnassociatedTargets(v bool) *CreateMaintenanceWindowInput {
 	s.AllowUnassociatedTargets = &v
@@ -12341,17 +12746,69 @@ func (s *CreatePatchBaselineInput) Validate() error {
 	return nil
 }
 
-// SetApprovalRules sets the ApprovalRules field's value.
-func (s *CreatePatchBaselineInput) SetApprovalRules(v *PatchRuleGroup) *CreatePatchBaselineInput {
-	s.ApprovalRules = v
-	return s
-}
-
-// SetApprovedPatches sets the ApprovedPatches field's value.
-func (s *CreatePatchBaselineInput) SetApprovedPatches(v []*string) *CreatePatchBaselineInput {
-	s.ApprovedPatches = v
-	return s
-}
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreatePatchBaselineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreatePatchBaselineInput"}
+	if s.ApprovedPatches != nil && len(s.ApprovedPatches) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApprovedPatches", 50))
+	}
+	if s.ApprovedPatchesComplianceLevel != nil {
+		values := []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "INFORMATIONAL", "UNSPECIFIED"}
+		if !request.IsParamEnumValue(*s.ApprovedPatchesComplianceLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("ApprovedPatchesComplianceLevel", *s.ApprovedPatchesComplianceLevel, values))
+		}
+	}
+	if s.ClientToken != nil && len(*s.ClientToken) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientToken", 64))
+	}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.OperatingSystem != nil {
+		values := []string{"WINDOWS", "AMAZON_LINUX", "UBUNTU", "REDHAT_ENTERPRISE_LINUX"}
+		if !request.IsParamEnumValue(*s.OperatingSystem, values) {
+			invalidParams.Add(request.NewErrParamEnum("OperatingSystem", *s.OperatingSystem, values))
+		}
+	}
+	if s.RejectedPatches != nil && len(s.RejectedPatches) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("RejectedPatches", 50))
+	}
+	if s.ApprovalRules != nil {
+		if err := s.ApprovalRules.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ApprovalRules", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.GlobalFilters != nil {
+		if err := s.GlobalFilters.ValidateExtended(); err != nil {
+			invalidParams.AddNested("GlobalFilters", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetApprovalRules sets the ApprovalRules field's value.
+func (s *CreatePatchBaselineInput) SetApprovalRules(v *PatchRuleGroup) *CreatePatchBaselineInput {
+	s.ApprovalRules = v
+	return s
+}
+
+// SetApprovedPatches sets the ApprovedPatches field's value.
+func (s *CreatePatchBaselineInput) SetApprovedPatches(v []*string) *CreatePatchBaselineInput {
+	s.ApprovedPatches = v
+	return s
+}
 
 // SetApprovedPatchesComplianceLevel sets the ApprovedPatchesComplianceLevel field's value.
 func (s *CreatePatchBaselineInput) SetApprovedPatchesComplianceLevel(v string) *CreatePatchBaselineInput {
@@ -12468,6 +12925,26 @@ func (s *CreateResourceDataSyncInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateResourceDataSyncInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateResourceDataSyncInput"}
+	if s.SyncName != nil && len(*s.SyncName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("SyncName", 64))
+	}
+	if s.S3Destination != nil {
+		if err := s.S3Destination.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3Destination", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetS3Destination sets the S3Destination field's value.
 func (s *CreateResourceDataSyncInput) SetS3Destination(v *ResourceDataSyncS3Destination) *CreateResourceDataSyncInput {
 	s.S3Destination = v
@@ -12528,6 +13005,21 @@ func (s *DeleteActivationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteActivationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteActivationInput"}
+	if s.ActivationId != nil && !request.MatchParamPattern("^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$", *s.ActivationId) {
+		invalidParams.Add(request.NewErrParamPattern("ActivationId", "^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActivationId sets the ActivationId field's value.
 func (s *DeleteActivationInput) SetActivationId(v string) *DeleteActivationInput {
 	s.ActivationId = &v
@@ -12573,6 +13065,27 @@ func (s DeleteAssociationInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteAssociationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteAssociationInput"}
+	if s.AssociationId != nil && !request.MatchParamPattern("[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}", *s.AssociationId) {
+		invalidParams.Add(request.NewErrParamPattern("AssociationId", "[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}"))
+	}
+	if s.InstanceId != nil && !request.MatchParamPattern("(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)"))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssociationId sets the AssociationId field's value.
 func (s *DeleteAssociationInput) SetAssociationId(v string) *DeleteAssociationInput {
 	s.AssociationId = &v
@@ -12639,6 +13152,21 @@ func (s *DeleteDocumentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteDocumentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDocumentInput"}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteDocumentInput) SetName(v string) *DeleteDocumentInput {
 	s.Name = &v
@@ -12696,6 +13224,24 @@ func (s *DeleteMaintenanceWindowInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteMaintenanceWindowInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteMaintenanceWindowInput"}
+	if s.WindowId != nil && len(*s.WindowId) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowId", 20))
+	}
+	if s.WindowId != nil && !request.MatchParamPattern("^mw-[0-9a-f]{17}$", *s.WindowId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowId", "^mw-[0-9a-f]{17}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetWindowId sets the WindowId field's value.
 func (s *DeleteMaintenanceWindowInput) SetWindowId(v string) *DeleteMaintenanceWindowInput {
 	s.WindowId = &v
@@ -12762,6 +13308,21 @@ func (s *DeleteParameterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteParameterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteParameterInput"}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeleteParameterInput) SetName(v string) *DeleteParameterInput {
 	s.Name = &v
@@ -12819,6 +13380,21 @@ func (s *DeleteParametersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteParametersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteParametersInput"}
+	if s.Names != nil && len(s.Names) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Names", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNames sets the Names field's value.
 func (s *DeleteParametersInput) SetNames(v []*string) *DeleteParametersInput {
 	s.Names = v
@@ -12895,6 +13471,24 @@ func (s *DeletePatchBaselineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeletePatchBaselineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeletePatchBaselineInput"}
+	if s.BaselineId != nil && len(*s.BaselineId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("BaselineId", 128))
+	}
+	if s.BaselineId != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-:/]{20,128}$", *s.BaselineId) {
+		invalidParams.Add(request.NewErrParamPattern("BaselineId", "^[a-zA-Z0-9_\\-:/]{20,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBaselineId sets the BaselineId field's value.
 func (s *DeletePatchBaselineInput) SetBaselineId(v string) *DeletePatchBaselineInput {
 	s.BaselineId = &v
@@ -12961,6 +13555,21 @@ func (s *DeleteResourceDataSyncInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteResourceDataSyncInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteResourceDataSyncInput"}
+	if s.SyncName != nil && len(*s.SyncName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("SyncName", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSyncName sets the SyncName field's value.
 func (s *DeleteResourceDataSyncInput) SetSyncName(v string) *DeleteResourceDataSyncInput {
 	s.SyncName = &v
@@ -13016,6 +13625,21 @@ func (s *DeregisterManagedInstanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeregisterManagedInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeregisterManagedInstanceInput"}
+	if s.InstanceId != nil && !request.MatchParamPattern("^mi-[0-9a-f]{17}$", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "^mi-[0-9a-f]{17}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceId sets the InstanceId field's value.
 func (s *DeregisterManagedInstanceInput) SetInstanceId(v string) *DeregisterManagedInstanceInput {
 	s.InstanceId = &v
@@ -13084,6 +13708,30 @@ func (s *DeregisterPatchBaselineForPatchGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeregisterPatchBaselineForPatchGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeregisterPatchBaselineForPatchGroupInput"}
+	if s.BaselineId != nil && len(*s.BaselineId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("BaselineId", 128))
+	}
+	if s.BaselineId != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-:/]{20,128}$", *s.BaselineId) {
+		invalidParams.Add(request.NewErrParamPattern("BaselineId", "^[a-zA-Z0-9_\\-:/]{20,128}$"))
+	}
+	if s.PatchGroup != nil && len(*s.PatchGroup) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("PatchGroup", 256))
+	}
+	if s.PatchGroup != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.PatchGroup) {
+		invalidParams.Add(request.NewErrParamPattern("PatchGroup", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBaselineId sets the BaselineId field's value.
 func (s *DeregisterPatchBaselineForPatchGroupInput) SetBaselineId(v string) *DeregisterPatchBaselineForPatchGroupInput {
 	s.BaselineId = &v
@@ -13181,6 +13829,30 @@ func (s *DeregisterTargetFromMaintenanceWindowInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeregisterTargetFromMaintenanceWindowInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeregisterTargetFromMaintenanceWindowInput"}
+	if s.WindowId != nil && len(*s.WindowId) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowId", 20))
+	}
+	if s.WindowId != nil && !request.MatchParamPattern("^mw-[0-9a-f]{17}$", *s.WindowId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowId", "^mw-[0-9a-f]{17}$"))
+	}
+	if s.WindowTargetId != nil && len(*s.WindowTargetId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowTargetId", 36))
+	}
+	if s.WindowTargetId != nil && !request.MatchParamPattern("^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$", *s.WindowTargetId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowTargetId", "^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSafe sets the Safe field's value.
 func (s *DeregisterTargetFromMaintenanceWindowInput) SetSafe(v bool) *DeregisterTargetFromMaintenanceWindowInput {
 	s.Safe = &v
@@ -13279,6 +13951,30 @@ func (s *DeregisterTaskFromMaintenanceWindowInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeregisterTaskFromMaintenanceWindowInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeregisterTaskFromMaintenanceWindowInput"}
+	if s.WindowId != nil && len(*s.WindowId) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowId", 20))
+	}
+	if s.WindowId != nil && !request.MatchParamPattern("^mw-[0-9a-f]{17}$", *s.WindowId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowId", "^mw-[0-9a-f]{17}$"))
+	}
+	if s.WindowTaskId != nil && len(*s.WindowTaskId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowTaskId", 36))
+	}
+	if s.WindowTaskId != nil && !request.MatchParamPattern("^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$", *s.WindowTaskId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowTaskId", "^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetWindowId sets the WindowId field's value.
 func (s *DeregisterTaskFromMaintenanceWindowInput) SetWindowId(v string) *DeregisterTaskFromMaintenanceWindowInput {
 	s.WindowId = &v
@@ -13346,6 +14042,24 @@ func (s DescribeActivationsFilter) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeActivationsFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeActivationsFilter"}
+	if s.FilterKey != nil {
+		values := []string{"ActivationIds", "DefaultInstanceName", "IamRole"}
+		if !request.IsParamEnumValue(*s.FilterKey, values) {
+			invalidParams.Add(request.NewErrParamEnum("FilterKey", *s.FilterKey, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilterKey sets the FilterKey field's value.
 func (s *DescribeActivationsFilter) SetFilterKey(v string) *DescribeActivationsFilter {
 	s.FilterKey = &v
@@ -13397,6 +14111,31 @@ func (s *DescribeActivationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeActivationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeActivationsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribeActivationsInput) SetFilters(v []*DescribeActivationsFilter) *DescribeActivationsInput {
 	s.Filters = v
@@ -13479,6 +14218,30 @@ func (s DescribeAssociationInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAssociationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAssociationInput"}
+	if s.AssociationId != nil && !request.MatchParamPattern("[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}", *s.AssociationId) {
+		invalidParams.Add(request.NewErrParamPattern("AssociationId", "[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}"))
+	}
+	if s.AssociationVersion != nil && !request.MatchParamPattern("([$]LATEST)|([1-9][0-9]*)", *s.AssociationVersion) {
+		invalidParams.Add(request.NewErrParamPattern("AssociationVersion", "([$]LATEST)|([1-9][0-9]*)"))
+	}
+	if s.InstanceId != nil && !request.MatchParamPattern("(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)"))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssociationId sets the AssociationId field's value.
 func (s *DescribeAssociationInput) SetAssociationId(v string) *DescribeAssociationInput {
 	s.AssociationId = &v
@@ -13580,6 +14343,34 @@ func (s *DescribeAutomationExecutionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAutomationExecutionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAutomationExecutionsInput"}
+	if s.Filters != nil && len(s.Filters) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 10))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribeAutomationExecutionsInput) SetFilters(v []*AutomationExecutionFilter) *DescribeAutomationExecutionsInput {
 	s.Filters = v
@@ -13681,6 +14472,34 @@ func (s *DescribeAvailablePatchesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeAvailablePatchesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeAvailablePatchesInput"}
+	if s.Filters != nil && len(s.Filters) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 5))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribeAvailablePatchesInput) SetFilters(v []*PatchOrchestratorFilter) *DescribeAvailablePatchesInput {
 	s.Filters = v
@@ -13770,6 +14589,24 @@ func (s *DescribeDocumentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeDocumentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeDocumentInput"}
+	if s.DocumentVersion != nil && !request.MatchParamPattern("([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)", *s.DocumentVersion) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentVersion", "([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)"))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.:/]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.:/]{3,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDocumentVersion sets the DocumentVersion field's value.
 func (s *DescribeDocumentInput) SetDocumentVersion(v string) *DescribeDocumentInput {
 	s.DocumentVersion = &v
@@ -13847,6 +14684,27 @@ func (s *DescribeDocumentPermissionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeDocumentPermissionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeDocumentPermissionInput"}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.PermissionType != nil {
+		values := []string{"Share"}
+		if !request.IsParamEnumValue(*s.PermissionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("PermissionType", *s.PermissionType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DescribeDocumentPermissionInput) SetName(v string) *DescribeDocumentPermissionInput {
 	s.Name = &v
@@ -13929,6 +14787,24 @@ func (s *DescribeEffectiveInstanceAssociationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEffectiveInstanceAssociationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEffectiveInstanceAssociationsInput"}
+	if s.InstanceId != nil && !request.MatchParamPattern("(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 5 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 5))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceId sets the InstanceId field's value.
 func (s *DescribeEffectiveInstanceAssociationsInput) SetInstanceId(v string) *DescribeEffectiveInstanceAssociationsInput {
 	s.InstanceId = &v
@@ -14027,6 +14903,27 @@ func (s *DescribeEffectivePatchesForPatchBaselineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeEffectivePatchesForPatchBaselineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeEffectivePatchesForPatchBaselineInput"}
+	if s.BaselineId != nil && len(*s.BaselineId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("BaselineId", 128))
+	}
+	if s.BaselineId != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-:/]{20,128}$", *s.BaselineId) {
+		invalidParams.Add(request.NewErrParamPattern("BaselineId", "^[a-zA-Z0-9_\\-:/]{20,128}$"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBaselineId sets the BaselineId field's value.
 func (s *DescribeEffectivePatchesForPatchBaselineInput) SetBaselineId(v string) *DescribeEffectivePatchesForPatchBaselineInput {
 	s.BaselineId = &v
@@ -14124,6 +15021,24 @@ func (s *DescribeInstanceAssociationsStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeInstanceAssociationsStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeInstanceAssociationsStatusInput"}
+	if s.InstanceId != nil && !request.MatchParamPattern("(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceId sets the InstanceId field's value.
 func (s *DescribeInstanceAssociationsStatusInput) SetInstanceId(v string) *DescribeInstanceAssociationsStatusInput {
 	s.InstanceId = &v
@@ -14239,6 +15154,41 @@ func (s *DescribeInstanceInformationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeInstanceInformationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeInstanceInformationInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.InstanceInformationFilterList != nil {
+		for i, v := range s.InstanceInformationFilterList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "InstanceInformationFilterList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribeInstanceInformationInput) SetFilters(v []*InstanceInformationStringFilter) *DescribeInstanceInformationInput {
 	s.Filters = v
@@ -14363,6 +15313,40 @@ func (s *DescribeInstancePatchStatesForPatchGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeInstancePatchStatesForPatchGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeInstancePatchStatesForPatchGroupInput"}
+	if s.Filters != nil && len(s.Filters) > 4 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 4))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.PatchGroup != nil && len(*s.PatchGroup) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("PatchGroup", 256))
+	}
+	if s.PatchGroup != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.PatchGroup) {
+		invalidParams.Add(request.NewErrParamPattern("PatchGroup", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribeInstancePatchStatesForPatchGroupInput) SetFilters(v []*InstancePatchStateFilter) *DescribeInstancePatchStatesForPatchGroupInput {
 	s.Filters = v
@@ -14464,6 +15448,24 @@ func (s *DescribeInstancePatchStatesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeInstancePatchStatesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeInstancePatchStatesInput"}
+	if s.InstanceIds != nil && len(s.InstanceIds) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceIds", 50))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceIds sets the InstanceIds field's value.
 func (s *DescribeInstancePatchStatesInput) SetInstanceIds(v []*string) *DescribeInstancePatchStatesInput {
 	s.InstanceIds = v
@@ -14576,6 +15578,37 @@ func (s *DescribeInstancePatchesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeInstancePatchesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeInstancePatchesInput"}
+	if s.Filters != nil && len(s.Filters) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 5))
+	}
+	if s.InstanceId != nil && !request.MatchParamPattern("(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribeInstancePatchesInput) SetFilters(v []*PatchOrchestratorFilter) *DescribeInstancePatchesInput {
 	s.Filters = v
@@ -14724,6 +15757,46 @@ func (s *DescribeMaintenanceWindowExecutionTaskInvocationsInput) Validate() erro
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeMaintenanceWindowExecutionTaskInvocationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeMaintenanceWindowExecutionTaskInvocationsInput"}
+	if s.Filters != nil && len(s.Filters) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 5))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.TaskId != nil && len(*s.TaskId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskId", 36))
+	}
+	if s.TaskId != nil && !request.MatchParamPattern("^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$", *s.TaskId) {
+		invalidParams.Add(request.NewErrParamPattern("TaskId", "^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$"))
+	}
+	if s.WindowExecutionId != nil && len(*s.WindowExecutionId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowExecutionId", 36))
+	}
+	if s.WindowExecutionId != nil && !request.MatchParamPattern("^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$", *s.WindowExecutionId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowExecutionId", "^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$"))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribeMaintenanceWindowExecutionTaskInvocationsInput) SetFilters(v []*MaintenanceWindowFilter) *DescribeMaintenanceWindowExecutionTaskInvocationsInput {
 	s.Filters = v
@@ -14852,6 +15925,40 @@ func (s *DescribeMaintenanceWindowExecutionTasksInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeMaintenanceWindowExecutionTasksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeMaintenanceWindowExecutionTasksInput"}
+	if s.Filters != nil && len(s.Filters) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 5))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.WindowExecutionId != nil && len(*s.WindowExecutionId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowExecutionId", 36))
+	}
+	if s.WindowExecutionId != nil && !request.MatchParamPattern("^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$", *s.WindowExecutionId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowExecutionId", "^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$"))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribeMaintenanceWindowExecutionTasksInput) SetFilters(v []*MaintenanceWindowFilter) *DescribeMaintenanceWindowExecutionTasksInput {
 	s.Filters = v
@@ -14978,6 +16085,40 @@ func (s *DescribeMaintenanceWindowExecutionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeMaintenanceWindowExecutionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeMaintenanceWindowExecutionsInput"}
+	if s.Filters != nil && len(s.Filters) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 5))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.WindowId != nil && len(*s.WindowId) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowId", 20))
+	}
+	if s.WindowId != nil && !request.MatchParamPattern("^mw-[0-9a-f]{17}$", *s.WindowId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowId", "^mw-[0-9a-f]{17}$"))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribeMaintenanceWindowExecutionsInput) SetFilters(v []*MaintenanceWindowFilter) *DescribeMaintenanceWindowExecutionsInput {
 	s.Filters = v
@@ -15098,6 +16239,40 @@ func (s *DescribeMaintenanceWindowTargetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeMaintenanceWindowTargetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeMaintenanceWindowTargetsInput"}
+	if s.Filters != nil && len(s.Filters) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 5))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.WindowId != nil && len(*s.WindowId) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowId", 20))
+	}
+	if s.WindowId != nil && !request.MatchParamPattern("^mw-[0-9a-f]{17}$", *s.WindowId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowId", "^mw-[0-9a-f]{17}$"))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribeMaintenanceWindowTargetsInput) SetFilters(v []*MaintenanceWindowFilter) *DescribeMaintenanceWindowTargetsInput {
 	s.Filters = v
@@ -15218,6 +16393,40 @@ func (s *DescribeMaintenanceWindowTasksInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeMaintenanceWindowTasksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeMaintenanceWindowTasksInput"}
+	if s.Filters != nil && len(s.Filters) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 5))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.WindowId != nil && len(*s.WindowId) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowId", 20))
+	}
+	if s.WindowId != nil && !request.MatchParamPattern("^mw-[0-9a-f]{17}$", *s.WindowId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowId", "^mw-[0-9a-f]{17}$"))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribeMaintenanceWindowTasksInput) SetFilters(v []*MaintenanceWindowFilter) *DescribeMaintenanceWindowTasksInput {
 	s.Filters = v
@@ -15327,6 +16536,34 @@ func (s *DescribeMaintenanceWindowsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeMaintenanceWindowsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeMaintenanceWindowsInput"}
+	if s.Filters != nil && len(s.Filters) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 5))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribeMaintenanceWindowsInput) SetFilters(v []*MaintenanceWindowFilter) *DescribeMaintenanceWindowsInput {
 	s.Filters = v
@@ -15420,7 +16657,42 @@ func (s *DescribeParametersInput) Validate() error {
 			if v == nil {
 				continue
 			}
-			if err := v.Validate(); err != nil {
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.ParameterFilters != nil {
+		for i, v := range s.ParameterFilters {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ParameterFilters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeParametersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeParametersInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
 				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
 			}
 		}
@@ -15430,7 +16702,7 @@ func (s *DescribeParametersInput) Validate() error {
 			if v == nil {
 				continue
 			}
-			if err := v.Validate(); err != nil {
+			if err := v.ValidateExtended(); err != nil {
 				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ParameterFilters", i), err.(request.ErrInvalidParams))
 			}
 		}
@@ -15552,6 +16824,34 @@ func (s *DescribePatchBaselinesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribePatchBaselinesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribePatchBaselinesInput"}
+	if s.Filters != nil && len(s.Filters) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 5))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribePatchBaselinesInput) SetFilters(v []*PatchOrchestratorFilter) *DescribePatchBaselinesInput {
 	s.Filters = v
@@ -15640,6 +16940,24 @@ func (s *DescribePatchGroupStateInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribePatchGroupStateInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribePatchGroupStateInput"}
+	if s.PatchGroup != nil && len(*s.PatchGroup) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("PatchGroup", 256))
+	}
+	if s.PatchGroup != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.PatchGroup) {
+		invalidParams.Add(request.NewErrParamPattern("PatchGroup", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPatchGroup sets the PatchGroup field's value.
 func (s *DescribePatchGroupStateInput) SetPatchGroup(v string) *DescribePatchGroupStateInput {
 	s.PatchGroup = &v
@@ -15765,6 +17083,34 @@ func (s *DescribePatchGroupsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribePatchGroupsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribePatchGroupsInput"}
+	if s.Filters != nil && len(s.Filters) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 5))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *DescribePatchGroupsInput) SetFilters(v []*PatchOrchestratorFilter) *DescribePatchGroupsInput {
 	s.Filters = v
@@ -16055,6 +17401,24 @@ func (s *DocumentFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DocumentFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DocumentFilter"}
+	if s.Key != nil {
+		values := []string{"Name", "Owner", "PlatformTypes", "DocumentType"}
+		if !request.IsParamEnumValue(*s.Key, values) {
+			invalidParams.Add(request.NewErrParamEnum("Key", *s.Key, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *DocumentFilter) SetKey(v string) *DocumentFilter {
 	s.Key = &v
@@ -16412,6 +17776,21 @@ func (s *GetAutomationExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetAutomationExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetAutomationExecutionInput"}
+	if s.AutomationExecutionId != nil && len(*s.AutomationExecutionId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutomationExecutionId", 36))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutomationExecutionId sets the AutomationExecutionId field's value.
 func (s *GetAutomationExecutionInput) SetAutomationExecutionId(v string) *GetAutomationExecutionInput {
 	s.AutomationExecutionId = &v
@@ -16496,6 +17875,24 @@ func (s *GetCommandInvocationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetCommandInvocationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetCommandInvocationInput"}
+	if s.CommandId != nil && len(*s.CommandId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("CommandId", 36))
+	}
+	if s.InstanceId != nil && !request.MatchParamPattern("(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCommandId sets the CommandId field's value.
 func (s *GetCommandInvocationInput) SetCommandId(v string) *GetCommandInvocationInput {
 	s.CommandId = &v
@@ -16751,6 +18148,24 @@ func (s GetDefaultPatchBaselineInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDefaultPatchBaselineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDefaultPatchBaselineInput"}
+	if s.OperatingSystem != nil {
+		values := []string{"WINDOWS", "AMAZON_LINUX", "UBUNTU", "REDHAT_ENTERPRISE_LINUX"}
+		if !request.IsParamEnumValue(*s.OperatingSystem, values) {
+			invalidParams.Add(request.NewErrParamEnum("OperatingSystem", *s.OperatingSystem, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetOperatingSystem sets the OperatingSystem field's value.
 func (s *GetDefaultPatchBaselineInput) SetOperatingSystem(v string) *GetDefaultPatchBaselineInput {
 	s.OperatingSystem = &v
@@ -16835,6 +18250,27 @@ func (s *GetDeployablePatchSnapshotForInstanceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDeployablePatchSnapshotForInstanceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDeployablePatchSnapshotForInstanceInput"}
+	if s.InstanceId != nil && !request.MatchParamPattern("(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)"))
+	}
+	if s.SnapshotId != nil && len(*s.SnapshotId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("SnapshotId", 36))
+	}
+	if s.SnapshotId != nil && !request.MatchParamPattern("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$", *s.SnapshotId) {
+		invalidParams.Add(request.NewErrParamPattern("SnapshotId", "^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceId sets the InstanceId field's value.
 func (s *GetDeployablePatchSnapshotForInstanceInput) SetInstanceId(v string) *GetDeployablePatchSnapshotForInstanceInput {
 	s.InstanceId = &v
@@ -16935,6 +18371,24 @@ func (s *GetDocumentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDocumentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDocumentInput"}
+	if s.DocumentVersion != nil && !request.MatchParamPattern("([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)", *s.DocumentVersion) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentVersion", "([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)"))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.:/]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.:/]{3,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDocumentVersion sets the DocumentVersion field's value.
 func (s *GetDocumentInput) SetDocumentVersion(v string) *GetDocumentInput {
 	s.DocumentVersion = &v
@@ -17067,6 +18521,47 @@ func (s *GetInventoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetInventoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetInventoryInput"}
+	if s.Filters != nil && len(s.Filters) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 5))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.ResultAttributes != nil && len(s.ResultAttributes) > 1 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResultAttributes", 1))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.ResultAttributes != nil {
+		for i, v := range s.ResultAttributes {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ResultAttributes", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *GetInventoryInput) SetFilters(v []*InventoryFilter) *GetInventoryInput {
 	s.Filters = v
@@ -17168,6 +18663,24 @@ func (s *GetInventorySchemaInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetInventorySchemaInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetInventorySchemaInput"}
+	if s.MaxResults != nil && *s.MaxResults > 200 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 200))
+	}
+	if s.TypeName != nil && len(*s.TypeName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TypeName", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *GetInventorySchemaInput) SetMaxResults(v int64) *GetInventorySchemaInput {
 	s.MaxResults = &v
@@ -17262,6 +18775,24 @@ func (s *GetMaintenanceWindowExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetMaintenanceWindowExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetMaintenanceWindowExecutionInput"}
+	if s.WindowExecutionId != nil && len(*s.WindowExecutionId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowExecutionId", 36))
+	}
+	if s.WindowExecutionId != nil && !request.MatchParamPattern("^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$", *s.WindowExecutionId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowExecutionId", "^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetWindowExecutionId sets the WindowExecutionId field's value.
 func (s *GetMaintenanceWindowExecutionInput) SetWindowExecutionId(v string) *GetMaintenanceWindowExecutionInput {
 	s.WindowExecutionId = &v
@@ -17385,6 +18916,30 @@ func (s *GetMaintenanceWindowExecutionTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetMaintenanceWindowExecutionTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetMaintenanceWindowExecutionTaskInput"}
+	if s.TaskId != nil && len(*s.TaskId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskId", 36))
+	}
+	if s.TaskId != nil && !request.MatchParamPattern("^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$", *s.TaskId) {
+		invalidParams.Add(request.NewErrParamPattern("TaskId", "^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$"))
+	}
+	if s.WindowExecutionId != nil && len(*s.WindowExecutionId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowExecutionId", 36))
+	}
+	if s.WindowExecutionId != nil && !request.MatchParamPattern("^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$", *s.WindowExecutionId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowExecutionId", "^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTaskId sets the TaskId field's value.
 func (s *GetMaintenanceWindowExecutionTaskInput) SetTaskId(v string) *GetMaintenanceWindowExecutionTaskInput {
 	s.TaskId = &v
@@ -17456,6 +19011,36 @@ func (s *GetMaintenanceWindowExecutionTaskInvocationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetMaintenanceWindowExecutionTaskInvocationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetMaintenanceWindowExecutionTaskInvocationInput"}
+	if s.InvocationId != nil && len(*s.InvocationId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("InvocationId", 36))
+	}
+	if s.InvocationId != nil && !request.MatchParamPattern("^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$", *s.InvocationId) {
+		invalidParams.Add(request.NewErrParamPattern("InvocationId", "^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$"))
+	}
+	if s.TaskId != nil && len(*s.TaskId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskId", 36))
+	}
+	if s.TaskId != nil && !request.MatchParamPattern("^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$", *s.TaskId) {
+		invalidParams.Add(request.NewErrParamPattern("TaskId", "^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$"))
+	}
+	if s.WindowExecutionId != nil && len(*s.WindowExecutionId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowExecutionId", 36))
+	}
+	if s.WindowExecutionId != nil && !request.MatchParamPattern("^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$", *s.WindowExecutionId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowExecutionId", "^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInvocationId sets the InvocationId field's value.
 func (s *GetMaintenanceWindowExecutionTaskInvocationInput) SetInvocationId(v string) *GetMaintenanceWindowExecutionTaskInvocationInput {
 	s.InvocationId = &v
@@ -17489,10 +19074,10 @@ type GetMaintenanceWindowExecutionTaskInvocationOutput struct {
 
 	// User-provided value to be included in any CloudWatch events raised while
 	// running tasks for these targets in this Maintenance Window.
-	OwnerInformation *string `min:"1" type:"string"`
+	OwnerInformation *string `min:"1" type:"string" sensitive:"true"`
 
 	// The parameters used at the time that the task executed.
-	Parameters *string `type:"string"`
+	Parameters *string `type:"string" sensitive:"true"`
 
 	// The time that the task started executing on the target.
 	StartTime *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -17642,7 +19227,7 @@ type GetMaintenanceWindowExecutionTaskOutput struct {
 	// Key: string, between 1 and 255 characters
 	//
 	// Value: an array of strings, each string is between 1 and 255 characters
-	TaskParameters []map[string]*MaintenanceWindowTaskParameterValueExpression `type:"list"`
+	TaskParameters []map[string]*MaintenanceWindowTaskParameterValueExpression `type:"list" sensitive:"true"`
 
 	// The type of task executed.
 	Type *string `type:"string" enum:"MaintenanceWindowTaskType"`
@@ -17775,6 +19360,24 @@ func (s *GetMaintenanceWindowInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetMaintenanceWindowInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetMaintenanceWindowInput"}
+	if s.WindowId != nil && len(*s.WindowId) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowId", 20))
+	}
+	if s.WindowId != nil && !request.MatchParamPattern("^mw-[0-9a-f]{17}$", *s.WindowId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowId", "^mw-[0-9a-f]{17}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetWindowId sets the WindowId field's value.
 func (s *GetMaintenanceWindowInput) SetWindowId(v string) *GetMaintenanceWindowInput {
 	s.WindowId = &v
@@ -17797,7 +19400,7 @@ type GetMaintenanceWindowOutput struct {
 	Cutoff *int64 `type:"integer"`
 
 	// The description of the Maintenance Window.
-	Description *string `min:"1" type:"string"`
+	Description *string `min:"1" type:"string" sensitive:"true"`
 
 	// The duration of the Maintenance Window in hours.
 	Duration *int64 `min:"1" type:"integer"`
@@ -17935,6 +19538,30 @@ func (s *GetMaintenanceWindowTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetMaintenanceWindowTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetMaintenanceWindowTaskInput"}
+	if s.WindowId != nil && len(*s.WindowId) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowId", 20))
+	}
+	if s.WindowId != nil && !request.MatchParamPattern("^mw-[0-9a-f]{17}$", *s.WindowId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowId", "^mw-[0-9a-f]{17}$"))
+	}
+	if s.WindowTaskId != nil && len(*s.WindowTaskId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowTaskId", 36))
+	}
+	if s.WindowTaskId != nil && !request.MatchParamPattern("^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$", *s.WindowTaskId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowTaskId", "^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetWindowId sets the WindowId field's value.
 func (s *GetMaintenanceWindowTaskInput) SetWindowId(v string) *GetMaintenanceWindowTaskInput {
 	s.WindowId = &v
@@ -17952,7 +19579,7 @@ type GetMaintenanceWindowTaskOutput struct {
 	_ struct{} `type:"structure"`
 
 	// The retrieved task description.
-	Description *string `min:"1" type:"string"`
+	Description *string `min:"1" type:"string" sensitive:"true"`
 
 	// The location in Amazon S3 where the task results are logged.
 	LoggingInfo *LoggingInfo `type:"structure"`
@@ -17986,7 +19613,7 @@ type GetMaintenanceWindowTaskOutput struct {
 	TaskInvocationParameters *MaintenanceWindowTaskInvocationParameters `type:"structure"`
 
 	// The parameters to pass to the task when it executes.
-	TaskParameters map[string]*MaintenanceWindowTaskParameterValueExpression `type:"map"`
+	TaskParameters map[string]*MaintenanceWindowTaskParameterValueExpression `type:"map" sensitive:"true"`
 
 	// The type of task to execute.
 	TaskType *string `type:"string" enum:"MaintenanceWindowTaskType"`
@@ -18144,6 +19771,24 @@ func (s *GetParameterHistoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetParameterHistoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetParameterHistoryInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *GetParameterHistoryInput) SetMaxResults(v int64) *GetParameterHistoryInput {
 	s.MaxResults = &v
@@ -18242,6 +19887,21 @@ func (s *GetParameterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetParameterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetParameterInput"}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *GetParameterInput) SetName(v string) *GetParameterInput {
 	s.Name = &v
@@ -18348,6 +20008,34 @@ func (s *GetParametersByPathInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetParametersByPathInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetParametersByPathInput"}
+	if s.MaxResults != nil && *s.MaxResults > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 10))
+	}
+	if s.Path != nil && len(*s.Path) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Path", 1024))
+	}
+	if s.ParameterFilters != nil {
+		for i, v := range s.ParameterFilters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "ParameterFilters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *GetParametersByPathInput) SetMaxResults(v int64) *GetParametersByPathInput {
 	s.MaxResults = &v
@@ -18459,6 +20147,21 @@ func (s *GetParametersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetParametersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetParametersInput"}
+	if s.Names != nil && len(s.Names) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Names", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNames sets the Names field's value.
 func (s *GetParametersInput) SetNames(v []*string) *GetParametersInput {
 	s.Names = v
@@ -18545,6 +20248,30 @@ func (s *GetPatchBaselineForPatchGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetPatchBaselineForPatchGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPatchBaselineForPatchGroupInput"}
+	if s.OperatingSystem != nil {
+		values := []string{"WINDOWS", "AMAZON_LINUX", "UBUNTU", "REDHAT_ENTERPRISE_LINUX"}
+		if !request.IsParamEnumValue(*s.OperatingSystem, values) {
+			invalidParams.Add(request.NewErrParamEnum("OperatingSystem", *s.OperatingSystem, values))
+		}
+	}
+	if s.PatchGroup != nil && len(*s.PatchGroup) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("PatchGroup", 256))
+	}
+	if s.PatchGroup != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.PatchGroup) {
+		invalidParams.Add(request.NewErrParamPattern("PatchGroup", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetOperatingSystem sets the OperatingSystem field's value.
 func (s *GetPatchBaselineForPatchGroupInput) SetOperatingSystem(v string) *GetPatchBaselineForPatchGroupInput {
 	s.OperatingSystem = &v
@@ -18635,6 +20362,24 @@ func (s *GetPatchBaselineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetPatchBaselineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetPatchBaselineInput"}
+	if s.BaselineId != nil && len(*s.BaselineId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("BaselineId", 128))
+	}
+	if s.BaselineId != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-:/]{20,128}$", *s.BaselineId) {
+		invalidParams.Add(request.NewErrParamPattern("BaselineId", "^[a-zA-Z0-9_\\-:/]{20,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBaselineId sets the BaselineId field's value.
 func (s *GetPatchBaselineInput) SetBaselineId(v string) *GetPatchBaselineInput {
 	s.BaselineId = &v
@@ -18885,6 +20630,23 @@ func (s *InstanceAssociationOutputLocation) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InstanceAssociationOutputLocation) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InstanceAssociationOutputLocation"}
+	if s.S3Location != nil {
+		if err := s.S3Location.ValidateExtended(); err != nil {
+			invalidParams.AddNested("S3Location", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetS3Location sets the S3Location field's value.
 func (s *InstanceAssociationOutputLocation) SetS3Location(v *S3OutputLocation) *InstanceAssociationOutputLocation {
 	s.S3Location = v
@@ -19274,6 +21036,27 @@ func (s *InstanceInformationFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InstanceInformationFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InstanceInformationFilter"}
+	if s.Key != nil {
+		values := []string{"InstanceIds", "AgentVersion", "PingStatus", "PlatformTypes", "ActivationIds", "IamRole", "ResourceType", "AssociationStatus"}
+		if !request.IsParamEnumValue(*s.Key, values) {
+			invalidParams.Add(request.NewErrParamEnum("Key", *s.Key, values))
+		}
+	}
+	if s.ValueSet != nil && len(s.ValueSet) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ValueSet", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *InstanceInformationFilter) SetKey(v string) *InstanceInformationFilter {
 	s.Key = &v
@@ -19337,6 +21120,21 @@ func (s *InstanceInformationStringFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InstanceInformationStringFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InstanceInformationStringFilter"}
+	if s.Values != nil && len(s.Values) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Values", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *InstanceInformationStringFilter) SetKey(v string) *InstanceInformationStringFilter {
 	s.Key = &v
@@ -19405,7 +21203,7 @@ type InstancePatchState struct {
 
 	// Placeholder information, this field will always be empty in the current release
 	// of the service.
-	OwnerInformation *string `min:"1" type:"string"`
+	OwnerInformation *string `min:"1" type:"string" sensitive:"true"`
 
 	// The name of the patch group the managed instance belongs to.
 	//
@@ -19564,6 +21362,30 @@ func (s *InstancePatchStateFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InstancePatchStateFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InstancePatchStateFilter"}
+	if s.Key != nil && len(*s.Key) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 200))
+	}
+	if s.Type != nil {
+		values := []string{"Equal", "NotEqual", "LessThan", "GreaterThan"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.Values != nil && len(s.Values) > 1 {
+		invalidParams.Add(request.NewErrParamMaxLen("Values", 1))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *InstancePatchStateFilter) SetKey(v string) *InstancePatchStateFilter {
 	s.Key = &v
@@ -19635,6 +21457,30 @@ func (s *InventoryFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InventoryFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InventoryFilter"}
+	if s.Key != nil && len(*s.Key) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 200))
+	}
+	if s.Type != nil {
+		values := []string{"Equal", "NotEqual", "BeginWith", "LessThan", "GreaterThan"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.Values != nil && len(s.Values) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("Values", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *InventoryFilter) SetKey(v string) *InventoryFilter {
 	s.Key = &v
@@ -19724,6 +21570,39 @@ func (s *InventoryItem) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InventoryItem) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InventoryItem"}
+	if s.CaptureTime != nil && !request.MatchParamPattern("^(20)[0-9][0-9]-(0[1-9]|1[012])-([12][0-9]|3[01]|0[1-9])(T)(2[0-3]|[0-1][0-9])(:[0-5][0-9])(:[0-5][0-9])(Z)$", *s.CaptureTime) {
+		invalidParams.Add(request.NewErrParamPattern("CaptureTime", "^(20)[0-9][0-9]-(0[1-9]|1[012])-([12][0-9]|3[01]|0[1-9])(T)(2[0-3]|[0-1][0-9])(:[0-5][0-9])(:[0-5][0-9])(Z)$"))
+	}
+	if s.Content != nil && len(s.Content) > 10000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Content", 10000))
+	}
+	if s.ContentHash != nil && len(*s.ContentHash) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ContentHash", 256))
+	}
+	if s.Context != nil && len(s.Context) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Context", 50))
+	}
+	if s.SchemaVersion != nil && !request.MatchParamPattern("^([0-9]{1,6})(\\.[0-9]{1,6})$", *s.SchemaVersion) {
+		invalidParams.Add(request.NewErrParamPattern("SchemaVersion", "^([0-9]{1,6})(\\.[0-9]{1,6})$"))
+	}
+	if s.TypeName != nil && len(*s.TypeName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TypeName", 100))
+	}
+	if s.TypeName != nil && !request.MatchParamPattern("^(AWS|Custom):.*$", *s.TypeName) {
+		invalidParams.Add(request.NewErrParamPattern("TypeName", "^(AWS|Custom):.*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCaptureTime sets the CaptureTime field's value.
 func (s *InventoryItem) SetCaptureTime(v string) *InventoryItem {
 	s.CaptureTime = &v
@@ -20002,6 +21881,24 @@ func (s *ListAssociationVersionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAssociationVersionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAssociationVersionsInput"}
+	if s.AssociationId != nil && !request.MatchParamPattern("[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}", *s.AssociationId) {
+		invalidParams.Add(request.NewErrParamPattern("AssociationId", "[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssociationId sets the AssociationId field's value.
 func (s *ListAssociationVersionsInput) SetAssociationId(v string) *ListAssociationVersionsInput {
 	s.AssociationId = &v
@@ -20108,6 +22005,31 @@ func (s *ListAssociationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListAssociationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListAssociationsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.AssociationFilterList != nil {
+		for i, v := range s.AssociationFilterList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "AssociationFilterList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssociationFilterList sets the AssociationFilterList field's value.
 func (s *ListAssociationsInput) SetAssociationFilterList(v []*AssociationFilter) *ListAssociationsInput {
 	s.AssociationFilterList = v
@@ -20227,6 +22149,40 @@ func (s *ListCommandInvocationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListCommandInvocationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListCommandInvocationsInput"}
+	if s.CommandId != nil && len(*s.CommandId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("CommandId", 36))
+	}
+	if s.Filters != nil && len(s.Filters) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 3))
+	}
+	if s.InstanceId != nil && !request.MatchParamPattern("(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCommandId sets the CommandId field's value.
 func (s *ListCommandInvocationsInput) SetCommandId(v string) *ListCommandInvocationsInput {
 	s.CommandId = &v
@@ -20360,6 +22316,40 @@ func (s *ListCommandsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListCommandsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListCommandsInput"}
+	if s.CommandId != nil && len(*s.CommandId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("CommandId", 36))
+	}
+	if s.Filters != nil && len(s.Filters) > 3 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 3))
+	}
+	if s.InstanceId != nil && !request.MatchParamPattern("(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCommandId sets the CommandId field's value.
 func (s *ListCommandsInput) SetCommandId(v string) *ListCommandsInput {
 	s.CommandId = &v
@@ -20488,6 +22478,31 @@ func (s *ListComplianceItemsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListComplianceItemsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListComplianceItemsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *ListComplianceItemsInput) SetFilters(v []*ComplianceStringFilter) *ListComplianceItemsInput {
 	s.Filters = v
@@ -20602,6 +22617,31 @@ func (s *ListComplianceSummariesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListComplianceSummariesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListComplianceSummariesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *ListComplianceSummariesInput) SetFilters(v []*ComplianceStringFilter) *ListComplianceSummariesInput {
 	s.Filters = v
@@ -20701,6 +22741,24 @@ func (s *ListDocumentVersionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListDocumentVersionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDocumentVersionsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListDocumentVersionsInput) SetMaxResults(v int64) *ListDocumentVersionsInput {
 	s.MaxResults = &v
@@ -20806,6 +22864,31 @@ func (s *ListDocumentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListDocumentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDocumentsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.DocumentFilterList != nil {
+		for i, v := range s.DocumentFilterList {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "DocumentFilterList", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDocumentFilterList sets the DocumentFilterList field's value.
 func (s *ListDocumentsInput) SetDocumentFilterList(v []*DocumentFilter) *ListDocumentsInput {
 	s.DocumentFilterList = v
@@ -20904,21 +22987,58 @@ func (s *ListInventoryEntriesInput) Validate() error {
 	if s.InstanceId == nil {
 		invalidParams.Add(request.NewErrParamRequired("InstanceId"))
 	}
-	if s.MaxResults != nil && *s.MaxResults < 1 {
-		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	if s.MaxResults != nil && *s.MaxResults < 1 {
+		invalidParams.Add(request.NewErrParamMinValue("MaxResults", 1))
+	}
+	if s.TypeName == nil {
+		invalidParams.Add(request.NewErrParamRequired("TypeName"))
+	}
+	if s.TypeName != nil && len(*s.TypeName) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("TypeName", 1))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListInventoryEntriesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListInventoryEntriesInput"}
+	if s.Filters != nil && len(s.Filters) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Filters", 5))
+	}
+	if s.InstanceId != nil && !request.MatchParamPattern("(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)"))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
 	}
-	if s.TypeName == nil {
-		invalidParams.Add(request.NewErrParamRequired("TypeName"))
+	if s.TypeName != nil && len(*s.TypeName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TypeName", 100))
 	}
-	if s.TypeName != nil && len(*s.TypeName) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("TypeName", 1))
+	if s.TypeName != nil && !request.MatchParamPattern("^(AWS|Custom):.*$", *s.TypeName) {
+		invalidParams.Add(request.NewErrParamPattern("TypeName", "^(AWS|Custom):.*$"))
 	}
 	if s.Filters != nil {
 		for i, v := range s.Filters {
 			if v == nil {
 				continue
 			}
-			if err := v.Validate(); err != nil {
+			if err := v.ValidateExtended(); err != nil {
 				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
 			}
 		}
@@ -21079,6 +23199,31 @@ func (s *ListResourceComplianceSummariesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListResourceComplianceSummariesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListResourceComplianceSummariesInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+	if s.Filters != nil {
+		for i, v := range s.Filters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Filters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFilters sets the Filters field's value.
 func (s *ListResourceComplianceSummariesInput) SetFilters(v []*ComplianceStringFilter) *ListResourceComplianceSummariesInput {
 	s.Filters = v
@@ -21169,6 +23314,21 @@ func (s *ListResourceDataSyncInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListResourceDataSyncInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListResourceDataSyncInput"}
+	if s.MaxResults != nil && *s.MaxResults > 50 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaxResults sets the MaxResults field's value.
 func (s *ListResourceDataSyncInput) SetMaxResults(v int64) *ListResourceDataSyncInput {
 	s.MaxResults = &v
@@ -21256,6 +23416,24 @@ func (s *ListTagsForResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsForResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
+	if s.ResourceType != nil {
+		values := []string{"ManagedInstance", "MaintenanceWindow", "Parameter"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *ListTagsForResourceInput) SetResourceId(v string) *ListTagsForResourceInput {
 	s.ResourceId = &v
@@ -21343,6 +23521,27 @@ func (s *LoggingInfo) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *LoggingInfo) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "LoggingInfo"}
+	if s.S3BucketName != nil && len(*s.S3BucketName) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3BucketName", 63))
+	}
+	if s.S3KeyPrefix != nil && len(*s.S3KeyPrefix) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3KeyPrefix", 500))
+	}
+	if s.S3Region != nil && len(*s.S3Region) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("S3Region", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetS3BucketName sets the S3BucketName field's value.
 func (s *LoggingInfo) SetS3BucketName(v string) *LoggingInfo {
 	s.S3BucketName = &v
@@ -21396,6 +23595,24 @@ func (s *MaintenanceWindowAutomationParameters) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MaintenanceWindowAutomationParameters) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MaintenanceWindowAutomationParameters"}
+	if s.DocumentVersion != nil && !request.MatchParamPattern("([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)", *s.DocumentVersion) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentVersion", "([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)"))
+	}
+	if s.Parameters != nil && len(s.Parameters) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Parameters", 200))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDocumentVersion sets the DocumentVersion field's value.
 func (s *MaintenanceWindowAutomationParameters) SetDocumentVersion(v string) *MaintenanceWindowAutomationParameters {
 	s.DocumentVersion = &v
@@ -21587,10 +23804,10 @@ type MaintenanceWindowExecutionTaskInvocationIdentity struct {
 	// User-provided value that was specified when the target was registered with
 	// the Maintenance Window. This was also included in any CloudWatch events raised
 	// during the task invocation.
-	OwnerInformation *string `min:"1" type:"string"`
+	OwnerInformation *string `min:"1" type:"string" sensitive:"true"`
 
 	// The parameters that were provided for the invocation when it was executed.
-	Parameters *string `type:"string"`
+	Parameters *string `type:"string" sensitive:"true"`
 
 	// The time the invocation started.
 	StartTime *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -21733,6 +23950,21 @@ func (s *MaintenanceWindowFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MaintenanceWindowFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MaintenanceWindowFilter"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *MaintenanceWindowFilter) SetKey(v string) *MaintenanceWindowFilter {
 	s.Key = &v
@@ -21755,7 +23987,7 @@ type MaintenanceWindowIdentity struct {
 	Cutoff *int64 `type:"integer"`
 
 	// A description of the Maintenance Window.
-	Description *string `min:"1" type:"string"`
+	Description *string `min:"1" type:"string" sensitive:"true"`
 
 	// The duration of the Maintenance Window in hours.
 	Duration *int64 `min:"1" type:"integer"`
@@ -21829,7 +24061,7 @@ type MaintenanceWindowLambdaParameters struct {
 	// JSON to provide to your Lambda function as input.
 	//
 	// Payload is automatically base64 encoded/decoded by the SDK.
-	Payload []byte `type:"blob"`
+	Payload []byte `type:"blob" sensitive:"true"`
 
 	// (Optional) Specify a Lambda function version or alias name. If you specify
 	// a function version, the action uses the qualified function ARN to invoke
@@ -21864,6 +24096,27 @@ func (s *MaintenanceWindowLambdaParameters) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MaintenanceWindowLambdaParameters) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MaintenanceWindowLambdaParameters"}
+	if s.ClientContext != nil && len(*s.ClientContext) > 8000 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientContext", 8000))
+	}
+	if s.Payload != nil && len(s.Payload) > 4096 {
+		invalidParams.Add(request.NewErrParamMaxLen("Payload", 4096))
+	}
+	if s.Qualifier != nil && len(*s.Qualifier) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Qualifier", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientContext sets the ClientContext field's value.
 func (s *MaintenanceWindowLambdaParameters) SetClientContext(v string) *MaintenanceWindowLambdaParameters {
 	s.ClientContext = &v
@@ -21944,6 +24197,44 @@ func (s *MaintenanceWindowRunCommandParameters) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MaintenanceWindowRunCommandParameters) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MaintenanceWindowRunCommandParameters"}
+	if s.Comment != nil && len(*s.Comment) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Comment", 100))
+	}
+	if s.DocumentHash != nil && len(*s.DocumentHash) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("DocumentHash", 256))
+	}
+	if s.DocumentHashType != nil {
+		values := []string{"Sha256", "Sha1"}
+		if !request.IsParamEnumValue(*s.DocumentHashType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DocumentHashType", *s.DocumentHashType, values))
+		}
+	}
+	if s.OutputS3BucketName != nil && len(*s.OutputS3BucketName) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("OutputS3BucketName", 63))
+	}
+	if s.OutputS3KeyPrefix != nil && len(*s.OutputS3KeyPrefix) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("OutputS3KeyPrefix", 500))
+	}
+	if s.TimeoutSeconds != nil && *s.TimeoutSeconds > 2.592e+06 {
+		invalidParams.Add(request.NewErrParamMaxValue("TimeoutSeconds", 2.592e+06))
+	}
+	if s.NotificationConfig != nil {
+		if err := s.NotificationConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("NotificationConfig", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComment sets the Comment field's value.
 func (s *MaintenanceWindowRunCommandParameters) SetComment(v string) *MaintenanceWindowRunCommandParameters {
 	s.Comment = &v
@@ -22004,7 +24295,7 @@ type MaintenanceWindowStepFunctionsParameters struct {
 	_ struct{} `type:"structure"`
 
 	// The inputs for the STEP_FUNCTION task.
-	Input *string `type:"string"`
+	Input *string `type:"string" sensitive:"true"`
 
 	// The name of the STEP_FUNCTION task.
 	Name *string `min:"1" type:"string"`
@@ -22033,6 +24324,24 @@ func (s *MaintenanceWindowStepFunctionsParameters) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MaintenanceWindowStepFunctionsParameters) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MaintenanceWindowStepFunctionsParameters"}
+	if s.Input != nil && len(*s.Input) > 4096 {
+		invalidParams.Add(request.NewErrParamMaxLen("Input", 4096))
+	}
+	if s.Name != nil && len(*s.Name) > 80 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 80))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInput sets the Input field's value.
 func (s *MaintenanceWindowStepFunctionsParameters) SetInput(v string) *MaintenanceWindowStepFunctionsParameters {
 	s.Input = &v
@@ -22051,14 +24360,14 @@ type MaintenanceWindowTarget struct {
 	_ struct{} `type:"structure"`
 
 	// A description of the target.
-	Description *string `min:"1" type:"string"`
+	Description *string `min:"1" type:"string" sensitive:"true"`
 
 	// The target name.
 	Name *string `min:"3" type:"string"`
 
 	// User-provided value that will be included in any CloudWatch events raised
 	// while running tasks for these targets in this Maintenance Window.
-	OwnerInformation *string `min:"1" type:"string"`
+	OwnerInformation *string `min:"1" type:"string" sensitive:"true"`
 
 	// The type of target.
 	ResourceType *string `type:"string" enum:"MaintenanceWindowResourceType"`
@@ -22132,7 +24441,7 @@ type MaintenanceWindowTask struct {
 	_ struct{} `type:"structure"`
 
 	// A description of the task.
-	Description *string `min:"1" type:"string"`
+	Description *string `min:"1" type:"string" sensitive:"true"`
 
 	// Information about an Amazon S3 bucket to write task-level logs to.
 	LoggingInfo *LoggingInfo `type:"structure"`
@@ -22165,7 +24474,7 @@ type MaintenanceWindowTask struct {
 	TaskArn *string `min:"1" type:"string"`
 
 	// The parameters that should be passed to the task when it is executed.
-	TaskParameters map[string]*MaintenanceWindowTaskParameterValueExpression `type:"map"`
+	TaskParameters map[string]*MaintenanceWindowTaskParameterValueExpression `type:"map" sensitive:"true"`
 
 	// The type of task. The type can be one of the following: RUN_COMMAND, AUTOMATION,
 	// LAMBDA, or STEP_FUNCTION.
@@ -22324,6 +24633,38 @@ func (s *MaintenanceWindowTaskInvocationParameters) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *MaintenanceWindowTaskInvocationParameters) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "MaintenanceWindowTaskInvocationParameters"}
+	if s.Automation != nil {
+		if err := s.Automation.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Automation", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Lambda != nil {
+		if err := s.Lambda.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Lambda", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RunCommand != nil {
+		if err := s.RunCommand.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RunCommand", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.StepFunctions != nil {
+		if err := s.StepFunctions.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StepFunctions", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutomation sets the Automation field's value.
 func (s *MaintenanceWindowTaskInvocationParameters) SetAutomation(v *MaintenanceWindowAutomationParameters) *MaintenanceWindowTaskInvocationParameters {
 	s.Automation = v
@@ -22351,11 +24692,11 @@ func (s *MaintenanceWindowTaskInvocationParameters) SetStepFunctions(v *Maintena
 // Defines the values for a task parameter.
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/ssm-2014-11-06/MaintenanceWindowTaskParameterValueExpression
 type MaintenanceWindowTaskParameterValueExpression struct {
-	_ struct{} `type:"structure"`
+	_ struct{} `type:"structure" sensitive:"true"`
 
 	// This field contains an array of 0 or more strings, each 1 to 255 characters
 	// in length.
-	Values []*string `type:"list"`
+	Values []*string `type:"list" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -22425,6 +24766,33 @@ func (s *ModifyDocumentPermissionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyDocumentPermissionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyDocumentPermissionInput"}
+	if s.AccountIdsToAdd != nil && len(s.AccountIdsToAdd) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountIdsToAdd", 20))
+	}
+	if s.AccountIdsToRemove != nil && len(s.AccountIdsToRemove) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("AccountIdsToRemove", 20))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.PermissionType != nil {
+		values := []string{"Share"}
+		if !request.IsParamEnumValue(*s.PermissionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("PermissionType", *s.PermissionType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAccountIdsToAdd sets the AccountIdsToAdd field's value.
 func (s *ModifyDocumentPermissionInput) SetAccountIdsToAdd(v []*string) *ModifyDocumentPermissionInput {
 	s.AccountIdsToAdd = v
@@ -22531,6 +24899,24 @@ func (s NotificationConfig) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NotificationConfig) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NotificationConfig"}
+	if s.NotificationType != nil {
+		values := []string{"Command", "Invocation"}
+		if !request.IsParamEnumValue(*s.NotificationType, values) {
+			invalidParams.Add(request.NewErrParamEnum("NotificationType", *s.NotificationType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNotificationArn sets the NotificationArn field's value.
 func (s *NotificationConfig) SetNotificationArn(v string) *NotificationConfig {
 	s.NotificationArn = &v
@@ -22816,6 +25202,30 @@ func (s *ParameterStringFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ParameterStringFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ParameterStringFilter"}
+	if s.Key != nil && len(*s.Key) > 132 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 132))
+	}
+	if s.Key != nil && !request.MatchParamPattern("tag:.+|Name|Type|KeyId|Path", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "tag:.+|Name|Type|KeyId|Path"))
+	}
+	if s.Option != nil && len(*s.Option) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("Option", 10))
+	}
+	if s.Values != nil && len(s.Values) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Values", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *ParameterStringFilter) SetKey(v string) *ParameterStringFilter {
 	s.Key = &v
@@ -22879,6 +25289,27 @@ func (s *ParametersFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ParametersFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ParametersFilter"}
+	if s.Key != nil {
+		values := []string{"Name", "Type", "KeyId"}
+		if !request.IsParamEnumValue(*s.Key, values) {
+			invalidParams.Add(request.NewErrParamEnum("Key", *s.Key, values))
+		}
+	}
+	if s.Values != nil && len(s.Values) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Values", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *ParametersFilter) SetKey(v string) *ParametersFilter {
 	s.Key = &v
@@ -23220,6 +25651,27 @@ func (s *PatchFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PatchFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PatchFilter"}
+	if s.Key != nil {
+		values := []string{"PRODUCT", "CLASSIFICATION", "MSRC_SEVERITY", "PATCH_ID", "SECTION", "PRIORITY", "SEVERITY"}
+		if !request.IsParamEnumValue(*s.Key, values) {
+			invalidParams.Add(request.NewErrParamEnum("Key", *s.Key, values))
+		}
+	}
+	if s.Values != nil && len(s.Values) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("Values", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *PatchFilter) SetKey(v string) *PatchFilter {
 	s.Key = &v
@@ -23276,6 +25728,31 @@ func (s *PatchFilterGroup) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PatchFilterGroup) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PatchFilterGroup"}
+	if s.PatchFilters != nil && len(s.PatchFilters) > 4 {
+		invalidParams.Add(request.NewErrParamMaxLen("PatchFilters", 4))
+	}
+	if s.PatchFilters != nil {
+		for i, v := range s.PatchFilters {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchFilters", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetPatchFilters sets the PatchFilters field's value.
 func (s *PatchFilterGroup) SetPatchFilters(v []*PatchFilter) *PatchFilterGroup {
 	s.PatchFilters = v
@@ -23352,6 +25829,21 @@ func (s *PatchOrchestratorFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PatchOrchestratorFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PatchOrchestratorFilter"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *PatchOrchestratorFilter) SetKey(v string) *PatchOrchestratorFilter {
 	s.Key = &v
@@ -23417,6 +25909,32 @@ func (s *PatchRule) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PatchRule) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PatchRule"}
+	if s.ApproveAfterDays != nil && *s.ApproveAfterDays > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("ApproveAfterDays", 100))
+	}
+	if s.ComplianceLevel != nil {
+		values := []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "INFORMATIONAL", "UNSPECIFIED"}
+		if !request.IsParamEnumValue(*s.ComplianceLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("ComplianceLevel", *s.ComplianceLevel, values))
+		}
+	}
+	if s.PatchFilterGroup != nil {
+		if err := s.PatchFilterGroup.ValidateExtended(); err != nil {
+			invalidParams.AddNested("PatchFilterGroup", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApproveAfterDays sets the ApproveAfterDays field's value.
 func (s *PatchRule) SetApproveAfterDays(v int64) *PatchRule {
 	s.ApproveAfterDays = &v
@@ -23456,18 +25974,43 @@ func (s PatchRuleGroup) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *PatchRuleGroup) Validate() error {
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *PatchRuleGroup) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "PatchRuleGroup"}
+	if s.PatchRules == nil {
+		invalidParams.Add(request.NewErrParamRequired("PatchRules"))
+	}
+	if s.PatchRules != nil {
+		for i, v := range s.PatchRules {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchRules", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PatchRuleGroup) ValidateExtended() error {
 	invalidParams := request.ErrInvalidParams{Context: "PatchRuleGroup"}
-	if s.PatchRules == nil {
-		invalidParams.Add(request.NewErrParamRequired("PatchRules"))
+	if s.PatchRules != nil && len(s.PatchRules) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("PatchRules", 10))
 	}
 	if s.PatchRules != nil {
 		for i, v := range s.PatchRules {
 			if v == nil {
 				continue
 			}
-			if err := v.Validate(); err != nil {
+			if err := v.ValidateExtended(); err != nil {
 				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "PatchRules", i), err.(request.ErrInvalidParams))
 			}
 		}
@@ -23630,6 +26173,51 @@ func (s *PutComplianceItemsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutComplianceItemsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutComplianceItemsInput"}
+	if s.ComplianceType != nil && len(*s.ComplianceType) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ComplianceType", 100))
+	}
+	if s.ComplianceType != nil && !request.MatchParamPattern("[A-Za-z0-9_\\-]\\w+|Custom:[a-zA-Z0-9_\\-]\\w+", *s.ComplianceType) {
+		invalidParams.Add(request.NewErrParamPattern("ComplianceType", "[A-Za-z0-9_\\-]\\w+|Custom:[a-zA-Z0-9_\\-]\\w+"))
+	}
+	if s.ItemContentHash != nil && len(*s.ItemContentHash) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ItemContentHash", 256))
+	}
+	if s.Items != nil && len(s.Items) > 10000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Items", 10000))
+	}
+	if s.ResourceId != nil && len(*s.ResourceId) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 100))
+	}
+	if s.ResourceType != nil && len(*s.ResourceType) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceType", 50))
+	}
+	if s.ExecutionSummary != nil {
+		if err := s.ExecutionSummary.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ExecutionSummary", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Items != nil {
+		for i, v := range s.Items {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Items", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComplianceType sets the ComplianceType field's value.
 func (s *PutComplianceItemsInput) SetComplianceType(v string) *PutComplianceItemsInput {
 	s.ComplianceType = &v
@@ -23735,6 +26323,34 @@ func (s *PutInventoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutInventoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutInventoryInput"}
+	if s.InstanceId != nil && !request.MatchParamPattern("(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)"))
+	}
+	if s.Items != nil && len(s.Items) > 30 {
+		invalidParams.Add(request.NewErrParamMaxLen("Items", 30))
+	}
+	if s.Items != nil {
+		for i, v := range s.Items {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Items", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInstanceId sets the InstanceId field's value.
 func (s *PutInventoryInput) SetInstanceId(v string) *PutInventoryInput {
 	s.InstanceId = &v
@@ -23839,6 +26455,42 @@ func (s *PutParameterInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PutParameterInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PutParameterInput"}
+	if s.AllowedPattern != nil && len(*s.AllowedPattern) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("AllowedPattern", 1024))
+	}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.KeyId != nil && len(*s.KeyId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("KeyId", 256))
+	}
+	if s.KeyId != nil && !request.MatchParamPattern("^([a-zA-Z0-9:/_-]+)$", *s.KeyId) {
+		invalidParams.Add(request.NewErrParamPattern("KeyId", "^([a-zA-Z0-9:/_-]+)$"))
+	}
+	if s.Name != nil && len(*s.Name) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 1024))
+	}
+	if s.Type != nil {
+		values := []string{"String", "StringList", "SecureString"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.Value != nil && len(*s.Value) > 4096 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 4096))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllowedPattern sets the AllowedPattern field's value.
 func (s *PutParameterInput) SetAllowedPattern(v string) *PutParameterInput {
 	s.AllowedPattern = &v
@@ -23932,6 +26584,24 @@ func (s *RegisterDefaultPatchBaselineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterDefaultPatchBaselineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterDefaultPatchBaselineInput"}
+	if s.BaselineId != nil && len(*s.BaselineId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("BaselineId", 128))
+	}
+	if s.BaselineId != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-:/]{20,128}$", *s.BaselineId) {
+		invalidParams.Add(request.NewErrParamPattern("BaselineId", "^[a-zA-Z0-9_\\-:/]{20,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBaselineId sets the BaselineId field's value.
 func (s *RegisterDefaultPatchBaselineInput) SetBaselineId(v string) *RegisterDefaultPatchBaselineInput {
 	s.BaselineId = &v
@@ -24009,6 +26679,30 @@ func (s *RegisterPatchBaselineForPatchGroupInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterPatchBaselineForPatchGroupInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterPatchBaselineForPatchGroupInput"}
+	if s.BaselineId != nil && len(*s.BaselineId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("BaselineId", 128))
+	}
+	if s.BaselineId != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-:/]{20,128}$", *s.BaselineId) {
+		invalidParams.Add(request.NewErrParamPattern("BaselineId", "^[a-zA-Z0-9_\\-:/]{20,128}$"))
+	}
+	if s.PatchGroup != nil && len(*s.PatchGroup) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("PatchGroup", 256))
+	}
+	if s.PatchGroup != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.PatchGroup) {
+		invalidParams.Add(request.NewErrParamPattern("PatchGroup", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBaselineId sets the BaselineId field's value.
 func (s *RegisterPatchBaselineForPatchGroupInput) SetBaselineId(v string) *RegisterPatchBaselineForPatchGroupInput {
 	s.BaselineId = &v
@@ -24062,14 +26756,14 @@ type RegisterTargetWithMaintenanceWindowInput struct {
 	ClientToken *string `min:"1" type:"string" idempotencyToken:"true"`
 
 	// An optional description for the target.
-	Description *string `min:"1" type:"string"`
+	Description *string `min:"1" type:"string" sensitive:"true"`
 
 	// An optional name for the target.
 	Name *string `min:"3" type:"string"`
 
 	// User-provided value that will be included in any CloudWatch events raised
 	// while running tasks for these targets in this Maintenance Window.
-	OwnerInformation *string `min:"1" type:"string"`
+	OwnerInformation *string `min:"1" type:"string" sensitive:"true"`
 
 	// The type of target being registered with the Maintenance Window.
 	//
@@ -24142,6 +26836,58 @@ func (s *RegisterTargetWithMaintenanceWindowInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterTargetWithMaintenanceWindowInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterTargetWithMaintenanceWindowInput"}
+	if s.ClientToken != nil && len(*s.ClientToken) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientToken", 64))
+	}
+	if s.Description != nil && len(*s.Description) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 128))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.OwnerInformation != nil && len(*s.OwnerInformation) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("OwnerInformation", 128))
+	}
+	if s.ResourceType != nil {
+		values := []string{"INSTANCE"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+	if s.Targets != nil && len(s.Targets) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Targets", 5))
+	}
+	if s.WindowId != nil && len(*s.WindowId) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowId", 20))
+	}
+	if s.WindowId != nil && !request.MatchParamPattern("^mw-[0-9a-f]{17}$", *s.WindowId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowId", "^mw-[0-9a-f]{17}$"))
+	}
+	if s.Targets != nil {
+		for i, v := range s.Targets {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Targets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientToken sets the ClientToken field's value.
 func (s *RegisterTargetWithMaintenanceWindowInput) SetClientToken(v string) *RegisterTargetWithMaintenanceWindowInput {
 	s.ClientToken = &v
@@ -24216,7 +26962,7 @@ type RegisterTaskWithMaintenanceWindowInput struct {
 	ClientToken *string `min:"1" type:"string" idempotencyToken:"true"`
 
 	// An optional description for the task.
-	Description *string `min:"1" type:"string"`
+	Description *string `min:"1" type:"string" sensitive:"true"`
 
 	// A structure containing information about an Amazon S3 bucket to write instance-level
 	// logs to.
@@ -24261,7 +27007,7 @@ type RegisterTaskWithMaintenanceWindowInput struct {
 	TaskInvocationParameters *MaintenanceWindowTaskInvocationParameters `type:"structure"`
 
 	// The parameters that should be passed to the task when it is executed.
-	TaskParameters map[string]*MaintenanceWindowTaskParameterValueExpression `type:"map"`
+	TaskParameters map[string]*MaintenanceWindowTaskParameterValueExpression `type:"map" sensitive:"true"`
 
 	// The type of task being registered.
 	//
@@ -24356,6 +27102,80 @@ func (s *RegisterTaskWithMaintenanceWindowInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterTaskWithMaintenanceWindowInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterTaskWithMaintenanceWindowInput"}
+	if s.ClientToken != nil && len(*s.ClientToken) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientToken", 64))
+	}
+	if s.Description != nil && len(*s.Description) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 128))
+	}
+	if s.MaxConcurrency != nil && len(*s.MaxConcurrency) > 7 {
+		invalidParams.Add(request.NewErrParamMaxLen("MaxConcurrency", 7))
+	}
+	if s.MaxConcurrency != nil && !request.MatchParamPattern("^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$", *s.MaxConcurrency) {
+		invalidParams.Add(request.NewErrParamPattern("MaxConcurrency", "^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$"))
+	}
+	if s.MaxErrors != nil && len(*s.MaxErrors) > 7 {
+		invalidParams.Add(request.NewErrParamMaxLen("MaxErrors", 7))
+	}
+	if s.MaxErrors != nil && !request.MatchParamPattern("^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$", *s.MaxErrors) {
+		invalidParams.Add(request.NewErrParamPattern("MaxErrors", "^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$"))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.Targets != nil && len(s.Targets) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Targets", 5))
+	}
+	if s.TaskArn != nil && len(*s.TaskArn) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskArn", 1600))
+	}
+	if s.TaskType != nil {
+		values := []string{"RUN_COMMAND", "AUTOMATION", "STEP_FUNCTIONS", "LAMBDA"}
+		if !request.IsParamEnumValue(*s.TaskType, values) {
+			invalidParams.Add(request.NewErrParamEnum("TaskType", *s.TaskType, values))
+		}
+	}
+	if s.WindowId != nil && len(*s.WindowId) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowId", 20))
+	}
+	if s.WindowId != nil && !request.MatchParamPattern("^mw-[0-9a-f]{17}$", *s.WindowId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowId", "^mw-[0-9a-f]{17}$"))
+	}
+	if s.LoggingInfo != nil {
+		if err := s.LoggingInfo.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LoggingInfo", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Targets != nil {
+		for i, v := range s.Targets {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Targets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.TaskInvocationParameters != nil {
+		if err := s.TaskInvocationParameters.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TaskInvocationParameters", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientToken sets the ClientToken field's value.
 func (s *RegisterTaskWithMaintenanceWindowInput) SetClientToken(v string) *RegisterTaskWithMaintenanceWindowInput {
 	s.ClientToken = &v
@@ -24513,6 +27333,24 @@ func (s *RemoveTagsFromResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveTagsFromResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveTagsFromResourceInput"}
+	if s.ResourceType != nil {
+		values := []string{"ManagedInstance", "MaintenanceWindow", "Parameter"}
+		if !request.IsParamEnumValue(*s.ResourceType, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceType", *s.ResourceType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *RemoveTagsFromResourceInput) SetResourceId(v string) *RemoveTagsFromResourceInput {
 	s.ResourceId = &v
@@ -24775,6 +27613,39 @@ func (s *ResourceDataSyncS3Destination) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResourceDataSyncS3Destination) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResourceDataSyncS3Destination"}
+	if s.AWSKMSKeyARN != nil && len(*s.AWSKMSKeyARN) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("AWSKMSKeyARN", 512))
+	}
+	if s.AWSKMSKeyARN != nil && !request.MatchParamPattern("arn:.*", *s.AWSKMSKeyARN) {
+		invalidParams.Add(request.NewErrParamPattern("AWSKMSKeyARN", "arn:.*"))
+	}
+	if s.BucketName != nil && len(*s.BucketName) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("BucketName", 2048))
+	}
+	if s.Prefix != nil && len(*s.Prefix) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Prefix", 256))
+	}
+	if s.Region != nil && len(*s.Region) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Region", 64))
+	}
+	if s.SyncFormat != nil {
+		values := []string{"JsonSerDe"}
+		if !request.IsParamEnumValue(*s.SyncFormat, values) {
+			invalidParams.Add(request.NewErrParamEnum("SyncFormat", *s.SyncFormat, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAWSKMSKeyARN sets the AWSKMSKeyARN field's value.
 func (s *ResourceDataSyncS3Destination) SetAWSKMSKeyARN(v string) *ResourceDataSyncS3Destination {
 	s.AWSKMSKeyARN = &v
@@ -24843,6 +27714,24 @@ func (s *ResultAttribute) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResultAttribute) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResultAttribute"}
+	if s.TypeName != nil && len(*s.TypeName) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("TypeName", 100))
+	}
+	if s.TypeName != nil && !request.MatchParamPattern("^(AWS|Custom):.*$", *s.TypeName) {
+		invalidParams.Add(request.NewErrParamPattern("TypeName", "^(AWS|Custom):.*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTypeName sets the TypeName field's value.
 func (s *ResultAttribute) SetTypeName(v string) *ResultAttribute {
 	s.TypeName = &v
@@ -24892,6 +27781,27 @@ func (s *S3OutputLocation) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *S3OutputLocation) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "S3OutputLocation"}
+	if s.OutputS3BucketName != nil && len(*s.OutputS3BucketName) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("OutputS3BucketName", 63))
+	}
+	if s.OutputS3KeyPrefix != nil && len(*s.OutputS3KeyPrefix) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("OutputS3KeyPrefix", 500))
+	}
+	if s.OutputS3Region != nil && len(*s.OutputS3Region) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("OutputS3Region", 20))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetOutputS3BucketName sets the OutputS3BucketName field's value.
 func (s *S3OutputLocation) SetOutputS3BucketName(v string) *S3OutputLocation {
 	s.OutputS3BucketName = &v
@@ -24990,6 +27900,30 @@ func (s *SendAutomationSignalInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SendAutomationSignalInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SendAutomationSignalInput"}
+	if s.AutomationExecutionId != nil && len(*s.AutomationExecutionId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutomationExecutionId", 36))
+	}
+	if s.Payload != nil && len(s.Payload) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Payload", 200))
+	}
+	if s.SignalType != nil {
+		values := []string{"Approve", "Reject"}
+		if !request.IsParamEnumValue(*s.SignalType, values) {
+			invalidParams.Add(request.NewErrParamEnum("SignalType", *s.SignalType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutomationExecutionId sets the AutomationExecutionId field's value.
 func (s *SendAutomationSignalInput) SetAutomationExecutionId(v string) *SendAutomationSignalInput {
 	s.AutomationExecutionId = &v
@@ -25109,33 +28043,105 @@ func (s SendCommandInput) GoString() string {
 	return s.String()
 }
 
-// Validate inspects the fields of the type to determine if they are valid.
-func (s *SendCommandInput) Validate() error {
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *SendCommandInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "SendCommandInput"}
+	if s.DocumentName == nil {
+		invalidParams.Add(request.NewErrParamRequired("DocumentName"))
+	}
+	if s.MaxConcurrency != nil && len(*s.MaxConcurrency) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MaxConcurrency", 1))
+	}
+	if s.MaxErrors != nil && len(*s.MaxErrors) < 1 {
+		invalidParams.Add(request.NewErrParamMinLen("MaxErrors", 1))
+	}
+	if s.OutputS3BucketName != nil && len(*s.OutputS3BucketName) < 3 {
+		invalidParams.Add(request.NewErrParamMinLen("OutputS3BucketName", 3))
+	}
+	if s.OutputS3Region != nil && len(*s.OutputS3Region) < 3 {
+		invalidParams.Add(request.NewErrParamMinLen("OutputS3Region", 3))
+	}
+	if s.TimeoutSeconds != nil && *s.TimeoutSeconds < 30 {
+		invalidParams.Add(request.NewErrParamMinValue("TimeoutSeconds", 30))
+	}
+	if s.Targets != nil {
+		for i, v := range s.Targets {
+			if v == nil {
+				continue
+			}
+			if err := v.Validate(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Targets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SendCommandInput) ValidateExtended() error {
 	invalidParams := request.ErrInvalidParams{Context: "SendCommandInput"}
-	if s.DocumentName == nil {
-		invalidParams.Add(request.NewErrParamRequired("DocumentName"))
+	if s.Comment != nil && len(*s.Comment) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("Comment", 100))
 	}
-	if s.MaxConcurrency != nil && len(*s.MaxConcurrency) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("MaxConcurrency", 1))
+	if s.DocumentHash != nil && len(*s.DocumentHash) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("DocumentHash", 256))
 	}
-	if s.MaxErrors != nil && len(*s.MaxErrors) < 1 {
-		invalidParams.Add(request.NewErrParamMinLen("MaxErrors", 1))
+	if s.DocumentHashType != nil {
+		values := []string{"Sha256", "Sha1"}
+		if !request.IsParamEnumValue(*s.DocumentHashType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DocumentHashType", *s.DocumentHashType, values))
+		}
 	}
-	if s.OutputS3BucketName != nil && len(*s.OutputS3BucketName) < 3 {
-		invalidParams.Add(request.NewErrParamMinLen("OutputS3BucketName", 3))
+	if s.DocumentName != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.:/]{3,128}$", *s.DocumentName) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentName", "^[a-zA-Z0-9_\\-.:/]{3,128}$"))
 	}
-	if s.OutputS3Region != nil && len(*s.OutputS3Region) < 3 {
-		invalidParams.Add(request.NewErrParamMinLen("OutputS3Region", 3))
+	if s.InstanceIds != nil && len(s.InstanceIds) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("InstanceIds", 50))
 	}
-	if s.TimeoutSeconds != nil && *s.TimeoutSeconds < 30 {
-		invalidParams.Add(request.NewErrParamMinValue("TimeoutSeconds", 30))
+	if s.MaxConcurrency != nil && len(*s.MaxConcurrency) > 7 {
+		invalidParams.Add(request.NewErrParamMaxLen("MaxConcurrency", 7))
+	}
+	if s.MaxConcurrency != nil && !request.MatchParamPattern("^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$", *s.MaxConcurrency) {
+		invalidParams.Add(request.NewErrParamPattern("MaxConcurrency", "^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$"))
+	}
+	if s.MaxErrors != nil && len(*s.MaxErrors) > 7 {
+		invalidParams.Add(request.NewErrParamMaxLen("MaxErrors", 7))
+	}
+	if s.MaxErrors != nil && !request.MatchParamPattern("^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$", *s.MaxErrors) {
+		invalidParams.Add(request.NewErrParamPattern("MaxErrors", "^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$"))
+	}
+	if s.OutputS3BucketName != nil && len(*s.OutputS3BucketName) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("OutputS3BucketName", 63))
+	}
+	if s.OutputS3KeyPrefix != nil && len(*s.OutputS3KeyPrefix) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("OutputS3KeyPrefix", 500))
+	}
+	if s.OutputS3Region != nil && len(*s.OutputS3Region) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("OutputS3Region", 20))
+	}
+	if s.Targets != nil && len(s.Targets) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Targets", 5))
+	}
+	if s.TimeoutSeconds != nil && *s.TimeoutSeconds > 2.592e+06 {
+		invalidParams.Add(request.NewErrParamMaxValue("TimeoutSeconds", 2.592e+06))
+	}
+	if s.NotificationConfig != nil {
+		if err := s.NotificationConfig.ValidateExtended(); err != nil {
+			invalidParams.AddNested("NotificationConfig", err.(request.ErrInvalidParams))
+		}
 	}
 	if s.Targets != nil {
 		for i, v := range s.Targets {
 			if v == nil {
 				continue
 			}
-			if err := v.Validate(); err != nil {
+			if err := v.ValidateExtended(); err != nil {
 				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Targets", i), err.(request.ErrInvalidParams))
 			}
 		}
@@ -25395,6 +28401,33 @@ func (s *StartAutomationExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartAutomationExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartAutomationExecutionInput"}
+	if s.ClientToken != nil && len(*s.ClientToken) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientToken", 36))
+	}
+	if s.ClientToken != nil && !request.MatchParamPattern("[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12}", *s.ClientToken) {
+		invalidParams.Add(request.NewErrParamPattern("ClientToken", "[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12}"))
+	}
+	if s.DocumentName != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.:/]{3,128}$", *s.DocumentName) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentName", "^[a-zA-Z0-9_\\-.:/]{3,128}$"))
+	}
+	if s.DocumentVersion != nil && !request.MatchParamPattern("([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)", *s.DocumentVersion) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentVersion", "([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)"))
+	}
+	if s.Parameters != nil && len(s.Parameters) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("Parameters", 200))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientToken sets the ClientToken field's value.
 func (s *StartAutomationExecutionInput) SetClientToken(v string) *StartAutomationExecutionInput {
 	s.ClientToken = &v
@@ -25598,6 +28631,21 @@ func (s *StopAutomationExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopAutomationExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopAutomationExecutionInput"}
+	if s.AutomationExecutionId != nil && len(*s.AutomationExecutionId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("AutomationExecutionId", 36))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAutomationExecutionId sets the AutomationExecutionId field's value.
 func (s *StopAutomationExecutionInput) SetAutomationExecutionId(v string) *StopAutomationExecutionInput {
 	s.AutomationExecutionId = &v
@@ -25669,6 +28717,27 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+	if s.Value != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$", *s.Value) {
+		invalidParams.Add(request.NewErrParamPattern("Value", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -25725,6 +28794,27 @@ func (s *Target) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Target) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Target"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^[\\p{L}\\p{Z}\\p{N}_.:/=\\-@]*$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^[\\p{L}\\p{Z}\\p{N}_.:/=\\-@]*$"))
+	}
+	if s.Values != nil && len(s.Values) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("Values", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Target) SetKey(v string) *Target {
 	s.Key = &v
@@ -25815,6 +28905,54 @@ func (s *UpdateAssociationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateAssociationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateAssociationInput"}
+	if s.AssociationId != nil && !request.MatchParamPattern("[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}", *s.AssociationId) {
+		invalidParams.Add(request.NewErrParamPattern("AssociationId", "[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}"))
+	}
+	if s.AssociationName != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.AssociationName) {
+		invalidParams.Add(request.NewErrParamPattern("AssociationName", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.AssociationVersion != nil && !request.MatchParamPattern("([$]LATEST)|([1-9][0-9]*)", *s.AssociationVersion) {
+		invalidParams.Add(request.NewErrParamPattern("AssociationVersion", "([$]LATEST)|([1-9][0-9]*)"))
+	}
+	if s.DocumentVersion != nil && !request.MatchParamPattern("([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)", *s.DocumentVersion) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentVersion", "([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)"))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.ScheduleExpression != nil && len(*s.ScheduleExpression) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ScheduleExpression", 256))
+	}
+	if s.Targets != nil && len(s.Targets) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Targets", 5))
+	}
+	if s.OutputLocation != nil {
+		if err := s.OutputLocation.ValidateExtended(); err != nil {
+			invalidParams.AddNested("OutputLocation", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Targets != nil {
+		for i, v := range s.Targets {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Targets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssociationId sets the AssociationId field's value.
 func (s *UpdateAssociationInput) SetAssociationId(v string) *UpdateAssociationInput {
 	s.AssociationId = &v
@@ -25947,6 +29085,29 @@ func (s *UpdateAssociationStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateAssociationStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateAssociationStatusInput"}
+	if s.InstanceId != nil && !request.MatchParamPattern("(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "(^i-(\\w{8}|\\w{17})$)|(^mi-\\w{17}$)"))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.AssociationStatus != nil {
+		if err := s.AssociationStatus.ValidateExtended(); err != nil {
+			invalidParams.AddNested("AssociationStatus", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAssociationStatus sets the AssociationStatus field's value.
 func (s *UpdateAssociationStatusInput) SetAssociationStatus(v *AssociationStatus) *UpdateAssociationStatusInput {
 	s.AssociationStatus = v
@@ -26030,6 +29191,24 @@ func (s *UpdateDocumentDefaultVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDocumentDefaultVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDocumentDefaultVersionInput"}
+	if s.DocumentVersion != nil && !request.MatchParamPattern("(^[1-9][0-9]*$)", *s.DocumentVersion) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentVersion", "(^[1-9][0-9]*$)"))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDocumentVersion sets the DocumentVersion field's value.
 func (s *UpdateDocumentDefaultVersionInput) SetDocumentVersion(v string) *UpdateDocumentDefaultVersionInput {
 	s.DocumentVersion = &v
@@ -26114,6 +29293,24 @@ func (s *UpdateDocumentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDocumentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDocumentInput"}
+	if s.DocumentVersion != nil && !request.MatchParamPattern("([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)", *s.DocumentVersion) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentVersion", "([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)"))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetContent sets the Content field's value.
 func (s *UpdateDocumentInput) SetContent(v string) *UpdateDocumentInput {
 	s.Content = &v
@@ -26169,7 +29366,7 @@ type UpdateMaintenanceWindowInput struct {
 	Cutoff *int64 `type:"integer"`
 
 	// An optional description for the update request.
-	Description *string `min:"1" type:"string"`
+	Description *string `min:"1" type:"string" sensitive:"true"`
 
 	// The duration of the Maintenance Window in hours.
 	Duration *int64 `min:"1" type:"integer"`
@@ -26232,6 +29429,42 @@ func (s *UpdateMaintenanceWindowInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateMaintenanceWindowInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateMaintenanceWindowInput"}
+	if s.Cutoff != nil && *s.Cutoff > 23 {
+		invalidParams.Add(request.NewErrParamMaxValue("Cutoff", 23))
+	}
+	if s.Description != nil && len(*s.Description) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 128))
+	}
+	if s.Duration != nil && *s.Duration > 24 {
+		invalidParams.Add(request.NewErrParamMaxValue("Duration", 24))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.Schedule != nil && len(*s.Schedule) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Schedule", 256))
+	}
+	if s.WindowId != nil && len(*s.WindowId) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowId", 20))
+	}
+	if s.WindowId != nil && !request.MatchParamPattern("^mw-[0-9a-f]{17}$", *s.WindowId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowId", "^mw-[0-9a-f]{17}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAllowUnassociatedTargets sets the AllowUnassociatedTargets field's value.
 func (s *UpdateMaintenanceWindowInput) SetAllowUnassociatedTargets(v bool) *UpdateMaintenanceWindowInput {
 	s.AllowUnassociatedTargets = &v
@@ -26299,7 +29532,7 @@ type UpdateMaintenanceWindowOutput struct {
 	Cutoff *int64 `type:"integer"`
 
 	// An optional description of the update.
-	Description *string `min:"1" type:"string"`
+	Description *string `min:"1" type:"string" sensitive:"true"`
 
 	// The duration of the Maintenance Window in hours.
 	Duration *int64 `min:"1" type:"integer"`
@@ -26380,14 +29613,14 @@ type UpdateMaintenanceWindowTargetInput struct {
 	_ struct{} `type:"structure"`
 
 	// An optional description for the update.
-	Description *string `min:"1" type:"string"`
+	Description *string `min:"1" type:"string" sensitive:"true"`
 
 	// A name for the update.
 	Name *string `min:"3" type:"string"`
 
 	// User-provided value that will be included in any CloudWatch events raised
 	// while running tasks for these targets in this Maintenance Window.
-	OwnerInformation *string `min:"1" type:"string"`
+	OwnerInformation *string `min:"1" type:"string" sensitive:"true"`
 
 	// If True, then all fields that are required by the RegisterTargetWithMaintenanceWindow
 	// action are also required for this API request. Optional fields that are not
@@ -26459,6 +29692,55 @@ func (s *UpdateMaintenanceWindowTargetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateMaintenanceWindowTargetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateMaintenanceWindowTargetInput"}
+	if s.Description != nil && len(*s.Description) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 128))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.OwnerInformation != nil && len(*s.OwnerInformation) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("OwnerInformation", 128))
+	}
+	if s.Targets != nil && len(s.Targets) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Targets", 5))
+	}
+	if s.WindowId != nil && len(*s.WindowId) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowId", 20))
+	}
+	if s.WindowId != nil && !request.MatchParamPattern("^mw-[0-9a-f]{17}$", *s.WindowId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowId", "^mw-[0-9a-f]{17}$"))
+	}
+	if s.WindowTargetId != nil && len(*s.WindowTargetId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowTargetId", 36))
+	}
+	if s.WindowTargetId != nil && !request.MatchParamPattern("^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$", *s.WindowTargetId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowTargetId", "^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$"))
+	}
+	if s.Targets != nil {
+		for i, v := range s.Targets {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Targets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *UpdateMaintenanceWindowTargetInput) SetDescription(v string) *UpdateMaintenanceWindowTargetInput {
 	s.Description = &v
@@ -26506,13 +29788,13 @@ type UpdateMaintenanceWindowTargetOutput struct {
 	_ struct{} `type:"structure"`
 
 	// The updated description.
-	Description *string `min:"1" type:"string"`
+	Description *string `min:"1" type:"string" sensitive:"true"`
 
 	// The updated name.
 	Name *string `min:"3" type:"string"`
 
 	// The updated owner.
-	OwnerInformation *string `min:"1" type:"string"`
+	OwnerInformation *string `min:"1" type:"string" sensitive:"true"`
 
 	// The updated targets.
 	Targets []*Target `type:"list"`
@@ -26575,7 +29857,7 @@ type UpdateMaintenanceWindowTaskInput struct {
 	_ struct{} `type:"structure"`
 
 	// The new task description to specify.
-	Description *string `min:"1" type:"string"`
+	Description *string `min:"1" type:"string" sensitive:"true"`
 
 	// The new logging location in Amazon S3 to specify.
 	LoggingInfo *LoggingInfo `type:"structure"`
@@ -26621,7 +29903,7 @@ type UpdateMaintenanceWindowTaskInput struct {
 	// Key: string, between 1 and 255 characters
 	//
 	// Value: an array of strings, each string is between 1 and 255 characters
-	TaskParameters map[string]*MaintenanceWindowTaskParameterValueExpression `type:"map"`
+	TaskParameters map[string]*MaintenanceWindowTaskParameterValueExpression `type:"map" sensitive:"true"`
 
 	// The Maintenance Window ID that contains the task to modify.
 	//
@@ -26701,6 +29983,77 @@ func (s *UpdateMaintenanceWindowTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateMaintenanceWindowTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateMaintenanceWindowTaskInput"}
+	if s.Description != nil && len(*s.Description) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 128))
+	}
+	if s.MaxConcurrency != nil && len(*s.MaxConcurrency) > 7 {
+		invalidParams.Add(request.NewErrParamMaxLen("MaxConcurrency", 7))
+	}
+	if s.MaxConcurrency != nil && !request.MatchParamPattern("^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$", *s.MaxConcurrency) {
+		invalidParams.Add(request.NewErrParamPattern("MaxConcurrency", "^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$"))
+	}
+	if s.MaxErrors != nil && len(*s.MaxErrors) > 7 {
+		invalidParams.Add(request.NewErrParamMaxLen("MaxErrors", 7))
+	}
+	if s.MaxErrors != nil && !request.MatchParamPattern("^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$", *s.MaxErrors) {
+		invalidParams.Add(request.NewErrParamPattern("MaxErrors", "^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$"))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.Targets != nil && len(s.Targets) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("Targets", 5))
+	}
+	if s.TaskArn != nil && len(*s.TaskArn) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskArn", 1600))
+	}
+	if s.WindowId != nil && len(*s.WindowId) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowId", 20))
+	}
+	if s.WindowId != nil && !request.MatchParamPattern("^mw-[0-9a-f]{17}$", *s.WindowId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowId", "^mw-[0-9a-f]{17}$"))
+	}
+	if s.WindowTaskId != nil && len(*s.WindowTaskId) > 36 {
+		invalidParams.Add(request.NewErrParamMaxLen("WindowTaskId", 36))
+	}
+	if s.WindowTaskId != nil && !request.MatchParamPattern("^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$", *s.WindowTaskId) {
+		invalidParams.Add(request.NewErrParamPattern("WindowTaskId", "^[0-9a-fA-F]{8}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{4}\\-[0-9a-fA-F]{12}$"))
+	}
+	if s.LoggingInfo != nil {
+		if err := s.LoggingInfo.ValidateExtended(); err != nil {
+			invalidParams.AddNested("LoggingInfo", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Targets != nil {
+		for i, v := range s.Targets {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Targets", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.TaskInvocationParameters != nil {
+		if err := s.TaskInvocationParameters.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TaskInvocationParameters", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *UpdateMaintenanceWindowTaskInput) SetDescription(v string) *UpdateMaintenanceWindowTaskInput {
 	s.Description = &v
@@ -26790,7 +30143,7 @@ type UpdateMaintenanceWindowTaskOutput struct {
 	_ struct{} `type:"structure"`
 
 	// The updated task description.
-	Description *string `min:"1" type:"string"`
+	Description *string `min:"1" type:"string" sensitive:"true"`
 
 	// The updated logging information in Amazon S3.
 	LoggingInfo *LoggingInfo `type:"structure"`
@@ -26820,7 +30173,7 @@ type UpdateMaintenanceWindowTaskOutput struct {
 	TaskInvocationParameters *MaintenanceWindowTaskInvocationParameters `type:"structure"`
 
 	// The updated parameter values.
-	TaskParameters map[string]*MaintenanceWindowTaskParameterValueExpression `type:"map"`
+	TaskParameters map[string]*MaintenanceWindowTaskParameterValueExpression `type:"map" sensitive:"true"`
 
 	// The ID of the Maintenance Window that was updated.
 	WindowId *string `min:"20" type:"string"`
@@ -26958,6 +30311,24 @@ func (s *UpdateManagedInstanceRoleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateManagedInstanceRoleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateManagedInstanceRoleInput"}
+	if s.IamRole != nil && len(*s.IamRole) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("IamRole", 64))
+	}
+	if s.InstanceId != nil && !request.MatchParamPattern("^mi-[0-9a-f]{17}$", *s.InstanceId) {
+		invalidParams.Add(request.NewErrParamPattern("InstanceId", "^mi-[0-9a-f]{17}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIamRole sets the IamRole field's value.
 func (s *UpdateManagedInstanceRoleInput) SetIamRole(v string) *UpdateManagedInstanceRoleInput {
 	s.IamRole = &v
@@ -27058,6 +30429,55 @@ func (s *UpdatePatchBaselineInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdatePatchBaselineInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdatePatchBaselineInput"}
+	if s.ApprovedPatches != nil && len(s.ApprovedPatches) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ApprovedPatches", 50))
+	}
+	if s.ApprovedPatchesComplianceLevel != nil {
+		values := []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "INFORMATIONAL", "UNSPECIFIED"}
+		if !request.IsParamEnumValue(*s.ApprovedPatchesComplianceLevel, values) {
+			invalidParams.Add(request.NewErrParamEnum("ApprovedPatchesComplianceLevel", *s.ApprovedPatchesComplianceLevel, values))
+		}
+	}
+	if s.BaselineId != nil && len(*s.BaselineId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("BaselineId", 128))
+	}
+	if s.BaselineId != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-:/]{20,128}$", *s.BaselineId) {
+		invalidParams.Add(request.NewErrParamPattern("BaselineId", "^[a-zA-Z0-9_\\-:/]{20,128}$"))
+	}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.Name != nil && !request.MatchParamPattern("^[a-zA-Z0-9_\\-.]{3,128}$", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "^[a-zA-Z0-9_\\-.]{3,128}$"))
+	}
+	if s.RejectedPatches != nil && len(s.RejectedPatches) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("RejectedPatches", 50))
+	}
+	if s.ApprovalRules != nil {
+		if err := s.ApprovalRules.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ApprovalRules", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.GlobalFilters != nil {
+		if err := s.GlobalFilters.ValidateExtended(); err != nil {
+			invalidParams.AddNested("GlobalFilters", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetApprovalRules sets the ApprovalRules field's value.
 func (s *UpdatePatchBaselineInput) SetApprovalRules(v *PatchRuleGroup) *UpdatePatchBaselineInput {
 	s.ApprovalRules = v
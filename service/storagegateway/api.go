@@ -6127,6 +6127,42 @@ func (s *ActivateGatewayInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ActivateGatewayInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActivateGatewayInput"}
+	if s.ActivationKey != nil && len(*s.ActivationKey) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("ActivationKey", 50))
+	}
+	if s.GatewayName != nil && len(*s.GatewayName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayName", 255))
+	}
+	if s.GatewayName != nil && !request.MatchParamPattern("^[ -\\.0-\\[\\]-~]*[!-\\.0-\\[\\]-~][ -\\.0-\\[\\]-~]*$", *s.GatewayName) {
+		invalidParams.Add(request.NewErrParamPattern("GatewayName", "^[ -\\.0-\\[\\]-~]*[!-\\.0-\\[\\]-~][ -\\.0-\\[\\]-~]*$"))
+	}
+	if s.GatewayRegion != nil && len(*s.GatewayRegion) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayRegion", 25))
+	}
+	if s.GatewayTimezone != nil && len(*s.GatewayTimezone) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayTimezone", 10))
+	}
+	if s.GatewayType != nil && len(*s.GatewayType) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayType", 20))
+	}
+	if s.MediumChangerType != nil && len(*s.MediumChangerType) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("MediumChangerType", 50))
+	}
+	if s.TapeDriveType != nil && len(*s.TapeDriveType) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("TapeDriveType", 50))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActivationKey sets the ActivationKey field's value.
 func (s *ActivateGatewayInput) SetActivationKey(v string) *ActivateGatewayInput {
 	s.ActivationKey = &v
@@ -6245,6 +6281,21 @@ func (s *AddCacheInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddCacheInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddCacheInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDiskIds sets the DiskIds field's value.
 func (s *AddCacheInput) SetDiskIds(v []*string) *AddCacheInput {
 	s.DiskIds = v
@@ -6341,6 +6392,31 @@ func (s *AddTagsToResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddTagsToResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddTagsToResourceInput"}
+	if s.ResourceARN != nil && len(*s.ResourceARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceARN", 500))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceARN sets the ResourceARN field's value.
 func (s *AddTagsToResourceInput) SetResourceARN(v string) *AddTagsToResourceInput {
 	s.ResourceARN = &v
@@ -6421,6 +6497,21 @@ func (s *AddUploadBufferInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddUploadBufferInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddUploadBufferInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDiskIds sets the DiskIds field's value.
 func (s *AddUploadBufferInput) SetDiskIds(v []*string) *AddUploadBufferInput {
 	s.DiskIds = v
@@ -6508,6 +6599,21 @@ func (s *AddWorkingStorageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddWorkingStorageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddWorkingStorageInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDiskIds sets the DiskIds field's value.
 func (s *AddWorkingStorageInput) SetDiskIds(v []*string) *AddWorkingStorageInput {
 	s.DiskIds = v
@@ -6699,6 +6805,27 @@ func (s *CancelArchivalInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CancelArchivalInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelArchivalInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.TapeARN != nil && len(*s.TapeARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("TapeARN", 500))
+	}
+	if s.TapeARN != nil && !request.MatchParamPattern("^arn:(aws|aws-cn):storagegateway:[a-z\\-0-9]+:[0-9]+:tape\\/[0-9A-Z]{7,16}$", *s.TapeARN) {
+		invalidParams.Add(request.NewErrParamPattern("TapeARN", "^arn:(aws|aws-cn):storagegateway:[a-z\\-0-9]+:[0-9]+:tape\\/[0-9A-Z]{7,16}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *CancelArchivalInput) SetGatewayARN(v string) *CancelArchivalInput {
 	s.GatewayARN = &v
@@ -6787,6 +6914,27 @@ func (s *CancelRetrievalInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CancelRetrievalInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelRetrievalInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.TapeARN != nil && len(*s.TapeARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("TapeARN", 500))
+	}
+	if s.TapeARN != nil && !request.MatchParamPattern("^arn:(aws|aws-cn):storagegateway:[a-z\\-0-9]+:[0-9]+:tape\\/[0-9A-Z]{7,16}$", *s.TapeARN) {
+		invalidParams.Add(request.NewErrParamPattern("TapeARN", "^arn:(aws|aws-cn):storagegateway:[a-z\\-0-9]+:[0-9]+:tape\\/[0-9A-Z]{7,16}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *CancelRetrievalInput) SetGatewayARN(v string) *CancelRetrievalInput {
 	s.GatewayARN = &v
@@ -6961,6 +7109,39 @@ func (s *CreateCachediSCSIVolumeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateCachediSCSIVolumeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateCachediSCSIVolumeInput"}
+	if s.ClientToken != nil && len(*s.ClientToken) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientToken", 100))
+	}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.NetworkInterfaceId != nil && !request.MatchParamPattern("\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z", *s.NetworkInterfaceId) {
+		invalidParams.Add(request.NewErrParamPattern("NetworkInterfaceId", "\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z"))
+	}
+	if s.SnapshotId != nil && !request.MatchParamPattern("\\Asnap-([0-9A-Fa-f]{8}|[0-9A-Fa-f]{17})\\z", *s.SnapshotId) {
+		invalidParams.Add(request.NewErrParamPattern("SnapshotId", "\\Asnap-([0-9A-Fa-f]{8}|[0-9A-Fa-f]{17})\\z"))
+	}
+	if s.SourceVolumeARN != nil && len(*s.SourceVolumeARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceVolumeARN", 500))
+	}
+	if s.TargetName != nil && len(*s.TargetName) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("TargetName", 200))
+	}
+	if s.TargetName != nil && !request.MatchParamPattern("^[-\\.;a-z0-9]+$", *s.TargetName) {
+		invalidParams.Add(request.NewErrParamPattern("TargetName", "^[-\\.;a-z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientToken sets the ClientToken field's value.
 func (s *CreateCachediSCSIVolumeInput) SetClientToken(v string) *CreateCachediSCSIVolumeInput {
 	s.ClientToken = &v
@@ -7157,6 +7338,47 @@ func (s *CreateNFSFileShareInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateNFSFileShareInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateNFSFileShareInput"}
+	if s.ClientList != nil && len(s.ClientList) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientList", 100))
+	}
+	if s.ClientToken != nil && len(*s.ClientToken) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientToken", 100))
+	}
+	if s.DefaultStorageClass != nil && len(*s.DefaultStorageClass) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultStorageClass", 20))
+	}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.KMSKey != nil && len(*s.KMSKey) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KMSKey", 2048))
+	}
+	if s.LocationARN != nil && len(*s.LocationARN) > 310 {
+		invalidParams.Add(request.NewErrParamMaxLen("LocationARN", 310))
+	}
+	if s.Role != nil && len(*s.Role) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Role", 2048))
+	}
+	if s.Squash != nil && len(*s.Squash) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("Squash", 15))
+	}
+	if s.NFSFileShareDefaults != nil {
+		if err := s.NFSFileShareDefaults.ValidateExtended(); err != nil {
+			invalidParams.AddNested("NFSFileShareDefaults", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientList sets the ClientList field's value.
 func (s *CreateNFSFileShareInput) SetClientList(v []*string) *CreateNFSFileShareInput {
 	s.ClientList = v
@@ -7291,6 +7513,24 @@ func (s *CreateSnapshotFromVolumeRecoveryPointInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateSnapshotFromVolumeRecoveryPointInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateSnapshotFromVolumeRecoveryPointInput"}
+	if s.SnapshotDescription != nil && len(*s.SnapshotDescription) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("SnapshotDescription", 255))
+	}
+	if s.VolumeARN != nil && len(*s.VolumeARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("VolumeARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSnapshotDescription sets the SnapshotDescription field's value.
 func (s *CreateSnapshotFromVolumeRecoveryPointInput) SetSnapshotDescription(v string) *CreateSnapshotFromVolumeRecoveryPointInput {
 	s.SnapshotDescription = &v
@@ -7397,6 +7637,24 @@ func (s *CreateSnapshotInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateSnapshotInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateSnapshotInput"}
+	if s.SnapshotDescription != nil && len(*s.SnapshotDescription) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("SnapshotDescription", 255))
+	}
+	if s.VolumeARN != nil && len(*s.VolumeARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("VolumeARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSnapshotDescription sets the SnapshotDescription field's value.
 func (s *CreateSnapshotInput) SetSnapshotDescription(v string) *CreateSnapshotInput {
 	s.SnapshotDescription = &v
@@ -7550,6 +7808,36 @@ func (s *CreateStorediSCSIVolumeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateStorediSCSIVolumeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateStorediSCSIVolumeInput"}
+	if s.DiskId != nil && len(*s.DiskId) > 300 {
+		invalidParams.Add(request.NewErrParamMaxLen("DiskId", 300))
+	}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.NetworkInterfaceId != nil && !request.MatchParamPattern("\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z", *s.NetworkInterfaceId) {
+		invalidParams.Add(request.NewErrParamPattern("NetworkInterfaceId", "\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z"))
+	}
+	if s.SnapshotId != nil && !request.MatchParamPattern("\\Asnap-([0-9A-Fa-f]{8}|[0-9A-Fa-f]{17})\\z", *s.SnapshotId) {
+		invalidParams.Add(request.NewErrParamPattern("SnapshotId", "\\Asnap-([0-9A-Fa-f]{8}|[0-9A-Fa-f]{17})\\z"))
+	}
+	if s.TargetName != nil && len(*s.TargetName) > 200 {
+		invalidParams.Add(request.NewErrParamMaxLen("TargetName", 200))
+	}
+	if s.TargetName != nil && !request.MatchParamPattern("^[-\\.;a-z0-9]+$", *s.TargetName) {
+		invalidParams.Add(request.NewErrParamPattern("TargetName", "^[-\\.;a-z0-9]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDiskId sets the DiskId field's value.
 func (s *CreateStorediSCSIVolumeInput) SetDiskId(v string) *CreateStorediSCSIVolumeInput {
 	s.DiskId = &v
@@ -7693,6 +7981,27 @@ func (s *CreateTapeWithBarcodeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateTapeWithBarcodeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTapeWithBarcodeInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.TapeBarcode != nil && len(*s.TapeBarcode) > 16 {
+		invalidParams.Add(request.NewErrParamMaxLen("TapeBarcode", 16))
+	}
+	if s.TapeBarcode != nil && !request.MatchParamPattern("^[A-Z0-9]*$", *s.TapeBarcode) {
+		invalidParams.Add(request.NewErrParamPattern("TapeBarcode", "^[A-Z0-9]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *CreateTapeWithBarcodeInput) SetGatewayARN(v string) *CreateTapeWithBarcodeInput {
 	s.GatewayARN = &v
@@ -7826,6 +8135,33 @@ func (s *CreateTapesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateTapesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTapesInput"}
+	if s.ClientToken != nil && len(*s.ClientToken) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientToken", 100))
+	}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.NumTapesToCreate != nil && *s.NumTapesToCreate > 10 {
+		invalidParams.Add(request.NewErrParamMaxValue("NumTapesToCreate", 10))
+	}
+	if s.TapeBarcodePrefix != nil && len(*s.TapeBarcodePrefix) > 4 {
+		invalidParams.Add(request.NewErrParamMaxLen("TapeBarcodePrefix", 4))
+	}
+	if s.TapeBarcodePrefix != nil && !request.MatchParamPattern("^[A-Z]*$", *s.TapeBarcodePrefix) {
+		invalidParams.Add(request.NewErrParamPattern("TapeBarcodePrefix", "^[A-Z]*$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientToken sets the ClientToken field's value.
 func (s *CreateTapesInput) SetClientToken(v string) *CreateTapesInput {
 	s.ClientToken = &v
@@ -7936,6 +8272,24 @@ func (s *DeleteBandwidthRateLimitInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteBandwidthRateLimitInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteBandwidthRateLimitInput"}
+	if s.BandwidthType != nil && len(*s.BandwidthType) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("BandwidthType", 25))
+	}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBandwidthType sets the BandwidthType field's value.
 func (s *DeleteBandwidthRateLimitInput) SetBandwidthType(v string) *DeleteBandwidthRateLimitInput {
 	s.BandwidthType = &v
@@ -8028,6 +8382,27 @@ func (s *DeleteChapCredentialsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteChapCredentialsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteChapCredentialsInput"}
+	if s.InitiatorName != nil && len(*s.InitiatorName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("InitiatorName", 255))
+	}
+	if s.InitiatorName != nil && !request.MatchParamPattern("[0-9a-z:.-]+", *s.InitiatorName) {
+		invalidParams.Add(request.NewErrParamPattern("InitiatorName", "[0-9a-z:.-]+"))
+	}
+	if s.TargetARN != nil && len(*s.TargetARN) > 800 {
+		invalidParams.Add(request.NewErrParamMaxLen("TargetARN", 800))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInitiatorName sets the InitiatorName field's value.
 func (s *DeleteChapCredentialsInput) SetInitiatorName(v string) *DeleteChapCredentialsInput {
 	s.InitiatorName = &v
@@ -8117,6 +8492,21 @@ func (s *DeleteFileShareInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteFileShareInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteFileShareInput"}
+	if s.FileShareARN != nil && len(*s.FileShareARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("FileShareARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFileShareARN sets the FileShareARN field's value.
 func (s *DeleteFileShareInput) SetFileShareARN(v string) *DeleteFileShareInput {
 	s.FileShareARN = &v
@@ -8192,6 +8582,21 @@ func (s *DeleteGatewayInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteGatewayInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteGatewayInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *DeleteGatewayInput) SetGatewayARN(v string) *DeleteGatewayInput {
 	s.GatewayARN = &v
@@ -8258,6 +8663,21 @@ func (s *DeleteSnapshotScheduleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteSnapshotScheduleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSnapshotScheduleInput"}
+	if s.VolumeARN != nil && len(*s.VolumeARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("VolumeARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetVolumeARN sets the VolumeARN field's value.
 func (s *DeleteSnapshotScheduleInput) SetVolumeARN(v string) *DeleteSnapshotScheduleInput {
 	s.VolumeARN = &v
@@ -8325,6 +8745,24 @@ func (s *DeleteTapeArchiveInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteTapeArchiveInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTapeArchiveInput"}
+	if s.TapeARN != nil && len(*s.TapeARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("TapeARN", 500))
+	}
+	if s.TapeARN != nil && !request.MatchParamPattern("^arn:(aws|aws-cn):storagegateway:[a-z\\-0-9]+:[0-9]+:tape\\/[0-9A-Z]{7,16}$", *s.TapeARN) {
+		invalidParams.Add(request.NewErrParamPattern("TapeARN", "^arn:(aws|aws-cn):storagegateway:[a-z\\-0-9]+:[0-9]+:tape\\/[0-9A-Z]{7,16}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTapeARN sets the TapeARN field's value.
 func (s *DeleteTapeArchiveInput) SetTapeARN(v string) *DeleteTapeArchiveInput {
 	s.TapeARN = &v
@@ -8407,6 +8845,27 @@ func (s *DeleteTapeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteTapeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteTapeInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.TapeARN != nil && len(*s.TapeARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("TapeARN", 500))
+	}
+	if s.TapeARN != nil && !request.MatchParamPattern("^arn:(aws|aws-cn):storagegateway:[a-z\\-0-9]+:[0-9]+:tape\\/[0-9A-Z]{7,16}$", *s.TapeARN) {
+		invalidParams.Add(request.NewErrParamPattern("TapeARN", "^arn:(aws|aws-cn):storagegateway:[a-z\\-0-9]+:[0-9]+:tape\\/[0-9A-Z]{7,16}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *DeleteTapeInput) SetGatewayARN(v string) *DeleteTapeInput {
 	s.GatewayARN = &v
@@ -8482,6 +8941,21 @@ func (s *DeleteVolumeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteVolumeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteVolumeInput"}
+	if s.VolumeARN != nil && len(*s.VolumeARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("VolumeARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetVolumeARN sets the VolumeARN field's value.
 func (s *DeleteVolumeInput) SetVolumeARN(v string) *DeleteVolumeInput {
 	s.VolumeARN = &v
@@ -8552,6 +9026,21 @@ func (s *DescribeBandwidthRateLimitInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeBandwidthRateLimitInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeBandwidthRateLimitInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *DescribeBandwidthRateLimitInput) SetGatewayARN(v string) *DescribeBandwidthRateLimitInput {
 	s.GatewayARN = &v
@@ -8641,6 +9130,21 @@ func (s *DescribeCacheInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeCacheInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeCacheInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *DescribeCacheInput) SetGatewayARN(v string) *DescribeCacheInput {
 	s.GatewayARN = &v
@@ -8822,6 +9326,21 @@ func (s *DescribeChapCredentialsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeChapCredentialsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeChapCredentialsInput"}
+	if s.TargetARN != nil && len(*s.TargetARN) > 800 {
+		invalidParams.Add(request.NewErrParamMaxLen("TargetARN", 800))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTargetARN sets the TargetARN field's value.
 func (s *DescribeChapCredentialsInput) SetTargetARN(v string) *DescribeChapCredentialsInput {
 	s.TargetARN = &v
@@ -8905,6 +9424,21 @@ func (s *DescribeGatewayInformationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeGatewayInformationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeGatewayInformationInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *DescribeGatewayInformationInput) SetGatewayARN(v string) *DescribeGatewayInformationInput {
 	s.GatewayARN = &v
@@ -9054,6 +9588,21 @@ func (s *DescribeMaintenanceStartTimeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeMaintenanceStartTimeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeMaintenanceStartTimeInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *DescribeMaintenanceStartTimeInput) SetGatewayARN(v string) *DescribeMaintenanceStartTimeInput {
 	s.GatewayARN = &v
@@ -9173,6 +9722,21 @@ func (s *DescribeNFSFileSharesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeNFSFileSharesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeNFSFileSharesInput"}
+	if s.FileShareARNList != nil && len(s.FileShareARNList) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("FileShareARNList", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFileShareARNList sets the FileShareARNList field's value.
 func (s *DescribeNFSFileSharesInput) SetFileShareARNList(v []*string) *DescribeNFSFileSharesInput {
 	s.FileShareARNList = v
@@ -9243,6 +9807,21 @@ func (s *DescribeSnapshotScheduleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeSnapshotScheduleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeSnapshotScheduleInput"}
+	if s.VolumeARN != nil && len(*s.VolumeARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("VolumeARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetVolumeARN sets the VolumeARN field's value.
 func (s *DescribeSnapshotScheduleInput) SetVolumeARN(v string) *DescribeSnapshotScheduleInput {
 	s.VolumeARN = &v
@@ -9413,6 +9992,21 @@ func (s *DescribeTapeArchivesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeTapeArchivesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeTapeArchivesInput"}
+	if s.Marker != nil && len(*s.Marker) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *DescribeTapeArchivesInput) SetLimit(v int64) *DescribeTapeArchivesInput {
 	s.Limit = &v
@@ -9524,6 +10118,24 @@ func (s *DescribeTapeRecoveryPointsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeTapeRecoveryPointsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeTapeRecoveryPointsInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.Marker != nil && len(*s.Marker) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *DescribeTapeRecoveryPointsInput) SetGatewayARN(v string) *DescribeTapeRecoveryPointsInput {
 	s.GatewayARN = &v
@@ -9653,6 +10265,24 @@ func (s *DescribeTapesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeTapesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeTapesInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.Marker != nil && len(*s.Marker) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *DescribeTapesInput) SetGatewayARN(v string) *DescribeTapesInput {
 	s.GatewayARN = &v
@@ -9752,6 +10382,21 @@ func (s *DescribeUploadBufferInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeUploadBufferInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeUploadBufferInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *DescribeUploadBufferInput) SetGatewayARN(v string) *DescribeUploadBufferInput {
 	s.GatewayARN = &v
@@ -9867,6 +10512,24 @@ func (s *DescribeVTLDevicesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeVTLDevicesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeVTLDevicesInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.Marker != nil && len(*s.Marker) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *DescribeVTLDevicesInput) SetGatewayARN(v string) *DescribeVTLDevicesInput {
 	s.GatewayARN = &v
@@ -9977,6 +10640,21 @@ func (s *DescribeWorkingStorageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeWorkingStorageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeWorkingStorageInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *DescribeWorkingStorageInput) SetGatewayARN(v string) *DescribeWorkingStorageInput {
 	s.GatewayARN = &v
@@ -10132,6 +10810,21 @@ func (s *DisableGatewayInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisableGatewayInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisableGatewayInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *DisableGatewayInput) SetGatewayARN(v string) *DisableGatewayInput {
 	s.GatewayARN = &v
@@ -10439,6 +11132,24 @@ func (s *ListFileSharesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListFileSharesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListFileSharesInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.Marker != nil && len(*s.Marker) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *ListFileSharesInput) SetGatewayARN(v string) *ListFileSharesInput {
 	s.GatewayARN = &v
@@ -10546,6 +11257,21 @@ func (s *ListGatewaysInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListGatewaysInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListGatewaysInput"}
+	if s.Marker != nil && len(*s.Marker) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListGatewaysInput) SetLimit(v int64) *ListGatewaysInput {
 	s.Limit = &v
@@ -10627,6 +11353,21 @@ func (s *ListLocalDisksInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListLocalDisksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListLocalDisksInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *ListLocalDisksInput) SetGatewayARN(v string) *ListLocalDisksInput {
 	s.GatewayARN = &v
@@ -10718,6 +11459,24 @@ func (s *ListTagsForResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTagsForResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTagsForResourceInput"}
+	if s.Marker != nil && len(*s.Marker) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 1000))
+	}
+	if s.ResourceARN != nil && len(*s.ResourceARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListTagsForResourceInput) SetLimit(v int64) *ListTagsForResourceInput {
 	s.Limit = &v
@@ -10831,6 +11590,21 @@ func (s *ListTapesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListTapesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListTapesInput"}
+	if s.Marker != nil && len(*s.Marker) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListTapesInput) SetLimit(v int64) *ListTapesInput {
 	s.Limit = &v
@@ -10930,6 +11704,21 @@ func (s *ListVolumeInitiatorsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListVolumeInitiatorsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListVolumeInitiatorsInput"}
+	if s.VolumeARN != nil && len(*s.VolumeARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("VolumeARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetVolumeARN sets the VolumeARN field's value.
 func (s *ListVolumeInitiatorsInput) SetVolumeARN(v string) *ListVolumeInitiatorsInput {
 	s.VolumeARN = &v
@@ -10999,6 +11788,21 @@ func (s *ListVolumeRecoveryPointsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListVolumeRecoveryPointsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListVolumeRecoveryPointsInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *ListVolumeRecoveryPointsInput) SetGatewayARN(v string) *ListVolumeRecoveryPointsInput {
 	s.GatewayARN = &v
@@ -11090,6 +11894,24 @@ func (s *ListVolumesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListVolumesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListVolumesInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.Marker != nil && len(*s.Marker) > 1000 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 1000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *ListVolumesInput) SetGatewayARN(v string) *ListVolumesInput {
 	s.GatewayARN = &v
@@ -11202,6 +12024,36 @@ func (s *NFSFileShareDefaults) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *NFSFileShareDefaults) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "NFSFileShareDefaults"}
+	if s.DirectoryMode != nil && len(*s.DirectoryMode) > 4 {
+		invalidParams.Add(request.NewErrParamMaxLen("DirectoryMode", 4))
+	}
+	if s.DirectoryMode != nil && !request.MatchParamPattern("^[0-7]{4}$", *s.DirectoryMode) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryMode", "^[0-7]{4}$"))
+	}
+	if s.FileMode != nil && len(*s.FileMode) > 4 {
+		invalidParams.Add(request.NewErrParamMaxLen("FileMode", 4))
+	}
+	if s.FileMode != nil && !request.MatchParamPattern("^[0-7]{4}$", *s.FileMode) {
+		invalidParams.Add(request.NewErrParamPattern("FileMode", "^[0-7]{4}$"))
+	}
+	if s.GroupId != nil && *s.GroupId > 4.294967294e+09 {
+		invalidParams.Add(request.NewErrParamMaxValue("GroupId", 4.294967294e+09))
+	}
+	if s.OwnerId != nil && *s.OwnerId > 4.294967294e+09 {
+		invalidParams.Add(request.NewErrParamMaxValue("OwnerId", 4.294967294e+09))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryMode sets the DirectoryMode field's value.
 func (s *NFSFileShareDefaults) SetDirectoryMode(v string) *NFSFileShareDefaults {
 	s.DirectoryMode = &v
@@ -11470,6 +12322,21 @@ func (s *RefreshCacheInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RefreshCacheInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RefreshCacheInput"}
+	if s.FileShareARN != nil && len(*s.FileShareARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("FileShareARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFileShareARN sets the FileShareARN field's value.
 func (s *RefreshCacheInput) SetFileShareARN(v string) *RefreshCacheInput {
 	s.FileShareARN = &v
@@ -11547,6 +12414,21 @@ func (s *RemoveTagsFromResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveTagsFromResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveTagsFromResourceInput"}
+	if s.ResourceARN != nil && len(*s.ResourceARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceARN sets the ResourceARN field's value.
 func (s *RemoveTagsFromResourceInput) SetResourceARN(v string) *RemoveTagsFromResourceInput {
 	s.ResourceARN = &v
@@ -11622,6 +12504,21 @@ func (s *ResetCacheInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ResetCacheInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ResetCacheInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *ResetCacheInput) SetGatewayARN(v string) *ResetCacheInput {
 	s.GatewayARN = &v
@@ -11707,6 +12604,27 @@ func (s *RetrieveTapeArchiveInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RetrieveTapeArchiveInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RetrieveTapeArchiveInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.TapeARN != nil && len(*s.TapeARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("TapeARN", 500))
+	}
+	if s.TapeARN != nil && !request.MatchParamPattern("^arn:(aws|aws-cn):storagegateway:[a-z\\-0-9]+:[0-9]+:tape\\/[0-9A-Z]{7,16}$", *s.TapeARN) {
+		invalidParams.Add(request.NewErrParamPattern("TapeARN", "^arn:(aws|aws-cn):storagegateway:[a-z\\-0-9]+:[0-9]+:tape\\/[0-9A-Z]{7,16}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *RetrieveTapeArchiveInput) SetGatewayARN(v string) *RetrieveTapeArchiveInput {
 	s.GatewayARN = &v
@@ -11794,6 +12712,27 @@ func (s *RetrieveTapeRecoveryPointInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RetrieveTapeRecoveryPointInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RetrieveTapeRecoveryPointInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.TapeARN != nil && len(*s.TapeARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("TapeARN", 500))
+	}
+	if s.TapeARN != nil && !request.MatchParamPattern("^arn:(aws|aws-cn):storagegateway:[a-z\\-0-9]+:[0-9]+:tape\\/[0-9A-Z]{7,16}$", *s.TapeARN) {
+		invalidParams.Add(request.NewErrParamPattern("TapeARN", "^arn:(aws|aws-cn):storagegateway:[a-z\\-0-9]+:[0-9]+:tape\\/[0-9A-Z]{7,16}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *RetrieveTapeRecoveryPointInput) SetGatewayARN(v string) *RetrieveTapeRecoveryPointInput {
 	s.GatewayARN = &v
@@ -11846,7 +12785,7 @@ type SetLocalConsolePasswordInput struct {
 	// The password you want to set for your VM local console.
 	//
 	// LocalConsolePassword is a required field
-	LocalConsolePassword *string `min:"6" type:"string" required:"true"`
+	LocalConsolePassword *string `min:"6" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -11881,6 +12820,27 @@ func (s *SetLocalConsolePasswordInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SetLocalConsolePasswordInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SetLocalConsolePasswordInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.LocalConsolePassword != nil && len(*s.LocalConsolePassword) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("LocalConsolePassword", 512))
+	}
+	if s.LocalConsolePassword != nil && !request.MatchParamPattern("^[ -~]+$", *s.LocalConsolePassword) {
+		invalidParams.Add(request.NewErrParamPattern("LocalConsolePassword", "^[ -~]+$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *SetLocalConsolePasswordInput) SetGatewayARN(v string) *SetLocalConsolePasswordInput {
 	s.GatewayARN = &v
@@ -11956,6 +12916,21 @@ func (s *ShutdownGatewayInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ShutdownGatewayInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ShutdownGatewayInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *ShutdownGatewayInput) SetGatewayARN(v string) *ShutdownGatewayInput {
 	s.GatewayARN = &v
@@ -12026,6 +13001,21 @@ func (s *StartGatewayInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartGatewayInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartGatewayInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *StartGatewayInput) SetGatewayARN(v string) *StartGatewayInput {
 	s.GatewayARN = &v
@@ -12222,6 +13212,27 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 128))
+	}
+	if s.Key != nil && !request.MatchParamPattern("^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-%@]*)$", *s.Key) {
+		invalidParams.Add(request.NewErrParamPattern("Key", "^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-%@]*)$"))
+	}
+	if s.Value != nil && len(*s.Value) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -12594,6 +13605,21 @@ func (s *UpdateBandwidthRateLimitInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateBandwidthRateLimitInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateBandwidthRateLimitInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAverageDownloadRateLimitInBitsPerSec sets the AverageDownloadRateLimitInBitsPerSec field's value.
 func (s *UpdateBandwidthRateLimitInput) SetAverageDownloadRateLimitInBitsPerSec(v int64) *UpdateBandwidthRateLimitInput {
 	s.AverageDownloadRateLimitInBitsPerSec = &v
@@ -12721,6 +13747,33 @@ func (s *UpdateChapCredentialsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateChapCredentialsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateChapCredentialsInput"}
+	if s.InitiatorName != nil && len(*s.InitiatorName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("InitiatorName", 255))
+	}
+	if s.InitiatorName != nil && !request.MatchParamPattern("[0-9a-z:.-]+", *s.InitiatorName) {
+		invalidParams.Add(request.NewErrParamPattern("InitiatorName", "[0-9a-z:.-]+"))
+	}
+	if s.SecretToAuthenticateInitiator != nil && len(*s.SecretToAuthenticateInitiator) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("SecretToAuthenticateInitiator", 100))
+	}
+	if s.SecretToAuthenticateTarget != nil && len(*s.SecretToAuthenticateTarget) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("SecretToAuthenticateTarget", 100))
+	}
+	if s.TargetARN != nil && len(*s.TargetARN) > 800 {
+		invalidParams.Add(request.NewErrParamMaxLen("TargetARN", 800))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetInitiatorName sets the InitiatorName field's value.
 func (s *UpdateChapCredentialsInput) SetInitiatorName(v string) *UpdateChapCredentialsInput {
 	s.InitiatorName = &v
@@ -12829,6 +13882,30 @@ func (s *UpdateGatewayInformationInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateGatewayInformationInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateGatewayInformationInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.GatewayName != nil && len(*s.GatewayName) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayName", 255))
+	}
+	if s.GatewayName != nil && !request.MatchParamPattern("^[ -\\.0-\\[\\]-~]*[!-\\.0-\\[\\]-~][ -\\.0-\\[\\]-~]*$", *s.GatewayName) {
+		invalidParams.Add(request.NewErrParamPattern("GatewayName", "^[ -\\.0-\\[\\]-~]*[!-\\.0-\\[\\]-~][ -\\.0-\\[\\]-~]*$"))
+	}
+	if s.GatewayTimezone != nil && len(*s.GatewayTimezone) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayTimezone", 10))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *UpdateGatewayInformationInput) SetGatewayARN(v string) *UpdateGatewayInformationInput {
 	s.GatewayARN = &v
@@ -12919,6 +13996,21 @@ func (s *UpdateGatewaySoftwareNowInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateGatewaySoftwareNowInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateGatewaySoftwareNowInput"}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetGatewayARN sets the GatewayARN field's value.
 func (s *UpdateGatewaySoftwareNowInput) SetGatewayARN(v string) *UpdateGatewaySoftwareNowInput {
 	s.GatewayARN = &v
@@ -13024,6 +14116,30 @@ func (s *UpdateMaintenanceStartTimeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateMaintenanceStartTimeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateMaintenanceStartTimeInput"}
+	if s.DayOfWeek != nil && *s.DayOfWeek > 6 {
+		invalidParams.Add(request.NewErrParamMaxValue("DayOfWeek", 6))
+	}
+	if s.GatewayARN != nil && len(*s.GatewayARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("GatewayARN", 500))
+	}
+	if s.HourOfDay != nil && *s.HourOfDay > 23 {
+		invalidParams.Add(request.NewErrParamMaxValue("HourOfDay", 23))
+	}
+	if s.MinuteOfHour != nil && *s.MinuteOfHour > 59 {
+		invalidParams.Add(request.NewErrParamMaxValue("MinuteOfHour", 59))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDayOfWeek sets the DayOfWeek field's value.
 func (s *UpdateMaintenanceStartTimeInput) SetDayOfWeek(v int64) *UpdateMaintenanceStartTimeInput {
 	s.DayOfWeek = &v
@@ -13162,6 +14278,38 @@ func (s *UpdateNFSFileShareInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateNFSFileShareInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateNFSFileShareInput"}
+	if s.ClientList != nil && len(s.ClientList) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ClientList", 100))
+	}
+	if s.DefaultStorageClass != nil && len(*s.DefaultStorageClass) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultStorageClass", 20))
+	}
+	if s.FileShareARN != nil && len(*s.FileShareARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("FileShareARN", 500))
+	}
+	if s.KMSKey != nil && len(*s.KMSKey) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("KMSKey", 2048))
+	}
+	if s.Squash != nil && len(*s.Squash) > 15 {
+		invalidParams.Add(request.NewErrParamMaxLen("Squash", 15))
+	}
+	if s.NFSFileShareDefaults != nil {
+		if err := s.NFSFileShareDefaults.ValidateExtended(); err != nil {
+			invalidParams.AddNested("NFSFileShareDefaults", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetClientList sets the ClientList field's value.
 func (s *UpdateNFSFileShareInput) SetClientList(v []*string) *UpdateNFSFileShareInput {
 	s.ClientList = v
@@ -13308,6 +14456,30 @@ func (s *UpdateSnapshotScheduleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateSnapshotScheduleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateSnapshotScheduleInput"}
+	if s.Description != nil && len(*s.Description) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 255))
+	}
+	if s.RecurrenceInHours != nil && *s.RecurrenceInHours > 24 {
+		invalidParams.Add(request.NewErrParamMaxValue("RecurrenceInHours", 24))
+	}
+	if s.StartAt != nil && *s.StartAt > 23 {
+		invalidParams.Add(request.NewErrParamMaxValue("StartAt", 23))
+	}
+	if s.VolumeARN != nil && len(*s.VolumeARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("VolumeARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *UpdateSnapshotScheduleInput) SetDescription(v string) *UpdateSnapshotScheduleInput {
 	s.Description = &v
@@ -13405,6 +14577,24 @@ func (s *UpdateVTLDeviceTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateVTLDeviceTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateVTLDeviceTypeInput"}
+	if s.DeviceType != nil && len(*s.DeviceType) > 50 {
+		invalidParams.Add(request.NewErrParamMaxLen("DeviceType", 50))
+	}
+	if s.VTLDeviceARN != nil && len(*s.VTLDeviceARN) > 500 {
+		invalidParams.Add(request.NewErrParamMaxLen("VTLDeviceARN", 500))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDeviceType sets the DeviceType field's value.
 func (s *UpdateVTLDeviceTypeInput) SetDeviceType(v string) *UpdateVTLDeviceTypeInput {
 	s.DeviceType = &v
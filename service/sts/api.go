@@ -1140,6 +1140,16 @@ type AssumeRoleInput struct {
 	// also include underscores or any of the following characters: =,.@-
 	SerialNumber *string `min:"9" type:"string"`
 
+	// The source identity specified by the principal that is calling the AssumeRole
+	// operation. The source identity is recorded in CloudTrail logs, and persists
+	// across chained role sessions. You can use the sts:SourceIdentity condition
+	// key in a role trust policy to require users to set a source identity.
+	//
+	// The regex used to validate this parameter is a string of characters consisting
+	// of upper- and lower-case alphanumeric characters with no spaces. You can
+	// also include underscores or any of the following characters: =,.@-
+	SourceIdentity *string `min:"2" type:"string"`
+
 	// The value provided by the MFA device, if the trust policy of the role being
 	// assumed requires MFA (that is, if the policy includes a condition that tests
 	// for MFA). If the role being assumed requires MFA and if the TokenCode value
@@ -1187,6 +1197,9 @@ func (s *AssumeRoleInput) Validate() error {
 	if s.SerialNumber != nil && len(*s.SerialNumber) < 9 {
 		invalidParams.Add(request.NewErrParamMinLen("SerialNumber", 9))
 	}
+	if s.SourceIdentity != nil && len(*s.SourceIdentity) < 2 {
+		invalidParams.Add(request.NewErrParamMinLen("SourceIdentity", 2))
+	}
 	if s.TokenCode != nil && len(*s.TokenCode) < 6 {
 		invalidParams.Add(request.NewErrParamMinLen("TokenCode", 6))
 	}
@@ -1197,6 +1210,57 @@ func (s *AssumeRoleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssumeRoleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssumeRoleInput"}
+	if s.DurationSeconds != nil && *s.DurationSeconds > 3600 {
+		invalidParams.Add(request.NewErrParamMaxValue("DurationSeconds", 3600))
+	}
+	if s.ExternalId != nil && len(*s.ExternalId) > 1224 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExternalId", 1224))
+	}
+	if s.ExternalId != nil && !request.MatchParamPattern("[\\w+=,.@:\\/-]*", *s.ExternalId) {
+		invalidParams.Add(request.NewErrParamPattern("ExternalId", "[\\w+=,.@:\\/-]*"))
+	}
+	if s.Policy != nil && len(*s.Policy) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Policy", 2048))
+	}
+	if s.RoleArn != nil && len(*s.RoleArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleArn", 2048))
+	}
+	if s.RoleSessionName != nil && len(*s.RoleSessionName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleSessionName", 64))
+	}
+	if s.RoleSessionName != nil && !request.MatchParamPattern("[\\w+=,.@-]*", *s.RoleSessionName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleSessionName", "[\\w+=,.@-]*"))
+	}
+	if s.SerialNumber != nil && len(*s.SerialNumber) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("SerialNumber", 256))
+	}
+	if s.SerialNumber != nil && !request.MatchParamPattern("[\\w+=/:,.@-]*", *s.SerialNumber) {
+		invalidParams.Add(request.NewErrParamPattern("SerialNumber", "[\\w+=/:,.@-]*"))
+	}
+	if s.SourceIdentity != nil && len(*s.SourceIdentity) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("SourceIdentity", 64))
+	}
+	if s.SourceIdentity != nil && !request.MatchParamPattern("[\\w+=,.@-]*", *s.SourceIdentity) {
+		invalidParams.Add(request.NewErrParamPattern("SourceIdentity", "[\\w+=,.@-]*"))
+	}
+	if s.TokenCode != nil && len(*s.TokenCode) > 6 {
+		invalidParams.Add(request.NewErrParamMaxLen("TokenCode", 6))
+	}
+	if s.TokenCode != nil && !request.MatchParamPattern("[\\d]*", *s.TokenCode) {
+		invalidParams.Add(request.NewErrParamPattern("TokenCode", "[\\d]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDurationSeconds sets the DurationSeconds field's value.
 func (s *AssumeRoleInput) SetDurationSeconds(v int64) *AssumeRoleInput {
 	s.DurationSeconds = &v
@@ -1233,6 +1297,12 @@ func (s *AssumeRoleInput) SetSerialNumber(v string) *AssumeRoleInput {
 	return s
 }
 
+// SetSourceIdentity sets the SourceIdentity field's value.
+func (s *AssumeRoleInput) SetSourceIdentity(v string) *AssumeRoleInput {
+	s.SourceIdentity = &v
+	return s
+}
+
 // SetTokenCode sets the TokenCode field's value.
 func (s *AssumeRoleInput) SetTokenCode(v string) *AssumeRoleInput {
 	s.TokenCode = &v
@@ -1404,6 +1474,33 @@ func (s *AssumeRoleWithSAMLInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssumeRoleWithSAMLInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssumeRoleWithSAMLInput"}
+	if s.DurationSeconds != nil && *s.DurationSeconds > 3600 {
+		invalidParams.Add(request.NewErrParamMaxValue("DurationSeconds", 3600))
+	}
+	if s.Policy != nil && len(*s.Policy) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Policy", 2048))
+	}
+	if s.PrincipalArn != nil && len(*s.PrincipalArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("PrincipalArn", 2048))
+	}
+	if s.RoleArn != nil && len(*s.RoleArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleArn", 2048))
+	}
+	if s.SAMLAssertion != nil && len(*s.SAMLAssertion) > 50000 {
+		invalidParams.Add(request.NewErrParamMaxLen("SAMLAssertion", 50000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDurationSeconds sets the DurationSeconds field's value.
 func (s *AssumeRoleWithSAMLInput) SetDurationSeconds(v int64) *AssumeRoleWithSAMLInput {
 	s.DurationSeconds = &v
@@ -1673,6 +1770,39 @@ func (s *AssumeRoleWithWebIdentityInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssumeRoleWithWebIdentityInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssumeRoleWithWebIdentityInput"}
+	if s.DurationSeconds != nil && *s.DurationSeconds > 3600 {
+		invalidParams.Add(request.NewErrParamMaxValue("DurationSeconds", 3600))
+	}
+	if s.Policy != nil && len(*s.Policy) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Policy", 2048))
+	}
+	if s.ProviderId != nil && len(*s.ProviderId) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("ProviderId", 2048))
+	}
+	if s.RoleArn != nil && len(*s.RoleArn) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleArn", 2048))
+	}
+	if s.RoleSessionName != nil && len(*s.RoleSessionName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RoleSessionName", 64))
+	}
+	if s.RoleSessionName != nil && !request.MatchParamPattern("[\\w+=,.@-]*", *s.RoleSessionName) {
+		invalidParams.Add(request.NewErrParamPattern("RoleSessionName", "[\\w+=,.@-]*"))
+	}
+	if s.WebIdentityToken != nil && len(*s.WebIdentityToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("WebIdentityToken", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDurationSeconds sets the DurationSeconds field's value.
 func (s *AssumeRoleWithWebIdentityInput) SetDurationSeconds(v int64) *AssumeRoleWithWebIdentityInput {
 	s.DurationSeconds = &v
@@ -1942,6 +2072,21 @@ func (s *DecodeAuthorizationMessageInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DecodeAuthorizationMessageInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DecodeAuthorizationMessageInput"}
+	if s.EncodedMessage != nil && len(*s.EncodedMessage) > 10240 {
+		invalidParams.Add(request.NewErrParamMaxLen("EncodedMessage", 10240))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEncodedMessage sets the EncodedMessage field's value.
 func (s *DecodeAuthorizationMessageInput) SetEncodedMessage(v string) *DecodeAuthorizationMessageInput {
 	s.EncodedMessage = &v
@@ -2169,6 +2314,30 @@ func (s *GetFederationTokenInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetFederationTokenInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetFederationTokenInput"}
+	if s.DurationSeconds != nil && *s.DurationSeconds > 129600 {
+		invalidParams.Add(request.NewErrParamMaxValue("DurationSeconds", 129600))
+	}
+	if s.Name != nil && len(*s.Name) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 32))
+	}
+	if s.Name != nil && !request.MatchParamPattern("[\\w+=,.@-]*", *s.Name) {
+		invalidParams.Add(request.NewErrParamPattern("Name", "[\\w+=,.@-]*"))
+	}
+	if s.Policy != nil && len(*s.Policy) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Policy", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDurationSeconds sets the DurationSeconds field's value.
 func (s *GetFederationTokenInput) SetDurationSeconds(v int64) *GetFederationTokenInput {
 	s.DurationSeconds = &v
@@ -2307,6 +2476,33 @@ func (s *GetSessionTokenInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetSessionTokenInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetSessionTokenInput"}
+	if s.DurationSeconds != nil && *s.DurationSeconds > 129600 {
+		invalidParams.Add(request.NewErrParamMaxValue("DurationSeconds", 129600))
+	}
+	if s.SerialNumber != nil && len(*s.SerialNumber) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("SerialNumber", 256))
+	}
+	if s.SerialNumber != nil && !request.MatchParamPattern("[\\w+=/:,.@-]*", *s.SerialNumber) {
+		invalidParams.Add(request.NewErrParamPattern("SerialNumber", "[\\w+=/:,.@-]*"))
+	}
+	if s.TokenCode != nil && len(*s.TokenCode) > 6 {
+		invalidParams.Add(request.NewErrParamMaxLen("TokenCode", 6))
+	}
+	if s.TokenCode != nil && !request.MatchParamPattern("[\\d]*", *s.TokenCode) {
+		invalidParams.Add(request.NewErrParamPattern("TokenCode", "[\\d]*"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDurationSeconds sets the DurationSeconds field's value.
 func (s *GetSessionTokenInput) SetDurationSeconds(v int64) *GetSessionTokenInput {
 	s.DurationSeconds = &v
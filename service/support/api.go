@@ -1560,6 +1560,24 @@ func (s *AddCommunicationToCaseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddCommunicationToCaseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddCommunicationToCaseInput"}
+	if s.CcEmailAddresses != nil && len(s.CcEmailAddresses) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("CcEmailAddresses", 10))
+	}
+	if s.CommunicationBody != nil && len(*s.CommunicationBody) > 8000 {
+		invalidParams.Add(request.NewErrParamMaxLen("CommunicationBody", 8000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttachmentSetId sets the AttachmentSetId field's value.
 func (s *AddCommunicationToCaseInput) SetAttachmentSetId(v string) *AddCommunicationToCaseInput {
 	s.AttachmentSetId = &v
@@ -2020,6 +2038,24 @@ func (s *CreateCaseInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateCaseInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateCaseInput"}
+	if s.CcEmailAddresses != nil && len(s.CcEmailAddresses) > 10 {
+		invalidParams.Add(request.NewErrParamMaxLen("CcEmailAddresses", 10))
+	}
+	if s.CommunicationBody != nil && len(*s.CommunicationBody) > 8000 {
+		invalidParams.Add(request.NewErrParamMaxLen("CommunicationBody", 8000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAttachmentSetId sets the AttachmentSetId field's value.
 func (s *CreateCaseInput) SetAttachmentSetId(v string) *CreateCaseInput {
 	s.AttachmentSetId = &v
@@ -2229,6 +2265,24 @@ func (s *DescribeCasesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeCasesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeCasesInput"}
+	if s.CaseIdList != nil && len(s.CaseIdList) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("CaseIdList", 100))
+	}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAfterTime sets the AfterTime field's value.
 func (s *DescribeCasesInput) SetAfterTime(v string) *DescribeCasesInput {
 	s.AfterTime = &v
@@ -2369,6 +2423,21 @@ func (s *DescribeCommunicationsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeCommunicationsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeCommunicationsInput"}
+	if s.MaxResults != nil && *s.MaxResults > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxResults", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAfterTime sets the AfterTime field's value.
 func (s *DescribeCommunicationsInput) SetAfterTime(v string) *DescribeCommunicationsInput {
 	s.AfterTime = &v
@@ -2456,6 +2525,21 @@ func (s DescribeServicesInput) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeServicesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeServicesInput"}
+	if s.ServiceCodeList != nil && len(s.ServiceCodeList) > 100 {
+		invalidParams.Add(request.NewErrParamMaxLen("ServiceCodeList", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLanguage sets the Language field's value.
 func (s *DescribeServicesInput) SetLanguage(v string) *DescribeServicesInput {
 	s.Language = &v
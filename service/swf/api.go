@@ -4424,6 +4424,24 @@ func (s *ActivityType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ActivityType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActivityType"}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.Version != nil && len(*s.Version) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *ActivityType) SetName(v string) *ActivityType {
 	s.Name = &v
@@ -4664,6 +4682,21 @@ func (s *CancelTimerDecisionAttributes) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CancelTimerDecisionAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelTimerDecisionAttributes"}
+	if s.TimerId != nil && len(*s.TimerId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("TimerId", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTimerId sets the TimerId field's value.
 func (s *CancelTimerDecisionAttributes) SetTimerId(v string) *CancelTimerDecisionAttributes {
 	s.TimerId = &v
@@ -4764,6 +4797,21 @@ func (s CancelWorkflowExecutionDecisionAttributes) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CancelWorkflowExecutionDecisionAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CancelWorkflowExecutionDecisionAttributes"}
+	if s.Details != nil && len(*s.Details) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Details", 32768))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDetails sets the Details field's value.
 func (s *CancelWorkflowExecutionDecisionAttributes) SetDetails(v string) *CancelWorkflowExecutionDecisionAttributes {
 	s.Details = &v
@@ -5270,6 +5318,24 @@ func (s *CloseStatusFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CloseStatusFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CloseStatusFilter"}
+	if s.Status != nil {
+		values := []string{"COMPLETED", "FAILED", "CANCELED", "TERMINATED", "CONTINUED_AS_NEW", "TIMED_OUT"}
+		if !request.IsParamEnumValue(*s.Status, values) {
+			invalidParams.Add(request.NewErrParamEnum("Status", *s.Status, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStatus sets the Status field's value.
 func (s *CloseStatusFilter) SetStatus(v string) *CloseStatusFilter {
 	s.Status = &v
@@ -5314,6 +5380,21 @@ func (s CompleteWorkflowExecutionDecisionAttributes) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CompleteWorkflowExecutionDecisionAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CompleteWorkflowExecutionDecisionAttributes"}
+	if s.Result != nil && len(*s.Result) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Result", 32768))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResult sets the Result field's value.
 func (s *CompleteWorkflowExecutionDecisionAttributes) SetResult(v string) *CompleteWorkflowExecutionDecisionAttributes {
 	s.Result = &v
@@ -5505,6 +5586,47 @@ func (s *ContinueAsNewWorkflowExecutionDecisionAttributes) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ContinueAsNewWorkflowExecutionDecisionAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ContinueAsNewWorkflowExecutionDecisionAttributes"}
+	if s.ChildPolicy != nil {
+		values := []string{"TERMINATE", "REQUEST_CANCEL", "ABANDON"}
+		if !request.IsParamEnumValue(*s.ChildPolicy, values) {
+			invalidParams.Add(request.NewErrParamEnum("ChildPolicy", *s.ChildPolicy, values))
+		}
+	}
+	if s.ExecutionStartToCloseTimeout != nil && len(*s.ExecutionStartToCloseTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExecutionStartToCloseTimeout", 8))
+	}
+	if s.Input != nil && len(*s.Input) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Input", 32768))
+	}
+	if s.LambdaRole != nil && len(*s.LambdaRole) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("LambdaRole", 1600))
+	}
+	if s.TagList != nil && len(s.TagList) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagList", 5))
+	}
+	if s.TaskStartToCloseTimeout != nil && len(*s.TaskStartToCloseTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskStartToCloseTimeout", 8))
+	}
+	if s.WorkflowTypeVersion != nil && len(*s.WorkflowTypeVersion) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkflowTypeVersion", 64))
+	}
+	if s.TaskList != nil {
+		if err := s.TaskList.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TaskList", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChildPolicy sets the ChildPolicy field's value.
 func (s *ContinueAsNewWorkflowExecutionDecisionAttributes) SetChildPolicy(v string) *ContinueAsNewWorkflowExecutionDecisionAttributes {
 	s.ChildPolicy = &v
@@ -5711,6 +5833,41 @@ func (s *CountClosedWorkflowExecutionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CountClosedWorkflowExecutionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CountClosedWorkflowExecutionsInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.CloseStatusFilter != nil {
+		if err := s.CloseStatusFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CloseStatusFilter", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ExecutionFilter != nil {
+		if err := s.ExecutionFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ExecutionFilter", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TagFilter != nil {
+		if err := s.TagFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TagFilter", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TypeFilter != nil {
+		if err := s.TypeFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TypeFilter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCloseStatusFilter sets the CloseStatusFilter field's value.
 func (s *CountClosedWorkflowExecutionsInput) SetCloseStatusFilter(v *CloseStatusFilter) *CountClosedWorkflowExecutionsInput {
 	s.CloseStatusFilter = v
@@ -5837,6 +5994,36 @@ func (s *CountOpenWorkflowExecutionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CountOpenWorkflowExecutionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CountOpenWorkflowExecutionsInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.ExecutionFilter != nil {
+		if err := s.ExecutionFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ExecutionFilter", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TagFilter != nil {
+		if err := s.TagFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TagFilter", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TypeFilter != nil {
+		if err := s.TypeFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TypeFilter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *CountOpenWorkflowExecutionsInput) SetDomain(v string) *CountOpenWorkflowExecutionsInput {
 	s.Domain = &v
@@ -5915,6 +6102,26 @@ func (s *CountPendingActivityTasksInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CountPendingActivityTasksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CountPendingActivityTasksInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.TaskList != nil {
+		if err := s.TaskList.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TaskList", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *CountPendingActivityTasksInput) SetDomain(v string) *CountPendingActivityTasksInput {
 	s.Domain = &v
@@ -5975,6 +6182,26 @@ func (s *CountPendingDecisionTasksInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CountPendingDecisionTasksInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CountPendingDecisionTasksInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.TaskList != nil {
+		if err := s.TaskList.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TaskList", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *CountPendingDecisionTasksInput) SetDomain(v string) *CountPendingDecisionTasksInput {
 	s.Domain = &v
@@ -6288,6 +6515,89 @@ func (s *Decision) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Decision) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Decision"}
+	if s.DecisionType != nil {
+		values := []string{"ScheduleActivityTask", "RequestCancelActivityTask", "CompleteWorkflowExecution", "FailWorkflowExecution", "CancelWorkflowExecution", "ContinueAsNewWorkflowExecution", "RecordMarker", "StartTimer", "CancelTimer", "SignalExternalWorkflowExecution", "RequestCancelExternalWorkflowExecution", "StartChildWorkflowExecution", "ScheduleLambdaFunction"}
+		if !request.IsParamEnumValue(*s.DecisionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("DecisionType", *s.DecisionType, values))
+		}
+	}
+	if s.CancelTimerDecisionAttributes != nil {
+		if err := s.CancelTimerDecisionAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CancelTimerDecisionAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.CancelWorkflowExecutionDecisionAttributes != nil {
+		if err := s.CancelWorkflowExecutionDecisionAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CancelWorkflowExecutionDecisionAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.CompleteWorkflowExecutionDecisionAttributes != nil {
+		if err := s.CompleteWorkflowExecutionDecisionAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CompleteWorkflowExecutionDecisionAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ContinueAsNewWorkflowExecutionDecisionAttributes != nil {
+		if err := s.ContinueAsNewWorkflowExecutionDecisionAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ContinueAsNewWorkflowExecutionDecisionAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.FailWorkflowExecutionDecisionAttributes != nil {
+		if err := s.FailWorkflowExecutionDecisionAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FailWorkflowExecutionDecisionAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RecordMarkerDecisionAttributes != nil {
+		if err := s.RecordMarkerDecisionAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RecordMarkerDecisionAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RequestCancelActivityTaskDecisionAttributes != nil {
+		if err := s.RequestCancelActivityTaskDecisionAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RequestCancelActivityTaskDecisionAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.RequestCancelExternalWorkflowExecutionDecisionAttributes != nil {
+		if err := s.RequestCancelExternalWorkflowExecutionDecisionAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("RequestCancelExternalWorkflowExecutionDecisionAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ScheduleActivityTaskDecisionAttributes != nil {
+		if err := s.ScheduleActivityTaskDecisionAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ScheduleActivityTaskDecisionAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ScheduleLambdaFunctionDecisionAttributes != nil {
+		if err := s.ScheduleLambdaFunctionDecisionAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ScheduleLambdaFunctionDecisionAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.SignalExternalWorkflowExecutionDecisionAttributes != nil {
+		if err := s.SignalExternalWorkflowExecutionDecisionAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SignalExternalWorkflowExecutionDecisionAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.StartChildWorkflowExecutionDecisionAttributes != nil {
+		if err := s.StartChildWorkflowExecutionDecisionAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StartChildWorkflowExecutionDecisionAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.StartTimerDecisionAttributes != nil {
+		if err := s.StartTimerDecisionAttributes.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StartTimerDecisionAttributes", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCancelTimerDecisionAttributes sets the CancelTimerDecisionAttributes field's value.
 func (s *Decision) SetCancelTimerDecisionAttributes(v *CancelTimerDecisionAttributes) *Decision {
 	s.CancelTimerDecisionAttributes = v
@@ -6614,6 +6924,26 @@ func (s *DeprecateActivityTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeprecateActivityTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeprecateActivityTypeInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.ActivityType != nil {
+		if err := s.ActivityType.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ActivityType", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActivityType sets the ActivityType field's value.
 func (s *DeprecateActivityTypeInput) SetActivityType(v *ActivityType) *DeprecateActivityTypeInput {
 	s.ActivityType = v
@@ -6675,6 +7005,21 @@ func (s *DeprecateDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeprecateDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeprecateDomainInput"}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DeprecateDomainInput) SetName(v string) *DeprecateDomainInput {
 	s.Name = &v
@@ -6743,6 +7088,26 @@ func (s *DeprecateWorkflowTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeprecateWorkflowTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeprecateWorkflowTypeInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.WorkflowType != nil {
+		if err := s.WorkflowType.ValidateExtended(); err != nil {
+			invalidParams.AddNested("WorkflowType", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *DeprecateWorkflowTypeInput) SetDomain(v string) *DeprecateWorkflowTypeInput {
 	s.Domain = &v
@@ -6818,6 +7183,26 @@ func (s *DescribeActivityTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeActivityTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeActivityTypeInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.ActivityType != nil {
+		if err := s.ActivityType.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ActivityType", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActivityType sets the ActivityType field's value.
 func (s *DescribeActivityTypeInput) SetActivityType(v *ActivityType) *DescribeActivityTypeInput {
 	s.ActivityType = v
@@ -6912,6 +7297,21 @@ func (s *DescribeDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeDomainInput"}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *DescribeDomainInput) SetName(v string) *DescribeDomainInput {
 	s.Name = &v
@@ -7004,6 +7404,26 @@ func (s *DescribeWorkflowExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeWorkflowExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeWorkflowExecutionInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.Execution != nil {
+		if err := s.Execution.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Execution", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *DescribeWorkflowExecutionInput) SetDomain(v string) *DescribeWorkflowExecutionInput {
 	s.Domain = &v
@@ -7136,6 +7556,26 @@ func (s *DescribeWorkflowTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeWorkflowTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeWorkflowTypeInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.WorkflowType != nil {
+		if err := s.WorkflowType.ValidateExtended(); err != nil {
+			invalidParams.AddNested("WorkflowType", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *DescribeWorkflowTypeInput) SetDomain(v string) *DescribeWorkflowTypeInput {
 	s.Domain = &v
@@ -7446,6 +7886,24 @@ func (s FailWorkflowExecutionDecisionAttributes) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *FailWorkflowExecutionDecisionAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "FailWorkflowExecutionDecisionAttributes"}
+	if s.Details != nil && len(*s.Details) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Details", 32768))
+	}
+	if s.Reason != nil && len(*s.Reason) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Reason", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDetails sets the Details field's value.
 func (s *FailWorkflowExecutionDecisionAttributes) SetDetails(v string) *FailWorkflowExecutionDecisionAttributes {
 	s.Details = &v
@@ -7573,6 +8031,32 @@ func (s *GetWorkflowExecutionHistoryInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetWorkflowExecutionHistoryInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetWorkflowExecutionHistoryInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.MaximumPageSize != nil && *s.MaximumPageSize > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaximumPageSize", 1000))
+	}
+	if s.NextPageToken != nil && len(*s.NextPageToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextPageToken", 2048))
+	}
+	if s.Execution != nil {
+		if err := s.Execution.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Execution", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *GetWorkflowExecutionHistoryInput) SetDomain(v string) *GetWorkflowExecutionHistoryInput {
 	s.Domain = &v
@@ -8749,6 +9233,36 @@ func (s *ListActivityTypesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListActivityTypesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListActivityTypesInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.MaximumPageSize != nil && *s.MaximumPageSize > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaximumPageSize", 1000))
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.NextPageToken != nil && len(*s.NextPageToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextPageToken", 2048))
+	}
+	if s.RegistrationStatus != nil {
+		values := []string{"REGISTERED", "DEPRECATED"}
+		if !request.IsParamEnumValue(*s.RegistrationStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("RegistrationStatus", *s.RegistrationStatus, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *ListActivityTypesInput) SetDomain(v string) *ListActivityTypesInput {
 	s.Domain = &v
@@ -8956,6 +9470,47 @@ func (s *ListClosedWorkflowExecutionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListClosedWorkflowExecutionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListClosedWorkflowExecutionsInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.MaximumPageSize != nil && *s.MaximumPageSize > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaximumPageSize", 1000))
+	}
+	if s.NextPageToken != nil && len(*s.NextPageToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextPageToken", 2048))
+	}
+	if s.CloseStatusFilter != nil {
+		if err := s.CloseStatusFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("CloseStatusFilter", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.ExecutionFilter != nil {
+		if err := s.ExecutionFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ExecutionFilter", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TagFilter != nil {
+		if err := s.TagFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TagFilter", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TypeFilter != nil {
+		if err := s.TypeFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TypeFilter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetCloseStatusFilter sets the CloseStatusFilter field's value.
 func (s *ListClosedWorkflowExecutionsInput) SetCloseStatusFilter(v *CloseStatusFilter) *ListClosedWorkflowExecutionsInput {
 	s.CloseStatusFilter = v
@@ -9069,6 +9624,30 @@ func (s *ListDomainsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListDomainsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListDomainsInput"}
+	if s.MaximumPageSize != nil && *s.MaximumPageSize > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaximumPageSize", 1000))
+	}
+	if s.NextPageToken != nil && len(*s.NextPageToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextPageToken", 2048))
+	}
+	if s.RegistrationStatus != nil {
+		values := []string{"REGISTERED", "DEPRECATED"}
+		if !request.IsParamEnumValue(*s.RegistrationStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("RegistrationStatus", *s.RegistrationStatus, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetMaximumPageSize sets the MaximumPageSize field's value.
 func (s *ListDomainsInput) SetMaximumPageSize(v int64) *ListDomainsInput {
 	s.MaximumPageSize = &v
@@ -9237,6 +9816,42 @@ func (s *ListOpenWorkflowExecutionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListOpenWorkflowExecutionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListOpenWorkflowExecutionsInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.MaximumPageSize != nil && *s.MaximumPageSize > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaximumPageSize", 1000))
+	}
+	if s.NextPageToken != nil && len(*s.NextPageToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextPageToken", 2048))
+	}
+	if s.ExecutionFilter != nil {
+		if err := s.ExecutionFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ExecutionFilter", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TagFilter != nil {
+		if err := s.TagFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TagFilter", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TypeFilter != nil {
+		if err := s.TypeFilter.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TypeFilter", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *ListOpenWorkflowExecutionsInput) SetDomain(v string) *ListOpenWorkflowExecutionsInput {
 	s.Domain = &v
@@ -9356,6 +9971,36 @@ func (s *ListWorkflowTypesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListWorkflowTypesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListWorkflowTypesInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.MaximumPageSize != nil && *s.MaximumPageSize > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaximumPageSize", 1000))
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.NextPageToken != nil && len(*s.NextPageToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextPageToken", 2048))
+	}
+	if s.RegistrationStatus != nil {
+		values := []string{"REGISTERED", "DEPRECATED"}
+		if !request.IsParamEnumValue(*s.RegistrationStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("RegistrationStatus", *s.RegistrationStatus, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *ListWorkflowTypesInput) SetDomain(v string) *ListWorkflowTypesInput {
 	s.Domain = &v
@@ -9574,6 +10219,29 @@ func (s *PollForActivityTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PollForActivityTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PollForActivityTaskInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.Identity != nil && len(*s.Identity) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Identity", 256))
+	}
+	if s.TaskList != nil {
+		if err := s.TaskList.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TaskList", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *PollForActivityTaskInput) SetDomain(v string) *PollForActivityTaskInput {
 	s.Domain = &v
@@ -9757,6 +10425,35 @@ func (s *PollForDecisionTaskInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *PollForDecisionTaskInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "PollForDecisionTaskInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.Identity != nil && len(*s.Identity) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Identity", 256))
+	}
+	if s.MaximumPageSize != nil && *s.MaximumPageSize > 1000 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaximumPageSize", 1000))
+	}
+	if s.NextPageToken != nil && len(*s.NextPageToken) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextPageToken", 2048))
+	}
+	if s.TaskList != nil {
+		if err := s.TaskList.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TaskList", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *PollForDecisionTaskInput) SetDomain(v string) *PollForDecisionTaskInput {
 	s.Domain = &v
@@ -9935,6 +10632,24 @@ func (s *RecordActivityTaskHeartbeatInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RecordActivityTaskHeartbeatInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RecordActivityTaskHeartbeatInput"}
+	if s.Details != nil && len(*s.Details) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Details", 2048))
+	}
+	if s.TaskToken != nil && len(*s.TaskToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDetails sets the Details field's value.
 func (s *RecordActivityTaskHeartbeatInput) SetDetails(v string) *RecordActivityTaskHeartbeatInput {
 	s.Details = &v
@@ -10031,6 +10746,24 @@ func (s *RecordMarkerDecisionAttributes) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RecordMarkerDecisionAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RecordMarkerDecisionAttributes"}
+	if s.Details != nil && len(*s.Details) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Details", 32768))
+	}
+	if s.MarkerName != nil && len(*s.MarkerName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("MarkerName", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDetails sets the Details field's value.
 func (s *RecordMarkerDecisionAttributes) SetDetails(v string) *RecordMarkerDecisionAttributes {
 	s.Details = &v
@@ -10228,6 +10961,47 @@ func (s *RegisterActivityTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterActivityTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterActivityTypeInput"}
+	if s.DefaultTaskHeartbeatTimeout != nil && len(*s.DefaultTaskHeartbeatTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultTaskHeartbeatTimeout", 8))
+	}
+	if s.DefaultTaskScheduleToCloseTimeout != nil && len(*s.DefaultTaskScheduleToCloseTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultTaskScheduleToCloseTimeout", 8))
+	}
+	if s.DefaultTaskScheduleToStartTimeout != nil && len(*s.DefaultTaskScheduleToStartTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultTaskScheduleToStartTimeout", 8))
+	}
+	if s.DefaultTaskStartToCloseTimeout != nil && len(*s.DefaultTaskStartToCloseTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultTaskStartToCloseTimeout", 8))
+	}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.Version != nil && len(*s.Version) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 64))
+	}
+	if s.DefaultTaskList != nil {
+		if err := s.DefaultTaskList.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DefaultTaskList", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultTaskHeartbeatTimeout sets the DefaultTaskHeartbeatTimeout field's value.
 func (s *RegisterActivityTypeInput) SetDefaultTaskHeartbeatTimeout(v string) *RegisterActivityTypeInput {
 	s.DefaultTaskHeartbeatTimeout = &v
@@ -10366,6 +11140,27 @@ func (s *RegisterDomainInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterDomainInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterDomainInput"}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.WorkflowExecutionRetentionPeriodInDays != nil && len(*s.WorkflowExecutionRetentionPeriodInDays) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkflowExecutionRetentionPeriodInDays", 8))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDescription sets the Description field's value.
 func (s *RegisterDomainInput) SetDescription(v string) *RegisterDomainInput {
 	s.Description = &v
@@ -10540,6 +11335,50 @@ func (s *RegisterWorkflowTypeInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RegisterWorkflowTypeInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RegisterWorkflowTypeInput"}
+	if s.DefaultChildPolicy != nil {
+		values := []string{"TERMINATE", "REQUEST_CANCEL", "ABANDON"}
+		if !request.IsParamEnumValue(*s.DefaultChildPolicy, values) {
+			invalidParams.Add(request.NewErrParamEnum("DefaultChildPolicy", *s.DefaultChildPolicy, values))
+		}
+	}
+	if s.DefaultExecutionStartToCloseTimeout != nil && len(*s.DefaultExecutionStartToCloseTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultExecutionStartToCloseTimeout", 8))
+	}
+	if s.DefaultLambdaRole != nil && len(*s.DefaultLambdaRole) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultLambdaRole", 1600))
+	}
+	if s.DefaultTaskStartToCloseTimeout != nil && len(*s.DefaultTaskStartToCloseTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("DefaultTaskStartToCloseTimeout", 8))
+	}
+	if s.Description != nil && len(*s.Description) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("Description", 1024))
+	}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.Version != nil && len(*s.Version) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 64))
+	}
+	if s.DefaultTaskList != nil {
+		if err := s.DefaultTaskList.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DefaultTaskList", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDefaultChildPolicy sets the DefaultChildPolicy field's value.
 func (s *RegisterWorkflowTypeInput) SetDefaultChildPolicy(v string) *RegisterWorkflowTypeInput {
 	s.DefaultChildPolicy = &v
@@ -10669,6 +11508,21 @@ func (s *RequestCancelActivityTaskDecisionAttributes) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RequestCancelActivityTaskDecisionAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RequestCancelActivityTaskDecisionAttributes"}
+	if s.ActivityId != nil && len(*s.ActivityId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ActivityId", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActivityId sets the ActivityId field's value.
 func (s *RequestCancelActivityTaskDecisionAttributes) SetActivityId(v string) *RequestCancelActivityTaskDecisionAttributes {
 	s.ActivityId = &v
@@ -10794,6 +11648,27 @@ func (s *RequestCancelExternalWorkflowExecutionDecisionAttributes) Validate() er
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RequestCancelExternalWorkflowExecutionDecisionAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RequestCancelExternalWorkflowExecutionDecisionAttributes"}
+	if s.Control != nil && len(*s.Control) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Control", 32768))
+	}
+	if s.RunId != nil && len(*s.RunId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RunId", 64))
+	}
+	if s.WorkflowId != nil && len(*s.WorkflowId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkflowId", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetControl sets the Control field's value.
 func (s *RequestCancelExternalWorkflowExecutionDecisionAttributes) SetControl(v string) *RequestCancelExternalWorkflowExecutionDecisionAttributes {
 	s.Control = &v
@@ -11013,6 +11888,27 @@ func (s *RequestCancelWorkflowExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RequestCancelWorkflowExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RequestCancelWorkflowExecutionInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.RunId != nil && len(*s.RunId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RunId", 64))
+	}
+	if s.WorkflowId != nil && len(*s.WorkflowId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkflowId", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *RequestCancelWorkflowExecutionInput) SetDomain(v string) *RequestCancelWorkflowExecutionInput {
 	s.Domain = &v
@@ -11087,6 +11983,24 @@ func (s *RespondActivityTaskCanceledInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RespondActivityTaskCanceledInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RespondActivityTaskCanceledInput"}
+	if s.Details != nil && len(*s.Details) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Details", 32768))
+	}
+	if s.TaskToken != nil && len(*s.TaskToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDetails sets the Details field's value.
 func (s *RespondActivityTaskCanceledInput) SetDetails(v string) *RespondActivityTaskCanceledInput {
 	s.Details = &v
@@ -11156,6 +12070,24 @@ func (s *RespondActivityTaskCompletedInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RespondActivityTaskCompletedInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RespondActivityTaskCompletedInput"}
+	if s.Result != nil && len(*s.Result) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Result", 32768))
+	}
+	if s.TaskToken != nil && len(*s.TaskToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResult sets the Result field's value.
 func (s *RespondActivityTaskCompletedInput) SetResult(v string) *RespondActivityTaskCompletedInput {
 	s.Result = &v
@@ -11227,6 +12159,27 @@ func (s *RespondActivityTaskFailedInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RespondActivityTaskFailedInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RespondActivityTaskFailedInput"}
+	if s.Details != nil && len(*s.Details) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Details", 32768))
+	}
+	if s.Reason != nil && len(*s.Reason) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Reason", 256))
+	}
+	if s.TaskToken != nil && len(*s.TaskToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskToken", 1024))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDetails sets the Details field's value.
 func (s *RespondActivityTaskFailedInput) SetDetails(v string) *RespondActivityTaskFailedInput {
 	s.Details = &v
@@ -11316,6 +12269,34 @@ func (s *RespondDecisionTaskCompletedInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RespondDecisionTaskCompletedInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RespondDecisionTaskCompletedInput"}
+	if s.ExecutionContext != nil && len(*s.ExecutionContext) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExecutionContext", 32768))
+	}
+	if s.TaskToken != nil && len(*s.TaskToken) > 1024 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskToken", 1024))
+	}
+	if s.Decisions != nil {
+		for i, v := range s.Decisions {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Decisions", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDecisions sets the Decisions field's value.
 func (s *RespondDecisionTaskCompletedInput) SetDecisions(v []*Decision) *RespondDecisionTaskCompletedInput {
 	s.Decisions = v
@@ -11512,6 +12493,49 @@ func (s *ScheduleActivityTaskDecisionAttributes) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ScheduleActivityTaskDecisionAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ScheduleActivityTaskDecisionAttributes"}
+	if s.ActivityId != nil && len(*s.ActivityId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("ActivityId", 256))
+	}
+	if s.Control != nil && len(*s.Control) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Control", 32768))
+	}
+	if s.HeartbeatTimeout != nil && len(*s.HeartbeatTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("HeartbeatTimeout", 8))
+	}
+	if s.Input != nil && len(*s.Input) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Input", 32768))
+	}
+	if s.ScheduleToCloseTimeout != nil && len(*s.ScheduleToCloseTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("ScheduleToCloseTimeout", 8))
+	}
+	if s.ScheduleToStartTimeout != nil && len(*s.ScheduleToStartTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("ScheduleToStartTimeout", 8))
+	}
+	if s.StartToCloseTimeout != nil && len(*s.StartToCloseTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("StartToCloseTimeout", 8))
+	}
+	if s.ActivityType != nil {
+		if err := s.ActivityType.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ActivityType", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.TaskList != nil {
+		if err := s.TaskList.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TaskList", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetActivityId sets the ActivityId field's value.
 func (s *ScheduleActivityTaskDecisionAttributes) SetActivityId(v string) *ScheduleActivityTaskDecisionAttributes {
 	s.ActivityId = &v
@@ -11700,6 +12724,33 @@ func (s *ScheduleLambdaFunctionDecisionAttributes) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ScheduleLambdaFunctionDecisionAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ScheduleLambdaFunctionDecisionAttributes"}
+	if s.Control != nil && len(*s.Control) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Control", 32768))
+	}
+	if s.Id != nil && len(*s.Id) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 256))
+	}
+	if s.Input != nil && len(*s.Input) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Input", 32768))
+	}
+	if s.Name != nil && len(*s.Name) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 64))
+	}
+	if s.StartToCloseTimeout != nil && len(*s.StartToCloseTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("StartToCloseTimeout", 8))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetControl sets the Control field's value.
 func (s *ScheduleLambdaFunctionDecisionAttributes) SetControl(v string) *ScheduleLambdaFunctionDecisionAttributes {
 	s.Control = &v
@@ -11876,6 +12927,33 @@ func (s *SignalExternalWorkflowExecutionDecisionAttributes) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SignalExternalWorkflowExecutionDecisionAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SignalExternalWorkflowExecutionDecisionAttributes"}
+	if s.Control != nil && len(*s.Control) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Control", 32768))
+	}
+	if s.Input != nil && len(*s.Input) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Input", 32768))
+	}
+	if s.RunId != nil && len(*s.RunId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RunId", 64))
+	}
+	if s.SignalName != nil && len(*s.SignalName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("SignalName", 256))
+	}
+	if s.WorkflowId != nil && len(*s.WorkflowId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkflowId", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetControl sets the Control field's value.
 func (s *SignalExternalWorkflowExecutionDecisionAttributes) SetControl(v string) *SignalExternalWorkflowExecutionDecisionAttributes {
 	s.Control = &v
@@ -12141,6 +13219,33 @@ func (s *SignalWorkflowExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SignalWorkflowExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SignalWorkflowExecutionInput"}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.Input != nil && len(*s.Input) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Input", 32768))
+	}
+	if s.RunId != nil && len(*s.RunId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RunId", 64))
+	}
+	if s.SignalName != nil && len(*s.SignalName) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("SignalName", 256))
+	}
+	if s.WorkflowId != nil && len(*s.WorkflowId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkflowId", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDomain sets the Domain field's value.
 func (s *SignalWorkflowExecutionInput) SetDomain(v string) *SignalWorkflowExecutionInput {
 	s.Domain = &v
@@ -12362,6 +13467,55 @@ func (s *StartChildWorkflowExecutionDecisionAttributes) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartChildWorkflowExecutionDecisionAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartChildWorkflowExecutionDecisionAttributes"}
+	if s.ChildPolicy != nil {
+		values := []string{"TERMINATE", "REQUEST_CANCEL", "ABANDON"}
+		if !request.IsParamEnumValue(*s.ChildPolicy, values) {
+			invalidParams.Add(request.NewErrParamEnum("ChildPolicy", *s.ChildPolicy, values))
+		}
+	}
+	if s.Control != nil && len(*s.Control) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Control", 32768))
+	}
+	if s.ExecutionStartToCloseTimeout != nil && len(*s.ExecutionStartToCloseTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExecutionStartToCloseTimeout", 8))
+	}
+	if s.Input != nil && len(*s.Input) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Input", 32768))
+	}
+	if s.LambdaRole != nil && len(*s.LambdaRole) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("LambdaRole", 1600))
+	}
+	if s.TagList != nil && len(s.TagList) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagList", 5))
+	}
+	if s.TaskStartToCloseTimeout != nil && len(*s.TaskStartToCloseTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskStartToCloseTimeout", 8))
+	}
+	if s.WorkflowId != nil && len(*s.WorkflowId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkflowId", 256))
+	}
+	if s.TaskList != nil {
+		if err := s.TaskList.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TaskList", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.WorkflowType != nil {
+		if err := s.WorkflowType.ValidateExtended(); err != nil {
+			invalidParams.AddNested("WorkflowType", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChildPolicy sets the ChildPolicy field's value.
 func (s *StartChildWorkflowExecutionDecisionAttributes) SetChildPolicy(v string) *StartChildWorkflowExecutionDecisionAttributes {
 	s.ChildPolicy = &v
@@ -12816,6 +13970,27 @@ func (s *StartTimerDecisionAttributes) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartTimerDecisionAttributes) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartTimerDecisionAttributes"}
+	if s.Control != nil && len(*s.Control) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Control", 32768))
+	}
+	if s.StartToFireTimeout != nil && len(*s.StartToFireTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("StartToFireTimeout", 8))
+	}
+	if s.TimerId != nil && len(*s.TimerId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("TimerId", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetControl sets the Control field's value.
 func (s *StartTimerDecisionAttributes) SetControl(v string) *StartTimerDecisionAttributes {
 	s.Control = &v
@@ -13062,6 +14237,55 @@ func (s *StartWorkflowExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartWorkflowExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartWorkflowExecutionInput"}
+	if s.ChildPolicy != nil {
+		values := []string{"TERMINATE", "REQUEST_CANCEL", "ABANDON"}
+		if !request.IsParamEnumValue(*s.ChildPolicy, values) {
+			invalidParams.Add(request.NewErrParamEnum("ChildPolicy", *s.ChildPolicy, values))
+		}
+	}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.ExecutionStartToCloseTimeout != nil && len(*s.ExecutionStartToCloseTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("ExecutionStartToCloseTimeout", 8))
+	}
+	if s.Input != nil && len(*s.Input) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Input", 32768))
+	}
+	if s.LambdaRole != nil && len(*s.LambdaRole) > 1600 {
+		invalidParams.Add(request.NewErrParamMaxLen("LambdaRole", 1600))
+	}
+	if s.TagList != nil && len(s.TagList) > 5 {
+		invalidParams.Add(request.NewErrParamMaxLen("TagList", 5))
+	}
+	if s.TaskStartToCloseTimeout != nil && len(*s.TaskStartToCloseTimeout) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("TaskStartToCloseTimeout", 8))
+	}
+	if s.WorkflowId != nil && len(*s.WorkflowId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkflowId", 256))
+	}
+	if s.TaskList != nil {
+		if err := s.TaskList.ValidateExtended(); err != nil {
+			invalidParams.AddNested("TaskList", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.WorkflowType != nil {
+		if err := s.WorkflowType.ValidateExtended(); err != nil {
+			invalidParams.AddNested("WorkflowType", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChildPolicy sets the ChildPolicy field's value.
 func (s *StartWorkflowExecutionInput) SetChildPolicy(v string) *StartWorkflowExecutionInput {
 	s.ChildPolicy = &v
@@ -13187,6 +14411,21 @@ func (s *TagFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TagFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TagFilter"}
+	if s.Tag != nil && len(*s.Tag) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Tag", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTag sets the Tag field's value.
 func (s *TagFilter) SetTag(v string) *TagFilter {
 	s.Tag = &v
@@ -13229,6 +14468,21 @@ func (s *TaskList) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TaskList) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TaskList"}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *TaskList) SetName(v string) *TaskList {
 	s.Name = &v
@@ -13312,6 +14566,39 @@ func (s *TerminateWorkflowExecutionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TerminateWorkflowExecutionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TerminateWorkflowExecutionInput"}
+	if s.ChildPolicy != nil {
+		values := []string{"TERMINATE", "REQUEST_CANCEL", "ABANDON"}
+		if !request.IsParamEnumValue(*s.ChildPolicy, values) {
+			invalidParams.Add(request.NewErrParamEnum("ChildPolicy", *s.ChildPolicy, values))
+		}
+	}
+	if s.Details != nil && len(*s.Details) > 32768 {
+		invalidParams.Add(request.NewErrParamMaxLen("Details", 32768))
+	}
+	if s.Domain != nil && len(*s.Domain) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Domain", 256))
+	}
+	if s.Reason != nil && len(*s.Reason) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Reason", 256))
+	}
+	if s.RunId != nil && len(*s.RunId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RunId", 64))
+	}
+	if s.WorkflowId != nil && len(*s.WorkflowId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkflowId", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChildPolicy sets the ChildPolicy field's value.
 func (s *TerminateWorkflowExecutionInput) SetChildPolicy(v string) *TerminateWorkflowExecutionInput {
 	s.ChildPolicy = &v
@@ -13565,6 +14852,24 @@ func (s *WorkflowExecution) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *WorkflowExecution) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "WorkflowExecution"}
+	if s.RunId != nil && len(*s.RunId) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("RunId", 64))
+	}
+	if s.WorkflowId != nil && len(*s.WorkflowId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkflowId", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRunId sets the RunId field's value.
 func (s *WorkflowExecution) SetRunId(v string) *WorkflowExecution {
 	s.RunId = &v
@@ -14073,6 +15378,21 @@ func (s *WorkflowExecutionFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *WorkflowExecutionFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "WorkflowExecutionFilter"}
+	if s.WorkflowId != nil && len(*s.WorkflowId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkflowId", 256))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetWorkflowId sets the WorkflowId field's value.
 func (s *WorkflowExecutionFilter) SetWorkflowId(v string) *WorkflowExecutionFilter {
 	s.WorkflowId = &v
@@ -14700,6 +16020,24 @@ func (s *WorkflowType) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *WorkflowType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "WorkflowType"}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.Version != nil && len(*s.Version) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *WorkflowType) SetName(v string) *WorkflowType {
 	s.Name = &v
@@ -14870,6 +16208,24 @@ func (s *WorkflowTypeFilter) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *WorkflowTypeFilter) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "WorkflowTypeFilter"}
+	if s.Name != nil && len(*s.Name) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 256))
+	}
+	if s.Version != nil && len(*s.Version) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Version", 64))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetName sets the Name field's value.
 func (s *WorkflowTypeFilter) SetName(v string) *WorkflowTypeFilter {
 	s.Name = &v
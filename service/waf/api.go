@@ -5750,6 +5750,32 @@ func (s *ActivatedRule) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ActivatedRule) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActivatedRule"}
+	if s.RuleId != nil && len(*s.RuleId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleId", 128))
+	}
+	if s.Type != nil {
+		values := []string{"REGULAR", "RATE_BASED"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.Action != nil {
+		if err := s.Action.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Action", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *ActivatedRule) SetAction(v *WafAction) *ActivatedRule {
 	s.Action = v
@@ -5932,6 +5958,29 @@ func (s *ByteMatchSetUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ByteMatchSetUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ByteMatchSetUpdate"}
+	if s.Action != nil {
+		values := []string{"INSERT", "DELETE"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+	if s.ByteMatchTuple != nil {
+		if err := s.ByteMatchTuple.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ByteMatchTuple", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *ByteMatchSetUpdate) SetAction(v string) *ByteMatchSetUpdate {
 	s.Action = &v
@@ -6169,6 +6218,35 @@ func (s *ByteMatchTuple) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ByteMatchTuple) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ByteMatchTuple"}
+	if s.PositionalConstraint != nil {
+		values := []string{"EXACTLY", "STARTS_WITH", "ENDS_WITH", "CONTAINS", "CONTAINS_WORD"}
+		if !request.IsParamEnumValue(*s.PositionalConstraint, values) {
+			invalidParams.Add(request.NewErrParamEnum("PositionalConstraint", *s.PositionalConstraint, values))
+		}
+	}
+	if s.TextTransformation != nil {
+		values := []string{"NONE", "COMPRESS_WHITE_SPACE", "HTML_ENTITY_DECODE", "LOWERCASE", "CMD_LINE", "URL_DECODE"}
+		if !request.IsParamEnumValue(*s.TextTransformation, values) {
+			invalidParams.Add(request.NewErrParamEnum("TextTransformation", *s.TextTransformation, values))
+		}
+	}
+	if s.FieldToMatch != nil {
+		if err := s.FieldToMatch.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FieldToMatch", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFieldToMatch sets the FieldToMatch field's value.
 func (s *ByteMatchTuple) SetFieldToMatch(v *FieldToMatch) *ByteMatchTuple {
 	s.FieldToMatch = v
@@ -6241,6 +6319,21 @@ func (s *CreateByteMatchSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateByteMatchSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateByteMatchSetInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *CreateByteMatchSetInput) SetChangeToken(v string) *CreateByteMatchSetInput {
 	s.ChangeToken = &v
@@ -6336,6 +6429,21 @@ func (s *CreateIPSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateIPSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateIPSetInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *CreateIPSetInput) SetChangeToken(v string) *CreateIPSetInput {
 	s.ChangeToken = &v
@@ -6470,6 +6578,27 @@ func (s *CreateRateBasedRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateRateBasedRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateRateBasedRuleInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.RateKey != nil {
+		values := []string{"IP"}
+		if !request.IsParamEnumValue(*s.RateKey, values) {
+			invalidParams.Add(request.NewErrParamEnum("RateKey", *s.RateKey, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *CreateRateBasedRuleInput) SetChangeToken(v string) *CreateRateBasedRuleInput {
 	s.ChangeToken = &v
@@ -6594,6 +6723,21 @@ func (s *CreateRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateRuleInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *CreateRuleInput) SetChangeToken(v string) *CreateRuleInput {
 	s.ChangeToken = &v
@@ -6695,6 +6839,21 @@ func (s *CreateSizeConstraintSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateSizeConstraintSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateSizeConstraintSetInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *CreateSizeConstraintSetInput) SetChangeToken(v string) *CreateSizeConstraintSetInput {
 	s.ChangeToken = &v
@@ -6791,6 +6950,21 @@ func (s *CreateSqlInjectionMatchSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateSqlInjectionMatchSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateSqlInjectionMatchSetInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *CreateSqlInjectionMatchSetInput) SetChangeToken(v string) *CreateSqlInjectionMatchSetInput {
 	s.ChangeToken = &v
@@ -6912,6 +7086,26 @@ func (s *CreateWebACLInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateWebACLInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateWebACLInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+	if s.DefaultAction != nil {
+		if err := s.DefaultAction.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DefaultAction", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *CreateWebACLInput) SetChangeToken(v string) *CreateWebACLInput {
 	s.ChangeToken = &v
@@ -7020,6 +7214,21 @@ func (s *CreateXssMatchSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateXssMatchSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateXssMatchSetInput"}
+	if s.Name != nil && len(*s.Name) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *CreateXssMatchSetInput) SetChangeToken(v string) *CreateXssMatchSetInput {
 	s.ChangeToken = &v
@@ -7116,6 +7325,21 @@ func (s *DeleteByteMatchSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteByteMatchSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteByteMatchSetInput"}
+	if s.ByteMatchSetId != nil && len(*s.ByteMatchSetId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ByteMatchSetId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetByteMatchSetId sets the ByteMatchSetId field's value.
 func (s *DeleteByteMatchSetInput) SetByteMatchSetId(v string) *DeleteByteMatchSetInput {
 	s.ByteMatchSetId = &v
@@ -7202,6 +7426,21 @@ func (s *DeleteIPSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteIPSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteIPSetInput"}
+	if s.IPSetId != nil && len(*s.IPSetId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("IPSetId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *DeleteIPSetInput) SetChangeToken(v string) *DeleteIPSetInput {
 	s.ChangeToken = &v
@@ -7288,6 +7527,21 @@ func (s *DeleteRateBasedRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteRateBasedRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteRateBasedRuleInput"}
+	if s.RuleId != nil && len(*s.RuleId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *DeleteRateBasedRuleInput) SetChangeToken(v string) *DeleteRateBasedRuleInput {
 	s.ChangeToken = &v
@@ -7374,6 +7628,21 @@ func (s *DeleteRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteRuleInput"}
+	if s.RuleId != nil && len(*s.RuleId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *DeleteRuleInput) SetChangeToken(v string) *DeleteRuleInput {
 	s.ChangeToken = &v
@@ -7460,6 +7729,21 @@ func (s *DeleteSizeConstraintSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteSizeConstraintSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSizeConstraintSetInput"}
+	if s.SizeConstraintSetId != nil && len(*s.SizeConstraintSetId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("SizeConstraintSetId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *DeleteSizeConstraintSetInput) SetChangeToken(v string) *DeleteSizeConstraintSetInput {
 	s.ChangeToken = &v
@@ -7547,6 +7831,21 @@ func (s *DeleteSqlInjectionMatchSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteSqlInjectionMatchSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteSqlInjectionMatchSetInput"}
+	if s.SqlInjectionMatchSetId != nil && len(*s.SqlInjectionMatchSetId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("SqlInjectionMatchSetId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *DeleteSqlInjectionMatchSetInput) SetChangeToken(v string) *DeleteSqlInjectionMatchSetInput {
 	s.ChangeToken = &v
@@ -7634,6 +7933,21 @@ func (s *DeleteWebACLInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteWebACLInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteWebACLInput"}
+	if s.WebACLId != nil && len(*s.WebACLId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("WebACLId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *DeleteWebACLInput) SetChangeToken(v string) *DeleteWebACLInput {
 	s.ChangeToken = &v
@@ -7721,6 +8035,21 @@ func (s *DeleteXssMatchSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteXssMatchSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteXssMatchSetInput"}
+	if s.XssMatchSetId != nil && len(*s.XssMatchSetId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("XssMatchSetId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *DeleteXssMatchSetInput) SetChangeToken(v string) *DeleteXssMatchSetInput {
 	s.ChangeToken = &v
@@ -7824,6 +8153,24 @@ func (s *FieldToMatch) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *FieldToMatch) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "FieldToMatch"}
+	if s.Type != nil {
+		values := []string{"URI", "QUERY_STRING", "HEADER", "METHOD", "BODY"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetData sets the Data field's value.
 func (s *FieldToMatch) SetData(v string) *FieldToMatch {
 	s.Data = &v
@@ -7873,6 +8220,21 @@ func (s *GetByteMatchSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetByteMatchSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetByteMatchSetInput"}
+	if s.ByteMatchSetId != nil && len(*s.ByteMatchSetId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ByteMatchSetId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetByteMatchSetId sets the ByteMatchSetId field's value.
 func (s *GetByteMatchSetInput) SetByteMatchSetId(v string) *GetByteMatchSetInput {
 	s.ByteMatchSetId = &v
@@ -8056,6 +8418,21 @@ func (s *GetIPSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetIPSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetIPSetInput"}
+	if s.IPSetId != nil && len(*s.IPSetId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("IPSetId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetIPSetId sets the IPSetId field's value.
 func (s *GetIPSetInput) SetIPSetId(v string) *GetIPSetInput {
 	s.IPSetId = &v
@@ -8129,6 +8506,21 @@ func (s *GetRateBasedRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetRateBasedRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetRateBasedRuleInput"}
+	if s.RuleId != nil && len(*s.RuleId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRuleId sets the RuleId field's value.
 func (s *GetRateBasedRuleInput) SetRuleId(v string) *GetRateBasedRuleInput {
 	s.RuleId = &v
@@ -8178,6 +8570,21 @@ func (s *GetRateBasedRuleManagedKeysInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetRateBasedRuleManagedKeysInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetRateBasedRuleManagedKeysInput"}
+	if s.RuleId != nil && len(*s.RuleId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextMarker sets the NextMarker field's value.
 func (s *GetRateBasedRuleManagedKeysInput) SetNextMarker(v string) *GetRateBasedRuleManagedKeysInput {
 	s.NextMarker = &v
@@ -8285,6 +8692,21 @@ func (s *GetRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetRuleInput"}
+	if s.RuleId != nil && len(*s.RuleId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRuleId sets the RuleId field's value.
 func (s *GetRuleInput) SetRuleId(v string) *GetRuleInput {
 	s.RuleId = &v
@@ -8405,11 +8827,32 @@ func (s *GetSampledRequestsInput) Validate() error {
 	return nil
 }
 
-// SetMaxItems sets the MaxItems field's value.
-func (s *GetSampledRequestsInput) SetMaxItems(v int64) *GetSampledRequestsInput {
-	s.MaxItems = &v
-	return s
-}
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetSampledRequestsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetSampledRequestsInput"}
+	if s.MaxItems != nil && *s.MaxItems > 500 {
+		invalidParams.Add(request.NewErrParamMaxValue("MaxItems", 500))
+	}
+	if s.RuleId != nil && len(*s.RuleId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleId", 128))
+	}
+	if s.WebAclId != nil && len(*s.WebAclId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("WebAclId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetMaxItems sets the MaxItems field's value.
+func (s *GetSampledRequestsInput) SetMaxItems(v int64) *GetSampledRequestsInput {
+	s.MaxItems = &v
+	return s
+}
 
 // SetRuleId sets the RuleId field's value.
 func (s *GetSampledRequestsInput) SetRuleId(v string) *GetSampledRequestsInput {
@@ -8514,6 +8957,21 @@ func (s *GetSizeConstraintSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetSizeConstraintSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetSizeConstraintSetInput"}
+	if s.SizeConstraintSetId != nil && len(*s.SizeConstraintSetId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("SizeConstraintSetId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSizeConstraintSetId sets the SizeConstraintSetId field's value.
 func (s *GetSizeConstraintSetInput) SetSizeConstraintSetId(v string) *GetSizeConstraintSetInput {
 	s.SizeConstraintSetId = &v
@@ -8592,6 +9050,21 @@ func (s *GetSqlInjectionMatchSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetSqlInjectionMatchSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetSqlInjectionMatchSetInput"}
+	if s.SqlInjectionMatchSetId != nil && len(*s.SqlInjectionMatchSetId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("SqlInjectionMatchSetId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetSqlInjectionMatchSetId sets the SqlInjectionMatchSetId field's value.
 func (s *GetSqlInjectionMatchSetInput) SetSqlInjectionMatchSetId(v string) *GetSqlInjectionMatchSetInput {
 	s.SqlInjectionMatchSetId = &v
@@ -8669,6 +9142,21 @@ func (s *GetWebACLInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetWebACLInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetWebACLInput"}
+	if s.WebACLId != nil && len(*s.WebACLId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("WebACLId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetWebACLId sets the WebACLId field's value.
 func (s *GetWebACLInput) SetWebACLId(v string) *GetWebACLInput {
 	s.WebACLId = &v
@@ -8748,6 +9236,21 @@ func (s *GetXssMatchSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetXssMatchSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetXssMatchSetInput"}
+	if s.XssMatchSetId != nil && len(*s.XssMatchSetId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("XssMatchSetId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetXssMatchSetId sets the XssMatchSetId field's value.
 func (s *GetXssMatchSetInput) SetXssMatchSetId(v string) *GetXssMatchSetInput {
 	s.XssMatchSetId = &v
@@ -9035,6 +9538,24 @@ func (s *IPSetDescriptor) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *IPSetDescriptor) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "IPSetDescriptor"}
+	if s.Type != nil {
+		values := []string{"IPV4", "IPV6"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetType sets the Type field's value.
 func (s *IPSetDescriptor) SetType(v string) *IPSetDescriptor {
 	s.Type = &v
@@ -9135,6 +9656,29 @@ func (s *IPSetUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *IPSetUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "IPSetUpdate"}
+	if s.Action != nil {
+		values := []string{"INSERT", "DELETE"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+	if s.IPSetDescriptor != nil {
+		if err := s.IPSetDescriptor.ValidateExtended(); err != nil {
+			invalidParams.AddNested("IPSetDescriptor", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *IPSetUpdate) SetAction(v string) *IPSetUpdate {
 	s.Action = &v
@@ -9188,6 +9732,21 @@ func (s *ListByteMatchSetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListByteMatchSetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListByteMatchSetsInput"}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListByteMatchSetsInput) SetLimit(v int64) *ListByteMatchSetsInput {
 	s.Limit = &v
@@ -9278,6 +9837,21 @@ func (s *ListIPSetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListIPSetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListIPSetsInput"}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListIPSetsInput) SetLimit(v int64) *ListIPSetsInput {
 	s.Limit = &v
@@ -9366,6 +9940,21 @@ func (s *ListRateBasedRulesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListRateBasedRulesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRateBasedRulesInput"}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListRateBasedRulesInput) SetLimit(v int64) *ListRateBasedRulesInput {
 	s.Limit = &v
@@ -9454,6 +10043,21 @@ func (s *ListRulesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListRulesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListRulesInput"}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListRulesInput) SetLimit(v int64) *ListRulesInput {
 	s.Limit = &v
@@ -9543,6 +10147,21 @@ func (s *ListSizeConstraintSetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListSizeConstraintSetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListSizeConstraintSetsInput"}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListSizeConstraintSetsInput) SetLimit(v int64) *ListSizeConstraintSetsInput {
 	s.Limit = &v
@@ -9635,6 +10254,21 @@ func (s *ListSqlInjectionMatchSetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListSqlInjectionMatchSetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListSqlInjectionMatchSetsInput"}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListSqlInjectionMatchSetsInput) SetLimit(v int64) *ListSqlInjectionMatchSetsInput {
 	s.Limit = &v
@@ -9727,6 +10361,21 @@ func (s *ListWebACLsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListWebACLsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListWebACLsInput"}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListWebACLsInput) SetLimit(v int64) *ListWebACLsInput {
 	s.Limit = &v
@@ -9817,6 +10466,21 @@ func (s *ListXssMatchSetsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListXssMatchSetsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListXssMatchSetsInput"}
+	if s.Limit != nil && *s.Limit > 100 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 100))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *ListXssMatchSetsInput) SetLimit(v int64) *ListXssMatchSetsInput {
 	s.Limit = &v
@@ -9934,6 +10598,27 @@ func (s *Predicate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Predicate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Predicate"}
+	if s.DataId != nil && len(*s.DataId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DataId", 128))
+	}
+	if s.Type != nil {
+		values := []string{"IPMatch", "ByteMatch", "SqlInjectionMatch", "SizeConstraint", "XssMatch"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDataId sets the DataId field's value.
 func (s *Predicate) SetDataId(v string) *Predicate {
 	s.DataId = &v
@@ -10229,6 +10914,29 @@ func (s *RuleUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RuleUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RuleUpdate"}
+	if s.Action != nil {
+		values := []string{"INSERT", "DELETE"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+	if s.Predicate != nil {
+		if err := s.Predicate.ValidateExtended(); err != nil {
+			invalidParams.AddNested("Predicate", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *RuleUpdate) SetAction(v string) *RuleUpdate {
 	s.Action = &v
@@ -10468,6 +11176,38 @@ func (s *SizeConstraint) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SizeConstraint) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SizeConstraint"}
+	if s.ComparisonOperator != nil {
+		values := []string{"EQ", "NE", "LE", "LT", "GE", "GT"}
+		if !request.IsParamEnumValue(*s.ComparisonOperator, values) {
+			invalidParams.Add(request.NewErrParamEnum("ComparisonOperator", *s.ComparisonOperator, values))
+		}
+	}
+	if s.Size != nil && *s.Size > 2.147483648e+10 {
+		invalidParams.Add(request.NewErrParamMaxValue("Size", 2.147483648e+10))
+	}
+	if s.TextTransformation != nil {
+		values := []string{"NONE", "COMPRESS_WHITE_SPACE", "HTML_ENTITY_DECODE", "LOWERCASE", "CMD_LINE", "URL_DECODE"}
+		if !request.IsParamEnumValue(*s.TextTransformation, values) {
+			invalidParams.Add(request.NewErrParamEnum("TextTransformation", *s.TextTransformation, values))
+		}
+	}
+	if s.FieldToMatch != nil {
+		if err := s.FieldToMatch.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FieldToMatch", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetComparisonOperator sets the ComparisonOperator field's value.
 func (s *SizeConstraint) SetComparisonOperator(v string) *SizeConstraint {
 	s.ComparisonOperator = &v
@@ -10645,6 +11385,29 @@ func (s *SizeConstraintSetUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SizeConstraintSetUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SizeConstraintSetUpdate"}
+	if s.Action != nil {
+		values := []string{"INSERT", "DELETE"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+	if s.SizeConstraint != nil {
+		if err := s.SizeConstraint.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SizeConstraint", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *SizeConstraintSetUpdate) SetAction(v string) *SizeConstraintSetUpdate {
 	s.Action = &v
@@ -10812,6 +11575,29 @@ func (s *SqlInjectionMatchSetUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SqlInjectionMatchSetUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SqlInjectionMatchSetUpdate"}
+	if s.Action != nil {
+		values := []string{"INSERT", "DELETE"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+	if s.SqlInjectionMatchTuple != nil {
+		if err := s.SqlInjectionMatchTuple.ValidateExtended(); err != nil {
+			invalidParams.AddNested("SqlInjectionMatchTuple", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *SqlInjectionMatchSetUpdate) SetAction(v string) *SqlInjectionMatchSetUpdate {
 	s.Action = &v
@@ -10942,6 +11728,29 @@ func (s *SqlInjectionMatchTuple) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SqlInjectionMatchTuple) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SqlInjectionMatchTuple"}
+	if s.TextTransformation != nil {
+		values := []string{"NONE", "COMPRESS_WHITE_SPACE", "HTML_ENTITY_DECODE", "LOWERCASE", "CMD_LINE", "URL_DECODE"}
+		if !request.IsParamEnumValue(*s.TextTransformation, values) {
+			invalidParams.Add(request.NewErrParamEnum("TextTransformation", *s.TextTransformation, values))
+		}
+	}
+	if s.FieldToMatch != nil {
+		if err := s.FieldToMatch.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FieldToMatch", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFieldToMatch sets the FieldToMatch field's value.
 func (s *SqlInjectionMatchTuple) SetFieldToMatch(v *FieldToMatch) *SqlInjectionMatchTuple {
 	s.FieldToMatch = v
@@ -11097,6 +11906,31 @@ func (s *UpdateByteMatchSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateByteMatchSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateByteMatchSetInput"}
+	if s.ByteMatchSetId != nil && len(*s.ByteMatchSetId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ByteMatchSetId", 128))
+	}
+	if s.Updates != nil {
+		for i, v := range s.Updates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Updates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetByteMatchSetId sets the ByteMatchSetId field's value.
 func (s *UpdateByteMatchSetInput) SetByteMatchSetId(v string) *UpdateByteMatchSetInput {
 	s.ByteMatchSetId = &v
@@ -11212,6 +12046,31 @@ func (s *UpdateIPSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateIPSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateIPSetInput"}
+	if s.IPSetId != nil && len(*s.IPSetId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("IPSetId", 128))
+	}
+	if s.Updates != nil {
+		for i, v := range s.Updates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Updates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *UpdateIPSetInput) SetChangeToken(v string) *UpdateIPSetInput {
 	s.ChangeToken = &v
@@ -11338,6 +12197,31 @@ func (s *UpdateRateBasedRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateRateBasedRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateRateBasedRuleInput"}
+	if s.RuleId != nil && len(*s.RuleId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleId", 128))
+	}
+	if s.Updates != nil {
+		for i, v := range s.Updates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Updates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *UpdateRateBasedRuleInput) SetChangeToken(v string) *UpdateRateBasedRuleInput {
 	s.ChangeToken = &v
@@ -11461,6 +12345,31 @@ func (s *UpdateRuleInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateRuleInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateRuleInput"}
+	if s.RuleId != nil && len(*s.RuleId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("RuleId", 128))
+	}
+	if s.Updates != nil {
+		for i, v := range s.Updates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Updates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *UpdateRuleInput) SetChangeToken(v string) *UpdateRuleInput {
 	s.ChangeToken = &v
@@ -11580,6 +12489,31 @@ func (s *UpdateSizeConstraintSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateSizeConstraintSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateSizeConstraintSetInput"}
+	if s.SizeConstraintSetId != nil && len(*s.SizeConstraintSetId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("SizeConstraintSetId", 128))
+	}
+	if s.Updates != nil {
+		for i, v := range s.Updates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Updates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *UpdateSizeConstraintSetInput) SetChangeToken(v string) *UpdateSizeConstraintSetInput {
 	s.ChangeToken = &v
@@ -11699,6 +12633,31 @@ func (s *UpdateSqlInjectionMatchSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateSqlInjectionMatchSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateSqlInjectionMatchSetInput"}
+	if s.SqlInjectionMatchSetId != nil && len(*s.SqlInjectionMatchSetId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("SqlInjectionMatchSetId", 128))
+	}
+	if s.Updates != nil {
+		for i, v := range s.Updates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Updates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *UpdateSqlInjectionMatchSetInput) SetChangeToken(v string) *UpdateSqlInjectionMatchSetInput {
 	s.ChangeToken = &v
@@ -11824,6 +12783,36 @@ func (s *UpdateWebACLInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateWebACLInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateWebACLInput"}
+	if s.WebACLId != nil && len(*s.WebACLId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("WebACLId", 128))
+	}
+	if s.DefaultAction != nil {
+		if err := s.DefaultAction.ValidateExtended(); err != nil {
+			invalidParams.AddNested("DefaultAction", err.(request.ErrInvalidParams))
+		}
+	}
+	if s.Updates != nil {
+		for i, v := range s.Updates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Updates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *UpdateWebACLInput) SetChangeToken(v string) *UpdateWebACLInput {
 	s.ChangeToken = &v
@@ -11948,6 +12937,31 @@ func (s *UpdateXssMatchSetInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateXssMatchSetInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateXssMatchSetInput"}
+	if s.XssMatchSetId != nil && len(*s.XssMatchSetId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("XssMatchSetId", 128))
+	}
+	if s.Updates != nil {
+		for i, v := range s.Updates {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Updates", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetChangeToken sets the ChangeToken field's value.
 func (s *UpdateXssMatchSetInput) SetChangeToken(v string) *UpdateXssMatchSetInput {
 	s.ChangeToken = &v
@@ -12041,6 +13055,24 @@ func (s *WafAction) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *WafAction) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "WafAction"}
+	if s.Type != nil {
+		values := []string{"BLOCK", "ALLOW", "COUNT"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetType sets the Type field's value.
 func (s *WafAction) SetType(v string) *WafAction {
 	s.Type = &v
@@ -12223,6 +13255,29 @@ func (s *WebACLUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *WebACLUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "WebACLUpdate"}
+	if s.Action != nil {
+		values := []string{"INSERT", "DELETE"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+	if s.ActivatedRule != nil {
+		if err := s.ActivatedRule.ValidateExtended(); err != nil {
+			invalidParams.AddNested("ActivatedRule", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *WebACLUpdate) SetAction(v string) *WebACLUpdate {
 	s.Action = &v
@@ -12388,6 +13443,29 @@ func (s *XssMatchSetUpdate) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *XssMatchSetUpdate) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "XssMatchSetUpdate"}
+	if s.Action != nil {
+		values := []string{"INSERT", "DELETE"}
+		if !request.IsParamEnumValue(*s.Action, values) {
+			invalidParams.Add(request.NewErrParamEnum("Action", *s.Action, values))
+		}
+	}
+	if s.XssMatchTuple != nil {
+		if err := s.XssMatchTuple.ValidateExtended(); err != nil {
+			invalidParams.AddNested("XssMatchTuple", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAction sets the Action field's value.
 func (s *XssMatchSetUpdate) SetAction(v string) *XssMatchSetUpdate {
 	s.Action = &v
@@ -12518,6 +13596,29 @@ func (s *XssMatchTuple) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *XssMatchTuple) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "XssMatchTuple"}
+	if s.TextTransformation != nil {
+		values := []string{"NONE", "COMPRESS_WHITE_SPACE", "HTML_ENTITY_DECODE", "LOWERCASE", "CMD_LINE", "URL_DECODE"}
+		if !request.IsParamEnumValue(*s.TextTransformation, values) {
+			invalidParams.Add(request.NewErrParamEnum("TextTransformation", *s.TextTransformation, values))
+		}
+	}
+	if s.FieldToMatch != nil {
+		if err := s.FieldToMatch.ValidateExtended(); err != nil {
+			invalidParams.AddNested("FieldToMatch", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetFieldToMatch sets the FieldToMatch field's value.
 func (s *XssMatchTuple) SetFieldToMatch(v *FieldToMatch) *XssMatchTuple {
 	s.FieldToMatch = v
@@ -6150,6 +6150,24 @@ func (s *AssociateWebACLInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AssociateWebACLInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AssociateWebACLInput"}
+	if s.ResourceArn != nil && len(*s.ResourceArn) > 1224 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceArn", 1224))
+	}
+	if s.WebACLId != nil && len(*s.WebACLId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("WebACLId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceArn sets the ResourceArn field's value.
 func (s *AssociateWebACLInput) SetResourceArn(v string) *AssociateWebACLInput {
 	s.ResourceArn = &v
@@ -6214,6 +6232,21 @@ func (s *DisassociateWebACLInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DisassociateWebACLInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DisassociateWebACLInput"}
+	if s.ResourceArn != nil && len(*s.ResourceArn) > 1224 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceArn", 1224))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceArn sets the ResourceArn field's value.
 func (s *DisassociateWebACLInput) SetResourceArn(v string) *DisassociateWebACLInput {
 	s.ResourceArn = &v
@@ -6271,6 +6304,21 @@ func (s *GetWebACLForResourceInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetWebACLForResourceInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetWebACLForResourceInput"}
+	if s.ResourceArn != nil && len(*s.ResourceArn) > 1224 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceArn", 1224))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceArn sets the ResourceArn field's value.
 func (s *GetWebACLForResourceInput) SetResourceArn(v string) *GetWebACLForResourceInput {
 	s.ResourceArn = &v
@@ -6339,6 +6387,21 @@ func (s *ListResourcesForWebACLInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ListResourcesForWebACLInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ListResourcesForWebACLInput"}
+	if s.WebACLId != nil && len(*s.WebACLId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("WebACLId", 128))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetWebACLId sets the WebACLId field's value.
 func (s *ListResourcesForWebACLInput) SetWebACLId(v string) *ListResourcesForWebACLInput {
 	s.WebACLId = &v
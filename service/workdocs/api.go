@@ -234,9 +234,10 @@ const opAddResourcePermissions = "AddResourcePermissions"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/workdocs-2016-05-01/AddResourcePermissions
 func (c *WorkDocs) AddResourcePermissionsRequest(input *AddResourcePermissionsInput) (req *request.Request, output *AddResourcePermissionsOutput) {
 	op := &request.Operation{
-		Name:       opAddResourcePermissions,
-		HTTPMethod: "POST",
-		HTTPPath:   "/api/v1/resources/{ResourceId}/permissions",
+		Name:                 opAddResourcePermissions,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/api/v1/resources/{ResourceId}/permissions",
+		SensitiveOutputPaths: []string{"ShareResults[*].StatusMessage"},
 	}
 
 	if input == nil {
@@ -325,9 +326,10 @@ const opCreateComment = "CreateComment"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/workdocs-2016-05-01/CreateComment
 func (c *WorkDocs) CreateCommentRequest(input *CreateCommentInput) (req *request.Request, output *CreateCommentOutput) {
 	op := &request.Operation{
-		Name:       opCreateComment,
-		HTTPMethod: "POST",
-		HTTPPath:   "/api/v1/documents/{DocumentId}/versions/{VersionId}/comment",
+		Name:                 opCreateComment,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/api/v1/documents/{DocumentId}/versions/{VersionId}/comment",
+		SensitiveOutputPaths: []string{"Comment.Text"},
 	}
 
 	if input == nil {
@@ -1870,9 +1872,10 @@ const opDescribeComments = "DescribeComments"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/workdocs-2016-05-01/DescribeComments
 func (c *WorkDocs) DescribeCommentsRequest(input *DescribeCommentsInput) (req *request.Request, output *DescribeCommentsOutput) {
 	op := &request.Operation{
-		Name:       opDescribeComments,
-		HTTPMethod: "GET",
-		HTTPPath:   "/api/v1/documents/{DocumentId}/versions/{VersionId}/comments",
+		Name:                 opDescribeComments,
+		HTTPMethod:           "GET",
+		HTTPPath:             "/api/v1/documents/{DocumentId}/versions/{VersionId}/comments",
+		SensitiveOutputPaths: []string{"Comments[*].Text"},
 	}
 
 	if input == nil {
@@ -1975,6 +1978,7 @@ func (c *WorkDocs) DescribeDocumentVersionsRequest(input *DescribeDocumentVersio
 			LimitToken:      "Limit",
 			TruncationToken: "",
 		},
+		SensitiveOutputPaths: []string{"DocumentVersions[*].Source.*", "DocumentVersions[*].Thumbnail.*"},
 	}
 
 	if input == nil {
@@ -2132,6 +2136,7 @@ func (c *WorkDocs) DescribeFolderContentsRequest(input *DescribeFolderContentsIn
 			LimitToken:      "Limit",
 			TruncationToken: "",
 		},
+		SensitiveOutputPaths: []string{"Documents[*].LatestVersionMetadata.Source.*", "Documents[*].LatestVersionMetadata.Thumbnail.*"},
 	}
 
 	if input == nil {
@@ -2800,9 +2805,10 @@ const opGetDocument = "GetDocument"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/workdocs-2016-05-01/GetDocument
 func (c *WorkDocs) GetDocumentRequest(input *GetDocumentInput) (req *request.Request, output *GetDocumentOutput) {
 	op := &request.Operation{
-		Name:       opGetDocument,
-		HTTPMethod: "GET",
-		HTTPPath:   "/api/v1/documents/{DocumentId}",
+		Name:                 opGetDocument,
+		HTTPMethod:           "GET",
+		HTTPPath:             "/api/v1/documents/{DocumentId}",
+		SensitiveOutputPaths: []string{"Metadata.LatestVersionMetadata.Source.*", "Metadata.LatestVersionMetadata.Thumbnail.*"},
 	}
 
 	if input == nil {
@@ -2995,9 +3001,10 @@ const opGetDocumentVersion = "GetDocumentVersion"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/workdocs-2016-05-01/GetDocumentVersion
 func (c *WorkDocs) GetDocumentVersionRequest(input *GetDocumentVersionInput) (req *request.Request, output *GetDocumentVersionOutput) {
 	op := &request.Operation{
-		Name:       opGetDocumentVersion,
-		HTTPMethod: "GET",
-		HTTPPath:   "/api/v1/documents/{DocumentId}/versions/{VersionId}",
+		Name:                 opGetDocumentVersion,
+		HTTPMethod:           "GET",
+		HTTPPath:             "/api/v1/documents/{DocumentId}/versions/{VersionId}",
+		SensitiveOutputPaths: []string{"Metadata.Source.*", "Metadata.Thumbnail.*"},
 	}
 
 	if input == nil {
@@ -3289,9 +3296,10 @@ const opInitiateDocumentVersionUpload = "InitiateDocumentVersionUpload"
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/workdocs-2016-05-01/InitiateDocumentVersionUpload
 func (c *WorkDocs) InitiateDocumentVersionUploadRequest(input *InitiateDocumentVersionUploadInput) (req *request.Request, output *InitiateDocumentVersionUploadOutput) {
 	op := &request.Operation{
-		Name:       opInitiateDocumentVersionUpload,
-		HTTPMethod: "POST",
-		HTTPPath:   "/api/v1/documents",
+		Name:                 opInitiateDocumentVersionUpload,
+		HTTPMethod:           "POST",
+		HTTPPath:             "/api/v1/documents",
+		SensitiveOutputPaths: []string{"Metadata.LatestVersionMetadata.Source.*", "Metadata.LatestVersionMetadata.Thumbnail.*", "UploadMetadata.UploadUrl"},
 	}
 
 	if input == nil {
@@ -3994,7 +4002,7 @@ type AbortDocumentVersionUploadInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the document.
 	//
@@ -4042,6 +4050,33 @@ func (s *AbortDocumentVersionUploadInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AbortDocumentVersionUploadInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AbortDocumentVersionUploadInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.DocumentId != nil && len(*s.DocumentId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DocumentId", 128))
+	}
+	if s.DocumentId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.DocumentId) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentId", "[\\w+-.@]+"))
+	}
+	if s.VersionId != nil && len(*s.VersionId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("VersionId", 128))
+	}
+	if s.VersionId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.VersionId) {
+		invalidParams.Add(request.NewErrParamPattern("VersionId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *AbortDocumentVersionUploadInput) SetAuthenticationToken(v string) *AbortDocumentVersionUploadInput {
 	s.AuthenticationToken = &v
@@ -4065,7 +4100,7 @@ func (s *AbortDocumentVersionUploadInput) MarshalFields(e protocol.FieldEncoder)
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.DocumentId != nil {
 		v := *s.DocumentId
@@ -4108,7 +4143,7 @@ type ActivateUserInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the user.
 	//
@@ -4145,6 +4180,27 @@ func (s *ActivateUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ActivateUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ActivateUserInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.UserId != nil && len(*s.UserId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserId", 256))
+	}
+	if s.UserId != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.UserId) {
+		invalidParams.Add(request.NewErrParamPattern("UserId", "[&\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *ActivateUserInput) SetAuthenticationToken(v string) *ActivateUserInput {
 	s.AuthenticationToken = &v
@@ -4162,7 +4218,7 @@ func (s *ActivateUserInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.UserId != nil {
 		v := *s.UserId
@@ -4360,7 +4416,7 @@ type AddResourcePermissionsInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The users, groups, or organization being granted permission.
 	//
@@ -4415,6 +4471,37 @@ func (s *AddResourcePermissionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *AddResourcePermissionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "AddResourcePermissionsInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.ResourceId != nil && len(*s.ResourceId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 128))
+	}
+	if s.ResourceId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "[\\w+-.@]+"))
+	}
+	if s.Principals != nil {
+		for i, v := range s.Principals {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Principals", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *AddResourcePermissionsInput) SetAuthenticationToken(v string) *AddResourcePermissionsInput {
 	s.AuthenticationToken = &v
@@ -4438,7 +4525,7 @@ func (s *AddResourcePermissionsInput) MarshalFields(e protocol.FieldEncoder) err
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if len(s.Principals) > 0 {
 		v := s.Principals
@@ -4516,7 +4603,7 @@ type Comment struct {
 	Status *string `type:"string" enum:"CommentStatusType"`
 
 	// The text of the comment.
-	Text *string `min:"1" type:"string"`
+	Text *string `min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the root comment in the thread.
 	ThreadId *string `min:"1" type:"string"`
@@ -4626,7 +4713,7 @@ func (s *Comment) MarshalFields(e protocol.FieldEncoder) error {
 	if s.Text != nil {
 		v := *s.Text
 
-		e.SetValue(protocol.BodyTarget, "Text", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "Text", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ThreadId != nil {
 		v := *s.ThreadId
@@ -4746,7 +4833,7 @@ type CreateCommentInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the document.
 	//
@@ -4763,7 +4850,7 @@ type CreateCommentInput struct {
 	// The text of the comment.
 	//
 	// Text is a required field
-	Text *string `min:"1" type:"string" required:"true"`
+	Text *string `min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The ID of the root comment in the thread.
 	ThreadId *string `min:"1" type:"string"`
@@ -4826,6 +4913,54 @@ func (s *CreateCommentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateCommentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateCommentInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.DocumentId != nil && len(*s.DocumentId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DocumentId", 128))
+	}
+	if s.DocumentId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.DocumentId) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentId", "[\\w+-.@]+"))
+	}
+	if s.ParentId != nil && len(*s.ParentId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ParentId", 128))
+	}
+	if s.ParentId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.ParentId) {
+		invalidParams.Add(request.NewErrParamPattern("ParentId", "[\\w+-.@]+"))
+	}
+	if s.Text != nil && len(*s.Text) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Text", 2048))
+	}
+	if s.ThreadId != nil && len(*s.ThreadId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ThreadId", 128))
+	}
+	if s.ThreadId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.ThreadId) {
+		invalidParams.Add(request.NewErrParamPattern("ThreadId", "[\\w+-.@]+"))
+	}
+	if s.VersionId != nil && len(*s.VersionId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("VersionId", 128))
+	}
+	if s.VersionId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.VersionId) {
+		invalidParams.Add(request.NewErrParamPattern("VersionId", "[\\w+-.@]+"))
+	}
+	if s.Visibility != nil {
+		values := []string{"PUBLIC", "PRIVATE"}
+		if !request.IsParamEnumValue(*s.Visibility, values) {
+			invalidParams.Add(request.NewErrParamEnum("Visibility", *s.Visibility, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *CreateCommentInput) SetAuthenticationToken(v string) *CreateCommentInput {
 	s.AuthenticationToken = &v
@@ -4879,7 +5014,7 @@ func (s *CreateCommentInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.DocumentId != nil {
 		v := *s.DocumentId
@@ -4899,7 +5034,7 @@ func (s *CreateCommentInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.Text != nil {
 		v := *s.Text
 
-		e.SetValue(protocol.BodyTarget, "Text", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "Text", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ThreadId != nil {
 		v := *s.ThreadId
@@ -4961,7 +5096,7 @@ type CreateCustomMetadataInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// Custom metadata in the form of name-value pairs.
 	//
@@ -5016,6 +5151,36 @@ func (s *CreateCustomMetadataInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateCustomMetadataInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateCustomMetadataInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.CustomMetadata != nil && len(s.CustomMetadata) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("CustomMetadata", 8))
+	}
+	if s.ResourceId != nil && len(*s.ResourceId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 128))
+	}
+	if s.ResourceId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "[\\w+-.@]+"))
+	}
+	if s.VersionId != nil && len(*s.VersionId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("VersionId", 128))
+	}
+	if s.VersionId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.VersionId) {
+		invalidParams.Add(request.NewErrParamPattern("VersionId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *CreateCustomMetadataInput) SetAuthenticationToken(v string) *CreateCustomMetadataInput {
 	s.AuthenticationToken = &v
@@ -5045,7 +5210,7 @@ func (s *CreateCustomMetadataInput) MarshalFields(e protocol.FieldEncoder) error
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if len(s.CustomMetadata) > 0 {
 		v := s.CustomMetadata
@@ -5093,7 +5258,7 @@ type CreateFolderInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The name of the new folder.
 	Name *string `min:"1" type:"string"`
@@ -5136,6 +5301,30 @@ func (s *CreateFolderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateFolderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateFolderInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.ParentFolderId != nil && len(*s.ParentFolderId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ParentFolderId", 128))
+	}
+	if s.ParentFolderId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.ParentFolderId) {
+		invalidParams.Add(request.NewErrParamPattern("ParentFolderId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *CreateFolderInput) SetAuthenticationToken(v string) *CreateFolderInput {
 	s.AuthenticationToken = &v
@@ -5159,7 +5348,7 @@ func (s *CreateFolderInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.Name != nil {
 		v := *s.Name
@@ -5216,7 +5405,7 @@ type CreateLabelsInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// List of labels to add to the resource.
 	//
@@ -5261,6 +5450,30 @@ func (s *CreateLabelsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateLabelsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateLabelsInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.Labels != nil && len(s.Labels) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("Labels", 20))
+	}
+	if s.ResourceId != nil && len(*s.ResourceId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 128))
+	}
+	if s.ResourceId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *CreateLabelsInput) SetAuthenticationToken(v string) *CreateLabelsInput {
 	s.AuthenticationToken = &v
@@ -5284,7 +5497,7 @@ func (s *CreateLabelsInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if len(s.Labels) > 0 {
 		v := s.Labels
@@ -5386,6 +5599,39 @@ func (s *CreateNotificationSubscriptionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateNotificationSubscriptionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateNotificationSubscriptionInput"}
+	if s.Endpoint != nil && len(*s.Endpoint) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Endpoint", 256))
+	}
+	if s.OrganizationId != nil && len(*s.OrganizationId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("OrganizationId", 256))
+	}
+	if s.OrganizationId != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.OrganizationId) {
+		invalidParams.Add(request.NewErrParamPattern("OrganizationId", "[&\\w+-.@]+"))
+	}
+	if s.Protocol != nil {
+		values := []string{"HTTPS"}
+		if !request.IsParamEnumValue(*s.Protocol, values) {
+			invalidParams.Add(request.NewErrParamEnum("Protocol", *s.Protocol, values))
+		}
+	}
+	if s.SubscriptionType != nil {
+		values := []string{"ALL"}
+		if !request.IsParamEnumValue(*s.SubscriptionType, values) {
+			invalidParams.Add(request.NewErrParamEnum("SubscriptionType", *s.SubscriptionType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEndpoint sets the Endpoint field's value.
 func (s *CreateNotificationSubscriptionInput) SetEndpoint(v string) *CreateNotificationSubscriptionInput {
 	s.Endpoint = &v
@@ -5477,7 +5723,7 @@ type CreateUserInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The email address of the user.
 	EmailAddress *string `min:"1" type:"string"`
@@ -5493,7 +5739,7 @@ type CreateUserInput struct {
 	// The password of the user.
 	//
 	// Password is a required field
-	Password *string `min:"4" type:"string" required:"true"`
+	Password *string `min:"4" type:"string" required:"true" sensitive:"true"`
 
 	// The amount of storage for the user.
 	StorageRule *StorageRuleType `type:"structure"`
@@ -5568,6 +5814,56 @@ func (s *CreateUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateUserInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.EmailAddress != nil && len(*s.EmailAddress) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("EmailAddress", 256))
+	}
+	if s.EmailAddress != nil && !request.MatchParamPattern("[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\\.[a-zA-Z]{2,}", *s.EmailAddress) {
+		invalidParams.Add(request.NewErrParamPattern("EmailAddress", "[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\\.[a-zA-Z]{2,}"))
+	}
+	if s.GivenName != nil && len(*s.GivenName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("GivenName", 64))
+	}
+	if s.OrganizationId != nil && len(*s.OrganizationId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("OrganizationId", 256))
+	}
+	if s.OrganizationId != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.OrganizationId) {
+		invalidParams.Add(request.NewErrParamPattern("OrganizationId", "[&\\w+-.@]+"))
+	}
+	if s.Password != nil && len(*s.Password) > 32 {
+		invalidParams.Add(request.NewErrParamMaxLen("Password", 32))
+	}
+	if s.Surname != nil && len(*s.Surname) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Surname", 64))
+	}
+	if s.TimeZoneId != nil && len(*s.TimeZoneId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("TimeZoneId", 256))
+	}
+	if s.Username != nil && len(*s.Username) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Username", 256))
+	}
+	if s.Username != nil && !request.MatchParamPattern("[\\w\\-+.]+(@[a-zA-Z0-9.\\-]+\\.[a-zA-Z]+)?", *s.Username) {
+		invalidParams.Add(request.NewErrParamPattern("Username", "[\\w\\-+.]+(@[a-zA-Z0-9.\\-]+\\.[a-zA-Z]+)?"))
+	}
+	if s.StorageRule != nil {
+		if err := s.StorageRule.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StorageRule", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *CreateUserInput) SetAuthenticationToken(v string) *CreateUserInput {
 	s.AuthenticationToken = &v
@@ -5627,7 +5923,7 @@ func (s *CreateUserInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.EmailAddress != nil {
 		v := *s.EmailAddress
@@ -5647,7 +5943,7 @@ func (s *CreateUserInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.Password != nil {
 		v := *s.Password
 
-		e.SetValue(protocol.BodyTarget, "Password", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "Password", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.StorageRule != nil {
 		v := s.StorageRule
@@ -5714,7 +6010,7 @@ type DeactivateUserInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the user.
 	//
@@ -5751,6 +6047,27 @@ func (s *DeactivateUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeactivateUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeactivateUserInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.UserId != nil && len(*s.UserId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserId", 256))
+	}
+	if s.UserId != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.UserId) {
+		invalidParams.Add(request.NewErrParamPattern("UserId", "[&\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *DeactivateUserInput) SetAuthenticationToken(v string) *DeactivateUserInput {
 	s.AuthenticationToken = &v
@@ -5768,7 +6085,7 @@ func (s *DeactivateUserInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.UserId != nil {
 		v := *s.UserId
@@ -5806,7 +6123,7 @@ type DeleteCommentInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the comment.
 	//
@@ -5865,6 +6182,39 @@ func (s *DeleteCommentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteCommentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteCommentInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.CommentId != nil && len(*s.CommentId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("CommentId", 128))
+	}
+	if s.CommentId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.CommentId) {
+		invalidParams.Add(request.NewErrParamPattern("CommentId", "[\\w+-.@]+"))
+	}
+	if s.DocumentId != nil && len(*s.DocumentId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DocumentId", 128))
+	}
+	if s.DocumentId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.DocumentId) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentId", "[\\w+-.@]+"))
+	}
+	if s.VersionId != nil && len(*s.VersionId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("VersionId", 128))
+	}
+	if s.VersionId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.VersionId) {
+		invalidParams.Add(request.NewErrParamPattern("VersionId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *DeleteCommentInput) SetAuthenticationToken(v string) *DeleteCommentInput {
 	s.AuthenticationToken = &v
@@ -5894,7 +6244,7 @@ func (s *DeleteCommentInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.CommentId != nil {
 		v := *s.CommentId
@@ -5942,7 +6292,7 @@ type DeleteCustomMetadataInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// Flag to indicate removal of all custom metadata properties from the specified
 	// resource.
@@ -5993,6 +6343,36 @@ func (s *DeleteCustomMetadataInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteCustomMetadataInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteCustomMetadataInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.Keys != nil && len(s.Keys) > 8 {
+		invalidParams.Add(request.NewErrParamMaxLen("Keys", 8))
+	}
+	if s.ResourceId != nil && len(*s.ResourceId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 128))
+	}
+	if s.ResourceId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "[\\w+-.@]+"))
+	}
+	if s.VersionId != nil && len(*s.VersionId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("VersionId", 128))
+	}
+	if s.VersionId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.VersionId) {
+		invalidParams.Add(request.NewErrParamPattern("VersionId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *DeleteCustomMetadataInput) SetAuthenticationToken(v string) *DeleteCustomMetadataInput {
 	s.AuthenticationToken = &v
@@ -6028,7 +6408,7 @@ func (s *DeleteCustomMetadataInput) MarshalFields(e protocol.FieldEncoder) error
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.DeleteAll != nil {
 		v := *s.DeleteAll
@@ -6081,7 +6461,7 @@ type DeleteDocumentInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the document.
 	//
@@ -6118,6 +6498,27 @@ func (s *DeleteDocumentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteDocumentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteDocumentInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.DocumentId != nil && len(*s.DocumentId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DocumentId", 128))
+	}
+	if s.DocumentId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.DocumentId) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *DeleteDocumentInput) SetAuthenticationToken(v string) *DeleteDocumentInput {
 	s.AuthenticationToken = &v
@@ -6135,7 +6536,7 @@ func (s *DeleteDocumentInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.DocumentId != nil {
 		v := *s.DocumentId
@@ -6173,7 +6574,7 @@ type DeleteFolderContentsInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the folder.
 	//
@@ -6210,6 +6611,27 @@ func (s *DeleteFolderContentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteFolderContentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteFolderContentsInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.FolderId != nil && len(*s.FolderId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("FolderId", 128))
+	}
+	if s.FolderId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.FolderId) {
+		invalidParams.Add(request.NewErrParamPattern("FolderId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *DeleteFolderContentsInput) SetAuthenticationToken(v string) *DeleteFolderContentsInput {
 	s.AuthenticationToken = &v
@@ -6227,7 +6649,7 @@ func (s *DeleteFolderContentsInput) MarshalFields(e protocol.FieldEncoder) error
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.FolderId != nil {
 		v := *s.FolderId
@@ -6265,7 +6687,7 @@ type DeleteFolderInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the folder.
 	//
@@ -6302,6 +6724,27 @@ func (s *DeleteFolderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteFolderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteFolderInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.FolderId != nil && len(*s.FolderId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("FolderId", 128))
+	}
+	if s.FolderId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.FolderId) {
+		invalidParams.Add(request.NewErrParamPattern("FolderId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *DeleteFolderInput) SetAuthenticationToken(v string) *DeleteFolderInput {
 	s.AuthenticationToken = &v
@@ -6319,7 +6762,7 @@ func (s *DeleteFolderInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.FolderId != nil {
 		v := *s.FolderId
@@ -6357,7 +6800,7 @@ type DeleteLabelsInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// Flag to request removal of all labels from the specified resource.
 	DeleteAll *bool `location:"querystring" locationName:"deleteAll" type:"boolean"`
@@ -6400,6 +6843,30 @@ func (s *DeleteLabelsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteLabelsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteLabelsInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.Labels != nil && len(s.Labels) > 20 {
+		invalidParams.Add(request.NewErrParamMaxLen("Labels", 20))
+	}
+	if s.ResourceId != nil && len(*s.ResourceId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 128))
+	}
+	if s.ResourceId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *DeleteLabelsInput) SetAuthenticationToken(v string) *DeleteLabelsInput {
 	s.AuthenticationToken = &v
@@ -6429,7 +6896,7 @@ func (s *DeleteLabelsInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.DeleteAll != nil {
 		v := *s.DeleteAll
@@ -6518,6 +6985,30 @@ func (s *DeleteNotificationSubscriptionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteNotificationSubscriptionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteNotificationSubscriptionInput"}
+	if s.OrganizationId != nil && len(*s.OrganizationId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("OrganizationId", 256))
+	}
+	if s.OrganizationId != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.OrganizationId) {
+		invalidParams.Add(request.NewErrParamPattern("OrganizationId", "[&\\w+-.@]+"))
+	}
+	if s.SubscriptionId != nil && len(*s.SubscriptionId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("SubscriptionId", 256))
+	}
+	if s.SubscriptionId != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.SubscriptionId) {
+		invalidParams.Add(request.NewErrParamPattern("SubscriptionId", "[&\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetOrganizationId sets the OrganizationId field's value.
 func (s *DeleteNotificationSubscriptionInput) SetOrganizationId(v string) *DeleteNotificationSubscriptionInput {
 	s.OrganizationId = &v
@@ -6573,7 +7064,7 @@ type DeleteUserInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the user.
 	//
@@ -6610,14 +7101,35 @@ func (s *DeleteUserInput) Validate() error {
 	return nil
 }
 
-// SetAuthenticationToken sets the AuthenticationToken field's value.
-func (s *DeleteUserInput) SetAuthenticationToken(v string) *DeleteUserInput {
-	s.AuthenticationToken = &v
-	return s
-}
-
-// SetUserId sets the UserId field's value.
-func (s *DeleteUserInput) SetUserId(v string) *DeleteUserInput {
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DeleteUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DeleteUserInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.UserId != nil && len(*s.UserId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserId", 256))
+	}
+	if s.UserId != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.UserId) {
+		invalidParams.Add(request.NewErrParamPattern("UserId", "[&\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+// SetAuthenticationToken sets the AuthenticationToken field's value.
+func (s *DeleteUserInput) SetAuthenticationToken(v string) *DeleteUserInput {
+	s.AuthenticationToken = &v
+	return s
+}
+
+// SetUserId sets the UserId field's value.
+func (s *DeleteUserInput) SetUserId(v string) *DeleteUserInput {
 	s.UserId = &v
 	return s
 }
@@ -6627,7 +7139,7 @@ func (s *DeleteUserInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.UserId != nil {
 		v := *s.UserId
@@ -6665,7 +7177,7 @@ type DescribeActivitiesInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The timestamp that determines the end time of the activities; the response
 	// includes the activities performed before the specified timestamp.
@@ -6727,6 +7239,39 @@ func (s *DescribeActivitiesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeActivitiesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeActivitiesInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.Limit != nil && *s.Limit > 999 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 999))
+	}
+	if s.Marker != nil && len(*s.Marker) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 2048))
+	}
+	if s.OrganizationId != nil && len(*s.OrganizationId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("OrganizationId", 256))
+	}
+	if s.OrganizationId != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.OrganizationId) {
+		invalidParams.Add(request.NewErrParamPattern("OrganizationId", "[&\\w+-.@]+"))
+	}
+	if s.UserId != nil && len(*s.UserId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserId", 256))
+	}
+	if s.UserId != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.UserId) {
+		invalidParams.Add(request.NewErrParamPattern("UserId", "[&\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *DescribeActivitiesInput) SetAuthenticationToken(v string) *DescribeActivitiesInput {
 	s.AuthenticationToken = &v
@@ -6774,7 +7319,7 @@ func (s *DescribeActivitiesInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.EndTime != nil {
 		v := *s.EndTime
@@ -6865,7 +7410,7 @@ type DescribeCommentsInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the document.
 	//
@@ -6926,6 +7471,39 @@ func (s *DescribeCommentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeCommentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeCommentsInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.DocumentId != nil && len(*s.DocumentId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DocumentId", 128))
+	}
+	if s.DocumentId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.DocumentId) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentId", "[\\w+-.@]+"))
+	}
+	if s.Limit != nil && *s.Limit > 999 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 999))
+	}
+	if s.Marker != nil && len(*s.Marker) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 2048))
+	}
+	if s.VersionId != nil && len(*s.VersionId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("VersionId", 128))
+	}
+	if s.VersionId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.VersionId) {
+		invalidParams.Add(request.NewErrParamPattern("VersionId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *DescribeCommentsInput) SetAuthenticationToken(v string) *DescribeCommentsInput {
 	s.AuthenticationToken = &v
@@ -6961,7 +7539,7 @@ func (s *DescribeCommentsInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.DocumentId != nil {
 		v := *s.DocumentId
@@ -7043,7 +7621,7 @@ type DescribeDocumentVersionsInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the document.
 	//
@@ -7107,6 +7685,45 @@ func (s *DescribeDocumentVersionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeDocumentVersionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeDocumentVersionsInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.DocumentId != nil && len(*s.DocumentId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DocumentId", 128))
+	}
+	if s.DocumentId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.DocumentId) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentId", "[\\w+-.@]+"))
+	}
+	if s.Fields != nil && len(*s.Fields) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Fields", 256))
+	}
+	if s.Fields != nil && !request.MatchParamPattern("[\\w,]+", *s.Fields) {
+		invalidParams.Add(request.NewErrParamPattern("Fields", "[\\w,]+"))
+	}
+	if s.Include != nil && len(*s.Include) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Include", 256))
+	}
+	if s.Include != nil && !request.MatchParamPattern("[\\w,]+", *s.Include) {
+		invalidParams.Add(request.NewErrParamPattern("Include", "[\\w,]+"))
+	}
+	if s.Limit != nil && *s.Limit > 999 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 999))
+	}
+	if s.Marker != nil && len(*s.Marker) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *DescribeDocumentVersionsInput) SetAuthenticationToken(v string) *DescribeDocumentVersionsInput {
 	s.AuthenticationToken = &v
@@ -7148,7 +7765,7 @@ func (s *DescribeDocumentVersionsInput) MarshalFields(e protocol.FieldEncoder) e
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.DocumentId != nil {
 		v := *s.DocumentId
@@ -7235,7 +7852,7 @@ type DescribeFolderContentsInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the folder.
 	//
@@ -7300,6 +7917,57 @@ func (s *DescribeFolderContentsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeFolderContentsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeFolderContentsInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.FolderId != nil && len(*s.FolderId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("FolderId", 128))
+	}
+	if s.FolderId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.FolderId) {
+		invalidParams.Add(request.NewErrParamPattern("FolderId", "[\\w+-.@]+"))
+	}
+	if s.Include != nil && len(*s.Include) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Include", 256))
+	}
+	if s.Include != nil && !request.MatchParamPattern("[\\w,]+", *s.Include) {
+		invalidParams.Add(request.NewErrParamPattern("Include", "[\\w,]+"))
+	}
+	if s.Limit != nil && *s.Limit > 999 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 999))
+	}
+	if s.Marker != nil && len(*s.Marker) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 2048))
+	}
+	if s.Order != nil {
+		values := []string{"ASCENDING", "DESCENDING"}
+		if !request.IsParamEnumValue(*s.Order, values) {
+			invalidParams.Add(request.NewErrParamEnum("Order", *s.Order, values))
+		}
+	}
+	if s.Sort != nil {
+		values := []string{"DATE", "NAME"}
+		if !request.IsParamEnumValue(*s.Sort, values) {
+			invalidParams.Add(request.NewErrParamEnum("Sort", *s.Sort, values))
+		}
+	}
+	if s.Type != nil {
+		values := []string{"ALL", "DOCUMENT", "FOLDER"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *DescribeFolderContentsInput) SetAuthenticationToken(v string) *DescribeFolderContentsInput {
 	s.AuthenticationToken = &v
@@ -7353,7 +8021,7 @@ func (s *DescribeFolderContentsInput) MarshalFields(e protocol.FieldEncoder) err
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.FolderId != nil {
 		v := *s.FolderId
@@ -7507,6 +8175,30 @@ func (s *DescribeNotificationSubscriptionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeNotificationSubscriptionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeNotificationSubscriptionsInput"}
+	if s.Limit != nil && *s.Limit > 999 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 999))
+	}
+	if s.Marker != nil && len(*s.Marker) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 2048))
+	}
+	if s.OrganizationId != nil && len(*s.OrganizationId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("OrganizationId", 256))
+	}
+	if s.OrganizationId != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.OrganizationId) {
+		invalidParams.Add(request.NewErrParamPattern("OrganizationId", "[&\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetLimit sets the Limit field's value.
 func (s *DescribeNotificationSubscriptionsInput) SetLimit(v int64) *DescribeNotificationSubscriptionsInput {
 	s.Limit = &v
@@ -7602,7 +8294,7 @@ type DescribeResourcePermissionsInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The maximum number of items to return with this call.
 	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
@@ -7652,6 +8344,33 @@ func (s *DescribeResourcePermissionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeResourcePermissionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeResourcePermissionsInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.Limit != nil && *s.Limit > 999 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 999))
+	}
+	if s.Marker != nil && len(*s.Marker) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 2048))
+	}
+	if s.ResourceId != nil && len(*s.ResourceId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 128))
+	}
+	if s.ResourceId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *DescribeResourcePermissionsInput) SetAuthenticationToken(v string) *DescribeResourcePermissionsInput {
 	s.AuthenticationToken = &v
@@ -7681,7 +8400,7 @@ func (s *DescribeResourcePermissionsInput) MarshalFields(e protocol.FieldEncoder
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.Limit != nil {
 		v := *s.Limit
@@ -7760,7 +8479,7 @@ type DescribeRootFoldersInput struct {
 	// administrative API actions, as in accessing the API using AWS credentials.
 	//
 	// AuthenticationToken is a required field
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" required:"true"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" required:"true" sensitive:"true"`
 
 	// The maximum number of items to return.
 	Limit *int64 `location:"querystring" locationName:"limit" min:"1" type:"integer"`
@@ -7802,6 +8521,27 @@ func (s *DescribeRootFoldersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeRootFoldersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeRootFoldersInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.Limit != nil && *s.Limit > 999 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 999))
+	}
+	if s.Marker != nil && len(*s.Marker) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *DescribeRootFoldersInput) SetAuthenticationToken(v string) *DescribeRootFoldersInput {
 	s.AuthenticationToken = &v
@@ -7825,7 +8565,7 @@ func (s *DescribeRootFoldersInput) MarshalFields(e protocol.FieldEncoder) error
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.Limit != nil {
 		v := *s.Limit
@@ -7896,7 +8636,7 @@ type DescribeUsersInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// A comma-separated list of values. Specify "STORAGE_METADATA" to include the
 	// user storage quota and utilization information.
@@ -7919,7 +8659,7 @@ type DescribeUsersInput struct {
 	OrganizationId *string `location:"querystring" locationName:"organizationId" min:"1" type:"string"`
 
 	// A query to filter users by user name.
-	Query *string `location:"querystring" locationName:"query" min:"1" type:"string"`
+	Query *string `location:"querystring" locationName:"query" min:"1" type:"string" sensitive:"true"`
 
 	// The sorting criteria.
 	Sort *string `location:"querystring" locationName:"sort" type:"string" enum:"UserSortType"`
@@ -7969,6 +8709,66 @@ func (s *DescribeUsersInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeUsersInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeUsersInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.Fields != nil && len(*s.Fields) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Fields", 256))
+	}
+	if s.Fields != nil && !request.MatchParamPattern("[\\w,]+", *s.Fields) {
+		invalidParams.Add(request.NewErrParamPattern("Fields", "[\\w,]+"))
+	}
+	if s.Include != nil {
+		values := []string{"ALL", "ACTIVE_PENDING"}
+		if !request.IsParamEnumValue(*s.Include, values) {
+			invalidParams.Add(request.NewErrParamEnum("Include", *s.Include, values))
+		}
+	}
+	if s.Limit != nil && *s.Limit > 999 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 999))
+	}
+	if s.Marker != nil && len(*s.Marker) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 2048))
+	}
+	if s.Order != nil {
+		values := []string{"ASCENDING", "DESCENDING"}
+		if !request.IsParamEnumValue(*s.Order, values) {
+			invalidParams.Add(request.NewErrParamEnum("Order", *s.Order, values))
+		}
+	}
+	if s.OrganizationId != nil && len(*s.OrganizationId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("OrganizationId", 256))
+	}
+	if s.OrganizationId != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.OrganizationId) {
+		invalidParams.Add(request.NewErrParamPattern("OrganizationId", "[&\\w+-.@]+"))
+	}
+	if s.Query != nil && len(*s.Query) > 512 {
+		invalidParams.Add(request.NewErrParamMaxLen("Query", 512))
+	}
+	if s.Sort != nil {
+		values := []string{"USER_NAME", "FULL_NAME", "STORAGE_LIMIT", "USER_STATUS", "STORAGE_USED"}
+		if !request.IsParamEnumValue(*s.Sort, values) {
+			invalidParams.Add(request.NewErrParamEnum("Sort", *s.Sort, values))
+		}
+	}
+	if s.UserIds != nil && len(*s.UserIds) > 2000 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserIds", 2000))
+	}
+	if s.UserIds != nil && !request.MatchParamPattern("[&\\w+-.@, ]+", *s.UserIds) {
+		invalidParams.Add(request.NewErrParamPattern("UserIds", "[&\\w+-.@, ]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *DescribeUsersInput) SetAuthenticationToken(v string) *DescribeUsersInput {
 	s.AuthenticationToken = &v
@@ -8034,7 +8834,7 @@ func (s *DescribeUsersInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.Fields != nil {
 		v := *s.Fields
@@ -8069,7 +8869,7 @@ func (s *DescribeUsersInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.Query != nil {
 		v := *s.Query
 
-		e.SetValue(protocol.QueryTarget, "query", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.QueryTarget, "query", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.Sort != nil {
 		v := *s.Sort
@@ -8327,13 +9127,13 @@ type DocumentVersionMetadata struct {
 	Size *int64 `type:"long"`
 
 	// The source of the document.
-	Source map[string]*string `type:"map"`
+	Source map[string]*string `type:"map" sensitive:"true"`
 
 	// The status of the document.
 	Status *string `type:"string" enum:"DocumentStatusType"`
 
 	// The thumbnail of the document.
-	Thumbnail map[string]*string `type:"map"`
+	Thumbnail map[string]*string `type:"map" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -8479,7 +9279,7 @@ func (s *DocumentVersionMetadata) MarshalFields(e protocol.FieldEncoder) error {
 	if len(s.Source) > 0 {
 		v := s.Source
 
-		e.SetMap(protocol.BodyTarget, "Source", protocol.EncodeStringMap(v), protocol.Metadata{})
+		e.SetMap(protocol.BodyTarget, "Source", protocol.EncodeStringMap(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.Status != nil {
 		v := *s.Status
@@ -8489,7 +9289,7 @@ func (s *DocumentVersionMetadata) MarshalFields(e protocol.FieldEncoder) error {
 	if len(s.Thumbnail) > 0 {
 		v := s.Thumbnail
 
-		e.SetMap(protocol.BodyTarget, "Thumbnail", protocol.EncodeStringMap(v), protocol.Metadata{})
+		e.SetMap(protocol.BodyTarget, "Thumbnail", protocol.EncodeStringMap(v), protocol.Metadata{Sensitive: true})
 	}
 
 	return nil
@@ -8694,7 +9494,7 @@ type GetCurrentUserInput struct {
 	// Amazon WorkDocs authentication token.
 	//
 	// AuthenticationToken is a required field
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" required:"true"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" required:"true" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -8723,6 +9523,21 @@ func (s *GetCurrentUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetCurrentUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetCurrentUserInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *GetCurrentUserInput) SetAuthenticationToken(v string) *GetCurrentUserInput {
 	s.AuthenticationToken = &v
@@ -8734,7 +9549,7 @@ func (s *GetCurrentUserInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 
 	return nil
@@ -8781,7 +9596,7 @@ type GetDocumentInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the document.
 	//
@@ -8821,6 +9636,27 @@ func (s *GetDocumentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDocumentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDocumentInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.DocumentId != nil && len(*s.DocumentId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DocumentId", 128))
+	}
+	if s.DocumentId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.DocumentId) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *GetDocumentInput) SetAuthenticationToken(v string) *GetDocumentInput {
 	s.AuthenticationToken = &v
@@ -8844,7 +9680,7 @@ func (s *GetDocumentInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.DocumentId != nil {
 		v := *s.DocumentId
@@ -8915,7 +9751,7 @@ type GetDocumentPathInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the document.
 	//
@@ -8971,6 +9807,39 @@ func (s *GetDocumentPathInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDocumentPathInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDocumentPathInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.DocumentId != nil && len(*s.DocumentId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("DocumentId", 256))
+	}
+	if s.DocumentId != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.DocumentId) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentId", "[&\\w+-.@]+"))
+	}
+	if s.Fields != nil && len(*s.Fields) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Fields", 256))
+	}
+	if s.Fields != nil && !request.MatchParamPattern("[\\w,]+", *s.Fields) {
+		invalidParams.Add(request.NewErrParamPattern("Fields", "[\\w,]+"))
+	}
+	if s.Limit != nil && *s.Limit > 999 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 999))
+	}
+	if s.Marker != nil && len(*s.Marker) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *GetDocumentPathInput) SetAuthenticationToken(v string) *GetDocumentPathInput {
 	s.AuthenticationToken = &v
@@ -9006,7 +9875,7 @@ func (s *GetDocumentPathInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.DocumentId != nil {
 		v := *s.DocumentId
@@ -9073,7 +9942,7 @@ type GetDocumentVersionInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the document.
 	//
@@ -9131,6 +10000,39 @@ func (s *GetDocumentVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetDocumentVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetDocumentVersionInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.DocumentId != nil && len(*s.DocumentId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DocumentId", 128))
+	}
+	if s.DocumentId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.DocumentId) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentId", "[\\w+-.@]+"))
+	}
+	if s.Fields != nil && len(*s.Fields) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Fields", 256))
+	}
+	if s.Fields != nil && !request.MatchParamPattern("[\\w,]+", *s.Fields) {
+		invalidParams.Add(request.NewErrParamPattern("Fields", "[\\w,]+"))
+	}
+	if s.VersionId != nil && len(*s.VersionId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("VersionId", 128))
+	}
+	if s.VersionId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.VersionId) {
+		invalidParams.Add(request.NewErrParamPattern("VersionId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *GetDocumentVersionInput) SetAuthenticationToken(v string) *GetDocumentVersionInput {
 	s.AuthenticationToken = &v
@@ -9166,7 +10068,7 @@ func (s *GetDocumentVersionInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.DocumentId != nil {
 		v := *s.DocumentId
@@ -9247,7 +10149,7 @@ type GetFolderInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the folder.
 	//
@@ -9287,6 +10189,27 @@ func (s *GetFolderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetFolderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetFolderInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.FolderId != nil && len(*s.FolderId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("FolderId", 128))
+	}
+	if s.FolderId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.FolderId) {
+		invalidParams.Add(request.NewErrParamPattern("FolderId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *GetFolderInput) SetAuthenticationToken(v string) *GetFolderInput {
 	s.AuthenticationToken = &v
@@ -9310,7 +10233,7 @@ func (s *GetFolderInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.FolderId != nil {
 		v := *s.FolderId
@@ -9381,7 +10304,7 @@ type GetFolderPathInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// A comma-separated list of values. Specify "NAME" to include the names of
 	// the parent folders.
@@ -9437,6 +10360,39 @@ func (s *GetFolderPathInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetFolderPathInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetFolderPathInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.Fields != nil && len(*s.Fields) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Fields", 256))
+	}
+	if s.Fields != nil && !request.MatchParamPattern("[\\w,]+", *s.Fields) {
+		invalidParams.Add(request.NewErrParamPattern("Fields", "[\\w,]+"))
+	}
+	if s.FolderId != nil && len(*s.FolderId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("FolderId", 256))
+	}
+	if s.FolderId != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.FolderId) {
+		invalidParams.Add(request.NewErrParamPattern("FolderId", "[&\\w+-.@]+"))
+	}
+	if s.Limit != nil && *s.Limit > 999 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 999))
+	}
+	if s.Marker != nil && len(*s.Marker) > 2048 {
+		invalidParams.Add(request.NewErrParamMaxLen("Marker", 2048))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *GetFolderPathInput) SetAuthenticationToken(v string) *GetFolderPathInput {
 	s.AuthenticationToken = &v
@@ -9472,7 +10428,7 @@ func (s *GetFolderPathInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.Fields != nil {
 		v := *s.Fields
@@ -9597,7 +10553,7 @@ type InitiateDocumentVersionUploadInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The time stamp when the content of the document was originally created.
 	ContentCreatedTimestamp *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -9661,6 +10617,39 @@ func (s *InitiateDocumentVersionUploadInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *InitiateDocumentVersionUploadInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "InitiateDocumentVersionUploadInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.ContentType != nil && len(*s.ContentType) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ContentType", 128))
+	}
+	if s.Id != nil && len(*s.Id) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 128))
+	}
+	if s.Id != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "[\\w+-.@]+"))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.ParentFolderId != nil && len(*s.ParentFolderId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ParentFolderId", 128))
+	}
+	if s.ParentFolderId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.ParentFolderId) {
+		invalidParams.Add(request.NewErrParamPattern("ParentFolderId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *InitiateDocumentVersionUploadInput) SetAuthenticationToken(v string) *InitiateDocumentVersionUploadInput {
 	s.AuthenticationToken = &v
@@ -9714,7 +10703,7 @@ func (s *InitiateDocumentVersionUploadInput) MarshalFields(e protocol.FieldEncod
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ContentCreatedTimestamp != nil {
 		v := *s.ContentCreatedTimestamp
@@ -9990,7 +10979,7 @@ type RemoveAllResourcePermissionsInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the resource.
 	//
@@ -10027,6 +11016,27 @@ func (s *RemoveAllResourcePermissionsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveAllResourcePermissionsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveAllResourcePermissionsInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.ResourceId != nil && len(*s.ResourceId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 128))
+	}
+	if s.ResourceId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *RemoveAllResourcePermissionsInput) SetAuthenticationToken(v string) *RemoveAllResourcePermissionsInput {
 	s.AuthenticationToken = &v
@@ -10044,7 +11054,7 @@ func (s *RemoveAllResourcePermissionsInput) MarshalFields(e protocol.FieldEncode
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.ResourceId != nil {
 		v := *s.ResourceId
@@ -10082,7 +11092,7 @@ type RemoveResourcePermissionInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The principal ID of the resource.
 	//
@@ -10133,6 +11143,39 @@ func (s *RemoveResourcePermissionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RemoveResourcePermissionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RemoveResourcePermissionInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.PrincipalId != nil && len(*s.PrincipalId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("PrincipalId", 256))
+	}
+	if s.PrincipalId != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.PrincipalId) {
+		invalidParams.Add(request.NewErrParamPattern("PrincipalId", "[&\\w+-.@]+"))
+	}
+	if s.PrincipalType != nil {
+		values := []string{"USER", "GROUP", "INVITE", "ANONYMOUS", "ORGANIZATION"}
+		if !request.IsParamEnumValue(*s.PrincipalType, values) {
+			invalidParams.Add(request.NewErrParamEnum("PrincipalType", *s.PrincipalType, values))
+		}
+	}
+	if s.ResourceId != nil && len(*s.ResourceId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ResourceId", 128))
+	}
+	if s.ResourceId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.ResourceId) {
+		invalidParams.Add(request.NewErrParamPattern("ResourceId", "[\\w+-.@]+"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *RemoveResourcePermissionInput) SetAuthenticationToken(v string) *RemoveResourcePermissionInput {
 	s.AuthenticationToken = &v
@@ -10162,7 +11205,7 @@ func (s *RemoveResourcePermissionInput) MarshalFields(e protocol.FieldEncoder) e
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.PrincipalId != nil {
 		v := *s.PrincipalId
@@ -10472,6 +11515,36 @@ func (s *SharePrincipal) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *SharePrincipal) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "SharePrincipal"}
+	if s.Id != nil && len(*s.Id) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("Id", 256))
+	}
+	if s.Id != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.Id) {
+		invalidParams.Add(request.NewErrParamPattern("Id", "[&\\w+-.@]+"))
+	}
+	if s.Role != nil {
+		values := []string{"VIEWER", "CONTRIBUTOR", "OWNER", "COOWNER"}
+		if !request.IsParamEnumValue(*s.Role, values) {
+			invalidParams.Add(request.NewErrParamEnum("Role", *s.Role, values))
+		}
+	}
+	if s.Type != nil {
+		values := []string{"USER", "GROUP", "INVITE", "ANONYMOUS", "ORGANIZATION"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetId sets the Id field's value.
 func (s *SharePrincipal) SetId(v string) *SharePrincipal {
 	s.Id = &v
@@ -10537,7 +11610,7 @@ type ShareResult struct {
 	Status *string `type:"string" enum:"ShareStatusType"`
 
 	// The status message.
-	StatusMessage *string `type:"string"`
+	StatusMessage *string `type:"string" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -10605,7 +11678,7 @@ func (s *ShareResult) MarshalFields(e protocol.FieldEncoder) error {
 	if s.StatusMessage != nil {
 		v := *s.StatusMessage
 
-		e.SetValue(protocol.BodyTarget, "StatusMessage", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "StatusMessage", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 
 	return nil
@@ -10641,6 +11714,24 @@ func (s StorageRuleType) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StorageRuleType) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StorageRuleType"}
+	if s.StorageType != nil {
+		values := []string{"UNLIMITED", "QUOTA"}
+		if !request.IsParamEnumValue(*s.StorageType, values) {
+			invalidParams.Add(request.NewErrParamEnum("StorageType", *s.StorageType, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStorageAllocatedInBytes sets the StorageAllocatedInBytes field's value.
 func (s *StorageRuleType) SetStorageAllocatedInBytes(v int64) *StorageRuleType {
 	s.StorageAllocatedInBytes = &v
@@ -10747,7 +11838,7 @@ type UpdateDocumentInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the document.
 	//
@@ -10800,6 +11891,42 @@ func (s *UpdateDocumentInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDocumentInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDocumentInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.DocumentId != nil && len(*s.DocumentId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DocumentId", 128))
+	}
+	if s.DocumentId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.DocumentId) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentId", "[\\w+-.@]+"))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.ParentFolderId != nil && len(*s.ParentFolderId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ParentFolderId", 128))
+	}
+	if s.ParentFolderId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.ParentFolderId) {
+		invalidParams.Add(request.NewErrParamPattern("ParentFolderId", "[\\w+-.@]+"))
+	}
+	if s.ResourceState != nil {
+		values := []string{"ACTIVE", "RESTORING", "RECYCLING", "RECYCLED"}
+		if !request.IsParamEnumValue(*s.ResourceState, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceState", *s.ResourceState, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *UpdateDocumentInput) SetAuthenticationToken(v string) *UpdateDocumentInput {
 	s.AuthenticationToken = &v
@@ -10835,7 +11962,7 @@ func (s *UpdateDocumentInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.DocumentId != nil {
 		v := *s.DocumentId
@@ -10888,7 +12015,7 @@ type UpdateDocumentVersionInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the document.
 	//
@@ -10939,6 +12066,39 @@ func (s *UpdateDocumentVersionInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateDocumentVersionInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateDocumentVersionInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.DocumentId != nil && len(*s.DocumentId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("DocumentId", 128))
+	}
+	if s.DocumentId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.DocumentId) {
+		invalidParams.Add(request.NewErrParamPattern("DocumentId", "[\\w+-.@]+"))
+	}
+	if s.VersionId != nil && len(*s.VersionId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("VersionId", 128))
+	}
+	if s.VersionId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.VersionId) {
+		invalidParams.Add(request.NewErrParamPattern("VersionId", "[\\w+-.@]+"))
+	}
+	if s.VersionStatus != nil {
+		values := []string{"ACTIVE"}
+		if !request.IsParamEnumValue(*s.VersionStatus, values) {
+			invalidParams.Add(request.NewErrParamEnum("VersionStatus", *s.VersionStatus, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *UpdateDocumentVersionInput) SetAuthenticationToken(v string) *UpdateDocumentVersionInput {
 	s.AuthenticationToken = &v
@@ -10968,7 +12128,7 @@ func (s *UpdateDocumentVersionInput) MarshalFields(e protocol.FieldEncoder) erro
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.DocumentId != nil {
 		v := *s.DocumentId
@@ -11016,7 +12176,7 @@ type UpdateFolderInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The ID of the folder.
 	//
@@ -11069,6 +12229,42 @@ func (s *UpdateFolderInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateFolderInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateFolderInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.FolderId != nil && len(*s.FolderId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("FolderId", 128))
+	}
+	if s.FolderId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.FolderId) {
+		invalidParams.Add(request.NewErrParamPattern("FolderId", "[\\w+-.@]+"))
+	}
+	if s.Name != nil && len(*s.Name) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Name", 255))
+	}
+	if s.ParentFolderId != nil && len(*s.ParentFolderId) > 128 {
+		invalidParams.Add(request.NewErrParamMaxLen("ParentFolderId", 128))
+	}
+	if s.ParentFolderId != nil && !request.MatchParamPattern("[\\w+-.@]+", *s.ParentFolderId) {
+		invalidParams.Add(request.NewErrParamPattern("ParentFolderId", "[\\w+-.@]+"))
+	}
+	if s.ResourceState != nil {
+		values := []string{"ACTIVE", "RESTORING", "RECYCLING", "RECYCLED"}
+		if !request.IsParamEnumValue(*s.ResourceState, values) {
+			invalidParams.Add(request.NewErrParamEnum("ResourceState", *s.ResourceState, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *UpdateFolderInput) SetAuthenticationToken(v string) *UpdateFolderInput {
 	s.AuthenticationToken = &v
@@ -11104,7 +12300,7 @@ func (s *UpdateFolderInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.FolderId != nil {
 		v := *s.FolderId
@@ -11157,7 +12353,7 @@ type UpdateUserInput struct {
 
 	// Amazon WorkDocs authentication token. This field should not be set when using
 	// administrative API actions, as in accessing the API using AWS credentials.
-	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string"`
+	AuthenticationToken *string `location:"header" locationName:"Authentication" min:"1" type:"string" sensitive:"true"`
 
 	// The given name of the user.
 	GivenName *string `min:"1" type:"string"`
@@ -11221,6 +12417,53 @@ func (s *UpdateUserInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *UpdateUserInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "UpdateUserInput"}
+	if s.AuthenticationToken != nil && len(*s.AuthenticationToken) > 8199 {
+		invalidParams.Add(request.NewErrParamMaxLen("AuthenticationToken", 8199))
+	}
+	if s.GivenName != nil && len(*s.GivenName) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("GivenName", 64))
+	}
+	if s.Locale != nil {
+		values := []string{"en", "fr", "ko", "de", "es", "ja", "ru", "zh_CN", "zh_TW", "pt_BR", "default"}
+		if !request.IsParamEnumValue(*s.Locale, values) {
+			invalidParams.Add(request.NewErrParamEnum("Locale", *s.Locale, values))
+		}
+	}
+	if s.Surname != nil && len(*s.Surname) > 64 {
+		invalidParams.Add(request.NewErrParamMaxLen("Surname", 64))
+	}
+	if s.TimeZoneId != nil && len(*s.TimeZoneId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("TimeZoneId", 256))
+	}
+	if s.Type != nil {
+		values := []string{"USER", "ADMIN"}
+		if !request.IsParamEnumValue(*s.Type, values) {
+			invalidParams.Add(request.NewErrParamEnum("Type", *s.Type, values))
+		}
+	}
+	if s.UserId != nil && len(*s.UserId) > 256 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserId", 256))
+	}
+	if s.UserId != nil && !request.MatchParamPattern("[&\\w+-.@]+", *s.UserId) {
+		invalidParams.Add(request.NewErrParamPattern("UserId", "[&\\w+-.@]+"))
+	}
+	if s.StorageRule != nil {
+		if err := s.StorageRule.ValidateExtended(); err != nil {
+			invalidParams.AddNested("StorageRule", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetAuthenticationToken sets the AuthenticationToken field's value.
 func (s *UpdateUserInput) SetAuthenticationToken(v string) *UpdateUserInput {
 	s.AuthenticationToken = &v
@@ -11274,7 +12517,7 @@ func (s *UpdateUserInput) MarshalFields(e protocol.FieldEncoder) error {
 	if s.AuthenticationToken != nil {
 		v := *s.AuthenticationToken
 
-		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.HeaderTarget, "Authentication", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 	if s.GivenName != nil {
 		v := *s.GivenName
@@ -11359,7 +12602,7 @@ type UploadMetadata struct {
 	SignedHeaders map[string]*string `type:"map"`
 
 	// The URL of the upload.
-	UploadUrl *string `min:"1" type:"string"`
+	UploadUrl *string `min:"1" type:"string" sensitive:"true"`
 }
 
 // String returns the string representation
@@ -11394,7 +12637,7 @@ func (s *UploadMetadata) MarshalFields(e protocol.FieldEncoder) error {
 	if s.UploadUrl != nil {
 		v := *s.UploadUrl
 
-		e.SetValue(protocol.BodyTarget, "UploadUrl", protocol.StringValue(v), protocol.Metadata{})
+		e.SetValue(protocol.BodyTarget, "UploadUrl", protocol.StringValue(v), protocol.Metadata{Sensitive: true})
 	}
 
 	return nil
@@ -1436,6 +1436,28 @@ func (s *CreateTagsInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateTagsInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateTagsInput"}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetResourceId sets the ResourceId field's value.
 func (s *CreateTagsInput) SetResourceId(v string) *CreateTagsInput {
 	s.ResourceId = &v
@@ -1511,6 +1533,31 @@ func (s *CreateWorkspacesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *CreateWorkspacesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "CreateWorkspacesInput"}
+	if s.Workspaces != nil && len(s.Workspaces) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("Workspaces", 25))
+	}
+	if s.Workspaces != nil {
+		for i, v := range s.Workspaces {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Workspaces", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetWorkspaces sets the Workspaces field's value.
 func (s *CreateWorkspacesInput) SetWorkspaces(v []*WorkspaceRequest) *CreateWorkspacesInput {
 	s.Workspaces = v
@@ -1810,6 +1857,24 @@ func (s *DescribeWorkspaceBundlesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeWorkspaceBundlesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeWorkspaceBundlesInput"}
+	if s.BundleIds != nil && len(s.BundleIds) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("BundleIds", 25))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 63))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBundleIds sets the BundleIds field's value.
 func (s *DescribeWorkspaceBundlesInput) SetBundleIds(v []*string) *DescribeWorkspaceBundlesInput {
 	s.BundleIds = v
@@ -1905,6 +1970,24 @@ func (s *DescribeWorkspaceDirectoriesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeWorkspaceDirectoriesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeWorkspaceDirectoriesInput"}
+	if s.DirectoryIds != nil && len(s.DirectoryIds) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("DirectoryIds", 25))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 63))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetDirectoryIds sets the DirectoryIds field's value.
 func (s *DescribeWorkspaceDirectoriesInput) SetDirectoryIds(v []*string) *DescribeWorkspaceDirectoriesInput {
 	s.DirectoryIds = v
@@ -1991,6 +2074,24 @@ func (s *DescribeWorkspacesConnectionStatusInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeWorkspacesConnectionStatusInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeWorkspacesConnectionStatusInput"}
+	if s.NextToken != nil && len(*s.NextToken) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 63))
+	}
+	if s.WorkspaceIds != nil && len(s.WorkspaceIds) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkspaceIds", 25))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetNextToken sets the NextToken field's value.
 func (s *DescribeWorkspacesConnectionStatusInput) SetNextToken(v string) *DescribeWorkspacesConnectionStatusInput {
 	s.NextToken = &v
@@ -2104,6 +2205,36 @@ func (s *DescribeWorkspacesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *DescribeWorkspacesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeWorkspacesInput"}
+	if s.BundleId != nil && !request.MatchParamPattern("^wsb-[0-9a-z]{8,63}$", *s.BundleId) {
+		invalidParams.Add(request.NewErrParamPattern("BundleId", "^wsb-[0-9a-z]{8,63}$"))
+	}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{8,63}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{8,63}$"))
+	}
+	if s.Limit != nil && *s.Limit > 25 {
+		invalidParams.Add(request.NewErrParamMaxValue("Limit", 25))
+	}
+	if s.NextToken != nil && len(*s.NextToken) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("NextToken", 63))
+	}
+	if s.UserName != nil && len(*s.UserName) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 63))
+	}
+	if s.WorkspaceIds != nil && len(s.WorkspaceIds) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("WorkspaceIds", 25))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBundleId sets the BundleId field's value.
 func (s *DescribeWorkspacesInput) SetBundleId(v string) *DescribeWorkspacesInput {
 	s.BundleId = &v
@@ -2310,6 +2441,26 @@ func (s *ModifyWorkspacePropertiesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *ModifyWorkspacePropertiesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyWorkspacePropertiesInput"}
+	if s.WorkspaceId != nil && !request.MatchParamPattern("^ws-[0-9a-z]{8,63}$", *s.WorkspaceId) {
+		invalidParams.Add(request.NewErrParamPattern("WorkspaceId", "^ws-[0-9a-z]{8,63}$"))
+	}
+	if s.WorkspaceProperties != nil {
+		if err := s.WorkspaceProperties.ValidateExtended(); err != nil {
+			invalidParams.AddNested("WorkspaceProperties", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetWorkspaceId sets the WorkspaceId field's value.
 func (s *ModifyWorkspacePropertiesInput) SetWorkspaceId(v string) *ModifyWorkspacePropertiesInput {
 	s.WorkspaceId = &v
@@ -2372,6 +2523,21 @@ func (s *RebootRequest) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RebootRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RebootRequest"}
+	if s.WorkspaceId != nil && !request.MatchParamPattern("^ws-[0-9a-z]{8,63}$", *s.WorkspaceId) {
+		invalidParams.Add(request.NewErrParamPattern("WorkspaceId", "^ws-[0-9a-z]{8,63}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetWorkspaceId sets the WorkspaceId field's value.
 func (s *RebootRequest) SetWorkspaceId(v string) *RebootRequest {
 	s.WorkspaceId = &v
@@ -2425,6 +2591,31 @@ func (s *RebootWorkspacesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RebootWorkspacesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RebootWorkspacesInput"}
+	if s.RebootWorkspaceRequests != nil && len(s.RebootWorkspaceRequests) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("RebootWorkspaceRequests", 25))
+	}
+	if s.RebootWorkspaceRequests != nil {
+		for i, v := range s.RebootWorkspaceRequests {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "RebootWorkspaceRequests", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRebootWorkspaceRequests sets the RebootWorkspaceRequests field's value.
 func (s *RebootWorkspacesInput) SetRebootWorkspaceRequests(v []*RebootRequest) *RebootWorkspacesInput {
 	s.RebootWorkspaceRequests = v
@@ -2491,6 +2682,21 @@ func (s *RebuildRequest) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RebuildRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RebuildRequest"}
+	if s.WorkspaceId != nil && !request.MatchParamPattern("^ws-[0-9a-z]{8,63}$", *s.WorkspaceId) {
+		invalidParams.Add(request.NewErrParamPattern("WorkspaceId", "^ws-[0-9a-z]{8,63}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetWorkspaceId sets the WorkspaceId field's value.
 func (s *RebuildRequest) SetWorkspaceId(v string) *RebuildRequest {
 	s.WorkspaceId = &v
@@ -2544,6 +2750,31 @@ func (s *RebuildWorkspacesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *RebuildWorkspacesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "RebuildWorkspacesInput"}
+	if s.RebuildWorkspaceRequests != nil && len(s.RebuildWorkspaceRequests) > 1 {
+		invalidParams.Add(request.NewErrParamMaxLen("RebuildWorkspaceRequests", 1))
+	}
+	if s.RebuildWorkspaceRequests != nil {
+		for i, v := range s.RebuildWorkspaceRequests {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "RebuildWorkspaceRequests", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRebuildWorkspaceRequests sets the RebuildWorkspaceRequests field's value.
 func (s *RebuildWorkspacesInput) SetRebuildWorkspaceRequests(v []*RebuildRequest) *RebuildWorkspacesInput {
 	s.RebuildWorkspaceRequests = v
@@ -2594,6 +2825,21 @@ func (s StartRequest) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartRequest"}
+	if s.WorkspaceId != nil && !request.MatchParamPattern("^ws-[0-9a-z]{8,63}$", *s.WorkspaceId) {
+		invalidParams.Add(request.NewErrParamPattern("WorkspaceId", "^ws-[0-9a-z]{8,63}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetWorkspaceId sets the WorkspaceId field's value.
 func (s *StartRequest) SetWorkspaceId(v string) *StartRequest {
 	s.WorkspaceId = &v
@@ -2636,6 +2882,31 @@ func (s *StartWorkspacesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StartWorkspacesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StartWorkspacesInput"}
+	if s.StartWorkspaceRequests != nil && len(s.StartWorkspaceRequests) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("StartWorkspaceRequests", 25))
+	}
+	if s.StartWorkspaceRequests != nil {
+		for i, v := range s.StartWorkspaceRequests {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "StartWorkspaceRequests", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStartWorkspaceRequests sets the StartWorkspaceRequests field's value.
 func (s *StartWorkspacesInput) SetStartWorkspaceRequests(v []*StartRequest) *StartWorkspacesInput {
 	s.StartWorkspaceRequests = v
@@ -2685,6 +2956,21 @@ func (s StopRequest) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopRequest"}
+	if s.WorkspaceId != nil && !request.MatchParamPattern("^ws-[0-9a-z]{8,63}$", *s.WorkspaceId) {
+		invalidParams.Add(request.NewErrParamPattern("WorkspaceId", "^ws-[0-9a-z]{8,63}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetWorkspaceId sets the WorkspaceId field's value.
 func (s *StopRequest) SetWorkspaceId(v string) *StopRequest {
 	s.WorkspaceId = &v
@@ -2727,6 +3013,31 @@ func (s *StopWorkspacesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *StopWorkspacesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "StopWorkspacesInput"}
+	if s.StopWorkspaceRequests != nil && len(s.StopWorkspaceRequests) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("StopWorkspaceRequests", 25))
+	}
+	if s.StopWorkspaceRequests != nil {
+		for i, v := range s.StopWorkspaceRequests {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "StopWorkspaceRequests", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetStopWorkspaceRequests sets the StopWorkspaceRequests field's value.
 func (s *StopWorkspacesInput) SetStopWorkspaceRequests(v []*StopRequest) *StopWorkspacesInput {
 	s.StopWorkspaceRequests = v
@@ -2797,6 +3108,24 @@ func (s *Tag) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *Tag) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "Tag"}
+	if s.Key != nil && len(*s.Key) > 127 {
+		invalidParams.Add(request.NewErrParamMaxLen("Key", 127))
+	}
+	if s.Value != nil && len(*s.Value) > 255 {
+		invalidParams.Add(request.NewErrParamMaxLen("Value", 255))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetKey sets the Key field's value.
 func (s *Tag) SetKey(v string) *Tag {
 	s.Key = &v
@@ -2844,6 +3173,21 @@ func (s *TerminateRequest) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TerminateRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TerminateRequest"}
+	if s.WorkspaceId != nil && !request.MatchParamPattern("^ws-[0-9a-z]{8,63}$", *s.WorkspaceId) {
+		invalidParams.Add(request.NewErrParamPattern("WorkspaceId", "^ws-[0-9a-z]{8,63}$"))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetWorkspaceId sets the WorkspaceId field's value.
 func (s *TerminateRequest) SetWorkspaceId(v string) *TerminateRequest {
 	s.WorkspaceId = &v
@@ -2897,6 +3241,31 @@ func (s *TerminateWorkspacesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *TerminateWorkspacesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "TerminateWorkspacesInput"}
+	if s.TerminateWorkspaceRequests != nil && len(s.TerminateWorkspaceRequests) > 25 {
+		invalidParams.Add(request.NewErrParamMaxLen("TerminateWorkspaceRequests", 25))
+	}
+	if s.TerminateWorkspaceRequests != nil {
+		for i, v := range s.TerminateWorkspaceRequests {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "TerminateWorkspaceRequests", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetTerminateWorkspaceRequests sets the TerminateWorkspaceRequests field's value.
 func (s *TerminateWorkspacesInput) SetTerminateWorkspaceRequests(v []*TerminateRequest) *TerminateWorkspacesInput {
 	s.TerminateWorkspaceRequests = v
@@ -3377,6 +3746,24 @@ func (s WorkspaceProperties) GoString() string {
 	return s.String()
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *WorkspaceProperties) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "WorkspaceProperties"}
+	if s.RunningMode != nil {
+		values := []string{"AUTO_STOP", "ALWAYS_ON"}
+		if !request.IsParamEnumValue(*s.RunningMode, values) {
+			invalidParams.Add(request.NewErrParamEnum("RunningMode", *s.RunningMode, values))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetRunningMode sets the RunningMode field's value.
 func (s *WorkspaceProperties) SetRunningMode(v string) *WorkspaceProperties {
 	s.RunningMode = &v
@@ -3472,6 +3859,42 @@ func (s *WorkspaceRequest) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *WorkspaceRequest) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "WorkspaceRequest"}
+	if s.BundleId != nil && !request.MatchParamPattern("^wsb-[0-9a-z]{8,63}$", *s.BundleId) {
+		invalidParams.Add(request.NewErrParamPattern("BundleId", "^wsb-[0-9a-z]{8,63}$"))
+	}
+	if s.DirectoryId != nil && !request.MatchParamPattern("^d-[0-9a-f]{8,63}$", *s.DirectoryId) {
+		invalidParams.Add(request.NewErrParamPattern("DirectoryId", "^d-[0-9a-f]{8,63}$"))
+	}
+	if s.UserName != nil && len(*s.UserName) > 63 {
+		invalidParams.Add(request.NewErrParamMaxLen("UserName", 63))
+	}
+	if s.Tags != nil {
+		for i, v := range s.Tags {
+			if v == nil {
+				continue
+			}
+			if err := v.ValidateExtended(); err != nil {
+				invalidParams.AddNested(fmt.Sprintf("%s[%v]", "Tags", i), err.(request.ErrInvalidParams))
+			}
+		}
+	}
+	if s.WorkspaceProperties != nil {
+		if err := s.WorkspaceProperties.ValidateExtended(); err != nil {
+			invalidParams.AddNested("WorkspaceProperties", err.(request.ErrInvalidParams))
+		}
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetBundleId sets the BundleId field's value.
 func (s *WorkspaceRequest) SetBundleId(v string) *WorkspaceRequest {
 	s.BundleId = &v
@@ -1581,6 +1581,21 @@ func (s *GetTraceSummariesInput) Validate() error {
 	return nil
 }
 
+// ValidateExtended inspects the fields of the type to determine if they
+// satisfy their maximum, pattern, and enum constraints. Only used if the
+// client's Config.EnableExtendedValidation is set.
+func (s *GetTraceSummariesInput) ValidateExtended() error {
+	invalidParams := request.ErrInvalidParams{Context: "GetTraceSummariesInput"}
+	if s.FilterExpression != nil && len(*s.FilterExpression) > 2000 {
+		invalidParams.Add(request.NewErrParamMaxLen("FilterExpression", 2000))
+	}
+
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
 // SetEndTime sets the EndTime field's value.
 func (s *GetTraceSummariesInput) SetEndTime(v time.Time) *GetTraceSummariesInput {
 	s.EndTime = &v